@@ -37,6 +37,17 @@ CREATE TABLE IF NOT EXISTS `family_members` (
   KEY `idx_relationship` (`relationship`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 
+-- Create family_member_departures table (feeds the leave cooldown)
+CREATE TABLE IF NOT EXISTS `family_member_departures` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `family_id` bigint(20) unsigned NOT NULL,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `relationship` varchar(191) NOT NULL,
+  `left_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_user_id_left_at` (`user_id`, `left_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
 -- Create join_requests table
 CREATE TABLE IF NOT EXISTS `join_requests` (
   `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
@@ -114,11 +125,29 @@ CREATE TABLE IF NOT EXISTS `received_prizes` (
   `user_id` bigint(20) unsigned NOT NULL,
   `prize_id` bigint(20) unsigned NOT NULL,
   `message` longtext NOT NULL,
+  `status` varchar(16) NOT NULL DEFAULT 'pending',
+  `psc` bigint(20) NOT NULL DEFAULT 0,
+  `satisfaction` decimal(20,2) NOT NULL DEFAULT 0.00,
+  `expires_at` timestamp NULL DEFAULT NULL,
+  `reminded_at` timestamp NULL DEFAULT NULL,
+  `settled_at` timestamp NULL DEFAULT NULL,
   `created_at` timestamp NULL DEFAULT NULL,
   `updated_at` timestamp NULL DEFAULT NULL,
   PRIMARY KEY (`id`),
   KEY `idx_user_id` (`user_id`),
-  KEY `idx_prize_id` (`prize_id`)
+  KEY `idx_prize_id` (`prize_id`),
+  KEY `idx_status_expires_at` (`status`, `expires_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Create dynasty_prize_pool table (funds prizes are paid from; escrowed is
+-- held for pending prizes and returns to balance when they expire)
+CREATE TABLE IF NOT EXISTS `dynasty_prize_pool` (
+  `asset` varchar(32) NOT NULL,
+  `balance` decimal(20,2) NOT NULL DEFAULT 0.00,
+  `escrowed` decimal(20,2) NOT NULL DEFAULT 0.00,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`asset`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 
 -- Create dynasty_messages table (message templates)
@@ -132,6 +161,37 @@ CREATE TABLE IF NOT EXISTS `dynasty_messages` (
   UNIQUE KEY `idx_type` (`type`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 
+-- Create dynasty_membership_rules table (single row, managed by dynasty admins)
+CREATE TABLE IF NOT EXISTS `dynasty_membership_rules` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `max_family_size` int(11) NOT NULL DEFAULT 0,
+  `relationship_limits` json NOT NULL,
+  `minor_relationships` varchar(191) NOT NULL DEFAULT '',
+  `unverified_relationships` varchar(191) NOT NULL DEFAULT '',
+  `leave_cooldown_hours` int(11) NOT NULL DEFAULT 0,
+  `updated_by` bigint(20) unsigned DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Create dynasty_stats table (one row per dynasty, refreshed by the stats job)
+CREATE TABLE IF NOT EXISTS `dynasty_stats` (
+  `dynasty_id` bigint(20) unsigned NOT NULL,
+  `owner_id` bigint(20) unsigned NOT NULL,
+  `member_count` int(11) NOT NULL DEFAULT 0,
+  `member_score` bigint(20) NOT NULL DEFAULT 0,
+  `land_count` int(11) NOT NULL DEFAULT 0,
+  `prize_psc` bigint(20) NOT NULL DEFAULT 0,
+  `computed_at` timestamp NOT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`dynasty_id`),
+  KEY `dynasty_stats_member_score_index` (`member_score`),
+  KEY `dynasty_stats_land_count_index` (`land_count`),
+  KEY `dynasty_stats_prize_psc_index` (`prize_psc`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
 -- Insert default dynasty permissions
 INSERT IGNORE INTO `dynasty_permissions` (`id`, `BFR`, `SF`, `W`, `JU`, `DM`, `PIUP`, `PITC`, `PIC`, `ESOO`, `COTB`, `created_at`, `updated_at`)
 VALUES (1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, NOW(), NOW());
@@ -0,0 +1,27 @@
+-- Creates buy_feature_request_expiries for the grace period expiry worker.
+--
+-- features-service records each buy request it cancels because its grace
+-- period ended, with the locked PSC and IRR to refund. Each asset is marked
+-- before it is refunded and the row is leased to one worker at a time, so a
+-- retried or concurrent run never refunds twice. Rows with a refunded_at set
+-- but no completed_at long after their lease need a manual check.
+-- Run it once, before the deploy:
+--   mysql metargb_db < scripts/migrate_buy_feature_request_expiries.sql
+
+CREATE TABLE IF NOT EXISTS `buy_feature_request_expiries` (
+  `buy_feature_request_id` bigint(20) unsigned NOT NULL,
+  `buyer_id` bigint(20) unsigned NOT NULL,
+  `seller_id` bigint(20) unsigned NOT NULL,
+  `feature_id` bigint(20) unsigned NOT NULL,
+  `psc` decimal(20,10) NOT NULL DEFAULT 0.0000000000,
+  `irr` bigint(20) NOT NULL DEFAULT 0,
+  `grace_period_ended_at` timestamp NULL DEFAULT NULL,
+  `psc_refunded_at` timestamp NULL DEFAULT NULL,
+  `irr_refunded_at` timestamp NULL DEFAULT NULL,
+  `completed_at` timestamp NULL DEFAULT NULL,
+  `locked_until` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`buy_feature_request_id`),
+  KEY `buy_feature_request_expiries_completed_at_locked_until_index` (`completed_at`,`locked_until`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,24 @@
+-- Creates buy_feature_request_offers for buy request negotiation.
+--
+-- features-service records one row per round when a seller counters a buy
+-- request: round 0 is the buyer's original offer, each counter-offer takes
+-- the next round. The unique key keeps two concurrent counters from taking
+-- the same round.
+-- Run it once, before the deploy:
+--   mysql metargb_db < scripts/migrate_buy_feature_request_offers.sql
+
+CREATE TABLE IF NOT EXISTS `buy_feature_request_offers` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `buy_feature_request_id` bigint(20) unsigned NOT NULL,
+  `round` tinyint(3) unsigned NOT NULL,
+  `offered_by` bigint(20) unsigned NOT NULL,
+  `price_psc` decimal(20,10) NOT NULL DEFAULT 0.0000000000,
+  `price_irr` bigint(20) NOT NULL DEFAULT 0,
+  `note` text COLLATE utf8mb4_unicode_ci DEFAULT NULL,
+  `status` varchar(20) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `responded_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `buy_feature_request_offers_request_id_round_unique` (`buy_feature_request_id`,`round`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,14 @@
+-- Prepares buy_feature_requests for grace period anti-sniping and expiry.
+--
+-- requested_grace_period becomes a timestamp so features-service can compare
+-- and scan it, existing values are in Laravel's Y-m-d H:i:s format.
+-- grace_period_extensions counts how often higher offers extended a grace
+-- period, and the index lets the expiry worker find ended grace periods.
+-- Run it once, before the deploy:
+--   mysql metargb_db < scripts/migrate_buy_request_grace_periods.sql
+
+ALTER TABLE `buy_feature_requests`
+  MODIFY `requested_grace_period` timestamp NULL DEFAULT NULL,
+  ADD COLUMN IF NOT EXISTS `grace_period_extensions` tinyint(3) unsigned NOT NULL DEFAULT 0 AFTER `requested_grace_period`,
+  ADD INDEX IF NOT EXISTS `buy_feature_requests_status_requested_grace_period_index` (`status`,`requested_grace_period`),
+  ADD INDEX IF NOT EXISTS `buy_feature_requests_feature_id_index` (`feature_id`);
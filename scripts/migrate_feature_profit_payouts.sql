@@ -0,0 +1,18 @@
+-- Adds the log of hourly profit payouts shown in feature histories.
+--
+-- Profits used to be paid into wallets without a trace of the payout. Only
+-- payouts made after this script runs appear in GET
+-- /api/features/{feature}/history. Run it once, before the deploy:
+--   mysql metargb_db < scripts/migrate_feature_profit_payouts.sql
+
+CREATE TABLE IF NOT EXISTS `feature_profit_payouts` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `feature_id` bigint(20) unsigned NOT NULL,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `profit_id` bigint(20) unsigned NOT NULL,
+  `asset` varchar(191) NOT NULL,
+  `amount` decimal(15,6) NOT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `feature_profit_payouts_feature_id_created_at_index` (`feature_id`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
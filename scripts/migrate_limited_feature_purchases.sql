@@ -0,0 +1,13 @@
+-- Indexes limited_feature_purchases for the per-user campaign limits.
+--
+-- Purchases from limited campaigns now claim a row before they are paid for,
+-- counting the buyer's earlier purchases in the same statement. Without the
+-- (user_id, feature_limit_id) index the count locks the whole table. The
+-- feature_id column features-service writes is added where it is missing.
+-- Run it once, before the deploy:
+--   mysql metargb_db < scripts/migrate_limited_feature_purchases.sql
+
+ALTER TABLE `limited_feature_purchases`
+  ADD COLUMN IF NOT EXISTS `feature_id` bigint(20) unsigned DEFAULT NULL AFTER `feature_limit_id`,
+  ADD INDEX IF NOT EXISTS `limited_feature_purchases_user_id_feature_limit_id_index` (`user_id`,`feature_limit_id`),
+  ADD INDEX IF NOT EXISTS `limited_feature_purchases_feature_limit_id_index` (`feature_limit_id`);
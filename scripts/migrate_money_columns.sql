@@ -0,0 +1,43 @@
+-- Migrates money columns of an existing database to exact decimals.
+--
+-- Amounts used to be truncated to whole numbers (bigint columns) or rounded
+-- (double and decimal(8,2) columns), so fractional psc was lost. Mixed asset
+-- columns get decimal(30,10), psc columns decimal(20,10) like wallets.psc.
+-- irr columns that only hold whole rials stay bigint.
+--
+-- Existing values are converted in place and the services read both the old
+-- and the new types, so the script can run before or after the deploy. Run it
+-- once:
+--   mysql metargb_db < scripts/migrate_money_columns.sql
+
+ALTER TABLE `transactions`
+  MODIFY `amount` decimal(30,10) NOT NULL;
+
+ALTER TABLE `orders`
+  MODIFY `amount` decimal(30,10) NOT NULL;
+
+ALTER TABLE `referral_order_histories`
+  MODIFY `amount` decimal(30,10) NOT NULL;
+
+ALTER TABLE `first_orders`
+  MODIFY `amount` decimal(30,10) NOT NULL,
+  MODIFY `bonus` decimal(30,10) NOT NULL;
+
+ALTER TABLE `locked_assets`
+  MODIFY `psc` decimal(20,10) NOT NULL;
+
+ALTER TABLE `trades`
+  MODIFY `psc_amount` decimal(20,10) unsigned DEFAULT NULL;
+
+ALTER TABLE `buy_feature_requests`
+  MODIFY `price_psc` decimal(20,10) NOT NULL DEFAULT 0.0000000000;
+
+ALTER TABLE `sell_feature_requests`
+  MODIFY `price_psc` decimal(20,10) NOT NULL DEFAULT 0.0000000000;
+
+ALTER TABLE `comissions`
+  MODIFY `psc` decimal(20,10) NOT NULL;
+
+ALTER TABLE `feature_reservations`
+  MODIFY `price_psc` decimal(20,10) NOT NULL DEFAULT 0.0000000000,
+  MODIFY `price_irr` decimal(30,10) NOT NULL DEFAULT 0.0000000000;
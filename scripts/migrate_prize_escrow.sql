@@ -0,0 +1,44 @@
+-- Moves dynasty prizes of an existing database into escrow.
+--
+-- Received prizes used to wait forever and were deleted when claimed. They
+-- now hold the psc and satisfaction they pay, must be claimed before
+-- expires_at, and the amounts are escrowed in dynasty_prize_pool until then.
+--
+-- Prizes awarded before this script get the default 30 day claim window
+-- (DYNASTY_PRIZE_CLAIM_WINDOW) from the moment it runs. Run it once, before
+-- the deploy:
+--   mysql metargb_db < scripts/migrate_prize_escrow.sql
+
+ALTER TABLE `received_prizes`
+  ADD COLUMN `status` varchar(16) NOT NULL DEFAULT 'pending' AFTER `message`,
+  ADD COLUMN `psc` bigint(20) NOT NULL DEFAULT 0 AFTER `status`,
+  ADD COLUMN `satisfaction` decimal(20,2) NOT NULL DEFAULT 0.00 AFTER `psc`,
+  ADD COLUMN `expires_at` timestamp NULL DEFAULT NULL AFTER `satisfaction`,
+  ADD COLUMN `reminded_at` timestamp NULL DEFAULT NULL AFTER `expires_at`,
+  ADD COLUMN `settled_at` timestamp NULL DEFAULT NULL AFTER `reminded_at`,
+  ADD KEY `received_prizes_user_id_status_index` (`user_id`,`status`),
+  ADD KEY `received_prizes_status_expires_at_index` (`status`,`expires_at`);
+
+CREATE TABLE IF NOT EXISTS `dynasty_prize_pool` (
+  `asset` varchar(32) NOT NULL,
+  `balance` decimal(20,2) NOT NULL DEFAULT 0.00,
+  `escrowed` decimal(20,2) NOT NULL DEFAULT 0.00,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`asset`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+UPDATE `received_prizes` rp
+INNER JOIN `dynasty_prizes` dp ON dp.id = rp.prize_id
+SET rp.psc = dp.psc,
+    rp.satisfaction = dp.satisfaction,
+    rp.expires_at = NOW() + INTERVAL 30 DAY;
+
+INSERT INTO `dynasty_prize_pool` (`asset`, `balance`, `escrowed`, `created_at`, `updated_at`)
+SELECT 'psc', -SUM(psc), SUM(psc), NOW(), NOW() FROM `received_prizes` WHERE status = 'pending'
+UNION ALL
+SELECT 'satisfaction', -SUM(satisfaction), SUM(satisfaction), NOW(), NOW() FROM `received_prizes` WHERE status = 'pending'
+ON DUPLICATE KEY UPDATE
+  `balance` = `balance` + VALUES(`balance`),
+  `escrowed` = `escrowed` + VALUES(`escrowed`),
+  `updated_at` = NOW();
@@ -0,0 +1,15 @@
+-- Adds the history of asset rates behind the 24h trends of GET /api/rates.
+--
+-- Rates used to be overwritten in place. commercial-service records a rate
+-- whenever it changes from now on, so the trends appear 24 hours after the
+-- deploy. Run it once, before the deploy:
+--   mysql metargb_db < scripts/migrate_rate_history.sql
+
+CREATE TABLE IF NOT EXISTS `rate_history` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `asset` varchar(191) NOT NULL,
+  `rate` decimal(20,4) NOT NULL,
+  `recorded_at` timestamp NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `rate_history_asset_recorded_at_index` (`asset`,`recorded_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,18 @@
+-- Makes (order_id, tier) unique in referral_order_histories.
+--
+-- commercial-service claims the history row of a referral reward before it
+-- credits the wallet, so a recalculation racing the payment callback, or two
+-- recalculations, can no longer pay the same order's tier twice. Rows without
+-- an order_id (paid before tiered rewards) are not affected.
+--
+-- The ALTER fails while duplicates exist. List them first and settle each one
+-- by hand, keeping the oldest row:
+--   SELECT order_id, tier, COUNT(*) FROM referral_order_histories
+--   WHERE order_id IS NOT NULL GROUP BY order_id, tier HAVING COUNT(*) > 1;
+--
+-- Run it once, before the deploy:
+--   mysql metargb_db < scripts/migrate_referral_reward_uniqueness.sql
+
+ALTER TABLE `referral_order_histories`
+  DROP INDEX IF EXISTS `referral_order_histories_order_id_tier_index`,
+  ADD UNIQUE INDEX IF NOT EXISTS `referral_order_histories_order_id_tier_unique` (`order_id`,`tier`);
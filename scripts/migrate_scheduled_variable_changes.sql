@@ -0,0 +1,17 @@
+-- Adds the variable changes admins schedule ahead of time, see
+-- api-docs/commercial-service/variables_api.md. Run it once, before the deploy:
+--   mysql metargb_db < scripts/migrate_scheduled_variable_changes.sql
+
+CREATE TABLE IF NOT EXISTS `scheduled_variable_changes` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `variable_key` varchar(191) NOT NULL,
+  `value` decimal(20,4) NOT NULL,
+  `note` text DEFAULT NULL,
+  `effective_at` timestamp NOT NULL,
+  `status` varchar(20) NOT NULL DEFAULT 'pending',
+  `created_by` bigint(20) unsigned NOT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `scheduled_variable_changes_status_effective_at_index` (`status`,`effective_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,18 @@
+-- Indexes trades, comissions and feature_profit_payouts for the annual trade
+-- summary.
+--
+-- features-service reads a user's trades of a Jalali year once as buyer and
+-- once as seller, the commission of each trade, and the profit paid to the
+-- user over the year. Without these indexes every summary scans the tables.
+-- Run it once, before the deploy:
+--   mysql metargb_db < scripts/migrate_trade_summary_indexes.sql
+
+ALTER TABLE `trades`
+  ADD INDEX IF NOT EXISTS `trades_buyer_id_created_at_index` (`buyer_id`,`created_at`),
+  ADD INDEX IF NOT EXISTS `trades_seller_id_created_at_index` (`seller_id`,`created_at`);
+
+ALTER TABLE `comissions`
+  ADD INDEX IF NOT EXISTS `comissions_trade_id_index` (`trade_id`);
+
+ALTER TABLE `feature_profit_payouts`
+  ADD INDEX IF NOT EXISTS `feature_profit_payouts_user_id_created_at_index` (`user_id`,`created_at`);
@@ -0,0 +1,18 @@
+-- Lets users choose a timezone in their account settings.
+--
+-- Calendar reminders, notification digests and digest quiet hours used to
+-- assume Tehran time. They now use settings.timezone, and an empty digest
+-- timezone follows it instead of meaning Asia/Tehran.
+--
+-- Digest settings saved with the old Asia/Tehran default are reset to follow
+-- the account timezone, which is Asia/Tehran until the user changes it. Run it
+-- once, before the deploy:
+--   mysql metargb_db < scripts/migrate_user_timezones.sql
+
+ALTER TABLE `settings`
+  ADD COLUMN `timezone` varchar(64) DEFAULT NULL AFTER `notifications`;
+
+ALTER TABLE `notification_digest_settings`
+  ALTER COLUMN `timezone` SET DEFAULT '';
+
+UPDATE `notification_digest_settings` SET `timezone` = '' WHERE `timezone` = 'Asia/Tehran';
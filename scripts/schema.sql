@@ -79,6 +79,54 @@ CREATE TABLE `answers` (
 ) ENGINE=InnoDB AUTO_INCREMENT=2813 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `api_keys`
+--
+
+DROP TABLE IF EXISTS `api_keys`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `api_keys` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `name` varchar(255) NOT NULL,
+  `prefix` varchar(16) NOT NULL,
+  `key_hash` char(64) NOT NULL,
+  `scopes` longtext CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL CHECK (json_valid(`scopes`)),
+  `rate_limit_per_minute` int(10) unsigned NOT NULL DEFAULT 60,
+  `last_used_at` timestamp NULL DEFAULT NULL,
+  `expires_at` timestamp NULL DEFAULT NULL,
+  `revoked_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `api_keys_key_hash_unique` (`key_hash`),
+  KEY `api_keys_user_id_foreign` (`user_id`),
+  CONSTRAINT `api_keys_user_id_foreign` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `backup_runs`
+--
+
+DROP TABLE IF EXISTS `backup_runs`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `backup_runs` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `target` varchar(64) NOT NULL,
+  `status` varchar(10) NOT NULL,
+  `location` varchar(500) DEFAULT NULL,
+  `size_bytes` bigint(20) unsigned DEFAULT NULL,
+  `error` text DEFAULT NULL,
+  `started_at` timestamp NULL DEFAULT NULL,
+  `finished_at` timestamp NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `backup_runs_target_status_finished_at_index` (`target`,`status`,`finished_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `bank_accounts`
 --
@@ -154,6 +202,56 @@ CREATE TABLE `buildings` (
 ) ENGINE=InnoDB AUTO_INCREMENT=15 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `buy_feature_request_expiries`
+--
+
+DROP TABLE IF EXISTS `buy_feature_request_expiries`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `buy_feature_request_expiries` (
+  `buy_feature_request_id` bigint(20) unsigned NOT NULL,
+  `buyer_id` bigint(20) unsigned NOT NULL,
+  `seller_id` bigint(20) unsigned NOT NULL,
+  `feature_id` bigint(20) unsigned NOT NULL,
+  `psc` decimal(20,10) NOT NULL DEFAULT 0.0000000000,
+  `irr` bigint(20) NOT NULL DEFAULT 0,
+  `grace_period_ended_at` timestamp NULL DEFAULT NULL,
+  `psc_refunded_at` timestamp NULL DEFAULT NULL,
+  `irr_refunded_at` timestamp NULL DEFAULT NULL,
+  `completed_at` timestamp NULL DEFAULT NULL,
+  `locked_until` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`buy_feature_request_id`),
+  KEY `buy_feature_request_expiries_completed_at_locked_until_index` (`completed_at`,`locked_until`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `buy_feature_request_offers`
+--
+
+DROP TABLE IF EXISTS `buy_feature_request_offers`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `buy_feature_request_offers` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `buy_feature_request_id` bigint(20) unsigned NOT NULL,
+  `round` tinyint(3) unsigned NOT NULL,
+  `offered_by` bigint(20) unsigned NOT NULL,
+  `price_psc` decimal(20,10) NOT NULL DEFAULT 0.0000000000,
+  `price_irr` bigint(20) NOT NULL DEFAULT 0,
+  `note` text COLLATE utf8mb4_unicode_ci DEFAULT NULL,
+  `status` varchar(20) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `responded_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `buy_feature_request_offers_request_id_round_unique` (`buy_feature_request_id`,`round`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `buy_feature_requests`
 --
@@ -168,13 +266,16 @@ CREATE TABLE `buy_feature_requests` (
   `feature_id` bigint(20) unsigned NOT NULL,
   `status` tinyint(4) NOT NULL DEFAULT 0,
   `note` text DEFAULT NULL,
-  `price_psc` decimal(8,2) NOT NULL DEFAULT 0.00,
+  `price_psc` decimal(20,10) NOT NULL DEFAULT 0.0000000000,
   `price_irr` bigint(20) NOT NULL DEFAULT 0,
-  `requested_grace_period` varchar(191) DEFAULT NULL,
+  `requested_grace_period` timestamp NULL DEFAULT NULL,
+  `grace_period_extensions` tinyint(3) unsigned NOT NULL DEFAULT 0,
   `deleted_at` timestamp NULL DEFAULT NULL,
   `created_at` timestamp NULL DEFAULT NULL,
   `updated_at` timestamp NULL DEFAULT NULL,
-  PRIMARY KEY (`id`)
+  PRIMARY KEY (`id`),
+  KEY `buy_feature_requests_status_requested_grace_period_index` (`status`,`requested_grace_period`),
+  KEY `buy_feature_requests_feature_id_index` (`feature_id`)
 ) ENGINE=InnoDB AUTO_INCREMENT=55 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -208,6 +309,29 @@ CREATE TABLE `cache_locks` (
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `calendar_rsvps`
+--
+
+DROP TABLE IF EXISTS `calendar_rsvps`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `calendar_rsvps` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `calendar_id` bigint(20) unsigned NOT NULL,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `status` varchar(16) NOT NULL,
+  `remind_minutes` int(10) unsigned NOT NULL DEFAULT 0,
+  `reminded_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `calendar_rsvps_calendar_id_user_id_unique` (`calendar_id`,`user_id`),
+  KEY `calendar_rsvps_calendar_id_status_index` (`calendar_id`,`status`),
+  KEY `calendar_rsvps_reminded_at_remind_minutes_index` (`reminded_at`,`remind_minutes`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `calendars`
 --
@@ -309,11 +433,12 @@ DROP TABLE IF EXISTS `comissions`;
 CREATE TABLE `comissions` (
   `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
   `trade_id` bigint(20) unsigned NOT NULL,
-  `psc` bigint(20) NOT NULL,
+  `psc` decimal(20,10) NOT NULL,
   `irr` bigint(20) NOT NULL,
   `created_at` timestamp NULL DEFAULT NULL,
   `updated_at` timestamp NULL DEFAULT NULL,
-  PRIMARY KEY (`id`)
+  PRIMARY KEY (`id`),
+  KEY `comissions_trade_id_index` (`trade_id`)
 ) ENGINE=InnoDB AUTO_INCREMENT=14 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -515,6 +640,27 @@ CREATE TABLE `dynasties` (
 ) ENGINE=InnoDB AUTO_INCREMENT=5 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `dynasty_membership_rules`
+--
+
+DROP TABLE IF EXISTS `dynasty_membership_rules`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `dynasty_membership_rules` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `max_family_size` int(11) NOT NULL DEFAULT 0,
+  `relationship_limits` longtext CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL CHECK (json_valid(`relationship_limits`)),
+  `minor_relationships` varchar(191) NOT NULL DEFAULT '',
+  `unverified_relationships` varchar(191) NOT NULL DEFAULT '',
+  `leave_cooldown_hours` int(11) NOT NULL DEFAULT 0,
+  `updated_by` bigint(20) unsigned DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `dynasty_messages`
 --
@@ -578,6 +724,47 @@ CREATE TABLE `dynasty_prizes` (
 ) ENGINE=InnoDB AUTO_INCREMENT=8 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `dynasty_prize_pool`
+--
+
+DROP TABLE IF EXISTS `dynasty_prize_pool`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `dynasty_prize_pool` (
+  `asset` varchar(32) NOT NULL,
+  `balance` decimal(20,2) NOT NULL DEFAULT 0.00,
+  `escrowed` decimal(20,2) NOT NULL DEFAULT 0.00,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`asset`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `dynasty_stats`
+--
+
+DROP TABLE IF EXISTS `dynasty_stats`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `dynasty_stats` (
+  `dynasty_id` bigint(20) unsigned NOT NULL,
+  `owner_id` bigint(20) unsigned NOT NULL,
+  `member_count` int(11) NOT NULL DEFAULT 0,
+  `member_score` bigint(20) NOT NULL DEFAULT 0,
+  `land_count` int(11) NOT NULL DEFAULT 0,
+  `prize_psc` bigint(20) NOT NULL DEFAULT 0,
+  `computed_at` timestamp NOT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`dynasty_id`),
+  KEY `dynasty_stats_member_score_index` (`member_score`),
+  KEY `dynasty_stats_land_count_index` (`land_count`),
+  KEY `dynasty_stats_prize_psc_index` (`prize_psc`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `employees`
 --
@@ -607,6 +794,28 @@ CREATE TABLE `employees` (
 ) ENGINE=InnoDB AUTO_INCREMENT=7 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `exchange_rates`
+--
+
+DROP TABLE IF EXISTS `exchange_rates`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `exchange_rates` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `from_asset` varchar(191) NOT NULL,
+  `to_asset` varchar(191) NOT NULL,
+  `rate` decimal(30,10) NOT NULL,
+  `fee_percent` decimal(5,2) NOT NULL DEFAULT 0.00,
+  `enabled` tinyint(1) NOT NULL DEFAULT 1,
+  `updated_by` bigint(20) unsigned NOT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `exchange_rates_from_asset_to_asset_unique` (`from_asset`,`to_asset`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `failed_jobs`
 --
@@ -643,6 +852,24 @@ CREATE TABLE `families` (
 ) ENGINE=InnoDB AUTO_INCREMENT=5 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `family_member_departures`
+--
+
+DROP TABLE IF EXISTS `family_member_departures`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `family_member_departures` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `family_id` bigint(20) unsigned NOT NULL,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `relationship` varchar(191) NOT NULL,
+  `left_at` timestamp NOT NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`id`),
+  KEY `family_member_departures_user_id_left_at_index` (`user_id`,`left_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `family_members`
 --
@@ -661,6 +888,28 @@ CREATE TABLE `family_members` (
 ) ENGINE=InnoDB AUTO_INCREMENT=10 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `feature_geometry_versions`
+--
+
+DROP TABLE IF EXISTS `feature_geometry_versions`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `feature_geometry_versions` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `feature_id` bigint(20) unsigned NOT NULL,
+  `geometry_id` bigint(20) unsigned NOT NULL,
+  `version` int(10) unsigned NOT NULL,
+  `coordinates` longtext NOT NULL,
+  `area` decimal(20,4) NOT NULL DEFAULT 0.0000,
+  `edited_by` bigint(20) unsigned DEFAULT NULL,
+  `reason` varchar(255) DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `feature_geometry_versions_feature_id_version_unique` (`feature_id`,`version`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `feature_hourly_profits`
 --
@@ -730,6 +979,46 @@ CREATE TABLE `feature_pricing_limits` (
 ) ENGINE=InnoDB AUTO_INCREMENT=2 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `feature_profit_payouts`
+--
+
+DROP TABLE IF EXISTS `feature_profit_payouts`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `feature_profit_payouts` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `feature_id` bigint(20) unsigned NOT NULL,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `profit_id` bigint(20) unsigned NOT NULL,
+  `asset` varchar(191) NOT NULL,
+  `amount` decimal(15,6) NOT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `feature_profit_payouts_feature_id_created_at_index` (`feature_id`,`created_at`),
+  KEY `feature_profit_payouts_user_id_created_at_index` (`user_id`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `feature_profit_settings`
+--
+
+DROP TABLE IF EXISTS `feature_profit_settings`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `feature_profit_settings` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `auto_claim` tinyint(1) NOT NULL DEFAULT 0,
+  `compound` tinyint(1) NOT NULL DEFAULT 0,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `feature_profit_settings_user_id_unique` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `feature_properties`
 --
@@ -759,7 +1048,55 @@ CREATE TABLE `feature_properties` (
   `created_at` timestamp NULL DEFAULT NULL,
   `updated_at` timestamp NULL DEFAULT NULL,
   UNIQUE KEY `feature_properties_id_unique` (`id`),
-  KEY `feature_properties_address_index` (`address`(768))
+  KEY `feature_properties_address_index` (`address`(768)),
+  KEY `feature_properties_feature_id_index` (`feature_id`),
+  KEY `feature_properties_region_karbari_index` (`region`,`karbari`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `feature_reservations`
+--
+
+DROP TABLE IF EXISTS `feature_reservations`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `feature_reservations` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `feature_id` bigint(20) unsigned NOT NULL,
+  `buyer_id` bigint(20) unsigned NOT NULL,
+  `seller_id` bigint(20) unsigned NOT NULL,
+  `price_psc` decimal(20,10) NOT NULL DEFAULT 0.0000000000,
+  `price_irr` decimal(30,10) NOT NULL DEFAULT 0.0000000000,
+  `trade_id` bigint(20) unsigned DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `feature_reservations_feature_id_unique` (`feature_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `feature_watchlists`
+--
+
+DROP TABLE IF EXISTS `feature_watchlists`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `feature_watchlists` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `feature_id` bigint(20) unsigned NOT NULL,
+  `last_owner_id` bigint(20) unsigned NOT NULL,
+  `last_price_psc` varchar(191) NOT NULL DEFAULT '0',
+  `last_price_irr` varchar(191) NOT NULL DEFAULT '0',
+  `last_sell_request_id` bigint(20) unsigned NOT NULL DEFAULT 0,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `feature_watchlists_user_id_feature_id_unique` (`user_id`,`feature_id`),
+  KEY `feature_watchlists_feature_id_index` (`feature_id`),
+  CONSTRAINT `feature_watchlists_feature_id_foreign` FOREIGN KEY (`feature_id`) REFERENCES `features` (`id`) ON DELETE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -792,9 +1129,9 @@ CREATE TABLE `first_orders` (
   `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
   `user_id` bigint(20) unsigned NOT NULL,
   `type` varchar(191) NOT NULL,
-  `amount` bigint(20) NOT NULL,
+  `amount` decimal(30,10) NOT NULL,
   `date` date NOT NULL,
-  `bonus` int(11) NOT NULL,
+  `bonus` decimal(30,10) NOT NULL,
   `created_at` timestamp NULL DEFAULT NULL,
   `updated_at` timestamp NULL DEFAULT NULL,
   PRIMARY KEY (`id`)
@@ -818,6 +1155,72 @@ CREATE TABLE `follows` (
 ) ENGINE=InnoDB AUTO_INCREMENT=247 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `fraud_card_blocklist`
+--
+
+DROP TABLE IF EXISTS `fraud_card_blocklist`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `fraud_card_blocklist` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `pattern` varchar(64) NOT NULL,
+  `reason` varchar(500) DEFAULT NULL,
+  `created_by` bigint(20) unsigned NOT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `fraud_card_blocklist_pattern_unique` (`pattern`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `fraud_checks`
+--
+
+DROP TABLE IF EXISTS `fraud_checks`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `fraud_checks` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `kind` varchar(20) NOT NULL,
+  `asset` varchar(191) NOT NULL,
+  `amount` decimal(30,10) NOT NULL,
+  `decision` varchar(10) NOT NULL,
+  `reasons` varchar(191) NOT NULL DEFAULT '',
+  `reference` varchar(64) DEFAULT NULL,
+  `ip` varchar(45) DEFAULT NULL,
+  `device` varchar(255) DEFAULT NULL,
+  `card_pan` varchar(64) DEFAULT NULL,
+  `review_status` varchar(10) DEFAULT NULL,
+  `reviewed_by` bigint(20) unsigned DEFAULT NULL,
+  `review_note` varchar(500) DEFAULT NULL,
+  `reviewed_at` timestamp NULL DEFAULT NULL,
+  `consumed_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `fraud_checks_user_id_kind_created_at_index` (`user_id`,`kind`,`created_at`),
+  KEY `fraud_checks_review_status_created_at_index` (`review_status`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `fraud_login_sightings`
+--
+
+DROP TABLE IF EXISTS `fraud_login_sightings`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `fraud_login_sightings` (
+  `user_id` bigint(20) unsigned NOT NULL,
+  `ip` varchar(45) NOT NULL DEFAULT '',
+  `device` varchar(191) NOT NULL DEFAULT '',
+  `last_seen_at` timestamp NOT NULL,
+  PRIMARY KEY (`user_id`,`ip`,`device`),
+  KEY `fraud_login_sightings_user_id_last_seen_at_index` (`user_id`,`last_seen_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `general_settings`
 --
@@ -873,6 +1276,9 @@ CREATE TABLE `images` (
   `imageable_type` varchar(191) NOT NULL,
   `imageable_id` bigint(20) unsigned NOT NULL,
   `url` varchar(191) NOT NULL,
+  `thumbnail_url` varchar(191) DEFAULT NULL,
+  `position` int(10) unsigned NOT NULL DEFAULT 0,
+  `is_cover` tinyint(1) NOT NULL DEFAULT 0,
   `created_at` timestamp NULL DEFAULT NULL,
   `updated_at` timestamp NULL DEFAULT NULL,
   PRIMARY KEY (`id`),
@@ -881,39 +1287,97 @@ CREATE TABLE `images` (
 /*!40101 SET character_set_client = @saved_cs_client */;
 
 --
--- Table structure for table `interactions`
+-- Table structure for table `installment_plans`
 --
 
-DROP TABLE IF EXISTS `interactions`;
+DROP TABLE IF EXISTS `installment_plans`;
 /*!40101 SET @saved_cs_client     = @@character_set_client */;
 /*!40101 SET character_set_client = utf8 */;
-CREATE TABLE `interactions` (
+CREATE TABLE `installment_plans` (
   `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
-  `user_id` bigint(20) unsigned NOT NULL,
-  `likeable_type` varchar(191) NOT NULL,
-  `likeable_id` bigint(20) unsigned NOT NULL,
-  `liked` tinyint(1) NOT NULL,
-  `ip_address` varchar(45) NOT NULL,
+  `feature_id` bigint(20) unsigned NOT NULL,
+  `buyer_id` bigint(20) unsigned NOT NULL,
+  `seller_id` bigint(20) unsigned NOT NULL,
+  `platform_user_id` bigint(20) unsigned NOT NULL,
+  `status` varchar(191) NOT NULL DEFAULT 'active',
+  `total_psc` decimal(20,10) NOT NULL DEFAULT 0.0000000000,
+  `total_irr` bigint(20) NOT NULL DEFAULT 0,
+  `seller_payment_psc` decimal(20,10) NOT NULL DEFAULT 0.0000000000,
+  `seller_payment_irr` bigint(20) NOT NULL DEFAULT 0,
+  `paid_psc` decimal(20,10) NOT NULL DEFAULT 0.0000000000,
+  `paid_irr` bigint(20) NOT NULL DEFAULT 0,
+  `refunded_psc` decimal(20,10) NOT NULL DEFAULT 0.0000000000,
+  `refunded_irr` bigint(20) NOT NULL DEFAULT 0,
+  `penalty_percent` decimal(5,2) NOT NULL DEFAULT 0.00,
+  `trade_id` bigint(20) unsigned DEFAULT NULL,
+  `closed_at` timestamp NULL DEFAULT NULL,
   `created_at` timestamp NULL DEFAULT NULL,
   `updated_at` timestamp NULL DEFAULT NULL,
   PRIMARY KEY (`id`),
-  KEY `interactions_user_id_foreign` (`user_id`),
-  KEY `interactions_likeable_type_likeable_id_index` (`likeable_type`,`likeable_id`),
-  CONSTRAINT `interactions_user_id_foreign` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
-) ENGINE=InnoDB AUTO_INCREMENT=102 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+  KEY `installment_plans_buyer_id_index` (`buyer_id`),
+  KEY `installment_plans_seller_id_index` (`seller_id`),
+  KEY `installment_plans_status_index` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
 --
--- Table structure for table `ips`
+-- Table structure for table `installments`
 --
 
-DROP TABLE IF EXISTS `ips`;
+DROP TABLE IF EXISTS `installments`;
 /*!40101 SET @saved_cs_client     = @@character_set_client */;
 /*!40101 SET character_set_client = utf8 */;
-CREATE TABLE `ips` (
+CREATE TABLE `installments` (
   `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
-  `title` varchar(191) NOT NULL,
-  `type` varchar(191) NOT NULL,
+  `plan_id` bigint(20) unsigned NOT NULL,
+  `sequence` int(11) NOT NULL,
+  `amount_psc` decimal(20,10) NOT NULL DEFAULT 0.0000000000,
+  `amount_irr` bigint(20) NOT NULL DEFAULT 0,
+  `status` varchar(191) NOT NULL DEFAULT 'pending',
+  `due_at` timestamp NULL DEFAULT NULL,
+  `paid_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `installments_plan_id_sequence_unique` (`plan_id`,`sequence`),
+  KEY `installments_status_due_at_index` (`status`,`due_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `interactions`
+--
+
+DROP TABLE IF EXISTS `interactions`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `interactions` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `likeable_type` varchar(191) NOT NULL,
+  `likeable_id` bigint(20) unsigned NOT NULL,
+  `liked` tinyint(1) NOT NULL,
+  `ip_address` varchar(45) NOT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `interactions_user_id_foreign` (`user_id`),
+  KEY `interactions_likeable_type_likeable_id_index` (`likeable_type`,`likeable_id`),
+  CONSTRAINT `interactions_user_id_foreign` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB AUTO_INCREMENT=102 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `ips`
+--
+
+DROP TABLE IF EXISTS `ips`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `ips` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `title` varchar(191) NOT NULL,
+  `type` varchar(191) NOT NULL,
   `from` bigint(20) unsigned NOT NULL,
   `to` bigint(20) unsigned NOT NULL DEFAULT 0,
   `blocked` tinyint(1) NOT NULL DEFAULT 0,
@@ -1262,9 +1726,12 @@ CREATE TABLE `limited_feature_purchases` (
   `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
   `user_id` bigint(20) unsigned NOT NULL,
   `feature_limit_id` bigint(20) unsigned NOT NULL,
+  `feature_id` bigint(20) unsigned DEFAULT NULL,
   `created_at` timestamp NULL DEFAULT NULL,
   `updated_at` timestamp NULL DEFAULT NULL,
-  PRIMARY KEY (`id`)
+  PRIMARY KEY (`id`),
+  KEY `limited_feature_purchases_user_id_feature_limit_id_index` (`user_id`,`feature_limit_id`),
+  KEY `limited_feature_purchases_feature_limit_id_index` (`feature_limit_id`)
 ) ENGINE=InnoDB AUTO_INCREMENT=47 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -1280,7 +1747,7 @@ CREATE TABLE `locked_assets` (
   `user_id` bigint(20) unsigned NOT NULL,
   `buy_feature_request_id` bigint(20) unsigned NOT NULL,
   `feature_id` bigint(20) unsigned NOT NULL,
-  `psc` bigint(20) NOT NULL,
+  `psc` decimal(20,10) NOT NULL,
   `irr` bigint(20) NOT NULL,
   `status` tinyint(4) NOT NULL DEFAULT 0,
   `created_at` timestamp NULL DEFAULT NULL,
@@ -1308,6 +1775,30 @@ CREATE TABLE `locked_features` (
 ) ENGINE=InnoDB AUTO_INCREMENT=8 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `login_alerts`
+--
+
+DROP TABLE IF EXISTS `login_alerts`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `login_alerts` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `user_event_id` bigint(20) unsigned NOT NULL,
+  `reason` varchar(32) NOT NULL,
+  `ip` varchar(45) NOT NULL DEFAULT '',
+  `device` varchar(512) NOT NULL DEFAULT '',
+  `confirmed` tinyint(1) DEFAULT NULL,
+  `responded_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `login_alerts_user_id_confirmed_index` (`user_id`,`confirmed`),
+  CONSTRAINT `login_alerts_user_id_foreign` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `maps`
 --
@@ -1459,6 +1950,115 @@ CREATE TABLE `notes` (
 ) ENGINE=InnoDB AUTO_INCREMENT=79 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `notification_bot_link_codes`
+--
+
+DROP TABLE IF EXISTS `notification_bot_link_codes`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `notification_bot_link_codes` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `provider` varchar(16) NOT NULL,
+  `code` varchar(32) NOT NULL,
+  `expires_at` timestamp NOT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `notification_bot_link_codes_provider_code_unique` (`provider`,`code`),
+  KEY `notification_bot_link_codes_user_id_index` (`user_id`),
+  CONSTRAINT `notification_bot_link_codes_user_id_foreign` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `notification_bot_links`
+--
+
+DROP TABLE IF EXISTS `notification_bot_links`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `notification_bot_links` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `provider` varchar(16) NOT NULL,
+  `chat_id` varchar(64) NOT NULL,
+  `username` varchar(64) DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `notification_bot_links_user_id_provider_unique` (`user_id`,`provider`),
+  UNIQUE KEY `notification_bot_links_provider_chat_id_unique` (`provider`,`chat_id`),
+  CONSTRAINT `notification_bot_links_user_id_foreign` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `notification_digest_queue`
+--
+
+DROP TABLE IF EXISTS `notification_digest_queue`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `notification_digest_queue` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `channel` varchar(16) NOT NULL,
+  `category` varchar(32) NOT NULL,
+  `title` varchar(255) NOT NULL,
+  `message` text NOT NULL,
+  `recipient` varchar(255) NOT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `notification_digest_queue_user_id_index` (`user_id`),
+  CONSTRAINT `notification_digest_queue_user_id_foreign` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `notification_digest_settings`
+--
+
+DROP TABLE IF EXISTS `notification_digest_settings`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `notification_digest_settings` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `mode` varchar(16) NOT NULL DEFAULT 'immediate',
+  `timezone` varchar(64) NOT NULL DEFAULT '',
+  `quiet_hours_start` tinyint(3) unsigned NOT NULL DEFAULT 0,
+  `quiet_hours_end` tinyint(3) unsigned NOT NULL DEFAULT 0,
+  `last_sent_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `notification_digest_settings_user_id_unique` (`user_id`),
+  CONSTRAINT `notification_digest_settings_user_id_foreign` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `notification_preferences`
+--
+
+DROP TABLE IF EXISTS `notification_preferences`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `notification_preferences` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `channel` varchar(16) NOT NULL,
+  `category` varchar(32) NOT NULL,
+  `enabled` tinyint(1) NOT NULL DEFAULT 1,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `notification_preferences_user_channel_category_unique` (`user_id`,`channel`,`category`),
+  CONSTRAINT `notification_preferences_user_id_foreign` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `notifications`
 --
@@ -1509,7 +2109,7 @@ DROP TABLE IF EXISTS `orders`;
 CREATE TABLE `orders` (
   `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
   `asset` varchar(191) NOT NULL,
-  `amount` bigint(20) NOT NULL,
+  `amount` decimal(30,10) NOT NULL,
   `user_id` bigint(20) unsigned NOT NULL,
   `status` int(11) NOT NULL DEFAULT -1,
   `created_at` timestamp NULL DEFAULT NULL,
@@ -1695,6 +2295,48 @@ CREATE TABLE `prizes` (
 ) ENGINE=InnoDB AUTO_INCREMENT=14 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `processed_activity_events`
+--
+
+DROP TABLE IF EXISTS `processed_activity_events`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `processed_activity_events` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `event_id` varchar(191) NOT NULL,
+  `type` varchar(191) NOT NULL,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `processed_activity_events_event_id_unique` (`event_id`),
+  KEY `processed_activity_events_user_id_index` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `processed_callbacks`
+--
+
+DROP TABLE IF EXISTS `processed_callbacks`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `processed_callbacks` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `gateway` varchar(191) NOT NULL,
+  `order_id` bigint(20) unsigned NOT NULL,
+  `token` bigint(20) NOT NULL,
+  `status` int(11) NOT NULL,
+  `raw_payload` text NOT NULL,
+  `result` varchar(191) NOT NULL DEFAULT 'processing',
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `processed_callbacks_gateway_order_id_unique` (`gateway`,`order_id`),
+  KEY `processed_callbacks_token_index` (`token`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `profile_limitations`
 --
@@ -1718,6 +2360,34 @@ CREATE TABLE `profile_limitations` (
 ) ENGINE=InnoDB AUTO_INCREMENT=10 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `profile_photo_uploads`
+--
+
+DROP TABLE IF EXISTS `profile_photo_uploads`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `profile_photo_uploads` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `status` varchar(191) NOT NULL DEFAULT 'processing',
+  `filename` varchar(191) NOT NULL,
+  `content_type` varchar(191) NOT NULL,
+  `image_data` mediumblob DEFAULT NULL,
+  `image_id` bigint(20) unsigned DEFAULT NULL,
+  `url` varchar(191) DEFAULT NULL,
+  `error` varchar(191) DEFAULT NULL,
+  `attempts` int(10) unsigned NOT NULL DEFAULT 0,
+  `locked_until` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `profile_photo_uploads_user_id_foreign` (`user_id`),
+  KEY `profile_photo_uploads_status_locked_until_index` (`status`,`locked_until`),
+  CONSTRAINT `profile_photo_uploads_user_id_foreign` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `questions`
 --
@@ -1741,6 +2411,23 @@ CREATE TABLE `questions` (
 ) ENGINE=InnoDB AUTO_INCREMENT=706 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `rate_history`
+--
+
+DROP TABLE IF EXISTS `rate_history`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `rate_history` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `asset` varchar(191) NOT NULL,
+  `rate` decimal(20,4) NOT NULL,
+  `recorded_at` timestamp NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `rate_history_asset_recorded_at_index` (`asset`,`recorded_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `received_prizes`
 --
@@ -1753,9 +2440,17 @@ CREATE TABLE `received_prizes` (
   `user_id` bigint(20) unsigned NOT NULL,
   `prize_id` bigint(20) unsigned NOT NULL,
   `message` longtext NOT NULL,
+  `status` varchar(16) NOT NULL DEFAULT 'pending',
+  `psc` bigint(20) NOT NULL DEFAULT 0,
+  `satisfaction` decimal(20,2) NOT NULL DEFAULT 0.00,
+  `expires_at` timestamp NULL DEFAULT NULL,
+  `reminded_at` timestamp NULL DEFAULT NULL,
+  `settled_at` timestamp NULL DEFAULT NULL,
   `created_at` timestamp NULL DEFAULT NULL,
   `updated_at` timestamp NULL DEFAULT NULL,
-  PRIMARY KEY (`id`)
+  PRIMARY KEY (`id`),
+  KEY `received_prizes_user_id_status_index` (`user_id`,`status`),
+  KEY `received_prizes_status_expires_at_index` (`status`,`expires_at`)
 ) ENGINE=InnoDB AUTO_INCREMENT=6 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -1787,10 +2482,13 @@ CREATE TABLE `referral_order_histories` (
   `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
   `user_id` bigint(20) unsigned NOT NULL,
   `referral_id` bigint(20) unsigned NOT NULL,
-  `amount` bigint(20) NOT NULL,
+  `order_id` bigint(20) unsigned DEFAULT NULL,
+  `tier` tinyint(3) unsigned NOT NULL DEFAULT 1,
+  `amount` decimal(30,10) NOT NULL,
   `created_at` timestamp NULL DEFAULT NULL,
   `updated_at` timestamp NULL DEFAULT NULL,
-  PRIMARY KEY (`id`)
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `referral_order_histories_order_id_tier_unique` (`order_id`,`tier`)
 ) ENGINE=InnoDB AUTO_INCREMENT=14 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -1811,6 +2509,27 @@ CREATE TABLE `referrals` (
 ) ENGINE=InnoDB AUTO_INCREMENT=93 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `report_definitions`
+--
+
+DROP TABLE IF EXISTS `report_definitions`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `report_definitions` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `name` varchar(191) NOT NULL,
+  `frequency` varchar(191) NOT NULL DEFAULT 'daily',
+  `metrics` text DEFAULT NULL,
+  `recipients` text NOT NULL,
+  `enabled` tinyint(1) NOT NULL DEFAULT 1,
+  `last_period_end` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `resets`
 --
@@ -1866,6 +2585,53 @@ CREATE TABLE `roles` (
 ) ENGINE=InnoDB AUTO_INCREMENT=11 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `saved_searches`
+--
+
+DROP TABLE IF EXISTS `saved_searches`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `saved_searches` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `name` varchar(191) NOT NULL DEFAULT '',
+  `region` int(11) NOT NULL DEFAULT 0,
+  `karbari` varchar(191) NOT NULL DEFAULT '',
+  `currency` varchar(191) NOT NULL DEFAULT 'irr',
+  `min_price` double NOT NULL DEFAULT 0,
+  `max_price` double NOT NULL DEFAULT 0,
+  `last_sell_request_id` bigint(20) unsigned NOT NULL DEFAULT 0,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `saved_searches_user_id_index` (`user_id`),
+  KEY `saved_searches_last_sell_request_id_index` (`last_sell_request_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `scheduled_variable_changes`
+--
+
+DROP TABLE IF EXISTS `scheduled_variable_changes`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `scheduled_variable_changes` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `variable_key` varchar(191) NOT NULL,
+  `value` decimal(20,4) NOT NULL,
+  `note` text DEFAULT NULL,
+  `effective_at` timestamp NOT NULL,
+  `status` varchar(20) NOT NULL DEFAULT 'pending',
+  `created_by` bigint(20) unsigned NOT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `scheduled_variable_changes_status_effective_at_index` (`status`,`effective_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `schema_migrations`
 --
@@ -1893,13 +2659,17 @@ CREATE TABLE `sell_feature_requests` (
   `seller_id` bigint(20) unsigned NOT NULL,
   `feature_id` bigint(20) unsigned NOT NULL,
   `status` tinyint(4) NOT NULL DEFAULT 0,
-  `price_psc` bigint(20) NOT NULL DEFAULT 0,
+  `price_psc` decimal(20,10) NOT NULL DEFAULT 0.0000000000,
   `price_irr` bigint(20) NOT NULL DEFAULT 0,
   `requested_grace_period` timestamp NULL DEFAULT NULL,
   `limit` int(11) NOT NULL DEFAULT 100,
   `created_at` timestamp NULL DEFAULT NULL,
   `updated_at` timestamp NULL DEFAULT NULL,
-  PRIMARY KEY (`id`)
+  PRIMARY KEY (`id`),
+  KEY `sell_feature_requests_feature_id_status_index` (`feature_id`,`status`),
+  KEY `sell_feature_requests_status_created_at_index` (`status`,`created_at`),
+  KEY `sell_feature_requests_status_price_irr_index` (`status`,`price_irr`),
+  KEY `sell_feature_requests_status_price_psc_index` (`status`,`price_psc`)
 ) ENGINE=InnoDB AUTO_INCREMENT=51 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -1940,12 +2710,52 @@ CREATE TABLE `settings` (
   `automatic_logout` bigint(20) unsigned NOT NULL DEFAULT 0,
   `privacy` longtext CHARACTER SET utf8mb4 COLLATE utf8mb4_bin DEFAULT NULL CHECK (json_valid(`privacy`)),
   `notifications` longtext CHARACTER SET utf8mb4 COLLATE utf8mb4_bin DEFAULT NULL CHECK (json_valid(`notifications`)),
+  `timezone` varchar(64) DEFAULT NULL,
   `created_at` timestamp NULL DEFAULT NULL,
   `updated_at` timestamp NULL DEFAULT NULL,
   PRIMARY KEY (`id`)
 ) ENGINE=InnoDB AUTO_INCREMENT=665 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `spending_limits`
+--
+
+DROP TABLE IF EXISTS `spending_limits`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `spending_limits` (
+  `user_id` bigint(20) unsigned NOT NULL,
+  `daily_psc` decimal(30,10) DEFAULT NULL,
+  `monthly_psc` decimal(30,10) DEFAULT NULL,
+  `daily_irr` decimal(30,10) DEFAULT NULL,
+  `monthly_irr` decimal(30,10) DEFAULT NULL,
+  `updated_by` bigint(20) unsigned DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `spending_records`
+--
+
+DROP TABLE IF EXISTS `spending_records`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `spending_records` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `asset` varchar(191) NOT NULL,
+  `amount` decimal(30,10) NOT NULL,
+  `source` varchar(191) NOT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `spending_records_user_id_asset_created_at_index` (`user_id`,`asset`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `statistices_settings`
 --
@@ -1996,6 +2806,120 @@ CREATE TABLE `statistices_types_settings` (
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `subscription_plans`
+--
+
+DROP TABLE IF EXISTS `subscription_plans`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `subscription_plans` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `slug` varchar(191) NOT NULL,
+  `name` varchar(191) NOT NULL,
+  `asset` varchar(191) NOT NULL DEFAULT 'irr',
+  `price` decimal(20,10) NOT NULL DEFAULT 0.0000000000,
+  `period_days` int(11) NOT NULL DEFAULT 30,
+  `entitlements` json DEFAULT NULL,
+  `active` tinyint(1) NOT NULL DEFAULT 1,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `subscription_plans_slug_unique` (`slug`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `subscriptions`
+--
+
+DROP TABLE IF EXISTS `subscriptions`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `subscriptions` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `plan_id` bigint(20) unsigned NOT NULL,
+  `status` varchar(191) NOT NULL DEFAULT 'pending',
+  `period_start` timestamp NULL DEFAULT NULL,
+  `period_end` timestamp NULL DEFAULT NULL,
+  `cancel_at_period_end` tinyint(1) NOT NULL DEFAULT 0,
+  `failed_attempts` int(11) NOT NULL DEFAULT 0,
+  `renews_at` timestamp NULL DEFAULT NULL,
+  `periods` int(11) NOT NULL DEFAULT 0,
+  `ended_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `subscriptions_user_id_status_index` (`user_id`,`status`),
+  KEY `subscriptions_status_renews_at_index` (`status`,`renews_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `support_chat_messages`
+--
+
+DROP TABLE IF EXISTS `support_chat_messages`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `support_chat_messages` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `session_id` bigint(20) unsigned NOT NULL,
+  `sender_id` bigint(20) unsigned NOT NULL,
+  `from_agent` tinyint(1) NOT NULL DEFAULT 0,
+  `body` text NOT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `support_chat_messages_session_id_index` (`session_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `support_chat_sessions`
+--
+
+DROP TABLE IF EXISTS `support_chat_sessions`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `support_chat_sessions` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `ticket_id` bigint(20) unsigned NOT NULL,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `agent_id` bigint(20) unsigned DEFAULT NULL,
+  `status` varchar(191) NOT NULL DEFAULT 'open',
+  `closed_by` bigint(20) unsigned DEFAULT NULL,
+  `closed_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `support_chat_sessions_ticket_id_status_index` (`ticket_id`,`status`),
+  KEY `support_chat_sessions_user_id_index` (`user_id`),
+  KEY `support_chat_sessions_status_index` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `support_faqs`
+--
+
+DROP TABLE IF EXISTS `support_faqs`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `support_faqs` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `question` varchar(191) NOT NULL,
+  `answer` text NOT NULL,
+  `department` varchar(191) DEFAULT NULL,
+  `keywords` varchar(191) NOT NULL DEFAULT '',
+  `created_by` bigint(20) unsigned NOT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `support_faqs_department_index` (`department`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `system_variables`
 --
@@ -2014,6 +2938,158 @@ CREATE TABLE `system_variables` (
 ) ENGINE=InnoDB AUTO_INCREMENT=6 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `ticket_emails`
+--
+
+DROP TABLE IF EXISTS `ticket_emails`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `ticket_emails` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `ticket_id` bigint(20) unsigned NOT NULL,
+  `response_id` bigint(20) unsigned DEFAULT NULL,
+  `direction` varchar(191) NOT NULL,
+  `message_id` varchar(191) NOT NULL,
+  `from_address` varchar(191) NOT NULL,
+  `to_address` varchar(191) NOT NULL DEFAULT '',
+  `subject` varchar(191) NOT NULL DEFAULT '',
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `ticket_emails_message_id_unique` (`message_id`),
+  KEY `ticket_emails_ticket_id_index` (`ticket_id`),
+  KEY `ticket_emails_response_id_index` (`response_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `ticket_responses`
+--
+
+DROP TABLE IF EXISTS `ticket_responses`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `ticket_responses` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `ticket_id` bigint(20) unsigned NOT NULL,
+  `response` longtext NOT NULL,
+  `attachment` varchar(191) DEFAULT NULL,
+  `responser_name` varchar(191) NOT NULL,
+  `responser_id` bigint(20) unsigned NOT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `ticket_responses_ticket_id_created_at_index` (`ticket_id`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `ticket_suggestions`
+--
+
+DROP TABLE IF EXISTS `ticket_suggestions`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `ticket_suggestions` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `ticket_id` bigint(20) unsigned NOT NULL,
+  `source` varchar(191) NOT NULL,
+  `source_id` bigint(20) unsigned NOT NULL,
+  `title` varchar(191) NOT NULL,
+  `body` text NOT NULL,
+  `slug` varchar(191) NOT NULL DEFAULT '',
+  `score` double NOT NULL,
+  `accepted_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `ticket_suggestions_ticket_id_index` (`ticket_id`),
+  KEY `ticket_suggestions_created_at_index` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `tickets`
+--
+
+DROP TABLE IF EXISTS `tickets`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `tickets` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `title` varchar(191) NOT NULL,
+  `content` longtext NOT NULL,
+  `attachment` varchar(191) DEFAULT NULL,
+  `status` tinyint(4) NOT NULL DEFAULT 0,
+  `department` varchar(191) DEFAULT NULL,
+  `importance` tinyint(4) NOT NULL DEFAULT 0,
+  `code` int(11) NOT NULL,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `reciever_id` bigint(20) unsigned DEFAULT NULL,
+  `resolved_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `tickets_user_id_index` (`user_id`),
+  KEY `tickets_reciever_id_index` (`reciever_id`),
+  KEY `tickets_created_at_status_department_index` (`created_at`,`status`,`department`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `trade_disputes`
+--
+
+DROP TABLE IF EXISTS `trade_disputes`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `trade_disputes` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `trade_id` bigint(20) unsigned NOT NULL,
+  `feature_id` bigint(20) unsigned NOT NULL,
+  `properties_id` varchar(191) NOT NULL,
+  `buyer_id` bigint(20) unsigned NOT NULL,
+  `seller_id` bigint(20) unsigned NOT NULL,
+  `opened_by` bigint(20) unsigned NOT NULL,
+  `ticket_id` bigint(20) unsigned NOT NULL,
+  `reason` varchar(191) NOT NULL,
+  `description` text NOT NULL,
+  `funds_frozen` tinyint(1) NOT NULL DEFAULT 0,
+  `status` varchar(191) NOT NULL DEFAULT 'open',
+  `outcome` varchar(191) DEFAULT NULL,
+  `resolution_note` text DEFAULT NULL,
+  `resolved_by` bigint(20) unsigned DEFAULT NULL,
+  `resolved_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `trade_disputes_trade_id_status_index` (`trade_id`,`status`),
+  KEY `trade_disputes_buyer_id_index` (`buyer_id`),
+  KEY `trade_disputes_seller_id_index` (`seller_id`),
+  KEY `trade_disputes_status_index` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `trade_receipts`
+--
+
+DROP TABLE IF EXISTS `trade_receipts`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `trade_receipts` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `trade_id` bigint(20) unsigned NOT NULL,
+  `code` varchar(16) NOT NULL,
+  `refunded_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `trade_receipts_trade_id_unique` (`trade_id`),
+  UNIQUE KEY `trade_receipts_code_unique` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `trades`
 --
@@ -2027,11 +3103,13 @@ CREATE TABLE `trades` (
   `buyer_id` bigint(20) unsigned NOT NULL,
   `seller_id` bigint(20) unsigned NOT NULL,
   `irr_amount` bigint(20) unsigned DEFAULT NULL,
-  `psc_amount` bigint(20) unsigned DEFAULT NULL,
+  `psc_amount` decimal(20,10) unsigned DEFAULT NULL,
   `date` date NOT NULL,
   `created_at` timestamp NULL DEFAULT NULL,
   `updated_at` timestamp NULL DEFAULT NULL,
-  PRIMARY KEY (`id`)
+  PRIMARY KEY (`id`),
+  KEY `trades_buyer_id_created_at_index` (`buyer_id`,`created_at`),
+  KEY `trades_seller_id_created_at_index` (`seller_id`,`created_at`)
 ) ENGINE=InnoDB AUTO_INCREMENT=273 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -2048,14 +3126,17 @@ CREATE TABLE `transactions` (
   `payable_id` bigint(20) NOT NULL,
   `payable_type` varchar(191) NOT NULL,
   `asset` varchar(191) NOT NULL,
-  `amount` bigint(20) NOT NULL,
+  `amount` decimal(30,10) NOT NULL,
   `action` varchar(191) NOT NULL,
   `status` int(11) NOT NULL DEFAULT -1,
   `token` bigint(20) unsigned DEFAULT NULL,
   `ref_id` bigint(20) unsigned DEFAULT NULL,
   `created_at` timestamp NULL DEFAULT NULL,
   `updated_at` timestamp NULL DEFAULT NULL,
-  PRIMARY KEY (`id`)
+  PRIMARY KEY (`id`),
+  KEY `transactions_user_id_created_at_id_index` (`user_id`,`created_at`,`id`),
+  KEY `transactions_user_id_asset_action_created_at_index` (`user_id`,`asset`,`action`,`created_at`),
+  KEY `transactions_user_id_payable_type_created_at_index` (`user_id`,`payable_type`,`created_at`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -2096,6 +3177,28 @@ CREATE TABLE `user_challenge_prizes` (
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `user_devices`
+--
+
+DROP TABLE IF EXISTS `user_devices`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `user_devices` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `fingerprint` char(64) NOT NULL,
+  `ip` varchar(45) NOT NULL DEFAULT '',
+  `user_agent` varchar(512) NOT NULL DEFAULT '',
+  `first_seen_at` timestamp NULL DEFAULT NULL,
+  `last_seen_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user_devices_user_id_fingerprint_ip_unique` (`user_id`,`fingerprint`,`ip`),
+  KEY `user_devices_user_id_ip_index` (`user_id`,`ip`),
+  CONSTRAINT `user_devices_user_id_foreign` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `user_logs`
 --
@@ -2117,6 +3220,38 @@ CREATE TABLE `user_logs` (
 ) ENGINE=InnoDB AUTO_INCREMENT=665 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `user_onboarding_rewards`
+--
+
+DROP TABLE IF EXISTS `user_onboarding_rewards`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `user_onboarding_rewards` (
+  `user_id` bigint(20) unsigned NOT NULL,
+  `asset` varchar(191) NOT NULL,
+  `amount` decimal(15,6) NOT NULL,
+  `granted_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `user_onboarding_steps`
+--
+
+DROP TABLE IF EXISTS `user_onboarding_steps`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `user_onboarding_steps` (
+  `user_id` bigint(20) unsigned NOT NULL,
+  `step` varchar(191) NOT NULL,
+  `completed_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`user_id`,`step`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `user_question_answers`
 --
@@ -2217,9 +3352,10 @@ DROP TABLE IF EXISTS `variable_change_logs`;
 /*!40101 SET character_set_client = utf8 */;
 CREATE TABLE `variable_change_logs` (
   `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `changer_id` bigint(20) unsigned DEFAULT NULL,
   `changer_name` varchar(191) NOT NULL,
-  `previous_value` int(11) NOT NULL,
-  `current_value` int(11) NOT NULL,
+  `previous_value` decimal(20,4) NOT NULL,
+  `current_value` decimal(20,4) NOT NULL,
   `note` text DEFAULT NULL,
   `created_at` timestamp NULL DEFAULT NULL,
   `updated_at` timestamp NULL DEFAULT NULL,
@@ -2305,6 +3441,7 @@ CREATE TABLE `videos` (
   `fileName` varchar(191) NOT NULL,
   `creator_code` varchar(191) NOT NULL,
   `image` varchar(191) NOT NULL,
+  `premium` tinyint(1) NOT NULL DEFAULT 0,
   `created_at` timestamp NULL DEFAULT NULL,
   `updated_at` timestamp NULL DEFAULT NULL,
   PRIMARY KEY (`id`),
@@ -2332,6 +3469,76 @@ CREATE TABLE `views` (
 ) ENGINE=InnoDB AUTO_INCREMENT=27510 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `wallet_adjustment_batches`
+--
+
+DROP TABLE IF EXISTS `wallet_adjustment_batches`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `wallet_adjustment_batches` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `reason` text NOT NULL,
+  `status` varchar(191) NOT NULL DEFAULT 'pending',
+  `created_by` bigint(20) unsigned NOT NULL,
+  `decided_by` bigint(20) unsigned DEFAULT NULL,
+  `decision_note` text DEFAULT NULL,
+  `entry_count` int(11) NOT NULL DEFAULT 0,
+  `decided_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `wallet_adjustment_batches_status_index` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `wallet_adjustment_entries`
+--
+
+DROP TABLE IF EXISTS `wallet_adjustment_entries`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `wallet_adjustment_entries` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `batch_id` bigint(20) unsigned NOT NULL,
+  `line` int(11) NOT NULL,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `asset` varchar(191) NOT NULL,
+  `amount` decimal(30,10) NOT NULL,
+  `transaction_id` varchar(191) DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `wallet_adjustment_entries_batch_id_index` (`batch_id`),
+  KEY `wallet_adjustment_entries_user_id_index` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `wallet_conversions`
+--
+
+DROP TABLE IF EXISTS `wallet_conversions`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `wallet_conversions` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `from_asset` varchar(191) NOT NULL,
+  `to_asset` varchar(191) NOT NULL,
+  `amount` decimal(30,10) NOT NULL,
+  `received` decimal(30,10) NOT NULL,
+  `fee` decimal(30,10) NOT NULL,
+  `rate` decimal(30,10) NOT NULL,
+  `fee_percent` decimal(5,2) NOT NULL,
+  `created_at` timestamp NULL DEFAULT NULL,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `wallet_conversions_user_id_created_at_index` (`user_id`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `wallets`
 --
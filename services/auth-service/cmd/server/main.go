@@ -3,11 +3,14 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -16,24 +19,32 @@ import (
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"metargb/auth-service/internal/handler"
 	"metargb/auth-service/internal/pubsub"
 	"metargb/auth-service/internal/repository"
 	"metargb/auth-service/internal/service"
-	notificationspb "metargb/shared/pb/notifications"
 	pb "metargb/shared/pb/auth"
+	notificationspb "metargb/shared/pb/notifications"
 	storagepb "metargb/shared/pb/storage"
+	"metargb/shared/pkg/auth"
+	"metargb/shared/pkg/buildinfo"
+	shareddb "metargb/shared/pkg/db"
+	"metargb/shared/pkg/dbsplit"
+	"metargb/shared/pkg/deadline"
+	"metargb/shared/pkg/debug"
+	"metargb/shared/pkg/devmode"
+	"metargb/shared/pkg/faultinject"
+	"metargb/shared/pkg/geoip"
+	healthprobe "metargb/shared/pkg/health"
+	"metargb/shared/pkg/jobs"
+	"metargb/shared/pkg/logger"
+	"metargb/shared/pkg/msgsize"
 )
 
 func main() {
-	// Panic recovery to catch any early failures
-	defer func() {
-		if r := recover(); r != nil {
-			log.Fatalf("Panic: %v", r)
-		}
-	}()
-
 	// Load environment variables from config.env
 	// Try multiple possible paths for config.env
 	configPaths := []string{
@@ -52,27 +63,45 @@ func main() {
 	}
 	if !configLoaded {
 		// Fallback to .env if config.env not found
-		if err2 := godotenv.Load(); err2 != nil {
-			log.Printf("Warning: config.env and .env files not found, using environment variables only")
+		configLoaded = godotenv.Load() == nil
+	}
+
+	// Initialize logger once the environment is loaded so LOG_LEVEL applies
+	log := logger.NewLogger("auth-service")
+	log.RedirectStdLog()
+
+	// SIGHUP toggles debug logging; AUTH_LOG_ADMIN_ADDR serves the level over HTTP
+	logCtx, stopLogControl := context.WithCancel(context.Background())
+	defer stopLogControl()
+	log.EnableLevelControl(logCtx)
+
+	// Panic recovery to catch any early failures
+	defer func() {
+		if r := recover(); r != nil {
+			log.Fatal("Panic", "panic", r)
 		}
+	}()
+
+	if !configLoaded {
+		log.Warn("config.env and .env files not found, using environment variables only")
 	}
 
 	// Database connection with proper UTF-8 encoding for Persian/Farsi text
 	// Using utf8mb4 charset for proper Persian/Farsi support
 	// interpolateParams=true helps with proper handling of multi-byte characters in parameterized queries
 	// Note: collation is not a valid DSN parameter - it's automatically set based on charset
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&loc=Local&tls=false&interpolateParams=true",
+	dsn := shareddb.ServiceDSN("auth-service", fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&loc=Local&tls=false&interpolateParams=true",
 		getEnv("DB_USER", "root"),
 		getEnv("DB_PASSWORD", ""),
 		getEnv("DB_HOST", "localhost"),
 		getEnv("DB_PORT", "3306"),
 		getEnv("DB_DATABASE", "metargb_db"),
-	)
+	))
 
 	// Parse DSN to get config
 	cfg, err := mysql.ParseDSN(dsn)
 	if err != nil {
-		log.Fatalf("Failed to parse DSN: %v", err)
+		log.Fatal("Failed to parse DSN", "error", err)
 	}
 
 	// Ensure charset is explicitly set to utf8mb4 in connection parameters
@@ -87,7 +116,7 @@ func main() {
 	// Create connector with proper charset configuration
 	connector, err := mysql.NewConnector(cfg)
 	if err != nil {
-		log.Fatalf("Failed to create connector: %v", err)
+		log.Fatal("Failed to create connector", "error", err)
 	}
 
 	// Open database using connector
@@ -100,25 +129,25 @@ func main() {
 	db.SetConnMaxLifetime(5 * time.Minute)
 	db.SetConnMaxIdleTime(5 * time.Minute)
 
-	// Test connection
+	// Wait for the database instead of crash looping while it is unavailable
+	if err := shareddb.PingWithRetry(context.Background(), db, shareddb.RetryPolicyFromEnv()); err != nil {
+		log.Fatal("Failed to ping database", "error", err)
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	if err := db.PingContext(ctx); err != nil {
-		log.Fatalf("Failed to ping database: %v", err)
-	}
-
 	// Explicitly set charset to UTF-8 for proper Persian/Farsi text handling
 	// SET NAMES sets character_set_client, character_set_connection, and character_set_results
 	// This ensures all queries return UTF-8 encoded strings
 	// Note: This is executed on the test connection; the connector config ensures all new connections use utf8mb4
 	if _, err := db.ExecContext(ctx, "SET NAMES utf8mb4 COLLATE utf8mb4_unicode_ci"); err != nil {
-		log.Printf("Warning: Failed to set charset to utf8mb4: %v", err)
+		log.Warn("Failed to set charset to utf8mb4", "error", err)
 	} else {
-		log.Println("Successfully set database charset to utf8mb4 for UTF-8/Persian text support")
+		log.Info("Successfully set database charset to utf8mb4 for UTF-8/Persian text support")
 	}
 
-	log.Println("Successfully connected to database")
+	log.Info("Successfully connected to database")
 
 	// Initialize Redis connection for caching and pub/sub
 	redisURL := getEnv("REDIS_URL", "")
@@ -139,7 +168,7 @@ func main() {
 	redisOpts, err := redis.ParseURL(redisURL)
 	if err != nil {
 		cancel()
-		log.Fatalf("Failed to parse Redis URL: %v", err)
+		log.Fatal("Failed to parse Redis URL", "error", err)
 	}
 	redisClient := redis.NewClient(redisOpts)
 
@@ -147,15 +176,15 @@ func main() {
 	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
 	if err := redisClient.Ping(ctx).Err(); err != nil {
 		cancel()
-		log.Fatalf("Failed to connect to Redis: %v", err)
+		log.Fatal("Failed to connect to Redis", "error", err)
 	}
 	cancel()
-	log.Println("Successfully connected to Redis")
+	log.Info("Successfully connected to Redis")
 
 	// Initialize Redis publisher for WebSocket broadcasting
 	redisPublisher, err := pubsub.NewRedisPublisher(redisURL)
 	if err != nil {
-		log.Fatalf("Failed to create Redis publisher: %v", err)
+		log.Fatal("Failed to create Redis publisher", "error", err)
 	}
 
 	// Initialize repositories
@@ -168,8 +197,30 @@ func main() {
 	citizenRepo := repository.NewCitizenRepository(db)
 	personalInfoRepo := repository.NewPersonalInfoRepository(db)
 	profilePhotoRepo := repository.NewProfilePhotoRepository(db)
+	photoUploadRepo := repository.NewPhotoUploadRepository(db)
 	settingsRepo := repository.NewSettingsRepository(db)
 	searchRepo := repository.NewSearchRepository(db)
+	presenceRepo := repository.NewPresenceRepository(db, redisClient)
+
+	// Initialize notifications clients (optional - service can work without them)
+	var smsClient notificationspb.SMSServiceClient
+	var notificationClient notificationspb.NotificationServiceClient
+	var emailClient notificationspb.EmailServiceClient
+	notificationsAddr := getEnv("NOTIFICATIONS_SERVICE_ADDR", "notifications-service:50058")
+	notificationsConn, err := grpc.Dial(notificationsAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Warn("Failed to connect to notifications service - continuing without SMS support", "error", err)
+	} else {
+		defer notificationsConn.Close()
+		smsClient = notificationspb.NewSMSServiceClient(notificationsConn)
+		notificationClient = notificationspb.NewNotificationServiceClient(notificationsConn)
+		emailClient = notificationspb.NewEmailServiceClient(notificationsConn)
+		log.Info("Successfully connected to notifications service", "addr", notificationsAddr)
+	}
+
+	// Logins from a new device or IP raise an in-app and SMS alert
+	loginAlertRepo := repository.NewLoginAlertRepository(db)
+	loginAlertService := service.NewLoginAlertService(loginAlertRepo, tokenRepo, notificationClient, smsClient)
 
 	// Initialize observer service for activity tracking and events
 	observerService := service.NewObserverServiceWithSettings(
@@ -177,6 +228,7 @@ func main() {
 		settingsRepo,
 		activityRepo,
 		redisPublisher,
+		loginAlertService,
 	)
 
 	// Initialize helper service for cross-service integrations
@@ -186,20 +238,20 @@ func main() {
 		getEnv("COMMERCIAL_SERVICE_ADDR", "commercial-service:50052"),
 	)
 
-	// Initialize notifications SMS client (optional - service can work without it)
-	var smsClient notificationspb.SMSServiceClient
-	notificationsAddr := getEnv("NOTIFICATIONS_SERVICE_ADDR", "notifications-service:50058")
-	notificationsConn, err := grpc.Dial(notificationsAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		log.Printf("Warning: Failed to connect to notifications service: %v (continuing without SMS support)", err)
-	} else {
-		defer notificationsConn.Close()
-		smsClient = notificationspb.NewSMSServiceClient(notificationsConn)
-		log.Println("Successfully connected to notifications service")
+	// GetMe snapshots are dropped when the user's settings, KYC, photos or
+	// notifications change, and kept at most ME_CACHE_TTL otherwise
+	meCacheTTL := service.DefaultMeCacheTTL
+	if v := getEnv("ME_CACHE_TTL", ""); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			meCacheTTL = d
+		} else {
+			log.Warn("Invalid ME_CACHE_TTL, using default", "value", v, "default", meCacheTTL)
+		}
 	}
+	meCache := service.NewMeCache(repository.NewMeCacheRepository(redisClient), meCacheTTL)
 
 	// Initialize services
-	authService := service.NewAuthService(
+	authService := service.NewAuthServiceWithCache(
 		userRepo,
 		tokenRepo,
 		cacheRepo,
@@ -207,6 +259,7 @@ func main() {
 		activityRepo,
 		observerService,
 		helperService,
+		meCache,
 		smsClient,
 		getEnv("OAUTH_SERVER_URL", ""),
 		getEnv("OAUTH_CLIENT_ID", ""),
@@ -214,63 +267,147 @@ func main() {
 		getEnv("APP_URL", "http://localhost:8000"),
 		getEnv("FRONT_END_URL", "http://localhost:3000"),
 	)
+	// Magic links stay disabled until MAGIC_LINK_SECRET is set
+	magicLinkService := service.NewMagicLinkService(
+		userRepo,
+		tokenRepo,
+		repository.NewMagicLinkRepository(redisClient),
+		observerService,
+		emailClient,
+		getEnv("MAGIC_LINK_SECRET", ""),
+		getEnv("APP_URL", "http://localhost:8000"),
+		getEnv("FRONT_END_URL", "http://localhost:3000"),
+		strings.Split(getEnv("BACK_URL_ALLOWED_ORIGINS", ""), ","),
+	)
+	// Verification emails stay disabled until EMAIL_VERIFICATION_SECRET is set
+	emailVerificationService := service.NewEmailVerificationService(
+		userRepo,
+		repository.NewEmailVerificationRepository(redisClient),
+		emailClient,
+		getEnv("EMAIL_VERIFICATION_SECRET", ""),
+		getEnv("APP_URL", "http://localhost:8000"),
+		getEnv("FRONT_END_URL", "http://localhost:3000"),
+	)
+	// Presence is written by the websocket gateway and read from Redis
+	presenceService := service.NewPresenceService(presenceRepo)
 	// Initialize user service with all dependencies for Users API
 	userService := service.NewUserServiceWithDependencies(
 		userRepo,
 		kycRepo,
 		settingsRepo,
 		profilePhotoRepo,
+		presenceService,
 	)
-	kycService := service.NewKYCService(kycRepo, userRepo)
+	kycService := service.NewKYCServiceWithCache(kycRepo, userRepo, meCache)
 	citizenService := service.NewCitizenService(citizenRepo, userRepo)
 	personalInfoService := service.NewPersonalInfoService(personalInfoRepo)
 	profileLimitationRepo := repository.NewProfileLimitationRepository(db)
 	profileLimitationService := service.NewProfileLimitationService(profileLimitationRepo, userRepo)
-	settingsService := service.NewSettingsService(settingsRepo)
+	settingsService := service.NewSettingsServiceWithCache(settingsRepo, meCache)
+	apiKeyRepo := repository.NewAPIKeyRepository(db)
+	apiKeyService := service.NewAPIKeyService(apiKeyRepo, userRepo, parseUserIDs(getEnv("SERVICE_KEY_ADMIN_IDS", ""), log))
+	personalAccessTokenRepo := repository.NewPersonalAccessTokenRepository(db)
+	personalAccessTokenService := service.NewPersonalAccessTokenService(personalAccessTokenRepo, userRepo)
 
 	// Get API Gateway URL for profile photo URLs - ensure it's not empty
 	apiGatewayURL := getEnv("API_GATEWAY_URL", "")
 	if apiGatewayURL == "" {
 		apiGatewayURL = getEnv("APP_URL", "http://localhost:8000")
 	}
-	log.Printf("Profile photo service using API Gateway URL: %s", apiGatewayURL)
+	log.Info("Profile photo service using API Gateway URL", "url", apiGatewayURL)
 
 	// Initialize profile photo service (storage client can be added later when proto files are generated)
 	// For now, service works without storage client (files can be uploaded via HTTP endpoint)
-	profilePhotoService := service.NewProfilePhotoService(profilePhotoRepo, nil, apiGatewayURL)
+	profilePhotoService := service.NewProfilePhotoServiceWithCache(profilePhotoRepo, nil, apiGatewayURL, meCache)
 
 	// Initialize storage service client for profile photo uploads
 	storageServiceAddr := getEnv("STORAGE_SERVICE_ADDR", "storage-service:50060")
 	var storageClient storagepb.FileStorageServiceClient
 	storageConn, err := grpc.NewClient(storageServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
-		log.Printf("Warning: Failed to connect to storage service: %v (profile photo uploads will fail)", err)
+		log.Warn("Failed to connect to storage service - profile photo uploads will fail", "error", err)
 		storageClient = nil
 	} else {
 		defer storageConn.Close()
 		storageClient = storagepb.NewFileStorageServiceClient(storageConn)
-		log.Printf("Successfully connected to storage service at %s", storageServiceAddr)
+		log.Info("Successfully connected to storage service", "addr", storageServiceAddr)
 	}
 
 	// Initialize user events service
-	userEventsService := service.NewUserEventsService(activityRepo, userRepo)
+	userEventsService := service.NewUserEventsService(activityRepo, userRepo, parseUserIDs(getEnv("USER_EVENTS_EXPORT_ADMIN_IDS", ""), log))
+
+	// Users audit their recent logins; locations come from an optional GeoIP database
+	var ipLocator service.IPLocator
+	if path := getEnv("GEOIP_DB_PATH", ""); path != "" {
+		if geoDB, err := geoip.Open(path); err != nil {
+			log.Warn("Failed to load GeoIP database, login history will have no locations", "error", err, "path", path)
+		} else {
+			ipLocator = geoDB
+			log.Info("Loaded GeoIP database", "path", path, "ranges", geoDB.Len())
+		}
+	}
+	loginHistoryService := service.NewLoginHistoryService(repository.NewLoginHistoryRepository(db), ipLocator)
 
 	// Initialize search service
-	searchService := service.NewSearchService(searchRepo)
+	searchService := service.NewSearchServiceWithPresence(searchRepo, presenceService)
 
 	// Create gRPC server
-	grpcServer := grpc.NewServer()
+	limits := msgsize.FromEnv("auth-service", msgsize.Defaults())
+	serverOpts := append(limits.ServerOptions(),
+		grpc.ChainUnaryInterceptor(
+			logger.UnaryServerInterceptor(log),
+			// Internal methods such as StatsService.GetStats need a service API key
+			auth.ServiceUnaryServerInterceptor(handler.NewLocalAPIKeyValidator(apiKeyService)),
+		),
+		grpc.ChainStreamInterceptor(logger.StreamServerInterceptor(log)),
+	)
+
+	// Cap how long handlers run so slow queries are cancelled, see deadline.FromEnv
+	serverOpts = append(serverOpts, deadline.FromEnv("auth-service", deadline.Defaults()).ServerOptions()...)
+
+	// Staging builds inject latency and errors into chosen methods, see faultinject
+	faultCtx, stopFaults := context.WithCancel(context.Background())
+	defer stopFaults()
+	serverOpts = append(serverOpts, faultinject.FromEnv(faultCtx, "auth-service", log)...)
+
+	// Outside development internal errors reach callers only as a reference, see devmode
+	serverOpts = append(serverOpts, devmode.FromEnv("auth-service", log)...)
+
+	// Every response names the build that served it, see buildinfo
+	serverOpts = append(serverOpts, buildinfo.ServerOptions()...)
+	grpcServer := grpc.NewServer(serverOpts...)
+
+	// Report readiness over HTTP and through the standard gRPC health service:
+	// the database is reachable, its tables exist and the server has started
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	defer stopHealth()
+	dbMonitor := shareddb.NewHealthMonitor(db, nil)
+	dbMonitor.Start(healthCtx)
+	probe := healthprobe.NewProbe("auth-service").
+		AddCheck("database", dbMonitor.Check).
+		AddCheck("migrations", shareddb.NewSchemaGuard(db).RequireTables(dbsplit.Ownership["auth-service"]...))
+	serving := probe.Starting("grpc")
+	probe.Watch(healthCtx, healthServer, healthprobe.DefaultInterval)
+	probeServer := healthprobe.NewServer(":"+getEnv("HEALTH_PORT", healthprobe.DefaultPort), probe)
+	go func() {
+		if err := probeServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Health probe server failed", "error", err)
+		}
+	}()
 
 	// Create profile photo handler instance (needed by auth handler)
 	profilePhotoHandler := &handler.ProfilePhotoHandler{
 		ProfilePhotoService: profilePhotoService,
-		StorageClient:       storageClient,
+		UploadService:       service.NewProfilePhotoUploadService(photoUploadRepo),
 		ApiGatewayURL:       apiGatewayURL,
 	}
 
 	// Register handlers
-	handler.RegisterAuthHandler(grpcServer, authService, tokenRepo, profilePhotoHandler)
-	handler.RegisterUserHandler(grpcServer, userService, profileLimitationService, helperService)
+	handler.RegisterAuthHandler(grpcServer, authService, tokenRepo, profilePhotoHandler, getEnv("REQUIRE_EMAIL_VERIFICATION", "false") == "true")
+	handler.RegisterEmailVerificationHandler(grpcServer, emailVerificationService)
+	handler.RegisterUserHandler(grpcServer, userService, profileLimitationService, helperService, presenceService)
 	handler.RegisterKYCHandler(grpcServer, kycService, storageClient)
 	handler.RegisterCitizenHandler(grpcServer, citizenService)
 	handler.RegisterPersonalInfoHandler(grpcServer, personalInfoService)
@@ -280,31 +417,98 @@ func main() {
 	handler.RegisterSettingsHandler(grpcServer, settingsService)
 	handler.RegisterUserEventsHandler(grpcServer, userEventsService, userRepo)
 	handler.RegisterSearchHandler(grpcServer, searchService)
+	handler.RegisterAPIKeyHandler(grpcServer, apiKeyService)
+	handler.RegisterPersonalAccessTokenHandler(grpcServer, personalAccessTokenService)
+	handler.RegisterLoginAlertHandler(grpcServer, loginAlertService)
+	handler.RegisterLoginHistoryHandler(grpcServer, loginHistoryService)
+	handler.RegisterMagicLinkHandler(grpcServer, magicLinkService)
+	handler.RegisterStatsHandler(grpcServer, repository.NewStatsRepository(db))
+
+	// Remove tokens idle for longer than their owner's automatic_logout setting
+	sweepInterval := service.DefaultTokenSweepInterval
+	if v := getEnv("TOKEN_SWEEP_INTERVAL", ""); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			sweepInterval = d
+		} else {
+			log.Warn("Invalid TOKEN_SWEEP_INTERVAL, using default", "value", v, "default", sweepInterval)
+		}
+	}
+	sweepCtx, stopSweep := context.WithCancel(context.Background())
+	defer stopSweep()
+	// Background jobs are timed, counted and listed on jobs.Path
+	backgroundJobs := jobs.NewRunner("auth-service", log)
+	service.NewTokenSweeper(tokenRepo, sweepInterval).Register(backgroundJobs)
+
+	// Copy last_seen to the users table when users disconnect from the websocket gateway
+	service.NewPresenceRecorder(presenceRepo, 0).Start(sweepCtx)
+
+	// Drop the GetMe snapshot of users whose notifications were created or read
+	meCache.Start(sweepCtx)
+
+	// Resize and transfer queued profile photos to storage-service
+	photoInterval := service.DefaultProfilePhotoProcessInterval
+	if v := getEnv("PROFILE_PHOTO_PROCESS_INTERVAL", ""); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			photoInterval = d
+		} else {
+			log.Warn("Invalid PROFILE_PHOTO_PROCESS_INTERVAL, using default", "value", v, "default", photoInterval)
+		}
+	}
+	service.NewProfilePhotoProcessor(photoUploadRepo, profilePhotoService, storageClient, redisPublisher, notificationClient, photoInterval).Register(backgroundJobs)
+
+	// Delete user events past the retention period; kept forever when unset
+	if v := getEnv("USER_EVENTS_RETENTION_MONTHS", ""); v != "" {
+		retentionMonths, err := strconv.Atoi(v)
+		if err != nil || retentionMonths <= 0 {
+			log.Warn("Invalid USER_EVENTS_RETENTION_MONTHS, user events will not be purged", "value", v)
+		} else {
+			purgeInterval := service.DefaultUserEventPurgeInterval
+			if v := getEnv("USER_EVENTS_PURGE_INTERVAL", ""); v != "" {
+				if d, err := time.ParseDuration(v); err == nil {
+					purgeInterval = d
+				} else {
+					log.Warn("Invalid USER_EVENTS_PURGE_INTERVAL, using default", "value", v, "default", purgeInterval)
+				}
+			}
+			service.NewUserEventPurger(activityRepo, retentionMonths, purgeInterval).Register(backgroundJobs)
+		}
+	}
+	backgroundJobs.Start(sweepCtx)
+
+	// Reflection is only registered in development, see devmode
+	devmode.Register(grpcServer, log)
+
+	// Profiling endpoints, off unless DEBUG_ADDR is set or in development, see debug
+	debug.Serve(context.Background(), "auth-service", log)
 
 	// Start gRPC server
 	port := getEnv("GRPC_PORT", "50051")
 	listener, err := net.Listen("tcp", ":"+port)
 	if err != nil {
-		log.Fatalf("Failed to listen on port %s: %v", port, err)
+		log.Fatal("Failed to listen", "error", err, "port", port)
 	}
 
-	log.Printf("Auth service listening on port %s", port)
+	log.Info("Auth service listening", "port", port)
 
 	// Graceful shutdown
 	go func() {
 		if err := grpcServer.Serve(listener); err != nil {
-			log.Fatalf("Failed to serve: %v", err)
+			log.Fatal("Failed to serve", "error", err)
 		}
 	}()
+	serving()
 
 	// Wait for interrupt signal
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	log.Println("Shutting down server...")
+	log.Info("Shutting down server...")
+	probe.Drain()
+	healthServer.Shutdown()
 	grpcServer.GracefulStop()
-	log.Println("Server stopped")
+	probeServer.Close()
+	log.Info("Server stopped")
 }
 
 func getEnv(key, defaultValue string) string {
@@ -313,3 +517,21 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// parseUserIDs parses a comma separated list of user IDs, skipping invalid entries
+func parseUserIDs(value string, log *logger.Logger) []uint64 {
+	var ids []uint64
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			log.Warn("Ignoring invalid user id", "value", part)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/service"
+	pb "metargb/shared/pb/auth"
+	authpkg "metargb/shared/pkg/auth"
+)
+
+type apiKeyHandler struct {
+	pb.UnimplementedAPIKeyServiceServer
+	apiKeyService service.APIKeyService
+}
+
+func RegisterAPIKeyHandler(grpcServer *grpc.Server, apiKeyService service.APIKeyService) {
+	pb.RegisterAPIKeyServiceServer(grpcServer, &apiKeyHandler{
+		apiKeyService: apiKeyService,
+	})
+}
+
+func (h *apiKeyHandler) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.APIKeySecretResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	key, plainKey, err := h.apiKeyService.Create(ctx, req.UserId, req.Name, req.Scopes, req.RateLimitPerMinute, req.ExpiresAt)
+	if err != nil {
+		return nil, mapAPIKeyError(err)
+	}
+
+	return &pb.APIKeySecretResponse{
+		Data: convertAPIKeyToProto(key),
+		Key:  plainKey,
+	}, nil
+}
+
+func (h *apiKeyHandler) ListAPIKeys(ctx context.Context, req *pb.ListAPIKeysRequest) (*pb.ListAPIKeysResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	keys, err := h.apiKeyService.List(ctx, req.UserId)
+	if err != nil {
+		return nil, mapAPIKeyError(err)
+	}
+
+	data := make([]*pb.APIKey, 0, len(keys))
+	for _, key := range keys {
+		data = append(data, convertAPIKeyToProto(key))
+	}
+
+	return &pb.ListAPIKeysResponse{Data: data}, nil
+}
+
+func (h *apiKeyHandler) RotateAPIKey(ctx context.Context, req *pb.RotateAPIKeyRequest) (*pb.APIKeySecretResponse, error) {
+	if req.UserId == 0 || req.KeyId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and key_id are required")
+	}
+
+	key, plainKey, err := h.apiKeyService.Rotate(ctx, req.UserId, req.KeyId)
+	if err != nil {
+		return nil, mapAPIKeyError(err)
+	}
+
+	return &pb.APIKeySecretResponse{
+		Data: convertAPIKeyToProto(key),
+		Key:  plainKey,
+	}, nil
+}
+
+func (h *apiKeyHandler) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*emptypb.Empty, error) {
+	if req.UserId == 0 || req.KeyId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and key_id are required")
+	}
+
+	if err := h.apiKeyService.Revoke(ctx, req.UserId, req.KeyId); err != nil {
+		return nil, mapAPIKeyError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *apiKeyHandler) ValidateAPIKey(ctx context.Context, req *pb.ValidateAPIKeyRequest) (*pb.ValidateAPIKeyResponse, error) {
+	key, user, err := h.apiKeyService.Validate(ctx, req.Key)
+	if err != nil {
+		if errors.Is(err, service.ErrAPIKeyInvalid) {
+			return &pb.ValidateAPIKeyResponse{Valid: false}, nil
+		}
+		return nil, mapAPIKeyError(err)
+	}
+
+	return &pb.ValidateAPIKeyResponse{
+		Valid:              true,
+		KeyId:              key.ID,
+		UserId:             user.ID,
+		Email:              user.Email,
+		Scopes:             key.Scopes,
+		RateLimitPerMinute: key.RateLimitPerMinute,
+	}, nil
+}
+
+// LocalAPIKeyValidator validates API keys in process, for the shared auth
+// interceptors guarding auth-service's own internal methods
+type LocalAPIKeyValidator struct {
+	apiKeyService service.APIKeyService
+}
+
+func NewLocalAPIKeyValidator(apiKeyService service.APIKeyService) *LocalAPIKeyValidator {
+	return &LocalAPIKeyValidator{apiKeyService: apiKeyService}
+}
+
+// ValidateAPIKey implements auth.APIKeyValidator
+func (v *LocalAPIKeyValidator) ValidateAPIKey(ctx context.Context, key string) (*authpkg.UserContext, error) {
+	apiKey, user, err := v.apiKeyService.Validate(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return &authpkg.UserContext{
+		UserID:   user.ID,
+		Email:    user.Email,
+		APIKeyID: apiKey.ID,
+		Scopes:   apiKey.Scopes,
+	}, nil
+}
+
+// mapAPIKeyError maps service errors to gRPC status codes
+func mapAPIKeyError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.Is(err, service.ErrAPIKeyNotFound), errors.Is(err, service.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrAPIKeyNameRequired),
+		errors.Is(err, service.ErrAPIKeyNameTooLong),
+		errors.Is(err, service.ErrAPIKeyScopeInvalid),
+		errors.Is(err, service.ErrAPIKeyScopeUnknown),
+		errors.Is(err, service.ErrAPIKeyRateLimit),
+		errors.Is(err, service.ErrAPIKeyExpiresAt):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	case errors.Is(err, service.ErrAPIKeyRevoked):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	case errors.Is(err, service.ErrAPIKeyServiceScope):
+		return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case errors.Is(err, service.ErrAPIKeyLimitExceeded):
+		return status.Errorf(codes.ResourceExhausted, "%s", err.Error())
+	case errors.Is(err, service.ErrAPIKeyInvalid):
+		return status.Errorf(codes.Unauthenticated, "%s", err.Error())
+	default:
+		return status.Errorf(codes.Internal, "operation failed: %v", err)
+	}
+}
+
+// convertAPIKeyToProto converts an APIKey model to proto; the hash is never exposed
+func convertAPIKeyToProto(key *models.APIKey) *pb.APIKey {
+	return &pb.APIKey{
+		Id:                 key.ID,
+		Name:               key.Name,
+		Prefix:             key.Prefix,
+		Scopes:             key.Scopes,
+		RateLimitPerMinute: key.RateLimitPerMinute,
+		LastUsedAt:         formatAPIKeyTime(key.LastUsedAt),
+		ExpiresAt:          formatAPIKeyTime(key.ExpiresAt),
+		RevokedAt:          formatAPIKeyTime(key.RevokedAt),
+		CreatedAt:          key.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func formatAPIKeyTime(t sql.NullTime) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.Format(time.RFC3339)
+}
@@ -12,6 +12,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"metargb/auth-service/internal/models"
 	"metargb/auth-service/internal/repository"
 	"metargb/auth-service/internal/service"
 	pb "metargb/shared/pb/auth"
@@ -23,13 +24,16 @@ type authHandler struct {
 	authService         service.AuthService
 	tokenRepo           repository.TokenRepository
 	profilePhotoHandler *ProfilePhotoHandler
+	// requireVerifiedEmail makes GetMe ask users with an unverified email to verify it
+	requireVerifiedEmail bool
 }
 
-func RegisterAuthHandler(grpcServer *grpc.Server, authService service.AuthService, tokenRepo repository.TokenRepository, profilePhotoHandler *ProfilePhotoHandler) {
+func RegisterAuthHandler(grpcServer *grpc.Server, authService service.AuthService, tokenRepo repository.TokenRepository, profilePhotoHandler *ProfilePhotoHandler, requireVerifiedEmail bool) {
 	pb.RegisterAuthServiceServer(grpcServer, &authHandler{
-		authService:         authService,
-		tokenRepo:           tokenRepo,
-		profilePhotoHandler: profilePhotoHandler,
+		authService:          authService,
+		tokenRepo:            tokenRepo,
+		profilePhotoHandler:  profilePhotoHandler,
+		requireVerifiedEmail: requireVerifiedEmail,
 	})
 }
 
@@ -78,10 +82,13 @@ func (h *authHandler) Redirect(ctx context.Context, req *pb.RedirectRequest) (*p
 }
 
 func (h *authHandler) Callback(ctx context.Context, req *pb.CallbackRequest) (*pb.CallbackResponse, error) {
-	// Extract IP from gRPC metadata if available
-	ip := extractIPFromContext(ctx)
-	
-	result, err := h.authService.Callback(ctx, req.State, req.Code, ip)
+	// Prefer the client IP forwarded by the gateway, then gRPC metadata
+	ip := strings.TrimSpace(req.Ip)
+	if ip == "" {
+		ip = extractIPFromContext(ctx)
+	}
+
+	result, err := h.authService.Callback(ctx, req.State, req.Code, ip, strings.TrimSpace(req.UserAgent))
 	if err != nil {
 		// Map InvalidArgumentException to InvalidArgument status code
 		if strings.Contains(err.Error(), "invalid state value") {
@@ -106,7 +113,7 @@ func (h *authHandler) GetMe(ctx context.Context, req *pb.GetMeRequest) (*pb.User
 	// Default automatic_logout to 55 if 0 (matching Laravel: settings->automatic_logout ?: 55)
 	automaticLogout := userDetails.AutomaticLogout
 	if automaticLogout == 0 {
-		automaticLogout = 55
+		automaticLogout = models.DefaultAutomaticLogout
 	}
 
 	response := &pb.UserResponse{
@@ -122,6 +129,9 @@ func (h *authHandler) GetMe(ctx context.Context, req *pb.GetMeRequest) (*pb.User
 		HourlyProfitTimePercentage: userDetails.HourlyProfitTimePercentage,
 		VerifiedKyc:                userDetails.VerifiedKYC,
 		Birthdate:                  userDetails.Birthdate,
+		EmailVerified:              userDetails.EmailVerified,
+		PhoneVerified:              userDetails.PhoneVerified,
+		MustVerifyEmail:            h.requireVerifiedEmail && !userDetails.EmailVerified,
 		// Token and AccessToken are omitted to match Laravel AuthenticatedUserResource structure
 	}
 
@@ -138,7 +148,7 @@ func (h *authHandler) GetMe(ctx context.Context, req *pb.GetMeRequest) (*pb.User
 
 func (h *authHandler) Logout(ctx context.Context, req *pb.LogoutRequest) (*emptypb.Empty, error) {
 	// Validate token and get user
-	user, err := h.tokenRepo.ValidateToken(ctx, req.Token)
+	user, _, err := h.tokenRepo.ValidateToken(ctx, req.Token)
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
 	}
@@ -156,18 +166,24 @@ func (h *authHandler) Logout(ctx context.Context, req *pb.LogoutRequest) (*empty
 }
 
 func (h *authHandler) ValidateToken(ctx context.Context, req *pb.ValidateTokenRequest) (*pb.ValidateTokenResponse, error) {
-	user, err := h.authService.ValidateToken(ctx, req.Token)
+	user, token, err := h.authService.ValidateToken(ctx, req.Token)
 	if err != nil {
 		return &pb.ValidateTokenResponse{
 			Valid: false,
 		}, nil
 	}
 
-	return &pb.ValidateTokenResponse{
+	resp := &pb.ValidateTokenResponse{
 		Valid:  true,
 		UserId: user.ID,
 		Email:  user.Email,
-	}, nil
+		Scopes: token.Scopes,
+	}
+	// Scoped tokens without an expiry never expire
+	if !token.ExpiresAt.IsZero() {
+		resp.ExpiresAt = token.ExpiresAt.Unix()
+	}
+	return resp, nil
 }
 
 func (h *authHandler) RequestAccountSecurity(ctx context.Context, req *pb.RequestAccountSecurityRequest) (*emptypb.Empty, error) {
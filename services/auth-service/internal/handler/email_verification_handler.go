@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"metargb/auth-service/internal/service"
+	pb "metargb/shared/pb/auth"
+)
+
+type emailVerificationHandler struct {
+	pb.UnimplementedEmailVerificationServiceServer
+	emailVerificationService service.EmailVerificationService
+}
+
+func RegisterEmailVerificationHandler(grpcServer *grpc.Server, emailVerificationService service.EmailVerificationService) {
+	pb.RegisterEmailVerificationServiceServer(grpcServer, &emailVerificationHandler{
+		emailVerificationService: emailVerificationService,
+	})
+}
+
+// SendEmailVerification handles POST /api/email/verification-notification
+func (h *emailVerificationHandler) SendEmailVerification(ctx context.Context, req *pb.SendEmailVerificationRequest) (*emptypb.Empty, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := h.emailVerificationService.SendEmailVerification(ctx, req.UserId); err != nil {
+		return nil, mapEmailVerificationError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// VerifyEmail handles GET /api/email/verify/{id}/{hash}
+func (h *emailVerificationHandler) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error) {
+	if req.UserId == 0 || req.Hash == "" || req.Signature == "" || req.Expires == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id, hash, expires and signature are required")
+	}
+
+	alreadyVerified, err := h.emailVerificationService.VerifyEmail(ctx, req.UserId, req.Hash, req.Expires, req.Signature)
+	if err != nil {
+		return nil, mapEmailVerificationError(err)
+	}
+
+	return &pb.VerifyEmailResponse{
+		AlreadyVerified: alreadyVerified,
+		RedirectUrl:     h.emailVerificationService.RedirectURL(),
+	}, nil
+}
+
+func mapEmailVerificationError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrEmailVerificationRateLimited):
+		return status.Errorf(codes.ResourceExhausted, "%s", err.Error())
+	case errors.Is(err, service.ErrEmailVerificationInvalid),
+		errors.Is(err, service.ErrEmailVerificationExpired):
+		return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case errors.Is(err, service.ErrEmailAlreadyVerified):
+		return status.Errorf(codes.AlreadyExists, "%s", err.Error())
+	case errors.Is(err, service.ErrEmailVerificationDisabled),
+		errors.Is(err, service.ErrEmailRequired):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	default:
+		return status.Errorf(codes.Internal, "operation failed: %v", err)
+	}
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/service"
+	pb "metargb/shared/pb/auth"
+	"metargb/shared/pkg/helpers"
+)
+
+type loginAlertHandler struct {
+	pb.UnimplementedLoginAlertServiceServer
+	loginAlertService service.LoginAlertService
+}
+
+func RegisterLoginAlertHandler(grpcServer *grpc.Server, loginAlertService service.LoginAlertService) {
+	pb.RegisterLoginAlertServiceServer(grpcServer, &loginAlertHandler{
+		loginAlertService: loginAlertService,
+	})
+}
+
+// ListLoginAlerts handles GET /api/events/login-alerts
+func (h *loginAlertHandler) ListLoginAlerts(ctx context.Context, req *pb.ListLoginAlertsRequest) (*pb.ListLoginAlertsResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	alerts, err := h.loginAlertService.ListLoginAlerts(ctx, req.UserId, req.PendingOnly)
+	if err != nil {
+		return nil, mapLoginAlertError(err)
+	}
+
+	data := make([]*pb.LoginAlert, 0, len(alerts))
+	for _, alert := range alerts {
+		data = append(data, convertLoginAlertToProto(alert))
+	}
+
+	return &pb.ListLoginAlertsResponse{Data: data}, nil
+}
+
+// ConfirmLoginAlert handles POST /api/events/login-alerts/{alert}/confirm
+func (h *loginAlertHandler) ConfirmLoginAlert(ctx context.Context, req *pb.ConfirmLoginAlertRequest) (*pb.LoginAlertResponse, error) {
+	if req.UserId == 0 || req.AlertId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and alert_id are required")
+	}
+
+	alert, err := h.loginAlertService.ConfirmLoginAlert(ctx, req.UserId, req.AlertId, req.WasMe)
+	if err != nil {
+		return nil, mapLoginAlertError(err)
+	}
+
+	return &pb.LoginAlertResponse{Data: convertLoginAlertToProto(alert)}, nil
+}
+
+func mapLoginAlertError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrLoginAlertNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrLoginAlertAnswered):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	default:
+		return status.Errorf(codes.Internal, "operation failed: %v", err)
+	}
+}
+
+func convertLoginAlertToProto(alert *models.LoginAlert) *pb.LoginAlert {
+	return &pb.LoginAlert{
+		Id:      alert.ID,
+		EventId: alert.UserEventID,
+		Reason:  alert.Reason,
+		Ip:      alert.IP,
+		Device:  alert.Device,
+		Status:  alert.Status(),
+		Date:    helpers.FormatJalaliDate(alert.CreatedAt),
+		Time:    helpers.FormatJalaliTime(alert.CreatedAt),
+	}
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/service"
+	pb "metargb/shared/pb/auth"
+	"metargb/shared/pkg/helpers"
+)
+
+type loginHistoryHandler struct {
+	pb.UnimplementedLoginHistoryServiceServer
+	loginHistoryService service.LoginHistoryService
+}
+
+func RegisterLoginHistoryHandler(grpcServer *grpc.Server, loginHistoryService service.LoginHistoryService) {
+	pb.RegisterLoginHistoryServiceServer(grpcServer, &loginHistoryHandler{
+		loginHistoryService: loginHistoryService,
+	})
+}
+
+// GetLoginHistory handles GET /api/auth/login-history
+func (h *loginHistoryHandler) GetLoginHistory(ctx context.Context, req *pb.GetLoginHistoryRequest) (*pb.GetLoginHistoryResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+
+	entries, nextPageURL, prevPageURL, err := h.loginHistoryService.GetLoginHistory(ctx, req.UserId, page, req.PerPage)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get login history: %v", err)
+	}
+
+	data := make([]*pb.LoginHistoryEntry, 0, len(entries))
+	for _, entry := range entries {
+		data = append(data, convertLoginHistoryEntryToProto(entry))
+	}
+
+	return &pb.GetLoginHistoryResponse{
+		Data: data,
+		Pagination: &pb.PaginationMeta{
+			CurrentPage: page,
+			NextPageUrl: nextPageURL,
+			PrevPageUrl: prevPageURL,
+		},
+	}, nil
+}
+
+func convertLoginHistoryEntryToProto(entry *models.LoginHistoryEntry) *pb.LoginHistoryEntry {
+	resource := &pb.LoginHistoryEntry{
+		EventId: entry.EventID,
+		Ip:      entry.IP,
+		Device:  entry.Device,
+		Date:    helpers.FormatJalaliDate(entry.CreatedAt),
+		Time:    helpers.FormatJalaliTime(entry.CreatedAt),
+	}
+	if !entry.Location.IsZero() {
+		resource.Location = &pb.LoginLocation{
+			CountryCode: entry.Location.CountryCode,
+			Region:      entry.Location.Region,
+			City:        entry.Location.City,
+		}
+	}
+	if entry.Alert != nil {
+		resource.AlertReason = entry.Alert.Reason
+		resource.AlertStatus = entry.Alert.Status()
+	}
+	return resource
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"metargb/auth-service/internal/service"
+	pb "metargb/shared/pb/auth"
+)
+
+type magicLinkHandler struct {
+	pb.UnimplementedMagicLinkServiceServer
+	magicLinkService service.MagicLinkService
+}
+
+func RegisterMagicLinkHandler(grpcServer *grpc.Server, magicLinkService service.MagicLinkService) {
+	pb.RegisterMagicLinkServiceServer(grpcServer, &magicLinkHandler{
+		magicLinkService: magicLinkService,
+	})
+}
+
+// RequestMagicLink handles POST /api/auth/magic-link
+func (h *magicLinkHandler) RequestMagicLink(ctx context.Context, req *pb.RequestMagicLinkRequest) (*emptypb.Empty, error) {
+	email := strings.TrimSpace(req.Email)
+	if email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+	if _, err := mail.ParseAddress(email); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "email must be a valid email address")
+	}
+
+	ip := strings.TrimSpace(req.Ip)
+	if ip == "" {
+		ip = extractIPFromContext(ctx)
+	}
+
+	if err := h.magicLinkService.RequestMagicLink(ctx, email, strings.TrimSpace(req.BackUrl), ip, req.DeviceNonce); err != nil {
+		return nil, mapMagicLinkError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ConsumeMagicLink handles GET /api/auth/magic-link/callback
+func (h *magicLinkHandler) ConsumeMagicLink(ctx context.Context, req *pb.ConsumeMagicLinkRequest) (*pb.CallbackResponse, error) {
+	if req.Token == "" || req.Signature == "" || req.Expires == 0 {
+		return nil, status.Error(codes.InvalidArgument, "token, expires and signature are required")
+	}
+
+	ip := strings.TrimSpace(req.Ip)
+	if ip == "" {
+		ip = extractIPFromContext(ctx)
+	}
+
+	result, err := h.magicLinkService.ConsumeMagicLink(ctx, req.Token, req.Expires, req.Signature, ip, strings.TrimSpace(req.UserAgent), req.DeviceNonce)
+	if err != nil {
+		return nil, mapMagicLinkError(err)
+	}
+
+	return &pb.CallbackResponse{
+		Token:       result.Token,
+		ExpiresAt:   result.ExpiresAt,
+		RedirectUrl: result.RedirectURL,
+	}, nil
+}
+
+func mapMagicLinkError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrMagicLinkRateLimited):
+		return status.Errorf(codes.ResourceExhausted, "%s", err.Error())
+	case errors.Is(err, service.ErrMagicLinkInvalid),
+		errors.Is(err, service.ErrMagicLinkExpired),
+		errors.Is(err, service.ErrMagicLinkDeviceMismatch):
+		return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case errors.Is(err, service.ErrMagicLinkDeviceRequired),
+		errors.Is(err, service.ErrMagicLinkBackURL):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	case errors.Is(err, service.ErrMagicLinkDisabled):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	default:
+		return status.Errorf(codes.Internal, "operation failed: %v", err)
+	}
+}
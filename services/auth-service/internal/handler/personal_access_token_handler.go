@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/service"
+	pb "metargb/shared/pb/auth"
+)
+
+type personalAccessTokenHandler struct {
+	pb.UnimplementedPersonalAccessTokenServiceServer
+	tokenService service.PersonalAccessTokenService
+}
+
+func RegisterPersonalAccessTokenHandler(grpcServer *grpc.Server, tokenService service.PersonalAccessTokenService) {
+	pb.RegisterPersonalAccessTokenServiceServer(grpcServer, &personalAccessTokenHandler{
+		tokenService: tokenService,
+	})
+}
+
+func (h *personalAccessTokenHandler) ListTokenScopes(ctx context.Context, req *emptypb.Empty) (*pb.TokenScopesResponse, error) {
+	return &pb.TokenScopesResponse{Scopes: h.tokenService.Scopes()}, nil
+}
+
+func (h *personalAccessTokenHandler) CreatePersonalAccessToken(ctx context.Context, req *pb.CreatePersonalAccessTokenRequest) (*pb.PersonalAccessTokenSecretResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	token, plainToken, err := h.tokenService.Create(ctx, req.UserId, req.Name, req.Scopes, req.ExpiresAt)
+	if err != nil {
+		return nil, mapPersonalAccessTokenError(err)
+	}
+
+	return &pb.PersonalAccessTokenSecretResponse{
+		Data:  convertScopedTokenToProto(token),
+		Token: plainToken,
+	}, nil
+}
+
+func (h *personalAccessTokenHandler) ListPersonalAccessTokens(ctx context.Context, req *pb.ListPersonalAccessTokensRequest) (*pb.ListPersonalAccessTokensResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	tokens, err := h.tokenService.List(ctx, req.UserId)
+	if err != nil {
+		return nil, mapPersonalAccessTokenError(err)
+	}
+
+	data := make([]*pb.PersonalAccessToken, 0, len(tokens))
+	for _, token := range tokens {
+		data = append(data, convertScopedTokenToProto(token))
+	}
+
+	return &pb.ListPersonalAccessTokensResponse{Data: data}, nil
+}
+
+func (h *personalAccessTokenHandler) RevokePersonalAccessToken(ctx context.Context, req *pb.RevokePersonalAccessTokenRequest) (*emptypb.Empty, error) {
+	if req.UserId == 0 || req.TokenId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and token_id are required")
+	}
+
+	if err := h.tokenService.Revoke(ctx, req.UserId, req.TokenId); err != nil {
+		return nil, mapPersonalAccessTokenError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// mapPersonalAccessTokenError maps service errors to gRPC status codes
+func mapPersonalAccessTokenError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrPersonalAccessTokenNotFound), errors.Is(err, service.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrPersonalAccessTokenNameRequired),
+		errors.Is(err, service.ErrPersonalAccessTokenNameTooLong),
+		errors.Is(err, service.ErrPersonalAccessTokenScopeInvalid),
+		errors.Is(err, service.ErrPersonalAccessTokenExpiresAt):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	case errors.Is(err, service.ErrPersonalAccessTokenLimitExceeded):
+		return status.Errorf(codes.ResourceExhausted, "%s", err.Error())
+	default:
+		return status.Errorf(codes.Internal, "operation failed: %v", err)
+	}
+}
+
+func convertScopedTokenToProto(token *models.ScopedToken) *pb.PersonalAccessToken {
+	return &pb.PersonalAccessToken{
+		Id:         token.ID,
+		Name:       token.Name,
+		Scopes:     token.Scopes,
+		LastUsedAt: formatAPIKeyTime(token.LastUsedAt),
+		ExpiresAt:  formatAPIKeyTime(token.ExpiresAt),
+		CreatedAt:  token.CreatedAt.Format(time.RFC3339),
+	}
+}
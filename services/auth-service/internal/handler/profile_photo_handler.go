@@ -2,18 +2,16 @@ package handler
 
 import (
 	"context"
-	"fmt"
 	"strings"
-	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"metargb/auth-service/internal/models"
 	"metargb/auth-service/internal/service"
 	pb "metargb/shared/pb/auth"
-	storagepb "metargb/shared/pb/storage"
 )
 
 // ProfilePhotoHandler handles profile photo gRPC requests
@@ -21,14 +19,14 @@ import (
 type ProfilePhotoHandler struct {
 	pb.UnimplementedProfilePhotoServiceServer
 	ProfilePhotoService service.ProfilePhotoService
-	StorageClient       storagepb.FileStorageServiceClient
+	UploadService       service.ProfilePhotoUploadService
 	ApiGatewayURL       string
 }
 
-func RegisterProfilePhotoHandler(grpcServer *grpc.Server, profilePhotoService service.ProfilePhotoService, storageClient storagepb.FileStorageServiceClient, apiGatewayURL string) {
+func RegisterProfilePhotoHandler(grpcServer *grpc.Server, profilePhotoService service.ProfilePhotoService, uploadService service.ProfilePhotoUploadService, apiGatewayURL string) {
 	pb.RegisterProfilePhotoServiceServer(grpcServer, &ProfilePhotoHandler{
 		ProfilePhotoService: profilePhotoService,
-		StorageClient:       storageClient,
+		UploadService:       uploadService,
 		ApiGatewayURL:       apiGatewayURL,
 	})
 }
@@ -86,8 +84,9 @@ func (h *ProfilePhotoHandler) ListProfilePhotos(ctx context.Context, req *pb.Lis
 	return response, nil
 }
 
-// UploadProfilePhoto uploads a new profile photo for the authenticated user
-func (h *ProfilePhotoHandler) UploadProfilePhoto(ctx context.Context, req *pb.UploadProfilePhotoRequest) (*pb.ProfilePhotoResponse, error) {
+// UploadProfilePhoto queues a new profile photo for the authenticated user and
+// returns at once with the processing status
+func (h *ProfilePhotoHandler) UploadProfilePhoto(ctx context.Context, req *pb.UploadProfilePhotoRequest) (*pb.PhotoUploadStatusResponse, error) {
 	if req.UserId == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
 	}
@@ -122,76 +121,55 @@ func (h *ProfilePhotoHandler) UploadProfilePhoto(ctx context.Context, req *pb.Up
 		return nil, status.Errorf(codes.InvalidArgument, "invalid image: must be PNG or JPEG, ≤1 MB")
 	}
 
-	// Upload file to storage-service
-	if h.StorageClient == nil {
-		return nil, status.Errorf(codes.Internal, "storage service not available")
-	}
-
-	// Create upload ID for chunk upload
-	uploadID := fmt.Sprintf("profile_photo_%d_%d", req.UserId, time.Now().UnixNano())
-
-	// Upload file using ChunkUpload (single chunk since file is small)
-	chunkReq := &storagepb.ChunkUploadRequest{
-		UploadId:    uploadID,
-		ChunkData:   req.ImageData,
-		ChunkIndex:  0,
-		TotalChunks: 1,
-		Filename:    req.Filename,
-		ContentType: req.ContentType,
-		TotalSize:   int64(len(req.ImageData)),
-		UploadPath:  "/uploads/profile", // Upload path for profile photos
-	}
-
-	chunkResp, err := h.StorageClient.ChunkUpload(ctx, chunkReq)
+	// Processing and the transfer to storage-service happen in the background,
+	// see service.ProfilePhotoProcessor
+	upload, err := h.UploadService.QueueUpload(ctx, req.UserId, req.ImageData, req.Filename, req.ContentType)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to upload file to storage service: %v", err)
+		switch err {
+		case service.ErrInvalidImage, service.ErrImageRequired:
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to queue profile photo: %v", err)
+		}
 	}
 
-	if !chunkResp.Success {
-		return nil, status.Errorf(codes.Internal, "storage service upload failed: %s", chunkResp.Message)
-	}
+	return h.photoUploadStatus(upload), nil
+}
 
-	if !chunkResp.IsFinished {
-		return nil, status.Errorf(codes.Internal, "storage service upload did not complete")
+// GetPhotoStatus reports whether a queued profile photo upload is ready
+func (h *ProfilePhotoHandler) GetPhotoStatus(ctx context.Context, req *pb.GetPhotoStatusRequest) (*pb.PhotoUploadStatusResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
 	}
 
-	// Get file path from storage service response
-	// FileUrl contains the directory path (e.g., "uploads/image-jpeg/2024-01-01/")
-	// FilePath contains the filename
-	// Combine them to get the full path
-	dirPath := chunkResp.FileUrl
-	filename := chunkResp.FilePath
-	if filename == "" {
-		// Fallback to FinalFilename if FilePath is not set
-		filename = chunkResp.FinalFilename
+	if req.UploadId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "upload_id is required")
 	}
 
-	if dirPath == "" {
-		return nil, status.Errorf(codes.Internal, "storage service did not return file directory path")
-	}
-	if filename == "" {
-		return nil, status.Errorf(codes.Internal, "storage service did not return filename")
+	upload, err := h.UploadService.GetUploadStatus(ctx, req.UserId, req.UploadId)
+	if err != nil {
+		switch err {
+		case service.ErrPhotoUploadNotFound:
+			return nil, status.Errorf(codes.NotFound, "profile photo upload not found")
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to get profile photo upload: %v", err)
+		}
 	}
 
-	// Construct full path: directory + filename
-	fullPath := strings.TrimSuffix(dirPath, "/") + "/" + filename
+	return h.photoUploadStatus(upload), nil
+}
 
-	// Create database record with the full file path from storage-service
-	photo, err := h.ProfilePhotoService.CreateProfilePhotoRecord(ctx, req.UserId, fullPath)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create profile photo record: %v", err)
+func (h *ProfilePhotoHandler) photoUploadStatus(upload *models.ProfilePhotoUpload) *pb.PhotoUploadStatusResponse {
+	resp := &pb.PhotoUploadStatusResponse{
+		UploadId: upload.ID,
+		Status:   upload.Status,
+		Error:    upload.Error.String,
 	}
-
-	// URLs are now stored as full URLs in the database, but keep fallback for existing records
-	url := photo.URL
-	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
-		// Fallback: prepend gateway URL for old records with relative URLs
-		url = h.PrependGatewayURL(url)
+	if upload.ImageID.Valid {
+		resp.ProfilePhotoId = uint64(upload.ImageID.Int64)
+		resp.Url = h.PrependGatewayURL(upload.URL.String)
 	}
-	return &pb.ProfilePhotoResponse{
-		Id:  photo.ID,
-		Url: url,
-	}, nil
+	return resp
 }
 
 // GetProfilePhoto retrieves a profile photo by ID
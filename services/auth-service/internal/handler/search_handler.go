@@ -53,6 +53,9 @@ func (h *searchHandler) SearchUsers(ctx context.Context, req *pb.SearchUsersRequ
 		if result.Photo != nil {
 			pbResult.Photo = *result.Photo
 		}
+		if result.Presence != nil {
+			pbResult.Presence = presenceToProto(result.Presence)
+		}
 
 		pbResults = append(pbResults, pbResult)
 	}
@@ -10,6 +10,7 @@ import (
 
 	"metargb/auth-service/internal/service"
 	pb "metargb/shared/pb/auth"
+	"metargb/shared/pkg/tz"
 )
 
 type settingsHandler struct {
@@ -33,6 +34,7 @@ func (h *settingsHandler) GetSettings(ctx context.Context, req *pb.GetSettingsRe
 		Data: &pb.SettingsData{
 			CheckoutDaysCount: settings.CheckoutDaysCount,
 			AutomaticLogout:   settings.AutomaticLogout,
+			Timezone:          tz.Load(settings.Timezone).String(),
 		},
 	}, nil
 }
@@ -67,10 +69,18 @@ func (h *settingsHandler) UpdateSettings(ctx context.Context, req *pb.UpdateSett
 		statusVal = &val
 	}
 
+	// Checked up front so an invalid timezone leaves the other settings unchanged
+	if req.Timezone != "" && !tz.Valid(req.Timezone) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", service.ErrInvalidTimezone)
+	}
+
 	err := h.settingsService.UpdateSettings(ctx, req.UserId, checkoutDaysCount, automaticLogout, setting, statusVal)
+	if err == nil && req.Timezone != "" {
+		err = h.settingsService.UpdateTimezone(ctx, req.UserId, req.Timezone)
+	}
 	if err != nil {
 		switch err {
-		case service.ErrInvalidCheckoutDays, service.ErrInvalidAutomaticLogout, service.ErrInvalidProfileSetting, service.ErrMissingRequiredFields:
+		case service.ErrInvalidCheckoutDays, service.ErrInvalidAutomaticLogout, service.ErrInvalidProfileSetting, service.ErrMissingRequiredFields, service.ErrInvalidTimezone:
 			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 		default:
 			return nil, status.Errorf(codes.Internal, "failed to update settings: %v", err)
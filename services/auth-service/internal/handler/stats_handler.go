@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"metargb/auth-service/internal/repository"
+	pb "metargb/shared/pb/stats"
+)
+
+type statsHandler struct {
+	pb.UnimplementedStatsServiceServer
+	statsRepo repository.StatsRepository
+}
+
+func RegisterStatsHandler(grpcServer *grpc.Server, statsRepo repository.StatsRepository) {
+	pb.RegisterStatsServiceServer(grpcServer, &statsHandler{
+		statsRepo: statsRepo,
+	})
+}
+
+// GetStats reports user figures for the scheduled admin reports
+func (h *statsHandler) GetStats(ctx context.Context, req *pb.GetStatsRequest) (*pb.GetStatsResponse, error) {
+	if req.From <= 0 || req.To <= req.From {
+		return nil, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+
+	newUsers, err := h.statsRepo.CountNewUsers(ctx, time.Unix(req.From, 0), time.Unix(req.To, 0))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get stats: %v", err)
+	}
+
+	return &pb.GetStatsResponse{
+		Metrics: []*pb.Metric{
+			{Key: "new_users", Value: float64(newUsers)},
+		},
+	}, nil
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -190,6 +191,58 @@ func (h *userEventsHandler) CloseEventReport(ctx context.Context, req *pb.CloseE
 	return &emptypb.Empty{}, nil
 }
 
+// userEventExportChunkSize is the size of the CSV chunks streamed by ExportUserEvents
+const userEventExportChunkSize = 64 << 10
+
+// ExportUserEvents handles GET /api/events/export
+func (h *userEventsHandler) ExportUserEvents(req *pb.ExportUserEventsRequest, stream pb.UserEventsService_ExportUserEventsServer) error {
+	if req.RequesterId == 0 {
+		return status.Errorf(codes.Unauthenticated, "authentication required")
+	}
+
+	writer := &exportChunkWriter{stream: stream}
+	err := h.service.ExportUserEvents(stream.Context(), req.RequesterId, req.UserId, req.FromDate, req.ToDate, writer)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUserEventExportDenied):
+			return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+		case errors.Is(err, service.ErrInvalidExportDateRange):
+			return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		default:
+			return status.Errorf(codes.Internal, "failed to export user events: %v", err)
+		}
+	}
+
+	return writer.flush()
+}
+
+// exportChunkWriter buffers the CSV written by the service and sends it to the
+// stream in chunks of userEventExportChunkSize
+type exportChunkWriter struct {
+	stream pb.UserEventsService_ExportUserEventsServer
+	buf    []byte
+}
+
+func (w *exportChunkWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= userEventExportChunkSize {
+		if err := w.stream.Send(&pb.UserEventsExportChunk{Data: w.buf[:userEventExportChunkSize]}); err != nil {
+			return 0, err
+		}
+		w.buf = append([]byte(nil), w.buf[userEventExportChunkSize:]...)
+	}
+	return len(p), nil
+}
+
+func (w *exportChunkWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	err := w.stream.Send(&pb.UserEventsExportChunk{Data: w.buf})
+	w.buf = nil
+	return err
+}
+
 // Helper functions to convert models to proto resources
 
 func (h *userEventsHandler) convertUserEventToResource(event *models.UserEvent, includeReport bool) *pb.UserEventResource {
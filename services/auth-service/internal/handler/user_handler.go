@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -20,13 +21,15 @@ type userHandler struct {
 	userService              service.UserService
 	profileLimitationService service.ProfileLimitationService
 	helperService            service.HelperService
+	presenceService          service.PresenceService
 }
 
-func RegisterUserHandler(grpcServer *grpc.Server, userService service.UserService, profileLimitationService service.ProfileLimitationService, helperService service.HelperService) {
+func RegisterUserHandler(grpcServer *grpc.Server, userService service.UserService, profileLimitationService service.ProfileLimitationService, helperService service.HelperService, presenceService service.PresenceService) {
 	pb.RegisterUserServiceServer(grpcServer, &userHandler{
 		userService:              userService,
 		profileLimitationService: profileLimitationService,
 		helperService:            helperService,
+		presenceService:          presenceService,
 	})
 }
 
@@ -366,6 +369,40 @@ func (h *userHandler) GetUserProfile(ctx context.Context, req *pb.GetUserProfile
 		response.Data.FollowingCount = *profileData.FollowingCount
 	}
 
+	// Set presence unless the user hides their status
+	if profileData.Presence != nil {
+		response.Data.Presence = presenceToProto(profileData.Presence)
+	}
+
+	return response, nil
+}
+
+// GetPresence reports whether users are online for other services
+func (h *userHandler) GetPresence(ctx context.Context, req *pb.GetPresenceRequest) (*pb.GetPresenceResponse, error) {
+	if h.presenceService == nil {
+		return nil, status.Errorf(codes.Unimplemented, "presence service not available")
+	}
+
+	presence, err := h.presenceService.GetPresence(ctx, req.UserIds)
+	if err != nil {
+		if errors.Is(err, service.ErrTooManyPresenceUserIDs) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get presence: %v", err)
+	}
+
+	response := &pb.GetPresenceResponse{
+		Data: make([]*pb.UserPresence, 0, len(presence)),
+	}
+	for _, p := range presence {
+		// Users who hide their status always look offline
+		if p.Hidden {
+			response.Data = append(response.Data, &pb.UserPresence{UserId: p.UserID})
+			continue
+		}
+		response.Data = append(response.Data, presenceToProto(p))
+	}
+
 	return response, nil
 }
 
@@ -390,6 +427,29 @@ func (h *userHandler) GetUserFeaturesCount(ctx context.Context, req *pb.GetUserF
 	return response, nil
 }
 
+func (h *userHandler) GetUserInfo(ctx context.Context, req *pb.GetUserInfoRequest) (*pb.UserInfo, error) {
+	info, err := h.userService.GetUserInfo(ctx, req.UserId, req.Code)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get user info: %v", err)
+	}
+
+	response := &pb.UserInfo{
+		Id:                 info.ID,
+		Code:               info.Code,
+		Name:               info.Name,
+		WithdrawProfitDays: info.WithdrawProfitDays,
+		Timezone:           info.Timezone,
+	}
+	if info.Birthdate.Valid {
+		response.Birthdate = info.Birthdate.Time.Format("2006-01-02")
+	}
+
+	return response, nil
+}
+
 // convertProfileLimitationToProtoForUser converts a ProfileLimitation model to proto for user service
 func convertProfileLimitationToProtoForUser(limitation *models.ProfileLimitation, callerUserID uint64) *pb.ProfileLimitation {
 	proto := &pb.ProfileLimitation{
@@ -415,3 +475,14 @@ func convertProfileLimitationToProtoForUser(limitation *models.ProfileLimitation
 
 	return proto
 }
+
+func presenceToProto(p *models.Presence) *pb.UserPresence {
+	presence := &pb.UserPresence{
+		UserId: p.UserID,
+		Online: p.Online,
+	}
+	if p.LastSeen.Valid {
+		presence.LastSeen = timestamppb.New(p.LastSeen.Time)
+	}
+	return presence
+}
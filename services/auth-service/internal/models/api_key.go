@@ -0,0 +1,34 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// APIKey represents a machine credential used by server-to-server callers.
+// Only the SHA-256 hash of the key is persisted; the plain key is shown once.
+type APIKey struct {
+	ID                 uint64       `db:"id"`
+	UserID             uint64       `db:"user_id"`
+	Name               string       `db:"name"`
+	Prefix             string       `db:"prefix"`
+	KeyHash            string       `db:"key_hash"`
+	Scopes             []string     `db:"scopes"`
+	RateLimitPerMinute int32        `db:"rate_limit_per_minute"`
+	LastUsedAt         sql.NullTime `db:"last_used_at"`
+	ExpiresAt          sql.NullTime `db:"expires_at"`
+	RevokedAt          sql.NullTime `db:"revoked_at"`
+	CreatedAt          time.Time    `db:"created_at"`
+	UpdatedAt          time.Time    `db:"updated_at"`
+}
+
+// IsActive reports whether the key is neither revoked nor expired at the given time
+func (k *APIKey) IsActive(now time.Time) bool {
+	if k.RevokedAt.Valid {
+		return false
+	}
+	if k.ExpiresAt.Valid && !now.Before(k.ExpiresAt.Time) {
+		return false
+	}
+	return true
+}
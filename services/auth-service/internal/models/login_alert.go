@@ -0,0 +1,50 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Reasons a login raises an alert
+const (
+	LoginAlertReasonNewDevice = "new_device"
+	LoginAlertReasonNewIP     = "new_ip"
+)
+
+// UserDevice is a device fingerprint and IP pair a user has logged in from
+type UserDevice struct {
+	ID          uint64    `db:"id"`
+	UserID      uint64    `db:"user_id"`
+	Fingerprint string    `db:"fingerprint"`
+	IP          string    `db:"ip"`
+	UserAgent   string    `db:"user_agent"`
+	FirstSeenAt time.Time `db:"first_seen_at"`
+	LastSeenAt  time.Time `db:"last_seen_at"`
+}
+
+// LoginAlert records a login from a device or IP the user had not used before
+// and the user's answer to "was this you?"
+type LoginAlert struct {
+	ID          uint64       `db:"id"`
+	UserID      uint64       `db:"user_id"`
+	UserEventID uint64       `db:"user_event_id"`
+	Reason      string       `db:"reason"`
+	IP          string       `db:"ip"`
+	Device      string       `db:"device"`
+	Confirmed   sql.NullBool `db:"confirmed"` // NULL until the user answers
+	RespondedAt sql.NullTime `db:"responded_at"`
+	CreatedAt   time.Time    `db:"created_at"`
+	UpdatedAt   time.Time    `db:"updated_at"`
+}
+
+// Status returns "pending", "confirmed" or "denied"
+func (a *LoginAlert) Status() string {
+	switch {
+	case !a.Confirmed.Valid:
+		return "pending"
+	case a.Confirmed.Bool:
+		return "confirmed"
+	default:
+		return "denied"
+	}
+}
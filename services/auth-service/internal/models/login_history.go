@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"metargb/shared/pkg/geoip"
+)
+
+// UserEventLogin is the event recorded for every login
+const UserEventLogin = "ورود به حساب کاربری" // "Login to user account" in Persian
+
+// LoginHistoryEntry is a login of a user and, when the login came from a new
+// device or IP, the alert it raised
+type LoginHistoryEntry struct {
+	EventID   uint64
+	IP        string
+	Device    string
+	CreatedAt time.Time
+	Alert     *LoginAlert    // nil when the login raised no alert
+	Location  geoip.Location // Filled from the GeoIP database, if any
+}
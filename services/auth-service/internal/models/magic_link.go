@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// MagicLink is a pending password-less login sent to a user's email. It is
+// kept in Redis under the hash of its token until used or expired.
+type MagicLink struct {
+	UserID      uint64    `json:"user_id"`
+	Fingerprint string    `json:"fingerprint"` // Hash of the device nonce of the requesting browser
+	IP          string    `json:"ip"`
+	BackURL     string    `json:"back_url"`
+	CreatedAt   time.Time `json:"created_at"`
+}
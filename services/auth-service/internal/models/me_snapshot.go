@@ -0,0 +1,31 @@
+package models
+
+// MeSnapshot is the part of GetMe that is cached per user: the lookups that
+// only change when the user's settings, KYC, notifications, score or profile
+// photo do. The token, the users row and the time dependent values are always
+// read fresh.
+type MeSnapshot struct {
+	UserID uint64 `json:"user_id"`
+	// Generation is the user's cache generation the snapshot was built in.
+	// Invalidating a user bumps it, so snapshots built before are ignored.
+	Generation int64 `json:"generation"`
+	// Score is the user score Level and ScorePercentageToNextLevel were
+	// resolved for
+	Score                      int32    `json:"score"`
+	AutomaticLogout            int32    `json:"automatic_logout"`
+	Notifications              int32    `json:"notifications"`
+	VerifiedKYC                bool     `json:"verified_kyc"`
+	KYCName                    string   `json:"kyc_name,omitempty"`
+	Birthdate                  string   `json:"birthdate,omitempty"`
+	Level                      *MeLevel `json:"level,omitempty"`
+	ScorePercentageToNextLevel float64  `json:"score_percentage_to_next_level"`
+	Image                      string   `json:"image,omitempty"`
+}
+
+// MeLevel is the user's level as cached in a MeSnapshot
+type MeLevel struct {
+	ID          uint64 `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Score       int32  `json:"score"`
+}
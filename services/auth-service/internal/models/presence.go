@@ -0,0 +1,25 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Presence is whether a user has an open websocket connection and when they
+// were last seen. The websocket gateway keeps it in Redis and auth-service
+// copies last_seen to the users table when the user goes offline.
+type Presence struct {
+	UserID   uint64
+	Online   bool
+	LastSeen sql.NullTime
+	// Hidden is true when the user turned off the status setting
+	Hidden bool
+}
+
+// PresenceEvent is published on the presence channel whenever a user's first
+// connection opens or their last connection closes
+type PresenceEvent struct {
+	UserID   uint64    `json:"user_id"`
+	Online   bool      `json:"online"`
+	LastSeen time.Time `json:"last_seen"`
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Profile photo upload statuses
+const (
+	PhotoUploadProcessing = "processing"
+	PhotoUploadReady      = "ready"
+	PhotoUploadFailed     = "failed"
+)
+
+// ProfilePhotoUpload is a profile photo accepted from the user and waiting to
+// be resized, moderated and transferred to storage-service. The image row is
+// only created once the upload is ready.
+type ProfilePhotoUpload struct {
+	ID          uint64         `db:"id"`
+	UserID      uint64         `db:"user_id"`
+	Status      string         `db:"status"`
+	Filename    string         `db:"filename"`
+	ContentType string         `db:"content_type"`
+	ImageData   []byte         `db:"image_data"` // Cleared once processed
+	ImageID     sql.NullInt64  `db:"image_id"`   // Set once ready
+	URL         sql.NullString `db:"url"`        // Set once ready
+	Error       sql.NullString `db:"error"`      // Set once failed
+	Attempts    int            `db:"attempts"`
+	CreatedAt   time.Time      `db:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at"`
+}
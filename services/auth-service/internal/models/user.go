@@ -2,6 +2,8 @@ package models
 
 import (
 	"database/sql"
+	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -39,6 +41,41 @@ type PersonalAccessToken struct {
 	UpdatedAt     time.Time    `db:"updated_at"`
 }
 
+// ScopedToken is a personal access token limited to chosen scopes, as listed
+// to its owner. Login tokens carry every scope and are not listed.
+type ScopedToken struct {
+	ID         uint64
+	UserID     uint64
+	Name       string
+	Scopes     []string
+	LastUsedAt sql.NullTime
+	ExpiresAt  sql.NullTime
+	CreatedAt  time.Time
+}
+
+// ValidatedToken is what token validation learns about a token besides its user
+type ValidatedToken struct {
+	ID        uint64
+	ExpiresAt time.Time // When the token expires unless it is used again
+	Scopes    []string
+}
+
+// TokenAbilitiesAll is the abilities column of tokens that carry every scope
+const TokenAbilitiesAll = `["*"]`
+
+// ParseTokenAbilities decodes a personal_access_tokens.abilities column.
+// Tokens without abilities predate scopes and carry every scope.
+func ParseTokenAbilities(abilities sql.NullString) ([]string, error) {
+	if !abilities.Valid || abilities.String == "" {
+		return []string{"*"}, nil
+	}
+	var scopes []string
+	if err := json.Unmarshal([]byte(abilities.String), &scopes); err != nil {
+		return nil, fmt.Errorf("invalid token abilities: %w", err)
+	}
+	return scopes, nil
+}
+
 type KYC struct {
 	ID           uint64         `db:"id"`
 	UserID       uint64         `db:"user_id"`
@@ -76,6 +113,10 @@ func (k *KYC) Approved() bool {
 	return k.Status == 1
 }
 
+// DefaultAutomaticLogout is the idle timeout in minutes used when a user has
+// no automatic_logout setting (Laravel: settings->automatic_logout ?: 55)
+const DefaultAutomaticLogout = 55
+
 type Settings struct {
 	ID                uint64          `db:"id"`
 	UserID            uint64          `db:"user_id"`
@@ -86,6 +127,7 @@ type Settings struct {
 	AutomaticLogout   int32           `db:"automatic_logout"`
 	Privacy           map[string]int  `db:"privacy"`       // JSON: key -> 0|1 (0=private, 1=public)
 	Notifications     map[string]bool `db:"notifications"` // JSON: channel -> bool
+	Timezone          string          `db:"timezone"`      // IANA name, empty until the user chooses one
 	CreatedAt         time.Time       `db:"created_at"`
 	UpdatedAt         time.Time       `db:"updated_at"`
 }
@@ -268,6 +310,14 @@ type UserEvent struct {
 	UpdatedAt time.Time `db:"updated_at"`
 }
 
+// UserEventExportFilter selects the user events of a compliance export. Zero
+// values leave a filter out; From is inclusive and To is exclusive.
+type UserEventExportFilter struct {
+	UserID uint64
+	From   time.Time
+	To     time.Time
+}
+
 // UserEventReport represents a report for a user event
 type UserEventReport struct {
 	ID                uint64         `db:"id"`
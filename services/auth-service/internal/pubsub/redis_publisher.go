@@ -12,6 +12,7 @@ import (
 // RedisPublisher handles publishing events to Redis for WebSocket broadcasting
 type RedisPublisher interface {
 	PublishUserStatusChanged(ctx context.Context, userID uint64, online bool) error
+	PublishProfilePhotoStatus(ctx context.Context, event ProfilePhotoStatusEvent) error
 	Close() error
 }
 
@@ -74,6 +75,31 @@ func (p *redisPublisher) PublishUserStatusChanged(ctx context.Context, userID ui
 	return nil
 }
 
+// ProfilePhotoStatusEvent reports that a queued profile photo upload finished processing
+type ProfilePhotoStatusEvent struct {
+	UserID         uint64 `json:"user_id"`
+	UploadID       uint64 `json:"upload_id"`
+	Status         string `json:"status"`
+	ProfilePhotoID uint64 `json:"profile_photo_id,omitempty"`
+	URL            string `json:"url,omitempty"`
+	Error          string `json:"error,omitempty"`
+}
+
+// PublishProfilePhotoStatus publishes a profile photo upload result to Redis
+// The WebSocket gateway forwards it to the uploading user's sockets
+func (p *redisPublisher) PublishProfilePhotoStatus(ctx context.Context, event ProfilePhotoStatusEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	if err := p.client.Publish(ctx, "profile-photo-status", payload).Err(); err != nil {
+		return fmt.Errorf("failed to publish to Redis: %w", err)
+	}
+
+	return nil
+}
+
 // Close closes the Redis connection
 func (p *redisPublisher) Close() error {
 	return p.client.Close()
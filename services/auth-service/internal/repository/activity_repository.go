@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"metargb/auth-service/internal/models"
@@ -14,6 +15,8 @@ type ActivityRepository interface {
 	CreateUserEvent(ctx context.Context, event *models.UserEvent) error
 	GetUserEventsByUserID(ctx context.Context, userID uint64, page int32) ([]*models.UserEvent, error)
 	GetUserEventByID(ctx context.Context, userID, eventID uint64) (*models.UserEvent, error)
+	StreamUserEvents(ctx context.Context, filter models.UserEventExportFilter, fn func(*models.UserEvent) error) error
+	PurgeUserEvents(ctx context.Context, before time.Time, limit int) (int64, error)
 
 	// User Event Reports
 	CreateUserEventReport(ctx context.Context, report *models.UserEventReport) error
@@ -130,6 +133,124 @@ func (r *activityRepository) GetUserEventByID(ctx context.Context, userID, event
 	return event, nil
 }
 
+// StreamUserEvents calls fn for every event matching the filter in id order,
+// without loading them all into memory. An error from fn stops the iteration.
+func (r *activityRepository) StreamUserEvents(ctx context.Context, filter models.UserEventExportFilter, fn func(*models.UserEvent) error) error {
+	query := `
+		SELECT id, user_id, event, ip, device, status, created_at, updated_at
+		FROM user_events
+		WHERE 1 = 1
+	`
+	var args []interface{}
+	if filter.UserID != 0 {
+		query += " AND user_id = ?"
+		args = append(args, filter.UserID)
+	}
+	if !filter.From.IsZero() {
+		query += " AND created_at >= ?"
+		args = append(args, filter.From)
+	}
+	if !filter.To.IsZero() {
+		query += " AND created_at < ?"
+		args = append(args, filter.To)
+	}
+	query += " ORDER BY id"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to export user events: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		event := &models.UserEvent{}
+		err := rows.Scan(
+			&event.ID, &event.UserID, &event.Event, &event.IP,
+			&event.Device, &event.Status, &event.CreatedAt, &event.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan user event: %w", err)
+		}
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate user events: %w", err)
+	}
+
+	return nil
+}
+
+// PurgeUserEvents deletes up to limit events created before the cutoff, with
+// their closed reports and report responses. Events with an open report are
+// kept until the report is closed. It returns how many events were deleted.
+func (r *activityRepository) PurgeUserEvents(ctx context.Context, before time.Time, limit int) (int64, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT e.id
+		FROM user_events e
+		WHERE e.created_at < ?
+			AND NOT EXISTS (
+				SELECT 1 FROM user_event_reports r WHERE r.user_event_id = e.id AND r.closed = 0
+			)
+		ORDER BY e.id
+		LIMIT ?
+	`, before, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find expired user events: %w", err)
+	}
+	var ids []interface{}
+	for rows.Next() {
+		var id uint64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan expired user event: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to iterate expired user events: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE rr FROM user_event_report_responses rr
+		INNER JOIN user_event_reports r ON r.id = rr.user_event_report_id
+		WHERE r.user_event_id IN (`+placeholders+`)
+	`, ids...); err != nil {
+		return 0, fmt.Errorf("failed to delete user event report responses: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_event_reports WHERE user_event_id IN (`+placeholders+`)`, ids...); err != nil {
+		return 0, fmt.Errorf("failed to delete user event reports: %w", err)
+	}
+	result, err := tx.ExecContext(ctx, `DELETE FROM user_events WHERE id IN (`+placeholders+`)`, ids...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete user events: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get deleted user events: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit user event purge: %w", err)
+	}
+
+	return deleted, nil
+}
+
 // CreateUserEventReport creates a new user event report
 func (r *activityRepository) CreateUserEventReport(ctx context.Context, report *models.UserEventReport) error {
 	query := `
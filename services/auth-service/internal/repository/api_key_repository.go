@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"metargb/auth-service/internal/models"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *models.APIKey) error
+	FindByID(ctx context.Context, id uint64) (*models.APIKey, error)
+	FindByHash(ctx context.Context, keyHash string) (*models.APIKey, error)
+	ListByUserID(ctx context.Context, userID uint64) ([]*models.APIKey, error)
+	UpdateSecret(ctx context.Context, id uint64, prefix, keyHash string) error
+	Revoke(ctx context.Context, id uint64) error
+	TouchLastUsed(ctx context.Context, id uint64) error
+}
+
+type apiKeyRepository struct {
+	db *sql.DB
+}
+
+func NewAPIKeyRepository(db *sql.DB) APIKeyRepository {
+	return &apiKeyRepository{db: db}
+}
+
+const apiKeyColumns = `id, user_id, name, prefix, key_hash, scopes, rate_limit_per_minute,
+			last_used_at, expires_at, revoked_at, created_at, updated_at`
+
+func (r *apiKeyRepository) Create(ctx context.Context, key *models.APIKey) error {
+	scopesJSON, err := json.Marshal(key.Scopes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal api key scopes: %w", err)
+	}
+
+	query := `
+		INSERT INTO api_keys (user_id, name, prefix, key_hash, scopes, rate_limit_per_minute, expires_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		key.UserID,
+		key.Name,
+		key.Prefix,
+		key.KeyHash,
+		string(scopesJSON),
+		key.RateLimitPerMinute,
+		key.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get api key id: %w", err)
+	}
+	key.ID = uint64(id)
+
+	return nil
+}
+
+func (r *apiKeyRepository) FindByID(ctx context.Context, id uint64) (*models.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE id = ? LIMIT 1`
+	return r.scanOne(r.db.QueryRowContext(ctx, query, id))
+}
+
+func (r *apiKeyRepository) FindByHash(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE key_hash = ? LIMIT 1`
+	return r.scanOne(r.db.QueryRowContext(ctx, query, keyHash))
+}
+
+func (r *apiKeyRepository) ListByUserID(ctx context.Context, userID uint64) ([]*models.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE user_id = ? ORDER BY created_at DESC, id DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []*models.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan api key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate api keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+func (r *apiKeyRepository) UpdateSecret(ctx context.Context, id uint64, prefix, keyHash string) error {
+	query := `UPDATE api_keys SET prefix = ?, key_hash = ?, last_used_at = NULL, updated_at = NOW() WHERE id = ? AND revoked_at IS NULL`
+
+	if _, err := r.db.ExecContext(ctx, query, prefix, keyHash, id); err != nil {
+		return fmt.Errorf("failed to rotate api key: %w", err)
+	}
+	return nil
+}
+
+func (r *apiKeyRepository) Revoke(ctx context.Context, id uint64) error {
+	query := `UPDATE api_keys SET revoked_at = NOW(), updated_at = NOW() WHERE id = ? AND revoked_at IS NULL`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	return nil
+}
+
+// TouchLastUsed records a use of the key at most once a minute, so a busy key
+// does not rewrite its row on every request
+func (r *apiKeyRepository) TouchLastUsed(ctx context.Context, id uint64) error {
+	query := `UPDATE api_keys SET last_used_at = NOW()
+		WHERE id = ? AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL 1 MINUTE)`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to update api key last used: %w", err)
+	}
+	return nil
+}
+
+func (r *apiKeyRepository) scanOne(row *sql.Row) (*models.APIKey, error) {
+	key, err := scanAPIKey(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find api key: %w", err)
+	}
+	return key, nil
+}
+
+type apiKeyScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAPIKey(s apiKeyScanner) (*models.APIKey, error) {
+	key := &models.APIKey{}
+	var scopesJSON sql.NullString
+
+	if err := s.Scan(
+		&key.ID,
+		&key.UserID,
+		&key.Name,
+		&key.Prefix,
+		&key.KeyHash,
+		&scopesJSON,
+		&key.RateLimitPerMinute,
+		&key.LastUsedAt,
+		&key.ExpiresAt,
+		&key.RevokedAt,
+		&key.CreatedAt,
+		&key.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	key.Scopes = []string{}
+	if scopesJSON.Valid && scopesJSON.String != "" {
+		if err := json.Unmarshal([]byte(scopesJSON.String), &key.Scopes); err != nil {
+			return nil, fmt.Errorf("failed to parse api key scopes: %w", err)
+		}
+	}
+
+	return key, nil
+}
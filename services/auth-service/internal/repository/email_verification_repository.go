@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// EmailVerificationRepository counts verification emails sent to each user.
+// The links themselves are signed and need no storage.
+type EmailVerificationRepository interface {
+	// IncrementRequests counts a request against key and returns the count
+	// within the current window
+	IncrementRequests(ctx context.Context, key string, window time.Duration) (int64, error)
+}
+
+type emailVerificationRepository struct {
+	client *redis.Client
+}
+
+// NewEmailVerificationRepository creates a new email verification repository
+func NewEmailVerificationRepository(client *redis.Client) EmailVerificationRepository {
+	return &emailVerificationRepository{
+		client: client,
+	}
+}
+
+func (r *emailVerificationRepository) IncrementRequests(ctx context.Context, key string, window time.Duration) (int64, error) {
+	key = fmt.Sprintf("email_verification:requests:%s", key)
+
+	count, err := r.client.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count email verification requests: %w", err)
+	}
+	// The window starts with the first request
+	if count == 1 {
+		if err := r.client.Expire(ctx, key, window).Err(); err != nil {
+			return 0, fmt.Errorf("failed to set email verification request window: %w", err)
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"metargb/auth-service/internal/models"
+)
+
+type LoginAlertRepository interface {
+	// KnownLogin reports whether the user has any recorded device, and whether
+	// the fingerprint and the IP have each been seen before
+	KnownLogin(ctx context.Context, userID uint64, fingerprint, ip string) (hasDevices, deviceKnown, ipKnown bool, err error)
+	TouchDevice(ctx context.Context, device *models.UserDevice) error
+	CreateAlert(ctx context.Context, alert *models.LoginAlert) error
+	FindAlert(ctx context.Context, userID, alertID uint64) (*models.LoginAlert, error)
+	ListAlerts(ctx context.Context, userID uint64, pendingOnly bool) ([]*models.LoginAlert, error)
+	RespondAlert(ctx context.Context, alertID uint64, wasMe bool) error
+}
+
+type loginAlertRepository struct {
+	db *sql.DB
+}
+
+func NewLoginAlertRepository(db *sql.DB) LoginAlertRepository {
+	return &loginAlertRepository{db: db}
+}
+
+const loginAlertColumns = `id, user_id, user_event_id, reason, ip, device, confirmed, responded_at, created_at, updated_at`
+
+// maxLoginAlerts caps ListAlerts, older alerts are only reachable through user events
+const maxLoginAlerts = 50
+
+func (r *loginAlertRepository) KnownLogin(ctx context.Context, userID uint64, fingerprint, ip string) (bool, bool, bool, error) {
+	query := `
+		SELECT
+			EXISTS(SELECT 1 FROM user_devices WHERE user_id = ?),
+			EXISTS(SELECT 1 FROM user_devices WHERE user_id = ? AND fingerprint = ?),
+			EXISTS(SELECT 1 FROM user_devices WHERE user_id = ? AND ip = ?)
+	`
+	var hasDevices, deviceKnown, ipKnown bool
+	err := r.db.QueryRowContext(ctx, query, userID, userID, fingerprint, userID, ip).Scan(&hasDevices, &deviceKnown, &ipKnown)
+	if err != nil {
+		return false, false, false, fmt.Errorf("failed to look up user devices: %w", err)
+	}
+	return hasDevices, deviceKnown, ipKnown, nil
+}
+
+func (r *loginAlertRepository) TouchDevice(ctx context.Context, device *models.UserDevice) error {
+	query := `
+		INSERT INTO user_devices (user_id, fingerprint, ip, user_agent, first_seen_at, last_seen_at)
+		VALUES (?, ?, ?, ?, NOW(), NOW())
+		ON DUPLICATE KEY UPDATE user_agent = VALUES(user_agent), last_seen_at = NOW()
+	`
+	if _, err := r.db.ExecContext(ctx, query, device.UserID, device.Fingerprint, device.IP, device.UserAgent); err != nil {
+		return fmt.Errorf("failed to record user device: %w", err)
+	}
+	return nil
+}
+
+func (r *loginAlertRepository) CreateAlert(ctx context.Context, alert *models.LoginAlert) error {
+	query := `
+		INSERT INTO login_alerts (user_id, user_event_id, reason, ip, device, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, NOW(), NOW())
+	`
+	result, err := r.db.ExecContext(ctx, query, alert.UserID, alert.UserEventID, alert.Reason, alert.IP, alert.Device)
+	if err != nil {
+		return fmt.Errorf("failed to create login alert: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get login alert id: %w", err)
+	}
+	alert.ID = uint64(id)
+
+	return nil
+}
+
+func (r *loginAlertRepository) FindAlert(ctx context.Context, userID, alertID uint64) (*models.LoginAlert, error) {
+	query := `SELECT ` + loginAlertColumns + ` FROM login_alerts WHERE id = ? AND user_id = ? LIMIT 1`
+
+	alert, err := scanLoginAlert(r.db.QueryRowContext(ctx, query, alertID, userID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find login alert: %w", err)
+	}
+	return alert, nil
+}
+
+func (r *loginAlertRepository) ListAlerts(ctx context.Context, userID uint64, pendingOnly bool) ([]*models.LoginAlert, error) {
+	query := `SELECT ` + loginAlertColumns + ` FROM login_alerts WHERE user_id = ?`
+	if pendingOnly {
+		query += ` AND confirmed IS NULL`
+	}
+	query += ` ORDER BY id DESC LIMIT ?`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, maxLoginAlerts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list login alerts: %w", err)
+	}
+	defer rows.Close()
+
+	var alerts []*models.LoginAlert
+	for rows.Next() {
+		alert, err := scanLoginAlert(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan login alert: %w", err)
+		}
+		alerts = append(alerts, alert)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate login alerts: %w", err)
+	}
+
+	return alerts, nil
+}
+
+func (r *loginAlertRepository) RespondAlert(ctx context.Context, alertID uint64, wasMe bool) error {
+	query := `UPDATE login_alerts SET confirmed = ?, responded_at = NOW(), updated_at = NOW() WHERE id = ?`
+
+	if _, err := r.db.ExecContext(ctx, query, wasMe, alertID); err != nil {
+		return fmt.Errorf("failed to update login alert: %w", err)
+	}
+	return nil
+}
+
+type loginAlertScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanLoginAlert(s loginAlertScanner) (*models.LoginAlert, error) {
+	alert := &models.LoginAlert{}
+	if err := s.Scan(
+		&alert.ID,
+		&alert.UserID,
+		&alert.UserEventID,
+		&alert.Reason,
+		&alert.IP,
+		&alert.Device,
+		&alert.Confirmed,
+		&alert.RespondedAt,
+		&alert.CreatedAt,
+		&alert.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return alert, nil
+}
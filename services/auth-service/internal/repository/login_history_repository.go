@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"metargb/auth-service/internal/models"
+)
+
+type LoginHistoryRepository interface {
+	// ListLogins returns up to limit logins of the user after skipping
+	// offset, newest first, with the alerts they raised
+	ListLogins(ctx context.Context, userID uint64, limit, offset int) ([]*models.LoginHistoryEntry, error)
+}
+
+type loginHistoryRepository struct {
+	db *sql.DB
+}
+
+func NewLoginHistoryRepository(db *sql.DB) LoginHistoryRepository {
+	return &loginHistoryRepository{db: db}
+}
+
+func (r *loginHistoryRepository) ListLogins(ctx context.Context, userID uint64, limit, offset int) ([]*models.LoginHistoryEntry, error) {
+	query := `
+		SELECT e.id, e.ip, e.device, e.created_at, a.id, a.reason, a.confirmed
+		FROM user_events e
+		LEFT JOIN login_alerts a ON a.user_event_id = e.id AND a.user_id = e.user_id
+		WHERE e.user_id = ? AND e.event = ?
+		ORDER BY e.id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, models.UserEventLogin, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list logins: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*models.LoginHistoryEntry
+	for rows.Next() {
+		entry := &models.LoginHistoryEntry{}
+		var (
+			alertID     sql.NullInt64
+			alertReason sql.NullString
+			confirmed   sql.NullBool
+		)
+		if err := rows.Scan(&entry.EventID, &entry.IP, &entry.Device, &entry.CreatedAt, &alertID, &alertReason, &confirmed); err != nil {
+			return nil, fmt.Errorf("failed to scan login: %w", err)
+		}
+		if alertID.Valid {
+			entry.Alert = &models.LoginAlert{
+				ID:          uint64(alertID.Int64),
+				UserID:      userID,
+				UserEventID: entry.EventID,
+				Reason:      alertReason.String,
+				IP:          entry.IP,
+				Device:      entry.Device,
+				Confirmed:   confirmed,
+			}
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate logins: %w", err)
+	}
+
+	return entries, nil
+}
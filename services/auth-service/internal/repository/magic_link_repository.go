@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"metargb/auth-service/internal/models"
+)
+
+// MagicLinkRepository stores pending magic links and their request counters
+type MagicLinkRepository interface {
+	// Store saves a magic link under the hash of its token
+	Store(ctx context.Context, tokenHash string, link *models.MagicLink, ttl time.Duration) error
+
+	// Consume retrieves and removes a magic link (pull semantics), nil if unknown or expired
+	Consume(ctx context.Context, tokenHash string) (*models.MagicLink, error)
+
+	// IncrementRequests counts a request against key and returns the count
+	// within the current window
+	IncrementRequests(ctx context.Context, key string, window time.Duration) (int64, error)
+}
+
+type magicLinkRepository struct {
+	client *redis.Client
+}
+
+// NewMagicLinkRepository creates a new magic link repository
+func NewMagicLinkRepository(client *redis.Client) MagicLinkRepository {
+	return &magicLinkRepository{
+		client: client,
+	}
+}
+
+func (r *magicLinkRepository) Store(ctx context.Context, tokenHash string, link *models.MagicLink, ttl time.Duration) error {
+	payload, err := json.Marshal(link)
+	if err != nil {
+		return fmt.Errorf("failed to encode magic link: %w", err)
+	}
+
+	key := fmt.Sprintf("magic_link:%s", tokenHash)
+	if err := r.client.Set(ctx, key, payload, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store magic link: %w", err)
+	}
+	return nil
+}
+
+func (r *magicLinkRepository) Consume(ctx context.Context, tokenHash string) (*models.MagicLink, error) {
+	key := fmt.Sprintf("magic_link:%s", tokenHash)
+
+	// Use GETDEL so a link can only be used once
+	val, err := r.client.GetDel(ctx, key).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get magic link: %w", err)
+	}
+
+	link := &models.MagicLink{}
+	if err := json.Unmarshal(val, link); err != nil {
+		return nil, fmt.Errorf("failed to decode magic link: %w", err)
+	}
+	return link, nil
+}
+
+func (r *magicLinkRepository) IncrementRequests(ctx context.Context, key string, window time.Duration) (int64, error) {
+	key = fmt.Sprintf("magic_link:requests:%s", key)
+
+	count, err := r.client.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count magic link requests: %w", err)
+	}
+	// The window starts with the first request
+	if count == 1 {
+		if err := r.client.Expire(ctx, key, window).Err(); err != nil {
+			return 0, fmt.Errorf("failed to set magic link request window: %w", err)
+		}
+	}
+	return count, nil
+}
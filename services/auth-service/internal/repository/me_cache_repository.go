@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"metargb/auth-service/internal/models"
+)
+
+// NotificationsChangedChannel is where notifications-service announces that a
+// user's notifications were created or read, as {"user_id": <id>}
+const NotificationsChangedChannel = "notifications-changed"
+
+// auth:me:<user id> holds the user's MeSnapshot as JSON and
+// auth:me:generation:<user id> the generation it must match to be served
+const (
+	meSnapshotKeyPrefix   = "auth:me:"
+	meGenerationKeyPrefix = "auth:me:generation:"
+)
+
+// MeCacheRepository stores the GetMe snapshot of each user in Redis
+type MeCacheRepository interface {
+	// Get returns the user's snapshot and current generation. The snapshot is
+	// nil when there is none or it was built in an older generation.
+	Get(ctx context.Context, userID uint64) (*models.MeSnapshot, int64, error)
+
+	// Set stores snapshot for ttl
+	Set(ctx context.Context, snapshot *models.MeSnapshot, ttl time.Duration) error
+
+	// Invalidate bumps the user's generation, so the stored snapshot and any
+	// snapshot being built meanwhile are ignored. ttl is how long snapshots
+	// live; the generation outlives them.
+	Invalidate(ctx context.Context, userID uint64, ttl time.Duration) error
+
+	// ListenNotificationsChanged calls handle with the user of every event on
+	// NotificationsChangedChannel until ctx is cancelled
+	ListenNotificationsChanged(ctx context.Context, handle func(userID uint64)) error
+}
+
+type meCacheRepository struct {
+	client *redis.Client
+}
+
+// NewMeCacheRepository creates a new GetMe cache repository
+func NewMeCacheRepository(client *redis.Client) MeCacheRepository {
+	return &meCacheRepository{client: client}
+}
+
+func (r *meCacheRepository) Get(ctx context.Context, userID uint64) (*models.MeSnapshot, int64, error) {
+	values, err := r.client.MGet(ctx, meSnapshotKey(userID), meGenerationKey(userID)).Result()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get me snapshot: %w", err)
+	}
+
+	var generation int64
+	if raw, ok := values[1].(string); ok {
+		if generation, err = strconv.ParseInt(raw, 10, 64); err != nil {
+			return nil, 0, fmt.Errorf("invalid me generation %q: %w", raw, err)
+		}
+	}
+
+	raw, ok := values[0].(string)
+	if !ok {
+		return nil, generation, nil
+	}
+	snapshot := &models.MeSnapshot{}
+	if err := json.Unmarshal([]byte(raw), snapshot); err != nil || snapshot.Generation != generation {
+		return nil, generation, nil
+	}
+	return snapshot, generation, nil
+}
+
+func (r *meCacheRepository) Set(ctx context.Context, snapshot *models.MeSnapshot, ttl time.Duration) error {
+	payload, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to marshal me snapshot: %w", err)
+	}
+	return r.client.Set(ctx, meSnapshotKey(snapshot.UserID), payload, ttl).Err()
+}
+
+func (r *meCacheRepository) Invalidate(ctx context.Context, userID uint64, ttl time.Duration) error {
+	key := meGenerationKey(userID)
+	pipe := r.client.TxPipeline()
+	pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, 2*ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to invalidate me snapshot: %w", err)
+	}
+	return nil
+}
+
+func (r *meCacheRepository) ListenNotificationsChanged(ctx context.Context, handle func(userID uint64)) error {
+	sub := r.client.Subscribe(ctx, NotificationsChangedChannel)
+	defer sub.Close()
+
+	// Fail fast when Redis is unreachable instead of waiting on an empty channel
+	if _, err := sub.Receive(ctx); err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %w", NotificationsChangedChannel, err)
+	}
+
+	messages := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-messages:
+			if !ok {
+				return fmt.Errorf("%s subscription closed", NotificationsChangedChannel)
+			}
+			var event struct {
+				UserID uint64 `json:"user_id"`
+			}
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil || event.UserID == 0 {
+				continue
+			}
+			handle(event.UserID)
+		}
+	}
+}
+
+func meSnapshotKey(userID uint64) string {
+	return meSnapshotKeyPrefix + strconv.FormatUint(userID, 10)
+}
+
+func meGenerationKey(userID uint64) string {
+	return meGenerationKeyPrefix + strconv.FormatUint(userID, 10)
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"metargb/auth-service/internal/models"
+)
+
+// PersonalAccessTokenRepository stores scoped tokens in personal_access_tokens,
+// next to the login tokens TokenRepository issues
+type PersonalAccessTokenRepository interface {
+	Create(ctx context.Context, token *models.ScopedToken) (string, error)
+	FindByID(ctx context.Context, id uint64) (*models.ScopedToken, error)
+	ListByUserID(ctx context.Context, userID uint64) ([]*models.ScopedToken, error)
+	Delete(ctx context.Context, id uint64) error
+}
+
+type personalAccessTokenRepository struct {
+	db *sql.DB
+}
+
+func NewPersonalAccessTokenRepository(db *sql.DB) PersonalAccessTokenRepository {
+	return &personalAccessTokenRepository{db: db}
+}
+
+// scopedTokenWhere matches the user tokens that do not carry every scope
+const scopedTokenWhere = `tokenable_type = 'App\\Models\\User' AND abilities IS NOT NULL AND abilities <> '` + models.TokenAbilitiesAll + `'`
+
+const scopedTokenColumns = `id, tokenable_id, name, abilities, last_used_at, expires_at, created_at`
+
+// Create inserts token and returns the plain token in the same {id}|{token}
+// format as login tokens
+func (r *personalAccessTokenRepository) Create(ctx context.Context, token *models.ScopedToken) (string, error) {
+	abilities, err := json.Marshal(token.Scopes)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal token scopes: %w", err)
+	}
+
+	plainToken := generatePlainToken()
+	now := time.Now()
+
+	query := `
+		INSERT INTO personal_access_tokens (tokenable_type, tokenable_id, name, token, abilities, expires_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	result, err := r.db.ExecContext(ctx, query,
+		"App\\Models\\User",
+		token.UserID,
+		token.Name,
+		hashToken(plainToken),
+		string(abilities),
+		token.ExpiresAt,
+		now,
+		now,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to create personal access token: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return "", fmt.Errorf("failed to get personal access token id: %w", err)
+	}
+	token.ID = uint64(id)
+	token.CreatedAt = now
+
+	return fmt.Sprintf("%d|%s", token.ID, plainToken), nil
+}
+
+func (r *personalAccessTokenRepository) FindByID(ctx context.Context, id uint64) (*models.ScopedToken, error) {
+	query := `SELECT ` + scopedTokenColumns + ` FROM personal_access_tokens WHERE id = ? AND ` + scopedTokenWhere
+	token, err := scanScopedToken(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return token, err
+}
+
+func (r *personalAccessTokenRepository) ListByUserID(ctx context.Context, userID uint64) ([]*models.ScopedToken, error) {
+	query := `SELECT ` + scopedTokenColumns + ` FROM personal_access_tokens
+		WHERE tokenable_id = ? AND ` + scopedTokenWhere + `
+		ORDER BY created_at DESC, id DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list personal access tokens: %w", err)
+	}
+	defer rows.Close()
+
+	var tokens []*models.ScopedToken
+	for rows.Next() {
+		token, err := scanScopedToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list personal access tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+func (r *personalAccessTokenRepository) Delete(ctx context.Context, id uint64) error {
+	query := `DELETE FROM personal_access_tokens WHERE id = ?`
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to delete personal access token: %w", err)
+	}
+	return nil
+}
+
+type scopedTokenScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanScopedToken(row scopedTokenScanner) (*models.ScopedToken, error) {
+	token := &models.ScopedToken{}
+	var abilities sql.NullString
+	err := row.Scan(&token.ID, &token.UserID, &token.Name, &abilities,
+		&token.LastUsedAt, &token.ExpiresAt, &token.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan personal access token: %w", err)
+	}
+	if token.Scopes, err = models.ParseTokenAbilities(abilities); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"metargb/auth-service/internal/models"
+)
+
+type PhotoUploadRepository interface {
+	Create(ctx context.Context, upload *models.ProfilePhotoUpload) error
+	// FindByID returns the upload without its image data, nil if missing
+	FindByID(ctx context.Context, id uint64) (*models.ProfilePhotoUpload, error)
+	// ClaimPending locks up to limit processing uploads for lease and returns
+	// them with their image data. Uploads whose lease expired are claimed again,
+	// so a crashed worker's uploads are retried.
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*models.ProfilePhotoUpload, error)
+	// MarkReady links the created image and drops the image data
+	MarkReady(ctx context.Context, id, imageID uint64, url string) error
+	// MarkFailed records the reason and drops the image data
+	MarkFailed(ctx context.Context, id uint64, reason string) error
+}
+
+type photoUploadRepository struct {
+	db *sql.DB
+}
+
+func NewPhotoUploadRepository(db *sql.DB) PhotoUploadRepository {
+	return &photoUploadRepository{db: db}
+}
+
+func (r *photoUploadRepository) Create(ctx context.Context, upload *models.ProfilePhotoUpload) error {
+	query := `
+		INSERT INTO profile_photo_uploads (user_id, status, filename, content_type, image_data, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, NOW(), NOW())
+	`
+	result, err := r.db.ExecContext(ctx, query, upload.UserID, models.PhotoUploadProcessing, upload.Filename, upload.ContentType, upload.ImageData)
+	if err != nil {
+		return fmt.Errorf("failed to create profile photo upload: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get profile photo upload id: %w", err)
+	}
+	upload.ID = uint64(id)
+	upload.Status = models.PhotoUploadProcessing
+
+	return nil
+}
+
+func (r *photoUploadRepository) FindByID(ctx context.Context, id uint64) (*models.ProfilePhotoUpload, error) {
+	query := `
+		SELECT id, user_id, status, filename, content_type, image_id, url, error, attempts, created_at, updated_at
+		FROM profile_photo_uploads
+		WHERE id = ?
+	`
+	var upload models.ProfilePhotoUpload
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&upload.ID,
+		&upload.UserID,
+		&upload.Status,
+		&upload.Filename,
+		&upload.ContentType,
+		&upload.ImageID,
+		&upload.URL,
+		&upload.Error,
+		&upload.Attempts,
+		&upload.CreatedAt,
+		&upload.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find profile photo upload: %w", err)
+	}
+
+	return &upload, nil
+}
+
+func (r *photoUploadRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*models.ProfilePhotoUpload, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id
+		FROM profile_photo_uploads
+		WHERE status = ? AND (locked_until IS NULL OR locked_until < NOW())
+		ORDER BY id ASC
+		LIMIT ?
+	`, models.PhotoUploadProcessing, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pending profile photo uploads: %w", err)
+	}
+	var ids []uint64
+	for rows.Next() {
+		var id uint64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan profile photo upload id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating profile photo uploads: %w", err)
+	}
+
+	// Another replica may claim the same rows, only the one whose update
+	// matches keeps the upload
+	var uploads []*models.ProfilePhotoUpload
+	for _, id := range ids {
+		result, err := r.db.ExecContext(ctx, `
+			UPDATE profile_photo_uploads
+			SET locked_until = DATE_ADD(NOW(), INTERVAL ? SECOND), attempts = attempts + 1, updated_at = NOW()
+			WHERE id = ? AND status = ? AND (locked_until IS NULL OR locked_until < NOW())
+		`, int64(lease/time.Second), id, models.PhotoUploadProcessing)
+		if err != nil {
+			return uploads, fmt.Errorf("failed to claim profile photo upload: %w", err)
+		}
+		if claimed, err := result.RowsAffected(); err != nil || claimed == 0 {
+			continue
+		}
+
+		var upload models.ProfilePhotoUpload
+		err = r.db.QueryRowContext(ctx, `
+			SELECT id, user_id, status, filename, content_type, image_data, attempts, created_at, updated_at
+			FROM profile_photo_uploads
+			WHERE id = ?
+		`, id).Scan(
+			&upload.ID,
+			&upload.UserID,
+			&upload.Status,
+			&upload.Filename,
+			&upload.ContentType,
+			&upload.ImageData,
+			&upload.Attempts,
+			&upload.CreatedAt,
+			&upload.UpdatedAt,
+		)
+		if err != nil {
+			return uploads, fmt.Errorf("failed to load profile photo upload: %w", err)
+		}
+		uploads = append(uploads, &upload)
+	}
+
+	return uploads, nil
+}
+
+func (r *photoUploadRepository) MarkReady(ctx context.Context, id, imageID uint64, url string) error {
+	query := `
+		UPDATE profile_photo_uploads
+		SET status = ?, image_id = ?, url = ?, image_data = NULL, locked_until = NULL, updated_at = NOW()
+		WHERE id = ?
+	`
+	if _, err := r.db.ExecContext(ctx, query, models.PhotoUploadReady, imageID, url, id); err != nil {
+		return fmt.Errorf("failed to mark profile photo upload ready: %w", err)
+	}
+	return nil
+}
+
+func (r *photoUploadRepository) MarkFailed(ctx context.Context, id uint64, reason string) error {
+	query := `
+		UPDATE profile_photo_uploads
+		SET status = ?, error = ?, image_data = NULL, locked_until = NULL, updated_at = NOW()
+		WHERE id = ?
+	`
+	if _, err := r.db.ExecContext(ctx, query, models.PhotoUploadFailed, reason, id); err != nil {
+		return fmt.Errorf("failed to mark profile photo upload failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"metargb/auth-service/internal/models"
+)
+
+// Redis layout shared with the websocket gateway (internal/presence).
+// Every open connection is a member of presence:conns:<user id> scored by the
+// unix millisecond time its heartbeat expires, so connections of a crashed
+// gateway stop counting on their own. presence:last_seen maps user ids to the
+// unix millisecond time they were last connected.
+const (
+	PresenceChannel        = "presence"
+	presenceConnsKeyPrefix = "presence:conns:"
+	presenceLastSeenKey    = "presence:last_seen"
+)
+
+// PresenceRepository reads user presence from Redis and persists last_seen
+type PresenceRepository interface {
+	// GetPresence returns the presence of the given users keyed by id.
+	// Users that do not exist are left out.
+	GetPresence(ctx context.Context, userIDs []uint64) (map[uint64]*models.Presence, error)
+
+	// SaveLastSeen stores at as the user's last_seen unless it is older
+	SaveLastSeen(ctx context.Context, userID uint64, at time.Time) error
+
+	// Listen calls handle for every event on the presence channel until ctx is cancelled
+	Listen(ctx context.Context, handle func(*models.PresenceEvent)) error
+}
+
+type presenceRepository struct {
+	db     *sql.DB
+	client *redis.Client
+	now    func() time.Time
+}
+
+// NewPresenceRepository creates a new presence repository
+func NewPresenceRepository(db *sql.DB, client *redis.Client) PresenceRepository {
+	return &presenceRepository{
+		db:     db,
+		client: client,
+		now:    time.Now,
+	}
+}
+
+func (r *presenceRepository) GetPresence(ctx context.Context, userIDs []uint64) (map[uint64]*models.Presence, error) {
+	presence := make(map[uint64]*models.Presence, len(userIDs))
+	if len(userIDs) == 0 {
+		return presence, nil
+	}
+
+	placeholders := make([]string, len(userIDs))
+	args := make([]interface{}, len(userIDs))
+	for i, id := range userIDs {
+		placeholders[i] = "?"
+		args[i] = id
+	}
+	query := fmt.Sprintf(`
+		SELECT u.id, u.last_seen, COALESCE(s.status, 1)
+		FROM users u
+		LEFT JOIN settings s ON s.user_id = u.id
+		WHERE u.id IN (%s)
+	`, strings.Join(placeholders, ","))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query last seen: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		p := &models.Presence{}
+		var visible bool
+		if err := rows.Scan(&p.UserID, &p.LastSeen, &visible); err != nil {
+			return nil, fmt.Errorf("failed to scan last seen: %w", err)
+		}
+		p.Hidden = !visible
+		presence[p.UserID] = p
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate last seen: %w", err)
+	}
+	if len(presence) == 0 {
+		return presence, nil
+	}
+
+	ids := make([]uint64, 0, len(presence))
+	fields := make([]string, 0, len(presence))
+	for id := range presence {
+		ids = append(ids, id)
+		fields = append(fields, strconv.FormatUint(id, 10))
+	}
+
+	now := strconv.FormatInt(r.now().UnixMilli(), 10)
+	pipe := r.client.Pipeline()
+	counts := make([]*redis.IntCmd, len(ids))
+	for i, id := range ids {
+		counts[i] = pipe.ZCount(ctx, presenceConnsKey(id), "("+now, "+inf")
+	}
+	lastSeen := pipe.HMGet(ctx, presenceLastSeenKey, fields...)
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("failed to read presence: %w", err)
+	}
+
+	values := lastSeen.Val()
+	for i, id := range ids {
+		p := presence[id]
+		p.Online = counts[i].Val() > 0
+		if i >= len(values) {
+			continue
+		}
+		raw, ok := values[i].(string)
+		if !ok {
+			continue
+		}
+		ms, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			continue
+		}
+		// Redis is ahead of the column while the user is connected
+		if seen := time.UnixMilli(ms); !p.LastSeen.Valid || seen.After(p.LastSeen.Time) {
+			p.LastSeen = sql.NullTime{Time: seen, Valid: true}
+		}
+	}
+
+	return presence, nil
+}
+
+func (r *presenceRepository) SaveLastSeen(ctx context.Context, userID uint64, at time.Time) error {
+	query := `UPDATE users SET last_seen = ? WHERE id = ? AND last_seen < ?`
+	if _, err := r.db.ExecContext(ctx, query, at, userID, at); err != nil {
+		return fmt.Errorf("failed to save last seen: %w", err)
+	}
+	return nil
+}
+
+func (r *presenceRepository) Listen(ctx context.Context, handle func(*models.PresenceEvent)) error {
+	sub := r.client.Subscribe(ctx, PresenceChannel)
+	defer sub.Close()
+
+	// Fail fast when Redis is unreachable instead of waiting on an empty channel
+	if _, err := sub.Receive(ctx); err != nil {
+		return fmt.Errorf("failed to subscribe to presence: %w", err)
+	}
+
+	messages := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-messages:
+			if !ok {
+				return fmt.Errorf("presence subscription closed")
+			}
+			event := &models.PresenceEvent{}
+			if err := json.Unmarshal([]byte(msg.Payload), event); err != nil || event.UserID == 0 {
+				continue
+			}
+			handle(event)
+		}
+	}
+}
+
+func presenceConnsKey(userID uint64) string {
+	return presenceConnsKeyPrefix + strconv.FormatUint(userID, 10)
+}
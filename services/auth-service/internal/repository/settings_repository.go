@@ -28,7 +28,7 @@ func NewSettingsRepository(db *sql.DB) SettingsRepository {
 func (r *settingsRepository) FindByUserID(ctx context.Context, userID uint64) (*models.Settings, error) {
 	query := `
 		SELECT id, user_id, status, level, details, checkout_days_count, automatic_logout,
-			privacy, notifications, created_at, updated_at
+			privacy, notifications, COALESCE(timezone, ''), created_at, updated_at
 		FROM settings
 		WHERE user_id = ?
 		LIMIT 1
@@ -47,6 +47,7 @@ func (r *settingsRepository) FindByUserID(ctx context.Context, userID uint64) (*
 		&settings.AutomaticLogout,
 		&privacyJSON,
 		&notificationsJSON,
+		&settings.Timezone,
 		&settings.CreatedAt,
 		&settings.UpdatedAt,
 	)
@@ -98,7 +99,7 @@ func (r *settingsRepository) FindByUserID(ctx context.Context, userID uint64) (*
 func (r *settingsRepository) FindByID(ctx context.Context, id uint64) (*models.Settings, error) {
 	query := `
 		SELECT id, user_id, status, level, details, checkout_days_count, automatic_logout,
-			privacy, notifications, created_at, updated_at
+			privacy, notifications, COALESCE(timezone, ''), created_at, updated_at
 		FROM settings
 		WHERE id = ?
 		LIMIT 1
@@ -117,6 +118,7 @@ func (r *settingsRepository) FindByID(ctx context.Context, id uint64) (*models.S
 		&settings.AutomaticLogout,
 		&privacyJSON,
 		&notificationsJSON,
+		&settings.Timezone,
 		&settings.CreatedAt,
 		&settings.UpdatedAt,
 	)
@@ -172,7 +174,7 @@ func (r *settingsRepository) Update(ctx context.Context, settings *models.Settin
 	query := `
 		UPDATE settings
 		SET status = ?, level = ?, details = ?, checkout_days_count = ?,
-			automatic_logout = ?, privacy = ?, notifications = ?, updated_at = ?
+			automatic_logout = ?, privacy = ?, notifications = ?, timezone = ?, updated_at = ?
 		WHERE id = ?
 	`
 
@@ -184,6 +186,7 @@ func (r *settingsRepository) Update(ctx context.Context, settings *models.Settin
 		settings.AutomaticLogout,
 		string(privacyJSON),
 		string(notificationsJSON),
+		nullableTimezone(settings.Timezone),
 		now,
 		settings.ID,
 	)
@@ -211,8 +214,8 @@ func (r *settingsRepository) Create(ctx context.Context, settings *models.Settin
 	now := time.Now()
 	query := `
 		INSERT INTO settings (user_id, status, level, details, checkout_days_count,
-			automatic_logout, privacy, notifications, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			automatic_logout, privacy, notifications, timezone, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
 	result, err := r.db.ExecContext(ctx, query,
@@ -224,6 +227,7 @@ func (r *settingsRepository) Create(ctx context.Context, settings *models.Settin
 		settings.AutomaticLogout,
 		string(privacyJSON),
 		string(notificationsJSON),
+		nullableTimezone(settings.Timezone),
 		now,
 		now,
 	)
@@ -241,3 +245,8 @@ func (r *settingsRepository) Create(ctx context.Context, settings *models.Settin
 	settings.UpdatedAt = now
 	return nil
 }
+
+// nullableTimezone stores an unchosen timezone as NULL
+func nullableTimezone(timezone string) sql.NullString {
+	return sql.NullString{String: timezone, Valid: timezone != ""}
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// StatsRepository aggregates figures for the scheduled admin reports
+type StatsRepository interface {
+	CountNewUsers(ctx context.Context, from, to time.Time) (int64, error)
+}
+
+type statsRepository struct {
+	db *sql.DB
+}
+
+func NewStatsRepository(db *sql.DB) StatsRepository {
+	return &statsRepository{db: db}
+}
+
+// CountNewUsers counts users registered in [from, to)
+func (r *statsRepository) CountNewUsers(ctx context.Context, from, to time.Time) (int64, error) {
+	var count int64
+	err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM users WHERE created_at >= ? AND created_at < ?`, from, to,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count new users: %w", err)
+	}
+	return count, nil
+}
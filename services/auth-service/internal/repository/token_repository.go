@@ -15,9 +15,10 @@ import (
 
 type TokenRepository interface {
 	Create(ctx context.Context, userID uint64, name string, expiresAt time.Time) (string, error)
-	ValidateToken(ctx context.Context, token string) (*models.User, error)
+	ValidateToken(ctx context.Context, token string) (*models.User, *models.ValidatedToken, error)
 	DeleteUserTokens(ctx context.Context, userID uint64) error
 	FindTokenByHash(ctx context.Context, tokenHash string) (*models.PersonalAccessToken, error)
+	DeleteIdleTokens(ctx context.Context, now time.Time) (int64, error)
 }
 
 type tokenRepository struct {
@@ -42,7 +43,7 @@ func (r *tokenRepository) Create(ctx context.Context, userID uint64, name string
 		userID,
 		name,
 		tokenHash,
-		"[\"*\"]",
+		models.TokenAbilitiesAll,
 		expiresAt,
 		time.Now(),
 		time.Now(),
@@ -61,7 +62,12 @@ func (r *tokenRepository) Create(ctx context.Context, userID uint64, name string
 	return fullToken, nil
 }
 
-func (r *tokenRepository) ValidateToken(ctx context.Context, token string) (*models.User, error) {
+// ValidateToken returns the token's user, its scopes and when the token expires
+// unless it is used again: the earlier of its expires_at and the user's
+// automatic_logout deadline, counted from now since this validation marks it
+// used. Scoped tokens are minted for third-party apps and only expire at their
+// expires_at, if any.
+func (r *tokenRepository) ValidateToken(ctx context.Context, token string) (*models.User, *models.ValidatedToken, error) {
 	// Extract plain token part if token is in format {id}|{plainToken}
 	// Tokens can be either:
 	// 1. Full format: "123|piSZrgcQzybhwnpeOWVABqXxurr2L3KIkBA8eK0c" - extract part after |
@@ -74,7 +80,8 @@ func (r *tokenRepository) ValidateToken(ctx context.Context, token string) (*mod
 	tokenHash := hashToken(plainToken)
 
 	query := `
-		SELECT pat.id, pat.tokenable_id, pat.expires_at, pat.last_used_at,
+		SELECT pat.id, pat.tokenable_id, pat.abilities, pat.expires_at, pat.last_used_at, pat.created_at,
+			   (SELECT s.automatic_logout FROM settings s WHERE s.user_id = u.id ORDER BY s.id LIMIT 1),
 			   u.id, u.name, u.email, u.phone, u.password, u.code, u.referrer_id, u.score, u.ip,
 			   u.last_seen, u.email_verified_at, u.phone_verified_at, u.access_token,
 			   u.refresh_token, u.token_type, u.expires_in, u.created_at, u.updated_at
@@ -85,12 +92,15 @@ func (r *tokenRepository) ValidateToken(ctx context.Context, token string) (*mod
 
 	var patID uint64
 	var tokenableID uint64
+	var abilities sql.NullString
 	var expiresAt sql.NullTime
 	var lastUsedAt sql.NullTime
+	var createdAt sql.NullTime
+	var automaticLogout sql.NullInt64
 	user := &models.User{}
 
 	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
-		&patID, &tokenableID, &expiresAt, &lastUsedAt,
+		&patID, &tokenableID, &abilities, &expiresAt, &lastUsedAt, &createdAt, &automaticLogout,
 		&user.ID, &user.Name, &user.Email, &user.Phone, &user.Password,
 		&user.Code, &user.ReferrerID, &user.Score, &user.IP, &user.LastSeen,
 		&user.EmailVerifiedAt, &user.PhoneVerifiedAt, &user.AccessToken,
@@ -98,21 +108,46 @@ func (r *tokenRepository) ValidateToken(ctx context.Context, token string) (*mod
 		&user.CreatedAt, &user.UpdatedAt,
 	)
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("invalid token")
+		return nil, nil, fmt.Errorf("invalid token")
 	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to validate token: %w", err)
+		return nil, nil, fmt.Errorf("failed to validate token: %w", err)
 	}
 
+	scopes, err := models.ParseTokenAbilities(abilities)
+	if err != nil {
+		return nil, nil, err
+	}
+	scoped := abilities.Valid && abilities.String != models.TokenAbilitiesAll
+
 	// Check if token is expired
 	if expiresAt.Valid && expiresAt.Time.Before(time.Now()) {
-		return nil, fmt.Errorf("token expired")
+		return nil, nil, fmt.Errorf("token expired")
+	}
+
+	// Enforce the user's automatic_logout setting against the last activity
+	lastActivity := createdAt
+	if lastUsedAt.Valid {
+		lastActivity = lastUsedAt
+	}
+	if !scoped && lastActivity.Valid && idleExpired(lastActivity.Time, automaticLogout.Int64, time.Now()) {
+		go r.deleteToken(ctx, patID)
+		return nil, nil, fmt.Errorf("token expired due to inactivity")
 	}
 
 	// Update last_used_at
-	go r.updateLastUsedAt(patID)
+	now := time.Now()
+	go r.updateLastUsedAt(ctx, patID)
 
-	return user, nil
+	validated := &models.ValidatedToken{ID: patID, Scopes: scopes}
+	if !scoped {
+		validated.ExpiresAt = now.Add(idleTimeout(automaticLogout.Int64))
+	}
+	if expiresAt.Valid && (validated.ExpiresAt.IsZero() || expiresAt.Time.Before(validated.ExpiresAt)) {
+		validated.ExpiresAt = expiresAt.Time
+	}
+
+	return user, validated, nil
 }
 
 func (r *tokenRepository) DeleteUserTokens(ctx context.Context, userID uint64) error {
@@ -145,8 +180,58 @@ func (r *tokenRepository) FindTokenByHash(ctx context.Context, tokenHash string)
 	return token, nil
 }
 
-func (r *tokenRepository) updateLastUsedAt(tokenID uint64) {
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+// DeleteIdleTokens removes tokens that passed their expiry or, unless they are
+// scoped, have been idle for longer than their owner's automatic_logout setting
+func (r *tokenRepository) DeleteIdleTokens(ctx context.Context, now time.Time) (int64, error) {
+	query := `
+		DELETE FROM personal_access_tokens
+		WHERE tokenable_type = 'App\\Models\\User'
+		  AND (
+			(expires_at IS NOT NULL AND expires_at < ?)
+			OR ((abilities IS NULL OR abilities = ?)
+				AND COALESCE(last_used_at, created_at) < DATE_SUB(?, INTERVAL COALESCE(NULLIF(
+					(SELECT s.automatic_logout FROM settings s WHERE s.user_id = tokenable_id ORDER BY s.id LIMIT 1),
+				0), ?) MINUTE))
+		  )
+	`
+	result, err := r.db.ExecContext(ctx, query, now, models.TokenAbilitiesAll, now, models.DefaultAutomaticLogout)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete idle tokens: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count deleted tokens: %w", err)
+	}
+	return deleted, nil
+}
+
+// idleExpired reports whether a token last active at lastActivity has been idle
+// longer than automaticLogout minutes (0 means the default)
+func idleExpired(lastActivity time.Time, automaticLogout int64, now time.Time) bool {
+	return now.Sub(lastActivity) > idleTimeout(automaticLogout)
+}
+
+// idleTimeout converts an automatic_logout setting in minutes, using the
+// default when unset
+func idleTimeout(automaticLogout int64) time.Duration {
+	if automaticLogout <= 0 {
+		automaticLogout = models.DefaultAutomaticLogout
+	}
+	return time.Duration(automaticLogout) * time.Minute
+}
+
+// deleteToken runs after the request returns, so it keeps ctx's values but
+// not its cancellation
+func (r *tokenRepository) deleteToken(ctx context.Context, tokenID uint64) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 3*time.Second)
+	defer cancel()
+
+	query := `DELETE FROM personal_access_tokens WHERE id = ?`
+	_, _ = r.db.ExecContext(ctx, query, tokenID)
+}
+
+func (r *tokenRepository) updateLastUsedAt(ctx context.Context, tokenID uint64) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 3*time.Second)
 	defer cancel()
 
 	query := `UPDATE personal_access_tokens SET last_used_at = ? WHERE id = ?`
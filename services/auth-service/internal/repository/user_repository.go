@@ -14,7 +14,6 @@ type UserRepository interface {
 	FindByEmail(ctx context.Context, email string) (*models.User, error)
 	FindByID(ctx context.Context, id uint64) (*models.User, error)
 	Update(ctx context.Context, user *models.User) error
-	UpdateLastSeen(ctx context.Context, userID uint64) error
 	FindByCode(ctx context.Context, code string) (*models.User, error)
 	GetSettings(ctx context.Context, userID uint64) (*models.Settings, error)
 	CreateSettings(ctx context.Context, settings *models.Settings) error
@@ -34,6 +33,8 @@ type UserRepository interface {
 	GetLevelsBelowScore(ctx context.Context, score int32) ([]*UserLevel, error)
 	GetNextLevelScore(ctx context.Context, currentScore int32) (int32, error)
 	GetFeatureCounts(ctx context.Context, userID uint64) (maskoni int32, tejari int32, amoozeshi int32, err error)
+	// Cross-service snapshot
+	FindUserInfo(ctx context.Context, userID uint64, code string) (*UserInfo, error)
 }
 
 // UserLevel represents level information from database
@@ -56,6 +57,16 @@ type UserWithRelations struct {
 	ProfilePhotoURL   *string
 }
 
+// UserInfo is the snapshot of a user shared with other services
+type UserInfo struct {
+	ID                 uint64
+	Code               string
+	Name               string
+	Birthdate          sql.NullTime
+	WithdrawProfitDays int32
+	Timezone           string
+}
+
 type userRepository struct {
 	db *sql.DB
 }
@@ -165,15 +176,6 @@ func (r *userRepository) Update(ctx context.Context, user *models.User) error {
 	return nil
 }
 
-func (r *userRepository) UpdateLastSeen(ctx context.Context, userID uint64) error {
-	query := `UPDATE users SET last_seen = ? WHERE id = ?`
-	_, err := r.db.ExecContext(ctx, query, time.Now(), userID)
-	if err != nil {
-		return fmt.Errorf("failed to update last seen: %w", err)
-	}
-	return nil
-}
-
 func (r *userRepository) FindByCode(ctx context.Context, code string) (*models.User, error) {
 	query := `SELECT id FROM users WHERE code = ?`
 	var id uint64
@@ -580,3 +582,38 @@ func (r *userRepository) GetFeatureCounts(ctx context.Context, userID uint64) (m
 
 	return maskoni, tejari, amoozeshi, nil
 }
+
+// FindUserInfo loads the user snapshot by ID, or by code when userID is 0.
+// Users without a user_variables row get the column default of 10 days, and
+// users who never chose a timezone an empty one.
+func (r *userRepository) FindUserInfo(ctx context.Context, userID uint64, code string) (*UserInfo, error) {
+	query := `
+		SELECT u.id, u.code, u.name, k.birthdate, COALESCE(uv.withdraw_profit, 10),
+			COALESCE((SELECT s.timezone FROM settings s WHERE s.user_id = u.id LIMIT 1), '')
+		FROM users u
+		LEFT JOIN kycs k ON k.user_id = u.id
+		LEFT JOIN user_variables uv ON uv.user_id = u.id
+	`
+	var arg interface{}
+	if userID != 0 {
+		query += "WHERE u.id = ? LIMIT 1"
+		arg = userID
+	} else {
+		query += "WHERE u.code = ? LIMIT 1"
+		arg = code
+	}
+
+	info := &UserInfo{}
+	var withdrawProfit float64
+	err := r.db.QueryRowContext(ctx, query, arg).Scan(
+		&info.ID, &info.Code, &info.Name, &info.Birthdate, &withdrawProfit, &info.Timezone,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user info: %w", err)
+	}
+	info.WithdrawProfitDays = int32(withdrawProfit)
+	return info, nil
+}
@@ -0,0 +1,301 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/repository"
+	authpkg "metargb/shared/pkg/auth"
+)
+
+const (
+	// APIKeyPrefix marks plain keys so they are easy to recognise in configs and leak scanners
+	APIKeyPrefix = "mgk_"
+
+	// DefaultAPIKeyRateLimit is applied when a key is created without an explicit limit
+	DefaultAPIKeyRateLimit int32 = 60
+	// MaxAPIKeyRateLimit caps the per-minute limit a user can request for a key
+	MaxAPIKeyRateLimit int32 = 6000
+	// MaxAPIKeysPerUser caps the number of active keys a user can hold
+	MaxAPIKeysPerUser = 10
+
+	apiKeySecretBytes   = 32
+	apiKeyDisplayLength = 12
+	apiKeyMaxNameLength = 255
+)
+
+var (
+	ErrAPIKeyNotFound      = errors.New("api key not found")
+	ErrAPIKeyInvalid       = errors.New("api key is invalid, revoked or expired")
+	ErrAPIKeyRevoked       = errors.New("api key has been revoked")
+	ErrAPIKeyNameRequired  = errors.New("api key name is required")
+	ErrAPIKeyNameTooLong   = errors.New("api key name must be 255 characters or less")
+	ErrAPIKeyScopeInvalid  = errors.New("api key scopes must be non-empty and contain only lowercase letters, digits, '.', ':', '_', '-' or '*'")
+	ErrAPIKeyScopeUnknown  = errors.New("api key scope is not a known scope")
+	ErrAPIKeyRateLimit     = errors.New("api key rate limit must be between 1 and 6000 requests per minute")
+	ErrAPIKeyExpiresAt     = errors.New("api key expiry must be a future RFC3339 timestamp")
+	ErrAPIKeyLimitExceeded = errors.New("maximum number of active api keys reached")
+	ErrAPIKeyServiceScope  = errors.New("only service key admins can create api keys with service scopes")
+)
+
+var apiKeyScopePattern = regexp.MustCompile(`^[a-z0-9._:*-]+$`)
+
+type APIKeyService interface {
+	Create(ctx context.Context, userID uint64, name string, scopes []string, rateLimit int32, expiresAt string) (*models.APIKey, string, error)
+	List(ctx context.Context, userID uint64) ([]*models.APIKey, error)
+	Rotate(ctx context.Context, userID, keyID uint64) (*models.APIKey, string, error)
+	Revoke(ctx context.Context, userID, keyID uint64) error
+	Validate(ctx context.Context, plainKey string) (*models.APIKey, *models.User, error)
+}
+
+type apiKeyService struct {
+	apiKeyRepo       repository.APIKeyRepository
+	userRepo         repository.UserRepository
+	serviceKeyAdmins map[uint64]bool
+}
+
+// NewAPIKeyService creates the API key service. serviceKeyAdminIDs are the
+// users allowed to create keys with service scopes, which let other services
+// call internal methods on behalf of any user.
+func NewAPIKeyService(apiKeyRepo repository.APIKeyRepository, userRepo repository.UserRepository, serviceKeyAdminIDs []uint64) APIKeyService {
+	serviceKeyAdmins := make(map[uint64]bool, len(serviceKeyAdminIDs))
+	for _, id := range serviceKeyAdminIDs {
+		serviceKeyAdmins[id] = true
+	}
+	return &apiKeyService{
+		apiKeyRepo:       apiKeyRepo,
+		userRepo:         userRepo,
+		serviceKeyAdmins: serviceKeyAdmins,
+	}
+}
+
+func (s *apiKeyService) Create(ctx context.Context, userID uint64, name string, scopes []string, rateLimit int32, expiresAt string) (*models.APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", ErrAPIKeyNameRequired
+	}
+	if len([]rune(name)) > apiKeyMaxNameLength {
+		return nil, "", ErrAPIKeyNameTooLong
+	}
+
+	normalizedScopes, err := normalizeAPIKeyScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+	if !s.serviceKeyAdmins[userID] {
+		for _, scope := range normalizedScopes {
+			if authpkg.IsServiceScope(scope) {
+				return nil, "", ErrAPIKeyServiceScope
+			}
+		}
+	}
+
+	if rateLimit == 0 {
+		rateLimit = DefaultAPIKeyRateLimit
+	}
+	if rateLimit < 1 || rateLimit > MaxAPIKeyRateLimit {
+		return nil, "", ErrAPIKeyRateLimit
+	}
+
+	var expires sql.NullTime
+	if expiresAt != "" {
+		t, err := time.Parse(time.RFC3339, expiresAt)
+		if err != nil || !t.After(time.Now()) {
+			return nil, "", ErrAPIKeyExpiresAt
+		}
+		expires = sql.NullTime{Time: t, Valid: true}
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil, "", ErrUserNotFound
+	}
+
+	existing, err := s.apiKeyRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	active := 0
+	now := time.Now()
+	for _, key := range existing {
+		if key.IsActive(now) {
+			active++
+		}
+	}
+	if active >= MaxAPIKeysPerUser {
+		return nil, "", ErrAPIKeyLimitExceeded
+	}
+
+	plainKey, err := GenerateAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := &models.APIKey{
+		UserID:             userID,
+		Name:               name,
+		Prefix:             plainKey[:apiKeyDisplayLength],
+		KeyHash:            HashAPIKey(plainKey),
+		Scopes:             normalizedScopes,
+		RateLimitPerMinute: rateLimit,
+		ExpiresAt:          expires,
+	}
+	if err := s.apiKeyRepo.Create(ctx, key); err != nil {
+		return nil, "", err
+	}
+
+	created, err := s.apiKeyRepo.FindByID(ctx, key.ID)
+	if err != nil {
+		return nil, "", err
+	}
+	if created == nil {
+		return nil, "", ErrAPIKeyNotFound
+	}
+
+	return created, plainKey, nil
+}
+
+func (s *apiKeyService) List(ctx context.Context, userID uint64) ([]*models.APIKey, error) {
+	return s.apiKeyRepo.ListByUserID(ctx, userID)
+}
+
+func (s *apiKeyService) Rotate(ctx context.Context, userID, keyID uint64) (*models.APIKey, string, error) {
+	key, err := s.findOwnedKey(ctx, userID, keyID)
+	if err != nil {
+		return nil, "", err
+	}
+	if key.RevokedAt.Valid {
+		return nil, "", ErrAPIKeyRevoked
+	}
+	// Scopes that were retired since the key was created are not carried over
+	// to a new secret; the owner has to create a key with current scopes
+	for _, scope := range key.Scopes {
+		if !authpkg.IsKnownScope(scope) && !authpkg.IsKnownServiceScope(scope) {
+			return nil, "", fmt.Errorf("%w: %s", ErrAPIKeyScopeUnknown, scope)
+		}
+	}
+
+	plainKey, err := GenerateAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := s.apiKeyRepo.UpdateSecret(ctx, key.ID, plainKey[:apiKeyDisplayLength], HashAPIKey(plainKey)); err != nil {
+		return nil, "", err
+	}
+
+	rotated, err := s.apiKeyRepo.FindByID(ctx, key.ID)
+	if err != nil {
+		return nil, "", err
+	}
+	if rotated == nil {
+		return nil, "", ErrAPIKeyNotFound
+	}
+
+	return rotated, plainKey, nil
+}
+
+func (s *apiKeyService) Revoke(ctx context.Context, userID, keyID uint64) error {
+	key, err := s.findOwnedKey(ctx, userID, keyID)
+	if err != nil {
+		return err
+	}
+	if key.RevokedAt.Valid {
+		return nil
+	}
+
+	return s.apiKeyRepo.Revoke(ctx, key.ID)
+}
+
+// Validate resolves a plain key to its record and owner. Unknown, revoked and
+// expired keys all return ErrAPIKeyInvalid so callers cannot probe key state.
+func (s *apiKeyService) Validate(ctx context.Context, plainKey string) (*models.APIKey, *models.User, error) {
+	if !strings.HasPrefix(plainKey, APIKeyPrefix) {
+		return nil, nil, ErrAPIKeyInvalid
+	}
+
+	key, err := s.apiKeyRepo.FindByHash(ctx, HashAPIKey(plainKey))
+	if err != nil {
+		return nil, nil, err
+	}
+	if key == nil || !key.IsActive(time.Now()) {
+		return nil, nil, ErrAPIKeyInvalid
+	}
+
+	user, err := s.userRepo.FindByID(ctx, key.UserID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil, nil, ErrAPIKeyInvalid
+	}
+
+	// Last-used tracking is informational only
+	_ = s.apiKeyRepo.TouchLastUsed(ctx, key.ID)
+
+	return key, user, nil
+}
+
+func (s *apiKeyService) findOwnedKey(ctx context.Context, userID, keyID uint64) (*models.APIKey, error) {
+	key, err := s.apiKeyRepo.FindByID(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	// Keys owned by other users are reported as missing to avoid leaking their existence
+	if key == nil || key.UserID != userID {
+		return nil, ErrAPIKeyNotFound
+	}
+	return key, nil
+}
+
+// GenerateAPIKey returns a new random plain key with the APIKeyPrefix
+func GenerateAPIKey() (string, error) {
+	secret := make([]byte, apiKeySecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	return APIKeyPrefix + hex.EncodeToString(secret), nil
+}
+
+// HashAPIKey returns the hex encoded SHA-256 hash stored for a plain key
+func HashAPIKey(plainKey string) string {
+	sum := sha256.Sum256([]byte(plainKey))
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeAPIKeyScopes trims, lowercases, validates and de-duplicates scopes,
+// rejecting scopes no method or internal method requires
+func normalizeAPIKeyScopes(scopes []string) ([]string, error) {
+	seen := make(map[string]bool, len(scopes))
+	normalized := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		if scope == "" || !apiKeyScopePattern.MatchString(scope) {
+			return nil, ErrAPIKeyScopeInvalid
+		}
+		if !authpkg.IsKnownScope(scope) && !authpkg.IsKnownServiceScope(scope) {
+			return nil, fmt.Errorf("%w: %s", ErrAPIKeyScopeUnknown, scope)
+		}
+		if seen[scope] {
+			continue
+		}
+		seen[scope] = true
+		normalized = append(normalized, scope)
+	}
+	if len(normalized) == 0 {
+		return nil, ErrAPIKeyScopeInvalid
+	}
+	return normalized, nil
+}
@@ -27,10 +27,10 @@ import (
 type AuthService interface {
 	Register(ctx context.Context, backURL, referral string) (string, error)
 	Redirect(ctx context.Context, redirectTo, backURL string) (string, string, error) // returns url and state
-	Callback(ctx context.Context, state, code, ip string) (*CallbackResult, error)
+	Callback(ctx context.Context, state, code, ip, userAgent string) (*CallbackResult, error)
 	GetMe(ctx context.Context, token string) (*UserDetails, error)
 	Logout(ctx context.Context, userID uint64, ip, userAgent string) error
-	ValidateToken(ctx context.Context, token string) (*models.User, error)
+	ValidateToken(ctx context.Context, token string) (*models.User, *models.ValidatedToken, error)
 	RequestAccountSecurity(ctx context.Context, userID uint64, minutes int32, phone string) error
 	VerifyAccountSecurity(ctx context.Context, userID uint64, code, ip, userAgent string) error
 }
@@ -43,6 +43,7 @@ type authService struct {
 	activityRepo        repository.ActivityRepository
 	observerService     ObserverService
 	helperService       HelperService
+	meCache             *MeCache
 	notificationsClient notificationspb.SMSServiceClient
 	oauthServerURL      string
 	oauthClientID       string
@@ -73,6 +74,8 @@ type UserDetails struct {
 	HourlyProfitTimePercentage float64
 	VerifiedKYC                bool
 	Birthdate                  string
+	EmailVerified              bool
+	PhoneVerified              bool
 }
 
 type LevelInfo struct {
@@ -108,6 +111,26 @@ func NewAuthService(
 	helperService HelperService,
 	notificationsClient notificationspb.SMSServiceClient,
 	oauthServerURL, oauthClientID, oauthClientSecret, appURL, frontEndURL string,
+) AuthService {
+	return NewAuthServiceWithCache(
+		userRepo, tokenRepo, cacheRepo, accountSecurityRepo, activityRepo, observerService, helperService, nil,
+		notificationsClient, oauthServerURL, oauthClientID, oauthClientSecret, appURL, frontEndURL,
+	)
+}
+
+// NewAuthServiceWithCache creates an auth service whose GetMe serves the
+// snapshots of meCache
+func NewAuthServiceWithCache(
+	userRepo repository.UserRepository,
+	tokenRepo repository.TokenRepository,
+	cacheRepo repository.CacheRepository,
+	accountSecurityRepo repository.AccountSecurityRepository,
+	activityRepo repository.ActivityRepository,
+	observerService ObserverService,
+	helperService HelperService,
+	meCache *MeCache,
+	notificationsClient notificationspb.SMSServiceClient,
+	oauthServerURL, oauthClientID, oauthClientSecret, appURL, frontEndURL string,
 ) AuthService {
 	// Validate OAuth configuration
 	if oauthServerURL == "" {
@@ -128,6 +151,7 @@ func NewAuthService(
 		activityRepo:        activityRepo,
 		observerService:     observerService,
 		helperService:       helperService,
+		meCache:             meCache,
 		notificationsClient: notificationsClient,
 		oauthServerURL:      oauthServerURL,
 		oauthClientID:       oauthClientID,
@@ -211,7 +235,7 @@ func (s *authService) Redirect(ctx context.Context, redirectTo, backURL string)
 	return authURL, state, nil
 }
 
-func (s *authService) Callback(ctx context.Context, state, code, ip string) (*CallbackResult, error) {
+func (s *authService) Callback(ctx context.Context, state, code, ip, userAgent string) (*CallbackResult, error) {
 	// Retrieve and remove cached state (pull semantics)
 	// Throws InvalidArgumentException if missing or doesn't match
 	stateExists, err := s.cacheRepo.GetState(ctx, state)
@@ -298,32 +322,16 @@ func (s *authService) Callback(ctx context.Context, state, code, ip string) (*Ca
 		return nil, fmt.Errorf("failed to save user: %w", err)
 	}
 
-	// Get user settings
-	settings, err := s.userRepo.GetSettings(ctx, user.ID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get settings: %w", err)
-	}
-
 	// Create Sanctum token
-	automaticLogout := settings.AutomaticLogout
-	if automaticLogout == 0 {
-		automaticLogout = 55
-	}
-	expiresAt := time.Now().Add(time.Duration(automaticLogout) * time.Minute)
-
-	token, err := s.tokenRepo.Create(ctx, user.ID, fmt.Sprintf("token_%d", user.ID), expiresAt)
+	plainToken, expiresAt, err := issueLoginToken(ctx, s.userRepo, s.tokenRepo, user)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create token: %w", err)
+		return nil, err
 	}
 
-	// Extract just the token part (after the |)
-	tokenParts := splitToken(token)
-	plainToken := tokenParts[1]
-
 	// Trigger login observer (fires logedIn event)
 	// Note: UserAgent should be extracted from gRPC metadata
 	if s.observerService != nil {
-		if err := s.observerService.OnUserLogin(ctx, user, ip, ""); err != nil {
+		if err := s.observerService.OnUserLogin(ctx, user, ip, userAgent); err != nil {
 			// Log error but don't fail the login
 			fmt.Printf("observer error on login: %v\n", err)
 		}
@@ -339,20 +347,7 @@ func (s *authService) Callback(ctx context.Context, state, code, ip string) (*Ca
 	if redirectBaseURL == "" {
 		redirectBaseURL = backURL
 	}
-	if redirectBaseURL == "" {
-		redirectBaseURL = s.frontEndURL
-		if redirectBaseURL == "" {
-			log.Printf("Warning: No redirect URL cached and FRONT_END_URL is not set")
-			redirectBaseURL = "http://localhost:3000" // Default fallback
-		}
-	}
-
-	// Construct redirect URL with token and expires_at query parameters
-	// Match Laravel format: base_url + '/?' + query_string
-	redirectParams := url.Values{}
-	redirectParams.Set("token", plainToken)
-	redirectParams.Set("expires_at", fmt.Sprintf("%d", int32(time.Until(expiresAt).Minutes())))
-	redirectURL := fmt.Sprintf("%s/?%s", redirectBaseURL, redirectParams.Encode())
+	redirectURL := loginRedirectURL(redirectBaseURL, s.frontEndURL, plainToken, expiresAt)
 
 	log.Printf("Callback successful for user %d, redirecting to: %s", user.ID, redirectURL)
 
@@ -366,86 +361,148 @@ func (s *authService) Callback(ctx context.Context, state, code, ip string) (*Ca
 }
 
 func (s *authService) GetMe(ctx context.Context, token string) (*UserDetails, error) {
-	user, err := s.tokenRepo.ValidateToken(ctx, token)
+	user, _, err := s.tokenRepo.ValidateToken(ctx, token)
 	if err != nil {
 		return nil, err
 	}
 
-	// Update last seen
-	_ = s.userRepo.UpdateLastSeen(ctx, user.ID)
-
-	// Get settings
-	settings, err := s.userRepo.GetSettings(ctx, user.ID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get settings: %w", err)
+	snapshot, generation, cacheable := s.meCache.Get(ctx, user.ID, user.Score)
+	if snapshot == nil {
+		var complete bool
+		snapshot, complete, err = s.buildMeSnapshot(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+		// Snapshots missing a lookup that failed are not kept
+		if complete && cacheable {
+			s.meCache.Set(ctx, snapshot, generation)
+		}
 	}
 
-	// Get KYC
-	kyc, err := s.userRepo.GetKYC(ctx, user.ID)
-
-	// Get unread notifications count
-	notificationsCount, _ := s.userRepo.GetUnreadNotificationsCount(ctx, user.ID)
-
 	// Prepare user details
 	details := &UserDetails{
-		ID:              user.ID,
-		Name:            user.Name,
-		Token:           token,
-		Code:            user.Code,
-		AutomaticLogout: settings.AutomaticLogout,
-		Notifications:   notificationsCount,
-		VerifiedKYC:     kyc != nil && kyc.Status == 1,
+		ID:                         user.ID,
+		Name:                       user.Name,
+		Token:                      token,
+		Code:                       user.Code,
+		AutomaticLogout:            snapshot.AutomaticLogout,
+		Notifications:              snapshot.Notifications,
+		VerifiedKYC:                snapshot.VerifiedKYC,
+		EmailVerified:              user.EmailVerifiedAt.Valid,
+		PhoneVerified:              user.PhoneVerifiedAt.Valid,
+		Birthdate:                  snapshot.Birthdate,
+		ScorePercentageToNextLevel: snapshot.ScorePercentageToNextLevel,
+		Image:                      snapshot.Image,
 	}
 
 	if user.AccessToken.Valid {
 		details.AccessToken = user.AccessToken.String
 	}
+	if snapshot.VerifiedKYC {
+		details.Name = snapshot.KYCName
+	}
+	if level := snapshot.Level; level != nil {
+		details.Level = &LevelInfo{
+			ID:          level.ID,
+			Title:       level.Title,
+			Description: level.Description,
+			Score:       level.Score,
+		}
+	}
+
+	// Unanswered questions and the hourly profit percentage change without the
+	// user doing anything, so they are never cached
+	if s.helperService != nil {
+		// Get unanswered questions count
+		unansweredCount, err := s.helperService.GetUnansweredQuestionsCount(ctx, user.ID)
+		if err == nil {
+			details.UnansweredQuestionsCount = unansweredCount
+		}
+
+		// Get hourly profit time percentage
+		profitPercentage, err := s.helperService.GetHourlyProfitTimePercentage(ctx, user.ID)
+		if err == nil {
+			details.HourlyProfitTimePercentage = profitPercentage
+		}
+	}
 
+	return details, nil
+}
+
+// buildMeSnapshot reads the cacheable part of GetMe. It reports false when an
+// optional lookup failed and the snapshot holds its zero value instead.
+func (s *authService) buildMeSnapshot(ctx context.Context, user *models.User) (*models.MeSnapshot, bool, error) {
+	complete := true
+
+	// Get settings
+	settings, err := s.userRepo.GetSettings(ctx, user.ID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get settings: %w", err)
+	}
+
+	snapshot := &models.MeSnapshot{
+		UserID:          user.ID,
+		Score:           user.Score,
+		AutomaticLogout: settings.AutomaticLogout,
+	}
+
+	// Get KYC
+	kyc, err := s.userRepo.GetKYC(ctx, user.ID)
+	if err != nil {
+		complete = false
+	}
 	if kyc != nil && kyc.Status == 1 {
-		details.Name = kyc.FullName()
+		snapshot.VerifiedKYC = true
+		snapshot.KYCName = kyc.FullName()
 		if kyc.Birthdate.Valid {
 			// Format as Jalali date Y/m/d
 			// Import shared helpers for Jalali formatting
 			// For now, using simple format - TODO: integrate shared/pkg/helpers/jalali.go
-			details.Birthdate = kyc.Birthdate.Time.Format("2006/01/02")
+			snapshot.Birthdate = kyc.Birthdate.Time.Format("2006/01/02")
 		}
 	}
 
-	// Get level, score percentage, unanswered questions, hourly profit percentage
-	// These require integration with Levels and Features services
+	// Get unread notifications count
+	notificationsCount, err := s.userRepo.GetUnreadNotificationsCount(ctx, user.ID)
+	if err != nil {
+		complete = false
+	}
+	snapshot.Notifications = notificationsCount
+
+	// Get level and score percentage
+	// These require integration with Levels service
 	if s.helperService != nil {
 		// Get user level
 		level, err := s.helperService.GetUserLevel(ctx, user.ID)
-		if err == nil && level != nil {
-			details.Level = level
+		if err != nil {
+			complete = false
+		} else if level != nil {
+			snapshot.Level = &models.MeLevel{
+				ID:          level.ID,
+				Title:       level.Title,
+				Description: level.Description,
+				Score:       level.Score,
+			}
 		}
 
 		// Get score percentage to next level
 		scorePercentage, err := s.helperService.GetScorePercentageToNextLevel(ctx, user.ID, user.Score)
-		if err == nil {
-			details.ScorePercentageToNextLevel = scorePercentage
-		}
-
-		// Get unanswered questions count
-		unansweredCount, err := s.helperService.GetUnansweredQuestionsCount(ctx, user.ID)
-		if err == nil {
-			details.UnansweredQuestionsCount = unansweredCount
-		}
-
-		// Get hourly profit time percentage
-		profitPercentage, err := s.helperService.GetHourlyProfitTimePercentage(ctx, user.ID)
-		if err == nil {
-			details.HourlyProfitTimePercentage = profitPercentage
+		if err != nil {
+			complete = false
+		} else {
+			snapshot.ScorePercentageToNextLevel = scorePercentage
 		}
 	}
 
 	// Get profile image (latest profile photo)
 	imageURL, err := s.userRepo.GetLatestProfilePhotoURL(ctx, user.ID)
-	if err == nil && imageURL != "" {
-		details.Image = imageURL
+	if err != nil {
+		complete = false
+	} else {
+		snapshot.Image = imageURL
 	}
 
-	return details, nil
+	return snapshot, complete, nil
 }
 
 func (s *authService) Logout(ctx context.Context, userID uint64, ip, userAgent string) error {
@@ -471,7 +528,9 @@ func (s *authService) Logout(ctx context.Context, userID uint64, ip, userAgent s
 	return s.tokenRepo.DeleteUserTokens(ctx, userID)
 }
 
-func (s *authService) ValidateToken(ctx context.Context, token string) (*models.User, error) {
+// ValidateToken returns the token's user, its scopes and when the token
+// expires unless it is used again
+func (s *authService) ValidateToken(ctx context.Context, token string) (*models.User, *models.ValidatedToken, error) {
 	return s.tokenRepo.ValidateToken(ctx, token)
 }
 
@@ -574,7 +633,7 @@ func (s *authService) RequestAccountSecurity(ctx context.Context, userID uint64,
 	if user.Phone.Valid {
 		phoneForOTP = user.Phone.String
 	}
-	if err := s.dispatchAccountSecurityOTP(ctx, phoneForOTP, code); err != nil {
+	if err := s.dispatchAccountSecurityOTP(ctx, user.ID, phoneForOTP, code); err != nil {
 		return err
 	}
 
@@ -650,7 +709,7 @@ func (s *authService) VerifyAccountSecurity(ctx context.Context, userID uint64,
 	return nil
 }
 
-func (s *authService) dispatchAccountSecurityOTP(ctx context.Context, phone, code string) error {
+func (s *authService) dispatchAccountSecurityOTP(ctx context.Context, userID uint64, phone, code string) error {
 	phone = strings.TrimSpace(phone)
 	if phone == "" {
 		return ErrPhoneRequired
@@ -667,6 +726,7 @@ func (s *authService) dispatchAccountSecurityOTP(ctx context.Context, phone, cod
 		Phone:  phone,
 		Code:   code,
 		Reason: "verify",
+		UserId: userID,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to dispatch account security otp: %w", err)
@@ -787,6 +847,47 @@ func generateRandomString(length int) string {
 	return string(b)
 }
 
+// issueLoginToken creates a personal access token for user that expires after
+// the user's automatic_logout setting, and returns its plain text part
+func issueLoginToken(ctx context.Context, userRepo repository.UserRepository, tokenRepo repository.TokenRepository, user *models.User) (string, time.Time, error) {
+	settings, err := userRepo.GetSettings(ctx, user.ID)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to get settings: %w", err)
+	}
+
+	automaticLogout := settings.AutomaticLogout
+	if automaticLogout == 0 {
+		automaticLogout = models.DefaultAutomaticLogout
+	}
+	expiresAt := time.Now().Add(time.Duration(automaticLogout) * time.Minute)
+
+	token, err := tokenRepo.Create(ctx, user.ID, fmt.Sprintf("token_%d", user.ID), expiresAt)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to create token: %w", err)
+	}
+
+	// Extract just the token part (after the |)
+	return splitToken(token)[1], expiresAt, nil
+}
+
+// loginRedirectURL builds the frontend URL a login lands on, falling back to
+// frontEndURL when no base URL was requested.
+// Match Laravel format: base_url + '/?' + query_string
+func loginRedirectURL(baseURL, frontEndURL, plainToken string, expiresAt time.Time) string {
+	if baseURL == "" {
+		baseURL = frontEndURL
+		if baseURL == "" {
+			log.Printf("Warning: No redirect URL cached and FRONT_END_URL is not set")
+			baseURL = "http://localhost:3000" // Default fallback
+		}
+	}
+
+	redirectParams := url.Values{}
+	redirectParams.Set("token", plainToken)
+	redirectParams.Set("expires_at", fmt.Sprintf("%d", int32(time.Until(expiresAt).Minutes())))
+	return fmt.Sprintf("%s/?%s", baseURL, redirectParams.Encode())
+}
+
 func splitToken(token string) [2]string {
 	for i := 0; i < len(token); i++ {
 		if token[i] == '|' {
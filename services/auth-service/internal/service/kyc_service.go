@@ -55,12 +55,20 @@ type KYCService interface {
 type kycService struct {
 	kycRepo  repository.KYCRepository
 	userRepo repository.UserRepository
+	meCache  *MeCache
 }
 
 func NewKYCService(kycRepo repository.KYCRepository, userRepo repository.UserRepository) KYCService {
+	return NewKYCServiceWithCache(kycRepo, userRepo, nil)
+}
+
+// NewKYCServiceWithCache creates a KYC service that invalidates the GetMe
+// snapshot of users who submit their KYC
+func NewKYCServiceWithCache(kycRepo repository.KYCRepository, userRepo repository.UserRepository, meCache *MeCache) KYCService {
 	return &kycService{
 		kycRepo:  kycRepo,
 		userRepo: userRepo,
+		meCache:  meCache,
 	}
 }
 
@@ -159,6 +167,7 @@ func (s *kycService) UpdateKYC(ctx context.Context, userID uint64, fname, lname,
 		if err := s.kycRepo.Update(ctx, existing); err != nil {
 			return nil, fmt.Errorf("failed to update kyc: %w", err)
 		}
+		s.meCache.Invalidate(ctx, userID)
 		return existing, nil
 	}
 
@@ -181,6 +190,7 @@ func (s *kycService) UpdateKYC(ctx context.Context, userID uint64, fname, lname,
 	if err := s.kycRepo.Create(ctx, kyc); err != nil {
 		return nil, fmt.Errorf("failed to create kyc: %w", err)
 	}
+	s.meCache.Invalidate(ctx, userID)
 
 	return kyc, nil
 }
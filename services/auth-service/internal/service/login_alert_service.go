@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/repository"
+	notificationspb "metargb/shared/pb/notifications"
+)
+
+var (
+	ErrLoginAlertNotFound = errors.New("login alert not found")
+	ErrLoginAlertAnswered = errors.New("login alert has already been answered")
+)
+
+// LoginAlertService detects logins from a device or IP the user has not used
+// before, alerts the user in-app and by SMS, and records their answer to
+// "was this you?"
+type LoginAlertService interface {
+	// CheckLogin records the login's device and raises an alert when it is new.
+	// The first login of a user only establishes the baseline.
+	CheckLogin(ctx context.Context, user *models.User, event *models.UserEvent) (*models.LoginAlert, error)
+	ListLoginAlerts(ctx context.Context, userID uint64, pendingOnly bool) ([]*models.LoginAlert, error)
+	// ConfirmLoginAlert stores the user's answer; answering "not me" signs the
+	// user out of every session
+	ConfirmLoginAlert(ctx context.Context, userID, alertID uint64, wasMe bool) (*models.LoginAlert, error)
+}
+
+type loginAlertService struct {
+	alertRepo          repository.LoginAlertRepository
+	tokenRepo          repository.TokenRepository
+	notificationClient notificationspb.NotificationServiceClient
+	smsClient          notificationspb.SMSServiceClient
+}
+
+// NewLoginAlertService creates the service. Either notification client may be
+// nil, in which case that channel is skipped.
+func NewLoginAlertService(
+	alertRepo repository.LoginAlertRepository,
+	tokenRepo repository.TokenRepository,
+	notificationClient notificationspb.NotificationServiceClient,
+	smsClient notificationspb.SMSServiceClient,
+) LoginAlertService {
+	return &loginAlertService{
+		alertRepo:          alertRepo,
+		tokenRepo:          tokenRepo,
+		notificationClient: notificationClient,
+		smsClient:          smsClient,
+	}
+}
+
+func (s *loginAlertService) CheckLogin(ctx context.Context, user *models.User, event *models.UserEvent) (*models.LoginAlert, error) {
+	fingerprint := deviceFingerprint(event.Device)
+
+	hasDevices, deviceKnown, ipKnown, err := s.alertRepo.KnownLogin(ctx, user.ID, fingerprint, event.IP)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.alertRepo.TouchDevice(ctx, &models.UserDevice{
+		UserID:      user.ID,
+		Fingerprint: fingerprint,
+		IP:          event.IP,
+		UserAgent:   event.Device,
+	}); err != nil {
+		return nil, err
+	}
+
+	reason := loginAlertReason(hasDevices, deviceKnown, ipKnown)
+	if reason == "" {
+		return nil, nil
+	}
+
+	alert := &models.LoginAlert{
+		UserID:      user.ID,
+		UserEventID: event.ID,
+		Reason:      reason,
+		IP:          event.IP,
+		Device:      event.Device,
+		CreatedAt:   time.Now(),
+	}
+	if err := s.alertRepo.CreateAlert(ctx, alert); err != nil {
+		return nil, err
+	}
+
+	s.notify(ctx, user, alert)
+
+	return alert, nil
+}
+
+func (s *loginAlertService) ListLoginAlerts(ctx context.Context, userID uint64, pendingOnly bool) ([]*models.LoginAlert, error) {
+	return s.alertRepo.ListAlerts(ctx, userID, pendingOnly)
+}
+
+func (s *loginAlertService) ConfirmLoginAlert(ctx context.Context, userID, alertID uint64, wasMe bool) (*models.LoginAlert, error) {
+	alert, err := s.alertRepo.FindAlert(ctx, userID, alertID)
+	if err != nil {
+		return nil, err
+	}
+	if alert == nil {
+		return nil, ErrLoginAlertNotFound
+	}
+	if alert.Confirmed.Valid {
+		return nil, ErrLoginAlertAnswered
+	}
+
+	if err := s.alertRepo.RespondAlert(ctx, alertID, wasMe); err != nil {
+		return nil, err
+	}
+	alert.Confirmed = sql.NullBool{Bool: wasMe, Valid: true}
+	alert.RespondedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	if !wasMe {
+		// Someone else may hold a session, so end all of them
+		if err := s.tokenRepo.DeleteUserTokens(ctx, userID); err != nil {
+			return nil, fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+	}
+
+	return alert, nil
+}
+
+// notify sends the in-app and SMS alerts. Delivery failures are logged and
+// never fail the login.
+func (s *loginAlertService) notify(ctx context.Context, user *models.User, alert *models.LoginAlert) {
+	message := loginAlertMessage(alert)
+
+	sendCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if s.notificationClient != nil {
+		// Security alerts have no category so notification preferences cannot mute them
+		_, err := s.notificationClient.SendNotification(sendCtx, &notificationspb.SendNotificationRequest{
+			UserId:  user.ID,
+			Type:    "login_alert",
+			Title:   "هشدار ورود به حساب کاربری",
+			Message: message,
+			Data: map[string]string{
+				"alert_id": strconv.FormatUint(alert.ID, 10),
+				"event_id": strconv.FormatUint(alert.UserEventID, 10),
+				"reason":   alert.Reason,
+				"ip":       alert.IP,
+				"device":   alert.Device,
+			},
+		})
+		if err != nil {
+			fmt.Printf("failed to send login alert notification: %v\n", err)
+		}
+	}
+
+	if s.smsClient != nil && user.Phone.Valid && strings.TrimSpace(user.Phone.String) != "" {
+		// Critical messages reach the user's linked bot chat when SMS fails
+		_, err := s.smsClient.SendSMS(sendCtx, &notificationspb.SendSMSRequest{
+			Phone:    strings.TrimSpace(user.Phone.String),
+			Message:  message,
+			UserId:   user.ID,
+			Critical: true,
+		})
+		if err != nil {
+			fmt.Printf("failed to send login alert sms: %v\n", err)
+		}
+	}
+}
+
+// loginAlertReason decides whether a login is unusual. Nothing is reported
+// until the user has at least one recorded device.
+func loginAlertReason(hasDevices, deviceKnown, ipKnown bool) string {
+	switch {
+	case !hasDevices:
+		return ""
+	case !deviceKnown:
+		return models.LoginAlertReasonNewDevice
+	case !ipKnown:
+		return models.LoginAlertReasonNewIP
+	default:
+		return ""
+	}
+}
+
+func loginAlertMessage(alert *models.LoginAlert) string {
+	source := "دستگاه جدید"
+	if alert.Reason == models.LoginAlertReasonNewIP {
+		source = "آی‌پی جدید"
+	}
+	return fmt.Sprintf("ورود به حساب کاربری شما از %s (%s) ثبت شد. اگر این ورود توسط شما نبوده است، آن را در بخش رویدادها رد کنید.", source, alert.IP)
+}
+
+// deviceFingerprint identifies a device by its normalised user agent
+func deviceFingerprint(userAgent string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(userAgent))))
+	return hex.EncodeToString(sum[:])
+}
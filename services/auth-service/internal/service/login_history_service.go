@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/repository"
+	"metargb/shared/pkg/geoip"
+)
+
+const (
+	// DefaultLoginHistoryPerPage is the page size of the login history when none is given
+	DefaultLoginHistoryPerPage = 10
+	// MaxLoginHistoryPerPage caps the page size of the login history
+	MaxLoginHistoryPerPage = 50
+)
+
+// IPLocator finds the approximate location of an IP, implemented by *geoip.DB
+type IPLocator interface {
+	Lookup(ip string) (geoip.Location, bool)
+}
+
+// LoginHistoryService lists a user's recent logins so they can audit access
+// to their account
+type LoginHistoryService interface {
+	// GetLoginHistory returns a page of the user's logins, newest first, and
+	// the next and previous page URLs
+	GetLoginHistory(ctx context.Context, userID uint64, page, perPage int32) ([]*models.LoginHistoryEntry, string, string, error)
+}
+
+type loginHistoryService struct {
+	repo    repository.LoginHistoryRepository
+	locator IPLocator
+}
+
+// NewLoginHistoryService creates the service. locator may be nil, in which
+// case logins have no location.
+func NewLoginHistoryService(repo repository.LoginHistoryRepository, locator IPLocator) LoginHistoryService {
+	return &loginHistoryService{
+		repo:    repo,
+		locator: locator,
+	}
+}
+
+func (s *loginHistoryService) GetLoginHistory(ctx context.Context, userID uint64, page, perPage int32) ([]*models.LoginHistoryEntry, string, string, error) {
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 {
+		perPage = DefaultLoginHistoryPerPage
+	}
+	if perPage > MaxLoginHistoryPerPage {
+		perPage = MaxLoginHistoryPerPage
+	}
+
+	// One extra login tells whether there is a next page
+	entries, err := s.repo.ListLogins(ctx, userID, int(perPage)+1, int((page-1)*perPage))
+	if err != nil {
+		return nil, "", "", fmt.Errorf("failed to get login history: %w", err)
+	}
+
+	var nextPageURL, prevPageURL string
+	if len(entries) > int(perPage) {
+		entries = entries[:perPage]
+		nextPageURL = fmt.Sprintf("/api/auth/login-history?page=%d&per_page=%d", page+1, perPage)
+	}
+	if page > 1 {
+		prevPageURL = fmt.Sprintf("/api/auth/login-history?page=%d&per_page=%d", page-1, perPage)
+	}
+
+	if s.locator != nil {
+		for _, entry := range entries {
+			if location, ok := s.locator.Lookup(entry.IP); ok {
+				entry.Location = location
+			}
+		}
+	}
+
+	return entries, nextPageURL, prevPageURL, nil
+}
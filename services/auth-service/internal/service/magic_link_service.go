@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/repository"
+	notificationspb "metargb/shared/pb/notifications"
+)
+
+// Magic link limits
+const (
+	MagicLinkTTL = 15 * time.Minute
+
+	magicLinkEmailLimit  = 3
+	magicLinkEmailWindow = 15 * time.Minute
+	magicLinkIPLimit     = 10
+	magicLinkIPWindow    = time.Hour
+)
+
+var (
+	ErrMagicLinkDisabled       = errors.New("magic link login is not enabled")
+	ErrMagicLinkRateLimited    = errors.New("too many magic link requests, please try again later")
+	ErrMagicLinkInvalid        = errors.New("magic link is invalid or has already been used")
+	ErrMagicLinkExpired        = errors.New("magic link has expired")
+	ErrMagicLinkDeviceMismatch = errors.New("magic link must be opened on the device that requested it")
+	ErrMagicLinkDeviceRequired = errors.New("magic link requests must carry a device nonce")
+	ErrMagicLinkBackURL        = errors.New("back_url must point to the frontend")
+)
+
+// MagicLinkService provides password-less login by email for users who can
+// not complete the OAuth flow, e.g. because they lost access to their phone
+type MagicLinkService interface {
+	// RequestMagicLink emails a signed one-time login URL that only works
+	// together with deviceNonce, a random value the gateway keeps in a cookie
+	// of the requesting browser. It succeeds without sending anything when no
+	// user has the email.
+	RequestMagicLink(ctx context.Context, email, backURL, ip, deviceNonce string) error
+	// ConsumeMagicLink exchanges a link for a personal access token
+	ConsumeMagicLink(ctx context.Context, token string, expires int64, signature, ip, userAgent, deviceNonce string) (*CallbackResult, error)
+}
+
+type magicLinkService struct {
+	userRepo        repository.UserRepository
+	tokenRepo       repository.TokenRepository
+	magicLinkRepo   repository.MagicLinkRepository
+	observerService ObserverService
+	emailClient     notificationspb.EmailServiceClient
+	secret          []byte
+	appURL          string
+	frontEndURL     string
+	backURLs        *RedirectAllowlist
+	now             func() time.Time
+}
+
+// NewMagicLinkService creates the service. Magic links are disabled while
+// secret is empty or emailClient is nil. A back_url must have the origin of
+// frontEndURL or one of allowedOrigins.
+func NewMagicLinkService(
+	userRepo repository.UserRepository,
+	tokenRepo repository.TokenRepository,
+	magicLinkRepo repository.MagicLinkRepository,
+	observerService ObserverService,
+	emailClient notificationspb.EmailServiceClient,
+	secret, appURL, frontEndURL string,
+	allowedOrigins []string,
+) MagicLinkService {
+	return &magicLinkService{
+		userRepo:        userRepo,
+		tokenRepo:       tokenRepo,
+		magicLinkRepo:   magicLinkRepo,
+		observerService: observerService,
+		emailClient:     emailClient,
+		secret:          []byte(secret),
+		appURL:          appURL,
+		frontEndURL:     frontEndURL,
+		backURLs:        NewRedirectAllowlist(frontEndURL, allowedOrigins),
+		now:             time.Now,
+	}
+}
+
+func (s *magicLinkService) RequestMagicLink(ctx context.Context, email, backURL, ip, deviceNonce string) error {
+	if len(s.secret) == 0 || s.emailClient == nil {
+		return ErrMagicLinkDisabled
+	}
+	if deviceNonce == "" {
+		return ErrMagicLinkDeviceRequired
+	}
+	// The login token is appended to back_url, so it must never leave the frontend
+	if backURL != "" && !s.backURLs.Allows(backURL) {
+		return ErrMagicLinkBackURL
+	}
+
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	// Count before looking the user up so unknown emails are limited the same way
+	if err := s.checkRateLimit(ctx, "email:"+email, magicLinkEmailLimit, magicLinkEmailWindow); err != nil {
+		return err
+	}
+	if err := s.checkRateLimit(ctx, "ip:"+ip, magicLinkIPLimit, magicLinkIPWindow); err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil
+	}
+
+	token, err := generateState()
+	if err != nil {
+		return fmt.Errorf("failed to generate magic link token: %w", err)
+	}
+
+	now := s.now()
+	link := &models.MagicLink{
+		UserID:      user.ID,
+		Fingerprint: hashMagicLinkToken(deviceNonce),
+		IP:          ip,
+		BackURL:     backURL,
+		CreatedAt:   now,
+	}
+	if err := s.magicLinkRepo.Store(ctx, hashMagicLinkToken(token), link, MagicLinkTTL); err != nil {
+		return err
+	}
+
+	expires := now.Add(MagicLinkTTL).Unix()
+	params := url.Values{}
+	params.Set("token", token)
+	params.Set("expires", strconv.FormatInt(expires, 10))
+	params.Set("signature", s.sign(token, expires))
+	loginURL := fmt.Sprintf("%s/api/auth/magic-link/callback?%s", s.appURL, params.Encode())
+
+	sendCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	_, err = s.emailClient.SendEmail(sendCtx, &notificationspb.SendEmailRequest{
+		To:       email,
+		Subject:  "ورود به حساب کاربری",
+		Body:     magicLinkEmailBody(loginURL),
+		HtmlBody: fmt.Sprintf(`<p>برای ورود به حساب کاربری خود روی <a href="%s">این لینک</a> کلیک کنید.</p><p>این لینک تا %d دقیقه و فقط یک بار معتبر است.</p>`, html.EscapeString(loginURL), int(MagicLinkTTL.Minutes())),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send magic link email: %w", err)
+	}
+
+	return nil
+}
+
+func (s *magicLinkService) ConsumeMagicLink(ctx context.Context, token string, expires int64, signature, ip, userAgent, deviceNonce string) (*CallbackResult, error) {
+	if len(s.secret) == 0 {
+		return nil, ErrMagicLinkDisabled
+	}
+
+	if token == "" || !hmac.Equal([]byte(signature), []byte(s.sign(token, expires))) {
+		return nil, ErrMagicLinkInvalid
+	}
+	if s.now().Unix() > expires {
+		return nil, ErrMagicLinkExpired
+	}
+
+	link, err := s.magicLinkRepo.Consume(ctx, hashMagicLinkToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if link == nil {
+		return nil, ErrMagicLinkInvalid
+	}
+	// The link has been consumed either way, so a leaked link can not be
+	// retried from the right device afterwards
+	if deviceNonce == "" || !hmac.Equal([]byte(link.Fingerprint), []byte(hashMagicLinkToken(deviceNonce))) {
+		return nil, ErrMagicLinkDeviceMismatch
+	}
+
+	user, err := s.userRepo.FindByID(ctx, link.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil, ErrMagicLinkInvalid
+	}
+
+	plainToken, expiresAt, err := issueLoginToken(ctx, s.userRepo, s.tokenRepo, user)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.observerService != nil {
+		if err := s.observerService.OnUserLogin(ctx, user, ip, userAgent); err != nil {
+			// Log error but don't fail the login
+			fmt.Printf("observer error on login: %v\n", err)
+		}
+	}
+
+	backURL := link.BackURL
+	if backURL != "" && !s.backURLs.Allows(backURL) {
+		// Stored before the allowlist changed
+		backURL = ""
+	}
+	redirectURL := loginRedirectURL(backURL, s.frontEndURL, plainToken, expiresAt)
+	log.Printf("Magic link login successful for user %d", user.ID)
+
+	return &CallbackResult{
+		Token:       plainToken,
+		ExpiresAt:   int32(time.Until(expiresAt).Minutes()),
+		RedirectURL: redirectURL,
+	}, nil
+}
+
+func (s *magicLinkService) checkRateLimit(ctx context.Context, key string, limit int64, window time.Duration) error {
+	count, err := s.magicLinkRepo.IncrementRequests(ctx, key, window)
+	if err != nil {
+		return err
+	}
+	if count > limit {
+		return ErrMagicLinkRateLimited
+	}
+	return nil
+}
+
+// sign returns the HMAC-SHA256 signature of a link's token and expiry
+func (s *magicLinkService) sign(token string, expires int64) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(token + "|" + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// hashMagicLinkToken keys stored links by hash so a Redis dump can not be
+// turned into working links
+func hashMagicLinkToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func magicLinkEmailBody(loginURL string) string {
+	return fmt.Sprintf("برای ورود به حساب کاربری خود لینک زیر را باز کنید:\n%s\n\nاین لینک تا %d دقیقه و فقط یک بار معتبر است. اگر این درخواست توسط شما ارسال نشده است، این ایمیل را نادیده بگیرید.", loginURL, int(MagicLinkTTL.Minutes()))
+}
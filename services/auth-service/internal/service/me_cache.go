@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/repository"
+)
+
+// DefaultMeCacheTTL bounds how long GetMe serves a snapshot, so changes made
+// outside auth-service, such as an administrator verifying a KYC, show up
+// without an invalidation
+const DefaultMeCacheTTL = 5 * time.Minute
+
+// MeCache keeps the settings, KYC, unread notifications count, level and
+// profile photo GetMe reads for each user. The services of auth-service that
+// change them invalidate the user, and so do notifications-service's events
+// about new and read notifications. A nil *MeCache caches nothing.
+type MeCache struct {
+	repo          repository.MeCacheRepository
+	ttl           time.Duration
+	retryInterval time.Duration
+}
+
+// NewMeCache creates a cache keeping snapshots for ttl (DefaultMeCacheTTL if zero)
+func NewMeCache(repo repository.MeCacheRepository, ttl time.Duration) *MeCache {
+	if ttl <= 0 {
+		ttl = DefaultMeCacheTTL
+	}
+	return &MeCache{
+		repo:          repo,
+		ttl:           ttl,
+		retryInterval: DefaultPresenceRetryInterval,
+	}
+}
+
+// Get returns the user's snapshot if it is current and was built for score,
+// along with the generation a rebuilt snapshot must be stored with. It
+// reports false when the cache could not be read, and nothing should be stored.
+func (c *MeCache) Get(ctx context.Context, userID uint64, score int32) (*models.MeSnapshot, int64, bool) {
+	if c == nil {
+		return nil, 0, false
+	}
+	snapshot, generation, err := c.repo.Get(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to read GetMe cache of user %d: %v", userID, err)
+		return nil, 0, false
+	}
+	if snapshot == nil || snapshot.Score != score {
+		return nil, generation, true
+	}
+	return snapshot, generation, true
+}
+
+// Set stores a snapshot built in generation
+func (c *MeCache) Set(ctx context.Context, snapshot *models.MeSnapshot, generation int64) {
+	if c == nil {
+		return
+	}
+	snapshot.Generation = generation
+	if err := c.repo.Set(ctx, snapshot, c.ttl); err != nil {
+		log.Printf("Failed to write GetMe cache of user %d: %v", snapshot.UserID, err)
+	}
+}
+
+// Invalidate drops the user's snapshot after something it holds changed
+func (c *MeCache) Invalidate(ctx context.Context, userID uint64) {
+	if c == nil {
+		return
+	}
+	if err := c.repo.Invalidate(ctx, userID, c.ttl); err != nil {
+		log.Printf("Failed to invalidate GetMe cache of user %d: %v", userID, err)
+	}
+}
+
+// Start invalidates the users whose notifications changed until ctx is
+// cancelled, resubscribing after a failure
+func (c *MeCache) Start(ctx context.Context) {
+	if c == nil {
+		return
+	}
+	go func() {
+		for {
+			if err := c.repo.ListenNotificationsChanged(ctx, func(userID uint64) {
+				c.Invalidate(ctx, userID)
+			}); err != nil {
+				log.Printf("Notifications subscription failed: %v", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(c.retryInterval):
+			}
+		}
+	}()
+}
@@ -36,8 +36,7 @@ type observerService struct {
 	settingsRepo repository.SettingsRepository
 	activityRepo repository.ActivityRepository
 	publisher    pubsub.RedisPublisher
-	// TODO: Add notification service client for sending login notifications
-	// notificationClient pb.NotificationServiceClient
+	loginAlerts  LoginAlertService
 }
 
 func NewObserverService(
@@ -52,17 +51,21 @@ func NewObserverService(
 	}
 }
 
+// NewObserverServiceWithSettings creates an observer that also seeds user
+// settings and, when loginAlerts is not nil, checks logins for new devices
 func NewObserverServiceWithSettings(
 	userRepo repository.UserRepository,
 	settingsRepo repository.SettingsRepository,
 	activityRepo repository.ActivityRepository,
 	publisher pubsub.RedisPublisher,
+	loginAlerts LoginAlertService,
 ) ObserverService {
 	return &observerService{
 		userRepo:     userRepo,
 		settingsRepo: settingsRepo,
 		activityRepo: activityRepo,
 		publisher:    publisher,
+		loginAlerts:  loginAlerts,
 	}
 }
 
@@ -72,7 +75,7 @@ func (s *observerService) OnUserLogin(ctx context.Context, user *models.User, ip
 	// 1. Create user event
 	event := &models.UserEvent{
 		UserID: user.ID,
-		Event:  "ورود به حساب کاربری", // "Login to user account" in Persian
+		Event:  models.UserEventLogin,
 		IP:     ip,
 		Device: userAgent,
 		Status: 1,
@@ -81,20 +84,15 @@ func (s *observerService) OnUserLogin(ctx context.Context, user *models.User, ip
 		return fmt.Errorf("failed to create login event: %w", err)
 	}
 
-	// 2. Update last_seen
-	if err := s.userRepo.UpdateLastSeen(ctx, user.ID); err != nil {
-		return fmt.Errorf("failed to update last seen: %w", err)
+	// 2. Alert the user about a login from a new device or IP
+	if s.loginAlerts != nil {
+		if _, err := s.loginAlerts.CheckLogin(ctx, user, event); err != nil {
+			// Log error but don't fail the login
+			fmt.Printf("failed to check login device: %v\n", err)
+		}
 	}
 
-	// 3. Send login notification (TODO: integrate with notifications service)
-	// notification := &pb.SendNotificationRequest{
-	//     UserId: user.ID,
-	//     Type:   "login",
-	//     Data:   map[string]string{"ip": ip},
-	// }
-	// _, err := s.notificationClient.SendNotification(ctx, notification)
-
-	// 4. Create activity tracking record
+	// 3. Create activity tracking record
 	activity := &models.UserActivity{
 		UserID: user.ID,
 		Start:  time.Now(),
@@ -104,7 +102,7 @@ func (s *observerService) OnUserLogin(ctx context.Context, user *models.User, ip
 		return fmt.Errorf("failed to create activity: %w", err)
 	}
 
-	// 5. Broadcast WebSocket event
+	// 4. Broadcast WebSocket event
 	if err := s.publisher.PublishUserStatusChanged(ctx, user.ID, true); err != nil {
 		// Log error but don't fail the login
 		fmt.Printf("failed to publish user status: %v\n", err)
@@ -142,14 +140,7 @@ func (s *observerService) OnUserLogout(ctx context.Context, user *models.User, i
 		fmt.Printf("failed to calculate score on logout: %v\n", err)
 	}
 
-	// 4. Set last_seen to 2 minutes ago (marks as offline)
-	// We'll update the user directly since we need a special time
-	// Note: This is a special case where we can't use UpdateLastSeen
-	// TODO: Add UpdateLastSeenWithTime method to repository
-	twoMinutesAgo := time.Now().Add(-2 * time.Minute)
-	user.LastSeen = sql.NullTime{Time: twoMinutesAgo, Valid: true}
-
-	// 5. Create logout event
+	// 4. Create logout event
 	event := &models.UserEvent{
 		UserID: user.ID,
 		Event:  "خروج از حساب کاربری", // "Logout from user account" in Persian
@@ -161,7 +152,7 @@ func (s *observerService) OnUserLogout(ctx context.Context, user *models.User, i
 		return fmt.Errorf("failed to create logout event: %w", err)
 	}
 
-	// 6. Broadcast offline status
+	// 5. Broadcast offline status
 	if err := s.publisher.PublishUserStatusChanged(ctx, user.ID, false); err != nil {
 		// Log error but don't fail the logout
 		fmt.Printf("failed to publish user offline status: %v\n", err)
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/repository"
+	authpkg "metargb/shared/pkg/auth"
+)
+
+const (
+	// MaxPersonalAccessTokensPerUser caps the number of scoped tokens a user can hold
+	MaxPersonalAccessTokensPerUser = 10
+
+	personalAccessTokenMaxNameLength = 255
+)
+
+var (
+	ErrPersonalAccessTokenNotFound      = errors.New("personal access token not found")
+	ErrPersonalAccessTokenNameRequired  = errors.New("personal access token name is required")
+	ErrPersonalAccessTokenNameTooLong   = errors.New("personal access token name must be 255 characters or less")
+	ErrPersonalAccessTokenScopeInvalid  = errors.New("personal access token scopes must be non-empty and listed by GET /api/personal-access-tokens/scopes")
+	ErrPersonalAccessTokenExpiresAt     = errors.New("personal access token expiry must be a future RFC3339 timestamp")
+	ErrPersonalAccessTokenLimitExceeded = errors.New("maximum number of personal access tokens reached")
+)
+
+// PersonalAccessTokenService mints bearer tokens limited to chosen scopes for
+// third-party apps. Unlike login tokens they are not logged out when idle.
+type PersonalAccessTokenService interface {
+	Scopes() []string
+	Create(ctx context.Context, userID uint64, name string, scopes []string, expiresAt string) (*models.ScopedToken, string, error)
+	List(ctx context.Context, userID uint64) ([]*models.ScopedToken, error)
+	Revoke(ctx context.Context, userID, tokenID uint64) error
+}
+
+type personalAccessTokenService struct {
+	tokenRepo repository.PersonalAccessTokenRepository
+	userRepo  repository.UserRepository
+}
+
+func NewPersonalAccessTokenService(tokenRepo repository.PersonalAccessTokenRepository, userRepo repository.UserRepository) PersonalAccessTokenService {
+	return &personalAccessTokenService{
+		tokenRepo: tokenRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// Scopes lists the scopes a token can be granted, besides "<resource>:*"
+func (s *personalAccessTokenService) Scopes() []string {
+	return authpkg.KnownScopes()
+}
+
+func (s *personalAccessTokenService) Create(ctx context.Context, userID uint64, name string, scopes []string, expiresAt string) (*models.ScopedToken, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", ErrPersonalAccessTokenNameRequired
+	}
+	if len([]rune(name)) > personalAccessTokenMaxNameLength {
+		return nil, "", ErrPersonalAccessTokenNameTooLong
+	}
+
+	normalizedScopes, err := normalizeTokenScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var expires sql.NullTime
+	if expiresAt != "" {
+		t, err := time.Parse(time.RFC3339, expiresAt)
+		if err != nil || !t.After(time.Now()) {
+			return nil, "", ErrPersonalAccessTokenExpiresAt
+		}
+		expires = sql.NullTime{Time: t, Valid: true}
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil, "", ErrUserNotFound
+	}
+
+	existing, err := s.tokenRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	active := 0
+	now := time.Now()
+	for _, token := range existing {
+		if !token.ExpiresAt.Valid || token.ExpiresAt.Time.After(now) {
+			active++
+		}
+	}
+	if active >= MaxPersonalAccessTokensPerUser {
+		return nil, "", ErrPersonalAccessTokenLimitExceeded
+	}
+
+	token := &models.ScopedToken{
+		UserID:    userID,
+		Name:      name,
+		Scopes:    normalizedScopes,
+		ExpiresAt: expires,
+	}
+	plainToken, err := s.tokenRepo.Create(ctx, token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return token, plainToken, nil
+}
+
+func (s *personalAccessTokenService) List(ctx context.Context, userID uint64) ([]*models.ScopedToken, error) {
+	return s.tokenRepo.ListByUserID(ctx, userID)
+}
+
+func (s *personalAccessTokenService) Revoke(ctx context.Context, userID, tokenID uint64) error {
+	token, err := s.tokenRepo.FindByID(ctx, tokenID)
+	if err != nil {
+		return err
+	}
+	// Tokens owned by other users are reported as missing to avoid leaking their existence
+	if token == nil || token.UserID != userID {
+		return ErrPersonalAccessTokenNotFound
+	}
+
+	return s.tokenRepo.Delete(ctx, token.ID)
+}
+
+// normalizeTokenScopes trims, lowercases, validates and de-duplicates scopes.
+// "*" is refused: a token with every scope is a login token.
+func normalizeTokenScopes(scopes []string) ([]string, error) {
+	seen := make(map[string]bool, len(scopes))
+	normalized := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		if scope == authpkg.ScopeAll || !authpkg.IsKnownScope(scope) {
+			return nil, ErrPersonalAccessTokenScopeInvalid
+		}
+		if seen[scope] {
+			continue
+		}
+		seen[scope] = true
+		normalized = append(normalized, scope)
+	}
+	if len(normalized) == 0 {
+		return nil, ErrPersonalAccessTokenScopeInvalid
+	}
+	return normalized, nil
+}
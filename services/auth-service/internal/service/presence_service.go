@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/repository"
+)
+
+// MaxPresenceUserIDs is how many users GetPresence accepts in one call
+const MaxPresenceUserIDs = 100
+
+// DefaultPresenceRetryInterval is how long the recorder waits before
+// subscribing again after losing the Redis connection
+const DefaultPresenceRetryInterval = 5 * time.Second
+
+var ErrTooManyPresenceUserIDs = errors.New("at most 100 user ids can be requested at once")
+
+// PresenceService reports whether users are connected to the websocket gateway
+type PresenceService interface {
+	// GetPresence returns the presence of the given users in request order,
+	// skipping duplicates and users that do not exist. Hidden users are
+	// returned as they are; callers decide what to show.
+	GetPresence(ctx context.Context, userIDs []uint64) ([]*models.Presence, error)
+}
+
+type presenceService struct {
+	presenceRepo repository.PresenceRepository
+}
+
+// NewPresenceService creates a new presence service
+func NewPresenceService(presenceRepo repository.PresenceRepository) PresenceService {
+	return &presenceService{
+		presenceRepo: presenceRepo,
+	}
+}
+
+func (s *presenceService) GetPresence(ctx context.Context, userIDs []uint64) ([]*models.Presence, error) {
+	if len(userIDs) > MaxPresenceUserIDs {
+		return nil, ErrTooManyPresenceUserIDs
+	}
+
+	byID, err := s.presenceRepo.GetPresence(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*models.Presence, 0, len(byID))
+	seen := make(map[uint64]bool, len(userIDs))
+	for _, id := range userIDs {
+		if p, ok := byID[id]; ok && !seen[id] {
+			seen[id] = true
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+// presenceFor looks up a single user, returning nil when presence is
+// unavailable so profile and search responses still render without Redis
+func presenceFor(ctx context.Context, presence PresenceService, userID uint64) *models.Presence {
+	if presence == nil {
+		return nil
+	}
+	result, err := presence.GetPresence(ctx, []uint64{userID})
+	if err != nil || len(result) == 0 {
+		return nil
+	}
+	return result[0]
+}
+
+// PresenceRecorder copies last_seen from presence events to the users table.
+// The column is only written when a user's last connection closes, so it lags
+// behind Redis while the user is online; GetPresence reads both.
+type PresenceRecorder struct {
+	presenceRepo  repository.PresenceRepository
+	retryInterval time.Duration
+}
+
+// NewPresenceRecorder creates a recorder that resubscribes every retryInterval
+// (DefaultPresenceRetryInterval if zero) after a failure
+func NewPresenceRecorder(presenceRepo repository.PresenceRepository, retryInterval time.Duration) *PresenceRecorder {
+	if retryInterval <= 0 {
+		retryInterval = DefaultPresenceRetryInterval
+	}
+	return &PresenceRecorder{
+		presenceRepo:  presenceRepo,
+		retryInterval: retryInterval,
+	}
+}
+
+// Start records presence events until ctx is cancelled
+func (r *PresenceRecorder) Start(ctx context.Context) {
+	go func() {
+		for {
+			if err := r.presenceRepo.Listen(ctx, func(event *models.PresenceEvent) {
+				r.Record(ctx, event)
+			}); err != nil {
+				log.Printf("Presence subscription failed: %v", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(r.retryInterval):
+			}
+		}
+	}()
+}
+
+// Record persists the last_seen of a user who went offline
+func (r *PresenceRecorder) Record(ctx context.Context, event *models.PresenceEvent) {
+	if event.Online || event.LastSeen.IsZero() {
+		return
+	}
+	if err := r.presenceRepo.SaveLastSeen(ctx, event.UserID, event.LastSeen); err != nil {
+		log.Printf("Failed to save last seen of user %d: %v", event.UserID, err)
+	}
+}
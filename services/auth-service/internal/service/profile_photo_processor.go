@@ -0,0 +1,268 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/pubsub"
+	"metargb/auth-service/internal/repository"
+	notificationspb "metargb/shared/pb/notifications"
+	storagepb "metargb/shared/pb/storage"
+	"metargb/shared/pkg/imaging"
+	"metargb/shared/pkg/jobs"
+)
+
+const (
+	// DefaultProfilePhotoProcessInterval is how often queued profile photos are picked up
+	DefaultProfilePhotoProcessInterval = 2 * time.Second
+	// profilePhotoBatchSize limits the uploads claimed in one pass
+	profilePhotoBatchSize = 10
+	// profilePhotoLease is how long a claimed upload is hidden from other
+	// workers; an upload still processing afterwards is retried
+	profilePhotoLease = 2 * time.Minute
+	// maxProfilePhotoAttempts is how many times a storage or database failure is retried
+	maxProfilePhotoAttempts = 3
+
+	// Stored profile photos are scaled down to fit maxProfilePhotoEdge
+	maxProfilePhotoEdge = 512
+	// Smaller images are rejected, they look broken in the avatar frame
+	minProfilePhotoEdge = 100
+	// Larger images are rejected before decoding, a 1 MB PNG can hold a huge bitmap
+	maxProfilePhotoPixels = 4096 * 4096
+)
+
+var (
+	errPhotoUndecodable = errors.New("image could not be decoded")
+	errPhotoTooSmall    = fmt.Errorf("image is too small: at least %dx%d pixels", minProfilePhotoEdge, minProfilePhotoEdge)
+	errPhotoTooLarge    = errors.New("image is too large: at most 4096x4096 pixels")
+)
+
+// PhotoStatusPublisher broadcasts upload results to the user's sockets, implemented by pubsub.RedisPublisher
+type PhotoStatusPublisher interface {
+	PublishProfilePhotoStatus(ctx context.Context, event pubsub.ProfilePhotoStatusEvent) error
+}
+
+// ProfilePhotoProcessor turns queued profile photo uploads into profile
+// photos. Each upload is checked and resized, transferred to storage-service
+// and recorded in images, then the user is told through the WebSocket
+// gateway and a notification.
+type ProfilePhotoProcessor struct {
+	uploadRepo         repository.PhotoUploadRepository
+	photoService       ProfilePhotoService
+	storageClient      storagepb.FileStorageServiceClient
+	publisher          PhotoStatusPublisher
+	notificationClient notificationspb.NotificationServiceClient
+	interval           time.Duration
+}
+
+// NewProfilePhotoProcessor creates a processor running every interval
+// (DefaultProfilePhotoProcessInterval if zero). The publisher and notification
+// client may be nil, in which case that channel is skipped.
+func NewProfilePhotoProcessor(
+	uploadRepo repository.PhotoUploadRepository,
+	photoService ProfilePhotoService,
+	storageClient storagepb.FileStorageServiceClient,
+	publisher PhotoStatusPublisher,
+	notificationClient notificationspb.NotificationServiceClient,
+	interval time.Duration,
+) *ProfilePhotoProcessor {
+	if interval <= 0 {
+		interval = DefaultProfilePhotoProcessInterval
+	}
+	return &ProfilePhotoProcessor{
+		uploadRepo:         uploadRepo,
+		photoService:       photoService,
+		storageClient:      storageClient,
+		publisher:          publisher,
+		notificationClient: notificationClient,
+		interval:           interval,
+	}
+}
+
+// Register processes queued uploads every interval under runner
+func (p *ProfilePhotoProcessor) Register(runner *jobs.Runner) {
+	runner.Add("profile-photo-processing", p.interval, func(ctx context.Context) error {
+		_, err := p.ProcessPending(ctx)
+		return err
+	})
+}
+
+// ProcessPending claims a batch of queued uploads and processes them,
+// returning how many finished as ready or failed
+func (p *ProfilePhotoProcessor) ProcessPending(ctx context.Context) (int, error) {
+	uploads, err := p.uploadRepo.ClaimPending(ctx, profilePhotoBatchSize, profilePhotoLease)
+	if err != nil && len(uploads) == 0 {
+		return 0, err
+	}
+
+	finished := 0
+	for _, upload := range uploads {
+		if p.process(ctx, upload) {
+			finished++
+		}
+	}
+	return finished, err
+}
+
+// process handles one upload and reports whether it left the processing status
+func (p *ProfilePhotoProcessor) process(ctx context.Context, upload *models.ProfilePhotoUpload) bool {
+	data, err := prepareProfilePhoto(upload.ImageData, upload.ContentType)
+	if err != nil {
+		return p.fail(ctx, upload, err.Error())
+	}
+
+	photo, err := p.store(ctx, upload, data)
+	if err != nil {
+		log.Printf("Profile photo upload %d attempt %d failed: %v", upload.ID, upload.Attempts, err)
+		if upload.Attempts >= maxProfilePhotoAttempts {
+			return p.fail(ctx, upload, "upload to storage failed")
+		}
+		// Left as processing, the upload is claimed again once its lease expires
+		return false
+	}
+
+	if err := p.uploadRepo.MarkReady(ctx, upload.ID, photo.ID, photo.URL); err != nil {
+		log.Printf("Failed to mark profile photo upload %d ready: %v", upload.ID, err)
+		return false
+	}
+	p.announce(ctx, upload, pubsub.ProfilePhotoStatusEvent{
+		UserID:         upload.UserID,
+		UploadID:       upload.ID,
+		Status:         models.PhotoUploadReady,
+		ProfilePhotoID: photo.ID,
+		URL:            photo.URL,
+	})
+	return true
+}
+
+// store transfers the processed image to storage-service and creates the profile photo
+func (p *ProfilePhotoProcessor) store(ctx context.Context, upload *models.ProfilePhotoUpload, data []byte) (*models.Image, error) {
+	if p.storageClient == nil {
+		return nil, errors.New("storage service not available")
+	}
+
+	// Single chunk since profile photos are small
+	resp, err := p.storageClient.ChunkUpload(ctx, &storagepb.ChunkUploadRequest{
+		UploadId:    fmt.Sprintf("profile_photo_%d_%d", upload.UserID, upload.ID),
+		ChunkData:   data,
+		ChunkIndex:  0,
+		TotalChunks: 1,
+		Filename:    upload.Filename,
+		ContentType: upload.ContentType,
+		TotalSize:   int64(len(data)),
+		UploadPath:  "/uploads/profile",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload file to storage service: %w", err)
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("storage service upload failed: %s", resp.Message)
+	}
+	if !resp.IsFinished {
+		return nil, errors.New("storage service upload did not complete")
+	}
+
+	// FileUrl is the directory (e.g. "uploads/image-jpeg/2024-01-01/"), FilePath the filename
+	filename := resp.FilePath
+	if filename == "" {
+		filename = resp.FinalFilename
+	}
+	if resp.FileUrl == "" || filename == "" {
+		return nil, errors.New("storage service did not return the file path")
+	}
+
+	return p.photoService.CreateProfilePhotoRecord(ctx, upload.UserID, strings.TrimSuffix(resp.FileUrl, "/")+"/"+filename)
+}
+
+func (p *ProfilePhotoProcessor) fail(ctx context.Context, upload *models.ProfilePhotoUpload, reason string) bool {
+	if err := p.uploadRepo.MarkFailed(ctx, upload.ID, reason); err != nil {
+		log.Printf("Failed to mark profile photo upload %d failed: %v", upload.ID, err)
+		return false
+	}
+	p.announce(ctx, upload, pubsub.ProfilePhotoStatusEvent{
+		UserID:   upload.UserID,
+		UploadID: upload.ID,
+		Status:   models.PhotoUploadFailed,
+		Error:    reason,
+	})
+	return true
+}
+
+// announce tells the user the upload finished. Delivery failures are only
+// logged, GetPhotoStatus still reports the result.
+func (p *ProfilePhotoProcessor) announce(ctx context.Context, upload *models.ProfilePhotoUpload, event pubsub.ProfilePhotoStatusEvent) {
+	if p.publisher != nil {
+		if err := p.publisher.PublishProfilePhotoStatus(ctx, event); err != nil {
+			log.Printf("Failed to publish profile photo status for upload %d: %v", upload.ID, err)
+		}
+	}
+
+	if p.notificationClient == nil {
+		return
+	}
+	req := &notificationspb.SendNotificationRequest{
+		UserId: upload.UserID,
+		Data: map[string]string{
+			"upload_id": strconv.FormatUint(upload.ID, 10),
+			"status":    event.Status,
+		},
+	}
+	if event.Status == models.PhotoUploadReady {
+		req.Type = "profile_photo_ready"
+		req.Title = "تصویر پروفایل"
+		req.Message = "تصویر پروفایل شما با موفقیت بارگذاری شد."
+		req.Data["profile_photo_id"] = strconv.FormatUint(event.ProfilePhotoID, 10)
+		req.Data["url"] = event.URL
+	} else {
+		req.Type = "profile_photo_failed"
+		req.Title = "تصویر پروفایل"
+		req.Message = "بارگذاری تصویر پروفایل شما ناموفق بود."
+		req.Data["error"] = event.Error
+	}
+	if _, err := p.notificationClient.SendNotification(ctx, req); err != nil {
+		log.Printf("Failed to send profile photo notification for upload %d: %v", upload.ID, err)
+	}
+}
+
+// prepareProfilePhoto rejects images that cannot be used as a profile photo
+// and scales the rest down to fit maxProfilePhotoEdge, re-encoding them in
+// the uploaded format. Re-encoding also drops metadata such as EXIF location.
+func prepareProfilePhoto(data []byte, contentType string) ([]byte, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, errPhotoUndecodable
+	}
+	if cfg.Width*cfg.Height > maxProfilePhotoPixels {
+		return nil, errPhotoTooLarge
+	}
+	if cfg.Width < minProfilePhotoEdge || cfg.Height < minProfilePhotoEdge {
+		return nil, errPhotoTooSmall
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, errPhotoUndecodable
+	}
+	img = imaging.Fit(img, maxProfilePhotoEdge)
+
+	var buf bytes.Buffer
+	if contentType == "image/png" {
+		err = png.Encode(&buf, img)
+	} else {
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+	return buf.Bytes(), nil
+}
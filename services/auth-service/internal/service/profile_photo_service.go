@@ -39,13 +39,21 @@ type profilePhotoService struct {
 	repo          repository.ProfilePhotoRepository
 	storageClient StorageClient
 	apiGatewayURL string
+	meCache       *MeCache
 }
 
 func NewProfilePhotoService(repo repository.ProfilePhotoRepository, storageClient StorageClient, apiGatewayURL string) ProfilePhotoService {
+	return NewProfilePhotoServiceWithCache(repo, storageClient, apiGatewayURL, nil)
+}
+
+// NewProfilePhotoServiceWithCache creates a profile photo service that
+// invalidates the GetMe snapshot of users whose photos change
+func NewProfilePhotoServiceWithCache(repo repository.ProfilePhotoRepository, storageClient StorageClient, apiGatewayURL string, meCache *MeCache) ProfilePhotoService {
 	return &profilePhotoService{
 		repo:          repo,
 		storageClient: storageClient,
 		apiGatewayURL: apiGatewayURL,
+		meCache:       meCache,
 	}
 }
 
@@ -79,30 +87,39 @@ func (s *profilePhotoService) ListProfilePhotos(ctx context.Context, userID uint
 	return photos, nil
 }
 
-// UploadProfilePhoto uploads a new profile photo
-func (s *profilePhotoService) UploadProfilePhoto(ctx context.Context, userID uint64, imageData []byte, filename, contentType string) (*models.Image, error) {
-	// Validate image
+// validateProfilePhoto checks an uploaded file is a PNG or JPEG of at most 1 MB
+func validateProfilePhoto(imageData []byte, filename, contentType string) error {
 	if len(imageData) == 0 {
-		return nil, ErrImageRequired
+		return ErrImageRequired
 	}
 
 	// Validate file size (≤1 MB = 1024 * 1024 bytes)
 	const maxSize = 1024 * 1024
 	if len(imageData) > maxSize {
-		return nil, ErrInvalidImage
+		return ErrInvalidImage
 	}
 
 	// Validate content type
 	contentType = strings.ToLower(contentType)
 	if contentType != "image/png" && contentType != "image/jpeg" && contentType != "image/jpg" {
-		return nil, ErrInvalidImage
+		return ErrInvalidImage
 	}
 
 	// Validate filename extension
 	filenameLower := strings.ToLower(filename)
 	if !strings.HasSuffix(filenameLower, ".png") && !strings.HasSuffix(filenameLower, ".jpg") && !strings.HasSuffix(filenameLower, ".jpeg") {
-		return nil, ErrInvalidImage
+		return ErrInvalidImage
+	}
+
+	return nil
+}
+
+// UploadProfilePhoto uploads a new profile photo
+func (s *profilePhotoService) UploadProfilePhoto(ctx context.Context, userID uint64, imageData []byte, filename, contentType string) (*models.Image, error) {
+	if err := validateProfilePhoto(imageData, filename, contentType); err != nil {
+		return nil, err
 	}
+	contentType = strings.ToLower(contentType)
 
 	// Upload to storage service if available
 	var url string
@@ -126,6 +143,7 @@ func (s *profilePhotoService) UploadProfilePhoto(ctx context.Context, userID uin
 	if err != nil {
 		return nil, fmt.Errorf("failed to create profile photo record: %w", err)
 	}
+	s.meCache.Invalidate(ctx, userID)
 
 	return image, nil
 }
@@ -140,6 +158,7 @@ func (s *profilePhotoService) CreateProfilePhotoRecord(ctx context.Context, user
 	if err != nil {
 		return nil, fmt.Errorf("failed to create profile photo record: %w", err)
 	}
+	s.meCache.Invalidate(ctx, userID)
 
 	return image, nil
 }
@@ -171,6 +190,7 @@ func (s *profilePhotoService) DeleteProfilePhoto(ctx context.Context, userID uin
 	if err := s.repo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("failed to delete profile photo: %w", err)
 	}
+	s.meCache.Invalidate(ctx, userID)
 
 	return nil
 }
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/repository"
+)
+
+var ErrPhotoUploadNotFound = errors.New("profile photo upload not found")
+
+// ProfilePhotoUploadService accepts profile photos without waiting for
+// storage-service. The upload is stored as processing and handed to
+// ProfilePhotoProcessor, which creates the profile photo.
+type ProfilePhotoUploadService interface {
+	// QueueUpload validates the file and stores it for processing
+	QueueUpload(ctx context.Context, userID uint64, imageData []byte, filename, contentType string) (*models.ProfilePhotoUpload, error)
+	// GetUploadStatus returns one of the user's uploads, without its image data
+	GetUploadStatus(ctx context.Context, userID, uploadID uint64) (*models.ProfilePhotoUpload, error)
+}
+
+type profilePhotoUploadService struct {
+	uploadRepo repository.PhotoUploadRepository
+}
+
+func NewProfilePhotoUploadService(uploadRepo repository.PhotoUploadRepository) ProfilePhotoUploadService {
+	return &profilePhotoUploadService{uploadRepo: uploadRepo}
+}
+
+func (s *profilePhotoUploadService) QueueUpload(ctx context.Context, userID uint64, imageData []byte, filename, contentType string) (*models.ProfilePhotoUpload, error) {
+	if err := validateProfilePhoto(imageData, filename, contentType); err != nil {
+		return nil, err
+	}
+
+	upload := &models.ProfilePhotoUpload{
+		UserID:      userID,
+		Filename:    filename,
+		ContentType: strings.ToLower(contentType),
+		ImageData:   imageData,
+	}
+	if err := s.uploadRepo.Create(ctx, upload); err != nil {
+		return nil, fmt.Errorf("failed to queue profile photo: %w", err)
+	}
+	return upload, nil
+}
+
+func (s *profilePhotoUploadService) GetUploadStatus(ctx context.Context, userID, uploadID uint64) (*models.ProfilePhotoUpload, error) {
+	upload, err := s.uploadRepo.FindByID(ctx, uploadID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile photo upload: %w", err)
+	}
+	// Other users' uploads are reported missing so ids cannot be probed
+	if upload == nil || upload.UserID != userID {
+		return nil, ErrPhotoUploadNotFound
+	}
+	return upload, nil
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"net/url"
+	"strings"
+)
+
+// RedirectAllowlist holds the origins a login may redirect to with its token.
+// Anything else would hand the token to a site the user never meant to log in
+// to.
+type RedirectAllowlist struct {
+	origins map[string]bool
+}
+
+// NewRedirectAllowlist allows the origin of frontEndURL and every entry of
+// extra, e.g. "https://admin.example.com". Blank and invalid entries are
+// ignored.
+func NewRedirectAllowlist(frontEndURL string, extra []string) *RedirectAllowlist {
+	list := &RedirectAllowlist{origins: make(map[string]bool)}
+	for _, raw := range append([]string{frontEndURL}, extra...) {
+		if origin, ok := urlOrigin(strings.TrimSpace(raw)); ok {
+			list.origins[origin] = true
+		}
+	}
+	return list
+}
+
+// Allows reports whether rawURL is an absolute http(s) URL on an allowed
+// origin
+func (l *RedirectAllowlist) Allows(rawURL string) bool {
+	origin, ok := urlOrigin(rawURL)
+	return ok && l.origins[origin]
+}
+
+// urlOrigin returns the scheme://host[:port] of an absolute http(s) URL
+// without credentials
+func urlOrigin(rawURL string) (string, bool) {
+	if rawURL == "" {
+		return "", false
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" || u.User != nil {
+		return "", false
+	}
+	scheme := strings.ToLower(u.Scheme)
+	if scheme != "http" && scheme != "https" {
+		return "", false
+	}
+	return scheme + "://" + strings.ToLower(u.Host), true
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"metargb/auth-service/internal/models"
 	"metargb/auth-service/internal/repository"
 )
 
@@ -15,7 +16,8 @@ type SearchService interface {
 }
 
 type searchService struct {
-	searchRepo repository.SearchRepository
+	searchRepo      repository.SearchRepository
+	presenceService PresenceService
 }
 
 func NewSearchService(searchRepo repository.SearchRepository) SearchService {
@@ -24,14 +26,24 @@ func NewSearchService(searchRepo repository.SearchRepository) SearchService {
 	}
 }
 
+// NewSearchServiceWithPresence creates a search service whose user results
+// include presence
+func NewSearchServiceWithPresence(searchRepo repository.SearchRepository, presenceService PresenceService) SearchService {
+	return &searchService{
+		searchRepo:      searchRepo,
+		presenceService: presenceService,
+	}
+}
+
 // SearchUserResult represents a user search result
 type SearchUserResult struct {
 	ID        uint64
 	Code      string
 	Name      string
 	Followers int32
-	Level     *string // nullable
-	Photo     *string // nullable
+	Level     *string          // nullable
+	Photo     *string          // nullable
+	Presence  *models.Presence // nil if the user hides their status
 }
 
 // SearchFeatureResult represents a feature search result
@@ -104,9 +116,36 @@ func (s *searchService) SearchUsers(ctx context.Context, searchTerm string) ([]*
 		results = append(results, result)
 	}
 
+	s.attachPresence(ctx, results)
+
 	return results, nil
 }
 
+// attachPresence adds the presence of users who show their status. Search
+// still answers when Redis is unavailable, just without presence.
+func (s *searchService) attachPresence(ctx context.Context, results []*SearchUserResult) {
+	if s.presenceService == nil || len(results) == 0 {
+		return
+	}
+	ids := make([]uint64, 0, len(results))
+	for _, result := range results {
+		ids = append(ids, result.ID)
+	}
+	presence, err := s.presenceService.GetPresence(ctx, ids)
+	if err != nil {
+		return
+	}
+	byID := make(map[uint64]*models.Presence, len(presence))
+	for _, p := range presence {
+		byID[p.UserID] = p
+	}
+	for _, result := range results {
+		if p := byID[result.ID]; p != nil && !p.Hidden {
+			result.Presence = p
+		}
+	}
+}
+
 // SearchFeatures searches feature properties by id and address
 func (s *searchService) SearchFeatures(ctx context.Context, searchTerm string) ([]*SearchFeatureResult, error) {
 	// Validate search term is not empty
@@ -7,6 +7,7 @@ import (
 
 	"metargb/auth-service/internal/models"
 	"metargb/auth-service/internal/repository"
+	"metargb/shared/pkg/tz"
 )
 
 var (
@@ -17,11 +18,13 @@ var (
 	ErrInvalidPrivacyKey      = errors.New("invalid privacy key")
 	ErrInvalidPrivacyValue    = errors.New("privacy value must be 0 or 1")
 	ErrMissingRequiredFields  = errors.New("missing required fields")
+	ErrInvalidTimezone        = errors.New("timezone must be an IANA timezone such as Asia/Tehran")
 )
 
 type SettingsService interface {
 	GetSettings(ctx context.Context, userID uint64) (*models.Settings, error)
 	UpdateSettings(ctx context.Context, userID uint64, checkoutDaysCount *uint32, automaticLogout *int32, setting *string, status *bool) error
+	UpdateTimezone(ctx context.Context, userID uint64, timezone string) error
 	GetGeneralSettings(ctx context.Context, userID uint64) (map[string]bool, error)
 	UpdateGeneralSettings(ctx context.Context, userID uint64, settingID uint64, notifications map[string]bool) (map[string]bool, error)
 	GetPrivacySettings(ctx context.Context, userID uint64) (map[string]int, error)
@@ -30,11 +33,19 @@ type SettingsService interface {
 
 type settingsService struct {
 	settingsRepo repository.SettingsRepository
+	meCache      *MeCache
 }
 
 func NewSettingsService(settingsRepo repository.SettingsRepository) SettingsService {
+	return NewSettingsServiceWithCache(settingsRepo, nil)
+}
+
+// NewSettingsServiceWithCache creates a settings service that invalidates the
+// GetMe snapshot of users whose settings change
+func NewSettingsServiceWithCache(settingsRepo repository.SettingsRepository, meCache *MeCache) SettingsService {
 	return &settingsService{
 		settingsRepo: settingsRepo,
+		meCache:      meCache,
 	}
 }
 
@@ -97,7 +108,35 @@ func (s *settingsService) UpdateSettings(ctx context.Context, userID uint64, che
 	if err := s.settingsRepo.Update(ctx, settings); err != nil {
 		return fmt.Errorf("failed to update settings: %w", err)
 	}
+	s.meCache.Invalidate(ctx, userID)
+
+	return nil
+}
 
+// UpdateTimezone stores the timezone reminders, digests and quiet hours are
+// scheduled in for the user
+func (s *settingsService) UpdateTimezone(ctx context.Context, userID uint64, timezone string) error {
+	if !tz.Valid(timezone) {
+		return ErrInvalidTimezone
+	}
+
+	settings, err := s.settingsRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get settings: %w", err)
+	}
+
+	settings.Timezone = timezone
+	if settings.ID == 0 {
+		settings.UserID = userID
+		if err := s.settingsRepo.Create(ctx, settings); err != nil {
+			return fmt.Errorf("failed to create settings: %w", err)
+		}
+		return nil
+	}
+
+	if err := s.settingsRepo.Update(ctx, settings); err != nil {
+		return fmt.Errorf("failed to update timezone: %w", err)
+	}
 	return nil
 }
 
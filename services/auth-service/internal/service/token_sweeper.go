@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"metargb/auth-service/internal/repository"
+	"metargb/shared/pkg/jobs"
+)
+
+// DefaultTokenSweepInterval is how often idle tokens are removed
+const DefaultTokenSweepInterval = 5 * time.Minute
+
+// TokenSweeper periodically deletes expired tokens and tokens idle for longer
+// than their owner's automatic_logout setting. ValidateToken already rejects
+// such tokens; the sweeper keeps personal_access_tokens from growing with them.
+type TokenSweeper struct {
+	tokenRepo repository.TokenRepository
+	interval  time.Duration
+	now       func() time.Time
+}
+
+// NewTokenSweeper creates a sweeper running every interval (DefaultTokenSweepInterval if zero)
+func NewTokenSweeper(tokenRepo repository.TokenRepository, interval time.Duration) *TokenSweeper {
+	if interval <= 0 {
+		interval = DefaultTokenSweepInterval
+	}
+	return &TokenSweeper{
+		tokenRepo: tokenRepo,
+		interval:  interval,
+		now:       time.Now,
+	}
+}
+
+// Register sweeps once every interval under runner
+func (s *TokenSweeper) Register(runner *jobs.Runner) {
+	runner.Add("token-sweep", s.interval, func(ctx context.Context) error {
+		_, err := s.Sweep(ctx)
+		return err
+	})
+}
+
+// Sweep deletes idle and expired tokens and returns how many were removed
+func (s *TokenSweeper) Sweep(ctx context.Context) (int64, error) {
+	deleted, err := s.tokenRepo.DeleteIdleTokens(ctx, s.now())
+	if err != nil {
+		return 0, err
+	}
+	if deleted > 0 {
+		log.Printf("Deleted %d idle tokens", deleted)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"metargb/auth-service/internal/repository"
+	"metargb/shared/pkg/jobs"
+)
+
+const (
+	// DefaultUserEventPurgeInterval is how often expired user events are purged
+	DefaultUserEventPurgeInterval = 24 * time.Hour
+	// userEventPurgeBatchSize limits the events deleted in one transaction
+	userEventPurgeBatchSize = 1000
+)
+
+// UserEventPurger periodically deletes user events older than the retention
+// period, together with their closed reports. Events with an open report are
+// kept until the report is closed.
+type UserEventPurger struct {
+	activityRepo    repository.ActivityRepository
+	retentionMonths int
+	interval        time.Duration
+	now             func() time.Time
+}
+
+// NewUserEventPurger creates a purger keeping retentionMonths of user events,
+// running every interval (DefaultUserEventPurgeInterval if zero)
+func NewUserEventPurger(activityRepo repository.ActivityRepository, retentionMonths int, interval time.Duration) *UserEventPurger {
+	if interval <= 0 {
+		interval = DefaultUserEventPurgeInterval
+	}
+	return &UserEventPurger{
+		activityRepo:    activityRepo,
+		retentionMonths: retentionMonths,
+		interval:        interval,
+		now:             time.Now,
+	}
+}
+
+// Register purges once at start and then every interval under runner
+func (p *UserEventPurger) Register(runner *jobs.Runner) {
+	runner.AddImmediate("user-event-purge", p.interval, func(ctx context.Context) error {
+		_, err := p.Purge(ctx)
+		return err
+	})
+}
+
+// Purge deletes the expired user events in batches and returns how many were
+// removed. Nothing is deleted when the retention period is not positive.
+func (p *UserEventPurger) Purge(ctx context.Context) (int64, error) {
+	if p.retentionMonths <= 0 {
+		return 0, nil
+	}
+
+	cutoff := p.now().AddDate(0, -p.retentionMonths, 0)
+	var total int64
+	for {
+		deleted, err := p.activityRepo.PurgeUserEvents(ctx, cutoff, userEventPurgeBatchSize)
+		if err != nil {
+			return total, err
+		}
+		total += deleted
+		if deleted < userEventPurgeBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		log.Printf("Purged %d user events created before %s", total, cutoff.Format("2006-01-02"))
+	}
+	return total, nil
+}
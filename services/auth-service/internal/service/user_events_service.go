@@ -3,9 +3,13 @@ package service
 import (
 	"context"
 	"database/sql"
+	"encoding/csv"
 	"errors"
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
+	"time"
 
 	"metargb/auth-service/internal/models"
 	"metargb/auth-service/internal/repository"
@@ -18,28 +22,46 @@ var (
 	ErrEventDescriptionTooLong = errors.New("event description exceeds maximum length of 500 characters")
 	ErrResponseTooLong         = errors.New("response exceeds maximum length of 300 characters")
 	ErrUserEventReportNotFound = errors.New("user event report not found")
+	ErrUserEventExportDenied   = errors.New("only compliance admins can export the events of other users")
+	ErrInvalidExportDateRange  = errors.New("invalid export date range")
 )
 
+// userEventExportDateLayout is the layout of the export from_date and to_date filters
+const userEventExportDateLayout = "2006-01-02"
+
+// userEventExportHeader is the header row of user event CSV exports
+var userEventExportHeader = []string{"id", "user_id", "event", "ip", "device", "status", "created_at"}
+
 type UserEventsService interface {
 	ListUserEvents(ctx context.Context, userID uint64, page int32) ([]*models.UserEvent, string, string, error)
 	GetUserEvent(ctx context.Context, userID, eventID uint64) (*models.UserEvent, *models.UserEventReport, []*models.UserEventReportResponse, error)
 	ReportUserEvent(ctx context.Context, userID, eventID uint64, suspeciousCitizen *string, eventDescription string) (*models.UserEventReport, error)
 	SendReportResponse(ctx context.Context, userID, eventID uint64, responserName, response string) (*models.UserEventReportResponse, error)
 	CloseEventReport(ctx context.Context, userID, eventID uint64) error
+	ExportUserEvents(ctx context.Context, requesterID, userID uint64, fromDate, toDate string, w io.Writer) error
 }
 
 type userEventsService struct {
 	activityRepo repository.ActivityRepository
 	userRepo     repository.UserRepository
+	exportAdmins map[uint64]bool
 }
 
+// NewUserEventsService creates the user events service. exportAdminIDs are the
+// compliance admins allowed to export the events of every user.
 func NewUserEventsService(
 	activityRepo repository.ActivityRepository,
 	userRepo repository.UserRepository,
+	exportAdminIDs []uint64,
 ) UserEventsService {
+	exportAdmins := make(map[uint64]bool, len(exportAdminIDs))
+	for _, id := range exportAdminIDs {
+		exportAdmins[id] = true
+	}
 	return &userEventsService{
 		activityRepo: activityRepo,
 		userRepo:     userRepo,
+		exportAdmins: exportAdmins,
 	}
 }
 
@@ -231,3 +253,56 @@ func (s *userEventsService) CloseEventReport(ctx context.Context, userID, eventI
 
 	return nil
 }
+
+// ExportUserEvents writes the events of userID created between fromDate and
+// toDate (inclusive, YYYY-MM-DD) to w as CSV. Users can only export their own
+// events; compliance admins can export any user, or every user with userID 0.
+func (s *userEventsService) ExportUserEvents(ctx context.Context, requesterID, userID uint64, fromDate, toDate string, w io.Writer) error {
+	if !s.exportAdmins[requesterID] {
+		if userID != 0 && userID != requesterID {
+			return ErrUserEventExportDenied
+		}
+		userID = requesterID
+	}
+
+	filter := models.UserEventExportFilter{UserID: userID}
+	if fromDate != "" {
+		from, err := time.ParseInLocation(userEventExportDateLayout, fromDate, time.Local)
+		if err != nil {
+			return fmt.Errorf("%w: from_date must be YYYY-MM-DD", ErrInvalidExportDateRange)
+		}
+		filter.From = from
+	}
+	if toDate != "" {
+		to, err := time.ParseInLocation(userEventExportDateLayout, toDate, time.Local)
+		if err != nil {
+			return fmt.Errorf("%w: to_date must be YYYY-MM-DD", ErrInvalidExportDateRange)
+		}
+		filter.To = to.AddDate(0, 0, 1)
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return fmt.Errorf("%w: from_date is after to_date", ErrInvalidExportDateRange)
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(userEventExportHeader); err != nil {
+		return err
+	}
+	err := s.activityRepo.StreamUserEvents(ctx, filter, func(event *models.UserEvent) error {
+		return writer.Write([]string{
+			strconv.FormatUint(event.ID, 10),
+			strconv.FormatUint(event.UserID, 10),
+			event.Event,
+			event.IP,
+			event.Device,
+			strconv.Itoa(int(event.Status)),
+			event.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -18,6 +18,7 @@ type UserService interface {
 	GetUserLevels(ctx context.Context, userID uint64) (*UserLevelsData, error)
 	GetUserProfile(ctx context.Context, userID uint64, viewerUserID *uint64) (*UserProfileData, error)
 	GetUserFeaturesCount(ctx context.Context, userID uint64) (*UserFeaturesCountData, error)
+	GetUserInfo(ctx context.Context, userID uint64, code string) (*repository.UserInfo, error)
 }
 
 type userService struct {
@@ -25,6 +26,7 @@ type userService struct {
 	kycRepo          repository.KYCRepository
 	settingsRepo     repository.SettingsRepository
 	profilePhotoRepo repository.ProfilePhotoRepository
+	presenceService  PresenceService
 }
 
 func NewUserService(userRepo repository.UserRepository) UserService {
@@ -39,12 +41,14 @@ func NewUserServiceWithDependencies(
 	kycRepo repository.KYCRepository,
 	settingsRepo repository.SettingsRepository,
 	profilePhotoRepo repository.ProfilePhotoRepository,
+	presenceService PresenceService,
 ) UserService {
 	return &userService{
 		userRepo:         userRepo,
 		kycRepo:          kycRepo,
 		settingsRepo:     settingsRepo,
 		profilePhotoRepo: profilePhotoRepo,
+		presenceService:  presenceService,
 	}
 }
 
@@ -88,8 +92,9 @@ type UserProfileData struct {
 	Code           string
 	RegisteredAt   *string // Jalali format, nil if privacy disallows
 	ProfileImages  []string
-	FollowersCount *int32 // nil if privacy disallows
-	FollowingCount *int32 // nil if privacy disallows
+	FollowersCount *int32           // nil if privacy disallows
+	FollowingCount *int32           // nil if privacy disallows
+	Presence       *models.Presence // nil if the user hides their status
 }
 
 // UserFeaturesCountData represents feature counts by category
@@ -320,6 +325,11 @@ func (s *userService) GetUserProfile(ctx context.Context, userID uint64, viewerU
 		}
 	}
 
+	// Presence: hidden from others when the status setting is off
+	if p := presenceFor(ctx, s.presenceService, userID); p != nil && (isOwner || !p.Hidden) {
+		profile.Presence = p
+	}
+
 	return profile, nil
 }
 
@@ -345,3 +355,20 @@ func (s *userService) GetUserFeaturesCount(ctx context.Context, userID uint64) (
 		AmoozeshiFeaturesCount: amoozeshi,
 	}, nil
 }
+
+// GetUserInfo returns the user snapshot other services use instead of reading
+// the users table directly. code is used when userID is 0.
+func (s *userService) GetUserInfo(ctx context.Context, userID uint64, code string) (*repository.UserInfo, error) {
+	if userID == 0 && code == "" {
+		return nil, ErrUserNotFound
+	}
+
+	info, err := s.userRepo.FindUserInfo(ctx, userID, code)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, ErrUserNotFound
+	}
+	return info, nil
+}
@@ -3,9 +3,10 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -14,28 +15,55 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
+	"metargb/calendar-service/internal/client"
 	"metargb/calendar-service/internal/handler"
 	"metargb/calendar-service/internal/repository"
 	"metargb/calendar-service/internal/service"
+	"metargb/shared/pkg/buildinfo"
+	shareddb "metargb/shared/pkg/db"
+	"metargb/shared/pkg/dbsplit"
+	"metargb/shared/pkg/deadline"
+	"metargb/shared/pkg/debug"
+	"metargb/shared/pkg/devmode"
+	"metargb/shared/pkg/faultinject"
+	healthprobe "metargb/shared/pkg/health"
+	"metargb/shared/pkg/jobs"
+	"metargb/shared/pkg/logger"
+	"metargb/shared/pkg/msgsize"
+	"metargb/shared/pkg/usercache"
 )
 
 func main() {
-	if err := godotenv.Load(); err != nil {
-		log.Printf("Warning: .env file not found: %v", err)
+	// Load environment variables
+	envErr := godotenv.Load()
+
+	// Initialize logger once the environment is loaded so LOG_LEVEL applies
+	log := logger.NewLogger("calendar-service")
+	log.RedirectStdLog()
+	if envErr != nil {
+		log.Warn(".env file not found", "error", envErr)
 	}
 
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
+	// SIGHUP toggles debug logging; CALENDAR_LOG_ADMIN_ADDR serves the level over HTTP
+	logCtx, stopLogControl := context.WithCancel(context.Background())
+	defer stopLogControl()
+	log.EnableLevelControl(logCtx)
+
+	dsn := shareddb.ServiceDSN("calendar-service", fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
 		getEnv("DB_USER", "root"),
 		getEnv("DB_PASSWORD", ""),
 		getEnv("DB_HOST", "localhost"),
 		getEnv("DB_PORT", "3306"),
 		getEnv("DB_DATABASE", "metargb_db"),
-	)
+	))
 
 	db, err := sql.Open("mysql", dsn)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		log.Fatal("Failed to connect to database", "error", err)
 	}
 	defer db.Close()
 
@@ -43,40 +71,147 @@ func main() {
 	db.SetMaxIdleConns(5)
 	db.SetConnMaxLifetime(5 * time.Minute)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	if err := db.PingContext(ctx); err != nil {
-		log.Fatalf("Failed to ping database: %v", err)
+	// Wait for the database instead of crash looping while it is unavailable
+	if err := shareddb.PingWithRetry(context.Background(), db, shareddb.RetryPolicyFromEnv()); err != nil {
+		log.Fatal("Failed to ping database", "error", err)
 	}
-	log.Println("Successfully connected to database")
+	log.Info("Successfully connected to database")
 
 	calendarRepo := repository.NewCalendarRepository(db)
 	calendarService := service.NewCalendarService(calendarRepo)
+	rsvpRepo := repository.NewRsvpRepository(db)
+	attendanceService := service.NewAttendanceService(calendarRepo, rsvpRepo)
+
+	limits := msgsize.FromEnv("calendar-service", msgsize.Defaults())
+	serverOpts := append(limits.ServerOptions(),
+		grpc.ChainUnaryInterceptor(logger.UnaryServerInterceptor(log)),
+		grpc.ChainStreamInterceptor(logger.StreamServerInterceptor(log)),
+	)
+
+	// Cap how long handlers run so slow queries are cancelled, see deadline.FromEnv
+	serverOpts = append(serverOpts, deadline.FromEnv("calendar-service", deadline.Defaults()).ServerOptions()...)
+
+	// Staging builds inject latency and errors into chosen methods, see faultinject
+	faultCtx, stopFaults := context.WithCancel(context.Background())
+	defer stopFaults()
+	serverOpts = append(serverOpts, faultinject.FromEnv(faultCtx, "calendar-service", log)...)
+
+	// Outside development internal errors reach callers only as a reference, see devmode
+	serverOpts = append(serverOpts, devmode.FromEnv("calendar-service", log)...)
+
+	// Every response names the build that served it, see buildinfo
+	serverOpts = append(serverOpts, buildinfo.ServerOptions()...)
+	grpcServer := grpc.NewServer(serverOpts...)
+
+	// Report readiness over HTTP and through the standard gRPC health service:
+	// the database is reachable, its tables exist and the server has started
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	defer stopHealth()
+	dbMonitor := shareddb.NewHealthMonitor(db, nil)
+	dbMonitor.Start(healthCtx)
+	probe := healthprobe.NewProbe("calendar-service").
+		AddCheck("database", dbMonitor.Check).
+		AddCheck("migrations", shareddb.NewSchemaGuard(db).RequireTables(dbsplit.Ownership["calendar-service"]...))
+	serving := probe.Starting("grpc")
+	probe.Watch(healthCtx, healthServer, healthprobe.DefaultInterval)
+	probeServer := healthprobe.NewServer(":"+getEnv("HEALTH_PORT", healthprobe.DefaultPort), probe)
+	go func() {
+		if err := probeServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Health probe server failed", "error", err)
+		}
+	}()
 
-	grpcServer := grpc.NewServer()
 	handler.RegisterCalendarHandler(grpcServer, calendarService)
+	handler.RegisterAttendanceHandler(grpcServer, attendanceService)
+
+	// Holidays come from the built-in dataset, OCCASIONS_SOURCE (a file or URL) replaces it when it loads
+	occasionRefreshInterval := service.DefaultOccasionRefreshInterval
+	if v := getEnv("OCCASIONS_REFRESH_INTERVAL", ""); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			occasionRefreshInterval = d
+		} else {
+			log.Warn("Invalid OCCASIONS_REFRESH_INTERVAL, using default", "value", v, "default", occasionRefreshInterval)
+		}
+	}
+	occasionService, err := service.NewOccasionService(getEnv("OCCASIONS_SOURCE", ""), occasionRefreshInterval, log)
+	if err != nil {
+		log.Fatal("Failed to load occasions", "error", err)
+	}
+	if _, err := occasionService.Refresh(context.Background()); err != nil {
+		log.Warn("Failed to load OCCASIONS_SOURCE, using the built-in occasions", "error", err)
+	}
+	// Background jobs are timed, counted and listed on jobs.Path
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	backgroundJobs := jobs.NewRunner("calendar-service", log)
+	occasionService.Register(backgroundJobs)
+	handler.RegisterOccasionHandler(grpcServer, occasionService)
+
+	// RSVP reminders are delivered through notifications-service when it is reachable
+	notificationServiceAddr := getEnv("NOTIFICATIONS_SERVICE_ADDR", "notifications-service:50058")
+	notificationClient, err := client.NewNotificationClient(notificationServiceAddr)
+	if err != nil {
+		log.Warn("Failed to connect to notification service - event reminders disabled", "error", err)
+	} else {
+		defer notificationClient.Close()
+
+		reminderInterval := service.DefaultEventReminderInterval
+		if v := getEnv("EVENT_REMINDER_INTERVAL", ""); v != "" {
+			if d, err := time.ParseDuration(v); err == nil {
+				reminderInterval = d
+			} else {
+				log.Warn("Invalid EVENT_REMINDER_INTERVAL, using default", "value", v, "default", reminderInterval)
+			}
+		}
+		reminderWorker := service.NewEventReminderWorker(rsvpRepo, notificationClient, reminderInterval, log)
+
+		// Start times are written in each user's timezone, read from auth-service
+		authServiceAddr := getEnv("AUTH_SERVICE_ADDR", "auth-service:50051")
+		authConn, err := grpc.Dial(authServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Warn("Failed to connect to auth service - event reminders use the default timezone", "error", err)
+		} else {
+			defer authConn.Close()
+			reminderWorker.SetLocations(usercache.NewFromConn(authConn, usercache.DefaultTTL))
+		}
+
+		reminderWorker.Register(backgroundJobs)
+	}
+	backgroundJobs.Start(jobsCtx)
+
+	// Reflection is only registered in development, see devmode
+	devmode.Register(grpcServer, log)
+
+	// Profiling endpoints, off unless DEBUG_ADDR is set or in development, see debug
+	debug.Serve(context.Background(), "calendar-service", log)
 
 	port := getEnv("GRPC_PORT", "50059")
 	listener, err := net.Listen("tcp", ":"+port)
 	if err != nil {
-		log.Fatalf("Failed to listen on port %s: %v", port, err)
+		log.Fatal("Failed to listen", "error", err, "port", port)
 	}
 
-	log.Printf("Calendar service listening on port %s", port)
+	log.Info("Calendar service listening", "port", port)
 
 	go func() {
 		if err := grpcServer.Serve(listener); err != nil {
-			log.Fatalf("Failed to serve: %v", err)
+			log.Fatal("Failed to serve", "error", err)
 		}
 	}()
+	serving()
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	log.Println("Shutting down server...")
+	log.Info("Shutting down server...")
+	probe.Drain()
+	healthServer.Shutdown()
 	grpcServer.GracefulStop()
-	log.Println("Server stopped")
+	probeServer.Close()
+	log.Info("Server stopped")
 }
 
 func getEnv(key, defaultValue string) string {
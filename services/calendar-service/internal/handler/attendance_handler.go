@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"metargb/calendar-service/internal/models"
+	"metargb/calendar-service/internal/service"
+	calendarpb "metargb/shared/pb/calendar"
+	commonpb "metargb/shared/pb/common"
+	"metargb/shared/pkg/jalali"
+)
+
+type AttendanceHandler struct {
+	calendarpb.UnimplementedEventAttendanceServiceServer
+	service service.AttendanceServiceInterface
+}
+
+func RegisterAttendanceHandler(grpcServer *grpc.Server, svc service.AttendanceServiceInterface) {
+	handler := &AttendanceHandler{service: svc}
+	calendarpb.RegisterEventAttendanceServiceServer(grpcServer, handler)
+}
+
+// RespondToEvent records the user's RSVP and reminder for an event
+func (h *AttendanceHandler) RespondToEvent(ctx context.Context, req *calendarpb.RespondToEventRequest) (*calendarpb.RsvpResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+
+	summary, err := h.service.RespondToEvent(ctx, req.EventId, req.UserId, req.Status, req.RemindMinutes)
+	if err != nil {
+		return nil, mapAttendanceError(err)
+	}
+
+	return buildRsvpResponse(summary), nil
+}
+
+// GetRsvp retrieves the user's RSVP for an event and the event's RSVP counts
+// NOTE: Without a user ID only the counts are returned
+func (h *AttendanceHandler) GetRsvp(ctx context.Context, req *calendarpb.GetRsvpRequest) (*calendarpb.RsvpResponse, error) {
+	summary, err := h.service.GetRsvp(ctx, req.EventId, req.UserId)
+	if err != nil {
+		return nil, mapAttendanceError(err)
+	}
+
+	return buildRsvpResponse(summary), nil
+}
+
+// ListAttendees lists the users who responded to an event with a status
+func (h *AttendanceHandler) ListAttendees(ctx context.Context, req *calendarpb.ListAttendeesRequest) (*calendarpb.AttendeesResponse, error) {
+	page := int32(1)
+	perPage := int32(10)
+	if req.Pagination != nil {
+		if req.Pagination.Page > 0 {
+			page = req.Pagination.Page
+		}
+		if req.Pagination.PerPage > 0 {
+			perPage = req.Pagination.PerPage
+		}
+	}
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	attendees, total, err := h.service.ListAttendees(ctx, req.EventId, req.Status, page, perPage)
+	if err != nil {
+		return nil, mapAttendanceError(err)
+	}
+
+	response := &calendarpb.AttendeesResponse{
+		Attendees: make([]*calendarpb.Attendee, 0, len(attendees)),
+		Pagination: &commonpb.PaginationMeta{
+			CurrentPage: page,
+			PerPage:     perPage,
+			Total:       total,
+			LastPage:    (total + perPage - 1) / perPage,
+		},
+	}
+	for _, attendee := range attendees {
+		response.Attendees = append(response.Attendees, &calendarpb.Attendee{
+			UserId:      attendee.UserID,
+			Name:        attendee.Name,
+			Code:        attendee.Code,
+			Status:      attendee.Status,
+			RespondedAt: jalali.CarbonToJalaliDateTime(attendee.RespondedAt),
+		})
+	}
+
+	return response, nil
+}
+
+func mapAttendanceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrEventNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrRsvpInvalidStatus),
+		errors.Is(err, service.ErrRsvpInvalidReminder):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	case errors.Is(err, service.ErrRsvpNotAnEvent),
+		errors.Is(err, service.ErrRsvpEventStarted):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	}
+	return status.Errorf(codes.Internal, "operation failed: %v", err)
+}
+
+func buildRsvpResponse(summary *models.RsvpSummary) *calendarpb.RsvpResponse {
+	response := &calendarpb.RsvpResponse{
+		EventId: summary.EventID,
+		Counts: &calendarpb.RsvpCounts{
+			Going:    summary.Counts.Going,
+			Maybe:    summary.Counts.Maybe,
+			Declined: summary.Counts.Declined,
+		},
+	}
+	if summary.Rsvp != nil {
+		response.Status = summary.Rsvp.Status
+		response.RemindMinutes = summary.Rsvp.RemindMinutes
+	}
+	if remindAt := summary.RemindAt(); remindAt != nil {
+		response.RemindAt = jalali.CarbonToJalaliDateTime(*remindAt)
+	}
+	return response
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"metargb/calendar-service/internal/service"
+	calendarpb "metargb/shared/pb/calendar"
+)
+
+type OccasionHandler struct {
+	calendarpb.UnimplementedOccasionServiceServer
+	service service.OccasionServiceInterface
+}
+
+func RegisterOccasionHandler(grpcServer *grpc.Server, svc service.OccasionServiceInterface) {
+	handler := &OccasionHandler{service: svc}
+	calendarpb.RegisterOccasionServiceServer(grpcServer, handler)
+}
+
+// GetOccasions lists the holidays and occasions of a Jalali month
+func (h *OccasionHandler) GetOccasions(ctx context.Context, req *calendarpb.GetOccasionsRequest) (*calendarpb.OccasionsResponse, error) {
+	occasions, err := h.service.GetOccasions(ctx, req.Year, req.Month)
+	if err != nil {
+		if errors.Is(err, service.ErrOccasionInvalidYear) || errors.Is(err, service.ErrOccasionInvalidMonth) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get occasions: %v", err)
+	}
+
+	response := &calendarpb.OccasionsResponse{
+		Year:      req.Year,
+		Month:     req.Month,
+		Occasions: make([]*calendarpb.Occasion, 0, len(occasions)),
+	}
+	for _, occasion := range occasions {
+		response.Occasions = append(response.Occasions, &calendarpb.Occasion{
+			JalaliDate:    fmt.Sprintf("%d/%02d/%02d", occasion.JalaliYear, occasion.JalaliMonth, occasion.JalaliDay),
+			GregorianDate: occasion.Date.Format("2006-01-02"),
+			Title:         occasion.Title,
+			IsHoliday:     occasion.IsHoliday,
+			Type:          occasion.Type,
+			Approximate:   occasion.Approximate,
+		})
+	}
+
+	return response, nil
+}
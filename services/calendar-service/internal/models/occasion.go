@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// Occasion types
+const (
+	OccasionSolar = "solar" // fixed Jalali date every year
+	OccasionLunar = "lunar" // fixed Hijri date, moves through the Jalali year
+)
+
+// OccasionDataset is the holiday and occasion data the calendar is shaded with
+type OccasionDataset struct {
+	// Solar occasions recur on the same Jalali month and day every year
+	Solar []OccasionRule `json:"solar"`
+	// Lunar occasions recur on the same Hijri month and day every Hijri year
+	Lunar []OccasionRule `json:"lunar"`
+	// LunarDates are the official Jalali dates of lunar occasions, keyed by
+	// Hijri year and occasion key. Lunar occasions without an official date
+	// are computed with the tabular Hijri calendar and may be a day off.
+	LunarDates map[string]map[string]string `json:"lunar_dates"`
+}
+
+// OccasionRule describes a recurring occasion
+type OccasionRule struct {
+	Key       string `json:"key"`
+	Month     int    `json:"month"`
+	Day       int    `json:"day"`
+	LastDay   bool   `json:"last_day,omitempty"` // the last day of the month, Day is ignored
+	Title     string `json:"title"`
+	IsHoliday bool   `json:"is_holiday"`
+}
+
+// Occasion is an occasion on a specific day
+type Occasion struct {
+	Key         string
+	Date        time.Time // Gregorian midnight UTC
+	JalaliYear  int
+	JalaliMonth int
+	JalaliDay   int
+	Title       string
+	IsHoliday   bool
+	Type        string
+	Approximate bool // computed lunar date without an official date
+}
@@ -0,0 +1,69 @@
+package models
+
+import "time"
+
+// RSVP statuses
+const (
+	RsvpGoing    = "going"
+	RsvpMaybe    = "maybe"
+	RsvpDeclined = "declined"
+)
+
+// IsValidRsvpStatus reports whether status is one of the RSVP statuses
+func IsValidRsvpStatus(status string) bool {
+	return status == RsvpGoing || status == RsvpMaybe || status == RsvpDeclined
+}
+
+// Rsvp is a user's response to a calendar event
+type Rsvp struct {
+	ID            uint64     `db:"id"`
+	CalendarID    uint64     `db:"calendar_id"`
+	UserID        uint64     `db:"user_id"`
+	Status        string     `db:"status"`
+	RemindMinutes int32      `db:"remind_minutes"` // 0 means no reminder
+	RemindedAt    *time.Time `db:"reminded_at"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+}
+
+// RsvpCounts holds the number of responses per status for an event
+type RsvpCounts struct {
+	Going    int32
+	Maybe    int32
+	Declined int32
+}
+
+// Attendee is a user who responded to an event
+type Attendee struct {
+	UserID      uint64
+	Name        string
+	Code        string
+	Status      string
+	RespondedAt time.Time
+}
+
+// EventReminder is a reminder that is due for a user
+type EventReminder struct {
+	RsvpID   uint64
+	UserID   uint64
+	EventID  uint64
+	Title    string
+	StartsAt time.Time
+}
+
+// RsvpSummary is a user's response to an event together with the event's counts
+type RsvpSummary struct {
+	EventID  uint64
+	StartsAt time.Time
+	Rsvp     *Rsvp // nil if the user did not respond
+	Counts   RsvpCounts
+}
+
+// RemindAt returns when the user is reminded of the event, nil without a reminder
+func (s *RsvpSummary) RemindAt() *time.Time {
+	if s.Rsvp == nil || s.Rsvp.RemindMinutes <= 0 || s.Rsvp.Status == RsvpDeclined {
+		return nil
+	}
+	remindAt := s.StartsAt.Add(-time.Duration(s.Rsvp.RemindMinutes) * time.Minute)
+	return &remindAt
+}
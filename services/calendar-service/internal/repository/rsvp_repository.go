@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"metargb/calendar-service/internal/models"
+)
+
+// RsvpRepositoryInterface defines the interface for RSVP repository operations
+type RsvpRepositoryInterface interface {
+	UpsertRsvp(ctx context.Context, rsvp *models.Rsvp) error
+	GetRsvp(ctx context.Context, eventID, userID uint64) (*models.Rsvp, error)
+	CountRsvps(ctx context.Context, eventID uint64) (*models.RsvpCounts, error)
+	ListAttendees(ctx context.Context, eventID uint64, status string, page, perPage int32) ([]*models.Attendee, int32, error)
+	ListDueReminders(ctx context.Context, limit int) ([]*models.EventReminder, error)
+	MarkReminded(ctx context.Context, rsvpID uint64) error
+}
+
+type RsvpRepository struct {
+	db *sql.DB
+}
+
+func NewRsvpRepository(db *sql.DB) *RsvpRepository {
+	return &RsvpRepository{db: db}
+}
+
+// UpsertRsvp creates or replaces a user's response to an event. A changed
+// reminder is sent again even if the previous one was already delivered.
+func (r *RsvpRepository) UpsertRsvp(ctx context.Context, rsvp *models.Rsvp) error {
+	query := `
+		INSERT INTO calendar_rsvps (calendar_id, user_id, status, remind_minutes, created_at, updated_at)
+		VALUES (?, ?, ?, ?, NOW(), NOW())
+		ON DUPLICATE KEY UPDATE
+			reminded_at = IF(remind_minutes = VALUES(remind_minutes), reminded_at, NULL),
+			remind_minutes = VALUES(remind_minutes),
+			status = VALUES(status),
+			updated_at = NOW()
+	`
+
+	_, err := r.db.ExecContext(ctx, query, rsvp.CalendarID, rsvp.UserID, rsvp.Status, rsvp.RemindMinutes)
+	if err != nil {
+		return fmt.Errorf("failed to save rsvp: %w", err)
+	}
+
+	return nil
+}
+
+// GetRsvp retrieves a user's response to an event, nil if they did not respond
+func (r *RsvpRepository) GetRsvp(ctx context.Context, eventID, userID uint64) (*models.Rsvp, error) {
+	query := "SELECT id, calendar_id, user_id, status, remind_minutes, reminded_at, created_at, updated_at FROM calendar_rsvps WHERE calendar_id = ? AND user_id = ?"
+
+	var rsvp models.Rsvp
+	err := r.db.QueryRowContext(ctx, query, eventID, userID).Scan(
+		&rsvp.ID,
+		&rsvp.CalendarID,
+		&rsvp.UserID,
+		&rsvp.Status,
+		&rsvp.RemindMinutes,
+		&rsvp.RemindedAt,
+		&rsvp.CreatedAt,
+		&rsvp.UpdatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rsvp: %w", err)
+	}
+
+	return &rsvp, nil
+}
+
+// CountRsvps counts the responses to an event per status
+func (r *RsvpRepository) CountRsvps(ctx context.Context, eventID uint64) (*models.RsvpCounts, error) {
+	query := "SELECT status, COUNT(*) FROM calendar_rsvps WHERE calendar_id = ? GROUP BY status"
+
+	rows, err := r.db.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count rsvps: %w", err)
+	}
+	defer rows.Close()
+
+	counts := &models.RsvpCounts{}
+	for rows.Next() {
+		var status string
+		var count int32
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan rsvp count: %w", err)
+		}
+		switch status {
+		case models.RsvpGoing:
+			counts.Going = count
+		case models.RsvpMaybe:
+			counts.Maybe = count
+		case models.RsvpDeclined:
+			counts.Declined = count
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to count rsvps: %w", err)
+	}
+
+	return counts, nil
+}
+
+// ListAttendees lists the users who responded to an event with status, most recent first
+func (r *RsvpRepository) ListAttendees(ctx context.Context, eventID uint64, status string, page, perPage int32) ([]*models.Attendee, int32, error) {
+	var total int32
+	countQuery := "SELECT COUNT(*) FROM calendar_rsvps WHERE calendar_id = ? AND status = ?"
+	if err := r.db.QueryRowContext(ctx, countQuery, eventID, status).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count attendees: %w", err)
+	}
+
+	query := `
+		SELECT r.user_id, COALESCE(u.name, ''), COALESCE(u.code, ''), r.status, r.updated_at
+		FROM calendar_rsvps r
+		LEFT JOIN users u ON u.id = r.user_id
+		WHERE r.calendar_id = ? AND r.status = ?
+		ORDER BY r.updated_at DESC, r.id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	offset := (page - 1) * perPage
+	rows, err := r.db.QueryContext(ctx, query, eventID, status, perPage, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list attendees: %w", err)
+	}
+	defer rows.Close()
+
+	var attendees []*models.Attendee
+	for rows.Next() {
+		var attendee models.Attendee
+		if err := rows.Scan(&attendee.UserID, &attendee.Name, &attendee.Code, &attendee.Status, &attendee.RespondedAt); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan attendee: %w", err)
+		}
+		attendees = append(attendees, &attendee)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to list attendees: %w", err)
+	}
+
+	return attendees, total, nil
+}
+
+// ListDueReminders lists the reminders of users going or maybe going to an
+// event that has not started yet, whose reminder time has passed and that
+// were not sent yet, soonest event first
+func (r *RsvpRepository) ListDueReminders(ctx context.Context, limit int) ([]*models.EventReminder, error) {
+	query := `
+		SELECT r.id, r.user_id, c.id, c.title, c.starts_at
+		FROM calendar_rsvps r
+		JOIN calendars c ON c.id = r.calendar_id
+		WHERE r.reminded_at IS NULL AND r.remind_minutes > 0 AND r.status IN (?, ?)
+			AND c.starts_at > NOW() AND c.starts_at <= DATE_ADD(NOW(), INTERVAL r.remind_minutes MINUTE)
+		ORDER BY c.starts_at, r.id
+		LIMIT ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, models.RsvpGoing, models.RsvpMaybe, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due reminders: %w", err)
+	}
+	defer rows.Close()
+
+	var reminders []*models.EventReminder
+	for rows.Next() {
+		var reminder models.EventReminder
+		if err := rows.Scan(&reminder.RsvpID, &reminder.UserID, &reminder.EventID, &reminder.Title, &reminder.StartsAt); err != nil {
+			return nil, fmt.Errorf("failed to scan reminder: %w", err)
+		}
+		reminders = append(reminders, &reminder)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list due reminders: %w", err)
+	}
+
+	return reminders, nil
+}
+
+// MarkReminded records that a reminder was sent
+func (r *RsvpRepository) MarkReminded(ctx context.Context, rsvpID uint64) error {
+	query := "UPDATE calendar_rsvps SET reminded_at = NOW() WHERE id = ?"
+	if _, err := r.db.ExecContext(ctx, query, rsvpID); err != nil {
+		return fmt.Errorf("failed to mark reminder as sent: %w", err)
+	}
+	return nil
+}
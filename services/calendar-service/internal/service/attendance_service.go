@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"metargb/calendar-service/internal/models"
+	"metargb/calendar-service/internal/repository"
+)
+
+// MaxRemindMinutes caps how long before an event a reminder can be sent (one week)
+const MaxRemindMinutes = 7 * 24 * 60
+
+var (
+	ErrEventNotFound       = errors.New("event not found")
+	ErrRsvpInvalidStatus   = errors.New("invalid status: must be going, maybe or declined")
+	ErrRsvpInvalidReminder = fmt.Errorf("invalid remind_minutes: must be between 0 and %d", MaxRemindMinutes)
+	ErrRsvpNotAnEvent      = errors.New("only events accept rsvps, not versions")
+	ErrRsvpEventStarted    = errors.New("the event has already started")
+)
+
+// EventFinder is the part of the calendar repository the attendance service uses
+type EventFinder interface {
+	GetEventByID(ctx context.Context, id uint64) (*models.Calendar, error)
+}
+
+// AttendanceServiceInterface defines the interface for event attendance operations
+type AttendanceServiceInterface interface {
+	RespondToEvent(ctx context.Context, eventID, userID uint64, status string, remindMinutes int32) (*models.RsvpSummary, error)
+	GetRsvp(ctx context.Context, eventID, userID uint64) (*models.RsvpSummary, error)
+	ListAttendees(ctx context.Context, eventID uint64, status string, page, perPage int32) ([]*models.Attendee, int32, error)
+}
+
+type AttendanceService struct {
+	events EventFinder
+	rsvps  repository.RsvpRepositoryInterface
+	now    func() time.Time
+}
+
+func NewAttendanceService(events EventFinder, rsvps repository.RsvpRepositoryInterface) AttendanceServiceInterface {
+	return &AttendanceService{events: events, rsvps: rsvps, now: time.Now}
+}
+
+// RespondToEvent records whether the user is going to an event and when to
+// remind them. Declining drops the reminder.
+func (s *AttendanceService) RespondToEvent(ctx context.Context, eventID, userID uint64, status string, remindMinutes int32) (*models.RsvpSummary, error) {
+	if !models.IsValidRsvpStatus(status) {
+		return nil, ErrRsvpInvalidStatus
+	}
+	if remindMinutes < 0 || remindMinutes > MaxRemindMinutes {
+		return nil, ErrRsvpInvalidReminder
+	}
+	if status == models.RsvpDeclined {
+		remindMinutes = 0
+	}
+
+	event, err := s.event(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.IsVersion {
+		return nil, ErrRsvpNotAnEvent
+	}
+	if !s.now().Before(event.StartsAt) {
+		return nil, ErrRsvpEventStarted
+	}
+
+	if err := s.rsvps.UpsertRsvp(ctx, &models.Rsvp{
+		CalendarID:    eventID,
+		UserID:        userID,
+		Status:        status,
+		RemindMinutes: remindMinutes,
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.summary(ctx, event, userID)
+}
+
+// GetRsvp retrieves the user's response to an event and the event's counts
+func (s *AttendanceService) GetRsvp(ctx context.Context, eventID, userID uint64) (*models.RsvpSummary, error) {
+	event, err := s.event(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return s.summary(ctx, event, userID)
+}
+
+// ListAttendees lists the users who responded to an event with status (going if empty)
+func (s *AttendanceService) ListAttendees(ctx context.Context, eventID uint64, status string, page, perPage int32) ([]*models.Attendee, int32, error) {
+	if status == "" {
+		status = models.RsvpGoing
+	}
+	if !models.IsValidRsvpStatus(status) {
+		return nil, 0, ErrRsvpInvalidStatus
+	}
+	if _, err := s.event(ctx, eventID); err != nil {
+		return nil, 0, err
+	}
+
+	return s.rsvps.ListAttendees(ctx, eventID, status, page, perPage)
+}
+
+func (s *AttendanceService) event(ctx context.Context, eventID uint64) (*models.Calendar, error) {
+	event, err := s.events.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if event == nil {
+		return nil, ErrEventNotFound
+	}
+	return event, nil
+}
+
+func (s *AttendanceService) summary(ctx context.Context, event *models.Calendar, userID uint64) (*models.RsvpSummary, error) {
+	summary := &models.RsvpSummary{EventID: event.ID, StartsAt: event.StartsAt}
+
+	if userID > 0 {
+		rsvp, err := s.rsvps.GetRsvp(ctx, event.ID, userID)
+		if err != nil {
+			return nil, err
+		}
+		summary.Rsvp = rsvp
+	}
+
+	counts, err := s.rsvps.CountRsvps(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+	summary.Counts = *counts
+
+	return summary, nil
+}
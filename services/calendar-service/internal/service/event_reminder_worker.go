@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"metargb/calendar-service/internal/models"
+	"metargb/shared/pkg/jalali"
+	"metargb/shared/pkg/jobs"
+	"metargb/shared/pkg/logger"
+	"metargb/shared/pkg/tz"
+)
+
+// DefaultEventReminderInterval is how often due event reminders are sent
+const DefaultEventReminderInterval = time.Minute
+
+// eventReminderBatchSize caps the reminders sent per run, the rest are sent next run
+const eventReminderBatchSize = 200
+
+// EventReminderRepository is the part of the RSVP repository the worker uses
+type EventReminderRepository interface {
+	ListDueReminders(ctx context.Context, limit int) ([]*models.EventReminder, error)
+	MarkReminded(ctx context.Context, rsvpID uint64) error
+}
+
+// EventReminderNotifier delivers reminders, implemented by client.NotificationClient
+type EventReminderNotifier interface {
+	SendNotification(ctx context.Context, userID uint64, notificationType, title, message string, data map[string]string) error
+}
+
+// UserLocations resolves the timezone each user chose, implemented by
+// usercache.Cache
+type UserLocations interface {
+	Location(ctx context.Context, userID uint64) *time.Location
+}
+
+// EventReminderWorker periodically reminds users going or maybe going to an
+// event the number of minutes they asked for before it starts
+type EventReminderWorker struct {
+	repo      EventReminderRepository
+	notifier  EventReminderNotifier
+	locations UserLocations
+	interval  time.Duration
+	log       *logger.Logger
+}
+
+// NewEventReminderWorker creates a worker running every interval
+// (DefaultEventReminderInterval if zero)
+func NewEventReminderWorker(repo EventReminderRepository, notifier EventReminderNotifier, interval time.Duration, log *logger.Logger) *EventReminderWorker {
+	if interval <= 0 {
+		interval = DefaultEventReminderInterval
+	}
+	return &EventReminderWorker{
+		repo:     repo,
+		notifier: notifier,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// SetLocations sets where users' timezones are read from. Without it start
+// times are shown in tz.Default.
+func (w *EventReminderWorker) SetLocations(locations UserLocations) {
+	w.locations = locations
+}
+
+// Register runs the worker once every interval under runner
+func (w *EventReminderWorker) Register(runner *jobs.Runner) {
+	runner.Add("event-reminders", w.interval, func(ctx context.Context) error {
+		_, err := w.Run(ctx)
+		return err
+	})
+}
+
+// Run sends the due reminders and returns how many were sent. A reminder that
+// fails to send is retried next run until the event starts.
+func (w *EventReminderWorker) Run(ctx context.Context) (int, error) {
+	reminders, err := w.repo.ListDueReminders(ctx, eventReminderBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, reminder := range reminders {
+		loc := w.location(ctx, reminder.UserID)
+		startsAt := jalali.CarbonToJalaliDateTime(reminder.StartsAt.In(loc))
+		message := fmt.Sprintf("رویداد «%s» در تاریخ %s آغاز می شود.", reminder.Title, startsAt)
+		data := map[string]string{
+			"event_id":  fmt.Sprintf("%d", reminder.EventID),
+			"starts_at": startsAt,
+			"timezone":  loc.String(),
+		}
+		if err := w.notifier.SendNotification(ctx, reminder.UserID, "calendar_event_reminder", "یادآوری رویداد", message, data); err != nil {
+			w.log.Warn("Failed to send event reminder", "error", err, "user_id", reminder.UserID, "event_id", reminder.EventID)
+			continue
+		}
+
+		if err := w.repo.MarkReminded(ctx, reminder.RsvpID); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+// location returns the timezone the user's reminder is written in
+func (w *EventReminderWorker) location(ctx context.Context, userID uint64) *time.Location {
+	if w.locations == nil {
+		return tz.Load("")
+	}
+	return w.locations.Location(ctx, userID)
+}
@@ -0,0 +1,365 @@
+package service
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"metargb/calendar-service/internal/models"
+	"metargb/shared/pkg/jobs"
+	"metargb/shared/pkg/logger"
+)
+
+// DefaultOccasionRefreshInterval is how often the occasion source is reloaded
+const DefaultOccasionRefreshInterval = 24 * time.Hour
+
+// maxOccasionSourceSize caps the size of a downloaded occasion dataset
+const maxOccasionSourceSize = 1 << 20
+
+// defaultOccasions is the built-in dataset, used until a source is loaded
+//
+//go:embed occasions.json
+var defaultOccasions []byte
+
+var (
+	ErrOccasionInvalidYear  = errors.New("invalid year: must be a Jalali year between 1300 and 1500")
+	ErrOccasionInvalidMonth = errors.New("invalid month: must be between 1 and 12, or 0 for the whole year")
+)
+
+// OccasionServiceInterface defines the interface for holiday and occasion lookups
+type OccasionServiceInterface interface {
+	GetOccasions(ctx context.Context, year, month int32) ([]*models.Occasion, error)
+}
+
+// OccasionService serves the official holidays and occasions of a Jalali
+// month. The dataset is reloaded from source every interval; a source that
+// fails to load keeps the last good dataset in use.
+type OccasionService struct {
+	source   string
+	interval time.Duration
+	log      *logger.Logger
+
+	mu      sync.RWMutex
+	dataset *models.OccasionDataset
+}
+
+// NewOccasionService creates a service serving the built-in dataset. source is
+// a file path or http(s) URL loaded by Refresh, empty to only use the
+// built-in dataset.
+func NewOccasionService(source string, interval time.Duration, log *logger.Logger) (*OccasionService, error) {
+	dataset, err := parseOccasionDataset(defaultOccasions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse built-in occasions: %w", err)
+	}
+	if interval <= 0 {
+		interval = DefaultOccasionRefreshInterval
+	}
+	return &OccasionService{
+		source:   source,
+		interval: interval,
+		log:      log,
+		dataset:  dataset,
+	}, nil
+}
+
+// Register reloads the source once every interval under runner. A failed
+// reload keeps the previous dataset. Without a source nothing is registered.
+func (s *OccasionService) Register(runner *jobs.Runner) {
+	if s.source == "" {
+		return
+	}
+	runner.Add("occasions-refresh", s.interval, func(ctx context.Context) error {
+		_, err := s.Refresh(ctx)
+		return err
+	})
+}
+
+// Refresh loads the dataset from source and returns how many occasions it has
+func (s *OccasionService) Refresh(ctx context.Context) (int, error) {
+	if s.source == "" {
+		return 0, nil
+	}
+
+	data, err := readOccasionSource(ctx, s.source)
+	if err != nil {
+		return 0, err
+	}
+	dataset, err := parseOccasionDataset(data)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	s.dataset = dataset
+	s.mu.Unlock()
+
+	return len(dataset.Solar) + len(dataset.Lunar), nil
+}
+
+// GetOccasions lists the occasions of a Jalali month (the whole year if month is 0) by date
+func (s *OccasionService) GetOccasions(ctx context.Context, year, month int32) ([]*models.Occasion, error) {
+	if year < 1300 || year > 1500 {
+		return nil, ErrOccasionInvalidYear
+	}
+	if month < 0 || month > 12 {
+		return nil, ErrOccasionInvalidMonth
+	}
+
+	s.mu.RLock()
+	dataset := s.dataset
+	s.mu.RUnlock()
+
+	months := []int{int(month)}
+	if month == 0 {
+		months = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+	}
+
+	occasions := []*models.Occasion{}
+	for _, m := range months {
+		monthOccasions, err := occasionsInMonth(dataset, int(year), m)
+		if err != nil {
+			return nil, err
+		}
+		occasions = append(occasions, monthOccasions...)
+	}
+
+	return occasions, nil
+}
+
+func occasionsInMonth(dataset *models.OccasionDataset, year, month int) ([]*models.Occasion, error) {
+	start, end, err := jalaliMonthRange(year, month)
+	if err != nil {
+		return nil, err
+	}
+
+	var occasions []*models.Occasion
+	add := func(rule models.OccasionRule, date time.Time, occasionType string, approximate bool) {
+		occasions = append(occasions, &models.Occasion{
+			Key:         rule.Key,
+			Date:        date,
+			JalaliYear:  year,
+			JalaliMonth: month,
+			JalaliDay:   int(date.Sub(start).Hours()/24) + 1,
+			Title:       rule.Title,
+			IsHoliday:   rule.IsHoliday,
+			Type:        occasionType,
+			Approximate: approximate,
+		})
+	}
+
+	for _, rule := range dataset.Solar {
+		if rule.Month != month {
+			continue
+		}
+		date := start.AddDate(0, 0, rule.Day-1)
+		if date.Before(end) {
+			add(rule, date, models.OccasionSolar, false)
+		}
+	}
+
+	// Official dates replace computed ones for the Hijri years they cover
+	hijriYears := make(map[int]bool)
+	for date := start; date.Before(end); date = date.AddDate(0, 0, 1) {
+		hy, hm, hd := gregorianToHijri(date)
+		hijriYears[hy] = true
+		_, nextMonth, _ := gregorianToHijri(date.AddDate(0, 0, 1))
+		lastDay := nextMonth != hm
+
+		for _, rule := range dataset.Lunar {
+			if rule.Month != hm || (rule.LastDay && !lastDay) || (!rule.LastDay && rule.Day != hd) {
+				continue
+			}
+			if _, ok := dataset.LunarDates[strconv.Itoa(hy)][rule.Key]; ok {
+				continue
+			}
+			add(rule, date, models.OccasionLunar, true)
+		}
+	}
+	// An official date can drift into this month from a neighbouring Hijri year
+	candidates := make(map[int]bool)
+	for hy := range hijriYears {
+		candidates[hy-1], candidates[hy], candidates[hy+1] = true, true, true
+	}
+	for hy := range candidates {
+		official := dataset.LunarDates[strconv.Itoa(hy)]
+		for _, rule := range dataset.Lunar {
+			date, err := parseJalaliDate(official[rule.Key])
+			if err != nil || date.Before(start) || !date.Before(end) {
+				continue
+			}
+			add(rule, date, models.OccasionLunar, false)
+		}
+	}
+
+	sort.SliceStable(occasions, func(i, j int) bool {
+		return occasions[i].Date.Before(occasions[j].Date)
+	})
+
+	return occasions, nil
+}
+
+// jalaliMonthRange returns the first day of a Jalali month and of the month after it
+func jalaliMonthRange(year, month int) (time.Time, time.Time, error) {
+	if month < 1 || month > 12 {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid jalali month %d/%d", year, month)
+	}
+	start := jalaliToGregorian(year, month, 1)
+	if month == 12 {
+		return start, jalaliToGregorian(year+1, 1, 1), nil
+	}
+	return start, jalaliToGregorian(year, month+1, 1), nil
+}
+
+// parseJalaliDate parses a Y/m/d Jalali date to midnight UTC of the Gregorian day
+func parseJalaliDate(value string) (time.Time, error) {
+	var year, month, day int
+	if _, err := fmt.Sscanf(value, "%d/%d/%d", &year, &month, &day); err != nil {
+		return time.Time{}, fmt.Errorf("invalid jalali date %q: expected Y/m/d", value)
+	}
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return time.Time{}, fmt.Errorf("invalid jalali date %q", value)
+	}
+	return jalaliToGregorian(year, month, day), nil
+}
+
+// jalaliBreaks are the years the 33 year leap cycle of the Jalali calendar shifts in
+var jalaliBreaks = []int{-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210,
+	1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178}
+
+// jalaliToGregorian converts a Jalali date to midnight UTC of its Gregorian day,
+// following the leap years of the astronomical calendar (Borkowski's algorithm).
+// NOTE: shared/pkg/jalali and go-persian-calendar are off by days around 1403.
+func jalaliToGregorian(year, month, day int) time.Time {
+	leapJ, jp, jump := -14, jalaliBreaks[0], 0
+	for _, jm := range jalaliBreaks[1:] {
+		jump = jm - jp
+		if year < jm {
+			break
+		}
+		leapJ += jump/33*8 + jump%33/4
+		jp = jm
+	}
+	n := year - jp
+	leapJ += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapJ++
+	}
+
+	gy := year + 621
+	leapG := gy/4 - (gy/100+1)*3/4 - 150
+	march := 20 + leapJ - leapG
+
+	offset := (month-1)*31 - month/7*(month-7) + day - 1
+	return time.Date(gy, time.March, march, 0, 0, 0, 0, time.UTC).AddDate(0, 0, offset)
+}
+
+// hijriEpoch is the Julian day number of 1 Muharram 1 AH in the civil tabular calendar
+const hijriEpoch = 1948440
+
+// gregorianToHijri converts a date to the tabular (arithmetic) Hijri calendar.
+// The official Iranian calendar follows moon sightings, so the result can be
+// a day off; the dataset's lunar_dates override it.
+func gregorianToHijri(t time.Time) (year, month, day int) {
+	jdn := int(t.Unix()/86400) + 2440588
+
+	year = (30*(jdn-hijriEpoch) + 10646) / 10631
+	month = (jdn-hijriToJDN(year, 1, 1))*2/59 + 1
+	for month < 12 && jdn >= hijriToJDN(year, month+1, 1) {
+		month++
+	}
+	for month > 1 && jdn < hijriToJDN(year, month, 1) {
+		month--
+	}
+	day = jdn - hijriToJDN(year, month, 1) + 1
+	return year, month, day
+}
+
+func hijriToJDN(year, month, day int) int {
+	return day + (59*(month-1)+1)/2 + (year-1)*354 + (3+11*year)/30 + hijriEpoch - 1
+}
+
+func readOccasionSource(ctx context.Context, source string) ([]byte, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		data, err := os.ReadFile(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read occasion source: %w", err)
+		}
+		return data, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create occasion source request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch occasion source: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch occasion source: status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxOccasionSourceSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read occasion source: %w", err)
+	}
+	if len(data) > maxOccasionSourceSize {
+		return nil, fmt.Errorf("occasion source exceeds %d bytes", maxOccasionSourceSize)
+	}
+	return data, nil
+}
+
+func parseOccasionDataset(data []byte) (*models.OccasionDataset, error) {
+	var dataset models.OccasionDataset
+	if err := json.Unmarshal(data, &dataset); err != nil {
+		return nil, fmt.Errorf("failed to parse occasions: %w", err)
+	}
+
+	for _, rule := range dataset.Solar {
+		maxDay := 30
+		if rule.Month <= 6 {
+			maxDay = 31
+		}
+		if rule.Month < 1 || rule.Month > 12 || rule.Day < 1 || rule.Day > maxDay || rule.Title == "" {
+			return nil, fmt.Errorf("invalid solar occasion %d/%d %q", rule.Month, rule.Day, rule.Title)
+		}
+	}
+
+	keys := make(map[string]bool)
+	for _, rule := range dataset.Lunar {
+		if rule.Key == "" || keys[rule.Key] || rule.Title == "" || rule.Month < 1 || rule.Month > 12 ||
+			(!rule.LastDay && (rule.Day < 1 || rule.Day > 30)) {
+			return nil, fmt.Errorf("invalid lunar occasion %q", rule.Key)
+		}
+		keys[rule.Key] = true
+	}
+
+	for year, dates := range dataset.LunarDates {
+		if _, err := strconv.Atoi(year); err != nil {
+			return nil, fmt.Errorf("invalid hijri year %q in lunar_dates", year)
+		}
+		for key, date := range dates {
+			if !keys[key] {
+				return nil, fmt.Errorf("unknown lunar occasion %q in lunar_dates", key)
+			}
+			if _, err := parseJalaliDate(date); err != nil {
+				return nil, fmt.Errorf("invalid date %q for %s in lunar_dates: %w", date, key, err)
+			}
+		}
+	}
+
+	return &dataset, nil
+}
@@ -0,0 +1,95 @@
+// Command referral-recalc re-evaluates paid orders against the current
+// referral tier configuration and credits rewards that were never paid.
+//
+// It is a dry run unless -apply is given:
+//
+//	referral-recalc -from 2025-01-01 [-to 2025-02-01] [-apply]
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/joho/godotenv"
+
+	"metargb/commercial-service/internal/repository"
+	"metargb/commercial-service/internal/service"
+	shareddb "metargb/shared/pkg/db"
+)
+
+func main() {
+	fromStr := flag.String("from", "", "first order date to check, YYYY-MM-DD (required)")
+	toStr := flag.String("to", "", "order date to stop before, YYYY-MM-DD (default: now)")
+	apply := flag.Bool("apply", false, "credit missing rewards instead of only reporting them")
+	flag.Parse()
+
+	if *fromStr == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	from, err := time.ParseInLocation("2006-01-02", *fromStr, time.Local)
+	if err != nil {
+		log.Fatalf("Invalid -from date: %v", err)
+	}
+	to := time.Now()
+	if *toStr != "" {
+		to, err = time.ParseInLocation("2006-01-02", *toStr, time.Local)
+		if err != nil {
+			log.Fatalf("Invalid -to date: %v", err)
+		}
+	}
+	if !from.Before(to) {
+		log.Fatalf("-from must be before -to")
+	}
+
+	// Load environment variables
+	if err := godotenv.Load(); err != nil {
+		log.Printf("Warning: .env file not found: %v", err)
+	}
+
+	dsn := shareddb.ServiceDSN("commercial-service", fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
+		getEnv("DB_USER", "root"),
+		getEnv("DB_PASSWORD", ""),
+		getEnv("DB_HOST", "localhost"),
+		getEnv("DB_PORT", "3306"),
+		getEnv("DB_DATABASE", "metargb_db"),
+	))
+
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	referralService := service.NewReferralService(
+		repository.NewReferralRepository(db),
+		repository.NewVariableRepository(db),
+		repository.NewUserVariableRepository(db),
+		repository.NewOrderRepository(db),
+	)
+
+	result, err := referralService.RecalculateRewards(context.Background(), from, to, *apply)
+	if err != nil {
+		log.Fatalf("Recalculation failed: %v", err)
+	}
+
+	action := "would be credited (dry run, pass -apply to credit)"
+	if result.Applied {
+		action = "credited"
+	}
+	log.Printf("Checked %d orders between %s and %s", result.OrdersChecked, from.Format("2006-01-02"), to.Format("2006-01-02 15:04"))
+	log.Printf("%d missing rewards totalling %s PSC %s", result.MissingRewards, result.MissingAmount.StringFixed(4), action)
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
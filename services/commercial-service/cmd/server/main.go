@@ -3,44 +3,77 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/joho/godotenv"
+	"github.com/shopspring/decimal"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
+	"metargb/commercial-service/internal/client"
 	"metargb/commercial-service/internal/handler"
+	"metargb/commercial-service/internal/models"
 	"metargb/commercial-service/internal/parsian"
+	"metargb/commercial-service/internal/pubsub"
 	"metargb/commercial-service/internal/repository"
 	"metargb/commercial-service/internal/service"
+	"metargb/shared/pkg/activity"
 	"metargb/shared/pkg/auth"
+	"metargb/shared/pkg/buildinfo"
+	shareddb "metargb/shared/pkg/db"
+	"metargb/shared/pkg/dbsplit"
+	"metargb/shared/pkg/deadline"
+	"metargb/shared/pkg/debug"
+	"metargb/shared/pkg/devmode"
+	"metargb/shared/pkg/faultinject"
+	healthprobe "metargb/shared/pkg/health"
+	"metargb/shared/pkg/jobs"
+	"metargb/shared/pkg/logger"
+	"metargb/shared/pkg/msgsize"
+	"metargb/shared/pkg/usercache"
+	"metargb/shared/pkg/variables"
 )
 
 func main() {
 	// Load environment variables
-	if err := godotenv.Load(); err != nil {
-		log.Printf("Warning: .env file not found: %v", err)
+	envErr := godotenv.Load()
+
+	// Initialize logger once the environment is loaded so LOG_LEVEL applies
+	log := logger.NewLogger("commercial-service")
+	log.RedirectStdLog()
+	if envErr != nil {
+		log.Warn(".env file not found", "error", envErr)
 	}
 
+	// SIGHUP toggles debug logging; COMMERCIAL_LOG_ADMIN_ADDR serves the level over HTTP
+	logCtx, stopLogControl := context.WithCancel(context.Background())
+	defer stopLogControl()
+	log.EnableLevelControl(logCtx)
+
 	// Database connection
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
+	dsn := shareddb.ServiceDSN("commercial-service", fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
 		getEnv("DB_USER", "root"),
 		getEnv("DB_PASSWORD", ""),
 		getEnv("DB_HOST", "localhost"),
 		getEnv("DB_PORT", "3306"),
 		getEnv("DB_DATABASE", "metargb_db"),
-	)
+	))
 
 	db, err := sql.Open("mysql", dsn)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		log.Fatal("Failed to connect to database", "error", err)
 	}
 	defer db.Close()
 
@@ -49,13 +82,11 @@ func main() {
 	db.SetMaxIdleConns(5)
 	db.SetConnMaxLifetime(5 * time.Minute)
 
-	// Test connection
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	if err := db.PingContext(ctx); err != nil {
-		log.Fatalf("Failed to ping database: %v", err)
+	// Wait for the database instead of crash looping while it is unavailable
+	if err := shareddb.PingWithRetry(context.Background(), db, shareddb.RetryPolicyFromEnv()); err != nil {
+		log.Fatal("Failed to ping database", "error", err)
 	}
-	log.Println("Successfully connected to database")
+	log.Info("Successfully connected to database")
 
 	// Initialize repositories
 	walletRepo := repository.NewWalletRepository(db)
@@ -63,37 +94,117 @@ func main() {
 	transactionRepo := repository.NewTransactionRepository(db)
 	paymentRepo := repository.NewPaymentRepository(db)
 	firstOrderRepo := repository.NewFirstOrderRepository(db)
-	variableRepo := repository.NewVariableRepository(db)
+	// Variable reads are cached, every replica drops a key when it changes
+	uncachedVariableRepo := repository.NewVariableRepository(db)
+	variableCache := variables.NewCache(uncachedVariableRepo.GetMany, getEnvAsDuration("VARIABLE_CACHE_TTL", variables.DefaultTTL, log))
+	variableRepo := repository.NewCachedVariableRepository(uncachedVariableRepo, variableCache)
 	userVariableRepo := repository.NewUserVariableRepository(db)
 	referralOrderRepo := repository.NewReferralRepository(db)
+	adjustmentRepo := repository.NewWalletAdjustmentRepository(db)
+	installmentRepo := repository.NewInstallmentRepository(db)
+	subscriptionRepo := repository.NewSubscriptionRepository(db)
+	exchangeRepo := repository.NewExchangeRepository(db)
 
-	// Initialize Parsian client
-	parsianClient := parsian.NewClient()
+	// Initialize Parsian client. Transient failures are retried with jittered
+	// backoff within PARSIAN_TIMEOUT_BUDGET; confirms only when undelivered.
+	parsianClient := parsian.NewClientWithRetry(parsian.RetryConfig{
+		MaxAttempts:    getEnvAsInt("PARSIAN_MAX_ATTEMPTS", parsian.DefaultMaxAttempts, log),
+		BaseDelay:      getEnvAsDuration("PARSIAN_RETRY_BASE_DELAY", parsian.DefaultBaseDelay, log),
+		MaxDelay:       getEnvAsDuration("PARSIAN_RETRY_MAX_DELAY", parsian.DefaultMaxDelay, log),
+		AttemptTimeout: getEnvAsDuration("PARSIAN_ATTEMPT_TIMEOUT", parsian.DefaultAttemptTimeout, log),
+		Budget:         getEnvAsDuration("PARSIAN_TIMEOUT_BUDGET", parsian.DefaultBudget, log),
+	})
 
 	// Initialize helper services
 	jalaliConverter := service.NewJalaliConverter()
 
-	// Initialize order policy
-	orderPolicy := service.NewOrderPolicy(firstOrderRepo)
+	// Initialize order policy, its rules are the first_order_* variables
+	orderPolicy := service.NewOrderPolicy(firstOrderRepo, variableRepo)
 
 	// Initialize referral service
 	referralService := service.NewReferralService(
 		referralOrderRepo,
 		variableRepo,
 		userVariableRepo,
-		walletRepo,
+		orderRepo,
 	)
 
-	// Payment configuration
+	// Payment configuration. Parsian posts the callback to the gateway, which
+	// hands it to financial-service
 	paymentConfig := &service.PaymentConfig{
 		ParsianMerchantID:            getEnv("PARSIAN_PIN", ""),
 		ParsianLoanAccountMerchantID: getEnv("PARSIAN_LOAN_ACCOUNT_PIN", ""),
-		ParsianCallbackURL:           getEnv("PAYMENT_CALLBACK_URL", "http://localhost:8000/api/v2/payment/callback"),
+		ParsianCallbackURL:           getEnv("PARSIAN_CALLBACK_URL", "http://localhost:8000/api/parsian/callback"),
+		Sandbox:                      getEnv("PAYMENT_SANDBOX", "false") == "true",
+	}
+	if paymentConfig.Sandbox {
+		log.Warn("PAYMENT_SANDBOX is enabled - payments are simulated and no bank calls are made")
 	}
 
 	// Initialize services
-	walletService := service.NewWalletService(walletRepo)
 	transactionService := service.NewTransactionService(transactionRepo, jalaliConverter)
+	orderService := service.NewOrderService(orderRepo, jalaliConverter)
+	// Batch wallet adjustments need two different admins from WALLET_ADMIN_IDS
+	walletAdminIDs := parseUserIDs(getEnv("WALLET_ADMIN_IDS", ""), log)
+
+	// Variable changes are announced through Redis so features-service and the
+	// other replicas drop their cached values
+	var variablePublisher service.VariablePublisher
+	variableEvents, err := pubsub.NewVariableEvents(redisURL())
+	if err != nil {
+		log.Warn("Failed to connect to Redis - variable changes apply once caches expire", "error", err)
+	} else {
+		defer variableEvents.Close()
+		variablePublisher = variableEvents
+		variableEventsCtx, stopVariableEvents := context.WithCancel(context.Background())
+		defer stopVariableEvents()
+		variableEvents.Subscribe(variableEventsCtx, func(event variables.ChangedEvent) {
+			variableCache.Invalidate(event.Key)
+		})
+	}
+	// The same admins manage the variables
+	variableService := service.NewVariableService(variableRepo, repository.NewScheduledVariableRepository(db), walletAdminIDs, variablePublisher)
+	rateHistoryService := service.NewRateHistoryService(variableRepo, repository.NewRateHistoryRepository(db))
+	adjustmentService := service.NewWalletAdjustmentService(adjustmentRepo, walletAdminIDs)
+	// The same admins set the color exchange rates
+	exchangeService := service.NewExchangeService(exchangeRepo, walletAdminIDs)
+	// Payments and large transfers pass the fraud rules; the same admins work
+	// the review queue and the card blocklist
+	fraudService := service.NewFraudService(repository.NewFraudRepository(db), walletAdminIDs, service.FraudConfig{
+		VelocityReview:   getEnvAsInt("FRAUD_VELOCITY_REVIEW", service.DefaultFraudVelocityReview, log),
+		VelocityDeny:     getEnvAsInt("FRAUD_VELOCITY_DENY", service.DefaultFraudVelocityDeny, log),
+		LoginLookback:    getEnvAsDuration("FRAUD_LOGIN_LOOKBACK", service.DefaultFraudLoginLookback, log),
+		ApprovalTTL:      getEnvAsDuration("FRAUD_APPROVAL_TTL", service.DefaultFraudApprovalTTL, log),
+		LargeTransferPSC: decimal.NewFromFloat(getEnvAsFloat("FRAUD_LARGE_TRANSFER_PSC", service.DefaultFraudLargeTransferPSC, log)),
+		LargeTransferIRR: decimal.NewFromFloat(getEnvAsFloat("FRAUD_LARGE_TRANSFER_IRR", service.DefaultFraudLargeTransferIRR, log)),
+	})
+	// The login rule compares payments with the logins auth-service reports
+	// on the activity stream
+	loginEventsCtx, stopLoginEvents := context.WithCancel(context.Background())
+	defer stopLoginEvents()
+	hostname, _ := os.Hostname()
+	loginEvents, err := pubsub.NewLoginEvents(
+		redisURL(),
+		getEnv("ACTIVITY_STREAM", activity.Stream),
+		getEnv("ACTIVITY_CONSUMER_NAME", "commercial-"+hostname),
+		fraudService.RecordLogin,
+		log,
+	)
+	if err != nil {
+		log.Warn("Failed to connect to Redis - logins are not compared with payments", "error", err)
+	} else {
+		defer loginEvents.Close()
+		loginEvents.Start(loginEventsCtx)
+	}
+	// Order payment states are pushed to the payment page through the WebSocket gateway
+	var orderStatusPublisher service.OrderStatusPublisher
+	orderEvents, err := pubsub.NewOrderEvents(redisURL())
+	if err != nil {
+		log.Warn("Failed to connect to Redis - payment pages fall back to polling", "error", err)
+	} else {
+		defer orderEvents.Close()
+		orderStatusPublisher = orderEvents
+	}
 	paymentService := service.NewPaymentService(
 		orderRepo,
 		transactionRepo,
@@ -105,6 +216,8 @@ func main() {
 		referralService,
 		orderPolicy,
 		jalaliConverter,
+		fraudService,
+		orderStatusPublisher,
 		paymentConfig,
 	)
 
@@ -113,11 +226,52 @@ func main() {
 	authServiceAddr := getEnv("AUTH_SERVICE_ADDR", "auth-service:50051")
 	authConn, err := grpc.Dial(authServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
-		log.Printf("Warning: Failed to connect to auth service - authentication disabled: %v", err)
+		log.Warn("Failed to connect to auth service - authentication disabled", "error", err)
 	} else {
 		defer authConn.Close()
-		log.Printf("Connected to auth service at %s", authServiceAddr)
+		log.Info("Connected to auth service", "addr", authServiceAddr)
+	}
+
+	// Users under 18 have daily and monthly caps on psc and irr spending; their
+	// age comes from auth-service and guardians adjust the caps through dynasty-service
+	var userCache *usercache.Cache
+	if authConn != nil {
+		userCache = usercache.NewFromConn(authConn, usercache.DefaultTTL)
 	}
+	spendingLimitService := service.NewSpendingLimitService(repository.NewSpendingLimitRepository(db), userCache, service.SpendingLimitConfig{
+		PSC: models.SpendingCap{
+			Daily:   decimal.NewFromFloat(getEnvAsFloat("MINOR_DAILY_PSC_LIMIT", service.DefaultMinorDailyPSCLimit, log)),
+			Monthly: decimal.NewFromFloat(getEnvAsFloat("MINOR_MONTHLY_PSC_LIMIT", service.DefaultMinorMonthlyPSCLimit, log)),
+		},
+		IRR: models.SpendingCap{
+			Daily:   decimal.NewFromFloat(getEnvAsFloat("MINOR_DAILY_IRR_LIMIT", service.DefaultMinorDailyIRRLimit, log)),
+			Monthly: decimal.NewFromFloat(getEnvAsFloat("MINOR_MONTHLY_IRR_LIMIT", service.DefaultMinorMonthlyIRRLimit, log)),
+		},
+	})
+	walletService := service.NewWalletService(walletRepo, spendingLimitService, fraudService)
+
+	// Installment plans reserve and transfer features through features-service,
+	// authenticated with an API key holding the service:installments scope
+	serviceAPIKey := getEnv(auth.ServiceAPIKeyEnv, "")
+	if serviceAPIKey == "" {
+		log.Warn("SERVICE_API_KEY is not set - installment plans will be rejected by features service")
+	}
+	featuresServiceAddr := getEnv("FEATURES_SERVICE_ADDR", "features-service:50053")
+	featuresConn, err := grpc.Dial(featuresServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), auth.WithServiceAPIKey(serviceAPIKey))
+	if err != nil {
+		log.Fatal("Failed to connect to features service", "error", err)
+	}
+	defer featuresConn.Close()
+	installmentService := service.NewInstallmentService(installmentRepo, client.NewFeaturesClient(featuresConn), spendingLimitService, service.InstallmentConfig{
+		DownPaymentPercent: getEnvAsFloat("INSTALLMENT_DOWN_PAYMENT_PERCENT", service.DefaultInstallmentDownPaymentPercent, log),
+		PenaltyPercent:     getEnvAsFloat("INSTALLMENT_PENALTY_PERCENT", service.DefaultInstallmentPenaltyPercent, log),
+		GracePeriod:        getEnvAsDuration("INSTALLMENT_GRACE_PERIOD", service.DefaultInstallmentGracePeriod, log),
+	})
+
+	// Premium memberships are charged from the wallet, topped up through the gateway when short
+	subscriptionService := service.NewSubscriptionService(subscriptionRepo, walletRepo, paymentService, spendingLimitService, service.SubscriptionConfig{
+		PendingTimeout: getEnvAsDuration("SUBSCRIPTION_PENDING_TIMEOUT", service.DefaultSubscriptionPendingTimeout, log),
+	})
 
 	// Create token validator using auth service
 	var tokenValidator auth.TokenValidator
@@ -126,43 +280,162 @@ func main() {
 	}
 
 	// Build gRPC server options with interceptors
-	var serverOpts []grpc.ServerOption
+	serverOpts := msgsize.FromEnv("commercial-service", msgsize.Defaults()).ServerOptions()
+	unaryInterceptors := []grpc.UnaryServerInterceptor{logger.UnaryServerInterceptor(log)}
 	if tokenValidator != nil {
-		serverOpts = append(serverOpts, grpc.UnaryInterceptor(auth.UnaryServerInterceptor(tokenValidator)))
+		unaryInterceptors = append(unaryInterceptors, auth.UnaryServerInterceptor(tokenValidator))
 	}
+	serverOpts = append(serverOpts, grpc.ChainUnaryInterceptor(unaryInterceptors...))
+
+	// Cap how long handlers run so slow queries are cancelled, see deadline.FromEnv
+	serverOpts = append(serverOpts, deadline.FromEnv("commercial-service", deadline.Defaults()).ServerOptions()...)
+
+	// Staging builds inject latency and errors into chosen methods, see faultinject
+	faultCtx, stopFaults := context.WithCancel(context.Background())
+	defer stopFaults()
+	serverOpts = append(serverOpts, faultinject.FromEnv(faultCtx, "commercial-service", log)...)
+
+	// Outside development internal errors reach callers only as a reference, see devmode
+	serverOpts = append(serverOpts, devmode.FromEnv("commercial-service", log)...)
+
+	// Every response names the build that served it, see buildinfo
+	serverOpts = append(serverOpts, buildinfo.ServerOptions()...)
 
 	// Create gRPC server
 	grpcServer := grpc.NewServer(serverOpts...)
 
+	// Report readiness over HTTP and through the standard gRPC health service:
+	// the database is reachable, its tables exist and the server has started
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	defer stopHealth()
+	dbMonitor := shareddb.NewHealthMonitor(db, nil)
+	dbMonitor.Start(healthCtx)
+	probe := healthprobe.NewProbe("commercial-service").
+		AddCheck("database", dbMonitor.Check).
+		AddCheck("migrations", shareddb.NewSchemaGuard(db).RequireTables(dbsplit.Ownership["commercial-service"]...))
+	serving := probe.Starting("grpc")
+	probe.Watch(healthCtx, healthServer, healthprobe.DefaultInterval)
+	probeServer := healthprobe.NewServer(":"+getEnv("HEALTH_PORT", healthprobe.DefaultPort), probe)
+	go func() {
+		if err := probeServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Health probe server failed", "error", err)
+		}
+	}()
+
+	// Load the asset rates into the variable cache before taking traffic
+	cachesWarmed := probe.Starting("caches")
+	go func() {
+		defer cachesWarmed()
+		if _, err := variableRepo.GetAllRates(healthCtx); err != nil {
+			log.Warn("Failed to warm variable cache", "error", err)
+		}
+	}()
+
 	// Register handlers
 	handler.RegisterWalletHandler(grpcServer, walletService)
 	handler.RegisterTransactionHandler(grpcServer, transactionService)
 	handler.RegisterPaymentHandler(grpcServer, paymentService)
+	handler.RegisterOrderHandler(grpcServer, orderService, orderPolicy)
+	handler.RegisterVariableHandler(grpcServer, variableService, rateHistoryService, jalaliConverter)
+	handler.RegisterWalletAdjustmentHandler(grpcServer, adjustmentService, jalaliConverter)
+	handler.RegisterInstallmentHandler(grpcServer, installmentService, jalaliConverter)
+	handler.RegisterSubscriptionHandler(grpcServer, subscriptionService, jalaliConverter)
+	handler.RegisterExchangeHandler(grpcServer, exchangeService, jalaliConverter)
+	handler.RegisterSpendingLimitHandler(grpcServer, spendingLimitService)
+	handler.RegisterFraudHandler(grpcServer, fraudService, jalaliConverter)
+	handler.RegisterStatsHandler(grpcServer, repository.NewStatsRepository(db))
+
+	// Background jobs are timed, counted and listed on jobs.Path
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	backgroundJobs := jobs.NewRunner("commercial-service", log)
+
+	// Charge due installments and settle paid off or defaulted plans
+	service.NewInstallmentWorker(installmentService, getEnvAsDuration("INSTALLMENT_INTERVAL", service.DefaultInstallmentInterval, log)).Register(backgroundJobs)
+
+	// Renew subscriptions, retry failed renewals and end canceled or expired ones
+	service.NewSubscriptionWorker(subscriptionService, getEnvAsDuration("SUBSCRIPTION_INTERVAL", service.DefaultSubscriptionInterval, log)).Register(backgroundJobs)
+
+	// Apply scheduled variable changes when they are due
+	scheduledVariablesCtx, stopScheduledVariables := context.WithCancel(context.Background())
+	defer stopScheduledVariables()
+	service.NewScheduledVariableWorker(variableService, getEnvAsDuration("SCHEDULED_VARIABLE_INTERVAL", service.DefaultScheduledVariableInterval, log)).Start(scheduledVariablesCtx)
+
+	// Record rate changes for the 24h trends of the display rates
+	service.NewRateHistoryWorker(rateHistoryService, getEnvAsDuration("RATE_HISTORY_INTERVAL", service.DefaultRateHistoryInterval, log)).Register(backgroundJobs)
+
+	backgroundJobs.Start(jobsCtx)
+
+	// Reflection is only registered in development, see devmode
+	devmode.Register(grpcServer, log)
+
+	// Profiling endpoints, off unless DEBUG_ADDR is set or in development, see debug
+	debug.Serve(context.Background(), "commercial-service", log)
 
 	// Start gRPC server
 	port := getEnv("GRPC_PORT", "50052")
 	listener, err := net.Listen("tcp", ":"+port)
 	if err != nil {
-		log.Fatalf("Failed to listen on port %s: %v", port, err)
+		log.Fatal("Failed to listen", "error", err, "port", port)
 	}
 
-	log.Printf("Commercial service listening on port %s", port)
+	log.Info("Commercial service listening", "port", port)
 
 	// Graceful shutdown
 	go func() {
 		if err := grpcServer.Serve(listener); err != nil {
-			log.Fatalf("Failed to serve: %v", err)
+			log.Fatal("Failed to serve", "error", err)
 		}
 	}()
+	serving()
 
 	// Wait for interrupt signal
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	log.Println("Shutting down server...")
+	log.Info("Shutting down server...")
+	probe.Drain()
+	healthServer.Shutdown()
+	stopJobs()
+	stopLoginEvents()
 	grpcServer.GracefulStop()
-	log.Println("Server stopped")
+	probeServer.Close()
+	log.Info("Server stopped")
+}
+
+// redisURL returns REDIS_URL, or builds it from the individual REDIS_* settings
+func redisURL() string {
+	if url := getEnv("REDIS_URL", ""); url != "" {
+		return url
+	}
+	host := getEnv("REDIS_HOST", "localhost")
+	port := getEnv("REDIS_PORT", "6379")
+	database := getEnv("REDIS_DB", "0")
+	if password := getEnv("REDIS_PASSWORD", ""); password != "" {
+		return fmt.Sprintf("redis://:%s@%s:%s/%s", password, host, port, database)
+	}
+	return fmt.Sprintf("redis://%s:%s/%s", host, port, database)
+}
+
+// parseUserIDs parses a comma separated list of user IDs, skipping invalid entries
+func parseUserIDs(value string, log *logger.Logger) []uint64 {
+	var ids []uint64
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			log.Warn("Ignoring invalid user id", "value", part)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
 }
 
 func getEnv(key, defaultValue string) string {
@@ -171,3 +444,45 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+func getEnvAsFloat(key string, defaultValue float64, log *logger.Logger) float64 {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.ParseFloat(valueStr, 64)
+	if err != nil {
+		log.Warn("Invalid "+key+", using default", "value", valueStr, "default", defaultValue)
+		return defaultValue
+	}
+	return value
+}
+
+func getEnvAsInt(key string, defaultValue int, log *logger.Logger) int {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.Atoi(valueStr)
+	if err != nil {
+		log.Warn("Invalid "+key+", using default", "value", valueStr, "default", defaultValue)
+		return defaultValue
+	}
+	return value
+}
+
+func getEnvAsDuration(key string, defaultValue time.Duration, log *logger.Logger) time.Duration {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := time.ParseDuration(valueStr)
+	if err != nil {
+		log.Warn("Invalid "+key+", using default", "value", valueStr, "default", defaultValue)
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	featurespb "metargb/shared/pb/features"
+)
+
+// FeaturesClient reserves and transfers features sold in installments
+type FeaturesClient struct {
+	installmentClient featurespb.FeatureInstallmentServiceClient
+}
+
+// NewFeaturesClient creates a features-service client on an existing connection
+func NewFeaturesClient(conn *grpc.ClientConn) *FeaturesClient {
+	return &FeaturesClient{
+		installmentClient: featurespb.NewFeatureInstallmentServiceClient(conn),
+	}
+}
+
+// ReserveFeature holds a feature for an installment buyer and returns the sale amounts
+func (c *FeaturesClient) ReserveFeature(ctx context.Context, featureID, buyerID uint64) (*featurespb.FeatureReservation, error) {
+	return c.installmentClient.ReserveFeature(ctx, &featurespb.ReserveFeatureRequest{
+		FeatureId: featureID,
+		BuyerId:   buyerID,
+	})
+}
+
+// CompleteReservedPurchase transfers a reserved feature to its buyer and returns the trade id
+func (c *FeaturesClient) CompleteReservedPurchase(ctx context.Context, featureID, buyerID uint64) (uint64, error) {
+	resp, err := c.installmentClient.CompleteReservedPurchase(ctx, &featurespb.FeatureReservationRequest{
+		FeatureId: featureID,
+		BuyerId:   buyerID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to complete reserved purchase: %w", err)
+	}
+	return resp.TradeId, nil
+}
+
+// ReleaseFeatureReservation frees a reserved feature
+func (c *FeaturesClient) ReleaseFeatureReservation(ctx context.Context, featureID, buyerID uint64) error {
+	if _, err := c.installmentClient.ReleaseFeatureReservation(ctx, &featurespb.FeatureReservationRequest{
+		FeatureId: featureID,
+		BuyerId:   buyerID,
+	}); err != nil {
+		return fmt.Errorf("failed to release feature reservation: %w", err)
+	}
+	return nil
+}
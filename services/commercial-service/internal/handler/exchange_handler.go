@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+	"metargb/commercial-service/internal/service"
+	pb "metargb/shared/pb/commercial"
+	"metargb/shared/pkg/auth"
+)
+
+type ExchangeHandler struct {
+	pb.UnimplementedExchangeServiceServer
+	exchangeService service.ExchangeService
+	jalaliConverter service.JalaliConverter
+}
+
+func NewExchangeHandler(exchangeService service.ExchangeService, jalaliConverter service.JalaliConverter) *ExchangeHandler {
+	return &ExchangeHandler{
+		exchangeService: exchangeService,
+		jalaliConverter: jalaliConverter,
+	}
+}
+
+func RegisterExchangeHandler(grpcServer *grpc.Server, exchangeService service.ExchangeService, jalaliConverter service.JalaliConverter) {
+	handler := NewExchangeHandler(exchangeService, jalaliConverter)
+	pb.RegisterExchangeServiceServer(grpcServer, handler)
+}
+
+func (h *ExchangeHandler) ListExchangeRates(ctx context.Context, req *pb.ListExchangeRatesRequest) (*pb.ListExchangeRatesResponse, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := h.exchangeService.ListRates(ctx, user.UserID, req.IncludeDisabled && !user.IsAPIKey())
+	if err != nil {
+		return nil, mapExchangeError(err)
+	}
+
+	response := &pb.ListExchangeRatesResponse{
+		Rates: make([]*pb.ExchangeRate, len(rates)),
+	}
+	for i, rate := range rates {
+		response.Rates[i] = h.convertRateToProto(rate)
+	}
+
+	return response, nil
+}
+
+func (h *ExchangeHandler) SetExchangeRate(ctx context.Context, req *pb.SetExchangeRateRequest) (*pb.ExchangeRate, error) {
+	// Rates are set by people, like adjustment batches
+	adminID, err := adjustmentAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := h.exchangeService.SetRate(ctx, adminID, req.FromAsset, req.ToAsset, req.Rate, req.FeePercent, req.Enabled)
+	if err != nil {
+		return nil, mapExchangeError(err)
+	}
+
+	return h.convertRateToProto(rate), nil
+}
+
+func (h *ExchangeHandler) Convert(ctx context.Context, req *pb.ConvertRequest) (*pb.Conversion, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversion, err := h.exchangeService.Convert(ctx, user.UserID, req.FromAsset, req.ToAsset, req.Amount, req.MinReceive)
+	if err != nil {
+		return nil, mapExchangeError(err)
+	}
+
+	return &pb.Conversion{
+		Id:                    conversion.ID,
+		FromAsset:             conversion.FromAsset,
+		ToAsset:               conversion.ToAsset,
+		Amount:                conversion.Amount.String(),
+		Received:              conversion.Received.String(),
+		Fee:                   conversion.Fee.String(),
+		Rate:                  conversion.Rate.String(),
+		WithdrawTransactionId: conversion.WithdrawTransactionID,
+		DepositTransactionId:  conversion.DepositTransactionID,
+		Date:                  h.jalaliConverter.FormatJalaliDate(conversion.CreatedAt),
+		Time:                  h.jalaliConverter.FormatJalaliTime(conversion.CreatedAt),
+	}, nil
+}
+
+func mapExchangeError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrExchangeNotAdmin):
+		return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case errors.Is(err, service.ErrExchangeInvalidAsset),
+		errors.Is(err, service.ErrExchangeInvalidRate),
+		errors.Is(err, service.ErrExchangeInvalidFee),
+		errors.Is(err, service.ErrExchangeInvalidAmount),
+		errors.Is(err, service.ErrExchangeInvalidMin),
+		errors.Is(err, repository.ErrConversionTooSmall):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	case errors.Is(err, repository.ErrExchangeRateNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, repository.ErrConversionSlippage),
+		errors.Is(err, repository.ErrConversionWalletNotFound),
+		errors.Is(err, repository.ErrConversionInsufficientBalance):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	}
+	return status.Errorf(codes.Internal, "operation failed: %v", err)
+}
+
+func (h *ExchangeHandler) convertRateToProto(rate *models.ExchangeRate) *pb.ExchangeRate {
+	return &pb.ExchangeRate{
+		FromAsset:  rate.FromAsset,
+		ToAsset:    rate.ToAsset,
+		Rate:       rate.Rate.String(),
+		FeePercent: rate.FeePercent.String(),
+		Enabled:    rate.Enabled,
+		UpdatedBy:  rate.UpdatedBy,
+		Date:       h.jalaliConverter.FormatJalaliDate(rate.UpdatedAt),
+		Time:       h.jalaliConverter.FormatJalaliTime(rate.UpdatedAt),
+	}
+}
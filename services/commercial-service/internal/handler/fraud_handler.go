@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+	"metargb/commercial-service/internal/service"
+	pb "metargb/shared/pb/commercial"
+)
+
+type FraudHandler struct {
+	pb.UnimplementedFraudServiceServer
+	fraudService    service.FraudService
+	jalaliConverter service.JalaliConverter
+}
+
+func NewFraudHandler(fraudService service.FraudService, jalaliConverter service.JalaliConverter) *FraudHandler {
+	return &FraudHandler{
+		fraudService:    fraudService,
+		jalaliConverter: jalaliConverter,
+	}
+}
+
+func RegisterFraudHandler(grpcServer *grpc.Server, fraudService service.FraudService, jalaliConverter service.JalaliConverter) {
+	handler := NewFraudHandler(fraudService, jalaliConverter)
+	pb.RegisterFraudServiceServer(grpcServer, handler)
+}
+
+func (h *FraudHandler) ListFraudReviews(ctx context.Context, req *pb.ListFraudReviewsRequest) (*pb.ListFraudReviewsResponse, error) {
+	// Reviews are worked by people, like adjustment batches
+	adminID, err := fraudAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	checks, err := h.fraudService.ListReviews(ctx, adminID, req.Status)
+	if err != nil {
+		return nil, mapFraudError(err)
+	}
+
+	response := &pb.ListFraudReviewsResponse{
+		Checks: make([]*pb.FraudCheck, len(checks)),
+	}
+	for i, check := range checks {
+		response.Checks[i] = h.convertCheckToProto(check)
+	}
+
+	return response, nil
+}
+
+func (h *FraudHandler) ResolveFraudReview(ctx context.Context, req *pb.ResolveFraudReviewRequest) (*pb.FraudCheck, error) {
+	adminID, err := fraudAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	check, err := h.fraudService.ResolveReview(ctx, adminID, req.CheckId, req.Approve, req.Note)
+	if err != nil {
+		return nil, mapFraudError(err)
+	}
+
+	return h.convertCheckToProto(check), nil
+}
+
+func (h *FraudHandler) ListBlockedCards(ctx context.Context, req *pb.ListBlockedCardsRequest) (*pb.ListBlockedCardsResponse, error) {
+	adminID, err := fraudAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cards, err := h.fraudService.ListBlockedCards(ctx, adminID)
+	if err != nil {
+		return nil, mapFraudError(err)
+	}
+
+	response := &pb.ListBlockedCardsResponse{
+		Cards: make([]*pb.BlockedCard, len(cards)),
+	}
+	for i, card := range cards {
+		response.Cards[i] = h.convertBlockedCardToProto(card)
+	}
+
+	return response, nil
+}
+
+func (h *FraudHandler) BlockCard(ctx context.Context, req *pb.BlockCardRequest) (*pb.BlockedCard, error) {
+	adminID, err := fraudAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	card, err := h.fraudService.BlockCard(ctx, adminID, req.Pattern, req.Reason)
+	if err != nil {
+		return nil, mapFraudError(err)
+	}
+
+	return h.convertBlockedCardToProto(card), nil
+}
+
+func (h *FraudHandler) UnblockCard(ctx context.Context, req *pb.UnblockCardRequest) (*emptypb.Empty, error) {
+	adminID, err := fraudAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.fraudService.UnblockCard(ctx, adminID, req.CardId); err != nil {
+		return nil, mapFraudError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// fraudAdminID returns the calling admin, API keys cannot review checks
+func fraudAdminID(ctx context.Context) (uint64, error) {
+	adminID, err := adjustmentAdminID(ctx)
+	if status.Code(err) == codes.PermissionDenied {
+		return 0, status.Error(codes.PermissionDenied, service.ErrFraudNotAdmin.Error())
+	}
+	return adminID, err
+}
+
+func mapFraudError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrFraudNotAdmin):
+		return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case errors.Is(err, service.ErrFraudCheckNotFound), errors.Is(err, repository.ErrBlockedCardNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrFraudInvalidStatus), errors.Is(err, service.ErrFraudInvalidPattern):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	case errors.Is(err, repository.ErrFraudCheckNotPending):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	case errors.Is(err, repository.ErrBlockedCardExists):
+		return status.Errorf(codes.AlreadyExists, "%s", err.Error())
+	}
+	return status.Errorf(codes.Internal, "operation failed: %v", err)
+}
+
+func (h *FraudHandler) convertCheckToProto(check *models.FraudCheck) *pb.FraudCheck {
+	result := &pb.FraudCheck{
+		Id:           check.ID,
+		UserId:       check.UserID,
+		Kind:         check.Kind,
+		Asset:        check.Asset,
+		Amount:       check.Amount.String(),
+		Decision:     check.Decision,
+		Reasons:      check.Reasons,
+		Reference:    check.Reference,
+		Ip:           check.IP,
+		Device:       check.Device,
+		CardPan:      check.CardPan,
+		ReviewStatus: check.ReviewStatus,
+		ReviewedBy:   check.ReviewedBy,
+		ReviewNote:   check.ReviewNote,
+		Date:         h.jalaliConverter.FormatJalaliDate(check.CreatedAt),
+		Time:         h.jalaliConverter.FormatJalaliTime(check.CreatedAt),
+	}
+	if check.ReviewedAt != nil {
+		result.ReviewedDate = h.jalaliConverter.FormatJalaliDate(*check.ReviewedAt)
+	}
+	return result
+}
+
+func (h *FraudHandler) convertBlockedCardToProto(card *models.BlockedCard) *pb.BlockedCard {
+	return &pb.BlockedCard{
+		Id:        card.ID,
+		Pattern:   card.Pattern,
+		Reason:    card.Reason,
+		CreatedBy: card.CreatedBy,
+		Date:      h.jalaliConverter.FormatJalaliDate(card.CreatedAt),
+		Time:      h.jalaliConverter.FormatJalaliTime(card.CreatedAt),
+	}
+}
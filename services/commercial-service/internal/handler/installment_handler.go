@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+	"metargb/commercial-service/internal/service"
+	pb "metargb/shared/pb/commercial"
+	"metargb/shared/pkg/auth"
+)
+
+type InstallmentHandler struct {
+	pb.UnimplementedInstallmentServiceServer
+	installmentService service.InstallmentService
+	jalaliConverter    service.JalaliConverter
+}
+
+func NewInstallmentHandler(installmentService service.InstallmentService, jalaliConverter service.JalaliConverter) *InstallmentHandler {
+	return &InstallmentHandler{
+		installmentService: installmentService,
+		jalaliConverter:    jalaliConverter,
+	}
+}
+
+func RegisterInstallmentHandler(grpcServer *grpc.Server, installmentService service.InstallmentService, jalaliConverter service.JalaliConverter) {
+	handler := NewInstallmentHandler(installmentService, jalaliConverter)
+	pb.RegisterInstallmentServiceServer(grpcServer, handler)
+}
+
+func (h *InstallmentHandler) CreateInstallmentPlan(ctx context.Context, req *pb.CreateInstallmentPlanRequest) (*pb.InstallmentPlan, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.FeatureId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "feature_id is required")
+	}
+
+	plan, err := h.installmentService.CreatePlan(ctx, user.UserID, req.FeatureId, req.Installments)
+	if err != nil {
+		return nil, mapInstallmentError(err)
+	}
+
+	return h.convertPlanToProto(plan), nil
+}
+
+func (h *InstallmentHandler) ListInstallmentPlans(ctx context.Context, req *pb.ListInstallmentPlansRequest) (*pb.ListInstallmentPlansResponse, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plans, err := h.installmentService.ListPlans(ctx, user.UserID, req.Status)
+	if err != nil {
+		return nil, mapInstallmentError(err)
+	}
+
+	response := &pb.ListInstallmentPlansResponse{
+		Plans: make([]*pb.InstallmentPlan, len(plans)),
+	}
+	for i, plan := range plans {
+		response.Plans[i] = h.convertPlanToProto(plan)
+	}
+
+	return response, nil
+}
+
+func (h *InstallmentHandler) GetInstallmentPlan(ctx context.Context, req *pb.GetInstallmentPlanRequest) (*pb.InstallmentPlan, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.PlanId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "plan_id is required")
+	}
+
+	plan, err := h.installmentService.GetPlan(ctx, user.UserID, req.PlanId)
+	if err != nil {
+		return nil, mapInstallmentError(err)
+	}
+
+	return h.convertPlanToProto(plan), nil
+}
+
+func (h *InstallmentHandler) PayInstallment(ctx context.Context, req *pb.PayInstallmentRequest) (*pb.InstallmentPlan, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.PlanId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "plan_id is required")
+	}
+
+	plan, err := h.installmentService.PayInstallment(ctx, user.UserID, req.PlanId)
+	if err != nil {
+		return nil, mapInstallmentError(err)
+	}
+
+	return h.convertPlanToProto(plan), nil
+}
+
+func mapInstallmentError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInstallmentNotBuyer):
+		return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case errors.Is(err, service.ErrInstallmentPlanNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrInstallmentCountOutOfRange),
+		errors.Is(err, service.ErrInstallmentInvalidStatus):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	case errors.Is(err, repository.ErrInstallmentPlanNotActive),
+		errors.Is(err, repository.ErrInstallmentNothingDue),
+		errors.Is(err, repository.ErrInstallmentInsufficientBalance),
+		errors.Is(err, repository.ErrDailySpendingLimit),
+		errors.Is(err, repository.ErrMonthlySpendingLimit):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	}
+	// Reservation errors from features-service keep their status
+	if st, ok := status.FromError(err); ok && st.Code() != codes.Unknown {
+		return err
+	}
+	return status.Errorf(codes.Internal, "operation failed: %v", err)
+}
+
+func (h *InstallmentHandler) convertPlanToProto(plan *models.InstallmentPlan) *pb.InstallmentPlan {
+	response := &pb.InstallmentPlan{
+		Id:          plan.ID,
+		FeatureId:   plan.FeatureID,
+		BuyerId:     plan.BuyerID,
+		SellerId:    plan.SellerID,
+		Status:      plan.Status,
+		TotalPsc:    plan.TotalPSC.String(),
+		TotalIrr:    plan.TotalIRR.String(),
+		PaidPsc:     plan.PaidPSC.String(),
+		PaidIrr:     plan.PaidIRR.String(),
+		RefundedPsc: plan.RefundedPSC.String(),
+		RefundedIrr: plan.RefundedIRR.String(),
+		Date:        h.jalaliConverter.FormatJalaliDate(plan.CreatedAt),
+		Time:        h.jalaliConverter.FormatJalaliTime(plan.CreatedAt),
+	}
+	if plan.TradeID != nil {
+		response.TradeId = *plan.TradeID
+	}
+	if plan.ClosedAt != nil {
+		response.ClosedDate = h.jalaliConverter.FormatJalaliDate(*plan.ClosedAt)
+	}
+
+	for _, installment := range plan.Installments {
+		protoInstallment := &pb.Installment{
+			Sequence:  installment.Sequence,
+			AmountPsc: installment.AmountPSC.String(),
+			AmountIrr: installment.AmountIRR.String(),
+			Status:    installment.Status,
+			DueDate:   h.jalaliConverter.FormatJalaliDate(installment.DueAt),
+		}
+		if installment.PaidAt != nil {
+			protoInstallment.PaidDate = h.jalaliConverter.FormatJalaliDate(*installment.PaidAt)
+		}
+		response.Installments = append(response.Installments, protoInstallment)
+	}
+
+	return response
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"metargb/commercial-service/internal/service"
+	pb "metargb/shared/pb/commercial"
+	"metargb/shared/pkg/money"
+)
+
+type OrderHandler struct {
+	pb.UnimplementedOrderServiceServer
+	orderService service.OrderService
+	orderPolicy  service.OrderPolicy
+}
+
+func NewOrderHandler(orderService service.OrderService, orderPolicy service.OrderPolicy) *OrderHandler {
+	return &OrderHandler{
+		orderService: orderService,
+		orderPolicy:  orderPolicy,
+	}
+}
+
+func RegisterOrderHandler(grpcServer *grpc.Server, orderService service.OrderService, orderPolicy service.OrderPolicy) {
+	handler := NewOrderHandler(orderService, orderPolicy)
+	pb.RegisterOrderServiceServer(grpcServer, handler)
+}
+
+func (h *OrderHandler) ListOrders(ctx context.Context, req *pb.ListOrdersRequest) (*pb.ListOrdersResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	orders, total, page, perPage, err := h.orderService.ListOrders(ctx, req.UserId, service.ListOrdersInput{
+		Statuses: req.Status,
+		Gateway:  req.Gateway,
+		FromDate: req.FromDate,
+		ToDate:   req.ToDate,
+		Page:     int(req.Page),
+		PerPage:  int(req.PerPage),
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidOrderDate) || errors.Is(err, service.ErrInvalidOrderDateRange) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list orders: %v", err)
+	}
+
+	resources := make([]*pb.OrderResource, 0, len(orders))
+	for _, o := range orders {
+		resources = append(resources, &pb.OrderResource{
+			Id:            o.ID,
+			Asset:         o.Asset,
+			Amount:        money.Float(o.Amount),
+			AmountDecimal: money.Format(o.Amount),
+			Status:        o.Status,
+			Gateway:       o.Gateway,
+			RefId:         o.RefID,
+			Date:          o.Date, // Already in Jalali format
+			Time:          o.Time, // Already in Jalali format
+		})
+	}
+
+	return &pb.ListOrdersResponse{
+		Orders:       resources,
+		CurrentPage:  int32(page),
+		PerPage:      int32(perPage),
+		Total:        int32(total),
+		HasMorePages: int64(page*perPage) < total,
+	}, nil
+}
+
+func (h *OrderHandler) EvaluateFirstOrderBonus(ctx context.Context, req *pb.EvaluateFirstOrderBonusRequest) (*pb.FirstOrderBonusEvaluation, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.Asset == "" {
+		return nil, status.Error(codes.InvalidArgument, "asset is required")
+	}
+	amount, err := money.Parse(req.Amount)
+	if err != nil || !amount.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "amount must be a positive number")
+	}
+
+	result, err := h.orderPolicy.PreviewFirstOrderBonus(ctx, req.UserId, req.Asset, amount)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to evaluate first order bonus: %v", err)
+	}
+
+	resp := &pb.FirstOrderBonusEvaluation{
+		Eligible:     result.Eligible,
+		Reason:       result.Reason,
+		Bonus:        money.Format(result.Bonus),
+		BonusPercent: money.Format(result.Rules.BonusPercent),
+		MinAmount:    money.Format(result.Rules.MinAmount),
+		WindowDays:   int32(result.Rules.WindowDays),
+		IrrValue:     money.Format(result.IRRValue),
+	}
+	if !result.WindowEndsAt.IsZero() {
+		resp.WindowEndsAt = timestamppb.New(result.WindowEndsAt)
+	}
+	return resp, nil
+}
@@ -2,13 +2,16 @@ package handler
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	"metargb/commercial-service/internal/service"
 	pb "metargb/shared/pb/commercial"
+	"metargb/shared/pkg/money"
 )
 
 type PaymentHandler struct {
@@ -28,7 +31,15 @@ func RegisterPaymentHandler(grpcServer *grpc.Server, paymentService service.Paym
 }
 
 func (h *PaymentHandler) InitiatePayment(ctx context.Context, req *pb.InitiatePaymentRequest) (*pb.InitiatePaymentResponse, error) {
-	paymentURL, orderID, transactionID, err := h.paymentService.InitiatePayment(ctx, req.UserId, req.Asset, req.Amount)
+	amount, err := requestAmount(req.AmountDecimal, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentURL, orderID, transactionID, err := h.paymentService.InitiatePayment(ctx, req.UserId, req.Asset, amount, req.Ip, req.Device)
+	if errors.Is(err, service.ErrFraudDenied) || errors.Is(err, service.ErrFraudReview) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to initiate payment: %v", err)
 	}
@@ -40,19 +51,6 @@ func (h *PaymentHandler) InitiatePayment(ctx context.Context, req *pb.InitiatePa
 	}, nil
 }
 
-func (h *PaymentHandler) HandleCallback(ctx context.Context, req *pb.HandleCallbackRequest) (*pb.HandleCallbackResponse, error) {
-	success, redirectURL, message, err := h.paymentService.HandleCallback(ctx, req.OrderId, req.Status, req.Token)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to handle callback: %v", err)
-	}
-
-	return &pb.HandleCallbackResponse{
-		Success:     success,
-		RedirectUrl: redirectURL,
-		Message:     message,
-	}, nil
-}
-
 func (h *PaymentHandler) VerifyPayment(ctx context.Context, req *pb.VerifyPaymentRequest) (*pb.VerifyPaymentResponse, error) {
 	success, statusCode, referenceID, cardHash, message, err := h.paymentService.VerifyPayment(ctx, req.Token, req.MerchantId)
 	if err != nil {
@@ -67,3 +65,73 @@ func (h *PaymentHandler) VerifyPayment(ctx context.Context, req *pb.VerifyPaymen
 		Message:     message,
 	}, nil
 }
+
+func (h *PaymentHandler) ScreenPayment(ctx context.Context, req *pb.ScreenPaymentRequest) (*emptypb.Empty, error) {
+	amount, err := requestAmount(req.Amount, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.paymentService.ScreenPayment(ctx, req.UserId, req.Asset, amount, req.Ip, req.Device)
+	if errors.Is(err, service.ErrFraudDenied) || errors.Is(err, service.ErrFraudReview) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to screen payment: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *PaymentHandler) ScreenPaymentCard(ctx context.Context, req *pb.ScreenPaymentCardRequest) (*emptypb.Empty, error) {
+	amount, err := requestAmount(req.Amount, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.paymentService.ScreenPaymentCard(ctx, req.UserId, req.OrderId, req.Asset, amount, req.CardPan)
+	if errors.Is(err, service.ErrFraudDenied) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to screen payment card: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *PaymentHandler) SettleOrder(ctx context.Context, req *pb.SettleOrderRequest) (*pb.SettleOrderResponse, error) {
+	if req.OrderId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "order_id is required")
+	}
+
+	credited, bonus, err := h.paymentService.SettleOrder(ctx, req.OrderId, req.RefId)
+	switch {
+	case errors.Is(err, service.ErrPaymentOrderNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrPaymentOrderNotPaid):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to settle order: %v", err)
+	}
+
+	return &pb.SettleOrderResponse{
+		Credited: money.Format(credited),
+		Bonus:    money.Format(bonus),
+	}, nil
+}
+
+func (h *PaymentHandler) PublishOrderStatus(ctx context.Context, req *pb.PublishOrderStatusRequest) (*emptypb.Empty, error) {
+	if req.OrderId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "order_id is required")
+	}
+
+	err := h.paymentService.PublishOrderStatus(ctx, req.OrderId, req.Status, req.Message)
+	switch {
+	case errors.Is(err, service.ErrInvalidPaymentState):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrPaymentOrderNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to publish order status: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/service"
+	pb "metargb/shared/pb/commercial"
+)
+
+type SpendingLimitHandler struct {
+	pb.UnimplementedSpendingLimitServiceServer
+	spendingLimitService service.SpendingLimitService
+}
+
+func NewSpendingLimitHandler(spendingLimitService service.SpendingLimitService) *SpendingLimitHandler {
+	return &SpendingLimitHandler{
+		spendingLimitService: spendingLimitService,
+	}
+}
+
+func RegisterSpendingLimitHandler(grpcServer *grpc.Server, spendingLimitService service.SpendingLimitService) {
+	handler := NewSpendingLimitHandler(spendingLimitService)
+	pb.RegisterSpendingLimitServiceServer(grpcServer, handler)
+}
+
+func (h *SpendingLimitHandler) GetSpendingLimits(ctx context.Context, req *pb.GetSpendingLimitsRequest) (*pb.SpendingLimits, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	limits, err := h.spendingLimitService.GetLimits(ctx, req.UserId)
+	if err != nil {
+		return nil, mapSpendingLimitError(err)
+	}
+	return convertSpendingLimitsToProto(limits), nil
+}
+
+// SetSpendingLimits changes the limits of a user under 18. dynasty-service
+// calls it once it checked that updated_by is the user's guardian.
+func (h *SpendingLimitHandler) SetSpendingLimits(ctx context.Context, req *pb.SetSpendingLimitsRequest) (*pb.SpendingLimits, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.UpdatedBy == 0 {
+		return nil, status.Error(codes.InvalidArgument, "updated_by is required")
+	}
+
+	update := &models.SpendingLimits{}
+	for _, field := range []struct {
+		value  string
+		target **decimal.Decimal
+	}{
+		{req.DailyPsc, &update.DailyPSC},
+		{req.MonthlyPsc, &update.MonthlyPSC},
+		{req.DailyIrr, &update.DailyIRR},
+		{req.MonthlyIrr, &update.MonthlyIRR},
+	} {
+		if field.value == "" {
+			continue
+		}
+		amount, err := decimal.NewFromString(field.value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", service.ErrSpendingLimitInvalid.Error())
+		}
+		*field.target = &amount
+	}
+
+	limits, err := h.spendingLimitService.SetLimits(ctx, req.UserId, req.UpdatedBy, update)
+	if err != nil {
+		return nil, mapSpendingLimitError(err)
+	}
+	return convertSpendingLimitsToProto(limits), nil
+}
+
+func mapSpendingLimitError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrSpendingLimitNotMinor):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	case errors.Is(err, service.ErrSpendingLimitInvalid),
+		errors.Is(err, service.ErrSpendingLimitAboveMax),
+		errors.Is(err, service.ErrSpendingLimitDailyAboveMonthly):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	case errors.Is(err, service.ErrSpendingLimitUnavailable):
+		return status.Errorf(codes.Unavailable, "%s", err.Error())
+	}
+	return status.Errorf(codes.Internal, "operation failed: %v", err)
+}
+
+func convertSpendingLimitsToProto(limits *service.SpendingLimitStatus) *pb.SpendingLimits {
+	assetToProto := func(asset string) *pb.AssetSpendingLimit {
+		a := limits.Assets[asset]
+		return &pb.AssetSpendingLimit{
+			Daily:          a.Limit.Daily.String(),
+			Monthly:        a.Limit.Monthly.String(),
+			SpentToday:     a.Spent.Day.String(),
+			SpentThisMonth: a.Spent.Month.String(),
+			MaxDaily:       a.Max.Daily.String(),
+			MaxMonthly:     a.Max.Monthly.String(),
+		}
+	}
+	return &pb.SpendingLimits{
+		UserId:    limits.UserID,
+		Applies:   limits.Applies,
+		Psc:       assetToProto("psc"),
+		Irr:       assetToProto("irr"),
+		UpdatedBy: limits.UpdatedBy,
+	}
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"metargb/commercial-service/internal/repository"
+	pb "metargb/shared/pb/stats"
+)
+
+type StatsHandler struct {
+	pb.UnimplementedStatsServiceServer
+	statsRepo repository.StatsRepository
+}
+
+func NewStatsHandler(statsRepo repository.StatsRepository) *StatsHandler {
+	return &StatsHandler{
+		statsRepo: statsRepo,
+	}
+}
+
+func RegisterStatsHandler(grpcServer *grpc.Server, statsRepo repository.StatsRepository) {
+	handler := NewStatsHandler(statsRepo)
+	pb.RegisterStatsServiceServer(grpcServer, handler)
+}
+
+// GetStats reports payment figures for the scheduled admin reports
+func (h *StatsHandler) GetStats(ctx context.Context, req *pb.GetStatsRequest) (*pb.GetStatsResponse, error) {
+	if req.From <= 0 || req.To <= req.From {
+		return nil, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+
+	payments, revenue, err := h.statsRepo.GetPaymentStats(ctx, time.Unix(req.From, 0), time.Unix(req.To, 0))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get stats: %v", err)
+	}
+
+	return &pb.GetStatsResponse{
+		Metrics: []*pb.Metric{
+			{Key: "payments", Value: float64(payments)},
+			{Key: "revenue_irr", Value: float64(revenue)},
+		},
+	}, nil
+}
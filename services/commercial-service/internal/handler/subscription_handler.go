@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+	"metargb/commercial-service/internal/service"
+	pb "metargb/shared/pb/commercial"
+	"metargb/shared/pkg/auth"
+)
+
+type SubscriptionHandler struct {
+	pb.UnimplementedSubscriptionServiceServer
+	subscriptionService service.SubscriptionService
+	jalaliConverter     service.JalaliConverter
+}
+
+func NewSubscriptionHandler(subscriptionService service.SubscriptionService, jalaliConverter service.JalaliConverter) *SubscriptionHandler {
+	return &SubscriptionHandler{
+		subscriptionService: subscriptionService,
+		jalaliConverter:     jalaliConverter,
+	}
+}
+
+func RegisterSubscriptionHandler(grpcServer *grpc.Server, subscriptionService service.SubscriptionService, jalaliConverter service.JalaliConverter) {
+	handler := NewSubscriptionHandler(subscriptionService, jalaliConverter)
+	pb.RegisterSubscriptionServiceServer(grpcServer, handler)
+}
+
+func (h *SubscriptionHandler) ListSubscriptionPlans(ctx context.Context, req *pb.ListSubscriptionPlansRequest) (*pb.ListSubscriptionPlansResponse, error) {
+	plans, err := h.subscriptionService.ListPlans(ctx)
+	if err != nil {
+		return nil, mapSubscriptionError(err)
+	}
+
+	response := &pb.ListSubscriptionPlansResponse{
+		Plans: make([]*pb.SubscriptionPlan, len(plans)),
+	}
+	for i, plan := range plans {
+		response.Plans[i] = convertSubscriptionPlanToProto(plan)
+	}
+
+	return response, nil
+}
+
+func (h *SubscriptionHandler) Subscribe(ctx context.Context, req *pb.SubscribeRequest) (*pb.SubscriptionPayment, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.PlanId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "plan_id is required")
+	}
+
+	payment, err := h.subscriptionService.Subscribe(ctx, user.UserID, req.PlanId, service.PaymentOptions{
+		UseGateway: req.UseGateway,
+		IP:         req.Ip,
+		Device:     req.Device,
+	})
+	if err != nil {
+		return nil, mapSubscriptionError(err)
+	}
+
+	return h.convertPaymentToProto(payment), nil
+}
+
+func (h *SubscriptionHandler) GetSubscription(ctx context.Context, req *pb.GetSubscriptionRequest) (*pb.Subscription, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := h.subscriptionService.GetSubscription(ctx, user.UserID)
+	if err != nil {
+		return nil, mapSubscriptionError(err)
+	}
+
+	return h.convertSubscriptionToProto(subscription), nil
+}
+
+func (h *SubscriptionHandler) CancelSubscription(ctx context.Context, req *pb.CancelSubscriptionRequest) (*pb.Subscription, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.SubscriptionId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "subscription_id is required")
+	}
+
+	subscription, err := h.subscriptionService.Cancel(ctx, user.UserID, req.SubscriptionId)
+	if err != nil {
+		return nil, mapSubscriptionError(err)
+	}
+
+	return h.convertSubscriptionToProto(subscription), nil
+}
+
+func (h *SubscriptionHandler) PaySubscription(ctx context.Context, req *pb.PaySubscriptionRequest) (*pb.SubscriptionPayment, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.SubscriptionId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "subscription_id is required")
+	}
+
+	payment, err := h.subscriptionService.Pay(ctx, user.UserID, req.SubscriptionId, service.PaymentOptions{
+		UseGateway: req.UseGateway,
+		IP:         req.Ip,
+		Device:     req.Device,
+	})
+	if err != nil {
+		return nil, mapSubscriptionError(err)
+	}
+
+	return h.convertPaymentToProto(payment), nil
+}
+
+// GetEntitlements is called by other services to gate premium features and
+// is not routed by the gateway
+func (h *SubscriptionHandler) GetEntitlements(ctx context.Context, req *pb.GetEntitlementsRequest) (*pb.Entitlements, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	entitlements, err := h.subscriptionService.GetEntitlements(ctx, req.UserId)
+	if err != nil {
+		return nil, mapSubscriptionError(err)
+	}
+
+	response := &pb.Entitlements{
+		UserId: entitlements.UserID,
+		Flags:  entitlements.Flags,
+	}
+	if subscription := entitlements.Subscription; subscription != nil {
+		response.PlanId = subscription.PlanID
+		response.PlanSlug = subscription.Plan.Slug
+		response.Status = subscription.Status
+		response.ExpiresAt = timestamppb.New(subscription.PeriodEnd)
+	}
+
+	return response, nil
+}
+
+func mapSubscriptionError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrSubscriptionPlanNotFound),
+		errors.Is(err, service.ErrSubscriptionNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, repository.ErrSubscriptionExists):
+		return status.Errorf(codes.AlreadyExists, "%s", err.Error())
+	case errors.Is(err, service.ErrSubscriptionNotDue),
+		errors.Is(err, repository.ErrSubscriptionNotLive),
+		errors.Is(err, repository.ErrSubscriptionInsufficientBalance),
+		errors.Is(err, repository.ErrDailySpendingLimit),
+		errors.Is(err, repository.ErrMonthlySpendingLimit):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	}
+	// Fraud checks of gateway payments keep their status
+	if st, ok := status.FromError(err); ok && st.Code() != codes.Unknown {
+		return err
+	}
+	return status.Errorf(codes.Internal, "operation failed: %v", err)
+}
+
+func (h *SubscriptionHandler) convertPaymentToProto(payment *service.SubscriptionPayment) *pb.SubscriptionPayment {
+	return &pb.SubscriptionPayment{
+		Subscription: h.convertSubscriptionToProto(payment.Subscription),
+		PaymentUrl:   payment.PaymentURL,
+		OrderId:      payment.OrderID,
+	}
+}
+
+func (h *SubscriptionHandler) convertSubscriptionToProto(subscription *models.Subscription) *pb.Subscription {
+	response := &pb.Subscription{
+		Id:                subscription.ID,
+		UserId:            subscription.UserID,
+		Status:            subscription.Status,
+		CancelAtPeriodEnd: subscription.CancelAtPeriodEnd,
+		FailedAttempts:    subscription.FailedAttempts,
+		Date:              h.jalaliConverter.FormatJalaliDate(subscription.CreatedAt),
+		Time:              h.jalaliConverter.FormatJalaliTime(subscription.CreatedAt),
+	}
+	if subscription.Plan != nil {
+		response.Plan = convertSubscriptionPlanToProto(subscription.Plan)
+	}
+	if subscription.Periods > 0 {
+		response.PeriodStartDate = h.jalaliConverter.FormatJalaliDate(subscription.PeriodStart)
+		response.PeriodEndDate = h.jalaliConverter.FormatJalaliDate(subscription.PeriodEnd)
+	}
+	if subscription.RenewsAt != nil {
+		response.NextAttemptDate = h.jalaliConverter.FormatJalaliDate(*subscription.RenewsAt)
+	}
+	if subscription.EndedAt != nil {
+		response.EndedDate = h.jalaliConverter.FormatJalaliDate(*subscription.EndedAt)
+	}
+
+	return response
+}
+
+func convertSubscriptionPlanToProto(plan *models.SubscriptionPlan) *pb.SubscriptionPlan {
+	return &pb.SubscriptionPlan{
+		Id:           plan.ID,
+		Slug:         plan.Slug,
+		Name:         plan.Name,
+		Asset:        plan.Asset,
+		Price:        plan.Price.String(),
+		PeriodDays:   plan.PeriodDays,
+		Entitlements: plan.Entitlements,
+	}
+}
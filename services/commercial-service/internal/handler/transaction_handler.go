@@ -2,7 +2,7 @@ package handler
 
 import (
 	"context"
-	"fmt"
+	"errors"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -12,6 +12,7 @@ import (
 	"metargb/commercial-service/internal/models"
 	"metargb/commercial-service/internal/service"
 	pb "metargb/shared/pb/commercial"
+	"metargb/shared/pkg/money"
 )
 
 type TransactionHandler struct {
@@ -33,44 +34,57 @@ func RegisterTransactionHandler(grpcServer *grpc.Server, transactionService serv
 }
 
 func (h *TransactionHandler) ListTransactions(ctx context.Context, req *pb.ListTransactionsRequest) (*pb.ListTransactionsResponse, error) {
-	filters := make(map[string]interface{})
-	if req.Asset != "" {
-		filters["asset"] = req.Asset
-	}
-	if req.Action != "" {
-		filters["action"] = req.Action
-	}
-	if req.PerPage > 0 {
-		filters["limit"] = int(req.PerPage)
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
 	}
 
-	transactions, err := h.transactionService.ListTransactions(ctx, req.UserId, filters)
+	transactions, page, nextCursor, err := h.transactionService.ListTransactions(ctx, req.UserId, service.ListTransactionsInput{
+		Asset:     req.Asset,
+		Action:    req.Action,
+		Type:      req.Type,
+		Statuses:  req.Status,
+		Search:    req.Search,
+		MinAmount: req.MinAmount,
+		MaxAmount: req.MaxAmount,
+		FromDate:  req.StartDateTime,
+		ToDate:    req.EndDateTime,
+		Cursor:    req.Cursor,
+		Page:      int(req.Page),
+		PerPage:   int(req.PerPage),
+	})
 	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidOrderDate),
+			errors.Is(err, service.ErrInvalidOrderDateRange),
+			errors.Is(err, service.ErrInvalidTransactionType),
+			errors.Is(err, service.ErrInvalidTransactionAction),
+			errors.Is(err, service.ErrInvalidAmountRange),
+			errors.Is(err, service.ErrInvalidTransactionCursor):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to list transactions: %v", err)
 	}
 
-	var resources []*pb.TransactionResource
+	resources := make([]*pb.TransactionResource, 0, len(transactions))
 	for _, t := range transactions {
-		// Parse amount string to float64
-		var amount float64
-		fmt.Sscanf(t.Amount, "%f", &amount)
-
 		resources = append(resources, &pb.TransactionResource{
-			Id:     t.ID,
-			Type:   t.Type,
-			Asset:  t.Asset,
-			Amount: amount,
-			Action: t.Action,
-			Status: t.Status,
-			Date:   t.Date, // Already in Jalali format
-			Time:   t.Time, // Already in Jalali format
+			Id:            t.ID,
+			Type:          t.Type,
+			Asset:         t.Asset,
+			Amount:        money.Float(money.ParseOrZero(t.Amount)),
+			AmountDecimal: t.Amount, // Already formatted exactly
+			Action:        t.Action,
+			Status:        t.Status,
+			Date:          t.Date, // Already in Jalali format
+			Time:          t.Time, // Already in Jalali format
 		})
 	}
 
 	return &pb.ListTransactionsResponse{
 		Transactions: resources,
-		CurrentPage:  req.Page,
-		HasMorePages: len(resources) >= int(req.PerPage),
+		CurrentPage:  int32(page),
+		HasMorePages: nextCursor != "",
+		NextCursor:   nextCursor,
 	}, nil
 }
 
@@ -84,14 +98,15 @@ func (h *TransactionHandler) GetLatestTransaction(ctx context.Context, req *pb.G
 
 	if transaction != nil {
 		response.LatestTransaction = &pb.Transaction{
-			Id:        transaction.ID,
-			UserId:    transaction.UserID,
-			Asset:     transaction.Asset,
-			Amount:    transaction.Amount,
-			Action:    transaction.Action,
-			Status:    transaction.Status,
-			CreatedAt: timestamppb.New(transaction.CreatedAt),
-			UpdatedAt: timestamppb.New(transaction.UpdatedAt),
+			Id:            transaction.ID,
+			UserId:        transaction.UserID,
+			Asset:         transaction.Asset,
+			Amount:        money.Float(transaction.Amount),
+			AmountDecimal: money.Format(transaction.Amount),
+			Action:        transaction.Action,
+			Status:        transaction.Status,
+			CreatedAt:     timestamppb.New(transaction.CreatedAt),
+			UpdatedAt:     timestamppb.New(transaction.UpdatedAt),
 		}
 
 		if transaction.Token != nil {
@@ -112,10 +127,15 @@ func (h *TransactionHandler) GetLatestTransaction(ctx context.Context, req *pb.G
 }
 
 func (h *TransactionHandler) CreateTransaction(ctx context.Context, req *pb.CreateTransactionRequest) (*pb.Transaction, error) {
+	amount, err := requestAmount(req.AmountDecimal, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
 	transaction := &models.Transaction{
 		UserID: req.UserId,
 		Asset:  req.Asset,
-		Amount: req.Amount,
+		Amount: amount,
 		Action: req.Action,
 		Status: req.Status,
 	}
@@ -127,19 +147,20 @@ func (h *TransactionHandler) CreateTransaction(ctx context.Context, req *pb.Crea
 		transaction.PayableID = &req.PayableId
 	}
 
-	err := h.transactionService.CreateTransaction(ctx, transaction)
+	err = h.transactionService.CreateTransaction(ctx, transaction)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create transaction: %v", err)
 	}
 
 	return &pb.Transaction{
-		Id:        transaction.ID,
-		UserId:    transaction.UserID,
-		Asset:     transaction.Asset,
-		Amount:    transaction.Amount,
-		Action:    transaction.Action,
-		Status:    transaction.Status,
-		CreatedAt: timestamppb.New(transaction.CreatedAt),
-		UpdatedAt: timestamppb.New(transaction.UpdatedAt),
+		Id:            transaction.ID,
+		UserId:        transaction.UserID,
+		Asset:         transaction.Asset,
+		Amount:        money.Float(transaction.Amount),
+		AmountDecimal: money.Format(transaction.Amount),
+		Action:        transaction.Action,
+		Status:        transaction.Status,
+		CreatedAt:     timestamppb.New(transaction.CreatedAt),
+		UpdatedAt:     timestamppb.New(transaction.UpdatedAt),
 	}, nil
 }
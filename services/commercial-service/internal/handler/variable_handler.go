@@ -0,0 +1,271 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+	"metargb/commercial-service/internal/service"
+	pb "metargb/shared/pb/commercial"
+)
+
+// maxVariableKeys bounds a single GetVariables request
+const maxVariableKeys = 100
+
+type VariableHandler struct {
+	pb.UnimplementedVariableServiceServer
+	variableService    service.VariableService
+	rateHistoryService service.RateHistoryService
+	jalaliConverter    service.JalaliConverter
+}
+
+func NewVariableHandler(variableService service.VariableService, rateHistoryService service.RateHistoryService, jalaliConverter service.JalaliConverter) *VariableHandler {
+	return &VariableHandler{
+		variableService:    variableService,
+		rateHistoryService: rateHistoryService,
+		jalaliConverter:    jalaliConverter,
+	}
+}
+
+func RegisterVariableHandler(grpcServer *grpc.Server, variableService service.VariableService, rateHistoryService service.RateHistoryService, jalaliConverter service.JalaliConverter) {
+	handler := NewVariableHandler(variableService, rateHistoryService, jalaliConverter)
+	pb.RegisterVariableServiceServer(grpcServer, handler)
+}
+
+func (h *VariableHandler) GetVariables(ctx context.Context, req *pb.GetVariablesRequest) (*pb.GetVariablesResponse, error) {
+	if len(req.Keys) > maxVariableKeys {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d keys may be requested", maxVariableKeys)
+	}
+
+	values, err := h.variableService.GetVariables(ctx, req.Keys)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get variables: %v", err)
+	}
+
+	return &pb.GetVariablesResponse{Values: values}, nil
+}
+
+func (h *VariableHandler) ListVariables(ctx context.Context, req *pb.ListVariablesRequest) (*pb.ListVariablesResponse, error) {
+	adminID, err := adjustmentAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := h.variableService.ListVariables(ctx, adminID)
+	if err != nil {
+		return nil, mapVariableError(err)
+	}
+
+	response := &pb.ListVariablesResponse{
+		Variables: make([]*pb.Variable, len(values)),
+	}
+	for i, value := range values {
+		response.Variables[i] = h.convertVariableToProto(value)
+	}
+	return response, nil
+}
+
+func (h *VariableHandler) GetVariable(ctx context.Context, req *pb.GetVariableRequest) (*pb.Variable, error) {
+	adminID, err := adjustmentAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := h.variableService.GetVariable(ctx, adminID, req.Key)
+	if err != nil {
+		return nil, mapVariableError(err)
+	}
+	return h.convertVariableToProto(value), nil
+}
+
+func (h *VariableHandler) SetVariable(ctx context.Context, req *pb.SetVariableRequest) (*pb.Variable, error) {
+	// Changes are recorded under a person, like adjustment batches
+	adminID, err := adjustmentAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := h.variableService.SetVariable(ctx, adminID, req.Key, req.Value, req.Note)
+	if err != nil {
+		return nil, mapVariableError(err)
+	}
+	return h.convertVariableToProto(value), nil
+}
+
+func (h *VariableHandler) ListVariableChanges(ctx context.Context, req *pb.ListVariableChangesRequest) (*pb.ListVariableChangesResponse, error) {
+	adminID, err := adjustmentAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := h.variableService.ListChanges(ctx, adminID, req.Key, int(req.Limit))
+	if err != nil {
+		return nil, mapVariableError(err)
+	}
+
+	response := &pb.ListVariableChangesResponse{
+		Changes: make([]*pb.VariableChange, len(changes)),
+	}
+	for i, change := range changes {
+		response.Changes[i] = &pb.VariableChange{
+			Id:            change.ID,
+			Key:           change.Key,
+			PreviousValue: change.PreviousValue.String(),
+			CurrentValue:  change.CurrentValue.String(),
+			ChangerId:     change.ChangerID,
+			ChangerName:   change.ChangerName,
+			Note:          change.Note,
+			Date:          h.jalaliConverter.FormatJalaliDate(change.CreatedAt),
+			Time:          h.jalaliConverter.FormatJalaliTime(change.CreatedAt),
+		}
+	}
+	return response, nil
+}
+
+func (h *VariableHandler) ScheduleVariableChange(ctx context.Context, req *pb.ScheduleVariableChangeRequest) (*pb.ScheduledVariableChange, error) {
+	adminID, err := adjustmentAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	effectiveAt, err := time.Parse(time.RFC3339, req.EffectiveAt)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "effective_at must be an RFC3339 time")
+	}
+
+	change, err := h.variableService.ScheduleChange(ctx, adminID, req.Key, req.Value, req.Note, effectiveAt)
+	if err != nil {
+		return nil, mapVariableError(err)
+	}
+	return h.convertScheduledChangeToProto(change), nil
+}
+
+func (h *VariableHandler) ListScheduledVariableChanges(ctx context.Context, req *pb.ListScheduledVariableChangesRequest) (*pb.ListScheduledVariableChangesResponse, error) {
+	adminID, err := adjustmentAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := h.variableService.ListScheduledChanges(ctx, adminID, req.Key)
+	if err != nil {
+		return nil, mapVariableError(err)
+	}
+
+	response := &pb.ListScheduledVariableChangesResponse{
+		Changes: make([]*pb.ScheduledVariableChange, len(changes)),
+	}
+	for i, change := range changes {
+		response.Changes[i] = h.convertScheduledChangeToProto(change)
+	}
+	return response, nil
+}
+
+func (h *VariableHandler) CancelScheduledVariableChange(ctx context.Context, req *pb.CancelScheduledVariableChangeRequest) (*pb.ScheduledVariableChange, error) {
+	adminID, err := adjustmentAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	change, err := h.variableService.CancelScheduledChange(ctx, adminID, req.Id)
+	if err != nil {
+		return nil, mapVariableError(err)
+	}
+	return h.convertScheduledChangeToProto(change), nil
+}
+
+// DisplayRates handles GET /api/rates
+func (h *VariableHandler) DisplayRates(ctx context.Context, req *pb.DisplayRatesRequest) (*pb.DisplayRatesResponse, error) {
+	now := time.Now()
+	rates, err := h.rateHistoryService.DisplayRates(ctx, now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rates: %v", err)
+	}
+
+	response := &pb.DisplayRatesResponse{
+		Rates: make([]*pb.DisplayRate, len(rates)),
+		Date:  h.jalaliConverter.FormatJalaliDate(now),
+		Time:  h.jalaliConverter.FormatJalaliTime(now),
+	}
+	for i, rate := range rates {
+		response.Rates[i] = &pb.DisplayRate{
+			Asset:            rate.Asset,
+			Irr:              rate.IRR.String(),
+			Psc:              nullDecimalString(rate.PSC),
+			PreviousIrr:      nullDecimalString(rate.PreviousIRR),
+			IrrChange:        nullDecimalString(rate.IRRChange),
+			IrrChangePercent: nullDecimalString(rate.IRRChangePercent),
+			PreviousPsc:      nullDecimalString(rate.PreviousPSC),
+			PscChangePercent: nullDecimalString(rate.PSCChangePercent),
+		}
+	}
+	return response, nil
+}
+
+func mapVariableError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrVariableNotAdmin):
+		return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case errors.Is(err, service.ErrVariableUnknown):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrVariableInvalidValue), errors.Is(err, service.ErrVariableNotScheduled):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	case errors.Is(err, repository.ErrScheduledChangeNotPending):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	}
+	return status.Errorf(codes.Internal, "operation failed: %v", err)
+}
+
+func (h *VariableHandler) convertVariableToProto(value *models.VariableValue) *pb.Variable {
+	def := value.Definition
+	variable := &pb.Variable{
+		Key:         def.Key,
+		Type:        def.Type,
+		Description: def.Description,
+	}
+	if def.Min.Valid {
+		variable.Min = def.Min.Decimal.String()
+	}
+	if def.Max.Valid {
+		variable.Max = def.Max.Decimal.String()
+	}
+	if value.IsSet {
+		variable.Value = value.Value.String()
+		if !value.UpdatedAt.IsZero() {
+			variable.Date = h.jalaliConverter.FormatJalaliDate(value.UpdatedAt)
+			variable.Time = h.jalaliConverter.FormatJalaliTime(value.UpdatedAt)
+		}
+	}
+	return variable
+}
+
+func (h *VariableHandler) convertScheduledChangeToProto(change *models.ScheduledVariableChange) *pb.ScheduledVariableChange {
+	return &pb.ScheduledVariableChange{
+		Id:          change.ID,
+		Key:         change.Key,
+		Value:       change.Value.String(),
+		Note:        change.Note,
+		EffectiveAt: change.EffectiveAt.Format(time.RFC3339),
+		Status:      change.Status,
+		CreatedBy:   change.CreatedBy,
+		Date:        h.jalaliConverter.FormatJalaliDate(change.EffectiveAt),
+		Time:        h.jalaliConverter.FormatJalaliTime(change.EffectiveAt),
+	}
+}
+
+// nullDecimalString formats value, or returns "" when it is not valid
+func nullDecimalString(value decimal.NullDecimal) string {
+	if !value.Valid {
+		return ""
+	}
+	return value.Decimal.String()
+}
@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+	"metargb/commercial-service/internal/service"
+	pb "metargb/shared/pb/commercial"
+	"metargb/shared/pkg/auth"
+)
+
+type WalletAdjustmentHandler struct {
+	pb.UnimplementedWalletAdjustmentServiceServer
+	adjustmentService service.WalletAdjustmentService
+	jalaliConverter   service.JalaliConverter
+}
+
+func NewWalletAdjustmentHandler(adjustmentService service.WalletAdjustmentService, jalaliConverter service.JalaliConverter) *WalletAdjustmentHandler {
+	return &WalletAdjustmentHandler{
+		adjustmentService: adjustmentService,
+		jalaliConverter:   jalaliConverter,
+	}
+}
+
+func RegisterWalletAdjustmentHandler(grpcServer *grpc.Server, adjustmentService service.WalletAdjustmentService, jalaliConverter service.JalaliConverter) {
+	handler := NewWalletAdjustmentHandler(adjustmentService, jalaliConverter)
+	pb.RegisterWalletAdjustmentServiceServer(grpcServer, handler)
+}
+
+func (h *WalletAdjustmentHandler) CreateAdjustmentBatch(ctx context.Context, req *pb.CreateAdjustmentBatchRequest) (*pb.AdjustmentBatch, error) {
+	adminID, err := adjustmentAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := h.adjustmentService.CreateBatch(ctx, adminID, req.Reason, req.Entries)
+	if err != nil {
+		return nil, mapAdjustmentError(err)
+	}
+
+	return h.convertBatchToProto(batch), nil
+}
+
+func (h *WalletAdjustmentHandler) ListAdjustmentBatches(ctx context.Context, req *pb.ListAdjustmentBatchesRequest) (*pb.ListAdjustmentBatchesResponse, error) {
+	adminID, err := adjustmentAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	batches, err := h.adjustmentService.ListBatches(ctx, adminID, req.Status)
+	if err != nil {
+		return nil, mapAdjustmentError(err)
+	}
+
+	response := &pb.ListAdjustmentBatchesResponse{
+		Batches: make([]*pb.AdjustmentBatch, len(batches)),
+	}
+	for i, batch := range batches {
+		response.Batches[i] = h.convertBatchToProto(batch)
+	}
+
+	return response, nil
+}
+
+func (h *WalletAdjustmentHandler) GetAdjustmentBatch(ctx context.Context, req *pb.GetAdjustmentBatchRequest) (*pb.AdjustmentBatch, error) {
+	adminID, err := adjustmentAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.BatchId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "batch_id is required")
+	}
+
+	batch, err := h.adjustmentService.GetBatch(ctx, adminID, req.BatchId)
+	if err != nil {
+		return nil, mapAdjustmentError(err)
+	}
+
+	return h.convertBatchToProto(batch), nil
+}
+
+func (h *WalletAdjustmentHandler) ApproveAdjustmentBatch(ctx context.Context, req *pb.ApproveAdjustmentBatchRequest) (*pb.AdjustmentBatch, error) {
+	adminID, err := adjustmentAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.BatchId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "batch_id is required")
+	}
+
+	batch, err := h.adjustmentService.ApproveBatch(ctx, adminID, req.BatchId)
+	if err != nil {
+		return nil, mapAdjustmentError(err)
+	}
+
+	return h.convertBatchToProto(batch), nil
+}
+
+func (h *WalletAdjustmentHandler) RejectAdjustmentBatch(ctx context.Context, req *pb.RejectAdjustmentBatchRequest) (*pb.AdjustmentBatch, error) {
+	adminID, err := adjustmentAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.BatchId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "batch_id is required")
+	}
+
+	batch, err := h.adjustmentService.RejectBatch(ctx, adminID, req.BatchId, req.Note)
+	if err != nil {
+		return nil, mapAdjustmentError(err)
+	}
+
+	return h.convertBatchToProto(batch), nil
+}
+
+// adjustmentAdminID returns the authenticated caller. API keys cannot manage
+// adjustment batches since approval must come from a person.
+func adjustmentAdminID(ctx context.Context) (uint64, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if user.IsAPIKey() {
+		return 0, status.Error(codes.PermissionDenied, service.ErrAdjustmentNotAdmin.Error())
+	}
+	return user.UserID, nil
+}
+
+func mapAdjustmentError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrAdjustmentNotAdmin), errors.Is(err, service.ErrAdjustmentSelfApproval):
+		return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case errors.Is(err, service.ErrAdjustmentBatchNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrAdjustmentReasonRequired),
+		errors.Is(err, service.ErrAdjustmentNoEntries),
+		errors.Is(err, service.ErrAdjustmentTooManyEntries),
+		errors.Is(err, service.ErrAdjustmentInvalidEntry),
+		errors.Is(err, service.ErrAdjustmentInvalidStatus):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	case errors.Is(err, repository.ErrAdjustmentBatchNotPending),
+		errors.Is(err, repository.ErrAdjustmentWalletNotFound),
+		errors.Is(err, repository.ErrAdjustmentInsufficientBalance):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	}
+	return status.Errorf(codes.Internal, "operation failed: %v", err)
+}
+
+func (h *WalletAdjustmentHandler) convertBatchToProto(batch *models.AdjustmentBatch) *pb.AdjustmentBatch {
+	response := &pb.AdjustmentBatch{
+		Id:         batch.ID,
+		Reason:     batch.Reason,
+		Status:     batch.Status,
+		CreatedBy:  batch.CreatedBy,
+		EntryCount: batch.EntryCount,
+		Date:       h.jalaliConverter.FormatJalaliDate(batch.CreatedAt),
+		Time:       h.jalaliConverter.FormatJalaliTime(batch.CreatedAt),
+	}
+	if batch.DecidedBy != nil {
+		response.DecidedBy = *batch.DecidedBy
+	}
+	if batch.DecisionNote != nil {
+		response.DecisionNote = *batch.DecisionNote
+	}
+	if batch.DecidedAt != nil {
+		response.DecidedDate = h.jalaliConverter.FormatJalaliDate(*batch.DecidedAt)
+	}
+
+	for _, entry := range batch.Entries {
+		protoEntry := &pb.AdjustmentEntry{
+			UserId: entry.UserID,
+			Asset:  entry.Asset,
+			Amount: entry.Amount.String(),
+		}
+		if entry.TransactionID != nil {
+			protoEntry.TransactionId = *entry.TransactionID
+		}
+		response.Entries = append(response.Entries, protoEntry)
+	}
+
+	return response
+}
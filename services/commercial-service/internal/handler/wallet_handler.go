@@ -2,8 +2,8 @@ package handler
 
 import (
 	"context"
-	"strconv"
 
+	"github.com/shopspring/decimal"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -11,6 +11,7 @@ import (
 
 	"metargb/commercial-service/internal/service"
 	pb "metargb/shared/pb/commercial"
+	"metargb/shared/pkg/money"
 )
 
 type WalletHandler struct {
@@ -29,20 +30,18 @@ func RegisterWalletHandler(grpcServer *grpc.Server, walletService service.Wallet
 	pb.RegisterWalletServiceServer(grpcServer, handler)
 }
 
+// walletEffect parses the stored effect as a decimal and converts it only for
+// the legacy double field of the response
+func walletEffect(wallet map[string]string) float64 {
+	return money.Float(money.ParseOrZero(wallet["effect"]))
+}
+
 func (h *WalletHandler) GetWallet(ctx context.Context, req *pb.GetWalletRequest) (*pb.WalletResponse, error) {
 	wallet, err := h.walletService.GetWallet(ctx, req.UserId)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get wallet: %v", err)
 	}
 
-	// Parse effect from string to float64
-	effect := 0.0
-	if effectStr, ok := wallet["effect"]; ok && effectStr != "" {
-		if parsedEffect, err := strconv.ParseFloat(effectStr, 64); err == nil {
-			effect = parsedEffect
-		}
-	}
-
 	return &pb.WalletResponse{
 		Psc:          wallet["psc"],
 		Irr:          wallet["irr"],
@@ -50,12 +49,21 @@ func (h *WalletHandler) GetWallet(ctx context.Context, req *pb.GetWalletRequest)
 		Blue:         wallet["blue"],
 		Yellow:       wallet["yellow"],
 		Satisfaction: wallet["satisfaction"],
-		Effect:       effect,
+		Effect:       walletEffect(wallet),
 	}, nil
 }
 
 func (h *WalletHandler) DeductBalance(ctx context.Context, req *pb.DeductBalanceRequest) (*pb.DeductBalanceResponse, error) {
-	wallet, err := h.walletService.DeductBalance(ctx, req.UserId, req.Asset, req.Amount)
+	deduct := h.walletService.DeductBalance
+	if req.Reclaim {
+		deduct = h.walletService.ReclaimBalance
+	}
+	amount, err := requestAmount(req.AmountDecimal, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	wallet, err := deduct(ctx, req.UserId, req.Asset, amount)
 	if err != nil {
 		return &pb.DeductBalanceResponse{
 			Success: false,
@@ -63,14 +71,6 @@ func (h *WalletHandler) DeductBalance(ctx context.Context, req *pb.DeductBalance
 		}, nil
 	}
 
-	// Parse effect from string to float64
-	effect := 0.0
-	if effectStr, ok := wallet["effect"]; ok && effectStr != "" {
-		if parsedEffect, err := strconv.ParseFloat(effectStr, 64); err == nil {
-			effect = parsedEffect
-		}
-	}
-
 	return &pb.DeductBalanceResponse{
 		Success: true,
 		Message: "Balance deducted successfully",
@@ -81,13 +81,18 @@ func (h *WalletHandler) DeductBalance(ctx context.Context, req *pb.DeductBalance
 			Blue:         wallet["blue"],
 			Yellow:       wallet["yellow"],
 			Satisfaction: wallet["satisfaction"],
-			Effect:       effect,
+			Effect:       walletEffect(wallet),
 		},
 	}, nil
 }
 
 func (h *WalletHandler) AddBalance(ctx context.Context, req *pb.AddBalanceRequest) (*pb.AddBalanceResponse, error) {
-	wallet, err := h.walletService.AddBalance(ctx, req.UserId, req.Asset, req.Amount)
+	amount, err := requestAmount(req.AmountDecimal, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	wallet, err := h.walletService.AddBalance(ctx, req.UserId, req.Asset, amount)
 	if err != nil {
 		return &pb.AddBalanceResponse{
 			Success: false,
@@ -95,14 +100,6 @@ func (h *WalletHandler) AddBalance(ctx context.Context, req *pb.AddBalanceReques
 		}, nil
 	}
 
-	// Parse effect from string to float64
-	effect := 0.0
-	if effectStr, ok := wallet["effect"]; ok && effectStr != "" {
-		if parsedEffect, err := strconv.ParseFloat(effectStr, 64); err == nil {
-			effect = parsedEffect
-		}
-	}
-
 	return &pb.AddBalanceResponse{
 		Success: true,
 		Message: "Balance added successfully",
@@ -113,13 +110,18 @@ func (h *WalletHandler) AddBalance(ctx context.Context, req *pb.AddBalanceReques
 			Blue:         wallet["blue"],
 			Yellow:       wallet["yellow"],
 			Satisfaction: wallet["satisfaction"],
-			Effect:       effect,
+			Effect:       walletEffect(wallet),
 		},
 	}, nil
 }
 
 func (h *WalletHandler) LockBalance(ctx context.Context, req *pb.LockBalanceRequest) (*emptypb.Empty, error) {
-	err := h.walletService.LockBalance(ctx, req.UserId, req.Asset, req.Amount, req.Reason)
+	amount, err := requestAmount(req.AmountDecimal, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.walletService.LockBalance(ctx, req.UserId, req.Asset, amount, req.Reason)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to lock balance: %v", err)
 	}
@@ -128,10 +130,25 @@ func (h *WalletHandler) LockBalance(ctx context.Context, req *pb.LockBalanceRequ
 }
 
 func (h *WalletHandler) UnlockBalance(ctx context.Context, req *pb.UnlockBalanceRequest) (*emptypb.Empty, error) {
-	err := h.walletService.UnlockBalance(ctx, req.UserId, req.Asset, req.Amount)
+	amount, err := requestAmount(req.AmountDecimal, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.walletService.UnlockBalance(ctx, req.UserId, req.Asset, amount)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to unlock balance: %v", err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
+
+// requestAmount reads the amount of a request. Callers that send the exact
+// decimal string are not rounded; older callers still send a double.
+func requestAmount(exact string, legacy float64) (decimal.Decimal, error) {
+	amount, err := money.FromRequest(exact, legacy)
+	if err != nil {
+		return decimal.Zero, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return amount, nil
+}
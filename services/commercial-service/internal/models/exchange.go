@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ConversionPayableType is stored as payable_type on both transactions of a
+// wallet conversion
+const ConversionPayableType = "App\\Models\\WalletConversion"
+
+// ExchangeRate is the admin-set rate and fee for converting one color asset
+// into another. Each direction of a pair has its own rate.
+type ExchangeRate struct {
+	ID         uint64          `db:"id"`
+	FromAsset  string          `db:"from_asset"`
+	ToAsset    string          `db:"to_asset"`
+	Rate       decimal.Decimal `db:"rate"`        // Units of ToAsset per unit of FromAsset
+	FeePercent decimal.Decimal `db:"fee_percent"` // Taken from the converted amount
+	Enabled    bool            `db:"enabled"`
+	UpdatedBy  uint64          `db:"updated_by"`
+	CreatedAt  time.Time       `db:"created_at"`
+	UpdatedAt  time.Time       `db:"updated_at"`
+}
+
+// Conversion is a completed exchange of Amount of FromAsset for Received of
+// ToAsset in a user's wallet
+type Conversion struct {
+	ID                    uint64          `db:"id"`
+	UserID                uint64          `db:"user_id"`
+	FromAsset             string          `db:"from_asset"`
+	ToAsset               string          `db:"to_asset"`
+	Amount                decimal.Decimal `db:"amount"`
+	Received              decimal.Decimal `db:"received"`
+	Fee                   decimal.Decimal `db:"fee"`
+	Rate                  decimal.Decimal `db:"rate"`
+	FeePercent            decimal.Decimal `db:"fee_percent"`
+	WithdrawTransactionID string          `db:"-"`
+	DepositTransactionID  string          `db:"-"`
+	CreatedAt             time.Time       `db:"created_at"`
+}
+
+// Quote returns what converting amount pays out and the fee kept from it.
+// Color wallet columns hold 10 decimal places, so the payout is truncated to
+// that precision and the remainder counts towards the fee.
+func (r *ExchangeRate) Quote(amount decimal.Decimal) (received, fee decimal.Decimal) {
+	gross := amount.Mul(r.Rate)
+	keep := decimal.NewFromInt(100).Sub(r.FeePercent).Div(decimal.NewFromInt(100))
+	received = gross.Mul(keep).Truncate(10)
+	return received, gross.Sub(received)
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Fraud check decisions, from least to most severe
+const (
+	FraudAllow  = "allow"
+	FraudReview = "review"
+	FraudDeny   = "deny"
+)
+
+// What a fraud check was run for
+const (
+	FraudKindPayment  = "payment"  // Buying an asset through the payment gateway
+	FraudKindTransfer = "transfer" // A large deduction from the wallet
+)
+
+// Rules that raised a check above allow
+const (
+	FraudReasonVelocity      = "velocity"
+	FraudReasonLoginMismatch = "login_mismatch"
+	FraudReasonBlockedCard   = "blocked_card"
+)
+
+// Review states of checks decided as review
+const (
+	FraudReviewPending  = "pending"
+	FraudReviewApproved = "approved"
+	FraudReviewRejected = "rejected"
+)
+
+// OrderStatusFraudDenied is stored on orders whose payment was refused
+// because the card is blocklisted. The payment is not verified, so the bank
+// returns the money.
+const OrderStatusFraudDenied int32 = -2
+
+// FraudCheck records the decision of the fraud rules on a payment or transfer
+type FraudCheck struct {
+	ID           uint64          `db:"id"`
+	UserID       uint64          `db:"user_id"`
+	Kind         string          `db:"kind"`
+	Asset        string          `db:"asset"`
+	Amount       decimal.Decimal `db:"amount"`
+	Decision     string          `db:"decision"`
+	Reasons      []string        `db:"reasons"` // Stored comma separated
+	Reference    string          `db:"reference"`
+	IP           string          `db:"ip"`
+	Device       string          `db:"device"`
+	CardPan      string          `db:"card_pan"`
+	ReviewStatus string          `db:"review_status"` // Empty unless Decision is review
+	ReviewedBy   uint64          `db:"reviewed_by"`
+	ReviewNote   string          `db:"review_note"`
+	ReviewedAt   *time.Time      `db:"reviewed_at"`
+	ConsumedAt   *time.Time      `db:"consumed_at"` // When an approved check let a retry through
+	CreatedAt    time.Time       `db:"created_at"`
+}
+
+// BlockedCard is a card number pattern whose payments are denied
+type BlockedCard struct {
+	ID        uint64    `db:"id"`
+	Pattern   string    `db:"pattern"`
+	Reason    string    `db:"reason"`
+	CreatedBy uint64    `db:"created_by"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// LoginSighting is an IP and device a user recently logged in from
+type LoginSighting struct {
+	UserID     uint64    `db:"user_id"`
+	IP         string    `db:"ip"`
+	Device     string    `db:"device"`
+	LastSeenAt time.Time `db:"last_seen_at"`
+}
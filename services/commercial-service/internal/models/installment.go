@@ -0,0 +1,81 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/shared/pkg/money"
+)
+
+// Installment plan statuses
+const (
+	InstallmentPlanActive    = "active"
+	InstallmentPlanCompleted = "completed"
+	InstallmentPlanDefaulted = "defaulted"
+)
+
+// Installment statuses
+const (
+	InstallmentPending = "pending"
+	InstallmentPaid    = "paid"
+)
+
+// InstallmentPlanPayableType is stored as payable_type on the transactions
+// created for installment charges, payouts and refunds
+const InstallmentPlanPayableType = "App\\Models\\InstallmentPlan"
+
+// InstallmentPlan is a feature purchase paid over scheduled installments.
+// Collected installments are held by the plan until the final payment, when
+// the seller and platform are paid, or a default, when the buyer is refunded.
+type InstallmentPlan struct {
+	ID               uint64          `db:"id"`
+	FeatureID        uint64          `db:"feature_id"`
+	BuyerID          uint64          `db:"buyer_id"`
+	SellerID         uint64          `db:"seller_id"`
+	PlatformUserID   uint64          `db:"platform_user_id"`
+	Status           string          `db:"status"`
+	TotalPSC         decimal.Decimal `db:"total_psc"`
+	TotalIRR         decimal.Decimal `db:"total_irr"`
+	SellerPaymentPSC decimal.Decimal `db:"seller_payment_psc"`
+	SellerPaymentIRR decimal.Decimal `db:"seller_payment_irr"`
+	PaidPSC          decimal.Decimal `db:"paid_psc"`
+	PaidIRR          decimal.Decimal `db:"paid_irr"`
+	RefundedPSC      decimal.Decimal `db:"refunded_psc"`
+	RefundedIRR      decimal.Decimal `db:"refunded_irr"`
+	PenaltyPercent   decimal.Decimal `db:"penalty_percent"`
+	TradeID          *uint64         `db:"trade_id"`
+	ClosedAt         *time.Time      `db:"closed_at"`
+	CreatedAt        time.Time       `db:"created_at"`
+	UpdatedAt        time.Time       `db:"updated_at"`
+	Installments     []*Installment  `db:"-"`
+}
+
+// NextPending returns the earliest unpaid installment, nil once all are paid
+func (p *InstallmentPlan) NextPending() *Installment {
+	for _, installment := range p.Installments {
+		if installment.Status == InstallmentPending {
+			return installment
+		}
+	}
+	return nil
+}
+
+// Installment is a scheduled charge of a plan. Sequence 0 is the down
+// payment, charged when the plan is created.
+type Installment struct {
+	ID        uint64          `db:"id"`
+	PlanID    uint64          `db:"plan_id"`
+	Sequence  int32           `db:"sequence"`
+	AmountPSC decimal.Decimal `db:"amount_psc"`
+	AmountIRR decimal.Decimal `db:"amount_irr"`
+	Status    string          `db:"status"`
+	DueAt     time.Time       `db:"due_at"`
+	PaidAt    *time.Time      `db:"paid_at"`
+}
+
+// TruncateToWallet drops the digits a wallet column cannot hold: irr is
+// stored as an integer and other assets with 10 decimal places
+func TruncateToWallet(asset string, amount decimal.Decimal) decimal.Decimal {
+	return money.Truncate(asset, amount)
+}
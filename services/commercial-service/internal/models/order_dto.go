@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// OrderFilter narrows the order history query
+type OrderFilter struct {
+	Statuses []int32
+	Gateway  string     // payments.gateway of the order's payment
+	From     *time.Time // inclusive lower bound on created_at
+	To       *time.Time // exclusive upper bound on created_at
+	Page     int
+	PerPage  int
+}
+
+// OrderHistoryItem is an order joined with the payment that settled it, if any
+type OrderHistoryItem struct {
+	Order
+	Gateway *string
+	RefID   *string
+}
+
+// OrderDTO represents the formatted order history response
+type OrderDTO struct {
+	ID      uint64          `json:"id"`
+	Asset   string          `json:"asset"`
+	Amount  decimal.Decimal `json:"amount"`
+	Status  int32           `json:"status"`
+	Gateway string          `json:"gateway"` // empty until a payment is recorded
+	RefID   string          `json:"ref_id"`  // empty until a payment is recorded
+	Date    string          `json:"date"`    // Jalali format: Y/m/d
+	Time    string          `json:"time"`    // Jalali format: H:m:s
+}
+
+// Payment states announced while a user waits on the payment redirect
+const (
+	OrderPaymentPending = "pending"
+	OrderPaymentPaid    = "paid"
+	OrderPaymentFailed  = "failed"
+)
+
+// OrderStatusEvent announces a change of an order's payment state. The
+// WebSocket gateway forwards it to the order owner's sockets.
+type OrderStatusEvent struct {
+	OrderID uint64          `json:"order_id"`
+	UserID  uint64          `json:"user_id"`
+	Asset   string          `json:"asset"`
+	Amount  decimal.Decimal `json:"amount"`
+	Status  string          `json:"status"` // OrderPaymentPending, OrderPaymentPaid or OrderPaymentFailed
+	Code    int32           `json:"code"`   // orders.status, the gateway's status code
+	Message string          `json:"message,omitempty"`
+	RefID   int64           `json:"ref_id,omitempty"` // set once paid
+}
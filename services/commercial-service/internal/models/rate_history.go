@@ -0,0 +1,20 @@
+package models
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// DisplayRate is the current price of one unit of an asset with its change
+// over the trend window, shown in price tickers
+type DisplayRate struct {
+	Asset string
+	IRR   decimal.Decimal
+	PSC   decimal.NullDecimal // Not valid while the psc rate is unset
+
+	// Not valid while rate_history has no record from the start of the window
+	PreviousIRR      decimal.NullDecimal
+	IRRChange        decimal.NullDecimal
+	IRRChangePercent decimal.NullDecimal
+	PreviousPSC      decimal.NullDecimal
+	PSCChangePercent decimal.NullDecimal
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// SpendingAssets are the wallet assets spending limits apply to. Colors are
+// earned and spent in the game and are not limited.
+var SpendingAssets = []string{"psc", "irr"}
+
+// Sources of spending records
+const (
+	SpendingSourceWallet       = "wallet"
+	SpendingSourceInstallment  = "installment"
+	SpendingSourceSubscription = "subscription"
+)
+
+// Spending is limited over rolling windows ending now
+const (
+	SpendingDay   = 24 * time.Hour
+	SpendingMonth = 30 * 24 * time.Hour
+)
+
+// SpendingCap is the most a user may spend of one asset in a day and in a month
+type SpendingCap struct {
+	Daily   decimal.Decimal
+	Monthly decimal.Decimal
+}
+
+// SpendingLimits are the caps a guardian set for a user. Nil caps fall back
+// to the platform maximums.
+type SpendingLimits struct {
+	UserID     uint64           `db:"user_id"`
+	DailyPSC   *decimal.Decimal `db:"daily_psc"`
+	MonthlyPSC *decimal.Decimal `db:"monthly_psc"`
+	DailyIRR   *decimal.Decimal `db:"daily_irr"`
+	MonthlyIRR *decimal.Decimal `db:"monthly_irr"`
+	UpdatedBy  uint64           `db:"updated_by"`
+	CreatedAt  time.Time        `db:"created_at"`
+	UpdatedAt  time.Time        `db:"updated_at"`
+}
+
+// Caps returns the stored daily and monthly caps of an asset, nil where the
+// platform maximum applies
+func (l *SpendingLimits) Caps(asset string) (daily, monthly *decimal.Decimal) {
+	if l == nil {
+		return nil, nil
+	}
+	switch asset {
+	case "psc":
+		return l.DailyPSC, l.MonthlyPSC
+	case "irr":
+		return l.DailyIRR, l.MonthlyIRR
+	}
+	return nil, nil
+}
+
+// SpendingUsage is what a user spent of one asset in the current windows
+type SpendingUsage struct {
+	Day   decimal.Decimal // Last 24 hours
+	Month decimal.Decimal // Last 30 days
+}
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Subscription statuses. Pending subscriptions wait for their first charge,
+// past due ones for a renewal retry. Canceled and expired ones are ended.
+const (
+	SubscriptionPending  = "pending"
+	SubscriptionActive   = "active"
+	SubscriptionPastDue  = "past_due"
+	SubscriptionCanceled = "canceled"
+	SubscriptionExpired  = "expired"
+)
+
+// SubscriptionPayableType is stored as payable_type on the transactions
+// created for subscription charges
+const SubscriptionPayableType = "App\\Models\\Subscription"
+
+// SubscriptionPlan is a premium membership sold for a price per period
+type SubscriptionPlan struct {
+	ID           uint64          `db:"id"`
+	Slug         string          `db:"slug"`
+	Name         string          `db:"name"`
+	Asset        string          `db:"asset"`
+	Price        decimal.Decimal `db:"price"`
+	PeriodDays   int32           `db:"period_days"`
+	Entitlements []string        `db:"entitlements"`
+	Active       bool            `db:"active"`
+	CreatedAt    time.Time       `db:"created_at"`
+	UpdatedAt    time.Time       `db:"updated_at"`
+}
+
+// Period is how long one charge of the plan lasts
+func (p *SubscriptionPlan) Period() time.Duration {
+	return time.Duration(p.PeriodDays) * 24 * time.Hour
+}
+
+// Subscription is a user's membership of a plan. RenewsAt is when the worker
+// next charges it: the period end while active, the next retry while past
+// due, and when an unpaid pending subscription expires.
+type Subscription struct {
+	ID                uint64            `db:"id"`
+	UserID            uint64            `db:"user_id"`
+	PlanID            uint64            `db:"plan_id"`
+	Status            string            `db:"status"`
+	PeriodStart       time.Time         `db:"period_start"`
+	PeriodEnd         time.Time         `db:"period_end"`
+	CancelAtPeriodEnd bool              `db:"cancel_at_period_end"`
+	FailedAttempts    int32             `db:"failed_attempts"`
+	RenewsAt          *time.Time        `db:"renews_at"`
+	Periods           int32             `db:"periods"`
+	EndedAt           *time.Time        `db:"ended_at"`
+	CreatedAt         time.Time         `db:"created_at"`
+	UpdatedAt         time.Time         `db:"updated_at"`
+	Plan              *SubscriptionPlan `db:"-"`
+}
+
+// Live reports whether the subscription has not ended
+func (s *Subscription) Live() bool {
+	switch s.Status {
+	case SubscriptionPending, SubscriptionActive, SubscriptionPastDue:
+		return true
+	}
+	return false
+}
+
+// Entitled reports whether the subscription grants its plan's entitlements.
+// Past due subscriptions keep them while renewals are retried.
+func (s *Subscription) Entitled() bool {
+	return s.Status == SubscriptionActive || s.Status == SubscriptionPastDue
+}
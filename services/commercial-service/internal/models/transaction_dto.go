@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 // TransactionDTO represents the formatted transaction response
 // Matches Laravel's TransactionResource exactly
 type TransactionDTO struct {
@@ -12,3 +14,28 @@ type TransactionDTO struct {
 	Date   string `json:"date"`   // Jalali format: Y/m/d
 	Time   string `json:"time"`   // Jalali format: H:i:s
 }
+
+// TransactionFilter narrows the transaction history query
+type TransactionFilter struct {
+	Asset       string
+	Action      string
+	PayableType string // full morph class, e.g. App\Models\Trade
+	Statuses    []int32
+	Search      string     // transaction ID prefix
+	MinAmount   *float64   // inclusive
+	MaxAmount   *float64   // inclusive
+	From        *time.Time // inclusive lower bound on created_at
+	To          *time.Time // exclusive upper bound on created_at
+	// After continues from the last transaction of the previous page, Page is
+	// ignored when it is set
+	After   *TransactionCursor
+	Page    int
+	PerPage int
+}
+
+// TransactionCursor is the position of a transaction in the history, which is
+// ordered by created_at then id, newest first
+type TransactionCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Variable value types
+const (
+	VariableTypeRate    = "rate"    // Positive number, e.g. the IRR price of an asset
+	VariableTypePercent = "percent" // 0 to 100
+	VariableTypeAmount  = "amount"  // Non-negative number
+	VariableTypeInteger = "integer" // Whole number
+)
+
+// Tables variables are stored in
+const (
+	VariablesTable       = "variables"        // Keyed by key, owned by commercial-service
+	SystemVariablesTable = "system_variables" // Keyed by slug, shared
+)
+
+// Morph classes stored as changeable_type in variable_change_logs
+const (
+	VariableChangeableType       = "App\\Models\\Variable"
+	SystemVariableChangeableType = "App\\Models\\SystemVariable"
+)
+
+// VariableDefinition describes a variable admins may set and how its value is validated
+type VariableDefinition struct {
+	Key         string
+	Type        string
+	Table       string
+	Min         decimal.NullDecimal // Inclusive, unbounded if not valid
+	Max         decimal.NullDecimal
+	Description string
+}
+
+// VariableValue is the current value of a defined variable
+type VariableValue struct {
+	Definition VariableDefinition
+	ID         uint64 // Row id, 0 while unset
+	Value      decimal.Decimal
+	IsSet      bool
+	UpdatedAt  time.Time
+}
+
+// VariableChange is an entry of variable_change_logs
+type VariableChange struct {
+	ID            uint64          `db:"id"`
+	Key           string          `db:"-"`
+	PreviousValue decimal.Decimal `db:"previous_value"`
+	CurrentValue  decimal.Decimal `db:"current_value"`
+	ChangerID     uint64          `db:"changer_id"` // 0 for changes logged before the admin API
+	ChangerName   string          `db:"changer_name"`
+	Note          string          `db:"note"`
+	CreatedAt     time.Time       `db:"created_at"`
+}
+
+// Statuses of a scheduled variable change
+const (
+	ScheduledChangePending  = "pending"
+	ScheduledChangeApplied  = "applied"
+	ScheduledChangeCanceled = "canceled"
+)
+
+// ScheduledVariableChange is a variable value an admin set ahead of time, an
+// entry of scheduled_variable_changes
+type ScheduledVariableChange struct {
+	ID          uint64          `db:"id"`
+	Key         string          `db:"variable_key"`
+	Value       decimal.Decimal `db:"value"`
+	Note        string          `db:"note"`
+	EffectiveAt time.Time       `db:"effective_at"`
+	Status      string          `db:"status"`
+	CreatedBy   uint64          `db:"created_by"`
+	CreatedAt   time.Time       `db:"created_at"`
+}
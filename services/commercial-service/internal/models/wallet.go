@@ -21,40 +21,49 @@ type Wallet struct {
 }
 
 type Transaction struct {
-	ID          string    `db:"id"` // VARCHAR PK like TR-xxxxx
-	UserID      uint64    `db:"user_id"`
-	Asset       string    `db:"asset"`
-	Amount      float64   `db:"amount"`
-	Action      string    `db:"action"` // deposit, withdraw
-	Status      int32     `db:"status"`
-	Token       *int64    `db:"token"`
-	RefID       *int64    `db:"ref_id"`
-	PayableType *string   `db:"payable_type"`
-	PayableID   *uint64   `db:"payable_id"`
-	CreatedAt   time.Time `db:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at"`
+	ID          string          `db:"id"` // VARCHAR PK like TR-xxxxx
+	UserID      uint64          `db:"user_id"`
+	Asset       string          `db:"asset"`
+	Amount      decimal.Decimal `db:"amount"`
+	Action      string          `db:"action"` // deposit, withdraw
+	Status      int32           `db:"status"`
+	Token       *int64          `db:"token"`
+	RefID       *int64          `db:"ref_id"`
+	PayableType *string         `db:"payable_type"`
+	PayableID   *uint64         `db:"payable_id"`
+	CreatedAt   time.Time       `db:"created_at"`
+	UpdatedAt   time.Time       `db:"updated_at"`
 }
 
 type Order struct {
-	ID        uint64    `db:"id"`
-	UserID    uint64    `db:"user_id"`
-	Asset     string    `db:"asset"`
-	Amount    float64   `db:"amount"`
-	Status    int32     `db:"status"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID        uint64          `db:"id"`
+	UserID    uint64          `db:"user_id"`
+	Asset     string          `db:"asset"`
+	Amount    decimal.Decimal `db:"amount"`
+	Status    int32           `db:"status"`
+	CreatedAt time.Time       `db:"created_at"`
+	UpdatedAt time.Time       `db:"updated_at"`
 }
 
+// OrderStatusPending is the status an order keeps until financial-service
+// has processed its Parsian callback, which settles only pending orders
+const OrderStatusPending int32 = -138
+
+// OrderPayableType is stored as payable_type on the deposit transaction of an
+// order; the callback finds the transaction and its token through it
+const OrderPayableType = "App\\Models\\Order"
+
 type Payment struct {
-	ID        uint64    `db:"id"`
-	UserID    uint64    `db:"user_id"`
-	RefID     int64     `db:"ref_id"`
-	CardPan   string    `db:"card_pan"`
-	Gateway   string    `db:"gateway"`
-	Amount    float64   `db:"amount"`
-	Product   string    `db:"product"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID        uint64          `db:"id"`
+	OrderID   uint64          `db:"order_id"`
+	UserID    uint64          `db:"user_id"`
+	RefID     int64           `db:"ref_id"`
+	CardPan   string          `db:"card_pan"`
+	Gateway   string          `db:"gateway"`
+	Amount    decimal.Decimal `db:"amount"`
+	Product   string          `db:"product"`
+	CreatedAt time.Time       `db:"created_at"`
+	UpdatedAt time.Time       `db:"updated_at"`
 }
 
 type Variable struct {
@@ -64,23 +73,25 @@ type Variable struct {
 }
 
 type FirstOrder struct {
-	ID        uint64    `db:"id"`
-	UserID    uint64    `db:"user_id"`
-	Type      string    `db:"type"`
-	Amount    float64   `db:"amount"`
-	Date      string    `db:"date"` // Jalali date format Y/m/d
-	Bonus     float64   `db:"bonus"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID        uint64          `db:"id"`
+	UserID    uint64          `db:"user_id"`
+	Type      string          `db:"type"`
+	Amount    decimal.Decimal `db:"amount"`
+	Date      string          `db:"date"` // Jalali date format Y/m/d
+	Bonus     decimal.Decimal `db:"bonus"`
+	CreatedAt time.Time       `db:"created_at"`
+	UpdatedAt time.Time       `db:"updated_at"`
 }
 
 type ReferralOrderHistory struct {
-	ID         uint64    `db:"id"`
-	UserID     uint64    `db:"user_id"`     // The referrer who receives the commission
-	ReferralID uint64    `db:"referral_id"` // The user who was referred
-	Amount     float64   `db:"amount"`
-	CreatedAt  time.Time `db:"created_at"`
-	UpdatedAt  time.Time `db:"updated_at"`
+	ID         uint64          `db:"id"`
+	UserID     uint64          `db:"user_id"`     // The referrer who receives the commission
+	ReferralID uint64          `db:"referral_id"` // The user who was referred
+	OrderID    *uint64         `db:"order_id"`    // The order that earned the reward; nil on rows written before tiers
+	Tier       int             `db:"tier"`        // 1 = direct referrer, 2 = referrer of referrer, ...
+	Amount     decimal.Decimal `db:"amount"`
+	CreatedAt  time.Time       `db:"created_at"`
+	UpdatedAt  time.Time       `db:"updated_at"`
 }
 
 type LockedAsset struct {
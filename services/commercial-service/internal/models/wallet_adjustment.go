@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Wallet adjustment batch statuses
+const (
+	AdjustmentBatchPending  = "pending"
+	AdjustmentBatchExecuted = "executed"
+	AdjustmentBatchRejected = "rejected"
+)
+
+// AdjustmentBatchPayableType is stored as payable_type on the transactions
+// created when a batch is executed
+const AdjustmentBatchPayableType = "App\\Models\\WalletAdjustmentBatch"
+
+// AdjustmentBatch is a set of admin wallet credits and debits awaiting or
+// having passed a second admin's approval
+type AdjustmentBatch struct {
+	ID           uint64             `db:"id"`
+	Reason       string             `db:"reason"`
+	Status       string             `db:"status"`
+	CreatedBy    uint64             `db:"created_by"`
+	DecidedBy    *uint64            `db:"decided_by"`
+	DecisionNote *string            `db:"decision_note"`
+	EntryCount   int32              `db:"entry_count"`
+	DecidedAt    *time.Time         `db:"decided_at"`
+	CreatedAt    time.Time          `db:"created_at"`
+	UpdatedAt    time.Time          `db:"updated_at"`
+	Entries      []*AdjustmentEntry `db:"-"`
+}
+
+// AdjustmentEntry is a single wallet credit (positive amount) or debit
+// (negative amount) of a batch
+type AdjustmentEntry struct {
+	ID            uint64          `db:"id"`
+	BatchID       uint64          `db:"batch_id"`
+	Line          int32           `db:"line"` // Line of the entry in the submitted payload
+	UserID        uint64          `db:"user_id"`
+	Asset         string          `db:"asset"`
+	Amount        decimal.Decimal `db:"amount"`
+	TransactionID *string         `db:"transaction_id"`
+}
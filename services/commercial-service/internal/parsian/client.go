@@ -2,11 +2,11 @@ package parsian
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 	"io"
 	"net/http"
-	"time"
 )
 
 const (
@@ -20,14 +20,25 @@ const (
 // Matches Laravel's App\Parsian\Parsian class
 type Client struct {
 	httpClient *http.Client
+	retry      RetryConfig
+	saleURL    string
+	confirmURL string
 }
 
-// NewClient creates a new Parsian client
+// NewClient creates a new Parsian client with the default retry policy
 func NewClient() *Client {
+	return NewClientWithRetry(DefaultRetryConfig())
+}
+
+// NewClientWithRetry creates a Parsian client retrying transient failures as
+// retry says. Unset fields take their defaults.
+func NewClientWithRetry(retry RetryConfig) *Client {
 	return &Client{
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+		// Each attempt is bounded by its context instead
+		httpClient: &http.Client{},
+		retry:      retry.withDefaults(),
+		saleURL:    saleServiceURL,
+		confirmURL: confirmServiceURL,
 	}
 }
 
@@ -67,7 +78,7 @@ type VerificationResponse struct {
 
 // RequestPayment initiates a payment request
 // Matches Laravel's App\Parsian\Request::send()
-func (c *Client) RequestPayment(params RequestParams) (*RequestResponse, error) {
+func (c *Client) RequestPayment(ctx context.Context, params RequestParams) (*RequestResponse, error) {
 	// Build SOAP envelope - exactly as in Laravel
 	additionalData := params.AdditionalData
 	originator := params.Originator
@@ -88,25 +99,6 @@ func (c *Client) RequestPayment(params RequestParams) (*RequestResponse, error)
   </soap:Body>
 </soap:Envelope>`, params.MerchantID, params.Amount, params.OrderID, params.CallbackURL, additionalData, originator)
 
-	req, err := http.NewRequest("POST", saleServiceURL, bytes.NewBufferString(soapEnvelope))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", "text/xml; charset=utf-8")
-	req.Header.Set("SOAPAction", "https://pec.Shaparak.ir/NewIPGServices/Sale/SaleService/SalePaymentRequest")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
 	// Parse SOAP response
 	var envelope struct {
 		Body struct {
@@ -120,9 +112,12 @@ func (c *Client) RequestPayment(params RequestParams) (*RequestResponse, error)
 		} `xml:"Body"`
 	}
 
-	if err := xml.Unmarshal(body, &envelope); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	err := c.send(ctx, OperationSale, c.saleURL,
+		"https://pec.Shaparak.ir/NewIPGServices/Sale/SaleService/SalePaymentRequest", soapEnvelope, &envelope)
+	if err != nil {
+		return nil, fmt.Errorf("payment request failed: %w", err)
 	}
+	recordStatus(OperationSale, envelope.Body.Response.Result.Status)
 
 	return &RequestResponse{
 		Status:  envelope.Body.Response.Result.Status,
@@ -134,7 +129,9 @@ func (c *Client) RequestPayment(params RequestParams) (*RequestResponse, error)
 // VerifyPayment verifies a payment
 // Matches Laravel's App\Parsian\Verification::send()
 // IMPORTANT: Uses DIFFERENT endpoint (ConfirmService, not SaleService)
-func (c *Client) VerifyPayment(params VerificationParams) (*VerificationResponse, error) {
+// A confirm that may have reached the gateway is not sent again; such
+// failures wrap ErrConfirmOutcomeUnknown.
+func (c *Client) VerifyPayment(ctx context.Context, params VerificationParams) (*VerificationResponse, error) {
 	// Build SOAP envelope - exactly as in Laravel
 	soapEnvelope := fmt.Sprintf(`<?xml version="1.0" encoding="utf-8"?>
 <soap:Envelope xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema" xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
@@ -148,26 +145,6 @@ func (c *Client) VerifyPayment(params VerificationParams) (*VerificationResponse
   </soap:Body>
 </soap:Envelope>`, params.MerchantID, params.Token)
 
-	// Use ConfirmService endpoint, not SaleService!
-	req, err := http.NewRequest("POST", confirmServiceURL, bytes.NewBufferString(soapEnvelope))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create verification request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", "text/xml; charset=utf-8")
-	req.Header.Set("SOAPAction", "https://pec.Shaparak.ir/NewIPGServices/Confirm/ConfirmService/ConfirmPayment")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send verification request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read verification response: %w", err)
-	}
-
 	// Parse SOAP response
 	var envelope struct {
 		Body struct {
@@ -180,9 +157,13 @@ func (c *Client) VerifyPayment(params VerificationParams) (*VerificationResponse
 		} `xml:"Body"`
 	}
 
-	if err := xml.Unmarshal(body, &envelope); err != nil {
-		return nil, fmt.Errorf("failed to parse verification response: %w", err)
+	// Use ConfirmService endpoint, not SaleService!
+	err := c.send(ctx, OperationConfirm, c.confirmURL,
+		"https://pec.Shaparak.ir/NewIPGServices/Confirm/ConfirmService/ConfirmPayment", soapEnvelope, &envelope)
+	if err != nil {
+		return nil, fmt.Errorf("verification request failed: %w", err)
 	}
+	recordStatus(OperationConfirm, envelope.Body.Response.Result.Status)
 
 	return &VerificationResponse{
 		Status:      envelope.Body.Response.Result.Status,
@@ -191,6 +172,38 @@ func (c *Client) VerifyPayment(params VerificationParams) (*VerificationResponse
 	}, nil
 }
 
+// send posts a SOAP envelope to url and decodes the answer into out, retrying
+// transient failures
+func (c *Client) send(ctx context.Context, op, url, soapAction, envelope string, out interface{}) error {
+	return c.withRetries(ctx, op, func(ctx context.Context) *attemptError {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBufferString(envelope))
+		if err != nil {
+			return &attemptError{err: fmt.Errorf("failed to create request: %w", err), result: attemptNetworkError}
+		}
+		req.Header.Set("Content-Type", "text/xml; charset=utf-8")
+		req.Header.Set("SOAPAction", soapAction)
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return classifyTransportError(fmt.Errorf("failed to send request: %w", err))
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return classifyTransportError(fmt.Errorf("failed to read response: %w", err))
+		}
+		if attempt := classifyStatus(resp.StatusCode); attempt != nil {
+			return attempt
+		}
+
+		if err := xml.Unmarshal(body, out); err != nil {
+			return &attemptError{err: fmt.Errorf("failed to parse response: %w", err), result: attemptInvalidResponse, delivered: true}
+		}
+		return nil
+	})
+}
+
 // Success checks if the request response indicates success
 // Matches Laravel's App\Parsian\RequestResponse::success()
 // Success criteria: status === 0 AND token > 0
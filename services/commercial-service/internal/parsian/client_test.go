@@ -0,0 +1,150 @@
+package parsian
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const saleAnswer = `<?xml version="1.0" encoding="utf-8"?>
+<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body>
+<SalePaymentRequestResponse><SalePaymentRequestResult><Status>0</Status><Token>42</Token></SalePaymentRequestResult></SalePaymentRequestResponse>
+</soap:Body></soap:Envelope>`
+
+const confirmAnswer = `<?xml version="1.0" encoding="utf-8"?>
+<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body>
+<ConfirmPaymentResponse><ConfirmPaymentResult><Status>0</Status><RRN>777</RRN></ConfirmPaymentResult></ConfirmPaymentResponse>
+</soap:Body></soap:Envelope>`
+
+// gateway answers with the given HTTP statuses in turn, then with answer
+func gateway(t *testing.T, answer string, statuses ...int) (*httptest.Server, *int32) {
+	t.Helper()
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&calls, 1))
+		if n <= len(statuses) {
+			w.WriteHeader(statuses[n-1])
+			return
+		}
+		w.Write([]byte(answer))
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func testClient(url string) *Client {
+	client := NewClientWithRetry(RetryConfig{
+		MaxAttempts:    3,
+		BaseDelay:      time.Millisecond,
+		MaxDelay:       5 * time.Millisecond,
+		AttemptTimeout: time.Second,
+		Budget:         5 * time.Second,
+	})
+	client.saleURL = url
+	client.confirmURL = url
+	return client
+}
+
+func TestRequestPaymentRetriesTransientFailures(t *testing.T) {
+	server, calls := gateway(t, saleAnswer, http.StatusServiceUnavailable, http.StatusBadGateway)
+
+	resp, err := testClient(server.URL).RequestPayment(context.Background(), RequestParams{OrderID: "1", Amount: 1000})
+	if err != nil {
+		t.Fatalf("RequestPayment: %v", err)
+	}
+	if !resp.Success() || resp.Token != 42 {
+		t.Errorf("got %+v, want token 42", resp)
+	}
+	if *calls != 3 {
+		t.Errorf("gateway called %d times, want 3", *calls)
+	}
+}
+
+func TestRequestPaymentStopsOnPermanentFailure(t *testing.T) {
+	server, calls := gateway(t, saleAnswer, http.StatusBadRequest)
+
+	if _, err := testClient(server.URL).RequestPayment(context.Background(), RequestParams{}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if *calls != 1 {
+		t.Errorf("gateway called %d times, want 1", *calls)
+	}
+}
+
+func TestVerifyPaymentIsNotResentOnceDelivered(t *testing.T) {
+	server, calls := gateway(t, confirmAnswer, http.StatusBadGateway)
+
+	_, err := testClient(server.URL).VerifyPayment(context.Background(), VerificationParams{Token: 42})
+	if !errors.Is(err, ErrConfirmOutcomeUnknown) {
+		t.Fatalf("got %v, want ErrConfirmOutcomeUnknown", err)
+	}
+	if *calls != 1 {
+		t.Errorf("gateway called %d times, want 1", *calls)
+	}
+}
+
+func TestVerifyPaymentRetriesUndeliveredRequests(t *testing.T) {
+	server, calls := gateway(t, confirmAnswer, http.StatusTooManyRequests)
+
+	resp, err := testClient(server.URL).VerifyPayment(context.Background(), VerificationParams{Token: 42})
+	if err != nil {
+		t.Fatalf("VerifyPayment: %v", err)
+	}
+	if !resp.Success() || resp.ReferenceID != 777 {
+		t.Errorf("got %+v, want reference 777", resp)
+	}
+	if *calls != 2 {
+		t.Errorf("gateway called %d times, want 2", *calls)
+	}
+
+	// Nothing listens on a closed server, so the confirm never left
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+	_, err = testClient(closed.URL).VerifyPayment(context.Background(), VerificationParams{Token: 42})
+	if err == nil || errors.Is(err, ErrConfirmOutcomeUnknown) {
+		t.Fatalf("got %v, want a connection error", err)
+	}
+}
+
+func TestCallsStopWhenBudgetIsSpent(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := testClient(server.URL)
+	client.retry.AttemptTimeout = 50 * time.Millisecond
+	client.retry.Budget = 120 * time.Millisecond
+
+	start := time.Now()
+	if _, err := client.RequestPayment(context.Background(), RequestParams{}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("call took %v, want it bounded by the budget", elapsed)
+	}
+}
+
+func TestBackoffStaysWithinBounds(t *testing.T) {
+	config := RetryConfig{BaseDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond}
+	for retry := 0; retry < 40; retry++ {
+		ceiling := config.MaxDelay
+		if retry < 3 {
+			ceiling = config.BaseDelay << retry
+		}
+		for i := 0; i < 20; i++ {
+			if d := config.backoff(retry); d < 0 || d > ceiling {
+				t.Fatalf("backoff(%d) = %v, want within [0, %v]", retry, d, ceiling)
+			}
+		}
+	}
+}
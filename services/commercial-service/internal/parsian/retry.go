@@ -0,0 +1,270 @@
+package parsian
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Gateway operations, the operation label of the metrics
+const (
+	// OperationSale requests a payment token. Sending it again at worst leaves
+	// an unused token or a duplicate order error, so it is retried on any
+	// transient failure.
+	OperationSale = "sale"
+	// OperationConfirm settles a paid token. It is only retried when the
+	// gateway certainly did not receive the previous attempt.
+	OperationConfirm = "confirm"
+)
+
+const (
+	DefaultMaxAttempts    = 3
+	DefaultBaseDelay      = 200 * time.Millisecond
+	DefaultMaxDelay       = 2 * time.Second
+	DefaultAttemptTimeout = 10 * time.Second
+	DefaultBudget         = 30 * time.Second
+)
+
+// ErrConfirmOutcomeUnknown is returned when a confirm failed after its request
+// may have reached the gateway. It is not resent, since the token may already
+// be settled; the payment has to be checked with the bank.
+var ErrConfirmOutcomeUnknown = errors.New("parsian confirm may have reached the gateway")
+
+// Attempt results, the result label of parsian_attempts_total
+const (
+	attemptOK              = "ok"
+	attemptTimeout         = "timeout"
+	attemptNetworkError    = "network_error"
+	attemptHTTPError       = "http_error"
+	attemptInvalidResponse = "invalid_response"
+)
+
+var (
+	gatewayAttempts = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "metargb",
+			Subsystem: "commercial",
+			Name:      "parsian_attempts_total",
+			Help:      "HTTP requests sent to Parsian by operation and result: ok, timeout, network_error, http_error or invalid_response",
+		},
+		[]string{"operation", "result"},
+	)
+	gatewayRetries = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "metargb",
+			Subsystem: "commercial",
+			Name:      "parsian_retries_total",
+			Help:      "Parsian requests sent again after a transient failure, by operation",
+		},
+		[]string{"operation"},
+	)
+	gatewayCalls = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "metargb",
+			Subsystem: "commercial",
+			Name:      "parsian_calls_total",
+			Help:      "Parsian operations by result: ok when the gateway answered, error when every attempt failed or the budget ran out",
+		},
+		[]string{"operation", "result"},
+	)
+	gatewayStatuses = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "metargb",
+			Subsystem: "commercial",
+			Name:      "parsian_responses_total",
+			Help:      "Parsian answers by operation and gateway status code, 0 being success",
+		},
+		[]string{"operation", "status"},
+	)
+	gatewayCallDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "metargb",
+			Subsystem: "commercial",
+			Name:      "parsian_call_duration_seconds",
+			Help:      "Time Parsian operations took including retries and backoff",
+			Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 20, 30},
+		},
+		[]string{"operation"},
+	)
+)
+
+// RetryConfig controls how calls to the gateway are retried
+type RetryConfig struct {
+	// MaxAttempts is how many times a call is sent, including the first
+	MaxAttempts int
+	// BaseDelay is the backoff before the first retry. It doubles with every
+	// retry up to MaxDelay, and the actual wait is a random duration up to it
+	// so replicas do not retry in step.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// AttemptTimeout bounds each request
+	AttemptTimeout time.Duration
+	// Budget bounds a call across all its attempts and backoffs
+	Budget time.Duration
+}
+
+// DefaultRetryConfig returns the defaults used when the environment sets nothing
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts:    DefaultMaxAttempts,
+		BaseDelay:      DefaultBaseDelay,
+		MaxDelay:       DefaultMaxDelay,
+		AttemptTimeout: DefaultAttemptTimeout,
+		Budget:         DefaultBudget,
+	}
+}
+
+// withDefaults replaces unset fields with their defaults
+func (c RetryConfig) withDefaults() RetryConfig {
+	defaults := DefaultRetryConfig()
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = defaults.MaxAttempts
+	}
+	if c.BaseDelay <= 0 {
+		c.BaseDelay = defaults.BaseDelay
+	}
+	if c.MaxDelay < c.BaseDelay {
+		c.MaxDelay = c.BaseDelay
+	}
+	if c.AttemptTimeout <= 0 {
+		c.AttemptTimeout = defaults.AttemptTimeout
+	}
+	if c.Budget <= 0 {
+		c.Budget = defaults.Budget
+	}
+	return c
+}
+
+// backoff returns a random wait of at most BaseDelay * 2^retry, capped at MaxDelay
+func (c RetryConfig) backoff(retry int) time.Duration {
+	ceiling := c.MaxDelay
+	if retry < 30 {
+		if d := c.BaseDelay << retry; d > 0 && d < ceiling {
+			ceiling = d
+		}
+	}
+	return time.Duration(rand.Int64N(int64(ceiling) + 1))
+}
+
+// attemptError is a failed request to the gateway
+type attemptError struct {
+	err    error
+	result string
+	// delivered is false when the gateway certainly did not get the request
+	delivered bool
+	// transient failures may succeed when sent again
+	transient bool
+}
+
+func (e *attemptError) Error() string { return e.err.Error() }
+func (e *attemptError) Unwrap() error { return e.err }
+
+// retryable reports whether op may be sent again after e
+func (e *attemptError) retryable(op string) bool {
+	if !e.transient {
+		return false
+	}
+	return op != OperationConfirm || !e.delivered
+}
+
+// classifyTransportError wraps an error of http.Client.Do
+func classifyTransportError(err error) *attemptError {
+	attempt := &attemptError{err: err, result: attemptNetworkError, delivered: true, transient: true}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() || errors.Is(err, context.DeadlineExceeded) {
+		attempt.result = attemptTimeout
+	}
+
+	// Failing to resolve or connect means nothing was written
+	var dnsErr *net.DNSError
+	var opErr *net.OpError
+	if errors.As(err, &dnsErr) || errors.As(err, &opErr) && opErr.Op == "dial" {
+		attempt.delivered = false
+	}
+	return attempt
+}
+
+// classifyStatus wraps a non-2xx HTTP status, or returns nil for success
+func classifyStatus(code int) *attemptError {
+	if code >= 200 && code < 300 {
+		return nil
+	}
+	attempt := &attemptError{
+		err:       fmt.Errorf("unexpected HTTP status %d", code),
+		result:    attemptHTTPError,
+		delivered: true,
+	}
+	switch code {
+	case http.StatusTooManyRequests:
+		// Rate limited requests are refused before they are processed
+		attempt.transient = true
+		attempt.delivered = false
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		attempt.transient = true
+	}
+	return attempt
+}
+
+// withRetries runs attempt until it succeeds, fails for good, runs out of
+// attempts or the budget is spent. attempt gets a context bounded by the
+// attempt timeout.
+func (c *Client) withRetries(ctx context.Context, op string, attempt func(ctx context.Context) *attemptError) error {
+	start := time.Now()
+	defer func() {
+		gatewayCallDuration.WithLabelValues(op).Observe(time.Since(start).Seconds())
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, c.retry.Budget)
+	defer cancel()
+
+	var last *attemptError
+	for i := 0; i < c.retry.MaxAttempts; i++ {
+		if i > 0 {
+			timer := time.NewTimer(c.retry.backoff(i - 1))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return c.callFailed(op, last)
+			case <-timer.C:
+			}
+			gatewayRetries.WithLabelValues(op).Inc()
+		}
+
+		attemptCtx, cancelAttempt := context.WithTimeout(ctx, c.retry.AttemptTimeout)
+		last = attempt(attemptCtx)
+		cancelAttempt()
+		if last == nil {
+			gatewayAttempts.WithLabelValues(op, attemptOK).Inc()
+			gatewayCalls.WithLabelValues(op, "ok").Inc()
+			return nil
+		}
+		gatewayAttempts.WithLabelValues(op, last.result).Inc()
+
+		if !last.retryable(op) || ctx.Err() != nil {
+			break
+		}
+	}
+	return c.callFailed(op, last)
+}
+
+func (c *Client) callFailed(op string, last *attemptError) error {
+	gatewayCalls.WithLabelValues(op, "error").Inc()
+	if op == OperationConfirm && last.delivered {
+		return fmt.Errorf("%w: %w", ErrConfirmOutcomeUnknown, last)
+	}
+	return last
+}
+
+// recordStatus counts the gateway status code of an answer
+func recordStatus(op string, status int32) {
+	gatewayStatuses.WithLabelValues(op, strconv.Itoa(int(status))).Inc()
+}
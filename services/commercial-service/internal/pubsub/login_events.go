@@ -0,0 +1,177 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/redis/go-redis/v9/maintnotifications"
+
+	"metargb/shared/pkg/activity"
+	"metargb/shared/pkg/logger"
+)
+
+const (
+	// LoginConsumerGroup is the Redis consumer group every commercial-service
+	// replica joins to follow logins on the activity stream
+	LoginConsumerGroup = "commercial-service"
+	// loginClaimIdle is how long a delivered event may stay unacknowledged
+	// before another replica claims it
+	loginClaimIdle = 5 * time.Minute
+
+	loginReadBatch    = 50
+	loginReadBlock    = 5 * time.Second
+	loginRetryBackoff = 5 * time.Second
+)
+
+// LoginHandler records the IP and device of a login
+type LoginHandler func(ctx context.Context, userID uint64, ip, device string, at time.Time) error
+
+// LoginEvents reads login events from the activity stream, so the fraud rules
+// can compare payments with the devices users recently logged in from. Other
+// event types are acknowledged without being handled.
+type LoginEvents struct {
+	client   *redis.Client
+	stream   string
+	consumer string
+	handler  LoginHandler
+	log      *logger.Logger
+}
+
+// NewLoginEvents connects to Redis and creates the consumer group, and the
+// stream if it does not exist yet. consumer names this replica in the group.
+func NewLoginEvents(redisURL, stream, consumer string, handler LoginHandler, log *logger.Logger) (*LoginEvents, error) {
+	opts, err := redis.ParseURL(redisURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Redis URL: %w", err)
+	}
+
+	// Disable maint notifications to avoid warning about maint_notifications command
+	opts.MaintNotificationsConfig = &maintnotifications.Config{
+		Mode: maintnotifications.ModeDisabled,
+	}
+
+	client := redis.NewClient(opts)
+
+	ctx := context.Background()
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	// Start from the beginning of the stream so logins older than the first
+	// deployment still count as recent
+	err = client.XGroupCreateMkStream(ctx, stream, LoginConsumerGroup, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		client.Close()
+		return nil, fmt.Errorf("failed to create consumer group: %w", err)
+	}
+
+	return &LoginEvents{
+		client:   client,
+		stream:   stream,
+		consumer: consumer,
+		handler:  handler,
+		log:      log,
+	}, nil
+}
+
+// Start consumes events in the background until ctx is cancelled
+func (e *LoginEvents) Start(ctx context.Context) {
+	go func() {
+		lastClaim := time.Time{}
+		for ctx.Err() == nil {
+			if time.Since(lastClaim) >= loginClaimIdle {
+				if err := e.claimStale(ctx); err != nil && ctx.Err() == nil {
+					e.log.Warn("Failed to claim stale login events", "error", err)
+				}
+				lastClaim = time.Now()
+			}
+
+			if err := e.read(ctx); err != nil && ctx.Err() == nil {
+				e.log.Error("Failed to read login events", "error", err)
+				select {
+				case <-ctx.Done():
+				case <-time.After(loginRetryBackoff):
+				}
+			}
+		}
+	}()
+}
+
+// read blocks until new events arrive and handles them
+func (e *LoginEvents) read(ctx context.Context) error {
+	streams, err := e.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    LoginConsumerGroup,
+		Consumer: e.consumer,
+		Streams:  []string{e.stream, ">"},
+		Count:    loginReadBatch,
+		Block:    loginReadBlock,
+	}).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, stream := range streams {
+		for _, message := range stream.Messages {
+			e.handle(ctx, message)
+		}
+	}
+	return nil
+}
+
+// claimStale takes over events other replicas received but never acknowledged
+func (e *LoginEvents) claimStale(ctx context.Context) error {
+	start := "0-0"
+	for {
+		messages, next, err := e.client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   e.stream,
+			Group:    LoginConsumerGroup,
+			Consumer: e.consumer,
+			MinIdle:  loginClaimIdle,
+			Start:    start,
+			Count:    loginReadBatch,
+		}).Result()
+		if err != nil {
+			return err
+		}
+
+		for _, message := range messages {
+			e.handle(ctx, message)
+		}
+		if next == "0-0" || len(messages) == 0 {
+			return nil
+		}
+		start = next
+	}
+}
+
+// handle records a login and acknowledges the message unless recording
+// failed. Recording a login twice changes nothing, so redelivery is safe.
+func (e *LoginEvents) handle(ctx context.Context, message redis.XMessage) {
+	payload, _ := message.Values[activity.PayloadField].(string)
+	event, err := activity.Unmarshal([]byte(payload))
+	if err != nil {
+		e.log.Warn("Dropping malformed activity event", "message_id", message.ID, "error", err)
+	} else if event.Type == activity.TypeLogin && (event.IP != "" || event.Device != "") {
+		if err := e.handler(ctx, event.UserID, event.IP, event.Device, event.OccurredAt); err != nil {
+			e.log.Error("Failed to record login", "message_id", message.ID, "event_id", event.ID, "error", err)
+			return
+		}
+	}
+
+	if err := e.client.XAck(ctx, e.stream, LoginConsumerGroup, message.ID).Err(); err != nil {
+		e.log.Warn("Failed to acknowledge activity event", "message_id", message.ID, "error", err)
+	}
+}
+
+// Close closes the Redis connection
+func (e *LoginEvents) Close() error {
+	return e.client.Close()
+}
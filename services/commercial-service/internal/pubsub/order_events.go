@@ -0,0 +1,62 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/redis/go-redis/v9/maintnotifications"
+
+	"metargb/commercial-service/internal/models"
+)
+
+// OrderStatusChannel is the Redis channel the WebSocket gateway relays to
+// order owners as order-status-changed
+const OrderStatusChannel = "order-status"
+
+// OrderEvents publishes order payment state changes to Redis
+type OrderEvents struct {
+	client *redis.Client
+}
+
+// NewOrderEvents connects to Redis
+func NewOrderEvents(redisURL string) (*OrderEvents, error) {
+	opts, err := redis.ParseURL(redisURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Redis URL: %w", err)
+	}
+
+	// Disable maint notifications to avoid warning about maint_notifications command
+	opts.MaintNotificationsConfig = &maintnotifications.Config{
+		Mode: maintnotifications.ModeDisabled,
+	}
+
+	client := redis.NewClient(opts)
+
+	// Test connection
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	return &OrderEvents{client: client}, nil
+}
+
+// PublishOrderStatusChanged announces an order's payment state on OrderStatusChannel
+func (e *OrderEvents) PublishOrderStatusChanged(ctx context.Context, event models.OrderStatusEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	if err := e.client.Publish(ctx, OrderStatusChannel, payload).Err(); err != nil {
+		return fmt.Errorf("failed to publish to Redis: %w", err)
+	}
+	return nil
+}
+
+// Close closes the Redis connection
+func (e *OrderEvents) Close() error {
+	return e.client.Close()
+}
@@ -0,0 +1,81 @@
+package pubsub
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/redis/go-redis/v9/maintnotifications"
+
+	"metargb/shared/pkg/variables"
+)
+
+// VariableEvents publishes variable changes to Redis and delivers the
+// changes published by every commercial-service replica
+type VariableEvents struct {
+	client *redis.Client
+}
+
+// NewVariableEvents connects to Redis
+func NewVariableEvents(redisURL string) (*VariableEvents, error) {
+	opts, err := redis.ParseURL(redisURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Redis URL: %w", err)
+	}
+
+	// Disable maint notifications to avoid warning about maint_notifications command
+	opts.MaintNotificationsConfig = &maintnotifications.Config{
+		Mode: maintnotifications.ModeDisabled,
+	}
+
+	client := redis.NewClient(opts)
+
+	// Test connection
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	return &VariableEvents{client: client}, nil
+}
+
+// PublishVariableChanged announces a variable's new value on variables.Channel
+func (e *VariableEvents) PublishVariableChanged(ctx context.Context, event variables.ChangedEvent) error {
+	payload, err := event.Marshal()
+	if err != nil {
+		return err
+	}
+
+	if err := e.client.Publish(ctx, variables.Channel, payload).Err(); err != nil {
+		return fmt.Errorf("failed to publish to Redis: %w", err)
+	}
+	return nil
+}
+
+// Subscribe calls onChange for every variable change until ctx is cancelled.
+// Malformed events are skipped.
+func (e *VariableEvents) Subscribe(ctx context.Context, onChange func(variables.ChangedEvent)) {
+	sub := e.client.Subscribe(ctx, variables.Channel)
+	go func() {
+		defer sub.Close()
+		messages := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+				if event, err := variables.Unmarshal([]byte(message.Payload)); err == nil {
+					onChange(event)
+				}
+			}
+		}
+	}()
+}
+
+// Close closes the Redis connection
+func (e *VariableEvents) Close() error {
+	return e.client.Close()
+}
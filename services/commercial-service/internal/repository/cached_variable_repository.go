@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/shared/pkg/variables"
+)
+
+// rateVariableKeys are the asset rates GetAllRates returns
+var rateVariableKeys = []string{"psc", "red", "blue", "yellow"}
+
+// cachedVariableRepository serves variable reads from a cache that is
+// invalidated on every change, see variables.Channel
+type cachedVariableRepository struct {
+	VariableRepository
+	cache *variables.Cache
+}
+
+// NewCachedVariableRepository wraps repo with cache. The cache must load
+// through repo.GetMany.
+func NewCachedVariableRepository(repo VariableRepository, cache *variables.Cache) VariableRepository {
+	return &cachedVariableRepository{
+		VariableRepository: repo,
+		cache:              cache,
+	}
+}
+
+func (r *cachedVariableRepository) GetRate(ctx context.Context, key string) (float64, error) {
+	values, err := r.cache.Get(ctx, key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get variable rate: %w", err)
+	}
+	value, ok := values[key]
+	if !ok {
+		return 0, fmt.Errorf("variable not found: %s", key)
+	}
+	return value, nil
+}
+
+func (r *cachedVariableRepository) GetAllRates(ctx context.Context) (map[string]float64, error) {
+	return r.cache.Get(ctx, rateVariableKeys...)
+}
+
+func (r *cachedVariableRepository) GetMany(ctx context.Context, keys []string) (map[string]float64, error) {
+	return r.cache.Get(ctx, keys...)
+}
+
+// SetValue drops the key from this replica's cache right away, other
+// replicas drop it when the change event arrives
+func (r *cachedVariableRepository) SetValue(ctx context.Context, def models.VariableDefinition, value decimal.Decimal, changerID uint64, note string) (*models.VariableValue, error) {
+	variable, err := r.VariableRepository.SetValue(ctx, def, value, changerID, note)
+	r.cache.Invalidate(def.Key)
+	return variable, err
+}
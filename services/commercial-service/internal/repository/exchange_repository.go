@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+)
+
+var (
+	// ErrExchangeRateNotFound is returned when a pair has no rate or its rate is disabled
+	ErrExchangeRateNotFound = errors.New("conversion between these assets is not available")
+	// ErrConversionSlippage is returned when the current rate pays out less than the caller's minimum
+	ErrConversionSlippage = errors.New("received amount is below min_receive")
+	// ErrConversionTooSmall is returned when nothing is left after the fee
+	ErrConversionTooSmall = errors.New("amount is too small to convert")
+	// ErrConversionWalletNotFound is returned when the user has no wallet
+	ErrConversionWalletNotFound = errors.New("wallet not found")
+	// ErrConversionInsufficientBalance is returned when the wallet holds less than the amount
+	ErrConversionInsufficientBalance = errors.New("insufficient balance")
+)
+
+type ExchangeRepository interface {
+	ListRates(ctx context.Context, includeDisabled bool) ([]*models.ExchangeRate, error)
+	GetRate(ctx context.Context, fromAsset, toAsset string) (*models.ExchangeRate, error)
+	SaveRate(ctx context.Context, rate *models.ExchangeRate) error
+	Convert(ctx context.Context, userID uint64, fromAsset, toAsset string, amount, minReceive decimal.Decimal) (*models.Conversion, error)
+}
+
+type exchangeRepository struct {
+	db *sql.DB
+}
+
+func NewExchangeRepository(db *sql.DB) ExchangeRepository {
+	return &exchangeRepository{db: db}
+}
+
+const exchangeRateColumns = `id, from_asset, to_asset, rate, fee_percent, enabled, updated_by, created_at, updated_at`
+
+func (r *exchangeRepository) ListRates(ctx context.Context, includeDisabled bool) ([]*models.ExchangeRate, error) {
+	query := `SELECT ` + exchangeRateColumns + ` FROM exchange_rates`
+	if !includeDisabled {
+		query += ` WHERE enabled = 1`
+	}
+	query += ` ORDER BY from_asset, to_asset`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exchange rates: %w", err)
+	}
+	defer rows.Close()
+
+	var rates []*models.ExchangeRate
+	for rows.Next() {
+		rate, err := scanExchangeRate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan exchange rate: %w", err)
+		}
+		rates = append(rates, rate)
+	}
+
+	return rates, rows.Err()
+}
+
+func (r *exchangeRepository) GetRate(ctx context.Context, fromAsset, toAsset string) (*models.ExchangeRate, error) {
+	rate, err := scanExchangeRate(r.db.QueryRowContext(ctx,
+		`SELECT `+exchangeRateColumns+` FROM exchange_rates WHERE from_asset = ? AND to_asset = ?`, fromAsset, toAsset))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange rate: %w", err)
+	}
+	return rate, nil
+}
+
+// SaveRate creates the rate of a pair or replaces its current rate
+func (r *exchangeRepository) SaveRate(ctx context.Context, rate *models.ExchangeRate) error {
+	now := time.Now()
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO exchange_rates (from_asset, to_asset, rate, fee_percent, enabled, updated_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE rate = VALUES(rate), fee_percent = VALUES(fee_percent), enabled = VALUES(enabled),
+			updated_by = VALUES(updated_by), updated_at = VALUES(updated_at)
+	`, rate.FromAsset, rate.ToAsset, rate.Rate.String(), rate.FeePercent.String(), rate.Enabled, rate.UpdatedBy, now, now)
+	if err != nil {
+		return fmt.Errorf("failed to save exchange rate: %w", err)
+	}
+	return nil
+}
+
+// Convert debits amount of fromAsset and credits the converted amount of
+// toAsset at the pair's current rate, recording a withdraw and a deposit
+// transaction. Nothing changes if the payout would be below minReceive.
+func (r *exchangeRepository) Convert(ctx context.Context, userID uint64, fromAsset, toAsset string, amount, minReceive decimal.Decimal) (*models.Conversion, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// The shared lock keeps an admin from changing the rate until the conversion commits
+	rate, err := scanExchangeRate(tx.QueryRowContext(ctx,
+		`SELECT `+exchangeRateColumns+` FROM exchange_rates WHERE from_asset = ? AND to_asset = ? LOCK IN SHARE MODE`, fromAsset, toAsset))
+	if err == sql.ErrNoRows {
+		return nil, ErrExchangeRateNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange rate: %w", err)
+	}
+	if !rate.Enabled {
+		return nil, ErrExchangeRateNotFound
+	}
+
+	received, fee := rate.Quote(amount)
+	if !received.IsPositive() {
+		return nil, ErrConversionTooSmall
+	}
+	if received.LessThan(minReceive) {
+		return nil, ErrConversionSlippage
+	}
+
+	// assets are validated against the color wallet columns by the service
+	var balance decimal.Decimal
+	err = tx.QueryRowContext(ctx, fmt.Sprintf(`SELECT %s FROM wallets WHERE user_id = ? FOR UPDATE`, fromAsset), userID).Scan(&balance)
+	if err == sql.ErrNoRows {
+		return nil, ErrConversionWalletNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock wallet: %w", err)
+	}
+	if balance.LessThan(amount) {
+		return nil, ErrConversionInsufficientBalance
+	}
+
+	now := time.Now()
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`
+		UPDATE wallets SET %s = %s - ?, %s = %s + ?, updated_at = ? WHERE user_id = ?
+	`, fromAsset, fromAsset, toAsset, toAsset), amount.String(), received.String(), now, userID); err != nil {
+		return nil, fmt.Errorf("failed to update wallet: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO wallet_conversions (user_id, from_asset, to_asset, amount, received, fee, rate, fee_percent, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, userID, fromAsset, toAsset, amount.String(), received.String(), fee.String(), rate.Rate.String(), rate.FeePercent.String(), now, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create conversion: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	conversion := &models.Conversion{
+		ID:                    uint64(id),
+		UserID:                userID,
+		FromAsset:             fromAsset,
+		ToAsset:               toAsset,
+		Amount:                amount,
+		Received:              received,
+		Fee:                   fee,
+		Rate:                  rate.Rate,
+		FeePercent:            rate.FeePercent,
+		WithdrawTransactionID: fmt.Sprintf("TR-CNV-%d-%s", id, fromAsset),
+		DepositTransactionID:  fmt.Sprintf("TR-CNV-%d-%s", id, toAsset),
+		CreatedAt:             now,
+	}
+
+	for _, leg := range []struct {
+		id     string
+		asset  string
+		amount decimal.Decimal
+		action string
+	}{
+		{conversion.WithdrawTransactionID, fromAsset, amount, "withdraw"},
+		{conversion.DepositTransactionID, toAsset, received, "deposit"},
+	} {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO transactions (id, user_id, asset, amount, action, status, payable_type, payable_id, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		`, leg.id, userID, leg.asset, leg.amount, leg.action, 1, models.ConversionPayableType, id, now, now); err != nil {
+			return nil, fmt.Errorf("failed to create conversion transaction: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit conversion: %w", err)
+	}
+	return conversion, nil
+}
+
+type exchangeRateScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanExchangeRate(s exchangeRateScanner) (*models.ExchangeRate, error) {
+	rate := &models.ExchangeRate{}
+	err := s.Scan(
+		&rate.ID, &rate.FromAsset, &rate.ToAsset, &rate.Rate, &rate.FeePercent, &rate.Enabled,
+		&rate.UpdatedBy, &rate.CreatedAt, &rate.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rate, nil
+}
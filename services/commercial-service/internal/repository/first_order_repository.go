@@ -13,6 +13,8 @@ type FirstOrderRepository interface {
 	Create(ctx context.Context, firstOrder *models.FirstOrder) error
 	HasFirstOrder(ctx context.Context, userID uint64, orderType string) (bool, error)
 	Count(ctx context.Context, userID uint64) (int, error)
+	// GetUserRegisteredAt returns when the user signed up, zero when unknown
+	GetUserRegisteredAt(ctx context.Context, userID uint64) (time.Time, error)
 }
 
 type firstOrderRepository struct {
@@ -86,3 +88,25 @@ func (r *firstOrderRepository) Count(ctx context.Context, userID uint64) (int, e
 
 	return count, nil
 }
+
+// GetUserRegisteredAt returns the user's registration time, which bounds the
+// first order bonus window
+func (r *firstOrderRepository) GetUserRegisteredAt(ctx context.Context, userID uint64) (time.Time, error) {
+	query := `
+		SELECT created_at
+		FROM users
+		WHERE id = ?
+		LIMIT 1
+	`
+
+	var createdAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&createdAt)
+	if err == sql.ErrNoRows {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get user registration date: %w", err)
+	}
+
+	return createdAt.Time, nil
+}
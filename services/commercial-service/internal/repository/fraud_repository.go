@@ -0,0 +1,320 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+)
+
+var (
+	// ErrFraudCheckNotPending is returned when resolving a check that is not waiting for review
+	ErrFraudCheckNotPending = errors.New("fraud check is not pending review")
+	// ErrBlockedCardExists is returned when the pattern is already blocked
+	ErrBlockedCardExists = errors.New("card pattern is already blocked")
+	// ErrBlockedCardNotFound is returned when unblocking a pattern that does not exist
+	ErrBlockedCardNotFound = errors.New("blocked card not found")
+)
+
+// maxFraudReviews caps how many checks a review queue listing returns
+const maxFraudReviews = 200
+
+type FraudRepository interface {
+	CountOrdersSince(ctx context.Context, userID uint64, since time.Time) (int, error)
+	CountChecksSince(ctx context.Context, userID uint64, kind string, since time.Time) (int, error)
+	RecordLogin(ctx context.Context, userID uint64, ip, device string, at time.Time) error
+	RecentLogins(ctx context.Context, userID uint64, since time.Time) ([]*models.LoginSighting, error)
+	CreateCheck(ctx context.Context, check *models.FraudCheck) error
+	GetCheck(ctx context.Context, checkID uint64) (*models.FraudCheck, error)
+	ListChecks(ctx context.Context, reviewStatus string) ([]*models.FraudCheck, error)
+	ResolveCheck(ctx context.Context, checkID uint64, reviewStatus string, reviewerID uint64, note string, at time.Time) error
+	ConsumeApproval(ctx context.Context, userID uint64, kind, asset string, amount decimal.Decimal, since, at time.Time) (bool, error)
+	ListBlockedCards(ctx context.Context) ([]*models.BlockedCard, error)
+	CreateBlockedCard(ctx context.Context, card *models.BlockedCard) error
+	DeleteBlockedCard(ctx context.Context, cardID uint64) error
+}
+
+type fraudRepository struct {
+	db *sql.DB
+}
+
+func NewFraudRepository(db *sql.DB) FraudRepository {
+	return &fraudRepository{db: db}
+}
+
+const fraudCheckColumns = `id, user_id, kind, asset, amount, decision, reasons, reference, ip, device, card_pan,
+	review_status, reviewed_by, review_note, reviewed_at, consumed_at, created_at`
+
+// CountOrdersSince counts the payment orders a user created since a time
+func (r *fraudRepository) CountOrdersSince(ctx context.Context, userID uint64, since time.Time) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM orders WHERE user_id = ? AND created_at >= ?`, userID, since).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count orders: %w", err)
+	}
+	return count, nil
+}
+
+// CountChecksSince counts the checks of a kind run for a user since a time
+func (r *fraudRepository) CountChecksSince(ctx context.Context, userID uint64, kind string, since time.Time) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM fraud_checks WHERE user_id = ? AND kind = ? AND created_at >= ?`, userID, kind, since).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count fraud checks: %w", err)
+	}
+	return count, nil
+}
+
+// RecordLogin remembers that a user logged in from an IP and device. Each
+// pair is kept once with the time it was last seen.
+func (r *fraudRepository) RecordLogin(ctx context.Context, userID uint64, ip, device string, at time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO fraud_login_sightings (user_id, ip, device, last_seen_at)
+		VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE last_seen_at = GREATEST(last_seen_at, VALUES(last_seen_at))
+	`, userID, ip, device, at)
+	if err != nil {
+		return fmt.Errorf("failed to record login: %w", err)
+	}
+	return nil
+}
+
+// RecentLogins returns the IPs and devices a user logged in from since a time
+func (r *fraudRepository) RecentLogins(ctx context.Context, userID uint64, since time.Time) ([]*models.LoginSighting, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT user_id, ip, device, last_seen_at
+		FROM fraud_login_sightings
+		WHERE user_id = ? AND last_seen_at >= ?
+	`, userID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recent logins: %w", err)
+	}
+	defer rows.Close()
+
+	var sightings []*models.LoginSighting
+	for rows.Next() {
+		sighting := &models.LoginSighting{}
+		if err := rows.Scan(&sighting.UserID, &sighting.IP, &sighting.Device, &sighting.LastSeenAt); err != nil {
+			return nil, fmt.Errorf("failed to scan login: %w", err)
+		}
+		sightings = append(sightings, sighting)
+	}
+
+	return sightings, rows.Err()
+}
+
+func (r *fraudRepository) CreateCheck(ctx context.Context, check *models.FraudCheck) error {
+	if check.CreatedAt.IsZero() {
+		check.CreatedAt = time.Now()
+	}
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO fraud_checks (user_id, kind, asset, amount, decision, reasons, reference, ip, device, card_pan, review_status, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, check.UserID, check.Kind, check.Asset, check.Amount, check.Decision, strings.Join(check.Reasons, ","),
+		nullString(check.Reference), nullString(check.IP), nullString(check.Device), nullString(check.CardPan),
+		nullString(check.ReviewStatus), check.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create fraud check: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get fraud check id: %w", err)
+	}
+	check.ID = uint64(id)
+	return nil
+}
+
+// GetCheck returns a check, nil if it does not exist
+func (r *fraudRepository) GetCheck(ctx context.Context, checkID uint64) (*models.FraudCheck, error) {
+	check, err := scanFraudCheck(r.db.QueryRowContext(ctx,
+		`SELECT `+fraudCheckColumns+` FROM fraud_checks WHERE id = ?`, checkID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fraud check: %w", err)
+	}
+	return check, nil
+}
+
+// ListChecks returns checks in a review state. Pending checks come oldest
+// first so the queue is worked in order, resolved ones newest first.
+func (r *fraudRepository) ListChecks(ctx context.Context, reviewStatus string) ([]*models.FraudCheck, error) {
+	order := `DESC`
+	if reviewStatus == models.FraudReviewPending {
+		order = `ASC`
+	}
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+fraudCheckColumns+` FROM fraud_checks WHERE review_status = ? ORDER BY created_at `+order+`, id `+order+` LIMIT ?`,
+		reviewStatus, maxFraudReviews)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fraud checks: %w", err)
+	}
+	defer rows.Close()
+
+	var checks []*models.FraudCheck
+	for rows.Next() {
+		check, err := scanFraudCheck(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan fraud check: %w", err)
+		}
+		checks = append(checks, check)
+	}
+
+	return checks, rows.Err()
+}
+
+// ResolveCheck approves or rejects a pending check
+func (r *fraudRepository) ResolveCheck(ctx context.Context, checkID uint64, reviewStatus string, reviewerID uint64, note string, at time.Time) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE fraud_checks
+		SET review_status = ?, reviewed_by = ?, review_note = ?, reviewed_at = ?
+		WHERE id = ? AND review_status = ?
+	`, reviewStatus, reviewerID, nullString(note), at, checkID, models.FraudReviewPending)
+	if err != nil {
+		return fmt.Errorf("failed to resolve fraud check: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrFraudCheckNotPending
+	}
+	return nil
+}
+
+// ConsumeApproval uses up one check approved since a time for at least
+// amount of the asset, so the user's retry is let through once
+func (r *fraudRepository) ConsumeApproval(ctx context.Context, userID uint64, kind, asset string, amount decimal.Decimal, since, at time.Time) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE fraud_checks
+		SET consumed_at = ?
+		WHERE user_id = ? AND kind = ? AND asset = ? AND amount >= ?
+			AND review_status = ? AND reviewed_at >= ? AND consumed_at IS NULL
+		ORDER BY id
+		LIMIT 1
+	`, at, userID, kind, asset, amount, models.FraudReviewApproved, since)
+	if err != nil {
+		return false, fmt.Errorf("failed to consume fraud approval: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *fraudRepository) ListBlockedCards(ctx context.Context) ([]*models.BlockedCard, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, pattern, reason, created_by, created_at
+		FROM fraud_card_blocklist
+		ORDER BY id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blocked cards: %w", err)
+	}
+	defer rows.Close()
+
+	var cards []*models.BlockedCard
+	for rows.Next() {
+		card := &models.BlockedCard{}
+		var reason sql.NullString
+		if err := rows.Scan(&card.ID, &card.Pattern, &reason, &card.CreatedBy, &card.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan blocked card: %w", err)
+		}
+		card.Reason = reason.String
+		cards = append(cards, card)
+	}
+
+	return cards, rows.Err()
+}
+
+func (r *fraudRepository) CreateBlockedCard(ctx context.Context, card *models.BlockedCard) error {
+	if card.CreatedAt.IsZero() {
+		card.CreatedAt = time.Now()
+	}
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO fraud_card_blocklist (pattern, reason, created_by, created_at)
+		VALUES (?, ?, ?, ?)
+	`, card.Pattern, nullString(card.Reason), card.CreatedBy, card.CreatedAt)
+	if isDuplicateKey(err) {
+		return ErrBlockedCardExists
+	}
+	if err != nil {
+		return fmt.Errorf("failed to block card: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get blocked card id: %w", err)
+	}
+	card.ID = uint64(id)
+	return nil
+}
+
+func (r *fraudRepository) DeleteBlockedCard(ctx context.Context, cardID uint64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM fraud_card_blocklist WHERE id = ?`, cardID)
+	if err != nil {
+		return fmt.Errorf("failed to unblock card: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrBlockedCardNotFound
+	}
+	return nil
+}
+
+type fraudCheckScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanFraudCheck(s fraudCheckScanner) (*models.FraudCheck, error) {
+	check := &models.FraudCheck{}
+	var reasons string
+	var reference, ip, device, cardPan, reviewStatus, reviewNote sql.NullString
+	var reviewedBy sql.NullInt64
+	err := s.Scan(
+		&check.ID, &check.UserID, &check.Kind, &check.Asset, &check.Amount, &check.Decision, &reasons,
+		&reference, &ip, &device, &cardPan, &reviewStatus, &reviewedBy, &reviewNote,
+		&check.ReviewedAt, &check.ConsumedAt, &check.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if reasons != "" {
+		check.Reasons = strings.Split(reasons, ",")
+	}
+	check.Reference = reference.String
+	check.IP = ip.String
+	check.Device = device.String
+	check.CardPan = cardPan.String
+	check.ReviewStatus = reviewStatus.String
+	check.ReviewedBy = uint64(reviewedBy.Int64)
+	check.ReviewNote = reviewNote.String
+	return check, nil
+}
+
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+func isDuplicateKey(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 // ER_DUP_ENTRY
+}
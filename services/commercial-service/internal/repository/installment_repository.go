@@ -0,0 +1,486 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+)
+
+var (
+	// ErrInstallmentPlanNotActive is returned when a plan was already completed or defaulted
+	ErrInstallmentPlanNotActive = errors.New("installment plan is not active")
+	// ErrInstallmentPlanUnpaid is returned when completing a plan with unpaid installments
+	ErrInstallmentPlanUnpaid = errors.New("installment plan is not paid off")
+	// ErrInstallmentNothingDue is returned when paying a plan whose installments are all paid
+	ErrInstallmentNothingDue = errors.New("installment plan has no unpaid installments")
+	// ErrInstallmentInsufficientBalance is returned when the buyer's wallet cannot cover an installment
+	ErrInstallmentInsufficientBalance = errors.New("insufficient balance")
+	// ErrInstallmentWalletNotFound is returned when a payout goes to a user without a wallet
+	ErrInstallmentWalletNotFound = errors.New("wallet not found")
+)
+
+type InstallmentRepository interface {
+	Create(ctx context.Context, plan *models.InstallmentPlan) (*models.InstallmentPlan, error)
+	GetByID(ctx context.Context, planID uint64) (*models.InstallmentPlan, error)
+	ListForUser(ctx context.Context, userID uint64, status string) ([]*models.InstallmentPlan, error)
+	ListDuePlanIDs(ctx context.Context, now time.Time) ([]uint64, error)
+	PayNext(ctx context.Context, planID uint64) error
+	Complete(ctx context.Context, planID, tradeID uint64) error
+	Default(ctx context.Context, planID uint64) error
+}
+
+type installmentRepository struct {
+	db *sql.DB
+}
+
+func NewInstallmentRepository(db *sql.DB) InstallmentRepository {
+	return &installmentRepository{db: db}
+}
+
+const installmentPlanColumns = `
+	id, feature_id, buyer_id, seller_id, platform_user_id, status, total_psc, total_irr,
+	seller_payment_psc, seller_payment_irr, paid_psc, paid_irr, refunded_psc, refunded_irr,
+	penalty_percent, trade_id, closed_at, created_at, updated_at
+`
+
+// Create stores a plan with its schedule and charges the down payment
+// (sequence 0) from the buyer's wallet. Nothing is stored if the wallet
+// cannot cover the down payment.
+func (r *installmentRepository) Create(ctx context.Context, plan *models.InstallmentPlan) (*models.InstallmentPlan, error) {
+	if len(plan.Installments) == 0 || plan.Installments[0].Sequence != 0 {
+		return nil, fmt.Errorf("installment plan needs a down payment")
+	}
+	downPayment := plan.Installments[0]
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO installment_plans
+			(feature_id, buyer_id, seller_id, platform_user_id, status, total_psc, total_irr,
+			 seller_payment_psc, seller_payment_irr, paid_psc, paid_irr, refunded_psc, refunded_irr,
+			 penalty_percent, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 0, 0, ?, ?, ?)
+	`, plan.FeatureID, plan.BuyerID, plan.SellerID, plan.PlatformUserID, models.InstallmentPlanActive,
+		plan.TotalPSC.String(), plan.TotalIRR.String(), plan.SellerPaymentPSC.String(), plan.SellerPaymentIRR.String(),
+		downPayment.AmountPSC.String(), downPayment.AmountIRR.String(), plan.PenaltyPercent.String(), now, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create installment plan: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+	plan.ID = uint64(id)
+
+	for _, installment := range plan.Installments {
+		installment.PlanID = plan.ID
+		installment.Status = models.InstallmentPending
+		var paidAt *time.Time
+		if installment.Sequence == 0 {
+			installment.Status = models.InstallmentPaid
+			paidAt = &now
+		}
+
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO installments (plan_id, sequence, amount_psc, amount_irr, status, due_at, paid_at, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		`, plan.ID, installment.Sequence, installment.AmountPSC.String(), installment.AmountIRR.String(),
+			installment.Status, installment.DueAt, paidAt, now, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create installment: %w", err)
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get last insert id: %w", err)
+		}
+		installment.ID = uint64(id)
+		installment.PaidAt = paidAt
+	}
+
+	if err := chargeInstallment(ctx, tx, plan.ID, plan.BuyerID, downPayment, now); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit installment plan: %w", err)
+	}
+
+	plan.Status = models.InstallmentPlanActive
+	plan.PaidPSC = downPayment.AmountPSC
+	plan.PaidIRR = downPayment.AmountIRR
+	plan.CreatedAt = now
+	plan.UpdatedAt = now
+	return plan, nil
+}
+
+func (r *installmentRepository) GetByID(ctx context.Context, planID uint64) (*models.InstallmentPlan, error) {
+	query := `SELECT ` + installmentPlanColumns + ` FROM installment_plans WHERE id = ?`
+
+	plan, err := scanInstallmentPlan(r.db.QueryRowContext(ctx, query, planID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get installment plan: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, plan_id, sequence, amount_psc, amount_irr, status, due_at, paid_at
+		FROM installments
+		WHERE plan_id = ?
+		ORDER BY sequence
+	`, planID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get installments: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		installment := &models.Installment{}
+		if err := rows.Scan(
+			&installment.ID, &installment.PlanID, &installment.Sequence, &installment.AmountPSC,
+			&installment.AmountIRR, &installment.Status, &installment.DueAt, &installment.PaidAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan installment: %w", err)
+		}
+		plan.Installments = append(plan.Installments, installment)
+	}
+
+	return plan, rows.Err()
+}
+
+// ListForUser returns the plans a user is buying or selling, newest first
+func (r *installmentRepository) ListForUser(ctx context.Context, userID uint64, status string) ([]*models.InstallmentPlan, error) {
+	query := `SELECT ` + installmentPlanColumns + ` FROM installment_plans WHERE (buyer_id = ? OR seller_id = ?)`
+	args := []interface{}{userID, userID}
+	if status != "" {
+		query += ` AND status = ?`
+		args = append(args, status)
+	}
+	query += ` ORDER BY id DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list installment plans: %w", err)
+	}
+	defer rows.Close()
+
+	var plans []*models.InstallmentPlan
+	for rows.Next() {
+		plan, err := scanInstallmentPlan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan installment plan: %w", err)
+		}
+		plans = append(plans, plan)
+	}
+
+	return plans, rows.Err()
+}
+
+// ListDuePlanIDs returns the active plans with an installment due by now and
+// the active plans that are paid off but not yet completed
+func (r *installmentRepository) ListDuePlanIDs(ctx context.Context, now time.Time) ([]uint64, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT p.id
+		FROM installment_plans p
+		WHERE p.status = ?
+		  AND (
+			EXISTS (SELECT 1 FROM installments i WHERE i.plan_id = p.id AND i.status = ? AND i.due_at <= ?)
+			OR NOT EXISTS (SELECT 1 FROM installments i WHERE i.plan_id = p.id AND i.status = ?)
+		  )
+		ORDER BY p.id
+	`, models.InstallmentPlanActive, models.InstallmentPending, now, models.InstallmentPending)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due installment plans: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uint64
+	for rows.Next() {
+		var id uint64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan installment plan id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// PayNext charges the earliest unpaid installment of an active plan from the
+// buyer's wallet, whether or not it is due yet
+func (r *installmentRepository) PayNext(ctx context.Context, planID uint64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Locking the plan keeps the worker and an early payment from charging the same installment
+	var status string
+	var buyerID uint64
+	err = tx.QueryRowContext(ctx, `SELECT status, buyer_id FROM installment_plans WHERE id = ? FOR UPDATE`, planID).Scan(&status, &buyerID)
+	if err == sql.ErrNoRows || (err == nil && status != models.InstallmentPlanActive) {
+		return ErrInstallmentPlanNotActive
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock installment plan: %w", err)
+	}
+
+	installment := &models.Installment{}
+	err = tx.QueryRowContext(ctx, `
+		SELECT id, sequence, amount_psc, amount_irr
+		FROM installments
+		WHERE plan_id = ? AND status = ?
+		ORDER BY sequence
+		LIMIT 1
+	`, planID, models.InstallmentPending).Scan(&installment.ID, &installment.Sequence, &installment.AmountPSC, &installment.AmountIRR)
+	if err == sql.ErrNoRows {
+		return ErrInstallmentNothingDue
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get next installment: %w", err)
+	}
+
+	now := time.Now()
+	if err := chargeInstallment(ctx, tx, planID, buyerID, installment, now); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE installments SET status = ?, paid_at = ?, updated_at = ? WHERE id = ?
+	`, models.InstallmentPaid, now, now, installment.ID); err != nil {
+		return fmt.Errorf("failed to update installment: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE installment_plans SET paid_psc = paid_psc + ?, paid_irr = paid_irr + ?, updated_at = ? WHERE id = ?
+	`, installment.AmountPSC.String(), installment.AmountIRR.String(), now, planID); err != nil {
+		return fmt.Errorf("failed to update installment plan: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit installment payment: %w", err)
+	}
+	return nil
+}
+
+// Complete closes a paid off plan, paying the seller their share and the
+// platform the rest of what was collected
+func (r *installmentRepository) Complete(ctx context.Context, planID, tradeID uint64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	plan, err := lockInstallmentPlan(ctx, tx, planID)
+	if err != nil {
+		return err
+	}
+
+	var unpaid bool
+	if err := tx.QueryRowContext(ctx, `
+		SELECT EXISTS(SELECT 1 FROM installments WHERE plan_id = ? AND status = ?)
+	`, planID, models.InstallmentPending).Scan(&unpaid); err != nil {
+		return fmt.Errorf("failed to check installments: %w", err)
+	}
+	if unpaid {
+		return ErrInstallmentPlanUnpaid
+	}
+
+	now := time.Now()
+	payouts := []struct {
+		userID uint64
+		prefix string
+		psc    decimal.Decimal
+		irr    decimal.Decimal
+	}{
+		{plan.SellerID, "TR-INSS", plan.SellerPaymentPSC, plan.SellerPaymentIRR},
+		{plan.PlatformUserID, "TR-INSF", plan.PaidPSC.Sub(plan.SellerPaymentPSC), plan.PaidIRR.Sub(plan.SellerPaymentIRR)},
+	}
+	for _, payout := range payouts {
+		if err := depositToWallet(ctx, tx, planID, payout.userID, payout.prefix, payout.psc, payout.irr, now); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE installment_plans SET status = ?, trade_id = ?, closed_at = ?, updated_at = ? WHERE id = ?
+	`, models.InstallmentPlanCompleted, tradeID, now, now, planID); err != nil {
+		return fmt.Errorf("failed to complete installment plan: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit installment plan: %w", err)
+	}
+	return nil
+}
+
+// Default closes a plan whose buyer missed an installment. The penalty, a
+// percentage of the total capped at what was paid, goes to the seller and
+// the rest is refunded to the buyer.
+func (r *installmentRepository) Default(ctx context.Context, planID uint64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	plan, err := lockInstallmentPlan(ctx, tx, planID)
+	if err != nil {
+		return err
+	}
+
+	penaltyPSC := decimal.Min(models.TruncateToWallet("psc", plan.TotalPSC.Mul(plan.PenaltyPercent).Div(decimal.NewFromInt(100))), plan.PaidPSC)
+	penaltyIRR := decimal.Min(models.TruncateToWallet("irr", plan.TotalIRR.Mul(plan.PenaltyPercent).Div(decimal.NewFromInt(100))), plan.PaidIRR)
+	refundPSC := plan.PaidPSC.Sub(penaltyPSC)
+	refundIRR := plan.PaidIRR.Sub(penaltyIRR)
+
+	now := time.Now()
+	if err := depositToWallet(ctx, tx, planID, plan.BuyerID, "TR-INSR", refundPSC, refundIRR, now); err != nil {
+		return err
+	}
+	if err := depositToWallet(ctx, tx, planID, plan.SellerID, "TR-INSP", penaltyPSC, penaltyIRR, now); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE installment_plans
+		SET status = ?, refunded_psc = ?, refunded_irr = ?, closed_at = ?, updated_at = ?
+		WHERE id = ?
+	`, models.InstallmentPlanDefaulted, refundPSC.String(), refundIRR.String(), now, now, planID); err != nil {
+		return fmt.Errorf("failed to default installment plan: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit installment plan: %w", err)
+	}
+	return nil
+}
+
+// lockInstallmentPlan loads an active plan for update
+func lockInstallmentPlan(ctx context.Context, tx *sql.Tx, planID uint64) (*models.InstallmentPlan, error) {
+	query := `SELECT ` + installmentPlanColumns + ` FROM installment_plans WHERE id = ? FOR UPDATE`
+	plan, err := scanInstallmentPlan(tx.QueryRowContext(ctx, query, planID))
+	if err == sql.ErrNoRows || (err == nil && plan.Status != models.InstallmentPlanActive) {
+		return nil, ErrInstallmentPlanNotActive
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock installment plan: %w", err)
+	}
+	return plan, nil
+}
+
+// chargeInstallment withdraws an installment from the buyer's wallet and
+// records a transaction per asset
+func chargeInstallment(ctx context.Context, tx *sql.Tx, planID, buyerID uint64, installment *models.Installment, now time.Time) error {
+	for _, charge := range []struct {
+		asset  string
+		amount decimal.Decimal
+	}{
+		{"psc", installment.AmountPSC},
+		{"irr", installment.AmountIRR},
+	} {
+		if !charge.amount.IsPositive() {
+			continue
+		}
+
+		// asset is one of the fixed wallet columns above
+		result, err := tx.ExecContext(ctx, fmt.Sprintf(`
+			UPDATE wallets SET %s = %s - ?, updated_at = ? WHERE user_id = ? AND %s >= ?
+		`, charge.asset, charge.asset, charge.asset), charge.amount.String(), now, buyerID, charge.amount.String())
+		if err != nil {
+			return fmt.Errorf("failed to charge installment: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return ErrInstallmentInsufficientBalance
+		}
+
+		transactionID := fmt.Sprintf("TR-INS-%d-%s", installment.ID, charge.asset)
+		if err := insertInstallmentTransaction(ctx, tx, transactionID, buyerID, charge.asset, charge.amount, "withdraw", planID, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// depositToWallet credits a payout or refund of a plan and records a
+// transaction per asset, identified by prefix and the plan id
+func depositToWallet(ctx context.Context, tx *sql.Tx, planID, userID uint64, prefix string, psc, irr decimal.Decimal, now time.Time) error {
+	for _, deposit := range []struct {
+		asset  string
+		amount decimal.Decimal
+	}{
+		{"psc", psc},
+		{"irr", irr},
+	} {
+		if !deposit.amount.IsPositive() {
+			continue
+		}
+
+		// asset is one of the fixed wallet columns above
+		result, err := tx.ExecContext(ctx, fmt.Sprintf(`
+			UPDATE wallets SET %s = %s + ?, updated_at = ? WHERE user_id = ?
+		`, deposit.asset, deposit.asset), deposit.amount.String(), now, userID)
+		if err != nil {
+			return fmt.Errorf("failed to credit wallet: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return fmt.Errorf("user %d: %w", userID, ErrInstallmentWalletNotFound)
+		}
+
+		transactionID := fmt.Sprintf("%s-%d-%s", prefix, planID, deposit.asset)
+		if err := insertInstallmentTransaction(ctx, tx, transactionID, userID, deposit.asset, deposit.amount, "deposit", planID, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertInstallmentTransaction(ctx context.Context, tx *sql.Tx, transactionID string, userID uint64, asset string, amount decimal.Decimal, action string, planID uint64, now time.Time) error {
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO transactions (id, user_id, asset, amount, action, status, payable_type, payable_id, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, transactionID, userID, asset, amount, action, 1, models.InstallmentPlanPayableType, planID, now, now); err != nil {
+		return fmt.Errorf("failed to create installment transaction: %w", err)
+	}
+	return nil
+}
+
+type installmentPlanScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanInstallmentPlan(s installmentPlanScanner) (*models.InstallmentPlan, error) {
+	plan := &models.InstallmentPlan{}
+	err := s.Scan(
+		&plan.ID, &plan.FeatureID, &plan.BuyerID, &plan.SellerID, &plan.PlatformUserID, &plan.Status,
+		&plan.TotalPSC, &plan.TotalIRR, &plan.SellerPaymentPSC, &plan.SellerPaymentIRR,
+		&plan.PaidPSC, &plan.PaidIRR, &plan.RefundedPSC, &plan.RefundedIRR,
+		&plan.PenaltyPercent, &plan.TradeID, &plan.ClosedAt, &plan.CreatedAt, &plan.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
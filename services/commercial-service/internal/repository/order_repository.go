@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"metargb/commercial-service/internal/models"
@@ -14,6 +15,8 @@ type OrderRepository interface {
 	FindByID(ctx context.Context, id uint64) (*models.Order, error)
 	Update(ctx context.Context, order *models.Order) error
 	FindLatestByUserID(ctx context.Context, userID uint64) (*models.Order, error)
+	ListByUserID(ctx context.Context, userID uint64, filter models.OrderFilter) ([]*models.OrderHistoryItem, int64, error)
+	ListPaidBetween(ctx context.Context, from, to time.Time) ([]*models.Order, error)
 }
 
 type orderRepository struct {
@@ -98,3 +101,113 @@ func (r *orderRepository) FindLatestByUserID(ctx context.Context, userID uint64)
 	}
 	return order, nil
 }
+
+// ListByUserID returns a page of the user's orders, newest first, each joined
+// with the payment that settled it, along with the total number of matches.
+func (r *orderRepository) ListByUserID(ctx context.Context, userID uint64, filter models.OrderFilter) ([]*models.OrderHistoryItem, int64, error) {
+	where := []string{"o.user_id = ?"}
+	args := []interface{}{userID}
+
+	if len(filter.Statuses) > 0 {
+		placeholders := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			placeholders[i] = "?"
+			args = append(args, status)
+		}
+		where = append(where, "o.status IN ("+strings.Join(placeholders, ", ")+")")
+	}
+	if filter.Gateway != "" {
+		where = append(where, "p.gateway = ?")
+		args = append(args, filter.Gateway)
+	}
+	if filter.From != nil {
+		where = append(where, "o.created_at >= ?")
+		args = append(args, *filter.From)
+	}
+	if filter.To != nil {
+		where = append(where, "o.created_at < ?")
+		args = append(args, *filter.To)
+	}
+
+	from := `
+		FROM orders o
+		LEFT JOIN payments p ON p.order_id = o.id
+		WHERE ` + strings.Join(where, " AND ")
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*)"+from, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count orders: %w", err)
+	}
+
+	query := `
+		SELECT o.id, o.user_id, o.asset, o.amount, o.status, o.created_at, o.updated_at, p.gateway, p.ref_id` + from + `
+		ORDER BY o.created_at DESC, o.id DESC
+		LIMIT ? OFFSET ?
+	`
+	offset := (filter.Page - 1) * filter.PerPage
+	rows, err := r.db.QueryContext(ctx, query, append(args, filter.PerPage, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list orders: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*models.OrderHistoryItem
+	for rows.Next() {
+		item := &models.OrderHistoryItem{}
+		var gateway, refID sql.NullString
+		if err := rows.Scan(
+			&item.ID, &item.UserID, &item.Asset, &item.Amount,
+			&item.Status, &item.CreatedAt, &item.UpdatedAt, &gateway, &refID,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan order: %w", err)
+		}
+		if gateway.Valid {
+			item.Gateway = &gateway.String
+		}
+		if refID.Valid {
+			item.RefID = &refID.String
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to iterate orders: %w", err)
+	}
+
+	return items, total, nil
+}
+
+// ListPaidBetween returns orders created in [from, to) that were settled by a
+// payment, oldest first
+func (r *orderRepository) ListPaidBetween(ctx context.Context, from, to time.Time) ([]*models.Order, error) {
+	query := `
+		SELECT o.id, o.user_id, o.asset, o.amount, o.status, o.created_at, o.updated_at
+		FROM orders o
+		WHERE o.created_at >= ? AND o.created_at < ?
+			AND EXISTS (SELECT 1 FROM payments p WHERE p.order_id = o.id)
+		ORDER BY o.created_at ASC, o.id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list paid orders: %w", err)
+	}
+	defer rows.Close()
+
+	var orders []*models.Order
+	for rows.Next() {
+		order := &models.Order{}
+		if err := rows.Scan(
+			&order.ID, &order.UserID, &order.Asset, &order.Amount,
+			&order.Status, &order.CreatedAt, &order.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan order: %w", err)
+		}
+		orders = append(orders, order)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return orders, nil
+}
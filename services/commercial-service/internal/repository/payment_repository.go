@@ -24,11 +24,12 @@ func NewPaymentRepository(db *sql.DB) PaymentRepository {
 
 func (r *paymentRepository) Create(ctx context.Context, payment *models.Payment) error {
 	query := `
-		INSERT INTO payments (user_id, ref_id, card_pan, gateway, amount, product, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO payments (order_id, user_id, ref_id, card_pan, gateway, amount, product, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
+	orderID := sql.NullInt64{Int64: int64(payment.OrderID), Valid: payment.OrderID != 0}
 	result, err := r.db.ExecContext(ctx, query,
-		payment.UserID, payment.RefID, payment.CardPan, payment.Gateway,
+		orderID, payment.UserID, payment.RefID, payment.CardPan, payment.Gateway,
 		payment.Amount, payment.Product, time.Now(), time.Now())
 	if err != nil {
 		return fmt.Errorf("failed to create payment: %w", err)
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// RateHistoryRepository stores asset rates over time. A rate is recorded
+// only when it differs from the last record, so the rate at any time is the
+// latest record before it.
+type RateHistoryRepository interface {
+	// RatesAt returns each asset's latest rate recorded at or before at.
+	// Assets without such a record are left out.
+	RatesAt(ctx context.Context, at time.Time) (map[string]decimal.Decimal, error)
+	Record(ctx context.Context, asset string, rate decimal.Decimal, at time.Time) error
+}
+
+type rateHistoryRepository struct {
+	db *sql.DB
+}
+
+func NewRateHistoryRepository(db *sql.DB) RateHistoryRepository {
+	return &rateHistoryRepository{db: db}
+}
+
+func (r *rateHistoryRepository) RatesAt(ctx context.Context, at time.Time) (map[string]decimal.Decimal, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT h.asset, h.rate
+		FROM rate_history h
+		INNER JOIN (
+			SELECT asset, MAX(recorded_at) AS recorded_at
+			FROM rate_history
+			WHERE recorded_at <= ?
+			GROUP BY asset
+		) latest ON latest.asset = h.asset AND latest.recorded_at = h.recorded_at
+		ORDER BY h.id
+	`, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rate history: %w", err)
+	}
+	defer rows.Close()
+
+	rates := make(map[string]decimal.Decimal)
+	for rows.Next() {
+		var asset string
+		var rate decimal.Decimal
+		if err := rows.Scan(&asset, &rate); err != nil {
+			return nil, fmt.Errorf("failed to scan rate history: %w", err)
+		}
+		// Ordered by id, so the last of two records in the same second wins
+		rates[asset] = rate
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return rates, nil
+}
+
+func (r *rateHistoryRepository) Record(ctx context.Context, asset string, rate decimal.Decimal, at time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO rate_history (asset, rate, recorded_at)
+		VALUES (?, ?, ?)
+	`, asset, rate, at)
+	if err != nil {
+		return fmt.Errorf("failed to record %s rate: %w", asset, err)
+	}
+	return nil
+}
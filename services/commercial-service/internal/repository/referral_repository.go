@@ -3,16 +3,24 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/shopspring/decimal"
+
 	"metargb/commercial-service/internal/models"
 )
 
+// ErrReferrerWalletNotFound is returned when a commission goes to a referrer
+// without a wallet; nothing is recorded so the reward can be paid later
+var ErrReferrerWalletNotFound = errors.New("referrer wallet not found")
+
 type ReferralRepository interface {
 	GetReferrerID(ctx context.Context, userID uint64) (*uint64, error)
-	GetTotalReferredAmount(ctx context.Context, referrerID uint64) (float64, error)
-	CreateReferralOrder(ctx context.Context, history *models.ReferralOrderHistory) error
+	GetTotalReferredAmount(ctx context.Context, referrerID uint64) (decimal.Decimal, error)
+	PayReferralReward(ctx context.Context, history *models.ReferralOrderHistory) (bool, error)
+	HasReferralOrder(ctx context.Context, orderID uint64, tier int) (bool, error)
 }
 
 type referralRepository struct {
@@ -52,46 +60,91 @@ func (r *referralRepository) GetReferrerID(ctx context.Context, userID uint64) (
 
 // GetTotalReferredAmount calculates total referral amount for a referrer
 // Laravel: $referred->referalOrders()->sum('amount')
-func (r *referralRepository) GetTotalReferredAmount(ctx context.Context, referrerID uint64) (float64, error) {
+func (r *referralRepository) GetTotalReferredAmount(ctx context.Context, referrerID uint64) (decimal.Decimal, error) {
 	query := `
 		SELECT COALESCE(SUM(amount), 0)
 		FROM referral_order_histories
 		WHERE user_id = ?
 	`
 
-	var total float64
+	var total decimal.Decimal
 	err := r.db.QueryRowContext(ctx, query, referrerID).Scan(&total)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get total referred amount: %w", err)
+		return decimal.Zero, fmt.Errorf("failed to get total referred amount: %w", err)
 	}
 
 	return total, nil
 }
 
-// CreateReferralOrder creates a referral order history record
+// PayReferralReward records the reward of an order's tier and credits it to
+// the referrer's psc wallet in one transaction. The history row is claimed
+// first: (order_id, tier) is unique, so when the reward was already paid,
+// e.g. by a concurrent recalculation, it returns false and credits nothing.
 // Laravel: $referred->referralOrders()->create([...])
-func (r *referralRepository) CreateReferralOrder(ctx context.Context, history *models.ReferralOrderHistory) error {
-	query := `
-		INSERT INTO referral_order_histories (user_id, referral_id, amount, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?)
-	`
+func (r *referralRepository) PayReferralReward(ctx context.Context, history *models.ReferralOrderHistory) (bool, error) {
+	tier := history.Tier
+	if tier == 0 {
+		tier = 1
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
 
 	now := time.Now()
-	result, err := r.db.ExecContext(ctx, query,
-		history.UserID,
-		history.ReferralID,
-		history.Amount,
-		now,
-		now,
-	)
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO referral_order_histories (user_id, referral_id, order_id, tier, amount, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`, history.UserID, history.ReferralID, history.OrderID, tier, history.Amount, now, now)
+	if isDuplicateKey(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to create referral order: %w", err)
+	}
+
+	credited, err := tx.ExecContext(ctx, `
+		UPDATE wallets
+		SET psc = psc + ?, updated_at = ?
+		WHERE user_id = ?
+	`, history.Amount.String(), now, history.UserID)
 	if err != nil {
-		return fmt.Errorf("failed to create referral order: %w", err)
+		return false, fmt.Errorf("failed to add referral commission to wallet: %w", err)
+	}
+	rowsAffected, err := credited.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return false, fmt.Errorf("user %d: %w", history.UserID, ErrReferrerWalletNotFound)
 	}
 
-	id, err := result.LastInsertId()
-	if err == nil {
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit referral reward: %w", err)
+	}
+
+	if id, err := result.LastInsertId(); err == nil {
 		history.ID = uint64(id)
 	}
+	history.Tier = tier
+	return true, nil
+}
+
+// HasReferralOrder reports whether the reward for an order's tier has already been paid
+func (r *referralRepository) HasReferralOrder(ctx context.Context, orderID uint64, tier int) (bool, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM referral_order_histories
+		WHERE order_id = ? AND tier = ?
+	`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, orderID, tier).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check referral order: %w", err)
+	}
 
-	return nil
+	return count > 0, nil
 }
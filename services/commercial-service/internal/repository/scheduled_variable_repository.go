@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"metargb/commercial-service/internal/models"
+)
+
+// ErrScheduledChangeNotPending is returned when a scheduled change does not
+// exist or was already applied or canceled
+var ErrScheduledChangeNotPending = errors.New("scheduled change not found or no longer pending")
+
+type ScheduledVariableRepository interface {
+	Create(ctx context.Context, change *models.ScheduledVariableChange) (*models.ScheduledVariableChange, error)
+	GetByID(ctx context.Context, id uint64) (*models.ScheduledVariableChange, error)
+	// ListPending returns the pending changes of key, or of every variable
+	// when key is empty, soonest first
+	ListPending(ctx context.Context, key string) ([]*models.ScheduledVariableChange, error)
+	// ListDue returns the pending changes effective at or before now, oldest first
+	ListDue(ctx context.Context, now time.Time) ([]*models.ScheduledVariableChange, error)
+	// NextEffectiveAt returns when the soonest pending change is due, false
+	// when none is pending
+	NextEffectiveAt(ctx context.Context) (time.Time, bool, error)
+	// Claim marks a pending change applied. It returns ErrScheduledChangeNotPending
+	// when another replica claimed or an admin canceled it first.
+	Claim(ctx context.Context, id uint64) error
+	// Release returns a claimed change to pending after applying it failed
+	Release(ctx context.Context, id uint64) error
+	Cancel(ctx context.Context, id uint64) error
+}
+
+type scheduledVariableRepository struct {
+	db *sql.DB
+}
+
+func NewScheduledVariableRepository(db *sql.DB) ScheduledVariableRepository {
+	return &scheduledVariableRepository{db: db}
+}
+
+const scheduledVariableColumns = `id, variable_key, value, COALESCE(note, ''), effective_at, status, created_by, created_at`
+
+func (r *scheduledVariableRepository) Create(ctx context.Context, change *models.ScheduledVariableChange) (*models.ScheduledVariableChange, error) {
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO scheduled_variable_changes
+			(variable_key, value, note, effective_at, status, created_by, created_at, updated_at)
+		VALUES (?, ?, NULLIF(?, ''), ?, ?, ?, NOW(), NOW())
+	`, change.Key, change.Value.String(), change.Note, change.EffectiveAt, models.ScheduledChangePending, change.CreatedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule variable change: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduled change id: %w", err)
+	}
+	return r.GetByID(ctx, uint64(id))
+}
+
+func (r *scheduledVariableRepository) GetByID(ctx context.Context, id uint64) (*models.ScheduledVariableChange, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+scheduledVariableColumns+` FROM scheduled_variable_changes WHERE id = ?`, id)
+	change, err := scanScheduledVariableChange(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrScheduledChangeNotPending
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduled change: %w", err)
+	}
+	return change, nil
+}
+
+func (r *scheduledVariableRepository) ListPending(ctx context.Context, key string) ([]*models.ScheduledVariableChange, error) {
+	query := `SELECT ` + scheduledVariableColumns + ` FROM scheduled_variable_changes WHERE status = ?`
+	args := []interface{}{models.ScheduledChangePending}
+	if key != "" {
+		query += ` AND variable_key = ?`
+		args = append(args, key)
+	}
+	query += ` ORDER BY effective_at, id`
+	return r.list(ctx, query, args...)
+}
+
+func (r *scheduledVariableRepository) ListDue(ctx context.Context, now time.Time) ([]*models.ScheduledVariableChange, error) {
+	return r.list(ctx, `
+		SELECT `+scheduledVariableColumns+`
+		FROM scheduled_variable_changes
+		WHERE status = ? AND effective_at <= ?
+		ORDER BY effective_at, id
+	`, models.ScheduledChangePending, now)
+}
+
+func (r *scheduledVariableRepository) NextEffectiveAt(ctx context.Context) (time.Time, bool, error) {
+	var next sql.NullTime
+	err := r.db.QueryRowContext(ctx,
+		`SELECT MIN(effective_at) FROM scheduled_variable_changes WHERE status = ?`,
+		models.ScheduledChangePending,
+	).Scan(&next)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to get next scheduled change: %w", err)
+	}
+	return next.Time, next.Valid, nil
+}
+
+func (r *scheduledVariableRepository) Claim(ctx context.Context, id uint64) error {
+	return r.setStatus(ctx, id, models.ScheduledChangePending, models.ScheduledChangeApplied)
+}
+
+func (r *scheduledVariableRepository) Release(ctx context.Context, id uint64) error {
+	return r.setStatus(ctx, id, models.ScheduledChangeApplied, models.ScheduledChangePending)
+}
+
+func (r *scheduledVariableRepository) Cancel(ctx context.Context, id uint64) error {
+	return r.setStatus(ctx, id, models.ScheduledChangePending, models.ScheduledChangeCanceled)
+}
+
+// setStatus moves a change from one status to another. The status check
+// makes concurrent claims and cancels exclusive.
+func (r *scheduledVariableRepository) setStatus(ctx context.Context, id uint64, from, to string) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE scheduled_variable_changes SET status = ?, updated_at = NOW() WHERE id = ? AND status = ?`,
+		to, id, from,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update scheduled change: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if affected == 0 {
+		return ErrScheduledChangeNotPending
+	}
+	return nil
+}
+
+func (r *scheduledVariableRepository) list(ctx context.Context, query string, args ...interface{}) ([]*models.ScheduledVariableChange, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled changes: %w", err)
+	}
+	defer rows.Close()
+
+	changes := []*models.ScheduledVariableChange{}
+	for rows.Next() {
+		change, err := scanScheduledVariableChange(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan scheduled change: %w", err)
+		}
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return changes, nil
+}
+
+type scheduledVariableScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanScheduledVariableChange(row scheduledVariableScanner) (*models.ScheduledVariableChange, error) {
+	change := &models.ScheduledVariableChange{}
+	var createdAt sql.NullTime
+	if err := row.Scan(
+		&change.ID, &change.Key, &change.Value, &change.Note, &change.EffectiveAt,
+		&change.Status, &change.CreatedBy, &createdAt,
+	); err != nil {
+		return nil, err
+	}
+	change.CreatedAt = createdAt.Time
+	return change, nil
+}
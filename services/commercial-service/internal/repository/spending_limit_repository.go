@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+)
+
+var (
+	// ErrDailySpendingLimit is returned when a charge would exceed the daily cap
+	ErrDailySpendingLimit = errors.New("daily spending limit reached")
+	// ErrMonthlySpendingLimit is returned when a charge would exceed the monthly cap
+	ErrMonthlySpendingLimit = errors.New("monthly spending limit reached")
+)
+
+type SpendingLimitRepository interface {
+	GetLimits(ctx context.Context, userID uint64) (*models.SpendingLimits, error)
+	SaveLimits(ctx context.Context, limits *models.SpendingLimits) error
+	Usage(ctx context.Context, userID uint64, asset string, now time.Time) (models.SpendingUsage, error)
+	Reserve(ctx context.Context, userID uint64, asset string, amount decimal.Decimal, source string, limit models.SpendingCap, now time.Time) (uint64, error)
+	Release(ctx context.Context, recordID uint64) error
+}
+
+type spendingLimitRepository struct {
+	db *sql.DB
+}
+
+func NewSpendingLimitRepository(db *sql.DB) SpendingLimitRepository {
+	return &spendingLimitRepository{db: db}
+}
+
+// GetLimits returns the caps set for a user, nil if none were set
+func (r *spendingLimitRepository) GetLimits(ctx context.Context, userID uint64) (*models.SpendingLimits, error) {
+	limits := &models.SpendingLimits{}
+	var dailyPSC, monthlyPSC, dailyIRR, monthlyIRR decimal.NullDecimal
+	var updatedBy sql.NullInt64
+	err := r.db.QueryRowContext(ctx, `
+		SELECT user_id, daily_psc, monthly_psc, daily_irr, monthly_irr, updated_by, created_at, updated_at
+		FROM spending_limits
+		WHERE user_id = ?
+	`, userID).Scan(
+		&limits.UserID, &dailyPSC, &monthlyPSC, &dailyIRR, &monthlyIRR, &updatedBy,
+		&limits.CreatedAt, &limits.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spending limits: %w", err)
+	}
+
+	limits.DailyPSC = nullDecimalPtr(dailyPSC)
+	limits.MonthlyPSC = nullDecimalPtr(monthlyPSC)
+	limits.DailyIRR = nullDecimalPtr(dailyIRR)
+	limits.MonthlyIRR = nullDecimalPtr(monthlyIRR)
+	limits.UpdatedBy = uint64(updatedBy.Int64)
+	return limits, nil
+}
+
+// SaveLimits creates or replaces the caps of a user
+func (r *spendingLimitRepository) SaveLimits(ctx context.Context, limits *models.SpendingLimits) error {
+	now := time.Now()
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO spending_limits (user_id, daily_psc, monthly_psc, daily_irr, monthly_irr, updated_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			daily_psc = VALUES(daily_psc), monthly_psc = VALUES(monthly_psc),
+			daily_irr = VALUES(daily_irr), monthly_irr = VALUES(monthly_irr),
+			updated_by = VALUES(updated_by), updated_at = VALUES(updated_at)
+	`, limits.UserID, decimalPtrValue(limits.DailyPSC), decimalPtrValue(limits.MonthlyPSC),
+		decimalPtrValue(limits.DailyIRR), decimalPtrValue(limits.MonthlyIRR), limits.UpdatedBy, now, now)
+	if err != nil {
+		return fmt.Errorf("failed to save spending limits: %w", err)
+	}
+	return nil
+}
+
+// Usage sums what a user spent of an asset in the day and month ending at now
+func (r *spendingLimitRepository) Usage(ctx context.Context, userID uint64, asset string, now time.Time) (models.SpendingUsage, error) {
+	return spendingUsage(ctx, r.db, userID, asset, now)
+}
+
+// Reserve records a charge against the caps of a user, or returns
+// ErrDailySpendingLimit or ErrMonthlySpendingLimit if it does not fit. The
+// wallet row is locked while checking, so concurrent charges are counted one
+// after the other. Release the record if the charge then fails.
+func (r *spendingLimitRepository) Reserve(ctx context.Context, userID uint64, asset string, amount decimal.Decimal, source string, limit models.SpendingCap, now time.Time) (uint64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// A missing wallet is left to the charge itself to report
+	var walletID uint64
+	err = tx.QueryRowContext(ctx, `SELECT id FROM wallets WHERE user_id = ? FOR UPDATE`, userID).Scan(&walletID)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, fmt.Errorf("failed to lock wallet: %w", err)
+	}
+
+	usage, err := spendingUsage(ctx, tx, userID, asset, now)
+	if err != nil {
+		return 0, err
+	}
+	if usage.Day.Add(amount).GreaterThan(limit.Daily) {
+		return 0, ErrDailySpendingLimit
+	}
+	if usage.Month.Add(amount).GreaterThan(limit.Monthly) {
+		return 0, ErrMonthlySpendingLimit
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO spending_records (user_id, asset, amount, source, created_at)
+		VALUES (?, ?, ?, ?, ?)
+	`, userID, asset, amount.String(), source, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to record spending: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit spending record: %w", err)
+	}
+	return uint64(id), nil
+}
+
+// Release removes a spending record whose charge did not go through
+func (r *spendingLimitRepository) Release(ctx context.Context, recordID uint64) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM spending_records WHERE id = ?`, recordID); err != nil {
+		return fmt.Errorf("failed to release spending record: %w", err)
+	}
+	return nil
+}
+
+type spendingQuerier interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+func spendingUsage(ctx context.Context, q spendingQuerier, userID uint64, asset string, now time.Time) (models.SpendingUsage, error) {
+	var usage models.SpendingUsage
+	err := q.QueryRowContext(ctx, `
+		SELECT COALESCE(SUM(CASE WHEN created_at > ? THEN amount END), 0), COALESCE(SUM(amount), 0)
+		FROM spending_records
+		WHERE user_id = ? AND asset = ? AND created_at > ?
+	`, now.Add(-models.SpendingDay), userID, asset, now.Add(-models.SpendingMonth)).Scan(&usage.Day, &usage.Month)
+	if err != nil {
+		return usage, fmt.Errorf("failed to sum spending: %w", err)
+	}
+	return usage, nil
+}
+
+func nullDecimalPtr(d decimal.NullDecimal) *decimal.Decimal {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Decimal
+}
+
+func decimalPtrValue(d *decimal.Decimal) interface{} {
+	if d == nil {
+		return nil
+	}
+	return d.String()
+}
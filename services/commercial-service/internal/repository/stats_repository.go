@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// StatsRepository aggregates figures for the scheduled admin reports
+type StatsRepository interface {
+	// GetPaymentStats returns the number and the total IRR amount of gateway
+	// payments made in [from, to)
+	GetPaymentStats(ctx context.Context, from, to time.Time) (count int64, revenue int64, err error)
+}
+
+type statsRepository struct {
+	db *sql.DB
+}
+
+func NewStatsRepository(db *sql.DB) StatsRepository {
+	return &statsRepository{db: db}
+}
+
+func (r *statsRepository) GetPaymentStats(ctx context.Context, from, to time.Time) (int64, int64, error) {
+	query := `
+		SELECT COUNT(*), COALESCE(SUM(amount), 0)
+		FROM payments
+		WHERE created_at >= ? AND created_at < ?
+	`
+
+	var count, revenue int64
+	if err := r.db.QueryRowContext(ctx, query, from, to).Scan(&count, &revenue); err != nil {
+		return 0, 0, fmt.Errorf("failed to get payment stats: %w", err)
+	}
+	return count, revenue, nil
+}
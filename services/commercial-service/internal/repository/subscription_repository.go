@@ -0,0 +1,369 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+)
+
+var (
+	// ErrSubscriptionExists is returned when subscribing while another subscription is live
+	ErrSubscriptionExists = errors.New("user already has a subscription")
+	// ErrSubscriptionNotLive is returned when a subscription was already canceled or expired
+	ErrSubscriptionNotLive = errors.New("subscription has ended")
+	// ErrSubscriptionInsufficientBalance is returned when the wallet cannot cover a period
+	ErrSubscriptionInsufficientBalance = errors.New("insufficient balance")
+)
+
+type SubscriptionRepository interface {
+	ListPlans(ctx context.Context) ([]*models.SubscriptionPlan, error)
+	GetPlan(ctx context.Context, planID uint64) (*models.SubscriptionPlan, error)
+	Create(ctx context.Context, subscription *models.Subscription) (*models.Subscription, error)
+	GetByID(ctx context.Context, subscriptionID uint64) (*models.Subscription, error)
+	GetLatestForUser(ctx context.Context, userID uint64) (*models.Subscription, error)
+	ListDueIDs(ctx context.Context, now time.Time) ([]uint64, error)
+	ChargePeriod(ctx context.Context, subscriptionID uint64, now time.Time) error
+	RecordFailedCharge(ctx context.Context, subscriptionID uint64, failedAttempts int32, retryAt time.Time) error
+	SetCancelAtPeriodEnd(ctx context.Context, subscriptionID uint64, cancel bool) error
+	End(ctx context.Context, subscriptionID uint64, status string, now time.Time) error
+}
+
+type subscriptionRepository struct {
+	db *sql.DB
+}
+
+func NewSubscriptionRepository(db *sql.DB) SubscriptionRepository {
+	return &subscriptionRepository{db: db}
+}
+
+const subscriptionPlanColumns = `id, slug, name, asset, price, period_days, entitlements, active, created_at, updated_at`
+
+const subscriptionColumns = `
+	id, user_id, plan_id, status, period_start, period_end, cancel_at_period_end, failed_attempts,
+	renews_at, periods, ended_at, created_at, updated_at
+`
+
+// liveSubscriptionStatuses are matched with IN (?, ?, ?)
+var liveSubscriptionStatuses = []interface{}{models.SubscriptionPending, models.SubscriptionActive, models.SubscriptionPastDue}
+
+// ListPlans returns the plans open to new subscribers, cheapest first
+func (r *subscriptionRepository) ListPlans(ctx context.Context) ([]*models.SubscriptionPlan, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+subscriptionPlanColumns+`
+		FROM subscription_plans
+		WHERE active = 1
+		ORDER BY price, id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subscription plans: %w", err)
+	}
+	defer rows.Close()
+
+	var plans []*models.SubscriptionPlan
+	for rows.Next() {
+		plan, err := scanSubscriptionPlan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan subscription plan: %w", err)
+		}
+		plans = append(plans, plan)
+	}
+
+	return plans, rows.Err()
+}
+
+// GetPlan returns a plan whether or not it is still sold, nil if it does not exist
+func (r *subscriptionRepository) GetPlan(ctx context.Context, planID uint64) (*models.SubscriptionPlan, error) {
+	plan, err := scanSubscriptionPlan(r.db.QueryRowContext(ctx, `SELECT `+subscriptionPlanColumns+` FROM subscription_plans WHERE id = ?`, planID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription plan: %w", err)
+	}
+	return plan, nil
+}
+
+// Create stores a pending subscription. A user has at most one live
+// subscription; locking their live rows keeps two requests from both
+// creating one.
+func (r *subscriptionRepository) Create(ctx context.Context, subscription *models.Subscription) (*models.Subscription, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	args := append([]interface{}{subscription.UserID}, liveSubscriptionStatuses...)
+	var existing uint64
+	err = tx.QueryRowContext(ctx, `
+		SELECT id FROM subscriptions WHERE user_id = ? AND status IN (?, ?, ?) LIMIT 1 FOR UPDATE
+	`, args...).Scan(&existing)
+	if err == nil {
+		return nil, ErrSubscriptionExists
+	}
+	if err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to check subscriptions: %w", err)
+	}
+
+	now := time.Now()
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO subscriptions
+			(user_id, plan_id, status, period_start, period_end, cancel_at_period_end, failed_attempts,
+			 renews_at, periods, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, 0, 0, ?, 0, ?, ?)
+	`, subscription.UserID, subscription.PlanID, models.SubscriptionPending, now, now, subscription.RenewsAt, now, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create subscription: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit subscription: %w", err)
+	}
+
+	subscription.ID = uint64(id)
+	subscription.Status = models.SubscriptionPending
+	subscription.PeriodStart = now
+	subscription.PeriodEnd = now
+	subscription.CreatedAt = now
+	subscription.UpdatedAt = now
+	return subscription, nil
+}
+
+// GetByID returns a subscription with its plan, nil if it does not exist
+func (r *subscriptionRepository) GetByID(ctx context.Context, subscriptionID uint64) (*models.Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + ` FROM subscriptions WHERE id = ?`
+	return r.getWithPlan(ctx, query, subscriptionID)
+}
+
+// GetLatestForUser returns the user's newest subscription with its plan,
+// nil if they never subscribed
+func (r *subscriptionRepository) GetLatestForUser(ctx context.Context, userID uint64) (*models.Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + ` FROM subscriptions WHERE user_id = ? ORDER BY id DESC LIMIT 1`
+	return r.getWithPlan(ctx, query, userID)
+}
+
+func (r *subscriptionRepository) getWithPlan(ctx context.Context, query string, args ...interface{}) (*models.Subscription, error) {
+	subscription, err := scanSubscription(r.db.QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	subscription.Plan, err = r.GetPlan(ctx, subscription.PlanID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription.Plan == nil {
+		return nil, fmt.Errorf("subscription %d: plan %d not found", subscription.ID, subscription.PlanID)
+	}
+	return subscription, nil
+}
+
+// ListDueIDs returns the active and past due subscriptions due for a charge
+// by now, and every pending subscription: those are charged as soon as the
+// wallet covers the first period
+func (r *subscriptionRepository) ListDueIDs(ctx context.Context, now time.Time) ([]uint64, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id
+		FROM subscriptions
+		WHERE (status IN (?, ?) AND renews_at <= ?) OR status = ?
+		ORDER BY id
+	`, models.SubscriptionActive, models.SubscriptionPastDue, now, models.SubscriptionPending)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uint64
+	for rows.Next() {
+		var id uint64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan subscription id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// ChargePeriod charges one period of the plan from the user's wallet and
+// starts it. The first period starts now; renewals continue from the end of
+// the previous period, also after retries, so the dates stay anchored.
+func (r *subscriptionRepository) ChargePeriod(ctx context.Context, subscriptionID uint64, now time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Locking the subscription keeps the worker and a payment from charging the same period
+	subscription, err := scanSubscription(tx.QueryRowContext(ctx, `SELECT `+subscriptionColumns+` FROM subscriptions WHERE id = ? FOR UPDATE`, subscriptionID))
+	if err == sql.ErrNoRows || (err == nil && !subscription.Live()) {
+		return ErrSubscriptionNotLive
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock subscription: %w", err)
+	}
+
+	plan, err := scanSubscriptionPlan(tx.QueryRowContext(ctx, `SELECT `+subscriptionPlanColumns+` FROM subscription_plans WHERE id = ?`, subscription.PlanID))
+	if err != nil {
+		return fmt.Errorf("failed to get subscription plan: %w", err)
+	}
+
+	start := subscription.PeriodEnd
+	if subscription.Periods == 0 {
+		start = now
+	}
+	end := start.Add(plan.Period())
+	sequence := subscription.Periods + 1
+
+	if plan.Price.IsPositive() {
+		// asset is one of the wallet columns, checked when the plan is scanned
+		result, err := tx.ExecContext(ctx, fmt.Sprintf(`
+			UPDATE wallets SET %s = %s - ?, updated_at = ? WHERE user_id = ? AND %s >= ?
+		`, plan.Asset, plan.Asset, plan.Asset), plan.Price.String(), now, subscription.UserID, plan.Price.String())
+		if err != nil {
+			return fmt.Errorf("failed to charge subscription: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return ErrSubscriptionInsufficientBalance
+		}
+
+		transactionID := fmt.Sprintf("TR-SUB-%d-%d", subscription.ID, sequence)
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO transactions (id, user_id, asset, amount, action, status, payable_type, payable_id, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		`, transactionID, subscription.UserID, plan.Asset, plan.Price, "withdraw", 1, models.SubscriptionPayableType, subscription.ID, now, now); err != nil {
+			return fmt.Errorf("failed to create subscription transaction: %w", err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE subscriptions
+		SET status = ?, period_start = ?, period_end = ?, failed_attempts = 0, renews_at = ?, periods = ?, updated_at = ?
+		WHERE id = ?
+	`, models.SubscriptionActive, start, end, end, sequence, now, subscriptionID); err != nil {
+		return fmt.Errorf("failed to renew subscription: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit subscription charge: %w", err)
+	}
+	return nil
+}
+
+// RecordFailedCharge marks a subscription past due until the retry at retryAt
+func (r *subscriptionRepository) RecordFailedCharge(ctx context.Context, subscriptionID uint64, failedAttempts int32, retryAt time.Time) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE subscriptions
+		SET status = ?, failed_attempts = ?, renews_at = ?, updated_at = ?
+		WHERE id = ? AND status IN (?, ?)
+	`, models.SubscriptionPastDue, failedAttempts, retryAt, time.Now(), subscriptionID, models.SubscriptionActive, models.SubscriptionPastDue)
+	if err != nil {
+		return fmt.Errorf("failed to record subscription charge: %w", err)
+	}
+	return requireSubscriptionRow(result)
+}
+
+// SetCancelAtPeriodEnd sets whether an active subscription ends instead of
+// renewing
+func (r *subscriptionRepository) SetCancelAtPeriodEnd(ctx context.Context, subscriptionID uint64, cancel bool) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE subscriptions SET cancel_at_period_end = ?, updated_at = ? WHERE id = ? AND status = ?
+	`, cancel, time.Now(), subscriptionID, models.SubscriptionActive)
+	if err != nil {
+		return fmt.Errorf("failed to cancel subscription: %w", err)
+	}
+	return requireSubscriptionRow(result)
+}
+
+// End closes a live subscription as canceled or expired
+func (r *subscriptionRepository) End(ctx context.Context, subscriptionID uint64, status string, now time.Time) error {
+	args := append([]interface{}{status, now, now, subscriptionID}, liveSubscriptionStatuses...)
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE subscriptions
+		SET status = ?, renews_at = NULL, ended_at = ?, updated_at = ?
+		WHERE id = ? AND status IN (?, ?, ?)
+	`, args...)
+	if err != nil {
+		return fmt.Errorf("failed to end subscription: %w", err)
+	}
+	return requireSubscriptionRow(result)
+}
+
+// requireSubscriptionRow turns an update that matched no live subscription
+// into ErrSubscriptionNotLive
+func requireSubscriptionRow(result sql.Result) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrSubscriptionNotLive
+	}
+	return nil
+}
+
+type subscriptionScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSubscription(s subscriptionScanner) (*models.Subscription, error) {
+	subscription := &models.Subscription{}
+	err := s.Scan(
+		&subscription.ID, &subscription.UserID, &subscription.PlanID, &subscription.Status,
+		&subscription.PeriodStart, &subscription.PeriodEnd, &subscription.CancelAtPeriodEnd,
+		&subscription.FailedAttempts, &subscription.RenewsAt, &subscription.Periods,
+		&subscription.EndedAt, &subscription.CreatedAt, &subscription.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// scanSubscriptionPlan reads a plan, rejecting assets that are not wallet
+// columns as the asset is written into the charge query
+func scanSubscriptionPlan(s subscriptionScanner) (*models.SubscriptionPlan, error) {
+	plan := &models.SubscriptionPlan{}
+	var price string
+	var entitlements sql.NullString
+	err := s.Scan(
+		&plan.ID, &plan.Slug, &plan.Name, &plan.Asset, &price, &plan.PeriodDays,
+		&entitlements, &plan.Active, &plan.CreatedAt, &plan.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if plan.Asset != "psc" && plan.Asset != "irr" {
+		return nil, fmt.Errorf("subscription plan %d: unsupported asset %q", plan.ID, plan.Asset)
+	}
+	if plan.Price, err = decimal.NewFromString(price); err != nil {
+		return nil, fmt.Errorf("subscription plan %d: invalid price %q: %w", plan.ID, price, err)
+	}
+	if entitlements.Valid && strings.TrimSpace(entitlements.String) != "" {
+		if err := json.Unmarshal([]byte(entitlements.String), &plan.Entitlements); err != nil {
+			return nil, fmt.Errorf("subscription plan %d: invalid entitlements: %w", plan.ID, err)
+		}
+	}
+	return plan, nil
+}
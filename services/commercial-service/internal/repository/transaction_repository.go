@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"metargb/commercial-service/internal/models"
@@ -14,7 +15,9 @@ type TransactionRepository interface {
 	Update(ctx context.Context, transaction *models.Transaction) error
 	FindByID(ctx context.Context, id string) (*models.Transaction, error)
 	FindLatestByUserID(ctx context.Context, userID uint64) (*models.Transaction, error)
-	FindByUserID(ctx context.Context, userID uint64, filters map[string]interface{}) ([]*models.Transaction, error)
+	// ListByUserID returns up to filter.PerPage+1 transactions, so callers can
+	// tell whether another page follows
+	ListByUserID(ctx context.Context, userID uint64, filter models.TransactionFilter) ([]*models.Transaction, error)
 }
 
 type transactionRepository struct {
@@ -115,7 +118,7 @@ func (r *transactionRepository) FindLatestByUserID(ctx context.Context, userID u
 	return transaction, nil
 }
 
-func (r *transactionRepository) FindByUserID(ctx context.Context, userID uint64, filters map[string]interface{}) ([]*models.Transaction, error) {
+func (r *transactionRepository) ListByUserID(ctx context.Context, userID uint64, filter models.TransactionFilter) ([]*models.Transaction, error) {
 	query := `
 		SELECT id, user_id, asset, amount, action, status, token, ref_id, payable_type, payable_id, created_at, updated_at
 		FROM transactions
@@ -123,20 +126,56 @@ func (r *transactionRepository) FindByUserID(ctx context.Context, userID uint64,
 	`
 	args := []interface{}{userID}
 
-	// Add filters (simplified for now)
-	if asset, ok := filters["asset"].(string); ok && asset != "" {
+	if filter.Asset != "" {
 		query += " AND asset = ?"
-		args = append(args, asset)
+		args = append(args, filter.Asset)
 	}
-	if action, ok := filters["action"].(string); ok && action != "" {
+	if filter.Action != "" {
 		query += " AND action = ?"
-		args = append(args, action)
+		args = append(args, filter.Action)
+	}
+	if filter.PayableType != "" {
+		query += " AND payable_type = ?"
+		args = append(args, filter.PayableType)
+	}
+	if len(filter.Statuses) > 0 {
+		placeholders := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			placeholders[i] = "?"
+			args = append(args, status)
+		}
+		query += " AND status IN (" + strings.Join(placeholders, ", ") + ")"
+	}
+	if filter.Search != "" {
+		query += " AND id LIKE ?"
+		args = append(args, filter.Search+"%")
+	}
+	if filter.MinAmount != nil {
+		query += " AND amount >= ?"
+		args = append(args, *filter.MinAmount)
+	}
+	if filter.MaxAmount != nil {
+		query += " AND amount <= ?"
+		args = append(args, *filter.MaxAmount)
+	}
+	if filter.From != nil {
+		query += " AND created_at >= ?"
+		args = append(args, *filter.From)
+	}
+	if filter.To != nil {
+		query += " AND created_at < ?"
+		args = append(args, *filter.To)
+	}
+	if filter.After != nil {
+		query += " AND (created_at < ? OR (created_at = ? AND id < ?))"
+		args = append(args, filter.After.CreatedAt, filter.After.CreatedAt, filter.After.ID)
 	}
 
-	query += " ORDER BY created_at DESC"
-
-	if limit, ok := filters["limit"].(int); ok && limit > 0 {
-		query += fmt.Sprintf(" LIMIT %d", limit)
+	query += " ORDER BY created_at DESC, id DESC LIMIT ?"
+	args = append(args, filter.PerPage+1)
+	if filter.After == nil {
+		query += " OFFSET ?"
+		args = append(args, (filter.Page-1)*filter.PerPage)
 	}
 
 	rows, err := r.db.QueryContext(ctx, query, args...)
@@ -3,12 +3,23 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
 )
 
 type VariableRepository interface {
 	GetRate(ctx context.Context, key string) (float64, error)
 	GetAllRates(ctx context.Context) (map[string]float64, error)
+	GetByPrefix(ctx context.Context, prefix string) (map[string]float64, error)
+	GetMany(ctx context.Context, keys []string) (map[string]float64, error)
+	GetValue(ctx context.Context, def models.VariableDefinition) (*models.VariableValue, error)
+	SetValue(ctx context.Context, def models.VariableDefinition, value decimal.Decimal, changerID uint64, note string) (*models.VariableValue, error)
+	ListChanges(ctx context.Context, def models.VariableDefinition, limit int) ([]*models.VariableChange, error)
 }
 
 type variableRepository struct {
@@ -71,3 +82,229 @@ func (r *variableRepository) GetAllRates(ctx context.Context) (map[string]float6
 
 	return rates, nil
 }
+
+// GetByPrefix retrieves every variable whose key starts with prefix
+func (r *variableRepository) GetByPrefix(ctx context.Context, prefix string) (map[string]float64, error) {
+	query := `
+		SELECT ` + "`key`" + `, value
+		FROM variables
+		WHERE ` + "`key`" + ` LIKE ?
+	`
+
+	pattern := strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(prefix) + "%"
+	rows, err := r.db.QueryContext(ctx, query, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get variables: %w", err)
+	}
+	defer rows.Close()
+
+	values := make(map[string]float64)
+	for rows.Next() {
+		var key string
+		var value float64
+		if err := rows.Scan(&key, &value); err != nil {
+			return nil, fmt.Errorf("failed to scan variable: %w", err)
+		}
+		values[key] = value
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return values, nil
+}
+
+// GetMany retrieves the variables with the given keys; missing keys are omitted
+func (r *variableRepository) GetMany(ctx context.Context, keys []string) (map[string]float64, error) {
+	values := make(map[string]float64)
+	if len(keys) == 0 {
+		return values, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(keys)), ",")
+	query := `
+		SELECT ` + "`key`" + `, value
+		FROM variables
+		WHERE ` + "`key`" + ` IN (` + placeholders + `)
+	`
+
+	args := make([]interface{}, len(keys))
+	for i, key := range keys {
+		args[i] = key
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get variables: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key string
+		var value float64
+		if err := rows.Scan(&key, &value); err != nil {
+			return nil, fmt.Errorf("failed to scan variable: %w", err)
+		}
+		values[key] = value
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return values, nil
+}
+
+// variableColumns returns the key column and morph class of a variable table
+func variableColumns(table string) (string, string, error) {
+	switch table {
+	case models.VariablesTable:
+		return "`key`", models.VariableChangeableType, nil
+	case models.SystemVariablesTable:
+		return "slug", models.SystemVariableChangeableType, nil
+	}
+	return "", "", fmt.Errorf("unknown variable table: %s", table)
+}
+
+// GetValue reads the current value of a defined variable. An unset variable
+// is returned with IsSet false.
+func (r *variableRepository) GetValue(ctx context.Context, def models.VariableDefinition) (*models.VariableValue, error) {
+	keyColumn, _, err := variableColumns(def.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT id, value, updated_at FROM ` + def.Table + ` WHERE ` + keyColumn + ` = ? LIMIT 1`
+
+	variable := &models.VariableValue{Definition: def}
+	var value string
+	var updatedAt sql.NullTime
+	err = r.db.QueryRowContext(ctx, query, def.Key).Scan(&variable.ID, &value, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return variable, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get variable: %w", err)
+	}
+
+	variable.Value, err = decimal.NewFromString(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse variable %s: %w", def.Key, err)
+	}
+	variable.IsSet = true
+	variable.UpdatedAt = updatedAt.Time
+	return variable, nil
+}
+
+// SetValue stores a variable's new value and logs the change with the
+// changer's id and name in one transaction
+func (r *variableRepository) SetValue(ctx context.Context, def models.VariableDefinition, value decimal.Decimal, changerID uint64, note string) (*models.VariableValue, error) {
+	keyColumn, changeableType, err := variableColumns(def.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var id uint64
+	previous := "0"
+	err = tx.QueryRowContext(ctx,
+		`SELECT id, value FROM `+def.Table+` WHERE `+keyColumn+` = ? LIMIT 1 FOR UPDATE`,
+		def.Key,
+	).Scan(&id, &previous)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		var result sql.Result
+		if def.Table == models.SystemVariablesTable {
+			result, err = tx.ExecContext(ctx,
+				`INSERT INTO system_variables (name, slug, value, created_at, updated_at) VALUES (?, ?, ?, NOW(), NOW())`,
+				def.Description, def.Key, value.String(),
+			)
+		} else {
+			result, err = tx.ExecContext(ctx,
+				"INSERT INTO variables (`key`, value, created_at, updated_at) VALUES (?, ?, NOW(), NOW())",
+				def.Key, value.String(),
+			)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to create variable: %w", err)
+		}
+		insertID, err := result.LastInsertId()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get variable id: %w", err)
+		}
+		id = uint64(insertID)
+	case err != nil:
+		return nil, fmt.Errorf("failed to lock variable: %w", err)
+	default:
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE `+def.Table+` SET value = ?, updated_at = NOW() WHERE id = ?`,
+			value.String(), id,
+		); err != nil {
+			return nil, fmt.Errorf("failed to update variable: %w", err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO variable_change_logs
+			(changer_id, changer_name, previous_value, current_value, note, changeable_type, changeable_id, created_at, updated_at)
+		VALUES (?, COALESCE((SELECT name FROM users WHERE id = ?), ''), ?, ?, NULLIF(?, ''), ?, ?, NOW(), NOW())
+	`, changerID, changerID, previous, value.String(), note, changeableType, id); err != nil {
+		return nil, fmt.Errorf("failed to log variable change: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit variable change: %w", err)
+	}
+
+	return r.GetValue(ctx, def)
+}
+
+// ListChanges returns the latest changes of a variable, newest first
+func (r *variableRepository) ListChanges(ctx context.Context, def models.VariableDefinition, limit int) ([]*models.VariableChange, error) {
+	keyColumn, changeableType, err := variableColumns(def.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT l.id, l.previous_value, l.current_value, COALESCE(l.changer_id, 0), l.changer_name,
+		       COALESCE(l.note, ''), l.created_at
+		FROM variable_change_logs l
+		INNER JOIN ` + def.Table + ` v ON v.id = l.changeable_id
+		WHERE l.changeable_type = ? AND v.` + keyColumn + ` = ?
+		ORDER BY l.id DESC
+		LIMIT ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, changeableType, def.Key, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list variable changes: %w", err)
+	}
+	defer rows.Close()
+
+	changes := []*models.VariableChange{}
+	for rows.Next() {
+		change := &models.VariableChange{Key: def.Key}
+		var createdAt sql.NullTime
+		if err := rows.Scan(
+			&change.ID, &change.PreviousValue, &change.CurrentValue, &change.ChangerID,
+			&change.ChangerName, &change.Note, &createdAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan variable change: %w", err)
+		}
+		change.CreatedAt = createdAt.Time
+		changes = append(changes, change)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,300 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+)
+
+var (
+	// ErrAdjustmentBatchNotPending is returned when a batch was already executed or rejected
+	ErrAdjustmentBatchNotPending = errors.New("adjustment batch is not pending")
+	// ErrAdjustmentWalletNotFound is returned when an entry's user has no wallet
+	ErrAdjustmentWalletNotFound = errors.New("wallet not found")
+	// ErrAdjustmentInsufficientBalance is returned when a debit exceeds the wallet balance
+	ErrAdjustmentInsufficientBalance = errors.New("insufficient balance")
+)
+
+type WalletAdjustmentRepository interface {
+	Create(ctx context.Context, batch *models.AdjustmentBatch) (*models.AdjustmentBatch, error)
+	GetByID(ctx context.Context, batchID uint64) (*models.AdjustmentBatch, error)
+	List(ctx context.Context, status string) ([]*models.AdjustmentBatch, error)
+	Execute(ctx context.Context, batchID, approverID uint64) error
+	Reject(ctx context.Context, batchID, adminID uint64, note string) error
+}
+
+type walletAdjustmentRepository struct {
+	db *sql.DB
+}
+
+func NewWalletAdjustmentRepository(db *sql.DB) WalletAdjustmentRepository {
+	return &walletAdjustmentRepository{db: db}
+}
+
+func (r *walletAdjustmentRepository) Create(ctx context.Context, batch *models.AdjustmentBatch) (*models.AdjustmentBatch, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO wallet_adjustment_batches (reason, status, created_by, entry_count, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, batch.Reason, models.AdjustmentBatchPending, batch.CreatedBy, len(batch.Entries), now, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create adjustment batch: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+	batch.ID = uint64(id)
+
+	entryQuery := `
+		INSERT INTO wallet_adjustment_entries (batch_id, line, user_id, asset, amount, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+	for _, entry := range batch.Entries {
+		if _, err := tx.ExecContext(ctx, entryQuery,
+			batch.ID, entry.Line, entry.UserID, entry.Asset, entry.Amount.String(), now, now); err != nil {
+			return nil, fmt.Errorf("failed to create adjustment entry: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit adjustment batch: %w", err)
+	}
+
+	batch.Status = models.AdjustmentBatchPending
+	batch.EntryCount = int32(len(batch.Entries))
+	batch.CreatedAt = now
+	batch.UpdatedAt = now
+	return batch, nil
+}
+
+func (r *walletAdjustmentRepository) GetByID(ctx context.Context, batchID uint64) (*models.AdjustmentBatch, error) {
+	query := `
+		SELECT id, reason, status, created_by, decided_by, decision_note, entry_count, decided_at, created_at, updated_at
+		FROM wallet_adjustment_batches
+		WHERE id = ?
+	`
+
+	batch, err := scanAdjustmentBatch(r.db.QueryRowContext(ctx, query, batchID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjustment batch: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, batch_id, line, user_id, asset, amount, transaction_id
+		FROM wallet_adjustment_entries
+		WHERE batch_id = ?
+		ORDER BY line
+	`, batchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjustment entries: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		entry := &models.AdjustmentEntry{}
+		var amount string
+		if err := rows.Scan(&entry.ID, &entry.BatchID, &entry.Line, &entry.UserID, &entry.Asset, &amount, &entry.TransactionID); err != nil {
+			return nil, fmt.Errorf("failed to scan adjustment entry: %w", err)
+		}
+		entry.Amount, err = decimal.NewFromString(amount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse adjustment amount: %w", err)
+		}
+		batch.Entries = append(batch.Entries, entry)
+	}
+
+	return batch, rows.Err()
+}
+
+func (r *walletAdjustmentRepository) List(ctx context.Context, status string) ([]*models.AdjustmentBatch, error) {
+	query := `
+		SELECT id, reason, status, created_by, decided_by, decision_note, entry_count, decided_at, created_at, updated_at
+		FROM wallet_adjustment_batches
+	`
+	var args []interface{}
+	if status != "" {
+		query += ` WHERE status = ?`
+		args = append(args, status)
+	}
+	query += ` ORDER BY id DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list adjustment batches: %w", err)
+	}
+	defer rows.Close()
+
+	var batches []*models.AdjustmentBatch
+	for rows.Next() {
+		batch, err := scanAdjustmentBatch(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan adjustment batch: %w", err)
+		}
+		batches = append(batches, batch)
+	}
+
+	return batches, rows.Err()
+}
+
+// Execute applies every entry of a pending batch to the wallets and records a
+// transaction per entry. Either all entries are applied or none are.
+func (r *walletAdjustmentRepository) Execute(ctx context.Context, batchID, approverID uint64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+
+	// Claiming the batch first makes concurrent approvals fail instead of applying it twice
+	result, err := tx.ExecContext(ctx, `
+		UPDATE wallet_adjustment_batches
+		SET status = ?, decided_by = ?, decided_at = ?, updated_at = ?
+		WHERE id = ? AND status = ?
+	`, models.AdjustmentBatchExecuted, approverID, now, now, batchID, models.AdjustmentBatchPending)
+	if err != nil {
+		return fmt.Errorf("failed to claim adjustment batch: %w", err)
+	}
+	if rowsAffected, err := result.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	} else if rowsAffected == 0 {
+		return ErrAdjustmentBatchNotPending
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id, line, user_id, asset, amount
+		FROM wallet_adjustment_entries
+		WHERE batch_id = ?
+		ORDER BY line
+	`, batchID)
+	if err != nil {
+		return fmt.Errorf("failed to get adjustment entries: %w", err)
+	}
+	var entries []*models.AdjustmentEntry
+	for rows.Next() {
+		entry := &models.AdjustmentEntry{}
+		var amount string
+		if err := rows.Scan(&entry.ID, &entry.Line, &entry.UserID, &entry.Asset, &amount); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan adjustment entry: %w", err)
+		}
+		if entry.Amount, err = decimal.NewFromString(amount); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to parse adjustment amount: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to get adjustment entries: %w", err)
+	}
+
+	payableType := models.AdjustmentBatchPayableType
+	for _, entry := range entries {
+		amount := entry.Amount.Abs()
+		action := "deposit"
+		// asset is validated against the wallet columns when the batch is created
+		query := fmt.Sprintf(`UPDATE wallets SET %s = %s + ?, updated_at = ? WHERE user_id = ?`, entry.Asset, entry.Asset)
+		args := []interface{}{amount.String(), now, entry.UserID}
+		if entry.Amount.IsNegative() {
+			action = "withdraw"
+			query = fmt.Sprintf(`UPDATE wallets SET %s = %s - ?, updated_at = ? WHERE user_id = ? AND %s >= ?`, entry.Asset, entry.Asset, entry.Asset)
+			args = append(args, amount.String())
+		}
+
+		result, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to adjust wallet on line %d: %w", entry.Line, err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			if entry.Amount.IsNegative() {
+				var exists bool
+				if err := tx.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM wallets WHERE user_id = ?)`, entry.UserID).Scan(&exists); err != nil {
+					return fmt.Errorf("failed to check wallet: %w", err)
+				}
+				if exists {
+					return fmt.Errorf("line %d: user %d: %w", entry.Line, entry.UserID, ErrAdjustmentInsufficientBalance)
+				}
+			}
+			return fmt.Errorf("line %d: user %d: %w", entry.Line, entry.UserID, ErrAdjustmentWalletNotFound)
+		}
+
+		transactionID := fmt.Sprintf("TR-ADJ-%d", entry.ID)
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO transactions (id, user_id, asset, amount, action, status, payable_type, payable_id, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		`, transactionID, entry.UserID, entry.Asset, amount, action, 1, payableType, batchID, now, now); err != nil {
+			return fmt.Errorf("failed to create adjustment transaction: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE wallet_adjustment_entries SET transaction_id = ?, updated_at = ? WHERE id = ?
+		`, transactionID, now, entry.ID); err != nil {
+			return fmt.Errorf("failed to update adjustment entry: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit adjustment batch: %w", err)
+	}
+	return nil
+}
+
+func (r *walletAdjustmentRepository) Reject(ctx context.Context, batchID, adminID uint64, note string) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE wallet_adjustment_batches
+		SET status = ?, decided_by = ?, decision_note = ?, decided_at = NOW(), updated_at = NOW()
+		WHERE id = ? AND status = ?
+	`, models.AdjustmentBatchRejected, adminID, note, batchID, models.AdjustmentBatchPending)
+	if err != nil {
+		return fmt.Errorf("failed to reject adjustment batch: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrAdjustmentBatchNotPending
+	}
+
+	return nil
+}
+
+type adjustmentBatchScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAdjustmentBatch(s adjustmentBatchScanner) (*models.AdjustmentBatch, error) {
+	batch := &models.AdjustmentBatch{}
+	err := s.Scan(
+		&batch.ID, &batch.Reason, &batch.Status, &batch.CreatedBy, &batch.DecidedBy, &batch.DecisionNote,
+		&batch.EntryCount, &batch.DecidedAt, &batch.CreatedAt, &batch.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+)
+
+// exchangeableAssets are the color wallet columns that can be converted
+var exchangeableAssets = map[string]bool{
+	"red": true, "blue": true, "yellow": true,
+}
+
+var (
+	ErrExchangeNotAdmin      = errors.New("unauthorized: only wallet admins can set exchange rates")
+	ErrExchangeInvalidAsset  = errors.New("assets must be two different colors of red, blue, yellow")
+	ErrExchangeInvalidRate   = errors.New("rate must be a positive number with at most 10 decimal places")
+	ErrExchangeInvalidFee    = errors.New("fee_percent must be between 0 and 100 with at most 2 decimal places")
+	ErrExchangeInvalidAmount = errors.New("amount must be a positive number with at most 10 decimal places")
+	ErrExchangeInvalidMin    = errors.New("min_receive must be a non-negative number")
+)
+
+type ExchangeService interface {
+	ListRates(ctx context.Context, userID uint64, includeDisabled bool) ([]*models.ExchangeRate, error)
+	SetRate(ctx context.Context, adminID uint64, fromAsset, toAsset, rate, feePercent string, enabled bool) (*models.ExchangeRate, error)
+	Convert(ctx context.Context, userID uint64, fromAsset, toAsset, amount, minReceive string) (*models.Conversion, error)
+}
+
+type exchangeService struct {
+	exchangeRepo repository.ExchangeRepository
+	admins       map[uint64]bool
+}
+
+// NewExchangeService creates the exchange service. Only adminIDs can set
+// rates or see disabled ones.
+func NewExchangeService(exchangeRepo repository.ExchangeRepository, adminIDs []uint64) ExchangeService {
+	admins := make(map[uint64]bool, len(adminIDs))
+	for _, id := range adminIDs {
+		admins[id] = true
+	}
+	return &exchangeService{
+		exchangeRepo: exchangeRepo,
+		admins:       admins,
+	}
+}
+
+func (s *exchangeService) ListRates(ctx context.Context, userID uint64, includeDisabled bool) ([]*models.ExchangeRate, error) {
+	return s.exchangeRepo.ListRates(ctx, includeDisabled && s.admins[userID])
+}
+
+func (s *exchangeService) SetRate(ctx context.Context, adminID uint64, fromAsset, toAsset, rate, feePercent string, enabled bool) (*models.ExchangeRate, error) {
+	if !s.admins[adminID] {
+		return nil, ErrExchangeNotAdmin
+	}
+	fromAsset, toAsset, err := parseExchangePair(fromAsset, toAsset)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedRate, err := decimal.NewFromString(strings.TrimSpace(rate))
+	if err != nil || !parsedRate.IsPositive() || parsedRate.Exponent() < -10 {
+		return nil, ErrExchangeInvalidRate
+	}
+	parsedFee := decimal.Zero
+	if strings.TrimSpace(feePercent) != "" {
+		parsedFee, err = decimal.NewFromString(strings.TrimSpace(feePercent))
+		if err != nil || parsedFee.IsNegative() || parsedFee.GreaterThanOrEqual(decimal.NewFromInt(100)) || parsedFee.Exponent() < -2 {
+			return nil, ErrExchangeInvalidFee
+		}
+	}
+
+	if err := s.exchangeRepo.SaveRate(ctx, &models.ExchangeRate{
+		FromAsset:  fromAsset,
+		ToAsset:    toAsset,
+		Rate:       parsedRate,
+		FeePercent: parsedFee,
+		Enabled:    enabled,
+		UpdatedBy:  adminID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.exchangeRepo.GetRate(ctx, fromAsset, toAsset)
+}
+
+// Convert exchanges amount of fromAsset for toAsset in the user's wallet.
+// An empty minReceive accepts any payout.
+func (s *exchangeService) Convert(ctx context.Context, userID uint64, fromAsset, toAsset, amount, minReceive string) (*models.Conversion, error) {
+	fromAsset, toAsset, err := parseExchangePair(fromAsset, toAsset)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedAmount, err := decimal.NewFromString(strings.TrimSpace(amount))
+	if err != nil || !parsedAmount.IsPositive() || parsedAmount.Exponent() < -10 {
+		return nil, ErrExchangeInvalidAmount
+	}
+	parsedMin := decimal.Zero
+	if strings.TrimSpace(minReceive) != "" {
+		parsedMin, err = decimal.NewFromString(strings.TrimSpace(minReceive))
+		if err != nil || parsedMin.IsNegative() {
+			return nil, ErrExchangeInvalidMin
+		}
+	}
+
+	return s.exchangeRepo.Convert(ctx, userID, fromAsset, toAsset, parsedAmount, parsedMin)
+}
+
+func parseExchangePair(fromAsset, toAsset string) (string, string, error) {
+	fromAsset = strings.ToLower(strings.TrimSpace(fromAsset))
+	toAsset = strings.ToLower(strings.TrimSpace(toAsset))
+	if !exchangeableAssets[fromAsset] || !exchangeableAssets[toAsset] || fromAsset == toAsset {
+		return "", "", ErrExchangeInvalidAsset
+	}
+	return fromAsset, toAsset, nil
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+)
+
+type fakeExchangeRepository struct {
+	rate            *models.ExchangeRate
+	includeDisabled bool
+	converted       decimal.Decimal
+	minReceive      decimal.Decimal
+}
+
+func (r *fakeExchangeRepository) ListRates(_ context.Context, includeDisabled bool) ([]*models.ExchangeRate, error) {
+	r.includeDisabled = includeDisabled
+	return []*models.ExchangeRate{r.rate}, nil
+}
+
+func (r *fakeExchangeRepository) GetRate(context.Context, string, string) (*models.ExchangeRate, error) {
+	return r.rate, nil
+}
+
+func (r *fakeExchangeRepository) SaveRate(_ context.Context, rate *models.ExchangeRate) error {
+	r.rate = rate
+	return nil
+}
+
+func (r *fakeExchangeRepository) Convert(_ context.Context, userID uint64, fromAsset, toAsset string, amount, minReceive decimal.Decimal) (*models.Conversion, error) {
+	r.converted, r.minReceive = amount, minReceive
+	return &models.Conversion{UserID: userID, FromAsset: fromAsset, ToAsset: toAsset, Amount: amount}, nil
+}
+
+func TestExchangeSetRate(t *testing.T) {
+	repo := &fakeExchangeRepository{}
+	svc := NewExchangeService(repo, []uint64{1})
+
+	if _, err := svc.SetRate(context.Background(), 2, "red", "blue", "2", "1", true); !errors.Is(err, ErrExchangeNotAdmin) {
+		t.Fatalf("expected ErrExchangeNotAdmin, got %v", err)
+	}
+
+	rate, err := svc.SetRate(context.Background(), 1, " RED ", "blue", "2.5", "1.25", true)
+	if err != nil {
+		t.Fatalf("SetRate returned error: %v", err)
+	}
+	if rate.FromAsset != "red" || rate.ToAsset != "blue" || rate.Rate.String() != "2.5" || rate.FeePercent.String() != "1.25" || rate.UpdatedBy != 1 {
+		t.Errorf("unexpected rate %+v", rate)
+	}
+
+	invalid := []struct {
+		from, to, rate, fee string
+		want                error
+	}{
+		{"red", "red", "1", "0", ErrExchangeInvalidAsset},
+		{"red", "psc", "1", "0", ErrExchangeInvalidAsset},
+		{"red", "blue", "0", "0", ErrExchangeInvalidRate},
+		{"red", "blue", "abc", "0", ErrExchangeInvalidRate},
+		{"red", "blue", "0.00000000001", "0", ErrExchangeInvalidRate},
+		{"red", "blue", "1", "100", ErrExchangeInvalidFee},
+		{"red", "blue", "1", "-1", ErrExchangeInvalidFee},
+		{"red", "blue", "1", "0.125", ErrExchangeInvalidFee},
+	}
+	for _, tc := range invalid {
+		if _, err := svc.SetRate(context.Background(), 1, tc.from, tc.to, tc.rate, tc.fee, true); !errors.Is(err, tc.want) {
+			t.Errorf("SetRate(%s, %s, %s, %s) = %v, want %v", tc.from, tc.to, tc.rate, tc.fee, err, tc.want)
+		}
+	}
+}
+
+func TestExchangeListRatesHidesDisabledFromUsers(t *testing.T) {
+	repo := &fakeExchangeRepository{rate: &models.ExchangeRate{}}
+	svc := NewExchangeService(repo, []uint64{1})
+
+	if _, err := svc.ListRates(context.Background(), 2, true); err != nil || repo.includeDisabled {
+		t.Errorf("a user saw disabled rates (err %v)", err)
+	}
+	if _, err := svc.ListRates(context.Background(), 1, true); err != nil || !repo.includeDisabled {
+		t.Errorf("an admin did not see disabled rates (err %v)", err)
+	}
+}
+
+func TestExchangeConvertValidation(t *testing.T) {
+	repo := &fakeExchangeRepository{}
+	svc := NewExchangeService(repo, nil)
+
+	if _, err := svc.Convert(context.Background(), 5, "yellow", "red", "12.5", ""); err != nil {
+		t.Fatalf("Convert returned error: %v", err)
+	}
+	if repo.converted.String() != "12.5" || !repo.minReceive.IsZero() {
+		t.Errorf("repository got amount %s min %s", repo.converted, repo.minReceive)
+	}
+
+	for _, amount := range []string{"", "0", "-1", "abc", "0.00000000001"} {
+		if _, err := svc.Convert(context.Background(), 5, "yellow", "red", amount, ""); !errors.Is(err, ErrExchangeInvalidAmount) {
+			t.Errorf("Convert(amount %q) = %v, want ErrExchangeInvalidAmount", amount, err)
+		}
+	}
+	if _, err := svc.Convert(context.Background(), 5, "yellow", "red", "1", "-2"); !errors.Is(err, ErrExchangeInvalidMin) {
+		t.Errorf("expected ErrExchangeInvalidMin, got %v", err)
+	}
+	if _, err := svc.Convert(context.Background(), 5, "irr", "red", "1", ""); !errors.Is(err, ErrExchangeInvalidAsset) {
+		t.Errorf("expected ErrExchangeInvalidAsset, got %v", err)
+	}
+}
+
+func TestExchangeRateQuote(t *testing.T) {
+	rate := &models.ExchangeRate{Rate: decimal.RequireFromString("3"), FeePercent: decimal.RequireFromString("2.5")}
+	received, fee := rate.Quote(decimal.RequireFromString("10"))
+	if received.String() != "29.25" || fee.String() != "0.75" {
+		t.Errorf("Quote(10) = %s, fee %s; want 29.25, fee 0.75", received, fee)
+	}
+
+	rate = &models.ExchangeRate{Rate: decimal.RequireFromString("0.3333333333"), FeePercent: decimal.RequireFromString("1")}
+	received, fee = rate.Quote(decimal.RequireFromString("1"))
+	if received.Exponent() < -10 || !received.Add(fee).Equal(decimal.RequireFromString("0.3333333333")) {
+		t.Errorf("Quote(1) = %s, fee %s; payout must have 10 decimals and add up to the gross amount", received, fee)
+	}
+}
@@ -0,0 +1,411 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+)
+
+// Defaults of the fraud rules
+const (
+	DefaultFraudVelocityReview   = 5 // Payments or large transfers in the last hour before review
+	DefaultFraudVelocityDeny     = 10
+	DefaultFraudLoginLookback    = 30 * 24 * time.Hour
+	DefaultFraudApprovalTTL      = 24 * time.Hour
+	DefaultFraudLargeTransferPSC = 1000
+	DefaultFraudLargeTransferIRR = 100000000
+)
+
+// Column sizes of the recorded client details
+const (
+	maxFraudIPLength         = 45
+	maxFraudDeviceLength     = 255
+	maxLoginDeviceLength     = 191
+	maxCardPatternLength     = 64
+	minCardPatternCharacters = 4
+)
+
+var (
+	ErrFraudDenied         = errors.New("declined by fraud checks")
+	ErrFraudReview         = errors.New("held for manual review")
+	ErrFraudNotAdmin       = errors.New("unauthorized: only wallet admins can review fraud checks")
+	ErrFraudCheckNotFound  = errors.New("fraud check not found")
+	ErrFraudInvalidStatus  = errors.New("status must be pending, approved or rejected")
+	ErrFraudInvalidPattern = fmt.Errorf("pattern must be at most %d characters with at least %d that are not wildcards", maxCardPatternLength, minCardPatternCharacters)
+)
+
+// FraudCheckInput describes a payment or transfer to check
+type FraudCheckInput struct {
+	UserID uint64
+	Kind   string // models.FraudKindPayment or models.FraudKindTransfer
+	Asset  string
+	Amount decimal.Decimal
+	IP     string // Optional, compared with the user's recent logins
+	Device string
+}
+
+// FraudChecker runs the fraud rules on payments and large transfers
+type FraudChecker interface {
+	// Check returns ErrFraudDenied or ErrFraudReview unless the rules let the
+	// payment or transfer through
+	Check(ctx context.Context, input FraudCheckInput) error
+	// CheckCard returns ErrFraudDenied if the card paying for an order is blocklisted
+	CheckCard(ctx context.Context, userID, orderID uint64, asset string, amount decimal.Decimal, cardPan string) error
+}
+
+// FraudConfig holds the thresholds of the fraud rules
+type FraudConfig struct {
+	VelocityReview   int // Payments or large transfers in the last hour that send the next one to review
+	VelocityDeny     int // and that deny it
+	LoginLookback    time.Duration
+	ApprovalTTL      time.Duration // How long an approved review lets the user retry
+	LargeTransferPSC decimal.Decimal
+	LargeTransferIRR decimal.Decimal
+}
+
+type FraudService interface {
+	FraudChecker
+	RecordLogin(ctx context.Context, userID uint64, ip, device string, at time.Time) error
+	ListReviews(ctx context.Context, adminID uint64, status string) ([]*models.FraudCheck, error)
+	ResolveReview(ctx context.Context, adminID, checkID uint64, approve bool, note string) (*models.FraudCheck, error)
+	ListBlockedCards(ctx context.Context, adminID uint64) ([]*models.BlockedCard, error)
+	BlockCard(ctx context.Context, adminID uint64, pattern, reason string) (*models.BlockedCard, error)
+	UnblockCard(ctx context.Context, adminID, cardID uint64) error
+}
+
+type fraudService struct {
+	fraudRepo repository.FraudRepository
+	admins    map[uint64]bool
+	config    FraudConfig
+	now       func() time.Time
+}
+
+// NewFraudService creates the fraud service. Only adminIDs can work the review
+// queue and the card blocklist. Zero config values fall back to the defaults.
+func NewFraudService(fraudRepo repository.FraudRepository, adminIDs []uint64, config FraudConfig) FraudService {
+	admins := make(map[uint64]bool, len(adminIDs))
+	for _, id := range adminIDs {
+		admins[id] = true
+	}
+	if config.VelocityReview <= 0 {
+		config.VelocityReview = DefaultFraudVelocityReview
+	}
+	if config.VelocityDeny <= 0 {
+		config.VelocityDeny = DefaultFraudVelocityDeny
+	}
+	if config.LoginLookback <= 0 {
+		config.LoginLookback = DefaultFraudLoginLookback
+	}
+	if config.ApprovalTTL <= 0 {
+		config.ApprovalTTL = DefaultFraudApprovalTTL
+	}
+	if !config.LargeTransferPSC.IsPositive() {
+		config.LargeTransferPSC = decimal.NewFromInt(DefaultFraudLargeTransferPSC)
+	}
+	if !config.LargeTransferIRR.IsPositive() {
+		config.LargeTransferIRR = decimal.NewFromInt(DefaultFraudLargeTransferIRR)
+	}
+	return &fraudService{
+		fraudRepo: fraudRepo,
+		admins:    admins,
+		config:    config,
+		now:       time.Now,
+	}
+}
+
+// Check runs the velocity and login rules and records the decision. Transfers
+// below the large transfer threshold of their asset are not checked. A check
+// sent to review is let through if a review of the user was approved for at
+// least the same amount within the approval TTL, once per approval.
+func (s *fraudService) Check(ctx context.Context, input FraudCheckInput) error {
+	if input.Kind == models.FraudKindTransfer && !s.isLargeTransfer(input.Asset, input.Amount) {
+		return nil
+	}
+
+	now := s.now()
+	check := &models.FraudCheck{
+		UserID:   input.UserID,
+		Kind:     input.Kind,
+		Asset:    input.Asset,
+		Amount:   input.Amount,
+		Decision: models.FraudAllow,
+		IP:       truncateString(input.IP, maxFraudIPLength),
+		Device:   truncateString(input.Device, maxFraudDeviceLength),
+	}
+
+	recent, err := s.recentCount(ctx, input, now.Add(-time.Hour))
+	if err != nil {
+		return err
+	}
+	switch {
+	case recent >= s.config.VelocityDeny:
+		raise(check, models.FraudDeny, models.FraudReasonVelocity)
+	case recent >= s.config.VelocityReview:
+		raise(check, models.FraudReview, models.FraudReasonVelocity)
+	}
+
+	mismatch, err := s.loginMismatch(ctx, input, now)
+	if err != nil {
+		return err
+	}
+	if mismatch {
+		raise(check, models.FraudReview, models.FraudReasonLoginMismatch)
+	}
+
+	if check.Decision == models.FraudReview {
+		approved, err := s.fraudRepo.ConsumeApproval(ctx, input.UserID, input.Kind, input.Asset, input.Amount, now.Add(-s.config.ApprovalTTL), now)
+		if err != nil {
+			return err
+		}
+		if approved {
+			check.Decision = models.FraudAllow
+		} else {
+			check.ReviewStatus = models.FraudReviewPending
+		}
+	}
+
+	if err := s.fraudRepo.CreateCheck(ctx, check); err != nil {
+		return err
+	}
+	return decisionError(check)
+}
+
+// CheckCard denies the payment of an order when its card matches a blocklisted
+// pattern. Cards that are not blocked are not recorded.
+func (s *fraudService) CheckCard(ctx context.Context, userID, orderID uint64, asset string, amount decimal.Decimal, cardPan string) error {
+	pan := normalizeCardPattern(cardPan)
+	if pan == "" {
+		return nil
+	}
+
+	cards, err := s.fraudRepo.ListBlockedCards(ctx)
+	if err != nil {
+		return err
+	}
+	for _, card := range cards {
+		if matched, _ := path.Match(card.Pattern, pan); !matched {
+			continue
+		}
+		check := &models.FraudCheck{
+			UserID:    userID,
+			Kind:      models.FraudKindPayment,
+			Asset:     asset,
+			Amount:    amount,
+			Decision:  models.FraudDeny,
+			Reasons:   []string{models.FraudReasonBlockedCard},
+			Reference: strconv.FormatUint(orderID, 10),
+			CardPan:   truncateString(pan, maxCardPatternLength),
+		}
+		if err := s.fraudRepo.CreateCheck(ctx, check); err != nil {
+			return err
+		}
+		return decisionError(check)
+	}
+	return nil
+}
+
+// RecordLogin remembers the IP and device of a login for the login rule
+func (s *fraudService) RecordLogin(ctx context.Context, userID uint64, ip, device string, at time.Time) error {
+	if at.IsZero() {
+		at = s.now()
+	}
+	return s.fraudRepo.RecordLogin(ctx, userID, truncateString(ip, maxFraudIPLength), truncateString(device, maxLoginDeviceLength), at)
+}
+
+func (s *fraudService) ListReviews(ctx context.Context, adminID uint64, status string) ([]*models.FraudCheck, error) {
+	if !s.admins[adminID] {
+		return nil, ErrFraudNotAdmin
+	}
+	if status == "" {
+		status = models.FraudReviewPending
+	}
+	if status != models.FraudReviewPending && status != models.FraudReviewApproved && status != models.FraudReviewRejected {
+		return nil, ErrFraudInvalidStatus
+	}
+	return s.fraudRepo.ListChecks(ctx, status)
+}
+
+// ResolveReview approves or rejects a check waiting for review. Approving
+// lets the user retry the payment or transfer within the approval TTL.
+func (s *fraudService) ResolveReview(ctx context.Context, adminID, checkID uint64, approve bool, note string) (*models.FraudCheck, error) {
+	if !s.admins[adminID] {
+		return nil, ErrFraudNotAdmin
+	}
+
+	check, err := s.fraudRepo.GetCheck(ctx, checkID)
+	if err != nil {
+		return nil, err
+	}
+	if check == nil {
+		return nil, ErrFraudCheckNotFound
+	}
+
+	status := models.FraudReviewRejected
+	if approve {
+		status = models.FraudReviewApproved
+	}
+	if err := s.fraudRepo.ResolveCheck(ctx, checkID, status, adminID, strings.TrimSpace(note), s.now()); err != nil {
+		return nil, err
+	}
+
+	return s.fraudRepo.GetCheck(ctx, checkID)
+}
+
+func (s *fraudService) ListBlockedCards(ctx context.Context, adminID uint64) ([]*models.BlockedCard, error) {
+	if !s.admins[adminID] {
+		return nil, ErrFraudNotAdmin
+	}
+	return s.fraudRepo.ListBlockedCards(ctx)
+}
+
+// BlockCard adds a card number pattern to the blocklist. * matches any
+// characters and ? a single one, e.g. 603799******1234 or 603799*.
+func (s *fraudService) BlockCard(ctx context.Context, adminID uint64, pattern, reason string) (*models.BlockedCard, error) {
+	if !s.admins[adminID] {
+		return nil, ErrFraudNotAdmin
+	}
+
+	pattern = normalizeCardPattern(pattern)
+	if !validCardPattern(pattern) {
+		return nil, ErrFraudInvalidPattern
+	}
+
+	card := &models.BlockedCard{
+		Pattern:   pattern,
+		Reason:    strings.TrimSpace(reason),
+		CreatedBy: adminID,
+		CreatedAt: s.now(),
+	}
+	if err := s.fraudRepo.CreateBlockedCard(ctx, card); err != nil {
+		return nil, err
+	}
+	return card, nil
+}
+
+func (s *fraudService) UnblockCard(ctx context.Context, adminID, cardID uint64) error {
+	if !s.admins[adminID] {
+		return ErrFraudNotAdmin
+	}
+	return s.fraudRepo.DeleteBlockedCard(ctx, cardID)
+}
+
+func (s *fraudService) isLargeTransfer(asset string, amount decimal.Decimal) bool {
+	switch asset {
+	case "psc":
+		return amount.GreaterThanOrEqual(s.config.LargeTransferPSC)
+	case "irr":
+		return amount.GreaterThanOrEqual(s.config.LargeTransferIRR)
+	}
+	return false
+}
+
+// recentCount counts the user's payment orders, or checked transfers, since a time
+func (s *fraudService) recentCount(ctx context.Context, input FraudCheckInput, since time.Time) (int, error) {
+	if input.Kind == models.FraudKindPayment {
+		return s.fraudRepo.CountOrdersSince(ctx, input.UserID, since)
+	}
+	return s.fraudRepo.CountChecksSince(ctx, input.UserID, input.Kind, since)
+}
+
+// loginMismatch reports whether neither the IP nor the device of the request
+// was seen in the user's recent logins. Without a client or login history
+// there is nothing to compare.
+func (s *fraudService) loginMismatch(ctx context.Context, input FraudCheckInput, now time.Time) (bool, error) {
+	ip := truncateString(input.IP, maxFraudIPLength)
+	device := truncateString(input.Device, maxLoginDeviceLength)
+	if ip == "" && device == "" {
+		return false, nil
+	}
+
+	logins, err := s.fraudRepo.RecentLogins(ctx, input.UserID, now.Add(-s.config.LoginLookback))
+	if err != nil {
+		return false, err
+	}
+	if len(logins) == 0 {
+		return false, nil
+	}
+	for _, login := range logins {
+		if (ip != "" && login.IP == ip) || (device != "" && login.Device == device) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// raise moves a check to a more severe decision and adds the reason
+func raise(check *models.FraudCheck, decision, reason string) {
+	check.Reasons = append(check.Reasons, reason)
+	if decisionSeverity(decision) > decisionSeverity(check.Decision) {
+		check.Decision = decision
+	}
+}
+
+func decisionSeverity(decision string) int {
+	switch decision {
+	case models.FraudDeny:
+		return 2
+	case models.FraudReview:
+		return 1
+	}
+	return 0
+}
+
+func decisionError(check *models.FraudCheck) error {
+	switch check.Decision {
+	case models.FraudDeny:
+		return ErrFraudDenied
+	case models.FraudReview:
+		return fmt.Errorf("%w as check %d", ErrFraudReview, check.ID)
+	}
+	return nil
+}
+
+// normalizeCardPattern drops spaces and dashes so patterns and card numbers
+// compare the way they are printed on the card
+func normalizeCardPattern(value string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '-' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, value)
+}
+
+func validCardPattern(pattern string) bool {
+	if pattern == "" || len(pattern) > maxCardPatternLength {
+		return false
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return false
+	}
+	literal := 0
+	for _, r := range pattern {
+		if r != '*' && r != '?' {
+			literal++
+		}
+	}
+	return literal >= minCardPatternCharacters
+}
+
+func truncateString(value string, max int) string {
+	value = strings.TrimSpace(value)
+	if len(value) <= max {
+		return value
+	}
+	// Cut at a rune boundary
+	for max > 0 && !utf8.RuneStart(value[max]) {
+		max--
+	}
+	return value[:max]
+}
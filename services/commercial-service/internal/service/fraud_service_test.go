@@ -0,0 +1,282 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+)
+
+type fakeFraudRepository struct {
+	orders int
+	logins []*models.LoginSighting
+	checks []*models.FraudCheck
+	cards  []*models.BlockedCard
+}
+
+func (r *fakeFraudRepository) CountOrdersSince(context.Context, uint64, time.Time) (int, error) {
+	return r.orders, nil
+}
+
+func (r *fakeFraudRepository) CountChecksSince(_ context.Context, userID uint64, kind string, _ time.Time) (int, error) {
+	count := 0
+	for _, check := range r.checks {
+		if check.UserID == userID && check.Kind == kind {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *fakeFraudRepository) RecordLogin(_ context.Context, userID uint64, ip, device string, at time.Time) error {
+	r.logins = append(r.logins, &models.LoginSighting{UserID: userID, IP: ip, Device: device, LastSeenAt: at})
+	return nil
+}
+
+func (r *fakeFraudRepository) RecentLogins(_ context.Context, userID uint64, _ time.Time) ([]*models.LoginSighting, error) {
+	var logins []*models.LoginSighting
+	for _, login := range r.logins {
+		if login.UserID == userID {
+			logins = append(logins, login)
+		}
+	}
+	return logins, nil
+}
+
+func (r *fakeFraudRepository) CreateCheck(_ context.Context, check *models.FraudCheck) error {
+	check.ID = uint64(len(r.checks) + 1)
+	r.checks = append(r.checks, check)
+	return nil
+}
+
+func (r *fakeFraudRepository) GetCheck(_ context.Context, checkID uint64) (*models.FraudCheck, error) {
+	for _, check := range r.checks {
+		if check.ID == checkID {
+			return check, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeFraudRepository) ListChecks(_ context.Context, reviewStatus string) ([]*models.FraudCheck, error) {
+	var checks []*models.FraudCheck
+	for _, check := range r.checks {
+		if check.ReviewStatus == reviewStatus {
+			checks = append(checks, check)
+		}
+	}
+	return checks, nil
+}
+
+func (r *fakeFraudRepository) ResolveCheck(_ context.Context, checkID uint64, reviewStatus string, reviewerID uint64, note string, at time.Time) error {
+	check, _ := r.GetCheck(context.Background(), checkID)
+	if check == nil || check.ReviewStatus != models.FraudReviewPending {
+		return repository.ErrFraudCheckNotPending
+	}
+	check.ReviewStatus = reviewStatus
+	check.ReviewedBy = reviewerID
+	check.ReviewNote = note
+	check.ReviewedAt = &at
+	return nil
+}
+
+func (r *fakeFraudRepository) ConsumeApproval(_ context.Context, userID uint64, kind, asset string, amount decimal.Decimal, _, at time.Time) (bool, error) {
+	for _, check := range r.checks {
+		if check.UserID == userID && check.Kind == kind && check.Asset == asset && check.Amount.GreaterThanOrEqual(amount) &&
+			check.ReviewStatus == models.FraudReviewApproved && check.ConsumedAt == nil {
+			check.ConsumedAt = &at
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeFraudRepository) ListBlockedCards(context.Context) ([]*models.BlockedCard, error) {
+	return r.cards, nil
+}
+
+func (r *fakeFraudRepository) CreateBlockedCard(_ context.Context, card *models.BlockedCard) error {
+	for _, existing := range r.cards {
+		if existing.Pattern == card.Pattern {
+			return repository.ErrBlockedCardExists
+		}
+	}
+	card.ID = uint64(len(r.cards) + 1)
+	r.cards = append(r.cards, card)
+	return nil
+}
+
+func (r *fakeFraudRepository) DeleteBlockedCard(_ context.Context, cardID uint64) error {
+	for i, card := range r.cards {
+		if card.ID == cardID {
+			r.cards = append(r.cards[:i], r.cards[i+1:]...)
+			return nil
+		}
+	}
+	return repository.ErrBlockedCardNotFound
+}
+
+func paymentInput(ip, device string) FraudCheckInput {
+	return FraudCheckInput{
+		UserID: 12,
+		Kind:   models.FraudKindPayment,
+		Asset:  "psc",
+		Amount: decimal.NewFromInt(50),
+		IP:     ip,
+		Device: device,
+	}
+}
+
+func TestFraudCheckVelocity(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeFraudRepository{}
+	svc := NewFraudService(repo, []uint64{1}, FraudConfig{VelocityReview: 3, VelocityDeny: 6})
+
+	tests := []struct {
+		orders int
+		want   error
+	}{
+		{orders: 2, want: nil},
+		{orders: 3, want: ErrFraudReview},
+		{orders: 6, want: ErrFraudDenied},
+	}
+	for _, tt := range tests {
+		repo.orders = tt.orders
+		err := svc.Check(ctx, paymentInput("", ""))
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%d orders: got %v, want %v", tt.orders, err, tt.want)
+		}
+	}
+
+	if len(repo.checks) != 3 {
+		t.Fatalf("recorded %d checks, want 3", len(repo.checks))
+	}
+	if review := repo.checks[1]; review.ReviewStatus != models.FraudReviewPending || review.Reasons[0] != models.FraudReasonVelocity {
+		t.Errorf("unexpected review check %+v", review)
+	}
+	if denied := repo.checks[2]; denied.ReviewStatus != "" {
+		t.Errorf("denied check should not wait for review, got %q", denied.ReviewStatus)
+	}
+}
+
+func TestFraudCheckLoginMismatch(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeFraudRepository{}
+	svc := NewFraudService(repo, []uint64{1}, FraudConfig{})
+
+	// Without login history there is nothing to compare
+	if err := svc.Check(ctx, paymentInput("10.0.0.9", "Firefox")); err != nil {
+		t.Fatalf("no history: got %v", err)
+	}
+
+	if err := svc.RecordLogin(ctx, 12, "10.0.0.1", "Chrome", time.Time{}); err != nil {
+		t.Fatalf("RecordLogin returned error: %v", err)
+	}
+	if err := svc.Check(ctx, paymentInput("10.0.0.9", "Chrome")); err != nil {
+		t.Errorf("known device: got %v", err)
+	}
+	if err := svc.Check(ctx, paymentInput("10.0.0.1", "Firefox")); err != nil {
+		t.Errorf("known IP: got %v", err)
+	}
+	err := svc.Check(ctx, paymentInput("10.0.0.9", "Firefox"))
+	if !errors.Is(err, ErrFraudReview) {
+		t.Fatalf("unknown IP and device: got %v", err)
+	}
+	if last := repo.checks[len(repo.checks)-1]; last.Reasons[0] != models.FraudReasonLoginMismatch {
+		t.Errorf("reasons = %v", last.Reasons)
+	}
+}
+
+func TestFraudReviewApprovalLetsRetryThroughOnce(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeFraudRepository{orders: 5}
+	svc := NewFraudService(repo, []uint64{1}, FraudConfig{VelocityReview: 5, VelocityDeny: 10})
+
+	if err := svc.Check(ctx, paymentInput("", "")); !errors.Is(err, ErrFraudReview) {
+		t.Fatalf("expected review, got %v", err)
+	}
+
+	if _, err := svc.ResolveReview(ctx, 99, 1, true, ""); !errors.Is(err, ErrFraudNotAdmin) {
+		t.Errorf("non admin: got %v", err)
+	}
+	check, err := svc.ResolveReview(ctx, 1, 1, true, "known customer")
+	if err != nil {
+		t.Fatalf("ResolveReview returned error: %v", err)
+	}
+	if check.ReviewStatus != models.FraudReviewApproved || check.ReviewedBy != 1 {
+		t.Errorf("unexpected resolved check %+v", check)
+	}
+	if _, err := svc.ResolveReview(ctx, 1, 1, false, ""); !errors.Is(err, repository.ErrFraudCheckNotPending) {
+		t.Errorf("resolving twice: got %v", err)
+	}
+
+	if err := svc.Check(ctx, paymentInput("", "")); err != nil {
+		t.Errorf("retry after approval: got %v", err)
+	}
+	if err := svc.Check(ctx, paymentInput("", "")); !errors.Is(err, ErrFraudReview) {
+		t.Errorf("second retry: got %v", err)
+	}
+}
+
+func TestFraudCheckTransfers(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeFraudRepository{}
+	svc := NewFraudService(repo, []uint64{1}, FraudConfig{VelocityReview: 2, VelocityDeny: 4, LargeTransferPSC: decimal.NewFromInt(100)})
+
+	transfer := func(asset string, amount int64) error {
+		return svc.Check(ctx, FraudCheckInput{UserID: 12, Kind: models.FraudKindTransfer, Asset: asset, Amount: decimal.NewFromInt(amount)})
+	}
+
+	// Small transfers and color assets are not checked or counted
+	if err := transfer("psc", 99); err != nil || len(repo.checks) != 0 {
+		t.Fatalf("small transfer: err = %v, checks = %d", err, len(repo.checks))
+	}
+	if err := transfer("red", 5000); err != nil || len(repo.checks) != 0 {
+		t.Fatalf("color transfer: err = %v, checks = %d", err, len(repo.checks))
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := transfer("psc", 100); err != nil {
+			t.Fatalf("transfer %d: got %v", i+1, err)
+		}
+	}
+	if err := transfer("psc", 100); !errors.Is(err, ErrFraudReview) {
+		t.Errorf("third large transfer in an hour: got %v", err)
+	}
+}
+
+func TestFraudCheckCard(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeFraudRepository{}
+	svc := NewFraudService(repo, []uint64{1}, FraudConfig{})
+
+	if _, err := svc.BlockCard(ctx, 1, "*", ""); !errors.Is(err, ErrFraudInvalidPattern) {
+		t.Errorf("wildcard only pattern: got %v", err)
+	}
+	if _, err := svc.BlockCard(ctx, 1, "6037-99**-****-1234", "chargebacks"); err != nil {
+		t.Fatalf("BlockCard returned error: %v", err)
+	}
+	if _, err := svc.BlockCard(ctx, 1, "603799******1234", ""); !errors.Is(err, repository.ErrBlockedCardExists) {
+		t.Errorf("duplicate pattern: got %v", err)
+	}
+
+	amount := decimal.NewFromInt(50)
+	if err := svc.CheckCard(ctx, 12, 7, "psc", amount, "6037 9912 3456 1234"); !errors.Is(err, ErrFraudDenied) {
+		t.Errorf("blocked card: got %v", err)
+	}
+	if err := svc.CheckCard(ctx, 12, 8, "psc", amount, "6037991234565678"); err != nil {
+		t.Errorf("other card: got %v", err)
+	}
+	if err := svc.CheckCard(ctx, 12, 9, "psc", amount, ""); err != nil {
+		t.Errorf("no card: got %v", err)
+	}
+
+	if len(repo.checks) != 1 || repo.checks[0].Reference != "7" || repo.checks[0].Reasons[0] != models.FraudReasonBlockedCard {
+		t.Errorf("unexpected checks %+v", repo.checks)
+	}
+}
@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+	featurespb "metargb/shared/pb/features"
+)
+
+// Installment plan limits and defaults
+const (
+	MinInstallments = 2
+	MaxInstallments = 12
+	// InstallmentPeriod separates the due dates of consecutive installments
+	InstallmentPeriod = 30 * 24 * time.Hour
+	// DefaultInstallmentDownPaymentPercent is the share of the total charged when a plan is created
+	DefaultInstallmentDownPaymentPercent = 20
+	// DefaultInstallmentPenaltyPercent is the share of the total kept for the seller when a plan defaults
+	DefaultInstallmentPenaltyPercent = 10
+	// DefaultInstallmentGracePeriod is how long an installment may stay unpaid after its due date
+	DefaultInstallmentGracePeriod = 72 * time.Hour
+)
+
+var (
+	ErrInstallmentCountOutOfRange = fmt.Errorf("installments must be between %d and %d", MinInstallments, MaxInstallments)
+	ErrInstallmentPlanNotFound    = errors.New("installment plan not found")
+	ErrInstallmentNotBuyer        = errors.New("only the buyer can pay installments of a plan")
+	ErrInstallmentInvalidStatus   = errors.New("status must be active, completed or defaulted")
+)
+
+// FeatureReservations reserves, transfers and releases features sold in
+// installments, implemented by client.FeaturesClient
+type FeatureReservations interface {
+	ReserveFeature(ctx context.Context, featureID, buyerID uint64) (*featurespb.FeatureReservation, error)
+	CompleteReservedPurchase(ctx context.Context, featureID, buyerID uint64) (uint64, error)
+	ReleaseFeatureReservation(ctx context.Context, featureID, buyerID uint64) error
+}
+
+// InstallmentConfig holds the terms applied to new plans and to defaults
+type InstallmentConfig struct {
+	DownPaymentPercent float64
+	PenaltyPercent     float64
+	GracePeriod        time.Duration
+}
+
+type InstallmentService interface {
+	CreatePlan(ctx context.Context, buyerID, featureID uint64, installments int32) (*models.InstallmentPlan, error)
+	ListPlans(ctx context.Context, userID uint64, status string) ([]*models.InstallmentPlan, error)
+	GetPlan(ctx context.Context, userID, planID uint64) (*models.InstallmentPlan, error)
+	PayInstallment(ctx context.Context, buyerID, planID uint64) (*models.InstallmentPlan, error)
+	ProcessDuePlans(ctx context.Context, now time.Time) (int, error)
+}
+
+type installmentService struct {
+	installmentRepo repository.InstallmentRepository
+	features        FeatureReservations
+	spending        SpendingLimiter
+	config          InstallmentConfig
+	now             func() time.Time
+}
+
+// NewInstallmentService creates the installment service. Zero config values
+// fall back to the defaults. The whole price of a plan is counted against the
+// spending limits of buyers under 18 when it is created, unless spending is nil.
+func NewInstallmentService(installmentRepo repository.InstallmentRepository, features FeatureReservations, spending SpendingLimiter, config InstallmentConfig) InstallmentService {
+	if config.DownPaymentPercent <= 0 || config.DownPaymentPercent >= 100 {
+		config.DownPaymentPercent = DefaultInstallmentDownPaymentPercent
+	}
+	if config.PenaltyPercent < 0 || config.PenaltyPercent > 100 {
+		config.PenaltyPercent = DefaultInstallmentPenaltyPercent
+	}
+	if config.GracePeriod <= 0 {
+		config.GracePeriod = DefaultInstallmentGracePeriod
+	}
+	return &installmentService{
+		installmentRepo: installmentRepo,
+		features:        features,
+		spending:        spending,
+		config:          config,
+		now:             time.Now,
+	}
+}
+
+// CreatePlan reserves the feature for the buyer and charges the down payment.
+// The reservation is released again if the down payment cannot be charged.
+func (s *installmentService) CreatePlan(ctx context.Context, buyerID, featureID uint64, installments int32) (*models.InstallmentPlan, error) {
+	if installments < MinInstallments || installments > MaxInstallments {
+		return nil, ErrInstallmentCountOutOfRange
+	}
+
+	reservation, err := s.features.ReserveFeature(ctx, featureID, buyerID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := buildInstallmentPlan(reservation, int(installments), s.config, s.now())
+	releaseSpending, err := s.reserveSpending(ctx, plan)
+	if err != nil {
+		if releaseErr := s.features.ReleaseFeatureReservation(ctx, featureID, buyerID); releaseErr != nil {
+			log.Printf("Failed to release feature %d reserved for buyer %d: %v", featureID, buyerID, releaseErr)
+		}
+		return nil, err
+	}
+	created, err := s.installmentRepo.Create(ctx, plan)
+	if err != nil {
+		releaseSpending()
+		if releaseErr := s.features.ReleaseFeatureReservation(ctx, featureID, buyerID); releaseErr != nil {
+			log.Printf("Failed to release feature %d reserved for buyer %d: %v", featureID, buyerID, releaseErr)
+		}
+		return nil, err
+	}
+
+	return s.installmentRepo.GetByID(ctx, created.ID)
+}
+
+// reserveSpending counts the whole price of a plan against the buyer's
+// spending limits; the installments are committed to when the plan is made
+func (s *installmentService) reserveSpending(ctx context.Context, plan *models.InstallmentPlan) (func(), error) {
+	if s.spending == nil {
+		return func() {}, nil
+	}
+	releasePSC, err := s.spending.Reserve(ctx, plan.BuyerID, "psc", plan.TotalPSC, models.SpendingSourceInstallment)
+	if err != nil {
+		return nil, err
+	}
+	releaseIRR, err := s.spending.Reserve(ctx, plan.BuyerID, "irr", plan.TotalIRR, models.SpendingSourceInstallment)
+	if err != nil {
+		releasePSC()
+		return nil, err
+	}
+	return func() {
+		releasePSC()
+		releaseIRR()
+	}, nil
+}
+
+func (s *installmentService) ListPlans(ctx context.Context, userID uint64, status string) ([]*models.InstallmentPlan, error) {
+	switch status {
+	case "", models.InstallmentPlanActive, models.InstallmentPlanCompleted, models.InstallmentPlanDefaulted:
+	default:
+		return nil, ErrInstallmentInvalidStatus
+	}
+
+	return s.installmentRepo.ListForUser(ctx, userID, status)
+}
+
+// GetPlan returns a plan to its buyer or seller
+func (s *installmentService) GetPlan(ctx context.Context, userID, planID uint64) (*models.InstallmentPlan, error) {
+	plan, err := s.installmentRepo.GetByID(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	if plan == nil || (plan.BuyerID != userID && plan.SellerID != userID) {
+		return nil, ErrInstallmentPlanNotFound
+	}
+
+	return plan, nil
+}
+
+// PayInstallment charges the next unpaid installment from the buyer's wallet
+// ahead of its due date. Buyers paying through the payment gateway top up
+// their wallet first. Paying the last installment completes the plan.
+func (s *installmentService) PayInstallment(ctx context.Context, buyerID, planID uint64) (*models.InstallmentPlan, error) {
+	plan, err := s.GetPlan(ctx, buyerID, planID)
+	if err != nil {
+		return nil, err
+	}
+	if plan.BuyerID != buyerID {
+		return nil, ErrInstallmentNotBuyer
+	}
+
+	if err := s.installmentRepo.PayNext(ctx, planID); err != nil {
+		return nil, err
+	}
+
+	plan, err = s.installmentRepo.GetByID(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	if plan.NextPending() == nil {
+		// The worker retries the transfer if it fails now
+		if err := s.completePlan(ctx, plan); err != nil {
+			log.Printf("Failed to complete installment plan %d: %v", planID, err)
+			return plan, nil
+		}
+		return s.installmentRepo.GetByID(ctx, planID)
+	}
+
+	return plan, nil
+}
+
+// ProcessDuePlans charges due installments, completes paid off plans and
+// defaults plans past the grace period. It returns how many plans changed.
+func (s *installmentService) ProcessDuePlans(ctx context.Context, now time.Time) (int, error) {
+	ids, err := s.installmentRepo.ListDuePlanIDs(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for _, id := range ids {
+		changed, err := s.processPlan(ctx, id, now)
+		if err != nil {
+			log.Printf("Failed to process installment plan %d: %v", id, err)
+			continue
+		}
+		if changed {
+			processed++
+		}
+	}
+
+	return processed, nil
+}
+
+func (s *installmentService) processPlan(ctx context.Context, planID uint64, now time.Time) (bool, error) {
+	plan, err := s.installmentRepo.GetByID(ctx, planID)
+	if err != nil || plan == nil || plan.Status != models.InstallmentPlanActive {
+		return false, err
+	}
+
+	next := plan.NextPending()
+	if next == nil {
+		return true, s.completePlan(ctx, plan)
+	}
+	if next.DueAt.After(now) {
+		return false, nil
+	}
+
+	err = s.installmentRepo.PayNext(ctx, planID)
+	if errors.Is(err, repository.ErrInstallmentInsufficientBalance) {
+		if now.Before(next.DueAt.Add(s.config.GracePeriod)) {
+			return false, nil
+		}
+		return true, s.defaultPlan(ctx, plan)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// The plan is paid off when the charged installment was the last one
+	if next == plan.Installments[len(plan.Installments)-1] {
+		if err := s.completePlan(ctx, plan); err != nil {
+			log.Printf("Failed to complete installment plan %d: %v", planID, err)
+		}
+	}
+	return true, nil
+}
+
+// completePlan transfers the feature to the buyer and pays out the plan.
+// Both steps can be retried: features-service returns the same trade for a
+// completed reservation.
+func (s *installmentService) completePlan(ctx context.Context, plan *models.InstallmentPlan) error {
+	tradeID, err := s.features.CompleteReservedPurchase(ctx, plan.FeatureID, plan.BuyerID)
+	if err != nil {
+		return err
+	}
+	return s.installmentRepo.Complete(ctx, plan.ID, tradeID)
+}
+
+// defaultPlan releases the feature back to the seller and refunds the buyer
+// what was paid minus the penalty
+func (s *installmentService) defaultPlan(ctx context.Context, plan *models.InstallmentPlan) error {
+	if err := s.features.ReleaseFeatureReservation(ctx, plan.FeatureID, plan.BuyerID); err != nil {
+		return err
+	}
+	return s.installmentRepo.Default(ctx, plan.ID)
+}
+
+// buildInstallmentPlan splits the buyer charge of a reservation into a down
+// payment and count monthly installments. The last installment absorbs the
+// rounding so the schedule adds up to the total.
+func buildInstallmentPlan(reservation *featurespb.FeatureReservation, count int, config InstallmentConfig, now time.Time) *models.InstallmentPlan {
+	plan := &models.InstallmentPlan{
+		FeatureID:        reservation.FeatureId,
+		BuyerID:          reservation.BuyerId,
+		SellerID:         reservation.SellerId,
+		PlatformUserID:   reservation.PlatformUserId,
+		TotalPSC:         models.TruncateToWallet("psc", decimal.NewFromFloat(reservation.BuyerChargePsc)),
+		TotalIRR:         models.TruncateToWallet("irr", decimal.NewFromFloat(reservation.BuyerChargeIrr)),
+		SellerPaymentPSC: models.TruncateToWallet("psc", decimal.NewFromFloat(reservation.SellerPaymentPsc)),
+		SellerPaymentIRR: models.TruncateToWallet("irr", decimal.NewFromFloat(reservation.SellerPaymentIrr)),
+		PenaltyPercent:   decimal.NewFromFloat(config.PenaltyPercent),
+	}
+
+	downPayment := decimal.NewFromFloat(config.DownPaymentPercent)
+	psc := splitInstallments("psc", plan.TotalPSC, downPayment, count)
+	irr := splitInstallments("irr", plan.TotalIRR, downPayment, count)
+	for i := 0; i <= count; i++ {
+		plan.Installments = append(plan.Installments, &models.Installment{
+			Sequence:  int32(i),
+			AmountPSC: psc[i],
+			AmountIRR: irr[i],
+			Status:    models.InstallmentPending,
+			DueAt:     now.Add(time.Duration(i) * InstallmentPeriod),
+		})
+	}
+
+	return plan
+}
+
+// splitInstallments returns the down payment followed by count installments
+// of an asset, truncated to what the wallet column holds
+func splitInstallments(asset string, total, downPaymentPercent decimal.Decimal, count int) []decimal.Decimal {
+	amounts := make([]decimal.Decimal, count+1)
+	amounts[0] = models.TruncateToWallet(asset, total.Mul(downPaymentPercent).Div(decimal.NewFromInt(100)))
+
+	rest := total.Sub(amounts[0])
+	each := models.TruncateToWallet(asset, rest.Div(decimal.NewFromInt(int64(count))))
+	for i := 1; i < count; i++ {
+		amounts[i] = each
+	}
+	amounts[count] = rest.Sub(each.Mul(decimal.NewFromInt(int64(count - 1))))
+
+	return amounts
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+	featurespb "metargb/shared/pb/features"
+)
+
+func TestSplitInstallments(t *testing.T) {
+	irr := splitInstallments("irr", decimal.NewFromInt(1000001), decimal.NewFromInt(20), 3)
+	want := []string{"200000", "266667", "266667", "266667"}
+	for i, amount := range irr {
+		if amount.String() != want[i] {
+			t.Errorf("irr installment %d = %s, want %s", i, amount, want[i])
+		}
+	}
+
+	psc := splitInstallments("psc", decimal.RequireFromString("10"), decimal.NewFromInt(20), 3)
+	sum := decimal.Zero
+	for _, amount := range psc {
+		if amount.Exponent() < -10 {
+			t.Errorf("psc installment %s has more than 10 decimal places", amount)
+		}
+		sum = sum.Add(amount)
+	}
+	if !sum.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("psc installments add up to %s, want 10", sum)
+	}
+}
+
+type fakeFeatureReservations struct {
+	reserveErr  error
+	completeErr error
+	released    []uint64
+	completed   []uint64
+}
+
+func (f *fakeFeatureReservations) ReserveFeature(_ context.Context, featureID, buyerID uint64) (*featurespb.FeatureReservation, error) {
+	if f.reserveErr != nil {
+		return nil, f.reserveErr
+	}
+	return &featurespb.FeatureReservation{
+		FeatureId:        featureID,
+		BuyerId:          buyerID,
+		SellerId:         61,
+		BuyerChargePsc:   10.2,
+		BuyerChargeIrr:   5100000,
+		SellerPaymentPsc: 9.8,
+		SellerPaymentIrr: 4900000,
+		PlatformUserId:   1,
+	}, nil
+}
+
+func (f *fakeFeatureReservations) CompleteReservedPurchase(_ context.Context, featureID, _ uint64) (uint64, error) {
+	if f.completeErr != nil {
+		return 0, f.completeErr
+	}
+	f.completed = append(f.completed, featureID)
+	return 500, nil
+}
+
+func (f *fakeFeatureReservations) ReleaseFeatureReservation(_ context.Context, featureID, _ uint64) error {
+	f.released = append(f.released, featureID)
+	return nil
+}
+
+type fakeInstallmentRepository struct {
+	plan      *models.InstallmentPlan
+	createErr error
+	payErr    error
+	tradeID   uint64
+}
+
+func (r *fakeInstallmentRepository) Create(_ context.Context, plan *models.InstallmentPlan) (*models.InstallmentPlan, error) {
+	if r.createErr != nil {
+		return nil, r.createErr
+	}
+	plan.ID = 1
+	plan.Status = models.InstallmentPlanActive
+	plan.Installments[0].Status = models.InstallmentPaid
+	r.plan = plan
+	return plan, nil
+}
+
+func (r *fakeInstallmentRepository) GetByID(_ context.Context, planID uint64) (*models.InstallmentPlan, error) {
+	if r.plan == nil || r.plan.ID != planID {
+		return nil, nil
+	}
+	return r.plan, nil
+}
+
+func (r *fakeInstallmentRepository) ListForUser(context.Context, uint64, string) ([]*models.InstallmentPlan, error) {
+	return []*models.InstallmentPlan{r.plan}, nil
+}
+
+func (r *fakeInstallmentRepository) ListDuePlanIDs(context.Context, time.Time) ([]uint64, error) {
+	return []uint64{r.plan.ID}, nil
+}
+
+func (r *fakeInstallmentRepository) PayNext(context.Context, uint64) error {
+	if r.payErr != nil {
+		return r.payErr
+	}
+	next := r.plan.NextPending()
+	if next == nil {
+		return repository.ErrInstallmentNothingDue
+	}
+	next.Status = models.InstallmentPaid
+	return nil
+}
+
+func (r *fakeInstallmentRepository) Complete(_ context.Context, _ uint64, tradeID uint64) error {
+	r.tradeID = tradeID
+	r.plan.Status = models.InstallmentPlanCompleted
+	return nil
+}
+
+func (r *fakeInstallmentRepository) Default(context.Context, uint64) error {
+	r.plan.Status = models.InstallmentPlanDefaulted
+	return nil
+}
+
+func TestInstallmentServiceCreatePlan(t *testing.T) {
+	ctx := context.Background()
+
+	repo := &fakeInstallmentRepository{}
+	svc := NewInstallmentService(repo, &fakeFeatureReservations{}, nil, InstallmentConfig{})
+	if _, err := svc.CreatePlan(ctx, 88, 1204, 1); !errors.Is(err, ErrInstallmentCountOutOfRange) {
+		t.Fatalf("expected ErrInstallmentCountOutOfRange, got %v", err)
+	}
+
+	plan, err := svc.CreatePlan(ctx, 88, 1204, 6)
+	if err != nil {
+		t.Fatalf("CreatePlan returned error: %v", err)
+	}
+	if len(plan.Installments) != 7 {
+		t.Fatalf("expected a down payment and 6 installments, got %d", len(plan.Installments))
+	}
+	if plan.Installments[0].AmountIRR.String() != "1020000" || plan.Installments[1].AmountIRR.String() != "680000" {
+		t.Errorf("unexpected schedule: down payment %s, first installment %s", plan.Installments[0].AmountIRR, plan.Installments[1].AmountIRR)
+	}
+	if got := plan.Installments[2].DueAt.Sub(plan.Installments[1].DueAt); got != InstallmentPeriod {
+		t.Errorf("installments are %s apart, want %s", got, InstallmentPeriod)
+	}
+	if _, err := svc.PayInstallment(ctx, 61, plan.ID); !errors.Is(err, ErrInstallmentNotBuyer) {
+		t.Errorf("expected the seller to be refused, got %v", err)
+	}
+	if _, err := svc.GetPlan(ctx, 99, plan.ID); !errors.Is(err, ErrInstallmentPlanNotFound) {
+		t.Errorf("expected other users not to see the plan, got %v", err)
+	}
+
+	features := &fakeFeatureReservations{}
+	svc = NewInstallmentService(&fakeInstallmentRepository{createErr: repository.ErrInstallmentInsufficientBalance}, features, nil, InstallmentConfig{})
+	if _, err := svc.CreatePlan(ctx, 88, 1204, 6); !errors.Is(err, repository.ErrInstallmentInsufficientBalance) {
+		t.Fatalf("expected ErrInstallmentInsufficientBalance, got %v", err)
+	}
+	if len(features.released) != 1 {
+		t.Errorf("expected the reservation to be released when the down payment fails")
+	}
+}
+
+func TestInstallmentServiceProcessDuePlans(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newService := func(repo *fakeInstallmentRepository, features *fakeFeatureReservations) *installmentService {
+		svc := NewInstallmentService(repo, features, nil, InstallmentConfig{GracePeriod: 48 * time.Hour}).(*installmentService)
+		svc.now = func() time.Time { return created }
+		if _, err := svc.CreatePlan(ctx, 88, 1204, 2); err != nil {
+			t.Fatalf("CreatePlan returned error: %v", err)
+		}
+		return svc
+	}
+
+	t.Run("nothing due before the first installment", func(t *testing.T) {
+		repo, features := &fakeInstallmentRepository{}, &fakeFeatureReservations{}
+		svc := newService(repo, features)
+
+		if n, err := svc.ProcessDuePlans(ctx, created.Add(time.Hour)); err != nil || n != 0 {
+			t.Fatalf("ProcessDuePlans = %d, %v; want 0, nil", n, err)
+		}
+	})
+
+	t.Run("final charge transfers the feature", func(t *testing.T) {
+		repo, features := &fakeInstallmentRepository{}, &fakeFeatureReservations{}
+		svc := newService(repo, features)
+
+		svc.ProcessDuePlans(ctx, created.Add(InstallmentPeriod))
+		if repo.plan.Status != models.InstallmentPlanActive {
+			t.Fatalf("expected the plan to stay active after the first installment, got %s", repo.plan.Status)
+		}
+		svc.ProcessDuePlans(ctx, created.Add(2*InstallmentPeriod))
+		if repo.plan.Status != models.InstallmentPlanCompleted || repo.tradeID != 500 {
+			t.Fatalf("expected the plan to complete with trade 500, got %s and %d", repo.plan.Status, repo.tradeID)
+		}
+	})
+
+	t.Run("failed transfer is retried", func(t *testing.T) {
+		repo, features := &fakeInstallmentRepository{}, &fakeFeatureReservations{completeErr: errors.New("unavailable")}
+		svc := newService(repo, features)
+		for _, installment := range repo.plan.Installments {
+			installment.Status = models.InstallmentPaid
+		}
+
+		svc.ProcessDuePlans(ctx, created)
+		if repo.plan.Status != models.InstallmentPlanActive {
+			t.Fatalf("expected the plan to stay active, got %s", repo.plan.Status)
+		}
+		features.completeErr = nil
+		svc.ProcessDuePlans(ctx, created)
+		if repo.plan.Status != models.InstallmentPlanCompleted {
+			t.Fatalf("expected the retry to complete the plan, got %s", repo.plan.Status)
+		}
+	})
+
+	t.Run("unpaid installment defaults after the grace period", func(t *testing.T) {
+		repo, features := &fakeInstallmentRepository{}, &fakeFeatureReservations{}
+		svc := newService(repo, features)
+		repo.payErr = repository.ErrInstallmentInsufficientBalance
+
+		svc.ProcessDuePlans(ctx, created.Add(InstallmentPeriod+47*time.Hour))
+		if repo.plan.Status != models.InstallmentPlanActive || len(features.released) != 0 {
+			t.Fatalf("expected the plan to stay active within the grace period, got %s", repo.plan.Status)
+		}
+
+		svc.ProcessDuePlans(ctx, created.Add(InstallmentPeriod+48*time.Hour))
+		if repo.plan.Status != models.InstallmentPlanDefaulted {
+			t.Fatalf("expected the plan to default, got %s", repo.plan.Status)
+		}
+		if len(features.released) != 1 {
+			t.Fatalf("expected the reservation to be released")
+		}
+	})
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"metargb/shared/pkg/jobs"
+)
+
+// DefaultInstallmentInterval is how often installment plans are checked for due charges
+const DefaultInstallmentInterval = time.Hour
+
+// InstallmentWorker periodically charges due installments, completes paid
+// off plans and defaults plans past their grace period
+type InstallmentWorker struct {
+	installments InstallmentService
+	interval     time.Duration
+}
+
+// NewInstallmentWorker creates a worker that runs every interval
+// (DefaultInstallmentInterval if zero)
+func NewInstallmentWorker(installments InstallmentService, interval time.Duration) *InstallmentWorker {
+	if interval <= 0 {
+		interval = DefaultInstallmentInterval
+	}
+	return &InstallmentWorker{
+		installments: installments,
+		interval:     interval,
+	}
+}
+
+// Register runs the worker once at start and then every interval under runner
+func (w *InstallmentWorker) Register(runner *jobs.Runner) {
+	runner.AddImmediate("installments", w.interval, func(ctx context.Context) error {
+		_, err := w.Run(ctx)
+		return err
+	})
+}
+
+// Run processes every due plan and returns how many plans changed
+func (w *InstallmentWorker) Run(ctx context.Context) (int, error) {
+	return w.installments.ProcessDuePlans(ctx, time.Now())
+}
@@ -3,34 +3,172 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
 
 	"metargb/commercial-service/internal/repository"
+	"metargb/shared/pkg/money"
+)
+
+// The first order bonus is configured in the variables table:
+// first_order_bonus_percent = 50 credits half the order on top of it,
+// first_order_min_amount = 1000000 requires the order to be worth at least
+// one million rials, and first_order_window_days = 30 offers the bonus only in
+// the first 30 days after registration. Unset variables keep the original
+// rule: 50% on the first order of each asset, whatever its size or date.
+const (
+	firstOrderVariablePrefix  = "first_order_"
+	firstOrderBonusPercentKey = "first_order_bonus_percent"
+	firstOrderMinAmountKey    = "first_order_min_amount"
+	firstOrderWindowDaysKey   = "first_order_window_days"
+)
+
+// defaultFirstOrderRules applies when no first_order_* variable is set
+var defaultFirstOrderRules = FirstOrderRules{BonusPercent: decimal.NewFromInt(50)}
+
+// Reasons a first order bonus is or is not granted
+const (
+	FirstOrderEligible        = "eligible"
+	FirstOrderAlreadyReceived = "already_received" // The user got the bonus for this asset before
+	FirstOrderDisabled        = "disabled"         // first_order_bonus_percent is 0
+	FirstOrderWindowClosed    = "window_closed"    // The user registered too long ago
+	FirstOrderBelowMinimum    = "below_minimum"    // The order is worth less than first_order_min_amount
 )
 
+// FirstOrderRules is the bonus offered on a user's first order of each asset
+type FirstOrderRules struct {
+	BonusPercent decimal.Decimal // Of the order amount
+	MinAmount    decimal.Decimal // IRR value the order must reach, zero for any
+	WindowDays   int             // Days after registration the bonus is offered, zero for always
+}
+
+// FirstOrderCheck is an order the rules are applied to
+type FirstOrderCheck struct {
+	Asset         string
+	Amount        decimal.Decimal
+	IRRValue      decimal.Decimal
+	HasFirstOrder bool      // The user already received the bonus for Asset
+	RegisteredAt  time.Time // Zero when unknown, which passes the window
+	Now           time.Time
+}
+
+// FirstOrderBonus is the outcome of the rules for an order
+type FirstOrderBonus struct {
+	Eligible     bool
+	Reason       string
+	Bonus        decimal.Decimal // In the order's asset, zero unless eligible
+	IRRValue     decimal.Decimal
+	Rules        FirstOrderRules
+	WindowEndsAt time.Time // Zero without a window or a known registration date
+}
+
+// Evaluate applies the rules to an order. It has no side effects so the
+// payment callback and the evaluation RPC give the same answer.
+func (r FirstOrderRules) Evaluate(check FirstOrderCheck) FirstOrderBonus {
+	result := FirstOrderBonus{Bonus: decimal.Zero, IRRValue: check.IRRValue, Rules: r}
+	if r.WindowDays > 0 && !check.RegisteredAt.IsZero() {
+		result.WindowEndsAt = check.RegisteredAt.AddDate(0, 0, r.WindowDays)
+	}
+
+	switch {
+	case check.HasFirstOrder:
+		result.Reason = FirstOrderAlreadyReceived
+	case !r.BonusPercent.IsPositive():
+		result.Reason = FirstOrderDisabled
+	case !result.WindowEndsAt.IsZero() && !check.Now.Before(result.WindowEndsAt):
+		result.Reason = FirstOrderWindowClosed
+	case check.IRRValue.LessThan(r.MinAmount):
+		result.Reason = FirstOrderBelowMinimum
+	default:
+		result.Eligible = true
+		result.Reason = FirstOrderEligible
+		result.Bonus = money.Truncate(check.Asset, check.Amount.Mul(r.BonusPercent).Div(decimal.NewFromInt(100)))
+	}
+	return result
+}
+
+// parseFirstOrderRules reads the first_order_* variables over the defaults,
+// ignoring values out of range
+func parseFirstOrderRules(values map[string]float64) FirstOrderRules {
+	rules := defaultFirstOrderRules
+	if value, ok := values[firstOrderBonusPercentKey]; ok && value >= 0 && value <= 100 {
+		rules.BonusPercent = decimal.NewFromFloat(value)
+	}
+	if value, ok := values[firstOrderMinAmountKey]; ok && value >= 0 {
+		rules.MinAmount = decimal.NewFromFloat(value)
+	}
+	if value, ok := values[firstOrderWindowDaysKey]; ok && value >= 0 {
+		rules.WindowDays = int(value)
+	}
+	return rules
+}
+
 type OrderPolicy interface {
-	CanGetBonus(ctx context.Context, userID uint64, orderType string) (bool, error)
+	// EvaluateFirstOrderBonus applies the current first order rules to an
+	// order of amount asset worth irrValue rials
+	EvaluateFirstOrderBonus(ctx context.Context, userID uint64, asset string, amount, irrValue decimal.Decimal) (*FirstOrderBonus, error)
+	// PreviewFirstOrderBonus evaluates an order that was not paid yet,
+	// valuing it at the asset's current rate
+	PreviewFirstOrderBonus(ctx context.Context, userID uint64, asset string, amount decimal.Decimal) (*FirstOrderBonus, error)
 }
 
 type orderPolicy struct {
 	firstOrderRepo repository.FirstOrderRepository
+	variableRepo   repository.VariableRepository
+	now            func() time.Time
 }
 
-func NewOrderPolicy(firstOrderRepo repository.FirstOrderRepository) OrderPolicy {
+func NewOrderPolicy(firstOrderRepo repository.FirstOrderRepository, variableRepo repository.VariableRepository) OrderPolicy {
 	return &orderPolicy{
 		firstOrderRepo: firstOrderRepo,
+		variableRepo:   variableRepo,
+		now:            time.Now,
 	}
 }
 
-// CanGetBonus checks if user can get first order bonus
+// EvaluateFirstOrderBonus checks if user can get first order bonus
 // Laravel: OrderPolicy::canGetBonus
-// Rule: User can get bonus only if they haven't received first order bonus for this asset type
-func (p *orderPolicy) CanGetBonus(ctx context.Context, userID uint64, orderType string) (bool, error) {
-	// Check if user already has a first order for this type
-	hasFirstOrder, err := p.firstOrderRepo.HasFirstOrder(ctx, userID, orderType)
+// Rule: User can get bonus only if they haven't received first order bonus for
+// this asset type, within the limits set by the first_order_* variables
+func (p *orderPolicy) EvaluateFirstOrderBonus(ctx context.Context, userID uint64, asset string, amount, irrValue decimal.Decimal) (*FirstOrderBonus, error) {
+	values, err := p.variableRepo.GetByPrefix(ctx, firstOrderVariablePrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get first order rules: %w", err)
+	}
+	rules := parseFirstOrderRules(values)
+
+	hasFirstOrder, err := p.firstOrderRepo.HasFirstOrder(ctx, userID, asset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check first order: %w", err)
+	}
+
+	var registeredAt time.Time
+	if rules.WindowDays > 0 {
+		registeredAt, err = p.firstOrderRepo.GetUserRegisteredAt(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := rules.Evaluate(FirstOrderCheck{
+		Asset:         asset,
+		Amount:        amount,
+		IRRValue:      irrValue,
+		HasFirstOrder: hasFirstOrder,
+		RegisteredAt:  registeredAt,
+		Now:           p.now(),
+	})
+	return &result, nil
+}
+
+func (p *orderPolicy) PreviewFirstOrderBonus(ctx context.Context, userID uint64, asset string, amount decimal.Decimal) (*FirstOrderBonus, error) {
+	rate, err := p.variableRepo.GetRate(ctx, asset)
 	if err != nil {
-		return false, fmt.Errorf("failed to check first order: %w", err)
+		return nil, fmt.Errorf("failed to get asset rate: %w", err)
 	}
 
-	// User can get bonus only if they DON'T have a first order for this type yet
-	return !hasFirstOrder, nil
+	// Valued in whole rials, like the payment the callback compares
+	irrValue := amount.Mul(decimal.NewFromFloat(rate)).Truncate(0)
+	return p.EvaluateFirstOrderBonus(ctx, userID, asset, amount, irrValue)
 }
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestParseFirstOrderRules(t *testing.T) {
+	rules := parseFirstOrderRules(nil)
+	if !rules.BonusPercent.Equal(decimal.NewFromInt(50)) || !rules.MinAmount.IsZero() || rules.WindowDays != 0 {
+		t.Fatalf("default rules = %+v, want 50%% without minimum or window", rules)
+	}
+
+	rules = parseFirstOrderRules(map[string]float64{
+		"first_order_bonus_percent": 20,
+		"first_order_min_amount":    500000,
+		"first_order_window_days":   30,
+	})
+	if !rules.BonusPercent.Equal(decimal.NewFromInt(20)) || !rules.MinAmount.Equal(decimal.NewFromInt(500000)) || rules.WindowDays != 30 {
+		t.Fatalf("parseFirstOrderRules() = %+v", rules)
+	}
+
+	// Out of range values keep the defaults
+	rules = parseFirstOrderRules(map[string]float64{
+		"first_order_bonus_percent": 150,
+		"first_order_min_amount":    -1,
+		"first_order_window_days":   -7,
+	})
+	if !rules.BonusPercent.Equal(decimal.NewFromInt(50)) || !rules.MinAmount.IsZero() || rules.WindowDays != 0 {
+		t.Fatalf("parseFirstOrderRules() = %+v, want the defaults", rules)
+	}
+}
+
+func TestFirstOrderRulesEvaluate(t *testing.T) {
+	now := time.Date(2026, 5, 10, 12, 0, 0, 0, time.UTC)
+	rules := FirstOrderRules{
+		BonusPercent: decimal.NewFromInt(25),
+		MinAmount:    decimal.NewFromInt(1000000),
+		WindowDays:   30,
+	}
+	eligible := FirstOrderCheck{
+		Asset:        "psc",
+		Amount:       decimal.RequireFromString("10.5"),
+		IRRValue:     decimal.NewFromInt(1050000),
+		RegisteredAt: now.AddDate(0, 0, -10),
+		Now:          now,
+	}
+
+	tests := []struct {
+		name   string
+		rules  FirstOrderRules
+		change func(*FirstOrderCheck)
+		reason string
+		bonus  string
+	}{
+		{"eligible", rules, nil, FirstOrderEligible, "2.625"},
+		{"already received", rules, func(c *FirstOrderCheck) { c.HasFirstOrder = true }, FirstOrderAlreadyReceived, "0"},
+		{"below minimum", rules, func(c *FirstOrderCheck) { c.IRRValue = decimal.NewFromInt(999999) }, FirstOrderBelowMinimum, "0"},
+		{"window closed", rules, func(c *FirstOrderCheck) { c.RegisteredAt = now.AddDate(0, 0, -30) }, FirstOrderWindowClosed, "0"},
+		{"unknown registration", rules, func(c *FirstOrderCheck) { c.RegisteredAt = time.Time{} }, FirstOrderEligible, "2.625"},
+		{"disabled", FirstOrderRules{}, nil, FirstOrderDisabled, "0"},
+		{"irr is whole", FirstOrderRules{BonusPercent: decimal.NewFromInt(50)}, func(c *FirstOrderCheck) {
+			c.Asset, c.Amount = "irr", decimal.NewFromInt(1001)
+		}, FirstOrderEligible, "500"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := eligible
+			if tt.change != nil {
+				tt.change(&check)
+			}
+			result := tt.rules.Evaluate(check)
+			if result.Reason != tt.reason || result.Eligible != (tt.reason == FirstOrderEligible) {
+				t.Fatalf("Evaluate() = %s (eligible %v), want %s", result.Reason, result.Eligible, tt.reason)
+			}
+			if result.Bonus.String() != tt.bonus {
+				t.Errorf("bonus = %s, want %s", result.Bonus, tt.bonus)
+			}
+		})
+	}
+
+	result := rules.Evaluate(eligible)
+	if want := eligible.RegisteredAt.AddDate(0, 0, 30); !result.WindowEndsAt.Equal(want) {
+		t.Errorf("window ends at %v, want %v", result.WindowEndsAt, want)
+	}
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	ptime "github.com/yaa110/go-persian-calendar"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+)
+
+const (
+	defaultOrdersPerPage = 10
+	maxOrdersPerPage     = 100
+)
+
+var (
+	ErrInvalidOrderDate      = errors.New("invalid date: expected Jalali Y/m/d or Gregorian Y-m-d")
+	ErrInvalidOrderDateRange = errors.New("from_date must not be after to_date")
+)
+
+// ListOrdersInput holds the raw order history filters received from the transport layer
+type ListOrdersInput struct {
+	Statuses []int32
+	Gateway  string
+	FromDate string // Jalali Y/m/d or Gregorian Y-m-d, inclusive
+	ToDate   string // Jalali Y/m/d or Gregorian Y-m-d, inclusive
+	Page     int
+	PerPage  int
+}
+
+type OrderService interface {
+	ListOrders(ctx context.Context, userID uint64, input ListOrdersInput) ([]*models.OrderDTO, int64, int, int, error)
+}
+
+type orderService struct {
+	orderRepo       repository.OrderRepository
+	jalaliConverter JalaliConverter
+}
+
+func NewOrderService(orderRepo repository.OrderRepository, jalaliConverter JalaliConverter) OrderService {
+	return &orderService{
+		orderRepo:       orderRepo,
+		jalaliConverter: jalaliConverter,
+	}
+}
+
+// ListOrders returns a page of the user's orders along with the total count and
+// the normalized page and per-page values that were applied
+func (s *orderService) ListOrders(ctx context.Context, userID uint64, input ListOrdersInput) ([]*models.OrderDTO, int64, int, int, error) {
+	filter, err := buildOrderFilter(input)
+	if err != nil {
+		return nil, 0, 0, 0, err
+	}
+
+	items, total, err := s.orderRepo.ListByUserID(ctx, userID, filter)
+	if err != nil {
+		return nil, 0, 0, 0, fmt.Errorf("failed to list orders: %w", err)
+	}
+
+	dtos := make([]*models.OrderDTO, len(items))
+	for i, item := range items {
+		dtos[i] = s.orderToDTO(item)
+	}
+
+	return dtos, total, filter.Page, filter.PerPage, nil
+}
+
+func (s *orderService) orderToDTO(item *models.OrderHistoryItem) *models.OrderDTO {
+	dto := &models.OrderDTO{
+		ID:     item.ID,
+		Asset:  item.Asset,
+		Amount: item.Amount,
+		Status: item.Status,
+		Date:   s.jalaliConverter.FormatJalaliDate(item.CreatedAt),
+		Time:   s.jalaliConverter.FormatJalaliTime(item.CreatedAt),
+	}
+	if item.Gateway != nil {
+		dto.Gateway = *item.Gateway
+	}
+	if item.RefID != nil {
+		dto.RefID = *item.RefID
+	}
+	return dto
+}
+
+// buildOrderFilter validates the raw input and converts the inclusive date
+// range into [from 00:00, day after to 00:00)
+func buildOrderFilter(input ListOrdersInput) (models.OrderFilter, error) {
+	filter := models.OrderFilter{
+		Statuses: input.Statuses,
+		Gateway:  strings.TrimSpace(input.Gateway),
+		Page:     input.Page,
+		PerPage:  input.PerPage,
+	}
+
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PerPage < 1 {
+		filter.PerPage = defaultOrdersPerPage
+	}
+	if filter.PerPage > maxOrdersPerPage {
+		filter.PerPage = maxOrdersPerPage
+	}
+
+	if input.FromDate != "" {
+		from, err := parseOrderDate(input.FromDate)
+		if err != nil {
+			return filter, err
+		}
+		filter.From = &from
+	}
+	if input.ToDate != "" {
+		to, err := parseOrderDate(input.ToDate)
+		if err != nil {
+			return filter, err
+		}
+		to = to.AddDate(0, 0, 1)
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return filter, ErrInvalidOrderDateRange
+	}
+
+	return filter, nil
+}
+
+// parseOrderDate parses a Gregorian Y-m-d (or Y/m/d) date or a Jalali Y/m/d date.
+// Years before 1700 are treated as Jalali. The result is midnight UTC, matching
+// how created_at values are read from the database.
+func parseOrderDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+
+	parts := strings.Split(value, "/")
+	if len(parts) != 3 {
+		return time.Time{}, ErrInvalidOrderDate
+	}
+	var ymd [3]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return time.Time{}, ErrInvalidOrderDate
+		}
+		ymd[i] = n
+	}
+	year, month, day := ymd[0], ymd[1], ymd[2]
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return time.Time{}, ErrInvalidOrderDate
+	}
+
+	if year >= 1700 {
+		t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+		if t.Day() != day {
+			return time.Time{}, ErrInvalidOrderDate
+		}
+		return t, nil
+	}
+
+	pt := ptime.Date(year, ptime.Month(month), day, 0, 0, 0, 0, time.UTC)
+	// Reject days that do not exist in the month, e.g. 1403/07/31
+	if pt.Year() != year || int(pt.Month()) != month || pt.Day() != day {
+		return time.Time{}, ErrInvalidOrderDate
+	}
+	return pt.Time(), nil
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseOrderDate(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{value: "1403/08/09", want: time.Date(2024, 10, 30, 0, 0, 0, 0, time.UTC)},
+		{value: "1403/01/01", want: time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)},
+		{value: "1402/12/29", want: time.Date(2024, 3, 19, 0, 0, 0, 0, time.UTC)},
+		{value: "2024-10-30", want: time.Date(2024, 10, 30, 0, 0, 0, 0, time.UTC)},
+		{value: "2024/10/30", want: time.Date(2024, 10, 30, 0, 0, 0, 0, time.UTC)},
+		{value: "1403/07/31", wantErr: true},
+		{value: "2024-02-30", wantErr: true},
+		{value: "1403/13/01", wantErr: true},
+		{value: "1403/08", wantErr: true},
+		{value: "yesterday", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseOrderDate(tt.value)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseOrderDate(%q) = %s, want error", tt.value, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseOrderDate(%q) returned error: %v", tt.value, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("parseOrderDate(%q) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestBuildOrderFilter(t *testing.T) {
+	filter, err := buildOrderFilter(ListOrdersInput{FromDate: "1403/08/09", ToDate: "1403/08/09", PerPage: 500})
+	if err != nil {
+		t.Fatalf("buildOrderFilter returned error: %v", err)
+	}
+	if filter.Page != 1 || filter.PerPage != maxOrdersPerPage {
+		t.Errorf("pagination = (%d, %d), want (1, %d)", filter.Page, filter.PerPage, maxOrdersPerPage)
+	}
+	if got := filter.To.Sub(*filter.From); got != 24*time.Hour {
+		t.Errorf("single-day range spans %s, want 24h", got)
+	}
+
+	if _, err := buildOrderFilter(ListOrdersInput{FromDate: "1403/08/10", ToDate: "1403/08/09"}); err != ErrInvalidOrderDateRange {
+		t.Errorf("reversed range error = %v, want %v", err, ErrInvalidOrderDateRange)
+	}
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"fmt"
+	"net/url"
+
+	"metargb/commercial-service/internal/parsian"
+)
+
+// In sandbox mode the gateway is simulated instead of calling Parsian. The
+// outcome is chosen by the last two digits of the whole order amount, so QA
+// can drive every branch of checkout by picking the amount:
+//
+//	..13  the gateway refuses to issue a token and InitiatePayment fails
+//	..17  the user cancels on the gateway page (callback status -138)
+//	..31  the callback succeeds but verification is denied (status -1531)
+//	other the payment is approved and verified
+const (
+	sandboxDeclineRequestSuffix   = 13
+	sandboxCancelSuffix           = 17
+	sandboxDenyVerificationSuffix = 31
+)
+
+// sandboxCardHash is reported as the card used for every approved sandbox payment
+const sandboxCardHash = "603799******0000"
+
+func sandboxOutcome(amount float64) int64 {
+	return int64(amount) % 100
+}
+
+// sandboxToken encodes the outcome in the token so verification stays
+// deterministic without keeping any state between requests
+func sandboxToken(orderID uint64, amount float64) int64 {
+	return int64(orderID)*100 + sandboxOutcome(amount)
+}
+
+func sandboxRequestPayment(orderID uint64, amount float64) *parsian.RequestResponse {
+	if sandboxOutcome(amount) == sandboxDeclineRequestSuffix {
+		return &parsian.RequestResponse{Status: -138, Message: "sandbox: payment request declined"}
+	}
+	return &parsian.RequestResponse{Status: 0, Token: sandboxToken(orderID, amount)}
+}
+
+func sandboxVerifyPayment(token int64) *parsian.VerificationResponse {
+	if token <= 0 || token%100 == sandboxDenyVerificationSuffix {
+		return &parsian.VerificationResponse{Status: -1531}
+	}
+	return &parsian.VerificationResponse{
+		Status:      0,
+		ReferenceID: token,
+		CardHash:    sandboxCardHash,
+	}
+}
+
+// sandboxPaymentURL points straight at the callback with the fields Parsian
+// would post, instead of the bank's payment page
+func sandboxPaymentURL(callbackURL string, orderID uint64, token int64) (string, error) {
+	u, err := url.Parse(callbackURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid callback URL: %w", err)
+	}
+
+	status := 0
+	if token%100 == sandboxCancelSuffix {
+		status = -138
+	}
+
+	q := u.Query()
+	q.Set("OrderId", fmt.Sprintf("%d", orderID))
+	q.Set("Token", fmt.Sprintf("%d", token))
+	q.Set("status", fmt.Sprintf("%d", status))
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestSandboxRequestPayment(t *testing.T) {
+	declined := sandboxRequestPayment(5, 113)
+	if declined.Success() {
+		t.Errorf("expected amount ending in 13 to be declined")
+	}
+
+	approved := sandboxRequestPayment(5, 250)
+	if !approved.Success() {
+		t.Fatalf("expected amount 250 to be approved, got status %d", approved.Status)
+	}
+	if approved.Token != 550 {
+		t.Errorf("expected token 550, got %d", approved.Token)
+	}
+}
+
+func TestSandboxVerifyPayment(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  float64
+		success bool
+	}{
+		{name: "approved", amount: 40, success: true},
+		{name: "cancelled amount still verifies", amount: 117, success: true},
+		{name: "verification denied", amount: 231, success: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := sandboxVerifyPayment(sandboxToken(9, tt.amount))
+			if resp.Success() != tt.success {
+				t.Errorf("expected success=%v, got status %d", tt.success, resp.Status)
+			}
+			if tt.success && resp.CardHash != sandboxCardHash {
+				t.Errorf("expected sandbox card hash, got %q", resp.CardHash)
+			}
+		})
+	}
+}
+
+func TestSandboxPaymentURL(t *testing.T) {
+	tests := []struct {
+		amount float64
+		status string
+	}{
+		{amount: 100, status: "0"},
+		{amount: 217, status: "-138"},
+	}
+
+	for _, tt := range tests {
+		token := sandboxToken(3, tt.amount)
+		link, err := sandboxPaymentURL("https://example.com/api/parsian/callback", 3, token)
+		if err != nil {
+			t.Fatalf("sandboxPaymentURL failed: %v", err)
+		}
+
+		u, err := url.Parse(link)
+		if err != nil {
+			t.Fatalf("invalid link %q: %v", link, err)
+		}
+		q := u.Query()
+		if q.Get("OrderId") != "3" || q.Get("status") != tt.status {
+			t.Errorf("amount %v: unexpected callback query %q", tt.amount, u.RawQuery)
+		}
+	}
+}
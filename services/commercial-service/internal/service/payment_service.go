@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,12 +11,31 @@ import (
 	"metargb/commercial-service/internal/models"
 	"metargb/commercial-service/internal/parsian"
 	"metargb/commercial-service/internal/repository"
+	"metargb/shared/pkg/money"
+)
+
+var (
+	ErrPaymentOrderNotFound = errors.New("order not found")
+	ErrPaymentOrderNotPaid  = errors.New("order payment is not verified")
+	ErrInvalidPaymentState  = errors.New("payment state must be pending or failed")
 )
 
 type PaymentService interface {
-	InitiatePayment(ctx context.Context, userID uint64, asset string, amount float64) (string, uint64, string, error)
-	HandleCallback(ctx context.Context, orderID uint64, status int32, token int64) (bool, string, string, error)
+	InitiatePayment(ctx context.Context, userID uint64, asset string, amount decimal.Decimal, ip, device string) (string, uint64, string, error)
 	VerifyPayment(ctx context.Context, token int64, merchantID string) (bool, int32, int64, string, string, error)
+	// ScreenPayment runs the fraud rules on a store order financial-service is
+	// about to place, returning ErrFraudDenied or ErrFraudReview to refuse it
+	ScreenPayment(ctx context.Context, userID uint64, asset string, amount decimal.Decimal, ip, device string) error
+	// ScreenPaymentCard returns ErrFraudDenied if the card paying for a store
+	// order is blocklisted
+	ScreenPaymentCard(ctx context.Context, userID, orderID uint64, asset string, amount decimal.Decimal, cardPan string) error
+	// SettleOrder credits a store order financial-service has verified with the
+	// bank: the order amount plus any first order bonus, then announces it paid
+	// with the bank's refID. It returns the credited amount and the bonus.
+	SettleOrder(ctx context.Context, orderID uint64, refID int64) (decimal.Decimal, decimal.Decimal, error)
+	// PublishOrderStatus announces a pending or failed store order of
+	// financial-service to the user waiting on the payment page
+	PublishOrderStatus(ctx context.Context, orderID uint64, status, message string) error
 }
 
 type paymentService struct {
@@ -29,14 +49,25 @@ type paymentService struct {
 	referralService ReferralService
 	orderPolicy     OrderPolicy
 	jalaliConverter JalaliConverter
+	fraud           FraudChecker
+	statusPublisher OrderStatusPublisher
 	config          *PaymentConfig
 }
 
+// OrderStatusPublisher announces order payment state changes so the payment
+// page can follow them without polling, implemented by pubsub.OrderEvents
+type OrderStatusPublisher interface {
+	PublishOrderStatusChanged(ctx context.Context, event models.OrderStatusEvent) error
+}
+
 // PaymentConfig holds payment-specific configuration
 type PaymentConfig struct {
 	ParsianMerchantID            string
 	ParsianLoanAccountMerchantID string
 	ParsianCallbackURL           string
+	// Sandbox simulates gateway approval/denial from the order amount instead
+	// of calling Parsian. For QA environments only.
+	Sandbox bool
 }
 
 func NewPaymentService(
@@ -50,6 +81,8 @@ func NewPaymentService(
 	referralService ReferralService,
 	orderPolicy OrderPolicy,
 	jalaliConverter JalaliConverter,
+	fraud FraudChecker,
+	statusPublisher OrderStatusPublisher,
 	config *PaymentConfig,
 ) PaymentService {
 	return &paymentService{
@@ -63,17 +96,24 @@ func NewPaymentService(
 		referralService: referralService,
 		orderPolicy:     orderPolicy,
 		jalaliConverter: jalaliConverter,
+		fraud:           fraud,
+		statusPublisher: statusPublisher,
 		config:          config,
 	}
 }
 
-func (s *paymentService) InitiatePayment(ctx context.Context, userID uint64, asset string, amount float64) (string, uint64, string, error) {
+func (s *paymentService) InitiatePayment(ctx context.Context, userID uint64, asset string, amount decimal.Decimal, ip, device string) (string, uint64, string, error) {
+	// Run the fraud rules before anything is created
+	if err := s.ScreenPayment(ctx, userID, asset, amount, ip, device); err != nil {
+		return "", 0, "", err
+	}
+
 	// Create order
 	order := &models.Order{
 		UserID: userID,
 		Asset:  asset,
 		Amount: amount,
-		Status: 0, // Pending
+		Status: models.OrderStatusPending,
 	}
 
 	err := s.orderRepo.Create(ctx, order)
@@ -83,13 +123,16 @@ func (s *paymentService) InitiatePayment(ctx context.Context, userID uint64, ass
 
 	// Create transaction
 	transactionID := fmt.Sprintf("TR-%d", time.Now().UnixNano())
+	payableType := models.OrderPayableType
 	transaction := &models.Transaction{
-		ID:     transactionID,
-		UserID: userID,
-		Asset:  asset,
-		Amount: amount,
-		Action: "deposit",
-		Status: 0, // Pending
+		ID:          transactionID,
+		UserID:      userID,
+		Asset:       asset,
+		Amount:      amount,
+		Action:      "deposit",
+		Status:      models.OrderStatusPending,
+		PayableType: &payableType,
+		PayableID:   &order.ID,
 	}
 
 	err = s.transactionRepo.Create(ctx, transaction)
@@ -103,7 +146,7 @@ func (s *paymentService) InitiatePayment(ctx context.Context, userID uint64, ass
 		return "", 0, "", fmt.Errorf("failed to get asset rate: %w", err)
 	}
 
-	amountInRials := int64(amount * rate)
+	amountInRials := amount.Mul(decimal.NewFromFloat(rate)).IntPart()
 
 	// Determine merchant ID (regular or loan account)
 	// Laravel: $merchantId = $order->asset !== 'irr' ? config('parsian.merchant_id') : config('parsian.loan_account_merchant_id');
@@ -120,7 +163,7 @@ func (s *paymentService) InitiatePayment(ctx context.Context, userID uint64, ass
 		Originator:     "",
 	}
 
-	response, err := s.parsianClient.RequestPayment(params)
+	response, err := s.requestPayment(ctx, params, order)
 	if err != nil {
 		return "", 0, "", fmt.Errorf("failed to request payment: %w", err)
 	}
@@ -137,10 +180,35 @@ func (s *paymentService) InitiatePayment(ctx context.Context, userID uint64, ass
 		return "", 0, "", fmt.Errorf("failed to update transaction with token: %w", err)
 	}
 
+	s.publishOrderStatus(ctx, order, models.OrderPaymentPending, "", 0)
+
 	// Return payment URL
+	if s.config.Sandbox {
+		link, err := sandboxPaymentURL(s.config.ParsianCallbackURL, order.ID, response.Token)
+		if err != nil {
+			return "", 0, "", err
+		}
+		return link, order.ID, transactionID, nil
+	}
 	return response.URL(), order.ID, transactionID, nil
 }
 
+// requestPayment sends the purchase request to Parsian, or simulates it in sandbox mode
+func (s *paymentService) requestPayment(ctx context.Context, params parsian.RequestParams, order *models.Order) (*parsian.RequestResponse, error) {
+	if s.config.Sandbox {
+		return sandboxRequestPayment(order.ID, money.Float(order.Amount)), nil
+	}
+	return s.parsianClient.RequestPayment(ctx, params)
+}
+
+// verifyPayment confirms a payment with Parsian, or simulates it in sandbox mode
+func (s *paymentService) verifyPayment(ctx context.Context, params parsian.VerificationParams) (*parsian.VerificationResponse, error) {
+	if s.config.Sandbox {
+		return sandboxVerifyPayment(params.Token), nil
+	}
+	return s.parsianClient.VerifyPayment(ctx, params)
+}
+
 // getMerchantID returns the appropriate merchant ID based on asset
 // Laravel logic from OrderController.php lines 48-50
 func (s *paymentService) getMerchantID(asset string) string {
@@ -150,154 +218,148 @@ func (s *paymentService) getMerchantID(asset string) string {
 	return s.config.ParsianLoanAccountMerchantID // Loan account merchant ID
 }
 
-func (s *paymentService) HandleCallback(ctx context.Context, orderID uint64, status int32, token int64) (bool, string, string, error) {
+func (s *paymentService) ScreenPayment(ctx context.Context, userID uint64, asset string, amount decimal.Decimal, ip, device string) error {
+	if s.fraud == nil {
+		return nil
+	}
+	return s.fraud.Check(ctx, FraudCheckInput{
+		UserID: userID,
+		Kind:   models.FraudKindPayment,
+		Asset:  asset,
+		Amount: amount,
+		IP:     ip,
+		Device: device,
+	})
+}
+
+func (s *paymentService) ScreenPaymentCard(ctx context.Context, userID, orderID uint64, asset string, amount decimal.Decimal, cardPan string) error {
+	if s.fraud == nil {
+		return nil
+	}
+	return s.fraud.CheckCard(ctx, userID, orderID, asset, amount, cardPan)
+}
+
+func (s *paymentService) SettleOrder(ctx context.Context, orderID uint64, refID int64) (decimal.Decimal, decimal.Decimal, error) {
 	order, err := s.orderRepo.FindByID(ctx, orderID)
 	if err != nil {
-		return false, "", "Failed to find order", err
+		return decimal.Zero, decimal.Zero, fmt.Errorf("failed to find order: %w", err)
 	}
 	if order == nil {
-		return false, "", "Order not found", fmt.Errorf("order not found")
+		return decimal.Zero, decimal.Zero, ErrPaymentOrderNotFound
+	}
+	// financial-service stores the bank's status 0 once the payment is verified
+	if order.Status != 0 {
+		return decimal.Zero, decimal.Zero, ErrPaymentOrderNotPaid
 	}
 
-	redirectURL := "/payment/result"
-	message := "Payment processed"
-
-	// Check if status from gateway is success (0)
-	// Laravel: if ($request->status == 0)
-	if status == 0 { // Success from gateway
-		// Get rate to calculate amount in Rials
-		rate, err := s.variableRepo.GetRate(ctx, order.Asset)
-		if err != nil {
-			return false, "", "Failed to get rate", err
-		}
-
-		amount := order.Amount * rate
-
-		// Determine merchant ID for verification
-		merchantID := s.getMerchantID(order.Asset)
-
-		// Verify payment with Parsian
-		// Matches Laravel: parsian()->token($transaction->token)->merchantId($merchantId)->verification()->send()
-		verifyParams := parsian.VerificationParams{
-			MerchantID: merchantID,
-			Token:      token,
-		}
-
-		verifyResponse, err := s.parsianClient.VerifyPayment(verifyParams)
-		if err != nil {
-			return false, "", "Failed to verify payment", err
-		}
-
-		// Check if verification was successful
-		if !verifyResponse.Success() {
-			// Verification failed
-			order.Status = verifyResponse.Status
-			s.orderRepo.Update(ctx, order)
-
-			// Update transaction
-			// TODO: Get transaction by order_id and update status
+	rate, err := s.variableRepo.GetRate(ctx, order.Asset)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("failed to get rate: %w", err)
+	}
+	// Payments are recorded in whole rials, like the amount sent to the gateway
+	amount := order.Amount.Mul(decimal.NewFromFloat(rate)).Truncate(0)
 
-			return false, "", verifyResponse.Error().Message(), nil
-		}
+	credited, bonus, err := s.creditOrder(ctx, order, amount)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
 
-		// Verification successful - update order status
-		order.Status = verifyResponse.Status
-		err = s.orderRepo.Update(ctx, order)
-		if err != nil {
-			return false, "", "Failed to update order", err
-		}
+	// The wallet is credited, so the payment page may show the new balance
+	s.publishOrderStatus(ctx, order, models.OrderPaymentPaid, "Payment successful", refID)
+	return credited, bonus, nil
+}
 
-		// Update transaction with reference ID and status
-		// TODO: Get transaction by order_id and update with ref_id and status
-
-		// Create payment record
-		// Matches Laravel OrderController.php lines 129-136
-		payment := &models.Payment{
-			UserID:  order.UserID,
-			RefID:   verifyResponse.ReferenceID,
-			CardPan: verifyResponse.CardHash,
-			Gateway: "parsian",
-			Amount:  amount,
-			Product: order.Asset,
-		}
+func (s *paymentService) PublishOrderStatus(ctx context.Context, orderID uint64, status, message string) error {
+	if status != models.OrderPaymentPending && status != models.OrderPaymentFailed {
+		return ErrInvalidPaymentState
+	}
 
-		err = s.paymentRepo.Create(ctx, payment)
-		if err != nil {
-			// Log error but don't fail the transaction
-			fmt.Printf("Warning: failed to create payment record: %v\n", err)
-		}
+	order, err := s.orderRepo.FindByID(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("failed to find order: %w", err)
+	}
+	if order == nil {
+		return ErrPaymentOrderNotFound
+	}
 
-		message = "Payment successful"
+	s.publishOrderStatus(ctx, order, status, message, 0)
+	return nil
+}
 
-		// Check if user can get first order bonus
-		canGetBonus, err := s.orderPolicy.CanGetBonus(ctx, order.UserID, order.Asset)
-		if err != nil {
-			return false, "", "Failed to check bonus eligibility", err
-		}
+// creditOrder adds a paid order to the wallet, with the first order bonus when
+// the first_order_* rules grant it, and pays the referrer's commission.
+// irrAmount is the order's value in rials.
+func (s *paymentService) creditOrder(ctx context.Context, order *models.Order, irrAmount decimal.Decimal) (decimal.Decimal, decimal.Decimal, error) {
+	firstOrder, err := s.orderPolicy.EvaluateFirstOrderBonus(ctx, order.UserID, order.Asset, order.Amount, irrAmount)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("failed to check bonus eligibility: %w", err)
+	}
 
-		if canGetBonus {
-			// User gets 50% bonus on first order
-			bonus := order.Amount * 0.5
-			totalAmount := order.Amount + bonus
-
-			// Add order amount + bonus to wallet
-			totalAmountDec := decimal.NewFromFloat(totalAmount)
-			err = s.walletRepo.AddBalance(ctx, order.UserID, order.Asset, totalAmountDec)
-			if err != nil {
-				return false, "", "Failed to add balance with bonus", err
-			}
-
-			// Get current Jalali date
-			jalaliDate := s.jalaliConverter.NowJalali()
-
-			// Create first order record
-			firstOrder := &models.FirstOrder{
-				UserID: order.UserID,
-				Type:   order.Asset,
-				Amount: order.Amount,
-				Date:   jalaliDate,
-				Bonus:  bonus,
-			}
-
-			err = s.firstOrderRepo.Create(ctx, firstOrder)
-			if err != nil {
-				// Log error but don't fail the transaction
-				fmt.Printf("Warning: failed to create first order record: %v\n", err)
-			}
-		} else {
-			// Regular order - add only order amount
-			amountDec := decimal.NewFromFloat(order.Amount)
-			err = s.walletRepo.AddBalance(ctx, order.UserID, order.Asset, amountDec)
-			if err != nil {
-				return false, "", "Failed to add balance", err
-			}
+	if !firstOrder.Eligible {
+		// Regular order - add only order amount
+		if err := s.walletRepo.AddBalance(ctx, order.UserID, order.Asset, order.Amount); err != nil {
+			return decimal.Zero, decimal.Zero, fmt.Errorf("failed to add balance: %w", err)
 		}
+		s.payReferralCommission(ctx, order)
+		return order.Amount, decimal.Zero, nil
+	}
 
-		// Process referral commission (only if asset is not IRR)
-		if order.Asset != "irr" {
-			err = s.referralService.ProcessReferralCommission(ctx, order.UserID, order)
-			if err != nil {
-				// Log error but don't fail the transaction
-				fmt.Printf("Warning: failed to process referral commission: %v\n", err)
-			}
-		}
+	// The first_order_* variables set the bonus, 50% by default
+	bonus := firstOrder.Bonus
+	totalAmount := order.Amount.Add(bonus)
 
-		// TODO: Send notification (requires gRPC call to notifications service)
-		// user->notify(new TransactionNotification($order));
+	// Add order amount + bonus to wallet
+	if err := s.walletRepo.AddBalance(ctx, order.UserID, order.Asset, totalAmount); err != nil {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("failed to add balance with bonus: %w", err)
+	}
 
-		// TODO: Call user.deposit() for score tracking (requires gRPC call to levels service)
-		// $user->deposit();
+	// Create first order record
+	record := &models.FirstOrder{
+		UserID: order.UserID,
+		Type:   order.Asset,
+		Amount: order.Amount,
+		Date:   s.jalaliConverter.NowJalali(),
+		Bonus:  bonus,
+	}
+	if err := s.firstOrderRepo.Create(ctx, record); err != nil {
+		// Log error but don't fail the transaction
+		fmt.Printf("Warning: failed to create first order record: %v\n", err)
+	}
 
-		return true, redirectURL, message, nil
-	} else {
-		// Payment failed
-		order.Status = status
-		s.orderRepo.Update(ctx, order)
+	s.payReferralCommission(ctx, order)
+	return totalAmount, bonus, nil
+}
 
-		// TODO: Update transaction status
+// payReferralCommission pays the referrer's share of a credited order (only if
+// asset is not IRR). The wallet is already credited, so a failure is logged and
+// left to the referral-recalc command, which pays missing rewards.
+func (s *paymentService) payReferralCommission(ctx context.Context, order *models.Order) {
+	if order.Asset == "irr" {
+		return
+	}
+	if err := s.referralService.ProcessReferralCommission(ctx, order.UserID, order); err != nil {
+		fmt.Printf("Warning: failed to process referral commission for order %d: %v\n", order.ID, err)
+	}
+}
 
-		message = "Payment failed"
-		return false, redirectURL, message, nil
+// publishOrderStatus announces the order's payment state. A lost event only
+// means the payment page falls back to polling, so errors are logged.
+func (s *paymentService) publishOrderStatus(ctx context.Context, order *models.Order, status, message string, refID int64) {
+	if s.statusPublisher == nil {
+		return
+	}
+	err := s.statusPublisher.PublishOrderStatusChanged(ctx, models.OrderStatusEvent{
+		OrderID: order.ID,
+		UserID:  order.UserID,
+		Asset:   order.Asset,
+		Amount:  order.Amount,
+		Status:  status,
+		Code:    order.Status,
+		Message: message,
+		RefID:   refID,
+	})
+	if err != nil {
+		fmt.Printf("Warning: failed to publish order %d status: %v\n", order.ID, err)
 	}
 }
 
@@ -309,7 +371,7 @@ func (s *paymentService) VerifyPayment(ctx context.Context, token int64, merchan
 		Token:      token,
 	}
 
-	response, err := s.parsianClient.VerifyPayment(params)
+	response, err := s.verifyPayment(ctx, params)
 	if err != nil {
 		return false, 0, 0, "", fmt.Sprintf("Verification failed: %s", err.Error()), err
 	}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+)
+
+type fakePaymentOrderRepository struct {
+	repository.OrderRepository
+	orders map[uint64]*models.Order
+}
+
+func (f *fakePaymentOrderRepository) FindByID(ctx context.Context, id uint64) (*models.Order, error) {
+	return f.orders[id], nil
+}
+
+func (f *fakePaymentOrderRepository) Update(ctx context.Context, order *models.Order) error {
+	f.orders[order.ID] = order
+	return nil
+}
+
+type recordingOrderStatusPublisher struct {
+	events []models.OrderStatusEvent
+}
+
+func (r *recordingOrderStatusPublisher) PublishOrderStatusChanged(ctx context.Context, event models.OrderStatusEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func TestPublishOrderStatusWithoutPublisher(t *testing.T) {
+	orders := &fakePaymentOrderRepository{orders: map[uint64]*models.Order{9: {ID: 9, UserID: 4}}}
+	svc := NewPaymentService(orders, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, &PaymentConfig{})
+
+	if err := svc.PublishOrderStatus(context.Background(), 9, models.OrderPaymentFailed, ""); err != nil {
+		t.Fatalf("PublishOrderStatus() error = %v", err)
+	}
+}
+
+type fakeSettlementWallets struct {
+	repository.WalletRepository
+	credits map[string]decimal.Decimal
+}
+
+func (f *fakeSettlementWallets) AddBalance(ctx context.Context, userID uint64, asset string, amount decimal.Decimal) error {
+	f.credits[asset] = f.credits[asset].Add(amount)
+	return nil
+}
+
+type fakeSettlementRates struct {
+	repository.VariableRepository
+}
+
+func (fakeSettlementRates) GetRate(ctx context.Context, asset string) (float64, error) {
+	return 1000, nil
+}
+
+type fakeFirstOrders struct {
+	repository.FirstOrderRepository
+	created []*models.FirstOrder
+}
+
+func (f *fakeFirstOrders) Create(ctx context.Context, firstOrder *models.FirstOrder) error {
+	f.created = append(f.created, firstOrder)
+	return nil
+}
+
+// fakeBonusPolicy grants percent of the order as first order bonus
+type fakeBonusPolicy struct {
+	OrderPolicy
+	percent  int64
+	irrValue decimal.Decimal
+}
+
+func (f *fakeBonusPolicy) EvaluateFirstOrderBonus(ctx context.Context, userID uint64, asset string, amount, irrValue decimal.Decimal) (*FirstOrderBonus, error) {
+	f.irrValue = irrValue
+	if f.percent == 0 {
+		return &FirstOrderBonus{Reason: "already_received", Bonus: decimal.Zero}, nil
+	}
+	return &FirstOrderBonus{Eligible: true, Reason: "eligible", Bonus: amount.Mul(decimal.NewFromInt(f.percent)).Div(decimal.NewFromInt(100))}, nil
+}
+
+type fakeReferrals struct {
+	ReferralService
+	paid []uint64
+}
+
+func (f *fakeReferrals) ProcessReferralCommission(ctx context.Context, userID uint64, order *models.Order) error {
+	f.paid = append(f.paid, order.ID)
+	return nil
+}
+
+func TestSettleOrderCreditsConfiguredBonus(t *testing.T) {
+	orders := &fakePaymentOrderRepository{orders: map[uint64]*models.Order{
+		9:  {ID: 9, UserID: 4, Asset: "psc", Amount: decimal.NewFromInt(200), Status: 0},
+		10: {ID: 10, UserID: 4, Asset: "psc", Amount: decimal.NewFromInt(200), Status: models.OrderStatusPending},
+	}}
+	wallets := &fakeSettlementWallets{credits: map[string]decimal.Decimal{}}
+	firstOrders := &fakeFirstOrders{}
+	policy := &fakeBonusPolicy{percent: 25}
+	referrals := &fakeReferrals{}
+	publisher := &recordingOrderStatusPublisher{}
+	svc := NewPaymentService(orders, nil, nil, wallets, firstOrders, fakeSettlementRates{}, nil, referrals, policy, NewJalaliConverter(), nil, publisher, &PaymentConfig{})
+
+	credited, bonus, err := svc.SettleOrder(context.Background(), 9, 738201)
+	if err != nil {
+		t.Fatalf("SettleOrder() error = %v", err)
+	}
+	if !credited.Equal(decimal.NewFromInt(250)) || !bonus.Equal(decimal.NewFromInt(50)) {
+		t.Errorf("SettleOrder() = %s, %s; want 250 with a bonus of 50", credited, bonus)
+	}
+	if !wallets.credits["psc"].Equal(decimal.NewFromInt(250)) {
+		t.Errorf("wallet credited %s psc, want 250", wallets.credits["psc"])
+	}
+	if !policy.irrValue.Equal(decimal.NewFromInt(200000)) {
+		t.Errorf("bonus evaluated at %s rials, want 200000", policy.irrValue)
+	}
+	if len(firstOrders.created) != 1 || !firstOrders.created[0].Bonus.Equal(decimal.NewFromInt(50)) {
+		t.Errorf("first order records = %+v, want one with a bonus of 50", firstOrders.created)
+	}
+	if len(referrals.paid) != 1 || referrals.paid[0] != 9 {
+		t.Errorf("referral commissions paid for orders %v, want [9]", referrals.paid)
+	}
+	if len(publisher.events) != 1 || publisher.events[0].Status != models.OrderPaymentPaid || publisher.events[0].RefID != 738201 {
+		t.Errorf("events = %+v, want order 9 paid with ref 738201", publisher.events)
+	}
+
+	if _, _, err := svc.SettleOrder(context.Background(), 10, 0); !errors.Is(err, ErrPaymentOrderNotPaid) {
+		t.Errorf("SettleOrder(unpaid) error = %v, want ErrPaymentOrderNotPaid", err)
+	}
+	if _, _, err := svc.SettleOrder(context.Background(), 11, 0); !errors.Is(err, ErrPaymentOrderNotFound) {
+		t.Errorf("SettleOrder(missing) error = %v, want ErrPaymentOrderNotFound", err)
+	}
+}
+
+func TestSettleOrderWithoutBonus(t *testing.T) {
+	orders := &fakePaymentOrderRepository{orders: map[uint64]*models.Order{
+		9:  {ID: 9, UserID: 4, Asset: "red", Amount: decimal.NewFromInt(30), Status: 0},
+		12: {ID: 12, UserID: 4, Asset: "irr", Amount: decimal.NewFromInt(5000), Status: 0},
+	}}
+	wallets := &fakeSettlementWallets{credits: map[string]decimal.Decimal{}}
+	firstOrders := &fakeFirstOrders{}
+	referrals := &fakeReferrals{}
+	svc := NewPaymentService(orders, nil, nil, wallets, firstOrders, fakeSettlementRates{}, nil, referrals, &fakeBonusPolicy{}, NewJalaliConverter(), nil, nil, &PaymentConfig{})
+
+	credited, bonus, err := svc.SettleOrder(context.Background(), 9, 0)
+	if err != nil || !credited.Equal(decimal.NewFromInt(30)) || !bonus.IsZero() {
+		t.Fatalf("SettleOrder() = %s, %s, %v; want 30 without a bonus", credited, bonus, err)
+	}
+	if len(firstOrders.created) != 0 {
+		t.Errorf("order without a bonus recorded as first order")
+	}
+
+	// irr purchases pay no referral commission
+	if _, _, err := svc.SettleOrder(context.Background(), 12, 0); err != nil {
+		t.Fatalf("SettleOrder(irr) error = %v", err)
+	}
+	if len(referrals.paid) != 1 || referrals.paid[0] != 9 {
+		t.Errorf("referral commissions paid for orders %v, want [9]", referrals.paid)
+	}
+}
+
+func TestPublishOrderStatus(t *testing.T) {
+	orders := &fakePaymentOrderRepository{orders: map[uint64]*models.Order{
+		9: {ID: 9, UserID: 4, Asset: "psc", Amount: decimal.NewFromInt(200), Status: -138},
+	}}
+	publisher := &recordingOrderStatusPublisher{}
+	svc := NewPaymentService(orders, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, publisher, &PaymentConfig{})
+
+	if err := svc.PublishOrderStatus(context.Background(), 9, models.OrderPaymentFailed, "Payment failed"); err != nil {
+		t.Fatalf("PublishOrderStatus() error = %v", err)
+	}
+	if len(publisher.events) != 1 {
+		t.Fatalf("published %d events, want 1", len(publisher.events))
+	}
+	event := publisher.events[0]
+	if event.OrderID != 9 || event.UserID != 4 || event.Status != models.OrderPaymentFailed || event.Code != -138 {
+		t.Errorf("event = %+v, want order 9 of user 4 failed with code -138", event)
+	}
+
+	// Only SettleOrder announces paid orders, once the wallet is credited
+	if err := svc.PublishOrderStatus(context.Background(), 9, models.OrderPaymentPaid, ""); !errors.Is(err, ErrInvalidPaymentState) {
+		t.Errorf("PublishOrderStatus(paid) error = %v, want ErrInvalidPaymentState", err)
+	}
+	if err := svc.PublishOrderStatus(context.Background(), 11, models.OrderPaymentPending, ""); !errors.Is(err, ErrPaymentOrderNotFound) {
+		t.Errorf("PublishOrderStatus(missing) error = %v, want ErrPaymentOrderNotFound", err)
+	}
+	if len(publisher.events) != 1 {
+		t.Errorf("published %d events, want 1", len(publisher.events))
+	}
+}
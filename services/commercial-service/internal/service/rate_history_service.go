@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+)
+
+// RateTrendWindow is how far back display rates compare the current rates
+const RateTrendWindow = 24 * time.Hour
+
+// displayPSCDecimals is the precision of rates converted to PSC
+const displayPSCDecimals = 6
+
+// displayRateAssets are the assets shown in price tickers, in display order
+var displayRateAssets = []string{"psc", "red", "blue", "yellow"}
+
+// RateHistoryService records the asset rates over time and serves them with
+// their recent change for price tickers
+type RateHistoryService interface {
+	// DisplayRates returns the set asset rates in IRR and PSC with their
+	// change since RateTrendWindow before now
+	DisplayRates(ctx context.Context, now time.Time) ([]*models.DisplayRate, error)
+	// RecordRates records every asset rate that differs from its last record
+	// and returns how many were recorded
+	RecordRates(ctx context.Context, now time.Time) (int, error)
+}
+
+type rateHistoryService struct {
+	variableRepo repository.VariableRepository
+	historyRepo  repository.RateHistoryRepository
+}
+
+func NewRateHistoryService(variableRepo repository.VariableRepository, historyRepo repository.RateHistoryRepository) RateHistoryService {
+	return &rateHistoryService{
+		variableRepo: variableRepo,
+		historyRepo:  historyRepo,
+	}
+}
+
+func (s *rateHistoryService) DisplayRates(ctx context.Context, now time.Time) ([]*models.DisplayRate, error) {
+	current, err := s.currentRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := s.historyRepo.RatesAt(ctx, now.Add(-RateTrendWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	currentPSC, hasPSC := current["psc"]
+	previousPSC, hadPSC := previous["psc"]
+
+	rates := make([]*models.DisplayRate, 0, len(displayRateAssets))
+	for _, asset := range displayRateAssets {
+		irr, ok := current[asset]
+		if !ok {
+			continue
+		}
+		rate := &models.DisplayRate{Asset: asset, IRR: irr}
+		if hasPSC {
+			rate.PSC = decimal.NewNullDecimal(irr.Div(currentPSC).Round(displayPSCDecimals))
+		}
+
+		if before, ok := previous[asset]; ok {
+			rate.PreviousIRR = decimal.NewNullDecimal(before)
+			rate.IRRChange = decimal.NewNullDecimal(irr.Sub(before))
+			rate.IRRChangePercent = changePercent(before, irr)
+			if hadPSC && rate.PSC.Valid {
+				beforePSC := before.Div(previousPSC).Round(displayPSCDecimals)
+				rate.PreviousPSC = decimal.NewNullDecimal(beforePSC)
+				rate.PSCChangePercent = changePercent(beforePSC, rate.PSC.Decimal)
+			}
+		}
+		rates = append(rates, rate)
+	}
+	return rates, nil
+}
+
+func (s *rateHistoryService) RecordRates(ctx context.Context, now time.Time) (int, error) {
+	current, err := s.currentRates(ctx)
+	if err != nil {
+		return 0, err
+	}
+	last, err := s.historyRepo.RatesAt(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	recorded := 0
+	for _, asset := range displayRateAssets {
+		rate, ok := current[asset]
+		if !ok {
+			continue
+		}
+		if before, ok := last[asset]; ok && before.Equal(rate) {
+			continue
+		}
+		if err := s.historyRepo.Record(ctx, asset, rate, now); err != nil {
+			return recorded, err
+		}
+		recorded++
+	}
+	return recorded, nil
+}
+
+// currentRates returns the set, positive rates of the display assets
+func (s *rateHistoryService) currentRates(ctx context.Context) (map[string]decimal.Decimal, error) {
+	rates := make(map[string]decimal.Decimal, len(displayRateAssets))
+	for _, asset := range displayRateAssets {
+		def, ok := findVariableDefinition(asset)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrVariableUnknown, asset)
+		}
+		value, err := s.variableRepo.GetValue(ctx, def)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s rate: %w", asset, err)
+		}
+		// A zero rate would divide by zero and is as good as unset
+		if value.IsSet && value.Value.IsPositive() {
+			rates[asset] = value.Value
+		}
+	}
+	return rates, nil
+}
+
+// changePercent returns the change from before to after in percent, rounded
+// to 2 decimals
+func changePercent(before, after decimal.Decimal) decimal.NullDecimal {
+	if !before.IsPositive() {
+		return decimal.NullDecimal{}
+	}
+	return decimal.NewNullDecimal(after.Sub(before).Div(before).Mul(decimal.NewFromInt(100)).Round(2))
+}
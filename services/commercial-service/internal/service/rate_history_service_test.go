@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type rateRecord struct {
+	asset string
+	rate  decimal.Decimal
+	at    time.Time
+}
+
+type fakeRateHistoryRepository struct {
+	records []rateRecord
+}
+
+func (r *fakeRateHistoryRepository) RatesAt(_ context.Context, at time.Time) (map[string]decimal.Decimal, error) {
+	rates := make(map[string]decimal.Decimal)
+	for _, record := range r.records {
+		if !record.at.After(at) {
+			rates[record.asset] = record.rate
+		}
+	}
+	return rates, nil
+}
+
+func (r *fakeRateHistoryRepository) Record(_ context.Context, asset string, rate decimal.Decimal, at time.Time) error {
+	r.records = append(r.records, rateRecord{asset: asset, rate: rate, at: at})
+	return nil
+}
+
+func TestRateHistoryRecordsOnlyChanges(t *testing.T) {
+	variables := &fakeVariableRepository{set: map[string]decimal.Decimal{
+		"psc": decimal.NewFromInt(1000),
+		"red": decimal.NewFromInt(500),
+	}}
+	history := &fakeRateHistoryRepository{}
+	svc := NewRateHistoryService(variables, history)
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	if recorded, err := svc.RecordRates(context.Background(), now); err != nil || recorded != 2 {
+		t.Fatalf("expected 2 rates recorded, got %d, %v", recorded, err)
+	}
+	if recorded, err := svc.RecordRates(context.Background(), now.Add(time.Minute)); err != nil || recorded != 0 {
+		t.Fatalf("expected unchanged rates to be skipped, got %d, %v", recorded, err)
+	}
+
+	variables.set["red"] = decimal.NewFromInt(600)
+	if recorded, err := svc.RecordRates(context.Background(), now.Add(2*time.Minute)); err != nil || recorded != 1 {
+		t.Fatalf("expected the changed rate to be recorded, got %d, %v", recorded, err)
+	}
+	if last := history.records[len(history.records)-1]; last.asset != "red" || !last.rate.Equal(decimal.NewFromInt(600)) {
+		t.Errorf("unexpected last record %+v", last)
+	}
+}
+
+func TestDisplayRatesTrends(t *testing.T) {
+	now := time.Date(2026, 5, 2, 12, 0, 0, 0, time.UTC)
+	variables := &fakeVariableRepository{set: map[string]decimal.Decimal{
+		"psc":  decimal.NewFromInt(1250),
+		"red":  decimal.NewFromInt(500),
+		"blue": decimal.NewFromInt(300),
+	}}
+	history := &fakeRateHistoryRepository{records: []rateRecord{
+		{asset: "psc", rate: decimal.NewFromInt(1000), at: now.Add(-48 * time.Hour)},
+		{asset: "red", rate: decimal.NewFromInt(400), at: now.Add(-30 * time.Hour)},
+		// Newer than the window, so it is the current rate rather than the previous one
+		{asset: "red", rate: decimal.NewFromInt(500), at: now.Add(-time.Hour)},
+		{asset: "blue", rate: decimal.NewFromInt(300), at: now.Add(-time.Hour)},
+	}}
+	svc := NewRateHistoryService(variables, history)
+
+	rates, err := svc.DisplayRates(context.Background(), now)
+	if err != nil {
+		t.Fatalf("DisplayRates returned error: %v", err)
+	}
+	if len(rates) != 3 || rates[0].Asset != "psc" || rates[1].Asset != "red" || rates[2].Asset != "blue" {
+		t.Fatalf("unexpected rates %+v", rates)
+	}
+
+	psc := rates[0]
+	if !psc.PSC.Decimal.Equal(decimal.NewFromInt(1)) || psc.IRRChangePercent.Decimal.String() != "25" || psc.PSCChangePercent.Decimal.String() != "0" {
+		t.Errorf("unexpected psc rate %+v", psc)
+	}
+
+	red := rates[1]
+	if red.PSC.Decimal.String() != "0.4" || red.IRRChange.Decimal.String() != "100" || red.IRRChangePercent.Decimal.String() != "25" {
+		t.Errorf("unexpected red IRR trend %+v", red)
+	}
+	// 0.4 PSC now against 0.4 PSC a day ago, the PSC rose as much as red
+	if red.PreviousPSC.Decimal.String() != "0.4" || red.PSCChangePercent.Decimal.String() != "0" {
+		t.Errorf("unexpected red PSC trend %+v", red)
+	}
+
+	blue := rates[2]
+	if blue.PreviousIRR.Valid || blue.IRRChange.Valid || blue.PSCChangePercent.Valid {
+		t.Errorf("expected no trend without history from a day ago, got %+v", blue)
+	}
+}
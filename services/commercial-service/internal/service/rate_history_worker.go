@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"metargb/shared/pkg/jobs"
+)
+
+// DefaultRateHistoryInterval is how often the asset rates are checked for
+// changes. A change shows in the 24h trends at most this late.
+const DefaultRateHistoryInterval = 5 * time.Minute
+
+// RateHistoryWorker periodically records changed asset rates, whether they
+// were set through the admin API or directly in the database
+type RateHistoryWorker struct {
+	rates    RateHistoryService
+	interval time.Duration
+}
+
+// NewRateHistoryWorker creates a worker that runs every interval
+// (DefaultRateHistoryInterval if zero)
+func NewRateHistoryWorker(rates RateHistoryService, interval time.Duration) *RateHistoryWorker {
+	if interval <= 0 {
+		interval = DefaultRateHistoryInterval
+	}
+	return &RateHistoryWorker{
+		rates:    rates,
+		interval: interval,
+	}
+}
+
+// Register runs the worker once at start and then every interval under runner
+func (w *RateHistoryWorker) Register(runner *jobs.Runner) {
+	runner.AddImmediate("rate-history", w.interval, func(ctx context.Context) error {
+		_, err := w.Run(ctx)
+		return err
+	})
+}
+
+// Run records every rate that changed and returns how many were recorded
+func (w *RateHistoryWorker) Run(ctx context.Context) (int, error) {
+	return w.rates.RecordRates(ctx, time.Now())
+}
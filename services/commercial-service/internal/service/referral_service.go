@@ -3,119 +3,339 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/shopspring/decimal"
 
 	"metargb/commercial-service/internal/models"
 	"metargb/commercial-service/internal/repository"
+	"metargb/shared/pkg/money"
 )
 
+// Reward tiers are configured in the variables table with keys such as
+// referral_tier_1_percent = 50 (50% of the order's PSC value) or
+// referral_tier_2_fixed = 10 (a flat 10 PSC per order). A fixed amount takes
+// precedence over a percentage on the same tier. Tiers must be numbered from 1
+// without gaps; the first missing tier ends the chain.
+const (
+	referralTierVariablePrefix = "referral_tier_"
+	maxReferralTiers           = 5
+)
+
+// defaultReferralTiers keeps the original single-level 50% commission when no
+// tier variables are configured
+var defaultReferralTiers = []ReferralTier{{Level: 1, Percent: 50}}
+
+// ReferralTier describes the reward paid to the referrer Level steps above the buyer
+type ReferralTier struct {
+	Level   int
+	Percent float64
+	Fixed   float64
+}
+
+// Reward returns the PSC reward for an order worth pscValue, cut to the
+// decimal places a psc wallet holds
+func (t ReferralTier) Reward(pscValue decimal.Decimal) decimal.Decimal {
+	if t.Fixed > 0 {
+		return money.Truncate("psc", decimal.NewFromFloat(t.Fixed))
+	}
+	return money.Truncate("psc", pscValue.Mul(decimal.NewFromFloat(t.Percent)).Div(decimal.NewFromInt(100)))
+}
+
+// ReferralRecalculation summarises a RecalculateRewards run
+type ReferralRecalculation struct {
+	OrdersChecked  int
+	MissingRewards int
+	MissingAmount  decimal.Decimal // PSC
+	Applied        bool
+}
+
 type ReferralService interface {
 	ProcessReferralCommission(ctx context.Context, userID uint64, order *models.Order) error
+	RecalculateRewards(ctx context.Context, from, to time.Time, apply bool) (*ReferralRecalculation, error)
 }
 
 type referralService struct {
 	referralRepo     repository.ReferralRepository
 	variableRepo     repository.VariableRepository
 	userVariableRepo repository.UserVariableRepository
-	walletRepo       repository.WalletRepository
+	orderRepo        repository.OrderRepository
 }
 
 func NewReferralService(
 	referralRepo repository.ReferralRepository,
 	variableRepo repository.VariableRepository,
 	userVariableRepo repository.UserVariableRepository,
-	walletRepo repository.WalletRepository,
+	orderRepo repository.OrderRepository,
 ) ReferralService {
 	return &referralService{
 		referralRepo:     referralRepo,
 		variableRepo:     variableRepo,
 		userVariableRepo: userVariableRepo,
-		walletRepo:       walletRepo,
+		orderRepo:        orderRepo,
 	}
 }
 
+// referralReward is a single commission owed to one referrer for one order
+type referralReward struct {
+	ReferrerID uint64
+	Tier       int
+	Amount     decimal.Decimal
+}
+
 // ProcessReferralCommission implements the referral commission logic from Laravel
 // Laravel: App\Services\ReferralService::referral(User $user, Order $order)
+// extended to pay every configured tier up the referral chain.
 func (s *referralService) ProcessReferralCommission(ctx context.Context, userID uint64, order *models.Order) error {
 	// If the asset is 'irr', do not proceed with referral
 	if order.Asset == "irr" {
 		return nil
 	}
 
-	// Get the referrer (the user who referred this buyer)
-	referrerID, err := s.referralRepo.GetReferrerID(ctx, userID)
+	rewards, err := s.planRewards(ctx, userID, order)
 	if err != nil {
-		return fmt.Errorf("failed to get referrer: %w", err)
+		return err
 	}
 
-	// If user has no referrer, skip
-	if referrerID == nil {
-		return nil
+	for _, reward := range rewards {
+		if _, err := s.payReward(ctx, userID, order, reward); err != nil {
+			return err
+		}
 	}
 
-	// Get PSC rate
-	pscRate, err := s.variableRepo.GetRate(ctx, "psc")
+	return nil
+}
+
+// RecalculateRewards re-evaluates paid orders created in [from, to) against the
+// current tier configuration and reports rewards that were never paid. When
+// apply is true the missing rewards are credited; existing rewards are never
+// changed or reclaimed. Only rewards recorded with their order ID can be
+// matched, so from must not precede the rollout of tiered rewards.
+func (s *referralService) RecalculateRewards(ctx context.Context, from, to time.Time, apply bool) (*ReferralRecalculation, error) {
+	orders, err := s.orderRepo.ListPaidBetween(ctx, from, to)
 	if err != nil {
-		return fmt.Errorf("failed to get PSC rate: %w", err)
+		return nil, err
 	}
 
-	// Calculate the total amount already referred
-	referredAmount, err := s.referralRepo.GetTotalReferredAmount(ctx, *referrerID)
-	if err != nil {
-		return fmt.Errorf("failed to get referred amount: %w", err)
+	result := &ReferralRecalculation{Applied: apply}
+	for _, order := range orders {
+		if order.Asset == "irr" {
+			continue
+		}
+		result.OrdersChecked++
+
+		rewards, err := s.planRewards(ctx, order.UserID, order)
+		if err != nil {
+			return nil, fmt.Errorf("order %d: %w", order.ID, err)
+		}
+
+		for _, reward := range rewards {
+			paid, err := s.referralRepo.HasReferralOrder(ctx, order.ID, reward.Tier)
+			if err != nil {
+				return nil, fmt.Errorf("order %d: %w", order.ID, err)
+			}
+			if paid {
+				continue
+			}
+
+			if apply {
+				paid, err := s.payReward(ctx, order.UserID, order, reward)
+				if err != nil {
+					return nil, fmt.Errorf("order %d: %w", order.ID, err)
+				}
+				if !paid {
+					// Paid meanwhile, e.g. by the payment callback or another run
+					continue
+				}
+			}
+
+			result.MissingRewards++
+			result.MissingAmount = result.MissingAmount.Add(reward.Amount)
+		}
 	}
 
-	// Multiply by PSC rate to get total in PSC equivalent
-	totalReferredPSC := referredAmount * pscRate
+	return result, nil
+}
 
-	// Get referral profit limit for the referrer
-	referralLimit, err := s.userVariableRepo.GetReferralProfitLimit(ctx, *referrerID)
+// planRewards works out which referrers up the buyer's chain are owed a
+// commission for the order, honouring each referrer's profit limit
+func (s *referralService) planRewards(ctx context.Context, buyerID uint64, order *models.Order) ([]referralReward, error) {
+	tiers, err := s.loadTiers(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get referral limit: %w", err)
+		return nil, err
 	}
 
-	// Check if limit exceeded
-	if totalReferredPSC >= referralLimit {
-		return nil // Limit reached, no commission
+	chain, err := referralChain(ctx, buyerID, len(tiers), s.referralRepo.GetReferrerID)
+	if err != nil {
+		return nil, err
+	}
+	if len(chain) == 0 {
+		return nil, nil
 	}
 
-	// Calculate referral commission (50% of order amount)
-	var referrerAmount float64
+	// Get PSC rate
+	pscRate, err := s.variableRepo.GetRate(ctx, "psc")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PSC rate: %w", err)
+	}
 
+	pscValue := order.Amount
 	// If order asset is a color (blue, red, yellow), convert to PSC equivalent first
 	if order.Asset == "blue" || order.Asset == "red" || order.Asset == "yellow" {
-		// Get the color rate
 		colorRate, err := s.variableRepo.GetRate(ctx, order.Asset)
 		if err != nil {
-			return fmt.Errorf("failed to get color rate: %w", err)
+			return nil, fmt.Errorf("failed to get color rate: %w", err)
+		}
+		if pscRate <= 0 {
+			return nil, fmt.Errorf("invalid PSC rate %v", pscRate)
+		}
+		pscValue = order.Amount.Mul(decimal.NewFromFloat(colorRate)).Div(decimal.NewFromFloat(pscRate))
+	}
+
+	var rewards []referralReward
+	for i, referrerID := range chain {
+		tier := tiers[i]
+
+		reached, err := s.limitReached(ctx, referrerID, pscRate)
+		if err != nil {
+			return nil, err
+		}
+		if reached {
+			// This referrer is capped, but the ones above may still earn
+			continue
 		}
 
-		// Convert: (order.amount * colorRate) / pscRate * 0.5
-		referrerAmount = ((order.Amount * colorRate) / pscRate) * 0.5
-	} else {
-		// For PSC orders, direct 50% multiplier
-		referrerAmount = order.Amount * 0.5
+		amount := tier.Reward(pscValue)
+		if !amount.IsPositive() {
+			continue
+		}
+		rewards = append(rewards, referralReward{ReferrerID: referrerID, Tier: tier.Level, Amount: amount})
 	}
 
-	// Increment referrer's PSC wallet
-	referrerAmountDec := decimal.NewFromFloat(referrerAmount)
-	err = s.walletRepo.AddBalance(ctx, *referrerID, "psc", referrerAmountDec)
+	return rewards, nil
+}
+
+// limitReached checks the referrer's referral_profit limit against what they
+// have already earned from referrals
+func (s *referralService) limitReached(ctx context.Context, referrerID uint64, pscRate float64) (bool, error) {
+	// Calculate the total amount already referred
+	referredAmount, err := s.referralRepo.GetTotalReferredAmount(ctx, referrerID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get referred amount: %w", err)
+	}
+
+	// Get referral profit limit for the referrer
+	referralLimit, err := s.userVariableRepo.GetReferralProfitLimit(ctx, referrerID)
 	if err != nil {
-		return fmt.Errorf("failed to add referral commission to wallet: %w", err)
+		return false, fmt.Errorf("failed to get referral limit: %w", err)
 	}
 
-	// Create referral order history
+	// Multiply by PSC rate to get total in PSC equivalent
+	return referredAmount.Mul(decimal.NewFromFloat(pscRate)).GreaterThanOrEqual(decimal.NewFromFloat(referralLimit)), nil
+}
+
+// payReward credits reward to the referrer unless the order's tier was
+// already paid, and reports whether it paid
+func (s *referralService) payReward(ctx context.Context, buyerID uint64, order *models.Order, reward referralReward) (bool, error) {
+	orderID := order.ID
 	history := &models.ReferralOrderHistory{
-		UserID:     *referrerID, // The referrer who receives commission
-		ReferralID: userID,      // The user who was referred
-		Amount:     referrerAmount,
+		UserID:     reward.ReferrerID, // The referrer who receives commission
+		ReferralID: buyerID,           // The user who was referred
+		OrderID:    &orderID,
+		Tier:       reward.Tier,
+		Amount:     reward.Amount,
 	}
 
-	err = s.referralRepo.CreateReferralOrder(ctx, history)
+	paid, err := s.referralRepo.PayReferralReward(ctx, history)
 	if err != nil {
-		return fmt.Errorf("failed to create referral order history: %w", err)
+		return false, fmt.Errorf("failed to pay referral commission: %w", err)
 	}
+	return paid, nil
+}
 
-	return nil
+func (s *referralService) loadTiers(ctx context.Context) ([]ReferralTier, error) {
+	values, err := s.variableRepo.GetByPrefix(ctx, referralTierVariablePrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get referral tiers: %w", err)
+	}
+
+	tiers := parseReferralTiers(values)
+	if len(tiers) == 0 {
+		return defaultReferralTiers, nil
+	}
+	return tiers, nil
+}
+
+// parseReferralTiers builds the tier list from referral_tier_* variables,
+// stopping at the first gap and at maxReferralTiers
+func parseReferralTiers(values map[string]float64) []ReferralTier {
+	byLevel := make(map[int]*ReferralTier)
+	for key, value := range values {
+		rest := strings.TrimPrefix(key, referralTierVariablePrefix)
+		levelStr, kind, ok := strings.Cut(rest, "_")
+		if !ok {
+			continue
+		}
+		level, err := strconv.Atoi(levelStr)
+		if err != nil || level < 1 || level > maxReferralTiers || value < 0 {
+			continue
+		}
+
+		tier, exists := byLevel[level]
+		if !exists {
+			tier = &ReferralTier{Level: level}
+		}
+		switch kind {
+		case "percent":
+			tier.Percent = value
+		case "fixed":
+			tier.Fixed = value
+		default:
+			continue
+		}
+		byLevel[level] = tier
+	}
+
+	levels := make([]int, 0, len(byLevel))
+	for level := range byLevel {
+		levels = append(levels, level)
+	}
+	sort.Ints(levels)
+
+	var tiers []ReferralTier
+	for i, level := range levels {
+		if level != i+1 {
+			break
+		}
+		tiers = append(tiers, *byLevel[level])
+	}
+	return tiers
+}
+
+// referralChain walks up to depth referrers above the buyer. It stops at the
+// first user without a referrer, and refuses self-referrals and cycles so no
+// user is ever rewarded for their own purchase or rewarded twice per order.
+func referralChain(ctx context.Context, buyerID uint64, depth int, getReferrer func(context.Context, uint64) (*uint64, error)) ([]uint64, error) {
+	seen := map[uint64]bool{buyerID: true}
+	var chain []uint64
+
+	current := buyerID
+	for len(chain) < depth {
+		referrerID, err := getReferrer(ctx, current)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get referrer: %w", err)
+		}
+		if referrerID == nil || seen[*referrerID] {
+			break
+		}
+
+		seen[*referrerID] = true
+		chain = append(chain, *referrerID)
+		current = *referrerID
+	}
+
+	return chain, nil
 }
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+)
+
+func TestParseReferralTiers(t *testing.T) {
+	tiers := parseReferralTiers(map[string]float64{
+		"referral_tier_1_percent": 40,
+		"referral_tier_2_percent": 10,
+		"referral_tier_2_fixed":   3,
+		"referral_tier_4_percent": 5, // unreachable: tier 3 is missing
+		"referral_tier_x_percent": 5,
+		"referral_tier_1_bonus":   5,
+		"referral_tier_9_percent": 5,
+	})
+
+	want := []ReferralTier{
+		{Level: 1, Percent: 40},
+		{Level: 2, Percent: 10, Fixed: 3},
+	}
+	if !reflect.DeepEqual(tiers, want) {
+		t.Fatalf("parseReferralTiers() = %+v, want %+v", tiers, want)
+	}
+
+	if got := tiers[0].Reward(decimal.NewFromInt(200)); !got.Equal(decimal.NewFromInt(80)) {
+		t.Errorf("percent tier reward = %v, want 80", got)
+	}
+	if got := tiers[1].Reward(decimal.NewFromInt(200)); !got.Equal(decimal.NewFromInt(3)) {
+		t.Errorf("fixed tier reward = %v, want 3", got)
+	}
+	if got := tiers[0].Reward(decimal.RequireFromString("0.7")); got.String() != "0.28" {
+		t.Errorf("percent tier reward = %v, want exactly 0.28", got)
+	}
+
+	if tiers := parseReferralTiers(map[string]float64{"referral_tier_2_percent": 10}); len(tiers) != 0 {
+		t.Errorf("expected no tiers without tier 1, got %+v", tiers)
+	}
+}
+
+func TestReferralChain(t *testing.T) {
+	referrers := map[uint64]uint64{
+		1: 2, 2: 3, 3: 4, 4: 5, // straight chain
+		10: 10,         // self-referral
+		20: 21, 21: 20, // cycle back to the buyer
+	}
+	getReferrer := func(ctx context.Context, userID uint64) (*uint64, error) {
+		if id, ok := referrers[userID]; ok {
+			return &id, nil
+		}
+		return nil, nil
+	}
+
+	tests := []struct {
+		name  string
+		buyer uint64
+		depth int
+		want  []uint64
+	}{
+		{name: "limited by depth", buyer: 1, depth: 2, want: []uint64{2, 3}},
+		{name: "ends at top of chain", buyer: 3, depth: 5, want: []uint64{4, 5}},
+		{name: "self referral", buyer: 10, depth: 5, want: nil},
+		{name: "cycle", buyer: 20, depth: 5, want: []uint64{21}},
+		{name: "no referrer", buyer: 99, depth: 5, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := referralChain(context.Background(), tt.buyer, tt.depth, getReferrer)
+			if err != nil {
+				t.Fatalf("referralChain returned error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("referralChain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type referralRewardKey struct {
+	orderID uint64
+	tier    int
+}
+
+// fakeReferralRepository claims rewards the way the unique (order_id, tier)
+// key does: a reward is credited only by the first claim of its order and tier
+type fakeReferralRepository struct {
+	repository.ReferralRepository
+	referrers map[uint64]uint64
+	claimed   map[referralRewardKey]bool
+	credited  map[uint64]decimal.Decimal
+}
+
+func (f *fakeReferralRepository) GetReferrerID(_ context.Context, userID uint64) (*uint64, error) {
+	if id, ok := f.referrers[userID]; ok {
+		return &id, nil
+	}
+	return nil, nil
+}
+
+func (f *fakeReferralRepository) GetTotalReferredAmount(context.Context, uint64) (decimal.Decimal, error) {
+	return decimal.Zero, nil
+}
+
+func (f *fakeReferralRepository) HasReferralOrder(context.Context, uint64, int) (bool, error) {
+	// Always stale, as when a concurrent run pays between the check and the claim
+	return false, nil
+}
+
+func (f *fakeReferralRepository) PayReferralReward(_ context.Context, history *models.ReferralOrderHistory) (bool, error) {
+	key := referralRewardKey{*history.OrderID, history.Tier}
+	if f.claimed[key] {
+		return false, nil
+	}
+	f.claimed[key] = true
+	f.credited[history.UserID] = f.credited[history.UserID].Add(history.Amount)
+	return true, nil
+}
+
+type referralRates struct{ repository.VariableRepository }
+
+func (referralRates) GetRate(context.Context, string) (float64, error) { return 1, nil }
+func (referralRates) GetByPrefix(context.Context, string) (map[string]float64, error) {
+	return nil, nil
+}
+
+type unlimitedReferralProfit struct {
+	repository.UserVariableRepository
+}
+
+func (unlimitedReferralProfit) GetReferralProfitLimit(context.Context, uint64) (float64, error) {
+	return 1e12, nil
+}
+
+type paidOrders struct {
+	repository.OrderRepository
+	orders []*models.Order
+}
+
+func (p paidOrders) ListPaidBetween(context.Context, time.Time, time.Time) ([]*models.Order, error) {
+	return p.orders, nil
+}
+
+func TestReferralRewardsAreCreditedOnce(t *testing.T) {
+	order := &models.Order{ID: 7, UserID: 1, Asset: "psc", Amount: decimal.NewFromInt(100)}
+	referrals := &fakeReferralRepository{
+		referrers: map[uint64]uint64{1: 2},
+		claimed:   map[referralRewardKey]bool{},
+		credited:  map[uint64]decimal.Decimal{},
+	}
+	svc := NewReferralService(referrals, referralRates{}, unlimitedReferralProfit{}, paidOrders{orders: []*models.Order{order}})
+
+	if err := svc.ProcessReferralCommission(context.Background(), order.UserID, order); err != nil {
+		t.Fatalf("ProcessReferralCommission() error = %v", err)
+	}
+	// A retried payment callback pays nothing more
+	if err := svc.ProcessReferralCommission(context.Background(), order.UserID, order); err != nil {
+		t.Fatalf("second ProcessReferralCommission() error = %v", err)
+	}
+
+	// The recalculation sees the reward as unpaid but loses the claim
+	result, err := svc.RecalculateRewards(context.Background(), time.Unix(0, 0), time.Now(), true)
+	if err != nil {
+		t.Fatalf("RecalculateRewards() error = %v", err)
+	}
+	if result.MissingRewards != 0 || !result.MissingAmount.IsZero() {
+		t.Errorf("recalculation paid %d rewards worth %v, want none", result.MissingRewards, result.MissingAmount)
+	}
+
+	if got := referrals.credited[2]; !got.Equal(decimal.NewFromInt(50)) {
+		t.Errorf("referrer credited %v, want 50 once", got)
+	}
+}
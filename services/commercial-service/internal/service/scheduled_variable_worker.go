@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// DefaultScheduledVariableInterval is how often scheduled variable changes
+// are looked up. Between lookups the worker sleeps until the soonest known
+// change, so a change scheduled further ahead than this is applied on time.
+const DefaultScheduledVariableInterval = time.Minute
+
+// ScheduledVariableWorker applies scheduled variable changes when they are due
+type ScheduledVariableWorker struct {
+	variables VariableService
+	interval  time.Duration
+}
+
+// NewScheduledVariableWorker creates a worker that looks up changes every
+// interval (DefaultScheduledVariableInterval if zero)
+func NewScheduledVariableWorker(variables VariableService, interval time.Duration) *ScheduledVariableWorker {
+	if interval <= 0 {
+		interval = DefaultScheduledVariableInterval
+	}
+	return &ScheduledVariableWorker{
+		variables: variables,
+		interval:  interval,
+	}
+}
+
+// Start runs once immediately and then whenever a change is due or the
+// interval passes, until ctx is cancelled
+func (w *ScheduledVariableWorker) Start(ctx context.Context) {
+	go func() {
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+			}
+			ranAt := time.Now()
+			if _, err := w.variables.ApplyDueChanges(ctx, ranAt); err != nil {
+				log.Printf("Scheduled variable run failed: %v", err)
+			}
+			timer.Reset(w.nextWait(ctx, ranAt))
+		}
+	}()
+}
+
+// Run applies every due change and returns how many were applied
+func (w *ScheduledVariableWorker) Run(ctx context.Context) (int, error) {
+	return w.variables.ApplyDueChanges(ctx, time.Now())
+}
+
+// nextWait returns how long to sleep after a run at ranAt: until the soonest
+// pending change, but no longer than the interval so changes scheduled
+// meanwhile are found
+func (w *ScheduledVariableWorker) nextWait(ctx context.Context, ranAt time.Time) time.Duration {
+	next, ok, err := w.variables.NextScheduledChange(ctx)
+	if err != nil {
+		log.Printf("Failed to get next scheduled variable change: %v", err)
+		return w.interval
+	}
+	if !ok {
+		return w.interval
+	}
+	if !next.After(ranAt) {
+		// Due during the run but not applied, e.g. applying it failed
+		return w.interval
+	}
+	wait := time.Until(next)
+	if wait < 0 {
+		return 0
+	}
+	if wait > w.interval {
+		return w.interval
+	}
+	return wait
+}
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+	"metargb/shared/pkg/usercache"
+)
+
+// Platform maximums of the spending limits of users under 18
+const (
+	DefaultMinorDailyPSCLimit   = 100
+	DefaultMinorMonthlyPSCLimit = 1000
+	DefaultMinorDailyIRRLimit   = 5000000
+	DefaultMinorMonthlyIRRLimit = 50000000
+)
+
+var (
+	ErrSpendingLimitNotMinor          = errors.New("spending limits only apply to users under 18")
+	ErrSpendingLimitInvalid           = errors.New("limits must be non-negative numbers")
+	ErrSpendingLimitAboveMax          = errors.New("limits cannot exceed the platform maximums")
+	ErrSpendingLimitDailyAboveMonthly = errors.New("daily limits cannot exceed monthly limits")
+	ErrSpendingLimitUnavailable       = errors.New("the age of the user could not be checked")
+)
+
+// UserAges looks up the birthdate of users, implemented by usercache.Cache
+type UserAges interface {
+	Get(ctx context.Context, userID uint64) (*usercache.Snapshot, error)
+}
+
+// SpendingLimiter counts charges against the caps of users under 18
+type SpendingLimiter interface {
+	// Reserve records a charge, or fails if it would exceed a cap. Call
+	// release if the charge does not go through.
+	Reserve(ctx context.Context, userID uint64, asset string, amount decimal.Decimal, source string) (release func(), err error)
+}
+
+// SpendingLimitConfig holds the platform maximums per asset. Guardians can
+// lower the caps of their children, not raise them above these.
+type SpendingLimitConfig struct {
+	PSC models.SpendingCap
+	IRR models.SpendingCap
+}
+
+// SpendingLimitStatus is the effective caps of a user and what they spent
+type SpendingLimitStatus struct {
+	UserID    uint64
+	Applies   bool
+	UpdatedBy uint64
+	Assets    map[string]AssetSpendingStatus
+}
+
+// AssetSpendingStatus is the caps and spending of one asset
+type AssetSpendingStatus struct {
+	Limit models.SpendingCap
+	Max   models.SpendingCap
+	Spent models.SpendingUsage
+}
+
+type SpendingLimitService interface {
+	SpendingLimiter
+	GetLimits(ctx context.Context, userID uint64) (*SpendingLimitStatus, error)
+	SetLimits(ctx context.Context, userID, updatedBy uint64, update *models.SpendingLimits) (*SpendingLimitStatus, error)
+}
+
+type spendingLimitService struct {
+	limitRepo repository.SpendingLimitRepository
+	users     UserAges
+	config    SpendingLimitConfig
+	now       func() time.Time
+}
+
+// NewSpendingLimitService creates the spending limit service. Zero config
+// values fall back to the defaults.
+func NewSpendingLimitService(limitRepo repository.SpendingLimitRepository, users UserAges, config SpendingLimitConfig) SpendingLimitService {
+	config.PSC = capOrDefault(config.PSC, DefaultMinorDailyPSCLimit, DefaultMinorMonthlyPSCLimit)
+	config.IRR = capOrDefault(config.IRR, DefaultMinorDailyIRRLimit, DefaultMinorMonthlyIRRLimit)
+	return &spendingLimitService{
+		limitRepo: limitRepo,
+		users:     users,
+		config:    config,
+		now:       time.Now,
+	}
+}
+
+func capOrDefault(limit models.SpendingCap, daily, monthly int64) models.SpendingCap {
+	if !limit.Daily.IsPositive() {
+		limit.Daily = decimal.NewFromInt(daily)
+	}
+	if !limit.Monthly.IsPositive() {
+		limit.Monthly = decimal.NewFromInt(monthly)
+	}
+	return limit
+}
+
+// Reserve counts a psc or irr charge of a user under 18. Other users and
+// assets are not limited. If the age of the user cannot be checked the
+// charge is let through, so an auth-service outage does not stop payments.
+func (s *spendingLimitService) Reserve(ctx context.Context, userID uint64, asset string, amount decimal.Decimal, source string) (func(), error) {
+	noop := func() {}
+	if !amount.IsPositive() || s.maxCap(asset) == nil {
+		return noop, nil
+	}
+
+	minor, err := s.isMinor(ctx, userID)
+	if err != nil {
+		log.Printf("Spending of user %d not limited: %v", userID, err)
+		return noop, nil
+	}
+	if !minor {
+		return noop, nil
+	}
+
+	limits, err := s.limitRepo.GetLimits(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	id, err := s.limitRepo.Reserve(ctx, userID, asset, amount, source, s.effectiveCap(limits, asset), s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		// Released after the charge failed, possibly because ctx was cancelled
+		if err := s.limitRepo.Release(context.WithoutCancel(ctx), id); err != nil {
+			log.Printf("Failed to release spending record %d of user %d: %v", id, userID, err)
+		}
+	}, nil
+}
+
+func (s *spendingLimitService) GetLimits(ctx context.Context, userID uint64) (*SpendingLimitStatus, error) {
+	minor, err := s.isMinor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := s.limitRepo.GetLimits(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.status(ctx, userID, minor, limits)
+}
+
+// SetLimits changes the caps of a user under 18. Nil caps in update keep
+// their current value. Callers check that updatedBy may change them.
+func (s *spendingLimitService) SetLimits(ctx context.Context, userID, updatedBy uint64, update *models.SpendingLimits) (*SpendingLimitStatus, error) {
+	minor, err := s.isMinor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !minor {
+		return nil, ErrSpendingLimitNotMinor
+	}
+
+	current, err := s.limitRepo.GetLimits(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	limits := &models.SpendingLimits{UserID: userID}
+	if current != nil {
+		*limits = *current
+	}
+	for _, field := range []struct {
+		current **decimal.Decimal
+		update  *decimal.Decimal
+		max     decimal.Decimal
+	}{
+		{&limits.DailyPSC, update.DailyPSC, s.config.PSC.Daily},
+		{&limits.MonthlyPSC, update.MonthlyPSC, s.config.PSC.Monthly},
+		{&limits.DailyIRR, update.DailyIRR, s.config.IRR.Daily},
+		{&limits.MonthlyIRR, update.MonthlyIRR, s.config.IRR.Monthly},
+	} {
+		if field.update == nil {
+			continue
+		}
+		if field.update.IsNegative() {
+			return nil, ErrSpendingLimitInvalid
+		}
+		if field.update.GreaterThan(field.max) {
+			return nil, ErrSpendingLimitAboveMax
+		}
+		value := *field.update
+		*field.current = &value
+	}
+	for _, asset := range models.SpendingAssets {
+		limit := s.effectiveCap(limits, asset)
+		if limit.Daily.GreaterThan(limit.Monthly) {
+			return nil, ErrSpendingLimitDailyAboveMonthly
+		}
+	}
+
+	limits.UpdatedBy = updatedBy
+	if err := s.limitRepo.SaveLimits(ctx, limits); err != nil {
+		return nil, err
+	}
+	return s.status(ctx, userID, minor, limits)
+}
+
+func (s *spendingLimitService) status(ctx context.Context, userID uint64, minor bool, limits *models.SpendingLimits) (*SpendingLimitStatus, error) {
+	status := &SpendingLimitStatus{
+		UserID:  userID,
+		Applies: minor,
+		Assets:  make(map[string]AssetSpendingStatus, len(models.SpendingAssets)),
+	}
+	if limits != nil {
+		status.UpdatedBy = limits.UpdatedBy
+	}
+	now := s.now()
+	for _, asset := range models.SpendingAssets {
+		spent, err := s.limitRepo.Usage(ctx, userID, asset, now)
+		if err != nil {
+			return nil, err
+		}
+		status.Assets[asset] = AssetSpendingStatus{
+			Limit: s.effectiveCap(limits, asset),
+			Max:   *s.maxCap(asset),
+			Spent: spent,
+		}
+	}
+	return status, nil
+}
+
+// effectiveCap is the guardian's caps of an asset where set, otherwise the
+// platform maximums. Caps set before a maximum was lowered are held to it.
+func (s *spendingLimitService) effectiveCap(limits *models.SpendingLimits, asset string) models.SpendingCap {
+	limit := *s.maxCap(asset)
+	daily, monthly := limits.Caps(asset)
+	if daily != nil {
+		limit.Daily = decimal.Min(limit.Daily, *daily)
+	}
+	if monthly != nil {
+		limit.Monthly = decimal.Min(limit.Monthly, *monthly)
+	}
+	return limit
+}
+
+func (s *spendingLimitService) maxCap(asset string) *models.SpendingCap {
+	switch asset {
+	case "psc":
+		return &s.config.PSC
+	case "irr":
+		return &s.config.IRR
+	}
+	return nil
+}
+
+func (s *spendingLimitService) isMinor(ctx context.Context, userID uint64) (bool, error) {
+	user, err := s.users.Get(ctx, userID)
+	if errors.Is(err, usercache.ErrUserNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", ErrSpendingLimitUnavailable, err)
+	}
+	return user.IsUnder18(s.now()), nil
+}
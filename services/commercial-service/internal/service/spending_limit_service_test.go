@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+	"metargb/shared/pkg/usercache"
+)
+
+type fakeUserAges map[uint64]*usercache.Snapshot
+
+func (f fakeUserAges) Get(_ context.Context, userID uint64) (*usercache.Snapshot, error) {
+	user, ok := f[userID]
+	if !ok {
+		return nil, usercache.ErrUserNotFound
+	}
+	return user, nil
+}
+
+type fakeSpendingRecord struct {
+	userID uint64
+	asset  string
+	amount decimal.Decimal
+}
+
+type fakeSpendingLimitRepository struct {
+	limits  map[uint64]*models.SpendingLimits
+	records map[uint64]fakeSpendingRecord
+	nextID  uint64
+}
+
+func newFakeSpendingLimitRepository() *fakeSpendingLimitRepository {
+	return &fakeSpendingLimitRepository{
+		limits:  map[uint64]*models.SpendingLimits{},
+		records: map[uint64]fakeSpendingRecord{},
+	}
+}
+
+func (r *fakeSpendingLimitRepository) GetLimits(_ context.Context, userID uint64) (*models.SpendingLimits, error) {
+	return r.limits[userID], nil
+}
+
+func (r *fakeSpendingLimitRepository) SaveLimits(_ context.Context, limits *models.SpendingLimits) error {
+	r.limits[limits.UserID] = limits
+	return nil
+}
+
+// Usage counts every record in both windows
+func (r *fakeSpendingLimitRepository) Usage(_ context.Context, userID uint64, asset string, _ time.Time) (models.SpendingUsage, error) {
+	spent := decimal.Zero
+	for _, record := range r.records {
+		if record.userID == userID && record.asset == asset {
+			spent = spent.Add(record.amount)
+		}
+	}
+	return models.SpendingUsage{Day: spent, Month: spent}, nil
+}
+
+func (r *fakeSpendingLimitRepository) Reserve(ctx context.Context, userID uint64, asset string, amount decimal.Decimal, _ string, limit models.SpendingCap, now time.Time) (uint64, error) {
+	usage, _ := r.Usage(ctx, userID, asset, now)
+	if usage.Day.Add(amount).GreaterThan(limit.Daily) {
+		return 0, repository.ErrDailySpendingLimit
+	}
+	if usage.Month.Add(amount).GreaterThan(limit.Monthly) {
+		return 0, repository.ErrMonthlySpendingLimit
+	}
+	r.nextID++
+	r.records[r.nextID] = fakeSpendingRecord{userID: userID, asset: asset, amount: amount}
+	return r.nextID, nil
+}
+
+func (r *fakeSpendingLimitRepository) Release(_ context.Context, recordID uint64) error {
+	delete(r.records, recordID)
+	return nil
+}
+
+func birthdateYearsAgo(years int) *time.Time {
+	birthdate := time.Now().AddDate(-years, 0, -1)
+	return &birthdate
+}
+
+func newTestSpendingLimitService(repo *fakeSpendingLimitRepository) SpendingLimitService {
+	users := fakeUserAges{
+		1: {ID: 1, Birthdate: birthdateYearsAgo(15)},
+		2: {ID: 2, Birthdate: birthdateYearsAgo(30)},
+	}
+	return NewSpendingLimitService(repo, users, SpendingLimitConfig{
+		PSC: models.SpendingCap{Daily: decimal.NewFromInt(10), Monthly: decimal.NewFromInt(50)},
+	})
+}
+
+func TestSpendingLimitReserve(t *testing.T) {
+	repo := newFakeSpendingLimitRepository()
+	svc := newTestSpendingLimitService(repo)
+	ctx := context.Background()
+
+	if _, err := svc.Reserve(ctx, 1, "psc", decimal.NewFromInt(8), models.SpendingSourceWallet); err != nil {
+		t.Fatalf("Reserve returned error: %v", err)
+	}
+	if _, err := svc.Reserve(ctx, 1, "psc", decimal.NewFromInt(3), models.SpendingSourceWallet); !errors.Is(err, repository.ErrDailySpendingLimit) {
+		t.Fatalf("expected ErrDailySpendingLimit, got %v", err)
+	}
+
+	release, err := svc.Reserve(ctx, 1, "psc", decimal.NewFromInt(2), models.SpendingSourceWallet)
+	if err != nil {
+		t.Fatalf("Reserve returned error: %v", err)
+	}
+	release()
+	if len(repo.records) != 1 {
+		t.Errorf("expected the released charge to be removed, have %d records", len(repo.records))
+	}
+
+	// Adults, unknown users and colors are not limited
+	for _, charge := range []struct {
+		userID uint64
+		asset  string
+	}{
+		{2, "psc"},
+		{3, "psc"},
+		{1, "red"},
+	} {
+		if _, err := svc.Reserve(ctx, charge.userID, charge.asset, decimal.NewFromInt(1000), models.SpendingSourceWallet); err != nil {
+			t.Errorf("Reserve(%d, %s) returned error: %v", charge.userID, charge.asset, err)
+		}
+	}
+	if len(repo.records) != 1 {
+		t.Errorf("expected unlimited charges not to be recorded, have %d records", len(repo.records))
+	}
+
+	// The irr maximums fall back to the defaults
+	limits, err := svc.GetLimits(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetLimits returned error: %v", err)
+	}
+	if irr := limits.Assets["irr"].Limit; irr.Daily.IntPart() != DefaultMinorDailyIRRLimit || irr.Monthly.IntPart() != DefaultMinorMonthlyIRRLimit {
+		t.Errorf("unexpected irr limit %+v", irr)
+	}
+	if spent := limits.Assets["psc"].Spent.Day; spent.String() != "8" {
+		t.Errorf("spent today = %s, want 8", spent)
+	}
+}
+
+func TestSpendingLimitSetLimits(t *testing.T) {
+	repo := newFakeSpendingLimitRepository()
+	svc := newTestSpendingLimitService(repo)
+	ctx := context.Background()
+
+	amount := func(s string) *decimal.Decimal {
+		d := decimal.RequireFromString(s)
+		return &d
+	}
+
+	limits, err := svc.SetLimits(ctx, 1, 9, &models.SpendingLimits{DailyPSC: amount("4")})
+	if err != nil {
+		t.Fatalf("SetLimits returned error: %v", err)
+	}
+	psc := limits.Assets["psc"]
+	if psc.Limit.Daily.String() != "4" || psc.Limit.Monthly.String() != "50" || limits.UpdatedBy != 9 {
+		t.Errorf("unexpected limits %+v updated by %d", psc.Limit, limits.UpdatedBy)
+	}
+	if _, err := svc.Reserve(ctx, 1, "psc", decimal.NewFromInt(5), models.SpendingSourceWallet); !errors.Is(err, repository.ErrDailySpendingLimit) {
+		t.Errorf("expected the lowered daily limit to apply, got %v", err)
+	}
+
+	invalid := []struct {
+		userID uint64
+		update *models.SpendingLimits
+		want   error
+	}{
+		{2, &models.SpendingLimits{DailyPSC: amount("1")}, ErrSpendingLimitNotMinor},
+		{1, &models.SpendingLimits{DailyPSC: amount("-1")}, ErrSpendingLimitInvalid},
+		{1, &models.SpendingLimits{DailyPSC: amount("11")}, ErrSpendingLimitAboveMax},
+		{1, &models.SpendingLimits{MonthlyPSC: amount("3")}, ErrSpendingLimitDailyAboveMonthly},
+	}
+	for _, tc := range invalid {
+		if _, err := svc.SetLimits(ctx, tc.userID, 9, tc.update); !errors.Is(err, tc.want) {
+			t.Errorf("SetLimits(%d, %+v) = %v, want %v", tc.userID, tc.update, err, tc.want)
+		}
+	}
+	if monthly := repo.limits[1].MonthlyPSC; monthly != nil {
+		t.Errorf("rejected update was saved: monthly psc %s", monthly)
+	}
+}
+
+func TestSpendingLimitHeldToLoweredMaximum(t *testing.T) {
+	repo := newFakeSpendingLimitRepository()
+	twenty := decimal.NewFromInt(20)
+	repo.limits[1] = &models.SpendingLimits{UserID: 1, DailyPSC: &twenty}
+	svc := newTestSpendingLimitService(repo)
+
+	limits, err := svc.GetLimits(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetLimits returned error: %v", err)
+	}
+	if daily := limits.Assets["psc"].Limit.Daily; daily.String() != "10" {
+		t.Errorf("daily limit = %s, want the maximum 10", daily)
+	}
+}
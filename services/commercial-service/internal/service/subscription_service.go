@@ -0,0 +1,373 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+)
+
+// Subscription defaults
+const (
+	// DefaultSubscriptionPendingTimeout is how long a new subscription waits
+	// for its first charge, e.g. while the user pays through the gateway
+	DefaultSubscriptionPendingTimeout = 24 * time.Hour
+)
+
+// DefaultSubscriptionRetrySchedule is when failed renewals are retried,
+// counted from the end of the unpaid period. The subscription expires when
+// the last retry fails.
+var DefaultSubscriptionRetrySchedule = []time.Duration{24 * time.Hour, 72 * time.Hour, 7 * 24 * time.Hour}
+
+var (
+	ErrSubscriptionPlanNotFound = errors.New("subscription plan not found")
+	ErrSubscriptionNotFound     = errors.New("subscription not found")
+	ErrSubscriptionNotDue       = errors.New("subscription has no unpaid period")
+)
+
+// SubscriptionWallets reads the balance a gateway payment tops up, implemented
+// by repository.WalletRepository
+type SubscriptionWallets interface {
+	FindByUserID(ctx context.Context, userID uint64) (*models.Wallet, error)
+}
+
+// SubscriptionGateway starts payment gateway orders that deposit into the
+// wallet, implemented by PaymentService
+type SubscriptionGateway interface {
+	InitiatePayment(ctx context.Context, userID uint64, asset string, amount decimal.Decimal, ip, device string) (string, uint64, string, error)
+}
+
+// SubscriptionConfig holds the dunning and pending terms
+type SubscriptionConfig struct {
+	PendingTimeout time.Duration
+	RetrySchedule  []time.Duration
+}
+
+// SubscriptionPayment is a subscription after a charge. PaymentURL is set
+// when the wallet was short and a gateway payment of the difference was
+// started; the subscription is charged once that order is paid.
+type SubscriptionPayment struct {
+	Subscription *models.Subscription
+	PaymentURL   string
+	OrderID      uint64
+}
+
+// Entitlements are the flags a user's subscription grants. Subscription is
+// nil, and Flags empty, without an active or past due subscription.
+type Entitlements struct {
+	UserID       uint64
+	Flags        []string
+	Subscription *models.Subscription
+}
+
+// PaymentOptions choose how a period the wallet cannot cover is paid
+type PaymentOptions struct {
+	UseGateway bool
+	IP         string
+	Device     string
+}
+
+type SubscriptionService interface {
+	ListPlans(ctx context.Context) ([]*models.SubscriptionPlan, error)
+	Subscribe(ctx context.Context, userID, planID uint64, opts PaymentOptions) (*SubscriptionPayment, error)
+	GetSubscription(ctx context.Context, userID uint64) (*models.Subscription, error)
+	Cancel(ctx context.Context, userID, subscriptionID uint64) (*models.Subscription, error)
+	Pay(ctx context.Context, userID, subscriptionID uint64, opts PaymentOptions) (*SubscriptionPayment, error)
+	GetEntitlements(ctx context.Context, userID uint64) (*Entitlements, error)
+	ProcessDueRenewals(ctx context.Context, now time.Time) (int, error)
+}
+
+type subscriptionService struct {
+	subscriptionRepo repository.SubscriptionRepository
+	wallets          SubscriptionWallets
+	gateway          SubscriptionGateway
+	spending         SpendingLimiter
+	config           SubscriptionConfig
+	now              func() time.Time
+}
+
+// NewSubscriptionService creates the subscription service. Zero config
+// values fall back to the defaults. Every charged period counts against the
+// spending limits of users under 18, unless spending is nil.
+func NewSubscriptionService(subscriptionRepo repository.SubscriptionRepository, wallets SubscriptionWallets, gateway SubscriptionGateway, spending SpendingLimiter, config SubscriptionConfig) SubscriptionService {
+	if config.PendingTimeout <= 0 {
+		config.PendingTimeout = DefaultSubscriptionPendingTimeout
+	}
+	if len(config.RetrySchedule) == 0 {
+		config.RetrySchedule = DefaultSubscriptionRetrySchedule
+	}
+	return &subscriptionService{
+		subscriptionRepo: subscriptionRepo,
+		wallets:          wallets,
+		gateway:          gateway,
+		spending:         spending,
+		config:           config,
+		now:              time.Now,
+	}
+}
+
+func (s *subscriptionService) ListPlans(ctx context.Context) ([]*models.SubscriptionPlan, error) {
+	return s.subscriptionRepo.ListPlans(ctx)
+}
+
+// Subscribe creates a subscription and charges its first period. When the
+// wallet cannot cover it the subscription is dropped again, unless the
+// gateway was asked for: it then stays pending while the user pays the
+// difference.
+func (s *subscriptionService) Subscribe(ctx context.Context, userID, planID uint64, opts PaymentOptions) (*SubscriptionPayment, error) {
+	plan, err := s.subscriptionRepo.GetPlan(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	if plan == nil || !plan.Active {
+		return nil, ErrSubscriptionPlanNotFound
+	}
+
+	renewsAt := s.now().Add(s.config.PendingTimeout)
+	subscription, err := s.subscriptionRepo.Create(ctx, &models.Subscription{
+		UserID:   userID,
+		PlanID:   planID,
+		RenewsAt: &renewsAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	subscription.Plan = plan
+
+	payment, err := s.pay(ctx, subscription, opts)
+	if err != nil {
+		if endErr := s.subscriptionRepo.End(ctx, subscription.ID, models.SubscriptionCanceled, s.now()); endErr != nil {
+			log.Printf("Failed to drop unpaid subscription %d: %v", subscription.ID, endErr)
+		}
+		return nil, err
+	}
+	return payment, nil
+}
+
+// GetSubscription returns the user's newest subscription, ended or not
+func (s *subscriptionService) GetSubscription(ctx context.Context, userID uint64) (*models.Subscription, error) {
+	subscription, err := s.subscriptionRepo.GetLatestForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription == nil {
+		return nil, ErrSubscriptionNotFound
+	}
+	return subscription, nil
+}
+
+// Cancel stops an active subscription from renewing; it keeps its
+// entitlements until the paid period ends. Pending and past due
+// subscriptions have no paid period left and end right away.
+func (s *subscriptionService) Cancel(ctx context.Context, userID, subscriptionID uint64) (*models.Subscription, error) {
+	subscription, err := s.getOwned(ctx, userID, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch subscription.Status {
+	case models.SubscriptionActive:
+		err = s.subscriptionRepo.SetCancelAtPeriodEnd(ctx, subscriptionID, true)
+	case models.SubscriptionPending, models.SubscriptionPastDue:
+		err = s.subscriptionRepo.End(ctx, subscriptionID, models.SubscriptionCanceled, s.now())
+	default:
+		err = repository.ErrSubscriptionNotLive
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.subscriptionRepo.GetByID(ctx, subscriptionID)
+}
+
+// Pay charges the unpaid period of a pending or past due subscription now,
+// e.g. after topping up the wallet, instead of waiting for the worker
+func (s *subscriptionService) Pay(ctx context.Context, userID, subscriptionID uint64, opts PaymentOptions) (*SubscriptionPayment, error) {
+	subscription, err := s.getOwned(ctx, userID, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	switch subscription.Status {
+	case models.SubscriptionPending, models.SubscriptionPastDue:
+	case models.SubscriptionActive:
+		return nil, ErrSubscriptionNotDue
+	default:
+		return nil, repository.ErrSubscriptionNotLive
+	}
+
+	return s.pay(ctx, subscription, opts)
+}
+
+// GetEntitlements returns the flags of the user's active or past due
+// subscription, sorted
+func (s *subscriptionService) GetEntitlements(ctx context.Context, userID uint64) (*Entitlements, error) {
+	entitlements := &Entitlements{UserID: userID, Flags: []string{}}
+
+	subscription, err := s.subscriptionRepo.GetLatestForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription == nil || !subscription.Entitled() {
+		return entitlements, nil
+	}
+
+	entitlements.Subscription = subscription
+	entitlements.Flags = append(entitlements.Flags, subscription.Plan.Entitlements...)
+	sort.Strings(entitlements.Flags)
+	return entitlements, nil
+}
+
+// ProcessDueRenewals renews subscriptions at the end of their period, retries
+// failed renewals, ends canceled subscriptions and expires those whose
+// retries or pending payment ran out. It returns how many changed.
+func (s *subscriptionService) ProcessDueRenewals(ctx context.Context, now time.Time) (int, error) {
+	ids, err := s.subscriptionRepo.ListDueIDs(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for _, id := range ids {
+		changed, err := s.processSubscription(ctx, id, now)
+		if err != nil {
+			log.Printf("Failed to process subscription %d: %v", id, err)
+			continue
+		}
+		if changed {
+			processed++
+		}
+	}
+
+	return processed, nil
+}
+
+func (s *subscriptionService) processSubscription(ctx context.Context, subscriptionID uint64, now time.Time) (bool, error) {
+	subscription, err := s.subscriptionRepo.GetByID(ctx, subscriptionID)
+	if err != nil || subscription == nil || !subscription.Live() || subscription.RenewsAt == nil {
+		return false, err
+	}
+
+	if subscription.Status == models.SubscriptionPending {
+		err := s.charge(ctx, subscription, now)
+		if isDeclinedCharge(err) {
+			if now.Before(*subscription.RenewsAt) {
+				return false, nil
+			}
+			return true, s.subscriptionRepo.End(ctx, subscriptionID, models.SubscriptionExpired, now)
+		}
+		return err == nil, err
+	}
+
+	if subscription.RenewsAt.After(now) {
+		return false, nil
+	}
+	if subscription.CancelAtPeriodEnd {
+		return true, s.subscriptionRepo.End(ctx, subscriptionID, models.SubscriptionCanceled, now)
+	}
+
+	err = s.charge(ctx, subscription, now)
+	if !isDeclinedCharge(err) {
+		return err == nil, err
+	}
+
+	attempts := subscription.FailedAttempts + 1
+	if int(attempts) > len(s.config.RetrySchedule) {
+		return true, s.subscriptionRepo.End(ctx, subscriptionID, models.SubscriptionExpired, now)
+	}
+	retryAt := subscription.PeriodEnd.Add(s.config.RetrySchedule[attempts-1])
+	return true, s.subscriptionRepo.RecordFailedCharge(ctx, subscriptionID, attempts, retryAt)
+}
+
+// pay charges the unpaid period from the wallet. When the wallet is short
+// and the gateway was asked for, a gateway payment of the difference is
+// started and the subscription is returned unchanged with its link.
+func (s *subscriptionService) pay(ctx context.Context, subscription *models.Subscription, opts PaymentOptions) (*SubscriptionPayment, error) {
+	err := s.charge(ctx, subscription, s.now())
+	if err == nil {
+		charged, err := s.subscriptionRepo.GetByID(ctx, subscription.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &SubscriptionPayment{Subscription: charged}, nil
+	}
+	if !errors.Is(err, repository.ErrSubscriptionInsufficientBalance) || !opts.UseGateway || s.gateway == nil {
+		return nil, err
+	}
+
+	shortfall, err := s.shortfall(ctx, subscription)
+	if err != nil {
+		return nil, err
+	}
+	if !shortfall.IsPositive() {
+		// The balance changed since the charge; the worker charges it on its next run
+		return nil, repository.ErrSubscriptionInsufficientBalance
+	}
+	link, orderID, _, err := s.gateway.InitiatePayment(ctx, subscription.UserID, subscription.Plan.Asset, shortfall, opts.IP, opts.Device)
+	if err != nil {
+		return nil, err
+	}
+	return &SubscriptionPayment{Subscription: subscription, PaymentURL: link, OrderID: orderID}, nil
+}
+
+// charge counts a period against the spending limits and charges it from
+// the wallet
+func (s *subscriptionService) charge(ctx context.Context, subscription *models.Subscription, now time.Time) error {
+	release := func() {}
+	if s.spending != nil {
+		var err error
+		release, err = s.spending.Reserve(ctx, subscription.UserID, subscription.Plan.Asset, subscription.Plan.Price, models.SpendingSourceSubscription)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := s.subscriptionRepo.ChargePeriod(ctx, subscription.ID, now); err != nil {
+		release()
+		return err
+	}
+	return nil
+}
+
+// shortfall is how much of a period's price the wallet is missing
+func (s *subscriptionService) shortfall(ctx context.Context, subscription *models.Subscription) (decimal.Decimal, error) {
+	price := subscription.Plan.Price
+	wallet, err := s.wallets.FindByUserID(ctx, subscription.UserID)
+	if err != nil || wallet == nil {
+		return price, err
+	}
+
+	balance := wallet.IRR
+	if subscription.Plan.Asset == "psc" {
+		balance = wallet.PSC
+	}
+	if !balance.IsPositive() {
+		return price, nil
+	}
+	return models.TruncateToWallet(subscription.Plan.Asset, price.Sub(balance)), nil
+}
+
+// getOwned returns a subscription of the user
+func (s *subscriptionService) getOwned(ctx context.Context, userID, subscriptionID uint64) (*models.Subscription, error) {
+	subscription, err := s.subscriptionRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription == nil || subscription.UserID != userID {
+		return nil, ErrSubscriptionNotFound
+	}
+	return subscription, nil
+}
+
+// isDeclinedCharge reports whether a charge failed for a reason a later
+// retry may not hit: the wallet was short or a spending limit was reached
+func isDeclinedCharge(err error) bool {
+	return errors.Is(err, repository.ErrSubscriptionInsufficientBalance) ||
+		errors.Is(err, repository.ErrDailySpendingLimit) ||
+		errors.Is(err, repository.ErrMonthlySpendingLimit)
+}
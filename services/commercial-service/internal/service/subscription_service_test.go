@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+)
+
+type fakeSubscriptionRepository struct {
+	plan         *models.SubscriptionPlan
+	subscription *models.Subscription
+	balance      decimal.Decimal
+	charges      int
+}
+
+func (r *fakeSubscriptionRepository) ListPlans(context.Context) ([]*models.SubscriptionPlan, error) {
+	return []*models.SubscriptionPlan{r.plan}, nil
+}
+
+func (r *fakeSubscriptionRepository) GetPlan(_ context.Context, planID uint64) (*models.SubscriptionPlan, error) {
+	if r.plan == nil || r.plan.ID != planID {
+		return nil, nil
+	}
+	return r.plan, nil
+}
+
+func (r *fakeSubscriptionRepository) Create(_ context.Context, subscription *models.Subscription) (*models.Subscription, error) {
+	if r.subscription != nil && r.subscription.Live() {
+		return nil, repository.ErrSubscriptionExists
+	}
+	subscription.ID = 1
+	subscription.Status = models.SubscriptionPending
+	r.subscription = subscription
+	return subscription, nil
+}
+
+func (r *fakeSubscriptionRepository) GetByID(_ context.Context, subscriptionID uint64) (*models.Subscription, error) {
+	if r.subscription == nil || r.subscription.ID != subscriptionID {
+		return nil, nil
+	}
+	r.subscription.Plan = r.plan
+	return r.subscription, nil
+}
+
+func (r *fakeSubscriptionRepository) GetLatestForUser(_ context.Context, userID uint64) (*models.Subscription, error) {
+	if r.subscription == nil || r.subscription.UserID != userID {
+		return nil, nil
+	}
+	return r.GetByID(context.Background(), r.subscription.ID)
+}
+
+func (r *fakeSubscriptionRepository) ListDueIDs(context.Context, time.Time) ([]uint64, error) {
+	return []uint64{r.subscription.ID}, nil
+}
+
+func (r *fakeSubscriptionRepository) ChargePeriod(_ context.Context, _ uint64, now time.Time) error {
+	if r.balance.LessThan(r.plan.Price) {
+		return repository.ErrSubscriptionInsufficientBalance
+	}
+	r.balance = r.balance.Sub(r.plan.Price)
+	r.charges++
+
+	start := r.subscription.PeriodEnd
+	if r.subscription.Periods == 0 {
+		start = now
+	}
+	end := start.Add(r.plan.Period())
+	r.subscription.Status = models.SubscriptionActive
+	r.subscription.PeriodStart = start
+	r.subscription.PeriodEnd = end
+	r.subscription.RenewsAt = &end
+	r.subscription.FailedAttempts = 0
+	r.subscription.Periods++
+	return nil
+}
+
+func (r *fakeSubscriptionRepository) RecordFailedCharge(_ context.Context, _ uint64, failedAttempts int32, retryAt time.Time) error {
+	r.subscription.Status = models.SubscriptionPastDue
+	r.subscription.FailedAttempts = failedAttempts
+	r.subscription.RenewsAt = &retryAt
+	return nil
+}
+
+func (r *fakeSubscriptionRepository) SetCancelAtPeriodEnd(_ context.Context, _ uint64, cancel bool) error {
+	r.subscription.CancelAtPeriodEnd = cancel
+	return nil
+}
+
+func (r *fakeSubscriptionRepository) End(_ context.Context, _ uint64, status string, now time.Time) error {
+	r.subscription.Status = status
+	r.subscription.RenewsAt = nil
+	r.subscription.EndedAt = &now
+	return nil
+}
+
+type fakeSubscriptionWallets struct {
+	irr decimal.Decimal
+}
+
+func (w *fakeSubscriptionWallets) FindByUserID(_ context.Context, userID uint64) (*models.Wallet, error) {
+	return &models.Wallet{UserID: userID, IRR: w.irr}, nil
+}
+
+type fakeSubscriptionGateway struct {
+	amounts []decimal.Decimal
+}
+
+func (g *fakeSubscriptionGateway) InitiatePayment(_ context.Context, _ uint64, _ string, amount decimal.Decimal, _, _ string) (string, uint64, string, error) {
+	g.amounts = append(g.amounts, amount)
+	return "https://pay.example/token", 42, "TR-1", nil
+}
+
+func newSubscriptionTestRepo(balance int64) *fakeSubscriptionRepository {
+	return &fakeSubscriptionRepository{
+		plan: &models.SubscriptionPlan{
+			ID:           3,
+			Slug:         "premium-monthly",
+			Asset:        "irr",
+			Price:        decimal.NewFromInt(500000),
+			PeriodDays:   30,
+			Entitlements: []string{"priority_support", "ad_free"},
+			Active:       true,
+		},
+		balance: decimal.NewFromInt(balance),
+	}
+}
+
+func TestSubscriptionServiceSubscribe(t *testing.T) {
+	ctx := context.Background()
+
+	repo := newSubscriptionTestRepo(600000)
+	svc := NewSubscriptionService(repo, &fakeSubscriptionWallets{}, nil, nil, SubscriptionConfig{})
+	if _, err := svc.Subscribe(ctx, 88, 9, PaymentOptions{}); !errors.Is(err, ErrSubscriptionPlanNotFound) {
+		t.Fatalf("expected ErrSubscriptionPlanNotFound, got %v", err)
+	}
+
+	payment, err := svc.Subscribe(ctx, 88, 3, PaymentOptions{})
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	if payment.Subscription.Status != models.SubscriptionActive || repo.charges != 1 {
+		t.Fatalf("expected an active subscription charged once, got %s after %d charges", payment.Subscription.Status, repo.charges)
+	}
+	if _, err := svc.Subscribe(ctx, 88, 3, PaymentOptions{}); !errors.Is(err, repository.ErrSubscriptionExists) {
+		t.Fatalf("expected ErrSubscriptionExists, got %v", err)
+	}
+
+	entitlements, err := svc.GetEntitlements(ctx, 88)
+	if err != nil {
+		t.Fatalf("GetEntitlements: %v", err)
+	}
+	if len(entitlements.Flags) != 2 || entitlements.Flags[0] != "ad_free" {
+		t.Errorf("flags = %v, want sorted plan entitlements", entitlements.Flags)
+	}
+}
+
+func TestSubscriptionServiceSubscribeShortWallet(t *testing.T) {
+	ctx := context.Background()
+
+	repo := newSubscriptionTestRepo(200000)
+	gateway := &fakeSubscriptionGateway{}
+	svc := NewSubscriptionService(repo, &fakeSubscriptionWallets{irr: decimal.NewFromInt(200000)}, gateway, nil, SubscriptionConfig{})
+
+	if _, err := svc.Subscribe(ctx, 88, 3, PaymentOptions{}); !errors.Is(err, repository.ErrSubscriptionInsufficientBalance) {
+		t.Fatalf("expected ErrSubscriptionInsufficientBalance, got %v", err)
+	}
+	if repo.subscription.Status != models.SubscriptionCanceled {
+		t.Fatalf("unpaid subscription status = %s, want canceled", repo.subscription.Status)
+	}
+
+	payment, err := svc.Subscribe(ctx, 88, 3, PaymentOptions{UseGateway: true})
+	if err != nil {
+		t.Fatalf("Subscribe with gateway: %v", err)
+	}
+	if payment.PaymentURL == "" || payment.Subscription.Status != models.SubscriptionPending {
+		t.Fatalf("expected a pending subscription with a payment link, got %+v", payment)
+	}
+	if len(gateway.amounts) != 1 || !gateway.amounts[0].Equal(decimal.NewFromInt(300000)) {
+		t.Fatalf("gateway amounts = %v, want the 300000 shortfall", gateway.amounts)
+	}
+
+	// The worker charges the subscription once the gateway payment lands
+	repo.balance = decimal.NewFromInt(500000)
+	if changed, err := svc.ProcessDueRenewals(ctx, time.Now()); err != nil || changed != 1 {
+		t.Fatalf("ProcessDueRenewals = %d, %v", changed, err)
+	}
+	if repo.subscription.Status != models.SubscriptionActive {
+		t.Fatalf("status = %s, want active", repo.subscription.Status)
+	}
+}
+
+func TestSubscriptionServiceDunning(t *testing.T) {
+	ctx := context.Background()
+
+	repo := newSubscriptionTestRepo(500000)
+	svc := NewSubscriptionService(repo, &fakeSubscriptionWallets{}, nil, nil, SubscriptionConfig{
+		RetrySchedule: []time.Duration{24 * time.Hour, 72 * time.Hour},
+	})
+	if _, err := svc.Subscribe(ctx, 88, 3, PaymentOptions{}); err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	periodEnd := repo.subscription.PeriodEnd
+
+	// Nothing happens before the period ends
+	if changed, _ := svc.ProcessDueRenewals(ctx, periodEnd.Add(-time.Hour)); changed != 0 {
+		t.Fatalf("renewed %d subscriptions before the period ended", changed)
+	}
+
+	svc.ProcessDueRenewals(ctx, periodEnd)
+	if repo.subscription.Status != models.SubscriptionPastDue || !repo.subscription.RenewsAt.Equal(periodEnd.Add(24*time.Hour)) {
+		t.Fatalf("after first failure: status %s, retry at %v", repo.subscription.Status, repo.subscription.RenewsAt)
+	}
+	if entitlements, _ := svc.GetEntitlements(ctx, 88); len(entitlements.Flags) == 0 {
+		t.Error("past due subscription lost its entitlements")
+	}
+
+	svc.ProcessDueRenewals(ctx, periodEnd.Add(24*time.Hour))
+	if repo.subscription.FailedAttempts != 2 || !repo.subscription.RenewsAt.Equal(periodEnd.Add(72*time.Hour)) {
+		t.Fatalf("after second failure: %d attempts, retry at %v", repo.subscription.FailedAttempts, repo.subscription.RenewsAt)
+	}
+
+	svc.ProcessDueRenewals(ctx, periodEnd.Add(72*time.Hour))
+	if repo.subscription.Status != models.SubscriptionExpired {
+		t.Fatalf("status = %s, want expired after the last retry", repo.subscription.Status)
+	}
+	if entitlements, _ := svc.GetEntitlements(ctx, 88); len(entitlements.Flags) != 0 {
+		t.Errorf("expired subscription kept entitlements %v", entitlements.Flags)
+	}
+}
+
+func TestSubscriptionServiceRetryRenewsFromPeriodEnd(t *testing.T) {
+	ctx := context.Background()
+
+	repo := newSubscriptionTestRepo(500000)
+	svc := NewSubscriptionService(repo, &fakeSubscriptionWallets{}, nil, nil, SubscriptionConfig{})
+	if _, err := svc.Subscribe(ctx, 88, 3, PaymentOptions{}); err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	periodEnd := repo.subscription.PeriodEnd
+
+	svc.ProcessDueRenewals(ctx, periodEnd)
+	repo.balance = decimal.NewFromInt(500000)
+	if _, err := svc.Pay(ctx, 88, 1, PaymentOptions{}); err != nil {
+		t.Fatalf("Pay: %v", err)
+	}
+	if repo.subscription.Status != models.SubscriptionActive || !repo.subscription.PeriodStart.Equal(periodEnd) {
+		t.Fatalf("status %s, period start %v, want active from %v", repo.subscription.Status, repo.subscription.PeriodStart, periodEnd)
+	}
+	if _, err := svc.Pay(ctx, 88, 1, PaymentOptions{}); !errors.Is(err, ErrSubscriptionNotDue) {
+		t.Fatalf("expected ErrSubscriptionNotDue, got %v", err)
+	}
+}
+
+func TestSubscriptionServiceCancel(t *testing.T) {
+	ctx := context.Background()
+
+	repo := newSubscriptionTestRepo(1000000)
+	svc := NewSubscriptionService(repo, &fakeSubscriptionWallets{}, nil, nil, SubscriptionConfig{})
+	if _, err := svc.Subscribe(ctx, 88, 3, PaymentOptions{}); err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	if _, err := svc.Cancel(ctx, 61, 1); !errors.Is(err, ErrSubscriptionNotFound) {
+		t.Fatalf("expected ErrSubscriptionNotFound for another user, got %v", err)
+	}
+
+	subscription, err := svc.Cancel(ctx, 88, 1)
+	if err != nil {
+		t.Fatalf("Cancel: %v", err)
+	}
+	if subscription.Status != models.SubscriptionActive || !subscription.CancelAtPeriodEnd {
+		t.Fatalf("canceled subscription should stay active until the period ends, got %+v", subscription)
+	}
+
+	svc.ProcessDueRenewals(ctx, subscription.PeriodEnd)
+	if repo.subscription.Status != models.SubscriptionCanceled || repo.charges != 1 {
+		t.Fatalf("status %s after %d charges, want canceled without a renewal", repo.subscription.Status, repo.charges)
+	}
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"metargb/shared/pkg/jobs"
+)
+
+// DefaultSubscriptionInterval is how often subscriptions are checked for due
+// renewals. It is short so subscriptions paid through the gateway start soon
+// after the payment.
+const DefaultSubscriptionInterval = 5 * time.Minute
+
+// SubscriptionWorker periodically renews subscriptions, retries failed
+// renewals and ends canceled or expired subscriptions
+type SubscriptionWorker struct {
+	subscriptions SubscriptionService
+	interval      time.Duration
+}
+
+// NewSubscriptionWorker creates a worker that runs every interval
+// (DefaultSubscriptionInterval if zero)
+func NewSubscriptionWorker(subscriptions SubscriptionService, interval time.Duration) *SubscriptionWorker {
+	if interval <= 0 {
+		interval = DefaultSubscriptionInterval
+	}
+	return &SubscriptionWorker{
+		subscriptions: subscriptions,
+		interval:      interval,
+	}
+}
+
+// Register runs the worker once at start and then every interval under runner
+func (w *SubscriptionWorker) Register(runner *jobs.Runner) {
+	runner.AddImmediate("subscription-renewals", w.interval, func(ctx context.Context) error {
+		_, err := w.Run(ctx)
+		return err
+	})
+}
+
+// Run processes every due subscription and returns how many changed
+func (w *SubscriptionWorker) Run(ctx context.Context) (int, error) {
+	return w.subscriptions.ProcessDueRenewals(ctx, time.Now())
+}
@@ -2,16 +2,58 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
-	"strconv"
+	"strings"
 	"time"
 
 	"metargb/commercial-service/internal/models"
 	"metargb/commercial-service/internal/repository"
+	"metargb/shared/pkg/money"
 )
 
+const (
+	defaultTransactionsPerPage = 10
+	maxTransactionsPerPage     = 100
+)
+
+var (
+	ErrInvalidTransactionType   = errors.New("type must be trade, buy_request, order, installment_plan or wallet_adjustment")
+	ErrInvalidTransactionAction = errors.New("action must be deposit or withdraw")
+	ErrInvalidAmountRange       = errors.New("min_amount must not be greater than max_amount")
+	ErrInvalidTransactionCursor = errors.New("invalid cursor")
+)
+
+// transactionPayableTypes maps the public transaction type filter to the morph
+// class stored in transactions.payable_type
+var transactionPayableTypes = map[string]string{
+	"trade":             "App\\Models\\Trade",
+	"buy_request":       "App\\Models\\BuyFeatureRequest",
+	"order":             "App\\Models\\Order",
+	"installment_plan":  models.InstallmentPlanPayableType,
+	"wallet_adjustment": models.AdjustmentBatchPayableType,
+}
+
+// ListTransactionsInput holds the raw transaction history filters received from
+// the transport layer
+type ListTransactionsInput struct {
+	Asset     string
+	Action    string // deposit or withdraw
+	Type      string // key of transactionPayableTypes
+	Statuses  []int32
+	Search    string  // transaction ID prefix
+	MinAmount float64 // inclusive, 0 for no lower bound
+	MaxAmount float64 // inclusive, 0 for no upper bound
+	FromDate  string  // Jalali Y/m/d or Gregorian Y-m-d, inclusive
+	ToDate    string  // Jalali Y/m/d or Gregorian Y-m-d, inclusive
+	Cursor    string  // next cursor of the previous page, replaces Page
+	Page      int
+	PerPage   int
+}
+
 type TransactionService interface {
-	ListTransactions(ctx context.Context, userID uint64, filters map[string]interface{}) ([]*models.TransactionDTO, error)
+	ListTransactions(ctx context.Context, userID uint64, input ListTransactionsInput) ([]*models.TransactionDTO, int, string, error)
 	GetLatestTransaction(ctx context.Context, userID uint64) (*models.Transaction, error)
 	CreateTransaction(ctx context.Context, transaction *models.Transaction) error
 }
@@ -31,10 +73,25 @@ func NewTransactionService(
 	}
 }
 
-func (s *transactionService) ListTransactions(ctx context.Context, userID uint64, filters map[string]interface{}) ([]*models.TransactionDTO, error) {
-	transactions, err := s.transactionRepo.FindByUserID(ctx, userID, filters)
+// ListTransactions returns a page of the user's transactions along with the
+// normalized page that was applied and the cursor of the next page, which is
+// empty on the last page
+func (s *transactionService) ListTransactions(ctx context.Context, userID uint64, input ListTransactionsInput) ([]*models.TransactionDTO, int, string, error) {
+	filter, err := buildTransactionFilter(input)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	transactions, err := s.transactionRepo.ListByUserID(ctx, userID, filter)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list transactions: %w", err)
+		return nil, 0, "", fmt.Errorf("failed to list transactions: %w", err)
+	}
+
+	nextCursor := ""
+	if len(transactions) > filter.PerPage {
+		transactions = transactions[:filter.PerPage]
+		last := transactions[len(transactions)-1]
+		nextCursor = encodeTransactionCursor(models.TransactionCursor{CreatedAt: last.CreatedAt, ID: last.ID})
 	}
 
 	// Convert to DTOs with Jalali date formatting
@@ -43,7 +100,7 @@ func (s *transactionService) ListTransactions(ctx context.Context, userID uint64
 		dtos[i] = s.transactionToDTO(t)
 	}
 
-	return dtos, nil
+	return dtos, filter.Page, nextCursor, nil
 }
 
 func (s *transactionService) GetLatestTransaction(ctx context.Context, userID uint64) (*models.Transaction, error) {
@@ -67,7 +124,7 @@ func (s *transactionService) transactionToDTO(t *models.Transaction) *models.Tra
 		ID:     t.ID,
 		Type:   payableType,
 		Asset:  t.Asset,
-		Amount: strconv.FormatFloat(t.Amount, 'f', -1, 64),
+		Amount: money.Format(t.Amount),
 		Action: t.Action,
 		Status: t.Status,
 		Date:   s.jalaliConverter.FormatJalaliDate(t.CreatedAt), // Laravel: jdate($this->created_at)->format('Y/m/d')
@@ -88,3 +145,103 @@ func (s *transactionService) CreateTransaction(ctx context.Context, transaction
 
 	return nil
 }
+
+// buildTransactionFilter validates the raw input and converts the inclusive date
+// range into [from 00:00, day after to 00:00)
+func buildTransactionFilter(input ListTransactionsInput) (models.TransactionFilter, error) {
+	filter := models.TransactionFilter{
+		Asset:    strings.ToLower(strings.TrimSpace(input.Asset)),
+		Action:   strings.ToLower(strings.TrimSpace(input.Action)),
+		Statuses: input.Statuses,
+		Search:   strings.TrimSpace(input.Search),
+		Page:     input.Page,
+		PerPage:  input.PerPage,
+	}
+
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PerPage < 1 {
+		filter.PerPage = defaultTransactionsPerPage
+	}
+	if filter.PerPage > maxTransactionsPerPage {
+		filter.PerPage = maxTransactionsPerPage
+	}
+
+	if filter.Action != "" && filter.Action != "deposit" && filter.Action != "withdraw" {
+		return filter, ErrInvalidTransactionAction
+	}
+	if txType := strings.TrimSpace(input.Type); txType != "" {
+		payableType, ok := transactionPayableTypes[txType]
+		if !ok {
+			return filter, ErrInvalidTransactionType
+		}
+		filter.PayableType = payableType
+	}
+
+	if input.MinAmount < 0 || input.MaxAmount < 0 {
+		return filter, ErrInvalidAmountRange
+	}
+	if input.MinAmount > 0 {
+		minAmount := input.MinAmount
+		filter.MinAmount = &minAmount
+	}
+	if input.MaxAmount > 0 {
+		maxAmount := input.MaxAmount
+		filter.MaxAmount = &maxAmount
+	}
+	if filter.MinAmount != nil && filter.MaxAmount != nil && *filter.MinAmount > *filter.MaxAmount {
+		return filter, ErrInvalidAmountRange
+	}
+
+	if input.FromDate != "" {
+		from, err := parseOrderDate(input.FromDate)
+		if err != nil {
+			return filter, err
+		}
+		filter.From = &from
+	}
+	if input.ToDate != "" {
+		to, err := parseOrderDate(input.ToDate)
+		if err != nil {
+			return filter, err
+		}
+		to = to.AddDate(0, 0, 1)
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return filter, ErrInvalidOrderDateRange
+	}
+
+	if input.Cursor != "" {
+		cursor, err := decodeTransactionCursor(input.Cursor)
+		if err != nil {
+			return filter, err
+		}
+		filter.After = &cursor
+	}
+
+	return filter, nil
+}
+
+// encodeTransactionCursor serializes a cursor as an opaque URL-safe token
+func encodeTransactionCursor(cursor models.TransactionCursor) string {
+	raw := cursor.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + cursor.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeTransactionCursor(token string) (models.TransactionCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(strings.TrimSpace(token))
+	if err != nil {
+		return models.TransactionCursor{}, ErrInvalidTransactionCursor
+	}
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return models.TransactionCursor{}, ErrInvalidTransactionCursor
+	}
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return models.TransactionCursor{}, ErrInvalidTransactionCursor
+	}
+	return models.TransactionCursor{CreatedAt: t, ID: id}, nil
+}
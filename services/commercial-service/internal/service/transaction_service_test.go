@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"metargb/commercial-service/internal/models"
+)
+
+func TestBuildTransactionFilter(t *testing.T) {
+	filter, err := buildTransactionFilter(ListTransactionsInput{
+		Asset:     " PSC ",
+		Action:    "Withdraw",
+		Type:      "installment_plan",
+		MinAmount: 10,
+		FromDate:  "1403/08/09",
+		ToDate:    "1403/08/09",
+		PerPage:   500,
+	})
+	if err != nil {
+		t.Fatalf("buildTransactionFilter returned error: %v", err)
+	}
+	if filter.Asset != "psc" || filter.Action != "withdraw" || filter.PayableType != models.InstallmentPlanPayableType {
+		t.Errorf("unexpected filter %+v", filter)
+	}
+	if filter.MinAmount == nil || *filter.MinAmount != 10 || filter.MaxAmount != nil {
+		t.Errorf("amount range = (%v, %v), want (10, nil)", filter.MinAmount, filter.MaxAmount)
+	}
+	if filter.Page != 1 || filter.PerPage != maxTransactionsPerPage {
+		t.Errorf("pagination = (%d, %d), want (1, %d)", filter.Page, filter.PerPage, maxTransactionsPerPage)
+	}
+	if got := filter.To.Sub(*filter.From); got != 24*time.Hour {
+		t.Errorf("single-day range spans %s, want 24h", got)
+	}
+
+	invalid := []struct {
+		input ListTransactionsInput
+		want  error
+	}{
+		{ListTransactionsInput{Type: "gift"}, ErrInvalidTransactionType},
+		{ListTransactionsInput{Action: "transfer"}, ErrInvalidTransactionAction},
+		{ListTransactionsInput{MinAmount: 20, MaxAmount: 10}, ErrInvalidAmountRange},
+		{ListTransactionsInput{MinAmount: -1}, ErrInvalidAmountRange},
+		{ListTransactionsInput{FromDate: "1403/08/10", ToDate: "1403/08/09"}, ErrInvalidOrderDateRange},
+		{ListTransactionsInput{Cursor: "not a cursor"}, ErrInvalidTransactionCursor},
+	}
+	for _, tt := range invalid {
+		if _, err := buildTransactionFilter(tt.input); err != tt.want {
+			t.Errorf("buildTransactionFilter(%+v) error = %v, want %v", tt.input, err, tt.want)
+		}
+	}
+}
+
+func TestTransactionCursorRoundTrip(t *testing.T) {
+	want := models.TransactionCursor{CreatedAt: time.Date(2024, 10, 30, 14, 30, 45, 0, time.UTC), ID: "TR-1730298645"}
+	got, err := decodeTransactionCursor(encodeTransactionCursor(want))
+	if err != nil {
+		t.Fatalf("decodeTransactionCursor returned error: %v", err)
+	}
+	if !got.CreatedAt.Equal(want.CreatedAt) || got.ID != want.ID {
+		t.Errorf("cursor = %+v, want %+v", got, want)
+	}
+}
+
+type fakeTransactionRepository struct {
+	transactions []*models.Transaction
+	filter       models.TransactionFilter
+}
+
+func (r *fakeTransactionRepository) Create(context.Context, *models.Transaction) error { return nil }
+
+func (r *fakeTransactionRepository) Update(context.Context, *models.Transaction) error { return nil }
+
+func (r *fakeTransactionRepository) FindByID(context.Context, string) (*models.Transaction, error) {
+	return nil, nil
+}
+
+func (r *fakeTransactionRepository) FindLatestByUserID(context.Context, uint64) (*models.Transaction, error) {
+	return nil, nil
+}
+
+func (r *fakeTransactionRepository) ListByUserID(_ context.Context, _ uint64, filter models.TransactionFilter) ([]*models.Transaction, error) {
+	r.filter = filter
+	if len(r.transactions) > filter.PerPage+1 {
+		return r.transactions[:filter.PerPage+1], nil
+	}
+	return r.transactions, nil
+}
+
+func TestListTransactionsNextCursor(t *testing.T) {
+	base := time.Date(2024, 10, 30, 12, 0, 0, 0, time.UTC)
+	repo := &fakeTransactionRepository{}
+	for i := 0; i < 3; i++ {
+		repo.transactions = append(repo.transactions, &models.Transaction{
+			ID:        fmt.Sprintf("TR-%d", i),
+			Asset:     "psc",
+			Action:    "deposit",
+			CreatedAt: base.Add(-time.Duration(i) * time.Minute),
+		})
+	}
+	svc := NewTransactionService(repo, NewJalaliConverter())
+
+	dtos, page, next, err := svc.ListTransactions(context.Background(), 1, ListTransactionsInput{PerPage: 2})
+	if err != nil {
+		t.Fatalf("ListTransactions returned error: %v", err)
+	}
+	if len(dtos) != 2 || page != 1 || next == "" {
+		t.Fatalf("got %d transactions on page %d with cursor %q, want 2 on page 1 with a cursor", len(dtos), page, next)
+	}
+
+	if _, _, _, err := svc.ListTransactions(context.Background(), 1, ListTransactionsInput{PerPage: 2, Cursor: next}); err != nil {
+		t.Fatalf("ListTransactions with cursor returned error: %v", err)
+	}
+	if repo.filter.After == nil || repo.filter.After.ID != "TR-1" || !repo.filter.After.CreatedAt.Equal(base.Add(-time.Minute)) {
+		t.Errorf("cursor resolved to %+v, want the second transaction", repo.filter.After)
+	}
+
+	repo.transactions = repo.transactions[:2]
+	if _, _, next, _ := svc.ListTransactions(context.Background(), 1, ListTransactionsInput{PerPage: 2}); next != "" {
+		t.Errorf("last page cursor = %q, want empty", next)
+	}
+}
@@ -0,0 +1,374 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+	"metargb/shared/pkg/variables"
+)
+
+// maxVariableDecimals matches the precision of variable_change_logs
+const maxVariableDecimals = 4
+
+// Bounds of ListChanges
+const (
+	defaultVariableChangeLimit = 20
+	maxVariableChangeLimit     = 100
+)
+
+var (
+	ErrVariableNotAdmin     = errors.New("unauthorized: only wallet admins can manage variables")
+	ErrVariableUnknown      = errors.New("unknown variable")
+	ErrVariableInvalidValue = errors.New("invalid variable value")
+	ErrVariableNotScheduled = errors.New("invalid scheduled change: effective_at must be in the future")
+)
+
+// variableDefinitions are the variables admins may set, in listing order
+var variableDefinitions = buildVariableDefinitions()
+
+func buildVariableDefinitions() []models.VariableDefinition {
+	percentMax := decimal.NewNullDecimal(decimal.NewFromInt(100))
+	limitMin := decimal.NewNullDecimal(decimal.NewFromInt(1))
+	limitMax := decimal.NewNullDecimal(decimal.NewFromInt(1000))
+
+	defs := []models.VariableDefinition{
+		{Key: "psc", Type: models.VariableTypeRate, Table: models.VariablesTable, Description: "IRR price of one PSC"},
+		{Key: "red", Type: models.VariableTypeRate, Table: models.VariablesTable, Description: "IRR price of one red"},
+		{Key: "blue", Type: models.VariableTypeRate, Table: models.VariablesTable, Description: "IRR price of one blue"},
+		{Key: "yellow", Type: models.VariableTypeRate, Table: models.VariablesTable, Description: "IRR price of one yellow"},
+		{Key: "public_pricing_limit", Type: models.VariableTypeInteger, Table: models.SystemVariablesTable, Min: limitMin, Max: limitMax,
+			Description: "Lowest minimum price percentage adults may set on their features"},
+		{Key: "under_18_pricing_limit", Type: models.VariableTypeInteger, Table: models.SystemVariablesTable, Min: limitMin, Max: limitMax,
+			Description: "Lowest minimum price percentage users under 18 may set on their features"},
+	}
+	defs = append(defs,
+		models.VariableDefinition{Key: firstOrderBonusPercentKey, Type: models.VariableTypePercent, Table: models.VariablesTable, Max: percentMax,
+			Description: "First order bonus as a percentage of the order, 0 turns it off"},
+		models.VariableDefinition{Key: firstOrderMinAmountKey, Type: models.VariableTypeAmount, Table: models.VariablesTable,
+			Description: "Lowest IRR value of an order earning the first order bonus"},
+		models.VariableDefinition{Key: firstOrderWindowDaysKey, Type: models.VariableTypeInteger, Table: models.VariablesTable,
+			Min: decimal.NewNullDecimal(decimal.Zero), Max: decimal.NewNullDecimal(decimal.NewFromInt(3650)),
+			Description: "Days after registration the first order bonus is offered, 0 for no limit"},
+	)
+	for level := 1; level <= maxReferralTiers; level++ {
+		defs = append(defs,
+			models.VariableDefinition{
+				Key: fmt.Sprintf("%s%d_percent", referralTierVariablePrefix, level), Type: models.VariableTypePercent,
+				Table: models.VariablesTable, Max: percentMax,
+				Description: fmt.Sprintf("Referral reward of tier %d as a percentage of the order", level),
+			},
+			models.VariableDefinition{
+				Key: fmt.Sprintf("%s%d_fixed", referralTierVariablePrefix, level), Type: models.VariableTypeAmount,
+				Table:       models.VariablesTable,
+				Description: fmt.Sprintf("Fixed PSC referral reward of tier %d, overrides the percentage", level),
+			},
+		)
+	}
+	return defs
+}
+
+func findVariableDefinition(key string) (models.VariableDefinition, bool) {
+	key = strings.TrimSpace(key)
+	for _, def := range variableDefinitions {
+		if def.Key == key {
+			return def, true
+		}
+	}
+	return models.VariableDefinition{}, false
+}
+
+// VariablePublisher announces variable changes so other services and
+// replicas drop cached values, implemented by pubsub.VariableEvents
+type VariablePublisher interface {
+	PublishVariableChanged(ctx context.Context, event variables.ChangedEvent) error
+}
+
+// VariableService exposes the variables table (asset exchange rates) to
+// other services, which must not read it directly, and lets admins manage
+// the known variables
+type VariableService interface {
+	GetVariables(ctx context.Context, keys []string) (map[string]float64, error)
+	ListVariables(ctx context.Context, adminID uint64) ([]*models.VariableValue, error)
+	GetVariable(ctx context.Context, adminID uint64, key string) (*models.VariableValue, error)
+	SetVariable(ctx context.Context, adminID uint64, key, value, note string) (*models.VariableValue, error)
+	ListChanges(ctx context.Context, adminID uint64, key string, limit int) ([]*models.VariableChange, error)
+
+	// ScheduleChange validates a value now and sets it at effectiveAt
+	ScheduleChange(ctx context.Context, adminID uint64, key, value, note string, effectiveAt time.Time) (*models.ScheduledVariableChange, error)
+	ListScheduledChanges(ctx context.Context, adminID uint64, key string) ([]*models.ScheduledVariableChange, error)
+	CancelScheduledChange(ctx context.Context, adminID uint64, id uint64) (*models.ScheduledVariableChange, error)
+	// ApplyDueChanges sets every scheduled change due at now and returns how
+	// many were applied
+	ApplyDueChanges(ctx context.Context, now time.Time) (int, error)
+	// NextScheduledChange returns when the soonest pending change is due,
+	// false when none is pending
+	NextScheduledChange(ctx context.Context) (time.Time, bool, error)
+}
+
+type variableService struct {
+	variableRepo  repository.VariableRepository
+	scheduledRepo repository.ScheduledVariableRepository
+	admins        map[uint64]bool
+	publisher     VariablePublisher
+}
+
+// NewVariableService creates the variable service. Only adminIDs can use the
+// admin methods. publisher may be nil, in which case other services see a
+// change once their cached value expires.
+func NewVariableService(variableRepo repository.VariableRepository, scheduledRepo repository.ScheduledVariableRepository, adminIDs []uint64, publisher VariablePublisher) VariableService {
+	admins := make(map[uint64]bool, len(adminIDs))
+	for _, id := range adminIDs {
+		admins[id] = true
+	}
+	return &variableService{
+		variableRepo:  variableRepo,
+		scheduledRepo: scheduledRepo,
+		admins:        admins,
+		publisher:     publisher,
+	}
+}
+
+// GetVariables returns the requested variables, or the asset rates when no
+// keys are given. Unknown keys are left out of the result.
+func (s *variableService) GetVariables(ctx context.Context, keys []string) (map[string]float64, error) {
+	if len(keys) == 0 {
+		values, err := s.variableRepo.GetAllRates(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get rates: %w", err)
+		}
+		return values, nil
+	}
+
+	values, err := s.variableRepo.GetMany(ctx, keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get variables: %w", err)
+	}
+	return values, nil
+}
+
+// ListVariables returns every known variable with its current value
+func (s *variableService) ListVariables(ctx context.Context, adminID uint64) ([]*models.VariableValue, error) {
+	if !s.admins[adminID] {
+		return nil, ErrVariableNotAdmin
+	}
+
+	result := make([]*models.VariableValue, 0, len(variableDefinitions))
+	for _, def := range variableDefinitions {
+		variable, err := s.variableRepo.GetValue(ctx, def)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, variable)
+	}
+	return result, nil
+}
+
+func (s *variableService) GetVariable(ctx context.Context, adminID uint64, key string) (*models.VariableValue, error) {
+	if !s.admins[adminID] {
+		return nil, ErrVariableNotAdmin
+	}
+	def, ok := findVariableDefinition(key)
+	if !ok {
+		return nil, ErrVariableUnknown
+	}
+	return s.variableRepo.GetValue(ctx, def)
+}
+
+// SetVariable validates and stores a variable's new value, records who
+// changed it and announces the change
+func (s *variableService) SetVariable(ctx context.Context, adminID uint64, key, value, note string) (*models.VariableValue, error) {
+	if !s.admins[adminID] {
+		return nil, ErrVariableNotAdmin
+	}
+	def, ok := findVariableDefinition(key)
+	if !ok {
+		return nil, ErrVariableUnknown
+	}
+
+	parsed, err := parseVariableValue(def, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.setValue(ctx, def, parsed, adminID, strings.TrimSpace(note))
+}
+
+// setValue stores a validated value and announces the change
+func (s *variableService) setValue(ctx context.Context, def models.VariableDefinition, value decimal.Decimal, changerID uint64, note string) (*models.VariableValue, error) {
+	variable, err := s.variableRepo.SetValue(ctx, def, value, changerID, note)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.publisher != nil {
+		event := variables.ChangedEvent{
+			Key:       def.Key,
+			Value:     value.String(),
+			ChangedBy: changerID,
+			ChangedAt: time.Now(),
+		}
+		// The change is stored, caches elsewhere pick it up when they expire
+		if err := s.publisher.PublishVariableChanged(ctx, event); err != nil {
+			log.Printf("Failed to publish variable change of %s: %v", def.Key, err)
+		}
+	}
+
+	return variable, nil
+}
+
+// ListChanges returns the latest changes of a variable, newest first
+func (s *variableService) ListChanges(ctx context.Context, adminID uint64, key string, limit int) ([]*models.VariableChange, error) {
+	if !s.admins[adminID] {
+		return nil, ErrVariableNotAdmin
+	}
+	def, ok := findVariableDefinition(key)
+	if !ok {
+		return nil, ErrVariableUnknown
+	}
+
+	if limit <= 0 {
+		limit = defaultVariableChangeLimit
+	}
+	if limit > maxVariableChangeLimit {
+		limit = maxVariableChangeLimit
+	}
+	return s.variableRepo.ListChanges(ctx, def, limit)
+}
+
+// ScheduleChange stores a change to apply at effectiveAt. The value is
+// validated now so a scheduled change cannot fail when it is due.
+func (s *variableService) ScheduleChange(ctx context.Context, adminID uint64, key, value, note string, effectiveAt time.Time) (*models.ScheduledVariableChange, error) {
+	if !s.admins[adminID] {
+		return nil, ErrVariableNotAdmin
+	}
+	def, ok := findVariableDefinition(key)
+	if !ok {
+		return nil, ErrVariableUnknown
+	}
+	parsed, err := parseVariableValue(def, value)
+	if err != nil {
+		return nil, err
+	}
+	if !effectiveAt.After(time.Now()) {
+		return nil, ErrVariableNotScheduled
+	}
+
+	return s.scheduledRepo.Create(ctx, &models.ScheduledVariableChange{
+		Key:         def.Key,
+		Value:       parsed,
+		Note:        strings.TrimSpace(note),
+		EffectiveAt: effectiveAt,
+		CreatedBy:   adminID,
+	})
+}
+
+// ListScheduledChanges returns the pending changes of key, or of every
+// variable when key is empty, soonest first
+func (s *variableService) ListScheduledChanges(ctx context.Context, adminID uint64, key string) ([]*models.ScheduledVariableChange, error) {
+	if !s.admins[adminID] {
+		return nil, ErrVariableNotAdmin
+	}
+	key = strings.TrimSpace(key)
+	if key != "" {
+		if _, ok := findVariableDefinition(key); !ok {
+			return nil, ErrVariableUnknown
+		}
+	}
+	return s.scheduledRepo.ListPending(ctx, key)
+}
+
+func (s *variableService) CancelScheduledChange(ctx context.Context, adminID uint64, id uint64) (*models.ScheduledVariableChange, error) {
+	if !s.admins[adminID] {
+		return nil, ErrVariableNotAdmin
+	}
+	if err := s.scheduledRepo.Cancel(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.scheduledRepo.GetByID(ctx, id)
+}
+
+// ApplyDueChanges sets the due changes in the order they were due. Each
+// change is claimed first, so with several replicas only one applies it.
+func (s *variableService) ApplyDueChanges(ctx context.Context, now time.Time) (int, error) {
+	due, err := s.scheduledRepo.ListDue(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	for _, change := range due {
+		def, ok := findVariableDefinition(change.Key)
+		if !ok {
+			// Only possible if a definition was removed after scheduling
+			log.Printf("Canceling scheduled change %d of unknown variable %s", change.ID, change.Key)
+			if err := s.scheduledRepo.Cancel(ctx, change.ID); err != nil && !errors.Is(err, repository.ErrScheduledChangeNotPending) {
+				return applied, err
+			}
+			continue
+		}
+
+		if err := s.scheduledRepo.Claim(ctx, change.ID); err != nil {
+			if errors.Is(err, repository.ErrScheduledChangeNotPending) {
+				continue
+			}
+			return applied, err
+		}
+
+		note := strings.TrimSpace(fmt.Sprintf("%s (scheduled change #%d)", change.Note, change.ID))
+		if _, err := s.setValue(ctx, def, change.Value, change.CreatedBy, note); err != nil {
+			// Retried on the next run
+			if releaseErr := s.scheduledRepo.Release(ctx, change.ID); releaseErr != nil {
+				log.Printf("Failed to release scheduled change %d: %v", change.ID, releaseErr)
+			}
+			return applied, fmt.Errorf("failed to apply scheduled change %d: %w", change.ID, err)
+		}
+		applied++
+	}
+	return applied, nil
+}
+
+func (s *variableService) NextScheduledChange(ctx context.Context) (time.Time, bool, error) {
+	return s.scheduledRepo.NextEffectiveAt(ctx)
+}
+
+// parseVariableValue checks value against the definition's type and bounds
+func parseVariableValue(def models.VariableDefinition, value string) (decimal.Decimal, error) {
+	parsed, err := decimal.NewFromString(strings.TrimSpace(value))
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("%w: %s must be a number", ErrVariableInvalidValue, def.Key)
+	}
+	if parsed.Exponent() < -maxVariableDecimals {
+		return decimal.Zero, fmt.Errorf("%w: %s may have at most %d decimal places", ErrVariableInvalidValue, def.Key, maxVariableDecimals)
+	}
+
+	switch def.Type {
+	case models.VariableTypeRate:
+		if !parsed.IsPositive() {
+			return decimal.Zero, fmt.Errorf("%w: %s must be positive", ErrVariableInvalidValue, def.Key)
+		}
+	case models.VariableTypePercent, models.VariableTypeAmount:
+		if parsed.IsNegative() {
+			return decimal.Zero, fmt.Errorf("%w: %s must not be negative", ErrVariableInvalidValue, def.Key)
+		}
+	case models.VariableTypeInteger:
+		if !parsed.Equal(parsed.Truncate(0)) {
+			return decimal.Zero, fmt.Errorf("%w: %s must be a whole number", ErrVariableInvalidValue, def.Key)
+		}
+	}
+
+	if def.Min.Valid && parsed.LessThan(def.Min.Decimal) {
+		return decimal.Zero, fmt.Errorf("%w: %s must be at least %s", ErrVariableInvalidValue, def.Key, def.Min.Decimal)
+	}
+	if def.Max.Valid && parsed.GreaterThan(def.Max.Decimal) {
+		return decimal.Zero, fmt.Errorf("%w: %s must be at most %s", ErrVariableInvalidValue, def.Key, def.Max.Decimal)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+	"metargb/shared/pkg/variables"
+)
+
+type fakeVariableRepository struct {
+	variableReadStub
+	set       map[string]decimal.Decimal
+	changer   uint64
+	note      string
+	listLimit int
+}
+
+// variableReadStub implements the read methods the admin API does not use
+type variableReadStub struct{}
+
+func (variableReadStub) GetRate(context.Context, string) (float64, error) { return 0, nil }
+func (variableReadStub) GetAllRates(context.Context) (map[string]float64, error) {
+	return nil, nil
+}
+func (variableReadStub) GetByPrefix(context.Context, string) (map[string]float64, error) {
+	return nil, nil
+}
+func (variableReadStub) GetMany(context.Context, []string) (map[string]float64, error) {
+	return nil, nil
+}
+
+func (r *fakeVariableRepository) GetValue(_ context.Context, def models.VariableDefinition) (*models.VariableValue, error) {
+	value, ok := r.set[def.Key]
+	return &models.VariableValue{Definition: def, Value: value, IsSet: ok}, nil
+}
+
+func (r *fakeVariableRepository) SetValue(ctx context.Context, def models.VariableDefinition, value decimal.Decimal, changerID uint64, note string) (*models.VariableValue, error) {
+	r.set[def.Key] = value
+	r.changer, r.note = changerID, note
+	return r.GetValue(ctx, def)
+}
+
+func (r *fakeVariableRepository) ListChanges(_ context.Context, _ models.VariableDefinition, limit int) ([]*models.VariableChange, error) {
+	r.listLimit = limit
+	return nil, nil
+}
+
+type fakeVariablePublisher struct {
+	events []variables.ChangedEvent
+}
+
+func (p *fakeVariablePublisher) PublishVariableChanged(_ context.Context, event variables.ChangedEvent) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+func TestVariableSetValidatesAndPublishes(t *testing.T) {
+	repo := &fakeVariableRepository{set: map[string]decimal.Decimal{}}
+	publisher := &fakeVariablePublisher{}
+	svc := NewVariableService(repo, nil, []uint64{1}, publisher)
+
+	if _, err := svc.SetVariable(context.Background(), 2, "psc", "100", ""); !errors.Is(err, ErrVariableNotAdmin) {
+		t.Fatalf("expected ErrVariableNotAdmin, got %v", err)
+	}
+	if _, err := svc.SetVariable(context.Background(), 1, "gold", "100", ""); !errors.Is(err, ErrVariableUnknown) {
+		t.Fatalf("expected ErrVariableUnknown, got %v", err)
+	}
+
+	variable, err := svc.SetVariable(context.Background(), 1, "psc", "120000", " monthly update ")
+	if err != nil {
+		t.Fatalf("SetVariable returned error: %v", err)
+	}
+	if variable.Value.String() != "120000" || repo.changer != 1 || repo.note != "monthly update" {
+		t.Errorf("unexpected value %s, changer %d, note %q", variable.Value, repo.changer, repo.note)
+	}
+	if len(publisher.events) != 1 || publisher.events[0].Key != "psc" || publisher.events[0].Value != "120000" || publisher.events[0].ChangedBy != 1 {
+		t.Errorf("unexpected events %+v", publisher.events)
+	}
+
+	invalid := []struct{ key, value string }{
+		{"psc", "0"},
+		{"psc", "abc"},
+		{"psc", "1.00001"},
+		{"referral_tier_1_percent", "101"},
+		{"referral_tier_2_fixed", "-1"},
+		{"public_pricing_limit", "80.5"},
+		{"under_18_pricing_limit", "0"},
+		{"under_18_pricing_limit", "1001"},
+	}
+	for _, tc := range invalid {
+		if _, err := svc.SetVariable(context.Background(), 1, tc.key, tc.value, ""); !errors.Is(err, ErrVariableInvalidValue) {
+			t.Errorf("SetVariable(%s, %s) = %v, want ErrVariableInvalidValue", tc.key, tc.value, err)
+		}
+	}
+	if len(publisher.events) != 1 {
+		t.Errorf("rejected values were published: %+v", publisher.events)
+	}
+}
+
+func TestVariableListCoversDefinitions(t *testing.T) {
+	repo := &fakeVariableRepository{set: map[string]decimal.Decimal{"red": decimal.NewFromInt(5)}}
+	svc := NewVariableService(repo, nil, []uint64{1}, nil)
+
+	list, err := svc.ListVariables(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("ListVariables returned error: %v", err)
+	}
+	if len(list) != len(variableDefinitions) {
+		t.Fatalf("listed %d variables, want %d", len(list), len(variableDefinitions))
+	}
+	for _, v := range list {
+		if v.IsSet != (v.Definition.Key == "red") {
+			t.Errorf("%s IsSet = %v", v.Definition.Key, v.IsSet)
+		}
+	}
+}
+
+func TestVariableListChangesClampsLimit(t *testing.T) {
+	repo := &fakeVariableRepository{}
+	svc := NewVariableService(repo, nil, []uint64{1}, nil)
+
+	for limit, want := range map[int]int{0: defaultVariableChangeLimit, 50: 50, 1000: maxVariableChangeLimit} {
+		if _, err := svc.ListChanges(context.Background(), 1, "psc", limit); err != nil {
+			t.Fatalf("ListChanges returned error: %v", err)
+		}
+		if repo.listLimit != want {
+			t.Errorf("ListChanges(limit %d) used %d, want %d", limit, repo.listLimit, want)
+		}
+	}
+}
+
+type fakeScheduledVariableRepository struct {
+	changes []*models.ScheduledVariableChange
+}
+
+func (r *fakeScheduledVariableRepository) Create(_ context.Context, change *models.ScheduledVariableChange) (*models.ScheduledVariableChange, error) {
+	change.ID = uint64(len(r.changes) + 1)
+	change.Status = models.ScheduledChangePending
+	r.changes = append(r.changes, change)
+	return change, nil
+}
+
+func (r *fakeScheduledVariableRepository) GetByID(_ context.Context, id uint64) (*models.ScheduledVariableChange, error) {
+	for _, change := range r.changes {
+		if change.ID == id {
+			return change, nil
+		}
+	}
+	return nil, repository.ErrScheduledChangeNotPending
+}
+
+func (r *fakeScheduledVariableRepository) ListPending(_ context.Context, key string) ([]*models.ScheduledVariableChange, error) {
+	var pending []*models.ScheduledVariableChange
+	for _, change := range r.changes {
+		if change.Status == models.ScheduledChangePending && (key == "" || change.Key == key) {
+			pending = append(pending, change)
+		}
+	}
+	return pending, nil
+}
+
+func (r *fakeScheduledVariableRepository) ListDue(ctx context.Context, now time.Time) ([]*models.ScheduledVariableChange, error) {
+	pending, _ := r.ListPending(ctx, "")
+	var due []*models.ScheduledVariableChange
+	for _, change := range pending {
+		if !change.EffectiveAt.After(now) {
+			due = append(due, change)
+		}
+	}
+	return due, nil
+}
+
+func (r *fakeScheduledVariableRepository) NextEffectiveAt(ctx context.Context) (time.Time, bool, error) {
+	pending, _ := r.ListPending(ctx, "")
+	var next time.Time
+	for _, change := range pending {
+		if next.IsZero() || change.EffectiveAt.Before(next) {
+			next = change.EffectiveAt
+		}
+	}
+	return next, !next.IsZero(), nil
+}
+
+func (r *fakeScheduledVariableRepository) Claim(ctx context.Context, id uint64) error {
+	return r.setStatus(ctx, id, models.ScheduledChangePending, models.ScheduledChangeApplied)
+}
+
+func (r *fakeScheduledVariableRepository) Release(ctx context.Context, id uint64) error {
+	return r.setStatus(ctx, id, models.ScheduledChangeApplied, models.ScheduledChangePending)
+}
+
+func (r *fakeScheduledVariableRepository) Cancel(ctx context.Context, id uint64) error {
+	return r.setStatus(ctx, id, models.ScheduledChangePending, models.ScheduledChangeCanceled)
+}
+
+func (r *fakeScheduledVariableRepository) setStatus(ctx context.Context, id uint64, from, to string) error {
+	change, err := r.GetByID(ctx, id)
+	if err != nil || change.Status != from {
+		return repository.ErrScheduledChangeNotPending
+	}
+	change.Status = to
+	return nil
+}
+
+func TestVariableScheduleValidatesNow(t *testing.T) {
+	scheduled := &fakeScheduledVariableRepository{}
+	svc := NewVariableService(&fakeVariableRepository{set: map[string]decimal.Decimal{}}, scheduled, []uint64{1}, nil)
+	tomorrow := time.Now().Add(24 * time.Hour)
+
+	if _, err := svc.ScheduleChange(context.Background(), 2, "psc", "100", "", tomorrow); !errors.Is(err, ErrVariableNotAdmin) {
+		t.Errorf("expected ErrVariableNotAdmin, got %v", err)
+	}
+	if _, err := svc.ScheduleChange(context.Background(), 1, "psc", "0", "", tomorrow); !errors.Is(err, ErrVariableInvalidValue) {
+		t.Errorf("expected ErrVariableInvalidValue, got %v", err)
+	}
+	if _, err := svc.ScheduleChange(context.Background(), 1, "psc", "100", "", time.Now().Add(-time.Minute)); !errors.Is(err, ErrVariableNotScheduled) {
+		t.Errorf("expected ErrVariableNotScheduled, got %v", err)
+	}
+
+	change, err := svc.ScheduleChange(context.Background(), 1, " psc ", "130000", " new year ", tomorrow)
+	if err != nil {
+		t.Fatalf("ScheduleChange returned error: %v", err)
+	}
+	if change.Key != "psc" || change.Value.String() != "130000" || change.Note != "new year" || change.CreatedBy != 1 {
+		t.Errorf("unexpected scheduled change %+v", change)
+	}
+
+	if _, err := svc.CancelScheduledChange(context.Background(), 1, change.ID); err != nil {
+		t.Fatalf("CancelScheduledChange returned error: %v", err)
+	}
+	if _, err := svc.CancelScheduledChange(context.Background(), 1, change.ID); !errors.Is(err, repository.ErrScheduledChangeNotPending) {
+		t.Errorf("expected a second cancel to fail, got %v", err)
+	}
+}
+
+func TestVariableApplyDueChanges(t *testing.T) {
+	repo := &fakeVariableRepository{set: map[string]decimal.Decimal{"psc": decimal.NewFromInt(120000)}}
+	publisher := &fakeVariablePublisher{}
+	now := time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)
+	scheduled := &fakeScheduledVariableRepository{changes: []*models.ScheduledVariableChange{
+		{ID: 1, Key: "psc", Value: decimal.NewFromInt(130000), Note: "new year", EffectiveAt: now, Status: models.ScheduledChangePending, CreatedBy: 7},
+		{ID: 2, Key: "red", Value: decimal.NewFromInt(600), EffectiveAt: now.Add(time.Hour), Status: models.ScheduledChangePending, CreatedBy: 7},
+		{ID: 3, Key: "gold", Value: decimal.NewFromInt(1), EffectiveAt: now.Add(-time.Hour), Status: models.ScheduledChangePending, CreatedBy: 7},
+	}}
+	svc := NewVariableService(repo, scheduled, []uint64{7}, publisher)
+
+	applied, err := svc.ApplyDueChanges(context.Background(), now)
+	if err != nil || applied != 1 {
+		t.Fatalf("expected 1 change applied, got %d, %v", applied, err)
+	}
+	if !repo.set["psc"].Equal(decimal.NewFromInt(130000)) || repo.changer != 7 || !strings.HasPrefix(repo.note, "new year") {
+		t.Errorf("unexpected stored psc %s by %d with note %q", repo.set["psc"], repo.changer, repo.note)
+	}
+	if len(publisher.events) != 1 || publisher.events[0].Key != "psc" || publisher.events[0].Value != "130000" {
+		t.Errorf("unexpected events %+v", publisher.events)
+	}
+	if scheduled.changes[0].Status != models.ScheduledChangeApplied || scheduled.changes[2].Status != models.ScheduledChangeCanceled {
+		t.Errorf("unexpected statuses %s, %s", scheduled.changes[0].Status, scheduled.changes[2].Status)
+	}
+
+	// Applied changes are not applied again
+	if applied, err := svc.ApplyDueChanges(context.Background(), now); err != nil || applied != 0 {
+		t.Errorf("expected nothing left to apply, got %d, %v", applied, err)
+	}
+	if next, ok, _ := svc.NextScheduledChange(context.Background()); !ok || !next.Equal(now.Add(time.Hour)) {
+		t.Errorf("unexpected next change %v, %v", next, ok)
+	}
+}
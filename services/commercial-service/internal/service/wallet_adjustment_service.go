@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+)
+
+// MaxAdjustmentEntries limits the number of entries in a single batch
+const MaxAdjustmentEntries = 5000
+
+// adjustableAssets are the wallet columns a batch may credit or debit
+var adjustableAssets = map[string]bool{
+	"psc": true, "irr": true, "red": true, "blue": true, "yellow": true,
+}
+
+var (
+	ErrAdjustmentNotAdmin       = errors.New("unauthorized: only wallet admins can manage adjustment batches")
+	ErrAdjustmentSelfApproval   = errors.New("a batch must be approved by a different admin than its creator")
+	ErrAdjustmentBatchNotFound  = errors.New("adjustment batch not found")
+	ErrAdjustmentReasonRequired = errors.New("reason is required")
+	ErrAdjustmentNoEntries      = errors.New("entries are required")
+	ErrAdjustmentTooManyEntries = fmt.Errorf("a batch can have at most %d entries", MaxAdjustmentEntries)
+	ErrAdjustmentInvalidEntry   = errors.New("invalid entry")
+	ErrAdjustmentInvalidStatus  = errors.New("status must be pending, executed or rejected")
+)
+
+type WalletAdjustmentService interface {
+	CreateBatch(ctx context.Context, adminID uint64, reason, entries string) (*models.AdjustmentBatch, error)
+	ListBatches(ctx context.Context, adminID uint64, status string) ([]*models.AdjustmentBatch, error)
+	GetBatch(ctx context.Context, adminID, batchID uint64) (*models.AdjustmentBatch, error)
+	ApproveBatch(ctx context.Context, adminID, batchID uint64) (*models.AdjustmentBatch, error)
+	RejectBatch(ctx context.Context, adminID, batchID uint64, note string) (*models.AdjustmentBatch, error)
+}
+
+type walletAdjustmentService struct {
+	adjustmentRepo repository.WalletAdjustmentRepository
+	admins         map[uint64]bool
+}
+
+// NewWalletAdjustmentService creates the adjustment service. Only adminIDs can
+// create, approve and reject batches, and a batch needs two different admins.
+func NewWalletAdjustmentService(adjustmentRepo repository.WalletAdjustmentRepository, adminIDs []uint64) WalletAdjustmentService {
+	admins := make(map[uint64]bool, len(adminIDs))
+	for _, id := range adminIDs {
+		admins[id] = true
+	}
+	return &walletAdjustmentService{
+		adjustmentRepo: adjustmentRepo,
+		admins:         admins,
+	}
+}
+
+func (s *walletAdjustmentService) CreateBatch(ctx context.Context, adminID uint64, reason, entries string) (*models.AdjustmentBatch, error) {
+	if !s.admins[adminID] {
+		return nil, ErrAdjustmentNotAdmin
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrAdjustmentReasonRequired
+	}
+
+	parsed, err := parseAdjustmentEntries(entries)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := s.adjustmentRepo.Create(ctx, &models.AdjustmentBatch{
+		Reason:    reason,
+		CreatedBy: adminID,
+		Entries:   parsed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.adjustmentRepo.GetByID(ctx, batch.ID)
+}
+
+func (s *walletAdjustmentService) ListBatches(ctx context.Context, adminID uint64, status string) ([]*models.AdjustmentBatch, error) {
+	if !s.admins[adminID] {
+		return nil, ErrAdjustmentNotAdmin
+	}
+	switch status {
+	case "", models.AdjustmentBatchPending, models.AdjustmentBatchExecuted, models.AdjustmentBatchRejected:
+	default:
+		return nil, ErrAdjustmentInvalidStatus
+	}
+
+	return s.adjustmentRepo.List(ctx, status)
+}
+
+func (s *walletAdjustmentService) GetBatch(ctx context.Context, adminID, batchID uint64) (*models.AdjustmentBatch, error) {
+	if !s.admins[adminID] {
+		return nil, ErrAdjustmentNotAdmin
+	}
+
+	batch, err := s.adjustmentRepo.GetByID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if batch == nil {
+		return nil, ErrAdjustmentBatchNotFound
+	}
+
+	return batch, nil
+}
+
+// ApproveBatch executes a pending batch created by another admin. The batch
+// stays pending if any entry cannot be applied.
+func (s *walletAdjustmentService) ApproveBatch(ctx context.Context, adminID, batchID uint64) (*models.AdjustmentBatch, error) {
+	batch, err := s.GetBatch(ctx, adminID, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if batch.Status != models.AdjustmentBatchPending {
+		return nil, repository.ErrAdjustmentBatchNotPending
+	}
+	if batch.CreatedBy == adminID {
+		return nil, ErrAdjustmentSelfApproval
+	}
+
+	if err := s.adjustmentRepo.Execute(ctx, batchID, adminID); err != nil {
+		return nil, err
+	}
+
+	return s.adjustmentRepo.GetByID(ctx, batchID)
+}
+
+// RejectBatch discards a pending batch. Its creator may reject it to withdraw it.
+func (s *walletAdjustmentService) RejectBatch(ctx context.Context, adminID, batchID uint64, note string) (*models.AdjustmentBatch, error) {
+	if _, err := s.GetBatch(ctx, adminID, batchID); err != nil {
+		return nil, err
+	}
+
+	if err := s.adjustmentRepo.Reject(ctx, batchID, adminID, strings.TrimSpace(note)); err != nil {
+		return nil, err
+	}
+
+	return s.adjustmentRepo.GetByID(ctx, batchID)
+}
+
+// parseAdjustmentEntries parses "user_id,asset,amount" lines, skipping blank
+// lines and an optional header line
+func parseAdjustmentEntries(payload string) ([]*models.AdjustmentEntry, error) {
+	var entries []*models.AdjustmentEntry
+
+	for i, raw := range strings.Split(payload, "\n") {
+		line := int32(i + 1)
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+
+		fields := strings.Split(raw, ",")
+		for j := range fields {
+			fields[j] = strings.TrimSpace(fields[j])
+		}
+		if len(entries) == 0 && strings.EqualFold(fields[0], "user_id") {
+			continue
+		}
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("%w on line %d: expected user_id,asset,amount", ErrAdjustmentInvalidEntry, line)
+		}
+
+		userID, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil || userID == 0 {
+			return nil, fmt.Errorf("%w on line %d: invalid user_id %q", ErrAdjustmentInvalidEntry, line, fields[0])
+		}
+
+		asset := strings.ToLower(fields[1])
+		if !adjustableAssets[asset] {
+			return nil, fmt.Errorf("%w on line %d: asset must be one of psc, irr, red, blue, yellow", ErrAdjustmentInvalidEntry, line)
+		}
+
+		amount, err := decimal.NewFromString(fields[2])
+		if err != nil || amount.IsZero() {
+			return nil, fmt.Errorf("%w on line %d: invalid amount %q", ErrAdjustmentInvalidEntry, line, fields[2])
+		}
+		// Wallet columns hold irr as an integer and other assets with 10 decimal places
+		if (asset == "irr" && !amount.IsInteger()) || amount.Exponent() < -10 {
+			return nil, fmt.Errorf("%w on line %d: too many decimal places in %q", ErrAdjustmentInvalidEntry, line, fields[2])
+		}
+
+		if len(entries) == MaxAdjustmentEntries {
+			return nil, ErrAdjustmentTooManyEntries
+		}
+		entries = append(entries, &models.AdjustmentEntry{
+			Line:   line,
+			UserID: userID,
+			Asset:  asset,
+			Amount: amount,
+		})
+	}
+
+	if len(entries) == 0 {
+		return nil, ErrAdjustmentNoEntries
+	}
+	return entries, nil
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"metargb/commercial-service/internal/models"
+	"metargb/commercial-service/internal/repository"
+)
+
+func TestParseAdjustmentEntries(t *testing.T) {
+	entries, err := parseAdjustmentEntries("user_id,asset,amount\n12, PSC, 1.5\n\n13,irr,-20000\n")
+	if err != nil {
+		t.Fatalf("parseAdjustmentEntries returned error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].UserID != 12 || entries[0].Asset != "psc" || entries[0].Amount.String() != "1.5" || entries[0].Line != 2 {
+		t.Errorf("unexpected first entry %+v", entries[0])
+	}
+	if entries[1].UserID != 13 || entries[1].Asset != "irr" || !entries[1].Amount.IsNegative() || entries[1].Line != 4 {
+		t.Errorf("unexpected second entry %+v", entries[1])
+	}
+
+	invalid := []string{
+		"",
+		"user_id,asset,amount",
+		"12,psc",
+		"0,psc,1",
+		"12,effect,1",
+		"12,psc,0",
+		"12,psc,abc",
+		"12,irr,1.5",
+		"12,psc,0.00000000001",
+	}
+	for _, payload := range invalid {
+		if _, err := parseAdjustmentEntries(payload); err == nil {
+			t.Errorf("parseAdjustmentEntries(%q) expected error", payload)
+		}
+	}
+}
+
+type fakeAdjustmentRepository struct {
+	batch      *models.AdjustmentBatch
+	executedBy uint64
+	executeErr error
+}
+
+func (r *fakeAdjustmentRepository) Create(_ context.Context, batch *models.AdjustmentBatch) (*models.AdjustmentBatch, error) {
+	batch.ID = 1
+	batch.Status = models.AdjustmentBatchPending
+	r.batch = batch
+	return batch, nil
+}
+
+func (r *fakeAdjustmentRepository) GetByID(_ context.Context, batchID uint64) (*models.AdjustmentBatch, error) {
+	if r.batch == nil || r.batch.ID != batchID {
+		return nil, nil
+	}
+	return r.batch, nil
+}
+
+func (r *fakeAdjustmentRepository) List(context.Context, string) ([]*models.AdjustmentBatch, error) {
+	return []*models.AdjustmentBatch{r.batch}, nil
+}
+
+func (r *fakeAdjustmentRepository) Execute(_ context.Context, batchID, approverID uint64) error {
+	if r.executeErr != nil {
+		return r.executeErr
+	}
+	r.executedBy = approverID
+	r.batch.Status = models.AdjustmentBatchExecuted
+	return nil
+}
+
+func (r *fakeAdjustmentRepository) Reject(_ context.Context, batchID, adminID uint64, note string) error {
+	r.batch.Status = models.AdjustmentBatchRejected
+	return nil
+}
+
+func TestWalletAdjustmentService_TwoPersonApproval(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeAdjustmentRepository{}
+	svc := NewWalletAdjustmentService(repo, []uint64{1, 2})
+
+	if _, err := svc.CreateBatch(ctx, 3, "incident compensation", "12,psc,5"); !errors.Is(err, ErrAdjustmentNotAdmin) {
+		t.Fatalf("expected ErrAdjustmentNotAdmin, got %v", err)
+	}
+	if _, err := svc.CreateBatch(ctx, 1, " ", "12,psc,5"); !errors.Is(err, ErrAdjustmentReasonRequired) {
+		t.Fatalf("expected ErrAdjustmentReasonRequired, got %v", err)
+	}
+
+	batch, err := svc.CreateBatch(ctx, 1, "incident compensation", "12,psc,5\n13,red,-1")
+	if err != nil {
+		t.Fatalf("CreateBatch failed: %v", err)
+	}
+	if batch.Status != models.AdjustmentBatchPending || len(batch.Entries) != 2 {
+		t.Fatalf("unexpected batch %+v", batch)
+	}
+
+	if _, err := svc.ApproveBatch(ctx, 1, batch.ID); !errors.Is(err, ErrAdjustmentSelfApproval) {
+		t.Fatalf("expected ErrAdjustmentSelfApproval, got %v", err)
+	}
+	if _, err := svc.ApproveBatch(ctx, 3, batch.ID); !errors.Is(err, ErrAdjustmentNotAdmin) {
+		t.Fatalf("expected ErrAdjustmentNotAdmin, got %v", err)
+	}
+
+	approved, err := svc.ApproveBatch(ctx, 2, batch.ID)
+	if err != nil {
+		t.Fatalf("ApproveBatch failed: %v", err)
+	}
+	if approved.Status != models.AdjustmentBatchExecuted || repo.executedBy != 2 {
+		t.Errorf("expected batch executed by admin 2, got status %s by %d", approved.Status, repo.executedBy)
+	}
+
+	if _, err := svc.ApproveBatch(ctx, 2, batch.ID); !errors.Is(err, repository.ErrAdjustmentBatchNotPending) {
+		t.Errorf("expected ErrAdjustmentBatchNotPending, got %v", err)
+	}
+	if _, err := svc.ApproveBatch(ctx, 2, 99); !errors.Is(err, ErrAdjustmentBatchNotFound) {
+		t.Errorf("expected ErrAdjustmentBatchNotFound, got %v", err)
+	}
+}
+
+func TestWalletAdjustmentService_ApproveFailureKeepsBatchPending(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeAdjustmentRepository{executeErr: repository.ErrAdjustmentInsufficientBalance}
+	svc := NewWalletAdjustmentService(repo, []uint64{1, 2})
+
+	batch, err := svc.CreateBatch(ctx, 1, "incident compensation", "12,psc,-5")
+	if err != nil {
+		t.Fatalf("CreateBatch failed: %v", err)
+	}
+
+	if _, err := svc.ApproveBatch(ctx, 2, batch.ID); !errors.Is(err, repository.ErrAdjustmentInsufficientBalance) {
+		t.Fatalf("expected ErrAdjustmentInsufficientBalance, got %v", err)
+	}
+	if repo.batch.Status != models.AdjustmentBatchPending {
+		t.Errorf("expected batch to stay pending, got %s", repo.batch.Status)
+	}
+}
@@ -6,24 +6,33 @@ import (
 
 	"github.com/shopspring/decimal"
 
+	"metargb/commercial-service/internal/models"
 	"metargb/commercial-service/internal/repository"
 )
 
 type WalletService interface {
 	GetWallet(ctx context.Context, userID uint64) (map[string]string, error)
-	DeductBalance(ctx context.Context, userID uint64, asset string, amount float64) (map[string]string, error)
-	AddBalance(ctx context.Context, userID uint64, asset string, amount float64) (map[string]string, error)
-	LockBalance(ctx context.Context, userID uint64, asset string, amount float64, reason string) error
-	UnlockBalance(ctx context.Context, userID uint64, asset string, amount float64) error
+	DeductBalance(ctx context.Context, userID uint64, asset string, amount decimal.Decimal) (map[string]string, error)
+	ReclaimBalance(ctx context.Context, userID uint64, asset string, amount decimal.Decimal) (map[string]string, error)
+	AddBalance(ctx context.Context, userID uint64, asset string, amount decimal.Decimal) (map[string]string, error)
+	LockBalance(ctx context.Context, userID uint64, asset string, amount decimal.Decimal, reason string) error
+	UnlockBalance(ctx context.Context, userID uint64, asset string, amount decimal.Decimal) error
 }
 
 type walletService struct {
 	walletRepo repository.WalletRepository
+	spending   SpendingLimiter
+	fraud      FraudChecker
 }
 
-func NewWalletService(walletRepo repository.WalletRepository) WalletService {
+// NewWalletService creates the wallet service. Deductions are counted
+// against the spending limits of users under 18 unless spending is nil, and
+// large deductions pass the fraud rules unless fraud is nil.
+func NewWalletService(walletRepo repository.WalletRepository, spending SpendingLimiter, fraud FraudChecker) WalletService {
 	return &walletService{
 		walletRepo: walletRepo,
+		spending:   spending,
+		fraud:      fraud,
 	}
 }
 
@@ -48,21 +57,45 @@ func (s *walletService) GetWallet(ctx context.Context, userID uint64) (map[strin
 	}, nil
 }
 
-func (s *walletService) DeductBalance(ctx context.Context, userID uint64, asset string, amount float64) (map[string]string, error) {
-	amountDec := decimal.NewFromFloat(amount)
+func (s *walletService) DeductBalance(ctx context.Context, userID uint64, asset string, amount decimal.Decimal) (map[string]string, error) {
+	if s.fraud != nil {
+		err := s.fraud.Check(ctx, FraudCheckInput{UserID: userID, Kind: models.FraudKindTransfer, Asset: asset, Amount: amount})
+		if err != nil {
+			return nil, fmt.Errorf("failed to deduct balance: %w", err)
+		}
+	}
+
+	release := func() {}
+	if s.spending != nil {
+		var err error
+		release, err = s.spending.Reserve(ctx, userID, asset, amount, models.SpendingSourceWallet)
+		if err != nil {
+			return nil, fmt.Errorf("failed to deduct balance: %w", err)
+		}
+	}
 
-	err := s.walletRepo.DeductBalance(ctx, userID, asset, amountDec)
+	err := s.walletRepo.DeductBalance(ctx, userID, asset, amount)
 	if err != nil {
+		release()
 		return nil, fmt.Errorf("failed to deduct balance: %w", err)
 	}
 
 	return s.GetWallet(ctx, userID)
 }
 
-func (s *walletService) AddBalance(ctx context.Context, userID uint64, asset string, amount float64) (map[string]string, error) {
-	amountDec := decimal.NewFromFloat(amount)
+// ReclaimBalance takes back funds a user received, such as the proceeds of a
+// refunded trade. It is not spending, so spending limits do not apply.
+func (s *walletService) ReclaimBalance(ctx context.Context, userID uint64, asset string, amount decimal.Decimal) (map[string]string, error) {
+	err := s.walletRepo.DeductBalance(ctx, userID, asset, amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reclaim balance: %w", err)
+	}
+
+	return s.GetWallet(ctx, userID)
+}
 
-	err := s.walletRepo.AddBalance(ctx, userID, asset, amountDec)
+func (s *walletService) AddBalance(ctx context.Context, userID uint64, asset string, amount decimal.Decimal) (map[string]string, error) {
+	err := s.walletRepo.AddBalance(ctx, userID, asset, amount)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add balance: %w", err)
 	}
@@ -70,10 +103,8 @@ func (s *walletService) AddBalance(ctx context.Context, userID uint64, asset str
 	return s.GetWallet(ctx, userID)
 }
 
-func (s *walletService) LockBalance(ctx context.Context, userID uint64, asset string, amount float64, reason string) error {
-	amountDec := decimal.NewFromFloat(amount)
-
-	err := s.walletRepo.LockBalance(ctx, userID, asset, amountDec, reason)
+func (s *walletService) LockBalance(ctx context.Context, userID uint64, asset string, amount decimal.Decimal, reason string) error {
+	err := s.walletRepo.LockBalance(ctx, userID, asset, amount, reason)
 	if err != nil {
 		return fmt.Errorf("failed to lock balance: %w", err)
 	}
@@ -81,10 +112,8 @@ func (s *walletService) LockBalance(ctx context.Context, userID uint64, asset st
 	return nil
 }
 
-func (s *walletService) UnlockBalance(ctx context.Context, userID uint64, asset string, amount float64) error {
-	amountDec := decimal.NewFromFloat(amount)
-
-	err := s.walletRepo.UnlockBalance(ctx, userID, asset, amountDec)
+func (s *walletService) UnlockBalance(ctx context.Context, userID uint64, asset string, amount decimal.Decimal) error {
+	err := s.walletRepo.UnlockBalance(ctx, userID, asset, amount)
 	if err != nil {
 		return fmt.Errorf("failed to unlock balance: %w", err)
 	}
@@ -3,43 +3,71 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 
+	"metargb/dynasty-service/internal/client"
 	"metargb/dynasty-service/internal/handler"
 	"metargb/dynasty-service/internal/repository"
 	"metargb/dynasty-service/internal/service"
-
 	dynastypb "metargb/shared/pb/dynasty"
+	"metargb/shared/pkg/auth"
+	"metargb/shared/pkg/buildinfo"
+	shareddb "metargb/shared/pkg/db"
+	"metargb/shared/pkg/dbsplit"
+	"metargb/shared/pkg/deadline"
+	"metargb/shared/pkg/debug"
+	"metargb/shared/pkg/devmode"
+	"metargb/shared/pkg/faultinject"
+	healthprobe "metargb/shared/pkg/health"
+	"metargb/shared/pkg/jobs"
+	"metargb/shared/pkg/logger"
+	"metargb/shared/pkg/msgsize"
 )
 
 func main() {
 	// Load environment variables
-	if err := godotenv.Load(); err != nil {
-		log.Printf("Warning: .env file not found: %v", err)
+	envErr := godotenv.Load()
+
+	// Initialize logger once the environment is loaded so LOG_LEVEL applies
+	log := logger.NewLogger("dynasty-service")
+	log.RedirectStdLog()
+	if envErr != nil {
+		log.Warn(".env file not found", "error", envErr)
 	}
 
+	// SIGHUP toggles debug logging; DYNASTY_LOG_ADMIN_ADDR serves the level over HTTP
+	logCtx, stopLogControl := context.WithCancel(context.Background())
+	defer stopLogControl()
+	log.EnableLevelControl(logCtx)
+
 	// Database connection
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
+	dsn := shareddb.ServiceDSN("dynasty-service", fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
 		getEnv("DB_USER", "root"),
 		getEnv("DB_PASSWORD", ""),
 		getEnv("DB_HOST", "localhost"),
 		getEnv("DB_PORT", "3306"),
 		getEnv("DB_DATABASE", "metargb_db"),
-	)
+	))
 
 	db, err := sql.Open("mysql", dsn)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		log.Fatal("Failed to connect to database", "error", err)
 	}
 	defer db.Close()
 
@@ -48,13 +76,11 @@ func main() {
 	db.SetMaxIdleConns(5)
 	db.SetConnMaxLifetime(5 * time.Minute)
 
-	// Test connection
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	if err := db.PingContext(ctx); err != nil {
-		log.Fatalf("Failed to ping database: %v", err)
+	// Wait for the database instead of crash looping while it is unavailable
+	if err := shareddb.PingWithRetry(context.Background(), db, shareddb.RetryPolicyFromEnv()); err != nil {
+		log.Fatal("Failed to ping database", "error", err)
 	}
-	log.Println("Successfully connected to database")
+	log.Info("Successfully connected to database")
 
 	// Initialize repositories
 	dynastyRepo := repository.NewDynastyRepository(db)
@@ -62,6 +88,8 @@ func main() {
 	familyRepo := repository.NewFamilyRepository(db)
 	prizeRepo := repository.NewPrizeRepository(db)
 	permissionRepo := repository.NewPermissionRepository(db)
+	membershipRulesRepo := repository.NewMembershipRulesRepository(db)
+	statsRepo := repository.NewStatsRepository(db)
 
 	// Notification service client (for sending notifications)
 	notificationServiceAddr := getEnv("NOTIFICATION_SERVICE_ADDR", "localhost:50058")
@@ -69,50 +97,177 @@ func main() {
 	// Initialize services
 	dynastyService := service.NewDynastyService(dynastyRepo, familyRepo, prizeRepo, notificationServiceAddr)
 	joinRequestService := service.NewJoinRequestService(joinRequestRepo, dynastyRepo, familyRepo, prizeRepo, notificationServiceAddr)
+
+	// Join requests are checked against the rules DYNASTY_ADMIN_IDS manage
+	membershipRulesService := service.NewMembershipRulesService(membershipRulesRepo, parseUserIDs(getEnv("DYNASTY_ADMIN_IDS", ""), log))
+	joinRequestService.SetMembershipRules(membershipRulesService)
 	familyService := service.NewFamilyService(familyRepo, dynastyRepo)
+	// Awarded prizes are held in escrow and must be claimed within DYNASTY_PRIZE_CLAIM_WINDOW
+	prizeRepo.SetClaimWindow(getEnvAsDuration("DYNASTY_PRIZE_CLAIM_WINDOW", service.DefaultPrizeClaimWindow, log))
 	prizeService := service.NewPrizeService(prizeRepo)
 	permissionService := service.NewPermissionService(permissionRepo, joinRequestRepo, familyRepo, dynastyRepo)
 	userSearchService := service.NewUserSearchService(db)
 
+	// Guardians manage the spending limits of their children, which
+	// commercial-service keeps; it authenticates the guardian's forwarded token
+	var spendingLimits service.SpendingLimitsClient
+	commercialClient, err := client.NewCommercialClient(getEnv("COMMERCIAL_SERVICE_ADDR", "commercial-service:50052"), grpc.WithUnaryInterceptor(forwardAuthorization))
+	if err != nil {
+		log.Warn("Failed to connect to commercial service - spending limits unavailable", "error", err)
+	} else {
+		defer commercialClient.Close()
+		spendingLimits = commercialClient
+		prizeService.SetWallet(commercialClient)
+	}
+	guardianService := service.NewGuardianService(joinRequestRepo, familyRepo, dynastyRepo, spendingLimits)
+
+	// Leaderboards rank dynasties by member scores from levels-service and
+	// owned lands from features-service, aggregated every DYNASTY_STATS_INTERVAL
+	var memberScores service.MemberScoreSource
+	levelsClient, err := client.NewLevelsClient(getEnv("LEVELS_SERVICE_ADDR", "levels-service:50054"))
+	if err != nil {
+		log.Warn("Failed to connect to levels service - dynasty stats will not be refreshed", "error", err)
+	} else {
+		defer levelsClient.Close()
+		memberScores = levelsClient
+	}
+	var landCounts service.LandCountSource
+	serviceAPIKey := getEnv(auth.ServiceAPIKeyEnv, "")
+	if serviceAPIKey == "" {
+		log.Warn("SERVICE_API_KEY is not set - features service will reject land counts")
+	}
+	featuresClient, err := client.NewFeaturesClient(getEnv("FEATURES_SERVICE_ADDR", "features-service:50053"), serviceAPIKey)
+	if err != nil {
+		log.Warn("Failed to connect to features service - dynasty stats will not be refreshed", "error", err)
+	} else {
+		defer featuresClient.Close()
+		landCounts = featuresClient
+	}
+	statsInterval := service.DefaultDynastyStatsInterval
+	if v := os.Getenv("DYNASTY_STATS_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			statsInterval = d
+		} else {
+			log.Warn("Invalid DYNASTY_STATS_INTERVAL, using default", "value", v, "default", statsInterval)
+		}
+	}
+	statsService := service.NewDynastyStatsService(statsRepo, memberScores, landCounts, statsInterval, log)
+
+	// Expired prizes return to the prize pool; owners are reminded
+	// DYNASTY_PRIZE_REMINDER_BEFORE a prize expires
+	var prizeNotifier service.PrizeNotifier
+	notificationClient, err := client.NewNotificationClient(notificationServiceAddr)
+	if err != nil {
+		log.Warn("Failed to connect to notifications service - prize reminders disabled", "error", err)
+	} else {
+		defer notificationClient.Close()
+		prizeNotifier = notificationClient
+	}
+	prizeEscrowService := service.NewPrizeEscrowService(prizeRepo, prizeNotifier,
+		getEnvAsDuration("DYNASTY_PRIZE_ESCROW_INTERVAL", service.DefaultPrizeEscrowInterval, log),
+		getEnvAsDuration("DYNASTY_PRIZE_REMINDER_BEFORE", service.DefaultPrizeReminderBefore, log),
+		log)
+
 	// Create gRPC server
-	grpcServer := grpc.NewServer()
+	limits := msgsize.FromEnv("dynasty-service", msgsize.Defaults())
+	serverOpts := append(limits.ServerOptions(),
+		grpc.ChainUnaryInterceptor(logger.UnaryServerInterceptor(log)),
+		grpc.ChainStreamInterceptor(logger.StreamServerInterceptor(log)),
+	)
+
+	// Cap how long handlers run so slow queries are cancelled, see deadline.FromEnv
+	serverOpts = append(serverOpts, deadline.FromEnv("dynasty-service", deadline.Defaults()).ServerOptions()...)
+
+	// Staging builds inject latency and errors into chosen methods, see faultinject
+	faultCtx, stopFaults := context.WithCancel(context.Background())
+	defer stopFaults()
+	serverOpts = append(serverOpts, faultinject.FromEnv(faultCtx, "dynasty-service", log)...)
+
+	// Outside development internal errors reach callers only as a reference, see devmode
+	serverOpts = append(serverOpts, devmode.FromEnv("dynasty-service", log)...)
+
+	// Every response names the build that served it, see buildinfo
+	serverOpts = append(serverOpts, buildinfo.ServerOptions()...)
+	grpcServer := grpc.NewServer(serverOpts...)
+
+	// Report readiness over HTTP and through the standard gRPC health service:
+	// the database is reachable, its tables exist and the server has started
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	defer stopHealth()
+	dbMonitor := shareddb.NewHealthMonitor(db, nil)
+	dbMonitor.Start(healthCtx)
+	probe := healthprobe.NewProbe("dynasty-service").
+		AddCheck("database", dbMonitor.Check).
+		AddCheck("migrations", shareddb.NewSchemaGuard(db).RequireTables(dbsplit.Ownership["dynasty-service"]...))
+	serving := probe.Starting("grpc")
+	probe.Watch(healthCtx, healthServer, healthprobe.DefaultInterval)
+	probeServer := healthprobe.NewServer(":"+getEnv("HEALTH_PORT", healthprobe.DefaultPort), probe)
+	go func() {
+		if err := probeServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Health probe server failed", "error", err)
+		}
+	}()
 
 	// Create dedicated handlers for each service
 	dynastyHandler := handler.NewDynastyHandler(dynastyService)
 	joinRequestHandler := handler.NewJoinRequestHandler(joinRequestService, permissionService, userSearchService)
-	familyHandler := handler.NewFamilyHandler(familyService, permissionService)
+	familyHandler := handler.NewFamilyHandler(familyService, permissionService, guardianService)
 	prizeHandler := handler.NewPrizeHandler(prizeService)
+	membershipRulesHandler := handler.NewMembershipRulesHandler(membershipRulesService)
+	leaderboardHandler := handler.NewLeaderboardHandler(statsService)
 
 	// Register all services with their dedicated handlers
 	dynastypb.RegisterDynastyServiceServer(grpcServer, dynastyHandler)
 	dynastypb.RegisterJoinRequestServiceServer(grpcServer, joinRequestHandler)
 	dynastypb.RegisterFamilyServiceServer(grpcServer, familyHandler)
 	dynastypb.RegisterDynastyPrizeServiceServer(grpcServer, prizeHandler)
+	dynastypb.RegisterMembershipRulesServiceServer(grpcServer, membershipRulesHandler)
+	dynastypb.RegisterDynastyLeaderboardServiceServer(grpcServer, leaderboardHandler)
+
+	// Background jobs are timed, counted and listed on jobs.Path
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	backgroundJobs := jobs.NewRunner("dynasty-service", log)
+	statsService.Register(backgroundJobs)
+	prizeEscrowService.Register(backgroundJobs)
+	backgroundJobs.Start(jobsCtx)
+
+	// Reflection is only registered in development, see devmode
+	devmode.Register(grpcServer, log)
+
+	// Profiling endpoints, off unless DEBUG_ADDR is set or in development, see debug
+	debug.Serve(context.Background(), "dynasty-service", log)
 
 	// Start gRPC server
 	port := getEnv("GRPC_PORT", "50055")
 	listener, err := net.Listen("tcp", ":"+port)
 	if err != nil {
-		log.Fatalf("Failed to listen on port %s: %v", port, err)
+		log.Fatal("Failed to listen", "error", err, "port", port)
 	}
 
-	log.Printf("Dynasty service listening on port %s", port)
+	log.Info("Dynasty service listening", "port", port)
 
 	// Graceful shutdown
 	go func() {
 		if err := grpcServer.Serve(listener); err != nil {
-			log.Fatalf("Failed to serve: %v", err)
+			log.Fatal("Failed to serve", "error", err)
 		}
 	}()
+	serving()
 
 	// Wait for interrupt signal
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	log.Println("Shutting down server...")
+	log.Info("Shutting down server...")
+	probe.Drain()
+	healthServer.Shutdown()
 	grpcServer.GracefulStop()
-	log.Println("Server stopped")
+	probeServer.Close()
+	log.Info("Server stopped")
 }
 
 func getEnv(key, defaultValue string) string {
@@ -121,3 +276,44 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+func getEnvAsDuration(key string, defaultValue time.Duration, log *logger.Logger) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		log.Warn("Invalid "+key+", using default", "value", value, "default", defaultValue)
+		return defaultValue
+	}
+	return d
+}
+
+// forwardAuthorization passes the caller's authorization header on to commercial-service
+func forwardAuthorization(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if inMd, ok := metadata.FromIncomingContext(ctx); ok {
+		if authHeaders := inMd.Get("authorization"); len(authHeaders) > 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authHeaders[0])
+		}
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// parseUserIDs parses a comma separated list of user IDs, skipping invalid entries
+func parseUserIDs(value string, log *logger.Logger) []uint64 {
+	var ids []uint64
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			log.Warn("Ignoring invalid user id", "value", part)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -12,26 +12,31 @@ import (
 
 // CommercialClient wraps gRPC client for Commercial Service (wallet operations)
 type CommercialClient struct {
-	walletClient pb.WalletServiceClient
-	conn         *grpc.ClientConn
+	walletClient        pb.WalletServiceClient
+	spendingLimitClient pb.SpendingLimitServiceClient
+	variableClient      pb.VariableServiceClient
+	conn                *grpc.ClientConn
 }
 
 // NewCommercialClient creates a new Commercial Service client
-func NewCommercialClient(address string) (*CommercialClient, error) {
+func NewCommercialClient(address string, opts ...grpc.DialOption) (*CommercialClient, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	conn, err := grpc.DialContext(ctx, address,
+	opts = append([]grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithBlock(),
-	)
+	}, opts...)
+	conn, err := grpc.DialContext(ctx, address, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to commercial service at %s: %w", address, err)
 	}
 
 	return &CommercialClient{
-		walletClient: pb.NewWalletServiceClient(conn),
-		conn:         conn,
+		walletClient:        pb.NewWalletServiceClient(conn),
+		spendingLimitClient: pb.NewSpendingLimitServiceClient(conn),
+		variableClient:      pb.NewVariableServiceClient(conn),
+		conn:                conn,
 	}, nil
 }
 
@@ -83,6 +88,22 @@ func (c *CommercialClient) IncrementSatisfaction(ctx context.Context, userID uin
 	return nil
 }
 
+// GetPSCRate returns the psc rate prize amounts are divided by before they
+// are credited to wallets
+func (c *CommercialClient) GetPSCRate(ctx context.Context) (float64, error) {
+	resp, err := c.variableClient.GetVariables(ctx, &pb.GetVariablesRequest{Keys: []string{"psc"}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get psc rate: %w", err)
+	}
+
+	rate, ok := resp.Values["psc"]
+	if !ok || rate <= 0 {
+		return 0, fmt.Errorf("psc rate is not set")
+	}
+
+	return rate, nil
+}
+
 // GetWallet retrieves user's wallet
 func (c *CommercialClient) GetWallet(ctx context.Context, userID uint64) (*pb.WalletResponse, error) {
 	req := &pb.GetWalletRequest{
@@ -97,3 +118,12 @@ func (c *CommercialClient) GetWallet(ctx context.Context, userID uint64) (*pb.Wa
 	return resp, nil
 }
 
+// GetSpendingLimits retrieves the spending limits of a user
+func (c *CommercialClient) GetSpendingLimits(ctx context.Context, userID uint64) (*pb.SpendingLimits, error) {
+	return c.spendingLimitClient.GetSpendingLimits(ctx, &pb.GetSpendingLimitsRequest{UserId: userID})
+}
+
+// SetSpendingLimits changes the spending limits of a user under 18
+func (c *CommercialClient) SetSpendingLimits(ctx context.Context, req *pb.SetSpendingLimitsRequest) (*pb.SpendingLimits, error) {
+	return c.spendingLimitClient.SetSpendingLimits(ctx, req)
+}
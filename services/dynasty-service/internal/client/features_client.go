@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	pb "metargb/shared/pb/features"
+	"metargb/shared/pkg/auth"
 )
 
 // FeaturesClient wraps gRPC client for Features Service
@@ -16,13 +17,15 @@ type FeaturesClient struct {
 	conn          *grpc.ClientConn
 }
 
-// NewFeaturesClient creates a new Features Service client
-func NewFeaturesClient(address string) (*FeaturesClient, error) {
+// NewFeaturesClient creates a new Features Service client. CountOwnedFeatures
+// needs serviceAPIKey to hold the service:feature-counts scope.
+func NewFeaturesClient(address, serviceAPIKey string) (*FeaturesClient, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	conn, err := grpc.DialContext(ctx, address,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		auth.WithServiceAPIKey(serviceAPIKey),
 		grpc.WithBlock(),
 	)
 	if err != nil {
@@ -86,3 +89,12 @@ func (c *FeaturesClient) ListMyFeatures(ctx context.Context, userID uint64, page
 	return resp.Data, nil
 }
 
+// CountOwnedFeatures counts the features of many owners, at most 1000 at once
+func (c *FeaturesClient) CountOwnedFeatures(ctx context.Context, ownerIDs []uint64) (map[uint64]int32, error) {
+	resp, err := c.featureClient.CountOwnedFeatures(ctx, &pb.CountOwnedFeaturesRequest{OwnerIds: ownerIDs})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count owned features: %w", err)
+	}
+
+	return resp.Counts, nil
+}
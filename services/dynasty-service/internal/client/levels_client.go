@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	pb "metargb/shared/pb/levels"
+)
+
+// LevelsClient wraps gRPC client for Levels Service
+type LevelsClient struct {
+	levelClient pb.LevelServiceClient
+	conn        *grpc.ClientConn
+}
+
+// NewLevelsClient creates a new Levels Service client
+func NewLevelsClient(address string) (*LevelsClient, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to levels service at %s: %w", address, err)
+	}
+
+	return &LevelsClient{
+		levelClient: pb.NewLevelServiceClient(conn),
+		conn:        conn,
+	}, nil
+}
+
+// Close closes the gRPC connection
+func (c *LevelsClient) Close() error {
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
+}
+
+// GetUserScores retrieves the scores of many users, at most 1000 at once
+func (c *LevelsClient) GetUserScores(ctx context.Context, userIDs []uint64) (map[uint64]int32, error) {
+	resp, err := c.levelClient.GetUserScores(ctx, &pb.GetUserScoresRequest{UserIds: userIDs})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user scores: %w", err)
+	}
+
+	return resp.Scores, nil
+}
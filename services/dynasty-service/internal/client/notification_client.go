@@ -53,6 +53,7 @@ func (c *NotificationClient) SendNotification(ctx context.Context, userID uint64
 		Data:       data,
 		SendSms:    sendSMS,
 		SendEmail:  sendEmail,
+		Category:   "dynasty",
 	}
 
 	resp, err := c.notificationClient.SendNotification(ctx, req)
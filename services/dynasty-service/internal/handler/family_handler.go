@@ -2,12 +2,14 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"metargb/dynasty-service/internal/service"
+	commercialpb "metargb/shared/pb/commercial"
 	commonpb "metargb/shared/pb/common"
 	dynastypb "metargb/shared/pb/dynasty"
 	"metargb/shared/pkg/helpers"
@@ -18,16 +20,19 @@ type FamilyHandler struct {
 	dynastypb.UnimplementedFamilyServiceServer
 	familyService     *service.FamilyService
 	permissionService *service.PermissionService
+	guardianService   *service.GuardianService
 }
 
 // NewFamilyHandler creates a new family handler
 func NewFamilyHandler(
 	familyService *service.FamilyService,
 	permissionService *service.PermissionService,
+	guardianService *service.GuardianService,
 ) *FamilyHandler {
 	return &FamilyHandler{
 		familyService:     familyService,
 		permissionService: permissionService,
+		guardianService:   guardianService,
 	}
 }
 
@@ -194,3 +199,75 @@ func (h *FamilyHandler) SetChildPermissions(ctx context.Context, req *dynastypb.
 	return &commonpb.Empty{}, nil
 }
 
+// GetChildSpendingLimits returns a child's spending limits to their guardian
+// Implements GET /api/dynasty/children/{user}/spending-limits
+func (h *FamilyHandler) GetChildSpendingLimits(ctx context.Context, req *dynastypb.GetChildSpendingLimitsRequest) (*dynastypb.ChildSpendingLimitsResponse, error) {
+	if h.guardianService == nil {
+		return nil, status.Errorf(codes.Internal, "guardian service not initialized")
+	}
+	if validationErrors := validateRequired("child_user_id", req.ChildUserId, "en"); len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	limits, err := h.guardianService.GetChildSpendingLimits(ctx, req.ParentUserId, req.ChildUserId)
+	if err != nil {
+		return nil, mapSpendingLimitError(err)
+	}
+	return buildChildSpendingLimits(limits), nil
+}
+
+// SetChildSpendingLimits lets a guardian change a child's spending limits
+// Implements PUT /api/dynasty/children/{user}/spending-limits
+func (h *FamilyHandler) SetChildSpendingLimits(ctx context.Context, req *dynastypb.SetChildSpendingLimitsRequest) (*dynastypb.ChildSpendingLimitsResponse, error) {
+	if h.guardianService == nil {
+		return nil, status.Errorf(codes.Internal, "guardian service not initialized")
+	}
+	if validationErrors := validateRequired("child_user_id", req.ChildUserId, "en"); len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	limits, err := h.guardianService.SetChildSpendingLimits(ctx, req.ParentUserId, req.ChildUserId, &commercialpb.SetSpendingLimitsRequest{
+		DailyPsc:   req.DailyPsc,
+		MonthlyPsc: req.MonthlyPsc,
+		DailyIrr:   req.DailyIrr,
+		MonthlyIrr: req.MonthlyIrr,
+	})
+	if err != nil {
+		return nil, mapSpendingLimitError(err)
+	}
+	return buildChildSpendingLimits(limits), nil
+}
+
+// mapSpendingLimitError keeps the status of errors from commercial-service,
+// which validates the limits
+func mapSpendingLimitError(err error) error {
+	if errors.Is(err, service.ErrSpendingLimitsUnavailable) {
+		return status.Errorf(codes.Unavailable, "%s", err.Error())
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return mapServiceError(err)
+}
+
+func buildChildSpendingLimits(limits *commercialpb.SpendingLimits) *dynastypb.ChildSpendingLimitsResponse {
+	asset := func(a *commercialpb.AssetSpendingLimit) *dynastypb.SpendingLimit {
+		if a == nil {
+			return nil
+		}
+		return &dynastypb.SpendingLimit{
+			Daily:          a.Daily,
+			Monthly:        a.Monthly,
+			SpentToday:     a.SpentToday,
+			SpentThisMonth: a.SpentThisMonth,
+			MaxDaily:       a.MaxDaily,
+			MaxMonthly:     a.MaxMonthly,
+		}
+	}
+	return &dynastypb.ChildSpendingLimitsResponse{
+		ChildUserId: limits.UserId,
+		Psc:         asset(limits.Psc),
+		Irr:         asset(limits.Irr),
+		UpdatedBy:   limits.UpdatedBy,
+	}
+}
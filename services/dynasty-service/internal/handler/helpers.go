@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"metargb/dynasty-service/internal/models"
+	"metargb/dynasty-service/internal/validation"
 	commonpb "metargb/shared/pb/common"
 	dynastypb "metargb/shared/pb/dynasty"
 	"metargb/shared/pkg/helpers"
@@ -109,6 +111,19 @@ func buildDynastyPrize(prize *models.DynastyPrize) *dynastypb.DynastyPrize {
 	}
 }
 
+// buildReceivedPrize builds a user's awarded prize, with the received prize
+// ID to claim it by and its escrow state
+func buildReceivedPrize(received *models.ReceivedPrize) *dynastypb.DynastyPrize {
+	prize := buildDynastyPrize(received.Prize)
+	prize.ReceivedPrizeId = received.ID
+	prize.Status = received.Status
+	prize.Message = received.Message
+	if received.ExpiresAt != nil {
+		prize.ExpiresAt = formatJalaliDateTime(*received.ExpiresAt)
+	}
+	return prize
+}
+
 func buildUserBasic(user *models.UserBasic) *commonpb.UserBasic {
 	if user == nil {
 		return nil
@@ -150,6 +165,15 @@ func mapServiceError(err error) error {
 		return nil
 	}
 
+	// Validation errors carry a user facing Farsi message
+	var validationErr *validation.ValidationError
+	if errors.As(err, &validationErr) {
+		if validationErr.Code == 400 {
+			return status.Errorf(codes.InvalidArgument, "%s", validationErr.Message)
+		}
+		return status.Errorf(codes.PermissionDenied, "%s", validationErr.Message)
+	}
+
 	errStr := err.Error()
 
 	// Map common errors to gRPC status codes
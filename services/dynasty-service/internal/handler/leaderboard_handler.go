@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"metargb/dynasty-service/internal/models"
+	"metargb/dynasty-service/internal/service"
+	commonpb "metargb/shared/pb/common"
+	dynastypb "metargb/shared/pb/dynasty"
+)
+
+// LeaderboardHandler handles DynastyLeaderboardService gRPC methods
+type LeaderboardHandler struct {
+	dynastypb.UnimplementedDynastyLeaderboardServiceServer
+	statsService *service.DynastyStatsService
+}
+
+// NewLeaderboardHandler creates a new leaderboard handler
+func NewLeaderboardHandler(statsService *service.DynastyStatsService) *LeaderboardHandler {
+	return &LeaderboardHandler{
+		statsService: statsService,
+	}
+}
+
+// GetDynastyLeaderboard returns a page of dynasties ranked by member score, land count or prizes
+func (h *LeaderboardHandler) GetDynastyLeaderboard(ctx context.Context, req *dynastypb.GetDynastyLeaderboardRequest) (*dynastypb.DynastyLeaderboardResponse, error) {
+	var page, perPage int
+	if req.Pagination != nil {
+		page = int(req.Pagination.Page)
+		perPage = int(req.Pagination.PerPage)
+	}
+
+	leaderboard, err := h.statsService.GetLeaderboard(ctx, req.SortBy, page, perPage)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	data := make([]*dynastypb.DynastyStats, 0, len(leaderboard.Stats))
+	offset := (leaderboard.Page - 1) * leaderboard.PerPage
+	for i, stats := range leaderboard.Stats {
+		entry := buildDynastyStats(stats)
+		entry.Rank = int32(offset + i + 1)
+		data = append(data, entry)
+	}
+
+	lastPage := (leaderboard.Total + leaderboard.PerPage - 1) / leaderboard.PerPage
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	return &dynastypb.DynastyLeaderboardResponse{
+		Data: data,
+		Pagination: &commonpb.PaginationMeta{
+			CurrentPage: int32(leaderboard.Page),
+			PerPage:     int32(leaderboard.PerPage),
+			Total:       int32(leaderboard.Total),
+			LastPage:    int32(lastPage),
+		},
+	}, nil
+}
+
+// GetDynastyStats returns the stats of a dynasty and its rank in every leaderboard
+func (h *LeaderboardHandler) GetDynastyStats(ctx context.Context, req *dynastypb.GetDynastyStatsRequest) (*dynastypb.DynastyStatsResponse, error) {
+	if req.DynastyId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "dynasty_id is required")
+	}
+
+	stats, ranks, err := h.statsService.GetDynastyStats(ctx, req.DynastyId)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &dynastypb.DynastyStatsResponse{
+		Stats:     buildDynastyStats(stats),
+		ScoreRank: int32(ranks.Score),
+		LandRank:  int32(ranks.Lands),
+		PrizeRank: int32(ranks.Prizes),
+	}, nil
+}
+
+func buildDynastyStats(stats *models.DynastyStats) *dynastypb.DynastyStats {
+	return &dynastypb.DynastyStats{
+		DynastyId:   stats.DynastyID,
+		Owner:       buildUserBasic(stats.Owner),
+		MemberCount: int32(stats.MemberCount),
+		MemberScore: stats.MemberScore,
+		LandCount:   int32(stats.LandCount),
+		PrizePsc:    stats.PrizePSC,
+		ComputedAt:  formatJalaliDateTime(stats.ComputedAt),
+	}
+}
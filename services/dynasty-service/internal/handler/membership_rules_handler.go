@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"metargb/dynasty-service/internal/models"
+	"metargb/dynasty-service/internal/service"
+	dynastypb "metargb/shared/pb/dynasty"
+)
+
+// MembershipRulesHandler handles MembershipRulesService gRPC methods
+type MembershipRulesHandler struct {
+	dynastypb.UnimplementedMembershipRulesServiceServer
+	membershipRulesService *service.MembershipRulesService
+}
+
+// NewMembershipRulesHandler creates a new membership rules handler
+func NewMembershipRulesHandler(membershipRulesService *service.MembershipRulesService) *MembershipRulesHandler {
+	return &MembershipRulesHandler{
+		membershipRulesService: membershipRulesService,
+	}
+}
+
+// GetMembershipRules returns the membership rules in effect
+func (h *MembershipRulesHandler) GetMembershipRules(ctx context.Context, req *dynastypb.GetMembershipRulesRequest) (*dynastypb.MembershipRulesResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	rules, err := h.membershipRulesService.GetMembershipRules(ctx, req.UserId)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return buildMembershipRulesResponse(rules), nil
+}
+
+// UpdateMembershipRules replaces the membership rules
+func (h *MembershipRulesHandler) UpdateMembershipRules(ctx context.Context, req *dynastypb.UpdateMembershipRulesRequest) (*dynastypb.MembershipRulesResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.Rules == nil {
+		return nil, status.Error(codes.InvalidArgument, "rules are required")
+	}
+
+	rules, err := h.membershipRulesService.UpdateMembershipRules(ctx, req.UserId, &models.MembershipRules{
+		MaxFamilySize:           req.Rules.MaxFamilySize,
+		RelationshipLimits:      req.Rules.RelationshipLimits,
+		MinorRelationships:      req.Rules.MinorRelationships,
+		UnverifiedRelationships: req.Rules.UnverifiedRelationships,
+		LeaveCooldownHours:      req.Rules.LeaveCooldownHours,
+	})
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return buildMembershipRulesResponse(rules), nil
+}
+
+func buildMembershipRulesResponse(rules *models.MembershipRules) *dynastypb.MembershipRulesResponse {
+	resp := &dynastypb.MembershipRulesResponse{
+		Rules: &dynastypb.MembershipRules{
+			MaxFamilySize:           rules.MaxFamilySize,
+			RelationshipLimits:      rules.RelationshipLimits,
+			MinorRelationships:      rules.MinorRelationships,
+			UnverifiedRelationships: rules.UnverifiedRelationships,
+			LeaveCooldownHours:      rules.LeaveCooldownHours,
+		},
+		UpdatedBy: rules.UpdatedBy,
+	}
+	if rules.UpdatedAt != nil {
+		resp.UpdatedAt = formatJalaliDateTime(*rules.UpdatedAt)
+	}
+	return resp
+}
@@ -2,6 +2,8 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -51,7 +53,7 @@ func (h *PrizeHandler) GetPrizes(ctx context.Context, req *dynastypb.GetPrizesRe
 	var protoPrizes []*dynastypb.DynastyPrize
 	for _, prize := range prizes {
 		if prize.Prize != nil {
-			protoPrizes = append(protoPrizes, buildDynastyPrize(prize.Prize))
+			protoPrizes = append(protoPrizes, buildReceivedPrize(prize))
 		}
 	}
 
@@ -82,7 +84,7 @@ func (h *PrizeHandler) GetPrize(ctx context.Context, req *dynastypb.GetPrizeRequ
 	}
 
 	return &dynastypb.PrizeResponse{
-		Prize: buildDynastyPrize(receivedPrize.Prize),
+		Prize: buildReceivedPrize(receivedPrize),
 	}, nil
 }
 
@@ -94,9 +96,45 @@ func (h *PrizeHandler) ClaimPrize(ctx context.Context, req *dynastypb.ClaimPrize
 
 	err := h.prizeService.ClaimPrize(ctx, req.PrizeId, req.UserId)
 	if err != nil {
-		return nil, mapServiceError(err)
+		return nil, mapPrizeError(err)
 	}
 
 	return &commonpb.Empty{}, nil
 }
 
+// ClaimAllPrizes redeems every unexpired prize of a user
+func (h *PrizeHandler) ClaimAllPrizes(ctx context.Context, req *dynastypb.ClaimAllPrizesRequest) (*dynastypb.ClaimAllPrizesResponse, error) {
+	if h.prizeService == nil {
+		return nil, status.Errorf(codes.Internal, "prize service not initialized")
+	}
+
+	claimed, err := h.prizeService.ClaimAllPrizes(ctx, req.UserId)
+	if err != nil {
+		return nil, mapPrizeError(err)
+	}
+
+	resp := &dynastypb.ClaimAllPrizesResponse{Claimed: []*dynastypb.DynastyPrize{}}
+	var satisfaction float64
+	for _, prize := range claimed {
+		resp.Psc += prize.PSC
+		satisfaction += prize.Satisfaction
+		if prize.Prize != nil {
+			resp.Claimed = append(resp.Claimed, buildReceivedPrize(prize))
+		}
+	}
+	resp.Satisfaction = fmt.Sprintf("%.2f", satisfaction)
+
+	return resp, nil
+}
+
+// mapPrizeError maps the escrow errors of prize claims to gRPC status codes
+func mapPrizeError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrPrizeExpired), errors.Is(err, service.ErrPrizeAlreadyClaimed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrPrizeWalletUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return mapServiceError(err)
+}
+
@@ -0,0 +1,83 @@
+package models
+
+import "time"
+
+// Relationships lists the relationships a user can join a family as
+var Relationships = []string{"father", "mother", "husband", "wife", "offspring", "brother", "sister"}
+
+// relationshipTitles are the Farsi names used in membership rule errors
+var relationshipTitles = map[string]string{
+	"father":    "پدر",
+	"mother":    "مادر",
+	"husband":   "همسر",
+	"wife":      "همسر",
+	"offspring": "فرزند",
+	"brother":   "برادر",
+	"sister":    "خواهر",
+}
+
+// GetRelationshipTitle returns the Farsi name of a relationship
+func GetRelationshipTitle(relationship string) string {
+	if title, ok := relationshipTitles[relationship]; ok {
+		return title
+	}
+	return relationship
+}
+
+// IsValidRelationship reports whether relationship is one of Relationships
+func IsValidRelationship(relationship string) bool {
+	_, ok := relationshipTitles[relationship]
+	return ok
+}
+
+// MembershipRules are the admin managed rules join requests are checked against
+type MembershipRules struct {
+	MaxFamilySize           int32            `db:"max_family_size"`     // 0 means no limit
+	RelationshipLimits      map[string]int32 `db:"relationship_limits"` // missing relationships have no limit
+	MinorRelationships      []string         `db:"minor_relationships"`
+	UnverifiedRelationships []string         `db:"unverified_relationships"`
+	LeaveCooldownHours      int32            `db:"leave_cooldown_hours"`
+	UpdatedBy               uint64           `db:"updated_by"`
+	UpdatedAt               *time.Time       `db:"updated_at"` // nil while the defaults apply
+}
+
+// DefaultMembershipRules returns the rules used until an admin saves their own.
+// The limits match the ones the join request flow always enforced.
+func DefaultMembershipRules() *MembershipRules {
+	return &MembershipRules{
+		RelationshipLimits: map[string]int32{
+			"father":    1,
+			"mother":    1,
+			"husband":   1,
+			"wife":      4,
+			"offspring": 4,
+		},
+		MinorRelationships:      []string{"offspring", "brother", "sister"},
+		UnverifiedRelationships: append([]string(nil), Relationships...),
+		LeaveCooldownHours:      7 * 24,
+	}
+}
+
+// LeaveCooldown is how long a user must wait after leaving a family before joining another
+func (r *MembershipRules) LeaveCooldown() time.Duration {
+	return time.Duration(r.LeaveCooldownHours) * time.Hour
+}
+
+// AllowsMinor reports whether an under-18 user may join as relationship
+func (r *MembershipRules) AllowsMinor(relationship string) bool {
+	return containsRelationship(r.MinorRelationships, relationship)
+}
+
+// AllowsUnverified reports whether a user without approved KYC may join as relationship
+func (r *MembershipRules) AllowsUnverified(relationship string) bool {
+	return containsRelationship(r.UnverifiedRelationships, relationship)
+}
+
+func containsRelationship(relationships []string, relationship string) bool {
+	for _, r := range relationships {
+		if r == relationship {
+			return true
+		}
+	}
+	return false
+}
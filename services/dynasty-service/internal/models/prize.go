@@ -2,13 +2,39 @@ package models
 
 import "time"
 
-// ReceivedPrize represents a prize awarded to a user
+// Received prize statuses. Prizes are held in escrow while pending and
+// settle once claimed or expired.
+const (
+	PrizeStatusPending = "pending"
+	PrizeStatusClaimed = "claimed"
+	PrizeStatusExpired = "expired"
+)
+
+// Prize pool assets, matching the wallet assets prizes are paid in
+const (
+	PrizePoolAssetPSC          = "psc"
+	PrizePoolAssetSatisfaction = "satisfaction"
+)
+
+// ReceivedPrize represents a prize awarded to a user. PSC and Satisfaction
+// are the amounts held in escrow, copied from the prize when it was awarded.
 type ReceivedPrize struct {
-	ID        uint64        `db:"id"`
-	UserID    uint64        `db:"user_id"`
-	PrizeID   uint64        `db:"prize_id"`
-	Message   string        `db:"message"`
-	CreatedAt time.Time     `db:"created_at"`
-	UpdatedAt time.Time     `db:"updated_at"`
-	Prize     *DynastyPrize `db:"-"` // Not from DB, joined
+	ID           uint64        `db:"id"`
+	UserID       uint64        `db:"user_id"`
+	PrizeID      uint64        `db:"prize_id"`
+	Message      string        `db:"message"`
+	Status       string        `db:"status"`
+	PSC          int64         `db:"psc"`
+	Satisfaction float64       `db:"satisfaction"`
+	ExpiresAt    *time.Time    `db:"expires_at"` // nil when awarded without a claim window, never expires
+	RemindedAt   *time.Time    `db:"reminded_at"`
+	SettledAt    *time.Time    `db:"settled_at"`
+	CreatedAt    time.Time     `db:"created_at"`
+	UpdatedAt    time.Time     `db:"updated_at"`
+	Prize        *DynastyPrize `db:"-"` // Not from DB, joined
+}
+
+// Claimable reports whether the prize can still be claimed at now
+func (p *ReceivedPrize) Claimable(now time.Time) bool {
+	return p.Status == PrizeStatusPending && (p.ExpiresAt == nil || now.Before(*p.ExpiresAt))
 }
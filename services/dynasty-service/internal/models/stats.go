@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+// Dynasty rankings, named after the column they sort dynasty_stats by
+const (
+	LeaderboardByScore  = "score"
+	LeaderboardByLands  = "lands"
+	LeaderboardByPrizes = "prizes"
+)
+
+// DynastyMembers lists the users of a dynasty, its owner included
+type DynastyMembers struct {
+	DynastyID uint64
+	OwnerID   uint64
+	MemberIDs []uint64
+}
+
+// DynastyStats represents dynasty_stats table, refreshed by the stats job
+type DynastyStats struct {
+	DynastyID   uint64
+	OwnerID     uint64
+	MemberCount int
+	MemberScore int64
+	LandCount   int
+	PrizePSC    int64
+	ComputedAt  time.Time
+	Owner       *UserBasic // Joined from users when listed
+}
+
+// DynastyRanks holds a dynasty's position in every ranking
+type DynastyRanks struct {
+	Score  int
+	Lands  int
+	Prizes int
+}
+
+// DynastyLeaderboard is one page of a ranking
+type DynastyLeaderboard struct {
+	SortBy  string
+	Stats   []*DynastyStats
+	Total   int
+	Page    int
+	PerPage int
+}
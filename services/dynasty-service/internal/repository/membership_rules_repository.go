@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"metargb/dynasty-service/internal/models"
+)
+
+// membershipRulesID is the id of the single dynasty_membership_rules row
+const membershipRulesID = 1
+
+type MembershipRulesRepository struct {
+	db *sql.DB
+}
+
+func NewMembershipRulesRepository(db *sql.DB) *MembershipRulesRepository {
+	return &MembershipRulesRepository{db: db}
+}
+
+// GetMembershipRules returns the saved rules, or nil if an admin never saved any
+func (r *MembershipRulesRepository) GetMembershipRules(ctx context.Context) (*models.MembershipRules, error) {
+	query := `SELECT max_family_size, relationship_limits, minor_relationships, unverified_relationships,
+	          leave_cooldown_hours, updated_by, updated_at
+	          FROM dynasty_membership_rules WHERE id = ?`
+
+	var rules models.MembershipRules
+	var limits []byte
+	var minor, unverified string
+	var updatedBy sql.NullInt64
+	var updatedAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query, membershipRulesID).Scan(
+		&rules.MaxFamilySize,
+		&limits,
+		&minor,
+		&unverified,
+		&rules.LeaveCooldownHours,
+		&updatedBy,
+		&updatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get membership rules: %w", err)
+	}
+
+	if err := json.Unmarshal(limits, &rules.RelationshipLimits); err != nil {
+		return nil, fmt.Errorf("failed to decode relationship limits: %w", err)
+	}
+	rules.MinorRelationships = splitRelationships(minor)
+	rules.UnverifiedRelationships = splitRelationships(unverified)
+	rules.UpdatedBy = uint64(updatedBy.Int64)
+	if updatedAt.Valid {
+		rules.UpdatedAt = &updatedAt.Time
+	}
+
+	return &rules, nil
+}
+
+// SaveMembershipRules replaces the saved rules
+func (r *MembershipRulesRepository) SaveMembershipRules(ctx context.Context, rules *models.MembershipRules) error {
+	limits, err := json.Marshal(rules.RelationshipLimits)
+	if err != nil {
+		return fmt.Errorf("failed to encode relationship limits: %w", err)
+	}
+
+	query := `INSERT INTO dynasty_membership_rules
+	          (id, max_family_size, relationship_limits, minor_relationships, unverified_relationships,
+	           leave_cooldown_hours, updated_by, created_at, updated_at)
+	          VALUES (?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
+	          ON DUPLICATE KEY UPDATE
+	          max_family_size = VALUES(max_family_size),
+	          relationship_limits = VALUES(relationship_limits),
+	          minor_relationships = VALUES(minor_relationships),
+	          unverified_relationships = VALUES(unverified_relationships),
+	          leave_cooldown_hours = VALUES(leave_cooldown_hours),
+	          updated_by = VALUES(updated_by),
+	          updated_at = NOW()`
+
+	_, err = r.db.ExecContext(ctx, query,
+		membershipRulesID,
+		rules.MaxFamilySize,
+		string(limits),
+		strings.Join(rules.MinorRelationships, ","),
+		strings.Join(rules.UnverifiedRelationships, ","),
+		rules.LeaveCooldownHours,
+		rules.UpdatedBy,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save membership rules: %w", err)
+	}
+
+	return nil
+}
+
+// CountFamilyMembers counts a family's members, in total and per relationship.
+// The owner is counted in the total.
+func (r *MembershipRulesRepository) CountFamilyMembers(ctx context.Context, familyID uint64) (int, map[string]int, error) {
+	query := `SELECT relationship, COUNT(*) FROM family_members
+	          WHERE family_id = ? GROUP BY relationship`
+
+	rows, err := r.db.QueryContext(ctx, query, familyID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to count family members: %w", err)
+	}
+	defer rows.Close()
+
+	total := 0
+	byRelationship := make(map[string]int)
+	for rows.Next() {
+		var relationship string
+		var count int
+		if err := rows.Scan(&relationship, &count); err != nil {
+			return 0, nil, fmt.Errorf("failed to scan family member count: %w", err)
+		}
+		byRelationship[relationship] = count
+		total += count
+	}
+	if err := rows.Err(); err != nil {
+		return 0, nil, fmt.Errorf("failed to count family members: %w", err)
+	}
+
+	return total, byRelationship, nil
+}
+
+// GetUserEligibility reports whether a user's latest KYC is approved and
+// whether it says they are under 18. Users without KYC are neither.
+func (r *MembershipRulesRepository) GetUserEligibility(ctx context.Context, userID uint64) (verified, under18 bool, err error) {
+	query := `SELECT status = 1, TIMESTAMPDIFF(YEAR, birthdate, CURDATE()) < 18
+	          FROM kycs WHERE user_id = ?
+	          ORDER BY id DESC LIMIT 1`
+
+	err = r.db.QueryRowContext(ctx, query, userID).Scan(&verified, &under18)
+	if err == sql.ErrNoRows {
+		return false, false, nil
+	}
+	if err != nil {
+		return false, false, fmt.Errorf("failed to check user eligibility: %w", err)
+	}
+
+	return verified, under18, nil
+}
+
+// GetLastDepartureAt returns when the user last left a family, or nil if they never did
+func (r *MembershipRulesRepository) GetLastDepartureAt(ctx context.Context, userID uint64) (*time.Time, error) {
+	query := `SELECT MAX(left_at) FROM family_member_departures WHERE user_id = ?`
+
+	var leftAt sql.NullTime
+	if err := r.db.QueryRowContext(ctx, query, userID).Scan(&leftAt); err != nil {
+		return nil, fmt.Errorf("failed to get last departure: %w", err)
+	}
+	if !leftAt.Valid {
+		return nil, nil
+	}
+
+	return &leftAt.Time, nil
+}
+
+func splitRelationships(value string) []string {
+	var relationships []string
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			relationships = append(relationships, part)
+		}
+	}
+	return relationships
+}
@@ -4,18 +4,28 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
 	"metargb/dynasty-service/internal/models"
 )
 
 type PrizeRepository struct {
 	db *sql.DB
+	// claimWindow is how long awarded prizes can be claimed; zero awards
+	// prizes that never expire
+	claimWindow time.Duration
 }
 
 func NewPrizeRepository(db *sql.DB) *PrizeRepository {
 	return &PrizeRepository{db: db}
 }
 
+// SetClaimWindow sets how long prizes awarded from now on can be claimed
+func (r *PrizeRepository) SetClaimWindow(window time.Duration) {
+	r.claimWindow = window
+}
+
 // GetPrizeByRelationship retrieves dynasty prize by relationship type
 func (r *PrizeRepository) GetPrizeByRelationship(ctx context.Context, relationship string) (*models.DynastyPrize, error) {
 	query := `
@@ -49,50 +59,100 @@ func (r *PrizeRepository) GetPrizeByRelationship(ctx context.Context, relationsh
 	return &prize, nil
 }
 
-// AwardPrize creates a received prize record for a user
-func (r *PrizeRepository) AwardPrize(ctx context.Context, userID, prizeID uint64, message string) error {
+// AwardPrize holds the prize in escrow for the user: it records a pending
+// received prize with the prize's amounts and moves them from the pool
+// balance to escrow
+func (r *PrizeRepository) AwardPrize(ctx context.Context, userID uint64, prize *models.DynastyPrize, message string) error {
+	now := time.Now()
+	var expiresAt *time.Time
+	if r.claimWindow > 0 {
+		t := now.Add(r.claimWindow)
+		expiresAt = &t
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
 	query := `
-		INSERT INTO received_prizes (user_id, prize_id, message, created_at, updated_at)
-		VALUES (?, ?, ?, NOW(), NOW())
+		INSERT INTO received_prizes (user_id, prize_id, message, status, psc, satisfaction, expires_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
-	_, err := r.db.ExecContext(ctx, query, userID, prizeID, message)
-	if err != nil {
+	if _, err := tx.ExecContext(ctx, query,
+		userID, prize.ID, message, models.PrizeStatusPending, prize.PSC, prize.Satisfaction, expiresAt, now, now,
+	); err != nil {
 		return fmt.Errorf("failed to award prize: %w", err)
 	}
 
-	return nil
+	if err := adjustPrizePool(ctx, tx, float64(prize.PSC), prize.Satisfaction, -1, 1); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
-// GetReceivedPrize retrieves a received prize by ID
-func (r *PrizeRepository) GetReceivedPrize(ctx context.Context, receivedPrizeID uint64) (*models.ReceivedPrize, error) {
-	query := `
-		SELECT rp.id, rp.user_id, rp.prize_id, rp.message, rp.created_at, rp.updated_at,
-		       dp.member, dp.satisfaction, dp.introduction_profit_increase,
+const receivedPrizeColumns = `
+		SELECT rp.id, rp.user_id, rp.prize_id, rp.message, rp.status, rp.psc, rp.satisfaction,
+		       rp.expires_at, rp.reminded_at, rp.settled_at, rp.created_at, rp.updated_at,
+		       dp.id, dp.member, dp.satisfaction, dp.introduction_profit_increase,
 		       dp.accumulated_capital_reserve, dp.data_storage, dp.psc
 		FROM received_prizes rp
 		INNER JOIN dynasty_prizes dp ON dp.id = rp.prize_id
-		WHERE rp.id = ?
-	`
+`
 
+func scanReceivedPrize(row interface{ Scan(...interface{}) error }) (*models.ReceivedPrize, error) {
 	var received models.ReceivedPrize
 	var prize models.DynastyPrize
+	var expiresAt, remindedAt, settledAt sql.NullTime
 
-	err := r.db.QueryRowContext(ctx, query, receivedPrizeID).Scan(
+	if err := row.Scan(
 		&received.ID,
 		&received.UserID,
 		&received.PrizeID,
 		&received.Message,
+		&received.Status,
+		&received.PSC,
+		&received.Satisfaction,
+		&expiresAt,
+		&remindedAt,
+		&settledAt,
 		&received.CreatedAt,
 		&received.UpdatedAt,
+		&prize.ID,
 		&prize.Member,
 		&prize.Satisfaction,
 		&prize.IntroductionProfitIncrease,
 		&prize.AccumulatedCapitalReserve,
 		&prize.DataStorage,
 		&prize.PSC,
-	)
+	); err != nil {
+		return nil, err
+	}
 
+	received.ExpiresAt = nullTimePtr(expiresAt)
+	received.RemindedAt = nullTimePtr(remindedAt)
+	received.SettledAt = nullTimePtr(settledAt)
+	received.Prize = &prize
+	return &received, nil
+}
+
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// GetReceivedPrize retrieves a received prize by ID
+func (r *PrizeRepository) GetReceivedPrize(ctx context.Context, receivedPrizeID uint64) (*models.ReceivedPrize, error) {
+	query := receivedPrizeColumns + `
+		WHERE rp.id = ?
+	`
+
+	received, err := scanReceivedPrize(r.db.QueryRowContext(ctx, query, receivedPrizeID))
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -100,23 +160,18 @@ func (r *PrizeRepository) GetReceivedPrize(ctx context.Context, receivedPrizeID
 		return nil, fmt.Errorf("failed to get received prize: %w", err)
 	}
 
-	received.Prize = &prize
-	return &received, nil
+	return received, nil
 }
 
-// GetUserReceivedPrizes retrieves all received prizes for a user
+// GetUserReceivedPrizes retrieves the prizes a user has yet to claim,
+// including expired ones the escrow job has not settled yet
 func (r *PrizeRepository) GetUserReceivedPrizes(ctx context.Context, userID uint64) ([]*models.ReceivedPrize, error) {
-	query := `
-		SELECT rp.id, rp.user_id, rp.prize_id, rp.message, rp.created_at, rp.updated_at,
-		       dp.id, dp.member, dp.satisfaction, dp.introduction_profit_increase,
-		       dp.accumulated_capital_reserve, dp.data_storage, dp.psc
-		FROM received_prizes rp
-		INNER JOIN dynasty_prizes dp ON dp.id = rp.prize_id
-		WHERE rp.user_id = ?
+	query := receivedPrizeColumns + `
+		WHERE rp.user_id = ? AND rp.status = ?
 		ORDER BY rp.created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, userID)
+	rows, err := r.db.QueryContext(ctx, query, userID, models.PrizeStatusPending)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user prizes: %w", err)
 	}
@@ -124,44 +179,14 @@ func (r *PrizeRepository) GetUserReceivedPrizes(ctx context.Context, userID uint
 
 	var prizes []*models.ReceivedPrize
 	for rows.Next() {
-		var received models.ReceivedPrize
-		var prize models.DynastyPrize
-
-		if err := rows.Scan(
-			&received.ID,
-			&received.UserID,
-			&received.PrizeID,
-			&received.Message,
-			&received.CreatedAt,
-			&received.UpdatedAt,
-			&prize.ID,
-			&prize.Member,
-			&prize.Satisfaction,
-			&prize.IntroductionProfitIncrease,
-			&prize.AccumulatedCapitalReserve,
-			&prize.DataStorage,
-			&prize.PSC,
-		); err != nil {
+		received, err := scanReceivedPrize(rows)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan prize: %w", err)
 		}
-
-		received.Prize = &prize
-		prizes = append(prizes, &received)
+		prizes = append(prizes, received)
 	}
 
-	return prizes, nil
-}
-
-// DeleteReceivedPrize deletes a claimed prize
-func (r *PrizeRepository) DeleteReceivedPrize(ctx context.Context, receivedPrizeID uint64) error {
-	query := `DELETE FROM received_prizes WHERE id = ?`
-
-	_, err := r.db.ExecContext(ctx, query, receivedPrizeID)
-	if err != nil {
-		return fmt.Errorf("failed to delete received prize: %w", err)
-	}
-
-	return nil
+	return prizes, rows.Err()
 }
 
 // GetAllDynastyPrizes retrieves all dynasty prizes (for introduction/display)
@@ -310,3 +335,214 @@ func (r *PrizeRepository) ClaimPrize(ctx context.Context, userID, prizeID uint64
 
 	return nil
 }
+
+// ClaimReceivedPrize marks the user's pending prize claimed and releases its
+// amounts from escrow. It returns false when the prize is not the user's,
+// was already settled or expired at now.
+func (r *PrizeRepository) ClaimReceivedPrize(ctx context.Context, receivedPrizeID, userID uint64, now time.Time) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var psc int64
+	var satisfaction float64
+	err = tx.QueryRowContext(ctx, `
+		SELECT psc, satisfaction
+		FROM received_prizes
+		WHERE id = ? AND user_id = ? AND status = ? AND (expires_at IS NULL OR expires_at > ?)
+		FOR UPDATE
+	`, receivedPrizeID, userID, models.PrizeStatusPending, now).Scan(&psc, &satisfaction)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to lock received prize: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		"UPDATE received_prizes SET status = ?, settled_at = ?, updated_at = ? WHERE id = ?",
+		models.PrizeStatusClaimed, now, now, receivedPrizeID,
+	); err != nil {
+		return false, fmt.Errorf("failed to claim received prize: %w", err)
+	}
+
+	if err := adjustPrizePool(ctx, tx, float64(psc), satisfaction, 0, -1); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit prize claim: %w", err)
+	}
+	return true, nil
+}
+
+// ReopenReceivedPrize returns a claimed prize to escrow, for claims whose
+// wallet credit failed
+func (r *PrizeRepository) ReopenReceivedPrize(ctx context.Context, receivedPrizeID uint64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var psc int64
+	var satisfaction float64
+	err = tx.QueryRowContext(ctx,
+		"SELECT psc, satisfaction FROM received_prizes WHERE id = ? AND status = ? FOR UPDATE",
+		receivedPrizeID, models.PrizeStatusClaimed,
+	).Scan(&psc, &satisfaction)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock received prize: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		"UPDATE received_prizes SET status = ?, settled_at = NULL, updated_at = NOW() WHERE id = ?",
+		models.PrizeStatusPending, receivedPrizeID,
+	); err != nil {
+		return fmt.Errorf("failed to reopen received prize: %w", err)
+	}
+
+	if err := adjustPrizePool(ctx, tx, float64(psc), satisfaction, 0, 1); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ExpirePrizes settles up to limit pending prizes whose claim window ended
+// by now, returning their amounts from escrow to the pool balance, and
+// returns the expired prizes
+func (r *PrizeRepository) ExpirePrizes(ctx context.Context, now time.Time, limit int) ([]*models.ReceivedPrize, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, receivedPrizeColumns+`
+		WHERE rp.status = ? AND rp.expires_at <= ?
+		ORDER BY rp.expires_at
+		LIMIT ?
+		FOR UPDATE
+	`, models.PrizeStatusPending, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expired prizes: %w", err)
+	}
+
+	var expired []*models.ReceivedPrize
+	var psc, satisfaction float64
+	for rows.Next() {
+		received, err := scanReceivedPrize(rows)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan prize: %w", err)
+		}
+		expired = append(expired, received)
+		psc += float64(received.PSC)
+		satisfaction += received.Satisfaction
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate expired prizes: %w", err)
+	}
+	if len(expired) == 0 {
+		return nil, nil
+	}
+
+	placeholders := make([]string, len(expired))
+	args := []interface{}{models.PrizeStatusExpired, now, now}
+	for i, received := range expired {
+		placeholders[i] = "?"
+		args = append(args, received.ID)
+		received.Status = models.PrizeStatusExpired
+		received.SettledAt = &now
+	}
+	if _, err := tx.ExecContext(ctx,
+		"UPDATE received_prizes SET status = ?, settled_at = ?, updated_at = ? WHERE id IN ("+strings.Join(placeholders, ", ")+")",
+		args...,
+	); err != nil {
+		return nil, fmt.Errorf("failed to expire prizes: %w", err)
+	}
+
+	if err := adjustPrizePool(ctx, tx, psc, satisfaction, 1, -1); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit prize expiry: %w", err)
+	}
+	return expired, nil
+}
+
+// GetPrizesToRemind returns up to limit pending prizes that expire after now
+// but by remindBy and whose owners were not reminded yet
+func (r *PrizeRepository) GetPrizesToRemind(ctx context.Context, now, remindBy time.Time, limit int) ([]*models.ReceivedPrize, error) {
+	rows, err := r.db.QueryContext(ctx, receivedPrizeColumns+`
+		WHERE rp.status = ? AND rp.reminded_at IS NULL AND rp.expires_at > ? AND rp.expires_at <= ?
+		ORDER BY rp.expires_at
+		LIMIT ?
+	`, models.PrizeStatusPending, now, remindBy, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prizes to remind: %w", err)
+	}
+	defer rows.Close()
+
+	var prizes []*models.ReceivedPrize
+	for rows.Next() {
+		received, err := scanReceivedPrize(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan prize: %w", err)
+		}
+		prizes = append(prizes, received)
+	}
+
+	return prizes, rows.Err()
+}
+
+// MarkPrizeReminded records that the prize's owner was reminded to claim it
+func (r *PrizeRepository) MarkPrizeReminded(ctx context.Context, receivedPrizeID uint64, at time.Time) error {
+	if _, err := r.db.ExecContext(ctx,
+		"UPDATE received_prizes SET reminded_at = ?, updated_at = ? WHERE id = ?",
+		at, at, receivedPrizeID,
+	); err != nil {
+		return fmt.Errorf("failed to mark prize reminded: %w", err)
+	}
+	return nil
+}
+
+// adjustPrizePool changes the pool by the psc and satisfaction amounts,
+// multiplied by balance for the pool balance and by escrowed for the
+// escrowed amount (each -1, 0 or 1)
+func adjustPrizePool(ctx context.Context, tx *sql.Tx, psc, satisfaction, balance, escrowed float64) error {
+	query := `
+		INSERT INTO dynasty_prize_pool (asset, balance, escrowed, created_at, updated_at)
+		VALUES (?, ?, ?, NOW(), NOW())
+		ON DUPLICATE KEY UPDATE
+			balance = balance + VALUES(balance),
+			escrowed = escrowed + VALUES(escrowed),
+			updated_at = NOW()
+	`
+
+	amounts := []struct {
+		asset  string
+		amount float64
+	}{
+		{models.PrizePoolAssetPSC, psc},
+		{models.PrizePoolAssetSatisfaction, satisfaction},
+	}
+	for _, a := range amounts {
+		if a.amount == 0 {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, query, a.asset, a.amount*balance, a.amount*escrowed); err != nil {
+			return fmt.Errorf("failed to update prize pool: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"metargb/dynasty-service/internal/models"
+)
+
+// leaderboardColumns maps each ranking to the dynasty_stats column it sorts by
+var leaderboardColumns = map[string]string{
+	models.LeaderboardByScore:  "member_score",
+	models.LeaderboardByLands:  "land_count",
+	models.LeaderboardByPrizes: "prize_psc",
+}
+
+type StatsRepository struct {
+	db *sql.DB
+}
+
+func NewStatsRepository(db *sql.DB) *StatsRepository {
+	return &StatsRepository{db: db}
+}
+
+// ListDynastyMembers returns the members of every dynasty. The owner is
+// counted as a member even when the family has no owner row.
+func (r *StatsRepository) ListDynastyMembers(ctx context.Context) ([]*models.DynastyMembers, error) {
+	query := `
+		SELECT d.id, d.user_id, fm.user_id
+		FROM dynasties d
+		LEFT JOIN families f ON f.dynasty_id = d.id
+		LEFT JOIN family_members fm ON fm.family_id = f.id
+		ORDER BY d.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dynasty members: %w", err)
+	}
+	defer rows.Close()
+
+	var dynasties []*models.DynastyMembers
+	var current *models.DynastyMembers
+	seen := make(map[uint64]bool)
+	for rows.Next() {
+		var dynastyID, ownerID uint64
+		var memberID sql.NullInt64
+		if err := rows.Scan(&dynastyID, &ownerID, &memberID); err != nil {
+			return nil, fmt.Errorf("failed to scan dynasty member: %w", err)
+		}
+		if current == nil || current.DynastyID != dynastyID {
+			current = &models.DynastyMembers{DynastyID: dynastyID, OwnerID: ownerID, MemberIDs: []uint64{ownerID}}
+			seen = map[uint64]bool{ownerID: true}
+			dynasties = append(dynasties, current)
+		}
+		if memberID.Valid && !seen[uint64(memberID.Int64)] {
+			seen[uint64(memberID.Int64)] = true
+			current.MemberIDs = append(current.MemberIDs, uint64(memberID.Int64))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate dynasty members: %w", err)
+	}
+
+	return dynasties, nil
+}
+
+// PrizeTotals returns the psc of the prizes each dynasty's memberships
+// earned. Prizes are counted from the members' relationships rather than
+// received_prizes, whose expired prizes were never paid and which lost the
+// rows of prizes claimed before escrow.
+func (r *StatsRepository) PrizeTotals(ctx context.Context) (map[uint64]int64, error) {
+	query := `
+		SELECT f.dynasty_id, COALESCE(SUM(dp.psc), 0)
+		FROM families f
+		INNER JOIN family_members fm ON fm.family_id = f.id
+		INNER JOIN dynasty_prizes dp ON dp.member = fm.relationship
+		GROUP BY f.dynasty_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum dynasty prizes: %w", err)
+	}
+	defer rows.Close()
+
+	totals := make(map[uint64]int64)
+	for rows.Next() {
+		var dynastyID uint64
+		var total int64
+		if err := rows.Scan(&dynastyID, &total); err != nil {
+			return nil, fmt.Errorf("failed to scan dynasty prizes: %w", err)
+		}
+		totals[dynastyID] = total
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate dynasty prizes: %w", err)
+	}
+
+	return totals, nil
+}
+
+// ReplaceStats saves the stats of one aggregation run and removes the rows of
+// dynasties it did not see, such as deleted ones
+func (r *StatsRepository) ReplaceStats(ctx context.Context, stats []*models.DynastyStats, computedAt time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO dynasty_stats
+			(dynasty_id, owner_id, member_count, member_score, land_count, prize_psc, computed_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
+		ON DUPLICATE KEY UPDATE
+			owner_id = VALUES(owner_id), member_count = VALUES(member_count), member_score = VALUES(member_score),
+			land_count = VALUES(land_count), prize_psc = VALUES(prize_psc), computed_at = VALUES(computed_at),
+			updated_at = NOW()
+	`
+	for _, s := range stats {
+		if _, err := tx.ExecContext(ctx, query, s.DynastyID, s.OwnerID, s.MemberCount, s.MemberScore, s.LandCount, s.PrizePSC, computedAt); err != nil {
+			return fmt.Errorf("failed to save dynasty stats: %w", err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM dynasty_stats WHERE computed_at < ?`, computedAt); err != nil {
+		return fmt.Errorf("failed to remove stale dynasty stats: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit dynasty stats: %w", err)
+	}
+	return nil
+}
+
+// ListLeaderboard returns a page of dynasty stats ordered by a ranking, and
+// how many dynasties are ranked. Ties are broken by the oldest dynasty.
+func (r *StatsRepository) ListLeaderboard(ctx context.Context, sortBy string, limit, offset int) ([]*models.DynastyStats, int, error) {
+	column, ok := leaderboardColumns[sortBy]
+	if !ok {
+		return nil, 0, fmt.Errorf("invalid leaderboard sort: %s", sortBy)
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM dynasty_stats`).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count dynasty stats: %w", err)
+	}
+
+	query := `
+		SELECT s.dynasty_id, s.owner_id, s.member_count, s.member_score, s.land_count, s.prize_psc, s.computed_at,
+		       COALESCE(u.code, ''), COALESCE(u.name, '')
+		FROM dynasty_stats s
+		LEFT JOIN users u ON u.id = s.owner_id
+		ORDER BY s.` + column + ` DESC, s.dynasty_id ASC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list dynasty leaderboard: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []*models.DynastyStats
+	for rows.Next() {
+		s, err := scanDynastyStats(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan dynasty stats: %w", err)
+		}
+		stats = append(stats, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to iterate dynasty leaderboard: %w", err)
+	}
+
+	return stats, total, nil
+}
+
+// GetStats returns the stats of a dynasty, nil if it was not aggregated yet
+func (r *StatsRepository) GetStats(ctx context.Context, dynastyID uint64) (*models.DynastyStats, error) {
+	query := `
+		SELECT s.dynasty_id, s.owner_id, s.member_count, s.member_score, s.land_count, s.prize_psc, s.computed_at,
+		       COALESCE(u.code, ''), COALESCE(u.name, '')
+		FROM dynasty_stats s
+		LEFT JOIN users u ON u.id = s.owner_id
+		WHERE s.dynasty_id = ?
+	`
+
+	stats, err := scanDynastyStats(r.db.QueryRowContext(ctx, query, dynastyID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dynasty stats: %w", err)
+	}
+	return stats, nil
+}
+
+// GetRanks returns the position of a dynasty in every ranking, counted the
+// way ListLeaderboard orders them
+func (r *StatsRepository) GetRanks(ctx context.Context, stats *models.DynastyStats) (*models.DynastyRanks, error) {
+	query := `
+		SELECT
+			SUM(member_score > ? OR (member_score = ? AND dynasty_id < ?)) + 1,
+			SUM(land_count > ? OR (land_count = ? AND dynasty_id < ?)) + 1,
+			SUM(prize_psc > ? OR (prize_psc = ? AND dynasty_id < ?)) + 1
+		FROM dynasty_stats
+	`
+
+	var ranks models.DynastyRanks
+	err := r.db.QueryRowContext(ctx, query,
+		stats.MemberScore, stats.MemberScore, stats.DynastyID,
+		stats.LandCount, stats.LandCount, stats.DynastyID,
+		stats.PrizePSC, stats.PrizePSC, stats.DynastyID,
+	).Scan(&ranks.Score, &ranks.Lands, &ranks.Prizes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rank dynasty: %w", err)
+	}
+	return &ranks, nil
+}
+
+type dynastyStatsScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanDynastyStats(s dynastyStatsScanner) (*models.DynastyStats, error) {
+	stats := &models.DynastyStats{Owner: &models.UserBasic{}}
+	if err := s.Scan(
+		&stats.DynastyID, &stats.OwnerID, &stats.MemberCount, &stats.MemberScore,
+		&stats.LandCount, &stats.PrizePSC, &stats.ComputedAt,
+		&stats.Owner.Code, &stats.Owner.Name,
+	); err != nil {
+		return nil, err
+	}
+	stats.Owner.ID = stats.OwnerID
+	return stats, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"metargb/dynasty-service/internal/models"
 	"metargb/dynasty-service/internal/repository"
 )
 
@@ -143,9 +144,7 @@ func TestJoinRequestService_EdgeCases(t *testing.T) {
 			WillReturnRows(sqlmock.NewRows([]string{"id", "member", "satisfaction", "introduction_profit_increase", "accumulated_capital_reserve", "data_storage", "psc", "created_at", "updated_at"}).
 				AddRow(1, "father", 0.1, 0.05, 0.02, 0.03, 1000, time.Now(), time.Now()))
 
-		mock.ExpectExec("INSERT INTO received_prizes").
-			WithArgs(toUserID, 1, sqlmock.AnyArg()).
-			WillReturnResult(sqlmock.NewResult(1, 1))
+		expectPrizeAward(mock, toUserID)
 
 		err := service.AcceptJoinRequest(ctx, requestID, toUserID)
 		require.NoError(t, err)
@@ -209,9 +208,7 @@ func TestJoinRequestService_EdgeCases(t *testing.T) {
 			WillReturnRows(sqlmock.NewRows([]string{"id", "member", "satisfaction", "introduction_profit_increase", "accumulated_capital_reserve", "data_storage", "psc", "created_at", "updated_at"}).
 				AddRow(1, "offspring", 0.1, 0.05, 0.02, 0.03, 1000, time.Now(), time.Now()))
 
-		mock.ExpectExec("INSERT INTO received_prizes").
-			WithArgs(toUserID, 1, sqlmock.AnyArg()).
-			WillReturnResult(sqlmock.NewResult(1, 1))
+		expectPrizeAward(mock, toUserID)
 
 		err := service.AcceptJoinRequest(ctx, requestID, toUserID)
 		require.NoError(t, err)
@@ -231,29 +228,53 @@ func TestPrizeService_EdgeCases(t *testing.T) {
 
 	ctx := context.Background()
 
-	t.Run("ClaimPrize_ConcurrentAttempts_SecondFailsWith404", func(t *testing.T) {
+	service.SetWallet(newFakePrizeWallet())
+
+	t.Run("ClaimPrize_ConcurrentAttempts_SecondFailsAsClaimed", func(t *testing.T) {
 		receivedPrizeID := uint64(1)
 		userID := uint64(1)
 
 		// First claim succeeds
 		mock.ExpectQuery("SELECT rp.id, rp.user_id").
 			WithArgs(receivedPrizeID).
-			WillReturnRows(sqlmock.NewRows([]string{"rp.id", "rp.user_id", "rp.prize_id", "rp.message", "rp.created_at", "rp.updated_at", "dp.member", "dp.satisfaction", "dp.introduction_profit_increase", "dp.accumulated_capital_reserve", "dp.data_storage", "dp.psc"}).
-				AddRow(receivedPrizeID, userID, 1, "Congratulations!", time.Now(), time.Now(), "offspring", 0.1, 0.05, 0.02, 0.03, 1000))
-
-		mock.ExpectExec("DELETE FROM received_prizes").
-			WithArgs(receivedPrizeID).
-			WillReturnResult(sqlmock.NewResult(0, 1))
+			WillReturnRows(addReceivedPrizeRow(receivedPrizeRows(), receivedPrizeID, userID, models.PrizeStatusPending, time.Now().Add(time.Hour)))
+		expectPrizeClaim(mock, receivedPrizeID, userID)
 
 		err := service.ClaimPrize(ctx, receivedPrizeID, userID)
 		require.NoError(t, err)
 
-		// Second claim fails (prize already deleted)
+		// Second claim fails (prize already settled)
 		mock.ExpectQuery("SELECT rp.id, rp.user_id").
 			WithArgs(receivedPrizeID).
-			WillReturnError(sql.ErrNoRows)
+			WillReturnRows(addReceivedPrizeRow(receivedPrizeRows(), receivedPrizeID, userID, models.PrizeStatusClaimed, time.Now().Add(time.Hour)))
 
 		err = service.ClaimPrize(ctx, receivedPrizeID, userID)
+		assert.ErrorIs(t, err, ErrPrizeAlreadyClaimed)
+	})
+
+	t.Run("ClaimPrize_RaceLostAfterLookup", func(t *testing.T) {
+		receivedPrizeID := uint64(2)
+		userID := uint64(1)
+
+		// Claimed by another request between the lookup and the lock
+		mock.ExpectQuery("SELECT rp.id, rp.user_id").
+			WithArgs(receivedPrizeID).
+			WillReturnRows(addReceivedPrizeRow(receivedPrizeRows(), receivedPrizeID, userID, models.PrizeStatusPending, time.Now().Add(time.Hour)))
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT psc, satisfaction FROM received_prizes").
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		err := service.ClaimPrize(ctx, receivedPrizeID, userID)
+		assert.ErrorIs(t, err, ErrPrizeAlreadyClaimed)
+	})
+
+	t.Run("ClaimPrize_UnknownPrize", func(t *testing.T) {
+		mock.ExpectQuery("SELECT rp.id, rp.user_id").
+			WithArgs(uint64(3)).
+			WillReturnError(sql.ErrNoRows)
+
+		err := service.ClaimPrize(ctx, 3, 1)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "not found")
 	})
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"metargb/dynasty-service/internal/models"
+	"metargb/shared/pkg/jobs"
+	"metargb/shared/pkg/logger"
+)
+
+// DefaultDynastyStatsInterval is how often the dynasty stats are aggregated
+const DefaultDynastyStatsInterval = 15 * time.Minute
+
+// dynastyStatsBatchSize caps the users sent to levels-service and
+// features-service per call, matching the limit of their batch RPCs
+const dynastyStatsBatchSize = 1000
+
+const (
+	defaultLeaderboardPerPage = 20
+	maxLeaderboardPerPage     = 100
+)
+
+var (
+	ErrLeaderboardInvalidSort  = errors.New("invalid sort_by: must be score, lands or prizes")
+	ErrDynastyStatsNotFound    = errors.New("dynasty stats not found")
+	ErrDynastyStatsUnavailable = errors.New("dynasty stats sources are unavailable")
+)
+
+// DynastyStatsRepository reads the dynasties and stores their stats
+type DynastyStatsRepository interface {
+	ListDynastyMembers(ctx context.Context) ([]*models.DynastyMembers, error)
+	PrizeTotals(ctx context.Context) (map[uint64]int64, error)
+	ReplaceStats(ctx context.Context, stats []*models.DynastyStats, computedAt time.Time) error
+	ListLeaderboard(ctx context.Context, sortBy string, limit, offset int) ([]*models.DynastyStats, int, error)
+	GetStats(ctx context.Context, dynastyID uint64) (*models.DynastyStats, error)
+	GetRanks(ctx context.Context, stats *models.DynastyStats) (*models.DynastyRanks, error)
+}
+
+// MemberScoreSource reads user scores, implemented by client.LevelsClient
+type MemberScoreSource interface {
+	GetUserScores(ctx context.Context, userIDs []uint64) (map[uint64]int32, error)
+}
+
+// LandCountSource counts owned features, implemented by client.FeaturesClient
+type LandCountSource interface {
+	CountOwnedFeatures(ctx context.Context, ownerIDs []uint64) (map[uint64]int32, error)
+}
+
+// DynastyStatsService aggregates dynasty stats and ranks dynasties by them
+type DynastyStatsService struct {
+	repo     DynastyStatsRepository
+	scores   MemberScoreSource
+	lands    LandCountSource
+	interval time.Duration
+	log      *logger.Logger
+	now      func() time.Time
+}
+
+// NewDynastyStatsService creates a service aggregating every interval
+// (DefaultDynastyStatsInterval if zero). While scores or lands is nil the
+// stats are not refreshed and the last aggregated ones are served.
+func NewDynastyStatsService(repo DynastyStatsRepository, scores MemberScoreSource, lands LandCountSource, interval time.Duration, log *logger.Logger) *DynastyStatsService {
+	if interval <= 0 {
+		interval = DefaultDynastyStatsInterval
+	}
+	return &DynastyStatsService{
+		repo:     repo,
+		scores:   scores,
+		lands:    lands,
+		interval: interval,
+		log:      log,
+		now:      time.Now,
+	}
+}
+
+// Register aggregates the stats at start and then once every interval under
+// runner
+func (s *DynastyStatsService) Register(runner *jobs.Runner) {
+	runner.AddImmediate("dynasty-stats", s.interval, func(ctx context.Context) error {
+		count, err := s.Aggregate(ctx)
+		if err == nil {
+			s.log.Debug("Dynasty stats aggregated", "dynasties", count)
+		}
+		return err
+	})
+}
+
+// Aggregate recomputes the stats of every dynasty and returns how many were
+// saved. Nothing is saved when a source fails, so the last complete stats
+// keep being served.
+func (s *DynastyStatsService) Aggregate(ctx context.Context) (int, error) {
+	if s.scores == nil || s.lands == nil {
+		return 0, ErrDynastyStatsUnavailable
+	}
+
+	dynasties, err := s.repo.ListDynastyMembers(ctx)
+	if err != nil {
+		return 0, err
+	}
+	prizes, err := s.repo.PrizeTotals(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var userIDs []uint64
+	seen := make(map[uint64]bool)
+	for _, dynasty := range dynasties {
+		for _, id := range dynasty.MemberIDs {
+			if !seen[id] {
+				seen[id] = true
+				userIDs = append(userIDs, id)
+			}
+		}
+	}
+
+	scores := make(map[uint64]int32, len(userIDs))
+	lands := make(map[uint64]int32, len(userIDs))
+	for start := 0; start < len(userIDs); start += dynastyStatsBatchSize {
+		end := start + dynastyStatsBatchSize
+		if end > len(userIDs) {
+			end = len(userIDs)
+		}
+		batch := userIDs[start:end]
+
+		batchScores, err := s.scores.GetUserScores(ctx, batch)
+		if err != nil {
+			return 0, err
+		}
+		for id, score := range batchScores {
+			scores[id] = score
+		}
+
+		batchLands, err := s.lands.CountOwnedFeatures(ctx, batch)
+		if err != nil {
+			return 0, err
+		}
+		for id, count := range batchLands {
+			lands[id] = count
+		}
+	}
+
+	computedAt := s.now()
+	stats := make([]*models.DynastyStats, 0, len(dynasties))
+	for _, dynasty := range dynasties {
+		entry := &models.DynastyStats{
+			DynastyID:   dynasty.DynastyID,
+			OwnerID:     dynasty.OwnerID,
+			MemberCount: len(dynasty.MemberIDs),
+			PrizePSC:    prizes[dynasty.DynastyID],
+			ComputedAt:  computedAt,
+		}
+		for _, id := range dynasty.MemberIDs {
+			entry.MemberScore += int64(scores[id])
+			entry.LandCount += int(lands[id])
+		}
+		stats = append(stats, entry)
+	}
+
+	if err := s.repo.ReplaceStats(ctx, stats, computedAt); err != nil {
+		return 0, err
+	}
+	return len(stats), nil
+}
+
+// GetLeaderboard returns a page of dynasties ranked by sortBy, the member
+// score ranking if empty
+func (s *DynastyStatsService) GetLeaderboard(ctx context.Context, sortBy string, page, perPage int) (*models.DynastyLeaderboard, error) {
+	if sortBy == "" {
+		sortBy = models.LeaderboardByScore
+	}
+	if sortBy != models.LeaderboardByScore && sortBy != models.LeaderboardByLands && sortBy != models.LeaderboardByPrizes {
+		return nil, ErrLeaderboardInvalidSort
+	}
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 {
+		perPage = defaultLeaderboardPerPage
+	}
+	if perPage > maxLeaderboardPerPage {
+		perPage = maxLeaderboardPerPage
+	}
+
+	stats, total, err := s.repo.ListLeaderboard(ctx, sortBy, perPage, (page-1)*perPage)
+	if err != nil {
+		return nil, err
+	}
+	return &models.DynastyLeaderboard{
+		SortBy:  sortBy,
+		Stats:   stats,
+		Total:   total,
+		Page:    page,
+		PerPage: perPage,
+	}, nil
+}
+
+// GetDynastyStats returns the stats of a dynasty and its position in every ranking
+func (s *DynastyStatsService) GetDynastyStats(ctx context.Context, dynastyID uint64) (*models.DynastyStats, *models.DynastyRanks, error) {
+	stats, err := s.repo.GetStats(ctx, dynastyID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if stats == nil {
+		return nil, nil, ErrDynastyStatsNotFound
+	}
+
+	ranks, err := s.repo.GetRanks(ctx, stats)
+	if err != nil {
+		return nil, nil, err
+	}
+	return stats, ranks, nil
+}
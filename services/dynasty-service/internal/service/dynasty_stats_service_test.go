@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"metargb/dynasty-service/internal/models"
+	"metargb/shared/pkg/logger"
+)
+
+type fakeDynastyStatsRepository struct {
+	dynasties  []*models.DynastyMembers
+	prizes     map[uint64]int64
+	saved      []*models.DynastyStats
+	savedAt    time.Time
+	listSortBy string
+	listLimit  int
+	listOffset int
+}
+
+func (f *fakeDynastyStatsRepository) ListDynastyMembers(ctx context.Context) ([]*models.DynastyMembers, error) {
+	return f.dynasties, nil
+}
+
+func (f *fakeDynastyStatsRepository) PrizeTotals(ctx context.Context) (map[uint64]int64, error) {
+	return f.prizes, nil
+}
+
+func (f *fakeDynastyStatsRepository) ReplaceStats(ctx context.Context, stats []*models.DynastyStats, computedAt time.Time) error {
+	f.saved = stats
+	f.savedAt = computedAt
+	return nil
+}
+
+func (f *fakeDynastyStatsRepository) ListLeaderboard(ctx context.Context, sortBy string, limit, offset int) ([]*models.DynastyStats, int, error) {
+	f.listSortBy, f.listLimit, f.listOffset = sortBy, limit, offset
+	return f.saved, len(f.saved), nil
+}
+
+func (f *fakeDynastyStatsRepository) GetStats(ctx context.Context, dynastyID uint64) (*models.DynastyStats, error) {
+	for _, stats := range f.saved {
+		if stats.DynastyID == dynastyID {
+			return stats, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeDynastyStatsRepository) GetRanks(ctx context.Context, stats *models.DynastyStats) (*models.DynastyRanks, error) {
+	return &models.DynastyRanks{Score: 1, Lands: 2, Prizes: 3}, nil
+}
+
+type fakeMemberScores struct {
+	scores  map[uint64]int32
+	batches [][]uint64
+	err     error
+}
+
+func (f *fakeMemberScores) GetUserScores(ctx context.Context, userIDs []uint64) (map[uint64]int32, error) {
+	f.batches = append(f.batches, userIDs)
+	if f.err != nil {
+		return nil, f.err
+	}
+	scores := make(map[uint64]int32)
+	for _, id := range userIDs {
+		if score, ok := f.scores[id]; ok {
+			scores[id] = score
+		}
+	}
+	return scores, nil
+}
+
+type fakeLandCounts map[uint64]int32
+
+func (f fakeLandCounts) CountOwnedFeatures(ctx context.Context, ownerIDs []uint64) (map[uint64]int32, error) {
+	counts := make(map[uint64]int32)
+	for _, id := range ownerIDs {
+		if count, ok := f[id]; ok {
+			counts[id] = count
+		}
+	}
+	return counts, nil
+}
+
+func TestDynastyStatsService_Aggregate(t *testing.T) {
+	repo := &fakeDynastyStatsRepository{
+		dynasties: []*models.DynastyMembers{
+			{DynastyID: 1, OwnerID: 10, MemberIDs: []uint64{10, 11, 12}},
+			{DynastyID: 2, OwnerID: 20, MemberIDs: []uint64{20}},
+		},
+		prizes: map[uint64]int64{1: 700},
+	}
+	scores := &fakeMemberScores{scores: map[uint64]int32{10: 100, 11: 50, 20: 300}}
+	lands := fakeLandCounts{10: 2, 12: 1}
+	svc := NewDynastyStatsService(repo, scores, lands, 0, logger.NewLogger("test"))
+
+	count, err := svc.Aggregate(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, scores.batches, 1)
+	assert.ElementsMatch(t, []uint64{10, 11, 12, 20}, scores.batches[0])
+
+	require.Len(t, repo.saved, 2)
+	first := repo.saved[0]
+	assert.Equal(t, uint64(1), first.DynastyID)
+	assert.Equal(t, 3, first.MemberCount)
+	assert.Equal(t, int64(150), first.MemberScore)
+	assert.Equal(t, 3, first.LandCount)
+	assert.Equal(t, int64(700), first.PrizePSC)
+
+	second := repo.saved[1]
+	assert.Equal(t, int64(300), second.MemberScore)
+	assert.Equal(t, 0, second.LandCount)
+	assert.Equal(t, int64(0), second.PrizePSC)
+	assert.Equal(t, repo.savedAt, second.ComputedAt)
+}
+
+func TestDynastyStatsService_AggregateKeepsStatsWhenSourceFails(t *testing.T) {
+	repo := &fakeDynastyStatsRepository{
+		dynasties: []*models.DynastyMembers{{DynastyID: 1, OwnerID: 10, MemberIDs: []uint64{10}}},
+	}
+	scores := &fakeMemberScores{err: errors.New("levels-service unavailable")}
+	svc := NewDynastyStatsService(repo, scores, fakeLandCounts{}, 0, logger.NewLogger("test"))
+
+	_, err := svc.Aggregate(context.Background())
+	assert.Error(t, err)
+	assert.Nil(t, repo.saved)
+
+	svc = NewDynastyStatsService(repo, nil, fakeLandCounts{}, 0, logger.NewLogger("test"))
+	_, err = svc.Aggregate(context.Background())
+	assert.ErrorIs(t, err, ErrDynastyStatsUnavailable)
+}
+
+func TestDynastyStatsService_GetLeaderboard(t *testing.T) {
+	repo := &fakeDynastyStatsRepository{}
+	svc := NewDynastyStatsService(repo, nil, nil, 0, logger.NewLogger("test"))
+
+	leaderboard, err := svc.GetLeaderboard(context.Background(), "", 3, 500)
+	require.NoError(t, err)
+	assert.Equal(t, models.LeaderboardByScore, leaderboard.SortBy)
+	assert.Equal(t, maxLeaderboardPerPage, leaderboard.PerPage)
+	assert.Equal(t, models.LeaderboardByScore, repo.listSortBy)
+	assert.Equal(t, 200, repo.listOffset)
+
+	_, err = svc.GetLeaderboard(context.Background(), "wealth", 1, 10)
+	assert.ErrorIs(t, err, ErrLeaderboardInvalidSort)
+
+	_, _, err = svc.GetDynastyStats(context.Background(), 9)
+	assert.ErrorIs(t, err, ErrDynastyStatsNotFound)
+}
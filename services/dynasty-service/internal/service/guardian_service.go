@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"metargb/dynasty-service/internal/repository"
+	commercialpb "metargb/shared/pb/commercial"
+)
+
+var (
+	// ErrNotGuardian is returned when the caller is not the guardian of the child
+	ErrNotGuardian = errors.New("permission denied: only the guardian of a child under 18 can manage their spending limits")
+	// ErrSpendingLimitsUnavailable is returned when commercial-service is not connected
+	ErrSpendingLimitsUnavailable = errors.New("spending limits are unavailable")
+)
+
+// SpendingLimitsClient reads and changes the spending limits commercial-service
+// keeps for users under 18, implemented by client.CommercialClient
+type SpendingLimitsClient interface {
+	GetSpendingLimits(ctx context.Context, userID uint64) (*commercialpb.SpendingLimits, error)
+	SetSpendingLimits(ctx context.Context, req *commercialpb.SetSpendingLimitsRequest) (*commercialpb.SpendingLimits, error)
+}
+
+// GuardianService links children under 18 to their guardian: the owner of
+// the dynasty they joined as offspring. Guardians manage the child's spending limits.
+type GuardianService struct {
+	joinRequestRepo *repository.JoinRequestRepository
+	familyRepo      *repository.FamilyRepository
+	dynastyRepo     *repository.DynastyRepository
+	spendingLimits  SpendingLimitsClient
+}
+
+// NewGuardianService creates the guardian service. spendingLimits may be nil
+// while commercial-service is unreachable.
+func NewGuardianService(
+	joinRequestRepo *repository.JoinRequestRepository,
+	familyRepo *repository.FamilyRepository,
+	dynastyRepo *repository.DynastyRepository,
+	spendingLimits SpendingLimitsClient,
+) *GuardianService {
+	return &GuardianService{
+		joinRequestRepo: joinRequestRepo,
+		familyRepo:      familyRepo,
+		dynastyRepo:     dynastyRepo,
+		spendingLimits:  spendingLimits,
+	}
+}
+
+// IsGuardian checks that childUserID is under 18 and a member of
+// parentUserID's dynasty family as offspring
+func (s *GuardianService) IsGuardian(ctx context.Context, parentUserID, childUserID uint64) (bool, error) {
+	if parentUserID == childUserID {
+		return false, nil
+	}
+
+	dynasty, err := s.dynastyRepo.GetDynastyByUserID(ctx, parentUserID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get dynasty: %w", err)
+	}
+	if dynasty == nil {
+		return false, nil
+	}
+
+	family, err := s.familyRepo.GetFamilyByDynastyID(ctx, dynasty.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get family: %w", err)
+	}
+	if family == nil {
+		return false, nil
+	}
+
+	member, err := s.familyRepo.FindMemberByUserAndFamily(ctx, childUserID, family.ID)
+	if err != nil {
+		return false, err
+	}
+	if member == nil || member.Relationship != "offspring" {
+		return false, nil
+	}
+
+	isUnder18, err := s.joinRequestRepo.CheckUserAge(ctx, childUserID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check child age: %w", err)
+	}
+	return isUnder18, nil
+}
+
+// GetChildSpendingLimits returns the spending limits of a child to their guardian
+func (s *GuardianService) GetChildSpendingLimits(ctx context.Context, parentUserID, childUserID uint64) (*commercialpb.SpendingLimits, error) {
+	if err := s.checkGuardian(ctx, parentUserID, childUserID); err != nil {
+		return nil, err
+	}
+	return s.spendingLimits.GetSpendingLimits(ctx, childUserID)
+}
+
+// SetChildSpendingLimits lets a guardian change the spending limits of a
+// child. Empty amounts in req keep the current limit.
+func (s *GuardianService) SetChildSpendingLimits(ctx context.Context, parentUserID, childUserID uint64, req *commercialpb.SetSpendingLimitsRequest) (*commercialpb.SpendingLimits, error) {
+	if err := s.checkGuardian(ctx, parentUserID, childUserID); err != nil {
+		return nil, err
+	}
+	req.UserId = childUserID
+	req.UpdatedBy = parentUserID
+	return s.spendingLimits.SetSpendingLimits(ctx, req)
+}
+
+func (s *GuardianService) checkGuardian(ctx context.Context, parentUserID, childUserID uint64) error {
+	if s.spendingLimits == nil {
+		return ErrSpendingLimitsUnavailable
+	}
+	isGuardian, err := s.IsGuardian(ctx, parentUserID, childUserID)
+	if err != nil {
+		return err
+	}
+	if !isGuardian {
+		return ErrNotGuardian
+	}
+	return nil
+}
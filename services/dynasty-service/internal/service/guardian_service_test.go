@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"metargb/dynasty-service/internal/repository"
+	commercialpb "metargb/shared/pb/commercial"
+)
+
+type fakeSpendingLimitsClient struct {
+	set *commercialpb.SetSpendingLimitsRequest
+}
+
+func (f *fakeSpendingLimitsClient) GetSpendingLimits(_ context.Context, userID uint64) (*commercialpb.SpendingLimits, error) {
+	return &commercialpb.SpendingLimits{UserId: userID, Applies: true}, nil
+}
+
+func (f *fakeSpendingLimitsClient) SetSpendingLimits(_ context.Context, req *commercialpb.SetSpendingLimitsRequest) (*commercialpb.SpendingLimits, error) {
+	f.set = req
+	return &commercialpb.SpendingLimits{UserId: req.UserId, Applies: true, UpdatedBy: req.UpdatedBy}, nil
+}
+
+func TestGuardianService_SetChildSpendingLimits(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	limits := &fakeSpendingLimitsClient{}
+	service := NewGuardianService(
+		repository.NewJoinRequestRepository(db),
+		repository.NewFamilyRepository(db),
+		repository.NewDynastyRepository(db),
+		limits,
+	)
+
+	ctx := context.Background()
+	parentUserID := uint64(1)
+	childUserID := uint64(2)
+
+	expectMember := func(relationship string) {
+		mock.ExpectQuery("SELECT id, user_id, feature_id").
+			WithArgs(parentUserID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "feature_id", "created_at", "updated_at"}).
+				AddRow(1, parentUserID, 100, time.Now(), time.Now()))
+		mock.ExpectQuery("SELECT id, dynasty_id").
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "dynasty_id", "created_at", "updated_at"}).
+				AddRow(1, 1, time.Now(), time.Now()))
+		mock.ExpectQuery("SELECT id, family_id, user_id, relationship").
+			WithArgs(childUserID, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "family_id", "user_id", "relationship", "created_at", "updated_at"}).
+				AddRow(2, 1, childUserID, relationship, time.Now(), time.Now()))
+	}
+
+	t.Run("Guardian", func(t *testing.T) {
+		expectMember("offspring")
+		mock.ExpectQuery("SELECT TIMESTAMPDIFF").
+			WithArgs(childUserID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_under_18"}).AddRow(true))
+
+		result, err := service.SetChildSpendingLimits(ctx, parentUserID, childUserID, &commercialpb.SetSpendingLimitsRequest{DailyPsc: "5"})
+		require.NoError(t, err)
+		assert.Equal(t, parentUserID, result.UpdatedBy)
+		require.NotNil(t, limits.set)
+		assert.Equal(t, childUserID, limits.set.UserId)
+		assert.Equal(t, "5", limits.set.DailyPsc)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NotOffspring", func(t *testing.T) {
+		limits.set = nil
+		expectMember("brother")
+
+		_, err := service.SetChildSpendingLimits(ctx, parentUserID, childUserID, &commercialpb.SetSpendingLimitsRequest{DailyPsc: "5"})
+		assert.ErrorIs(t, err, ErrNotGuardian)
+		assert.Nil(t, limits.set)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ChildTurned18", func(t *testing.T) {
+		expectMember("offspring")
+		mock.ExpectQuery("SELECT TIMESTAMPDIFF").
+			WithArgs(childUserID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_under_18"}).AddRow(false))
+
+		_, err := service.GetChildSpendingLimits(ctx, parentUserID, childUserID)
+		assert.ErrorIs(t, err, ErrNotGuardian)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CommercialUnavailable", func(t *testing.T) {
+		unavailable := NewGuardianService(nil, nil, nil, nil)
+		_, err := unavailable.GetChildSpendingLimits(ctx, parentUserID, childUserID)
+		assert.ErrorIs(t, err, ErrSpendingLimitsUnavailable)
+	})
+}
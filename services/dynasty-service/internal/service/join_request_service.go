@@ -14,6 +14,7 @@ type JoinRequestService struct {
 	dynastyRepo             *repository.DynastyRepository
 	familyRepo              *repository.FamilyRepository
 	prizeRepo               *repository.PrizeRepository
+	membershipRules         *MembershipRulesService
 	notificationServiceAddr string
 }
 
@@ -33,6 +34,36 @@ func NewJoinRequestService(
 	}
 }
 
+// SetMembershipRules makes join requests respect the admin managed membership rules
+func (s *JoinRequestService) SetMembershipRules(rules *MembershipRulesService) {
+	s.membershipRules = rules
+}
+
+// checkMembershipRules checks the membership rules for userID joining
+// ownerID's family, if rules are set
+func (s *JoinRequestService) checkMembershipRules(ctx context.Context, ownerID, userID uint64, relationship string) error {
+	if s.membershipRules == nil {
+		return nil
+	}
+
+	dynasty, err := s.dynastyRepo.GetDynastyByUserID(ctx, ownerID)
+	if err != nil {
+		return fmt.Errorf("failed to get dynasty: %w", err)
+	}
+	if dynasty == nil {
+		return fmt.Errorf("requester does not have a dynasty")
+	}
+	family, err := s.familyRepo.GetFamilyByDynastyID(ctx, dynasty.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get family: %w", err)
+	}
+	if family == nil {
+		return fmt.Errorf("family not found")
+	}
+
+	return s.membershipRules.CheckJoin(ctx, family.ID, userID, relationship)
+}
+
 // SendJoinRequest creates and sends a join request
 func (s *JoinRequestService) SendJoinRequest(ctx context.Context, fromUserID, toUserID uint64, relationship string, message *string, permissions *models.ChildPermission) (*models.JoinRequest, error) {
 	// Validate relationship is offering (not spring) and user age for permissions
@@ -46,6 +77,10 @@ func (s *JoinRequestService) SendJoinRequest(ctx context.Context, fromUserID, to
 		}
 	}
 
+	if err := s.checkMembershipRules(ctx, fromUserID, toUserID, relationship); err != nil {
+		return nil, err
+	}
+
 	// Get dynasty message for receiver
 	messageTemplate, err := s.dynastyRepo.GetDynastyMessage(ctx, "receiver_message")
 	if err != nil {
@@ -123,6 +158,11 @@ func (s *JoinRequestService) AcceptJoinRequest(ctx context.Context, requestID, u
 		return fmt.Errorf("unauthorized to accept this request")
 	}
 
+	// The family may have changed since the request was sent
+	if err := s.checkMembershipRules(ctx, request.FromUser, userID, request.Relationship); err != nil {
+		return err
+	}
+
 	// Update request status to accepted
 	if err := s.joinRequestRepo.UpdateJoinRequestStatus(ctx, requestID, 1); err != nil {
 		return fmt.Errorf("failed to update request status: %w", err)
@@ -194,7 +234,7 @@ func (s *JoinRequestService) AcceptJoinRequest(ctx context.Context, requestID, u
 			// Create message for prize
 			message := fmt.Sprintf("پاداش اضافه شدن به سلسله به عنوان %s", request.Relationship)
 			// Award prize to the user who accepted (the new family member)
-			if err := s.prizeRepo.AwardPrize(ctx, userID, prize.ID, message); err != nil {
+			if err := s.prizeRepo.AwardPrize(ctx, userID, prize, message); err != nil {
 				// Log error but don't fail the entire operation
 				fmt.Printf("Warning: failed to award prize: %v\n", err)
 			}
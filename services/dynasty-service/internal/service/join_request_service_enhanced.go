@@ -261,7 +261,7 @@ func (s *JoinRequestServiceEnhanced) AcceptJoinRequest(
 		)
 		if err == nil {
 			// Award prize to requester
-			s.prizeRepo.AwardPrize(ctx, requestedUser, prize.ID, requesterMsg)
+			s.prizeRepo.AwardPrize(ctx, requestedUser, prize, requesterMsg)
 		}
 	}
 
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"metargb/dynasty-service/internal/models"
+	"metargb/dynasty-service/internal/validation"
+	"metargb/shared/pkg/helpers"
+)
+
+var (
+	ErrMembershipRulesNotAdmin            = errors.New("unauthorized: only dynasty admins can manage membership rules")
+	ErrMembershipRulesInvalidRelationship = errors.New("invalid relationship in membership rules")
+	ErrMembershipRulesNegative            = errors.New("invalid membership rules: values may not be negative")
+)
+
+// MembershipRulesRepository stores the rules and answers the questions they ask
+type MembershipRulesRepository interface {
+	GetMembershipRules(ctx context.Context) (*models.MembershipRules, error)
+	SaveMembershipRules(ctx context.Context, rules *models.MembershipRules) error
+	CountFamilyMembers(ctx context.Context, familyID uint64) (int, map[string]int, error)
+	GetUserEligibility(ctx context.Context, userID uint64) (verified, under18 bool, err error)
+	GetLastDepartureAt(ctx context.Context, userID uint64) (*time.Time, error)
+}
+
+// MembershipRulesService manages the membership rules and checks join requests against them
+type MembershipRulesService struct {
+	repo   MembershipRulesRepository
+	admins map[uint64]bool
+	now    func() time.Time
+}
+
+// NewMembershipRulesService creates a rules service that only adminIDs may manage
+func NewMembershipRulesService(repo MembershipRulesRepository, adminIDs []uint64) *MembershipRulesService {
+	admins := make(map[uint64]bool, len(adminIDs))
+	for _, id := range adminIDs {
+		admins[id] = true
+	}
+	return &MembershipRulesService{
+		repo:   repo,
+		admins: admins,
+		now:    time.Now,
+	}
+}
+
+// GetMembershipRules returns the rules in effect for an admin
+func (s *MembershipRulesService) GetMembershipRules(ctx context.Context, adminID uint64) (*models.MembershipRules, error) {
+	if !s.admins[adminID] {
+		return nil, ErrMembershipRulesNotAdmin
+	}
+	return s.rules(ctx)
+}
+
+// UpdateMembershipRules validates and saves rules on behalf of an admin
+func (s *MembershipRulesService) UpdateMembershipRules(ctx context.Context, adminID uint64, rules *models.MembershipRules) (*models.MembershipRules, error) {
+	if !s.admins[adminID] {
+		return nil, ErrMembershipRulesNotAdmin
+	}
+	if rules.MaxFamilySize < 0 || rules.LeaveCooldownHours < 0 {
+		return nil, ErrMembershipRulesNegative
+	}
+	for relationship, limit := range rules.RelationshipLimits {
+		if !models.IsValidRelationship(relationship) {
+			return nil, fmt.Errorf("%w: %s", ErrMembershipRulesInvalidRelationship, relationship)
+		}
+		if limit < 0 {
+			return nil, ErrMembershipRulesNegative
+		}
+	}
+	for _, relationships := range [][]string{rules.MinorRelationships, rules.UnverifiedRelationships} {
+		for _, relationship := range relationships {
+			if !models.IsValidRelationship(relationship) {
+				return nil, fmt.Errorf("%w: %s", ErrMembershipRulesInvalidRelationship, relationship)
+			}
+		}
+	}
+
+	rules.UpdatedBy = adminID
+	if err := s.repo.SaveMembershipRules(ctx, rules); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetMembershipRules(ctx)
+}
+
+// CheckJoin checks whether userID may join familyID as relationship. Broken
+// rules are returned as validation errors with a Farsi message.
+func (s *MembershipRulesService) CheckJoin(ctx context.Context, familyID, userID uint64, relationship string) error {
+	rules, err := s.rules(ctx)
+	if err != nil {
+		return err
+	}
+
+	total, byRelationship, err := s.repo.CountFamilyMembers(ctx, familyID)
+	if err != nil {
+		return err
+	}
+	if rules.MaxFamilySize > 0 && total >= int(rules.MaxFamilySize) {
+		return &validation.ValidationError{
+			Message: fmt.Sprintf("ظرفیت سلسله تکمیل است. هر سلسله حداکثر %d عضو می تواند داشته باشد.", rules.MaxFamilySize),
+			Code:    403,
+		}
+	}
+
+	title := models.GetRelationshipTitle(relationship)
+	if limit, ok := rules.RelationshipLimits[relationship]; ok && byRelationship[relationship] >= int(limit) {
+		if limit == 0 {
+			return &validation.ValidationError{
+				Message: fmt.Sprintf("در حال حاضر امکان اضافه کردن %s به سلسله وجود ندارد.", title),
+				Code:    403,
+			}
+		}
+		return &validation.ValidationError{
+			Message: fmt.Sprintf("هر سلسله حداکثر %d %s می تواند داشته باشد.", limit, title),
+			Code:    403,
+		}
+	}
+
+	verified, under18, err := s.repo.GetUserEligibility(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if under18 && !rules.AllowsMinor(relationship) {
+		return &validation.ValidationError{
+			Message: fmt.Sprintf("کاربران زیر ۱۸ سال نمی توانند به عنوان %s به سلسله اضافه شوند.", title),
+			Code:    403,
+		}
+	}
+	if !verified && !rules.AllowsUnverified(relationship) {
+		return &validation.ValidationError{
+			Message: fmt.Sprintf("برای اضافه شدن به عنوان %s، احراز هویت کاربر باید تایید شده باشد.", title),
+			Code:    403,
+		}
+	}
+
+	if cooldown := rules.LeaveCooldown(); cooldown > 0 {
+		leftAt, err := s.repo.GetLastDepartureAt(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if leftAt != nil {
+			if until := leftAt.Add(cooldown); s.now().Before(until) {
+				return &validation.ValidationError{
+					Message: fmt.Sprintf("این کاربر به تازگی از یک سلسله خارج شده است و تا %s نمی تواند به سلسله دیگری بپیوندد.", helpers.FormatJalaliDateTime(until)),
+					Code:    403,
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// rules returns the saved rules, or the defaults if none were saved
+func (s *MembershipRulesService) rules(ctx context.Context) (*models.MembershipRules, error) {
+	rules, err := s.repo.GetMembershipRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rules == nil {
+		return models.DefaultMembershipRules(), nil
+	}
+	return rules, nil
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"metargb/dynasty-service/internal/models"
+	"metargb/dynasty-service/internal/validation"
+)
+
+type fakeMembershipRulesRepository struct {
+	rules          *models.MembershipRules
+	total          int
+	byRelationship map[string]int
+	verified       bool
+	under18        bool
+	leftAt         *time.Time
+}
+
+func (f *fakeMembershipRulesRepository) GetMembershipRules(ctx context.Context) (*models.MembershipRules, error) {
+	return f.rules, nil
+}
+
+func (f *fakeMembershipRulesRepository) SaveMembershipRules(ctx context.Context, rules *models.MembershipRules) error {
+	f.rules = rules
+	return nil
+}
+
+func (f *fakeMembershipRulesRepository) CountFamilyMembers(ctx context.Context, familyID uint64) (int, map[string]int, error) {
+	return f.total, f.byRelationship, nil
+}
+
+func (f *fakeMembershipRulesRepository) GetUserEligibility(ctx context.Context, userID uint64) (bool, bool, error) {
+	return f.verified, f.under18, nil
+}
+
+func (f *fakeMembershipRulesRepository) GetLastDepartureAt(ctx context.Context, userID uint64) (*time.Time, error) {
+	return f.leftAt, nil
+}
+
+func TestMembershipRulesService_CheckJoinDefaults(t *testing.T) {
+	repo := &fakeMembershipRulesRepository{total: 3, byRelationship: map[string]int{"owner": 1, "wife": 2}, verified: true}
+	svc := NewMembershipRulesService(repo, nil)
+
+	assert.NoError(t, svc.CheckJoin(context.Background(), 1, 2, "wife"))
+
+	repo.byRelationship["father"] = 1
+	err := svc.CheckJoin(context.Background(), 1, 2, "father")
+	var validationErr *validation.ValidationError
+	require.True(t, errors.As(err, &validationErr), "expected a validation error, got %v", err)
+	assert.Equal(t, "هر سلسله حداکثر 1 پدر می تواند داشته باشد.", validationErr.Message)
+}
+
+func TestMembershipRulesService_CheckJoin(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	recently := now.Add(-time.Hour)
+	longAgo := now.Add(-48 * time.Hour)
+	rules := &models.MembershipRules{
+		MaxFamilySize:           5,
+		RelationshipLimits:      map[string]int32{"husband": 0},
+		MinorRelationships:      []string{"offspring"},
+		UnverifiedRelationships: []string{"offspring", "brother"},
+		LeaveCooldownHours:      24,
+	}
+
+	tests := []struct {
+		name         string
+		repo         fakeMembershipRulesRepository
+		relationship string
+		wantErr      bool
+	}{
+		{"allowed", fakeMembershipRulesRepository{total: 4, verified: true}, "father", false},
+		{"family full", fakeMembershipRulesRepository{total: 5, verified: true}, "father", true},
+		{"relationship disabled", fakeMembershipRulesRepository{verified: true}, "husband", true},
+		{"minor as offspring", fakeMembershipRulesRepository{verified: true, under18: true}, "offspring", false},
+		{"minor as father", fakeMembershipRulesRepository{verified: true, under18: true}, "father", true},
+		{"unverified as brother", fakeMembershipRulesRepository{}, "brother", false},
+		{"unverified as sister", fakeMembershipRulesRepository{}, "sister", true},
+		{"within cooldown", fakeMembershipRulesRepository{verified: true, leftAt: &recently}, "father", true},
+		{"after cooldown", fakeMembershipRulesRepository{verified: true, leftAt: &longAgo}, "father", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo
+			repo.rules = rules
+			svc := NewMembershipRulesService(&repo, nil)
+			svc.now = func() time.Time { return now }
+
+			err := svc.CheckJoin(context.Background(), 1, 2, tt.relationship)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr *validation.ValidationError
+			require.True(t, errors.As(err, &validationErr), "expected a validation error, got %v", err)
+			assert.NotEmpty(t, validationErr.Message)
+		})
+	}
+}
+
+func TestMembershipRulesService_UpdateMembershipRules(t *testing.T) {
+	repo := &fakeMembershipRulesRepository{}
+	svc := NewMembershipRulesService(repo, []uint64{7})
+	ctx := context.Background()
+
+	_, err := svc.GetMembershipRules(ctx, 8)
+	assert.ErrorIs(t, err, ErrMembershipRulesNotAdmin)
+
+	current, err := svc.GetMembershipRules(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, models.DefaultMembershipRules(), current)
+
+	_, err = svc.UpdateMembershipRules(ctx, 7, &models.MembershipRules{RelationshipLimits: map[string]int32{"cousin": 1}})
+	assert.ErrorIs(t, err, ErrMembershipRulesInvalidRelationship)
+	_, err = svc.UpdateMembershipRules(ctx, 7, &models.MembershipRules{MinorRelationships: []string{"cousin"}})
+	assert.ErrorIs(t, err, ErrMembershipRulesInvalidRelationship)
+	_, err = svc.UpdateMembershipRules(ctx, 7, &models.MembershipRules{LeaveCooldownHours: -1})
+	assert.ErrorIs(t, err, ErrMembershipRulesNegative)
+
+	saved, err := svc.UpdateMembershipRules(ctx, 7, &models.MembershipRules{MaxFamilySize: 10, LeaveCooldownHours: 48})
+	require.NoError(t, err)
+	assert.Equal(t, int32(10), saved.MaxFamilySize)
+	assert.Equal(t, uint64(7), saved.UpdatedBy)
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"metargb/dynasty-service/internal/models"
+	"metargb/shared/pkg/helpers"
+	"metargb/shared/pkg/jobs"
+	"metargb/shared/pkg/logger"
+)
+
+const (
+	// DefaultPrizeClaimWindow is how long awarded prizes can be claimed
+	DefaultPrizeClaimWindow = 30 * 24 * time.Hour
+	// DefaultPrizeEscrowInterval is how often prizes are expired and reminders sent
+	DefaultPrizeEscrowInterval = 15 * time.Minute
+	// DefaultPrizeReminderBefore is how long before a prize expires its owner is reminded
+	DefaultPrizeReminderBefore = 3 * 24 * time.Hour
+)
+
+// prizeEscrowBatchSize caps the prizes expired per transaction and the
+// reminders sent per run
+const prizeEscrowBatchSize = 200
+
+// PrizeEscrowRepository is the part of the prize repository the escrow job uses
+type PrizeEscrowRepository interface {
+	ExpirePrizes(ctx context.Context, now time.Time, limit int) ([]*models.ReceivedPrize, error)
+	GetPrizesToRemind(ctx context.Context, now, remindBy time.Time, limit int) ([]*models.ReceivedPrize, error)
+	MarkPrizeReminded(ctx context.Context, receivedPrizeID uint64, at time.Time) error
+}
+
+// PrizeNotifier delivers prize reminders, implemented by client.NotificationClient
+type PrizeNotifier interface {
+	SendNotification(ctx context.Context, userID uint64, notificationType, title, message string, data map[string]string, sendSMS, sendEmail bool) error
+}
+
+// PrizeEscrowService expires prizes whose claim window ended, returning their
+// amounts to the prize pool, and reminds users of prizes about to expire
+type PrizeEscrowService struct {
+	repo         PrizeEscrowRepository
+	notifier     PrizeNotifier
+	interval     time.Duration
+	remindBefore time.Duration
+	log          *logger.Logger
+	now          func() time.Time
+}
+
+// NewPrizeEscrowService creates a job running every interval
+// (DefaultPrizeEscrowInterval if zero) that reminds users remindBefore a
+// prize expires (DefaultPrizeReminderBefore if zero). While notifier is nil
+// prizes are still expired but nobody is reminded or told.
+func NewPrizeEscrowService(repo PrizeEscrowRepository, notifier PrizeNotifier, interval, remindBefore time.Duration, log *logger.Logger) *PrizeEscrowService {
+	if interval <= 0 {
+		interval = DefaultPrizeEscrowInterval
+	}
+	if remindBefore <= 0 {
+		remindBefore = DefaultPrizeReminderBefore
+	}
+	return &PrizeEscrowService{
+		repo:         repo,
+		notifier:     notifier,
+		interval:     interval,
+		remindBefore: remindBefore,
+		log:          log,
+		now:          time.Now,
+	}
+}
+
+// Register runs the job at start and then once every interval under runner
+func (s *PrizeEscrowService) Register(runner *jobs.Runner) {
+	runner.AddImmediate("dynasty-prize-escrow", s.interval, func(ctx context.Context) error {
+		expired, reminded, err := s.Run(ctx)
+		if err == nil && (expired > 0 || reminded > 0) {
+			s.log.Info("Dynasty prize escrow run finished", "expired", expired, "reminded", reminded)
+		}
+		return err
+	})
+}
+
+// Run expires every prize whose claim window ended and sends the due
+// reminders, returning how many prizes were expired and reminded. A reminder
+// that fails to send is retried next run until the prize expires.
+func (s *PrizeEscrowService) Run(ctx context.Context) (int, int, error) {
+	now := s.now()
+
+	expired := 0
+	for {
+		prizes, err := s.repo.ExpirePrizes(ctx, now, prizeEscrowBatchSize)
+		if err != nil {
+			return expired, 0, err
+		}
+		expired += len(prizes)
+		for _, prize := range prizes {
+			s.notify(ctx, prize, "dynasty_prize_expired", "پاداش سلسله منقضی شد",
+				"مهلت دریافت پاداش سلسله شما به پایان رسید.")
+		}
+		if len(prizes) < prizeEscrowBatchSize {
+			break
+		}
+	}
+
+	if s.notifier == nil {
+		return expired, 0, nil
+	}
+
+	prizes, err := s.repo.GetPrizesToRemind(ctx, now, now.Add(s.remindBefore), prizeEscrowBatchSize)
+	if err != nil {
+		return expired, 0, err
+	}
+
+	reminded := 0
+	for _, prize := range prizes {
+		message := fmt.Sprintf("پاداش سلسله شما تا %s قابل دریافت است.", helpers.FormatJalaliDateTime(*prize.ExpiresAt))
+		if !s.notify(ctx, prize, "dynasty_prize_reminder", "یادآوری دریافت پاداش سلسله", message) {
+			continue
+		}
+
+		if err := s.repo.MarkPrizeReminded(ctx, prize.ID, now); err != nil {
+			return expired, reminded, err
+		}
+		reminded++
+	}
+
+	return expired, reminded, nil
+}
+
+// notify tells the prize's owner about it and reports whether it was sent
+func (s *PrizeEscrowService) notify(ctx context.Context, prize *models.ReceivedPrize, notificationType, title, message string) bool {
+	if s.notifier == nil {
+		return false
+	}
+
+	data := map[string]string{
+		"received_prize_id": fmt.Sprintf("%d", prize.ID),
+		"psc":               fmt.Sprintf("%d", prize.PSC),
+		"satisfaction":      fmt.Sprintf("%.2f", prize.Satisfaction),
+	}
+	if prize.ExpiresAt != nil {
+		data["expires_at"] = helpers.FormatJalaliDateTime(*prize.ExpiresAt)
+	}
+
+	if err := s.notifier.SendNotification(ctx, prize.UserID, notificationType, title, message, data, false, false); err != nil {
+		s.log.Warn("Failed to send dynasty prize notification", "error", err, "type", notificationType, "user_id", prize.UserID, "received_prize_id", prize.ID)
+		return false
+	}
+	return true
+}
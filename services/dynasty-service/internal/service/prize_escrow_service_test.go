@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"metargb/dynasty-service/internal/models"
+	"metargb/shared/pkg/logger"
+)
+
+type fakePrizeEscrowRepository struct {
+	prizes []*models.ReceivedPrize
+	pool   map[string]float64 // pool balance per asset
+}
+
+func (r *fakePrizeEscrowRepository) ExpirePrizes(ctx context.Context, now time.Time, limit int) ([]*models.ReceivedPrize, error) {
+	var expired []*models.ReceivedPrize
+	for _, prize := range r.prizes {
+		if len(expired) == limit {
+			break
+		}
+		if prize.Status == models.PrizeStatusPending && prize.ExpiresAt != nil && !prize.ExpiresAt.After(now) {
+			prize.Status = models.PrizeStatusExpired
+			r.pool[models.PrizePoolAssetPSC] += float64(prize.PSC)
+			expired = append(expired, prize)
+		}
+	}
+	return expired, nil
+}
+
+func (r *fakePrizeEscrowRepository) GetPrizesToRemind(ctx context.Context, now, remindBy time.Time, limit int) ([]*models.ReceivedPrize, error) {
+	var due []*models.ReceivedPrize
+	for _, prize := range r.prizes {
+		if prize.Status == models.PrizeStatusPending && prize.RemindedAt == nil && prize.ExpiresAt != nil &&
+			prize.ExpiresAt.After(now) && !prize.ExpiresAt.After(remindBy) && len(due) < limit {
+			due = append(due, prize)
+		}
+	}
+	return due, nil
+}
+
+func (r *fakePrizeEscrowRepository) MarkPrizeReminded(ctx context.Context, receivedPrizeID uint64, at time.Time) error {
+	for _, prize := range r.prizes {
+		if prize.ID == receivedPrizeID {
+			prize.RemindedAt = &at
+		}
+	}
+	return nil
+}
+
+type fakePrizeNotifier struct {
+	fail bool
+	sent map[string][]uint64 // received prize IDs per notification type
+}
+
+func (n *fakePrizeNotifier) SendNotification(ctx context.Context, userID uint64, notificationType, title, message string, data map[string]string, sendSMS, sendEmail bool) error {
+	if n.fail {
+		return errors.New("notifications-service unavailable")
+	}
+	if n.sent == nil {
+		n.sent = make(map[string][]uint64)
+	}
+	id, _ := strconv.ParseUint(data["received_prize_id"], 10, 64)
+	n.sent[notificationType] = append(n.sent[notificationType], id)
+	return nil
+}
+
+func TestPrizeEscrowService_Run(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+	repo := &fakePrizeEscrowRepository{
+		pool: map[string]float64{},
+		prizes: []*models.ReceivedPrize{
+			{ID: 1, UserID: 7, Status: models.PrizeStatusPending, PSC: 1000, ExpiresAt: at(-time.Minute)},
+			{ID: 2, UserID: 7, Status: models.PrizeStatusPending, PSC: 500, ExpiresAt: at(24 * time.Hour)},
+			{ID: 3, UserID: 8, Status: models.PrizeStatusPending, PSC: 500, ExpiresAt: at(10 * 24 * time.Hour)},
+			{ID: 4, UserID: 8, Status: models.PrizeStatusClaimed, PSC: 500, ExpiresAt: at(-time.Hour)},
+			{ID: 5, UserID: 9, Status: models.PrizeStatusPending, PSC: 500},
+		},
+	}
+	notifier := &fakePrizeNotifier{}
+	svc := NewPrizeEscrowService(repo, notifier, 0, 0, logger.NewLogger("test"))
+	svc.now = func() time.Time { return now }
+
+	expired, reminded, err := svc.Run(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+	assert.Equal(t, 1, reminded)
+	assert.Equal(t, models.PrizeStatusExpired, repo.prizes[0].Status)
+	assert.Equal(t, 1000.0, repo.pool[models.PrizePoolAssetPSC], "expired prizes return to the pool")
+	assert.Equal(t, []uint64{1}, notifier.sent["dynasty_prize_expired"])
+	assert.Equal(t, []uint64{2}, notifier.sent["dynasty_prize_reminder"])
+
+	// Owners are reminded once
+	_, reminded, err = svc.Run(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, reminded)
+}
+
+func TestPrizeEscrowService_RetriesFailedReminders(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+	repo := &fakePrizeEscrowRepository{
+		pool:   map[string]float64{},
+		prizes: []*models.ReceivedPrize{{ID: 1, UserID: 7, Status: models.PrizeStatusPending, ExpiresAt: &expiresAt}},
+	}
+	notifier := &fakePrizeNotifier{fail: true}
+	svc := NewPrizeEscrowService(repo, notifier, 0, 0, logger.NewLogger("test"))
+
+	_, reminded, err := svc.Run(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, reminded)
+	assert.Nil(t, repo.prizes[0].RemindedAt)
+
+	notifier.fail = false
+	_, reminded, err = svc.Run(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, reminded)
+
+	// Without a notifier prizes still expire
+	svc = NewPrizeEscrowService(repo, nil, 0, 0, logger.NewLogger("test"))
+	svc.now = func() time.Time { return now.Add(2 * time.Hour) }
+	expired, _, err := svc.Run(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+}
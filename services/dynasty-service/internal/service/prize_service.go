@@ -2,18 +2,42 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"time"
 
 	"metargb/dynasty-service/internal/models"
 	"metargb/dynasty-service/internal/repository"
 )
 
+var (
+	ErrPrizeExpired           = errors.New("prize claim window has ended")
+	ErrPrizeAlreadyClaimed    = errors.New("prize already claimed")
+	ErrPrizeWalletUnavailable = errors.New("wallet service is unavailable")
+)
+
+// PrizeWallet credits claimed prizes, implemented by client.CommercialClient
+type PrizeWallet interface {
+	GetPSCRate(ctx context.Context) (float64, error)
+	IncrementWalletPSC(ctx context.Context, userID uint64, amount float64) error
+	IncrementSatisfaction(ctx context.Context, userID uint64, amount float64) error
+}
+
 type PrizeService struct {
 	prizeRepo *repository.PrizeRepository
+	wallet    PrizeWallet
+	now       func() time.Time
 }
 
 func NewPrizeService(prizeRepo *repository.PrizeRepository) *PrizeService {
-	return &PrizeService{prizeRepo: prizeRepo}
+	return &PrizeService{prizeRepo: prizeRepo, now: time.Now}
+}
+
+// SetWallet sets the wallet claimed prizes are credited to. Without one
+// prizes cannot be claimed.
+func (s *PrizeService) SetWallet(wallet PrizeWallet) {
+	s.wallet = wallet
 }
 
 // GetAllPrizes retrieves all dynasty prizes
@@ -33,7 +57,8 @@ func (s *PrizeService) GetPrize(ctx context.Context, prizeID uint64) (*models.Dy
 	return prize, nil
 }
 
-// ClaimPrize allows a user to claim a dynasty prize
+// ClaimPrize releases a received prize from escrow and credits its psc and
+// satisfaction to the user's wallet
 func (s *PrizeService) ClaimPrize(ctx context.Context, prizeID, userID uint64) error {
 	// Get received prize (this is the ID of received_prizes table)
 	receivedPrize, err := s.prizeRepo.GetReceivedPrize(ctx, prizeID)
@@ -49,23 +74,123 @@ func (s *PrizeService) ClaimPrize(ctx context.Context, prizeID, userID uint64) e
 		return fmt.Errorf("unauthorized: prize does not belong to user")
 	}
 
-	// TODO: Update wallet and variables via commercial service
-	// For now, just delete the received prize record
-	if err := s.prizeRepo.DeleteReceivedPrize(ctx, prizeID); err != nil {
-		return fmt.Errorf("failed to delete received prize: %w", err)
+	switch {
+	case receivedPrize.Status == models.PrizeStatusClaimed:
+		return ErrPrizeAlreadyClaimed
+	case !receivedPrize.Claimable(s.now()):
+		return ErrPrizeExpired
+	}
+
+	if s.wallet == nil {
+		return ErrPrizeWalletUnavailable
+	}
+	rate, err := s.wallet.GetPSCRate(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrPrizeWalletUnavailable, err)
+	}
+
+	return s.claim(ctx, receivedPrize, rate)
+}
+
+// ClaimAllPrizes claims every unexpired prize of the user and returns the
+// claimed prizes. When the wallet fails part way, the prizes claimed so far
+// are returned and the rest stay pending.
+func (s *PrizeService) ClaimAllPrizes(ctx context.Context, userID uint64) ([]*models.ReceivedPrize, error) {
+	if s.wallet == nil {
+		return nil, ErrPrizeWalletUnavailable
+	}
+
+	prizes, err := s.prizeRepo.GetUserReceivedPrizes(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user prizes: %w", err)
 	}
 
+	now := s.now()
+	var claimable []*models.ReceivedPrize
+	for _, prize := range prizes {
+		if prize.Claimable(now) {
+			claimable = append(claimable, prize)
+		}
+	}
+	if len(claimable) == 0 {
+		return nil, nil
+	}
+
+	rate, err := s.wallet.GetPSCRate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPrizeWalletUnavailable, err)
+	}
+
+	var claimed []*models.ReceivedPrize
+	for _, prize := range claimable {
+		err := s.claim(ctx, prize, rate)
+		if errors.Is(err, ErrPrizeAlreadyClaimed) {
+			// Claimed or expired concurrently
+			continue
+		}
+		if err != nil {
+			if len(claimed) > 0 {
+				return claimed, nil
+			}
+			return nil, err
+		}
+		claimed = append(claimed, prize)
+	}
+
+	return claimed, nil
+}
+
+// claim settles the prize and credits the wallet. The prize is settled
+// first so concurrent claims cannot pay it twice, and returns to escrow when
+// the psc credit fails.
+func (s *PrizeService) claim(ctx context.Context, prize *models.ReceivedPrize, rate float64) error {
+	now := s.now()
+	claimed, err := s.prizeRepo.ClaimReceivedPrize(ctx, prize.ID, prize.UserID, now)
+	if err != nil {
+		return fmt.Errorf("failed to claim prize: %w", err)
+	}
+	if !claimed {
+		return ErrPrizeAlreadyClaimed
+	}
+
+	if prize.PSC > 0 {
+		if err := s.wallet.IncrementWalletPSC(ctx, prize.UserID, float64(prize.PSC)/rate); err != nil {
+			if reopenErr := s.prizeRepo.ReopenReceivedPrize(ctx, prize.ID); reopenErr != nil {
+				log.Printf("Error: prize %d was claimed but not credited and could not be reopened: %v", prize.ID, reopenErr)
+			}
+			return fmt.Errorf("%w: %v", ErrPrizeWalletUnavailable, err)
+		}
+	}
+
+	// The psc is paid by now, so reopening the prize would pay it twice
+	if prize.Satisfaction > 0 {
+		if err := s.wallet.IncrementSatisfaction(ctx, prize.UserID, prize.Satisfaction); err != nil {
+			log.Printf("Error: prize %d was claimed but its satisfaction %.2f was not credited to user %d: %v", prize.ID, prize.Satisfaction, prize.UserID, err)
+		}
+	}
+
+	prize.Status = models.PrizeStatusClaimed
+	prize.SettledAt = &now
 	return nil
 }
 
-// GetUserReceivedPrizes retrieves all received prizes for a user
+// GetUserReceivedPrizes retrieves the prizes a user can still claim
 func (s *PrizeService) GetUserReceivedPrizes(ctx context.Context, userID uint64, page, perPage int32) ([]*models.ReceivedPrize, int32, error) {
 	// Get all prizes for user
-	prizes, err := s.prizeRepo.GetUserReceivedPrizes(ctx, userID)
+	all, err := s.prizeRepo.GetUserReceivedPrizes(ctx, userID)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get user prizes: %w", err)
 	}
 
+	// Expired prizes wait for the escrow job but can no longer be claimed
+	now := s.now()
+	prizes := make([]*models.ReceivedPrize, 0, len(all))
+	for _, prize := range all {
+		if prize.Claimable(now) {
+			prizes = append(prizes, prize)
+		}
+	}
+
 	total := int32(len(prizes))
 
 	// Simple pagination
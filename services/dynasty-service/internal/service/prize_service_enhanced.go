@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"metargb/dynasty-service/internal/models"
 	"metargb/dynasty-service/internal/repository"
@@ -40,7 +41,7 @@ func (s *PrizeServiceEnhanced) AwardPrize(
 	}
 
 	// Create received prize record
-	if err := s.prizeRepo.AwardPrize(ctx, userID, prize.ID, message); err != nil {
+	if err := s.prizeRepo.AwardPrize(ctx, userID, prize, message); err != nil {
 		return fmt.Errorf("failed to award prize: %w", err)
 	}
 
@@ -119,9 +120,13 @@ func (s *PrizeServiceEnhanced) ClaimPrize(
 		}
 	*/
 
-	// 5. Delete claimed prize
-	if err := s.prizeRepo.DeleteReceivedPrize(ctx, receivedPrizeID); err != nil {
-		return fmt.Errorf("failed to delete received prize: %w", err)
+	// 5. Release the prize from escrow so it cannot be claimed again
+	claimed, err := s.prizeRepo.ClaimReceivedPrize(ctx, receivedPrizeID, userID, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to claim received prize: %w", err)
+	}
+	if !claimed {
+		return fmt.Errorf("received prize already claimed or expired")
 	}
 
 	return nil
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -9,9 +10,89 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"metargb/dynasty-service/internal/models"
 	"metargb/dynasty-service/internal/repository"
 )
 
+// receivedPrizeRows returns the columns received prize queries select
+func receivedPrizeRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"rp.id", "rp.user_id", "rp.prize_id", "rp.message", "rp.status", "rp.psc", "rp.satisfaction",
+		"rp.expires_at", "rp.reminded_at", "rp.settled_at", "rp.created_at", "rp.updated_at",
+		"dp.id", "dp.member", "dp.satisfaction", "dp.introduction_profit_increase",
+		"dp.accumulated_capital_reserve", "dp.data_storage", "dp.psc",
+	})
+}
+
+func addReceivedPrizeRow(rows *sqlmock.Rows, id, userID uint64, status string, expiresAt time.Time) *sqlmock.Rows {
+	return rows.AddRow(id, userID, 1, "Congratulations!", status, 1000, 0.1,
+		expiresAt, nil, nil, time.Now(), time.Now(),
+		1, "offspring", 0.1, 0.05, 0.02, 0.03, 1000)
+}
+
+// expectPrizeAward expects the escrow transaction awarding the user a 1000
+// psc, 0.1 satisfaction prize
+func expectPrizeAward(mock sqlmock.Sqlmock, userID uint64) {
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO received_prizes").
+		WithArgs(userID, 1, sqlmock.AnyArg(), models.PrizeStatusPending, 1000, 0.1, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO dynasty_prize_pool").
+		WithArgs(models.PrizePoolAssetPSC, -1000.0, 1000.0).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO dynasty_prize_pool").
+		WithArgs(models.PrizePoolAssetSatisfaction, -0.1, 0.1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+}
+
+// expectPrizeClaim expects the claim transaction of a 1000 psc, 0.1
+// satisfaction prize
+func expectPrizeClaim(mock sqlmock.Sqlmock, receivedPrizeID, userID uint64) {
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT psc, satisfaction FROM received_prizes").
+		WithArgs(receivedPrizeID, userID, models.PrizeStatusPending, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"psc", "satisfaction"}).AddRow(1000, 0.1))
+	mock.ExpectExec("UPDATE received_prizes SET status").
+		WithArgs(models.PrizeStatusClaimed, sqlmock.AnyArg(), sqlmock.AnyArg(), receivedPrizeID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO dynasty_prize_pool").
+		WithArgs(models.PrizePoolAssetPSC, 0.0, -1000.0).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO dynasty_prize_pool").
+		WithArgs(models.PrizePoolAssetSatisfaction, 0.0, -0.1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+}
+
+type fakePrizeWallet struct {
+	rate         float64
+	pscErr       error
+	psc          map[uint64]float64
+	satisfaction map[uint64]float64
+}
+
+func newFakePrizeWallet() *fakePrizeWallet {
+	return &fakePrizeWallet{rate: 10, psc: make(map[uint64]float64), satisfaction: make(map[uint64]float64)}
+}
+
+func (w *fakePrizeWallet) GetPSCRate(ctx context.Context) (float64, error) {
+	return w.rate, nil
+}
+
+func (w *fakePrizeWallet) IncrementWalletPSC(ctx context.Context, userID uint64, amount float64) error {
+	if w.pscErr != nil {
+		return w.pscErr
+	}
+	w.psc[userID] += amount
+	return nil
+}
+
+func (w *fakePrizeWallet) IncrementSatisfaction(ctx context.Context, userID uint64, amount float64) error {
+	w.satisfaction[userID] += amount
+	return nil
+}
+
 func TestPrizeService_GetUserReceivedPrizes(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -24,10 +105,12 @@ func TestPrizeService_GetUserReceivedPrizes(t *testing.T) {
 	userID := uint64(1)
 
 	t.Run("Success", func(t *testing.T) {
+		rows := addReceivedPrizeRow(receivedPrizeRows(), 1, userID, models.PrizeStatusPending, time.Now().Add(time.Hour))
+		// Expired prizes the escrow job has not settled yet are left out
+		rows = addReceivedPrizeRow(rows, 2, userID, models.PrizeStatusPending, time.Now().Add(-time.Hour))
 		mock.ExpectQuery("SELECT rp.id, rp.user_id").
-			WithArgs(userID).
-			WillReturnRows(sqlmock.NewRows([]string{"rp.id", "rp.user_id", "rp.prize_id", "rp.message", "rp.created_at", "rp.updated_at", "dp.id", "dp.member", "dp.satisfaction", "dp.introduction_profit_increase", "dp.accumulated_capital_reserve", "dp.data_storage", "dp.psc"}).
-				AddRow(1, userID, 1, "Congratulations!", time.Now(), time.Now(), 1, "offspring", 0.1, 0.05, 0.02, 0.03, 1000))
+			WithArgs(userID, models.PrizeStatusPending).
+			WillReturnRows(rows)
 
 		prizes, total, err := service.GetUserReceivedPrizes(ctx, userID, 1, 10)
 		require.NoError(t, err)
@@ -35,6 +118,8 @@ func TestPrizeService_GetUserReceivedPrizes(t *testing.T) {
 		assert.Len(t, prizes, 1)
 		if len(prizes) > 0 {
 			assert.Equal(t, userID, prizes[0].UserID)
+			assert.Equal(t, int64(1000), prizes[0].PSC)
+			assert.NotNil(t, prizes[0].ExpiresAt)
 			assert.NotNil(t, prizes[0].Prize)
 		}
 	})
@@ -49,6 +134,8 @@ func TestPrizeService_ClaimPrize(t *testing.T) {
 
 	prizeRepo := repository.NewPrizeRepository(db)
 	service := NewPrizeService(prizeRepo)
+	wallet := newFakePrizeWallet()
+	service.SetWallet(wallet)
 
 	ctx := context.Background()
 	receivedPrizeID := uint64(1)
@@ -58,29 +145,110 @@ func TestPrizeService_ClaimPrize(t *testing.T) {
 		// Get received prize
 		mock.ExpectQuery("SELECT rp.id, rp.user_id").
 			WithArgs(receivedPrizeID).
-			WillReturnRows(sqlmock.NewRows([]string{"rp.id", "rp.user_id", "rp.prize_id", "rp.message", "rp.created_at", "rp.updated_at", "dp.member", "dp.satisfaction", "dp.introduction_profit_increase", "dp.accumulated_capital_reserve", "dp.data_storage", "dp.psc"}).
-				AddRow(receivedPrizeID, userID, 1, "Congratulations!", time.Now(), time.Now(), "offspring", 0.1, 0.05, 0.02, 0.03, 1000))
+			WillReturnRows(addReceivedPrizeRow(receivedPrizeRows(), receivedPrizeID, userID, models.PrizeStatusPending, time.Now().Add(time.Hour)))
 
-		// Delete received prize
-		mock.ExpectExec("DELETE FROM received_prizes").
-			WithArgs(receivedPrizeID).
-			WillReturnResult(sqlmock.NewResult(0, 1))
+		// Release it from escrow
+		expectPrizeClaim(mock, receivedPrizeID, userID)
 
 		err := service.ClaimPrize(ctx, receivedPrizeID, userID)
 		require.NoError(t, err)
+		assert.Equal(t, 100.0, wallet.psc[userID], "psc is divided by the psc rate")
+		assert.Equal(t, 0.1, wallet.satisfaction[userID])
 	})
 
 	t.Run("Unauthorized", func(t *testing.T) {
 		otherUserID := uint64(2)
 		mock.ExpectQuery("SELECT rp.id, rp.user_id").
 			WithArgs(receivedPrizeID).
-			WillReturnRows(sqlmock.NewRows([]string{"rp.id", "rp.user_id", "rp.prize_id", "rp.message", "rp.created_at", "rp.updated_at", "dp.member", "dp.satisfaction", "dp.introduction_profit_increase", "dp.accumulated_capital_reserve", "dp.data_storage", "dp.psc"}).
-				AddRow(receivedPrizeID, userID, 1, "Congratulations!", time.Now(), time.Now(), "offspring", 0.1, 0.05, 0.02, 0.03, 1000))
+			WillReturnRows(addReceivedPrizeRow(receivedPrizeRows(), receivedPrizeID, userID, models.PrizeStatusPending, time.Now().Add(time.Hour)))
 
 		err := service.ClaimPrize(ctx, receivedPrizeID, otherUserID)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "unauthorized")
 	})
 
+	t.Run("Expired", func(t *testing.T) {
+		mock.ExpectQuery("SELECT rp.id, rp.user_id").
+			WithArgs(receivedPrizeID).
+			WillReturnRows(addReceivedPrizeRow(receivedPrizeRows(), receivedPrizeID, userID, models.PrizeStatusPending, time.Now().Add(-time.Minute)))
+
+		err := service.ClaimPrize(ctx, receivedPrizeID, userID)
+		assert.ErrorIs(t, err, ErrPrizeExpired)
+	})
+
+	t.Run("WalletFailureReturnsPrizeToEscrow", func(t *testing.T) {
+		wallet.pscErr = errors.New("commercial-service unavailable")
+		defer func() { wallet.pscErr = nil }()
+
+		mock.ExpectQuery("SELECT rp.id, rp.user_id").
+			WithArgs(receivedPrizeID).
+			WillReturnRows(addReceivedPrizeRow(receivedPrizeRows(), receivedPrizeID, userID, models.PrizeStatusPending, time.Now().Add(time.Hour)))
+		expectPrizeClaim(mock, receivedPrizeID, userID)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT psc, satisfaction FROM received_prizes").
+			WithArgs(receivedPrizeID, models.PrizeStatusClaimed).
+			WillReturnRows(sqlmock.NewRows([]string{"psc", "satisfaction"}).AddRow(1000, 0.1))
+		mock.ExpectExec("UPDATE received_prizes SET status").
+			WithArgs(models.PrizeStatusPending, receivedPrizeID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO dynasty_prize_pool").
+			WithArgs(models.PrizePoolAssetPSC, 0.0, 1000.0).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO dynasty_prize_pool").
+			WithArgs(models.PrizePoolAssetSatisfaction, 0.0, 0.1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := service.ClaimPrize(ctx, receivedPrizeID, userID)
+		assert.ErrorIs(t, err, ErrPrizeWalletUnavailable)
+	})
+
+	t.Run("WithoutWallet", func(t *testing.T) {
+		mock.ExpectQuery("SELECT rp.id, rp.user_id").
+			WithArgs(receivedPrizeID).
+			WillReturnRows(addReceivedPrizeRow(receivedPrizeRows(), receivedPrizeID, userID, models.PrizeStatusPending, time.Now().Add(time.Hour)))
+
+		err := NewPrizeService(prizeRepo).ClaimPrize(ctx, receivedPrizeID, userID)
+		assert.ErrorIs(t, err, ErrPrizeWalletUnavailable)
+	})
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPrizeService_ClaimAllPrizes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	service := NewPrizeService(repository.NewPrizeRepository(db))
+	wallet := newFakePrizeWallet()
+	service.SetWallet(wallet)
+
+	ctx := context.Background()
+	userID := uint64(1)
+
+	rows := addReceivedPrizeRow(receivedPrizeRows(), 1, userID, models.PrizeStatusPending, time.Now().Add(time.Hour))
+	rows = addReceivedPrizeRow(rows, 2, userID, models.PrizeStatusPending, time.Now().Add(-time.Hour))
+	rows = addReceivedPrizeRow(rows, 3, userID, models.PrizeStatusPending, time.Now().Add(time.Hour))
+	mock.ExpectQuery("SELECT rp.id, rp.user_id").
+		WithArgs(userID, models.PrizeStatusPending).
+		WillReturnRows(rows)
+
+	expectPrizeClaim(mock, 1, userID)
+	// Prize 3 was claimed concurrently and is skipped
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT psc, satisfaction FROM received_prizes").
+		WithArgs(uint64(3), userID, models.PrizeStatusPending, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"psc", "satisfaction"}))
+	mock.ExpectRollback()
+
+	claimed, err := service.ClaimAllPrizes(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, uint64(1), claimed[0].ID)
+	assert.Equal(t, models.PrizeStatusClaimed, claimed[0].Status)
+	assert.Equal(t, 100.0, wallet.psc[userID])
+
 	require.NoError(t, mock.ExpectationsWereMet())
 }
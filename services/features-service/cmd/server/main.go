@@ -3,27 +3,46 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
 	"metargb/features-service/internal/client"
 	"metargb/features-service/internal/handler"
+	"metargb/features-service/internal/pubsub"
 	"metargb/features-service/internal/repository"
 	"metargb/features-service/internal/service"
 	"metargb/features-service/pkg/threed_client"
 	pb "metargb/shared/pb/features"
+	statspb "metargb/shared/pb/stats"
 	"metargb/shared/pkg/auth"
+	"metargb/shared/pkg/buildinfo"
 	"metargb/shared/pkg/db"
+	"metargb/shared/pkg/dbsplit"
+	"metargb/shared/pkg/deadline"
+	"metargb/shared/pkg/debug"
+	"metargb/shared/pkg/devmode"
+	"metargb/shared/pkg/faultinject"
+	healthprobe "metargb/shared/pkg/health"
+	"metargb/shared/pkg/jobs"
 	"metargb/shared/pkg/logger"
 	"metargb/shared/pkg/metrics"
+	"metargb/shared/pkg/msgsize"
+	"metargb/shared/pkg/usercache"
+	"metargb/shared/pkg/variables"
 
 	_ "github.com/go-sql-driver/mysql"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 func main() {
@@ -31,15 +50,20 @@ func main() {
 	log := logger.NewLogger("features-service")
 	log.Info("Starting Features Service...")
 
+	// SIGHUP toggles debug logging; FEATURES_LOG_ADMIN_ADDR serves the level over HTTP
+	logCtx, stopLogControl := context.WithCancel(context.Background())
+	defer stopLogControl()
+	log.EnableLevelControl(logCtx)
+
 	// Load configuration from environment
 	// Construct DSN from individual environment variables
-	dbDSN := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
+	dbDSN := db.ServiceDSN("features-service", fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
 		getEnv("DB_USER", "metargb_user"),
 		getEnv("DB_PASSWORD", "metargb_password"),
 		getEnv("DB_HOST", "mysql"),
 		getEnv("DB_PORT", "3306"),
 		getEnv("DB_DATABASE", "metargb_db"),
-	)
+	))
 	port := getEnv("GRPC_PORT", "50053")
 	metricsPort := getEnv("METRICS_PORT", "9090")
 	threeDMetaURL := getEnv("THREE_D_META_URL", "http://3d-meta-api")
@@ -51,8 +75,8 @@ func main() {
 	}
 	defer database.Close()
 
-	// Test database connection
-	if err := database.Ping(); err != nil {
+	// Wait for the database instead of crash looping while it is unavailable
+	if err := db.PingWithRetry(context.Background(), database, db.RetryPolicyFromEnv()); err != nil {
 		log.Fatal("Failed to ping database", "error", err)
 	}
 
@@ -77,24 +101,61 @@ func main() {
 	propertiesRepo := repository.NewPropertiesRepository(database)
 	geometryRepo := repository.NewGeometryRepository(database)
 	tradeRepo := repository.NewTradeRepository(database)
+	tradeReceiptRepo := repository.NewTradeReceiptRepository(database)
 	buyRequestRepo := repository.NewBuyRequestRepository(database)
 	sellRequestRepo := repository.NewSellRequestRepository(database)
 	hourlyProfitRepo := repository.NewHourlyProfitRepository(database)
+	profitSettingsRepo := repository.NewProfitSettingsRepository(database)
+	systemVariableRepo := repository.NewSystemVariableRepository(database)
 	buildingRepo := repository.NewBuildingRepository(database)
 	imageRepo := repository.NewImageRepository(database)
 	lockedAssetRepo := repository.NewLockedAssetRepository(database)
 	featureLimitRepo := repository.NewFeatureLimitRepository(database)
 	mapRepo := repository.NewMapRepository(database)
+	watchlistRepo := repository.NewWatchlistRepository(database)
+	listingRepo := repository.NewListingRepository(database)
+	portfolioRepo := repository.NewPortfolioRepository(database)
+	featureHistoryRepo := repository.NewFeatureHistoryRepository(database)
+	tradeSummaryRepo := repository.NewTradeSummaryRepository(database)
+	savedSearchRepo := repository.NewSavedSearchRepository(database)
 
 	// Initialize 3D client
 	threeDClient := threed_client.New(threeDMetaURL)
 
-	// Initialize commercial client for wallet operations
+	// Initialize commercial client for wallet operations. Without it balances
+	// are neither checked nor charged, so outside development the service
+	// refuses to start unless COMMERCIAL_SERVICE_REQUIRED=false.
 	commercialServiceAddr := getEnv("COMMERCIAL_SERVICE_ADDR", "commercial-service:50052")
-	commercialClient, err := client.NewCommercialClient(commercialServiceAddr)
+	commercialRequired := !devmode.Enabled()
+	if v := getEnv("COMMERCIAL_SERVICE_REQUIRED", ""); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			commercialRequired = b
+		} else {
+			log.Warn("Invalid COMMERCIAL_SERVICE_REQUIRED, using default", "value", v, "default", commercialRequired)
+		}
+	}
+	commercialRetry := db.DefaultRetryPolicy()
+	commercialRetry.MaxAttempts = 10
+	if v := getEnv("COMMERCIAL_CONNECT_MAX_ATTEMPTS", ""); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			commercialRetry.MaxAttempts = n
+		} else {
+			log.Warn("Invalid COMMERCIAL_CONNECT_MAX_ATTEMPTS, using default", "value", v, "default", commercialRetry.MaxAttempts)
+		}
+	}
+	if v := getEnv("COMMERCIAL_CONNECT_INITIAL_BACKOFF", ""); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			commercialRetry.InitialBackoff = d
+		} else {
+			log.Warn("Invalid COMMERCIAL_CONNECT_INITIAL_BACKOFF, using default", "value", v, "default", commercialRetry.InitialBackoff)
+		}
+	}
+	commercialClient, err := client.ConnectCommercialAtStartup(context.Background(), commercialServiceAddr, commercialRetry, commercialRequired)
 	if err != nil {
-		log.Warn("Failed to connect to commercial service - marketplace features disabled", "error", err)
-		commercialClient = nil
+		log.Fatal("Failed to connect to commercial service", "addr", commercialServiceAddr, "error", err)
+	}
+	if commercialClient == nil {
+		log.Warn("Commercial service not connected - balances will not be checked or charged", "addr", commercialServiceAddr)
 	} else {
 		log.Info("Connected to commercial service", "addr", commercialServiceAddr)
 		defer commercialClient.Close()
@@ -111,10 +172,28 @@ func main() {
 		defer notificationClient.Close()
 	}
 
+	// Connect to auth service for token validation and user snapshots
+	authServiceAddr := getEnv("AUTH_SERVICE_ADDR", "auth-service:50051")
+	authConn, err := grpc.Dial(authServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Warn("Failed to connect to auth service - authentication disabled", "error", err)
+	} else {
+		defer authConn.Close()
+		log.Info("Connected to auth service", "addr", authServiceAddr)
+	}
+
+	// User names, codes and birthdates come from auth-service instead of the users tables
+	var userCache *usercache.Cache
+	if authConn != nil {
+		userCache = usercache.NewFromConn(authConn, usercache.DefaultTTL)
+	}
+
 	// Initialize pricing service
 	pricingService := service.NewFeaturePricingService(
 		featureRepo,
 		propertiesRepo,
+		commercialClient,
+		userCache,
 		database,
 		log,
 	)
@@ -129,6 +208,7 @@ func main() {
 		tradeRepo,
 		hourlyProfitRepo,
 		pricingService,
+		userCache,
 		database,
 	)
 
@@ -143,18 +223,122 @@ func main() {
 		lockedAssetRepo,
 		hourlyProfitRepo,
 		featureLimitRepo,
+		systemVariableRepo,
 		commercialClient,
 		notificationClient,
+		userCache,
 		database,
 		log,
 	)
 
+	// Checkout reservations hold a feature in Redis while the 3D client
+	// confirms a purchase. Without Redis purchases are not held.
+	checkoutRedis, err := pubsub.NewRedisClient(redisURL())
+	if err != nil {
+		log.Warn("Failed to connect to Redis - checkout reservations disabled", "error", err)
+	} else {
+		defer checkoutRedis.Close()
+		checkoutTTL := service.DefaultCheckoutReservationTTL
+		if v := getEnv("CHECKOUT_RESERVATION_TTL", ""); v != "" {
+			if d, err := time.ParseDuration(v); err == nil && d > 0 {
+				checkoutTTL = d
+			} else {
+				log.Warn("Invalid CHECKOUT_RESERVATION_TTL, using default", "value", v, "default", checkoutTTL)
+			}
+		}
+		marketplaceService.SetCheckoutReservations(repository.NewCheckoutReservationRepository(checkoutRedis), checkoutTTL)
+	}
+
+	// Subscription plans raise how many features a user may own above the
+	// free limit. MAX_OWNED_FEATURES=0 leaves free users unlimited. Plans are
+	// read with an API key holding the service:entitlements scope.
+	serviceAPIKey := getEnv(auth.ServiceAPIKeyEnv, "")
+	if serviceAPIKey == "" {
+		log.Warn("SERVICE_API_KEY is not set - commercial service will reject entitlement lookups")
+	}
+	commercialConn, err := grpc.Dial(commercialServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), auth.WithServiceAPIKey(serviceAPIKey))
+	if err != nil {
+		log.Warn("Failed to connect to commercial service - ownership limits disabled", "error", err)
+	} else {
+		defer commercialConn.Close()
+		entitlementTTL := auth.DefaultEntitlementTTL
+		if v := getEnv("ENTITLEMENT_CACHE_TTL", ""); v != "" {
+			if d, err := time.ParseDuration(v); err == nil && d > 0 {
+				entitlementTTL = d
+			} else {
+				log.Warn("Invalid ENTITLEMENT_CACHE_TTL, using default", "value", v, "default", entitlementTTL)
+			}
+		}
+		freeLimit := 0
+		if v := getEnv("MAX_OWNED_FEATURES", ""); v != "" {
+			if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+				freeLimit = n
+			} else {
+				log.Warn("Invalid MAX_OWNED_FEATURES, using default", "value", v, "default", freeLimit)
+			}
+		}
+		marketplaceService.SetOwnershipLimit(auth.NewEntitlementCacheFromConn(commercialConn, entitlementTTL), freeLimit)
+	}
+
+	// Caps on top of the plan limit: per karbari, and per level of the buyer.
+	// levels-service is only needed when a level cap is set.
+	var ownershipCaps service.OwnershipCaps
+	if ownershipCaps.PerKarbari, err = service.ParseOwnershipCaps(getEnv("MAX_OWNED_FEATURES_PER_KARBARI", "")); err != nil {
+		log.Warn("Invalid MAX_OWNED_FEATURES_PER_KARBARI, karbari caps disabled", "error", err)
+	}
+	if ownershipCaps.PerLevel, err = service.ParseOwnershipCaps(getEnv("MAX_OWNED_FEATURES_PER_LEVEL", "")); err != nil {
+		log.Warn("Invalid MAX_OWNED_FEATURES_PER_LEVEL, level caps disabled", "error", err)
+	}
+	if !ownershipCaps.Empty() {
+		var levelResolver service.LevelResolver
+		if len(ownershipCaps.PerLevel) > 0 {
+			levelsClient, err := client.NewLevelsClient(getEnv("LEVELS_SERVICE_ADDR", "levels-service:50054"))
+			if err != nil {
+				log.Warn("Failed to connect to levels service - level caps disabled", "error", err)
+			} else {
+				defer levelsClient.Close()
+				levelResolver = levelsClient
+			}
+		}
+		marketplaceService.SetLimits(service.NewLimitsService(featureRepo, levelResolver, ownershipCaps, log))
+	}
+
+	// A higher buy request shortly before a competing grace period ends
+	// extends it. Without GRACE_PERIOD_EXTENSION_RULES nothing is extended.
+	gracePeriodRules, err := service.ParseGracePeriodRules(getEnv("GRACE_PERIOD_EXTENSION_RULES", ""))
+	if err != nil {
+		log.Warn("Invalid GRACE_PERIOD_EXTENSION_RULES, grace periods will not be extended", "error", err)
+		gracePeriodRules = nil
+	}
+	maxGracePeriodExtensions := 0
+	if v := getEnv("GRACE_PERIOD_MAX_EXTENSIONS", ""); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			maxGracePeriodExtensions = n
+		} else {
+			log.Warn("Invalid GRACE_PERIOD_MAX_EXTENSIONS, using default", "value", v, "default", maxGracePeriodExtensions)
+		}
+	}
+	marketplaceService.SetGracePeriodRules(service.GracePeriodRules{
+		Rules:         gracePeriodRules,
+		MaxExtensions: maxGracePeriodExtensions,
+	})
+
+	if v := getEnv("BUY_REQUEST_MAX_COUNTER_OFFERS", ""); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			marketplaceService.SetMaxCounterOffers(n)
+		} else {
+			log.Warn("Invalid BUY_REQUEST_MAX_COUNTER_OFFERS, using default", "value", v, "default", service.DefaultMaxCounterOffers)
+		}
+	}
+
 	profitService := service.NewProfitService(
 		hourlyProfitRepo,
 		featureRepo,
 		propertiesRepo,
+		profitSettingsRepo,
 		commercialClient,
 		notificationClient,
+		userCache,
 		database,
 		log,
 	)
@@ -172,29 +356,142 @@ func main() {
 		buildingService.SetCommercialClient(commercialClient)
 	}
 
+	// Inline model images and files are uploaded to storage-service so build
+	// packages carry URLs instead of base64 payloads
+	storageServiceAddr := getEnv("STORAGE_SERVICE_ADDR", "storage-service:50060")
+	// Feature gallery uploads are stored there as well
+	var imageUploader service.ImageUploader
+	storageClient, err := client.NewStorageClient(storageServiceAddr)
+	if err != nil {
+		log.Warn("Failed to connect to storage service - building model payloads stay inline and feature images cannot be uploaded", "error", err)
+	} else {
+		log.Info("Connected to storage service", "addr", storageServiceAddr)
+		defer storageClient.Close()
+		buildingService.SetStorageClient(storageClient)
+		imageUploader = storageClient
+	}
+	galleryService := service.NewGalleryService(imageRepo, featureRepo, imageUploader)
+	featureService.SetGalleryService(galleryService)
+
+	// Message size limits are configurable because build packages can be large
+	limits := msgsize.FromEnv("features-service", msgsize.Defaults())
+
 	mapService := service.NewMapService(
 		mapRepo,
 		featureRepo,
 	)
 
+	watchlistService := service.NewWatchlistService(watchlistRepo)
+	listingService := service.NewListingService(listingRepo)
+	savedSearchService := service.NewSavedSearchService(savedSearchRepo)
+
+	// Portfolio values need rates from commercial-service
+	var portfolioRates service.PortfolioRates
+	if commercialClient != nil {
+		portfolioRates = commercialClient
+	}
+	portfolioService := service.NewPortfolioService(portfolioRepo, portfolioRates)
+	featureHistoryService := service.NewFeatureHistoryService(featureHistoryRepo)
+	tradeSummaryService := service.NewTradeSummaryService(tradeSummaryRepo)
+
+	tradeService := service.NewTradeService(
+		tradeRepo,
+		tradeReceiptRepo,
+		featureRepo,
+		propertiesRepo,
+		commercialClient,
+		userCache,
+	)
+	tradeReceiptService := service.NewTradeReceiptService(tradeReceiptRepo, userCache)
+
+	// Watchers are alerted through notification-service when it is reachable
+	var watchlistNotifier service.WatchlistNotifier
+	if notificationClient != nil {
+		watchlistNotifier = notificationClient
+	}
+	watchlistInterval := service.DefaultWatchlistAlertInterval
+	if v := getEnv("WATCHLIST_ALERT_INTERVAL", ""); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			watchlistInterval = d
+		} else {
+			log.Warn("Invalid WATCHLIST_ALERT_INTERVAL, using default", "value", v, "default", watchlistInterval)
+		}
+	}
+	watchlistAlertWorker := service.NewWatchlistAlertWorker(watchlistRepo, watchlistNotifier, watchlistInterval, log)
+
+	// Saved search matches use the same notification-service connection
+	var savedSearchNotifier service.SavedSearchNotifier
+	if notificationClient != nil {
+		savedSearchNotifier = notificationClient
+	}
+	savedSearchInterval := service.DefaultSavedSearchInterval
+	if v := getEnv("SAVED_SEARCH_INTERVAL", ""); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			savedSearchInterval = d
+		} else {
+			log.Warn("Invalid SAVED_SEARCH_INTERVAL, using default", "value", v, "default", savedSearchInterval)
+		}
+	}
+	savedSearchWorker := service.NewSavedSearchWorker(savedSearchRepo, listingRepo, savedSearchNotifier, savedSearchInterval, log)
+
+	hourlyProfitInterval := service.DefaultHourlyProfitInterval
+	if v := getEnv("HOURLY_PROFIT_INTERVAL", ""); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			hourlyProfitInterval = d
+		} else {
+			log.Warn("Invalid HOURLY_PROFIT_INTERVAL, using default", "value", v, "default", hourlyProfitInterval)
+		}
+	}
+	hourlyProfitWorker := service.NewHourlyProfitWorker(profitService, hourlyProfitInterval, log)
+
+	gracePeriodExpiryInterval := service.DefaultGracePeriodExpiryInterval
+	if v := getEnv("GRACE_PERIOD_EXPIRY_INTERVAL", ""); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			gracePeriodExpiryInterval = d
+		} else {
+			log.Warn("Invalid GRACE_PERIOD_EXPIRY_INTERVAL, using default", "value", v, "default", gracePeriodExpiryInterval)
+		}
+	}
+	gracePeriodExpiryWorker := service.NewGracePeriodExpiryWorker(marketplaceService, gracePeriodExpiryInterval, log)
+
+	// Geometry edits are broadcast through Redis to the WebSocket gateway
+	var geometryPublisher service.GeometryPublisher
+	redisPublisher, err := pubsub.NewRedisPublisher(redisURL())
+	if err != nil {
+		log.Warn("Failed to connect to Redis - geometry edits will not be broadcast", "error", err)
+	} else {
+		defer redisPublisher.Close()
+		geometryPublisher = redisPublisher
+	}
+	// Only GEOMETRY_ADMIN_IDS may redraw feature polygons
+	geometryService := service.NewGeometryService(
+		geometryRepo,
+		geometryPublisher,
+		parseUserIDs(getEnv("GEOMETRY_ADMIN_IDS", ""), log),
+		getEnvAsFloat("GEOMETRY_MIN_AREA", service.DefaultGeometryMinArea, log),
+		getEnvAsFloat("GEOMETRY_MAX_AREA", service.DefaultGeometryMaxArea, log),
+		log,
+	)
+
 	// Initialize gRPC handlers
 	featureHandler := handler.NewFeatureHandler(featureService)
-	marketplaceHandler := handler.NewMarketplaceHandler(marketplaceService, geometryRepo, propertiesRepo, featureRepo)
+	marketplaceHandler := handler.NewMarketplaceHandler(marketplaceService, geometryRepo, propertiesRepo, featureRepo, listingService)
 	profitHandler := handler.NewProfitHandler(profitService)
-	buildingHandler := handler.NewBuildingHandler(buildingService)
+	buildingHandler := handler.NewBuildingHandler(buildingService, limits.MaxSend)
 	mapHandler := handler.NewMapHandler(mapService)
+	watchlistHandler := handler.NewWatchlistHandler(watchlistService)
+	portfolioHandler := handler.NewPortfolioHandler(portfolioService)
+	featureHistoryHandler := handler.NewFeatureHistoryHandler(featureHistoryService)
+	tradeSummaryHandler := handler.NewTradeSummaryHandler(tradeSummaryService)
+	savedSearchHandler := handler.NewSavedSearchHandler(savedSearchService)
+	tradeHandler := handler.NewTradeHandler(tradeService)
+	tradeReceiptHandler := handler.NewTradeReceiptHandler(tradeReceiptService)
+	geometryHandler := handler.NewGeometryHandler(geometryService)
+	galleryHandler := handler.NewGalleryHandler(galleryService)
+	installmentHandler := handler.NewInstallmentHandler(marketplaceService)
+	statsHandler := handler.NewStatsHandler(repository.NewStatsRepository(database))
 
 	// Initialize token validator for authentication
-	// Connect to auth service for token validation
-	authServiceAddr := getEnv("AUTH_SERVICE_ADDR", "auth-service:50051")
-	authConn, err := grpc.Dial(authServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		log.Warn("Failed to connect to auth service - authentication disabled", "error", err)
-	} else {
-		defer authConn.Close()
-		log.Info("Connected to auth service", "addr", authServiceAddr)
-	}
-
 	// Create token validator using auth service
 	var tokenValidator auth.TokenValidator
 	if authConn != nil {
@@ -210,34 +507,122 @@ func main() {
 		metrics.UnaryServerInterceptor(serviceMetrics),
 	}
 
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		logger.StreamServerInterceptor(log),
+		metrics.StreamServerInterceptor(serviceMetrics),
+	}
+
 	// Add auth interceptor if token validator is available
 	if tokenValidator != nil {
 		interceptors = append(interceptors, auth.UnaryServerInterceptor(tokenValidator))
+		streamInterceptors = append(streamInterceptors, auth.StreamServerInterceptor(tokenValidator))
 	}
 
-	grpcServer := grpc.NewServer(
+	serverOpts := append(limits.ServerOptions(),
 		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
 	)
 
+	// Cap how long handlers run so slow queries are cancelled, see deadline.FromEnv
+	serverOpts = append(serverOpts, deadline.FromEnv("features-service", deadline.Defaults()).ServerOptions()...)
+
+	// Staging builds inject latency and errors into chosen methods, see faultinject
+	faultCtx, stopFaults := context.WithCancel(context.Background())
+	defer stopFaults()
+	serverOpts = append(serverOpts, faultinject.FromEnv(faultCtx, "features-service", log)...)
+
+	// Outside development internal errors reach callers only as a reference, see devmode
+	serverOpts = append(serverOpts, devmode.FromEnv("features-service", log)...)
+
+	// Every response names the build that served it, see buildinfo
+	serverOpts = append(serverOpts, buildinfo.ServerOptions()...)
+	grpcServer := grpc.NewServer(serverOpts...)
+
+	// Report readiness over HTTP and through the standard gRPC health service:
+	// the database is reachable, its tables exist and the server has started
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	defer stopHealth()
+	dbMonitor := db.NewHealthMonitor(database, nil)
+	dbMonitor.Start(healthCtx)
+	probe := healthprobe.NewProbe("features-service").
+		AddCheck("database", dbMonitor.Check).
+		AddCheck("migrations", schemaGuard.RequireTables(dbsplit.Ownership["features-service"]...))
+	serving := probe.Starting("grpc")
+	probe.Watch(healthCtx, healthServer, healthprobe.DefaultInterval)
+	probeServer := healthprobe.NewServer(":"+getEnv("HEALTH_PORT", healthprobe.DefaultPort), probe)
+	go func() {
+		if err := probeServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Health probe server failed", "error", err)
+		}
+	}()
+
+	// Load the pricing limits into the system variable cache before taking traffic
+	cachesWarmed := probe.Starting("caches")
+	go func() {
+		defer cachesWarmed()
+		systemVariableRepo.GetPricingLimits(healthCtx)
+	}()
+
 	// Register services
 	pb.RegisterFeatureServiceServer(grpcServer, featureHandler)
 	pb.RegisterFeatureMarketplaceServiceServer(grpcServer, marketplaceHandler)
 	pb.RegisterFeatureProfitServiceServer(grpcServer, profitHandler)
 	pb.RegisterBuildingServiceServer(grpcServer, buildingHandler)
 	pb.RegisterMapsServiceServer(grpcServer, mapHandler)
-
-	// Enable reflection for debugging
-	reflection.Register(grpcServer)
-
-	// Metrics are exposed via Prometheus client library
-	// Start HTTP server for metrics endpoint if needed
+	pb.RegisterWatchlistServiceServer(grpcServer, watchlistHandler)
+	pb.RegisterFeaturePortfolioServiceServer(grpcServer, portfolioHandler)
+	pb.RegisterFeatureHistoryServiceServer(grpcServer, featureHistoryHandler)
+	pb.RegisterTradeSummaryServiceServer(grpcServer, tradeSummaryHandler)
+	pb.RegisterSavedSearchServiceServer(grpcServer, savedSearchHandler)
+	pb.RegisterTradeServiceServer(grpcServer, tradeHandler)
+	pb.RegisterTradeReceiptServiceServer(grpcServer, tradeReceiptHandler)
+	pb.RegisterFeatureGeometryServiceServer(grpcServer, geometryHandler)
+	pb.RegisterFeatureGalleryServiceServer(grpcServer, galleryHandler)
+	pb.RegisterFeatureInstallmentServiceServer(grpcServer, installmentHandler)
+	statspb.RegisterStatsServiceServer(grpcServer, statsHandler)
+
+	// Reflection is only registered in development, see devmode
+	devmode.Register(grpcServer, log)
+
+	// Profiling endpoints, off unless DEBUG_ADDR is set or in development, see debug
+	debug.Serve(context.Background(), "features-service", log)
+
+	// Serve Prometheus metrics, including the deadline and request counters
+	metricsServer := metrics.NewServer(":" + metricsPort)
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Metrics server failed", "error", err)
+		}
+	}()
 	log.Info("Metrics available on /metrics endpoint", "port", metricsPort)
 
-	// Start hourly profit calculator background job
+	// Start background workers
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	go profitService.StartHourlyProfitCalculator(ctx, log)
+	// Cached rates and pricing limits are dropped when an admin changes them
+	variableSubscriber, err := pubsub.NewVariableSubscriber(redisURL())
+	if err != nil {
+		log.Warn("Failed to connect to Redis - variable changes apply once caches expire", "error", err)
+	} else {
+		defer variableSubscriber.Close()
+		variableSubscriber.Subscribe(ctx, func(event variables.ChangedEvent) {
+			systemVariableRepo.Invalidate(event.Key)
+			if commercialClient != nil {
+				commercialClient.InvalidateVariable(event.Key)
+			}
+		})
+	}
+
+	// Background jobs are timed, counted and listed on jobs.Path
+	backgroundJobs := jobs.NewRunner("features-service", log)
+	hourlyProfitWorker.Register(backgroundJobs)
+	watchlistAlertWorker.Register(backgroundJobs)
+	savedSearchWorker.Register(backgroundJobs)
+	gracePeriodExpiryWorker.Register(backgroundJobs)
+	backgroundJobs.Start(ctx)
 
 	// Start gRPC server
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", port))
@@ -246,6 +631,7 @@ func main() {
 	}
 
 	log.Info("Features Service started", "port", port, "metrics_port", metricsPort)
+	serving()
 
 	// Graceful shutdown
 	go func() {
@@ -255,6 +641,10 @@ func main() {
 
 		log.Info("Shutting down gracefully...")
 		cancel() // Stop background jobs
+		probe.Drain()
+		healthServer.Shutdown()
+		metricsServer.Close()
+		probeServer.Close()
 		grpcServer.GracefulStop()
 		database.Close()
 		log.Info("Shutdown complete")
@@ -272,3 +662,47 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// redisURL returns REDIS_URL, or builds it from the individual REDIS_* settings
+func redisURL() string {
+	if url := getEnv("REDIS_URL", ""); url != "" {
+		return url
+	}
+	host := getEnv("REDIS_HOST", "localhost")
+	port := getEnv("REDIS_PORT", "6379")
+	database := getEnv("REDIS_DB", "0")
+	if password := getEnv("REDIS_PASSWORD", ""); password != "" {
+		return fmt.Sprintf("redis://:%s@%s:%s/%s", password, host, port, database)
+	}
+	return fmt.Sprintf("redis://%s:%s/%s", host, port, database)
+}
+
+func parseUserIDs(value string, log *logger.Logger) []uint64 {
+	var ids []uint64
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			log.Warn("Ignoring invalid user id", "value", part)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func getEnvAsFloat(key string, defaultValue float64, log *logger.Logger) float64 {
+	value := getEnv(key, "")
+	if value == "" {
+		return defaultValue
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.Warn("Invalid "+key+", using default", "value", value, "default", defaultValue)
+		return defaultValue
+	}
+	return f
+}
@@ -3,17 +3,26 @@ package client
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	pb "metargb/shared/pb/commercial"
+	"metargb/shared/pkg/db"
+	"metargb/shared/pkg/money"
+	"metargb/shared/pkg/variables"
 )
 
 // CommercialClient wraps gRPC clients for Commercial Service
 type CommercialClient struct {
 	walletClient      pb.WalletServiceClient
 	transactionClient pb.TransactionServiceClient
+	variableClient    pb.VariableServiceClient
+	variableCache     *variables.Cache
+	healthClient      healthpb.HealthClient
 	conn              *grpc.ClientConn
 }
 
@@ -23,6 +32,63 @@ func NewCommercialClient(address string) (*CommercialClient, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	return dialCommercialClient(ctx, address)
+}
+
+// ConnectCommercialClient connects like NewCommercialClient but waits until
+// commercial-service reports itself serving, retrying with the backoff of
+// policy until it does, the policy is exhausted or ctx is cancelled. Each
+// attempt may take policy.PingTimeout.
+func ConnectCommercialClient(ctx context.Context, address string, policy db.RetryPolicy) (*CommercialClient, error) {
+	var lastErr error
+	for attempt := 1; policy.MaxAttempts == 0 || attempt <= policy.MaxAttempts; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, policy.PingTimeout)
+		c, err := dialCommercialClient(attemptCtx, address)
+		if err == nil {
+			if err = c.Ping(attemptCtx); err != nil {
+				c.Close()
+			}
+		}
+		cancel()
+		if err == nil {
+			return c, nil
+		}
+		lastErr = err
+
+		if policy.MaxAttempts != 0 && attempt == policy.MaxAttempts {
+			break
+		}
+
+		delay := policy.Backoff(attempt)
+		log.Printf("Commercial service not reachable (attempt %d): %v; retrying in %s", attempt, lastErr, delay)
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("commercial service connection cancelled: %w", ctx.Err())
+		case <-time.After(delay):
+		}
+	}
+
+	return nil, fmt.Errorf("commercial service not reachable after %d attempts: %w", policy.MaxAttempts, lastErr)
+}
+
+// ConnectCommercialAtStartup connects with ConnectCommercialClient for a
+// starting service. When commercial-service is required its failure is
+// returned so the service can refuse to start; otherwise it is logged and a
+// nil client is returned, leaving balances neither checked nor charged.
+func ConnectCommercialAtStartup(ctx context.Context, address string, policy db.RetryPolicy, required bool) (*CommercialClient, error) {
+	c, err := ConnectCommercialClient(ctx, address, policy)
+	if err != nil {
+		if required {
+			return nil, err
+		}
+		log.Printf("Commercial service not reachable, continuing without it: %v", err)
+		return nil, nil
+	}
+	return c, nil
+}
+
+func dialCommercialClient(ctx context.Context, address string) (*CommercialClient, error) {
 	conn, err := grpc.DialContext(ctx, address,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithBlock(),
@@ -31,11 +97,15 @@ func NewCommercialClient(address string) (*CommercialClient, error) {
 		return nil, fmt.Errorf("failed to connect to commercial service at %s: %w", address, err)
 	}
 
-	return &CommercialClient{
+	c := &CommercialClient{
 		walletClient:      pb.NewWalletServiceClient(conn),
 		transactionClient: pb.NewTransactionServiceClient(conn),
+		variableClient:    pb.NewVariableServiceClient(conn),
+		healthClient:      healthpb.NewHealthClient(conn),
 		conn:              conn,
-	}, nil
+	}
+	c.variableCache = variables.NewCache(c.getVariables, variables.DefaultTTL)
+	return c, nil
 }
 
 // Close closes the gRPC connection
@@ -46,23 +116,37 @@ func (c *CommercialClient) Close() error {
 	return nil
 }
 
+// Ping fails unless commercial-service reports itself serving through the
+// standard gRPC health service
+func (c *CommercialClient) Ping(ctx context.Context) error {
+	resp, err := c.healthClient.Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to check commercial service health: %w", err)
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("commercial service is %s", resp.Status)
+	}
+	return nil
+}
+
 // UpdateWallet updates a user's wallet balance (add or deduct)
 // Positive amount = add, negative amount = deduct
-func (c *CommercialClient) UpdateWallet(ctx context.Context, userID uint64, asset string, amount float64) error {
-	if amount > 0 {
+func (c *CommercialClient) UpdateWallet(ctx context.Context, userID uint64, asset string, amount decimal.Decimal) error {
+	if amount.IsPositive() {
 		return c.AddBalance(ctx, userID, asset, amount)
-	} else if amount < 0 {
-		return c.DeductBalance(ctx, userID, asset, -amount) // Make positive for deduct
+	} else if amount.IsNegative() {
+		return c.DeductBalance(ctx, userID, asset, amount.Neg()) // Make positive for deduct
 	}
 	return nil // Zero amount, no-op
 }
 
 // AddBalance adds balance to a user's wallet
-func (c *CommercialClient) AddBalance(ctx context.Context, userID uint64, asset string, amount float64) error {
+func (c *CommercialClient) AddBalance(ctx context.Context, userID uint64, asset string, amount decimal.Decimal) error {
 	req := &pb.AddBalanceRequest{
-		UserId: userID,
-		Asset:  asset,
-		Amount: amount,
+		UserId:        userID,
+		Asset:         asset,
+		Amount:        money.Float(amount),
+		AmountDecimal: money.Format(amount),
 	}
 
 	resp, err := c.walletClient.AddBalance(ctx, req)
@@ -78,13 +162,28 @@ func (c *CommercialClient) AddBalance(ctx context.Context, userID uint64, asset
 }
 
 // DeductBalance deducts balance from a user's wallet
-func (c *CommercialClient) DeductBalance(ctx context.Context, userID uint64, asset string, amount float64) error {
-	req := &pb.DeductBalanceRequest{
-		UserId: userID,
-		Asset:  asset,
-		Amount: amount,
-	}
+func (c *CommercialClient) DeductBalance(ctx context.Context, userID uint64, asset string, amount decimal.Decimal) error {
+	return c.deductBalance(ctx, &pb.DeductBalanceRequest{
+		UserId:        userID,
+		Asset:         asset,
+		Amount:        money.Float(amount),
+		AmountDecimal: money.Format(amount),
+	})
+}
+
+// ReclaimBalance takes back funds a user received, such as the proceeds of a
+// refunded trade. Unlike DeductBalance it is not limited by spending limits.
+func (c *CommercialClient) ReclaimBalance(ctx context.Context, userID uint64, asset string, amount decimal.Decimal) error {
+	return c.deductBalance(ctx, &pb.DeductBalanceRequest{
+		UserId:        userID,
+		Asset:         asset,
+		Amount:        money.Float(amount),
+		AmountDecimal: money.Format(amount),
+		Reclaim:       true,
+	})
+}
 
+func (c *CommercialClient) deductBalance(ctx context.Context, req *pb.DeductBalanceRequest) error {
 	resp, err := c.walletClient.DeductBalance(ctx, req)
 	if err != nil {
 		return fmt.Errorf("failed to deduct balance: %w", err)
@@ -112,15 +211,16 @@ func (c *CommercialClient) GetWallet(ctx context.Context, userID uint64) (*pb.Wa
 }
 
 // CreateTransaction creates a transaction record
-func (c *CommercialClient) CreateTransaction(ctx context.Context, userID uint64, asset string, amount float64, action string, status int32, payableType string, payableID uint64) (*pb.Transaction, error) {
+func (c *CommercialClient) CreateTransaction(ctx context.Context, userID uint64, asset string, amount decimal.Decimal, action string, status int32, payableType string, payableID uint64) (*pb.Transaction, error) {
 	req := &pb.CreateTransactionRequest{
-		UserId:      userID,
-		Asset:       asset,
-		Amount:      amount,
-		Action:      action,
-		Status:      status,
-		PayableType: payableType,
-		PayableId:   payableID,
+		UserId:        userID,
+		Asset:         asset,
+		Amount:        money.Float(amount),
+		AmountDecimal: money.Format(amount),
+		Action:        action,
+		Status:        status,
+		PayableType:   payableType,
+		PayableId:     payableID,
 	}
 
 	resp, err := c.transactionClient.CreateTransaction(ctx, req)
@@ -132,12 +232,13 @@ func (c *CommercialClient) CreateTransaction(ctx context.Context, userID uint64,
 }
 
 // LockBalance locks balance for a pending transaction
-func (c *CommercialClient) LockBalance(ctx context.Context, userID uint64, asset string, amount float64, reason string) error {
+func (c *CommercialClient) LockBalance(ctx context.Context, userID uint64, asset string, amount decimal.Decimal, reason string) error {
 	req := &pb.LockBalanceRequest{
-		UserId: userID,
-		Asset:  asset,
-		Amount: amount,
-		Reason: reason,
+		UserId:        userID,
+		Asset:         asset,
+		Amount:        money.Float(amount),
+		AmountDecimal: money.Format(amount),
+		Reason:        reason,
 	}
 
 	_, err := c.walletClient.LockBalance(ctx, req)
@@ -149,11 +250,12 @@ func (c *CommercialClient) LockBalance(ctx context.Context, userID uint64, asset
 }
 
 // UnlockBalance unlocks previously locked balance
-func (c *CommercialClient) UnlockBalance(ctx context.Context, userID uint64, asset string, amount float64) error {
+func (c *CommercialClient) UnlockBalance(ctx context.Context, userID uint64, asset string, amount decimal.Decimal) error {
 	req := &pb.UnlockBalanceRequest{
-		UserId: userID,
-		Asset:  asset,
-		Amount: amount,
+		UserId:        userID,
+		Asset:         asset,
+		Amount:        money.Float(amount),
+		AmountDecimal: money.Format(amount),
 	}
 
 	_, err := c.walletClient.UnlockBalance(ctx, req)
@@ -164,38 +266,59 @@ func (c *CommercialClient) UnlockBalance(ctx context.Context, userID uint64, ass
 	return nil
 }
 
+// GetVariableRate retrieves an exchange rate (e.g. "psc", "red") from the variables table.
+// Rates are cached until InvalidateVariable is called or they expire.
+func (c *CommercialClient) GetVariableRate(ctx context.Context, key string) (float64, error) {
+	values, err := c.variableCache.Get(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+
+	value, ok := values[key]
+	if !ok {
+		return 0, fmt.Errorf("variable not found: %s", key)
+	}
+
+	return value, nil
+}
+
+// InvalidateVariable drops a cached rate, e.g. when a variable change event arrives
+func (c *CommercialClient) InvalidateVariable(key string) {
+	c.variableCache.Invalidate(key)
+}
+
+func (c *CommercialClient) getVariables(ctx context.Context, keys []string) (map[string]float64, error) {
+	resp, err := c.variableClient.GetVariables(ctx, &pb.GetVariablesRequest{Keys: keys})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get variable: %w", err)
+	}
+	return resp.Values, nil
+}
+
 // CheckBalance verifies if user has sufficient balance
 // Returns true if balance >= required amount
-func (c *CommercialClient) CheckBalance(ctx context.Context, userID uint64, asset string, requiredAmount float64) (bool, error) {
+func (c *CommercialClient) CheckBalance(ctx context.Context, userID uint64, asset string, requiredAmount decimal.Decimal) (bool, error) {
 	wallet, err := c.GetWallet(ctx, userID)
 	if err != nil {
 		return false, err
 	}
 
-	var balance float64
+	var balance string
 	switch asset {
 	case "psc":
-		balance = parseWalletString(wallet.Psc)
+		balance = wallet.Psc
 	case "irr":
-		balance = parseWalletString(wallet.Irr)
+		balance = wallet.Irr
 	case "red":
-		balance = parseWalletString(wallet.Red)
+		balance = wallet.Red
 	case "blue":
-		balance = parseWalletString(wallet.Blue)
+		balance = wallet.Blue
 	case "yellow":
-		balance = parseWalletString(wallet.Yellow)
+		balance = wallet.Yellow
 	default:
 		return false, fmt.Errorf("unknown asset: %s", asset)
 	}
 
-	return balance >= requiredAmount, nil
-}
-
-// parseWalletString converts formatted wallet string to float
-// Handles compact notation like "1.5K", "2.3M"
-func parseWalletString(s string) float64 {
-	// TODO: Implement proper parsing of compact notation
-	// For now, this is a placeholder
-	// In production, this should parse strings like "1.5K" -> 1500.0
-	return 0
+	// The wallet returns exact decimal strings without compact notation
+	return money.ParseOrZero(balance).GreaterThanOrEqual(requiredAmount), nil
 }
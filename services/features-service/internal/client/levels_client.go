@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "metargb/shared/pb/levels"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// LevelsClient wraps gRPC client for Levels Service
+type LevelsClient struct {
+	client pb.LevelServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewLevelsClient creates a new Levels Service client
+func NewLevelsClient(address string) (*LevelsClient, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to levels service at %s: %w", address, err)
+	}
+
+	return &LevelsClient{
+		client: pb.NewLevelServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// Close closes the gRPC connection
+func (c *LevelsClient) Close() error {
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
+}
+
+// GetUserLevel returns the slug and name of the user's current level, both
+// empty when the user has not reached any level
+func (c *LevelsClient) GetUserLevel(ctx context.Context, userID uint64) (string, string, error) {
+	resp, err := c.client.GetUserLevel(ctx, &pb.GetUserLevelRequest{UserId: userID})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get user level: %w", err)
+	}
+	if resp.LatestLevel == nil {
+		return "", "", nil
+	}
+	return resp.LatestLevel.Slug, resp.LatestLevel.Name, nil
+}
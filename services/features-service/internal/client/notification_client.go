@@ -55,6 +55,7 @@ func (c *NotificationClient) SendNotification(ctx context.Context, userID uint64
 		Data:      data,
 		SendSms:   false,
 		SendEmail: false,
+		Category:  "marketplace",
 	}
 
 	_, err := c.client.SendNotification(ctx, req)
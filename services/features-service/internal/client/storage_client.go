@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "metargb/shared/pb/storage"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// uploadChunkSize keeps every UploadFile message well below the 4MB gRPC default
+const uploadChunkSize = 512 << 10
+
+// StorageClient wraps gRPC client for Storage Service
+type StorageClient struct {
+	client pb.FileStorageServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewStorageClient creates a new Storage Service client
+func NewStorageClient(address string) (*StorageClient, error) {
+	// Create connection with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to storage service at %s: %w", address, err)
+	}
+
+	return &StorageClient{
+		client: pb.NewFileStorageServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// Close closes the gRPC connection
+func (c *StorageClient) Close() error {
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
+}
+
+// UploadFile streams data to storage-service in chunks and returns the file URL
+func (c *StorageClient) UploadFile(ctx context.Context, uploadPath, filename, contentType string, data []byte) (string, error) {
+	stream, err := c.client.UploadFile(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to open upload stream: %w", err)
+	}
+
+	err = stream.Send(&pb.UploadFileRequest{
+		Data: &pb.UploadFileRequest_Metadata{
+			Metadata: &pb.FileMetadata{
+				Filename:    filename,
+				ContentType: contentType,
+				FileSize:    int64(len(data)),
+				UploadPath:  uploadPath,
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to send file metadata: %w", err)
+	}
+
+	for start := 0; start < len(data); start += uploadChunkSize {
+		end := start + uploadChunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		err = stream.Send(&pb.UploadFileRequest{
+			Data: &pb.UploadFileRequest_ChunkData{ChunkData: data[start:end]},
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to send file chunk: %w", err)
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return "", fmt.Errorf("failed to upload file: %w", err)
+	}
+	if !resp.Success {
+		return "", fmt.Errorf("upload file failed: %s", resp.Message)
+	}
+
+	return resp.FileUrl, nil
+}
@@ -1,5 +1,7 @@
 package constants
 
+import "github.com/shopspring/decimal"
+
 // RGB System Configuration Constants
 // Matches config/rgb.php
 
@@ -31,22 +33,26 @@ const (
 	UnderpricedLockDurationHours = 24
 )
 
+// rgbFee is RGBFee as an exact decimal, so fees on large prices are not
+// rounded
+var rgbFee = decimal.NewFromFloat(RGBFee)
+
 // CalculateBuyerCharge calculates the amount buyer pays (price + fee)
-func CalculateBuyerCharge(price float64) float64 {
-	return price + (price * RGBFee)
+func CalculateBuyerCharge(price decimal.Decimal) decimal.Decimal {
+	return price.Add(CalculateFee(price))
 }
 
 // CalculateSellerPayment calculates the amount seller receives (price - fee)
-func CalculateSellerPayment(price float64) float64 {
-	return price - (price * RGBFee)
+func CalculateSellerPayment(price decimal.Decimal) decimal.Decimal {
+	return price.Sub(CalculateFee(price))
 }
 
 // CalculatePlatformFee calculates the total fee for platform (fee * 2)
-func CalculatePlatformFee(price float64) float64 {
-	return (price * RGBFee) * 2
+func CalculatePlatformFee(price decimal.Decimal) decimal.Decimal {
+	return CalculateFee(price).Mul(decimal.NewFromInt(2))
 }
 
 // CalculateFee calculates the fee amount for a given price
-func CalculateFee(price float64) float64 {
-	return price * RGBFee
+func CalculateFee(price decimal.Decimal) decimal.Decimal {
+	return price.Mul(rgbFee)
 }
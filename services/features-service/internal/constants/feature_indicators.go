@@ -109,6 +109,21 @@ func GetColor(karbari string) string {
 	}
 }
 
+// GetKarbariByColor returns the karbari whose color asset is color, the
+// reverse of GetColor
+func GetKarbariByColor(color string) string {
+	switch color {
+	case ColorAmozeshi:
+		return Amozeshi
+	case ColorTejari:
+		return Tejari
+	case ColorMaskoni:
+		return Maskoni
+	default:
+		return ""
+	}
+}
+
 // GetColorPersian returns the Persian color name based on karbari
 func GetColorPersian(karbari string) string {
 	switch karbari {
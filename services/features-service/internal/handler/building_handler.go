@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"metargb/features-service/internal/service"
@@ -9,16 +10,25 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
+// chunkHeadroom is left free in every streamed chunk for the page fields and
+// message framing
+const chunkHeadroom = 1 << 10
+
 type BuildingHandler struct {
 	pb.UnimplementedBuildingServiceServer
-	service *service.BuildingService
+	service        *service.BuildingService
+	maxSendMsgSize int
 }
 
-func NewBuildingHandler(service *service.BuildingService) *BuildingHandler {
+// NewBuildingHandler creates the handler. maxSendMsgSize must match the
+// server's send limit so oversized build packages fail with a clear error.
+func NewBuildingHandler(service *service.BuildingService, maxSendMsgSize int) *BuildingHandler {
 	return &BuildingHandler{
-		service: service,
+		service:        service,
+		maxSendMsgSize: maxSendMsgSize,
 	}
 }
 
@@ -29,18 +39,97 @@ func (h *BuildingHandler) GetBuildPackage(ctx context.Context, req *pb.GetBuildP
 		return nil, status.Errorf(codes.InvalidArgument, "feature_id is required")
 	}
 
-	models, coordinates, err := h.service.GetBuildPackage(ctx, req.FeatureId, req.Page)
+	pkg, err := h.getBuildPackage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.BuildPackageResponse{
+		Models:       pkg.Models,
+		Coordinates:  pkg.Coordinates,
+		CurrentPage:  pkg.CurrentPage,
+		LastPage:     pkg.LastPage,
+		HasMorePages: pkg.HasMorePages(),
+	}
+
+	// Fail with a descriptive status instead of the transport's generic error
+	if size := proto.Size(resp); size > h.maxSendMsgSize {
+		return nil, status.Errorf(codes.ResourceExhausted,
+			"build package is %d bytes, over the %d byte limit; use StreamBuildPackage", size, h.maxSendMsgSize)
+	}
+
+	return resp, nil
+}
+
+// StreamBuildPackage sends one page of building models in chunks that each fit
+// the message size limit. Coordinates are sent with the first chunk.
+func (h *BuildingHandler) StreamBuildPackage(req *pb.GetBuildPackageRequest, stream pb.BuildingService_StreamBuildPackageServer) error {
+	if req.FeatureId == 0 {
+		return status.Errorf(codes.InvalidArgument, "feature_id is required")
+	}
+
+	pkg, err := h.getBuildPackage(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	coordinatesSize := proto.Size(&pb.BuildPackageChunk{Coordinates: pkg.Coordinates})
+	chunks, err := chunkBuildingModels(pkg.Models, h.maxSendMsgSize-coordinatesSize-chunkHeadroom)
+	if err != nil {
+		return status.Errorf(codes.ResourceExhausted, "%s", err.Error())
+	}
+
+	for i, models := range chunks {
+		chunk := &pb.BuildPackageChunk{
+			Models:       models,
+			CurrentPage:  pkg.CurrentPage,
+			LastPage:     pkg.LastPage,
+			HasMorePages: pkg.HasMorePages(),
+		}
+		if i == 0 {
+			chunk.Coordinates = pkg.Coordinates
+		}
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *BuildingHandler) getBuildPackage(ctx context.Context, req *pb.GetBuildPackageRequest) (*service.BuildPackage, error) {
+	pkg, err := h.service.GetBuildPackage(ctx, req.FeatureId, req.Page)
 	if err != nil {
 		if strings.Contains(err.Error(), "unauthorized") || strings.Contains(err.Error(), "does not own") {
 			return nil, status.Errorf(codes.PermissionDenied, "%s", err.Error())
 		}
 		return nil, status.Errorf(codes.Internal, "failed to get build package: %v", err)
 	}
+	return pkg, nil
+}
 
-	return &pb.BuildPackageResponse{
-		Models:      models,
-		Coordinates: coordinates,
-	}, nil
+// chunkBuildingModels groups models so the encoded models of each group stay
+// within budget bytes. It always returns at least one, possibly empty, group.
+func chunkBuildingModels(models []*pb.BuildingModel, budget int) ([][]*pb.BuildingModel, error) {
+	chunks := [][]*pb.BuildingModel{{}}
+	used := 0
+	for _, model := range models {
+		// Field tag plus a length prefix of up to 5 bytes
+		size := proto.Size(model) + 6
+		if size > budget {
+			return nil, fmt.Errorf("building model %s is %d bytes, over the %d byte chunk limit", model.ModelId, size, budget)
+		}
+
+		last := len(chunks) - 1
+		if used+size > budget && len(chunks[last]) > 0 {
+			chunks = append(chunks, nil)
+			last++
+			used = 0
+		}
+		chunks[last] = append(chunks[last], model)
+		used += size
+	}
+	return chunks, nil
 }
 
 // BuildFeature starts construction of a building on a feature
@@ -145,3 +234,29 @@ func (h *BuildingHandler) DestroyBuilding(ctx context.Context, req *pb.DestroyBu
 		Message: "Building destroyed successfully",
 	}, nil
 }
+
+// SimulateBuild reports whether BuildFeature would succeed and what is missing
+func (h *BuildingHandler) SimulateBuild(ctx context.Context, req *pb.SimulateBuildRequest) (*pb.SimulateBuildResponse, error) {
+	if req.FeatureId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "feature_id is required")
+	}
+	if req.BuildingModelId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "building_model_id is required")
+	}
+
+	resp, err := h.service.SimulateBuild(ctx, req)
+	if err != nil {
+		if strings.Contains(err.Error(), "unauthorized") {
+			return nil, status.Errorf(codes.Unauthenticated, "%s", err.Error())
+		}
+		if strings.Contains(err.Error(), "feature not found") {
+			return nil, status.Errorf(codes.NotFound, "%s", err.Error())
+		}
+		if strings.Contains(err.Error(), "invalid launched_satisfaction") {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to simulate build: %v", err)
+	}
+
+	return resp, nil
+}
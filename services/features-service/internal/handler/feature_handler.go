@@ -133,3 +133,19 @@ func (h *FeatureHandler) GetMyFeatures(ctx context.Context, req *pb.GetMyFeature
 		Features: features,
 	}, nil
 }
+
+// CountOwnedFeatures counts the features of many owners at once
+func (h *FeatureHandler) CountOwnedFeatures(ctx context.Context, req *pb.CountOwnedFeaturesRequest) (*pb.OwnedFeatureCountsResponse, error) {
+	if len(req.OwnerIds) > service.MaxOwnedFeatureCountsBatch {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d owner_ids may be requested at once", service.MaxOwnedFeatureCountsBatch)
+	}
+
+	counts, err := h.service.CountOwnedFeatures(ctx, req.OwnerIds)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count owned features: %v", err)
+	}
+
+	return &pb.OwnedFeatureCountsResponse{
+		Counts: counts,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"metargb/features-service/internal/service"
 	pb "metargb/shared/pb/features"
 	"metargb/shared/pkg/auth"
 
@@ -110,27 +111,21 @@ func (h *FeatureHandler) AddMyFeatureImages(ctx context.Context, req *pb.AddMyFe
 		return nil, status.Errorf(codes.InvalidArgument, "at least one image is required")
 	}
 
-	// TODO: Upload images to storage service and get URLs
-	// For now, we'll expect the grpc-gateway to handle file uploads and pass URLs
-	// This is a placeholder - the actual implementation should handle file uploads
-	imageURLs := make([]string, 0, len(req.ImageData))
-	for i, imgData := range req.ImageData {
-		// In a real implementation, upload to storage service
-		// For now, create a placeholder URL
-		// The grpc-gateway should handle actual file uploads
-		_ = imgData // Use image data
-		_ = req.Filenames[i]
-		_ = req.ContentTypes[i]
-		// Placeholder - should be replaced with actual storage URL
-		imageURLs = append(imageURLs, fmt.Sprintf("uploads/features/%d/image_%d.jpg", req.FeatureId, i+1))
+	uploads := make([]service.ImageUpload, 0, len(req.ImageData))
+	for i, data := range req.ImageData {
+		upload := service.ImageUpload{Data: data}
+		if i < len(req.Filenames) {
+			upload.Filename = req.Filenames[i]
+		}
+		if i < len(req.ContentTypes) {
+			upload.ContentType = req.ContentTypes[i]
+		}
+		uploads = append(uploads, upload)
 	}
 
-	feature, err := h.service.AddMyFeatureImages(ctx, req.UserId, req.FeatureId, imageURLs)
+	feature, err := h.service.AddMyFeatureImages(ctx, req.UserId, req.FeatureId, uploads)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return nil, status.Errorf(codes.NotFound, "feature not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to add images: %v", err)
+		return nil, mapGalleryError(err)
 	}
 
 	return &pb.FeatureResponse{
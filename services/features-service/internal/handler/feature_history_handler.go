@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"metargb/features-service/internal/models"
+	"metargb/features-service/internal/service"
+	commonpb "metargb/shared/pb/common"
+	pb "metargb/shared/pb/features"
+	"metargb/shared/pkg/auth"
+	"metargb/shared/pkg/helpers"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type FeatureHistoryHandler struct {
+	pb.UnimplementedFeatureHistoryServiceServer
+	service service.FeatureHistoryServiceInterface
+}
+
+func NewFeatureHistoryHandler(service service.FeatureHistoryServiceInterface) *FeatureHistoryHandler {
+	return &FeatureHistoryHandler{
+		service: service,
+	}
+}
+
+// GetFeatureHistory handles GET /api/features/{feature}/history
+// Returns everything that happened to a feature for its owner to audit
+func (h *FeatureHistoryHandler) GetFeatureHistory(ctx context.Context, req *pb.GetFeatureHistoryRequest) (*pb.FeatureHistoryResponse, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: authentication required")
+	}
+	if req.UserId != 0 && req.UserId != user.UserID {
+		return nil, status.Errorf(codes.PermissionDenied, "feature does not belong to user")
+	}
+	if req.FeatureId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "feature_id is required")
+	}
+
+	history, err := h.service.GetFeatureHistory(ctx, req.FeatureId, user.UserID, int(req.Page), int(req.PerPage))
+	if err != nil {
+		return nil, mapFeatureHistoryError(err)
+	}
+
+	data := make([]*pb.FeatureHistoryEvent, 0, len(history.Events))
+	for _, event := range history.Events {
+		data = append(data, featureHistoryEventToPB(event))
+	}
+
+	lastPage := (history.Total + history.PerPage - 1) / history.PerPage
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	return &pb.FeatureHistoryResponse{
+		Data: data,
+		Meta: &commonpb.PaginationMeta{
+			CurrentPage: int32(history.Page),
+			PerPage:     int32(history.PerPage),
+			Total:       int32(history.Total),
+			LastPage:    int32(lastPage),
+		},
+	}, nil
+}
+
+func featureHistoryEventToPB(event *models.FeatureHistoryEvent) *pb.FeatureHistoryEvent {
+	item := &pb.FeatureHistoryEvent{
+		Type:           event.Type,
+		ReferenceId:    event.ReferenceID,
+		UserId:         event.UserID,
+		CounterpartyId: event.CounterpartyID,
+		PricePsc:       event.PricePSC,
+		PriceIrr:       event.PriceIRR,
+		Asset:          event.Asset,
+		Amount:         event.Amount,
+		Status:         event.Status,
+	}
+	if !event.OccurredAt.IsZero() {
+		item.Date = helpers.FormatJalaliDate(event.OccurredAt)
+		item.Time = helpers.FormatJalaliTime(event.OccurredAt)
+	}
+	return item
+}
+
+func mapFeatureHistoryError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrFeatureHistoryNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrFeatureHistoryNotOwner):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "failed to get feature history: %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"metargb/features-service/internal/repository"
+	"metargb/features-service/internal/service"
+	pb "metargb/shared/pb/features"
+	"metargb/shared/pkg/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GalleryHandler struct {
+	pb.UnimplementedFeatureGalleryServiceServer
+	service service.GalleryServiceInterface
+}
+
+func NewGalleryHandler(service service.GalleryServiceInterface) *GalleryHandler {
+	return &GalleryHandler{
+		service: service,
+	}
+}
+
+// ListFeatureImages handles GET /api/features/{feature}/images
+func (h *GalleryHandler) ListFeatureImages(ctx context.Context, req *pb.ListFeatureImagesRequest) (*pb.FeatureImagesResponse, error) {
+	if req.FeatureId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "feature_id is required")
+	}
+
+	images, err := h.service.ListImages(ctx, req.FeatureId)
+	if err != nil {
+		return nil, mapGalleryError(err)
+	}
+	return galleryResponse(images), nil
+}
+
+// AttachFeatureImages handles POST /api/features/{feature}/images
+func (h *GalleryHandler) AttachFeatureImages(ctx context.Context, req *pb.AttachFeatureImagesRequest) (*pb.FeatureImagesResponse, error) {
+	userID, err := galleryUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.FeatureId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "feature_id is required")
+	}
+
+	uploads := make([]service.ImageUpload, 0, len(req.Images))
+	for i, image := range req.Images {
+		if image == nil || len(image.Data) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "images.%d is empty", i)
+		}
+		uploads = append(uploads, service.ImageUpload{
+			Data:        image.Data,
+			Filename:    image.Filename,
+			ContentType: image.ContentType,
+		})
+	}
+
+	images, err := h.service.AttachImages(ctx, userID, req.FeatureId, uploads)
+	if err != nil {
+		return nil, mapGalleryError(err)
+	}
+	return galleryResponse(images), nil
+}
+
+// RemoveFeatureImage handles DELETE /api/features/{feature}/images/{image}
+func (h *GalleryHandler) RemoveFeatureImage(ctx context.Context, req *pb.RemoveFeatureImageRequest) (*pb.FeatureImagesResponse, error) {
+	userID, err := galleryUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.FeatureId == 0 || req.ImageId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "feature_id and image_id are required")
+	}
+
+	images, err := h.service.RemoveImage(ctx, userID, req.FeatureId, req.ImageId)
+	if err != nil {
+		return nil, mapGalleryError(err)
+	}
+	return galleryResponse(images), nil
+}
+
+// ReorderFeatureImages handles PUT /api/features/{feature}/images/order
+func (h *GalleryHandler) ReorderFeatureImages(ctx context.Context, req *pb.ReorderFeatureImagesRequest) (*pb.FeatureImagesResponse, error) {
+	userID, err := galleryUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.FeatureId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "feature_id is required")
+	}
+
+	images, err := h.service.ReorderImages(ctx, userID, req.FeatureId, req.ImageIds)
+	if err != nil {
+		return nil, mapGalleryError(err)
+	}
+	return galleryResponse(images), nil
+}
+
+// SetFeatureCoverImage handles PUT /api/features/{feature}/images/{image}/cover
+func (h *GalleryHandler) SetFeatureCoverImage(ctx context.Context, req *pb.SetFeatureCoverImageRequest) (*pb.FeatureImagesResponse, error) {
+	userID, err := galleryUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.FeatureId == 0 || req.ImageId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "feature_id and image_id are required")
+	}
+
+	images, err := h.service.SetCoverImage(ctx, userID, req.FeatureId, req.ImageId)
+	if err != nil {
+		return nil, mapGalleryError(err)
+	}
+	return galleryResponse(images), nil
+}
+
+func galleryUserID(ctx context.Context) (uint64, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return 0, status.Error(codes.Unauthenticated, "unauthorized: authentication required")
+	}
+	if user.IsAPIKey() {
+		return 0, status.Error(codes.PermissionDenied, service.ErrGalleryNotOwner.Error())
+	}
+	return user.UserID, nil
+}
+
+func mapGalleryError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrGalleryNotOwner):
+		return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case errors.Is(err, service.ErrGalleryFeatureNotFound),
+		errors.Is(err, service.ErrGalleryImageNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrGalleryNoImages),
+		errors.Is(err, service.ErrGalleryImageTooBig),
+		errors.Is(err, service.ErrGalleryUnsupportedImage),
+		errors.Is(err, service.ErrGalleryImageTooLarge),
+		errors.Is(err, service.ErrGalleryInvalidOrder),
+		errors.Is(err, service.ErrGalleryTooManyImages):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	case errors.Is(err, service.ErrGalleryStorageUnavailable):
+		return status.Error(codes.Unavailable, service.ErrGalleryStorageUnavailable.Error())
+	default:
+		return status.Errorf(codes.Internal, "operation failed: %v", err)
+	}
+}
+
+func galleryResponse(images []*repository.Image) *pb.FeatureImagesResponse {
+	data := make([]*pb.Image, 0, len(images))
+	for _, img := range images {
+		data = append(data, &pb.Image{
+			Id:           img.ID,
+			Url:          img.URL,
+			ThumbnailUrl: img.ThumbnailURL,
+			Position:     int32(img.Position),
+			IsCover:      img.IsCover,
+		})
+	}
+	return &pb.FeatureImagesResponse{Data: data}
+}
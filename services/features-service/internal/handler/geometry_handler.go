@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"metargb/features-service/internal/models"
+	"metargb/features-service/internal/service"
+	pb "metargb/shared/pb/features"
+	"metargb/shared/pkg/auth"
+	"metargb/shared/pkg/helpers"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GeometryHandler struct {
+	pb.UnimplementedFeatureGeometryServiceServer
+	service service.GeometryServiceInterface
+}
+
+func NewGeometryHandler(service service.GeometryServiceInterface) *GeometryHandler {
+	return &GeometryHandler{
+		service: service,
+	}
+}
+
+// UpdateFeatureGeometry handles PUT /api/admin/features/{feature}/geometry
+func (h *GeometryHandler) UpdateFeatureGeometry(ctx context.Context, req *pb.UpdateFeatureGeometryRequest) (*pb.GeometryVersionResponse, error) {
+	adminID, err := geometryAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.FeatureId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "feature_id is required")
+	}
+
+	points := make([]models.GeometryPoint, 0, len(req.Coordinates))
+	for i, coord := range req.Coordinates {
+		if coord == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "coordinates.%d is required", i)
+		}
+		x, errX := strconv.ParseFloat(strings.TrimSpace(coord.X), 64)
+		y, errY := strconv.ParseFloat(strings.TrimSpace(coord.Y), 64)
+		if errX != nil || errY != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "coordinates.%d must have numeric x and y", i)
+		}
+		points = append(points, models.GeometryPoint{X: x, Y: y})
+	}
+
+	version, err := h.service.UpdateGeometry(ctx, adminID, req.FeatureId, points, req.Reason)
+	if err != nil {
+		return nil, mapGeometryError(err)
+	}
+
+	return &pb.GeometryVersionResponse{Data: geometryVersionToPB(version)}, nil
+}
+
+// ListGeometryVersions handles GET /api/admin/features/{feature}/geometry/versions
+func (h *GeometryHandler) ListGeometryVersions(ctx context.Context, req *pb.ListGeometryVersionsRequest) (*pb.ListGeometryVersionsResponse, error) {
+	adminID, err := geometryAdminID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.FeatureId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "feature_id is required")
+	}
+
+	versions, err := h.service.ListVersions(ctx, adminID, req.FeatureId)
+	if err != nil {
+		return nil, mapGeometryError(err)
+	}
+
+	data := make([]*pb.GeometryVersion, 0, len(versions))
+	for _, version := range versions {
+		data = append(data, geometryVersionToPB(version))
+	}
+
+	return &pb.ListGeometryVersionsResponse{Data: data}, nil
+}
+
+func geometryAdminID(ctx context.Context) (uint64, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if user.IsAPIKey() {
+		return 0, status.Error(codes.PermissionDenied, service.ErrGeometryNotAdmin.Error())
+	}
+	return user.UserID, nil
+}
+
+func mapGeometryError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrGeometryNotAdmin):
+		return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case errors.Is(err, service.ErrGeometryFeatureNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrGeometryTooFewPoints),
+		errors.Is(err, service.ErrGeometryTooManyPoints),
+		errors.Is(err, service.ErrGeometryInvalidPoint),
+		errors.Is(err, service.ErrGeometryNotClosed),
+		errors.Is(err, service.ErrGeometryDuplicatePoint),
+		errors.Is(err, service.ErrGeometrySelfIntersecting),
+		errors.Is(err, service.ErrGeometryAreaOutOfBounds),
+		errors.Is(err, service.ErrGeometryReasonTooLong):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	default:
+		return status.Errorf(codes.Internal, "operation failed: %v", err)
+	}
+}
+
+func geometryVersionToPB(version *models.GeometryVersion) *pb.GeometryVersion {
+	coordinates := make([]*pb.Coordinate, 0, len(version.Coordinates))
+	for _, point := range version.Coordinates {
+		coordinates = append(coordinates, &pb.Coordinate{
+			GeometryId: version.GeometryID,
+			X:          strconv.FormatFloat(point.X, 'f', -1, 64),
+			Y:          strconv.FormatFloat(point.Y, 'f', -1, 64),
+		})
+	}
+
+	return &pb.GeometryVersion{
+		Id:          version.ID,
+		FeatureId:   version.FeatureID,
+		Version:     int32(version.Version),
+		Coordinates: coordinates,
+		Area:        strconv.FormatFloat(version.Area, 'f', 2, 64),
+		EditedBy:    version.EditedBy,
+		Reason:      version.Reason,
+		Date:        helpers.FormatJalaliDate(version.CreatedAt),
+		Time:        helpers.FormatJalaliTime(version.CreatedAt),
+	}
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"metargb/features-service/internal/service"
+	pb "metargb/shared/pb/features"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// InstallmentHandler serves feature reservations to commercial-service,
+// which runs the installment plans. It is not routed by the gateway.
+type InstallmentHandler struct {
+	pb.UnimplementedFeatureInstallmentServiceServer
+	service *service.MarketplaceService
+}
+
+func NewInstallmentHandler(service *service.MarketplaceService) *InstallmentHandler {
+	return &InstallmentHandler{
+		service: service,
+	}
+}
+
+func (h *InstallmentHandler) ReserveFeature(ctx context.Context, req *pb.ReserveFeatureRequest) (*pb.FeatureReservation, error) {
+	if req.FeatureId == 0 || req.BuyerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "feature_id and buyer_id are required")
+	}
+
+	reservation, err := h.service.ReserveFeature(ctx, req.FeatureId, req.BuyerId)
+	if err != nil {
+		return nil, mapReservationError(err)
+	}
+
+	return reservation, nil
+}
+
+func (h *InstallmentHandler) CompleteReservedPurchase(ctx context.Context, req *pb.FeatureReservationRequest) (*pb.CompleteReservedPurchaseResponse, error) {
+	if req.FeatureId == 0 || req.BuyerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "feature_id and buyer_id are required")
+	}
+
+	tradeID, err := h.service.CompleteReservedPurchase(ctx, req.FeatureId, req.BuyerId)
+	if err != nil {
+		return nil, mapReservationError(err)
+	}
+
+	return &pb.CompleteReservedPurchaseResponse{TradeId: tradeID}, nil
+}
+
+func (h *InstallmentHandler) ReleaseFeatureReservation(ctx context.Context, req *pb.FeatureReservationRequest) (*emptypb.Empty, error) {
+	if req.FeatureId == 0 || req.BuyerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "feature_id and buyer_id are required")
+	}
+
+	if err := h.service.ReleaseFeatureReservation(ctx, req.FeatureId, req.BuyerId); err != nil {
+		return nil, mapReservationError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func mapReservationError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrReservationNotFound), errors.Is(err, service.ErrReservationFeatureNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrFeatureReserved), errors.Is(err, service.ErrFeatureNotInstallable),
+		errors.Is(err, service.ErrReservationSellerChanged), errors.Is(err, service.ErrFeatureHeldForCheckout),
+		errors.Is(err, service.ErrOwnershipLimitReached):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	}
+	// The underpriced restriction is reported as a plain Persian message
+	if strings.Contains(err.Error(), "صبر") {
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "operation failed: %v", err)
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"metargb/features-service/internal/constants"
+	"metargb/features-service/internal/models"
+	"metargb/features-service/internal/service"
+	pb "metargb/shared/pb/features"
+	"metargb/shared/pkg/helpers"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// listingsPath is the gateway route of ListForSaleFeatures, used in pagination links
+const listingsPath = "/api/marketplace/listings"
+
+// ListForSaleFeatures handles GET /api/marketplace/listings
+// Returns a page of features with an open sell request (simple pagination)
+func (h *MarketplaceHandler) ListForSaleFeatures(ctx context.Context, req *pb.ListForSaleFeaturesRequest) (*pb.ListForSaleFeaturesResponse, error) {
+	filter := models.ListingFilter{
+		Region:   req.Region,
+		Karbari:  req.Karbari,
+		Currency: req.Currency,
+		Sort:     req.Sort,
+	}
+
+	validationErrors := make(map[string]string)
+	if req.Color != "" {
+		karbari := constants.GetKarbariByColor(req.Color)
+		if karbari == "" || (filter.Karbari != "" && filter.Karbari != karbari) {
+			validationErrors["color"] = "color must be yellow, red or blue and match karbari"
+		}
+		filter.Karbari = karbari
+	}
+	var err error
+	if filter.MinPrice, err = parseListingPrice(req.MinPrice); err != nil {
+		validationErrors["min_price"] = "min_price must be a number"
+	}
+	if filter.MaxPrice, err = parseListingPrice(req.MaxPrice); err != nil {
+		validationErrors["max_price"] = "max_price must be a number"
+	}
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	perPage := int(req.PerPage)
+	if perPage == 0 {
+		perPage = service.DefaultListingsPerPage
+	}
+
+	listings, hasMore, err := h.listings.ListForSale(ctx, filter, page, perPage)
+	if err != nil {
+		return nil, mapListingError(err)
+	}
+
+	data := make([]*pb.MarketplaceListing, 0, len(listings))
+	for _, listing := range listings {
+		data = append(data, listingToPB(listing))
+	}
+
+	// Pagination links keep the filters of the request
+	query := listingQuery(req)
+	links := &pb.PaginationLinks{
+		First: listingPageURL(query, 1),
+	}
+	if page > 1 {
+		links.Prev = listingPageURL(query, page-1)
+	}
+	if hasMore {
+		links.Next = listingPageURL(query, page+1)
+	}
+
+	return &pb.ListForSaleFeaturesResponse{
+		Data:  data,
+		Links: links,
+		Meta: &pb.SimplePaginationMeta{
+			CurrentPage: int32(page),
+			Path:        listingsPath,
+			PerPage:     int32(perPage),
+		},
+	}, nil
+}
+
+func parseListingPrice(value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+func listingQuery(req *pb.ListForSaleFeaturesRequest) url.Values {
+	query := url.Values{}
+	if req.Region != 0 {
+		query.Set("region", strconv.Itoa(int(req.Region)))
+	}
+	for key, value := range map[string]string{
+		"karbari":   req.Karbari,
+		"color":     req.Color,
+		"currency":  req.Currency,
+		"min_price": req.MinPrice,
+		"max_price": req.MaxPrice,
+		"sort":      req.Sort,
+	} {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	if req.PerPage != 0 {
+		query.Set("per_page", strconv.Itoa(int(req.PerPage)))
+	}
+	return query
+}
+
+func listingPageURL(query url.Values, page int) string {
+	query.Set("page", strconv.Itoa(page))
+	return fmt.Sprintf("%s?%s", listingsPath, query.Encode())
+}
+
+func mapListingError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrListingInvalidKarbari):
+		return returnValidationError(map[string]string{"karbari": err.Error()})
+	case errors.Is(err, service.ErrListingInvalidCurrency):
+		return returnValidationError(map[string]string{"currency": err.Error()})
+	case errors.Is(err, service.ErrListingInvalidSort):
+		return returnValidationError(map[string]string{"sort": err.Error()})
+	case errors.Is(err, service.ErrListingInvalidPriceRange):
+		return returnValidationError(map[string]string{"min_price": err.Error()})
+	case errors.Is(err, service.ErrListingInvalidPerPage):
+		return returnValidationError(map[string]string{"per_page": err.Error()})
+	default:
+		return status.Errorf(codes.Internal, "failed to list features for sale: %v", err)
+	}
+}
+
+func listingToPB(listing *models.Listing) *pb.MarketplaceListing {
+	return &pb.MarketplaceListing{
+		SellRequestId: listing.SellRequestID,
+		FeatureId:     listing.FeatureID,
+		PropertiesId:  listing.PropertiesID,
+		Karbari:       listing.Karbari,
+		Region:        listing.Region,
+		Area:          listing.Area,
+		Address:       listing.Address,
+		SellerId:      listing.SellerID,
+		PricePsc:      strconv.FormatFloat(listing.PricePSC, 'f', -1, 64),
+		PriceIrr:      strconv.FormatFloat(listing.PriceIRR, 'f', -1, 64),
+		Date:          helpers.FormatJalaliDate(listing.ListedAt),
+		Time:          helpers.FormatJalaliTime(listing.ListedAt),
+	}
+}
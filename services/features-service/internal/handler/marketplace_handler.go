@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"metargb/features-service/internal/service"
 	pb "metargb/shared/pb/features"
 	"metargb/shared/pkg/helpers"
+	"metargb/shared/pkg/money"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -23,14 +25,16 @@ type MarketplaceHandler struct {
 	geometryRepo   *repository.GeometryRepository
 	propertiesRepo *repository.PropertiesRepository
 	featureRepo    *repository.FeatureRepository
+	listings       service.ListingServiceInterface
 }
 
-func NewMarketplaceHandler(service *service.MarketplaceService, geometryRepo *repository.GeometryRepository, propertiesRepo *repository.PropertiesRepository, featureRepo *repository.FeatureRepository) *MarketplaceHandler {
+func NewMarketplaceHandler(service *service.MarketplaceService, geometryRepo *repository.GeometryRepository, propertiesRepo *repository.PropertiesRepository, featureRepo *repository.FeatureRepository, listings service.ListingServiceInterface) *MarketplaceHandler {
 	return &MarketplaceHandler{
 		service:        service,
 		geometryRepo:   geometryRepo,
 		propertiesRepo: propertiesRepo,
 		featureRepo:    featureRepo,
+		listings:       listings,
 	}
 }
 
@@ -51,6 +55,14 @@ func (h *MarketplaceHandler) BuyFeature(ctx context.Context, req *pb.BuyFeatureR
 	updatedFeature, err := h.service.BuyFeature(ctx, req.FeatureId, req.BuyerId)
 	if err != nil {
 		// Map service errors to appropriate gRPC status codes
+		if errors.Is(err, service.ErrFeatureReserved) || errors.Is(err, service.ErrFeatureHeldForCheckout) || errors.Is(err, service.ErrOwnershipLimitReached) ||
+			errors.Is(err, service.ErrLimitedQuotaReached) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		// Users under 18 reached a daily or monthly cap of commercial-service
+		if strings.Contains(err.Error(), "spending limit") {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
 		if strings.Contains(err.Error(), "موجودی") || strings.Contains(err.Error(), "balance") {
 			return nil, status.Errorf(codes.PermissionDenied, "insufficient balance: %v", err)
 		}
@@ -70,6 +82,77 @@ func (h *MarketplaceHandler) BuyFeature(ctx context.Context, req *pb.BuyFeatureR
 	}, nil
 }
 
+// ReserveFeature holds a feature for the caller while the 3D client confirms
+// the purchase
+// Implements POST /api/features/{feature}/reservation
+func (h *MarketplaceHandler) ReserveFeature(ctx context.Context, req *pb.CheckoutReservationRequest) (*pb.CheckoutReservation, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("feature_id", req.FeatureId, locale),
+		validateRequired("user_id", req.UserId, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	reservation, err := h.service.ReserveForCheckout(ctx, req.FeatureId, req.UserId)
+	if err != nil {
+		return nil, mapCheckoutReservationError(err)
+	}
+	return reservation, nil
+}
+
+// ReleaseReservation ends the caller's hold on a feature
+// Implements DELETE /api/features/{feature}/reservation
+func (h *MarketplaceHandler) ReleaseReservation(ctx context.Context, req *pb.CheckoutReservationRequest) (*emptypb.Empty, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("feature_id", req.FeatureId, locale),
+		validateRequired("user_id", req.UserId, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	if err := h.service.ReleaseCheckoutReservation(ctx, req.FeatureId, req.UserId); err != nil {
+		return nil, mapCheckoutReservationError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetLimitedFeatureAvailability returns the remaining stock of limited campaigns
+// Implements GET /api/features/limited/availability
+func (h *MarketplaceHandler) GetLimitedFeatureAvailability(ctx context.Context, req *pb.GetLimitedFeatureAvailabilityRequest) (*pb.GetLimitedFeatureAvailabilityResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := validateRequired("user_id", req.UserId, locale)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	availability, err := h.service.GetLimitedFeatureAvailability(ctx, req.UserId, req.FeatureId)
+	if err != nil {
+		if errors.Is(err, service.ErrCheckoutFeatureNotFound) || errors.Is(err, service.ErrLimitationNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get limited feature availability: %v", err)
+	}
+	return &pb.GetLimitedFeatureAvailabilityResponse{Data: availability}, nil
+}
+
+func mapCheckoutReservationError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrCheckoutFeatureNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrCheckoutOwnFeature):
+		return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case errors.Is(err, service.ErrFeatureHeldForCheckout), errors.Is(err, service.ErrFeatureReserved), errors.Is(err, service.ErrLimitedQuotaReached):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	case errors.Is(err, service.ErrCheckoutUnavailable):
+		return status.Errorf(codes.Unavailable, "%s", err.Error())
+	}
+	return status.Errorf(codes.Internal, "failed to reserve feature: %v", err)
+}
+
 // SendBuyRequest creates a buy request for a feature
 // Implements Laravel's BuyRequestsController@store
 func (h *MarketplaceHandler) SendBuyRequest(ctx context.Context, req *pb.SendBuyRequestRequest) (*pb.BuyRequestResponse, error) {
@@ -85,10 +168,13 @@ func (h *MarketplaceHandler) SendBuyRequest(ctx context.Context, req *pb.SendBuy
 	buyRequest, err := h.service.SendBuyRequest(ctx, req)
 	if err != nil {
 		// Map service errors to appropriate gRPC status codes
+		if errors.Is(err, service.ErrOwnershipLimitReached) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
 		if strings.Contains(err.Error(), "موجودی") {
 			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 		}
-		if strings.Contains(err.Error(), "مجاز") || strings.Contains(err.Error(), "price") {
+		if strings.Contains(err.Error(), "مجاز") || strings.Contains(err.Error(), "price") || strings.Contains(err.Error(), "spending limit") {
 			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
 		}
 		if strings.Contains(err.Error(), "not found") {
@@ -122,7 +208,7 @@ func (h *MarketplaceHandler) AcceptBuyRequest(ctx context.Context, req *pb.Accep
 		if strings.Contains(err.Error(), "not found") {
 			return nil, status.Errorf(codes.NotFound, "%v", err)
 		}
-		if strings.Contains(err.Error(), "صبر") || strings.Contains(err.Error(), "زیر قیمت") {
+		if strings.Contains(err.Error(), "صبر") || strings.Contains(err.Error(), "زیر قیمت") || errors.Is(err, service.ErrFeatureReserved) || errors.Is(err, service.ErrOwnershipLimitReached) {
 			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
 		}
 		return nil, status.Errorf(codes.Internal, "failed to accept buy request: %v", err)
@@ -244,8 +330,8 @@ func (h *MarketplaceHandler) buildSellRequestResponse(ctx context.Context, sellR
 		Id:        sellRequest.ID,
 		SellerId:  sellRequest.SellerID,
 		FeatureId: sellRequest.FeatureID,
-		PricePsc:  fmt.Sprintf("%.10f", sellRequest.PricePSC),
-		PriceIrr:  fmt.Sprintf("%.10f", sellRequest.PriceIRR),
+		PricePsc:  sellRequest.PricePSC.StringFixed(10),
+		PriceIrr:  sellRequest.PriceIRR.StringFixed(10),
 		Status:    int32(sellRequest.Status),
 		CreatedAt: helpers.FormatJalaliDate(sellRequest.CreatedAt),
 	}
@@ -426,6 +512,135 @@ func (h *MarketplaceHandler) RequestGracePeriod(ctx context.Context, req *pb.Req
 	}, nil
 }
 
+// CounterBuyRequest answers a pending buy request with the seller's price
+// Implements POST /api/buy-requests/{buyFeatureRequest}/counter
+func (h *MarketplaceHandler) CounterBuyRequest(ctx context.Context, req *pb.CounterBuyRequestRequest) (*pb.NegotiationResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("request_id", req.RequestId, locale),
+		validateRequired("seller_id", req.SellerId, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	negotiation, err := h.service.CounterBuyRequest(ctx, req.RequestId, req.SellerId,
+		money.ParseOrZero(req.PricePsc), money.ParseOrZero(req.PriceIrr), req.Note)
+	if err != nil {
+		return nil, mapNegotiationError(err, "failed to counter buy request")
+	}
+	return h.buildNegotiationResponse(ctx, negotiation)
+}
+
+// AcceptCounterOffer agrees to the open counter-offer and completes the sale
+// Implements POST /api/buy-requests/{buyFeatureRequest}/counter/accept
+func (h *MarketplaceHandler) AcceptCounterOffer(ctx context.Context, req *pb.AnswerCounterOfferRequest) (*pb.BuyRequestResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("request_id", req.RequestId, locale),
+		validateRequired("buyer_id", req.BuyerId, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	buyRequest, err := h.service.AcceptCounterOffer(ctx, req.RequestId, req.BuyerId)
+	if err != nil {
+		return nil, mapNegotiationError(err, "failed to accept counter-offer")
+	}
+	return h.buildBuyRequestResponse(ctx, buyRequest)
+}
+
+// DeclineCounterOffer turns down the open counter-offer
+// Implements POST /api/buy-requests/{buyFeatureRequest}/counter/decline
+func (h *MarketplaceHandler) DeclineCounterOffer(ctx context.Context, req *pb.AnswerCounterOfferRequest) (*pb.NegotiationResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("request_id", req.RequestId, locale),
+		validateRequired("buyer_id", req.BuyerId, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	negotiation, err := h.service.DeclineCounterOffer(ctx, req.RequestId, req.BuyerId)
+	if err != nil {
+		return nil, mapNegotiationError(err, "failed to decline counter-offer")
+	}
+	return h.buildNegotiationResponse(ctx, negotiation)
+}
+
+// GetNegotiation returns a buy request with every round of its negotiation
+// Implements GET /api/buy-requests/{buyFeatureRequest}/negotiation
+func (h *MarketplaceHandler) GetNegotiation(ctx context.Context, req *pb.GetNegotiationRequest) (*pb.NegotiationResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("request_id", req.RequestId, locale),
+		validateRequired("user_id", req.UserId, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	negotiation, err := h.service.GetNegotiation(ctx, req.RequestId, req.UserId)
+	if err != nil {
+		return nil, mapNegotiationError(err, "failed to get negotiation")
+	}
+	return h.buildNegotiationResponse(ctx, negotiation)
+}
+
+func mapNegotiationError(err error, action string) error {
+	switch {
+	case errors.Is(err, service.ErrCounterOfferNoPrice), errors.Is(err, service.ErrCounterOfferSamePrice),
+		strings.Contains(err.Error(), "موجودی"):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, service.ErrNegotiationChanged),
+		errors.Is(err, service.ErrCounterOfferOpen), errors.Is(err, service.ErrNegotiationRoundsUsed),
+		errors.Is(err, service.ErrNoOpenCounterOffer), errors.Is(err, service.ErrBuyRequestNotNegotiable),
+		errors.Is(err, service.ErrFeatureReserved), errors.Is(err, service.ErrOwnershipLimitReached),
+		strings.Contains(err.Error(), "مجاز") || strings.Contains(err.Error(), "صبر") || strings.Contains(err.Error(), "زیر قیمت"):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case strings.Contains(err.Error(), "unauthorized"):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	case strings.Contains(err.Error(), "not found"):
+		return status.Errorf(codes.NotFound, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", action, err)
+}
+
+func (h *MarketplaceHandler) buildNegotiationResponse(ctx context.Context, negotiation *service.Negotiation) (*pb.NegotiationResponse, error) {
+	buyRequest, err := h.buildBuyRequestResponse(ctx, negotiation.Request)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build negotiation: %v", err)
+	}
+
+	response := &pb.NegotiationResponse{
+		BuyRequest: buyRequest,
+		RoundsUsed: int32(negotiation.RoundsUsed),
+		MaxRounds:  int32(negotiation.MaxRounds),
+	}
+	for _, offer := range negotiation.Offers {
+		pbOffer := &pb.BuyRequestOffer{
+			Id:        offer.ID,
+			Round:     int32(offer.Round),
+			OfferedBy: offer.OfferedBy,
+			PricePsc:  offer.PricePSC.StringFixed(2),
+			PriceIrr:  offer.PriceIRR.StringFixed(0),
+			Note:      offer.Note,
+			Status:    offer.Status,
+			CreatedAt: helpers.FormatJalaliDateTime(offer.CreatedAt),
+		}
+		if offer.RespondedAt.Valid {
+			pbOffer.RespondedAt = helpers.FormatJalaliDateTime(offer.RespondedAt.Time)
+		}
+		response.Offers = append(response.Offers, pbOffer)
+		if offer == negotiation.Open {
+			response.OpenOffer = pbOffer
+		}
+	}
+	return response, nil
+}
+
 // buildBuyRequestResponse builds a complete BuyRequestResponse from a BuyFeatureRequest model
 func (h *MarketplaceHandler) buildBuyRequestResponse(ctx context.Context, buyRequest *models.BuyFeatureRequest) (*pb.BuyRequestResponse, error) {
 	if buyRequest == nil {
@@ -437,8 +652,8 @@ func (h *MarketplaceHandler) buildBuyRequestResponse(ctx context.Context, buyReq
 		FeatureId: buyRequest.FeatureID,
 		Status:    int32(buyRequest.Status),
 		Note:      buyRequest.Note,
-		PricePsc:  fmt.Sprintf("%.2f", buyRequest.PricePSC),
-		PriceIrr:  fmt.Sprintf("%.0f", buyRequest.PriceIRR),
+		PricePsc:  buyRequest.PricePSC.StringFixed(2),
+		PriceIrr:  buyRequest.PriceIRR.StringFixed(0),
 		CreatedAt: helpers.FormatJalaliDate(buyRequest.CreatedAt),
 	}
 
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+
+	"metargb/features-service/internal/models"
+	"metargb/features-service/internal/service"
+	pb "metargb/shared/pb/features"
+	"metargb/shared/pkg/auth"
+	"metargb/shared/pkg/helpers"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PortfolioHandler struct {
+	pb.UnimplementedFeaturePortfolioServiceServer
+	service service.PortfolioServiceInterface
+}
+
+func NewPortfolioHandler(service service.PortfolioServiceInterface) *PortfolioHandler {
+	return &PortfolioHandler{
+		service: service,
+	}
+}
+
+// GetUserPortfolio handles GET /api/portfolio
+// Returns the authenticated user's features with profits, requests and values
+func (h *PortfolioHandler) GetUserPortfolio(ctx context.Context, req *pb.GetUserPortfolioRequest) (*pb.UserPortfolioResponse, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: authentication required")
+	}
+	if req.UserId != 0 && req.UserId != user.UserID {
+		return nil, status.Errorf(codes.PermissionDenied, "portfolio does not belong to user")
+	}
+
+	portfolio, err := h.service.GetUserPortfolio(ctx, user.UserID, int(req.Page), int(req.PerPage))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get portfolio: %v", err)
+	}
+
+	data := make([]*pb.PortfolioItem, 0, len(portfolio.Features))
+	for _, feature := range portfolio.Features {
+		data = append(data, portfolioItemToPB(user.UserID, feature))
+	}
+
+	totals := portfolio.Totals
+	lastPage := (totals.FeatureCount + portfolio.PerPage - 1) / portfolio.PerPage
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	return &pb.UserPortfolioResponse{
+		Data: data,
+		Summary: &pb.PortfolioSummary{
+			FeatureCount:       int32(totals.FeatureCount),
+			EstimatedValueIrr:  totals.EstimatedValueIRR,
+			ProfitMaskoni:      profitTotal(totals, "yellow"),
+			ProfitTejari:       profitTotal(totals, "red"),
+			ProfitAmozeshi:     profitTotal(totals, "blue"),
+			OnSale:             int32(totals.OnSale),
+			PendingBuyRequests: int32(totals.PendingBuyRequests),
+		},
+		Meta: &pb.PortfolioMeta{
+			CurrentPage: int32(portfolio.Page),
+			PerPage:     int32(portfolio.PerPage),
+			Total:       int32(totals.FeatureCount),
+			LastPage:    int32(lastPage),
+		},
+	}, nil
+}
+
+func portfolioItemToPB(userID uint64, feature *models.PortfolioFeature) *pb.PortfolioItem {
+	item := &pb.PortfolioItem{
+		FeatureId:          feature.Properties.FeatureID,
+		Properties:         models.PropertiesToPB(&feature.Properties),
+		EstimatedValueIrr:  feature.EstimatedValueIRR,
+		PendingBuyRequests: int32(feature.PendingBuyRequests),
+	}
+	if feature.ProfitID != 0 {
+		item.Profit = &pb.HourlyProfit{
+			Id:        feature.ProfitID,
+			FeatureId: feature.Properties.FeatureID,
+			UserId:    userID,
+			Asset:     feature.ProfitAsset,
+			Amount:    feature.ProfitAmount,
+			DeadLine:  helpers.FormatJalaliDate(feature.ProfitDeadLine),
+			IsActive:  feature.ProfitActive,
+		}
+	}
+	if feature.SellRequestID != 0 {
+		item.SellRequest = &pb.PortfolioSellRequest{
+			Id:       feature.SellRequestID,
+			PricePsc: feature.SellPricePSC,
+			PriceIrr: feature.SellPriceIRR,
+		}
+	}
+	return item
+}
+
+func profitTotal(totals *models.PortfolioTotals, asset string) string {
+	if total, ok := totals.ProfitByAsset[asset]; ok {
+		return total
+	}
+	return "0"
+}
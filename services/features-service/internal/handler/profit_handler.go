@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"metargb/features-service/internal/models"
 	"metargb/features-service/internal/service"
 	pb "metargb/shared/pb/features"
 	"metargb/shared/pkg/helpers"
@@ -144,3 +145,84 @@ func (h *ProfitHandler) GetProfitsByApplication(ctx context.Context, req *pb.Get
 		Success: true,
 	}, nil
 }
+
+// GetFeatureProfit shows the accrued profit of one of the user's features
+func (h *ProfitHandler) GetFeatureProfit(ctx context.Context, req *pb.GetFeatureProfitRequest) (*pb.FeatureProfitResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("user_id", req.UserId, locale),
+		validateRequired("feature_id", req.FeatureId, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	result, err := h.service.GetFeatureProfit(ctx, req.UserId, req.FeatureId)
+	if err != nil {
+		if err.Error() == "profit not found" {
+			return nil, status.Errorf(codes.NotFound, "profit not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get feature profit: %v", err)
+	}
+
+	profit := result.Profit
+	return &pb.FeatureProfitResponse{
+		Profit: &pb.HourlyProfit{
+			Id:        profit.ID,
+			FeatureId: profit.FeatureID,
+			UserId:    profit.UserID,
+			Asset:     profit.Asset,
+			Amount:    fmt.Sprintf("%.3f", profit.Amount),
+			DeadLine:  helpers.FormatJalaliDate(profit.Deadline),
+			IsActive:  profit.IsActive,
+		},
+		HourlyRate:        fmt.Sprintf("%.6f", result.HourlyRate),
+		Accruing:          result.Accruing,
+		SecondsToDeadline: result.SecondsToDeadline,
+		Compound:          result.Compound,
+	}, nil
+}
+
+// GetProfitSettings returns the user's auto-claim and compounding settings
+func (h *ProfitHandler) GetProfitSettings(ctx context.Context, req *pb.GetProfitSettingsRequest) (*pb.ProfitSettingsResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := validateRequired("user_id", req.UserId, locale)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	settings, withdrawProfitDays, err := h.service.GetProfitSettings(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get profit settings: %v", err)
+	}
+
+	return &pb.ProfitSettingsResponse{
+		AutoClaim:          settings.AutoClaim,
+		Compound:           settings.Compound,
+		WithdrawProfitDays: int32(withdrawProfitDays),
+	}, nil
+}
+
+// UpdateProfitSettings replaces the user's auto-claim and compounding settings
+func (h *ProfitHandler) UpdateProfitSettings(ctx context.Context, req *pb.UpdateProfitSettingsRequest) (*pb.ProfitSettingsResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := validateRequired("user_id", req.UserId, locale)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	settings, withdrawProfitDays, err := h.service.UpdateProfitSettings(ctx, &models.ProfitSettings{
+		UserID:    req.UserId,
+		AutoClaim: req.AutoClaim,
+		Compound:  req.Compound,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update profit settings: %v", err)
+	}
+
+	return &pb.ProfitSettingsResponse{
+		AutoClaim:          settings.AutoClaim,
+		Compound:           settings.Compound,
+		WithdrawProfitDays: int32(withdrawProfitDays),
+	}, nil
+}
@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"metargb/features-service/internal/constants"
+	"metargb/features-service/internal/models"
+	"metargb/features-service/internal/service"
+	pb "metargb/shared/pb/features"
+	"metargb/shared/pkg/helpers"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type SavedSearchHandler struct {
+	pb.UnimplementedSavedSearchServiceServer
+	service service.SavedSearchServiceInterface
+}
+
+func NewSavedSearchHandler(service service.SavedSearchServiceInterface) *SavedSearchHandler {
+	return &SavedSearchHandler{
+		service: service,
+	}
+}
+
+// savedSearchCriteria are the fields shared by the create and update requests
+type savedSearchCriteria struct {
+	name, karbari, color, currency, minPrice, maxPrice string
+	region                                             int32
+}
+
+// CreateSavedSearch handles POST /api/marketplace/saved-searches
+func (h *SavedSearchHandler) CreateSavedSearch(ctx context.Context, req *pb.CreateSavedSearchRequest) (*pb.SavedSearchResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := validateRequired("user_id", req.UserId, locale)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	search, err := parseSavedSearch(req.UserId, savedSearchCriteria{
+		name: req.Name, region: req.Region, karbari: req.Karbari, color: req.Color,
+		currency: req.Currency, minPrice: req.MinPrice, maxPrice: req.MaxPrice,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	search, err = h.service.CreateSavedSearch(ctx, search)
+	if err != nil {
+		return nil, mapSavedSearchError(err)
+	}
+
+	return &pb.SavedSearchResponse{Data: savedSearchToPB(search)}, nil
+}
+
+// UpdateSavedSearch handles PUT /api/marketplace/saved-searches/{savedSearch}
+func (h *SavedSearchHandler) UpdateSavedSearch(ctx context.Context, req *pb.UpdateSavedSearchRequest) (*pb.SavedSearchResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("user_id", req.UserId, locale),
+		validateRequired("saved_search_id", req.SavedSearchId, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	search, err := parseSavedSearch(req.UserId, savedSearchCriteria{
+		name: req.Name, region: req.Region, karbari: req.Karbari, color: req.Color,
+		currency: req.Currency, minPrice: req.MinPrice, maxPrice: req.MaxPrice,
+	})
+	if err != nil {
+		return nil, err
+	}
+	search.ID = req.SavedSearchId
+
+	search, err = h.service.UpdateSavedSearch(ctx, search)
+	if err != nil {
+		return nil, mapSavedSearchError(err)
+	}
+
+	return &pb.SavedSearchResponse{Data: savedSearchToPB(search)}, nil
+}
+
+// DeleteSavedSearch handles DELETE /api/marketplace/saved-searches/{savedSearch}
+func (h *SavedSearchHandler) DeleteSavedSearch(ctx context.Context, req *pb.DeleteSavedSearchRequest) (*emptypb.Empty, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("user_id", req.UserId, locale),
+		validateRequired("saved_search_id", req.SavedSearchId, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	if err := h.service.DeleteSavedSearch(ctx, req.UserId, req.SavedSearchId); err != nil {
+		return nil, mapSavedSearchError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListSavedSearches handles GET /api/marketplace/saved-searches
+func (h *SavedSearchHandler) ListSavedSearches(ctx context.Context, req *pb.ListSavedSearchesRequest) (*pb.ListSavedSearchesResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := validateRequired("user_id", req.UserId, locale)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	searches, err := h.service.ListSavedSearches(ctx, req.UserId)
+	if err != nil {
+		return nil, mapSavedSearchError(err)
+	}
+
+	data := make([]*pb.SavedSearch, 0, len(searches))
+	for _, search := range searches {
+		data = append(data, savedSearchToPB(search))
+	}
+
+	return &pb.ListSavedSearchesResponse{Data: data}, nil
+}
+
+// parseSavedSearch maps color to karbari and parses the price bounds the
+// same way ListForSaleFeatures does
+func parseSavedSearch(userID uint64, criteria savedSearchCriteria) (*models.SavedSearch, error) {
+	search := &models.SavedSearch{
+		UserID:   userID,
+		Name:     criteria.name,
+		Region:   criteria.region,
+		Karbari:  criteria.karbari,
+		Currency: criteria.currency,
+	}
+
+	validationErrors := make(map[string]string)
+	if criteria.color != "" {
+		karbari := constants.GetKarbariByColor(criteria.color)
+		if karbari == "" || (search.Karbari != "" && search.Karbari != karbari) {
+			validationErrors["color"] = "color must be yellow, red or blue and match karbari"
+		}
+		search.Karbari = karbari
+	}
+	var err error
+	if search.MinPrice, err = parseListingPrice(criteria.minPrice); err != nil {
+		validationErrors["min_price"] = "min_price must be a number"
+	}
+	if search.MaxPrice, err = parseListingPrice(criteria.maxPrice); err != nil {
+		validationErrors["max_price"] = "max_price must be a number"
+	}
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+	return search, nil
+}
+
+func mapSavedSearchError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrSavedSearchNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrSavedSearchLimit):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	case errors.Is(err, service.ErrSavedSearchEmpty):
+		return returnValidationError(map[string]string{"region": err.Error()})
+	case errors.Is(err, service.ErrSavedSearchInvalidRegion):
+		return returnValidationError(map[string]string{"region": err.Error()})
+	case errors.Is(err, service.ErrSavedSearchNameTooLong):
+		return returnValidationError(map[string]string{"name": err.Error()})
+	case errors.Is(err, service.ErrListingInvalidKarbari):
+		return returnValidationError(map[string]string{"karbari": err.Error()})
+	case errors.Is(err, service.ErrListingInvalidCurrency):
+		return returnValidationError(map[string]string{"currency": err.Error()})
+	case errors.Is(err, service.ErrListingInvalidPriceRange):
+		return returnValidationError(map[string]string{"min_price": err.Error()})
+	default:
+		return status.Errorf(codes.Internal, "operation failed: %v", err)
+	}
+}
+
+func savedSearchToPB(search *models.SavedSearch) *pb.SavedSearch {
+	return &pb.SavedSearch{
+		Id:       search.ID,
+		Name:     search.Name,
+		Region:   search.Region,
+		Karbari:  search.Karbari,
+		Currency: search.Currency,
+		MinPrice: strconv.FormatFloat(search.MinPrice, 'f', -1, 64),
+		MaxPrice: strconv.FormatFloat(search.MaxPrice, 'f', -1, 64),
+		Date:     helpers.FormatJalaliDate(search.CreatedAt),
+		Time:     helpers.FormatJalaliTime(search.CreatedAt),
+	}
+}
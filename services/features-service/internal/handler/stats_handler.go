@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"metargb/features-service/internal/repository"
+	pb "metargb/shared/pb/stats"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type StatsHandler struct {
+	pb.UnimplementedStatsServiceServer
+	statsRepo *repository.StatsRepository
+}
+
+func NewStatsHandler(statsRepo *repository.StatsRepository) *StatsHandler {
+	return &StatsHandler{
+		statsRepo: statsRepo,
+	}
+}
+
+// GetStats reports trade figures for the scheduled admin reports
+func (h *StatsHandler) GetStats(ctx context.Context, req *pb.GetStatsRequest) (*pb.GetStatsResponse, error) {
+	if req.From <= 0 || req.To <= req.From {
+		return nil, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+
+	trades, err := h.statsRepo.GetTradeStats(ctx, time.Unix(req.From, 0), time.Unix(req.To, 0))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "operation failed: %v", err)
+	}
+
+	return &pb.GetStatsResponse{
+		Metrics: []*pb.Metric{
+			{Key: "trades", Value: float64(trades.Count)},
+			{Key: "trade_volume_psc", Value: trades.PSCVolume},
+			{Key: "trade_volume_irr", Value: trades.IRRVolume},
+		},
+	}, nil
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"metargb/features-service/internal/service"
+	pb "metargb/shared/pb/features"
+	"metargb/shared/pkg/money"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// TradeHandler exposes trades to support-service for dispute handling
+type TradeHandler struct {
+	pb.UnimplementedTradeServiceServer
+	service service.TradeServiceInterface
+}
+
+func NewTradeHandler(service service.TradeServiceInterface) *TradeHandler {
+	return &TradeHandler{
+		service: service,
+	}
+}
+
+func (h *TradeHandler) GetTrade(ctx context.Context, req *pb.GetTradeRequest) (*pb.TradeResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := validateRequired("trade_id", req.TradeId, locale)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	trade, properties, err := h.service.GetTrade(ctx, req.TradeId)
+	if err != nil {
+		return nil, mapTradeError(err)
+	}
+
+	return &pb.TradeResponse{
+		Data: &pb.TradeDetails{
+			Id:           trade.ID,
+			FeatureId:    trade.FeatureID,
+			PropertiesId: properties.ID,
+			BuyerId:      trade.BuyerID,
+			SellerId:     trade.SellerID,
+			PscAmount:    money.Float(trade.PSCAmount),
+			IrrAmount:    money.Float(trade.IRRAmount),
+			TradedAt:     trade.CreatedAt.Unix(),
+		},
+	}, nil
+}
+
+func (h *TradeHandler) FreezeTradeFunds(ctx context.Context, req *pb.TradeFundsRequest) (*emptypb.Empty, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := validateRequired("trade_id", req.TradeId, locale)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	if err := h.service.FreezeTradeFunds(ctx, req.TradeId); err != nil {
+		return nil, mapTradeError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *TradeHandler) ReleaseTradeFunds(ctx context.Context, req *pb.TradeFundsRequest) (*emptypb.Empty, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := validateRequired("trade_id", req.TradeId, locale)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	if err := h.service.ReleaseTradeFunds(ctx, req.TradeId); err != nil {
+		return nil, mapTradeError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *TradeHandler) RefundTrade(ctx context.Context, req *pb.RefundTradeRequest) (*emptypb.Empty, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := validateRequired("trade_id", req.TradeId, locale)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	if err := h.service.RefundTrade(ctx, req.TradeId, req.FundsFrozen); err != nil {
+		return nil, mapTradeError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func mapTradeError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrTradeNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrTradeWalletUnavailable):
+		return status.Errorf(codes.Unavailable, "%s", err.Error())
+	case errors.Is(err, service.ErrTradeFundsNotFrozen),
+		errors.Is(err, service.ErrTradeFeatureResold),
+		errors.Is(err, service.ErrTradeRefundFailed):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	default:
+		return status.Errorf(codes.Internal, "operation failed: %v", err)
+	}
+}
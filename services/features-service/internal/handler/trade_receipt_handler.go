@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"metargb/features-service/internal/service"
+	pb "metargb/shared/pb/features"
+	"metargb/shared/pkg/auth"
+	"metargb/shared/pkg/helpers"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TradeReceiptHandler serves trade receipts to participants and verifies their codes
+type TradeReceiptHandler struct {
+	pb.UnimplementedTradeReceiptServiceServer
+	service service.TradeReceiptServiceInterface
+}
+
+func NewTradeReceiptHandler(service service.TradeReceiptServiceInterface) *TradeReceiptHandler {
+	return &TradeReceiptHandler{
+		service: service,
+	}
+}
+
+// GetTradeReceipt handles GET /api/trades/{trade}/receipt
+func (h *TradeReceiptHandler) GetTradeReceipt(ctx context.Context, req *pb.GetTradeReceiptRequest) (*pb.TradeReceiptResponse, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized: authentication required")
+	}
+
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := validateRequired("trade_id", req.TradeId, locale)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	receipt, err := h.service.GetReceipt(ctx, user.UserID, req.TradeId)
+	if err != nil {
+		return nil, mapTradeReceiptError(err)
+	}
+
+	return &pb.TradeReceiptResponse{Data: tradeReceiptToPB(receipt)}, nil
+}
+
+// VerifyTradeReceipt handles GET /api/trades/verify/{code}
+func (h *TradeReceiptHandler) VerifyTradeReceipt(ctx context.Context, req *pb.VerifyTradeReceiptRequest) (*pb.TradeReceiptResponse, error) {
+	if strings.TrimSpace(req.Code) == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	receipt, err := h.service.VerifyReceipt(ctx, req.Code)
+	if err != nil {
+		return nil, mapTradeReceiptError(err)
+	}
+
+	return &pb.TradeReceiptResponse{Data: tradeReceiptToPB(receipt)}, nil
+}
+
+func mapTradeReceiptError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrTradeNotFound),
+		errors.Is(err, service.ErrTradeReceiptNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrTradeReceiptNotParticipant):
+		return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case errors.Is(err, service.ErrTradeReceiptInvalidCode):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	default:
+		return status.Errorf(codes.Internal, "operation failed: %v", err)
+	}
+}
+
+func tradeReceiptToPB(receipt *service.TradeReceiptView) *pb.TradeReceipt {
+	data := &pb.TradeReceipt{
+		Code:         receipt.Code,
+		TradeId:      receipt.TradeID,
+		FeatureId:    receipt.FeatureID,
+		PropertiesId: receipt.PropertiesID,
+		BuyerCode:    receipt.BuyerCode,
+		SellerCode:   receipt.SellerCode,
+		PscAmount:    receipt.PSCAmount,
+		IrrAmount:    receipt.IRRAmount,
+		Date:         helpers.FormatJalaliDate(receipt.TradedAt),
+		Time:         helpers.FormatJalaliTime(receipt.TradedAt),
+		Status:       "completed",
+	}
+	if receipt.RefundedAt != nil {
+		data.Status = "refunded"
+		data.RefundedAt = helpers.FormatJalaliDateTime(*receipt.RefundedAt)
+	}
+	return data
+}
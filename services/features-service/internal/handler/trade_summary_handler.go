@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/features-service/internal/models"
+	"metargb/features-service/internal/service"
+	pb "metargb/shared/pb/features"
+	"metargb/shared/pkg/auth"
+	"metargb/shared/pkg/helpers"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TradeSummaryHandler struct {
+	pb.UnimplementedTradeSummaryServiceServer
+	service service.TradeSummaryServiceInterface
+}
+
+func NewTradeSummaryHandler(service service.TradeSummaryServiceInterface) *TradeSummaryHandler {
+	return &TradeSummaryHandler{
+		service: service,
+	}
+}
+
+// GetAnnualTradeSummary handles GET /api/trades/annual-summary
+// Returns the caller's trades, fees and profit payouts of a Jalali year
+func (h *TradeSummaryHandler) GetAnnualTradeSummary(ctx context.Context, req *pb.GetAnnualTradeSummaryRequest) (*pb.AnnualTradeSummaryResponse, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: authentication required")
+	}
+	if req.UserId != 0 && req.UserId != user.UserID {
+		return nil, status.Errorf(codes.PermissionDenied, "users can only read their own trade summary")
+	}
+
+	summary, err := h.service.GetAnnualTradeSummary(ctx, user.UserID, int(req.Year))
+	if err != nil {
+		if errors.Is(err, service.ErrTradeSummaryYear) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get trade summary: %v", err)
+	}
+
+	totals := summary.Totals
+	response := &pb.AnnualTradeSummaryResponse{
+		Year: int32(summary.Year),
+		From: helpers.FormatJalaliDate(summary.From),
+		To:   helpers.FormatJalaliDate(summary.To.AddDate(0, 0, -1)),
+		Totals: &pb.AnnualTradeTotals{
+			BoughtCount: int32(totals.BoughtCount),
+			BoughtPsc:   totals.BoughtPSC.String(),
+			BoughtIrr:   formatIRR(totals.BoughtIRR),
+			SoldCount:   int32(totals.SoldCount),
+			SoldPsc:     totals.SoldPSC.String(),
+			SoldIrr:     formatIRR(totals.SoldIRR),
+			FeesPsc:     totals.FeesPSC.String(),
+			FeesIrr:     formatIRR(totals.FeesIRR),
+			NetPsc:      totals.NetPSC().String(),
+			NetIrr:      formatIRR(totals.NetIRR()),
+		},
+		Profits: make([]*pb.AnnualProfit, 0, len(summary.Profits)),
+		Trades:  make([]*pb.AnnualTrade, 0, len(summary.Trades)),
+	}
+	for _, profit := range summary.Profits {
+		response.Profits = append(response.Profits, &pb.AnnualProfit{
+			Asset:  profit.Asset,
+			Amount: profit.Amount.String(),
+		})
+	}
+	for _, trade := range summary.Trades {
+		response.Trades = append(response.Trades, annualTradeToPB(trade))
+	}
+	return response, nil
+}
+
+func annualTradeToPB(trade *models.AnnualTrade) *pb.AnnualTrade {
+	item := &pb.AnnualTrade{
+		TradeId:      trade.TradeID,
+		FeatureId:    trade.FeatureID,
+		PropertiesId: trade.PropertiesID,
+		Role:         trade.Role,
+		Status:       "completed",
+		PricePsc:     trade.PricePSC.String(),
+		PriceIrr:     formatIRR(trade.PriceIRR),
+		FeePsc:       trade.FeePSC.String(),
+		FeeIrr:       formatIRR(trade.FeeIRR),
+		ReceiptCode:  trade.ReceiptCode,
+	}
+	if trade.Refunded {
+		item.Status = "refunded"
+	}
+	if !trade.TradedAt.IsZero() {
+		item.Date = helpers.FormatJalaliDate(trade.TradedAt)
+		item.Time = helpers.FormatJalaliTime(trade.TradedAt)
+	}
+	return item
+}
+
+// formatIRR rounds to whole rials, as wallets hold them
+func formatIRR(amount decimal.Decimal) string {
+	return amount.StringFixed(0)
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"metargb/features-service/internal/models"
+	"metargb/features-service/internal/service"
+	pb "metargb/shared/pb/features"
+	"metargb/shared/pkg/helpers"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type WatchlistHandler struct {
+	pb.UnimplementedWatchlistServiceServer
+	service service.WatchlistServiceInterface
+}
+
+func NewWatchlistHandler(service service.WatchlistServiceInterface) *WatchlistHandler {
+	return &WatchlistHandler{
+		service: service,
+	}
+}
+
+// AddToWatchlist handles POST /api/features/{feature}/watch
+func (h *WatchlistHandler) AddToWatchlist(ctx context.Context, req *pb.AddToWatchlistRequest) (*pb.WatchlistItemResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("user_id", req.UserId, locale),
+		validateRequired("feature_id", req.FeatureId, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	watch, err := h.service.AddToWatchlist(ctx, req.UserId, req.FeatureId)
+	if err != nil {
+		return nil, mapWatchlistError(err)
+	}
+
+	return &pb.WatchlistItemResponse{Data: watchedFeatureToPB(watch)}, nil
+}
+
+// RemoveFromWatchlist handles DELETE /api/features/{feature}/watch
+func (h *WatchlistHandler) RemoveFromWatchlist(ctx context.Context, req *pb.RemoveFromWatchlistRequest) (*emptypb.Empty, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("user_id", req.UserId, locale),
+		validateRequired("feature_id", req.FeatureId, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	if err := h.service.RemoveFromWatchlist(ctx, req.UserId, req.FeatureId); err != nil {
+		return nil, mapWatchlistError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListWatchlist handles GET /api/watchlist
+func (h *WatchlistHandler) ListWatchlist(ctx context.Context, req *pb.ListWatchlistRequest) (*pb.ListWatchlistResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := validateRequired("user_id", req.UserId, locale)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	watches, err := h.service.ListWatchlist(ctx, req.UserId)
+	if err != nil {
+		return nil, mapWatchlistError(err)
+	}
+
+	data := make([]*pb.WatchlistItem, 0, len(watches))
+	for _, watch := range watches {
+		data = append(data, watchedFeatureToPB(watch))
+	}
+
+	return &pb.ListWatchlistResponse{Data: data}, nil
+}
+
+func mapWatchlistError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrWatchlistFeatureNotFound), errors.Is(err, service.ErrWatchlistNotWatched):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrWatchlistFull):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	default:
+		return status.Errorf(codes.Internal, "operation failed: %v", err)
+	}
+}
+
+func watchedFeatureToPB(watch *models.WatchedFeature) *pb.WatchlistItem {
+	return &pb.WatchlistItem{
+		Id:           watch.ID,
+		FeatureId:    watch.FeatureID,
+		PropertiesId: watch.PropertiesID,
+		Karbari:      watch.Karbari,
+		OwnerId:      watch.OwnerID,
+		PricePsc:     watch.PricePSC,
+		PriceIrr:     watch.PriceIRR,
+		OnSale:       watch.SellRequestID != 0,
+		Date:         helpers.FormatJalaliDate(watch.CreatedAt),
+		Time:         helpers.FormatJalaliTime(watch.CreatedAt),
+	}
+}
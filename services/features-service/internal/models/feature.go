@@ -3,6 +3,8 @@ package models
 import (
 	"database/sql"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // Feature represents a land/property feature
@@ -35,55 +37,109 @@ type FeatureProperties struct {
 
 // Trade represents trades table
 type Trade struct {
-	ID        uint64    `db:"id"`
-	FeatureID uint64    `db:"feature_id"`
-	BuyerID   uint64    `db:"buyer_id"`
-	SellerID  uint64    `db:"seller_id"`
-	IRRAmount float64   `db:"irr_amount"`
-	PSCAmount float64   `db:"psc_amount"`
-	Date      time.Time `db:"date"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID        uint64          `db:"id"`
+	FeatureID uint64          `db:"feature_id"`
+	BuyerID   uint64          `db:"buyer_id"`
+	SellerID  uint64          `db:"seller_id"`
+	IRRAmount decimal.Decimal `db:"irr_amount"`
+	PSCAmount decimal.Decimal `db:"psc_amount"`
+	Date      time.Time       `db:"date"`
+	CreatedAt time.Time       `db:"created_at"`
+	UpdatedAt time.Time       `db:"updated_at"`
 }
 
 // BuyFeatureRequest represents buy_feature_requests table
 type BuyFeatureRequest struct {
-	ID                   uint64       `db:"id"`
-	BuyerID              uint64       `db:"buyer_id"`
-	SellerID             uint64       `db:"seller_id"`
-	FeatureID            uint64       `db:"feature_id"`
-	Note                 string       `db:"note"`
-	PricePSC             float64      `db:"price_psc"`
-	PriceIRR             float64      `db:"price_irr"`
-	Status               int          `db:"status"`
-	RequestedGracePeriod sql.NullTime `db:"requested_grace_period"`
-	DeletedAt            sql.NullTime `db:"deleted_at"` // Soft delete
-	CreatedAt            time.Time    `db:"created_at"`
-	UpdatedAt            time.Time    `db:"updated_at"`
+	ID                   uint64          `db:"id"`
+	BuyerID              uint64          `db:"buyer_id"`
+	SellerID             uint64          `db:"seller_id"`
+	FeatureID            uint64          `db:"feature_id"`
+	Note                 string          `db:"note"`
+	PricePSC             decimal.Decimal `db:"price_psc"`
+	PriceIRR             decimal.Decimal `db:"price_irr"`
+	Status               int             `db:"status"`
+	RequestedGracePeriod sql.NullTime    `db:"requested_grace_period"`
+	DeletedAt            sql.NullTime    `db:"deleted_at"` // Soft delete
+	CreatedAt            time.Time       `db:"created_at"`
+	UpdatedAt            time.Time       `db:"updated_at"`
+}
+
+// BuyRequestGracePeriod is a pending buy request whose seller granted a
+// grace period, with how often anti-sniping has extended it
+type BuyRequestGracePeriod struct {
+	ID         uint64          `db:"id"`
+	BuyerID    uint64          `db:"buyer_id"`
+	SellerID   uint64          `db:"seller_id"`
+	FeatureID  uint64          `db:"feature_id"`
+	PricePSC   decimal.Decimal `db:"price_psc"`
+	PriceIRR   decimal.Decimal `db:"price_irr"`
+	ExpiresAt  time.Time       `db:"requested_grace_period"`
+	Extensions int             `db:"grace_period_extensions"`
+}
+
+// BuyRequestExpiry represents buy_feature_request_expiries table, the
+// refund of a buy request cancelled when its grace period ended. Each asset
+// is marked refunded before it is paid so a retry never pays it twice.
+type BuyRequestExpiry struct {
+	BuyFeatureRequestID uint64          `db:"buy_feature_request_id"`
+	BuyerID             uint64          `db:"buyer_id"`
+	SellerID            uint64          `db:"seller_id"`
+	FeatureID           uint64          `db:"feature_id"`
+	PSC                 decimal.Decimal `db:"psc"`
+	IRR                 decimal.Decimal `db:"irr"`
+	GracePeriodEndedAt  time.Time       `db:"grace_period_ended_at"`
+	PSCRefundedAt       sql.NullTime    `db:"psc_refunded_at"`
+	IRRRefundedAt       sql.NullTime    `db:"irr_refunded_at"`
+	CompletedAt         sql.NullTime    `db:"completed_at"`
+}
+
+// Offer statuses of buy_feature_request_offers
+const (
+	OfferOpen      = "open"      // A counter-offer awaiting the buyer
+	OfferAccepted  = "accepted"  // The buyer accepted the counter-offer
+	OfferDeclined  = "declined"  // The buyer declined the counter-offer
+	OfferCountered = "countered" // The buyer's opening offer, answered with a counter-offer
+)
+
+// BuyRequestOffer represents buy_feature_request_offers table, one round of
+// the negotiation on a buy request. Round 0 is the buyer's opening offer,
+// recorded when the seller first counters it; later rounds are the seller's
+// counter-offers.
+type BuyRequestOffer struct {
+	ID                  uint64          `db:"id"`
+	BuyFeatureRequestID uint64          `db:"buy_feature_request_id"`
+	Round               int             `db:"round"`
+	OfferedBy           uint64          `db:"offered_by"`
+	PricePSC            decimal.Decimal `db:"price_psc"`
+	PriceIRR            decimal.Decimal `db:"price_irr"`
+	Note                string          `db:"note"`
+	Status              string          `db:"status"`
+	RespondedAt         sql.NullTime    `db:"responded_at"`
+	CreatedAt           time.Time       `db:"created_at"`
 }
 
 // SellFeatureRequest represents sell_feature_requests table
 type SellFeatureRequest struct {
-	ID        uint64    `db:"id"`
-	SellerID  uint64    `db:"seller_id"`
-	FeatureID uint64    `db:"feature_id"`
-	PricePSC  float64   `db:"price_psc"`
-	PriceIRR  float64   `db:"price_irr"`
-	Limit     int       `db:"limit"` // Percentage of stability (underpriced if < 100)
-	Status    int       `db:"status"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID        uint64          `db:"id"`
+	SellerID  uint64          `db:"seller_id"`
+	FeatureID uint64          `db:"feature_id"`
+	PricePSC  decimal.Decimal `db:"price_psc"`
+	PriceIRR  decimal.Decimal `db:"price_irr"`
+	Limit     int             `db:"limit"` // Percentage of stability (underpriced if < 100)
+	Status    int             `db:"status"`
+	CreatedAt time.Time       `db:"created_at"`
+	UpdatedAt time.Time       `db:"updated_at"`
 }
 
 // LockedAsset represents locked_wallets/locked_assets table
 type LockedAsset struct {
-	ID                  uint64    `db:"id"`
-	BuyFeatureRequestID uint64    `db:"buy_feature_request_id"`
-	FeatureID           uint64    `db:"feature_id"`
-	PSC                 float64   `db:"psc"`
-	IRR                 float64   `db:"irr"`
-	CreatedAt           time.Time `db:"created_at"`
-	UpdatedAt           time.Time `db:"updated_at"`
+	ID                  uint64          `db:"id"`
+	BuyFeatureRequestID uint64          `db:"buy_feature_request_id"`
+	FeatureID           uint64          `db:"feature_id"`
+	PSC                 decimal.Decimal `db:"psc"`
+	IRR                 decimal.Decimal `db:"irr"`
+	CreatedAt           time.Time       `db:"created_at"`
+	UpdatedAt           time.Time       `db:"updated_at"`
 }
 
 // FeatureHourlyProfit represents feature_hourly_profits table
@@ -103,6 +159,15 @@ type FeatureHourlyProfit struct {
 	Karbari      string `db:"karbari"`       // feature_properties.karbari
 }
 
+// ProfitSettings represents feature_profit_settings table, a user's choices
+// for how their hourly profits are claimed. Users without a row use the zero
+// value.
+type ProfitSettings struct {
+	UserID    uint64 `db:"user_id"`
+	AutoClaim bool   `db:"auto_claim"` // Claim profits before their dead_line
+	Compound  bool   `db:"compound"`   // Unclaimed profit earns profit too
+}
+
 // FeatureLimit represents feature_limits table (for limited feature campaigns)
 type FeatureLimit struct {
 	ID                 uint64    `db:"id"`
@@ -135,12 +200,12 @@ type LimitedFeaturePurchase struct {
 
 // Commission represents comissions table
 type Commission struct {
-	ID        uint64    `db:"id"`
-	TradeID   uint64    `db:"trade_id"`
-	PSC       float64   `db:"psc"`
-	IRR       float64   `db:"irr"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID        uint64          `db:"id"`
+	TradeID   uint64          `db:"trade_id"`
+	PSC       decimal.Decimal `db:"psc"`
+	IRR       decimal.Decimal `db:"irr"`
+	CreatedAt time.Time       `db:"created_at"`
+	UpdatedAt time.Time       `db:"updated_at"`
 }
 
 // LockedFeature represents locked_features table
@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// Feature history event types
+const (
+	HistoryEventTrade        = "trade"
+	HistoryEventSellRequest  = "sell_request"
+	HistoryEventBuyRequest   = "buy_request"
+	HistoryEventBuild        = "build"
+	HistoryEventProfitPayout = "profit_payout"
+)
+
+// FeatureHistoryEvent is a trade, request, building or profit payout of a
+// feature. Which fields are set depends on the type.
+type FeatureHistoryEvent struct {
+	Type           string
+	ReferenceID    uint64
+	OccurredAt     time.Time
+	UserID         uint64
+	CounterpartyID uint64
+	PricePSC       string
+	PriceIRR       string
+	Asset          string
+	Amount         string
+	Status         string
+}
+
+// FeatureHistory is one page of a feature's history, oldest first
+type FeatureHistory struct {
+	Events  []*FeatureHistoryEvent
+	Total   int
+	Page    int
+	PerPage int
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+// GeometryPoint is one polygon vertex
+type GeometryPoint struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// GeometryVersion represents feature_geometry_versions table. Coordinates are
+// stored as a JSON array of points, closing point included.
+type GeometryVersion struct {
+	ID          uint64          `db:"id"`
+	FeatureID   uint64          `db:"feature_id"`
+	GeometryID  uint64          `db:"geometry_id"`
+	Version     int             `db:"version"`
+	Coordinates []GeometryPoint `db:"coordinates"`
+	Area        float64         `db:"area"`
+	EditedBy    uint64          `db:"edited_by"`
+	Reason      string          `db:"reason"`
+	CreatedAt   time.Time       `db:"created_at"`
+}
+
+// PolygonArea returns the area of a ring using the shoelace formula. The ring
+// may or may not repeat its first point at the end.
+func PolygonArea(points []GeometryPoint) float64 {
+	n := len(points)
+	if n < 3 {
+		return 0
+	}
+	var sum float64
+	for i := 0; i < n; i++ {
+		j := (i + 1) % n
+		sum += points[i].X*points[j].Y - points[j].X*points[i].Y
+	}
+	return math.Abs(sum) / 2
+}
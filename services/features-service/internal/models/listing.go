@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// Listing is an open sell request joined with the feature it sells
+type Listing struct {
+	SellRequestID uint64
+	FeatureID     uint64
+	PropertiesID  string
+	Karbari       string
+	Region        int32
+	Area          int64
+	Address       string
+	SellerID      uint64
+	PricePSC      float64
+	PriceIRR      float64
+	ListedAt      time.Time
+}
+
+// Sort orders for marketplace listings
+const (
+	ListingSortNewest    = "newest"
+	ListingSortPriceAsc  = "price_asc"
+	ListingSortPriceDesc = "price_desc"
+)
+
+// ListingFilter narrows and orders marketplace listings. Prices are compared
+// in Currency ("psc" or "irr"); a zero bound is not applied.
+type ListingFilter struct {
+	Region   int32
+	Karbari  string
+	Currency string
+	MinPrice float64
+	MaxPrice float64
+	Sort     string
+	Limit    int
+	Offset   int
+}
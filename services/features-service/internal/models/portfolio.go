@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// PortfolioFeature is an owned feature joined with its hourly profit, pending
+// sell request and received buy requests
+type PortfolioFeature struct {
+	Properties         FeatureProperties
+	ProfitID           uint64 // 0 if the feature has no hourly profit
+	ProfitAsset        string
+	ProfitAmount       string
+	ProfitDeadLine     time.Time
+	ProfitActive       bool
+	SellRequestID      uint64 // Latest pending sell request, 0 if the feature is not for sale
+	SellPricePSC       string
+	SellPriceIRR       string
+	PendingBuyRequests int
+	EstimatedValueIRR  string // Set by the service, empty when no rate is available
+}
+
+// PortfolioTotals aggregates every feature a user owns
+type PortfolioTotals struct {
+	FeatureCount       int
+	StabilityByKarbari map[string]float64
+	ProfitByAsset      map[string]string
+	OnSale             int
+	PendingBuyRequests int
+	EstimatedValueIRR  string // Set by the service, empty when a rate is unavailable
+}
+
+// Portfolio is one page of a user's features with totals over all of them
+type Portfolio struct {
+	Features []*PortfolioFeature
+	Totals   *PortfolioTotals
+	Page     int
+	PerPage  int
+}
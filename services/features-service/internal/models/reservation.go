@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// FeatureReservation represents feature_reservations table. A reservation
+// holds a feature for an installment buyer at the price it had when reserved.
+// TradeID is set once the purchase is completed.
+type FeatureReservation struct {
+	ID        uint64          `db:"id"`
+	FeatureID uint64          `db:"feature_id"`
+	BuyerID   uint64          `db:"buyer_id"`
+	SellerID  uint64          `db:"seller_id"`
+	PricePSC  decimal.Decimal `db:"price_psc"`
+	PriceIRR  decimal.Decimal `db:"price_irr"`
+	TradeID   *uint64         `db:"trade_id"`
+	CreatedAt time.Time       `db:"created_at"`
+	UpdatedAt time.Time       `db:"updated_at"`
+}
@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// SavedSearch represents saved_searches table. Zero criteria match anything.
+// LastSellRequestID is the newest sell request already checked against the
+// search, so its owner is only notified of listings created after it.
+type SavedSearch struct {
+	ID                uint64    `db:"id"`
+	UserID            uint64    `db:"user_id"`
+	Name              string    `db:"name"`
+	Region            int32     `db:"region"`
+	Karbari           string    `db:"karbari"`
+	Currency          string    `db:"currency"`
+	MinPrice          float64   `db:"min_price"`
+	MaxPrice          float64   `db:"max_price"`
+	LastSellRequestID uint64    `db:"last_sell_request_id"`
+	CreatedAt         time.Time `db:"created_at"`
+	UpdatedAt         time.Time `db:"updated_at"`
+}
+
+// Matches reports whether listing satisfies the search criteria
+func (s *SavedSearch) Matches(listing *Listing) bool {
+	if s.Region != 0 && listing.Region != s.Region {
+		return false
+	}
+	if s.Karbari != "" && listing.Karbari != s.Karbari {
+		return false
+	}
+
+	price := listing.PriceIRR
+	if s.Currency == "psc" {
+		price = listing.PricePSC
+	}
+	if s.MinPrice > 0 && price < s.MinPrice {
+		return false
+	}
+	if s.MaxPrice > 0 && price > s.MaxPrice {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,74 @@
+package models
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// receiptCodeAlphabet is Crockford's base32: no I, L, O or U, so codes read
+// aloud or copied by hand are not misread
+const receiptCodeAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// receiptCodeLength is the number of characters in a verification code,
+// 50 random bits, written as two groups of five
+const receiptCodeLength = 10
+
+// TradeReceipt represents trade_receipts joined with the trade it was issued for
+type TradeReceipt struct {
+	ID           uint64
+	TradeID      uint64
+	Code         string
+	FeatureID    uint64
+	PropertiesID string // feature_properties.id, the code shown on the map
+	BuyerID      uint64
+	SellerID     uint64
+	PSCAmount    float64
+	IRRAmount    float64
+	TradedAt     time.Time
+	RefundedAt   *time.Time // Set when a dispute returned the feature to the seller
+	IssuedAt     time.Time
+}
+
+// NewReceiptCode returns a random verification code such as "7KQ2M-XH93A"
+func NewReceiptCode() (string, error) {
+	buf := make([]byte, receiptCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate receipt code: %w", err)
+	}
+	code := make([]byte, 0, receiptCodeLength+1)
+	for i, b := range buf {
+		if i == receiptCodeLength/2 {
+			code = append(code, '-')
+		}
+		// 256 is a multiple of 32, so every character is equally likely
+		code = append(code, receiptCodeAlphabet[int(b)%len(receiptCodeAlphabet)])
+	}
+	return string(code), nil
+}
+
+// NormalizeReceiptCode turns a code as typed by a person into the stored
+// form. Case, spaces and dashes are ignored, and O, I and L are read as 0, 1
+// and 1. Returns false if the input cannot be a verification code.
+func NormalizeReceiptCode(input string) (string, bool) {
+	var chars []byte
+	for _, r := range strings.ToUpper(input) {
+		switch r {
+		case ' ', '-':
+			continue
+		case 'O':
+			r = '0'
+		case 'I', 'L':
+			r = '1'
+		}
+		if r > 127 || !strings.ContainsRune(receiptCodeAlphabet, r) {
+			return "", false
+		}
+		chars = append(chars, byte(r))
+	}
+	if len(chars) != receiptCodeLength {
+		return "", false
+	}
+	return string(chars[:receiptCodeLength/2]) + "-" + string(chars[receiptCodeLength/2:]), true
+}
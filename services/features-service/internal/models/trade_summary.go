@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Sides of a trade in a user's annual summary
+const (
+	TradeRoleBuyer  = "buyer"
+	TradeRoleSeller = "seller"
+)
+
+// AnnualTrade is a trade seen from one of its parties
+type AnnualTrade struct {
+	TradeID      uint64
+	FeatureID    uint64
+	PropertiesID string
+	Role         string
+	Refunded     bool
+	PricePSC     decimal.Decimal
+	PriceIRR     decimal.Decimal
+	FeePSC       decimal.Decimal // The party's half of the commission
+	FeeIRR       decimal.Decimal
+	ReceiptCode  string // Empty for trades from before receipts were issued
+	TradedAt     time.Time
+}
+
+// AnnualTradeTotals sums the completed trades of a year
+type AnnualTradeTotals struct {
+	BoughtCount int
+	BoughtPSC   decimal.Decimal
+	BoughtIRR   decimal.Decimal
+	SoldCount   int
+	SoldPSC     decimal.Decimal
+	SoldIRR     decimal.Decimal
+	FeesPSC     decimal.Decimal
+	FeesIRR     decimal.Decimal
+}
+
+// NetPSC is what selling brought in after purchases and fees
+func (t AnnualTradeTotals) NetPSC() decimal.Decimal {
+	return t.SoldPSC.Sub(t.BoughtPSC).Sub(t.FeesPSC)
+}
+
+// NetIRR is what selling brought in after purchases and fees
+func (t AnnualTradeTotals) NetIRR() decimal.Decimal {
+	return t.SoldIRR.Sub(t.BoughtIRR).Sub(t.FeesIRR)
+}
+
+// AnnualProfit is the hourly profit of one asset paid out in a year
+type AnnualProfit struct {
+	Asset  string
+	Amount decimal.Decimal
+}
+
+// AnnualTradeSummary is a user's trading over one Jalali year, From
+// inclusive to To exclusive
+type AnnualTradeSummary struct {
+	Year    int
+	From    time.Time
+	To      time.Time
+	Totals  AnnualTradeTotals
+	Profits []*AnnualProfit
+	Trades  []*AnnualTrade
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// FeatureWatch represents feature_watchlists table. The last_* columns hold
+// the feature's state as of the last alert sent to the watcher.
+type FeatureWatch struct {
+	ID                uint64    `db:"id"`
+	UserID            uint64    `db:"user_id"`
+	FeatureID         uint64    `db:"feature_id"`
+	LastOwnerID       uint64    `db:"last_owner_id"`
+	LastPricePSC      string    `db:"last_price_psc"`
+	LastPriceIRR      string    `db:"last_price_irr"`
+	LastSellRequestID uint64    `db:"last_sell_request_id"`
+	CreatedAt         time.Time `db:"created_at"`
+	UpdatedAt         time.Time `db:"updated_at"`
+}
+
+// WatchedFeature is a watchlist entry joined with the feature's current state
+type WatchedFeature struct {
+	FeatureWatch
+	PropertiesID  string
+	Karbari       string
+	OwnerID       uint64
+	PricePSC      string
+	PriceIRR      string
+	SellRequestID uint64 // Latest pending sell request, 0 if the feature is not for sale
+}
@@ -0,0 +1,91 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/redis/go-redis/v9/maintnotifications"
+
+	"metargb/features-service/internal/models"
+)
+
+// FeatureGeometryChangedChannel is the Redis channel the WebSocket gateway
+// relays to map clients when a feature polygon is redrawn
+const FeatureGeometryChangedChannel = "feature-geometry-changed"
+
+// RedisPublisher publishes feature events to Redis for WebSocket broadcasting
+type RedisPublisher struct {
+	client *redis.Client
+}
+
+// NewRedisPublisher creates a new Redis publisher
+func NewRedisPublisher(redisURL string) (*RedisPublisher, error) {
+	client, err := NewRedisClient(redisURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RedisPublisher{
+		client: client,
+	}, nil
+}
+
+// NewRedisClient connects to Redis and checks the connection
+func NewRedisClient(redisURL string) (*redis.Client, error) {
+	opts, err := redis.ParseURL(redisURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Redis URL: %w", err)
+	}
+
+	// Disable maint notifications to avoid warning about maint_notifications command
+	opts.MaintNotificationsConfig = &maintnotifications.Config{
+		Mode: maintnotifications.ModeDisabled,
+	}
+
+	client := redis.NewClient(opts)
+
+	// Test connection
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	return client, nil
+}
+
+// FeatureGeometryChangedEvent carries the new polygon of a feature
+type FeatureGeometryChangedEvent struct {
+	ID          uint64   `json:"id"`
+	Version     int      `json:"version"`
+	Coordinates []string `json:"coordinates"` // "x,y" strings, closing point included
+}
+
+// PublishGeometryChanged publishes a feature's new geometry version
+func (p *RedisPublisher) PublishGeometryChanged(ctx context.Context, version *models.GeometryVersion) error {
+	event := FeatureGeometryChangedEvent{
+		ID:          version.FeatureID,
+		Version:     version.Version,
+		Coordinates: make([]string, 0, len(version.Coordinates)),
+	}
+	for _, point := range version.Coordinates {
+		event.Coordinates = append(event.Coordinates, fmt.Sprintf("%.6f,%.6f", point.X, point.Y))
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	if err := p.client.Publish(ctx, FeatureGeometryChangedChannel, payload).Err(); err != nil {
+		return fmt.Errorf("failed to publish to Redis: %w", err)
+	}
+
+	return nil
+}
+
+// Close closes the Redis connection
+func (p *RedisPublisher) Close() error {
+	return p.client.Close()
+}
@@ -0,0 +1,68 @@
+package pubsub
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/redis/go-redis/v9/maintnotifications"
+
+	"metargb/shared/pkg/variables"
+)
+
+// VariableSubscriber receives the variable changes commercial-service
+// publishes, so cached rates and pricing limits are dropped
+type VariableSubscriber struct {
+	client *redis.Client
+}
+
+// NewVariableSubscriber connects to Redis
+func NewVariableSubscriber(redisURL string) (*VariableSubscriber, error) {
+	opts, err := redis.ParseURL(redisURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Redis URL: %w", err)
+	}
+
+	// Disable maint notifications to avoid warning about maint_notifications command
+	opts.MaintNotificationsConfig = &maintnotifications.Config{
+		Mode: maintnotifications.ModeDisabled,
+	}
+
+	client := redis.NewClient(opts)
+
+	// Test connection
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	return &VariableSubscriber{client: client}, nil
+}
+
+// Subscribe calls onChange for every variable change until ctx is cancelled.
+// Malformed events are skipped.
+func (s *VariableSubscriber) Subscribe(ctx context.Context, onChange func(variables.ChangedEvent)) {
+	sub := s.client.Subscribe(ctx, variables.Channel)
+	go func() {
+		defer sub.Close()
+		messages := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+				if event, err := variables.Unmarshal([]byte(message.Payload)); err == nil {
+					onChange(event)
+				}
+			}
+		}
+	}()
+}
+
+// Close closes the Redis connection
+func (s *VariableSubscriber) Close() error {
+	return s.client.Close()
+}
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/features-service/internal/models"
+)
+
+// BuyRequestExpiryRepository records the refunds of buy requests cancelled
+// because their grace period ended. A refund is leased to one worker at a
+// time, so replicas never settle the same expiry concurrently.
+type BuyRequestExpiryRepository struct {
+	db *sql.DB
+}
+
+func NewBuyRequestExpiryRepository(db *sql.DB) *BuyRequestExpiryRepository {
+	return &BuyRequestExpiryRepository{db: db}
+}
+
+// Expire cancels a pending buy request whose grace period ended at or before
+// now and records the locked assets to refund, leased to the caller for
+// lease. It returns nil when the request was accepted, removed or extended
+// meanwhile.
+func (r *BuyRequestExpiryRepository) Expire(ctx context.Context, period *models.BuyRequestGracePeriod, now time.Time, lease time.Duration) (*models.BuyRequestExpiry, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE buy_feature_requests
+		SET deleted_at = NOW()
+		WHERE id = ? AND status = 0 AND deleted_at IS NULL AND requested_grace_period <= ?
+	`, period.ID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expire buy request: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if affected != 1 {
+		return nil, nil
+	}
+
+	// Requests sent without a wallet lock have nothing to refund
+	psc, irr := decimal.Zero, decimal.Zero
+	err = tx.QueryRowContext(ctx, "SELECT psc, irr FROM locked_wallets WHERE buy_feature_request_id = ?", period.ID).Scan(&psc, &irr)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to get locked assets: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO buy_feature_request_expiries
+			(buy_feature_request_id, buyer_id, seller_id, feature_id, psc, irr, grace_period_ended_at, locked_until, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, DATE_ADD(NOW(), INTERVAL ? SECOND), NOW(), NOW())
+	`, period.ID, period.BuyerID, period.SellerID, period.FeatureID, psc, irr, period.ExpiresAt, int(lease.Seconds()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to record expiry: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit expiry: %w", err)
+	}
+	return &models.BuyRequestExpiry{
+		BuyFeatureRequestID: period.ID,
+		BuyerID:             period.BuyerID,
+		SellerID:            period.SellerID,
+		FeatureID:           period.FeatureID,
+		PSC:                 psc,
+		IRR:                 irr,
+		GracePeriodEndedAt:  period.ExpiresAt,
+	}, nil
+}
+
+// ListUnsettled lists up to limit expiries whose refund did not finish and
+// whose lease ran out, oldest first
+func (r *BuyRequestExpiryRepository) ListUnsettled(ctx context.Context, limit int) ([]*models.BuyRequestExpiry, error) {
+	query := `
+		SELECT buy_feature_request_id, buyer_id, seller_id, feature_id, psc, irr, grace_period_ended_at, psc_refunded_at, irr_refunded_at, completed_at
+		FROM buy_feature_request_expiries
+		WHERE completed_at IS NULL AND (locked_until IS NULL OR locked_until < NOW())
+		ORDER BY buy_feature_request_id
+		LIMIT ?
+	`
+	rows, err := r.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unsettled expiries: %w", err)
+	}
+	defer rows.Close()
+
+	var expiries []*models.BuyRequestExpiry
+	for rows.Next() {
+		expiry := &models.BuyRequestExpiry{}
+		if err := rows.Scan(
+			&expiry.BuyFeatureRequestID, &expiry.BuyerID, &expiry.SellerID, &expiry.FeatureID,
+			&expiry.PSC, &expiry.IRR, &expiry.GracePeriodEndedAt,
+			&expiry.PSCRefundedAt, &expiry.IRRRefundedAt, &expiry.CompletedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan expiry: %w", err)
+		}
+		expiries = append(expiries, expiry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate expiries: %w", err)
+	}
+	return expiries, nil
+}
+
+// Lease takes an unsettled expiry for lease. It reports false when another
+// worker holds it or it was settled.
+func (r *BuyRequestExpiryRepository) Lease(ctx context.Context, requestID uint64, lease time.Duration) (bool, error) {
+	query := `
+		UPDATE buy_feature_request_expiries
+		SET locked_until = DATE_ADD(NOW(), INTERVAL ? SECOND), updated_at = NOW()
+		WHERE buy_feature_request_id = ? AND completed_at IS NULL AND (locked_until IS NULL OR locked_until < NOW())
+	`
+	return r.execAffected(ctx, query, int(lease.Seconds()), requestID)
+}
+
+// Release gives up the lease of an expiry so the next run retries it
+func (r *BuyRequestExpiryRepository) Release(ctx context.Context, requestID uint64) error {
+	query := "UPDATE buy_feature_request_expiries SET locked_until = NULL, updated_at = NOW() WHERE buy_feature_request_id = ?"
+	_, err := r.db.ExecContext(ctx, query, requestID)
+	return err
+}
+
+// MarkRefunded records that asset ("psc" or "irr") is being refunded. It
+// reports false when it already was.
+func (r *BuyRequestExpiryRepository) MarkRefunded(ctx context.Context, requestID uint64, asset string) (bool, error) {
+	column, err := refundedColumn(asset)
+	if err != nil {
+		return false, err
+	}
+	query := fmt.Sprintf(`
+		UPDATE buy_feature_request_expiries
+		SET %[1]s = NOW(), updated_at = NOW()
+		WHERE buy_feature_request_id = ? AND %[1]s IS NULL
+	`, column)
+	return r.execAffected(ctx, query, requestID)
+}
+
+// UnmarkRefunded undoes MarkRefunded when the refund failed
+func (r *BuyRequestExpiryRepository) UnmarkRefunded(ctx context.Context, requestID uint64, asset string) error {
+	column, err := refundedColumn(asset)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf("UPDATE buy_feature_request_expiries SET %s = NULL, updated_at = NOW() WHERE buy_feature_request_id = ?", column)
+	_, err = r.db.ExecContext(ctx, query, requestID)
+	return err
+}
+
+// Complete marks an expiry settled. It reports false when it already was.
+func (r *BuyRequestExpiryRepository) Complete(ctx context.Context, requestID uint64) (bool, error) {
+	query := `
+		UPDATE buy_feature_request_expiries
+		SET completed_at = NOW(), locked_until = NULL, updated_at = NOW()
+		WHERE buy_feature_request_id = ? AND completed_at IS NULL
+	`
+	return r.execAffected(ctx, query, requestID)
+}
+
+func (r *BuyRequestExpiryRepository) execAffected(ctx context.Context, query string, args ...interface{}) (bool, error) {
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func refundedColumn(asset string) (string, error) {
+	switch asset {
+	case "psc":
+		return "psc_refunded_at", nil
+	case "irr":
+		return "irr_refunded_at", nil
+	default:
+		return "", fmt.Errorf("unknown refund asset %q", asset)
+	}
+}
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/features-service/internal/models"
+)
+
+// BuyRequestOfferRepository records the negotiation on buy requests: the
+// buyer's opening offer and the seller's counter-offers, one row per round
+type BuyRequestOfferRepository struct {
+	db *sql.DB
+}
+
+func NewBuyRequestOfferRepository(db *sql.DB) *BuyRequestOfferRepository {
+	return &BuyRequestOfferRepository{db: db}
+}
+
+// ListByRequest returns the rounds of a buy request's negotiation in order
+func (r *BuyRequestOfferRepository) ListByRequest(ctx context.Context, buyRequestID uint64) ([]*models.BuyRequestOffer, error) {
+	query := `
+		SELECT id, buy_feature_request_id, round, offered_by, price_psc, price_irr, COALESCE(note, ''), status, responded_at, created_at
+		FROM buy_feature_request_offers
+		WHERE buy_feature_request_id = ?
+		ORDER BY round
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, buyRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list offers: %w", err)
+	}
+	defer rows.Close()
+
+	var offers []*models.BuyRequestOffer
+	for rows.Next() {
+		offer := &models.BuyRequestOffer{}
+		if err := rows.Scan(
+			&offer.ID, &offer.BuyFeatureRequestID, &offer.Round, &offer.OfferedBy,
+			&offer.PricePSC, &offer.PriceIRR, &offer.Note, &offer.Status,
+			&offer.RespondedAt, &offer.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan offer: %w", err)
+		}
+		offers = append(offers, offer)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate offers: %w", err)
+	}
+	return offers, nil
+}
+
+// CreateCounter records a counter-offer, and the opening offer it answers
+// when opening is not nil. It reports false, recording nothing, when another
+// counter-offer took the same round meanwhile.
+func (r *BuyRequestOfferRepository) CreateCounter(ctx context.Context, opening, counter *models.BuyRequestOffer) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	insert := `
+		INSERT INTO buy_feature_request_offers
+			(buy_feature_request_id, round, offered_by, price_psc, price_irr, note, status, responded_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, NULLIF(?, ''), ?, ?, NOW(), NOW())
+	`
+	for _, offer := range []*models.BuyRequestOffer{opening, counter} {
+		if offer == nil {
+			continue
+		}
+		result, err := tx.ExecContext(ctx, insert,
+			offer.BuyFeatureRequestID, offer.Round, offer.OfferedBy, offer.PricePSC, offer.PriceIRR,
+			offer.Note, offer.Status, offer.RespondedAt,
+		)
+		if isDuplicateKey(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to record offer: %w", err)
+		}
+		if id, err := result.LastInsertId(); err == nil {
+			offer.ID = uint64(id)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit offer: %w", err)
+	}
+	return true, nil
+}
+
+// Decline marks an open counter-offer declined. It reports false when the
+// offer was answered meanwhile.
+func (r *BuyRequestOfferRepository) Decline(ctx context.Context, offerID uint64) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE buy_feature_request_offers
+		SET status = ?, responded_at = NOW(), updated_at = NOW()
+		WHERE id = ? AND status = ?
+	`, models.OfferDeclined, offerID, models.OfferOpen)
+	if err != nil {
+		return false, fmt.Errorf("failed to decline offer: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+// Accept marks an open counter-offer accepted, moves its buy request to the
+// offered price and records lockedPSC and lockedIRR as the buyer's locked
+// assets, all at once. It reports false, changing nothing, when the offer
+// was answered or the request accepted or removed meanwhile.
+func (r *BuyRequestOfferRepository) Accept(ctx context.Context, offer *models.BuyRequestOffer, lockedPSC, lockedIRR decimal.Decimal) (bool, error) {
+	return r.settle(ctx, offer.ID, models.OfferOpen, models.OfferAccepted, true,
+		offer.BuyFeatureRequestID, offer.PricePSC, offer.PriceIRR, lockedPSC, lockedIRR)
+}
+
+// Reopen undoes Accept when the buyer's wallet could not be charged: the
+// offer is open again and the request is back at pricePSC and priceIRR with
+// lockedPSC and lockedIRR locked
+func (r *BuyRequestOfferRepository) Reopen(ctx context.Context, offer *models.BuyRequestOffer, pricePSC, priceIRR, lockedPSC, lockedIRR decimal.Decimal) error {
+	_, err := r.settle(ctx, offer.ID, models.OfferAccepted, models.OfferOpen, false,
+		offer.BuyFeatureRequestID, pricePSC, priceIRR, lockedPSC, lockedIRR)
+	return err
+}
+
+func (r *BuyRequestOfferRepository) settle(ctx context.Context, offerID uint64, from, to string, responded bool, buyRequestID uint64, pricePSC, priceIRR, lockedPSC, lockedIRR decimal.Decimal) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE buy_feature_request_offers
+		SET status = ?, responded_at = IF(?, NOW(), NULL), updated_at = NOW()
+		WHERE id = ? AND status = ?
+	`, to, responded, offerID, from)
+	if err != nil {
+		return false, fmt.Errorf("failed to update offer: %w", err)
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected != 1 {
+		return false, err
+	}
+
+	// Locked so the request cannot be accepted or removed at its old price
+	// meanwhile; the price may not change, so rows affected would not tell
+	var id uint64
+	err = tx.QueryRowContext(ctx, `
+		SELECT id FROM buy_feature_requests
+		WHERE id = ? AND status = 0 AND deleted_at IS NULL
+		FOR UPDATE
+	`, buyRequestID).Scan(&id)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to lock buy request: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE buy_feature_requests
+		SET price_psc = ?, price_irr = ?, updated_at = NOW()
+		WHERE id = ?
+	`, pricePSC, priceIRR, buyRequestID)
+	if err != nil {
+		return false, fmt.Errorf("failed to update buy request price: %w", err)
+	}
+
+	// Requests sent without a wallet lock have no row to update
+	_, err = tx.ExecContext(ctx, `
+		UPDATE locked_wallets
+		SET psc = ?, irr = ?, updated_at = NOW()
+		WHERE buy_feature_request_id = ?
+	`, lockedPSC, lockedIRR, buyRequestID)
+	if err != nil {
+		return false, fmt.Errorf("failed to update locked assets: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit offer: %w", err)
+	}
+	return true, nil
+}
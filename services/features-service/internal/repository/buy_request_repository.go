@@ -3,6 +3,10 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
 
 	"metargb/features-service/internal/models"
 )
@@ -16,7 +20,7 @@ func NewBuyRequestRepository(db *sql.DB) *BuyRequestRepository {
 }
 
 // Create creates a new buy feature request
-func (r *BuyRequestRepository) Create(ctx context.Context, buyerID, sellerID, featureID uint64, note string, pricePSC, priceIRR float64) (uint64, error) {
+func (r *BuyRequestRepository) Create(ctx context.Context, buyerID, sellerID, featureID uint64, note string, pricePSC, priceIRR decimal.Decimal) (uint64, error) {
 	query := `
 		INSERT INTO buy_feature_requests (buyer_id, seller_id, feature_id, note, price_psc, price_irr, status, requested_grace_period, created_at, updated_at)
 		VALUES (?, ?, ?, ?, ?, ?, 0, NULL, NOW(), NOW())
@@ -175,13 +179,84 @@ func (r *BuyRequestRepository) Delete(ctx context.Context, id uint64) error {
 	return err
 }
 
-// UpdateGracePeriod updates the requested_grace_period field
+// UpdateGracePeriod updates the requested_grace_period field. A grace period
+// set by the seller starts with no anti-sniping extensions.
 func (r *BuyRequestRepository) UpdateGracePeriod(ctx context.Context, id uint64, gracePeriod sql.NullTime) error {
-	query := "UPDATE buy_feature_requests SET requested_grace_period = ?, updated_at = NOW() WHERE id = ?"
+	query := "UPDATE buy_feature_requests SET requested_grace_period = ?, grace_period_extensions = 0, updated_at = NOW() WHERE id = ?"
 	_, err := r.db.ExecContext(ctx, query, gracePeriod, id)
 	return err
 }
 
+// ListOpenGracePeriods lists the pending buy requests for a feature whose
+// grace period ends after now
+func (r *BuyRequestRepository) ListOpenGracePeriods(ctx context.Context, featureID uint64, now time.Time) ([]*models.BuyRequestGracePeriod, error) {
+	query := `
+		SELECT id, buyer_id, seller_id, feature_id, price_psc, price_irr, requested_grace_period, grace_period_extensions
+		FROM buy_feature_requests
+		WHERE feature_id = ? AND status = 0 AND deleted_at IS NULL AND requested_grace_period > ?
+		ORDER BY requested_grace_period
+	`
+	return r.queryGracePeriods(ctx, query, featureID, now)
+}
+
+// ListExpiredGracePeriods lists up to limit pending buy requests whose grace
+// period ended at or before now, oldest first
+func (r *BuyRequestRepository) ListExpiredGracePeriods(ctx context.Context, now time.Time, limit int) ([]*models.BuyRequestGracePeriod, error) {
+	query := `
+		SELECT id, buyer_id, seller_id, feature_id, price_psc, price_irr, requested_grace_period, grace_period_extensions
+		FROM buy_feature_requests
+		WHERE status = 0 AND deleted_at IS NULL AND requested_grace_period <= ?
+		ORDER BY requested_grace_period
+		LIMIT ?
+	`
+	return r.queryGracePeriods(ctx, query, now, limit)
+}
+
+func (r *BuyRequestRepository) queryGracePeriods(ctx context.Context, query string, args ...interface{}) ([]*models.BuyRequestGracePeriod, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list grace periods: %w", err)
+	}
+	defer rows.Close()
+
+	var periods []*models.BuyRequestGracePeriod
+	for rows.Next() {
+		period := &models.BuyRequestGracePeriod{}
+		if err := rows.Scan(
+			&period.ID, &period.BuyerID, &period.SellerID, &period.FeatureID,
+			&period.PricePSC, &period.PriceIRR, &period.ExpiresAt, &period.Extensions,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan grace period: %w", err)
+		}
+		periods = append(periods, period)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate grace periods: %w", err)
+	}
+	return periods, nil
+}
+
+// ExtendGracePeriod moves the grace period of a pending request to expiresAt
+// and counts the extension. It reports false, changing nothing, when the
+// request was accepted, removed or extended again since it was read with
+// the given number of extensions.
+func (r *BuyRequestRepository) ExtendGracePeriod(ctx context.Context, id uint64, extensions int, expiresAt time.Time) (bool, error) {
+	query := `
+		UPDATE buy_feature_requests
+		SET requested_grace_period = ?, grace_period_extensions = grace_period_extensions + 1, updated_at = NOW()
+		WHERE id = ? AND status = 0 AND deleted_at IS NULL AND grace_period_extensions = ?
+	`
+	result, err := r.db.ExecContext(ctx, query, expiresAt, id, extensions)
+	if err != nil {
+		return false, fmt.Errorf("failed to extend grace period: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
 // HasPendingRequest checks if buyer has a pending request for the feature
 func (r *BuyRequestRepository) HasPendingRequest(ctx context.Context, buyerID, featureID uint64) (bool, error) {
 	query := `
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	checkoutFeatureKeyPrefix = "checkout_reservation:feature:"
+	checkoutUserKeyPrefix    = "checkout_reservation:user:"
+)
+
+// checkoutHoldersBatch bounds the keys of one MGET in Holders
+const checkoutHoldersBatch = 500
+
+// reserveCheckoutScript holds KEYS[1] (the feature) for ARGV[1] (the user)
+// for ARGV[2] milliseconds and records it under KEYS[2] (the user), releasing
+// the user's previous feature. It returns the milliseconds left of the hold,
+// or -1 if another user holds the feature. Holding the same feature again
+// does not extend it.
+var reserveCheckoutScript = redis.NewScript(`
+local holder = redis.call('GET', KEYS[1])
+if holder then
+	if holder ~= ARGV[1] then
+		return -1
+	end
+	return redis.call('PTTL', KEYS[1])
+end
+local previous = redis.call('GET', KEYS[2])
+if previous and previous ~= ARGV[3] then
+	local previousKey = ARGV[4] .. previous
+	if redis.call('GET', previousKey) == ARGV[1] then
+		redis.call('DEL', previousKey)
+	end
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+redis.call('SET', KEYS[2], ARGV[3], 'PX', ARGV[2])
+return tonumber(ARGV[2])
+`)
+
+// releaseCheckoutScript removes the hold of KEYS[1] if ARGV[1] holds it
+var releaseCheckoutScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	redis.call('DEL', KEYS[1], KEYS[2])
+	return 1
+end
+return 0
+`)
+
+// CheckoutReservationRepository keeps the short holds buyers place on a
+// feature while confirming a purchase
+type CheckoutReservationRepository interface {
+	// Reserve holds a feature for userID and returns how long the hold lasts.
+	// It reports false if another user holds the feature.
+	Reserve(ctx context.Context, featureID, userID uint64, ttl time.Duration) (time.Duration, bool, error)
+
+	// Release removes the hold of userID on a feature, if any
+	Release(ctx context.Context, featureID, userID uint64) error
+
+	// Holder returns the user holding a feature, 0 if none
+	Holder(ctx context.Context, featureID uint64) (uint64, error)
+
+	// Holders returns the users holding any of the features, by feature.
+	// Features nobody holds are left out.
+	Holders(ctx context.Context, featureIDs []uint64) (map[uint64]uint64, error)
+}
+
+type checkoutReservationRepository struct {
+	client *redis.Client
+}
+
+// NewCheckoutReservationRepository creates a checkout reservation repository
+func NewCheckoutReservationRepository(client *redis.Client) CheckoutReservationRepository {
+	return &checkoutReservationRepository{client: client}
+}
+
+func (r *checkoutReservationRepository) Reserve(ctx context.Context, featureID, userID uint64, ttl time.Duration) (time.Duration, bool, error) {
+	left, err := reserveCheckoutScript.Run(ctx, r.client,
+		[]string{checkoutFeatureKey(featureID), checkoutUserKey(userID)},
+		userID, ttl.Milliseconds(), featureID, checkoutFeatureKeyPrefix,
+	).Int64()
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to reserve feature: %w", err)
+	}
+	if left < 0 {
+		return 0, false, nil
+	}
+	return time.Duration(left) * time.Millisecond, true, nil
+}
+
+func (r *checkoutReservationRepository) Release(ctx context.Context, featureID, userID uint64) error {
+	err := releaseCheckoutScript.Run(ctx, r.client,
+		[]string{checkoutFeatureKey(featureID), checkoutUserKey(userID)},
+		userID,
+	).Err()
+	if err != nil {
+		return fmt.Errorf("failed to release feature reservation: %w", err)
+	}
+	return nil
+}
+
+func (r *checkoutReservationRepository) Holder(ctx context.Context, featureID uint64) (uint64, error) {
+	val, err := r.client.Get(ctx, checkoutFeatureKey(featureID)).Result()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get feature reservation: %w", err)
+	}
+	holder, err := strconv.ParseUint(val, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid feature reservation holder %q: %w", val, err)
+	}
+	return holder, nil
+}
+
+func (r *checkoutReservationRepository) Holders(ctx context.Context, featureIDs []uint64) (map[uint64]uint64, error) {
+	holders := make(map[uint64]uint64)
+	for start := 0; start < len(featureIDs); start += checkoutHoldersBatch {
+		batch := featureIDs[start:min(start+checkoutHoldersBatch, len(featureIDs))]
+		keys := make([]string, len(batch))
+		for i, featureID := range batch {
+			keys[i] = checkoutFeatureKey(featureID)
+		}
+
+		values, err := r.client.MGet(ctx, keys...).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get feature reservations: %w", err)
+		}
+		for i, value := range values {
+			val, ok := value.(string)
+			if !ok {
+				continue
+			}
+			holder, err := strconv.ParseUint(val, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid feature reservation holder %q: %w", val, err)
+			}
+			holders[batch[i]] = holder
+		}
+	}
+	return holders, nil
+}
+
+func checkoutFeatureKey(featureID uint64) string {
+	return checkoutFeatureKeyPrefix + strconv.FormatUint(featureID, 10)
+}
+
+func checkoutUserKey(userID uint64) string {
+	return checkoutUserKeyPrefix + strconv.FormatUint(userID, 10)
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/features-service/internal/models"
+)
+
+type FeatureHistoryRepository struct {
+	db *sql.DB
+}
+
+func NewFeatureHistoryRepository(db *sql.DB) *FeatureHistoryRepository {
+	return &FeatureHistoryRepository{db: db}
+}
+
+// featureHistoryQuery merges the trades, sell and buy requests, buildings and
+// profit payouts of a feature into rows of the same shape, named by the first
+// select
+const featureHistoryQuery = `
+	SELECT 'trade' AS type, t.id AS reference_id, COALESCE(t.created_at, t.date) AS occurred_at,
+	       t.buyer_id AS user_id, t.seller_id AS counterparty_id,
+	       COALESCE(t.psc_amount, 0) AS price_psc, COALESCE(t.irr_amount, 0) AS price_irr,
+	       '' AS asset, 0 AS amount,
+	       CASE WHEN r.refunded_at IS NULL THEN 'completed' ELSE 'refunded' END AS status
+	FROM trades t
+	LEFT JOIN trade_receipts r ON r.trade_id = t.id
+	WHERE t.feature_id = ?
+	UNION ALL
+	SELECT 'sell_request', s.id, s.created_at, s.seller_id, 0,
+	       COALESCE(s.price_psc, 0), COALESCE(s.price_irr, 0), '', 0,
+	       CASE WHEN s.status = 0 THEN 'open' ELSE 'closed' END
+	FROM sell_feature_requests s
+	WHERE s.feature_id = ?
+	UNION ALL
+	SELECT 'buy_request', b.id, b.created_at, b.buyer_id, b.seller_id,
+	       COALESCE(b.price_psc, 0), COALESCE(b.price_irr, 0), '', 0,
+	       CASE WHEN b.deleted_at IS NOT NULL THEN 'cancelled' WHEN b.status = 1 THEN 'accepted' ELSE 'pending' END
+	FROM buy_feature_requests b
+	WHERE b.feature_id = ?
+	UNION ALL
+	SELECT 'build', bl.id, bl.construction_start_date, 0, 0, 0, 0, '', 0,
+	       CASE WHEN bl.construction_end_date > NOW() THEN 'under_construction' ELSE 'built' END
+	FROM buildings bl
+	WHERE bl.feature_id = ?
+	UNION ALL
+	SELECT 'profit_payout', p.id, p.created_at, p.user_id, 0, 0, 0, p.asset, p.amount, ''
+	FROM feature_profit_payouts p
+	WHERE p.feature_id = ?
+`
+
+// OwnerID returns the owner of a feature and false if it does not exist
+func (r *FeatureHistoryRepository) OwnerID(ctx context.Context, featureID uint64) (uint64, bool, error) {
+	var ownerID uint64
+	err := r.db.QueryRowContext(ctx, "SELECT owner_id FROM features WHERE id = ?", featureID).Scan(&ownerID)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get feature owner: %w", err)
+	}
+	return ownerID, true, nil
+}
+
+// List returns a page of a feature's history, oldest first, and the number
+// of events over all pages. Events at the same moment are ordered by type
+// and ID so pages are stable.
+func (r *FeatureHistoryRepository) List(ctx context.Context, featureID uint64, limit, offset int) ([]*models.FeatureHistoryEvent, int, error) {
+	args := []interface{}{featureID, featureID, featureID, featureID, featureID}
+
+	var total int
+	countQuery := "SELECT COUNT(*) FROM (" + featureHistoryQuery + ") h"
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count feature history: %w", err)
+	}
+	if total == 0 || offset >= total {
+		return []*models.FeatureHistoryEvent{}, total, nil
+	}
+
+	query := `
+		SELECT type, reference_id, occurred_at, user_id, counterparty_id, price_psc, price_irr, asset, amount, status
+		FROM (` + featureHistoryQuery + `) h
+		ORDER BY occurred_at, type, reference_id
+		LIMIT ? OFFSET ?
+	`
+	rows, err := r.db.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list feature history: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]*models.FeatureHistoryEvent, 0, limit)
+	for rows.Next() {
+		event := &models.FeatureHistoryEvent{}
+		var occurredAt sql.NullTime
+		var pricePSC, priceIRR, amount string
+		if err := rows.Scan(
+			&event.Type, &event.ReferenceID, &occurredAt, &event.UserID, &event.CounterpartyID,
+			&pricePSC, &priceIRR, &event.Asset, &amount, &event.Status,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan feature history: %w", err)
+		}
+		event.OccurredAt = occurredAt.Time
+		switch event.Type {
+		case models.HistoryEventProfitPayout:
+			event.Amount = normalizeDecimal(amount)
+		case models.HistoryEventBuild:
+		default:
+			event.PricePSC = normalizeDecimal(pricePSC)
+			event.PriceIRR = normalizeDecimal(priceIRR)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to iterate feature history: %w", err)
+	}
+
+	return events, total, nil
+}
+
+// normalizeDecimal drops the trailing zeros the union's widened decimal
+// columns add, leaving values that do not parse as they are
+func normalizeDecimal(value string) string {
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return value
+	}
+	return d.String()
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
 	"metargb/features-service/internal/models"
@@ -64,15 +65,97 @@ func (r *FeatureLimitRepository) CountLimitedPurchases(ctx context.Context, user
 	return count, err
 }
 
-// TrackLimitedPurchase records a purchase from a limited feature campaign
-func (r *FeatureLimitRepository) TrackLimitedPurchase(ctx context.Context, userID, limitID, featureID uint64) error {
-	query := `
-		INSERT INTO limited_feature_purchases (user_id, feature_limit_id, feature_id, created_at, updated_at)
-		VALUES (?, ?, ?, NOW(), NOW())
-	`
+// ClaimLimitedPurchase records a purchase from a limited feature campaign
+// before it is paid for, so concurrent purchases of one user cannot exceed
+// maxPurchases (0 for no limit). It reports false when the user reached the
+// limit. The count and insert run as one statement and InnoDB locks the
+// counted rows, so of two racing claims for the last purchase one fails.
+func (r *FeatureLimitRepository) ClaimLimitedPurchase(ctx context.Context, userID, limitID, featureID uint64, maxPurchases int) (uint64, bool, error) {
+	var result sql.Result
+	var err error
+	if maxPurchases > 0 {
+		result, err = r.db.ExecContext(ctx, `
+			INSERT INTO limited_feature_purchases (user_id, feature_limit_id, feature_id, created_at, updated_at)
+			SELECT ?, ?, ?, NOW(), NOW() FROM DUAL
+			WHERE (SELECT COUNT(*) FROM limited_feature_purchases WHERE user_id = ? AND feature_limit_id = ?) < ?
+		`, userID, limitID, featureID, userID, limitID, maxPurchases)
+	} else {
+		result, err = r.db.ExecContext(ctx, `
+			INSERT INTO limited_feature_purchases (user_id, feature_limit_id, feature_id, created_at, updated_at)
+			VALUES (?, ?, ?, NOW(), NOW())
+		`, userID, limitID, featureID)
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to claim limited purchase: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return 0, false, nil
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get limited purchase id: %w", err)
+	}
+	return uint64(id), true, nil
+}
+
+// DeleteLimitedPurchase removes a claimed purchase whose payment failed
+func (r *FeatureLimitRepository) DeleteLimitedPurchase(ctx context.Context, purchaseID uint64) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM limited_feature_purchases WHERE id = ?`, purchaseID); err != nil {
+		return fmt.Errorf("failed to delete limited purchase: %w", err)
+	}
+	return nil
+}
 
-	_, err := r.db.ExecContext(ctx, query, userID, limitID, featureID)
-	return err
+// CountPurchases counts the purchases recorded for a limitation
+func (r *FeatureLimitRepository) CountPurchases(ctx context.Context, limitID uint64) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM limited_feature_purchases WHERE feature_limit_id = ?
+	`, limitID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count limited purchases: %w", err)
+	}
+	return count, nil
+}
+
+// CampaignFeatures returns how many features are in a limitation's range and
+// the ids of those still for sale at the limited status
+func (r *FeatureLimitRepository) CampaignFeatures(ctx context.Context, limit *models.FeatureLimit, limitedStatuses []string) (int, []uint64, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT feature_id, rgb
+		FROM feature_properties
+		WHERE id >= ? AND id <= ?
+	`, limit.StartID, limit.EndID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to get campaign features: %w", err)
+	}
+	defer rows.Close()
+
+	total := 0
+	unsold := []uint64{}
+	for rows.Next() {
+		var featureID uint64
+		var rgb string
+		if err := rows.Scan(&featureID, &rgb); err != nil {
+			return 0, nil, fmt.Errorf("failed to scan campaign feature: %w", err)
+		}
+		total++
+		for _, status := range limitedStatuses {
+			if rgb == status {
+				unsold = append(unsold, featureID)
+				break
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return total, unsold, nil
 }
 
 // GetActiveLimitations retrieves all active feature limitations
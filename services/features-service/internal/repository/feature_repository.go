@@ -265,6 +265,71 @@ func (r *FeatureRepository) FindByBoundingBoxWithProperties(ctx context.Context,
 }
 
 // FindByOwner retrieves all features owned by a user
+// CountOwnedByKarbari counts the features of an owner per karbari
+func (r *FeatureRepository) CountOwnedByKarbari(ctx context.Context, ownerID uint64) (map[string]int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT fp.karbari, COUNT(*)
+		FROM features f
+		INNER JOIN feature_properties fp ON fp.feature_id = f.id
+		WHERE f.owner_id = ?
+		GROUP BY fp.karbari
+	`, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count owned features: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var karbari sql.NullString
+		var count int
+		if err := rows.Scan(&karbari, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan owned feature count: %w", err)
+		}
+		counts[karbari.String] += count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate owned feature counts: %w", err)
+	}
+	return counts, nil
+}
+
+// CountByOwners counts the features of many owners in one query. Owners
+// without features are left out.
+func (r *FeatureRepository) CountByOwners(ctx context.Context, ownerIDs []uint64) (map[uint64]int32, error) {
+	counts := make(map[uint64]int32, len(ownerIDs))
+	if len(ownerIDs) == 0 {
+		return counts, nil
+	}
+
+	args := make([]interface{}, len(ownerIDs))
+	for i, id := range ownerIDs {
+		args[i] = id
+	}
+	query := `SELECT owner_id, COUNT(*) FROM features WHERE owner_id IN (` +
+		strings.TrimSuffix(strings.Repeat("?,", len(ownerIDs)), ",") + `) GROUP BY owner_id`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count owned features: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var ownerID uint64
+		var count int32
+		if err := rows.Scan(&ownerID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan owned feature count: %w", err)
+		}
+		counts[ownerID] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate owned feature counts: %w", err)
+	}
+
+	return counts, nil
+}
+
 func (r *FeatureRepository) FindByOwner(ctx context.Context, ownerID uint64) ([]*models.Feature, error) {
 	query := `
 		SELECT id, owner_id, dynasty_id, created_at, updated_at
@@ -3,7 +3,11 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"metargb/features-service/internal/models"
 )
@@ -100,3 +104,181 @@ func (r *GeometryRepository) GetCoordinatesWithIDs(ctx context.Context, featureI
 
 	return coordinates, nil
 }
+
+// ReplaceCoordinates swaps the feature's polygon for points and records the
+// edit as the next geometry version. The first edit of a feature also records
+// the geometry it had before as version 1. Returns nil if the feature has no
+// geometry.
+func (r *GeometryRepository) ReplaceCoordinates(ctx context.Context, featureID uint64, points []models.GeometryPoint, area float64, editedBy uint64, reason string) (*models.GeometryVersion, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the geometry so concurrent edits get consecutive versions
+	var geometryID uint64
+	err = tx.QueryRowContext(ctx, `SELECT id FROM geometries WHERE feature_id = ? FOR UPDATE`, featureID).Scan(&geometryID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock geometry: %w", err)
+	}
+
+	var latest int
+	if err := tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM feature_geometry_versions WHERE feature_id = ?`, featureID).Scan(&latest); err != nil {
+		return nil, fmt.Errorf("failed to get latest geometry version: %w", err)
+	}
+
+	now := time.Now()
+	if latest == 0 {
+		original, err := r.loadPoints(ctx, tx, geometryID)
+		if err != nil {
+			return nil, err
+		}
+		latest++
+		initial := &models.GeometryVersion{
+			FeatureID:   featureID,
+			GeometryID:  geometryID,
+			Version:     latest,
+			Coordinates: original,
+			Area:        models.PolygonArea(original),
+			CreatedAt:   now,
+		}
+		if err := insertGeometryVersion(ctx, tx, initial); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM coordinates WHERE geometry_id = ?`, geometryID); err != nil {
+		return nil, fmt.Errorf("failed to delete coordinates: %w", err)
+	}
+
+	placeholders := make([]string, 0, len(points))
+	args := make([]interface{}, 0, len(points)*5)
+	for _, p := range points {
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?)")
+		args = append(args, geometryID, formatCoordinateValue(p.X), formatCoordinateValue(p.Y), now, now)
+	}
+	insertQuery := `INSERT INTO coordinates (geometry_id, x, y, created_at, updated_at) VALUES ` + strings.Join(placeholders, ", ")
+	if _, err := tx.ExecContext(ctx, insertQuery, args...); err != nil {
+		return nil, fmt.Errorf("failed to insert coordinates: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE geometries SET updated_at = ? WHERE id = ?`, now, geometryID); err != nil {
+		return nil, fmt.Errorf("failed to update geometry: %w", err)
+	}
+
+	version := &models.GeometryVersion{
+		FeatureID:   featureID,
+		GeometryID:  geometryID,
+		Version:     latest + 1,
+		Coordinates: points,
+		Area:        area,
+		EditedBy:    editedBy,
+		Reason:      reason,
+		CreatedAt:   now,
+	}
+	if err := insertGeometryVersion(ctx, tx, version); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit geometry edit: %w", err)
+	}
+
+	return version, nil
+}
+
+// ListVersions returns the recorded geometry versions of a feature, newest first
+func (r *GeometryRepository) ListVersions(ctx context.Context, featureID uint64) ([]*models.GeometryVersion, error) {
+	query := `
+		SELECT id, feature_id, geometry_id, version, coordinates, area, COALESCE(edited_by, 0), COALESCE(reason, ''), created_at
+		FROM feature_geometry_versions
+		WHERE feature_id = ?
+		ORDER BY version DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, featureID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query geometry versions: %w", err)
+	}
+	defer rows.Close()
+
+	versions := []*models.GeometryVersion{}
+	for rows.Next() {
+		version := &models.GeometryVersion{}
+		var coordinates string
+		if err := rows.Scan(
+			&version.ID, &version.FeatureID, &version.GeometryID, &version.Version,
+			&coordinates, &version.Area, &version.EditedBy, &version.Reason, &version.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan geometry version: %w", err)
+		}
+		if err := json.Unmarshal([]byte(coordinates), &version.Coordinates); err != nil {
+			return nil, fmt.Errorf("failed to decode geometry version %d: %w", version.ID, err)
+		}
+		versions = append(versions, version)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate geometry versions: %w", err)
+	}
+
+	return versions, nil
+}
+
+func (r *GeometryRepository) loadPoints(ctx context.Context, tx *sql.Tx, geometryID uint64) ([]models.GeometryPoint, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT x, y FROM coordinates WHERE geometry_id = ? ORDER BY id`, geometryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query coordinates: %w", err)
+	}
+	defer rows.Close()
+
+	points := []models.GeometryPoint{}
+	for rows.Next() {
+		var p models.GeometryPoint
+		if err := rows.Scan(&p.X, &p.Y); err != nil {
+			return nil, fmt.Errorf("failed to scan coordinate: %w", err)
+		}
+		points = append(points, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate coordinates: %w", err)
+	}
+
+	return points, nil
+}
+
+func insertGeometryVersion(ctx context.Context, tx *sql.Tx, version *models.GeometryVersion) error {
+	coordinates, err := json.Marshal(version.Coordinates)
+	if err != nil {
+		return fmt.Errorf("failed to encode geometry version: %w", err)
+	}
+
+	var editedBy interface{}
+	if version.EditedBy != 0 {
+		editedBy = version.EditedBy
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO feature_geometry_versions (feature_id, geometry_id, version, coordinates, area, edited_by, reason, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`, version.FeatureID, version.GeometryID, version.Version, string(coordinates), version.Area, editedBy, version.Reason, version.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert geometry version: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get geometry version id: %w", err)
+	}
+	version.ID = uint64(id)
+
+	return nil
+}
+
+// formatCoordinateValue keeps every significant digit of an edited coordinate
+func formatCoordinateValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
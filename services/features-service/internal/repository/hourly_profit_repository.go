@@ -161,9 +161,22 @@ func (r *HourlyProfitRepository) GetTotalsByKarbari(ctx context.Context, userID
 		nil
 }
 
-// ResetProfitAndUpdateDeadline resets amount to 0 and updates deadline
-// Implements Laravel's FeatureHourlyProfitController@getSingleProfit logic
-func (r *HourlyProfitRepository) ResetProfitAndUpdateDeadline(ctx context.Context, profitID uint64, withdrawProfitDays int) error {
+// ClaimAmount zeroes the accrued amount of a profit, moves its dead_line
+// withdrawProfitDays ahead and returns the amount taken. The row is locked so
+// a manual claim and an auto-claim cannot both take the same amount.
+// Implements Laravel's FeatureHourlyProfitController@getSingleProfit reset
+func (r *HourlyProfitRepository) ClaimAmount(ctx context.Context, profitID uint64, withdrawProfitDays int) (float64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var amount float64
+	if err := tx.QueryRowContext(ctx, "SELECT amount FROM feature_hourly_profits WHERE id = ? FOR UPDATE", profitID).Scan(&amount); err != nil {
+		return 0, err
+	}
+
 	deadlineSeconds := withdrawProfitDays * 86400
 	newDeadline := time.Now().Add(time.Duration(deadlineSeconds) * time.Second)
 
@@ -172,69 +185,147 @@ func (r *HourlyProfitRepository) ResetProfitAndUpdateDeadline(ctx context.Contex
 		SET amount = 0, dead_line = ?, updated_at = NOW()
 		WHERE id = ?
 	`
+	if _, err := tx.ExecContext(ctx, query, newDeadline, profitID); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit claim: %w", err)
+	}
+	return amount, nil
+}
+
+// RestoreAmount adds back an amount taken by ClaimAmount that could not be
+// paid out
+func (r *HourlyProfitRepository) RestoreAmount(ctx context.Context, profitID uint64, amount float64) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE feature_hourly_profits SET amount = amount + ? WHERE id = ?", amount, profitID)
+	return err
+}
 
-	_, err := r.db.ExecContext(ctx, query, newDeadline, profitID)
+// RecordPayout logs an amount of a profit paid into the owner's wallet so it
+// shows in the feature's history
+func (r *HourlyProfitRepository) RecordPayout(ctx context.Context, profit *models.FeatureHourlyProfit, amount float64) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO feature_profit_payouts (feature_id, user_id, profit_id, asset, amount, created_at)
+		VALUES (?, ?, ?, ?, ?, NOW())
+	`, profit.FeatureID, profit.UserID, profit.ID, profit.Asset, amount)
 	return err
 }
 
 // CalculateAndUpdateProfits implements the hourly profit calculation job
-// From Laravel's CalculateFeatureProfit command
-func (r *HourlyProfitRepository) CalculateAndUpdateProfits(ctx context.Context) error {
+// From Laravel's CalculateFeatureProfit command. It handles at most limit
+// profits and returns how many were incremented.
+func (r *HourlyProfitRepository) CalculateAndUpdateProfits(ctx context.Context, limit int) (int, error) {
 	// Find all profits that need updating:
 	// - dead_line > now (not expired)
 	// - updated_at < 3 hours ago
 	// - is_active = true
-	threeHoursAgo := time.Now().Add(-3 * time.Hour)
+	cutoff := time.Now().Add(-constants.HourlyProfitCalculationIntervalHours * time.Hour)
 
 	query := `
-		SELECT fhp.id, fhp.feature_id
+		SELECT fhp.id, fhp.amount, fp.stability, COALESCE(fps.compound, 0)
 		FROM feature_hourly_profits fhp
+		INNER JOIN feature_properties fp ON fp.feature_id = fhp.feature_id
+		LEFT JOIN feature_profit_settings fps ON fps.user_id = fhp.user_id
 		WHERE fhp.dead_line > NOW()
 		  AND fhp.updated_at < ?
 		  AND fhp.is_active = 1
-		LIMIT 100
+		LIMIT ?
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, threeHoursAgo)
+	rows, err := r.db.QueryContext(ctx, query, cutoff, limit)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	defer rows.Close()
-
-	profits := []struct {
-		ID        uint64
-		FeatureID uint64
-	}{}
 
+	type pendingProfit struct {
+		id        uint64
+		amount    float64
+		stability float64
+		compound  bool
+	}
+	var profits []pendingProfit
 	for rows.Next() {
-		var p struct {
-			ID        uint64
-			FeatureID uint64
-		}
-		if err := rows.Scan(&p.ID, &p.FeatureID); err != nil {
-			continue
+		var p pendingProfit
+		if err := rows.Scan(&p.id, &p.amount, &p.stability, &p.compound); err != nil {
+			rows.Close()
+			return 0, err
 		}
 		profits = append(profits, p)
 	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
 
-	// For each profit, get feature stability and increment amount
+	updated := 0
 	for _, p := range profits {
-		var stability float64
-		stabilityQuery := "SELECT stability FROM feature_properties WHERE feature_id = ?"
-		if err := r.db.QueryRowContext(ctx, stabilityQuery, p.FeatureID).Scan(&stability); err != nil {
-			continue
+		// Increment amount by stability * 0.000041666. Compounding users also
+		// earn on the amount they have not claimed yet.
+		base := p.stability
+		if p.compound {
+			base += p.amount
 		}
+		increment := base * constants.HourlyProfitCalculationRate
 
-		// Increment amount by stability * 0.000041666
-		increment := stability * constants.HourlyProfitCalculationRate
+		// The updated_at guard skips profits claimed since they were selected
+		updateQuery := "UPDATE feature_hourly_profits SET amount = amount + ?, updated_at = NOW() WHERE id = ? AND updated_at < ?"
+		if _, err := r.db.ExecContext(ctx, updateQuery, increment, p.id, cutoff); err != nil {
+			return updated, err
+		}
+		updated++
+	}
 
-		updateQuery := "UPDATE feature_hourly_profits SET amount = amount + ?, updated_at = NOW() WHERE id = ?"
-		if _, err := r.db.ExecContext(ctx, updateQuery, increment, p.ID); err != nil {
-			continue
+	return updated, nil
+}
+
+// ListAutoClaimDue returns profits with an accrued amount whose owner enabled
+// auto-claim and whose dead_line is before the given time, earliest first
+func (r *HourlyProfitRepository) ListAutoClaimDue(ctx context.Context, before time.Time, limit int) ([]*models.FeatureHourlyProfit, error) {
+	query := `
+		SELECT
+			fhp.id,
+			fhp.user_id,
+			fhp.feature_id,
+			fhp.asset,
+			fhp.amount,
+			fhp.dead_line,
+			fhp.is_active,
+			fhp.created_at,
+			fhp.updated_at,
+			f.id as feature_db_id,
+			fp.id as properties_id,
+			fp.karbari
+		FROM feature_hourly_profits fhp
+		INNER JOIN feature_profit_settings fps ON fps.user_id = fhp.user_id AND fps.auto_claim = 1
+		INNER JOIN features f ON fhp.feature_id = f.id
+		LEFT JOIN feature_properties fp ON fhp.feature_id = fp.feature_id
+		WHERE fhp.amount > 0 AND fhp.dead_line < ?
+		ORDER BY fhp.dead_line
+		LIMIT ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	profits := []*models.FeatureHourlyProfit{}
+	for rows.Next() {
+		profit := &models.FeatureHourlyProfit{}
+		if err := rows.Scan(
+			&profit.ID, &profit.UserID, &profit.FeatureID, &profit.Asset,
+			&profit.Amount, &profit.Deadline, &profit.IsActive,
+			&profit.CreatedAt, &profit.UpdatedAt,
+			&profit.FeatureDBID, &profit.PropertiesID, &profit.Karbari,
+		); err != nil {
+			return nil, err
 		}
+		profits = append(profits, profit)
 	}
 
-	return nil
+	return profits, rows.Err()
 }
 
 // TransferProfitToNewOwner transfers profit to seller and resets for buyer
@@ -14,14 +14,17 @@ func NewImageRepository(db *sql.DB) *ImageRepository {
 	return &ImageRepository{db: db}
 }
 
-// GetImagesByFeatureID retrieves all images for a feature
+// imageColumns are the images columns scanned by scanImage
+const imageColumns = `id, url, COALESCE(thumbnail_url, ''), position, is_cover`
+
+// GetImagesByFeatureID retrieves all images for a feature in gallery order
 // Uses polymorphic relationship: imageable_type = 'App\\Models\\Feature'
 func (r *ImageRepository) GetImagesByFeatureID(ctx context.Context, featureID uint64) ([]*Image, error) {
 	query := `
-		SELECT id, url
+		SELECT ` + imageColumns + `
 		FROM images
 		WHERE imageable_type = 'App\\Models\\Feature' AND imageable_id = ?
-		ORDER BY id ASC
+		ORDER BY position ASC, id ASC
 	`
 
 	rows, err := r.db.QueryContext(ctx, query, featureID)
@@ -32,8 +35,8 @@ func (r *ImageRepository) GetImagesByFeatureID(ctx context.Context, featureID ui
 
 	images := []*Image{}
 	for rows.Next() {
-		img := &Image{}
-		if err := rows.Scan(&img.ID, &img.URL); err != nil {
+		img, err := scanImage(rows)
+		if err != nil {
 			continue
 		}
 		images = append(images, img)
@@ -44,69 +47,279 @@ func (r *ImageRepository) GetImagesByFeatureID(ctx context.Context, featureID ui
 
 // Image represents a feature image
 type Image struct {
-	ID  uint64
-	URL string
+	ID           uint64
+	URL          string
+	ThumbnailURL string
+	Position     int
+	IsCover      bool
+}
+
+// NewImage is an uploaded image to attach to a feature
+type NewImage struct {
+	URL          string
+	ThumbnailURL string
+}
+
+type imageScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanImage(row imageScanner) (*Image, error) {
+	img := &Image{}
+	if err := row.Scan(&img.ID, &img.URL, &img.ThumbnailURL, &img.Position, &img.IsCover); err != nil {
+		return nil, err
+	}
+	return img, nil
 }
 
 // CreateImage creates a new image record for a feature
 // imageable_type = 'App\\Models\\Feature', imageable_id = featureID
 func (r *ImageRepository) CreateImage(ctx context.Context, featureID uint64, url string) (*Image, error) {
-	query := `
-		INSERT INTO images (imageable_type, imageable_id, url, created_at, updated_at)
-		VALUES ('App\\Models\\Feature', ?, ?, NOW(), NOW())
-	`
+	images, err := r.AddImages(ctx, featureID, []NewImage{{URL: url}})
+	if err != nil {
+		return nil, err
+	}
+	return images[0], nil
+}
 
-	result, err := r.db.ExecContext(ctx, query, featureID, url)
+// AddImages appends images to the end of a feature's gallery. The first
+// image of a gallery without a cover becomes the cover.
+func (r *ImageRepository) AddImages(ctx context.Context, featureID uint64, images []NewImage) ([]*Image, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create image: %w", err)
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback()
 
-	id, err := result.LastInsertId()
+	if err := lockGallery(ctx, tx, featureID); err != nil {
+		return nil, err
+	}
+
+	var next int
+	var hasCover bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT COALESCE(MAX(position) + 1, 0), COALESCE(MAX(is_cover), 0) = 1
+		FROM images
+		WHERE imageable_type = 'App\\Models\\Feature' AND imageable_id = ?
+	`, featureID).Scan(&next, &hasCover)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get image ID: %w", err)
+		return nil, fmt.Errorf("failed to get gallery position: %w", err)
+	}
+
+	created := make([]*Image, 0, len(images))
+	for _, image := range images {
+		img := &Image{
+			URL:          image.URL,
+			ThumbnailURL: image.ThumbnailURL,
+			Position:     next,
+			IsCover:      !hasCover,
+		}
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO images (imageable_type, imageable_id, url, thumbnail_url, position, is_cover, created_at, updated_at)
+			VALUES ('App\\Models\\Feature', ?, ?, NULLIF(?, ''), ?, ?, NOW(), NOW())
+		`, featureID, img.URL, img.ThumbnailURL, img.Position, img.IsCover)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create image: %w", err)
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get image ID: %w", err)
+		}
+		img.ID = uint64(id)
+		created = append(created, img)
+		next++
+		hasCover = true
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit images: %w", err)
 	}
 
-	return &Image{
-		ID:  uint64(id),
-		URL: url,
-	}, nil
+	return created, nil
 }
 
 // DeleteImage deletes an image record
-// Verifies that the image belongs to the feature before deletion
+// Verifies that the image belongs to the feature before deletion. Deleting
+// the cover makes the next image in gallery order the cover.
 func (r *ImageRepository) DeleteImage(ctx context.Context, featureID, imageID uint64) error {
-	query := `
-		DELETE FROM images
-		WHERE id = ? AND imageable_type = 'App\\Models\\Feature' AND imageable_id = ?
-	`
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockGallery(ctx, tx, featureID); err != nil {
+		return err
+	}
 
-	result, err := r.db.ExecContext(ctx, query, imageID, featureID)
+	var wasCover bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT is_cover
+		FROM images
+		WHERE id = ? AND imageable_type = 'App\\Models\\Feature' AND imageable_id = ?
+	`, imageID, featureID).Scan(&wasCover)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("image not found or does not belong to feature")
+	}
 	if err != nil {
+		return fmt.Errorf("failed to get image: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM images WHERE id = ?`, imageID); err != nil {
 		return fmt.Errorf("failed to delete image: %w", err)
 	}
 
-	rowsAffected, err := result.RowsAffected()
+	if wasCover {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE images SET is_cover = 1, updated_at = NOW()
+			WHERE imageable_type = 'App\\Models\\Feature' AND imageable_id = ?
+			ORDER BY position ASC, id ASC
+			LIMIT 1
+		`, featureID)
+		if err != nil {
+			return fmt.Errorf("failed to promote cover image: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit image deletion: %w", err)
+	}
+
+	return nil
+}
+
+// ReorderImages sets the gallery position of every image to its index in
+// imageIDs. Returns false without changing anything unless imageIDs lists
+// every image of the feature exactly once.
+func (r *ImageRepository) ReorderImages(ctx context.Context, featureID uint64, imageIDs []uint64) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to check rows affected: %w", err)
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback()
 
-	if rowsAffected == 0 {
-		return fmt.Errorf("image not found or does not belong to feature")
+	if err := lockGallery(ctx, tx, featureID); err != nil {
+		return false, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id FROM images
+		WHERE imageable_type = 'App\\Models\\Feature' AND imageable_id = ?
+	`, featureID)
+	if err != nil {
+		return false, fmt.Errorf("failed to query images: %w", err)
+	}
+	current := make(map[uint64]bool)
+	for rows.Next() {
+		var id uint64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return false, fmt.Errorf("failed to scan image: %w", err)
+		}
+		current[id] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return false, fmt.Errorf("failed to query images: %w", err)
+	}
+
+	if len(imageIDs) != len(current) {
+		return false, nil
+	}
+	for _, id := range imageIDs {
+		if !current[id] {
+			return false, nil
+		}
+		delete(current, id)
+	}
+
+	for position, id := range imageIDs {
+		if _, err := tx.ExecContext(ctx, `UPDATE images SET position = ?, updated_at = NOW() WHERE id = ?`, position, id); err != nil {
+			return false, fmt.Errorf("failed to update image position: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit image order: %w", err)
+	}
+
+	return true, nil
+}
+
+// SetCoverImage makes imageID the only cover image of the feature. Returns
+// false if the image does not belong to the feature.
+func (r *ImageRepository) SetCoverImage(ctx context.Context, featureID, imageID uint64) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback()
 
+	if err := lockGallery(ctx, tx, featureID); err != nil {
+		return false, err
+	}
+
+	var id uint64
+	err = tx.QueryRowContext(ctx, `
+		SELECT id FROM images
+		WHERE id = ? AND imageable_type = 'App\\Models\\Feature' AND imageable_id = ?
+	`, imageID, featureID).Scan(&id)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get image: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE images SET is_cover = (id = ?), updated_at = NOW()
+		WHERE imageable_type = 'App\\Models\\Feature' AND imageable_id = ?
+	`, imageID, featureID)
+	if err != nil {
+		return false, fmt.Errorf("failed to set cover image: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit cover image: %w", err)
+	}
+
+	return true, nil
+}
+
+// CountImages returns the number of images of a feature
+func (r *ImageRepository) CountImages(ctx context.Context, featureID uint64) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM images
+		WHERE imageable_type = 'App\\Models\\Feature' AND imageable_id = ?
+	`, featureID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count images: %w", err)
+	}
+	return count, nil
+}
+
+// lockGallery locks the feature row so concurrent gallery changes of the same
+// feature are applied one after another
+func lockGallery(ctx context.Context, tx *sql.Tx, featureID uint64) error {
+	var id uint64
+	err := tx.QueryRowContext(ctx, `SELECT id FROM features WHERE id = ? FOR UPDATE`, featureID).Scan(&id)
+	if err != nil && err != sql.ErrNoRows {
+		return fmt.Errorf("failed to lock feature: %w", err)
+	}
 	return nil
 }
 
 // GetImageByID retrieves an image by ID and verifies it belongs to the feature
 func (r *ImageRepository) GetImageByID(ctx context.Context, featureID, imageID uint64) (*Image, error) {
 	query := `
-		SELECT id, url
+		SELECT ` + imageColumns + `
 		FROM images
 		WHERE id = ? AND imageable_type = 'App\\Models\\Feature' AND imageable_id = ?
 	`
 
-	img := &Image{}
-	err := r.db.QueryRowContext(ctx, query, imageID, featureID).Scan(&img.ID, &img.URL)
+	img, err := scanImage(r.db.QueryRowContext(ctx, query, imageID, featureID))
 	if err == sql.ErrNoRows {
 		return nil, nil // Not found
 	}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"metargb/features-service/internal/models"
+)
+
+type ListingRepository struct {
+	db *sql.DB
+}
+
+func NewListingRepository(db *sql.DB) *ListingRepository {
+	return &ListingRepository{db: db}
+}
+
+// A feature is listed by its latest open sell request, as long as the seller
+// still owns it and it is not held for an installment buyer. The filters and
+// sorts are served by the sell_feature_requests status indexes and
+// feature_properties_region_karbari_index.
+const listingSelect = `
+	SELECT s.id, s.feature_id, fp.id, fp.karbari, fp.region, fp.area, fp.address,
+	       s.seller_id, s.price_psc, s.price_irr, s.created_at
+	FROM sell_feature_requests s
+	INNER JOIN features f ON f.id = s.feature_id AND f.owner_id = s.seller_id
+	INNER JOIN feature_properties fp ON fp.feature_id = s.feature_id
+	WHERE s.status = 0
+	  AND s.id = (SELECT MAX(s2.id) FROM sell_feature_requests s2 WHERE s2.feature_id = s.feature_id AND s2.status = 0)
+	  AND NOT EXISTS (SELECT 1 FROM feature_reservations r WHERE r.feature_id = s.feature_id AND r.trade_id IS NULL)
+`
+
+// ListForSale returns the features for sale matching filter
+func (r *ListingRepository) ListForSale(ctx context.Context, filter models.ListingFilter) ([]*models.Listing, error) {
+	priceColumn := "s.price_irr"
+	if filter.Currency == "psc" {
+		priceColumn = "s.price_psc"
+	}
+
+	query := listingSelect
+	var args []interface{}
+	if filter.Region != 0 {
+		query += ` AND fp.region = ?`
+		args = append(args, filter.Region)
+	}
+	if filter.Karbari != "" {
+		query += ` AND fp.karbari = ?`
+		args = append(args, filter.Karbari)
+	}
+	if filter.MinPrice > 0 {
+		query += ` AND ` + priceColumn + ` >= ?`
+		args = append(args, filter.MinPrice)
+	}
+	if filter.MaxPrice > 0 {
+		query += ` AND ` + priceColumn + ` <= ?`
+		args = append(args, filter.MaxPrice)
+	}
+
+	switch filter.Sort {
+	case models.ListingSortPriceAsc:
+		query += ` ORDER BY ` + priceColumn + ` ASC, s.id ASC`
+	case models.ListingSortPriceDesc:
+		query += ` ORDER BY ` + priceColumn + ` DESC, s.id DESC`
+	default:
+		query += ` ORDER BY s.created_at DESC, s.id DESC`
+	}
+	query += ` LIMIT ? OFFSET ?`
+	args = append(args, filter.Limit, filter.Offset)
+
+	return r.list(ctx, query, args...)
+}
+
+// ListCreatedAfter returns up to limit listings whose sell request id is
+// greater than afterID, oldest first
+func (r *ListingRepository) ListCreatedAfter(ctx context.Context, afterID uint64, limit int) ([]*models.Listing, error) {
+	query := listingSelect + ` AND s.id > ? ORDER BY s.id ASC LIMIT ?`
+
+	return r.list(ctx, query, afterID, limit)
+}
+
+func (r *ListingRepository) list(ctx context.Context, query string, args ...interface{}) ([]*models.Listing, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list features for sale: %w", err)
+	}
+	defer rows.Close()
+
+	var listings []*models.Listing
+	for rows.Next() {
+		listing := &models.Listing{}
+		var listedAt sql.NullTime
+		if err := rows.Scan(
+			&listing.SellRequestID, &listing.FeatureID, &listing.PropertiesID, &listing.Karbari,
+			&listing.Region, &listing.Area, &listing.Address,
+			&listing.SellerID, &listing.PricePSC, &listing.PriceIRR, &listedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan listing: %w", err)
+		}
+		listing.ListedAt = listedAt.Time
+		listings = append(listings, listing)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate listings: %w", err)
+	}
+
+	return listings, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/shopspring/decimal"
+
 	"metargb/features-service/internal/models"
 )
 
@@ -17,7 +19,7 @@ func NewLockedAssetRepository(db *sql.DB) *LockedAssetRepository {
 
 // Create locks assets for a buy request
 // Implements Laravel's BuyRequestsController@store logic for lockedwallet
-func (r *LockedAssetRepository) Create(ctx context.Context, buyRequestID, featureID uint64, psc, irr float64) (uint64, error) {
+func (r *LockedAssetRepository) Create(ctx context.Context, buyRequestID, featureID uint64, psc, irr decimal.Decimal) (uint64, error) {
 	query := `
 		INSERT INTO locked_wallets (buy_feature_request_id, feature_id, psc, irr, created_at, updated_at)
 		VALUES (?, ?, ?, ?, NOW(), NOW())
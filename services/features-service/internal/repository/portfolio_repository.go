@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"metargb/features-service/internal/models"
+)
+
+type PortfolioRepository struct {
+	db *sql.DB
+}
+
+func NewPortfolioRepository(db *sql.DB) *PortfolioRepository {
+	return &PortfolioRepository{db: db}
+}
+
+// ListOwned returns a page of the features a user owns, newest first. Profits
+// and requests are joined in the same query so a page costs one round trip.
+func (r *PortfolioRepository) ListOwned(ctx context.Context, ownerID uint64, limit, offset int) ([]*models.PortfolioFeature, error) {
+	query := `
+		SELECT fp.id, fp.feature_id, fp.karbari, fp.rgb, fp.owner, fp.label, fp.area, fp.density,
+		       fp.stability, fp.price_psc, fp.price_irr, fp.minimum_price_percentage,
+		       COALESCE(p.id, 0), COALESCE(p.asset, ''), COALESCE(p.amount, 0), p.dead_line, COALESCE(p.is_active, 0),
+		       COALESCE(s.id, 0), COALESCE(s.price_psc, 0), COALESCE(s.price_irr, 0),
+		       COALESCE(b.pending, 0)
+		FROM features f
+		INNER JOIN feature_properties fp ON fp.feature_id = f.id
+		LEFT JOIN feature_hourly_profits p ON p.id = (
+			SELECT MAX(p2.id) FROM feature_hourly_profits p2 WHERE p2.feature_id = f.id AND p2.user_id = f.owner_id
+		)
+		LEFT JOIN sell_feature_requests s ON s.id = (
+			SELECT MAX(s2.id) FROM sell_feature_requests s2 WHERE s2.feature_id = f.id AND s2.status = 0
+		)
+		LEFT JOIN (
+			SELECT feature_id, COUNT(*) AS pending
+			FROM buy_feature_requests
+			WHERE seller_id = ? AND status = 0 AND deleted_at IS NULL
+			GROUP BY feature_id
+		) b ON b.feature_id = f.id
+		WHERE f.owner_id = ?
+		ORDER BY f.id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, ownerID, ownerID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list portfolio: %w", err)
+	}
+	defer rows.Close()
+
+	var features []*models.PortfolioFeature
+	for rows.Next() {
+		feature := &models.PortfolioFeature{}
+		props := &feature.Properties
+		var deadLine sql.NullTime
+		if err := rows.Scan(
+			&props.ID, &props.FeatureID, &props.Karbari, &props.RGB, &props.Owner, &props.Label,
+			&props.Area, &props.Density, &props.Stability, &props.PricePSC, &props.PriceIRR,
+			&props.MinimumPricePercentage,
+			&feature.ProfitID, &feature.ProfitAsset, &feature.ProfitAmount, &deadLine, &feature.ProfitActive,
+			&feature.SellRequestID, &feature.SellPricePSC, &feature.SellPriceIRR,
+			&feature.PendingBuyRequests,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan portfolio feature: %w", err)
+		}
+		feature.ProfitDeadLine = deadLine.Time
+		features = append(features, feature)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate portfolio: %w", err)
+	}
+
+	return features, nil
+}
+
+// Totals aggregates every feature a user owns: stability per karbari for
+// valuation, accrued profit per asset and open requests
+func (r *PortfolioRepository) Totals(ctx context.Context, ownerID uint64) (*models.PortfolioTotals, error) {
+	totals := &models.PortfolioTotals{
+		StabilityByKarbari: make(map[string]float64),
+		ProfitByAsset:      make(map[string]string),
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT fp.karbari, COUNT(*), COALESCE(SUM(fp.stability), 0)
+		FROM features f
+		INNER JOIN feature_properties fp ON fp.feature_id = f.id
+		WHERE f.owner_id = ?
+		GROUP BY fp.karbari
+	`, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum portfolio stability: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var karbari string
+		var count int
+		var stability float64
+		if err := rows.Scan(&karbari, &count, &stability); err != nil {
+			return nil, fmt.Errorf("failed to scan portfolio stability: %w", err)
+		}
+		totals.FeatureCount += count
+		totals.StabilityByKarbari[karbari] = stability
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate portfolio stability: %w", err)
+	}
+
+	profitRows, err := r.db.QueryContext(ctx, `
+		SELECT p.asset, SUM(p.amount)
+		FROM feature_hourly_profits p
+		INNER JOIN features f ON f.id = p.feature_id AND f.owner_id = p.user_id
+		WHERE p.user_id = ?
+		GROUP BY p.asset
+	`, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum portfolio profits: %w", err)
+	}
+	defer profitRows.Close()
+	for profitRows.Next() {
+		var asset, total string
+		if err := profitRows.Scan(&asset, &total); err != nil {
+			return nil, fmt.Errorf("failed to scan portfolio profits: %w", err)
+		}
+		totals.ProfitByAsset[asset] = total
+	}
+	if err := profitRows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate portfolio profits: %w", err)
+	}
+
+	err = r.db.QueryRowContext(ctx, `
+		SELECT
+			(SELECT COUNT(DISTINCT s.feature_id)
+			 FROM sell_feature_requests s
+			 INNER JOIN features f ON f.id = s.feature_id
+			 WHERE f.owner_id = ? AND s.status = 0),
+			(SELECT COUNT(*)
+			 FROM buy_feature_requests b
+			 INNER JOIN features f ON f.id = b.feature_id AND f.owner_id = b.seller_id
+			 WHERE b.seller_id = ? AND b.status = 0 AND b.deleted_at IS NULL)
+	`, ownerID, ownerID).Scan(&totals.OnSale, &totals.PendingBuyRequests)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count portfolio requests: %w", err)
+	}
+
+	return totals, nil
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"metargb/features-service/internal/models"
+)
+
+type ProfitSettingsRepository struct {
+	db *sql.DB
+}
+
+func NewProfitSettingsRepository(db *sql.DB) *ProfitSettingsRepository {
+	return &ProfitSettingsRepository{db: db}
+}
+
+// GetSettings returns a user's profit settings, the zero value if they never saved any
+func (r *ProfitSettingsRepository) GetSettings(ctx context.Context, userID uint64) (*models.ProfitSettings, error) {
+	settings := &models.ProfitSettings{UserID: userID}
+
+	err := r.db.QueryRowContext(ctx,
+		"SELECT auto_claim, compound FROM feature_profit_settings WHERE user_id = ?",
+		userID,
+	).Scan(&settings.AutoClaim, &settings.Compound)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get profit settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// SaveSettings creates or replaces a user's profit settings
+func (r *ProfitSettingsRepository) SaveSettings(ctx context.Context, settings *models.ProfitSettings) error {
+	query := `
+		INSERT INTO feature_profit_settings (user_id, auto_claim, compound, created_at, updated_at)
+		VALUES (?, ?, ?, NOW(), NOW())
+		ON DUPLICATE KEY UPDATE auto_claim = VALUES(auto_claim), compound = VALUES(compound), updated_at = NOW()
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, settings.UserID, settings.AutoClaim, settings.Compound); err != nil {
+		return fmt.Errorf("failed to save profit settings: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"metargb/features-service/internal/models"
+)
+
+type ReservationRepository struct {
+	db *sql.DB
+}
+
+func NewReservationRepository(db *sql.DB) *ReservationRepository {
+	return &ReservationRepository{db: db}
+}
+
+// Create reserves a feature and reports whether it was free. A completed
+// reservation left from an earlier installment sale does not block a new one.
+func (r *ReservationRepository) Create(ctx context.Context, reservation *models.FeatureReservation) (bool, error) {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM feature_reservations WHERE feature_id = ? AND trade_id IS NOT NULL`, reservation.FeatureID); err != nil {
+		return false, fmt.Errorf("failed to clear completed reservation: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		INSERT IGNORE INTO feature_reservations (feature_id, buyer_id, seller_id, price_psc, price_irr, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, NOW(), NOW())
+	`, reservation.FeatureID, reservation.BuyerID, reservation.SellerID, reservation.PricePSC, reservation.PriceIRR)
+	if err != nil {
+		return false, fmt.Errorf("failed to reserve feature: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// FindByFeatureID returns the reservation of a feature, nil if it has none
+func (r *ReservationRepository) FindByFeatureID(ctx context.Context, featureID uint64) (*models.FeatureReservation, error) {
+	reservation := &models.FeatureReservation{}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, feature_id, buyer_id, seller_id, price_psc, price_irr, trade_id, created_at, updated_at
+		FROM feature_reservations
+		WHERE feature_id = ?
+	`, featureID).Scan(
+		&reservation.ID, &reservation.FeatureID, &reservation.BuyerID, &reservation.SellerID,
+		&reservation.PricePSC, &reservation.PriceIRR, &reservation.TradeID,
+		&reservation.CreatedAt, &reservation.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feature reservation: %w", err)
+	}
+	return reservation, nil
+}
+
+// IsReserved reports whether a feature is held for an installment buyer
+func (r *ReservationRepository) IsReserved(ctx context.Context, featureID uint64) (bool, error) {
+	var reserved bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS(SELECT 1 FROM feature_reservations WHERE feature_id = ? AND trade_id IS NULL)
+	`, featureID).Scan(&reserved)
+	if err != nil {
+		return false, fmt.Errorf("failed to check feature reservation: %w", err)
+	}
+	return reserved, nil
+}
+
+// MarkCompleted records the trade that completed a reservation
+func (r *ReservationRepository) MarkCompleted(ctx context.Context, reservationID, tradeID uint64) error {
+	if _, err := r.db.ExecContext(ctx, `
+		UPDATE feature_reservations SET trade_id = ?, updated_at = NOW() WHERE id = ?
+	`, tradeID, reservationID); err != nil {
+		return fmt.Errorf("failed to complete feature reservation: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the open reservation of a buyer on a feature and reports
+// whether there was one
+func (r *ReservationRepository) Delete(ctx context.Context, featureID, buyerID uint64) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM feature_reservations WHERE feature_id = ? AND buyer_id = ? AND trade_id IS NULL
+	`, featureID, buyerID)
+	if err != nil {
+		return false, fmt.Errorf("failed to release feature reservation: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
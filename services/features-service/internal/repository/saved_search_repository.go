@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"metargb/features-service/internal/models"
+)
+
+type SavedSearchRepository struct {
+	db *sql.DB
+}
+
+func NewSavedSearchRepository(db *sql.DB) *SavedSearchRepository {
+	return &SavedSearchRepository{db: db}
+}
+
+const savedSearchSelect = `
+	SELECT id, user_id, name, region, karbari, currency, min_price, max_price,
+	       last_sell_request_id, created_at, updated_at
+	FROM saved_searches
+`
+
+// Create saves a search and returns its id. The search starts at the newest
+// sell request so only listings created from now on are notified.
+func (r *SavedSearchRepository) Create(ctx context.Context, search *models.SavedSearch) (uint64, error) {
+	query := `
+		INSERT INTO saved_searches
+			(user_id, name, region, karbari, currency, min_price, max_price, last_sell_request_id, created_at, updated_at)
+		SELECT ?, ?, ?, ?, ?, ?, ?, COALESCE((SELECT MAX(id) FROM sell_feature_requests), 0), NOW(), NOW()
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		search.UserID, search.Name, search.Region, search.Karbari, search.Currency, search.MinPrice, search.MaxPrice,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create saved search: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get saved search id: %w", err)
+	}
+	return uint64(id), nil
+}
+
+// Update replaces the criteria of a user's saved search
+func (r *SavedSearchRepository) Update(ctx context.Context, search *models.SavedSearch) error {
+	query := `
+		UPDATE saved_searches
+		SET name = ?, region = ?, karbari = ?, currency = ?, min_price = ?, max_price = ?, updated_at = NOW()
+		WHERE id = ? AND user_id = ?
+	`
+
+	if _, err := r.db.ExecContext(ctx, query,
+		search.Name, search.Region, search.Karbari, search.Currency, search.MinPrice, search.MaxPrice,
+		search.ID, search.UserID,
+	); err != nil {
+		return fmt.Errorf("failed to update saved search: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a user's saved search and reports whether it existed
+func (r *SavedSearchRepository) Delete(ctx context.Context, userID, id uint64) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM saved_searches WHERE id = ? AND user_id = ?`, id, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete saved search: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// Find returns a user's saved search, nil if the user has no search with that id
+func (r *SavedSearchRepository) Find(ctx context.Context, userID, id uint64) (*models.SavedSearch, error) {
+	query := savedSearchSelect + ` WHERE id = ? AND user_id = ? LIMIT 1`
+
+	search, err := scanSavedSearch(r.db.QueryRowContext(ctx, query, id, userID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find saved search: %w", err)
+	}
+	return search, nil
+}
+
+// ListByUser returns a user's saved searches, most recently saved first
+func (r *SavedSearchRepository) ListByUser(ctx context.Context, userID uint64) ([]*models.SavedSearch, error) {
+	query := savedSearchSelect + ` WHERE user_id = ? ORDER BY id DESC`
+
+	return r.list(ctx, query, userID)
+}
+
+// CountByUser returns how many searches a user saved
+func (r *SavedSearchRepository) CountByUser(ctx context.Context, userID uint64) (int, error) {
+	var count int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM saved_searches WHERE user_id = ?`, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count saved searches: %w", err)
+	}
+	return count, nil
+}
+
+// MinLastSellRequestID returns the oldest cursor of all saved searches and
+// false when there are no saved searches
+func (r *SavedSearchRepository) MinLastSellRequestID(ctx context.Context) (uint64, bool, error) {
+	var cursor sql.NullInt64
+	if err := r.db.QueryRowContext(ctx, `SELECT MIN(last_sell_request_id) FROM saved_searches`).Scan(&cursor); err != nil {
+		return 0, false, fmt.Errorf("failed to get saved search cursor: %w", err)
+	}
+	return uint64(cursor.Int64), cursor.Valid, nil
+}
+
+// ListBefore returns the saved searches that have not been checked against
+// the sell request sellRequestID yet
+func (r *SavedSearchRepository) ListBefore(ctx context.Context, sellRequestID uint64) ([]*models.SavedSearch, error) {
+	query := savedSearchSelect + ` WHERE last_sell_request_id < ? ORDER BY id`
+
+	return r.list(ctx, query, sellRequestID)
+}
+
+// UpdateLastSellRequestID moves a search's cursor forward
+func (r *SavedSearchRepository) UpdateLastSellRequestID(ctx context.Context, id, sellRequestID uint64) error {
+	query := `UPDATE saved_searches SET last_sell_request_id = ? WHERE id = ? AND last_sell_request_id < ?`
+
+	if _, err := r.db.ExecContext(ctx, query, sellRequestID, id, sellRequestID); err != nil {
+		return fmt.Errorf("failed to update saved search cursor: %w", err)
+	}
+	return nil
+}
+
+func (r *SavedSearchRepository) list(ctx context.Context, query string, args ...interface{}) ([]*models.SavedSearch, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved searches: %w", err)
+	}
+	defer rows.Close()
+
+	var searches []*models.SavedSearch
+	for rows.Next() {
+		search, err := scanSavedSearch(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan saved search: %w", err)
+		}
+		searches = append(searches, search)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate saved searches: %w", err)
+	}
+
+	return searches, nil
+}
+
+type savedSearchScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSavedSearch(s savedSearchScanner) (*models.SavedSearch, error) {
+	search := &models.SavedSearch{}
+	var createdAt, updatedAt sql.NullTime
+	if err := s.Scan(
+		&search.ID, &search.UserID, &search.Name, &search.Region, &search.Karbari, &search.Currency,
+		&search.MinPrice, &search.MaxPrice, &search.LastSellRequestID, &createdAt, &updatedAt,
+	); err != nil {
+		return nil, err
+	}
+	search.CreatedAt = createdAt.Time
+	search.UpdatedAt = updatedAt.Time
+	return search, nil
+}
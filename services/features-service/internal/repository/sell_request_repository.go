@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/shopspring/decimal"
+
 	"metargb/features-service/internal/models"
 )
 
@@ -16,7 +18,7 @@ func NewSellRequestRepository(db *sql.DB) *SellRequestRepository {
 }
 
 // Create creates a new sell feature request
-func (r *SellRequestRepository) Create(ctx context.Context, sellerID, featureID uint64, pricePSC, priceIRR float64, limit int) (uint64, error) {
+func (r *SellRequestRepository) Create(ctx context.Context, sellerID, featureID uint64, pricePSC, priceIRR decimal.Decimal, limit int) (uint64, error) {
 	query := `
 		INSERT INTO sell_feature_requests (seller_id, feature_id, price_psc, price_irr, ` + "`limit`" + `, status, created_at, updated_at)
 		VALUES (?, ?, ?, ?, ?, 0, NOW(), NOW())
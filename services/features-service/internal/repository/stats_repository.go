@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+type StatsRepository struct {
+	db *sql.DB
+}
+
+func NewStatsRepository(db *sql.DB) *StatsRepository {
+	return &StatsRepository{db: db}
+}
+
+// TradeStats summarizes the trades made in a period
+type TradeStats struct {
+	Count     int64
+	PSCVolume float64
+	IRRVolume float64
+}
+
+// GetTradeStats summarizes trades made in [from, to)
+func (r *StatsRepository) GetTradeStats(ctx context.Context, from, to time.Time) (*TradeStats, error) {
+	query := `
+		SELECT COUNT(*), COALESCE(SUM(psc_amount), 0), COALESCE(SUM(irr_amount), 0)
+		FROM trades
+		WHERE created_at >= ? AND created_at < ?
+	`
+
+	stats := &TradeStats{}
+	if err := r.db.QueryRowContext(ctx, query, from, to).Scan(&stats.Count, &stats.PSCVolume, &stats.IRRVolume); err != nil {
+		return nil, fmt.Errorf("failed to get trade stats: %w", err)
+	}
+	return stats, nil
+}
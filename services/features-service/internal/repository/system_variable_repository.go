@@ -4,73 +4,89 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"strconv"
+	"strings"
+
+	"metargb/features-service/internal/constants"
+	"metargb/shared/pkg/variables"
+)
+
+// Slugs of the pricing limit system variables
+const (
+	publicPricingLimitSlug  = "public_pricing_limit"
+	under18PricingLimitSlug = "under_18_pricing_limit"
 )
 
+// SystemVariableRepository reads system_variables through a cache. Admins
+// change them through commercial-service's VariableService, which publishes
+// a change event so Invalidate can drop the old value.
 type SystemVariableRepository struct {
-	db *sql.DB
+	db    *sql.DB
+	cache *variables.Cache
 }
 
 func NewSystemVariableRepository(db *sql.DB) *SystemVariableRepository {
-	return &SystemVariableRepository{db: db}
+	r := &SystemVariableRepository{db: db}
+	r.cache = variables.NewCache(r.load, variables.DefaultTTL)
+	return r
+}
+
+// Invalidate drops a cached system variable so it is read again
+func (r *SystemVariableRepository) Invalidate(slug string) {
+	r.cache.Invalidate(slug)
 }
 
-// GetByKey retrieves a system variable value by key
+// GetByKey retrieves a system variable value by slug
 // Implements Laravel: SystemVariable::getByKey('public_pricing_limit') ?? 80
 func (r *SystemVariableRepository) GetByKey(ctx context.Context, key string) (int, error) {
-	query := `
-		SELECT value
-		FROM system_variables
-		WHERE key_name = ?
-		LIMIT 1
-	`
-
-	var valueStr string
-	err := r.db.QueryRowContext(ctx, query, key).Scan(&valueStr)
-	if err == sql.ErrNoRows {
-		return 0, nil // Return 0 if not found (caller will use default)
-	}
+	values, err := r.cache.Get(ctx, key)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get system variable: %w", err)
 	}
-
-	value, err := strconv.Atoi(valueStr)
-	if err != nil {
-		return 0, fmt.Errorf("failed to parse system variable value: %w", err)
-	}
-
-	return value, nil
+	return int(values[key]), nil // 0 if not found, caller will use default
 }
 
 // GetPricingLimits retrieves both pricing limits at once
 func (r *SystemVariableRepository) GetPricingLimits(ctx context.Context) (publicLimit int, under18Limit int, err error) {
-	query := `
-		SELECT 
-			COALESCE(MAX(CASE WHEN key_name = 'public_pricing_limit' THEN value END), '80') as public_limit,
-			COALESCE(MAX(CASE WHEN key_name = 'under_18_pricing_limit' THEN value END), '110') as under_18_limit
-		FROM system_variables
-		WHERE key_name IN ('public_pricing_limit', 'under_18_pricing_limit')
-	`
+	publicLimit, under18Limit = constants.DefaultPublicPricingLimit, constants.DefaultUnder18PricingLimit
 
-	var publicLimitStr, under18LimitStr string
-	err = r.db.QueryRowContext(ctx, query).Scan(&publicLimitStr, &under18LimitStr)
-	if err != nil && err != sql.ErrNoRows {
-		return 80, 110, nil // Return defaults on error
+	values, err := r.cache.Get(ctx, publicPricingLimitSlug, under18PricingLimitSlug)
+	if err != nil {
+		return publicLimit, under18Limit, nil // Return defaults on error
 	}
 
-	if err == sql.ErrNoRows {
-		return 80, 110, nil // Return defaults if no rows
+	if value, ok := values[publicPricingLimitSlug]; ok && value > 0 {
+		publicLimit = int(value)
+	}
+	if value, ok := values[under18PricingLimitSlug]; ok && value > 0 {
+		under18Limit = int(value)
 	}
 
-	publicLimit, err = strconv.Atoi(publicLimitStr)
-	if err != nil {
-		publicLimit = 80 // Default on parse error
+	return publicLimit, under18Limit, nil
+}
+
+// load reads the system variables with the given slugs
+func (r *SystemVariableRepository) load(ctx context.Context, slugs []string) (map[string]float64, error) {
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(slugs)), ",")
+	args := make([]interface{}, len(slugs))
+	for i, slug := range slugs {
+		args[i] = slug
 	}
 
-	under18Limit, err = strconv.Atoi(under18LimitStr)
+	rows, err := r.db.QueryContext(ctx, `SELECT slug, value FROM system_variables WHERE slug IN (`+placeholders+`)`, args...)
 	if err != nil {
-		under18Limit = 110 // Default on parse error
+		return nil, err
 	}
+	defer rows.Close()
 
-	return publicLimit, under18Limit, nil
+	values := make(map[string]float64, len(slugs))
+	for rows.Next() {
+		var slug string
+		var value float64
+		if err := rows.Scan(&slug, &value); err != nil {
+			return nil, err
+		}
+		values[slug] = value
+	}
+
+	return values, rows.Err()
 }
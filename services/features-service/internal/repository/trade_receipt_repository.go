@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+
+	"metargb/features-service/internal/models"
+)
+
+// receiptCodeAttempts is how many codes are tried before giving up on a
+// collision; with 50 random bits a second attempt is already very unlikely
+const receiptCodeAttempts = 3
+
+type TradeReceiptRepository struct {
+	db *sql.DB
+}
+
+func NewTradeReceiptRepository(db *sql.DB) *TradeReceiptRepository {
+	return &TradeReceiptRepository{db: db}
+}
+
+type receiptExecer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// insertTradeReceipt issues the receipt of a trade with a new verification code
+func insertTradeReceipt(ctx context.Context, exec receiptExecer, tradeID uint64) error {
+	for attempt := 1; ; attempt++ {
+		code, err := models.NewReceiptCode()
+		if err != nil {
+			return err
+		}
+		_, err = exec.ExecContext(ctx, `
+			INSERT INTO trade_receipts (trade_id, code, created_at, updated_at)
+			VALUES (?, ?, NOW(), NOW())
+		`, tradeID, code)
+		if err == nil {
+			return nil
+		}
+		if !isDuplicateKey(err) || attempt == receiptCodeAttempts {
+			return fmt.Errorf("failed to issue trade receipt: %w", err)
+		}
+	}
+}
+
+func isDuplicateKey(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 // ER_DUP_ENTRY
+}
+
+const tradeReceiptQuery = `
+	SELECT r.id, r.trade_id, r.code, r.refunded_at, r.created_at,
+	       t.feature_id, COALESCE(fp.id, ''), t.buyer_id, t.seller_id,
+	       COALESCE(t.psc_amount, 0), COALESCE(t.irr_amount, 0), t.created_at
+	FROM trade_receipts r
+	INNER JOIN trades t ON t.id = r.trade_id
+	LEFT JOIN feature_properties fp ON fp.feature_id = t.feature_id
+`
+
+func (r *TradeReceiptRepository) findOne(ctx context.Context, where string, arg interface{}) (*models.TradeReceipt, error) {
+	receipt := &models.TradeReceipt{}
+	var refundedAt sql.NullTime
+	var tradedAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, tradeReceiptQuery+where, arg).Scan(
+		&receipt.ID, &receipt.TradeID, &receipt.Code, &refundedAt, &receipt.IssuedAt,
+		&receipt.FeatureID, &receipt.PropertiesID, &receipt.BuyerID, &receipt.SellerID,
+		&receipt.PSCAmount, &receipt.IRRAmount, &tradedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trade receipt: %w", err)
+	}
+	if refundedAt.Valid {
+		receipt.RefundedAt = &refundedAt.Time
+	}
+	receipt.TradedAt = receipt.IssuedAt
+	if tradedAt.Valid {
+		receipt.TradedAt = tradedAt.Time
+	}
+	return receipt, nil
+}
+
+// FindByCode returns the receipt with a verification code in stored form, nil if none
+func (r *TradeReceiptRepository) FindByCode(ctx context.Context, code string) (*models.TradeReceipt, error) {
+	return r.findOne(ctx, `WHERE r.code = ?`, code)
+}
+
+// FindByTradeID returns the receipt of a trade, nil if none was issued
+func (r *TradeReceiptRepository) FindByTradeID(ctx context.Context, tradeID uint64) (*models.TradeReceipt, error) {
+	return r.findOne(ctx, `WHERE r.trade_id = ?`, tradeID)
+}
+
+// EnsureForTrade returns the receipt of a trade, issuing it first for trades
+// made before receipts existed. Returns nil if the trade does not exist.
+func (r *TradeReceiptRepository) EnsureForTrade(ctx context.Context, tradeID uint64) (*models.TradeReceipt, error) {
+	receipt, err := r.FindByTradeID(ctx, tradeID)
+	if err != nil || receipt != nil {
+		return receipt, err
+	}
+
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM trades WHERE id = ?)`, tradeID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to find trade: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	// A concurrent call may issue the receipt first, then its receipt is returned
+	if err := insertTradeReceipt(ctx, r.db, tradeID); err != nil && !isDuplicateKey(err) {
+		return nil, err
+	}
+	return r.FindByTradeID(ctx, tradeID)
+}
+
+// MarkRefunded records that a trade was reversed by a dispute, issuing its
+// receipt first if needed
+func (r *TradeReceiptRepository) MarkRefunded(ctx context.Context, tradeID uint64) error {
+	if _, err := r.EnsureForTrade(ctx, tradeID); err != nil {
+		return err
+	}
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE trade_receipts SET refunded_at = NOW(), updated_at = NOW()
+		WHERE trade_id = ? AND refunded_at IS NULL
+	`, tradeID)
+	if err != nil {
+		return fmt.Errorf("failed to mark trade receipt refunded: %w", err)
+	}
+	return nil
+}
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/shopspring/decimal"
+
 	"metargb/features-service/internal/models"
 )
 
@@ -16,35 +18,47 @@ func NewTradeRepository(db *sql.DB) *TradeRepository {
 	return &TradeRepository{db: db}
 }
 
-// Create creates a new trade record
-func (r *TradeRepository) Create(ctx context.Context, featureID, buyerID, sellerID uint64, irrAmount, pscAmount float64) (uint64, error) {
+// Create creates a new trade record together with its receipt
+func (r *TradeRepository) Create(ctx context.Context, featureID, buyerID, sellerID uint64, irrAmount, pscAmount decimal.Decimal) (uint64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
 	query := `
 		INSERT INTO trades (feature_id, buyer_id, seller_id, irr_amount, psc_amount, date, created_at, updated_at)
 		VALUES (?, ?, ?, ?, ?, NOW(), NOW(), NOW())
 	`
 
-	result, err := r.db.ExecContext(ctx, query, featureID, buyerID, sellerID, irrAmount, pscAmount)
+	result, err := tx.ExecContext(ctx, query, featureID, buyerID, sellerID, irrAmount, pscAmount)
 	if err != nil {
 		return 0, err
 	}
 
 	id, err := result.LastInsertId()
-	return uint64(id), err
+	if err != nil {
+		return 0, err
+	}
+
+	if err := insertTradeReceipt(ctx, tx, uint64(id)); err != nil {
+		return 0, err
+	}
+
+	return uint64(id), tx.Commit()
 }
 
-// GetLatestForFeature gets the most recent trade for a feature
-func (r *TradeRepository) GetLatestForFeature(ctx context.Context, featureID uint64) (*models.Trade, error) {
+// FindByID finds a trade by ID, nil if it does not exist
+func (r *TradeRepository) FindByID(ctx context.Context, id uint64) (*models.Trade, error) {
 	trade := &models.Trade{}
 
 	query := `
 		SELECT id, feature_id, buyer_id, seller_id, irr_amount, psc_amount, date, created_at, updated_at
 		FROM trades
-		WHERE feature_id = ?
-		ORDER BY created_at DESC
-		LIMIT 1
+		WHERE id = ?
 	`
 
-	err := r.db.QueryRowContext(ctx, query, featureID).Scan(
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&trade.ID, &trade.FeatureID, &trade.BuyerID, &trade.SellerID,
 		&trade.IRRAmount, &trade.PSCAmount, &trade.Date,
 		&trade.CreatedAt, &trade.UpdatedAt,
@@ -57,19 +71,15 @@ func (r *TradeRepository) GetLatestForFeature(ctx context.Context, featureID uin
 	return trade, err
 }
 
-// GetLatestForFeatureWithSeller gets the most recent trade for a feature with seller information
-func (r *TradeRepository) GetLatestForFeatureWithSeller(ctx context.Context, featureID uint64) (*models.Trade, *SellerInfo, error) {
+// GetLatestForFeature gets the most recent trade for a feature
+func (r *TradeRepository) GetLatestForFeature(ctx context.Context, featureID uint64) (*models.Trade, error) {
 	trade := &models.Trade{}
-	seller := &SellerInfo{}
 
 	query := `
-		SELECT 
-			t.id, t.feature_id, t.buyer_id, t.seller_id, t.irr_amount, t.psc_amount, t.date, t.created_at, t.updated_at,
-			u.id as seller_user_id, u.name as seller_name, u.code as seller_code
-		FROM trades t
-		LEFT JOIN users u ON t.seller_id = u.id
-		WHERE t.feature_id = ?
-		ORDER BY t.created_at DESC
+		SELECT id, feature_id, buyer_id, seller_id, irr_amount, psc_amount, date, created_at, updated_at
+		FROM trades
+		WHERE feature_id = ?
+		ORDER BY created_at DESC
 		LIMIT 1
 	`
 
@@ -77,21 +87,13 @@ func (r *TradeRepository) GetLatestForFeatureWithSeller(ctx context.Context, fea
 		&trade.ID, &trade.FeatureID, &trade.BuyerID, &trade.SellerID,
 		&trade.IRRAmount, &trade.PSCAmount, &trade.Date,
 		&trade.CreatedAt, &trade.UpdatedAt,
-		&seller.ID, &seller.Name, &seller.Code,
 	)
 
 	if err == sql.ErrNoRows {
-		return nil, nil, nil
+		return nil, nil
 	}
 
-	return trade, seller, err
-}
-
-// SellerInfo represents seller information from a trade
-type SellerInfo struct {
-	ID   uint64
-	Name string
-	Code string
+	return trade, err
 }
 
 // GetLatestForSeller gets the most recent underpriced trade for a seller
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/features-service/internal/models"
+)
+
+// TradeSummaryRepository reads a user's trades and profit payouts over a
+// period for their annual summary
+type TradeSummaryRepository struct {
+	db *sql.DB
+}
+
+func NewTradeSummaryRepository(db *sql.DB) *TradeSummaryRepository {
+	return &TradeSummaryRepository{db: db}
+}
+
+// annualTradeQuery selects the trades of one side, named by the caller's
+// WHERE. The commission is split evenly between buyer and seller.
+const annualTradeQuery = `
+	SELECT t.id, t.feature_id, COALESCE(fp.id, ''), t.buyer_id,
+	       COALESCE(t.psc_amount, 0), COALESCE(t.irr_amount, 0),
+	       COALESCE((SELECT SUM(c.psc) FROM comissions c WHERE c.trade_id = t.id), 0),
+	       COALESCE((SELECT SUM(c.irr) FROM comissions c WHERE c.trade_id = t.id), 0),
+	       COALESCE(r.code, ''), r.refunded_at IS NOT NULL, COALESCE(t.created_at, t.date)
+	FROM trades t
+	LEFT JOIN feature_properties fp ON fp.feature_id = t.feature_id
+	LEFT JOIN trade_receipts r ON r.trade_id = t.id
+`
+
+// ListTrades returns the trades the user bought or sold in [from, to),
+// oldest first
+func (r *TradeSummaryRepository) ListTrades(ctx context.Context, userID uint64, from, to time.Time) ([]*models.AnnualTrade, error) {
+	// One select per side so each can use its (user, created_at) index
+	query := annualTradeQuery + ` WHERE t.buyer_id = ? AND t.created_at >= ? AND t.created_at < ?
+		UNION ALL ` + annualTradeQuery + ` WHERE t.seller_id = ? AND t.buyer_id <> t.seller_id AND t.created_at >= ? AND t.created_at < ?
+		ORDER BY 11, 1`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, from, to, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trades: %w", err)
+	}
+	defer rows.Close()
+
+	two := decimal.NewFromInt(2)
+	var trades []*models.AnnualTrade
+	for rows.Next() {
+		trade := &models.AnnualTrade{}
+		var buyerID uint64
+		var commissionPSC, commissionIRR decimal.Decimal
+		var tradedAt sql.NullTime
+		if err := rows.Scan(
+			&trade.TradeID, &trade.FeatureID, &trade.PropertiesID, &buyerID,
+			&trade.PricePSC, &trade.PriceIRR, &commissionPSC, &commissionIRR,
+			&trade.ReceiptCode, &trade.Refunded, &tradedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan trade: %w", err)
+		}
+		trade.Role = models.TradeRoleSeller
+		if buyerID == userID {
+			trade.Role = models.TradeRoleBuyer
+		}
+		trade.FeePSC = commissionPSC.Div(two)
+		trade.FeeIRR = commissionIRR.Div(two)
+		trade.TradedAt = tradedAt.Time
+		trades = append(trades, trade)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate trades: %w", err)
+	}
+	return trades, nil
+}
+
+// ProfitTotals returns the hourly profit paid to the user in [from, to) per
+// asset
+func (r *TradeSummaryRepository) ProfitTotals(ctx context.Context, userID uint64, from, to time.Time) ([]*models.AnnualProfit, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT asset, SUM(amount)
+		FROM feature_profit_payouts
+		WHERE user_id = ? AND created_at >= ? AND created_at < ?
+		GROUP BY asset
+		ORDER BY asset
+	`, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to total profit payouts: %w", err)
+	}
+	defer rows.Close()
+
+	var profits []*models.AnnualProfit
+	for rows.Next() {
+		profit := &models.AnnualProfit{}
+		if err := rows.Scan(&profit.Asset, &profit.Amount); err != nil {
+			return nil, fmt.Errorf("failed to scan profit payouts: %w", err)
+		}
+		profits = append(profits, profit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate profit payouts: %w", err)
+	}
+	return profits, nil
+}
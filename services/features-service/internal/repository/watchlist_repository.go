@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"metargb/features-service/internal/models"
+)
+
+type WatchlistRepository struct {
+	db *sql.DB
+}
+
+func NewWatchlistRepository(db *sql.DB) *WatchlistRepository {
+	return &WatchlistRepository{db: db}
+}
+
+// pendingSellRequestSQL selects the latest pending sell request of the watched feature
+const pendingSellRequestSQL = `COALESCE((
+	SELECT MAX(s.id) FROM sell_feature_requests s WHERE s.feature_id = w.feature_id AND s.status = 0
+), 0)`
+
+const watchedFeatureSelect = `
+	SELECT w.id, w.user_id, w.feature_id, w.last_owner_id, w.last_price_psc, w.last_price_irr,
+	       w.last_sell_request_id, w.created_at, w.updated_at,
+	       fp.id, fp.karbari, f.owner_id, fp.price_psc, fp.price_irr, ` + pendingSellRequestSQL + ` AS sell_request_id
+	FROM feature_watchlists w
+	INNER JOIN features f ON f.id = w.feature_id
+	INNER JOIN feature_properties fp ON fp.feature_id = w.feature_id
+`
+
+// Add watches a feature for a user, recording the feature's current state as
+// the baseline for alerts. Watching an already watched feature is a no-op.
+func (r *WatchlistRepository) Add(ctx context.Context, userID, featureID uint64) error {
+	query := `
+		INSERT INTO feature_watchlists
+			(user_id, feature_id, last_owner_id, last_price_psc, last_price_irr, last_sell_request_id, created_at, updated_at)
+		SELECT ?, f.id, f.owner_id, fp.price_psc, fp.price_irr,
+		       COALESCE((SELECT MAX(s.id) FROM sell_feature_requests s WHERE s.feature_id = f.id AND s.status = 0), 0),
+		       NOW(), NOW()
+		FROM features f
+		INNER JOIN feature_properties fp ON fp.feature_id = f.id
+		WHERE f.id = ?
+		ON DUPLICATE KEY UPDATE id = id
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, featureID); err != nil {
+		return fmt.Errorf("failed to add feature to watchlist: %w", err)
+	}
+	return nil
+}
+
+// Remove stops watching a feature and reports whether it was watched
+func (r *WatchlistRepository) Remove(ctx context.Context, userID, featureID uint64) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM feature_watchlists WHERE user_id = ? AND feature_id = ?`, userID, featureID)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove feature from watchlist: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// CountByUser returns how many features a user watches
+func (r *WatchlistRepository) CountByUser(ctx context.Context, userID uint64) (int, error) {
+	var count int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM feature_watchlists WHERE user_id = ?`, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count watchlist: %w", err)
+	}
+	return count, nil
+}
+
+// Find returns a user's watchlist entry for a feature, nil if not watched
+func (r *WatchlistRepository) Find(ctx context.Context, userID, featureID uint64) (*models.WatchedFeature, error) {
+	query := watchedFeatureSelect + ` WHERE w.user_id = ? AND w.feature_id = ? LIMIT 1`
+
+	watch, err := scanWatchedFeature(r.db.QueryRowContext(ctx, query, userID, featureID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find watchlist entry: %w", err)
+	}
+	return watch, nil
+}
+
+// ListByUser returns a user's watchlist, most recently added first
+func (r *WatchlistRepository) ListByUser(ctx context.Context, userID uint64) ([]*models.WatchedFeature, error) {
+	query := watchedFeatureSelect + ` WHERE w.user_id = ? ORDER BY w.id DESC`
+
+	return r.list(ctx, query, userID)
+}
+
+// ListChanged returns up to limit entries whose feature changed owner, price
+// or pending sell request since the watcher's last alert
+func (r *WatchlistRepository) ListChanged(ctx context.Context, limit int) ([]*models.WatchedFeature, error) {
+	query := watchedFeatureSelect + `
+		WHERE f.owner_id <> w.last_owner_id
+		   OR fp.price_psc <> w.last_price_psc
+		   OR fp.price_irr <> w.last_price_irr
+		   OR ` + pendingSellRequestSQL + ` <> w.last_sell_request_id
+		ORDER BY w.id
+		LIMIT ?
+	`
+
+	return r.list(ctx, query, limit)
+}
+
+// UpdateSnapshot records the feature's current state as alerted
+func (r *WatchlistRepository) UpdateSnapshot(ctx context.Context, watch *models.WatchedFeature) error {
+	query := `
+		UPDATE feature_watchlists
+		SET last_owner_id = ?, last_price_psc = ?, last_price_irr = ?, last_sell_request_id = ?, updated_at = NOW()
+		WHERE id = ?
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, watch.OwnerID, watch.PricePSC, watch.PriceIRR, watch.SellRequestID, watch.ID); err != nil {
+		return fmt.Errorf("failed to update watchlist snapshot: %w", err)
+	}
+	return nil
+}
+
+func (r *WatchlistRepository) list(ctx context.Context, query string, args ...interface{}) ([]*models.WatchedFeature, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list watchlist: %w", err)
+	}
+	defer rows.Close()
+
+	var watches []*models.WatchedFeature
+	for rows.Next() {
+		watch, err := scanWatchedFeature(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan watchlist entry: %w", err)
+		}
+		watches = append(watches, watch)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate watchlist: %w", err)
+	}
+
+	return watches, nil
+}
+
+type watchedFeatureScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanWatchedFeature(s watchedFeatureScanner) (*models.WatchedFeature, error) {
+	watch := &models.WatchedFeature{}
+	if err := s.Scan(
+		&watch.ID, &watch.UserID, &watch.FeatureID, &watch.LastOwnerID,
+		&watch.LastPricePSC, &watch.LastPriceIRR, &watch.LastSellRequestID,
+		&watch.CreatedAt, &watch.UpdatedAt,
+		&watch.PropertiesID, &watch.Karbari, &watch.OwnerID,
+		&watch.PricePSC, &watch.PriceIRR, &watch.SellRequestID,
+	); err != nil {
+		return nil, err
+	}
+	return watch, nil
+}
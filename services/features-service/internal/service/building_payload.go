@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net/url"
+	"strings"
+)
+
+// FileUploader stores a file and returns its public URL
+type FileUploader interface {
+	UploadFile(ctx context.Context, uploadPath, filename, contentType string, data []byte) (string, error)
+}
+
+// buildingModelUploadPath is where inline building model payloads are stored
+const buildingModelUploadPath = "building-models"
+
+// offloadInlinePayloads walks a decoded JSON value from the 3D Meta API and
+// replaces every data: URI with the URL returned by upload, so model images and
+// files travel as links instead of inflating gRPC responses
+func offloadInlinePayloads(value interface{}, upload func(contentType string, data []byte) (string, error)) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		if !strings.HasPrefix(v, "data:") {
+			return v, nil
+		}
+		contentType, data, err := decodeDataURI(v)
+		if err != nil {
+			return nil, err
+		}
+		return upload(contentType, data)
+	case map[string]interface{}:
+		for key, item := range v {
+			replaced, err := offloadInlinePayloads(item, upload)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = replaced
+		}
+		return v, nil
+	case []map[string]interface{}:
+		for i, item := range v {
+			if _, err := offloadInlinePayloads(item, upload); err != nil {
+				return nil, err
+			}
+			v[i] = item
+		}
+		return v, nil
+	case []interface{}:
+		for i, item := range v {
+			replaced, err := offloadInlinePayloads(item, upload)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = replaced
+		}
+		return v, nil
+	default:
+		return v, nil
+	}
+}
+
+// decodeDataURI parses data:[<mediatype>][;base64],<data>
+func decodeDataURI(uri string) (string, []byte, error) {
+	header, payload, ok := strings.Cut(strings.TrimPrefix(uri, "data:"), ",")
+	if !ok {
+		return "", nil, fmt.Errorf("invalid data URI")
+	}
+
+	contentType := "application/octet-stream"
+	isBase64 := strings.HasSuffix(header, ";base64")
+	if mediaType := strings.TrimSuffix(header, ";base64"); mediaType != "" {
+		contentType = mediaType
+	}
+
+	if isBase64 {
+		data, err := base64.StdEncoding.DecodeString(payload)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid base64 data URI: %w", err)
+		}
+		return contentType, data, nil
+	}
+
+	text, err := url.PathUnescape(payload)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid data URI: %w", err)
+	}
+	return contentType, []byte(text), nil
+}
+
+// payloadFilename names an uploaded payload after its content, so the same
+// image uploaded again maps to the same file
+func payloadFilename(contentType string, data []byte) string {
+	sum := sha256.Sum256(data)
+	ext := ".bin"
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if exts, err := mime.ExtensionsByType(mediaType); err == nil && len(exts) > 0 {
+		ext = exts[0]
+	}
+	return hex.EncodeToString(sum[:16]) + ext
+}
@@ -2,11 +2,14 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"regexp"
 	"strconv"
+	"sync"
 	"time"
 
 	"metargb/features-service/internal/client"
@@ -25,6 +28,21 @@ type BuildingService struct {
 	hourlyProfitRepo *repository.HourlyProfitRepository
 	threeDClient     *threed_client.Client
 	commercialClient *client.CommercialClient
+	storageClient    FileUploader
+	payloadURLs      sync.Map // payload filename -> storage URL
+}
+
+// BuildPackage is one page of building models from the 3D Meta API
+type BuildPackage struct {
+	Models      []*pb.BuildingModel
+	Coordinates []string
+	CurrentPage int32
+	LastPage    int32
+}
+
+// HasMorePages reports whether the 3D Meta API has pages after this one
+func (p *BuildPackage) HasMorePages() bool {
+	return p.CurrentPage < p.LastPage
 }
 
 func NewBuildingService(
@@ -48,30 +66,36 @@ func (s *BuildingService) SetCommercialClient(client *client.CommercialClient) {
 	s.commercialClient = client
 }
 
+// SetStorageClient sets the storage client used to move inline model payloads
+// out of responses. Without it data: URIs are returned as-is.
+func (s *BuildingService) SetStorageClient(client FileUploader) {
+	s.storageClient = client
+}
+
 // GetBuildPackage retrieves building models from 3D Meta API
 // Checks ownership, calls 3D API, calculates required_satisfaction, upserts models, and returns with coordinates
-func (s *BuildingService) GetBuildPackage(ctx context.Context, featureID uint64, page int32) ([]*pb.BuildingModel, []string, error) {
+func (s *BuildingService) GetBuildPackage(ctx context.Context, featureID uint64, page int32) (*BuildPackage, error) {
 	// Get feature with properties
 	feature, properties, err := s.featureRepo.FindByID(ctx, featureID)
 	if err != nil {
-		return nil, nil, fmt.Errorf("feature not found: %w", err)
+		return nil, fmt.Errorf("feature not found: %w", err)
 	}
 
 	// Get user from context for ownership check
 	user, err := auth.GetUserFromContext(ctx)
 	if err != nil {
-		return nil, nil, fmt.Errorf("unauthorized: authentication required")
+		return nil, fmt.Errorf("unauthorized: authentication required")
 	}
 
 	// Ownership check: user must own the feature
 	if feature.OwnerID != user.UserID {
-		return nil, nil, fmt.Errorf("unauthorized: user does not own this feature")
+		return nil, fmt.Errorf("unauthorized: user does not own this feature")
 	}
 
 	// Get coordinates for feature
 	coordinates, err := s.geometryRepo.GetCoordinatesByFeatureID(ctx, featureID)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get coordinates: %w", err)
+		return nil, fmt.Errorf("failed to get coordinates: %w", err)
 	}
 
 	// Get density from properties (default to 1 if not set)
@@ -89,7 +113,7 @@ func (s *BuildingService) GetBuildPackage(ctx context.Context, featureID uint64,
 		Page:      page,
 	})
 	if err != nil {
-		return nil, nil, fmt.Errorf("3D API call failed: %w", err)
+		return nil, fmt.Errorf("3D API call failed: %w", err)
 	}
 
 	// Get karbari coefficient
@@ -98,6 +122,10 @@ func (s *BuildingService) GetBuildPackage(ctx context.Context, featureID uint64,
 	// Convert API response to protobuf models and calculate required_satisfaction
 	models := make([]*pb.BuildingModel, 0, len(apiResp.Data))
 	for _, item := range apiResp.Data {
+		if err := s.offloadModelPayloads(ctx, &item); err != nil {
+			return nil, fmt.Errorf("failed to store building model %s payload: %w", item.ID, err)
+		}
+
 		imagesJSON, _ := json.Marshal(item.Images)
 		attrsJSON, _ := json.Marshal(item.Attributes)
 		fileJSON, _ := json.Marshal(item.File)
@@ -124,7 +152,49 @@ func (s *BuildingService) GetBuildPackage(ctx context.Context, featureID uint64,
 		})
 	}
 
-	return models, coordinates, nil
+	result := &BuildPackage{
+		Models:      models,
+		Coordinates: coordinates,
+		CurrentPage: apiResp.Meta.CurrentPage,
+		LastPage:    apiResp.Meta.LastPage,
+	}
+	if result.CurrentPage == 0 {
+		result.CurrentPage = page
+	}
+	if result.LastPage < result.CurrentPage {
+		result.LastPage = result.CurrentPage
+	}
+
+	return result, nil
+}
+
+// offloadModelPayloads uploads the inline data: URIs in a model's images and
+// file to storage-service and replaces them with URLs
+func (s *BuildingService) offloadModelPayloads(ctx context.Context, item *threed_client.BuildingModelData) error {
+	if s.storageClient == nil {
+		return nil
+	}
+
+	upload := func(contentType string, data []byte) (string, error) {
+		filename := payloadFilename(contentType, data)
+		if url, ok := s.payloadURLs.Load(filename); ok {
+			return url.(string), nil
+		}
+		url, err := s.storageClient.UploadFile(ctx, buildingModelUploadPath, filename, contentType, data)
+		if err != nil {
+			return "", err
+		}
+		s.payloadURLs.Store(filename, url)
+		return url, nil
+	}
+
+	if _, err := offloadInlinePayloads(item.Images, upload); err != nil {
+		return err
+	}
+	if _, err := offloadInlinePayloads(item.File, upload); err != nil {
+		return err
+	}
+	return nil
 }
 
 // BuildFeature starts construction of a building on a feature
@@ -568,3 +638,93 @@ func (s *BuildingService) DestroyBuilding(ctx context.Context, featureID, buildi
 
 	return s.buildingRepo.DeleteBuilding(ctx, featureID, buildingModelID)
 }
+
+// SimulateBuild runs the checks of BuildFeature for the current user without
+// building. Unmet requirements are listed instead of failing on the first one.
+func (s *BuildingService) SimulateBuild(ctx context.Context, req *pb.SimulateBuildRequest) (*pb.SimulateBuildResponse, error) {
+	feature, properties, err := s.featureRepo.FindByID(ctx, req.FeatureId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("feature not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feature: %w", err)
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: authentication required")
+	}
+
+	resp := &pb.SimulateBuildResponse{
+		ProfitAsset:           constants.GetColor(properties.Karbari),
+		ProjectedHourlyProfit: fmt.Sprintf("%.6f", properties.Stability*constants.HourlyProfitCalculationRate/constants.HourlyProfitCalculationIntervalHours),
+	}
+	missing := func(code, message, required, available string) {
+		resp.Missing = append(resp.Missing, &pb.BuildRequirement{Code: code, Message: message, Required: required, Available: available})
+	}
+
+	if feature.OwnerID != user.UserID {
+		missing("ownership", "user does not own this feature", "", "")
+	}
+
+	hasBuilding, err := s.buildingRepo.HasBuilding(ctx, req.FeatureId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check building existence: %w", err)
+	}
+	if hasBuilding {
+		missing("existing_building", "feature already has a building", "", "")
+	}
+
+	buildingModel, err := s.buildingRepo.FindBuildingModelByModelID(ctx, req.BuildingModelId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find building model: %w", err)
+	}
+	if buildingModel == nil {
+		// Models are stored when the build package is fetched
+		missing("building_model", "building model not found, fetch the build package first", "", "")
+		return resp, nil
+	}
+
+	requiredSatisfaction, err := strconv.ParseFloat(buildingModel.RequiredSatisfaction, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid required_satisfaction: %w", err)
+	}
+	launchedSatisfaction := requiredSatisfaction
+	if req.LaunchedSatisfaction != "" {
+		launchedSatisfaction, err = strconv.ParseFloat(req.LaunchedSatisfaction, 64)
+		if err != nil || launchedSatisfaction <= 0 {
+			return nil, fmt.Errorf("invalid launched_satisfaction: must be a positive number")
+		}
+	}
+	resp.RequiredSatisfaction = fmt.Sprintf("%.4f", requiredSatisfaction)
+	resp.LaunchedSatisfaction = fmt.Sprintf("%.4f", launchedSatisfaction)
+
+	if launchedSatisfaction < requiredSatisfaction {
+		missing("satisfaction", "launched_satisfaction is below the model's required satisfaction", resp.RequiredSatisfaction, resp.LaunchedSatisfaction)
+	}
+
+	if s.commercialClient == nil {
+		return nil, fmt.Errorf("commercial client not available")
+	}
+	wallet, err := s.commercialClient.GetWallet(ctx, user.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wallet: %w", err)
+	}
+	walletSatisfaction, err := strconv.ParseFloat(wallet.Satisfaction, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wallet satisfaction: %w", err)
+	}
+	resp.AvailableSatisfaction = fmt.Sprintf("%.4f", walletSatisfaction)
+
+	if launchedSatisfaction > walletSatisfaction {
+		missing("balance", "insufficient satisfaction in wallet", resp.LaunchedSatisfaction, resp.AvailableSatisfaction)
+	}
+
+	// Same duration as BuildFeature: required_satisfaction * 288000 / launched_satisfaction
+	constructionDuration := time.Duration(requiredSatisfaction*288000.0/launchedSatisfaction) * time.Second
+	resp.ConstructionSeconds = int64(constructionDuration / time.Second)
+	resp.ConstructionEndDate = helpers.FormatJalaliDateTime(time.Now().Add(constructionDuration))
+	resp.Qualifies = len(resp.Missing) == 0
+
+	return resp, nil
+}
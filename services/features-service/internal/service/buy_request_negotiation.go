@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/features-service/internal/constants"
+	"metargb/features-service/internal/models"
+)
+
+// DefaultMaxCounterOffers is how many counter-offers a seller may make on one
+// buy request when BUY_REQUEST_MAX_COUNTER_OFFERS is not set
+const DefaultMaxCounterOffers = 3
+
+var (
+	ErrNotNegotiating          = errors.New("unauthorized: not the buyer or seller of this request")
+	ErrCounterOfferOpen        = errors.New("the buyer has not answered the last counter-offer yet")
+	ErrNegotiationRoundsUsed   = errors.New("no counter-offers are left on this buy request")
+	ErrNoOpenCounterOffer      = errors.New("there is no counter-offer to answer")
+	ErrNegotiationChanged      = errors.New("the negotiation changed meanwhile, reload it and try again")
+	ErrCounterOfferNoPrice     = errors.New("price_psc and price_irr cannot both be zero")
+	ErrCounterOfferSamePrice   = errors.New("the counter-offer must differ from the current price")
+	ErrBuyRequestNotNegotiable = errors.New("buy request is not pending")
+)
+
+// Negotiation is a buy request with the rounds of its negotiation
+type Negotiation struct {
+	Request    *models.BuyFeatureRequest
+	Offers     []*models.BuyRequestOffer
+	RoundsUsed int
+	MaxRounds  int
+	// Open is the counter-offer awaiting the buyer, nil when there is none
+	Open *models.BuyRequestOffer
+}
+
+// SetMaxCounterOffers bounds the counter-offers a seller may make on one buy
+// request, DefaultMaxCounterOffers if n is not positive
+func (s *MarketplaceService) SetMaxCounterOffers(n int) {
+	if n <= 0 {
+		n = DefaultMaxCounterOffers
+	}
+	s.maxCounterOffers = n
+}
+
+// newNegotiation summarises the rounds recorded for request
+func newNegotiation(request *models.BuyFeatureRequest, offers []*models.BuyRequestOffer, maxRounds int) *Negotiation {
+	negotiation := &Negotiation{Request: request, Offers: offers, MaxRounds: maxRounds}
+	for _, offer := range offers {
+		if offer.Round == 0 {
+			continue
+		}
+		negotiation.RoundsUsed++
+		if offer.Status == models.OfferOpen {
+			negotiation.Open = offer
+		}
+	}
+	return negotiation
+}
+
+// nextCounter returns the round a new counter-offer takes, and whether the
+// buyer's opening offer must be recorded with it
+func (n *Negotiation) nextCounter() (int, bool, error) {
+	if n.Open != nil {
+		return 0, false, ErrCounterOfferOpen
+	}
+	if n.RoundsUsed >= n.MaxRounds {
+		return 0, false, ErrNegotiationRoundsUsed
+	}
+	return n.RoundsUsed + 1, len(n.Offers) == 0, nil
+}
+
+// relockDelta returns how much more of an asset the buyer must have locked,
+// negative when part of the lock is returned, once the request moves from
+// a lock of locked to a price of price
+func relockDelta(price, locked decimal.Decimal) decimal.Decimal {
+	return constants.CalculateBuyerCharge(price).Sub(locked)
+}
+
+func (s *MarketplaceService) loadNegotiation(ctx context.Context, requestID uint64) (*Negotiation, error) {
+	request, err := s.buyRequestRepo.FindByID(ctx, requestID)
+	if err != nil || request == nil {
+		return nil, fmt.Errorf("buy request not found: %w", err)
+	}
+	offers, err := s.offerRepo.ListByRequest(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	return newNegotiation(request, offers, s.maxCounterOffers), nil
+}
+
+// GetNegotiation returns a buy request with every round of its negotiation,
+// to its buyer or seller
+func (s *MarketplaceService) GetNegotiation(ctx context.Context, requestID, userID uint64) (*Negotiation, error) {
+	negotiation, err := s.loadNegotiation(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if negotiation.Request.BuyerID != userID && negotiation.Request.SellerID != userID {
+		return nil, ErrNotNegotiating
+	}
+	return negotiation, nil
+}
+
+// CounterBuyRequest answers a pending buy request with the seller's price.
+// The buyer's funds stay locked at their own offer until they accept it.
+func (s *MarketplaceService) CounterBuyRequest(ctx context.Context, requestID, sellerID uint64, pricePSC, priceIRR decimal.Decimal, note string) (*Negotiation, error) {
+	negotiation, err := s.loadNegotiation(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	request := negotiation.Request
+	if request.SellerID != sellerID {
+		return nil, fmt.Errorf("unauthorized: not the seller")
+	}
+	if request.Status != 0 {
+		return nil, ErrBuyRequestNotNegotiable
+	}
+	if pricePSC.IsNegative() || priceIRR.IsNegative() || pricePSC.IsZero() && priceIRR.IsZero() {
+		return nil, ErrCounterOfferNoPrice
+	}
+	if pricePSC.Equal(request.PricePSC) && priceIRR.Equal(request.PriceIRR) {
+		return nil, ErrCounterOfferSamePrice
+	}
+
+	_, properties, err := s.featureRepo.FindByID(ctx, request.FeatureID)
+	if err != nil {
+		return nil, fmt.Errorf("feature not found: %w", err)
+	}
+	if err := s.checkPriceFloor(ctx, properties, pricePSC, priceIRR); err != nil {
+		return nil, err
+	}
+
+	round, recordOpening, err := negotiation.nextCounter()
+	if err != nil {
+		return nil, err
+	}
+
+	var opening *models.BuyRequestOffer
+	if recordOpening {
+		opening = &models.BuyRequestOffer{
+			BuyFeatureRequestID: requestID,
+			Round:               0,
+			OfferedBy:           request.BuyerID,
+			PricePSC:            request.PricePSC,
+			PriceIRR:            request.PriceIRR,
+			Note:                request.Note,
+			Status:              models.OfferCountered,
+		}
+	}
+	counter := &models.BuyRequestOffer{
+		BuyFeatureRequestID: requestID,
+		Round:               round,
+		OfferedBy:           sellerID,
+		PricePSC:            pricePSC,
+		PriceIRR:            priceIRR,
+		Note:                note,
+		Status:              models.OfferOpen,
+	}
+	created, err := s.offerRepo.CreateCounter(ctx, opening, counter)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		return nil, ErrNegotiationChanged
+	}
+
+	s.log.Info("Buy request countered",
+		"request_id", requestID,
+		"seller_id", sellerID,
+		"round", round,
+		"price_psc", pricePSC,
+		"price_irr", priceIRR,
+	)
+	s.notifyNegotiation(ctx, request.BuyerID, "buy_request_countered",
+		"پیشنهاد متقابل فروشنده",
+		fmt.Sprintf("فروشنده به درخواست خرید شماره %d با قیمت جدیدی پاسخ داد", requestID),
+		request, round)
+
+	return s.loadNegotiation(ctx, requestID)
+}
+
+// DeclineCounterOffer turns down the open counter-offer. The request stays
+// pending at the buyer's price and the seller may counter again while
+// rounds are left.
+func (s *MarketplaceService) DeclineCounterOffer(ctx context.Context, requestID, buyerID uint64) (*Negotiation, error) {
+	negotiation, err := s.loadNegotiation(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if negotiation.Request.BuyerID != buyerID {
+		return nil, fmt.Errorf("unauthorized: not the buyer")
+	}
+	if negotiation.Open == nil {
+		return nil, ErrNoOpenCounterOffer
+	}
+
+	declined, err := s.offerRepo.Decline(ctx, negotiation.Open.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !declined {
+		return nil, ErrNegotiationChanged
+	}
+
+	s.log.Info("Counter-offer declined", "request_id", requestID, "round", negotiation.Open.Round)
+	s.notifyNegotiation(ctx, negotiation.Request.SellerID, "buy_request_counter_declined",
+		"پیشنهاد متقابل رد شد",
+		fmt.Sprintf("خریدار پیشنهاد متقابل شما برای درخواست خرید شماره %d را نپذیرفت", requestID),
+		negotiation.Request, negotiation.Open.Round)
+
+	return s.loadNegotiation(ctx, requestID)
+}
+
+// AcceptCounterOffer agrees to the open counter-offer. The buyer's lock is
+// topped up or partly returned to match the new price, then the sale
+// completes as if the seller accepted the request at that price. If the sale
+// cannot complete, e.g. the feature is reserved, the request stays pending
+// at the agreed price for the seller to accept later.
+func (s *MarketplaceService) AcceptCounterOffer(ctx context.Context, requestID, buyerID uint64) (*models.BuyFeatureRequest, error) {
+	negotiation, err := s.loadNegotiation(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	request := negotiation.Request
+	if request.BuyerID != buyerID {
+		return nil, fmt.Errorf("unauthorized: not the buyer")
+	}
+	if request.Status != 0 {
+		return nil, ErrBuyRequestNotNegotiable
+	}
+	counter := negotiation.Open
+	if counter == nil {
+		return nil, ErrNoOpenCounterOffer
+	}
+
+	lockedPSC, lockedIRR := decimal.Zero, decimal.Zero
+	if s.commercialClient != nil {
+		locked, err := s.lockedAssetRepo.GetByBuyRequestID(ctx, requestID)
+		if err != nil {
+			return nil, fmt.Errorf("locked assets not found: %w", err)
+		}
+		lockedPSC, lockedIRR = locked.PSC, locked.IRR
+	}
+	deltaPSC := relockDelta(counter.PricePSC, lockedPSC)
+	deltaIRR := relockDelta(counter.PriceIRR, lockedIRR)
+	if s.commercialClient != nil {
+		if deltaPSC.IsPositive() {
+			if ok, _ := s.commercialClient.CheckBalance(ctx, buyerID, "psc", deltaPSC); !ok {
+				return nil, fmt.Errorf("موجودی psc شما کافی نیست!")
+			}
+		}
+		if deltaIRR.IsPositive() {
+			if ok, _ := s.commercialClient.CheckBalance(ctx, buyerID, "irr", deltaIRR); !ok {
+				return nil, fmt.Errorf("موجودی ریال شما کافی نیست!")
+			}
+		}
+	}
+
+	// Claim the offer first, so a concurrent answer cannot move funds twice
+	accepted, err := s.offerRepo.Accept(ctx, counter, lockedPSC.Add(deltaPSC), lockedIRR.Add(deltaIRR))
+	if err != nil {
+		return nil, err
+	}
+	if !accepted {
+		return nil, ErrNegotiationChanged
+	}
+
+	if err := s.relock(ctx, buyerID, requestID, deltaPSC, deltaIRR); err != nil {
+		if reopenErr := s.offerRepo.Reopen(ctx, counter, request.PricePSC, request.PriceIRR, lockedPSC, lockedIRR); reopenErr != nil {
+			s.log.Error("Failed to reopen counter-offer after a failed relock",
+				"request_id", requestID, "offer_id", counter.ID, "error", reopenErr)
+		}
+		return nil, err
+	}
+
+	s.log.Info("Counter-offer accepted",
+		"request_id", requestID,
+		"round", counter.Round,
+		"psc_relocked", deltaPSC,
+		"irr_relocked", deltaIRR,
+	)
+
+	completed, err := s.AcceptBuyRequest(ctx, requestID, request.SellerID)
+	if err != nil {
+		s.log.Warn("Counter-offer accepted but the sale did not complete",
+			"request_id", requestID, "error", err)
+		s.notifyNegotiation(ctx, request.SellerID, "buy_request_counter_accepted",
+			"پیشنهاد متقابل پذیرفته شد",
+			fmt.Sprintf("خریدار پیشنهاد متقابل شما برای درخواست خرید شماره %d را پذیرفت و درخواست در انتظار تایید شماست", requestID),
+			request, counter.Round)
+		return nil, err
+	}
+	s.notifyNegotiation(ctx, request.SellerID, "buy_request_counter_accepted",
+		"پیشنهاد متقابل پذیرفته شد",
+		fmt.Sprintf("خریدار پیشنهاد متقابل شما برای درخواست خرید شماره %d را پذیرفت و ملک فروخته شد", requestID),
+		request, counter.Round)
+	return completed, nil
+}
+
+// relock charges the buyer the positive deltas and returns the negative
+// ones. A PSC change is undone when the IRR change fails.
+func (s *MarketplaceService) relock(ctx context.Context, buyerID, requestID uint64, deltaPSC, deltaIRR decimal.Decimal) error {
+	if s.commercialClient == nil {
+		return nil
+	}
+	if err := s.relockAsset(ctx, buyerID, requestID, "psc", deltaPSC); err != nil {
+		return err
+	}
+	if err := s.relockAsset(ctx, buyerID, requestID, "irr", deltaIRR); err != nil {
+		if undoErr := s.relockAsset(ctx, buyerID, requestID, "psc", deltaPSC.Neg()); undoErr != nil {
+			s.log.Error("Failed to undo PSC relock", "request_id", requestID, "buyer_id", buyerID, "error", undoErr)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *MarketplaceService) relockAsset(ctx context.Context, buyerID, requestID uint64, asset string, delta decimal.Decimal) error {
+	switch {
+	case delta.IsPositive():
+		if err := s.commercialClient.DeductBalance(ctx, buyerID, asset, delta); err != nil {
+			return fmt.Errorf("failed to lock %s: %w", asset, err)
+		}
+		s.commercialClient.CreateTransaction(ctx, buyerID, asset, delta, "withdraw", 0, "App\\Models\\BuyFeatureRequest", requestID)
+	case delta.IsNegative():
+		if err := s.commercialClient.AddBalance(ctx, buyerID, asset, delta.Neg()); err != nil {
+			return fmt.Errorf("failed to release %s: %w", asset, err)
+		}
+		s.commercialClient.CreateTransaction(ctx, buyerID, asset, delta.Neg(), "deposit", 1, "App\\Models\\BuyFeatureRequest", requestID)
+	}
+	return nil
+}
+
+func (s *MarketplaceService) notifyNegotiation(ctx context.Context, userID uint64, notificationType, title, message string, request *models.BuyFeatureRequest, round int) {
+	if s.notificationClient == nil {
+		return
+	}
+	data := map[string]string{
+		"buy_request_id": fmt.Sprintf("%d", request.ID),
+		"feature_id":     fmt.Sprintf("%d", request.FeatureID),
+		"round":          fmt.Sprintf("%d", round),
+	}
+	if err := s.notificationClient.SendNotification(ctx, userID, notificationType, title, message, data); err != nil {
+		s.log.Warn("Failed to send negotiation notification", "user_id", userID, "request_id", request.ID, "error", err)
+	}
+}
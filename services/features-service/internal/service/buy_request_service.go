@@ -6,11 +6,14 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/shopspring/decimal"
+
 	"metargb/features-service/internal/client"
 	"metargb/features-service/internal/constants"
 	"metargb/features-service/internal/models"
 	"metargb/features-service/internal/repository"
 	"metargb/shared/pkg/logger"
+	"metargb/shared/pkg/usercache"
 )
 
 // BuyRequestService handles buy requests with gRPC cross-service calls
@@ -23,6 +26,7 @@ type BuyRequestService struct {
 	lockedAssetRepo  *repository.LockedAssetRepository
 	hourlyProfitRepo *repository.HourlyProfitRepository
 	commercialClient *client.CommercialClient
+	userCache        *usercache.Cache
 	db               *sql.DB
 	log              *logger.Logger
 }
@@ -36,6 +40,7 @@ func NewBuyRequestService(
 	lockedAssetRepo *repository.LockedAssetRepository,
 	hourlyProfitRepo *repository.HourlyProfitRepository,
 	commercialClient *client.CommercialClient,
+	userCache *usercache.Cache,
 	db *sql.DB,
 	log *logger.Logger,
 ) *BuyRequestService {
@@ -48,13 +53,14 @@ func NewBuyRequestService(
 		lockedAssetRepo:  lockedAssetRepo,
 		hourlyProfitRepo: hourlyProfitRepo,
 		commercialClient: commercialClient,
+		userCache:        userCache,
 		db:               db,
 		log:              log,
 	}
 }
 
 // SendBuyRequest creates a buy request with locked assets using gRPC
-func (s *BuyRequestService) SendBuyRequest(ctx context.Context, buyerID, featureID uint64, pricePSC, priceIRR float64, note string) (uint64, error) {
+func (s *BuyRequestService) SendBuyRequest(ctx context.Context, buyerID, featureID uint64, pricePSC, priceIRR decimal.Decimal, note string) (uint64, error) {
 	// Get feature and seller
 	feature, properties, err := s.featureRepo.FindByID(ctx, featureID)
 	if err != nil {
@@ -64,15 +70,15 @@ func (s *BuyRequestService) SendBuyRequest(ctx context.Context, buyerID, feature
 	sellerID := feature.OwnerID
 
 	// Validate price against minimum_price_percentage
-	totalRequestedPrice := priceIRR + (pricePSC * s.getVariableRate(ctx, "psc"))
+	totalRequestedPrice := priceIRR.Add(pricePSC.Mul(decimal.NewFromFloat(s.getVariableRate(ctx, "psc"))))
 	color := constants.GetColor(properties.Karbari)
 	colorRate := s.getVariableRate(ctx, color)
-	totalFeaturePrice := properties.Stability * colorRate
+	totalFeaturePrice := decimal.NewFromFloat(properties.Stability * colorRate)
 
 	floorPercentage := float64(properties.MinimumPricePercentage)
-	actualPercentage := (totalRequestedPrice / totalFeaturePrice) * 100
+	floorPrice := totalFeaturePrice.Mul(decimal.NewFromInt(int64(properties.MinimumPricePercentage))).Div(decimal.NewFromInt(100))
 
-	if actualPercentage < floorPercentage {
+	if totalRequestedPrice.LessThan(floorPrice) {
 		return 0, fmt.Errorf("شما مجاز به ارسال درخواست خرید به کمتر از %.0f%% قیمت ملک نمی باشید!", floorPercentage)
 	}
 
@@ -161,18 +167,18 @@ func (s *BuyRequestService) AcceptBuyRequest(ctx context.Context, requestID, sel
 	irrFee := constants.CalculateFee(irrAmount)
 
 	// Pay seller via gRPC (price - fee)
-	if err := s.commercialClient.AddBalance(ctx, sellerID, "psc", pscAmount-pscFee); err != nil {
+	if err := s.commercialClient.AddBalance(ctx, sellerID, "psc", pscAmount.Sub(pscFee)); err != nil {
 		return err
 	}
-	if err := s.commercialClient.AddBalance(ctx, sellerID, "irr", irrAmount-irrFee); err != nil {
+	if err := s.commercialClient.AddBalance(ctx, sellerID, "irr", irrAmount.Sub(irrFee)); err != nil {
 		return err
 	}
 
 	// Pay RGB platform via gRPC (fee × 2)
 	rgbUserID, err := s.getRGBUserID(ctx)
 	if err == nil {
-		s.commercialClient.AddBalance(ctx, rgbUserID, "psc", pscFee*2)
-		s.commercialClient.AddBalance(ctx, rgbUserID, "irr", irrFee*2)
+		s.commercialClient.AddBalance(ctx, rgbUserID, "psc", constants.CalculatePlatformFee(pscAmount))
+		s.commercialClient.AddBalance(ctx, rgbUserID, "irr", constants.CalculatePlatformFee(irrAmount))
 	}
 
 	// Create trade
@@ -182,11 +188,11 @@ func (s *BuyRequestService) AcceptBuyRequest(ctx context.Context, requestID, sel
 	}
 
 	// Create commission
-	s.createCommission(ctx, tradeID, pscFee*2, irrFee*2)
+	s.createCommission(ctx, tradeID, constants.CalculatePlatformFee(pscAmount), constants.CalculatePlatformFee(irrAmount))
 
 	// Create transactions for seller via gRPC
-	s.commercialClient.CreateTransaction(ctx, sellerID, "psc", pscAmount-pscFee, "deposit", 1, "App\\Models\\Trade", tradeID)
-	s.commercialClient.CreateTransaction(ctx, sellerID, "irr", irrAmount-irrFee, "deposit", 1, "App\\Models\\Trade", tradeID)
+	s.commercialClient.CreateTransaction(ctx, sellerID, "psc", pscAmount.Sub(pscFee), "deposit", 1, "App\\Models\\Trade", tradeID)
+	s.commercialClient.CreateTransaction(ctx, sellerID, "irr", irrAmount.Sub(irrFee), "deposit", 1, "App\\Models\\Trade", tradeID)
 
 	// Transfer ownership
 	if err := s.featureRepo.UpdateOwner(ctx, feature.ID, buyRequest.BuyerID); err != nil {
@@ -214,7 +220,7 @@ func (s *BuyRequestService) AcceptBuyRequest(ctx context.Context, requestID, sel
 
 	oldProfit, _ := s.hourlyProfitRepo.GetByFeatureAndUser(ctx, feature.ID, sellerID)
 	if oldProfit != nil && oldProfit.Amount > 0 {
-		s.commercialClient.AddBalance(ctx, sellerID, oldProfit.Asset, oldProfit.Amount)
+		s.commercialClient.AddBalance(ctx, sellerID, oldProfit.Asset, decimal.NewFromFloat(oldProfit.Amount))
 	}
 
 	s.hourlyProfitRepo.TransferProfitToNewOwner(ctx, feature.ID, sellerID, buyRequest.BuyerID, withdrawProfitDays)
@@ -299,43 +305,49 @@ func (s *BuyRequestService) checkUnderpricedRestriction(ctx context.Context, fea
 }
 
 func (s *BuyRequestService) getVariableRate(ctx context.Context, asset string) float64 {
-	var rate float64
-	query := "SELECT value FROM variables WHERE `key` = ?"
-	if err := s.db.QueryRowContext(ctx, query, asset).Scan(&rate); err != nil {
+	if s.commercialClient == nil {
+		return 1.0
+	}
+	rate, err := s.commercialClient.GetVariableRate(ctx, asset)
+	if err != nil {
 		return 1.0
 	}
 	return rate
 }
 
 func (s *BuyRequestService) getRGBUserID(ctx context.Context) (uint64, error) {
-	var rgbID uint64
-	err := s.db.QueryRowContext(ctx, "SELECT id FROM users WHERE code = ?", constants.RGBUserCode).Scan(&rgbID)
-	return rgbID, err
+	rgb, err := s.userCache.GetByCode(ctx, constants.RGBUserCode)
+	if err != nil {
+		return 0, err
+	}
+	return rgb.ID, nil
 }
 
 func (s *BuyRequestService) getUserName(ctx context.Context, userID uint64) string {
-	var name string
-	s.db.QueryRowContext(ctx, "SELECT name FROM users WHERE id = ?", userID).Scan(&name)
-	return name
+	user, err := s.userCache.Get(ctx, userID)
+	if err != nil {
+		return ""
+	}
+	return user.Name
 }
 
 func (s *BuyRequestService) isUserUnder18(ctx context.Context, userID uint64) bool {
-	var birthdate sql.NullTime
-	s.db.QueryRowContext(ctx, "SELECT birthdate FROM kycs WHERE user_id = ?", userID).Scan(&birthdate)
-	if !birthdate.Valid {
+	user, err := s.userCache.Get(ctx, userID)
+	if err != nil {
 		return false
 	}
-	// Simplified age check
-	return false
+	return user.IsUnder18(time.Now())
 }
 
 func (s *BuyRequestService) getUserVariableWithdrawProfit(ctx context.Context, userID uint64) (int, error) {
-	var days int
-	err := s.db.QueryRowContext(ctx, "SELECT withdraw_profit FROM user_variables WHERE user_id = ?", userID).Scan(&days)
-	return days, err
+	user, err := s.userCache.Get(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	return user.WithdrawProfitDays, nil
 }
 
-func (s *BuyRequestService) createCommission(ctx context.Context, tradeID uint64, psc, irr float64) {
+func (s *BuyRequestService) createCommission(ctx context.Context, tradeID uint64, psc, irr decimal.Decimal) {
 	query := "INSERT INTO comissions (trade_id, psc, irr, created_at, updated_at) VALUES (?, ?, ?, NOW(), NOW())"
 	s.db.ExecContext(ctx, query, tradeID, psc, irr)
 }
@@ -509,8 +521,8 @@ type BuyRequestDetail struct {
 	FeatureID            uint64
 	Status               int
 	Note                 string
-	PricePSC             float64
-	PriceIRR             float64
+	PricePSC             decimal.Decimal
+	PriceIRR             decimal.Decimal
 	RequestedGracePeriod *time.Time
 	CreatedAt            time.Time
 	// Loaded relationships
@@ -569,14 +581,16 @@ func (s *BuyRequestService) buildBuyRequestDetail(ctx context.Context, req *mode
 	return detail, nil
 }
 
-// getUserCode gets user code from database
+// getUserCode gets user code from auth-service
 func (s *BuyRequestService) getUserCode(ctx context.Context, userID uint64) (string, error) {
-	var code string
-	err := s.db.QueryRowContext(ctx, "SELECT code FROM users WHERE id = ?", userID).Scan(&code)
-	if err == sql.ErrNoRows {
+	user, err := s.userCache.Get(ctx, userID)
+	if err == usercache.ErrUserNotFound {
 		return "", fmt.Errorf("user not found")
 	}
-	return code, err
+	if err != nil {
+		return "", err
+	}
+	return user.Code, nil
 }
 
 // getLatestProfilePhoto gets the latest profile photo URL for a user
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"metargb/features-service/internal/constants"
+	"metargb/features-service/internal/repository"
+	pb "metargb/shared/pb/features"
+)
+
+// DefaultCheckoutReservationTTL is how long a buyer holds a feature while
+// confirming a purchase
+const DefaultCheckoutReservationTTL = 2 * time.Minute
+
+var (
+	ErrFeatureHeldForCheckout  = errors.New("این ملک توسط خریدار دیگری در حال خرید است")
+	ErrCheckoutOwnFeature      = errors.New("you cannot reserve your own feature")
+	ErrCheckoutUnavailable     = errors.New("feature reservations are unavailable")
+	ErrCheckoutFeatureNotFound = errors.New("feature not found")
+)
+
+// SetCheckoutReservations enables checkout reservations. Without them
+// ReserveFeature fails and purchases are not checked for holds.
+func (s *MarketplaceService) SetCheckoutReservations(repo repository.CheckoutReservationRepository, ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = DefaultCheckoutReservationTTL
+	}
+	s.checkoutRepo = repo
+	s.checkoutTTL = ttl
+}
+
+// ReserveForCheckout holds a feature for userID while the 3D client confirms
+// the purchase. Reserving the same feature again does not extend the hold.
+func (s *MarketplaceService) ReserveForCheckout(ctx context.Context, featureID, userID uint64) (*pb.CheckoutReservation, error) {
+	if s.checkoutRepo == nil {
+		return nil, ErrCheckoutUnavailable
+	}
+
+	feature, properties, err := s.featureRepo.FindByID(ctx, featureID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCheckoutFeatureNotFound, err)
+	}
+	if feature.OwnerID == userID {
+		return nil, ErrCheckoutOwnFeature
+	}
+	if err := s.checkNotReserved(ctx, featureID); err != nil {
+		return nil, err
+	}
+	// A user who cannot buy more of a limited campaign must not hold its stock
+	if constants.IsLimitedFeature(properties.RGB) {
+		limitation, err := s.featureLimitRepo.GetLimitationByPropertyID(ctx, properties.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get limitation: %w", err)
+		}
+		if limitation != nil {
+			if err := s.checkLimitedQuota(ctx, limitation, userID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	left, ok, err := s.checkoutRepo.Reserve(ctx, featureID, userID, s.checkoutTTL)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrFeatureHeldForCheckout
+	}
+
+	return &pb.CheckoutReservation{
+		FeatureId:  featureID,
+		UserId:     userID,
+		ExpiresAt:  time.Now().Add(left).Unix(),
+		TtlSeconds: int32(left.Round(time.Second).Seconds()),
+	}, nil
+}
+
+// ReleaseCheckoutReservation ends the hold of userID on a feature. Releasing
+// a feature the user does not hold does nothing.
+func (s *MarketplaceService) ReleaseCheckoutReservation(ctx context.Context, featureID, userID uint64) error {
+	if s.checkoutRepo == nil {
+		return ErrCheckoutUnavailable
+	}
+	return s.checkoutRepo.Release(ctx, featureID, userID)
+}
+
+// checkNotHeldForCheckout fails with ErrFeatureHeldForCheckout while another
+// buyer holds the feature. If Redis cannot be reached the purchase goes ahead.
+func (s *MarketplaceService) checkNotHeldForCheckout(ctx context.Context, featureID, buyerID uint64) error {
+	if s.checkoutRepo == nil {
+		return nil
+	}
+	holder, err := s.checkoutRepo.Holder(ctx, featureID)
+	if err != nil {
+		s.log.Warn("Failed to check checkout reservation", "feature_id", featureID, "error", err)
+		return nil
+	}
+	if holder != 0 && holder != buyerID {
+		return ErrFeatureHeldForCheckout
+	}
+	return nil
+}
+
+// releaseAfterPurchase drops the buyer's hold once the feature is theirs
+func (s *MarketplaceService) releaseAfterPurchase(ctx context.Context, featureID, buyerID uint64) {
+	if s.checkoutRepo == nil {
+		return
+	}
+	if err := s.checkoutRepo.Release(ctx, featureID, buyerID); err != nil {
+		s.log.Warn("Failed to release checkout reservation", "feature_id", featureID, "error", err)
+	}
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"metargb/features-service/internal/models"
+	"metargb/features-service/internal/repository"
+)
+
+const (
+	defaultFeatureHistoryPerPage = 20
+	maxFeatureHistoryPerPage     = 100
+)
+
+var (
+	ErrFeatureHistoryNotFound = errors.New("feature not found")
+	ErrFeatureHistoryNotOwner = errors.New("feature does not belong to user")
+)
+
+// FeatureHistoryServiceInterface defines the interface for feature history operations
+type FeatureHistoryServiceInterface interface {
+	GetFeatureHistory(ctx context.Context, featureID, userID uint64, page, perPage int) (*models.FeatureHistory, error)
+}
+
+type FeatureHistoryService struct {
+	historyRepo *repository.FeatureHistoryRepository
+}
+
+func NewFeatureHistoryService(historyRepo *repository.FeatureHistoryRepository) FeatureHistoryServiceInterface {
+	return &FeatureHistoryService{historyRepo: historyRepo}
+}
+
+// GetFeatureHistory returns a page of the trades, requests, buildings and
+// profit payouts of a feature, oldest first. Only the current owner may read
+// it, including events from before they bought it.
+func (s *FeatureHistoryService) GetFeatureHistory(ctx context.Context, featureID, userID uint64, page, perPage int) (*models.FeatureHistory, error) {
+	ownerID, found, err := s.historyRepo.OwnerID(ctx, featureID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrFeatureHistoryNotFound
+	}
+	if ownerID != userID {
+		return nil, ErrFeatureHistoryNotOwner
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 {
+		perPage = defaultFeatureHistoryPerPage
+	}
+	if perPage > maxFeatureHistoryPerPage {
+		perPage = maxFeatureHistoryPerPage
+	}
+
+	events, total, err := s.historyRepo.List(ctx, featureID, perPage, (page-1)*perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.FeatureHistory{
+		Events:  events,
+		Total:   total,
+		Page:    page,
+		PerPage: perPage,
+	}, nil
+}
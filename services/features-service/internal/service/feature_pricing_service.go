@@ -7,31 +7,39 @@ import (
 	"strconv"
 	"time"
 
+	"metargb/features-service/internal/client"
 	"metargb/features-service/internal/constants"
 	"metargb/features-service/internal/repository"
 	"metargb/shared/pkg/logger"
+	"metargb/shared/pkg/usercache"
 )
 
 // FeaturePricingService handles feature pricing updates
 // Implements Laravel's FeatureController@updateFeature logic (lines 77-105)
 type FeaturePricingService struct {
-	featureRepo    *repository.FeatureRepository
-	propertiesRepo *repository.PropertiesRepository
-	db             *sql.DB
-	log            *logger.Logger
+	featureRepo      *repository.FeatureRepository
+	propertiesRepo   *repository.PropertiesRepository
+	commercialClient *client.CommercialClient
+	userCache        *usercache.Cache
+	db               *sql.DB
+	log              *logger.Logger
 }
 
 func NewFeaturePricingService(
 	featureRepo *repository.FeatureRepository,
 	propertiesRepo *repository.PropertiesRepository,
+	commercialClient *client.CommercialClient,
+	userCache *usercache.Cache,
 	db *sql.DB,
 	log *logger.Logger,
 ) *FeaturePricingService {
 	return &FeaturePricingService{
-		featureRepo:    featureRepo,
-		propertiesRepo: propertiesRepo,
-		db:             db,
-		log:            log,
+		featureRepo:      featureRepo,
+		propertiesRepo:   propertiesRepo,
+		commercialClient: commercialClient,
+		userCache:        userCache,
+		db:               db,
+		log:              log,
 	}
 }
 
@@ -171,23 +179,20 @@ func (s *FeaturePricingService) GetFeaturePriceInfo(ctx context.Context, feature
 // Utility methods
 
 func (s *FeaturePricingService) isUserUnder18(ctx context.Context, userID uint64) (bool, error) {
-	var birthdate sql.NullTime
-	err := s.db.QueryRowContext(ctx, "SELECT birthdate FROM kycs WHERE user_id = ?", userID).Scan(&birthdate)
-	if err != nil || !birthdate.Valid {
+	user, err := s.userCache.Get(ctx, userID)
+	if err != nil {
 		return false, nil
 	}
-
-	// Calculate age correctly
-	now := time.Now()
-	age := float64(now.Sub(birthdate.Time).Hours()) / (365.25 * 24)
-	return age < 18, nil
+	return user.IsUnder18(time.Now()), nil
 }
 
 func (s *FeaturePricingService) getVariableRate(ctx context.Context, asset string) float64 {
-	var rate float64
-	query := "SELECT value FROM variables WHERE `key` = ?"
-	if err := s.db.QueryRowContext(ctx, query, asset).Scan(&rate); err != nil {
-		return 1.0 // Default
+	if s.commercialClient == nil {
+		return 1.0
+	}
+	rate, err := s.commercialClient.GetVariableRate(ctx, asset)
+	if err != nil {
+		return 1.0
 	}
 	return rate
 }
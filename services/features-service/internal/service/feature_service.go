@@ -12,6 +12,7 @@ import (
 	"metargb/features-service/internal/models"
 	"metargb/features-service/internal/repository"
 	pb "metargb/shared/pb/features"
+	"metargb/shared/pkg/usercache"
 )
 
 type FeatureService struct {
@@ -23,6 +24,8 @@ type FeatureService struct {
 	tradeRepo        *repository.TradeRepository
 	hourlyProfitRepo *repository.HourlyProfitRepository
 	pricingService   *FeaturePricingService
+	userCache        *usercache.Cache
+	gallery          GalleryServiceInterface
 	db               *sql.DB
 }
 
@@ -35,6 +38,7 @@ func NewFeatureService(
 	tradeRepo *repository.TradeRepository,
 	hourlyProfitRepo *repository.HourlyProfitRepository,
 	pricingService *FeaturePricingService,
+	userCache *usercache.Cache,
 	db *sql.DB,
 ) *FeatureService {
 	return &FeatureService{
@@ -46,10 +50,16 @@ func NewFeatureService(
 		tradeRepo:        tradeRepo,
 		hourlyProfitRepo: hourlyProfitRepo,
 		pricingService:   pricingService,
+		userCache:        userCache,
 		db:               db,
 	}
 }
 
+// SetGalleryService enables uploading images through AddMyFeatureImages
+func (s *FeatureService) SetGalleryService(gallery GalleryServiceInterface) {
+	s.gallery = gallery
+}
+
 // ListFeatures retrieves features within a bounding box
 // Implements Laravel's FeatureRepository@all logic
 // Supports optional authentication (is_owned_by_auth_user) and building models
@@ -183,21 +193,16 @@ func (s *FeatureService) GetFeature(ctx context.Context, featureID uint64) (*pb.
 	pbImages := make([]*pb.Image, 0, len(images))
 	for _, img := range images {
 		pbImages = append(pbImages, &pb.Image{
-			Id:  img.ID,
-			Url: img.URL,
+			Id:           img.ID,
+			Url:          img.URL,
+			ThumbnailUrl: img.ThumbnailURL,
+			Position:     int32(img.Position),
+			IsCover:      img.IsCover,
 		})
 	}
 
 	// Load latest trade with seller
-	_, seller, err := s.tradeRepo.GetLatestForFeatureWithSeller(ctx, featureID)
-	var pbSeller *pb.Seller
-	if seller != nil && seller.ID > 0 {
-		pbSeller = &pb.Seller{
-			Id:   seller.ID,
-			Name: seller.Name,
-			Code: seller.Code,
-		}
-	}
+	pbSeller := s.latestSeller(ctx, featureID)
 
 	// Load hourly profit status
 	hourlyProfit, err := s.hourlyProfitRepo.GetByFeatureAndUser(ctx, featureID, feature.OwnerID)
@@ -273,6 +278,14 @@ func (s *FeatureService) GetMyFeatures(ctx context.Context, userID uint64) ([]*p
 	return models.FeaturesToPB(features), nil
 }
 
+// MaxOwnedFeatureCountsBatch caps how many owners CountOwnedFeatures counts at once
+const MaxOwnedFeatureCountsBatch = 1000
+
+// CountOwnedFeatures counts the features of many owners
+func (s *FeatureService) CountOwnedFeatures(ctx context.Context, ownerIDs []uint64) (map[uint64]int32, error) {
+	return s.featureRepo.CountByOwners(ctx, ownerIDs)
+}
+
 // ListMyFeatures retrieves paginated features owned by authenticated user (5 per page)
 // Only loads properties (images are empty on this endpoint)
 func (s *FeatureService) ListMyFeatures(ctx context.Context, userID uint64, page int32) ([]*pb.Feature, error) {
@@ -386,21 +399,16 @@ func (s *FeatureService) GetMyFeature(ctx context.Context, userID, featureID uin
 	pbImages := make([]*pb.Image, 0, len(images))
 	for _, img := range images {
 		pbImages = append(pbImages, &pb.Image{
-			Id:  img.ID,
-			Url: img.URL,
+			Id:           img.ID,
+			Url:          img.URL,
+			ThumbnailUrl: img.ThumbnailURL,
+			Position:     int32(img.Position),
+			IsCover:      img.IsCover,
 		})
 	}
 
 	// Load latest trade with seller
-	_, seller, err := s.tradeRepo.GetLatestForFeatureWithSeller(ctx, featureID)
-	var pbSeller *pb.Seller
-	if seller != nil && seller.ID > 0 {
-		pbSeller = &pb.Seller{
-			Id:   seller.ID,
-			Name: seller.Name,
-			Code: seller.Code,
-		}
-	}
+	pbSeller := s.latestSeller(ctx, featureID)
 
 	// Build complete feature response
 	pbFeature := &pb.Feature{
@@ -415,24 +423,13 @@ func (s *FeatureService) GetMyFeature(ctx context.Context, userID, featureID uin
 	return pbFeature, nil
 }
 
-// AddMyFeatureImages adds images to a feature owned by the user
-// imageURLs should be public URLs after file upload (handled by grpc-gateway)
-func (s *FeatureService) AddMyFeatureImages(ctx context.Context, userID, featureID uint64, imageURLs []string) (*pb.Feature, error) {
-	// Verify ownership
-	feature, _, err := s.featureRepo.FindByOwnerAndFeatureID(ctx, userID, featureID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find feature: %w", err)
+// AddMyFeatureImages uploads images to the gallery of a feature owned by the user
+func (s *FeatureService) AddMyFeatureImages(ctx context.Context, userID, featureID uint64, uploads []ImageUpload) (*pb.Feature, error) {
+	if s.gallery == nil {
+		return nil, ErrGalleryStorageUnavailable
 	}
-	if feature == nil {
-		return nil, fmt.Errorf("feature not found or does not belong to user")
-	}
-
-	// Create image records
-	for _, url := range imageURLs {
-		_, err := s.imageRepo.CreateImage(ctx, featureID, url)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create image: %w", err)
-		}
+	if _, err := s.gallery.AttachImages(ctx, userID, featureID, uploads); err != nil {
+		return nil, err
 	}
 
 	// Return updated feature with all images
@@ -484,3 +481,23 @@ func (s *FeatureService) UpdateMyFeature(ctx context.Context, userID, featureID
 
 	return nil
 }
+
+// latestSeller returns the seller of the feature's most recent trade, resolved
+// through auth-service, or nil when the feature was never traded
+func (s *FeatureService) latestSeller(ctx context.Context, featureID uint64) *pb.Seller {
+	trade, err := s.tradeRepo.GetLatestForFeature(ctx, featureID)
+	if err != nil || trade == nil || trade.SellerID == 0 {
+		return nil
+	}
+
+	seller, err := s.userCache.Get(ctx, trade.SellerID)
+	if err != nil {
+		return nil
+	}
+
+	return &pb.Seller{
+		Id:   seller.ID,
+		Name: seller.Name,
+		Code: seller.Code,
+	}
+}
@@ -0,0 +1,298 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"path"
+	"strings"
+	"time"
+
+	"metargb/features-service/internal/models"
+	"metargb/features-service/internal/repository"
+	"metargb/shared/pkg/imaging"
+)
+
+const (
+	// maxFeatureImages caps the gallery of one feature
+	maxFeatureImages = 10
+	// maxFeatureImageBytes is the largest upload accepted per image
+	maxFeatureImageBytes = 1024 << 10
+	// Larger images are rejected before decoding, a 1 MB PNG can hold a huge bitmap
+	maxFeatureImagePixels = 4096 * 4096
+	// Stored gallery images are scaled down to fit maxFeatureImageEdge
+	maxFeatureImageEdge = 1920
+	// Thumbnails are scaled down to fit featureThumbnailEdge
+	featureThumbnailEdge = 320
+)
+
+var (
+	ErrGalleryFeatureNotFound    = errors.New("feature not found")
+	ErrGalleryNotOwner           = errors.New("feature does not belong to user")
+	ErrGalleryImageNotFound      = errors.New("image not found or does not belong to feature")
+	ErrGalleryNoImages           = errors.New("at least one image is required")
+	ErrGalleryTooManyImages      = fmt.Errorf("a feature can have at most %d images", maxFeatureImages)
+	ErrGalleryImageTooBig        = errors.New("image size exceeds 1024 KB limit")
+	ErrGalleryUnsupportedImage   = errors.New("invalid image type: must be PNG or JPG")
+	ErrGalleryImageTooLarge      = errors.New("image is too large: at most 4096x4096 pixels")
+	ErrGalleryInvalidOrder       = errors.New("image_ids must list every image of the feature exactly once")
+	ErrGalleryStorageUnavailable = errors.New("image storage is unavailable")
+)
+
+// galleryImageExtensions maps the accepted image formats to the extension they are stored with
+var galleryImageExtensions = map[string]string{"jpeg": ".jpg", "png": ".png"}
+
+// GalleryImageRepository is the part of the image repository the gallery service uses
+type GalleryImageRepository interface {
+	GetImagesByFeatureID(ctx context.Context, featureID uint64) ([]*repository.Image, error)
+	GetImageByID(ctx context.Context, featureID, imageID uint64) (*repository.Image, error)
+	CountImages(ctx context.Context, featureID uint64) (int, error)
+	AddImages(ctx context.Context, featureID uint64, images []repository.NewImage) ([]*repository.Image, error)
+	DeleteImage(ctx context.Context, featureID, imageID uint64) error
+	ReorderImages(ctx context.Context, featureID uint64, imageIDs []uint64) (bool, error)
+	SetCoverImage(ctx context.Context, featureID, imageID uint64) (bool, error)
+}
+
+// GalleryFeatureRepository looks up the feature a gallery belongs to
+type GalleryFeatureRepository interface {
+	FindByID(ctx context.Context, id uint64) (*models.Feature, *models.FeatureProperties, error)
+}
+
+// ImageUploader stores files and returns their URL, implemented by client.StorageClient
+type ImageUploader interface {
+	UploadFile(ctx context.Context, uploadPath, filename, contentType string, data []byte) (string, error)
+}
+
+// ImageUpload is an image sent by a client to attach to a gallery
+type ImageUpload struct {
+	Data        []byte
+	Filename    string
+	ContentType string
+}
+
+// GalleryServiceInterface defines the interface for feature image galleries
+type GalleryServiceInterface interface {
+	ListImages(ctx context.Context, featureID uint64) ([]*repository.Image, error)
+	AttachImages(ctx context.Context, userID, featureID uint64, uploads []ImageUpload) ([]*repository.Image, error)
+	RemoveImage(ctx context.Context, userID, featureID, imageID uint64) ([]*repository.Image, error)
+	ReorderImages(ctx context.Context, userID, featureID uint64, imageIDs []uint64) ([]*repository.Image, error)
+	SetCoverImage(ctx context.Context, userID, featureID, imageID uint64) ([]*repository.Image, error)
+}
+
+type GalleryService struct {
+	imageRepo   GalleryImageRepository
+	featureRepo GalleryFeatureRepository
+	uploader    ImageUploader
+}
+
+// NewGalleryService creates a gallery service. uploader may be nil, in which
+// case galleries can be listed and arranged but no images can be attached.
+func NewGalleryService(imageRepo GalleryImageRepository, featureRepo GalleryFeatureRepository, uploader ImageUploader) *GalleryService {
+	return &GalleryService{
+		imageRepo:   imageRepo,
+		featureRepo: featureRepo,
+		uploader:    uploader,
+	}
+}
+
+// ListImages returns the gallery of a feature in order
+func (s *GalleryService) ListImages(ctx context.Context, featureID uint64) ([]*repository.Image, error) {
+	if _, _, err := s.featureRepo.FindByID(ctx, featureID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrGalleryFeatureNotFound
+		}
+		return nil, fmt.Errorf("failed to find feature: %w", err)
+	}
+	return s.imageRepo.GetImagesByFeatureID(ctx, featureID)
+}
+
+// AttachImages checks and resizes uploads, stores them with a thumbnail and
+// appends them to the gallery. Nothing is attached if any upload is invalid.
+func (s *GalleryService) AttachImages(ctx context.Context, userID, featureID uint64, uploads []ImageUpload) ([]*repository.Image, error) {
+	if err := s.checkOwner(ctx, userID, featureID); err != nil {
+		return nil, err
+	}
+	if len(uploads) == 0 {
+		return nil, ErrGalleryNoImages
+	}
+
+	count, err := s.imageRepo.CountImages(ctx, featureID)
+	if err != nil {
+		return nil, err
+	}
+	if count+len(uploads) > maxFeatureImages {
+		return nil, ErrGalleryTooManyImages
+	}
+
+	prepared := make([]*preparedGalleryImage, 0, len(uploads))
+	for _, upload := range uploads {
+		p, err := prepareGalleryImage(upload)
+		if err != nil {
+			return nil, err
+		}
+		prepared = append(prepared, p)
+	}
+
+	if s.uploader == nil {
+		return nil, ErrGalleryStorageUnavailable
+	}
+
+	uploadPath := fmt.Sprintf("/uploads/features/%d", featureID)
+	images := make([]repository.NewImage, 0, len(prepared))
+	for _, p := range prepared {
+		url, err := s.uploader.UploadFile(ctx, uploadPath, p.filename, p.contentType, p.data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrGalleryStorageUnavailable, err)
+		}
+		thumbnailURL, err := s.uploader.UploadFile(ctx, uploadPath+"/thumbnails", p.filename, p.contentType, p.thumbnail)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrGalleryStorageUnavailable, err)
+		}
+		images = append(images, repository.NewImage{URL: url, ThumbnailURL: thumbnailURL})
+	}
+
+	if _, err := s.imageRepo.AddImages(ctx, featureID, images); err != nil {
+		return nil, err
+	}
+	return s.imageRepo.GetImagesByFeatureID(ctx, featureID)
+}
+
+// RemoveImage detaches an image from the gallery. The stored files are kept.
+func (s *GalleryService) RemoveImage(ctx context.Context, userID, featureID, imageID uint64) ([]*repository.Image, error) {
+	if err := s.checkOwner(ctx, userID, featureID); err != nil {
+		return nil, err
+	}
+
+	img, err := s.imageRepo.GetImageByID(ctx, featureID, imageID)
+	if err != nil {
+		return nil, err
+	}
+	if img == nil {
+		return nil, ErrGalleryImageNotFound
+	}
+	if err := s.imageRepo.DeleteImage(ctx, featureID, imageID); err != nil {
+		return nil, err
+	}
+	return s.imageRepo.GetImagesByFeatureID(ctx, featureID)
+}
+
+// ReorderImages puts the gallery in the order of imageIDs
+func (s *GalleryService) ReorderImages(ctx context.Context, userID, featureID uint64, imageIDs []uint64) ([]*repository.Image, error) {
+	if err := s.checkOwner(ctx, userID, featureID); err != nil {
+		return nil, err
+	}
+
+	ok, err := s.imageRepo.ReorderImages(ctx, featureID, imageIDs)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrGalleryInvalidOrder
+	}
+	return s.imageRepo.GetImagesByFeatureID(ctx, featureID)
+}
+
+// SetCoverImage makes an image the cover of the gallery
+func (s *GalleryService) SetCoverImage(ctx context.Context, userID, featureID, imageID uint64) ([]*repository.Image, error) {
+	if err := s.checkOwner(ctx, userID, featureID); err != nil {
+		return nil, err
+	}
+
+	ok, err := s.imageRepo.SetCoverImage(ctx, featureID, imageID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrGalleryImageNotFound
+	}
+	return s.imageRepo.GetImagesByFeatureID(ctx, featureID)
+}
+
+func (s *GalleryService) checkOwner(ctx context.Context, userID, featureID uint64) error {
+	feature, _, err := s.featureRepo.FindByID(ctx, featureID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrGalleryFeatureNotFound
+		}
+		return fmt.Errorf("failed to find feature: %w", err)
+	}
+	if feature.OwnerID != userID {
+		return ErrGalleryNotOwner
+	}
+	return nil
+}
+
+// preparedGalleryImage is an upload re-encoded for storage, with its thumbnail
+type preparedGalleryImage struct {
+	data        []byte
+	thumbnail   []byte
+	filename    string
+	contentType string
+}
+
+// prepareGalleryImage checks an upload and re-encodes it, scaled down to
+// maxFeatureImageEdge, together with a thumbnail. Re-encoding also drops any
+// metadata such as the location a photo was taken at.
+func prepareGalleryImage(upload ImageUpload) (*preparedGalleryImage, error) {
+	if len(upload.Data) > maxFeatureImageBytes {
+		return nil, ErrGalleryImageTooBig
+	}
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(upload.Data))
+	if err != nil {
+		return nil, ErrGalleryUnsupportedImage
+	}
+	ext, ok := galleryImageExtensions[format]
+	if !ok {
+		return nil, ErrGalleryUnsupportedImage
+	}
+	contentType := "image/" + format
+	if cfg.Width*cfg.Height > maxFeatureImagePixels {
+		return nil, ErrGalleryImageTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(upload.Data))
+	if err != nil {
+		return nil, ErrGalleryUnsupportedImage
+	}
+
+	data, err := encodeGalleryImage(imaging.Fit(img, maxFeatureImageEdge), contentType)
+	if err != nil {
+		return nil, err
+	}
+	thumbnail, err := encodeGalleryImage(imaging.Fit(img, featureThumbnailEdge), contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSuffix(path.Base(upload.Filename), path.Ext(upload.Filename))
+	if name == "" || name == "." || name == "/" {
+		name = fmt.Sprintf("image_%d", time.Now().UnixNano())
+	}
+
+	return &preparedGalleryImage{
+		data:        data,
+		thumbnail:   thumbnail,
+		filename:    name + ext,
+		contentType: contentType,
+	}, nil
+}
+
+func encodeGalleryImage(img image.Image, contentType string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	if contentType == "image/png" {
+		err = png.Encode(&buf, img)
+	} else {
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"metargb/features-service/internal/models"
+	"metargb/shared/pkg/logger"
+)
+
+// maxGeometryEditReasonLength caps the reason stored with a geometry edit
+const maxGeometryEditReasonLength = 255
+
+var (
+	ErrGeometryNotAdmin        = errors.New("unauthorized: only map admins can edit feature geometry")
+	ErrGeometryFeatureNotFound = errors.New("feature geometry not found")
+	ErrGeometryReasonTooLong   = errors.New("reason must not exceed 255 characters")
+)
+
+// GeometryEditRepository is the part of the geometry repository the geometry service uses
+type GeometryEditRepository interface {
+	ReplaceCoordinates(ctx context.Context, featureID uint64, points []models.GeometryPoint, area float64, editedBy uint64, reason string) (*models.GeometryVersion, error)
+	ListVersions(ctx context.Context, featureID uint64) ([]*models.GeometryVersion, error)
+}
+
+// GeometryPublisher broadcasts geometry edits to live map clients, implemented by pubsub.RedisPublisher
+type GeometryPublisher interface {
+	PublishGeometryChanged(ctx context.Context, version *models.GeometryVersion) error
+}
+
+// GeometryServiceInterface defines the interface for feature geometry editing
+type GeometryServiceInterface interface {
+	UpdateGeometry(ctx context.Context, adminID, featureID uint64, points []models.GeometryPoint, reason string) (*models.GeometryVersion, error)
+	ListVersions(ctx context.Context, adminID, featureID uint64) ([]*models.GeometryVersion, error)
+}
+
+type GeometryService struct {
+	repo      GeometryEditRepository
+	publisher GeometryPublisher
+	admins    map[uint64]bool
+	minArea   float64
+	maxArea   float64
+	log       *logger.Logger
+}
+
+// NewGeometryService creates a geometry service. Only adminIDs may edit or
+// view geometry history, and edited polygons must have an area within
+// [minArea, maxArea]. publisher may be nil, in which case edits are not broadcast.
+func NewGeometryService(repo GeometryEditRepository, publisher GeometryPublisher, adminIDs []uint64, minArea, maxArea float64, log *logger.Logger) *GeometryService {
+	admins := make(map[uint64]bool, len(adminIDs))
+	for _, id := range adminIDs {
+		admins[id] = true
+	}
+	if minArea <= 0 {
+		minArea = DefaultGeometryMinArea
+	}
+	if maxArea <= 0 {
+		maxArea = DefaultGeometryMaxArea
+	}
+	return &GeometryService{
+		repo:      repo,
+		publisher: publisher,
+		admins:    admins,
+		minArea:   minArea,
+		maxArea:   maxArea,
+		log:       log,
+	}
+}
+
+// UpdateGeometry validates and stores a redrawn polygon as the feature's next
+// geometry version, then broadcasts it to map clients
+func (s *GeometryService) UpdateGeometry(ctx context.Context, adminID, featureID uint64, points []models.GeometryPoint, reason string) (*models.GeometryVersion, error) {
+	if !s.admins[adminID] {
+		return nil, ErrGeometryNotAdmin
+	}
+
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > maxGeometryEditReasonLength {
+		return nil, ErrGeometryReasonTooLong
+	}
+
+	area, err := ValidatePolygon(points, s.minArea, s.maxArea)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := s.repo.ReplaceCoordinates(ctx, featureID, points, area, adminID, reason)
+	if err != nil {
+		return nil, err
+	}
+	if version == nil {
+		return nil, ErrGeometryFeatureNotFound
+	}
+
+	// The edit is committed; a failed broadcast only delays clients until their next map load
+	if s.publisher != nil {
+		if err := s.publisher.PublishGeometryChanged(ctx, version); err != nil && s.log != nil {
+			s.log.Warn("Failed to broadcast geometry change", "feature_id", featureID, "version", version.Version, "error", err)
+		}
+	}
+
+	return version, nil
+}
+
+// ListVersions returns the geometry history of a feature, newest first
+func (s *GeometryService) ListVersions(ctx context.Context, adminID, featureID uint64) ([]*models.GeometryVersion, error) {
+	if !s.admins[adminID] {
+		return nil, ErrGeometryNotAdmin
+	}
+
+	return s.repo.ListVersions(ctx, featureID)
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"metargb/features-service/internal/models"
+)
+
+// Polygon limits applied to geometry edits
+const (
+	// maxGeometryPoints caps the vertices of an edited polygon
+	maxGeometryPoints = 500
+	// DefaultGeometryMinArea and DefaultGeometryMaxArea bound the area of an
+	// edited polygon, in coordinate units
+	DefaultGeometryMinArea = 1.0
+	DefaultGeometryMaxArea = 1000000.0
+)
+
+var (
+	ErrGeometryTooFewPoints     = errors.New("polygon needs at least 3 distinct points")
+	ErrGeometryTooManyPoints    = fmt.Errorf("polygon is limited to %d points", maxGeometryPoints)
+	ErrGeometryInvalidPoint     = errors.New("polygon coordinates must be finite numbers")
+	ErrGeometryNotClosed        = errors.New("polygon ring must end with its first point")
+	ErrGeometryDuplicatePoint   = errors.New("polygon has repeated points")
+	ErrGeometrySelfIntersecting = errors.New("polygon edges must not intersect")
+	ErrGeometryAreaOutOfBounds  = errors.New("polygon area is out of bounds")
+)
+
+// ValidatePolygon checks that points form a closed, simple ring whose area is
+// within [minArea, maxArea], and returns the area
+func ValidatePolygon(points []models.GeometryPoint, minArea, maxArea float64) (float64, error) {
+	for _, p := range points {
+		if math.IsNaN(p.X) || math.IsNaN(p.Y) || math.IsInf(p.X, 0) || math.IsInf(p.Y, 0) {
+			return 0, ErrGeometryInvalidPoint
+		}
+	}
+	if len(points) > maxGeometryPoints {
+		return 0, ErrGeometryTooManyPoints
+	}
+	if len(points) < 4 {
+		return 0, ErrGeometryTooFewPoints
+	}
+	if points[0] != points[len(points)-1] {
+		return 0, ErrGeometryNotClosed
+	}
+
+	// The vertices without the closing point
+	ring := points[:len(points)-1]
+	seen := make(map[models.GeometryPoint]bool, len(ring))
+	for _, p := range ring {
+		if seen[p] {
+			return 0, ErrGeometryDuplicatePoint
+		}
+		seen[p] = true
+	}
+
+	if ringSelfIntersects(ring) {
+		return 0, ErrGeometrySelfIntersecting
+	}
+
+	area := models.PolygonArea(ring)
+	if area < minArea || area > maxArea {
+		return area, fmt.Errorf("%w: %.2f is not between %.2f and %.2f", ErrGeometryAreaOutOfBounds, area, minArea, maxArea)
+	}
+
+	return area, nil
+}
+
+// ringSelfIntersects reports whether any two edges of the ring cross or touch,
+// other than adjacent edges meeting at their shared vertex
+func ringSelfIntersects(ring []models.GeometryPoint) bool {
+	n := len(ring)
+
+	// Adjacent edges overlap when the ring folds back on itself at a vertex
+	for k := 0; k < n; k++ {
+		prev, vertex, next := ring[(k+n-1)%n], ring[k], ring[(k+1)%n]
+		dot := (prev.X-vertex.X)*(next.X-vertex.X) + (prev.Y-vertex.Y)*(next.Y-vertex.Y)
+		if orientation(prev, vertex, next) == 0 && dot > 0 {
+			return true
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		for j := i + 2; j < n; j++ {
+			if i == 0 && j == n-1 {
+				// The last edge is adjacent to the first one
+				continue
+			}
+			if segmentsIntersect(ring[i], ring[(i+1)%n], ring[j], ring[(j+1)%n]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// orientation returns 1 for a counter-clockwise turn p->q->r, -1 for clockwise and 0 when collinear
+func orientation(p, q, r models.GeometryPoint) int {
+	v := (q.X-p.X)*(r.Y-p.Y) - (q.Y-p.Y)*(r.X-p.X)
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	}
+	return 0
+}
+
+// onSegment reports whether r lies on segment p-q, given the three points are collinear
+func onSegment(p, q, r models.GeometryPoint) bool {
+	return math.Min(p.X, q.X) <= r.X && r.X <= math.Max(p.X, q.X) &&
+		math.Min(p.Y, q.Y) <= r.Y && r.Y <= math.Max(p.Y, q.Y)
+}
+
+func segmentsIntersect(p1, p2, q1, q2 models.GeometryPoint) bool {
+	o1 := orientation(p1, p2, q1)
+	o2 := orientation(p1, p2, q2)
+	o3 := orientation(q1, q2, p1)
+	o4 := orientation(q1, q2, p2)
+
+	if o1 != o2 && o3 != o4 {
+		return true
+	}
+	return (o1 == 0 && onSegment(p1, p2, q1)) ||
+		(o2 == 0 && onSegment(p1, p2, q2)) ||
+		(o3 == 0 && onSegment(q1, q2, p1)) ||
+		(o4 == 0 && onSegment(q1, q2, p2))
+}
@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/shopspring/decimal"
+
+	"metargb/features-service/internal/models"
+)
+
+// GracePeriodRule extends a buy request's grace period when a higher
+// competing offer for the same feature arrives at most Window before it ends.
+// The grace period then ends no sooner than Extension after the offer.
+type GracePeriodRule struct {
+	Window    time.Duration
+	Extension time.Duration
+}
+
+// GracePeriodRules decide when a competing offer extends a grace period.
+// Without rules grace periods are never extended.
+type GracePeriodRules struct {
+	Rules []GracePeriodRule
+	// MaxExtensions caps the extensions of one grace period, 0 means unlimited
+	MaxExtensions int
+}
+
+// ParseGracePeriodRules parses a comma-separated list of window:extension
+// durations, e.g. "15m:15m,1h:30m"
+func ParseGracePeriodRules(value string) ([]GracePeriodRule, error) {
+	var rules []GracePeriodRule
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		window, extension, ok := strings.Cut(part, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid grace period rule %q, want window:extension", part)
+		}
+		w, err := time.ParseDuration(strings.TrimSpace(window))
+		if err != nil || w <= 0 {
+			return nil, fmt.Errorf("invalid grace period rule window %q", window)
+		}
+		e, err := time.ParseDuration(strings.TrimSpace(extension))
+		if err != nil || e <= 0 {
+			return nil, fmt.Errorf("invalid grace period rule extension %q", extension)
+		}
+		rules = append(rules, GracePeriodRule{Window: w, Extension: e})
+	}
+	return rules, nil
+}
+
+// Extend returns when a grace period ending at expiresAt and already extended
+// extensions times should end after a higher competing offer at now. The
+// rule with the smallest window still containing the remaining time applies.
+// It reports false when no rule applies or the extensions are used up.
+func (r GracePeriodRules) Extend(expiresAt, now time.Time, extensions int) (time.Time, bool) {
+	if r.MaxExtensions > 0 && extensions >= r.MaxExtensions {
+		return time.Time{}, false
+	}
+	remaining := expiresAt.Sub(now)
+	if remaining <= 0 {
+		return time.Time{}, false
+	}
+
+	rules := append([]GracePeriodRule(nil), r.Rules...)
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Window < rules[j].Window })
+	for _, rule := range rules {
+		if remaining > rule.Window {
+			continue
+		}
+		extended := now.Add(rule.Extension)
+		if !extended.After(expiresAt) {
+			return time.Time{}, false
+		}
+		return extended, true
+	}
+	return time.Time{}, false
+}
+
+// SetGracePeriodRules enables anti-sniping: a higher buy request arriving
+// shortly before a competing request's grace period ends extends it
+func (s *MarketplaceService) SetGracePeriodRules(rules GracePeriodRules) {
+	s.gracePeriodRules = rules
+}
+
+// extendCompetingGracePeriods extends the grace periods the new buy request
+// outbids and tells their sellers. It is best effort, failures are logged.
+func (s *MarketplaceService) extendCompetingGracePeriods(ctx context.Context, offer *models.BuyFeatureRequest) {
+	if len(s.gracePeriodRules.Rules) == 0 {
+		return
+	}
+
+	now := time.Now()
+	periods, err := s.buyRequestRepo.ListOpenGracePeriods(ctx, offer.FeatureID, now)
+	if err != nil {
+		s.log.Warn("Failed to list grace periods", "feature_id", offer.FeatureID, "error", err)
+		return
+	}
+	if len(periods) == 0 {
+		return
+	}
+
+	pscRate := decimal.NewFromFloat(s.getVariableRate(ctx, "psc"))
+	bid := offerValue(offer.PricePSC, offer.PriceIRR, pscRate)
+	for _, period := range periods {
+		if period.ID == offer.ID || period.BuyerID == offer.BuyerID {
+			continue
+		}
+		if !bid.GreaterThan(offerValue(period.PricePSC, period.PriceIRR, pscRate)) {
+			continue
+		}
+		expiresAt, ok := s.gracePeriodRules.Extend(period.ExpiresAt, now, period.Extensions)
+		if !ok {
+			continue
+		}
+		extended, err := s.buyRequestRepo.ExtendGracePeriod(ctx, period.ID, period.Extensions, expiresAt)
+		if err != nil {
+			s.log.Warn("Failed to extend grace period", "request_id", period.ID, "error", err)
+			continue
+		}
+		if !extended {
+			continue
+		}
+
+		s.log.Info("Grace period extended by a higher offer",
+			"request_id", period.ID,
+			"offer_id", offer.ID,
+			"expires_at", expiresAt,
+			"extensions", period.Extensions+1,
+		)
+		s.notifyGracePeriod(ctx, period.SellerID, "buy_request_grace_extended",
+			"مهلت درخواست خرید تمدید شد",
+			fmt.Sprintf("به دلیل ثبت پیشنهاد بالاتر برای ملک، مهلت درخواست خرید شماره %d تمدید شد", period.ID),
+			period.ID, period.FeatureID, expiresAt)
+	}
+}
+
+// buyRequestExpiryLease is how long a worker holds an expiry while refunding
+// it, after which another run may resume it
+const buyRequestExpiryLease = 5 * time.Minute
+
+var (
+	buyRequestExpiries = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "metargb",
+			Subsystem: "features",
+			Name:      "buy_request_expiries_total",
+			Help:      "Buy requests cancelled because their grace period ended, by outcome: refunded, or refund_failed when the refund is retried next run",
+		},
+		[]string{"outcome"},
+	)
+	buyRequestExpiryDelay = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "metargb",
+			Subsystem: "features",
+			Name:      "buy_request_expiry_delay_seconds",
+			Help:      "Time from the end of a buy request's grace period until its buyer was refunded",
+			Buckets:   []float64{1, 5, 15, 30, 60, 120, 300, 900, 3600},
+		},
+	)
+)
+
+// ExpireGracePeriods cancels up to limit pending buy requests whose grace
+// period ended at or before now, refunds their buyers through
+// commercial-service and tells both sides. Refunds an earlier run left
+// unfinished are resumed first. It returns how many buyers were refunded.
+func (s *MarketplaceService) ExpireGracePeriods(ctx context.Context, now time.Time, limit int) (int, error) {
+	settled := 0
+
+	unsettled, err := s.expiryRepo.ListUnsettled(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+	for _, expiry := range unsettled {
+		leased, err := s.expiryRepo.Lease(ctx, expiry.BuyFeatureRequestID, buyRequestExpiryLease)
+		if err != nil {
+			return settled, fmt.Errorf("failed to lease expiry: %w", err)
+		}
+		if !leased {
+			continue
+		}
+		if s.settleExpiry(ctx, expiry) {
+			settled++
+		}
+	}
+
+	periods, err := s.buyRequestRepo.ListExpiredGracePeriods(ctx, now, limit)
+	if err != nil {
+		return settled, err
+	}
+	for _, period := range periods {
+		expiry, err := s.expiryRepo.Expire(ctx, period, now, buyRequestExpiryLease)
+		if err != nil {
+			return settled, err
+		}
+		if expiry == nil {
+			continue
+		}
+		s.log.Info("Buy request grace period expired", "request_id", period.ID, "buyer_id", period.BuyerID)
+		if s.settleExpiry(ctx, expiry) {
+			settled++
+		}
+	}
+	return settled, nil
+}
+
+// settleExpiry refunds the locked assets of a leased expiry not refunded yet,
+// then completes it and notifies buyer and seller. A failed refund releases
+// the lease so the next run retries it. It reports whether the expiry settled.
+func (s *MarketplaceService) settleExpiry(ctx context.Context, expiry *models.BuyRequestExpiry) bool {
+	refunds := []struct {
+		asset    string
+		amount   decimal.Decimal
+		refunded bool
+	}{
+		{"psc", expiry.PSC, expiry.PSCRefundedAt.Valid},
+		{"irr", expiry.IRR, expiry.IRRRefundedAt.Valid},
+	}
+	for _, refund := range refunds {
+		if refund.refunded || refund.amount.IsZero() || s.commercialClient == nil {
+			continue
+		}
+		// The mark comes first so a retry never pays twice. After a crash
+		// between mark and refund the expiry stays incomplete with the asset
+		// marked, and must be refunded by hand.
+		marked, err := s.expiryRepo.MarkRefunded(ctx, expiry.BuyFeatureRequestID, refund.asset)
+		if err != nil {
+			s.failExpiry(ctx, expiry, fmt.Errorf("failed to mark %s refund: %w", refund.asset, err))
+			return false
+		}
+		if !marked {
+			continue
+		}
+		if err := s.commercialClient.AddBalance(ctx, expiry.BuyerID, refund.asset, refund.amount); err != nil {
+			if unmarkErr := s.expiryRepo.UnmarkRefunded(ctx, expiry.BuyFeatureRequestID, refund.asset); unmarkErr != nil {
+				s.log.Error("Failed to unmark refund, refund it manually",
+					"request_id", expiry.BuyFeatureRequestID, "buyer_id", expiry.BuyerID,
+					"asset", refund.asset, "amount", refund.amount, "error", unmarkErr)
+			}
+			s.failExpiry(ctx, expiry, fmt.Errorf("failed to refund %s: %w", refund.asset, err))
+			return false
+		}
+	}
+
+	if err := s.lockedAssetRepo.Delete(ctx, expiry.BuyFeatureRequestID); err != nil {
+		s.log.Error("Failed to delete locked asset", "request_id", expiry.BuyFeatureRequestID, "error", err)
+	}
+
+	completed, err := s.expiryRepo.Complete(ctx, expiry.BuyFeatureRequestID)
+	if err != nil {
+		s.failExpiry(ctx, expiry, fmt.Errorf("failed to complete expiry: %w", err))
+		return false
+	}
+	if !completed {
+		return false
+	}
+
+	buyRequestExpiries.WithLabelValues("refunded").Inc()
+	buyRequestExpiryDelay.Observe(time.Since(expiry.GracePeriodEndedAt).Seconds())
+
+	s.notifyGracePeriod(ctx, expiry.BuyerID, "buy_request_expired",
+		"مهلت درخواست خرید به پایان رسید",
+		fmt.Sprintf("مهلت درخواست خرید شماره %d به پایان رسید و مبلغ آن به کیف پول شما بازگشت", expiry.BuyFeatureRequestID),
+		expiry.BuyFeatureRequestID, expiry.FeatureID, expiry.GracePeriodEndedAt)
+	s.notifyGracePeriod(ctx, expiry.SellerID, "buy_request_expired",
+		"مهلت درخواست خرید به پایان رسید",
+		fmt.Sprintf("مهلت درخواست خرید شماره %d به پایان رسید و درخواست لغو شد", expiry.BuyFeatureRequestID),
+		expiry.BuyFeatureRequestID, expiry.FeatureID, expiry.GracePeriodEndedAt)
+	return true
+}
+
+// failExpiry logs a failed refund and releases its lease for the next run
+func (s *MarketplaceService) failExpiry(ctx context.Context, expiry *models.BuyRequestExpiry, err error) {
+	buyRequestExpiries.WithLabelValues("refund_failed").Inc()
+	s.log.Error("Failed to refund expired buy request", "request_id", expiry.BuyFeatureRequestID, "error", err)
+	if err := s.expiryRepo.Release(ctx, expiry.BuyFeatureRequestID); err != nil {
+		s.log.Warn("Failed to release expiry", "request_id", expiry.BuyFeatureRequestID, "error", err)
+	}
+}
+
+func (s *MarketplaceService) notifyGracePeriod(ctx context.Context, userID uint64, notificationType, title, message string, requestID, featureID uint64, gracePeriod time.Time) {
+	if s.notificationClient == nil {
+		return
+	}
+	data := map[string]string{
+		"buy_request_id": fmt.Sprintf("%d", requestID),
+		"feature_id":     fmt.Sprintf("%d", featureID),
+		"grace_period":   gracePeriod.Format(time.RFC3339),
+	}
+	if err := s.notificationClient.SendNotification(ctx, userID, notificationType, title, message, data); err != nil {
+		s.log.Warn("Failed to send grace period notification", "user_id", userID, "request_id", requestID, "error", err)
+	}
+}
+
+// offerValue is the IRR value of a PSC and IRR offer
+func offerValue(pricePSC, priceIRR, pscRate decimal.Decimal) decimal.Decimal {
+	return priceIRR.Add(pricePSC.Mul(pscRate))
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"metargb/shared/pkg/jobs"
+	"metargb/shared/pkg/logger"
+)
+
+// DefaultGracePeriodExpiryInterval is how often ended buy request grace periods are enforced
+const DefaultGracePeriodExpiryInterval = time.Minute
+
+// gracePeriodExpiryBatchSize caps the requests expired per query, expiry repeats until a batch comes back short
+const gracePeriodExpiryBatchSize = 500
+
+// GracePeriodExpirer expires buy requests whose grace period ended, implemented by MarketplaceService
+type GracePeriodExpirer interface {
+	ExpireGracePeriods(ctx context.Context, now time.Time, limit int) (int, error)
+}
+
+// GracePeriodExpiryWorker periodically cancels pending buy requests whose
+// grace period ended and refunds their buyers
+type GracePeriodExpiryWorker struct {
+	expirer  GracePeriodExpirer
+	interval time.Duration
+	log      *logger.Logger
+}
+
+// NewGracePeriodExpiryWorker creates a worker running every interval
+// (DefaultGracePeriodExpiryInterval if zero)
+func NewGracePeriodExpiryWorker(expirer GracePeriodExpirer, interval time.Duration, log *logger.Logger) *GracePeriodExpiryWorker {
+	if interval <= 0 {
+		interval = DefaultGracePeriodExpiryInterval
+	}
+	return &GracePeriodExpiryWorker{
+		expirer:  expirer,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Register runs the worker once every interval under runner
+func (w *GracePeriodExpiryWorker) Register(runner *jobs.Runner) {
+	runner.Add("grace-period-expiry", w.interval, func(ctx context.Context) error {
+		_, err := w.Run(ctx)
+		return err
+	})
+}
+
+// Run expires every buy request whose grace period has ended and returns how many expired
+func (w *GracePeriodExpiryWorker) Run(ctx context.Context) (int, error) {
+	now := time.Now()
+	expired := 0
+	for {
+		n, err := w.expirer.ExpireGracePeriods(ctx, now, gracePeriodExpiryBatchSize)
+		expired += n
+		if err != nil {
+			return expired, err
+		}
+		if n < gracePeriodExpiryBatchSize || ctx.Err() != nil {
+			break
+		}
+	}
+
+	if expired > 0 {
+		w.log.Info("Buy request grace periods expired", "expired", expired)
+	}
+	return expired, nil
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"metargb/shared/pkg/jobs"
+	"metargb/shared/pkg/logger"
+)
+
+// DefaultHourlyProfitInterval is how often hourly profits are accrued and auto-claimed
+const DefaultHourlyProfitInterval = time.Hour
+
+// hourlyProfitBatchSize caps the profits handled per query, accrual repeats until a batch comes back short
+const hourlyProfitBatchSize = 500
+
+// HourlyProfitWorker periodically accrues hourly profits, implementing
+// Laravel's CalculateFeatureProfit command, and claims the profits of
+// auto-claim users before their dead_line stops them accruing
+type HourlyProfitWorker struct {
+	profits  ProfitServiceInterface
+	interval time.Duration
+	log      *logger.Logger
+}
+
+// NewHourlyProfitWorker creates a worker running every interval
+// (DefaultHourlyProfitInterval if zero)
+func NewHourlyProfitWorker(profits ProfitServiceInterface, interval time.Duration, log *logger.Logger) *HourlyProfitWorker {
+	if interval <= 0 {
+		interval = DefaultHourlyProfitInterval
+	}
+	return &HourlyProfitWorker{
+		profits:  profits,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Register runs the worker once every interval under runner
+func (w *HourlyProfitWorker) Register(runner *jobs.Runner) {
+	runner.Add("hourly-profit", w.interval, func(ctx context.Context) error {
+		_, _, err := w.Run(ctx)
+		return err
+	})
+}
+
+// Run accrues all due profits, then claims auto-claim profits whose dead_line
+// falls before the next run. It returns how many profits were accrued and claimed.
+func (w *HourlyProfitWorker) Run(ctx context.Context) (int, int, error) {
+	accrued := 0
+	for {
+		n, err := w.profits.CalculateProfits(ctx, hourlyProfitBatchSize)
+		accrued += n
+		if err != nil {
+			return accrued, 0, err
+		}
+		if n < hourlyProfitBatchSize || ctx.Err() != nil {
+			break
+		}
+	}
+
+	claimed, err := w.profits.AutoClaimDueProfits(ctx, time.Now().Add(w.interval), hourlyProfitBatchSize)
+	if err != nil {
+		return accrued, claimed, err
+	}
+
+	if accrued > 0 || claimed > 0 {
+		w.log.Info("Hourly profits processed", "accrued", accrued, "claimed", claimed)
+	}
+	return accrued, claimed, nil
+}
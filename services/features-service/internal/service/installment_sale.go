@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"metargb/features-service/internal/constants"
+	"metargb/features-service/internal/models"
+	pb "metargb/shared/pb/features"
+	"metargb/shared/pkg/money"
+)
+
+var (
+	ErrFeatureReserved            = errors.New("این ملک برای خرید اقساطی رزرو شده است")
+	ErrFeatureNotInstallable      = errors.New("feature cannot be bought in installments")
+	ErrReservationNotFound        = errors.New("feature reservation not found")
+	ErrReservationSellerChanged   = errors.New("feature owner changed since it was reserved")
+	ErrReservationFeatureNotFound = errors.New("feature not found")
+)
+
+// checkNotReserved fails with ErrFeatureReserved while an installment buyer holds the feature
+func (s *MarketplaceService) checkNotReserved(ctx context.Context, featureID uint64) error {
+	reserved, err := s.reservationRepo.IsReserved(ctx, featureID)
+	if err != nil {
+		return err
+	}
+	if reserved {
+		return ErrFeatureReserved
+	}
+	return nil
+}
+
+// ReserveFeature holds a user-owned feature for an installment buyer at its
+// current price. Limited features and features still owned by RGB are sold
+// only through BuyFeature. Buyers at their ownership limit are turned away
+// before they start paying.
+func (s *MarketplaceService) ReserveFeature(ctx context.Context, featureID, buyerID uint64) (*pb.FeatureReservation, error) {
+	feature, properties, err := s.featureRepo.FindByID(ctx, featureID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrReservationFeatureNotFound, err)
+	}
+	if constants.IsLimitedFeature(properties.RGB) || feature.OwnerID == buyerID {
+		return nil, ErrFeatureNotInstallable
+	}
+	if err := s.checkNotHeldForCheckout(ctx, featureID, buyerID); err != nil {
+		return nil, err
+	}
+	if err := s.checkOwnershipLimit(ctx, buyerID, properties.Karbari); err != nil {
+		return nil, err
+	}
+
+	owner, err := s.userCache.Get(ctx, feature.OwnerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get owner: %w", err)
+	}
+	if owner.Code == constants.RGBUserCode {
+		return nil, ErrFeatureNotInstallable
+	}
+
+	pricePSC := money.ParseOrZero(properties.PricePSC)
+	priceIRR := money.ParseOrZero(properties.PriceIRR)
+	if !pricePSC.IsPositive() && !priceIRR.IsPositive() {
+		return nil, ErrFeatureNotInstallable
+	}
+
+	if err := s.checkUnderpricedRestriction(ctx, feature, properties); err != nil {
+		return nil, err
+	}
+
+	rgbUserID, err := s.getRGBUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get platform user: %w", err)
+	}
+
+	reservation := &models.FeatureReservation{
+		FeatureID: feature.ID,
+		BuyerID:   buyerID,
+		SellerID:  feature.OwnerID,
+		PricePSC:  pricePSC,
+		PriceIRR:  priceIRR,
+	}
+	created, err := s.reservationRepo.Create(ctx, reservation)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		return nil, ErrFeatureReserved
+	}
+	s.releaseAfterPurchase(ctx, feature.ID, buyerID)
+
+	s.log.Info("Feature reserved for installment purchase",
+		"feature_id", feature.ID,
+		"buyer_id", buyerID,
+		"seller_id", feature.OwnerID,
+	)
+
+	return &pb.FeatureReservation{
+		FeatureId:        feature.ID,
+		BuyerId:          buyerID,
+		SellerId:         feature.OwnerID,
+		BuyerChargePsc:   money.Float(constants.CalculateBuyerCharge(pricePSC)),
+		BuyerChargeIrr:   money.Float(constants.CalculateBuyerCharge(priceIRR)),
+		SellerPaymentPsc: money.Float(constants.CalculateSellerPayment(pricePSC)),
+		SellerPaymentIrr: money.Float(constants.CalculateSellerPayment(priceIRR)),
+		PlatformFeePsc:   money.Float(constants.CalculatePlatformFee(pricePSC)),
+		PlatformFeeIrr:   money.Float(constants.CalculatePlatformFee(priceIRR)),
+		PlatformUserId:   rgbUserID,
+	}, nil
+}
+
+// CompleteReservedPurchase hands a reserved feature to its installment buyer
+// once the plan is paid off. The seller and platform are paid by
+// commercial-service. Completing an already completed reservation returns
+// its trade again, so the caller can retry safely. The ownership limits are
+// checked again, since the buyer may have bought other features meanwhile.
+func (s *MarketplaceService) CompleteReservedPurchase(ctx context.Context, featureID, buyerID uint64) (uint64, error) {
+	reservation, err := s.reservationRepo.FindByFeatureID(ctx, featureID)
+	if err != nil {
+		return 0, err
+	}
+	if reservation == nil || reservation.BuyerID != buyerID {
+		return 0, ErrReservationNotFound
+	}
+	if reservation.TradeID != nil {
+		return *reservation.TradeID, nil
+	}
+
+	feature, properties, err := s.featureRepo.FindByID(ctx, featureID)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrReservationFeatureNotFound, err)
+	}
+	if feature.OwnerID != reservation.SellerID {
+		return 0, ErrReservationSellerChanged
+	}
+	if err := s.checkOwnershipLimit(ctx, buyerID, properties.Karbari); err != nil {
+		return 0, err
+	}
+
+	buyer, err := s.userCache.Get(ctx, buyerID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get buyer: %w", err)
+	}
+
+	tradeID, err := s.transferToBuyer(ctx, feature, properties, buyer,
+		reservation.PricePSC, reservation.PriceIRR,
+		constants.CalculatePlatformFee(reservation.PricePSC), constants.CalculatePlatformFee(reservation.PriceIRR))
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.reservationRepo.MarkCompleted(ctx, reservation.ID, tradeID); err != nil {
+		return 0, err
+	}
+
+	s.log.Info("Installment purchase completed",
+		"trade_id", tradeID,
+		"feature_id", featureID,
+		"buyer_id", buyerID,
+		"seller_id", reservation.SellerID,
+	)
+
+	return tradeID, nil
+}
+
+// ReleaseFeatureReservation frees a feature held for a defaulted or cancelled
+// installment plan. Releasing a feature that is not reserved is a no-op.
+func (s *MarketplaceService) ReleaseFeatureReservation(ctx context.Context, featureID, buyerID uint64) error {
+	released, err := s.reservationRepo.Delete(ctx, featureID, buyerID)
+	if err != nil {
+		return err
+	}
+	if released {
+		s.log.Info("Feature reservation released", "feature_id", featureID, "buyer_id", buyerID)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"metargb/features-service/internal/constants"
+	"metargb/features-service/internal/models"
+	pb "metargb/shared/pb/features"
+)
+
+var (
+	ErrLimitedQuotaReached = errors.New("you have bought as many features of this campaign as allowed")
+	ErrLimitationNotFound  = errors.New("feature is not in an active limited campaign")
+)
+
+// limitedStatuses are the statuses of features still for sale in a limited campaign
+var limitedStatuses = []string{
+	constants.MaskoniTradingLimited,
+	constants.TejariTradingLimited,
+	constants.AmoozeshiTradingLimited,
+}
+
+// GetLimitedFeatureAvailability returns the stock of the campaign of
+// featureID, or of every active campaign when featureID is 0, as userID sees
+// it: features other buyers hold for checkout are not remaining, the
+// feature userID holds is.
+func (s *MarketplaceService) GetLimitedFeatureAvailability(ctx context.Context, userID, featureID uint64) ([]*pb.LimitedFeatureAvailability, error) {
+	var limitations []*models.FeatureLimit
+	if featureID != 0 {
+		_, properties, err := s.featureRepo.FindByID(ctx, featureID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCheckoutFeatureNotFound, err)
+		}
+		limitation, err := s.featureLimitRepo.GetLimitationByPropertyID(ctx, properties.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get limitation: %w", err)
+		}
+		if limitation == nil {
+			return nil, ErrLimitationNotFound
+		}
+		limitations = append(limitations, limitation)
+	} else {
+		var err error
+		limitations, err = s.featureLimitRepo.GetActiveLimitations(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get limitations: %w", err)
+		}
+	}
+
+	result := make([]*pb.LimitedFeatureAvailability, 0, len(limitations))
+	for _, limitation := range limitations {
+		availability, err := s.limitationAvailability(ctx, limitation, userID)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, availability)
+	}
+	return result, nil
+}
+
+func (s *MarketplaceService) limitationAvailability(ctx context.Context, limitation *models.FeatureLimit, userID uint64) (*pb.LimitedFeatureAvailability, error) {
+	total, unsold, err := s.featureLimitRepo.CampaignFeatures(ctx, limitation, limitedStatuses)
+	if err != nil {
+		return nil, err
+	}
+	sold, err := s.featureLimitRepo.CountPurchases(ctx, limitation.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	availability := &pb.LimitedFeatureAvailability{
+		LimitationId:       limitation.ID,
+		Title:              limitation.Title,
+		Total:              int32(total),
+		Sold:               int32(sold),
+		Remaining:          int32(len(unsold)),
+		IndividualBuyLimit: limitation.IndividualBuyLimit,
+		IndividualBuyCount: int32(limitation.IndividualBuyCount),
+	}
+
+	// Without Redis holds cannot be seen, nor do they block purchases
+	if s.checkoutRepo != nil && len(unsold) > 0 {
+		holders, err := s.checkoutRepo.Holders(ctx, unsold)
+		if err != nil {
+			s.log.Warn("Failed to get checkout reservations", "limitation_id", limitation.ID, "error", err)
+		}
+		for featureID, holder := range holders {
+			if holder == userID {
+				availability.UserHeldFeatureId = featureID
+				continue
+			}
+			availability.Held++
+			availability.Remaining--
+		}
+	}
+
+	availability.UserRemaining = availability.Remaining
+	if userID != 0 {
+		purchased, err := s.featureLimitRepo.CountLimitedPurchases(ctx, userID, limitation.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count limited purchases: %w", err)
+		}
+		availability.UserPurchased = int32(purchased)
+		if limitation.IndividualBuyLimit {
+			availability.UserRemaining = min(availability.Remaining, max(int32(limitation.IndividualBuyCount-purchased), 0))
+		}
+	}
+	return availability, nil
+}
+
+// checkLimitedQuota fails with ErrLimitedQuotaReached when userID bought as
+// many features of the campaign as it allows
+func (s *MarketplaceService) checkLimitedQuota(ctx context.Context, limitation *models.FeatureLimit, userID uint64) error {
+	if !limitation.IndividualBuyLimit {
+		return nil
+	}
+	purchased, err := s.featureLimitRepo.CountLimitedPurchases(ctx, userID, limitation.ID)
+	if err != nil {
+		return fmt.Errorf("failed to count limited purchases: %w", err)
+	}
+	if purchased >= limitation.IndividualBuyCount {
+		return ErrLimitedQuotaReached
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"metargb/features-service/internal/constants"
+	"metargb/shared/pkg/logger"
+)
+
+// OwnershipCaps bound how many features one user may own on top of the plan
+// limit of SetOwnershipLimit. A missing key leaves that karbari or level
+// uncapped.
+type OwnershipCaps struct {
+	// PerKarbari caps the features of one karbari, e.g. "t": 10
+	PerKarbari map[string]int
+	// PerLevel caps all the features of users at a level, by level slug
+	PerLevel map[string]int
+}
+
+// Empty reports whether no cap is set
+func (c OwnershipCaps) Empty() bool {
+	return len(c.PerKarbari) == 0 && len(c.PerLevel) == 0
+}
+
+// ParseOwnershipCaps reads comma-separated key:N pairs such as
+// "m:20,t:10,a:5". N must be positive.
+func ParseOwnershipCaps(value string) (map[string]int, error) {
+	caps := make(map[string]int)
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		key, limit, ok := strings.Cut(part, ":")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid ownership cap %q, want key:N", part)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(limit))
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid ownership cap %q, N must be a positive number", part)
+		}
+		caps[key] = n
+	}
+	return caps, nil
+}
+
+// OwnershipCapError explains which cap a purchase would exceed. It matches
+// ErrOwnershipLimitReached with errors.Is.
+type OwnershipCapError struct {
+	Karbari string // Set when the karbari cap was reached
+	Level   string // Name of the user's level, set when the level cap was reached
+	Limit   int
+	Owned   int
+}
+
+func (e *OwnershipCapError) Error() string {
+	if e.Karbari != "" {
+		return fmt.Sprintf("سقف مالکیت املاک %s (%d ملک) تکمیل شده است؛ خریدار اکنون %d ملک %s دارد",
+			constants.GetKarbariTitle(e.Karbari), e.Limit, e.Owned, constants.GetKarbariTitle(e.Karbari))
+	}
+	return fmt.Sprintf("سقف مالکیت املاک برای کاربران سطح %s (%d ملک) تکمیل شده است؛ خریدار اکنون %d ملک دارد",
+		e.Level, e.Limit, e.Owned)
+}
+
+func (e *OwnershipCapError) Is(target error) bool {
+	return target == ErrOwnershipLimitReached
+}
+
+// LevelResolver returns the slug and name of a user's current level
+type LevelResolver interface {
+	GetUserLevel(ctx context.Context, userID uint64) (string, string, error)
+}
+
+// OwnedFeatureCounter counts the features a user owns by karbari
+type OwnedFeatureCounter interface {
+	CountOwnedByKarbari(ctx context.Context, ownerID uint64) (map[string]int, error)
+}
+
+// LimitsService enforces OwnershipCaps when a user is about to get a feature
+type LimitsService struct {
+	featureRepo OwnedFeatureCounter
+	levels      LevelResolver
+	caps        OwnershipCaps
+	log         *logger.Logger
+}
+
+// NewLimitsService enforces caps. levels may be nil, then level caps are
+// not applied.
+func NewLimitsService(featureRepo OwnedFeatureCounter, levels LevelResolver, caps OwnershipCaps, log *logger.Logger) *LimitsService {
+	return &LimitsService{
+		featureRepo: featureRepo,
+		levels:      levels,
+		caps:        caps,
+		log:         log,
+	}
+}
+
+// CheckPurchase fails with an *OwnershipCapError when one more feature of
+// karbari would take userID over a cap. Lookup failures are logged and do
+// not block purchases, like the plan limit.
+func (l *LimitsService) CheckPurchase(ctx context.Context, userID uint64, karbari string) error {
+	if l == nil || l.caps.Empty() {
+		return nil
+	}
+
+	counts, err := l.featureRepo.CountOwnedByKarbari(ctx, userID)
+	if err != nil {
+		l.log.Warn("Failed to count owned features", "user_id", userID, "error", err)
+		return nil
+	}
+
+	if limit, ok := l.caps.PerKarbari[karbari]; ok && counts[karbari] >= limit {
+		return &OwnershipCapError{Karbari: karbari, Limit: limit, Owned: counts[karbari]}
+	}
+
+	if len(l.caps.PerLevel) == 0 || l.levels == nil {
+		return nil
+	}
+	slug, name, err := l.levels.GetUserLevel(ctx, userID)
+	if err != nil {
+		l.log.Warn("Failed to get user level", "user_id", userID, "error", err)
+		return nil
+	}
+	limit, ok := l.caps.PerLevel[slug]
+	if !ok {
+		return nil
+	}
+	owned := 0
+	for _, count := range counts {
+		owned += count
+	}
+	if owned >= limit {
+		if name == "" {
+			name = slug
+		}
+		return &OwnershipCapError{Level: name, Limit: limit, Owned: owned}
+	}
+	return nil
+}
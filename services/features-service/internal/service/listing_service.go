@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"metargb/features-service/internal/constants"
+	"metargb/features-service/internal/models"
+)
+
+// Page sizes of the marketplace listings
+const (
+	DefaultListingsPerPage = 20
+	MaxListingsPerPage     = 100
+)
+
+var (
+	ErrListingInvalidKarbari    = errors.New("unknown karbari")
+	ErrListingInvalidCurrency   = errors.New("currency must be psc or irr")
+	ErrListingInvalidSort       = errors.New("sort must be newest, price_asc or price_desc")
+	ErrListingInvalidPriceRange = errors.New("min_price must not be greater than max_price")
+	ErrListingInvalidPerPage    = fmt.Errorf("per_page must be between 1 and %d", MaxListingsPerPage)
+)
+
+// ListingServiceInterface defines the interface for browsing features for sale
+type ListingServiceInterface interface {
+	ListForSale(ctx context.Context, filter models.ListingFilter, page, perPage int) ([]*models.Listing, bool, error)
+}
+
+// ListingRepository is implemented by repository.ListingRepository
+type ListingRepository interface {
+	ListForSale(ctx context.Context, filter models.ListingFilter) ([]*models.Listing, error)
+}
+
+type ListingService struct {
+	listingRepo ListingRepository
+}
+
+func NewListingService(listingRepo ListingRepository) ListingServiceInterface {
+	return &ListingService{
+		listingRepo: listingRepo,
+	}
+}
+
+// ListForSale returns a page of features for sale and whether another page
+// follows. Limit and Offset of filter are set from page and perPage.
+func (s *ListingService) ListForSale(ctx context.Context, filter models.ListingFilter, page, perPage int) ([]*models.Listing, bool, error) {
+	if err := normalizeListingFilter(&filter); err != nil {
+		return nil, false, err
+	}
+	if page < 1 {
+		page = 1
+	}
+	if perPage == 0 {
+		perPage = DefaultListingsPerPage
+	}
+	if perPage < 1 || perPage > MaxListingsPerPage {
+		return nil, false, ErrListingInvalidPerPage
+	}
+
+	// Fetch one extra row to tell whether there is a next page
+	filter.Limit = perPage + 1
+	filter.Offset = (page - 1) * perPage
+
+	listings, err := s.listingRepo.ListForSale(ctx, filter)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(listings) > perPage {
+		return listings[:perPage], true, nil
+	}
+	return listings, false, nil
+}
+
+// normalizeListingFilter applies the defaults and rejects unknown values
+func normalizeListingFilter(filter *models.ListingFilter) error {
+	if filter.Karbari != "" && constants.GetKarbariTitle(filter.Karbari) == "" {
+		return ErrListingInvalidKarbari
+	}
+
+	switch filter.Currency {
+	case "":
+		filter.Currency = "irr"
+	case "irr", "psc":
+	default:
+		return ErrListingInvalidCurrency
+	}
+
+	switch filter.Sort {
+	case "":
+		filter.Sort = models.ListingSortNewest
+	case models.ListingSortNewest, models.ListingSortPriceAsc, models.ListingSortPriceDesc:
+	default:
+		return ErrListingInvalidSort
+	}
+
+	if filter.MinPrice < 0 || filter.MaxPrice < 0 || (filter.MaxPrice > 0 && filter.MinPrice > filter.MaxPrice) {
+		return ErrListingInvalidPriceRange
+	}
+	return nil
+}
@@ -4,15 +4,19 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"strconv"
 	"time"
 
+	"github.com/shopspring/decimal"
+
 	"metargb/features-service/internal/client"
 	"metargb/features-service/internal/constants"
 	"metargb/features-service/internal/models"
 	"metargb/features-service/internal/repository"
 	pb "metargb/shared/pb/features"
+	"metargb/shared/pkg/auth"
 	"metargb/shared/pkg/logger"
+	"metargb/shared/pkg/money"
+	"metargb/shared/pkg/usercache"
 )
 
 // MarketplaceService implements marketplace logic with gRPC cross-service calls
@@ -23,13 +27,24 @@ type MarketplaceService struct {
 	geometryRepo       *repository.GeometryRepository
 	tradeRepo          *repository.TradeRepository
 	buyRequestRepo     *repository.BuyRequestRepository
+	expiryRepo         *repository.BuyRequestExpiryRepository
+	offerRepo          *repository.BuyRequestOfferRepository
 	sellRequestRepo    *repository.SellRequestRepository
 	lockedAssetRepo    *repository.LockedAssetRepository
 	hourlyProfitRepo   *repository.HourlyProfitRepository
 	featureLimitRepo   *repository.FeatureLimitRepository
 	systemVariableRepo *repository.SystemVariableRepository
+	reservationRepo    *repository.ReservationRepository
+	checkoutRepo       repository.CheckoutReservationRepository
+	checkoutTTL        time.Duration
+	entitlements       auth.EntitlementResolver
+	freeOwnershipLimit int
+	limits             *LimitsService
+	gracePeriodRules   GracePeriodRules
+	maxCounterOffers   int
 	commercialClient   *client.CommercialClient
 	notificationClient *client.NotificationClient
+	userCache          *usercache.Cache
 	db                 *sql.DB
 	log                *logger.Logger
 }
@@ -44,8 +59,10 @@ func NewMarketplaceService(
 	lockedAssetRepo *repository.LockedAssetRepository,
 	hourlyProfitRepo *repository.HourlyProfitRepository,
 	featureLimitRepo *repository.FeatureLimitRepository,
+	systemVariableRepo *repository.SystemVariableRepository,
 	commercialClient *client.CommercialClient,
 	notificationClient *client.NotificationClient,
+	userCache *usercache.Cache,
 	db *sql.DB,
 	log *logger.Logger,
 ) *MarketplaceService {
@@ -55,13 +72,18 @@ func NewMarketplaceService(
 		geometryRepo:       geometryRepo,
 		tradeRepo:          tradeRepo,
 		buyRequestRepo:     buyRequestRepo,
+		expiryRepo:         repository.NewBuyRequestExpiryRepository(db),
+		offerRepo:          repository.NewBuyRequestOfferRepository(db),
 		sellRequestRepo:    sellRequestRepo,
 		lockedAssetRepo:    lockedAssetRepo,
 		hourlyProfitRepo:   hourlyProfitRepo,
 		featureLimitRepo:   featureLimitRepo,
-		systemVariableRepo: repository.NewSystemVariableRepository(db),
+		systemVariableRepo: systemVariableRepo,
+		reservationRepo:    repository.NewReservationRepository(db),
+		maxCounterOffers:   DefaultMaxCounterOffers,
 		commercialClient:   commercialClient,
 		notificationClient: notificationClient,
+		userCache:          userCache,
 		db:                 db,
 		log:                log,
 	}
@@ -76,12 +98,21 @@ func (s *MarketplaceService) BuyFeature(ctx context.Context, featureID, buyerID
 		return nil, fmt.Errorf("feature not found: %w", err)
 	}
 
+	// Another buyer may be confirming this feature in the 3D client
+	if err := s.checkNotHeldForCheckout(ctx, featureID, buyerID); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkOwnershipLimit(ctx, buyerID, properties.Karbari); err != nil {
+		return nil, err
+	}
+
 	// Get owner code
-	var ownerCode string
-	err = s.db.QueryRowContext(ctx, "SELECT code FROM users WHERE id = ?", feature.OwnerID).Scan(&ownerCode)
+	owner, err := s.userCache.Get(ctx, feature.OwnerID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get owner: %w", err)
 	}
+	ownerCode := owner.Code
 
 	// Route to appropriate buy path
 	if constants.IsLimitedFeature(properties.RGB) {
@@ -97,6 +128,7 @@ func (s *MarketplaceService) BuyFeature(ctx context.Context, featureID, buyerID
 			return nil, err
 		}
 	}
+	s.releaseAfterPurchase(ctx, featureID, buyerID)
 
 	// Return updated feature (reload to get latest state)
 	// We'll need to call GetFeature service method, but for now return basic info
@@ -125,42 +157,55 @@ func (s *MarketplaceService) handleLimitedFeature(ctx context.Context, feature *
 	}
 
 	// Get buyer info
-	var buyerName string
-	var buyerDynastyID sql.NullInt64
-	var buyerBirthdate sql.NullTime
-	err = s.db.QueryRowContext(ctx,
-		"SELECT u.name, u.dynasty_id, k.birthdate FROM users u LEFT JOIN kycs k ON u.id = k.user_id WHERE u.id = ?",
-		buyerID,
-	).Scan(&buyerName, &buyerDynastyID, &buyerBirthdate)
+	buyer, err := s.userCache.Get(ctx, buyerID)
 	if err != nil {
 		return err
 	}
+	buyerName := buyer.Name
+	isUnder18 := buyer.IsUnder18(time.Now())
 
-	isUnder18 := false
-	if buyerBirthdate.Valid {
-		age := time.Since(buyerBirthdate.Time).Hours() / 24 / 365
-		isUnder18 = age < 18
+	// Claim one of the buyer's purchases from the campaign before paying, so
+	// concurrent purchases cannot exceed the individual limit
+	maxPurchases := 0
+	if limitation.IndividualBuyLimit {
+		maxPurchases = limitation.IndividualBuyCount
+	}
+	purchaseID, claimed, err := s.featureLimitRepo.ClaimLimitedPurchase(ctx, buyerID, limitation.ID, feature.ID, maxPurchases)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return ErrLimitedQuotaReached
+	}
+	releaseClaim := func() {
+		if err := s.featureLimitRepo.DeleteLimitedPurchase(ctx, purchaseID); err != nil {
+			s.log.Error("Failed to release limited purchase", "purchase_id", purchaseID, "error", err)
+		}
 	}
 
 	// Check buyer balance for color using gRPC
 	color := constants.GetColor(properties.Karbari)
+	stability := decimal.NewFromFloat(properties.Stability)
 	if limitation.PriceLimit {
-		hasBalance, err := s.commercialClient.CheckBalance(ctx, buyerID, color, properties.Stability)
+		hasBalance, err := s.commercialClient.CheckBalance(ctx, buyerID, color, stability)
 		if err != nil || !hasBalance {
+			releaseClaim()
 			return fmt.Errorf("برای خرید این ملک شما نیاز به %.2f لیتر رنگ %s دارید!",
 				properties.Stability, constants.GetColorPersian(properties.Karbari))
 		}
 	}
 
 	// Deduct buyer's color wallet via gRPC
-	if err := s.commercialClient.DeductBalance(ctx, buyerID, color, properties.Stability); err != nil {
+	if err := s.commercialClient.DeductBalance(ctx, buyerID, color, stability); err != nil {
+		releaseClaim()
 		return fmt.Errorf("failed to deduct buyer wallet: %w", err)
 	}
 
 	// Credit seller's color wallet via gRPC
-	if err := s.commercialClient.AddBalance(ctx, feature.OwnerID, color, properties.Stability); err != nil {
+	if err := s.commercialClient.AddBalance(ctx, feature.OwnerID, color, stability); err != nil {
 		// Rollback buyer deduction
-		s.commercialClient.AddBalance(ctx, buyerID, color, properties.Stability)
+		s.commercialClient.AddBalance(ctx, buyerID, color, stability)
+		releaseClaim()
 		return fmt.Errorf("failed to credit seller wallet: %w", err)
 	}
 
@@ -181,7 +226,7 @@ func (s *MarketplaceService) handleLimitedFeature(ctx context.Context, feature *
 	}
 
 	// Create trade
-	tradeID, err := s.tradeRepo.Create(ctx, feature.ID, buyerID, feature.OwnerID, 0, 0)
+	tradeID, err := s.tradeRepo.Create(ctx, feature.ID, buyerID, feature.OwnerID, decimal.Zero, decimal.Zero)
 	if err != nil {
 		return err
 	}
@@ -199,51 +244,38 @@ func (s *MarketplaceService) handleLimitedFeature(ctx context.Context, feature *
 		s.log.Error("Failed to create hourly profit", "error", err)
 	}
 
-	// Track limited feature purchase
-	if err := s.featureLimitRepo.TrackLimitedPurchase(ctx, buyerID, limitation.ID, feature.ID); err != nil {
-		s.log.Error("Failed to track limited purchase", "error", err)
-	}
-
 	return nil
 }
 
 // buyFromRGB - Path B with gRPC wallet operations
 func (s *MarketplaceService) buyFromRGB(ctx context.Context, feature *models.Feature, properties *models.FeatureProperties, buyerID uint64) error {
 	// Get buyer info
-	var buyerName string
-	var buyerBirthdate sql.NullTime
-	err := s.db.QueryRowContext(ctx,
-		"SELECT u.name, k.birthdate FROM users u LEFT JOIN kycs k ON u.id = k.user_id WHERE u.id = ?",
-		buyerID,
-	).Scan(&buyerName, &buyerBirthdate)
+	buyer, err := s.userCache.Get(ctx, buyerID)
 	if err != nil {
 		return err
 	}
-
-	isUnder18 := false
-	if buyerBirthdate.Valid {
-		age := time.Since(buyerBirthdate.Time).Hours() / 24 / 365
-		isUnder18 = age < 18
-	}
+	buyerName := buyer.Name
+	isUnder18 := buyer.IsUnder18(time.Now())
 
 	color := constants.GetColor(properties.Karbari)
+	stability := decimal.NewFromFloat(properties.Stability)
 
 	// Check buyer balance via gRPC
-	hasBalance, err := s.commercialClient.CheckBalance(ctx, buyerID, color, properties.Stability)
+	hasBalance, err := s.commercialClient.CheckBalance(ctx, buyerID, color, stability)
 	if err != nil || !hasBalance {
 		return fmt.Errorf("برای خرید این ملک شما نیاز به %.2f لیتر رنگ %s دارید!",
 			properties.Stability, constants.GetColorPersian(properties.Karbari))
 	}
 
 	// Deduct buyer's wallet via gRPC
-	if err := s.commercialClient.DeductBalance(ctx, buyerID, color, properties.Stability); err != nil {
+	if err := s.commercialClient.DeductBalance(ctx, buyerID, color, stability); err != nil {
 		return err
 	}
 
 	// Credit RGB's wallet via gRPC
-	if err := s.commercialClient.AddBalance(ctx, feature.OwnerID, color, properties.Stability); err != nil {
+	if err := s.commercialClient.AddBalance(ctx, feature.OwnerID, color, stability); err != nil {
 		// Rollback
-		s.commercialClient.AddBalance(ctx, buyerID, color, properties.Stability)
+		s.commercialClient.AddBalance(ctx, buyerID, color, stability)
 		return err
 	}
 
@@ -264,7 +296,7 @@ func (s *MarketplaceService) buyFromRGB(ctx context.Context, feature *models.Fea
 	}
 
 	// Create trade
-	_, err = s.tradeRepo.Create(ctx, feature.ID, buyerID, feature.OwnerID, 0, 0)
+	_, err = s.tradeRepo.Create(ctx, feature.ID, buyerID, feature.OwnerID, decimal.Zero, decimal.Zero)
 	if err != nil {
 		return err
 	}
@@ -290,26 +322,20 @@ func (s *MarketplaceService) buyFromUser(ctx context.Context, feature *models.Fe
 		return err
 	}
 
-	// Get buyer info
-	var buyerName string
-	var buyerBirthdate sql.NullTime
-	err := s.db.QueryRowContext(ctx,
-		"SELECT u.name, k.birthdate FROM users u LEFT JOIN kycs k ON u.id = k.user_id WHERE u.id = ?",
-		buyerID,
-	).Scan(&buyerName, &buyerBirthdate)
-	if err != nil {
+	// Features held for an installment buyer cannot be bought directly
+	if err := s.checkNotReserved(ctx, feature.ID); err != nil {
 		return err
 	}
 
-	isUnder18 := false
-	if buyerBirthdate.Valid {
-		age := time.Since(buyerBirthdate.Time).Hours() / 24 / 365
-		isUnder18 = age < 18
+	// Get buyer info
+	buyer, err := s.userCache.Get(ctx, buyerID)
+	if err != nil {
+		return err
 	}
 
 	// Parse prices
-	pricePSC := parseFloat(properties.PricePSC)
-	priceIRR := parseFloat(properties.PriceIRR)
+	pricePSC := money.ParseOrZero(properties.PricePSC)
+	priceIRR := money.ParseOrZero(properties.PriceIRR)
 
 	// Calculate amounts with fees
 	buyerChargePSC := constants.CalculateBuyerCharge(pricePSC)
@@ -351,10 +377,32 @@ func (s *MarketplaceService) buyFromUser(ctx context.Context, feature *models.Fe
 		s.commercialClient.AddBalance(ctx, rgbUserID, "irr", platformFeeIRR)
 	}
 
+	tradeID, err := s.transferToBuyer(ctx, feature, properties, buyer, pricePSC, priceIRR, platformFeePSC, platformFeeIRR)
+	if err != nil {
+		return err
+	}
+
+	s.log.Info("Feature purchased from user",
+		"trade_id", tradeID,
+		"feature_id", feature.ID,
+		"buyer_id", buyerID,
+		"seller_id", feature.OwnerID,
+	)
+
+	return nil
+}
+
+// transferToBuyer records the trade of a paid user-to-user sale and hands the
+// feature to the buyer. The seller and platform must already have been paid.
+func (s *MarketplaceService) transferToBuyer(ctx context.Context, feature *models.Feature, properties *models.FeatureProperties, buyer *usercache.Snapshot, pricePSC, priceIRR, platformFeePSC, platformFeeIRR decimal.Decimal) (uint64, error) {
+	buyerID := buyer.ID
+	buyerName := buyer.Name
+	isUnder18 := buyer.IsUnder18(time.Now())
+
 	// Create trade
 	tradeID, err := s.tradeRepo.Create(ctx, feature.ID, buyerID, feature.OwnerID, priceIRR, pricePSC)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	// Create commission via direct SQL (Commercial service doesn't have commission endpoint yet)
@@ -362,7 +410,7 @@ func (s *MarketplaceService) buyFromUser(ctx context.Context, feature *models.Fe
 
 	// Transfer ownership
 	if err := s.featureRepo.UpdateOwner(ctx, feature.ID, buyerID); err != nil {
-		return err
+		return 0, err
 	}
 
 	// Update properties
@@ -373,7 +421,7 @@ func (s *MarketplaceService) buyFromUser(ctx context.Context, feature *models.Fe
 
 	newStatus := constants.ChangeStatusToSoldAndNotPriced(properties.Karbari)
 	if err := s.propertiesRepo.UpdateStatus(ctx, feature.ID, newStatus, buyerName, "", pricingLimit); err != nil {
-		return err
+		return 0, err
 	}
 
 	// Transfer hourly profit
@@ -385,7 +433,7 @@ func (s *MarketplaceService) buyFromUser(ctx context.Context, feature *models.Fe
 	oldProfit, err := s.hourlyProfitRepo.GetByFeatureAndUser(ctx, feature.ID, feature.OwnerID)
 	if err == nil && oldProfit != nil && oldProfit.Amount > 0 {
 		// Add accumulated profit to seller's wallet via gRPC
-		if err := s.commercialClient.AddBalance(ctx, feature.OwnerID, oldProfit.Asset, oldProfit.Amount); err != nil {
+		if err := s.commercialClient.AddBalance(ctx, feature.OwnerID, oldProfit.Asset, decimal.NewFromFloat(oldProfit.Amount)); err != nil {
 			s.log.Error("Failed to transfer profit to seller", "error", err)
 		}
 	}
@@ -406,14 +454,7 @@ func (s *MarketplaceService) buyFromUser(ctx context.Context, feature *models.Fe
 		s.log.Error("Failed to update sell requests", "error", err)
 	}
 
-	s.log.Info("Feature purchased from user",
-		"trade_id", tradeID,
-		"feature_id", feature.ID,
-		"buyer_id", buyerID,
-		"seller_id", feature.OwnerID,
-	)
-
-	return nil
+	return tradeID, nil
 }
 
 // Helper methods
@@ -449,32 +490,27 @@ func (s *MarketplaceService) checkUnderpricedRestriction(ctx context.Context, fe
 }
 
 func (s *MarketplaceService) getUserVariableWithdrawProfit(ctx context.Context, userID uint64) (int, error) {
-	var days int
-	err := s.db.QueryRowContext(ctx, "SELECT withdraw_profit FROM user_variables WHERE user_id = ?", userID).Scan(&days)
+	user, err := s.userCache.Get(ctx, userID)
 	if err != nil {
 		return 10, nil
 	}
-	return days, nil
+	return user.WithdrawProfitDays, nil
 }
 
 func (s *MarketplaceService) getRGBUserID(ctx context.Context) (uint64, error) {
-	var rgbID uint64
-	err := s.db.QueryRowContext(ctx, "SELECT id FROM users WHERE code = ?", constants.RGBUserCode).Scan(&rgbID)
-	return rgbID, err
+	rgb, err := s.userCache.GetByCode(ctx, constants.RGBUserCode)
+	if err != nil {
+		return 0, err
+	}
+	return rgb.ID, nil
 }
 
-func (s *MarketplaceService) createCommission(ctx context.Context, tradeID uint64, psc, irr float64) error {
+func (s *MarketplaceService) createCommission(ctx context.Context, tradeID uint64, psc, irr decimal.Decimal) error {
 	query := "INSERT INTO comissions (trade_id, psc, irr, created_at, updated_at) VALUES (?, ?, ?, NOW(), NOW())"
 	_, err := s.db.ExecContext(ctx, query, tradeID, psc, irr)
 	return err
 }
 
-func parseFloat(s string) float64 {
-	var f float64
-	fmt.Sscanf(s, "%f", &f)
-	return f
-}
-
 // SendBuyRequest creates a buy request for a feature
 // Implements POST /api/buy-requests/store/{feature}
 func (s *MarketplaceService) SendBuyRequest(ctx context.Context, req *pb.SendBuyRequestRequest) (*models.BuyFeatureRequest, error) {
@@ -484,8 +520,8 @@ func (s *MarketplaceService) SendBuyRequest(ctx context.Context, req *pb.SendBuy
 
 	buyerID := req.BuyerId
 	featureID := req.FeatureId
-	pricePSC := parseFloat(req.PricePsc)
-	priceIRR := parseFloat(req.PriceIrr)
+	pricePSC := money.ParseOrZero(req.PricePsc)
+	priceIRR := money.ParseOrZero(req.PriceIrr)
 	note := req.Note
 
 	// Get feature and seller
@@ -502,22 +538,18 @@ func (s *MarketplaceService) SendBuyRequest(ctx context.Context, req *pb.SendBuy
 		return nil, fmt.Errorf("you already have a pending buy request for this feature")
 	}
 
+	if err := s.checkOwnershipLimit(ctx, buyerID, properties.Karbari); err != nil {
+		return nil, err
+	}
+
 	// Validate price - cannot be both zero
-	if pricePSC == 0 && priceIRR == 0 {
+	if pricePSC.IsZero() && priceIRR.IsZero() {
 		return nil, fmt.Errorf("price_psc and price_irr cannot both be zero")
 	}
 
 	// Validate price against minimum_price_percentage
-	totalRequestedPrice := priceIRR + (pricePSC * s.getVariableRate(ctx, "psc"))
-	color := constants.GetColor(properties.Karbari)
-	colorRate := s.getVariableRate(ctx, color)
-	totalFeaturePrice := properties.Stability * colorRate
-
-	floorPercentage := float64(properties.MinimumPricePercentage)
-	actualPercentage := (totalRequestedPrice / totalFeaturePrice) * 100
-
-	if actualPercentage < floorPercentage {
-		return nil, fmt.Errorf("شما مجاز به ارسال درخواست خرید به کمتر از %.0f%% قیمت ملک نمی باشید!", floorPercentage)
+	if err := s.checkPriceFloor(ctx, properties, pricePSC, priceIRR); err != nil {
+		return nil, err
 	}
 
 	// Calculate amounts with fees
@@ -575,11 +607,31 @@ func (s *MarketplaceService) SendBuyRequest(ctx context.Context, req *pb.SendBuy
 		"feature_id", featureID,
 	)
 
+	// A higher offer shortly before a competing grace period ends extends it
+	s.extendCompetingGracePeriods(ctx, buyRequest)
+
 	// TODO: Send notifications via Notifications Service
 
 	return buyRequest, nil
 }
 
+// checkPriceFloor refuses buy request prices below the feature's
+// minimum_price_percentage of its value
+func (s *MarketplaceService) checkPriceFloor(ctx context.Context, properties *models.FeatureProperties, pricePSC, priceIRR decimal.Decimal) error {
+	totalRequestedPrice := priceIRR.Add(pricePSC.Mul(decimal.NewFromFloat(s.getVariableRate(ctx, "psc"))))
+	color := constants.GetColor(properties.Karbari)
+	colorRate := s.getVariableRate(ctx, color)
+	totalFeaturePrice := decimal.NewFromFloat(properties.Stability * colorRate)
+
+	floorPercentage := float64(properties.MinimumPricePercentage)
+	floorPrice := totalFeaturePrice.Mul(decimal.NewFromInt(int64(properties.MinimumPricePercentage))).Div(decimal.NewFromInt(100))
+
+	if totalRequestedPrice.LessThan(floorPrice) {
+		return fmt.Errorf("شما مجاز به ارسال درخواست خرید به کمتر از %.0f%% قیمت ملک نمی باشید!", floorPercentage)
+	}
+	return nil
+}
+
 // AcceptBuyRequest accepts a buy request
 // Implements POST /api/buy-requests/accept/{buyFeatureRequest}
 func (s *MarketplaceService) AcceptBuyRequest(ctx context.Context, requestID, sellerID uint64) (*models.BuyFeatureRequest, error) {
@@ -610,6 +662,16 @@ func (s *MarketplaceService) AcceptBuyRequest(ctx context.Context, requestID, se
 		return nil, err
 	}
 
+	// Features held for an installment buyer cannot be sold to anyone else
+	if err := s.checkNotReserved(ctx, feature.ID); err != nil {
+		return nil, err
+	}
+
+	// The buyer may have bought other features since sending the request
+	if err := s.checkOwnershipLimit(ctx, buyRequest.BuyerID, properties.Karbari); err != nil {
+		return nil, err
+	}
+
 	// Get locked assets (not used in this function but kept for consistency)
 	_, err = s.lockedAssetRepo.GetByBuyRequestID(ctx, requestID)
 	if err != nil {
@@ -623,27 +685,27 @@ func (s *MarketplaceService) AcceptBuyRequest(ctx context.Context, requestID, se
 
 	if s.commercialClient != nil {
 		// Pay seller via gRPC (price - fee)
-		if err := s.commercialClient.AddBalance(ctx, sellerID, "psc", pscAmount-pscFee); err != nil {
+		if err := s.commercialClient.AddBalance(ctx, sellerID, "psc", pscAmount.Sub(pscFee)); err != nil {
 			return nil, err
 		}
-		if err := s.commercialClient.AddBalance(ctx, sellerID, "irr", irrAmount-irrFee); err != nil {
+		if err := s.commercialClient.AddBalance(ctx, sellerID, "irr", irrAmount.Sub(irrFee)); err != nil {
 			return nil, err
 		}
 
 		// Pay RGB platform via gRPC (fee × 2)
 		rgbUserID, err := s.getRGBUserID(ctx)
 		if err == nil {
-			s.commercialClient.AddBalance(ctx, rgbUserID, "psc", pscFee*2)
-			s.commercialClient.AddBalance(ctx, rgbUserID, "irr", irrFee*2)
+			s.commercialClient.AddBalance(ctx, rgbUserID, "psc", constants.CalculatePlatformFee(pscAmount))
+			s.commercialClient.AddBalance(ctx, rgbUserID, "irr", constants.CalculatePlatformFee(irrAmount))
 		}
 
 		// Create transactions for seller via gRPC
 		tradeID, _ := s.tradeRepo.Create(ctx, buyRequest.FeatureID, buyRequest.BuyerID, sellerID, irrAmount, pscAmount)
-		s.commercialClient.CreateTransaction(ctx, sellerID, "psc", pscAmount-pscFee, "deposit", 1, "App\\Models\\Trade", tradeID)
-		s.commercialClient.CreateTransaction(ctx, sellerID, "irr", irrAmount-irrFee, "deposit", 1, "App\\Models\\Trade", tradeID)
+		s.commercialClient.CreateTransaction(ctx, sellerID, "psc", pscAmount.Sub(pscFee), "deposit", 1, "App\\Models\\Trade", tradeID)
+		s.commercialClient.CreateTransaction(ctx, sellerID, "irr", irrAmount.Sub(irrFee), "deposit", 1, "App\\Models\\Trade", tradeID)
 
 		// Create commission
-		s.createCommission(ctx, tradeID, pscFee*2, irrFee*2)
+		s.createCommission(ctx, tradeID, constants.CalculatePlatformFee(pscAmount), constants.CalculatePlatformFee(irrAmount))
 	}
 
 	// Transfer ownership
@@ -673,7 +735,7 @@ func (s *MarketplaceService) AcceptBuyRequest(ctx context.Context, requestID, se
 	if s.commercialClient != nil {
 		oldProfit, _ := s.hourlyProfitRepo.GetByFeatureAndUser(ctx, feature.ID, sellerID)
 		if oldProfit != nil && oldProfit.Amount > 0 {
-			s.commercialClient.AddBalance(ctx, sellerID, oldProfit.Asset, oldProfit.Amount)
+			s.commercialClient.AddBalance(ctx, sellerID, oldProfit.Asset, decimal.NewFromFloat(oldProfit.Amount))
 		}
 	}
 
@@ -738,7 +800,7 @@ func (s *MarketplaceService) CreateSellRequest(ctx context.Context, req *pb.Crea
 	isUnder18 := s.isUserUnder18(ctx, sellerID)
 
 	// Parse request - either explicit prices or percentage
-	var requestedPricePSC, requestedPriceIRR float64
+	var requestedPricePSC, requestedPriceIRR decimal.Decimal
 	var pricingPercentage int
 
 	hasExplicitPrices := (req.PricePsc != "" && req.PricePsc != "0") || (req.PriceIrr != "" && req.PriceIrr != "0")
@@ -770,31 +832,36 @@ func (s *MarketplaceService) CreateSellRequest(ctx context.Context, req *pb.Crea
 		color := constants.GetColor(properties.Karbari)
 		colorRate := s.getVariableRate(ctx, color)
 		pscRate := s.getVariableRate(ctx, "psc")
+		if pscRate <= 0 {
+			return nil, fmt.Errorf("psc rate is not set")
+		}
 
-		totalPrice := properties.Stability * colorRate * float64(req.MinimumPricePercentage) / 100.0
+		totalPrice := decimal.NewFromFloat(properties.Stability * colorRate).
+			Mul(decimal.NewFromInt32(req.MinimumPricePercentage)).Div(decimal.NewFromInt(100))
 
 		// Split 50/50 between PSC and IRR
-		requestedPricePSC = (totalPrice * 0.5) / pscRate
-		requestedPriceIRR = totalPrice * 0.5
+		half := totalPrice.Div(decimal.NewFromInt(2))
+		requestedPricePSC = half.DivRound(decimal.NewFromFloat(pscRate), money.Scale)
+		requestedPriceIRR = half
 		pricingPercentage = int(req.MinimumPricePercentage)
 	} else {
 		// Validate explicit prices
 		var err error
 		if req.PricePsc != "" {
-			requestedPricePSC, err = strconv.ParseFloat(req.PricePsc, 64)
+			requestedPricePSC, err = money.Parse(req.PricePsc)
 			if err != nil {
 				return nil, fmt.Errorf("invalid price_psc: %w", err)
 			}
 		}
 		if req.PriceIrr != "" {
-			requestedPriceIRR, err = strconv.ParseFloat(req.PriceIrr, 64)
+			requestedPriceIRR, err = money.Parse(req.PriceIrr)
 			if err != nil {
 				return nil, fmt.Errorf("invalid price_irr: %w", err)
 			}
 		}
 
 		// At least one must be non-zero
-		if requestedPricePSC == 0 && requestedPriceIRR == 0 {
+		if !requestedPricePSC.IsPositive() && !requestedPriceIRR.IsPositive() {
 			return nil, fmt.Errorf("at least one of price_psc or price_irr must be greater than 0")
 		}
 
@@ -803,11 +870,11 @@ func (s *MarketplaceService) CreateSellRequest(ctx context.Context, req *pb.Crea
 		color := constants.GetColor(properties.Karbari)
 		colorRate := s.getVariableRate(ctx, color)
 
-		totalRequestedPrice := requestedPriceIRR + (requestedPricePSC * pscRate)
-		totalTradedPrice := properties.Stability * colorRate
+		totalRequestedPrice := requestedPriceIRR.Add(requestedPricePSC.Mul(decimal.NewFromFloat(pscRate)))
+		totalTradedPrice := decimal.NewFromFloat(properties.Stability * colorRate)
 
-		if totalTradedPrice > 0 {
-			pricingPercentage = int((totalRequestedPrice / totalTradedPrice) * 100)
+		if totalTradedPrice.IsPositive() {
+			pricingPercentage = int(totalRequestedPrice.Mul(decimal.NewFromInt(100)).Div(totalTradedPrice).IntPart())
 		} else {
 			pricingPercentage = 100
 		}
@@ -828,8 +895,8 @@ func (s *MarketplaceService) CreateSellRequest(ctx context.Context, req *pb.Crea
 
 	// Update feature properties: RGB status and pricing
 	newRGBStatus := constants.ChangeStatusToSoldAndPriced(properties.Karbari)
-	pricePSCStr := fmt.Sprintf("%.10f", requestedPricePSC)
-	priceIRRStr := fmt.Sprintf("%.10f", requestedPriceIRR)
+	pricePSCStr := requestedPricePSC.StringFixed(money.Scale)
+	priceIRRStr := requestedPriceIRR.StringFixed(money.Scale)
 
 	if err := s.propertiesRepo.Update(ctx, featureID, map[string]interface{}{
 		"rgb":                      newRGBStatus,
@@ -1095,38 +1162,42 @@ func (s *MarketplaceService) refundBuyRequest(ctx context.Context, requestID uin
 }
 
 func (s *MarketplaceService) getVariableRate(ctx context.Context, asset string) float64 {
-	var rate float64
-	query := "SELECT value FROM variables WHERE `key` = ?"
-	if err := s.db.QueryRowContext(ctx, query, asset).Scan(&rate); err != nil {
+	if s.commercialClient == nil {
+		return 1.0
+	}
+	rate, err := s.commercialClient.GetVariableRate(ctx, asset)
+	if err != nil {
 		return 1.0
 	}
 	return rate
 }
 
 func (s *MarketplaceService) getUserName(ctx context.Context, userID uint64) string {
-	var name string
-	s.db.QueryRowContext(ctx, "SELECT name FROM users WHERE id = ?", userID).Scan(&name)
-	return name
+	user, err := s.userCache.Get(ctx, userID)
+	if err != nil {
+		return ""
+	}
+	return user.Name
 }
 
 func (s *MarketplaceService) isUserUnder18(ctx context.Context, userID uint64) bool {
-	var birthdate sql.NullTime
-	s.db.QueryRowContext(ctx, "SELECT birthdate FROM kycs WHERE user_id = ?", userID).Scan(&birthdate)
-	if !birthdate.Valid {
+	user, err := s.userCache.Get(ctx, userID)
+	if err != nil {
 		return false
 	}
-	// Simplified age check
-	return false
+	return user.IsUnder18(time.Now())
 }
 
-// GetUserCode gets user code from database (exported for handler use)
+// GetUserCode gets user code from auth-service (exported for handler use)
 func (s *MarketplaceService) GetUserCode(ctx context.Context, userID uint64) (string, error) {
-	var code string
-	err := s.db.QueryRowContext(ctx, "SELECT code FROM users WHERE id = ?", userID).Scan(&code)
-	if err == sql.ErrNoRows {
+	user, err := s.userCache.Get(ctx, userID)
+	if err == usercache.ErrUserNotFound {
 		return "", fmt.Errorf("user not found")
 	}
-	return code, err
+	if err != nil {
+		return "", err
+	}
+	return user.Code, nil
 }
 
 // GetLatestProfilePhoto gets the latest profile photo URL for a user (exported for handler use)
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"metargb/shared/pkg/auth"
+)
+
+var ErrOwnershipLimitReached = errors.New("feature ownership limit reached")
+
+// SetOwnershipLimit caps how many features a user may own. Users own at most
+// freeLimit features unless their subscription grants a "max_features:N"
+// entitlement. A limit of 0 means unlimited, so a freeLimit of 0 only caps
+// users whose plan sets a limit.
+func (s *MarketplaceService) SetOwnershipLimit(entitlements auth.EntitlementResolver, freeLimit int) {
+	s.entitlements = entitlements
+	s.freeOwnershipLimit = freeLimit
+}
+
+// SetLimits adds the per-karbari and per-level caps of limits to the plan
+// limit. A nil limits enforces only the plan limit.
+func (s *MarketplaceService) SetLimits(limits *LimitsService) {
+	s.limits = limits
+}
+
+// checkOwnershipLimit fails when buyerID already owns as many features as
+// their plan allows, or when one more feature of karbari would exceed a cap
+// of SetLimits. Lookup failures are logged and do not block purchases.
+func (s *MarketplaceService) checkOwnershipLimit(ctx context.Context, buyerID uint64, karbari string) error {
+	if err := s.limits.CheckPurchase(ctx, buyerID, karbari); err != nil {
+		return err
+	}
+	if s.entitlements == nil {
+		return nil
+	}
+
+	entitlements, err := s.entitlements.Entitlements(ctx, buyerID)
+	if err != nil {
+		s.log.Warn("Failed to get entitlements", "user_id", buyerID, "error", err)
+		entitlements = nil
+	}
+	limit := entitlements.Limit(auth.EntitlementMaxFeatures, s.freeOwnershipLimit)
+	if limit <= 0 {
+		return nil
+	}
+
+	counts, err := s.featureRepo.CountByOwners(ctx, []uint64{buyerID})
+	if err != nil {
+		s.log.Warn("Failed to count owned features", "user_id", buyerID, "error", err)
+		return nil
+	}
+	if int(counts[buyerID]) >= limit {
+		return ErrOwnershipLimitReached
+	}
+	return nil
+}
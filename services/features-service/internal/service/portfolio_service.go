@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/features-service/internal/constants"
+	"metargb/features-service/internal/models"
+	"metargb/features-service/internal/repository"
+)
+
+const (
+	defaultPortfolioPerPage = 20
+	maxPortfolioPerPage     = 50
+)
+
+// PortfolioRates reads asset rates in rials, implemented by client.CommercialClient
+type PortfolioRates interface {
+	GetVariableRate(ctx context.Context, key string) (float64, error)
+}
+
+// PortfolioServiceInterface defines the interface for portfolio operations
+type PortfolioServiceInterface interface {
+	GetUserPortfolio(ctx context.Context, userID uint64, page, perPage int) (*models.Portfolio, error)
+}
+
+type PortfolioService struct {
+	portfolioRepo *repository.PortfolioRepository
+	rates         PortfolioRates
+}
+
+// NewPortfolioService creates the portfolio service. Without rates the
+// portfolio is returned without estimated values.
+func NewPortfolioService(portfolioRepo *repository.PortfolioRepository, rates PortfolioRates) PortfolioServiceInterface {
+	return &PortfolioService{
+		portfolioRepo: portfolioRepo,
+		rates:         rates,
+	}
+}
+
+// GetUserPortfolio returns a page of the features a user owns with their
+// profits, requests and estimated values, and totals over all of them. Rates
+// are read once per call.
+func (s *PortfolioService) GetUserPortfolio(ctx context.Context, userID uint64, page, perPage int) (*models.Portfolio, error) {
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 {
+		perPage = defaultPortfolioPerPage
+	}
+	if perPage > maxPortfolioPerPage {
+		perPage = maxPortfolioPerPage
+	}
+
+	features, err := s.portfolioRepo.ListOwned(ctx, userID, perPage, (page-1)*perPage)
+	if err != nil {
+		return nil, err
+	}
+	totals, err := s.portfolioRepo.Totals(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := make(map[string]*decimal.Decimal)
+	rateOf := func(karbari string) *decimal.Decimal {
+		color := constants.GetColor(karbari)
+		if color == "" {
+			return nil
+		}
+		if rate, ok := rates[color]; ok {
+			return rate
+		}
+		var rate *decimal.Decimal
+		if s.rates != nil {
+			if value, err := s.rates.GetVariableRate(ctx, color); err == nil {
+				d := decimal.NewFromFloat(value)
+				rate = &d
+			}
+		}
+		rates[color] = rate
+		return rate
+	}
+
+	for _, feature := range features {
+		if rate := rateOf(feature.Properties.Karbari); rate != nil {
+			feature.EstimatedValueIRR = decimal.NewFromFloat(feature.Properties.Stability).Mul(*rate).StringFixed(0)
+		}
+	}
+
+	// Features whose karbari has no color asset have no market rate and are
+	// left out of the total. Any other missing rate leaves the total unknown.
+	total := decimal.Zero
+	known := true
+	for karbari, stability := range totals.StabilityByKarbari {
+		if constants.GetColor(karbari) == "" {
+			continue
+		}
+		rate := rateOf(karbari)
+		if rate == nil {
+			known = false
+			break
+		}
+		total = total.Add(decimal.NewFromFloat(stability).Mul(*rate))
+	}
+	if known {
+		totals.EstimatedValueIRR = total.StringFixed(0)
+	}
+
+	return &models.Portfolio{
+		Features: features,
+		Totals:   totals,
+		Page:     page,
+		PerPage:  perPage,
+	}, nil
+}
@@ -3,14 +3,19 @@ package service
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
 
 	"metargb/features-service/internal/client"
 	"metargb/features-service/internal/constants"
 	"metargb/features-service/internal/models"
 	"metargb/features-service/internal/repository"
 	"metargb/shared/pkg/logger"
+	"metargb/shared/pkg/money"
+	"metargb/shared/pkg/usercache"
 )
 
 // ProfitServiceInterface defines the interface for profit service operations
@@ -19,7 +24,20 @@ type ProfitServiceInterface interface {
 	GetProfitsByApplication(ctx context.Context, userID uint64, karbari string) (float64, error)
 	TransferProfitOnSale(ctx context.Context, featureID, sellerID, buyerID uint64, withdrawProfitDays int) error
 	GetHourlyProfits(ctx context.Context, userID uint64, page, pageSize int32) ([]*models.FeatureHourlyProfit, string, string, string, error)
-	StartHourlyProfitCalculator(ctx context.Context, log *logger.Logger)
+	GetFeatureProfit(ctx context.Context, userID, featureID uint64) (*FeatureProfitStatus, error)
+	GetProfitSettings(ctx context.Context, userID uint64) (*models.ProfitSettings, int, error)
+	UpdateProfitSettings(ctx context.Context, settings *models.ProfitSettings) (*models.ProfitSettings, int, error)
+	AutoClaimDueProfits(ctx context.Context, before time.Time, limit int) (int, error)
+	CalculateProfits(ctx context.Context, limit int) (int, error)
+}
+
+// FeatureProfitStatus is the accrued profit of a feature and how fast it grows
+type FeatureProfitStatus struct {
+	Profit            *models.FeatureHourlyProfit
+	HourlyRate        float64 // Accrual per hour, 0 when not accruing
+	Accruing          bool
+	SecondsToDeadline int64
+	Compound          bool
 }
 
 // ProfitService implements profit service with gRPC cross-service calls
@@ -27,8 +45,10 @@ type ProfitService struct {
 	profitRepo         *repository.HourlyProfitRepository
 	featureRepo        *repository.FeatureRepository
 	propertiesRepo     *repository.PropertiesRepository
+	settingsRepo       *repository.ProfitSettingsRepository
 	commercialClient   *client.CommercialClient
 	notificationClient *client.NotificationClient
+	userCache          *usercache.Cache
 	db                 *sql.DB
 	log                *logger.Logger
 }
@@ -37,8 +57,10 @@ func NewProfitService(
 	profitRepo *repository.HourlyProfitRepository,
 	featureRepo *repository.FeatureRepository,
 	propertiesRepo *repository.PropertiesRepository,
+	settingsRepo *repository.ProfitSettingsRepository,
 	commercialClient *client.CommercialClient,
 	notificationClient *client.NotificationClient,
+	userCache *usercache.Cache,
 	db *sql.DB,
 	log *logger.Logger,
 ) ProfitServiceInterface {
@@ -46,8 +68,10 @@ func NewProfitService(
 		profitRepo:         profitRepo,
 		featureRepo:        featureRepo,
 		propertiesRepo:     propertiesRepo,
+		settingsRepo:       settingsRepo,
 		commercialClient:   commercialClient,
 		notificationClient: notificationClient,
+		userCache:          userCache,
 		db:                 db,
 		log:                log,
 	}
@@ -67,49 +91,23 @@ func (s *ProfitService) GetSingleProfit(ctx context.Context, profitID, userID ui
 		return nil, fmt.Errorf("unauthorized")
 	}
 
-	// Add amount to user wallet via gRPC
-	if profit.Amount > 0 && s.commercialClient != nil {
-		if err := s.commercialClient.AddBalance(ctx, userID, profit.Asset, profit.Amount); err != nil {
-			return nil, fmt.Errorf("failed to update wallet: %w", err)
-		}
+	// Get user's withdraw_profit days
+	withdrawProfitDays := s.withdrawProfitDays(ctx, userID)
+
+	// Reset profit and update deadline, then add the amount to user wallet via gRPC
+	amount, err := s.claim(ctx, profit, withdrawProfitDays)
+	if err != nil {
+		return nil, err
+	}
 
+	if amount > 0 {
 		s.log.Info("Profit withdrawn",
 			"profit_id", profitID,
 			"user_id", userID,
 			"asset", profit.Asset,
-			"amount", profit.Amount,
+			"amount", amount,
 		)
-
-		// Send notification if notification client is available
-		if s.notificationClient != nil {
-			data := map[string]string{
-				"asset":  profit.Asset,
-				"amount": fmt.Sprintf("%.6f", profit.Amount),
-			}
-			if profit.PropertiesID != "" {
-				data["id"] = profit.PropertiesID
-			}
-
-			// Get color name for notification
-			colorName := constants.GetColorPersian(profit.Karbari)
-			title := fmt.Sprintf("سود ساعتی %s", colorName)
-			message := fmt.Sprintf("مبلغ %.6f %s به کیف پول شما اضافه شد", profit.Amount, colorName)
-
-			if err := s.notificationClient.SendNotification(ctx, userID, "FeatureHourlyProfitDeposit", title, message, data); err != nil {
-				s.log.Warn("Failed to send notification", "error", err)
-			}
-		}
-	}
-
-	// Get user's withdraw_profit days
-	withdrawProfitDays, err := s.getUserVariableWithdrawProfit(ctx, userID)
-	if err != nil || withdrawProfitDays == 0 {
-		withdrawProfitDays = 10
-	}
-
-	// Reset profit and update deadline
-	if err := s.profitRepo.ResetProfitAndUpdateDeadline(ctx, profitID, withdrawProfitDays); err != nil {
-		return nil, fmt.Errorf("failed to reset profit: %w", err)
+		s.notifyProfitDeposit(ctx, profit, amount)
 	}
 
 	// Re-fetch the updated profit record
@@ -133,10 +131,7 @@ func (s *ProfitService) GetProfitsByApplication(ctx context.Context, userID uint
 	asset := constants.GetColor(karbari)
 
 	// Get user's withdraw_profit days
-	withdrawProfitDays, err := s.getUserVariableWithdrawProfit(ctx, userID)
-	if err != nil || withdrawProfitDays == 0 {
-		withdrawProfitDays = 10
-	}
+	withdrawProfitDays := s.withdrawProfitDays(ctx, userID)
 
 	// Get all profits for this user and karbari
 	profits, err := s.profitRepo.GetAllByUserAndKarbari(ctx, userID, asset)
@@ -156,20 +151,12 @@ func (s *ProfitService) GetProfitsByApplication(ctx context.Context, userID uint
 
 		chunk := profits[i:end]
 		for _, profit := range chunk {
-			totalAmount += profit.Amount
-
-			// Add to wallet via gRPC
-			if profit.Amount > 0 && s.commercialClient != nil {
-				if err := s.commercialClient.AddBalance(ctx, userID, profit.Asset, profit.Amount); err != nil {
-					s.log.Error("Failed to add profit to wallet", "profit_id", profit.ID, "error", err)
-					continue
-				}
-			}
-
-			// Reset profit
-			if err := s.profitRepo.ResetProfitAndUpdateDeadline(ctx, profit.ID, withdrawProfitDays); err != nil {
-				s.log.Error("Failed to reset profit", "profit_id", profit.ID, "error", err)
+			amount, err := s.claim(ctx, profit, withdrawProfitDays)
+			if err != nil {
+				s.log.Error("Failed to withdraw profit", "profit_id", profit.ID, "error", err)
+				continue
 			}
+			totalAmount += amount
 		}
 	}
 
@@ -205,6 +192,146 @@ func (s *ProfitService) GetProfitsByApplication(ctx context.Context, userID uint
 	return totalAmount, nil
 }
 
+// GetFeatureProfit returns the accrued profit of a user's feature and how fast it grows
+func (s *ProfitService) GetFeatureProfit(ctx context.Context, userID, featureID uint64) (*FeatureProfitStatus, error) {
+	profit, err := s.profitRepo.GetByFeatureAndUser(ctx, featureID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("profit not found")
+		}
+		return nil, fmt.Errorf("failed to get profit: %w", err)
+	}
+
+	_, properties, err := s.featureRepo.FindByID(ctx, featureID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feature: %w", err)
+	}
+
+	settings, err := s.settingsRepo.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FeatureProfitStatus{
+		Profit:   profit,
+		Compound: settings.Compound,
+	}
+	if remaining := time.Until(profit.Deadline); remaining > 0 {
+		result.SecondsToDeadline = int64(remaining.Seconds())
+	}
+	result.Accruing = profit.IsActive && result.SecondsToDeadline > 0
+
+	// The calculator adds HourlyProfitCalculationRate of the base once per interval
+	base := properties.Stability
+	if settings.Compound {
+		base += profit.Amount
+	}
+	if result.Accruing {
+		result.HourlyRate = base * constants.HourlyProfitCalculationRate / constants.HourlyProfitCalculationIntervalHours
+	}
+
+	return result, nil
+}
+
+// GetProfitSettings returns the user's profit settings and claim window
+func (s *ProfitService) GetProfitSettings(ctx context.Context, userID uint64) (*models.ProfitSettings, int, error) {
+	settings, err := s.settingsRepo.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return settings, s.withdrawProfitDays(ctx, userID), nil
+}
+
+// UpdateProfitSettings replaces the user's profit settings
+func (s *ProfitService) UpdateProfitSettings(ctx context.Context, settings *models.ProfitSettings) (*models.ProfitSettings, int, error) {
+	if err := s.settingsRepo.SaveSettings(ctx, settings); err != nil {
+		return nil, 0, err
+	}
+	return settings, s.withdrawProfitDays(ctx, settings.UserID), nil
+}
+
+// AutoClaimDueProfits claims up to limit profits of auto-claim users whose
+// dead_line is before the given time and returns how many were claimed
+func (s *ProfitService) AutoClaimDueProfits(ctx context.Context, before time.Time, limit int) (int, error) {
+	profits, err := s.profitRepo.ListAutoClaimDue(ctx, before, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list due profits: %w", err)
+	}
+
+	claimed := 0
+	for _, profit := range profits {
+		amount, err := s.claim(ctx, profit, s.withdrawProfitDays(ctx, profit.UserID))
+		if err != nil {
+			s.log.Warn("Failed to auto-claim profit", "profit_id", profit.ID, "user_id", profit.UserID, "error", err)
+			continue
+		}
+		claimed++
+		if amount > 0 {
+			s.notifyProfitDeposit(ctx, profit, amount)
+		}
+	}
+
+	return claimed, nil
+}
+
+// CalculateProfits accrues up to limit profits and returns how many were incremented
+func (s *ProfitService) CalculateProfits(ctx context.Context, limit int) (int, error) {
+	return s.profitRepo.CalculateAndUpdateProfits(ctx, limit)
+}
+
+// claim resets a profit and adds its accrued amount to the owner's wallet. The
+// amount is put back if the wallet cannot be credited.
+func (s *ProfitService) claim(ctx context.Context, profit *models.FeatureHourlyProfit, withdrawProfitDays int) (float64, error) {
+	amount, err := s.profitRepo.ClaimAmount(ctx, profit.ID, withdrawProfitDays)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reset profit: %w", err)
+	}
+
+	if amount > 0 && s.commercialClient != nil {
+		if err := s.commercialClient.AddBalance(ctx, profit.UserID, profit.Asset, decimal.NewFromFloat(amount)); err != nil {
+			if restoreErr := s.profitRepo.RestoreAmount(ctx, profit.ID, amount); restoreErr != nil {
+				s.log.Error("Failed to restore unpaid profit", "profit_id", profit.ID, "amount", amount, "error", restoreErr)
+			}
+			return 0, fmt.Errorf("failed to update wallet: %w", err)
+		}
+		s.recordPayout(ctx, profit, amount)
+	}
+
+	return amount, nil
+}
+
+// recordPayout logs a paid profit for the feature history. The wallet is
+// already credited, so a failure is only logged.
+func (s *ProfitService) recordPayout(ctx context.Context, profit *models.FeatureHourlyProfit, amount float64) {
+	if err := s.profitRepo.RecordPayout(ctx, profit, amount); err != nil {
+		s.log.Warn("Failed to record profit payout", "profit_id", profit.ID, "user_id", profit.UserID, "amount", amount, "error", err)
+	}
+}
+
+// notifyProfitDeposit tells the owner a profit was added to their wallet
+func (s *ProfitService) notifyProfitDeposit(ctx context.Context, profit *models.FeatureHourlyProfit, amount float64) {
+	if s.notificationClient == nil {
+		return
+	}
+
+	data := map[string]string{
+		"asset":  profit.Asset,
+		"amount": fmt.Sprintf("%.6f", amount),
+	}
+	if profit.PropertiesID != "" {
+		data["id"] = profit.PropertiesID
+	}
+
+	// Get color name for notification
+	colorName := constants.GetColorPersian(profit.Karbari)
+	title := fmt.Sprintf("سود ساعتی %s", colorName)
+	message := fmt.Sprintf("مبلغ %.6f %s به کیف پول شما اضافه شد", amount, colorName)
+
+	if err := s.notificationClient.SendNotification(ctx, profit.UserID, "FeatureHourlyProfitDeposit", title, message, data); err != nil {
+		s.log.Warn("Failed to send notification", "error", err)
+	}
+}
+
 // TransferProfitOnSale handles profit transfer when feature is sold
 // Uses gRPC to add accumulated profit to seller's wallet
 func (s *ProfitService) TransferProfitOnSale(ctx context.Context, featureID, sellerID, buyerID uint64, withdrawProfitDays int) error {
@@ -212,10 +339,11 @@ func (s *ProfitService) TransferProfitOnSale(ctx context.Context, featureID, sel
 	oldProfit, err := s.profitRepo.GetByFeatureAndUser(ctx, featureID, sellerID)
 	if err == nil && oldProfit != nil && oldProfit.Amount > 0 {
 		// Add accumulated profit to seller's wallet via gRPC
-		if err := s.commercialClient.AddBalance(ctx, sellerID, oldProfit.Asset, oldProfit.Amount); err != nil {
+		if err := s.commercialClient.AddBalance(ctx, sellerID, oldProfit.Asset, decimal.NewFromFloat(oldProfit.Amount)); err != nil {
 			s.log.Error("Failed to transfer profit to seller", "error", err)
 			return err
 		}
+		s.recordPayout(ctx, oldProfit, oldProfit.Amount)
 
 		s.log.Info("Profit transferred on sale",
 			"feature_id", featureID,
@@ -266,26 +394,23 @@ func (s *ProfitService) GetHourlyProfits(ctx context.Context, userID uint64, pag
 
 // formatTotal formats a total amount string to 2 decimal places
 func formatTotal(totalStr string) string {
-	total, err := strconv.ParseFloat(totalStr, 64)
-	if err != nil {
-		return "0.00"
-	}
-	return fmt.Sprintf("%.2f", total)
-}
-
-// StartHourlyProfitCalculator runs the background job to calculate hourly profits
-func (s *ProfitService) StartHourlyProfitCalculator(ctx context.Context, log *logger.Logger) {
-	// TODO: Implement background job similar to Laravel's CalculateFeatureProfit command
-	// This should run periodically and call profitRepo.CalculateAndUpdateProfits
-	log.Info("Hourly profit calculator started (not yet implemented)")
+	return money.ParseOrZero(totalStr).StringFixed(2)
 }
 
 // Utility methods
 func (s *ProfitService) getUserVariableWithdrawProfit(ctx context.Context, userID uint64) (int, error) {
-	var days int
-	err := s.db.QueryRowContext(ctx, "SELECT withdraw_profit FROM user_variables WHERE user_id = ?", userID).Scan(&days)
+	user, err := s.userCache.Get(ctx, userID)
 	if err != nil {
 		return 10, nil
 	}
-	return days, nil
+	return user.WithdrawProfitDays, nil
+}
+
+// withdrawProfitDays returns the user's withdraw_profit days, 10 if unset
+func (s *ProfitService) withdrawProfitDays(ctx context.Context, userID uint64) int {
+	days, err := s.getUserVariableWithdrawProfit(ctx, userID)
+	if err != nil || days == 0 {
+		return 10
+	}
+	return days
 }
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"metargb/features-service/internal/models"
+)
+
+// Limits of saved searches
+const (
+	maxSavedSearches       = 20
+	maxSavedSearchNameSize = 100
+)
+
+var (
+	ErrSavedSearchNotFound      = errors.New("saved search not found")
+	ErrSavedSearchLimit         = fmt.Errorf("you can save at most %d searches", maxSavedSearches)
+	ErrSavedSearchEmpty         = errors.New("at least one of region, karbari, min_price or max_price is required")
+	ErrSavedSearchInvalidRegion = errors.New("region must be a positive integer")
+	ErrSavedSearchNameTooLong   = fmt.Errorf("name may not be greater than %d characters", maxSavedSearchNameSize)
+)
+
+// SavedSearchServiceInterface defines the interface for saved search operations
+type SavedSearchServiceInterface interface {
+	CreateSavedSearch(ctx context.Context, search *models.SavedSearch) (*models.SavedSearch, error)
+	UpdateSavedSearch(ctx context.Context, search *models.SavedSearch) (*models.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, userID, id uint64) error
+	ListSavedSearches(ctx context.Context, userID uint64) ([]*models.SavedSearch, error)
+}
+
+// SavedSearchRepository is implemented by repository.SavedSearchRepository
+type SavedSearchRepository interface {
+	Create(ctx context.Context, search *models.SavedSearch) (uint64, error)
+	Update(ctx context.Context, search *models.SavedSearch) error
+	Delete(ctx context.Context, userID, id uint64) (bool, error)
+	Find(ctx context.Context, userID, id uint64) (*models.SavedSearch, error)
+	ListByUser(ctx context.Context, userID uint64) ([]*models.SavedSearch, error)
+	CountByUser(ctx context.Context, userID uint64) (int, error)
+}
+
+type SavedSearchService struct {
+	savedSearchRepo SavedSearchRepository
+}
+
+func NewSavedSearchService(savedSearchRepo SavedSearchRepository) SavedSearchServiceInterface {
+	return &SavedSearchService{
+		savedSearchRepo: savedSearchRepo,
+	}
+}
+
+// CreateSavedSearch validates and saves the criteria of search for search.UserID
+func (s *SavedSearchService) CreateSavedSearch(ctx context.Context, search *models.SavedSearch) (*models.SavedSearch, error) {
+	if err := normalizeSavedSearch(search); err != nil {
+		return nil, err
+	}
+
+	count, err := s.savedSearchRepo.CountByUser(ctx, search.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxSavedSearches {
+		return nil, ErrSavedSearchLimit
+	}
+
+	id, err := s.savedSearchRepo.Create(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+	return s.find(ctx, search.UserID, id)
+}
+
+// UpdateSavedSearch replaces the criteria of a user's saved search. Listings
+// created since the last check are matched against the new criteria.
+func (s *SavedSearchService) UpdateSavedSearch(ctx context.Context, search *models.SavedSearch) (*models.SavedSearch, error) {
+	if err := normalizeSavedSearch(search); err != nil {
+		return nil, err
+	}
+	if _, err := s.find(ctx, search.UserID, search.ID); err != nil {
+		return nil, err
+	}
+
+	if err := s.savedSearchRepo.Update(ctx, search); err != nil {
+		return nil, err
+	}
+	return s.find(ctx, search.UserID, search.ID)
+}
+
+func (s *SavedSearchService) DeleteSavedSearch(ctx context.Context, userID, id uint64) error {
+	deleted, err := s.savedSearchRepo.Delete(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrSavedSearchNotFound
+	}
+	return nil
+}
+
+func (s *SavedSearchService) ListSavedSearches(ctx context.Context, userID uint64) ([]*models.SavedSearch, error) {
+	return s.savedSearchRepo.ListByUser(ctx, userID)
+}
+
+func (s *SavedSearchService) find(ctx context.Context, userID, id uint64) (*models.SavedSearch, error) {
+	search, err := s.savedSearchRepo.Find(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if search == nil {
+		return nil, ErrSavedSearchNotFound
+	}
+	return search, nil
+}
+
+// normalizeSavedSearch applies the marketplace listing rules to the criteria
+// of search, so a saved search matches what the same listing query returns
+func normalizeSavedSearch(search *models.SavedSearch) error {
+	search.Name = strings.TrimSpace(search.Name)
+	if utf8.RuneCountInString(search.Name) > maxSavedSearchNameSize {
+		return ErrSavedSearchNameTooLong
+	}
+	if search.Region < 0 {
+		return ErrSavedSearchInvalidRegion
+	}
+
+	filter := models.ListingFilter{
+		Region:   search.Region,
+		Karbari:  search.Karbari,
+		Currency: search.Currency,
+		MinPrice: search.MinPrice,
+		MaxPrice: search.MaxPrice,
+	}
+	if err := normalizeListingFilter(&filter); err != nil {
+		return err
+	}
+	search.Currency = filter.Currency
+
+	if search.Region == 0 && search.Karbari == "" && search.MinPrice == 0 && search.MaxPrice == 0 {
+		return ErrSavedSearchEmpty
+	}
+	return nil
+}
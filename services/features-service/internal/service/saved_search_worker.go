@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"metargb/features-service/internal/models"
+	"metargb/shared/pkg/jobs"
+	"metargb/shared/pkg/logger"
+)
+
+// DefaultSavedSearchInterval is how often new listings are matched against saved searches
+const DefaultSavedSearchInterval = time.Minute
+
+// savedSearchListingBatchSize caps the listings handled per run, the rest are picked up next run
+const savedSearchListingBatchSize = 200
+
+// SavedSearchMatchRepository is the part of the saved search repository the worker uses
+type SavedSearchMatchRepository interface {
+	MinLastSellRequestID(ctx context.Context) (uint64, bool, error)
+	ListBefore(ctx context.Context, sellRequestID uint64) ([]*models.SavedSearch, error)
+	UpdateLastSellRequestID(ctx context.Context, id, sellRequestID uint64) error
+}
+
+// SavedSearchListingRepository is the part of the listing repository the worker uses
+type SavedSearchListingRepository interface {
+	ListCreatedAfter(ctx context.Context, afterID uint64, limit int) ([]*models.Listing, error)
+}
+
+// SavedSearchNotifier delivers saved search matches, implemented by client.NotificationClient
+type SavedSearchNotifier interface {
+	SendNotification(ctx context.Context, userID uint64, notificationType, title, message string, data map[string]string) error
+}
+
+// SavedSearchWorker periodically matches listings created since each saved
+// search was last checked and notifies the owners of matching searches
+type SavedSearchWorker struct {
+	searches SavedSearchMatchRepository
+	listings SavedSearchListingRepository
+	notifier SavedSearchNotifier
+	interval time.Duration
+	log      *logger.Logger
+}
+
+// NewSavedSearchWorker creates a worker running every interval
+// (DefaultSavedSearchInterval if zero). notifier may be nil, in which case
+// the searches only advance past new listings.
+func NewSavedSearchWorker(searches SavedSearchMatchRepository, listings SavedSearchListingRepository, notifier SavedSearchNotifier, interval time.Duration, log *logger.Logger) *SavedSearchWorker {
+	if interval <= 0 {
+		interval = DefaultSavedSearchInterval
+	}
+	return &SavedSearchWorker{
+		searches: searches,
+		listings: listings,
+		notifier: notifier,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Register runs the worker once every interval under runner
+func (w *SavedSearchWorker) Register(runner *jobs.Runner) {
+	runner.Add("saved-search-alerts", w.interval, func(ctx context.Context) error {
+		_, err := w.Run(ctx)
+		return err
+	})
+}
+
+// Run notifies the owners of saved searches matching new listings and returns
+// how many notifications were sent
+func (w *SavedSearchWorker) Run(ctx context.Context) (int, error) {
+	cursor, ok, err := w.searches.MinLastSellRequestID(ctx)
+	if err != nil || !ok {
+		return 0, err
+	}
+
+	listings, err := w.listings.ListCreatedAfter(ctx, cursor, savedSearchListingBatchSize)
+	if err != nil || len(listings) == 0 {
+		return 0, err
+	}
+	last := listings[len(listings)-1].SellRequestID
+
+	searches, err := w.searches.ListBefore(ctx, last)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	// A listing matching several searches of a user is notified once
+	notified := make(map[[2]uint64]bool)
+	for _, search := range searches {
+		for _, listing := range savedSearchMatches(search, listings) {
+			key := [2]uint64{search.UserID, listing.SellRequestID}
+			if w.notifier == nil || notified[key] {
+				continue
+			}
+			notified[key] = true
+
+			title, message := savedSearchMatchText(search, listing)
+			data := map[string]string{
+				"saved_search_id": fmt.Sprintf("%d", search.ID),
+				"sell_request_id": fmt.Sprintf("%d", listing.SellRequestID),
+				"feature_id":      fmt.Sprintf("%d", listing.FeatureID),
+				"id":              listing.PropertiesID,
+			}
+			// Notifications are best effort, a failed delivery is not retried
+			if err := w.notifier.SendNotification(ctx, search.UserID, "saved_search_match", title, message, data); err != nil {
+				w.log.Warn("Failed to send saved search match", "error", err, "user_id", search.UserID, "sell_request_id", listing.SellRequestID)
+				continue
+			}
+			sent++
+		}
+
+		if err := w.searches.UpdateLastSellRequestID(ctx, search.ID, last); err != nil {
+			return sent, err
+		}
+	}
+
+	return sent, nil
+}
+
+// savedSearchMatches returns the listings created after the search's cursor
+// that match it. The owner's own listings are never reported.
+func savedSearchMatches(search *models.SavedSearch, listings []*models.Listing) []*models.Listing {
+	var matches []*models.Listing
+	for _, listing := range listings {
+		if listing.SellRequestID <= search.LastSellRequestID || listing.SellerID == search.UserID {
+			continue
+		}
+		if search.Matches(listing) {
+			matches = append(matches, listing)
+		}
+	}
+	return matches
+}
+
+func savedSearchMatchText(search *models.SavedSearch, listing *models.Listing) (string, string) {
+	if search.Name != "" {
+		return "ملک جدید مطابق جستجوی ذخیره شده",
+			fmt.Sprintf("ملک %s مطابق جستجوی «%s» برای فروش گذاشته شد", listing.PropertiesID, search.Name)
+	}
+	return "ملک جدید مطابق جستجوی ذخیره شده",
+		fmt.Sprintf("ملک %s مطابق جستجوی ذخیره شده شما برای فروش گذاشته شد", listing.PropertiesID)
+}
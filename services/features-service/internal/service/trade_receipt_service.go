@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"metargb/features-service/internal/models"
+	"metargb/features-service/internal/repository"
+	"metargb/shared/pkg/usercache"
+)
+
+var (
+	ErrTradeReceiptNotFound       = errors.New("no trade receipt matches this code")
+	ErrTradeReceiptInvalidCode    = errors.New("invalid verification code")
+	ErrTradeReceiptNotParticipant = errors.New("only the buyer and the seller can get the receipt of a trade")
+)
+
+// TradeReceiptView is a receipt with the citizen codes of the buyer and seller
+type TradeReceiptView struct {
+	*models.TradeReceipt
+	BuyerCode  string
+	SellerCode string
+}
+
+// TradeReceiptServiceInterface defines the interface for trade receipts
+type TradeReceiptServiceInterface interface {
+	GetReceipt(ctx context.Context, userID, tradeID uint64) (*TradeReceiptView, error)
+	VerifyReceipt(ctx context.Context, code string) (*TradeReceiptView, error)
+}
+
+type TradeReceiptService struct {
+	receiptRepo *repository.TradeReceiptRepository
+	userCache   *usercache.Cache
+}
+
+func NewTradeReceiptService(receiptRepo *repository.TradeReceiptRepository, userCache *usercache.Cache) TradeReceiptServiceInterface {
+	return &TradeReceiptService{
+		receiptRepo: receiptRepo,
+		userCache:   userCache,
+	}
+}
+
+// GetReceipt returns the receipt of a trade to its buyer or seller, issuing
+// it first for trades made before receipts existed
+func (s *TradeReceiptService) GetReceipt(ctx context.Context, userID, tradeID uint64) (*TradeReceiptView, error) {
+	receipt, err := s.receiptRepo.EnsureForTrade(ctx, tradeID)
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		return nil, ErrTradeNotFound
+	}
+	if receipt.BuyerID != userID && receipt.SellerID != userID {
+		return nil, ErrTradeReceiptNotParticipant
+	}
+	return s.view(ctx, receipt), nil
+}
+
+// VerifyReceipt returns the receipt with a verification code
+func (s *TradeReceiptService) VerifyReceipt(ctx context.Context, code string) (*TradeReceiptView, error) {
+	code, ok := models.NormalizeReceiptCode(code)
+	if !ok {
+		return nil, ErrTradeReceiptInvalidCode
+	}
+
+	receipt, err := s.receiptRepo.FindByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		return nil, ErrTradeReceiptNotFound
+	}
+	return s.view(ctx, receipt), nil
+}
+
+func (s *TradeReceiptService) view(ctx context.Context, receipt *models.TradeReceipt) *TradeReceiptView {
+	view := &TradeReceiptView{TradeReceipt: receipt}
+	if buyer, err := s.userCache.Get(ctx, receipt.BuyerID); err == nil {
+		view.BuyerCode = buyer.Code
+	}
+	if seller, err := s.userCache.Get(ctx, receipt.SellerID); err == nil {
+		view.SellerCode = seller.Code
+	}
+	return view
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"metargb/features-service/internal/client"
+	"metargb/features-service/internal/constants"
+	"metargb/features-service/internal/models"
+	"metargb/features-service/internal/repository"
+	"metargb/shared/pkg/usercache"
+)
+
+var (
+	ErrTradeNotFound          = errors.New("trade not found")
+	ErrTradeWalletUnavailable = errors.New("wallet operations are unavailable")
+	ErrTradeFundsNotFrozen    = errors.New("trade proceeds could not be frozen")
+	ErrTradeFeatureResold     = errors.New("feature is no longer owned by the buyer")
+	ErrTradeRefundFailed      = errors.New("trade proceeds could not be recovered from the seller")
+)
+
+// TradeServiceInterface defines the interface for trade operations used by dispute handling
+type TradeServiceInterface interface {
+	GetTrade(ctx context.Context, tradeID uint64) (*models.Trade, *models.FeatureProperties, error)
+	FreezeTradeFunds(ctx context.Context, tradeID uint64) error
+	ReleaseTradeFunds(ctx context.Context, tradeID uint64) error
+	RefundTrade(ctx context.Context, tradeID uint64, fundsFrozen bool) error
+}
+
+type TradeService struct {
+	tradeRepo        *repository.TradeRepository
+	receiptRepo      *repository.TradeReceiptRepository
+	featureRepo      *repository.FeatureRepository
+	propertiesRepo   *repository.PropertiesRepository
+	commercialClient *client.CommercialClient
+	userCache        *usercache.Cache
+}
+
+func NewTradeService(
+	tradeRepo *repository.TradeRepository,
+	receiptRepo *repository.TradeReceiptRepository,
+	featureRepo *repository.FeatureRepository,
+	propertiesRepo *repository.PropertiesRepository,
+	commercialClient *client.CommercialClient,
+	userCache *usercache.Cache,
+) TradeServiceInterface {
+	return &TradeService{
+		tradeRepo:        tradeRepo,
+		receiptRepo:      receiptRepo,
+		featureRepo:      featureRepo,
+		propertiesRepo:   propertiesRepo,
+		commercialClient: commercialClient,
+		userCache:        userCache,
+	}
+}
+
+// tradeProceeds returns what the seller received for a trade, the price minus the seller fee
+func tradeProceeds(trade *models.Trade) (psc, irr decimal.Decimal) {
+	return constants.CalculateSellerPayment(trade.PSCAmount), constants.CalculateSellerPayment(trade.IRRAmount)
+}
+
+// tradeFundsReason is the locked_assets reason of a trade's frozen proceeds
+func tradeFundsReason(tradeID uint64) string {
+	return fmt.Sprintf("trade_dispute:%d", tradeID)
+}
+
+func (s *TradeService) GetTrade(ctx context.Context, tradeID uint64) (*models.Trade, *models.FeatureProperties, error) {
+	trade, err := s.tradeRepo.FindByID(ctx, tradeID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find trade: %w", err)
+	}
+	if trade == nil {
+		return nil, nil, ErrTradeNotFound
+	}
+
+	properties, err := s.propertiesRepo.GetByFeatureID(ctx, trade.FeatureID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get feature properties: %w", err)
+	}
+
+	return trade, properties, nil
+}
+
+// FreezeTradeFunds locks the seller's proceeds of a trade in their wallet
+func (s *TradeService) FreezeTradeFunds(ctx context.Context, tradeID uint64) error {
+	trade, err := s.findTrade(ctx, tradeID)
+	if err != nil {
+		return err
+	}
+
+	psc, irr := tradeProceeds(trade)
+	reason := tradeFundsReason(trade.ID)
+	if psc.IsPositive() {
+		if err := s.commercialClient.LockBalance(ctx, trade.SellerID, "psc", psc, reason); err != nil {
+			return fmt.Errorf("%w: %v", ErrTradeFundsNotFrozen, err)
+		}
+	}
+	if irr.IsPositive() {
+		if err := s.commercialClient.LockBalance(ctx, trade.SellerID, "irr", irr, reason); err != nil {
+			// Rollback PSC lock
+			if psc.IsPositive() {
+				s.commercialClient.UnlockBalance(ctx, trade.SellerID, "psc", psc)
+			}
+			return fmt.Errorf("%w: %v", ErrTradeFundsNotFrozen, err)
+		}
+	}
+
+	return nil
+}
+
+// ReleaseTradeFunds unlocks proceeds frozen by FreezeTradeFunds
+func (s *TradeService) ReleaseTradeFunds(ctx context.Context, tradeID uint64) error {
+	trade, err := s.findTrade(ctx, tradeID)
+	if err != nil {
+		return err
+	}
+
+	return s.unlockProceeds(ctx, trade)
+}
+
+// RefundTrade returns the seller's proceeds to the buyer and the feature to
+// the seller, and marks the trade's receipt refunded. Platform fees are not refunded.
+func (s *TradeService) RefundTrade(ctx context.Context, tradeID uint64, fundsFrozen bool) error {
+	trade, err := s.findTrade(ctx, tradeID)
+	if err != nil {
+		return err
+	}
+
+	feature, properties, err := s.featureRepo.FindByID(ctx, trade.FeatureID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrTradeFeatureResold
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find feature: %w", err)
+	}
+	if feature.OwnerID != trade.BuyerID {
+		return ErrTradeFeatureResold
+	}
+
+	if fundsFrozen {
+		if err := s.unlockProceeds(ctx, trade); err != nil {
+			return err
+		}
+	}
+
+	psc, irr := tradeProceeds(trade)
+	if psc.IsPositive() {
+		if err := s.commercialClient.ReclaimBalance(ctx, trade.SellerID, "psc", psc); err != nil {
+			return fmt.Errorf("%w: %v", ErrTradeRefundFailed, err)
+		}
+	}
+	if irr.IsPositive() {
+		if err := s.commercialClient.ReclaimBalance(ctx, trade.SellerID, "irr", irr); err != nil {
+			// Rollback PSC deduction
+			if psc.IsPositive() {
+				s.commercialClient.AddBalance(ctx, trade.SellerID, "psc", psc)
+			}
+			return fmt.Errorf("%w: %v", ErrTradeRefundFailed, err)
+		}
+	}
+
+	if psc.IsPositive() {
+		if err := s.commercialClient.AddBalance(ctx, trade.BuyerID, "psc", psc); err != nil {
+			return fmt.Errorf("failed to credit buyer wallet: %w", err)
+		}
+	}
+	if irr.IsPositive() {
+		if err := s.commercialClient.AddBalance(ctx, trade.BuyerID, "irr", irr); err != nil {
+			return fmt.Errorf("failed to credit buyer wallet: %w", err)
+		}
+	}
+
+	// Transfer ownership back
+	if err := s.featureRepo.UpdateOwner(ctx, feature.ID, trade.SellerID); err != nil {
+		return err
+	}
+
+	sellerName := ""
+	if s.userCache != nil {
+		if seller, err := s.userCache.Get(ctx, trade.SellerID); err == nil {
+			sellerName = seller.Name
+		}
+	}
+	newStatus := constants.ChangeStatusToSoldAndNotPriced(properties.Karbari)
+	if err := s.propertiesRepo.UpdateStatus(ctx, feature.ID, newStatus, sellerName, "", constants.DefaultPublicPricingLimit); err != nil {
+		return err
+	}
+
+	// The receipt keeps verifying, but shows the trade was reversed
+	if err := s.receiptRepo.MarkRefunded(ctx, trade.ID); err != nil {
+		return fmt.Errorf("trade refunded but its receipt was not updated: %w", err)
+	}
+	return nil
+}
+
+func (s *TradeService) findTrade(ctx context.Context, tradeID uint64) (*models.Trade, error) {
+	if s.commercialClient == nil {
+		return nil, ErrTradeWalletUnavailable
+	}
+
+	trade, err := s.tradeRepo.FindByID(ctx, tradeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find trade: %w", err)
+	}
+	if trade == nil {
+		return nil, ErrTradeNotFound
+	}
+	return trade, nil
+}
+
+func (s *TradeService) unlockProceeds(ctx context.Context, trade *models.Trade) error {
+	psc, irr := tradeProceeds(trade)
+	if psc.IsPositive() {
+		if err := s.commercialClient.UnlockBalance(ctx, trade.SellerID, "psc", psc); err != nil {
+			return err
+		}
+	}
+	if irr.IsPositive() {
+		if err := s.commercialClient.UnlockBalance(ctx, trade.SellerID, "irr", irr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"metargb/features-service/internal/models"
+	"metargb/features-service/internal/repository"
+	"metargb/shared/pkg/helpers"
+)
+
+// firstTradeSummaryYear is the earliest Jalali year a summary is offered for
+const firstTradeSummaryYear = 1399
+
+var ErrTradeSummaryYear = errors.New("year must be a Jalali year from 1399 up to the current year")
+
+// TradeSummaryServiceInterface defines the interface for annual trade summaries
+type TradeSummaryServiceInterface interface {
+	GetAnnualTradeSummary(ctx context.Context, userID uint64, year int) (*models.AnnualTradeSummary, error)
+}
+
+type TradeSummaryService struct {
+	summaryRepo *repository.TradeSummaryRepository
+	now         func() time.Time
+}
+
+func NewTradeSummaryService(summaryRepo *repository.TradeSummaryRepository) TradeSummaryServiceInterface {
+	return &TradeSummaryService{summaryRepo: summaryRepo, now: time.Now}
+}
+
+// GetAnnualTradeSummary totals the user's trades, the fees they paid and the
+// profit paid to them in a Jalali year, the current one when year is 0.
+// Refunded trades are listed but left out of the totals.
+func (s *TradeSummaryService) GetAnnualTradeSummary(ctx context.Context, userID uint64, year int) (*models.AnnualTradeSummary, error) {
+	currentYear := helpers.JalaliYear(s.now())
+	if year == 0 {
+		year = currentYear
+	}
+	if year < firstTradeSummaryYear || year > currentYear {
+		return nil, ErrTradeSummaryYear
+	}
+	from, to := helpers.JalaliYearRange(year)
+
+	trades, err := s.summaryRepo.ListTrades(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	profits, err := s.summaryRepo.ProfitTotals(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.AnnualTradeSummary{
+		Year:    year,
+		From:    from,
+		To:      to,
+		Totals:  totalAnnualTrades(trades),
+		Profits: profits,
+		Trades:  trades,
+	}, nil
+}
+
+func totalAnnualTrades(trades []*models.AnnualTrade) models.AnnualTradeTotals {
+	var totals models.AnnualTradeTotals
+	for _, trade := range trades {
+		if trade.Refunded {
+			continue
+		}
+		if trade.Role == models.TradeRoleBuyer {
+			totals.BoughtCount++
+			totals.BoughtPSC = totals.BoughtPSC.Add(trade.PricePSC)
+			totals.BoughtIRR = totals.BoughtIRR.Add(trade.PriceIRR)
+		} else {
+			totals.SoldCount++
+			totals.SoldPSC = totals.SoldPSC.Add(trade.PricePSC)
+			totals.SoldIRR = totals.SoldIRR.Add(trade.PriceIRR)
+		}
+		totals.FeesPSC = totals.FeesPSC.Add(trade.FeePSC)
+		totals.FeesIRR = totals.FeesIRR.Add(trade.FeeIRR)
+	}
+	return totals
+}
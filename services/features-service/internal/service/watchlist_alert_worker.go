@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"metargb/features-service/internal/models"
+	"metargb/shared/pkg/jobs"
+	"metargb/shared/pkg/logger"
+)
+
+// DefaultWatchlistAlertInterval is how often watched features are checked for changes
+const DefaultWatchlistAlertInterval = time.Minute
+
+// watchlistAlertBatchSize caps the entries handled per run, the rest are picked up next run
+const watchlistAlertBatchSize = 500
+
+// Kinds of watched feature changes
+const (
+	WatchlistChangeSellRequest = "sell_request"
+	WatchlistChangePrice       = "price"
+	WatchlistChangeOwner       = "owner"
+)
+
+// WatchlistAlertRepository is the part of the watchlist repository the alert worker uses
+type WatchlistAlertRepository interface {
+	ListChanged(ctx context.Context, limit int) ([]*models.WatchedFeature, error)
+	UpdateSnapshot(ctx context.Context, watch *models.WatchedFeature) error
+}
+
+// WatchlistNotifier delivers watchlist alerts, implemented by client.NotificationClient
+type WatchlistNotifier interface {
+	SendNotification(ctx context.Context, userID uint64, notificationType, title, message string, data map[string]string) error
+}
+
+// WatchlistAlertWorker periodically compares watched features with the state
+// they had at the watcher's last alert and notifies watchers of new sell
+// requests, price changes and ownership changes
+type WatchlistAlertWorker struct {
+	repo     WatchlistAlertRepository
+	notifier WatchlistNotifier
+	interval time.Duration
+	log      *logger.Logger
+}
+
+// NewWatchlistAlertWorker creates a worker running every interval
+// (DefaultWatchlistAlertInterval if zero). notifier may be nil, in which case
+// changes are only recorded.
+func NewWatchlistAlertWorker(repo WatchlistAlertRepository, notifier WatchlistNotifier, interval time.Duration, log *logger.Logger) *WatchlistAlertWorker {
+	if interval <= 0 {
+		interval = DefaultWatchlistAlertInterval
+	}
+	return &WatchlistAlertWorker{
+		repo:     repo,
+		notifier: notifier,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Register runs the worker once every interval under runner
+func (w *WatchlistAlertWorker) Register(runner *jobs.Runner) {
+	runner.Add("watchlist-alerts", w.interval, func(ctx context.Context) error {
+		_, err := w.Run(ctx)
+		return err
+	})
+}
+
+// Run alerts the watchers of changed features and returns how many alerts were sent
+func (w *WatchlistAlertWorker) Run(ctx context.Context) (int, error) {
+	watches, err := w.repo.ListChanged(ctx, watchlistAlertBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, watch := range watches {
+		for _, change := range watchlistChanges(watch) {
+			if w.notifier == nil {
+				continue
+			}
+			title, message := watchlistAlertText(watch, change)
+			data := map[string]string{
+				"feature_id": fmt.Sprintf("%d", watch.FeatureID),
+				"id":         watch.PropertiesID,
+				"change":     change,
+			}
+			// Alerts are best effort, a failed delivery is not retried
+			if err := w.notifier.SendNotification(ctx, watch.UserID, "feature_watchlist", title, message, data); err != nil {
+				w.log.Warn("Failed to send watchlist alert", "error", err, "user_id", watch.UserID, "feature_id", watch.FeatureID)
+				continue
+			}
+			sent++
+		}
+
+		if err := w.repo.UpdateSnapshot(ctx, watch); err != nil {
+			return sent, err
+		}
+	}
+
+	return sent, nil
+}
+
+// watchlistChanges lists what changed since the watcher's last alert. Changes
+// made while the watcher owns the feature are their own and not reported.
+func watchlistChanges(watch *models.WatchedFeature) []string {
+	if watch.OwnerID == watch.UserID {
+		return nil
+	}
+
+	var changes []string
+	if watch.SellRequestID != 0 && watch.SellRequestID != watch.LastSellRequestID {
+		changes = append(changes, WatchlistChangeSellRequest)
+	}
+	if watch.PricePSC != watch.LastPricePSC || watch.PriceIRR != watch.LastPriceIRR {
+		changes = append(changes, WatchlistChangePrice)
+	}
+	if watch.OwnerID != watch.LastOwnerID {
+		changes = append(changes, WatchlistChangeOwner)
+	}
+	return changes
+}
+
+func watchlistAlertText(watch *models.WatchedFeature, change string) (string, string) {
+	switch change {
+	case WatchlistChangeSellRequest:
+		return "ملک مورد علاقه در حال فروش است",
+			fmt.Sprintf("ملک %s برای فروش گذاشته شد", watch.PropertiesID)
+	case WatchlistChangePrice:
+		return "تغییر قیمت ملک مورد علاقه",
+			fmt.Sprintf("قیمت ملک %s به %s PSC و %s IRR تغییر کرد", watch.PropertiesID, watch.PricePSC, watch.PriceIRR)
+	default:
+		return "تغییر مالکیت ملک مورد علاقه",
+			fmt.Sprintf("مالکیت ملک %s تغییر کرد", watch.PropertiesID)
+	}
+}
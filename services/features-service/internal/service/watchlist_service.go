@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"metargb/features-service/internal/models"
+	"metargb/features-service/internal/repository"
+)
+
+// maxWatchlistSize caps how many features a user can watch
+const maxWatchlistSize = 100
+
+var (
+	ErrWatchlistFeatureNotFound = errors.New("feature not found")
+	ErrWatchlistNotWatched      = errors.New("feature is not in your watchlist")
+	ErrWatchlistFull            = fmt.Errorf("watchlist is limited to %d features", maxWatchlistSize)
+)
+
+// WatchlistServiceInterface defines the interface for watchlist operations
+type WatchlistServiceInterface interface {
+	AddToWatchlist(ctx context.Context, userID, featureID uint64) (*models.WatchedFeature, error)
+	RemoveFromWatchlist(ctx context.Context, userID, featureID uint64) error
+	ListWatchlist(ctx context.Context, userID uint64) ([]*models.WatchedFeature, error)
+}
+
+type WatchlistService struct {
+	watchlistRepo *repository.WatchlistRepository
+}
+
+func NewWatchlistService(watchlistRepo *repository.WatchlistRepository) WatchlistServiceInterface {
+	return &WatchlistService{
+		watchlistRepo: watchlistRepo,
+	}
+}
+
+// AddToWatchlist watches a feature, returning the existing entry if it is already watched
+func (s *WatchlistService) AddToWatchlist(ctx context.Context, userID, featureID uint64) (*models.WatchedFeature, error) {
+	existing, err := s.watchlistRepo.Find(ctx, userID, featureID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	count, err := s.watchlistRepo.CountByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxWatchlistSize {
+		return nil, ErrWatchlistFull
+	}
+
+	if err := s.watchlistRepo.Add(ctx, userID, featureID); err != nil {
+		return nil, err
+	}
+
+	// Add inserts nothing when the feature does not exist
+	watch, err := s.watchlistRepo.Find(ctx, userID, featureID)
+	if err != nil {
+		return nil, err
+	}
+	if watch == nil {
+		return nil, ErrWatchlistFeatureNotFound
+	}
+	return watch, nil
+}
+
+func (s *WatchlistService) RemoveFromWatchlist(ctx context.Context, userID, featureID uint64) error {
+	removed, err := s.watchlistRepo.Remove(ctx, userID, featureID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return ErrWatchlistNotWatched
+	}
+	return nil
+}
+
+func (s *WatchlistService) ListWatchlist(ctx context.Context, userID uint64) ([]*models.WatchedFeature, error) {
+	return s.watchlistRepo.ListByUser(ctx, userID)
+}
@@ -34,6 +34,13 @@ type BuildPackageRequest struct {
 // BuildPackageResponse represents the response from the build package API
 type BuildPackageResponse struct {
 	Data []BuildingModelData `json:"data"`
+	Meta PaginationMeta      `json:"meta"`
+}
+
+// PaginationMeta is the Laravel paginator metadata returned with each page
+type PaginationMeta struct {
+	CurrentPage int32 `json:"current_page"`
+	LastPage    int32 `json:"last_page"`
 }
 
 // BuildingModelData represents a building model from the 3D API
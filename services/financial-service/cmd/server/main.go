@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/joho/godotenv"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"metargb/financial-service/internal/client"
+	"metargb/financial-service/internal/handler"
+	"metargb/financial-service/internal/parsian"
+	"metargb/financial-service/internal/repository"
+	"metargb/financial-service/internal/service"
+	"metargb/shared/pkg/auth"
+	"metargb/shared/pkg/buildinfo"
+	shareddb "metargb/shared/pkg/db"
+	"metargb/shared/pkg/dbsplit"
+	"metargb/shared/pkg/deadline"
+	"metargb/shared/pkg/debug"
+	"metargb/shared/pkg/devmode"
+	healthprobe "metargb/shared/pkg/health"
+	"metargb/shared/pkg/logger"
+	"metargb/shared/pkg/msgsize"
+)
+
+func main() {
+	// Load environment variables
+	envErr := godotenv.Load()
+
+	// Initialize logger once the environment is loaded so LOG_LEVEL applies
+	log := logger.NewLogger("financial-service")
+	log.RedirectStdLog()
+	if envErr != nil {
+		log.Warn(".env file not found", "error", envErr)
+	}
+
+	// SIGHUP toggles debug logging; FINANCIAL_LOG_ADMIN_ADDR serves the level over HTTP
+	logCtx, stopLogControl := context.WithCancel(context.Background())
+	defer stopLogControl()
+	log.EnableLevelControl(logCtx)
+
+	dsn := shareddb.ServiceDSN("financial-service", fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
+		getEnv("DB_USER", "root"),
+		getEnv("DB_PASSWORD", ""),
+		getEnv("DB_HOST", "localhost"),
+		getEnv("DB_PORT", "3306"),
+		getEnv("DB_DATABASE", "metargb_db"),
+	))
+
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		log.Fatal("Failed to connect to database", "error", err)
+	}
+	defer db.Close()
+
+	db.SetMaxOpenConns(25)
+	db.SetMaxIdleConns(5)
+	db.SetConnMaxLifetime(5 * time.Minute)
+
+	// Wait for the database instead of crash looping while it is unavailable
+	if err := shareddb.PingWithRetry(context.Background(), db, shareddb.RetryPolicyFromEnv()); err != nil {
+		log.Fatal("Failed to ping database", "error", err)
+	}
+	log.Info("Successfully connected to database")
+
+	// Initialize repositories
+	orderRepo := repository.NewOrderRepository(db)
+	transactionRepo := repository.NewTransactionRepository(db)
+	paymentRepo := repository.NewPaymentRepository(db)
+	variableRepo := repository.NewVariableRepository(db)
+	callbackRepo := repository.NewProcessedCallbackRepository(db)
+	optionRepo := repository.NewOptionRepository(db)
+	imageRepo := repository.NewImageRepository(db)
+
+	// Initialize Parsian client. Transient failures are retried with jittered
+	// backoff within PARSIAN_TIMEOUT_BUDGET; confirms only when undelivered.
+	parsianClient := parsian.NewClientWithRetry(parsian.RetryConfig{
+		MaxAttempts:    getEnvAsInt("PARSIAN_MAX_ATTEMPTS", parsian.DefaultMaxAttempts, log),
+		BaseDelay:      getEnvAsDuration("PARSIAN_RETRY_BASE_DELAY", parsian.DefaultBaseDelay, log),
+		MaxDelay:       getEnvAsDuration("PARSIAN_RETRY_MAX_DELAY", parsian.DefaultMaxDelay, log),
+		AttemptTimeout: getEnvAsDuration("PARSIAN_ATTEMPT_TIMEOUT", parsian.DefaultAttemptTimeout, log),
+		Budget:         getEnvAsDuration("PARSIAN_TIMEOUT_BUDGET", parsian.DefaultBudget, log),
+	})
+
+	sandbox := getEnv("PAYMENT_SANDBOX", "false") == "true"
+	if sandbox {
+		log.Warn("PAYMENT_SANDBOX is enabled - payments are simulated and no bank calls are made")
+	}
+
+	// Fraud screening and the wallet credit of store orders are done by commercial-service
+	commercialClient, err := client.NewCommercialClient(getEnv("COMMERCIAL_SERVICE_ADDR", "commercial-service:50052"), getEnv(auth.ServiceAPIKeyEnv, ""))
+	if err != nil {
+		log.Fatal("Failed to create commercial service client", "error", err)
+	}
+	defer commercialClient.Close()
+
+	// Initialize services
+	orderService := service.NewOrderService(
+		orderRepo,
+		transactionRepo,
+		paymentRepo,
+		variableRepo,
+		callbackRepo,
+		parsianClient,
+		commercialClient,
+		service.NewOrderPolicy(db),
+		service.OrderConfig{
+			ParsianMerchantID:            getEnv("PARSIAN_MERCHANT_ID", ""),
+			ParsianLoanAccountMerchantID: getEnv("PARSIAN_LOAN_ACCOUNT_MERCHANT_ID", ""),
+			ParsianCallbackURL:           getEnv("PARSIAN_CALLBACK_URL", "https://rgb.irpsc.com/api/parsian/callback"),
+			FrontendURL:                  getEnv("FRONTEND_URL", "https://rgb.irpsc.com"),
+			Sandbox:                      sandbox,
+		},
+		log,
+	)
+	storeService := service.NewStoreService(optionRepo, variableRepo, imageRepo)
+
+	limits := msgsize.FromEnv("financial-service", msgsize.Defaults())
+	serverOpts := append(limits.ServerOptions(),
+		grpc.ChainUnaryInterceptor(logger.UnaryServerInterceptor(log)),
+		grpc.ChainStreamInterceptor(logger.StreamServerInterceptor(log)),
+	)
+
+	// Cap how long handlers run so slow queries are cancelled, see deadline.FromEnv
+	serverOpts = append(serverOpts, deadline.FromEnv("financial-service", deadline.Defaults()).ServerOptions()...)
+
+	// Outside development internal errors reach callers only as a reference, see devmode
+	serverOpts = append(serverOpts, devmode.FromEnv("financial-service", log)...)
+
+	// Every response names the build that served it, see buildinfo
+	serverOpts = append(serverOpts, buildinfo.ServerOptions()...)
+	grpcServer := grpc.NewServer(serverOpts...)
+
+	// Report readiness over HTTP and through the standard gRPC health service:
+	// the database is reachable, its tables exist and the server has started
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	defer stopHealth()
+	dbMonitor := shareddb.NewHealthMonitor(db, nil)
+	dbMonitor.Start(healthCtx)
+	probe := healthprobe.NewProbe("financial-service").
+		AddCheck("database", dbMonitor.Check).
+		AddCheck("migrations", shareddb.NewSchemaGuard(db).RequireTables(dbsplit.Ownership["financial-service"]...))
+	serving := probe.Starting("grpc")
+	probe.Watch(healthCtx, healthServer, healthprobe.DefaultInterval)
+	probeServer := healthprobe.NewServer(":"+getEnv("HEALTH_PORT", healthprobe.DefaultPort), probe)
+	go func() {
+		if err := probeServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Health probe server failed", "error", err)
+		}
+	}()
+
+	handler.RegisterOrderHandler(grpcServer, orderService)
+	handler.RegisterStoreHandler(grpcServer, storeService)
+
+	// Reflection is only registered in development, see devmode
+	devmode.Register(grpcServer, log)
+
+	// Profiling endpoints, off unless DEBUG_ADDR is set or in development, see debug
+	debug.Serve(context.Background(), "financial-service", log)
+
+	port := getEnv("GRPC_PORT", "50058")
+	listener, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		log.Fatal("Failed to listen", "error", err, "port", port)
+	}
+
+	log.Info("Financial service listening", "port", port)
+
+	go func() {
+		if err := grpcServer.Serve(listener); err != nil {
+			log.Fatal("Failed to serve", "error", err)
+		}
+	}()
+	serving()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Info("Shutting down server...")
+	probe.Drain()
+	healthServer.Shutdown()
+	grpcServer.GracefulStop()
+	probeServer.Close()
+	log.Info("Server stopped")
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+func getEnvAsInt(key string, defaultValue int, log *logger.Logger) int {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.Atoi(valueStr)
+	if err != nil {
+		log.Warn("Invalid "+key+", using default", "value", valueStr, "default", defaultValue)
+		return defaultValue
+	}
+	return value
+}
+
+func getEnvAsDuration(key string, defaultValue time.Duration, log *logger.Logger) time.Duration {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := time.ParseDuration(valueStr)
+	if err != nil {
+		log.Warn("Invalid "+key+", using default", "value", valueStr, "default", defaultValue)
+		return defaultValue
+	}
+	return value
+}
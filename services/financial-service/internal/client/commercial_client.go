@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"metargb/financial-service/internal/service"
+	pb "metargb/shared/pb/commercial"
+	"metargb/shared/pkg/auth"
+)
+
+// CommercialClient calls the payment methods commercial-service offers to
+// financial-service: fraud screening, the wallet credit of store orders and
+// their payment state events. They need serviceAPIKey to hold the
+// service:payments scope.
+type CommercialClient struct {
+	paymentClient pb.PaymentServiceClient
+	conn          *grpc.ClientConn
+}
+
+// NewCommercialClient creates a Commercial Service client. The connection is
+// made on first use, so calls fail while commercial-service is unreachable.
+func NewCommercialClient(address, serviceAPIKey string) (*CommercialClient, error) {
+	conn, err := grpc.Dial(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		auth.WithServiceAPIKey(serviceAPIKey),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to commercial service at %s: %w", address, err)
+	}
+
+	return &CommercialClient{
+		paymentClient: pb.NewPaymentServiceClient(conn),
+		conn:          conn,
+	}, nil
+}
+
+// Close closes the gRPC connection
+func (c *CommercialClient) Close() error {
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
+}
+
+// ScreenPayment runs the fraud rules on an order the user is about to place
+func (c *CommercialClient) ScreenPayment(ctx context.Context, userID uint64, asset string, amount float64, ip, device string) error {
+	_, err := c.paymentClient.ScreenPayment(ctx, &pb.ScreenPaymentRequest{
+		UserId: userID,
+		Asset:  asset,
+		Amount: formatAmount(amount),
+		Ip:     ip,
+		Device: device,
+	})
+	return screeningError("screen payment", err)
+}
+
+// ScreenPaymentCard checks the card paying for an order against the blocklist
+func (c *CommercialClient) ScreenPaymentCard(ctx context.Context, userID, orderID uint64, asset string, amount float64, cardPan string) error {
+	_, err := c.paymentClient.ScreenPaymentCard(ctx, &pb.ScreenPaymentCardRequest{
+		UserId:  userID,
+		OrderId: orderID,
+		Asset:   asset,
+		Amount:  formatAmount(amount),
+		CardPan: cardPan,
+	})
+	return screeningError("screen payment card", err)
+}
+
+// SettleOrder credits a verified order to the wallet, with the first order
+// bonus the first_order_* rules of commercial-service grant
+func (c *CommercialClient) SettleOrder(ctx context.Context, orderID uint64, refID int64) error {
+	if _, err := c.paymentClient.SettleOrder(ctx, &pb.SettleOrderRequest{OrderId: orderID, RefId: refID}); err != nil {
+		return fmt.Errorf("failed to settle order: %w", err)
+	}
+	return nil
+}
+
+// PublishOrderStatus announces a pending or failed order on the order-status
+// channel of the WebSocket gateway
+func (c *CommercialClient) PublishOrderStatus(ctx context.Context, orderID uint64, status, message string) error {
+	_, err := c.paymentClient.PublishOrderStatus(ctx, &pb.PublishOrderStatusRequest{
+		OrderId: orderID,
+		Status:  status,
+		Message: message,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish order status: %w", err)
+	}
+	return nil
+}
+
+// screeningError reports a payment the fraud rules refused as
+// service.ErrPaymentRefused, keeping their reason
+func screeningError(op string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+		return fmt.Errorf("%w: %s", service.ErrPaymentRefused, st.Message())
+	}
+	return fmt.Errorf("failed to %s: %w", op, err)
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', -1, 64)
+}
@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"metargb/financial-service/internal/client"
+	"metargb/financial-service/internal/models"
+	"metargb/financial-service/internal/service"
+	commercialpb "metargb/shared/pb/commercial"
+	pb "metargb/shared/pb/financial"
+	"metargb/shared/pkg/logger"
+)
+
+// fakeCommercialServer stands in for commercial-service and records the
+// settlements and payment states financial-service sends it
+type fakeCommercialServer struct {
+	commercialpb.UnimplementedPaymentServiceServer
+	mu        sync.Mutex
+	settled   []*commercialpb.SettleOrderRequest
+	published []*commercialpb.PublishOrderStatusRequest
+}
+
+func (f *fakeCommercialServer) ScreenPayment(ctx context.Context, req *commercialpb.ScreenPaymentRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (f *fakeCommercialServer) ScreenPaymentCard(ctx context.Context, req *commercialpb.ScreenPaymentCardRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (f *fakeCommercialServer) SettleOrder(ctx context.Context, req *commercialpb.SettleOrderRequest) (*commercialpb.SettleOrderResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.settled = append(f.settled, req)
+	return &commercialpb.SettleOrderResponse{Credited: "250", Bonus: "0"}, nil
+}
+
+func (f *fakeCommercialServer) PublishOrderStatus(ctx context.Context, req *commercialpb.PublishOrderStatusRequest) (*emptypb.Empty, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.published = append(f.published, req)
+	return &emptypb.Empty{}, nil
+}
+
+// Single order stores, enough for one order and its callback
+
+type callbackOrderRepo struct{ order *models.Order }
+
+func (r *callbackOrderRepo) Create(ctx context.Context, order *models.Order) error {
+	order.ID = 7
+	r.order = order
+	return nil
+}
+
+func (r *callbackOrderRepo) FindByID(ctx context.Context, id uint64) (*models.Order, error) {
+	return r.order, nil
+}
+
+func (r *callbackOrderRepo) FindByIDWithUser(ctx context.Context, id uint64) (*models.Order, *models.User, error) {
+	return r.order, nil, nil
+}
+
+func (r *callbackOrderRepo) Update(ctx context.Context, order *models.Order) error { return nil }
+
+type callbackTransactionRepo struct{ transaction *models.Transaction }
+
+func (r *callbackTransactionRepo) Create(ctx context.Context, transaction *models.Transaction) error {
+	r.transaction = transaction
+	return nil
+}
+
+func (r *callbackTransactionRepo) Update(ctx context.Context, transaction *models.Transaction) error {
+	return nil
+}
+
+func (r *callbackTransactionRepo) FindByID(ctx context.Context, id string) (*models.Transaction, error) {
+	return r.transaction, nil
+}
+
+func (r *callbackTransactionRepo) FindByPayable(ctx context.Context, payableType string, payableID uint64) (*models.Transaction, error) {
+	return r.transaction, nil
+}
+
+type callbackPaymentRepo struct{}
+
+func (callbackPaymentRepo) Create(ctx context.Context, payment *models.Payment) error { return nil }
+
+type callbackRates struct{}
+
+func (callbackRates) GetRate(ctx context.Context, asset string) (float64, error) { return 1000, nil }
+
+type callbackClaims struct{ claimed bool }
+
+func (r *callbackClaims) Claim(ctx context.Context, callback *models.ProcessedCallback) (bool, error) {
+	if r.claimed {
+		return false, nil
+	}
+	r.claimed = true
+	callback.ID = 1
+	return true, nil
+}
+
+func (r *callbackClaims) UpdateResult(ctx context.Context, id uint64, result string) error {
+	return nil
+}
+
+type allowStorePolicy struct{}
+
+func (allowStorePolicy) CanBuyFromStore(ctx context.Context, userID uint64) (bool, error) {
+	return true, nil
+}
+
+// serveGRPC serves register on a loopback port until the test ends
+func serveGRPC(t *testing.T, register func(*grpc.Server)) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	srv := grpc.NewServer()
+	register(srv)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+// TestHandleCallbackAnnouncesOrderStatus follows a sandbox payment the way the
+// gateway routes POST /api/parsian/callback: over gRPC to this handler, and
+// from the order service to commercial-service, which publishes the order's
+// payment states to the WebSocket gateway.
+func TestHandleCallbackAnnouncesOrderStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    int32
+		paid      bool
+		published []string
+	}{
+		{name: "approved", amount: 250, paid: true, published: []string{"pending"}},
+		{name: "user cancels", amount: 217, published: []string{"pending", "failed"}},
+		{name: "verification denied", amount: 231, published: []string{"pending", "failed"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commercial := &fakeCommercialServer{}
+			commercialAddr := serveGRPC(t, func(srv *grpc.Server) {
+				commercialpb.RegisterPaymentServiceServer(srv, commercial)
+			})
+			commercialClient, err := client.NewCommercialClient(commercialAddr, "test-key")
+			if err != nil {
+				t.Fatalf("NewCommercialClient() error = %v", err)
+			}
+			defer commercialClient.Close()
+
+			orderService := service.NewOrderService(
+				&callbackOrderRepo{}, &callbackTransactionRepo{}, callbackPaymentRepo{}, callbackRates{}, &callbackClaims{},
+				nil, commercialClient, allowStorePolicy{},
+				service.OrderConfig{
+					ParsianCallbackURL: "https://rgb.irpsc.com/api/parsian/callback",
+					FrontendURL:        "https://rgb.irpsc.com",
+					Sandbox:            true,
+				},
+				logger.NewLogger("test"),
+			)
+			financialAddr := serveGRPC(t, func(srv *grpc.Server) {
+				RegisterOrderHandler(srv, orderService)
+			})
+			conn, err := grpc.Dial(financialAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				t.Fatalf("failed to dial financial-service: %v", err)
+			}
+			defer conn.Close()
+			orders := pb.NewOrderServiceClient(conn)
+
+			created, err := orders.CreateOrder(context.Background(), &pb.CreateOrderRequest{UserId: 1, Amount: tt.amount, Asset: "psc"})
+			if err != nil {
+				t.Fatalf("CreateOrder() error = %v", err)
+			}
+			link, err := url.Parse(created.Link)
+			if err != nil {
+				t.Fatalf("invalid payment link %q", created.Link)
+			}
+
+			// The sandbox link carries the fields Parsian would post
+			q := link.Query()
+			orderID, _ := strconv.ParseUint(q.Get("OrderId"), 10, 64)
+			status, _ := strconv.ParseInt(q.Get("status"), 10, 32)
+			token, _ := strconv.ParseInt(q.Get("Token"), 10, 64)
+			_, err = orders.HandleCallback(context.Background(), &pb.HandleCallbackRequest{
+				OrderId:    orderID,
+				Status:     int32(status),
+				Token:      token,
+				RawPayload: link.RawQuery,
+			})
+			if err != nil {
+				t.Fatalf("HandleCallback() error = %v", err)
+			}
+
+			commercial.mu.Lock()
+			defer commercial.mu.Unlock()
+			if tt.paid != (len(commercial.settled) == 1) {
+				t.Fatalf("expected paid=%v, got %d settlements", tt.paid, len(commercial.settled))
+			}
+			// commercial-service announces the paid state when it settles
+			if tt.paid && (commercial.settled[0].OrderId != 7 || commercial.settled[0].RefId != token) {
+				t.Errorf("settled %+v, want order 7 with ref %d", commercial.settled[0], token)
+			}
+			if len(commercial.published) != len(tt.published) {
+				t.Fatalf("published %d states, want %v", len(commercial.published), tt.published)
+			}
+			for i, want := range tt.published {
+				if got := commercial.published[i]; got.OrderId != 7 || got.Status != want {
+					t.Errorf("state %d = order %d %q, want order 7 %q", i, got.OrderId, got.Status, want)
+				}
+			}
+		})
+	}
+}
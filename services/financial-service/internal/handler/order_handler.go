@@ -40,7 +40,7 @@ func (h *OrderHandler) CreateOrder(ctx context.Context, req *pb.CreateOrderReque
 	}
 
 	// Call service
-	link, err := h.orderService.CreateOrder(ctx, req.UserId, req.Amount, req.Asset)
+	link, err := h.orderService.CreateOrder(ctx, req.UserId, req.Amount, req.Asset, req.Ip, req.Device)
 	if err != nil {
 		// Map service errors to gRPC status codes
 		if errors.Is(err, service.ErrInvalidAmount) || errors.Is(err, service.ErrInvalidAsset) {
@@ -49,7 +49,7 @@ func (h *OrderHandler) CreateOrder(ctx context.Context, req *pb.CreateOrderReque
 		if errors.Is(err, service.ErrUserNotEligible) {
 			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
 		}
-		if errors.Is(err, service.ErrPaymentFailed) {
+		if errors.Is(err, service.ErrPaymentFailed) || errors.Is(err, service.ErrPaymentRefused) {
 			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
 		}
 		return nil, status.Errorf(codes.Internal, "failed to create order: %v", err)
@@ -79,11 +79,17 @@ func (h *OrderHandler) HandleCallback(ctx context.Context, req *pb.HandleCallbac
 	}
 
 	// Call service
-	redirectURL, err := h.orderService.HandleCallback(ctx, req.OrderId, req.Status, req.Token, additionalParams)
+	redirectURL, err := h.orderService.HandleCallback(ctx, req.OrderId, req.Status, req.Token, additionalParams, req.RawPayload)
 	if err != nil {
 		if errors.Is(err, service.ErrOrderNotFound) {
 			return nil, status.Errorf(codes.NotFound, "%v", err)
 		}
+		if errors.Is(err, service.ErrInvalidCallbackToken) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		if errors.Is(err, service.ErrCallbackReplayed) {
+			return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to handle callback: %v", err)
 	}
 
@@ -17,16 +17,18 @@ import (
 type mockOrderService struct {
 	createOrderFunc func(ctx context.Context, userID uint64, amount int32, asset string) (string, error)
 	callbackFunc    func(ctx context.Context, orderID uint64, status int32, token int64, additionalParams map[string]string) (string, error)
+	rawPayload      string
 }
 
-func (m *mockOrderService) CreateOrder(ctx context.Context, userID uint64, amount int32, asset string) (string, error) {
+func (m *mockOrderService) CreateOrder(ctx context.Context, userID uint64, amount int32, asset, ip, device string) (string, error) {
 	if m.createOrderFunc != nil {
 		return m.createOrderFunc(ctx, userID, amount, asset)
 	}
 	return "", nil
 }
 
-func (m *mockOrderService) HandleCallback(ctx context.Context, orderID uint64, status int32, token int64, additionalParams map[string]string) (string, error) {
+func (m *mockOrderService) HandleCallback(ctx context.Context, orderID uint64, status int32, token int64, additionalParams map[string]string, rawPayload string) (string, error) {
+	m.rawPayload = rawPayload
 	if m.callbackFunc != nil {
 		return m.callbackFunc(ctx, orderID, status, token, additionalParams)
 	}
@@ -490,6 +492,67 @@ func TestOrderHandler_HandleCallback(t *testing.T) {
 		}
 	})
 
+	t.Run("service error - forged token", func(t *testing.T) {
+		mockService := &mockOrderService{
+			callbackFunc: func(ctx context.Context, orderID uint64, status int32, token int64, additionalParams map[string]string) (string, error) {
+				return "", service.ErrInvalidCallbackToken
+			},
+		}
+		handler := NewOrderHandler(mockService)
+
+		req := &pb.HandleCallbackRequest{
+			OrderId: 123,
+			Status:  0,
+			Token:   111111,
+		}
+
+		_, err := handler.HandleCallback(ctx, req)
+		st, ok := status.FromError(err)
+		if !ok || st.Code() != codes.PermissionDenied {
+			t.Errorf("expected PermissionDenied, got %v", err)
+		}
+	})
+
+	t.Run("service error - replayed callback", func(t *testing.T) {
+		mockService := &mockOrderService{
+			callbackFunc: func(ctx context.Context, orderID uint64, status int32, token int64, additionalParams map[string]string) (string, error) {
+				return "", service.ErrCallbackReplayed
+			},
+		}
+		handler := NewOrderHandler(mockService)
+
+		req := &pb.HandleCallbackRequest{
+			OrderId: 123,
+			Status:  0,
+			Token:   456789,
+		}
+
+		_, err := handler.HandleCallback(ctx, req)
+		st, ok := status.FromError(err)
+		if !ok || st.Code() != codes.AlreadyExists {
+			t.Errorf("expected AlreadyExists, got %v", err)
+		}
+	})
+
+	t.Run("raw payload is passed through", func(t *testing.T) {
+		mockService := &mockOrderService{}
+		handler := NewOrderHandler(mockService)
+
+		req := &pb.HandleCallbackRequest{
+			OrderId:    123,
+			Status:     0,
+			Token:      456789,
+			RawPayload: "OrderId=123&status=0&Token=456789",
+		}
+
+		if _, err := handler.HandleCallback(ctx, req); err != nil {
+			t.Fatalf("HandleCallback failed: %v", err)
+		}
+		if mockService.rawPayload != req.RawPayload {
+			t.Errorf("expected raw payload %q, got %q", req.RawPayload, mockService.rawPayload)
+		}
+	})
+
 	t.Run("service error - internal error", func(t *testing.T) {
 		mockService := &mockOrderService{
 			callbackFunc: func(ctx context.Context, orderID uint64, status int32, token int64, additionalParams map[string]string) (string, error) {
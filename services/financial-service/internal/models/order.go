@@ -55,20 +55,24 @@ type Variable struct {
 	Value float64 `db:"value"`
 }
 
-type FirstOrder struct {
-	ID        uint64    `db:"id"`
-	UserID    uint64    `db:"user_id"`
-	Type      string    `db:"type"`
-	Amount    float64   `db:"amount"`
-	Date      string    `db:"date"` // Jalali date format Y/m/d
-	Bonus     float64   `db:"bonus"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
-}
-
 type User struct {
 	ID        uint64     `db:"id"`
 	Name      string     `db:"name"`
 	Email     string     `db:"email"`
 	Birthdate *time.Time `db:"birthdate"`
 }
+
+// ProcessedCallback records a payment gateway callback that was accepted for
+// processing. The unique (gateway, order_id) key makes each order's callback
+// one-time; RawPayload keeps the body exactly as the gateway sent it.
+type ProcessedCallback struct {
+	ID         uint64    `db:"id"`
+	Gateway    string    `db:"gateway"`
+	OrderID    uint64    `db:"order_id"`
+	Token      int64     `db:"token"`
+	Status     int32     `db:"status"`
+	RawPayload string    `db:"raw_payload"`
+	Result     string    `db:"result"` // processing, paid, failed, error
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
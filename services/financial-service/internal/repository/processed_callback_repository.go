@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"metargb/financial-service/internal/models"
+)
+
+type ProcessedCallbackRepository interface {
+	// Claim records the callback and reports whether this call was the first
+	// one for its (gateway, order_id). A false result means it was replayed.
+	Claim(ctx context.Context, callback *models.ProcessedCallback) (bool, error)
+	UpdateResult(ctx context.Context, id uint64, result string) error
+}
+
+type processedCallbackRepository struct {
+	db *sql.DB
+}
+
+func NewProcessedCallbackRepository(db *sql.DB) ProcessedCallbackRepository {
+	return &processedCallbackRepository{db: db}
+}
+
+func (r *processedCallbackRepository) Claim(ctx context.Context, callback *models.ProcessedCallback) (bool, error) {
+	// INSERT IGNORE lets the unique key decide atomically which request wins,
+	// so concurrent replays cannot both pass a read-then-write check
+	query := `
+		INSERT IGNORE INTO processed_callbacks (gateway, order_id, token, status, raw_payload, result, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	now := time.Now()
+	result, err := r.db.ExecContext(ctx, query,
+		callback.Gateway, callback.OrderID, callback.Token, callback.Status,
+		callback.RawPayload, callback.Result, now, now)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim callback: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return false, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+	callback.ID = uint64(id)
+	callback.CreatedAt = now
+	callback.UpdatedAt = now
+
+	return true, nil
+}
+
+func (r *processedCallbackRepository) UpdateResult(ctx context.Context, id uint64, result string) error {
+	query := `
+		UPDATE processed_callbacks
+		SET result = ?, updated_at = ?
+		WHERE id = ?
+	`
+	_, err := r.db.ExecContext(ctx, query, result, time.Now(), id)
+	if err != nil {
+		return fmt.Errorf("failed to update callback result: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package service
+
+import "context"
+
+// CommercialPayments is the part of store orders commercial-service owns: its
+// fraud rules, and the wallets the paid orders are credited to
+type CommercialPayments interface {
+	// ScreenPayment returns ErrPaymentRefused unless the rules let the order
+	// be placed
+	ScreenPayment(ctx context.Context, userID uint64, asset string, amount float64, ip, device string) error
+	// ScreenPaymentCard returns ErrPaymentRefused if the card paying for the
+	// order is blocklisted
+	ScreenPaymentCard(ctx context.Context, userID, orderID uint64, asset string, amount float64, cardPan string) error
+	// SettleOrder credits a verified order to the wallet, with the first order
+	// bonus the first_order_* rules grant, and announces it paid with the
+	// bank's refID
+	SettleOrder(ctx context.Context, orderID uint64, refID int64) error
+	// PublishOrderStatus announces a pending or failed order to the user
+	// waiting on the payment page
+	PublishOrderStatus(ctx context.Context, orderID uint64, status, message string) error
+}
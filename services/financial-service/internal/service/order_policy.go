@@ -5,24 +5,19 @@ import (
 	"database/sql"
 	"fmt"
 	"time"
-
-	"metargb/financial-service/internal/repository"
 )
 
 type OrderPolicy interface {
 	CanBuyFromStore(ctx context.Context, userID uint64) (bool, error)
-	CanGetBonus(ctx context.Context, userID uint64, asset string) (bool, error)
 }
 
 type orderPolicy struct {
-	db             *sql.DB
-	firstOrderRepo repository.FirstOrderRepository
+	db *sql.DB
 }
 
-func NewOrderPolicy(db *sql.DB, firstOrderRepo repository.FirstOrderRepository) OrderPolicy {
+func NewOrderPolicy(db *sql.DB) OrderPolicy {
 	return &orderPolicy{
-		db:             db,
-		firstOrderRepo: firstOrderRepo,
+		db: db,
 	}
 }
 
@@ -71,22 +66,3 @@ func (p *orderPolicy) CanBuyFromStore(ctx context.Context, userID uint64) (bool,
 
 	return false, nil
 }
-
-// CanGetBonus checks if user can get first order bonus
-// Laravel: OrderPolicy::canGetBonus
-// Rule: Returns true only when user has never logged a firstOrder record and asset is not 'irr'
-func (p *orderPolicy) CanGetBonus(ctx context.Context, userID uint64, asset string) (bool, error) {
-	// Asset must not be 'irr'
-	if asset == "irr" {
-		return false, nil
-	}
-
-	// Check if user has any first order
-	count, err := p.firstOrderRepo.Count(ctx, userID)
-	if err != nil {
-		return false, fmt.Errorf("failed to check first order: %w", err)
-	}
-
-	// User can get bonus only if they have NO first orders
-	return count == 0, nil
-}
@@ -10,6 +10,7 @@ import (
 	"metargb/financial-service/internal/models"
 	"metargb/financial-service/internal/parsian"
 	"metargb/financial-service/internal/repository"
+	"metargb/shared/pkg/logger"
 )
 
 var (
@@ -18,11 +19,39 @@ var (
 	ErrOrderNotFound   = errors.New("order not found")
 	ErrPaymentFailed   = errors.New("payment request failed")
 	ErrUserNotEligible = errors.New("user not eligible to buy from store")
+	ErrPaymentRefused  = errors.New("payment refused by the fraud rules")
+
+	ErrInvalidCallbackToken = errors.New("callback token does not match order")
+	ErrCallbackReplayed     = errors.New("callback already processed")
+)
+
+// orderStatusPending is the status an order keeps until its Parsian callback
+// has been processed
+const orderStatusPending int32 = -138
+
+// orderStatusFraudDenied is stored on orders whose payment was refused because
+// the card is blocklisted. The payment is not verified, so the bank returns
+// the money.
+const orderStatusFraudDenied int32 = -2
+
+// Results recorded on processed_callbacks once a claimed callback is handled
+const (
+	callbackResultProcessing = "processing"
+	callbackResultPaid       = "paid"
+	callbackResultFailed     = "failed"
+	callbackResultError      = "error"
+)
+
+// Payment states announced to the user waiting on the payment page; paid is
+// announced by commercial-service once the order is settled
+const (
+	orderPaymentPending = "pending"
+	orderPaymentFailed  = "failed"
 )
 
 type OrderService interface {
-	CreateOrder(ctx context.Context, userID uint64, amount int32, asset string) (string, error)
-	HandleCallback(ctx context.Context, orderID uint64, status int32, token int64, additionalParams map[string]string) (string, error)
+	CreateOrder(ctx context.Context, userID uint64, amount int32, asset, ip, device string) (string, error)
+	HandleCallback(ctx context.Context, orderID uint64, status int32, token int64, additionalParams map[string]string, rawPayload string) (string, error)
 }
 
 type orderService struct {
@@ -30,17 +59,16 @@ type orderService struct {
 	transactionRepo repository.TransactionRepository
 	paymentRepo     repository.PaymentRepository
 	variableRepo    repository.VariableRepository
-	firstOrderRepo  repository.FirstOrderRepository
+	callbackRepo    repository.ProcessedCallbackRepository
 	parsianClient   ParsianClient // Interface for easier testing
+	commercial      CommercialPayments
 	orderPolicy     OrderPolicy
-	jalaliConverter JalaliConverter
 	merchantID      string
 	loanMerchantID  string
 	callbackURL     string
 	frontendURL     string
-	// TODO: Add gRPC clients for wallet and referral services
-	// walletClient    commercialpb.WalletServiceClient
-	// referralService ReferralService
+	sandbox         bool
+	log             *logger.Logger
 }
 
 type OrderConfig struct {
@@ -48,6 +76,9 @@ type OrderConfig struct {
 	ParsianLoanAccountMerchantID string
 	ParsianCallbackURL           string
 	FrontendURL                  string
+	// Sandbox simulates gateway approval/denial from the order amount instead
+	// of calling Parsian. For QA environments only.
+	Sandbox bool
 }
 
 func NewOrderService(
@@ -55,29 +86,32 @@ func NewOrderService(
 	transactionRepo repository.TransactionRepository,
 	paymentRepo repository.PaymentRepository,
 	variableRepo repository.VariableRepository,
-	firstOrderRepo repository.FirstOrderRepository,
+	callbackRepo repository.ProcessedCallbackRepository,
 	parsianClient ParsianClient,
+	commercial CommercialPayments,
 	orderPolicy OrderPolicy,
-	jalaliConverter JalaliConverter,
 	config OrderConfig,
+	log *logger.Logger,
 ) OrderService {
 	return &orderService{
 		orderRepo:       orderRepo,
 		transactionRepo: transactionRepo,
 		paymentRepo:     paymentRepo,
 		variableRepo:    variableRepo,
-		firstOrderRepo:  firstOrderRepo,
+		callbackRepo:    callbackRepo,
 		parsianClient:   parsianClient,
+		commercial:      commercial,
 		orderPolicy:     orderPolicy,
-		jalaliConverter: jalaliConverter,
 		merchantID:      config.ParsianMerchantID,
 		loanMerchantID:  config.ParsianLoanAccountMerchantID,
 		callbackURL:     config.ParsianCallbackURL,
 		frontendURL:     config.FrontendURL,
+		sandbox:         config.Sandbox,
+		log:             log,
 	}
 }
 
-func (s *orderService) CreateOrder(ctx context.Context, userID uint64, amount int32, asset string) (string, error) {
+func (s *orderService) CreateOrder(ctx context.Context, userID uint64, amount int32, asset, ip, device string) (string, error) {
 	// Validation
 	if amount < 1 {
 		return "", ErrInvalidAmount
@@ -97,6 +131,11 @@ func (s *orderService) CreateOrder(ctx context.Context, userID uint64, amount in
 		return "", ErrUserNotEligible
 	}
 
+	// Run the fraud rules before anything is created
+	if err := s.commercial.ScreenPayment(ctx, userID, asset, float64(amount), ip, device); err != nil {
+		return "", err
+	}
+
 	// Get conversion rate
 	rate, err := s.variableRepo.GetRate(ctx, asset)
 	if err != nil {
@@ -108,7 +147,7 @@ func (s *orderService) CreateOrder(ctx context.Context, userID uint64, amount in
 		UserID: userID,
 		Asset:  asset,
 		Amount: float64(amount),
-		Status: -138, // Default status per documentation
+		Status: orderStatusPending, // Default status per documentation
 	}
 
 	err = s.orderRepo.Create(ctx, order)
@@ -124,7 +163,7 @@ func (s *orderService) CreateOrder(ctx context.Context, userID uint64, amount in
 		Asset:       asset,
 		Amount:      float64(amount),
 		Action:      "deposit",
-		Status:      -138,
+		Status:      orderStatusPending,
 		PayableType: stringPtr("App\\Models\\Order"),
 		PayableID:   &order.ID,
 	}
@@ -153,7 +192,7 @@ func (s *orderService) CreateOrder(ctx context.Context, userID uint64, amount in
 		Originator:     "",
 	}
 
-	response, err := s.parsianClient.RequestPayment(params)
+	response, err := s.requestPayment(ctx, params, order)
 	if err != nil {
 		return "", fmt.Errorf("failed to request payment: %w", err)
 	}
@@ -168,14 +207,35 @@ func (s *orderService) CreateOrder(ctx context.Context, userID uint64, amount in
 	err = s.transactionRepo.Update(ctx, transaction)
 	if err != nil {
 		// Log error but don't fail - token is stored
-		fmt.Printf("Warning: failed to update transaction with token: %v\n", err)
+		s.log.Warn("Failed to update transaction with token", "order_id", order.ID, "transaction_id", transaction.ID, "error", err)
 	}
 
+	s.publishOrderStatus(ctx, order.ID, orderPaymentPending, "")
+
 	// Return payment URL
+	if s.sandbox {
+		return sandboxPaymentURL(s.callbackURL, order.ID, response.Token)
+	}
 	return response.URL(), nil
 }
 
-func (s *orderService) HandleCallback(ctx context.Context, orderID uint64, status int32, token int64, additionalParams map[string]string) (string, error) {
+// requestPayment sends the purchase request to Parsian, or simulates it in sandbox mode
+func (s *orderService) requestPayment(ctx context.Context, params parsian.RequestParams, order *models.Order) (*parsian.RequestResponse, error) {
+	if s.sandbox {
+		return sandboxRequestPayment(order.ID, order.Amount), nil
+	}
+	return s.parsianClient.RequestPayment(ctx, params)
+}
+
+// verifyPayment confirms a payment with Parsian, or simulates it in sandbox mode
+func (s *orderService) verifyPayment(ctx context.Context, params parsian.VerificationParams) (*parsian.VerificationResponse, error) {
+	if s.sandbox {
+		return sandboxVerifyPayment(params.Token), nil
+	}
+	return s.parsianClient.VerifyPayment(ctx, params)
+}
+
+func (s *orderService) HandleCallback(ctx context.Context, orderID uint64, status int32, token int64, additionalParams map[string]string, rawPayload string) (string, error) {
 	// Fetch order with user
 	order, _, err := s.orderRepo.FindByIDWithUser(ctx, orderID)
 	if err != nil {
@@ -194,6 +254,41 @@ func (s *orderService) HandleCallback(ctx context.Context, orderID uint64, statu
 		return "", fmt.Errorf("transaction not found for order")
 	}
 
+	// The token is issued to us by Parsian when the order is created, so a
+	// callback carrying any other token did not come from that payment
+	if transaction.Token == nil || *transaction.Token != token {
+		s.log.Warn("Rejected callback with mismatched token", "order_id", orderID)
+		return "", ErrInvalidCallbackToken
+	}
+
+	// Orders settled before replay protection existed have no claim row
+	if order.Status != orderStatusPending {
+		return "", ErrCallbackReplayed
+	}
+
+	callback := &models.ProcessedCallback{
+		Gateway:    "parsian",
+		OrderID:    orderID,
+		Token:      token,
+		Status:     status,
+		RawPayload: rawPayload,
+		Result:     callbackResultProcessing,
+	}
+	claimed, err := s.callbackRepo.Claim(ctx, callback)
+	if err != nil {
+		return "", fmt.Errorf("failed to record callback: %w", err)
+	}
+	if !claimed {
+		return "", ErrCallbackReplayed
+	}
+
+	result := callbackResultError
+	defer func() {
+		if err := s.callbackRepo.UpdateResult(ctx, callback.ID, result); err != nil {
+			s.log.Warn("Failed to record callback result", "order_id", orderID, "result", result, "error", err)
+		}
+	}()
+
 	// Build redirect URL with all query parameters
 	redirectURL := s.frontendURL + "/metaverse/payment/verify"
 	u, err := url.Parse(redirectURL)
@@ -214,6 +309,24 @@ func (s *orderService) HandleCallback(ctx context.Context, orderID uint64, statu
 
 	// If status == 0, verify payment
 	if status == 0 {
+		// A blocklisted card is refused before verification, so the bank
+		// returns the unverified payment
+		cardPan := callbackCardPan(additionalParams)
+		err := s.commercial.ScreenPaymentCard(ctx, order.UserID, order.ID, order.Asset, order.Amount, cardPan)
+		if errors.Is(err, ErrPaymentRefused) {
+			s.log.Warn("Refused payment with blocklisted card", "order_id", orderID, "user_id", order.UserID)
+			order.Status = orderStatusFraudDenied
+			s.orderRepo.Update(ctx, order)
+			transaction.Status = orderStatusFraudDenied
+			s.transactionRepo.Update(ctx, transaction)
+			result = callbackResultFailed
+			s.publishOrderStatus(ctx, order.ID, orderPaymentFailed, "Payment declined")
+			return u.String(), nil
+		}
+		if err != nil {
+			return u.String(), fmt.Errorf("failed to screen payment card: %w", err)
+		}
+
 		// Get rate to calculate amount in Rials
 		rate, err := s.variableRepo.GetRate(ctx, order.Asset)
 		if err != nil {
@@ -232,9 +345,16 @@ func (s *orderService) HandleCallback(ctx context.Context, orderID uint64, statu
 			Token:      token,
 		}
 
-		verifyResponse, err := s.parsianClient.VerifyPayment(verifyParams)
+		verifyResponse, err := s.verifyPayment(ctx, verifyParams)
+		if errors.Is(err, parsian.ErrConfirmOutcomeUnknown) {
+			// The bank may have settled the token, so the order stays pending
+			// until the payment is checked with the bank
+			s.log.Error("Parsian confirm outcome unknown, check the payment with the bank", "order_id", orderID, "token", token, "error", err)
+			return u.String(), nil
+		}
 		if err != nil {
 			// Verification failed - still redirect but don't update order
+			s.log.Warn("Failed to verify payment", "order_id", orderID, "error", err)
 			return u.String(), nil
 		}
 
@@ -255,45 +375,11 @@ func (s *orderService) HandleCallback(ctx context.Context, orderID uint64, statu
 				return u.String(), fmt.Errorf("failed to update transaction: %w", err)
 			}
 
-			// Check if user can get bonus
-			canGetBonus, err := s.orderPolicy.CanGetBonus(ctx, order.UserID, order.Asset)
-			if err != nil {
-				return u.String(), fmt.Errorf("failed to check bonus eligibility: %w", err)
-			}
-
 			amount := order.Amount * rate
 
-			if canGetBonus {
-				// First order bonus: 50%
-				bonus := order.Amount * 0.5
-				_ = order.Amount + bonus // totalAmount for future gRPC call
-
-				// TODO: Add balance via gRPC call to commercial-service
-				// walletClient.AddBalance(ctx, order.UserID, order.Asset, totalAmount)
-
-				// Create first order record
-				jalaliDate := s.jalaliConverter.NowJalali()
-				firstOrder := &models.FirstOrder{
-					UserID: order.UserID,
-					Type:   order.Asset,
-					Amount: order.Amount,
-					Date:   jalaliDate,
-					Bonus:  bonus,
-				}
-				err = s.firstOrderRepo.Create(ctx, firstOrder)
-				if err != nil {
-					fmt.Printf("Warning: failed to create first order record: %v\n", err)
-				}
-			} else {
-				// Regular order - add only order amount
-				// TODO: Add balance via gRPC call to commercial-service
-				// walletClient.AddBalance(ctx, order.UserID, order.Asset, order.Amount)
-			}
-
 			// Create payment record
-			cardPan := additionalParams["CardMaskPan"]
 			if cardPan == "" {
-				cardPan = additionalParams["card_pan"]
+				cardPan = verifyResponse.CardHash
 			}
 			payment := &models.Payment{
 				UserID:  order.UserID,
@@ -305,20 +391,24 @@ func (s *orderService) HandleCallback(ctx context.Context, orderID uint64, statu
 			}
 			err = s.paymentRepo.Create(ctx, payment)
 			if err != nil {
-				fmt.Printf("Warning: failed to create payment record: %v\n", err)
+				s.log.Warn("Failed to create payment record", "order_id", order.ID, "ref_id", verifyResponse.ReferenceID, "error", err)
 			}
 
-			// Process referral (only for non-irr assets)
-			if order.Asset != "irr" {
-				// TODO: Process referral via gRPC call to commercial-service
-				// referralService.ProcessReferralCommission(ctx, order.UserID, order)
+			// commercial-service credits the wallet, with the first order bonus
+			// its first_order_* variables grant, pays the referral commission
+			// of non-irr assets and announces the order paid
+			if err := s.commercial.SettleOrder(ctx, order.ID, verifyResponse.ReferenceID); err != nil {
+				return u.String(), fmt.Errorf("failed to settle order: %w", err)
 			}
+			result = callbackResultPaid
 
 			// TODO: Send notification and call user.deposit() via gRPC
 		} else {
 			// Verification failed - update order with status
 			order.Status = verifyResponse.Status
 			s.orderRepo.Update(ctx, order)
+			result = callbackResultFailed
+			s.publishOrderStatus(ctx, order.ID, orderPaymentFailed, verifyResponse.Error().Message())
 		}
 	} else {
 		// Payment failed (status != 0)
@@ -326,11 +416,30 @@ func (s *orderService) HandleCallback(ctx context.Context, orderID uint64, statu
 		s.orderRepo.Update(ctx, order)
 		transaction.Status = status
 		s.transactionRepo.Update(ctx, transaction)
+		result = callbackResultFailed
+		s.publishOrderStatus(ctx, order.ID, orderPaymentFailed, "Payment failed")
 	}
 
 	return u.String(), nil
 }
 
+// publishOrderStatus announces the order's payment state through
+// commercial-service. A lost event only means the payment page falls back to
+// polling, so errors are logged.
+func (s *orderService) publishOrderStatus(ctx context.Context, orderID uint64, status, message string) {
+	if err := s.commercial.PublishOrderStatus(ctx, orderID, status, message); err != nil {
+		s.log.Warn("Failed to publish order status", "order_id", orderID, "status", status, "error", err)
+	}
+}
+
+// callbackCardPan returns the masked card Parsian reports on the callback
+func callbackCardPan(additionalParams map[string]string) string {
+	if cardPan := additionalParams["CardMaskPan"]; cardPan != "" {
+		return cardPan
+	}
+	return additionalParams["card_pan"]
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
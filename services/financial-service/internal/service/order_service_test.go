@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"metargb/financial-service/internal/models"
+	"metargb/financial-service/internal/parsian"
+	"metargb/shared/pkg/logger"
+)
+
+type fakeOrderRepo struct {
+	order   *models.Order
+	updates int
+}
+
+func (f *fakeOrderRepo) Create(ctx context.Context, order *models.Order) error {
+	order.ID = 7
+	f.order = order
+	return nil
+}
+
+func (f *fakeOrderRepo) FindByID(ctx context.Context, id uint64) (*models.Order, error) {
+	return f.order, nil
+}
+
+func (f *fakeOrderRepo) FindByIDWithUser(ctx context.Context, id uint64) (*models.Order, *models.User, error) {
+	return f.order, &models.User{ID: f.order.UserID}, nil
+}
+
+func (f *fakeOrderRepo) Update(ctx context.Context, order *models.Order) error {
+	f.updates++
+	return nil
+}
+
+type fakeTransactionRepo struct {
+	transaction *models.Transaction
+}
+
+func (f *fakeTransactionRepo) Create(ctx context.Context, transaction *models.Transaction) error {
+	f.transaction = transaction
+	return nil
+}
+
+func (f *fakeTransactionRepo) Update(ctx context.Context, transaction *models.Transaction) error {
+	return nil
+}
+
+func (f *fakeTransactionRepo) FindByID(ctx context.Context, id string) (*models.Transaction, error) {
+	return f.transaction, nil
+}
+
+func (f *fakeTransactionRepo) FindByPayable(ctx context.Context, payableType string, payableID uint64) (*models.Transaction, error) {
+	return f.transaction, nil
+}
+
+type fakeVariableRepo struct{}
+
+func (fakeVariableRepo) GetRate(ctx context.Context, asset string) (float64, error) { return 1000, nil }
+
+type fakePaymentRepo struct {
+	payments []*models.Payment
+}
+
+func (f *fakePaymentRepo) Create(ctx context.Context, payment *models.Payment) error {
+	f.payments = append(f.payments, payment)
+	return nil
+}
+
+type fakeOrderPolicy struct{}
+
+func (fakeOrderPolicy) CanBuyFromStore(ctx context.Context, userID uint64) (bool, error) {
+	return true, nil
+}
+
+// fakeCommercial refuses the orders of the users and the cards it lists, and
+// records the orders it settles and the payment states it announces
+type fakeCommercial struct {
+	refusedUsers map[uint64]bool
+	refusedCards map[string]bool
+	settled      []uint64
+	published    []string
+}
+
+func (f *fakeCommercial) ScreenPayment(ctx context.Context, userID uint64, asset string, amount float64, ip, device string) error {
+	if f.refusedUsers[userID] {
+		return fmt.Errorf("%w: too many payments", ErrPaymentRefused)
+	}
+	return nil
+}
+
+func (f *fakeCommercial) ScreenPaymentCard(ctx context.Context, userID, orderID uint64, asset string, amount float64, cardPan string) error {
+	if f.refusedCards[cardPan] {
+		return fmt.Errorf("%w: card is blocklisted", ErrPaymentRefused)
+	}
+	return nil
+}
+
+func (f *fakeCommercial) SettleOrder(ctx context.Context, orderID uint64, refID int64) error {
+	f.settled = append(f.settled, orderID)
+	return nil
+}
+
+func (f *fakeCommercial) PublishOrderStatus(ctx context.Context, orderID uint64, status, message string) error {
+	f.published = append(f.published, status)
+	return nil
+}
+
+type fakeCallbackRepo struct {
+	claimed map[uint64]*models.ProcessedCallback
+	results map[uint64]string
+}
+
+func (f *fakeCallbackRepo) Claim(ctx context.Context, callback *models.ProcessedCallback) (bool, error) {
+	if _, ok := f.claimed[callback.OrderID]; ok {
+		return false, nil
+	}
+	callback.ID = uint64(len(f.claimed) + 1)
+	f.claimed[callback.OrderID] = callback
+	return true, nil
+}
+
+func (f *fakeCallbackRepo) UpdateResult(ctx context.Context, id uint64, result string) error {
+	f.results[id] = result
+	return nil
+}
+
+type fakeParsianClient struct {
+	verifications int
+	verifyErr     error
+}
+
+func (f *fakeParsianClient) RequestPayment(ctx context.Context, params parsian.RequestParams) (*parsian.RequestResponse, error) {
+	return nil, errors.New("not used")
+}
+
+func (f *fakeParsianClient) VerifyPayment(ctx context.Context, params parsian.VerificationParams) (*parsian.VerificationResponse, error) {
+	f.verifications++
+	if f.verifyErr != nil {
+		return nil, f.verifyErr
+	}
+	return nil, errors.New("gateway unavailable")
+}
+
+func newCallbackTestService(order *models.Order, transactionToken int64) (*orderService, *fakeOrderRepo, *fakeCallbackRepo) {
+	orderRepo := &fakeOrderRepo{order: order}
+	callbackRepo := &fakeCallbackRepo{
+		claimed: make(map[uint64]*models.ProcessedCallback),
+		results: make(map[uint64]string),
+	}
+	svc := &orderService{
+		orderRepo: orderRepo,
+		transactionRepo: &fakeTransactionRepo{transaction: &models.Transaction{
+			ID:    "TR-1",
+			Token: &transactionToken,
+		}},
+		variableRepo:  fakeVariableRepo{},
+		callbackRepo:  callbackRepo,
+		parsianClient: &fakeParsianClient{},
+		commercial:    &fakeCommercial{},
+		frontendURL:   "https://rgb.irpsc.com",
+		log:           logger.NewLogger("test"),
+	}
+	return svc, orderRepo, callbackRepo
+}
+
+func TestHandleCallback_RejectsForgedToken(t *testing.T) {
+	order := &models.Order{ID: 7, UserID: 1, Asset: "psc", Status: orderStatusPending}
+	svc, orderRepo, callbackRepo := newCallbackTestService(order, 456789)
+
+	_, err := svc.HandleCallback(context.Background(), 7, -1, 111111, nil, "OrderId=7&status=-1&Token=111111")
+	if !errors.Is(err, ErrInvalidCallbackToken) {
+		t.Fatalf("expected ErrInvalidCallbackToken, got %v", err)
+	}
+	if orderRepo.updates != 0 {
+		t.Errorf("forged callback must not update the order")
+	}
+	if len(callbackRepo.claimed) != 0 {
+		t.Errorf("forged callback must not be claimed")
+	}
+}
+
+func TestHandleCallback_ProcessesOnlyOnce(t *testing.T) {
+	order := &models.Order{ID: 7, UserID: 1, Asset: "psc", Status: orderStatusPending}
+	svc, orderRepo, callbackRepo := newCallbackTestService(order, 456789)
+	payload := "OrderId=7&status=-1&Token=456789"
+
+	if _, err := svc.HandleCallback(context.Background(), 7, -1, 456789, nil, payload); err != nil {
+		t.Fatalf("first callback failed: %v", err)
+	}
+	claimed := callbackRepo.claimed[7]
+	if claimed == nil || claimed.RawPayload != payload {
+		t.Fatalf("expected raw payload to be recorded, got %+v", claimed)
+	}
+	if got := callbackRepo.results[claimed.ID]; got != callbackResultFailed {
+		t.Errorf("expected result %q, got %q", callbackResultFailed, got)
+	}
+
+	// Replaying the same callback must not touch the order again, even if
+	// the order status were somehow still pending
+	order.Status = orderStatusPending
+	_, err := svc.HandleCallback(context.Background(), 7, -1, 456789, nil, payload)
+	if !errors.Is(err, ErrCallbackReplayed) {
+		t.Fatalf("expected ErrCallbackReplayed, got %v", err)
+	}
+	if orderRepo.updates != 1 {
+		t.Errorf("expected 1 order update, got %d", orderRepo.updates)
+	}
+}
+
+func TestHandleCallback_RejectsSettledOrder(t *testing.T) {
+	order := &models.Order{ID: 7, UserID: 1, Asset: "psc", Status: 0}
+	svc, _, callbackRepo := newCallbackTestService(order, 456789)
+
+	_, err := svc.HandleCallback(context.Background(), 7, 0, 456789, nil, "")
+	if !errors.Is(err, ErrCallbackReplayed) {
+		t.Fatalf("expected ErrCallbackReplayed, got %v", err)
+	}
+	if svc.parsianClient.(*fakeParsianClient).verifications != 0 {
+		t.Errorf("settled order must not be verified again")
+	}
+	if len(callbackRepo.claimed) != 0 {
+		t.Errorf("settled order callback must not be claimed")
+	}
+}
+
+func TestHandleCallback_KeepsOrderPendingWhenConfirmOutcomeUnknown(t *testing.T) {
+	order := &models.Order{ID: 7, UserID: 1, Asset: "psc", Status: orderStatusPending}
+	svc, orderRepo, callbackRepo := newCallbackTestService(order, 456789)
+	svc.parsianClient = &fakeParsianClient{verifyErr: fmt.Errorf("verification request failed: %w", parsian.ErrConfirmOutcomeUnknown)}
+
+	if _, err := svc.HandleCallback(context.Background(), 7, 0, 456789, nil, ""); err != nil {
+		t.Fatalf("callback failed: %v", err)
+	}
+	if orderRepo.updates != 0 || order.Status != orderStatusPending {
+		t.Errorf("order must stay pending until the payment is checked, status %d after %d updates", order.Status, orderRepo.updates)
+	}
+	if got := callbackRepo.results[callbackRepo.claimed[7].ID]; got != callbackResultError {
+		t.Errorf("expected result %q, got %q", callbackResultError, got)
+	}
+}
+
+func TestSandboxOrderSettlesThroughCallback(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    int32
+		status    int32
+		paid      bool
+		published []string
+	}{
+		// commercial-service announces the paid state when it settles
+		{name: "approved", amount: 250, status: 0, paid: true, published: []string{orderPaymentPending}},
+		{name: "user cancels", amount: 217, status: orderStatusPending, published: []string{orderPaymentPending, orderPaymentFailed}},
+		{name: "verification denied", amount: 231, status: -1531, published: []string{orderPaymentPending, orderPaymentFailed}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, orderRepo, _ := newCallbackTestService(nil, 0)
+			transactionRepo := &fakeTransactionRepo{}
+			paymentRepo := &fakePaymentRepo{}
+			svc.transactionRepo = transactionRepo
+			svc.paymentRepo = paymentRepo
+			svc.orderPolicy = fakeOrderPolicy{}
+			svc.callbackURL = "https://rgb.irpsc.com/api/parsian/callback"
+			svc.sandbox = true
+
+			link, err := svc.CreateOrder(context.Background(), 1, tt.amount, "psc", "", "")
+			if err != nil {
+				t.Fatalf("CreateOrder failed: %v", err)
+			}
+			u, err := url.Parse(link)
+			if err != nil || u.Path != "/api/parsian/callback" {
+				t.Fatalf("expected a link to the callback, got %q", link)
+			}
+
+			// Follow the link the way the gateway hands it to HandleCallback
+			q := u.Query()
+			status, _ := strconv.ParseInt(q.Get("status"), 10, 32)
+			token, _ := strconv.ParseInt(q.Get("Token"), 10, 64)
+			if _, err := svc.HandleCallback(context.Background(), 7, int32(status), token, nil, u.RawQuery); err != nil {
+				t.Fatalf("HandleCallback failed: %v", err)
+			}
+
+			if orderRepo.order.Status != tt.status {
+				t.Errorf("expected order status %d, got %d", tt.status, orderRepo.order.Status)
+			}
+			if tt.paid != (len(paymentRepo.payments) == 1) {
+				t.Fatalf("expected paid=%v, got %d payments", tt.paid, len(paymentRepo.payments))
+			}
+			if tt.paid && paymentRepo.payments[0].CardPan != sandboxCardHash {
+				t.Errorf("expected sandbox card, got %q", paymentRepo.payments[0].CardPan)
+			}
+			if settled := svc.commercial.(*fakeCommercial).settled; tt.paid != (len(settled) == 1) {
+				t.Errorf("expected paid=%v, got settled orders %v", tt.paid, settled)
+			}
+			if published := svc.commercial.(*fakeCommercial).published; !reflect.DeepEqual(published, tt.published) {
+				t.Errorf("expected announced states %v, got %v", tt.published, published)
+			}
+		})
+	}
+}
+
+func TestCreateOrder_RefusedByFraudRules(t *testing.T) {
+	svc, orderRepo, _ := newCallbackTestService(nil, 0)
+	svc.orderPolicy = fakeOrderPolicy{}
+	svc.commercial = &fakeCommercial{refusedUsers: map[uint64]bool{1: true}}
+
+	_, err := svc.CreateOrder(context.Background(), 1, 250, "psc", "10.0.0.1", "test-agent")
+	if !errors.Is(err, ErrPaymentRefused) {
+		t.Fatalf("expected ErrPaymentRefused, got %v", err)
+	}
+	if orderRepo.order != nil {
+		t.Errorf("refused payment must not create an order")
+	}
+}
+
+func TestHandleCallback_RefusesBlocklistedCard(t *testing.T) {
+	order := &models.Order{ID: 7, UserID: 1, Asset: "psc", Amount: 250, Status: orderStatusPending}
+	svc, orderRepo, callbackRepo := newCallbackTestService(order, 456789)
+	svc.commercial = &fakeCommercial{refusedCards: map[string]bool{"603799******1234": true}}
+
+	params := map[string]string{"CardMaskPan": "603799******1234"}
+	if _, err := svc.HandleCallback(context.Background(), 7, 0, 456789, params, ""); err != nil {
+		t.Fatalf("callback failed: %v", err)
+	}
+	if order.Status != orderStatusFraudDenied || orderRepo.updates != 1 {
+		t.Errorf("expected the order to be fraud denied, status %d after %d updates", order.Status, orderRepo.updates)
+	}
+	if svc.parsianClient.(*fakeParsianClient).verifications != 0 {
+		t.Errorf("payment with a blocklisted card must not be verified")
+	}
+	if got := callbackRepo.results[callbackRepo.claimed[7].ID]; got != callbackResultFailed {
+		t.Errorf("expected result %q, got %q", callbackResultFailed, got)
+	}
+}
@@ -1,10 +1,16 @@
 package service
 
-import "metargb/financial-service/internal/parsian"
+import (
+	"context"
+
+	"metargb/financial-service/internal/parsian"
+)
 
 // ParsianClient interface for payment gateway operations
 // Allows for easier testing with mocks
 type ParsianClient interface {
-	RequestPayment(params parsian.RequestParams) (*parsian.RequestResponse, error)
-	VerifyPayment(params parsian.VerificationParams) (*parsian.VerificationResponse, error)
+	RequestPayment(ctx context.Context, params parsian.RequestParams) (*parsian.RequestResponse, error)
+	// VerifyPayment does not resend a confirm that may have reached the
+	// gateway; such failures wrap parsian.ErrConfirmOutcomeUnknown
+	VerifyPayment(ctx context.Context, params parsian.VerificationParams) (*parsian.VerificationResponse, error)
 }
@@ -0,0 +1,136 @@
+package apiversion
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var deprecatedRequests = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "http_deprecated_requests_total",
+		Help: "Total number of requests to deprecated API versions or routes",
+	},
+	[]string{"version", "route"},
+)
+
+// Deprecation describes when a version or route stops being served
+type Deprecation struct {
+	// Since is when it was deprecated, sent in the Deprecation header
+	Since time.Time
+	// Sunset is when it will be removed, sent in the Sunset header if set
+	Sunset time.Time
+	// Successor is a link to its replacement, sent as rel="successor-version"
+	Successor string
+}
+
+// setHeaders writes the headers of RFC 9745 (Deprecation) and RFC 8594
+// (Sunset)
+func (d Deprecation) setHeaders(h http.Header) {
+	if d.Since.IsZero() {
+		h.Set("Deprecation", "true")
+	} else {
+		h.Set("Deprecation", fmt.Sprintf("@%d", d.Since.Unix()))
+	}
+	if !d.Sunset.IsZero() {
+		h.Set("Sunset", d.Sunset.UTC().Format(http.TimeFormat))
+	}
+	if d.Successor != "" {
+		h.Add("Link", fmt.Sprintf(`<%s>; rel="successor-version"`, d.Successor))
+	}
+}
+
+// Router registers versioned routes on a ServeMux
+type Router struct {
+	mux          *http.ServeMux
+	deprecations map[Version]Deprecation
+}
+
+// NewRouter returns a router registering routes on mux
+func NewRouter(mux *http.ServeMux) *Router {
+	return &Router{mux: mux, deprecations: map[Version]Deprecation{}}
+}
+
+// Deprecate marks every route of v as deprecated
+func (rt *Router) Deprecate(v Version, d Deprecation) {
+	rt.deprecations[v] = d
+}
+
+// Group returns the route group of v
+func (rt *Router) Group(v Version) *Group {
+	return &Group{router: rt, version: v}
+}
+
+// HandleFunc registers handler in every given version, e.g.
+//
+//	router.HandleFunc("GET /levels/{slug}", levels.GetLevel, apiversion.V1, apiversion.V2)
+func (rt *Router) HandleFunc(pattern string, handler http.HandlerFunc, versions ...Version) {
+	for _, v := range versions {
+		rt.Group(v).Handle(pattern, handler)
+	}
+}
+
+// Group registers the routes of one version under its prefix
+type Group struct {
+	router  *Router
+	version Version
+}
+
+// Version returns the group's version
+func (g *Group) Version() Version {
+	return g.version
+}
+
+// Handle registers handler for pattern, which may start with a method, e.g.
+// "GET /levels/{slug}" becomes "GET /api/v2/levels/{slug}" in the v2 group.
+// Requests carry the version in their context.
+func (g *Group) Handle(pattern string, handler http.Handler, opts ...RouteOption) {
+	route := routeOptions{}
+	if d, ok := g.router.deprecations[g.version]; ok {
+		route.deprecation = &d
+	}
+	for _, opt := range opts {
+		opt(&route)
+	}
+
+	full := g.pattern(pattern)
+	g.router.mux.Handle(full, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if route.deprecation != nil {
+			route.deprecation.setHeaders(w.Header())
+			deprecatedRequests.WithLabelValues(g.version.String(), full).Inc()
+		}
+		handler.ServeHTTP(w, r.WithContext(WithVersion(r.Context(), g.version)))
+	}))
+}
+
+// HandleFunc registers a handler function, see Handle
+func (g *Group) HandleFunc(pattern string, handler http.HandlerFunc, opts ...RouteOption) {
+	g.Handle(pattern, handler, opts...)
+}
+
+// pattern prefixes the path of a ServeMux pattern with the version prefix
+func (g *Group) pattern(pattern string) string {
+	method, path, ok := strings.Cut(pattern, " ")
+	if !ok {
+		return g.version.Prefix() + pattern
+	}
+	return method + " " + g.version.Prefix() + strings.TrimSpace(path)
+}
+
+// RouteOption configures a single route
+type RouteOption func(*routeOptions)
+
+type routeOptions struct {
+	deprecation *Deprecation
+}
+
+// Deprecated marks a single route as deprecated
+func Deprecated(d Deprecation) RouteOption {
+	return func(o *routeOptions) {
+		o.deprecation = &d
+	}
+}
@@ -0,0 +1,29 @@
+package apiversion
+
+import "net/http"
+
+// Shapes holds the marshalers that turn a gRPC response into the JSON body of
+// each version. A version without its own marshaler uses the one of the
+// newest older version, so a shape only needs adding when it changes.
+//
+//	var levelShapes = apiversion.Shapes[*levelspb.Level]{
+//		apiversion.V1: levelV1,
+//		apiversion.V2: levelV2,
+//	}
+//	writeJSON(w, http.StatusOK, levelShapes.Render(r, resp.Level))
+type Shapes[T any] map[Version]func(T) interface{}
+
+// Marshal shapes value for v
+func (s Shapes[T]) Marshal(v Version, value T) interface{} {
+	for ; v >= V1; v-- {
+		if marshal, ok := s[v]; ok {
+			return marshal(value)
+		}
+	}
+	return value
+}
+
+// Render shapes value for the version of r
+func (s Shapes[T]) Render(r *http.Request, value T) interface{} {
+	return s.Marshal(FromRequest(r), value)
+}
@@ -0,0 +1,83 @@
+// Package apiversion serves several versions of the REST API from the same
+// handlers.
+//
+// Each version is a route group under its own prefix (/api for v1, which the
+// 3D client still uses, and /api/v2). Handlers make the gRPC call once and
+// pick the response shape for the request's version with Shapes, so a new
+// version only adds a marshaler instead of a copy of the handler. Deprecated
+// versions and routes get Deprecation, Sunset and Link headers.
+package apiversion
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// Version is a REST API version
+type Version int
+
+const (
+	// V1 is served under /api and matches the Laravel API used by the 3D client
+	V1 Version = 1
+	// V2 is served under /api/v2
+	V2 Version = 2
+
+	// Latest is the newest version
+	Latest = V2
+)
+
+// String returns the version name, e.g. "v2"
+func (v Version) String() string {
+	switch v {
+	case V1:
+		return "v1"
+	case V2:
+		return "v2"
+	}
+	return "unknown"
+}
+
+// Prefix returns the path prefix the version is served under
+func (v Version) Prefix() string {
+	if v == V1 {
+		return "/api"
+	}
+	return "/api/" + v.String()
+}
+
+type contextKey struct{}
+
+// WithVersion returns a copy of ctx carrying v
+func WithVersion(ctx context.Context, v Version) context.Context {
+	return context.WithValue(ctx, contextKey{}, v)
+}
+
+// FromRequest returns the version of r: the version of the route group that
+// matched it, or else the one named by its path
+func FromRequest(r *http.Request) Version {
+	if v, ok := r.Context().Value(contextKey{}).(Version); ok {
+		return v
+	}
+	v, _ := split(r.URL.Path)
+	return v
+}
+
+// Path returns r's path without its version prefix, e.g. "/levels/gold" for
+// both /api/levels/gold and /api/v2/levels/gold
+func Path(r *http.Request) string {
+	_, rest := split(r.URL.Path)
+	return rest
+}
+
+// split separates the version prefix from path. Paths outside /api are
+// returned unchanged as V1.
+func split(path string) (Version, string) {
+	if rest, ok := strings.CutPrefix(path, V2.Prefix()); ok && (rest == "" || rest[0] == '/') {
+		return V2, rest
+	}
+	if rest, ok := strings.CutPrefix(path, V1.Prefix()); ok && (rest == "" || rest[0] == '/') {
+		return V1, rest
+	}
+	return V1, path
+}
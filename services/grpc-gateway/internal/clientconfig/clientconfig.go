@@ -0,0 +1,171 @@
+// Package clientconfig serves the branding and environment settings of each
+// frontend that talks to the gateway, so the web app and the Unity client can
+// share one backend with different URLs, locales and branding.
+//
+// A frontend names itself with the X-Client-Id header. Requests without it,
+// or with an id that is not configured, get the default client.
+package clientconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// Header is the request header naming the frontend
+const Header = "X-Client-Id"
+
+// DefaultClientID is the client used when no file names a default
+const DefaultClientID = "web"
+
+// Branding is what a frontend shows as the product's identity
+type Branding struct {
+	Name           string `json:"name"`
+	LogoURL        string `json:"logo_url"`
+	FaviconURL     string `json:"favicon_url"`
+	PrimaryColor   string `json:"primary_color"`
+	SecondaryColor string `json:"secondary_color"`
+	SupportEmail   string `json:"support_email"`
+}
+
+// Client is the configuration of one frontend
+type Client struct {
+	ID            string   `json:"id"`
+	AppURL        string   `json:"app_url"`
+	FrontEndURL   string   `json:"front_end_url"`
+	DefaultLocale string   `json:"default_locale"`
+	Locales       []string `json:"locales"`
+	Branding      Branding `json:"branding"`
+	// Settings holds anything else the frontend reads, e.g. a map tile URL
+	Settings map[string]string `json:"settings"`
+}
+
+// file is the format of CLIENT_CONFIG_FILE
+type file struct {
+	Default string             `json:"default"`
+	Clients map[string]*Client `json:"clients"`
+}
+
+// Registry holds the configured clients
+type Registry struct {
+	clients   map[string]*Client
+	defaultID string
+}
+
+// FromEnv builds the registry from CLIENT_CONFIG_FILE, a JSON file of
+// clients. Fields a client leaves empty fall back to APP_URL, FRONT_END_URL
+// and LOCALE, which alone make up the default client when no file is set.
+func FromEnv() (*Registry, error) {
+	base := Client{
+		AppURL:        strings.TrimSuffix(os.Getenv("APP_URL"), "/"),
+		FrontEndURL:   strings.TrimSuffix(os.Getenv("FRONT_END_URL"), "/"),
+		DefaultLocale: getEnv("LOCALE", "en"),
+	}
+
+	cfg := file{Default: DefaultClientID, Clients: map[string]*Client{DefaultClientID: {}}}
+	if path := os.Getenv("CLIENT_CONFIG_FILE"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client config: %w", err)
+		}
+		cfg = file{}
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse client config %s: %w", path, err)
+		}
+	}
+	return New(cfg.Default, cfg.Clients, base)
+}
+
+// New builds a registry of clients keyed by id, filling their empty fields
+// from base. defaultID must be one of them; it defaults to DefaultClientID.
+func New(defaultID string, clients map[string]*Client, base Client) (*Registry, error) {
+	if defaultID == "" {
+		defaultID = DefaultClientID
+	}
+	registry := &Registry{clients: make(map[string]*Client, len(clients)), defaultID: defaultID}
+	for id, client := range clients {
+		if id == "" || client == nil {
+			return nil, fmt.Errorf("client config: empty client entry %q", id)
+		}
+		resolved := *client
+		resolved.ID = id
+		if resolved.AppURL == "" {
+			resolved.AppURL = base.AppURL
+		}
+		if resolved.FrontEndURL == "" {
+			resolved.FrontEndURL = base.FrontEndURL
+		}
+		if resolved.DefaultLocale == "" {
+			resolved.DefaultLocale = base.DefaultLocale
+		}
+		if len(resolved.Locales) == 0 {
+			resolved.Locales = []string{resolved.DefaultLocale}
+		}
+		if resolved.Settings == nil {
+			resolved.Settings = map[string]string{}
+		}
+		registry.clients[id] = &resolved
+	}
+	if registry.clients[defaultID] == nil {
+		return nil, fmt.Errorf("client config: default client %q is not configured", defaultID)
+	}
+	return registry, nil
+}
+
+// Lookup returns the client with id, or the default client when id is empty
+// or unknown
+func (r *Registry) Lookup(id string) *Client {
+	if client, ok := r.clients[strings.TrimSpace(id)]; ok {
+		return client
+	}
+	return r.clients[r.defaultID]
+}
+
+type contextKey struct{}
+
+// Middleware resolves each request's client from the X-Client-Id header, for
+// handlers to read with FromRequest
+func (r *Registry) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		client := r.Lookup(req.Header.Get(Header))
+		next.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), contextKey{}, client)))
+	})
+}
+
+// FromRequest returns the client Middleware resolved for req, or nil when the
+// request did not pass through it
+func FromRequest(req *http.Request) *Client {
+	client, _ := req.Context().Value(contextKey{}).(*Client)
+	return client
+}
+
+// Handler serves GET /api/client-config with the requesting client's settings.
+// It needs no authentication, as frontends read it before the user logs in.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		client := FromRequest(req)
+		if client == nil {
+			client = r.Lookup(req.Header.Get(Header))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Add("Vary", Header)
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": client})
+	})
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -17,6 +17,7 @@ type Config struct {
 	SupportServiceAddr      string
 	NotificationServiceAddr string
 	StorageServiceAddr      string
+	StorageGRPCAddr         string
 	Locale                  string
 	AppURL                  string
 }
@@ -36,6 +37,7 @@ func Load() *Config {
 		SupportServiceAddr:      getEnv("SUPPORT_SERVICE_ADDR", "support-service:50056"),
 		NotificationServiceAddr: getEnv("NOTIFICATION_SERVICE_ADDR", "notifications-service:50058"),
 		StorageServiceAddr:      getEnv("STORAGE_SERVICE_ADDR", "storage-service:8059"),
+		StorageGRPCAddr:         getEnv("STORAGE_GRPC_ADDR", "storage-service:50060"),
 		Locale:                  locale,
 		AppURL:                  getEnv("APP_URL", ""),
 	}
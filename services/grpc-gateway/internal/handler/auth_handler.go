@@ -3,6 +3,8 @@ package handler
 
 import (
 	"bytes"
+	"crypto/rand"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,43 +12,58 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	"metargb/grpc-gateway/internal/middleware"
 	pb "metargb/shared/pb/auth"
+	authpkg "metargb/shared/pkg/auth"
 	"metargb/shared/pkg/helpers"
 )
 
 type AuthHandler struct {
-	authClient              pb.AuthServiceClient
-	userClient              pb.UserServiceClient
-	kycClient               pb.KYCServiceClient
-	citizenClient           pb.CitizenServiceClient
-	personalInfoClient      pb.PersonalInfoServiceClient
-	profileLimitationClient pb.ProfileLimitationServiceClient
-	profilePhotoClient      pb.ProfilePhotoServiceClient
-	settingsClient          pb.SettingsServiceClient
-	userEventsClient        pb.UserEventsServiceClient
-	searchClient            pb.SearchServiceClient
-	locale                  string
+	authClient                pb.AuthServiceClient
+	userClient                pb.UserServiceClient
+	kycClient                 pb.KYCServiceClient
+	citizenClient             pb.CitizenServiceClient
+	personalInfoClient        pb.PersonalInfoServiceClient
+	profileLimitationClient   pb.ProfileLimitationServiceClient
+	profilePhotoClient        pb.ProfilePhotoServiceClient
+	settingsClient            pb.SettingsServiceClient
+	userEventsClient          pb.UserEventsServiceClient
+	loginAlertClient          pb.LoginAlertServiceClient
+	loginHistoryClient        pb.LoginHistoryServiceClient
+	magicLinkClient           pb.MagicLinkServiceClient
+	emailVerificationClient   pb.EmailVerificationServiceClient
+	searchClient              pb.SearchServiceClient
+	apiKeyClient              pb.APIKeyServiceClient
+	personalAccessTokenClient pb.PersonalAccessTokenServiceClient
+	locale                    string
 }
 
 func NewAuthHandler(conn *grpc.ClientConn, locale string) *AuthHandler {
 	return &AuthHandler{
-		authClient:              pb.NewAuthServiceClient(conn),
-		userClient:              pb.NewUserServiceClient(conn),
-		kycClient:               pb.NewKYCServiceClient(conn),
-		citizenClient:           pb.NewCitizenServiceClient(conn),
-		personalInfoClient:      pb.NewPersonalInfoServiceClient(conn),
-		profileLimitationClient: pb.NewProfileLimitationServiceClient(conn),
-		profilePhotoClient:      pb.NewProfilePhotoServiceClient(conn),
-		settingsClient:          pb.NewSettingsServiceClient(conn),
-		userEventsClient:        pb.NewUserEventsServiceClient(conn),
-		searchClient:            pb.NewSearchServiceClient(conn),
-		locale:                  locale,
+		authClient:                middleware.AuthClient(conn),
+		userClient:                pb.NewUserServiceClient(conn),
+		kycClient:                 pb.NewKYCServiceClient(conn),
+		citizenClient:             pb.NewCitizenServiceClient(conn),
+		personalInfoClient:        pb.NewPersonalInfoServiceClient(conn),
+		profileLimitationClient:   pb.NewProfileLimitationServiceClient(conn),
+		profilePhotoClient:        pb.NewProfilePhotoServiceClient(conn),
+		settingsClient:            pb.NewSettingsServiceClient(conn),
+		userEventsClient:          pb.NewUserEventsServiceClient(conn),
+		loginAlertClient:          pb.NewLoginAlertServiceClient(conn),
+		loginHistoryClient:        pb.NewLoginHistoryServiceClient(conn),
+		magicLinkClient:           pb.NewMagicLinkServiceClient(conn),
+		emailVerificationClient:   pb.NewEmailVerificationServiceClient(conn),
+		searchClient:              pb.NewSearchServiceClient(conn),
+		apiKeyClient:              middleware.APIKeyClient(conn),
+		personalAccessTokenClient: pb.NewPersonalAccessTokenServiceClient(conn),
+		locale:                    locale,
 	}
 }
 
@@ -110,8 +127,10 @@ func (h *AuthHandler) Callback(w http.ResponseWriter, r *http.Request) {
 	code := r.URL.Query().Get("code")
 
 	grpcReq := &pb.CallbackRequest{
-		State: state,
-		Code:  code,
+		State:     state,
+		Code:      code,
+		Ip:        getClientIP(r),
+		UserAgent: r.UserAgent(),
 	}
 
 	resp, err := h.authClient.Callback(r.Context(), grpcReq)
@@ -132,6 +151,187 @@ func (h *AuthHandler) Callback(w http.ResponseWriter, r *http.Request) {
 	writeError(w, http.StatusInternalServerError, "redirect URL not configured (empty response from auth service)")
 }
 
+// RequestMagicLink handles POST /api/auth/magic-link
+// Emails a one-time login link. Responds the same whether or not the email
+// belongs to a user.
+func (h *AuthHandler) RequestMagicLink(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Email   string `json:"email"`
+		BackURL string `json:"back_url"`
+	}
+
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+	if strings.TrimSpace(req.Email) == "" {
+		writeValidationErrorWithLocale(w, "email is required", h.locale)
+		return
+	}
+
+	nonce, err := newMagicLinkNonce()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to start magic link login")
+		return
+	}
+
+	grpcReq := &pb.RequestMagicLinkRequest{
+		Email:       req.Email,
+		BackUrl:     req.BackURL,
+		Ip:          getClientIP(r),
+		UserAgent:   r.UserAgent(),
+		DeviceNonce: nonce,
+	}
+
+	if _, err := h.magicLinkClient.RequestMagicLink(r.Context(), grpcReq); err != nil {
+		h.writeGRPCErrorLocale(w, err)
+		return
+	}
+
+	setMagicLinkCookie(w, r, nonce, int(magicLinkCookieTTL.Seconds()))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// MagicLinkCallback handles GET /api/auth/magic-link/callback
+// Exchanges the emailed link for a token and redirects to the frontend like Callback
+func (h *AuthHandler) MagicLinkCallback(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid expires")
+		return
+	}
+
+	var nonce string
+	if cookie, err := r.Cookie(magicLinkCookie); err == nil {
+		nonce = cookie.Value
+	}
+
+	grpcReq := &pb.ConsumeMagicLinkRequest{
+		Token:       query.Get("token"),
+		Expires:     expires,
+		Signature:   query.Get("signature"),
+		Ip:          getClientIP(r),
+		UserAgent:   r.UserAgent(),
+		DeviceNonce: nonce,
+	}
+
+	resp, err := h.magicLinkClient.ConsumeMagicLink(r.Context(), grpcReq)
+	if err != nil {
+		h.writeGRPCErrorLocale(w, err)
+		return
+	}
+	// The link is used up, so is the nonce
+	setMagicLinkCookie(w, r, "", -1)
+
+	if resp.RedirectUrl != "" {
+		http.Redirect(w, r, resp.RedirectUrl, http.StatusFound)
+		return
+	}
+
+	writeError(w, http.StatusInternalServerError, "redirect URL not configured (empty response from auth service)")
+}
+
+// magicLinkCookie holds the random nonce a magic link is bound to. Only the
+// browser that requested the link sends it back, which the user agent, known
+// to anyone who can read the request, could not guarantee.
+const magicLinkCookie = "magic_link_device"
+
+// magicLinkCookieTTL matches the lifetime of the link in auth-service
+const magicLinkCookieTTL = 15 * time.Minute
+
+func newMagicLinkNonce() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// setMagicLinkCookie sets the nonce cookie, or deletes it when maxAge is
+// negative. It is only sent to the magic link routes, and also on the top
+// level navigation of opening the emailed link.
+func setMagicLinkCookie(w http.ResponseWriter, r *http.Request, nonce string, maxAge int) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     magicLinkCookie,
+		Value:    nonce,
+		Path:     "/api/auth/magic-link",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   r.TLS != nil || strings.EqualFold(r.Header.Get("X-Forwarded-Proto"), "https"),
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// SendEmailVerification handles POST /api/email/verification-notification
+// Emails the authenticated user a link that verifies their email address
+func (h *AuthHandler) SendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	grpcReq := &pb.SendEmailVerificationRequest{
+		UserId: userCtx.UserID,
+	}
+
+	if _, err := h.emailVerificationClient.SendEmailVerification(r.Context(), grpcReq); err != nil {
+		h.writeGRPCErrorLocale(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// VerifyEmail handles GET /api/email/verify/{id}/{hash}
+// Verifies the emailed link and redirects to the frontend like MagicLinkCallback
+func (h *AuthHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	pathParts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/email/verify/"), "/"), "/")
+	if len(pathParts) != 2 || pathParts[1] == "" {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	userID, err := strconv.ParseUint(pathParts[0], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	query := r.URL.Query()
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid expires")
+		return
+	}
+
+	grpcReq := &pb.VerifyEmailRequest{
+		UserId:    userID,
+		Hash:      pathParts[1],
+		Expires:   expires,
+		Signature: query.Get("signature"),
+	}
+
+	resp, err := h.emailVerificationClient.VerifyEmail(r.Context(), grpcReq)
+	if err != nil {
+		h.writeGRPCErrorLocale(w, err)
+		return
+	}
+
+	if resp.RedirectUrl != "" {
+		http.Redirect(w, r, resp.RedirectUrl, http.StatusFound)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"already_verified": resp.AlreadyVerified})
+}
+
 // GetMe handles POST /api/auth/me
 func (h *AuthHandler) GetMe(w http.ResponseWriter, r *http.Request) {
 	// Get user from context (set by auth middleware)
@@ -165,6 +365,9 @@ func (h *AuthHandler) GetMe(w http.ResponseWriter, r *http.Request) {
 			"hourly_profit_time_percentage":  resp.HourlyProfitTimePercentage,
 			"verified_kyc":                   resp.VerifiedKyc,
 			"birthdate":                      resp.Birthdate,
+			"email_verified":                 resp.EmailVerified,
+			"phone_verified":                 resp.PhoneVerified,
+			"must_verify_email":              resp.MustVerifyEmail,
 		},
 	}
 
@@ -591,41 +794,7 @@ func (h *AuthHandler) GetUserLevels(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Format response according to Laravel API spec
-	data := map[string]interface{}{}
-
-	if resp.Data.LatestLevel != nil {
-		latestLevel := map[string]interface{}{
-			"id":    resp.Data.LatestLevel.Id,
-			"name":  resp.Data.LatestLevel.Title,
-			"score": resp.Data.LatestLevel.Score,
-			"slug":  resp.Data.LatestLevel.Slug,
-		}
-		if resp.Data.LatestLevel.ImageUrl != "" {
-			latestLevel["image"] = resp.Data.LatestLevel.ImageUrl
-		}
-		data["latest_level"] = latestLevel
-	} else {
-		data["latest_level"] = nil
-	}
-
-	previousLevels := make([]map[string]interface{}, 0, len(resp.Data.PreviousLevels))
-	for _, level := range resp.Data.PreviousLevels {
-		levelData := map[string]interface{}{
-			"id":    level.Id,
-			"name":  level.Title,
-			"score": level.Score,
-			"slug":  level.Slug,
-		}
-		if level.ImageUrl != "" {
-			levelData["image"] = level.ImageUrl
-		}
-		previousLevels = append(previousLevels, levelData)
-	}
-	data["previous_levels"] = previousLevels
-	data["score_percentage_to_next_level"] = resp.Data.ScorePercentageToNextLevel
-
-	writeJSON(w, http.StatusOK, map[string]interface{}{"data": data})
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": formatUserLevels(resp.Data)})
 }
 
 // GetUserProfile handles GET /api/users/{user}/profile
@@ -674,28 +843,71 @@ func (h *AuthHandler) GetUserProfile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Format response according to Laravel ProfileResource spec
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": formatUserProfile(resp.Data)})
+}
+
+// formatUserLevels formats a user level ladder as the Laravel API does
+func formatUserLevels(levels *pb.UserLevelData) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	if levels.LatestLevel != nil {
+		latestLevel := map[string]interface{}{
+			"id":    levels.LatestLevel.Id,
+			"name":  levels.LatestLevel.Title,
+			"score": levels.LatestLevel.Score,
+			"slug":  levels.LatestLevel.Slug,
+		}
+		if levels.LatestLevel.ImageUrl != "" {
+			latestLevel["image"] = levels.LatestLevel.ImageUrl
+		}
+		data["latest_level"] = latestLevel
+	} else {
+		data["latest_level"] = nil
+	}
+
+	previousLevels := make([]map[string]interface{}, 0, len(levels.PreviousLevels))
+	for _, level := range levels.PreviousLevels {
+		levelData := map[string]interface{}{
+			"id":    level.Id,
+			"name":  level.Title,
+			"score": level.Score,
+			"slug":  level.Slug,
+		}
+		if level.ImageUrl != "" {
+			levelData["image"] = level.ImageUrl
+		}
+		previousLevels = append(previousLevels, levelData)
+	}
+	data["previous_levels"] = previousLevels
+	data["score_percentage_to_next_level"] = levels.ScorePercentageToNextLevel
+
+	return data
+}
+
+// formatUserProfile formats a privacy filtered profile as Laravel's
+// ProfileResource
+func formatUserProfile(profile *pb.UserProfileData) map[string]interface{} {
 	data := map[string]interface{}{
-		"id":             resp.Data.Id,
-		"code":           resp.Data.Code,
-		"profile_images": resp.Data.ProfileImages,
+		"id":             profile.Id,
+		"code":           profile.Code,
+		"profile_images": profile.ProfileImages,
 	}
 
 	// Add optional fields (may be empty/null if privacy disallows)
-	if resp.Data.Name != "" {
-		data["name"] = resp.Data.Name
+	if profile.Name != "" {
+		data["name"] = profile.Name
 	}
-	if resp.Data.RegisteredAt != "" {
-		data["registered_at"] = resp.Data.RegisteredAt
+	if profile.RegisteredAt != "" {
+		data["registered_at"] = profile.RegisteredAt
 	}
-	if resp.Data.FollowersCount != 0 {
-		data["followers_count"] = resp.Data.FollowersCount
+	if profile.FollowersCount != 0 {
+		data["followers_count"] = profile.FollowersCount
 	}
-	if resp.Data.FollowingCount != 0 {
-		data["following_count"] = resp.Data.FollowingCount
+	if profile.FollowingCount != 0 {
+		data["following_count"] = profile.FollowingCount
 	}
 
-	writeJSON(w, http.StatusOK, map[string]interface{}{"data": data})
+	return data
 }
 
 // GetUserWallet handles GET /api/users/{user}/wallet
@@ -788,14 +1000,16 @@ func (h *AuthHandler) GetUserFeaturesCount(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	// Format response according to Laravel API spec
-	data := map[string]interface{}{
-		"maskoni_features_count":   resp.Data.MaskoniFeaturesCount,
-		"tejari_features_count":    resp.Data.TejariFeaturesCount,
-		"amoozeshi_features_count": resp.Data.AmoozeshiFeaturesCount,
-	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": formatUserFeaturesCount(resp.Data)})
+}
 
-	writeJSON(w, http.StatusOK, map[string]interface{}{"data": data})
+// formatUserFeaturesCount formats a user's feature counts by karbari
+func formatUserFeaturesCount(counts *pb.UserFeaturesCountData) map[string]interface{} {
+	return map[string]interface{}{
+		"maskoni_features_count":   counts.MaskoniFeaturesCount,
+		"tejari_features_count":    counts.TejariFeaturesCount,
+		"amoozeshi_features_count": counts.AmoozeshiFeaturesCount,
+	}
 }
 
 // GetKYC handles GET /api/kyc
@@ -903,17 +1117,17 @@ func (h *AuthHandler) UpdateKYC(w http.ResponseWriter, r *http.Request) {
 
 	// Build gRPC request
 	grpcReq := &pb.UpdateKYCRequest{
-		UserId:                userCtx.UserID,
-		Fname:                 fname,
-		Lname:                  lname,
-		MelliCode:              melliCode,
-		Birthdate:              birthdate,
-		Province:               province,
-		MelliCardData:          melliCardData,
-		MelliCardFilename:      header.Filename,
-		MelliCardContentType:   header.Header.Get("Content-Type"),
-		VerifyTextId:           verifyTextID,
-		Gender:                 gender,
+		UserId:               userCtx.UserID,
+		Fname:                fname,
+		Lname:                lname,
+		MelliCode:            melliCode,
+		Birthdate:            birthdate,
+		Province:             province,
+		MelliCardData:        melliCardData,
+		MelliCardFilename:    header.Filename,
+		MelliCardContentType: header.Header.Get("Content-Type"),
+		VerifyTextId:         verifyTextID,
+		Gender:               gender,
 	}
 
 	// Add video info if provided
@@ -1506,7 +1720,7 @@ func populateStructFromQuery(queryValues map[string][]string, v interface{}) err
 			// For non-slice fields, get the first value
 			value := values[0]
 			if err := setFieldValue(fieldValue, value); err != nil {
-				return fmt.Errorf("failed to set field %s: %w", fieldName, err)
+				return &fieldDecodeError{field: fieldName, kind: fieldValue.Kind(), err: err}
 			}
 		}
 	}
@@ -1570,7 +1784,7 @@ func populateStructFromForm(formValues map[string][]string, v interface{}) error
 
 		// Set the field value based on its type
 		if err := setFieldValue(fieldValue, value); err != nil {
-			return fmt.Errorf("failed to set field %s: %w", fieldName, err)
+			return &fieldDecodeError{field: fieldName, kind: fieldValue.Kind(), err: err}
 		}
 	}
 
@@ -1672,6 +1886,8 @@ func writeGRPCErrorWithLocale(w http.ResponseWriter, err error, locale string) {
 		writeError(w, http.StatusConflict, st.Message())
 	case codes.FailedPrecondition:
 		writeError(w, http.StatusPreconditionFailed, st.Message())
+	case codes.ResourceExhausted:
+		writeError(w, http.StatusTooManyRequests, st.Message())
 	case codes.Unavailable:
 		// Service unavailable - likely connection issue
 		writeError(w, http.StatusServiceUnavailable, "service temporarily unavailable: "+st.Message())
@@ -1707,28 +1923,6 @@ func getClientIP(r *http.Request) string {
 	return remoteAddr
 }
 
-// extractTokenFromHeader extracts Bearer token from Authorization header
-func extractTokenFromHeader(r *http.Request) string {
-	authHeader := r.Header.Get("Authorization")
-	if authHeader == "" {
-		// Try cookie as fallback
-		cookie, err := r.Cookie("token")
-		if err == nil && cookie != nil {
-			return cookie.Value
-		}
-		return ""
-	}
-
-	// Check for Bearer token format
-	const bearerPrefix = "Bearer "
-	if !strings.HasPrefix(authHeader, bearerPrefix) {
-		// If no Bearer prefix, assume the whole header is the token
-		return authHeader
-	}
-
-	return strings.TrimPrefix(authHeader, bearerPrefix)
-}
-
 func extractIDFromPath(path, prefix string) string {
 	if !strings.HasPrefix(path, prefix) {
 		return ""
@@ -2396,8 +2590,44 @@ func (h *AuthHandler) UploadProfilePhoto(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Format response to match Laravel ProfilePhotoResource: { "id": ..., "url": ... }
-	writeJSON(w, http.StatusCreated, resp)
+	// The photo is processed in the background: { "upload_id": ..., "status": "processing" }
+	// Poll GetProfilePhotoStatus or wait for the profile-photo-status-changed socket event
+	writeJSON(w, http.StatusAccepted, resp)
+}
+
+// GetProfilePhotoStatus handles GET /api/profilePhotos/uploads/{upload}
+func (h *AuthHandler) GetProfilePhotoStatus(w http.ResponseWriter, r *http.Request) {
+	// Get user from context (set by auth middleware)
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	uploadIDStr := extractIDFromPath(r.URL.Path, "/api/profilePhotos/uploads/")
+	if uploadIDStr == "" {
+		writeError(w, http.StatusBadRequest, "upload_id is required")
+		return
+	}
+
+	uploadID, err := strconv.ParseUint(uploadIDStr, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid upload_id")
+		return
+	}
+
+	grpcReq := &pb.GetPhotoStatusRequest{
+		UserId:   userCtx.UserID,
+		UploadId: uploadID,
+	}
+
+	resp, err := h.profilePhotoClient.GetPhotoStatus(r.Context(), grpcReq)
+	if err != nil {
+		h.writeGRPCErrorLocale(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
 }
 
 // GetProfilePhoto handles GET /api/profilePhotos/{profilePhoto}
@@ -2486,10 +2716,11 @@ func (h *AuthHandler) GetSettings(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Response format: { "checkout_days_count": ..., "automatic_logout": ... }
+	// Response format: { "checkout_days_count": ..., "automatic_logout": ..., "timezone": ... }
 	response := map[string]interface{}{
 		"checkout_days_count": resp.Data.CheckoutDaysCount,
 		"automatic_logout":    resp.Data.AutomaticLogout,
+		"timezone":            resp.Data.Timezone,
 	}
 
 	writeJSON(w, http.StatusOK, response)
@@ -2507,8 +2738,9 @@ func (h *AuthHandler) UpdateSettings(w http.ResponseWriter, r *http.Request) {
 	var req struct {
 		CheckoutDaysCount uint32 `json:"checkout_days_count"`
 		AutomaticLogout   int32  `json:"automatic_logout"`
-		Setting           string `json:"setting"` // "status", "level", or "details"
-		Status            bool   `json:"status"`  // boolean value
+		Setting           string `json:"setting"`  // "status", "level", or "details"
+		Status            bool   `json:"status"`   // boolean value
+		Timezone          string `json:"timezone"` // IANA name such as "Europe/Berlin"
 	}
 
 	if err := decodeRequestBody(r, &req); err != nil {
@@ -2543,6 +2775,7 @@ func (h *AuthHandler) UpdateSettings(w http.ResponseWriter, r *http.Request) {
 		AutomaticLogout:   0, // Will be set properly by handler logic
 		Setting:           "",
 		Status:            false,
+		Timezone:          req.Timezone,
 	}
 
 	// Set values if provided
@@ -2997,6 +3230,175 @@ func (h *AuthHandler) CloseEventReport(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// ExportUserEvents handles GET /api/events/export
+// Streams the events as a CSV download. Query parameters: user_id (compliance
+// admins only), from_date and to_date (YYYY-MM-DD, inclusive).
+func (h *AuthHandler) ExportUserEvents(w http.ResponseWriter, r *http.Request) {
+	// Get user from context (set by auth middleware)
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	query := r.URL.Query()
+	grpcReq := &pb.ExportUserEventsRequest{
+		RequesterId: userCtx.UserID,
+		FromDate:    query.Get("from_date"),
+		ToDate:      query.Get("to_date"),
+	}
+	if userIDStr := query.Get("user_id"); userIDStr != "" {
+		userID, err := strconv.ParseUint(userIDStr, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid user_id")
+			return
+		}
+		grpcReq.UserId = userID
+	}
+
+	stream, err := h.userEventsClient.ExportUserEvents(r.Context(), grpcReq)
+	if err != nil {
+		h.writeGRPCErrorLocale(w, err)
+		return
+	}
+
+	// The service reports errors before the first chunk, so wait for it before
+	// committing to a CSV response
+	chunk, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		h.writeGRPCErrorLocale(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="user-events.csv"`)
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+	for err == nil {
+		if _, writeErr := w.Write(chunk.Data); writeErr != nil {
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		chunk, err = stream.Recv()
+	}
+	// Headers are already sent, so a failure midway can only cut the download short
+}
+
+// ListLoginAlerts handles GET /api/events/login-alerts
+func (h *AuthHandler) ListLoginAlerts(w http.ResponseWriter, r *http.Request) {
+	// Get user from context (set by auth middleware)
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	grpcReq := &pb.ListLoginAlertsRequest{
+		UserId:      userCtx.UserID,
+		PendingOnly: r.URL.Query().Get("pending") == "1" || r.URL.Query().Get("pending") == "true",
+	}
+
+	resp, err := h.loginAlertClient.ListLoginAlerts(r.Context(), grpcReq)
+	if err != nil {
+		h.writeGRPCErrorLocale(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": resp.Data,
+	})
+}
+
+// GetLoginHistory handles GET /api/auth/login-history
+func (h *AuthHandler) GetLoginHistory(w http.ResponseWriter, r *http.Request) {
+	// Get user from context (set by auth middleware)
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	page, perPage := parsePagination(r, 1, 0)
+	resp, err := h.loginHistoryClient.GetLoginHistory(r.Context(), &pb.GetLoginHistoryRequest{
+		UserId:  userCtx.UserID,
+		Page:    page,
+		PerPage: perPage,
+	})
+	if err != nil {
+		h.writeGRPCErrorLocale(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": resp.Data,
+		"links": map[string]interface{}{
+			"next": resp.Pagination.GetNextPageUrl(),
+			"prev": resp.Pagination.GetPrevPageUrl(),
+		},
+		"meta": map[string]interface{}{
+			"current_page": resp.Pagination.GetCurrentPage(),
+		},
+	})
+}
+
+// ConfirmLoginAlert handles POST /api/events/login-alerts/{alert}/confirm
+func (h *AuthHandler) ConfirmLoginAlert(w http.ResponseWriter, r *http.Request) {
+	// Get user from context (set by auth middleware)
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	// Extract alert ID from path: /api/events/login-alerts/{alert}/confirm
+	alertIDStr := strings.TrimSuffix(extractIDFromPath(r.URL.Path, "/api/events/login-alerts/"), "/confirm")
+	if alertIDStr == "" {
+		writeError(w, http.StatusBadRequest, "alert_id is required")
+		return
+	}
+
+	alertID, err := strconv.ParseUint(alertIDStr, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid alert_id")
+		return
+	}
+
+	var req struct {
+		WasMe *bool `json:"was_me"`
+	}
+
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+	if req.WasMe == nil {
+		writeValidationErrorWithLocale(w, "was_me is required", h.locale)
+		return
+	}
+
+	grpcReq := &pb.ConfirmLoginAlertRequest{
+		UserId:  userCtx.UserID,
+		AlertId: alertID,
+		WasMe:   *req.WasMe,
+	}
+
+	resp, err := h.loginAlertClient.ConfirmLoginAlert(r.Context(), grpcReq)
+	if err != nil {
+		h.writeGRPCErrorLocale(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": resp.Data,
+	})
+}
+
 // SearchUsers handles POST /api/search/users
 func (h *AuthHandler) SearchUsers(w http.ResponseWriter, r *http.Request) {
 	var req struct {
@@ -3141,3 +3543,278 @@ func (h *AuthHandler) SearchIsicCodes(w http.ResponseWriter, r *http.Request) {
 		"data": responseData,
 	})
 }
+
+// ============================================================================
+// API Key Service Handlers
+// ============================================================================
+
+// requireTokenUser returns the authenticated user, rejecting API key and scoped
+// token callers so that a leaked credential cannot be used to mint or manage
+// other credentials
+func requireTokenUser(w http.ResponseWriter, r *http.Request) (uint64, bool) {
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return 0, false
+	}
+	if userCtx.IsAPIKey() {
+		writeError(w, http.StatusForbidden, "api keys cannot manage api keys")
+		return 0, false
+	}
+	if !userCtx.HasScope(authpkg.ScopeAll) {
+		writeError(w, http.StatusForbidden, "scoped tokens cannot manage api keys or tokens")
+		return 0, false
+	}
+	return userCtx.UserID, true
+}
+
+// ListAPIKeys handles GET /api/api-keys
+func (h *AuthHandler) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireTokenUser(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.apiKeyClient.ListAPIKeys(r.Context(), &pb.ListAPIKeysRequest{UserId: userID})
+	if err != nil {
+		h.writeGRPCErrorLocale(w, err)
+		return
+	}
+
+	data := make([]map[string]interface{}, 0, len(resp.Data))
+	for _, key := range resp.Data {
+		data = append(data, formatAPIKey(key))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": data})
+}
+
+// CreateAPIKey handles POST /api/api-keys
+func (h *AuthHandler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireTokenUser(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Name               string   `json:"name"`
+		Scopes             []string `json:"scopes"`
+		RateLimitPerMinute int32    `json:"rate_limit_per_minute"`
+		ExpiresAt          string   `json:"expires_at"`
+	}
+
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	resp, err := h.apiKeyClient.CreateAPIKey(r.Context(), &pb.CreateAPIKeyRequest{
+		UserId:             userID,
+		Name:               req.Name,
+		Scopes:             req.Scopes,
+		RateLimitPerMinute: req.RateLimitPerMinute,
+		ExpiresAt:          req.ExpiresAt,
+	})
+	if err != nil {
+		h.writeGRPCErrorLocale(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, formatAPIKeySecret(resp))
+}
+
+// HandleAPIKeyRoutes handles /api/api-keys/{id} and /api/api-keys/{id}/rotate
+func (h *AuthHandler) HandleAPIKeyRoutes(w http.ResponseWriter, r *http.Request) {
+	keyPath := strings.Trim(extractIDFromPath(r.URL.Path, "/api/api-keys/"), "/")
+	parts := strings.Split(keyPath, "/")
+
+	keyID, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil || keyID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid api key id")
+		return
+	}
+
+	switch {
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		h.RevokeAPIKey(w, r, keyID)
+	case len(parts) == 2 && parts[1] == "rotate" && r.Method == http.MethodPost:
+		h.RotateAPIKey(w, r, keyID)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// RotateAPIKey handles POST /api/api-keys/{id}/rotate
+func (h *AuthHandler) RotateAPIKey(w http.ResponseWriter, r *http.Request, keyID uint64) {
+	userID, ok := requireTokenUser(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.apiKeyClient.RotateAPIKey(r.Context(), &pb.RotateAPIKeyRequest{
+		UserId: userID,
+		KeyId:  keyID,
+	})
+	if err != nil {
+		h.writeGRPCErrorLocale(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, formatAPIKeySecret(resp))
+}
+
+// RevokeAPIKey handles DELETE /api/api-keys/{id}
+func (h *AuthHandler) RevokeAPIKey(w http.ResponseWriter, r *http.Request, keyID uint64) {
+	userID, ok := requireTokenUser(w, r)
+	if !ok {
+		return
+	}
+
+	_, err := h.apiKeyClient.RevokeAPIKey(r.Context(), &pb.RevokeAPIKeyRequest{
+		UserId: userID,
+		KeyId:  keyID,
+	})
+	if err != nil {
+		h.writeGRPCErrorLocale(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ============================================================================
+// Personal Access Token Service Handlers
+// ============================================================================
+
+// ListTokenScopes handles GET /api/personal-access-tokens/scopes
+func (h *AuthHandler) ListTokenScopes(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.personalAccessTokenClient.ListTokenScopes(r.Context(), &emptypb.Empty{})
+	if err != nil {
+		h.writeGRPCErrorLocale(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": resp.Scopes})
+}
+
+// ListPersonalAccessTokens handles GET /api/personal-access-tokens
+func (h *AuthHandler) ListPersonalAccessTokens(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireTokenUser(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.personalAccessTokenClient.ListPersonalAccessTokens(r.Context(), &pb.ListPersonalAccessTokensRequest{UserId: userID})
+	if err != nil {
+		h.writeGRPCErrorLocale(w, err)
+		return
+	}
+
+	data := make([]map[string]interface{}, 0, len(resp.Data))
+	for _, token := range resp.Data {
+		data = append(data, formatPersonalAccessToken(token))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": data})
+}
+
+// CreatePersonalAccessToken handles POST /api/personal-access-tokens
+func (h *AuthHandler) CreatePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireTokenUser(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Name      string   `json:"name"`
+		Scopes    []string `json:"scopes"`
+		ExpiresAt string   `json:"expires_at"`
+	}
+
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	resp, err := h.personalAccessTokenClient.CreatePersonalAccessToken(r.Context(), &pb.CreatePersonalAccessTokenRequest{
+		UserId:    userID,
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		h.writeGRPCErrorLocale(w, err)
+		return
+	}
+
+	data := formatPersonalAccessToken(resp.Data)
+	data["token"] = resp.Token
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"data": data})
+}
+
+// RevokePersonalAccessToken handles DELETE /api/personal-access-tokens/{id}
+func (h *AuthHandler) RevokePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireTokenUser(w, r)
+	if !ok {
+		return
+	}
+
+	tokenID, err := strconv.ParseUint(strings.Trim(extractIDFromPath(r.URL.Path, "/api/personal-access-tokens/"), "/"), 10, 64)
+	if err != nil || tokenID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid token id")
+		return
+	}
+
+	_, err = h.personalAccessTokenClient.RevokePersonalAccessToken(r.Context(), &pb.RevokePersonalAccessTokenRequest{
+		UserId:  userID,
+		TokenId: tokenID,
+	})
+	if err != nil {
+		h.writeGRPCErrorLocale(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// formatPersonalAccessToken formats token metadata for JSON responses
+func formatPersonalAccessToken(token *pb.PersonalAccessToken) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           token.Id,
+		"name":         token.Name,
+		"scopes":       token.Scopes,
+		"last_used_at": token.LastUsedAt,
+		"expires_at":   token.ExpiresAt,
+		"created_at":   token.CreatedAt,
+	}
+}
+
+// formatAPIKey formats API key metadata for JSON responses
+func formatAPIKey(key *pb.APIKey) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                    key.Id,
+		"name":                  key.Name,
+		"prefix":                key.Prefix,
+		"scopes":                key.Scopes,
+		"rate_limit_per_minute": key.RateLimitPerMinute,
+		"last_used_at":          key.LastUsedAt,
+		"expires_at":            key.ExpiresAt,
+		"revoked_at":            key.RevokedAt,
+		"created_at":            key.CreatedAt,
+	}
+}
+
+// formatAPIKeySecret formats a create/rotate response; the plain key is only returned here
+func formatAPIKeySecret(resp *pb.APIKeySecretResponse) map[string]interface{} {
+	data := formatAPIKey(resp.Data)
+	data["key"] = resp.Key
+	return map[string]interface{}{"data": data}
+}
@@ -15,14 +15,18 @@ import (
 )
 
 type CalendarHandler struct {
-	calendarClient calendarpb.CalendarServiceClient
-	authClient     pb.AuthServiceClient // For token validation
+	calendarClient   calendarpb.CalendarServiceClient
+	attendanceClient calendarpb.EventAttendanceServiceClient
+	occasionClient   calendarpb.OccasionServiceClient
+	authClient       pb.AuthServiceClient // For token validation
 }
 
 func NewCalendarHandler(calendarConn *grpc.ClientConn, authConn *grpc.ClientConn) *CalendarHandler {
 	return &CalendarHandler{
-		calendarClient: calendarpb.NewCalendarServiceClient(calendarConn),
-		authClient:     pb.NewAuthServiceClient(authConn),
+		calendarClient:   calendarpb.NewCalendarServiceClient(calendarConn),
+		attendanceClient: calendarpb.NewEventAttendanceServiceClient(calendarConn),
+		occasionClient:   calendarpb.NewOccasionServiceClient(calendarConn),
+		authClient:       middleware.AuthClient(authConn),
 	}
 }
 
@@ -395,6 +399,219 @@ func (h *CalendarHandler) AddInteraction(w http.ResponseWriter, r *http.Request)
 	writeJSON(w, http.StatusOK, map[string]interface{}{"data": eventMap})
 }
 
+// GetRsvp handles GET /api/calendar/events/{event}/rsvp
+// Returns the RSVP counts, plus the caller's RSVP when authenticated
+func (h *CalendarHandler) GetRsvp(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	eventID := extractIDFromPathWithSuffix(r.URL.Path, "/api/calendar/events/", "/rsvp")
+	if eventID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid event ID")
+		return
+	}
+
+	// Extract user ID from context if authenticated (optional - calendar is public)
+	var userID uint64
+	if userCtx, err := middleware.GetUserFromRequest(r); err == nil {
+		userID = userCtx.UserID
+	}
+
+	resp, err := h.attendanceClient.GetRsvp(r.Context(), &calendarpb.GetRsvpRequest{
+		EventId: eventID,
+		UserId:  userID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": buildRsvpMap(resp)})
+}
+
+// RespondToEvent handles POST /api/calendar/events/{event}/rsvp
+// Body: status (going|maybe|declined), remind_minutes (0 for no reminder)
+func (h *CalendarHandler) RespondToEvent(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	eventID := extractIDFromPathWithSuffix(r.URL.Path, "/api/calendar/events/", "/rsvp")
+	if eventID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid event ID")
+		return
+	}
+
+	var req struct {
+		Status        string `json:"status"`
+		RemindMinutes int32  `json:"remind_minutes"`
+	}
+
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	resp, err := h.attendanceClient.RespondToEvent(r.Context(), &calendarpb.RespondToEventRequest{
+		EventId:       eventID,
+		UserId:        userCtx.UserID,
+		Status:        req.Status,
+		RemindMinutes: req.RemindMinutes,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": buildRsvpMap(resp)})
+}
+
+// ListAttendees handles GET /api/calendar/events/{event}/attendees
+// Query params: status (going|maybe|declined, default going), page, per_page
+func (h *CalendarHandler) ListAttendees(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	eventID := extractIDFromPathWithSuffix(r.URL.Path, "/api/calendar/events/", "/attendees")
+	if eventID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid event ID")
+		return
+	}
+
+	var page, perPage int32 = 1, 10
+	if p := r.URL.Query().Get("page"); p != "" {
+		if parsed, err := strconv.ParseInt(p, 10, 32); err == nil && parsed > 0 {
+			page = int32(parsed)
+		}
+	}
+	if pp := r.URL.Query().Get("per_page"); pp != "" {
+		if parsed, err := strconv.ParseInt(pp, 10, 32); err == nil && parsed > 0 {
+			perPage = int32(parsed)
+		}
+	}
+
+	resp, err := h.attendanceClient.ListAttendees(r.Context(), &calendarpb.ListAttendeesRequest{
+		EventId: eventID,
+		Status:  r.URL.Query().Get("status"),
+		Pagination: &commonpb.PaginationRequest{
+			Page:    page,
+			PerPage: perPage,
+		},
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	attendees := make([]map[string]interface{}, 0, len(resp.Attendees))
+	for _, attendee := range resp.Attendees {
+		attendees = append(attendees, map[string]interface{}{
+			"user_id":      attendee.UserId,
+			"name":         attendee.Name,
+			"code":         attendee.Code,
+			"status":       attendee.Status,
+			"responded_at": attendee.RespondedAt,
+		})
+	}
+
+	response := map[string]interface{}{
+		"data": attendees,
+	}
+	if resp.Pagination != nil {
+		response["links"] = buildPaginationLinks(r, resp.Pagination)
+		response["meta"] = map[string]interface{}{
+			"current_page": resp.Pagination.CurrentPage,
+			"per_page":     resp.Pagination.PerPage,
+			"total":        resp.Pagination.Total,
+			"last_page":    resp.Pagination.LastPage,
+		}
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// GetOccasions handles GET /api/calendar/occasions
+// Query params: year (Jalali, required), month (1-12, omit for the whole year)
+func (h *CalendarHandler) GetOccasions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	year, err := strconv.ParseInt(r.URL.Query().Get("year"), 10, 32)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "year is required")
+		return
+	}
+	var month int64
+	if m := r.URL.Query().Get("month"); m != "" {
+		month, err = strconv.ParseInt(m, 10, 32)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid month")
+			return
+		}
+	}
+
+	resp, err := h.occasionClient.GetOccasions(r.Context(), &calendarpb.GetOccasionsRequest{
+		Year:  int32(year),
+		Month: int32(month),
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	occasions := make([]map[string]interface{}, 0, len(resp.Occasions))
+	for _, occasion := range resp.Occasions {
+		occasions = append(occasions, map[string]interface{}{
+			"jalali_date":    occasion.JalaliDate,
+			"gregorian_date": occasion.GregorianDate,
+			"title":          occasion.Title,
+			"is_holiday":     occasion.IsHoliday,
+			"type":           occasion.Type,
+			"approximate":    occasion.Approximate,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": occasions})
+}
+
+func buildRsvpMap(resp *calendarpb.RsvpResponse) map[string]interface{} {
+	rsvp := map[string]interface{}{
+		"event_id":       resp.EventId,
+		"status":         nil,
+		"remind_minutes": resp.RemindMinutes,
+		"remind_at":      nil,
+		"counts": map[string]int32{
+			"going":    resp.GetCounts().GetGoing(),
+			"maybe":    resp.GetCounts().GetMaybe(),
+			"declined": resp.GetCounts().GetDeclined(),
+		},
+	}
+	if resp.Status != "" {
+		rsvp["status"] = resp.Status
+	}
+	if resp.RemindAt != "" {
+		rsvp["remind_at"] = resp.RemindAt
+	}
+	return rsvp
+}
+
 // Helper function to build pagination links
 func buildPaginationLinks(r *http.Request, pagination *commonpb.PaginationMeta) map[string]interface{} {
 	baseURL := r.URL.Scheme + "://" + r.Host + r.URL.Path
@@ -428,24 +645,3 @@ func buildPaginationLinks(r *http.Request, pagination *commonpb.PaginationMeta)
 
 	return links
 }
-
-// extractTokenFromHeader extracts Bearer token from Authorization header
-func (h *CalendarHandler) extractTokenFromHeader(r *http.Request) string {
-	authHeader := r.Header.Get("Authorization")
-	if authHeader == "" {
-		// Try cookie as fallback
-		cookie, err := r.Cookie("token")
-		if err == nil && cookie != nil {
-			return cookie.Value
-		}
-		return ""
-	}
-
-	// Check for Bearer token format
-	if strings.HasPrefix(authHeader, "Bearer ") {
-		return strings.TrimPrefix(authHeader, "Bearer ")
-	}
-
-	// Also support token as direct value
-	return authHeader
-}
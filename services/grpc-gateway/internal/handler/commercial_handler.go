@@ -0,0 +1,1265 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"metargb/grpc-gateway/internal/middleware"
+	commercialpb "metargb/shared/pb/commercial"
+)
+
+type CommercialHandler struct {
+	orderClient        commercialpb.OrderServiceClient
+	adjustmentClient   commercialpb.WalletAdjustmentServiceClient
+	installmentClient  commercialpb.InstallmentServiceClient
+	subscriptionClient commercialpb.SubscriptionServiceClient
+	exchangeClient     commercialpb.ExchangeServiceClient
+	variableClient     commercialpb.VariableServiceClient
+	spendingClient     commercialpb.SpendingLimitServiceClient
+	fraudClient        commercialpb.FraudServiceClient
+	locale             string
+}
+
+func NewCommercialHandler(commercialConn *grpc.ClientConn, locale string) *CommercialHandler {
+	return &CommercialHandler{
+		orderClient:        commercialpb.NewOrderServiceClient(commercialConn),
+		adjustmentClient:   commercialpb.NewWalletAdjustmentServiceClient(commercialConn),
+		installmentClient:  commercialpb.NewInstallmentServiceClient(commercialConn),
+		subscriptionClient: commercialpb.NewSubscriptionServiceClient(commercialConn),
+		exchangeClient:     commercialpb.NewExchangeServiceClient(commercialConn),
+		variableClient:     commercialpb.NewVariableServiceClient(commercialConn),
+		spendingClient:     commercialpb.NewSpendingLimitServiceClient(commercialConn),
+		fraudClient:        commercialpb.NewFraudServiceClient(commercialConn),
+		locale:             locale,
+	}
+}
+
+// ListOrders handles GET /api/orders
+// Query params: status (comma separated or repeated), gateway, from_date, to_date
+// (Jalali Y/m/d or Gregorian Y-m-d, inclusive), page, per_page
+func (h *CommercialHandler) ListOrders(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// Get user from context (set by auth middleware)
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	query := r.URL.Query()
+
+	grpcReq := &commercialpb.ListOrdersRequest{
+		UserId:   userCtx.UserID,
+		Page:     1,
+		Gateway:  query.Get("gateway"),
+		FromDate: query.Get("from_date"),
+		ToDate:   query.Get("to_date"),
+	}
+	if p, err := strconv.ParseInt(query.Get("page"), 10, 32); err == nil && p > 0 {
+		grpcReq.Page = int32(p)
+	}
+	if pp, err := strconv.ParseInt(query.Get("per_page"), 10, 32); err == nil && pp > 0 {
+		grpcReq.PerPage = int32(pp)
+	}
+
+	for _, value := range query["status"] {
+		for _, s := range strings.Split(value, ",") {
+			s = strings.TrimSpace(s)
+			if s == "" {
+				continue
+			}
+			status, err := strconv.ParseInt(s, 10, 32)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "invalid status")
+				return
+			}
+			grpcReq.Status = append(grpcReq.Status, int32(status))
+		}
+	}
+
+	resp, err := h.orderClient.ListOrders(middleware.ContextWithAuthFromRequest(r), grpcReq)
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	orders := make([]map[string]interface{}, 0, len(resp.Orders))
+	for _, order := range resp.Orders {
+		orders = append(orders, map[string]interface{}{
+			"id":             order.Id,
+			"asset":          order.Asset,
+			"amount":         order.Amount,
+			"amount_decimal": order.AmountDecimal,
+			"status":         order.Status,
+			"gateway":        order.Gateway,
+			"ref_id":         order.RefId,
+			"date":           order.Date,
+			"time":           order.Time,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": orders,
+		"meta": map[string]interface{}{
+			"current_page":   resp.CurrentPage,
+			"per_page":       resp.PerPage,
+			"total":          resp.Total,
+			"has_more_pages": resp.HasMorePages,
+		},
+	})
+}
+
+// FirstOrderBonus handles GET /api/orders/first-order-bonus
+// Query params: asset, amount. Reports the bonus a payment of amount would
+// earn under the current first order rules, and why not when it earns none.
+func (h *CommercialHandler) FirstOrderBonus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	query := r.URL.Query()
+	resp, err := h.orderClient.EvaluateFirstOrderBonus(middleware.ContextWithAuthFromRequest(r), &commercialpb.EvaluateFirstOrderBonusRequest{
+		UserId: userCtx.UserID,
+		Asset:  query.Get("asset"),
+		Amount: query.Get("amount"),
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	var windowEndsAt interface{}
+	if resp.WindowEndsAt != nil {
+		windowEndsAt = resp.WindowEndsAt.AsTime().Format(time.RFC3339)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": map[string]interface{}{
+		"eligible":       resp.Eligible,
+		"reason":         resp.Reason,
+		"bonus":          resp.Bonus,
+		"bonus_percent":  resp.BonusPercent,
+		"min_amount":     resp.MinAmount,
+		"irr_value":      resp.IrrValue,
+		"window_days":    resp.WindowDays,
+		"window_ends_at": windowEndsAt,
+	}})
+}
+
+// ListAdjustmentBatches handles GET /api/admin/wallet-adjustments
+// Query params: status (pending, executed, rejected)
+func (h *CommercialHandler) ListAdjustmentBatches(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	resp, err := h.adjustmentClient.ListAdjustmentBatches(middleware.ContextWithAuthFromRequest(r), &commercialpb.ListAdjustmentBatchesRequest{
+		Status: r.URL.Query().Get("status"),
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	batches := make([]map[string]interface{}, 0, len(resp.Batches))
+	for _, batch := range resp.Batches {
+		batches = append(batches, adjustmentBatchToMap(batch))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": batches})
+}
+
+// CreateAdjustmentBatch handles POST /api/admin/wallet-adjustments
+func (h *CommercialHandler) CreateAdjustmentBatch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req struct {
+		Reason  string `json:"reason"`
+		Entries string `json:"entries"`
+	}
+
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	resp, err := h.adjustmentClient.CreateAdjustmentBatch(middleware.ContextWithAuthFromRequest(r), &commercialpb.CreateAdjustmentBatchRequest{
+		Reason:  req.Reason,
+		Entries: req.Entries,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"data": adjustmentBatchToMap(resp)})
+}
+
+// GetAdjustmentBatch handles GET /api/admin/wallet-adjustments/{batch}
+func (h *CommercialHandler) GetAdjustmentBatch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	batchID := extractIDFromPathWithSuffix(r.URL.Path, "/api/admin/wallet-adjustments/", "")
+	if batchID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid batch_id")
+		return
+	}
+
+	resp, err := h.adjustmentClient.GetAdjustmentBatch(middleware.ContextWithAuthFromRequest(r), &commercialpb.GetAdjustmentBatchRequest{
+		BatchId: batchID,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": adjustmentBatchToMap(resp)})
+}
+
+// ApproveAdjustmentBatch handles POST /api/admin/wallet-adjustments/{batch}/approve
+func (h *CommercialHandler) ApproveAdjustmentBatch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	batchID := extractIDFromPathWithSuffix(r.URL.Path, "/api/admin/wallet-adjustments/", "/approve")
+	if batchID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid batch_id")
+		return
+	}
+
+	resp, err := h.adjustmentClient.ApproveAdjustmentBatch(middleware.ContextWithAuthFromRequest(r), &commercialpb.ApproveAdjustmentBatchRequest{
+		BatchId: batchID,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": adjustmentBatchToMap(resp)})
+}
+
+// RejectAdjustmentBatch handles POST /api/admin/wallet-adjustments/{batch}/reject
+func (h *CommercialHandler) RejectAdjustmentBatch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	batchID := extractIDFromPathWithSuffix(r.URL.Path, "/api/admin/wallet-adjustments/", "/reject")
+	if batchID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid batch_id")
+		return
+	}
+
+	var req struct {
+		Note string `json:"note"`
+	}
+	if err := decodeRequestBody(r, &req); err != nil && err != io.EOF {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.adjustmentClient.RejectAdjustmentBatch(middleware.ContextWithAuthFromRequest(r), &commercialpb.RejectAdjustmentBatchRequest{
+		BatchId: batchID,
+		Note:    req.Note,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": adjustmentBatchToMap(resp)})
+}
+
+func adjustmentBatchToMap(batch *commercialpb.AdjustmentBatch) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":          batch.Id,
+		"reason":      batch.Reason,
+		"status":      batch.Status,
+		"created_by":  batch.CreatedBy,
+		"entry_count": batch.EntryCount,
+		"date":        batch.Date,
+		"time":        batch.Time,
+	}
+	if batch.DecidedBy != 0 {
+		result["decided_by"] = batch.DecidedBy
+		result["decision_note"] = batch.DecisionNote
+		result["decided_date"] = batch.DecidedDate
+	}
+	if len(batch.Entries) > 0 {
+		entries := make([]map[string]interface{}, 0, len(batch.Entries))
+		for _, entry := range batch.Entries {
+			item := map[string]interface{}{
+				"user_id": entry.UserId,
+				"asset":   entry.Asset,
+				"amount":  entry.Amount,
+			}
+			if entry.TransactionId != "" {
+				item["transaction_id"] = entry.TransactionId
+			}
+			entries = append(entries, item)
+		}
+		result["entries"] = entries
+	}
+	return result
+}
+
+// CreateInstallmentPlan handles POST /api/installments
+func (h *CommercialHandler) CreateInstallmentPlan(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req struct {
+		FeatureID    uint64 `json:"feature_id"`
+		Installments int32  `json:"installments"`
+	}
+
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	resp, err := h.installmentClient.CreateInstallmentPlan(middleware.ContextWithAuthFromRequest(r), &commercialpb.CreateInstallmentPlanRequest{
+		FeatureId:    req.FeatureID,
+		Installments: req.Installments,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"data": installmentPlanToMap(resp)})
+}
+
+// ListInstallmentPlans handles GET /api/installments
+// Query params: status (active, completed, defaulted)
+func (h *CommercialHandler) ListInstallmentPlans(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	resp, err := h.installmentClient.ListInstallmentPlans(middleware.ContextWithAuthFromRequest(r), &commercialpb.ListInstallmentPlansRequest{
+		Status: r.URL.Query().Get("status"),
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	plans := make([]map[string]interface{}, 0, len(resp.Plans))
+	for _, plan := range resp.Plans {
+		plans = append(plans, installmentPlanToMap(plan))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": plans})
+}
+
+// GetInstallmentPlan handles GET /api/installments/{plan}
+func (h *CommercialHandler) GetInstallmentPlan(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	planID := extractIDFromPathWithSuffix(r.URL.Path, "/api/installments/", "")
+	if planID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid plan_id")
+		return
+	}
+
+	resp, err := h.installmentClient.GetInstallmentPlan(middleware.ContextWithAuthFromRequest(r), &commercialpb.GetInstallmentPlanRequest{
+		PlanId: planID,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": installmentPlanToMap(resp)})
+}
+
+// PayInstallment handles POST /api/installments/{plan}/pay
+func (h *CommercialHandler) PayInstallment(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	planID := extractIDFromPathWithSuffix(r.URL.Path, "/api/installments/", "/pay")
+	if planID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid plan_id")
+		return
+	}
+
+	resp, err := h.installmentClient.PayInstallment(middleware.ContextWithAuthFromRequest(r), &commercialpb.PayInstallmentRequest{
+		PlanId: planID,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": installmentPlanToMap(resp)})
+}
+
+func installmentPlanToMap(plan *commercialpb.InstallmentPlan) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":         plan.Id,
+		"feature_id": plan.FeatureId,
+		"buyer_id":   plan.BuyerId,
+		"seller_id":  plan.SellerId,
+		"status":     plan.Status,
+		"total_psc":  plan.TotalPsc,
+		"total_irr":  plan.TotalIrr,
+		"paid_psc":   plan.PaidPsc,
+		"paid_irr":   plan.PaidIrr,
+		"date":       plan.Date,
+		"time":       plan.Time,
+	}
+	if plan.TradeId != 0 {
+		result["trade_id"] = plan.TradeId
+	}
+	if plan.ClosedDate != "" {
+		result["closed_date"] = plan.ClosedDate
+		result["refunded_psc"] = plan.RefundedPsc
+		result["refunded_irr"] = plan.RefundedIrr
+	}
+	if len(plan.Installments) > 0 {
+		installments := make([]map[string]interface{}, 0, len(plan.Installments))
+		for _, installment := range plan.Installments {
+			item := map[string]interface{}{
+				"sequence":   installment.Sequence,
+				"amount_psc": installment.AmountPsc,
+				"amount_irr": installment.AmountIrr,
+				"status":     installment.Status,
+				"due_date":   installment.DueDate,
+			}
+			if installment.PaidDate != "" {
+				item["paid_date"] = installment.PaidDate
+			}
+			installments = append(installments, item)
+		}
+		result["installments"] = installments
+	}
+	return result
+}
+
+// ListSubscriptionPlans handles GET /api/subscriptions/plans
+func (h *CommercialHandler) ListSubscriptionPlans(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	resp, err := h.subscriptionClient.ListSubscriptionPlans(r.Context(), &commercialpb.ListSubscriptionPlansRequest{})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	plans := make([]map[string]interface{}, 0, len(resp.Plans))
+	for _, plan := range resp.Plans {
+		plans = append(plans, subscriptionPlanToMap(plan))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": plans})
+}
+
+// Subscribe handles POST /api/subscriptions
+func (h *CommercialHandler) Subscribe(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req struct {
+		PlanID     uint64 `json:"plan_id"`
+		UseGateway bool   `json:"use_gateway"`
+	}
+
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	resp, err := h.subscriptionClient.Subscribe(middleware.ContextWithAuthFromRequest(r), &commercialpb.SubscribeRequest{
+		PlanId:     req.PlanID,
+		UseGateway: req.UseGateway,
+		Ip:         getClientIP(r),
+		Device:     r.UserAgent(),
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"data": subscriptionPaymentToMap(resp)})
+}
+
+// GetSubscription handles GET /api/subscriptions/current
+func (h *CommercialHandler) GetSubscription(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	resp, err := h.subscriptionClient.GetSubscription(middleware.ContextWithAuthFromRequest(r), &commercialpb.GetSubscriptionRequest{})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": subscriptionToMap(resp)})
+}
+
+// CancelSubscription handles POST /api/subscriptions/{subscription}/cancel
+func (h *CommercialHandler) CancelSubscription(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	subscriptionID := extractIDFromPathWithSuffix(r.URL.Path, "/api/subscriptions/", "/cancel")
+	if subscriptionID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid subscription_id")
+		return
+	}
+
+	resp, err := h.subscriptionClient.CancelSubscription(middleware.ContextWithAuthFromRequest(r), &commercialpb.CancelSubscriptionRequest{
+		SubscriptionId: subscriptionID,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": subscriptionToMap(resp)})
+}
+
+// PaySubscription handles POST /api/subscriptions/{subscription}/pay
+// Body (optional): use_gateway
+func (h *CommercialHandler) PaySubscription(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	subscriptionID := extractIDFromPathWithSuffix(r.URL.Path, "/api/subscriptions/", "/pay")
+	if subscriptionID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid subscription_id")
+		return
+	}
+
+	var req struct {
+		UseGateway bool `json:"use_gateway"`
+	}
+	if err := decodeRequestBody(r, &req); err != nil && err != io.EOF {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.subscriptionClient.PaySubscription(middleware.ContextWithAuthFromRequest(r), &commercialpb.PaySubscriptionRequest{
+		SubscriptionId: subscriptionID,
+		UseGateway:     req.UseGateway,
+		Ip:             getClientIP(r),
+		Device:         r.UserAgent(),
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": subscriptionPaymentToMap(resp)})
+}
+
+func subscriptionPlanToMap(plan *commercialpb.SubscriptionPlan) map[string]interface{} {
+	entitlements := plan.Entitlements
+	if entitlements == nil {
+		entitlements = []string{}
+	}
+	return map[string]interface{}{
+		"id":           plan.Id,
+		"slug":         plan.Slug,
+		"name":         plan.Name,
+		"asset":        plan.Asset,
+		"price":        plan.Price,
+		"period_days":  plan.PeriodDays,
+		"entitlements": entitlements,
+	}
+}
+
+func subscriptionToMap(subscription *commercialpb.Subscription) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":                   subscription.Id,
+		"status":               subscription.Status,
+		"cancel_at_period_end": subscription.CancelAtPeriodEnd,
+		"failed_attempts":      subscription.FailedAttempts,
+		"date":                 subscription.Date,
+		"time":                 subscription.Time,
+	}
+	if subscription.Plan != nil {
+		result["plan"] = subscriptionPlanToMap(subscription.Plan)
+	}
+	if subscription.PeriodEndDate != "" {
+		result["period_start_date"] = subscription.PeriodStartDate
+		result["period_end_date"] = subscription.PeriodEndDate
+	}
+	if subscription.NextAttemptDate != "" {
+		result["next_attempt_date"] = subscription.NextAttemptDate
+	}
+	if subscription.EndedDate != "" {
+		result["ended_date"] = subscription.EndedDate
+	}
+	return result
+}
+
+func subscriptionPaymentToMap(payment *commercialpb.SubscriptionPayment) map[string]interface{} {
+	result := subscriptionToMap(payment.Subscription)
+	if payment.PaymentUrl != "" {
+		result["payment_url"] = payment.PaymentUrl
+		result["order_id"] = payment.OrderId
+	}
+	return result
+}
+
+// GetSpendingLimits handles GET /api/wallet/spending-limits
+// Users under 18 see their daily and monthly caps and what they spent.
+func (h *CommercialHandler) GetSpendingLimits(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	resp, err := h.spendingClient.GetSpendingLimits(middleware.ContextWithAuthFromRequest(r), &commercialpb.GetSpendingLimitsRequest{
+		UserId: userCtx.UserID,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": spendingLimitsToMap(resp)})
+}
+
+func spendingLimitsToMap(limits *commercialpb.SpendingLimits) map[string]interface{} {
+	assetToMap := func(limit *commercialpb.AssetSpendingLimit) map[string]interface{} {
+		return map[string]interface{}{
+			"daily":            limit.GetDaily(),
+			"monthly":          limit.GetMonthly(),
+			"spent_today":      limit.GetSpentToday(),
+			"spent_this_month": limit.GetSpentThisMonth(),
+			"max_daily":        limit.GetMaxDaily(),
+			"max_monthly":      limit.GetMaxMonthly(),
+		}
+	}
+
+	return map[string]interface{}{
+		"user_id":    limits.GetUserId(),
+		"applies":    limits.GetApplies(),
+		"psc":        assetToMap(limits.GetPsc()),
+		"irr":        assetToMap(limits.GetIrr()),
+		"updated_by": limits.GetUpdatedBy(),
+	}
+}
+
+// ListFraudReviews handles GET /api/admin/fraud/reviews
+// Query params: status (pending, approved, rejected)
+func (h *CommercialHandler) ListFraudReviews(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	resp, err := h.fraudClient.ListFraudReviews(middleware.ContextWithAuthFromRequest(r), &commercialpb.ListFraudReviewsRequest{
+		Status: r.URL.Query().Get("status"),
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	checks := make([]map[string]interface{}, 0, len(resp.Checks))
+	for _, check := range resp.Checks {
+		checks = append(checks, fraudCheckToMap(check))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": checks})
+}
+
+// ApproveFraudReview handles POST /api/admin/fraud/reviews/{check}/approve
+func (h *CommercialHandler) ApproveFraudReview(w http.ResponseWriter, r *http.Request) {
+	h.resolveFraudReview(w, r, "/approve", true)
+}
+
+// RejectFraudReview handles POST /api/admin/fraud/reviews/{check}/reject
+func (h *CommercialHandler) RejectFraudReview(w http.ResponseWriter, r *http.Request) {
+	h.resolveFraudReview(w, r, "/reject", false)
+}
+
+func (h *CommercialHandler) resolveFraudReview(w http.ResponseWriter, r *http.Request, suffix string, approve bool) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	checkID := extractIDFromPathWithSuffix(r.URL.Path, "/api/admin/fraud/reviews/", suffix)
+	if checkID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid check_id")
+		return
+	}
+
+	var req struct {
+		Note string `json:"note"`
+	}
+	if err := decodeRequestBody(r, &req); err != nil && err != io.EOF {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.fraudClient.ResolveFraudReview(middleware.ContextWithAuthFromRequest(r), &commercialpb.ResolveFraudReviewRequest{
+		CheckId: checkID,
+		Approve: approve,
+		Note:    req.Note,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": fraudCheckToMap(resp)})
+}
+
+func fraudCheckToMap(check *commercialpb.FraudCheck) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":       check.Id,
+		"user_id":  check.UserId,
+		"kind":     check.Kind,
+		"asset":    check.Asset,
+		"amount":   check.Amount,
+		"decision": check.Decision,
+		"reasons":  check.Reasons,
+		"date":     check.Date,
+		"time":     check.Time,
+	}
+	if check.Reasons == nil {
+		result["reasons"] = []string{}
+	}
+	if check.Reference != "" {
+		result["reference"] = check.Reference
+	}
+	if check.Ip != "" {
+		result["ip"] = check.Ip
+	}
+	if check.Device != "" {
+		result["device"] = check.Device
+	}
+	if check.CardPan != "" {
+		result["card_pan"] = check.CardPan
+	}
+	if check.ReviewStatus != "" {
+		result["review_status"] = check.ReviewStatus
+	}
+	if check.ReviewedBy != 0 {
+		result["reviewed_by"] = check.ReviewedBy
+		result["review_note"] = check.ReviewNote
+		result["reviewed_date"] = check.ReviewedDate
+	}
+	return result
+}
+
+// BlockedCards handles GET and POST /api/admin/fraud/blocked-cards
+func (h *CommercialHandler) BlockedCards(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		resp, err := h.fraudClient.ListBlockedCards(middleware.ContextWithAuthFromRequest(r), &commercialpb.ListBlockedCardsRequest{})
+		if err != nil {
+			writeGRPCErrorWithLocale(w, err, h.locale)
+			return
+		}
+
+		cards := make([]map[string]interface{}, 0, len(resp.Cards))
+		for _, card := range resp.Cards {
+			cards = append(cards, blockedCardToMap(card))
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": cards})
+	case http.MethodPost:
+		var req struct {
+			Pattern string `json:"pattern"`
+			Reason  string `json:"reason"`
+		}
+		if err := decodeRequestBody(r, &req); err != nil {
+			if err == io.EOF {
+				writeError(w, http.StatusBadRequest, "request body is required")
+			} else {
+				writeError(w, http.StatusBadRequest, "invalid request body")
+			}
+			return
+		}
+
+		resp, err := h.fraudClient.BlockCard(middleware.ContextWithAuthFromRequest(r), &commercialpb.BlockCardRequest{
+			Pattern: req.Pattern,
+			Reason:  req.Reason,
+		})
+		if err != nil {
+			writeGRPCErrorWithLocale(w, err, h.locale)
+			return
+		}
+		writeJSON(w, http.StatusCreated, map[string]interface{}{"data": blockedCardToMap(resp)})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// UnblockCard handles DELETE /api/admin/fraud/blocked-cards/{card}
+func (h *CommercialHandler) UnblockCard(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	cardID := extractIDFromPathWithSuffix(r.URL.Path, "/api/admin/fraud/blocked-cards/", "")
+	if cardID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid card_id")
+		return
+	}
+
+	_, err := h.fraudClient.UnblockCard(middleware.ContextWithAuthFromRequest(r), &commercialpb.UnblockCardRequest{
+		CardId: cardID,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func blockedCardToMap(card *commercialpb.BlockedCard) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         card.Id,
+		"pattern":    card.Pattern,
+		"reason":     card.Reason,
+		"created_by": card.CreatedBy,
+		"date":       card.Date,
+		"time":       card.Time,
+	}
+}
+
+// ListExchangeRates handles GET /api/wallet/exchange-rates
+// Query params: include_disabled (wallet admins only)
+func (h *CommercialHandler) ListExchangeRates(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	includeDisabled, _ := strconv.ParseBool(r.URL.Query().Get("include_disabled"))
+	resp, err := h.exchangeClient.ListExchangeRates(middleware.ContextWithAuthFromRequest(r), &commercialpb.ListExchangeRatesRequest{
+		IncludeDisabled: includeDisabled,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	rates := make([]map[string]interface{}, 0, len(resp.Rates))
+	for _, rate := range resp.Rates {
+		rates = append(rates, exchangeRateToMap(rate))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": rates})
+}
+
+// SetExchangeRate handles PUT /api/admin/exchange-rates
+func (h *CommercialHandler) SetExchangeRate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req struct {
+		FromAsset  string `json:"from_asset"`
+		ToAsset    string `json:"to_asset"`
+		Rate       string `json:"rate"`
+		FeePercent string `json:"fee_percent"`
+		Enabled    *bool  `json:"enabled"`
+	}
+
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	// A rate is enabled unless the admin turns it off explicitly
+	enabled := req.Enabled == nil || *req.Enabled
+	resp, err := h.exchangeClient.SetExchangeRate(middleware.ContextWithAuthFromRequest(r), &commercialpb.SetExchangeRateRequest{
+		FromAsset:  req.FromAsset,
+		ToAsset:    req.ToAsset,
+		Rate:       req.Rate,
+		FeePercent: req.FeePercent,
+		Enabled:    enabled,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": exchangeRateToMap(resp)})
+}
+
+// Convert handles POST /api/wallet/convert
+func (h *CommercialHandler) Convert(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req struct {
+		FromAsset  string `json:"from_asset"`
+		ToAsset    string `json:"to_asset"`
+		Amount     string `json:"amount"`
+		MinReceive string `json:"min_receive"`
+	}
+
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	resp, err := h.exchangeClient.Convert(middleware.ContextWithAuthFromRequest(r), &commercialpb.ConvertRequest{
+		FromAsset:  req.FromAsset,
+		ToAsset:    req.ToAsset,
+		Amount:     req.Amount,
+		MinReceive: req.MinReceive,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"data": map[string]interface{}{
+		"id":                      resp.Id,
+		"from_asset":              resp.FromAsset,
+		"to_asset":                resp.ToAsset,
+		"amount":                  resp.Amount,
+		"received":                resp.Received,
+		"fee":                     resp.Fee,
+		"rate":                    resp.Rate,
+		"withdraw_transaction_id": resp.WithdrawTransactionId,
+		"deposit_transaction_id":  resp.DepositTransactionId,
+		"date":                    resp.Date,
+		"time":                    resp.Time,
+	}})
+}
+
+// DisplayRates handles GET /api/rates
+// Public, for price tickers
+func (h *CommercialHandler) DisplayRates(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	resp, err := h.variableClient.DisplayRates(r.Context(), &commercialpb.DisplayRatesRequest{})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	rates := make([]map[string]interface{}, 0, len(resp.Rates))
+	for _, rate := range resp.Rates {
+		rates = append(rates, map[string]interface{}{
+			"asset":              rate.Asset,
+			"irr":                rate.Irr,
+			"psc":                rate.Psc,
+			"previous_irr":       rate.PreviousIrr,
+			"irr_change":         rate.IrrChange,
+			"irr_change_percent": rate.IrrChangePercent,
+			"previous_psc":       rate.PreviousPsc,
+			"psc_change_percent": rate.PscChangePercent,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": rates,
+		"date": resp.Date,
+		"time": resp.Time,
+	})
+}
+
+func exchangeRateToMap(rate *commercialpb.ExchangeRate) map[string]interface{} {
+	return map[string]interface{}{
+		"from_asset":  rate.FromAsset,
+		"to_asset":    rate.ToAsset,
+		"rate":        rate.Rate,
+		"fee_percent": rate.FeePercent,
+		"enabled":     rate.Enabled,
+		"updated_by":  rate.UpdatedBy,
+		"date":        rate.Date,
+		"time":        rate.Time,
+	}
+}
+
+// ListVariables handles GET /api/admin/variables
+func (h *CommercialHandler) ListVariables(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	resp, err := h.variableClient.ListVariables(middleware.ContextWithAuthFromRequest(r), &commercialpb.ListVariablesRequest{})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	variables := make([]map[string]interface{}, 0, len(resp.Variables))
+	for _, variable := range resp.Variables {
+		variables = append(variables, variableToMap(variable))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": variables})
+}
+
+// Variable handles GET and PUT /api/admin/variables/{key}
+func (h *CommercialHandler) Variable(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPut {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	key := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/admin/variables/"), "/")
+	if key == "" || strings.Contains(key, "/") {
+		writeError(w, http.StatusBadRequest, "variable key is required in path")
+		return
+	}
+
+	var resp *commercialpb.Variable
+	var err error
+	if r.Method == http.MethodGet {
+		resp, err = h.variableClient.GetVariable(middleware.ContextWithAuthFromRequest(r), &commercialpb.GetVariableRequest{Key: key})
+	} else {
+		var req struct {
+			Value string `json:"value"`
+			Note  string `json:"note"`
+		}
+		if err := decodeRequestBody(r, &req); err != nil {
+			if err == io.EOF {
+				writeError(w, http.StatusBadRequest, "request body is required")
+			} else {
+				writeError(w, http.StatusBadRequest, "invalid request body")
+			}
+			return
+		}
+
+		resp, err = h.variableClient.SetVariable(middleware.ContextWithAuthFromRequest(r), &commercialpb.SetVariableRequest{
+			Key:   key,
+			Value: req.Value,
+			Note:  req.Note,
+		})
+	}
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": variableToMap(resp)})
+}
+
+// ListVariableChanges handles GET /api/admin/variables/{key}/changes
+// Query params: limit (default 20, at most 100)
+func (h *CommercialHandler) ListVariableChanges(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/admin/variables/")
+	key, ok := strings.CutSuffix(path, "/changes")
+	if !ok || key == "" || strings.Contains(key, "/") {
+		writeError(w, http.StatusBadRequest, "variable key is required in path")
+		return
+	}
+
+	limit, _ := strconv.ParseInt(r.URL.Query().Get("limit"), 10, 32)
+	resp, err := h.variableClient.ListVariableChanges(middleware.ContextWithAuthFromRequest(r), &commercialpb.ListVariableChangesRequest{
+		Key:   key,
+		Limit: int32(limit),
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	changes := make([]map[string]interface{}, 0, len(resp.Changes))
+	for _, change := range resp.Changes {
+		changes = append(changes, map[string]interface{}{
+			"id":             change.Id,
+			"key":            change.Key,
+			"previous_value": change.PreviousValue,
+			"current_value":  change.CurrentValue,
+			"changer_id":     change.ChangerId,
+			"changer_name":   change.ChangerName,
+			"note":           change.Note,
+			"date":           change.Date,
+			"time":           change.Time,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": changes})
+}
+
+// VariableSchedules handles GET and POST /api/admin/variable-schedules
+// Query params (GET): key, to list the pending changes of one variable
+func (h *CommercialHandler) VariableSchedules(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		resp, err := h.variableClient.ListScheduledVariableChanges(middleware.ContextWithAuthFromRequest(r), &commercialpb.ListScheduledVariableChangesRequest{
+			Key: r.URL.Query().Get("key"),
+		})
+		if err != nil {
+			writeGRPCErrorWithLocale(w, err, h.locale)
+			return
+		}
+
+		changes := make([]map[string]interface{}, 0, len(resp.Changes))
+		for _, change := range resp.Changes {
+			changes = append(changes, scheduledVariableChangeToMap(change))
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": changes})
+
+	case http.MethodPost:
+		var req struct {
+			Key         string `json:"key"`
+			Value       string `json:"value"`
+			Note        string `json:"note"`
+			EffectiveAt string `json:"effective_at"`
+		}
+		if err := decodeRequestBody(r, &req); err != nil {
+			if err == io.EOF {
+				writeError(w, http.StatusBadRequest, "request body is required")
+			} else {
+				writeError(w, http.StatusBadRequest, "invalid request body")
+			}
+			return
+		}
+
+		resp, err := h.variableClient.ScheduleVariableChange(middleware.ContextWithAuthFromRequest(r), &commercialpb.ScheduleVariableChangeRequest{
+			Key:         req.Key,
+			Value:       req.Value,
+			Note:        req.Note,
+			EffectiveAt: req.EffectiveAt,
+		})
+		if err != nil {
+			writeGRPCErrorWithLocale(w, err, h.locale)
+			return
+		}
+		writeJSON(w, http.StatusCreated, map[string]interface{}{"data": scheduledVariableChangeToMap(resp)})
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// CancelVariableSchedule handles DELETE /api/admin/variable-schedules/{id}
+func (h *CommercialHandler) CancelVariableSchedule(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	id := extractIDFromPathWithSuffix(r.URL.Path, "/api/admin/variable-schedules/", "")
+	if id == 0 {
+		writeError(w, http.StatusBadRequest, "invalid schedule id")
+		return
+	}
+
+	resp, err := h.variableClient.CancelScheduledVariableChange(middleware.ContextWithAuthFromRequest(r), &commercialpb.CancelScheduledVariableChangeRequest{
+		Id: id,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": scheduledVariableChangeToMap(resp)})
+}
+
+func scheduledVariableChangeToMap(change *commercialpb.ScheduledVariableChange) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           change.Id,
+		"key":          change.Key,
+		"value":        change.Value,
+		"note":         change.Note,
+		"effective_at": change.EffectiveAt,
+		"status":       change.Status,
+		"created_by":   change.CreatedBy,
+		"date":         change.Date,
+		"time":         change.Time,
+	}
+}
+
+func variableToMap(variable *commercialpb.Variable) map[string]interface{} {
+	return map[string]interface{}{
+		"key":         variable.Key,
+		"type":        variable.Type,
+		"value":       variable.Value,
+		"min":         variable.Min,
+		"max":         variable.Max,
+		"description": variable.Description,
+		"date":        variable.Date,
+		"time":        variable.Time,
+	}
+}
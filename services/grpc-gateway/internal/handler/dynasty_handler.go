@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"encoding/json"
 	"io"
 	"net/http"
 	"strconv"
@@ -19,6 +20,8 @@ type DynastyHandler struct {
 	joinRequestClient dynastypb.JoinRequestServiceClient
 	familyClient      dynastypb.FamilyServiceClient
 	prizeClient       dynastypb.DynastyPrizeServiceClient
+	rulesClient       dynastypb.MembershipRulesServiceClient
+	leaderboardClient dynastypb.DynastyLeaderboardServiceClient
 	authClient        pb.AuthServiceClient
 }
 
@@ -28,7 +31,9 @@ func NewDynastyHandler(dynastyConn, authConn *grpc.ClientConn) *DynastyHandler {
 		joinRequestClient: dynastypb.NewJoinRequestServiceClient(dynastyConn),
 		familyClient:      dynastypb.NewFamilyServiceClient(dynastyConn),
 		prizeClient:       dynastypb.NewDynastyPrizeServiceClient(dynastyConn),
-		authClient:        pb.NewAuthServiceClient(authConn),
+		rulesClient:       dynastypb.NewMembershipRulesServiceClient(dynastyConn),
+		leaderboardClient: dynastypb.NewDynastyLeaderboardServiceClient(dynastyConn),
+		authClient:        middleware.AuthClient(authConn),
 	}
 }
 
@@ -136,19 +141,6 @@ func (h *DynastyHandler) UpdateDynastyFeature(w http.ResponseWriter, r *http.Req
 
 // GetFamily handles GET /api/dynasty/{dynasty}/family/{family}
 func (h *DynastyHandler) GetFamily(w http.ResponseWriter, r *http.Request) {
-	token := extractTokenFromHeader(r)
-	if token == "" {
-		writeError(w, http.StatusUnauthorized, "authentication required")
-		return
-	}
-
-	validateReq := &pb.ValidateTokenRequest{Token: token}
-	_, err := h.authClient.ValidateToken(r.Context(), validateReq)
-	if err != nil {
-		writeError(w, http.StatusUnauthorized, "invalid or expired token")
-		return
-	}
-
 	// Extract dynasty and family IDs from path
 	path := strings.TrimPrefix(r.URL.Path, "/api/dynasty/")
 	parts := strings.Split(path, "/")
@@ -550,6 +542,32 @@ func (h *DynastyHandler) ClaimPrize(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// ClaimAllPrizes handles POST /api/dynasty/prizes/claim-all
+func (h *DynastyHandler) ClaimAllPrizes(w http.ResponseWriter, r *http.Request) {
+	// Get user from context (set by auth middleware)
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	resp, err := h.prizeClient.ClaimAllPrizes(r.Context(), &dynastypb.ClaimAllPrizesRequest{
+		UserId: userCtx.UserID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": map[string]interface{}{
+			"claimed":      resp.Claimed,
+			"psc":          resp.Psc,
+			"satisfaction": resp.Satisfaction,
+		},
+	})
+}
+
 // UpdateChildPermissions handles POST /api/dynasty/children/{user}
 func (h *DynastyHandler) UpdateChildPermissions(w http.ResponseWriter, r *http.Request) {
 	// Get user from context (set by auth middleware)
@@ -636,21 +654,114 @@ func (h *DynastyHandler) UpdateChildPermissions(w http.ResponseWriter, r *http.R
 	writeJSON(w, http.StatusOK, map[string]interface{}{"data": []interface{}{}})
 }
 
-// SearchUsers handles POST /api/dynasty/search
-func (h *DynastyHandler) SearchUsers(w http.ResponseWriter, r *http.Request) {
-	token := extractTokenFromHeader(r)
-	if token == "" {
+// childSpendingLimitsBody is the request body of SetChildSpendingLimits.
+// Amounts may be sent as JSON numbers or strings; omitted ones are kept.
+type childSpendingLimitsBody struct {
+	DailyPSC   json.Number `json:"daily_psc"`
+	MonthlyPSC json.Number `json:"monthly_psc"`
+	DailyIRR   json.Number `json:"daily_irr"`
+	MonthlyIRR json.Number `json:"monthly_irr"`
+}
+
+// GetChildSpendingLimits handles GET /api/dynasty/children/{user}/spending-limits
+func (h *DynastyHandler) GetChildSpendingLimits(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	childUserID := extractIDFromPathWithSuffix(r.URL.Path, "/api/dynasty/children/", "/spending-limits")
+	if childUserID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid user_id")
+		return
+	}
+
+	resp, err := h.familyClient.GetChildSpendingLimits(r.Context(), &dynastypb.GetChildSpendingLimitsRequest{
+		ChildUserId:  childUserID,
+		ParentUserId: userCtx.UserID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": formatChildSpendingLimits(resp)})
+}
+
+// SetChildSpendingLimits handles PUT /api/dynasty/children/{user}/spending-limits
+// Body: {"daily_psc", "monthly_psc", "daily_irr", "monthly_irr"}
+func (h *DynastyHandler) SetChildSpendingLimits(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
 		writeError(w, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
-	validateReq := &pb.ValidateTokenRequest{Token: token}
-	_, err := h.authClient.ValidateToken(r.Context(), validateReq)
+	childUserID := extractIDFromPathWithSuffix(r.URL.Path, "/api/dynasty/children/", "/spending-limits")
+	if childUserID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid user_id")
+		return
+	}
+
+	var req childSpendingLimitsBody
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	resp, err := h.familyClient.SetChildSpendingLimits(r.Context(), &dynastypb.SetChildSpendingLimitsRequest{
+		ChildUserId:  childUserID,
+		ParentUserId: userCtx.UserID,
+		DailyPsc:     req.DailyPSC.String(),
+		MonthlyPsc:   req.MonthlyPSC.String(),
+		DailyIrr:     req.DailyIRR.String(),
+		MonthlyIrr:   req.MonthlyIRR.String(),
+	})
 	if err != nil {
-		writeError(w, http.StatusUnauthorized, "invalid or expired token")
+		writeGRPCError(w, err)
 		return
 	}
 
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": formatChildSpendingLimits(resp)})
+}
+
+func formatChildSpendingLimits(resp *dynastypb.ChildSpendingLimitsResponse) map[string]interface{} {
+	formatLimit := func(limit *dynastypb.SpendingLimit) map[string]interface{} {
+		return map[string]interface{}{
+			"daily":            limit.GetDaily(),
+			"monthly":          limit.GetMonthly(),
+			"spent_today":      limit.GetSpentToday(),
+			"spent_this_month": limit.GetSpentThisMonth(),
+			"max_daily":        limit.GetMaxDaily(),
+			"max_monthly":      limit.GetMaxMonthly(),
+		}
+	}
+
+	return map[string]interface{}{
+		"user_id":    resp.GetChildUserId(),
+		"psc":        formatLimit(resp.GetPsc()),
+		"irr":        formatLimit(resp.GetIrr()),
+		"updated_by": resp.GetUpdatedBy(),
+	}
+}
+
+// SearchUsers handles POST /api/dynasty/search
+func (h *DynastyHandler) SearchUsers(w http.ResponseWriter, r *http.Request) {
 	var req struct {
 		SearchTerm string `json:"searchTerm"`
 	}
@@ -686,19 +797,6 @@ func (h *DynastyHandler) SearchUsers(w http.ResponseWriter, r *http.Request) {
 
 // GetDefaultPermissions handles POST /api/dynasty/add/member/get/permissions
 func (h *DynastyHandler) GetDefaultPermissions(w http.ResponseWriter, r *http.Request) {
-	token := extractTokenFromHeader(r)
-	if token == "" {
-		writeError(w, http.StatusUnauthorized, "authentication required")
-		return
-	}
-
-	validateReq := &pb.ValidateTokenRequest{Token: token}
-	_, err := h.authClient.ValidateToken(r.Context(), validateReq)
-	if err != nil {
-		writeError(w, http.StatusUnauthorized, "invalid or expired token")
-		return
-	}
-
 	var req struct {
 		Relationship string `json:"relationship"`
 	}
@@ -729,3 +827,217 @@ func (h *DynastyHandler) GetDefaultPermissions(w http.ResponseWriter, r *http.Re
 
 	writeJSON(w, http.StatusOK, map[string]interface{}{"data": resp.Permissions})
 }
+
+// membershipRulesJSON is the JSON shape of the membership rules
+type membershipRulesJSON struct {
+	MaxFamilySize           int32            `json:"max_family_size"`
+	RelationshipLimits      map[string]int32 `json:"relationship_limits"`
+	MinorRelationships      []string         `json:"minor_relationships"`
+	UnverifiedRelationships []string         `json:"unverified_relationships"`
+	LeaveCooldownHours      int32            `json:"leave_cooldown_hours"`
+}
+
+// GetMembershipRules handles GET /api/admin/dynasty/membership-rules
+func (h *DynastyHandler) GetMembershipRules(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	resp, err := h.rulesClient.GetMembershipRules(r.Context(), &dynastypb.GetMembershipRulesRequest{
+		UserId: userCtx.UserID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": formatMembershipRules(resp)})
+}
+
+// UpdateMembershipRules handles PUT /api/admin/dynasty/membership-rules
+// The body replaces all rules, so omitted lists are saved as empty.
+func (h *DynastyHandler) UpdateMembershipRules(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	var req membershipRulesJSON
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	resp, err := h.rulesClient.UpdateMembershipRules(r.Context(), &dynastypb.UpdateMembershipRulesRequest{
+		UserId: userCtx.UserID,
+		Rules: &dynastypb.MembershipRules{
+			MaxFamilySize:           req.MaxFamilySize,
+			RelationshipLimits:      req.RelationshipLimits,
+			MinorRelationships:      req.MinorRelationships,
+			UnverifiedRelationships: req.UnverifiedRelationships,
+			LeaveCooldownHours:      req.LeaveCooldownHours,
+		},
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": formatMembershipRules(resp)})
+}
+
+func formatMembershipRules(resp *dynastypb.MembershipRulesResponse) map[string]interface{} {
+	rules := resp.GetRules()
+	limits := rules.GetRelationshipLimits()
+	if limits == nil {
+		limits = map[string]int32{}
+	}
+	minor := rules.GetMinorRelationships()
+	if minor == nil {
+		minor = []string{}
+	}
+	unverified := rules.GetUnverifiedRelationships()
+	if unverified == nil {
+		unverified = []string{}
+	}
+
+	result := map[string]interface{}{
+		"max_family_size":          rules.GetMaxFamilySize(),
+		"relationship_limits":      limits,
+		"minor_relationships":      minor,
+		"unverified_relationships": unverified,
+		"leave_cooldown_hours":     rules.GetLeaveCooldownHours(),
+		"updated_by":               nil,
+		"updated_at":               nil,
+	}
+	if resp.UpdatedBy != 0 {
+		result["updated_by"] = resp.UpdatedBy
+	}
+	if resp.UpdatedAt != "" {
+		result["updated_at"] = resp.UpdatedAt
+	}
+	return result
+}
+
+// GetDynastyLeaderboard handles GET /api/dynasty/leaderboard
+// sort_by is score (default), lands or prizes
+func (h *DynastyHandler) GetDynastyLeaderboard(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	if _, err := middleware.GetUserFromRequest(r); err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	page, perPage := parsePagination(r, 1, 0)
+	resp, err := h.leaderboardClient.GetDynastyLeaderboard(r.Context(), &dynastypb.GetDynastyLeaderboardRequest{
+		SortBy: r.URL.Query().Get("sort_by"),
+		Pagination: &commonpb.PaginationRequest{
+			Page:    page,
+			PerPage: perPage,
+		},
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	data := make([]map[string]interface{}, 0, len(resp.Data))
+	for _, stats := range resp.Data {
+		data = append(data, formatDynastyStats(stats))
+	}
+
+	meta := resp.GetPagination()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": data,
+		"meta": map[string]interface{}{
+			"current_page": meta.GetCurrentPage(),
+			"per_page":     meta.GetPerPage(),
+			"total":        meta.GetTotal(),
+			"last_page":    meta.GetLastPage(),
+		},
+	})
+}
+
+// GetDynastyStats handles GET /api/dynasty/{dynasty}/stats
+func (h *DynastyHandler) GetDynastyStats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	if _, err := middleware.GetUserFromRequest(r); err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	// Extract dynasty ID from path: /api/dynasty/{dynasty}/stats
+	path := strings.TrimPrefix(r.URL.Path, "/api/dynasty/")
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 || parts[1] != "stats" {
+		writeError(w, http.StatusBadRequest, "invalid path format: expected /api/dynasty/{dynasty}/stats")
+		return
+	}
+
+	dynastyID, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid dynasty_id")
+		return
+	}
+
+	resp, err := h.leaderboardClient.GetDynastyStats(r.Context(), &dynastypb.GetDynastyStatsRequest{
+		DynastyId: dynastyID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	data := formatDynastyStats(resp.GetStats())
+	delete(data, "rank")
+	data["ranks"] = map[string]interface{}{
+		"score":  resp.ScoreRank,
+		"lands":  resp.LandRank,
+		"prizes": resp.PrizeRank,
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": data})
+}
+
+// formatDynastyStats writes every field, zero counts included
+func formatDynastyStats(stats *dynastypb.DynastyStats) map[string]interface{} {
+	owner := stats.GetOwner()
+	return map[string]interface{}{
+		"dynasty_id": stats.GetDynastyId(),
+		"owner": map[string]interface{}{
+			"id":   owner.GetId(),
+			"code": owner.GetCode(),
+			"name": owner.GetName(),
+		},
+		"member_count": stats.GetMemberCount(),
+		"member_score": stats.GetMemberScore(),
+		"land_count":   stats.GetLandCount(),
+		"prize_psc":    stats.GetPrizePsc(),
+		"rank":         stats.GetRank(),
+		"computed_at":  stats.GetComputedAt(),
+	}
+}
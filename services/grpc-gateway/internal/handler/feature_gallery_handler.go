@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"metargb/grpc-gateway/internal/middleware"
+	featurespb "metargb/shared/pb/features"
+)
+
+// maxGalleryImageSize mirrors the 1024 KB limit features-service enforces per image
+const maxGalleryImageSize = 1024 << 10
+
+// FeatureImages handles /api/features/{feature}/images and its sub-routes:
+//
+//	GET    /api/features/{feature}/images                  list the gallery
+//	POST   /api/features/{feature}/images                  multipart "images" files
+//	PUT    /api/features/{feature}/images/order            {"image_ids": [...]}
+//	DELETE /api/features/{feature}/images/{image}
+//	PUT    /api/features/{feature}/images/{image}/cover
+//
+// Listing is public; the other routes are limited to the feature's owner.
+func (h *FeaturesHandler) FeatureImages(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/features/"), "/"), "/")
+	if len(parts) < 2 || parts[1] != "images" {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	featureID, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil || featureID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid feature_id")
+		return
+	}
+
+	switch {
+	case len(parts) == 2 && r.Method == http.MethodGet:
+		h.listFeatureImages(w, r, featureID)
+	case len(parts) == 2 && r.Method == http.MethodPost:
+		h.attachFeatureImages(w, r, featureID)
+	case len(parts) == 3 && parts[2] == "order" && r.Method == http.MethodPut:
+		h.reorderFeatureImages(w, r, featureID)
+	case len(parts) == 3 && r.Method == http.MethodDelete,
+		len(parts) == 4 && parts[3] == "cover" && r.Method == http.MethodPut:
+		imageID, err := strconv.ParseUint(parts[2], 10, 64)
+		if err != nil || imageID == 0 {
+			writeError(w, http.StatusBadRequest, "invalid image_id")
+			return
+		}
+		if len(parts) == 3 {
+			h.removeFeatureImage(w, r, featureID, imageID)
+		} else {
+			h.setFeatureCoverImage(w, r, featureID, imageID)
+		}
+	case len(parts) <= 4:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (h *FeaturesHandler) listFeatureImages(w http.ResponseWriter, r *http.Request, featureID uint64) {
+	resp, err := h.galleryClient.ListFeatureImages(r.Context(), &featurespb.ListFeatureImagesRequest{
+		FeatureId: featureID,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": featureImagesToMaps(resp.Data)})
+}
+
+func (h *FeaturesHandler) attachFeatureImages(w http.ResponseWriter, r *http.Request, featureID uint64) {
+	if err := r.ParseMultipartForm(12 << 20); err != nil {
+		writeError(w, http.StatusBadRequest, "failed to parse multipart form")
+		return
+	}
+
+	files := r.MultipartForm.File["images"]
+	if len(files) == 0 {
+		files = r.MultipartForm.File["images[]"]
+	}
+	if len(files) == 0 {
+		writeValidationErrorWithLocale(w, "images field is required", h.locale)
+		return
+	}
+
+	images := make([]*featurespb.ImageUpload, 0, len(files))
+	for _, fileHeader := range files {
+		if fileHeader.Size > maxGalleryImageSize {
+			writeValidationErrorWithLocale(w, "image size exceeds 1024 KB limit", h.locale)
+			return
+		}
+
+		file, err := fileHeader.Open()
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "failed to read file")
+			return
+		}
+		data, err := io.ReadAll(io.LimitReader(file, maxGalleryImageSize+1))
+		file.Close()
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "failed to read file data")
+			return
+		}
+
+		images = append(images, &featurespb.ImageUpload{
+			Data:        data,
+			Filename:    fileHeader.Filename,
+			ContentType: fileHeader.Header.Get("Content-Type"),
+		})
+	}
+
+	resp, err := h.galleryClient.AttachFeatureImages(middleware.ContextWithAuthFromRequest(r), &featurespb.AttachFeatureImagesRequest{
+		FeatureId: featureID,
+		Images:    images,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"data": featureImagesToMaps(resp.Data)})
+}
+
+func (h *FeaturesHandler) reorderFeatureImages(w http.ResponseWriter, r *http.Request, featureID uint64) {
+	var req struct {
+		ImageIDs []uint64 `json:"image_ids"`
+	}
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+	if len(req.ImageIDs) == 0 {
+		writeValidationErrorWithLocale(w, "image_ids field is required", h.locale)
+		return
+	}
+
+	resp, err := h.galleryClient.ReorderFeatureImages(middleware.ContextWithAuthFromRequest(r), &featurespb.ReorderFeatureImagesRequest{
+		FeatureId: featureID,
+		ImageIds:  req.ImageIDs,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": featureImagesToMaps(resp.Data)})
+}
+
+func (h *FeaturesHandler) removeFeatureImage(w http.ResponseWriter, r *http.Request, featureID, imageID uint64) {
+	resp, err := h.galleryClient.RemoveFeatureImage(middleware.ContextWithAuthFromRequest(r), &featurespb.RemoveFeatureImageRequest{
+		FeatureId: featureID,
+		ImageId:   imageID,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": featureImagesToMaps(resp.Data)})
+}
+
+func (h *FeaturesHandler) setFeatureCoverImage(w http.ResponseWriter, r *http.Request, featureID, imageID uint64) {
+	resp, err := h.galleryClient.SetFeatureCoverImage(middleware.ContextWithAuthFromRequest(r), &featurespb.SetFeatureCoverImageRequest{
+		FeatureId: featureID,
+		ImageId:   imageID,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": featureImagesToMaps(resp.Data)})
+}
+
+// featureImagesToMaps converts gallery images to the API response shape, in gallery order
+func featureImagesToMaps(images []*featurespb.Image) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(images))
+	for _, img := range images {
+		result = append(result, featureImageToMap(img))
+	}
+	return result
+}
+
+func featureImageToMap(img *featurespb.Image) map[string]interface{} {
+	return map[string]interface{}{
+		"id":            img.Id,
+		"url":           img.Url,
+		"thumbnail_url": img.ThumbnailUrl,
+		"position":      img.Position,
+		"is_cover":      img.IsCover,
+	}
+}
@@ -20,6 +20,14 @@ type FeaturesHandler struct {
 	marketplaceClient featurespb.FeatureMarketplaceServiceClient
 	profitClient      featurespb.FeatureProfitServiceClient
 	buildingClient    featurespb.BuildingServiceClient
+	watchlistClient   featurespb.WatchlistServiceClient
+	savedSearchClient featurespb.SavedSearchServiceClient
+	geometryClient    featurespb.FeatureGeometryServiceClient
+	galleryClient     featurespb.FeatureGalleryServiceClient
+	receiptClient     featurespb.TradeReceiptServiceClient
+	portfolioClient   featurespb.FeaturePortfolioServiceClient
+	historyClient     featurespb.FeatureHistoryServiceClient
+	summaryClient     featurespb.TradeSummaryServiceClient
 	authClient        pb.AuthServiceClient
 	locale            string
 }
@@ -30,7 +38,15 @@ func NewFeaturesHandler(featuresConn, authConn *grpc.ClientConn, locale string)
 		marketplaceClient: featurespb.NewFeatureMarketplaceServiceClient(featuresConn),
 		profitClient:      featurespb.NewFeatureProfitServiceClient(featuresConn),
 		buildingClient:    featurespb.NewBuildingServiceClient(featuresConn),
-		authClient:        pb.NewAuthServiceClient(authConn),
+		watchlistClient:   featurespb.NewWatchlistServiceClient(featuresConn),
+		savedSearchClient: featurespb.NewSavedSearchServiceClient(featuresConn),
+		geometryClient:    featurespb.NewFeatureGeometryServiceClient(featuresConn),
+		galleryClient:     featurespb.NewFeatureGalleryServiceClient(featuresConn),
+		receiptClient:     featurespb.NewTradeReceiptServiceClient(featuresConn),
+		portfolioClient:   featurespb.NewFeaturePortfolioServiceClient(featuresConn),
+		historyClient:     featurespb.NewFeatureHistoryServiceClient(featuresConn),
+		summaryClient:     featurespb.NewTradeSummaryServiceClient(featuresConn),
+		authClient:        middleware.AuthClient(authConn),
 		locale:            locale,
 	}
 }
@@ -229,14 +245,7 @@ func (h *FeaturesHandler) GetFeature(w http.ResponseWriter, r *http.Request) {
 
 	// Add images
 	if len(feature.Images) > 0 {
-		images := make([]map[string]interface{}, 0, len(feature.Images))
-		for _, img := range feature.Images {
-			images = append(images, map[string]interface{}{
-				"id":  img.Id,
-				"url": img.Url,
-			})
-		}
-		featureMap["images"] = images
+		featureMap["images"] = featureImagesToMaps(feature.Images)
 	}
 
 	// Add seller (from latest trade)
@@ -383,6 +392,128 @@ func (h *FeaturesHandler) BuyFeature(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, map[string]interface{}{"data": featureMap})
 }
 
+// ReserveFeature handles POST /api/features/{feature}/reservation
+// Holds the feature for the caller while the 3D client confirms the purchase
+func (h *FeaturesHandler) ReserveFeature(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	featureID := extractIDFromPathWithSuffix(r.URL.Path, "/api/features/", "/reservation")
+	if featureID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid feature ID")
+		return
+	}
+
+	resp, err := h.marketplaceClient.ReserveFeature(r.Context(), &featurespb.CheckoutReservationRequest{
+		FeatureId: featureID,
+		UserId:    userCtx.UserID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": map[string]interface{}{
+			"feature_id":  resp.FeatureId,
+			"user_id":     resp.UserId,
+			"expires_at":  resp.ExpiresAt,
+			"ttl_seconds": resp.TtlSeconds,
+		},
+	})
+}
+
+// ReleaseFeatureReservation handles DELETE /api/features/{feature}/reservation
+func (h *FeaturesHandler) ReleaseFeatureReservation(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	featureID := extractIDFromPathWithSuffix(r.URL.Path, "/api/features/", "/reservation")
+	if featureID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid feature ID")
+		return
+	}
+
+	_, err = h.marketplaceClient.ReleaseReservation(r.Context(), &featurespb.CheckoutReservationRequest{
+		FeatureId: featureID,
+		UserId:    userCtx.UserID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetLimitedFeatureAvailability handles GET /api/features/limited/availability
+// Query params: feature_id, to show only the campaign of that feature
+func (h *FeaturesHandler) GetLimitedFeatureAvailability(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	var featureID uint64
+	if value := r.URL.Query().Get("feature_id"); value != "" {
+		featureID, err = strconv.ParseUint(value, 10, 64)
+		if err != nil || featureID == 0 {
+			writeError(w, http.StatusBadRequest, "invalid feature ID")
+			return
+		}
+	}
+
+	resp, err := h.marketplaceClient.GetLimitedFeatureAvailability(r.Context(), &featurespb.GetLimitedFeatureAvailabilityRequest{
+		UserId:    userCtx.UserID,
+		FeatureId: featureID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	campaigns := make([]map[string]interface{}, 0, len(resp.Data))
+	for _, campaign := range resp.Data {
+		campaigns = append(campaigns, map[string]interface{}{
+			"limitation_id":        campaign.LimitationId,
+			"title":                campaign.Title,
+			"total":                campaign.Total,
+			"sold":                 campaign.Sold,
+			"held":                 campaign.Held,
+			"remaining":            campaign.Remaining,
+			"individual_buy_limit": campaign.IndividualBuyLimit,
+			"individual_buy_count": campaign.IndividualBuyCount,
+			"user_purchased":       campaign.UserPurchased,
+			"user_remaining":       campaign.UserRemaining,
+			"user_held_feature_id": campaign.UserHeldFeatureId,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": campaigns})
+}
+
 // Building Feature API Handlers (v2) - See api-docs/features-service/build_feature_api.md
 
 // GetBuildPackage handles GET /api/v2/features/{feature}/build/package
@@ -422,14 +553,34 @@ func (h *FeaturesHandler) GetBuildPackage(w http.ResponseWriter, r *http.Request
 		Page:      page,
 	}
 
-	resp, err := h.buildingClient.GetBuildPackage(r.Context(), grpcReq)
+	// Streamed so large model pages are not bound by the gRPC message size limit
+	stream, err := h.buildingClient.StreamBuildPackage(r.Context(), grpcReq)
 	if err != nil {
 		writeGRPCError(w, err)
 		return
 	}
 
-	models := make([]map[string]interface{}, 0, len(resp.Models))
-	for _, model := range resp.Models {
+	var buildModels []*featurespb.BuildingModel
+	var coordinates []string
+	var currentPage, lastPage int32
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			writeGRPCError(w, err)
+			return
+		}
+		buildModels = append(buildModels, chunk.Models...)
+		if len(chunk.Coordinates) > 0 {
+			coordinates = chunk.Coordinates
+		}
+		currentPage, lastPage = chunk.CurrentPage, chunk.LastPage
+	}
+
+	models := make([]map[string]interface{}, 0, len(buildModels))
+	for _, model := range buildModels {
 		var images, attributes, file interface{}
 		json.Unmarshal([]byte(model.Images), &images)
 		json.Unmarshal([]byte(model.Attributes), &attributes)
@@ -451,13 +602,48 @@ func (h *FeaturesHandler) GetBuildPackage(w http.ResponseWriter, r *http.Request
 	response := map[string]interface{}{
 		"data": models,
 		"feature": map[string]interface{}{
-			"coordinates": resp.Coordinates,
+			"coordinates": coordinates,
+		},
+		"meta": map[string]interface{}{
+			"current_page": currentPage,
+			"last_page":    lastPage,
 		},
 	}
 
 	writeJSON(w, http.StatusOK, response)
 }
 
+// buildingRequest is the body of the build and update building endpoints
+type buildingRequest struct {
+	LaunchedSatisfaction numericString               `json:"launched_satisfaction" validate:"required,numeric"`
+	Rotation             numericString               `json:"rotation" validate:"required,numeric"`
+	Position             string                      `json:"position" validate:"required,position"`
+	Information          *buildingInformationRequest `json:"information"`
+}
+
+type buildingInformationRequest struct {
+	ActivityLine string `json:"activity_line" validate:"omitempty,max=255"`
+	Name         string `json:"name" validate:"omitempty,max=255"`
+	Address      string `json:"address" validate:"omitempty,max=255"`
+	PostalCode   string `json:"postal_code" validate:"omitempty,iranian_postal_code"`
+	Website      string `json:"website" validate:"omitempty,url"`
+	Description  string `json:"description"`
+}
+
+func (info *buildingInformationRequest) toProto() *featurespb.BuildingInformation {
+	if info == nil {
+		return nil
+	}
+	return &featurespb.BuildingInformation{
+		ActivityLine: info.ActivityLine,
+		Name:         info.Name,
+		Address:      info.Address,
+		PostalCode:   info.PostalCode,
+		Website:      info.Website,
+		Description:  info.Description,
+	}
+}
+
 // BuildFeature handles POST /api/v2/features/{feature}/build/{buildingModel}
 func (h *FeaturesHandler) BuildFeature(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -488,66 +674,99 @@ func (h *FeaturesHandler) BuildFeature(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var reqBody map[string]interface{}
-	if err := decodeRequestBody(r, &reqBody); err != nil {
-		if err == io.EOF {
-			writeValidationErrorWithLocale(w, "request body is required", h.locale)
-		} else {
-			writeValidationErrorWithLocale(w, "invalid request body", h.locale)
-		}
+	var req buildingRequest
+	if !decodeValidatedRequest(w, r, &req) {
 		return
 	}
 
 	grpcReq := &featurespb.BuildFeatureRequest{
-		FeatureId:       featureID,
-		BuildingModelId: buildingModelID,
+		FeatureId:            featureID,
+		BuildingModelId:      buildingModelID,
+		LaunchedSatisfaction: string(req.LaunchedSatisfaction),
+		Rotation:             string(req.Rotation),
+		Position:             req.Position,
+		Information:          req.Information.toProto(),
 	}
 
-	if launchedSatisfaction, ok := reqBody["launched_satisfaction"].(string); ok {
-		grpcReq.LaunchedSatisfaction = launchedSatisfaction
-	} else if ls, ok := reqBody["launched_satisfaction"].(float64); ok {
-		grpcReq.LaunchedSatisfaction = strconv.FormatFloat(ls, 'f', -1, 64)
+	_, err = h.buildingClient.BuildFeature(r.Context(), grpcReq)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
 	}
 
-	if rotation, ok := reqBody["rotation"].(string); ok {
-		grpcReq.Rotation = rotation
-	} else if rot, ok := reqBody["rotation"].(float64); ok {
-		grpcReq.Rotation = strconv.FormatFloat(rot, 'f', -1, 64)
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+// SimulateBuild handles GET /api/v2/features/{feature}/build/{buildingModel}/simulate
+// Query params: launched_satisfaction (defaults to the model's required satisfaction)
+func (h *FeaturesHandler) SimulateBuild(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
 	}
 
-	if position, ok := reqBody["position"].(string); ok {
-		grpcReq.Position = position
+	// Get user from context (set by auth middleware)
+	_, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
 	}
 
-	if info, ok := reqBody["information"].(map[string]interface{}); ok {
-		grpcReq.Information = &featurespb.BuildingInformation{}
-		if activityLine, ok := info["activity_line"].(string); ok {
-			grpcReq.Information.ActivityLine = activityLine
-		}
-		if name, ok := info["name"].(string); ok {
-			grpcReq.Information.Name = name
-		}
-		if address, ok := info["address"].(string); ok {
-			grpcReq.Information.Address = address
-		}
-		if postalCode, ok := info["postal_code"].(string); ok {
-			grpcReq.Information.PostalCode = postalCode
-		}
-		if website, ok := info["website"].(string); ok {
-			grpcReq.Information.Website = website
-		}
-		if description, ok := info["description"].(string); ok {
-			grpcReq.Information.Description = description
-		}
+	pathParts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v2/features/"), "/")
+	if len(pathParts) < 4 || pathParts[3] != "simulate" {
+		writeError(w, http.StatusBadRequest, "feature ID and building model ID are required")
+		return
+	}
+	featureID, err := strconv.ParseUint(pathParts[0], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid feature ID")
+		return
+	}
+	buildingModelID, err := strconv.ParseUint(pathParts[2], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid building model ID")
+		return
 	}
 
-	_, err = h.buildingClient.BuildFeature(r.Context(), grpcReq)
+	resp, err := h.buildingClient.SimulateBuild(r.Context(), &featurespb.SimulateBuildRequest{
+		FeatureId:            featureID,
+		BuildingModelId:      buildingModelID,
+		LaunchedSatisfaction: r.URL.Query().Get("launched_satisfaction"),
+	})
 	if err != nil {
 		writeGRPCError(w, err)
 		return
 	}
 
-	writeJSON(w, http.StatusOK, map[string]interface{}{})
+	missing := make([]map[string]interface{}, 0, len(resp.Missing))
+	for _, requirement := range resp.Missing {
+		item := map[string]interface{}{
+			"code":    requirement.Code,
+			"message": requirement.Message,
+		}
+		if requirement.Required != "" {
+			item["required"] = requirement.Required
+			item["available"] = requirement.Available
+		}
+		missing = append(missing, item)
+	}
+
+	data := map[string]interface{}{
+		"qualifies":               resp.Qualifies,
+		"missing":                 missing,
+		"profit_asset":            resp.ProfitAsset,
+		"projected_hourly_profit": resp.ProjectedHourlyProfit,
+	}
+	// Unknown models have no satisfaction or construction figures
+	if resp.RequiredSatisfaction != "" {
+		data["required_satisfaction"] = resp.RequiredSatisfaction
+		data["launched_satisfaction"] = resp.LaunchedSatisfaction
+		data["available_satisfaction"] = resp.AvailableSatisfaction
+		data["construction_seconds"] = resp.ConstructionSeconds
+		data["construction_end_date"] = resp.ConstructionEndDate
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": data})
 }
 
 // GetBuildings handles GET /api/v2/features/{feature}/build/buildings
@@ -644,57 +863,18 @@ func (h *FeaturesHandler) UpdateBuilding(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	var reqBody map[string]interface{}
-	if err := decodeRequestBody(r, &reqBody); err != nil {
-		if err == io.EOF {
-			writeValidationErrorWithLocale(w, "request body is required", h.locale)
-		} else {
-			writeValidationErrorWithLocale(w, "invalid request body", h.locale)
-		}
+	var req buildingRequest
+	if !decodeValidatedRequest(w, r, &req) {
 		return
 	}
 
 	grpcReq := &featurespb.UpdateBuildingRequest{
-		FeatureId:       featureID,
-		BuildingModelId: buildingModelID,
-	}
-
-	if launchedSatisfaction, ok := reqBody["launched_satisfaction"].(string); ok {
-		grpcReq.LaunchedSatisfaction = launchedSatisfaction
-	} else if ls, ok := reqBody["launched_satisfaction"].(float64); ok {
-		grpcReq.LaunchedSatisfaction = strconv.FormatFloat(ls, 'f', -1, 64)
-	}
-
-	if rotation, ok := reqBody["rotation"].(string); ok {
-		grpcReq.Rotation = rotation
-	} else if rot, ok := reqBody["rotation"].(float64); ok {
-		grpcReq.Rotation = strconv.FormatFloat(rot, 'f', -1, 64)
-	}
-
-	if position, ok := reqBody["position"].(string); ok {
-		grpcReq.Position = position
-	}
-
-	if info, ok := reqBody["information"].(map[string]interface{}); ok {
-		grpcReq.Information = &featurespb.BuildingInformation{}
-		if activityLine, ok := info["activity_line"].(string); ok {
-			grpcReq.Information.ActivityLine = activityLine
-		}
-		if name, ok := info["name"].(string); ok {
-			grpcReq.Information.Name = name
-		}
-		if address, ok := info["address"].(string); ok {
-			grpcReq.Information.Address = address
-		}
-		if postalCode, ok := info["postal_code"].(string); ok {
-			grpcReq.Information.PostalCode = postalCode
-		}
-		if website, ok := info["website"].(string); ok {
-			grpcReq.Information.Website = website
-		}
-		if description, ok := info["description"].(string); ok {
-			grpcReq.Information.Description = description
-		}
+		FeatureId:            featureID,
+		BuildingModelId:      buildingModelID,
+		LaunchedSatisfaction: string(req.LaunchedSatisfaction),
+		Rotation:             string(req.Rotation),
+		Position:             req.Position,
+		Information:          req.Information.toProto(),
 	}
 
 	_, err = h.buildingClient.UpdateBuilding(r.Context(), grpcReq)
@@ -852,41 +1032,23 @@ func (h *FeaturesHandler) CreateSellRequest(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	// Parse request body
-	var reqBody map[string]interface{}
-	if err := decodeRequestBody(r, &reqBody); err != nil {
-		if err == io.EOF {
-			writeValidationErrorWithLocale(w, "request body is required", h.locale)
-		} else {
-			writeValidationErrorWithLocale(w, "invalid request body", h.locale)
-		}
+	// Either explicit prices or a minimum price percentage; the marketplace
+	// service rejects requests with both or neither
+	var req struct {
+		PricePsc               numericString `json:"price_psc" validate:"omitempty,numeric"`
+		PriceIrr               numericString `json:"price_irr" validate:"omitempty,numeric"`
+		MinimumPricePercentage int32         `json:"minimum_price_percentage" validate:"omitempty,gte=80"`
+	}
+	if !decodeValidatedRequest(w, r, &req) {
 		return
 	}
 
 	grpcReq := &featurespb.CreateSellRequestRequest{
-		FeatureId: featureID,
-		SellerId:  sellerID,
-	}
-
-	// Parse price_psc (optional)
-	if pricePsc, ok := reqBody["price_psc"].(float64); ok {
-		grpcReq.PricePsc = strconv.FormatFloat(pricePsc, 'f', -1, 64)
-	} else if pricePsc, ok := reqBody["price_psc"].(string); ok {
-		grpcReq.PricePsc = pricePsc
-	}
-
-	// Parse price_irr (optional)
-	if priceIrr, ok := reqBody["price_irr"].(float64); ok {
-		grpcReq.PriceIrr = strconv.FormatFloat(priceIrr, 'f', -1, 64)
-	} else if priceIrr, ok := reqBody["price_irr"].(string); ok {
-		grpcReq.PriceIrr = priceIrr
-	}
-
-	// Parse minimum_price_percentage (optional)
-	if minPerc, ok := reqBody["minimum_price_percentage"].(float64); ok {
-		grpcReq.MinimumPricePercentage = int32(minPerc)
-	} else if minPerc, ok := reqBody["minimum_price_percentage"].(int); ok {
-		grpcReq.MinimumPricePercentage = int32(minPerc)
+		FeatureId:              featureID,
+		SellerId:               sellerID,
+		PricePsc:               string(req.PricePsc),
+		PriceIrr:               string(req.PriceIrr),
+		MinimumPricePercentage: req.MinimumPricePercentage,
 	}
 
 	resp, err := h.marketplaceClient.CreateSellRequest(r.Context(), grpcReq)
@@ -1006,31 +1168,10 @@ func (h *FeaturesHandler) UpdateGracePeriod(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	// Parse request body
-	var reqBody map[string]interface{}
-	if err := decodeRequestBody(r, &reqBody); err != nil {
-		if err == io.EOF {
-			writeValidationErrorWithLocale(w, "request body is required", h.locale)
-		} else {
-			writeValidationErrorWithLocale(w, "invalid request body", h.locale)
-		}
-		return
-	}
-
-	// Extract grace_period from request body
-	var gracePeriodDays int32
-	if gp, ok := reqBody["grace_period"].(float64); ok {
-		gracePeriodDays = int32(gp)
-	} else if gp, ok := reqBody["grace_period"].(int); ok {
-		gracePeriodDays = int32(gp)
-	} else {
-		writeValidationErrorWithLocale(w, "grace_period is required and must be an integer", h.locale)
-		return
+	var req struct {
+		GracePeriod int32 `json:"grace_period" validate:"required,gte=1,lte=30"`
 	}
-
-	// Validate grace period range (1-30)
-	if gracePeriodDays < 1 || gracePeriodDays > 30 {
-		writeValidationErrorWithLocale(w, "grace_period must be between 1 and 30", h.locale)
+	if !decodeValidatedRequest(w, r, &req) {
 		return
 	}
 
@@ -1038,7 +1179,7 @@ func (h *FeaturesHandler) UpdateGracePeriod(w http.ResponseWriter, r *http.Reque
 	grpcReq := &featurespb.UpdateGracePeriodRequest{
 		RequestId:       requestID,
 		SellerId:        sellerID,
-		GracePeriodDays: gracePeriodDays,
+		GracePeriodDays: req.GracePeriod,
 	}
 
 	// Call gRPC service
@@ -1051,3 +1192,699 @@ func (h *FeaturesHandler) UpdateGracePeriod(w http.ResponseWriter, r *http.Reque
 	// Return empty JSON response (Laravel returns {})
 	writeJSON(w, http.StatusOK, map[string]interface{}{})
 }
+
+// CounterBuyRequest handles POST /api/buy-requests/{buyFeatureRequest}/counter
+// The seller answers a pending buy request with their own price
+func (h *FeaturesHandler) CounterBuyRequest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	requestID := extractIDFromPathWithSuffix(r.URL.Path, "/api/buy-requests/", "/counter")
+	if requestID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid buy request ID")
+		return
+	}
+
+	var req struct {
+		PricePsc numericString `json:"price_psc" validate:"omitempty,numeric"`
+		PriceIrr numericString `json:"price_irr" validate:"omitempty,numeric"`
+		Note     string        `json:"note" validate:"omitempty,max=500"`
+	}
+	if !decodeValidatedRequest(w, r, &req) {
+		return
+	}
+
+	resp, err := h.marketplaceClient.CounterBuyRequest(r.Context(), &featurespb.CounterBuyRequestRequest{
+		RequestId: requestID,
+		SellerId:  userCtx.UserID,
+		PricePsc:  string(req.PricePsc),
+		PriceIrr:  string(req.PriceIrr),
+		Note:      req.Note,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"data": formatNegotiation(resp)})
+}
+
+// AcceptCounterOffer handles POST /api/buy-requests/{buyFeatureRequest}/counter/accept
+// The buyer agrees to the seller's price and the sale completes
+func (h *FeaturesHandler) AcceptCounterOffer(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	requestID := extractIDFromPathWithSuffix(r.URL.Path, "/api/buy-requests/", "/counter/accept")
+	if requestID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid buy request ID")
+		return
+	}
+
+	resp, err := h.marketplaceClient.AcceptCounterOffer(r.Context(), &featurespb.AnswerCounterOfferRequest{
+		RequestId: requestID,
+		BuyerId:   userCtx.UserID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": formatBuyRequest(resp)})
+}
+
+// DeclineCounterOffer handles POST /api/buy-requests/{buyFeatureRequest}/counter/decline
+// The request stays pending at the buyer's price
+func (h *FeaturesHandler) DeclineCounterOffer(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	requestID := extractIDFromPathWithSuffix(r.URL.Path, "/api/buy-requests/", "/counter/decline")
+	if requestID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid buy request ID")
+		return
+	}
+
+	resp, err := h.marketplaceClient.DeclineCounterOffer(r.Context(), &featurespb.AnswerCounterOfferRequest{
+		RequestId: requestID,
+		BuyerId:   userCtx.UserID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": formatNegotiation(resp)})
+}
+
+// GetNegotiation handles GET /api/buy-requests/{buyFeatureRequest}/negotiation
+// Returns every round of the negotiation to the buyer or the seller
+func (h *FeaturesHandler) GetNegotiation(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	requestID := extractIDFromPathWithSuffix(r.URL.Path, "/api/buy-requests/", "/negotiation")
+	if requestID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid buy request ID")
+		return
+	}
+
+	resp, err := h.marketplaceClient.GetNegotiation(r.Context(), &featurespb.GetNegotiationRequest{
+		RequestId: requestID,
+		UserId:    userCtx.UserID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": formatNegotiation(resp)})
+}
+
+func formatNegotiation(resp *featurespb.NegotiationResponse) map[string]interface{} {
+	offers := make([]map[string]interface{}, 0, len(resp.Offers))
+	var openOffer map[string]interface{}
+	for _, offer := range resp.Offers {
+		offerMap := map[string]interface{}{
+			"id":           offer.Id,
+			"round":        offer.Round,
+			"offered_by":   offer.OfferedBy,
+			"price_psc":    offer.PricePsc,
+			"price_irr":    offer.PriceIrr,
+			"note":         offer.Note,
+			"status":       offer.Status,
+			"responded_at": offer.RespondedAt,
+			"created_at":   offer.CreatedAt,
+		}
+		offers = append(offers, offerMap)
+		if resp.OpenOffer != nil && offer.Id == resp.OpenOffer.Id {
+			openOffer = offerMap
+		}
+	}
+
+	return map[string]interface{}{
+		"buy_request": formatBuyRequest(resp.BuyRequest),
+		"rounds_used": resp.RoundsUsed,
+		"max_rounds":  resp.MaxRounds,
+		"open_offer":  openOffer,
+		"offers":      offers,
+	}
+}
+
+func formatBuyRequest(resp *featurespb.BuyRequestResponse) map[string]interface{} {
+	if resp == nil {
+		return nil
+	}
+	reqMap := map[string]interface{}{
+		"id":                     resp.Id,
+		"feature_id":             resp.FeatureId,
+		"status":                 resp.Status,
+		"note":                   resp.Note,
+		"price_psc":              resp.PricePsc,
+		"price_irr":              resp.PriceIrr,
+		"requested_grace_period": resp.RequestedGracePeriod,
+		"created_at":             resp.CreatedAt,
+	}
+	if resp.Buyer != nil {
+		reqMap["buyer"] = map[string]interface{}{
+			"id":            resp.Buyer.Id,
+			"code":          resp.Buyer.Code,
+			"profile_photo": resp.Buyer.ProfilePhoto,
+		}
+	}
+	if resp.Seller != nil {
+		reqMap["seller"] = map[string]interface{}{
+			"id":   resp.Seller.Id,
+			"code": resp.Seller.Code,
+		}
+	}
+	if resp.FeatureProperties != nil {
+		reqMap["feature_properties"] = map[string]interface{}{
+			"id":      resp.FeatureProperties.Id,
+			"address": resp.FeatureProperties.Address,
+			"karbari": resp.FeatureProperties.Karbari,
+			"area":    resp.FeatureProperties.Area,
+		}
+	}
+	return reqMap
+}
+
+// WatchFeature handles POST /api/features/{feature}/watch
+// Adds the feature to the authenticated user's watchlist
+func (h *FeaturesHandler) WatchFeature(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	featureID, ok := watchFeatureIDFromPath(r.URL.Path)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "invalid feature ID")
+		return
+	}
+
+	resp, err := h.watchlistClient.AddToWatchlist(r.Context(), &featurespb.AddToWatchlistRequest{
+		UserId:    userCtx.UserID,
+		FeatureId: featureID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": resp.Data,
+	})
+}
+
+// UnwatchFeature handles DELETE /api/features/{feature}/watch
+func (h *FeaturesHandler) UnwatchFeature(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	featureID, ok := watchFeatureIDFromPath(r.URL.Path)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "invalid feature ID")
+		return
+	}
+
+	_, err = h.watchlistClient.RemoveFromWatchlist(r.Context(), &featurespb.RemoveFromWatchlistRequest{
+		UserId:    userCtx.UserID,
+		FeatureId: featureID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListWatchlist handles GET /api/watchlist
+func (h *FeaturesHandler) ListWatchlist(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	resp, err := h.watchlistClient.ListWatchlist(r.Context(), &featurespb.ListWatchlistRequest{
+		UserId: userCtx.UserID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": resp.Data,
+	})
+}
+
+// watchFeatureIDFromPath extracts {feature} from /api/features/{feature}/watch
+func watchFeatureIDFromPath(path string) (uint64, bool) {
+	idStr := strings.TrimSuffix(strings.TrimSuffix(strings.TrimPrefix(path, "/api/features/"), "/"), "/watch")
+	featureID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil || featureID == 0 {
+		return 0, false
+	}
+	return featureID, true
+}
+
+// ListMarketplaceListings handles GET /api/marketplace/listings
+// Query params: region, karbari or color, currency (irr|psc), min_price, max_price,
+// sort (newest|price_asc|price_desc), page, per_page. No authentication required.
+func (h *FeaturesHandler) ListMarketplaceListings(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	query := r.URL.Query()
+	var region int32
+	if regionStr := query.Get("region"); regionStr != "" {
+		parsed, err := strconv.ParseInt(regionStr, 10, 32)
+		if err != nil || parsed < 1 {
+			writeValidationErrorWithLocale(w, "region must be a positive integer", h.locale)
+			return
+		}
+		region = int32(parsed)
+	}
+
+	page, perPage := parsePagination(r, 1, 0)
+
+	resp, err := h.marketplaceClient.ListForSaleFeatures(r.Context(), &featurespb.ListForSaleFeaturesRequest{
+		Region:   region,
+		Karbari:  query.Get("karbari"),
+		Color:    query.Get("color"),
+		Currency: query.Get("currency"),
+		MinPrice: query.Get("min_price"),
+		MaxPrice: query.Get("max_price"),
+		Sort:     query.Get("sort"),
+		Page:     page,
+		PerPage:  perPage,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	listings := make([]map[string]interface{}, 0, len(resp.Data))
+	for _, listing := range resp.Data {
+		listings = append(listings, map[string]interface{}{
+			"sell_request_id": listing.SellRequestId,
+			"feature_id":      listing.FeatureId,
+			"properties_id":   listing.PropertiesId,
+			"karbari":         listing.Karbari,
+			"region":          listing.Region,
+			"area":            listing.Area,
+			"address":         listing.Address,
+			"seller_id":       listing.SellerId,
+			"price_psc":       listing.PricePsc,
+			"price_irr":       listing.PriceIrr,
+			"date":            listing.Date,
+			"time":            listing.Time,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": listings,
+		"links": map[string]interface{}{
+			"first": resp.Links.GetFirst(),
+			"last":  resp.Links.GetLast(),
+			"prev":  resp.Links.GetPrev(),
+			"next":  resp.Links.GetNext(),
+		},
+		"meta": map[string]interface{}{
+			"current_page": resp.Meta.GetCurrentPage(),
+			"path":         resp.Meta.GetPath(),
+			"per_page":     resp.Meta.GetPerPage(),
+		},
+	})
+}
+
+// savedSearchBody is the request body of CreateSavedSearch and UpdateSavedSearch.
+// Prices may be sent as JSON numbers or strings.
+type savedSearchBody struct {
+	Name     string      `json:"name"`
+	Region   int32       `json:"region"`
+	Karbari  string      `json:"karbari"`
+	Color    string      `json:"color"`
+	Currency string      `json:"currency"`
+	MinPrice json.Number `json:"min_price"`
+	MaxPrice json.Number `json:"max_price"`
+}
+
+// ListSavedSearches handles GET /api/marketplace/saved-searches
+func (h *FeaturesHandler) ListSavedSearches(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	resp, err := h.savedSearchClient.ListSavedSearches(r.Context(), &featurespb.ListSavedSearchesRequest{
+		UserId: userCtx.UserID,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	searches := make([]map[string]interface{}, 0, len(resp.Data))
+	for _, search := range resp.Data {
+		searches = append(searches, savedSearchToMap(search))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": searches})
+}
+
+// CreateSavedSearch handles POST /api/marketplace/saved-searches
+// Body: {"name", "region", "karbari" or "color", "currency", "min_price", "max_price"}
+func (h *FeaturesHandler) CreateSavedSearch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	var req savedSearchBody
+	if err := decodeRequestBody(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.savedSearchClient.CreateSavedSearch(r.Context(), &featurespb.CreateSavedSearchRequest{
+		UserId:   userCtx.UserID,
+		Name:     req.Name,
+		Region:   req.Region,
+		Karbari:  req.Karbari,
+		Color:    req.Color,
+		Currency: req.Currency,
+		MinPrice: req.MinPrice.String(),
+		MaxPrice: req.MaxPrice.String(),
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"data": savedSearchToMap(resp.Data)})
+}
+
+// UpdateSavedSearch handles PUT /api/marketplace/saved-searches/{savedSearch}
+// Replaces all criteria; the body is the same as CreateSavedSearch.
+func (h *FeaturesHandler) UpdateSavedSearch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	searchID := extractIDFromPathWithSuffix(r.URL.Path, "/api/marketplace/saved-searches/", "")
+	if searchID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid saved search ID")
+		return
+	}
+
+	var req savedSearchBody
+	if err := decodeRequestBody(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.savedSearchClient.UpdateSavedSearch(r.Context(), &featurespb.UpdateSavedSearchRequest{
+		UserId:        userCtx.UserID,
+		SavedSearchId: searchID,
+		Name:          req.Name,
+		Region:        req.Region,
+		Karbari:       req.Karbari,
+		Color:         req.Color,
+		Currency:      req.Currency,
+		MinPrice:      req.MinPrice.String(),
+		MaxPrice:      req.MaxPrice.String(),
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": savedSearchToMap(resp.Data)})
+}
+
+// DeleteSavedSearch handles DELETE /api/marketplace/saved-searches/{savedSearch}
+func (h *FeaturesHandler) DeleteSavedSearch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	searchID := extractIDFromPathWithSuffix(r.URL.Path, "/api/marketplace/saved-searches/", "")
+	if searchID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid saved search ID")
+		return
+	}
+
+	_, err = h.savedSearchClient.DeleteSavedSearch(r.Context(), &featurespb.DeleteSavedSearchRequest{
+		UserId:        userCtx.UserID,
+		SavedSearchId: searchID,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func savedSearchToMap(search *featurespb.SavedSearch) map[string]interface{} {
+	return map[string]interface{}{
+		"id":        search.GetId(),
+		"name":      search.GetName(),
+		"region":    search.GetRegion(),
+		"karbari":   search.GetKarbari(),
+		"currency":  search.GetCurrency(),
+		"min_price": search.GetMinPrice(),
+		"max_price": search.GetMaxPrice(),
+		"date":      search.GetDate(),
+		"time":      search.GetTime(),
+	}
+}
+
+// UpdateFeatureGeometry handles PUT /api/admin/features/{feature}/geometry
+// Body: {"coordinates": ["x,y", ...], "reason": "..."}. The ring must be closed:
+// the last coordinate repeats the first.
+func (h *FeaturesHandler) UpdateFeatureGeometry(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	featureID := extractIDFromPathWithSuffix(r.URL.Path, "/api/admin/features/", "/geometry")
+	if featureID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid feature_id")
+		return
+	}
+
+	var req struct {
+		Coordinates []string `json:"coordinates"`
+		Reason      string   `json:"reason"`
+	}
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	coordinates := make([]*featurespb.Coordinate, 0, len(req.Coordinates))
+	for _, point := range req.Coordinates {
+		parts := strings.Split(point, ",")
+		if len(parts) != 2 {
+			writeValidationErrorWithLocale(w, "invalid coordinates format: expected array of 'x,y' strings", h.locale)
+			return
+		}
+		coordinates = append(coordinates, &featurespb.Coordinate{
+			X: strings.TrimSpace(parts[0]),
+			Y: strings.TrimSpace(parts[1]),
+		})
+	}
+
+	resp, err := h.geometryClient.UpdateFeatureGeometry(middleware.ContextWithAuthFromRequest(r), &featurespb.UpdateFeatureGeometryRequest{
+		FeatureId:   featureID,
+		Coordinates: coordinates,
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": geometryVersionToMap(resp.Data)})
+}
+
+// ListGeometryVersions handles GET /api/admin/features/{feature}/geometry/versions
+func (h *FeaturesHandler) ListGeometryVersions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	featureID := extractIDFromPathWithSuffix(r.URL.Path, "/api/admin/features/", "/geometry/versions")
+	if featureID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid feature_id")
+		return
+	}
+
+	resp, err := h.geometryClient.ListGeometryVersions(middleware.ContextWithAuthFromRequest(r), &featurespb.ListGeometryVersionsRequest{
+		FeatureId: featureID,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	versions := make([]map[string]interface{}, 0, len(resp.Data))
+	for _, version := range resp.Data {
+		versions = append(versions, geometryVersionToMap(version))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": versions})
+}
+
+// geometryVersionToMap converts a geometry version to the API response shape,
+// with coordinates as "x,y" strings like the features listing
+func geometryVersionToMap(version *featurespb.GeometryVersion) map[string]interface{} {
+	if version == nil {
+		return nil
+	}
+	coordinates := make([]string, 0, len(version.Coordinates))
+	for _, coord := range version.Coordinates {
+		coordinates = append(coordinates, coord.X+","+coord.Y)
+	}
+	return map[string]interface{}{
+		"id":          version.Id,
+		"feature_id":  version.FeatureId,
+		"version":     version.Version,
+		"coordinates": coordinates,
+		"area":        version.Area,
+		"edited_by":   version.EditedBy,
+		"reason":      version.Reason,
+		"date":        version.Date,
+		"time":        version.Time,
+	}
+}
+
+// GetFeatureHistory handles GET /api/features/{feature}/history
+// Query params: page, per_page (default 20, at most 100)
+// Returns the trades, requests, buildings and profit payouts of a feature the
+// user owns, oldest first
+func (h *FeaturesHandler) GetFeatureHistory(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	featureID := extractIDFromPathWithSuffix(r.URL.Path, "/api/features/", "/history")
+	if featureID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid feature_id")
+		return
+	}
+
+	page, perPage := parsePagination(r, 1, 0)
+	resp, err := h.historyClient.GetFeatureHistory(middleware.ContextWithAuthFromRequest(r), &featurespb.GetFeatureHistoryRequest{
+		FeatureId: featureID,
+		UserId:    userCtx.UserID,
+		Page:      page,
+		PerPage:   perPage,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": resp.Data,
+		"meta": resp.Meta,
+	})
+}
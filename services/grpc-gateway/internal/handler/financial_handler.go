@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"net/http"
@@ -25,7 +26,7 @@ func NewFinancialHandler(financialConn, authConn *grpc.ClientConn, locale string
 	return &FinancialHandler{
 		orderClient: financialpb.NewOrderServiceClient(financialConn),
 		storeClient: financialpb.NewStoreServiceClient(financialConn),
-		authClient:  pb.NewAuthServiceClient(authConn),
+		authClient:  middleware.AuthClient(authConn),
 		locale:      locale,
 	}
 }
@@ -80,6 +81,8 @@ func (h *FinancialHandler) CreateOrder(w http.ResponseWriter, r *http.Request) {
 		UserId: userID,
 		Amount: req.Amount,
 		Asset:  req.Asset,
+		Ip:     getClientIP(r),
+		Device: r.UserAgent(),
 	}
 
 	resp, err := h.orderClient.CreateOrder(r.Context(), grpcReq)
@@ -93,13 +96,30 @@ func (h *FinancialHandler) CreateOrder(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-// HandleCallback handles POST /api/parsian/callback
+// maxCallbackPayloadSize caps how much of a gateway callback body is read
+const maxCallbackPayloadSize = 64 << 10
+
+// HandleCallback handles POST /api/parsian/callback. GET carries the same
+// fields in the query, for the links payment sandboxes return in place of
+// the bank's page; the token and the bank's confirm guard both alike.
 func (h *FinancialHandler) HandleCallback(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
+	if r.Method != http.MethodPost && r.Method != http.MethodGet {
 		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
 		return
 	}
 
+	// Keep the body exactly as received so the callback can be audited later,
+	// then restore it for form parsing
+	rawPayload, err := io.ReadAll(io.LimitReader(r.Body, maxCallbackPayloadSize))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read request body")
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(rawPayload))
+	if r.Method == http.MethodGet {
+		rawPayload = []byte(r.URL.RawQuery)
+	}
+
 	// Parse form data (Parsian sends form-encoded data)
 	if err := r.ParseForm(); err != nil {
 		writeError(w, http.StatusBadRequest, "failed to parse form data")
@@ -148,6 +168,7 @@ func (h *FinancialHandler) HandleCallback(w http.ResponseWriter, r *http.Request
 		Status:           int32(status),
 		Token:            token,
 		AdditionalParams: additionalParams,
+		RawPayload:       string(rawPayload),
 	}
 
 	resp, err := h.orderClient.HandleCallback(r.Context(), grpcReq)
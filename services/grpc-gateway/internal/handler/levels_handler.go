@@ -3,22 +3,36 @@ package handler
 import (
 	"net/http"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc"
 
+	"metargb/grpc-gateway/internal/apiversion"
+	"metargb/grpc-gateway/internal/middleware"
 	levelspb "metargb/shared/pb/levels"
+	"metargb/shared/pkg/helpers"
 )
 
 type LevelsHandler struct {
-	levelClient levelspb.LevelServiceClient
-	appURL      string
+	levelClient      levelspb.LevelServiceClient
+	onboardingClient levelspb.OnboardingServiceClient
+	activityClient   levelspb.ActivityServiceClient
+	appURL           string
+	levelShapes      apiversion.Shapes[*levelspb.Level]
 }
 
 func NewLevelsHandler(conn *grpc.ClientConn, appURL string) *LevelsHandler {
-	return &LevelsHandler{
-		levelClient: levelspb.NewLevelServiceClient(conn),
-		appURL:      strings.TrimSuffix(appURL, "/"),
+	h := &LevelsHandler{
+		levelClient:      levelspb.NewLevelServiceClient(conn),
+		onboardingClient: levelspb.NewOnboardingServiceClient(conn),
+		activityClient:   levelspb.NewActivityServiceClient(conn),
+		appURL:           strings.TrimSuffix(appURL, "/"),
 	}
+	// v2 keeps the Laravel LevelResource shape until it needs its own
+	h.levelShapes = apiversion.Shapes[*levelspb.Level]{
+		apiversion.V1: func(level *levelspb.Level) interface{} { return h.formatLevelResponse(level) },
+	}
+	return h
 }
 
 // prefixImageURL prefixes an image/file URL with APP_URL/uploads/ if it's not already a full URL
@@ -94,17 +108,12 @@ func (h *LevelsHandler) GetLevel(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Extract slug from path (supports both /api/levels and /api/v2/levels)
-	var slug string
-	if strings.HasPrefix(r.URL.Path, "/api/v2/levels/") {
-		slug = extractSlugFromPath(r.URL.Path, "/api/v2/levels/")
-	} else if strings.HasPrefix(r.URL.Path, "/api/levels/") {
-		slug = extractSlugFromPath(r.URL.Path, "/api/levels/")
-	} else {
+	// Same path in every API version: /levels/{slug}
+	slug, ok := levelSlug(r, "")
+	if !ok {
 		writeError(w, http.StatusBadRequest, "invalid path")
 		return
 	}
-	
 	if slug == "" {
 		writeError(w, http.StatusBadRequest, "level slug is required")
 		return
@@ -120,8 +129,7 @@ func (h *LevelsHandler) GetLevel(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	level := h.formatLevelResponse(resp.Level)
-	writeJSON(w, http.StatusOK, level)
+	writeJSON(w, http.StatusOK, h.levelShapes.Render(r, resp.Level))
 }
 
 // GetLevelGeneralInfo handles GET /api/v2/levels/{slug}/general-info
@@ -132,25 +140,17 @@ func (h *LevelsHandler) GetLevelGeneralInfo(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	// Extract slug from path (supports both /api/levels and /api/v2/levels)
-	var slug string
-	if strings.HasPrefix(r.URL.Path, "/api/v2/levels/") {
-		slug = extractSlugFromPath(r.URL.Path, "/api/v2/levels/")
-	} else if strings.HasPrefix(r.URL.Path, "/api/levels/") {
-		slug = extractSlugFromPath(r.URL.Path, "/api/levels/")
-	} else {
+	// Same path in every API version: /levels/{slug}/general-info
+	slug, ok := levelSlug(r, "/general-info")
+	if !ok {
 		writeError(w, http.StatusBadRequest, "invalid path")
 		return
 	}
-	
 	if slug == "" {
 		writeError(w, http.StatusBadRequest, "level slug is required")
 		return
 	}
 
-	// Remove "/general-info" suffix if present
-	slug = strings.TrimSuffix(slug, "/general-info")
-
 	grpcReq := &levelspb.GetLevelGeneralInfoRequest{
 		LevelSlug: slug,
 	}
@@ -199,25 +199,17 @@ func (h *LevelsHandler) GetLevelGem(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Extract slug from path (supports both /api/levels and /api/v2/levels)
-	var slug string
-	if strings.HasPrefix(r.URL.Path, "/api/v2/levels/") {
-		slug = extractSlugFromPath(r.URL.Path, "/api/v2/levels/")
-	} else if strings.HasPrefix(r.URL.Path, "/api/levels/") {
-		slug = extractSlugFromPath(r.URL.Path, "/api/levels/")
-	} else {
+	// Same path in every API version: /levels/{slug}/gem
+	slug, ok := levelSlug(r, "/gem")
+	if !ok {
 		writeError(w, http.StatusBadRequest, "invalid path")
 		return
 	}
-	
 	if slug == "" {
 		writeError(w, http.StatusBadRequest, "level slug is required")
 		return
 	}
 
-	// Remove "/gem" suffix if present
-	slug = strings.TrimSuffix(slug, "/gem")
-
 	grpcReq := &levelspb.GetLevelGemRequest{
 		LevelSlug: slug,
 	}
@@ -263,25 +255,17 @@ func (h *LevelsHandler) GetLevelGift(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Extract slug from path (supports both /api/levels and /api/v2/levels)
-	var slug string
-	if strings.HasPrefix(r.URL.Path, "/api/v2/levels/") {
-		slug = extractSlugFromPath(r.URL.Path, "/api/v2/levels/")
-	} else if strings.HasPrefix(r.URL.Path, "/api/levels/") {
-		slug = extractSlugFromPath(r.URL.Path, "/api/levels/")
-	} else {
+	// Same path in every API version: /levels/{slug}/gift
+	slug, ok := levelSlug(r, "/gift")
+	if !ok {
 		writeError(w, http.StatusBadRequest, "invalid path")
 		return
 	}
-	
 	if slug == "" {
 		writeError(w, http.StatusBadRequest, "level slug is required")
 		return
 	}
 
-	// Remove "/gift" suffix if present
-	slug = strings.TrimSuffix(slug, "/gift")
-
 	grpcReq := &levelspb.GetLevelGiftRequest{
 		LevelSlug: slug,
 	}
@@ -335,25 +319,17 @@ func (h *LevelsHandler) GetLevelLicenses(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Extract slug from path (supports both /api/levels and /api/v2/levels)
-	var slug string
-	if strings.HasPrefix(r.URL.Path, "/api/v2/levels/") {
-		slug = extractSlugFromPath(r.URL.Path, "/api/v2/levels/")
-	} else if strings.HasPrefix(r.URL.Path, "/api/levels/") {
-		slug = extractSlugFromPath(r.URL.Path, "/api/levels/")
-	} else {
+	// Same path in every API version: /levels/{slug}/licenses
+	slug, ok := levelSlug(r, "/licenses")
+	if !ok {
 		writeError(w, http.StatusBadRequest, "invalid path")
 		return
 	}
-	
 	if slug == "" {
 		writeError(w, http.StatusBadRequest, "level slug is required")
 		return
 	}
 
-	// Remove "/licenses" suffix if present
-	slug = strings.TrimSuffix(slug, "/licenses")
-
 	grpcReq := &levelspb.GetLevelLicensesRequest{
 		LevelSlug: slug,
 	}
@@ -403,25 +379,17 @@ func (h *LevelsHandler) GetLevelPrize(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Extract slug from path (supports both /api/levels and /api/v2/levels)
-	var slug string
-	if strings.HasPrefix(r.URL.Path, "/api/v2/levels/") {
-		slug = extractSlugFromPath(r.URL.Path, "/api/v2/levels/")
-	} else if strings.HasPrefix(r.URL.Path, "/api/levels/") {
-		slug = extractSlugFromPath(r.URL.Path, "/api/levels/")
-	} else {
+	// Same path in every API version: /levels/{slug}/prize
+	slug, ok := levelSlug(r, "/prize")
+	if !ok {
 		writeError(w, http.StatusBadRequest, "invalid path")
 		return
 	}
-	
 	if slug == "" {
 		writeError(w, http.StatusBadRequest, "level slug is required")
 		return
 	}
 
-	// Remove "/prize" suffix if present
-	slug = strings.TrimSuffix(slug, "/prize")
-
 	grpcReq := &levelspb.GetLevelPrizesRequest{
 		LevelSlug: slug,
 	}
@@ -569,25 +537,106 @@ func (h *LevelsHandler) HandleLevelsRoutes(w http.ResponseWriter, r *http.Reques
 	writeError(w, http.StatusNotFound, "not found")
 }
 
-// extractSlugFromPath extracts slug from URL path
-// Supports both /api/levels and /api/v2/levels prefixes
-// e.g., "/api/levels/my-level" -> "my-level"
-// e.g., "/api/v2/levels/my-level/general-info" -> "my-level/general-info"
-func extractSlugFromPath(path, prefix string) string {
-	// Support both /api/levels and /api/v2/levels
-	if strings.HasPrefix(path, "/api/v2/levels/") {
-		if prefix == "/api/v2/levels/" || prefix == "/api/levels/" {
-			return strings.TrimPrefix(path, "/api/v2/levels/")
+// levelSlug returns the {slug} of /levels/{slug}<suffix>, whichever API
+// version the request was made under
+func levelSlug(r *http.Request, suffix string) (string, bool) {
+	rest, ok := strings.CutPrefix(apiversion.Path(r), "/levels/")
+	if !ok {
+		return "", false
+	}
+	return strings.TrimSuffix(rest, suffix), true
+}
+
+// GetOnboardingState handles GET /api/onboarding
+// Returns the authenticated user's onboarding checklist and its reward
+func (h *LevelsHandler) GetOnboardingState(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	resp, err := h.onboardingClient.GetOnboardingState(r.Context(), &levelspb.GetOnboardingStateRequest{
+		UserId: userCtx.UserID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": formatOnboardingState(resp)})
+}
+
+// Heartbeat handles POST /api/activity/heartbeat
+// Keeps the authenticated user's activity session open while the client is in use
+func (h *LevelsHandler) Heartbeat(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	resp, err := h.activityClient.Heartbeat(r.Context(), &levelspb.HeartbeatRequest{
+		UserId: userCtx.UserID,
+		Ip:     getClientIP(r),
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": map[string]interface{}{
+			"accepted":               resp.Accepted,
+			"session_minutes":        resp.SessionMinutes,
+			"next_heartbeat_seconds": resp.NextHeartbeatSeconds,
+		},
+	})
+}
+
+func formatOnboardingState(state *levelspb.OnboardingStateResponse) map[string]interface{} {
+	steps := make([]map[string]interface{}, 0, len(state.Steps))
+	for _, step := range state.Steps {
+		item := map[string]interface{}{
+			"key":          step.Key,
+			"title":        step.Title,
+			"completed":    step.Completed,
+			"completed_at": nil,
 		}
-	} else if strings.HasPrefix(path, "/api/levels/") {
-		if prefix == "/api/levels/" || prefix == "/api/v2/levels/" {
-			return strings.TrimPrefix(path, "/api/levels/")
+		if step.CompletedAt > 0 {
+			item["completed_at"] = helpers.FormatJalaliDateTime(time.Unix(step.CompletedAt, 0))
 		}
+		steps = append(steps, item)
 	}
-	
-	// Fallback to original logic
-	if !strings.HasPrefix(path, prefix) {
-		return ""
+
+	var reward map[string]interface{}
+	if state.Reward != nil {
+		reward = map[string]interface{}{
+			"asset":      state.Reward.Asset,
+			"amount":     state.Reward.Amount,
+			"granted":    state.Reward.Granted,
+			"granted_at": nil,
+		}
+		if state.Reward.GrantedAt > 0 {
+			reward["granted_at"] = helpers.FormatJalaliDateTime(time.Unix(state.Reward.GrantedAt, 0))
+		}
+	}
+
+	return map[string]interface{}{
+		"steps":           steps,
+		"completed_steps": state.CompletedSteps,
+		"total_steps":     state.TotalSteps,
+		"completed":       state.Completed,
+		"reward":          reward,
 	}
-	return strings.TrimPrefix(path, prefix)
 }
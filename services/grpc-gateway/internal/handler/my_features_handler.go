@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"metargb/grpc-gateway/internal/middleware"
-	pb "metargb/shared/pb/auth"
 	featurespb "metargb/shared/pb/features"
 )
 
@@ -83,23 +82,42 @@ func (h *FeaturesHandler) ListMyFeatures(w http.ResponseWriter, r *http.Request)
 	writeJSON(w, http.StatusOK, response)
 }
 
-// GetMyFeature handles GET /api/my-features/{user}/features/{feature}
-func (h *FeaturesHandler) GetMyFeature(w http.ResponseWriter, r *http.Request) {
+// GetPortfolio handles GET /api/portfolio
+// Query params: page, per_page (default 20, at most 50)
+func (h *FeaturesHandler) GetPortfolio(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
 		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
 		return
 	}
 
-	token := extractTokenFromHeader(r)
-	if token == "" {
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
 		writeError(w, http.StatusUnauthorized, "authentication required")
 		return
 	}
 
-	validateReq := &pb.ValidateTokenRequest{Token: token}
-	_, err := h.authClient.ValidateToken(r.Context(), validateReq)
+	page, perPage := parsePagination(r, 1, 0)
+	resp, err := h.portfolioClient.GetUserPortfolio(r.Context(), &featurespb.GetUserPortfolioRequest{
+		UserId:  userCtx.UserID,
+		Page:    page,
+		PerPage: perPage,
+	})
 	if err != nil {
-		writeError(w, http.StatusUnauthorized, "invalid or expired token")
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data":    resp.Data,
+		"summary": resp.Summary,
+		"meta":    resp.Meta,
+	})
+}
+
+// GetMyFeature handles GET /api/my-features/{user}/features/{feature}
+func (h *FeaturesHandler) GetMyFeature(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
 		return
 	}
 
@@ -145,19 +163,6 @@ func (h *FeaturesHandler) AddMyFeatureImages(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	token := extractTokenFromHeader(r)
-	if token == "" {
-		writeError(w, http.StatusUnauthorized, "authentication required")
-		return
-	}
-
-	validateReq := &pb.ValidateTokenRequest{Token: token}
-	_, err := h.authClient.ValidateToken(r.Context(), validateReq)
-	if err != nil {
-		writeError(w, http.StatusUnauthorized, "invalid or expired token")
-		return
-	}
-
 	path := strings.TrimPrefix(r.URL.Path, "/api/my-features/")
 	parts := strings.Split(path, "/")
 	if len(parts) < 3 || parts[1] != "add-image" {
@@ -194,8 +199,8 @@ func (h *FeaturesHandler) AddMyFeatureImages(w http.ResponseWriter, r *http.Requ
 
 	for _, fileHeader := range files {
 		contentType := fileHeader.Header.Get("Content-Type")
-		if contentType != "image/png" && contentType != "image/jpeg" && contentType != "image/bmp" {
-			writeValidationError(w, "invalid image type: must be PNG, JPG, or BMP")
+		if contentType != "image/png" && contentType != "image/jpeg" {
+			writeValidationError(w, "invalid image type: must be PNG or JPG")
 			return
 		}
 
@@ -237,15 +242,7 @@ func (h *FeaturesHandler) AddMyFeatureImages(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	images := make([]map[string]interface{}, 0, len(resp.Feature.Images))
-	for _, img := range resp.Feature.Images {
-		images = append(images, map[string]interface{}{
-			"id":  img.Id,
-			"url": img.Url,
-		})
-	}
-
-	writeJSON(w, http.StatusOK, map[string]interface{}{"data": images})
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": featureImagesToMaps(resp.Feature.Images)})
 }
 
 // RemoveMyFeatureImage handles POST /api/my-features/{user}/remove-image/{feature}/image/{image}
@@ -255,19 +252,6 @@ func (h *FeaturesHandler) RemoveMyFeatureImage(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	token := extractTokenFromHeader(r)
-	if token == "" {
-		writeError(w, http.StatusUnauthorized, "authentication required")
-		return
-	}
-
-	validateReq := &pb.ValidateTokenRequest{Token: token}
-	_, err := h.authClient.ValidateToken(r.Context(), validateReq)
-	if err != nil {
-		writeError(w, http.StatusUnauthorized, "invalid or expired token")
-		return
-	}
-
 	path := strings.TrimPrefix(r.URL.Path, "/api/my-features/")
 	parts := strings.Split(path, "/")
 	if len(parts) < 5 || parts[1] != "remove-image" || parts[3] != "image" {
@@ -285,7 +269,7 @@ func (h *FeaturesHandler) RemoveMyFeatureImage(w http.ResponseWriter, r *http.Re
 		ImageId:   imageID,
 	}
 
-	_, err = h.featureClient.RemoveMyFeatureImage(r.Context(), grpcReq)
+	_, err := h.featureClient.RemoveMyFeatureImage(r.Context(), grpcReq)
 	if err != nil {
 		writeGRPCError(w, err)
 		return
@@ -301,19 +285,6 @@ func (h *FeaturesHandler) UpdateMyFeature(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	token := extractTokenFromHeader(r)
-	if token == "" {
-		writeError(w, http.StatusUnauthorized, "authentication required")
-		return
-	}
-
-	validateReq := &pb.ValidateTokenRequest{Token: token}
-	_, err := h.authClient.ValidateToken(r.Context(), validateReq)
-	if err != nil {
-		writeError(w, http.StatusUnauthorized, "invalid or expired token")
-		return
-	}
-
 	path := strings.TrimPrefix(r.URL.Path, "/api/my-features/")
 	parts := strings.Split(path, "/")
 	if len(parts) < 3 || parts[1] != "features" {
@@ -348,7 +319,7 @@ func (h *FeaturesHandler) UpdateMyFeature(w http.ResponseWriter, r *http.Request
 		MinimumPricePercentage: reqBody.MinimumPricePercentage,
 	}
 
-	_, err = h.featureClient.UpdateMyFeature(r.Context(), grpcReq)
+	_, err := h.featureClient.UpdateMyFeature(r.Context(), grpcReq)
 	if err != nil {
 		writeGRPCError(w, err)
 		return
@@ -379,10 +350,7 @@ func buildFeatureMap(feature *featurespb.Feature) map[string]interface{} {
 	if len(feature.Images) > 0 {
 		images := make([]map[string]interface{}, 0, len(feature.Images))
 		for _, img := range feature.Images {
-			images = append(images, map[string]interface{}{
-				"id":  img.Id,
-				"url": img.Url,
-			})
+			images = append(images, featureImageToMap(img))
 		}
 		featureMap["images"] = images
 	}
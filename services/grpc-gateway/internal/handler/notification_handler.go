@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -15,13 +16,19 @@ import (
 
 type NotificationHandler struct {
 	notificationClient notificationpb.NotificationServiceClient
+	preferenceClient   notificationpb.NotificationPreferenceServiceClient
+	templateClient     notificationpb.NotificationTemplateServiceClient
+	botClient          notificationpb.NotificationBotServiceClient
 	authClient         pb.AuthServiceClient
 }
 
 func NewNotificationHandler(notificationConn *grpc.ClientConn, authConn *grpc.ClientConn) *NotificationHandler {
 	return &NotificationHandler{
 		notificationClient: notificationpb.NewNotificationServiceClient(notificationConn),
-		authClient:         pb.NewAuthServiceClient(authConn),
+		preferenceClient:   notificationpb.NewNotificationPreferenceServiceClient(notificationConn),
+		templateClient:     notificationpb.NewNotificationTemplateServiceClient(notificationConn),
+		botClient:          notificationpb.NewNotificationBotServiceClient(notificationConn),
+		authClient:         middleware.AuthClient(authConn),
 	}
 }
 
@@ -188,6 +195,429 @@ func (h *NotificationHandler) MarkAllAsRead(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// GetPreferences handles GET /api/settings/notifications
+// Returns the channel x category preference matrix for the authenticated user
+func (h *NotificationHandler) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// Extract user ID from token
+	userID, err := h.extractUserID(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	// Call gRPC service
+	resp, err := h.preferenceClient.GetPreferences(r.Context(), &notificationpb.GetPreferencesRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": transformPreferences(resp.Preferences),
+	})
+}
+
+// UpdatePreferences handles PUT /api/settings/notifications
+// Body: {"preferences": {"sms": {"marketing": false}, "email": {"dynasty": true}}}
+// Only the listed channel/category pairs are changed.
+func (h *NotificationHandler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut && r.Method != http.MethodPatch {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// Extract user ID from token
+	userID, err := h.extractUserID(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req struct {
+		Preferences map[string]map[string]bool `json:"preferences"`
+	}
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	// Build gRPC request
+	grpcReq := &notificationpb.UpdatePreferencesRequest{UserId: userID}
+	for channel, categories := range req.Preferences {
+		for category, enabled := range categories {
+			grpcReq.Preferences = append(grpcReq.Preferences, &notificationpb.NotificationPreference{
+				Channel:  channel,
+				Category: category,
+				Enabled:  enabled,
+			})
+		}
+	}
+
+	// Call gRPC service
+	resp, err := h.preferenceClient.UpdatePreferences(r.Context(), grpcReq)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": transformPreferences(resp.Preferences),
+	})
+}
+
+// GetDigestSettings handles GET /api/settings/notifications/digest
+// Returns the digest mode, timezone and quiet hours of the authenticated user
+func (h *NotificationHandler) GetDigestSettings(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// Extract user ID from token
+	userID, err := h.extractUserID(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	// Call gRPC service
+	resp, err := h.preferenceClient.GetDigestSettings(r.Context(), &notificationpb.GetDigestSettingsRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": transformDigestSettings(resp.Settings),
+	})
+}
+
+// UpdateDigestSettings handles PUT /api/settings/notifications/digest
+// Body: {"mode": "daily", "timezone": "Asia/Tehran", "quiet_hours_start": 23, "quiet_hours_end": 7}
+// mode is one of immediate, hourly or daily. Equal quiet hours disable them.
+func (h *NotificationHandler) UpdateDigestSettings(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut && r.Method != http.MethodPatch {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// Extract user ID from token
+	userID, err := h.extractUserID(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req struct {
+		Mode            string `json:"mode"`
+		Timezone        string `json:"timezone"`
+		QuietHoursStart int32  `json:"quiet_hours_start"`
+		QuietHoursEnd   int32  `json:"quiet_hours_end"`
+	}
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	// Call gRPC service
+	resp, err := h.preferenceClient.UpdateDigestSettings(r.Context(), &notificationpb.UpdateDigestSettingsRequest{
+		UserId: userID,
+		Settings: &notificationpb.DigestSettings{
+			Mode:            req.Mode,
+			Timezone:        req.Timezone,
+			QuietHoursStart: req.QuietHoursStart,
+			QuietHoursEnd:   req.QuietHoursEnd,
+		},
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": transformDigestSettings(resp.Settings),
+	})
+}
+
+// GetBotLink handles GET /api/settings/notifications/bot
+// Returns whether the authenticated user linked a bot chat
+func (h *NotificationHandler) GetBotLink(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// Extract user ID from token
+	userID, err := h.extractUserID(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	// Call gRPC service
+	resp, err := h.botClient.GetBotLink(r.Context(), &notificationpb.GetBotLinkRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	data := map[string]interface{}{
+		"linked": resp.Linked,
+	}
+	if resp.Linked {
+		data["provider"] = resp.Provider
+		data["username"] = resp.Username
+		data["linked_at"] = resp.LinkedAt
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": data,
+	})
+}
+
+// CreateBotLinkCode handles POST /api/settings/notifications/bot
+// Issues a code the user sends to the bot (or a link that opens the bot with
+// it) to link that chat
+func (h *NotificationHandler) CreateBotLinkCode(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// Extract user ID from token
+	userID, err := h.extractUserID(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	// Call gRPC service
+	resp, err := h.botClient.CreateBotLinkCode(r.Context(), &notificationpb.CreateBotLinkCodeRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{
+		"data": map[string]interface{}{
+			"provider":   resp.Provider,
+			"code":       resp.Code,
+			"link_url":   resp.LinkUrl,
+			"expires_at": resp.ExpiresAt,
+		},
+	})
+}
+
+// UnlinkBot handles DELETE /api/settings/notifications/bot
+func (h *NotificationHandler) UnlinkBot(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// Extract user ID from token
+	userID, err := h.extractUserID(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	// Call gRPC service
+	if _, err := h.botClient.UnlinkBot(r.Context(), &notificationpb.UnlinkBotRequest{
+		UserId: userID,
+	}); err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListTemplates handles GET /api/admin/notifications/templates
+func (h *NotificationHandler) ListTemplates(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userID, err := h.extractUserID(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	resp, err := h.templateClient.ListTemplates(r.Context(), &notificationpb.ListTemplatesRequest{UserId: userID})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	templates := resp.Templates
+	if templates == nil {
+		templates = []string{}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": templates})
+}
+
+// PreviewTemplate handles POST /api/admin/notifications/templates/preview
+// Body: {"template": "email/otp", "subject": "...", "data": {"OtpCode": "482193"}}
+// Fields missing from data are filled with sample values and listed in sample_fields.
+func (h *NotificationHandler) PreviewTemplate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userID, err := h.extractUserID(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req struct {
+		Template string            `json:"template"`
+		Subject  string            `json:"subject"`
+		Data     map[string]string `json:"data"`
+	}
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	resp, err := h.templateClient.PreviewTemplate(r.Context(), &notificationpb.PreviewTemplateRequest{
+		UserId:   userID,
+		Template: req.Template,
+		Subject:  req.Subject,
+		Data:     req.Data,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	fields, sampleFields := resp.Fields, resp.SampleFields
+	if fields == nil {
+		fields = []string{}
+	}
+	if sampleFields == nil {
+		sampleFields = []string{}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": map[string]interface{}{
+			"template":      resp.Template,
+			"subject":       resp.Subject,
+			"html_body":     resp.HtmlBody,
+			"fields":        fields,
+			"sample_fields": sampleFields,
+		},
+	})
+}
+
+// TestSendTemplate handles POST /api/admin/notifications/templates/test-send
+// Body: {"template": "email/otp", "data": {...}, "email": "admin@example.com", "phone": "09120000000", "sms_template": "verify"}
+// Only the given email and phone receive the message; preferences and digests are skipped.
+func (h *NotificationHandler) TestSendTemplate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userID, err := h.extractUserID(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req struct {
+		Template    string            `json:"template"`
+		Subject     string            `json:"subject"`
+		Data        map[string]string `json:"data"`
+		Email       string            `json:"email"`
+		Phone       string            `json:"phone"`
+		SMSTemplate string            `json:"sms_template"`
+		SMSMessage  string            `json:"sms_message"`
+	}
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	resp, err := h.templateClient.TestSendTemplate(r.Context(), &notificationpb.TestSendTemplateRequest{
+		UserId:      userID,
+		Template:    req.Template,
+		Subject:     req.Subject,
+		Data:        req.Data,
+		Email:       req.Email,
+		Phone:       req.Phone,
+		SmsTemplate: req.SMSTemplate,
+		SmsMessage:  req.SMSMessage,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": map[string]interface{}{
+			"email_message_id": resp.EmailMessageId,
+			"sms_message_id":   resp.SmsMessageId,
+		},
+	})
+}
+
+// transformDigestSettings converts digest settings to the API response shape
+func transformDigestSettings(settings *notificationpb.DigestSettings) map[string]interface{} {
+	if settings == nil {
+		settings = &notificationpb.DigestSettings{}
+	}
+	return map[string]interface{}{
+		"mode":              settings.Mode,
+		"timezone":          settings.Timezone,
+		"quiet_hours_start": settings.QuietHoursStart,
+		"quiet_hours_end":   settings.QuietHoursEnd,
+	}
+}
+
+// transformPreferences groups the flat preference list as channel -> category -> enabled
+func transformPreferences(prefs []*notificationpb.NotificationPreference) map[string]map[string]bool {
+	result := make(map[string]map[string]bool)
+	for _, pref := range prefs {
+		if result[pref.Channel] == nil {
+			result[pref.Channel] = make(map[string]bool)
+		}
+		result[pref.Channel][pref.Category] = pref.Enabled
+	}
+	return result
+}
+
 // transformNotification transforms proto notification to API docs format
 func (h *NotificationHandler) transformNotification(notif *notificationpb.Notification) map[string]interface{} {
 	// Parse created_at Jalali datetime string and split into date and time
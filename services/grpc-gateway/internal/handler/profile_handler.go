@@ -0,0 +1,248 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"metargb/grpc-gateway/internal/middleware"
+	pb "metargb/shared/pb/auth"
+	dynastypb "metargb/shared/pb/dynasty"
+)
+
+// profileSectionTimeout bounds each section of a profile, so one slow service
+// only drops its own section
+const profileSectionTimeout = 3 * time.Second
+
+// Profile sections a client may ask for with the fields query param
+const (
+	profileBasic    = "basic"
+	profileLevels   = "levels"
+	profileDynasty  = "dynasty"
+	profileFeatures = "features"
+)
+
+var profileSections = []string{profileBasic, profileLevels, profileDynasty, profileFeatures}
+
+// ProfileHandler serves a user's public profile assembled from several
+// services
+type ProfileHandler struct {
+	userClient    pb.UserServiceClient
+	dynastyClient dynastypb.DynastyServiceClient
+	locale        string
+}
+
+func NewProfileHandler(authConn, dynastyConn *grpc.ClientConn, locale string) *ProfileHandler {
+	return &ProfileHandler{
+		userClient:    pb.NewUserServiceClient(authConn),
+		dynastyClient: dynastypb.NewDynastyServiceClient(dynastyConn),
+		locale:        locale,
+	}
+}
+
+// GetProfile handles GET /api/users/{user}/profile
+// {user} is a user ID or citizen code.
+// Query params: fields, comma separated sections among basic, levels, dynasty
+// and features. Without it the response is the basic profile alone, shaped as
+// Laravel's ProfileResource.
+//
+// The sections are fetched in parallel. A section whose service fails is left
+// out and named in "errors"; the request only fails when every section did.
+func (h *ProfileHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	pathParts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/users/"), "/"), "/")
+	if len(pathParts) != 2 || pathParts[0] == "" || pathParts[1] != "profile" {
+		writeError(w, http.StatusBadRequest, "invalid path format: expected /api/users/{user}/profile")
+		return
+	}
+
+	fields, ok := parseProfileFields(r.URL.Query().Get("fields"))
+	if !ok {
+		writeValidationErrorWithLocale(w, "fields may only contain "+strings.Join(profileSections, ", "), h.locale)
+		return
+	}
+
+	infoReq := &pb.GetUserInfoRequest{}
+	if userID, err := strconv.ParseUint(pathParts[0], 10, 64); err == nil {
+		infoReq.UserId = userID
+	} else {
+		infoReq.Code = pathParts[0]
+	}
+	user, err := h.userClient.GetUserInfo(r.Context(), infoReq)
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	// The viewer decides which basic fields the user's privacy settings show
+	var viewerUserID uint64
+	if userCtx, err := middleware.GetUserFromRequest(r); err == nil {
+		viewerUserID = userCtx.UserID
+	}
+
+	if fields == nil {
+		resp, err := h.userClient.GetUserProfile(r.Context(), &pb.GetUserProfileRequest{UserId: user.Id, ViewerUserId: viewerUserID})
+		if err != nil {
+			writeGRPCErrorWithLocale(w, err, h.locale)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": formatUserProfile(resp.Data)})
+		return
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		sections = map[string]interface{}{}
+		failures = map[string]string{}
+		firstErr error
+	)
+	for _, field := range fields {
+		wg.Add(1)
+		go func(field string) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(r.Context(), profileSectionTimeout)
+			defer cancel()
+
+			section, err := h.profileSection(ctx, field, user.Id, viewerUserID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failures[field] = profileSectionError(err)
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			sections[field] = section
+		}(field)
+	}
+	wg.Wait()
+
+	if len(sections) == 0 {
+		writeGRPCErrorWithLocale(w, firstErr, h.locale)
+		return
+	}
+
+	data := map[string]interface{}{
+		"id":   user.Id,
+		"code": user.Code,
+	}
+	for field, section := range sections {
+		data[field] = section
+	}
+	response := map[string]interface{}{"data": data}
+	if len(failures) > 0 {
+		response["errors"] = failures
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// profileSection fetches one section of userID's profile
+func (h *ProfileHandler) profileSection(ctx context.Context, field string, userID, viewerUserID uint64) (interface{}, error) {
+	switch field {
+	case profileBasic:
+		resp, err := h.userClient.GetUserProfile(ctx, &pb.GetUserProfileRequest{UserId: userID, ViewerUserId: viewerUserID})
+		if err != nil {
+			return nil, err
+		}
+		return formatUserProfile(resp.Data), nil
+	case profileLevels:
+		resp, err := h.userClient.GetUserLevels(ctx, &pb.GetUserLevelsRequest{UserId: userID})
+		if err != nil {
+			return nil, err
+		}
+		return formatUserLevels(resp.Data), nil
+	case profileDynasty:
+		resp, err := h.dynastyClient.GetUserDynasty(ctx, &dynastypb.GetUserDynastyRequest{UserId: userID})
+		if err != nil {
+			return nil, err
+		}
+		return formatPublicDynasty(resp), nil
+	case profileFeatures:
+		resp, err := h.userClient.GetUserFeaturesCount(ctx, &pb.GetUserFeaturesCountRequest{UserId: userID})
+		if err != nil {
+			return nil, err
+		}
+		return formatUserFeaturesCount(resp.Data), nil
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "unknown profile section %q", field)
+}
+
+// formatPublicDynasty formats the part of a dynasty other users may see. The
+// owner's other features, which GET /api/dynasty lists, are left out.
+func formatPublicDynasty(dynasty *dynastypb.DynastyResponse) map[string]interface{} {
+	if !dynasty.UserHasDynasty {
+		return map[string]interface{}{"has_dynasty": false}
+	}
+
+	data := map[string]interface{}{
+		"has_dynasty":   true,
+		"id":            dynasty.Id,
+		"created_at":    dynasty.CreatedAt,
+		"profile_image": dynasty.ProfileImage,
+	}
+	if feature := dynasty.DynastyFeature; feature != nil {
+		data["members_count"] = feature.FamilyMembersCount
+		data["feature"] = map[string]interface{}{
+			"id":            feature.Id,
+			"properties_id": feature.PropertiesId,
+			"area":          feature.Area,
+			"density":       feature.Density,
+		}
+	}
+	return data
+}
+
+// parseProfileFields parses the fields query param into distinct sections. It
+// returns nil for an empty param and false for an unknown section.
+func parseProfileFields(raw string) ([]string, bool) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, true
+	}
+
+	var fields []string
+	seen := map[string]bool{}
+	for _, field := range strings.Split(raw, ",") {
+		field = strings.ToLower(strings.TrimSpace(field))
+		if field == "" || seen[field] {
+			continue
+		}
+		known := false
+		for _, section := range profileSections {
+			known = known || field == section
+		}
+		if !known {
+			return nil, false
+		}
+		seen[field] = true
+		fields = append(fields, field)
+	}
+	return fields, len(fields) > 0
+}
+
+// profileSectionError describes why a section is missing without leaking the
+// service's error message
+func profileSectionError(err error) string {
+	switch status.Code(err) {
+	case codes.DeadlineExceeded:
+		return "timeout"
+	case codes.NotFound:
+		return "not_found"
+	case codes.PermissionDenied:
+		return "forbidden"
+	}
+	return "unavailable"
+}
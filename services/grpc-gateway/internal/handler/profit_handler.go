@@ -21,7 +21,7 @@ type ProfitHandler struct {
 func NewProfitHandler(featuresConn, authConn *grpc.ClientConn) *ProfitHandler {
 	return &ProfitHandler{
 		profitClient: featurespb.NewFeatureProfitServiceClient(featuresConn),
-		authClient:   pb.NewAuthServiceClient(authConn),
+		authClient:   middleware.AuthClient(authConn),
 	}
 }
 
@@ -204,6 +204,117 @@ func (h *ProfitHandler) GetSingleProfit(w http.ResponseWriter, r *http.Request)
 	writeJSON(w, http.StatusOK, map[string]interface{}{"data": profitMap})
 }
 
+// GetFeatureProfit handles GET /api/hourly-profits/features/{feature}
+func (h *ProfitHandler) GetFeatureProfit(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// Extract authenticated user ID from token
+	userID, err := h.getAuthenticatedUserID(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	// Extract feature ID from path: /api/hourly-profits/features/{feature}
+	path := strings.TrimPrefix(r.URL.Path, "/api/hourly-profits/features/")
+	if path == "" || path == r.URL.Path {
+		writeError(w, http.StatusBadRequest, "feature ID is required in path")
+		return
+	}
+
+	featureID, err := strconv.ParseUint(path, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid feature ID")
+		return
+	}
+
+	resp, err := h.profitClient.GetFeatureProfit(r.Context(), &featurespb.GetFeatureProfitRequest{
+		UserId:    userID,
+		FeatureId: featureID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": map[string]interface{}{
+			"id":                  resp.Profit.Id,
+			"feature_id":          resp.Profit.FeatureId,
+			"asset":               resp.Profit.Asset,
+			"amount":              resp.Profit.Amount,
+			"dead_line":           resp.Profit.DeadLine,
+			"is_active":           resp.Profit.IsActive,
+			"hourly_rate":         resp.HourlyRate,
+			"accruing":            resp.Accruing,
+			"seconds_to_deadline": resp.SecondsToDeadline,
+			"compound":            resp.Compound,
+		},
+	})
+}
+
+// ProfitSettings handles GET and PUT /api/hourly-profits/settings
+func (h *ProfitHandler) ProfitSettings(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPut {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// Extract authenticated user ID from token
+	userID, err := h.getAuthenticatedUserID(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	var resp *featurespb.ProfitSettingsResponse
+	if r.Method == http.MethodGet {
+		resp, err = h.profitClient.GetProfitSettings(r.Context(), &featurespb.GetProfitSettingsRequest{UserId: userID})
+	} else {
+		var req struct {
+			AutoClaim *bool `json:"auto_claim"`
+			Compound  *bool `json:"compound"`
+		}
+		if err := decodeRequestBody(r, &req); err != nil {
+			if err == io.EOF {
+				writeValidationError(w, "request body is required")
+			} else {
+				writeValidationError(w, "invalid request body")
+			}
+			return
+		}
+		if req.AutoClaim == nil {
+			writeValidationError(w, "auto_claim field is required")
+			return
+		}
+		if req.Compound == nil {
+			writeValidationError(w, "compound field is required")
+			return
+		}
+
+		resp, err = h.profitClient.UpdateProfitSettings(r.Context(), &featurespb.UpdateProfitSettingsRequest{
+			UserId:    userID,
+			AutoClaim: *req.AutoClaim,
+			Compound:  *req.Compound,
+		})
+	}
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": map[string]interface{}{
+			"auto_claim":           resp.AutoClaim,
+			"compound":             resp.Compound,
+			"withdraw_profit_days": resp.WithdrawProfitDays,
+		},
+	})
+}
+
 // getAuthenticatedUserID extracts user ID from context (set by auth middleware)
 func (h *ProfitHandler) getAuthenticatedUserID(r *http.Request) (uint64, error) {
 	userCtx, err := middleware.GetUserFromRequest(r)
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"metargb/shared/pkg/helpers"
+)
+
+// requestValidationLocale is the locale of validation messages, matching the
+// Farsi messages of the Laravel API
+const requestValidationLocale = "fa"
+
+// requestValidator checks request structs against their validate tags. Field
+// errors are reported by json name.
+var requestValidator = helpers.NewCustomValidator()
+
+// numericString holds a number sent either as a JSON number or as a string,
+// e.g. "price_psc": 12.5 or "price_psc": "12.5". Use the "numeric" validate
+// tag to reject strings that are not numbers.
+type numericString string
+
+func (n *numericString) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*n = numericString(s)
+		return nil
+	}
+
+	// Other literals (true, objects, ...) are kept as-is for the numeric tag to reject
+	if f, err := strconv.ParseFloat(string(data), 64); err == nil {
+		*n = numericString(strconv.FormatFloat(f, 'f', -1, 64))
+	} else {
+		*n = numericString(data)
+	}
+	return nil
+}
+
+// fieldDecodeError reports a form or query value that could not be converted
+// to the type of its struct field
+type fieldDecodeError struct {
+	field string
+	kind  reflect.Kind
+	err   error
+}
+
+func (e *fieldDecodeError) Error() string {
+	return fmt.Sprintf("failed to set field %s: %v", e.field, e.err)
+}
+
+func (e *fieldDecodeError) Unwrap() error {
+	return e.err
+}
+
+// decodeValidatedRequest decodes the request body (JSON or form-data) and query
+// parameters into req, then validates req against its validate tags. On failure
+// it writes a 422 response with Laravel-style field errors and returns false.
+//
+//	var req struct {
+//		Content string `json:"content" validate:"required"`
+//	}
+//	if !decodeValidatedRequest(w, r, &req) {
+//		return
+//	}
+func decodeValidatedRequest(w http.ResponseWriter, r *http.Request, req interface{}) bool {
+	if err := decodeRequestFields(r, req); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		var fieldErr *fieldDecodeError
+		switch {
+		case errors.As(err, &typeErr) && typeErr.Field != "":
+			helpers.WriteValidationErrorResponseFromMap(w, map[string]string{
+				typeErr.Field: helpers.FormatTypeError(typeErr.Field, typeErr.Type.Kind(), requestValidationLocale),
+			}, requestValidationLocale)
+		case errors.As(err, &fieldErr):
+			helpers.WriteValidationErrorResponseFromMap(w, map[string]string{
+				fieldErr.field: helpers.FormatTypeError(fieldErr.field, fieldErr.kind, requestValidationLocale),
+			}, requestValidationLocale)
+		default:
+			writeValidationErrorWithLocale(w, "invalid request body", requestValidationLocale)
+		}
+		return false
+	}
+
+	if err := requestValidator.Validate(req); err != nil {
+		validationErrors, ok := helpers.AsValidationErrors(err)
+		if !ok {
+			writeError(w, http.StatusInternalServerError, "failed to validate request")
+			return false
+		}
+		helpers.WriteValidationErrorResponse(w, validationErrors, requestValidationLocale)
+		return false
+	}
+
+	return true
+}
+
+// decodeRequestFields decodes like decodeRequestBody, but reports body errors
+// instead of falling back to query parameters so that type errors reach the
+// client. An empty body is not an error; required fields are left to validation.
+func decodeRequestFields(r *http.Request, v interface{}) error {
+	if r.Body == nil || r.ContentLength <= 0 {
+		return decodeQueryParams(r, v)
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	var err error
+	if strings.HasPrefix(contentType, "multipart/form-data") || strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		err = decodeFormData(r, v)
+	} else {
+		err = decodeJSONBody(r, v)
+	}
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	mergeQueryParams(r, v)
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+	"strings"
 )
 
 type StorageHandler struct {
@@ -32,6 +33,16 @@ func NewStorageHandler(storageServiceAddr string) *StorageHandler {
 		// We just need to ensure the host is correct
 		req.Host = targetURL.Host
 	}
+	// CORS is answered by the gateway middleware; drop any upstream headers
+	// so browsers do not see two Access-Control-Allow-Origin values
+	proxy.ModifyResponse = func(resp *http.Response) error {
+		for name := range resp.Header {
+			if strings.HasPrefix(name, "Access-Control-") {
+				resp.Header.Del(name)
+			}
+		}
+		return nil
+	}
 
 	return &StorageHandler{
 		proxy: proxy,
@@ -4,6 +4,8 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc"
 
@@ -11,6 +13,7 @@ import (
 	pbAuth "metargb/shared/pb/auth"
 	pbCommon "metargb/shared/pb/common"
 	pbSupport "metargb/shared/pb/support"
+	"metargb/shared/pkg/helpers"
 )
 
 type SupportHandler struct {
@@ -18,6 +21,11 @@ type SupportHandler struct {
 	reportClient    pbSupport.ReportServiceClient
 	userEventClient pbSupport.UserEventReportServiceClient
 	noteClient      pbSupport.NoteServiceClient
+	disputeClient   pbSupport.DisputeServiceClient
+	emailClient     pbSupport.TicketEmailServiceClient
+	statsClient     pbSupport.SupportStatsServiceClient
+	chatClient      pbSupport.SupportChatServiceClient
+	faqClient       pbSupport.FaqServiceClient
 	authClient      pbAuth.AuthServiceClient
 }
 
@@ -27,7 +35,12 @@ func NewSupportHandler(supportConn, authConn *grpc.ClientConn) *SupportHandler {
 		reportClient:    pbSupport.NewReportServiceClient(supportConn),
 		userEventClient: pbSupport.NewUserEventReportServiceClient(supportConn),
 		noteClient:      pbSupport.NewNoteServiceClient(supportConn),
-		authClient:      pbAuth.NewAuthServiceClient(authConn),
+		disputeClient:   pbSupport.NewDisputeServiceClient(supportConn),
+		emailClient:     pbSupport.NewTicketEmailServiceClient(supportConn),
+		statsClient:     pbSupport.NewSupportStatsServiceClient(supportConn),
+		chatClient:      pbSupport.NewSupportChatServiceClient(supportConn),
+		faqClient:       pbSupport.NewFaqServiceClient(supportConn),
+		authClient:      middleware.AuthClient(authConn),
 	}
 }
 
@@ -237,6 +250,13 @@ func (h *SupportHandler) CreateTicket(w http.ResponseWriter, r *http.Request) {
 		ticketMap["department"] = resp.Department
 	}
 
+	// FAQs and training videos matching the ticket, see AcceptTicketSuggestion
+	suggestions := make([]map[string]interface{}, 0, len(resp.Suggestions))
+	for _, suggestion := range resp.Suggestions {
+		suggestions = append(suggestions, ticketSuggestionToMap(suggestion))
+	}
+	ticketMap["suggestions"] = suggestions
+
 	writeJSON(w, http.StatusCreated, ticketMap)
 }
 
@@ -954,3 +974,794 @@ func (h *SupportHandler) DeleteNote(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// ============================================================================
+// Trade Disputes API
+// ============================================================================
+
+// ListDisputes handles GET /api/disputes
+// Support agents get all open disputes
+func (h *SupportHandler) ListDisputes(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userID, err := h.getAuthUserID(r)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	resp, err := h.disputeClient.ListDisputes(r.Context(), &pbSupport.ListDisputesRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	disputes := make([]map[string]interface{}, 0, len(resp.Disputes))
+	for _, dispute := range resp.Disputes {
+		disputes = append(disputes, disputeToMap(dispute))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": disputes})
+}
+
+// OpenDispute handles POST /api/disputes
+func (h *SupportHandler) OpenDispute(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userID, err := h.getAuthUserID(r)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	var req struct {
+		TradeID     uint64 `json:"trade_id"`
+		Reason      string `json:"reason"`
+		Description string `json:"description"`
+	}
+
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	resp, err := h.disputeClient.OpenDispute(r.Context(), &pbSupport.OpenDisputeRequest{
+		UserId:      userID,
+		TradeId:     req.TradeID,
+		Reason:      req.Reason,
+		Description: req.Description,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"data": disputeToMap(resp)})
+}
+
+// GetDispute handles GET /api/disputes/{dispute}
+func (h *SupportHandler) GetDispute(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userID, err := h.getAuthUserID(r)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	disputeID, err := strconv.ParseUint(extractIDFromPath(r.URL.Path, "/api/disputes/"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid dispute_id")
+		return
+	}
+
+	resp, err := h.disputeClient.GetDispute(r.Context(), &pbSupport.GetDisputeRequest{
+		DisputeId: disputeID,
+		UserId:    userID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": disputeToMap(resp)})
+}
+
+// ResolveDispute handles POST /api/disputes/{dispute}/resolve
+// Only support agents can resolve disputes
+func (h *SupportHandler) ResolveDispute(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	agentID, err := h.getAuthUserID(r)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	disputeID := extractIDFromPathWithSuffix(r.URL.Path, "/api/disputes/", "/resolve")
+	if disputeID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid dispute_id")
+		return
+	}
+
+	var req struct {
+		Outcome string `json:"outcome"`
+		Note    string `json:"note"`
+	}
+
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	resp, err := h.disputeClient.ResolveDispute(r.Context(), &pbSupport.ResolveDisputeRequest{
+		DisputeId: disputeID,
+		AgentId:   agentID,
+		Outcome:   req.Outcome,
+		Note:      req.Note,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": disputeToMap(resp)})
+}
+
+// ============================================================================
+// Support Chat API
+// ============================================================================
+
+// StartSupportChat handles POST /api/tickets/{ticket}/chat
+// Returns the ticket's open chat, starting one if there is none
+func (h *SupportHandler) StartSupportChat(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userID, err := h.getAuthUserID(r)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	ticketID := extractIDFromPathWithSuffix(r.URL.Path, "/api/tickets/", "/chat")
+	if ticketID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid ticket_id")
+		return
+	}
+
+	resp, err := h.chatClient.StartChat(r.Context(), &pbSupport.StartChatRequest{
+		TicketId: ticketID,
+		UserId:   userID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": supportChatToMap(resp)})
+}
+
+// ListSupportChats handles GET /api/support/chats
+// Support agents get all open chats
+func (h *SupportHandler) ListSupportChats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userID, err := h.getAuthUserID(r)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	resp, err := h.chatClient.ListChats(r.Context(), &pbSupport.ListChatsRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	chats := make([]map[string]interface{}, 0, len(resp.Chats))
+	for _, chat := range resp.Chats {
+		chats = append(chats, supportChatToMap(chat))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": chats})
+}
+
+// GetSupportChat handles GET /api/support/chats/{chat}
+// Includes the chat's messages, oldest first
+func (h *SupportHandler) GetSupportChat(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userID, err := h.getAuthUserID(r)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	chatID, err := strconv.ParseUint(extractIDFromPath(r.URL.Path, "/api/support/chats/"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid chat_id")
+		return
+	}
+
+	resp, err := h.chatClient.GetChat(r.Context(), &pbSupport.GetChatRequest{
+		ChatId:          chatID,
+		UserId:          userID,
+		IncludeMessages: true,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	chat := supportChatToMap(resp)
+	messages := make([]map[string]interface{}, 0, len(resp.Messages))
+	for _, message := range resp.Messages {
+		messages = append(messages, supportChatMessageToMap(message))
+	}
+	chat["messages"] = messages
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": chat})
+}
+
+// SendSupportChatMessage handles POST /api/support/chats/{chat}/messages
+// The message is also pushed to the chat's websocket room
+func (h *SupportHandler) SendSupportChatMessage(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userID, err := h.getAuthUserID(r)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	chatID := extractIDFromPathWithSuffix(r.URL.Path, "/api/support/chats/", "/messages")
+	if chatID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid chat_id")
+		return
+	}
+
+	var req struct {
+		Body string `json:"body"`
+	}
+
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	resp, err := h.chatClient.SendChatMessage(r.Context(), &pbSupport.SendChatMessageRequest{
+		ChatId: chatID,
+		UserId: userID,
+		Body:   req.Body,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"data": supportChatMessageToMap(resp)})
+}
+
+// CloseSupportChat handles POST /api/support/chats/{chat}/close
+func (h *SupportHandler) CloseSupportChat(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userID, err := h.getAuthUserID(r)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	chatID := extractIDFromPathWithSuffix(r.URL.Path, "/api/support/chats/", "/close")
+	if chatID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid chat_id")
+		return
+	}
+
+	resp, err := h.chatClient.CloseChat(r.Context(), &pbSupport.CloseChatRequest{
+		ChatId: chatID,
+		UserId: userID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": supportChatToMap(resp)})
+}
+
+// ExportSupportChatTranscript handles GET /api/support/chats/{chat}/transcript
+// Downloads the chat as a plain text file
+func (h *SupportHandler) ExportSupportChatTranscript(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userID, err := h.getAuthUserID(r)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	chatID := extractIDFromPathWithSuffix(r.URL.Path, "/api/support/chats/", "/transcript")
+	if chatID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid chat_id")
+		return
+	}
+
+	resp, err := h.chatClient.ExportChatTranscript(r.Context(), &pbSupport.ExportChatTranscriptRequest{
+		ChatId: chatID,
+		UserId: userID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+resp.Filename+`"`)
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, resp.Content)
+}
+
+// ============================================================================
+// FAQ Suggestions API
+// ============================================================================
+
+// AcceptTicketSuggestion handles POST /api/tickets/{ticket}/suggestions/{suggestion}/accept
+// The sender marks a suggestion as the answer, which closes the ticket
+func (h *SupportHandler) AcceptTicketSuggestion(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userID, err := h.getAuthUserID(r)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	pathParts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/tickets/"), "/"), "/")
+	if len(pathParts) != 4 || pathParts[1] != "suggestions" || pathParts[3] != "accept" {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	ticketID, err := strconv.ParseUint(pathParts[0], 10, 64)
+	if err != nil || ticketID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid ticket_id")
+		return
+	}
+	suggestionID, err := strconv.ParseUint(pathParts[2], 10, 64)
+	if err != nil || suggestionID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid suggestion_id")
+		return
+	}
+
+	resp, err := h.faqClient.AcceptTicketSuggestion(r.Context(), &pbSupport.AcceptTicketSuggestionRequest{
+		TicketId:     ticketID,
+		SuggestionId: suggestionID,
+		UserId:       userID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": ticketSuggestionToMap(resp)})
+}
+
+// ListSupportFaqs handles GET /api/support/faqs
+// Query params: department (optional, adds the FAQs of every department)
+func (h *SupportHandler) ListSupportFaqs(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	resp, err := h.faqClient.ListFaqs(r.Context(), &pbSupport.ListFaqsRequest{
+		Department: r.URL.Query().Get("department"),
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	faqs := make([]map[string]interface{}, 0, len(resp.Faqs))
+	for _, faq := range resp.Faqs {
+		faqs = append(faqs, supportFaqToMap(faq))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": faqs})
+}
+
+// supportFaqRequest is the body of the FAQ create and update endpoints
+type supportFaqRequest struct {
+	Question   string `json:"question"`
+	Answer     string `json:"answer"`
+	Department string `json:"department"`
+	Keywords   string `json:"keywords"`
+}
+
+// CreateSupportFaq handles POST /api/admin/support/faqs
+// Only support agents can manage FAQs
+func (h *SupportHandler) CreateSupportFaq(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userID, err := h.getAuthUserID(r)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	var req supportFaqRequest
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	resp, err := h.faqClient.CreateFaq(r.Context(), &pbSupport.CreateFaqRequest{
+		UserId:     userID,
+		Question:   req.Question,
+		Answer:     req.Answer,
+		Department: req.Department,
+		Keywords:   req.Keywords,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"data": supportFaqToMap(resp)})
+}
+
+// UpdateSupportFaq handles PUT /api/admin/support/faqs/{faq}
+// Only support agents can manage FAQs
+func (h *SupportHandler) UpdateSupportFaq(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userID, err := h.getAuthUserID(r)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	faqID, err := strconv.ParseUint(extractIDFromPath(r.URL.Path, "/api/admin/support/faqs/"), 10, 64)
+	if err != nil || faqID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid faq_id")
+		return
+	}
+
+	var req supportFaqRequest
+	if err := decodeRequestBody(r, &req); err != nil {
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "request body is required")
+		} else {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+		}
+		return
+	}
+
+	resp, err := h.faqClient.UpdateFaq(r.Context(), &pbSupport.UpdateFaqRequest{
+		FaqId:      faqID,
+		UserId:     userID,
+		Question:   req.Question,
+		Answer:     req.Answer,
+		Department: req.Department,
+		Keywords:   req.Keywords,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": supportFaqToMap(resp)})
+}
+
+// DeleteSupportFaq handles DELETE /api/admin/support/faqs/{faq}
+// Only support agents can manage FAQs
+func (h *SupportHandler) DeleteSupportFaq(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userID, err := h.getAuthUserID(r)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	faqID, err := strconv.ParseUint(extractIDFromPath(r.URL.Path, "/api/admin/support/faqs/"), 10, 64)
+	if err != nil || faqID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid faq_id")
+		return
+	}
+
+	_, err = h.faqClient.DeleteFaq(r.Context(), &pbSupport.DeleteFaqRequest{
+		FaqId:  faqID,
+		UserId: userID,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// maxInboundEmailSize caps the raw email accepted by the inbound email webhook.
+// It stays below the default gRPC message size of the support service.
+const maxInboundEmailSize = 3 << 20
+
+// IngestSupportEmail handles POST /api/webhooks/support-email. The mail
+// provider posts the raw RFC 5322 message as the body and authenticates with the
+// shared secret in the X-Webhook-Token header or the token query parameter.
+func (h *SupportHandler) IngestSupportEmail(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	rawMessage, err := io.ReadAll(io.LimitReader(r.Body, maxInboundEmailSize+1))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read request body")
+		return
+	}
+	if len(rawMessage) > maxInboundEmailSize {
+		writeError(w, http.StatusRequestEntityTooLarge, "email is too large")
+		return
+	}
+	if len(rawMessage) == 0 {
+		writeError(w, http.StatusBadRequest, "email message is required")
+		return
+	}
+
+	token := r.Header.Get("X-Webhook-Token")
+	if token == "" {
+		token = r.URL.Query().Get("token")
+	}
+
+	resp, err := h.emailClient.IngestEmail(r.Context(), &pbSupport.IngestEmailRequest{
+		RawMessage:   rawMessage,
+		WebhookToken: token,
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	result := map[string]interface{}{"status": resp.Status}
+	if resp.TicketId != 0 {
+		result["ticket_id"] = resp.TicketId
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// defaultSupportStatsDays is the period of GetSupportStats when from is not given
+const defaultSupportStatsDays = 30
+
+// GetSupportStats handles GET /api/admin/support/stats
+// Query params: from, to (Jalali Y/m/d or Gregorian Y-m-d, inclusive). Defaults
+// to the last 30 days including today. Only support agents can view the stats.
+func (h *SupportHandler) GetSupportStats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userID, err := h.getAuthUserID(r)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	query := r.URL.Query()
+	to := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+	if value := query.Get("to"); value != "" {
+		date, err := parseSupportStatsDate(value)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid to date")
+			return
+		}
+		to = date.AddDate(0, 0, 1)
+	}
+	from := to.AddDate(0, 0, -defaultSupportStatsDays)
+	if value := query.Get("from"); value != "" {
+		if from, err = parseSupportStatsDate(value); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid from date")
+			return
+		}
+	}
+
+	resp, err := h.statsClient.GetSupportStats(r.Context(), &pbSupport.GetSupportStatsRequest{
+		UserId: userID,
+		From:   from.Unix(),
+		To:     to.Unix(),
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	byStatus := make([]map[string]interface{}, 0, len(resp.ByStatus))
+	for _, count := range resp.ByStatus {
+		byStatus = append(byStatus, map[string]interface{}{
+			"status": count.Status,
+			"name":   count.Name,
+			"count":  count.Count,
+		})
+	}
+	byDepartment := make([]map[string]interface{}, 0, len(resp.ByDepartment))
+	for _, count := range resp.ByDepartment {
+		byDepartment = append(byDepartment, map[string]interface{}{
+			"department": count.Department,
+			"title":      count.Title,
+			"count":      count.Count,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": map[string]interface{}{
+			"from":                       helpers.FormatJalaliDate(time.Unix(resp.From, 0)),
+			"to":                         helpers.FormatJalaliDate(time.Unix(resp.To, 0).AddDate(0, 0, -1)),
+			"total_tickets":              resp.TotalTickets,
+			"by_status":                  byStatus,
+			"by_department":              byDepartment,
+			"responded_tickets":          resp.RespondedTickets,
+			"avg_first_response_seconds": resp.AvgFirstResponseSeconds,
+			"resolved_tickets":           resp.ResolvedTickets,
+			"avg_resolution_seconds":     resp.AvgResolutionSeconds,
+			"suggested_tickets":          resp.SuggestedTickets,
+			"deflected_tickets":          resp.DeflectedTickets,
+			"generated_at":               helpers.FormatJalaliDateTime(time.Unix(resp.GeneratedAt, 0)),
+		},
+	})
+}
+
+// parseSupportStatsDate parses a Gregorian Y-m-d date or a Jalali Y/m/d date
+func parseSupportStatsDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	return helpers.ParseJalaliDate(value)
+}
+
+func disputeToMap(dispute *pbSupport.DisputeResponse) map[string]interface{} {
+	disputeMap := map[string]interface{}{
+		"id":            dispute.Id,
+		"trade_id":      dispute.TradeId,
+		"feature_id":    dispute.FeatureId,
+		"properties_id": dispute.PropertiesId,
+		"buyer_id":      dispute.BuyerId,
+		"seller_id":     dispute.SellerId,
+		"opened_by":     dispute.OpenedBy,
+		"ticket_id":     dispute.TicketId,
+		"reason":        dispute.Reason,
+		"description":   dispute.Description,
+		"funds_frozen":  dispute.FundsFrozen,
+		"status":        dispute.Status,
+		"date":          dispute.Date,
+		"time":          dispute.Time,
+	}
+	if dispute.Outcome != "" {
+		disputeMap["outcome"] = dispute.Outcome
+		disputeMap["resolution_note"] = dispute.ResolutionNote
+		disputeMap["resolved_date"] = dispute.ResolvedDate
+	}
+	return disputeMap
+}
+
+func supportChatToMap(chat *pbSupport.ChatSessionResponse) map[string]interface{} {
+	chatMap := map[string]interface{}{
+		"id":        chat.Id,
+		"ticket_id": chat.TicketId,
+		"user_id":   chat.UserId,
+		"status":    chat.Status,
+		"room":      chat.Room,
+		"date":      chat.Date,
+		"time":      chat.Time,
+	}
+	if chat.AgentId != 0 {
+		chatMap["agent_id"] = chat.AgentId
+	}
+	if chat.ClosedDate != "" {
+		chatMap["closed_date"] = chat.ClosedDate
+	}
+	return chatMap
+}
+
+func supportChatMessageToMap(message *pbSupport.ChatMessageResponse) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         message.Id,
+		"chat_id":    message.ChatId,
+		"sender_id":  message.SenderId,
+		"from_agent": message.FromAgent,
+		"body":       message.Body,
+		"date":       message.Date,
+		"time":       message.Time,
+		"created_at": message.CreatedAt,
+	}
+}
+
+func supportFaqToMap(faq *pbSupport.FaqResponse) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         faq.Id,
+		"question":   faq.Question,
+		"answer":     faq.Answer,
+		"department": faq.Department,
+		"keywords":   faq.Keywords,
+		"updated_at": faq.UpdatedAt,
+	}
+}
+
+func ticketSuggestionToMap(suggestion *pbSupport.TicketSuggestion) map[string]interface{} {
+	suggestionMap := map[string]interface{}{
+		"id":        suggestion.Id,
+		"source":    suggestion.Source,
+		"source_id": suggestion.SourceId,
+		"title":     suggestion.Title,
+		"body":      suggestion.Body,
+		"score":     suggestion.Score,
+		"accepted":  suggestion.Accepted,
+	}
+	if suggestion.Slug != "" {
+		suggestionMap["slug"] = suggestion.Slug
+	}
+	return suggestionMap
+}
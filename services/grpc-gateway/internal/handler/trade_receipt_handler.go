@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"metargb/grpc-gateway/internal/middleware"
+	featurespb "metargb/shared/pb/features"
+)
+
+// GetTradeReceipt handles GET /api/trades/{trade}/receipt
+// Only the buyer and the seller of the trade can get its receipt.
+func (h *FeaturesHandler) GetTradeReceipt(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	tradeID := extractIDFromPathWithSuffix(r.URL.Path, "/api/trades/", "/receipt")
+	if tradeID == 0 {
+		writeError(w, http.StatusBadRequest, "invalid trade_id")
+		return
+	}
+
+	resp, err := h.receiptClient.GetTradeReceipt(middleware.ContextWithAuthFromRequest(r), &featurespb.GetTradeReceiptRequest{
+		TradeId: tradeID,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": tradeReceiptToMap(resp.Data)})
+}
+
+// VerifyTradeReceipt handles GET /api/trades/verify/{code}
+// Public: anyone holding a receipt code can check the trade it belongs to.
+func (h *FeaturesHandler) VerifyTradeReceipt(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	code := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/trades/verify/"), "/")
+	if code == "" || strings.Contains(code, "/") {
+		writeError(w, http.StatusBadRequest, "invalid verification code")
+		return
+	}
+
+	resp, err := h.receiptClient.VerifyTradeReceipt(r.Context(), &featurespb.VerifyTradeReceiptRequest{
+		Code: code,
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": tradeReceiptToMap(resp.Data)})
+}
+
+func tradeReceiptToMap(receipt *featurespb.TradeReceipt) map[string]interface{} {
+	if receipt == nil {
+		return nil
+	}
+	var refundedAt interface{}
+	if receipt.RefundedAt != "" {
+		refundedAt = receipt.RefundedAt
+	}
+	return map[string]interface{}{
+		"code":          receipt.Code,
+		"trade_id":      receipt.TradeId,
+		"feature_id":    receipt.FeatureId,
+		"properties_id": receipt.PropertiesId,
+		"buyer_code":    receipt.BuyerCode,
+		"seller_code":   receipt.SellerCode,
+		"psc_amount":    receipt.PscAmount,
+		"irr_amount":    receipt.IrrAmount,
+		"date":          receipt.Date,
+		"time":          receipt.Time,
+		"status":        receipt.Status,
+		"refunded_at":   refundedAt,
+	}
+}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"metargb/grpc-gateway/internal/middleware"
+	featurespb "metargb/shared/pb/features"
+)
+
+// GetAnnualTradeSummary handles GET /api/trades/annual-summary
+// Query params: year, a Jalali year defaulting to the current one
+func (h *FeaturesHandler) GetAnnualTradeSummary(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	resp, ok := h.fetchAnnualTradeSummary(w, r)
+	if !ok {
+		return
+	}
+
+	trades := make([]map[string]interface{}, 0, len(resp.Trades))
+	for _, trade := range resp.Trades {
+		trades = append(trades, map[string]interface{}{
+			"trade_id":      trade.TradeId,
+			"feature_id":    trade.FeatureId,
+			"properties_id": trade.PropertiesId,
+			"role":          trade.Role,
+			"status":        trade.Status,
+			"price_psc":     trade.PricePsc,
+			"price_irr":     trade.PriceIrr,
+			"fee_psc":       trade.FeePsc,
+			"fee_irr":       trade.FeeIrr,
+			"receipt_code":  trade.ReceiptCode,
+			"date":          trade.Date,
+			"time":          trade.Time,
+		})
+	}
+	profits := make(map[string]string, len(resp.Profits))
+	for _, profit := range resp.Profits {
+		profits[profit.Asset] = profit.Amount
+	}
+
+	totals := resp.Totals
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": map[string]interface{}{
+			"year": resp.Year,
+			"from": resp.From,
+			"to":   resp.To,
+			"totals": map[string]interface{}{
+				"bought_count": totals.GetBoughtCount(),
+				"bought_psc":   totals.GetBoughtPsc(),
+				"bought_irr":   totals.GetBoughtIrr(),
+				"sold_count":   totals.GetSoldCount(),
+				"sold_psc":     totals.GetSoldPsc(),
+				"sold_irr":     totals.GetSoldIrr(),
+				"fees_psc":     totals.GetFeesPsc(),
+				"fees_irr":     totals.GetFeesIrr(),
+				"net_psc":      totals.GetNetPsc(),
+				"net_irr":      totals.GetNetIrr(),
+			},
+			"profits": profits,
+			"trades":  trades,
+		},
+	})
+}
+
+// ExportAnnualTradeSummary handles GET /api/trades/annual-summary/export
+// Downloads the summary as CSV: the trades, then the totals, then the
+// profit payouts, each section with its own header row
+func (h *FeaturesHandler) ExportAnnualTradeSummary(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	resp, ok := h.fetchAnnualTradeSummary(w, r)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="trades-%d.csv"`, resp.Year))
+	w.WriteHeader(http.StatusOK)
+
+	out := csv.NewWriter(w)
+	out.Write([]string{"trade_id", "date", "time", "feature", "role", "status", "price_psc", "price_irr", "fee_psc", "fee_irr", "receipt_code"})
+	for _, trade := range resp.Trades {
+		out.Write([]string{
+			strconv.FormatUint(trade.TradeId, 10), trade.Date, trade.Time, trade.PropertiesId, trade.Role, trade.Status,
+			trade.PricePsc, trade.PriceIrr, trade.FeePsc, trade.FeeIrr, trade.ReceiptCode,
+		})
+	}
+
+	totals := resp.Totals
+	out.Write(nil)
+	out.Write([]string{"total", "count", "psc", "irr"})
+	out.Write([]string{"bought", strconv.Itoa(int(totals.GetBoughtCount())), totals.GetBoughtPsc(), totals.GetBoughtIrr()})
+	out.Write([]string{"sold", strconv.Itoa(int(totals.GetSoldCount())), totals.GetSoldPsc(), totals.GetSoldIrr()})
+	out.Write([]string{"fees", "", totals.GetFeesPsc(), totals.GetFeesIrr()})
+	out.Write([]string{"net", "", totals.GetNetPsc(), totals.GetNetIrr()})
+
+	out.Write(nil)
+	out.Write([]string{"profit_asset", "amount"})
+	for _, profit := range resp.Profits {
+		out.Write([]string{profit.Asset, profit.Amount})
+	}
+	out.Flush()
+}
+
+// fetchAnnualTradeSummary reads the caller's summary for the year in the
+// query, writing the error response when it fails
+func (h *FeaturesHandler) fetchAnnualTradeSummary(w http.ResponseWriter, r *http.Request) (*featurespb.AnnualTradeSummaryResponse, bool) {
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return nil, false
+	}
+
+	var year int64
+	if value := r.URL.Query().Get("year"); value != "" {
+		year, err = strconv.ParseInt(value, 10, 32)
+		if err != nil || year <= 0 {
+			writeError(w, http.StatusBadRequest, "invalid year")
+			return nil, false
+		}
+	}
+
+	resp, err := h.summaryClient.GetAnnualTradeSummary(middleware.ContextWithAuthFromRequest(r), &featurespb.GetAnnualTradeSummaryRequest{
+		UserId: userCtx.UserID,
+		Year:   int32(year),
+	})
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return nil, false
+	}
+	return resp, true
+}
@@ -1,7 +1,6 @@
 package handler
 
 import (
-	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -30,7 +29,7 @@ func NewTrainingHandler(trainingConn *grpc.ClientConn, authConn *grpc.ClientConn
 		categoryClient: trainingpb.NewCategoryServiceClient(trainingConn),
 		commentClient:  trainingpb.NewCommentServiceClient(trainingConn),
 		replyClient:    trainingpb.NewReplyServiceClient(trainingConn),
-		authClient:     pb.NewAuthServiceClient(authConn),
+		authClient:     middleware.AuthClient(authConn),
 	}
 }
 
@@ -104,20 +103,10 @@ func (h *TrainingHandler) SearchVideos(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req struct {
-		SearchTerm string `json:"searchTerm"`
+		SearchTerm string `json:"searchTerm" validate:"required"`
 	}
 
-	if err := decodeRequestBody(r, &req); err != nil {
-		if err == io.EOF {
-			writeError(w, http.StatusBadRequest, "request body is required")
-		} else {
-			writeError(w, http.StatusBadRequest, "invalid request body")
-		}
-		return
-	}
-
-	if req.SearchTerm == "" {
-		writeValidationError(w, "searchTerm is required")
+	if !decodeValidatedRequest(w, r, &req) {
 		return
 	}
 
@@ -170,12 +159,7 @@ func (h *TrainingHandler) AddInteraction(w http.ResponseWriter, r *http.Request)
 		req.Liked = likedStr == "1" || likedStr == "true"
 	} else {
 		// Try request body (JSON or form-data)
-		if err := decodeRequestBody(r, &req); err != nil {
-			if err == io.EOF {
-				writeError(w, http.StatusBadRequest, "request body is required")
-			} else {
-				writeError(w, http.StatusBadRequest, "invalid request body")
-			}
+		if !decodeValidatedRequest(w, r, &req) {
 			return
 		}
 	}
@@ -206,21 +190,18 @@ func (h *TrainingHandler) GetVideoByFileName(w http.ResponseWriter, r *http.Requ
 	}
 
 	var req struct {
-		URL string `json:"url"`
+		URL string `json:"url" validate:"required"`
 	}
 
-	if err := decodeRequestBody(r, &req); err != nil {
-		if err == io.EOF {
-			writeError(w, http.StatusBadRequest, "request body is required")
-		} else {
-			writeError(w, http.StatusBadRequest, "invalid request body")
-		}
+	if !decodeValidatedRequest(w, r, &req) {
 		return
 	}
 
-	if req.URL == "" {
-		writeValidationError(w, "url is required")
-		return
+	var userID uint64
+	// Premium videos are only returned to subscribers
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err == nil {
+		userID = userCtx.UserID
 	}
 
 	ipAddress := getIPAddress(r)
@@ -228,6 +209,7 @@ func (h *TrainingHandler) GetVideoByFileName(w http.ResponseWriter, r *http.Requ
 	grpcReq := &trainingpb.GetVideoByFileNameRequest{
 		FileName:  req.URL,
 		IpAddress: ipAddress,
+		UserId:    userID,
 	}
 
 	resp, err := h.trainingClient.GetVideoByFileName(r.Context(), grpcReq)
@@ -421,20 +403,10 @@ func (h *TrainingHandler) AddComment(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req struct {
-		Content string `json:"content"`
-	}
-
-	if err := decodeRequestBody(r, &req); err != nil {
-		if err == io.EOF {
-			writeError(w, http.StatusBadRequest, "request body is required")
-		} else {
-			writeError(w, http.StatusBadRequest, "invalid request body")
-		}
-		return
+		Content string `json:"content" validate:"required"`
 	}
 
-	if req.Content == "" {
-		writeValidationError(w, "content is required")
+	if !decodeValidatedRequest(w, r, &req) {
 		return
 	}
 
@@ -475,20 +447,10 @@ func (h *TrainingHandler) UpdateComment(w http.ResponseWriter, r *http.Request)
 	}
 
 	var req struct {
-		Content string `json:"content"`
+		Content string `json:"content" validate:"required"`
 	}
 
-	if err := decodeRequestBody(r, &req); err != nil {
-		if err == io.EOF {
-			writeError(w, http.StatusBadRequest, "request body is required")
-		} else {
-			writeError(w, http.StatusBadRequest, "invalid request body")
-		}
-		return
-	}
-
-	if req.Content == "" {
-		writeValidationError(w, "content is required")
+	if !decodeValidatedRequest(w, r, &req) {
 		return
 	}
 
@@ -565,12 +527,7 @@ func (h *TrainingHandler) AddCommentInteraction(w http.ResponseWriter, r *http.R
 		Liked bool `json:"liked"`
 	}
 
-	if err := decodeRequestBody(r, &req); err != nil {
-		if err == io.EOF {
-			writeError(w, http.StatusBadRequest, "request body is required")
-		} else {
-			writeError(w, http.StatusBadRequest, "invalid request body")
-		}
+	if !decodeValidatedRequest(w, r, &req) {
 		return
 	}
 
@@ -615,20 +572,10 @@ func (h *TrainingHandler) ReportComment(w http.ResponseWriter, r *http.Request)
 	}
 
 	var req struct {
-		Content string `json:"content"`
-	}
-
-	if err := decodeRequestBody(r, &req); err != nil {
-		if err == io.EOF {
-			writeError(w, http.StatusBadRequest, "request body is required")
-		} else {
-			writeError(w, http.StatusBadRequest, "invalid request body")
-		}
-		return
+		Content string `json:"content" validate:"required"`
 	}
 
-	if req.Content == "" {
-		writeValidationError(w, "content is required")
+	if !decodeValidatedRequest(w, r, &req) {
 		return
 	}
 
@@ -702,20 +649,10 @@ func (h *TrainingHandler) AddReply(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req struct {
-		Content string `json:"content"`
-	}
-
-	if err := decodeRequestBody(r, &req); err != nil {
-		if err == io.EOF {
-			writeError(w, http.StatusBadRequest, "request body is required")
-		} else {
-			writeError(w, http.StatusBadRequest, "invalid request body")
-		}
-		return
+		Content string `json:"content" validate:"required"`
 	}
 
-	if req.Content == "" {
-		writeValidationError(w, "content is required")
+	if !decodeValidatedRequest(w, r, &req) {
 		return
 	}
 
@@ -756,20 +693,10 @@ func (h *TrainingHandler) UpdateReply(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req struct {
-		Content string `json:"content"`
-	}
-
-	if err := decodeRequestBody(r, &req); err != nil {
-		if err == io.EOF {
-			writeError(w, http.StatusBadRequest, "request body is required")
-		} else {
-			writeError(w, http.StatusBadRequest, "invalid request body")
-		}
-		return
+		Content string `json:"content" validate:"required"`
 	}
 
-	if req.Content == "" {
-		writeValidationError(w, "content is required")
+	if !decodeValidatedRequest(w, r, &req) {
 		return
 	}
 
@@ -846,12 +773,7 @@ func (h *TrainingHandler) AddReplyInteraction(w http.ResponseWriter, r *http.Req
 		Liked bool `json:"liked"`
 	}
 
-	if err := decodeRequestBody(r, &req); err != nil {
-		if err == io.EOF {
-			writeError(w, http.StatusBadRequest, "request body is required")
-		} else {
-			writeError(w, http.StatusBadRequest, "invalid request body")
-		}
+	if !decodeValidatedRequest(w, r, &req) {
 		return
 	}
 
@@ -959,6 +881,7 @@ func buildVideoResponse(video *trainingpb.VideoResponse) map[string]interface{}
 		"description": video.Description,
 		"image_url":   video.ImageUrl,
 		"video_url":   video.VideoUrl,
+		"premium":     video.Premium,
 		"created_at":  video.CreatedAt,
 	}
 
@@ -1203,6 +1126,8 @@ func writeGRPCErrorTraining(w http.ResponseWriter, err error) {
 		writeError(w, http.StatusUnauthorized, st.Message())
 	case codes.PermissionDenied:
 		writeError(w, http.StatusForbidden, st.Message())
+	case codes.Unavailable:
+		writeError(w, http.StatusServiceUnavailable, st.Message())
 	default:
 		writeError(w, http.StatusInternalServerError, st.Message())
 	}
@@ -0,0 +1,254 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	"metargb/grpc-gateway/internal/middleware"
+	storagepb "metargb/shared/pb/storage"
+)
+
+const (
+	// uploadChunkSize is the size of each chunk streamed to storage-service
+	uploadChunkSize = 32 << 10
+	// uploadSniffSize is how much of a file is read to detect its type
+	uploadSniffSize = 512
+	// uploadFormOverhead allows for multipart boundaries, part headers and
+	// small form fields on top of the file size limit
+	uploadFormOverhead = 64 << 10
+)
+
+// uploadKind describes what one upload endpoint accepts
+type uploadKind struct {
+	// maxSize is the largest accepted file in bytes
+	maxSize int64
+	// types maps each accepted sniffed content type to the extension the
+	// stored file gets
+	types map[string]string
+	// path is the storage folder; files are grouped per user below it
+	path string
+}
+
+var (
+	imageUploadTypes = map[string]string{
+		"image/jpeg": ".jpg",
+		"image/png":  ".png",
+	}
+
+	// uploadKinds mirror the limits the owning services apply to the same files
+	uploadKinds = map[string]uploadKind{
+		// auth-service accepts profile photos up to 1 MB
+		"profile-photos": {maxSize: 1 << 20, types: imageUploadTypes, path: "profile-photos"},
+		// features-service accepts gallery images up to 1024 KB
+		"feature-images": {maxSize: maxGalleryImageSize, types: imageUploadTypes, path: "feature-images"},
+		"ticket-attachments": {
+			maxSize: 5 << 20,
+			types: map[string]string{
+				"image/jpeg":      ".jpg",
+				"image/png":       ".png",
+				"application/pdf": ".pdf",
+			},
+			path: "tickets",
+		},
+	}
+)
+
+// UploadHandler streams multipart uploads to storage-service. Files are
+// sent on as they are read, so the gateway never holds a whole file.
+type UploadHandler struct {
+	storageClient storagepb.FileStorageServiceClient
+	locale        string
+}
+
+func NewUploadHandler(storageConn *grpc.ClientConn, locale string) *UploadHandler {
+	return &UploadHandler{
+		storageClient: storagepb.NewFileStorageServiceClient(storageConn),
+		locale:        locale,
+	}
+}
+
+// Upload handles POST /api/uploads/{kind} with the file in the multipart
+// "file" field, where kind is profile-photos, feature-images or
+// ticket-attachments. It responds with the stored file's URL, which the
+// client then passes to the endpoint that uses the file.
+func (h *UploadHandler) Upload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	userCtx, err := middleware.GetUserFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	kindName := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/uploads/"), "/")
+	kind, ok := uploadKinds[kindName]
+	if !ok {
+		writeError(w, http.StatusNotFound, "unknown upload type")
+		return
+	}
+
+	// Refuse oversized bodies before reading them, and cap bodies without a length
+	maxBody := kind.maxSize + uploadFormOverhead
+	if r.ContentLength > maxBody {
+		writeFileTooLarge(w, kind)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxBody)
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "multipart/form-data body is required")
+		return
+	}
+
+	// Parts before the file are skipped without being buffered
+	var part io.ReadCloser
+	var filename string
+	for {
+		p, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			writeUploadReadError(w, kind, err)
+			return
+		}
+		if p.FormName() == "file" && p.FileName() != "" {
+			part, filename = p, p.FileName()
+			break
+		}
+		p.Close()
+	}
+	if part == nil {
+		writeValidationErrorWithLocale(w, "file field is required", h.locale)
+		return
+	}
+	defer part.Close()
+
+	// The type is sniffed from the content; the client's Content-Type is ignored
+	head := make([]byte, uploadSniffSize)
+	n, err := io.ReadFull(part, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		writeUploadReadError(w, kind, err)
+		return
+	}
+	head = head[:n]
+	if n == 0 {
+		writeValidationErrorWithLocale(w, "file must not be empty", h.locale)
+		return
+	}
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	ext, ok := kind.types[contentType]
+	if !ok {
+		writeValidationErrorWithLocale(w, "file type is not allowed", h.locale)
+		return
+	}
+
+	// Cancelling the context aborts the stream, and storage-service discards the partial file
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	stream, err := h.storageClient.UploadFile(ctx)
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+	if err := stream.Send(&storagepb.UploadFileRequest{
+		Data: &storagepb.UploadFileRequest_Metadata{Metadata: &storagepb.FileMetadata{
+			Filename:    uploadFilename(filename, ext),
+			ContentType: contentType,
+			UploadPath:  kind.path + "/" + strconv.FormatUint(userCtx.UserID, 10),
+		}},
+	}); err != nil && err != io.EOF {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	var size int64
+	body := io.MultiReader(bytes.NewReader(head), part)
+	buf := make([]byte, uploadChunkSize)
+	for {
+		n, readErr := body.Read(buf)
+		if n > 0 {
+			size += int64(n)
+			if size > kind.maxSize {
+				cancel()
+				writeFileTooLarge(w, kind)
+				return
+			}
+			// io.EOF means storage-service ended the stream; CloseAndRecv reports why
+			if err := stream.Send(&storagepb.UploadFileRequest{
+				Data: &storagepb.UploadFileRequest_ChunkData{ChunkData: buf[:n]},
+			}); err != nil {
+				break
+			}
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			cancel()
+			writeUploadReadError(w, kind, readErr)
+			return
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		writeGRPCErrorWithLocale(w, err, h.locale)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{
+		"data": map[string]interface{}{
+			"url":          resp.FileUrl,
+			"filename":     resp.Filename,
+			"size":         resp.FileSize,
+			"content_type": contentType,
+		},
+	})
+}
+
+// uploadFilename keeps the client's base name but replaces its extension
+// with the one matching the sniffed type, so a file is never served as a
+// type it was not checked as
+func uploadFilename(name, ext string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.TrimSuffix(name, filepath.Ext(name))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || strings.ContainsRune(`/\:*?"<>|`, r) {
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(name))
+	if name == "" || name == "." || name == ".." {
+		name = "file"
+	}
+	return name + ext
+}
+
+func writeFileTooLarge(w http.ResponseWriter, kind uploadKind) {
+	writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("file exceeds the %d KB limit", kind.maxSize>>10))
+}
+
+func writeUploadReadError(w http.ResponseWriter, kind uploadKind, err error) {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		writeFileTooLarge(w, kind)
+		return
+	}
+	writeError(w, http.StatusBadRequest, "failed to read multipart body")
+}
@@ -0,0 +1,107 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	pb "metargb/shared/pb/auth"
+	authpkg "metargb/shared/pkg/auth"
+)
+
+// APIKeyHeader is the HTTP header server-to-server callers use instead of a bearer token
+const APIKeyHeader = "X-Api-Key"
+
+// apiKeyThrottleStore tracks per-key request counts, separately from per-user throttling
+var apiKeyThrottleStore = newThrottleStore()
+
+// APIKeyAuthMiddleware creates an HTTP middleware that accepts either an X-Api-Key
+// header or a bearer token. API key requests are rate limited per key using the
+// limit configured on the key; requests without a key fall through to AuthMiddleware.
+func APIKeyAuthMiddleware(authClient pb.AuthServiceClient, apiKeyClient pb.APIKeyServiceClient) func(http.Handler) http.Handler {
+	tokenAuth := AuthMiddleware(authClient)
+
+	return func(next http.Handler) http.Handler {
+		tokenNext := tokenAuth(next)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := strings.TrimSpace(r.Header.Get(APIKeyHeader))
+			if key == "" {
+				tokenNext.ServeHTTP(w, r)
+				return
+			}
+
+			// Validate key with auth service
+			validateResp, err := apiKeyClient.ValidateAPIKey(r.Context(), &pb.ValidateAPIKeyRequest{Key: key})
+			if err != nil || !validateResp.Valid {
+				writeError(w, http.StatusUnauthorized, "Unauthenticated")
+				return
+			}
+
+			// Enforce the per-key rate limit
+			limit := int(validateResp.RateLimitPerMinute)
+			if limit <= 0 {
+				limit = 1
+			}
+			if !apiKeyThrottleStore.checkAndIncrement(validateResp.KeyId, limit, time.Minute) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Retry-After", formatRetryAfter(time.Minute))
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write([]byte(`{"error":"rate limit exceeded"}`))
+				return
+			}
+
+			// Create user context
+			userCtx := &authpkg.UserContext{
+				UserID:   validateResp.UserId,
+				Email:    validateResp.Email,
+				APIKeyID: validateResp.KeyId,
+				Scopes:   validateResp.Scopes,
+			}
+
+			// Add user context to request context
+			ctx := context.WithValue(r.Context(), authpkg.UserContextKey{}, userCtx)
+
+			// Forward the key to gRPC services so their interceptors can authenticate it
+			ctx = ContextWithAPIKey(ctx, key)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequireScopeMiddleware rejects API key and personal access token callers that
+// were not granted scope. Login tokens carry every scope. It must run after the
+// auth middleware.
+func RequireScopeMiddleware(scope string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userCtx, err := authpkg.GetUserFromContext(r.Context())
+			if err != nil {
+				writeError(w, http.StatusUnauthorized, "Unauthenticated")
+				return
+			}
+
+			if !userCtx.HasScope(scope) {
+				writeError(w, http.StatusForbidden, "Forbidden")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ContextWithAPIKey adds the API key to the context as gRPC metadata.
+func ContextWithAPIKey(ctx context.Context, key string) context.Context {
+	if key == "" {
+		return ctx
+	}
+	md := metadata.New(map[string]string{
+		authpkg.APIKeyMetadataKey: key,
+	})
+	return metadata.NewOutgoingContext(ctx, md)
+}
@@ -0,0 +1,166 @@
+package middleware
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "metargb/shared/pb/auth"
+)
+
+// DefaultAPIKeyCacheTTL caps how long an API key validation is reused. It
+// bounds how long a key revoked through another gateway replica keeps working
+// here, and how often auth-service is asked per key.
+const DefaultAPIKeyCacheTTL = 30 * time.Second
+
+type apiKeyCacheEntry struct {
+	resp    *pb.ValidateAPIKeyResponse
+	expires time.Time
+}
+
+// APIKeyCache remembers ValidateAPIKey results, so a server-to-server caller
+// sending many requests does not cost a lookup and a last_used_at write in
+// auth-service for each of them. Keys are kept as SHA-256 hashes.
+type APIKeyCache struct {
+	client    pb.APIKeyServiceClient
+	ttl       time.Duration
+	mu        sync.Mutex
+	entries   map[string]apiKeyCacheEntry
+	lastSweep time.Time
+}
+
+// NewAPIKeyCache creates a cache in front of client. A ttl of 0 uses
+// DefaultAPIKeyCacheTTL.
+func NewAPIKeyCache(client pb.APIKeyServiceClient, ttl time.Duration) *APIKeyCache {
+	if ttl <= 0 {
+		ttl = DefaultAPIKeyCacheTTL
+	}
+	return &APIKeyCache{
+		client:    client,
+		ttl:       ttl,
+		entries:   make(map[string]apiKeyCacheEntry),
+		lastSweep: time.Now(),
+	}
+}
+
+// Validate returns the cached result for key, or validates it with
+// auth-service. Errors are not cached, rejected keys only briefly.
+func (c *APIKeyCache) Validate(ctx context.Context, key string, opts ...grpc.CallOption) (*pb.ValidateAPIKeyResponse, error) {
+	hash := tokenCacheKey(key)
+	now := time.Now()
+
+	c.mu.Lock()
+	if entry, ok := c.entries[hash]; ok && now.Before(entry.expires) {
+		c.mu.Unlock()
+		return entry.resp, nil
+	}
+	c.mu.Unlock()
+
+	resp, err := c.client.ValidateAPIKey(ctx, &pb.ValidateAPIKeyRequest{Key: key}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	now = time.Now()
+	ttl := c.ttl
+	if !resp.Valid {
+		ttl = invalidTokenCacheTTL
+	}
+	c.mu.Lock()
+	c.entries[hash] = apiKeyCacheEntry{resp: resp, expires: now.Add(ttl)}
+	c.sweepLocked(now)
+	c.mu.Unlock()
+
+	return resp, nil
+}
+
+// InvalidateKey drops the cached validation of keyID, e.g. after it was
+// revoked or rotated
+func (c *APIKeyCache) InvalidateKey(keyID uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for hash, entry := range c.entries {
+		if entry.resp.Valid && entry.resp.KeyId == keyID {
+			delete(c.entries, hash)
+		}
+	}
+}
+
+func (c *APIKeyCache) sweepLocked(now time.Time) {
+	if now.Sub(c.lastSweep) < tokenCacheSweepInterval {
+		return
+	}
+	c.lastSweep = now
+	for hash, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, hash)
+		}
+	}
+}
+
+// cachingAPIKeyClient is an APIKeyServiceClient whose ValidateAPIKey goes
+// through an APIKeyCache and whose RevokeAPIKey and RotateAPIKey drop the
+// old key from it
+type cachingAPIKeyClient struct {
+	pb.APIKeyServiceClient
+	cache *APIKeyCache
+}
+
+// NewCachingAPIKeyClient wraps client so ValidateAPIKey results are cached
+// for at most ttl (0 uses DefaultAPIKeyCacheTTL)
+func NewCachingAPIKeyClient(client pb.APIKeyServiceClient, ttl time.Duration) pb.APIKeyServiceClient {
+	return &cachingAPIKeyClient{APIKeyServiceClient: client, cache: NewAPIKeyCache(client, ttl)}
+}
+
+func (c *cachingAPIKeyClient) ValidateAPIKey(ctx context.Context, in *pb.ValidateAPIKeyRequest, opts ...grpc.CallOption) (*pb.ValidateAPIKeyResponse, error) {
+	return c.cache.Validate(ctx, in.Key, opts...)
+}
+
+func (c *cachingAPIKeyClient) RevokeAPIKey(ctx context.Context, in *pb.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out, err := c.APIKeyServiceClient.RevokeAPIKey(ctx, in, opts...)
+	if err == nil {
+		c.cache.InvalidateKey(in.KeyId)
+	}
+	return out, err
+}
+
+func (c *cachingAPIKeyClient) RotateAPIKey(ctx context.Context, in *pb.RotateAPIKeyRequest, opts ...grpc.CallOption) (*pb.APIKeySecretResponse, error) {
+	out, err := c.APIKeyServiceClient.RotateAPIKey(ctx, in, opts...)
+	if err == nil {
+		c.cache.InvalidateKey(in.KeyId)
+	}
+	return out, err
+}
+
+var (
+	sharedAPIKeyClientsMu sync.Mutex
+	sharedAPIKeyClients   = make(map[*grpc.ClientConn]pb.APIKeyServiceClient)
+)
+
+// apiKeyCacheTTLFromEnv reads API_KEY_CACHE_TTL, defaulting to
+// DefaultAPIKeyCacheTTL
+func apiKeyCacheTTLFromEnv() time.Duration {
+	if d, err := time.ParseDuration(os.Getenv("API_KEY_CACHE_TTL")); err == nil && d > 0 {
+		return d
+	}
+	return DefaultAPIKeyCacheTTL
+}
+
+// APIKeyClient returns the caching API key client of conn, with
+// API_KEY_CACHE_TTL as its ttl. The route auth and the key management
+// handlers share it, so a key revoked through this gateway stops working here
+// at once.
+func APIKeyClient(conn *grpc.ClientConn) pb.APIKeyServiceClient {
+	sharedAPIKeyClientsMu.Lock()
+	defer sharedAPIKeyClientsMu.Unlock()
+	if client, ok := sharedAPIKeyClients[conn]; ok {
+		return client
+	}
+	client := NewCachingAPIKeyClient(pb.NewAPIKeyServiceClient(conn), apiKeyCacheTTLFromEnv())
+	sharedAPIKeyClients[conn] = client
+	return client
+}
@@ -42,6 +42,7 @@ func AuthMiddleware(authClient pb.AuthServiceClient) func(http.Handler) http.Han
 				UserID: validateResp.UserId,
 				Email:  validateResp.Email,
 				Token:  token,
+				Scopes: validateResp.Scopes,
 			}
 
 			// Add user context to request context
@@ -72,6 +73,7 @@ func OptionalAuthMiddleware(authClient pb.AuthServiceClient) func(http.Handler)
 						UserID: validateResp.UserId,
 						Email:  validateResp.Email,
 						Token:  token,
+						Scopes: validateResp.Scopes,
 					}
 
 					// Add user context to request context
@@ -164,7 +166,11 @@ func ContextWithAuth(ctx context.Context, token string) context.Context {
 
 // ContextWithAuthFromRequest extracts the token from the request and adds it to context as gRPC metadata.
 // This is a convenience function that combines token extraction and context creation.
+// Requests authenticated with an X-Api-Key header forward the key instead.
 func ContextWithAuthFromRequest(r *http.Request) context.Context {
 	token := extractTokenFromHeader(r)
+	if token == "" {
+		return ContextWithAPIKey(r.Context(), strings.TrimSpace(r.Header.Get(APIKeyHeader)))
+	}
 	return ContextWithAuth(r.Context(), token)
 }
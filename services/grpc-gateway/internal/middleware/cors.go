@@ -0,0 +1,169 @@
+package middleware
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultCORSMethods = "GET, POST, PUT, PATCH, DELETE, OPTIONS"
+	defaultCORSHeaders = "Accept, Accept-Language, Content-Language, Content-Type, Authorization, X-Requested-With, X-Api-Key, X-Client-Id"
+	defaultCORSMaxAge  = time.Hour
+)
+
+// CORSConfig controls which browser origins may call the API
+type CORSConfig struct {
+	// AllowedOrigins are exact origins such as https://metarang.com, or "*"
+	// for any origin
+	AllowedOrigins []string
+	// AllowCredentials lets browsers send cookies and Authorization. The
+	// request's origin is then echoed instead of "*".
+	AllowCredentials bool
+	AllowedMethods   string
+	AllowedHeaders   string
+	ExposedHeaders   string
+	// MaxAge is how long browsers may cache a preflight response
+	MaxAge time.Duration
+}
+
+// CORSConfigFromEnv reads CORS_ALLOWED_ORIGINS (comma separated, default *),
+// CORS_ALLOW_CREDENTIALS, CORS_ALLOWED_HEADERS, CORS_EXPOSED_HEADERS and
+// CORS_MAX_AGE
+func CORSConfigFromEnv() CORSConfig {
+	cfg := CORSConfig{
+		AllowedOrigins: splitList(getEnv("CORS_ALLOWED_ORIGINS", "*")),
+		AllowedMethods: defaultCORSMethods,
+		AllowedHeaders: getEnv("CORS_ALLOWED_HEADERS", defaultCORSHeaders),
+		ExposedHeaders: os.Getenv("CORS_EXPOSED_HEADERS"),
+		MaxAge:         defaultCORSMaxAge,
+	}
+	if b, err := strconv.ParseBool(os.Getenv("CORS_ALLOW_CREDENTIALS")); err == nil {
+		cfg.AllowCredentials = b
+	}
+	if d, err := time.ParseDuration(os.Getenv("CORS_MAX_AGE")); err == nil && d >= 0 {
+		cfg.MaxAge = d
+	}
+	return cfg
+}
+
+// CORS answers preflight requests and adds CORS headers for allowed origins.
+// Requests from other origins are served without them, so browsers block
+// reading the response.
+func CORS(cfg CORSConfig) func(http.Handler) http.Handler {
+	allowed := make(map[string]bool, len(cfg.AllowedOrigins))
+	for _, origin := range cfg.AllowedOrigins {
+		allowed[strings.TrimSuffix(origin, "/")] = true
+	}
+	anyOrigin := allowed["*"]
+	maxAge := strconv.Itoa(int(cfg.MaxAge.Seconds()))
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+
+			h := w.Header()
+			h.Add("Vary", "Origin")
+			if origin == "" || !(anyOrigin || allowed[origin]) {
+				if preflight {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if anyOrigin && !cfg.AllowCredentials {
+				h.Set("Access-Control-Allow-Origin", "*")
+			} else {
+				h.Set("Access-Control-Allow-Origin", origin)
+			}
+			if cfg.AllowCredentials {
+				h.Set("Access-Control-Allow-Credentials", "true")
+			}
+
+			if preflight {
+				h.Add("Vary", "Access-Control-Request-Method")
+				h.Add("Vary", "Access-Control-Request-Headers")
+				h.Set("Access-Control-Allow-Methods", cfg.AllowedMethods)
+				h.Set("Access-Control-Allow-Headers", cfg.AllowedHeaders)
+				h.Set("Access-Control-Max-Age", maxAge)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			if cfg.ExposedHeaders != "" {
+				h.Set("Access-Control-Expose-Headers", cfg.ExposedHeaders)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// SecurityConfig holds the security headers added to every response
+type SecurityConfig struct {
+	// ContentSecurityPolicy defaults to denying everything, as the API only
+	// serves JSON and uploads
+	ContentSecurityPolicy string
+	// HSTSMaxAge sends Strict-Transport-Security when positive. Only enable
+	// it when the API is served over HTTPS alone.
+	HSTSMaxAge time.Duration
+}
+
+// SecurityConfigFromEnv reads SECURITY_CSP and HSTS_MAX_AGE (default off)
+func SecurityConfigFromEnv() SecurityConfig {
+	cfg := SecurityConfig{
+		ContentSecurityPolicy: getEnv("SECURITY_CSP", "default-src 'none'; frame-ancestors 'none'"),
+	}
+	if d, err := time.ParseDuration(os.Getenv("HSTS_MAX_AGE")); err == nil && d > 0 {
+		cfg.HSTSMaxAge = d
+	}
+	return cfg
+}
+
+// SecurityHeaders adds standard security headers to every response.
+// Handlers may still override them, such as a file download setting its own
+// Content-Security-Policy.
+func SecurityHeaders(cfg SecurityConfig) func(http.Handler) http.Handler {
+	hsts := ""
+	if cfg.HSTSMaxAge > 0 {
+		hsts = "max-age=" + strconv.Itoa(int(cfg.HSTSMaxAge.Seconds())) + "; includeSubDomains"
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			h := w.Header()
+			h.Set("X-Content-Type-Options", "nosniff")
+			h.Set("X-Frame-Options", "DENY")
+			h.Set("Referrer-Policy", "strict-origin-when-cross-origin")
+			h.Set("Cross-Origin-Opener-Policy", "same-origin")
+			if cfg.ContentSecurityPolicy != "" {
+				h.Set("Content-Security-Policy", cfg.ContentSecurityPolicy)
+			}
+			if hsts != "" {
+				h.Set("Strict-Transport-Security", hsts)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
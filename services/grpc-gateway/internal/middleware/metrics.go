@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// unmatchedRoute labels requests that no handler served, so random paths from
+// scanners do not each create a new time series
+const unmatchedRoute = "unmatched"
+
+var (
+	httpRequestDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "HTTP request duration in seconds by route",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method", "route"},
+	)
+	httpRequestsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Total number of HTTP requests by route and status code",
+		},
+		[]string{"method", "route", "code"},
+	)
+	httpRequestsInFlight = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "http_requests_in_flight",
+			Help: "Number of HTTP requests currently being served",
+		},
+	)
+)
+
+var (
+	numericSegment = regexp.MustCompile(`^\d+$`)
+	uuidSegment    = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	// Property codes such as HM-2004521 and long tokens or hashes
+	codeSegment  = regexp.MustCompile(`^[A-Za-z]{1,4}-\d+$`)
+	tokenSegment = regexp.MustCompile(`^[A-Za-z0-9_\-]{20,}$`)
+)
+
+// MetricsMiddleware records request duration, status codes and in-flight
+// requests for every request it wraps. Routes are labeled by their pattern
+// (e.g. /api/disputes/{id}/resolve) rather than the raw path.
+func MetricsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		httpRequestsInFlight.Inc()
+		defer httpRequestsInFlight.Dec()
+
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		route := RoutePattern(r)
+		if recorder.status == http.StatusNotFound && r.Pattern == "" {
+			route = unmatchedRoute
+		}
+
+		httpRequestDuration.WithLabelValues(r.Method, route).Observe(time.Since(start).Seconds())
+		httpRequestsTotal.WithLabelValues(r.Method, route, strconv.Itoa(recorder.status)).Inc()
+	})
+}
+
+// MetricsHandler serves the Prometheus metrics at /metrics
+func MetricsHandler() http.Handler {
+	return promhttp.Handler()
+}
+
+// RoutePattern returns the route pattern of a request. The pattern matched by
+// http.ServeMux is used when available; otherwise IDs, UUIDs, property codes
+// and tokens in the path are replaced by placeholders.
+func RoutePattern(r *http.Request) string {
+	if r.Pattern != "" {
+		// Method-qualified patterns ("GET /api/...") carry the method in their own label
+		pattern := r.Pattern
+		if i := strings.Index(pattern, " "); i >= 0 {
+			pattern = strings.TrimSpace(pattern[i+1:])
+		}
+		return pattern
+	}
+	return normalizePath(r.URL.Path)
+}
+
+func normalizePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		switch {
+		case segment == "":
+		case numericSegment.MatchString(segment):
+			segments[i] = "{id}"
+		case uuidSegment.MatchString(segment):
+			segments[i] = "{uuid}"
+		case codeSegment.MatchString(segment):
+			segments[i] = "{code}"
+		case tokenSegment.MatchString(segment):
+			segments[i] = "{token}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// statusRecorder captures the status code written by a handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
+
+// Flush lets streaming handlers flush through the recorder
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	pb "metargb/shared/pb/auth"
+)
+
+// AuthLevel is who may call a route. Every route of the gateway declares one,
+// so handlers never validate tokens themselves.
+type AuthLevel int
+
+const (
+	// AuthUnset is the zero value. Routes left unset are refused at registration.
+	AuthUnset AuthLevel = iota
+	// AuthPublic routes are served to anyone and never look at the token
+	AuthPublic
+	// AuthGuest routes are only served without a valid token, e.g. registration
+	AuthGuest
+	// AuthOptional routes are served to anyone, with the user in the context
+	// when the token is valid
+	AuthOptional
+	// AuthRequired routes need a valid token or API key
+	AuthRequired
+	// AuthAdmin routes need a valid token of an administrator
+	AuthAdmin
+)
+
+// String returns the level name, e.g. "required"
+func (l AuthLevel) String() string {
+	switch l {
+	case AuthPublic:
+		return "public"
+	case AuthGuest:
+		return "guest"
+	case AuthOptional:
+		return "optional"
+	case AuthRequired:
+		return "required"
+	case AuthAdmin:
+		return "admin"
+	}
+	return "unset"
+}
+
+// RouteAuth enforces the auth level of each route
+type RouteAuth struct {
+	guest    func(http.Handler) http.Handler
+	optional func(http.Handler) http.Handler
+	required func(http.Handler) http.Handler
+	token    func(http.Handler) http.Handler
+	admins   map[uint64]bool
+}
+
+// NewRouteAuth creates the auth of the gateway's routes. Required routes also
+// accept an X-Api-Key header when apiKeyClient is set. Admin routes are only
+// served to adminIDs; without any, admin routes only need a valid token and
+// the owning service decides who is an administrator.
+func NewRouteAuth(authClient pb.AuthServiceClient, apiKeyClient pb.APIKeyServiceClient, adminIDs []uint64) *RouteAuth {
+	token := AuthMiddleware(authClient)
+	required := token
+	if apiKeyClient != nil {
+		required = APIKeyAuthMiddleware(authClient, apiKeyClient)
+	}
+
+	admins := make(map[uint64]bool, len(adminIDs))
+	for _, id := range adminIDs {
+		admins[id] = true
+	}
+
+	return &RouteAuth{
+		guest:    GuestMiddleware(authClient),
+		optional: OptionalAuthMiddleware(authClient),
+		required: required,
+		token:    token,
+		admins:   admins,
+	}
+}
+
+// Wrap returns handler behind the middleware of level. It panics on
+// AuthUnset, so a route without a level fails at startup instead of being
+// served unauthenticated.
+func (a *RouteAuth) Wrap(level AuthLevel, handler http.Handler) http.Handler {
+	switch level {
+	case AuthPublic:
+		return handler
+	case AuthGuest:
+		return a.guest(handler)
+	case AuthOptional:
+		return a.optional(handler)
+	case AuthRequired:
+		return a.required(handler)
+	case AuthAdmin:
+		// Admin calls are made with the administrator's own login token
+		return a.token(a.requireAdmin(handler))
+	}
+	panic(fmt.Sprintf("middleware: route auth level %d is not set", level))
+}
+
+// requireAdmin rejects users missing from the admin IDs, when there are any
+func (a *RouteAuth) requireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(a.admins) > 0 {
+			userCtx, err := GetUserFromRequest(r)
+			if err != nil {
+				writeError(w, http.StatusUnauthorized, "Unauthenticated")
+				return
+			}
+			if !a.admins[userCtx.UserID] {
+				writeError(w, http.StatusForbidden, "Forbidden")
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// AdminIDsFromEnv reads GATEWAY_ADMIN_IDS, a comma separated list of user IDs.
+// Invalid entries are skipped.
+func AdminIDsFromEnv() []uint64 {
+	var ids []uint64
+	for _, field := range strings.Split(os.Getenv("GATEWAY_ADMIN_IDS"), ",") {
+		if id, err := strconv.ParseUint(strings.TrimSpace(field), 10, 64); err == nil && id > 0 {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
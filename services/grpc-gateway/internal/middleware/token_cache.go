@@ -0,0 +1,209 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "metargb/shared/pb/auth"
+)
+
+// DefaultTokenCacheTTL caps how long a validation result is reused. It bounds
+// how long a token revoked through another gateway replica, or by anything
+// but a logout through this one, keeps working here.
+const DefaultTokenCacheTTL = time.Minute
+
+// invalidTokenCacheTTL is how long a rejected token is remembered. It is kept
+// short because auth-service also reports database errors as invalid.
+const invalidTokenCacheTTL = 5 * time.Second
+
+// tokenCacheSweepInterval is how often expired entries are dropped
+const tokenCacheSweepInterval = 5 * time.Minute
+
+type tokenCacheEntry struct {
+	resp    *pb.ValidateTokenResponse
+	expires time.Time
+}
+
+// tokenValidation is a ValidateToken call in flight, shared by every request
+// validating the same token meanwhile
+type tokenValidation struct {
+	done chan struct{}
+	resp *pb.ValidateTokenResponse
+	err  error
+}
+
+// TokenCache remembers ValidateToken results so handlers and middleware
+// validating the same token do not each call auth-service. A result is reused
+// until the token expires or ttl passes, whichever comes first, and
+// concurrent validations of one token share a single call.
+type TokenCache struct {
+	client    pb.AuthServiceClient
+	ttl       time.Duration
+	mu        sync.Mutex
+	entries   map[string]tokenCacheEntry
+	inFlight  map[string]*tokenValidation
+	lastSweep time.Time
+}
+
+// NewTokenCache creates a cache in front of client. A ttl of 0 uses
+// DefaultTokenCacheTTL.
+func NewTokenCache(client pb.AuthServiceClient, ttl time.Duration) *TokenCache {
+	if ttl <= 0 {
+		ttl = DefaultTokenCacheTTL
+	}
+	return &TokenCache{
+		client:    client,
+		ttl:       ttl,
+		entries:   make(map[string]tokenCacheEntry),
+		inFlight:  make(map[string]*tokenValidation),
+		lastSweep: time.Now(),
+	}
+}
+
+// Validate returns the cached result for token, or validates it with
+// auth-service. Errors are not cached.
+func (c *TokenCache) Validate(ctx context.Context, token string) (*pb.ValidateTokenResponse, error) {
+	key := tokenCacheKey(token)
+	now := time.Now()
+
+	c.mu.Lock()
+	if entry, ok := c.entries[key]; ok && now.Before(entry.expires) {
+		c.mu.Unlock()
+		return entry.resp, nil
+	}
+	if call, ok := c.inFlight[key]; ok {
+		c.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.resp, call.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	call := &tokenValidation{done: make(chan struct{})}
+	c.inFlight[key] = call
+	c.mu.Unlock()
+
+	// The call is shared, so one caller's cancellation must not fail the rest
+	callCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	call.resp, call.err = c.client.ValidateToken(callCtx, &pb.ValidateTokenRequest{Token: token})
+	cancel()
+
+	c.mu.Lock()
+	delete(c.inFlight, key)
+	if call.err == nil {
+		c.entries[key] = tokenCacheEntry{resp: call.resp, expires: c.expiry(call.resp, time.Now())}
+	}
+	c.sweepLocked(time.Now())
+	c.mu.Unlock()
+	close(call.done)
+
+	return call.resp, call.err
+}
+
+// InvalidateUser drops every cached token of userID, e.g. after a logout
+// deleted them
+func (c *TokenCache) InvalidateUser(userID uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, entry := range c.entries {
+		if entry.resp.Valid && entry.resp.UserId == userID {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// expiry returns when a validation result stops being reused
+func (c *TokenCache) expiry(resp *pb.ValidateTokenResponse, now time.Time) time.Time {
+	if !resp.Valid {
+		return now.Add(invalidTokenCacheTTL)
+	}
+	expires := now.Add(c.ttl)
+	if resp.ExpiresAt > 0 {
+		if tokenExpires := time.Unix(resp.ExpiresAt, 0); tokenExpires.Before(expires) {
+			expires = tokenExpires
+		}
+	}
+	return expires
+}
+
+func (c *TokenCache) sweepLocked(now time.Time) {
+	if now.Sub(c.lastSweep) < tokenCacheSweepInterval {
+		return
+	}
+	c.lastSweep = now
+	for key, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// tokenCacheKey hashes token so the cache does not hold usable credentials
+func tokenCacheKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// cachingAuthClient is an AuthServiceClient whose ValidateToken goes through
+// a TokenCache and whose Logout invalidates the logged out user's tokens
+type cachingAuthClient struct {
+	pb.AuthServiceClient
+	cache *TokenCache
+}
+
+// NewCachingAuthClient wraps client so ValidateToken results are cached for
+// at most ttl (0 uses DefaultTokenCacheTTL)
+func NewCachingAuthClient(client pb.AuthServiceClient, ttl time.Duration) pb.AuthServiceClient {
+	return &cachingAuthClient{AuthServiceClient: client, cache: NewTokenCache(client, ttl)}
+}
+
+func (c *cachingAuthClient) ValidateToken(ctx context.Context, in *pb.ValidateTokenRequest, opts ...grpc.CallOption) (*pb.ValidateTokenResponse, error) {
+	return c.cache.Validate(ctx, in.Token)
+}
+
+// Logout deletes every token of the user, so none of them may be served from
+// the cache afterwards
+func (c *cachingAuthClient) Logout(ctx context.Context, in *pb.LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	resp, err := c.cache.Validate(ctx, in.Token)
+	out, logoutErr := c.AuthServiceClient.Logout(ctx, in, opts...)
+	if logoutErr == nil && err == nil && resp.Valid {
+		c.cache.InvalidateUser(resp.UserId)
+	}
+	return out, logoutErr
+}
+
+var (
+	sharedAuthClientsMu sync.Mutex
+	sharedAuthClients   = make(map[*grpc.ClientConn]pb.AuthServiceClient)
+)
+
+// tokenCacheTTLFromEnv reads TOKEN_CACHE_TTL, defaulting to
+// DefaultTokenCacheTTL
+func tokenCacheTTLFromEnv() time.Duration {
+	if d, err := time.ParseDuration(os.Getenv("TOKEN_CACHE_TTL")); err == nil && d > 0 {
+		return d
+	}
+	return DefaultTokenCacheTTL
+}
+
+// AuthClient returns the caching auth client of conn, with TOKEN_CACHE_TTL as
+// its ttl. Handlers and middleware built on the same connection share one
+// token cache, so a token is validated once however many of them see it.
+func AuthClient(conn *grpc.ClientConn) pb.AuthServiceClient {
+	sharedAuthClientsMu.Lock()
+	defer sharedAuthClientsMu.Unlock()
+	if client, ok := sharedAuthClients[conn]; ok {
+		return client
+	}
+	client := NewCachingAuthClient(pb.NewAuthServiceClient(conn), tokenCacheTTLFromEnv())
+	sharedAuthClients[conn] = client
+	return client
+}
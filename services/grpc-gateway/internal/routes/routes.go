@@ -0,0 +1,519 @@
+// Package routes is the route table of the gateway. Each route names the
+// handler serving it and who may call it, so authentication is enforced in
+// one place instead of inside the handlers.
+package routes
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"metargb/grpc-gateway/internal/apiversion"
+	"metargb/grpc-gateway/internal/handler"
+	"metargb/grpc-gateway/internal/middleware"
+)
+
+// Route is one endpoint of the gateway
+type Route struct {
+	// Pattern is the ServeMux pattern without the version prefix, e.g.
+	// "GET /orders/{order}"
+	Pattern string
+	// Auth is who may call the route
+	Auth middleware.AuthLevel
+	// Handler serves the route
+	Handler http.HandlerFunc
+	// Versions are the API versions serving the route
+	Versions []apiversion.Version
+}
+
+// Handlers are the handlers the routes are served by
+type Handlers struct {
+	Auth          *handler.AuthHandler
+	Calendar      *handler.CalendarHandler
+	Commercial    *handler.CommercialHandler
+	Dynasty       *handler.DynastyHandler
+	Features      *handler.FeaturesHandler
+	Financial     *handler.FinancialHandler
+	Levels        *handler.LevelsHandler
+	Maps          *handler.MapsHandler
+	Notifications *handler.NotificationHandler
+	Profile       *handler.ProfileHandler
+	Profit        *handler.ProfitHandler
+	Social        *handler.SocialHandler
+	Storage       *handler.StorageHandler
+	Support       *handler.SupportHandler
+	Training      *handler.TrainingHandler
+	Upload        *handler.UploadHandler
+}
+
+var (
+	v1          = []apiversion.Version{apiversion.V1}
+	v2          = []apiversion.Version{apiversion.V2}
+	allVersions = []apiversion.Version{apiversion.V1, apiversion.V2}
+)
+
+// Table returns every route of the gateway. Routes under /admin/ are
+// AuthAdmin.
+func Table(h Handlers) []Route {
+	return []Route{
+		// Auth
+		{"POST /auth/register", middleware.AuthGuest, h.Auth.Register, v1},
+		{"GET /auth/redirect", middleware.AuthGuest, h.Auth.Redirect, v1},
+		{"GET /auth/callback", middleware.AuthGuest, h.Auth.Callback, v1},
+		{"POST /auth/magic-link", middleware.AuthGuest, h.Auth.RequestMagicLink, v1},
+		{"GET /auth/magic-link/callback", middleware.AuthGuest, h.Auth.MagicLinkCallback, v1},
+		{"POST /email/verification-notification", middleware.AuthRequired, h.Auth.SendEmailVerification, v1},
+		{"GET /email/verify/{id}/{hash}", middleware.AuthPublic, h.Auth.VerifyEmail, v1},
+		{"POST /auth/me", middleware.AuthRequired, h.Auth.GetMe, v1},
+		{"POST /auth/logout", middleware.AuthRequired, h.Auth.Logout, v1},
+		{"POST /auth/validate", middleware.AuthPublic, h.Auth.ValidateToken, v1},
+		{"POST /account/security", middleware.AuthRequired, h.Auth.RequestAccountSecurity, v1},
+		{"POST /account/security/verify", middleware.AuthRequired, h.Auth.VerifyAccountSecurity, v1},
+		{"GET /user", middleware.AuthPublic, h.Auth.GetUser, v1},
+		{"PUT /user/profile", middleware.AuthRequired, h.Auth.UpdateProfile, v1},
+		{"PATCH /user/profile", middleware.AuthRequired, h.Auth.UpdateProfile, v1},
+		{"GET /user/profile-limitations", middleware.AuthRequired, h.Auth.GetProfileLimitations, v1},
+		{"GET /users/{user}/profile-limitations", middleware.AuthRequired, h.Auth.GetProfileLimitations, v1},
+		{"GET /users", middleware.AuthPublic, h.Auth.ListUsers, v1},
+		{"GET /users/{user}/levels", middleware.AuthPublic, h.Auth.GetUserLevels, v1},
+		{"GET /users/{user}/profile", middleware.AuthOptional, h.Profile.GetProfile, v1},
+		{"GET /users/{user}/wallet", middleware.AuthPublic, h.Auth.GetUserWallet, v1},
+		{"GET /users/{user}/features/count", middleware.AuthPublic, h.Auth.GetUserFeaturesCount, v1},
+		{"GET /citizen/{code}", middleware.AuthPublic, h.Auth.HandleCitizenRoutes, v1},
+		{"GET /citizen/{code}/referrals", middleware.AuthPublic, h.Auth.HandleCitizenRoutes, v1},
+		{"GET /citizen/{code}/referrals/chart", middleware.AuthPublic, h.Auth.HandleCitizenRoutes, v1},
+		{"GET /kyc", middleware.AuthRequired, h.Auth.GetKYC, v1},
+		{"PUT /kyc", middleware.AuthRequired, h.Auth.UpdateKYC, v1},
+		{"PATCH /kyc", middleware.AuthRequired, h.Auth.UpdateKYC, v1},
+		{"GET /bank-accounts", middleware.AuthRequired, h.Auth.ListBankAccounts, v1},
+		{"POST /bank-accounts", middleware.AuthRequired, h.Auth.CreateBankAccount, v1},
+		{"GET /bank-accounts/{bankAccount}", middleware.AuthRequired, h.Auth.GetBankAccount, v1},
+		{"PUT /bank-accounts/{bankAccount}", middleware.AuthRequired, h.Auth.UpdateBankAccount, v1},
+		{"PATCH /bank-accounts/{bankAccount}", middleware.AuthRequired, h.Auth.UpdateBankAccount, v1},
+		{"DELETE /bank-accounts/{bankAccount}", middleware.AuthRequired, h.Auth.DeleteBankAccount, v1},
+		{"GET /personal-info", middleware.AuthRequired, h.Auth.GetPersonalInfo, v1},
+		{"PUT /personal-info", middleware.AuthRequired, h.Auth.UpdatePersonalInfo, v1},
+		{"PATCH /personal-info", middleware.AuthRequired, h.Auth.UpdatePersonalInfo, v1},
+		{"POST /profile-limitations", middleware.AuthRequired, h.Auth.CreateProfileLimitation, v1},
+		{"PUT /profile-limitations/{limitation_id}", middleware.AuthRequired, h.Auth.UpdateProfileLimitation, v1},
+		{"PATCH /profile-limitations/{limitation_id}", middleware.AuthRequired, h.Auth.UpdateProfileLimitation, v1},
+		{"DELETE /profile-limitations/{limitation_id}", middleware.AuthRequired, h.Auth.DeleteProfileLimitation, v1},
+		{"GET /profile-limitations/{limitation_id}", middleware.AuthRequired, h.Auth.GetProfileLimitation, v1},
+		{"GET /profilePhotos", middleware.AuthRequired, h.Auth.ListProfilePhotos, v1},
+		{"POST /profilePhotos", middleware.AuthRequired, h.Auth.UploadProfilePhoto, v1},
+		{"GET /profilePhotos/uploads/{upload}", middleware.AuthRequired, h.Auth.GetProfilePhotoStatus, v1},
+		{"GET /profilePhotos/{profilePhoto}", middleware.AuthPublic, h.Auth.GetProfilePhoto, v1},
+		{"DELETE /profilePhotos/{profilePhoto}", middleware.AuthRequired, h.Auth.DeleteProfilePhoto, v1},
+		{"GET /settings", middleware.AuthRequired, h.Auth.GetSettings, v1},
+		{"POST /settings", middleware.AuthRequired, h.Auth.UpdateSettings, v1},
+		{"GET /general-settings", middleware.AuthRequired, h.Auth.GetGeneralSettings, v1},
+		{"PUT /general-settings/{setting}", middleware.AuthRequired, h.Auth.UpdateGeneralSettings, v1},
+		{"GET /privacy", middleware.AuthRequired, h.Auth.GetPrivacySettings, v1},
+		{"POST /privacy", middleware.AuthRequired, h.Auth.UpdatePrivacySettings, v1},
+		{"GET /events", middleware.AuthRequired, h.Auth.ListUserEvents, v1},
+		{"GET /events/{userEvent}", middleware.AuthRequired, h.Auth.GetUserEvent, v1},
+		{"POST /events/report/{userEvent}", middleware.AuthRequired, h.Auth.ReportUserEvent, v1},
+		{"POST /events/report/response/{userEvent}", middleware.AuthRequired, h.Auth.SendReportResponse, v1},
+		{"POST /events/report/close/{userEvent}", middleware.AuthRequired, h.Auth.CloseEventReport, v1},
+		{"GET /events/export", middleware.AuthRequired, h.Auth.ExportUserEvents, v1},
+		{"GET /events/login-alerts", middleware.AuthRequired, h.Auth.ListLoginAlerts, v1},
+		{"GET /auth/login-history", middleware.AuthRequired, h.Auth.GetLoginHistory, v1},
+		{"POST /events/login-alerts/{alert}/confirm", middleware.AuthRequired, h.Auth.ConfirmLoginAlert, v1},
+		{"POST /search/users", middleware.AuthPublic, h.Auth.SearchUsers, v1},
+		{"POST /search/features", middleware.AuthPublic, h.Auth.SearchFeatures, v1},
+		{"POST /search/isic-codes", middleware.AuthPublic, h.Auth.SearchIsicCodes, v1},
+		{"GET /api-keys", middleware.AuthRequired, h.Auth.ListAPIKeys, v1},
+		{"POST /api-keys", middleware.AuthRequired, h.Auth.CreateAPIKey, v1},
+		{"DELETE /api-keys/{id}", middleware.AuthRequired, h.Auth.HandleAPIKeyRoutes, v1},
+		{"POST /api-keys/{id}/rotate", middleware.AuthRequired, h.Auth.HandleAPIKeyRoutes, v1},
+		{"GET /personal-access-tokens/scopes", middleware.AuthPublic, h.Auth.ListTokenScopes, v1},
+		{"GET /personal-access-tokens", middleware.AuthRequired, h.Auth.ListPersonalAccessTokens, v1},
+		{"POST /personal-access-tokens", middleware.AuthRequired, h.Auth.CreatePersonalAccessToken, v1},
+		{"DELETE /personal-access-tokens/{id}", middleware.AuthRequired, h.Auth.RevokePersonalAccessToken, v1},
+
+		// Calendar
+		{"GET /calendar", middleware.AuthOptional, h.Calendar.GetEvents, v1},
+		{"GET /calendar/{event}", middleware.AuthOptional, h.Calendar.GetEvent, v1},
+		{"GET /calendar/filter", middleware.AuthPublic, h.Calendar.FilterByDateRange, v1},
+		{"GET /calendar/latest-version", middleware.AuthPublic, h.Calendar.GetLatestVersion, v1},
+		{"POST /calendar/events/{event}/interact", middleware.AuthRequired, h.Calendar.AddInteraction, v1},
+		{"GET /calendar/events/{event}/rsvp", middleware.AuthOptional, h.Calendar.GetRsvp, v1},
+		{"POST /calendar/events/{event}/rsvp", middleware.AuthRequired, h.Calendar.RespondToEvent, v1},
+		{"GET /calendar/events/{event}/attendees", middleware.AuthPublic, h.Calendar.ListAttendees, v1},
+		{"GET /calendar/occasions", middleware.AuthPublic, h.Calendar.GetOccasions, v1},
+
+		// Commercial
+		{"GET /orders", middleware.AuthRequired, h.Commercial.ListOrders, v1},
+		{"GET /orders/first-order-bonus", middleware.AuthRequired, h.Commercial.FirstOrderBonus, v1},
+		{"GET /admin/wallet-adjustments", middleware.AuthAdmin, h.Commercial.ListAdjustmentBatches, v1},
+		{"POST /admin/wallet-adjustments", middleware.AuthAdmin, h.Commercial.CreateAdjustmentBatch, v1},
+		{"GET /admin/wallet-adjustments/{batch}", middleware.AuthAdmin, h.Commercial.GetAdjustmentBatch, v1},
+		{"POST /admin/wallet-adjustments/{batch}/approve", middleware.AuthAdmin, h.Commercial.ApproveAdjustmentBatch, v1},
+		{"POST /admin/wallet-adjustments/{batch}/reject", middleware.AuthAdmin, h.Commercial.RejectAdjustmentBatch, v1},
+		{"POST /installments", middleware.AuthRequired, h.Commercial.CreateInstallmentPlan, v1},
+		{"GET /installments", middleware.AuthRequired, h.Commercial.ListInstallmentPlans, v1},
+		{"GET /installments/{plan}", middleware.AuthRequired, h.Commercial.GetInstallmentPlan, v1},
+		{"POST /installments/{plan}/pay", middleware.AuthRequired, h.Commercial.PayInstallment, v1},
+		{"GET /subscriptions/plans", middleware.AuthPublic, h.Commercial.ListSubscriptionPlans, v1},
+		{"POST /subscriptions", middleware.AuthRequired, h.Commercial.Subscribe, v1},
+		{"GET /subscriptions/current", middleware.AuthRequired, h.Commercial.GetSubscription, v1},
+		{"POST /subscriptions/{subscription}/cancel", middleware.AuthRequired, h.Commercial.CancelSubscription, v1},
+		{"POST /subscriptions/{subscription}/pay", middleware.AuthRequired, h.Commercial.PaySubscription, v1},
+		{"GET /wallet/spending-limits", middleware.AuthRequired, h.Commercial.GetSpendingLimits, v1},
+		{"GET /admin/fraud/reviews", middleware.AuthAdmin, h.Commercial.ListFraudReviews, v1},
+		{"POST /admin/fraud/reviews/{check}/approve", middleware.AuthAdmin, h.Commercial.ApproveFraudReview, v1},
+		{"POST /admin/fraud/reviews/{check}/reject", middleware.AuthAdmin, h.Commercial.RejectFraudReview, v1},
+		{"POST /admin/fraud/blocked-cards", middleware.AuthAdmin, h.Commercial.BlockedCards, v1},
+		{"DELETE /admin/fraud/blocked-cards/{card}", middleware.AuthAdmin, h.Commercial.UnblockCard, v1},
+		{"GET /wallet/exchange-rates", middleware.AuthRequired, h.Commercial.ListExchangeRates, v1},
+		{"PUT /admin/exchange-rates", middleware.AuthAdmin, h.Commercial.SetExchangeRate, v1},
+		{"POST /wallet/convert", middleware.AuthRequired, h.Commercial.Convert, v1},
+		{"GET /rates", middleware.AuthPublic, h.Commercial.DisplayRates, v1},
+		{"GET /admin/variables", middleware.AuthAdmin, h.Commercial.ListVariables, v1},
+		{"PUT /admin/variables/{key}", middleware.AuthAdmin, h.Commercial.Variable, v1},
+		{"GET /admin/variables/{key}/changes", middleware.AuthAdmin, h.Commercial.ListVariableChanges, v1},
+		{"GET /admin/variable-schedules", middleware.AuthAdmin, h.Commercial.VariableSchedules, v1},
+		{"POST /admin/variable-schedules", middleware.AuthAdmin, h.Commercial.VariableSchedules, v1},
+		{"DELETE /admin/variable-schedules/{id}", middleware.AuthAdmin, h.Commercial.CancelVariableSchedule, v1},
+
+		// Dynasty
+		{"GET /dynasty", middleware.AuthRequired, h.Dynasty.GetDynasty, v1},
+		{"POST /dynasty/create/{feature}", middleware.AuthRequired, h.Dynasty.CreateDynasty, v1},
+		{"POST /dynasty/{dynasty}/update/{feature}", middleware.AuthRequired, h.Dynasty.UpdateDynastyFeature, v1},
+		{"GET /dynasty/{dynasty}/family/{family}", middleware.AuthRequired, h.Dynasty.GetFamily, v1},
+		{"GET /dynasty/requests/sent", middleware.AuthRequired, h.Dynasty.GetSentRequests, v1},
+		{"GET /dynasty/requests/recieved", middleware.AuthRequired, h.Dynasty.GetReceivedRequests, v1},
+		{"POST /dynasty/add/member", middleware.AuthRequired, h.Dynasty.SendJoinRequest, v1},
+		{"POST /dynasty/requests/recieved/{joinRequest}", middleware.AuthRequired, h.Dynasty.AcceptJoinRequest, v1},
+		{"DELETE /dynasty/requests/recieved/{joinRequest}", middleware.AuthRequired, h.Dynasty.RejectJoinRequest, v1},
+		{"GET /dynasty/requests/sent/{joinRequest}", middleware.AuthRequired, h.Dynasty.GetSentRequest, v1},
+		{"GET /dynasty/requests/recieved/{joinRequest}", middleware.AuthRequired, h.Dynasty.GetReceivedRequest, v1},
+		{"DELETE /dynasty/requests/sent/{joinRequest}", middleware.AuthRequired, h.Dynasty.DeleteJoinRequest, v1},
+		{"GET /dynasty/prizes", middleware.AuthRequired, h.Dynasty.GetPrizes, v1},
+		{"POST /dynasty/prizes/{recievedPrize}", middleware.AuthRequired, h.Dynasty.ClaimPrize, v1},
+		{"POST /dynasty/prizes/claim-all", middleware.AuthRequired, h.Dynasty.ClaimAllPrizes, v1},
+		{"POST /dynasty/children/{user}", middleware.AuthRequired, h.Dynasty.UpdateChildPermissions, v1},
+		{"GET /dynasty/children/{user}/spending-limits", middleware.AuthRequired, h.Dynasty.GetChildSpendingLimits, v1},
+		{"PUT /dynasty/children/{user}/spending-limits", middleware.AuthRequired, h.Dynasty.SetChildSpendingLimits, v1},
+		{"POST /dynasty/search", middleware.AuthRequired, h.Dynasty.SearchUsers, v1},
+		{"POST /dynasty/add/member/get/permissions", middleware.AuthRequired, h.Dynasty.GetDefaultPermissions, v1},
+		{"GET /admin/dynasty/membership-rules", middleware.AuthAdmin, h.Dynasty.GetMembershipRules, v1},
+		{"PUT /admin/dynasty/membership-rules", middleware.AuthAdmin, h.Dynasty.UpdateMembershipRules, v1},
+		{"GET /dynasty/leaderboard", middleware.AuthRequired, h.Dynasty.GetDynastyLeaderboard, v1},
+		{"GET /dynasty/{dynasty}/stats", middleware.AuthRequired, h.Dynasty.GetDynastyStats, v1},
+
+		// Features
+		{"GET /features", middleware.AuthOptional, h.Features.ListFeatures, v1},
+		{"GET /features/{feature}", middleware.AuthPublic, h.Features.GetFeature, v1},
+		{"POST /features/buy/{feature}", middleware.AuthRequired, h.Features.BuyFeature, v1},
+		{"POST /features/{feature}/reservation", middleware.AuthRequired, h.Features.ReserveFeature, v1},
+		{"DELETE /features/{feature}/reservation", middleware.AuthRequired, h.Features.ReleaseFeatureReservation, v1},
+		{"GET /features/limited/availability", middleware.AuthRequired, h.Features.GetLimitedFeatureAvailability, v1},
+		{"GET /features/{feature}/build/package", middleware.AuthRequired, h.Features.GetBuildPackage, v2},
+		{"POST /features/{feature}/build/{buildingModel}", middleware.AuthRequired, h.Features.BuildFeature, v2},
+		{"GET /features/{feature}/build/{buildingModel}/simulate", middleware.AuthRequired, h.Features.SimulateBuild, v2},
+		{"GET /features/{feature}/build/buildings", middleware.AuthPublic, h.Features.GetBuildings, v2},
+		{"PUT /features/{feature}/build/buildings/{buildingModel}", middleware.AuthRequired, h.Features.UpdateBuilding, v2},
+		{"DELETE /features/{feature}/build/buildings/{buildingModel}", middleware.AuthRequired, h.Features.DestroyBuilding, v2},
+		{"GET /sell-requests", middleware.AuthRequired, h.Features.ListSellRequests, v1},
+		{"POST /sell-requests/store/{feature}", middleware.AuthRequired, h.Features.CreateSellRequest, v1},
+		{"DELETE /sell-requests/{sellRequest}", middleware.AuthRequired, h.Features.DeleteSellRequest, v1},
+		{"POST /buy-requests/add-grace-period/{buyFeatureRequest}", middleware.AuthRequired, h.Features.UpdateGracePeriod, v1},
+		{"POST /buy-requests/{buyFeatureRequest}/counter", middleware.AuthRequired, h.Features.CounterBuyRequest, v1},
+		{"POST /buy-requests/{buyFeatureRequest}/counter/accept", middleware.AuthRequired, h.Features.AcceptCounterOffer, v1},
+		{"POST /buy-requests/{buyFeatureRequest}/counter/decline", middleware.AuthRequired, h.Features.DeclineCounterOffer, v1},
+		{"GET /buy-requests/{buyFeatureRequest}/negotiation", middleware.AuthRequired, h.Features.GetNegotiation, v1},
+		{"POST /features/{feature}/watch", middleware.AuthRequired, h.Features.WatchFeature, v1},
+		{"DELETE /features/{feature}/watch", middleware.AuthRequired, h.Features.UnwatchFeature, v1},
+		{"GET /watchlist", middleware.AuthRequired, h.Features.ListWatchlist, v1},
+		{"GET /marketplace/listings", middleware.AuthPublic, h.Features.ListMarketplaceListings, v1},
+		{"GET /marketplace/saved-searches", middleware.AuthRequired, h.Features.ListSavedSearches, v1},
+		{"POST /marketplace/saved-searches", middleware.AuthRequired, h.Features.CreateSavedSearch, v1},
+		{"PUT /marketplace/saved-searches/{savedSearch}", middleware.AuthRequired, h.Features.UpdateSavedSearch, v1},
+		{"DELETE /marketplace/saved-searches/{savedSearch}", middleware.AuthRequired, h.Features.DeleteSavedSearch, v1},
+		{"PUT /admin/features/{feature}/geometry", middleware.AuthAdmin, h.Features.UpdateFeatureGeometry, v1},
+		{"GET /admin/features/{feature}/geometry/versions", middleware.AuthAdmin, h.Features.ListGeometryVersions, v1},
+		{"GET /features/{feature}/history", middleware.AuthRequired, h.Features.GetFeatureHistory, v1},
+		{"GET /features/{feature}/images", middleware.AuthRequired, h.Features.FeatureImages, v1},
+		{"POST /features/{feature}/images", middleware.AuthRequired, h.Features.FeatureImages, v1},
+		{"PUT /features/{feature}/images/order", middleware.AuthRequired, h.Features.FeatureImages, v1},
+		{"DELETE /features/{feature}/images/{image}", middleware.AuthRequired, h.Features.FeatureImages, v1},
+		{"PUT /features/{feature}/images/{image}/cover", middleware.AuthRequired, h.Features.FeatureImages, v1},
+
+		// Financial
+		{"POST /order", middleware.AuthRequired, h.Financial.CreateOrder, v1},
+		{"POST /parsian/callback", middleware.AuthPublic, h.Financial.HandleCallback, v1},
+		{"GET /parsian/callback", middleware.AuthPublic, h.Financial.HandleCallback, v1}, // Sandbox payment links
+		{"POST /store", middleware.AuthPublic, h.Financial.GetStorePackages, v1},
+
+		// Levels
+		{"GET /levels", middleware.AuthPublic, h.Levels.GetAllLevels, allVersions},
+		{"GET /levels/{slug}", middleware.AuthPublic, h.Levels.GetLevel, allVersions},
+		{"GET /levels/{slug}/general-info", middleware.AuthPublic, h.Levels.GetLevelGeneralInfo, allVersions},
+		{"GET /levels/{slug}/gem", middleware.AuthPublic, h.Levels.GetLevelGem, allVersions},
+		{"GET /levels/{slug}/gift", middleware.AuthPublic, h.Levels.GetLevelGift, allVersions},
+		{"GET /levels/{slug}/licenses", middleware.AuthPublic, h.Levels.GetLevelLicenses, allVersions},
+		{"GET /levels/{slug}/prize", middleware.AuthPublic, h.Levels.GetLevelPrize, allVersions},
+		{"GET /onboarding", middleware.AuthRequired, h.Levels.GetOnboardingState, v1},
+		{"POST /activity/heartbeat", middleware.AuthRequired, h.Levels.Heartbeat, v1},
+
+		// Maps
+		{"GET /maps", middleware.AuthPublic, h.Maps.ListMaps, v2},
+		{"GET /maps/{map}", middleware.AuthPublic, h.Maps.GetMap, v2},
+		{"GET /maps/{map}/border", middleware.AuthPublic, h.Maps.GetMapBorder, v2},
+
+		// My features
+		{"GET /my-features", middleware.AuthRequired, h.Features.ListMyFeatures, v1},
+		{"GET /portfolio", middleware.AuthRequired, h.Features.GetPortfolio, v1},
+		{"GET /my-features/{user}/features/{feature}", middleware.AuthRequired, h.Features.GetMyFeature, v1},
+		{"POST /my-features/{user}/add-image/{feature}", middleware.AuthRequired, h.Features.AddMyFeatureImages, v1},
+		{"POST /my-features/{user}/remove-image/{feature}/image/{image}", middleware.AuthRequired, h.Features.RemoveMyFeatureImage, v1},
+		{"POST /my-features/{user}/features/{feature}", middleware.AuthRequired, h.Features.UpdateMyFeature, v1},
+
+		// Notifications
+		{"GET /notifications", middleware.AuthRequired, h.Notifications.GetNotifications, v1},
+		{"GET /notifications/{notification}", middleware.AuthRequired, h.Notifications.GetNotification, v1},
+		{"POST /notifications/read/{notification}", middleware.AuthRequired, h.Notifications.MarkAsRead, v1},
+		{"POST /notifications/read/all", middleware.AuthRequired, h.Notifications.MarkAllAsRead, v1},
+		{"GET /settings/notifications", middleware.AuthRequired, h.Notifications.GetPreferences, v1},
+		{"PUT /settings/notifications", middleware.AuthRequired, h.Notifications.UpdatePreferences, v1},
+		{"GET /settings/notifications/digest", middleware.AuthRequired, h.Notifications.GetDigestSettings, v1},
+		{"PUT /settings/notifications/digest", middleware.AuthRequired, h.Notifications.UpdateDigestSettings, v1},
+		{"GET /settings/notifications/bot", middleware.AuthRequired, h.Notifications.GetBotLink, v1},
+		{"POST /settings/notifications/bot", middleware.AuthRequired, h.Notifications.CreateBotLinkCode, v1},
+		{"DELETE /settings/notifications/bot", middleware.AuthRequired, h.Notifications.UnlinkBot, v1},
+		{"GET /admin/notifications/templates", middleware.AuthAdmin, h.Notifications.ListTemplates, v1},
+		{"POST /admin/notifications/templates/preview", middleware.AuthAdmin, h.Notifications.PreviewTemplate, v1},
+		{"POST /admin/notifications/templates/test-send", middleware.AuthAdmin, h.Notifications.TestSendTemplate, v1},
+
+		// Profit
+		{"GET /hourly-profits", middleware.AuthRequired, h.Profit.GetHourlyProfits, v1},
+		{"POST /hourly-profits", middleware.AuthRequired, h.Profit.GetProfitsByApplication, v1},
+		{"POST /hourly-profits/{featureHourlyProfit}", middleware.AuthRequired, h.Profit.GetSingleProfit, v1},
+		{"GET /hourly-profits/features/{feature}", middleware.AuthRequired, h.Profit.GetFeatureProfit, v1},
+		{"PUT /hourly-profits/settings", middleware.AuthRequired, h.Profit.ProfitSettings, v1},
+
+		// Social
+		{"GET /followers", middleware.AuthRequired, h.Social.GetFollowers, v1},
+		{"GET /following", middleware.AuthRequired, h.Social.GetFollowing, v1},
+		{"GET /follow/{user}", middleware.AuthRequired, h.Social.Follow, v1},
+		{"GET /unfollow/{user}", middleware.AuthRequired, h.Social.Unfollow, v1},
+		{"GET /remove/{user}", middleware.AuthRequired, h.Social.Remove, v1},
+		{"GET /challenge/timings", middleware.AuthRequired, h.Social.GetTimings, v1},
+		{"POST /challenge/question", middleware.AuthRequired, h.Social.GetQuestion, v1},
+		{"POST /challenge/answer", middleware.AuthRequired, h.Social.SubmitAnswer, v1},
+
+		// Storage
+		{"POST /upload", middleware.AuthRequired, h.Storage.HandleUpload, v1},
+
+		// Support
+		{"GET /tickets", middleware.AuthRequired, h.Support.ListTickets, v1},
+		{"POST /tickets", middleware.AuthRequired, h.Support.CreateTicket, v1},
+		{"GET /tickets/{ticket}", middleware.AuthRequired, h.Support.GetTicket, v1},
+		{"PUT /tickets/{ticket}", middleware.AuthRequired, h.Support.UpdateTicket, v1},
+		{"PATCH /tickets/{ticket}", middleware.AuthRequired, h.Support.UpdateTicket, v1},
+		{"POST /tickets/response/{ticket}", middleware.AuthRequired, h.Support.AddTicketResponse, v1},
+		{"GET /tickets/close/{ticket}", middleware.AuthRequired, h.Support.CloseTicket, v1},
+		{"GET /reports", middleware.AuthRequired, h.Support.ListReports, v1},
+		{"POST /reports", middleware.AuthRequired, h.Support.CreateReport, v1},
+		{"GET /reports/{report}", middleware.AuthRequired, h.Support.GetReport, v1},
+		{"GET /notes", middleware.AuthRequired, h.Support.ListNotes, v1},
+		{"POST /notes", middleware.AuthRequired, h.Support.CreateNote, v1},
+		{"GET /notes/{note}", middleware.AuthRequired, h.Support.GetNote, v1},
+		{"PUT /notes/{note}", middleware.AuthRequired, h.Support.UpdateNote, v1},
+		{"PATCH /notes/{note}", middleware.AuthRequired, h.Support.UpdateNote, v1},
+		{"DELETE /notes/{note}", middleware.AuthRequired, h.Support.DeleteNote, v1},
+		{"GET /disputes", middleware.AuthRequired, h.Support.ListDisputes, v1},
+		{"POST /disputes", middleware.AuthRequired, h.Support.OpenDispute, v1},
+		{"GET /disputes/{dispute}", middleware.AuthRequired, h.Support.GetDispute, v1},
+		{"POST /disputes/{dispute}/resolve", middleware.AuthRequired, h.Support.ResolveDispute, v1},
+		{"POST /tickets/{ticket}/chat", middleware.AuthRequired, h.Support.StartSupportChat, v1},
+		{"GET /support/chats", middleware.AuthRequired, h.Support.ListSupportChats, v1},
+		{"GET /support/chats/{chat}", middleware.AuthRequired, h.Support.GetSupportChat, v1},
+		{"POST /support/chats/{chat}/messages", middleware.AuthRequired, h.Support.SendSupportChatMessage, v1},
+		{"POST /support/chats/{chat}/close", middleware.AuthRequired, h.Support.CloseSupportChat, v1},
+		{"GET /support/chats/{chat}/transcript", middleware.AuthRequired, h.Support.ExportSupportChatTranscript, v1},
+		{"POST /tickets/{ticket}/suggestions/{suggestion}/accept", middleware.AuthRequired, h.Support.AcceptTicketSuggestion, v1},
+		{"GET /support/faqs", middleware.AuthPublic, h.Support.ListSupportFaqs, v1},
+		{"POST /admin/support/faqs", middleware.AuthAdmin, h.Support.CreateSupportFaq, v1},
+		{"PUT /admin/support/faqs/{faq}", middleware.AuthAdmin, h.Support.UpdateSupportFaq, v1},
+		{"DELETE /admin/support/faqs/{faq}", middleware.AuthAdmin, h.Support.DeleteSupportFaq, v1},
+		{"POST /webhooks/support-email", middleware.AuthPublic, h.Support.IngestSupportEmail, v1},
+		{"GET /admin/support/stats", middleware.AuthAdmin, h.Support.GetSupportStats, v1},
+
+		// Trade receipts
+		{"GET /trades/{trade}/receipt", middleware.AuthRequired, h.Features.GetTradeReceipt, v1},
+		{"GET /trades/verify/{code}", middleware.AuthPublic, h.Features.VerifyTradeReceipt, v1},
+		{"GET /trades/annual-summary", middleware.AuthRequired, h.Features.GetAnnualTradeSummary, v1},
+		{"GET /trades/annual-summary/export", middleware.AuthRequired, h.Features.ExportAnnualTradeSummary, v1},
+
+		// Training
+		{"GET /tutorials", middleware.AuthPublic, h.Training.GetVideos, v1},
+		{"GET /tutorials/{slug}", middleware.AuthOptional, h.Training.GetVideo, v1},
+		{"POST /tutorials/search", middleware.AuthPublic, h.Training.SearchVideos, v1},
+		{"POST /tutorials/{video}/interactions", middleware.AuthRequired, h.Training.AddInteraction, v1},
+		{"POST /video-tutorials", middleware.AuthOptional, h.Training.GetVideoByFileName, v1},
+		{"GET /tutorials/categories", middleware.AuthPublic, h.Training.GetCategories, v1},
+		{"GET /tutorials/categories/{category}", middleware.AuthPublic, h.Training.GetCategory, v1},
+		{"GET /tutorials/categories/{category}/videos", middleware.AuthPublic, h.Training.GetCategoryVideos, v1},
+		{"GET /tutorials/categories/{category}/{subCategory}", middleware.AuthPublic, h.Training.GetSubCategory, v1},
+		{"GET /tutorials/{video}/comments", middleware.AuthPublic, h.Training.GetComments, v1},
+		{"POST /tutorials/{video}/comments", middleware.AuthRequired, h.Training.AddComment, v1},
+		{"PUT /tutorials/{video}/comments/{comment}", middleware.AuthRequired, h.Training.UpdateComment, v1},
+		{"DELETE /tutorials/{video}/comments/{comment}", middleware.AuthRequired, h.Training.DeleteComment, v1},
+		{"POST /tutorials/{video}/comments/{comment}/interactions", middleware.AuthRequired, h.Training.AddCommentInteraction, v1},
+		{"POST /tutorials/{video}/comments/{comment}/report", middleware.AuthRequired, h.Training.ReportComment, v1},
+		{"GET /comments/{comment}/replies", middleware.AuthPublic, h.Training.GetReplies, v1},
+		{"POST /comments/{comment}/reply", middleware.AuthRequired, h.Training.AddReply, v1},
+		{"PUT /comments/{comment}/replies/{reply}", middleware.AuthRequired, h.Training.UpdateReply, v1},
+		{"DELETE /comments/{comment}/replies/{reply}", middleware.AuthRequired, h.Training.DeleteReply, v1},
+		{"POST /comments/{comment}/replies/{reply}/interactions", middleware.AuthRequired, h.Training.AddReplyInteraction, v1},
+
+		// Upload
+		{"POST /uploads/{kind}", middleware.AuthRequired, h.Upload.Upload, v1},
+	}
+}
+
+// Register registers the routes on router behind the middleware of their
+// auth level. It panics if a route has no auth level.
+//
+// ServeMux refuses two patterns matching a same path when neither is more
+// specific, e.g. "POST /features/buy/{feature}" and "POST
+// /features/{feature}/watch". Such routes are registered as one pattern and
+// told apart by their literal segments, the leftmost literal winning.
+func Register(router *apiversion.Router, auth *middleware.RouteAuth, routes []Route) {
+	var versions []apiversion.Version
+	entries := map[apiversion.Version][]*muxEntry{}
+	for _, route := range routes {
+		wrapped := auth.Wrap(route.Auth, route.Handler)
+		method, path, _ := strings.Cut(route.Pattern, " ")
+		segments := strings.Split(strings.Trim(path, "/"), "/")
+		for _, v := range route.Versions {
+			if _, ok := entries[v]; !ok {
+				versions = append(versions, v)
+			}
+			entries[v] = addRoute(entries[v], method, segments, wrapped)
+		}
+	}
+
+	for _, v := range versions {
+		group := router.Group(v)
+		for _, entry := range entries[v] {
+			group.Handle(entry.pattern(), entry.handler())
+		}
+	}
+}
+
+// muxEntry is one ServeMux pattern and the routes it serves
+type muxEntry struct {
+	method string
+	routes []muxRoute
+}
+
+type muxRoute struct {
+	segments []string
+	handler  http.Handler
+}
+
+// addRoute adds a route to the entry of every route it is ambiguous with,
+// merging them, or else to a new entry
+func addRoute(entries []*muxEntry, method string, segments []string, handler http.Handler) []*muxEntry {
+	merged := &muxEntry{method: method, routes: []muxRoute{{segments: segments, handler: handler}}}
+	kept := entries[:0]
+	for _, entry := range entries {
+		if entry.method == method && entry.ambiguous(segments) {
+			merged.routes = append(merged.routes, entry.routes...)
+			continue
+		}
+		kept = append(kept, entry)
+	}
+	sort.SliceStable(merged.routes, func(i, j int) bool {
+		return literalFirst(merged.routes[i].segments, merged.routes[j].segments)
+	})
+	return append(kept, merged)
+}
+
+// ambiguous reports whether a route of the entry and segments both match a
+// path while neither is more specific
+func (e *muxEntry) ambiguous(segments []string) bool {
+	for _, route := range e.routes {
+		if len(route.segments) != len(segments) {
+			continue
+		}
+		ownLiteral, otherLiteral, disjoint := false, false, false
+		for i, segment := range segments {
+			switch {
+			case !isWildcard(route.segments[i]) && !isWildcard(segment):
+				disjoint = disjoint || route.segments[i] != segment
+			case !isWildcard(route.segments[i]):
+				ownLiteral = true
+			case !isWildcard(segment):
+				otherLiteral = true
+			}
+		}
+		if !disjoint && ownLiteral && otherLiteral {
+			return true
+		}
+	}
+	return false
+}
+
+// pattern is the ServeMux pattern matching every route of the entry: the
+// segments they share, and wildcards elsewhere
+func (e *muxEntry) pattern() string {
+	if len(e.routes) == 1 {
+		return e.method + " /" + strings.Join(e.routes[0].segments, "/")
+	}
+	segments := make([]string, len(e.routes[0].segments))
+	for i := range segments {
+		segments[i] = e.routes[0].segments[i]
+		for _, route := range e.routes[1:] {
+			if route.segments[i] != segments[i] || isWildcard(segments[i]) {
+				segments[i] = fmt.Sprintf("{s%d}", i)
+				break
+			}
+		}
+	}
+	return e.method + " /" + strings.Join(segments, "/")
+}
+
+// handler serves a request with the first route of the entry matching it
+func (e *muxEntry) handler() http.Handler {
+	if len(e.routes) == 1 {
+		return e.routes[0].handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		for _, route := range e.routes {
+			if matchSegments(route.segments, path[len(path)-len(route.segments):]) {
+				route.handler.ServeHTTP(w, r)
+				return
+			}
+		}
+		http.NotFound(w, r)
+	})
+}
+
+func matchSegments(pattern, path []string) bool {
+	for i, segment := range pattern {
+		if !isWildcard(segment) && segment != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// literalFirst orders a before b when a has a literal segment left of b's
+func literalFirst(a, b []string) bool {
+	for i := range a {
+		if aWild, bWild := isWildcard(a[i]), isWildcard(b[i]); aWild != bWild {
+			return !aWild
+		}
+	}
+	return false
+}
+
+func isWildcard(segment string) bool {
+	return strings.HasPrefix(segment, "{")
+}
@@ -0,0 +1,117 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"metargb/grpc-gateway/internal/apiversion"
+	"metargb/grpc-gateway/internal/middleware"
+	pb "metargb/shared/pb/auth"
+)
+
+type fakeAuthClient struct {
+	pb.AuthServiceClient
+	users map[string]uint64
+}
+
+func (c *fakeAuthClient) ValidateToken(ctx context.Context, in *pb.ValidateTokenRequest, opts ...grpc.CallOption) (*pb.ValidateTokenResponse, error) {
+	userID, ok := c.users[in.Token]
+	return &pb.ValidateTokenResponse{Valid: ok, UserId: userID}, nil
+}
+
+func TestEveryRouteDeclaresAuthLevel(t *testing.T) {
+	seen := map[string]bool{}
+	for _, route := range Table(Handlers{}) {
+		if route.Auth == middleware.AuthUnset {
+			t.Errorf("%s has no auth level", route.Pattern)
+		}
+		if route.Handler == nil {
+			t.Errorf("%s has no handler", route.Pattern)
+		}
+		if len(route.Versions) == 0 {
+			t.Errorf("%s is not served in any version", route.Pattern)
+		}
+		if strings.Contains(route.Pattern, "/admin/") && route.Auth != middleware.AuthAdmin {
+			t.Errorf("%s is %s, admin routes must be admin", route.Pattern, route.Auth)
+		}
+		for _, v := range route.Versions {
+			key := v.String() + " " + route.Pattern
+			if seen[key] {
+				t.Errorf("%s is declared twice", key)
+			}
+			seen[key] = true
+		}
+	}
+}
+
+func TestRegisterPanicsWithoutAuthLevel(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("route without auth level was registered")
+		}
+	}()
+	router := apiversion.NewRouter(http.NewServeMux())
+	auth := middleware.NewRouteAuth(&fakeAuthClient{}, nil, nil)
+	Register(router, auth, []Route{{Pattern: "GET /unset", Handler: func(http.ResponseWriter, *http.Request) {}, Versions: v1}})
+}
+
+func TestRegisterEnforcesAuthLevels(t *testing.T) {
+	mux := http.NewServeMux()
+	auth := middleware.NewRouteAuth(&fakeAuthClient{users: map[string]uint64{"admin": 1, "user": 2}}, nil, []uint64{1})
+	// Registering every route also checks the patterns do not conflict
+	Register(apiversion.NewRouter(mux), auth, Table(Handlers{}))
+
+	served := func(http.ResponseWriter, *http.Request) {}
+	Register(apiversion.NewRouter(mux), auth, []Route{
+		{"GET /test/public", middleware.AuthPublic, served, v1},
+		{"GET /test/guest", middleware.AuthGuest, served, v1},
+		{"GET /test/optional", middleware.AuthOptional, served, v1},
+		{"GET /test/required", middleware.AuthRequired, served, v1},
+		{"GET /test/admin", middleware.AuthAdmin, served, v1},
+		// Ambiguous for ServeMux, told apart by their literal segments
+		{"GET /test/verify/{code}", middleware.AuthPublic, served, v1},
+		{"GET /test/{trade}/receipt", middleware.AuthRequired, served, v1},
+	})
+
+	tests := []struct {
+		path  string
+		token string
+		want  int
+	}{
+		{"/api/test/public", "", http.StatusOK},
+		{"/api/test/guest", "", http.StatusOK},
+		{"/api/test/guest", "user", http.StatusForbidden},
+		{"/api/test/optional", "", http.StatusOK},
+		{"/api/test/optional", "user", http.StatusOK},
+		{"/api/test/required", "", http.StatusUnauthorized},
+		{"/api/test/required", "expired", http.StatusUnauthorized},
+		{"/api/test/required", "user", http.StatusOK},
+		{"/api/test/admin", "", http.StatusUnauthorized},
+		{"/api/test/admin", "user", http.StatusForbidden},
+		{"/api/test/admin", "admin", http.StatusOK},
+		{"/api/test/verify/abc", "", http.StatusOK},
+		{"/api/test/verify/receipt", "", http.StatusOK},
+		{"/api/test/5/receipt", "", http.StatusUnauthorized},
+		{"/api/test/5/other", "user", http.StatusNotFound},
+		// Required routes of the table are refused before their handler runs
+		{"/api/orders", "", http.StatusUnauthorized},
+		{"/api/dynasty/1/family/2", "", http.StatusUnauthorized},
+		{"/api/admin/variables", "user", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+		if tt.token != "" {
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+		}
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if rec.Code != tt.want {
+			t.Errorf("GET %s with token %q: status %d, want %d", tt.path, tt.token, rec.Code, tt.want)
+		}
+	}
+}
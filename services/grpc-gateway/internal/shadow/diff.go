@@ -0,0 +1,143 @@
+package shadow
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// maxDifferences limits the differences kept per mismatch
+const maxDifferences = 20
+
+// Difference is one value that differs between the two responses. A missing
+// value is reported as nil with the Missing flag of that side set.
+type Difference struct {
+	Path           string `json:"path"`
+	Legacy         any    `json:"legacy"`
+	Gateway        any    `json:"gateway"`
+	MissingLegacy  bool   `json:"missing_legacy,omitempty"`
+	MissingGateway bool   `json:"missing_gateway,omitempty"`
+}
+
+var indexPattern = regexp.MustCompile(`\[\d+\]`)
+
+// Ignore decides which fields are left out of the comparison. An entry is a
+// field name matched at any depth ("updated_at") or a dotted path with array
+// indexes left out ("data.owner.last_seen").
+type Ignore map[string]bool
+
+// ParseIgnore builds an Ignore from a comma separated list
+func ParseIgnore(list string) Ignore {
+	ignore := Ignore{}
+	for _, field := range strings.Split(list, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			ignore[field] = true
+		}
+	}
+	return ignore
+}
+
+func (ig Ignore) skip(path, key string) bool {
+	return ig[key] || ig[indexPattern.ReplaceAllString(path, "")]
+}
+
+// DiffJSON compares two JSON documents. Numbers are compared by value, so
+// 1 and 1.0 are equal. Bodies that are not JSON are compared as text.
+func DiffJSON(legacy, gateway []byte, ignore Ignore) []Difference {
+	var l, g any
+	if json.Unmarshal(legacy, &l) != nil || json.Unmarshal(gateway, &g) != nil {
+		if string(legacy) == string(gateway) {
+			return nil
+		}
+		return []Difference{{Path: "$", Legacy: truncate(string(legacy)), Gateway: truncate(string(gateway))}}
+	}
+
+	var diffs []Difference
+	diffValues("", l, g, ignore, &diffs)
+	return diffs
+}
+
+func diffValues(path string, l, g any, ignore Ignore, diffs *[]Difference) {
+	if len(*diffs) >= maxDifferences {
+		return
+	}
+
+	switch lv := l.(type) {
+	case map[string]any:
+		gv, ok := g.(map[string]any)
+		if !ok {
+			break
+		}
+		for _, key := range unionKeys(lv, gv) {
+			child := joinPath(path, key)
+			if ignore.skip(child, key) {
+				continue
+			}
+			lc, lok := lv[key]
+			gc, gok := gv[key]
+			if !lok || !gok {
+				if len(*diffs) < maxDifferences {
+					*diffs = append(*diffs, Difference{Path: child, Legacy: lc, Gateway: gc, MissingLegacy: !lok, MissingGateway: !gok})
+				}
+				continue
+			}
+			diffValues(child, lc, gc, ignore, diffs)
+		}
+		return
+	case []any:
+		gv, ok := g.([]any)
+		if !ok {
+			break
+		}
+		if len(lv) != len(gv) && len(*diffs) < maxDifferences {
+			*diffs = append(*diffs, Difference{Path: pathOrRoot(path) + ".length", Legacy: len(lv), Gateway: len(gv)})
+		}
+		for i := 0; i < len(lv) && i < len(gv); i++ {
+			diffValues(fmt.Sprintf("%s[%d]", path, i), lv[i], gv[i], ignore, diffs)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(l, g) {
+		*diffs = append(*diffs, Difference{Path: pathOrRoot(path), Legacy: l, Gateway: g})
+	}
+}
+
+func unionKeys(a, b map[string]any) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "$"
+	}
+	return path
+}
+
+func truncate(s string) string {
+	const max = 512
+	if len(s) > max {
+		return s[:max] + "…"
+	}
+	return s
+}
@@ -0,0 +1,294 @@
+// Package shadow mirrors a share of the gateway's read requests to the legacy
+// Laravel API and compares the JSON responses, so parity can be measured on
+// real traffic before the legacy API is turned off. The client always gets
+// the gateway's response; the legacy call happens afterwards in the
+// background.
+package shadow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"metargb/grpc-gateway/internal/middleware"
+)
+
+var shadowRequests = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "gateway_shadow_requests_total",
+		Help: "Read requests mirrored to the legacy API by route and result (match, mismatch, error, dropped)",
+	},
+	[]string{"route", "result"},
+)
+
+const (
+	// maxBodySize caps the bytes of each response kept for comparison;
+	// larger responses are counted as errors
+	maxBodySize = 1 << 20
+
+	defaultTimeout     = 5 * time.Second
+	defaultMaxSamples  = 100
+	defaultMaxInFlight = 10
+)
+
+// mirroredHeaders are copied to the legacy request, so it authenticates and
+// localizes like the original
+var mirroredHeaders = []string{"Authorization", "Accept", "Accept-Language", "X-Api-Key"}
+
+// Config configures shadow traffic. It is off unless LegacyURL and Percent are set.
+type Config struct {
+	// LegacyURL is the base URL of the Laravel API, e.g. https://legacy.metargb.com
+	LegacyURL string
+	// Percent of GET requests mirrored, 0 to 100
+	Percent float64
+	// Ignore lists fields left out of the comparison, see Ignore
+	Ignore Ignore
+	// Timeout of each legacy request
+	Timeout time.Duration
+	// MaxSamples is how many recent mismatches are kept for review
+	MaxSamples int
+	// MaxInFlight caps concurrent legacy requests; further requests are not mirrored
+	MaxInFlight int
+}
+
+// FromEnv reads SHADOW_LEGACY_URL, SHADOW_PERCENT, SHADOW_IGNORE_FIELDS,
+// SHADOW_TIMEOUT, SHADOW_MAX_SAMPLES and SHADOW_MAX_IN_FLIGHT
+func FromEnv() Config {
+	cfg := Config{
+		LegacyURL:   strings.TrimSuffix(os.Getenv("SHADOW_LEGACY_URL"), "/"),
+		Ignore:      ParseIgnore(os.Getenv("SHADOW_IGNORE_FIELDS")),
+		Timeout:     defaultTimeout,
+		MaxSamples:  defaultMaxSamples,
+		MaxInFlight: defaultMaxInFlight,
+	}
+	if v, err := strconv.ParseFloat(os.Getenv("SHADOW_PERCENT"), 64); err == nil {
+		cfg.Percent = min(max(v, 0), 100)
+	}
+	if d, err := time.ParseDuration(os.Getenv("SHADOW_TIMEOUT")); err == nil && d > 0 {
+		cfg.Timeout = d
+	}
+	if n, err := strconv.Atoi(os.Getenv("SHADOW_MAX_SAMPLES")); err == nil && n > 0 {
+		cfg.MaxSamples = n
+	}
+	if n, err := strconv.Atoi(os.Getenv("SHADOW_MAX_IN_FLIGHT")); err == nil && n > 0 {
+		cfg.MaxInFlight = n
+	}
+	return cfg
+}
+
+// Enabled reports whether any traffic is mirrored
+func (c Config) Enabled() bool {
+	return c.LegacyURL != "" && c.Percent > 0
+}
+
+// Mismatch is a sampled request whose responses differ
+type Mismatch struct {
+	Time          time.Time    `json:"time"`
+	Method        string       `json:"method"`
+	Path          string       `json:"path"` // Includes the query string
+	Route         string       `json:"route"`
+	LegacyStatus  int          `json:"legacy_status"`
+	GatewayStatus int          `json:"gateway_status"`
+	Differences   []Difference `json:"differences"`
+}
+
+// Shadow mirrors requests and keeps the most recent mismatches
+type Shadow struct {
+	cfg      Config
+	client   *http.Client
+	inFlight chan struct{}
+
+	mu      sync.Mutex
+	samples []Mismatch // ring buffer of the last MaxSamples mismatches
+	next    int
+}
+
+// New creates a Shadow for cfg
+func New(cfg Config) *Shadow {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.MaxSamples <= 0 {
+		cfg.MaxSamples = defaultMaxSamples
+	}
+	if cfg.MaxInFlight <= 0 {
+		cfg.MaxInFlight = defaultMaxInFlight
+	}
+	return &Shadow{
+		cfg:      cfg,
+		client:   &http.Client{Timeout: cfg.Timeout},
+		inFlight: make(chan struct{}, cfg.MaxInFlight),
+	}
+}
+
+// Middleware mirrors the sampled GET requests it wraps. Wrap it inside the
+// metrics middleware so routes are labeled by pattern.
+func (s *Shadow) Middleware(next http.Handler) http.Handler {
+	if !s.cfg.Enabled() {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || rand.Float64()*100 >= s.cfg.Percent {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		recorder := &bodyRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		route := middleware.RoutePattern(r)
+		select {
+		case s.inFlight <- struct{}{}:
+		default:
+			shadowRequests.WithLabelValues(route, "dropped").Inc()
+			return
+		}
+
+		req := r.Clone(context.WithoutCancel(r.Context()))
+		go func() {
+			defer func() { <-s.inFlight }()
+			s.compare(req, route, recorder.status, recorder.body.Bytes(), recorder.overflow)
+		}()
+	})
+}
+
+func (s *Shadow) compare(r *http.Request, route string, gatewayStatus int, gatewayBody []byte, overflow bool) {
+	ctx, cancel := context.WithTimeout(r.Context(), s.cfg.Timeout)
+	defer cancel()
+
+	legacyReq, err := http.NewRequestWithContext(ctx, http.MethodGet, s.cfg.LegacyURL+r.URL.RequestURI(), nil)
+	if err != nil {
+		shadowRequests.WithLabelValues(route, "error").Inc()
+		return
+	}
+	for _, name := range mirroredHeaders {
+		if v := r.Header.Get(name); v != "" {
+			legacyReq.Header.Set(name, v)
+		}
+	}
+	legacyReq.Header.Set("X-Shadow-Request", "1")
+
+	resp, err := s.client.Do(legacyReq)
+	if err != nil {
+		shadowRequests.WithLabelValues(route, "error").Inc()
+		log.Printf("Shadow request %s failed: %v", route, err)
+		return
+	}
+	defer resp.Body.Close()
+	legacyBody, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize+1))
+	if err != nil || len(legacyBody) > maxBodySize || overflow {
+		shadowRequests.WithLabelValues(route, "error").Inc()
+		return
+	}
+
+	var diffs []Difference
+	if resp.StatusCode != gatewayStatus {
+		diffs = append(diffs, Difference{Path: "status", Legacy: resp.StatusCode, Gateway: gatewayStatus})
+	}
+	diffs = append(diffs, DiffJSON(legacyBody, gatewayBody, s.cfg.Ignore)...)
+	if len(diffs) == 0 {
+		shadowRequests.WithLabelValues(route, "match").Inc()
+		return
+	}
+
+	shadowRequests.WithLabelValues(route, "mismatch").Inc()
+	s.record(Mismatch{
+		Time:          time.Now(),
+		Method:        r.Method,
+		Path:          r.URL.RequestURI(),
+		Route:         route,
+		LegacyStatus:  resp.StatusCode,
+		GatewayStatus: gatewayStatus,
+		Differences:   diffs,
+	})
+}
+
+func (s *Shadow) record(m Mismatch) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.samples) < s.cfg.MaxSamples {
+		s.samples = append(s.samples, m)
+		return
+	}
+	s.samples[s.next] = m
+	s.next = (s.next + 1) % s.cfg.MaxSamples
+}
+
+// Mismatches returns the kept mismatches, newest first
+func (s *Shadow) Mismatches() []Mismatch {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Mismatch, 0, len(s.samples))
+	for i := 0; i < len(s.samples); i++ {
+		idx := (s.next - 1 - i + 2*len(s.samples)) % len(s.samples)
+		out = append(out, s.samples[idx])
+	}
+	return out
+}
+
+// MismatchesHandler serves the kept mismatches as JSON. The samples contain
+// user data, so only mount it on an internal listener like /metrics.
+func (s *Shadow) MismatchesHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"enabled":    s.cfg.Enabled(),
+			"percent":    s.cfg.Percent,
+			"mismatches": s.Mismatches(),
+		})
+	})
+}
+
+// bodyRecorder passes the response through and keeps a copy of its body
+type bodyRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+	overflow    bool
+}
+
+func (r *bodyRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *bodyRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	if !r.overflow {
+		if r.body.Len()+len(b) > maxBodySize {
+			r.overflow = true
+			r.body.Reset()
+		} else {
+			r.body.Write(b)
+		}
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+// Flush lets streaming handlers flush through the recorder
+func (r *bodyRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController
+func (r *bodyRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// apiKeys are the keys accepted on protected routes, from HEALTH_API_KEYS, a
+// comma separated list so keys can be rotated without downtime
+var apiKeys = loadAPIKeys()
+
+func loadAPIKeys() [][sha256.Size]byte {
+	var keys [][sha256.Size]byte
+	for _, key := range strings.Split(os.Getenv("HEALTH_API_KEYS"), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, sha256.Sum256([]byte(key)))
+		}
+	}
+	return keys
+}
+
+// authRequired reports whether protected routes need a key. Without
+// HEALTH_API_KEYS everything stays open, as before keys existed.
+func authRequired() bool {
+	return len(apiKeys) > 0
+}
+
+// authorized reports whether r carries one of apiKeys, either as a bearer
+// token or in X-API-Key
+func authorized(r *http.Request) bool {
+	if !authRequired() {
+		return true
+	}
+
+	key := r.Header.Get("X-API-Key")
+	if auth := r.Header.Get("Authorization"); key == "" && len(auth) > 7 && strings.EqualFold(auth[:7], "Bearer ") {
+		key = strings.TrimSpace(auth[7:])
+	}
+	if key == "" {
+		return false
+	}
+
+	// Hashing first keeps the comparison constant time whatever the length
+	sum := sha256.Sum256([]byte(key))
+	match := 0
+	for _, allowed := range apiKeys {
+		match |= subtle.ConstantTimeCompare(sum[:], allowed[:])
+	}
+	return match == 1
+}
+
+// requireAPIKey answers 401 to requests without a valid key. Wrap every route
+// that changes state or reveals more than the public health summary with it.
+func requireAPIKey(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(r) {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="health-check-service"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next(w, r)
+	}
+}
@@ -0,0 +1,271 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"metargb/shared/pkg/jobs"
+)
+
+// Backup jobs record every run in the backup_runs table. The probe reads the
+// latest successful run of each target, so a job that stopped running or
+// keeps failing shows up as stale backups instead of staying silent.
+const (
+	backupStatusSuccess = "success"
+	backupStatusFailed  = "failed"
+
+	defaultBackupTargets       = "mysql"
+	defaultBackupMaxAge        = 26 * time.Hour
+	defaultBackupCheckInterval = time.Minute
+	backupQueryTimeout         = 5 * time.Second
+)
+
+// BackupStatus represents the freshness of the backups of every target
+type BackupStatus struct {
+	Status        string               `json:"status"`
+	MaxAgeSeconds float64              `json:"max_age_seconds"`
+	Targets       []BackupTargetStatus `json:"targets"`
+	LastChecked   string               `json:"last_checked,omitempty"`
+	Error         string               `json:"error,omitempty"`
+}
+
+// BackupTargetStatus represents the latest backup runs of one target
+type BackupTargetStatus struct {
+	Target      string  `json:"target"`
+	Status      string  `json:"status"`
+	LastSuccess string  `json:"last_success,omitempty"`
+	AgeSeconds  float64 `json:"age_seconds,omitempty"`
+	LastFailure string  `json:"last_failure,omitempty"`
+	LastError   string  `json:"last_error,omitempty"`
+
+	lastSuccessAt time.Time
+	lastFailureAt time.Time
+}
+
+var (
+	lastBackupStatus = BackupStatus{Status: "unknown", Targets: []BackupTargetStatus{}}
+	backupMu         sync.RWMutex
+)
+
+// registerBackupCheck checks the backup runs every BACKUP_CHECK_INTERVAL
+// under runner. Targets listed in BACKUP_TARGETS are reported stale even
+// before their first run.
+func registerBackupCheck(runner *jobs.Runner) {
+	maxAge := getEnvDuration("BACKUP_MAX_AGE", defaultBackupMaxAge)
+	if dbConnection == nil {
+		setBackupStatus(BackupStatus{Status: "unhealthy", MaxAgeSeconds: maxAge.Seconds(), Targets: []BackupTargetStatus{}, Error: "Database connection not initialized"})
+		return
+	}
+
+	interval := getEnvDuration("BACKUP_CHECK_INTERVAL", defaultBackupCheckInterval)
+	targets := parseBackupTargets(getEnv("BACKUP_TARGETS", defaultBackupTargets))
+
+	runner.AddImmediate("backup-check", interval, func(context.Context) error {
+		status := probeBackups(dbConnection, targets, maxAge)
+		if status.Error != "" {
+			log.Printf("⚠️  Backup check failed: %s", status.Error)
+		}
+		for _, target := range status.Targets {
+			if target.Status != "healthy" {
+				log.Printf("⚠️  Backups of %s are stale (last success: %s)", target.Target, valueOrNever(target.LastSuccess))
+			}
+		}
+		setBackupStatus(status)
+		return nil
+	})
+}
+
+// probeBackups reads the latest runs of every target. When the table cannot
+// be read, the targets of the previous check are kept so their timestamps
+// stay exported and stale backups keep alerting.
+func probeBackups(db *sql.DB, expected []string, maxAge time.Duration) BackupStatus {
+	now := time.Now()
+	status := BackupStatus{
+		Status:        "healthy",
+		MaxAgeSeconds: maxAge.Seconds(),
+		LastChecked:   now.UTC().Format(time.RFC3339),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), backupQueryTimeout)
+	defer cancel()
+
+	targets, err := queryBackupRuns(ctx, db)
+	if err != nil {
+		status.Status = "unhealthy"
+		status.Error = fmt.Sprintf("failed to read backup runs: %v", err)
+		status.Targets = getBackupStatus().Targets
+		return status
+	}
+
+	for _, name := range expected {
+		if _, ok := targets[name]; !ok {
+			targets[name] = &BackupTargetStatus{Target: name}
+		}
+	}
+
+	status.Targets = make([]BackupTargetStatus, 0, len(targets))
+	for _, target := range targets {
+		evaluateBackupTarget(target, now, maxAge)
+		if target.Status != "healthy" {
+			status.Status = "unhealthy"
+		}
+		status.Targets = append(status.Targets, *target)
+	}
+	sort.Slice(status.Targets, func(i, j int) bool {
+		return status.Targets[i].Target < status.Targets[j].Target
+	})
+	return status
+}
+
+// queryBackupRuns returns the latest successful and failed run of every
+// target, and the error of the failure when it came after the last success
+func queryBackupRuns(ctx context.Context, db *sql.DB) (map[string]*BackupTargetStatus, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT target,
+			MAX(CASE WHEN status = ? THEN finished_at END),
+			MAX(CASE WHEN status = ? THEN finished_at END)
+		FROM backup_runs
+		GROUP BY target`, backupStatusSuccess, backupStatusFailed)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	targets := make(map[string]*BackupTargetStatus)
+	for rows.Next() {
+		var target string
+		var lastSuccess, lastFailure sql.NullTime
+		if err := rows.Scan(&target, &lastSuccess, &lastFailure); err != nil {
+			return nil, err
+		}
+		targets[target] = &BackupTargetStatus{
+			Target:        target,
+			lastSuccessAt: lastSuccess.Time,
+			lastFailureAt: lastFailure.Time,
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, target := range targets {
+		if target.lastFailureAt.IsZero() || target.lastFailureAt.Before(target.lastSuccessAt) {
+			continue
+		}
+		var lastError sql.NullString
+		err := db.QueryRowContext(ctx, `
+			SELECT error FROM backup_runs
+			WHERE target = ? AND status = ?
+			ORDER BY finished_at DESC, id DESC
+			LIMIT 1`, target.Target, backupStatusFailed).Scan(&lastError)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+		target.LastError = lastError.String
+	}
+
+	return targets, nil
+}
+
+// evaluateBackupTarget marks a target stale when it never succeeded or its
+// last success is older than maxAge
+func evaluateBackupTarget(target *BackupTargetStatus, now time.Time, maxAge time.Duration) {
+	target.Status = "stale"
+	if !target.lastFailureAt.IsZero() {
+		target.LastFailure = target.lastFailureAt.UTC().Format(time.RFC3339)
+	}
+	if target.lastSuccessAt.IsZero() {
+		return
+	}
+
+	age := now.Sub(target.lastSuccessAt)
+	target.LastSuccess = target.lastSuccessAt.UTC().Format(time.RFC3339)
+	target.AgeSeconds = age.Seconds()
+	if age <= maxAge {
+		target.Status = "healthy"
+	}
+}
+
+// exportBackupMetrics writes the backup freshness metrics. Ages are computed
+// at scrape time so they keep growing between checks.
+func exportBackupMetrics(w http.ResponseWriter) {
+	backups := getBackupStatus()
+	now := time.Now()
+
+	checkValue := 1
+	if backups.Error != "" || backups.Status == "unknown" {
+		checkValue = 0
+	}
+	fmt.Fprintf(w, "\n# HELP backup_check_status Whether the latest read of the backup runs succeeded (1=succeeded, 0=failed)\n")
+	fmt.Fprintf(w, "# TYPE backup_check_status gauge\n")
+	fmt.Fprintf(w, "backup_check_status %d\n", checkValue)
+
+	fmt.Fprintf(w, "\n# HELP backup_max_age_seconds Age after which backups count as stale\n")
+	fmt.Fprintf(w, "# TYPE backup_max_age_seconds gauge\n")
+	fmt.Fprintf(w, "backup_max_age_seconds %.0f\n", backups.MaxAgeSeconds)
+
+	fmt.Fprintf(w, "\n# HELP backup_last_success_timestamp Unix time of the latest successful backup, 0 if none was recorded\n")
+	fmt.Fprintf(w, "# TYPE backup_last_success_timestamp gauge\n")
+	for _, target := range backups.Targets {
+		fmt.Fprintf(w, "backup_last_success_timestamp{target=\"%s\"} %d\n", target.Target, unixOrZero(target.lastSuccessAt))
+	}
+
+	fmt.Fprintf(w, "\n# HELP backup_last_failure_timestamp Unix time of the latest failed backup, 0 if none was recorded\n")
+	fmt.Fprintf(w, "# TYPE backup_last_failure_timestamp gauge\n")
+	for _, target := range backups.Targets {
+		fmt.Fprintf(w, "backup_last_failure_timestamp{target=\"%s\"} %d\n", target.Target, unixOrZero(target.lastFailureAt))
+	}
+
+	fmt.Fprintf(w, "\n# HELP backup_status Backup freshness (1=fresh, 0=stale or never succeeded)\n")
+	fmt.Fprintf(w, "# TYPE backup_status gauge\n")
+	for _, target := range backups.Targets {
+		value := 0
+		if !target.lastSuccessAt.IsZero() && now.Sub(target.lastSuccessAt).Seconds() <= backups.MaxAgeSeconds {
+			value = 1
+		}
+		fmt.Fprintf(w, "backup_status{target=\"%s\"} %d\n", target.Target, value)
+	}
+}
+
+func parseBackupTargets(value string) []string {
+	var targets []string
+	for _, target := range strings.Split(value, ",") {
+		if target = strings.TrimSpace(target); target != "" {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func valueOrNever(value string) string {
+	if value == "" {
+		return "never"
+	}
+	return value
+}
+
+func setBackupStatus(status BackupStatus) {
+	backupMu.Lock()
+	defer backupMu.Unlock()
+	lastBackupStatus = status
+}
+
+func getBackupStatus() BackupStatus {
+	backupMu.RLock()
+	defer backupMu.RUnlock()
+	return lastBackupStatus
+}
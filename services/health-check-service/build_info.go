@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Set with -ldflags -X at build time, as shared/pkg/buildinfo is for the
+// other services
+var (
+	version   = "dev"
+	commit    = "unknown"
+	buildDate = "unknown"
+)
+
+// buildInfoServices are the services whose build is read from /version on
+// their health probe port
+var buildInfoServices = []string{
+	"auth-service",
+	"commercial-service",
+	"features-service",
+	"levels-service",
+	"dynasty-service",
+	"support-service",
+	"notifications-service",
+	"calendar-service",
+	"storage-service",
+	"websocket-gateway",
+}
+
+// ServiceBuild is the body of a service's /version endpoint
+type ServiceBuild struct {
+	Service   string `json:"service"`
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"build_date"`
+	GoVersion string `json:"go_version"`
+}
+
+func ownBuild() ServiceBuild {
+	return ServiceBuild{
+		Service:   "health-check-service",
+		Version:   version,
+		Commit:    commit,
+		BuildDate: buildDate,
+		GoVersion: runtime.Version(),
+	}
+}
+
+// versionHandler serves this service's build
+func versionHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(ownBuild())
+}
+
+// fetchBuilds reads the build of every service that answers /version
+func fetchBuilds(ctx context.Context) []ServiceBuild {
+	client := &http.Client{Timeout: 2 * time.Second}
+	port := getEnv("SERVICE_HEALTH_PORT", "8086")
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	builds := []ServiceBuild{ownBuild()}
+	for _, service := range buildInfoServices {
+		wg.Add(1)
+		go func(service string) {
+			defer wg.Done()
+			req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("http://%s:%s/version", service, port), nil)
+			if err != nil {
+				return
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				return
+			}
+			defer resp.Body.Close()
+
+			var build ServiceBuild
+			if resp.StatusCode != http.StatusOK || json.NewDecoder(resp.Body).Decode(&build) != nil {
+				return
+			}
+			build.Service = service
+			mu.Lock()
+			builds = append(builds, build)
+			mu.Unlock()
+		}(service)
+	}
+	wg.Wait()
+
+	sort.Slice(builds, func(i, j int) bool { return builds[i].Service < builds[j].Service })
+	return builds
+}
+
+// exportBuildInfoMetrics writes metargb_build_info, the metric services with
+// their own metrics endpoint export, for every service. Services that did not
+// answer are left out.
+func exportBuildInfoMetrics(ctx context.Context, w http.ResponseWriter) {
+	fmt.Fprintf(w, "\n# HELP metargb_build_info Build of the running service; always 1\n")
+	fmt.Fprintf(w, "# TYPE metargb_build_info gauge\n")
+	for _, build := range fetchBuilds(ctx) {
+		fmt.Fprintf(w, "metargb_build_info{service=%q,version=%q,commit=%q,build_date=%q,go_version=%q} 1\n",
+			build.Service, build.Version, build.Commit, build.BuildDate, build.GoVersion)
+	}
+}
@@ -15,7 +15,12 @@ import (
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
 	"github.com/redis/go-redis/v9"
+
+	"metargb/shared/pkg/jobs"
+	"metargb/shared/pkg/logger"
 )
 
 // ServiceStatus represents the health status of a service
@@ -33,6 +38,8 @@ type ServiceStatus struct {
 type DependencyHealth struct {
 	DatabaseConnections  map[string]DBConnectionStatus `json:"database_connections"` // Map of service name to DB connection status
 	CacheMetrics         CacheMetrics                  `json:"cache_metrics"`
+	PubSub               PubSubStatus                  `json:"pubsub"`
+	Backups              BackupStatus                  `json:"backups"`
 	ExternalAPIs         []ExternalAPIStatus           `json:"external_apis"`
 	ThirdPartyServices   []ThirdPartyService           `json:"third_party_services"`
 	CircuitBreakerStatus map[string]string             `json:"circuit_breaker_status,omitempty"`
@@ -120,6 +127,39 @@ type HealthResponse struct {
 	ServiceAvailability map[string]ServiceAvailabilityInfo `json:"service_availability"`
 }
 
+// PublicHealthResponse is the health report for callers without an API key:
+// the overall status and each service's status, without hosts, ports, errors
+// or dependencies
+type PublicHealthResponse struct {
+	Status    string                `json:"status"`
+	Timestamp string                `json:"timestamp"`
+	Services  []PublicServiceStatus `json:"services"`
+	Summary   struct {
+		Total     int `json:"total"`
+		Healthy   int `json:"healthy"`
+		Unhealthy int `json:"unhealthy"`
+	} `json:"summary"`
+}
+
+// PublicServiceStatus is a service in PublicHealthResponse
+type PublicServiceStatus struct {
+	Service string `json:"service"`
+	Status  string `json:"status"`
+}
+
+func publicHealth(response HealthResponse) PublicHealthResponse {
+	public := PublicHealthResponse{
+		Status:    response.Status,
+		Timestamp: response.Timestamp,
+		Services:  make([]PublicServiceStatus, 0, len(response.Services)),
+		Summary:   response.Summary,
+	}
+	for _, s := range response.Services {
+		public.Services = append(public.Services, PublicServiceStatus{Service: s.Service, Status: s.Status})
+	}
+	return public
+}
+
 // ServiceAvailabilityInfo provides detailed availability metrics
 type ServiceAvailabilityInfo struct {
 	UptimePercentage  float64           `json:"uptime_percentage"`
@@ -143,20 +183,21 @@ var (
 
 // Map service display names to Prometheus service labels
 var serviceNameMap = map[string]string{
-	"MySQL":                  "mysql",
-	"Redis":                  "redis",
-	"Auth Service":           "auth-service",
-	"Commercial Service":     "commercial-service",
-	"Features Service":       "features-service",
-	"Levels Service":         "levels-service",
-	"Dynasty Service":        "dynasty-service",
-	"Calendar Service":       "calendar-service",
-	"Storage Service (gRPC)": "storage-service",
-	"Kong API Gateway":       "kong",
-	"Kong Admin API":         "kong",
-	"WebSocket Gateway":      "websocket-gateway",
-	"Storage Service (HTTP)": "storage-service",
-	"gRPC Gateway":           "grpc-gateway",
+	"MySQL":                       "mysql",
+	"Redis":                       "redis",
+	"Auth Service":                "auth-service",
+	"Commercial Service":          "commercial-service",
+	"Features Service":            "features-service",
+	"Levels Service":              "levels-service",
+	"Dynasty Service":             "dynasty-service",
+	"Calendar Service":            "calendar-service",
+	"Storage Service (gRPC)":      "storage-service",
+	"Kong API Gateway":            "kong",
+	"Kong Admin API":              "kong",
+	"WebSocket Gateway":           "websocket-gateway",
+	"WebSocket Gateway (Pub/Sub)": "websocket-gateway",
+	"Storage Service (HTTP)":      "storage-service",
+	"gRPC Gateway":                "grpc-gateway",
 }
 
 // Map service labels to their running ports
@@ -187,12 +228,34 @@ func main() {
 	// Initialize database connections for each service
 	initServiceDBConnections()
 
-	// Start background goroutine to track uptime
-	go trackUptime()
+	// Background checks are timed, counted and listed on jobs.Path
+	backgroundJobs := jobs.NewRunner("health-check-service", logger.NewLogger("health-check-service"))
+
+	// Track uptime from the latest health checks
+	backgroundJobs.Add("uptime", uptimeInterval, func(context.Context) error {
+		recordUptime()
+		return nil
+	})
 
+	// Verify the WebSocket gateway still receives Redis pub/sub messages
+	registerPubSubCanary(backgroundJobs)
+
+	// Verify backup jobs keep recording successful runs
+	registerBackupCheck(backgroundJobs)
+
+	backgroundJobs.Start(context.Background())
+
+	// /health answers everyone, but only callers with an API key get the full
+	// report. Every other route that is not plain liveness needs a key.
 	http.HandleFunc("/health", healthCheckHandler)
 	http.HandleFunc("/api/health", healthCheckHandler)
-	http.HandleFunc("/metrics", metricsHandler)
+	http.HandleFunc("/metrics", requireAPIKey(metricsHandler))
+	http.HandleFunc(jobs.Path, requireAPIKey(jobs.Handler().ServeHTTP))
+	http.HandleFunc("/version", versionHandler)
+
+	if !authRequired() {
+		log.Printf("⚠️  HEALTH_API_KEYS is not set - the full health report and metrics are served without authentication")
+	}
 
 	port := "8090"
 	log.Printf("🏥 Health Check Service starting on port %s", port)
@@ -299,62 +362,62 @@ func initServiceDBConnections() {
 	}
 }
 
-func trackUptime() {
-	ticker := time.NewTicker(15 * time.Second)
-	defer ticker.Stop()
+// uptimeInterval is how often recordUptime runs
+const uptimeInterval = 15 * time.Second
 
-	for range ticker.C {
-		uptimeMu.Lock()
-		now := time.Now()
+// recordUptime adds the time since the previous run to the uptime or
+// downtime of every tracked service, by the status of its latest health check
+func recordUptime() {
+	uptimeMu.Lock()
+	now := time.Now()
 
-		for serviceName, uptime := range serviceUptimes {
-			// Check if service status changed
-			status, exists := lastHealthCheck[serviceName]
-			currentStatus := "unhealthy"
-			if exists && status.Status == "healthy" {
-				currentStatus = "healthy"
-			}
+	for serviceName, uptime := range serviceUptimes {
+		// Check if service status changed
+		status, exists := lastHealthCheck[serviceName]
+		currentStatus := "unhealthy"
+		if exists && status.Status == "healthy" {
+			currentStatus = "healthy"
+		}
 
-			uptime.mu.Lock()
-			// Track status changes
-			if uptime.LastStatus != currentStatus {
-				if currentStatus == "unhealthy" && uptime.LastStatus == "healthy" {
-					// Service went down
-					uptime.DowntimeIncidents = append(uptime.DowntimeIncidents, DowntimeIncident{
-						StartTime: now,
-						Resolved:  false,
-					})
-				} else if currentStatus == "healthy" && uptime.LastStatus == "unhealthy" {
-					// Service came back up
-					if len(uptime.DowntimeIncidents) > 0 {
-						lastIncident := &uptime.DowntimeIncidents[len(uptime.DowntimeIncidents)-1]
-						if !lastIncident.Resolved {
-							lastIncident.EndTime = now
-							lastIncident.Duration = now.Sub(lastIncident.StartTime)
-							lastIncident.Resolved = true
-							uptime.TotalDowntime += lastIncident.Duration
-						}
+		uptime.mu.Lock()
+		// Track status changes
+		if uptime.LastStatus != currentStatus {
+			if currentStatus == "unhealthy" && uptime.LastStatus == "healthy" {
+				// Service went down
+				uptime.DowntimeIncidents = append(uptime.DowntimeIncidents, DowntimeIncident{
+					StartTime: now,
+					Resolved:  false,
+				})
+			} else if currentStatus == "healthy" && uptime.LastStatus == "unhealthy" {
+				// Service came back up
+				if len(uptime.DowntimeIncidents) > 0 {
+					lastIncident := &uptime.DowntimeIncidents[len(uptime.DowntimeIncidents)-1]
+					if !lastIncident.Resolved {
+						lastIncident.EndTime = now
+						lastIncident.Duration = now.Sub(lastIncident.StartTime)
+						lastIncident.Resolved = true
+						uptime.TotalDowntime += lastIncident.Duration
 					}
 				}
-				uptime.LastStatus = currentStatus
 			}
+			uptime.LastStatus = currentStatus
+		}
 
-			// Update uptime/downtime
-			if currentStatus == "healthy" {
-				if !uptime.LastSeen.IsZero() {
-					uptime.TotalUptime += now.Sub(uptime.LastSeen)
-				}
-				uptime.LastSeen = now
-			} else {
-				if !uptime.LastSeen.IsZero() {
-					uptime.TotalDowntime += now.Sub(uptime.LastSeen)
-				}
+		// Update uptime/downtime
+		if currentStatus == "healthy" {
+			if !uptime.LastSeen.IsZero() {
+				uptime.TotalUptime += now.Sub(uptime.LastSeen)
+			}
+			uptime.LastSeen = now
+		} else {
+			if !uptime.LastSeen.IsZero() {
+				uptime.TotalDowntime += now.Sub(uptime.LastSeen)
 			}
-
-			uptime.mu.Unlock()
 		}
-		uptimeMu.Unlock()
+
+		uptime.mu.Unlock()
 	}
+	uptimeMu.Unlock()
 }
 
 func getOrCreateUptimeTracker(serviceName string) *ServiceUptime {
@@ -378,7 +441,7 @@ func getOrCreateUptimeTracker(serviceName string) *ServiceUptime {
 
 func healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Access-Control-Allow-Origin", "*")
+	allowOrigin(w, r)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -389,16 +452,17 @@ func healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 	services = append(services, checkTCP(ctx, "MySQL", "mysql", 3306))
 	services = append(services, checkTCP(ctx, "Redis", "redis", 6379))
 
-	// Core Microservices (gRPC)
-	services = append(services, checkTCP(ctx, "Auth Service", "auth-service", 50051))
-	services = append(services, checkTCP(ctx, "Commercial Service", "commercial-service", 50052))
-	services = append(services, checkTCP(ctx, "Features Service", "features-service", 50053))
-	services = append(services, checkTCP(ctx, "Levels Service", "levels-service", 50054))
-	services = append(services, checkTCP(ctx, "Dynasty Service", "dynasty-service", 50055))
-	services = append(services, checkTCP(ctx, "Support Service", "support-service", 50056))
-	services = append(services, checkTCP(ctx, "Notifications Service", "notifications-service", 50058))
-	services = append(services, checkTCP(ctx, "Calendar Service", "calendar-service", 50059))
-	services = append(services, checkTCP(ctx, "Storage Service (gRPC)", "storage-service", 50060))
+	// Core Microservices, through their readiness probes so services still
+	// warming up or shutting down count as unhealthy
+	services = append(services, checkReadiness(ctx, "Auth Service", "auth-service"))
+	services = append(services, checkReadiness(ctx, "Commercial Service", "commercial-service"))
+	services = append(services, checkReadiness(ctx, "Features Service", "features-service"))
+	services = append(services, checkReadiness(ctx, "Levels Service", "levels-service"))
+	services = append(services, checkReadiness(ctx, "Dynasty Service", "dynasty-service"))
+	services = append(services, checkReadiness(ctx, "Support Service", "support-service"))
+	services = append(services, checkReadiness(ctx, "Notifications Service", "notifications-service"))
+	services = append(services, checkReadiness(ctx, "Calendar Service", "calendar-service"))
+	services = append(services, checkReadiness(ctx, "Storage Service (gRPC)", "storage-service"))
 
 	// Gateway Services (HTTP)
 	services = append(services, checkHTTP(ctx, "Kong API Gateway", "http://kong:8001/status"))
@@ -406,6 +470,9 @@ func healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 	services = append(services, checkHTTP(ctx, "WebSocket Gateway", "http://websocket-gateway:3000/health"))
 	services = append(services, checkHTTP(ctx, "Storage Service (HTTP)", "http://storage-service:8059/health"))
 
+	// A stuck subscriber keeps its port open, so report the latest canary roundtrip
+	services = append(services, pubsubServiceStatus())
+
 	// Update uptime trackers
 	for _, s := range services {
 		uptime := getOrCreateUptimeTracker(s.Service)
@@ -465,7 +532,11 @@ func healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(response)
+	if authorized(r) {
+		json.NewEncoder(w).Encode(response)
+	} else {
+		json.NewEncoder(w).Encode(publicHealth(response))
+	}
 
 	// Store results for metrics endpoint
 	for _, s := range services {
@@ -509,6 +580,12 @@ func checkDependencies(ctx context.Context) DependencyHealth {
 	// Check cache metrics
 	deps.CacheMetrics = checkCacheMetrics(ctx)
 
+	// Latest pub/sub canary roundtrip
+	deps.PubSub = getPubSubStatus()
+
+	// Latest backup freshness check
+	deps.Backups = getBackupStatus()
+
 	// Check external APIs (e.g., Parsian payment gateway)
 	deps.ExternalAPIs = checkExternalAPIs(ctx)
 
@@ -807,19 +884,20 @@ func metricsHandler(w http.ResponseWriter, r *http.Request) {
 	services := []ServiceStatus{}
 	services = append(services, checkTCP(ctx, "MySQL", "mysql", 3306))
 	services = append(services, checkTCP(ctx, "Redis", "redis", 6379))
-	services = append(services, checkTCP(ctx, "Auth Service", "auth-service", 50051))
-	services = append(services, checkTCP(ctx, "Commercial Service", "commercial-service", 50052))
-	services = append(services, checkTCP(ctx, "Features Service", "features-service", 50053))
-	services = append(services, checkTCP(ctx, "Levels Service", "levels-service", 50054))
-	services = append(services, checkTCP(ctx, "Dynasty Service", "dynasty-service", 50055))
-	services = append(services, checkTCP(ctx, "Support Service", "support-service", 50056))
-	services = append(services, checkTCP(ctx, "Notifications Service", "notifications-service", 50058))
-	services = append(services, checkTCP(ctx, "Calendar Service", "calendar-service", 50059))
-	services = append(services, checkTCP(ctx, "Storage Service (gRPC)", "storage-service", 50060))
+	services = append(services, checkReadiness(ctx, "Auth Service", "auth-service"))
+	services = append(services, checkReadiness(ctx, "Commercial Service", "commercial-service"))
+	services = append(services, checkReadiness(ctx, "Features Service", "features-service"))
+	services = append(services, checkReadiness(ctx, "Levels Service", "levels-service"))
+	services = append(services, checkReadiness(ctx, "Dynasty Service", "dynasty-service"))
+	services = append(services, checkReadiness(ctx, "Support Service", "support-service"))
+	services = append(services, checkReadiness(ctx, "Notifications Service", "notifications-service"))
+	services = append(services, checkReadiness(ctx, "Calendar Service", "calendar-service"))
+	services = append(services, checkReadiness(ctx, "Storage Service (gRPC)", "storage-service"))
 	services = append(services, checkHTTP(ctx, "Kong API Gateway", "http://kong:8001/status"))
 	services = append(services, checkHTTP(ctx, "WebSocket Gateway", "http://websocket-gateway:3000/health"))
 	services = append(services, checkHTTP(ctx, "Storage Service (HTTP)", "http://storage-service:8059/health"))
 	services = append(services, checkHTTP(ctx, "gRPC Gateway", "http://grpc-gateway:8080/health"))
+	services = append(services, pubsubServiceStatus())
 
 	// Update lastHealthCheck with fresh data
 	for _, s := range services {
@@ -877,6 +955,26 @@ func metricsHandler(w http.ResponseWriter, r *http.Request) {
 
 	// Export dependency health metrics
 	exportDependencyHealthMetrics(w)
+
+	// Export the build every service runs
+	exportBuildInfoMetrics(ctx, w)
+
+	// Export the runs of the background checks
+	exportJobMetrics(w)
+}
+
+// exportJobMetrics writes the metargb_job_* metrics of the background checks,
+// which the jobs runner keeps in the default Prometheus registry
+func exportJobMetrics(w http.ResponseWriter) {
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		log.Printf("⚠️  Warning: Failed to gather job metrics: %v", err)
+	}
+	for _, family := range families {
+		if strings.HasPrefix(family.GetName(), "metargb_job_") {
+			expfmt.MetricFamilyToText(w, family)
+		}
+	}
 }
 
 func exportServiceHealthMetrics(w http.ResponseWriter) {
@@ -1143,6 +1241,27 @@ func exportDependencyHealthMetrics(w http.ResponseWriter) {
 	fmt.Fprintf(w, "# TYPE cache_memory_usage_bytes gauge\n")
 	fmt.Fprintf(w, "cache_memory_usage_bytes{cache=\"redis\"} %d\n", cacheMetrics.MemoryUsage)
 
+	// Pub/sub canary metrics
+	pubsub := getPubSubStatus()
+	pubsubValue := 0
+	if pubsub.Status == "healthy" {
+		pubsubValue = 1
+	}
+	fmt.Fprintf(w, "\n# HELP pubsub_status Pub/sub canary roundtrip status (1=healthy, 0=unhealthy)\n")
+	fmt.Fprintf(w, "# TYPE pubsub_status gauge\n")
+	fmt.Fprintf(w, "pubsub_status{channel=\"%s\"} %d\n", pubsub.Channel, pubsubValue)
+
+	fmt.Fprintf(w, "\n# HELP pubsub_roundtrip_seconds Seconds from publishing the latest canary to receiving its echo, or until it timed out\n")
+	fmt.Fprintf(w, "# TYPE pubsub_roundtrip_seconds gauge\n")
+	fmt.Fprintf(w, "pubsub_roundtrip_seconds{channel=\"%s\"} %.6f\n", pubsub.Channel, pubsub.RoundtripSeconds)
+
+	fmt.Fprintf(w, "\n# HELP pubsub_subscribers Subscribers that received the latest canary\n")
+	fmt.Fprintf(w, "# TYPE pubsub_subscribers gauge\n")
+	fmt.Fprintf(w, "pubsub_subscribers{channel=\"%s\"} %d\n", pubsub.Channel, pubsub.Subscribers)
+
+	// Backup freshness metrics
+	exportBackupMetrics(w)
+
 	// External API metrics
 	fmt.Fprintf(w, "\n# HELP external_api_status External API status (1=healthy, 0=unhealthy)\n")
 	fmt.Fprintf(w, "# TYPE external_api_status gauge\n")
@@ -1201,6 +1320,55 @@ func checkTCP(ctx context.Context, name, host string, port int) ServiceStatus {
 	}
 }
 
+// readinessReport is the body of a service's readiness probe, see
+// shared/pkg/health
+type readinessReport struct {
+	Status  string            `json:"status"`
+	Checks  map[string]string `json:"checks"`
+	Pending []string          `json:"pending"`
+}
+
+// checkReadiness queries the readiness probe every gRPC service serves on
+// SERVICE_HEALTH_PORT and reports why an unready service is not ready
+func checkReadiness(ctx context.Context, name, host string) ServiceStatus {
+	url := fmt.Sprintf("http://%s:%s/readyz", host, getEnv("SERVICE_HEALTH_PORT", "8086"))
+	start := time.Now()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return ServiceStatus{Service: name, Status: "unhealthy", URL: url, Error: err.Error()}
+	}
+
+	client := &http.Client{Timeout: 2 * time.Second}
+	resp, err := client.Do(req)
+	latency := time.Since(start)
+	if err != nil {
+		return ServiceStatus{Service: name, Status: "unhealthy", URL: url, Error: err.Error(), Latency: latency.String()}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return ServiceStatus{Service: name, Status: "healthy", URL: url, Latency: latency.String()}
+	}
+
+	reason := resp.Status
+	var report readinessReport
+	if err := json.NewDecoder(resp.Body).Decode(&report); err == nil && report.Status != "" {
+		reasons := []string{report.Status}
+		for check, result := range report.Checks {
+			if result != "ok" {
+				reasons = append(reasons, check+": "+result)
+			}
+		}
+		if len(report.Pending) > 0 {
+			reasons = append(reasons, "pending: "+strings.Join(report.Pending, ", "))
+		}
+		reason = strings.Join(reasons, "; ")
+	}
+
+	return ServiceStatus{Service: name, Status: "unhealthy", URL: url, Error: reason, Latency: latency.String()}
+}
+
 func checkHTTP(ctx context.Context, name, url string) ServiceStatus {
 	start := time.Now()
 
@@ -1242,6 +1410,23 @@ func checkHTTP(ctx context.Context, name, url string) ServiceStatus {
 	}
 }
 
+// allowOrigin lets the origins in CORS_ALLOWED_ORIGINS, a comma separated
+// list, read the health report from a browser. Other origins get no CORS
+// headers.
+func allowOrigin(w http.ResponseWriter, r *http.Request) {
+	w.Header().Add("Vary", "Origin")
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return
+	}
+	for _, allowed := range strings.Split(os.Getenv("CORS_ALLOWED_ORIGINS"), ",") {
+		if strings.TrimSpace(allowed) == origin {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			return
+		}
+	}
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"metargb/shared/pkg/jobs"
+)
+
+// The canary is published on pubsubCanaryChannel. The WebSocket gateway
+// subscribes to it next to its broadcast channels and echoes every canary on
+// pubsubAckChannel, so a roundtrip proves its subscriber connection still
+// receives messages - an open port does not.
+const (
+	pubsubCanaryChannel = "health-canary"
+	pubsubAckChannel    = "health-canary-ack"
+
+	defaultPubSubCanaryInterval = 15 * time.Second
+	defaultPubSubCanaryTimeout  = 5 * time.Second
+)
+
+// PubSubStatus represents the result of the latest canary roundtrip
+type PubSubStatus struct {
+	Status           string  `json:"status"`
+	Channel          string  `json:"channel"`
+	Subscribers      int64   `json:"subscribers"`
+	RoundtripSeconds float64 `json:"roundtrip_seconds"`
+	LastChecked      string  `json:"last_checked,omitempty"`
+	Error            string  `json:"error,omitempty"`
+}
+
+type pubsubCanary struct {
+	ID     string `json:"id"`
+	SentAt int64  `json:"sent_at"` // Unix milliseconds
+}
+
+var (
+	lastPubSubStatus = PubSubStatus{Status: "unknown", Channel: pubsubCanaryChannel}
+	pubsubMu         sync.RWMutex
+)
+
+// registerPubSubCanary publishes a canary every PUBSUB_CANARY_INTERVAL under
+// runner and waits up to PUBSUB_CANARY_TIMEOUT for the echo
+func registerPubSubCanary(runner *jobs.Runner) {
+	if redisClient == nil {
+		setPubSubStatus(PubSubStatus{Status: "unhealthy", Channel: pubsubCanaryChannel, Error: "Redis client not initialized"})
+		return
+	}
+
+	interval := getEnvDuration("PUBSUB_CANARY_INTERVAL", defaultPubSubCanaryInterval)
+	timeout := getEnvDuration("PUBSUB_CANARY_TIMEOUT", defaultPubSubCanaryTimeout)
+
+	// One subscription, open for the life of the process, serves every probe;
+	// acks of earlier, timed out probes are skipped by id
+	messages := redisClient.Subscribe(context.Background(), pubsubAckChannel).Channel()
+
+	var sequence uint64
+	runner.AddImmediate("pubsub-canary", interval, func(context.Context) error {
+		sequence++
+		status := probePubSub(redisClient, messages, strconv.FormatUint(sequence, 10)+"-"+strconv.FormatInt(time.Now().UnixNano(), 36), timeout)
+		if status.Status != "healthy" {
+			log.Printf("⚠️  Pub/sub canary failed: %s", status.Error)
+		}
+		setPubSubStatus(status)
+		return nil
+	})
+}
+
+// probePubSub publishes one canary and waits for its echo on messages
+func probePubSub(client *redis.Client, messages <-chan *redis.Message, id string, timeout time.Duration) PubSubStatus {
+	status := PubSubStatus{
+		Status:      "unhealthy",
+		Channel:     pubsubCanaryChannel,
+		LastChecked: time.Now().UTC().Format(time.RFC3339),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	payload, _ := json.Marshal(pubsubCanary{ID: id, SentAt: time.Now().UnixMilli()})
+	start := time.Now()
+	subscribers, err := client.Publish(ctx, pubsubCanaryChannel, payload).Result()
+	if err != nil {
+		status.Error = fmt.Sprintf("failed to publish canary: %v", err)
+		return status
+	}
+	status.Subscribers = subscribers
+	if subscribers == 0 {
+		status.Error = "no subscribers on " + pubsubCanaryChannel
+		return status
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			status.RoundtripSeconds = time.Since(start).Seconds()
+			status.Error = fmt.Sprintf("no echo within %s", timeout)
+			return status
+		case message, ok := <-messages:
+			if !ok {
+				status.Error = "ack subscription closed"
+				return status
+			}
+			var ack pubsubCanary
+			if json.Unmarshal([]byte(message.Payload), &ack) != nil || ack.ID != id {
+				continue
+			}
+			status.Status = "healthy"
+			status.RoundtripSeconds = time.Since(start).Seconds()
+			return status
+		}
+	}
+}
+
+// pubsubServiceStatus reports the latest roundtrip as the WebSocket gateway's
+// subscriber health
+func pubsubServiceStatus() ServiceStatus {
+	pubsub := getPubSubStatus()
+	return ServiceStatus{
+		Service: "WebSocket Gateway (Pub/Sub)",
+		Status:  pubsub.Status,
+		Error:   pubsub.Error,
+		Latency: fmt.Sprintf("%.3fs", pubsub.RoundtripSeconds),
+	}
+}
+
+func setPubSubStatus(status PubSubStatus) {
+	pubsubMu.Lock()
+	defer pubsubMu.Unlock()
+	lastPubSubStatus = status
+}
+
+func getPubSubStatus() PubSubStatus {
+	pubsubMu.RLock()
+	defer pubsubMu.RUnlock()
+	return lastPubSubStatus
+}
+
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if d, err := time.ParseDuration(getEnv(key, "")); err == nil && d > 0 {
+		return d
+	}
+	return defaultValue
+}
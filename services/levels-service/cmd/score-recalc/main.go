@@ -0,0 +1,108 @@
+// Command score-recalc rebuilds user scores and levels from the trade,
+// follower and activity records, after a score rule change or a bug that
+// left user_logs wrong. Deposits are only accumulated in user_logs, so the
+// stored deposit amount is kept.
+//
+// It is a dry run that prints each changed user unless -apply is given:
+//
+//	score-recalc [-user 42] [-after 0] [-chunk 500] [-psc-rate 30000] [-apply]
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	"metargb/levels-service/internal/models"
+	"metargb/levels-service/internal/repository"
+	"metargb/levels-service/internal/service"
+	shareddb "metargb/shared/pkg/db"
+)
+
+func main() {
+	userID := flag.Uint64("user", 0, "recalculate only this user (default: all users)")
+	after := flag.Uint64("after", 0, "resume after this user id")
+	chunk := flag.Int("chunk", service.DefaultScoreRecalculationChunkSize, "users loaded per batch")
+	pscRate := flag.Float64("psc-rate", models.DefaultPSCRate, "IRR value of one PSC, used to find significant trades")
+	apply := flag.Bool("apply", false, "write the recalculated scores instead of only reporting them")
+	flag.Parse()
+
+	if *chunk <= 0 || *pscRate <= 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	dsn := shareddb.ServiceDSN("levels-service", fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
+		getEnv("DB_USER", "metargb_user"),
+		getEnv("DB_PASSWORD", "metargb_password"),
+		getEnv("DB_HOST", "mysql"),
+		getEnv("DB_PORT", "3306"),
+		getEnv("DB_DATABASE", "metargb_db"),
+	))
+
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	// Stop between users on Ctrl-C so an applied run can be resumed with -after
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	recalculation := service.NewScoreRecalculationService(
+		repository.NewUserLogRepository(db),
+		repository.NewLevelRepository(db),
+	)
+
+	result, err := recalculation.Recalculate(ctx, service.ScoreRecalculationOptions{
+		UserID:      *userID,
+		AfterUserID: *after,
+		ChunkSize:   *chunk,
+		PSCRate:     *pscRate,
+		Apply:       *apply,
+	}, printChange)
+	if err != nil {
+		log.Printf("Recalculation stopped after user %d: %v", result.LastUserID, err)
+		os.Exit(1)
+	}
+
+	action := "would change (dry run, pass -apply to write)"
+	if result.Applied {
+		action = "changed"
+	}
+	log.Printf("Checked %d users, last user %d", result.UsersChecked, result.LastUserID)
+	log.Printf("%d scores and %d levels %s", result.UsersChanged, result.LevelsReached, action)
+}
+
+func printChange(change service.ScoreChange) {
+	line := fmt.Sprintf("user %d: score %d -> %d", change.UserID, change.OldScore, change.NewScore)
+	line += component("transactions", change.Old.Transactions, change.New.Transactions)
+	line += component("followers", change.Old.Followers, change.New.Followers)
+	line += component("activity", change.Old.ActivityHours, change.New.ActivityHours)
+	if change.ReachedLevel != nil {
+		line += fmt.Sprintf(", reaches level %s", change.ReachedLevel.Slug)
+	}
+	fmt.Println(line)
+}
+
+func component(name string, old, new float64) string {
+	if fmt.Sprintf("%.2f", old) == fmt.Sprintf("%.2f", new) {
+		return ""
+	}
+	return fmt.Sprintf(", %s %.2f -> %.2f", name, old, new)
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -1,24 +1,40 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 
+	"metargb/levels-service/internal/client"
 	"metargb/levels-service/internal/handler"
+	"metargb/levels-service/internal/pubsub"
 	"metargb/levels-service/internal/repository"
 	"metargb/levels-service/internal/service"
 	pb "metargb/shared/pb/levels"
+	"metargb/shared/pkg/activity"
+	"metargb/shared/pkg/buildinfo"
 	"metargb/shared/pkg/db"
+	"metargb/shared/pkg/dbsplit"
+	"metargb/shared/pkg/deadline"
+	"metargb/shared/pkg/debug"
+	"metargb/shared/pkg/devmode"
+	"metargb/shared/pkg/faultinject"
+	healthprobe "metargb/shared/pkg/health"
 	"metargb/shared/pkg/logger"
 	"metargb/shared/pkg/metrics"
+	"metargb/shared/pkg/msgsize"
 
 	_ "github.com/go-sql-driver/mysql"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 func main() {
@@ -26,15 +42,20 @@ func main() {
 	log := logger.NewLogger("levels-service")
 	log.Info("Starting Levels Service...")
 
+	// SIGHUP toggles debug logging; LEVELS_LOG_ADMIN_ADDR serves the level over HTTP
+	logCtx, stopLogControl := context.WithCancel(context.Background())
+	defer stopLogControl()
+	log.EnableLevelControl(logCtx)
+
 	// Load configuration from environment
 	// Construct DSN from individual environment variables
-	dbDSN := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
+	dbDSN := db.ServiceDSN("levels-service", fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
 		getEnv("DB_USER", "metargb_user"),
 		getEnv("DB_PASSWORD", "metargb_password"),
 		getEnv("DB_HOST", "mysql"),
 		getEnv("DB_PORT", "3306"),
 		getEnv("DB_DATABASE", "metargb_db"),
-	)
+	))
 	port := getEnv("GRPC_PORT", "50054")
 	metricsPort := getEnv("METRICS_PORT", "9090")
 
@@ -45,8 +66,8 @@ func main() {
 	}
 	defer database.Close()
 
-	// Test database connection
-	if err := database.Ping(); err != nil {
+	// Wait for the database instead of crash looping while it is unavailable
+	if err := db.PingWithRetry(context.Background(), database, db.RetryPolicyFromEnv()); err != nil {
 		log.Fatal("Failed to ping database", "error", err)
 	}
 
@@ -71,36 +92,134 @@ func main() {
 	activityRepo := repository.NewActivityRepository(database)
 	challengeRepo := repository.NewChallengeRepository(database)
 	userLogRepo := repository.NewUserLogRepository(database)
+	processedEventRepo := repository.NewProcessedEventRepository(database)
+	onboardingRepo := repository.NewOnboardingRepository(database)
 
 	// Initialize services
 	levelService := service.NewLevelService(levelRepo, userLogRepo)
 	activityService := service.NewActivityService(activityRepo, userLogRepo, levelRepo)
 	challengeService := service.NewChallengeService(challengeRepo)
 
+	// Completing every onboarding step pays ONBOARDING_REWARD_AMOUNT of
+	// ONBOARDING_REWARD_ASSET into the user's wallet; no reward while it is 0
+	var rewardWallet service.WalletCreditor
+	rewardAmount := 0.0
+	if v := getEnv("ONBOARDING_REWARD_AMOUNT", ""); v != "" {
+		if amount, err := strconv.ParseFloat(v, 64); err == nil && amount >= 0 {
+			rewardAmount = amount
+		} else {
+			log.Warn("Invalid ONBOARDING_REWARD_AMOUNT, onboarding rewards disabled", "value", v)
+		}
+	}
+	if rewardAmount > 0 {
+		commercialClient, err := client.NewCommercialClient(getEnv("COMMERCIAL_SERVICE_ADDR", "commercial-service:50052"))
+		if err != nil {
+			log.Warn("Failed to connect to commercial service - onboarding rewards disabled", "error", err)
+		} else {
+			defer commercialClient.Close()
+			rewardWallet = commercialClient
+		}
+	}
+	onboardingService := service.NewOnboardingService(
+		onboardingRepo,
+		rewardWallet,
+		getEnv("ONBOARDING_REWARD_ASSET", service.DefaultOnboardingRewardAsset),
+		rewardAmount,
+	)
+
+	// Other services report user activity on the shared Redis stream instead
+	// of calling ActivityService directly
+	activityIngestor := service.NewActivityIngestor(activityService, processedEventRepo, onboardingService)
+	consumerCtx, stopConsumer := context.WithCancel(context.Background())
+	defer stopConsumer()
+	hostname, _ := os.Hostname()
+	activityConsumer, err := pubsub.NewRedisConsumer(
+		redisURL(),
+		getEnv("ACTIVITY_STREAM", activity.Stream),
+		getEnv("ACTIVITY_CONSUMER_NAME", "levels-"+hostname),
+		func(ctx context.Context, event activity.Event) error {
+			_, err := activityIngestor.Ingest(ctx, event)
+			return err
+		},
+		log,
+	)
+	if err != nil {
+		log.Warn("Failed to connect to Redis - activity events will not be ingested", "error", err)
+	} else {
+		defer activityConsumer.Close()
+		activityConsumer.Start(consumerCtx)
+	}
+
 	// Initialize gRPC handlers
 	levelHandler := handler.NewLevelHandler(levelService)
 	activityHandler := handler.NewActivityHandler(activityService)
 	challengeHandler := handler.NewChallengeHandler(challengeService)
+	onboardingHandler := handler.NewOnboardingHandler(onboardingService)
 
 	// Create gRPC server with interceptors
 	serviceMetrics := metrics.NewMetrics("levels")
-	grpcServer := grpc.NewServer(
+	limits := msgsize.FromEnv("levels-service", msgsize.Defaults())
+	serverOpts := append(limits.ServerOptions(),
 		grpc.ChainUnaryInterceptor(
 			logger.UnaryServerInterceptor(log),
 			metrics.UnaryServerInterceptor(serviceMetrics),
 		),
 	)
 
+	// Cap how long handlers run so slow queries are cancelled, see deadline.FromEnv
+	serverOpts = append(serverOpts, deadline.FromEnv("levels-service", deadline.Defaults()).ServerOptions()...)
+
+	// Staging builds inject latency and errors into chosen methods, see faultinject
+	faultCtx, stopFaults := context.WithCancel(context.Background())
+	defer stopFaults()
+	serverOpts = append(serverOpts, faultinject.FromEnv(faultCtx, "levels-service", log)...)
+
+	// Outside development internal errors reach callers only as a reference, see devmode
+	serverOpts = append(serverOpts, devmode.FromEnv("levels-service", log)...)
+
+	// Every response names the build that served it, see buildinfo
+	serverOpts = append(serverOpts, buildinfo.ServerOptions()...)
+	grpcServer := grpc.NewServer(serverOpts...)
+
+	// Report readiness over HTTP and through the standard gRPC health service:
+	// the database is reachable, its tables exist and the server has started
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	defer stopHealth()
+	dbMonitor := db.NewHealthMonitor(database, nil)
+	dbMonitor.Start(healthCtx)
+	probe := healthprobe.NewProbe("levels-service").
+		AddCheck("database", dbMonitor.Check).
+		AddCheck("migrations", schemaGuard.RequireTables(dbsplit.Ownership["levels-service"]...))
+	serving := probe.Starting("grpc")
+	probe.Watch(healthCtx, healthServer, healthprobe.DefaultInterval)
+	probeServer := healthprobe.NewServer(":"+getEnv("HEALTH_PORT", healthprobe.DefaultPort), probe)
+	go func() {
+		if err := probeServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Health probe server failed", "error", err)
+		}
+	}()
+
 	// Register services
 	pb.RegisterLevelServiceServer(grpcServer, levelHandler)
 	pb.RegisterActivityServiceServer(grpcServer, activityHandler)
 	pb.RegisterChallengeServiceServer(grpcServer, challengeHandler)
+	pb.RegisterOnboardingServiceServer(grpcServer, onboardingHandler)
+
+	// Reflection is only registered in development, see devmode
+	devmode.Register(grpcServer, log)
 
-	// Enable reflection for debugging
-	reflection.Register(grpcServer)
+	// Profiling endpoints, off unless DEBUG_ADDR is set or in development, see debug
+	debug.Serve(context.Background(), "levels-service", log)
 
-	// Metrics are exposed via Prometheus client library
-	// Start HTTP server for metrics endpoint if needed
+	// Serve Prometheus metrics, including the deadline and request counters
+	metricsServer := metrics.NewServer(":" + metricsPort)
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Metrics server failed", "error", err)
+		}
+	}()
 	log.Info("Metrics available on /metrics endpoint", "port", metricsPort)
 
 	// Start gRPC server
@@ -110,6 +229,7 @@ func main() {
 	}
 
 	log.Info("Levels Service started", "port", port, "metrics_port", metricsPort)
+	serving()
 
 	// Graceful shutdown
 	go func() {
@@ -118,6 +238,11 @@ func main() {
 		<-sigChan
 
 		log.Info("Shutting down gracefully...")
+		stopConsumer()
+		probe.Drain()
+		healthServer.Shutdown()
+		metricsServer.Close()
+		probeServer.Close()
 		grpcServer.GracefulStop()
 		database.Close()
 		log.Info("Shutdown complete")
@@ -135,3 +260,17 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// redisURL returns REDIS_URL, or builds it from the individual REDIS_* settings
+func redisURL() string {
+	if url := getEnv("REDIS_URL", ""); url != "" {
+		return url
+	}
+	host := getEnv("REDIS_HOST", "localhost")
+	port := getEnv("REDIS_PORT", "6379")
+	database := getEnv("REDIS_DB", "0")
+	if password := getEnv("REDIS_PASSWORD", ""); password != "" {
+		return fmt.Sprintf("redis://:%s@%s:%s/%s", password, host, port, database)
+	}
+	return fmt.Sprintf("redis://%s:%s/%s", host, port, database)
+}
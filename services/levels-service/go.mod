@@ -6,6 +6,7 @@ toolchain go1.24.3
 
 require (
 	github.com/go-sql-driver/mysql v1.7.1
+	github.com/redis/go-redis/v9 v9.16.0
 	google.golang.org/grpc v1.76.0
 	metargb/shared v0.0.0
 )
@@ -13,6 +14,7 @@ require (
 require (
 	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
 	github.com/golang/protobuf v1.5.4 // indirect
 	github.com/matttproud/golang_protobuf_extensions v1.0.4 // indirect
 	github.com/prometheus/client_golang v1.17.0 // indirect
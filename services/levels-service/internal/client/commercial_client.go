@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	pb "metargb/shared/pb/commercial"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// CommercialClient credits rewards to user wallets through commercial-service
+type CommercialClient struct {
+	walletClient pb.WalletServiceClient
+	conn         *grpc.ClientConn
+}
+
+// NewCommercialClient dials commercial-service. The connection is made
+// lazily, so levels-service starts while commercial-service is down.
+func NewCommercialClient(address string) (*CommercialClient, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to commercial service at %s: %w", address, err)
+	}
+
+	return &CommercialClient{
+		walletClient: pb.NewWalletServiceClient(conn),
+		conn:         conn,
+	}, nil
+}
+
+// AddBalance credits amount of asset to the user's wallet
+func (c *CommercialClient) AddBalance(ctx context.Context, userID uint64, asset string, amount float64) error {
+	resp, err := c.walletClient.AddBalance(ctx, &pb.AddBalanceRequest{
+		UserId: userID,
+		Asset:  asset,
+		Amount: amount,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add balance: %w", err)
+	}
+	if !resp.Success {
+		return fmt.Errorf("add balance failed: %s", resp.Message)
+	}
+
+	return nil
+}
+
+// Close closes the gRPC connection
+func (c *CommercialClient) Close() error {
+	return c.conn.Close()
+}
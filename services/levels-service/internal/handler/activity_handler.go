@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -128,3 +129,22 @@ func (h *ActivityHandler) RecordFollower(ctx context.Context, req *pb.RecordFoll
 		Success: true,
 	}, nil
 }
+
+// Heartbeat keeps the user's activity session open while the client is in use
+func (h *ActivityHandler) Heartbeat(ctx context.Context, req *pb.HeartbeatRequest) (*pb.HeartbeatResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+
+	result, err := h.service.Heartbeat(ctx, req.UserId, req.Ip)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record heartbeat: %v", err)
+	}
+
+	return &pb.HeartbeatResponse{
+		Accepted:             result.Accepted,
+		ActivityId:           result.ActivityID,
+		SessionMinutes:       result.SessionMinutes,
+		NextHeartbeatSeconds: int32(service.ActivityHeartbeatInterval / time.Second),
+	}, nil
+}
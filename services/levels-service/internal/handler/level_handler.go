@@ -181,3 +181,19 @@ func (h *LevelHandler) ClaimPrize(ctx context.Context, req *pb.ClaimPrizeRequest
 		Message: "Prize claimed successfully",
 	}, nil
 }
+
+// GetUserScores retrieves the current scores of many users at once
+func (h *LevelHandler) GetUserScores(ctx context.Context, req *pb.GetUserScoresRequest) (*pb.UserScoresResponse, error) {
+	if len(req.UserIds) > service.MaxUserScoresBatch {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d user_ids may be requested at once", service.MaxUserScoresBatch)
+	}
+
+	scores, err := h.service.GetUserScores(ctx, req.UserIds)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user scores: %v", err)
+	}
+
+	return &pb.UserScoresResponse{
+		Scores: scores,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"metargb/levels-service/internal/service"
+	pb "metargb/shared/pb/levels"
+)
+
+type OnboardingHandler struct {
+	pb.UnimplementedOnboardingServiceServer
+	service *service.OnboardingService
+}
+
+func NewOnboardingHandler(service *service.OnboardingService) *OnboardingHandler {
+	return &OnboardingHandler{
+		service: service,
+	}
+}
+
+// GetOnboardingState returns the user's onboarding checklist for the frontend
+func (h *OnboardingHandler) GetOnboardingState(ctx context.Context, req *pb.GetOnboardingStateRequest) (*pb.OnboardingStateResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+
+	state, err := h.service.GetOnboardingState(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get onboarding state: %v", err)
+	}
+
+	return state, nil
+}
@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// Onboarding checklist steps, in the order the frontend shows them
+const (
+	OnboardingVerifyPhone        = "verify_phone"
+	OnboardingCompleteKYC        = "complete_kyc"
+	OnboardingBuyFirstLand       = "buy_first_land"
+	OnboardingBuildFirstBuilding = "build_first_building"
+	OnboardingJoinDynasty        = "join_dynasty"
+)
+
+// OnboardingStepDefinition describes a step of the onboarding checklist
+type OnboardingStepDefinition struct {
+	Key   string
+	Title string // Persian title shown in the checklist
+}
+
+// OnboardingSteps lists the checklist in order
+var OnboardingSteps = []OnboardingStepDefinition{
+	{Key: OnboardingVerifyPhone, Title: "تایید شماره موبایل"},
+	{Key: OnboardingCompleteKYC, Title: "احراز هویت"},
+	{Key: OnboardingBuyFirstLand, Title: "خرید اولین زمین"},
+	{Key: OnboardingBuildFirstBuilding, Title: "ساخت اولین بنا"},
+	{Key: OnboardingJoinDynasty, Title: "پیوستن به سلسله"},
+}
+
+// OnboardingReward is the reward a user gets once every onboarding step is
+// completed. GrantedAt stays nil until the wallet was credited.
+// Maps to user_onboarding_rewards
+type OnboardingReward struct {
+	UserID    uint64     `json:"user_id" db:"user_id"`
+	Asset     string     `json:"asset" db:"asset"`
+	Amount    float64    `json:"amount" db:"amount"`
+	GrantedAt *time.Time `json:"granted_at" db:"granted_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
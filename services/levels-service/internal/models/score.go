@@ -0,0 +1,76 @@
+package models
+
+import "math"
+
+// Score rules
+// Implements Laravel: UserObserver@traded, @followed, @deposit and @hourReached
+const (
+	// SignificantTradeIRRAmount is the IRR amount a trade must exceed to count
+	SignificantTradeIRRAmount = 7000000
+	// DefaultPSCRate is the IRR value of one PSC used when the rate is unknown
+	DefaultPSCRate = 30000
+
+	TradeScore        = 2
+	FollowerScore     = 0.1
+	DepositScoreRate  = 0.0001
+	ActivityHourScore = 0.1
+)
+
+// SignificantTradePSCAmount is the PSC amount a trade must exceed to count
+// Implements Laravel: 7000000 / Variable::getRate('psc')
+func SignificantTradePSCAmount(pscRate float64) float64 {
+	if pscRate <= 0 {
+		pscRate = DefaultPSCRate
+	}
+	return SignificantTradeIRRAmount / pscRate
+}
+
+// TransactionsScore implements Laravel: $trades * 2
+func TransactionsScore(significantTrades int64) float64 {
+	return float64(significantTrades) * TradeScore
+}
+
+// FollowersScore implements Laravel: $totalFollowers * 0.1
+func FollowersScore(followers int64) float64 {
+	return float64(followers) * FollowerScore
+}
+
+// ActivityHoursScore implements Laravel: ceil($totalActiveHours / 60) * 0.1
+func ActivityHoursScore(totalMinutes int64) float64 {
+	return math.Ceil(float64(totalMinutes)/60) * ActivityHourScore
+}
+
+// ScoreComponents are the parts of a user's score kept in user_logs
+type ScoreComponents struct {
+	Transactions  float64 `json:"transactions_count"`
+	Followers     float64 `json:"followers_count"`
+	Deposit       float64 `json:"deposit_amount"`
+	ActivityHours float64 `json:"activity_hours"`
+}
+
+// Score sums the components
+// Implements Laravel: array_sum([...$log components])
+func (c ScoreComponents) Score() int32 {
+	return int32(c.Transactions + c.Followers + c.Deposit + c.ActivityHours)
+}
+
+// ScoreSources are the records a user's score is rebuilt from. Deposits are
+// only ever accumulated into user_logs, so the stored deposit is kept as is.
+type ScoreSources struct {
+	UserID            uint64
+	SignificantTrades int64
+	Followers         int64
+	ActivityMinutes   int64
+	Stored            ScoreComponents
+	StoredScore       int32
+}
+
+// Components applies the score rules to the sources
+func (s *ScoreSources) Components() ScoreComponents {
+	return ScoreComponents{
+		Transactions:  TransactionsScore(s.SignificantTrades),
+		Followers:     FollowersScore(s.Followers),
+		Deposit:       s.Stored.Deposit,
+		ActivityHours: ActivityHoursScore(s.ActivityMinutes),
+	}
+}
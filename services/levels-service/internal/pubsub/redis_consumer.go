@@ -0,0 +1,178 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/redis/go-redis/v9/maintnotifications"
+
+	"metargb/shared/pkg/activity"
+	"metargb/shared/pkg/logger"
+)
+
+const (
+	// ConsumerGroup is the Redis consumer group every levels-service replica joins
+	ConsumerGroup = "levels-service"
+	// DefaultClaimIdle is how long a delivered event may stay unacknowledged
+	// before another consumer claims it
+	DefaultClaimIdle = 5 * time.Minute
+
+	readBatch    = 50
+	readBlock    = 5 * time.Second
+	retryBackoff = 5 * time.Second
+)
+
+// ActivityHandler applies a single activity event
+type ActivityHandler func(ctx context.Context, event activity.Event) error
+
+// RedisConsumer reads activity events from a Redis stream through a consumer
+// group. Events are acknowledged once handled, so an event whose handler
+// fails stays pending and is claimed again after DefaultClaimIdle.
+type RedisConsumer struct {
+	client   *redis.Client
+	stream   string
+	consumer string
+	handler  ActivityHandler
+	log      *logger.Logger
+}
+
+// NewRedisConsumer connects to Redis and creates the consumer group, and the
+// stream if it does not exist yet. consumer names this replica in the group.
+func NewRedisConsumer(redisURL, stream, consumer string, handler ActivityHandler, log *logger.Logger) (*RedisConsumer, error) {
+	opts, err := redis.ParseURL(redisURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Redis URL: %w", err)
+	}
+
+	// Disable maint notifications to avoid warning about maint_notifications command
+	opts.MaintNotificationsConfig = &maintnotifications.Config{
+		Mode: maintnotifications.ModeDisabled,
+	}
+
+	client := redis.NewClient(opts)
+
+	ctx := context.Background()
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	// Start from the beginning of the stream so events added before the
+	// first deployment are not lost
+	if err := client.XGroupCreateMkStream(ctx, stream, ConsumerGroup, "0").Err(); err != nil && !isBusyGroup(err) {
+		client.Close()
+		return nil, fmt.Errorf("failed to create consumer group: %w", err)
+	}
+
+	return &RedisConsumer{
+		client:   client,
+		stream:   stream,
+		consumer: consumer,
+		handler:  handler,
+		log:      log,
+	}, nil
+}
+
+// Start consumes events in the background until ctx is cancelled
+func (c *RedisConsumer) Start(ctx context.Context) {
+	go func() {
+		lastClaim := time.Time{}
+		for ctx.Err() == nil {
+			if time.Since(lastClaim) >= DefaultClaimIdle {
+				if err := c.claimStale(ctx); err != nil && ctx.Err() == nil {
+					c.log.Warn("Failed to claim stale activity events", "error", err)
+				}
+				lastClaim = time.Now()
+			}
+
+			if err := c.read(ctx); err != nil && ctx.Err() == nil {
+				c.log.Error("Failed to read activity events", "error", err)
+				select {
+				case <-ctx.Done():
+				case <-time.After(retryBackoff):
+				}
+			}
+		}
+	}()
+}
+
+// read blocks until new events arrive and handles them
+func (c *RedisConsumer) read(ctx context.Context) error {
+	streams, err := c.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    ConsumerGroup,
+		Consumer: c.consumer,
+		Streams:  []string{c.stream, ">"},
+		Count:    readBatch,
+		Block:    readBlock,
+	}).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, stream := range streams {
+		for _, message := range stream.Messages {
+			c.handle(ctx, message)
+		}
+	}
+	return nil
+}
+
+// claimStale takes over events other consumers received but never
+// acknowledged, e.g. because they crashed or their handler failed
+func (c *RedisConsumer) claimStale(ctx context.Context) error {
+	start := "0-0"
+	for {
+		messages, next, err := c.client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   c.stream,
+			Group:    ConsumerGroup,
+			Consumer: c.consumer,
+			MinIdle:  DefaultClaimIdle,
+			Start:    start,
+			Count:    readBatch,
+		}).Result()
+		if err != nil {
+			return err
+		}
+
+		for _, message := range messages {
+			c.handle(ctx, message)
+		}
+		if next == "0-0" || len(messages) == 0 {
+			return nil
+		}
+		start = next
+	}
+}
+
+// handle applies a message and acknowledges it unless the handler failed.
+// Malformed messages are acknowledged too since retrying cannot fix them.
+func (c *RedisConsumer) handle(ctx context.Context, message redis.XMessage) {
+	payload, _ := message.Values[activity.PayloadField].(string)
+	event, err := activity.Unmarshal([]byte(payload))
+	if err != nil {
+		c.log.Warn("Dropping malformed activity event", "message_id", message.ID, "error", err)
+	} else if err := c.handler(ctx, event); err != nil {
+		c.log.Error("Failed to handle activity event", "message_id", message.ID, "event_id", event.ID, "error", err)
+		return
+	}
+
+	if err := c.client.XAck(ctx, c.stream, ConsumerGroup, message.ID).Err(); err != nil {
+		c.log.Warn("Failed to acknowledge activity event", "message_id", message.ID, "error", err)
+	}
+}
+
+// Close closes the Redis connection
+func (c *RedisConsumer) Close() error {
+	return c.client.Close()
+}
+
+func isBusyGroup(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), "BUSYGROUP")
+}
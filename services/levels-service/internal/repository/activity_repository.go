@@ -3,8 +3,10 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
+	"metargb/levels-service/internal/models"
 	pb "metargb/shared/pb/levels"
 )
 
@@ -139,6 +141,64 @@ func (r *ActivityRepository) UpdateActivity(ctx context.Context, activityID uint
 	return err
 }
 
+// GetLatestSession retrieves user's latest activity session, or nil when
+// the user has none
+func (r *ActivityRepository) GetLatestSession(ctx context.Context, userID uint64) (*models.UserActivity, error) {
+	query := `
+		SELECT id, user_id, start, end, total, ip
+		FROM user_activities
+		WHERE user_id = ?
+		ORDER BY id DESC
+		LIMIT 1
+	`
+
+	var activity models.UserActivity
+	var start string
+	var end sql.NullString
+	var total sql.NullInt32
+
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+		&activity.ID,
+		&activity.UserID,
+		&start,
+		&end,
+		&total,
+		&activity.IP,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// start and end are varchar columns holding local date times
+	if activity.Start, err = parseActivityTime(start); err != nil {
+		return nil, fmt.Errorf("invalid start of activity %d: %w", activity.ID, err)
+	}
+	if end.Valid && end.String != "" {
+		endTime, err := parseActivityTime(end.String)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end of activity %d: %w", activity.ID, err)
+		}
+		activity.End = &endTime
+	}
+	if total.Valid {
+		activity.Total = &total.Int32
+	}
+
+	return &activity, nil
+}
+
+func parseActivityTime(value string) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02 15:04:05.999999", time.RFC3339} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized time %q", value)
+}
+
 // GetTotalActivityMinutes calculates total activity time for user
 // Implements Laravel: $user->activities->sum('total')
 func (r *ActivityRepository) GetTotalActivityMinutes(ctx context.Context, userID uint64) (int32, error) {
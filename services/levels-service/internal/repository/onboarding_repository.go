@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"metargb/levels-service/internal/models"
+)
+
+// OnboardingRepository handles user_onboarding_steps and
+// user_onboarding_rewards table operations
+type OnboardingRepository struct {
+	db *sql.DB
+}
+
+func NewOnboardingRepository(db *sql.DB) *OnboardingRepository {
+	return &OnboardingRepository{db: db}
+}
+
+// CompleteStep records a completed step and reports whether it was new. A
+// step completed again keeps its first completion time.
+func (r *OnboardingRepository) CompleteStep(ctx context.Context, userID uint64, step string, at time.Time) (bool, error) {
+	query := `
+		INSERT IGNORE INTO user_onboarding_steps (user_id, step, completed_at)
+		VALUES (?, ?, ?)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, userID, step, at)
+	if err != nil {
+		return false, fmt.Errorf("failed to complete onboarding step: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to complete onboarding step: %w", err)
+	}
+
+	return affected > 0, nil
+}
+
+// GetCompletedSteps returns the completion time of each completed step
+func (r *OnboardingRepository) GetCompletedSteps(ctx context.Context, userID uint64) (map[string]time.Time, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT step, completed_at FROM user_onboarding_steps WHERE user_id = ?
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get onboarding steps: %w", err)
+	}
+	defer rows.Close()
+
+	steps := make(map[string]time.Time)
+	for rows.Next() {
+		var step string
+		var completedAt time.Time
+		if err := rows.Scan(&step, &completedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan onboarding step: %w", err)
+		}
+		steps[step] = completedAt
+	}
+
+	return steps, rows.Err()
+}
+
+// GetReward returns the user's completion reward, or nil if none was created
+func (r *OnboardingRepository) GetReward(ctx context.Context, userID uint64) (*models.OnboardingReward, error) {
+	var reward models.OnboardingReward
+	err := r.db.QueryRowContext(ctx, `
+		SELECT user_id, asset, amount, granted_at, created_at
+		FROM user_onboarding_rewards
+		WHERE user_id = ?
+	`, userID).Scan(&reward.UserID, &reward.Asset, &reward.Amount, &reward.GrantedAt, &reward.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get onboarding reward: %w", err)
+	}
+
+	return &reward, nil
+}
+
+// CreateReward records the user's completion reward unless one exists. The
+// reward is only granted once, whatever the configured amount later becomes.
+func (r *OnboardingRepository) CreateReward(ctx context.Context, userID uint64, asset string, amount float64) error {
+	query := `
+		INSERT IGNORE INTO user_onboarding_rewards (user_id, asset, amount, created_at)
+		VALUES (?, ?, ?, NOW())
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, asset, amount); err != nil {
+		return fmt.Errorf("failed to create onboarding reward: %w", err)
+	}
+	return nil
+}
+
+// MarkRewardGranted records that the reward was credited to the wallet
+func (r *OnboardingRepository) MarkRewardGranted(ctx context.Context, userID uint64, at time.Time) error {
+	query := `UPDATE user_onboarding_rewards SET granted_at = ? WHERE user_id = ? AND granted_at IS NULL`
+
+	if _, err := r.db.ExecContext(ctx, query, at, userID); err != nil {
+		return fmt.Errorf("failed to mark onboarding reward granted: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// ProcessedEventRepository handles processed_activity_events table operations.
+// A row per ingested activity event lets redelivered events be skipped.
+type ProcessedEventRepository struct {
+	db *sql.DB
+}
+
+func NewProcessedEventRepository(db *sql.DB) *ProcessedEventRepository {
+	return &ProcessedEventRepository{db: db}
+}
+
+// MarkProcessed records an event id and reports whether it was new.
+// It returns false when the event was already processed.
+func (r *ProcessedEventRepository) MarkProcessed(ctx context.Context, eventID, eventType string, userID uint64) (bool, error) {
+	query := `
+		INSERT IGNORE INTO processed_activity_events (event_id, type, user_id, created_at)
+		VALUES (?, ?, ?, NOW())
+	`
+
+	result, err := r.db.ExecContext(ctx, query, eventID, eventType, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark activity event processed: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to mark activity event processed: %w", err)
+	}
+
+	return affected > 0, nil
+}
+
+// Unmark forgets an event id so a failed event can be processed again
+func (r *ProcessedEventRepository) Unmark(ctx context.Context, eventID string) error {
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM processed_activity_events WHERE event_id = ?", eventID); err != nil {
+		return fmt.Errorf("failed to unmark activity event: %w", err)
+	}
+	return nil
+}
@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"fmt"
 	"strconv"
+	"strings"
 
+	"metargb/levels-service/internal/models"
 	pb "metargb/shared/pb/levels"
 )
 
@@ -41,6 +43,46 @@ func (r *UserLogRepository) GetUserScore(ctx context.Context, userID uint64) (in
 	return int32(scoreInt), nil
 }
 
+// GetUserScores retrieves the current scores of many users in one query.
+// Users that do not exist are left out.
+func (r *UserLogRepository) GetUserScores(ctx context.Context, userIDs []uint64) (map[uint64]int32, error) {
+	scores := make(map[uint64]int32, len(userIDs))
+	if len(userIDs) == 0 {
+		return scores, nil
+	}
+
+	args := make([]interface{}, len(userIDs))
+	for i, id := range userIDs {
+		args[i] = id
+	}
+	query := "SELECT id, score FROM users WHERE id IN (" + strings.TrimSuffix(strings.Repeat("?,", len(userIDs)), ",") + ")"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user scores: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id uint64
+		var score sql.NullString
+		if err := rows.Scan(&id, &score); err != nil {
+			return nil, fmt.Errorf("failed to scan user score: %w", err)
+		}
+		scores[id] = 0
+		if score.Valid && score.String != "" {
+			if value, err := strconv.ParseFloat(score.String, 32); err == nil {
+				scores[id] = int32(value)
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate user scores: %w", err)
+	}
+
+	return scores, nil
+}
+
 // GetUserLog retrieves user's activity log
 // Implements Laravel: $user->log
 func (r *UserLogRepository) GetUserLog(ctx context.Context, userID uint64) (*pb.UserLog, error) {
@@ -125,7 +167,7 @@ func (r *UserLogRepository) IncrementDeposit(ctx context.Context, userID uint64,
 // UpdateFollowersCount updates followers count
 // Implements Laravel: $user->log->update(['followers_count' => $totalFollowers * 0.1])
 func (r *UserLogRepository) UpdateFollowersCount(ctx context.Context, userID uint64, totalFollowers int32) error {
-	count := float64(totalFollowers) * 0.1
+	count := models.FollowersScore(int64(totalFollowers))
 	query := "UPDATE user_logs SET followers_count = ?, updated_at = NOW() WHERE user_id = ?"
 	_, err := r.db.ExecContext(ctx, query, fmt.Sprintf("%.1f", count), userID)
 	return err
@@ -134,9 +176,7 @@ func (r *UserLogRepository) UpdateFollowersCount(ctx context.Context, userID uin
 // UpdateActivityHours updates activity hours
 // Implements Laravel: $user->log->update(['activity_hours' => ceil($totalActiveHours / 60) * 0.1])
 func (r *UserLogRepository) UpdateActivityHours(ctx context.Context, userID uint64, totalMinutes int32) error {
-	hours := float64(totalMinutes) / 60.0
-	ceiledHours := float64(int32(hours) + 1) // ceil function
-	activityScore := ceiledHours * 0.1
+	activityScore := models.ActivityHoursScore(int64(totalMinutes))
 
 	query := "UPDATE user_logs SET activity_hours = ?, updated_at = NOW() WHERE user_id = ?"
 	_, err := r.db.ExecContext(ctx, query, fmt.Sprintf("%.1f", activityScore), userID)
@@ -169,3 +209,163 @@ func (r *UserLogRepository) CalculateScore(ctx context.Context, userID uint64) (
 
 	return int32(total), nil
 }
+
+// ListScoredUserIDs returns up to limit user ids with a user log, in id order,
+// after afterUserID
+func (r *UserLogRepository) ListScoredUserIDs(ctx context.Context, afterUserID uint64, limit int) ([]uint64, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT user_id FROM user_logs WHERE user_id > ? ORDER BY user_id LIMIT ?", afterUserID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scored users: %w", err)
+	}
+	defer rows.Close()
+
+	var userIDs []uint64
+	for rows.Next() {
+		var id uint64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan user id: %w", err)
+		}
+		userIDs = append(userIDs, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate scored users: %w", err)
+	}
+
+	return userIDs, nil
+}
+
+// GetScoreSources loads the stored user log and the trade, follower and
+// activity records of each user that has a user log
+func (r *UserLogRepository) GetScoreSources(ctx context.Context, userIDs []uint64, minIrrAmount, minPscAmount float64) (map[uint64]*models.ScoreSources, error) {
+	sources := make(map[uint64]*models.ScoreSources, len(userIDs))
+	if len(userIDs) == 0 {
+		return sources, nil
+	}
+
+	ids := make([]interface{}, len(userIDs))
+	for i, id := range userIDs {
+		ids[i] = id
+	}
+	in := "(" + strings.TrimSuffix(strings.Repeat("?,", len(userIDs)), ",") + ")"
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT user_id,
+		       COALESCE(transactions_count, '0'),
+		       COALESCE(followers_count, '0'),
+		       COALESCE(deposit_amount, '0'),
+		       COALESCE(activity_hours, '0'),
+		       COALESCE(score, '0')
+		FROM user_logs
+		WHERE user_id IN `+in, ids...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user logs: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userID uint64
+		var transactions, followers, deposit, activity, score string
+		if err := rows.Scan(&userID, &transactions, &followers, &deposit, &activity, &score); err != nil {
+			return nil, fmt.Errorf("failed to scan user log: %w", err)
+		}
+		storedScore, _ := strconv.ParseFloat(score, 64)
+		sources[userID] = &models.ScoreSources{
+			UserID: userID,
+			Stored: models.ScoreComponents{
+				Transactions:  parseScoreComponent(transactions),
+				Followers:     parseScoreComponent(followers),
+				Deposit:       parseScoreComponent(deposit),
+				ActivityHours: parseScoreComponent(activity),
+			},
+			StoredScore: int32(storedScore),
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate user logs: %w", err)
+	}
+
+	// A trade counts once for a user even when they are both buyer and seller
+	tradeArgs := append(append([]interface{}{}, ids...), minIrrAmount, minPscAmount)
+	err = r.countByUser(ctx, `
+		SELECT u.user_id, COUNT(*)
+		FROM (SELECT user_id FROM user_logs WHERE user_id IN `+in+`) u
+		JOIN trades t ON t.buyer_id = u.user_id OR t.seller_id = u.user_id
+		WHERE t.irr_amount > ? OR t.psc_amount > ?
+		GROUP BY u.user_id`, tradeArgs, sources, func(s *models.ScoreSources, n int64) { s.SignificantTrades = n })
+	if err != nil {
+		return nil, fmt.Errorf("failed to count trades: %w", err)
+	}
+
+	err = r.countByUser(ctx, "SELECT followed_id, COUNT(*) FROM followers WHERE followed_id IN "+in+" GROUP BY followed_id",
+		ids, sources, func(s *models.ScoreSources, n int64) { s.Followers = n })
+	if err != nil {
+		return nil, fmt.Errorf("failed to count followers: %w", err)
+	}
+
+	err = r.countByUser(ctx, "SELECT user_id, COALESCE(SUM(total), 0) FROM user_activities WHERE user_id IN "+in+" GROUP BY user_id",
+		ids, sources, func(s *models.ScoreSources, n int64) { s.ActivityMinutes = n })
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum activity minutes: %w", err)
+	}
+
+	return sources, nil
+}
+
+// countByUser runs a query returning (user id, count) rows and sets each
+// count on the user's sources
+func (r *UserLogRepository) countByUser(ctx context.Context, query string, args []interface{}, sources map[uint64]*models.ScoreSources, set func(*models.ScoreSources, int64)) error {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userID uint64
+		var count int64
+		if err := rows.Scan(&userID, &count); err != nil {
+			return err
+		}
+		if s, ok := sources[userID]; ok {
+			set(s, count)
+		}
+	}
+	return rows.Err()
+}
+
+// SetScoreComponents rewrites a user's recalculated score components and
+// score in user_logs and users. The deposit amount is left untouched.
+func (r *UserLogRepository) SetScoreComponents(ctx context.Context, userID uint64, components models.ScoreComponents) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	score := fmt.Sprintf("%d", components.Score())
+	_, err = tx.ExecContext(ctx, `
+		UPDATE user_logs
+		SET transactions_count = ?, followers_count = ?, activity_hours = ?, score = ?, updated_at = NOW()
+		WHERE user_id = ?`,
+		fmt.Sprintf("%.0f", components.Transactions),
+		fmt.Sprintf("%.1f", components.Followers),
+		fmt.Sprintf("%.1f", components.ActivityHours),
+		score,
+		userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE users SET score = ?, updated_at = NOW() WHERE id = ?", score, userID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func parseScoreComponent(value string) float64 {
+	parsed, _ := strconv.ParseFloat(value, 64)
+	return parsed
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"metargb/levels-service/internal/models"
+	pb "metargb/shared/pb/levels"
+)
+
+const (
+	// ActivityHeartbeatInterval is how often clients should send a heartbeat
+	ActivityHeartbeatInterval = time.Minute
+	// ActivitySessionTimeout is the longest gap between heartbeats that keeps a
+	// session open; a later heartbeat starts a new session
+	ActivitySessionTimeout = 5 * time.Minute
+
+	// activityHeartbeatDebounce drops heartbeats sent sooner than this after the
+	// previous one, e.g. from several open tabs
+	activityHeartbeatDebounce = 30 * time.Second
+	// heartbeatSweepSize is the number of tracked users above which stale
+	// entries are dropped
+	heartbeatSweepSize = 10000
+)
+
+// HeartbeatResult is the user's session after a heartbeat
+type HeartbeatResult struct {
+	// Accepted is false when the heartbeat was debounced and changed nothing
+	Accepted       bool
+	ActivityID     uint64
+	SessionMinutes int32
+}
+
+// Heartbeat extends the user's activity session to now, or starts a new one
+// when the latest session went quiet for longer than ActivitySessionTimeout.
+// When the session's minutes start another hour of activity, the activity
+// hours and score are recalculated as on logout.
+func (s *ActivityService) Heartbeat(ctx context.Context, userID uint64, ip string) (*HeartbeatResult, error) {
+	now := time.Now()
+	if !s.heartbeats.allow(userID, now) {
+		return &HeartbeatResult{Accepted: false}, nil
+	}
+
+	session, err := s.activityRepo.GetLatestSession(ctx, userID)
+	if err != nil {
+		s.heartbeats.forget(userID)
+		return nil, err
+	}
+
+	if session == nil || now.Sub(lastSeen(session)) > ActivitySessionTimeout {
+		activityID, err := s.activityRepo.CreateActivity(ctx, &pb.LogActivityRequest{UserId: userID, Ip: ip})
+		if err != nil {
+			s.heartbeats.forget(userID)
+			return nil, err
+		}
+		return &HeartbeatResult{Accepted: true, ActivityID: activityID}, nil
+	}
+
+	var previousMinutes int32
+	if session.Total != nil {
+		previousMinutes = *session.Total
+	}
+	minutes := int32(now.Sub(session.Start).Minutes())
+	if err := s.activityRepo.UpdateActivity(ctx, session.ID, now, minutes); err != nil {
+		s.heartbeats.forget(userID)
+		return nil, err
+	}
+
+	result := &HeartbeatResult{Accepted: true, ActivityID: session.ID, SessionMinutes: minutes}
+	if minutes == previousMinutes {
+		return result, nil
+	}
+
+	totalMinutes, err := s.activityRepo.GetTotalActivityMinutes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	before := int64(totalMinutes) - int64(minutes-previousMinutes)
+	if models.ActivityHoursScore(before) != models.ActivityHoursScore(int64(totalMinutes)) {
+		if err := s.HourReached(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// lastSeen is when a session was last known active: its end, which each
+// heartbeat moves forward, or its start
+func lastSeen(session *models.UserActivity) time.Time {
+	if session.End != nil {
+		return *session.End
+	}
+	return session.Start
+}
+
+// heartbeatDebouncer remembers each user's last accepted heartbeat
+type heartbeatDebouncer struct {
+	mu   sync.Mutex
+	last map[uint64]time.Time
+}
+
+func newHeartbeatDebouncer() *heartbeatDebouncer {
+	return &heartbeatDebouncer{last: make(map[uint64]time.Time)}
+}
+
+// allow reports whether a heartbeat at now is far enough from the previous one
+func (d *heartbeatDebouncer) allow(userID uint64, now time.Time) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if last, ok := d.last[userID]; ok && now.Sub(last) < activityHeartbeatDebounce {
+		return false
+	}
+	if len(d.last) >= heartbeatSweepSize {
+		for id, last := range d.last {
+			if now.Sub(last) >= activityHeartbeatDebounce {
+				delete(d.last, id)
+			}
+		}
+	}
+	d.last[userID] = now
+	return true
+}
+
+// forget lets the next heartbeat through after a failed one
+func (d *heartbeatDebouncer) forget(userID uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.last, userID)
+}
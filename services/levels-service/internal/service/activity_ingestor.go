@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "metargb/shared/pb/levels"
+	"metargb/shared/pkg/activity"
+)
+
+// ActivityRecorder applies activity events to user logs and scores,
+// implemented by ActivityService
+type ActivityRecorder interface {
+	LogActivity(ctx context.Context, req *pb.LogActivityRequest) (uint64, error)
+	LogLogout(ctx context.Context, userID uint64, ip string) error
+	RecordTrade(ctx context.Context, userID uint64, irrAmount, pscAmount string) error
+	RecordDeposit(ctx context.Context, userID uint64, amount string) error
+	RecordFollower(ctx context.Context, userID uint64) error
+	RecordUserEvent(ctx context.Context, userID uint64, event, ip, device string) error
+}
+
+// ProcessedEvents remembers which activity events were already applied,
+// implemented by repository.ProcessedEventRepository
+type ProcessedEvents interface {
+	MarkProcessed(ctx context.Context, eventID, eventType string, userID uint64) (bool, error)
+	Unmark(ctx context.Context, eventID string) error
+}
+
+// OnboardingTracker completes onboarding checklist steps, implemented by
+// OnboardingService
+type OnboardingTracker interface {
+	CompleteStep(ctx context.Context, userID uint64, step string, at time.Time) error
+}
+
+// ActivityIngestor applies activity events read from the event bus exactly
+// once per event id
+type ActivityIngestor struct {
+	recorder   ActivityRecorder
+	processed  ProcessedEvents
+	onboarding OnboardingTracker
+}
+
+// NewActivityIngestor creates an ingestor. onboarding may be nil, events then
+// do not complete onboarding steps.
+func NewActivityIngestor(recorder ActivityRecorder, processed ProcessedEvents, onboarding OnboardingTracker) *ActivityIngestor {
+	return &ActivityIngestor{
+		recorder:   recorder,
+		processed:  processed,
+		onboarding: onboarding,
+	}
+}
+
+// Ingest applies an event unless it was already processed, and reports
+// whether it was applied. A failed event is forgotten again so redelivery
+// retries it.
+func (i *ActivityIngestor) Ingest(ctx context.Context, event activity.Event) (bool, error) {
+	if err := event.Validate(); err != nil {
+		return false, err
+	}
+
+	isNew, err := i.processed.MarkProcessed(ctx, event.ID, event.Type, event.UserID)
+	if err != nil || !isNew {
+		return false, err
+	}
+
+	if err := i.apply(ctx, event); err != nil {
+		if unmarkErr := i.processed.Unmark(ctx, event.ID); unmarkErr != nil {
+			return false, fmt.Errorf("failed to apply %s event %s: %v (and to unmark it: %w)", event.Type, event.ID, err, unmarkErr)
+		}
+		return false, fmt.Errorf("failed to apply %s event %s: %w", event.Type, event.ID, err)
+	}
+
+	return true, nil
+}
+
+func (i *ActivityIngestor) apply(ctx context.Context, event activity.Event) error {
+	if err := i.record(ctx, event); err != nil {
+		return err
+	}
+
+	if step, ok := OnboardingStep(event.Type); ok && i.onboarding != nil {
+		return i.onboarding.CompleteStep(ctx, event.UserID, step, event.OccurredAt)
+	}
+	return nil
+}
+
+// record applies an event to the user's activity history, log and score
+func (i *ActivityIngestor) record(ctx context.Context, event activity.Event) error {
+	switch event.Type {
+	case activity.TypeLogin:
+		_, err := i.recorder.LogActivity(ctx, &pb.LogActivityRequest{
+			UserId:    event.UserID,
+			EventType: "login",
+			Ip:        event.IP,
+			Device:    event.Device,
+		})
+		return err
+	case activity.TypeLogout:
+		return i.recorder.LogLogout(ctx, event.UserID, event.IP)
+	case activity.TypeTrade:
+		return i.recorder.RecordTrade(ctx, event.UserID, event.IRRAmount, event.PSCAmount)
+	case activity.TypeDeposit:
+		return i.recorder.RecordDeposit(ctx, event.UserID, event.Amount)
+	case activity.TypeFollow:
+		return i.recorder.RecordFollower(ctx, event.UserID)
+	case activity.TypeBuild:
+		return i.recorder.RecordUserEvent(ctx, event.UserID, "ساخت بنا", event.IP, event.Device) // Building constructed in Persian
+	case activity.TypeTutorialCompleted:
+		return i.recorder.RecordUserEvent(ctx, event.UserID, "تکمیل آموزش", event.IP, event.Device) // Tutorial completed in Persian
+	case activity.TypePhoneVerified, activity.TypeKYCVerified, activity.TypeLandPurchased, activity.TypeDynastyJoined:
+		// Only complete onboarding steps
+		return nil
+	}
+	return fmt.Errorf("%w: unknown type %q", activity.ErrInvalidEvent, event.Type)
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	"metargb/levels-service/internal/models"
 	"metargb/levels-service/internal/repository"
 	pb "metargb/shared/pb/levels"
 )
@@ -14,6 +15,7 @@ type ActivityService struct {
 	activityRepo *repository.ActivityRepository
 	userLogRepo  *repository.UserLogRepository
 	levelRepo    *repository.LevelRepository
+	heartbeats   *heartbeatDebouncer
 }
 
 func NewActivityService(
@@ -25,6 +27,7 @@ func NewActivityService(
 		activityRepo: activityRepo,
 		userLogRepo:  userLogRepo,
 		levelRepo:    levelRepo,
+		heartbeats:   newHeartbeatDebouncer(),
 	}
 }
 
@@ -48,6 +51,12 @@ func (s *ActivityService) LogActivity(ctx context.Context, req *pb.LogActivityRe
 	return activityID, nil
 }
 
+// RecordUserEvent records an activity that only appears in the user's event
+// history, such as constructing a building or completing a tutorial
+func (s *ActivityService) RecordUserEvent(ctx context.Context, userID uint64, event, ip, device string) error {
+	return s.activityRepo.CreateUserEvent(ctx, userID, event, ip, device, 1)
+}
+
 // GetUserActivities retrieves user's activity history
 func (s *ActivityService) GetUserActivities(ctx context.Context, userID uint64, limit int32) ([]*pb.UserActivity, *pb.UserLog, error) {
 	activities, err := s.activityRepo.FindByUserID(ctx, userID, limit)
@@ -131,9 +140,8 @@ func (s *ActivityService) RecordTrade(ctx context.Context, userID uint64, irrAmo
 	// Get PSC value rate to calculate minimum PSC amount
 	// Laravel: $psc_value = Variable::getRate('psc'); return 7000000 / $psc_value;
 	// For now, we'll use a default rate (TODO: query from variables table)
-	minIrrAmount := float64(7000000)
-	pscRate := float64(30000) // Default PSC rate
-	minPscAmount := minIrrAmount / pscRate
+	minIrrAmount := float64(models.SignificantTradeIRRAmount)
+	minPscAmount := models.SignificantTradePSCAmount(models.DefaultPSCRate)
 
 	// Check if this trade is significant
 	if irr < minIrrAmount && psc < minPscAmount {
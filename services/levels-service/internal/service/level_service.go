@@ -62,6 +62,14 @@ func (s *LevelService) GetUserLevel(ctx context.Context, userID uint64) (*pb.Use
 	}, nil
 }
 
+// MaxUserScoresBatch caps how many users GetUserScores reads at once
+const MaxUserScoresBatch = 1000
+
+// GetUserScores retrieves the current scores of many users
+func (s *LevelService) GetUserScores(ctx context.Context, userIDs []uint64) (map[uint64]int32, error) {
+	return s.userLogRepo.GetUserScores(ctx, userIDs)
+}
+
 // GetAllLevels retrieves all levels
 // Implements Laravel: LevelController@index
 func (s *LevelService) GetAllLevels(ctx context.Context) ([]*pb.Level, error) {
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"metargb/levels-service/internal/models"
+	pb "metargb/shared/pb/levels"
+	"metargb/shared/pkg/activity"
+)
+
+// DefaultOnboardingRewardAsset is the wallet asset the completion reward is
+// paid in unless ONBOARDING_REWARD_ASSET says otherwise
+const DefaultOnboardingRewardAsset = "psc"
+
+// onboardingEventSteps maps the activity events that complete a step to it
+var onboardingEventSteps = map[string]string{
+	activity.TypePhoneVerified: models.OnboardingVerifyPhone,
+	activity.TypeKYCVerified:   models.OnboardingCompleteKYC,
+	activity.TypeLandPurchased: models.OnboardingBuyFirstLand,
+	activity.TypeBuild:         models.OnboardingBuildFirstBuilding,
+	activity.TypeDynastyJoined: models.OnboardingJoinDynasty,
+}
+
+// OnboardingStore persists onboarding progress, implemented by
+// repository.OnboardingRepository
+type OnboardingStore interface {
+	CompleteStep(ctx context.Context, userID uint64, step string, at time.Time) (bool, error)
+	GetCompletedSteps(ctx context.Context, userID uint64) (map[string]time.Time, error)
+	GetReward(ctx context.Context, userID uint64) (*models.OnboardingReward, error)
+	CreateReward(ctx context.Context, userID uint64, asset string, amount float64) error
+	MarkRewardGranted(ctx context.Context, userID uint64, at time.Time) error
+}
+
+// WalletCreditor credits rewards to user wallets, implemented by
+// client.CommercialClient
+type WalletCreditor interface {
+	AddBalance(ctx context.Context, userID uint64, asset string, amount float64) error
+}
+
+// OnboardingService tracks the onboarding checklist and pays the completion
+// reward once every step is done
+type OnboardingService struct {
+	store        OnboardingStore
+	wallet       WalletCreditor
+	rewardAsset  string
+	rewardAmount float64
+	now          func() time.Time
+}
+
+// NewOnboardingService creates the onboarding service. No reward is paid
+// when rewardAmount is not positive or wallet is nil.
+func NewOnboardingService(store OnboardingStore, wallet WalletCreditor, rewardAsset string, rewardAmount float64) *OnboardingService {
+	if rewardAsset == "" {
+		rewardAsset = DefaultOnboardingRewardAsset
+	}
+	return &OnboardingService{
+		store:        store,
+		wallet:       wallet,
+		rewardAsset:  rewardAsset,
+		rewardAmount: rewardAmount,
+		now:          time.Now,
+	}
+}
+
+// OnboardingStep returns the step an activity event completes, if any
+func OnboardingStep(eventType string) (string, bool) {
+	step, ok := onboardingEventSteps[eventType]
+	return step, ok
+}
+
+// CompleteStep marks a step completed and pays the reward when it was the
+// last one. A failed payment returns an error so the event is retried.
+func (s *OnboardingService) CompleteStep(ctx context.Context, userID uint64, step string, at time.Time) error {
+	if at.IsZero() {
+		at = s.now()
+	}
+	if _, err := s.store.CompleteStep(ctx, userID, step, at); err != nil {
+		return err
+	}
+
+	if !s.rewardEnabled() {
+		return nil
+	}
+	completed, err := s.store.GetCompletedSteps(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !allStepsCompleted(completed) {
+		return nil
+	}
+	return s.grantReward(ctx, userID)
+}
+
+// GetOnboardingState returns the checklist with the user's progress
+func (s *OnboardingService) GetOnboardingState(ctx context.Context, userID uint64) (*pb.OnboardingStateResponse, error) {
+	completed, err := s.store.GetCompletedSteps(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.OnboardingStateResponse{
+		UserId:     userID,
+		TotalSteps: int32(len(models.OnboardingSteps)),
+	}
+	for _, def := range models.OnboardingSteps {
+		step := &pb.OnboardingStep{Key: def.Key, Title: def.Title}
+		if at, ok := completed[def.Key]; ok {
+			step.Completed = true
+			step.CompletedAt = at.Unix()
+			resp.CompletedSteps++
+		}
+		resp.Steps = append(resp.Steps, step)
+	}
+	resp.Completed = resp.CompletedSteps == resp.TotalSteps
+
+	reward, err := s.store.GetReward(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case reward != nil:
+		resp.Reward = &pb.OnboardingReward{Asset: reward.Asset, Amount: reward.Amount}
+		if reward.GrantedAt != nil {
+			resp.Reward.Granted = true
+			resp.Reward.GrantedAt = reward.GrantedAt.Unix()
+		}
+	case s.rewardEnabled():
+		resp.Reward = &pb.OnboardingReward{Asset: s.rewardAsset, Amount: s.rewardAmount}
+	}
+
+	return resp, nil
+}
+
+// grantReward records the reward before crediting it and marks it granted
+// after, so a redelivered event retries a failed payment but does not repeat
+// a successful one
+func (s *OnboardingService) grantReward(ctx context.Context, userID uint64) error {
+	if err := s.store.CreateReward(ctx, userID, s.rewardAsset, s.rewardAmount); err != nil {
+		return err
+	}
+	reward, err := s.store.GetReward(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if reward == nil || reward.GrantedAt != nil {
+		return nil
+	}
+
+	if err := s.wallet.AddBalance(ctx, userID, reward.Asset, reward.Amount); err != nil {
+		return fmt.Errorf("failed to pay onboarding reward to user %d: %w", userID, err)
+	}
+	return s.store.MarkRewardGranted(ctx, userID, s.now())
+}
+
+func (s *OnboardingService) rewardEnabled() bool {
+	return s.rewardAmount > 0 && s.wallet != nil
+}
+
+func allStepsCompleted(completed map[string]time.Time) bool {
+	for _, def := range models.OnboardingSteps {
+		if _, ok := completed[def.Key]; !ok {
+			return false
+		}
+	}
+	return true
+}
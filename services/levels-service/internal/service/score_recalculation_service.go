@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"metargb/levels-service/internal/models"
+	"metargb/levels-service/internal/repository"
+	pb "metargb/shared/pb/levels"
+)
+
+// DefaultScoreRecalculationChunkSize is how many users are recalculated per batch
+const DefaultScoreRecalculationChunkSize = 500
+
+// ScoreRecalculationOptions selects the users to recalculate
+type ScoreRecalculationOptions struct {
+	// UserID recalculates only this user when set
+	UserID uint64
+	// AfterUserID resumes an interrupted run after this user id
+	AfterUserID uint64
+	ChunkSize   int
+	// PSCRate is the IRR value of one PSC used to find significant trades
+	PSCRate float64
+	// Apply writes the recalculated scores; otherwise only the changes are reported
+	Apply bool
+}
+
+// ScoreChange is a user whose recalculated score differs from the stored one
+type ScoreChange struct {
+	UserID   uint64
+	Old      models.ScoreComponents
+	New      models.ScoreComponents
+	OldScore int32
+	NewScore int32
+	// ReachedLevel is the level the new score reaches that the user does not have yet
+	ReachedLevel *pb.Level
+}
+
+// ScoreRecalculationResult summarizes a recalculation
+type ScoreRecalculationResult struct {
+	UsersChecked  int
+	UsersChanged  int
+	LevelsReached int
+	// LastUserID is the last user checked, to resume from with AfterUserID
+	LastUserID uint64
+	Applied    bool
+}
+
+// ScoreRecalculationService rebuilds scores from the raw trade, follower and
+// activity records, to repair scores after a rule change or a bug fix
+type ScoreRecalculationService struct {
+	userLogRepo *repository.UserLogRepository
+	levelRepo   *repository.LevelRepository
+}
+
+func NewScoreRecalculationService(userLogRepo *repository.UserLogRepository, levelRepo *repository.LevelRepository) *ScoreRecalculationService {
+	return &ScoreRecalculationService{
+		userLogRepo: userLogRepo,
+		levelRepo:   levelRepo,
+	}
+}
+
+// Recalculate walks the users with a user log in id order, a chunk at a time,
+// and calls report for each user whose score changes. With Apply the new
+// components and score are written and a newly reached level is attached, as
+// UserObserver@calculateScore does. Levels are never removed and prizes are
+// left to ClaimPrize.
+func (s *ScoreRecalculationService) Recalculate(ctx context.Context, opts ScoreRecalculationOptions, report func(ScoreChange)) (*ScoreRecalculationResult, error) {
+	chunkSize := opts.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultScoreRecalculationChunkSize
+	}
+	minIrrAmount := float64(models.SignificantTradeIRRAmount)
+	minPscAmount := models.SignificantTradePSCAmount(opts.PSCRate)
+
+	result := &ScoreRecalculationResult{LastUserID: opts.AfterUserID, Applied: opts.Apply}
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		var userIDs []uint64
+		if opts.UserID != 0 {
+			userIDs = []uint64{opts.UserID}
+		} else {
+			var err error
+			userIDs, err = s.userLogRepo.ListScoredUserIDs(ctx, result.LastUserID, chunkSize)
+			if err != nil {
+				return result, err
+			}
+		}
+		if len(userIDs) == 0 {
+			return result, nil
+		}
+
+		sources, err := s.userLogRepo.GetScoreSources(ctx, userIDs, minIrrAmount, minPscAmount)
+		if err != nil {
+			return result, err
+		}
+		for _, userID := range userIDs {
+			source, ok := sources[userID]
+			if !ok {
+				continue
+			}
+			if err := s.recalculateUser(ctx, source, opts.Apply, result, report); err != nil {
+				return result, fmt.Errorf("user %d: %w", userID, err)
+			}
+			result.UsersChecked++
+			result.LastUserID = userID
+		}
+
+		if opts.UserID != 0 || len(userIDs) < chunkSize {
+			return result, nil
+		}
+	}
+}
+
+func (s *ScoreRecalculationService) recalculateUser(ctx context.Context, source *models.ScoreSources, apply bool, result *ScoreRecalculationResult, report func(ScoreChange)) error {
+	change := ScoreChange{
+		UserID:   source.UserID,
+		Old:      source.Stored,
+		New:      source.Components(),
+		OldScore: source.StoredScore,
+	}
+	change.NewScore = change.New.Score()
+
+	level, err := s.levelRepo.GetNextLevelForScore(ctx, source.UserID, change.NewScore)
+	if err != nil {
+		return fmt.Errorf("failed to find reached level: %w", err)
+	}
+	change.ReachedLevel = level
+
+	if change.ReachedLevel == nil && change.NewScore == change.OldScore && sameScoreComponents(change.Old, change.New) {
+		return nil
+	}
+
+	if apply {
+		if err := s.userLogRepo.SetScoreComponents(ctx, source.UserID, change.New); err != nil {
+			return fmt.Errorf("failed to write score: %w", err)
+		}
+		if change.ReachedLevel != nil {
+			if err := s.levelRepo.AttachLevelToUser(ctx, source.UserID, change.ReachedLevel.Id); err != nil {
+				return fmt.Errorf("failed to attach level: %w", err)
+			}
+		}
+	}
+
+	result.UsersChanged++
+	if change.ReachedLevel != nil {
+		result.LevelsReached++
+	}
+	if report != nil {
+		report(change)
+	}
+	return nil
+}
+
+// sameScoreComponents compares components at the precision user_logs keeps
+func sameScoreComponents(a, b models.ScoreComponents) bool {
+	const epsilon = 0.005
+	return math.Abs(a.Transactions-b.Transactions) < epsilon &&
+		math.Abs(a.Followers-b.Followers) < epsilon &&
+		math.Abs(a.Deposit-b.Deposit) < epsilon &&
+		math.Abs(a.ActivityHours-b.ActivityHours) < epsilon
+}
@@ -3,22 +3,43 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"metargb/notifications-service/internal/handler"
+	"metargb/notifications-service/internal/models"
+	"metargb/notifications-service/internal/pubsub"
 	"metargb/notifications-service/internal/repository"
 	"metargb/notifications-service/internal/service"
+	"metargb/notifications-service/templates"
+	"metargb/shared/pkg/buildinfo"
+	shareddb "metargb/shared/pkg/db"
+	"metargb/shared/pkg/dbsplit"
+	"metargb/shared/pkg/deadline"
+	"metargb/shared/pkg/debug"
+	"metargb/shared/pkg/devmode"
+	"metargb/shared/pkg/faultinject"
+	healthprobe "metargb/shared/pkg/health"
+	"metargb/shared/pkg/jobs"
+	"metargb/shared/pkg/logger"
+	"metargb/shared/pkg/metrics"
+	"metargb/shared/pkg/msgsize"
+	"metargb/shared/pkg/usercache"
 )
 
 func main() {
@@ -31,34 +52,48 @@ func main() {
 		"../../config.env",
 		"services/notifications-service/config.env",
 	}
-	var configLoaded bool
+	var loadedConfig string
 	for _, configPath := range configPaths {
 		if err := godotenv.Load(configPath); err == nil {
-			configLoaded = true
-			log.Printf("Loaded config from: %s", configPath)
+			loadedConfig = configPath
 			break
 		}
 	}
-	if !configLoaded {
-		// Fallback to .env if config.env not found
-		if err2 := godotenv.Load(); err2 != nil {
-			log.Printf("Warning: config.env and .env files not found, using environment variables only")
-		}
+	// Fallback to .env if config.env not found
+	var envErr error
+	if loadedConfig == "" {
+		envErr = godotenv.Load()
+	}
+
+	// Initialize logger once the environment is loaded so LOG_LEVEL applies
+	log := logger.NewLogger("notifications-service")
+	log.RedirectStdLog()
+	if loadedConfig != "" {
+		log.Info("Loaded config", "path", loadedConfig)
+	} else if envErr != nil {
+		log.Warn("config.env and .env files not found, using environment variables only")
 	}
 
-	db, err := setupDatabase()
+	// SIGHUP toggles debug logging; NOTIFICATIONS_LOG_ADMIN_ADDR serves the level over HTTP
+	logCtx, stopLogControl := context.WithCancel(context.Background())
+	defer stopLogControl()
+	log.EnableLevelControl(logCtx)
+
+	db, err := setupDatabase(log)
 	if err != nil {
-		log.Fatalf("Failed to prepare database connection: %v", err)
+		log.Fatal("Failed to prepare database connection", "error", err)
 	}
 	defer db.Close()
 
-	if err := pingDatabase(db); err != nil {
-		log.Fatalf("Failed to ping database: %v", err)
+	// Wait for the database instead of crash looping while it is unavailable
+	if err := shareddb.PingWithRetry(context.Background(), db, shareddb.RetryPolicyFromEnv()); err != nil {
+		log.Fatal("Failed to ping database", "error", err)
 	}
-	log.Println("Successfully connected to database")
+	log.Info("Successfully connected to database")
 
 	notificationRepo := repository.NewNotificationRepository(db)
-	smsChannel := service.NewSMSChannel()
+	preferenceRepo := repository.NewPreferenceRepository(db)
+	digestRepo := repository.NewDigestRepository(db)
 	emailChannel := service.NewEmailChannel()
 
 	// Verify SMS configuration
@@ -66,77 +101,231 @@ func main() {
 	smsApiKey := getEnv("SMS_API_KEY", "")
 	smsSender := getEnv("SMS_SENDER", "")
 	if smsProvider == "" || smsApiKey == "" {
-		log.Printf("WARNING: SMS not fully configured (SMS_PROVIDER=%s, SMS_API_KEY set=%v). SMS features will not work and will return 'not implemented' errors.", smsProvider, smsApiKey != "")
-		log.Printf("Please set SMS_PROVIDER and SMS_API_KEY environment variables or ensure config.env is loaded.")
+		log.Warn("SMS not fully configured - SMS features will return 'not implemented' errors. Set SMS_PROVIDER and SMS_API_KEY or ensure config.env is loaded.",
+			"provider", smsProvider, "api_key_set", smsApiKey != "")
 	} else {
-		log.Printf("SMS configured: provider=%s, sender=%s", smsProvider, smsSender)
+		log.Info("SMS configured", "provider", smsProvider, "sender", smsSender)
 	}
 
-	notificationService := service.NewNotificationService(notificationRepo, smsChannel, emailChannel)
+	// Every SMS passes the provider's rate cap, and bulk messages wait out the
+	// night, so large broadcasts do not get the sender line blocked
+	smsShaping := service.DefaultSMSShapingConfig(smsProvider)
+	smsShaping.MessagesPerSecond = getEnvAsFloat("SMS_MAX_PER_SECOND", smsShaping.MessagesPerSecond, log)
+	smsShaping.MaxWait = getEnvAsDuration("SMS_MAX_WAIT", smsShaping.MaxWait, log)
+	smsShaping.QuietHoursStart = getEnvAsInt("SMS_QUIET_HOURS_START", smsShaping.QuietHoursStart, log)
+	smsShaping.QuietHoursEnd = getEnvAsInt("SMS_QUIET_HOURS_END", smsShaping.QuietHoursEnd, log)
+	smsShaping.Timezone = getEnv("SMS_QUIET_HOURS_TIMEZONE", smsShaping.Timezone)
+	smsShaping.QueueSize = getEnvAsInt("SMS_QUEUE_SIZE", smsShaping.QueueSize, log)
+	shapedSMSChannel := service.NewShapedSMSChannel(service.NewSMSChannel(), smsShaping)
+	// Background jobs are timed, counted and listed on jobs.Path
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	backgroundJobs := jobs.NewRunner("notifications-service", log)
+	shapedSMSChannel.Register(backgroundJobs)
+	var smsChannel service.SMSChannel = shapedSMSChannel
+
+	// Users link a Telegram chat with a code issued in the app. OTPs and
+	// critical alerts reach that chat when SMS to their operator fails.
+	var bot service.BotChannel
+	var telegramBot *service.TelegramBot
+	if token := getEnv("TELEGRAM_BOT_TOKEN", ""); token != "" {
+		telegramBot = service.NewTelegramBot(token, getEnv("TELEGRAM_BOT_USERNAME", ""), getEnv("TELEGRAM_API_URL", ""))
+		bot = telegramBot
+		log.Info("Telegram bot configured", "username", getEnv("TELEGRAM_BOT_USERNAME", ""))
+	} else {
+		log.Warn("TELEGRAM_BOT_TOKEN is not set - bot notifications are disabled")
+	}
+	botLinkService := service.NewBotLinkService(repository.NewBotLinkRepository(db), bot,
+		getEnvAsDuration("BOT_LINK_CODE_TTL", service.DefaultBotLinkCodeTTL, log))
+	botRouting := models.BotRoutingRules{
+		PhonePrefixes:        splitList(getEnv("BOT_ROUTE_PHONE_PREFIXES", "")),
+		FallbackOnSMSFailure: getEnvAsBool("BOT_FALLBACK_ON_SMS_FAILURE", true, log),
+		CriticalAlerts:       getEnvAsBool("BOT_CRITICAL_ALERTS", true, log),
+	}
+	var criticalAlerts service.BotSender
+	if bot != nil {
+		smsChannel = service.NewBotRoutedSMSChannel(smsChannel, botLinkService, botRouting)
+		if botRouting.CriticalAlerts {
+			criticalAlerts = botLinkService
+		}
+	}
+
+	// New and read notifications are announced on Redis so auth-service drops
+	// the unread count it caches for /api/auth/me
+	var notificationEvents service.NotificationEventPublisher
+	if redisAddr := getEnv("REDIS_ADDR", ""); redisAddr != "" {
+		redisPublisher, err := pubsub.NewRedisPublisher(redisAddr, getEnv("REDIS_PASSWORD", ""), getEnvAsInt("REDIS_DB", 0, log))
+		if err != nil {
+			log.Warn("Failed to connect to Redis - notification changes will not be announced", "error", err)
+		} else {
+			defer redisPublisher.Close()
+			notificationEvents = redisPublisher
+		}
+	}
+
+	notificationService := service.NewNotificationService(notificationRepo, preferenceRepo, digestRepo, smsChannel, emailChannel, criticalAlerts, notificationEvents)
+	preferenceService := service.NewPreferenceService(preferenceRepo, digestRepo)
 	smsService := service.NewSMSService(smsChannel)
 	emailService := service.NewEmailService(emailChannel)
 
-	grpcServer := grpc.NewServer()
+	// NOTIFICATION_TEMPLATE_ADMIN_IDS may preview templates and send test copies
+	emailTemplates, err := service.LoadEmailTemplates(templates.FS)
+	if err != nil {
+		log.Error("Failed to load email templates, template previews are disabled", "error", err)
+	}
+	templateService := service.NewTemplateService(emailTemplates, smsChannel, emailChannel,
+		parseUserIDs(getEnv("NOTIFICATION_TEMPLATE_ADMIN_IDS", ""), log))
+
+	limits := msgsize.FromEnv("notifications-service", msgsize.Defaults())
+	serverOpts := append(limits.ServerOptions(),
+		grpc.ChainUnaryInterceptor(logger.UnaryServerInterceptor(log)),
+		grpc.ChainStreamInterceptor(logger.StreamServerInterceptor(log)),
+	)
+
+	// Cap how long handlers run so slow queries are cancelled, see deadline.FromEnv
+	serverOpts = append(serverOpts, deadline.FromEnv("notifications-service", deadline.Defaults()).ServerOptions()...)
+
+	// Staging builds inject latency and errors into chosen methods, see faultinject
+	faultCtx, stopFaults := context.WithCancel(context.Background())
+	defer stopFaults()
+	serverOpts = append(serverOpts, faultinject.FromEnv(faultCtx, "notifications-service", log)...)
+
+	// Outside development internal errors reach callers only as a reference, see devmode
+	serverOpts = append(serverOpts, devmode.FromEnv("notifications-service", log)...)
+
+	// Every response names the build that served it, see buildinfo
+	serverOpts = append(serverOpts, buildinfo.ServerOptions()...)
+	grpcServer := grpc.NewServer(serverOpts...)
+
+	// Report readiness over HTTP and through the standard gRPC health service:
+	// the database is reachable, its tables exist and the server has started
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	defer stopHealth()
+	dbMonitor := shareddb.NewHealthMonitor(db, nil)
+	dbMonitor.Start(healthCtx)
+	probe := healthprobe.NewProbe("notifications-service").
+		AddCheck("database", dbMonitor.Check).
+		AddCheck("migrations", shareddb.NewSchemaGuard(db).RequireTables(dbsplit.Ownership["notifications-service"]...))
+	serving := probe.Starting("grpc")
+	probe.Watch(healthCtx, healthServer, healthprobe.DefaultInterval)
+	probeServer := healthprobe.NewServer(":"+getEnv("HEALTH_PORT", healthprobe.DefaultPort), probe)
+	go func() {
+		if err := probeServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Health probe server failed", "error", err)
+		}
+	}()
+
+	// Serve Prometheus metrics, including the SMS queued, sent and throttled counters
+	metricsPort := getEnv("METRICS_PORT", "9090")
+	metricsServer := metrics.NewServer(":" + metricsPort)
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Metrics server failed", "error", err)
+		}
+	}()
+
+	// Send the hourly and daily digests of users who opted out of immediate delivery
+	digestWorker := service.NewDigestWorker(
+		digestRepo,
+		smsChannel,
+		emailChannel,
+		getEnvAsInt("DIGEST_DAILY_HOUR", service.DefaultDigestDailyHour, log),
+		getEnvAsDuration("DIGEST_INTERVAL", service.DefaultDigestInterval, log),
+	)
+	// Users who chose no digest timezone get the one in their account settings
+	authServiceAddr := getEnv("AUTH_SERVICE_ADDR", "auth-service:50051")
+	if authConn, err := grpc.Dial(authServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials())); err != nil {
+		log.Warn("Failed to connect to auth service - digests use the default timezone", "error", err)
+	} else {
+		defer authConn.Close()
+		digestWorker.SetLocations(usercache.NewFromConn(authConn, usercache.DefaultTTL))
+	}
+	digestWorker.Register(backgroundJobs)
+	backgroundJobs.Start(jobsCtx)
+
+	// Read link codes and /stop commands sent to the bot. Telegram hands
+	// updates to one poller, so TELEGRAM_POLL_UPDATES=false on other replicas.
+	botCtx, stopBot := context.WithCancel(context.Background())
+	defer stopBot()
+	if telegramBot != nil && getEnvAsBool("TELEGRAM_POLL_UPDATES", true, log) {
+		service.NewTelegramUpdatesWorker(telegramBot, botLinkService,
+			getEnvAsDuration("TELEGRAM_POLL_TIMEOUT", service.DefaultTelegramPollTimeout, log)).Start(botCtx)
+	}
 
 	handler.RegisterNotificationHandler(grpcServer, notificationService)
 	handler.RegisterSMSHandler(grpcServer, smsService)
 	handler.RegisterEmailHandler(grpcServer, emailService)
+	handler.RegisterPreferenceHandler(grpcServer, preferenceService)
+	handler.RegisterTemplateHandler(grpcServer, templateService)
+	handler.RegisterBotHandler(grpcServer, botLinkService)
+
+	// Reflection is only registered in development, see devmode
+	devmode.Register(grpcServer, log)
+
+	// Profiling endpoints, off unless DEBUG_ADDR is set or in development, see debug
+	debug.Serve(context.Background(), "notifications-service", log)
 
 	port := getEnv("GRPC_PORT", "50058")
 	listener, err := net.Listen("tcp", ":"+port)
 	if err != nil {
-		log.Fatalf("Failed to listen on port %s: %v", port, err)
+		log.Fatal("Failed to listen", "error", err, "port", port)
 	}
 
-	log.Printf("Notification service listening on port %s", port)
+	log.Info("Notification service listening", "port", port)
 
 	go func() {
 		if err := grpcServer.Serve(listener); err != nil {
-			log.Fatalf("Failed to serve gRPC: %v", err)
+			log.Fatal("Failed to serve gRPC", "error", err)
 		}
 	}()
+	serving()
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	log.Println("Shutting down server...")
+	log.Info("Shutting down server...")
+	stopBot()
+	probe.Drain()
+	healthServer.Shutdown()
 	grpcServer.GracefulStop()
-	log.Println("Server stopped")
+	stopJobs()
+	if pending := shapedSMSChannel.QueueLength(); pending > 0 {
+		log.Warn("Queued SMS messages were not sent before shutdown", "pending", pending)
+	}
+	metricsServer.Close()
+	probeServer.Close()
+	log.Info("Server stopped")
 }
 
-func setupDatabase() (*sql.DB, error) {
+func setupDatabase(log *logger.Logger) (*sql.DB, error) {
 	port, err := strconv.Atoi(getEnv("DB_PORT", "3306"))
 	if err != nil {
 		return nil, fmt.Errorf("invalid DB_PORT value: %w", err)
 	}
 
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
+	dsn := shareddb.ServiceDSN("notifications-service", fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
 		getEnv("DB_USER", "root"),
 		getEnv("DB_PASSWORD", ""),
 		getEnv("DB_HOST", "localhost"),
 		port,
 		getEnv("DB_DATABASE", "metargb_db"),
-	)
+	))
 
 	db, err := sql.Open("mysql", dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
-	db.SetMaxOpenConns(getEnvAsInt("DB_MAX_OPEN_CONNS", 25))
-	db.SetMaxIdleConns(getEnvAsInt("DB_MAX_IDLE_CONNS", 5))
-	db.SetConnMaxLifetime(getEnvAsDuration("DB_CONN_MAX_LIFETIME", 5*time.Minute))
+	db.SetMaxOpenConns(getEnvAsInt("DB_MAX_OPEN_CONNS", 25, log))
+	db.SetMaxIdleConns(getEnvAsInt("DB_MAX_IDLE_CONNS", 5, log))
+	db.SetConnMaxLifetime(getEnvAsDuration("DB_CONN_MAX_LIFETIME", 5*time.Minute, log))
 
 	return db, nil
 }
 
-func pingDatabase(db *sql.DB) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	return db.PingContext(ctx)
-}
-
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -144,7 +333,7 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
-func getEnvAsInt(key string, defaultValue int) int {
+func getEnvAsInt(key string, defaultValue int, log *logger.Logger) int {
 	valueStr := os.Getenv(key)
 	if valueStr == "" {
 		return defaultValue
@@ -152,13 +341,27 @@ func getEnvAsInt(key string, defaultValue int) int {
 
 	value, err := strconv.Atoi(valueStr)
 	if err != nil {
-		log.Printf("Invalid value for %s: %v, falling back to default %d", key, err, defaultValue)
+		log.Warn("Invalid "+key+", using default", "value", valueStr, "default", defaultValue)
+		return defaultValue
+	}
+	return value
+}
+
+func getEnvAsFloat(key string, defaultValue float64, log *logger.Logger) float64 {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.ParseFloat(valueStr, 64)
+	if err != nil {
+		log.Warn("Invalid "+key+", using default", "value", valueStr, "default", defaultValue)
 		return defaultValue
 	}
 	return value
 }
 
-func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+func getEnvAsDuration(key string, defaultValue time.Duration, log *logger.Logger) time.Duration {
 	valueStr := os.Getenv(key)
 	if valueStr == "" {
 		return defaultValue
@@ -166,8 +369,50 @@ func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 
 	value, err := time.ParseDuration(valueStr)
 	if err != nil {
-		log.Printf("Invalid duration for %s: %v, falling back to default %s", key, err, defaultValue)
+		log.Warn("Invalid "+key+", using default", "value", valueStr, "default", defaultValue)
+		return defaultValue
+	}
+	return value
+}
+
+func getEnvAsBool(key string, defaultValue bool, log *logger.Logger) bool {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.ParseBool(valueStr)
+	if err != nil {
+		log.Warn("Invalid "+key+", using default", "value", valueStr, "default", defaultValue)
 		return defaultValue
 	}
 	return value
 }
+
+// splitList splits a comma-separated value, dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			items = append(items, part)
+		}
+	}
+	return items
+}
+
+func parseUserIDs(value string, log *logger.Logger) []uint64 {
+	var ids []uint64
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			log.Warn("Ignoring invalid user id", "value", part)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -7,4 +7,26 @@ var (
 	ErrNotImplemented = errors.New("not implemented")
 	// ErrNotificationNotFound indicates that a notification was not found.
 	ErrNotificationNotFound = errors.New("notification not found")
+	// ErrInvalidChannel indicates an unknown notification channel.
+	ErrInvalidChannel = errors.New("invalid notification channel")
+	// ErrInvalidCategory indicates an unknown notification category.
+	ErrInvalidCategory = errors.New("invalid notification category")
+	// ErrInvalidDigestSettings indicates an unknown digest mode, timezone or quiet hour.
+	ErrInvalidDigestSettings = errors.New("invalid digest settings")
+	// ErrNotTemplateAdmin indicates the caller may not preview or test-send templates.
+	ErrNotTemplateAdmin = errors.New("only notification admins can preview and test-send templates")
+	// ErrTemplateNotFound indicates an unknown email template.
+	ErrTemplateNotFound = errors.New("template not found")
+	// ErrTemplateRender indicates a template could not be rendered with the given data.
+	ErrTemplateRender = errors.New("template could not be rendered")
+	// ErrInvalidTestRecipient indicates a missing or malformed test-send recipient or message.
+	ErrInvalidTestRecipient = errors.New("invalid test recipient")
+	// ErrSMSThrottled indicates an SMS was refused to keep the provider's sender line within its rate limits.
+	ErrSMSThrottled = errors.New("sms rate limit reached, try again later")
+	// ErrBotNotConfigured indicates no chat bot provider is configured.
+	ErrBotNotConfigured = errors.New("notification bot is not configured")
+	// ErrBotNotLinked indicates the user has not linked a bot chat.
+	ErrBotNotLinked = errors.New("no bot chat is linked")
+	// ErrBotChatUnavailable indicates the bot can no longer message the chat, e.g. the user blocked it.
+	ErrBotChatUnavailable = errors.New("bot chat is unavailable")
 )
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbCommon "metargb/shared/pb/common"
+	pb "metargb/shared/pb/notifications"
+
+	"metargb/notifications-service/internal/errs"
+	"metargb/notifications-service/internal/service"
+)
+
+// BotHandler implements the gRPC NotificationBotService.
+type BotHandler struct {
+	pb.UnimplementedNotificationBotServiceServer
+	service service.BotLinkService
+}
+
+// RegisterBotHandler registers the bot handler with the gRPC server.
+func RegisterBotHandler(grpcServer *grpc.Server, svc service.BotLinkService) {
+	handler := &BotHandler{service: svc}
+	pb.RegisterNotificationBotServiceServer(grpcServer, handler)
+}
+
+func (h *BotHandler) CreateBotLinkCode(ctx context.Context, req *pb.CreateBotLinkCodeRequest) (*pb.BotLinkCodeResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	code, err := h.service.CreateLinkCode(ctx, req.UserId)
+	if err != nil {
+		return nil, handleServiceError(err)
+	}
+
+	return &pb.BotLinkCodeResponse{
+		Provider:  code.Provider,
+		Code:      code.Code,
+		LinkUrl:   code.LinkURL,
+		ExpiresAt: code.ExpiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+func (h *BotHandler) GetBotLink(ctx context.Context, req *pb.GetBotLinkRequest) (*pb.BotLinkResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	link, err := h.service.GetLink(ctx, req.UserId)
+	if errors.Is(err, errs.ErrBotNotLinked) {
+		return &pb.BotLinkResponse{Linked: false}, nil
+	}
+	if err != nil {
+		return nil, handleServiceError(err)
+	}
+
+	return &pb.BotLinkResponse{
+		Linked:   true,
+		Provider: link.Provider,
+		Username: link.Username,
+		LinkedAt: link.LinkedAt.Format(time.RFC3339),
+	}, nil
+}
+
+func (h *BotHandler) UnlinkBot(ctx context.Context, req *pb.UnlinkBotRequest) (*pbCommon.Empty, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := h.service.Unlink(ctx, req.UserId); err != nil {
+		return nil, handleServiceError(err)
+	}
+
+	return &pbCommon.Empty{}, nil
+}
@@ -45,6 +45,7 @@ func (h *EmailHandler) SendEmail(ctx context.Context, req *pb.SendEmailRequest)
 		HTMLBody: req.HtmlBody,
 		CC:       req.Cc,
 		BCC:      req.Bcc,
+		Headers:  req.Headers,
 	}
 
 	messageID, err := h.service.SendEmail(ctx, payload)
@@ -44,6 +44,9 @@ func (h *NotificationHandler) SendNotification(ctx context.Context, req *pb.Send
 	if req.Message == "" {
 		return nil, status.Error(codes.InvalidArgument, "message is required")
 	}
+	if req.Category != "" && !models.IsValidCategory(req.Category) {
+		return nil, status.Error(codes.InvalidArgument, "invalid category")
+	}
 
 	input := service.SendNotificationInput{
 		UserID:    req.UserId,
@@ -53,6 +56,8 @@ func (h *NotificationHandler) SendNotification(ctx context.Context, req *pb.Send
 		Data:      req.Data,
 		SendSMS:   req.SendSms,
 		SendEmail: req.SendEmail,
+		Category:  req.Category,
+		Critical:  req.Critical,
 	}
 
 	result, err := h.service.SendNotification(ctx, input)
@@ -61,8 +66,11 @@ func (h *NotificationHandler) SendNotification(ctx context.Context, req *pb.Send
 	}
 
 	return &pb.NotificationResponse{
-		Id:   result.ID,
-		Sent: result.Sent,
+		Id:                 result.ID,
+		Sent:               result.Sent,
+		SuppressedChannels: result.SuppressedChannels,
+		DigestChannels:     result.DigestChannels,
+		SentToBot:          result.SentToBot,
 	}, nil
 }
 
@@ -187,8 +195,23 @@ func handleServiceError(err error) error {
 	if errors.Is(err, errs.ErrNotImplemented) {
 		return status.Error(codes.Unimplemented, err.Error())
 	}
-	if errors.Is(err, errs.ErrNotificationNotFound) {
+	if errors.Is(err, errs.ErrNotificationNotFound) || errors.Is(err, errs.ErrTemplateNotFound) || errors.Is(err, errs.ErrBotNotLinked) {
 		return status.Error(codes.NotFound, err.Error())
 	}
+	if errors.Is(err, errs.ErrInvalidChannel) || errors.Is(err, errs.ErrInvalidCategory) || errors.Is(err, errs.ErrInvalidDigestSettings) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, errs.ErrTemplateRender) || errors.Is(err, errs.ErrInvalidTestRecipient) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, errs.ErrNotTemplateAdmin) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	if errors.Is(err, errs.ErrSMSThrottled) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if errors.Is(err, errs.ErrBotNotConfigured) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
 	return status.Errorf(codes.Internal, "service error: %v", err)
 }
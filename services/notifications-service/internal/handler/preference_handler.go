@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "metargb/shared/pb/notifications"
+
+	"metargb/notifications-service/internal/models"
+	"metargb/notifications-service/internal/service"
+)
+
+// PreferenceHandler implements the gRPC NotificationPreferenceService.
+type PreferenceHandler struct {
+	pb.UnimplementedNotificationPreferenceServiceServer
+	service service.PreferenceService
+}
+
+// RegisterPreferenceHandler registers the preference handler with the gRPC server.
+func RegisterPreferenceHandler(grpcServer *grpc.Server, svc service.PreferenceService) {
+	handler := &PreferenceHandler{service: svc}
+	pb.RegisterNotificationPreferenceServiceServer(grpcServer, handler)
+}
+
+func (h *PreferenceHandler) GetPreferences(ctx context.Context, req *pb.GetPreferencesRequest) (*pb.PreferencesResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	prefs, err := h.service.GetPreferences(ctx, req.UserId)
+	if err != nil {
+		return nil, handleServiceError(err)
+	}
+
+	return convertPreferences(prefs), nil
+}
+
+func (h *PreferenceHandler) UpdatePreferences(ctx context.Context, req *pb.UpdatePreferencesRequest) (*pb.PreferencesResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	updates := make([]models.NotificationPreference, 0, len(req.Preferences))
+	for _, pref := range req.Preferences {
+		if pref == nil {
+			continue
+		}
+		updates = append(updates, models.NotificationPreference{
+			Channel:  pref.Channel,
+			Category: pref.Category,
+			Enabled:  pref.Enabled,
+		})
+	}
+
+	prefs, err := h.service.UpdatePreferences(ctx, req.UserId, updates)
+	if err != nil {
+		return nil, handleServiceError(err)
+	}
+
+	return convertPreferences(prefs), nil
+}
+
+func convertPreferences(prefs models.NotificationPreferences) *pb.PreferencesResponse {
+	list := prefs.List()
+	response := &pb.PreferencesResponse{
+		Preferences: make([]*pb.NotificationPreference, 0, len(list)),
+	}
+	for _, pref := range list {
+		response.Preferences = append(response.Preferences, &pb.NotificationPreference{
+			Channel:  pref.Channel,
+			Category: pref.Category,
+			Enabled:  pref.Enabled,
+		})
+	}
+	return response
+}
+
+func (h *PreferenceHandler) GetDigestSettings(ctx context.Context, req *pb.GetDigestSettingsRequest) (*pb.DigestSettingsResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	settings, err := h.service.GetDigestSettings(ctx, req.UserId)
+	if err != nil {
+		return nil, handleServiceError(err)
+	}
+
+	return convertDigestSettings(settings), nil
+}
+
+func (h *PreferenceHandler) UpdateDigestSettings(ctx context.Context, req *pb.UpdateDigestSettingsRequest) (*pb.DigestSettingsResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.Settings == nil {
+		return nil, status.Error(codes.InvalidArgument, "settings is required")
+	}
+
+	settings, err := h.service.UpdateDigestSettings(ctx, req.UserId, models.DigestSettings{
+		Mode:            req.Settings.Mode,
+		Timezone:        req.Settings.Timezone,
+		QuietHoursStart: int(req.Settings.QuietHoursStart),
+		QuietHoursEnd:   int(req.Settings.QuietHoursEnd),
+	})
+	if err != nil {
+		return nil, handleServiceError(err)
+	}
+
+	return convertDigestSettings(settings), nil
+}
+
+func convertDigestSettings(settings models.DigestSettings) *pb.DigestSettingsResponse {
+	return &pb.DigestSettingsResponse{
+		Settings: &pb.DigestSettings{
+			Mode:            settings.Mode,
+			Timezone:        settings.Timezone,
+			QuietHoursStart: int32(settings.QuietHoursStart),
+			QuietHoursEnd:   int32(settings.QuietHoursEnd),
+		},
+	}
+}
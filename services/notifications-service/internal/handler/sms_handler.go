@@ -40,6 +40,8 @@ func (h *SMSHandler) SendSMS(ctx context.Context, req *pb.SendSMSRequest) (*pb.S
 		Message:  req.Message,
 		Template: req.Template,
 		Tokens:   req.Tokens,
+		UserID:   req.UserId,
+		Critical: req.Critical,
 	}
 
 	messageID, err := h.service.SendSMS(ctx, payload)
@@ -47,11 +49,16 @@ func (h *SMSHandler) SendSMS(ctx context.Context, req *pb.SendSMSRequest) (*pb.S
 		return nil, handleSMSError(err)
 	}
 
-	return &pb.SMSResponse{
-		Sent:      true,
-		MessageId: messageID,
-		Status:    "queued",
-	}, nil
+	// Bulk messages sent during quiet hours go out when they end
+	if service.IsQueuedSMS(messageID) {
+		return &pb.SMSResponse{
+			Sent:      false,
+			MessageId: messageID,
+			Status:    "scheduled",
+		}, nil
+	}
+
+	return smsResponse(messageID), nil
 }
 
 func (h *SMSHandler) SendOTP(ctx context.Context, req *pb.SendOTPRequest) (*pb.SMSResponse, error) {
@@ -66,6 +73,7 @@ func (h *SMSHandler) SendOTP(ctx context.Context, req *pb.SendOTPRequest) (*pb.S
 		Phone:  req.Phone,
 		Code:   req.Code,
 		Reason: req.Reason,
+		UserID: req.UserId,
 	}
 
 	messageID, err := h.service.SendOTP(ctx, payload)
@@ -73,16 +81,31 @@ func (h *SMSHandler) SendOTP(ctx context.Context, req *pb.SendOTPRequest) (*pb.S
 		return nil, handleSMSError(err)
 	}
 
+	return smsResponse(messageID), nil
+}
+
+// smsResponse reports messages delivered through a bot chat with the "bot" status
+func smsResponse(messageID string) *pb.SMSResponse {
+	if service.IsBotMessage(messageID) {
+		return &pb.SMSResponse{
+			Sent:      true,
+			MessageId: messageID,
+			Status:    "bot",
+		}
+	}
 	return &pb.SMSResponse{
 		Sent:      true,
 		MessageId: messageID,
 		Status:    "queued",
-	}, nil
+	}
 }
 
 func handleSMSError(err error) error {
 	if errors.Is(err, errs.ErrNotImplemented) {
 		return status.Error(codes.FailedPrecondition, "SMS service is not configured. Please set SMS_PROVIDER and SMS_API_KEY environment variables.")
 	}
+	if errors.Is(err, errs.ErrSMSThrottled) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
 	return status.Errorf(codes.Internal, "sms service error: %v", err)
 }
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "metargb/shared/pb/notifications"
+
+	"metargb/notifications-service/internal/models"
+	"metargb/notifications-service/internal/service"
+)
+
+// TemplateHandler implements the gRPC NotificationTemplateService.
+type TemplateHandler struct {
+	pb.UnimplementedNotificationTemplateServiceServer
+	service service.TemplateService
+}
+
+// RegisterTemplateHandler registers the template handler with the gRPC server.
+func RegisterTemplateHandler(grpcServer *grpc.Server, svc service.TemplateService) {
+	handler := &TemplateHandler{service: svc}
+	pb.RegisterNotificationTemplateServiceServer(grpcServer, handler)
+}
+
+func (h *TemplateHandler) ListTemplates(ctx context.Context, req *pb.ListTemplatesRequest) (*pb.TemplatesResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	templates, err := h.service.ListTemplates(ctx, req.UserId)
+	if err != nil {
+		return nil, handleServiceError(err)
+	}
+
+	return &pb.TemplatesResponse{Templates: templates}, nil
+}
+
+func (h *TemplateHandler) PreviewTemplate(ctx context.Context, req *pb.PreviewTemplateRequest) (*pb.TemplatePreviewResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.Template == "" {
+		return nil, status.Error(codes.InvalidArgument, "template is required")
+	}
+
+	preview, err := h.service.PreviewTemplate(ctx, req.UserId, models.TemplateRender{
+		Template: req.Template,
+		Subject:  req.Subject,
+		Data:     req.Data,
+	})
+	if err != nil {
+		return nil, handleServiceError(err)
+	}
+
+	return &pb.TemplatePreviewResponse{
+		Template:     preview.Template,
+		Subject:      preview.Subject,
+		HtmlBody:     preview.HTMLBody,
+		Fields:       preview.Fields,
+		SampleFields: preview.SampleFields,
+	}, nil
+}
+
+func (h *TemplateHandler) TestSendTemplate(ctx context.Context, req *pb.TestSendTemplateRequest) (*pb.TestSendTemplateResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.Email != "" && req.Template == "" {
+		return nil, status.Error(codes.InvalidArgument, "template is required to send an email")
+	}
+
+	result, err := h.service.TestSendTemplate(ctx, req.UserId, models.TemplateTestSend{
+		TemplateRender: models.TemplateRender{
+			Template: req.Template,
+			Subject:  req.Subject,
+			Data:     req.Data,
+		},
+		Email:       req.Email,
+		Phone:       req.Phone,
+		SMSTemplate: req.SmsTemplate,
+		SMSMessage:  req.SmsMessage,
+	})
+	if err != nil {
+		return nil, handleServiceError(err)
+	}
+
+	return &pb.TestSendTemplateResponse{
+		EmailMessageId: result.EmailMessageID,
+		SmsMessageId:   result.SMSMessageID,
+	}, nil
+}
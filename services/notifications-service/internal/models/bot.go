@@ -0,0 +1,74 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Chat bot providers a user can link.
+const (
+	BotProviderTelegram = "telegram"
+)
+
+// BotLink connects a user to the bot chat their alerts are sent to.
+type BotLink struct {
+	UserID   uint64
+	Provider string
+	ChatID   string
+	Username string
+	LinkedAt time.Time
+}
+
+// BotLinkCode is issued in the app and sent to the bot to link the chat it
+// was sent from.
+type BotLinkCode struct {
+	UserID    uint64
+	Provider  string
+	Code      string
+	LinkURL   string
+	ExpiresAt time.Time
+}
+
+// BotMessage is a message a user sent to the bot.
+type BotMessage struct {
+	ChatID   string
+	Username string
+	Text     string
+}
+
+// BotRoutingRules decide when OTPs and critical messages are sent through a
+// linked bot chat instead of, or after, SMS.
+type BotRoutingRules struct {
+	// PhonePrefixes lists the prefixes (e.g. 0990) of operators whose SMS
+	// delivery is unreliable; their users get the bot first.
+	PhonePrefixes []string
+	// FallbackOnSMSFailure sends through the bot when the SMS provider fails.
+	FallbackOnSMSFailure bool
+	// CriticalAlerts copies critical notifications to the bot chat.
+	CriticalAlerts bool
+}
+
+// PrefersBot reports whether phone belongs to an operator routed to the bot first.
+func (r BotRoutingRules) PrefersBot(phone string) bool {
+	phone = NormalizePhone(phone)
+	for _, prefix := range r.PhonePrefixes {
+		if prefix != "" && strings.HasPrefix(phone, NormalizePhone(prefix)) {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizePhone rewrites Iranian numbers given as +98912... or 98912... to 0912...
+func NormalizePhone(phone string) string {
+	phone = strings.TrimSpace(phone)
+	switch {
+	case strings.HasPrefix(phone, "+98"):
+		return "0" + phone[3:]
+	case strings.HasPrefix(phone, "0098"):
+		return "0" + phone[4:]
+	case strings.HasPrefix(phone, "98") && len(phone) == 12:
+		return "0" + phone[2:]
+	}
+	return phone
+}
@@ -0,0 +1,32 @@
+package models
+
+import "testing"
+
+func TestBotRoutingRulesPrefersBot(t *testing.T) {
+	rules := BotRoutingRules{PhonePrefixes: []string{"0990", "+98991"}}
+
+	tests := []struct {
+		name  string
+		phone string
+		want  bool
+	}{
+		{"listed operator", "09901234567", true},
+		{"international format", "+989901234567", true},
+		{"country code without plus", "989911234567", true},
+		{"prefix given in international format", "09911234567", true},
+		{"other operator", "09121234567", false},
+		{"empty phone", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rules.PrefersBot(tt.phone); got != tt.want {
+				t.Errorf("PrefersBot(%q) = %v, want %v", tt.phone, got, tt.want)
+			}
+		})
+	}
+
+	if (BotRoutingRules{}).PrefersBot("09901234567") {
+		t.Error("no prefixes should route nobody to the bot")
+	}
+}
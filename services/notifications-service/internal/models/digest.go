@@ -0,0 +1,118 @@
+package models
+
+import (
+	"time"
+
+	"metargb/shared/pkg/tz"
+)
+
+// Digest modes. Immediate sends every notification as it happens.
+const (
+	DigestModeImmediate = "immediate"
+	DigestModeHourly    = "hourly"
+	DigestModeDaily     = "daily"
+)
+
+// DefaultDigestTimezone applies to users who chose a timezone neither for
+// their digests nor in their account settings.
+const DefaultDigestTimezone = tz.Default
+
+// DigestModes lists every digest mode in display order.
+var DigestModes = []string{DigestModeImmediate, DigestModeHourly, DigestModeDaily}
+
+// DigestSettings controls whether a user's categorized SMS and email
+// notifications are sent immediately or collected into a digest.
+type DigestSettings struct {
+	Mode string
+	// Timezone is empty to follow the timezone of the user's account settings
+	Timezone string
+	// QuietHoursStart and QuietHoursEnd are hours (0-23) in Timezone during
+	// which no digest is sent. Equal values disable quiet hours.
+	QuietHoursStart int
+	QuietHoursEnd   int
+	LastSentAt      *time.Time
+}
+
+// DefaultDigestSettings returns the settings applied before a user changes anything.
+func DefaultDigestSettings() DigestSettings {
+	return DigestSettings{
+		Mode: DigestModeImmediate,
+	}
+}
+
+// Enabled reports whether notifications are queued for a digest.
+func (s DigestSettings) Enabled() bool {
+	return s.Mode == DigestModeHourly || s.Mode == DigestModeDaily
+}
+
+// Location returns the user's timezone, falling back to the default one.
+func (s DigestSettings) Location() *time.Location {
+	return tz.Load(s.Timezone)
+}
+
+// InQuietHours reports whether now falls in the user's quiet hours.
+func (s DigestSettings) InQuietHours(now time.Time) bool {
+	return tz.InHours(now, s.Location(), s.QuietHoursStart, s.QuietHoursEnd)
+}
+
+// DigestDue reports whether a digest should be sent now for notifications
+// queued since oldestQueued. Hourly digests go out an hour after the previous
+// digest or the first queued notification; daily digests go out once a day from
+// dailyHour in the user's timezone. No digest is sent during quiet hours.
+func (s DigestSettings) DigestDue(now, oldestQueued time.Time, dailyHour int) bool {
+	if s.InQuietHours(now) {
+		return false
+	}
+
+	switch s.Mode {
+	case DigestModeHourly:
+		since := oldestQueued
+		if s.LastSentAt != nil && s.LastSentAt.After(since) {
+			since = *s.LastSentAt
+		}
+		return !now.Before(since.Add(time.Hour))
+	case DigestModeDaily:
+		local := now.In(s.Location())
+		if local.Hour() < dailyHour {
+			return false
+		}
+		if s.LastSentAt == nil {
+			return true
+		}
+		last := s.LastSentAt.In(s.Location())
+		return last.Year() != local.Year() || last.YearDay() != local.YearDay()
+	default:
+		// Notifications queued before the user switched back to immediate
+		return true
+	}
+}
+
+// IsValidDigestMode reports whether mode is a known digest mode.
+func IsValidDigestMode(mode string) bool {
+	for _, m := range DigestModes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// DigestItem is a notification queued for a user's next digest.
+type DigestItem struct {
+	ID       uint64
+	UserID   uint64
+	Channel  string
+	Category string
+	Title    string
+	Message  string
+	// Recipient is the phone number or email address the notification was meant for
+	Recipient string
+	CreatedAt time.Time
+}
+
+// PendingDigest summarises the queued notifications of one user.
+type PendingDigest struct {
+	UserID       uint64
+	Settings     DigestSettings
+	OldestQueued time.Time
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDigestSettingsInQuietHours(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end int
+		hour       int
+		want       bool
+	}{
+		{"disabled", 0, 0, 3, false},
+		{"inside same-day range", 13, 15, 14, true},
+		{"end is exclusive", 13, 15, 15, false},
+		{"before midnight", 22, 7, 23, true},
+		{"after midnight", 22, 7, 6, true},
+		{"outside overnight range", 22, 7, 12, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := DigestSettings{Mode: DigestModeDaily, Timezone: "UTC", QuietHoursStart: tt.start, QuietHoursEnd: tt.end}
+			now := time.Date(2024, 5, 1, tt.hour, 30, 0, 0, time.UTC)
+			if got := settings.InQuietHours(now); got != tt.want {
+				t.Errorf("InQuietHours(%02d:30) = %v, want %v", tt.hour, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigestSettingsDigestDue(t *testing.T) {
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	yesterday := now.Add(-24 * time.Hour)
+	earlierToday := now.Add(-time.Hour)
+	halfHourAgo := now.Add(-30 * time.Minute)
+
+	tests := []struct {
+		name         string
+		settings     DigestSettings
+		oldestQueued time.Time
+		dailyHour    int
+		want         bool
+	}{
+		{"immediate flushes leftovers", DigestSettings{Mode: DigestModeImmediate, Timezone: "UTC"}, now, 9, true},
+		{"hourly waits an hour after first item", DigestSettings{Mode: DigestModeHourly, Timezone: "UTC"}, halfHourAgo, 9, false},
+		{"hourly due after an hour", DigestSettings{Mode: DigestModeHourly, Timezone: "UTC"}, earlierToday, 9, true},
+		{"hourly waits an hour after last digest", DigestSettings{Mode: DigestModeHourly, Timezone: "UTC", LastSentAt: &halfHourAgo}, yesterday, 9, false},
+		{"daily before send hour", DigestSettings{Mode: DigestModeDaily, Timezone: "UTC"}, yesterday, 11, false},
+		{"daily never sent", DigestSettings{Mode: DigestModeDaily, Timezone: "UTC"}, yesterday, 9, true},
+		{"daily already sent today", DigestSettings{Mode: DigestModeDaily, Timezone: "UTC", LastSentAt: &earlierToday}, halfHourAgo, 9, false},
+		{"daily sent yesterday", DigestSettings{Mode: DigestModeDaily, Timezone: "UTC", LastSentAt: &yesterday}, earlierToday, 9, true},
+		{"quiet hours hold digest", DigestSettings{Mode: DigestModeHourly, Timezone: "UTC", QuietHoursStart: 8, QuietHoursEnd: 12}, yesterday, 9, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.settings.DigestDue(now, tt.oldestQueued, tt.dailyHour); got != tt.want {
+				t.Errorf("DigestDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigestSettingsDailyUsesUserTimezone(t *testing.T) {
+	settings := DigestSettings{Mode: DigestModeDaily, Timezone: "Asia/Tehran"}
+	// 06:00 UTC is 09:30 in Tehran
+	now := time.Date(2024, 1, 10, 6, 0, 0, 0, time.UTC)
+	if !settings.DigestDue(now, now.Add(-time.Hour), 9) {
+		t.Error("daily digest should be due at 09:30 Tehran time")
+	}
+	if settings.DigestDue(now, now.Add(-time.Hour), 10) {
+		t.Error("daily digest should not be due before 10:00 Tehran time")
+	}
+}
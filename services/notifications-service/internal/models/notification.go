@@ -19,6 +19,12 @@ type Notification struct {
 type NotificationResult struct {
 	ID   uint64
 	Sent bool
+	// SuppressedChannels lists channels skipped because the user opted out
+	SuppressedChannels []string
+	// DigestChannels lists channels queued for the user's digest instead of sent
+	DigestChannels []string
+	// SentToBot reports whether a critical notification reached the user's bot chat
+	SentToBot bool
 }
 
 // NotificationFilter defines pagination and filtering information when querying notifications.
@@ -34,6 +40,10 @@ type SMSPayload struct {
 	Message  string
 	Template string
 	Tokens   map[string]string
+	// UserID and Critical let the message go through the user's linked bot
+	// chat when SMS cannot reach them; only critical messages are rerouted
+	UserID   uint64
+	Critical bool
 }
 
 // OTPPayload contains information needed to send an OTP via SMS.
@@ -41,6 +51,8 @@ type OTPPayload struct {
 	Phone  string
 	Code   string
 	Reason string
+	// UserID lets the code go through the user's linked bot chat
+	UserID uint64
 }
 
 // EmailPayload contains the minimal information required to send an email.
@@ -51,4 +63,6 @@ type EmailPayload struct {
 	HTMLBody string
 	CC       []string
 	BCC      []string
+	// Headers holds extra headers, e.g. Message-ID and In-Reply-To for threaded replies
+	Headers map[string]string
 }
@@ -0,0 +1,95 @@
+package models
+
+// Notification delivery channels.
+const (
+	ChannelSMS   = "sms"
+	ChannelEmail = "email"
+	ChannelPush  = "push"
+	ChannelInApp = "in_app"
+)
+
+// Notification categories that users can opt out of per channel.
+const (
+	CategoryMarketplace = "marketplace"
+	CategoryDynasty     = "dynasty"
+	CategorySupport     = "support"
+	CategoryMarketing   = "marketing"
+)
+
+// NotificationChannels lists every channel in display order.
+var NotificationChannels = []string{ChannelSMS, ChannelEmail, ChannelPush, ChannelInApp}
+
+// NotificationCategories lists every category in display order.
+var NotificationCategories = []string{CategoryMarketplace, CategoryDynasty, CategorySupport, CategoryMarketing}
+
+// NotificationPreference records whether a category is delivered over a channel.
+type NotificationPreference struct {
+	Channel  string
+	Category string
+	Enabled  bool
+}
+
+// NotificationPreferences maps channel -> category -> enabled.
+type NotificationPreferences map[string]map[string]bool
+
+// DefaultNotificationPreferences returns the matrix applied before a user changes anything.
+// Every category is enabled on every channel, matching delivery before preferences existed.
+func DefaultNotificationPreferences() NotificationPreferences {
+	prefs := make(NotificationPreferences, len(NotificationChannels))
+	for _, channel := range NotificationChannels {
+		prefs[channel] = make(map[string]bool, len(NotificationCategories))
+		for _, category := range NotificationCategories {
+			prefs[channel][category] = true
+		}
+	}
+	return prefs
+}
+
+// Allows reports whether category may be delivered over channel. Unknown or
+// empty categories are not subject to preferences (e.g. security and OTP messages).
+func (p NotificationPreferences) Allows(channel, category string) bool {
+	categories, ok := p[channel]
+	if !ok {
+		return true
+	}
+	enabled, ok := categories[category]
+	if !ok {
+		return true
+	}
+	return enabled
+}
+
+// List flattens the matrix in display order.
+func (p NotificationPreferences) List() []NotificationPreference {
+	list := make([]NotificationPreference, 0, len(NotificationChannels)*len(NotificationCategories))
+	for _, channel := range NotificationChannels {
+		for _, category := range NotificationCategories {
+			list = append(list, NotificationPreference{
+				Channel:  channel,
+				Category: category,
+				Enabled:  p.Allows(channel, category),
+			})
+		}
+	}
+	return list
+}
+
+// IsValidChannel reports whether channel is a known delivery channel.
+func IsValidChannel(channel string) bool {
+	for _, c := range NotificationChannels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidCategory reports whether category is a known notification category.
+func IsValidCategory(category string) bool {
+	for _, c := range NotificationCategories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
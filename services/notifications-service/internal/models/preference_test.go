@@ -0,0 +1,44 @@
+package models
+
+import "testing"
+
+func TestNotificationPreferencesAllows(t *testing.T) {
+	prefs := DefaultNotificationPreferences()
+	prefs[ChannelSMS][CategoryMarketing] = false
+
+	tests := []struct {
+		name     string
+		channel  string
+		category string
+		want     bool
+	}{
+		{"default enabled", ChannelEmail, CategoryMarketing, true},
+		{"opted out", ChannelSMS, CategoryMarketing, false},
+		{"other category on same channel", ChannelSMS, CategoryDynasty, true},
+		{"empty category bypasses preferences", ChannelSMS, "", true},
+		{"unknown channel", "fax", CategoryMarketing, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := prefs.Allows(tt.channel, tt.category); got != tt.want {
+				t.Errorf("Allows(%q, %q) = %v, want %v", tt.channel, tt.category, got, tt.want)
+			}
+		})
+	}
+
+	var none NotificationPreferences
+	if !none.Allows(ChannelSMS, CategoryMarketing) {
+		t.Error("nil preferences should allow every channel")
+	}
+}
+
+func TestNotificationPreferencesList(t *testing.T) {
+	list := DefaultNotificationPreferences().List()
+	if want := len(NotificationChannels) * len(NotificationCategories); len(list) != want {
+		t.Fatalf("List() returned %d entries, want %d", len(list), want)
+	}
+	if list[0].Channel != ChannelSMS || list[0].Category != CategoryMarketplace || !list[0].Enabled {
+		t.Errorf("unexpected first entry: %+v", list[0])
+	}
+}
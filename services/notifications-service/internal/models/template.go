@@ -0,0 +1,36 @@
+package models
+
+// TemplateRender selects an email template and the data it is rendered with.
+type TemplateRender struct {
+	Template string
+	Subject  string
+	// Data holds the template fields. Dotted keys such as Assets.LogoURL fill nested fields.
+	Data map[string]string
+}
+
+// TemplatePreview is a rendered email template.
+type TemplatePreview struct {
+	Template string
+	Subject  string
+	HTMLBody string
+	// Fields lists the top-level fields the template reads
+	Fields []string
+	// SampleFields lists the fields that were not given and were filled with sample values
+	SampleFields []string
+}
+
+// TemplateTestSend sends a rendered email template and/or an SMS to a single recipient.
+type TemplateTestSend struct {
+	TemplateRender
+	Email string
+	Phone string
+	// SMSTemplate is a Kavenegar template name, sent with Data as its tokens
+	SMSTemplate string
+	SMSMessage  string
+}
+
+// TemplateTestResult holds the provider message IDs of a test send.
+type TemplateTestResult struct {
+	EmailMessageID string
+	SMSMessageID   string
+}
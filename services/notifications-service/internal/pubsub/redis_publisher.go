@@ -0,0 +1,57 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/redis/go-redis/v9/maintnotifications"
+)
+
+// NotificationsChangedChannel is the Redis channel announcing that a user's
+// in-app notifications were created or read. auth-service drops the unread
+// count it caches for the user when it sees one.
+const NotificationsChangedChannel = "notifications-changed"
+
+// RedisPublisher publishes notification events to Redis
+type RedisPublisher struct {
+	client *redis.Client
+}
+
+// NewRedisPublisher connects to the Redis at addr and checks the connection
+func NewRedisPublisher(addr, password string, db int) (*RedisPublisher, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:     addr,
+		Password: password,
+		DB:       db,
+		// Disable maint notifications to avoid warning about maint_notifications command
+		MaintNotificationsConfig: &maintnotifications.Config{
+			Mode: maintnotifications.ModeDisabled,
+		},
+	})
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	return &RedisPublisher{client: client}, nil
+}
+
+// PublishNotificationsChanged announces that userID's notifications changed
+func (p *RedisPublisher) PublishNotificationsChanged(ctx context.Context, userID uint64) error {
+	payload, err := json.Marshal(map[string]uint64{"user_id": userID})
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	if err := p.client.Publish(ctx, NotificationsChangedChannel, payload).Err(); err != nil {
+		return fmt.Errorf("failed to publish to Redis: %w", err)
+	}
+	return nil
+}
+
+// Close closes the Redis connection
+func (p *RedisPublisher) Close() error {
+	return p.client.Close()
+}
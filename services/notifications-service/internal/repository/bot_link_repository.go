@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"metargb/notifications-service/internal/models"
+)
+
+// BotLinkRepository handles database interactions for bot chat links.
+type BotLinkRepository struct {
+	db *sql.DB
+}
+
+// NewBotLinkRepository creates a new repository instance.
+func NewBotLinkRepository(db *sql.DB) *BotLinkRepository {
+	return &BotLinkRepository{
+		db: db,
+	}
+}
+
+// CreateLinkCode stores a link code and drops the user's earlier codes for the provider.
+func (r *BotLinkRepository) CreateLinkCode(ctx context.Context, code *models.BotLinkCode) error {
+	if r.db == nil {
+		return fmt.Errorf("database connection is nil")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx,
+		"DELETE FROM notification_bot_link_codes WHERE user_id = ? AND provider = ?",
+		code.UserID, code.Provider,
+	); err != nil {
+		return fmt.Errorf("failed to delete previous bot link codes: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO notification_bot_link_codes (user_id, provider, code, expires_at, created_at)
+		VALUES (?, ?, ?, ?, NOW())
+	`, code.UserID, code.Provider, code.Code, code.ExpiresAt); err != nil {
+		return fmt.Errorf("failed to insert bot link code: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// ConsumeLinkCode deletes an unexpired code and returns its user, or 0 when
+// the code is unknown, expired or was consumed concurrently.
+func (r *BotLinkRepository) ConsumeLinkCode(ctx context.Context, provider, code string, now time.Time) (uint64, error) {
+	if r.db == nil {
+		return 0, fmt.Errorf("database connection is nil")
+	}
+
+	var id, userID uint64
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, user_id
+		FROM notification_bot_link_codes
+		WHERE provider = ? AND code = ? AND expires_at > ?
+	`, provider, code, now).Scan(&id, &userID)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to query bot link code: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, "DELETE FROM notification_bot_link_codes WHERE id = ?", id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete bot link code: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return 0, nil
+	}
+
+	return userID, nil
+}
+
+// UpsertLink links the chat to the user, replacing the user's previous chat.
+func (r *BotLinkRepository) UpsertLink(ctx context.Context, link *models.BotLink) error {
+	if r.db == nil {
+		return fmt.Errorf("database connection is nil")
+	}
+
+	query := `
+		INSERT INTO notification_bot_links (user_id, provider, chat_id, username, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			chat_id = VALUES(chat_id),
+			username = VALUES(username),
+			created_at = VALUES(created_at),
+			updated_at = VALUES(updated_at)
+	`
+
+	username := sql.NullString{String: link.Username, Valid: link.Username != ""}
+	if _, err := r.db.ExecContext(ctx, query,
+		link.UserID, link.Provider, link.ChatID, username, link.LinkedAt, link.LinkedAt,
+	); err != nil {
+		return fmt.Errorf("failed to save bot link: %w", err)
+	}
+
+	return nil
+}
+
+// GetLink returns the user's linked chat, or nil when none is linked.
+func (r *BotLinkRepository) GetLink(ctx context.Context, userID uint64, provider string) (*models.BotLink, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("database connection is nil")
+	}
+
+	link := &models.BotLink{UserID: userID, Provider: provider}
+	var username sql.NullString
+	var linkedAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, `
+		SELECT chat_id, username, created_at
+		FROM notification_bot_links
+		WHERE user_id = ? AND provider = ?
+	`, userID, provider).Scan(&link.ChatID, &username, &linkedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bot link: %w", err)
+	}
+	link.Username = username.String
+	link.LinkedAt = linkedAt.Time
+
+	return link, nil
+}
+
+// DeleteLink unlinks the user's chat.
+func (r *BotLinkRepository) DeleteLink(ctx context.Context, userID uint64, provider string) error {
+	if r.db == nil {
+		return fmt.Errorf("database connection is nil")
+	}
+
+	if _, err := r.db.ExecContext(ctx,
+		"DELETE FROM notification_bot_links WHERE user_id = ? AND provider = ?",
+		userID, provider,
+	); err != nil {
+		return fmt.Errorf("failed to delete bot link: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteLinkByChat unlinks the chat from whichever user it is linked to.
+func (r *BotLinkRepository) DeleteLinkByChat(ctx context.Context, provider, chatID string) error {
+	if r.db == nil {
+		return fmt.Errorf("database connection is nil")
+	}
+
+	if _, err := r.db.ExecContext(ctx,
+		"DELETE FROM notification_bot_links WHERE provider = ? AND chat_id = ?",
+		provider, chatID,
+	); err != nil {
+		return fmt.Errorf("failed to delete bot link: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"metargb/notifications-service/internal/models"
+)
+
+// DigestRepository handles database interactions for notification digests.
+type DigestRepository struct {
+	db *sql.DB
+}
+
+// NewDigestRepository creates a new repository instance.
+func NewDigestRepository(db *sql.DB) *DigestRepository {
+	return &DigestRepository{
+		db: db,
+	}
+}
+
+// GetDigestSettings returns the user's digest settings, or the defaults when unset.
+func (r *DigestRepository) GetDigestSettings(ctx context.Context, userID uint64) (models.DigestSettings, error) {
+	if r.db == nil {
+		return models.DigestSettings{}, fmt.Errorf("database connection is nil")
+	}
+
+	query := `
+		SELECT mode, timezone, quiet_hours_start, quiet_hours_end, last_sent_at
+		FROM notification_digest_settings
+		WHERE user_id = ?
+	`
+
+	settings := models.DefaultDigestSettings()
+	var lastSentAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+		&settings.Mode, &settings.Timezone, &settings.QuietHoursStart, &settings.QuietHoursEnd, &lastSentAt,
+	)
+	if err == sql.ErrNoRows {
+		return models.DefaultDigestSettings(), nil
+	}
+	if err != nil {
+		return models.DigestSettings{}, fmt.Errorf("failed to query digest settings: %w", err)
+	}
+	if lastSentAt.Valid {
+		settings.LastSentAt = &lastSentAt.Time
+	}
+
+	return settings, nil
+}
+
+// UpsertDigestSettings stores the user's digest mode, timezone and quiet hours.
+func (r *DigestRepository) UpsertDigestSettings(ctx context.Context, userID uint64, settings models.DigestSettings) error {
+	if r.db == nil {
+		return fmt.Errorf("database connection is nil")
+	}
+
+	query := `
+		INSERT INTO notification_digest_settings (user_id, mode, timezone, quiet_hours_start, quiet_hours_end, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, NOW(), NOW())
+		ON DUPLICATE KEY UPDATE
+			mode = VALUES(mode),
+			timezone = VALUES(timezone),
+			quiet_hours_start = VALUES(quiet_hours_start),
+			quiet_hours_end = VALUES(quiet_hours_end),
+			updated_at = NOW()
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, settings.Mode, settings.Timezone, settings.QuietHoursStart, settings.QuietHoursEnd); err != nil {
+		return fmt.Errorf("failed to save digest settings: %w", err)
+	}
+
+	return nil
+}
+
+// QueueDigestItem adds a notification to the user's next digest.
+func (r *DigestRepository) QueueDigestItem(ctx context.Context, item *models.DigestItem) error {
+	if r.db == nil {
+		return fmt.Errorf("database connection is nil")
+	}
+
+	query := `
+		INSERT INTO notification_digest_queue (user_id, channel, category, title, message, recipient, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	if item.CreatedAt.IsZero() {
+		item.CreatedAt = time.Now()
+	}
+	result, err := r.db.ExecContext(ctx, query,
+		item.UserID, item.Channel, item.Category, item.Title, item.Message, item.Recipient, item.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to queue digest item: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get last insert id: %w", err)
+	}
+	item.ID = uint64(id)
+
+	return nil
+}
+
+// ListPendingDigests returns every user with queued notifications, with their
+// digest settings and the time of their oldest queued notification.
+func (r *DigestRepository) ListPendingDigests(ctx context.Context) ([]models.PendingDigest, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("database connection is nil")
+	}
+
+	query := `
+		SELECT q.user_id, MIN(q.created_at),
+			s.mode, s.timezone, s.quiet_hours_start, s.quiet_hours_end, s.last_sent_at
+		FROM notification_digest_queue q
+		LEFT JOIN notification_digest_settings s ON s.user_id = q.user_id
+		GROUP BY q.user_id, s.mode, s.timezone, s.quiet_hours_start, s.quiet_hours_end, s.last_sent_at
+		ORDER BY q.user_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pending digests: %w", err)
+	}
+	defer rows.Close()
+
+	var pending []models.PendingDigest
+	for rows.Next() {
+		var digest models.PendingDigest
+		var mode, timezone sql.NullString
+		var quietStart, quietEnd sql.NullInt64
+		var lastSentAt sql.NullTime
+		if err := rows.Scan(&digest.UserID, &digest.OldestQueued, &mode, &timezone, &quietStart, &quietEnd, &lastSentAt); err != nil {
+			return nil, fmt.Errorf("failed to scan pending digest: %w", err)
+		}
+
+		digest.Settings = models.DefaultDigestSettings()
+		if mode.Valid {
+			digest.Settings.Mode = mode.String
+			digest.Settings.Timezone = timezone.String
+			digest.Settings.QuietHoursStart = int(quietStart.Int64)
+			digest.Settings.QuietHoursEnd = int(quietEnd.Int64)
+		}
+		if lastSentAt.Valid {
+			digest.Settings.LastSentAt = &lastSentAt.Time
+		}
+		pending = append(pending, digest)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate pending digests: %w", err)
+	}
+
+	return pending, nil
+}
+
+// ListDigestItems returns the user's queued notifications, oldest first.
+func (r *DigestRepository) ListDigestItems(ctx context.Context, userID uint64) ([]models.DigestItem, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("database connection is nil")
+	}
+
+	query := `
+		SELECT id, user_id, channel, category, title, message, recipient, created_at
+		FROM notification_digest_queue
+		WHERE user_id = ?
+		ORDER BY id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query digest items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []models.DigestItem
+	for rows.Next() {
+		var item models.DigestItem
+		if err := rows.Scan(&item.ID, &item.UserID, &item.Channel, &item.Category, &item.Title, &item.Message, &item.Recipient, &item.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan digest item: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate digest items: %w", err)
+	}
+
+	return items, nil
+}
+
+// MarkDigestSent removes the delivered items from the queue and records when
+// the user's last digest was sent, in a single transaction.
+func (r *DigestRepository) MarkDigestSent(ctx context.Context, userID uint64, itemIDs []uint64, sentAt time.Time) error {
+	if r.db == nil {
+		return fmt.Errorf("database connection is nil")
+	}
+	if len(itemIDs) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	args := make([]interface{}, 0, len(itemIDs)+1)
+	args = append(args, userID)
+	for _, id := range itemIDs {
+		args = append(args, id)
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(itemIDs)), ",")
+	if _, err := tx.ExecContext(ctx, `DELETE FROM notification_digest_queue WHERE user_id = ? AND id IN (`+placeholders+`)`, args...); err != nil {
+		return fmt.Errorf("failed to delete digest items: %w", err)
+	}
+
+	// Users who never changed their settings get a row holding the defaults
+	query := `
+		INSERT INTO notification_digest_settings (user_id, mode, timezone, quiet_hours_start, quiet_hours_end, last_sent_at, created_at, updated_at)
+		VALUES (?, ?, ?, 0, 0, ?, NOW(), NOW())
+		ON DUPLICATE KEY UPDATE last_sent_at = VALUES(last_sent_at), updated_at = NOW()
+	`
+	if _, err := tx.ExecContext(ctx, query, userID, models.DigestModeImmediate, "", sentAt); err != nil {
+		return fmt.Errorf("failed to record digest delivery: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit digest delivery: %w", err)
+	}
+
+	return nil
+}
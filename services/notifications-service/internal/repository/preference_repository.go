@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"metargb/notifications-service/internal/models"
+)
+
+// PreferenceRepository handles database interactions for notification preferences.
+type PreferenceRepository struct {
+	db *sql.DB
+}
+
+// NewPreferenceRepository creates a new repository instance.
+func NewPreferenceRepository(db *sql.DB) *PreferenceRepository {
+	return &PreferenceRepository{
+		db: db,
+	}
+}
+
+// GetPreferences returns the user's stored preferences merged over the defaults.
+func (r *PreferenceRepository) GetPreferences(ctx context.Context, userID uint64) (models.NotificationPreferences, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("database connection is nil")
+	}
+
+	query := `
+		SELECT channel, category, enabled
+		FROM notification_preferences
+		WHERE user_id = ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notification preferences: %w", err)
+	}
+	defer rows.Close()
+
+	prefs := models.DefaultNotificationPreferences()
+	for rows.Next() {
+		var pref models.NotificationPreference
+		if err := rows.Scan(&pref.Channel, &pref.Category, &pref.Enabled); err != nil {
+			return nil, fmt.Errorf("failed to scan notification preference: %w", err)
+		}
+		// Ignore rows for channels or categories that are no longer offered
+		if categories, ok := prefs[pref.Channel]; ok {
+			if _, ok := categories[pref.Category]; ok {
+				categories[pref.Category] = pref.Enabled
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate notification preferences: %w", err)
+	}
+
+	return prefs, nil
+}
+
+// UpsertPreferences stores the given preferences for the user in a single transaction.
+func (r *PreferenceRepository) UpsertPreferences(ctx context.Context, userID uint64, prefs []models.NotificationPreference) error {
+	if r.db == nil {
+		return fmt.Errorf("database connection is nil")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO notification_preferences (user_id, channel, category, enabled, created_at, updated_at)
+		VALUES (?, ?, ?, ?, NOW(), NOW())
+		ON DUPLICATE KEY UPDATE enabled = VALUES(enabled), updated_at = NOW()
+	`
+
+	for _, pref := range prefs {
+		if _, err := tx.ExecContext(ctx, query, userID, pref.Channel, pref.Category, pref.Enabled); err != nil {
+			return fmt.Errorf("failed to save notification preference: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit notification preferences: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"metargb/notifications-service/internal/errs"
+	"metargb/notifications-service/internal/models"
+)
+
+// DefaultBotLinkCodeTTL is how long a link code issued in the app stays valid
+const DefaultBotLinkCodeTTL = 10 * time.Minute
+
+// botLinkCodeAlphabet leaves out letters and digits that are easy to confuse
+const botLinkCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+const botLinkCodeLength = 8
+
+// Replies sent by the bot
+const (
+	botReplyLinked      = "حساب کاربری شما به این گفتگو متصل شد. کدهای تأیید و هشدارهای مهم در اینجا ارسال می‌شوند."
+	botReplyInvalidCode = "کد اتصال نامعتبر است یا منقضی شده است. یک کد جدید از بخش تنظیمات اعلان‌ها دریافت کنید."
+	botReplyHelp        = "برای اتصال حساب کاربری، کد اتصال را از بخش تنظیمات اعلان‌ها دریافت کرده و در اینجا ارسال کنید."
+	botReplyUnlinked    = "اتصال حساب کاربری شما از این گفتگو حذف شد."
+)
+
+// BotLinkStore persists bot link codes and the chats linked to users.
+type BotLinkStore interface {
+	CreateLinkCode(ctx context.Context, code *models.BotLinkCode) error
+	// ConsumeLinkCode deletes an unexpired code and returns its user, or 0 when there is none
+	ConsumeLinkCode(ctx context.Context, provider, code string, now time.Time) (uint64, error)
+	UpsertLink(ctx context.Context, link *models.BotLink) error
+	// GetLink returns nil when the user has not linked a chat
+	GetLink(ctx context.Context, userID uint64, provider string) (*models.BotLink, error)
+	DeleteLink(ctx context.Context, userID uint64, provider string) error
+	DeleteLinkByChat(ctx context.Context, provider, chatID string) error
+}
+
+// BotSender sends messages to a user's linked bot chat.
+type BotSender interface {
+	// SendToUser reports false without an error when the user has not linked a chat
+	SendToUser(ctx context.Context, userID uint64, text string) (string, bool, error)
+}
+
+// BotLinkService links users to bot chats and messages them there.
+type BotLinkService interface {
+	BotSender
+	CreateLinkCode(ctx context.Context, userID uint64) (*models.BotLinkCode, error)
+	GetLink(ctx context.Context, userID uint64) (*models.BotLink, error)
+	Unlink(ctx context.Context, userID uint64) error
+	HandleMessage(ctx context.Context, message models.BotMessage) error
+}
+
+type botLinkService struct {
+	store   BotLinkStore
+	bot     BotChannel
+	codeTTL time.Duration
+	now     func() time.Time
+}
+
+// NewBotLinkService creates a bot link service. A nil bot makes every method
+// fail with ErrBotNotConfigured, and SendToUser report no linked chat.
+func NewBotLinkService(store BotLinkStore, bot BotChannel, codeTTL time.Duration) BotLinkService {
+	if codeTTL <= 0 {
+		codeTTL = DefaultBotLinkCodeTTL
+	}
+	return &botLinkService{
+		store:   store,
+		bot:     bot,
+		codeTTL: codeTTL,
+		now:     time.Now,
+	}
+}
+
+// CreateLinkCode issues a code that links the chat it is sent from to userID.
+// Codes issued before for the user stop working.
+func (s *botLinkService) CreateLinkCode(ctx context.Context, userID uint64) (*models.BotLinkCode, error) {
+	if s.bot == nil {
+		return nil, errs.ErrBotNotConfigured
+	}
+
+	code, err := newBotLinkCode()
+	if err != nil {
+		return nil, err
+	}
+	linkCode := &models.BotLinkCode{
+		UserID:    userID,
+		Provider:  s.bot.Provider(),
+		Code:      code,
+		LinkURL:   s.bot.LinkURL(code),
+		ExpiresAt: s.now().Add(s.codeTTL),
+	}
+	if err := s.store.CreateLinkCode(ctx, linkCode); err != nil {
+		return nil, fmt.Errorf("failed to create bot link code: %w", err)
+	}
+	return linkCode, nil
+}
+
+func (s *botLinkService) GetLink(ctx context.Context, userID uint64) (*models.BotLink, error) {
+	if s.bot == nil {
+		return nil, errs.ErrBotNotConfigured
+	}
+
+	link, err := s.store.GetLink(ctx, userID, s.bot.Provider())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bot link: %w", err)
+	}
+	if link == nil {
+		return nil, errs.ErrBotNotLinked
+	}
+	return link, nil
+}
+
+func (s *botLinkService) Unlink(ctx context.Context, userID uint64) error {
+	if s.bot == nil {
+		return errs.ErrBotNotConfigured
+	}
+	if err := s.store.DeleteLink(ctx, userID, s.bot.Provider()); err != nil {
+		return fmt.Errorf("failed to delete bot link: %w", err)
+	}
+	return nil
+}
+
+// HandleMessage confirms link codes sent to the bot, either as /start CODE
+// from a t.me link or typed in, and unlinks the chat on /stop.
+func (s *botLinkService) HandleMessage(ctx context.Context, message models.BotMessage) error {
+	if s.bot == nil {
+		return errs.ErrBotNotConfigured
+	}
+
+	command, argument := parseBotCommand(message.Text)
+	switch command {
+	case "/stop", "/unlink":
+		if err := s.store.DeleteLinkByChat(ctx, s.bot.Provider(), message.ChatID); err != nil {
+			return fmt.Errorf("failed to unlink bot chat: %w", err)
+		}
+		return s.reply(ctx, message.ChatID, botReplyUnlinked)
+	case "/start", "/link":
+	case "":
+		// A code typed in without a command
+		argument = strings.TrimSpace(message.Text)
+	default:
+		return s.reply(ctx, message.ChatID, botReplyHelp)
+	}
+	if argument == "" {
+		return s.reply(ctx, message.ChatID, botReplyHelp)
+	}
+
+	userID, err := s.store.ConsumeLinkCode(ctx, s.bot.Provider(), strings.ToUpper(argument), s.now())
+	if err != nil {
+		return fmt.Errorf("failed to check bot link code: %w", err)
+	}
+	if userID == 0 {
+		return s.reply(ctx, message.ChatID, botReplyInvalidCode)
+	}
+
+	// A chat receives one user's messages; linking it again moves it
+	if err := s.store.DeleteLinkByChat(ctx, s.bot.Provider(), message.ChatID); err != nil {
+		return fmt.Errorf("failed to unlink bot chat: %w", err)
+	}
+	link := &models.BotLink{
+		UserID:   userID,
+		Provider: s.bot.Provider(),
+		ChatID:   message.ChatID,
+		Username: message.Username,
+		LinkedAt: s.now(),
+	}
+	if err := s.store.UpsertLink(ctx, link); err != nil {
+		return fmt.Errorf("failed to save bot link: %w", err)
+	}
+	return s.reply(ctx, message.ChatID, botReplyLinked)
+}
+
+// SendToUser messages the user's linked chat. Chats that blocked the bot are unlinked.
+func (s *botLinkService) SendToUser(ctx context.Context, userID uint64, text string) (string, bool, error) {
+	if s.bot == nil || userID == 0 {
+		return "", false, nil
+	}
+
+	link, err := s.store.GetLink(ctx, userID, s.bot.Provider())
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get bot link: %w", err)
+	}
+	if link == nil {
+		return "", false, nil
+	}
+
+	messageID, err := s.bot.SendMessage(ctx, link.ChatID, text)
+	if errors.Is(err, errs.ErrBotChatUnavailable) {
+		if err := s.store.DeleteLink(ctx, userID, link.Provider); err != nil {
+			log.Printf("Failed to unlink unavailable bot chat of user %d: %v", userID, err)
+		}
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return messageID, true, nil
+}
+
+func (s *botLinkService) reply(ctx context.Context, chatID, text string) error {
+	if _, err := s.bot.SendMessage(ctx, chatID, text); err != nil {
+		return fmt.Errorf("failed to reply to bot chat: %w", err)
+	}
+	return nil
+}
+
+// parseBotCommand splits "/start CODE" into its command and argument. Text
+// that is not a command returns an empty command.
+func parseBotCommand(text string) (string, string) {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "/") {
+		return "", ""
+	}
+	command, argument, _ := strings.Cut(text, " ")
+	// Commands in groups are addressed as /start@bot_name
+	command, _, _ = strings.Cut(command, "@")
+	return strings.ToLower(command), strings.TrimSpace(argument)
+}
+
+func newBotLinkCode() (string, error) {
+	buf := make([]byte, botLinkCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate bot link code: %w", err)
+	}
+	for i, b := range buf {
+		buf[i] = botLinkCodeAlphabet[int(b)%len(botLinkCodeAlphabet)]
+	}
+	return string(buf), nil
+}
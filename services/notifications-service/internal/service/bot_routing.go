@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"metargb/notifications-service/internal/models"
+)
+
+// BotMessageIDPrefix starts the message id returned for messages delivered
+// through a bot chat instead of SMS
+const BotMessageIDPrefix = "bot:"
+
+// IsBotMessage reports whether messageID was returned for a message
+// delivered through a bot chat
+func IsBotMessage(messageID string) bool {
+	return strings.HasPrefix(messageID, BotMessageIDPrefix)
+}
+
+// BotRoutedSMSChannel sends OTPs and critical messages through the user's
+// linked bot chat when SMS cannot reach them: users of operators listed in
+// the routing rules get the bot first, and everyone else gets it when the SMS
+// provider fails. Messages without a user, bulk messages and users without a
+// linked chat always go over SMS.
+type BotRoutedSMSChannel struct {
+	sms   SMSChannel
+	bots  BotSender
+	rules models.BotRoutingRules
+}
+
+// NewBotRoutedSMSChannel wraps sms with the routing rules
+func NewBotRoutedSMSChannel(sms SMSChannel, bots BotSender, rules models.BotRoutingRules) *BotRoutedSMSChannel {
+	return &BotRoutedSMSChannel{
+		sms:   sms,
+		bots:  bots,
+		rules: rules,
+	}
+}
+
+func (c *BotRoutedSMSChannel) SendSMS(ctx context.Context, payload models.SMSPayload) (string, error) {
+	// Template messages are rendered by the SMS provider and have no text to send
+	if !payload.Critical || payload.Message == "" {
+		return c.sms.SendSMS(ctx, payload)
+	}
+	return c.route(ctx, payload.UserID, payload.Phone, payload.Message, func() (string, error) {
+		return c.sms.SendSMS(ctx, payload)
+	})
+}
+
+func (c *BotRoutedSMSChannel) SendOTP(ctx context.Context, payload models.OTPPayload) (string, error) {
+	text := fmt.Sprintf("کد تأیید شما: %s", payload.Code)
+	return c.route(ctx, payload.UserID, payload.Phone, text, func() (string, error) {
+		return c.sms.SendOTP(ctx, payload)
+	})
+}
+
+func (c *BotRoutedSMSChannel) route(ctx context.Context, userID uint64, phone, text string, sendSMS func() (string, error)) (string, error) {
+	if userID == 0 || c.bots == nil {
+		return sendSMS()
+	}
+
+	if c.rules.PrefersBot(phone) {
+		if messageID, sent := c.sendToBot(ctx, userID, text); sent {
+			return messageID, nil
+		}
+	}
+
+	messageID, err := sendSMS()
+	if err == nil || !c.rules.FallbackOnSMSFailure {
+		return messageID, err
+	}
+	if botMessageID, sent := c.sendToBot(ctx, userID, text); sent {
+		log.Printf("SMS to user %d failed, sent through bot instead: %v", userID, err)
+		return botMessageID, nil
+	}
+	return "", err
+}
+
+func (c *BotRoutedSMSChannel) sendToBot(ctx context.Context, userID uint64, text string) (string, bool) {
+	messageID, sent, err := c.bots.SendToUser(ctx, userID, text)
+	if err != nil {
+		log.Printf("Failed to send message to bot chat of user %d: %v", userID, err)
+		return "", false
+	}
+	if !sent {
+		return "", false
+	}
+	return BotMessageIDPrefix + messageID, true
+}
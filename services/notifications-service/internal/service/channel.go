@@ -24,3 +24,11 @@ type SMSChannel interface {
 type EmailChannel interface {
 	SendEmail(ctx context.Context, payload models.EmailPayload) (string, error)
 }
+
+// BotChannel abstracts chat bot providers such as Telegram.
+type BotChannel interface {
+	Provider() string
+	// LinkURL returns the link that opens the bot with code prefilled
+	LinkURL(code string) string
+	SendMessage(ctx context.Context, chatID, text string) (string, error)
+}
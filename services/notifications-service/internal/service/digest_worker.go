@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"text/template"
+	"time"
+
+	"metargb/notifications-service/internal/models"
+	"metargb/shared/pkg/jobs"
+)
+
+const (
+	// DefaultDigestInterval is how often queued notifications are checked for due digests
+	DefaultDigestInterval = 5 * time.Minute
+	// DefaultDigestDailyHour is the hour, in the user's timezone, daily digests are sent from
+	DefaultDigestDailyHour = 9
+	// maxDigestSMSLength keeps digest SMS within a few message parts
+	maxDigestSMSLength = 320
+)
+
+// digestCategoryTitles are the Persian headings of each category in a digest
+var digestCategoryTitles = map[string]string{
+	models.CategoryMarketplace: "بازار",
+	models.CategoryDynasty:     "سلسله",
+	models.CategorySupport:     "پشتیبانی",
+	models.CategoryMarketing:   "اخبار و پیشنهادها",
+}
+
+var digestEmailTemplate = template.Must(template.New("digest_email").Parse(
+	`سلام،
+{{.Count}} اعلان تازه در متارنگ برای شما ثبت شده است:
+{{range .Groups}}
+{{.Title}}
+{{range .Items}}- {{.Title}}: {{.Message}}
+{{end}}{{end}}
+برای مشاهده جزئیات به بخش اعلان‌ها در متارنگ مراجعه کنید.
+`))
+
+var digestSMSTemplate = template.Must(template.New("digest_sms").Parse(
+	`متارنگ: {{.Count}} اعلان تازه دارید.{{range .Groups}}
+{{.Title}}: {{len .Items}}{{end}}`))
+
+type digestGroup struct {
+	Title string
+	Items []models.DigestItem
+}
+
+type digestContent struct {
+	Count  int
+	Groups []digestGroup
+}
+
+// UserLocations resolves the timezone each user chose in their account
+// settings, implemented by usercache.Cache
+type UserLocations interface {
+	Location(ctx context.Context, userID uint64) *time.Location
+}
+
+// DigestWorker sends the notifications queued for users who opted into hourly
+// or daily digests as one message per channel. Digests are not sent during the
+// user's quiet hours; items whose delivery fails are retried on the next run.
+type DigestWorker struct {
+	digests      DigestStore
+	smsChannel   SMSChannel
+	emailChannel EmailChannel
+	locations    UserLocations
+	dailyHour    int
+	interval     time.Duration
+	now          func() time.Time
+}
+
+// NewDigestWorker creates a worker checking for due digests every interval
+// (DefaultDigestInterval if zero). Daily digests are sent from dailyHour.
+func NewDigestWorker(digests DigestStore, smsChannel SMSChannel, emailChannel EmailChannel, dailyHour int, interval time.Duration) *DigestWorker {
+	if interval <= 0 {
+		interval = DefaultDigestInterval
+	}
+	if dailyHour < 0 || dailyHour > 23 {
+		dailyHour = DefaultDigestDailyHour
+	}
+	return &DigestWorker{
+		digests:      digests,
+		smsChannel:   smsChannel,
+		emailChannel: emailChannel,
+		dailyHour:    dailyHour,
+		interval:     interval,
+		now:          time.Now,
+	}
+}
+
+// SetLocations sets where the timezones of users who chose none for their
+// digests are read from. Without it they get models.DefaultDigestTimezone.
+func (w *DigestWorker) SetLocations(locations UserLocations) {
+	w.locations = locations
+}
+
+// Register runs the worker once at start and then every interval under runner
+func (w *DigestWorker) Register(runner *jobs.Runner) {
+	runner.AddImmediate("notification-digests", w.interval, func(ctx context.Context) error {
+		_, err := w.Run(ctx)
+		return err
+	})
+}
+
+// Run sends every due digest and returns how many messages were sent
+func (w *DigestWorker) Run(ctx context.Context) (int, error) {
+	pending, err := w.digests.ListPendingDigests(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	now := w.now()
+	sent := 0
+	for _, digest := range pending {
+		if digest.Settings.Timezone == "" && w.locations != nil {
+			digest.Settings.Timezone = w.locations.Location(ctx, digest.UserID).String()
+		}
+		if !digest.Settings.DigestDue(now, digest.OldestQueued, w.dailyHour) {
+			continue
+		}
+		count, err := w.sendDigest(ctx, digest.UserID, now)
+		sent += count
+		if err != nil {
+			log.Printf("Failed to send notification digest to user %d: %v", digest.UserID, err)
+		}
+	}
+
+	return sent, nil
+}
+
+// sendDigest sends one message per channel with the user's queued items
+func (w *DigestWorker) sendDigest(ctx context.Context, userID uint64, now time.Time) (int, error) {
+	items, err := w.digests.ListDigestItems(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	byChannel := make(map[string][]models.DigestItem)
+	for _, item := range items {
+		byChannel[item.Channel] = append(byChannel[item.Channel], item)
+	}
+
+	var delivered []uint64
+	var failures []string
+	sent := 0
+	for _, channel := range []string{models.ChannelEmail, models.ChannelSMS} {
+		channelItems := byChannel[channel]
+		if len(channelItems) == 0 {
+			continue
+		}
+		if err := w.deliver(ctx, channel, channelItems); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", channel, err))
+			continue
+		}
+		sent++
+		for _, item := range channelItems {
+			delivered = append(delivered, item.ID)
+		}
+	}
+
+	if err := w.digests.MarkDigestSent(ctx, userID, delivered, now); err != nil {
+		return sent, err
+	}
+	if len(failures) > 0 {
+		return sent, fmt.Errorf("%s", strings.Join(failures, "; "))
+	}
+	return sent, nil
+}
+
+func (w *DigestWorker) deliver(ctx context.Context, channel string, items []models.DigestItem) error {
+	content := buildDigestContent(items)
+	// The latest notification carries the user's current phone number or email address
+	recipient := items[len(items)-1].Recipient
+
+	switch channel {
+	case models.ChannelEmail:
+		if w.emailChannel == nil {
+			return fmt.Errorf("email channel is not configured")
+		}
+		var body strings.Builder
+		if err := digestEmailTemplate.Execute(&body, content); err != nil {
+			return err
+		}
+		_, err := w.emailChannel.SendEmail(ctx, models.EmailPayload{
+			To:      recipient,
+			Subject: fmt.Sprintf("خلاصه اعلان‌های متارنگ (%d)", content.Count),
+			Body:    body.String(),
+		})
+		return err
+	case models.ChannelSMS:
+		if w.smsChannel == nil {
+			return fmt.Errorf("sms channel is not configured")
+		}
+		var message strings.Builder
+		if err := digestSMSTemplate.Execute(&message, content); err != nil {
+			return err
+		}
+		text := message.String()
+		if runes := []rune(text); len(runes) > maxDigestSMSLength {
+			text = string(runes[:maxDigestSMSLength])
+		}
+		_, err := w.smsChannel.SendSMS(ctx, models.SMSPayload{
+			Phone:   recipient,
+			Message: text,
+		})
+		return err
+	}
+	return fmt.Errorf("unsupported digest channel %q", channel)
+}
+
+// buildDigestContent groups the items by category in display order
+func buildDigestContent(items []models.DigestItem) digestContent {
+	byCategory := make(map[string][]models.DigestItem)
+	for _, item := range items {
+		byCategory[item.Category] = append(byCategory[item.Category], item)
+	}
+
+	content := digestContent{Count: len(items)}
+	for _, category := range models.NotificationCategories {
+		if categoryItems := byCategory[category]; len(categoryItems) > 0 {
+			content.Groups = append(content.Groups, digestGroup{
+				Title: digestCategoryTitles[category],
+				Items: categoryItems,
+			})
+		}
+	}
+	return content
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"sort"
+	"strings"
+	"text/template/parse"
+
+	"metargb/notifications-service/internal/errs"
+)
+
+// EmailTemplates renders the email templates. Each template email/<name> is
+// laid out by the "base" template with email/<name>/content as its body.
+type EmailTemplates struct {
+	sets   map[string]*template.Template
+	fields map[string][]string
+	names  []string
+}
+
+// LoadEmailTemplates parses the base layout and every email template in fsys.
+func LoadEmailTemplates(fsys fs.FS) (*EmailTemplates, error) {
+	root, err := template.ParseFS(fsys, "email/*.html.tmpl", "email/dynasty/*.html.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse email templates: %w", err)
+	}
+
+	t := &EmailTemplates{
+		sets:   make(map[string]*template.Template),
+		fields: make(map[string][]string),
+	}
+	for _, tmpl := range root.Templates() {
+		name := tmpl.Name()
+		content := root.Lookup(name + "/content")
+		if !strings.HasPrefix(name, "email/") || content == nil {
+			continue
+		}
+
+		set, err := root.Clone()
+		if err != nil {
+			return nil, fmt.Errorf("failed to clone email templates: %w", err)
+		}
+		if _, err := set.Parse(`{{define "content"}}{{template "` + name + `/content" .}}{{end}}`); err != nil {
+			return nil, fmt.Errorf("failed to prepare email template %s: %w", name, err)
+		}
+
+		t.sets[name] = set
+		t.fields[name] = templateFields(content.Tree)
+		t.names = append(t.names, name)
+	}
+	sort.Strings(t.names)
+
+	return t, nil
+}
+
+// Names returns the renderable templates, e.g. email/otp.
+func (t *EmailTemplates) Names() []string {
+	return append([]string(nil), t.names...)
+}
+
+// Fields returns the top-level fields a template's content reads.
+func (t *EmailTemplates) Fields(name string) ([]string, error) {
+	fields, ok := t.fields[name]
+	if !ok {
+		return nil, errs.ErrTemplateNotFound
+	}
+	return fields, nil
+}
+
+// Render executes a template with data and returns the HTML body.
+func (t *EmailTemplates) Render(name string, data map[string]interface{}) (string, error) {
+	set, ok := t.sets[name]
+	if !ok {
+		return "", errs.ErrTemplateNotFound
+	}
+
+	// The layout reads .Assets.LogoURL and .Footer.*, which fail on a missing parent
+	for _, key := range []string{"Assets", "Footer"} {
+		if _, ok := data[key]; !ok {
+			data[key] = map[string]interface{}{}
+		}
+	}
+
+	var body bytes.Buffer
+	if err := set.ExecuteTemplate(&body, name, data); err != nil {
+		return "", fmt.Errorf("%w: %v", errs.ErrTemplateRender, err)
+	}
+	return body.String(), nil
+}
+
+// templateFields lists the fields read from the template's dot. Fields inside
+// with and range blocks are relative to the block's value and are skipped.
+func templateFields(tree *parse.Tree) []string {
+	found := make(map[string]bool)
+	if tree != nil {
+		collectNodeFields(tree.Root, found)
+	}
+
+	fields := make([]string, 0, len(found))
+	for field := range found {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func collectNodeFields(node parse.Node, found map[string]bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			collectNodeFields(child, found)
+		}
+	case *parse.ActionNode:
+		collectPipeFields(n.Pipe, found)
+	case *parse.IfNode:
+		collectPipeFields(n.Pipe, found)
+		collectNodeFields(n.List, found)
+		collectNodeFields(n.ElseList, found)
+	case *parse.WithNode:
+		collectPipeFields(n.Pipe, found)
+		collectNodeFields(n.ElseList, found)
+	case *parse.RangeNode:
+		collectPipeFields(n.Pipe, found)
+		collectNodeFields(n.ElseList, found)
+	case *parse.TemplateNode:
+		collectPipeFields(n.Pipe, found)
+	}
+}
+
+func collectPipeFields(pipe *parse.PipeNode, found map[string]bool) {
+	if pipe == nil {
+		return
+	}
+	for _, cmd := range pipe.Cmds {
+		for _, arg := range cmd.Args {
+			switch a := arg.(type) {
+			case *parse.FieldNode:
+				found[a.Ident[0]] = true
+			case *parse.PipeNode:
+				collectPipeFields(a, found)
+			}
+		}
+	}
+}
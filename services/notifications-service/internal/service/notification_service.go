@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
 
 	"metargb/notifications-service/internal/errs"
@@ -19,6 +20,11 @@ type SendNotificationInput struct {
 	Data      map[string]string
 	SendSMS   bool
 	SendEmail bool
+	// Category selects the user preferences to apply; empty skips preference checks
+	Category string
+	// Critical copies the notification to the user's linked bot chat, e.g.
+	// security alerts that must arrive even when SMS does not
+	Critical bool
 
 	SMSPayload   *models.SMSPayload
 	EmailPayload *models.EmailPayload
@@ -33,57 +39,145 @@ type NotificationService interface {
 	MarkAllAsRead(ctx context.Context, userID uint64) error
 }
 
+// NotificationEventPublisher announces changes to a user's in-app
+// notifications, so services caching the unread count can drop it
+type NotificationEventPublisher interface {
+	PublishNotificationsChanged(ctx context.Context, userID uint64) error
+}
+
 type notificationService struct {
 	repo         *repository.NotificationRepository
+	preferences  PreferenceStore
+	digests      DigestStore
 	smsChannel   SMSChannel
 	emailChannel EmailChannel
+	bots         BotSender
+	events       NotificationEventPublisher
 }
 
 // NewNotificationService creates a notification service implementation.
+// A nil preference store delivers every notification regardless of category,
+// a nil digest store sends every notification immediately, a nil bot sender
+// does not copy critical notifications to bot chats, and a nil event
+// publisher announces nothing.
 func NewNotificationService(
 	repo *repository.NotificationRepository,
+	preferences PreferenceStore,
+	digests DigestStore,
 	smsChannel SMSChannel,
 	emailChannel EmailChannel,
+	bots BotSender,
+	events NotificationEventPublisher,
 ) NotificationService {
 	return &notificationService{
 		repo:         repo,
+		preferences:  preferences,
+		digests:      digests,
 		smsChannel:   smsChannel,
 		emailChannel: emailChannel,
+		bots:         bots,
+		events:       events,
 	}
 }
 
 func (s *notificationService) SendNotification(ctx context.Context, input SendNotificationInput) (*models.NotificationResult, error) {
-	notification := &models.Notification{
-		UserID:    input.UserID,
-		Type:      input.Type,
-		Title:     input.Title,
-		Message:   input.Message,
-		Data:      input.Data,
-		CreatedAt: time.Now(),
+	var prefs models.NotificationPreferences
+	if input.Category != "" && s.preferences != nil {
+		var err error
+		prefs, err = s.preferences.GetPreferences(ctx, input.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load notification preferences: %w", err)
+		}
 	}
 
-	id, err := s.repo.CreateNotification(ctx, notification)
+	// Categorized SMS and email go to the user's digest when they opted into one.
+	// Uncategorized messages such as OTPs and security alerts are always sent.
+	digest := models.DefaultDigestSettings()
+	if input.Category != "" && s.digests != nil && (input.SendSMS || input.SendEmail) {
+		var err error
+		digest, err = s.digests.GetDigestSettings(ctx, input.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load digest settings: %w", err)
+		}
+	}
 
-	if err != nil {
-		return nil, err
+	result := &models.NotificationResult{Sent: true}
+
+	if prefs.Allows(models.ChannelInApp, input.Category) {
+		notification := &models.Notification{
+			UserID:    input.UserID,
+			Type:      input.Type,
+			Title:     input.Title,
+			Message:   input.Message,
+			Data:      input.Data,
+			CreatedAt: time.Now(),
+		}
+
+		id, err := s.repo.CreateNotification(ctx, notification)
+		if err != nil {
+			return nil, err
+		}
+		result.ID = id
+		s.publishChanged(ctx, input.UserID)
+	} else {
+		result.SuppressedChannels = append(result.SuppressedChannels, models.ChannelInApp)
+	}
+
+	// The in-app copy is kept when the bot chat cannot be reached
+	if input.Critical && s.bots != nil {
+		_, sent, err := s.bots.SendToUser(ctx, input.UserID, input.Title+"\n\n"+input.Message)
+		if err != nil {
+			log.Printf("Failed to send critical notification to bot chat of user %d: %v", input.UserID, err)
+		}
+		result.SentToBot = sent
 	}
 
 	if input.SendSMS && s.smsChannel != nil && input.SMSPayload != nil {
-		if _, err := s.smsChannel.SendSMS(ctx, *input.SMSPayload); err != nil {
-			return &models.NotificationResult{ID: id, Sent: false}, err
+		if !prefs.Allows(models.ChannelSMS, input.Category) {
+			result.SuppressedChannels = append(result.SuppressedChannels, models.ChannelSMS)
+		} else if digest.Enabled() {
+			if err := s.queueDigestItem(ctx, input, models.ChannelSMS, input.SMSPayload.Phone); err != nil {
+				result.Sent = false
+				return result, err
+			}
+			result.DigestChannels = append(result.DigestChannels, models.ChannelSMS)
+		} else if _, err := s.smsChannel.SendSMS(ctx, *input.SMSPayload); err != nil {
+			result.Sent = false
+			return result, err
 		}
 	}
 
 	if input.SendEmail && s.emailChannel != nil && input.EmailPayload != nil {
-		if _, err := s.emailChannel.SendEmail(ctx, *input.EmailPayload); err != nil {
-			return &models.NotificationResult{ID: id, Sent: false}, err
+		if !prefs.Allows(models.ChannelEmail, input.Category) {
+			result.SuppressedChannels = append(result.SuppressedChannels, models.ChannelEmail)
+		} else if digest.Enabled() {
+			if err := s.queueDigestItem(ctx, input, models.ChannelEmail, input.EmailPayload.To); err != nil {
+				result.Sent = false
+				return result, err
+			}
+			result.DigestChannels = append(result.DigestChannels, models.ChannelEmail)
+		} else if _, err := s.emailChannel.SendEmail(ctx, *input.EmailPayload); err != nil {
+			result.Sent = false
+			return result, err
 		}
 	}
 
-	return &models.NotificationResult{
-		ID:   id,
-		Sent: true,
-	}, nil
+	return result, nil
+}
+
+func (s *notificationService) queueDigestItem(ctx context.Context, input SendNotificationInput, channel, recipient string) error {
+	item := &models.DigestItem{
+		UserID:    input.UserID,
+		Channel:   channel,
+		Category:  input.Category,
+		Title:     input.Title,
+		Message:   input.Message,
+		Recipient: recipient,
+	}
+	if err := s.digests.QueueDigestItem(ctx, item); err != nil {
+		return fmt.Errorf("failed to queue %s notification for digest: %w", channel, err)
+	}
+	return nil
 }
 
 func (s *notificationService) GetNotifications(ctx context.Context, userID uint64, filter models.NotificationFilter) ([]models.Notification, int64, error) {
@@ -93,11 +187,30 @@ func (s *notificationService) GetNotifications(ctx context.Context, userID uint6
 }
 
 func (s *notificationService) MarkAsRead(ctx context.Context, notificationID string, userID uint64) error {
-	return s.repo.MarkAsRead(ctx, notificationID, userID)
+	if err := s.repo.MarkAsRead(ctx, notificationID, userID); err != nil {
+		return err
+	}
+	s.publishChanged(ctx, userID)
+	return nil
 }
 
 func (s *notificationService) MarkAllAsRead(ctx context.Context, userID uint64) error {
-	return s.repo.MarkAllAsRead(ctx, userID)
+	if err := s.repo.MarkAllAsRead(ctx, userID); err != nil {
+		return err
+	}
+	s.publishChanged(ctx, userID)
+	return nil
+}
+
+// publishChanged announces that userID's unread count may have changed. The
+// notification is stored either way, so a failure is only logged.
+func (s *notificationService) publishChanged(ctx context.Context, userID uint64) {
+	if s.events == nil {
+		return
+	}
+	if err := s.events.PublishNotificationsChanged(ctx, userID); err != nil {
+		log.Printf("Failed to announce notification change of user %d: %v", userID, err)
+	}
 }
 
 func (s *notificationService) GetNotificationByID(ctx context.Context, notificationID string, userID uint64) (*models.Notification, error) {
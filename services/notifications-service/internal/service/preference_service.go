@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"metargb/notifications-service/internal/errs"
+	"metargb/notifications-service/internal/models"
+	"metargb/shared/pkg/tz"
+)
+
+// PreferenceStore persists per-channel, per-category notification preferences.
+type PreferenceStore interface {
+	GetPreferences(ctx context.Context, userID uint64) (models.NotificationPreferences, error)
+	UpsertPreferences(ctx context.Context, userID uint64, prefs []models.NotificationPreference) error
+}
+
+// DigestStore persists digest settings and the notifications queued for digests.
+type DigestStore interface {
+	GetDigestSettings(ctx context.Context, userID uint64) (models.DigestSettings, error)
+	UpsertDigestSettings(ctx context.Context, userID uint64, settings models.DigestSettings) error
+	QueueDigestItem(ctx context.Context, item *models.DigestItem) error
+	ListPendingDigests(ctx context.Context) ([]models.PendingDigest, error)
+	ListDigestItems(ctx context.Context, userID uint64) ([]models.DigestItem, error)
+	MarkDigestSent(ctx context.Context, userID uint64, itemIDs []uint64, sentAt time.Time) error
+}
+
+// PreferenceService exposes notification preference operations to transport handlers.
+type PreferenceService interface {
+	GetPreferences(ctx context.Context, userID uint64) (models.NotificationPreferences, error)
+	UpdatePreferences(ctx context.Context, userID uint64, prefs []models.NotificationPreference) (models.NotificationPreferences, error)
+	GetDigestSettings(ctx context.Context, userID uint64) (models.DigestSettings, error)
+	UpdateDigestSettings(ctx context.Context, userID uint64, settings models.DigestSettings) (models.DigestSettings, error)
+}
+
+type preferenceService struct {
+	store   PreferenceStore
+	digests DigestStore
+}
+
+// NewPreferenceService creates a preference service backed by the provided stores.
+func NewPreferenceService(store PreferenceStore, digests DigestStore) PreferenceService {
+	return &preferenceService{
+		store:   store,
+		digests: digests,
+	}
+}
+
+func (s *preferenceService) GetPreferences(ctx context.Context, userID uint64) (models.NotificationPreferences, error) {
+	return s.store.GetPreferences(ctx, userID)
+}
+
+func (s *preferenceService) UpdatePreferences(ctx context.Context, userID uint64, prefs []models.NotificationPreference) (models.NotificationPreferences, error) {
+	for _, pref := range prefs {
+		if !models.IsValidChannel(pref.Channel) {
+			return nil, errs.ErrInvalidChannel
+		}
+		if !models.IsValidCategory(pref.Category) {
+			return nil, errs.ErrInvalidCategory
+		}
+	}
+
+	if len(prefs) > 0 {
+		if err := s.store.UpsertPreferences(ctx, userID, prefs); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.store.GetPreferences(ctx, userID)
+}
+
+func (s *preferenceService) GetDigestSettings(ctx context.Context, userID uint64) (models.DigestSettings, error) {
+	return s.digests.GetDigestSettings(ctx, userID)
+}
+
+func (s *preferenceService) UpdateDigestSettings(ctx context.Context, userID uint64, settings models.DigestSettings) (models.DigestSettings, error) {
+	if !models.IsValidDigestMode(settings.Mode) {
+		return models.DigestSettings{}, fmt.Errorf("%w: unknown mode %q", errs.ErrInvalidDigestSettings, settings.Mode)
+	}
+	// An empty timezone follows the one in the user's account settings
+	if settings.Timezone != "" && !tz.Valid(settings.Timezone) {
+		return models.DigestSettings{}, fmt.Errorf("%w: unknown timezone %q", errs.ErrInvalidDigestSettings, settings.Timezone)
+	}
+	if settings.QuietHoursStart < 0 || settings.QuietHoursStart > 23 || settings.QuietHoursEnd < 0 || settings.QuietHoursEnd > 23 {
+		return models.DigestSettings{}, fmt.Errorf("%w: quiet hours must be between 0 and 23", errs.ErrInvalidDigestSettings)
+	}
+
+	if err := s.digests.UpsertDigestSettings(ctx, userID, settings); err != nil {
+		return models.DigestSettings{}, err
+	}
+
+	return s.digests.GetDigestSettings(ctx, userID)
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"metargb/notifications-service/internal/errs"
+	"metargb/notifications-service/internal/models"
+	"metargb/shared/pkg/jobs"
+)
+
+const (
+	// DefaultSMSMessagesPerSecond caps providers without an entry in SMSProviderRates
+	DefaultSMSMessagesPerSecond = 1.0
+	// DefaultSMSMaxWait is how long a send waits for a free slot before it is throttled
+	DefaultSMSMaxWait = 5 * time.Second
+	// DefaultSMSQuietHoursStart and DefaultSMSQuietHoursEnd bound the night,
+	// in Tehran time, during which operators refuse bulk messages
+	DefaultSMSQuietHoursStart = 22
+	DefaultSMSQuietHoursEnd   = 8
+	// DefaultSMSQueueSize bounds the messages held until quiet hours end
+	DefaultSMSQueueSize = 10000
+
+	// QueuedSMSMessageIDPrefix starts the message id returned for messages held
+	// until quiet hours end, which have no provider id yet
+	QueuedSMSMessageIDPrefix = "quiet-hours:"
+
+	smsQueueCheckInterval = time.Minute
+	smsQueuedSendTimeout  = 30 * time.Second
+)
+
+// SMSProviderRates are the default messages-per-second caps of each provider's sender line
+var SMSProviderRates = map[string]float64{
+	"kavenegar": 5,
+}
+
+// SMS send outcomes counted by smsMessages
+const (
+	smsOutcomeSent      = "sent"
+	smsOutcomeQueued    = "queued"
+	smsOutcomeThrottled = "throttled"
+	smsOutcomeFailed    = "failed"
+)
+
+var (
+	smsMessages = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "metargb",
+			Subsystem: "notifications",
+			Name:      "sms_messages_total",
+			Help:      "SMS messages by provider and outcome: sent, queued for the end of quiet hours, throttled or failed",
+		},
+		[]string{"provider", "outcome"},
+	)
+	smsQueueDepth = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "metargb",
+			Subsystem: "notifications",
+			Name:      "sms_quiet_hours_queue",
+			Help:      "SMS messages waiting for quiet hours to end",
+		},
+		[]string{"provider"},
+	)
+)
+
+// SMSShapingConfig controls how fast and when messages reach the provider
+type SMSShapingConfig struct {
+	Provider string
+	// MessagesPerSecond caps sends to the provider; zero or less disables the cap
+	MessagesPerSecond float64
+	// MaxWait is how long a send may wait for a slot before ErrSMSThrottled
+	MaxWait time.Duration
+	// QuietHoursStart and QuietHoursEnd are hours (0-23) in Timezone during
+	// which bulk messages are queued. Equal values disable quiet hours.
+	QuietHoursStart int
+	QuietHoursEnd   int
+	Timezone        string
+	// QueueSize bounds the queued messages; further ones are throttled
+	QueueSize int
+}
+
+// DefaultSMSShapingConfig returns the defaults for provider
+func DefaultSMSShapingConfig(provider string) SMSShapingConfig {
+	rate, ok := SMSProviderRates[provider]
+	if !ok {
+		rate = DefaultSMSMessagesPerSecond
+	}
+	return SMSShapingConfig{
+		Provider:          provider,
+		MessagesPerSecond: rate,
+		MaxWait:           DefaultSMSMaxWait,
+		QuietHoursStart:   DefaultSMSQuietHoursStart,
+		QuietHoursEnd:     DefaultSMSQuietHoursEnd,
+		Timezone:          models.DefaultDigestTimezone,
+		QueueSize:         DefaultSMSQueueSize,
+	}
+}
+
+// ShapedSMSChannel keeps a provider's sender line within operator rules: sends
+// are spaced to the provider's messages-per-second cap, and bulk messages sent
+// during quiet hours are queued until morning. OTPs and template (verify
+// lookup) messages are transactional and are never queued.
+//
+// The queue is held in memory, so messages queued when the service stops are
+// lost; their count is logged.
+type ShapedSMSChannel struct {
+	channel SMSChannel
+	config  SMSShapingConfig
+	quiet   models.DigestSettings
+	now     func() time.Time
+	sleep   func(ctx context.Context, d time.Duration) error
+
+	mu       sync.Mutex
+	nextSlot time.Time
+	queue    []models.SMSPayload
+	queued   uint64
+}
+
+// NewShapedSMSChannel wraps channel with the limits of config
+func NewShapedSMSChannel(channel SMSChannel, config SMSShapingConfig) *ShapedSMSChannel {
+	if config.MaxWait < 0 {
+		config.MaxWait = 0
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = DefaultSMSQueueSize
+	}
+	if config.Provider == "" {
+		config.Provider = "none"
+	}
+	return &ShapedSMSChannel{
+		channel: channel,
+		config:  config,
+		quiet: models.DigestSettings{
+			Timezone:        config.Timezone,
+			QuietHoursStart: config.QuietHoursStart,
+			QuietHoursEnd:   config.QuietHoursEnd,
+		},
+		now:   time.Now,
+		sleep: sleepContext,
+	}
+}
+
+// Register sends the queued messages once quiet hours end, checking every
+// smsQueueCheckInterval under runner
+func (c *ShapedSMSChannel) Register(runner *jobs.Runner) {
+	runner.Add("sms-queue-flush", smsQueueCheckInterval, func(ctx context.Context) error {
+		c.Flush(ctx)
+		return nil
+	})
+}
+
+func (c *ShapedSMSChannel) SendSMS(ctx context.Context, payload models.SMSPayload) (string, error) {
+	if payload.Template == "" && c.quiet.InQuietHours(c.now()) {
+		return c.enqueue(payload)
+	}
+	if err := c.wait(ctx, c.config.MaxWait); err != nil {
+		return "", err
+	}
+	return c.count(c.channel.SendSMS(ctx, payload))
+}
+
+func (c *ShapedSMSChannel) SendOTP(ctx context.Context, payload models.OTPPayload) (string, error) {
+	if err := c.wait(ctx, c.config.MaxWait); err != nil {
+		return "", err
+	}
+	return c.count(c.channel.SendOTP(ctx, payload))
+}
+
+// Flush sends the queued messages at the rate cap unless quiet hours are
+// still on. Messages the provider rejects are logged and dropped.
+func (c *ShapedSMSChannel) Flush(ctx context.Context) {
+	for !c.quiet.InQuietHours(c.now()) {
+		c.mu.Lock()
+		if len(c.queue) == 0 {
+			c.mu.Unlock()
+			return
+		}
+		payload := c.queue[0]
+		c.queue = c.queue[1:]
+		smsQueueDepth.WithLabelValues(c.config.Provider).Set(float64(len(c.queue)))
+		c.mu.Unlock()
+
+		// Queued messages have no caller waiting, so they wait as long as needed
+		if err := c.wait(ctx, -1); err != nil {
+			c.requeue(payload)
+			return
+		}
+		sendCtx, cancel := context.WithTimeout(ctx, smsQueuedSendTimeout)
+		if _, err := c.count(c.channel.SendSMS(sendCtx, payload)); err != nil {
+			log.Printf("Warning: failed to send queued SMS: %v", err)
+		}
+		cancel()
+	}
+}
+
+// QueueLength returns the number of messages waiting for quiet hours to end
+func (c *ShapedSMSChannel) QueueLength() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.queue)
+}
+
+func (c *ShapedSMSChannel) enqueue(payload models.SMSPayload) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.queue) >= c.config.QueueSize {
+		smsMessages.WithLabelValues(c.config.Provider, smsOutcomeThrottled).Inc()
+		return "", errs.ErrSMSThrottled
+	}
+	c.queue = append(c.queue, payload)
+	c.queued++
+	smsMessages.WithLabelValues(c.config.Provider, smsOutcomeQueued).Inc()
+	smsQueueDepth.WithLabelValues(c.config.Provider).Set(float64(len(c.queue)))
+	return fmt.Sprintf("%s%d", QueuedSMSMessageIDPrefix, c.queued), nil
+}
+
+func (c *ShapedSMSChannel) requeue(payload models.SMSPayload) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.queue = append([]models.SMSPayload{payload}, c.queue...)
+	smsQueueDepth.WithLabelValues(c.config.Provider).Set(float64(len(c.queue)))
+}
+
+// wait takes the next send slot and sleeps until it comes. A slot further
+// away than maxWait, or past ctx's deadline, throttles the send instead;
+// a negative maxWait waits for any slot.
+func (c *ShapedSMSChannel) wait(ctx context.Context, maxWait time.Duration) error {
+	if c.config.MessagesPerSecond <= 0 {
+		return nil
+	}
+	interval := time.Duration(float64(time.Second) / c.config.MessagesPerSecond)
+
+	c.mu.Lock()
+	now := c.now()
+	slot := c.nextSlot
+	if slot.Before(now) {
+		slot = now
+	}
+	delay := slot.Sub(now)
+	deadline, hasDeadline := ctx.Deadline()
+	if (maxWait >= 0 && delay > maxWait) || (hasDeadline && slot.After(deadline)) {
+		c.mu.Unlock()
+		smsMessages.WithLabelValues(c.config.Provider, smsOutcomeThrottled).Inc()
+		return errs.ErrSMSThrottled
+	}
+	c.nextSlot = slot.Add(interval)
+	c.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	return c.sleep(ctx, delay)
+}
+
+func (c *ShapedSMSChannel) count(messageID string, err error) (string, error) {
+	outcome := smsOutcomeSent
+	if err != nil {
+		outcome = smsOutcomeFailed
+	}
+	smsMessages.WithLabelValues(c.config.Provider, outcome).Inc()
+	return messageID, err
+}
+
+// IsQueuedSMS reports whether messageID was returned for a message held until
+// quiet hours end
+func IsQueuedSMS(messageID string) bool {
+	return strings.HasPrefix(messageID, QueuedSMSMessageIDPrefix)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
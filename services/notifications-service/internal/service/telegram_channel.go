@@ -0,0 +1,138 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"metargb/notifications-service/internal/errs"
+	"metargb/notifications-service/internal/models"
+)
+
+// DefaultTelegramAPIURL is the Telegram Bot API endpoint
+const DefaultTelegramAPIURL = "https://api.telegram.org"
+
+// TelegramUpdate is an incoming update from getUpdates; only messages are used
+type TelegramUpdate struct {
+	UpdateID int64            `json:"update_id"`
+	Message  *TelegramMessage `json:"message"`
+}
+
+// TelegramMessage is a message sent to the bot
+type TelegramMessage struct {
+	MessageID int64 `json:"message_id"`
+	Chat      struct {
+		ID       int64  `json:"id"`
+		Username string `json:"username"`
+	} `json:"chat"`
+	Text string `json:"text"`
+}
+
+// TelegramBot sends messages and reads updates through the Telegram Bot API.
+type TelegramBot struct {
+	token    string
+	username string
+	apiURL   string
+	client   *http.Client
+}
+
+// NewTelegramBot creates a bot client. username is the bot's @name without
+// the @, used for t.me links.
+func NewTelegramBot(token, username, apiURL string) *TelegramBot {
+	if apiURL == "" {
+		apiURL = DefaultTelegramAPIURL
+	}
+	return &TelegramBot{
+		token:    token,
+		username: username,
+		apiURL:   apiURL,
+		client:   &http.Client{Timeout: time.Minute},
+	}
+}
+
+func (b *TelegramBot) Provider() string {
+	return models.BotProviderTelegram
+}
+
+func (b *TelegramBot) LinkURL(code string) string {
+	return fmt.Sprintf("https://t.me/%s?start=%s", b.username, url.QueryEscape(code))
+}
+
+func (b *TelegramBot) SendMessage(ctx context.Context, chatID, text string) (string, error) {
+	var message TelegramMessage
+	err := b.call(ctx, "sendMessage", map[string]interface{}{
+		"chat_id": chatID,
+		"text":    text,
+	}, &message)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(message.MessageID, 10), nil
+}
+
+// GetUpdates long-polls for updates after offset, waiting up to timeout
+func (b *TelegramBot) GetUpdates(ctx context.Context, offset int64, timeout time.Duration) ([]TelegramUpdate, error) {
+	var updates []TelegramUpdate
+	err := b.call(ctx, "getUpdates", map[string]interface{}{
+		"offset":          offset,
+		"timeout":         int(timeout.Seconds()),
+		"allowed_updates": []string{"message"},
+	}, &updates)
+	return updates, err
+}
+
+type telegramResponse struct {
+	OK          bool            `json:"ok"`
+	Result      json.RawMessage `json:"result"`
+	ErrorCode   int             `json:"error_code"`
+	Description string          `json:"description"`
+}
+
+func (b *TelegramBot) call(ctx context.Context, method string, params map[string]interface{}, result interface{}) error {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("failed to encode telegram %s request: %w", method, err)
+	}
+
+	endpoint := fmt.Sprintf("%s/bot%s/%s", b.apiURL, b.token, method)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create telegram %s request: %w", method, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		// Drop the URL from the error, it holds the token
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("telegram %s request failed: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	var decoded telegramResponse
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return fmt.Errorf("failed to decode telegram %s response (status %d): %w", method, resp.StatusCode, err)
+	}
+	if !decoded.OK {
+		// 403 means the user blocked the bot or deleted the chat
+		if decoded.ErrorCode == http.StatusForbidden {
+			return fmt.Errorf("%w: %s", errs.ErrBotChatUnavailable, decoded.Description)
+		}
+		return fmt.Errorf("telegram %s failed (%d): %s", method, decoded.ErrorCode, decoded.Description)
+	}
+	if result != nil {
+		if err := json.Unmarshal(decoded.Result, result); err != nil {
+			return fmt.Errorf("failed to decode telegram %s result: %w", method, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"metargb/notifications-service/internal/models"
+)
+
+const (
+	// DefaultTelegramPollTimeout is how long one getUpdates call waits for messages
+	DefaultTelegramPollTimeout = 30 * time.Second
+	// telegramPollRetryDelay is the pause after a failed getUpdates call
+	telegramPollRetryDelay = 5 * time.Second
+)
+
+// TelegramUpdateSource returns the messages sent to the bot
+type TelegramUpdateSource interface {
+	GetUpdates(ctx context.Context, offset int64, timeout time.Duration) ([]TelegramUpdate, error)
+}
+
+// TelegramUpdatesWorker long-polls the Telegram Bot API for messages sent to
+// the bot and hands them to the bot link service. Telegram delivers updates
+// to one poller at a time, so only one replica should run it.
+type TelegramUpdatesWorker struct {
+	updates     TelegramUpdateSource
+	links       BotLinkService
+	pollTimeout time.Duration
+	offset      int64
+}
+
+// NewTelegramUpdatesWorker creates a worker that waits up to pollTimeout per poll
+func NewTelegramUpdatesWorker(updates TelegramUpdateSource, links BotLinkService, pollTimeout time.Duration) *TelegramUpdatesWorker {
+	if pollTimeout <= 0 {
+		pollTimeout = DefaultTelegramPollTimeout
+	}
+	return &TelegramUpdatesWorker{
+		updates:     updates,
+		links:       links,
+		pollTimeout: pollTimeout,
+	}
+}
+
+// Start polls until ctx is cancelled
+func (w *TelegramUpdatesWorker) Start(ctx context.Context) {
+	go func() {
+		for ctx.Err() == nil {
+			if _, err := w.Run(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("Telegram updates poll failed: %v", err)
+				select {
+				case <-ctx.Done():
+				case <-time.After(telegramPollRetryDelay):
+				}
+			}
+		}
+	}()
+}
+
+// Run handles one batch of updates and returns how many messages were handled
+func (w *TelegramUpdatesWorker) Run(ctx context.Context) (int, error) {
+	updates, err := w.updates.GetUpdates(ctx, w.offset, w.pollTimeout)
+	if err != nil {
+		return 0, err
+	}
+
+	handled := 0
+	for _, update := range updates {
+		// Updates up to the offset are confirmed on the next poll, so a
+		// message that fails is not retried forever
+		w.offset = update.UpdateID + 1
+		if update.Message == nil || update.Message.Text == "" {
+			continue
+		}
+		message := models.BotMessage{
+			ChatID:   strconv.FormatInt(update.Message.Chat.ID, 10),
+			Username: update.Message.Chat.Username,
+			Text:     update.Message.Text,
+		}
+		if err := w.links.HandleMessage(ctx, message); err != nil {
+			log.Printf("Failed to handle telegram message from chat %s: %v", message.ChatID, err)
+			continue
+		}
+		handled++
+	}
+	return handled, nil
+}
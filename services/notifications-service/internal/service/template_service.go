@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"metargb/notifications-service/internal/errs"
+	"metargb/notifications-service/internal/models"
+)
+
+// testSubjectPrefix marks test sends in the recipient's inbox
+const testSubjectPrefix = "[TEST] "
+
+var testPhonePattern = regexp.MustCompile(`^09\d{9}$`)
+
+// sampleTemplateData fills the template fields a preview or test send does not give
+var sampleTemplateData = map[string]interface{}{
+	"RecipientName":      "علی رضایی",
+	"RecipientEmail":     "ali.rezaei@example.com",
+	"UserCode":           "HM-2000145",
+	"OwnerName":          "سارا احمدی",
+	"OwnerCode":          "HM-2000087",
+	"BuyerName":          "علی رضایی",
+	"BuyerCode":          "HM-2000145",
+	"SellerName":         "سارا احمدی",
+	"SellerCode":         "HM-2000087",
+	"RequesterName":      "علی رضایی",
+	"RequesterCode":      "HM-2000145",
+	"OtpCode":            "482193",
+	"ExpirationWindow":   "۲ دقیقه",
+	"ExpiresIn":          "۶۰ دقیقه",
+	"RequestIP":          "5.160.12.34",
+	"RequestedAt":        "1405/07/25 10:15",
+	"PrimaryAction":      map[string]interface{}{"URL": "https://rgb.irpsc.com/metaverse", "Label": "ورود به متارنگ"},
+	"ResetURL":           "https://rgb.irpsc.com/reset-password?token=sample",
+	"VerifyURL":          "https://rgb.irpsc.com/verify-email?token=sample",
+	"ReRegisterURL":      "https://rgb.irpsc.com/register",
+	"SignupDate":         "1405/07/25",
+	"SignupTime":         "10:15",
+	"AssetTitle":         "رنگ زرد",
+	"Quantity":           "1,000",
+	"PaidAmount":         "1,500,000 ریال",
+	"PaidAmountPSC":      "12.5",
+	"PaymentId":          "PAY-90871",
+	"TransactionId":      "TR-554120",
+	"TransactionDate":    "1405/07/25",
+	"TransactionTime":    "10:15",
+	"FeatureID":          "HM-12-40512",
+	"FeatureTitle":       "زمین مسکونی HM-12-40512",
+	"FeatureArea":        "250",
+	"FeatureApplication": "مسکونی",
+	"FeatureDensity":     "3",
+	"FeatureCoordinates": "35.6892, 51.3890",
+	"FeatureAddress":     "تهران، خیابان آزادی",
+	"PriceIRR":           "25,000,000",
+	"PricePSC":           "180",
+	"OfferIRR":           "24,000,000",
+	"OfferPSC":           "170",
+	"RequestId":          "BR-3301",
+	"CreatedDate":        "1405/07/25",
+	"CreatedTime":        "10:15",
+	"LoginDate":          "1405/07/25",
+	"LoginTime":          "10:15",
+	"IPAddress":          "5.160.12.34",
+	"UserAgent":          "Mozilla/5.0 (Windows NT 10.0; Win64; x64) Chrome/126.0",
+	"Location":           "تهران، ایران",
+	"DynastyName":        "خاندان رضایی",
+	"DynastyCode":        "DY-1024",
+	"Message":            "سلام، مایلم به خاندان شما بپیوندم.",
+	"SubmittedDate":      "1405/07/25",
+	"SubmittedTime":      "10:15",
+	"Role":               "فرزند",
+	"AcceptedBy":         "سارا احمدی",
+	"AcceptedAt":         "1405/07/25 10:15",
+	"RejectedAt":         "1405/07/25 10:15",
+	"RejectionReason":    "ظرفیت خاندان تکمیل است.",
+	"ManageURL":          "https://rgb.irpsc.com/metaverse/dynasty",
+	"CancelURL":          "https://rgb.irpsc.com/metaverse/requests",
+	"DeclineURL":         "https://rgb.irpsc.com/metaverse/requests",
+	"DashboardURL":       "https://rgb.irpsc.com/metaverse/dynasty",
+	"GuidelineURL":       "https://rgb.irpsc.com/guide/dynasty",
+	"ExploreURL":         "https://rgb.irpsc.com/metaverse/dynasty",
+	"ProfileURL":         "https://rgb.irpsc.com/citizen/HM-2000145",
+	"DisputeURL":         "https://rgb.irpsc.com/support",
+	"LockURL":            "https://rgb.irpsc.com/settings/security",
+	"SecurityURL":        "https://rgb.irpsc.com/settings/security",
+	"ContactURL":         "https://rgb.irpsc.com/contact",
+	"SupportURL":         "https://rgb.irpsc.com/support",
+	"FaqURL":             "https://rgb.irpsc.com/faq",
+}
+
+// TemplateService lets notification admins preview email templates and send
+// test copies to a single recipient, outside of preferences and digests.
+type TemplateService interface {
+	ListTemplates(ctx context.Context, adminID uint64) ([]string, error)
+	PreviewTemplate(ctx context.Context, adminID uint64, render models.TemplateRender) (*models.TemplatePreview, error)
+	TestSendTemplate(ctx context.Context, adminID uint64, send models.TemplateTestSend) (*models.TemplateTestResult, error)
+}
+
+type templateService struct {
+	templates    *EmailTemplates
+	smsChannel   SMSChannel
+	emailChannel EmailChannel
+	admins       map[uint64]bool
+}
+
+// NewTemplateService creates a template service that only adminIDs may use.
+// Without templates every call fails with errs.ErrNotImplemented.
+func NewTemplateService(templates *EmailTemplates, smsChannel SMSChannel, emailChannel EmailChannel, adminIDs []uint64) TemplateService {
+	admins := make(map[uint64]bool, len(adminIDs))
+	for _, id := range adminIDs {
+		admins[id] = true
+	}
+	return &templateService{
+		templates:    templates,
+		smsChannel:   smsChannel,
+		emailChannel: emailChannel,
+		admins:       admins,
+	}
+}
+
+func (s *templateService) ListTemplates(ctx context.Context, adminID uint64) ([]string, error) {
+	if err := s.authorize(adminID); err != nil {
+		return nil, err
+	}
+	return s.templates.Names(), nil
+}
+
+func (s *templateService) PreviewTemplate(ctx context.Context, adminID uint64, render models.TemplateRender) (*models.TemplatePreview, error) {
+	if err := s.authorize(adminID); err != nil {
+		return nil, err
+	}
+	return s.render(render)
+}
+
+// TestSendTemplate checks every recipient before sending, so a bad phone does
+// not leave an email already sent.
+func (s *templateService) TestSendTemplate(ctx context.Context, adminID uint64, send models.TemplateTestSend) (*models.TemplateTestResult, error) {
+	if err := s.authorize(adminID); err != nil {
+		return nil, err
+	}
+	if send.Email == "" && send.Phone == "" {
+		return nil, fmt.Errorf("%w: email or phone is required", errs.ErrInvalidTestRecipient)
+	}
+
+	var preview *models.TemplatePreview
+	if send.Email != "" {
+		if addr, err := mail.ParseAddress(send.Email); err != nil || addr.Address != send.Email {
+			return nil, fmt.Errorf("%w: email is not a valid address", errs.ErrInvalidTestRecipient)
+		}
+		rendered, err := s.render(send.TemplateRender)
+		if err != nil {
+			return nil, err
+		}
+		preview = rendered
+	}
+	if send.Phone != "" {
+		if !testPhonePattern.MatchString(send.Phone) {
+			return nil, fmt.Errorf("%w: phone must be a mobile number like 09123456789", errs.ErrInvalidTestRecipient)
+		}
+		if send.SMSTemplate == "" && send.SMSMessage == "" {
+			return nil, fmt.Errorf("%w: sms_template or sms_message is required to send to a phone", errs.ErrInvalidTestRecipient)
+		}
+	}
+
+	result := &models.TemplateTestResult{}
+	if preview != nil {
+		messageID, err := s.emailChannel.SendEmail(ctx, models.EmailPayload{
+			To:       send.Email,
+			Subject:  testSubjectPrefix + preview.Subject,
+			HTMLBody: preview.HTMLBody,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result.EmailMessageID = messageID
+	}
+	if send.Phone != "" {
+		messageID, err := s.smsChannel.SendSMS(ctx, models.SMSPayload{
+			Phone:    send.Phone,
+			Message:  send.SMSMessage,
+			Template: send.SMSTemplate,
+			Tokens:   send.Data,
+		})
+		if err != nil {
+			return result, err
+		}
+		result.SMSMessageID = messageID
+	}
+
+	return result, nil
+}
+
+func (s *templateService) authorize(adminID uint64) error {
+	if !s.admins[adminID] {
+		return errs.ErrNotTemplateAdmin
+	}
+	if s.templates == nil {
+		return errs.ErrNotImplemented
+	}
+	return nil
+}
+
+// render fills the fields the template reads but render does not give with
+// sample values and renders the template
+func (s *templateService) render(render models.TemplateRender) (*models.TemplatePreview, error) {
+	fields, err := s.templates.Fields(render.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	subject := render.Subject
+	if subject == "" {
+		subject = render.Template
+	}
+	data := templateData(render.Data)
+	data["Subject"] = subject
+
+	var sampled []string
+	for _, field := range fields {
+		if _, ok := data[field]; ok {
+			continue
+		}
+		if sample, ok := sampleTemplateData[field]; ok {
+			data[field] = sample
+			sampled = append(sampled, field)
+		}
+	}
+
+	body, err := s.templates.Render(render.Template, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.TemplatePreview{
+		Template:     render.Template,
+		Subject:      subject,
+		HTMLBody:     body,
+		Fields:       fields,
+		SampleFields: sampled,
+	}, nil
+}
+
+// templateData turns flat request data into template data, nesting dotted
+// keys such as Footer.Tagline
+func templateData(values map[string]string) map[string]interface{} {
+	data := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		parent, child, nested := strings.Cut(key, ".")
+		if !nested {
+			data[key] = value
+			continue
+		}
+		fields, ok := data[parent].(map[string]interface{})
+		if !ok {
+			fields = make(map[string]interface{})
+			data[parent] = fields
+		}
+		fields[child] = value
+	}
+	return data
+}
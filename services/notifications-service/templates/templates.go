@@ -0,0 +1,10 @@
+// Package templates embeds the notification templates so the service binary
+// does not depend on the working directory it is started from.
+package templates
+
+import "embed"
+
+// FS holds the email templates under email/
+//
+//go:embed email
+var FS embed.FS
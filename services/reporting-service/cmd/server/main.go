@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/joho/godotenv"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"metargb/reporting-service/internal/repository"
+	"metargb/reporting-service/internal/service"
+	pbNotifications "metargb/shared/pb/notifications"
+	pbStats "metargb/shared/pb/stats"
+	"metargb/shared/pkg/auth"
+	"metargb/shared/pkg/buildinfo"
+	shareddb "metargb/shared/pkg/db"
+	"metargb/shared/pkg/dbsplit"
+	"metargb/shared/pkg/deadline"
+	"metargb/shared/pkg/debug"
+	"metargb/shared/pkg/devmode"
+	"metargb/shared/pkg/faultinject"
+	healthprobe "metargb/shared/pkg/health"
+	"metargb/shared/pkg/jobs"
+	"metargb/shared/pkg/logger"
+	"metargb/shared/pkg/msgsize"
+)
+
+func main() {
+	// Load environment variables
+	envErr := godotenv.Load()
+
+	// Initialize logger once the environment is loaded so LOG_LEVEL applies
+	log := logger.NewLogger("reporting-service")
+	log.RedirectStdLog()
+	if envErr != nil {
+		log.Warn(".env file not found", "error", envErr)
+	}
+
+	// SIGHUP toggles debug logging; REPORTING_LOG_ADMIN_ADDR serves the level over HTTP
+	logCtx, stopLogControl := context.WithCancel(context.Background())
+	defer stopLogControl()
+	log.EnableLevelControl(logCtx)
+
+	dsn := shareddb.ServiceDSN("reporting-service", fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
+		getEnv("DB_USER", "root"),
+		getEnv("DB_PASSWORD", ""),
+		getEnv("DB_HOST", "localhost"),
+		getEnv("DB_PORT", "3306"),
+		getEnv("DB_DATABASE", "metargb_db"),
+	))
+
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		log.Fatal("Failed to connect to database", "error", err)
+	}
+	defer db.Close()
+
+	db.SetMaxOpenConns(5)
+	db.SetMaxIdleConns(2)
+	db.SetConnMaxLifetime(5 * time.Minute)
+
+	// Wait for the database instead of crash looping while it is unavailable
+	if err := shareddb.PingWithRetry(context.Background(), db, shareddb.RetryPolicyFromEnv()); err != nil {
+		log.Fatal("Failed to ping database", "error", err)
+	}
+	log.Info("Successfully connected to database")
+
+	reportRepo := repository.NewReportRepository(db)
+
+	// Report metrics are collected from each service's StatsService, which
+	// needs an API key holding the service:reports scope
+	serviceAPIKey := getEnv(auth.ServiceAPIKeyEnv, "")
+	if serviceAPIKey == "" {
+		log.Warn("SERVICE_API_KEY is not set - services will reject metric requests")
+	}
+	var sources []service.StatsSource
+	for _, target := range []struct{ name, envKey, addr string }{
+		{"auth-service", "AUTH_SERVICE_ADDR", "auth-service:50051"},
+		{"features-service", "FEATURES_SERVICE_ADDR", "features-service:50053"},
+		{"commercial-service", "COMMERCIAL_SERVICE_ADDR", "commercial-service:50052"},
+		{"support-service", "SUPPORT_SERVICE_ADDR", "support-service:50056"},
+	} {
+		source := service.StatsSource{Name: target.name}
+		conn, err := grpc.Dial(getEnv(target.envKey, target.addr), grpc.WithTransportCredentials(insecure.NewCredentials()), auth.WithServiceAPIKey(serviceAPIKey))
+		if err != nil {
+			log.Warn("Failed to connect to service - its metrics will be unavailable", "service", target.name, "error", err)
+		} else {
+			defer conn.Close()
+			source.Client = pbStats.NewStatsServiceClient(conn)
+		}
+		sources = append(sources, source)
+	}
+
+	var emailClient pbNotifications.EmailServiceClient
+	notificationConn, err := grpc.Dial(getEnv("NOTIFICATION_SERVICE_ADDR", "notifications-service:50058"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Warn("Failed to connect to notifications service - reports will not be sent", "error", err)
+	} else {
+		defer notificationConn.Close()
+		emailClient = pbNotifications.NewEmailServiceClient(notificationConn)
+	}
+
+	loc, err := time.LoadLocation(getEnv("REPORT_TIMEZONE", "Asia/Tehran"))
+	if err != nil {
+		log.Warn("Invalid REPORT_TIMEZONE, using UTC", "error", err)
+		loc = time.UTC
+	}
+
+	checkInterval := service.DefaultReportCheckInterval
+	if v := getEnv("REPORT_CHECK_INTERVAL", ""); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			checkInterval = d
+		} else {
+			log.Warn("Invalid REPORT_CHECK_INTERVAL, using default", "value", v, "default", checkInterval)
+		}
+	}
+	// Background jobs are timed, counted and listed on jobs.Path
+	workerCtx, stopWorker := context.WithCancel(context.Background())
+	defer stopWorker()
+	backgroundJobs := jobs.NewRunner("reporting-service", log)
+	service.NewReportWorker(reportRepo, sources, emailClient, loc, checkInterval).Register(backgroundJobs)
+	backgroundJobs.Start(workerCtx)
+
+	limits := msgsize.FromEnv("reporting-service", msgsize.Defaults())
+	serverOpts := append(limits.ServerOptions(),
+		grpc.ChainUnaryInterceptor(logger.UnaryServerInterceptor(log)),
+		grpc.ChainStreamInterceptor(logger.StreamServerInterceptor(log)),
+	)
+
+	// Cap how long handlers run so slow queries are cancelled, see deadline.FromEnv
+	serverOpts = append(serverOpts, deadline.FromEnv("reporting-service", deadline.Defaults()).ServerOptions()...)
+
+	// Staging builds inject latency and errors into chosen methods, see faultinject
+	faultCtx, stopFaults := context.WithCancel(context.Background())
+	defer stopFaults()
+	serverOpts = append(serverOpts, faultinject.FromEnv(faultCtx, "reporting-service", log)...)
+
+	// Outside development internal errors reach callers only as a reference, see devmode
+	serverOpts = append(serverOpts, devmode.FromEnv("reporting-service", log)...)
+
+	// Every response names the build that served it, see buildinfo
+	serverOpts = append(serverOpts, buildinfo.ServerOptions()...)
+	grpcServer := grpc.NewServer(serverOpts...)
+
+	// Report readiness over HTTP and through the standard gRPC health service:
+	// the database is reachable, its tables exist and the server has started
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	defer stopHealth()
+	dbMonitor := shareddb.NewHealthMonitor(db, nil)
+	dbMonitor.Start(healthCtx)
+	probe := healthprobe.NewProbe("reporting-service").
+		AddCheck("database", dbMonitor.Check).
+		AddCheck("migrations", shareddb.NewSchemaGuard(db).RequireTables(dbsplit.Ownership["reporting-service"]...))
+	serving := probe.Starting("grpc")
+	probe.Watch(healthCtx, healthServer, healthprobe.DefaultInterval)
+	probeServer := healthprobe.NewServer(":"+getEnv("HEALTH_PORT", healthprobe.DefaultPort), probe)
+	go func() {
+		if err := probeServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Health probe server failed", "error", err)
+		}
+	}()
+
+	// Reflection is only registered in development, see devmode
+	devmode.Register(grpcServer, log)
+
+	// Profiling endpoints, off unless DEBUG_ADDR is set or in development, see debug
+	debug.Serve(context.Background(), "reporting-service", log)
+
+	port := getEnv("GRPC_PORT", "50063")
+	listener, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		log.Fatal("Failed to listen", "error", err, "port", port)
+	}
+
+	log.Info("Reporting service listening", "port", port)
+
+	go func() {
+		if err := grpcServer.Serve(listener); err != nil {
+			log.Fatal("Failed to serve", "error", err)
+		}
+	}()
+	serving()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Info("Shutting down server...")
+	stopWorker()
+	probe.Drain()
+	healthServer.Shutdown()
+	grpcServer.GracefulStop()
+	probeServer.Close()
+	log.Info("Server stopped")
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Report frequencies
+const (
+	FrequencyDaily  = "daily"
+	FrequencyWeekly = "weekly"
+)
+
+// ReportDefinition represents a scheduled admin report stored in report_definitions
+type ReportDefinition struct {
+	ID         uint64 `db:"id"`
+	Name       string `db:"name"`
+	Frequency  string `db:"frequency"`
+	Metrics    string `db:"metrics"`    // Comma separated metric keys, empty for all
+	Recipients string `db:"recipients"` // Comma separated email addresses
+	Enabled    bool   `db:"enabled"`
+	// LastPeriodEnd is the end of the last period the report was sent for
+	LastPeriodEnd *time.Time `db:"last_period_end"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+}
+
+// MetricKeys returns the metric keys the report includes, nil for all
+func (d *ReportDefinition) MetricKeys() []string {
+	return splitList(d.Metrics)
+}
+
+// RecipientList returns the email addresses the report is sent to
+func (d *ReportDefinition) RecipientList() []string {
+	return splitList(d.Recipients)
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
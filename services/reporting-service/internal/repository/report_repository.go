@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"metargb/reporting-service/internal/models"
+	"time"
+)
+
+type ReportRepository interface {
+	ListEnabled(ctx context.Context) ([]*models.ReportDefinition, error)
+	MarkSent(ctx context.Context, reportID uint64, periodEnd time.Time) error
+}
+
+type reportRepository struct {
+	db *sql.DB
+}
+
+func NewReportRepository(db *sql.DB) ReportRepository {
+	return &reportRepository{db: db}
+}
+
+func (r *reportRepository) ListEnabled(ctx context.Context) ([]*models.ReportDefinition, error) {
+	query := `
+		SELECT id, name, frequency, COALESCE(metrics, ''), recipients, enabled, last_period_end, created_at, updated_at
+		FROM report_definitions
+		WHERE enabled = 1
+		ORDER BY id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report definitions: %w", err)
+	}
+	defer rows.Close()
+
+	var reports []*models.ReportDefinition
+	for rows.Next() {
+		var report models.ReportDefinition
+		err := rows.Scan(
+			&report.ID, &report.Name, &report.Frequency, &report.Metrics, &report.Recipients,
+			&report.Enabled, &report.LastPeriodEnd, &report.CreatedAt, &report.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan report definition: %w", err)
+		}
+		reports = append(reports, &report)
+	}
+
+	return reports, rows.Err()
+}
+
+func (r *reportRepository) MarkSent(ctx context.Context, reportID uint64, periodEnd time.Time) error {
+	query := `UPDATE report_definitions SET last_period_end = ?, updated_at = NOW() WHERE id = ?`
+
+	if _, err := r.db.ExecContext(ctx, query, periodEnd, reportID); err != nil {
+		return fmt.Errorf("failed to mark report sent: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"metargb/reporting-service/internal/models"
+	"metargb/reporting-service/internal/repository"
+
+	pbNotifications "metargb/shared/pb/notifications"
+	pbStats "metargb/shared/pb/stats"
+	"metargb/shared/pkg/helpers"
+	"metargb/shared/pkg/jobs"
+)
+
+// DefaultReportCheckInterval is how often report definitions are checked for due reports
+const DefaultReportCheckInterval = 15 * time.Minute
+
+// metricLabels are the display names of the metrics reported by the services
+var metricLabels = map[string]string{
+	"new_users":        "کاربران جدید",
+	"trades":           "تعداد معاملات",
+	"trade_volume_psc": "حجم معاملات (PSC)",
+	"trade_volume_irr": "حجم معاملات (ریال)",
+	"payments":         "تعداد پرداخت‌ها",
+	"revenue_irr":      "درآمد (ریال)",
+	"tickets":          "تیکت‌های جدید",
+	"sla_breaches":     "تیکت‌های خارج از SLA",
+}
+
+// StatsSource is a service queried for report metrics
+type StatsSource struct {
+	Name   string
+	Client pbStats.StatsServiceClient
+}
+
+// ReportPeriod is the half open time range [From, To) a report covers
+type ReportPeriod struct {
+	From time.Time
+	To   time.Time
+}
+
+// ReportWorker periodically sends the daily and weekly reports of the enabled
+// report definitions. Only the latest complete period is sent; periods missed
+// while the worker was down are not backfilled.
+type ReportWorker struct {
+	reportRepo  repository.ReportRepository
+	sources     []StatsSource
+	emailClient pbNotifications.EmailServiceClient
+	loc         *time.Location
+	interval    time.Duration
+	now         func() time.Time
+}
+
+// NewReportWorker creates a worker checking for due reports every interval
+// (DefaultReportCheckInterval if zero). Periods start at midnight in loc.
+func NewReportWorker(
+	reportRepo repository.ReportRepository,
+	sources []StatsSource,
+	emailClient pbNotifications.EmailServiceClient,
+	loc *time.Location,
+	interval time.Duration,
+) *ReportWorker {
+	if interval <= 0 {
+		interval = DefaultReportCheckInterval
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return &ReportWorker{
+		reportRepo:  reportRepo,
+		sources:     sources,
+		emailClient: emailClient,
+		loc:         loc,
+		interval:    interval,
+		now:         time.Now,
+	}
+}
+
+// Register runs the worker once at start and then every interval under runner
+func (w *ReportWorker) Register(runner *jobs.Runner) {
+	runner.AddImmediate("scheduled-reports", w.interval, w.Run)
+}
+
+// Run sends every enabled report whose latest period has not been sent yet
+func (w *ReportWorker) Run(ctx context.Context) error {
+	reports, err := w.reportRepo.ListEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := w.now()
+	for _, report := range reports {
+		period, ok := PeriodFor(report.Frequency, now, w.loc)
+		if !ok {
+			log.Printf("Skipping report %d with unknown frequency %q", report.ID, report.Frequency)
+			continue
+		}
+		if !IsDue(report, period) {
+			continue
+		}
+		if err := w.send(ctx, report, period); err != nil {
+			log.Printf("Failed to send report %d: %v", report.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// PeriodFor returns the latest complete period of the frequency at now: the
+// previous day for daily reports and the previous Saturday to Friday week for
+// weekly reports
+func PeriodFor(frequency string, now time.Time, loc *time.Location) (ReportPeriod, bool) {
+	local := now.In(loc)
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+
+	switch frequency {
+	case models.FrequencyDaily:
+		return ReportPeriod{From: today.AddDate(0, 0, -1), To: today}, true
+	case models.FrequencyWeekly:
+		// The Iranian week starts on Saturday
+		daysSinceSaturday := (int(today.Weekday()) + 1) % 7
+		weekStart := today.AddDate(0, 0, -daysSinceSaturday)
+		return ReportPeriod{From: weekStart.AddDate(0, 0, -7), To: weekStart}, true
+	}
+
+	return ReportPeriod{}, false
+}
+
+// IsDue reports whether the report has not been sent for the period yet
+func IsDue(report *models.ReportDefinition, period ReportPeriod) bool {
+	return report.LastPeriodEnd == nil || report.LastPeriodEnd.Before(period.To)
+}
+
+func (w *ReportWorker) send(ctx context.Context, report *models.ReportDefinition, period ReportPeriod) error {
+	recipients := report.RecipientList()
+	if len(recipients) == 0 {
+		return fmt.Errorf("report has no recipients")
+	}
+	if w.emailClient == nil {
+		return fmt.Errorf("notifications service is unavailable")
+	}
+
+	metrics, unavailable := w.collect(ctx, period)
+	subject, body, htmlBody := buildReport(report, period, metrics, unavailable)
+
+	sent := 0
+	for _, recipient := range recipients {
+		_, err := w.emailClient.SendEmail(ctx, &pbNotifications.SendEmailRequest{
+			To:       recipient,
+			Subject:  subject,
+			Body:     body,
+			HtmlBody: htmlBody,
+		})
+		if err != nil {
+			log.Printf("Failed to email report %d to %s: %v", report.ID, recipient, err)
+			continue
+		}
+		sent++
+	}
+	if sent == 0 {
+		return fmt.Errorf("failed to email report to any recipient")
+	}
+
+	return w.reportRepo.MarkSent(ctx, report.ID, period.To)
+}
+
+// collect gathers the metrics of the period from all sources, keyed by metric.
+// Sources that fail are returned by name so the report can say so.
+func (w *ReportWorker) collect(ctx context.Context, period ReportPeriod) (map[string]float64, []string) {
+	metrics := make(map[string]float64)
+	var unavailable []string
+
+	for _, source := range w.sources {
+		if source.Client == nil {
+			unavailable = append(unavailable, source.Name)
+			continue
+		}
+		resp, err := source.Client.GetStats(ctx, &pbStats.GetStatsRequest{
+			From: period.From.Unix(),
+			To:   period.To.Unix(),
+		})
+		if err != nil {
+			log.Printf("Failed to get stats from %s: %v", source.Name, err)
+			unavailable = append(unavailable, source.Name)
+			continue
+		}
+		for _, metric := range resp.Metrics {
+			metrics[metric.Key] = metric.Value
+		}
+	}
+
+	return metrics, unavailable
+}
+
+type reportLine struct {
+	label string
+	value string
+}
+
+func buildReport(report *models.ReportDefinition, period ReportPeriod, metrics map[string]float64, unavailable []string) (string, string, string) {
+	from := helpers.FormatJalaliDate(period.From)
+	to := helpers.FormatJalaliDate(period.To.AddDate(0, 0, -1))
+	dateRange := from
+	if from != to {
+		dateRange = from + " تا " + to
+	}
+	subject := fmt.Sprintf("%s - %s", report.Name, dateRange)
+
+	keys := report.MetricKeys()
+	if len(keys) == 0 {
+		keys = []string{
+			"new_users", "trades", "trade_volume_psc", "trade_volume_irr",
+			"payments", "revenue_irr", "tickets", "sla_breaches",
+		}
+	}
+
+	var lines []reportLine
+	for _, key := range keys {
+		label := metricLabels[key]
+		if label == "" {
+			label = key
+		}
+		value := "نامشخص"
+		if v, ok := metrics[key]; ok {
+			value = formatMetric(v)
+		}
+		lines = append(lines, reportLine{label: label, value: value})
+	}
+
+	var body strings.Builder
+	body.WriteString(subject + "\n\n")
+	for _, line := range lines {
+		fmt.Fprintf(&body, "%s: %s\n", line.label, line.value)
+	}
+
+	var htmlBody strings.Builder
+	fmt.Fprintf(&htmlBody, `<div dir="rtl"><h3>%s</h3><table>`, html.EscapeString(subject))
+	for _, line := range lines {
+		fmt.Fprintf(&htmlBody, "<tr><td>%s</td><td>%s</td></tr>", html.EscapeString(line.label), html.EscapeString(line.value))
+	}
+	htmlBody.WriteString("</table>")
+
+	if len(unavailable) > 0 {
+		note := "اطلاعات این سرویس‌ها در دسترس نبود: " + strings.Join(unavailable, "، ")
+		body.WriteString("\n" + note + "\n")
+		fmt.Fprintf(&htmlBody, "<p>%s</p>", html.EscapeString(note))
+	}
+	htmlBody.WriteString("</div>")
+
+	return subject, body.String(), htmlBody.String()
+}
+
+func formatMetric(value float64) string {
+	if value == float64(int64(value)) {
+		return strconv.FormatInt(int64(value), 10)
+	}
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
@@ -3,18 +3,32 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
+	"metargb/shared/pkg/buildinfo"
+	shareddb "metargb/shared/pkg/db"
+	"metargb/shared/pkg/deadline"
+	"metargb/shared/pkg/debug"
+	"metargb/shared/pkg/devmode"
+	"metargb/shared/pkg/faultinject"
+	healthprobe "metargb/shared/pkg/health"
+	"metargb/shared/pkg/logger"
+	"metargb/shared/pkg/metrics"
+	"metargb/shared/pkg/msgsize"
 	"metargb/storage-service/internal/ftp"
 	"metargb/storage-service/internal/handler"
 	"metargb/storage-service/internal/repository"
@@ -22,21 +36,32 @@ import (
 )
 
 func main() {
-	if err := godotenv.Load(); err != nil {
-		log.Printf("Warning: .env file not found: %v", err)
+	// Load environment variables
+	envErr := godotenv.Load()
+
+	// Initialize logger once the environment is loaded so LOG_LEVEL applies
+	log := logger.NewLogger("storage-service")
+	log.RedirectStdLog()
+	if envErr != nil {
+		log.Warn(".env file not found", "error", envErr)
 	}
 
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
+	// SIGHUP toggles debug logging; STORAGE_LOG_ADMIN_ADDR serves the level over HTTP
+	logCtx, stopLogControl := context.WithCancel(context.Background())
+	defer stopLogControl()
+	log.EnableLevelControl(logCtx)
+
+	dsn := shareddb.ServiceDSN("storage-service", fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
 		getEnv("DB_USER", "root"),
 		getEnv("DB_PASSWORD", ""),
 		getEnv("DB_HOST", "localhost"),
 		getEnv("DB_PORT", "3306"),
 		getEnv("DB_DATABASE", "metargb_db"),
-	)
+	))
 
 	db, err := sql.Open("mysql", dsn)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		log.Fatal("Failed to connect to database", "error", err)
 	}
 	defer db.Close()
 
@@ -44,29 +69,40 @@ func main() {
 	db.SetMaxIdleConns(5)
 	db.SetConnMaxLifetime(5 * time.Minute)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	if err := db.PingContext(ctx); err != nil {
-		log.Fatalf("Failed to ping database: %v", err)
+	// Wait for the database instead of crash looping while it is unavailable
+	if err := shareddb.PingWithRetry(context.Background(), db, shareddb.RetryPolicyFromEnv()); err != nil {
+		log.Fatal("Failed to ping database", "error", err)
 	}
-	log.Println("Successfully connected to database")
+	log.Info("Successfully connected to database")
 
-	// Initialize FTP client
-	ftpClient := ftp.NewFTPClient(
+	// Initialize FTP client; operations share a bounded pool of connections
+	// so upload bursts wait for a free one instead of exhausting the server
+	ftpPool := ftp.PoolConfig{
+		Size:              getEnvAsInt("FTP_POOL_SIZE", ftp.DefaultPoolSize, log),
+		DialTimeout:       getEnvAsDuration("FTP_DIAL_TIMEOUT", ftp.DefaultDialTimeout, log),
+		OperationTimeout:  getEnvAsDuration("FTP_OPERATION_TIMEOUT", ftp.DefaultOperationTimeout, log),
+		AcquireTimeout:    getEnvAsDuration("FTP_ACQUIRE_TIMEOUT", ftp.DefaultAcquireTimeout, log),
+		IdleTimeout:       getEnvAsDuration("FTP_IDLE_TIMEOUT", ftp.DefaultIdleTimeout, log),
+		KeepaliveInterval: getEnvAsDuration("FTP_KEEPALIVE_INTERVAL", ftp.DefaultKeepaliveInterval, log),
+	}
+	ftpClient := ftp.NewPooledFTPClient(
 		getEnv("FTP_HOST", "localhost"),
 		getEnv("FTP_PORT", "21"),
 		getEnv("FTP_USER", ""),
 		getEnv("FTP_PASSWORD", ""),
 		getEnv("FTP_BASE_URL", ""),
+		ftpPool,
 	)
+	defer ftpClient.Close()
+	log.Info("FTP connection pool initialized", "size", ftpPool.Size)
 
 	// Initialize chunk manager
 	tempDir := getEnv("TEMP_DIR", "/tmp/storage-chunks")
 	chunkManager, err := service.NewChunkManager(tempDir)
 	if err != nil {
-		log.Fatalf("Failed to initialize chunk manager: %v", err)
+		log.Fatal("Failed to initialize chunk manager", "error", err)
 	}
-	log.Printf("Chunk manager initialized with temp directory: %s", tempDir)
+	log.Info("Chunk manager initialized", "temp_dir", tempDir)
 
 	// Initialize repositories
 	imageRepo := repository.NewImageRepository(db)
@@ -74,9 +110,9 @@ func main() {
 	// Ensure uploads directory exists
 	uploadsDir := "uploads"
 	if err := os.MkdirAll(uploadsDir, 0755); err != nil {
-		log.Fatalf("Failed to create uploads directory: %v", err)
+		log.Fatal("Failed to create uploads directory", "error", err)
 	}
-	log.Printf("✅ Uploads directory initialized: %s", uploadsDir)
+	log.Info("Uploads directory initialized", "dir", uploadsDir)
 
 	// Initialize services
 	// Storage base is no longer used - files are stored in uploads/ directory at service root
@@ -84,10 +120,59 @@ func main() {
 	imageService := service.NewImageService(imageRepo, ftpClient)
 
 	// Create gRPC server
-	grpcServer := grpc.NewServer(
-		grpc.MaxRecvMsgSize(100 * 1024 * 1024), // 100MB for file uploads
+	// Uploads default to a 100MB receive limit
+	limits := msgsize.FromEnv("storage-service", msgsize.Limits{
+		MaxRecv: 100 * 1024 * 1024,
+		MaxSend: msgsize.DefaultMaxMessageSize,
+	})
+	serverOpts := append(limits.ServerOptions(),
+		grpc.ChainUnaryInterceptor(logger.UnaryServerInterceptor(log)),
+		grpc.ChainStreamInterceptor(logger.StreamServerInterceptor(log)),
 	)
 
+	// Cap how long handlers run so slow queries are cancelled, see deadline.FromEnv
+	serverOpts = append(serverOpts, deadline.FromEnv("storage-service", deadline.Defaults()).ServerOptions()...)
+
+	// Staging builds inject latency and errors into chosen methods, see faultinject
+	faultCtx, stopFaults := context.WithCancel(context.Background())
+	defer stopFaults()
+	serverOpts = append(serverOpts, faultinject.FromEnv(faultCtx, "storage-service", log)...)
+
+	// Outside development internal errors reach callers only as a reference, see devmode
+	serverOpts = append(serverOpts, devmode.FromEnv("storage-service", log)...)
+
+	// Every response names the build that served it, see buildinfo
+	serverOpts = append(serverOpts, buildinfo.ServerOptions()...)
+	grpcServer := grpc.NewServer(serverOpts...)
+
+	// Report readiness over HTTP and through the standard gRPC health service:
+	// the database is reachable and the server has started
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	defer stopHealth()
+	dbMonitor := shareddb.NewHealthMonitor(db, nil)
+	dbMonitor.Start(healthCtx)
+	probe := healthprobe.NewProbe("storage-service").
+		AddCheck("database", dbMonitor.Check)
+	serving := probe.Starting("grpc")
+	probe.Watch(healthCtx, healthServer, healthprobe.DefaultInterval)
+	probeServer := healthprobe.NewServer(":"+getEnv("HEALTH_PORT", healthprobe.DefaultPort), probe)
+	go func() {
+		if err := probeServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Health probe server failed", "error", err)
+		}
+	}()
+
+	// Serve Prometheus metrics, including FTP pool saturation
+	metricsPort := getEnv("METRICS_PORT", "9090")
+	metricsServer := metrics.NewServer(":" + metricsPort)
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Metrics server failed", "error", err)
+		}
+	}()
+
 	// Register gRPC handlers
 	handler.RegisterStorageHandler(grpcServer, storageService)
 	handler.RegisterImageHandler(grpcServer, imageService)
@@ -95,29 +180,35 @@ func main() {
 	// Create HTTP handler for REST API
 	httpHandler := handler.NewHTTPHandler(storageService)
 
+	// Reflection is only registered in development, see devmode
+	devmode.Register(grpcServer, log)
+
+	// Profiling endpoints, off unless DEBUG_ADDR is set or in development, see debug
+	debug.Serve(context.Background(), "storage-service", log)
+
 	// Start gRPC server
 	grpcPort := getEnv("GRPC_PORT", "50059")
 	listener, err := net.Listen("tcp", ":"+grpcPort)
 	if err != nil {
-		log.Fatalf("Failed to listen on gRPC port %s: %v", grpcPort, err)
+		log.Fatal("Failed to listen", "error", err, "port", grpcPort)
 	}
 
-	log.Printf("✅ gRPC server listening on port %s", grpcPort)
+	log.Info("gRPC server listening", "port", grpcPort)
 
 	go func() {
 		if err := grpcServer.Serve(listener); err != nil {
-			log.Fatalf("Failed to serve gRPC: %v", err)
+			log.Fatal("Failed to serve gRPC", "error", err)
 		}
 	}()
+	serving()
 
 	// Start HTTP server for REST API
 	httpPort := getEnv("HTTP_PORT", "8059")
-	log.Printf("✅ HTTP server listening on port %s", httpPort)
-	log.Printf("📤 Chunk upload endpoint: http://localhost:%s/upload", httpPort)
+	log.Info("HTTP server listening", "port", httpPort, "upload_endpoint", "http://localhost:"+httpPort+"/upload")
 
 	go func() {
 		if err := handler.StartHTTPServer(httpHandler, httpPort); err != nil {
-			log.Fatalf("Failed to serve HTTP: %v", err)
+			log.Fatal("Failed to serve HTTP", "error", err)
 		}
 	}()
 
@@ -125,9 +216,13 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	log.Println("Shutting down server...")
+	log.Info("Shutting down server...")
+	probe.Drain()
+	healthServer.Shutdown()
 	grpcServer.GracefulStop()
-	log.Println("Server stopped")
+	probeServer.Close()
+	metricsServer.Close()
+	log.Info("Server stopped")
 }
 
 func getEnv(key, defaultValue string) string {
@@ -136,3 +231,31 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+func getEnvAsInt(key string, defaultValue int, log *logger.Logger) int {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.Atoi(valueStr)
+	if err != nil {
+		log.Warn("Invalid "+key+", using default", "value", valueStr, "default", defaultValue)
+		return defaultValue
+	}
+	return value
+}
+
+func getEnvAsDuration(key string, defaultValue time.Duration, log *logger.Logger) time.Duration {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := time.ParseDuration(valueStr)
+	if err != nil {
+		log.Warn("Invalid "+key+", using default", "value", valueStr, "default", defaultValue)
+		return defaultValue
+	}
+	return value
+}
@@ -1,92 +1,105 @@
 package ftp
 
 import (
+	"errors"
 	"fmt"
 	"io"
-	"time"
+	"net/textproto"
 
 	"github.com/jlaffaye/ftp"
 )
 
+// FTPClient runs each operation on a connection from a bounded pool, so
+// upload bursts queue for a connection instead of exceeding the server's
+// connection limit
 type FTPClient struct {
 	host     string
 	port     string
 	user     string
 	password string
 	baseURL  string
-	conn     *ftp.ServerConn
+	pool     *connPool
 }
 
+// NewFTPClient creates a client with DefaultPoolConfig
 func NewFTPClient(host, port, user, password, baseURL string) *FTPClient {
-	return &FTPClient{
+	return NewPooledFTPClient(host, port, user, password, baseURL, DefaultPoolConfig())
+}
+
+// NewPooledFTPClient creates a client holding up to config.Size connections.
+// Connections are opened on first use and reopened when they break.
+func NewPooledFTPClient(host, port, user, password, baseURL string, config PoolConfig) *FTPClient {
+	c := &FTPClient{
 		host:     host,
 		port:     port,
 		user:     user,
 		password: password,
 		baseURL:  baseURL,
 	}
+	config = config.withDefaults()
+	c.pool = newConnPool(config, func() (serverConn, error) {
+		return dialServer(c.host+":"+c.port, c.user, c.password, config)
+	})
+	return c
 }
 
-// Connect establishes connection to FTP server
+// Connect opens a pooled connection ahead of the first operation, failing
+// when the server cannot be reached or rejects the login
 func (c *FTPClient) Connect() error {
-	addr := c.host + ":" + c.port
-	conn, err := ftp.Dial(addr, ftp.DialWithTimeout(10*time.Second))
+	pc, err := c.pool.acquire()
 	if err != nil {
-		return fmt.Errorf("failed to connect to FTP: %w", err)
-	}
-
-	if err := conn.Login(c.user, c.password); err != nil {
-		conn.Quit()
-		return fmt.Errorf("failed to login to FTP: %w", err)
+		return err
 	}
-
-	c.conn = conn
+	c.pool.release(pc, false)
 	return nil
 }
 
 // UploadFile uploads a file to the FTP server
 func (c *FTPClient) UploadFile(remotePath string, data io.Reader) error {
-	if c.conn == nil {
-		if err := c.Connect(); err != nil {
-			return err
-		}
+	pc, err := c.pool.acquire()
+	if err != nil {
+		return err
 	}
 
-	if err := c.conn.Stor(remotePath, data); err != nil {
+	if err := pc.conn.Stor(remotePath, data); err != nil {
+		c.pool.release(pc, isConnError(err))
 		return fmt.Errorf("failed to upload file: %w", err)
 	}
 
+	c.pool.release(pc, false)
 	return nil
 }
 
-// DownloadFile downloads a file from the FTP server
+// DownloadFile downloads a file from the FTP server. The connection returns
+// to the pool when the reader is closed.
 func (c *FTPClient) DownloadFile(remotePath string) (io.ReadCloser, error) {
-	if c.conn == nil {
-		if err := c.Connect(); err != nil {
-			return nil, err
-		}
+	pc, err := c.pool.acquire()
+	if err != nil {
+		return nil, err
 	}
 
-	resp, err := c.conn.Retr(remotePath)
+	resp, err := pc.conn.Retr(remotePath)
 	if err != nil {
+		c.pool.release(pc, isConnError(err))
 		return nil, fmt.Errorf("failed to download file: %w", err)
 	}
 
-	return resp, nil
+	return &pooledResponse{Response: resp, pool: c.pool, conn: pc}, nil
 }
 
 // DeleteFile deletes a file from the FTP server
 func (c *FTPClient) DeleteFile(remotePath string) error {
-	if c.conn == nil {
-		if err := c.Connect(); err != nil {
-			return err
-		}
+	pc, err := c.pool.acquire()
+	if err != nil {
+		return err
 	}
 
-	if err := c.conn.Delete(remotePath); err != nil {
+	if err := pc.conn.Delete(remotePath); err != nil {
+		c.pool.release(pc, isConnError(err))
 		return fmt.Errorf("failed to delete file: %w", err)
 	}
 
+	c.pool.release(pc, false)
 	return nil
 }
 
@@ -95,10 +108,33 @@ func (c *FTPClient) GenerateURL(remotePath string) string {
 	return c.baseURL + "/" + remotePath
 }
 
-// Close closes the FTP connection
+// Close closes the pooled connections
 func (c *FTPClient) Close() error {
-	if c.conn != nil {
-		return c.conn.Quit()
+	return c.pool.close()
+}
+
+// pooledResponse releases the connection serving a download once it is closed
+type pooledResponse struct {
+	*ftp.Response
+	pool *connPool
+	conn *pooledConn
+	done bool
+}
+
+func (r *pooledResponse) Close() error {
+	if r.done {
+		return nil
 	}
-	return nil
+	r.done = true
+	err := r.Response.Close()
+	r.pool.release(r.conn, err != nil)
+	return err
+}
+
+// isConnError reports whether err left the connection unusable. Replies
+// from the server, such as a missing file, leave it ready for the next
+// command.
+func isConnError(err error) bool {
+	var protoErr *textproto.Error
+	return !errors.As(err, &protoErr)
 }
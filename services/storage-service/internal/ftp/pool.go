@@ -0,0 +1,468 @@
+package ftp
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/jlaffaye/ftp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	// DefaultPoolSize caps the connections one replica holds to the FTP server
+	DefaultPoolSize = 4
+	// DefaultDialTimeout bounds connecting and logging in to the FTP server
+	DefaultDialTimeout = 10 * time.Second
+	// DefaultOperationTimeout bounds each read or write on a control or data
+	// connection, so a stalled server fails the operation instead of hanging it
+	DefaultOperationTimeout = 60 * time.Second
+	// DefaultAcquireTimeout is how long an operation waits for a free connection
+	DefaultAcquireTimeout = 30 * time.Second
+	// DefaultIdleTimeout closes connections left unused this long
+	DefaultIdleTimeout = 5 * time.Minute
+	// DefaultKeepaliveInterval is how often idle connections are sent NOOP
+	DefaultKeepaliveInterval = 30 * time.Second
+)
+
+// ErrPoolExhausted is returned when no connection frees up within the acquire timeout
+var ErrPoolExhausted = errors.New("ftp connection pool exhausted")
+
+// errPoolClosed is returned by operations started after Close
+var errPoolClosed = errors.New("ftp connection pool closed")
+
+// Connections dropped from the pool, counted by poolDiscards
+const (
+	discardBroken    = "broken"
+	discardUnhealthy = "unhealthy"
+	discardIdle      = "idle"
+)
+
+var (
+	poolConnections = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "metargb",
+			Subsystem: "storage",
+			Name:      "ftp_pool_connections",
+			Help:      "Open FTP connections by state: in_use or idle",
+		},
+		[]string{"state"},
+	)
+	poolSize = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "metargb",
+			Subsystem: "storage",
+			Name:      "ftp_pool_size",
+			Help:      "Maximum FTP connections the pool opens",
+		},
+	)
+	poolWaits = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "metargb",
+			Subsystem: "storage",
+			Name:      "ftp_pool_waits_total",
+			Help:      "Operations that waited because every FTP connection was in use",
+		},
+	)
+	poolWaitDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "metargb",
+			Subsystem: "storage",
+			Name:      "ftp_pool_wait_seconds",
+			Help:      "Time operations waited for a free FTP connection",
+			Buckets:   prometheus.DefBuckets,
+		},
+	)
+	poolAcquireTimeouts = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "metargb",
+			Subsystem: "storage",
+			Name:      "ftp_pool_acquire_timeouts_total",
+			Help:      "Operations that failed because no FTP connection freed up in time",
+		},
+	)
+	poolDials = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "metargb",
+			Subsystem: "storage",
+			Name:      "ftp_pool_dials_total",
+			Help:      "FTP connections opened by result: success or error",
+		},
+		[]string{"result"},
+	)
+	poolDiscards = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "metargb",
+			Subsystem: "storage",
+			Name:      "ftp_pool_discards_total",
+			Help:      "FTP connections closed by reason: broken by a failed operation, unhealthy on NOOP or idle too long",
+		},
+		[]string{"reason"},
+	)
+)
+
+// PoolConfig controls how many FTP connections are held and for how long
+type PoolConfig struct {
+	// Size caps open connections; operations beyond it wait for a free one
+	Size int
+	// DialTimeout bounds connecting and logging in
+	DialTimeout time.Duration
+	// OperationTimeout bounds each read or write on a connection
+	OperationTimeout time.Duration
+	// AcquireTimeout is how long an operation waits for a free connection
+	AcquireTimeout time.Duration
+	// IdleTimeout closes connections unused this long; zero keeps them open
+	IdleTimeout time.Duration
+	// KeepaliveInterval is how often idle connections are sent NOOP; zero disables keepalives
+	KeepaliveInterval time.Duration
+}
+
+// DefaultPoolConfig returns the defaults used when the environment sets nothing
+func DefaultPoolConfig() PoolConfig {
+	return PoolConfig{
+		Size:              DefaultPoolSize,
+		DialTimeout:       DefaultDialTimeout,
+		OperationTimeout:  DefaultOperationTimeout,
+		AcquireTimeout:    DefaultAcquireTimeout,
+		IdleTimeout:       DefaultIdleTimeout,
+		KeepaliveInterval: DefaultKeepaliveInterval,
+	}
+}
+
+func (c PoolConfig) withDefaults() PoolConfig {
+	defaults := DefaultPoolConfig()
+	if c.Size <= 0 {
+		c.Size = defaults.Size
+	}
+	if c.DialTimeout <= 0 {
+		c.DialTimeout = defaults.DialTimeout
+	}
+	if c.OperationTimeout <= 0 {
+		c.OperationTimeout = defaults.OperationTimeout
+	}
+	if c.AcquireTimeout <= 0 {
+		c.AcquireTimeout = defaults.AcquireTimeout
+	}
+	return c
+}
+
+// serverConn is the part of *ftp.ServerConn the pool and client use
+type serverConn interface {
+	Stor(path string, r io.Reader) error
+	Retr(path string) (*ftp.Response, error)
+	Delete(path string) error
+	NoOp() error
+	Quit() error
+}
+
+// pooledConn is a logged in connection and when it was last returned
+type pooledConn struct {
+	conn     serverConn
+	lastUsed time.Time
+}
+
+// connPool holds at most Size connections. Idle connections wait in idle,
+// and each permit allows opening one more, so idle connections plus permits
+// plus connections in use always add up to Size.
+type connPool struct {
+	config PoolConfig
+	dial   func() (serverConn, error)
+	now    func() time.Time
+
+	idleConns chan *pooledConn
+	permits   chan struct{}
+
+	mu     sync.Mutex
+	inUse  int
+	idle   int
+	closed bool
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func newConnPool(config PoolConfig, dial func() (serverConn, error)) *connPool {
+	config = config.withDefaults()
+	p := &connPool{
+		config: config,
+		dial:   dial,
+		now:    time.Now,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+
+		idleConns: make(chan *pooledConn, config.Size),
+		permits:   make(chan struct{}, config.Size),
+	}
+	for i := 0; i < config.Size; i++ {
+		p.permits <- struct{}{}
+	}
+	poolSize.Set(float64(config.Size))
+
+	if config.KeepaliveInterval > 0 {
+		go p.keepalive()
+	} else {
+		close(p.done)
+	}
+	return p
+}
+
+// acquire returns a healthy connection, reconnecting when the idle one went
+// stale. Callers must pass it back to release.
+func (p *connPool) acquire() (*pooledConn, error) {
+	// Reuse an idle connection before opening another
+	var slot *pooledConn
+	select {
+	case slot = <-p.idleConns:
+	default:
+		select {
+		case slot = <-p.idleConns:
+		case <-p.permits:
+		default:
+			poolWaits.Inc()
+			started := p.now()
+			timer := time.NewTimer(p.config.AcquireTimeout)
+			defer timer.Stop()
+			select {
+			case slot = <-p.idleConns:
+			case <-p.permits:
+			case <-timer.C:
+				poolAcquireTimeouts.Inc()
+				return nil, ErrPoolExhausted
+			}
+			poolWaitDuration.Observe(p.now().Sub(started).Seconds())
+		}
+	}
+
+	p.mu.Lock()
+	if slot != nil {
+		p.idle--
+	}
+	if p.closed {
+		p.updateGauges()
+		p.mu.Unlock()
+		if slot != nil {
+			slot.conn.Quit()
+		}
+		p.permits <- struct{}{}
+		return nil, errPoolClosed
+	}
+	p.inUse++
+	p.updateGauges()
+	p.mu.Unlock()
+
+	if slot != nil {
+		if p.expired(slot) {
+			p.discard(slot, discardIdle)
+			slot = nil
+		} else if p.needsCheck(slot) {
+			// The server may have dropped a connection that outlived its idle timeout
+			if err := slot.conn.NoOp(); err != nil {
+				p.discard(slot, discardUnhealthy)
+				slot = nil
+			}
+		}
+	}
+	if slot != nil {
+		return slot, nil
+	}
+
+	conn, err := p.dial()
+	if err != nil {
+		poolDials.WithLabelValues("error").Inc()
+		p.putBack(nil)
+		return nil, err
+	}
+	poolDials.WithLabelValues("success").Inc()
+	return &pooledConn{conn: conn, lastUsed: p.now()}, nil
+}
+
+// release returns the connection to the pool, or closes it when the
+// operation left it in an unknown state
+func (p *connPool) release(pc *pooledConn, broken bool) {
+	if broken {
+		p.discard(pc, discardBroken)
+		p.putBack(nil)
+		return
+	}
+	pc.lastUsed = p.now()
+	p.putBack(pc)
+}
+
+func (p *connPool) putBack(pc *pooledConn) {
+	p.mu.Lock()
+	p.inUse--
+	if p.closed && pc != nil {
+		p.updateGauges()
+		p.mu.Unlock()
+		pc.conn.Quit()
+		p.permits <- struct{}{}
+		return
+	}
+	if pc != nil {
+		p.idle++
+	}
+	p.updateGauges()
+	p.mu.Unlock()
+	if pc == nil {
+		p.permits <- struct{}{}
+		return
+	}
+	p.idleConns <- pc
+}
+
+func (p *connPool) discard(pc *pooledConn, reason string) {
+	poolDiscards.WithLabelValues(reason).Inc()
+	pc.conn.Quit()
+}
+
+func (p *connPool) expired(pc *pooledConn) bool {
+	return p.config.IdleTimeout > 0 && p.now().Sub(pc.lastUsed) >= p.config.IdleTimeout
+}
+
+func (p *connPool) needsCheck(pc *pooledConn) bool {
+	interval := p.config.KeepaliveInterval
+	if interval <= 0 {
+		interval = p.config.IdleTimeout
+	}
+	return interval > 0 && p.now().Sub(pc.lastUsed) >= interval
+}
+
+// keepalive sends NOOP on idle connections so the server does not drop them,
+// and closes connections left unused past the idle timeout
+func (p *connPool) keepalive() {
+	defer close(p.done)
+	ticker := time.NewTicker(p.config.KeepaliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.checkIdle()
+		}
+	}
+}
+
+// checkIdle visits the connections idle when it starts, at most once each
+func (p *connPool) checkIdle() {
+	for i := len(p.idleConns); i > 0; i-- {
+		var slot *pooledConn
+		select {
+		case slot = <-p.idleConns:
+		default:
+			return
+		}
+		if !p.needsCheck(slot) {
+			p.idleConns <- slot
+			continue
+		}
+
+		p.mu.Lock()
+		p.idle--
+		p.inUse++
+		p.updateGauges()
+		p.mu.Unlock()
+
+		if p.expired(slot) {
+			p.discard(slot, discardIdle)
+			slot = nil
+		} else if err := slot.conn.NoOp(); err != nil {
+			log.Printf("FTP keepalive failed, closing connection: %v", err)
+			p.discard(slot, discardUnhealthy)
+			slot = nil
+		} else {
+			slot.lastUsed = p.now()
+		}
+		p.putBack(slot)
+	}
+}
+
+func (p *connPool) updateGauges() {
+	poolConnections.WithLabelValues("in_use").Set(float64(p.inUse))
+	poolConnections.WithLabelValues("idle").Set(float64(p.idle))
+}
+
+// close stops keepalives and quits idle connections. Connections in use are
+// quit when they are released.
+func (p *connPool) close() error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil
+	}
+	p.closed = true
+	p.mu.Unlock()
+
+	close(p.stop)
+	<-p.done
+
+	var errs []error
+	for {
+		var slot *pooledConn
+		select {
+		case slot = <-p.idleConns:
+		default:
+			return errors.Join(errs...)
+		}
+		p.mu.Lock()
+		p.idle--
+		p.updateGauges()
+		p.mu.Unlock()
+		if err := slot.conn.Quit(); err != nil {
+			errs = append(errs, err)
+		}
+		p.permits <- struct{}{}
+	}
+}
+
+// deadlineConn refreshes the connection deadline before every read and
+// write, so each operation step gets the full timeout however long the
+// connection sat idle between steps
+type deadlineConn struct {
+	net.Conn
+	timeout time.Duration
+}
+
+func (c *deadlineConn) Read(b []byte) (int, error) {
+	if err := c.Conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		return 0, err
+	}
+	return c.Conn.Read(b)
+}
+
+func (c *deadlineConn) Write(b []byte) (int, error) {
+	if err := c.Conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		return 0, err
+	}
+	return c.Conn.Write(b)
+}
+
+// dialServer connects and logs in, applying the pool timeouts to the control
+// connection and to the data connections the library opens through dialFunc
+func dialServer(addr, user, password string, config PoolConfig) (serverConn, error) {
+	dialer := net.Dialer{Timeout: config.DialTimeout}
+	dialFunc := func(network, address string) (net.Conn, error) {
+		conn, err := dialer.Dial(network, address)
+		if err != nil {
+			return nil, err
+		}
+		return &deadlineConn{Conn: conn, timeout: config.OperationTimeout}, nil
+	}
+
+	conn, err := ftp.Dial(addr, ftp.DialWithDialFunc(dialFunc))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to FTP: %w", err)
+	}
+
+	if err := conn.Login(user, password); err != nil {
+		conn.Quit()
+		return nil, fmt.Errorf("failed to login to FTP: %w", err)
+	}
+
+	return conn, nil
+}
@@ -47,16 +47,8 @@ type ChunkUploadHTTPResponse struct {
 // HandleChunkUpload handles the chunk upload HTTP endpoint
 // POST /upload
 func (h *HTTPHandler) HandleChunkUpload(w http.ResponseWriter, r *http.Request) {
-	// Set CORS headers
-	w.Header().Set("Access-Control-Allow-Origin", "*")
-	w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
-	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
-
-	// Handle preflight
-	if r.Method == http.MethodOptions {
-		w.WriteHeader(http.StatusOK)
-		return
-	}
+	// CORS, including preflight requests, is handled by the gateway in front
+	// of this endpoint
 
 	// Only accept POST
 	if r.Method != http.MethodPost {
@@ -1,8 +1,8 @@
 package handler
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 
@@ -12,6 +12,7 @@ import (
 
 	commonpb "metargb/shared/pb/common"
 	storagepb "metargb/shared/pb/storage"
+	"metargb/storage-service/internal/ftp"
 	"metargb/storage-service/internal/service"
 )
 
@@ -25,44 +26,65 @@ func RegisterStorageHandler(grpcServer *grpc.Server, svc *service.StorageService
 	storagepb.RegisterFileStorageServiceServer(grpcServer, handler)
 }
 
-// UploadFile handles streaming file uploads
+// UploadFile handles streaming file uploads. The first message carries the
+// metadata, and chunks are written to FTP as they arrive.
 func (h *StorageHandler) UploadFile(stream storagepb.FileStorageService_UploadFileServer) error {
-	var metadata *storagepb.FileMetadata
-	var fileData bytes.Buffer
-
-	// Receive file chunks
-	for {
-		req, err := stream.Recv()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return status.Errorf(codes.Internal, "failed to receive chunk: %v", err)
-		}
-
-		switch data := req.Data.(type) {
-		case *storagepb.UploadFileRequest_Metadata:
-			metadata = data.Metadata
-
-		case *storagepb.UploadFileRequest_ChunkData:
-			if _, err := fileData.Write(data.ChunkData); err != nil {
-				return status.Errorf(codes.Internal, "failed to write chunk: %v", err)
-			}
-		}
+	req, err := stream.Recv()
+	if err == io.EOF {
+		return status.Errorf(codes.InvalidArgument, "no metadata provided")
 	}
-
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to receive chunk: %v", err)
+	}
+	metadata := req.GetMetadata()
 	if metadata == nil {
 		return status.Errorf(codes.InvalidArgument, "no metadata provided")
 	}
+	if metadata.Filename == "" {
+		return status.Errorf(codes.InvalidArgument, "filename is required")
+	}
+
+	// Chunks are piped to the FTP upload; a failed receive aborts it
+	reader, writer := io.Pipe()
+	received := make(chan int64, 1)
+	go func() {
+		var size int64
+		defer func() { received <- size }()
+		for {
+			req, err := stream.Recv()
+			if err == io.EOF {
+				writer.Close()
+				return
+			}
+			if err != nil {
+				writer.CloseWithError(fmt.Errorf("failed to receive chunk: %w", err))
+				return
+			}
+			chunk := req.GetChunkData()
+			if len(chunk) == 0 {
+				continue
+			}
+			if _, err := writer.Write(chunk); err != nil {
+				return
+			}
+			size += int64(len(chunk))
+		}
+	}()
 
 	// Upload file to FTP
-	url, err := h.service.UploadFile(
+	url, err := h.service.UploadStream(
 		metadata.Filename,
 		metadata.ContentType,
-		fileData.Bytes(),
+		reader,
 		metadata.UploadPath,
 	)
+	// Unblock the receiver when the upload stopped reading early
+	reader.CloseWithError(io.ErrClosedPipe)
+	size := <-received
 	if err != nil {
+		if errors.Is(err, ftp.ErrPoolExhausted) {
+			return status.Errorf(codes.Unavailable, "storage is busy, try again later")
+		}
 		return status.Errorf(codes.Internal, "failed to upload file: %v", err)
 	}
 
@@ -70,7 +92,7 @@ func (h *StorageHandler) UploadFile(stream storagepb.FileStorageService_UploadFi
 	response := &storagepb.UploadFileResponse{
 		FileUrl:  url,
 		Filename: metadata.Filename,
-		FileSize: int64(fileData.Len()),
+		FileSize: size,
 		Success:  true,
 		Message:  "File uploaded successfully",
 	}
@@ -30,8 +30,16 @@ func NewStorageService(ftpClient ftp.FTPClientInterface, chunkManager *ChunkMana
 
 // UploadFile uploads a file to FTP server
 func (s *StorageService) UploadFile(filename, contentType string, data []byte, uploadPath string) (string, error) {
+	return s.UploadStream(filename, contentType, bytes.NewReader(data), uploadPath)
+}
+
+// UploadStream uploads a file to FTP server as it is read from data, so
+// large files are never held in memory. A partially written file is
+// deleted when data fails.
+func (s *StorageService) UploadStream(filename, contentType string, data io.Reader, uploadPath string) (string, error) {
 	// Generate unique filename
 	timestamp := time.Now().Unix()
+	filename = filepath.Base(filename)
 	ext := filepath.Ext(filename)
 	uniqueFilename := fmt.Sprintf("%d_%s%s", timestamp, filename[:len(filename)-len(ext)], ext)
 
@@ -39,8 +47,8 @@ func (s *StorageService) UploadFile(filename, contentType string, data []byte, u
 	remotePath := filepath.Join(uploadPath, uniqueFilename)
 
 	// Upload to FTP
-	reader := bytes.NewReader(data)
-	if err := s.ftpClient.UploadFile(remotePath, reader); err != nil {
+	if err := s.ftpClient.UploadFile(remotePath, data); err != nil {
+		s.ftpClient.DeleteFile(remotePath)
 		return "", fmt.Errorf("failed to upload file: %w", err)
 	}
 
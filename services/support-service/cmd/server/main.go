@@ -3,39 +3,73 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 
+	pbFeatures "metargb/shared/pb/features"
+	pbNotification "metargb/shared/pb/notifications"
+	pbTraining "metargb/shared/pb/training"
+	"metargb/shared/pkg/auth"
+	"metargb/shared/pkg/buildinfo"
+	shareddb "metargb/shared/pkg/db"
+	"metargb/shared/pkg/dbsplit"
+	"metargb/shared/pkg/deadline"
+	"metargb/shared/pkg/debug"
+	"metargb/shared/pkg/devmode"
+	"metargb/shared/pkg/faultinject"
+	healthprobe "metargb/shared/pkg/health"
+	"metargb/shared/pkg/jobs"
+	"metargb/shared/pkg/logger"
+	"metargb/shared/pkg/msgsize"
 	"metargb/support-service/internal/handler"
+	"metargb/support-service/internal/pubsub"
 	"metargb/support-service/internal/repository"
 	"metargb/support-service/internal/service"
 )
 
 func main() {
-	if err := godotenv.Load(); err != nil {
-		log.Printf("Warning: .env file not found: %v", err)
+	// Load environment variables
+	envErr := godotenv.Load()
+
+	// Initialize logger once the environment is loaded so LOG_LEVEL applies
+	log := logger.NewLogger("support-service")
+	log.RedirectStdLog()
+	if envErr != nil {
+		log.Warn(".env file not found", "error", envErr)
 	}
 
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
+	// SIGHUP toggles debug logging; SUPPORT_LOG_ADMIN_ADDR serves the level over HTTP
+	logCtx, stopLogControl := context.WithCancel(context.Background())
+	defer stopLogControl()
+	log.EnableLevelControl(logCtx)
+
+	dsn := shareddb.ServiceDSN("support-service", fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
 		getEnv("DB_USER", "root"),
 		getEnv("DB_PASSWORD", ""),
 		getEnv("DB_HOST", "localhost"),
 		getEnv("DB_PORT", "3306"),
 		getEnv("DB_DATABASE", "metargb_db"),
-	)
+	))
 
 	db, err := sql.Open("mysql", dsn)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		log.Fatal("Failed to connect to database", "error", err)
 	}
 	defer db.Close()
 
@@ -43,17 +77,20 @@ func main() {
 	db.SetMaxIdleConns(5)
 	db.SetConnMaxLifetime(5 * time.Minute)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	if err := db.PingContext(ctx); err != nil {
-		log.Fatalf("Failed to ping database: %v", err)
+	// Wait for the database instead of crash looping while it is unavailable
+	if err := shareddb.PingWithRetry(context.Background(), db, shareddb.RetryPolicyFromEnv()); err != nil {
+		log.Fatal("Failed to ping database", "error", err)
 	}
-	log.Println("Successfully connected to database")
+	log.Info("Successfully connected to database")
 
 	ticketRepo := repository.NewTicketRepository(db)
 	reportRepo := repository.NewReportRepository(db)
 	userEventRepo := repository.NewUserEventRepository(db)
 	noteRepo := repository.NewNoteRepository(db)
+	disputeRepo := repository.NewDisputeRepository(db)
+	ticketEmailRepo := repository.NewTicketEmailRepository(db)
+	chatRepo := repository.NewChatRepository(db)
+	faqRepo := repository.NewFaqRepository(db)
 
 	notificationServiceAddr := getEnv("NOTIFICATION_SERVICE_ADDR", "notifications-service:50058")
 
@@ -62,34 +99,266 @@ func main() {
 	userEventService := service.NewUserEventService(userEventRepo)
 	noteService := service.NewNoteService(noteRepo)
 
-	grpcServer := grpc.NewServer()
+	// Disputed trades are looked up, frozen and settled through features-service
+	var tradeClient pbFeatures.TradeServiceClient
+	featuresServiceAddr := getEnv("FEATURES_SERVICE_ADDR", "features-service:50053")
+	featuresConn, err := grpc.Dial(featuresServiceAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(forwardAuthorization),
+	)
+	if err != nil {
+		log.Warn("Failed to connect to features service - disputes disabled", "error", err)
+	} else {
+		defer featuresConn.Close()
+		tradeClient = pbFeatures.NewTradeServiceClient(featuresConn)
+	}
+
+	disputeWindow := service.DefaultDisputeWindow
+	if v := getEnv("DISPUTE_WINDOW_DAYS", ""); v != "" {
+		if days, err := strconv.Atoi(v); err == nil && days > 0 {
+			disputeWindow = time.Duration(days) * 24 * time.Hour
+		} else {
+			log.Warn("Invalid DISPUTE_WINDOW_DAYS, using default", "value", v)
+		}
+	}
+	disputeService := service.NewDisputeService(
+		disputeRepo,
+		ticketService,
+		ticketRepo,
+		tradeClient,
+		disputeWindow,
+		parseUserIDs(getEnv("SUPPORT_AGENT_IDS", ""), log),
+	)
+
+	// Live chat messages are delivered to the chat's room through the
+	// websocket gateway; without Redis clients only see them on reload
+	var chatPublisher service.ChatEventPublisher
+	redisPublisher, err := pubsub.NewRedisPublisher(getEnv("REDIS_URL", "redis://localhost:6379"))
+	if err != nil {
+		log.Warn("Failed to connect to Redis - live chat messages will not be pushed", "error", err)
+	} else {
+		defer redisPublisher.Close()
+		chatPublisher = redisPublisher
+	}
+	chatService := service.NewChatService(
+		chatRepo,
+		ticketRepo,
+		chatPublisher,
+		parseUserIDs(getEnv("SUPPORT_AGENT_IDS", ""), log),
+	)
+
+	// New tickets are matched against the FAQs and training-service videos,
+	// reloaded every SUPPORT_SUGGESTION_REFRESH
+	var videoClient pbTraining.VideoServiceClient
+	trainingConn, err := grpc.Dial(getEnv("TRAINING_SERVICE_ADDR", "training-service:50057"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Warn("Failed to connect to training service - only FAQs will be suggested", "error", err)
+	} else {
+		defer trainingConn.Close()
+		videoClient = pbTraining.NewVideoServiceClient(trainingConn)
+	}
+	faqService := service.NewFaqService(
+		faqRepo,
+		ticketRepo,
+		videoClient,
+		parseUserIDs(getEnv("SUPPORT_AGENT_IDS", ""), log),
+	)
+	suggestionInterval := service.DefaultSuggestionRefreshInterval
+	if v := getEnv("SUPPORT_SUGGESTION_REFRESH", ""); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			suggestionInterval = d
+		} else {
+			log.Warn("Invalid SUPPORT_SUGGESTION_REFRESH, using default", "value", v, "default", suggestionInterval)
+		}
+	}
+	// Background jobs are timed, counted and listed on jobs.Path
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	backgroundJobs := jobs.NewRunner("support-service", log)
+	faqService.Register(backgroundJobs, suggestionInterval)
+
+	// Emails to the support mailbox are delivered by the mail provider's inbound
+	// webhook through the gateway; agent responses are emailed back by the worker
+	supportEmailAddress := getEnv("SUPPORT_EMAIL_ADDRESS", "")
+	ticketEmailService := service.NewTicketEmailService(
+		ticketEmailRepo,
+		ticketService,
+		ticketRepo,
+		getEnv("SUPPORT_EMAIL_WEBHOOK_SECRET", ""),
+		supportEmailAddress,
+	)
+
+	notificationConn, err := grpc.Dial(notificationServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Warn("Failed to connect to notifications service - ticket responses will not be emailed", "error", err)
+	} else {
+		defer notificationConn.Close()
+		emailInterval := service.DefaultTicketEmailInterval
+		if v := getEnv("SUPPORT_EMAIL_INTERVAL", ""); v != "" {
+			if d, err := time.ParseDuration(v); err == nil {
+				emailInterval = d
+			} else {
+				log.Warn("Invalid SUPPORT_EMAIL_INTERVAL, using default", "value", v, "default", emailInterval)
+			}
+		}
+		service.NewTicketEmailWorker(
+			ticketEmailRepo,
+			pbNotification.NewEmailServiceClient(notificationConn),
+			supportEmailAddress,
+			emailInterval,
+		).Register(backgroundJobs)
+	}
+	backgroundJobs.Start(jobsCtx)
+
+	// StatsService.GetStats needs a service API key, validated by auth-service
+	authConn, err := grpc.Dial(getEnv("AUTH_SERVICE_ADDR", "auth-service:50051"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal("Failed to connect to auth service", "error", err)
+	}
+	defer authConn.Close()
+
+	limits := msgsize.FromEnv("support-service", msgsize.Defaults())
+	serverOpts := append(limits.ServerOptions(),
+		grpc.ChainUnaryInterceptor(
+			logger.UnaryServerInterceptor(log),
+			auth.ServiceUnaryServerInterceptor(auth.NewAuthServiceTokenValidator(authConn)),
+		),
+		grpc.ChainStreamInterceptor(logger.StreamServerInterceptor(log)),
+	)
+
+	// Cap how long handlers run so slow queries are cancelled, see deadline.FromEnv
+	serverOpts = append(serverOpts, deadline.FromEnv("support-service", deadline.Defaults()).ServerOptions()...)
+
+	// Staging builds inject latency and errors into chosen methods, see faultinject
+	faultCtx, stopFaults := context.WithCancel(context.Background())
+	defer stopFaults()
+	serverOpts = append(serverOpts, faultinject.FromEnv(faultCtx, "support-service", log)...)
+
+	// Outside development internal errors reach callers only as a reference, see devmode
+	serverOpts = append(serverOpts, devmode.FromEnv("support-service", log)...)
+
+	// Every response names the build that served it, see buildinfo
+	serverOpts = append(serverOpts, buildinfo.ServerOptions()...)
+	grpcServer := grpc.NewServer(serverOpts...)
 
-	handler.RegisterTicketHandler(grpcServer, ticketService)
+	// Report readiness over HTTP and through the standard gRPC health service:
+	// the database is reachable, its tables exist and the server has started
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	defer stopHealth()
+	dbMonitor := shareddb.NewHealthMonitor(db, nil)
+	dbMonitor.Start(healthCtx)
+	probe := healthprobe.NewProbe("support-service").
+		AddCheck("database", dbMonitor.Check).
+		AddCheck("migrations", shareddb.NewSchemaGuard(db).RequireTables(dbsplit.Ownership["support-service"]...))
+	serving := probe.Starting("grpc")
+	probe.Watch(healthCtx, healthServer, healthprobe.DefaultInterval)
+	probeServer := healthprobe.NewServer(":"+getEnv("HEALTH_PORT", healthprobe.DefaultPort), probe)
+	go func() {
+		if err := probeServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Health probe server failed", "error", err)
+		}
+	}()
+
+	handler.RegisterTicketHandler(grpcServer, ticketService, faqService)
 	handler.RegisterReportHandler(grpcServer, reportService)
 	handler.RegisterUserEventHandler(grpcServer, userEventService)
 	handler.RegisterNoteHandler(grpcServer, noteService)
+	handler.RegisterDisputeHandler(grpcServer, disputeService)
+	handler.RegisterTicketEmailHandler(grpcServer, ticketEmailService)
+	handler.RegisterChatHandler(grpcServer, chatService)
+	handler.RegisterFaqHandler(grpcServer, faqService)
+
+	// Tickets without a response from anyone but their sender within the SLA
+	// are reported as breaches in the admin reports
+	ticketSLA := 24 * time.Hour
+	if v := getEnv("TICKET_SLA_HOURS", ""); v != "" {
+		if hours, err := strconv.Atoi(v); err == nil && hours > 0 {
+			ticketSLA = time.Duration(hours) * time.Hour
+		} else {
+			log.Warn("Invalid TICKET_SLA_HOURS, using default", "value", v)
+		}
+	}
+	statsRepo := repository.NewStatsRepository(db)
+	handler.RegisterStatsHandler(grpcServer, statsRepo, ticketSLA)
+
+	// Support agents can view ticket volumes and response times, recomputed
+	// at most once per SUPPORT_STATS_CACHE_TTL for the same period
+	supportStatsTTL := service.DefaultSupportStatsCacheTTL
+	if v := getEnv("SUPPORT_STATS_CACHE_TTL", ""); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			supportStatsTTL = d
+		} else {
+			log.Warn("Invalid SUPPORT_STATS_CACHE_TTL, using default", "value", v, "default", supportStatsTTL)
+		}
+	}
+	handler.RegisterSupportStatsHandler(grpcServer, service.NewSupportStatsService(
+		statsRepo,
+		parseUserIDs(getEnv("SUPPORT_AGENT_IDS", ""), log),
+		supportStatsTTL,
+	))
+
+	// Reflection is only registered in development, see devmode
+	devmode.Register(grpcServer, log)
+
+	// Profiling endpoints, off unless DEBUG_ADDR is set or in development, see debug
+	debug.Serve(context.Background(), "support-service", log)
 
 	port := getEnv("GRPC_PORT", "50056")
 	listener, err := net.Listen("tcp", ":"+port)
 	if err != nil {
-		log.Fatalf("Failed to listen on port %s: %v", port, err)
+		log.Fatal("Failed to listen", "error", err, "port", port)
 	}
 
-	log.Printf("Support service listening on port %s", port)
+	log.Info("Support service listening", "port", port)
 
 	go func() {
 		if err := grpcServer.Serve(listener); err != nil {
-			log.Fatalf("Failed to serve: %v", err)
+			log.Fatal("Failed to serve", "error", err)
 		}
 	}()
+	serving()
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	log.Println("Shutting down server...")
+	log.Info("Shutting down server...")
+	stopJobs()
+	probe.Drain()
+	healthServer.Shutdown()
 	grpcServer.GracefulStop()
-	log.Println("Server stopped")
+	probeServer.Close()
+	log.Info("Server stopped")
+}
+
+// forwardAuthorization passes the caller's authorization header on to features-service
+func forwardAuthorization(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if inMd, ok := metadata.FromIncomingContext(ctx); ok {
+		if authHeaders := inMd.Get("authorization"); len(authHeaders) > 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authHeaders[0])
+		}
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// parseUserIDs parses a comma separated list of user IDs, skipping invalid entries
+func parseUserIDs(value string, log *logger.Logger) []uint64 {
+	var ids []uint64
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			log.Warn("Ignoring invalid user id", "value", part)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
 }
 
 func getEnv(key, defaultValue string) string {
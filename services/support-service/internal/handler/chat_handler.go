@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"metargb/support-service/internal/models"
+	"metargb/support-service/internal/service"
+	"metargb/support-service/internal/utils"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pb "metargb/shared/pb/support"
+)
+
+type ChatHandler struct {
+	pb.UnimplementedSupportChatServiceServer
+	chatService service.ChatService
+}
+
+func NewChatHandler(chatService service.ChatService) *ChatHandler {
+	return &ChatHandler{
+		chatService: chatService,
+	}
+}
+
+func RegisterChatHandler(grpcServer *grpc.Server, chatService service.ChatService) {
+	handler := NewChatHandler(chatService)
+	pb.RegisterSupportChatServiceServer(grpcServer, handler)
+}
+
+func (h *ChatHandler) StartChat(ctx context.Context, req *pb.StartChatRequest) (*pb.ChatSessionResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("ticket_id", req.TicketId, locale),
+		validateRequired("user_id", req.UserId, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	session, err := h.chatService.StartChat(ctx, req.TicketId, req.UserId)
+	if err != nil {
+		return nil, mapChatError(err)
+	}
+
+	return convertChatToProto(session, nil), nil
+}
+
+func (h *ChatHandler) GetChat(ctx context.Context, req *pb.GetChatRequest) (*pb.ChatSessionResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("chat_id", req.ChatId, locale),
+		validateRequired("user_id", req.UserId, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	session, messages, err := h.chatService.GetChat(ctx, req.ChatId, req.UserId, req.IncludeMessages)
+	if err != nil {
+		return nil, mapChatError(err)
+	}
+
+	return convertChatToProto(session, messages), nil
+}
+
+func (h *ChatHandler) ListChats(ctx context.Context, req *pb.ListChatsRequest) (*pb.ChatSessionsResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := validateRequired("user_id", req.UserId, locale)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	sessions, err := h.chatService.ListChats(ctx, req.UserId)
+	if err != nil {
+		return nil, mapChatError(err)
+	}
+
+	response := &pb.ChatSessionsResponse{
+		Chats: make([]*pb.ChatSessionResponse, len(sessions)),
+	}
+	for i, session := range sessions {
+		response.Chats[i] = convertChatToProto(session, nil)
+	}
+
+	return response, nil
+}
+
+func (h *ChatHandler) SendChatMessage(ctx context.Context, req *pb.SendChatMessageRequest) (*pb.ChatMessageResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("chat_id", req.ChatId, locale),
+		validateRequired("user_id", req.UserId, locale),
+		validateRequired("body", req.Body, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	message, err := h.chatService.SendMessage(ctx, req.ChatId, req.UserId, req.Body)
+	if err != nil {
+		return nil, mapChatError(err)
+	}
+
+	return convertChatMessageToProto(message), nil
+}
+
+func (h *ChatHandler) CloseChat(ctx context.Context, req *pb.CloseChatRequest) (*pb.ChatSessionResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("chat_id", req.ChatId, locale),
+		validateRequired("user_id", req.UserId, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	session, err := h.chatService.CloseChat(ctx, req.ChatId, req.UserId)
+	if err != nil {
+		return nil, mapChatError(err)
+	}
+
+	return convertChatToProto(session, nil), nil
+}
+
+func (h *ChatHandler) ExportChatTranscript(ctx context.Context, req *pb.ExportChatTranscriptRequest) (*pb.ChatTranscriptResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("chat_id", req.ChatId, locale),
+		validateRequired("user_id", req.UserId, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	filename, content, err := h.chatService.ExportTranscript(ctx, req.ChatId, req.UserId)
+	if err != nil {
+		return nil, mapChatError(err)
+	}
+
+	return &pb.ChatTranscriptResponse{Filename: filename, Content: content}, nil
+}
+
+func mapChatError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrChatNotFound), errors.Is(err, service.ErrChatTicketNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrChatForbidden):
+		return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case errors.Is(err, service.ErrChatNotSupportTicket),
+		errors.Is(err, service.ErrChatTicketClosed),
+		errors.Is(err, service.ErrChatClosed):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	case errors.Is(err, service.ErrChatEmptyMessage), errors.Is(err, service.ErrChatMessageTooLong):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+	return status.Errorf(codes.Internal, "operation failed: %v", err)
+}
+
+// Helper function to convert chat session model to proto response
+func convertChatToProto(session *models.ChatSession, messages []*models.ChatMessage) *pb.ChatSessionResponse {
+	response := &pb.ChatSessionResponse{
+		Id:       session.ID,
+		TicketId: session.TicketID,
+		UserId:   session.UserID,
+		Status:   session.Status,
+		Room:     service.ChatRoom(session.ID),
+		Date:     utils.FormatJalaliDate(session.CreatedAt),
+		Time:     utils.FormatJalaliTime(session.CreatedAt),
+	}
+
+	if session.AgentID != nil {
+		response.AgentId = *session.AgentID
+	}
+	if session.ClosedAt != nil {
+		response.ClosedDate = utils.FormatJalaliDate(*session.ClosedAt)
+	}
+	for _, message := range messages {
+		response.Messages = append(response.Messages, convertChatMessageToProto(message))
+	}
+
+	return response
+}
+
+func convertChatMessageToProto(message *models.ChatMessage) *pb.ChatMessageResponse {
+	return &pb.ChatMessageResponse{
+		Id:        message.ID,
+		ChatId:    message.SessionID,
+		SenderId:  message.SenderID,
+		FromAgent: message.FromAgent,
+		Body:      message.Body,
+		Date:      utils.FormatJalaliDate(message.CreatedAt),
+		Time:      utils.FormatJalaliTime(message.CreatedAt),
+		CreatedAt: message.CreatedAt.Unix(),
+	}
+}
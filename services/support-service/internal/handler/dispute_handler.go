@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"metargb/support-service/internal/models"
+	"metargb/support-service/internal/service"
+	"metargb/support-service/internal/utils"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pb "metargb/shared/pb/support"
+)
+
+type DisputeHandler struct {
+	pb.UnimplementedDisputeServiceServer
+	disputeService service.DisputeService
+}
+
+func NewDisputeHandler(disputeService service.DisputeService) *DisputeHandler {
+	return &DisputeHandler{
+		disputeService: disputeService,
+	}
+}
+
+func RegisterDisputeHandler(grpcServer *grpc.Server, disputeService service.DisputeService) {
+	handler := NewDisputeHandler(disputeService)
+	pb.RegisterDisputeServiceServer(grpcServer, handler)
+}
+
+func (h *DisputeHandler) OpenDispute(ctx context.Context, req *pb.OpenDisputeRequest) (*pb.DisputeResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("user_id", req.UserId, locale),
+		validateRequired("trade_id", req.TradeId, locale),
+		validateRequired("reason", req.Reason, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	dispute, err := h.disputeService.OpenDispute(ctx, req.UserId, req.TradeId, req.Reason, req.Description)
+	if err != nil {
+		return nil, mapDisputeError(err)
+	}
+
+	return convertDisputeToProto(dispute), nil
+}
+
+func (h *DisputeHandler) ListDisputes(ctx context.Context, req *pb.ListDisputesRequest) (*pb.DisputesResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := validateRequired("user_id", req.UserId, locale)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	disputes, err := h.disputeService.ListDisputes(ctx, req.UserId)
+	if err != nil {
+		return nil, mapDisputeError(err)
+	}
+
+	response := &pb.DisputesResponse{
+		Disputes: make([]*pb.DisputeResponse, len(disputes)),
+	}
+	for i, dispute := range disputes {
+		response.Disputes[i] = convertDisputeToProto(dispute)
+	}
+
+	return response, nil
+}
+
+func (h *DisputeHandler) GetDispute(ctx context.Context, req *pb.GetDisputeRequest) (*pb.DisputeResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("dispute_id", req.DisputeId, locale),
+		validateRequired("user_id", req.UserId, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	dispute, err := h.disputeService.GetDispute(ctx, req.DisputeId, req.UserId)
+	if err != nil {
+		return nil, mapDisputeError(err)
+	}
+
+	return convertDisputeToProto(dispute), nil
+}
+
+func (h *DisputeHandler) ResolveDispute(ctx context.Context, req *pb.ResolveDisputeRequest) (*pb.DisputeResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("dispute_id", req.DisputeId, locale),
+		validateRequired("agent_id", req.AgentId, locale),
+		validateRequired("outcome", req.Outcome, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	dispute, err := h.disputeService.ResolveDispute(ctx, req.DisputeId, req.AgentId, req.Outcome, req.Note)
+	if err != nil {
+		return nil, mapDisputeError(err)
+	}
+
+	return convertDisputeToProto(dispute), nil
+}
+
+func mapDisputeError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrDisputeNotFound), errors.Is(err, service.ErrDisputeTradeNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrDisputeNotParticipant),
+		errors.Is(err, service.ErrDisputeForbidden),
+		errors.Is(err, service.ErrDisputeNotAgent):
+		return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case errors.Is(err, service.ErrDisputeWindowClosed),
+		errors.Is(err, service.ErrDisputeAlreadyOpen),
+		errors.Is(err, service.ErrDisputeAlreadyResolved):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	case errors.Is(err, service.ErrDisputeInvalidOutcome):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	case errors.Is(err, service.ErrDisputeTradesUnavailable):
+		return status.Errorf(codes.Unavailable, "%s", err.Error())
+	}
+
+	// Failures executing an outcome keep the status reported by features-service
+	if st, ok := status.FromError(err); ok && st.Code() != codes.Unknown {
+		return status.Errorf(st.Code(), "%s", err.Error())
+	}
+	return status.Errorf(codes.Internal, "operation failed: %v", err)
+}
+
+// Helper function to convert dispute model to proto response
+func convertDisputeToProto(dispute *models.Dispute) *pb.DisputeResponse {
+	response := &pb.DisputeResponse{
+		Id:           dispute.ID,
+		TradeId:      dispute.TradeID,
+		FeatureId:    dispute.FeatureID,
+		PropertiesId: dispute.PropertiesID,
+		BuyerId:      dispute.BuyerID,
+		SellerId:     dispute.SellerID,
+		OpenedBy:     dispute.OpenedBy,
+		TicketId:     dispute.TicketID,
+		Reason:       dispute.Reason,
+		Description:  dispute.Description,
+		FundsFrozen:  dispute.FundsFrozen,
+		Status:       dispute.Status,
+		Date:         utils.FormatJalaliDate(dispute.CreatedAt),
+		Time:         utils.FormatJalaliTime(dispute.CreatedAt),
+	}
+
+	if dispute.Outcome != nil {
+		response.Outcome = *dispute.Outcome
+	}
+	if dispute.ResolutionNote != nil {
+		response.ResolutionNote = *dispute.ResolutionNote
+	}
+	if dispute.ResolvedAt != nil {
+		response.ResolvedDate = utils.FormatJalaliDate(*dispute.ResolvedAt)
+	}
+
+	return response
+}
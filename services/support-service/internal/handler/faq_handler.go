@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"metargb/support-service/internal/models"
+	"metargb/support-service/internal/service"
+	"metargb/support-service/internal/utils"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pbCommon "metargb/shared/pb/common"
+	pb "metargb/shared/pb/support"
+)
+
+type FaqHandler struct {
+	pb.UnimplementedFaqServiceServer
+	faqService service.FaqService
+}
+
+func NewFaqHandler(faqService service.FaqService) *FaqHandler {
+	return &FaqHandler{
+		faqService: faqService,
+	}
+}
+
+func RegisterFaqHandler(grpcServer *grpc.Server, faqService service.FaqService) {
+	handler := NewFaqHandler(faqService)
+	pb.RegisterFaqServiceServer(grpcServer, handler)
+}
+
+func (h *FaqHandler) ListFaqs(ctx context.Context, req *pb.ListFaqsRequest) (*pb.FaqsResponse, error) {
+	faqs, err := h.faqService.ListFaqs(ctx, req.Department)
+	if err != nil {
+		return nil, mapFaqError(err)
+	}
+
+	response := &pb.FaqsResponse{
+		Faqs: make([]*pb.FaqResponse, len(faqs)),
+	}
+	for i, faq := range faqs {
+		response.Faqs[i] = convertFaqToProto(faq)
+	}
+
+	return response, nil
+}
+
+func (h *FaqHandler) CreateFaq(ctx context.Context, req *pb.CreateFaqRequest) (*pb.FaqResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("user_id", req.UserId, locale),
+		validateRequired("question", req.Question, locale),
+		validateRequired("answer", req.Answer, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	faq, err := h.faqService.CreateFaq(ctx, req.UserId, req.Question, req.Answer, req.Department, req.Keywords)
+	if err != nil {
+		return nil, mapFaqError(err)
+	}
+
+	return convertFaqToProto(faq), nil
+}
+
+func (h *FaqHandler) UpdateFaq(ctx context.Context, req *pb.UpdateFaqRequest) (*pb.FaqResponse, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("faq_id", req.FaqId, locale),
+		validateRequired("user_id", req.UserId, locale),
+		validateRequired("question", req.Question, locale),
+		validateRequired("answer", req.Answer, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	faq, err := h.faqService.UpdateFaq(ctx, req.FaqId, req.UserId, req.Question, req.Answer, req.Department, req.Keywords)
+	if err != nil {
+		return nil, mapFaqError(err)
+	}
+
+	return convertFaqToProto(faq), nil
+}
+
+func (h *FaqHandler) DeleteFaq(ctx context.Context, req *pb.DeleteFaqRequest) (*pbCommon.Empty, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("faq_id", req.FaqId, locale),
+		validateRequired("user_id", req.UserId, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	if err := h.faqService.DeleteFaq(ctx, req.FaqId, req.UserId); err != nil {
+		return nil, mapFaqError(err)
+	}
+
+	return &pbCommon.Empty{}, nil
+}
+
+func (h *FaqHandler) AcceptTicketSuggestion(ctx context.Context, req *pb.AcceptTicketSuggestionRequest) (*pb.TicketSuggestion, error) {
+	locale := "en" // TODO: Get locale from config or context
+	validationErrors := mergeValidationErrors(
+		validateRequired("ticket_id", req.TicketId, locale),
+		validateRequired("suggestion_id", req.SuggestionId, locale),
+		validateRequired("user_id", req.UserId, locale),
+	)
+	if len(validationErrors) > 0 {
+		return nil, returnValidationError(validationErrors)
+	}
+
+	suggestion, err := h.faqService.AcceptSuggestion(ctx, req.TicketId, req.SuggestionId, req.UserId)
+	if err != nil {
+		return nil, mapFaqError(err)
+	}
+
+	return convertTicketSuggestionToProto(suggestion), nil
+}
+
+func mapFaqError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrFaqNotFound),
+		errors.Is(err, service.ErrSuggestionNotFound),
+		errors.Is(err, service.ErrSuggestionTicketNotFound):
+		return status.Errorf(codes.NotFound, "%s", err.Error())
+	case errors.Is(err, service.ErrFaqNotAgent), errors.Is(err, service.ErrSuggestionForbidden):
+		return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case errors.Is(err, service.ErrSuggestionTicketClosed):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	case errors.Is(err, service.ErrFaqQuestionRequired),
+		errors.Is(err, service.ErrFaqQuestionTooLong),
+		errors.Is(err, service.ErrFaqAnswerRequired),
+		errors.Is(err, service.ErrFaqInvalidDepartment):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+	return status.Errorf(codes.Internal, "operation failed: %v", err)
+}
+
+// Helper function to convert FAQ model to proto response
+func convertFaqToProto(faq *models.Faq) *pb.FaqResponse {
+	response := &pb.FaqResponse{
+		Id:        faq.ID,
+		Question:  faq.Question,
+		Answer:    faq.Answer,
+		Keywords:  faq.Keywords,
+		UpdatedAt: utils.FormatJalaliDateTime(faq.UpdatedAt),
+	}
+	if faq.Department != nil {
+		response.Department = *faq.Department
+	}
+	return response
+}
+
+func convertTicketSuggestionToProto(suggestion *models.TicketSuggestion) *pb.TicketSuggestion {
+	return &pb.TicketSuggestion{
+		Id:       suggestion.ID,
+		TicketId: suggestion.TicketID,
+		Source:   suggestion.Source,
+		SourceId: suggestion.SourceID,
+		Title:    suggestion.Title,
+		Body:     suggestion.Body,
+		Slug:     suggestion.Slug,
+		Score:    suggestion.Score,
+		Accepted: suggestion.AcceptedAt != nil,
+	}
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+	"metargb/support-service/internal/repository"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pb "metargb/shared/pb/stats"
+)
+
+type StatsHandler struct {
+	pb.UnimplementedStatsServiceServer
+	statsRepo repository.StatsRepository
+	ticketSLA time.Duration
+}
+
+func NewStatsHandler(statsRepo repository.StatsRepository, ticketSLA time.Duration) *StatsHandler {
+	return &StatsHandler{
+		statsRepo: statsRepo,
+		ticketSLA: ticketSLA,
+	}
+}
+
+func RegisterStatsHandler(grpcServer *grpc.Server, statsRepo repository.StatsRepository, ticketSLA time.Duration) {
+	handler := NewStatsHandler(statsRepo, ticketSLA)
+	pb.RegisterStatsServiceServer(grpcServer, handler)
+}
+
+// GetStats reports ticket figures for the scheduled admin reports
+func (h *StatsHandler) GetStats(ctx context.Context, req *pb.GetStatsRequest) (*pb.GetStatsResponse, error) {
+	if req.From <= 0 || req.To <= req.From {
+		return nil, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+
+	opened, breaches, err := h.statsRepo.GetTicketStats(ctx, time.Unix(req.From, 0), time.Unix(req.To, 0), h.ticketSLA)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get stats: %v", err)
+	}
+
+	return &pb.GetStatsResponse{
+		Metrics: []*pb.Metric{
+			{Key: "tickets", Value: float64(opened)},
+			{Key: "sla_breaches", Value: float64(breaches)},
+		},
+	}, nil
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"metargb/support-service/internal/models"
+	"metargb/support-service/internal/service"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pb "metargb/shared/pb/support"
+)
+
+type SupportStatsHandler struct {
+	pb.UnimplementedSupportStatsServiceServer
+	supportStatsService service.SupportStatsService
+}
+
+func NewSupportStatsHandler(supportStatsService service.SupportStatsService) *SupportStatsHandler {
+	return &SupportStatsHandler{
+		supportStatsService: supportStatsService,
+	}
+}
+
+func RegisterSupportStatsHandler(grpcServer *grpc.Server, supportStatsService service.SupportStatsService) {
+	handler := NewSupportStatsHandler(supportStatsService)
+	pb.RegisterSupportStatsServiceServer(grpcServer, handler)
+}
+
+func (h *SupportStatsHandler) GetSupportStats(ctx context.Context, req *pb.GetSupportStatsRequest) (*pb.SupportStatsResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	stats, err := h.supportStatsService.GetSupportStats(ctx, req.UserId, time.Unix(req.From, 0), time.Unix(req.To, 0))
+	if err != nil {
+		return nil, mapSupportStatsError(err)
+	}
+
+	return supportStatsToPB(stats), nil
+}
+
+func mapSupportStatsError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrSupportStatsNotAgent):
+		return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case errors.Is(err, service.ErrSupportStatsInvalidPeriod),
+		errors.Is(err, service.ErrSupportStatsPeriodTooLong):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+	return status.Errorf(codes.Internal, "operation failed: %v", err)
+}
+
+// supportStatsToPB lists every status, including those without tickets, and
+// the departments by ticket count
+func supportStatsToPB(stats *models.SupportStats) *pb.SupportStatsResponse {
+	resp := &pb.SupportStatsResponse{
+		From:                    stats.From.Unix(),
+		To:                      stats.To.Unix(),
+		TotalTickets:            stats.Total,
+		RespondedTickets:        stats.Responded,
+		AvgFirstResponseSeconds: stats.AvgFirstResponse.Seconds(),
+		ResolvedTickets:         stats.Resolved,
+		AvgResolutionSeconds:    stats.AvgResolution.Seconds(),
+		SuggestedTickets:        stats.Suggested,
+		DeflectedTickets:        stats.Deflected,
+		GeneratedAt:             stats.GeneratedAt.Unix(),
+	}
+
+	for s := int32(models.TicketStatusNew); s <= models.TicketStatusClosed; s++ {
+		resp.ByStatus = append(resp.ByStatus, &pb.SupportStatusCount{
+			Status: s,
+			Name:   models.GetStatusName(s),
+			Count:  stats.ByStatus[s],
+		})
+	}
+
+	for department, count := range stats.ByDepartment {
+		resp.ByDepartment = append(resp.ByDepartment, &pb.SupportDepartmentCount{
+			Department: department,
+			Title:      models.GetDepartmentTitle(department),
+			Count:      count,
+		})
+	}
+	sort.Slice(resp.ByDepartment, func(i, j int) bool {
+		a, b := resp.ByDepartment[i], resp.ByDepartment[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Department < b.Department
+	})
+
+	return resp
+}
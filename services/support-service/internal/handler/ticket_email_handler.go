@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"metargb/support-service/internal/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pb "metargb/shared/pb/support"
+)
+
+type TicketEmailHandler struct {
+	pb.UnimplementedTicketEmailServiceServer
+	ticketEmailService service.TicketEmailService
+}
+
+func NewTicketEmailHandler(ticketEmailService service.TicketEmailService) *TicketEmailHandler {
+	return &TicketEmailHandler{
+		ticketEmailService: ticketEmailService,
+	}
+}
+
+func RegisterTicketEmailHandler(grpcServer *grpc.Server, ticketEmailService service.TicketEmailService) {
+	handler := NewTicketEmailHandler(ticketEmailService)
+	pb.RegisterTicketEmailServiceServer(grpcServer, handler)
+}
+
+func (h *TicketEmailHandler) IngestEmail(ctx context.Context, req *pb.IngestEmailRequest) (*pb.IngestEmailResponse, error) {
+	if len(req.RawMessage) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "raw_message is required")
+	}
+
+	result, err := h.ticketEmailService.IngestEmail(ctx, req.RawMessage, req.WebhookToken)
+	if err != nil {
+		return nil, mapTicketEmailError(err)
+	}
+
+	return &pb.IngestEmailResponse{
+		Status:   result.Status,
+		TicketId: result.TicketID,
+	}, nil
+}
+
+func mapTicketEmailError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidWebhookToken):
+		return status.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case errors.Is(err, service.ErrEmailIngestionDisabled):
+		return status.Errorf(codes.Unavailable, "%s", err.Error())
+	case errors.Is(err, service.ErrInvalidEmail):
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+	return status.Errorf(codes.Internal, "operation failed: %v", err)
+}
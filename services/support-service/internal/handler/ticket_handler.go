@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"fmt"
+	"log"
 	"metargb/support-service/internal/models"
 	"metargb/support-service/internal/service"
 	"metargb/support-service/internal/utils"
@@ -16,7 +17,8 @@ import (
 
 type TicketHandler struct {
 	pb.UnimplementedTicketServiceServer
-	ticketService service.TicketService
+	ticketService     service.TicketService
+	suggestionService service.FaqService
 }
 
 func NewTicketHandler(ticketService service.TicketService) *TicketHandler {
@@ -25,8 +27,12 @@ func NewTicketHandler(ticketService service.TicketService) *TicketHandler {
 	}
 }
 
-func RegisterTicketHandler(grpcServer *grpc.Server, ticketService service.TicketService) {
+// RegisterTicketHandler registers the ticket service. New tickets sent to a
+// department are answered with FAQ and training suggestions from
+// suggestionService, which may be nil.
+func RegisterTicketHandler(grpcServer *grpc.Server, ticketService service.TicketService, suggestionService service.FaqService) {
 	handler := NewTicketHandler(ticketService)
+	handler.suggestionService = suggestionService
 	pb.RegisterTicketServiceServer(grpcServer, handler)
 }
 
@@ -64,7 +70,21 @@ func (h *TicketHandler) CreateTicket(ctx context.Context, req *pb.CreateTicketRe
 		return nil, status.Errorf(codes.Internal, "failed to create ticket: %v", err)
 	}
 
-	return convertTicketToProto(ticket), nil
+	response := convertTicketToProto(ticket)
+
+	// The ticket is already created, so it is returned without suggestions
+	// when they cannot be saved
+	if h.suggestionService != nil {
+		suggestions, err := h.suggestionService.Suggest(ctx, ticket)
+		if err != nil {
+			log.Printf("Failed to suggest answers for ticket %d: %v", ticket.ID, err)
+		}
+		for _, suggestion := range suggestions {
+			response.Suggestions = append(response.Suggestions, convertTicketSuggestionToProto(suggestion))
+		}
+	}
+
+	return response, nil
 }
 
 func (h *TicketHandler) GetTickets(ctx context.Context, req *pb.GetTicketsRequest) (*pb.TicketsResponse, error) {
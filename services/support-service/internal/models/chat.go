@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+)
+
+// Chat session statuses
+const (
+	ChatStatusOpen   = "open"
+	ChatStatusClosed = "closed"
+)
+
+// ChatSession is a live chat between a ticket's sender and support agents
+type ChatSession struct {
+	ID        uint64     `db:"id"`
+	TicketID  uint64     `db:"ticket_id"`
+	UserID    uint64     `db:"user_id"`
+	AgentID   *uint64    `db:"agent_id"`
+	Status    string     `db:"status"`
+	ClosedBy  *uint64    `db:"closed_by"`
+	ClosedAt  *time.Time `db:"closed_at"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+}
+
+// IsOpen checks if messages can still be sent to the chat
+func (c *ChatSession) IsOpen() bool {
+	return c.Status == ChatStatusOpen
+}
+
+// ChatMessage is a message sent in a chat session
+type ChatMessage struct {
+	ID        uint64    `db:"id"`
+	SessionID uint64    `db:"session_id"`
+	SenderID  uint64    `db:"sender_id"`
+	FromAgent bool      `db:"from_agent"`
+	Body      string    `db:"body"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+)
+
+// Dispute statuses
+const (
+	DisputeStatusOpen     = "open"
+	DisputeStatusResolved = "resolved"
+)
+
+// Dispute outcomes chosen by the resolving support agent
+const (
+	// DisputeOutcomeRefund returns the price to the buyer and the feature to the seller
+	DisputeOutcomeRefund = "refund"
+	// DisputeOutcomeUphold keeps the trade and releases any frozen proceeds
+	DisputeOutcomeUphold = "uphold"
+)
+
+// Dispute represents a dispute on a feature trade
+type Dispute struct {
+	ID             uint64     `db:"id"`
+	TradeID        uint64     `db:"trade_id"`
+	FeatureID      uint64     `db:"feature_id"`
+	PropertiesID   string     `db:"properties_id"`
+	BuyerID        uint64     `db:"buyer_id"`
+	SellerID       uint64     `db:"seller_id"`
+	OpenedBy       uint64     `db:"opened_by"`
+	TicketID       uint64     `db:"ticket_id"`
+	Reason         string     `db:"reason"`
+	Description    string     `db:"description"`
+	FundsFrozen    bool       `db:"funds_frozen"`
+	Status         string     `db:"status"`
+	Outcome        *string    `db:"outcome"`
+	ResolutionNote *string    `db:"resolution_note"`
+	ResolvedBy     *uint64    `db:"resolved_by"`
+	ResolvedAt     *time.Time `db:"resolved_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+}
+
+// IsParticipant checks if the user is the buyer or seller of the disputed trade
+func (d *Dispute) IsParticipant(userID uint64) bool {
+	return d.BuyerID == userID || d.SellerID == userID
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+)
+
+// Sources of ticket suggestions
+const (
+	SuggestionSourceFaq           = "faq"
+	SuggestionSourceTrainingVideo = "training_video"
+)
+
+// Faq is a frequently asked question suggested to users creating tickets
+type Faq struct {
+	ID         uint64    `db:"id"`
+	Question   string    `db:"question"`
+	Answer     string    `db:"answer"`
+	Department *string   `db:"department"` // nil for every department
+	Keywords   string    `db:"keywords"`
+	CreatedBy  uint64    `db:"created_by"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+// TicketSuggestion is an FAQ or training video shown to the sender of a new
+// ticket. Accepting it closes the ticket as answered by the suggestion.
+type TicketSuggestion struct {
+	ID         uint64     `db:"id"`
+	TicketID   uint64     `db:"ticket_id"`
+	Source     string     `db:"source"`
+	SourceID   uint64     `db:"source_id"`
+	Title      string     `db:"title"`
+	Body       string     `db:"body"`
+	Slug       string     `db:"slug"`
+	Score      float64    `db:"score"`
+	AcceptedAt *time.Time `db:"accepted_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
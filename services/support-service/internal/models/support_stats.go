@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// SupportStats aggregates the tickets created in [From, To)
+type SupportStats struct {
+	From             time.Time
+	To               time.Time
+	Total            int64
+	ByStatus         map[int32]int64
+	ByDepartment     map[string]int64 // "" holds tickets sent to a user
+	Responded        int64
+	AvgFirstResponse time.Duration
+	Resolved         int64
+	AvgResolution    time.Duration
+	Suggested        int64 // tickets shown FAQ or training suggestions
+	Deflected        int64 // tickets closed by accepting a suggestion
+	GeneratedAt      time.Time
+}
@@ -22,6 +22,7 @@ const (
 	DeptInspection       = "inspection"
 	DeptProtection       = "protection"
 	DeptZTB              = "ztb"
+	DeptTradeDisputes    = "trade_disputes"
 )
 
 // Ticket represents a support ticket
@@ -89,6 +90,28 @@ func GetDepartmentTitle(dept string) string {
 		return "حراست"
 	case DeptZTB:
 		return "مدیریت کل ز ت ب"
+	case DeptTradeDisputes:
+		return "اختلافات معاملات"
+	default:
+		return ""
+	}
+}
+
+// GetStatusName returns the English name of a ticket status for reports
+func GetStatusName(status int32) string {
+	switch status {
+	case TicketStatusNew:
+		return "new"
+	case TicketStatusAnswered:
+		return "answered"
+	case TicketStatusResolved:
+		return "resolved"
+	case TicketStatusUnresolved:
+		return "unresolved"
+	case TicketStatusTracking:
+		return "tracking"
+	case TicketStatusClosed:
+		return "closed"
 	default:
 		return ""
 	}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"time"
+)
+
+// Ticket email directions
+const (
+	TicketEmailInbound  = "inbound"
+	TicketEmailOutbound = "outbound"
+)
+
+// Results of ingesting an inbound email
+const (
+	// IngestStatusCreated means the email opened a new ticket
+	IngestStatusCreated = "created"
+	// IngestStatusReplied means the email was added as a response to an existing ticket
+	IngestStatusReplied = "replied"
+	// IngestStatusDuplicate means the email was already ingested, e.g. on a webhook retry
+	IngestStatusDuplicate = "duplicate"
+	// IngestStatusIgnored means the email was dropped (auto-reply, unknown sender, ...)
+	IngestStatusIgnored = "ignored"
+)
+
+// TicketEmail is an email received for or sent from a ticket. Emails are
+// threaded into tickets by their Message-ID.
+type TicketEmail struct {
+	ID          uint64    `db:"id"`
+	TicketID    uint64    `db:"ticket_id"`
+	ResponseID  *uint64   `db:"response_id"` // ticket response sent by an outbound email
+	Direction   string    `db:"direction"`
+	MessageID   string    `db:"message_id"`
+	FromAddress string    `db:"from_address"`
+	ToAddress   string    `db:"to_address"`
+	Subject     string    `db:"subject"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+// InboundEmail is a parsed email sent to the support mailbox
+type InboundEmail struct {
+	MessageID   string
+	InReplyTo   string
+	References  []string
+	FromAddress string
+	FromName    string
+	ToAddress   string
+	Subject     string
+	Body        string
+	// AutoSubmitted is set for auto-replies and bulk mail, which must not create tickets
+	AutoSubmitted bool
+}
+
+// ThreadIDs returns the message IDs the email replies to, most recent first
+func (e *InboundEmail) ThreadIDs() []string {
+	var ids []string
+	if e.InReplyTo != "" {
+		ids = append(ids, e.InReplyTo)
+	}
+	for i := len(e.References) - 1; i >= 0; i-- {
+		if e.References[i] != e.InReplyTo {
+			ids = append(ids, e.References[i])
+		}
+	}
+	return ids
+}
+
+// IngestResult is the outcome of ingesting an inbound email
+type IngestResult struct {
+	Status   string
+	TicketID uint64
+}
+
+// PendingEmailReply is a ticket response that must be emailed to the sender of
+// an email ticket
+type PendingEmailReply struct {
+	ResponseID    uint64
+	TicketID      uint64
+	TicketTitle   string
+	TicketCode    int32
+	Response      string
+	ResponserName string
+}
+
+// EmailSender is the registered user an inbound email belongs to
+type EmailSender struct {
+	UserID uint64
+	Name   string
+}
@@ -0,0 +1,62 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/redis/go-redis/v9/maintnotifications"
+)
+
+// RoomEventsChannel is the Redis channel the WebSocket gateway broadcasts to
+// the connections subscribed to a room
+const RoomEventsChannel = "room-events"
+
+// RedisPublisher publishes chat events to Redis for WebSocket broadcasting
+type RedisPublisher struct {
+	client *redis.Client
+}
+
+// NewRedisPublisher connects to Redis and checks the connection
+func NewRedisPublisher(redisURL string) (*RedisPublisher, error) {
+	opts, err := redis.ParseURL(redisURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Redis URL: %w", err)
+	}
+
+	// Disable maint notifications to avoid warning about maint_notifications command
+	opts.MaintNotificationsConfig = &maintnotifications.Config{
+		Mode: maintnotifications.ModeDisabled,
+	}
+
+	client := redis.NewClient(opts)
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	return &RedisPublisher{client: client}, nil
+}
+
+// PublishRoomEvent sends event with data to every connection in room
+func (p *RedisPublisher) PublishRoomEvent(ctx context.Context, room, event string, data interface{}) error {
+	payload, err := json.Marshal(map[string]interface{}{
+		"room":  room,
+		"event": event,
+		"data":  data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	if err := p.client.Publish(ctx, RoomEventsChannel, payload).Err(); err != nil {
+		return fmt.Errorf("failed to publish %s: %w", event, err)
+	}
+	return nil
+}
+
+// Close closes the Redis connection
+func (p *RedisPublisher) Close() error {
+	return p.client.Close()
+}
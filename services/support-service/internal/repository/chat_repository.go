@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"metargb/support-service/internal/models"
+)
+
+type ChatRepository interface {
+	CreateSession(ctx context.Context, session *models.ChatSession) (*models.ChatSession, error)
+	GetSession(ctx context.Context, sessionID uint64) (*models.ChatSession, error)
+	GetOpenSessionByTicketID(ctx context.Context, ticketID uint64) (*models.ChatSession, error)
+	GetSessionsByUserID(ctx context.Context, userID uint64) ([]*models.ChatSession, error)
+	GetOpenSessions(ctx context.Context) ([]*models.ChatSession, error)
+	// AssignAgent sets the agent of a session that has none and reports
+	// whether it did
+	AssignAgent(ctx context.Context, sessionID, agentID uint64) (bool, error)
+	CloseSession(ctx context.Context, sessionID, closedBy uint64) error
+	CreateMessage(ctx context.Context, message *models.ChatMessage) (*models.ChatMessage, error)
+	GetMessages(ctx context.Context, sessionID uint64) ([]*models.ChatMessage, error)
+}
+
+type chatRepository struct {
+	db *sql.DB
+}
+
+func NewChatRepository(db *sql.DB) ChatRepository {
+	return &chatRepository{db: db}
+}
+
+const chatSessionColumns = `
+	id, ticket_id, user_id, agent_id, status, closed_by, closed_at, created_at, updated_at
+`
+
+func (r *chatRepository) CreateSession(ctx context.Context, session *models.ChatSession) (*models.ChatSession, error) {
+	query := `
+		INSERT INTO support_chat_sessions (ticket_id, user_id, agent_id, status, created_at, updated_at)
+		VALUES (?, ?, ?, ?, NOW(), NOW())
+	`
+
+	result, err := r.db.ExecContext(ctx, query, session.TicketID, session.UserID, session.AgentID, session.Status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create chat session: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	session.ID = uint64(id)
+	return session, nil
+}
+
+func (r *chatRepository) GetSession(ctx context.Context, sessionID uint64) (*models.ChatSession, error) {
+	query := `SELECT ` + chatSessionColumns + ` FROM support_chat_sessions WHERE id = ?`
+
+	session, err := scanChatSession(r.db.QueryRowContext(ctx, query, sessionID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chat session: %w", err)
+	}
+
+	return session, nil
+}
+
+func (r *chatRepository) GetOpenSessionByTicketID(ctx context.Context, ticketID uint64) (*models.ChatSession, error) {
+	query := `SELECT ` + chatSessionColumns + ` FROM support_chat_sessions WHERE ticket_id = ? AND status = ? ORDER BY id DESC LIMIT 1`
+
+	session, err := scanChatSession(r.db.QueryRowContext(ctx, query, ticketID, models.ChatStatusOpen))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chat session: %w", err)
+	}
+
+	return session, nil
+}
+
+func (r *chatRepository) GetSessionsByUserID(ctx context.Context, userID uint64) ([]*models.ChatSession, error) {
+	query := `
+		SELECT ` + chatSessionColumns + `
+		FROM support_chat_sessions
+		WHERE user_id = ?
+		ORDER BY id DESC
+	`
+
+	return r.listSessions(ctx, query, userID)
+}
+
+func (r *chatRepository) GetOpenSessions(ctx context.Context) ([]*models.ChatSession, error) {
+	query := `
+		SELECT ` + chatSessionColumns + `
+		FROM support_chat_sessions
+		WHERE status = ?
+		ORDER BY id
+	`
+
+	return r.listSessions(ctx, query, models.ChatStatusOpen)
+}
+
+func (r *chatRepository) AssignAgent(ctx context.Context, sessionID, agentID uint64) (bool, error) {
+	query := `
+		UPDATE support_chat_sessions
+		SET agent_id = ?, updated_at = NOW()
+		WHERE id = ? AND agent_id IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, agentID, sessionID)
+	if err != nil {
+		return false, fmt.Errorf("failed to assign chat agent: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return affected > 0, nil
+}
+
+func (r *chatRepository) CloseSession(ctx context.Context, sessionID, closedBy uint64) error {
+	query := `
+		UPDATE support_chat_sessions
+		SET status = ?, closed_by = ?, closed_at = NOW(), updated_at = NOW()
+		WHERE id = ? AND status = ?
+	`
+
+	_, err := r.db.ExecContext(ctx, query, models.ChatStatusClosed, closedBy, sessionID, models.ChatStatusOpen)
+	if err != nil {
+		return fmt.Errorf("failed to close chat session: %w", err)
+	}
+
+	return nil
+}
+
+func (r *chatRepository) CreateMessage(ctx context.Context, message *models.ChatMessage) (*models.ChatMessage, error) {
+	query := `
+		INSERT INTO support_chat_messages (session_id, sender_id, from_agent, body, created_at)
+		VALUES (?, ?, ?, ?, ?)
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		message.SessionID,
+		message.SenderID,
+		message.FromAgent,
+		message.Body,
+		message.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create chat message: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	message.ID = uint64(id)
+	return message, nil
+}
+
+func (r *chatRepository) GetMessages(ctx context.Context, sessionID uint64) ([]*models.ChatMessage, error) {
+	query := `
+		SELECT id, session_id, sender_id, from_agent, body, created_at
+		FROM support_chat_messages
+		WHERE session_id = ?
+		ORDER BY id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chat messages: %w", err)
+	}
+	defer rows.Close()
+
+	var messages []*models.ChatMessage
+	for rows.Next() {
+		var message models.ChatMessage
+		if err := rows.Scan(
+			&message.ID, &message.SessionID, &message.SenderID,
+			&message.FromAgent, &message.Body, &message.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan chat message: %w", err)
+		}
+		messages = append(messages, &message)
+	}
+
+	return messages, rows.Err()
+}
+
+func (r *chatRepository) listSessions(ctx context.Context, query string, args ...interface{}) ([]*models.ChatSession, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chat sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []*models.ChatSession
+	for rows.Next() {
+		session, err := scanChatSession(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan chat session: %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, rows.Err()
+}
+
+type chatSessionScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanChatSession(s chatSessionScanner) (*models.ChatSession, error) {
+	var session models.ChatSession
+	err := s.Scan(
+		&session.ID, &session.TicketID, &session.UserID, &session.AgentID, &session.Status,
+		&session.ClosedBy, &session.ClosedAt, &session.CreatedAt, &session.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"metargb/support-service/internal/models"
+)
+
+type DisputeRepository interface {
+	Create(ctx context.Context, dispute *models.Dispute) (*models.Dispute, error)
+	GetByID(ctx context.Context, disputeID uint64) (*models.Dispute, error)
+	GetOpenByTradeID(ctx context.Context, tradeID uint64) (*models.Dispute, error)
+	GetByUserID(ctx context.Context, userID uint64) ([]*models.Dispute, error)
+	GetOpen(ctx context.Context) ([]*models.Dispute, error)
+	Resolve(ctx context.Context, disputeID, agentID uint64, outcome, note string) error
+}
+
+type disputeRepository struct {
+	db *sql.DB
+}
+
+func NewDisputeRepository(db *sql.DB) DisputeRepository {
+	return &disputeRepository{db: db}
+}
+
+const disputeColumns = `
+	id, trade_id, feature_id, properties_id, buyer_id, seller_id, opened_by, ticket_id,
+	reason, description, funds_frozen, status, outcome, resolution_note, resolved_by, resolved_at,
+	created_at, updated_at
+`
+
+func (r *disputeRepository) Create(ctx context.Context, dispute *models.Dispute) (*models.Dispute, error) {
+	query := `
+		INSERT INTO trade_disputes (trade_id, feature_id, properties_id, buyer_id, seller_id, opened_by, ticket_id,
+			reason, description, funds_frozen, status, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		dispute.TradeID,
+		dispute.FeatureID,
+		dispute.PropertiesID,
+		dispute.BuyerID,
+		dispute.SellerID,
+		dispute.OpenedBy,
+		dispute.TicketID,
+		dispute.Reason,
+		dispute.Description,
+		dispute.FundsFrozen,
+		dispute.Status,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dispute: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	dispute.ID = uint64(id)
+	return dispute, nil
+}
+
+func (r *disputeRepository) GetByID(ctx context.Context, disputeID uint64) (*models.Dispute, error) {
+	query := `SELECT ` + disputeColumns + ` FROM trade_disputes WHERE id = ?`
+
+	dispute, err := scanDispute(r.db.QueryRowContext(ctx, query, disputeID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dispute: %w", err)
+	}
+
+	return dispute, nil
+}
+
+func (r *disputeRepository) GetOpenByTradeID(ctx context.Context, tradeID uint64) (*models.Dispute, error) {
+	query := `SELECT ` + disputeColumns + ` FROM trade_disputes WHERE trade_id = ? AND status = ? LIMIT 1`
+
+	dispute, err := scanDispute(r.db.QueryRowContext(ctx, query, tradeID, models.DisputeStatusOpen))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dispute: %w", err)
+	}
+
+	return dispute, nil
+}
+
+func (r *disputeRepository) GetByUserID(ctx context.Context, userID uint64) ([]*models.Dispute, error) {
+	query := `
+		SELECT ` + disputeColumns + `
+		FROM trade_disputes
+		WHERE buyer_id = ? OR seller_id = ?
+		ORDER BY id DESC
+	`
+
+	return r.list(ctx, query, userID, userID)
+}
+
+func (r *disputeRepository) GetOpen(ctx context.Context) ([]*models.Dispute, error) {
+	query := `
+		SELECT ` + disputeColumns + `
+		FROM trade_disputes
+		WHERE status = ?
+		ORDER BY id
+	`
+
+	return r.list(ctx, query, models.DisputeStatusOpen)
+}
+
+func (r *disputeRepository) Resolve(ctx context.Context, disputeID, agentID uint64, outcome, note string) error {
+	query := `
+		UPDATE trade_disputes
+		SET status = ?, outcome = ?, resolution_note = ?, resolved_by = ?, resolved_at = NOW(), updated_at = NOW()
+		WHERE id = ?
+	`
+
+	_, err := r.db.ExecContext(ctx, query, models.DisputeStatusResolved, outcome, note, agentID, disputeID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve dispute: %w", err)
+	}
+
+	return nil
+}
+
+func (r *disputeRepository) list(ctx context.Context, query string, args ...interface{}) ([]*models.Dispute, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get disputes: %w", err)
+	}
+	defer rows.Close()
+
+	var disputes []*models.Dispute
+	for rows.Next() {
+		dispute, err := scanDispute(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan dispute: %w", err)
+		}
+		disputes = append(disputes, dispute)
+	}
+
+	return disputes, rows.Err()
+}
+
+type disputeScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanDispute(s disputeScanner) (*models.Dispute, error) {
+	var dispute models.Dispute
+	err := s.Scan(
+		&dispute.ID, &dispute.TradeID, &dispute.FeatureID, &dispute.PropertiesID,
+		&dispute.BuyerID, &dispute.SellerID, &dispute.OpenedBy, &dispute.TicketID,
+		&dispute.Reason, &dispute.Description, &dispute.FundsFrozen, &dispute.Status,
+		&dispute.Outcome, &dispute.ResolutionNote, &dispute.ResolvedBy, &dispute.ResolvedAt,
+		&dispute.CreatedAt, &dispute.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &dispute, nil
+}
@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"metargb/support-service/internal/models"
+)
+
+type FaqRepository interface {
+	List(ctx context.Context, department string) ([]*models.Faq, error)
+	GetByID(ctx context.Context, faqID uint64) (*models.Faq, error)
+	Create(ctx context.Context, faq *models.Faq) (*models.Faq, error)
+	Update(ctx context.Context, faq *models.Faq) error
+	Delete(ctx context.Context, faqID uint64) error
+
+	// CreateSuggestions records the suggestions shown for a ticket
+	CreateSuggestions(ctx context.Context, suggestions []*models.TicketSuggestion) error
+	GetSuggestion(ctx context.Context, suggestionID uint64) (*models.TicketSuggestion, error)
+	AcceptSuggestion(ctx context.Context, suggestionID uint64, at time.Time) error
+}
+
+type faqRepository struct {
+	db *sql.DB
+}
+
+func NewFaqRepository(db *sql.DB) FaqRepository {
+	return &faqRepository{db: db}
+}
+
+const faqColumns = `id, question, answer, department, keywords, created_by, created_at, updated_at`
+
+// List returns all FAQs, or the FAQs of department and the general ones
+func (r *faqRepository) List(ctx context.Context, department string) ([]*models.Faq, error) {
+	query := `SELECT ` + faqColumns + ` FROM support_faqs`
+	var args []interface{}
+	if department != "" {
+		query += ` WHERE department IS NULL OR department = ?`
+		args = append(args, department)
+	}
+	query += ` ORDER BY id`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get faqs: %w", err)
+	}
+	defer rows.Close()
+
+	var faqs []*models.Faq
+	for rows.Next() {
+		faq, err := scanFaq(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan faq: %w", err)
+		}
+		faqs = append(faqs, faq)
+	}
+
+	return faqs, rows.Err()
+}
+
+func (r *faqRepository) GetByID(ctx context.Context, faqID uint64) (*models.Faq, error) {
+	query := `SELECT ` + faqColumns + ` FROM support_faqs WHERE id = ?`
+
+	faq, err := scanFaq(r.db.QueryRowContext(ctx, query, faqID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get faq: %w", err)
+	}
+
+	return faq, nil
+}
+
+func (r *faqRepository) Create(ctx context.Context, faq *models.Faq) (*models.Faq, error) {
+	query := `
+		INSERT INTO support_faqs (question, answer, department, keywords, created_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, NOW(), NOW())
+	`
+
+	result, err := r.db.ExecContext(ctx, query, faq.Question, faq.Answer, faq.Department, faq.Keywords, faq.CreatedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create faq: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	faq.ID = uint64(id)
+	return faq, nil
+}
+
+func (r *faqRepository) Update(ctx context.Context, faq *models.Faq) error {
+	query := `
+		UPDATE support_faqs
+		SET question = ?, answer = ?, department = ?, keywords = ?, updated_at = NOW()
+		WHERE id = ?
+	`
+
+	_, err := r.db.ExecContext(ctx, query, faq.Question, faq.Answer, faq.Department, faq.Keywords, faq.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update faq: %w", err)
+	}
+
+	return nil
+}
+
+func (r *faqRepository) Delete(ctx context.Context, faqID uint64) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM support_faqs WHERE id = ?`, faqID)
+	if err != nil {
+		return fmt.Errorf("failed to delete faq: %w", err)
+	}
+
+	return nil
+}
+
+func (r *faqRepository) CreateSuggestions(ctx context.Context, suggestions []*models.TicketSuggestion) error {
+	if len(suggestions) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(suggestions))
+	args := make([]interface{}, 0, len(suggestions)*8)
+	for i, s := range suggestions {
+		placeholders[i] = "(?, ?, ?, ?, ?, ?, ?, ?)"
+		args = append(args, s.TicketID, s.Source, s.SourceID, s.Title, s.Body, s.Slug, s.Score, s.CreatedAt)
+	}
+	query := `
+		INSERT INTO ticket_suggestions (ticket_id, source, source_id, title, body, slug, score, created_at)
+		VALUES ` + strings.Join(placeholders, ", ")
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to create ticket suggestions: %w", err)
+	}
+
+	// MySQL returns the id of the first row of a multi-row insert and
+	// assigns the others consecutively
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get last insert id: %w", err)
+	}
+	for i, s := range suggestions {
+		s.ID = uint64(id) + uint64(i)
+	}
+
+	return nil
+}
+
+func (r *faqRepository) GetSuggestion(ctx context.Context, suggestionID uint64) (*models.TicketSuggestion, error) {
+	query := `
+		SELECT id, ticket_id, source, source_id, title, body, slug, score, accepted_at, created_at
+		FROM ticket_suggestions
+		WHERE id = ?
+	`
+
+	var s models.TicketSuggestion
+	err := r.db.QueryRowContext(ctx, query, suggestionID).Scan(
+		&s.ID, &s.TicketID, &s.Source, &s.SourceID, &s.Title, &s.Body, &s.Slug, &s.Score, &s.AcceptedAt, &s.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket suggestion: %w", err)
+	}
+
+	return &s, nil
+}
+
+func (r *faqRepository) AcceptSuggestion(ctx context.Context, suggestionID uint64, at time.Time) error {
+	query := `UPDATE ticket_suggestions SET accepted_at = ? WHERE id = ? AND accepted_at IS NULL`
+
+	if _, err := r.db.ExecContext(ctx, query, at, suggestionID); err != nil {
+		return fmt.Errorf("failed to accept ticket suggestion: %w", err)
+	}
+
+	return nil
+}
+
+type faqScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanFaq(s faqScanner) (*models.Faq, error) {
+	var faq models.Faq
+	err := s.Scan(
+		&faq.ID, &faq.Question, &faq.Answer, &faq.Department, &faq.Keywords,
+		&faq.CreatedBy, &faq.CreatedAt, &faq.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &faq, nil
+}
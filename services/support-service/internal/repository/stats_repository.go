@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"metargb/support-service/internal/models"
+)
+
+// StatsRepository aggregates figures for the scheduled admin reports
+type StatsRepository interface {
+	// GetTicketStats returns the number of tickets opened in [from, to) and how
+	// many of them breached the first response SLA
+	GetTicketStats(ctx context.Context, from, to time.Time, sla time.Duration) (opened int64, breaches int64, err error)
+	// GetSupportStats counts the tickets created in [from, to) by status and
+	// department, averages their first response and resolution times and
+	// counts how many were shown and closed by a suggestion
+	GetSupportStats(ctx context.Context, from, to time.Time) (*models.SupportStats, error)
+}
+
+type statsRepository struct {
+	db *sql.DB
+}
+
+func NewStatsRepository(db *sql.DB) StatsRepository {
+	return &statsRepository{db: db}
+}
+
+// A ticket breaches the SLA when nobody but its sender responded within sla,
+// either late or not yet while the deadline has passed
+func (r *statsRepository) GetTicketStats(ctx context.Context, from, to time.Time, sla time.Duration) (int64, int64, error) {
+	query := `
+		SELECT COUNT(*),
+		       COALESCE(SUM(CASE WHEN COALESCE(fr.first_response_at, NOW()) > t.created_at + INTERVAL ? SECOND THEN 1 ELSE 0 END), 0)
+		FROM tickets t
+		LEFT JOIN (
+			SELECT r.ticket_id, MIN(r.created_at) AS first_response_at
+			FROM ticket_responses r
+			INNER JOIN tickets rt ON rt.id = r.ticket_id
+			WHERE r.responser_id <> rt.user_id
+			GROUP BY r.ticket_id
+		) fr ON fr.ticket_id = t.id
+		WHERE t.created_at >= ? AND t.created_at < ?
+	`
+
+	var opened, breaches int64
+	err := r.db.QueryRowContext(ctx, query, int64(sla.Seconds()), from, to).Scan(&opened, &breaches)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get ticket stats: %w", err)
+	}
+	return opened, breaches, nil
+}
+
+// Both queries range over tickets_created_at_status_department_index; the first
+// response of each ticket is found through ticket_responses_ticket_id_created_at_index
+func (r *statsRepository) GetSupportStats(ctx context.Context, from, to time.Time) (*models.SupportStats, error) {
+	stats := &models.SupportStats{
+		From:         from,
+		To:           to,
+		ByStatus:     make(map[int32]int64),
+		ByDepartment: make(map[string]int64),
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT status, COALESCE(department, ''), COUNT(*)
+		FROM tickets
+		WHERE created_at >= ? AND created_at < ?
+		GROUP BY status, department
+	`, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count tickets: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var status int32
+		var department string
+		var count int64
+		if err := rows.Scan(&status, &department, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan ticket count: %w", err)
+		}
+		stats.Total += count
+		stats.ByStatus[status] += count
+		stats.ByDepartment[department] += count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate ticket counts: %w", err)
+	}
+
+	query := `
+		SELECT COUNT(first_response_at),
+		       COALESCE(AVG(TIMESTAMPDIFF(SECOND, created_at, first_response_at)), 0),
+		       COUNT(resolved_at),
+		       COALESCE(AVG(TIMESTAMPDIFF(SECOND, created_at, resolved_at)), 0)
+		FROM (
+			SELECT t.created_at, t.resolved_at,
+			       (SELECT MIN(r.created_at) FROM ticket_responses r
+			        WHERE r.ticket_id = t.id AND r.responser_id <> t.user_id) AS first_response_at
+			FROM tickets t
+			WHERE t.created_at >= ? AND t.created_at < ?
+		) times
+	`
+
+	var avgFirstResponse, avgResolution float64
+	if err := r.db.QueryRowContext(ctx, query, from, to).Scan(
+		&stats.Responded, &avgFirstResponse, &stats.Resolved, &avgResolution,
+	); err != nil {
+		return nil, fmt.Errorf("failed to get ticket response times: %w", err)
+	}
+	stats.AvgFirstResponse = time.Duration(avgFirstResponse * float64(time.Second))
+	stats.AvgResolution = time.Duration(avgResolution * float64(time.Second))
+
+	deflectionQuery := `
+		SELECT COUNT(DISTINCT s.ticket_id),
+		       COUNT(DISTINCT CASE WHEN s.accepted_at IS NOT NULL THEN s.ticket_id END)
+		FROM ticket_suggestions s
+		JOIN tickets t ON t.id = s.ticket_id
+		WHERE t.created_at >= ? AND t.created_at < ?
+	`
+	if err := r.db.QueryRowContext(ctx, deflectionQuery, from, to).Scan(&stats.Suggested, &stats.Deflected); err != nil {
+		return nil, fmt.Errorf("failed to count ticket suggestions: %w", err)
+	}
+
+	return stats, nil
+}
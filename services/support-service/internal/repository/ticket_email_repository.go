@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"metargb/support-service/internal/models"
+)
+
+type TicketEmailRepository interface {
+	Create(ctx context.Context, email *models.TicketEmail) (*models.TicketEmail, error)
+	GetByMessageID(ctx context.Context, messageID string) (*models.TicketEmail, error)
+	FindTicketIDByMessageIDs(ctx context.Context, messageIDs []string) (uint64, error)
+	FindTicketIDByCode(ctx context.Context, code int32, userID uint64) (uint64, error)
+	GetThread(ctx context.Context, ticketID uint64) ([]*models.TicketEmail, error)
+	ListPendingReplies(ctx context.Context, limit int) ([]*models.PendingEmailReply, error)
+	FindSenderByEmail(ctx context.Context, email string) (*models.EmailSender, error)
+}
+
+type ticketEmailRepository struct {
+	db *sql.DB
+}
+
+func NewTicketEmailRepository(db *sql.DB) TicketEmailRepository {
+	return &ticketEmailRepository{db: db}
+}
+
+func (r *ticketEmailRepository) Create(ctx context.Context, email *models.TicketEmail) (*models.TicketEmail, error) {
+	query := `
+		INSERT INTO ticket_emails (ticket_id, response_id, direction, message_id, from_address, to_address, subject, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		email.TicketID, email.ResponseID, email.Direction, email.MessageID,
+		email.FromAddress, email.ToAddress, email.Subject,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ticket email: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	email.ID = uint64(id)
+	return email, nil
+}
+
+func (r *ticketEmailRepository) GetByMessageID(ctx context.Context, messageID string) (*models.TicketEmail, error) {
+	query := `
+		SELECT id, ticket_id, response_id, direction, message_id, from_address, to_address, subject, created_at, updated_at
+		FROM ticket_emails
+		WHERE message_id = ?
+	`
+
+	email, err := scanTicketEmail(r.db.QueryRowContext(ctx, query, messageID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket email: %w", err)
+	}
+
+	return email, nil
+}
+
+// FindTicketIDByMessageIDs returns the ticket of the first message ID that
+// belongs to a known email, or 0 when none does
+func (r *ticketEmailRepository) FindTicketIDByMessageIDs(ctx context.Context, messageIDs []string) (uint64, error) {
+	for _, messageID := range messageIDs {
+		var ticketID uint64
+		err := r.db.QueryRowContext(ctx, `SELECT ticket_id FROM ticket_emails WHERE message_id = ?`, messageID).Scan(&ticketID)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to find ticket by message id: %w", err)
+		}
+		return ticketID, nil
+	}
+
+	return 0, nil
+}
+
+// FindTicketIDByCode returns the ticket with the code sent by userID, or 0
+func (r *ticketEmailRepository) FindTicketIDByCode(ctx context.Context, code int32, userID uint64) (uint64, error) {
+	var ticketID uint64
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id FROM tickets WHERE code = ? AND user_id = ? ORDER BY id DESC LIMIT 1
+	`, code, userID).Scan(&ticketID)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to find ticket by code: %w", err)
+	}
+
+	return ticketID, nil
+}
+
+// GetThread returns the emails of a ticket in the order they were received or sent
+func (r *ticketEmailRepository) GetThread(ctx context.Context, ticketID uint64) ([]*models.TicketEmail, error) {
+	query := `
+		SELECT id, ticket_id, response_id, direction, message_id, from_address, to_address, subject, created_at, updated_at
+		FROM ticket_emails
+		WHERE ticket_id = ?
+		ORDER BY id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket emails: %w", err)
+	}
+	defer rows.Close()
+
+	var emails []*models.TicketEmail
+	for rows.Next() {
+		email, err := scanTicketEmail(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ticket email: %w", err)
+		}
+		emails = append(emails, email)
+	}
+
+	return emails, rows.Err()
+}
+
+// ListPendingReplies returns responses by anyone but the ticket sender on
+// tickets opened or answered by email that have not been emailed yet. Responses
+// written before the first inbound email are not sent.
+func (r *ticketEmailRepository) ListPendingReplies(ctx context.Context, limit int) ([]*models.PendingEmailReply, error) {
+	query := `
+		SELECT r.id, r.ticket_id, t.title, t.code, r.response, r.responser_name
+		FROM ticket_responses r
+		INNER JOIN tickets t ON t.id = r.ticket_id
+		WHERE r.responser_id <> t.user_id
+			AND EXISTS (
+				SELECT 1 FROM ticket_emails e
+				WHERE e.ticket_id = r.ticket_id AND e.direction = ? AND e.created_at <= r.created_at
+			)
+			AND NOT EXISTS (
+				SELECT 1 FROM ticket_emails e WHERE e.response_id = r.id
+			)
+		ORDER BY r.id
+		LIMIT ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, models.TicketEmailInbound, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending email replies: %w", err)
+	}
+	defer rows.Close()
+
+	var replies []*models.PendingEmailReply
+	for rows.Next() {
+		reply := &models.PendingEmailReply{}
+		if err := rows.Scan(&reply.ResponseID, &reply.TicketID, &reply.TicketTitle, &reply.TicketCode, &reply.Response, &reply.ResponserName); err != nil {
+			return nil, fmt.Errorf("failed to scan pending email reply: %w", err)
+		}
+		replies = append(replies, reply)
+	}
+
+	return replies, rows.Err()
+}
+
+// FindSenderByEmail returns the user registered with the email address, or nil
+func (r *ticketEmailRepository) FindSenderByEmail(ctx context.Context, email string) (*models.EmailSender, error) {
+	sender := &models.EmailSender{}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, name FROM users WHERE email = ?
+	`, email).Scan(&sender.UserID, &sender.Name)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user by email: %w", err)
+	}
+
+	return sender, nil
+}
+
+type ticketEmailScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanTicketEmail(s ticketEmailScanner) (*models.TicketEmail, error) {
+	email := &models.TicketEmail{}
+	var responseID sql.NullInt64
+	err := s.Scan(
+		&email.ID, &email.TicketID, &responseID, &email.Direction, &email.MessageID,
+		&email.FromAddress, &email.ToAddress, &email.Subject, &email.CreatedAt, &email.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if responseID.Valid {
+		id := uint64(responseID.Int64)
+		email.ResponseID = &id
+	}
+	return email, nil
+}
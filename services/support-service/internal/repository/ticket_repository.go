@@ -229,10 +229,15 @@ func (r *ticketRepository) GetByUserID(ctx context.Context, userID uint64, page,
 	return tickets, total, nil
 }
 
+// resolvedAtSQL keeps the time a ticket was first resolved (2) or closed (5)
+// and clears it when the ticket is reopened. It takes the new status as its
+// parameter.
+const resolvedAtSQL = `CASE WHEN ? IN (2, 5) THEN COALESCE(resolved_at, NOW()) ELSE NULL END`
+
 func (r *ticketRepository) Update(ctx context.Context, ticket *models.Ticket) error {
 	query := `
 		UPDATE tickets 
-		SET title = ?, content = ?, attachment = ?, status = ?, updated_at = NOW()
+		SET title = ?, content = ?, attachment = ?, status = ?, resolved_at = ` + resolvedAtSQL + `, updated_at = NOW()
 		WHERE id = ?
 	`
 
@@ -241,6 +246,7 @@ func (r *ticketRepository) Update(ctx context.Context, ticket *models.Ticket) er
 		ticket.Content,
 		ticket.Attachment,
 		ticket.Status,
+		ticket.Status,
 		ticket.ID,
 	)
 
@@ -252,9 +258,9 @@ func (r *ticketRepository) Update(ctx context.Context, ticket *models.Ticket) er
 }
 
 func (r *ticketRepository) UpdateStatus(ctx context.Context, ticketID uint64, status int32) error {
-	query := `UPDATE tickets SET status = ?, updated_at = NOW() WHERE id = ?`
+	query := `UPDATE tickets SET status = ?, resolved_at = ` + resolvedAtSQL + `, updated_at = NOW() WHERE id = ?`
 
-	_, err := r.db.ExecContext(ctx, query, status, ticketID)
+	_, err := r.db.ExecContext(ctx, query, status, status, ticketID)
 	if err != nil {
 		return fmt.Errorf("failed to update ticket status: %w", err)
 	}
@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"metargb/support-service/internal/models"
+	"metargb/support-service/internal/repository"
+	"metargb/support-service/internal/utils"
+)
+
+// MaxChatMessageLength is the longest chat message in characters
+const MaxChatMessageLength = 2000
+
+// Events published to a chat's websocket room
+const (
+	ChatEventMessage       = "support-chat-message"
+	ChatEventAgentAssigned = "support-chat-agent-assigned"
+	ChatEventClosed        = "support-chat-closed"
+)
+
+// chatRoomPrefix names the websocket gateway rooms of chats
+const chatRoomPrefix = "support-chat:"
+
+// Sender names shown in chat transcripts
+const (
+	chatUserName  = "کاربر"
+	chatAgentName = "پشتیبانی"
+)
+
+var (
+	ErrChatNotFound         = errors.New("chat not found")
+	ErrChatTicketNotFound   = errors.New("ticket not found")
+	ErrChatForbidden        = errors.New("unauthorized: you don't have permission to use this chat")
+	ErrChatNotSupportTicket = errors.New("live chat is only available on tickets sent to a support department")
+	ErrChatTicketClosed     = errors.New("ticket is closed")
+	ErrChatClosed           = errors.New("chat is closed")
+	ErrChatEmptyMessage     = errors.New("message is required")
+	ErrChatMessageTooLong   = fmt.Errorf("message must be at most %d characters", MaxChatMessageLength)
+)
+
+// ChatEventPublisher delivers chat events to the websocket gateway
+type ChatEventPublisher interface {
+	PublishRoomEvent(ctx context.Context, room, event string, data interface{}) error
+}
+
+type ChatService interface {
+	StartChat(ctx context.Context, ticketID, userID uint64) (*models.ChatSession, error)
+	GetChat(ctx context.Context, chatID, userID uint64, includeMessages bool) (*models.ChatSession, []*models.ChatMessage, error)
+	ListChats(ctx context.Context, userID uint64) ([]*models.ChatSession, error)
+	SendMessage(ctx context.Context, chatID, userID uint64, body string) (*models.ChatMessage, error)
+	CloseChat(ctx context.Context, chatID, userID uint64) (*models.ChatSession, error)
+	ExportTranscript(ctx context.Context, chatID, userID uint64) (filename, content string, err error)
+}
+
+type chatService struct {
+	chatRepo   repository.ChatRepository
+	ticketRepo repository.TicketRepository
+	publisher  ChatEventPublisher
+	agents     map[uint64]bool
+	now        func() time.Time
+}
+
+// NewChatService creates a chat service. Chats are open to the ticket's
+// sender and to agentIDs. publisher may be nil, messages are then only seen
+// by reloading the chat.
+func NewChatService(
+	chatRepo repository.ChatRepository,
+	ticketRepo repository.TicketRepository,
+	publisher ChatEventPublisher,
+	agentIDs []uint64,
+) ChatService {
+	agents := make(map[uint64]bool, len(agentIDs))
+	for _, id := range agentIDs {
+		agents[id] = true
+	}
+	return &chatService{
+		chatRepo:   chatRepo,
+		ticketRepo: ticketRepo,
+		publisher:  publisher,
+		agents:     agents,
+		now:        time.Now,
+	}
+}
+
+// ChatRoom names the websocket gateway room of a chat
+func ChatRoom(chatID uint64) string {
+	return fmt.Sprintf("%s%d", chatRoomPrefix, chatID)
+}
+
+// StartChat returns the open chat of a ticket, starting one if there is none
+func (s *chatService) StartChat(ctx context.Context, ticketID, userID uint64) (*models.ChatSession, error) {
+	ticket, err := s.ticketRepo.GetByID(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	if ticket == nil {
+		return nil, ErrChatTicketNotFound
+	}
+	if ticket.UserID != userID && !s.agents[userID] {
+		return nil, ErrChatForbidden
+	}
+	if ticket.Department == nil {
+		return nil, ErrChatNotSupportTicket
+	}
+	if ticket.IsClosed() {
+		return nil, ErrChatTicketClosed
+	}
+
+	existing, err := s.chatRepo.GetOpenSessionByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	session := &models.ChatSession{
+		TicketID: ticketID,
+		UserID:   ticket.UserID,
+		Status:   models.ChatStatusOpen,
+	}
+	if s.agents[userID] && userID != ticket.UserID {
+		session.AgentID = &userID
+	}
+
+	created, err := s.chatRepo.CreateSession(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.chatRepo.GetSession(ctx, created.ID)
+}
+
+func (s *chatService) GetChat(ctx context.Context, chatID, userID uint64, includeMessages bool) (*models.ChatSession, []*models.ChatMessage, error) {
+	session, err := s.getAuthorized(ctx, chatID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !includeMessages {
+		return session, nil, nil
+	}
+
+	messages, err := s.chatRepo.GetMessages(ctx, chatID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return session, messages, nil
+}
+
+// ListChats returns the user's chats, or all open chats for support agents
+func (s *chatService) ListChats(ctx context.Context, userID uint64) ([]*models.ChatSession, error) {
+	if s.agents[userID] {
+		return s.chatRepo.GetOpenSessions(ctx)
+	}
+	return s.chatRepo.GetSessionsByUserID(ctx, userID)
+}
+
+// SendMessage stores a message and delivers it to the chat's room. The first
+// agent to reply is assigned to the chat.
+func (s *chatService) SendMessage(ctx context.Context, chatID, userID uint64, body string) (*models.ChatMessage, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, ErrChatEmptyMessage
+	}
+	if utf8.RuneCountInString(body) > MaxChatMessageLength {
+		return nil, ErrChatMessageTooLong
+	}
+
+	session, err := s.getAuthorized(ctx, chatID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !session.IsOpen() {
+		return nil, ErrChatClosed
+	}
+
+	fromAgent := userID != session.UserID
+	if fromAgent && session.AgentID == nil {
+		assigned, err := s.chatRepo.AssignAgent(ctx, chatID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if assigned {
+			s.publish(ctx, chatID, ChatEventAgentAssigned, map[string]interface{}{
+				"chat_id":  chatID,
+				"agent_id": userID,
+			})
+		}
+	}
+
+	message, err := s.chatRepo.CreateMessage(ctx, &models.ChatMessage{
+		SessionID: chatID,
+		SenderID:  userID,
+		FromAgent: fromAgent,
+		Body:      body,
+		CreatedAt: s.now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, chatID, ChatEventMessage, ChatMessageEvent(message))
+	return message, nil
+}
+
+// CloseChat ends a chat. Either the user or an agent may close it.
+func (s *chatService) CloseChat(ctx context.Context, chatID, userID uint64) (*models.ChatSession, error) {
+	session, err := s.getAuthorized(ctx, chatID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !session.IsOpen() {
+		return nil, ErrChatClosed
+	}
+
+	if err := s.chatRepo.CloseSession(ctx, chatID, userID); err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, chatID, ChatEventClosed, map[string]interface{}{
+		"chat_id":   chatID,
+		"closed_by": userID,
+	})
+	return s.chatRepo.GetSession(ctx, chatID)
+}
+
+// ExportTranscript renders the chat as plain text, one line per message
+func (s *chatService) ExportTranscript(ctx context.Context, chatID, userID uint64) (string, string, error) {
+	session, messages, err := s.GetChat(ctx, chatID, userID, true)
+	if err != nil {
+		return "", "", err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "گفتگوی پشتیبانی #%d - تیکت #%d\n", session.ID, session.TicketID)
+	fmt.Fprintf(&b, "شروع: %s\n", utils.FormatJalaliDateTime(session.CreatedAt))
+	if session.ClosedAt != nil {
+		fmt.Fprintf(&b, "پایان: %s\n", utils.FormatJalaliDateTime(*session.ClosedAt))
+	}
+	b.WriteString("\n")
+	for _, message := range messages {
+		sender := chatUserName
+		if message.FromAgent {
+			sender = chatAgentName
+		}
+		fmt.Fprintf(&b, "[%s] %s: %s\n", utils.FormatJalaliDateTime(message.CreatedAt), sender, message.Body)
+	}
+
+	return fmt.Sprintf("support-chat-%d.txt", session.ID), b.String(), nil
+}
+
+// ChatMessageEvent is the data of a ChatEventMessage
+func ChatMessageEvent(message *models.ChatMessage) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         message.ID,
+		"chat_id":    message.SessionID,
+		"sender_id":  message.SenderID,
+		"from_agent": message.FromAgent,
+		"body":       message.Body,
+		"date":       utils.FormatJalaliDate(message.CreatedAt),
+		"time":       utils.FormatJalaliTime(message.CreatedAt),
+		"created_at": message.CreatedAt.Unix(),
+	}
+}
+
+func (s *chatService) getAuthorized(ctx context.Context, chatID, userID uint64) (*models.ChatSession, error) {
+	session, err := s.chatRepo.GetSession(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, ErrChatNotFound
+	}
+	if session.UserID != userID && !s.agents[userID] {
+		return nil, ErrChatForbidden
+	}
+	return session, nil
+}
+
+// publish delivers an event to the chat's room. The change is already saved,
+// clients that miss the event see it when they reload the chat.
+func (s *chatService) publish(ctx context.Context, chatID uint64, event string, data interface{}) {
+	if s.publisher == nil {
+		return
+	}
+	if err := s.publisher.PublishRoomEvent(ctx, ChatRoom(chatID), event, data); err != nil {
+		log.Printf("Failed to publish %s for chat %d: %v", event, chatID, err)
+	}
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"metargb/support-service/internal/models"
+	"metargb/support-service/internal/repository"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pbFeatures "metargb/shared/pb/features"
+)
+
+// DefaultDisputeWindow is how long after a trade a dispute can be opened
+const DefaultDisputeWindow = 7 * 24 * time.Hour
+
+// disputeResponderName is shown as the responder of dispute ticket resolutions
+const disputeResponderName = "پشتیبانی"
+
+var (
+	ErrDisputeNotFound          = errors.New("dispute not found")
+	ErrDisputeTradeNotFound     = errors.New("trade not found")
+	ErrDisputeNotParticipant    = errors.New("unauthorized: only the buyer or seller of the trade can dispute it")
+	ErrDisputeForbidden         = errors.New("unauthorized: you don't have permission to view this dispute")
+	ErrDisputeNotAgent          = errors.New("unauthorized: only support agents can resolve disputes")
+	ErrDisputeWindowClosed      = errors.New("the dispute window for this trade has closed")
+	ErrDisputeAlreadyOpen       = errors.New("this trade already has an open dispute")
+	ErrDisputeAlreadyResolved   = errors.New("dispute is already resolved")
+	ErrDisputeInvalidOutcome    = errors.New("outcome must be refund or uphold")
+	ErrDisputeTradesUnavailable = errors.New("trades are unavailable")
+)
+
+type DisputeService interface {
+	OpenDispute(ctx context.Context, userID, tradeID uint64, reason, description string) (*models.Dispute, error)
+	ListDisputes(ctx context.Context, userID uint64) ([]*models.Dispute, error)
+	GetDispute(ctx context.Context, disputeID, userID uint64) (*models.Dispute, error)
+	ResolveDispute(ctx context.Context, disputeID, agentID uint64, outcome, note string) (*models.Dispute, error)
+}
+
+type disputeService struct {
+	disputeRepo   repository.DisputeRepository
+	ticketService TicketService
+	ticketRepo    repository.TicketRepository
+	tradeClient   pbFeatures.TradeServiceClient
+	window        time.Duration
+	agents        map[uint64]bool
+	now           func() time.Time
+}
+
+// NewDisputeService creates a dispute service. Disputes can be opened within
+// window of the trade (DefaultDisputeWindow if zero) and resolved by agentIDs.
+// tradeClient may be nil while features-service is unreachable.
+func NewDisputeService(
+	disputeRepo repository.DisputeRepository,
+	ticketService TicketService,
+	ticketRepo repository.TicketRepository,
+	tradeClient pbFeatures.TradeServiceClient,
+	window time.Duration,
+	agentIDs []uint64,
+) DisputeService {
+	if window <= 0 {
+		window = DefaultDisputeWindow
+	}
+	agents := make(map[uint64]bool, len(agentIDs))
+	for _, id := range agentIDs {
+		agents[id] = true
+	}
+	return &disputeService{
+		disputeRepo:   disputeRepo,
+		ticketService: ticketService,
+		ticketRepo:    ticketRepo,
+		tradeClient:   tradeClient,
+		window:        window,
+		agents:        agents,
+		now:           time.Now,
+	}
+}
+
+func (s *disputeService) OpenDispute(ctx context.Context, userID, tradeID uint64, reason, description string) (*models.Dispute, error) {
+	if s.tradeClient == nil {
+		return nil, ErrDisputeTradesUnavailable
+	}
+
+	resp, err := s.tradeClient.GetTrade(ctx, &pbFeatures.GetTradeRequest{TradeId: tradeID})
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrDisputeTradeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trade: %w", err)
+	}
+	trade := resp.Data
+
+	if trade.BuyerId != userID && trade.SellerId != userID {
+		return nil, ErrDisputeNotParticipant
+	}
+	if s.now().Sub(time.Unix(trade.TradedAt, 0)) > s.window {
+		return nil, ErrDisputeWindowClosed
+	}
+
+	existing, err := s.disputeRepo.GetOpenByTradeID(ctx, tradeID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrDisputeAlreadyOpen
+	}
+
+	department := models.DeptTradeDisputes
+	title := fmt.Sprintf("اختلاف در معامله ملک %s", trade.PropertiesId)
+	content := reason
+	if description != "" {
+		content = reason + "\n\n" + description
+	}
+	ticket, err := s.ticketService.CreateTicket(ctx, userID, title, content, "", nil, &department)
+	if err != nil {
+		return nil, err
+	}
+
+	// A dispute is still opened when the seller already spent the proceeds,
+	// a refund then fails unless they top up their wallet
+	fundsFrozen := false
+	if trade.PscAmount > 0 || trade.IrrAmount > 0 {
+		if _, err := s.tradeClient.FreezeTradeFunds(ctx, &pbFeatures.TradeFundsRequest{TradeId: tradeID}); err != nil {
+			log.Printf("Failed to freeze funds of disputed trade %d: %v", tradeID, err)
+		} else {
+			fundsFrozen = true
+		}
+	}
+
+	dispute := &models.Dispute{
+		TradeID:      trade.Id,
+		FeatureID:    trade.FeatureId,
+		PropertiesID: trade.PropertiesId,
+		BuyerID:      trade.BuyerId,
+		SellerID:     trade.SellerId,
+		OpenedBy:     userID,
+		TicketID:     ticket.ID,
+		Reason:       reason,
+		Description:  description,
+		FundsFrozen:  fundsFrozen,
+		Status:       models.DisputeStatusOpen,
+	}
+
+	created, err := s.disputeRepo.Create(ctx, dispute)
+	if err != nil {
+		if fundsFrozen {
+			s.tradeClient.ReleaseTradeFunds(ctx, &pbFeatures.TradeFundsRequest{TradeId: tradeID})
+		}
+		return nil, err
+	}
+
+	return s.disputeRepo.GetByID(ctx, created.ID)
+}
+
+// ListDisputes returns the user's disputes, or all open disputes for support agents
+func (s *disputeService) ListDisputes(ctx context.Context, userID uint64) ([]*models.Dispute, error) {
+	if s.agents[userID] {
+		return s.disputeRepo.GetOpen(ctx)
+	}
+	return s.disputeRepo.GetByUserID(ctx, userID)
+}
+
+func (s *disputeService) GetDispute(ctx context.Context, disputeID, userID uint64) (*models.Dispute, error) {
+	dispute, err := s.disputeRepo.GetByID(ctx, disputeID)
+	if err != nil {
+		return nil, err
+	}
+	if dispute == nil {
+		return nil, ErrDisputeNotFound
+	}
+	if !dispute.IsParticipant(userID) && !s.agents[userID] {
+		return nil, ErrDisputeForbidden
+	}
+
+	return dispute, nil
+}
+
+// ResolveDispute executes the agent's outcome on the trade and records it on
+// the dispute and its ticket
+func (s *disputeService) ResolveDispute(ctx context.Context, disputeID, agentID uint64, outcome, note string) (*models.Dispute, error) {
+	if !s.agents[agentID] {
+		return nil, ErrDisputeNotAgent
+	}
+	if outcome != models.DisputeOutcomeRefund && outcome != models.DisputeOutcomeUphold {
+		return nil, ErrDisputeInvalidOutcome
+	}
+
+	dispute, err := s.disputeRepo.GetByID(ctx, disputeID)
+	if err != nil {
+		return nil, err
+	}
+	if dispute == nil {
+		return nil, ErrDisputeNotFound
+	}
+	if dispute.Status != models.DisputeStatusOpen {
+		return nil, ErrDisputeAlreadyResolved
+	}
+	if s.tradeClient == nil {
+		return nil, ErrDisputeTradesUnavailable
+	}
+
+	switch outcome {
+	case models.DisputeOutcomeRefund:
+		if _, err := s.tradeClient.RefundTrade(ctx, &pbFeatures.RefundTradeRequest{
+			TradeId:     dispute.TradeID,
+			FundsFrozen: dispute.FundsFrozen,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to refund trade: %w", err)
+		}
+	case models.DisputeOutcomeUphold:
+		if dispute.FundsFrozen {
+			if _, err := s.tradeClient.ReleaseTradeFunds(ctx, &pbFeatures.TradeFundsRequest{TradeId: dispute.TradeID}); err != nil {
+				return nil, fmt.Errorf("failed to release trade funds: %w", err)
+			}
+		}
+	}
+
+	if err := s.disputeRepo.Resolve(ctx, disputeID, agentID, outcome, note); err != nil {
+		return nil, err
+	}
+
+	// The outcome is already executed, a failed ticket update must not fail the resolution
+	response := &models.TicketResponse{
+		TicketID:      dispute.TicketID,
+		Response:      disputeResolutionMessage(outcome, note),
+		ResponserName: disputeResponderName,
+		ResponserID:   agentID,
+	}
+	if _, err := s.ticketRepo.CreateResponse(ctx, response); err != nil {
+		log.Printf("Failed to respond to dispute ticket %d: %v", dispute.TicketID, err)
+	} else if err := s.ticketRepo.UpdateStatus(ctx, dispute.TicketID, models.TicketStatusResolved); err != nil {
+		log.Printf("Failed to resolve dispute ticket %d: %v", dispute.TicketID, err)
+	}
+
+	return s.disputeRepo.GetByID(ctx, disputeID)
+}
+
+func disputeResolutionMessage(outcome, note string) string {
+	message := "اعتراض بررسی شد و معامله تایید شد."
+	if outcome == models.DisputeOutcomeRefund {
+		message = "اعتراض پذیرفته شد. مبلغ معامله به خریدار بازگردانده شد و ملک به فروشنده بازگشت."
+	}
+	if note != "" {
+		message += "\n\n" + note
+	}
+	return message
+}
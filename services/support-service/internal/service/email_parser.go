@@ -0,0 +1,186 @@
+package service
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"metargb/support-service/internal/models"
+)
+
+var (
+	messageIDPattern = regexp.MustCompile(`<[^<>\s]+>`)
+	htmlTagPattern   = regexp.MustCompile(`(?s)<[^>]*>`)
+	// Reply headers added by mail clients above the quoted message, e.g.
+	// "On Mon, 1 Jan 2024 at 10:00, Support <support@metargb.com> wrote:"
+	quoteHeaderPattern = regexp.MustCompile(`(?i)^(on\s.+wrote:|.+نوشت:|-+\s*original message\s*-+)$`)
+)
+
+// ParseInboundEmail parses a raw RFC 5322 message into the fields needed to
+// create or answer a ticket. The body is the text/plain part (or the text of
+// the text/html part) without the quoted previous messages.
+func ParseInboundEmail(raw []byte) (*models.InboundEmail, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEmail, err)
+	}
+
+	from, err := mail.ParseAddress(msg.Header.Get("From"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid From header: %v", ErrInvalidEmail, err)
+	}
+
+	email := &models.InboundEmail{
+		MessageID:     firstMessageID(msg.Header.Get("Message-ID")),
+		InReplyTo:     firstMessageID(msg.Header.Get("In-Reply-To")),
+		References:    messageIDPattern.FindAllString(msg.Header.Get("References"), -1),
+		FromAddress:   strings.ToLower(from.Address),
+		FromName:      from.Name,
+		Subject:       decodeHeader(msg.Header.Get("Subject")),
+		AutoSubmitted: isAutoSubmitted(msg.Header),
+	}
+	if to, err := mail.ParseAddress(msg.Header.Get("To")); err == nil {
+		email.ToAddress = strings.ToLower(to.Address)
+	}
+	// Webhook retries deliver the same message again; without a Message-ID the
+	// content hash still identifies it
+	if email.MessageID == "" {
+		sum := sha256.Sum256(raw)
+		email.MessageID = "<" + hex.EncodeToString(sum[:16]) + "@inbound.metargb>"
+	}
+
+	body, err := readTextBody(msg.Header.Get("Content-Type"), msg.Header.Get("Content-Transfer-Encoding"), msg.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEmail, err)
+	}
+	email.Body = stripQuotedReply(body)
+
+	return email, nil
+}
+
+func firstMessageID(value string) string {
+	return messageIDPattern.FindString(value)
+}
+
+func decodeHeader(value string) string {
+	decoded, err := new(mime.WordDecoder).DecodeHeader(value)
+	if err != nil {
+		return strings.TrimSpace(value)
+	}
+	return strings.TrimSpace(decoded)
+}
+
+// isAutoSubmitted reports auto-replies and bulk mail (RFC 3834), which must not
+// open tickets or answering them would loop
+func isAutoSubmitted(header mail.Header) bool {
+	if v := strings.ToLower(header.Get("Auto-Submitted")); v != "" && v != "no" {
+		return true
+	}
+	switch strings.ToLower(header.Get("Precedence")) {
+	case "bulk", "junk", "list", "auto_reply":
+		return true
+	}
+	return header.Get("X-Autoreply") != "" || header.Get("X-Autorespond") != ""
+}
+
+// readTextBody returns the text of a message part, preferring text/plain over
+// text/html in multipart messages
+func readTextBody(contentType, transferEncoding string, body io.Reader) (string, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = "text/plain"
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		reader := multipart.NewReader(body, params["boundary"])
+		var plain, htmlText string
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return "", err
+			}
+			if strings.HasPrefix(part.Header.Get("Content-Disposition"), "attachment") {
+				continue
+			}
+			partType := part.Header.Get("Content-Type")
+			if partType == "" {
+				partType = "text/plain"
+			}
+			text, err := readTextBody(partType, part.Header.Get("Content-Transfer-Encoding"), part)
+			if err != nil {
+				return "", err
+			}
+			partMedia, _, _ := mime.ParseMediaType(partType)
+			switch {
+			case partMedia == "text/html" && htmlText == "":
+				htmlText = text
+			case text != "" && plain == "":
+				plain = text
+			}
+		}
+		if plain != "" {
+			return plain, nil
+		}
+		return htmlText, nil
+	}
+
+	if mediaType != "text/plain" && mediaType != "text/html" {
+		return "", nil
+	}
+
+	switch strings.ToLower(strings.TrimSpace(transferEncoding)) {
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	}
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return "", err
+	}
+
+	text := string(content)
+	if mediaType == "text/html" {
+		text = htmlToText(text)
+	}
+	return text, nil
+}
+
+func htmlToText(body string) string {
+	body = strings.NewReplacer("<br>", "\n", "<br/>", "\n", "<br />", "\n", "</p>", "\n", "</div>", "\n").Replace(body)
+	return html.UnescapeString(htmlTagPattern.ReplaceAllString(body, ""))
+}
+
+// stripQuotedReply drops the previous messages mail clients quote below a
+// reply. The full text is kept if nothing would remain.
+func stripQuotedReply(body string) string {
+	body = strings.ReplaceAll(body, "\r\n", "\n")
+	lines := strings.Split(body, "\n")
+
+	var kept []string
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, ">") || quoteHeaderPattern.MatchString(trimmed) {
+			break
+		}
+		kept = append(kept, line)
+	}
+
+	if reply := strings.TrimSpace(strings.Join(kept, "\n")); reply != "" {
+		return reply
+	}
+	return strings.TrimSpace(body)
+}
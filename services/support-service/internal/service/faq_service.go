@@ -0,0 +1,368 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	pbCommon "metargb/shared/pb/common"
+	pbTraining "metargb/shared/pb/training"
+	"metargb/shared/pkg/jobs"
+	"metargb/support-service/internal/models"
+	"metargb/support-service/internal/repository"
+)
+
+const (
+	// DefaultSuggestionRefreshInterval is how often FAQs and training videos
+	// are reloaded into the suggestion index
+	DefaultSuggestionRefreshInterval = 15 * time.Minute
+
+	// MaxTicketSuggestions is the most suggestions returned for a ticket
+	MaxTicketSuggestions = 3
+
+	// MinSuggestionScore is the lowest similarity worth suggesting
+	MinSuggestionScore = 0.1
+
+	// MaxFaqQuestionLength is the longest FAQ question in characters
+	MaxFaqQuestionLength = 191
+
+	// suggestionBodyLength caps the video description sent with a suggestion
+	suggestionBodyLength = 300
+
+	// trainingVideoPageSize and trainingVideoMaxPages bound how many videos
+	// are loaded from training-service per refresh
+	trainingVideoPageSize = 100
+	trainingVideoMaxPages = 20
+)
+
+var (
+	ErrFaqNotFound              = errors.New("faq not found")
+	ErrFaqNotAgent              = errors.New("unauthorized: only support agents can manage faqs")
+	ErrFaqQuestionRequired      = errors.New("question is required")
+	ErrFaqQuestionTooLong       = fmt.Errorf("question must be at most %d characters", MaxFaqQuestionLength)
+	ErrFaqAnswerRequired        = errors.New("answer is required")
+	ErrFaqInvalidDepartment     = errors.New("invalid department")
+	ErrSuggestionNotFound       = errors.New("suggestion not found")
+	ErrSuggestionForbidden      = errors.New("unauthorized: only the ticket sender can accept its suggestions")
+	ErrSuggestionTicketClosed   = errors.New("ticket is already closed")
+	ErrSuggestionTicketNotFound = errors.New("ticket not found")
+)
+
+type FaqService interface {
+	ListFaqs(ctx context.Context, department string) ([]*models.Faq, error)
+	CreateFaq(ctx context.Context, userID uint64, question, answer, department, keywords string) (*models.Faq, error)
+	UpdateFaq(ctx context.Context, faqID, userID uint64, question, answer, department, keywords string) (*models.Faq, error)
+	DeleteFaq(ctx context.Context, faqID, userID uint64) error
+
+	// Suggest matches a new ticket against the FAQs and training videos and
+	// records the best matches
+	Suggest(ctx context.Context, ticket *models.TicketWithRelations) ([]*models.TicketSuggestion, error)
+	// AcceptSuggestion records that a suggestion answered the ticket and
+	// closes it
+	AcceptSuggestion(ctx context.Context, ticketID, suggestionID, userID uint64) (*models.TicketSuggestion, error)
+
+	// Refresh reloads the FAQs and training videos into the index
+	Refresh(ctx context.Context) error
+	// Register refreshes the index at start and then every interval under runner
+	Register(runner *jobs.Runner, interval time.Duration)
+}
+
+type faqService struct {
+	faqRepo     repository.FaqRepository
+	ticketRepo  repository.TicketRepository
+	videoClient pbTraining.VideoServiceClient
+	agents      map[uint64]bool
+	now         func() time.Time
+
+	mu     sync.RWMutex
+	index  *suggestionIndex
+	videos []suggestionDocument
+}
+
+// NewFaqService creates the FAQ and suggestion service. FAQs are managed by
+// agentIDs. videoClient may be nil, only FAQs are suggested then.
+func NewFaqService(
+	faqRepo repository.FaqRepository,
+	ticketRepo repository.TicketRepository,
+	videoClient pbTraining.VideoServiceClient,
+	agentIDs []uint64,
+) FaqService {
+	agents := make(map[uint64]bool, len(agentIDs))
+	for _, id := range agentIDs {
+		agents[id] = true
+	}
+	return &faqService{
+		faqRepo:     faqRepo,
+		ticketRepo:  ticketRepo,
+		videoClient: videoClient,
+		agents:      agents,
+		now:         time.Now,
+		index:       newSuggestionIndex(nil),
+	}
+}
+
+func (s *faqService) ListFaqs(ctx context.Context, department string) ([]*models.Faq, error) {
+	return s.faqRepo.List(ctx, department)
+}
+
+func (s *faqService) CreateFaq(ctx context.Context, userID uint64, question, answer, department, keywords string) (*models.Faq, error) {
+	if !s.agents[userID] {
+		return nil, ErrFaqNotAgent
+	}
+	faq, err := buildFaq(question, answer, department, keywords)
+	if err != nil {
+		return nil, err
+	}
+	faq.CreatedBy = userID
+
+	created, err := s.faqRepo.Create(ctx, faq)
+	if err != nil {
+		return nil, err
+	}
+
+	s.rebuildFaqs(ctx)
+	return s.faqRepo.GetByID(ctx, created.ID)
+}
+
+func (s *faqService) UpdateFaq(ctx context.Context, faqID, userID uint64, question, answer, department, keywords string) (*models.Faq, error) {
+	if !s.agents[userID] {
+		return nil, ErrFaqNotAgent
+	}
+	existing, err := s.faqRepo.GetByID(ctx, faqID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, ErrFaqNotFound
+	}
+
+	faq, err := buildFaq(question, answer, department, keywords)
+	if err != nil {
+		return nil, err
+	}
+	faq.ID = faqID
+	if err := s.faqRepo.Update(ctx, faq); err != nil {
+		return nil, err
+	}
+
+	s.rebuildFaqs(ctx)
+	return s.faqRepo.GetByID(ctx, faqID)
+}
+
+func (s *faqService) DeleteFaq(ctx context.Context, faqID, userID uint64) error {
+	if !s.agents[userID] {
+		return ErrFaqNotAgent
+	}
+	existing, err := s.faqRepo.GetByID(ctx, faqID)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return ErrFaqNotFound
+	}
+
+	if err := s.faqRepo.Delete(ctx, faqID); err != nil {
+		return err
+	}
+
+	s.rebuildFaqs(ctx)
+	return nil
+}
+
+// Suggest only runs for tickets sent to a department, tickets between users
+// are not support questions
+func (s *faqService) Suggest(ctx context.Context, ticket *models.TicketWithRelations) ([]*models.TicketSuggestion, error) {
+	if ticket.Department == nil {
+		return nil, nil
+	}
+
+	s.mu.RLock()
+	matches := s.index.search(ticket.Title+" "+ticket.Content, *ticket.Department, MaxTicketSuggestions, MinSuggestionScore)
+	s.mu.RUnlock()
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	now := s.now()
+	suggestions := make([]*models.TicketSuggestion, len(matches))
+	for i, match := range matches {
+		suggestions[i] = &models.TicketSuggestion{
+			TicketID:  ticket.ID,
+			Source:    match.Document.Source,
+			SourceID:  match.Document.SourceID,
+			Title:     match.Document.Title,
+			Body:      match.Document.Body,
+			Slug:      match.Document.Slug,
+			Score:     match.Score,
+			CreatedAt: now,
+		}
+	}
+
+	if err := s.faqRepo.CreateSuggestions(ctx, suggestions); err != nil {
+		return nil, err
+	}
+	return suggestions, nil
+}
+
+func (s *faqService) AcceptSuggestion(ctx context.Context, ticketID, suggestionID, userID uint64) (*models.TicketSuggestion, error) {
+	suggestion, err := s.faqRepo.GetSuggestion(ctx, suggestionID)
+	if err != nil {
+		return nil, err
+	}
+	if suggestion == nil || suggestion.TicketID != ticketID {
+		return nil, ErrSuggestionNotFound
+	}
+
+	ticket, err := s.ticketRepo.GetByID(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	if ticket == nil {
+		return nil, ErrSuggestionTicketNotFound
+	}
+	if ticket.UserID != userID {
+		return nil, ErrSuggestionForbidden
+	}
+	if suggestion.AcceptedAt != nil {
+		return suggestion, nil
+	}
+	if ticket.IsClosed() {
+		return nil, ErrSuggestionTicketClosed
+	}
+
+	if err := s.faqRepo.AcceptSuggestion(ctx, suggestionID, s.now()); err != nil {
+		return nil, err
+	}
+	if err := s.ticketRepo.UpdateStatus(ctx, ticketID, models.TicketStatusClosed); err != nil {
+		return nil, err
+	}
+
+	return s.faqRepo.GetSuggestion(ctx, suggestionID)
+}
+
+func (s *faqService) Refresh(ctx context.Context) error {
+	faqs, err := s.faqRepo.List(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	videos, err := s.loadVideos(ctx)
+	if err != nil {
+		// Keep suggesting the videos of the last successful load
+		log.Printf("Failed to load training videos for ticket suggestions: %v", err)
+		s.mu.RLock()
+		videos = s.videos
+		s.mu.RUnlock()
+	}
+
+	s.build(faqs, videos)
+	return nil
+}
+
+func (s *faqService) Register(runner *jobs.Runner, interval time.Duration) {
+	runner.AddImmediate("ticket-suggestions-refresh", interval, s.Refresh)
+}
+
+// rebuildFaqs reloads the FAQs after they change, keeping the loaded videos
+func (s *faqService) rebuildFaqs(ctx context.Context) {
+	faqs, err := s.faqRepo.List(ctx, "")
+	if err != nil {
+		log.Printf("Failed to reload faqs for ticket suggestions: %v", err)
+		return
+	}
+
+	s.mu.RLock()
+	videos := s.videos
+	s.mu.RUnlock()
+	s.build(faqs, videos)
+}
+
+func (s *faqService) build(faqs []*models.Faq, videos []suggestionDocument) {
+	docs := make([]suggestionDocument, 0, len(faqs)+len(videos))
+	for _, faq := range faqs {
+		doc := suggestionDocument{
+			Source:   models.SuggestionSourceFaq,
+			SourceID: faq.ID,
+			Title:    faq.Question,
+			Body:     faq.Answer,
+			// The question weighs more than the answer
+			Text: strings.Join([]string{faq.Question, faq.Question, faq.Keywords, faq.Answer}, " "),
+		}
+		if faq.Department != nil {
+			doc.Department = *faq.Department
+		}
+		docs = append(docs, doc)
+	}
+	docs = append(docs, videos...)
+
+	index := newSuggestionIndex(docs)
+	s.mu.Lock()
+	s.index = index
+	s.videos = videos
+	s.mu.Unlock()
+}
+
+func (s *faqService) loadVideos(ctx context.Context) ([]suggestionDocument, error) {
+	if s.videoClient == nil {
+		return nil, nil
+	}
+
+	var docs []suggestionDocument
+	for page := int32(1); page <= trainingVideoMaxPages; page++ {
+		resp, err := s.videoClient.GetVideos(ctx, &pbTraining.GetVideosRequest{
+			Pagination: &pbCommon.PaginationRequest{Page: page, PerPage: trainingVideoPageSize},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, video := range resp.Videos {
+			docs = append(docs, suggestionDocument{
+				Source:   models.SuggestionSourceTrainingVideo,
+				SourceID: video.Id,
+				Title:    video.Title,
+				Body:     truncateRunes(video.Description, suggestionBodyLength),
+				Slug:     video.Slug,
+				Text:     strings.Join([]string{video.Title, video.Title, video.Description}, " "),
+			})
+		}
+
+		if resp.Pagination == nil || page >= resp.Pagination.LastPage || len(resp.Videos) == 0 {
+			break
+		}
+	}
+	return docs, nil
+}
+
+func buildFaq(question, answer, department, keywords string) (*models.Faq, error) {
+	question = strings.TrimSpace(question)
+	answer = strings.TrimSpace(answer)
+	department = strings.TrimSpace(department)
+	if question == "" {
+		return nil, ErrFaqQuestionRequired
+	}
+	if utf8.RuneCountInString(question) > MaxFaqQuestionLength {
+		return nil, ErrFaqQuestionTooLong
+	}
+	if answer == "" {
+		return nil, ErrFaqAnswerRequired
+	}
+
+	faq := &models.Faq{
+		Question: question,
+		Answer:   answer,
+		Keywords: truncateRunes(strings.TrimSpace(keywords), MaxFaqQuestionLength),
+	}
+	if department != "" {
+		if models.GetDepartmentTitle(department) == "" {
+			return nil, ErrFaqInvalidDepartment
+		}
+		faq.Department = &department
+	}
+	return faq, nil
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// suggestionStopWords are too common to tell tickets apart
+var suggestionStopWords = map[string]bool{
+	"از": true, "به": true, "با": true, "در": true, "که": true, "این": true, "آن": true, "را": true,
+	"و": true, "یا": true, "تا": true, "برای": true, "است": true, "هست": true, "نیست": true, "من": true,
+	"ما": true, "شما": true, "او": true, "می": true, "نمی": true, "هم": true, "یک": true, "چه": true,
+	"اگر": true, "اما": true, "بر": true, "ها": true, "های": true, "ای": true, "شده": true, "شد": true,
+	"کرد": true, "کردم": true, "کنم": true, "کنید": true, "دارم": true, "سلام": true, "لطفا": true, "باید": true,
+	"the": true, "a": true, "an": true, "and": true, "or": true, "to": true, "of": true, "in": true,
+	"on": true, "is": true, "it": true, "for": true, "my": true, "i": true, "with": true, "can": true,
+	"do": true, "how": true, "what": true, "why": true, "not": true, "please": true, "hi": true, "hello": true,
+}
+
+// suggestionNormalizer maps Arabic letters and digits to their Persian and
+// ASCII forms. ZWNJ splits suffixes such as ها into their own token.
+var suggestionNormalizer = strings.NewReplacer(
+	"ي", "ی", "ى", "ی", "ك", "ک", "ة", "ه", "ۀ", "ه", "أ", "ا", "إ", "ا", "ٱ", "ا",
+	"‌", " ", "ـ", "",
+	"۰", "0", "۱", "1", "۲", "2", "۳", "3", "۴", "4", "۵", "5", "۶", "6", "۷", "7", "۸", "8", "۹", "9",
+	"٠", "0", "١", "1", "٢", "2", "٣", "3", "٤", "4", "٥", "5", "٦", "6", "٧", "7", "٨", "8", "٩", "9",
+)
+
+// suggestionTokens splits text into normalized terms, dropping stop words,
+// diacritics and single characters
+func suggestionTokens(text string) []string {
+	text = strings.ToLower(suggestionNormalizer.Replace(text))
+	text = strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Mn, r) {
+			return -1
+		}
+		return r
+	}, text)
+
+	var tokens []string
+	for _, field := range strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if len([]rune(field)) < 2 || suggestionStopWords[field] {
+			continue
+		}
+		tokens = append(tokens, field)
+	}
+	return tokens
+}
+
+// suggestionDocument is an FAQ or training video that can be suggested
+type suggestionDocument struct {
+	Source     string
+	SourceID   uint64
+	Title      string
+	Body       string
+	Slug       string
+	Department string // empty for every department
+	// Text is what tickets are matched against
+	Text string
+}
+
+// scoredSuggestion is a document matched to a ticket with its cosine
+// similarity
+type scoredSuggestion struct {
+	Document suggestionDocument
+	Score    float64
+}
+
+// suggestionIndex ranks documents against a ticket by the cosine similarity
+// of their TF-IDF vectors
+type suggestionIndex struct {
+	docs    []suggestionDocument
+	vectors []map[string]float64
+	idf     map[string]float64
+}
+
+func newSuggestionIndex(docs []suggestionDocument) *suggestionIndex {
+	termCounts := make([]map[string]int, len(docs))
+	docFreq := make(map[string]int)
+	for i, doc := range docs {
+		counts := make(map[string]int)
+		for _, token := range suggestionTokens(doc.Text) {
+			counts[token]++
+		}
+		for term := range counts {
+			docFreq[term]++
+		}
+		termCounts[i] = counts
+	}
+
+	// Smoothed so terms found in every document still count a little
+	idf := make(map[string]float64, len(docFreq))
+	n := float64(len(docs))
+	for term, df := range docFreq {
+		idf[term] = math.Log((1+n)/(1+float64(df))) + 1
+	}
+
+	index := &suggestionIndex{docs: docs, idf: idf, vectors: make([]map[string]float64, len(docs))}
+	for i, counts := range termCounts {
+		index.vectors[i] = index.vector(counts)
+	}
+	return index
+}
+
+// search returns up to limit documents of department or of every department
+// scoring at least minScore, best first
+func (ix *suggestionIndex) search(text, department string, limit int, minScore float64) []scoredSuggestion {
+	counts := make(map[string]int)
+	for _, token := range suggestionTokens(text) {
+		counts[token]++
+	}
+	query := ix.vector(counts)
+	if len(query) == 0 {
+		return nil
+	}
+
+	var results []scoredSuggestion
+	for i, doc := range ix.docs {
+		if doc.Department != "" && doc.Department != department {
+			continue
+		}
+		score := 0.0
+		for term, weight := range query {
+			score += weight * ix.vectors[i][term]
+		}
+		if score >= minScore {
+			results = append(results, scoredSuggestion{Document: doc, Score: score})
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// vector weighs term counts by sublinear TF times IDF and normalizes the
+// result to unit length. Terms missing from the index are dropped.
+func (ix *suggestionIndex) vector(counts map[string]int) map[string]float64 {
+	vector := make(map[string]float64, len(counts))
+	norm := 0.0
+	for term, count := range counts {
+		idf, ok := ix.idf[term]
+		if !ok {
+			continue
+		}
+		weight := (1 + math.Log(float64(count))) * idf
+		vector[term] = weight
+		norm += weight * weight
+	}
+	if norm == 0 {
+		return nil
+	}
+	norm = math.Sqrt(norm)
+	for term := range vector {
+		vector[term] /= norm
+	}
+	return vector
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"metargb/support-service/internal/models"
+	"metargb/support-service/internal/repository"
+)
+
+// DefaultSupportStatsCacheTTL is how long computed support stats are served from memory
+const DefaultSupportStatsCacheTTL = 5 * time.Minute
+
+// maxSupportStatsPeriod caps the period of one stats request
+const maxSupportStatsPeriod = 366 * 24 * time.Hour
+
+var (
+	ErrSupportStatsNotAgent      = errors.New("unauthorized: only support agents can view support stats")
+	ErrSupportStatsInvalidPeriod = errors.New("from must be before to")
+	ErrSupportStatsPeriodTooLong = errors.New("the period may not be longer than 366 days")
+)
+
+type SupportStatsService interface {
+	// GetSupportStats returns the stats of the tickets created in [from, to).
+	// The same period is recomputed at most once per cache TTL.
+	GetSupportStats(ctx context.Context, userID uint64, from, to time.Time) (*models.SupportStats, error)
+}
+
+type supportStatsEntry struct {
+	stats     *models.SupportStats
+	expiresAt time.Time
+}
+
+type supportStatsService struct {
+	statsRepo repository.StatsRepository
+	agents    map[uint64]bool
+	ttl       time.Duration
+
+	mu    sync.Mutex
+	cache map[[2]int64]supportStatsEntry
+}
+
+// NewSupportStatsService creates a stats service for agentIDs caching results
+// for ttl (DefaultSupportStatsCacheTTL if zero)
+func NewSupportStatsService(statsRepo repository.StatsRepository, agentIDs []uint64, ttl time.Duration) SupportStatsService {
+	if ttl <= 0 {
+		ttl = DefaultSupportStatsCacheTTL
+	}
+	agents := make(map[uint64]bool, len(agentIDs))
+	for _, id := range agentIDs {
+		agents[id] = true
+	}
+	return &supportStatsService{
+		statsRepo: statsRepo,
+		agents:    agents,
+		ttl:       ttl,
+		cache:     make(map[[2]int64]supportStatsEntry),
+	}
+}
+
+func (s *supportStatsService) GetSupportStats(ctx context.Context, userID uint64, from, to time.Time) (*models.SupportStats, error) {
+	if !s.agents[userID] {
+		return nil, ErrSupportStatsNotAgent
+	}
+	if !from.Before(to) {
+		return nil, ErrSupportStatsInvalidPeriod
+	}
+	if to.Sub(from) > maxSupportStatsPeriod {
+		return nil, ErrSupportStatsPeriodTooLong
+	}
+
+	key := [2]int64{from.Unix(), to.Unix()}
+	now := time.Now()
+
+	s.mu.Lock()
+	entry, ok := s.cache[key]
+	s.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.stats, nil
+	}
+
+	stats, err := s.statsRepo.GetSupportStats(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	stats.GeneratedAt = now
+
+	s.mu.Lock()
+	// Drop expired periods so ad hoc ranges do not accumulate
+	for k, e := range s.cache {
+		if !now.Before(e.expiresAt) {
+			delete(s.cache, k)
+		}
+	}
+	s.cache[key] = supportStatsEntry{stats: stats, expiresAt: now.Add(s.ttl)}
+	s.mu.Unlock()
+
+	return stats, nil
+}
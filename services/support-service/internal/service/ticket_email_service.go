@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"metargb/support-service/internal/models"
+	"metargb/support-service/internal/repository"
+)
+
+const (
+	// emailTicketSubjectFallback is the title of tickets opened by emails without a subject
+	emailTicketSubjectFallback = "ایمیل بدون موضوع"
+	// maxEmailColumnLength is the length of the tickets.title and ticket_emails.subject columns
+	maxEmailColumnLength = 191
+)
+
+// ticketCodePattern matches the "[#123456]" ticket code added to the subject of
+// outbound emails, so replies are threaded even when clients drop In-Reply-To
+var ticketCodePattern = regexp.MustCompile(`\[#(\d{6})\]`)
+
+var (
+	ErrEmailIngestionDisabled = errors.New("email ingestion is not configured")
+	ErrInvalidWebhookToken    = errors.New("invalid webhook token")
+	ErrInvalidEmail           = errors.New("invalid email message")
+)
+
+type TicketEmailService interface {
+	IngestEmail(ctx context.Context, raw []byte, webhookToken string) (*models.IngestResult, error)
+}
+
+type ticketEmailService struct {
+	emailRepo      repository.TicketEmailRepository
+	ticketService  TicketService
+	ticketRepo     repository.TicketRepository
+	webhookSecret  string
+	supportAddress string
+}
+
+// NewTicketEmailService creates the service ingesting emails sent to
+// supportAddress. Webhook calls must carry webhookSecret; ingestion is disabled
+// while it is empty.
+func NewTicketEmailService(
+	emailRepo repository.TicketEmailRepository,
+	ticketService TicketService,
+	ticketRepo repository.TicketRepository,
+	webhookSecret string,
+	supportAddress string,
+) TicketEmailService {
+	return &ticketEmailService{
+		emailRepo:      emailRepo,
+		ticketService:  ticketService,
+		ticketRepo:     ticketRepo,
+		webhookSecret:  webhookSecret,
+		supportAddress: strings.ToLower(supportAddress),
+	}
+}
+
+// IngestEmail creates a ticket from an inbound email, or adds it as a response
+// to the ticket it replies to. Replies are threaded by In-Reply-To and
+// References, then by the ticket code in the subject.
+func (s *ticketEmailService) IngestEmail(ctx context.Context, raw []byte, webhookToken string) (*models.IngestResult, error) {
+	if s.webhookSecret == "" {
+		return nil, ErrEmailIngestionDisabled
+	}
+	if subtle.ConstantTimeCompare([]byte(webhookToken), []byte(s.webhookSecret)) != 1 {
+		return nil, ErrInvalidWebhookToken
+	}
+
+	email, err := ParseInboundEmail(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.emailRepo.GetByMessageID(ctx, email.MessageID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return &models.IngestResult{Status: models.IngestStatusDuplicate, TicketID: existing.TicketID}, nil
+	}
+
+	// Auto-replies and our own outbound emails bouncing back would loop
+	if email.AutoSubmitted || (s.supportAddress != "" && email.FromAddress == s.supportAddress) {
+		log.Printf("Ignoring automatic email %s from %s", email.MessageID, email.FromAddress)
+		return &models.IngestResult{Status: models.IngestStatusIgnored}, nil
+	}
+	if strings.TrimSpace(email.Body) == "" {
+		log.Printf("Ignoring empty email %s from %s", email.MessageID, email.FromAddress)
+		return &models.IngestResult{Status: models.IngestStatusIgnored}, nil
+	}
+
+	// Tickets belong to users, so only registered addresses can open them
+	sender, err := s.emailRepo.FindSenderByEmail(ctx, email.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	if sender == nil {
+		log.Printf("Ignoring email %s from unregistered address %s", email.MessageID, email.FromAddress)
+		return &models.IngestResult{Status: models.IngestStatusIgnored}, nil
+	}
+
+	result, err := s.threadEmail(ctx, email, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.emailRepo.Create(ctx, &models.TicketEmail{
+		TicketID:    result.TicketID,
+		Direction:   models.TicketEmailInbound,
+		MessageID:   email.MessageID,
+		FromAddress: email.FromAddress,
+		ToAddress:   email.ToAddress,
+		Subject:     truncateRunes(email.Subject, maxEmailColumnLength),
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// threadEmail adds the email to the open ticket it replies to, or opens a new
+// ticket with the technical support department
+func (s *ticketEmailService) threadEmail(ctx context.Context, email *models.InboundEmail, sender *models.EmailSender) (*models.IngestResult, error) {
+	ticketID, err := s.findThreadTicket(ctx, email, sender.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ticketID != 0 {
+		ticket, err := s.ticketRepo.GetByID(ctx, ticketID)
+		if err != nil {
+			return nil, err
+		}
+		// Replies to closed tickets, or to tickets of another user, open a new ticket
+		if ticket != nil && ticket.IsOpen() && isTicketParticipant(&ticket.Ticket, sender.UserID) {
+			if _, err := s.ticketService.AddResponse(ctx, ticketID, sender.UserID, email.Body, "", sender.Name); err != nil {
+				return nil, err
+			}
+			return &models.IngestResult{Status: models.IngestStatusReplied, TicketID: ticketID}, nil
+		}
+	}
+
+	title := strings.TrimSpace(ticketCodePattern.ReplaceAllString(email.Subject, ""))
+	if title == "" {
+		title = emailTicketSubjectFallback
+	}
+	title = truncateRunes(title, maxEmailColumnLength)
+	department := models.DeptTechnicalSupport
+	ticket, err := s.ticketService.CreateTicket(ctx, sender.UserID, title, email.Body, "", nil, &department)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.IngestResult{Status: models.IngestStatusCreated, TicketID: ticket.ID}, nil
+}
+
+func (s *ticketEmailService) findThreadTicket(ctx context.Context, email *models.InboundEmail, userID uint64) (uint64, error) {
+	if ids := email.ThreadIDs(); len(ids) > 0 {
+		ticketID, err := s.emailRepo.FindTicketIDByMessageIDs(ctx, ids)
+		if err != nil || ticketID != 0 {
+			return ticketID, err
+		}
+	}
+
+	if match := ticketCodePattern.FindStringSubmatch(email.Subject); match != nil {
+		code, err := strconv.ParseInt(match[1], 10, 32)
+		if err != nil {
+			return 0, nil
+		}
+		return s.emailRepo.FindTicketIDByCode(ctx, int32(code), userID)
+	}
+
+	return 0, nil
+}
+
+func isTicketParticipant(ticket *models.Ticket, userID uint64) bool {
+	return ticket.UserID == userID || (ticket.ReceiverID != nil && *ticket.ReceiverID == userID)
+}
+
+func truncateRunes(value string, max int) string {
+	if runes := []rune(value); len(runes) > max {
+		return string(runes[:max])
+	}
+	return value
+}
+
+// emailSubject is the subject of outbound emails for a ticket
+func emailSubject(title string, code int32) string {
+	return fmt.Sprintf("Re: %s [#%d]", title, code)
+}
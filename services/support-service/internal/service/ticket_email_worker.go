@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"metargb/support-service/internal/models"
+	"metargb/support-service/internal/repository"
+
+	pbNotification "metargb/shared/pb/notifications"
+	"metargb/shared/pkg/jobs"
+)
+
+const (
+	// DefaultTicketEmailInterval is how often responses to email tickets are sent
+	DefaultTicketEmailInterval = time.Minute
+	// ticketEmailBatchSize limits the responses sent in one run
+	ticketEmailBatchSize = 50
+)
+
+// TicketEmailWorker emails the responses of agents on tickets that were opened
+// or answered by email to the ticket sender, threaded into the sender's email
+// conversation. Responses whose email fails are retried on the next run.
+type TicketEmailWorker struct {
+	emailRepo      repository.TicketEmailRepository
+	emailClient    pbNotification.EmailServiceClient
+	supportAddress string
+	interval       time.Duration
+	now            func() time.Time
+}
+
+// NewTicketEmailWorker creates a worker sending pending responses every
+// interval (DefaultTicketEmailInterval if zero) from supportAddress
+func NewTicketEmailWorker(
+	emailRepo repository.TicketEmailRepository,
+	emailClient pbNotification.EmailServiceClient,
+	supportAddress string,
+	interval time.Duration,
+) *TicketEmailWorker {
+	if interval <= 0 {
+		interval = DefaultTicketEmailInterval
+	}
+	return &TicketEmailWorker{
+		emailRepo:      emailRepo,
+		emailClient:    emailClient,
+		supportAddress: supportAddress,
+		interval:       interval,
+		now:            time.Now,
+	}
+}
+
+// Register runs the worker once at start and then every interval under runner
+func (w *TicketEmailWorker) Register(runner *jobs.Runner) {
+	runner.AddImmediate("ticket-emails", w.interval, func(ctx context.Context) error {
+		_, err := w.Run(ctx)
+		return err
+	})
+}
+
+// Run emails the pending responses and returns how many were sent
+func (w *TicketEmailWorker) Run(ctx context.Context) (int, error) {
+	replies, err := w.emailRepo.ListPendingReplies(ctx, ticketEmailBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, reply := range replies {
+		if err := w.send(ctx, reply); err != nil {
+			log.Printf("Failed to email response %d of ticket %d: %v", reply.ResponseID, reply.TicketID, err)
+			continue
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+func (w *TicketEmailWorker) send(ctx context.Context, reply *models.PendingEmailReply) error {
+	thread, err := w.emailRepo.GetThread(ctx, reply.TicketID)
+	if err != nil {
+		return err
+	}
+
+	// Replies go to the address of the latest email received on the ticket
+	var to string
+	var references []string
+	for _, email := range thread {
+		references = append(references, email.MessageID)
+		if email.Direction == models.TicketEmailInbound {
+			to = email.FromAddress
+		}
+	}
+	if to == "" {
+		return fmt.Errorf("ticket has no inbound email")
+	}
+
+	messageID := w.messageID(reply)
+	subject := emailSubject(reply.TicketTitle, reply.TicketCode)
+	headers := map[string]string{
+		"Message-ID":     messageID,
+		"In-Reply-To":    references[len(references)-1],
+		"References":     strings.Join(references, " "),
+		"Auto-Submitted": "no",
+	}
+	if w.supportAddress != "" {
+		headers["Reply-To"] = w.supportAddress
+	}
+
+	if _, err := w.emailClient.SendEmail(ctx, &pbNotification.SendEmailRequest{
+		To:      to,
+		Subject: subject,
+		Body:    fmt.Sprintf("%s\n\n-- \n%s\nکد تیکت: %d", reply.Response, reply.ResponserName, reply.TicketCode),
+		Headers: headers,
+	}); err != nil {
+		return err
+	}
+
+	responseID := reply.ResponseID
+	_, err = w.emailRepo.Create(ctx, &models.TicketEmail{
+		TicketID:    reply.TicketID,
+		ResponseID:  &responseID,
+		Direction:   models.TicketEmailOutbound,
+		MessageID:   messageID,
+		FromAddress: w.supportAddress,
+		ToAddress:   to,
+		Subject:     subject,
+	})
+	return err
+}
+
+// messageID builds the Message-ID of an outbound email. Replies to it are
+// threaded into the ticket through this ID.
+func (w *TicketEmailWorker) messageID(reply *models.PendingEmailReply) string {
+	domain := "metargb"
+	if at := strings.LastIndex(w.supportAddress, "@"); at >= 0 && at < len(w.supportAddress)-1 {
+		domain = w.supportAddress[at+1:]
+	}
+	return fmt.Sprintf("<ticket-%d.response-%d.%d@%s>", reply.TicketID, reply.ResponseID, w.now().UnixNano(), domain)
+}
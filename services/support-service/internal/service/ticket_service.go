@@ -64,7 +64,7 @@ func (s *ticketService) CreateTicket(ctx context.Context, userID uint64, title,
 
 	// Send notification to receiver if present
 	if receiverID != nil {
-		go s.sendTicketNotification(*receiverID, fullTicket)
+		go s.sendTicketNotification(ctx, *receiverID, fullTicket)
 	}
 
 	return fullTicket, nil
@@ -160,7 +160,7 @@ func (s *ticketService) AddResponse(ctx context.Context, ticketID, userID uint64
 	}
 
 	// Send notification to sender
-	go s.sendTicketNotification(ticket.UserID, updatedTicket)
+	go s.sendTicketNotification(ctx, ticket.UserID, updatedTicket)
 
 	return updatedTicket, nil
 }
@@ -224,7 +224,9 @@ func (s *ticketService) CheckAuthorization(ctx context.Context, ticketID, userID
 	return nil
 }
 
-func (s *ticketService) sendTicketNotification(userID uint64, ticket *models.TicketWithRelations) {
+// sendTicketNotification runs after the request returns, so it keeps ctx's
+// values but not its cancellation
+func (s *ticketService) sendTicketNotification(ctx context.Context, userID uint64, ticket *models.TicketWithRelations) {
 	// Connect to notification service
 	conn, err := grpc.Dial(s.notificationServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
@@ -235,7 +237,7 @@ func (s *ticketService) sendTicketNotification(userID uint64, ticket *models.Tic
 
 	client := pbNotification.NewNotificationServiceClient(conn)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
 	defer cancel()
 
 	// Prepare notification data matching Laravel TicketRecieved notification
@@ -246,10 +248,11 @@ func (s *ticketService) sendTicketNotification(userID uint64, ticket *models.Tic
 	}
 
 	_, err = client.SendNotification(ctx, &pbNotification.SendNotificationRequest{
-		UserId:  userID,
-		Type:    "ticket_received",
-		Title:   "تیکت جدید",
-		Message: message,
+		UserId:   userID,
+		Type:     "ticket_received",
+		Category: "support",
+		Title:    "تیکت جدید",
+		Message:  message,
 		Data: map[string]string{
 			"related-to":   "tickets",
 			"sender-image": senderImage,
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -72,6 +73,10 @@ func (h *VideoHandler) GetVideos(ctx context.Context, req *trainingpb.GetVideosR
 		if err != nil {
 			continue
 		}
+		// Premium videos are only playable through GetVideo
+		if videoResp.Premium {
+			videoResp.VideoUrl = ""
+		}
 		response.Videos = append(response.Videos, videoResp)
 	}
 
@@ -88,7 +93,7 @@ func (h *VideoHandler) GetVideo(ctx context.Context, req *trainingpb.GetVideoReq
 
 	video, err := h.service.GetVideoBySlug(ctx, req.Slug, userID, ipAddress)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "video not found: %v", err)
+		return nil, mapVideoError(err)
 	}
 
 	details, err := h.service.GetVideoWithDetails(ctx, video)
@@ -106,9 +111,14 @@ func (h *VideoHandler) GetVideoByFileName(ctx context.Context, req *trainingpb.G
 		ipAddress = h.getIPAddress(ctx)
 	}
 
-	video, err := h.service.GetVideoByFileName(ctx, req.FileName, ipAddress)
+	var userID *uint64
+	if req.UserId > 0 {
+		userID = &req.UserId
+	}
+
+	video, err := h.service.GetVideoByFileName(ctx, req.FileName, userID, ipAddress)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "video not found: %v", err)
+		return nil, mapVideoError(err)
 	}
 
 	details, err := h.service.GetVideoWithDetails(ctx, video)
@@ -163,6 +173,10 @@ func (h *VideoHandler) SearchVideos(ctx context.Context, req *trainingpb.SearchV
 		if err != nil {
 			continue
 		}
+		// Premium videos are only playable through GetVideo
+		if videoResp.Premium {
+			videoResp.VideoUrl = ""
+		}
 		response.Videos = append(response.Videos, videoResp)
 	}
 
@@ -197,6 +211,17 @@ func (h *VideoHandler) AddInteraction(ctx context.Context, req *trainingpb.AddIn
 	return &commonpb.Empty{}, nil
 }
 
+// mapVideoError maps errors of single video lookups to gRPC status codes
+func mapVideoError(err error) error {
+	if errors.Is(err, service.ErrPremiumVideo) {
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	if errors.Is(err, service.ErrEntitlementsUnavailable) {
+		return status.Errorf(codes.Unavailable, "%v", err)
+	}
+	return status.Errorf(codes.NotFound, "video not found: %v", err)
+}
+
 // buildVideoResponse builds a VideoResponse from a Video model
 func (h *VideoHandler) buildVideoResponse(ctx context.Context, video *service.VideoDetails) (*trainingpb.VideoResponse, error) {
 	if video == nil || video.Video == nil {
@@ -210,6 +235,7 @@ func (h *VideoHandler) buildVideoResponse(ctx context.Context, video *service.Vi
 		Description: video.Video.Description,
 		FileName:    video.Video.FileName,
 		CreatorCode: video.Video.CreatorCode,
+		Premium:     video.Video.Premium,
 		CreatedAt:   video.CreatedAtJalali,
 	}
 
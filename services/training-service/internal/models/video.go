@@ -12,6 +12,7 @@ type Video struct {
 	FileName           string    `db:"fileName"`
 	CreatorCode        string    `db:"creator_code"`
 	Image              string    `db:"image"`
+	Premium            bool      `db:"premium"`
 	CreatedAt          time.Time `db:"created_at"`
 	UpdatedAt          time.Time `db:"updated_at"`
 }
@@ -30,7 +30,7 @@ func NewVideoRepository(db *sql.DB) *VideoRepository {
 // GetVideos retrieves paginated videos with optional category filters
 func (r *VideoRepository) GetVideos(ctx context.Context, page, perPage int32, categoryID, subCategoryID *uint64) ([]*models.Video, int32, error) {
 	query := `
-		SELECT v.id, v.video_sub_category_id, v.title, v.slug, v.description, v.fileName, v.creator_code, v.image, v.created_at, v.updated_at
+		SELECT v.id, v.video_sub_category_id, v.title, v.slug, v.description, v.fileName, v.creator_code, v.image, v.premium, v.created_at, v.updated_at
 		FROM videos v
 		WHERE 1=1
 	`
@@ -81,6 +81,7 @@ func (r *VideoRepository) GetVideos(ctx context.Context, page, perPage int32, ca
 			&video.FileName,
 			&video.CreatorCode,
 			&video.Image,
+			&video.Premium,
 			&video.CreatedAt,
 			&video.UpdatedAt,
 		); err != nil {
@@ -95,7 +96,7 @@ func (r *VideoRepository) GetVideos(ctx context.Context, page, perPage int32, ca
 // GetVideoBySlug retrieves a video by slug
 func (r *VideoRepository) GetVideoBySlug(ctx context.Context, slug string) (*models.Video, error) {
 	query := `
-		SELECT id, video_sub_category_id, title, slug, description, fileName, creator_code, image, created_at, updated_at
+		SELECT id, video_sub_category_id, title, slug, description, fileName, creator_code, image, premium, created_at, updated_at
 		FROM videos
 		WHERE slug = ?
 	`
@@ -110,6 +111,7 @@ func (r *VideoRepository) GetVideoBySlug(ctx context.Context, slug string) (*mod
 		&video.FileName,
 		&video.CreatorCode,
 		&video.Image,
+		&video.Premium,
 		&video.CreatedAt,
 		&video.UpdatedAt,
 	)
@@ -127,7 +129,7 @@ func (r *VideoRepository) GetVideoBySlug(ctx context.Context, slug string) (*mod
 // GetVideoByFileName retrieves a video by partial file name match
 func (r *VideoRepository) GetVideoByFileName(ctx context.Context, fileName string) (*models.Video, error) {
 	query := `
-		SELECT id, video_sub_category_id, title, slug, description, fileName, creator_code, image, created_at, updated_at
+		SELECT id, video_sub_category_id, title, slug, description, fileName, creator_code, image, premium, created_at, updated_at
 		FROM videos
 		WHERE fileName LIKE ?
 		LIMIT 1
@@ -143,6 +145,7 @@ func (r *VideoRepository) GetVideoByFileName(ctx context.Context, fileName strin
 		&video.FileName,
 		&video.CreatorCode,
 		&video.Image,
+		&video.Premium,
 		&video.CreatedAt,
 		&video.UpdatedAt,
 	)
@@ -160,7 +163,7 @@ func (r *VideoRepository) GetVideoByFileName(ctx context.Context, fileName strin
 // SearchVideos searches videos by title
 func (r *VideoRepository) SearchVideos(ctx context.Context, searchTerm string, page, perPage int32) ([]*models.Video, int32, error) {
 	query := `
-		SELECT id, video_sub_category_id, title, slug, description, fileName, creator_code, image, created_at, updated_at
+		SELECT id, video_sub_category_id, title, slug, description, fileName, creator_code, image, premium, created_at, updated_at
 		FROM videos
 		WHERE title LIKE ?
 		ORDER BY created_at DESC
@@ -198,6 +201,7 @@ func (r *VideoRepository) SearchVideos(ctx context.Context, searchTerm string, p
 			&video.FileName,
 			&video.CreatorCode,
 			&video.Image,
+			&video.Premium,
 			&video.CreatedAt,
 			&video.UpdatedAt,
 		); err != nil {
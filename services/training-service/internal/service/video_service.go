@@ -2,17 +2,28 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"metargb/shared/pkg/auth"
 	"metargb/shared/pkg/jalali"
 	"metargb/training-service/internal/models"
 	"metargb/training-service/internal/repository"
 )
 
+// ErrPremiumVideo is returned when a premium video is requested by a user
+// whose subscription does not grant premium videos
+var ErrPremiumVideo = errors.New("this video requires a premium subscription")
+
+// ErrEntitlementsUnavailable is returned when the subscription of a user
+// requesting a premium video could not be looked up
+var ErrEntitlementsUnavailable = errors.New("subscription lookup unavailable")
+
 type VideoService struct {
 	videoRepo    repository.VideoRepositoryInterface
 	categoryRepo repository.CategoryRepositoryInterface
 	userRepo     repository.UserRepositoryInterface
+	entitlements auth.EntitlementResolver
 }
 
 func NewVideoService(videoRepo repository.VideoRepositoryInterface, categoryRepo repository.CategoryRepositoryInterface, userRepo repository.UserRepositoryInterface) *VideoService {
@@ -23,6 +34,12 @@ func NewVideoService(videoRepo repository.VideoRepositoryInterface, categoryRepo
 	}
 }
 
+// SetEntitlements sets where subscriptions are looked up. Without it premium
+// videos are shown to nobody.
+func (s *VideoService) SetEntitlements(entitlements auth.EntitlementResolver) {
+	s.entitlements = entitlements
+}
+
 // GetVideos retrieves paginated videos
 func (s *VideoService) GetVideos(ctx context.Context, page, perPage int32, categoryID, subCategoryID *uint64) ([]*models.Video, int32, error) {
 	return s.videoRepo.GetVideos(ctx, page, perPage, categoryID, subCategoryID)
@@ -37,6 +54,9 @@ func (s *VideoService) GetVideoBySlug(ctx context.Context, slug string, userID *
 	if video == nil {
 		return nil, fmt.Errorf("video not found")
 	}
+	if err := s.checkPremium(ctx, video, userID); err != nil {
+		return nil, err
+	}
 
 	// Increment view
 	if err := s.videoRepo.IncrementView(ctx, video.ID, ipAddress); err != nil {
@@ -48,7 +68,7 @@ func (s *VideoService) GetVideoBySlug(ctx context.Context, slug string, userID *
 }
 
 // GetVideoByFileName retrieves a video by partial file name and increments view
-func (s *VideoService) GetVideoByFileName(ctx context.Context, fileName string, ipAddress string) (*models.Video, error) {
+func (s *VideoService) GetVideoByFileName(ctx context.Context, fileName string, userID *uint64, ipAddress string) (*models.Video, error) {
 	video, err := s.videoRepo.GetVideoByFileName(ctx, fileName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get video: %w", err)
@@ -56,6 +76,9 @@ func (s *VideoService) GetVideoByFileName(ctx context.Context, fileName string,
 	if video == nil {
 		return nil, fmt.Errorf("video not found")
 	}
+	if err := s.checkPremium(ctx, video, userID); err != nil {
+		return nil, err
+	}
 
 	// Increment view
 	if err := s.videoRepo.IncrementView(ctx, video.ID, ipAddress); err != nil {
@@ -66,6 +89,26 @@ func (s *VideoService) GetVideoByFileName(ctx context.Context, fileName string,
 	return video, nil
 }
 
+// checkPremium fails unless the video is free or userID's subscription
+// grants premium videos
+func (s *VideoService) checkPremium(ctx context.Context, video *models.Video, userID *uint64) error {
+	if !video.Premium {
+		return nil
+	}
+	if userID == nil || s.entitlements == nil {
+		return ErrPremiumVideo
+	}
+
+	entitlements, err := s.entitlements.Entitlements(ctx, *userID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrEntitlementsUnavailable, err)
+	}
+	if !entitlements.Has(auth.EntitlementPremiumVideos) {
+		return ErrPremiumVideo
+	}
+	return nil
+}
+
 // SearchVideos searches videos by title
 func (s *VideoService) SearchVideos(ctx context.Context, searchTerm string, page, perPage int32) ([]*models.Video, int32, error) {
 	if searchTerm == "" {
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/redis/go-redis/v9"
+	"github.com/redis/go-redis/v9/maintnotifications"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	authpb "metargb/shared/pb/auth"
+	dynastypb "metargb/shared/pb/dynasty"
+	supportpb "metargb/shared/pb/support"
+	"metargb/shared/pkg/debug"
+	healthprobe "metargb/shared/pkg/health"
+	"metargb/shared/pkg/logger"
+	"metargb/websocket-gateway/internal/gateway"
+	"metargb/websocket-gateway/internal/hub"
+	"metargb/websocket-gateway/internal/presence"
+	"metargb/websocket-gateway/internal/rooms"
+	"metargb/websocket-gateway/internal/router"
+	"metargb/websocket-gateway/internal/socketio"
+)
+
+func main() {
+	// Load environment variables from config.env
+	// Try multiple possible paths for config.env
+	configPaths := []string{
+		"config.env",
+		"./config.env",
+		"../config.env",
+		"../../config.env",
+		"services/websocket-gateway/config.env",
+	}
+	var loadedConfig string
+	for _, configPath := range configPaths {
+		if err := godotenv.Load(configPath); err == nil {
+			loadedConfig = configPath
+			break
+		}
+	}
+	// Fallback to .env if config.env not found
+	var envErr error
+	if loadedConfig == "" {
+		envErr = godotenv.Load()
+	}
+
+	// Initialize logger once the environment is loaded so LOG_LEVEL applies
+	log := logger.NewLogger("websocket-gateway")
+	log.RedirectStdLog()
+	if loadedConfig != "" {
+		log.Info("Loaded config", "path", loadedConfig)
+	} else if envErr != nil {
+		log.Warn("config.env and .env files not found, using environment variables only")
+	}
+	startedAt := time.Now()
+
+	redisOpts, err := redis.ParseURL(getEnv("REDIS_URL", "redis://localhost:6379"))
+	if err != nil {
+		log.Fatal("Invalid REDIS_URL", "error", err)
+	}
+	// Disable maint notifications to avoid warning about maint_notifications command
+	redisOpts.MaintNotificationsConfig = &maintnotifications.Config{
+		Mode: maintnotifications.ModeDisabled,
+	}
+	redisClient := redis.NewClient(redisOpts)
+	defer redisClient.Close()
+
+	authServiceAddr := getEnv("AUTH_SERVICE_ADDR", "localhost:50051")
+	authConn, err := grpc.NewClient(authServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal("Failed to connect to auth service", "error", err)
+	}
+	defer authConn.Close()
+
+	dynastyServiceAddr := getEnv("DYNASTY_SERVICE_ADDR", "localhost:50055")
+	dynastyConn, err := grpc.NewClient(dynastyServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal("Failed to connect to dynasty service", "error", err)
+	}
+	defer dynastyConn.Close()
+
+	supportServiceAddr := getEnv("SUPPORT_SERVICE_ADDR", "localhost:50056")
+	supportConn, err := grpc.NewClient(supportServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal("Failed to connect to support service", "error", err)
+	}
+	defer supportConn.Close()
+
+	publish := func(ctx context.Context, channel string, payload []byte) error {
+		return redisClient.Publish(ctx, channel, payload).Err()
+	}
+
+	connections := hub.New()
+	tracker := presence.NewTracker(redisClient, time.Duration(getEnvAsInt("PRESENCE_TTL_SECONDS", 90, log))*time.Second)
+	handler := gateway.New(
+		connections,
+		authpb.NewAuthServiceClient(authConn),
+		rooms.NewAuthorizer(
+			dynastypb.NewFamilyServiceClient(dynastyConn),
+			supportpb.NewSupportChatServiceClient(supportConn),
+		),
+		tracker,
+		publish,
+		log,
+		getEnvAsInt("MAX_ROOMS_PER_CONNECTION", gateway.DefaultMaxRooms, log),
+	)
+	sockets := socketio.NewServer(handler, socketio.Options{
+		CheckOrigin: allowedOrigins(getEnv("CORS_ORIGIN", "*")),
+	})
+
+	// Ready once Redis answers and the event subscription is in place
+	probe := healthprobe.NewProbe("websocket-gateway").
+		AddCheck("redis", func(ctx context.Context) error { return redisClient.Ping(ctx).Err() })
+	subscribed := probe.Starting("subscription")
+	probeServer := healthprobe.NewServer(":"+getEnv("HEALTH_PORT", healthprobe.DefaultPort), probe)
+	go func() {
+		if err := probeServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Health probe server failed", "error", err)
+		}
+	}()
+
+	// Profiling endpoints, off unless DEBUG_ADDR is set or in development, see debug
+	debug.Serve(context.Background(), "websocket-gateway", log)
+
+	runCtx, stop := context.WithCancel(context.Background())
+	defer stop()
+
+	// Deliver what services publish on Redis to the rooms of this gateway
+	events := router.New(connections, publish)
+	go func() {
+		for runCtx.Err() == nil {
+			err := events.Run(runCtx, redisClient, func() {
+				subscribed()
+				log.Info("Subscribed to Redis channels", "channels", strings.Join(router.Channels, ","))
+			}, func(channel string, err error) {
+				log.Warn("Failed to route Redis message", "channel", channel, "error", err)
+			})
+			if err != nil {
+				log.Error("Redis subscription failed, retrying", "error", err)
+				time.Sleep(time.Second)
+			}
+		}
+	}()
+
+	// Keep the presence of this gateway's connections from expiring
+	go tracker.Run(runCtx, connections.Connections, func(err error) {
+		log.Error("Failed to refresh presence", "error", err)
+	})
+
+	mux := http.NewServeMux()
+	mux.Handle("/socket.io/", sockets)
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		conns, users, _ := connections.Stats()
+		writeJSON(w, map[string]interface{}{
+			"status":      "healthy",
+			"connections": conns,
+			"users":       users,
+			"timestamp":   time.Now().UTC().Format(time.RFC3339),
+		})
+	})
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		conns, users, roomCount := connections.Stats()
+		var memory runtime.MemStats
+		runtime.ReadMemStats(&memory)
+		writeJSON(w, map[string]interface{}{
+			"totalConnections": conns,
+			"totalUsers":       users,
+			"totalRooms":       roomCount,
+			"sockets":          sockets.Connections(),
+			"uptime":           time.Since(startedAt).Seconds(),
+			"memory": map[string]uint64{
+				"heapAlloc": memory.HeapAlloc,
+				"heapSys":   memory.HeapSys,
+				"sys":       memory.Sys,
+			},
+			"timestamp": time.Now().UTC().Format(time.RFC3339),
+		})
+	})
+
+	port := getEnv("PORT", "3000")
+	httpServer := &http.Server{Addr: ":" + port, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal("Failed to serve websocket gateway", "error", err)
+		}
+	}()
+	log.Info("WebSocket gateway listening", "port", port, "auth_service", authServiceAddr, "dynasty_service", dynastyServiceAddr, "support_service", supportServiceAddr)
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Info("Shutting down server...")
+	probe.Drain()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	httpServer.Shutdown(shutdownCtx)
+	// Hijacked websockets are not closed by Shutdown
+	sockets.Close()
+	for sockets.Connections() > 0 && shutdownCtx.Err() == nil {
+		time.Sleep(50 * time.Millisecond)
+	}
+	stop()
+	probeServer.Close()
+	log.Info("Server stopped")
+}
+
+// allowedOrigins checks browser origins against CORS_ORIGIN, a comma
+// separated list or *
+func allowedOrigins(setting string) func(r *http.Request) bool {
+	allowed := make(map[string]bool)
+	for _, origin := range strings.Split(setting, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			allowed[origin] = true
+		}
+	}
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		return origin == "" || allowed["*"] || allowed[origin]
+	}
+}
+
+func writeJSON(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(body)
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+func getEnvAsInt(key string, defaultValue int, log *logger.Logger) int {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.Atoi(valueStr)
+	if err != nil {
+		log.Warn("Invalid "+key+", using default", "value", valueStr, "default", defaultValue)
+		return defaultValue
+	}
+	return value
+}
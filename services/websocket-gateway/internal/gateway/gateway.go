@@ -0,0 +1,290 @@
+// Package gateway authenticates socket connections against auth-service and
+// handles the events clients send: room subscriptions, typing indicators and
+// heartbeats
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	authpb "metargb/shared/pb/auth"
+	"metargb/shared/pkg/logger"
+	"metargb/websocket-gateway/internal/hub"
+	"metargb/websocket-gateway/internal/presence"
+	"metargb/websocket-gateway/internal/rooms"
+	"metargb/websocket-gateway/internal/router"
+	"metargb/websocket-gateway/internal/socketio"
+)
+
+// DefaultMaxRooms is how many rooms a connection may subscribe to unless
+// MAX_ROOMS_PER_CONNECTION is set. A map view of a few hundred tiles needs
+// its client to subscribe at a coarser zoom.
+const DefaultMaxRooms = 100
+
+// callTimeout bounds the calls made while handling one client packet
+const callTimeout = 5 * time.Second
+
+// EventSupportChatTyping tells a support chat room that a participant started
+// or stopped typing
+const EventSupportChatTyping = "support-chat-typing"
+
+// Handshake errors, reported to the client's connect_error handler
+var (
+	errNoToken      = errors.New("Authentication error: No token provided")
+	errInvalidToken = errors.New("Authentication error: Invalid token")
+)
+
+// session is the state of an authenticated connection
+type session struct {
+	userID uint64
+	// expiry disconnects the client when its token expires
+	expiry *time.Timer
+}
+
+// Gateway is the socketio.Handler of the websocket gateway
+type Gateway struct {
+	hub        *hub.Hub
+	auth       authpb.AuthServiceClient
+	authorizer *rooms.Authorizer
+	presence   *presence.Tracker
+	publish    router.Publisher
+	log        *logger.Logger
+	maxRooms   int
+}
+
+// New creates the gateway. publish sends typing indicators to the gateways
+// holding the other participants. A maxRooms of 0 uses DefaultMaxRooms.
+func New(h *hub.Hub, auth authpb.AuthServiceClient, authorizer *rooms.Authorizer, tracker *presence.Tracker, publish router.Publisher, log *logger.Logger, maxRooms int) *Gateway {
+	if maxRooms <= 0 {
+		maxRooms = DefaultMaxRooms
+	}
+	return &Gateway{
+		hub:        h,
+		auth:       auth,
+		authorizer: authorizer,
+		presence:   tracker,
+		publish:    publish,
+		log:        log,
+		maxRooms:   maxRooms,
+	}
+}
+
+// Connect validates the token of the handshake with auth-service
+func (g *Gateway) Connect(c *socketio.Conn, auth json.RawMessage) error {
+	token := handshakeToken(c.Request(), auth)
+	if token == "" {
+		return errNoToken
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	resp, err := g.auth.ValidateToken(ctx, &authpb.ValidateTokenRequest{Token: token})
+	if err != nil {
+		g.log.Warn("Token validation failed", "socket_id", c.ID(), "error", err)
+		return errInvalidToken
+	}
+	if !resp.Valid || resp.UserId == 0 {
+		return errInvalidToken
+	}
+
+	s := &session{userID: resp.UserId}
+	if resp.ExpiresAt > 0 {
+		s.expiry = time.AfterFunc(time.Until(time.Unix(resp.ExpiresAt, 0)), func() {
+			c.Emit("session-expired", map[string]interface{}{
+				"message":   "Your session has expired",
+				"timestamp": time.Now().UTC().Format(time.RFC3339),
+			})
+			c.Disconnect()
+		})
+	}
+	c.Data = s
+	return nil
+}
+
+// Connected puts the connection in its user's room and marks the user online
+func (g *Gateway) Connected(c *socketio.Conn) {
+	s := c.Data.(*session)
+	g.hub.Register(c, s.userID)
+	g.log.Debug("User connected", "user_id", s.userID, "socket_id", c.ID())
+
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	if err := g.presence.Connected(ctx, s.userID, c.ID()); err != nil {
+		g.log.Error("Failed to mark user online", "user_id", s.userID, "error", err)
+	}
+
+	c.Emit("connected", map[string]interface{}{
+		"message":   "Connected to MetaRGB WebSocket Gateway",
+		"userId":    s.userID,
+		"timestamp": time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+// Disconnect takes the connection out of every room and marks the user
+// offline once their last connection is gone
+func (g *Gateway) Disconnect(c *socketio.Conn) {
+	s := c.Data.(*session)
+	if s.expiry != nil {
+		s.expiry.Stop()
+	}
+	g.hub.Unregister(c)
+	g.log.Debug("User disconnected", "user_id", s.userID, "socket_id", c.ID())
+
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	if err := g.presence.Disconnected(ctx, s.userID, c.ID()); err != nil {
+		g.log.Error("Failed to mark user offline", "user_id", s.userID, "error", err)
+	}
+}
+
+// Event handles ping, subscribe, unsubscribe and typing. subscribe and
+// unsubscribe take {"room": "<name>"}, typing takes {"room", "typing"}; all
+// three acknowledge with {"ok", "room", "error"}.
+func (g *Gateway) Event(c *socketio.Conn, event string, args []json.RawMessage) []interface{} {
+	s := c.Data.(*session)
+	switch event {
+	case "ping":
+		now := time.Now().UnixMilli()
+		c.Emit("pong", map[string]interface{}{"timestamp": now})
+		return []interface{}{map[string]interface{}{"timestamp": now}}
+	case "subscribe":
+		return []interface{}{g.subscribe(c, s, args)}
+	case "unsubscribe":
+		return []interface{}{g.unsubscribe(c, args)}
+	case "typing":
+		return []interface{}{g.typing(c, s, args)}
+	}
+	return []interface{}{subscriptionResult{Error: "unknown event"}}
+}
+
+// subscriptionResult acknowledges subscribe and unsubscribe
+type subscriptionResult struct {
+	OK    bool   `json:"ok"`
+	Room  string `json:"room,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+func (g *Gateway) subscribe(c *socketio.Conn, s *session, args []json.RawMessage) subscriptionResult {
+	room, err := roomArg(args)
+	if err != nil {
+		return subscriptionResult{Error: err.Error()}
+	}
+	// The user's own room is always joined and does not count
+	if len(g.hub.Rooms(c))-1 >= g.maxRooms {
+		return subscriptionResult{Room: room.Name, Error: "too many rooms"}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	if err := g.authorizer.Authorize(ctx, s.userID, room); err != nil {
+		if errors.Is(err, rooms.ErrForbidden) {
+			return subscriptionResult{Room: room.Name, Error: err.Error()}
+		}
+		g.log.Error("Failed to authorize room subscription", "user_id", s.userID, "room", room.Name, "error", err)
+		return subscriptionResult{Room: room.Name, Error: "subscription is temporarily unavailable"}
+	}
+
+	g.hub.Join(c, room.Name)
+	return subscriptionResult{OK: true, Room: room.Name}
+}
+
+func (g *Gateway) unsubscribe(c *socketio.Conn, args []json.RawMessage) subscriptionResult {
+	room, err := roomArg(args)
+	if err != nil {
+		return subscriptionResult{Error: err.Error()}
+	}
+	g.hub.Leave(c, room.Name)
+	return subscriptionResult{OK: true, Room: room.Name}
+}
+
+// typing relays that the user started or stopped typing to a support chat
+// room the connection subscribed to. Every participant hears it, the typist
+// included.
+func (g *Gateway) typing(c *socketio.Conn, s *session, args []json.RawMessage) subscriptionResult {
+	room, err := roomArg(args)
+	if err != nil {
+		return subscriptionResult{Error: err.Error()}
+	}
+	if room.Kind != rooms.KindSupportChat {
+		return subscriptionResult{Room: room.Name, Error: rooms.ErrUnknownRoom.Error()}
+	}
+	if !g.inRoom(c, room.Name) {
+		return subscriptionResult{Room: room.Name, Error: "not subscribed to this room"}
+	}
+
+	var payload struct {
+		Typing bool `json:"typing"`
+	}
+	// A bare room name, without the typing flag, stops the indicator
+	json.Unmarshal(args[0], &payload)
+	data, err := json.Marshal(map[string]interface{}{
+		"chat_id": room.ChatID,
+		"user_id": s.userID,
+		"typing":  payload.Typing,
+	})
+	if err != nil {
+		return subscriptionResult{Room: room.Name, Error: err.Error()}
+	}
+	event, err := json.Marshal(router.RoomEvent{Room: room.Name, Event: EventSupportChatTyping, Data: data})
+	if err != nil {
+		return subscriptionResult{Room: room.Name, Error: err.Error()}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	if err := g.publish(ctx, router.ChannelRoomEvents, event); err != nil {
+		g.log.Error("Failed to publish typing indicator", "user_id", s.userID, "room", room.Name, "error", err)
+		return subscriptionResult{Room: room.Name, Error: "typing is temporarily unavailable"}
+	}
+	return subscriptionResult{OK: true, Room: room.Name}
+}
+
+func (g *Gateway) inRoom(c *socketio.Conn, name string) bool {
+	for _, joined := range g.hub.Rooms(c) {
+		if joined == name {
+			return true
+		}
+	}
+	return false
+}
+
+// roomArg reads the room of a subscribe or unsubscribe event, given either as
+// {"room": "<name>"} or as the bare name
+func roomArg(args []json.RawMessage) (rooms.Room, error) {
+	if len(args) == 0 {
+		return rooms.Room{}, rooms.ErrUnknownRoom
+	}
+	var name string
+	if err := json.Unmarshal(args[0], &name); err != nil {
+		var payload struct {
+			Room string `json:"room"`
+		}
+		if err := json.Unmarshal(args[0], &payload); err != nil {
+			return rooms.Room{}, rooms.ErrUnknownRoom
+		}
+		name = payload.Room
+	}
+	return rooms.Parse(name)
+}
+
+// handshakeToken reads the token from the auth payload of the namespace
+// connect, as socket.io-client sends it, or from a bearer Authorization
+// header for clients that cannot set an auth payload
+func handshakeToken(r *http.Request, auth json.RawMessage) string {
+	if len(auth) > 0 {
+		var payload struct {
+			Token string `json:"token"`
+		}
+		if err := json.Unmarshal(auth, &payload); err == nil && payload.Token != "" {
+			return strings.TrimPrefix(payload.Token, "Bearer ")
+		}
+	}
+	if header := r.Header.Get("Authorization"); strings.HasPrefix(header, "Bearer ") {
+		return strings.TrimPrefix(header, "Bearer ")
+	}
+	return ""
+}
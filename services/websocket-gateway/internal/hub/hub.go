@@ -0,0 +1,183 @@
+// Package hub keeps track of who is connected to this gateway and which rooms
+// their connections are in, and fans events out to rooms
+package hub
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Member is a connection that can be put in rooms
+type Member interface {
+	ID() string
+	Send(frame []byte)
+}
+
+// UserRoom is the room every connection of userID is in
+func UserRoom(userID uint64) string {
+	return fmt.Sprintf("user:%d", userID)
+}
+
+// Hub maps rooms to their members. It only knows the connections of this
+// gateway; every gateway receives every Redis event and delivers it to its
+// own connections.
+type Hub struct {
+	mu        sync.RWMutex
+	rooms     map[string]map[Member]struct{}
+	joined    map[Member]map[string]struct{}
+	users     map[Member]uint64
+	userConns map[uint64]map[Member]struct{}
+}
+
+// New creates an empty hub
+func New() *Hub {
+	return &Hub{
+		rooms:     make(map[string]map[Member]struct{}),
+		joined:    make(map[Member]map[string]struct{}),
+		users:     make(map[Member]uint64),
+		userConns: make(map[uint64]map[Member]struct{}),
+	}
+}
+
+// Register adds the connection m of userID and puts it in the user's room.
+// It reports whether m is the user's first connection to this gateway.
+func (h *Hub) Register(m Member, userID uint64) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.users[m] = userID
+	conns := h.userConns[userID]
+	if conns == nil {
+		conns = make(map[Member]struct{})
+		h.userConns[userID] = conns
+	}
+	conns[m] = struct{}{}
+	h.joinLocked(m, UserRoom(userID))
+	return len(conns) == 1
+}
+
+// Unregister removes m from every room. It reports whether m was the user's
+// last connection to this gateway.
+func (h *Hub) Unregister(m Member) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for room := range h.joined[m] {
+		h.leaveLocked(m, room)
+	}
+	delete(h.joined, m)
+
+	userID, ok := h.users[m]
+	if !ok {
+		return false
+	}
+	delete(h.users, m)
+	conns := h.userConns[userID]
+	delete(conns, m)
+	if len(conns) > 0 {
+		return false
+	}
+	delete(h.userConns, userID)
+	return true
+}
+
+// Join puts m in room and returns how many rooms m is in afterwards
+func (h *Hub) Join(m Member, room string) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.joinLocked(m, room)
+	return len(h.joined[m])
+}
+
+// Leave takes m out of room
+func (h *Hub) Leave(m Member, room string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.leaveLocked(m, room)
+}
+
+// Rooms returns the rooms m is in, sorted
+func (h *Hub) Rooms(m Member) []string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	rooms := make([]string, 0, len(h.joined[m]))
+	for room := range h.joined[m] {
+		rooms = append(rooms, room)
+	}
+	sort.Strings(rooms)
+	return rooms
+}
+
+// Broadcast sends frame to every member of room and returns how many it was
+// sent to
+func (h *Hub) Broadcast(room string, frame []byte) int {
+	h.mu.RLock()
+	members := make([]Member, 0, len(h.rooms[room]))
+	for m := range h.rooms[room] {
+		members = append(members, m)
+	}
+	h.mu.RUnlock()
+
+	// Send outside the lock: a slow member is disconnected, which unregisters it
+	for _, m := range members {
+		m.Send(frame)
+	}
+	return len(members)
+}
+
+// Online reports whether userID has a connection to this gateway
+func (h *Hub) Online(userID uint64) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.userConns[userID]) > 0
+}
+
+// Connections returns the connection ids of every user connected to this
+// gateway
+func (h *Hub) Connections() map[uint64][]string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	conns := make(map[uint64][]string, len(h.userConns))
+	for userID, members := range h.userConns {
+		for m := range members {
+			conns[userID] = append(conns[userID], m.ID())
+		}
+	}
+	return conns
+}
+
+// Stats returns the number of connections, users and rooms
+func (h *Hub) Stats() (connections, users, rooms int) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.users), len(h.userConns), len(h.rooms)
+}
+
+func (h *Hub) joinLocked(m Member, room string) {
+	members := h.rooms[room]
+	if members == nil {
+		members = make(map[Member]struct{})
+		h.rooms[room] = members
+	}
+	members[m] = struct{}{}
+
+	joined := h.joined[m]
+	if joined == nil {
+		joined = make(map[string]struct{})
+		h.joined[m] = joined
+	}
+	joined[room] = struct{}{}
+}
+
+func (h *Hub) leaveLocked(m Member, room string) {
+	if members := h.rooms[room]; members != nil {
+		delete(members, m)
+		if len(members) == 0 {
+			delete(h.rooms, room)
+		}
+	}
+	if joined := h.joined[m]; joined != nil {
+		delete(joined, room)
+	}
+}
@@ -0,0 +1,60 @@
+package hub
+
+import "testing"
+
+type fakeMember struct {
+	id     string
+	frames int
+}
+
+func (m *fakeMember) ID() string { return m.id }
+
+func (m *fakeMember) Send(frame []byte) { m.frames++ }
+
+func TestRegisterTracksFirstAndLastConnection(t *testing.T) {
+	h := New()
+	phone, laptop := &fakeMember{id: "phone"}, &fakeMember{id: "laptop"}
+
+	if !h.Register(phone, 1) {
+		t.Fatal("first connection not reported as first")
+	}
+	if h.Register(laptop, 1) {
+		t.Fatal("second connection reported as first")
+	}
+	if n := h.Broadcast(UserRoom(1), []byte("x")); n != 2 {
+		t.Fatalf("user room reached %d connections", n)
+	}
+
+	if h.Unregister(phone) {
+		t.Fatal("user went offline with a connection left")
+	}
+	if !h.Online(1) {
+		t.Fatal("user offline with a connection left")
+	}
+	if !h.Unregister(laptop) {
+		t.Fatal("last connection not reported as last")
+	}
+	if connections, users, rooms := h.Stats(); connections != 0 || users != 0 || rooms != 0 {
+		t.Fatalf("hub not empty: %d connections, %d users, %d rooms", connections, users, rooms)
+	}
+}
+
+func TestJoinAndLeaveRooms(t *testing.T) {
+	h := New()
+	viewer := &fakeMember{id: "viewer"}
+	h.Register(viewer, 1)
+
+	if n := h.Join(viewer, "map-tile:1/0/1"); n != 2 {
+		t.Fatalf("in %d rooms after joining one besides the user room", n)
+	}
+	h.Broadcast("map-tile:1/0/1", []byte("x"))
+	h.Leave(viewer, "map-tile:1/0/1")
+	h.Broadcast("map-tile:1/0/1", []byte("x"))
+
+	if viewer.frames != 1 {
+		t.Fatalf("got %d frames, expected only the one sent while subscribed", viewer.frames)
+	}
+	if rooms := h.Rooms(viewer); len(rooms) != 1 || rooms[0] != UserRoom(1) {
+		t.Fatalf("rooms %v", rooms)
+	}
+}
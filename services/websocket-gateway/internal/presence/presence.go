@@ -0,0 +1,142 @@
+// Package presence records who is connected in Redis, where auth-service
+// (internal/repository/presence_repository.go) reads it
+package presence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Redis layout shared with auth-service. Every open connection is a member of
+// presence:conns:<user id> scored by the unix millisecond time its heartbeat
+// expires, so connections of a crashed gateway stop counting on their own.
+// presence:last_seen maps user ids to the unix millisecond time they were
+// last connected.
+const (
+	Channel          = "presence"
+	connsKeyPrefix   = "presence:conns:"
+	lastSeenKey      = "presence:last_seen"
+	DefaultTTL       = 90 * time.Second
+	heartbeatDivisor = 3
+)
+
+// Event is published on Channel when a user's first connection opens or their
+// last connection closes, across all gateways
+type Event struct {
+	UserID   uint64    `json:"user_id"`
+	Online   bool      `json:"online"`
+	LastSeen time.Time `json:"last_seen"`
+}
+
+// Tracker keeps the presence keys of this gateway's connections
+type Tracker struct {
+	client *redis.Client
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// NewTracker creates a tracker whose connections expire ttl after their last
+// heartbeat. A ttl of 0 uses DefaultTTL.
+func NewTracker(client *redis.Client, ttl time.Duration) *Tracker {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Tracker{client: client, ttl: ttl, now: time.Now}
+}
+
+func connsKey(userID uint64) string {
+	return connsKeyPrefix + strconv.FormatUint(userID, 10)
+}
+
+// Connected records connection connID of userID
+func (t *Tracker) Connected(ctx context.Context, userID uint64, connID string) error {
+	return t.update(ctx, userID, connID, true)
+}
+
+// Disconnected removes connection connID of userID
+func (t *Tracker) Disconnected(ctx context.Context, userID uint64, connID string) error {
+	return t.update(ctx, userID, connID, false)
+}
+
+// update publishes on Channel when the user's first connection opens or their
+// last connection closes
+func (t *Tracker) update(ctx context.Context, userID uint64, connID string, online bool) error {
+	now := t.now()
+	nowMs := now.UnixMilli()
+	key := connsKey(userID)
+
+	pipe := t.client.TxPipeline()
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(nowMs, 10))
+	if online {
+		pipe.ZAdd(ctx, key, redis.Z{Score: float64(nowMs + t.ttl.Milliseconds()), Member: connID})
+	} else {
+		pipe.ZRem(ctx, key, connID)
+	}
+	connections := pipe.ZCard(ctx, key)
+	pipe.PExpire(ctx, key, t.ttl)
+	pipe.HSet(ctx, lastSeenKey, strconv.FormatUint(userID, 10), nowMs)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update presence of user %d: %w", userID, err)
+	}
+
+	count := connections.Val()
+	if (online && count == 1) || (!online && count == 0) {
+		payload, err := json.Marshal(Event{UserID: userID, Online: online, LastSeen: now.UTC()})
+		if err != nil {
+			return err
+		}
+		if err := t.client.Publish(ctx, Channel, payload).Err(); err != nil {
+			return fmt.Errorf("failed to publish presence of user %d: %w", userID, err)
+		}
+	}
+	return nil
+}
+
+// Refresh extends the heartbeat of the given connections, keyed by user
+func (t *Tracker) Refresh(ctx context.Context, conns map[uint64][]string) error {
+	if len(conns) == 0 {
+		return nil
+	}
+	nowMs := t.now().UnixMilli()
+	expires := float64(nowMs + t.ttl.Milliseconds())
+
+	pipe := t.client.Pipeline()
+	for userID, ids := range conns {
+		key := connsKey(userID)
+		members := make([]redis.Z, len(ids))
+		for i, id := range ids {
+			members[i] = redis.Z{Score: expires, Member: id}
+		}
+		pipe.ZAddXX(ctx, key, members...)
+		pipe.PExpire(ctx, key, t.ttl)
+		pipe.HSet(ctx, lastSeenKey, strconv.FormatUint(userID, 10), nowMs)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to refresh presence: %w", err)
+	}
+	return nil
+}
+
+// Run refreshes the connections returned by conns three times per ttl until
+// ctx is cancelled. Errors go to onError and the next heartbeat tries again.
+func (t *Tracker) Run(ctx context.Context, conns func() map[uint64][]string, onError func(error)) {
+	ticker := time.NewTicker(t.ttl / heartbeatDivisor)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			refreshCtx, cancel := context.WithTimeout(ctx, t.ttl/heartbeatDivisor)
+			if err := t.Refresh(refreshCtx, conns()); err != nil {
+				onError(err)
+			}
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// Package rooms defines the rooms clients may subscribe to and who may join
+// them
+package rooms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dynastypb "metargb/shared/pb/dynasty"
+	supportpb "metargb/shared/pb/support"
+)
+
+// Room name prefixes
+const (
+	mapTilePrefix     = "map-tile:"
+	dynastyPrefix     = "dynasty:"
+	supportChatPrefix = "support-chat:"
+)
+
+// MaxTileZoom is the deepest zoom level of a map tile room
+const MaxTileZoom = 22
+
+// Kind is what a room is about
+type Kind string
+
+const (
+	KindMapTile     Kind = "map-tile"
+	KindDynasty     Kind = "dynasty"
+	KindSupportChat Kind = "support-chat"
+)
+
+var (
+	// ErrUnknownRoom is returned for names that are not a subscribable room
+	ErrUnknownRoom = errors.New("unknown room")
+
+	// ErrForbidden is returned when the user may not join the room
+	ErrForbidden = errors.New("not allowed to join this room")
+)
+
+// Room is a parsed room name
+type Room struct {
+	Name string
+	Kind Kind
+	// Zoom, X and Y locate a map tile
+	Zoom, X, Y int
+	// DynastyID is the dynasty of a dynasty room
+	DynastyID uint64
+	// ChatID is the chat of a support chat room
+	ChatID uint64
+}
+
+// Parse validates a room name a client wants to subscribe to:
+// map-tile:<zoom>/<x>/<y>, dynasty:<id> or support-chat:<id>. Users' own
+// rooms are joined on connect and cannot be subscribed to.
+func Parse(name string) (Room, error) {
+	switch {
+	case strings.HasPrefix(name, mapTilePrefix):
+		return parseMapTile(name)
+	case strings.HasPrefix(name, dynastyPrefix):
+		id, err := strconv.ParseUint(strings.TrimPrefix(name, dynastyPrefix), 10, 64)
+		if err != nil || id == 0 {
+			return Room{}, ErrUnknownRoom
+		}
+		return Room{Name: DynastyRoom(id), Kind: KindDynasty, DynastyID: id}, nil
+	case strings.HasPrefix(name, supportChatPrefix):
+		id, err := strconv.ParseUint(strings.TrimPrefix(name, supportChatPrefix), 10, 64)
+		if err != nil || id == 0 {
+			return Room{}, ErrUnknownRoom
+		}
+		return Room{Name: SupportChatRoom(id), Kind: KindSupportChat, ChatID: id}, nil
+	}
+	return Room{}, ErrUnknownRoom
+}
+
+func parseMapTile(name string) (Room, error) {
+	parts := strings.Split(strings.TrimPrefix(name, mapTilePrefix), "/")
+	if len(parts) != 3 {
+		return Room{}, ErrUnknownRoom
+	}
+	var coords [3]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return Room{}, ErrUnknownRoom
+		}
+		coords[i] = n
+	}
+	zoom, x, y := coords[0], coords[1], coords[2]
+	if zoom > MaxTileZoom || x >= 1<<zoom || y >= 1<<zoom {
+		return Room{}, ErrUnknownRoom
+	}
+	return Room{Name: MapTileRoom(zoom, x, y), Kind: KindMapTile, Zoom: zoom, X: x, Y: y}, nil
+}
+
+// MapTileRoom names the room of a slippy map tile
+func MapTileRoom(zoom, x, y int) string {
+	return fmt.Sprintf("%s%d/%d/%d", mapTilePrefix, zoom, x, y)
+}
+
+// DynastyRoom names the room of a dynasty
+func DynastyRoom(dynastyID uint64) string {
+	return fmt.Sprintf("%s%d", dynastyPrefix, dynastyID)
+}
+
+// SupportChatRoom names the room of a support chat
+func SupportChatRoom(chatID uint64) string {
+	return fmt.Sprintf("%s%d", supportChatPrefix, chatID)
+}
+
+// Authorizer decides who may join a room. Map tiles are public to signed in
+// users; dynasty rooms are limited to the members of the dynasty's family and
+// support chats to their user and the support agents.
+type Authorizer struct {
+	family dynastypb.FamilyServiceClient
+	chats  supportpb.SupportChatServiceClient
+}
+
+// NewAuthorizer creates an authorizer asking dynasty-service for family
+// members and support-service for chat participants
+func NewAuthorizer(family dynastypb.FamilyServiceClient, chats supportpb.SupportChatServiceClient) *Authorizer {
+	return &Authorizer{family: family, chats: chats}
+}
+
+// Authorize returns nil when userID may join room
+func (a *Authorizer) Authorize(ctx context.Context, userID uint64, room Room) error {
+	switch room.Kind {
+	case KindMapTile:
+		return nil
+	case KindDynasty:
+		return a.authorizeDynasty(ctx, userID, room.DynastyID)
+	case KindSupportChat:
+		return a.authorizeSupportChat(ctx, userID, room.ChatID)
+	}
+	return ErrUnknownRoom
+}
+
+func (a *Authorizer) authorizeDynasty(ctx context.Context, userID, dynastyID uint64) error {
+	family, err := a.family.GetFamily(ctx, &dynastypb.GetFamilyRequest{DynastyId: dynastyID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return ErrForbidden
+		}
+		return fmt.Errorf("failed to get family of dynasty %d: %w", dynastyID, err)
+	}
+	for _, member := range family.Members {
+		if member.UserId == userID {
+			return nil
+		}
+	}
+	return ErrForbidden
+}
+
+func (a *Authorizer) authorizeSupportChat(ctx context.Context, userID, chatID uint64) error {
+	_, err := a.chats.GetChat(ctx, &supportpb.GetChatRequest{ChatId: chatID, UserId: userID})
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.NotFound, codes.PermissionDenied:
+		return ErrForbidden
+	}
+	return fmt.Errorf("failed to get support chat %d: %w", chatID, err)
+}
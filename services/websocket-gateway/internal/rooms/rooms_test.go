@@ -0,0 +1,132 @@
+package rooms
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dynastypb "metargb/shared/pb/dynasty"
+	supportpb "metargb/shared/pb/support"
+)
+
+func TestParse(t *testing.T) {
+	tile, err := Parse("map-tile:3/7/0")
+	if err != nil || tile.Kind != KindMapTile || tile.Zoom != 3 || tile.X != 7 || tile.Y != 0 {
+		t.Fatalf("map tile got %+v %v", tile, err)
+	}
+
+	dynasty, err := Parse("dynasty:42")
+	if err != nil || dynasty.Kind != KindDynasty || dynasty.DynastyID != 42 || dynasty.Name != "dynasty:42" {
+		t.Fatalf("dynasty got %+v %v", dynasty, err)
+	}
+
+	chat, err := Parse("support-chat:9")
+	if err != nil || chat.Kind != KindSupportChat || chat.ChatID != 9 || chat.Name != "support-chat:9" {
+		t.Fatalf("support chat got %+v %v", chat, err)
+	}
+
+	for _, name := range []string{
+		"user:5",
+		"map-tile:3/8/0",
+		"map-tile:23/0/0",
+		"map-tile:3/-1/0",
+		"map-tile:3/1",
+		"dynasty:0",
+		"dynasty:abc",
+		"support-chat:0",
+		"support-chat:",
+		"",
+	} {
+		if _, err := Parse(name); !errors.Is(err, ErrUnknownRoom) {
+			t.Errorf("%q: expected ErrUnknownRoom, got %v", name, err)
+		}
+	}
+}
+
+type fakeFamilyClient struct {
+	dynastypb.FamilyServiceClient
+	members map[uint64][]uint64
+}
+
+func (f *fakeFamilyClient) GetFamily(ctx context.Context, in *dynastypb.GetFamilyRequest, opts ...grpc.CallOption) (*dynastypb.FamilyResponse, error) {
+	userIDs, ok := f.members[in.DynastyId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "family not found")
+	}
+	resp := &dynastypb.FamilyResponse{DynastyId: in.DynastyId}
+	for _, id := range userIDs {
+		resp.Members = append(resp.Members, &dynastypb.FamilyMember{UserId: id})
+	}
+	return resp, nil
+}
+
+func TestAuthorizeDynastyMembersOnly(t *testing.T) {
+	authorizer := NewAuthorizer(&fakeFamilyClient{members: map[uint64][]uint64{7: {1, 2}}}, nil)
+	ctx := context.Background()
+
+	room, _ := Parse("dynasty:7")
+	if err := authorizer.Authorize(ctx, 2, room); err != nil {
+		t.Fatalf("member refused: %v", err)
+	}
+	if err := authorizer.Authorize(ctx, 3, room); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected ErrForbidden for a non-member, got %v", err)
+	}
+
+	missing, _ := Parse("dynasty:8")
+	if err := authorizer.Authorize(ctx, 1, missing); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected ErrForbidden for an unknown dynasty, got %v", err)
+	}
+
+	tile, _ := Parse("map-tile:0/0/0")
+	if err := authorizer.Authorize(ctx, 3, tile); err != nil {
+		t.Fatalf("map tiles are public: %v", err)
+	}
+}
+
+type fakeSupportChatClient struct {
+	supportpb.SupportChatServiceClient
+	users map[uint64]uint64
+	err   error
+}
+
+func (f *fakeSupportChatClient) GetChat(ctx context.Context, in *supportpb.GetChatRequest, opts ...grpc.CallOption) (*supportpb.ChatSessionResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	userID, ok := f.users[in.ChatId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "chat not found")
+	}
+	if userID != in.UserId {
+		return nil, status.Error(codes.PermissionDenied, "not a participant")
+	}
+	return &supportpb.ChatSessionResponse{Id: in.ChatId, UserId: userID}, nil
+}
+
+func TestAuthorizeSupportChatParticipantsOnly(t *testing.T) {
+	chats := &fakeSupportChatClient{users: map[uint64]uint64{9: 4}}
+	authorizer := NewAuthorizer(nil, chats)
+	ctx := context.Background()
+
+	room, _ := Parse("support-chat:9")
+	if err := authorizer.Authorize(ctx, 4, room); err != nil {
+		t.Fatalf("participant refused: %v", err)
+	}
+	if err := authorizer.Authorize(ctx, 5, room); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected ErrForbidden for another user, got %v", err)
+	}
+
+	missing, _ := Parse("support-chat:10")
+	if err := authorizer.Authorize(ctx, 4, missing); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected ErrForbidden for an unknown chat, got %v", err)
+	}
+
+	chats.err = status.Error(codes.Unavailable, "connection refused")
+	if err := authorizer.Authorize(ctx, 4, room); err == nil || errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected an error other than ErrForbidden while support-service is down, got %v", err)
+	}
+}
@@ -0,0 +1,225 @@
+// Package router delivers the events services publish on Redis to the
+// connections in the matching rooms
+package router
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"metargb/websocket-gateway/internal/hub"
+	"metargb/websocket-gateway/internal/socketio"
+)
+
+// Redis channels the gateway subscribes to
+const (
+	ChannelUserStatus         = "user-status"
+	ChannelFeatureStatus      = "feature-status"
+	ChannelNotifications      = "notifications"
+	ChannelProfilePhotoStatus = "profile-photo-status"
+	// ChannelOrderStatus carries the payment state of an order, published by
+	// commercial-service while its owner waits on the payment redirect
+	ChannelOrderStatus = "order-status"
+	// ChannelRoomEvents carries {"room", "event", "data"} for map tile and
+	// dynasty rooms
+	ChannelRoomEvents = "room-events"
+	// ChannelHealthCanary is published by health-check-service and echoed on
+	// ChannelHealthCanaryAck, proving the subscription still receives messages
+	ChannelHealthCanary    = "health-canary"
+	ChannelHealthCanaryAck = "health-canary-ack"
+)
+
+// Channels lists every channel Route handles
+var Channels = []string{
+	ChannelUserStatus,
+	ChannelFeatureStatus,
+	ChannelNotifications,
+	ChannelProfilePhotoStatus,
+	ChannelOrderStatus,
+	ChannelRoomEvents,
+	ChannelHealthCanary,
+}
+
+// Publisher publishes a message on a Redis channel
+type Publisher func(ctx context.Context, channel string, payload []byte) error
+
+// Router maps Redis messages to socket events
+type Router struct {
+	hub     *hub.Hub
+	publish Publisher
+	now     func() time.Time
+}
+
+// New creates a router delivering to h. publish echoes health canaries.
+func New(h *hub.Hub, publish Publisher) *Router {
+	return &Router{hub: h, publish: publish, now: time.Now}
+}
+
+// RoomEvent is the payload of ChannelRoomEvents
+type RoomEvent struct {
+	Room  string          `json:"room"`
+	Event string          `json:"event"`
+	Data  json.RawMessage `json:"data"`
+}
+
+// Route delivers one message and returns how many connections of this
+// gateway received it
+func (r *Router) Route(ctx context.Context, channel string, payload []byte) (int, error) {
+	if channel == ChannelHealthCanary {
+		return 0, r.publish(ctx, ChannelHealthCanaryAck, payload)
+	}
+	if channel == ChannelRoomEvents {
+		return r.routeRoomEvent(payload)
+	}
+
+	data, err := decode(payload)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s message: %w", channel, err)
+	}
+
+	switch channel {
+	case ChannelUserStatus:
+		return r.toUser(data["user_id"], "user-status-changed", data)
+
+	case ChannelFeatureStatus:
+		// Both parties of an ownership change hear about it, told which one they are
+		delivered := 0
+		for _, party := range []struct{ key, userType string }{
+			{"old_owner_id", "old_owner"},
+			{"new_owner_id", "new_owner"},
+		} {
+			event := make(map[string]interface{}, len(data)+1)
+			for k, v := range data {
+				event[k] = v
+			}
+			event["userType"] = party.userType
+			n, err := r.toUser(data[party.key], "feature-status-changed", event)
+			if err != nil {
+				return delivered, err
+			}
+			delivered += n
+		}
+		return delivered, nil
+
+	case ChannelNotifications:
+		notificationData := data["data"]
+		if notificationData == nil {
+			notificationData = map[string]interface{}{}
+		}
+		return r.toUser(data["user_id"], "notification-received", map[string]interface{}{
+			"id":         data["id"],
+			"type":       data["type"],
+			"title":      data["title"],
+			"message":    data["message"],
+			"data":       notificationData,
+			"created_at": data["created_at"],
+			"timestamp":  r.now().UTC().Format(time.RFC3339),
+		})
+
+	case ChannelProfilePhotoStatus:
+		return r.toUser(data["user_id"], "profile-photo-status-changed", data)
+
+	case ChannelOrderStatus:
+		return r.toUser(data["user_id"], "order-status-changed", data)
+	}
+	return 0, fmt.Errorf("unknown channel %s", channel)
+}
+
+// Run subscribes to Channels and routes every message until ctx is
+// cancelled. subscribed is called once Redis confirmed the subscription;
+// messages that cannot be routed go to onError. go-redis resubscribes on its
+// own after a lost connection.
+func (r *Router) Run(ctx context.Context, client *redis.Client, subscribed func(), onError func(channel string, err error)) error {
+	sub := client.Subscribe(ctx, Channels...)
+	defer sub.Close()
+	if _, err := sub.Receive(ctx); err != nil {
+		return fmt.Errorf("failed to subscribe to Redis channels: %w", err)
+	}
+	subscribed()
+
+	messages := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case message, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			if _, err := r.Route(ctx, message.Channel, []byte(message.Payload)); err != nil {
+				onError(message.Channel, err)
+			}
+		}
+	}
+}
+
+func (r *Router) routeRoomEvent(payload []byte) (int, error) {
+	var event RoomEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return 0, fmt.Errorf("invalid %s message: %w", ChannelRoomEvents, err)
+	}
+	if event.Room == "" || event.Event == "" {
+		return 0, fmt.Errorf("invalid %s message: room and event are required", ChannelRoomEvents)
+	}
+	var data interface{} = event.Data
+	if len(event.Data) == 0 {
+		data = map[string]interface{}{}
+	}
+	frame, err := socketio.EventFrame(event.Event, data)
+	if err != nil {
+		return 0, err
+	}
+	return r.hub.Broadcast(event.Room, frame), nil
+}
+
+// toUser sends event to every connection of the user id held in rawID. Users
+// without a connection to this gateway are skipped; another gateway may hold
+// them.
+func (r *Router) toUser(rawID interface{}, event string, data interface{}) (int, error) {
+	userID, ok := userIDOf(rawID)
+	if !ok {
+		return 0, nil
+	}
+	if !r.hub.Online(userID) {
+		return 0, nil
+	}
+	frame, err := socketio.EventFrame(event, data)
+	if err != nil {
+		return 0, err
+	}
+	return r.hub.Broadcast(hub.UserRoom(userID), frame), nil
+}
+
+// decode keeps numbers as json.Number so large ids pass through unchanged
+func decode(payload []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var data map[string]interface{}
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// userIDOf reads a user id published as a number or a numeric string
+func userIDOf(raw interface{}) (uint64, bool) {
+	var s string
+	switch v := raw.(type) {
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	default:
+		return 0, false
+	}
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil || id == 0 {
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,94 @@
+package router
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"metargb/websocket-gateway/internal/hub"
+)
+
+type fakeMember struct {
+	id     string
+	frames []string
+}
+
+func (m *fakeMember) ID() string { return m.id }
+
+func (m *fakeMember) Send(frame []byte) { m.frames = append(m.frames, string(frame)) }
+
+func TestRouteTargetsUsers(t *testing.T) {
+	h := hub.New()
+	owner, buyer := &fakeMember{id: "a"}, &fakeMember{id: "b"}
+	h.Register(owner, 1)
+	h.Register(buyer, 2)
+	r := New(h, nil)
+	ctx := context.Background()
+
+	n, err := r.Route(ctx, ChannelNotifications, []byte(`{"user_id":1,"id":"n1","title":"Hi"}`))
+	if err != nil || n != 1 {
+		t.Fatalf("notification delivered to %d: %v", n, err)
+	}
+	if len(owner.frames) != 1 || !strings.HasPrefix(owner.frames[0], `42["notification-received",`) || !strings.Contains(owner.frames[0], `"data":{}`) {
+		t.Fatalf("owner frames %v", owner.frames)
+	}
+	if len(buyer.frames) != 0 {
+		t.Fatalf("notification leaked to another user: %v", buyer.frames)
+	}
+
+	n, err = r.Route(ctx, ChannelFeatureStatus, []byte(`{"feature_id":9,"old_owner_id":1,"new_owner_id":"2"}`))
+	if err != nil || n != 2 {
+		t.Fatalf("feature status delivered to %d: %v", n, err)
+	}
+	if !strings.Contains(owner.frames[1], `"userType":"old_owner"`) || !strings.Contains(buyer.frames[0], `"userType":"new_owner"`) {
+		t.Fatalf("feature status frames %v %v", owner.frames, buyer.frames)
+	}
+
+	n, err = r.Route(ctx, ChannelOrderStatus, []byte(`{"order_id":12,"user_id":2,"status":"paid"}`))
+	if err != nil || n != 1 {
+		t.Fatalf("order status delivered to %d: %v", n, err)
+	}
+	if !strings.HasPrefix(buyer.frames[1], `42["order-status-changed",`) || !strings.Contains(buyer.frames[1], `"order_id":12`) {
+		t.Fatalf("order status frames %v", buyer.frames)
+	}
+
+	// Users connected elsewhere are skipped
+	if n, err := r.Route(ctx, ChannelUserStatus, []byte(`{"user_id":3}`)); err != nil || n != 0 {
+		t.Fatalf("offline user got %d: %v", n, err)
+	}
+}
+
+func TestRouteRoomEvents(t *testing.T) {
+	h := hub.New()
+	viewer, other := &fakeMember{id: "a"}, &fakeMember{id: "b"}
+	h.Register(viewer, 1)
+	h.Register(other, 2)
+	h.Join(viewer, "map-tile:3/1/2")
+	r := New(h, nil)
+
+	n, err := r.Route(context.Background(), ChannelRoomEvents, []byte(`{"room":"map-tile:3/1/2","event":"feature-updated","data":{"feature_id":9}}`))
+	if err != nil || n != 1 {
+		t.Fatalf("room event delivered to %d: %v", n, err)
+	}
+	if viewer.frames[0] != `42["feature-updated",{"feature_id":9}]` || len(other.frames) != 0 {
+		t.Fatalf("frames %v %v", viewer.frames, other.frames)
+	}
+
+	if _, err := r.Route(context.Background(), ChannelRoomEvents, []byte(`{"event":"x"}`)); err == nil {
+		t.Fatal("expected an error for a room event without room")
+	}
+}
+
+func TestRouteEchoesHealthCanary(t *testing.T) {
+	var echoed string
+	r := New(hub.New(), func(ctx context.Context, channel string, payload []byte) error {
+		echoed = channel + " " + string(payload)
+		return nil
+	})
+	if _, err := r.Route(context.Background(), ChannelHealthCanary, []byte(`{"id":"c1"}`)); err != nil {
+		t.Fatal(err)
+	}
+	if echoed != `health-canary-ack {"id":"c1"}` {
+		t.Fatalf("echoed %q", echoed)
+	}
+}
@@ -0,0 +1,169 @@
+// Package socketio speaks the part of the Engine.IO v4 and Socket.IO v5
+// protocols the gateway needs, so socket.io-client keeps working against it:
+// the websocket transport, the default namespace, events and acknowledgements.
+// Long polling, binary packets and custom namespaces are not supported.
+package socketio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Engine.IO packet types, the first byte of every websocket frame
+const (
+	engineOpen    = '0'
+	engineClose   = '1'
+	enginePing    = '2'
+	enginePong    = '3'
+	engineMessage = '4'
+)
+
+// Socket.IO packet types, the first byte of an Engine.IO message
+const (
+	PacketConnect      byte = 0
+	PacketDisconnect   byte = 1
+	PacketEvent        byte = 2
+	PacketAck          byte = 3
+	PacketConnectError byte = 4
+	PacketBinaryEvent  byte = 5
+	PacketBinaryAck    byte = 6
+)
+
+// DefaultNamespace is the only namespace the gateway serves
+const DefaultNamespace = "/"
+
+// noAck marks a packet that does not ask for an acknowledgement
+const noAck = -1
+
+var (
+	// ErrMalformedPacket is returned for packets that cannot be decoded
+	ErrMalformedPacket = errors.New("malformed socket.io packet")
+
+	// ErrBinaryUnsupported is returned for binary events and acks
+	ErrBinaryUnsupported = errors.New("binary socket.io packets are not supported")
+)
+
+// Packet is a decoded Socket.IO packet
+type Packet struct {
+	Type      byte
+	Namespace string
+	// AckID is the id to acknowledge, or -1 when no ack was asked for
+	AckID int64
+	Data  json.RawMessage
+}
+
+// ParsePacket decodes a Socket.IO packet, the payload of an Engine.IO message:
+// <type>[<namespace>,][<ack id>][<json data>]
+func ParsePacket(p []byte) (Packet, error) {
+	if len(p) == 0 || p[0] < '0' || p[0] > '6' {
+		return Packet{}, ErrMalformedPacket
+	}
+	packet := Packet{Type: p[0] - '0', Namespace: DefaultNamespace, AckID: noAck}
+	if packet.Type == PacketBinaryEvent || packet.Type == PacketBinaryAck {
+		return Packet{}, ErrBinaryUnsupported
+	}
+
+	rest := p[1:]
+	if len(rest) > 0 && rest[0] == '/' {
+		end := len(rest)
+		for i, b := range rest {
+			if b == ',' {
+				end = i
+				break
+			}
+		}
+		packet.Namespace = string(rest[:end])
+		if end < len(rest) {
+			end++
+		}
+		rest = rest[end:]
+	}
+
+	digits := 0
+	for digits < len(rest) && rest[digits] >= '0' && rest[digits] <= '9' {
+		digits++
+	}
+	if digits > 0 {
+		id, err := strconv.ParseInt(string(rest[:digits]), 10, 64)
+		if err != nil {
+			return Packet{}, ErrMalformedPacket
+		}
+		packet.AckID = id
+		rest = rest[digits:]
+	}
+
+	if len(rest) > 0 {
+		if !json.Valid(rest) {
+			return Packet{}, ErrMalformedPacket
+		}
+		packet.Data = json.RawMessage(rest)
+	}
+	return packet, nil
+}
+
+// EventArgs splits the data of an event packet into its name and arguments
+func (p Packet) EventArgs() (string, []json.RawMessage, error) {
+	var args []json.RawMessage
+	if err := json.Unmarshal(p.Data, &args); err != nil || len(args) == 0 {
+		return "", nil, ErrMalformedPacket
+	}
+	var event string
+	if err := json.Unmarshal(args[0], &event); err != nil || event == "" {
+		return "", nil, ErrMalformedPacket
+	}
+	return event, args[1:], nil
+}
+
+// EventFrame encodes an event as a websocket frame. Encoding once lets the
+// same frame be sent to every connection in a room.
+func EventFrame(event string, data interface{}) ([]byte, error) {
+	payload, err := json.Marshal([]interface{}{event, data})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s event: %w", event, err)
+	}
+	return frame(PacketEvent, "", payload), nil
+}
+
+// ackFrame encodes the acknowledgement of packet id
+func ackFrame(id int64, args []interface{}) ([]byte, error) {
+	if args == nil {
+		args = []interface{}{}
+	}
+	payload, err := json.Marshal(args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode ack: %w", err)
+	}
+	return frame(PacketAck, strconv.FormatInt(id, 10), payload), nil
+}
+
+// connectFrame accepts a namespace connection under sid
+func connectFrame(sid string) []byte {
+	payload, _ := json.Marshal(map[string]string{"sid": sid})
+	return frame(PacketConnect, "", payload)
+}
+
+// connectErrorFrame refuses a namespace connection; socket.io-client reports
+// message through its connect_error event
+func connectErrorFrame(namespace, message string) []byte {
+	payload, _ := json.Marshal(map[string]string{"message": message})
+	prefix := ""
+	if namespace != DefaultNamespace {
+		prefix = namespace + ","
+	}
+	return frame(PacketConnectError, prefix, payload)
+}
+
+// disconnectFrame closes the namespace connection from the server side,
+// which socket.io-client does not reconnect after
+func disconnectFrame() []byte {
+	return frame(PacketDisconnect, "", nil)
+}
+
+func frame(packetType byte, prefix string, payload []byte) []byte {
+	out := make([]byte, 0, 2+len(prefix)+len(payload))
+	out = append(out, engineMessage, '0'+packetType)
+	out = append(out, prefix...)
+	return append(out, payload...)
+}
@@ -0,0 +1,86 @@
+package socketio
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParsePacket(t *testing.T) {
+	tests := []struct {
+		raw       string
+		wantType  byte
+		namespace string
+		ackID     int64
+		data      string
+	}{
+		{raw: `0`, wantType: PacketConnect, namespace: "/", ackID: -1},
+		{raw: `0{"token":"abc"}`, wantType: PacketConnect, namespace: "/", ackID: -1, data: `{"token":"abc"}`},
+		{raw: `2["ping"]`, wantType: PacketEvent, namespace: "/", ackID: -1, data: `["ping"]`},
+		{raw: `212["subscribe",{"room":"dynasty:3"}]`, wantType: PacketEvent, namespace: "/", ackID: 12, data: `["subscribe",{"room":"dynasty:3"}]`},
+		{raw: `2/admin,7["x"]`, wantType: PacketEvent, namespace: "/admin", ackID: 7, data: `["x"]`},
+		{raw: `0/admin`, wantType: PacketConnect, namespace: "/admin", ackID: -1},
+		{raw: `1`, wantType: PacketDisconnect, namespace: "/", ackID: -1},
+	}
+
+	for _, tt := range tests {
+		packet, err := ParsePacket([]byte(tt.raw))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.raw, err)
+		}
+		if packet.Type != tt.wantType || packet.Namespace != tt.namespace || packet.AckID != tt.ackID || string(packet.Data) != tt.data {
+			t.Errorf("%s: got %+v", tt.raw, packet)
+		}
+	}
+}
+
+func TestParsePacketRejects(t *testing.T) {
+	for _, raw := range []string{``, `9`, `2[broken`, `x`} {
+		if _, err := ParsePacket([]byte(raw)); !errors.Is(err, ErrMalformedPacket) {
+			t.Errorf("%q: expected ErrMalformedPacket, got %v", raw, err)
+		}
+	}
+	if _, err := ParsePacket([]byte(`51-["upload",{"_placeholder":true,"num":0}]`)); !errors.Is(err, ErrBinaryUnsupported) {
+		t.Errorf("expected ErrBinaryUnsupported, got %v", err)
+	}
+}
+
+func TestEventArgs(t *testing.T) {
+	packet, err := ParsePacket([]byte(`2["subscribe","map-tile:3/1/2"]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, args, err := packet.EventArgs()
+	if err != nil || event != "subscribe" || len(args) != 1 || string(args[0]) != `"map-tile:3/1/2"` {
+		t.Fatalf("got %q %s %v", event, args, err)
+	}
+
+	packet, _ = ParsePacket([]byte(`2[]`))
+	if _, _, err := packet.EventArgs(); !errors.Is(err, ErrMalformedPacket) {
+		t.Fatalf("expected ErrMalformedPacket for an event without name, got %v", err)
+	}
+}
+
+func TestFrames(t *testing.T) {
+	frame, err := EventFrame("notification-received", map[string]int{"id": 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(frame) != `42["notification-received",{"id":5}]` {
+		t.Errorf("event frame %s", frame)
+	}
+
+	ack, err := ackFrame(12, []interface{}{map[string]bool{"ok": true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(ack) != `4312[{"ok":true}]` {
+		t.Errorf("ack frame %s", ack)
+	}
+
+	if got := string(connectErrorFrame("/", "Authentication error: Invalid token")); got != `44{"message":"Authentication error: Invalid token"}` {
+		t.Errorf("connect error frame %s", got)
+	}
+	if got := string(connectErrorFrame("/admin", "Invalid namespace")); got != `44/admin,{"message":"Invalid namespace"}` {
+		t.Errorf("namespaced connect error frame %s", got)
+	}
+}
@@ -0,0 +1,410 @@
+package socketio
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Defaults of Options, the same as the socket.io server defaults
+const (
+	DefaultPingInterval = 25 * time.Second
+	DefaultPingTimeout  = 20 * time.Second
+	DefaultMaxPayload   = 1000000
+	DefaultSendBuffer   = 256
+)
+
+// connectTimeout is how long a client has to connect to the namespace after
+// the websocket opened
+const connectTimeout = 10 * time.Second
+
+// writeTimeout bounds every websocket write, so a stalled client cannot hold
+// its write loop forever
+const writeTimeout = 10 * time.Second
+
+// Handler reacts to the connections of a Server. Its methods are called from
+// the connection's read loop, one at a time per connection.
+type Handler interface {
+	// Connect authenticates c from the auth payload of its namespace connect
+	// packet. An error refuses the connection with the error as message.
+	Connect(c *Conn, auth json.RawMessage) error
+
+	// Connected is called once the client has been told it is connected, so
+	// events sent from here on reach it
+	Connected(c *Conn)
+
+	// Event handles an event from c. The returned arguments are sent back
+	// when the client asked for an acknowledgement.
+	Event(c *Conn, event string, args []json.RawMessage) []interface{}
+
+	// Disconnect is called once when a connected c goes away
+	Disconnect(c *Conn)
+}
+
+// Options tune a Server
+type Options struct {
+	PingInterval time.Duration
+	PingTimeout  time.Duration
+	MaxPayload   int64
+	// SendBuffer is how many frames may wait for a slow client before it is
+	// disconnected
+	SendBuffer int
+	// CheckOrigin decides which browser origins may connect; nil allows all
+	CheckOrigin func(r *http.Request) bool
+}
+
+// Server accepts Socket.IO clients over websockets
+type Server struct {
+	handler  Handler
+	opts     Options
+	upgrader websocket.Upgrader
+
+	mu      sync.Mutex
+	conns   map[*Conn]struct{}
+	closing bool
+}
+
+// NewServer creates a server dispatching to handler. Zero options use the
+// defaults.
+func NewServer(handler Handler, opts Options) *Server {
+	if opts.PingInterval <= 0 {
+		opts.PingInterval = DefaultPingInterval
+	}
+	if opts.PingTimeout <= 0 {
+		opts.PingTimeout = DefaultPingTimeout
+	}
+	if opts.MaxPayload <= 0 {
+		opts.MaxPayload = DefaultMaxPayload
+	}
+	if opts.SendBuffer <= 0 {
+		opts.SendBuffer = DefaultSendBuffer
+	}
+	checkOrigin := opts.CheckOrigin
+	if checkOrigin == nil {
+		checkOrigin = func(*http.Request) bool { return true }
+	}
+
+	return &Server{
+		handler:  handler,
+		opts:     opts,
+		upgrader: websocket.Upgrader{CheckOrigin: checkOrigin},
+		conns:    make(map[*Conn]struct{}),
+	}
+}
+
+// ServeHTTP upgrades an Engine.IO v4 websocket request and serves it until
+// the client goes away
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if query.Get("EIO") != "4" {
+		writeEngineError(w, 5, "Unsupported protocol version")
+		return
+	}
+	if query.Get("transport") != "websocket" {
+		// Clients have to use transports: ['websocket']
+		writeEngineError(w, 0, "Transport unknown")
+		return
+	}
+
+	ws, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader already answered the request
+		return
+	}
+	ws.SetReadLimit(s.opts.MaxPayload)
+
+	c := &Conn{
+		server:  s,
+		ws:      ws,
+		id:      newID(),
+		request: r,
+		send:    make(chan []byte, s.opts.SendBuffer),
+		done:    make(chan struct{}),
+	}
+	if !s.track(c) {
+		ws.Close()
+		return
+	}
+	defer s.untrack(c)
+
+	c.queue(c.openFrame())
+	go c.writeLoop()
+	c.readLoop()
+}
+
+// Connections returns the number of open connections
+func (s *Server) Connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.conns)
+}
+
+// Close disconnects every client and refuses new ones
+func (s *Server) Close() {
+	s.mu.Lock()
+	s.closing = true
+	conns := make([]*Conn, 0, len(s.conns))
+	for c := range s.conns {
+		conns = append(conns, c)
+	}
+	s.mu.Unlock()
+
+	for _, c := range conns {
+		c.Disconnect()
+	}
+}
+
+func (s *Server) track(c *Conn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closing {
+		return false
+	}
+	s.conns[c] = struct{}{}
+	return true
+}
+
+func (s *Server) untrack(c *Conn) {
+	s.mu.Lock()
+	delete(s.conns, c)
+	s.mu.Unlock()
+}
+
+// writeEngineError answers a request the Engine.IO way, which socket.io-client
+// reports as a connect_error
+func writeEngineError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(map[string]interface{}{"code": code, "message": message})
+}
+
+func newID() string {
+	b := make([]byte, 15)
+	rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Conn is one Socket.IO client
+type Conn struct {
+	server  *Server
+	ws      *websocket.Conn
+	id      string
+	request *http.Request
+	send    chan []byte
+
+	done      chan struct{}
+	closeOnce sync.Once
+	connected bool
+
+	// Data holds the handler's state of the connection. Only the Handler
+	// methods may use it, so it needs no locking.
+	Data interface{}
+}
+
+// ID returns the socket id, unique per connection
+func (c *Conn) ID() string {
+	return c.id
+}
+
+// Request returns the HTTP request that opened the connection
+func (c *Conn) Request() *http.Request {
+	return c.request
+}
+
+// Send queues a frame built by EventFrame. A client too slow to keep up with
+// its buffer is disconnected rather than stalling the sender.
+func (c *Conn) Send(frame []byte) {
+	if !c.queue(frame) {
+		c.close()
+	}
+}
+
+// Emit sends event with data to the client
+func (c *Conn) Emit(event string, data interface{}) error {
+	frame, err := EventFrame(event, data)
+	if err != nil {
+		return err
+	}
+	c.Send(frame)
+	return nil
+}
+
+// Disconnect closes the connection from the server side. socket.io-client
+// does not reconnect after it.
+func (c *Conn) Disconnect() {
+	c.queue(disconnectFrame())
+	c.close()
+}
+
+// Done is closed once the connection is closing
+func (c *Conn) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *Conn) queue(frame []byte) bool {
+	select {
+	case <-c.done:
+		return false
+	default:
+	}
+	select {
+	case c.send <- frame:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *Conn) close() {
+	c.closeOnce.Do(func() { close(c.done) })
+}
+
+func (c *Conn) openFrame() []byte {
+	payload, _ := json.Marshal(map[string]interface{}{
+		"sid":          newID(),
+		"upgrades":     []string{},
+		"pingInterval": c.server.opts.PingInterval.Milliseconds(),
+		"pingTimeout":  c.server.opts.PingTimeout.Milliseconds(),
+		"maxPayload":   c.server.opts.MaxPayload,
+	})
+	return append([]byte{engineOpen}, payload...)
+}
+
+// writeLoop is the only writer of the websocket. It sends queued frames and
+// pings, and flushes what is queued once the connection closes.
+func (c *Conn) writeLoop() {
+	ping := time.NewTicker(c.server.opts.PingInterval)
+	defer ping.Stop()
+	defer c.ws.Close()
+
+	for {
+		select {
+		case frame := <-c.send:
+			if c.write(frame) != nil {
+				c.close()
+				return
+			}
+		case <-ping.C:
+			if c.write([]byte{enginePing}) != nil {
+				c.close()
+				return
+			}
+		case <-c.done:
+			for {
+				select {
+				case frame := <-c.send:
+					if c.write(frame) != nil {
+						return
+					}
+				default:
+					c.ws.WriteControl(websocket.CloseMessage,
+						websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+						time.Now().Add(time.Second))
+					return
+				}
+			}
+		}
+	}
+}
+
+func (c *Conn) write(frame []byte) error {
+	c.ws.SetWriteDeadline(time.Now().Add(writeTimeout))
+	return c.ws.WriteMessage(websocket.TextMessage, frame)
+}
+
+// readLoop reads frames until the client goes away or misses its pongs
+func (c *Conn) readLoop() {
+	defer func() {
+		c.close()
+		if c.connected {
+			c.server.handler.Disconnect(c)
+		}
+	}()
+
+	// The first deadline is the namespace connect, later ones the next pong
+	c.ws.SetReadDeadline(time.Now().Add(connectTimeout))
+	go func() {
+		<-c.done
+		// Unblock ReadMessage once the write loop is closing the socket
+		c.ws.SetReadDeadline(time.Now().Add(2 * time.Second))
+	}()
+
+	for {
+		messageType, message, err := c.ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		if messageType != websocket.TextMessage || len(message) == 0 {
+			return
+		}
+		if c.connected {
+			c.ws.SetReadDeadline(time.Now().Add(c.server.opts.PingInterval + c.server.opts.PingTimeout))
+		}
+
+		switch message[0] {
+		case enginePing:
+			c.queue([]byte{enginePong})
+		case enginePong:
+		case engineClose:
+			return
+		case engineMessage:
+			if !c.handlePacket(message[1:]) {
+				return
+			}
+		default:
+			return
+		}
+	}
+}
+
+// handlePacket dispatches one Socket.IO packet and reports whether the
+// connection stays open
+func (c *Conn) handlePacket(raw []byte) bool {
+	packet, err := ParsePacket(raw)
+	if err != nil {
+		return false
+	}
+	if packet.Namespace != DefaultNamespace {
+		if packet.Type == PacketConnect {
+			c.queue(connectErrorFrame(packet.Namespace, "Invalid namespace"))
+		}
+		return true
+	}
+
+	switch packet.Type {
+	case PacketConnect:
+		if c.connected {
+			return true
+		}
+		if err := c.server.handler.Connect(c, packet.Data); err != nil {
+			c.queue(connectErrorFrame(DefaultNamespace, err.Error()))
+			return false
+		}
+		c.connected = true
+		c.ws.SetReadDeadline(time.Now().Add(c.server.opts.PingInterval + c.server.opts.PingTimeout))
+		c.queue(connectFrame(c.id))
+		c.server.handler.Connected(c)
+	case PacketDisconnect:
+		return false
+	case PacketEvent:
+		if !c.connected {
+			return false
+		}
+		event, args, err := packet.EventArgs()
+		if err != nil {
+			return false
+		}
+		ack := c.server.handler.Event(c, event, args)
+		if packet.AckID >= 0 {
+			if frame, err := ackFrame(packet.AckID, ack); err == nil {
+				c.Send(frame)
+			}
+		}
+	}
+	return true
+}
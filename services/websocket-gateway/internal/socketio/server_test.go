@@ -0,0 +1,127 @@
+package socketio
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+type testHandler struct {
+	disconnected chan string
+}
+
+func (h *testHandler) Connect(c *Conn, auth json.RawMessage) error {
+	var payload struct {
+		Token string `json:"token"`
+	}
+	json.Unmarshal(auth, &payload)
+	if payload.Token != "valid" {
+		return errors.New("Authentication error: Invalid token")
+	}
+	return nil
+}
+
+func (h *testHandler) Connected(c *Conn) {
+	c.Emit("connected", map[string]string{"id": c.ID()})
+}
+
+func (h *testHandler) Event(c *Conn, event string, args []json.RawMessage) []interface{} {
+	return []interface{}{map[string]string{"echo": event}}
+}
+
+func (h *testHandler) Disconnect(c *Conn) {
+	h.disconnected <- c.ID()
+}
+
+func dial(t *testing.T, server *httptest.Server) *websocket.Conn {
+	t.Helper()
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/socket.io/?EIO=4&transport=websocket"
+	ws, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	ws.SetReadDeadline(time.Now().Add(5 * time.Second))
+	return ws
+}
+
+func read(t *testing.T, ws *websocket.Conn) string {
+	t.Helper()
+	_, message, err := ws.ReadMessage()
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	return string(message)
+}
+
+func TestServerHandshakeEventsAndAcks(t *testing.T) {
+	handler := &testHandler{disconnected: make(chan string, 1)}
+	sockets := NewServer(handler, Options{})
+	server := httptest.NewServer(sockets)
+	defer server.Close()
+
+	ws := dial(t, server)
+	defer ws.Close()
+
+	if open := read(t, ws); !strings.HasPrefix(open, `0{`) || !strings.Contains(open, `"pingInterval":25000`) {
+		t.Fatalf("open packet %s", open)
+	}
+	ws.WriteMessage(websocket.TextMessage, []byte(`40{"token":"valid"}`))
+	if connect := read(t, ws); !strings.HasPrefix(connect, `40{"sid":`) {
+		t.Fatalf("connect packet %s", connect)
+	}
+	if welcome := read(t, ws); !strings.HasPrefix(welcome, `42["connected"`) {
+		t.Fatalf("welcome event %s", welcome)
+	}
+
+	ws.WriteMessage(websocket.TextMessage, []byte(`423["subscribe",{"room":"map-tile:1/0/0"}]`))
+	if ack := read(t, ws); ack != `433[{"echo":"subscribe"}]` {
+		t.Fatalf("ack %s", ack)
+	}
+
+	ws.WriteMessage(websocket.TextMessage, []byte(`41`))
+	select {
+	case <-handler.disconnected:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Disconnect was not called")
+	}
+}
+
+func TestServerRefusesInvalidToken(t *testing.T) {
+	handler := &testHandler{disconnected: make(chan string, 1)}
+	server := httptest.NewServer(NewServer(handler, Options{}))
+	defer server.Close()
+
+	ws := dial(t, server)
+	defer ws.Close()
+	read(t, ws)
+
+	ws.WriteMessage(websocket.TextMessage, []byte(`40{"token":"stolen"}`))
+	if refused := read(t, ws); refused != `44{"message":"Authentication error: Invalid token"}` {
+		t.Fatalf("connect error packet %s", refused)
+	}
+	select {
+	case <-handler.disconnected:
+		t.Fatal("Disconnect called for a connection that never connected")
+	default:
+	}
+}
+
+func TestServerRejectsPolling(t *testing.T) {
+	server := httptest.NewServer(NewServer(&testHandler{}, Options{}))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/socket.io/?EIO=4&transport=polling")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 for long polling, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,140 @@
+// Command db-split copies a service's tables from the shared metargb_db schema
+// into the service's own schema and verifies the copy with row counts and
+// CHECKSUM TABLE. The source schema is never modified, so a service is cut
+// over by pointing its <SERVICE>_DB_DSN at the new schema and rolled back by
+// unsetting it.
+//
+// It only prints the plan unless -apply is given:
+//
+//	db-split -service features-service [-target metargb_features] [-apply]
+//	db-split -service features-service -verify
+//
+// Stop writes to the service (scale it to zero) before the final -apply run,
+// otherwise rows written during the copy are lost and verification fails.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	shareddb "metargb/shared/pkg/db"
+	"metargb/shared/pkg/dbsplit"
+)
+
+func main() {
+	service := flag.String("service", "", "service whose tables are moved, e.g. features-service (required)")
+	source := flag.String("source", getEnv("DB_DATABASE", "metargb_db"), "shared schema to copy from")
+	target := flag.String("target", "", "schema to copy into (default metargb_<service>)")
+	apply := flag.Bool("apply", false, "create and copy the tables instead of only printing the plan")
+	verifyOnly := flag.Bool("verify", false, "only compare the existing copies with the source")
+	flag.Parse()
+
+	tables, ok := dbsplit.Ownership[*service]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown -service %q, expected one of: %s\n", *service, strings.Join(dbsplit.Services(), ", "))
+		os.Exit(2)
+	}
+	if *target == "" {
+		*target = dbsplit.SchemaName(*service)
+	}
+
+	if !*apply && !*verifyOnly {
+		printPlan(*service, *source, *target, tables)
+		return
+	}
+
+	// Connect to the server rather than a schema so both schemas are reachable
+	dsn := getEnv("DB_SPLIT_DSN", fmt.Sprintf("%s:%s@tcp(%s:%s)/?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
+		getEnv("DB_USER", "root"),
+		getEnv("DB_PASSWORD", ""),
+		getEnv("DB_HOST", "localhost"),
+		getEnv("DB_PORT", "3306"),
+	))
+
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	if err := shareddb.PingWithRetry(ctx, db, shareddb.RetryPolicyFromEnv()); err != nil {
+		log.Fatalf("Failed to ping database: %v", err)
+	}
+
+	splitter := dbsplit.New(db, *source)
+
+	if *apply {
+		for _, table := range tables {
+			result, err := splitter.Copy(ctx, *target, table)
+			if err != nil {
+				log.Fatalf("Copy failed: %v", err)
+			}
+			if result.Copied {
+				log.Printf("copied %s", table)
+			} else {
+				log.Printf("skipped %s: target already has rows", table)
+			}
+		}
+	}
+
+	failed := 0
+	for _, table := range tables {
+		result, err := splitter.Verify(ctx, *target, table)
+		if err != nil {
+			log.Printf("FAIL %s: %v", table, err)
+			failed++
+			continue
+		}
+
+		state := "OK  "
+		if !result.OK() {
+			state = "FAIL"
+			failed++
+		}
+		log.Printf("%s %s: rows %d/%d, checksum %s/%s", state, table,
+			result.SourceRows, result.TargetRows,
+			formatChecksum(result.SourceChecksum), formatChecksum(result.TargetChecksum))
+	}
+
+	if failed > 0 {
+		log.Fatalf("%d of %d tables do not match; keep %s on the shared schema", failed, len(tables), *service)
+	}
+	log.Printf("All %d tables match. Cut over with %s=<dsn for %s>", len(tables), shareddb.ServiceDSNEnv(*service), *target)
+}
+
+func printPlan(service, source, target string, tables []string) {
+	fmt.Printf("-- Dry run: %d tables of %s from %s to %s\n", len(tables), service, source, target)
+	for _, table := range tables {
+		statements, err := dbsplit.Statements(source, target, table)
+		if err != nil {
+			log.Fatalf("Invalid plan: %v", err)
+		}
+		for _, stmt := range statements {
+			fmt.Println(stmt + ";")
+		}
+	}
+	fmt.Printf("-- Shared tables left in %s: %s\n", source, strings.Join(dbsplit.Shared, ", "))
+	fmt.Println("-- Re-run with -apply to copy, then -verify before cutting over")
+}
+
+func formatChecksum(v sql.NullInt64) string {
+	if !v.Valid {
+		return "NULL"
+	}
+	return fmt.Sprintf("%d", v.Int64)
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,130 @@
+// Command importer migrates users, wallets, feature ownership and trades from
+// the legacy Laravel database into the microservice schema. Every migrated
+// row is recorded in legacy_id_map with its new id, so references are
+// rewritten, a failed run is resumed by running it again, and rows whose
+// user or feature was never migrated are reported instead of being inserted
+// as orphans. Rows an earlier manual migration already created are matched
+// (users by email, wallets by user, features by id) rather than duplicated.
+//
+// It only reports what would happen unless -apply is given:
+//
+//	LEGACY_DB_DSN=... importer [-entities users,wallets] [-apply]
+//	LEGACY_DB_DSN=... importer -verify
+//
+// To import an exported dump, load it into a scratch schema first and point
+// LEGACY_DB_DSN at it. Run the importer before moving tables out of the shared
+// schema with db-split.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	shareddb "metargb/shared/pkg/db"
+	"metargb/shared/pkg/legacyimport"
+)
+
+func main() {
+	entityList := flag.String("entities", "", "comma separated entities to import (default all: "+strings.Join(legacyimport.Names(), ",")+")")
+	apply := flag.Bool("apply", false, "write to the target instead of only reporting what would be imported")
+	verifyOnly := flag.Bool("verify", false, "only compare the target with the legacy database")
+	batchSize := flag.Int("batch", legacyimport.DefaultBatchSize, "legacy rows read at a time")
+	flag.Parse()
+
+	var names []string
+	if *entityList != "" {
+		names = strings.Split(*entityList, ",")
+	}
+	entities, err := legacyimport.Select(names)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v, expected one of: %s\n", err, strings.Join(legacyimport.Names(), ", "))
+		os.Exit(2)
+	}
+
+	legacyDSN := os.Getenv("LEGACY_DB_DSN")
+	if legacyDSN == "" {
+		fmt.Fprintln(os.Stderr, "LEGACY_DB_DSN is required")
+		os.Exit(2)
+	}
+	targetDSN := getEnv("IMPORT_TARGET_DSN", fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci",
+		getEnv("DB_USER", "root"),
+		getEnv("DB_PASSWORD", ""),
+		getEnv("DB_HOST", "localhost"),
+		getEnv("DB_PORT", "3306"),
+		getEnv("DB_DATABASE", "metargb_db"),
+	))
+
+	ctx := context.Background()
+	source := open(ctx, "legacy", legacyDSN)
+	defer source.Close()
+	target := open(ctx, "target", targetDSN)
+	defer target.Close()
+
+	importer := legacyimport.New(source, target, legacyimport.Options{DryRun: !*apply, BatchSize: *batchSize})
+
+	if !*verifyOnly {
+		if !*apply {
+			log.Printf("Dry run: nothing is written. Re-run with -apply to import")
+		}
+		results, err := importer.Run(ctx, entities)
+		for _, result := range results {
+			log.Printf("%s: read %d, inserted %d, matched %d (updated %d), already imported %d, orphaned %d",
+				result.Entity, result.Read, result.Inserted, result.Matched, result.Updated, result.Skipped, result.Orphaned)
+			for _, sample := range result.OrphanSamples {
+				log.Printf("  orphan %s", sample)
+			}
+		}
+		if err != nil {
+			log.Fatalf("Import stopped: %v. Fix the cause and run again to resume", err)
+		}
+		if !*apply {
+			return
+		}
+	}
+
+	checks, err := importer.Verify(ctx, entities)
+	if err != nil {
+		log.Fatalf("Verification failed: %v", err)
+	}
+	failed := 0
+	for _, check := range checks {
+		state := "OK  "
+		if !check.OK() {
+			state = "FAIL"
+			failed++
+		}
+		log.Printf("%s %s: %d of %d legacy rows mapped", state, check.Entity, check.Mapped, check.LegacyRows)
+		for _, column := range check.DanglingColumns() {
+			log.Printf("     %d rows with dangling %s", check.Dangling[column], column)
+		}
+	}
+	if failed > 0 {
+		log.Fatalf("%d of %d entities do not match", failed, len(checks))
+	}
+	log.Printf("All %d entities match", len(checks))
+}
+
+func open(ctx context.Context, name, dsn string) *sql.DB {
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		log.Fatalf("Failed to connect to %s database: %v", name, err)
+	}
+	if err := shareddb.PingWithRetry(ctx, db, shareddb.RetryPolicyFromEnv()); err != nil {
+		log.Fatalf("Failed to ping %s database: %v", name, err)
+	}
+	return db
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -835,6 +835,8 @@ type CallbackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
 	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`                                // Client IP, used for new device/IP login alerts
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"` // Client user agent, fingerprinted per user
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -883,6 +885,20 @@ func (x *CallbackRequest) GetCode() string {
 	return ""
 }
 
+func (x *CallbackRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *CallbackRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
 type CallbackResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -1005,6 +1021,9 @@ type UserResponse struct {
 	HourlyProfitTimePercentage float64 `protobuf:"fixed64,12,opt,name=hourly_profit_time_percentage,json=hourlyProfitTimePercentage,proto3" json:"hourly_profit_time_percentage,omitempty"`
 	VerifiedKyc                bool    `protobuf:"varint,13,opt,name=verified_kyc,json=verifiedKyc,proto3" json:"verified_kyc,omitempty"`
 	Birthdate                  string  `protobuf:"bytes,14,opt,name=birthdate,proto3" json:"birthdate,omitempty"`
+	EmailVerified              bool    `protobuf:"varint,15,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	PhoneVerified              bool    `protobuf:"varint,16,opt,name=phone_verified,json=phoneVerified,proto3" json:"phone_verified,omitempty"`
+	MustVerifyEmail            bool    `protobuf:"varint,17,opt,name=must_verify_email,json=mustVerifyEmail,proto3" json:"must_verify_email,omitempty"` // Email verification is required and the email is not verified yet
 	unknownFields              protoimpl.UnknownFields
 	sizeCache                  protoimpl.SizeCache
 }
@@ -1137,6 +1156,27 @@ func (x *UserResponse) GetBirthdate() string {
 	return ""
 }
 
+func (x *UserResponse) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *UserResponse) GetPhoneVerified() bool {
+	if x != nil {
+		return x.PhoneVerified
+	}
+	return false
+}
+
+func (x *UserResponse) GetMustVerifyEmail() bool {
+	if x != nil {
+		return x.MustVerifyEmail
+	}
+	return false
+}
+
 type LogoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -1230,6 +1270,8 @@ type ValidateTokenResponse struct {
 	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
 	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix seconds when the token expires, by lifetime or inactivity
+	Scopes        []string               `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`                         // ["*"] for login tokens
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1285,6 +1327,20 @@ func (x *ValidateTokenResponse) GetEmail() string {
 	return ""
 }
 
+func (x *ValidateTokenResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *ValidateTokenResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
 type RequestAccountSecurityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1457,30 +1513,30 @@ func (x *GetUserRequest) GetUserId() uint64 {
 	return 0
 }
 
-type UpdateProfileRequest struct {
+// GetUserInfo returns a small snapshot of a user for other services, so they
+// do not need to read the users, kycs and user_variables tables directly
+type GetUserInfoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // Looked up by code when user_id is 0
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProfileRequest) Reset() {
-	*x = UpdateProfileRequest{}
+func (x *GetUserInfoRequest) Reset() {
+	*x = GetUserInfoRequest{}
 	mi := &file_auth_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProfileRequest) String() string {
+func (x *GetUserInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProfileRequest) ProtoMessage() {}
+func (*GetUserInfoRequest) ProtoMessage() {}
 
-func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserInfoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1492,60 +1548,51 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetUserInfoRequest) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *UpdateProfileRequest) GetUserId() uint64 {
+func (x *GetUserInfoRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *UpdateProfileRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UpdateProfileRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *UpdateProfileRequest) GetPhone() string {
+func (x *GetUserInfoRequest) GetCode() string {
 	if x != nil {
-		return x.Phone
+		return x.Code
 	}
 	return ""
 }
 
-type GetUserWalletRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UserInfo struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code               string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Birthdate          string                 `protobuf:"bytes,4,opt,name=birthdate,proto3" json:"birthdate,omitempty"` // KYC birthdate as YYYY-MM-DD, empty when unknown
+	WithdrawProfitDays int32                  `protobuf:"varint,5,opt,name=withdraw_profit_days,json=withdrawProfitDays,proto3" json:"withdraw_profit_days,omitempty"`
+	Timezone           string                 `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA name from the user's settings, empty until they choose one
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetUserWalletRequest) Reset() {
-	*x = GetUserWalletRequest{}
+func (x *UserInfo) Reset() {
+	*x = UserInfo{}
 	mi := &file_auth_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserWalletRequest) String() string {
+func (x *UserInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserWalletRequest) ProtoMessage() {}
+func (*UserInfo) ProtoMessage() {}
 
-func (x *GetUserWalletRequest) ProtoReflect() protoreflect.Message {
+func (x *UserInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1557,131 +1604,121 @@ func (x *GetUserWalletRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserWalletRequest.ProtoReflect.Descriptor instead.
-func (*GetUserWalletRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
+func (*UserInfo) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetUserWalletRequest) GetUserId() uint64 {
+func (x *UserInfo) GetId() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-type UserWalletResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Psc           string                 `protobuf:"bytes,1,opt,name=psc,proto3" json:"psc,omitempty"`
-	Irr           string                 `protobuf:"bytes,2,opt,name=irr,proto3" json:"irr,omitempty"`
-	Red           string                 `protobuf:"bytes,3,opt,name=red,proto3" json:"red,omitempty"`
-	Blue          string                 `protobuf:"bytes,4,opt,name=blue,proto3" json:"blue,omitempty"`
-	Yellow        string                 `protobuf:"bytes,5,opt,name=yellow,proto3" json:"yellow,omitempty"`
-	Satisfaction  string                 `protobuf:"bytes,6,opt,name=satisfaction,proto3" json:"satisfaction,omitempty"`
-	Effect        float64                `protobuf:"fixed64,7,opt,name=effect,proto3" json:"effect,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UserWalletResponse) Reset() {
-	*x = UserWalletResponse{}
-	mi := &file_auth_proto_msgTypes[22]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UserWalletResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UserWalletResponse) ProtoMessage() {}
-
-func (x *UserWalletResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[22]
+func (x *UserInfo) GetCode() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Code
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UserWalletResponse.ProtoReflect.Descriptor instead.
-func (*UserWalletResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{22}
+	return ""
 }
 
-func (x *UserWalletResponse) GetPsc() string {
+func (x *UserInfo) GetName() string {
 	if x != nil {
-		return x.Psc
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UserWalletResponse) GetIrr() string {
+func (x *UserInfo) GetBirthdate() string {
 	if x != nil {
-		return x.Irr
+		return x.Birthdate
 	}
 	return ""
 }
 
-func (x *UserWalletResponse) GetRed() string {
+func (x *UserInfo) GetWithdrawProfitDays() int32 {
 	if x != nil {
-		return x.Red
+		return x.WithdrawProfitDays
 	}
-	return ""
+	return 0
 }
 
-func (x *UserWalletResponse) GetBlue() string {
+func (x *UserInfo) GetTimezone() string {
 	if x != nil {
-		return x.Blue
+		return x.Timezone
 	}
 	return ""
 }
 
-func (x *UserWalletResponse) GetYellow() string {
-	if x != nil {
-		return x.Yellow
-	}
-	return ""
+// GetPresence reports whether users have an open websocket connection and
+// when they were last seen. Users who hide their status are reported offline
+// without a last_seen.
+type GetPresenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []uint64               `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"` // At most 100 ids
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserWalletResponse) GetSatisfaction() string {
+func (x *GetPresenceRequest) Reset() {
+	*x = GetPresenceRequest{}
+	mi := &file_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresenceRequest) ProtoMessage() {}
+
+func (x *GetPresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[22]
 	if x != nil {
-		return x.Satisfaction
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserWalletResponse) GetEffect() float64 {
+// Deprecated: Use GetPresenceRequest.ProtoReflect.Descriptor instead.
+func (*GetPresenceRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPresenceRequest) GetUserIds() []uint64 {
 	if x != nil {
-		return x.Effect
+		return x.UserIds
 	}
-	return 0
+	return nil
 }
 
-type GetUserLevelRequest struct {
+type GetPresenceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Data          []*UserPresence        `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"` // In request order, unknown users are omitted
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserLevelRequest) Reset() {
-	*x = GetUserLevelRequest{}
+func (x *GetPresenceResponse) Reset() {
+	*x = GetPresenceResponse{}
 	mi := &file_auth_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserLevelRequest) String() string {
+func (x *GetPresenceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserLevelRequest) ProtoMessage() {}
+func (*GetPresenceResponse) ProtoMessage() {}
 
-func (x *GetUserLevelRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPresenceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1693,41 +1730,41 @@ func (x *GetUserLevelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserLevelRequest.ProtoReflect.Descriptor instead.
-func (*GetUserLevelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPresenceResponse.ProtoReflect.Descriptor instead.
+func (*GetPresenceResponse) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetUserLevelRequest) GetUserId() uint64 {
+func (x *GetPresenceResponse) GetData() []*UserPresence {
 	if x != nil {
-		return x.UserId
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-type UserLevelResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Level            *Level                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
-	Score            int32                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
-	PercentageToNext float64                `protobuf:"fixed64,3,opt,name=percentage_to_next,json=percentageToNext,proto3" json:"percentage_to_next,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type UserPresence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Online        bool                   `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
+	LastSeen      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"` // Unset when hidden or never seen
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserLevelResponse) Reset() {
-	*x = UserLevelResponse{}
+func (x *UserPresence) Reset() {
+	*x = UserPresence{}
 	mi := &file_auth_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserLevelResponse) String() string {
+func (x *UserPresence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserLevelResponse) ProtoMessage() {}
+func (*UserPresence) ProtoMessage() {}
 
-func (x *UserLevelResponse) ProtoReflect() protoreflect.Message {
+func (x *UserPresence) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1739,53 +1776,56 @@ func (x *UserLevelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserLevelResponse.ProtoReflect.Descriptor instead.
-func (*UserLevelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserPresence.ProtoReflect.Descriptor instead.
+func (*UserPresence) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *UserLevelResponse) GetLevel() *Level {
+func (x *UserPresence) GetUserId() uint64 {
 	if x != nil {
-		return x.Level
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-func (x *UserLevelResponse) GetScore() int32 {
+func (x *UserPresence) GetOnline() bool {
 	if x != nil {
-		return x.Score
+		return x.Online
 	}
-	return 0
+	return false
 }
 
-func (x *UserLevelResponse) GetPercentageToNext() float64 {
+func (x *UserPresence) GetLastSeen() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PercentageToNext
+		return x.LastSeen
 	}
-	return 0
+	return nil
 }
 
-type GetKYCRequest struct {
+type UpdateProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetKYCRequest) Reset() {
-	*x = GetKYCRequest{}
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
 	mi := &file_auth_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetKYCRequest) String() string {
+func (x *UpdateProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKYCRequest) ProtoMessage() {}
+func (*UpdateProfileRequest) ProtoMessage() {}
 
-func (x *GetKYCRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1797,50 +1837,60 @@ func (x *GetKYCRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetKYCRequest.ProtoReflect.Descriptor instead.
-func (*GetKYCRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetKYCRequest) GetUserId() uint64 {
+func (x *UpdateProfileRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-type UpdateKYCRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	UserId               uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Fname                string                 `protobuf:"bytes,2,opt,name=fname,proto3" json:"fname,omitempty"`
-	Lname                string                 `protobuf:"bytes,3,opt,name=lname,proto3" json:"lname,omitempty"`
-	MelliCode            string                 `protobuf:"bytes,4,opt,name=melli_code,json=melliCode,proto3" json:"melli_code,omitempty"`
-	Birthdate            string                 `protobuf:"bytes,5,opt,name=birthdate,proto3" json:"birthdate,omitempty"` // Jalali date format: Y/m/d
-	Province             string                 `protobuf:"bytes,6,opt,name=province,proto3" json:"province,omitempty"`
-	MelliCardData        []byte                 `protobuf:"bytes,7,opt,name=melli_card_data,json=melliCardData,proto3" json:"melli_card_data,omitempty"`                        // Image file data (PNG/JPEG, max 5MB)
-	MelliCardFilename    string                 `protobuf:"bytes,8,opt,name=melli_card_filename,json=melliCardFilename,proto3" json:"melli_card_filename,omitempty"`            // Original filename
-	MelliCardContentType string                 `protobuf:"bytes,9,opt,name=melli_card_content_type,json=melliCardContentType,proto3" json:"melli_card_content_type,omitempty"` // MIME type (image/png, image/jpeg)
-	Video                *VideoInfo             `protobuf:"bytes,10,opt,name=video,proto3" json:"video,omitempty"`
-	VerifyTextId         uint64                 `protobuf:"varint,11,opt,name=verify_text_id,json=verifyTextId,proto3" json:"verify_text_id,omitempty"`
-	Gender               string                 `protobuf:"bytes,12,opt,name=gender,proto3" json:"gender,omitempty"` // male, female, other
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
-}
-
-func (x *UpdateKYCRequest) Reset() {
-	*x = UpdateKYCRequest{}
+func (x *UpdateProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type GetUserWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserWalletRequest) Reset() {
+	*x = GetUserWalletRequest{}
 	mi := &file_auth_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateKYCRequest) String() string {
+func (x *GetUserWalletRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateKYCRequest) ProtoMessage() {}
+func (*GetUserWalletRequest) ProtoMessage() {}
 
-func (x *UpdateKYCRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserWalletRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1852,118 +1902,132 @@ func (x *UpdateKYCRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateKYCRequest.ProtoReflect.Descriptor instead.
-func (*UpdateKYCRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserWalletRequest.ProtoReflect.Descriptor instead.
+func (*GetUserWalletRequest) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UpdateKYCRequest) GetUserId() uint64 {
+func (x *GetUserWalletRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *UpdateKYCRequest) GetFname() string {
-	if x != nil {
-		return x.Fname
-	}
-	return ""
+type UserWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Psc           string                 `protobuf:"bytes,1,opt,name=psc,proto3" json:"psc,omitempty"`
+	Irr           string                 `protobuf:"bytes,2,opt,name=irr,proto3" json:"irr,omitempty"`
+	Red           string                 `protobuf:"bytes,3,opt,name=red,proto3" json:"red,omitempty"`
+	Blue          string                 `protobuf:"bytes,4,opt,name=blue,proto3" json:"blue,omitempty"`
+	Yellow        string                 `protobuf:"bytes,5,opt,name=yellow,proto3" json:"yellow,omitempty"`
+	Satisfaction  string                 `protobuf:"bytes,6,opt,name=satisfaction,proto3" json:"satisfaction,omitempty"`
+	Effect        float64                `protobuf:"fixed64,7,opt,name=effect,proto3" json:"effect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateKYCRequest) GetLname() string {
-	if x != nil {
-		return x.Lname
-	}
-	return ""
+func (x *UserWalletResponse) Reset() {
+	*x = UserWalletResponse{}
+	mi := &file_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateKYCRequest) GetMelliCode() string {
-	if x != nil {
-		return x.MelliCode
-	}
-	return ""
+func (x *UserWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateKYCRequest) GetBirthdate() string {
+func (*UserWalletResponse) ProtoMessage() {}
+
+func (x *UserWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[27]
 	if x != nil {
-		return x.Birthdate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateKYCRequest) GetProvince() string {
+// Deprecated: Use UserWalletResponse.ProtoReflect.Descriptor instead.
+func (*UserWalletResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UserWalletResponse) GetPsc() string {
 	if x != nil {
-		return x.Province
+		return x.Psc
 	}
 	return ""
 }
 
-func (x *UpdateKYCRequest) GetMelliCardData() []byte {
+func (x *UserWalletResponse) GetIrr() string {
 	if x != nil {
-		return x.MelliCardData
+		return x.Irr
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateKYCRequest) GetMelliCardFilename() string {
+func (x *UserWalletResponse) GetRed() string {
 	if x != nil {
-		return x.MelliCardFilename
+		return x.Red
 	}
 	return ""
 }
 
-func (x *UpdateKYCRequest) GetMelliCardContentType() string {
+func (x *UserWalletResponse) GetBlue() string {
 	if x != nil {
-		return x.MelliCardContentType
+		return x.Blue
 	}
 	return ""
 }
 
-func (x *UpdateKYCRequest) GetVideo() *VideoInfo {
+func (x *UserWalletResponse) GetYellow() string {
 	if x != nil {
-		return x.Video
+		return x.Yellow
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateKYCRequest) GetVerifyTextId() uint64 {
+func (x *UserWalletResponse) GetSatisfaction() string {
 	if x != nil {
-		return x.VerifyTextId
+		return x.Satisfaction
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateKYCRequest) GetGender() string {
+func (x *UserWalletResponse) GetEffect() float64 {
 	if x != nil {
-		return x.Gender
+		return x.Effect
 	}
-	return ""
+	return 0
 }
 
-type VideoInfo struct {
+type GetUserLevelRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VideoInfo) Reset() {
-	*x = VideoInfo{}
-	mi := &file_auth_proto_msgTypes[27]
+func (x *GetUserLevelRequest) Reset() {
+	*x = GetUserLevelRequest{}
+	mi := &file_auth_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VideoInfo) String() string {
+func (x *GetUserLevelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VideoInfo) ProtoMessage() {}
+func (*GetUserLevelRequest) ProtoMessage() {}
 
-func (x *VideoInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[27]
+func (x *GetUserLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1974,57 +2038,42 @@ func (x *VideoInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VideoInfo.ProtoReflect.Descriptor instead.
-func (*VideoInfo) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *VideoInfo) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+// Deprecated: Use GetUserLevelRequest.ProtoReflect.Descriptor instead.
+func (*GetUserLevelRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *VideoInfo) GetName() string {
+func (x *GetUserLevelRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Name
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-type KYCResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	MelliCard     string                 `protobuf:"bytes,2,opt,name=melli_card,json=melliCard,proto3" json:"melli_card,omitempty"`
-	Fname         string                 `protobuf:"bytes,3,opt,name=fname,proto3" json:"fname,omitempty"`
-	Lname         string                 `protobuf:"bytes,4,opt,name=lname,proto3" json:"lname,omitempty"`
-	MelliCode     string                 `protobuf:"bytes,5,opt,name=melli_code,json=melliCode,proto3" json:"melli_code,omitempty"`
-	Birthdate     string                 `protobuf:"bytes,6,opt,name=birthdate,proto3" json:"birthdate,omitempty"` // Jalali date format: Y/m/d
-	Province      string                 `protobuf:"bytes,7,opt,name=province,proto3" json:"province,omitempty"`
-	Status        int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
-	Video         string                 `protobuf:"bytes,9,opt,name=video,proto3" json:"video,omitempty"`
-	Errors        string                 `protobuf:"bytes,10,opt,name=errors,proto3" json:"errors,omitempty"`
-	Gender        string                 `protobuf:"bytes,11,opt,name=gender,proto3" json:"gender,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UserLevelResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Level            *Level                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	Score            int32                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	PercentageToNext float64                `protobuf:"fixed64,3,opt,name=percentage_to_next,json=percentageToNext,proto3" json:"percentage_to_next,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *KYCResponse) Reset() {
-	*x = KYCResponse{}
-	mi := &file_auth_proto_msgTypes[28]
+func (x *UserLevelResponse) Reset() {
+	*x = UserLevelResponse{}
+	mi := &file_auth_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *KYCResponse) String() string {
+func (x *UserLevelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KYCResponse) ProtoMessage() {}
+func (*UserLevelResponse) ProtoMessage() {}
 
-func (x *KYCResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[28]
+func (x *UserLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2035,110 +2084,109 @@ func (x *KYCResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KYCResponse.ProtoReflect.Descriptor instead.
-func (*KYCResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UserLevelResponse.ProtoReflect.Descriptor instead.
+func (*UserLevelResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *KYCResponse) GetId() uint64 {
+func (x *UserLevelResponse) GetLevel() *Level {
 	if x != nil {
-		return x.Id
+		return x.Level
 	}
-	return 0
+	return nil
 }
 
-func (x *KYCResponse) GetMelliCard() string {
+func (x *UserLevelResponse) GetScore() int32 {
 	if x != nil {
-		return x.MelliCard
+		return x.Score
 	}
-	return ""
+	return 0
 }
 
-func (x *KYCResponse) GetFname() string {
+func (x *UserLevelResponse) GetPercentageToNext() float64 {
 	if x != nil {
-		return x.Fname
+		return x.PercentageToNext
 	}
-	return ""
+	return 0
 }
 
-func (x *KYCResponse) GetLname() string {
-	if x != nil {
-		return x.Lname
-	}
-	return ""
+type GetKYCRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *KYCResponse) GetMelliCode() string {
-	if x != nil {
-		return x.MelliCode
-	}
-	return ""
+func (x *GetKYCRequest) Reset() {
+	*x = GetKYCRequest{}
+	mi := &file_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *KYCResponse) GetBirthdate() string {
-	if x != nil {
-		return x.Birthdate
-	}
-	return ""
+func (x *GetKYCRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *KYCResponse) GetProvince() string {
-	if x != nil {
-		return x.Province
-	}
-	return ""
-}
-
-func (x *KYCResponse) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
+func (*GetKYCRequest) ProtoMessage() {}
 
-func (x *KYCResponse) GetVideo() string {
+func (x *GetKYCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[30]
 	if x != nil {
-		return x.Video
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *KYCResponse) GetErrors() string {
-	if x != nil {
-		return x.Errors
-	}
-	return ""
+// Deprecated: Use GetKYCRequest.ProtoReflect.Descriptor instead.
+func (*GetKYCRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *KYCResponse) GetGender() string {
+func (x *GetKYCRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Gender
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-type ListBankAccountsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateKYCRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Fname                string                 `protobuf:"bytes,2,opt,name=fname,proto3" json:"fname,omitempty"`
+	Lname                string                 `protobuf:"bytes,3,opt,name=lname,proto3" json:"lname,omitempty"`
+	MelliCode            string                 `protobuf:"bytes,4,opt,name=melli_code,json=melliCode,proto3" json:"melli_code,omitempty"`
+	Birthdate            string                 `protobuf:"bytes,5,opt,name=birthdate,proto3" json:"birthdate,omitempty"` // Jalali date format: Y/m/d
+	Province             string                 `protobuf:"bytes,6,opt,name=province,proto3" json:"province,omitempty"`
+	MelliCardData        []byte                 `protobuf:"bytes,7,opt,name=melli_card_data,json=melliCardData,proto3" json:"melli_card_data,omitempty"`                        // Image file data (PNG/JPEG, max 5MB)
+	MelliCardFilename    string                 `protobuf:"bytes,8,opt,name=melli_card_filename,json=melliCardFilename,proto3" json:"melli_card_filename,omitempty"`            // Original filename
+	MelliCardContentType string                 `protobuf:"bytes,9,opt,name=melli_card_content_type,json=melliCardContentType,proto3" json:"melli_card_content_type,omitempty"` // MIME type (image/png, image/jpeg)
+	Video                *VideoInfo             `protobuf:"bytes,10,opt,name=video,proto3" json:"video,omitempty"`
+	VerifyTextId         uint64                 `protobuf:"varint,11,opt,name=verify_text_id,json=verifyTextId,proto3" json:"verify_text_id,omitempty"`
+	Gender               string                 `protobuf:"bytes,12,opt,name=gender,proto3" json:"gender,omitempty"` // male, female, other
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *ListBankAccountsRequest) Reset() {
-	*x = ListBankAccountsRequest{}
-	mi := &file_auth_proto_msgTypes[29]
+func (x *UpdateKYCRequest) Reset() {
+	*x = UpdateKYCRequest{}
+	mi := &file_auth_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBankAccountsRequest) String() string {
+func (x *UpdateKYCRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBankAccountsRequest) ProtoMessage() {}
+func (*UpdateKYCRequest) ProtoMessage() {}
 
-func (x *ListBankAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[29]
+func (x *UpdateKYCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2149,152 +2197,117 @@ func (x *ListBankAccountsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBankAccountsRequest.ProtoReflect.Descriptor instead.
-func (*ListBankAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use UpdateKYCRequest.ProtoReflect.Descriptor instead.
+func (*UpdateKYCRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListBankAccountsRequest) GetUserId() uint64 {
+func (x *UpdateKYCRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-type ListBankAccountsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*BankAccountResponse `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateKYCRequest) GetFname() string {
+	if x != nil {
+		return x.Fname
+	}
+	return ""
 }
 
-func (x *ListBankAccountsResponse) Reset() {
-	*x = ListBankAccountsResponse{}
-	mi := &file_auth_proto_msgTypes[30]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpdateKYCRequest) GetLname() string {
+	if x != nil {
+		return x.Lname
+	}
+	return ""
 }
 
-func (x *ListBankAccountsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateKYCRequest) GetMelliCode() string {
+	if x != nil {
+		return x.MelliCode
+	}
+	return ""
 }
 
-func (*ListBankAccountsResponse) ProtoMessage() {}
-
-func (x *ListBankAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[30]
+func (x *UpdateKYCRequest) GetBirthdate() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Birthdate
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListBankAccountsResponse.ProtoReflect.Descriptor instead.
-func (*ListBankAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{30}
+func (x *UpdateKYCRequest) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
 }
 
-func (x *ListBankAccountsResponse) GetData() []*BankAccountResponse {
+func (x *UpdateKYCRequest) GetMelliCardData() []byte {
 	if x != nil {
-		return x.Data
+		return x.MelliCardData
 	}
 	return nil
 }
 
-type CreateBankAccountRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	BankName      string                 `protobuf:"bytes,2,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
-	ShabaNum      string                 `protobuf:"bytes,3,opt,name=shaba_num,json=shabaNum,proto3" json:"shaba_num,omitempty"`
-	CardNum       string                 `protobuf:"bytes,4,opt,name=card_num,json=cardNum,proto3" json:"card_num,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateBankAccountRequest) Reset() {
-	*x = CreateBankAccountRequest{}
-	mi := &file_auth_proto_msgTypes[31]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateBankAccountRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateBankAccountRequest) ProtoMessage() {}
-
-func (x *CreateBankAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[31]
+func (x *UpdateKYCRequest) GetMelliCardFilename() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MelliCardFilename
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateBankAccountRequest.ProtoReflect.Descriptor instead.
-func (*CreateBankAccountRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{31}
+	return ""
 }
 
-func (x *CreateBankAccountRequest) GetUserId() uint64 {
+func (x *UpdateKYCRequest) GetMelliCardContentType() string {
 	if x != nil {
-		return x.UserId
+		return x.MelliCardContentType
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateBankAccountRequest) GetBankName() string {
+func (x *UpdateKYCRequest) GetVideo() *VideoInfo {
 	if x != nil {
-		return x.BankName
+		return x.Video
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateBankAccountRequest) GetShabaNum() string {
+func (x *UpdateKYCRequest) GetVerifyTextId() uint64 {
 	if x != nil {
-		return x.ShabaNum
+		return x.VerifyTextId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateBankAccountRequest) GetCardNum() string {
+func (x *UpdateKYCRequest) GetGender() string {
 	if x != nil {
-		return x.CardNum
+		return x.Gender
 	}
 	return ""
 }
 
-type GetBankAccountRequest struct {
+type VideoInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	BankAccountId uint64                 `protobuf:"varint,2,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBankAccountRequest) Reset() {
-	*x = GetBankAccountRequest{}
+func (x *VideoInfo) Reset() {
+	*x = VideoInfo{}
 	mi := &file_auth_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBankAccountRequest) String() string {
+func (x *VideoInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBankAccountRequest) ProtoMessage() {}
+func (*VideoInfo) ProtoMessage() {}
 
-func (x *GetBankAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *VideoInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2306,50 +2319,56 @@ func (x *GetBankAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBankAccountRequest.ProtoReflect.Descriptor instead.
-func (*GetBankAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VideoInfo.ProtoReflect.Descriptor instead.
+func (*VideoInfo) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetBankAccountRequest) GetUserId() uint64 {
+func (x *VideoInfo) GetPath() string {
 	if x != nil {
-		return x.UserId
+		return x.Path
 	}
-	return 0
+	return ""
 }
 
-func (x *GetBankAccountRequest) GetBankAccountId() uint64 {
+func (x *VideoInfo) GetName() string {
 	if x != nil {
-		return x.BankAccountId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type UpdateBankAccountRequest struct {
+type KYCResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	BankAccountId uint64                 `protobuf:"varint,2,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
-	BankName      string                 `protobuf:"bytes,3,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
-	ShabaNum      string                 `protobuf:"bytes,4,opt,name=shaba_num,json=shabaNum,proto3" json:"shaba_num,omitempty"`
-	CardNum       string                 `protobuf:"bytes,5,opt,name=card_num,json=cardNum,proto3" json:"card_num,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MelliCard     string                 `protobuf:"bytes,2,opt,name=melli_card,json=melliCard,proto3" json:"melli_card,omitempty"`
+	Fname         string                 `protobuf:"bytes,3,opt,name=fname,proto3" json:"fname,omitempty"`
+	Lname         string                 `protobuf:"bytes,4,opt,name=lname,proto3" json:"lname,omitempty"`
+	MelliCode     string                 `protobuf:"bytes,5,opt,name=melli_code,json=melliCode,proto3" json:"melli_code,omitempty"`
+	Birthdate     string                 `protobuf:"bytes,6,opt,name=birthdate,proto3" json:"birthdate,omitempty"` // Jalali date format: Y/m/d
+	Province      string                 `protobuf:"bytes,7,opt,name=province,proto3" json:"province,omitempty"`
+	Status        int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	Video         string                 `protobuf:"bytes,9,opt,name=video,proto3" json:"video,omitempty"`
+	Errors        string                 `protobuf:"bytes,10,opt,name=errors,proto3" json:"errors,omitempty"`
+	Gender        string                 `protobuf:"bytes,11,opt,name=gender,proto3" json:"gender,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBankAccountRequest) Reset() {
-	*x = UpdateBankAccountRequest{}
+func (x *KYCResponse) Reset() {
+	*x = KYCResponse{}
 	mi := &file_auth_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBankAccountRequest) String() string {
+func (x *KYCResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBankAccountRequest) ProtoMessage() {}
+func (*KYCResponse) ProtoMessage() {}
 
-func (x *UpdateBankAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *KYCResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2361,68 +2380,109 @@ func (x *UpdateBankAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBankAccountRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBankAccountRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use KYCResponse.ProtoReflect.Descriptor instead.
+func (*KYCResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *UpdateBankAccountRequest) GetUserId() uint64 {
+func (x *KYCResponse) GetId() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateBankAccountRequest) GetBankAccountId() uint64 {
+func (x *KYCResponse) GetMelliCard() string {
 	if x != nil {
-		return x.BankAccountId
+		return x.MelliCard
+	}
+	return ""
+}
+
+func (x *KYCResponse) GetFname() string {
+	if x != nil {
+		return x.Fname
+	}
+	return ""
+}
+
+func (x *KYCResponse) GetLname() string {
+	if x != nil {
+		return x.Lname
+	}
+	return ""
+}
+
+func (x *KYCResponse) GetMelliCode() string {
+	if x != nil {
+		return x.MelliCode
+	}
+	return ""
+}
+
+func (x *KYCResponse) GetBirthdate() string {
+	if x != nil {
+		return x.Birthdate
+	}
+	return ""
+}
+
+func (x *KYCResponse) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *KYCResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
 	}
 	return 0
 }
 
-func (x *UpdateBankAccountRequest) GetBankName() string {
+func (x *KYCResponse) GetVideo() string {
 	if x != nil {
-		return x.BankName
+		return x.Video
 	}
 	return ""
 }
 
-func (x *UpdateBankAccountRequest) GetShabaNum() string {
+func (x *KYCResponse) GetErrors() string {
 	if x != nil {
-		return x.ShabaNum
+		return x.Errors
 	}
 	return ""
 }
 
-func (x *UpdateBankAccountRequest) GetCardNum() string {
+func (x *KYCResponse) GetGender() string {
 	if x != nil {
-		return x.CardNum
+		return x.Gender
 	}
 	return ""
 }
 
-type DeleteBankAccountRequest struct {
+type ListBankAccountsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	BankAccountId uint64                 `protobuf:"varint,2,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteBankAccountRequest) Reset() {
-	*x = DeleteBankAccountRequest{}
+func (x *ListBankAccountsRequest) Reset() {
+	*x = ListBankAccountsRequest{}
 	mi := &file_auth_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBankAccountRequest) String() string {
+func (x *ListBankAccountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBankAccountRequest) ProtoMessage() {}
+func (*ListBankAccountsRequest) ProtoMessage() {}
 
-func (x *DeleteBankAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *ListBankAccountsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2434,52 +2494,87 @@ func (x *DeleteBankAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBankAccountRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBankAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBankAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListBankAccountsRequest) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *DeleteBankAccountRequest) GetUserId() uint64 {
+func (x *ListBankAccountsRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *DeleteBankAccountRequest) GetBankAccountId() uint64 {
+type ListBankAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*BankAccountResponse `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBankAccountsResponse) Reset() {
+	*x = ListBankAccountsResponse{}
+	mi := &file_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBankAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBankAccountsResponse) ProtoMessage() {}
+
+func (x *ListBankAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[35]
 	if x != nil {
-		return x.BankAccountId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type BankAccountResponse struct {
+// Deprecated: Use ListBankAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListBankAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListBankAccountsResponse) GetData() []*BankAccountResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateBankAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	BankName      string                 `protobuf:"bytes,2,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
 	ShabaNum      string                 `protobuf:"bytes,3,opt,name=shaba_num,json=shabaNum,proto3" json:"shaba_num,omitempty"`
 	CardNum       string                 `protobuf:"bytes,4,opt,name=card_num,json=cardNum,proto3" json:"card_num,omitempty"`
-	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
-	Errors        string                 `protobuf:"bytes,6,opt,name=errors,proto3" json:"errors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BankAccountResponse) Reset() {
-	*x = BankAccountResponse{}
-	mi := &file_auth_proto_msgTypes[35]
+func (x *CreateBankAccountRequest) Reset() {
+	*x = CreateBankAccountRequest{}
+	mi := &file_auth_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BankAccountResponse) String() string {
+func (x *CreateBankAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BankAccountResponse) ProtoMessage() {}
+func (*CreateBankAccountRequest) ProtoMessage() {}
 
-func (x *BankAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[35]
+func (x *CreateBankAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2490,75 +2585,62 @@ func (x *BankAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BankAccountResponse.ProtoReflect.Descriptor instead.
-func (*BankAccountResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use CreateBankAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateBankAccountRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *BankAccountResponse) GetId() uint64 {
+func (x *CreateBankAccountRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *BankAccountResponse) GetBankName() string {
+func (x *CreateBankAccountRequest) GetBankName() string {
 	if x != nil {
 		return x.BankName
 	}
 	return ""
 }
 
-func (x *BankAccountResponse) GetShabaNum() string {
+func (x *CreateBankAccountRequest) GetShabaNum() string {
 	if x != nil {
 		return x.ShabaNum
 	}
 	return ""
 }
 
-func (x *BankAccountResponse) GetCardNum() string {
+func (x *CreateBankAccountRequest) GetCardNum() string {
 	if x != nil {
 		return x.CardNum
 	}
 	return ""
 }
 
-func (x *BankAccountResponse) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
-func (x *BankAccountResponse) GetErrors() string {
-	if x != nil {
-		return x.Errors
-	}
-	return ""
-}
-
-type GetCitizenProfileRequest struct {
+type GetBankAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // Case-insensitive citizen code
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BankAccountId uint64                 `protobuf:"varint,2,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCitizenProfileRequest) Reset() {
-	*x = GetCitizenProfileRequest{}
-	mi := &file_auth_proto_msgTypes[36]
+func (x *GetBankAccountRequest) Reset() {
+	*x = GetBankAccountRequest{}
+	mi := &file_auth_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCitizenProfileRequest) String() string {
+func (x *GetBankAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCitizenProfileRequest) ProtoMessage() {}
+func (*GetBankAccountRequest) ProtoMessage() {}
 
-func (x *GetCitizenProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[36]
+func (x *GetBankAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2569,51 +2651,51 @@ func (x *GetCitizenProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCitizenProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetCitizenProfileRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetBankAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetBankAccountRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetCitizenProfileRequest) GetCode() string {
+func (x *GetBankAccountRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Code
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-type CitizenProfileResponse struct {
-	state                      protoimpl.MessageState `protogen:"open.v1"`
-	ProfilePhotos              []*ProfilePhoto        `protobuf:"bytes,1,rep,name=profile_photos,json=profilePhotos,proto3" json:"profile_photos,omitempty"`
-	Kyc                        *CitizenKYC            `protobuf:"bytes,2,opt,name=kyc,proto3" json:"kyc,omitempty"`
-	Code                       string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
-	Name                       string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Position                   string                 `protobuf:"bytes,5,opt,name=position,proto3" json:"position,omitempty"`
-	RegisteredAt               string                 `protobuf:"bytes,6,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"` // Jalali format
-	Customs                    *CitizenCustoms        `protobuf:"bytes,7,opt,name=customs,proto3" json:"customs,omitempty"`
-	Score                      int32                  `protobuf:"varint,8,opt,name=score,proto3" json:"score,omitempty"`
-	ScorePercentageToNextLevel float64                `protobuf:"fixed64,9,opt,name=score_percentage_to_next_level,json=scorePercentageToNextLevel,proto3" json:"score_percentage_to_next_level,omitempty"`
-	CurrentLevel               *CitizenLevel          `protobuf:"bytes,10,opt,name=current_level,json=currentLevel,proto3" json:"current_level,omitempty"`
-	AchievedLevels             []*CitizenLevel        `protobuf:"bytes,11,rep,name=achieved_levels,json=achievedLevels,proto3" json:"achieved_levels,omitempty"`
-	Avatar                     string                 `protobuf:"bytes,12,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	unknownFields              protoimpl.UnknownFields
-	sizeCache                  protoimpl.SizeCache
+func (x *GetBankAccountRequest) GetBankAccountId() uint64 {
+	if x != nil {
+		return x.BankAccountId
+	}
+	return 0
 }
 
-func (x *CitizenProfileResponse) Reset() {
-	*x = CitizenProfileResponse{}
-	mi := &file_auth_proto_msgTypes[37]
+type UpdateBankAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BankAccountId uint64                 `protobuf:"varint,2,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
+	BankName      string                 `protobuf:"bytes,3,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
+	ShabaNum      string                 `protobuf:"bytes,4,opt,name=shaba_num,json=shabaNum,proto3" json:"shaba_num,omitempty"`
+	CardNum       string                 `protobuf:"bytes,5,opt,name=card_num,json=cardNum,proto3" json:"card_num,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBankAccountRequest) Reset() {
+	*x = UpdateBankAccountRequest{}
+	mi := &file_auth_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CitizenProfileResponse) String() string {
+func (x *UpdateBankAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CitizenProfileResponse) ProtoMessage() {}
+func (*UpdateBankAccountRequest) ProtoMessage() {}
 
-func (x *CitizenProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[37]
+func (x *UpdateBankAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2624,118 +2706,69 @@ func (x *CitizenProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CitizenProfileResponse.ProtoReflect.Descriptor instead.
-func (*CitizenProfileResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *CitizenProfileResponse) GetProfilePhotos() []*ProfilePhoto {
-	if x != nil {
-		return x.ProfilePhotos
-	}
-	return nil
-}
-
-func (x *CitizenProfileResponse) GetKyc() *CitizenKYC {
-	if x != nil {
-		return x.Kyc
-	}
-	return nil
-}
-
-func (x *CitizenProfileResponse) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
-}
-
-func (x *CitizenProfileResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CitizenProfileResponse) GetPosition() string {
-	if x != nil {
-		return x.Position
-	}
-	return ""
-}
-
-func (x *CitizenProfileResponse) GetRegisteredAt() string {
-	if x != nil {
-		return x.RegisteredAt
-	}
-	return ""
-}
-
-func (x *CitizenProfileResponse) GetCustoms() *CitizenCustoms {
-	if x != nil {
-		return x.Customs
-	}
-	return nil
+// Deprecated: Use UpdateBankAccountRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBankAccountRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *CitizenProfileResponse) GetScore() int32 {
+func (x *UpdateBankAccountRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Score
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *CitizenProfileResponse) GetScorePercentageToNextLevel() float64 {
+func (x *UpdateBankAccountRequest) GetBankAccountId() uint64 {
 	if x != nil {
-		return x.ScorePercentageToNextLevel
+		return x.BankAccountId
 	}
 	return 0
 }
 
-func (x *CitizenProfileResponse) GetCurrentLevel() *CitizenLevel {
+func (x *UpdateBankAccountRequest) GetBankName() string {
 	if x != nil {
-		return x.CurrentLevel
+		return x.BankName
 	}
-	return nil
+	return ""
 }
 
-func (x *CitizenProfileResponse) GetAchievedLevels() []*CitizenLevel {
+func (x *UpdateBankAccountRequest) GetShabaNum() string {
 	if x != nil {
-		return x.AchievedLevels
+		return x.ShabaNum
 	}
-	return nil
+	return ""
 }
 
-func (x *CitizenProfileResponse) GetAvatar() string {
+func (x *UpdateBankAccountRequest) GetCardNum() string {
 	if x != nil {
-		return x.Avatar
+		return x.CardNum
 	}
 	return ""
 }
 
-type ProfilePhoto struct {
+type DeleteBankAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BankAccountId uint64                 `protobuf:"varint,2,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProfilePhoto) Reset() {
-	*x = ProfilePhoto{}
-	mi := &file_auth_proto_msgTypes[38]
+func (x *DeleteBankAccountRequest) Reset() {
+	*x = DeleteBankAccountRequest{}
+	mi := &file_auth_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProfilePhoto) String() string {
+func (x *DeleteBankAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfilePhoto) ProtoMessage() {}
+func (*DeleteBankAccountRequest) ProtoMessage() {}
 
-func (x *ProfilePhoto) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[38]
+func (x *DeleteBankAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2746,53 +2779,52 @@ func (x *ProfilePhoto) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfilePhoto.ProtoReflect.Descriptor instead.
-func (*ProfilePhoto) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use DeleteBankAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBankAccountRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ProfilePhoto) GetId() uint64 {
+func (x *DeleteBankAccountRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *ProfilePhoto) GetUrl() string {
+func (x *DeleteBankAccountRequest) GetBankAccountId() uint64 {
 	if x != nil {
-		return x.Url
+		return x.BankAccountId
 	}
-	return ""
+	return 0
 }
 
-type CitizenKYC struct {
+type BankAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Nationality   string                 `protobuf:"bytes,1,opt,name=nationality,proto3" json:"nationality,omitempty"` // URL to flag image
-	Fname         string                 `protobuf:"bytes,2,opt,name=fname,proto3" json:"fname,omitempty"`
-	Lname         string                 `protobuf:"bytes,3,opt,name=lname,proto3" json:"lname,omitempty"`
-	BirthDate     string                 `protobuf:"bytes,4,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"` // Jalali format
-	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
-	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
-	Address       string                 `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BankName      string                 `protobuf:"bytes,2,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
+	ShabaNum      string                 `protobuf:"bytes,3,opt,name=shaba_num,json=shabaNum,proto3" json:"shaba_num,omitempty"`
+	CardNum       string                 `protobuf:"bytes,4,opt,name=card_num,json=cardNum,proto3" json:"card_num,omitempty"`
+	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	Errors        string                 `protobuf:"bytes,6,opt,name=errors,proto3" json:"errors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CitizenKYC) Reset() {
-	*x = CitizenKYC{}
-	mi := &file_auth_proto_msgTypes[39]
+func (x *BankAccountResponse) Reset() {
+	*x = BankAccountResponse{}
+	mi := &file_auth_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CitizenKYC) String() string {
+func (x *BankAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CitizenKYC) ProtoMessage() {}
+func (*BankAccountResponse) ProtoMessage() {}
 
-func (x *CitizenKYC) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[39]
+func (x *BankAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2803,85 +2835,75 @@ func (x *CitizenKYC) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CitizenKYC.ProtoReflect.Descriptor instead.
-func (*CitizenKYC) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{39}
-}
-
-func (x *CitizenKYC) GetNationality() string {
-	if x != nil {
-		return x.Nationality
-	}
-	return ""
+// Deprecated: Use BankAccountResponse.ProtoReflect.Descriptor instead.
+func (*BankAccountResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *CitizenKYC) GetFname() string {
+func (x *BankAccountResponse) GetId() uint64 {
 	if x != nil {
-		return x.Fname
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *CitizenKYC) GetLname() string {
+func (x *BankAccountResponse) GetBankName() string {
 	if x != nil {
-		return x.Lname
+		return x.BankName
 	}
 	return ""
 }
 
-func (x *CitizenKYC) GetBirthDate() string {
+func (x *BankAccountResponse) GetShabaNum() string {
 	if x != nil {
-		return x.BirthDate
+		return x.ShabaNum
 	}
 	return ""
 }
 
-func (x *CitizenKYC) GetPhone() string {
+func (x *BankAccountResponse) GetCardNum() string {
 	if x != nil {
-		return x.Phone
+		return x.CardNum
 	}
 	return ""
 }
 
-func (x *CitizenKYC) GetEmail() string {
+func (x *BankAccountResponse) GetStatus() int32 {
 	if x != nil {
-		return x.Email
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-func (x *CitizenKYC) GetAddress() string {
+func (x *BankAccountResponse) GetErrors() string {
 	if x != nil {
-		return x.Address
+		return x.Errors
 	}
 	return ""
 }
 
-type CitizenCustoms struct {
+type GetCitizenProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Occupation    string                 `protobuf:"bytes,1,opt,name=occupation,proto3" json:"occupation,omitempty"`
-	Education     string                 `protobuf:"bytes,2,opt,name=education,proto3" json:"education,omitempty"`
-	Prediction    string                 `protobuf:"bytes,3,opt,name=prediction,proto3" json:"prediction,omitempty"`
-	Passions      map[string]string      `protobuf:"bytes,4,rep,name=passions,proto3" json:"passions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // passion_key -> icon_url
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // Case-insensitive citizen code
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CitizenCustoms) Reset() {
-	*x = CitizenCustoms{}
-	mi := &file_auth_proto_msgTypes[40]
+func (x *GetCitizenProfileRequest) Reset() {
+	*x = GetCitizenProfileRequest{}
+	mi := &file_auth_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CitizenCustoms) String() string {
+func (x *GetCitizenProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CitizenCustoms) ProtoMessage() {}
+func (*GetCitizenProfileRequest) ProtoMessage() {}
 
-func (x *CitizenCustoms) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[40]
+func (x *GetCitizenProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2892,64 +2914,51 @@ func (x *CitizenCustoms) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CitizenCustoms.ProtoReflect.Descriptor instead.
-func (*CitizenCustoms) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use GetCitizenProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetCitizenProfileRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *CitizenCustoms) GetOccupation() string {
+func (x *GetCitizenProfileRequest) GetCode() string {
 	if x != nil {
-		return x.Occupation
+		return x.Code
 	}
 	return ""
 }
 
-func (x *CitizenCustoms) GetEducation() string {
-	if x != nil {
-		return x.Education
-	}
-	return ""
+type CitizenProfileResponse struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	ProfilePhotos              []*ProfilePhoto        `protobuf:"bytes,1,rep,name=profile_photos,json=profilePhotos,proto3" json:"profile_photos,omitempty"`
+	Kyc                        *CitizenKYC            `protobuf:"bytes,2,opt,name=kyc,proto3" json:"kyc,omitempty"`
+	Code                       string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Name                       string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Position                   string                 `protobuf:"bytes,5,opt,name=position,proto3" json:"position,omitempty"`
+	RegisteredAt               string                 `protobuf:"bytes,6,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"` // Jalali format
+	Customs                    *CitizenCustoms        `protobuf:"bytes,7,opt,name=customs,proto3" json:"customs,omitempty"`
+	Score                      int32                  `protobuf:"varint,8,opt,name=score,proto3" json:"score,omitempty"`
+	ScorePercentageToNextLevel float64                `protobuf:"fixed64,9,opt,name=score_percentage_to_next_level,json=scorePercentageToNextLevel,proto3" json:"score_percentage_to_next_level,omitempty"`
+	CurrentLevel               *CitizenLevel          `protobuf:"bytes,10,opt,name=current_level,json=currentLevel,proto3" json:"current_level,omitempty"`
+	AchievedLevels             []*CitizenLevel        `protobuf:"bytes,11,rep,name=achieved_levels,json=achievedLevels,proto3" json:"achieved_levels,omitempty"`
+	Avatar                     string                 `protobuf:"bytes,12,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
 }
 
-func (x *CitizenCustoms) GetPrediction() string {
-	if x != nil {
-		return x.Prediction
-	}
-	return ""
+func (x *CitizenProfileResponse) Reset() {
+	*x = CitizenProfileResponse{}
+	mi := &file_auth_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CitizenCustoms) GetPassions() map[string]string {
-	if x != nil {
-		return x.Passions
-	}
-	return nil
+func (x *CitizenProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type CitizenLevel struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CitizenLevel) Reset() {
-	*x = CitizenLevel{}
-	mi := &file_auth_proto_msgTypes[41]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CitizenLevel) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CitizenLevel) ProtoMessage() {}
+func (*CitizenProfileResponse) ProtoMessage() {}
 
-func (x *CitizenLevel) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[41]
+func (x *CitizenProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2960,121 +2969,117 @@ func (x *CitizenLevel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CitizenLevel.ProtoReflect.Descriptor instead.
-func (*CitizenLevel) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use CitizenProfileResponse.ProtoReflect.Descriptor instead.
+func (*CitizenProfileResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *CitizenLevel) GetId() uint64 {
+func (x *CitizenProfileResponse) GetProfilePhotos() []*ProfilePhoto {
 	if x != nil {
-		return x.Id
+		return x.ProfilePhotos
 	}
-	return 0
+	return nil
 }
 
-func (x *CitizenLevel) GetTitle() string {
+func (x *CitizenProfileResponse) GetKyc() *CitizenKYC {
 	if x != nil {
-		return x.Title
+		return x.Kyc
 	}
-	return ""
+	return nil
 }
 
-func (x *CitizenLevel) GetDescription() string {
+func (x *CitizenProfileResponse) GetCode() string {
 	if x != nil {
-		return x.Description
+		return x.Code
 	}
 	return ""
 }
 
-func (x *CitizenLevel) GetScore() int32 {
+func (x *CitizenProfileResponse) GetName() string {
 	if x != nil {
-		return x.Score
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type GetCitizenReferralsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`     // Case-insensitive citizen code
-	Search        string                 `protobuf:"bytes,2,opt,name=search,proto3" json:"search,omitempty"` // Optional search query
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`    // Page number (default: 1)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CitizenProfileResponse) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
 }
 
-func (x *GetCitizenReferralsRequest) Reset() {
-	*x = GetCitizenReferralsRequest{}
-	mi := &file_auth_proto_msgTypes[42]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CitizenProfileResponse) GetRegisteredAt() string {
+	if x != nil {
+		return x.RegisteredAt
+	}
+	return ""
 }
 
-func (x *GetCitizenReferralsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CitizenProfileResponse) GetCustoms() *CitizenCustoms {
+	if x != nil {
+		return x.Customs
+	}
+	return nil
 }
 
-func (*GetCitizenReferralsRequest) ProtoMessage() {}
-
-func (x *GetCitizenReferralsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[42]
+func (x *CitizenProfileResponse) GetScore() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Score
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetCitizenReferralsRequest.ProtoReflect.Descriptor instead.
-func (*GetCitizenReferralsRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{42}
+func (x *CitizenProfileResponse) GetScorePercentageToNextLevel() float64 {
+	if x != nil {
+		return x.ScorePercentageToNextLevel
+	}
+	return 0
 }
 
-func (x *GetCitizenReferralsRequest) GetCode() string {
+func (x *CitizenProfileResponse) GetCurrentLevel() *CitizenLevel {
 	if x != nil {
-		return x.Code
+		return x.CurrentLevel
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCitizenReferralsRequest) GetSearch() string {
+func (x *CitizenProfileResponse) GetAchievedLevels() []*CitizenLevel {
 	if x != nil {
-		return x.Search
+		return x.AchievedLevels
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCitizenReferralsRequest) GetPage() int32 {
+func (x *CitizenProfileResponse) GetAvatar() string {
 	if x != nil {
-		return x.Page
+		return x.Avatar
 	}
-	return 0
+	return ""
 }
 
-type CitizenReferralsResponse struct {
+type ProfilePhoto struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*CitizenReferral     `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Meta          *PaginationMeta        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CitizenReferralsResponse) Reset() {
-	*x = CitizenReferralsResponse{}
+func (x *ProfilePhoto) Reset() {
+	*x = ProfilePhoto{}
 	mi := &file_auth_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CitizenReferralsResponse) String() string {
+func (x *ProfilePhoto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CitizenReferralsResponse) ProtoMessage() {}
+func (*ProfilePhoto) ProtoMessage() {}
 
-func (x *CitizenReferralsResponse) ProtoReflect() protoreflect.Message {
+func (x *ProfilePhoto) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3086,50 +3091,52 @@ func (x *CitizenReferralsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CitizenReferralsResponse.ProtoReflect.Descriptor instead.
-func (*CitizenReferralsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProfilePhoto.ProtoReflect.Descriptor instead.
+func (*ProfilePhoto) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *CitizenReferralsResponse) GetData() []*CitizenReferral {
+func (x *ProfilePhoto) GetId() uint64 {
 	if x != nil {
-		return x.Data
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *CitizenReferralsResponse) GetMeta() *PaginationMeta {
+func (x *ProfilePhoto) GetUrl() string {
 	if x != nil {
-		return x.Meta
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-type CitizenReferral struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Image          string                 `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"` // Profile photo URL
-	ReferrerOrders []*ReferrerOrder       `protobuf:"bytes,5,rep,name=referrer_orders,json=referrerOrders,proto3" json:"referrer_orders,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type CitizenKYC struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nationality   string                 `protobuf:"bytes,1,opt,name=nationality,proto3" json:"nationality,omitempty"` // URL to flag image
+	Fname         string                 `protobuf:"bytes,2,opt,name=fname,proto3" json:"fname,omitempty"`
+	Lname         string                 `protobuf:"bytes,3,opt,name=lname,proto3" json:"lname,omitempty"`
+	BirthDate     string                 `protobuf:"bytes,4,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"` // Jalali format
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	Address       string                 `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CitizenReferral) Reset() {
-	*x = CitizenReferral{}
+func (x *CitizenKYC) Reset() {
+	*x = CitizenKYC{}
 	mi := &file_auth_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CitizenReferral) String() string {
+func (x *CitizenKYC) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CitizenReferral) ProtoMessage() {}
+func (*CitizenKYC) ProtoMessage() {}
 
-func (x *CitizenReferral) ProtoReflect() protoreflect.Message {
+func (x *CitizenKYC) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3141,69 +3148,84 @@ func (x *CitizenReferral) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CitizenReferral.ProtoReflect.Descriptor instead.
-func (*CitizenReferral) Descriptor() ([]byte, []int) {
+// Deprecated: Use CitizenKYC.ProtoReflect.Descriptor instead.
+func (*CitizenKYC) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *CitizenReferral) GetId() uint64 {
+func (x *CitizenKYC) GetNationality() string {
 	if x != nil {
-		return x.Id
+		return x.Nationality
 	}
-	return 0
+	return ""
 }
 
-func (x *CitizenReferral) GetCode() string {
+func (x *CitizenKYC) GetFname() string {
 	if x != nil {
-		return x.Code
+		return x.Fname
 	}
 	return ""
 }
 
-func (x *CitizenReferral) GetName() string {
+func (x *CitizenKYC) GetLname() string {
 	if x != nil {
-		return x.Name
+		return x.Lname
 	}
 	return ""
 }
 
-func (x *CitizenReferral) GetImage() string {
+func (x *CitizenKYC) GetBirthDate() string {
 	if x != nil {
-		return x.Image
+		return x.BirthDate
 	}
 	return ""
 }
 
-func (x *CitizenReferral) GetReferrerOrders() []*ReferrerOrder {
+func (x *CitizenKYC) GetPhone() string {
 	if x != nil {
-		return x.ReferrerOrders
+		return x.Phone
 	}
-	return nil
+	return ""
 }
 
-type ReferrerOrder struct {
+func (x *CitizenKYC) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CitizenKYC) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type CitizenCustoms struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                       // bigint in database
-	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Jalali format Y-m-d H:i:s
+	Occupation    string                 `protobuf:"bytes,1,opt,name=occupation,proto3" json:"occupation,omitempty"`
+	Education     string                 `protobuf:"bytes,2,opt,name=education,proto3" json:"education,omitempty"`
+	Prediction    string                 `protobuf:"bytes,3,opt,name=prediction,proto3" json:"prediction,omitempty"`
+	Passions      map[string]string      `protobuf:"bytes,4,rep,name=passions,proto3" json:"passions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // passion_key -> icon_url
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReferrerOrder) Reset() {
-	*x = ReferrerOrder{}
+func (x *CitizenCustoms) Reset() {
+	*x = CitizenCustoms{}
 	mi := &file_auth_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReferrerOrder) String() string {
+func (x *CitizenCustoms) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReferrerOrder) ProtoMessage() {}
+func (*CitizenCustoms) ProtoMessage() {}
 
-func (x *ReferrerOrder) ProtoReflect() protoreflect.Message {
+func (x *CitizenCustoms) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3215,55 +3237,63 @@ func (x *ReferrerOrder) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReferrerOrder.ProtoReflect.Descriptor instead.
-func (*ReferrerOrder) Descriptor() ([]byte, []int) {
+// Deprecated: Use CitizenCustoms.ProtoReflect.Descriptor instead.
+func (*CitizenCustoms) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ReferrerOrder) GetId() uint64 {
+func (x *CitizenCustoms) GetOccupation() string {
 	if x != nil {
-		return x.Id
+		return x.Occupation
 	}
-	return 0
+	return ""
 }
 
-func (x *ReferrerOrder) GetAmount() int64 {
+func (x *CitizenCustoms) GetEducation() string {
 	if x != nil {
-		return x.Amount
+		return x.Education
 	}
-	return 0
+	return ""
 }
 
-func (x *ReferrerOrder) GetCreatedAt() string {
+func (x *CitizenCustoms) GetPrediction() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Prediction
 	}
 	return ""
 }
 
-type PaginationMeta struct {
+func (x *CitizenCustoms) GetPassions() map[string]string {
+	if x != nil {
+		return x.Passions
+	}
+	return nil
+}
+
+type CitizenLevel struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CurrentPage   int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
-	NextPageUrl   string                 `protobuf:"bytes,2,opt,name=next_page_url,json=nextPageUrl,proto3" json:"next_page_url,omitempty"`
-	PrevPageUrl   string                 `protobuf:"bytes,3,opt,name=prev_page_url,json=prevPageUrl,proto3" json:"prev_page_url,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaginationMeta) Reset() {
-	*x = PaginationMeta{}
+func (x *CitizenLevel) Reset() {
+	*x = CitizenLevel{}
 	mi := &file_auth_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaginationMeta) String() string {
+func (x *CitizenLevel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginationMeta) ProtoMessage() {}
+func (*CitizenLevel) ProtoMessage() {}
 
-func (x *PaginationMeta) ProtoReflect() protoreflect.Message {
+func (x *CitizenLevel) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3275,54 +3305,62 @@ func (x *PaginationMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginationMeta.ProtoReflect.Descriptor instead.
-func (*PaginationMeta) Descriptor() ([]byte, []int) {
+// Deprecated: Use CitizenLevel.ProtoReflect.Descriptor instead.
+func (*CitizenLevel) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *PaginationMeta) GetCurrentPage() int32 {
+func (x *CitizenLevel) GetId() uint64 {
 	if x != nil {
-		return x.CurrentPage
+		return x.Id
 	}
 	return 0
 }
 
-func (x *PaginationMeta) GetNextPageUrl() string {
+func (x *CitizenLevel) GetTitle() string {
 	if x != nil {
-		return x.NextPageUrl
+		return x.Title
 	}
 	return ""
 }
 
-func (x *PaginationMeta) GetPrevPageUrl() string {
+func (x *CitizenLevel) GetDescription() string {
 	if x != nil {
-		return x.PrevPageUrl
+		return x.Description
 	}
 	return ""
 }
 
-type GetCitizenReferralChartRequest struct {
+func (x *CitizenLevel) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetCitizenReferralsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`   // Case-insensitive citizen code
-	Range         string                 `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"` // daily, weekly, monthly, yearly (default: daily)
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`     // Case-insensitive citizen code
+	Search        string                 `protobuf:"bytes,2,opt,name=search,proto3" json:"search,omitempty"` // Optional search query
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`    // Page number (default: 1)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCitizenReferralChartRequest) Reset() {
-	*x = GetCitizenReferralChartRequest{}
+func (x *GetCitizenReferralsRequest) Reset() {
+	*x = GetCitizenReferralsRequest{}
 	mi := &file_auth_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCitizenReferralChartRequest) String() string {
+func (x *GetCitizenReferralsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCitizenReferralChartRequest) ProtoMessage() {}
+func (*GetCitizenReferralsRequest) ProtoMessage() {}
 
-func (x *GetCitizenReferralChartRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCitizenReferralsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3334,46 +3372,54 @@ func (x *GetCitizenReferralChartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCitizenReferralChartRequest.ProtoReflect.Descriptor instead.
-func (*GetCitizenReferralChartRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCitizenReferralsRequest.ProtoReflect.Descriptor instead.
+func (*GetCitizenReferralsRequest) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetCitizenReferralChartRequest) GetCode() string {
+func (x *GetCitizenReferralsRequest) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *GetCitizenReferralChartRequest) GetRange() string {
+func (x *GetCitizenReferralsRequest) GetSearch() string {
 	if x != nil {
-		return x.Range
+		return x.Search
 	}
 	return ""
 }
 
-type CitizenReferralChartResponse struct {
+func (x *GetCitizenReferralsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type CitizenReferralsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *ReferralChartData     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data          []*CitizenReferral     `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Meta          *PaginationMeta        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CitizenReferralChartResponse) Reset() {
-	*x = CitizenReferralChartResponse{}
+func (x *CitizenReferralsResponse) Reset() {
+	*x = CitizenReferralsResponse{}
 	mi := &file_auth_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CitizenReferralChartResponse) String() string {
+func (x *CitizenReferralsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CitizenReferralChartResponse) ProtoMessage() {}
+func (*CitizenReferralsResponse) ProtoMessage() {}
 
-func (x *CitizenReferralChartResponse) ProtoReflect() protoreflect.Message {
+func (x *CitizenReferralsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3385,41 +3431,50 @@ func (x *CitizenReferralChartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CitizenReferralChartResponse.ProtoReflect.Descriptor instead.
-func (*CitizenReferralChartResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CitizenReferralsResponse.ProtoReflect.Descriptor instead.
+func (*CitizenReferralsResponse) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CitizenReferralChartResponse) GetData() *ReferralChartData {
+func (x *CitizenReferralsResponse) GetData() []*CitizenReferral {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type ReferralChartData struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	TotalReferralsCount       string                 `protobuf:"bytes,1,opt,name=total_referrals_count,json=totalReferralsCount,proto3" json:"total_referrals_count,omitempty"`                     // Stringified count
-	TotalReferralOrdersAmount string                 `protobuf:"bytes,2,opt,name=total_referral_orders_amount,json=totalReferralOrdersAmount,proto3" json:"total_referral_orders_amount,omitempty"` // Stringified sum
-	ChartData                 []*ChartDataPoint      `protobuf:"bytes,3,rep,name=chart_data,json=chartData,proto3" json:"chart_data,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+func (x *CitizenReferralsResponse) GetMeta() *PaginationMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
 }
 
-func (x *ReferralChartData) Reset() {
-	*x = ReferralChartData{}
+type CitizenReferral struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Image          string                 `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"` // Profile photo URL
+	ReferrerOrders []*ReferrerOrder       `protobuf:"bytes,5,rep,name=referrer_orders,json=referrerOrders,proto3" json:"referrer_orders,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CitizenReferral) Reset() {
+	*x = CitizenReferral{}
 	mi := &file_auth_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReferralChartData) String() string {
+func (x *CitizenReferral) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReferralChartData) ProtoMessage() {}
+func (*CitizenReferral) ProtoMessage() {}
 
-func (x *ReferralChartData) ProtoReflect() protoreflect.Message {
+func (x *CitizenReferral) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3431,55 +3486,69 @@ func (x *ReferralChartData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReferralChartData.ProtoReflect.Descriptor instead.
-func (*ReferralChartData) Descriptor() ([]byte, []int) {
+// Deprecated: Use CitizenReferral.ProtoReflect.Descriptor instead.
+func (*CitizenReferral) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ReferralChartData) GetTotalReferralsCount() string {
+func (x *CitizenReferral) GetId() uint64 {
 	if x != nil {
-		return x.TotalReferralsCount
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CitizenReferral) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
 	return ""
 }
 
-func (x *ReferralChartData) GetTotalReferralOrdersAmount() string {
+func (x *CitizenReferral) GetName() string {
 	if x != nil {
-		return x.TotalReferralOrdersAmount
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ReferralChartData) GetChartData() []*ChartDataPoint {
+func (x *CitizenReferral) GetImage() string {
 	if x != nil {
-		return x.ChartData
+		return x.Image
+	}
+	return ""
+}
+
+func (x *CitizenReferral) GetReferrerOrders() []*ReferrerOrder {
+	if x != nil {
+		return x.ReferrerOrders
 	}
 	return nil
 }
 
-type ChartDataPoint struct {
+type ReferrerOrder struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"` // Jalali date/time label
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	TotalAmount   int64                  `protobuf:"varint,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"` // bigint in database
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                       // bigint in database
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Jalali format Y-m-d H:i:s
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChartDataPoint) Reset() {
-	*x = ChartDataPoint{}
+func (x *ReferrerOrder) Reset() {
+	*x = ReferrerOrder{}
 	mi := &file_auth_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChartDataPoint) String() string {
+func (x *ReferrerOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChartDataPoint) ProtoMessage() {}
+func (*ReferrerOrder) ProtoMessage() {}
 
-func (x *ChartDataPoint) ProtoReflect() protoreflect.Message {
+func (x *ReferrerOrder) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3491,53 +3560,55 @@ func (x *ChartDataPoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChartDataPoint.ProtoReflect.Descriptor instead.
-func (*ChartDataPoint) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReferrerOrder.ProtoReflect.Descriptor instead.
+func (*ReferrerOrder) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ChartDataPoint) GetLabel() string {
+func (x *ReferrerOrder) GetId() uint64 {
 	if x != nil {
-		return x.Label
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *ChartDataPoint) GetCount() int32 {
+func (x *ReferrerOrder) GetAmount() int64 {
 	if x != nil {
-		return x.Count
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *ChartDataPoint) GetTotalAmount() int64 {
+func (x *ReferrerOrder) GetCreatedAt() string {
 	if x != nil {
-		return x.TotalAmount
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-type GetPersonalInfoRequest struct {
+type PaginationMeta struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CurrentPage   int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	NextPageUrl   string                 `protobuf:"bytes,2,opt,name=next_page_url,json=nextPageUrl,proto3" json:"next_page_url,omitempty"`
+	PrevPageUrl   string                 `protobuf:"bytes,3,opt,name=prev_page_url,json=prevPageUrl,proto3" json:"prev_page_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPersonalInfoRequest) Reset() {
-	*x = GetPersonalInfoRequest{}
+func (x *PaginationMeta) Reset() {
+	*x = PaginationMeta{}
 	mi := &file_auth_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPersonalInfoRequest) String() string {
+func (x *PaginationMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPersonalInfoRequest) ProtoMessage() {}
+func (*PaginationMeta) ProtoMessage() {}
 
-func (x *GetPersonalInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *PaginationMeta) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3549,39 +3620,54 @@ func (x *GetPersonalInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPersonalInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetPersonalInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PaginationMeta.ProtoReflect.Descriptor instead.
+func (*PaginationMeta) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetPersonalInfoRequest) GetUserId() uint64 {
+func (x *PaginationMeta) GetCurrentPage() int32 {
 	if x != nil {
-		return x.UserId
+		return x.CurrentPage
 	}
 	return 0
 }
 
-type GetPersonalInfoResponse struct {
+func (x *PaginationMeta) GetNextPageUrl() string {
+	if x != nil {
+		return x.NextPageUrl
+	}
+	return ""
+}
+
+func (x *PaginationMeta) GetPrevPageUrl() string {
+	if x != nil {
+		return x.PrevPageUrl
+	}
+	return ""
+}
+
+type GetCitizenReferralChartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *PersonalInfoData      `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`   // Case-insensitive citizen code
+	Range         string                 `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"` // daily, weekly, monthly, yearly (default: daily)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPersonalInfoResponse) Reset() {
-	*x = GetPersonalInfoResponse{}
+func (x *GetCitizenReferralChartRequest) Reset() {
+	*x = GetCitizenReferralChartRequest{}
 	mi := &file_auth_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPersonalInfoResponse) String() string {
+func (x *GetCitizenReferralChartRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPersonalInfoResponse) ProtoMessage() {}
+func (*GetCitizenReferralChartRequest) ProtoMessage() {}
 
-func (x *GetPersonalInfoResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCitizenReferralChartRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3593,48 +3679,46 @@ func (x *GetPersonalInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPersonalInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetPersonalInfoResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCitizenReferralChartRequest.ProtoReflect.Descriptor instead.
+func (*GetCitizenReferralChartRequest) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetPersonalInfoResponse) GetData() *PersonalInfoData {
+func (x *GetCitizenReferralChartRequest) GetCode() string {
 	if x != nil {
-		return x.Data
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-type PersonalInfoData struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Occupation     string                 `protobuf:"bytes,1,opt,name=occupation,proto3" json:"occupation,omitempty"`
-	Education      string                 `protobuf:"bytes,2,opt,name=education,proto3" json:"education,omitempty"`
-	Memory         string                 `protobuf:"bytes,3,opt,name=memory,proto3" json:"memory,omitempty"`
-	LovedCity      string                 `protobuf:"bytes,4,opt,name=loved_city,json=lovedCity,proto3" json:"loved_city,omitempty"`
-	LovedCountry   string                 `protobuf:"bytes,5,opt,name=loved_country,json=lovedCountry,proto3" json:"loved_country,omitempty"`
-	LovedLanguage  string                 `protobuf:"bytes,6,opt,name=loved_language,json=lovedLanguage,proto3" json:"loved_language,omitempty"`
-	ProblemSolving string                 `protobuf:"bytes,7,opt,name=problem_solving,json=problemSolving,proto3" json:"problem_solving,omitempty"`
-	Prediction     string                 `protobuf:"bytes,8,opt,name=prediction,proto3" json:"prediction,omitempty"`
-	About          string                 `protobuf:"bytes,9,opt,name=about,proto3" json:"about,omitempty"`
-	Passions       map[string]bool        `protobuf:"bytes,10,rep,name=passions,proto3" json:"passions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // passion_key -> boolean value
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetCitizenReferralChartRequest) GetRange() string {
+	if x != nil {
+		return x.Range
+	}
+	return ""
 }
 
-func (x *PersonalInfoData) Reset() {
-	*x = PersonalInfoData{}
+type CitizenReferralChartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *ReferralChartData     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CitizenReferralChartResponse) Reset() {
+	*x = CitizenReferralChartResponse{}
 	mi := &file_auth_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PersonalInfoData) String() string {
+func (x *CitizenReferralChartResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PersonalInfoData) ProtoMessage() {}
+func (*CitizenReferralChartResponse) ProtoMessage() {}
 
-func (x *PersonalInfoData) ProtoReflect() protoreflect.Message {
+func (x *CitizenReferralChartResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3646,113 +3730,102 @@ func (x *PersonalInfoData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PersonalInfoData.ProtoReflect.Descriptor instead.
-func (*PersonalInfoData) Descriptor() ([]byte, []int) {
+// Deprecated: Use CitizenReferralChartResponse.ProtoReflect.Descriptor instead.
+func (*CitizenReferralChartResponse) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *PersonalInfoData) GetOccupation() string {
+func (x *CitizenReferralChartResponse) GetData() *ReferralChartData {
 	if x != nil {
-		return x.Occupation
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *PersonalInfoData) GetEducation() string {
-	if x != nil {
-		return x.Education
-	}
-	return ""
+type ReferralChartData struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	TotalReferralsCount       string                 `protobuf:"bytes,1,opt,name=total_referrals_count,json=totalReferralsCount,proto3" json:"total_referrals_count,omitempty"`                     // Stringified count
+	TotalReferralOrdersAmount string                 `protobuf:"bytes,2,opt,name=total_referral_orders_amount,json=totalReferralOrdersAmount,proto3" json:"total_referral_orders_amount,omitempty"` // Stringified sum
+	ChartData                 []*ChartDataPoint      `protobuf:"bytes,3,rep,name=chart_data,json=chartData,proto3" json:"chart_data,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *PersonalInfoData) GetMemory() string {
-	if x != nil {
-		return x.Memory
-	}
-	return ""
+func (x *ReferralChartData) Reset() {
+	*x = ReferralChartData{}
+	mi := &file_auth_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *PersonalInfoData) GetLovedCity() string {
-	if x != nil {
-		return x.LovedCity
-	}
-	return ""
+func (x *ReferralChartData) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PersonalInfoData) GetLovedCountry() string {
-	if x != nil {
-		return x.LovedCountry
-	}
-	return ""
-}
+func (*ReferralChartData) ProtoMessage() {}
 
-func (x *PersonalInfoData) GetLovedLanguage() string {
+func (x *ReferralChartData) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[54]
 	if x != nil {
-		return x.LovedLanguage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *PersonalInfoData) GetProblemSolving() string {
-	if x != nil {
-		return x.ProblemSolving
-	}
-	return ""
+// Deprecated: Use ReferralChartData.ProtoReflect.Descriptor instead.
+func (*ReferralChartData) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *PersonalInfoData) GetPrediction() string {
+func (x *ReferralChartData) GetTotalReferralsCount() string {
 	if x != nil {
-		return x.Prediction
+		return x.TotalReferralsCount
 	}
 	return ""
 }
 
-func (x *PersonalInfoData) GetAbout() string {
+func (x *ReferralChartData) GetTotalReferralOrdersAmount() string {
 	if x != nil {
-		return x.About
+		return x.TotalReferralOrdersAmount
 	}
 	return ""
 }
 
-func (x *PersonalInfoData) GetPassions() map[string]bool {
+func (x *ReferralChartData) GetChartData() []*ChartDataPoint {
 	if x != nil {
-		return x.Passions
+		return x.ChartData
 	}
 	return nil
 }
 
-type UpdatePersonalInfoRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Occupation     string                 `protobuf:"bytes,2,opt,name=occupation,proto3" json:"occupation,omitempty"`                                                                         // nullable, max 255
-	Education      string                 `protobuf:"bytes,3,opt,name=education,proto3" json:"education,omitempty"`                                                                           // nullable, max 255
-	Memory         string                 `protobuf:"bytes,4,opt,name=memory,proto3" json:"memory,omitempty"`                                                                                 // nullable, max 2000
-	LovedCity      string                 `protobuf:"bytes,5,opt,name=loved_city,json=lovedCity,proto3" json:"loved_city,omitempty"`                                                          // nullable, max 255
-	LovedCountry   string                 `protobuf:"bytes,6,opt,name=loved_country,json=lovedCountry,proto3" json:"loved_country,omitempty"`                                                 // nullable, max 255
-	LovedLanguage  string                 `protobuf:"bytes,7,opt,name=loved_language,json=lovedLanguage,proto3" json:"loved_language,omitempty"`                                              // nullable, max 255
-	ProblemSolving string                 `protobuf:"bytes,8,opt,name=problem_solving,json=problemSolving,proto3" json:"problem_solving,omitempty"`                                           // nullable, max 2000
-	Prediction     string                 `protobuf:"bytes,9,opt,name=prediction,proto3" json:"prediction,omitempty"`                                                                         // nullable, max 10000
-	About          string                 `protobuf:"bytes,10,opt,name=about,proto3" json:"about,omitempty"`                                                                                  // nullable, max 10000
-	Passions       map[string]bool        `protobuf:"bytes,11,rep,name=passions,proto3" json:"passions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // nullable, passion_key -> boolean
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ChartDataPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"` // Jalali date/time label
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalAmount   int64                  `protobuf:"varint,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"` // bigint in database
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePersonalInfoRequest) Reset() {
-	*x = UpdatePersonalInfoRequest{}
-	mi := &file_auth_proto_msgTypes[54]
+func (x *ChartDataPoint) Reset() {
+	*x = ChartDataPoint{}
+	mi := &file_auth_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePersonalInfoRequest) String() string {
+func (x *ChartDataPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePersonalInfoRequest) ProtoMessage() {}
+func (*ChartDataPoint) ProtoMessage() {}
 
-func (x *UpdatePersonalInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[54]
+func (x *ChartDataPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3763,89 +3836,361 @@ func (x *UpdatePersonalInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePersonalInfoRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePersonalInfoRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{54}
-}
-
-func (x *UpdatePersonalInfoRequest) GetUserId() uint64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use ChartDataPoint.ProtoReflect.Descriptor instead.
+func (*ChartDataPoint) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *UpdatePersonalInfoRequest) GetOccupation() string {
+func (x *ChartDataPoint) GetLabel() string {
 	if x != nil {
-		return x.Occupation
+		return x.Label
 	}
 	return ""
 }
 
-func (x *UpdatePersonalInfoRequest) GetEducation() string {
+func (x *ChartDataPoint) GetCount() int32 {
 	if x != nil {
-		return x.Education
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdatePersonalInfoRequest) GetMemory() string {
+func (x *ChartDataPoint) GetTotalAmount() int64 {
 	if x != nil {
-		return x.Memory
+		return x.TotalAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdatePersonalInfoRequest) GetLovedCity() string {
-	if x != nil {
-		return x.LovedCity
-	}
-	return ""
+type GetPersonalInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePersonalInfoRequest) GetLovedCountry() string {
-	if x != nil {
-		return x.LovedCountry
-	}
-	return ""
+func (x *GetPersonalInfoRequest) Reset() {
+	*x = GetPersonalInfoRequest{}
+	mi := &file_auth_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePersonalInfoRequest) GetLovedLanguage() string {
-	if x != nil {
-		return x.LovedLanguage
-	}
-	return ""
+func (x *GetPersonalInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdatePersonalInfoRequest) GetProblemSolving() string {
-	if x != nil {
-		return x.ProblemSolving
-	}
-	return ""
-}
+func (*GetPersonalInfoRequest) ProtoMessage() {}
 
-func (x *UpdatePersonalInfoRequest) GetPrediction() string {
+func (x *GetPersonalInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[56]
 	if x != nil {
-		return x.Prediction
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdatePersonalInfoRequest) GetAbout() string {
-	if x != nil {
-		return x.About
-	}
-	return ""
+// Deprecated: Use GetPersonalInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetPersonalInfoRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *UpdatePersonalInfoRequest) GetPassions() map[string]bool {
+func (x *GetPersonalInfoRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Passions
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-type ProfileLimitationOptions struct {
+type GetPersonalInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *PersonalInfoData      `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPersonalInfoResponse) Reset() {
+	*x = GetPersonalInfoResponse{}
+	mi := &file_auth_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPersonalInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPersonalInfoResponse) ProtoMessage() {}
+
+func (x *GetPersonalInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPersonalInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetPersonalInfoResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetPersonalInfoResponse) GetData() *PersonalInfoData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PersonalInfoData struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Occupation     string                 `protobuf:"bytes,1,opt,name=occupation,proto3" json:"occupation,omitempty"`
+	Education      string                 `protobuf:"bytes,2,opt,name=education,proto3" json:"education,omitempty"`
+	Memory         string                 `protobuf:"bytes,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	LovedCity      string                 `protobuf:"bytes,4,opt,name=loved_city,json=lovedCity,proto3" json:"loved_city,omitempty"`
+	LovedCountry   string                 `protobuf:"bytes,5,opt,name=loved_country,json=lovedCountry,proto3" json:"loved_country,omitempty"`
+	LovedLanguage  string                 `protobuf:"bytes,6,opt,name=loved_language,json=lovedLanguage,proto3" json:"loved_language,omitempty"`
+	ProblemSolving string                 `protobuf:"bytes,7,opt,name=problem_solving,json=problemSolving,proto3" json:"problem_solving,omitempty"`
+	Prediction     string                 `protobuf:"bytes,8,opt,name=prediction,proto3" json:"prediction,omitempty"`
+	About          string                 `protobuf:"bytes,9,opt,name=about,proto3" json:"about,omitempty"`
+	Passions       map[string]bool        `protobuf:"bytes,10,rep,name=passions,proto3" json:"passions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // passion_key -> boolean value
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PersonalInfoData) Reset() {
+	*x = PersonalInfoData{}
+	mi := &file_auth_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonalInfoData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonalInfoData) ProtoMessage() {}
+
+func (x *PersonalInfoData) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonalInfoData.ProtoReflect.Descriptor instead.
+func (*PersonalInfoData) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PersonalInfoData) GetOccupation() string {
+	if x != nil {
+		return x.Occupation
+	}
+	return ""
+}
+
+func (x *PersonalInfoData) GetEducation() string {
+	if x != nil {
+		return x.Education
+	}
+	return ""
+}
+
+func (x *PersonalInfoData) GetMemory() string {
+	if x != nil {
+		return x.Memory
+	}
+	return ""
+}
+
+func (x *PersonalInfoData) GetLovedCity() string {
+	if x != nil {
+		return x.LovedCity
+	}
+	return ""
+}
+
+func (x *PersonalInfoData) GetLovedCountry() string {
+	if x != nil {
+		return x.LovedCountry
+	}
+	return ""
+}
+
+func (x *PersonalInfoData) GetLovedLanguage() string {
+	if x != nil {
+		return x.LovedLanguage
+	}
+	return ""
+}
+
+func (x *PersonalInfoData) GetProblemSolving() string {
+	if x != nil {
+		return x.ProblemSolving
+	}
+	return ""
+}
+
+func (x *PersonalInfoData) GetPrediction() string {
+	if x != nil {
+		return x.Prediction
+	}
+	return ""
+}
+
+func (x *PersonalInfoData) GetAbout() string {
+	if x != nil {
+		return x.About
+	}
+	return ""
+}
+
+func (x *PersonalInfoData) GetPassions() map[string]bool {
+	if x != nil {
+		return x.Passions
+	}
+	return nil
+}
+
+type UpdatePersonalInfoRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Occupation     string                 `protobuf:"bytes,2,opt,name=occupation,proto3" json:"occupation,omitempty"`                                                                         // nullable, max 255
+	Education      string                 `protobuf:"bytes,3,opt,name=education,proto3" json:"education,omitempty"`                                                                           // nullable, max 255
+	Memory         string                 `protobuf:"bytes,4,opt,name=memory,proto3" json:"memory,omitempty"`                                                                                 // nullable, max 2000
+	LovedCity      string                 `protobuf:"bytes,5,opt,name=loved_city,json=lovedCity,proto3" json:"loved_city,omitempty"`                                                          // nullable, max 255
+	LovedCountry   string                 `protobuf:"bytes,6,opt,name=loved_country,json=lovedCountry,proto3" json:"loved_country,omitempty"`                                                 // nullable, max 255
+	LovedLanguage  string                 `protobuf:"bytes,7,opt,name=loved_language,json=lovedLanguage,proto3" json:"loved_language,omitempty"`                                              // nullable, max 255
+	ProblemSolving string                 `protobuf:"bytes,8,opt,name=problem_solving,json=problemSolving,proto3" json:"problem_solving,omitempty"`                                           // nullable, max 2000
+	Prediction     string                 `protobuf:"bytes,9,opt,name=prediction,proto3" json:"prediction,omitempty"`                                                                         // nullable, max 10000
+	About          string                 `protobuf:"bytes,10,opt,name=about,proto3" json:"about,omitempty"`                                                                                  // nullable, max 10000
+	Passions       map[string]bool        `protobuf:"bytes,11,rep,name=passions,proto3" json:"passions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // nullable, passion_key -> boolean
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdatePersonalInfoRequest) Reset() {
+	*x = UpdatePersonalInfoRequest{}
+	mi := &file_auth_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePersonalInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePersonalInfoRequest) ProtoMessage() {}
+
+func (x *UpdatePersonalInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePersonalInfoRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePersonalInfoRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdatePersonalInfoRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdatePersonalInfoRequest) GetOccupation() string {
+	if x != nil {
+		return x.Occupation
+	}
+	return ""
+}
+
+func (x *UpdatePersonalInfoRequest) GetEducation() string {
+	if x != nil {
+		return x.Education
+	}
+	return ""
+}
+
+func (x *UpdatePersonalInfoRequest) GetMemory() string {
+	if x != nil {
+		return x.Memory
+	}
+	return ""
+}
+
+func (x *UpdatePersonalInfoRequest) GetLovedCity() string {
+	if x != nil {
+		return x.LovedCity
+	}
+	return ""
+}
+
+func (x *UpdatePersonalInfoRequest) GetLovedCountry() string {
+	if x != nil {
+		return x.LovedCountry
+	}
+	return ""
+}
+
+func (x *UpdatePersonalInfoRequest) GetLovedLanguage() string {
+	if x != nil {
+		return x.LovedLanguage
+	}
+	return ""
+}
+
+func (x *UpdatePersonalInfoRequest) GetProblemSolving() string {
+	if x != nil {
+		return x.ProblemSolving
+	}
+	return ""
+}
+
+func (x *UpdatePersonalInfoRequest) GetPrediction() string {
+	if x != nil {
+		return x.Prediction
+	}
+	return ""
+}
+
+func (x *UpdatePersonalInfoRequest) GetAbout() string {
+	if x != nil {
+		return x.About
+	}
+	return ""
+}
+
+func (x *UpdatePersonalInfoRequest) GetPassions() map[string]bool {
+	if x != nil {
+		return x.Passions
+	}
+	return nil
+}
+
+type ProfileLimitationOptions struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	Follow                bool                   `protobuf:"varint,1,opt,name=follow,proto3" json:"follow,omitempty"`
 	SendMessage           bool                   `protobuf:"varint,2,opt,name=send_message,json=sendMessage,proto3" json:"send_message,omitempty"`
@@ -3857,21 +4202,1889 @@ type ProfileLimitationOptions struct {
 	sizeCache             protoimpl.SizeCache
 }
 
-func (x *ProfileLimitationOptions) Reset() {
-	*x = ProfileLimitationOptions{}
-	mi := &file_auth_proto_msgTypes[55]
+func (x *ProfileLimitationOptions) Reset() {
+	*x = ProfileLimitationOptions{}
+	mi := &file_auth_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileLimitationOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileLimitationOptions) ProtoMessage() {}
+
+func (x *ProfileLimitationOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileLimitationOptions.ProtoReflect.Descriptor instead.
+func (*ProfileLimitationOptions) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ProfileLimitationOptions) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *ProfileLimitationOptions) GetSendMessage() bool {
+	if x != nil {
+		return x.SendMessage
+	}
+	return false
+}
+
+func (x *ProfileLimitationOptions) GetShare() bool {
+	if x != nil {
+		return x.Share
+	}
+	return false
+}
+
+func (x *ProfileLimitationOptions) GetSendTicket() bool {
+	if x != nil {
+		return x.SendTicket
+	}
+	return false
+}
+
+func (x *ProfileLimitationOptions) GetViewProfileImages() bool {
+	if x != nil {
+		return x.ViewProfileImages
+	}
+	return false
+}
+
+func (x *ProfileLimitationOptions) GetViewFeaturesLocations() bool {
+	if x != nil {
+		return x.ViewFeaturesLocations
+	}
+	return false
+}
+
+type ProfileLimitation struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Id            uint64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LimiterUserId uint64                    `protobuf:"varint,2,opt,name=limiter_user_id,json=limiterUserId,proto3" json:"limiter_user_id,omitempty"`
+	LimitedUserId uint64                    `protobuf:"varint,3,opt,name=limited_user_id,json=limitedUserId,proto3" json:"limited_user_id,omitempty"`
+	Options       *ProfileLimitationOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	Note          string                    `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"` // Only visible to limiter
+	CreatedAt     *timestamppb.Timestamp    `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp    `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileLimitation) Reset() {
+	*x = ProfileLimitation{}
+	mi := &file_auth_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileLimitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileLimitation) ProtoMessage() {}
+
+func (x *ProfileLimitation) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileLimitation.ProtoReflect.Descriptor instead.
+func (*ProfileLimitation) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ProfileLimitation) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProfileLimitation) GetLimiterUserId() uint64 {
+	if x != nil {
+		return x.LimiterUserId
+	}
+	return 0
+}
+
+func (x *ProfileLimitation) GetLimitedUserId() uint64 {
+	if x != nil {
+		return x.LimitedUserId
+	}
+	return 0
+}
+
+func (x *ProfileLimitation) GetOptions() *ProfileLimitationOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ProfileLimitation) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ProfileLimitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProfileLimitation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateProfileLimitationRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	LimiterUserId uint64                    `protobuf:"varint,1,opt,name=limiter_user_id,json=limiterUserId,proto3" json:"limiter_user_id,omitempty"`
+	LimitedUserId uint64                    `protobuf:"varint,2,opt,name=limited_user_id,json=limitedUserId,proto3" json:"limited_user_id,omitempty"`
+	Options       *ProfileLimitationOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	Note          string                    `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"` // Optional, max 500 characters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProfileLimitationRequest) Reset() {
+	*x = CreateProfileLimitationRequest{}
+	mi := &file_auth_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProfileLimitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProfileLimitationRequest) ProtoMessage() {}
+
+func (x *CreateProfileLimitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProfileLimitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateProfileLimitationRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateProfileLimitationRequest) GetLimiterUserId() uint64 {
+	if x != nil {
+		return x.LimiterUserId
+	}
+	return 0
+}
+
+func (x *CreateProfileLimitationRequest) GetLimitedUserId() uint64 {
+	if x != nil {
+		return x.LimitedUserId
+	}
+	return 0
+}
+
+func (x *CreateProfileLimitationRequest) GetOptions() *ProfileLimitationOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateProfileLimitationRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type UpdateProfileLimitationRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	LimitationId  uint64                    `protobuf:"varint,1,opt,name=limitation_id,json=limitationId,proto3" json:"limitation_id,omitempty"`
+	LimiterUserId uint64                    `protobuf:"varint,2,opt,name=limiter_user_id,json=limiterUserId,proto3" json:"limiter_user_id,omitempty"`
+	Options       *ProfileLimitationOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	Note          string                    `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"` // Optional, max 500 characters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileLimitationRequest) Reset() {
+	*x = UpdateProfileLimitationRequest{}
+	mi := &file_auth_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileLimitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileLimitationRequest) ProtoMessage() {}
+
+func (x *UpdateProfileLimitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileLimitationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileLimitationRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UpdateProfileLimitationRequest) GetLimitationId() uint64 {
+	if x != nil {
+		return x.LimitationId
+	}
+	return 0
+}
+
+func (x *UpdateProfileLimitationRequest) GetLimiterUserId() uint64 {
+	if x != nil {
+		return x.LimiterUserId
+	}
+	return 0
+}
+
+func (x *UpdateProfileLimitationRequest) GetOptions() *ProfileLimitationOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *UpdateProfileLimitationRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type DeleteProfileLimitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LimitationId  uint64                 `protobuf:"varint,1,opt,name=limitation_id,json=limitationId,proto3" json:"limitation_id,omitempty"`
+	LimiterUserId uint64                 `protobuf:"varint,2,opt,name=limiter_user_id,json=limiterUserId,proto3" json:"limiter_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProfileLimitationRequest) Reset() {
+	*x = DeleteProfileLimitationRequest{}
+	mi := &file_auth_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProfileLimitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProfileLimitationRequest) ProtoMessage() {}
+
+func (x *DeleteProfileLimitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProfileLimitationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProfileLimitationRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DeleteProfileLimitationRequest) GetLimitationId() uint64 {
+	if x != nil {
+		return x.LimitationId
+	}
+	return 0
+}
+
+func (x *DeleteProfileLimitationRequest) GetLimiterUserId() uint64 {
+	if x != nil {
+		return x.LimiterUserId
+	}
+	return 0
+}
+
+type GetProfileLimitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LimitationId  uint64                 `protobuf:"varint,1,opt,name=limitation_id,json=limitationId,proto3" json:"limitation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileLimitationRequest) Reset() {
+	*x = GetProfileLimitationRequest{}
+	mi := &file_auth_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileLimitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileLimitationRequest) ProtoMessage() {}
+
+func (x *GetProfileLimitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileLimitationRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileLimitationRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetProfileLimitationRequest) GetLimitationId() uint64 {
+	if x != nil {
+		return x.LimitationId
+	}
+	return 0
+}
+
+type GetProfileLimitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallerUserId  uint64                 `protobuf:"varint,1,opt,name=caller_user_id,json=callerUserId,proto3" json:"caller_user_id,omitempty"`
+	TargetUserId  uint64                 `protobuf:"varint,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileLimitationsRequest) Reset() {
+	*x = GetProfileLimitationsRequest{}
+	mi := &file_auth_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileLimitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileLimitationsRequest) ProtoMessage() {}
+
+func (x *GetProfileLimitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileLimitationsRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileLimitationsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetProfileLimitationsRequest) GetCallerUserId() uint64 {
+	if x != nil {
+		return x.CallerUserId
+	}
+	return 0
+}
+
+func (x *GetProfileLimitationsRequest) GetTargetUserId() uint64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+type ProfileLimitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *ProfileLimitation     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileLimitationResponse) Reset() {
+	*x = ProfileLimitationResponse{}
+	mi := &file_auth_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileLimitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileLimitationResponse) ProtoMessage() {}
+
+func (x *ProfileLimitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileLimitationResponse.ProtoReflect.Descriptor instead.
+func (*ProfileLimitationResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ProfileLimitationResponse) GetData() *ProfileLimitation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetProfileLimitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *ProfileLimitation     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // Empty if no limitation exists
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileLimitationsResponse) Reset() {
+	*x = GetProfileLimitationsResponse{}
+	mi := &file_auth_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileLimitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileLimitationsResponse) ProtoMessage() {}
+
+func (x *GetProfileLimitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileLimitationsResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileLimitationsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetProfileLimitationsResponse) GetData() *ProfileLimitation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListProfilePhotosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Authenticated user ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProfilePhotosRequest) Reset() {
+	*x = ListProfilePhotosRequest{}
+	mi := &file_auth_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProfilePhotosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfilePhotosRequest) ProtoMessage() {}
+
+func (x *ListProfilePhotosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfilePhotosRequest.ProtoReflect.Descriptor instead.
+func (*ListProfilePhotosRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListProfilePhotosRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListProfilePhotosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*ProfilePhoto        `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProfilePhotosResponse) Reset() {
+	*x = ListProfilePhotosResponse{}
+	mi := &file_auth_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProfilePhotosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfilePhotosResponse) ProtoMessage() {}
+
+func (x *ListProfilePhotosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfilePhotosResponse.ProtoReflect.Descriptor instead.
+func (*ListProfilePhotosResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListProfilePhotosResponse) GetData() []*ProfilePhoto {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UploadProfilePhotoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`               // Authenticated user ID
+	ImageData     []byte                 `protobuf:"bytes,2,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`       // Image file data (PNG or JPEG, ≤1 MB)
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`                          // Original filename
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // MIME type (image/png, image/jpeg, etc.)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadProfilePhotoRequest) Reset() {
+	*x = UploadProfilePhotoRequest{}
+	mi := &file_auth_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadProfilePhotoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadProfilePhotoRequest) ProtoMessage() {}
+
+func (x *UploadProfilePhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadProfilePhotoRequest.ProtoReflect.Descriptor instead.
+func (*UploadProfilePhotoRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UploadProfilePhotoRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UploadProfilePhotoRequest) GetImageData() []byte {
+	if x != nil {
+		return x.ImageData
+	}
+	return nil
+}
+
+func (x *UploadProfilePhotoRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UploadProfilePhotoRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type GetProfilePhotoRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProfilePhotoId uint64                 `protobuf:"varint,1,opt,name=profile_photo_id,json=profilePhotoId,proto3" json:"profile_photo_id,omitempty"` // Profile photo ID
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetProfilePhotoRequest) Reset() {
+	*x = GetProfilePhotoRequest{}
+	mi := &file_auth_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfilePhotoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfilePhotoRequest) ProtoMessage() {}
+
+func (x *GetProfilePhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfilePhotoRequest.ProtoReflect.Descriptor instead.
+func (*GetProfilePhotoRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetProfilePhotoRequest) GetProfilePhotoId() uint64 {
+	if x != nil {
+		return x.ProfilePhotoId
+	}
+	return 0
+}
+
+type DeleteProfilePhotoRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                           // Authenticated user ID (for ownership check)
+	ProfilePhotoId uint64                 `protobuf:"varint,2,opt,name=profile_photo_id,json=profilePhotoId,proto3" json:"profile_photo_id,omitempty"` // Profile photo ID to delete
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteProfilePhotoRequest) Reset() {
+	*x = DeleteProfilePhotoRequest{}
+	mi := &file_auth_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProfilePhotoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProfilePhotoRequest) ProtoMessage() {}
+
+func (x *DeleteProfilePhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProfilePhotoRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProfilePhotoRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DeleteProfilePhotoRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteProfilePhotoRequest) GetProfilePhotoId() uint64 {
+	if x != nil {
+		return x.ProfilePhotoId
+	}
+	return 0
+}
+
+type ProfilePhotoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfilePhotoResponse) Reset() {
+	*x = ProfilePhotoResponse{}
+	mi := &file_auth_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfilePhotoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfilePhotoResponse) ProtoMessage() {}
+
+func (x *ProfilePhotoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfilePhotoResponse.ProtoReflect.Descriptor instead.
+func (*ProfilePhotoResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ProfilePhotoResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProfilePhotoResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetPhotoStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // Authenticated user ID (for ownership check)
+	UploadId      uint64                 `protobuf:"varint,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"` // Upload ID returned by UploadProfilePhoto
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPhotoStatusRequest) Reset() {
+	*x = GetPhotoStatusRequest{}
+	mi := &file_auth_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPhotoStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPhotoStatusRequest) ProtoMessage() {}
+
+func (x *GetPhotoStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPhotoStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPhotoStatusRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetPhotoStatusRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetPhotoStatusRequest) GetUploadId() uint64 {
+	if x != nil {
+		return x.UploadId
+	}
+	return 0
+}
+
+type PhotoUploadStatusResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UploadId       uint64                 `protobuf:"varint,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                                          // processing, ready, or failed
+	ProfilePhotoId uint64                 `protobuf:"varint,3,opt,name=profile_photo_id,json=profilePhotoId,proto3" json:"profile_photo_id,omitempty"` // Set once ready
+	Url            string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`                                                // Set once ready
+	Error          string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`                                            // Reason when failed
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PhotoUploadStatusResponse) Reset() {
+	*x = PhotoUploadStatusResponse{}
+	mi := &file_auth_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PhotoUploadStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhotoUploadStatusResponse) ProtoMessage() {}
+
+func (x *PhotoUploadStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhotoUploadStatusResponse.ProtoReflect.Descriptor instead.
+func (*PhotoUploadStatusResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PhotoUploadStatusResponse) GetUploadId() uint64 {
+	if x != nil {
+		return x.UploadId
+	}
+	return 0
+}
+
+func (x *PhotoUploadStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PhotoUploadStatusResponse) GetProfilePhotoId() uint64 {
+	if x != nil {
+		return x.ProfilePhotoId
+	}
+	return 0
+}
+
+func (x *PhotoUploadStatusResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PhotoUploadStatusResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettingsRequest) Reset() {
+	*x = GetSettingsRequest{}
+	mi := &file_auth_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettingsRequest) ProtoMessage() {}
+
+func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetSettingsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *SettingsData          `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettingsResponse) Reset() {
+	*x = GetSettingsResponse{}
+	mi := &file_auth_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettingsResponse) ProtoMessage() {}
+
+func (x *GetSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetSettingsResponse) GetData() *SettingsData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SettingsData struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CheckoutDaysCount uint32                 `protobuf:"varint,1,opt,name=checkout_days_count,json=checkoutDaysCount,proto3" json:"checkout_days_count,omitempty"`
+	AutomaticLogout   int32                  `protobuf:"varint,2,opt,name=automatic_logout,json=automaticLogout,proto3" json:"automatic_logout,omitempty"`
+	Timezone          string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA name such as "Europe/Berlin", Asia/Tehran until the user chooses one
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SettingsData) Reset() {
+	*x = SettingsData{}
+	mi := &file_auth_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettingsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettingsData) ProtoMessage() {}
+
+func (x *SettingsData) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettingsData.ProtoReflect.Descriptor instead.
+func (*SettingsData) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SettingsData) GetCheckoutDaysCount() uint32 {
+	if x != nil {
+		return x.CheckoutDaysCount
+	}
+	return 0
+}
+
+func (x *SettingsData) GetAutomaticLogout() int32 {
+	if x != nil {
+		return x.AutomaticLogout
+	}
+	return 0
+}
+
+func (x *SettingsData) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type UpdateSettingsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Checkout cadence update (both must be present if updating checkout)
+	CheckoutDaysCount uint32 `protobuf:"varint,2,opt,name=checkout_days_count,json=checkoutDaysCount,proto3" json:"checkout_days_count,omitempty"` // 3-1000 (optional, but required if updating checkout)
+	AutomaticLogout   int32  `protobuf:"varint,3,opt,name=automatic_logout,json=automaticLogout,proto3" json:"automatic_logout,omitempty"`         // 1-55 minutes (optional, but required if updating checkout)
+	// Profile exposure toggle (both must be present if updating profile exposure)
+	Setting       string `protobuf:"bytes,4,opt,name=setting,proto3" json:"setting,omitempty"`   // "status", "level", or "details" (optional)
+	Status        bool   `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`    // boolean (optional, but required if setting is present)
+	Timezone      string `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA timezone name (optional, unchanged when empty)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSettingsRequest) Reset() {
+	*x = UpdateSettingsRequest{}
+	mi := &file_auth_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UpdateSettingsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateSettingsRequest) GetCheckoutDaysCount() uint32 {
+	if x != nil {
+		return x.CheckoutDaysCount
+	}
+	return 0
+}
+
+func (x *UpdateSettingsRequest) GetAutomaticLogout() int32 {
+	if x != nil {
+		return x.AutomaticLogout
+	}
+	return 0
+}
+
+func (x *UpdateSettingsRequest) GetSetting() string {
+	if x != nil {
+		return x.Setting
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *UpdateSettingsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type GetGeneralSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGeneralSettingsRequest) Reset() {
+	*x = GetGeneralSettingsRequest{}
+	mi := &file_auth_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGeneralSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGeneralSettingsRequest) ProtoMessage() {}
+
+func (x *GetGeneralSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGeneralSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetGeneralSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetGeneralSettingsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetGeneralSettingsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Data          *NotificationSettingsData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGeneralSettingsResponse) Reset() {
+	*x = GetGeneralSettingsResponse{}
+	mi := &file_auth_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGeneralSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGeneralSettingsResponse) ProtoMessage() {}
+
+func (x *GetGeneralSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGeneralSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetGeneralSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetGeneralSettingsResponse) GetData() *NotificationSettingsData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type NotificationSettingsData struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	AnnouncementsSms       bool                   `protobuf:"varint,1,opt,name=announcements_sms,json=announcementsSms,proto3" json:"announcements_sms,omitempty"`
+	AnnouncementsEmail     bool                   `protobuf:"varint,2,opt,name=announcements_email,json=announcementsEmail,proto3" json:"announcements_email,omitempty"`
+	ReportsSms             bool                   `protobuf:"varint,3,opt,name=reports_sms,json=reportsSms,proto3" json:"reports_sms,omitempty"`
+	ReportsEmail           bool                   `protobuf:"varint,4,opt,name=reports_email,json=reportsEmail,proto3" json:"reports_email,omitempty"`
+	LoginVerificationSms   bool                   `protobuf:"varint,5,opt,name=login_verification_sms,json=loginVerificationSms,proto3" json:"login_verification_sms,omitempty"`
+	LoginVerificationEmail bool                   `protobuf:"varint,6,opt,name=login_verification_email,json=loginVerificationEmail,proto3" json:"login_verification_email,omitempty"`
+	TransactionsSms        bool                   `protobuf:"varint,7,opt,name=transactions_sms,json=transactionsSms,proto3" json:"transactions_sms,omitempty"`
+	TransactionsEmail      bool                   `protobuf:"varint,8,opt,name=transactions_email,json=transactionsEmail,proto3" json:"transactions_email,omitempty"`
+	TradesSms              bool                   `protobuf:"varint,9,opt,name=trades_sms,json=tradesSms,proto3" json:"trades_sms,omitempty"`
+	TradesEmail            bool                   `protobuf:"varint,10,opt,name=trades_email,json=tradesEmail,proto3" json:"trades_email,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *NotificationSettingsData) Reset() {
+	*x = NotificationSettingsData{}
+	mi := &file_auth_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSettingsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSettingsData) ProtoMessage() {}
+
+func (x *NotificationSettingsData) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSettingsData.ProtoReflect.Descriptor instead.
+func (*NotificationSettingsData) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *NotificationSettingsData) GetAnnouncementsSms() bool {
+	if x != nil {
+		return x.AnnouncementsSms
+	}
+	return false
+}
+
+func (x *NotificationSettingsData) GetAnnouncementsEmail() bool {
+	if x != nil {
+		return x.AnnouncementsEmail
+	}
+	return false
+}
+
+func (x *NotificationSettingsData) GetReportsSms() bool {
+	if x != nil {
+		return x.ReportsSms
+	}
+	return false
+}
+
+func (x *NotificationSettingsData) GetReportsEmail() bool {
+	if x != nil {
+		return x.ReportsEmail
+	}
+	return false
+}
+
+func (x *NotificationSettingsData) GetLoginVerificationSms() bool {
+	if x != nil {
+		return x.LoginVerificationSms
+	}
+	return false
+}
+
+func (x *NotificationSettingsData) GetLoginVerificationEmail() bool {
+	if x != nil {
+		return x.LoginVerificationEmail
+	}
+	return false
+}
+
+func (x *NotificationSettingsData) GetTransactionsSms() bool {
+	if x != nil {
+		return x.TransactionsSms
+	}
+	return false
+}
+
+func (x *NotificationSettingsData) GetTransactionsEmail() bool {
+	if x != nil {
+		return x.TransactionsEmail
+	}
+	return false
+}
+
+func (x *NotificationSettingsData) GetTradesSms() bool {
+	if x != nil {
+		return x.TradesSms
+	}
+	return false
+}
+
+func (x *NotificationSettingsData) GetTradesEmail() bool {
+	if x != nil {
+		return x.TradesEmail
+	}
+	return false
+}
+
+type UpdateGeneralSettingsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	UserId        uint64                    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SettingId     uint64                    `protobuf:"varint,2,opt,name=setting_id,json=settingId,proto3" json:"setting_id,omitempty"` // Settings ID for ownership check
+	Notifications *NotificationSettingsData `protobuf:"bytes,3,opt,name=notifications,proto3" json:"notifications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGeneralSettingsRequest) Reset() {
+	*x = UpdateGeneralSettingsRequest{}
+	mi := &file_auth_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGeneralSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGeneralSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateGeneralSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGeneralSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGeneralSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UpdateGeneralSettingsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateGeneralSettingsRequest) GetSettingId() uint64 {
+	if x != nil {
+		return x.SettingId
+	}
+	return 0
+}
+
+func (x *UpdateGeneralSettingsRequest) GetNotifications() *NotificationSettingsData {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+type UpdateGeneralSettingsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Data          *NotificationSettingsData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGeneralSettingsResponse) Reset() {
+	*x = UpdateGeneralSettingsResponse{}
+	mi := &file_auth_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGeneralSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGeneralSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateGeneralSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGeneralSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGeneralSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *UpdateGeneralSettingsResponse) GetData() *NotificationSettingsData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetPrivacySettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrivacySettingsRequest) Reset() {
+	*x = GetPrivacySettingsRequest{}
+	mi := &file_auth_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivacySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivacySettingsRequest) ProtoMessage() {}
+
+func (x *GetPrivacySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivacySettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetPrivacySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetPrivacySettingsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetPrivacySettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          map[string]int32       `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // key -> 0|1 (0=private, 1=public)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrivacySettingsResponse) Reset() {
+	*x = GetPrivacySettingsResponse{}
+	mi := &file_auth_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivacySettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivacySettingsResponse) ProtoMessage() {}
+
+func (x *GetPrivacySettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivacySettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetPrivacySettingsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetPrivacySettingsResponse) GetData() map[string]int32 {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdatePrivacySettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`      // Privacy field key
+	Value         int32                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"` // 0 or 1 (boolean or numeric representation)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePrivacySettingsRequest) Reset() {
+	*x = UpdatePrivacySettingsRequest{}
+	mi := &file_auth_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePrivacySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrivacySettingsRequest) ProtoMessage() {}
+
+func (x *UpdatePrivacySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrivacySettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePrivacySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UpdatePrivacySettingsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdatePrivacySettingsRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UpdatePrivacySettingsRequest) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type ListUserEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"` // Page number (default: 1)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserEventsRequest) Reset() {
+	*x = ListUserEventsRequest{}
+	mi := &file_auth_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserEventsRequest) ProtoMessage() {}
+
+func (x *ListUserEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserEventsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListUserEventsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListUserEventsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type ListUserEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*UserEventResource   `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination    *PaginationMeta        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserEventsResponse) Reset() {
+	*x = ListUserEventsResponse{}
+	mi := &file_auth_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserEventsResponse) ProtoMessage() {}
+
+func (x *ListUserEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserEventsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListUserEventsResponse) GetData() []*UserEventResource {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListUserEventsResponse) GetPagination() *PaginationMeta {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetUserEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventId       uint64                 `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserEventRequest) Reset() {
+	*x = GetUserEventRequest{}
+	mi := &file_auth_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserEventRequest) ProtoMessage() {}
+
+func (x *GetUserEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserEventRequest.ProtoReflect.Descriptor instead.
+func (*GetUserEventRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetUserEventRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserEventRequest) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+type GetUserEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *UserEventResource     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserEventResponse) Reset() {
+	*x = GetUserEventResponse{}
+	mi := &file_auth_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserEventResponse) ProtoMessage() {}
+
+func (x *GetUserEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserEventResponse.ProtoReflect.Descriptor instead.
+func (*GetUserEventResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetUserEventResponse) GetData() *UserEventResource {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ReportUserEventRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventId           uint64                 `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SuspeciousCitizen string                 `protobuf:"bytes,3,opt,name=suspecious_citizen,json=suspeciousCitizen,proto3" json:"suspecious_citizen,omitempty"` // Optional: citizen code
+	EventDescription  string                 `protobuf:"bytes,4,opt,name=event_description,json=eventDescription,proto3" json:"event_description,omitempty"`    // Required: max 500 characters
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReportUserEventRequest) Reset() {
+	*x = ReportUserEventRequest{}
+	mi := &file_auth_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProfileLimitationOptions) String() string {
+func (x *ReportUserEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfileLimitationOptions) ProtoMessage() {}
+func (*ReportUserEventRequest) ProtoMessage() {}
 
-func (x *ProfileLimitationOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[55]
+func (x *ReportUserEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3882,81 +6095,63 @@ func (x *ProfileLimitationOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfileLimitationOptions.ProtoReflect.Descriptor instead.
-func (*ProfileLimitationOptions) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{55}
-}
-
-func (x *ProfileLimitationOptions) GetFollow() bool {
-	if x != nil {
-		return x.Follow
-	}
-	return false
-}
-
-func (x *ProfileLimitationOptions) GetSendMessage() bool {
-	if x != nil {
-		return x.SendMessage
-	}
-	return false
+// Deprecated: Use ReportUserEventRequest.ProtoReflect.Descriptor instead.
+func (*ReportUserEventRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *ProfileLimitationOptions) GetShare() bool {
+func (x *ReportUserEventRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Share
+		return x.UserId
 	}
-	return false
+	return 0
 }
 
-func (x *ProfileLimitationOptions) GetSendTicket() bool {
+func (x *ReportUserEventRequest) GetEventId() uint64 {
 	if x != nil {
-		return x.SendTicket
+		return x.EventId
 	}
-	return false
+	return 0
 }
 
-func (x *ProfileLimitationOptions) GetViewProfileImages() bool {
+func (x *ReportUserEventRequest) GetSuspeciousCitizen() string {
 	if x != nil {
-		return x.ViewProfileImages
+		return x.SuspeciousCitizen
 	}
-	return false
+	return ""
 }
 
-func (x *ProfileLimitationOptions) GetViewFeaturesLocations() bool {
+func (x *ReportUserEventRequest) GetEventDescription() string {
 	if x != nil {
-		return x.ViewFeaturesLocations
+		return x.EventDescription
 	}
-	return false
+	return ""
 }
 
-type ProfileLimitation struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Id            uint64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	LimiterUserId uint64                    `protobuf:"varint,2,opt,name=limiter_user_id,json=limiterUserId,proto3" json:"limiter_user_id,omitempty"`
-	LimitedUserId uint64                    `protobuf:"varint,3,opt,name=limited_user_id,json=limitedUserId,proto3" json:"limited_user_id,omitempty"`
-	Options       *ProfileLimitationOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
-	Note          string                    `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"` // Only visible to limiter
-	CreatedAt     *timestamppb.Timestamp    `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp    `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+type SendReportResponseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventId       uint64                 `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Response      string                 `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"` // Required: max 300 characters
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProfileLimitation) Reset() {
-	*x = ProfileLimitation{}
-	mi := &file_auth_proto_msgTypes[56]
+func (x *SendReportResponseRequest) Reset() {
+	*x = SendReportResponseRequest{}
+	mi := &file_auth_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProfileLimitation) String() string {
+func (x *SendReportResponseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfileLimitation) ProtoMessage() {}
+func (*SendReportResponseRequest) ProtoMessage() {}
 
-func (x *ProfileLimitation) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[56]
+func (x *SendReportResponseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3967,85 +6162,109 @@ func (x *ProfileLimitation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfileLimitation.ProtoReflect.Descriptor instead.
-func (*ProfileLimitation) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use SendReportResponseRequest.ProtoReflect.Descriptor instead.
+func (*SendReportResponseRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *ProfileLimitation) GetId() uint64 {
+func (x *SendReportResponseRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *ProfileLimitation) GetLimiterUserId() uint64 {
+func (x *SendReportResponseRequest) GetEventId() uint64 {
 	if x != nil {
-		return x.LimiterUserId
+		return x.EventId
 	}
 	return 0
 }
 
-func (x *ProfileLimitation) GetLimitedUserId() uint64 {
+func (x *SendReportResponseRequest) GetResponse() string {
 	if x != nil {
-		return x.LimitedUserId
+		return x.Response
 	}
-	return 0
+	return ""
 }
 
-func (x *ProfileLimitation) GetOptions() *ProfileLimitationOptions {
-	if x != nil {
-		return x.Options
-	}
-	return nil
+type CloseEventReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventId       uint64                 `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProfileLimitation) GetNote() string {
+func (x *CloseEventReportRequest) Reset() {
+	*x = CloseEventReportRequest{}
+	mi := &file_auth_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseEventReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseEventReportRequest) ProtoMessage() {}
+
+func (x *CloseEventReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[95]
 	if x != nil {
-		return x.Note
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ProfileLimitation) GetCreatedAt() *timestamppb.Timestamp {
+// Deprecated: Use CloseEventReportRequest.ProtoReflect.Descriptor instead.
+func (*CloseEventReportRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CloseEventReportRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-func (x *ProfileLimitation) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *CloseEventReportRequest) GetEventId() uint64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.EventId
 	}
-	return nil
+	return 0
 }
 
-type CreateProfileLimitationRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	LimiterUserId uint64                    `protobuf:"varint,1,opt,name=limiter_user_id,json=limiterUserId,proto3" json:"limiter_user_id,omitempty"`
-	LimitedUserId uint64                    `protobuf:"varint,2,opt,name=limited_user_id,json=limitedUserId,proto3" json:"limited_user_id,omitempty"`
-	Options       *ProfileLimitationOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
-	Note          string                    `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"` // Optional, max 500 characters
+type ExportUserEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequesterId   uint64                 `protobuf:"varint,1,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"` // Authenticated caller; compliance admins can export any user
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // Optional for compliance admins: 0 exports every user
+	FromDate      string                 `protobuf:"bytes,3,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`           // Optional: YYYY-MM-DD, inclusive
+	ToDate        string                 `protobuf:"bytes,4,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`                 // Optional: YYYY-MM-DD, inclusive
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProfileLimitationRequest) Reset() {
-	*x = CreateProfileLimitationRequest{}
-	mi := &file_auth_proto_msgTypes[57]
+func (x *ExportUserEventsRequest) Reset() {
+	*x = ExportUserEventsRequest{}
+	mi := &file_auth_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProfileLimitationRequest) String() string {
+func (x *ExportUserEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProfileLimitationRequest) ProtoMessage() {}
+func (*ExportUserEventsRequest) ProtoMessage() {}
 
-func (x *CreateProfileLimitationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[57]
+func (x *ExportUserEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4056,64 +6275,112 @@ func (x *CreateProfileLimitationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProfileLimitationRequest.ProtoReflect.Descriptor instead.
-func (*CreateProfileLimitationRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use ExportUserEventsRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserEventsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *CreateProfileLimitationRequest) GetLimiterUserId() uint64 {
+func (x *ExportUserEventsRequest) GetRequesterId() uint64 {
 	if x != nil {
-		return x.LimiterUserId
+		return x.RequesterId
 	}
 	return 0
 }
 
-func (x *CreateProfileLimitationRequest) GetLimitedUserId() uint64 {
+func (x *ExportUserEventsRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.LimitedUserId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *CreateProfileLimitationRequest) GetOptions() *ProfileLimitationOptions {
+func (x *ExportUserEventsRequest) GetFromDate() string {
 	if x != nil {
-		return x.Options
+		return x.FromDate
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateProfileLimitationRequest) GetNote() string {
+func (x *ExportUserEventsRequest) GetToDate() string {
 	if x != nil {
-		return x.Note
+		return x.ToDate
 	}
 	return ""
 }
 
-type UpdateProfileLimitationRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	LimitationId  uint64                    `protobuf:"varint,1,opt,name=limitation_id,json=limitationId,proto3" json:"limitation_id,omitempty"`
-	LimiterUserId uint64                    `protobuf:"varint,2,opt,name=limiter_user_id,json=limiterUserId,proto3" json:"limiter_user_id,omitempty"`
-	Options       *ProfileLimitationOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
-	Note          string                    `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"` // Optional, max 500 characters
+type UserEventsExportChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // Next part of the CSV file, starting with the header row
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProfileLimitationRequest) Reset() {
-	*x = UpdateProfileLimitationRequest{}
-	mi := &file_auth_proto_msgTypes[58]
+func (x *UserEventsExportChunk) Reset() {
+	*x = UserEventsExportChunk{}
+	mi := &file_auth_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProfileLimitationRequest) String() string {
+func (x *UserEventsExportChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProfileLimitationRequest) ProtoMessage() {}
+func (*UserEventsExportChunk) ProtoMessage() {}
+
+func (x *UserEventsExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserEventsExportChunk.ProtoReflect.Descriptor instead.
+func (*UserEventsExportChunk) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UserEventsExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UserEventResource struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Id            uint64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event         string                   `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Ip            string                   `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	Device        string                   `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	Status        string                   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // "موفق" or "ناموفق" (Persian: successful or unsuccessful)
+	Date          string                   `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`     // Jalali format: Y/m/d
+	Time          string                   `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`     // H:m:s format
+	Report        *UserEventReportResource `protobuf:"bytes,8,opt,name=report,proto3" json:"report,omitempty"` // Only present in GetUserEvent response
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserEventResource) Reset() {
+	*x = UserEventResource{}
+	mi := &file_auth_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserEventResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEventResource) ProtoMessage() {}
 
-func (x *UpdateProfileLimitationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[58]
+func (x *UserEventResource) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4124,62 +6391,96 @@ func (x *UpdateProfileLimitationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProfileLimitationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProfileLimitationRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use UserEventResource.ProtoReflect.Descriptor instead.
+func (*UserEventResource) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *UpdateProfileLimitationRequest) GetLimitationId() uint64 {
+func (x *UserEventResource) GetId() uint64 {
 	if x != nil {
-		return x.LimitationId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateProfileLimitationRequest) GetLimiterUserId() uint64 {
+func (x *UserEventResource) GetEvent() string {
 	if x != nil {
-		return x.LimiterUserId
+		return x.Event
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateProfileLimitationRequest) GetOptions() *ProfileLimitationOptions {
+func (x *UserEventResource) GetIp() string {
 	if x != nil {
-		return x.Options
+		return x.Ip
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateProfileLimitationRequest) GetNote() string {
+func (x *UserEventResource) GetDevice() string {
 	if x != nil {
-		return x.Note
+		return x.Device
 	}
 	return ""
 }
 
-type DeleteProfileLimitationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LimitationId  uint64                 `protobuf:"varint,1,opt,name=limitation_id,json=limitationId,proto3" json:"limitation_id,omitempty"`
-	LimiterUserId uint64                 `protobuf:"varint,2,opt,name=limiter_user_id,json=limiterUserId,proto3" json:"limiter_user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UserEventResource) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *DeleteProfileLimitationRequest) Reset() {
-	*x = DeleteProfileLimitationRequest{}
-	mi := &file_auth_proto_msgTypes[59]
+func (x *UserEventResource) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *UserEventResource) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *UserEventResource) GetReport() *UserEventReportResource {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type UserEventReportResource struct {
+	state             protoimpl.MessageState             `protogen:"open.v1"`
+	Id                uint64                             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SuspeciousCitizen string                             `protobuf:"bytes,2,opt,name=suspecious_citizen,json=suspeciousCitizen,proto3" json:"suspecious_citizen,omitempty"` // Optional
+	EventDescription  string                             `protobuf:"bytes,3,opt,name=event_description,json=eventDescription,proto3" json:"event_description,omitempty"`
+	Status            int32                              `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	Closed            bool                               `protobuf:"varint,5,opt,name=closed,proto3" json:"closed,omitempty"`
+	Date              string                             `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"` // Jalali format: Y/m/d
+	Time              string                             `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"` // H:m:s format
+	Responses         []*UserEventReportResponseResource `protobuf:"bytes,8,rep,name=responses,proto3" json:"responses,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UserEventReportResource) Reset() {
+	*x = UserEventReportResource{}
+	mi := &file_auth_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProfileLimitationRequest) String() string {
+func (x *UserEventReportResource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProfileLimitationRequest) ProtoMessage() {}
+func (*UserEventReportResource) ProtoMessage() {}
 
-func (x *DeleteProfileLimitationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[59]
+func (x *UserEventReportResource) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4190,92 +6491,93 @@ func (x *DeleteProfileLimitationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProfileLimitationRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProfileLimitationRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use UserEventReportResource.ProtoReflect.Descriptor instead.
+func (*UserEventReportResource) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *DeleteProfileLimitationRequest) GetLimitationId() uint64 {
+func (x *UserEventReportResource) GetId() uint64 {
 	if x != nil {
-		return x.LimitationId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *DeleteProfileLimitationRequest) GetLimiterUserId() uint64 {
+func (x *UserEventReportResource) GetSuspeciousCitizen() string {
 	if x != nil {
-		return x.LimiterUserId
+		return x.SuspeciousCitizen
 	}
-	return 0
+	return ""
 }
 
-type GetProfileLimitationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LimitationId  uint64                 `protobuf:"varint,1,opt,name=limitation_id,json=limitationId,proto3" json:"limitation_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UserEventReportResource) GetEventDescription() string {
+	if x != nil {
+		return x.EventDescription
+	}
+	return ""
 }
 
-func (x *GetProfileLimitationRequest) Reset() {
-	*x = GetProfileLimitationRequest{}
-	mi := &file_auth_proto_msgTypes[60]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UserEventReportResource) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
 }
 
-func (x *GetProfileLimitationRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserEventReportResource) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
 }
 
-func (*GetProfileLimitationRequest) ProtoMessage() {}
-
-func (x *GetProfileLimitationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[60]
+func (x *UserEventReportResource) GetDate() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Date
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetProfileLimitationRequest.ProtoReflect.Descriptor instead.
-func (*GetProfileLimitationRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{60}
+func (x *UserEventReportResource) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
 }
 
-func (x *GetProfileLimitationRequest) GetLimitationId() uint64 {
+func (x *UserEventReportResource) GetResponses() []*UserEventReportResponseResource {
 	if x != nil {
-		return x.LimitationId
+		return x.Responses
 	}
-	return 0
+	return nil
 }
 
-type GetProfileLimitationsRequest struct {
+type UserEventReportResponseResource struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CallerUserId  uint64                 `protobuf:"varint,1,opt,name=caller_user_id,json=callerUserId,proto3" json:"caller_user_id,omitempty"`
-	TargetUserId  uint64                 `protobuf:"varint,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResponserName string                 `protobuf:"bytes,2,opt,name=responser_name,json=responserName,proto3" json:"responser_name,omitempty"`
+	Response      string                 `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	Date          string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"` // Jalali format: Y/m/d
+	Time          string                 `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"` // H:m:s format
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileLimitationsRequest) Reset() {
-	*x = GetProfileLimitationsRequest{}
-	mi := &file_auth_proto_msgTypes[61]
+func (x *UserEventReportResponseResource) Reset() {
+	*x = UserEventReportResponseResource{}
+	mi := &file_auth_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileLimitationsRequest) String() string {
+func (x *UserEventReportResponseResource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileLimitationsRequest) ProtoMessage() {}
+func (*UserEventReportResponseResource) ProtoMessage() {}
 
-func (x *GetProfileLimitationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[61]
+func (x *UserEventReportResponseResource) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4286,91 +6588,68 @@ func (x *GetProfileLimitationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileLimitationsRequest.ProtoReflect.Descriptor instead.
-func (*GetProfileLimitationsRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use UserEventReportResponseResource.ProtoReflect.Descriptor instead.
+func (*UserEventReportResponseResource) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *GetProfileLimitationsRequest) GetCallerUserId() uint64 {
+func (x *UserEventReportResponseResource) GetId() uint64 {
 	if x != nil {
-		return x.CallerUserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *GetProfileLimitationsRequest) GetTargetUserId() uint64 {
+func (x *UserEventReportResponseResource) GetResponserName() string {
 	if x != nil {
-		return x.TargetUserId
+		return x.ResponserName
 	}
-	return 0
-}
-
-type ProfileLimitationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *ProfileLimitation     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ProfileLimitationResponse) Reset() {
-	*x = ProfileLimitationResponse{}
-	mi := &file_auth_proto_msgTypes[62]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ProfileLimitationResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*ProfileLimitationResponse) ProtoMessage() {}
-
-func (x *ProfileLimitationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[62]
+func (x *UserEventReportResponseResource) GetResponse() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Response
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ProfileLimitationResponse.ProtoReflect.Descriptor instead.
-func (*ProfileLimitationResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{62}
+func (x *UserEventReportResponseResource) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
 }
 
-func (x *ProfileLimitationResponse) GetData() *ProfileLimitation {
+func (x *UserEventReportResponseResource) GetTime() string {
 	if x != nil {
-		return x.Data
+		return x.Time
 	}
-	return nil
+	return ""
 }
 
-type GetProfileLimitationsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *ProfileLimitation     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // Empty if no limitation exists
+type UserEventReportResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Data          *UserEventReportResource `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileLimitationsResponse) Reset() {
-	*x = GetProfileLimitationsResponse{}
-	mi := &file_auth_proto_msgTypes[63]
+func (x *UserEventReportResponse) Reset() {
+	*x = UserEventReportResponse{}
+	mi := &file_auth_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileLimitationsResponse) String() string {
+func (x *UserEventReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileLimitationsResponse) ProtoMessage() {}
+func (*UserEventReportResponse) ProtoMessage() {}
 
-func (x *GetProfileLimitationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[63]
+func (x *UserEventReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4381,40 +6660,40 @@ func (x *GetProfileLimitationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileLimitationsResponse.ProtoReflect.Descriptor instead.
-func (*GetProfileLimitationsResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use UserEventReportResponse.ProtoReflect.Descriptor instead.
+func (*UserEventReportResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *GetProfileLimitationsResponse) GetData() *ProfileLimitation {
+func (x *UserEventReportResponse) GetData() *UserEventReportResource {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type ListProfilePhotosRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Authenticated user ID
+type UserEventReportResponseResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Data          *UserEventReportResponseResource `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProfilePhotosRequest) Reset() {
-	*x = ListProfilePhotosRequest{}
-	mi := &file_auth_proto_msgTypes[64]
+func (x *UserEventReportResponseResponse) Reset() {
+	*x = UserEventReportResponseResponse{}
+	mi := &file_auth_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProfilePhotosRequest) String() string {
+func (x *UserEventReportResponseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProfilePhotosRequest) ProtoMessage() {}
+func (*UserEventReportResponseResponse) ProtoMessage() {}
 
-func (x *ListProfilePhotosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[64]
+func (x *UserEventReportResponseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4425,40 +6704,43 @@ func (x *ListProfilePhotosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProfilePhotosRequest.ProtoReflect.Descriptor instead.
-func (*ListProfilePhotosRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use UserEventReportResponseResponse.ProtoReflect.Descriptor instead.
+func (*UserEventReportResponseResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *ListProfilePhotosRequest) GetUserId() uint64 {
+func (x *UserEventReportResponseResponse) GetData() *UserEventReportResponseResource {
 	if x != nil {
-		return x.UserId
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-type ListProfilePhotosResponse struct {
+// ListUsersRequest - GET /api/users
+type ListUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*ProfilePhoto        `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Search        string                 `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`                  // Optional: partial match on name column
+	OrderBy       string                 `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"` // Optional: one of "score", "registered_at_asc", "registered_at_desc"
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                     // Optional: pagination cursor (default: 1)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProfilePhotosResponse) Reset() {
-	*x = ListProfilePhotosResponse{}
-	mi := &file_auth_proto_msgTypes[65]
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_auth_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProfilePhotosResponse) String() string {
+func (x *ListUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProfilePhotosResponse) ProtoMessage() {}
+func (*ListUsersRequest) ProtoMessage() {}
 
-func (x *ListProfilePhotosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[65]
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4469,43 +6751,57 @@ func (x *ListProfilePhotosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProfilePhotosResponse.ProtoReflect.Descriptor instead.
-func (*ListProfilePhotosResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *ListProfilePhotosResponse) GetData() []*ProfilePhoto {
+func (x *ListUsersRequest) GetSearch() string {
 	if x != nil {
-		return x.Data
+		return x.Search
 	}
-	return nil
+	return ""
 }
 
-type UploadProfilePhotoRequest struct {
+func (x *ListUsersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+// ListUsersResponse - paginated list of users
+type ListUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`               // Authenticated user ID
-	ImageData     []byte                 `protobuf:"bytes,2,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`       // Image file data (PNG or JPEG, ≤1 MB)
-	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`                          // Original filename
-	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // MIME type (image/png, image/jpeg, etc.)
+	Data          []*UserListItem        `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Links         *PaginationLinks       `protobuf:"bytes,2,opt,name=links,proto3" json:"links,omitempty"`
+	Meta          *PaginationMeta        `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadProfilePhotoRequest) Reset() {
-	*x = UploadProfilePhotoRequest{}
-	mi := &file_auth_proto_msgTypes[66]
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_auth_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadProfilePhotoRequest) String() string {
+func (x *ListUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadProfilePhotoRequest) ProtoMessage() {}
+func (*ListUsersResponse) ProtoMessage() {}
 
-func (x *UploadProfilePhotoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[66]
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4516,61 +6812,60 @@ func (x *UploadProfilePhotoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadProfilePhotoRequest.ProtoReflect.Descriptor instead.
-func (*UploadProfilePhotoRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{66}
-}
-
-func (x *UploadProfilePhotoRequest) GetUserId() uint64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *UploadProfilePhotoRequest) GetImageData() []byte {
+func (x *ListUsersResponse) GetData() []*UserListItem {
 	if x != nil {
-		return x.ImageData
+		return x.Data
 	}
 	return nil
 }
 
-func (x *UploadProfilePhotoRequest) GetFilename() string {
+func (x *ListUsersResponse) GetLinks() *PaginationLinks {
 	if x != nil {
-		return x.Filename
+		return x.Links
 	}
-	return ""
+	return nil
 }
 
-func (x *UploadProfilePhotoRequest) GetContentType() string {
+func (x *ListUsersResponse) GetMeta() *PaginationMeta {
 	if x != nil {
-		return x.ContentType
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-type GetProfilePhotoRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ProfilePhotoId uint64                 `protobuf:"varint,1,opt,name=profile_photo_id,json=profilePhotoId,proto3" json:"profile_photo_id,omitempty"` // Profile photo ID
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// UserListItem - user summary for list endpoint
+type UserListItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Prefers KYC full name when available
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	Levels        *UserLevelInfo         `protobuf:"bytes,5,opt,name=levels,proto3" json:"levels,omitempty"`                                 // Current and previous level info
+	ProfilePhoto  string                 `protobuf:"bytes,6,opt,name=profile_photo,json=profilePhoto,proto3" json:"profile_photo,omitempty"` // Latest profile photo URL
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfilePhotoRequest) Reset() {
-	*x = GetProfilePhotoRequest{}
-	mi := &file_auth_proto_msgTypes[67]
+func (x *UserListItem) Reset() {
+	*x = UserListItem{}
+	mi := &file_auth_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfilePhotoRequest) String() string {
+func (x *UserListItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfilePhotoRequest) ProtoMessage() {}
+func (*UserListItem) ProtoMessage() {}
 
-func (x *GetProfilePhotoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[67]
+func (x *UserListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4581,93 +6876,77 @@ func (x *GetProfilePhotoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfilePhotoRequest.ProtoReflect.Descriptor instead.
-func (*GetProfilePhotoRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use UserListItem.ProtoReflect.Descriptor instead.
+func (*UserListItem) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *GetProfilePhotoRequest) GetProfilePhotoId() uint64 {
+func (x *UserListItem) GetId() uint64 {
 	if x != nil {
-		return x.ProfilePhotoId
+		return x.Id
 	}
 	return 0
 }
 
-type DeleteProfilePhotoRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                           // Authenticated user ID (for ownership check)
-	ProfilePhotoId uint64                 `protobuf:"varint,2,opt,name=profile_photo_id,json=profilePhotoId,proto3" json:"profile_photo_id,omitempty"` // Profile photo ID to delete
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *DeleteProfilePhotoRequest) Reset() {
-	*x = DeleteProfilePhotoRequest{}
-	mi := &file_auth_proto_msgTypes[68]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteProfilePhotoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserListItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (*DeleteProfilePhotoRequest) ProtoMessage() {}
-
-func (x *DeleteProfilePhotoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[68]
+func (x *UserListItem) GetCode() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Code
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteProfilePhotoRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProfilePhotoRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{68}
+func (x *UserListItem) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
 }
 
-func (x *DeleteProfilePhotoRequest) GetUserId() uint64 {
+func (x *UserListItem) GetLevels() *UserLevelInfo {
 	if x != nil {
-		return x.UserId
+		return x.Levels
 	}
-	return 0
+	return nil
 }
 
-func (x *DeleteProfilePhotoRequest) GetProfilePhotoId() uint64 {
+func (x *UserListItem) GetProfilePhoto() string {
 	if x != nil {
-		return x.ProfilePhotoId
+		return x.ProfilePhoto
 	}
-	return 0
+	return ""
 }
 
-type ProfilePhotoResponse struct {
+// UserLevelInfo - level information for user list
+type UserLevelInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Current       *Level                 `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`   // Current level
+	Previous      *Level                 `protobuf:"bytes,2,opt,name=previous,proto3" json:"previous,omitempty"` // Previous level
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProfilePhotoResponse) Reset() {
-	*x = ProfilePhotoResponse{}
-	mi := &file_auth_proto_msgTypes[69]
+func (x *UserLevelInfo) Reset() {
+	*x = UserLevelInfo{}
+	mi := &file_auth_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProfilePhotoResponse) String() string {
+func (x *UserLevelInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfilePhotoResponse) ProtoMessage() {}
+func (*UserLevelInfo) ProtoMessage() {}
 
-func (x *ProfilePhotoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[69]
+func (x *UserLevelInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4678,47 +6957,51 @@ func (x *ProfilePhotoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfilePhotoResponse.ProtoReflect.Descriptor instead.
-func (*ProfilePhotoResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use UserLevelInfo.ProtoReflect.Descriptor instead.
+func (*UserLevelInfo) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *ProfilePhotoResponse) GetId() uint64 {
+func (x *UserLevelInfo) GetCurrent() *Level {
 	if x != nil {
-		return x.Id
+		return x.Current
 	}
-	return 0
+	return nil
 }
 
-func (x *ProfilePhotoResponse) GetUrl() string {
+func (x *UserLevelInfo) GetPrevious() *Level {
 	if x != nil {
-		return x.Url
+		return x.Previous
 	}
-	return ""
+	return nil
 }
 
-type GetSettingsRequest struct {
+// PaginationLinks - pagination links for simple pagination
+type PaginationLinks struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	First         string                 `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	Last          string                 `protobuf:"bytes,2,opt,name=last,proto3" json:"last,omitempty"`
+	Prev          string                 `protobuf:"bytes,3,opt,name=prev,proto3" json:"prev,omitempty"`
+	Next          string                 `protobuf:"bytes,4,opt,name=next,proto3" json:"next,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSettingsRequest) Reset() {
-	*x = GetSettingsRequest{}
-	mi := &file_auth_proto_msgTypes[70]
+func (x *PaginationLinks) Reset() {
+	*x = PaginationLinks{}
+	mi := &file_auth_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSettingsRequest) String() string {
+func (x *PaginationLinks) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSettingsRequest) ProtoMessage() {}
+func (*PaginationLinks) ProtoMessage() {}
 
-func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[70]
+func (x *PaginationLinks) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4729,40 +7012,62 @@ func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSettingsRequest.ProtoReflect.Descriptor instead.
-func (*GetSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use PaginationLinks.ProtoReflect.Descriptor instead.
+func (*PaginationLinks) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *GetSettingsRequest) GetUserId() uint64 {
+func (x *PaginationLinks) GetFirst() string {
 	if x != nil {
-		return x.UserId
+		return x.First
 	}
-	return 0
+	return ""
 }
 
-type GetSettingsResponse struct {
+func (x *PaginationLinks) GetLast() string {
+	if x != nil {
+		return x.Last
+	}
+	return ""
+}
+
+func (x *PaginationLinks) GetPrev() string {
+	if x != nil {
+		return x.Prev
+	}
+	return ""
+}
+
+func (x *PaginationLinks) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+// GetUserLevelsRequest - GET /api/users/{user}/levels
+type GetUserLevelsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *SettingsData          `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSettingsResponse) Reset() {
-	*x = GetSettingsResponse{}
-	mi := &file_auth_proto_msgTypes[71]
+func (x *GetUserLevelsRequest) Reset() {
+	*x = GetUserLevelsRequest{}
+	mi := &file_auth_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSettingsResponse) String() string {
+func (x *GetUserLevelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSettingsResponse) ProtoMessage() {}
+func (*GetUserLevelsRequest) ProtoMessage() {}
 
-func (x *GetSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[71]
+func (x *GetUserLevelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4773,41 +7078,41 @@ func (x *GetSettingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSettingsResponse.ProtoReflect.Descriptor instead.
-func (*GetSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use GetUserLevelsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserLevelsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *GetSettingsResponse) GetData() *SettingsData {
+func (x *GetUserLevelsRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Data
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-type SettingsData struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	CheckoutDaysCount uint32                 `protobuf:"varint,1,opt,name=checkout_days_count,json=checkoutDaysCount,proto3" json:"checkout_days_count,omitempty"`
-	AutomaticLogout   int32                  `protobuf:"varint,2,opt,name=automatic_logout,json=automaticLogout,proto3" json:"automatic_logout,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// GetUserLevelsResponse - user level ladder data
+type GetUserLevelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *UserLevelData         `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SettingsData) Reset() {
-	*x = SettingsData{}
-	mi := &file_auth_proto_msgTypes[72]
+func (x *GetUserLevelsResponse) Reset() {
+	*x = GetUserLevelsResponse{}
+	mi := &file_auth_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SettingsData) String() string {
+func (x *GetUserLevelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SettingsData) ProtoMessage() {}
+func (*GetUserLevelsResponse) ProtoMessage() {}
 
-func (x *SettingsData) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[72]
+func (x *GetUserLevelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4818,53 +7123,43 @@ func (x *SettingsData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SettingsData.ProtoReflect.Descriptor instead.
-func (*SettingsData) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{72}
-}
-
-func (x *SettingsData) GetCheckoutDaysCount() uint32 {
-	if x != nil {
-		return x.CheckoutDaysCount
-	}
-	return 0
+// Deprecated: Use GetUserLevelsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserLevelsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *SettingsData) GetAutomaticLogout() int32 {
+func (x *GetUserLevelsResponse) GetData() *UserLevelData {
 	if x != nil {
-		return x.AutomaticLogout
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-type UpdateSettingsRequest struct {
-	state  protoimpl.MessageState `protogen:"open.v1"`
-	UserId uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Checkout cadence update (both must be present if updating checkout)
-	CheckoutDaysCount uint32 `protobuf:"varint,2,opt,name=checkout_days_count,json=checkoutDaysCount,proto3" json:"checkout_days_count,omitempty"` // 3-1000 (optional, but required if updating checkout)
-	AutomaticLogout   int32  `protobuf:"varint,3,opt,name=automatic_logout,json=automaticLogout,proto3" json:"automatic_logout,omitempty"`         // 1-55 minutes (optional, but required if updating checkout)
-	// Profile exposure toggle (both must be present if updating profile exposure)
-	Setting       string `protobuf:"bytes,4,opt,name=setting,proto3" json:"setting,omitempty"` // "status", "level", or "details" (optional)
-	Status        bool   `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`  // boolean (optional, but required if setting is present)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// UserLevelData - detailed level information
+type UserLevelData struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	LatestLevel                *Level                 `protobuf:"bytes,1,opt,name=latest_level,json=latestLevel,proto3" json:"latest_level,omitempty"`                                                      // Latest level, null if absent
+	PreviousLevels             []*Level               `protobuf:"bytes,2,rep,name=previous_levels,json=previousLevels,proto3" json:"previous_levels,omitempty"`                                             // Ordered ascending by score
+	ScorePercentageToNextLevel float64                `protobuf:"fixed64,3,opt,name=score_percentage_to_next_level,json=scorePercentageToNextLevel,proto3" json:"score_percentage_to_next_level,omitempty"` // Progress toward next level
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
 }
 
-func (x *UpdateSettingsRequest) Reset() {
-	*x = UpdateSettingsRequest{}
-	mi := &file_auth_proto_msgTypes[73]
+func (x *UserLevelData) Reset() {
+	*x = UserLevelData{}
+	mi := &file_auth_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSettingsRequest) String() string {
+func (x *UserLevelData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSettingsRequest) ProtoMessage() {}
+func (*UserLevelData) ProtoMessage() {}
 
-func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[73]
+func (x *UserLevelData) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4875,68 +7170,56 @@ func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use UserLevelData.ProtoReflect.Descriptor instead.
+func (*UserLevelData) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *UpdateSettingsRequest) GetUserId() uint64 {
+func (x *UserLevelData) GetLatestLevel() *Level {
 	if x != nil {
-		return x.UserId
+		return x.LatestLevel
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateSettingsRequest) GetCheckoutDaysCount() uint32 {
+func (x *UserLevelData) GetPreviousLevels() []*Level {
 	if x != nil {
-		return x.CheckoutDaysCount
+		return x.PreviousLevels
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateSettingsRequest) GetAutomaticLogout() int32 {
+func (x *UserLevelData) GetScorePercentageToNextLevel() float64 {
 	if x != nil {
-		return x.AutomaticLogout
+		return x.ScorePercentageToNextLevel
 	}
 	return 0
 }
 
-func (x *UpdateSettingsRequest) GetSetting() string {
-	if x != nil {
-		return x.Setting
-	}
-	return ""
-}
-
-func (x *UpdateSettingsRequest) GetStatus() bool {
-	if x != nil {
-		return x.Status
-	}
-	return false
-}
-
-type GetGeneralSettingsRequest struct {
+// GetUserProfileRequest - GET /api/users/{user}/profile
+type GetUserProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ViewerUserId  uint64                 `protobuf:"varint,2,opt,name=viewer_user_id,json=viewerUserId,proto3" json:"viewer_user_id,omitempty"` // Optional: authenticated user viewing the profile
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGeneralSettingsRequest) Reset() {
-	*x = GetGeneralSettingsRequest{}
-	mi := &file_auth_proto_msgTypes[74]
+func (x *GetUserProfileRequest) Reset() {
+	*x = GetUserProfileRequest{}
+	mi := &file_auth_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGeneralSettingsRequest) String() string {
+func (x *GetUserProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGeneralSettingsRequest) ProtoMessage() {}
+func (*GetUserProfileRequest) ProtoMessage() {}
 
-func (x *GetGeneralSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[74]
+func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4947,40 +7230,48 @@ func (x *GetGeneralSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGeneralSettingsRequest.ProtoReflect.Descriptor instead.
-func (*GetGeneralSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *GetGeneralSettingsRequest) GetUserId() uint64 {
+func (x *GetUserProfileRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-type GetGeneralSettingsResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Data          *NotificationSettingsData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+func (x *GetUserProfileRequest) GetViewerUserId() uint64 {
+	if x != nil {
+		return x.ViewerUserId
+	}
+	return 0
+}
+
+// GetUserProfileResponse - user profile with privacy filtering
+type GetUserProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *UserProfileData       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGeneralSettingsResponse) Reset() {
-	*x = GetGeneralSettingsResponse{}
-	mi := &file_auth_proto_msgTypes[75]
+func (x *GetUserProfileResponse) Reset() {
+	*x = GetUserProfileResponse{}
+	mi := &file_auth_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGeneralSettingsResponse) String() string {
+func (x *GetUserProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGeneralSettingsResponse) ProtoMessage() {}
+func (*GetUserProfileResponse) ProtoMessage() {}
 
-func (x *GetGeneralSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[75]
+func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4991,49 +7282,48 @@ func (x *GetGeneralSettingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGeneralSettingsResponse.ProtoReflect.Descriptor instead.
-func (*GetGeneralSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use GetUserProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetUserProfileResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *GetGeneralSettingsResponse) GetData() *NotificationSettingsData {
+func (x *GetUserProfileResponse) GetData() *UserProfileData {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type NotificationSettingsData struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	AnnouncementsSms       bool                   `protobuf:"varint,1,opt,name=announcements_sms,json=announcementsSms,proto3" json:"announcements_sms,omitempty"`
-	AnnouncementsEmail     bool                   `protobuf:"varint,2,opt,name=announcements_email,json=announcementsEmail,proto3" json:"announcements_email,omitempty"`
-	ReportsSms             bool                   `protobuf:"varint,3,opt,name=reports_sms,json=reportsSms,proto3" json:"reports_sms,omitempty"`
-	ReportsEmail           bool                   `protobuf:"varint,4,opt,name=reports_email,json=reportsEmail,proto3" json:"reports_email,omitempty"`
-	LoginVerificationSms   bool                   `protobuf:"varint,5,opt,name=login_verification_sms,json=loginVerificationSms,proto3" json:"login_verification_sms,omitempty"`
-	LoginVerificationEmail bool                   `protobuf:"varint,6,opt,name=login_verification_email,json=loginVerificationEmail,proto3" json:"login_verification_email,omitempty"`
-	TransactionsSms        bool                   `protobuf:"varint,7,opt,name=transactions_sms,json=transactionsSms,proto3" json:"transactions_sms,omitempty"`
-	TransactionsEmail      bool                   `protobuf:"varint,8,opt,name=transactions_email,json=transactionsEmail,proto3" json:"transactions_email,omitempty"`
-	TradesSms              bool                   `protobuf:"varint,9,opt,name=trades_sms,json=tradesSms,proto3" json:"trades_sms,omitempty"`
-	TradesEmail            bool                   `protobuf:"varint,10,opt,name=trades_email,json=tradesEmail,proto3" json:"trades_email,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+// UserProfileData - user profile information (privacy-filtered)
+type UserProfileData struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // May be null if privacy disallows
+	Code           string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	RegisteredAt   string                 `protobuf:"bytes,4,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`        // Jalali format Y/m/d, may be null
+	ProfileImages  []string               `protobuf:"bytes,5,rep,name=profile_images,json=profileImages,proto3" json:"profile_images,omitempty"`     // Array of URLs, may be empty
+	FollowersCount int32                  `protobuf:"varint,6,opt,name=followers_count,json=followersCount,proto3" json:"followers_count,omitempty"` // May be null if privacy disallows
+	FollowingCount int32                  `protobuf:"varint,7,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"` // May be null if privacy disallows
+	Presence       *UserPresence          `protobuf:"bytes,8,opt,name=presence,proto3" json:"presence,omitempty"`                                    // Unset when the user hides their status
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *NotificationSettingsData) Reset() {
-	*x = NotificationSettingsData{}
-	mi := &file_auth_proto_msgTypes[76]
+func (x *UserProfileData) Reset() {
+	*x = UserProfileData{}
+	mi := &file_auth_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NotificationSettingsData) String() string {
+func (x *UserProfileData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotificationSettingsData) ProtoMessage() {}
+func (*UserProfileData) ProtoMessage() {}
 
-func (x *NotificationSettingsData) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[76]
+func (x *UserProfileData) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5044,105 +7334,90 @@ func (x *NotificationSettingsData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotificationSettingsData.ProtoReflect.Descriptor instead.
-func (*NotificationSettingsData) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{76}
-}
-
-func (x *NotificationSettingsData) GetAnnouncementsSms() bool {
-	if x != nil {
-		return x.AnnouncementsSms
-	}
-	return false
-}
-
-func (x *NotificationSettingsData) GetAnnouncementsEmail() bool {
-	if x != nil {
-		return x.AnnouncementsEmail
-	}
-	return false
+// Deprecated: Use UserProfileData.ProtoReflect.Descriptor instead.
+func (*UserProfileData) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *NotificationSettingsData) GetReportsSms() bool {
+func (x *UserProfileData) GetId() uint64 {
 	if x != nil {
-		return x.ReportsSms
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-func (x *NotificationSettingsData) GetReportsEmail() bool {
+func (x *UserProfileData) GetName() string {
 	if x != nil {
-		return x.ReportsEmail
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *NotificationSettingsData) GetLoginVerificationSms() bool {
+func (x *UserProfileData) GetCode() string {
 	if x != nil {
-		return x.LoginVerificationSms
+		return x.Code
 	}
-	return false
+	return ""
 }
 
-func (x *NotificationSettingsData) GetLoginVerificationEmail() bool {
+func (x *UserProfileData) GetRegisteredAt() string {
 	if x != nil {
-		return x.LoginVerificationEmail
+		return x.RegisteredAt
 	}
-	return false
+	return ""
 }
 
-func (x *NotificationSettingsData) GetTransactionsSms() bool {
+func (x *UserProfileData) GetProfileImages() []string {
 	if x != nil {
-		return x.TransactionsSms
+		return x.ProfileImages
 	}
-	return false
+	return nil
 }
 
-func (x *NotificationSettingsData) GetTransactionsEmail() bool {
+func (x *UserProfileData) GetFollowersCount() int32 {
 	if x != nil {
-		return x.TransactionsEmail
+		return x.FollowersCount
 	}
-	return false
+	return 0
 }
 
-func (x *NotificationSettingsData) GetTradesSms() bool {
+func (x *UserProfileData) GetFollowingCount() int32 {
 	if x != nil {
-		return x.TradesSms
+		return x.FollowingCount
 	}
-	return false
+	return 0
 }
 
-func (x *NotificationSettingsData) GetTradesEmail() bool {
+func (x *UserProfileData) GetPresence() *UserPresence {
 	if x != nil {
-		return x.TradesEmail
+		return x.Presence
 	}
-	return false
+	return nil
 }
 
-type UpdateGeneralSettingsRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	UserId        uint64                    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	SettingId     uint64                    `protobuf:"varint,2,opt,name=setting_id,json=settingId,proto3" json:"setting_id,omitempty"` // Settings ID for ownership check
-	Notifications *NotificationSettingsData `protobuf:"bytes,3,opt,name=notifications,proto3" json:"notifications,omitempty"`
+// GetUserFeaturesCountRequest - GET /api/users/{user}/features/count
+type GetUserFeaturesCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGeneralSettingsRequest) Reset() {
-	*x = UpdateGeneralSettingsRequest{}
-	mi := &file_auth_proto_msgTypes[77]
+func (x *GetUserFeaturesCountRequest) Reset() {
+	*x = GetUserFeaturesCountRequest{}
+	mi := &file_auth_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGeneralSettingsRequest) String() string {
+func (x *GetUserFeaturesCountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGeneralSettingsRequest) ProtoMessage() {}
+func (*GetUserFeaturesCountRequest) ProtoMessage() {}
 
-func (x *UpdateGeneralSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[77]
+func (x *GetUserFeaturesCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5153,54 +7428,41 @@ func (x *UpdateGeneralSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGeneralSettingsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGeneralSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use GetUserFeaturesCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUserFeaturesCountRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *UpdateGeneralSettingsRequest) GetUserId() uint64 {
+func (x *GetUserFeaturesCountRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *UpdateGeneralSettingsRequest) GetSettingId() uint64 {
-	if x != nil {
-		return x.SettingId
-	}
-	return 0
-}
-
-func (x *UpdateGeneralSettingsRequest) GetNotifications() *NotificationSettingsData {
-	if x != nil {
-		return x.Notifications
-	}
-	return nil
-}
-
-type UpdateGeneralSettingsResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Data          *NotificationSettingsData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+// GetUserFeaturesCountResponse - feature counts by category
+type GetUserFeaturesCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *UserFeaturesCountData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGeneralSettingsResponse) Reset() {
-	*x = UpdateGeneralSettingsResponse{}
-	mi := &file_auth_proto_msgTypes[78]
+func (x *GetUserFeaturesCountResponse) Reset() {
+	*x = GetUserFeaturesCountResponse{}
+	mi := &file_auth_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGeneralSettingsResponse) String() string {
+func (x *GetUserFeaturesCountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGeneralSettingsResponse) ProtoMessage() {}
+func (*GetUserFeaturesCountResponse) ProtoMessage() {}
 
-func (x *UpdateGeneralSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[78]
+func (x *GetUserFeaturesCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5211,40 +7473,43 @@ func (x *UpdateGeneralSettingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGeneralSettingsResponse.ProtoReflect.Descriptor instead.
-func (*UpdateGeneralSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use GetUserFeaturesCountResponse.ProtoReflect.Descriptor instead.
+func (*GetUserFeaturesCountResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *UpdateGeneralSettingsResponse) GetData() *NotificationSettingsData {
+func (x *GetUserFeaturesCountResponse) GetData() *UserFeaturesCountData {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type GetPrivacySettingsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// UserFeaturesCountData - categorized feature counts
+type UserFeaturesCountData struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	MaskoniFeaturesCount   int32                  `protobuf:"varint,1,opt,name=maskoni_features_count,json=maskoniFeaturesCount,proto3" json:"maskoni_features_count,omitempty"`       // karbari = 'm'
+	TejariFeaturesCount    int32                  `protobuf:"varint,2,opt,name=tejari_features_count,json=tejariFeaturesCount,proto3" json:"tejari_features_count,omitempty"`          // karbari = 't'
+	AmoozeshiFeaturesCount int32                  `protobuf:"varint,3,opt,name=amoozeshi_features_count,json=amoozeshiFeaturesCount,proto3" json:"amoozeshi_features_count,omitempty"` // karbari = 'a'
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GetPrivacySettingsRequest) Reset() {
-	*x = GetPrivacySettingsRequest{}
-	mi := &file_auth_proto_msgTypes[79]
+func (x *UserFeaturesCountData) Reset() {
+	*x = UserFeaturesCountData{}
+	mi := &file_auth_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPrivacySettingsRequest) String() string {
+func (x *UserFeaturesCountData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrivacySettingsRequest) ProtoMessage() {}
+func (*UserFeaturesCountData) ProtoMessage() {}
 
-func (x *GetPrivacySettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[79]
+func (x *UserFeaturesCountData) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5252,43 +7517,58 @@ func (x *GetPrivacySettingsRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetPrivacySettingsRequest.ProtoReflect.Descriptor instead.
-func (*GetPrivacySettingsRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{79}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFeaturesCountData.ProtoReflect.Descriptor instead.
+func (*UserFeaturesCountData) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *UserFeaturesCountData) GetMaskoniFeaturesCount() int32 {
+	if x != nil {
+		return x.MaskoniFeaturesCount
+	}
+	return 0
+}
+
+func (x *UserFeaturesCountData) GetTejariFeaturesCount() int32 {
+	if x != nil {
+		return x.TejariFeaturesCount
+	}
+	return 0
 }
 
-func (x *GetPrivacySettingsRequest) GetUserId() uint64 {
+func (x *UserFeaturesCountData) GetAmoozeshiFeaturesCount() int32 {
 	if x != nil {
-		return x.UserId
+		return x.AmoozeshiFeaturesCount
 	}
 	return 0
 }
 
-type GetPrivacySettingsResponse struct {
+// SearchUsersRequest - POST /api/search/users
+type SearchUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          map[string]int32       `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // key -> 0|1 (0=private, 1=public)
+	SearchTerm    string                 `protobuf:"bytes,1,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPrivacySettingsResponse) Reset() {
-	*x = GetPrivacySettingsResponse{}
-	mi := &file_auth_proto_msgTypes[80]
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_auth_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPrivacySettingsResponse) String() string {
+func (x *SearchUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrivacySettingsResponse) ProtoMessage() {}
+func (*SearchUsersRequest) ProtoMessage() {}
 
-func (x *GetPrivacySettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[80]
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5299,42 +7579,41 @@ func (x *GetPrivacySettingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPrivacySettingsResponse.ProtoReflect.Descriptor instead.
-func (*GetPrivacySettingsResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *GetPrivacySettingsResponse) GetData() map[string]int32 {
+func (x *SearchUsersRequest) GetSearchTerm() string {
 	if x != nil {
-		return x.Data
+		return x.SearchTerm
 	}
-	return nil
+	return ""
 }
 
-type UpdatePrivacySettingsRequest struct {
+// SearchUsersResponse - user search results
+type SearchUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`      // Privacy field key
-	Value         int32                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"` // 0 or 1 (boolean or numeric representation)
+	Data          []*SearchUserResult    `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePrivacySettingsRequest) Reset() {
-	*x = UpdatePrivacySettingsRequest{}
-	mi := &file_auth_proto_msgTypes[81]
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_auth_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePrivacySettingsRequest) String() string {
+func (x *SearchUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePrivacySettingsRequest) ProtoMessage() {}
+func (*SearchUsersResponse) ProtoMessage() {}
 
-func (x *UpdatePrivacySettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[81]
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5345,55 +7624,47 @@ func (x *UpdatePrivacySettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePrivacySettingsRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePrivacySettingsRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{81}
-}
-
-func (x *UpdatePrivacySettingsRequest) GetUserId() uint64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *UpdatePrivacySettingsRequest) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *UpdatePrivacySettingsRequest) GetValue() int32 {
+func (x *SearchUsersResponse) GetData() []*SearchUserResult {
 	if x != nil {
-		return x.Value
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-type ListUserEventsRequest struct {
+// SearchUserResult - individual user search result
+type SearchUserResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"` // Page number (default: 1)
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`            // Uppercased
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`            // Uses KYC data when verified, otherwise user.name
+	Followers     int32                  `protobuf:"varint,4,opt,name=followers,proto3" json:"followers,omitempty"` // Count of followers
+	Level         string                 `protobuf:"bytes,5,opt,name=level,proto3" json:"level,omitempty"`          // Latest level name (nullable)
+	Photo         string                 `protobuf:"bytes,6,opt,name=photo,proto3" json:"photo,omitempty"`          // Latest profile photo URL (nullable)
+	Presence      *UserPresence          `protobuf:"bytes,7,opt,name=presence,proto3" json:"presence,omitempty"`    // Unset when the user hides their status
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserEventsRequest) Reset() {
-	*x = ListUserEventsRequest{}
-	mi := &file_auth_proto_msgTypes[82]
+func (x *SearchUserResult) Reset() {
+	*x = SearchUserResult{}
+	mi := &file_auth_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserEventsRequest) String() string {
+func (x *SearchUserResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserEventsRequest) ProtoMessage() {}
+func (*SearchUserResult) ProtoMessage() {}
 
-func (x *ListUserEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[82]
+func (x *SearchUserResult) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5404,100 +7675,83 @@ func (x *ListUserEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListUserEventsRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use SearchUserResult.ProtoReflect.Descriptor instead.
+func (*SearchUserResult) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *ListUserEventsRequest) GetUserId() uint64 {
+func (x *SearchUserResult) GetId() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListUserEventsRequest) GetPage() int32 {
+func (x *SearchUserResult) GetCode() string {
 	if x != nil {
-		return x.Page
+		return x.Code
 	}
-	return 0
-}
-
-type ListUserEventsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*UserEventResource   `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Pagination    *PaginationMeta        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListUserEventsResponse) Reset() {
-	*x = ListUserEventsResponse{}
-	mi := &file_auth_proto_msgTypes[83]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *ListUserEventsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SearchUserResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (*ListUserEventsResponse) ProtoMessage() {}
-
-func (x *ListUserEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[83]
+func (x *SearchUserResult) GetFollowers() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Followers
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListUserEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListUserEventsResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{83}
+func (x *SearchUserResult) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
 }
 
-func (x *ListUserEventsResponse) GetData() []*UserEventResource {
+func (x *SearchUserResult) GetPhoto() string {
 	if x != nil {
-		return x.Data
+		return x.Photo
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUserEventsResponse) GetPagination() *PaginationMeta {
+func (x *SearchUserResult) GetPresence() *UserPresence {
 	if x != nil {
-		return x.Pagination
+		return x.Presence
 	}
 	return nil
 }
 
-type GetUserEventRequest struct {
+// SearchFeaturesRequest - POST /api/search/features
+type SearchFeaturesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	EventId       uint64                 `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SearchTerm    string                 `protobuf:"bytes,1,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserEventRequest) Reset() {
-	*x = GetUserEventRequest{}
-	mi := &file_auth_proto_msgTypes[84]
+func (x *SearchFeaturesRequest) Reset() {
+	*x = SearchFeaturesRequest{}
+	mi := &file_auth_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserEventRequest) String() string {
+func (x *SearchFeaturesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserEventRequest) ProtoMessage() {}
+func (*SearchFeaturesRequest) ProtoMessage() {}
 
-func (x *GetUserEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[84]
+func (x *SearchFeaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5508,47 +7762,41 @@ func (x *GetUserEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserEventRequest.ProtoReflect.Descriptor instead.
-func (*GetUserEventRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{84}
-}
-
-func (x *GetUserEventRequest) GetUserId() uint64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use SearchFeaturesRequest.ProtoReflect.Descriptor instead.
+func (*SearchFeaturesRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *GetUserEventRequest) GetEventId() uint64 {
+func (x *SearchFeaturesRequest) GetSearchTerm() string {
 	if x != nil {
-		return x.EventId
+		return x.SearchTerm
 	}
-	return 0
+	return ""
 }
 
-type GetUserEventResponse struct {
+// SearchFeaturesResponse - feature search results
+type SearchFeaturesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *UserEventResource     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data          []*SearchFeatureResult `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserEventResponse) Reset() {
-	*x = GetUserEventResponse{}
-	mi := &file_auth_proto_msgTypes[85]
+func (x *SearchFeaturesResponse) Reset() {
+	*x = SearchFeaturesResponse{}
+	mi := &file_auth_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserEventResponse) String() string {
+func (x *SearchFeaturesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserEventResponse) ProtoMessage() {}
+func (*SearchFeaturesResponse) ProtoMessage() {}
 
-func (x *GetUserEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[85]
+func (x *SearchFeaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5559,43 +7807,48 @@ func (x *GetUserEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserEventResponse.ProtoReflect.Descriptor instead.
-func (*GetUserEventResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use SearchFeaturesResponse.ProtoReflect.Descriptor instead.
+func (*SearchFeaturesResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *GetUserEventResponse) GetData() *UserEventResource {
+func (x *SearchFeaturesResponse) GetData() []*SearchFeatureResult {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type ReportUserEventRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	UserId            uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	EventId           uint64                 `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	SuspeciousCitizen string                 `protobuf:"bytes,3,opt,name=suspecious_citizen,json=suspeciousCitizen,proto3" json:"suspecious_citizen,omitempty"` // Optional: citizen code
-	EventDescription  string                 `protobuf:"bytes,4,opt,name=event_description,json=eventDescription,proto3" json:"event_description,omitempty"`    // Required: max 500 characters
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// SearchFeatureResult - individual feature search result
+type SearchFeatureResult struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                               // Feature ID
+	FeaturePropertiesId string                 `protobuf:"bytes,2,opt,name=feature_properties_id,json=featurePropertiesId,proto3" json:"feature_properties_id,omitempty"` // Uppercased FeatureProperties.id
+	Address             string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Karbari             string                 `protobuf:"bytes,4,opt,name=karbari,proto3" json:"karbari,omitempty"` // Application title from feature
+	PricePsc            string                 `protobuf:"bytes,5,opt,name=price_psc,json=pricePsc,proto3" json:"price_psc,omitempty"`
+	PriceIrr            string                 `protobuf:"bytes,6,opt,name=price_irr,json=priceIrr,proto3" json:"price_irr,omitempty"`
+	OwnerCode           string                 `protobuf:"bytes,7,opt,name=owner_code,json=ownerCode,proto3" json:"owner_code,omitempty"` // Uppercased owner code
+	Coordinates         []*Coordinate          `protobuf:"bytes,8,rep,name=coordinates,proto3" json:"coordinates,omitempty"`              // Geometry coordinates
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ReportUserEventRequest) Reset() {
-	*x = ReportUserEventRequest{}
-	mi := &file_auth_proto_msgTypes[86]
+func (x *SearchFeatureResult) Reset() {
+	*x = SearchFeatureResult{}
+	mi := &file_auth_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReportUserEventRequest) String() string {
+func (x *SearchFeatureResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportUserEventRequest) ProtoMessage() {}
+func (*SearchFeatureResult) ProtoMessage() {}
 
-func (x *ReportUserEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[86]
+func (x *SearchFeatureResult) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5606,63 +7859,92 @@ func (x *ReportUserEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportUserEventRequest.ProtoReflect.Descriptor instead.
-func (*ReportUserEventRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use SearchFeatureResult.ProtoReflect.Descriptor instead.
+func (*SearchFeatureResult) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *ReportUserEventRequest) GetUserId() uint64 {
+func (x *SearchFeatureResult) GetId() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ReportUserEventRequest) GetEventId() uint64 {
+func (x *SearchFeatureResult) GetFeaturePropertiesId() string {
 	if x != nil {
-		return x.EventId
+		return x.FeaturePropertiesId
 	}
-	return 0
+	return ""
 }
 
-func (x *ReportUserEventRequest) GetSuspeciousCitizen() string {
+func (x *SearchFeatureResult) GetAddress() string {
 	if x != nil {
-		return x.SuspeciousCitizen
+		return x.Address
 	}
 	return ""
 }
 
-func (x *ReportUserEventRequest) GetEventDescription() string {
+func (x *SearchFeatureResult) GetKarbari() string {
 	if x != nil {
-		return x.EventDescription
+		return x.Karbari
 	}
 	return ""
 }
 
-type SendReportResponseRequest struct {
+func (x *SearchFeatureResult) GetPricePsc() string {
+	if x != nil {
+		return x.PricePsc
+	}
+	return ""
+}
+
+func (x *SearchFeatureResult) GetPriceIrr() string {
+	if x != nil {
+		return x.PriceIrr
+	}
+	return ""
+}
+
+func (x *SearchFeatureResult) GetOwnerCode() string {
+	if x != nil {
+		return x.OwnerCode
+	}
+	return ""
+}
+
+func (x *SearchFeatureResult) GetCoordinates() []*Coordinate {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+// Coordinate - coordinate with id, x, y for search results
+type Coordinate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	EventId       uint64                 `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Response      string                 `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"` // Required: max 300 characters
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	X             float64                `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"` // Parsed from varchar as float64
+	Y             float64                `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"` // Parsed from varchar as float64
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendReportResponseRequest) Reset() {
-	*x = SendReportResponseRequest{}
-	mi := &file_auth_proto_msgTypes[87]
+func (x *Coordinate) Reset() {
+	*x = Coordinate{}
+	mi := &file_auth_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendReportResponseRequest) String() string {
+func (x *Coordinate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendReportResponseRequest) ProtoMessage() {}
+func (*Coordinate) ProtoMessage() {}
 
-func (x *SendReportResponseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[87]
+func (x *Coordinate) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5673,55 +7955,55 @@ func (x *SendReportResponseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendReportResponseRequest.ProtoReflect.Descriptor instead.
-func (*SendReportResponseRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use Coordinate.ProtoReflect.Descriptor instead.
+func (*Coordinate) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *SendReportResponseRequest) GetUserId() uint64 {
+func (x *Coordinate) GetId() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *SendReportResponseRequest) GetEventId() uint64 {
+func (x *Coordinate) GetX() float64 {
 	if x != nil {
-		return x.EventId
+		return x.X
 	}
 	return 0
 }
 
-func (x *SendReportResponseRequest) GetResponse() string {
+func (x *Coordinate) GetY() float64 {
 	if x != nil {
-		return x.Response
+		return x.Y
 	}
-	return ""
+	return 0
 }
 
-type CloseEventReportRequest struct {
+// SearchIsicCodesRequest - POST /api/search/isic-codes
+type SearchIsicCodesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	EventId       uint64                 `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SearchTerm    string                 `protobuf:"bytes,1,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CloseEventReportRequest) Reset() {
-	*x = CloseEventReportRequest{}
-	mi := &file_auth_proto_msgTypes[88]
+func (x *SearchIsicCodesRequest) Reset() {
+	*x = SearchIsicCodesRequest{}
+	mi := &file_auth_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CloseEventReportRequest) String() string {
+func (x *SearchIsicCodesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseEventReportRequest) ProtoMessage() {}
+func (*SearchIsicCodesRequest) ProtoMessage() {}
 
-func (x *CloseEventReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[88]
+func (x *SearchIsicCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5732,54 +8014,41 @@ func (x *CloseEventReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseEventReportRequest.ProtoReflect.Descriptor instead.
-func (*CloseEventReportRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{88}
-}
-
-func (x *CloseEventReportRequest) GetUserId() uint64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use SearchIsicCodesRequest.ProtoReflect.Descriptor instead.
+func (*SearchIsicCodesRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *CloseEventReportRequest) GetEventId() uint64 {
+func (x *SearchIsicCodesRequest) GetSearchTerm() string {
 	if x != nil {
-		return x.EventId
+		return x.SearchTerm
 	}
-	return 0
+	return ""
 }
 
-type UserEventResource struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Id            uint64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Event         string                   `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
-	Ip            string                   `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
-	Device        string                   `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
-	Status        string                   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // "موفق" or "ناموفق" (Persian: successful or unsuccessful)
-	Date          string                   `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`     // Jalali format: Y/m/d
-	Time          string                   `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`     // H:m:s format
-	Report        *UserEventReportResource `protobuf:"bytes,8,opt,name=report,proto3" json:"report,omitempty"` // Only present in GetUserEvent response
+// SearchIsicCodesResponse - ISIC code search results
+type SearchIsicCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*IsicCodeResult      `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserEventResource) Reset() {
-	*x = UserEventResource{}
-	mi := &file_auth_proto_msgTypes[89]
+func (x *SearchIsicCodesResponse) Reset() {
+	*x = SearchIsicCodesResponse{}
+	mi := &file_auth_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserEventResource) String() string {
+func (x *SearchIsicCodesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserEventResource) ProtoMessage() {}
+func (*SearchIsicCodesResponse) ProtoMessage() {}
 
-func (x *UserEventResource) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[89]
+func (x *SearchIsicCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5790,96 +8059,110 @@ func (x *UserEventResource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserEventResource.ProtoReflect.Descriptor instead.
-func (*UserEventResource) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use SearchIsicCodesResponse.ProtoReflect.Descriptor instead.
+func (*SearchIsicCodesResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *UserEventResource) GetId() uint64 {
+func (x *SearchIsicCodesResponse) GetData() []*IsicCodeResult {
 	if x != nil {
-		return x.Id
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *UserEventResource) GetEvent() string {
-	if x != nil {
-		return x.Event
-	}
-	return ""
+// IsicCodeResult - individual ISIC code result
+type IsicCodeResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code          uint64                 `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"` // bigint in database
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserEventResource) GetIp() string {
-	if x != nil {
-		return x.Ip
-	}
-	return ""
+func (x *IsicCodeResult) Reset() {
+	*x = IsicCodeResult{}
+	mi := &file_auth_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserEventResource) GetDevice() string {
-	if x != nil {
-		return x.Device
-	}
-	return ""
+func (x *IsicCodeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserEventResource) GetStatus() string {
+func (*IsicCodeResult) ProtoMessage() {}
+
+func (x *IsicCodeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[126]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserEventResource) GetDate() string {
+// Deprecated: Use IsicCodeResult.ProtoReflect.Descriptor instead.
+func (*IsicCodeResult) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *IsicCodeResult) GetId() uint64 {
 	if x != nil {
-		return x.Date
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *UserEventResource) GetTime() string {
+func (x *IsicCodeResult) GetName() string {
 	if x != nil {
-		return x.Time
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UserEventResource) GetReport() *UserEventReportResource {
+func (x *IsicCodeResult) GetCode() uint64 {
 	if x != nil {
-		return x.Report
+		return x.Code
 	}
-	return nil
+	return 0
 }
 
-type UserEventReportResource struct {
-	state             protoimpl.MessageState             `protogen:"open.v1"`
-	Id                uint64                             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	SuspeciousCitizen string                             `protobuf:"bytes,2,opt,name=suspecious_citizen,json=suspeciousCitizen,proto3" json:"suspecious_citizen,omitempty"` // Optional
-	EventDescription  string                             `protobuf:"bytes,3,opt,name=event_description,json=eventDescription,proto3" json:"event_description,omitempty"`
-	Status            int32                              `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
-	Closed            bool                               `protobuf:"varint,5,opt,name=closed,proto3" json:"closed,omitempty"`
-	Date              string                             `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"` // Jalali format: Y/m/d
-	Time              string                             `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"` // H:m:s format
-	Responses         []*UserEventReportResponseResource `protobuf:"bytes,8,rep,name=responses,proto3" json:"responses,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// APIKey - key metadata (the secret is never returned after creation)
+type APIKey struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix             string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"` // Public prefix used to identify the key in logs/UI
+	Scopes             []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	RateLimitPerMinute int32                  `protobuf:"varint,5,opt,name=rate_limit_per_minute,json=rateLimitPerMinute,proto3" json:"rate_limit_per_minute,omitempty"`
+	LastUsedAt         string                 `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"` // Empty when never used
+	ExpiresAt          string                 `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`      // Empty when the key never expires
+	RevokedAt          string                 `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`      // Empty while the key is active
+	CreatedAt          string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *UserEventReportResource) Reset() {
-	*x = UserEventReportResource{}
-	mi := &file_auth_proto_msgTypes[90]
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	mi := &file_auth_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserEventReportResource) String() string {
+func (x *APIKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserEventReportResource) ProtoMessage() {}
+func (*APIKey) ProtoMessage() {}
 
-func (x *UserEventReportResource) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[90]
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5890,93 +8173,101 @@ func (x *UserEventReportResource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserEventReportResource.ProtoReflect.Descriptor instead.
-func (*UserEventReportResource) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *UserEventReportResource) GetId() uint64 {
+func (x *APIKey) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UserEventReportResource) GetSuspeciousCitizen() string {
+func (x *APIKey) GetName() string {
 	if x != nil {
-		return x.SuspeciousCitizen
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UserEventReportResource) GetEventDescription() string {
+func (x *APIKey) GetPrefix() string {
 	if x != nil {
-		return x.EventDescription
+		return x.Prefix
 	}
 	return ""
 }
 
-func (x *UserEventReportResource) GetStatus() int32 {
+func (x *APIKey) GetScopes() []string {
 	if x != nil {
-		return x.Status
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APIKey) GetRateLimitPerMinute() int32 {
+	if x != nil {
+		return x.RateLimitPerMinute
 	}
 	return 0
 }
 
-func (x *UserEventReportResource) GetClosed() bool {
+func (x *APIKey) GetLastUsedAt() string {
 	if x != nil {
-		return x.Closed
+		return x.LastUsedAt
 	}
-	return false
+	return ""
 }
 
-func (x *UserEventReportResource) GetDate() string {
+func (x *APIKey) GetExpiresAt() string {
 	if x != nil {
-		return x.Date
+		return x.ExpiresAt
 	}
 	return ""
 }
 
-func (x *UserEventReportResource) GetTime() string {
+func (x *APIKey) GetRevokedAt() string {
 	if x != nil {
-		return x.Time
+		return x.RevokedAt
 	}
 	return ""
 }
 
-func (x *UserEventReportResource) GetResponses() []*UserEventReportResponseResource {
+func (x *APIKey) GetCreatedAt() string {
 	if x != nil {
-		return x.Responses
+		return x.CreatedAt
 	}
-	return nil
+	return ""
 }
 
-type UserEventReportResponseResource struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ResponserName string                 `protobuf:"bytes,2,opt,name=responser_name,json=responserName,proto3" json:"responser_name,omitempty"`
-	Response      string                 `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
-	Date          string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"` // Jalali format: Y/m/d
-	Time          string                 `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"` // H:m:s format
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// CreateAPIKeyRequest - POST /api/api-keys
+type CreateAPIKeyRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes             []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	RateLimitPerMinute int32                  `protobuf:"varint,4,opt,name=rate_limit_per_minute,json=rateLimitPerMinute,proto3" json:"rate_limit_per_minute,omitempty"` // 0 uses the service default
+	ExpiresAt          string                 `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                                 // Optional, RFC3339
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *UserEventReportResponseResource) Reset() {
-	*x = UserEventReportResponseResource{}
-	mi := &file_auth_proto_msgTypes[91]
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_auth_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserEventReportResponseResource) String() string {
+func (x *CreateAPIKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserEventReportResponseResource) ProtoMessage() {}
+func (*CreateAPIKeyRequest) ProtoMessage() {}
 
-func (x *UserEventReportResponseResource) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[91]
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5987,68 +8278,70 @@ func (x *UserEventReportResponseResource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserEventReportResponseResource.ProtoReflect.Descriptor instead.
-func (*UserEventReportResponseResource) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *UserEventReportResponseResource) GetId() uint64 {
+func (x *CreateAPIKeyRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *UserEventReportResponseResource) GetResponserName() string {
+func (x *CreateAPIKeyRequest) GetName() string {
 	if x != nil {
-		return x.ResponserName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UserEventReportResponseResource) GetResponse() string {
+func (x *CreateAPIKeyRequest) GetScopes() []string {
 	if x != nil {
-		return x.Response
+		return x.Scopes
 	}
-	return ""
+	return nil
 }
 
-func (x *UserEventReportResponseResource) GetDate() string {
+func (x *CreateAPIKeyRequest) GetRateLimitPerMinute() int32 {
 	if x != nil {
-		return x.Date
+		return x.RateLimitPerMinute
 	}
-	return ""
+	return 0
 }
 
-func (x *UserEventReportResponseResource) GetTime() string {
+func (x *CreateAPIKeyRequest) GetExpiresAt() string {
 	if x != nil {
-		return x.Time
+		return x.ExpiresAt
 	}
 	return ""
 }
 
-type UserEventReportResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Data          *UserEventReportResource `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+// APIKeySecretResponse - returned on create/rotate, contains the plain key once
+type APIKeySecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *APIKey                `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserEventReportResponse) Reset() {
-	*x = UserEventReportResponse{}
-	mi := &file_auth_proto_msgTypes[92]
+func (x *APIKeySecretResponse) Reset() {
+	*x = APIKeySecretResponse{}
+	mi := &file_auth_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserEventReportResponse) String() string {
+func (x *APIKeySecretResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserEventReportResponse) ProtoMessage() {}
+func (*APIKeySecretResponse) ProtoMessage() {}
 
-func (x *UserEventReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[92]
+func (x *APIKeySecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6059,40 +8352,48 @@ func (x *UserEventReportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserEventReportResponse.ProtoReflect.Descriptor instead.
-func (*UserEventReportResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use APIKeySecretResponse.ProtoReflect.Descriptor instead.
+func (*APIKeySecretResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *UserEventReportResponse) GetData() *UserEventReportResource {
+func (x *APIKeySecretResponse) GetData() *APIKey {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type UserEventReportResponseResponse struct {
-	state         protoimpl.MessageState           `protogen:"open.v1"`
-	Data          *UserEventReportResponseResource `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+func (x *APIKeySecretResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// ListAPIKeysRequest - GET /api/api-keys
+type ListAPIKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserEventReportResponseResponse) Reset() {
-	*x = UserEventReportResponseResponse{}
-	mi := &file_auth_proto_msgTypes[93]
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	mi := &file_auth_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserEventReportResponseResponse) String() string {
+func (x *ListAPIKeysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserEventReportResponseResponse) ProtoMessage() {}
+func (*ListAPIKeysRequest) ProtoMessage() {}
 
-func (x *UserEventReportResponseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[93]
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6103,43 +8404,40 @@ func (x *UserEventReportResponseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserEventReportResponseResponse.ProtoReflect.Descriptor instead.
-func (*UserEventReportResponseResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *UserEventReportResponseResponse) GetData() *UserEventReportResponseResource {
+func (x *ListAPIKeysRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Data
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-// ListUsersRequest - GET /api/users
-type ListUsersRequest struct {
+type ListAPIKeysResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        string                 `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`                  // Optional: partial match on name column
-	OrderBy       string                 `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"` // Optional: one of "score", "registered_at_asc", "registered_at_desc"
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                     // Optional: pagination cursor (default: 1)
+	Data          []*APIKey              `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersRequest) Reset() {
-	*x = ListUsersRequest{}
-	mi := &file_auth_proto_msgTypes[94]
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	mi := &file_auth_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersRequest) String() string {
+func (x *ListAPIKeysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersRequest) ProtoMessage() {}
+func (*ListAPIKeysResponse) ProtoMessage() {}
 
-func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[94]
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6150,57 +8448,95 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
-func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *ListUsersRequest) GetSearch() string {
+func (x *ListAPIKeysResponse) GetData() []*APIKey {
 	if x != nil {
-		return x.Search
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *ListUsersRequest) GetOrderBy() string {
+// RotateAPIKeyRequest - POST /api/api-keys/{id}/rotate
+type RotateAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	KeyId         uint64                 `protobuf:"varint,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateAPIKeyRequest) Reset() {
+	*x = RotateAPIKeyRequest{}
+	mi := &file_auth_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAPIKeyRequest) ProtoMessage() {}
+
+func (x *RotateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[132]
 	if x != nil {
-		return x.OrderBy
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListUsersRequest) GetPage() int32 {
+// Deprecated: Use RotateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *RotateAPIKeyRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Page
+		return x.UserId
 	}
 	return 0
 }
 
-// ListUsersResponse - paginated list of users
-type ListUsersResponse struct {
+func (x *RotateAPIKeyRequest) GetKeyId() uint64 {
+	if x != nil {
+		return x.KeyId
+	}
+	return 0
+}
+
+// RevokeAPIKeyRequest - DELETE /api/api-keys/{id}
+type RevokeAPIKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*UserListItem        `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Links         *PaginationLinks       `protobuf:"bytes,2,opt,name=links,proto3" json:"links,omitempty"`
-	Meta          *PaginationMeta        `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	KeyId         uint64                 `protobuf:"varint,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersResponse) Reset() {
-	*x = ListUsersResponse{}
-	mi := &file_auth_proto_msgTypes[95]
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_auth_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersResponse) String() string {
+func (x *RevokeAPIKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersResponse) ProtoMessage() {}
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
 
-func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[95]
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6211,60 +8547,48 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
-func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{95}
-}
-
-func (x *ListUsersResponse) GetData() []*UserListItem {
-	if x != nil {
-		return x.Data
-	}
-	return nil
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *ListUsersResponse) GetLinks() *PaginationLinks {
+func (x *RevokeAPIKeyRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Links
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListUsersResponse) GetMeta() *PaginationMeta {
+func (x *RevokeAPIKeyRequest) GetKeyId() uint64 {
 	if x != nil {
-		return x.Meta
+		return x.KeyId
 	}
-	return nil
+	return 0
 }
 
-// UserListItem - user summary for list endpoint
-type UserListItem struct {
+// ValidateAPIKeyRequest - used by the gateway and service interceptors
+type ValidateAPIKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Prefers KYC full name when available
-	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
-	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
-	Levels        *UserLevelInfo         `protobuf:"bytes,5,opt,name=levels,proto3" json:"levels,omitempty"`                                 // Current and previous level info
-	ProfilePhoto  string                 `protobuf:"bytes,6,opt,name=profile_photo,json=profilePhoto,proto3" json:"profile_photo,omitempty"` // Latest profile photo URL
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserListItem) Reset() {
-	*x = UserListItem{}
-	mi := &file_auth_proto_msgTypes[96]
+func (x *ValidateAPIKeyRequest) Reset() {
+	*x = ValidateAPIKeyRequest{}
+	mi := &file_auth_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserListItem) String() string {
+func (x *ValidateAPIKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserListItem) ProtoMessage() {}
+func (*ValidateAPIKeyRequest) ProtoMessage() {}
 
-func (x *UserListItem) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[96]
+func (x *ValidateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6275,77 +8599,125 @@ func (x *UserListItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserListItem.ProtoReflect.Descriptor instead.
-func (*UserListItem) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use ValidateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*ValidateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *UserListItem) GetId() uint64 {
+func (x *ValidateAPIKeyRequest) GetKey() string {
 	if x != nil {
-		return x.Id
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *UserListItem) GetName() string {
+type ValidateAPIKeyResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Valid              bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	KeyId              uint64                 `protobuf:"varint,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	UserId             uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email              string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Scopes             []string               `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	RateLimitPerMinute int32                  `protobuf:"varint,6,opt,name=rate_limit_per_minute,json=rateLimitPerMinute,proto3" json:"rate_limit_per_minute,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ValidateAPIKeyResponse) Reset() {
+	*x = ValidateAPIKeyResponse{}
+	mi := &file_auth_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateAPIKeyResponse) ProtoMessage() {}
+
+func (x *ValidateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[135]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserListItem) GetCode() string {
+// Deprecated: Use ValidateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*ValidateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ValidateAPIKeyResponse) GetValid() bool {
 	if x != nil {
-		return x.Code
+		return x.Valid
 	}
-	return ""
+	return false
 }
 
-func (x *UserListItem) GetScore() int32 {
+func (x *ValidateAPIKeyResponse) GetKeyId() uint64 {
 	if x != nil {
-		return x.Score
+		return x.KeyId
 	}
 	return 0
 }
 
-func (x *UserListItem) GetLevels() *UserLevelInfo {
+func (x *ValidateAPIKeyResponse) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ValidateAPIKeyResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ValidateAPIKeyResponse) GetScopes() []string {
 	if x != nil {
-		return x.Levels
+		return x.Scopes
 	}
 	return nil
 }
 
-func (x *UserListItem) GetProfilePhoto() string {
+func (x *ValidateAPIKeyResponse) GetRateLimitPerMinute() int32 {
 	if x != nil {
-		return x.ProfilePhoto
+		return x.RateLimitPerMinute
 	}
-	return ""
+	return 0
 }
 
-// UserLevelInfo - level information for user list
-type UserLevelInfo struct {
+// SendEmailVerificationRequest - POST /api/email/verification-notification
+type SendEmailVerificationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Current       *Level                 `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`   // Current level
-	Previous      *Level                 `protobuf:"bytes,2,opt,name=previous,proto3" json:"previous,omitempty"` // Previous level
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserLevelInfo) Reset() {
-	*x = UserLevelInfo{}
-	mi := &file_auth_proto_msgTypes[97]
+func (x *SendEmailVerificationRequest) Reset() {
+	*x = SendEmailVerificationRequest{}
+	mi := &file_auth_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserLevelInfo) String() string {
+func (x *SendEmailVerificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserLevelInfo) ProtoMessage() {}
+func (*SendEmailVerificationRequest) ProtoMessage() {}
 
-func (x *UserLevelInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[97]
+func (x *SendEmailVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6356,51 +8728,44 @@ func (x *UserLevelInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserLevelInfo.ProtoReflect.Descriptor instead.
-func (*UserLevelInfo) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{97}
-}
-
-func (x *UserLevelInfo) GetCurrent() *Level {
-	if x != nil {
-		return x.Current
-	}
-	return nil
+// Deprecated: Use SendEmailVerificationRequest.ProtoReflect.Descriptor instead.
+func (*SendEmailVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *UserLevelInfo) GetPrevious() *Level {
+func (x *SendEmailVerificationRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Previous
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-// PaginationLinks - pagination links for simple pagination
-type PaginationLinks struct {
+// VerifyEmailRequest - GET /api/email/verify/{id}/{hash}
+type VerifyEmailRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	First         string                 `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
-	Last          string                 `protobuf:"bytes,2,opt,name=last,proto3" json:"last,omitempty"`
-	Prev          string                 `protobuf:"bytes,3,opt,name=prev,proto3" json:"prev,omitempty"`
-	Next          string                 `protobuf:"bytes,4,opt,name=next,proto3" json:"next,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Hash          string                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`        // SHA-1 of the email the link was sent to
+	Expires       int64                  `protobuf:"varint,3,opt,name=expires,proto3" json:"expires,omitempty"` // Unix timestamp signed into the link
+	Signature     string                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaginationLinks) Reset() {
-	*x = PaginationLinks{}
-	mi := &file_auth_proto_msgTypes[98]
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	mi := &file_auth_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaginationLinks) String() string {
+func (x *VerifyEmailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginationLinks) ProtoMessage() {}
+func (*VerifyEmailRequest) ProtoMessage() {}
 
-func (x *PaginationLinks) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[98]
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6411,62 +8776,62 @@ func (x *PaginationLinks) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginationLinks.ProtoReflect.Descriptor instead.
-func (*PaginationLinks) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *PaginationLinks) GetFirst() string {
+func (x *VerifyEmailRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.First
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *PaginationLinks) GetLast() string {
+func (x *VerifyEmailRequest) GetHash() string {
 	if x != nil {
-		return x.Last
+		return x.Hash
 	}
 	return ""
 }
 
-func (x *PaginationLinks) GetPrev() string {
+func (x *VerifyEmailRequest) GetExpires() int64 {
 	if x != nil {
-		return x.Prev
+		return x.Expires
 	}
-	return ""
+	return 0
 }
 
-func (x *PaginationLinks) GetNext() string {
+func (x *VerifyEmailRequest) GetSignature() string {
 	if x != nil {
-		return x.Next
+		return x.Signature
 	}
 	return ""
 }
 
-// GetUserLevelsRequest - GET /api/users/{user}/levels
-type GetUserLevelsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type VerifyEmailResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AlreadyVerified bool                   `protobuf:"varint,1,opt,name=already_verified,json=alreadyVerified,proto3" json:"already_verified,omitempty"`
+	RedirectUrl     string                 `protobuf:"bytes,2,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"` // Where the frontend shows the result
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetUserLevelsRequest) Reset() {
-	*x = GetUserLevelsRequest{}
-	mi := &file_auth_proto_msgTypes[99]
+func (x *VerifyEmailResponse) Reset() {
+	*x = VerifyEmailResponse{}
+	mi := &file_auth_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserLevelsRequest) String() string {
+func (x *VerifyEmailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserLevelsRequest) ProtoMessage() {}
+func (*VerifyEmailResponse) ProtoMessage() {}
 
-func (x *GetUserLevelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[99]
+func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6477,41 +8842,48 @@ func (x *GetUserLevelsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserLevelsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserLevelsRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use VerifyEmailResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *GetUserLevelsRequest) GetUserId() uint64 {
+func (x *VerifyEmailResponse) GetAlreadyVerified() bool {
 	if x != nil {
-		return x.UserId
+		return x.AlreadyVerified
 	}
-	return 0
+	return false
 }
 
-// GetUserLevelsResponse - user level ladder data
-type GetUserLevelsResponse struct {
+func (x *VerifyEmailResponse) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+// TokenScopesResponse - GET /api/personal-access-tokens/scopes
+type TokenScopesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *UserLevelData         `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Scopes        []string               `protobuf:"bytes,1,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserLevelsResponse) Reset() {
-	*x = GetUserLevelsResponse{}
-	mi := &file_auth_proto_msgTypes[100]
+func (x *TokenScopesResponse) Reset() {
+	*x = TokenScopesResponse{}
+	mi := &file_auth_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserLevelsResponse) String() string {
+func (x *TokenScopesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserLevelsResponse) ProtoMessage() {}
+func (*TokenScopesResponse) ProtoMessage() {}
 
-func (x *GetUserLevelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[100]
+func (x *TokenScopesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6522,43 +8894,46 @@ func (x *GetUserLevelsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserLevelsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserLevelsResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use TokenScopesResponse.ProtoReflect.Descriptor instead.
+func (*TokenScopesResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *GetUserLevelsResponse) GetData() *UserLevelData {
+func (x *TokenScopesResponse) GetScopes() []string {
 	if x != nil {
-		return x.Data
+		return x.Scopes
 	}
 	return nil
 }
 
-// UserLevelData - detailed level information
-type UserLevelData struct {
-	state                      protoimpl.MessageState `protogen:"open.v1"`
-	LatestLevel                *Level                 `protobuf:"bytes,1,opt,name=latest_level,json=latestLevel,proto3" json:"latest_level,omitempty"`                                                      // Latest level, null if absent
-	PreviousLevels             []*Level               `protobuf:"bytes,2,rep,name=previous_levels,json=previousLevels,proto3" json:"previous_levels,omitempty"`                                             // Ordered ascending by score
-	ScorePercentageToNextLevel float64                `protobuf:"fixed64,3,opt,name=score_percentage_to_next_level,json=scorePercentageToNextLevel,proto3" json:"score_percentage_to_next_level,omitempty"` // Progress toward next level
-	unknownFields              protoimpl.UnknownFields
-	sizeCache                  protoimpl.SizeCache
+// PersonalAccessToken - token metadata (the token is never returned after creation)
+type PersonalAccessToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	LastUsedAt    string                 `protobuf:"bytes,4,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"` // Empty when never used
+	ExpiresAt     string                 `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`      // Empty when the token never expires
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserLevelData) Reset() {
-	*x = UserLevelData{}
-	mi := &file_auth_proto_msgTypes[101]
+func (x *PersonalAccessToken) Reset() {
+	*x = PersonalAccessToken{}
+	mi := &file_auth_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserLevelData) String() string {
+func (x *PersonalAccessToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserLevelData) ProtoMessage() {}
+func (*PersonalAccessToken) ProtoMessage() {}
 
-func (x *UserLevelData) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[101]
+func (x *PersonalAccessToken) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6569,56 +8944,79 @@ func (x *UserLevelData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserLevelData.ProtoReflect.Descriptor instead.
-func (*UserLevelData) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use PersonalAccessToken.ProtoReflect.Descriptor instead.
+func (*PersonalAccessToken) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *UserLevelData) GetLatestLevel() *Level {
+func (x *PersonalAccessToken) GetId() uint64 {
 	if x != nil {
-		return x.LatestLevel
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *UserLevelData) GetPreviousLevels() []*Level {
+func (x *PersonalAccessToken) GetName() string {
 	if x != nil {
-		return x.PreviousLevels
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PersonalAccessToken) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
 	}
 	return nil
 }
 
-func (x *UserLevelData) GetScorePercentageToNextLevel() float64 {
+func (x *PersonalAccessToken) GetLastUsedAt() string {
 	if x != nil {
-		return x.ScorePercentageToNextLevel
+		return x.LastUsedAt
 	}
-	return 0
+	return ""
 }
 
-// GetUserProfileRequest - GET /api/users/{user}/profile
-type GetUserProfileRequest struct {
+func (x *PersonalAccessToken) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *PersonalAccessToken) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// CreatePersonalAccessTokenRequest - POST /api/personal-access-tokens
+type CreatePersonalAccessTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ViewerUserId  uint64                 `protobuf:"varint,2,opt,name=viewer_user_id,json=viewerUserId,proto3" json:"viewer_user_id,omitempty"` // Optional: authenticated user viewing the profile
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Optional, RFC3339
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserProfileRequest) Reset() {
-	*x = GetUserProfileRequest{}
-	mi := &file_auth_proto_msgTypes[102]
+func (x *CreatePersonalAccessTokenRequest) Reset() {
+	*x = CreatePersonalAccessTokenRequest{}
+	mi := &file_auth_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserProfileRequest) String() string {
+func (x *CreatePersonalAccessTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserProfileRequest) ProtoMessage() {}
+func (*CreatePersonalAccessTokenRequest) ProtoMessage() {}
 
-func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[102]
+func (x *CreatePersonalAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6629,99 +9027,63 @@ func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use CreatePersonalAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreatePersonalAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *GetUserProfileRequest) GetUserId() uint64 {
+func (x *CreatePersonalAccessTokenRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetUserProfileRequest) GetViewerUserId() uint64 {
+func (x *CreatePersonalAccessTokenRequest) GetName() string {
 	if x != nil {
-		return x.ViewerUserId
+		return x.Name
 	}
-	return 0
-}
-
-// GetUserProfileResponse - user profile with privacy filtering
-type GetUserProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *UserProfileData       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetUserProfileResponse) Reset() {
-	*x = GetUserProfileResponse{}
-	mi := &file_auth_proto_msgTypes[103]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetUserProfileResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*GetUserProfileResponse) ProtoMessage() {}
-
-func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[103]
+func (x *CreatePersonalAccessTokenRequest) GetScopes() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Scopes
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetUserProfileResponse.ProtoReflect.Descriptor instead.
-func (*GetUserProfileResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{103}
+	return nil
 }
 
-func (x *GetUserProfileResponse) GetData() *UserProfileData {
+func (x *CreatePersonalAccessTokenRequest) GetExpiresAt() string {
 	if x != nil {
-		return x.Data
+		return x.ExpiresAt
 	}
-	return nil
+	return ""
 }
 
-// UserProfileData - user profile information (privacy-filtered)
-type UserProfileData struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // May be null if privacy disallows
-	Code           string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
-	RegisteredAt   string                 `protobuf:"bytes,4,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`        // Jalali format Y/m/d, may be null
-	ProfileImages  []string               `protobuf:"bytes,5,rep,name=profile_images,json=profileImages,proto3" json:"profile_images,omitempty"`     // Array of URLs, may be empty
-	FollowersCount int32                  `protobuf:"varint,6,opt,name=followers_count,json=followersCount,proto3" json:"followers_count,omitempty"` // May be null if privacy disallows
-	FollowingCount int32                  `protobuf:"varint,7,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"` // May be null if privacy disallows
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// PersonalAccessTokenSecretResponse - returned on create, contains the plain token once
+type PersonalAccessTokenSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *PersonalAccessToken   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserProfileData) Reset() {
-	*x = UserProfileData{}
-	mi := &file_auth_proto_msgTypes[104]
+func (x *PersonalAccessTokenSecretResponse) Reset() {
+	*x = PersonalAccessTokenSecretResponse{}
+	mi := &file_auth_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserProfileData) String() string {
+func (x *PersonalAccessTokenSecretResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserProfileData) ProtoMessage() {}
+func (*PersonalAccessTokenSecretResponse) ProtoMessage() {}
 
-func (x *UserProfileData) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[104]
+func (x *PersonalAccessTokenSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6732,83 +9094,92 @@ func (x *UserProfileData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserProfileData.ProtoReflect.Descriptor instead.
-func (*UserProfileData) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{104}
+// Deprecated: Use PersonalAccessTokenSecretResponse.ProtoReflect.Descriptor instead.
+func (*PersonalAccessTokenSecretResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *UserProfileData) GetId() uint64 {
+func (x *PersonalAccessTokenSecretResponse) GetData() *PersonalAccessToken {
 	if x != nil {
-		return x.Id
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *UserProfileData) GetName() string {
+func (x *PersonalAccessTokenSecretResponse) GetToken() string {
 	if x != nil {
-		return x.Name
+		return x.Token
 	}
 	return ""
 }
 
-func (x *UserProfileData) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
+// ListPersonalAccessTokensRequest - GET /api/personal-access-tokens
+type ListPersonalAccessTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPersonalAccessTokensRequest) Reset() {
+	*x = ListPersonalAccessTokensRequest{}
+	mi := &file_auth_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserProfileData) GetRegisteredAt() string {
-	if x != nil {
-		return x.RegisteredAt
-	}
-	return ""
+func (x *ListPersonalAccessTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserProfileData) GetProfileImages() []string {
+func (*ListPersonalAccessTokensRequest) ProtoMessage() {}
+
+func (x *ListPersonalAccessTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[143]
 	if x != nil {
-		return x.ProfileImages
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UserProfileData) GetFollowersCount() int32 {
-	if x != nil {
-		return x.FollowersCount
-	}
-	return 0
+// Deprecated: Use ListPersonalAccessTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListPersonalAccessTokensRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *UserProfileData) GetFollowingCount() int32 {
+func (x *ListPersonalAccessTokensRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.FollowingCount
+		return x.UserId
 	}
 	return 0
 }
 
-// GetUserFeaturesCountRequest - GET /api/users/{user}/features/count
-type GetUserFeaturesCountRequest struct {
+type ListPersonalAccessTokensResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Data          []*PersonalAccessToken `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserFeaturesCountRequest) Reset() {
-	*x = GetUserFeaturesCountRequest{}
-	mi := &file_auth_proto_msgTypes[105]
+func (x *ListPersonalAccessTokensResponse) Reset() {
+	*x = ListPersonalAccessTokensResponse{}
+	mi := &file_auth_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserFeaturesCountRequest) String() string {
+func (x *ListPersonalAccessTokensResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserFeaturesCountRequest) ProtoMessage() {}
+func (*ListPersonalAccessTokensResponse) ProtoMessage() {}
 
-func (x *GetUserFeaturesCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[105]
+func (x *ListPersonalAccessTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6819,41 +9190,42 @@ func (x *GetUserFeaturesCountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserFeaturesCountRequest.ProtoReflect.Descriptor instead.
-func (*GetUserFeaturesCountRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use ListPersonalAccessTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListPersonalAccessTokensResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *GetUserFeaturesCountRequest) GetUserId() uint64 {
+func (x *ListPersonalAccessTokensResponse) GetData() []*PersonalAccessToken {
 	if x != nil {
-		return x.UserId
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-// GetUserFeaturesCountResponse - feature counts by category
-type GetUserFeaturesCountResponse struct {
+// RevokePersonalAccessTokenRequest - DELETE /api/personal-access-tokens/{id}
+type RevokePersonalAccessTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *UserFeaturesCountData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TokenId       uint64                 `protobuf:"varint,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserFeaturesCountResponse) Reset() {
-	*x = GetUserFeaturesCountResponse{}
-	mi := &file_auth_proto_msgTypes[106]
+func (x *RevokePersonalAccessTokenRequest) Reset() {
+	*x = RevokePersonalAccessTokenRequest{}
+	mi := &file_auth_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserFeaturesCountResponse) String() string {
+func (x *RevokePersonalAccessTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserFeaturesCountResponse) ProtoMessage() {}
+func (*RevokePersonalAccessTokenRequest) ProtoMessage() {}
 
-func (x *GetUserFeaturesCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[106]
+func (x *RevokePersonalAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6864,43 +9236,55 @@ func (x *GetUserFeaturesCountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserFeaturesCountResponse.ProtoReflect.Descriptor instead.
-func (*GetUserFeaturesCountResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use RevokePersonalAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokePersonalAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *GetUserFeaturesCountResponse) GetData() *UserFeaturesCountData {
+func (x *RevokePersonalAccessTokenRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Data
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-// UserFeaturesCountData - categorized feature counts
-type UserFeaturesCountData struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	MaskoniFeaturesCount   int32                  `protobuf:"varint,1,opt,name=maskoni_features_count,json=maskoniFeaturesCount,proto3" json:"maskoni_features_count,omitempty"`       // karbari = 'm'
-	TejariFeaturesCount    int32                  `protobuf:"varint,2,opt,name=tejari_features_count,json=tejariFeaturesCount,proto3" json:"tejari_features_count,omitempty"`          // karbari = 't'
-	AmoozeshiFeaturesCount int32                  `protobuf:"varint,3,opt,name=amoozeshi_features_count,json=amoozeshiFeaturesCount,proto3" json:"amoozeshi_features_count,omitempty"` // karbari = 'a'
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *RevokePersonalAccessTokenRequest) GetTokenId() uint64 {
+	if x != nil {
+		return x.TokenId
+	}
+	return 0
 }
 
-func (x *UserFeaturesCountData) Reset() {
-	*x = UserFeaturesCountData{}
-	mi := &file_auth_proto_msgTypes[107]
+// LoginAlert - a login from a device or IP the user had not used before
+type LoginAlert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       uint64                 `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // The login user event, can be reported via UserEventsService
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                   // "new_device" or "new_ip"
+	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	Device        string                 `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // "pending", "confirmed" or "denied"
+	Date          string                 `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"`     // Jalali format: Y/m/d
+	Time          string                 `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"`     // H:i:s format
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginAlert) Reset() {
+	*x = LoginAlert{}
+	mi := &file_auth_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserFeaturesCountData) String() string {
+func (x *LoginAlert) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserFeaturesCountData) ProtoMessage() {}
+func (*LoginAlert) ProtoMessage() {}
 
-func (x *UserFeaturesCountData) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[107]
+func (x *LoginAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6911,55 +9295,91 @@ func (x *UserFeaturesCountData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserFeaturesCountData.ProtoReflect.Descriptor instead.
-func (*UserFeaturesCountData) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use LoginAlert.ProtoReflect.Descriptor instead.
+func (*LoginAlert) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *UserFeaturesCountData) GetMaskoniFeaturesCount() int32 {
+func (x *LoginAlert) GetId() uint64 {
 	if x != nil {
-		return x.MaskoniFeaturesCount
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UserFeaturesCountData) GetTejariFeaturesCount() int32 {
+func (x *LoginAlert) GetEventId() uint64 {
 	if x != nil {
-		return x.TejariFeaturesCount
+		return x.EventId
 	}
 	return 0
 }
 
-func (x *UserFeaturesCountData) GetAmoozeshiFeaturesCount() int32 {
+func (x *LoginAlert) GetReason() string {
 	if x != nil {
-		return x.AmoozeshiFeaturesCount
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-// SearchUsersRequest - POST /api/search/users
-type SearchUsersRequest struct {
+func (x *LoginAlert) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *LoginAlert) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *LoginAlert) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LoginAlert) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *LoginAlert) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+// ListLoginAlertsRequest - GET /api/events/login-alerts
+type ListLoginAlertsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SearchTerm    string                 `protobuf:"bytes,1,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PendingOnly   bool                   `protobuf:"varint,2,opt,name=pending_only,json=pendingOnly,proto3" json:"pending_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchUsersRequest) Reset() {
-	*x = SearchUsersRequest{}
-	mi := &file_auth_proto_msgTypes[108]
+func (x *ListLoginAlertsRequest) Reset() {
+	*x = ListLoginAlertsRequest{}
+	mi := &file_auth_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchUsersRequest) String() string {
+func (x *ListLoginAlertsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUsersRequest) ProtoMessage() {}
+func (*ListLoginAlertsRequest) ProtoMessage() {}
 
-func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[108]
+func (x *ListLoginAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6970,41 +9390,47 @@ func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
-func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use ListLoginAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListLoginAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *SearchUsersRequest) GetSearchTerm() string {
+func (x *ListLoginAlertsRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.SearchTerm
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-// SearchUsersResponse - user search results
-type SearchUsersResponse struct {
+func (x *ListLoginAlertsRequest) GetPendingOnly() bool {
+	if x != nil {
+		return x.PendingOnly
+	}
+	return false
+}
+
+type ListLoginAlertsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*SearchUserResult    `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Data          []*LoginAlert          `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchUsersResponse) Reset() {
-	*x = SearchUsersResponse{}
-	mi := &file_auth_proto_msgTypes[109]
+func (x *ListLoginAlertsResponse) Reset() {
+	*x = ListLoginAlertsResponse{}
+	mi := &file_auth_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchUsersResponse) String() string {
+func (x *ListLoginAlertsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUsersResponse) ProtoMessage() {}
+func (*ListLoginAlertsResponse) ProtoMessage() {}
 
-func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[109]
+func (x *ListLoginAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7015,46 +9441,44 @@ func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
-func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use ListLoginAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListLoginAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *SearchUsersResponse) GetData() []*SearchUserResult {
+func (x *ListLoginAlertsResponse) GetData() []*LoginAlert {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// SearchUserResult - individual user search result
-type SearchUserResult struct {
+// ConfirmLoginAlertRequest - POST /api/events/login-alerts/{alert}/confirm
+// was_me = false signs the user out of every session.
+type ConfirmLoginAlertRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`            // Uppercased
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`            // Uses KYC data when verified, otherwise user.name
-	Followers     int32                  `protobuf:"varint,4,opt,name=followers,proto3" json:"followers,omitempty"` // Count of followers
-	Level         string                 `protobuf:"bytes,5,opt,name=level,proto3" json:"level,omitempty"`          // Latest level name (nullable)
-	Photo         string                 `protobuf:"bytes,6,opt,name=photo,proto3" json:"photo,omitempty"`          // Latest profile photo URL (nullable)
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AlertId       uint64                 `protobuf:"varint,2,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	WasMe         bool                   `protobuf:"varint,3,opt,name=was_me,json=wasMe,proto3" json:"was_me,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchUserResult) Reset() {
-	*x = SearchUserResult{}
-	mi := &file_auth_proto_msgTypes[110]
+func (x *ConfirmLoginAlertRequest) Reset() {
+	*x = ConfirmLoginAlertRequest{}
+	mi := &file_auth_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchUserResult) String() string {
+func (x *ConfirmLoginAlertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUserResult) ProtoMessage() {}
+func (*ConfirmLoginAlertRequest) ProtoMessage() {}
 
-func (x *SearchUserResult) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[110]
+func (x *ConfirmLoginAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7065,76 +9489,101 @@ func (x *SearchUserResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUserResult.ProtoReflect.Descriptor instead.
-func (*SearchUserResult) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{110}
+// Deprecated: Use ConfirmLoginAlertRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmLoginAlertRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *SearchUserResult) GetId() uint64 {
+func (x *ConfirmLoginAlertRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *SearchUserResult) GetCode() string {
+func (x *ConfirmLoginAlertRequest) GetAlertId() uint64 {
 	if x != nil {
-		return x.Code
+		return x.AlertId
 	}
-	return ""
+	return 0
 }
 
-func (x *SearchUserResult) GetName() string {
+func (x *ConfirmLoginAlertRequest) GetWasMe() bool {
 	if x != nil {
-		return x.Name
+		return x.WasMe
 	}
-	return ""
+	return false
 }
 
-func (x *SearchUserResult) GetFollowers() int32 {
-	if x != nil {
-		return x.Followers
-	}
-	return 0
+type LoginAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *LoginAlert            `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchUserResult) GetLevel() string {
+func (x *LoginAlertResponse) Reset() {
+	*x = LoginAlertResponse{}
+	mi := &file_auth_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginAlertResponse) ProtoMessage() {}
+
+func (x *LoginAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[150]
 	if x != nil {
-		return x.Level
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SearchUserResult) GetPhoto() string {
+// Deprecated: Use LoginAlertResponse.ProtoReflect.Descriptor instead.
+func (*LoginAlertResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *LoginAlertResponse) GetData() *LoginAlert {
 	if x != nil {
-		return x.Photo
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-// SearchFeaturesRequest - POST /api/search/features
-type SearchFeaturesRequest struct {
+// GetLoginHistoryRequest - GET /api/auth/login-history
+type GetLoginHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SearchTerm    string                 `protobuf:"bytes,1,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                      // Default: 1
+	PerPage       int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"` // Default: 10, max: 50
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchFeaturesRequest) Reset() {
-	*x = SearchFeaturesRequest{}
-	mi := &file_auth_proto_msgTypes[111]
+func (x *GetLoginHistoryRequest) Reset() {
+	*x = GetLoginHistoryRequest{}
+	mi := &file_auth_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchFeaturesRequest) String() string {
+func (x *GetLoginHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchFeaturesRequest) ProtoMessage() {}
+func (*GetLoginHistoryRequest) ProtoMessage() {}
 
-func (x *SearchFeaturesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[111]
+func (x *GetLoginHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7145,41 +9594,57 @@ func (x *SearchFeaturesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchFeaturesRequest.ProtoReflect.Descriptor instead.
-func (*SearchFeaturesRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{111}
+// Deprecated: Use GetLoginHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetLoginHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *GetLoginHistoryRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
 }
 
-func (x *SearchFeaturesRequest) GetSearchTerm() string {
+func (x *GetLoginHistoryRequest) GetPage() int32 {
 	if x != nil {
-		return x.SearchTerm
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-// SearchFeaturesResponse - feature search results
-type SearchFeaturesResponse struct {
+func (x *GetLoginHistoryRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+// LoginLocation - approximate location of a login IP from the GeoIP database
+type LoginLocation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*SearchFeatureResult `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	CountryCode   string                 `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"` // ISO 3166-1 alpha-2, e.g. "IR"
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchFeaturesResponse) Reset() {
-	*x = SearchFeaturesResponse{}
-	mi := &file_auth_proto_msgTypes[112]
+func (x *LoginLocation) Reset() {
+	*x = LoginLocation{}
+	mi := &file_auth_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchFeaturesResponse) String() string {
+func (x *LoginLocation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchFeaturesResponse) ProtoMessage() {}
+func (*LoginLocation) ProtoMessage() {}
 
-func (x *SearchFeaturesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[112]
+func (x *LoginLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7190,48 +9655,61 @@ func (x *SearchFeaturesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchFeaturesResponse.ProtoReflect.Descriptor instead.
-func (*SearchFeaturesResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{112}
+// Deprecated: Use LoginLocation.ProtoReflect.Descriptor instead.
+func (*LoginLocation) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *SearchFeaturesResponse) GetData() []*SearchFeatureResult {
+func (x *LoginLocation) GetCountryCode() string {
 	if x != nil {
-		return x.Data
+		return x.CountryCode
 	}
-	return nil
+	return ""
 }
 
-// SearchFeatureResult - individual feature search result
-type SearchFeatureResult struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                               // Feature ID
-	FeaturePropertiesId string                 `protobuf:"bytes,2,opt,name=feature_properties_id,json=featurePropertiesId,proto3" json:"feature_properties_id,omitempty"` // Uppercased FeatureProperties.id
-	Address             string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Karbari             string                 `protobuf:"bytes,4,opt,name=karbari,proto3" json:"karbari,omitempty"` // Application title from feature
-	PricePsc            string                 `protobuf:"bytes,5,opt,name=price_psc,json=pricePsc,proto3" json:"price_psc,omitempty"`
-	PriceIrr            string                 `protobuf:"bytes,6,opt,name=price_irr,json=priceIrr,proto3" json:"price_irr,omitempty"`
-	OwnerCode           string                 `protobuf:"bytes,7,opt,name=owner_code,json=ownerCode,proto3" json:"owner_code,omitempty"` // Uppercased owner code
-	Coordinates         []*Coordinate          `protobuf:"bytes,8,rep,name=coordinates,proto3" json:"coordinates,omitempty"`              // Geometry coordinates
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *LoginLocation) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
 }
 
-func (x *SearchFeatureResult) Reset() {
-	*x = SearchFeatureResult{}
-	mi := &file_auth_proto_msgTypes[113]
+func (x *LoginLocation) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+type LoginHistoryEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // The login user event, can be reported via UserEventsService
+	Ip            string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	Device        string                 `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`                              // User agent of the login
+	Location      *LoginLocation         `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`                          // Unset for private or unknown IPs, or without a GeoIP database
+	Date          string                 `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`                                  // Jalali format: Y/m/d
+	Time          string                 `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`                                  // H:i:s format
+	AlertReason   string                 `protobuf:"bytes,7,opt,name=alert_reason,json=alertReason,proto3" json:"alert_reason,omitempty"` // "new_device" or "new_ip" when the login raised a login alert
+	AlertStatus   string                 `protobuf:"bytes,8,opt,name=alert_status,json=alertStatus,proto3" json:"alert_status,omitempty"` // "pending", "confirmed" or "denied" when the login raised a login alert
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginHistoryEntry) Reset() {
+	*x = LoginHistoryEntry{}
+	mi := &file_auth_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchFeatureResult) String() string {
+func (x *LoginHistoryEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchFeatureResult) ProtoMessage() {}
+func (*LoginHistoryEntry) ProtoMessage() {}
 
-func (x *SearchFeatureResult) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[113]
+func (x *LoginHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7242,92 +9720,90 @@ func (x *SearchFeatureResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchFeatureResult.ProtoReflect.Descriptor instead.
-func (*SearchFeatureResult) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{113}
+// Deprecated: Use LoginHistoryEntry.ProtoReflect.Descriptor instead.
+func (*LoginHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *SearchFeatureResult) GetId() uint64 {
+func (x *LoginHistoryEntry) GetEventId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.EventId
 	}
 	return 0
 }
 
-func (x *SearchFeatureResult) GetFeaturePropertiesId() string {
+func (x *LoginHistoryEntry) GetIp() string {
 	if x != nil {
-		return x.FeaturePropertiesId
+		return x.Ip
 	}
 	return ""
 }
 
-func (x *SearchFeatureResult) GetAddress() string {
+func (x *LoginHistoryEntry) GetDevice() string {
 	if x != nil {
-		return x.Address
+		return x.Device
 	}
 	return ""
 }
 
-func (x *SearchFeatureResult) GetKarbari() string {
+func (x *LoginHistoryEntry) GetLocation() *LoginLocation {
 	if x != nil {
-		return x.Karbari
+		return x.Location
 	}
-	return ""
+	return nil
 }
 
-func (x *SearchFeatureResult) GetPricePsc() string {
+func (x *LoginHistoryEntry) GetDate() string {
 	if x != nil {
-		return x.PricePsc
+		return x.Date
 	}
 	return ""
 }
 
-func (x *SearchFeatureResult) GetPriceIrr() string {
+func (x *LoginHistoryEntry) GetTime() string {
 	if x != nil {
-		return x.PriceIrr
+		return x.Time
 	}
 	return ""
 }
 
-func (x *SearchFeatureResult) GetOwnerCode() string {
+func (x *LoginHistoryEntry) GetAlertReason() string {
 	if x != nil {
-		return x.OwnerCode
+		return x.AlertReason
 	}
 	return ""
 }
 
-func (x *SearchFeatureResult) GetCoordinates() []*Coordinate {
+func (x *LoginHistoryEntry) GetAlertStatus() string {
 	if x != nil {
-		return x.Coordinates
+		return x.AlertStatus
 	}
-	return nil
+	return ""
 }
 
-// Coordinate - coordinate with id, x, y for search results
-type Coordinate struct {
+type GetLoginHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	X             float64                `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"` // Parsed from varchar as float64
-	Y             float64                `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"` // Parsed from varchar as float64
+	Data          []*LoginHistoryEntry   `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination    *PaginationMeta        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Coordinate) Reset() {
-	*x = Coordinate{}
-	mi := &file_auth_proto_msgTypes[114]
+func (x *GetLoginHistoryResponse) Reset() {
+	*x = GetLoginHistoryResponse{}
+	mi := &file_auth_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Coordinate) String() string {
+func (x *GetLoginHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Coordinate) ProtoMessage() {}
+func (*GetLoginHistoryResponse) ProtoMessage() {}
 
-func (x *Coordinate) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[114]
+func (x *GetLoginHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7338,55 +9814,54 @@ func (x *Coordinate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Coordinate.ProtoReflect.Descriptor instead.
-func (*Coordinate) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{114}
-}
-
-func (x *Coordinate) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use GetLoginHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetLoginHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *Coordinate) GetX() float64 {
+func (x *GetLoginHistoryResponse) GetData() []*LoginHistoryEntry {
 	if x != nil {
-		return x.X
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *Coordinate) GetY() float64 {
+func (x *GetLoginHistoryResponse) GetPagination() *PaginationMeta {
 	if x != nil {
-		return x.Y
+		return x.Pagination
 	}
-	return 0
+	return nil
 }
 
-// SearchIsicCodesRequest - POST /api/search/isic-codes
-type SearchIsicCodesRequest struct {
+// RequestMagicLinkRequest - POST /api/auth/magic-link
+// Succeeds without sending anything when no user has the email, so the
+// endpoint cannot be used to discover accounts.
+type RequestMagicLinkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SearchTerm    string                 `protobuf:"bytes,1,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	BackUrl       string                 `protobuf:"bytes,2,opt,name=back_url,json=backUrl,proto3" json:"back_url,omitempty"` // Optional, where the frontend should land after login; FRONT_END_URL or an allowed origin
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	DeviceNonce   string                 `protobuf:"bytes,5,opt,name=device_nonce,json=deviceNonce,proto3" json:"device_nonce,omitempty"` // Random value the gateway set as a cookie; the link only works where it is sent back
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchIsicCodesRequest) Reset() {
-	*x = SearchIsicCodesRequest{}
-	mi := &file_auth_proto_msgTypes[115]
+func (x *RequestMagicLinkRequest) Reset() {
+	*x = RequestMagicLinkRequest{}
+	mi := &file_auth_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchIsicCodesRequest) String() string {
+func (x *RequestMagicLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchIsicCodesRequest) ProtoMessage() {}
+func (*RequestMagicLinkRequest) ProtoMessage() {}
 
-func (x *SearchIsicCodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[115]
+func (x *RequestMagicLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7397,88 +9872,74 @@ func (x *SearchIsicCodesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchIsicCodesRequest.ProtoReflect.Descriptor instead.
-func (*SearchIsicCodesRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{115}
+// Deprecated: Use RequestMagicLinkRequest.ProtoReflect.Descriptor instead.
+func (*RequestMagicLinkRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *SearchIsicCodesRequest) GetSearchTerm() string {
+func (x *RequestMagicLinkRequest) GetEmail() string {
 	if x != nil {
-		return x.SearchTerm
+		return x.Email
 	}
 	return ""
 }
 
-// SearchIsicCodesResponse - ISIC code search results
-type SearchIsicCodesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*IsicCodeResult      `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SearchIsicCodesResponse) Reset() {
-	*x = SearchIsicCodesResponse{}
-	mi := &file_auth_proto_msgTypes[116]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SearchIsicCodesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RequestMagicLinkRequest) GetBackUrl() string {
+	if x != nil {
+		return x.BackUrl
+	}
+	return ""
 }
 
-func (*SearchIsicCodesResponse) ProtoMessage() {}
-
-func (x *SearchIsicCodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[116]
+func (x *RequestMagicLinkRequest) GetIp() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Ip
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SearchIsicCodesResponse.ProtoReflect.Descriptor instead.
-func (*SearchIsicCodesResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{116}
+func (x *RequestMagicLinkRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
 }
 
-func (x *SearchIsicCodesResponse) GetData() []*IsicCodeResult {
+func (x *RequestMagicLinkRequest) GetDeviceNonce() string {
 	if x != nil {
-		return x.Data
+		return x.DeviceNonce
 	}
-	return nil
+	return ""
 }
 
-// IsicCodeResult - individual ISIC code result
-type IsicCodeResult struct {
+// ConsumeMagicLinkRequest - GET /api/auth/magic-link/callback
+type ConsumeMagicLinkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Code          uint64                 `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"` // bigint in database
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Expires       int64                  `protobuf:"varint,2,opt,name=expires,proto3" json:"expires,omitempty"` // Unix timestamp signed into the link
+	Signature     string                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	DeviceNonce   string                 `protobuf:"bytes,6,opt,name=device_nonce,json=deviceNonce,proto3" json:"device_nonce,omitempty"` // The cookie set by RequestMagicLink
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IsicCodeResult) Reset() {
-	*x = IsicCodeResult{}
-	mi := &file_auth_proto_msgTypes[117]
+func (x *ConsumeMagicLinkRequest) Reset() {
+	*x = ConsumeMagicLinkRequest{}
+	mi := &file_auth_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IsicCodeResult) String() string {
+func (x *ConsumeMagicLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsicCodeResult) ProtoMessage() {}
+func (*ConsumeMagicLinkRequest) ProtoMessage() {}
 
-func (x *IsicCodeResult) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[117]
+func (x *ConsumeMagicLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7489,30 +9950,51 @@ func (x *IsicCodeResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsicCodeResult.ProtoReflect.Descriptor instead.
-func (*IsicCodeResult) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{117}
+// Deprecated: Use ConsumeMagicLinkRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeMagicLinkRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *IsicCodeResult) GetId() uint64 {
+func (x *ConsumeMagicLinkRequest) GetToken() string {
 	if x != nil {
-		return x.Id
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ConsumeMagicLinkRequest) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
 	}
 	return 0
 }
 
-func (x *IsicCodeResult) GetName() string {
+func (x *ConsumeMagicLinkRequest) GetSignature() string {
 	if x != nil {
-		return x.Name
+		return x.Signature
 	}
 	return ""
 }
 
-func (x *IsicCodeResult) GetCode() uint64 {
+func (x *ConsumeMagicLinkRequest) GetIp() string {
 	if x != nil {
-		return x.Code
+		return x.Ip
 	}
-	return 0
+	return ""
+}
+
+func (x *ConsumeMagicLinkRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *ConsumeMagicLinkRequest) GetDeviceNonce() string {
+	if x != nil {
+		return x.DeviceNonce
+	}
+	return ""
 }
 
 var File_auth_proto protoreflect.FileDescriptor
@@ -7602,17 +10084,20 @@ const file_auth_proto_rawDesc = "" +
 	"redirectTo\x12\x19\n" +
 	"\bback_url\x18\x02 \x01(\tR\abackUrl\"$\n" +
 	"\x10RedirectResponse\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\";\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"j\n" +
 	"\x0fCallbackRequest\x12\x14\n" +
 	"\x05state\x18\x01 \x01(\tR\x05state\x12\x12\n" +
-	"\x04code\x18\x02 \x01(\tR\x04code\"j\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\"j\n" +
 	"\x10CallbackResponse\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
 	"\n" +
 	"expires_at\x18\x02 \x01(\x05R\texpiresAt\x12!\n" +
 	"\fredirect_url\x18\x03 \x01(\tR\vredirectUrl\"$\n" +
 	"\fGetMeRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"\x8f\x04\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x89\x05\n" +
 	"\fUserResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -7628,15 +10113,21 @@ const file_auth_proto_rawDesc = "" +
 	"\x1aunasnwered_questions_count\x18\v \x01(\x05R\x18unasnweredQuestionsCount\x12A\n" +
 	"\x1dhourly_profit_time_percentage\x18\f \x01(\x01R\x1ahourlyProfitTimePercentage\x12!\n" +
 	"\fverified_kyc\x18\r \x01(\bR\vverifiedKyc\x12\x1c\n" +
-	"\tbirthdate\x18\x0e \x01(\tR\tbirthdate\"%\n" +
+	"\tbirthdate\x18\x0e \x01(\tR\tbirthdate\x12%\n" +
+	"\x0eemail_verified\x18\x0f \x01(\bR\remailVerified\x12%\n" +
+	"\x0ephone_verified\x18\x10 \x01(\bR\rphoneVerified\x12*\n" +
+	"\x11must_verify_email\x18\x11 \x01(\bR\x0fmustVerifyEmail\"%\n" +
 	"\rLogoutRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\",\n" +
 	"\x14ValidateTokenRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"\\\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x93\x01\n" +
 	"\x15ValidateTokenResponse\x12\x14\n" +
 	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\"q\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\x03R\texpiresAt\x12\x16\n" +
+	"\x06scopes\x18\x05 \x03(\tR\x06scopes\"q\n" +
 	"\x1dRequestAccountSecurityRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12!\n" +
 	"\ftime_minutes\x18\x02 \x01(\x05R\vtimeMinutes\x12\x14\n" +
@@ -7648,7 +10139,25 @@ const file_auth_proto_rawDesc = "" +
 	"\n" +
 	"user_agent\x18\x04 \x01(\tR\tuserAgent\")\n" +
 	"\x0eGetUserRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\"o\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"A\n" +
+	"\x12GetUserInfoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\xae\x01\n" +
+	"\bUserInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tbirthdate\x18\x04 \x01(\tR\tbirthdate\x120\n" +
+	"\x14withdraw_profit_days\x18\x05 \x01(\x05R\x12withdrawProfitDays\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\"/\n" +
+	"\x12GetPresenceRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x04R\auserIds\"=\n" +
+	"\x13GetPresenceResponse\x12&\n" +
+	"\x04data\x18\x01 \x03(\v2\x12.auth.UserPresenceR\x04data\"x\n" +
+	"\fUserPresence\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x16\n" +
+	"\x06online\x18\x02 \x01(\bR\x06online\x127\n" +
+	"\tlast_seen\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen\"o\n" +
 	"\x14UpdateProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -7917,20 +10426,31 @@ const file_auth_proto_rawDesc = "" +
 	"\x10profile_photo_id\x18\x02 \x01(\x04R\x0eprofilePhotoId\"8\n" +
 	"\x14ProfilePhotoResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x10\n" +
-	"\x03url\x18\x02 \x01(\tR\x03url\"-\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"M\n" +
+	"\x15GetPhotoStatusRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tupload_id\x18\x02 \x01(\x04R\buploadId\"\xa2\x01\n" +
+	"\x19PhotoUploadStatusResponse\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\x04R\buploadId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12(\n" +
+	"\x10profile_photo_id\x18\x03 \x01(\x04R\x0eprofilePhotoId\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"-\n" +
 	"\x12GetSettingsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\"=\n" +
 	"\x13GetSettingsResponse\x12&\n" +
-	"\x04data\x18\x01 \x01(\v2\x12.auth.SettingsDataR\x04data\"i\n" +
+	"\x04data\x18\x01 \x01(\v2\x12.auth.SettingsDataR\x04data\"\x85\x01\n" +
 	"\fSettingsData\x12.\n" +
 	"\x13checkout_days_count\x18\x01 \x01(\rR\x11checkoutDaysCount\x12)\n" +
-	"\x10automatic_logout\x18\x02 \x01(\x05R\x0fautomaticLogout\"\xbd\x01\n" +
+	"\x10automatic_logout\x18\x02 \x01(\x05R\x0fautomaticLogout\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\"\xd9\x01\n" +
 	"\x15UpdateSettingsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12.\n" +
 	"\x13checkout_days_count\x18\x02 \x01(\rR\x11checkoutDaysCount\x12)\n" +
 	"\x10automatic_logout\x18\x03 \x01(\x05R\x0fautomaticLogout\x12\x18\n" +
 	"\asetting\x18\x04 \x01(\tR\asetting\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\bR\x06status\"4\n" +
+	"\x06status\x18\x05 \x01(\bR\x06status\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\"4\n" +
 	"\x19GetGeneralSettingsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\"P\n" +
 	"\x1aGetGeneralSettingsResponse\x122\n" +
@@ -7991,7 +10511,14 @@ const file_auth_proto_rawDesc = "" +
 	"\bresponse\x18\x03 \x01(\tR\bresponse\"M\n" +
 	"\x17CloseEventReportRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x19\n" +
-	"\bevent_id\x18\x02 \x01(\x04R\aeventId\"\xd8\x01\n" +
+	"\bevent_id\x18\x02 \x01(\x04R\aeventId\"\x8b\x01\n" +
+	"\x17ExportUserEventsRequest\x12!\n" +
+	"\frequester_id\x18\x01 \x01(\x04R\vrequesterId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tfrom_date\x18\x03 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x04 \x01(\tR\x06toDate\"+\n" +
+	"\x15UserEventsExportChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\xd8\x01\n" +
 	"\x11UserEventResource\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
 	"\x05event\x18\x02 \x01(\tR\x05event\x12\x0e\n" +
@@ -8055,7 +10582,7 @@ const file_auth_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12$\n" +
 	"\x0eviewer_user_id\x18\x02 \x01(\x04R\fviewerUserId\"C\n" +
 	"\x16GetUserProfileResponse\x12)\n" +
-	"\x04data\x18\x01 \x01(\v2\x15.auth.UserProfileDataR\x04data\"\xe7\x01\n" +
+	"\x04data\x18\x01 \x01(\v2\x15.auth.UserProfileDataR\x04data\"\x97\x02\n" +
 	"\x0fUserProfileData\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -8063,7 +10590,8 @@ const file_auth_proto_rawDesc = "" +
 	"\rregistered_at\x18\x04 \x01(\tR\fregisteredAt\x12%\n" +
 	"\x0eprofile_images\x18\x05 \x03(\tR\rprofileImages\x12'\n" +
 	"\x0ffollowers_count\x18\x06 \x01(\x05R\x0efollowersCount\x12'\n" +
-	"\x0ffollowing_count\x18\a \x01(\x05R\x0efollowingCount\"6\n" +
+	"\x0ffollowing_count\x18\a \x01(\x05R\x0efollowingCount\x12.\n" +
+	"\bpresence\x18\b \x01(\v2\x12.auth.UserPresenceR\bpresence\"6\n" +
 	"\x1bGetUserFeaturesCountRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\"O\n" +
 	"\x1cGetUserFeaturesCountResponse\x12/\n" +
@@ -8076,14 +10604,15 @@ const file_auth_proto_rawDesc = "" +
 	"\vsearch_term\x18\x01 \x01(\tR\n" +
 	"searchTerm\"A\n" +
 	"\x13SearchUsersResponse\x12*\n" +
-	"\x04data\x18\x01 \x03(\v2\x16.auth.SearchUserResultR\x04data\"\x94\x01\n" +
+	"\x04data\x18\x01 \x03(\v2\x16.auth.SearchUserResultR\x04data\"\xc4\x01\n" +
 	"\x10SearchUserResult\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
 	"\tfollowers\x18\x04 \x01(\x05R\tfollowers\x12\x14\n" +
 	"\x05level\x18\x05 \x01(\tR\x05level\x12\x14\n" +
-	"\x05photo\x18\x06 \x01(\tR\x05photo\"8\n" +
+	"\x05photo\x18\x06 \x01(\tR\x05photo\x12.\n" +
+	"\bpresence\x18\a \x01(\v2\x12.auth.UserPresenceR\bpresence\"8\n" +
 	"\x15SearchFeaturesRequest\x12\x1f\n" +
 	"\vsearch_term\x18\x01 \x01(\tR\n" +
 	"searchTerm\"G\n" +
@@ -8112,7 +10641,146 @@ const file_auth_proto_rawDesc = "" +
 	"\x0eIsicCodeResult\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04code\x18\x03 \x01(\x04R\x04code2\x9c\x04\n" +
+	"\x04code\x18\x03 \x01(\x04R\x04code\"\x8e\x02\n" +
+	"\x06APIKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x121\n" +
+	"\x15rate_limit_per_minute\x18\x05 \x01(\x05R\x12rateLimitPerMinute\x12 \n" +
+	"\flast_used_at\x18\x06 \x01(\tR\n" +
+	"lastUsedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\tR\trevokedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"\xac\x01\n" +
+	"\x13CreateAPIKeyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x121\n" +
+	"\x15rate_limit_per_minute\x18\x04 \x01(\x05R\x12rateLimitPerMinute\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\"J\n" +
+	"\x14APIKeySecretResponse\x12 \n" +
+	"\x04data\x18\x01 \x01(\v2\f.auth.APIKeyR\x04data\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"-\n" +
+	"\x12ListAPIKeysRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"7\n" +
+	"\x13ListAPIKeysResponse\x12 \n" +
+	"\x04data\x18\x01 \x03(\v2\f.auth.APIKeyR\x04data\"E\n" +
+	"\x13RotateAPIKeyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x15\n" +
+	"\x06key_id\x18\x02 \x01(\x04R\x05keyId\"E\n" +
+	"\x13RevokeAPIKeyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x15\n" +
+	"\x06key_id\x18\x02 \x01(\x04R\x05keyId\")\n" +
+	"\x15ValidateAPIKeyRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\xbf\x01\n" +
+	"\x16ValidateAPIKeyResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x15\n" +
+	"\x06key_id\x18\x02 \x01(\x04R\x05keyId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x04R\x06userId\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x16\n" +
+	"\x06scopes\x18\x05 \x03(\tR\x06scopes\x121\n" +
+	"\x15rate_limit_per_minute\x18\x06 \x01(\x05R\x12rateLimitPerMinute\"7\n" +
+	"\x1cSendEmailVerificationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"y\n" +
+	"\x12VerifyEmailRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\tR\x04hash\x12\x18\n" +
+	"\aexpires\x18\x03 \x01(\x03R\aexpires\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\tR\tsignature\"c\n" +
+	"\x13VerifyEmailResponse\x12)\n" +
+	"\x10already_verified\x18\x01 \x01(\bR\x0falreadyVerified\x12!\n" +
+	"\fredirect_url\x18\x02 \x01(\tR\vredirectUrl\"-\n" +
+	"\x13TokenScopesResponse\x12\x16\n" +
+	"\x06scopes\x18\x01 \x03(\tR\x06scopes\"\xb1\x01\n" +
+	"\x13PersonalAccessToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12 \n" +
+	"\flast_used_at\x18\x04 \x01(\tR\n" +
+	"lastUsedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"\x86\x01\n" +
+	" CreatePersonalAccessTokenRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tR\texpiresAt\"h\n" +
+	"!PersonalAccessTokenSecretResponse\x12-\n" +
+	"\x04data\x18\x01 \x01(\v2\x19.auth.PersonalAccessTokenR\x04data\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\":\n" +
+	"\x1fListPersonalAccessTokensRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"Q\n" +
+	" ListPersonalAccessTokensResponse\x12-\n" +
+	"\x04data\x18\x01 \x03(\v2\x19.auth.PersonalAccessTokenR\x04data\"V\n" +
+	" RevokePersonalAccessTokenRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\x04R\atokenId\"\xb7\x01\n" +
+	"\n" +
+	"LoginAlert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\x04R\aeventId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\x12\x16\n" +
+	"\x06device\x18\x05 \x01(\tR\x06device\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x12\n" +
+	"\x04date\x18\a \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\b \x01(\tR\x04time\"T\n" +
+	"\x16ListLoginAlertsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12!\n" +
+	"\fpending_only\x18\x02 \x01(\bR\vpendingOnly\"?\n" +
+	"\x17ListLoginAlertsResponse\x12$\n" +
+	"\x04data\x18\x01 \x03(\v2\x10.auth.LoginAlertR\x04data\"e\n" +
+	"\x18ConfirmLoginAlertRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x19\n" +
+	"\balert_id\x18\x02 \x01(\x04R\aalertId\x12\x15\n" +
+	"\x06was_me\x18\x03 \x01(\bR\x05wasMe\":\n" +
+	"\x12LoginAlertResponse\x12$\n" +
+	"\x04data\x18\x01 \x01(\v2\x10.auth.LoginAlertR\x04data\"`\n" +
+	"\x16GetLoginHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x03 \x01(\x05R\aperPage\"^\n" +
+	"\rLoginLocation\x12!\n" +
+	"\fcountry_code\x18\x01 \x01(\tR\vcountryCode\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\"\xf5\x01\n" +
+	"\x11LoginHistoryEntry\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x12\x0e\n" +
+	"\x02ip\x18\x02 \x01(\tR\x02ip\x12\x16\n" +
+	"\x06device\x18\x03 \x01(\tR\x06device\x12/\n" +
+	"\blocation\x18\x04 \x01(\v2\x13.auth.LoginLocationR\blocation\x12\x12\n" +
+	"\x04date\x18\x05 \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\x06 \x01(\tR\x04time\x12!\n" +
+	"\falert_reason\x18\a \x01(\tR\valertReason\x12!\n" +
+	"\falert_status\x18\b \x01(\tR\valertStatus\"|\n" +
+	"\x17GetLoginHistoryResponse\x12+\n" +
+	"\x04data\x18\x01 \x03(\v2\x17.auth.LoginHistoryEntryR\x04data\x124\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x14.auth.PaginationMetaR\n" +
+	"pagination\"\x9c\x01\n" +
+	"\x17RequestMagicLinkRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x19\n" +
+	"\bback_url\x18\x02 \x01(\tR\abackUrl\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\x12!\n" +
+	"\fdevice_nonce\x18\x05 \x01(\tR\vdeviceNonce\"\xb9\x01\n" +
+	"\x17ConsumeMagicLinkRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x18\n" +
+	"\aexpires\x18\x02 \x01(\x03R\aexpires\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x05 \x01(\tR\tuserAgent\x12!\n" +
+	"\fdevice_nonce\x18\x06 \x01(\tR\vdeviceNonce2\x9c\x04\n" +
 	"\vAuthService\x129\n" +
 	"\bRegister\x12\x15.auth.RegisterRequest\x1a\x16.auth.RegisterResponse\x129\n" +
 	"\bRedirect\x12\x15.auth.RedirectRequest\x1a\x16.auth.RedirectResponse\x129\n" +
@@ -8121,7 +10789,7 @@ const file_auth_proto_rawDesc = "" +
 	"\x06Logout\x12\x13.auth.LogoutRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
 	"\rValidateToken\x12\x1a.auth.ValidateTokenRequest\x1a\x1b.auth.ValidateTokenResponse\x12U\n" +
 	"\x16RequestAccountSecurity\x12#.auth.RequestAccountSecurityRequest\x1a\x16.google.protobuf.Empty\x12S\n" +
-	"\x15VerifyAccountSecurity\x12\".auth.VerifyAccountSecurityRequest\x1a\x16.google.protobuf.Empty2\x94\x05\n" +
+	"\x15VerifyAccountSecurity\x12\".auth.VerifyAccountSecurityRequest\x1a\x16.google.protobuf.Empty2\x91\x06\n" +
 	"\vUserService\x12+\n" +
 	"\aGetUser\x12\x14.auth.GetUserRequest\x1a\n" +
 	".auth.User\x127\n" +
@@ -8133,7 +10801,9 @@ const file_auth_proto_rawDesc = "" +
 	"\rGetUserWallet\x12\x1a.auth.GetUserWalletRequest\x1a\x18.auth.UserWalletResponse\x12B\n" +
 	"\fGetUserLevel\x12\x19.auth.GetUserLevelRequest\x1a\x17.auth.UserLevelResponse\x12`\n" +
 	"\x15GetProfileLimitations\x12\".auth.GetProfileLimitationsRequest\x1a#.auth.GetProfileLimitationsResponse\x12]\n" +
-	"\x14GetUserFeaturesCount\x12!.auth.GetUserFeaturesCountRequest\x1a\".auth.GetUserFeaturesCountResponse2\x93\x03\n" +
+	"\x14GetUserFeaturesCount\x12!.auth.GetUserFeaturesCountRequest\x1a\".auth.GetUserFeaturesCountResponse\x127\n" +
+	"\vGetUserInfo\x12\x18.auth.GetUserInfoRequest\x1a\x0e.auth.UserInfo\x12B\n" +
+	"\vGetPresence\x12\x18.auth.GetPresenceRequest\x1a\x19.auth.GetPresenceResponse2\x93\x03\n" +
 	"\x18ProfileLimitationService\x12`\n" +
 	"\x17CreateProfileLimitation\x12$.auth.CreateProfileLimitationRequest\x1a\x1f.auth.ProfileLimitationResponse\x12`\n" +
 	"\x17UpdateProfileLimitation\x12$.auth.UpdateProfileLimitationRequest\x1a\x1f.auth.ProfileLimitationResponse\x12W\n" +
@@ -8154,10 +10824,11 @@ const file_auth_proto_rawDesc = "" +
 	"\x17GetCitizenReferralChart\x12$.auth.GetCitizenReferralChartRequest\x1a\".auth.CitizenReferralChartResponse2\xb4\x01\n" +
 	"\x13PersonalInfoService\x12N\n" +
 	"\x0fGetPersonalInfo\x12\x1c.auth.GetPersonalInfoRequest\x1a\x1d.auth.GetPersonalInfoResponse\x12M\n" +
-	"\x12UpdatePersonalInfo\x12\x1f.auth.UpdatePersonalInfoRequest\x1a\x16.google.protobuf.Empty2\xda\x02\n" +
+	"\x12UpdatePersonalInfo\x12\x1f.auth.UpdatePersonalInfoRequest\x1a\x16.google.protobuf.Empty2\xaf\x03\n" +
 	"\x13ProfilePhotoService\x12T\n" +
-	"\x11ListProfilePhotos\x12\x1e.auth.ListProfilePhotosRequest\x1a\x1f.auth.ListProfilePhotosResponse\x12Q\n" +
-	"\x12UploadProfilePhoto\x12\x1f.auth.UploadProfilePhotoRequest\x1a\x1a.auth.ProfilePhotoResponse\x12K\n" +
+	"\x11ListProfilePhotos\x12\x1e.auth.ListProfilePhotosRequest\x1a\x1f.auth.ListProfilePhotosResponse\x12V\n" +
+	"\x12UploadProfilePhoto\x12\x1f.auth.UploadProfilePhotoRequest\x1a\x1f.auth.PhotoUploadStatusResponse\x12N\n" +
+	"\x0eGetPhotoStatus\x12\x1b.auth.GetPhotoStatusRequest\x1a\x1f.auth.PhotoUploadStatusResponse\x12K\n" +
 	"\x0fGetProfilePhoto\x12\x1c.auth.GetProfilePhotoRequest\x1a\x1a.auth.ProfilePhotoResponse\x12M\n" +
 	"\x12DeleteProfilePhoto\x12\x1f.auth.DeleteProfilePhotoRequest\x1a\x16.google.protobuf.Empty2\x85\x04\n" +
 	"\x0fSettingsService\x12B\n" +
@@ -8166,17 +10837,40 @@ const file_auth_proto_rawDesc = "" +
 	"\x12GetGeneralSettings\x12\x1f.auth.GetGeneralSettingsRequest\x1a .auth.GetGeneralSettingsResponse\x12`\n" +
 	"\x15UpdateGeneralSettings\x12\".auth.UpdateGeneralSettingsRequest\x1a#.auth.UpdateGeneralSettingsResponse\x12W\n" +
 	"\x12GetPrivacySettings\x12\x1f.auth.GetPrivacySettingsRequest\x1a .auth.GetPrivacySettingsResponse\x12S\n" +
-	"\x15UpdatePrivacySettings\x12\".auth.UpdatePrivacySettingsRequest\x1a\x16.google.protobuf.Empty2\xa0\x03\n" +
+	"\x15UpdatePrivacySettings\x12\".auth.UpdatePrivacySettingsRequest\x1a\x16.google.protobuf.Empty2\xf2\x03\n" +
 	"\x11UserEventsService\x12K\n" +
 	"\x0eListUserEvents\x12\x1b.auth.ListUserEventsRequest\x1a\x1c.auth.ListUserEventsResponse\x12E\n" +
 	"\fGetUserEvent\x12\x19.auth.GetUserEventRequest\x1a\x1a.auth.GetUserEventResponse\x12N\n" +
 	"\x0fReportUserEvent\x12\x1c.auth.ReportUserEventRequest\x1a\x1d.auth.UserEventReportResponse\x12\\\n" +
 	"\x12SendReportResponse\x12\x1f.auth.SendReportResponseRequest\x1a%.auth.UserEventReportResponseResponse\x12I\n" +
-	"\x10CloseEventReport\x12\x1d.auth.CloseEventReportRequest\x1a\x16.google.protobuf.Empty2\xf0\x01\n" +
+	"\x10CloseEventReport\x12\x1d.auth.CloseEventReportRequest\x1a\x16.google.protobuf.Empty\x12P\n" +
+	"\x10ExportUserEvents\x12\x1d.auth.ExportUserEventsRequest\x1a\x1b.auth.UserEventsExportChunk0\x012\xf0\x01\n" +
 	"\rSearchService\x12B\n" +
 	"\vSearchUsers\x12\x18.auth.SearchUsersRequest\x1a\x19.auth.SearchUsersResponse\x12K\n" +
 	"\x0eSearchFeatures\x12\x1b.auth.SearchFeaturesRequest\x1a\x1c.auth.SearchFeaturesResponse\x12N\n" +
-	"\x0fSearchIsicCodes\x12\x1c.auth.SearchIsicCodesRequest\x1a\x1d.auth.SearchIsicCodesResponseB\x18Z\x16metargb/shared/pb/authb\x06proto3"
+	"\x0fSearchIsicCodes\x12\x1c.auth.SearchIsicCodesRequest\x1a\x1d.auth.SearchIsicCodesResponse2\xf1\x02\n" +
+	"\rAPIKeyService\x12E\n" +
+	"\fCreateAPIKey\x12\x19.auth.CreateAPIKeyRequest\x1a\x1a.auth.APIKeySecretResponse\x12B\n" +
+	"\vListAPIKeys\x12\x18.auth.ListAPIKeysRequest\x1a\x19.auth.ListAPIKeysResponse\x12E\n" +
+	"\fRotateAPIKey\x12\x19.auth.RotateAPIKeyRequest\x1a\x1a.auth.APIKeySecretResponse\x12A\n" +
+	"\fRevokeAPIKey\x12\x19.auth.RevokeAPIKeyRequest\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\x0eValidateAPIKey\x12\x1b.auth.ValidateAPIKeyRequest\x1a\x1c.auth.ValidateAPIKeyResponse2\xb3\x01\n" +
+	"\x18EmailVerificationService\x12S\n" +
+	"\x15SendEmailVerification\x12\".auth.SendEmailVerificationRequest\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\vVerifyEmail\x12\x18.auth.VerifyEmailRequest\x1a\x19.auth.VerifyEmailResponse2\x98\x03\n" +
+	"\x1aPersonalAccessTokenService\x12D\n" +
+	"\x0fListTokenScopes\x12\x16.google.protobuf.Empty\x1a\x19.auth.TokenScopesResponse\x12l\n" +
+	"\x19CreatePersonalAccessToken\x12&.auth.CreatePersonalAccessTokenRequest\x1a'.auth.PersonalAccessTokenSecretResponse\x12i\n" +
+	"\x18ListPersonalAccessTokens\x12%.auth.ListPersonalAccessTokensRequest\x1a&.auth.ListPersonalAccessTokensResponse\x12[\n" +
+	"\x19RevokePersonalAccessToken\x12&.auth.RevokePersonalAccessTokenRequest\x1a\x16.google.protobuf.Empty2\xb2\x01\n" +
+	"\x11LoginAlertService\x12N\n" +
+	"\x0fListLoginAlerts\x12\x1c.auth.ListLoginAlertsRequest\x1a\x1d.auth.ListLoginAlertsResponse\x12M\n" +
+	"\x11ConfirmLoginAlert\x12\x1e.auth.ConfirmLoginAlertRequest\x1a\x18.auth.LoginAlertResponse2e\n" +
+	"\x13LoginHistoryService\x12N\n" +
+	"\x0fGetLoginHistory\x12\x1c.auth.GetLoginHistoryRequest\x1a\x1d.auth.GetLoginHistoryResponse2\xa8\x01\n" +
+	"\x10MagicLinkService\x12I\n" +
+	"\x10RequestMagicLink\x12\x1d.auth.RequestMagicLinkRequest\x1a\x16.google.protobuf.Empty\x12I\n" +
+	"\x10ConsumeMagicLink\x12\x1d.auth.ConsumeMagicLinkRequest\x1a\x16.auth.CallbackResponseB\x18Z\x16metargb/shared/pb/authb\x06proto3"
 
 var (
 	file_auth_proto_rawDescOnce sync.Once
@@ -8190,306 +10884,398 @@ func file_auth_proto_rawDescGZIP() []byte {
 	return file_auth_proto_rawDescData
 }
 
-var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 124)
+var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 163)
 var file_auth_proto_goTypes = []any{
-	(*User)(nil),                            // 0: auth.User
-	(*KYC)(nil),                             // 1: auth.KYC
-	(*Settings)(nil),                        // 2: auth.Settings
-	(*Image)(nil),                           // 3: auth.Image
-	(*Notification)(nil),                    // 4: auth.Notification
-	(*Level)(nil),                           // 5: auth.Level
-	(*RegisterRequest)(nil),                 // 6: auth.RegisterRequest
-	(*RegisterResponse)(nil),                // 7: auth.RegisterResponse
-	(*RedirectRequest)(nil),                 // 8: auth.RedirectRequest
-	(*RedirectResponse)(nil),                // 9: auth.RedirectResponse
-	(*CallbackRequest)(nil),                 // 10: auth.CallbackRequest
-	(*CallbackResponse)(nil),                // 11: auth.CallbackResponse
-	(*GetMeRequest)(nil),                    // 12: auth.GetMeRequest
-	(*UserResponse)(nil),                    // 13: auth.UserResponse
-	(*LogoutRequest)(nil),                   // 14: auth.LogoutRequest
-	(*ValidateTokenRequest)(nil),            // 15: auth.ValidateTokenRequest
-	(*ValidateTokenResponse)(nil),           // 16: auth.ValidateTokenResponse
-	(*RequestAccountSecurityRequest)(nil),   // 17: auth.RequestAccountSecurityRequest
-	(*VerifyAccountSecurityRequest)(nil),    // 18: auth.VerifyAccountSecurityRequest
-	(*GetUserRequest)(nil),                  // 19: auth.GetUserRequest
-	(*UpdateProfileRequest)(nil),            // 20: auth.UpdateProfileRequest
-	(*GetUserWalletRequest)(nil),            // 21: auth.GetUserWalletRequest
-	(*UserWalletResponse)(nil),              // 22: auth.UserWalletResponse
-	(*GetUserLevelRequest)(nil),             // 23: auth.GetUserLevelRequest
-	(*UserLevelResponse)(nil),               // 24: auth.UserLevelResponse
-	(*GetKYCRequest)(nil),                   // 25: auth.GetKYCRequest
-	(*UpdateKYCRequest)(nil),                // 26: auth.UpdateKYCRequest
-	(*VideoInfo)(nil),                       // 27: auth.VideoInfo
-	(*KYCResponse)(nil),                     // 28: auth.KYCResponse
-	(*ListBankAccountsRequest)(nil),         // 29: auth.ListBankAccountsRequest
-	(*ListBankAccountsResponse)(nil),        // 30: auth.ListBankAccountsResponse
-	(*CreateBankAccountRequest)(nil),        // 31: auth.CreateBankAccountRequest
-	(*GetBankAccountRequest)(nil),           // 32: auth.GetBankAccountRequest
-	(*UpdateBankAccountRequest)(nil),        // 33: auth.UpdateBankAccountRequest
-	(*DeleteBankAccountRequest)(nil),        // 34: auth.DeleteBankAccountRequest
-	(*BankAccountResponse)(nil),             // 35: auth.BankAccountResponse
-	(*GetCitizenProfileRequest)(nil),        // 36: auth.GetCitizenProfileRequest
-	(*CitizenProfileResponse)(nil),          // 37: auth.CitizenProfileResponse
-	(*ProfilePhoto)(nil),                    // 38: auth.ProfilePhoto
-	(*CitizenKYC)(nil),                      // 39: auth.CitizenKYC
-	(*CitizenCustoms)(nil),                  // 40: auth.CitizenCustoms
-	(*CitizenLevel)(nil),                    // 41: auth.CitizenLevel
-	(*GetCitizenReferralsRequest)(nil),      // 42: auth.GetCitizenReferralsRequest
-	(*CitizenReferralsResponse)(nil),        // 43: auth.CitizenReferralsResponse
-	(*CitizenReferral)(nil),                 // 44: auth.CitizenReferral
-	(*ReferrerOrder)(nil),                   // 45: auth.ReferrerOrder
-	(*PaginationMeta)(nil),                  // 46: auth.PaginationMeta
-	(*GetCitizenReferralChartRequest)(nil),  // 47: auth.GetCitizenReferralChartRequest
-	(*CitizenReferralChartResponse)(nil),    // 48: auth.CitizenReferralChartResponse
-	(*ReferralChartData)(nil),               // 49: auth.ReferralChartData
-	(*ChartDataPoint)(nil),                  // 50: auth.ChartDataPoint
-	(*GetPersonalInfoRequest)(nil),          // 51: auth.GetPersonalInfoRequest
-	(*GetPersonalInfoResponse)(nil),         // 52: auth.GetPersonalInfoResponse
-	(*PersonalInfoData)(nil),                // 53: auth.PersonalInfoData
-	(*UpdatePersonalInfoRequest)(nil),       // 54: auth.UpdatePersonalInfoRequest
-	(*ProfileLimitationOptions)(nil),        // 55: auth.ProfileLimitationOptions
-	(*ProfileLimitation)(nil),               // 56: auth.ProfileLimitation
-	(*CreateProfileLimitationRequest)(nil),  // 57: auth.CreateProfileLimitationRequest
-	(*UpdateProfileLimitationRequest)(nil),  // 58: auth.UpdateProfileLimitationRequest
-	(*DeleteProfileLimitationRequest)(nil),  // 59: auth.DeleteProfileLimitationRequest
-	(*GetProfileLimitationRequest)(nil),     // 60: auth.GetProfileLimitationRequest
-	(*GetProfileLimitationsRequest)(nil),    // 61: auth.GetProfileLimitationsRequest
-	(*ProfileLimitationResponse)(nil),       // 62: auth.ProfileLimitationResponse
-	(*GetProfileLimitationsResponse)(nil),   // 63: auth.GetProfileLimitationsResponse
-	(*ListProfilePhotosRequest)(nil),        // 64: auth.ListProfilePhotosRequest
-	(*ListProfilePhotosResponse)(nil),       // 65: auth.ListProfilePhotosResponse
-	(*UploadProfilePhotoRequest)(nil),       // 66: auth.UploadProfilePhotoRequest
-	(*GetProfilePhotoRequest)(nil),          // 67: auth.GetProfilePhotoRequest
-	(*DeleteProfilePhotoRequest)(nil),       // 68: auth.DeleteProfilePhotoRequest
-	(*ProfilePhotoResponse)(nil),            // 69: auth.ProfilePhotoResponse
-	(*GetSettingsRequest)(nil),              // 70: auth.GetSettingsRequest
-	(*GetSettingsResponse)(nil),             // 71: auth.GetSettingsResponse
-	(*SettingsData)(nil),                    // 72: auth.SettingsData
-	(*UpdateSettingsRequest)(nil),           // 73: auth.UpdateSettingsRequest
-	(*GetGeneralSettingsRequest)(nil),       // 74: auth.GetGeneralSettingsRequest
-	(*GetGeneralSettingsResponse)(nil),      // 75: auth.GetGeneralSettingsResponse
-	(*NotificationSettingsData)(nil),        // 76: auth.NotificationSettingsData
-	(*UpdateGeneralSettingsRequest)(nil),    // 77: auth.UpdateGeneralSettingsRequest
-	(*UpdateGeneralSettingsResponse)(nil),   // 78: auth.UpdateGeneralSettingsResponse
-	(*GetPrivacySettingsRequest)(nil),       // 79: auth.GetPrivacySettingsRequest
-	(*GetPrivacySettingsResponse)(nil),      // 80: auth.GetPrivacySettingsResponse
-	(*UpdatePrivacySettingsRequest)(nil),    // 81: auth.UpdatePrivacySettingsRequest
-	(*ListUserEventsRequest)(nil),           // 82: auth.ListUserEventsRequest
-	(*ListUserEventsResponse)(nil),          // 83: auth.ListUserEventsResponse
-	(*GetUserEventRequest)(nil),             // 84: auth.GetUserEventRequest
-	(*GetUserEventResponse)(nil),            // 85: auth.GetUserEventResponse
-	(*ReportUserEventRequest)(nil),          // 86: auth.ReportUserEventRequest
-	(*SendReportResponseRequest)(nil),       // 87: auth.SendReportResponseRequest
-	(*CloseEventReportRequest)(nil),         // 88: auth.CloseEventReportRequest
-	(*UserEventResource)(nil),               // 89: auth.UserEventResource
-	(*UserEventReportResource)(nil),         // 90: auth.UserEventReportResource
-	(*UserEventReportResponseResource)(nil), // 91: auth.UserEventReportResponseResource
-	(*UserEventReportResponse)(nil),         // 92: auth.UserEventReportResponse
-	(*UserEventReportResponseResponse)(nil), // 93: auth.UserEventReportResponseResponse
-	(*ListUsersRequest)(nil),                // 94: auth.ListUsersRequest
-	(*ListUsersResponse)(nil),               // 95: auth.ListUsersResponse
-	(*UserListItem)(nil),                    // 96: auth.UserListItem
-	(*UserLevelInfo)(nil),                   // 97: auth.UserLevelInfo
-	(*PaginationLinks)(nil),                 // 98: auth.PaginationLinks
-	(*GetUserLevelsRequest)(nil),            // 99: auth.GetUserLevelsRequest
-	(*GetUserLevelsResponse)(nil),           // 100: auth.GetUserLevelsResponse
-	(*UserLevelData)(nil),                   // 101: auth.UserLevelData
-	(*GetUserProfileRequest)(nil),           // 102: auth.GetUserProfileRequest
-	(*GetUserProfileResponse)(nil),          // 103: auth.GetUserProfileResponse
-	(*UserProfileData)(nil),                 // 104: auth.UserProfileData
-	(*GetUserFeaturesCountRequest)(nil),     // 105: auth.GetUserFeaturesCountRequest
-	(*GetUserFeaturesCountResponse)(nil),    // 106: auth.GetUserFeaturesCountResponse
-	(*UserFeaturesCountData)(nil),           // 107: auth.UserFeaturesCountData
-	(*SearchUsersRequest)(nil),              // 108: auth.SearchUsersRequest
-	(*SearchUsersResponse)(nil),             // 109: auth.SearchUsersResponse
-	(*SearchUserResult)(nil),                // 110: auth.SearchUserResult
-	(*SearchFeaturesRequest)(nil),           // 111: auth.SearchFeaturesRequest
-	(*SearchFeaturesResponse)(nil),          // 112: auth.SearchFeaturesResponse
-	(*SearchFeatureResult)(nil),             // 113: auth.SearchFeatureResult
-	(*Coordinate)(nil),                      // 114: auth.Coordinate
-	(*SearchIsicCodesRequest)(nil),          // 115: auth.SearchIsicCodesRequest
-	(*SearchIsicCodesResponse)(nil),         // 116: auth.SearchIsicCodesResponse
-	(*IsicCodeResult)(nil),                  // 117: auth.IsicCodeResult
-	nil,                                     // 118: auth.Settings.PrivacyEntry
-	nil,                                     // 119: auth.Settings.NotificationsEntry
-	nil,                                     // 120: auth.CitizenCustoms.PassionsEntry
-	nil,                                     // 121: auth.PersonalInfoData.PassionsEntry
-	nil,                                     // 122: auth.UpdatePersonalInfoRequest.PassionsEntry
-	nil,                                     // 123: auth.GetPrivacySettingsResponse.DataEntry
-	(*timestamppb.Timestamp)(nil),           // 124: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                   // 125: google.protobuf.Empty
+	(*User)(nil),                              // 0: auth.User
+	(*KYC)(nil),                               // 1: auth.KYC
+	(*Settings)(nil),                          // 2: auth.Settings
+	(*Image)(nil),                             // 3: auth.Image
+	(*Notification)(nil),                      // 4: auth.Notification
+	(*Level)(nil),                             // 5: auth.Level
+	(*RegisterRequest)(nil),                   // 6: auth.RegisterRequest
+	(*RegisterResponse)(nil),                  // 7: auth.RegisterResponse
+	(*RedirectRequest)(nil),                   // 8: auth.RedirectRequest
+	(*RedirectResponse)(nil),                  // 9: auth.RedirectResponse
+	(*CallbackRequest)(nil),                   // 10: auth.CallbackRequest
+	(*CallbackResponse)(nil),                  // 11: auth.CallbackResponse
+	(*GetMeRequest)(nil),                      // 12: auth.GetMeRequest
+	(*UserResponse)(nil),                      // 13: auth.UserResponse
+	(*LogoutRequest)(nil),                     // 14: auth.LogoutRequest
+	(*ValidateTokenRequest)(nil),              // 15: auth.ValidateTokenRequest
+	(*ValidateTokenResponse)(nil),             // 16: auth.ValidateTokenResponse
+	(*RequestAccountSecurityRequest)(nil),     // 17: auth.RequestAccountSecurityRequest
+	(*VerifyAccountSecurityRequest)(nil),      // 18: auth.VerifyAccountSecurityRequest
+	(*GetUserRequest)(nil),                    // 19: auth.GetUserRequest
+	(*GetUserInfoRequest)(nil),                // 20: auth.GetUserInfoRequest
+	(*UserInfo)(nil),                          // 21: auth.UserInfo
+	(*GetPresenceRequest)(nil),                // 22: auth.GetPresenceRequest
+	(*GetPresenceResponse)(nil),               // 23: auth.GetPresenceResponse
+	(*UserPresence)(nil),                      // 24: auth.UserPresence
+	(*UpdateProfileRequest)(nil),              // 25: auth.UpdateProfileRequest
+	(*GetUserWalletRequest)(nil),              // 26: auth.GetUserWalletRequest
+	(*UserWalletResponse)(nil),                // 27: auth.UserWalletResponse
+	(*GetUserLevelRequest)(nil),               // 28: auth.GetUserLevelRequest
+	(*UserLevelResponse)(nil),                 // 29: auth.UserLevelResponse
+	(*GetKYCRequest)(nil),                     // 30: auth.GetKYCRequest
+	(*UpdateKYCRequest)(nil),                  // 31: auth.UpdateKYCRequest
+	(*VideoInfo)(nil),                         // 32: auth.VideoInfo
+	(*KYCResponse)(nil),                       // 33: auth.KYCResponse
+	(*ListBankAccountsRequest)(nil),           // 34: auth.ListBankAccountsRequest
+	(*ListBankAccountsResponse)(nil),          // 35: auth.ListBankAccountsResponse
+	(*CreateBankAccountRequest)(nil),          // 36: auth.CreateBankAccountRequest
+	(*GetBankAccountRequest)(nil),             // 37: auth.GetBankAccountRequest
+	(*UpdateBankAccountRequest)(nil),          // 38: auth.UpdateBankAccountRequest
+	(*DeleteBankAccountRequest)(nil),          // 39: auth.DeleteBankAccountRequest
+	(*BankAccountResponse)(nil),               // 40: auth.BankAccountResponse
+	(*GetCitizenProfileRequest)(nil),          // 41: auth.GetCitizenProfileRequest
+	(*CitizenProfileResponse)(nil),            // 42: auth.CitizenProfileResponse
+	(*ProfilePhoto)(nil),                      // 43: auth.ProfilePhoto
+	(*CitizenKYC)(nil),                        // 44: auth.CitizenKYC
+	(*CitizenCustoms)(nil),                    // 45: auth.CitizenCustoms
+	(*CitizenLevel)(nil),                      // 46: auth.CitizenLevel
+	(*GetCitizenReferralsRequest)(nil),        // 47: auth.GetCitizenReferralsRequest
+	(*CitizenReferralsResponse)(nil),          // 48: auth.CitizenReferralsResponse
+	(*CitizenReferral)(nil),                   // 49: auth.CitizenReferral
+	(*ReferrerOrder)(nil),                     // 50: auth.ReferrerOrder
+	(*PaginationMeta)(nil),                    // 51: auth.PaginationMeta
+	(*GetCitizenReferralChartRequest)(nil),    // 52: auth.GetCitizenReferralChartRequest
+	(*CitizenReferralChartResponse)(nil),      // 53: auth.CitizenReferralChartResponse
+	(*ReferralChartData)(nil),                 // 54: auth.ReferralChartData
+	(*ChartDataPoint)(nil),                    // 55: auth.ChartDataPoint
+	(*GetPersonalInfoRequest)(nil),            // 56: auth.GetPersonalInfoRequest
+	(*GetPersonalInfoResponse)(nil),           // 57: auth.GetPersonalInfoResponse
+	(*PersonalInfoData)(nil),                  // 58: auth.PersonalInfoData
+	(*UpdatePersonalInfoRequest)(nil),         // 59: auth.UpdatePersonalInfoRequest
+	(*ProfileLimitationOptions)(nil),          // 60: auth.ProfileLimitationOptions
+	(*ProfileLimitation)(nil),                 // 61: auth.ProfileLimitation
+	(*CreateProfileLimitationRequest)(nil),    // 62: auth.CreateProfileLimitationRequest
+	(*UpdateProfileLimitationRequest)(nil),    // 63: auth.UpdateProfileLimitationRequest
+	(*DeleteProfileLimitationRequest)(nil),    // 64: auth.DeleteProfileLimitationRequest
+	(*GetProfileLimitationRequest)(nil),       // 65: auth.GetProfileLimitationRequest
+	(*GetProfileLimitationsRequest)(nil),      // 66: auth.GetProfileLimitationsRequest
+	(*ProfileLimitationResponse)(nil),         // 67: auth.ProfileLimitationResponse
+	(*GetProfileLimitationsResponse)(nil),     // 68: auth.GetProfileLimitationsResponse
+	(*ListProfilePhotosRequest)(nil),          // 69: auth.ListProfilePhotosRequest
+	(*ListProfilePhotosResponse)(nil),         // 70: auth.ListProfilePhotosResponse
+	(*UploadProfilePhotoRequest)(nil),         // 71: auth.UploadProfilePhotoRequest
+	(*GetProfilePhotoRequest)(nil),            // 72: auth.GetProfilePhotoRequest
+	(*DeleteProfilePhotoRequest)(nil),         // 73: auth.DeleteProfilePhotoRequest
+	(*ProfilePhotoResponse)(nil),              // 74: auth.ProfilePhotoResponse
+	(*GetPhotoStatusRequest)(nil),             // 75: auth.GetPhotoStatusRequest
+	(*PhotoUploadStatusResponse)(nil),         // 76: auth.PhotoUploadStatusResponse
+	(*GetSettingsRequest)(nil),                // 77: auth.GetSettingsRequest
+	(*GetSettingsResponse)(nil),               // 78: auth.GetSettingsResponse
+	(*SettingsData)(nil),                      // 79: auth.SettingsData
+	(*UpdateSettingsRequest)(nil),             // 80: auth.UpdateSettingsRequest
+	(*GetGeneralSettingsRequest)(nil),         // 81: auth.GetGeneralSettingsRequest
+	(*GetGeneralSettingsResponse)(nil),        // 82: auth.GetGeneralSettingsResponse
+	(*NotificationSettingsData)(nil),          // 83: auth.NotificationSettingsData
+	(*UpdateGeneralSettingsRequest)(nil),      // 84: auth.UpdateGeneralSettingsRequest
+	(*UpdateGeneralSettingsResponse)(nil),     // 85: auth.UpdateGeneralSettingsResponse
+	(*GetPrivacySettingsRequest)(nil),         // 86: auth.GetPrivacySettingsRequest
+	(*GetPrivacySettingsResponse)(nil),        // 87: auth.GetPrivacySettingsResponse
+	(*UpdatePrivacySettingsRequest)(nil),      // 88: auth.UpdatePrivacySettingsRequest
+	(*ListUserEventsRequest)(nil),             // 89: auth.ListUserEventsRequest
+	(*ListUserEventsResponse)(nil),            // 90: auth.ListUserEventsResponse
+	(*GetUserEventRequest)(nil),               // 91: auth.GetUserEventRequest
+	(*GetUserEventResponse)(nil),              // 92: auth.GetUserEventResponse
+	(*ReportUserEventRequest)(nil),            // 93: auth.ReportUserEventRequest
+	(*SendReportResponseRequest)(nil),         // 94: auth.SendReportResponseRequest
+	(*CloseEventReportRequest)(nil),           // 95: auth.CloseEventReportRequest
+	(*ExportUserEventsRequest)(nil),           // 96: auth.ExportUserEventsRequest
+	(*UserEventsExportChunk)(nil),             // 97: auth.UserEventsExportChunk
+	(*UserEventResource)(nil),                 // 98: auth.UserEventResource
+	(*UserEventReportResource)(nil),           // 99: auth.UserEventReportResource
+	(*UserEventReportResponseResource)(nil),   // 100: auth.UserEventReportResponseResource
+	(*UserEventReportResponse)(nil),           // 101: auth.UserEventReportResponse
+	(*UserEventReportResponseResponse)(nil),   // 102: auth.UserEventReportResponseResponse
+	(*ListUsersRequest)(nil),                  // 103: auth.ListUsersRequest
+	(*ListUsersResponse)(nil),                 // 104: auth.ListUsersResponse
+	(*UserListItem)(nil),                      // 105: auth.UserListItem
+	(*UserLevelInfo)(nil),                     // 106: auth.UserLevelInfo
+	(*PaginationLinks)(nil),                   // 107: auth.PaginationLinks
+	(*GetUserLevelsRequest)(nil),              // 108: auth.GetUserLevelsRequest
+	(*GetUserLevelsResponse)(nil),             // 109: auth.GetUserLevelsResponse
+	(*UserLevelData)(nil),                     // 110: auth.UserLevelData
+	(*GetUserProfileRequest)(nil),             // 111: auth.GetUserProfileRequest
+	(*GetUserProfileResponse)(nil),            // 112: auth.GetUserProfileResponse
+	(*UserProfileData)(nil),                   // 113: auth.UserProfileData
+	(*GetUserFeaturesCountRequest)(nil),       // 114: auth.GetUserFeaturesCountRequest
+	(*GetUserFeaturesCountResponse)(nil),      // 115: auth.GetUserFeaturesCountResponse
+	(*UserFeaturesCountData)(nil),             // 116: auth.UserFeaturesCountData
+	(*SearchUsersRequest)(nil),                // 117: auth.SearchUsersRequest
+	(*SearchUsersResponse)(nil),               // 118: auth.SearchUsersResponse
+	(*SearchUserResult)(nil),                  // 119: auth.SearchUserResult
+	(*SearchFeaturesRequest)(nil),             // 120: auth.SearchFeaturesRequest
+	(*SearchFeaturesResponse)(nil),            // 121: auth.SearchFeaturesResponse
+	(*SearchFeatureResult)(nil),               // 122: auth.SearchFeatureResult
+	(*Coordinate)(nil),                        // 123: auth.Coordinate
+	(*SearchIsicCodesRequest)(nil),            // 124: auth.SearchIsicCodesRequest
+	(*SearchIsicCodesResponse)(nil),           // 125: auth.SearchIsicCodesResponse
+	(*IsicCodeResult)(nil),                    // 126: auth.IsicCodeResult
+	(*APIKey)(nil),                            // 127: auth.APIKey
+	(*CreateAPIKeyRequest)(nil),               // 128: auth.CreateAPIKeyRequest
+	(*APIKeySecretResponse)(nil),              // 129: auth.APIKeySecretResponse
+	(*ListAPIKeysRequest)(nil),                // 130: auth.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),               // 131: auth.ListAPIKeysResponse
+	(*RotateAPIKeyRequest)(nil),               // 132: auth.RotateAPIKeyRequest
+	(*RevokeAPIKeyRequest)(nil),               // 133: auth.RevokeAPIKeyRequest
+	(*ValidateAPIKeyRequest)(nil),             // 134: auth.ValidateAPIKeyRequest
+	(*ValidateAPIKeyResponse)(nil),            // 135: auth.ValidateAPIKeyResponse
+	(*SendEmailVerificationRequest)(nil),      // 136: auth.SendEmailVerificationRequest
+	(*VerifyEmailRequest)(nil),                // 137: auth.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),               // 138: auth.VerifyEmailResponse
+	(*TokenScopesResponse)(nil),               // 139: auth.TokenScopesResponse
+	(*PersonalAccessToken)(nil),               // 140: auth.PersonalAccessToken
+	(*CreatePersonalAccessTokenRequest)(nil),  // 141: auth.CreatePersonalAccessTokenRequest
+	(*PersonalAccessTokenSecretResponse)(nil), // 142: auth.PersonalAccessTokenSecretResponse
+	(*ListPersonalAccessTokensRequest)(nil),   // 143: auth.ListPersonalAccessTokensRequest
+	(*ListPersonalAccessTokensResponse)(nil),  // 144: auth.ListPersonalAccessTokensResponse
+	(*RevokePersonalAccessTokenRequest)(nil),  // 145: auth.RevokePersonalAccessTokenRequest
+	(*LoginAlert)(nil),                        // 146: auth.LoginAlert
+	(*ListLoginAlertsRequest)(nil),            // 147: auth.ListLoginAlertsRequest
+	(*ListLoginAlertsResponse)(nil),           // 148: auth.ListLoginAlertsResponse
+	(*ConfirmLoginAlertRequest)(nil),          // 149: auth.ConfirmLoginAlertRequest
+	(*LoginAlertResponse)(nil),                // 150: auth.LoginAlertResponse
+	(*GetLoginHistoryRequest)(nil),            // 151: auth.GetLoginHistoryRequest
+	(*LoginLocation)(nil),                     // 152: auth.LoginLocation
+	(*LoginHistoryEntry)(nil),                 // 153: auth.LoginHistoryEntry
+	(*GetLoginHistoryResponse)(nil),           // 154: auth.GetLoginHistoryResponse
+	(*RequestMagicLinkRequest)(nil),           // 155: auth.RequestMagicLinkRequest
+	(*ConsumeMagicLinkRequest)(nil),           // 156: auth.ConsumeMagicLinkRequest
+	nil,                                       // 157: auth.Settings.PrivacyEntry
+	nil,                                       // 158: auth.Settings.NotificationsEntry
+	nil,                                       // 159: auth.CitizenCustoms.PassionsEntry
+	nil,                                       // 160: auth.PersonalInfoData.PassionsEntry
+	nil,                                       // 161: auth.UpdatePersonalInfoRequest.PassionsEntry
+	nil,                                       // 162: auth.GetPrivacySettingsResponse.DataEntry
+	(*timestamppb.Timestamp)(nil),             // 163: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                     // 164: google.protobuf.Empty
 }
 var file_auth_proto_depIdxs = []int32{
-	124, // 0: auth.User.last_seen:type_name -> google.protobuf.Timestamp
-	124, // 1: auth.User.created_at:type_name -> google.protobuf.Timestamp
-	124, // 2: auth.User.email_verified_at:type_name -> google.protobuf.Timestamp
-	124, // 3: auth.User.phone_verified_at:type_name -> google.protobuf.Timestamp
-	124, // 4: auth.KYC.created_at:type_name -> google.protobuf.Timestamp
-	124, // 5: auth.KYC.updated_at:type_name -> google.protobuf.Timestamp
-	118, // 6: auth.Settings.privacy:type_name -> auth.Settings.PrivacyEntry
-	119, // 7: auth.Settings.notifications:type_name -> auth.Settings.NotificationsEntry
-	124, // 8: auth.Notification.created_at:type_name -> google.protobuf.Timestamp
-	124, // 9: auth.Notification.read_at:type_name -> google.protobuf.Timestamp
+	163, // 0: auth.User.last_seen:type_name -> google.protobuf.Timestamp
+	163, // 1: auth.User.created_at:type_name -> google.protobuf.Timestamp
+	163, // 2: auth.User.email_verified_at:type_name -> google.protobuf.Timestamp
+	163, // 3: auth.User.phone_verified_at:type_name -> google.protobuf.Timestamp
+	163, // 4: auth.KYC.created_at:type_name -> google.protobuf.Timestamp
+	163, // 5: auth.KYC.updated_at:type_name -> google.protobuf.Timestamp
+	157, // 6: auth.Settings.privacy:type_name -> auth.Settings.PrivacyEntry
+	158, // 7: auth.Settings.notifications:type_name -> auth.Settings.NotificationsEntry
+	163, // 8: auth.Notification.created_at:type_name -> google.protobuf.Timestamp
+	163, // 9: auth.Notification.read_at:type_name -> google.protobuf.Timestamp
 	5,   // 10: auth.UserResponse.level:type_name -> auth.Level
-	5,   // 11: auth.UserLevelResponse.level:type_name -> auth.Level
-	27,  // 12: auth.UpdateKYCRequest.video:type_name -> auth.VideoInfo
-	35,  // 13: auth.ListBankAccountsResponse.data:type_name -> auth.BankAccountResponse
-	38,  // 14: auth.CitizenProfileResponse.profile_photos:type_name -> auth.ProfilePhoto
-	39,  // 15: auth.CitizenProfileResponse.kyc:type_name -> auth.CitizenKYC
-	40,  // 16: auth.CitizenProfileResponse.customs:type_name -> auth.CitizenCustoms
-	41,  // 17: auth.CitizenProfileResponse.current_level:type_name -> auth.CitizenLevel
-	41,  // 18: auth.CitizenProfileResponse.achieved_levels:type_name -> auth.CitizenLevel
-	120, // 19: auth.CitizenCustoms.passions:type_name -> auth.CitizenCustoms.PassionsEntry
-	44,  // 20: auth.CitizenReferralsResponse.data:type_name -> auth.CitizenReferral
-	46,  // 21: auth.CitizenReferralsResponse.meta:type_name -> auth.PaginationMeta
-	45,  // 22: auth.CitizenReferral.referrer_orders:type_name -> auth.ReferrerOrder
-	49,  // 23: auth.CitizenReferralChartResponse.data:type_name -> auth.ReferralChartData
-	50,  // 24: auth.ReferralChartData.chart_data:type_name -> auth.ChartDataPoint
-	53,  // 25: auth.GetPersonalInfoResponse.data:type_name -> auth.PersonalInfoData
-	121, // 26: auth.PersonalInfoData.passions:type_name -> auth.PersonalInfoData.PassionsEntry
-	122, // 27: auth.UpdatePersonalInfoRequest.passions:type_name -> auth.UpdatePersonalInfoRequest.PassionsEntry
-	55,  // 28: auth.ProfileLimitation.options:type_name -> auth.ProfileLimitationOptions
-	124, // 29: auth.ProfileLimitation.created_at:type_name -> google.protobuf.Timestamp
-	124, // 30: auth.ProfileLimitation.updated_at:type_name -> google.protobuf.Timestamp
-	55,  // 31: auth.CreateProfileLimitationRequest.options:type_name -> auth.ProfileLimitationOptions
-	55,  // 32: auth.UpdateProfileLimitationRequest.options:type_name -> auth.ProfileLimitationOptions
-	56,  // 33: auth.ProfileLimitationResponse.data:type_name -> auth.ProfileLimitation
-	56,  // 34: auth.GetProfileLimitationsResponse.data:type_name -> auth.ProfileLimitation
-	38,  // 35: auth.ListProfilePhotosResponse.data:type_name -> auth.ProfilePhoto
-	72,  // 36: auth.GetSettingsResponse.data:type_name -> auth.SettingsData
-	76,  // 37: auth.GetGeneralSettingsResponse.data:type_name -> auth.NotificationSettingsData
-	76,  // 38: auth.UpdateGeneralSettingsRequest.notifications:type_name -> auth.NotificationSettingsData
-	76,  // 39: auth.UpdateGeneralSettingsResponse.data:type_name -> auth.NotificationSettingsData
-	123, // 40: auth.GetPrivacySettingsResponse.data:type_name -> auth.GetPrivacySettingsResponse.DataEntry
-	89,  // 41: auth.ListUserEventsResponse.data:type_name -> auth.UserEventResource
-	46,  // 42: auth.ListUserEventsResponse.pagination:type_name -> auth.PaginationMeta
-	89,  // 43: auth.GetUserEventResponse.data:type_name -> auth.UserEventResource
-	90,  // 44: auth.UserEventResource.report:type_name -> auth.UserEventReportResource
-	91,  // 45: auth.UserEventReportResource.responses:type_name -> auth.UserEventReportResponseResource
-	90,  // 46: auth.UserEventReportResponse.data:type_name -> auth.UserEventReportResource
-	91,  // 47: auth.UserEventReportResponseResponse.data:type_name -> auth.UserEventReportResponseResource
-	96,  // 48: auth.ListUsersResponse.data:type_name -> auth.UserListItem
-	98,  // 49: auth.ListUsersResponse.links:type_name -> auth.PaginationLinks
-	46,  // 50: auth.ListUsersResponse.meta:type_name -> auth.PaginationMeta
-	97,  // 51: auth.UserListItem.levels:type_name -> auth.UserLevelInfo
-	5,   // 52: auth.UserLevelInfo.current:type_name -> auth.Level
-	5,   // 53: auth.UserLevelInfo.previous:type_name -> auth.Level
-	101, // 54: auth.GetUserLevelsResponse.data:type_name -> auth.UserLevelData
-	5,   // 55: auth.UserLevelData.latest_level:type_name -> auth.Level
-	5,   // 56: auth.UserLevelData.previous_levels:type_name -> auth.Level
-	104, // 57: auth.GetUserProfileResponse.data:type_name -> auth.UserProfileData
-	107, // 58: auth.GetUserFeaturesCountResponse.data:type_name -> auth.UserFeaturesCountData
-	110, // 59: auth.SearchUsersResponse.data:type_name -> auth.SearchUserResult
-	113, // 60: auth.SearchFeaturesResponse.data:type_name -> auth.SearchFeatureResult
-	114, // 61: auth.SearchFeatureResult.coordinates:type_name -> auth.Coordinate
-	117, // 62: auth.SearchIsicCodesResponse.data:type_name -> auth.IsicCodeResult
-	6,   // 63: auth.AuthService.Register:input_type -> auth.RegisterRequest
-	8,   // 64: auth.AuthService.Redirect:input_type -> auth.RedirectRequest
-	10,  // 65: auth.AuthService.Callback:input_type -> auth.CallbackRequest
-	12,  // 66: auth.AuthService.GetMe:input_type -> auth.GetMeRequest
-	14,  // 67: auth.AuthService.Logout:input_type -> auth.LogoutRequest
-	15,  // 68: auth.AuthService.ValidateToken:input_type -> auth.ValidateTokenRequest
-	17,  // 69: auth.AuthService.RequestAccountSecurity:input_type -> auth.RequestAccountSecurityRequest
-	18,  // 70: auth.AuthService.VerifyAccountSecurity:input_type -> auth.VerifyAccountSecurityRequest
-	19,  // 71: auth.UserService.GetUser:input_type -> auth.GetUserRequest
-	20,  // 72: auth.UserService.UpdateProfile:input_type -> auth.UpdateProfileRequest
-	94,  // 73: auth.UserService.ListUsers:input_type -> auth.ListUsersRequest
-	99,  // 74: auth.UserService.GetUserLevels:input_type -> auth.GetUserLevelsRequest
-	102, // 75: auth.UserService.GetUserProfile:input_type -> auth.GetUserProfileRequest
-	21,  // 76: auth.UserService.GetUserWallet:input_type -> auth.GetUserWalletRequest
-	23,  // 77: auth.UserService.GetUserLevel:input_type -> auth.GetUserLevelRequest
-	61,  // 78: auth.UserService.GetProfileLimitations:input_type -> auth.GetProfileLimitationsRequest
-	105, // 79: auth.UserService.GetUserFeaturesCount:input_type -> auth.GetUserFeaturesCountRequest
-	57,  // 80: auth.ProfileLimitationService.CreateProfileLimitation:input_type -> auth.CreateProfileLimitationRequest
-	58,  // 81: auth.ProfileLimitationService.UpdateProfileLimitation:input_type -> auth.UpdateProfileLimitationRequest
-	59,  // 82: auth.ProfileLimitationService.DeleteProfileLimitation:input_type -> auth.DeleteProfileLimitationRequest
-	60,  // 83: auth.ProfileLimitationService.GetProfileLimitation:input_type -> auth.GetProfileLimitationRequest
-	25,  // 84: auth.KYCService.GetKYC:input_type -> auth.GetKYCRequest
-	26,  // 85: auth.KYCService.UpdateKYC:input_type -> auth.UpdateKYCRequest
-	29,  // 86: auth.KYCService.ListBankAccounts:input_type -> auth.ListBankAccountsRequest
-	31,  // 87: auth.KYCService.CreateBankAccount:input_type -> auth.CreateBankAccountRequest
-	32,  // 88: auth.KYCService.GetBankAccount:input_type -> auth.GetBankAccountRequest
-	33,  // 89: auth.KYCService.UpdateBankAccount:input_type -> auth.UpdateBankAccountRequest
-	34,  // 90: auth.KYCService.DeleteBankAccount:input_type -> auth.DeleteBankAccountRequest
-	36,  // 91: auth.CitizenService.GetCitizenProfile:input_type -> auth.GetCitizenProfileRequest
-	42,  // 92: auth.CitizenService.GetCitizenReferrals:input_type -> auth.GetCitizenReferralsRequest
-	47,  // 93: auth.CitizenService.GetCitizenReferralChart:input_type -> auth.GetCitizenReferralChartRequest
-	51,  // 94: auth.PersonalInfoService.GetPersonalInfo:input_type -> auth.GetPersonalInfoRequest
-	54,  // 95: auth.PersonalInfoService.UpdatePersonalInfo:input_type -> auth.UpdatePersonalInfoRequest
-	64,  // 96: auth.ProfilePhotoService.ListProfilePhotos:input_type -> auth.ListProfilePhotosRequest
-	66,  // 97: auth.ProfilePhotoService.UploadProfilePhoto:input_type -> auth.UploadProfilePhotoRequest
-	67,  // 98: auth.ProfilePhotoService.GetProfilePhoto:input_type -> auth.GetProfilePhotoRequest
-	68,  // 99: auth.ProfilePhotoService.DeleteProfilePhoto:input_type -> auth.DeleteProfilePhotoRequest
-	70,  // 100: auth.SettingsService.GetSettings:input_type -> auth.GetSettingsRequest
-	73,  // 101: auth.SettingsService.UpdateSettings:input_type -> auth.UpdateSettingsRequest
-	74,  // 102: auth.SettingsService.GetGeneralSettings:input_type -> auth.GetGeneralSettingsRequest
-	77,  // 103: auth.SettingsService.UpdateGeneralSettings:input_type -> auth.UpdateGeneralSettingsRequest
-	79,  // 104: auth.SettingsService.GetPrivacySettings:input_type -> auth.GetPrivacySettingsRequest
-	81,  // 105: auth.SettingsService.UpdatePrivacySettings:input_type -> auth.UpdatePrivacySettingsRequest
-	82,  // 106: auth.UserEventsService.ListUserEvents:input_type -> auth.ListUserEventsRequest
-	84,  // 107: auth.UserEventsService.GetUserEvent:input_type -> auth.GetUserEventRequest
-	86,  // 108: auth.UserEventsService.ReportUserEvent:input_type -> auth.ReportUserEventRequest
-	87,  // 109: auth.UserEventsService.SendReportResponse:input_type -> auth.SendReportResponseRequest
-	88,  // 110: auth.UserEventsService.CloseEventReport:input_type -> auth.CloseEventReportRequest
-	108, // 111: auth.SearchService.SearchUsers:input_type -> auth.SearchUsersRequest
-	111, // 112: auth.SearchService.SearchFeatures:input_type -> auth.SearchFeaturesRequest
-	115, // 113: auth.SearchService.SearchIsicCodes:input_type -> auth.SearchIsicCodesRequest
-	7,   // 114: auth.AuthService.Register:output_type -> auth.RegisterResponse
-	9,   // 115: auth.AuthService.Redirect:output_type -> auth.RedirectResponse
-	11,  // 116: auth.AuthService.Callback:output_type -> auth.CallbackResponse
-	13,  // 117: auth.AuthService.GetMe:output_type -> auth.UserResponse
-	125, // 118: auth.AuthService.Logout:output_type -> google.protobuf.Empty
-	16,  // 119: auth.AuthService.ValidateToken:output_type -> auth.ValidateTokenResponse
-	125, // 120: auth.AuthService.RequestAccountSecurity:output_type -> google.protobuf.Empty
-	125, // 121: auth.AuthService.VerifyAccountSecurity:output_type -> google.protobuf.Empty
-	0,   // 122: auth.UserService.GetUser:output_type -> auth.User
-	0,   // 123: auth.UserService.UpdateProfile:output_type -> auth.User
-	95,  // 124: auth.UserService.ListUsers:output_type -> auth.ListUsersResponse
-	100, // 125: auth.UserService.GetUserLevels:output_type -> auth.GetUserLevelsResponse
-	103, // 126: auth.UserService.GetUserProfile:output_type -> auth.GetUserProfileResponse
-	22,  // 127: auth.UserService.GetUserWallet:output_type -> auth.UserWalletResponse
-	24,  // 128: auth.UserService.GetUserLevel:output_type -> auth.UserLevelResponse
-	63,  // 129: auth.UserService.GetProfileLimitations:output_type -> auth.GetProfileLimitationsResponse
-	106, // 130: auth.UserService.GetUserFeaturesCount:output_type -> auth.GetUserFeaturesCountResponse
-	62,  // 131: auth.ProfileLimitationService.CreateProfileLimitation:output_type -> auth.ProfileLimitationResponse
-	62,  // 132: auth.ProfileLimitationService.UpdateProfileLimitation:output_type -> auth.ProfileLimitationResponse
-	125, // 133: auth.ProfileLimitationService.DeleteProfileLimitation:output_type -> google.protobuf.Empty
-	62,  // 134: auth.ProfileLimitationService.GetProfileLimitation:output_type -> auth.ProfileLimitationResponse
-	28,  // 135: auth.KYCService.GetKYC:output_type -> auth.KYCResponse
-	28,  // 136: auth.KYCService.UpdateKYC:output_type -> auth.KYCResponse
-	30,  // 137: auth.KYCService.ListBankAccounts:output_type -> auth.ListBankAccountsResponse
-	35,  // 138: auth.KYCService.CreateBankAccount:output_type -> auth.BankAccountResponse
-	35,  // 139: auth.KYCService.GetBankAccount:output_type -> auth.BankAccountResponse
-	35,  // 140: auth.KYCService.UpdateBankAccount:output_type -> auth.BankAccountResponse
-	125, // 141: auth.KYCService.DeleteBankAccount:output_type -> google.protobuf.Empty
-	37,  // 142: auth.CitizenService.GetCitizenProfile:output_type -> auth.CitizenProfileResponse
-	43,  // 143: auth.CitizenService.GetCitizenReferrals:output_type -> auth.CitizenReferralsResponse
-	48,  // 144: auth.CitizenService.GetCitizenReferralChart:output_type -> auth.CitizenReferralChartResponse
-	52,  // 145: auth.PersonalInfoService.GetPersonalInfo:output_type -> auth.GetPersonalInfoResponse
-	125, // 146: auth.PersonalInfoService.UpdatePersonalInfo:output_type -> google.protobuf.Empty
-	65,  // 147: auth.ProfilePhotoService.ListProfilePhotos:output_type -> auth.ListProfilePhotosResponse
-	69,  // 148: auth.ProfilePhotoService.UploadProfilePhoto:output_type -> auth.ProfilePhotoResponse
-	69,  // 149: auth.ProfilePhotoService.GetProfilePhoto:output_type -> auth.ProfilePhotoResponse
-	125, // 150: auth.ProfilePhotoService.DeleteProfilePhoto:output_type -> google.protobuf.Empty
-	71,  // 151: auth.SettingsService.GetSettings:output_type -> auth.GetSettingsResponse
-	125, // 152: auth.SettingsService.UpdateSettings:output_type -> google.protobuf.Empty
-	75,  // 153: auth.SettingsService.GetGeneralSettings:output_type -> auth.GetGeneralSettingsResponse
-	78,  // 154: auth.SettingsService.UpdateGeneralSettings:output_type -> auth.UpdateGeneralSettingsResponse
-	80,  // 155: auth.SettingsService.GetPrivacySettings:output_type -> auth.GetPrivacySettingsResponse
-	125, // 156: auth.SettingsService.UpdatePrivacySettings:output_type -> google.protobuf.Empty
-	83,  // 157: auth.UserEventsService.ListUserEvents:output_type -> auth.ListUserEventsResponse
-	85,  // 158: auth.UserEventsService.GetUserEvent:output_type -> auth.GetUserEventResponse
-	92,  // 159: auth.UserEventsService.ReportUserEvent:output_type -> auth.UserEventReportResponse
-	93,  // 160: auth.UserEventsService.SendReportResponse:output_type -> auth.UserEventReportResponseResponse
-	125, // 161: auth.UserEventsService.CloseEventReport:output_type -> google.protobuf.Empty
-	109, // 162: auth.SearchService.SearchUsers:output_type -> auth.SearchUsersResponse
-	112, // 163: auth.SearchService.SearchFeatures:output_type -> auth.SearchFeaturesResponse
-	116, // 164: auth.SearchService.SearchIsicCodes:output_type -> auth.SearchIsicCodesResponse
-	114, // [114:165] is the sub-list for method output_type
-	63,  // [63:114] is the sub-list for method input_type
-	63,  // [63:63] is the sub-list for extension type_name
-	63,  // [63:63] is the sub-list for extension extendee
-	0,   // [0:63] is the sub-list for field type_name
+	24,  // 11: auth.GetPresenceResponse.data:type_name -> auth.UserPresence
+	163, // 12: auth.UserPresence.last_seen:type_name -> google.protobuf.Timestamp
+	5,   // 13: auth.UserLevelResponse.level:type_name -> auth.Level
+	32,  // 14: auth.UpdateKYCRequest.video:type_name -> auth.VideoInfo
+	40,  // 15: auth.ListBankAccountsResponse.data:type_name -> auth.BankAccountResponse
+	43,  // 16: auth.CitizenProfileResponse.profile_photos:type_name -> auth.ProfilePhoto
+	44,  // 17: auth.CitizenProfileResponse.kyc:type_name -> auth.CitizenKYC
+	45,  // 18: auth.CitizenProfileResponse.customs:type_name -> auth.CitizenCustoms
+	46,  // 19: auth.CitizenProfileResponse.current_level:type_name -> auth.CitizenLevel
+	46,  // 20: auth.CitizenProfileResponse.achieved_levels:type_name -> auth.CitizenLevel
+	159, // 21: auth.CitizenCustoms.passions:type_name -> auth.CitizenCustoms.PassionsEntry
+	49,  // 22: auth.CitizenReferralsResponse.data:type_name -> auth.CitizenReferral
+	51,  // 23: auth.CitizenReferralsResponse.meta:type_name -> auth.PaginationMeta
+	50,  // 24: auth.CitizenReferral.referrer_orders:type_name -> auth.ReferrerOrder
+	54,  // 25: auth.CitizenReferralChartResponse.data:type_name -> auth.ReferralChartData
+	55,  // 26: auth.ReferralChartData.chart_data:type_name -> auth.ChartDataPoint
+	58,  // 27: auth.GetPersonalInfoResponse.data:type_name -> auth.PersonalInfoData
+	160, // 28: auth.PersonalInfoData.passions:type_name -> auth.PersonalInfoData.PassionsEntry
+	161, // 29: auth.UpdatePersonalInfoRequest.passions:type_name -> auth.UpdatePersonalInfoRequest.PassionsEntry
+	60,  // 30: auth.ProfileLimitation.options:type_name -> auth.ProfileLimitationOptions
+	163, // 31: auth.ProfileLimitation.created_at:type_name -> google.protobuf.Timestamp
+	163, // 32: auth.ProfileLimitation.updated_at:type_name -> google.protobuf.Timestamp
+	60,  // 33: auth.CreateProfileLimitationRequest.options:type_name -> auth.ProfileLimitationOptions
+	60,  // 34: auth.UpdateProfileLimitationRequest.options:type_name -> auth.ProfileLimitationOptions
+	61,  // 35: auth.ProfileLimitationResponse.data:type_name -> auth.ProfileLimitation
+	61,  // 36: auth.GetProfileLimitationsResponse.data:type_name -> auth.ProfileLimitation
+	43,  // 37: auth.ListProfilePhotosResponse.data:type_name -> auth.ProfilePhoto
+	79,  // 38: auth.GetSettingsResponse.data:type_name -> auth.SettingsData
+	83,  // 39: auth.GetGeneralSettingsResponse.data:type_name -> auth.NotificationSettingsData
+	83,  // 40: auth.UpdateGeneralSettingsRequest.notifications:type_name -> auth.NotificationSettingsData
+	83,  // 41: auth.UpdateGeneralSettingsResponse.data:type_name -> auth.NotificationSettingsData
+	162, // 42: auth.GetPrivacySettingsResponse.data:type_name -> auth.GetPrivacySettingsResponse.DataEntry
+	98,  // 43: auth.ListUserEventsResponse.data:type_name -> auth.UserEventResource
+	51,  // 44: auth.ListUserEventsResponse.pagination:type_name -> auth.PaginationMeta
+	98,  // 45: auth.GetUserEventResponse.data:type_name -> auth.UserEventResource
+	99,  // 46: auth.UserEventResource.report:type_name -> auth.UserEventReportResource
+	100, // 47: auth.UserEventReportResource.responses:type_name -> auth.UserEventReportResponseResource
+	99,  // 48: auth.UserEventReportResponse.data:type_name -> auth.UserEventReportResource
+	100, // 49: auth.UserEventReportResponseResponse.data:type_name -> auth.UserEventReportResponseResource
+	105, // 50: auth.ListUsersResponse.data:type_name -> auth.UserListItem
+	107, // 51: auth.ListUsersResponse.links:type_name -> auth.PaginationLinks
+	51,  // 52: auth.ListUsersResponse.meta:type_name -> auth.PaginationMeta
+	106, // 53: auth.UserListItem.levels:type_name -> auth.UserLevelInfo
+	5,   // 54: auth.UserLevelInfo.current:type_name -> auth.Level
+	5,   // 55: auth.UserLevelInfo.previous:type_name -> auth.Level
+	110, // 56: auth.GetUserLevelsResponse.data:type_name -> auth.UserLevelData
+	5,   // 57: auth.UserLevelData.latest_level:type_name -> auth.Level
+	5,   // 58: auth.UserLevelData.previous_levels:type_name -> auth.Level
+	113, // 59: auth.GetUserProfileResponse.data:type_name -> auth.UserProfileData
+	24,  // 60: auth.UserProfileData.presence:type_name -> auth.UserPresence
+	116, // 61: auth.GetUserFeaturesCountResponse.data:type_name -> auth.UserFeaturesCountData
+	119, // 62: auth.SearchUsersResponse.data:type_name -> auth.SearchUserResult
+	24,  // 63: auth.SearchUserResult.presence:type_name -> auth.UserPresence
+	122, // 64: auth.SearchFeaturesResponse.data:type_name -> auth.SearchFeatureResult
+	123, // 65: auth.SearchFeatureResult.coordinates:type_name -> auth.Coordinate
+	126, // 66: auth.SearchIsicCodesResponse.data:type_name -> auth.IsicCodeResult
+	127, // 67: auth.APIKeySecretResponse.data:type_name -> auth.APIKey
+	127, // 68: auth.ListAPIKeysResponse.data:type_name -> auth.APIKey
+	140, // 69: auth.PersonalAccessTokenSecretResponse.data:type_name -> auth.PersonalAccessToken
+	140, // 70: auth.ListPersonalAccessTokensResponse.data:type_name -> auth.PersonalAccessToken
+	146, // 71: auth.ListLoginAlertsResponse.data:type_name -> auth.LoginAlert
+	146, // 72: auth.LoginAlertResponse.data:type_name -> auth.LoginAlert
+	152, // 73: auth.LoginHistoryEntry.location:type_name -> auth.LoginLocation
+	153, // 74: auth.GetLoginHistoryResponse.data:type_name -> auth.LoginHistoryEntry
+	51,  // 75: auth.GetLoginHistoryResponse.pagination:type_name -> auth.PaginationMeta
+	6,   // 76: auth.AuthService.Register:input_type -> auth.RegisterRequest
+	8,   // 77: auth.AuthService.Redirect:input_type -> auth.RedirectRequest
+	10,  // 78: auth.AuthService.Callback:input_type -> auth.CallbackRequest
+	12,  // 79: auth.AuthService.GetMe:input_type -> auth.GetMeRequest
+	14,  // 80: auth.AuthService.Logout:input_type -> auth.LogoutRequest
+	15,  // 81: auth.AuthService.ValidateToken:input_type -> auth.ValidateTokenRequest
+	17,  // 82: auth.AuthService.RequestAccountSecurity:input_type -> auth.RequestAccountSecurityRequest
+	18,  // 83: auth.AuthService.VerifyAccountSecurity:input_type -> auth.VerifyAccountSecurityRequest
+	19,  // 84: auth.UserService.GetUser:input_type -> auth.GetUserRequest
+	25,  // 85: auth.UserService.UpdateProfile:input_type -> auth.UpdateProfileRequest
+	103, // 86: auth.UserService.ListUsers:input_type -> auth.ListUsersRequest
+	108, // 87: auth.UserService.GetUserLevels:input_type -> auth.GetUserLevelsRequest
+	111, // 88: auth.UserService.GetUserProfile:input_type -> auth.GetUserProfileRequest
+	26,  // 89: auth.UserService.GetUserWallet:input_type -> auth.GetUserWalletRequest
+	28,  // 90: auth.UserService.GetUserLevel:input_type -> auth.GetUserLevelRequest
+	66,  // 91: auth.UserService.GetProfileLimitations:input_type -> auth.GetProfileLimitationsRequest
+	114, // 92: auth.UserService.GetUserFeaturesCount:input_type -> auth.GetUserFeaturesCountRequest
+	20,  // 93: auth.UserService.GetUserInfo:input_type -> auth.GetUserInfoRequest
+	22,  // 94: auth.UserService.GetPresence:input_type -> auth.GetPresenceRequest
+	62,  // 95: auth.ProfileLimitationService.CreateProfileLimitation:input_type -> auth.CreateProfileLimitationRequest
+	63,  // 96: auth.ProfileLimitationService.UpdateProfileLimitation:input_type -> auth.UpdateProfileLimitationRequest
+	64,  // 97: auth.ProfileLimitationService.DeleteProfileLimitation:input_type -> auth.DeleteProfileLimitationRequest
+	65,  // 98: auth.ProfileLimitationService.GetProfileLimitation:input_type -> auth.GetProfileLimitationRequest
+	30,  // 99: auth.KYCService.GetKYC:input_type -> auth.GetKYCRequest
+	31,  // 100: auth.KYCService.UpdateKYC:input_type -> auth.UpdateKYCRequest
+	34,  // 101: auth.KYCService.ListBankAccounts:input_type -> auth.ListBankAccountsRequest
+	36,  // 102: auth.KYCService.CreateBankAccount:input_type -> auth.CreateBankAccountRequest
+	37,  // 103: auth.KYCService.GetBankAccount:input_type -> auth.GetBankAccountRequest
+	38,  // 104: auth.KYCService.UpdateBankAccount:input_type -> auth.UpdateBankAccountRequest
+	39,  // 105: auth.KYCService.DeleteBankAccount:input_type -> auth.DeleteBankAccountRequest
+	41,  // 106: auth.CitizenService.GetCitizenProfile:input_type -> auth.GetCitizenProfileRequest
+	47,  // 107: auth.CitizenService.GetCitizenReferrals:input_type -> auth.GetCitizenReferralsRequest
+	52,  // 108: auth.CitizenService.GetCitizenReferralChart:input_type -> auth.GetCitizenReferralChartRequest
+	56,  // 109: auth.PersonalInfoService.GetPersonalInfo:input_type -> auth.GetPersonalInfoRequest
+	59,  // 110: auth.PersonalInfoService.UpdatePersonalInfo:input_type -> auth.UpdatePersonalInfoRequest
+	69,  // 111: auth.ProfilePhotoService.ListProfilePhotos:input_type -> auth.ListProfilePhotosRequest
+	71,  // 112: auth.ProfilePhotoService.UploadProfilePhoto:input_type -> auth.UploadProfilePhotoRequest
+	75,  // 113: auth.ProfilePhotoService.GetPhotoStatus:input_type -> auth.GetPhotoStatusRequest
+	72,  // 114: auth.ProfilePhotoService.GetProfilePhoto:input_type -> auth.GetProfilePhotoRequest
+	73,  // 115: auth.ProfilePhotoService.DeleteProfilePhoto:input_type -> auth.DeleteProfilePhotoRequest
+	77,  // 116: auth.SettingsService.GetSettings:input_type -> auth.GetSettingsRequest
+	80,  // 117: auth.SettingsService.UpdateSettings:input_type -> auth.UpdateSettingsRequest
+	81,  // 118: auth.SettingsService.GetGeneralSettings:input_type -> auth.GetGeneralSettingsRequest
+	84,  // 119: auth.SettingsService.UpdateGeneralSettings:input_type -> auth.UpdateGeneralSettingsRequest
+	86,  // 120: auth.SettingsService.GetPrivacySettings:input_type -> auth.GetPrivacySettingsRequest
+	88,  // 121: auth.SettingsService.UpdatePrivacySettings:input_type -> auth.UpdatePrivacySettingsRequest
+	89,  // 122: auth.UserEventsService.ListUserEvents:input_type -> auth.ListUserEventsRequest
+	91,  // 123: auth.UserEventsService.GetUserEvent:input_type -> auth.GetUserEventRequest
+	93,  // 124: auth.UserEventsService.ReportUserEvent:input_type -> auth.ReportUserEventRequest
+	94,  // 125: auth.UserEventsService.SendReportResponse:input_type -> auth.SendReportResponseRequest
+	95,  // 126: auth.UserEventsService.CloseEventReport:input_type -> auth.CloseEventReportRequest
+	96,  // 127: auth.UserEventsService.ExportUserEvents:input_type -> auth.ExportUserEventsRequest
+	117, // 128: auth.SearchService.SearchUsers:input_type -> auth.SearchUsersRequest
+	120, // 129: auth.SearchService.SearchFeatures:input_type -> auth.SearchFeaturesRequest
+	124, // 130: auth.SearchService.SearchIsicCodes:input_type -> auth.SearchIsicCodesRequest
+	128, // 131: auth.APIKeyService.CreateAPIKey:input_type -> auth.CreateAPIKeyRequest
+	130, // 132: auth.APIKeyService.ListAPIKeys:input_type -> auth.ListAPIKeysRequest
+	132, // 133: auth.APIKeyService.RotateAPIKey:input_type -> auth.RotateAPIKeyRequest
+	133, // 134: auth.APIKeyService.RevokeAPIKey:input_type -> auth.RevokeAPIKeyRequest
+	134, // 135: auth.APIKeyService.ValidateAPIKey:input_type -> auth.ValidateAPIKeyRequest
+	136, // 136: auth.EmailVerificationService.SendEmailVerification:input_type -> auth.SendEmailVerificationRequest
+	137, // 137: auth.EmailVerificationService.VerifyEmail:input_type -> auth.VerifyEmailRequest
+	164, // 138: auth.PersonalAccessTokenService.ListTokenScopes:input_type -> google.protobuf.Empty
+	141, // 139: auth.PersonalAccessTokenService.CreatePersonalAccessToken:input_type -> auth.CreatePersonalAccessTokenRequest
+	143, // 140: auth.PersonalAccessTokenService.ListPersonalAccessTokens:input_type -> auth.ListPersonalAccessTokensRequest
+	145, // 141: auth.PersonalAccessTokenService.RevokePersonalAccessToken:input_type -> auth.RevokePersonalAccessTokenRequest
+	147, // 142: auth.LoginAlertService.ListLoginAlerts:input_type -> auth.ListLoginAlertsRequest
+	149, // 143: auth.LoginAlertService.ConfirmLoginAlert:input_type -> auth.ConfirmLoginAlertRequest
+	151, // 144: auth.LoginHistoryService.GetLoginHistory:input_type -> auth.GetLoginHistoryRequest
+	155, // 145: auth.MagicLinkService.RequestMagicLink:input_type -> auth.RequestMagicLinkRequest
+	156, // 146: auth.MagicLinkService.ConsumeMagicLink:input_type -> auth.ConsumeMagicLinkRequest
+	7,   // 147: auth.AuthService.Register:output_type -> auth.RegisterResponse
+	9,   // 148: auth.AuthService.Redirect:output_type -> auth.RedirectResponse
+	11,  // 149: auth.AuthService.Callback:output_type -> auth.CallbackResponse
+	13,  // 150: auth.AuthService.GetMe:output_type -> auth.UserResponse
+	164, // 151: auth.AuthService.Logout:output_type -> google.protobuf.Empty
+	16,  // 152: auth.AuthService.ValidateToken:output_type -> auth.ValidateTokenResponse
+	164, // 153: auth.AuthService.RequestAccountSecurity:output_type -> google.protobuf.Empty
+	164, // 154: auth.AuthService.VerifyAccountSecurity:output_type -> google.protobuf.Empty
+	0,   // 155: auth.UserService.GetUser:output_type -> auth.User
+	0,   // 156: auth.UserService.UpdateProfile:output_type -> auth.User
+	104, // 157: auth.UserService.ListUsers:output_type -> auth.ListUsersResponse
+	109, // 158: auth.UserService.GetUserLevels:output_type -> auth.GetUserLevelsResponse
+	112, // 159: auth.UserService.GetUserProfile:output_type -> auth.GetUserProfileResponse
+	27,  // 160: auth.UserService.GetUserWallet:output_type -> auth.UserWalletResponse
+	29,  // 161: auth.UserService.GetUserLevel:output_type -> auth.UserLevelResponse
+	68,  // 162: auth.UserService.GetProfileLimitations:output_type -> auth.GetProfileLimitationsResponse
+	115, // 163: auth.UserService.GetUserFeaturesCount:output_type -> auth.GetUserFeaturesCountResponse
+	21,  // 164: auth.UserService.GetUserInfo:output_type -> auth.UserInfo
+	23,  // 165: auth.UserService.GetPresence:output_type -> auth.GetPresenceResponse
+	67,  // 166: auth.ProfileLimitationService.CreateProfileLimitation:output_type -> auth.ProfileLimitationResponse
+	67,  // 167: auth.ProfileLimitationService.UpdateProfileLimitation:output_type -> auth.ProfileLimitationResponse
+	164, // 168: auth.ProfileLimitationService.DeleteProfileLimitation:output_type -> google.protobuf.Empty
+	67,  // 169: auth.ProfileLimitationService.GetProfileLimitation:output_type -> auth.ProfileLimitationResponse
+	33,  // 170: auth.KYCService.GetKYC:output_type -> auth.KYCResponse
+	33,  // 171: auth.KYCService.UpdateKYC:output_type -> auth.KYCResponse
+	35,  // 172: auth.KYCService.ListBankAccounts:output_type -> auth.ListBankAccountsResponse
+	40,  // 173: auth.KYCService.CreateBankAccount:output_type -> auth.BankAccountResponse
+	40,  // 174: auth.KYCService.GetBankAccount:output_type -> auth.BankAccountResponse
+	40,  // 175: auth.KYCService.UpdateBankAccount:output_type -> auth.BankAccountResponse
+	164, // 176: auth.KYCService.DeleteBankAccount:output_type -> google.protobuf.Empty
+	42,  // 177: auth.CitizenService.GetCitizenProfile:output_type -> auth.CitizenProfileResponse
+	48,  // 178: auth.CitizenService.GetCitizenReferrals:output_type -> auth.CitizenReferralsResponse
+	53,  // 179: auth.CitizenService.GetCitizenReferralChart:output_type -> auth.CitizenReferralChartResponse
+	57,  // 180: auth.PersonalInfoService.GetPersonalInfo:output_type -> auth.GetPersonalInfoResponse
+	164, // 181: auth.PersonalInfoService.UpdatePersonalInfo:output_type -> google.protobuf.Empty
+	70,  // 182: auth.ProfilePhotoService.ListProfilePhotos:output_type -> auth.ListProfilePhotosResponse
+	76,  // 183: auth.ProfilePhotoService.UploadProfilePhoto:output_type -> auth.PhotoUploadStatusResponse
+	76,  // 184: auth.ProfilePhotoService.GetPhotoStatus:output_type -> auth.PhotoUploadStatusResponse
+	74,  // 185: auth.ProfilePhotoService.GetProfilePhoto:output_type -> auth.ProfilePhotoResponse
+	164, // 186: auth.ProfilePhotoService.DeleteProfilePhoto:output_type -> google.protobuf.Empty
+	78,  // 187: auth.SettingsService.GetSettings:output_type -> auth.GetSettingsResponse
+	164, // 188: auth.SettingsService.UpdateSettings:output_type -> google.protobuf.Empty
+	82,  // 189: auth.SettingsService.GetGeneralSettings:output_type -> auth.GetGeneralSettingsResponse
+	85,  // 190: auth.SettingsService.UpdateGeneralSettings:output_type -> auth.UpdateGeneralSettingsResponse
+	87,  // 191: auth.SettingsService.GetPrivacySettings:output_type -> auth.GetPrivacySettingsResponse
+	164, // 192: auth.SettingsService.UpdatePrivacySettings:output_type -> google.protobuf.Empty
+	90,  // 193: auth.UserEventsService.ListUserEvents:output_type -> auth.ListUserEventsResponse
+	92,  // 194: auth.UserEventsService.GetUserEvent:output_type -> auth.GetUserEventResponse
+	101, // 195: auth.UserEventsService.ReportUserEvent:output_type -> auth.UserEventReportResponse
+	102, // 196: auth.UserEventsService.SendReportResponse:output_type -> auth.UserEventReportResponseResponse
+	164, // 197: auth.UserEventsService.CloseEventReport:output_type -> google.protobuf.Empty
+	97,  // 198: auth.UserEventsService.ExportUserEvents:output_type -> auth.UserEventsExportChunk
+	118, // 199: auth.SearchService.SearchUsers:output_type -> auth.SearchUsersResponse
+	121, // 200: auth.SearchService.SearchFeatures:output_type -> auth.SearchFeaturesResponse
+	125, // 201: auth.SearchService.SearchIsicCodes:output_type -> auth.SearchIsicCodesResponse
+	129, // 202: auth.APIKeyService.CreateAPIKey:output_type -> auth.APIKeySecretResponse
+	131, // 203: auth.APIKeyService.ListAPIKeys:output_type -> auth.ListAPIKeysResponse
+	129, // 204: auth.APIKeyService.RotateAPIKey:output_type -> auth.APIKeySecretResponse
+	164, // 205: auth.APIKeyService.RevokeAPIKey:output_type -> google.protobuf.Empty
+	135, // 206: auth.APIKeyService.ValidateAPIKey:output_type -> auth.ValidateAPIKeyResponse
+	164, // 207: auth.EmailVerificationService.SendEmailVerification:output_type -> google.protobuf.Empty
+	138, // 208: auth.EmailVerificationService.VerifyEmail:output_type -> auth.VerifyEmailResponse
+	139, // 209: auth.PersonalAccessTokenService.ListTokenScopes:output_type -> auth.TokenScopesResponse
+	142, // 210: auth.PersonalAccessTokenService.CreatePersonalAccessToken:output_type -> auth.PersonalAccessTokenSecretResponse
+	144, // 211: auth.PersonalAccessTokenService.ListPersonalAccessTokens:output_type -> auth.ListPersonalAccessTokensResponse
+	164, // 212: auth.PersonalAccessTokenService.RevokePersonalAccessToken:output_type -> google.protobuf.Empty
+	148, // 213: auth.LoginAlertService.ListLoginAlerts:output_type -> auth.ListLoginAlertsResponse
+	150, // 214: auth.LoginAlertService.ConfirmLoginAlert:output_type -> auth.LoginAlertResponse
+	154, // 215: auth.LoginHistoryService.GetLoginHistory:output_type -> auth.GetLoginHistoryResponse
+	164, // 216: auth.MagicLinkService.RequestMagicLink:output_type -> google.protobuf.Empty
+	11,  // 217: auth.MagicLinkService.ConsumeMagicLink:output_type -> auth.CallbackResponse
+	147, // [147:218] is the sub-list for method output_type
+	76,  // [76:147] is the sub-list for method input_type
+	76,  // [76:76] is the sub-list for extension type_name
+	76,  // [76:76] is the sub-list for extension extendee
+	0,   // [0:76] is the sub-list for field type_name
 }
 
 func init() { file_auth_proto_init() }
@@ -8503,9 +11289,9 @@ func file_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_proto_rawDesc), len(file_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   124,
+			NumMessages:   163,
 			NumExtensions: 0,
-			NumServices:   10,
+			NumServices:   16,
 		},
 		GoTypes:           file_auth_proto_goTypes,
 		DependencyIndexes: file_auth_proto_depIdxs,
@@ -401,6 +401,8 @@ const (
 	UserService_GetUserLevel_FullMethodName          = "/auth.UserService/GetUserLevel"
 	UserService_GetProfileLimitations_FullMethodName = "/auth.UserService/GetProfileLimitations"
 	UserService_GetUserFeaturesCount_FullMethodName  = "/auth.UserService/GetUserFeaturesCount"
+	UserService_GetUserInfo_FullMethodName           = "/auth.UserService/GetUserInfo"
+	UserService_GetPresence_FullMethodName           = "/auth.UserService/GetPresence"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -418,6 +420,8 @@ type UserServiceClient interface {
 	GetUserLevel(ctx context.Context, in *GetUserLevelRequest, opts ...grpc.CallOption) (*UserLevelResponse, error)
 	GetProfileLimitations(ctx context.Context, in *GetProfileLimitationsRequest, opts ...grpc.CallOption) (*GetProfileLimitationsResponse, error)
 	GetUserFeaturesCount(ctx context.Context, in *GetUserFeaturesCountRequest, opts ...grpc.CallOption) (*GetUserFeaturesCountResponse, error)
+	GetUserInfo(ctx context.Context, in *GetUserInfoRequest, opts ...grpc.CallOption) (*UserInfo, error)
+	GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*GetPresenceResponse, error)
 }
 
 type userServiceClient struct {
@@ -518,6 +522,26 @@ func (c *userServiceClient) GetUserFeaturesCount(ctx context.Context, in *GetUse
 	return out, nil
 }
 
+func (c *userServiceClient) GetUserInfo(ctx context.Context, in *GetUserInfoRequest, opts ...grpc.CallOption) (*UserInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserInfo)
+	err := c.cc.Invoke(ctx, UserService_GetUserInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*GetPresenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPresenceResponse)
+	err := c.cc.Invoke(ctx, UserService_GetPresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -533,6 +557,8 @@ type UserServiceServer interface {
 	GetUserLevel(context.Context, *GetUserLevelRequest) (*UserLevelResponse, error)
 	GetProfileLimitations(context.Context, *GetProfileLimitationsRequest) (*GetProfileLimitationsResponse, error)
 	GetUserFeaturesCount(context.Context, *GetUserFeaturesCountRequest) (*GetUserFeaturesCountResponse, error)
+	GetUserInfo(context.Context, *GetUserInfoRequest) (*UserInfo, error)
+	GetPresence(context.Context, *GetPresenceRequest) (*GetPresenceResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -570,6 +596,12 @@ func (UnimplementedUserServiceServer) GetProfileLimitations(context.Context, *Ge
 func (UnimplementedUserServiceServer) GetUserFeaturesCount(context.Context, *GetUserFeaturesCountRequest) (*GetUserFeaturesCountResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUserFeaturesCount not implemented")
 }
+func (UnimplementedUserServiceServer) GetUserInfo(context.Context, *GetUserInfoRequest) (*UserInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserInfo not implemented")
+}
+func (UnimplementedUserServiceServer) GetPresence(context.Context, *GetPresenceRequest) (*GetPresenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPresence not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -753,6 +785,42 @@ func _UserService_GetUserFeaturesCount_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserInfo(ctx, req.(*GetUserInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPresence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPresence(ctx, req.(*GetPresenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -796,6 +864,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserFeaturesCount",
 			Handler:    _UserService_GetUserFeaturesCount_Handler,
 		},
+		{
+			MethodName: "GetUserInfo",
+			Handler:    _UserService_GetUserInfo_Handler,
+		},
+		{
+			MethodName: "GetPresence",
+			Handler:    _UserService_GetPresence_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
@@ -1685,6 +1761,7 @@ var PersonalInfoService_ServiceDesc = grpc.ServiceDesc{
 const (
 	ProfilePhotoService_ListProfilePhotos_FullMethodName  = "/auth.ProfilePhotoService/ListProfilePhotos"
 	ProfilePhotoService_UploadProfilePhoto_FullMethodName = "/auth.ProfilePhotoService/UploadProfilePhoto"
+	ProfilePhotoService_GetPhotoStatus_FullMethodName     = "/auth.ProfilePhotoService/GetPhotoStatus"
 	ProfilePhotoService_GetProfilePhoto_FullMethodName    = "/auth.ProfilePhotoService/GetProfilePhoto"
 	ProfilePhotoService_DeleteProfilePhoto_FullMethodName = "/auth.ProfilePhotoService/DeleteProfilePhoto"
 )
@@ -1696,7 +1773,8 @@ const (
 // ProfilePhoto Service - handles user profile photo management
 type ProfilePhotoServiceClient interface {
 	ListProfilePhotos(ctx context.Context, in *ListProfilePhotosRequest, opts ...grpc.CallOption) (*ListProfilePhotosResponse, error)
-	UploadProfilePhoto(ctx context.Context, in *UploadProfilePhotoRequest, opts ...grpc.CallOption) (*ProfilePhotoResponse, error)
+	UploadProfilePhoto(ctx context.Context, in *UploadProfilePhotoRequest, opts ...grpc.CallOption) (*PhotoUploadStatusResponse, error)
+	GetPhotoStatus(ctx context.Context, in *GetPhotoStatusRequest, opts ...grpc.CallOption) (*PhotoUploadStatusResponse, error)
 	GetProfilePhoto(ctx context.Context, in *GetProfilePhotoRequest, opts ...grpc.CallOption) (*ProfilePhotoResponse, error)
 	DeleteProfilePhoto(ctx context.Context, in *DeleteProfilePhotoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
@@ -1719,9 +1797,9 @@ func (c *profilePhotoServiceClient) ListProfilePhotos(ctx context.Context, in *L
 	return out, nil
 }
 
-func (c *profilePhotoServiceClient) UploadProfilePhoto(ctx context.Context, in *UploadProfilePhotoRequest, opts ...grpc.CallOption) (*ProfilePhotoResponse, error) {
+func (c *profilePhotoServiceClient) UploadProfilePhoto(ctx context.Context, in *UploadProfilePhotoRequest, opts ...grpc.CallOption) (*PhotoUploadStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ProfilePhotoResponse)
+	out := new(PhotoUploadStatusResponse)
 	err := c.cc.Invoke(ctx, ProfilePhotoService_UploadProfilePhoto_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -1729,6 +1807,16 @@ func (c *profilePhotoServiceClient) UploadProfilePhoto(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *profilePhotoServiceClient) GetPhotoStatus(ctx context.Context, in *GetPhotoStatusRequest, opts ...grpc.CallOption) (*PhotoUploadStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PhotoUploadStatusResponse)
+	err := c.cc.Invoke(ctx, ProfilePhotoService_GetPhotoStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *profilePhotoServiceClient) GetProfilePhoto(ctx context.Context, in *GetProfilePhotoRequest, opts ...grpc.CallOption) (*ProfilePhotoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ProfilePhotoResponse)
@@ -1756,7 +1844,8 @@ func (c *profilePhotoServiceClient) DeleteProfilePhoto(ctx context.Context, in *
 // ProfilePhoto Service - handles user profile photo management
 type ProfilePhotoServiceServer interface {
 	ListProfilePhotos(context.Context, *ListProfilePhotosRequest) (*ListProfilePhotosResponse, error)
-	UploadProfilePhoto(context.Context, *UploadProfilePhotoRequest) (*ProfilePhotoResponse, error)
+	UploadProfilePhoto(context.Context, *UploadProfilePhotoRequest) (*PhotoUploadStatusResponse, error)
+	GetPhotoStatus(context.Context, *GetPhotoStatusRequest) (*PhotoUploadStatusResponse, error)
 	GetProfilePhoto(context.Context, *GetProfilePhotoRequest) (*ProfilePhotoResponse, error)
 	DeleteProfilePhoto(context.Context, *DeleteProfilePhotoRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedProfilePhotoServiceServer()
@@ -1772,9 +1861,12 @@ type UnimplementedProfilePhotoServiceServer struct{}
 func (UnimplementedProfilePhotoServiceServer) ListProfilePhotos(context.Context, *ListProfilePhotosRequest) (*ListProfilePhotosResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListProfilePhotos not implemented")
 }
-func (UnimplementedProfilePhotoServiceServer) UploadProfilePhoto(context.Context, *UploadProfilePhotoRequest) (*ProfilePhotoResponse, error) {
+func (UnimplementedProfilePhotoServiceServer) UploadProfilePhoto(context.Context, *UploadProfilePhotoRequest) (*PhotoUploadStatusResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UploadProfilePhoto not implemented")
 }
+func (UnimplementedProfilePhotoServiceServer) GetPhotoStatus(context.Context, *GetPhotoStatusRequest) (*PhotoUploadStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPhotoStatus not implemented")
+}
 func (UnimplementedProfilePhotoServiceServer) GetProfilePhoto(context.Context, *GetProfilePhotoRequest) (*ProfilePhotoResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProfilePhoto not implemented")
 }
@@ -1838,6 +1930,24 @@ func _ProfilePhotoService_UploadProfilePhoto_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfilePhotoService_GetPhotoStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPhotoStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilePhotoServiceServer).GetPhotoStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfilePhotoService_GetPhotoStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilePhotoServiceServer).GetPhotoStatus(ctx, req.(*GetPhotoStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProfilePhotoService_GetProfilePhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProfilePhotoRequest)
 	if err := dec(in); err != nil {
@@ -1889,6 +1999,10 @@ var ProfilePhotoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UploadProfilePhoto",
 			Handler:    _ProfilePhotoService_UploadProfilePhoto_Handler,
 		},
+		{
+			MethodName: "GetPhotoStatus",
+			Handler:    _ProfilePhotoService_GetPhotoStatus_Handler,
+		},
 		{
 			MethodName: "GetProfilePhoto",
 			Handler:    _ProfilePhotoService_GetProfilePhoto_Handler,
@@ -2204,6 +2318,7 @@ const (
 	UserEventsService_ReportUserEvent_FullMethodName    = "/auth.UserEventsService/ReportUserEvent"
 	UserEventsService_SendReportResponse_FullMethodName = "/auth.UserEventsService/SendReportResponse"
 	UserEventsService_CloseEventReport_FullMethodName   = "/auth.UserEventsService/CloseEventReport"
+	UserEventsService_ExportUserEvents_FullMethodName   = "/auth.UserEventsService/ExportUserEvents"
 )
 
 // UserEventsServiceClient is the client API for UserEventsService service.
@@ -2217,6 +2332,8 @@ type UserEventsServiceClient interface {
 	ReportUserEvent(ctx context.Context, in *ReportUserEventRequest, opts ...grpc.CallOption) (*UserEventReportResponse, error)
 	SendReportResponse(ctx context.Context, in *SendReportResponseRequest, opts ...grpc.CallOption) (*UserEventReportResponseResponse, error)
 	CloseEventReport(ctx context.Context, in *CloseEventReportRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Streams the matching events as CSV for data-retention and compliance requests
+	ExportUserEvents(ctx context.Context, in *ExportUserEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UserEventsExportChunk], error)
 }
 
 type userEventsServiceClient struct {
@@ -2277,6 +2394,25 @@ func (c *userEventsServiceClient) CloseEventReport(ctx context.Context, in *Clos
 	return out, nil
 }
 
+func (c *userEventsServiceClient) ExportUserEvents(ctx context.Context, in *ExportUserEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UserEventsExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserEventsService_ServiceDesc.Streams[0], UserEventsService_ExportUserEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportUserEventsRequest, UserEventsExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserEventsService_ExportUserEventsClient = grpc.ServerStreamingClient[UserEventsExportChunk]
+
 // UserEventsServiceServer is the server API for UserEventsService service.
 // All implementations must embed UnimplementedUserEventsServiceServer
 // for forward compatibility.
@@ -2288,6 +2424,8 @@ type UserEventsServiceServer interface {
 	ReportUserEvent(context.Context, *ReportUserEventRequest) (*UserEventReportResponse, error)
 	SendReportResponse(context.Context, *SendReportResponseRequest) (*UserEventReportResponseResponse, error)
 	CloseEventReport(context.Context, *CloseEventReportRequest) (*emptypb.Empty, error)
+	// Streams the matching events as CSV for data-retention and compliance requests
+	ExportUserEvents(*ExportUserEventsRequest, grpc.ServerStreamingServer[UserEventsExportChunk]) error
 	mustEmbedUnimplementedUserEventsServiceServer()
 }
 
@@ -2313,6 +2451,9 @@ func (UnimplementedUserEventsServiceServer) SendReportResponse(context.Context,
 func (UnimplementedUserEventsServiceServer) CloseEventReport(context.Context, *CloseEventReportRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method CloseEventReport not implemented")
 }
+func (UnimplementedUserEventsServiceServer) ExportUserEvents(*ExportUserEventsRequest, grpc.ServerStreamingServer[UserEventsExportChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportUserEvents not implemented")
+}
 func (UnimplementedUserEventsServiceServer) mustEmbedUnimplementedUserEventsServiceServer() {}
 func (UnimplementedUserEventsServiceServer) testEmbeddedByValue()                           {}
 
@@ -2424,6 +2565,17 @@ func _UserEventsService_CloseEventReport_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserEventsService_ExportUserEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUserEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserEventsServiceServer).ExportUserEvents(m, &grpc.GenericServerStream[ExportUserEventsRequest, UserEventsExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserEventsService_ExportUserEventsServer = grpc.ServerStreamingServer[UserEventsExportChunk]
+
 // UserEventsService_ServiceDesc is the grpc.ServiceDesc for UserEventsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2452,7 +2604,13 @@ var UserEventsService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _UserEventsService_CloseEventReport_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportUserEvents",
+			Handler:       _UserEventsService_ExportUserEvents_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "auth.proto",
 }
 
@@ -2639,3 +2797,1039 @@ var SearchService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
 }
+
+const (
+	APIKeyService_CreateAPIKey_FullMethodName   = "/auth.APIKeyService/CreateAPIKey"
+	APIKeyService_ListAPIKeys_FullMethodName    = "/auth.APIKeyService/ListAPIKeys"
+	APIKeyService_RotateAPIKey_FullMethodName   = "/auth.APIKeyService/RotateAPIKey"
+	APIKeyService_RevokeAPIKey_FullMethodName   = "/auth.APIKeyService/RevokeAPIKey"
+	APIKeyService_ValidateAPIKey_FullMethodName = "/auth.APIKeyService/ValidateAPIKey"
+)
+
+// APIKeyServiceClient is the client API for APIKeyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ============== API Key Service ==============
+// API Key Service - machine credentials for server-to-server callers
+type APIKeyServiceClient interface {
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*APIKeySecretResponse, error)
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	RotateAPIKey(ctx context.Context, in *RotateAPIKeyRequest, opts ...grpc.CallOption) (*APIKeySecretResponse, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ValidateAPIKey(ctx context.Context, in *ValidateAPIKeyRequest, opts ...grpc.CallOption) (*ValidateAPIKeyResponse, error)
+}
+
+type aPIKeyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAPIKeyServiceClient(cc grpc.ClientConnInterface) APIKeyServiceClient {
+	return &aPIKeyServiceClient{cc}
+}
+
+func (c *aPIKeyServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*APIKeySecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APIKeySecretResponse)
+	err := c.cc.Invoke(ctx, APIKeyService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, APIKeyService_ListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyServiceClient) RotateAPIKey(ctx context.Context, in *RotateAPIKeyRequest, opts ...grpc.CallOption) (*APIKeySecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APIKeySecretResponse)
+	err := c.cc.Invoke(ctx, APIKeyService_RotateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, APIKeyService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyServiceClient) ValidateAPIKey(ctx context.Context, in *ValidateAPIKeyRequest, opts ...grpc.CallOption) (*ValidateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, APIKeyService_ValidateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APIKeyServiceServer is the server API for APIKeyService service.
+// All implementations must embed UnimplementedAPIKeyServiceServer
+// for forward compatibility.
+//
+// ============== API Key Service ==============
+// API Key Service - machine credentials for server-to-server callers
+type APIKeyServiceServer interface {
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*APIKeySecretResponse, error)
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	RotateAPIKey(context.Context, *RotateAPIKeyRequest) (*APIKeySecretResponse, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*emptypb.Empty, error)
+	ValidateAPIKey(context.Context, *ValidateAPIKeyRequest) (*ValidateAPIKeyResponse, error)
+	mustEmbedUnimplementedAPIKeyServiceServer()
+}
+
+// UnimplementedAPIKeyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAPIKeyServiceServer struct{}
+
+func (UnimplementedAPIKeyServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*APIKeySecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedAPIKeyServiceServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedAPIKeyServiceServer) RotateAPIKey(context.Context, *RotateAPIKeyRequest) (*APIKeySecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateAPIKey not implemented")
+}
+func (UnimplementedAPIKeyServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedAPIKeyServiceServer) ValidateAPIKey(context.Context, *ValidateAPIKeyRequest) (*ValidateAPIKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateAPIKey not implemented")
+}
+func (UnimplementedAPIKeyServiceServer) mustEmbedUnimplementedAPIKeyServiceServer() {}
+func (UnimplementedAPIKeyServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeAPIKeyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to APIKeyServiceServer will
+// result in compilation errors.
+type UnsafeAPIKeyServiceServer interface {
+	mustEmbedUnimplementedAPIKeyServiceServer()
+}
+
+func RegisterAPIKeyServiceServer(s grpc.ServiceRegistrar, srv APIKeyServiceServer) {
+	// If the following call panics, it indicates UnimplementedAPIKeyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&APIKeyService_ServiceDesc, srv)
+}
+
+func _APIKeyService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIKeyService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKeyService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIKeyService_ListAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKeyService_RotateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServiceServer).RotateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIKeyService_RotateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServiceServer).RotateAPIKey(ctx, req.(*RotateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKeyService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIKeyService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKeyService_ValidateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServiceServer).ValidateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIKeyService_ValidateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServiceServer).ValidateAPIKey(ctx, req.(*ValidateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// APIKeyService_ServiceDesc is the grpc.ServiceDesc for APIKeyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var APIKeyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.APIKeyService",
+	HandlerType: (*APIKeyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _APIKeyService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _APIKeyService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RotateAPIKey",
+			Handler:    _APIKeyService_RotateAPIKey_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _APIKeyService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "ValidateAPIKey",
+			Handler:    _APIKeyService_ValidateAPIKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth.proto",
+}
+
+const (
+	EmailVerificationService_SendEmailVerification_FullMethodName = "/auth.EmailVerificationService/SendEmailVerification"
+	EmailVerificationService_VerifyEmail_FullMethodName           = "/auth.EmailVerificationService/VerifyEmail"
+)
+
+// EmailVerificationServiceClient is the client API for EmailVerificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ============== Email Verification Service ==============
+// Email Verification Service - signed links that confirm a user's email
+type EmailVerificationServiceClient interface {
+	SendEmailVerification(ctx context.Context, in *SendEmailVerificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+}
+
+type emailVerificationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEmailVerificationServiceClient(cc grpc.ClientConnInterface) EmailVerificationServiceClient {
+	return &emailVerificationServiceClient{cc}
+}
+
+func (c *emailVerificationServiceClient) SendEmailVerification(ctx context.Context, in *SendEmailVerificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EmailVerificationService_SendEmailVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailVerificationServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyEmailResponse)
+	err := c.cc.Invoke(ctx, EmailVerificationService_VerifyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EmailVerificationServiceServer is the server API for EmailVerificationService service.
+// All implementations must embed UnimplementedEmailVerificationServiceServer
+// for forward compatibility.
+//
+// ============== Email Verification Service ==============
+// Email Verification Service - signed links that confirm a user's email
+type EmailVerificationServiceServer interface {
+	SendEmailVerification(context.Context, *SendEmailVerificationRequest) (*emptypb.Empty, error)
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	mustEmbedUnimplementedEmailVerificationServiceServer()
+}
+
+// UnimplementedEmailVerificationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEmailVerificationServiceServer struct{}
+
+func (UnimplementedEmailVerificationServiceServer) SendEmailVerification(context.Context, *SendEmailVerificationRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendEmailVerification not implemented")
+}
+func (UnimplementedEmailVerificationServiceServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedEmailVerificationServiceServer) mustEmbedUnimplementedEmailVerificationServiceServer() {
+}
+func (UnimplementedEmailVerificationServiceServer) testEmbeddedByValue() {}
+
+// UnsafeEmailVerificationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmailVerificationServiceServer will
+// result in compilation errors.
+type UnsafeEmailVerificationServiceServer interface {
+	mustEmbedUnimplementedEmailVerificationServiceServer()
+}
+
+func RegisterEmailVerificationServiceServer(s grpc.ServiceRegistrar, srv EmailVerificationServiceServer) {
+	// If the following call panics, it indicates UnimplementedEmailVerificationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EmailVerificationService_ServiceDesc, srv)
+}
+
+func _EmailVerificationService_SendEmailVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEmailVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailVerificationServiceServer).SendEmailVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailVerificationService_SendEmailVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailVerificationServiceServer).SendEmailVerification(ctx, req.(*SendEmailVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailVerificationService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailVerificationServiceServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailVerificationService_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailVerificationServiceServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EmailVerificationService_ServiceDesc is the grpc.ServiceDesc for EmailVerificationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EmailVerificationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.EmailVerificationService",
+	HandlerType: (*EmailVerificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendEmailVerification",
+			Handler:    _EmailVerificationService_SendEmailVerification_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _EmailVerificationService_VerifyEmail_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth.proto",
+}
+
+const (
+	PersonalAccessTokenService_ListTokenScopes_FullMethodName           = "/auth.PersonalAccessTokenService/ListTokenScopes"
+	PersonalAccessTokenService_CreatePersonalAccessToken_FullMethodName = "/auth.PersonalAccessTokenService/CreatePersonalAccessToken"
+	PersonalAccessTokenService_ListPersonalAccessTokens_FullMethodName  = "/auth.PersonalAccessTokenService/ListPersonalAccessTokens"
+	PersonalAccessTokenService_RevokePersonalAccessToken_FullMethodName = "/auth.PersonalAccessTokenService/RevokePersonalAccessToken"
+)
+
+// PersonalAccessTokenServiceClient is the client API for PersonalAccessTokenService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ============== Personal Access Token Service ==============
+// Personal Access Token Service - bearer tokens limited to chosen scopes, for
+// third-party apps acting for the user
+type PersonalAccessTokenServiceClient interface {
+	ListTokenScopes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TokenScopesResponse, error)
+	CreatePersonalAccessToken(ctx context.Context, in *CreatePersonalAccessTokenRequest, opts ...grpc.CallOption) (*PersonalAccessTokenSecretResponse, error)
+	ListPersonalAccessTokens(ctx context.Context, in *ListPersonalAccessTokensRequest, opts ...grpc.CallOption) (*ListPersonalAccessTokensResponse, error)
+	RevokePersonalAccessToken(ctx context.Context, in *RevokePersonalAccessTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type personalAccessTokenServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPersonalAccessTokenServiceClient(cc grpc.ClientConnInterface) PersonalAccessTokenServiceClient {
+	return &personalAccessTokenServiceClient{cc}
+}
+
+func (c *personalAccessTokenServiceClient) ListTokenScopes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TokenScopesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenScopesResponse)
+	err := c.cc.Invoke(ctx, PersonalAccessTokenService_ListTokenScopes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personalAccessTokenServiceClient) CreatePersonalAccessToken(ctx context.Context, in *CreatePersonalAccessTokenRequest, opts ...grpc.CallOption) (*PersonalAccessTokenSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PersonalAccessTokenSecretResponse)
+	err := c.cc.Invoke(ctx, PersonalAccessTokenService_CreatePersonalAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personalAccessTokenServiceClient) ListPersonalAccessTokens(ctx context.Context, in *ListPersonalAccessTokensRequest, opts ...grpc.CallOption) (*ListPersonalAccessTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPersonalAccessTokensResponse)
+	err := c.cc.Invoke(ctx, PersonalAccessTokenService_ListPersonalAccessTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personalAccessTokenServiceClient) RevokePersonalAccessToken(ctx context.Context, in *RevokePersonalAccessTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PersonalAccessTokenService_RevokePersonalAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PersonalAccessTokenServiceServer is the server API for PersonalAccessTokenService service.
+// All implementations must embed UnimplementedPersonalAccessTokenServiceServer
+// for forward compatibility.
+//
+// ============== Personal Access Token Service ==============
+// Personal Access Token Service - bearer tokens limited to chosen scopes, for
+// third-party apps acting for the user
+type PersonalAccessTokenServiceServer interface {
+	ListTokenScopes(context.Context, *emptypb.Empty) (*TokenScopesResponse, error)
+	CreatePersonalAccessToken(context.Context, *CreatePersonalAccessTokenRequest) (*PersonalAccessTokenSecretResponse, error)
+	ListPersonalAccessTokens(context.Context, *ListPersonalAccessTokensRequest) (*ListPersonalAccessTokensResponse, error)
+	RevokePersonalAccessToken(context.Context, *RevokePersonalAccessTokenRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedPersonalAccessTokenServiceServer()
+}
+
+// UnimplementedPersonalAccessTokenServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPersonalAccessTokenServiceServer struct{}
+
+func (UnimplementedPersonalAccessTokenServiceServer) ListTokenScopes(context.Context, *emptypb.Empty) (*TokenScopesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTokenScopes not implemented")
+}
+func (UnimplementedPersonalAccessTokenServiceServer) CreatePersonalAccessToken(context.Context, *CreatePersonalAccessTokenRequest) (*PersonalAccessTokenSecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePersonalAccessToken not implemented")
+}
+func (UnimplementedPersonalAccessTokenServiceServer) ListPersonalAccessTokens(context.Context, *ListPersonalAccessTokensRequest) (*ListPersonalAccessTokensResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPersonalAccessTokens not implemented")
+}
+func (UnimplementedPersonalAccessTokenServiceServer) RevokePersonalAccessToken(context.Context, *RevokePersonalAccessTokenRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokePersonalAccessToken not implemented")
+}
+func (UnimplementedPersonalAccessTokenServiceServer) mustEmbedUnimplementedPersonalAccessTokenServiceServer() {
+}
+func (UnimplementedPersonalAccessTokenServiceServer) testEmbeddedByValue() {}
+
+// UnsafePersonalAccessTokenServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PersonalAccessTokenServiceServer will
+// result in compilation errors.
+type UnsafePersonalAccessTokenServiceServer interface {
+	mustEmbedUnimplementedPersonalAccessTokenServiceServer()
+}
+
+func RegisterPersonalAccessTokenServiceServer(s grpc.ServiceRegistrar, srv PersonalAccessTokenServiceServer) {
+	// If the following call panics, it indicates UnimplementedPersonalAccessTokenServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PersonalAccessTokenService_ServiceDesc, srv)
+}
+
+func _PersonalAccessTokenService_ListTokenScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonalAccessTokenServiceServer).ListTokenScopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersonalAccessTokenService_ListTokenScopes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonalAccessTokenServiceServer).ListTokenScopes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonalAccessTokenService_CreatePersonalAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePersonalAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonalAccessTokenServiceServer).CreatePersonalAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersonalAccessTokenService_CreatePersonalAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonalAccessTokenServiceServer).CreatePersonalAccessToken(ctx, req.(*CreatePersonalAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonalAccessTokenService_ListPersonalAccessTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPersonalAccessTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonalAccessTokenServiceServer).ListPersonalAccessTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersonalAccessTokenService_ListPersonalAccessTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonalAccessTokenServiceServer).ListPersonalAccessTokens(ctx, req.(*ListPersonalAccessTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonalAccessTokenService_RevokePersonalAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokePersonalAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonalAccessTokenServiceServer).RevokePersonalAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PersonalAccessTokenService_RevokePersonalAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonalAccessTokenServiceServer).RevokePersonalAccessToken(ctx, req.(*RevokePersonalAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PersonalAccessTokenService_ServiceDesc is the grpc.ServiceDesc for PersonalAccessTokenService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PersonalAccessTokenService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.PersonalAccessTokenService",
+	HandlerType: (*PersonalAccessTokenServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTokenScopes",
+			Handler:    _PersonalAccessTokenService_ListTokenScopes_Handler,
+		},
+		{
+			MethodName: "CreatePersonalAccessToken",
+			Handler:    _PersonalAccessTokenService_CreatePersonalAccessToken_Handler,
+		},
+		{
+			MethodName: "ListPersonalAccessTokens",
+			Handler:    _PersonalAccessTokenService_ListPersonalAccessTokens_Handler,
+		},
+		{
+			MethodName: "RevokePersonalAccessToken",
+			Handler:    _PersonalAccessTokenService_RevokePersonalAccessToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth.proto",
+}
+
+const (
+	LoginAlertService_ListLoginAlerts_FullMethodName   = "/auth.LoginAlertService/ListLoginAlerts"
+	LoginAlertService_ConfirmLoginAlert_FullMethodName = "/auth.LoginAlertService/ConfirmLoginAlert"
+)
+
+// LoginAlertServiceClient is the client API for LoginAlertService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ============== Login Alert Service ==============
+// Login Alert Service - "was this you?" confirmations for logins from a new device or IP
+type LoginAlertServiceClient interface {
+	ListLoginAlerts(ctx context.Context, in *ListLoginAlertsRequest, opts ...grpc.CallOption) (*ListLoginAlertsResponse, error)
+	ConfirmLoginAlert(ctx context.Context, in *ConfirmLoginAlertRequest, opts ...grpc.CallOption) (*LoginAlertResponse, error)
+}
+
+type loginAlertServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLoginAlertServiceClient(cc grpc.ClientConnInterface) LoginAlertServiceClient {
+	return &loginAlertServiceClient{cc}
+}
+
+func (c *loginAlertServiceClient) ListLoginAlerts(ctx context.Context, in *ListLoginAlertsRequest, opts ...grpc.CallOption) (*ListLoginAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoginAlertsResponse)
+	err := c.cc.Invoke(ctx, LoginAlertService_ListLoginAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginAlertServiceClient) ConfirmLoginAlert(ctx context.Context, in *ConfirmLoginAlertRequest, opts ...grpc.CallOption) (*LoginAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginAlertResponse)
+	err := c.cc.Invoke(ctx, LoginAlertService_ConfirmLoginAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LoginAlertServiceServer is the server API for LoginAlertService service.
+// All implementations must embed UnimplementedLoginAlertServiceServer
+// for forward compatibility.
+//
+// ============== Login Alert Service ==============
+// Login Alert Service - "was this you?" confirmations for logins from a new device or IP
+type LoginAlertServiceServer interface {
+	ListLoginAlerts(context.Context, *ListLoginAlertsRequest) (*ListLoginAlertsResponse, error)
+	ConfirmLoginAlert(context.Context, *ConfirmLoginAlertRequest) (*LoginAlertResponse, error)
+	mustEmbedUnimplementedLoginAlertServiceServer()
+}
+
+// UnimplementedLoginAlertServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLoginAlertServiceServer struct{}
+
+func (UnimplementedLoginAlertServiceServer) ListLoginAlerts(context.Context, *ListLoginAlertsRequest) (*ListLoginAlertsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLoginAlerts not implemented")
+}
+func (UnimplementedLoginAlertServiceServer) ConfirmLoginAlert(context.Context, *ConfirmLoginAlertRequest) (*LoginAlertResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmLoginAlert not implemented")
+}
+func (UnimplementedLoginAlertServiceServer) mustEmbedUnimplementedLoginAlertServiceServer() {}
+func (UnimplementedLoginAlertServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeLoginAlertServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LoginAlertServiceServer will
+// result in compilation errors.
+type UnsafeLoginAlertServiceServer interface {
+	mustEmbedUnimplementedLoginAlertServiceServer()
+}
+
+func RegisterLoginAlertServiceServer(s grpc.ServiceRegistrar, srv LoginAlertServiceServer) {
+	// If the following call panics, it indicates UnimplementedLoginAlertServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LoginAlertService_ServiceDesc, srv)
+}
+
+func _LoginAlertService_ListLoginAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoginAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginAlertServiceServer).ListLoginAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoginAlertService_ListLoginAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginAlertServiceServer).ListLoginAlerts(ctx, req.(*ListLoginAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginAlertService_ConfirmLoginAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmLoginAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginAlertServiceServer).ConfirmLoginAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoginAlertService_ConfirmLoginAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginAlertServiceServer).ConfirmLoginAlert(ctx, req.(*ConfirmLoginAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LoginAlertService_ServiceDesc is the grpc.ServiceDesc for LoginAlertService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LoginAlertService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.LoginAlertService",
+	HandlerType: (*LoginAlertServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListLoginAlerts",
+			Handler:    _LoginAlertService_ListLoginAlerts_Handler,
+		},
+		{
+			MethodName: "ConfirmLoginAlert",
+			Handler:    _LoginAlertService_ConfirmLoginAlert_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth.proto",
+}
+
+const (
+	LoginHistoryService_GetLoginHistory_FullMethodName = "/auth.LoginHistoryService/GetLoginHistory"
+)
+
+// LoginHistoryServiceClient is the client API for LoginHistoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ============== Login History Service ==============
+// Login History Service - the user's recent logins, newest first, so they can
+// spot access they do not recognise
+type LoginHistoryServiceClient interface {
+	GetLoginHistory(ctx context.Context, in *GetLoginHistoryRequest, opts ...grpc.CallOption) (*GetLoginHistoryResponse, error)
+}
+
+type loginHistoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLoginHistoryServiceClient(cc grpc.ClientConnInterface) LoginHistoryServiceClient {
+	return &loginHistoryServiceClient{cc}
+}
+
+func (c *loginHistoryServiceClient) GetLoginHistory(ctx context.Context, in *GetLoginHistoryRequest, opts ...grpc.CallOption) (*GetLoginHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLoginHistoryResponse)
+	err := c.cc.Invoke(ctx, LoginHistoryService_GetLoginHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LoginHistoryServiceServer is the server API for LoginHistoryService service.
+// All implementations must embed UnimplementedLoginHistoryServiceServer
+// for forward compatibility.
+//
+// ============== Login History Service ==============
+// Login History Service - the user's recent logins, newest first, so they can
+// spot access they do not recognise
+type LoginHistoryServiceServer interface {
+	GetLoginHistory(context.Context, *GetLoginHistoryRequest) (*GetLoginHistoryResponse, error)
+	mustEmbedUnimplementedLoginHistoryServiceServer()
+}
+
+// UnimplementedLoginHistoryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLoginHistoryServiceServer struct{}
+
+func (UnimplementedLoginHistoryServiceServer) GetLoginHistory(context.Context, *GetLoginHistoryRequest) (*GetLoginHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLoginHistory not implemented")
+}
+func (UnimplementedLoginHistoryServiceServer) mustEmbedUnimplementedLoginHistoryServiceServer() {}
+func (UnimplementedLoginHistoryServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeLoginHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LoginHistoryServiceServer will
+// result in compilation errors.
+type UnsafeLoginHistoryServiceServer interface {
+	mustEmbedUnimplementedLoginHistoryServiceServer()
+}
+
+func RegisterLoginHistoryServiceServer(s grpc.ServiceRegistrar, srv LoginHistoryServiceServer) {
+	// If the following call panics, it indicates UnimplementedLoginHistoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LoginHistoryService_ServiceDesc, srv)
+}
+
+func _LoginHistoryService_GetLoginHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoginHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginHistoryServiceServer).GetLoginHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoginHistoryService_GetLoginHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginHistoryServiceServer).GetLoginHistory(ctx, req.(*GetLoginHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LoginHistoryService_ServiceDesc is the grpc.ServiceDesc for LoginHistoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LoginHistoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.LoginHistoryService",
+	HandlerType: (*LoginHistoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLoginHistory",
+			Handler:    _LoginHistoryService_GetLoginHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth.proto",
+}
+
+const (
+	MagicLinkService_RequestMagicLink_FullMethodName = "/auth.MagicLinkService/RequestMagicLink"
+	MagicLinkService_ConsumeMagicLink_FullMethodName = "/auth.MagicLinkService/ConsumeMagicLink"
+)
+
+// MagicLinkServiceClient is the client API for MagicLinkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ============== Magic Link Service ==============
+// Magic Link Service - password-less email login alongside the OAuth flow,
+// for users who lost access to their phone
+type MagicLinkServiceClient interface {
+	RequestMagicLink(ctx context.Context, in *RequestMagicLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ConsumeMagicLink(ctx context.Context, in *ConsumeMagicLinkRequest, opts ...grpc.CallOption) (*CallbackResponse, error)
+}
+
+type magicLinkServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMagicLinkServiceClient(cc grpc.ClientConnInterface) MagicLinkServiceClient {
+	return &magicLinkServiceClient{cc}
+}
+
+func (c *magicLinkServiceClient) RequestMagicLink(ctx context.Context, in *RequestMagicLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MagicLinkService_RequestMagicLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *magicLinkServiceClient) ConsumeMagicLink(ctx context.Context, in *ConsumeMagicLinkRequest, opts ...grpc.CallOption) (*CallbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CallbackResponse)
+	err := c.cc.Invoke(ctx, MagicLinkService_ConsumeMagicLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MagicLinkServiceServer is the server API for MagicLinkService service.
+// All implementations must embed UnimplementedMagicLinkServiceServer
+// for forward compatibility.
+//
+// ============== Magic Link Service ==============
+// Magic Link Service - password-less email login alongside the OAuth flow,
+// for users who lost access to their phone
+type MagicLinkServiceServer interface {
+	RequestMagicLink(context.Context, *RequestMagicLinkRequest) (*emptypb.Empty, error)
+	ConsumeMagicLink(context.Context, *ConsumeMagicLinkRequest) (*CallbackResponse, error)
+	mustEmbedUnimplementedMagicLinkServiceServer()
+}
+
+// UnimplementedMagicLinkServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMagicLinkServiceServer struct{}
+
+func (UnimplementedMagicLinkServiceServer) RequestMagicLink(context.Context, *RequestMagicLinkRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestMagicLink not implemented")
+}
+func (UnimplementedMagicLinkServiceServer) ConsumeMagicLink(context.Context, *ConsumeMagicLinkRequest) (*CallbackResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConsumeMagicLink not implemented")
+}
+func (UnimplementedMagicLinkServiceServer) mustEmbedUnimplementedMagicLinkServiceServer() {}
+func (UnimplementedMagicLinkServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeMagicLinkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MagicLinkServiceServer will
+// result in compilation errors.
+type UnsafeMagicLinkServiceServer interface {
+	mustEmbedUnimplementedMagicLinkServiceServer()
+}
+
+func RegisterMagicLinkServiceServer(s grpc.ServiceRegistrar, srv MagicLinkServiceServer) {
+	// If the following call panics, it indicates UnimplementedMagicLinkServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MagicLinkService_ServiceDesc, srv)
+}
+
+func _MagicLinkService_RequestMagicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestMagicLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MagicLinkServiceServer).RequestMagicLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MagicLinkService_RequestMagicLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MagicLinkServiceServer).RequestMagicLink(ctx, req.(*RequestMagicLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MagicLinkService_ConsumeMagicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumeMagicLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MagicLinkServiceServer).ConsumeMagicLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MagicLinkService_ConsumeMagicLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MagicLinkServiceServer).ConsumeMagicLink(ctx, req.(*ConsumeMagicLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MagicLinkService_ServiceDesc is the grpc.ServiceDesc for MagicLinkService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MagicLinkService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.MagicLinkService",
+	HandlerType: (*MagicLinkServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestMagicLink",
+			Handler:    _MagicLinkService_RequestMagicLink_Handler,
+		},
+		{
+			MethodName: "ConsumeMagicLink",
+			Handler:    _MagicLinkService_ConsumeMagicLink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth.proto",
+}
@@ -715,6 +715,646 @@ func (x *LatestVersionResponse) GetVersionTitle() string {
 	return ""
 }
 
+type RespondToEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                                     // going, maybe or declined
+	RemindMinutes int32                  `protobuf:"varint,4,opt,name=remind_minutes,json=remindMinutes,proto3" json:"remind_minutes,omitempty"` // remind this many minutes before the event starts, 0 for no reminder
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToEventRequest) Reset() {
+	*x = RespondToEventRequest{}
+	mi := &file_calendar_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToEventRequest) ProtoMessage() {}
+
+func (x *RespondToEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToEventRequest.ProtoReflect.Descriptor instead.
+func (*RespondToEventRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RespondToEventRequest) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *RespondToEventRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RespondToEventRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RespondToEventRequest) GetRemindMinutes() int32 {
+	if x != nil {
+		return x.RemindMinutes
+	}
+	return 0
+}
+
+type GetRsvpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRsvpRequest) Reset() {
+	*x = GetRsvpRequest{}
+	mi := &file_calendar_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRsvpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRsvpRequest) ProtoMessage() {}
+
+func (x *GetRsvpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRsvpRequest.ProtoReflect.Descriptor instead.
+func (*GetRsvpRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetRsvpRequest) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *GetRsvpRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RsvpCounts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Going         int32                  `protobuf:"varint,1,opt,name=going,proto3" json:"going,omitempty"`
+	Maybe         int32                  `protobuf:"varint,2,opt,name=maybe,proto3" json:"maybe,omitempty"`
+	Declined      int32                  `protobuf:"varint,3,opt,name=declined,proto3" json:"declined,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RsvpCounts) Reset() {
+	*x = RsvpCounts{}
+	mi := &file_calendar_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RsvpCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsvpCounts) ProtoMessage() {}
+
+func (x *RsvpCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsvpCounts.ProtoReflect.Descriptor instead.
+func (*RsvpCounts) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RsvpCounts) GetGoing() int32 {
+	if x != nil {
+		return x.Going
+	}
+	return 0
+}
+
+func (x *RsvpCounts) GetMaybe() int32 {
+	if x != nil {
+		return x.Maybe
+	}
+	return 0
+}
+
+func (x *RsvpCounts) GetDeclined() int32 {
+	if x != nil {
+		return x.Declined
+	}
+	return 0
+}
+
+type RsvpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // empty if the user has not responded
+	RemindMinutes int32                  `protobuf:"varint,3,opt,name=remind_minutes,json=remindMinutes,proto3" json:"remind_minutes,omitempty"`
+	RemindAt      string                 `protobuf:"bytes,4,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"` // Jalali formatted Y/m/d H:i, empty without a reminder
+	Counts        *RsvpCounts            `protobuf:"bytes,5,opt,name=counts,proto3" json:"counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RsvpResponse) Reset() {
+	*x = RsvpResponse{}
+	mi := &file_calendar_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RsvpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsvpResponse) ProtoMessage() {}
+
+func (x *RsvpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsvpResponse.ProtoReflect.Descriptor instead.
+func (*RsvpResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RsvpResponse) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *RsvpResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RsvpResponse) GetRemindMinutes() int32 {
+	if x != nil {
+		return x.RemindMinutes
+	}
+	return 0
+}
+
+func (x *RsvpResponse) GetRemindAt() string {
+	if x != nil {
+		return x.RemindAt
+	}
+	return ""
+}
+
+func (x *RsvpResponse) GetCounts() *RsvpCounts {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type ListAttendeesRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	EventId       uint64                    `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Status        string                    `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // going, maybe or declined, defaults to going
+	Pagination    *common.PaginationRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttendeesRequest) Reset() {
+	*x = ListAttendeesRequest{}
+	mi := &file_calendar_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttendeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttendeesRequest) ProtoMessage() {}
+
+func (x *ListAttendeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttendeesRequest.ProtoReflect.Descriptor instead.
+func (*ListAttendeesRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListAttendeesRequest) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *ListAttendeesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListAttendeesRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type Attendee struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	RespondedAt   string                 `protobuf:"bytes,5,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"` // Jalali formatted Y/m/d H:i
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attendee) Reset() {
+	*x = Attendee{}
+	mi := &file_calendar_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attendee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attendee) ProtoMessage() {}
+
+func (x *Attendee) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attendee.ProtoReflect.Descriptor instead.
+func (*Attendee) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Attendee) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Attendee) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Attendee) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Attendee) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Attendee) GetRespondedAt() string {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return ""
+}
+
+type AttendeesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attendees     []*Attendee            `protobuf:"bytes,1,rep,name=attendees,proto3" json:"attendees,omitempty"`
+	Pagination    *common.PaginationMeta `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendeesResponse) Reset() {
+	*x = AttendeesResponse{}
+	mi := &file_calendar_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendeesResponse) ProtoMessage() {}
+
+func (x *AttendeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendeesResponse.ProtoReflect.Descriptor instead.
+func (*AttendeesResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AttendeesResponse) GetAttendees() []*Attendee {
+	if x != nil {
+		return x.Attendees
+	}
+	return nil
+}
+
+func (x *AttendeesResponse) GetPagination() *common.PaginationMeta {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetOccasionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`   // Jalali year
+	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"` // Jalali month 1-12, 0 for the whole year
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOccasionsRequest) Reset() {
+	*x = GetOccasionsRequest{}
+	mi := &file_calendar_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOccasionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOccasionsRequest) ProtoMessage() {}
+
+func (x *GetOccasionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOccasionsRequest.ProtoReflect.Descriptor instead.
+func (*GetOccasionsRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetOccasionsRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetOccasionsRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+type Occasion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JalaliDate    string                 `protobuf:"bytes,1,opt,name=jalali_date,json=jalaliDate,proto3" json:"jalali_date,omitempty"`          // Y/m/d
+	GregorianDate string                 `protobuf:"bytes,2,opt,name=gregorian_date,json=gregorianDate,proto3" json:"gregorian_date,omitempty"` // Y-m-d
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	IsHoliday     bool                   `protobuf:"varint,4,opt,name=is_holiday,json=isHoliday,proto3" json:"is_holiday,omitempty"`
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`                // "solar" or "lunar"
+	Approximate   bool                   `protobuf:"varint,6,opt,name=approximate,proto3" json:"approximate,omitempty"` // computed lunar date, may differ from the official date by a day
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Occasion) Reset() {
+	*x = Occasion{}
+	mi := &file_calendar_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Occasion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Occasion) ProtoMessage() {}
+
+func (x *Occasion) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Occasion.ProtoReflect.Descriptor instead.
+func (*Occasion) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Occasion) GetJalaliDate() string {
+	if x != nil {
+		return x.JalaliDate
+	}
+	return ""
+}
+
+func (x *Occasion) GetGregorianDate() string {
+	if x != nil {
+		return x.GregorianDate
+	}
+	return ""
+}
+
+func (x *Occasion) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Occasion) GetIsHoliday() bool {
+	if x != nil {
+		return x.IsHoliday
+	}
+	return false
+}
+
+func (x *Occasion) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Occasion) GetApproximate() bool {
+	if x != nil {
+		return x.Approximate
+	}
+	return false
+}
+
+type OccasionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Occasions     []*Occasion            `protobuf:"bytes,3,rep,name=occasions,proto3" json:"occasions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OccasionsResponse) Reset() {
+	*x = OccasionsResponse{}
+	mi := &file_calendar_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OccasionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OccasionsResponse) ProtoMessage() {}
+
+func (x *OccasionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OccasionsResponse.ProtoReflect.Descriptor instead.
+func (*OccasionsResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *OccasionsResponse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *OccasionsResponse) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *OccasionsResponse) GetOccasions() []*Occasion {
+	if x != nil {
+		return x.Occasions
+	}
+	return nil
+}
+
 var File_calendar_proto protoreflect.FileDescriptor
 
 const file_calendar_proto_rawDesc = "" +
@@ -773,13 +1413,71 @@ const file_calendar_proto_rawDesc = "" +
 	"\thas_liked\x18\x01 \x01(\bR\bhasLiked\x12!\n" +
 	"\fhas_disliked\x18\x02 \x01(\bR\vhasDisliked\"<\n" +
 	"\x15LatestVersionResponse\x12#\n" +
-	"\rversion_title\x18\x01 \x01(\tR\fversionTitle2\x95\x03\n" +
+	"\rversion_title\x18\x01 \x01(\tR\fversionTitle\"\x8a\x01\n" +
+	"\x15RespondToEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12%\n" +
+	"\x0eremind_minutes\x18\x04 \x01(\x05R\rremindMinutes\"D\n" +
+	"\x0eGetRsvpRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"T\n" +
+	"\n" +
+	"RsvpCounts\x12\x14\n" +
+	"\x05going\x18\x01 \x01(\x05R\x05going\x12\x14\n" +
+	"\x05maybe\x18\x02 \x01(\x05R\x05maybe\x12\x1a\n" +
+	"\bdeclined\x18\x03 \x01(\x05R\bdeclined\"\xb3\x01\n" +
+	"\fRsvpResponse\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12%\n" +
+	"\x0eremind_minutes\x18\x03 \x01(\x05R\rremindMinutes\x12\x1b\n" +
+	"\tremind_at\x18\x04 \x01(\tR\bremindAt\x12,\n" +
+	"\x06counts\x18\x05 \x01(\v2\x14.calendar.RsvpCountsR\x06counts\"\x84\x01\n" +
+	"\x14ListAttendeesRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\"\x86\x01\n" +
+	"\bAttendee\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12!\n" +
+	"\fresponded_at\x18\x05 \x01(\tR\vrespondedAt\"}\n" +
+	"\x11AttendeesResponse\x120\n" +
+	"\tattendees\x18\x01 \x03(\v2\x12.calendar.AttendeeR\tattendees\x126\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x16.common.PaginationMetaR\n" +
+	"pagination\"?\n" +
+	"\x13GetOccasionsRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\"\xbd\x01\n" +
+	"\bOccasion\x12\x1f\n" +
+	"\vjalali_date\x18\x01 \x01(\tR\n" +
+	"jalaliDate\x12%\n" +
+	"\x0egregorian_date\x18\x02 \x01(\tR\rgregorianDate\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"is_holiday\x18\x04 \x01(\bR\tisHoliday\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12 \n" +
+	"\vapproximate\x18\x06 \x01(\bR\vapproximate\"o\n" +
+	"\x11OccasionsResponse\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\x120\n" +
+	"\toccasions\x18\x03 \x03(\v2\x12.calendar.OccasionR\toccasions2\x95\x03\n" +
 	"\x0fCalendarService\x12A\n" +
 	"\tGetEvents\x12\x1a.calendar.GetEventsRequest\x1a\x18.calendar.EventsResponse\x12>\n" +
 	"\bGetEvent\x12\x19.calendar.GetEventRequest\x1a\x17.calendar.EventResponse\x12[\n" +
 	"\x11FilterByDateRange\x12\".calendar.FilterByDateRangeRequest\x1a\".calendar.SimplifiedEventsResponse\x12V\n" +
 	"\x10GetLatestVersion\x12!.calendar.GetLatestVersionRequest\x1a\x1f.calendar.LatestVersionResponse\x12J\n" +
-	"\x0eAddInteraction\x12\x1f.calendar.AddInteractionRequest\x1a\x17.calendar.EventResponseB\x1cZ\x1ametargb/shared/pb/calendarb\x06proto3"
+	"\x0eAddInteraction\x12\x1f.calendar.AddInteractionRequest\x1a\x17.calendar.EventResponse2\xee\x01\n" +
+	"\x16EventAttendanceService\x12I\n" +
+	"\x0eRespondToEvent\x12\x1f.calendar.RespondToEventRequest\x1a\x16.calendar.RsvpResponse\x12;\n" +
+	"\aGetRsvp\x12\x18.calendar.GetRsvpRequest\x1a\x16.calendar.RsvpResponse\x12L\n" +
+	"\rListAttendees\x12\x1e.calendar.ListAttendeesRequest\x1a\x1b.calendar.AttendeesResponse2]\n" +
+	"\x0fOccasionService\x12J\n" +
+	"\fGetOccasions\x12\x1d.calendar.GetOccasionsRequest\x1a\x1b.calendar.OccasionsResponseB\x1cZ\x1ametargb/shared/pb/calendarb\x06proto3"
 
 var (
 	file_calendar_proto_rawDescOnce sync.Once
@@ -793,7 +1491,7 @@ func file_calendar_proto_rawDescGZIP() []byte {
 	return file_calendar_proto_rawDescData
 }
 
-var file_calendar_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_calendar_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_calendar_proto_goTypes = []any{
 	(*GetEventsRequest)(nil),         // 0: calendar.GetEventsRequest
 	(*GetEventRequest)(nil),          // 1: calendar.GetEventRequest
@@ -806,30 +1504,53 @@ var file_calendar_proto_goTypes = []any{
 	(*SimplifiedEventsResponse)(nil), // 8: calendar.SimplifiedEventsResponse
 	(*UserInteraction)(nil),          // 9: calendar.UserInteraction
 	(*LatestVersionResponse)(nil),    // 10: calendar.LatestVersionResponse
-	(*common.PaginationRequest)(nil), // 11: common.PaginationRequest
-	(*common.PaginationMeta)(nil),    // 12: common.PaginationMeta
+	(*RespondToEventRequest)(nil),    // 11: calendar.RespondToEventRequest
+	(*GetRsvpRequest)(nil),           // 12: calendar.GetRsvpRequest
+	(*RsvpCounts)(nil),               // 13: calendar.RsvpCounts
+	(*RsvpResponse)(nil),             // 14: calendar.RsvpResponse
+	(*ListAttendeesRequest)(nil),     // 15: calendar.ListAttendeesRequest
+	(*Attendee)(nil),                 // 16: calendar.Attendee
+	(*AttendeesResponse)(nil),        // 17: calendar.AttendeesResponse
+	(*GetOccasionsRequest)(nil),      // 18: calendar.GetOccasionsRequest
+	(*Occasion)(nil),                 // 19: calendar.Occasion
+	(*OccasionsResponse)(nil),        // 20: calendar.OccasionsResponse
+	(*common.PaginationRequest)(nil), // 21: common.PaginationRequest
+	(*common.PaginationMeta)(nil),    // 22: common.PaginationMeta
 }
 var file_calendar_proto_depIdxs = []int32{
-	11, // 0: calendar.GetEventsRequest.pagination:type_name -> common.PaginationRequest
+	21, // 0: calendar.GetEventsRequest.pagination:type_name -> common.PaginationRequest
 	9,  // 1: calendar.EventResponse.user_interaction:type_name -> calendar.UserInteraction
 	5,  // 2: calendar.EventsResponse.events:type_name -> calendar.EventResponse
-	12, // 3: calendar.EventsResponse.pagination:type_name -> common.PaginationMeta
+	22, // 3: calendar.EventsResponse.pagination:type_name -> common.PaginationMeta
 	7,  // 4: calendar.SimplifiedEventsResponse.events:type_name -> calendar.SimplifiedEventResponse
-	0,  // 5: calendar.CalendarService.GetEvents:input_type -> calendar.GetEventsRequest
-	1,  // 6: calendar.CalendarService.GetEvent:input_type -> calendar.GetEventRequest
-	2,  // 7: calendar.CalendarService.FilterByDateRange:input_type -> calendar.FilterByDateRangeRequest
-	3,  // 8: calendar.CalendarService.GetLatestVersion:input_type -> calendar.GetLatestVersionRequest
-	4,  // 9: calendar.CalendarService.AddInteraction:input_type -> calendar.AddInteractionRequest
-	6,  // 10: calendar.CalendarService.GetEvents:output_type -> calendar.EventsResponse
-	5,  // 11: calendar.CalendarService.GetEvent:output_type -> calendar.EventResponse
-	8,  // 12: calendar.CalendarService.FilterByDateRange:output_type -> calendar.SimplifiedEventsResponse
-	10, // 13: calendar.CalendarService.GetLatestVersion:output_type -> calendar.LatestVersionResponse
-	5,  // 14: calendar.CalendarService.AddInteraction:output_type -> calendar.EventResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	13, // 5: calendar.RsvpResponse.counts:type_name -> calendar.RsvpCounts
+	21, // 6: calendar.ListAttendeesRequest.pagination:type_name -> common.PaginationRequest
+	16, // 7: calendar.AttendeesResponse.attendees:type_name -> calendar.Attendee
+	22, // 8: calendar.AttendeesResponse.pagination:type_name -> common.PaginationMeta
+	19, // 9: calendar.OccasionsResponse.occasions:type_name -> calendar.Occasion
+	0,  // 10: calendar.CalendarService.GetEvents:input_type -> calendar.GetEventsRequest
+	1,  // 11: calendar.CalendarService.GetEvent:input_type -> calendar.GetEventRequest
+	2,  // 12: calendar.CalendarService.FilterByDateRange:input_type -> calendar.FilterByDateRangeRequest
+	3,  // 13: calendar.CalendarService.GetLatestVersion:input_type -> calendar.GetLatestVersionRequest
+	4,  // 14: calendar.CalendarService.AddInteraction:input_type -> calendar.AddInteractionRequest
+	11, // 15: calendar.EventAttendanceService.RespondToEvent:input_type -> calendar.RespondToEventRequest
+	12, // 16: calendar.EventAttendanceService.GetRsvp:input_type -> calendar.GetRsvpRequest
+	15, // 17: calendar.EventAttendanceService.ListAttendees:input_type -> calendar.ListAttendeesRequest
+	18, // 18: calendar.OccasionService.GetOccasions:input_type -> calendar.GetOccasionsRequest
+	6,  // 19: calendar.CalendarService.GetEvents:output_type -> calendar.EventsResponse
+	5,  // 20: calendar.CalendarService.GetEvent:output_type -> calendar.EventResponse
+	8,  // 21: calendar.CalendarService.FilterByDateRange:output_type -> calendar.SimplifiedEventsResponse
+	10, // 22: calendar.CalendarService.GetLatestVersion:output_type -> calendar.LatestVersionResponse
+	5,  // 23: calendar.CalendarService.AddInteraction:output_type -> calendar.EventResponse
+	14, // 24: calendar.EventAttendanceService.RespondToEvent:output_type -> calendar.RsvpResponse
+	14, // 25: calendar.EventAttendanceService.GetRsvp:output_type -> calendar.RsvpResponse
+	17, // 26: calendar.EventAttendanceService.ListAttendees:output_type -> calendar.AttendeesResponse
+	20, // 27: calendar.OccasionService.GetOccasions:output_type -> calendar.OccasionsResponse
+	19, // [19:28] is the sub-list for method output_type
+	10, // [10:19] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_calendar_proto_init() }
@@ -843,9 +1564,9 @@ func file_calendar_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_calendar_proto_rawDesc), len(file_calendar_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   21,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   3,
 		},
 		GoTypes:           file_calendar_proto_goTypes,
 		DependencyIndexes: file_calendar_proto_depIdxs,
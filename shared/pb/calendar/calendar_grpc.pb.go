@@ -275,3 +275,292 @@ var CalendarService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "calendar.proto",
 }
+
+const (
+	EventAttendanceService_RespondToEvent_FullMethodName = "/calendar.EventAttendanceService/RespondToEvent"
+	EventAttendanceService_GetRsvp_FullMethodName        = "/calendar.EventAttendanceService/GetRsvp"
+	EventAttendanceService_ListAttendees_FullMethodName  = "/calendar.EventAttendanceService/ListAttendees"
+)
+
+// EventAttendanceServiceClient is the client API for EventAttendanceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// EventAttendanceService handles RSVPs and reminders for calendar events
+type EventAttendanceServiceClient interface {
+	RespondToEvent(ctx context.Context, in *RespondToEventRequest, opts ...grpc.CallOption) (*RsvpResponse, error)
+	GetRsvp(ctx context.Context, in *GetRsvpRequest, opts ...grpc.CallOption) (*RsvpResponse, error)
+	ListAttendees(ctx context.Context, in *ListAttendeesRequest, opts ...grpc.CallOption) (*AttendeesResponse, error)
+}
+
+type eventAttendanceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventAttendanceServiceClient(cc grpc.ClientConnInterface) EventAttendanceServiceClient {
+	return &eventAttendanceServiceClient{cc}
+}
+
+func (c *eventAttendanceServiceClient) RespondToEvent(ctx context.Context, in *RespondToEventRequest, opts ...grpc.CallOption) (*RsvpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RsvpResponse)
+	err := c.cc.Invoke(ctx, EventAttendanceService_RespondToEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventAttendanceServiceClient) GetRsvp(ctx context.Context, in *GetRsvpRequest, opts ...grpc.CallOption) (*RsvpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RsvpResponse)
+	err := c.cc.Invoke(ctx, EventAttendanceService_GetRsvp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventAttendanceServiceClient) ListAttendees(ctx context.Context, in *ListAttendeesRequest, opts ...grpc.CallOption) (*AttendeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttendeesResponse)
+	err := c.cc.Invoke(ctx, EventAttendanceService_ListAttendees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventAttendanceServiceServer is the server API for EventAttendanceService service.
+// All implementations must embed UnimplementedEventAttendanceServiceServer
+// for forward compatibility.
+//
+// EventAttendanceService handles RSVPs and reminders for calendar events
+type EventAttendanceServiceServer interface {
+	RespondToEvent(context.Context, *RespondToEventRequest) (*RsvpResponse, error)
+	GetRsvp(context.Context, *GetRsvpRequest) (*RsvpResponse, error)
+	ListAttendees(context.Context, *ListAttendeesRequest) (*AttendeesResponse, error)
+	mustEmbedUnimplementedEventAttendanceServiceServer()
+}
+
+// UnimplementedEventAttendanceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEventAttendanceServiceServer struct{}
+
+func (UnimplementedEventAttendanceServiceServer) RespondToEvent(context.Context, *RespondToEventRequest) (*RsvpResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RespondToEvent not implemented")
+}
+func (UnimplementedEventAttendanceServiceServer) GetRsvp(context.Context, *GetRsvpRequest) (*RsvpResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRsvp not implemented")
+}
+func (UnimplementedEventAttendanceServiceServer) ListAttendees(context.Context, *ListAttendeesRequest) (*AttendeesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAttendees not implemented")
+}
+func (UnimplementedEventAttendanceServiceServer) mustEmbedUnimplementedEventAttendanceServiceServer() {
+}
+func (UnimplementedEventAttendanceServiceServer) testEmbeddedByValue() {}
+
+// UnsafeEventAttendanceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventAttendanceServiceServer will
+// result in compilation errors.
+type UnsafeEventAttendanceServiceServer interface {
+	mustEmbedUnimplementedEventAttendanceServiceServer()
+}
+
+func RegisterEventAttendanceServiceServer(s grpc.ServiceRegistrar, srv EventAttendanceServiceServer) {
+	// If the following call panics, it indicates UnimplementedEventAttendanceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EventAttendanceService_ServiceDesc, srv)
+}
+
+func _EventAttendanceService_RespondToEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventAttendanceServiceServer).RespondToEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventAttendanceService_RespondToEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventAttendanceServiceServer).RespondToEvent(ctx, req.(*RespondToEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventAttendanceService_GetRsvp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRsvpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventAttendanceServiceServer).GetRsvp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventAttendanceService_GetRsvp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventAttendanceServiceServer).GetRsvp(ctx, req.(*GetRsvpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventAttendanceService_ListAttendees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttendeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventAttendanceServiceServer).ListAttendees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventAttendanceService_ListAttendees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventAttendanceServiceServer).ListAttendees(ctx, req.(*ListAttendeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EventAttendanceService_ServiceDesc is the grpc.ServiceDesc for EventAttendanceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventAttendanceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "calendar.EventAttendanceService",
+	HandlerType: (*EventAttendanceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RespondToEvent",
+			Handler:    _EventAttendanceService_RespondToEvent_Handler,
+		},
+		{
+			MethodName: "GetRsvp",
+			Handler:    _EventAttendanceService_GetRsvp_Handler,
+		},
+		{
+			MethodName: "ListAttendees",
+			Handler:    _EventAttendanceService_ListAttendees_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "calendar.proto",
+}
+
+const (
+	OccasionService_GetOccasions_FullMethodName = "/calendar.OccasionService/GetOccasions"
+)
+
+// OccasionServiceClient is the client API for OccasionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// OccasionService serves official Iranian holidays and occasions
+type OccasionServiceClient interface {
+	GetOccasions(ctx context.Context, in *GetOccasionsRequest, opts ...grpc.CallOption) (*OccasionsResponse, error)
+}
+
+type occasionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOccasionServiceClient(cc grpc.ClientConnInterface) OccasionServiceClient {
+	return &occasionServiceClient{cc}
+}
+
+func (c *occasionServiceClient) GetOccasions(ctx context.Context, in *GetOccasionsRequest, opts ...grpc.CallOption) (*OccasionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OccasionsResponse)
+	err := c.cc.Invoke(ctx, OccasionService_GetOccasions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OccasionServiceServer is the server API for OccasionService service.
+// All implementations must embed UnimplementedOccasionServiceServer
+// for forward compatibility.
+//
+// OccasionService serves official Iranian holidays and occasions
+type OccasionServiceServer interface {
+	GetOccasions(context.Context, *GetOccasionsRequest) (*OccasionsResponse, error)
+	mustEmbedUnimplementedOccasionServiceServer()
+}
+
+// UnimplementedOccasionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOccasionServiceServer struct{}
+
+func (UnimplementedOccasionServiceServer) GetOccasions(context.Context, *GetOccasionsRequest) (*OccasionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOccasions not implemented")
+}
+func (UnimplementedOccasionServiceServer) mustEmbedUnimplementedOccasionServiceServer() {}
+func (UnimplementedOccasionServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeOccasionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OccasionServiceServer will
+// result in compilation errors.
+type UnsafeOccasionServiceServer interface {
+	mustEmbedUnimplementedOccasionServiceServer()
+}
+
+func RegisterOccasionServiceServer(s grpc.ServiceRegistrar, srv OccasionServiceServer) {
+	// If the following call panics, it indicates UnimplementedOccasionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OccasionService_ServiceDesc, srv)
+}
+
+func _OccasionService_GetOccasions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOccasionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OccasionServiceServer).GetOccasions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OccasionService_GetOccasions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OccasionServiceServer).GetOccasions(ctx, req.(*GetOccasionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OccasionService_ServiceDesc is the grpc.ServiceDesc for OccasionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OccasionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "calendar.OccasionService",
+	HandlerType: (*OccasionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOccasions",
+			Handler:    _OccasionService_GetOccasions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "calendar.proto",
+}
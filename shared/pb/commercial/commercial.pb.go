@@ -161,6 +161,7 @@ type Transaction struct {
 	PayableId     uint64                 `protobuf:"varint,10,opt,name=payable_id,json=payableId,proto3" json:"payable_id,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AmountDecimal string                 `protobuf:"bytes,13,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"` // Exact amount, amount is rounded past 15 digits
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -279,6 +280,13 @@ func (x *Transaction) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Transaction) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
 type Order struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -287,6 +295,7 @@ type Order struct {
 	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AmountDecimal string                 `protobuf:"bytes,7,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"` // Exact amount, amount is rounded past 15 digits
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -363,6 +372,13 @@ func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Order) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
 type Payment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -373,6 +389,7 @@ type Payment struct {
 	Amount        float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
 	Product       string                 `protobuf:"bytes,7,opt,name=product,proto3" json:"product,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AmountDecimal string                 `protobuf:"bytes,9,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"` // Exact amount, amount is rounded past 15 digits
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -463,6 +480,13 @@ func (x *Payment) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Payment) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
 type GetWalletRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -600,10 +624,14 @@ func (x *WalletResponse) GetEffect() float64 {
 }
 
 type DeductBalanceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"` // psc, irr, red, blue, yellow
-	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Asset  string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"` // psc, irr, red, blue, yellow
+	Amount float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Takes back funds the user received, e.g. proceeds of a refunded trade.
+	// Reclaims are not spending and ignore spending limits.
+	Reclaim       bool   `protobuf:"varint,4,opt,name=reclaim,proto3" json:"reclaim,omitempty"`
+	AmountDecimal string `protobuf:"bytes,5,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"` // Exact amount, used instead of amount when set
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -659,6 +687,20 @@ func (x *DeductBalanceRequest) GetAmount() float64 {
 	return 0
 }
 
+func (x *DeductBalanceRequest) GetReclaim() bool {
+	if x != nil {
+		return x.Reclaim
+	}
+	return false
+}
+
+func (x *DeductBalanceRequest) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
 type DeductBalanceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -724,6 +766,7 @@ type AddBalanceRequest struct {
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
 	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountDecimal string                 `protobuf:"bytes,4,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"` // Exact amount, used instead of amount when set
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -779,6 +822,13 @@ func (x *AddBalanceRequest) GetAmount() float64 {
 	return 0
 }
 
+func (x *AddBalanceRequest) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
 type AddBalanceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -845,6 +895,7 @@ type LockBalanceRequest struct {
 	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
 	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	AmountDecimal string                 `protobuf:"bytes,5,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"` // Exact amount, used instead of amount when set
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -907,11 +958,19 @@ func (x *LockBalanceRequest) GetReason() string {
 	return ""
 }
 
+func (x *LockBalanceRequest) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
 type UnlockBalanceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
 	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountDecimal string                 `protobuf:"bytes,4,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"` // Exact amount, used instead of amount when set
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -967,6 +1026,13 @@ func (x *UnlockBalanceRequest) GetAmount() float64 {
 	return 0
 }
 
+func (x *UnlockBalanceRequest) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
 type ListTransactionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -978,7 +1044,10 @@ type ListTransactionsRequest struct {
 	Status        []int32                `protobuf:"varint,7,rep,packed,name=status,proto3" json:"status,omitempty"`
 	Action        string                 `protobuf:"bytes,8,opt,name=action,proto3" json:"action,omitempty"`
 	Asset         string                 `protobuf:"bytes,9,opt,name=asset,proto3" json:"asset,omitempty"`
-	Type          string                 `protobuf:"bytes,10,opt,name=type,proto3" json:"type,omitempty"`
+	Type          string                 `protobuf:"bytes,10,opt,name=type,proto3" json:"type,omitempty"`                              // trade, buy_request, order, installment_plan, wallet_adjustment
+	MinAmount     float64                `protobuf:"fixed64,11,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"` // inclusive, 0 for no lower bound
+	MaxAmount     float64                `protobuf:"fixed64,12,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"` // inclusive, 0 for no upper bound
+	Cursor        string                 `protobuf:"bytes,13,opt,name=cursor,proto3" json:"cursor,omitempty"`                          // next_cursor of the previous page, replaces page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1083,11 +1152,33 @@ func (x *ListTransactionsRequest) GetType() string {
 	return ""
 }
 
+func (x *ListTransactionsRequest) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetMaxAmount() float64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type ListTransactionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Transactions  []*TransactionResource `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
 	CurrentPage   int32                  `protobuf:"varint,2,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
 	HasMorePages  bool                   `protobuf:"varint,3,opt,name=has_more_pages,json=hasMorePages,proto3" json:"has_more_pages,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1143,6 +1234,13 @@ func (x *ListTransactionsResponse) GetHasMorePages() bool {
 	return false
 }
 
+func (x *ListTransactionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type TransactionResource struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1151,8 +1249,9 @@ type TransactionResource struct {
 	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	Action        string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
 	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
-	Date          string                 `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"` // Jalali format Y/m/d
-	Time          string                 `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"` // Jalali format H:m:s
+	Date          string                 `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"`                                        // Jalali format Y/m/d
+	Time          string                 `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"`                                        // Jalali format H:m:s
+	AmountDecimal string                 `protobuf:"bytes,9,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"` // Exact amount, amount is rounded past 15 digits
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1243,6 +1342,13 @@ func (x *TransactionResource) GetTime() string {
 	return ""
 }
 
+func (x *TransactionResource) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
 type GetLatestTransactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1356,6 +1462,7 @@ type CreateTransactionRequest struct {
 	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
 	PayableType   string                 `protobuf:"bytes,6,opt,name=payable_type,json=payableType,proto3" json:"payable_type,omitempty"`
 	PayableId     uint64                 `protobuf:"varint,7,opt,name=payable_id,json=payableId,proto3" json:"payable_id,omitempty"`
+	AmountDecimal string                 `protobuf:"bytes,8,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"` // Exact amount, used instead of amount when set
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1439,11 +1546,21 @@ func (x *CreateTransactionRequest) GetPayableId() uint64 {
 	return 0
 }
 
+func (x *CreateTransactionRequest) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
 type InitiatePaymentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
 	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`                                            // Client IP, compared with the user's recent logins
+	Device        string                 `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`                                    // Client user agent, compared with the user's recent logins
+	AmountDecimal string                 `protobuf:"bytes,6,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"` // Exact amount, used instead of amount when set
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1499,29 +1616,52 @@ func (x *InitiatePaymentRequest) GetAmount() float64 {
 	return 0
 }
 
-type InitiatePaymentResponse struct {
+func (x *InitiatePaymentRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *InitiatePaymentRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *InitiatePaymentRequest) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+type ScreenPaymentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PaymentUrl    string                 `protobuf:"bytes,1,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
-	OrderId       uint64                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	TransactionId string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"` // Order amount in the asset
+	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`         // Client IP, compared with the user's recent logins
+	Device        string                 `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"` // Client user agent, compared with the user's recent logins
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InitiatePaymentResponse) Reset() {
-	*x = InitiatePaymentResponse{}
+func (x *ScreenPaymentRequest) Reset() {
+	*x = ScreenPaymentRequest{}
 	mi := &file_commercial_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InitiatePaymentResponse) String() string {
+func (x *ScreenPaymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitiatePaymentResponse) ProtoMessage() {}
+func (*ScreenPaymentRequest) ProtoMessage() {}
 
-func (x *InitiatePaymentResponse) ProtoReflect() protoreflect.Message {
+func (x *ScreenPaymentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_commercial_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1533,55 +1673,71 @@ func (x *InitiatePaymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitiatePaymentResponse.ProtoReflect.Descriptor instead.
-func (*InitiatePaymentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScreenPaymentRequest.ProtoReflect.Descriptor instead.
+func (*ScreenPaymentRequest) Descriptor() ([]byte, []int) {
 	return file_commercial_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *InitiatePaymentResponse) GetPaymentUrl() string {
+func (x *ScreenPaymentRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.PaymentUrl
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ScreenPaymentRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *InitiatePaymentResponse) GetOrderId() uint64 {
+func (x *ScreenPaymentRequest) GetAmount() string {
 	if x != nil {
-		return x.OrderId
+		return x.Amount
 	}
-	return 0
+	return ""
 }
 
-func (x *InitiatePaymentResponse) GetTransactionId() string {
+func (x *ScreenPaymentRequest) GetIp() string {
 	if x != nil {
-		return x.TransactionId
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ScreenPaymentRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
 	}
 	return ""
 }
 
-type HandleCallbackRequest struct {
+type ScreenPaymentCardRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	Token         int64                  `protobuf:"varint,3,opt,name=token,proto3" json:"token,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderId       uint64                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`                  // Order amount in the asset
+	CardPan       string                 `protobuf:"bytes,5,opt,name=card_pan,json=cardPan,proto3" json:"card_pan,omitempty"` // Masked card reported by the gateway callback
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HandleCallbackRequest) Reset() {
-	*x = HandleCallbackRequest{}
+func (x *ScreenPaymentCardRequest) Reset() {
+	*x = ScreenPaymentCardRequest{}
 	mi := &file_commercial_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HandleCallbackRequest) String() string {
+func (x *ScreenPaymentCardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HandleCallbackRequest) ProtoMessage() {}
+func (*ScreenPaymentCardRequest) ProtoMessage() {}
 
-func (x *HandleCallbackRequest) ProtoReflect() protoreflect.Message {
+func (x *ScreenPaymentCardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_commercial_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1593,55 +1749,68 @@ func (x *HandleCallbackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HandleCallbackRequest.ProtoReflect.Descriptor instead.
-func (*HandleCallbackRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScreenPaymentCardRequest.ProtoReflect.Descriptor instead.
+func (*ScreenPaymentCardRequest) Descriptor() ([]byte, []int) {
 	return file_commercial_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *HandleCallbackRequest) GetOrderId() uint64 {
+func (x *ScreenPaymentCardRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.OrderId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *HandleCallbackRequest) GetStatus() int32 {
+func (x *ScreenPaymentCardRequest) GetOrderId() uint64 {
 	if x != nil {
-		return x.Status
+		return x.OrderId
 	}
 	return 0
 }
 
-func (x *HandleCallbackRequest) GetToken() int64 {
+func (x *ScreenPaymentCardRequest) GetAsset() string {
 	if x != nil {
-		return x.Token
+		return x.Asset
 	}
-	return 0
+	return ""
+}
+
+func (x *ScreenPaymentCardRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ScreenPaymentCardRequest) GetCardPan() string {
+	if x != nil {
+		return x.CardPan
+	}
+	return ""
 }
 
-type HandleCallbackResponse struct {
+type SettleOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	RedirectUrl   string                 `protobuf:"bytes,2,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	RefId         int64                  `protobuf:"varint,2,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"` // Bank reference of the verified payment, announced with the paid state
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HandleCallbackResponse) Reset() {
-	*x = HandleCallbackResponse{}
+func (x *SettleOrderRequest) Reset() {
+	*x = SettleOrderRequest{}
 	mi := &file_commercial_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HandleCallbackResponse) String() string {
+func (x *SettleOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HandleCallbackResponse) ProtoMessage() {}
+func (*SettleOrderRequest) ProtoMessage() {}
 
-func (x *HandleCallbackResponse) ProtoReflect() protoreflect.Message {
+func (x *SettleOrderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_commercial_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1653,54 +1822,47 @@ func (x *HandleCallbackResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HandleCallbackResponse.ProtoReflect.Descriptor instead.
-func (*HandleCallbackResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SettleOrderRequest.ProtoReflect.Descriptor instead.
+func (*SettleOrderRequest) Descriptor() ([]byte, []int) {
 	return file_commercial_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *HandleCallbackResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *HandleCallbackResponse) GetRedirectUrl() string {
+func (x *SettleOrderRequest) GetOrderId() uint64 {
 	if x != nil {
-		return x.RedirectUrl
+		return x.OrderId
 	}
-	return ""
+	return 0
 }
 
-func (x *HandleCallbackResponse) GetMessage() string {
+func (x *SettleOrderRequest) GetRefId() int64 {
 	if x != nil {
-		return x.Message
+		return x.RefId
 	}
-	return ""
+	return 0
 }
 
-type VerifyPaymentRequest struct {
+type SettleOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         int64                  `protobuf:"varint,1,opt,name=token,proto3" json:"token,omitempty"`
-	MerchantId    string                 `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Credited      string                 `protobuf:"bytes,1,opt,name=credited,proto3" json:"credited,omitempty"` // Added to the wallet in the order's asset, bonus included
+	Bonus         string                 `protobuf:"bytes,2,opt,name=bonus,proto3" json:"bonus,omitempty"`       // First order bonus, 0 when not granted
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyPaymentRequest) Reset() {
-	*x = VerifyPaymentRequest{}
+func (x *SettleOrderResponse) Reset() {
+	*x = SettleOrderResponse{}
 	mi := &file_commercial_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyPaymentRequest) String() string {
+func (x *SettleOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyPaymentRequest) ProtoMessage() {}
+func (*SettleOrderResponse) ProtoMessage() {}
 
-func (x *VerifyPaymentRequest) ProtoReflect() protoreflect.Message {
+func (x *SettleOrderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_commercial_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1712,50 +1874,48 @@ func (x *VerifyPaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyPaymentRequest.ProtoReflect.Descriptor instead.
-func (*VerifyPaymentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SettleOrderResponse.ProtoReflect.Descriptor instead.
+func (*SettleOrderResponse) Descriptor() ([]byte, []int) {
 	return file_commercial_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *VerifyPaymentRequest) GetToken() int64 {
+func (x *SettleOrderResponse) GetCredited() string {
 	if x != nil {
-		return x.Token
+		return x.Credited
 	}
-	return 0
+	return ""
 }
 
-func (x *VerifyPaymentRequest) GetMerchantId() string {
+func (x *SettleOrderResponse) GetBonus() string {
 	if x != nil {
-		return x.MerchantId
+		return x.Bonus
 	}
 	return ""
 }
 
-type VerifyPaymentResponse struct {
+type PublishOrderStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	ReferenceId   int64                  `protobuf:"varint,3,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
-	CardHash      string                 `protobuf:"bytes,4,opt,name=card_hash,json=cardHash,proto3" json:"card_hash,omitempty"`
-	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`   // pending or failed
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // Shown on the payment page, e.g. the gateway's error
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyPaymentResponse) Reset() {
-	*x = VerifyPaymentResponse{}
+func (x *PublishOrderStatusRequest) Reset() {
+	*x = PublishOrderStatusRequest{}
 	mi := &file_commercial_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyPaymentResponse) String() string {
+func (x *PublishOrderStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyPaymentResponse) ProtoMessage() {}
+func (*PublishOrderStatusRequest) ProtoMessage() {}
 
-func (x *VerifyPaymentResponse) ProtoReflect() protoreflect.Message {
+func (x *PublishOrderStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_commercial_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1767,52 +1927,4734 @@ func (x *VerifyPaymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyPaymentResponse.ProtoReflect.Descriptor instead.
-func (*VerifyPaymentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublishOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*PublishOrderStatusRequest) Descriptor() ([]byte, []int) {
 	return file_commercial_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *VerifyPaymentResponse) GetSuccess() bool {
+func (x *PublishOrderStatusRequest) GetOrderId() uint64 {
 	if x != nil {
-		return x.Success
+		return x.OrderId
 	}
-	return false
+	return 0
 }
 
-func (x *VerifyPaymentResponse) GetStatus() int32 {
+func (x *PublishOrderStatusRequest) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *VerifyPaymentResponse) GetReferenceId() int64 {
+func (x *PublishOrderStatusRequest) GetMessage() string {
 	if x != nil {
-		return x.ReferenceId
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *VerifyPaymentResponse) GetCardHash() string {
+type InitiatePaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentUrl    string                 `protobuf:"bytes,1,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
+	OrderId       uint64                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitiatePaymentResponse) Reset() {
+	*x = InitiatePaymentResponse{}
+	mi := &file_commercial_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiatePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiatePaymentResponse) ProtoMessage() {}
+
+func (x *InitiatePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[24]
 	if x != nil {
-		return x.CardHash
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *VerifyPaymentResponse) GetMessage() string {
+// Deprecated: Use InitiatePaymentResponse.ProtoReflect.Descriptor instead.
+func (*InitiatePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *InitiatePaymentResponse) GetPaymentUrl() string {
 	if x != nil {
-		return x.Message
+		return x.PaymentUrl
 	}
 	return ""
 }
 
-var File_commercial_proto protoreflect.FileDescriptor
+func (x *InitiatePaymentResponse) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
 
-const file_commercial_proto_rawDesc = "" +
-	"\n" +
-	"\x10commercial.proto\x12\n" +
-	"commercial\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xc5\x02\n" +
+func (x *InitiatePaymentResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type VerifyPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         int64                  `protobuf:"varint,1,opt,name=token,proto3" json:"token,omitempty"`
+	MerchantId    string                 `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPaymentRequest) Reset() {
+	*x = VerifyPaymentRequest{}
+	mi := &file_commercial_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPaymentRequest) ProtoMessage() {}
+
+func (x *VerifyPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPaymentRequest.ProtoReflect.Descriptor instead.
+func (*VerifyPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *VerifyPaymentRequest) GetToken() int64 {
+	if x != nil {
+		return x.Token
+	}
+	return 0
+}
+
+func (x *VerifyPaymentRequest) GetMerchantId() string {
+	if x != nil {
+		return x.MerchantId
+	}
+	return ""
+}
+
+type VerifyPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	ReferenceId   int64                  `protobuf:"varint,3,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	CardHash      string                 `protobuf:"bytes,4,opt,name=card_hash,json=cardHash,proto3" json:"card_hash,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPaymentResponse) Reset() {
+	*x = VerifyPaymentResponse{}
+	mi := &file_commercial_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPaymentResponse) ProtoMessage() {}
+
+func (x *VerifyPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPaymentResponse.ProtoReflect.Descriptor instead.
+func (*VerifyPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *VerifyPaymentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *VerifyPaymentResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *VerifyPaymentResponse) GetReferenceId() int64 {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return 0
+}
+
+func (x *VerifyPaymentResponse) GetCardHash() string {
+	if x != nil {
+		return x.CardHash
+	}
+	return ""
+}
+
+func (x *VerifyPaymentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Status        []int32                `protobuf:"varint,4,rep,packed,name=status,proto3" json:"status,omitempty"`
+	Gateway       string                 `protobuf:"bytes,5,opt,name=gateway,proto3" json:"gateway,omitempty"`                   // Payment gateway, e.g. "parsian"
+	FromDate      string                 `protobuf:"bytes,6,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // Jalali Y/m/d or Gregorian Y-m-d, inclusive
+	ToDate        string                 `protobuf:"bytes,7,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`       // Jalali Y/m/d or Gregorian Y-m-d, inclusive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_commercial_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListOrdersRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetStatus() []int32 {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListOrdersRequest) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type ListOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*OrderResource       `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	CurrentPage   int32                  `protobuf:"varint,2,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	HasMorePages  bool                   `protobuf:"varint,5,opt,name=has_more_pages,json=hasMorePages,proto3" json:"has_more_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_commercial_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*OrderResource {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetCurrentPage() int32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *ListOrdersResponse) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListOrdersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListOrdersResponse) GetHasMorePages() bool {
+	if x != nil {
+		return x.HasMorePages
+	}
+	return false
+}
+
+type EvaluateFirstOrderBonusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`   // psc, irr, red, blue or yellow
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"` // Order amount in the asset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateFirstOrderBonusRequest) Reset() {
+	*x = EvaluateFirstOrderBonusRequest{}
+	mi := &file_commercial_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateFirstOrderBonusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateFirstOrderBonusRequest) ProtoMessage() {}
+
+func (x *EvaluateFirstOrderBonusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateFirstOrderBonusRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateFirstOrderBonusRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *EvaluateFirstOrderBonusRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EvaluateFirstOrderBonusRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *EvaluateFirstOrderBonusRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type FirstOrderBonusEvaluation struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Eligible bool                   `protobuf:"varint,1,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	// eligible, already_received, disabled, window_closed or below_minimum
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Bonus         string                 `protobuf:"bytes,3,opt,name=bonus,proto3" json:"bonus,omitempty"`                                     // In the order's asset, 0 unless eligible
+	BonusPercent  string                 `protobuf:"bytes,4,opt,name=bonus_percent,json=bonusPercent,proto3" json:"bonus_percent,omitempty"`   // first_order_bonus_percent
+	MinAmount     string                 `protobuf:"bytes,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`            // first_order_min_amount, IRR
+	WindowDays    int32                  `protobuf:"varint,6,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`        // first_order_window_days, 0 for no limit
+	WindowEndsAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=window_ends_at,json=windowEndsAt,proto3" json:"window_ends_at,omitempty"` // Unset without a window
+	IrrValue      string                 `protobuf:"bytes,8,opt,name=irr_value,json=irrValue,proto3" json:"irr_value,omitempty"`               // The order's value compared with min_amount
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FirstOrderBonusEvaluation) Reset() {
+	*x = FirstOrderBonusEvaluation{}
+	mi := &file_commercial_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FirstOrderBonusEvaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirstOrderBonusEvaluation) ProtoMessage() {}
+
+func (x *FirstOrderBonusEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirstOrderBonusEvaluation.ProtoReflect.Descriptor instead.
+func (*FirstOrderBonusEvaluation) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FirstOrderBonusEvaluation) GetEligible() bool {
+	if x != nil {
+		return x.Eligible
+	}
+	return false
+}
+
+func (x *FirstOrderBonusEvaluation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FirstOrderBonusEvaluation) GetBonus() string {
+	if x != nil {
+		return x.Bonus
+	}
+	return ""
+}
+
+func (x *FirstOrderBonusEvaluation) GetBonusPercent() string {
+	if x != nil {
+		return x.BonusPercent
+	}
+	return ""
+}
+
+func (x *FirstOrderBonusEvaluation) GetMinAmount() string {
+	if x != nil {
+		return x.MinAmount
+	}
+	return ""
+}
+
+func (x *FirstOrderBonusEvaluation) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+func (x *FirstOrderBonusEvaluation) GetWindowEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEndsAt
+	}
+	return nil
+}
+
+func (x *FirstOrderBonusEvaluation) GetIrrValue() string {
+	if x != nil {
+		return x.IrrValue
+	}
+	return ""
+}
+
+type OrderResource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	Gateway       string                 `protobuf:"bytes,5,opt,name=gateway,proto3" json:"gateway,omitempty"`                                  // Empty until a payment is recorded
+	RefId         string                 `protobuf:"bytes,6,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`                         // Payment reference ID, empty until paid
+	Date          string                 `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"`                                        // Jalali format Y/m/d
+	Time          string                 `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"`                                        // Jalali format H:m:s
+	AmountDecimal string                 `protobuf:"bytes,9,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"` // Exact amount, amount is rounded past 15 digits
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderResource) Reset() {
+	*x = OrderResource{}
+	mi := &file_commercial_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderResource) ProtoMessage() {}
+
+func (x *OrderResource) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderResource.ProtoReflect.Descriptor instead.
+func (*OrderResource) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *OrderResource) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrderResource) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *OrderResource) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *OrderResource) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *OrderResource) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *OrderResource) GetRefId() string {
+	if x != nil {
+		return x.RefId
+	}
+	return ""
+}
+
+func (x *OrderResource) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *OrderResource) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *OrderResource) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+type GetVariablesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"` // e.g. "psc", "red"; empty returns the asset rates
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVariablesRequest) Reset() {
+	*x = GetVariablesRequest{}
+	mi := &file_commercial_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVariablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVariablesRequest) ProtoMessage() {}
+
+func (x *GetVariablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVariablesRequest.ProtoReflect.Descriptor instead.
+func (*GetVariablesRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetVariablesRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type GetVariablesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        map[string]float64     `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"` // Unknown keys are omitted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVariablesResponse) Reset() {
+	*x = GetVariablesResponse{}
+	mi := &file_commercial_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVariablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVariablesResponse) ProtoMessage() {}
+
+func (x *GetVariablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVariablesResponse.ProtoReflect.Descriptor instead.
+func (*GetVariablesResponse) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetVariablesResponse) GetValues() map[string]float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type Variable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`   // rate, percent, amount or integer
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"` // Decimal string, empty while unset
+	Min           string                 `protobuf:"bytes,4,opt,name=min,proto3" json:"min,omitempty"`     // Inclusive bounds, empty when unbounded
+	Max           string                 `protobuf:"bytes,5,opt,name=max,proto3" json:"max,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Date          string                 `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"` // Jalali format Y/m/d of the last change, empty while unset
+	Time          string                 `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"` // Jalali format H:m:s
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Variable) Reset() {
+	*x = Variable{}
+	mi := &file_commercial_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Variable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variable) ProtoMessage() {}
+
+func (x *Variable) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variable.ProtoReflect.Descriptor instead.
+func (*Variable) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Variable) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Variable) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Variable) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Variable) GetMin() string {
+	if x != nil {
+		return x.Min
+	}
+	return ""
+}
+
+func (x *Variable) GetMax() string {
+	if x != nil {
+		return x.Max
+	}
+	return ""
+}
+
+func (x *Variable) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Variable) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Variable) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type ListVariablesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVariablesRequest) Reset() {
+	*x = ListVariablesRequest{}
+	mi := &file_commercial_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVariablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVariablesRequest) ProtoMessage() {}
+
+func (x *ListVariablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVariablesRequest.ProtoReflect.Descriptor instead.
+func (*ListVariablesRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{35}
+}
+
+type ListVariablesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variables     []*Variable            `protobuf:"bytes,1,rep,name=variables,proto3" json:"variables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVariablesResponse) Reset() {
+	*x = ListVariablesResponse{}
+	mi := &file_commercial_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVariablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVariablesResponse) ProtoMessage() {}
+
+func (x *ListVariablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVariablesResponse.ProtoReflect.Descriptor instead.
+func (*ListVariablesResponse) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListVariablesResponse) GetVariables() []*Variable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+type GetVariableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVariableRequest) Reset() {
+	*x = GetVariableRequest{}
+	mi := &file_commercial_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVariableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVariableRequest) ProtoMessage() {}
+
+func (x *GetVariableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVariableRequest.ProtoReflect.Descriptor instead.
+func (*GetVariableRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetVariableRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type SetVariableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"` // Why the value changed, kept in the history
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVariableRequest) Reset() {
+	*x = SetVariableRequest{}
+	mi := &file_commercial_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVariableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVariableRequest) ProtoMessage() {}
+
+func (x *SetVariableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVariableRequest.ProtoReflect.Descriptor instead.
+func (*SetVariableRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SetVariableRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetVariableRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SetVariableRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ListVariableChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Latest changes first, default 20, at most 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVariableChangesRequest) Reset() {
+	*x = ListVariableChangesRequest{}
+	mi := &file_commercial_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVariableChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVariableChangesRequest) ProtoMessage() {}
+
+func (x *ListVariableChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVariableChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListVariableChangesRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListVariableChangesRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ListVariableChangesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type VariableChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	PreviousValue string                 `protobuf:"bytes,3,opt,name=previous_value,json=previousValue,proto3" json:"previous_value,omitempty"`
+	CurrentValue  string                 `protobuf:"bytes,4,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`
+	ChangerId     uint64                 `protobuf:"varint,5,opt,name=changer_id,json=changerId,proto3" json:"changer_id,omitempty"` // 0 for changes made before the admin API
+	ChangerName   string                 `protobuf:"bytes,6,opt,name=changer_name,json=changerName,proto3" json:"changer_name,omitempty"`
+	Note          string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	Date          string                 `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"` // Jalali format Y/m/d
+	Time          string                 `protobuf:"bytes,9,opt,name=time,proto3" json:"time,omitempty"` // Jalali format H:m:s
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariableChange) Reset() {
+	*x = VariableChange{}
+	mi := &file_commercial_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariableChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableChange) ProtoMessage() {}
+
+func (x *VariableChange) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableChange.ProtoReflect.Descriptor instead.
+func (*VariableChange) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VariableChange) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VariableChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *VariableChange) GetPreviousValue() string {
+	if x != nil {
+		return x.PreviousValue
+	}
+	return ""
+}
+
+func (x *VariableChange) GetCurrentValue() string {
+	if x != nil {
+		return x.CurrentValue
+	}
+	return ""
+}
+
+func (x *VariableChange) GetChangerId() uint64 {
+	if x != nil {
+		return x.ChangerId
+	}
+	return 0
+}
+
+func (x *VariableChange) GetChangerName() string {
+	if x != nil {
+		return x.ChangerName
+	}
+	return ""
+}
+
+func (x *VariableChange) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *VariableChange) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *VariableChange) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type ListVariableChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*VariableChange      `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVariableChangesResponse) Reset() {
+	*x = ListVariableChangesResponse{}
+	mi := &file_commercial_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVariableChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVariableChangesResponse) ProtoMessage() {}
+
+func (x *ListVariableChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVariableChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListVariableChangesResponse) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListVariableChangesResponse) GetChanges() []*VariableChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ScheduleVariableChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`                                // Validated like SetVariableRequest.value
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`                                  // Kept in the change history once applied
+	EffectiveAt   string                 `protobuf:"bytes,4,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"` // RFC3339, in the future
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleVariableChangeRequest) Reset() {
+	*x = ScheduleVariableChangeRequest{}
+	mi := &file_commercial_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleVariableChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleVariableChangeRequest) ProtoMessage() {}
+
+func (x *ScheduleVariableChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleVariableChangeRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleVariableChangeRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ScheduleVariableChangeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ScheduleVariableChangeRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ScheduleVariableChangeRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ScheduleVariableChangeRequest) GetEffectiveAt() string {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return ""
+}
+
+type ScheduledVariableChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	EffectiveAt   string                 `protobuf:"bytes,5,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"` // RFC3339
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                              // pending, applied or canceled
+	CreatedBy     uint64                 `protobuf:"varint,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Date          string                 `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"` // Jalali format Y/m/d of effective_at
+	Time          string                 `protobuf:"bytes,9,opt,name=time,proto3" json:"time,omitempty"` // Jalali format H:m:s
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledVariableChange) Reset() {
+	*x = ScheduledVariableChange{}
+	mi := &file_commercial_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledVariableChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledVariableChange) ProtoMessage() {}
+
+func (x *ScheduledVariableChange) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledVariableChange.ProtoReflect.Descriptor instead.
+func (*ScheduledVariableChange) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ScheduledVariableChange) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduledVariableChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ScheduledVariableChange) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ScheduledVariableChange) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ScheduledVariableChange) GetEffectiveAt() string {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return ""
+}
+
+func (x *ScheduledVariableChange) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScheduledVariableChange) GetCreatedBy() uint64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *ScheduledVariableChange) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ScheduledVariableChange) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type ListScheduledVariableChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // Optional, empty lists the pending changes of every variable
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledVariableChangesRequest) Reset() {
+	*x = ListScheduledVariableChangesRequest{}
+	mi := &file_commercial_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledVariableChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledVariableChangesRequest) ProtoMessage() {}
+
+func (x *ListScheduledVariableChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledVariableChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledVariableChangesRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListScheduledVariableChangesRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListScheduledVariableChangesResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Changes       []*ScheduledVariableChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"` // Pending changes, soonest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledVariableChangesResponse) Reset() {
+	*x = ListScheduledVariableChangesResponse{}
+	mi := &file_commercial_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledVariableChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledVariableChangesResponse) ProtoMessage() {}
+
+func (x *ListScheduledVariableChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledVariableChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledVariableChangesResponse) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListScheduledVariableChangesResponse) GetChanges() []*ScheduledVariableChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type CancelScheduledVariableChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledVariableChangeRequest) Reset() {
+	*x = CancelScheduledVariableChangeRequest{}
+	mi := &file_commercial_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledVariableChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledVariableChangeRequest) ProtoMessage() {}
+
+func (x *CancelScheduledVariableChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledVariableChangeRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledVariableChangeRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CancelScheduledVariableChangeRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DisplayRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisplayRatesRequest) Reset() {
+	*x = DisplayRatesRequest{}
+	mi := &file_commercial_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisplayRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisplayRatesRequest) ProtoMessage() {}
+
+func (x *DisplayRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisplayRatesRequest.ProtoReflect.Descriptor instead.
+func (*DisplayRatesRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{47}
+}
+
+// DisplayRate is the price of one unit of an asset. The previous and change
+// fields are empty while rate_history has no record from 24 hours ago.
+type DisplayRate struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Asset            string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`                                                 // psc, red, blue or yellow
+	Irr              string                 `protobuf:"bytes,2,opt,name=irr,proto3" json:"irr,omitempty"`                                                     // IRR price
+	Psc              string                 `protobuf:"bytes,3,opt,name=psc,proto3" json:"psc,omitempty"`                                                     // PSC price, empty while the psc rate is unset
+	PreviousIrr      string                 `protobuf:"bytes,4,opt,name=previous_irr,json=previousIrr,proto3" json:"previous_irr,omitempty"`                  // IRR price 24 hours ago
+	IrrChange        string                 `protobuf:"bytes,5,opt,name=irr_change,json=irrChange,proto3" json:"irr_change,omitempty"`                        // irr - previous_irr
+	IrrChangePercent string                 `protobuf:"bytes,6,opt,name=irr_change_percent,json=irrChangePercent,proto3" json:"irr_change_percent,omitempty"` // Rounded to 2 decimals
+	PreviousPsc      string                 `protobuf:"bytes,7,opt,name=previous_psc,json=previousPsc,proto3" json:"previous_psc,omitempty"`                  // PSC price 24 hours ago
+	PscChangePercent string                 `protobuf:"bytes,8,opt,name=psc_change_percent,json=pscChangePercent,proto3" json:"psc_change_percent,omitempty"` // Rounded to 2 decimals, always 0 for psc
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DisplayRate) Reset() {
+	*x = DisplayRate{}
+	mi := &file_commercial_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisplayRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisplayRate) ProtoMessage() {}
+
+func (x *DisplayRate) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisplayRate.ProtoReflect.Descriptor instead.
+func (*DisplayRate) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DisplayRate) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *DisplayRate) GetIrr() string {
+	if x != nil {
+		return x.Irr
+	}
+	return ""
+}
+
+func (x *DisplayRate) GetPsc() string {
+	if x != nil {
+		return x.Psc
+	}
+	return ""
+}
+
+func (x *DisplayRate) GetPreviousIrr() string {
+	if x != nil {
+		return x.PreviousIrr
+	}
+	return ""
+}
+
+func (x *DisplayRate) GetIrrChange() string {
+	if x != nil {
+		return x.IrrChange
+	}
+	return ""
+}
+
+func (x *DisplayRate) GetIrrChangePercent() string {
+	if x != nil {
+		return x.IrrChangePercent
+	}
+	return ""
+}
+
+func (x *DisplayRate) GetPreviousPsc() string {
+	if x != nil {
+		return x.PreviousPsc
+	}
+	return ""
+}
+
+func (x *DisplayRate) GetPscChangePercent() string {
+	if x != nil {
+		return x.PscChangePercent
+	}
+	return ""
+}
+
+type DisplayRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*DisplayRate         `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"` // In the order psc, red, blue, yellow; unset rates are omitted
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`   // Jalali format Y/m/d the rates were read
+	Time          string                 `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`   // Jalali format H:m:s
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisplayRatesResponse) Reset() {
+	*x = DisplayRatesResponse{}
+	mi := &file_commercial_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisplayRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisplayRatesResponse) ProtoMessage() {}
+
+func (x *DisplayRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisplayRatesResponse.ProtoReflect.Descriptor instead.
+func (*DisplayRatesResponse) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DisplayRatesResponse) GetRates() []*DisplayRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *DisplayRatesResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DisplayRatesResponse) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type CreateAdjustmentBatchRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Reason string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// One "user_id,asset,amount" line per adjustment. Positive amounts credit
+	// the wallet, negative amounts debit it. A "user_id,asset,amount" header is
+	// allowed on the first line.
+	Entries       string `protobuf:"bytes,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAdjustmentBatchRequest) Reset() {
+	*x = CreateAdjustmentBatchRequest{}
+	mi := &file_commercial_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAdjustmentBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAdjustmentBatchRequest) ProtoMessage() {}
+
+func (x *CreateAdjustmentBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAdjustmentBatchRequest.ProtoReflect.Descriptor instead.
+func (*CreateAdjustmentBatchRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateAdjustmentBatchRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreateAdjustmentBatchRequest) GetEntries() string {
+	if x != nil {
+		return x.Entries
+	}
+	return ""
+}
+
+type ListAdjustmentBatchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // pending, executed, rejected; empty for all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdjustmentBatchesRequest) Reset() {
+	*x = ListAdjustmentBatchesRequest{}
+	mi := &file_commercial_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdjustmentBatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdjustmentBatchesRequest) ProtoMessage() {}
+
+func (x *ListAdjustmentBatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdjustmentBatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListAdjustmentBatchesRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListAdjustmentBatchesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListAdjustmentBatchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Batches       []*AdjustmentBatch     `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdjustmentBatchesResponse) Reset() {
+	*x = ListAdjustmentBatchesResponse{}
+	mi := &file_commercial_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdjustmentBatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdjustmentBatchesResponse) ProtoMessage() {}
+
+func (x *ListAdjustmentBatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdjustmentBatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListAdjustmentBatchesResponse) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListAdjustmentBatchesResponse) GetBatches() []*AdjustmentBatch {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+type GetAdjustmentBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       uint64                 `protobuf:"varint,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdjustmentBatchRequest) Reset() {
+	*x = GetAdjustmentBatchRequest{}
+	mi := &file_commercial_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdjustmentBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdjustmentBatchRequest) ProtoMessage() {}
+
+func (x *GetAdjustmentBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdjustmentBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetAdjustmentBatchRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetAdjustmentBatchRequest) GetBatchId() uint64 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+type ApproveAdjustmentBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       uint64                 `protobuf:"varint,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveAdjustmentBatchRequest) Reset() {
+	*x = ApproveAdjustmentBatchRequest{}
+	mi := &file_commercial_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveAdjustmentBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveAdjustmentBatchRequest) ProtoMessage() {}
+
+func (x *ApproveAdjustmentBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveAdjustmentBatchRequest.ProtoReflect.Descriptor instead.
+func (*ApproveAdjustmentBatchRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ApproveAdjustmentBatchRequest) GetBatchId() uint64 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+type RejectAdjustmentBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       uint64                 `protobuf:"varint,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectAdjustmentBatchRequest) Reset() {
+	*x = RejectAdjustmentBatchRequest{}
+	mi := &file_commercial_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectAdjustmentBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectAdjustmentBatchRequest) ProtoMessage() {}
+
+func (x *RejectAdjustmentBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectAdjustmentBatchRequest.ProtoReflect.Descriptor instead.
+func (*RejectAdjustmentBatchRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RejectAdjustmentBatchRequest) GetBatchId() uint64 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+func (x *RejectAdjustmentBatchRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AdjustmentBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // pending, executed, rejected
+	CreatedBy     uint64                 `protobuf:"varint,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	DecidedBy     uint64                 `protobuf:"varint,5,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"` // Approving or rejecting admin, 0 while pending
+	DecisionNote  string                 `protobuf:"bytes,6,opt,name=decision_note,json=decisionNote,proto3" json:"decision_note,omitempty"`
+	EntryCount    int32                  `protobuf:"varint,7,opt,name=entry_count,json=entryCount,proto3" json:"entry_count,omitempty"`
+	Entries       []*AdjustmentEntry     `protobuf:"bytes,8,rep,name=entries,proto3" json:"entries,omitempty"`                             // Only set for a single batch
+	Date          string                 `protobuf:"bytes,9,opt,name=date,proto3" json:"date,omitempty"`                                   // Jalali format Y/m/d
+	Time          string                 `protobuf:"bytes,10,opt,name=time,proto3" json:"time,omitempty"`                                  // Jalali format H:m:s
+	DecidedDate   string                 `protobuf:"bytes,11,opt,name=decided_date,json=decidedDate,proto3" json:"decided_date,omitempty"` // Jalali format Y/m/d, empty while pending
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustmentBatch) Reset() {
+	*x = AdjustmentBatch{}
+	mi := &file_commercial_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustmentBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustmentBatch) ProtoMessage() {}
+
+func (x *AdjustmentBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustmentBatch.ProtoReflect.Descriptor instead.
+func (*AdjustmentBatch) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AdjustmentBatch) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdjustmentBatch) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdjustmentBatch) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdjustmentBatch) GetCreatedBy() uint64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *AdjustmentBatch) GetDecidedBy() uint64 {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return 0
+}
+
+func (x *AdjustmentBatch) GetDecisionNote() string {
+	if x != nil {
+		return x.DecisionNote
+	}
+	return ""
+}
+
+func (x *AdjustmentBatch) GetEntryCount() int32 {
+	if x != nil {
+		return x.EntryCount
+	}
+	return 0
+}
+
+func (x *AdjustmentBatch) GetEntries() []*AdjustmentEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *AdjustmentBatch) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AdjustmentBatch) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *AdjustmentBatch) GetDecidedDate() string {
+	if x != nil {
+		return x.DecidedDate
+	}
+	return ""
+}
+
+type AdjustmentEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`                                    // Signed decimal, negative for debits
+	TransactionId string                 `protobuf:"bytes,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"` // Set once the batch is executed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustmentEntry) Reset() {
+	*x = AdjustmentEntry{}
+	mi := &file_commercial_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustmentEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustmentEntry) ProtoMessage() {}
+
+func (x *AdjustmentEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustmentEntry.ProtoReflect.Descriptor instead.
+func (*AdjustmentEntry) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AdjustmentEntry) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdjustmentEntry) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *AdjustmentEntry) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdjustmentEntry) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type CreateInstallmentPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeatureId     uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	Installments  int32                  `protobuf:"varint,2,opt,name=installments,proto3" json:"installments,omitempty"` // Scheduled installments after the down payment
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInstallmentPlanRequest) Reset() {
+	*x = CreateInstallmentPlanRequest{}
+	mi := &file_commercial_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInstallmentPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInstallmentPlanRequest) ProtoMessage() {}
+
+func (x *CreateInstallmentPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInstallmentPlanRequest.ProtoReflect.Descriptor instead.
+func (*CreateInstallmentPlanRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateInstallmentPlanRequest) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+func (x *CreateInstallmentPlanRequest) GetInstallments() int32 {
+	if x != nil {
+		return x.Installments
+	}
+	return 0
+}
+
+type ListInstallmentPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // active, completed, defaulted; empty for all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstallmentPlansRequest) Reset() {
+	*x = ListInstallmentPlansRequest{}
+	mi := &file_commercial_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstallmentPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstallmentPlansRequest) ProtoMessage() {}
+
+func (x *ListInstallmentPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstallmentPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListInstallmentPlansRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListInstallmentPlansRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListInstallmentPlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*InstallmentPlan     `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstallmentPlansResponse) Reset() {
+	*x = ListInstallmentPlansResponse{}
+	mi := &file_commercial_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstallmentPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstallmentPlansResponse) ProtoMessage() {}
+
+func (x *ListInstallmentPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstallmentPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListInstallmentPlansResponse) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListInstallmentPlansResponse) GetPlans() []*InstallmentPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+type GetInstallmentPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        uint64                 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstallmentPlanRequest) Reset() {
+	*x = GetInstallmentPlanRequest{}
+	mi := &file_commercial_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstallmentPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstallmentPlanRequest) ProtoMessage() {}
+
+func (x *GetInstallmentPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstallmentPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetInstallmentPlanRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetInstallmentPlanRequest) GetPlanId() uint64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+type PayInstallmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        uint64                 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayInstallmentRequest) Reset() {
+	*x = PayInstallmentRequest{}
+	mi := &file_commercial_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayInstallmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayInstallmentRequest) ProtoMessage() {}
+
+func (x *PayInstallmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayInstallmentRequest.ProtoReflect.Descriptor instead.
+func (*PayInstallmentRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PayInstallmentRequest) GetPlanId() uint64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+type InstallmentPlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FeatureId     uint64                 `protobuf:"varint,2,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	BuyerId       uint64                 `protobuf:"varint,3,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	SellerId      uint64                 `protobuf:"varint,4,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                     // active, completed, defaulted
+	TotalPsc      string                 `protobuf:"bytes,6,opt,name=total_psc,json=totalPsc,proto3" json:"total_psc,omitempty"` // Price plus buyer fee
+	TotalIrr      string                 `protobuf:"bytes,7,opt,name=total_irr,json=totalIrr,proto3" json:"total_irr,omitempty"`
+	PaidPsc       string                 `protobuf:"bytes,8,opt,name=paid_psc,json=paidPsc,proto3" json:"paid_psc,omitempty"`
+	PaidIrr       string                 `protobuf:"bytes,9,opt,name=paid_irr,json=paidIrr,proto3" json:"paid_irr,omitempty"`
+	RefundedPsc   string                 `protobuf:"bytes,10,opt,name=refunded_psc,json=refundedPsc,proto3" json:"refunded_psc,omitempty"` // Returned to the buyer on default
+	RefundedIrr   string                 `protobuf:"bytes,11,opt,name=refunded_irr,json=refundedIrr,proto3" json:"refunded_irr,omitempty"`
+	TradeId       uint64                 `protobuf:"varint,12,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`         // Set once the feature is transferred
+	Installments  []*Installment         `protobuf:"bytes,13,rep,name=installments,proto3" json:"installments,omitempty"`               // Only set for a single plan
+	Date          string                 `protobuf:"bytes,14,opt,name=date,proto3" json:"date,omitempty"`                               // Jalali format Y/m/d
+	Time          string                 `protobuf:"bytes,15,opt,name=time,proto3" json:"time,omitempty"`                               // Jalali format H:m:s
+	ClosedDate    string                 `protobuf:"bytes,16,opt,name=closed_date,json=closedDate,proto3" json:"closed_date,omitempty"` // Jalali format Y/m/d, empty while active
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallmentPlan) Reset() {
+	*x = InstallmentPlan{}
+	mi := &file_commercial_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallmentPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallmentPlan) ProtoMessage() {}
+
+func (x *InstallmentPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallmentPlan.ProtoReflect.Descriptor instead.
+func (*InstallmentPlan) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *InstallmentPlan) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InstallmentPlan) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+func (x *InstallmentPlan) GetBuyerId() uint64 {
+	if x != nil {
+		return x.BuyerId
+	}
+	return 0
+}
+
+func (x *InstallmentPlan) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *InstallmentPlan) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InstallmentPlan) GetTotalPsc() string {
+	if x != nil {
+		return x.TotalPsc
+	}
+	return ""
+}
+
+func (x *InstallmentPlan) GetTotalIrr() string {
+	if x != nil {
+		return x.TotalIrr
+	}
+	return ""
+}
+
+func (x *InstallmentPlan) GetPaidPsc() string {
+	if x != nil {
+		return x.PaidPsc
+	}
+	return ""
+}
+
+func (x *InstallmentPlan) GetPaidIrr() string {
+	if x != nil {
+		return x.PaidIrr
+	}
+	return ""
+}
+
+func (x *InstallmentPlan) GetRefundedPsc() string {
+	if x != nil {
+		return x.RefundedPsc
+	}
+	return ""
+}
+
+func (x *InstallmentPlan) GetRefundedIrr() string {
+	if x != nil {
+		return x.RefundedIrr
+	}
+	return ""
+}
+
+func (x *InstallmentPlan) GetTradeId() uint64 {
+	if x != nil {
+		return x.TradeId
+	}
+	return 0
+}
+
+func (x *InstallmentPlan) GetInstallments() []*Installment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+func (x *InstallmentPlan) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *InstallmentPlan) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *InstallmentPlan) GetClosedDate() string {
+	if x != nil {
+		return x.ClosedDate
+	}
+	return ""
+}
+
+type Installment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sequence      int32                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"` // 0 is the down payment
+	AmountPsc     string                 `protobuf:"bytes,2,opt,name=amount_psc,json=amountPsc,proto3" json:"amount_psc,omitempty"`
+	AmountIrr     string                 `protobuf:"bytes,3,opt,name=amount_irr,json=amountIrr,proto3" json:"amount_irr,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                     // pending, paid
+	DueDate       string                 `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`    // Jalali format Y/m/d
+	PaidDate      string                 `protobuf:"bytes,6,opt,name=paid_date,json=paidDate,proto3" json:"paid_date,omitempty"` // Jalali format Y/m/d, empty until paid
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Installment) Reset() {
+	*x = Installment{}
+	mi := &file_commercial_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Installment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Installment) ProtoMessage() {}
+
+func (x *Installment) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Installment.ProtoReflect.Descriptor instead.
+func (*Installment) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Installment) GetSequence() int32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Installment) GetAmountPsc() string {
+	if x != nil {
+		return x.AmountPsc
+	}
+	return ""
+}
+
+func (x *Installment) GetAmountIrr() string {
+	if x != nil {
+		return x.AmountIrr
+	}
+	return ""
+}
+
+func (x *Installment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Installment) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *Installment) GetPaidDate() string {
+	if x != nil {
+		return x.PaidDate
+	}
+	return ""
+}
+
+type ListExchangeRatesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeDisabled bool                   `protobuf:"varint,1,opt,name=include_disabled,json=includeDisabled,proto3" json:"include_disabled,omitempty"` // Only honoured for wallet admins
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesRequest) Reset() {
+	*x = ListExchangeRatesRequest{}
+	mi := &file_commercial_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListExchangeRatesRequest) GetIncludeDisabled() bool {
+	if x != nil {
+		return x.IncludeDisabled
+	}
+	return false
+}
+
+type ListExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesResponse) Reset() {
+	*x = ListExchangeRatesResponse{}
+	mi := &file_commercial_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesResponse) ProtoMessage() {}
+
+func (x *ListExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListExchangeRatesResponse) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type SetExchangeRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromAsset     string                 `protobuf:"bytes,1,opt,name=from_asset,json=fromAsset,proto3" json:"from_asset,omitempty"` // red, blue, yellow
+	ToAsset       string                 `protobuf:"bytes,2,opt,name=to_asset,json=toAsset,proto3" json:"to_asset,omitempty"`
+	Rate          string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`                               // Units of to_asset per unit of from_asset
+	FeePercent    string                 `protobuf:"bytes,4,opt,name=fee_percent,json=feePercent,proto3" json:"fee_percent,omitempty"` // Taken from the converted amount, 0 to 100
+	Enabled       bool                   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangeRateRequest) Reset() {
+	*x = SetExchangeRateRequest{}
+	mi := &file_commercial_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeRateRequest) ProtoMessage() {}
+
+func (x *SetExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SetExchangeRateRequest) GetFromAsset() string {
+	if x != nil {
+		return x.FromAsset
+	}
+	return ""
+}
+
+func (x *SetExchangeRateRequest) GetToAsset() string {
+	if x != nil {
+		return x.ToAsset
+	}
+	return ""
+}
+
+func (x *SetExchangeRateRequest) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *SetExchangeRateRequest) GetFeePercent() string {
+	if x != nil {
+		return x.FeePercent
+	}
+	return ""
+}
+
+func (x *SetExchangeRateRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type ExchangeRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromAsset     string                 `protobuf:"bytes,1,opt,name=from_asset,json=fromAsset,proto3" json:"from_asset,omitempty"`
+	ToAsset       string                 `protobuf:"bytes,2,opt,name=to_asset,json=toAsset,proto3" json:"to_asset,omitempty"`
+	Rate          string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	FeePercent    string                 `protobuf:"bytes,4,opt,name=fee_percent,json=feePercent,proto3" json:"fee_percent,omitempty"`
+	Enabled       bool                   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	UpdatedBy     uint64                 `protobuf:"varint,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Date          string                 `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"` // Jalali format Y/m/d of the last change
+	Time          string                 `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"` // Jalali format H:m:s
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_commercial_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ExchangeRate) GetFromAsset() string {
+	if x != nil {
+		return x.FromAsset
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetToAsset() string {
+	if x != nil {
+		return x.ToAsset
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetFeePercent() string {
+	if x != nil {
+		return x.FeePercent
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ExchangeRate) GetUpdatedBy() uint64 {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type ConvertRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	FromAsset string                 `protobuf:"bytes,1,opt,name=from_asset,json=fromAsset,proto3" json:"from_asset,omitempty"`
+	ToAsset   string                 `protobuf:"bytes,2,opt,name=to_asset,json=toAsset,proto3" json:"to_asset,omitempty"`
+	Amount    string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"` // Amount of from_asset to convert
+	// The conversion fails instead of paying out less than min_receive of
+	// to_asset, e.g. when the rate changed after the user saw it
+	MinReceive    string `protobuf:"bytes,4,opt,name=min_receive,json=minReceive,proto3" json:"min_receive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertRequest) Reset() {
+	*x = ConvertRequest{}
+	mi := &file_commercial_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertRequest) ProtoMessage() {}
+
+func (x *ConvertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertRequest.ProtoReflect.Descriptor instead.
+func (*ConvertRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ConvertRequest) GetFromAsset() string {
+	if x != nil {
+		return x.FromAsset
+	}
+	return ""
+}
+
+func (x *ConvertRequest) GetToAsset() string {
+	if x != nil {
+		return x.ToAsset
+	}
+	return ""
+}
+
+func (x *ConvertRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ConvertRequest) GetMinReceive() string {
+	if x != nil {
+		return x.MinReceive
+	}
+	return ""
+}
+
+type Conversion struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromAsset             string                 `protobuf:"bytes,2,opt,name=from_asset,json=fromAsset,proto3" json:"from_asset,omitempty"`
+	ToAsset               string                 `protobuf:"bytes,3,opt,name=to_asset,json=toAsset,proto3" json:"to_asset,omitempty"`
+	Amount                string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`     // Debited from_asset
+	Received              string                 `protobuf:"bytes,5,opt,name=received,proto3" json:"received,omitempty"` // Credited to_asset after the fee
+	Fee                   string                 `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee,omitempty"`           // Fee in to_asset
+	Rate                  string                 `protobuf:"bytes,7,opt,name=rate,proto3" json:"rate,omitempty"`         // Rate the conversion was made at
+	WithdrawTransactionId string                 `protobuf:"bytes,8,opt,name=withdraw_transaction_id,json=withdrawTransactionId,proto3" json:"withdraw_transaction_id,omitempty"`
+	DepositTransactionId  string                 `protobuf:"bytes,9,opt,name=deposit_transaction_id,json=depositTransactionId,proto3" json:"deposit_transaction_id,omitempty"`
+	Date                  string                 `protobuf:"bytes,10,opt,name=date,proto3" json:"date,omitempty"` // Jalali format Y/m/d
+	Time                  string                 `protobuf:"bytes,11,opt,name=time,proto3" json:"time,omitempty"` // Jalali format H:m:s
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Conversion) Reset() {
+	*x = Conversion{}
+	mi := &file_commercial_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Conversion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Conversion) ProtoMessage() {}
+
+func (x *Conversion) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Conversion.ProtoReflect.Descriptor instead.
+func (*Conversion) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Conversion) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Conversion) GetFromAsset() string {
+	if x != nil {
+		return x.FromAsset
+	}
+	return ""
+}
+
+func (x *Conversion) GetToAsset() string {
+	if x != nil {
+		return x.ToAsset
+	}
+	return ""
+}
+
+func (x *Conversion) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Conversion) GetReceived() string {
+	if x != nil {
+		return x.Received
+	}
+	return ""
+}
+
+func (x *Conversion) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *Conversion) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *Conversion) GetWithdrawTransactionId() string {
+	if x != nil {
+		return x.WithdrawTransactionId
+	}
+	return ""
+}
+
+func (x *Conversion) GetDepositTransactionId() string {
+	if x != nil {
+		return x.DepositTransactionId
+	}
+	return ""
+}
+
+func (x *Conversion) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Conversion) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type GetSpendingLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSpendingLimitsRequest) Reset() {
+	*x = GetSpendingLimitsRequest{}
+	mi := &file_commercial_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpendingLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendingLimitsRequest) ProtoMessage() {}
+
+func (x *GetSpendingLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendingLimitsRequest.ProtoReflect.Descriptor instead.
+func (*GetSpendingLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetSpendingLimitsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// Empty amounts keep the current limit
+type SetSpendingLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UpdatedBy     uint64                 `protobuf:"varint,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"` // Guardian making the change
+	DailyPsc      string                 `protobuf:"bytes,3,opt,name=daily_psc,json=dailyPsc,proto3" json:"daily_psc,omitempty"`
+	MonthlyPsc    string                 `protobuf:"bytes,4,opt,name=monthly_psc,json=monthlyPsc,proto3" json:"monthly_psc,omitempty"`
+	DailyIrr      string                 `protobuf:"bytes,5,opt,name=daily_irr,json=dailyIrr,proto3" json:"daily_irr,omitempty"`
+	MonthlyIrr    string                 `protobuf:"bytes,6,opt,name=monthly_irr,json=monthlyIrr,proto3" json:"monthly_irr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSpendingLimitsRequest) Reset() {
+	*x = SetSpendingLimitsRequest{}
+	mi := &file_commercial_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSpendingLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSpendingLimitsRequest) ProtoMessage() {}
+
+func (x *SetSpendingLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSpendingLimitsRequest.ProtoReflect.Descriptor instead.
+func (*SetSpendingLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SetSpendingLimitsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetSpendingLimitsRequest) GetUpdatedBy() uint64 {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return 0
+}
+
+func (x *SetSpendingLimitsRequest) GetDailyPsc() string {
+	if x != nil {
+		return x.DailyPsc
+	}
+	return ""
+}
+
+func (x *SetSpendingLimitsRequest) GetMonthlyPsc() string {
+	if x != nil {
+		return x.MonthlyPsc
+	}
+	return ""
+}
+
+func (x *SetSpendingLimitsRequest) GetDailyIrr() string {
+	if x != nil {
+		return x.DailyIrr
+	}
+	return ""
+}
+
+func (x *SetSpendingLimitsRequest) GetMonthlyIrr() string {
+	if x != nil {
+		return x.MonthlyIrr
+	}
+	return ""
+}
+
+type SpendingLimits struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Applies       bool                   `protobuf:"varint,2,opt,name=applies,proto3" json:"applies,omitempty"` // Limits are only enforced for users under 18
+	Psc           *AssetSpendingLimit    `protobuf:"bytes,3,opt,name=psc,proto3" json:"psc,omitempty"`
+	Irr           *AssetSpendingLimit    `protobuf:"bytes,4,opt,name=irr,proto3" json:"irr,omitempty"`
+	UpdatedBy     uint64                 `protobuf:"varint,5,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"` // 0 while the platform maximums apply
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpendingLimits) Reset() {
+	*x = SpendingLimits{}
+	mi := &file_commercial_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendingLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingLimits) ProtoMessage() {}
+
+func (x *SpendingLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingLimits.ProtoReflect.Descriptor instead.
+func (*SpendingLimits) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SpendingLimits) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SpendingLimits) GetApplies() bool {
+	if x != nil {
+		return x.Applies
+	}
+	return false
+}
+
+func (x *SpendingLimits) GetPsc() *AssetSpendingLimit {
+	if x != nil {
+		return x.Psc
+	}
+	return nil
+}
+
+func (x *SpendingLimits) GetIrr() *AssetSpendingLimit {
+	if x != nil {
+		return x.Irr
+	}
+	return nil
+}
+
+func (x *SpendingLimits) GetUpdatedBy() uint64 {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return 0
+}
+
+type AssetSpendingLimit struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Daily          string                 `protobuf:"bytes,1,opt,name=daily,proto3" json:"daily,omitempty"`
+	Monthly        string                 `protobuf:"bytes,2,opt,name=monthly,proto3" json:"monthly,omitempty"`
+	SpentToday     string                 `protobuf:"bytes,3,opt,name=spent_today,json=spentToday,proto3" json:"spent_today,omitempty"`               // Spent in the last 24 hours
+	SpentThisMonth string                 `protobuf:"bytes,4,opt,name=spent_this_month,json=spentThisMonth,proto3" json:"spent_this_month,omitempty"` // Spent in the last 30 days
+	MaxDaily       string                 `protobuf:"bytes,5,opt,name=max_daily,json=maxDaily,proto3" json:"max_daily,omitempty"`                     // Platform maximums, limits cannot exceed them
+	MaxMonthly     string                 `protobuf:"bytes,6,opt,name=max_monthly,json=maxMonthly,proto3" json:"max_monthly,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AssetSpendingLimit) Reset() {
+	*x = AssetSpendingLimit{}
+	mi := &file_commercial_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetSpendingLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetSpendingLimit) ProtoMessage() {}
+
+func (x *AssetSpendingLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetSpendingLimit.ProtoReflect.Descriptor instead.
+func (*AssetSpendingLimit) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AssetSpendingLimit) GetDaily() string {
+	if x != nil {
+		return x.Daily
+	}
+	return ""
+}
+
+func (x *AssetSpendingLimit) GetMonthly() string {
+	if x != nil {
+		return x.Monthly
+	}
+	return ""
+}
+
+func (x *AssetSpendingLimit) GetSpentToday() string {
+	if x != nil {
+		return x.SpentToday
+	}
+	return ""
+}
+
+func (x *AssetSpendingLimit) GetSpentThisMonth() string {
+	if x != nil {
+		return x.SpentThisMonth
+	}
+	return ""
+}
+
+func (x *AssetSpendingLimit) GetMaxDaily() string {
+	if x != nil {
+		return x.MaxDaily
+	}
+	return ""
+}
+
+func (x *AssetSpendingLimit) GetMaxMonthly() string {
+	if x != nil {
+		return x.MaxMonthly
+	}
+	return ""
+}
+
+type ListFraudReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // pending (default), approved, rejected
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFraudReviewsRequest) Reset() {
+	*x = ListFraudReviewsRequest{}
+	mi := &file_commercial_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFraudReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFraudReviewsRequest) ProtoMessage() {}
+
+func (x *ListFraudReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFraudReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListFraudReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListFraudReviewsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListFraudReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checks        []*FraudCheck          `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFraudReviewsResponse) Reset() {
+	*x = ListFraudReviewsResponse{}
+	mi := &file_commercial_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFraudReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFraudReviewsResponse) ProtoMessage() {}
+
+func (x *ListFraudReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFraudReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListFraudReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListFraudReviewsResponse) GetChecks() []*FraudCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+type ResolveFraudReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CheckId       uint64                 `protobuf:"varint,1,opt,name=check_id,json=checkId,proto3" json:"check_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveFraudReviewRequest) Reset() {
+	*x = ResolveFraudReviewRequest{}
+	mi := &file_commercial_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveFraudReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveFraudReviewRequest) ProtoMessage() {}
+
+func (x *ResolveFraudReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveFraudReviewRequest.ProtoReflect.Descriptor instead.
+func (*ResolveFraudReviewRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ResolveFraudReviewRequest) GetCheckId() uint64 {
+	if x != nil {
+		return x.CheckId
+	}
+	return 0
+}
+
+func (x *ResolveFraudReviewRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ResolveFraudReviewRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type FraudCheck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"` // payment, transfer
+	Asset         string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount        string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Decision      string                 `protobuf:"bytes,6,opt,name=decision,proto3" json:"decision,omitempty"`   // allow, review, deny
+	Reasons       []string               `protobuf:"bytes,7,rep,name=reasons,proto3" json:"reasons,omitempty"`     // velocity, login_mismatch, blocked_card
+	Reference     string                 `protobuf:"bytes,8,opt,name=reference,proto3" json:"reference,omitempty"` // Order id of card checks
+	Ip            string                 `protobuf:"bytes,9,opt,name=ip,proto3" json:"ip,omitempty"`
+	Device        string                 `protobuf:"bytes,10,opt,name=device,proto3" json:"device,omitempty"`
+	CardPan       string                 `protobuf:"bytes,11,opt,name=card_pan,json=cardPan,proto3" json:"card_pan,omitempty"`
+	ReviewStatus  string                 `protobuf:"bytes,12,opt,name=review_status,json=reviewStatus,proto3" json:"review_status,omitempty"` // pending, approved, rejected; empty unless decision is review
+	ReviewedBy    uint64                 `protobuf:"varint,13,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewNote    string                 `protobuf:"bytes,14,opt,name=review_note,json=reviewNote,proto3" json:"review_note,omitempty"`
+	Date          string                 `protobuf:"bytes,15,opt,name=date,proto3" json:"date,omitempty"`                                     // Jalali format Y/m/d
+	Time          string                 `protobuf:"bytes,16,opt,name=time,proto3" json:"time,omitempty"`                                     // Jalali format H:m:s
+	ReviewedDate  string                 `protobuf:"bytes,17,opt,name=reviewed_date,json=reviewedDate,proto3" json:"reviewed_date,omitempty"` // Jalali format Y/m/d, empty while pending
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FraudCheck) Reset() {
+	*x = FraudCheck{}
+	mi := &file_commercial_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FraudCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FraudCheck) ProtoMessage() {}
+
+func (x *FraudCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FraudCheck.ProtoReflect.Descriptor instead.
+func (*FraudCheck) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *FraudCheck) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FraudCheck) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FraudCheck) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *FraudCheck) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetCardPan() string {
+	if x != nil {
+		return x.CardPan
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetReviewStatus() string {
+	if x != nil {
+		return x.ReviewStatus
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetReviewedBy() uint64 {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return 0
+}
+
+func (x *FraudCheck) GetReviewNote() string {
+	if x != nil {
+		return x.ReviewNote
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetReviewedDate() string {
+	if x != nil {
+		return x.ReviewedDate
+	}
+	return ""
+}
+
+type ListBlockedCardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedCardsRequest) Reset() {
+	*x = ListBlockedCardsRequest{}
+	mi := &file_commercial_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedCardsRequest) ProtoMessage() {}
+
+func (x *ListBlockedCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedCardsRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedCardsRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{79}
+}
+
+type ListBlockedCardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*BlockedCard         `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedCardsResponse) Reset() {
+	*x = ListBlockedCardsResponse{}
+	mi := &file_commercial_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedCardsResponse) ProtoMessage() {}
+
+func (x *ListBlockedCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedCardsResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedCardsResponse) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListBlockedCardsResponse) GetCards() []*BlockedCard {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+type BlockCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pattern       string                 `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"` // * matches any characters, e.g. 603799******1234
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockCardRequest) Reset() {
+	*x = BlockCardRequest{}
+	mi := &file_commercial_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockCardRequest) ProtoMessage() {}
+
+func (x *BlockCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockCardRequest.ProtoReflect.Descriptor instead.
+func (*BlockCardRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *BlockCardRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *BlockCardRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnblockCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardId        uint64                 `protobuf:"varint,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockCardRequest) Reset() {
+	*x = UnblockCardRequest{}
+	mi := &file_commercial_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockCardRequest) ProtoMessage() {}
+
+func (x *UnblockCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockCardRequest.ProtoReflect.Descriptor instead.
+func (*UnblockCardRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UnblockCardRequest) GetCardId() uint64 {
+	if x != nil {
+		return x.CardId
+	}
+	return 0
+}
+
+type BlockedCard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pattern       string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedBy     uint64                 `protobuf:"varint,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Date          string                 `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"` // Jalali format Y/m/d
+	Time          string                 `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"` // Jalali format H:m:s
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockedCard) Reset() {
+	*x = BlockedCard{}
+	mi := &file_commercial_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockedCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedCard) ProtoMessage() {}
+
+func (x *BlockedCard) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedCard.ProtoReflect.Descriptor instead.
+func (*BlockedCard) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *BlockedCard) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BlockedCard) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *BlockedCard) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BlockedCard) GetCreatedBy() uint64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *BlockedCard) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *BlockedCard) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type ListSubscriptionPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionPlansRequest) Reset() {
+	*x = ListSubscriptionPlansRequest{}
+	mi := &file_commercial_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionPlansRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionPlansRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{84}
+}
+
+type ListSubscriptionPlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*SubscriptionPlan    `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionPlansResponse) Reset() {
+	*x = ListSubscriptionPlansResponse{}
+	mi := &file_commercial_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionPlansResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionPlansResponse) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListSubscriptionPlansResponse) GetPlans() []*SubscriptionPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+type SubscriptionPlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Asset         string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"` // psc or irr
+	Price         string                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"` // Charged every period
+	PeriodDays    int32                  `protobuf:"varint,6,opt,name=period_days,json=periodDays,proto3" json:"period_days,omitempty"`
+	Entitlements  []string               `protobuf:"bytes,7,rep,name=entitlements,proto3" json:"entitlements,omitempty"` // Flags granted while subscribed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriptionPlan) Reset() {
+	*x = SubscriptionPlan{}
+	mi := &file_commercial_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionPlan) ProtoMessage() {}
+
+func (x *SubscriptionPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionPlan.ProtoReflect.Descriptor instead.
+func (*SubscriptionPlan) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SubscriptionPlan) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SubscriptionPlan) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *SubscriptionPlan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubscriptionPlan) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *SubscriptionPlan) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *SubscriptionPlan) GetPeriodDays() int32 {
+	if x != nil {
+		return x.PeriodDays
+	}
+	return 0
+}
+
+func (x *SubscriptionPlan) GetEntitlements() []string {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
+type SubscribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        uint64                 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	UseGateway    bool                   `protobuf:"varint,2,opt,name=use_gateway,json=useGateway,proto3" json:"use_gateway,omitempty"` // Pay what the wallet is short through the payment gateway
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`                                    // Client IP, passed to the fraud checks of gateway payments
+	Device        string                 `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`                            // Client user agent
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_commercial_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SubscribeRequest) GetPlanId() uint64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *SubscribeRequest) GetUseGateway() bool {
+	if x != nil {
+		return x.UseGateway
+	}
+	return false
+}
+
+func (x *SubscribeRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+type GetSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubscriptionRequest) Reset() {
+	*x = GetSubscriptionRequest{}
+	mi := &file_commercial_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionRequest) ProtoMessage() {}
+
+func (x *GetSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{88}
+}
+
+type CancelSubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId uint64                 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CancelSubscriptionRequest) Reset() {
+	*x = CancelSubscriptionRequest{}
+	mi := &file_commercial_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSubscriptionRequest) ProtoMessage() {}
+
+func (x *CancelSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *CancelSubscriptionRequest) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+type PaySubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId uint64                 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	UseGateway     bool                   `protobuf:"varint,2,opt,name=use_gateway,json=useGateway,proto3" json:"use_gateway,omitempty"`
+	Ip             string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	Device         string                 `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PaySubscriptionRequest) Reset() {
+	*x = PaySubscriptionRequest{}
+	mi := &file_commercial_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaySubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaySubscriptionRequest) ProtoMessage() {}
+
+func (x *PaySubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaySubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*PaySubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *PaySubscriptionRequest) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *PaySubscriptionRequest) GetUseGateway() bool {
+	if x != nil {
+		return x.UseGateway
+	}
+	return false
+}
+
+func (x *PaySubscriptionRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *PaySubscriptionRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+// SubscriptionPayment is the subscription after a charge. When the wallet was
+// short and the gateway was asked for, payment_url opens the payment of the
+// difference; the subscription is charged once the order is paid.
+type SubscriptionPayment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	PaymentUrl    string                 `protobuf:"bytes,2,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
+	OrderId       uint64                 `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriptionPayment) Reset() {
+	*x = SubscriptionPayment{}
+	mi := &file_commercial_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionPayment) ProtoMessage() {}
+
+func (x *SubscriptionPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionPayment.ProtoReflect.Descriptor instead.
+func (*SubscriptionPayment) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SubscriptionPayment) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *SubscriptionPayment) GetPaymentUrl() string {
+	if x != nil {
+		return x.PaymentUrl
+	}
+	return ""
+}
+
+func (x *SubscriptionPayment) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type Subscription struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId            uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Plan              *SubscriptionPlan      `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan,omitempty"`
+	Status            string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // pending, active, past_due, canceled, expired
+	CancelAtPeriodEnd bool                   `protobuf:"varint,5,opt,name=cancel_at_period_end,json=cancelAtPeriodEnd,proto3" json:"cancel_at_period_end,omitempty"`
+	PeriodStartDate   string                 `protobuf:"bytes,6,opt,name=period_start_date,json=periodStartDate,proto3" json:"period_start_date,omitempty"` // Jalali format Y/m/d
+	PeriodEndDate     string                 `protobuf:"bytes,7,opt,name=period_end_date,json=periodEndDate,proto3" json:"period_end_date,omitempty"`       // Jalali format Y/m/d
+	FailedAttempts    int32                  `protobuf:"varint,8,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`     // Failed renewal charges in the current dunning run
+	NextAttemptDate   string                 `protobuf:"bytes,9,opt,name=next_attempt_date,json=nextAttemptDate,proto3" json:"next_attempt_date,omitempty"` // Jalali format Y/m/d, empty once ended
+	Date              string                 `protobuf:"bytes,10,opt,name=date,proto3" json:"date,omitempty"`                                               // Jalali format Y/m/d
+	Time              string                 `protobuf:"bytes,11,opt,name=time,proto3" json:"time,omitempty"`                                               // Jalali format H:m:s
+	EndedDate         string                 `protobuf:"bytes,12,opt,name=ended_date,json=endedDate,proto3" json:"ended_date,omitempty"`                    // Jalali format Y/m/d, empty until canceled or expired
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_commercial_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *Subscription) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Subscription) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Subscription) GetPlan() *SubscriptionPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *Subscription) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Subscription) GetCancelAtPeriodEnd() bool {
+	if x != nil {
+		return x.CancelAtPeriodEnd
+	}
+	return false
+}
+
+func (x *Subscription) GetPeriodStartDate() string {
+	if x != nil {
+		return x.PeriodStartDate
+	}
+	return ""
+}
+
+func (x *Subscription) GetPeriodEndDate() string {
+	if x != nil {
+		return x.PeriodEndDate
+	}
+	return ""
+}
+
+func (x *Subscription) GetFailedAttempts() int32 {
+	if x != nil {
+		return x.FailedAttempts
+	}
+	return 0
+}
+
+func (x *Subscription) GetNextAttemptDate() string {
+	if x != nil {
+		return x.NextAttemptDate
+	}
+	return ""
+}
+
+func (x *Subscription) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Subscription) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *Subscription) GetEndedDate() string {
+	if x != nil {
+		return x.EndedDate
+	}
+	return ""
+}
+
+type GetEntitlementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntitlementsRequest) Reset() {
+	*x = GetEntitlementsRequest{}
+	mi := &file_commercial_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntitlementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntitlementsRequest) ProtoMessage() {}
+
+func (x *GetEntitlementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntitlementsRequest.ProtoReflect.Descriptor instead.
+func (*GetEntitlementsRequest) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetEntitlementsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type Entitlements struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Flags         []string               `protobuf:"bytes,2,rep,name=flags,proto3" json:"flags,omitempty"`                  // Sorted; empty without a subscription
+	PlanId        uint64                 `protobuf:"varint,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"` // 0 without a subscription
+	PlanSlug      string                 `protobuf:"bytes,4,opt,name=plan_slug,json=planSlug,proto3" json:"plan_slug,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                        // active or past_due
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // End of the paid period
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Entitlements) Reset() {
+	*x = Entitlements{}
+	mi := &file_commercial_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Entitlements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entitlements) ProtoMessage() {}
+
+func (x *Entitlements) ProtoReflect() protoreflect.Message {
+	mi := &file_commercial_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entitlements.ProtoReflect.Descriptor instead.
+func (*Entitlements) Descriptor() ([]byte, []int) {
+	return file_commercial_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *Entitlements) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Entitlements) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *Entitlements) GetPlanId() uint64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *Entitlements) GetPlanSlug() string {
+	if x != nil {
+		return x.PlanSlug
+	}
+	return ""
+}
+
+func (x *Entitlements) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Entitlements) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+var File_commercial_proto protoreflect.FileDescriptor
+
+const file_commercial_proto_rawDesc = "" +
+	"\n" +
+	"\x10commercial.proto\x12\n" +
+	"commercial\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xc5\x02\n" +
 	"\x06Wallet\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x10\n" +
@@ -1827,7 +6669,7 @@ const file_commercial_proto_rawDesc = "" +
 	"created_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf9\x02\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa0\x03\n" +
 	"\vTransaction\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x14\n" +
@@ -1844,7 +6686,8 @@ const file_commercial_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb1\x01\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12%\n" +
+	"\x0eamount_decimal\x18\r \x01(\tR\ramountDecimal\"\xd8\x01\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x14\n" +
@@ -1852,7 +6695,8 @@ const file_commercial_proto_rawDesc = "" +
 	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x16\n" +
 	"\x06status\x18\x05 \x01(\x05R\x06status\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xeb\x01\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12%\n" +
+	"\x0eamount_decimal\x18\a \x01(\tR\ramountDecimal\"\x92\x02\n" +
 	"\aPayment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x15\n" +
@@ -1862,7 +6706,8 @@ const file_commercial_proto_rawDesc = "" +
 	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12\x18\n" +
 	"\aproduct\x18\a \x01(\tR\aproduct\x129\n" +
 	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"+\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12%\n" +
+	"\x0eamount_decimal\x18\t \x01(\tR\ramountDecimal\"+\n" +
 	"\x10GetWalletRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\"\xae\x01\n" +
 	"\x0eWalletResponse\x12\x10\n" +
@@ -1872,32 +6717,37 @@ const file_commercial_proto_rawDesc = "" +
 	"\x04blue\x18\x04 \x01(\tR\x04blue\x12\x16\n" +
 	"\x06yellow\x18\x05 \x01(\tR\x06yellow\x12\"\n" +
 	"\fsatisfaction\x18\x06 \x01(\tR\fsatisfaction\x12\x16\n" +
-	"\x06effect\x18\a \x01(\x01R\x06effect\"]\n" +
+	"\x06effect\x18\a \x01(\x01R\x06effect\"\x9e\x01\n" +
 	"\x14DeductBalanceRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
 	"\x05asset\x18\x02 \x01(\tR\x05asset\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x01R\x06amount\"\x7f\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x18\n" +
+	"\areclaim\x18\x04 \x01(\bR\areclaim\x12%\n" +
+	"\x0eamount_decimal\x18\x05 \x01(\tR\ramountDecimal\"\x7f\n" +
 	"\x15DeductBalanceResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
-	"\x06wallet\x18\x03 \x01(\v2\x1a.commercial.WalletResponseR\x06wallet\"Z\n" +
+	"\x06wallet\x18\x03 \x01(\v2\x1a.commercial.WalletResponseR\x06wallet\"\x81\x01\n" +
 	"\x11AddBalanceRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
 	"\x05asset\x18\x02 \x01(\tR\x05asset\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x01R\x06amount\"|\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12%\n" +
+	"\x0eamount_decimal\x18\x04 \x01(\tR\ramountDecimal\"|\n" +
 	"\x12AddBalanceResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
-	"\x06wallet\x18\x03 \x01(\v2\x1a.commercial.WalletResponseR\x06wallet\"s\n" +
+	"\x06wallet\x18\x03 \x01(\v2\x1a.commercial.WalletResponseR\x06wallet\"\x9a\x01\n" +
 	"\x12LockBalanceRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
 	"\x05asset\x18\x02 \x01(\tR\x05asset\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x16\n" +
-	"\x06reason\x18\x04 \x01(\tR\x06reason\"]\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12%\n" +
+	"\x0eamount_decimal\x18\x05 \x01(\tR\ramountDecimal\"\x84\x01\n" +
 	"\x14UnlockBalanceRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
 	"\x05asset\x18\x02 \x01(\tR\x05asset\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x01R\x06amount\"\x9f\x02\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12%\n" +
+	"\x0eamount_decimal\x18\x04 \x01(\tR\ramountDecimal\"\xf5\x02\n" +
 	"\x17ListTransactionsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
@@ -1909,11 +6759,18 @@ const file_commercial_proto_rawDesc = "" +
 	"\x06action\x18\b \x01(\tR\x06action\x12\x14\n" +
 	"\x05asset\x18\t \x01(\tR\x05asset\x12\x12\n" +
 	"\x04type\x18\n" +
-	" \x01(\tR\x04type\"\xa8\x01\n" +
+	" \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\v \x01(\x01R\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\f \x01(\x01R\tmaxAmount\x12\x16\n" +
+	"\x06cursor\x18\r \x01(\tR\x06cursor\"\xc9\x01\n" +
 	"\x18ListTransactionsResponse\x12C\n" +
 	"\ftransactions\x18\x01 \x03(\v2\x1f.commercial.TransactionResourceR\ftransactions\x12!\n" +
 	"\fcurrent_page\x18\x02 \x01(\x05R\vcurrentPage\x12$\n" +
-	"\x0ehas_more_pages\x18\x03 \x01(\bR\fhasMorePages\"\xbf\x01\n" +
+	"\x0ehas_more_pages\x18\x03 \x01(\bR\fhasMorePages\x12\x1f\n" +
+	"\vnext_cursor\x18\x04 \x01(\tR\n" +
+	"nextCursor\"\xe6\x01\n" +
 	"\x13TransactionResource\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
@@ -1922,13 +6779,14 @@ const file_commercial_proto_rawDesc = "" +
 	"\x06action\x18\x05 \x01(\tR\x06action\x12\x16\n" +
 	"\x06status\x18\x06 \x01(\x05R\x06status\x12\x12\n" +
 	"\x04date\x18\a \x01(\tR\x04date\x12\x12\n" +
-	"\x04time\x18\b \x01(\tR\x04time\"6\n" +
+	"\x04time\x18\b \x01(\tR\x04time\x12%\n" +
+	"\x0eamount_decimal\x18\t \x01(\tR\ramountDecimal\"6\n" +
 	"\x1bGetLatestTransactionRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\"\xd5\x01\n" +
 	"\x19LatestTransactionResponse\x12F\n" +
 	"\x12latest_transaction\x18\x01 \x01(\v2\x17.commercial.TransactionR\x11latestTransaction\x12:\n" +
 	"\x0elatest_payment\x18\x02 \x01(\v2\x13.commercial.PaymentR\rlatestPayment\x124\n" +
-	"\flatest_order\x18\x03 \x01(\v2\x11.commercial.OrderR\vlatestOrder\"\xd3\x01\n" +
+	"\flatest_order\x18\x03 \x01(\v2\x11.commercial.OrderR\vlatestOrder\"\xfa\x01\n" +
 	"\x18CreateTransactionRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
 	"\x05asset\x18\x02 \x01(\tR\x05asset\x12\x16\n" +
@@ -1937,24 +6795,42 @@ const file_commercial_proto_rawDesc = "" +
 	"\x06status\x18\x05 \x01(\x05R\x06status\x12!\n" +
 	"\fpayable_type\x18\x06 \x01(\tR\vpayableType\x12\x1d\n" +
 	"\n" +
-	"payable_id\x18\a \x01(\x04R\tpayableId\"_\n" +
+	"payable_id\x18\a \x01(\x04R\tpayableId\x12%\n" +
+	"\x0eamount_decimal\x18\b \x01(\tR\ramountDecimal\"\xae\x01\n" +
 	"\x16InitiatePaymentRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
 	"\x05asset\x18\x02 \x01(\tR\x05asset\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x01R\x06amount\"|\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\x12\x16\n" +
+	"\x06device\x18\x05 \x01(\tR\x06device\x12%\n" +
+	"\x0eamount_decimal\x18\x06 \x01(\tR\ramountDecimal\"\x85\x01\n" +
+	"\x14ScreenPaymentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
+	"\x05asset\x18\x02 \x01(\tR\x05asset\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\x12\x16\n" +
+	"\x06device\x18\x05 \x01(\tR\x06device\"\x97\x01\n" +
+	"\x18ScreenPaymentCardRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x04R\aorderId\x12\x14\n" +
+	"\x05asset\x18\x03 \x01(\tR\x05asset\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x19\n" +
+	"\bcard_pan\x18\x05 \x01(\tR\acardPan\"F\n" +
+	"\x12SettleOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\x12\x15\n" +
+	"\x06ref_id\x18\x02 \x01(\x03R\x05refId\"G\n" +
+	"\x13SettleOrderResponse\x12\x1a\n" +
+	"\bcredited\x18\x01 \x01(\tR\bcredited\x12\x14\n" +
+	"\x05bonus\x18\x02 \x01(\tR\x05bonus\"h\n" +
+	"\x19PublishOrderStatusRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"|\n" +
 	"\x17InitiatePaymentResponse\x12\x1f\n" +
 	"\vpayment_url\x18\x01 \x01(\tR\n" +
 	"paymentUrl\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\x04R\aorderId\x12%\n" +
-	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\"`\n" +
-	"\x15HandleCallbackRequest\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\x04R\aorderId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x14\n" +
-	"\x05token\x18\x03 \x01(\x03R\x05token\"o\n" +
-	"\x16HandleCallbackResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12!\n" +
-	"\fredirect_url\x18\x02 \x01(\tR\vredirectUrl\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"M\n" +
+	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\"M\n" +
 	"\x14VerifyPaymentRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\x03R\x05token\x12\x1f\n" +
 	"\vmerchant_id\x18\x02 \x01(\tR\n" +
@@ -1964,7 +6840,377 @@ const file_commercial_proto_rawDesc = "" +
 	"\x06status\x18\x02 \x01(\x05R\x06status\x12!\n" +
 	"\freference_id\x18\x03 \x01(\x03R\vreferenceId\x12\x1b\n" +
 	"\tcard_hash\x18\x04 \x01(\tR\bcardHash\x12\x18\n" +
-	"\amessage\x18\x05 \x01(\tR\amessage2\x8b\x03\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\xc3\x01\n" +
+	"\x11ListOrdersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x03 \x01(\x05R\aperPage\x12\x16\n" +
+	"\x06status\x18\x04 \x03(\x05R\x06status\x12\x18\n" +
+	"\agateway\x18\x05 \x01(\tR\agateway\x12\x1b\n" +
+	"\tfrom_date\x18\x06 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\a \x01(\tR\x06toDate\"\xc1\x01\n" +
+	"\x12ListOrdersResponse\x121\n" +
+	"\x06orders\x18\x01 \x03(\v2\x19.commercial.OrderResourceR\x06orders\x12!\n" +
+	"\fcurrent_page\x18\x02 \x01(\x05R\vcurrentPage\x12\x19\n" +
+	"\bper_page\x18\x03 \x01(\x05R\aperPage\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12$\n" +
+	"\x0ehas_more_pages\x18\x05 \x01(\bR\fhasMorePages\"g\n" +
+	"\x1eEvaluateFirstOrderBonusRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
+	"\x05asset\x18\x02 \x01(\tR\x05asset\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\"\xa9\x02\n" +
+	"\x19FirstOrderBonusEvaluation\x12\x1a\n" +
+	"\beligible\x18\x01 \x01(\bR\beligible\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05bonus\x18\x03 \x01(\tR\x05bonus\x12#\n" +
+	"\rbonus_percent\x18\x04 \x01(\tR\fbonusPercent\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x05 \x01(\tR\tminAmount\x12\x1f\n" +
+	"\vwindow_days\x18\x06 \x01(\x05R\n" +
+	"windowDays\x12@\n" +
+	"\x0ewindow_ends_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fwindowEndsAt\x12\x1b\n" +
+	"\tirr_value\x18\b \x01(\tR\birrValue\"\xe5\x01\n" +
+	"\rOrderResource\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
+	"\x05asset\x18\x02 \x01(\tR\x05asset\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x18\n" +
+	"\agateway\x18\x05 \x01(\tR\agateway\x12\x15\n" +
+	"\x06ref_id\x18\x06 \x01(\tR\x05refId\x12\x12\n" +
+	"\x04date\x18\a \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\b \x01(\tR\x04time\x12%\n" +
+	"\x0eamount_decimal\x18\t \x01(\tR\ramountDecimal\")\n" +
+	"\x13GetVariablesRequest\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"\x97\x01\n" +
+	"\x14GetVariablesResponse\x12D\n" +
+	"\x06values\x18\x01 \x03(\v2,.commercial.GetVariablesResponse.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xb4\x01\n" +
+	"\bVariable\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x10\n" +
+	"\x03min\x18\x04 \x01(\tR\x03min\x12\x10\n" +
+	"\x03max\x18\x05 \x01(\tR\x03max\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04date\x18\a \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\b \x01(\tR\x04time\"\x16\n" +
+	"\x14ListVariablesRequest\"K\n" +
+	"\x15ListVariablesResponse\x122\n" +
+	"\tvariables\x18\x01 \x03(\v2\x14.commercial.VariableR\tvariables\"&\n" +
+	"\x12GetVariableRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"P\n" +
+	"\x12SetVariableRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"D\n" +
+	"\x1aListVariableChangesRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xfc\x01\n" +
+	"\x0eVariableChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12%\n" +
+	"\x0eprevious_value\x18\x03 \x01(\tR\rpreviousValue\x12#\n" +
+	"\rcurrent_value\x18\x04 \x01(\tR\fcurrentValue\x12\x1d\n" +
+	"\n" +
+	"changer_id\x18\x05 \x01(\x04R\tchangerId\x12!\n" +
+	"\fchanger_name\x18\x06 \x01(\tR\vchangerName\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x12\x12\n" +
+	"\x04date\x18\b \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\t \x01(\tR\x04time\"S\n" +
+	"\x1bListVariableChangesResponse\x124\n" +
+	"\achanges\x18\x01 \x03(\v2\x1a.commercial.VariableChangeR\achanges\"~\n" +
+	"\x1dScheduleVariableChangeRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12!\n" +
+	"\feffective_at\x18\x04 \x01(\tR\veffectiveAt\"\xe7\x01\n" +
+	"\x17ScheduledVariableChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12!\n" +
+	"\feffective_at\x18\x05 \x01(\tR\veffectiveAt\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\x04R\tcreatedBy\x12\x12\n" +
+	"\x04date\x18\b \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\t \x01(\tR\x04time\"7\n" +
+	"#ListScheduledVariableChangesRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"e\n" +
+	"$ListScheduledVariableChangesResponse\x12=\n" +
+	"\achanges\x18\x01 \x03(\v2#.commercial.ScheduledVariableChangeR\achanges\"6\n" +
+	"$CancelScheduledVariableChangeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\x15\n" +
+	"\x13DisplayRatesRequest\"\x88\x02\n" +
+	"\vDisplayRate\x12\x14\n" +
+	"\x05asset\x18\x01 \x01(\tR\x05asset\x12\x10\n" +
+	"\x03irr\x18\x02 \x01(\tR\x03irr\x12\x10\n" +
+	"\x03psc\x18\x03 \x01(\tR\x03psc\x12!\n" +
+	"\fprevious_irr\x18\x04 \x01(\tR\vpreviousIrr\x12\x1d\n" +
+	"\n" +
+	"irr_change\x18\x05 \x01(\tR\tirrChange\x12,\n" +
+	"\x12irr_change_percent\x18\x06 \x01(\tR\x10irrChangePercent\x12!\n" +
+	"\fprevious_psc\x18\a \x01(\tR\vpreviousPsc\x12,\n" +
+	"\x12psc_change_percent\x18\b \x01(\tR\x10pscChangePercent\"m\n" +
+	"\x14DisplayRatesResponse\x12-\n" +
+	"\x05rates\x18\x01 \x03(\v2\x17.commercial.DisplayRateR\x05rates\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\x03 \x01(\tR\x04time\"P\n" +
+	"\x1cCreateAdjustmentBatchRequest\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x18\n" +
+	"\aentries\x18\x02 \x01(\tR\aentries\"6\n" +
+	"\x1cListAdjustmentBatchesRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"V\n" +
+	"\x1dListAdjustmentBatchesResponse\x125\n" +
+	"\abatches\x18\x01 \x03(\v2\x1b.commercial.AdjustmentBatchR\abatches\"6\n" +
+	"\x19GetAdjustmentBatchRequest\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\x04R\abatchId\":\n" +
+	"\x1dApproveAdjustmentBatchRequest\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\x04R\abatchId\"M\n" +
+	"\x1cRejectAdjustmentBatchRequest\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\x04R\abatchId\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\"\xd7\x02\n" +
+	"\x0fAdjustmentBatch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\x04R\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\x05 \x01(\x04R\tdecidedBy\x12#\n" +
+	"\rdecision_note\x18\x06 \x01(\tR\fdecisionNote\x12\x1f\n" +
+	"\ventry_count\x18\a \x01(\x05R\n" +
+	"entryCount\x125\n" +
+	"\aentries\x18\b \x03(\v2\x1b.commercial.AdjustmentEntryR\aentries\x12\x12\n" +
+	"\x04date\x18\t \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\n" +
+	" \x01(\tR\x04time\x12!\n" +
+	"\fdecided_date\x18\v \x01(\tR\vdecidedDate\"\x7f\n" +
+	"\x0fAdjustmentEntry\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
+	"\x05asset\x18\x02 \x01(\tR\x05asset\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12%\n" +
+	"\x0etransaction_id\x18\x04 \x01(\tR\rtransactionId\"a\n" +
+	"\x1cCreateInstallmentPlanRequest\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x12\"\n" +
+	"\finstallments\x18\x02 \x01(\x05R\finstallments\"5\n" +
+	"\x1bListInstallmentPlansRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"Q\n" +
+	"\x1cListInstallmentPlansResponse\x121\n" +
+	"\x05plans\x18\x01 \x03(\v2\x1b.commercial.InstallmentPlanR\x05plans\"4\n" +
+	"\x19GetInstallmentPlanRequest\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\x04R\x06planId\"0\n" +
+	"\x15PayInstallmentRequest\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\x04R\x06planId\"\xe7\x03\n" +
+	"\x0fInstallmentPlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x02 \x01(\x04R\tfeatureId\x12\x19\n" +
+	"\bbuyer_id\x18\x03 \x01(\x04R\abuyerId\x12\x1b\n" +
+	"\tseller_id\x18\x04 \x01(\x04R\bsellerId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1b\n" +
+	"\ttotal_psc\x18\x06 \x01(\tR\btotalPsc\x12\x1b\n" +
+	"\ttotal_irr\x18\a \x01(\tR\btotalIrr\x12\x19\n" +
+	"\bpaid_psc\x18\b \x01(\tR\apaidPsc\x12\x19\n" +
+	"\bpaid_irr\x18\t \x01(\tR\apaidIrr\x12!\n" +
+	"\frefunded_psc\x18\n" +
+	" \x01(\tR\vrefundedPsc\x12!\n" +
+	"\frefunded_irr\x18\v \x01(\tR\vrefundedIrr\x12\x19\n" +
+	"\btrade_id\x18\f \x01(\x04R\atradeId\x12;\n" +
+	"\finstallments\x18\r \x03(\v2\x17.commercial.InstallmentR\finstallments\x12\x12\n" +
+	"\x04date\x18\x0e \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\x0f \x01(\tR\x04time\x12\x1f\n" +
+	"\vclosed_date\x18\x10 \x01(\tR\n" +
+	"closedDate\"\xb7\x01\n" +
+	"\vInstallment\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x05R\bsequence\x12\x1d\n" +
+	"\n" +
+	"amount_psc\x18\x02 \x01(\tR\tamountPsc\x12\x1d\n" +
+	"\n" +
+	"amount_irr\x18\x03 \x01(\tR\tamountIrr\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x19\n" +
+	"\bdue_date\x18\x05 \x01(\tR\adueDate\x12\x1b\n" +
+	"\tpaid_date\x18\x06 \x01(\tR\bpaidDate\"E\n" +
+	"\x18ListExchangeRatesRequest\x12)\n" +
+	"\x10include_disabled\x18\x01 \x01(\bR\x0fincludeDisabled\"K\n" +
+	"\x19ListExchangeRatesResponse\x12.\n" +
+	"\x05rates\x18\x01 \x03(\v2\x18.commercial.ExchangeRateR\x05rates\"\xa1\x01\n" +
+	"\x16SetExchangeRateRequest\x12\x1d\n" +
+	"\n" +
+	"from_asset\x18\x01 \x01(\tR\tfromAsset\x12\x19\n" +
+	"\bto_asset\x18\x02 \x01(\tR\atoAsset\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\x12\x1f\n" +
+	"\vfee_percent\x18\x04 \x01(\tR\n" +
+	"feePercent\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\"\xde\x01\n" +
+	"\fExchangeRate\x12\x1d\n" +
+	"\n" +
+	"from_asset\x18\x01 \x01(\tR\tfromAsset\x12\x19\n" +
+	"\bto_asset\x18\x02 \x01(\tR\atoAsset\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\x12\x1f\n" +
+	"\vfee_percent\x18\x04 \x01(\tR\n" +
+	"feePercent\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x06 \x01(\x04R\tupdatedBy\x12\x12\n" +
+	"\x04date\x18\a \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\b \x01(\tR\x04time\"\x83\x01\n" +
+	"\x0eConvertRequest\x12\x1d\n" +
+	"\n" +
+	"from_asset\x18\x01 \x01(\tR\tfromAsset\x12\x19\n" +
+	"\bto_asset\x18\x02 \x01(\tR\atoAsset\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1f\n" +
+	"\vmin_receive\x18\x04 \x01(\tR\n" +
+	"minReceive\"\xc6\x02\n" +
+	"\n" +
+	"Conversion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"from_asset\x18\x02 \x01(\tR\tfromAsset\x12\x19\n" +
+	"\bto_asset\x18\x03 \x01(\tR\atoAsset\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x1a\n" +
+	"\breceived\x18\x05 \x01(\tR\breceived\x12\x10\n" +
+	"\x03fee\x18\x06 \x01(\tR\x03fee\x12\x12\n" +
+	"\x04rate\x18\a \x01(\tR\x04rate\x126\n" +
+	"\x17withdraw_transaction_id\x18\b \x01(\tR\x15withdrawTransactionId\x124\n" +
+	"\x16deposit_transaction_id\x18\t \x01(\tR\x14depositTransactionId\x12\x12\n" +
+	"\x04date\x18\n" +
+	" \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\v \x01(\tR\x04time\"3\n" +
+	"\x18GetSpendingLimitsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"\xce\x01\n" +
+	"\x18SetSpendingLimitsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x02 \x01(\x04R\tupdatedBy\x12\x1b\n" +
+	"\tdaily_psc\x18\x03 \x01(\tR\bdailyPsc\x12\x1f\n" +
+	"\vmonthly_psc\x18\x04 \x01(\tR\n" +
+	"monthlyPsc\x12\x1b\n" +
+	"\tdaily_irr\x18\x05 \x01(\tR\bdailyIrr\x12\x1f\n" +
+	"\vmonthly_irr\x18\x06 \x01(\tR\n" +
+	"monthlyIrr\"\xc6\x01\n" +
+	"\x0eSpendingLimits\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x18\n" +
+	"\aapplies\x18\x02 \x01(\bR\aapplies\x120\n" +
+	"\x03psc\x18\x03 \x01(\v2\x1e.commercial.AssetSpendingLimitR\x03psc\x120\n" +
+	"\x03irr\x18\x04 \x01(\v2\x1e.commercial.AssetSpendingLimitR\x03irr\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x05 \x01(\x04R\tupdatedBy\"\xcd\x01\n" +
+	"\x12AssetSpendingLimit\x12\x14\n" +
+	"\x05daily\x18\x01 \x01(\tR\x05daily\x12\x18\n" +
+	"\amonthly\x18\x02 \x01(\tR\amonthly\x12\x1f\n" +
+	"\vspent_today\x18\x03 \x01(\tR\n" +
+	"spentToday\x12(\n" +
+	"\x10spent_this_month\x18\x04 \x01(\tR\x0espentThisMonth\x12\x1b\n" +
+	"\tmax_daily\x18\x05 \x01(\tR\bmaxDaily\x12\x1f\n" +
+	"\vmax_monthly\x18\x06 \x01(\tR\n" +
+	"maxMonthly\"1\n" +
+	"\x17ListFraudReviewsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"J\n" +
+	"\x18ListFraudReviewsResponse\x12.\n" +
+	"\x06checks\x18\x01 \x03(\v2\x16.commercial.FraudCheckR\x06checks\"d\n" +
+	"\x19ResolveFraudReviewRequest\x12\x19\n" +
+	"\bcheck_id\x18\x01 \x01(\x04R\acheckId\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\xc2\x03\n" +
+	"\n" +
+	"FraudCheck\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05asset\x18\x04 \x01(\tR\x05asset\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x1a\n" +
+	"\bdecision\x18\x06 \x01(\tR\bdecision\x12\x18\n" +
+	"\areasons\x18\a \x03(\tR\areasons\x12\x1c\n" +
+	"\treference\x18\b \x01(\tR\treference\x12\x0e\n" +
+	"\x02ip\x18\t \x01(\tR\x02ip\x12\x16\n" +
+	"\x06device\x18\n" +
+	" \x01(\tR\x06device\x12\x19\n" +
+	"\bcard_pan\x18\v \x01(\tR\acardPan\x12#\n" +
+	"\rreview_status\x18\f \x01(\tR\freviewStatus\x12\x1f\n" +
+	"\vreviewed_by\x18\r \x01(\x04R\n" +
+	"reviewedBy\x12\x1f\n" +
+	"\vreview_note\x18\x0e \x01(\tR\n" +
+	"reviewNote\x12\x12\n" +
+	"\x04date\x18\x0f \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\x10 \x01(\tR\x04time\x12#\n" +
+	"\rreviewed_date\x18\x11 \x01(\tR\freviewedDate\"\x19\n" +
+	"\x17ListBlockedCardsRequest\"I\n" +
+	"\x18ListBlockedCardsResponse\x12-\n" +
+	"\x05cards\x18\x01 \x03(\v2\x17.commercial.BlockedCardR\x05cards\"D\n" +
+	"\x10BlockCardRequest\x12\x18\n" +
+	"\apattern\x18\x01 \x01(\tR\apattern\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"-\n" +
+	"\x12UnblockCardRequest\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\x04R\x06cardId\"\x96\x01\n" +
+	"\vBlockedCard\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x18\n" +
+	"\apattern\x18\x02 \x01(\tR\apattern\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\x04R\tcreatedBy\x12\x12\n" +
+	"\x04date\x18\x05 \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\x06 \x01(\tR\x04time\"\x1e\n" +
+	"\x1cListSubscriptionPlansRequest\"S\n" +
+	"\x1dListSubscriptionPlansResponse\x122\n" +
+	"\x05plans\x18\x01 \x03(\v2\x1c.commercial.SubscriptionPlanR\x05plans\"\xbb\x01\n" +
+	"\x10SubscriptionPlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05asset\x18\x04 \x01(\tR\x05asset\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\tR\x05price\x12\x1f\n" +
+	"\vperiod_days\x18\x06 \x01(\x05R\n" +
+	"periodDays\x12\"\n" +
+	"\fentitlements\x18\a \x03(\tR\fentitlements\"t\n" +
+	"\x10SubscribeRequest\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\x04R\x06planId\x12\x1f\n" +
+	"\vuse_gateway\x18\x02 \x01(\bR\n" +
+	"useGateway\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x16\n" +
+	"\x06device\x18\x04 \x01(\tR\x06device\"\x18\n" +
+	"\x16GetSubscriptionRequest\"D\n" +
+	"\x19CancelSubscriptionRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\x04R\x0esubscriptionId\"\x8a\x01\n" +
+	"\x16PaySubscriptionRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\x04R\x0esubscriptionId\x12\x1f\n" +
+	"\vuse_gateway\x18\x02 \x01(\bR\n" +
+	"useGateway\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x16\n" +
+	"\x06device\x18\x04 \x01(\tR\x06device\"\x8f\x01\n" +
+	"\x13SubscriptionPayment\x12<\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x18.commercial.SubscriptionR\fsubscription\x12\x1f\n" +
+	"\vpayment_url\x18\x02 \x01(\tR\n" +
+	"paymentUrl\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\x04R\aorderId\"\xa2\x03\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x120\n" +
+	"\x04plan\x18\x03 \x01(\v2\x1c.commercial.SubscriptionPlanR\x04plan\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12/\n" +
+	"\x14cancel_at_period_end\x18\x05 \x01(\bR\x11cancelAtPeriodEnd\x12*\n" +
+	"\x11period_start_date\x18\x06 \x01(\tR\x0fperiodStartDate\x12&\n" +
+	"\x0fperiod_end_date\x18\a \x01(\tR\rperiodEndDate\x12'\n" +
+	"\x0ffailed_attempts\x18\b \x01(\x05R\x0efailedAttempts\x12*\n" +
+	"\x11next_attempt_date\x18\t \x01(\tR\x0fnextAttemptDate\x12\x12\n" +
+	"\x04date\x18\n" +
+	" \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\v \x01(\tR\x04time\x12\x1d\n" +
+	"\n" +
+	"ended_date\x18\f \x01(\tR\tendedDate\"1\n" +
+	"\x16GetEntitlementsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"\xc6\x01\n" +
+	"\fEntitlements\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
+	"\x05flags\x18\x02 \x03(\tR\x05flags\x12\x17\n" +
+	"\aplan_id\x18\x03 \x01(\x04R\x06planId\x12\x1b\n" +
+	"\tplan_slug\x18\x04 \x01(\tR\bplanSlug\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt2\x8b\x03\n" +
 	"\rWalletService\x12E\n" +
 	"\tGetWallet\x12\x1c.commercial.GetWalletRequest\x1a\x1a.commercial.WalletResponse\x12T\n" +
 	"\rDeductBalance\x12 .commercial.DeductBalanceRequest\x1a!.commercial.DeductBalanceResponse\x12K\n" +
@@ -1975,11 +7221,59 @@ const file_commercial_proto_rawDesc = "" +
 	"\x12TransactionService\x12]\n" +
 	"\x10ListTransactions\x12#.commercial.ListTransactionsRequest\x1a$.commercial.ListTransactionsResponse\x12f\n" +
 	"\x14GetLatestTransaction\x12'.commercial.GetLatestTransactionRequest\x1a%.commercial.LatestTransactionResponse\x12R\n" +
-	"\x11CreateTransaction\x12$.commercial.CreateTransactionRequest\x1a\x17.commercial.Transaction2\x9b\x02\n" +
+	"\x11CreateTransaction\x12$.commercial.CreateTransactionRequest\x1a\x17.commercial.Transaction2\x85\x04\n" +
 	"\x0ePaymentService\x12Z\n" +
-	"\x0fInitiatePayment\x12\".commercial.InitiatePaymentRequest\x1a#.commercial.InitiatePaymentResponse\x12W\n" +
-	"\x0eHandleCallback\x12!.commercial.HandleCallbackRequest\x1a\".commercial.HandleCallbackResponse\x12T\n" +
-	"\rVerifyPayment\x12 .commercial.VerifyPaymentRequest\x1a!.commercial.VerifyPaymentResponseB\x1eZ\x1cmetargb/shared/pb/commercialb\x06proto3"
+	"\x0fInitiatePayment\x12\".commercial.InitiatePaymentRequest\x1a#.commercial.InitiatePaymentResponse\x12T\n" +
+	"\rVerifyPayment\x12 .commercial.VerifyPaymentRequest\x1a!.commercial.VerifyPaymentResponse\x12I\n" +
+	"\rScreenPayment\x12 .commercial.ScreenPaymentRequest\x1a\x16.google.protobuf.Empty\x12Q\n" +
+	"\x11ScreenPaymentCard\x12$.commercial.ScreenPaymentCardRequest\x1a\x16.google.protobuf.Empty\x12N\n" +
+	"\vSettleOrder\x12\x1e.commercial.SettleOrderRequest\x1a\x1f.commercial.SettleOrderResponse\x12S\n" +
+	"\x12PublishOrderStatus\x12%.commercial.PublishOrderStatusRequest\x1a\x16.google.protobuf.Empty2\xc9\x01\n" +
+	"\fOrderService\x12K\n" +
+	"\n" +
+	"ListOrders\x12\x1d.commercial.ListOrdersRequest\x1a\x1e.commercial.ListOrdersResponse\x12l\n" +
+	"\x17EvaluateFirstOrderBonus\x12*.commercial.EvaluateFirstOrderBonusRequest\x1a%.commercial.FirstOrderBonusEvaluation2\xe5\x06\n" +
+	"\x0fVariableService\x12Q\n" +
+	"\fGetVariables\x12\x1f.commercial.GetVariablesRequest\x1a .commercial.GetVariablesResponse\x12T\n" +
+	"\rListVariables\x12 .commercial.ListVariablesRequest\x1a!.commercial.ListVariablesResponse\x12C\n" +
+	"\vGetVariable\x12\x1e.commercial.GetVariableRequest\x1a\x14.commercial.Variable\x12C\n" +
+	"\vSetVariable\x12\x1e.commercial.SetVariableRequest\x1a\x14.commercial.Variable\x12f\n" +
+	"\x13ListVariableChanges\x12&.commercial.ListVariableChangesRequest\x1a'.commercial.ListVariableChangesResponse\x12h\n" +
+	"\x16ScheduleVariableChange\x12).commercial.ScheduleVariableChangeRequest\x1a#.commercial.ScheduledVariableChange\x12\x81\x01\n" +
+	"\x1cListScheduledVariableChanges\x12/.commercial.ListScheduledVariableChangesRequest\x1a0.commercial.ListScheduledVariableChangesResponse\x12v\n" +
+	"\x1dCancelScheduledVariableChange\x120.commercial.CancelScheduledVariableChangeRequest\x1a#.commercial.ScheduledVariableChange\x12Q\n" +
+	"\fDisplayRates\x12\x1f.commercial.DisplayRatesRequest\x1a .commercial.DisplayRatesResponse2\x83\x04\n" +
+	"\x17WalletAdjustmentService\x12^\n" +
+	"\x15CreateAdjustmentBatch\x12(.commercial.CreateAdjustmentBatchRequest\x1a\x1b.commercial.AdjustmentBatch\x12l\n" +
+	"\x15ListAdjustmentBatches\x12(.commercial.ListAdjustmentBatchesRequest\x1a).commercial.ListAdjustmentBatchesResponse\x12X\n" +
+	"\x12GetAdjustmentBatch\x12%.commercial.GetAdjustmentBatchRequest\x1a\x1b.commercial.AdjustmentBatch\x12`\n" +
+	"\x16ApproveAdjustmentBatch\x12).commercial.ApproveAdjustmentBatchRequest\x1a\x1b.commercial.AdjustmentBatch\x12^\n" +
+	"\x15RejectAdjustmentBatch\x12(.commercial.RejectAdjustmentBatchRequest\x1a\x1b.commercial.AdjustmentBatch2\x8b\x03\n" +
+	"\x12InstallmentService\x12^\n" +
+	"\x15CreateInstallmentPlan\x12(.commercial.CreateInstallmentPlanRequest\x1a\x1b.commercial.InstallmentPlan\x12i\n" +
+	"\x14ListInstallmentPlans\x12'.commercial.ListInstallmentPlansRequest\x1a(.commercial.ListInstallmentPlansResponse\x12X\n" +
+	"\x12GetInstallmentPlan\x12%.commercial.GetInstallmentPlanRequest\x1a\x1b.commercial.InstallmentPlan\x12P\n" +
+	"\x0ePayInstallment\x12!.commercial.PayInstallmentRequest\x1a\x1b.commercial.InstallmentPlan2\x83\x02\n" +
+	"\x0fExchangeService\x12`\n" +
+	"\x11ListExchangeRates\x12$.commercial.ListExchangeRatesRequest\x1a%.commercial.ListExchangeRatesResponse\x12O\n" +
+	"\x0fSetExchangeRate\x12\".commercial.SetExchangeRateRequest\x1a\x18.commercial.ExchangeRate\x12=\n" +
+	"\aConvert\x12\x1a.commercial.ConvertRequest\x1a\x16.commercial.Conversion2\xc4\x01\n" +
+	"\x14SpendingLimitService\x12U\n" +
+	"\x11GetSpendingLimits\x12$.commercial.GetSpendingLimitsRequest\x1a\x1a.commercial.SpendingLimits\x12U\n" +
+	"\x11SetSpendingLimits\x12$.commercial.SetSpendingLimitsRequest\x1a\x1a.commercial.SpendingLimits2\xac\x03\n" +
+	"\fFraudService\x12]\n" +
+	"\x10ListFraudReviews\x12#.commercial.ListFraudReviewsRequest\x1a$.commercial.ListFraudReviewsResponse\x12S\n" +
+	"\x12ResolveFraudReview\x12%.commercial.ResolveFraudReviewRequest\x1a\x16.commercial.FraudCheck\x12]\n" +
+	"\x10ListBlockedCards\x12#.commercial.ListBlockedCardsRequest\x1a$.commercial.ListBlockedCardsResponse\x12B\n" +
+	"\tBlockCard\x12\x1c.commercial.BlockCardRequest\x1a\x17.commercial.BlockedCard\x12E\n" +
+	"\vUnblockCard\x12\x1e.commercial.UnblockCardRequest\x1a\x16.google.protobuf.Empty2\xa0\x04\n" +
+	"\x13SubscriptionService\x12l\n" +
+	"\x15ListSubscriptionPlans\x12(.commercial.ListSubscriptionPlansRequest\x1a).commercial.ListSubscriptionPlansResponse\x12J\n" +
+	"\tSubscribe\x12\x1c.commercial.SubscribeRequest\x1a\x1f.commercial.SubscriptionPayment\x12O\n" +
+	"\x0fGetSubscription\x12\".commercial.GetSubscriptionRequest\x1a\x18.commercial.Subscription\x12U\n" +
+	"\x12CancelSubscription\x12%.commercial.CancelSubscriptionRequest\x1a\x18.commercial.Subscription\x12V\n" +
+	"\x0fPaySubscription\x12\".commercial.PaySubscriptionRequest\x1a\x1f.commercial.SubscriptionPayment\x12O\n" +
+	"\x0fGetEntitlements\x12\".commercial.GetEntitlementsRequest\x1a\x18.commercial.EntitlementsB\x1eZ\x1cmetargb/shared/pb/commercialb\x06proto3"
 
 var (
 	file_commercial_proto_rawDescOnce sync.Once
@@ -1993,75 +7287,245 @@ func file_commercial_proto_rawDescGZIP() []byte {
 	return file_commercial_proto_rawDescData
 }
 
-var file_commercial_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_commercial_proto_msgTypes = make([]protoimpl.MessageInfo, 96)
 var file_commercial_proto_goTypes = []any{
-	(*Wallet)(nil),                      // 0: commercial.Wallet
-	(*Transaction)(nil),                 // 1: commercial.Transaction
-	(*Order)(nil),                       // 2: commercial.Order
-	(*Payment)(nil),                     // 3: commercial.Payment
-	(*GetWalletRequest)(nil),            // 4: commercial.GetWalletRequest
-	(*WalletResponse)(nil),              // 5: commercial.WalletResponse
-	(*DeductBalanceRequest)(nil),        // 6: commercial.DeductBalanceRequest
-	(*DeductBalanceResponse)(nil),       // 7: commercial.DeductBalanceResponse
-	(*AddBalanceRequest)(nil),           // 8: commercial.AddBalanceRequest
-	(*AddBalanceResponse)(nil),          // 9: commercial.AddBalanceResponse
-	(*LockBalanceRequest)(nil),          // 10: commercial.LockBalanceRequest
-	(*UnlockBalanceRequest)(nil),        // 11: commercial.UnlockBalanceRequest
-	(*ListTransactionsRequest)(nil),     // 12: commercial.ListTransactionsRequest
-	(*ListTransactionsResponse)(nil),    // 13: commercial.ListTransactionsResponse
-	(*TransactionResource)(nil),         // 14: commercial.TransactionResource
-	(*GetLatestTransactionRequest)(nil), // 15: commercial.GetLatestTransactionRequest
-	(*LatestTransactionResponse)(nil),   // 16: commercial.LatestTransactionResponse
-	(*CreateTransactionRequest)(nil),    // 17: commercial.CreateTransactionRequest
-	(*InitiatePaymentRequest)(nil),      // 18: commercial.InitiatePaymentRequest
-	(*InitiatePaymentResponse)(nil),     // 19: commercial.InitiatePaymentResponse
-	(*HandleCallbackRequest)(nil),       // 20: commercial.HandleCallbackRequest
-	(*HandleCallbackResponse)(nil),      // 21: commercial.HandleCallbackResponse
-	(*VerifyPaymentRequest)(nil),        // 22: commercial.VerifyPaymentRequest
-	(*VerifyPaymentResponse)(nil),       // 23: commercial.VerifyPaymentResponse
-	(*timestamppb.Timestamp)(nil),       // 24: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),               // 25: google.protobuf.Empty
+	(*Wallet)(nil),                               // 0: commercial.Wallet
+	(*Transaction)(nil),                          // 1: commercial.Transaction
+	(*Order)(nil),                                // 2: commercial.Order
+	(*Payment)(nil),                              // 3: commercial.Payment
+	(*GetWalletRequest)(nil),                     // 4: commercial.GetWalletRequest
+	(*WalletResponse)(nil),                       // 5: commercial.WalletResponse
+	(*DeductBalanceRequest)(nil),                 // 6: commercial.DeductBalanceRequest
+	(*DeductBalanceResponse)(nil),                // 7: commercial.DeductBalanceResponse
+	(*AddBalanceRequest)(nil),                    // 8: commercial.AddBalanceRequest
+	(*AddBalanceResponse)(nil),                   // 9: commercial.AddBalanceResponse
+	(*LockBalanceRequest)(nil),                   // 10: commercial.LockBalanceRequest
+	(*UnlockBalanceRequest)(nil),                 // 11: commercial.UnlockBalanceRequest
+	(*ListTransactionsRequest)(nil),              // 12: commercial.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),             // 13: commercial.ListTransactionsResponse
+	(*TransactionResource)(nil),                  // 14: commercial.TransactionResource
+	(*GetLatestTransactionRequest)(nil),          // 15: commercial.GetLatestTransactionRequest
+	(*LatestTransactionResponse)(nil),            // 16: commercial.LatestTransactionResponse
+	(*CreateTransactionRequest)(nil),             // 17: commercial.CreateTransactionRequest
+	(*InitiatePaymentRequest)(nil),               // 18: commercial.InitiatePaymentRequest
+	(*ScreenPaymentRequest)(nil),                 // 19: commercial.ScreenPaymentRequest
+	(*ScreenPaymentCardRequest)(nil),             // 20: commercial.ScreenPaymentCardRequest
+	(*SettleOrderRequest)(nil),                   // 21: commercial.SettleOrderRequest
+	(*SettleOrderResponse)(nil),                  // 22: commercial.SettleOrderResponse
+	(*PublishOrderStatusRequest)(nil),            // 23: commercial.PublishOrderStatusRequest
+	(*InitiatePaymentResponse)(nil),              // 24: commercial.InitiatePaymentResponse
+	(*VerifyPaymentRequest)(nil),                 // 25: commercial.VerifyPaymentRequest
+	(*VerifyPaymentResponse)(nil),                // 26: commercial.VerifyPaymentResponse
+	(*ListOrdersRequest)(nil),                    // 27: commercial.ListOrdersRequest
+	(*ListOrdersResponse)(nil),                   // 28: commercial.ListOrdersResponse
+	(*EvaluateFirstOrderBonusRequest)(nil),       // 29: commercial.EvaluateFirstOrderBonusRequest
+	(*FirstOrderBonusEvaluation)(nil),            // 30: commercial.FirstOrderBonusEvaluation
+	(*OrderResource)(nil),                        // 31: commercial.OrderResource
+	(*GetVariablesRequest)(nil),                  // 32: commercial.GetVariablesRequest
+	(*GetVariablesResponse)(nil),                 // 33: commercial.GetVariablesResponse
+	(*Variable)(nil),                             // 34: commercial.Variable
+	(*ListVariablesRequest)(nil),                 // 35: commercial.ListVariablesRequest
+	(*ListVariablesResponse)(nil),                // 36: commercial.ListVariablesResponse
+	(*GetVariableRequest)(nil),                   // 37: commercial.GetVariableRequest
+	(*SetVariableRequest)(nil),                   // 38: commercial.SetVariableRequest
+	(*ListVariableChangesRequest)(nil),           // 39: commercial.ListVariableChangesRequest
+	(*VariableChange)(nil),                       // 40: commercial.VariableChange
+	(*ListVariableChangesResponse)(nil),          // 41: commercial.ListVariableChangesResponse
+	(*ScheduleVariableChangeRequest)(nil),        // 42: commercial.ScheduleVariableChangeRequest
+	(*ScheduledVariableChange)(nil),              // 43: commercial.ScheduledVariableChange
+	(*ListScheduledVariableChangesRequest)(nil),  // 44: commercial.ListScheduledVariableChangesRequest
+	(*ListScheduledVariableChangesResponse)(nil), // 45: commercial.ListScheduledVariableChangesResponse
+	(*CancelScheduledVariableChangeRequest)(nil), // 46: commercial.CancelScheduledVariableChangeRequest
+	(*DisplayRatesRequest)(nil),                  // 47: commercial.DisplayRatesRequest
+	(*DisplayRate)(nil),                          // 48: commercial.DisplayRate
+	(*DisplayRatesResponse)(nil),                 // 49: commercial.DisplayRatesResponse
+	(*CreateAdjustmentBatchRequest)(nil),         // 50: commercial.CreateAdjustmentBatchRequest
+	(*ListAdjustmentBatchesRequest)(nil),         // 51: commercial.ListAdjustmentBatchesRequest
+	(*ListAdjustmentBatchesResponse)(nil),        // 52: commercial.ListAdjustmentBatchesResponse
+	(*GetAdjustmentBatchRequest)(nil),            // 53: commercial.GetAdjustmentBatchRequest
+	(*ApproveAdjustmentBatchRequest)(nil),        // 54: commercial.ApproveAdjustmentBatchRequest
+	(*RejectAdjustmentBatchRequest)(nil),         // 55: commercial.RejectAdjustmentBatchRequest
+	(*AdjustmentBatch)(nil),                      // 56: commercial.AdjustmentBatch
+	(*AdjustmentEntry)(nil),                      // 57: commercial.AdjustmentEntry
+	(*CreateInstallmentPlanRequest)(nil),         // 58: commercial.CreateInstallmentPlanRequest
+	(*ListInstallmentPlansRequest)(nil),          // 59: commercial.ListInstallmentPlansRequest
+	(*ListInstallmentPlansResponse)(nil),         // 60: commercial.ListInstallmentPlansResponse
+	(*GetInstallmentPlanRequest)(nil),            // 61: commercial.GetInstallmentPlanRequest
+	(*PayInstallmentRequest)(nil),                // 62: commercial.PayInstallmentRequest
+	(*InstallmentPlan)(nil),                      // 63: commercial.InstallmentPlan
+	(*Installment)(nil),                          // 64: commercial.Installment
+	(*ListExchangeRatesRequest)(nil),             // 65: commercial.ListExchangeRatesRequest
+	(*ListExchangeRatesResponse)(nil),            // 66: commercial.ListExchangeRatesResponse
+	(*SetExchangeRateRequest)(nil),               // 67: commercial.SetExchangeRateRequest
+	(*ExchangeRate)(nil),                         // 68: commercial.ExchangeRate
+	(*ConvertRequest)(nil),                       // 69: commercial.ConvertRequest
+	(*Conversion)(nil),                           // 70: commercial.Conversion
+	(*GetSpendingLimitsRequest)(nil),             // 71: commercial.GetSpendingLimitsRequest
+	(*SetSpendingLimitsRequest)(nil),             // 72: commercial.SetSpendingLimitsRequest
+	(*SpendingLimits)(nil),                       // 73: commercial.SpendingLimits
+	(*AssetSpendingLimit)(nil),                   // 74: commercial.AssetSpendingLimit
+	(*ListFraudReviewsRequest)(nil),              // 75: commercial.ListFraudReviewsRequest
+	(*ListFraudReviewsResponse)(nil),             // 76: commercial.ListFraudReviewsResponse
+	(*ResolveFraudReviewRequest)(nil),            // 77: commercial.ResolveFraudReviewRequest
+	(*FraudCheck)(nil),                           // 78: commercial.FraudCheck
+	(*ListBlockedCardsRequest)(nil),              // 79: commercial.ListBlockedCardsRequest
+	(*ListBlockedCardsResponse)(nil),             // 80: commercial.ListBlockedCardsResponse
+	(*BlockCardRequest)(nil),                     // 81: commercial.BlockCardRequest
+	(*UnblockCardRequest)(nil),                   // 82: commercial.UnblockCardRequest
+	(*BlockedCard)(nil),                          // 83: commercial.BlockedCard
+	(*ListSubscriptionPlansRequest)(nil),         // 84: commercial.ListSubscriptionPlansRequest
+	(*ListSubscriptionPlansResponse)(nil),        // 85: commercial.ListSubscriptionPlansResponse
+	(*SubscriptionPlan)(nil),                     // 86: commercial.SubscriptionPlan
+	(*SubscribeRequest)(nil),                     // 87: commercial.SubscribeRequest
+	(*GetSubscriptionRequest)(nil),               // 88: commercial.GetSubscriptionRequest
+	(*CancelSubscriptionRequest)(nil),            // 89: commercial.CancelSubscriptionRequest
+	(*PaySubscriptionRequest)(nil),               // 90: commercial.PaySubscriptionRequest
+	(*SubscriptionPayment)(nil),                  // 91: commercial.SubscriptionPayment
+	(*Subscription)(nil),                         // 92: commercial.Subscription
+	(*GetEntitlementsRequest)(nil),               // 93: commercial.GetEntitlementsRequest
+	(*Entitlements)(nil),                         // 94: commercial.Entitlements
+	nil,                                          // 95: commercial.GetVariablesResponse.ValuesEntry
+	(*timestamppb.Timestamp)(nil),                // 96: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                        // 97: google.protobuf.Empty
 }
 var file_commercial_proto_depIdxs = []int32{
-	24, // 0: commercial.Wallet.created_at:type_name -> google.protobuf.Timestamp
-	24, // 1: commercial.Wallet.updated_at:type_name -> google.protobuf.Timestamp
-	24, // 2: commercial.Transaction.created_at:type_name -> google.protobuf.Timestamp
-	24, // 3: commercial.Transaction.updated_at:type_name -> google.protobuf.Timestamp
-	24, // 4: commercial.Order.created_at:type_name -> google.protobuf.Timestamp
-	24, // 5: commercial.Payment.created_at:type_name -> google.protobuf.Timestamp
+	96, // 0: commercial.Wallet.created_at:type_name -> google.protobuf.Timestamp
+	96, // 1: commercial.Wallet.updated_at:type_name -> google.protobuf.Timestamp
+	96, // 2: commercial.Transaction.created_at:type_name -> google.protobuf.Timestamp
+	96, // 3: commercial.Transaction.updated_at:type_name -> google.protobuf.Timestamp
+	96, // 4: commercial.Order.created_at:type_name -> google.protobuf.Timestamp
+	96, // 5: commercial.Payment.created_at:type_name -> google.protobuf.Timestamp
 	5,  // 6: commercial.DeductBalanceResponse.wallet:type_name -> commercial.WalletResponse
 	5,  // 7: commercial.AddBalanceResponse.wallet:type_name -> commercial.WalletResponse
 	14, // 8: commercial.ListTransactionsResponse.transactions:type_name -> commercial.TransactionResource
 	1,  // 9: commercial.LatestTransactionResponse.latest_transaction:type_name -> commercial.Transaction
 	3,  // 10: commercial.LatestTransactionResponse.latest_payment:type_name -> commercial.Payment
 	2,  // 11: commercial.LatestTransactionResponse.latest_order:type_name -> commercial.Order
-	4,  // 12: commercial.WalletService.GetWallet:input_type -> commercial.GetWalletRequest
-	6,  // 13: commercial.WalletService.DeductBalance:input_type -> commercial.DeductBalanceRequest
-	8,  // 14: commercial.WalletService.AddBalance:input_type -> commercial.AddBalanceRequest
-	10, // 15: commercial.WalletService.LockBalance:input_type -> commercial.LockBalanceRequest
-	11, // 16: commercial.WalletService.UnlockBalance:input_type -> commercial.UnlockBalanceRequest
-	12, // 17: commercial.TransactionService.ListTransactions:input_type -> commercial.ListTransactionsRequest
-	15, // 18: commercial.TransactionService.GetLatestTransaction:input_type -> commercial.GetLatestTransactionRequest
-	17, // 19: commercial.TransactionService.CreateTransaction:input_type -> commercial.CreateTransactionRequest
-	18, // 20: commercial.PaymentService.InitiatePayment:input_type -> commercial.InitiatePaymentRequest
-	20, // 21: commercial.PaymentService.HandleCallback:input_type -> commercial.HandleCallbackRequest
-	22, // 22: commercial.PaymentService.VerifyPayment:input_type -> commercial.VerifyPaymentRequest
-	5,  // 23: commercial.WalletService.GetWallet:output_type -> commercial.WalletResponse
-	7,  // 24: commercial.WalletService.DeductBalance:output_type -> commercial.DeductBalanceResponse
-	9,  // 25: commercial.WalletService.AddBalance:output_type -> commercial.AddBalanceResponse
-	25, // 26: commercial.WalletService.LockBalance:output_type -> google.protobuf.Empty
-	25, // 27: commercial.WalletService.UnlockBalance:output_type -> google.protobuf.Empty
-	13, // 28: commercial.TransactionService.ListTransactions:output_type -> commercial.ListTransactionsResponse
-	16, // 29: commercial.TransactionService.GetLatestTransaction:output_type -> commercial.LatestTransactionResponse
-	1,  // 30: commercial.TransactionService.CreateTransaction:output_type -> commercial.Transaction
-	19, // 31: commercial.PaymentService.InitiatePayment:output_type -> commercial.InitiatePaymentResponse
-	21, // 32: commercial.PaymentService.HandleCallback:output_type -> commercial.HandleCallbackResponse
-	23, // 33: commercial.PaymentService.VerifyPayment:output_type -> commercial.VerifyPaymentResponse
-	23, // [23:34] is the sub-list for method output_type
-	12, // [12:23] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	31, // 12: commercial.ListOrdersResponse.orders:type_name -> commercial.OrderResource
+	96, // 13: commercial.FirstOrderBonusEvaluation.window_ends_at:type_name -> google.protobuf.Timestamp
+	95, // 14: commercial.GetVariablesResponse.values:type_name -> commercial.GetVariablesResponse.ValuesEntry
+	34, // 15: commercial.ListVariablesResponse.variables:type_name -> commercial.Variable
+	40, // 16: commercial.ListVariableChangesResponse.changes:type_name -> commercial.VariableChange
+	43, // 17: commercial.ListScheduledVariableChangesResponse.changes:type_name -> commercial.ScheduledVariableChange
+	48, // 18: commercial.DisplayRatesResponse.rates:type_name -> commercial.DisplayRate
+	56, // 19: commercial.ListAdjustmentBatchesResponse.batches:type_name -> commercial.AdjustmentBatch
+	57, // 20: commercial.AdjustmentBatch.entries:type_name -> commercial.AdjustmentEntry
+	63, // 21: commercial.ListInstallmentPlansResponse.plans:type_name -> commercial.InstallmentPlan
+	64, // 22: commercial.InstallmentPlan.installments:type_name -> commercial.Installment
+	68, // 23: commercial.ListExchangeRatesResponse.rates:type_name -> commercial.ExchangeRate
+	74, // 24: commercial.SpendingLimits.psc:type_name -> commercial.AssetSpendingLimit
+	74, // 25: commercial.SpendingLimits.irr:type_name -> commercial.AssetSpendingLimit
+	78, // 26: commercial.ListFraudReviewsResponse.checks:type_name -> commercial.FraudCheck
+	83, // 27: commercial.ListBlockedCardsResponse.cards:type_name -> commercial.BlockedCard
+	86, // 28: commercial.ListSubscriptionPlansResponse.plans:type_name -> commercial.SubscriptionPlan
+	92, // 29: commercial.SubscriptionPayment.subscription:type_name -> commercial.Subscription
+	86, // 30: commercial.Subscription.plan:type_name -> commercial.SubscriptionPlan
+	96, // 31: commercial.Entitlements.expires_at:type_name -> google.protobuf.Timestamp
+	4,  // 32: commercial.WalletService.GetWallet:input_type -> commercial.GetWalletRequest
+	6,  // 33: commercial.WalletService.DeductBalance:input_type -> commercial.DeductBalanceRequest
+	8,  // 34: commercial.WalletService.AddBalance:input_type -> commercial.AddBalanceRequest
+	10, // 35: commercial.WalletService.LockBalance:input_type -> commercial.LockBalanceRequest
+	11, // 36: commercial.WalletService.UnlockBalance:input_type -> commercial.UnlockBalanceRequest
+	12, // 37: commercial.TransactionService.ListTransactions:input_type -> commercial.ListTransactionsRequest
+	15, // 38: commercial.TransactionService.GetLatestTransaction:input_type -> commercial.GetLatestTransactionRequest
+	17, // 39: commercial.TransactionService.CreateTransaction:input_type -> commercial.CreateTransactionRequest
+	18, // 40: commercial.PaymentService.InitiatePayment:input_type -> commercial.InitiatePaymentRequest
+	25, // 41: commercial.PaymentService.VerifyPayment:input_type -> commercial.VerifyPaymentRequest
+	19, // 42: commercial.PaymentService.ScreenPayment:input_type -> commercial.ScreenPaymentRequest
+	20, // 43: commercial.PaymentService.ScreenPaymentCard:input_type -> commercial.ScreenPaymentCardRequest
+	21, // 44: commercial.PaymentService.SettleOrder:input_type -> commercial.SettleOrderRequest
+	23, // 45: commercial.PaymentService.PublishOrderStatus:input_type -> commercial.PublishOrderStatusRequest
+	27, // 46: commercial.OrderService.ListOrders:input_type -> commercial.ListOrdersRequest
+	29, // 47: commercial.OrderService.EvaluateFirstOrderBonus:input_type -> commercial.EvaluateFirstOrderBonusRequest
+	32, // 48: commercial.VariableService.GetVariables:input_type -> commercial.GetVariablesRequest
+	35, // 49: commercial.VariableService.ListVariables:input_type -> commercial.ListVariablesRequest
+	37, // 50: commercial.VariableService.GetVariable:input_type -> commercial.GetVariableRequest
+	38, // 51: commercial.VariableService.SetVariable:input_type -> commercial.SetVariableRequest
+	39, // 52: commercial.VariableService.ListVariableChanges:input_type -> commercial.ListVariableChangesRequest
+	42, // 53: commercial.VariableService.ScheduleVariableChange:input_type -> commercial.ScheduleVariableChangeRequest
+	44, // 54: commercial.VariableService.ListScheduledVariableChanges:input_type -> commercial.ListScheduledVariableChangesRequest
+	46, // 55: commercial.VariableService.CancelScheduledVariableChange:input_type -> commercial.CancelScheduledVariableChangeRequest
+	47, // 56: commercial.VariableService.DisplayRates:input_type -> commercial.DisplayRatesRequest
+	50, // 57: commercial.WalletAdjustmentService.CreateAdjustmentBatch:input_type -> commercial.CreateAdjustmentBatchRequest
+	51, // 58: commercial.WalletAdjustmentService.ListAdjustmentBatches:input_type -> commercial.ListAdjustmentBatchesRequest
+	53, // 59: commercial.WalletAdjustmentService.GetAdjustmentBatch:input_type -> commercial.GetAdjustmentBatchRequest
+	54, // 60: commercial.WalletAdjustmentService.ApproveAdjustmentBatch:input_type -> commercial.ApproveAdjustmentBatchRequest
+	55, // 61: commercial.WalletAdjustmentService.RejectAdjustmentBatch:input_type -> commercial.RejectAdjustmentBatchRequest
+	58, // 62: commercial.InstallmentService.CreateInstallmentPlan:input_type -> commercial.CreateInstallmentPlanRequest
+	59, // 63: commercial.InstallmentService.ListInstallmentPlans:input_type -> commercial.ListInstallmentPlansRequest
+	61, // 64: commercial.InstallmentService.GetInstallmentPlan:input_type -> commercial.GetInstallmentPlanRequest
+	62, // 65: commercial.InstallmentService.PayInstallment:input_type -> commercial.PayInstallmentRequest
+	65, // 66: commercial.ExchangeService.ListExchangeRates:input_type -> commercial.ListExchangeRatesRequest
+	67, // 67: commercial.ExchangeService.SetExchangeRate:input_type -> commercial.SetExchangeRateRequest
+	69, // 68: commercial.ExchangeService.Convert:input_type -> commercial.ConvertRequest
+	71, // 69: commercial.SpendingLimitService.GetSpendingLimits:input_type -> commercial.GetSpendingLimitsRequest
+	72, // 70: commercial.SpendingLimitService.SetSpendingLimits:input_type -> commercial.SetSpendingLimitsRequest
+	75, // 71: commercial.FraudService.ListFraudReviews:input_type -> commercial.ListFraudReviewsRequest
+	77, // 72: commercial.FraudService.ResolveFraudReview:input_type -> commercial.ResolveFraudReviewRequest
+	79, // 73: commercial.FraudService.ListBlockedCards:input_type -> commercial.ListBlockedCardsRequest
+	81, // 74: commercial.FraudService.BlockCard:input_type -> commercial.BlockCardRequest
+	82, // 75: commercial.FraudService.UnblockCard:input_type -> commercial.UnblockCardRequest
+	84, // 76: commercial.SubscriptionService.ListSubscriptionPlans:input_type -> commercial.ListSubscriptionPlansRequest
+	87, // 77: commercial.SubscriptionService.Subscribe:input_type -> commercial.SubscribeRequest
+	88, // 78: commercial.SubscriptionService.GetSubscription:input_type -> commercial.GetSubscriptionRequest
+	89, // 79: commercial.SubscriptionService.CancelSubscription:input_type -> commercial.CancelSubscriptionRequest
+	90, // 80: commercial.SubscriptionService.PaySubscription:input_type -> commercial.PaySubscriptionRequest
+	93, // 81: commercial.SubscriptionService.GetEntitlements:input_type -> commercial.GetEntitlementsRequest
+	5,  // 82: commercial.WalletService.GetWallet:output_type -> commercial.WalletResponse
+	7,  // 83: commercial.WalletService.DeductBalance:output_type -> commercial.DeductBalanceResponse
+	9,  // 84: commercial.WalletService.AddBalance:output_type -> commercial.AddBalanceResponse
+	97, // 85: commercial.WalletService.LockBalance:output_type -> google.protobuf.Empty
+	97, // 86: commercial.WalletService.UnlockBalance:output_type -> google.protobuf.Empty
+	13, // 87: commercial.TransactionService.ListTransactions:output_type -> commercial.ListTransactionsResponse
+	16, // 88: commercial.TransactionService.GetLatestTransaction:output_type -> commercial.LatestTransactionResponse
+	1,  // 89: commercial.TransactionService.CreateTransaction:output_type -> commercial.Transaction
+	24, // 90: commercial.PaymentService.InitiatePayment:output_type -> commercial.InitiatePaymentResponse
+	26, // 91: commercial.PaymentService.VerifyPayment:output_type -> commercial.VerifyPaymentResponse
+	97, // 92: commercial.PaymentService.ScreenPayment:output_type -> google.protobuf.Empty
+	97, // 93: commercial.PaymentService.ScreenPaymentCard:output_type -> google.protobuf.Empty
+	22, // 94: commercial.PaymentService.SettleOrder:output_type -> commercial.SettleOrderResponse
+	97, // 95: commercial.PaymentService.PublishOrderStatus:output_type -> google.protobuf.Empty
+	28, // 96: commercial.OrderService.ListOrders:output_type -> commercial.ListOrdersResponse
+	30, // 97: commercial.OrderService.EvaluateFirstOrderBonus:output_type -> commercial.FirstOrderBonusEvaluation
+	33, // 98: commercial.VariableService.GetVariables:output_type -> commercial.GetVariablesResponse
+	36, // 99: commercial.VariableService.ListVariables:output_type -> commercial.ListVariablesResponse
+	34, // 100: commercial.VariableService.GetVariable:output_type -> commercial.Variable
+	34, // 101: commercial.VariableService.SetVariable:output_type -> commercial.Variable
+	41, // 102: commercial.VariableService.ListVariableChanges:output_type -> commercial.ListVariableChangesResponse
+	43, // 103: commercial.VariableService.ScheduleVariableChange:output_type -> commercial.ScheduledVariableChange
+	45, // 104: commercial.VariableService.ListScheduledVariableChanges:output_type -> commercial.ListScheduledVariableChangesResponse
+	43, // 105: commercial.VariableService.CancelScheduledVariableChange:output_type -> commercial.ScheduledVariableChange
+	49, // 106: commercial.VariableService.DisplayRates:output_type -> commercial.DisplayRatesResponse
+	56, // 107: commercial.WalletAdjustmentService.CreateAdjustmentBatch:output_type -> commercial.AdjustmentBatch
+	52, // 108: commercial.WalletAdjustmentService.ListAdjustmentBatches:output_type -> commercial.ListAdjustmentBatchesResponse
+	56, // 109: commercial.WalletAdjustmentService.GetAdjustmentBatch:output_type -> commercial.AdjustmentBatch
+	56, // 110: commercial.WalletAdjustmentService.ApproveAdjustmentBatch:output_type -> commercial.AdjustmentBatch
+	56, // 111: commercial.WalletAdjustmentService.RejectAdjustmentBatch:output_type -> commercial.AdjustmentBatch
+	63, // 112: commercial.InstallmentService.CreateInstallmentPlan:output_type -> commercial.InstallmentPlan
+	60, // 113: commercial.InstallmentService.ListInstallmentPlans:output_type -> commercial.ListInstallmentPlansResponse
+	63, // 114: commercial.InstallmentService.GetInstallmentPlan:output_type -> commercial.InstallmentPlan
+	63, // 115: commercial.InstallmentService.PayInstallment:output_type -> commercial.InstallmentPlan
+	66, // 116: commercial.ExchangeService.ListExchangeRates:output_type -> commercial.ListExchangeRatesResponse
+	68, // 117: commercial.ExchangeService.SetExchangeRate:output_type -> commercial.ExchangeRate
+	70, // 118: commercial.ExchangeService.Convert:output_type -> commercial.Conversion
+	73, // 119: commercial.SpendingLimitService.GetSpendingLimits:output_type -> commercial.SpendingLimits
+	73, // 120: commercial.SpendingLimitService.SetSpendingLimits:output_type -> commercial.SpendingLimits
+	76, // 121: commercial.FraudService.ListFraudReviews:output_type -> commercial.ListFraudReviewsResponse
+	78, // 122: commercial.FraudService.ResolveFraudReview:output_type -> commercial.FraudCheck
+	80, // 123: commercial.FraudService.ListBlockedCards:output_type -> commercial.ListBlockedCardsResponse
+	83, // 124: commercial.FraudService.BlockCard:output_type -> commercial.BlockedCard
+	97, // 125: commercial.FraudService.UnblockCard:output_type -> google.protobuf.Empty
+	85, // 126: commercial.SubscriptionService.ListSubscriptionPlans:output_type -> commercial.ListSubscriptionPlansResponse
+	91, // 127: commercial.SubscriptionService.Subscribe:output_type -> commercial.SubscriptionPayment
+	92, // 128: commercial.SubscriptionService.GetSubscription:output_type -> commercial.Subscription
+	92, // 129: commercial.SubscriptionService.CancelSubscription:output_type -> commercial.Subscription
+	91, // 130: commercial.SubscriptionService.PaySubscription:output_type -> commercial.SubscriptionPayment
+	94, // 131: commercial.SubscriptionService.GetEntitlements:output_type -> commercial.Entitlements
+	82, // [82:132] is the sub-list for method output_type
+	32, // [32:82] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_commercial_proto_init() }
@@ -2075,9 +7539,9 @@ func file_commercial_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_commercial_proto_rawDesc), len(file_commercial_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   96,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   11,
 		},
 		GoTypes:           file_commercial_proto_goTypes,
 		DependencyIndexes: file_commercial_proto_depIdxs,
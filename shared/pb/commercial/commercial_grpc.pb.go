@@ -460,9 +460,12 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	PaymentService_InitiatePayment_FullMethodName = "/commercial.PaymentService/InitiatePayment"
-	PaymentService_HandleCallback_FullMethodName  = "/commercial.PaymentService/HandleCallback"
-	PaymentService_VerifyPayment_FullMethodName   = "/commercial.PaymentService/VerifyPayment"
+	PaymentService_InitiatePayment_FullMethodName    = "/commercial.PaymentService/InitiatePayment"
+	PaymentService_VerifyPayment_FullMethodName      = "/commercial.PaymentService/VerifyPayment"
+	PaymentService_ScreenPayment_FullMethodName      = "/commercial.PaymentService/ScreenPayment"
+	PaymentService_ScreenPaymentCard_FullMethodName  = "/commercial.PaymentService/ScreenPaymentCard"
+	PaymentService_SettleOrder_FullMethodName        = "/commercial.PaymentService/SettleOrder"
+	PaymentService_PublishOrderStatus_FullMethodName = "/commercial.PaymentService/PublishOrderStatus"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
@@ -472,8 +475,20 @@ const (
 // Payment Service - handles payment gateway integration
 type PaymentServiceClient interface {
 	InitiatePayment(ctx context.Context, in *InitiatePaymentRequest, opts ...grpc.CallOption) (*InitiatePaymentResponse, error)
-	HandleCallback(ctx context.Context, in *HandleCallbackRequest, opts ...grpc.CallOption) (*HandleCallbackResponse, error)
 	VerifyPayment(ctx context.Context, in *VerifyPaymentRequest, opts ...grpc.CallOption) (*VerifyPaymentResponse, error)
+	// Fraud rules for the store orders of financial-service, which calls them
+	// with a service:payments key. A refused payment fails with FailedPrecondition.
+	ScreenPayment(ctx context.Context, in *ScreenPaymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ScreenPaymentCard(ctx context.Context, in *ScreenPaymentCardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Credits a store order financial-service has verified with the bank: the
+	// order amount plus the first order bonus when the first_order_* rules grant
+	// it, and the referrer's commission for non-irr assets. Needs
+	// service:payments; fails with FailedPrecondition unless the order is paid.
+	SettleOrder(ctx context.Context, in *SettleOrderRequest, opts ...grpc.CallOption) (*SettleOrderResponse, error)
+	// Announces a pending or failed store order of financial-service on the
+	// order-status channel of the WebSocket gateway; SettleOrder announces paid
+	// orders. Needs service:payments.
+	PublishOrderStatus(ctx context.Context, in *PublishOrderStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type paymentServiceClient struct {
@@ -494,20 +509,50 @@ func (c *paymentServiceClient) InitiatePayment(ctx context.Context, in *Initiate
 	return out, nil
 }
 
-func (c *paymentServiceClient) HandleCallback(ctx context.Context, in *HandleCallbackRequest, opts ...grpc.CallOption) (*HandleCallbackResponse, error) {
+func (c *paymentServiceClient) VerifyPayment(ctx context.Context, in *VerifyPaymentRequest, opts ...grpc.CallOption) (*VerifyPaymentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(HandleCallbackResponse)
-	err := c.cc.Invoke(ctx, PaymentService_HandleCallback_FullMethodName, in, out, cOpts...)
+	out := new(VerifyPaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_VerifyPayment_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *paymentServiceClient) VerifyPayment(ctx context.Context, in *VerifyPaymentRequest, opts ...grpc.CallOption) (*VerifyPaymentResponse, error) {
+func (c *paymentServiceClient) ScreenPayment(ctx context.Context, in *ScreenPaymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(VerifyPaymentResponse)
-	err := c.cc.Invoke(ctx, PaymentService_VerifyPayment_FullMethodName, in, out, cOpts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PaymentService_ScreenPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ScreenPaymentCard(ctx context.Context, in *ScreenPaymentCardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PaymentService_ScreenPaymentCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) SettleOrder(ctx context.Context, in *SettleOrderRequest, opts ...grpc.CallOption) (*SettleOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettleOrderResponse)
+	err := c.cc.Invoke(ctx, PaymentService_SettleOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) PublishOrderStatus(ctx context.Context, in *PublishOrderStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PaymentService_PublishOrderStatus_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -521,8 +566,20 @@ func (c *paymentServiceClient) VerifyPayment(ctx context.Context, in *VerifyPaym
 // Payment Service - handles payment gateway integration
 type PaymentServiceServer interface {
 	InitiatePayment(context.Context, *InitiatePaymentRequest) (*InitiatePaymentResponse, error)
-	HandleCallback(context.Context, *HandleCallbackRequest) (*HandleCallbackResponse, error)
 	VerifyPayment(context.Context, *VerifyPaymentRequest) (*VerifyPaymentResponse, error)
+	// Fraud rules for the store orders of financial-service, which calls them
+	// with a service:payments key. A refused payment fails with FailedPrecondition.
+	ScreenPayment(context.Context, *ScreenPaymentRequest) (*emptypb.Empty, error)
+	ScreenPaymentCard(context.Context, *ScreenPaymentCardRequest) (*emptypb.Empty, error)
+	// Credits a store order financial-service has verified with the bank: the
+	// order amount plus the first order bonus when the first_order_* rules grant
+	// it, and the referrer's commission for non-irr assets. Needs
+	// service:payments; fails with FailedPrecondition unless the order is paid.
+	SettleOrder(context.Context, *SettleOrderRequest) (*SettleOrderResponse, error)
+	// Announces a pending or failed store order of financial-service on the
+	// order-status channel of the WebSocket gateway; SettleOrder announces paid
+	// orders. Needs service:payments.
+	PublishOrderStatus(context.Context, *PublishOrderStatusRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -536,12 +593,21 @@ type UnimplementedPaymentServiceServer struct{}
 func (UnimplementedPaymentServiceServer) InitiatePayment(context.Context, *InitiatePaymentRequest) (*InitiatePaymentResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InitiatePayment not implemented")
 }
-func (UnimplementedPaymentServiceServer) HandleCallback(context.Context, *HandleCallbackRequest) (*HandleCallbackResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method HandleCallback not implemented")
-}
 func (UnimplementedPaymentServiceServer) VerifyPayment(context.Context, *VerifyPaymentRequest) (*VerifyPaymentResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method VerifyPayment not implemented")
 }
+func (UnimplementedPaymentServiceServer) ScreenPayment(context.Context, *ScreenPaymentRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScreenPayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) ScreenPaymentCard(context.Context, *ScreenPaymentCardRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScreenPaymentCard not implemented")
+}
+func (UnimplementedPaymentServiceServer) SettleOrder(context.Context, *SettleOrderRequest) (*SettleOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SettleOrder not implemented")
+}
+func (UnimplementedPaymentServiceServer) PublishOrderStatus(context.Context, *PublishOrderStatusRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method PublishOrderStatus not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
@@ -581,38 +647,92 @@ func _PaymentService_InitiatePayment_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_HandleCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HandleCallbackRequest)
+func _PaymentService_VerifyPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).HandleCallback(ctx, in)
+		return srv.(PaymentServiceServer).VerifyPayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_HandleCallback_FullMethodName,
+		FullMethod: PaymentService_VerifyPayment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).HandleCallback(ctx, req.(*HandleCallbackRequest))
+		return srv.(PaymentServiceServer).VerifyPayment(ctx, req.(*VerifyPaymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_VerifyPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifyPaymentRequest)
+func _PaymentService_ScreenPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScreenPaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).VerifyPayment(ctx, in)
+		return srv.(PaymentServiceServer).ScreenPayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_VerifyPayment_FullMethodName,
+		FullMethod: PaymentService_ScreenPayment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).VerifyPayment(ctx, req.(*VerifyPaymentRequest))
+		return srv.(PaymentServiceServer).ScreenPayment(ctx, req.(*ScreenPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ScreenPaymentCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScreenPaymentCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ScreenPaymentCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ScreenPaymentCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ScreenPaymentCard(ctx, req.(*ScreenPaymentCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_SettleOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).SettleOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_SettleOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).SettleOrder(ctx, req.(*SettleOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_PublishOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishOrderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).PublishOrderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_PublishOrderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).PublishOrderStatus(ctx, req.(*PublishOrderStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -628,14 +748,1981 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InitiatePayment",
 			Handler:    _PaymentService_InitiatePayment_Handler,
 		},
-		{
-			MethodName: "HandleCallback",
-			Handler:    _PaymentService_HandleCallback_Handler,
-		},
 		{
 			MethodName: "VerifyPayment",
 			Handler:    _PaymentService_VerifyPayment_Handler,
 		},
+		{
+			MethodName: "ScreenPayment",
+			Handler:    _PaymentService_ScreenPayment_Handler,
+		},
+		{
+			MethodName: "ScreenPaymentCard",
+			Handler:    _PaymentService_ScreenPaymentCard_Handler,
+		},
+		{
+			MethodName: "SettleOrder",
+			Handler:    _PaymentService_SettleOrder_Handler,
+		},
+		{
+			MethodName: "PublishOrderStatus",
+			Handler:    _PaymentService_PublishOrderStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "commercial.proto",
+}
+
+const (
+	OrderService_ListOrders_FullMethodName              = "/commercial.OrderService/ListOrders"
+	OrderService_EvaluateFirstOrderBonus_FullMethodName = "/commercial.OrderService/EvaluateFirstOrderBonus"
+)
+
+// OrderServiceClient is the client API for OrderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Order Service - handles order history
+type OrderServiceClient interface {
+	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
+	// Applies the first order bonus rules (the first_order_* variables) to an
+	// order the user could place, as the payment callback would once it is paid
+	EvaluateFirstOrderBonus(ctx context.Context, in *EvaluateFirstOrderBonusRequest, opts ...grpc.CallOption) (*FirstOrderBonusEvaluation, error)
+}
+
+type orderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrderServiceClient(cc grpc.ClientConnInterface) OrderServiceClient {
+	return &orderServiceClient{cc}
+}
+
+func (c *orderServiceClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) EvaluateFirstOrderBonus(ctx context.Context, in *EvaluateFirstOrderBonusRequest, opts ...grpc.CallOption) (*FirstOrderBonusEvaluation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FirstOrderBonusEvaluation)
+	err := c.cc.Invoke(ctx, OrderService_EvaluateFirstOrderBonus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrderServiceServer is the server API for OrderService service.
+// All implementations must embed UnimplementedOrderServiceServer
+// for forward compatibility.
+//
+// Order Service - handles order history
+type OrderServiceServer interface {
+	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
+	// Applies the first order bonus rules (the first_order_* variables) to an
+	// order the user could place, as the payment callback would once it is paid
+	EvaluateFirstOrderBonus(context.Context, *EvaluateFirstOrderBonusRequest) (*FirstOrderBonusEvaluation, error)
+	mustEmbedUnimplementedOrderServiceServer()
+}
+
+// UnimplementedOrderServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOrderServiceServer struct{}
+
+func (UnimplementedOrderServiceServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) EvaluateFirstOrderBonus(context.Context, *EvaluateFirstOrderBonusRequest) (*FirstOrderBonusEvaluation, error) {
+	return nil, status.Error(codes.Unimplemented, "method EvaluateFirstOrderBonus not implemented")
+}
+func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
+func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeOrderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrderServiceServer will
+// result in compilation errors.
+type UnsafeOrderServiceServer interface {
+	mustEmbedUnimplementedOrderServiceServer()
+}
+
+func RegisterOrderServiceServer(s grpc.ServiceRegistrar, srv OrderServiceServer) {
+	// If the following call panics, it indicates UnimplementedOrderServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OrderService_ServiceDesc, srv)
+}
+
+func _OrderService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListOrders(ctx, req.(*ListOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_EvaluateFirstOrderBonus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateFirstOrderBonusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).EvaluateFirstOrderBonus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_EvaluateFirstOrderBonus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).EvaluateFirstOrderBonus(ctx, req.(*EvaluateFirstOrderBonusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "commercial.OrderService",
+	HandlerType: (*OrderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListOrders",
+			Handler:    _OrderService_ListOrders_Handler,
+		},
+		{
+			MethodName: "EvaluateFirstOrderBonus",
+			Handler:    _OrderService_EvaluateFirstOrderBonus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "commercial.proto",
+}
+
+const (
+	VariableService_GetVariables_FullMethodName                  = "/commercial.VariableService/GetVariables"
+	VariableService_ListVariables_FullMethodName                 = "/commercial.VariableService/ListVariables"
+	VariableService_GetVariable_FullMethodName                   = "/commercial.VariableService/GetVariable"
+	VariableService_SetVariable_FullMethodName                   = "/commercial.VariableService/SetVariable"
+	VariableService_ListVariableChanges_FullMethodName           = "/commercial.VariableService/ListVariableChanges"
+	VariableService_ScheduleVariableChange_FullMethodName        = "/commercial.VariableService/ScheduleVariableChange"
+	VariableService_ListScheduledVariableChanges_FullMethodName  = "/commercial.VariableService/ListScheduledVariableChanges"
+	VariableService_CancelScheduledVariableChange_FullMethodName = "/commercial.VariableService/CancelScheduledVariableChange"
+	VariableService_DisplayRates_FullMethodName                  = "/commercial.VariableService/DisplayRates"
+)
+
+// VariableServiceClient is the client API for VariableService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Variable Service - exposes exchange rates from the variables table so other
+// services do not read it directly
+type VariableServiceClient interface {
+	GetVariables(ctx context.Context, in *GetVariablesRequest, opts ...grpc.CallOption) (*GetVariablesResponse, error)
+	// Admin API over the known variables. Setting a value records it in the
+	// change history and publishes a variable-changed event so caches reload it.
+	ListVariables(ctx context.Context, in *ListVariablesRequest, opts ...grpc.CallOption) (*ListVariablesResponse, error)
+	GetVariable(ctx context.Context, in *GetVariableRequest, opts ...grpc.CallOption) (*Variable, error)
+	SetVariable(ctx context.Context, in *SetVariableRequest, opts ...grpc.CallOption) (*Variable, error)
+	ListVariableChanges(ctx context.Context, in *ListVariableChangesRequest, opts ...grpc.CallOption) (*ListVariableChangesResponse, error)
+	// Scheduled changes are set at effective_at as if their admin set them
+	// then, and published so services pick up the new value at that time.
+	ScheduleVariableChange(ctx context.Context, in *ScheduleVariableChangeRequest, opts ...grpc.CallOption) (*ScheduledVariableChange, error)
+	ListScheduledVariableChanges(ctx context.Context, in *ListScheduledVariableChangesRequest, opts ...grpc.CallOption) (*ListScheduledVariableChangesResponse, error)
+	CancelScheduledVariableChange(ctx context.Context, in *CancelScheduledVariableChangeRequest, opts ...grpc.CallOption) (*ScheduledVariableChange, error)
+	// Current asset rates with their change over the last 24 hours, for price
+	// tickers. Public, like GetVariables.
+	DisplayRates(ctx context.Context, in *DisplayRatesRequest, opts ...grpc.CallOption) (*DisplayRatesResponse, error)
+}
+
+type variableServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVariableServiceClient(cc grpc.ClientConnInterface) VariableServiceClient {
+	return &variableServiceClient{cc}
+}
+
+func (c *variableServiceClient) GetVariables(ctx context.Context, in *GetVariablesRequest, opts ...grpc.CallOption) (*GetVariablesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVariablesResponse)
+	err := c.cc.Invoke(ctx, VariableService_GetVariables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variableServiceClient) ListVariables(ctx context.Context, in *ListVariablesRequest, opts ...grpc.CallOption) (*ListVariablesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVariablesResponse)
+	err := c.cc.Invoke(ctx, VariableService_ListVariables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variableServiceClient) GetVariable(ctx context.Context, in *GetVariableRequest, opts ...grpc.CallOption) (*Variable, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Variable)
+	err := c.cc.Invoke(ctx, VariableService_GetVariable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variableServiceClient) SetVariable(ctx context.Context, in *SetVariableRequest, opts ...grpc.CallOption) (*Variable, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Variable)
+	err := c.cc.Invoke(ctx, VariableService_SetVariable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variableServiceClient) ListVariableChanges(ctx context.Context, in *ListVariableChangesRequest, opts ...grpc.CallOption) (*ListVariableChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVariableChangesResponse)
+	err := c.cc.Invoke(ctx, VariableService_ListVariableChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variableServiceClient) ScheduleVariableChange(ctx context.Context, in *ScheduleVariableChangeRequest, opts ...grpc.CallOption) (*ScheduledVariableChange, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledVariableChange)
+	err := c.cc.Invoke(ctx, VariableService_ScheduleVariableChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variableServiceClient) ListScheduledVariableChanges(ctx context.Context, in *ListScheduledVariableChangesRequest, opts ...grpc.CallOption) (*ListScheduledVariableChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledVariableChangesResponse)
+	err := c.cc.Invoke(ctx, VariableService_ListScheduledVariableChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variableServiceClient) CancelScheduledVariableChange(ctx context.Context, in *CancelScheduledVariableChangeRequest, opts ...grpc.CallOption) (*ScheduledVariableChange, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledVariableChange)
+	err := c.cc.Invoke(ctx, VariableService_CancelScheduledVariableChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variableServiceClient) DisplayRates(ctx context.Context, in *DisplayRatesRequest, opts ...grpc.CallOption) (*DisplayRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisplayRatesResponse)
+	err := c.cc.Invoke(ctx, VariableService_DisplayRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VariableServiceServer is the server API for VariableService service.
+// All implementations must embed UnimplementedVariableServiceServer
+// for forward compatibility.
+//
+// Variable Service - exposes exchange rates from the variables table so other
+// services do not read it directly
+type VariableServiceServer interface {
+	GetVariables(context.Context, *GetVariablesRequest) (*GetVariablesResponse, error)
+	// Admin API over the known variables. Setting a value records it in the
+	// change history and publishes a variable-changed event so caches reload it.
+	ListVariables(context.Context, *ListVariablesRequest) (*ListVariablesResponse, error)
+	GetVariable(context.Context, *GetVariableRequest) (*Variable, error)
+	SetVariable(context.Context, *SetVariableRequest) (*Variable, error)
+	ListVariableChanges(context.Context, *ListVariableChangesRequest) (*ListVariableChangesResponse, error)
+	// Scheduled changes are set at effective_at as if their admin set them
+	// then, and published so services pick up the new value at that time.
+	ScheduleVariableChange(context.Context, *ScheduleVariableChangeRequest) (*ScheduledVariableChange, error)
+	ListScheduledVariableChanges(context.Context, *ListScheduledVariableChangesRequest) (*ListScheduledVariableChangesResponse, error)
+	CancelScheduledVariableChange(context.Context, *CancelScheduledVariableChangeRequest) (*ScheduledVariableChange, error)
+	// Current asset rates with their change over the last 24 hours, for price
+	// tickers. Public, like GetVariables.
+	DisplayRates(context.Context, *DisplayRatesRequest) (*DisplayRatesResponse, error)
+	mustEmbedUnimplementedVariableServiceServer()
+}
+
+// UnimplementedVariableServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVariableServiceServer struct{}
+
+func (UnimplementedVariableServiceServer) GetVariables(context.Context, *GetVariablesRequest) (*GetVariablesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVariables not implemented")
+}
+func (UnimplementedVariableServiceServer) ListVariables(context.Context, *ListVariablesRequest) (*ListVariablesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVariables not implemented")
+}
+func (UnimplementedVariableServiceServer) GetVariable(context.Context, *GetVariableRequest) (*Variable, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVariable not implemented")
+}
+func (UnimplementedVariableServiceServer) SetVariable(context.Context, *SetVariableRequest) (*Variable, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetVariable not implemented")
+}
+func (UnimplementedVariableServiceServer) ListVariableChanges(context.Context, *ListVariableChangesRequest) (*ListVariableChangesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVariableChanges not implemented")
+}
+func (UnimplementedVariableServiceServer) ScheduleVariableChange(context.Context, *ScheduleVariableChangeRequest) (*ScheduledVariableChange, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScheduleVariableChange not implemented")
+}
+func (UnimplementedVariableServiceServer) ListScheduledVariableChanges(context.Context, *ListScheduledVariableChangesRequest) (*ListScheduledVariableChangesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScheduledVariableChanges not implemented")
+}
+func (UnimplementedVariableServiceServer) CancelScheduledVariableChange(context.Context, *CancelScheduledVariableChangeRequest) (*ScheduledVariableChange, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelScheduledVariableChange not implemented")
+}
+func (UnimplementedVariableServiceServer) DisplayRates(context.Context, *DisplayRatesRequest) (*DisplayRatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisplayRates not implemented")
+}
+func (UnimplementedVariableServiceServer) mustEmbedUnimplementedVariableServiceServer() {}
+func (UnimplementedVariableServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeVariableServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VariableServiceServer will
+// result in compilation errors.
+type UnsafeVariableServiceServer interface {
+	mustEmbedUnimplementedVariableServiceServer()
+}
+
+func RegisterVariableServiceServer(s grpc.ServiceRegistrar, srv VariableServiceServer) {
+	// If the following call panics, it indicates UnimplementedVariableServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VariableService_ServiceDesc, srv)
+}
+
+func _VariableService_GetVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariableServiceServer).GetVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariableService_GetVariables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariableServiceServer).GetVariables(ctx, req.(*GetVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariableService_ListVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariableServiceServer).ListVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariableService_ListVariables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariableServiceServer).ListVariables(ctx, req.(*ListVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariableService_GetVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVariableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariableServiceServer).GetVariable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariableService_GetVariable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariableServiceServer).GetVariable(ctx, req.(*GetVariableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariableService_SetVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVariableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariableServiceServer).SetVariable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariableService_SetVariable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariableServiceServer).SetVariable(ctx, req.(*SetVariableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariableService_ListVariableChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVariableChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariableServiceServer).ListVariableChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariableService_ListVariableChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariableServiceServer).ListVariableChanges(ctx, req.(*ListVariableChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariableService_ScheduleVariableChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleVariableChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariableServiceServer).ScheduleVariableChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariableService_ScheduleVariableChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariableServiceServer).ScheduleVariableChange(ctx, req.(*ScheduleVariableChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariableService_ListScheduledVariableChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledVariableChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariableServiceServer).ListScheduledVariableChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariableService_ListScheduledVariableChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariableServiceServer).ListScheduledVariableChanges(ctx, req.(*ListScheduledVariableChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariableService_CancelScheduledVariableChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledVariableChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariableServiceServer).CancelScheduledVariableChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariableService_CancelScheduledVariableChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariableServiceServer).CancelScheduledVariableChange(ctx, req.(*CancelScheduledVariableChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariableService_DisplayRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisplayRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariableServiceServer).DisplayRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariableService_DisplayRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariableServiceServer).DisplayRates(ctx, req.(*DisplayRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VariableService_ServiceDesc is the grpc.ServiceDesc for VariableService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VariableService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "commercial.VariableService",
+	HandlerType: (*VariableServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetVariables",
+			Handler:    _VariableService_GetVariables_Handler,
+		},
+		{
+			MethodName: "ListVariables",
+			Handler:    _VariableService_ListVariables_Handler,
+		},
+		{
+			MethodName: "GetVariable",
+			Handler:    _VariableService_GetVariable_Handler,
+		},
+		{
+			MethodName: "SetVariable",
+			Handler:    _VariableService_SetVariable_Handler,
+		},
+		{
+			MethodName: "ListVariableChanges",
+			Handler:    _VariableService_ListVariableChanges_Handler,
+		},
+		{
+			MethodName: "ScheduleVariableChange",
+			Handler:    _VariableService_ScheduleVariableChange_Handler,
+		},
+		{
+			MethodName: "ListScheduledVariableChanges",
+			Handler:    _VariableService_ListScheduledVariableChanges_Handler,
+		},
+		{
+			MethodName: "CancelScheduledVariableChange",
+			Handler:    _VariableService_CancelScheduledVariableChange_Handler,
+		},
+		{
+			MethodName: "DisplayRates",
+			Handler:    _VariableService_DisplayRates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "commercial.proto",
+}
+
+const (
+	WalletAdjustmentService_CreateAdjustmentBatch_FullMethodName  = "/commercial.WalletAdjustmentService/CreateAdjustmentBatch"
+	WalletAdjustmentService_ListAdjustmentBatches_FullMethodName  = "/commercial.WalletAdjustmentService/ListAdjustmentBatches"
+	WalletAdjustmentService_GetAdjustmentBatch_FullMethodName     = "/commercial.WalletAdjustmentService/GetAdjustmentBatch"
+	WalletAdjustmentService_ApproveAdjustmentBatch_FullMethodName = "/commercial.WalletAdjustmentService/ApproveAdjustmentBatch"
+	WalletAdjustmentService_RejectAdjustmentBatch_FullMethodName  = "/commercial.WalletAdjustmentService/RejectAdjustmentBatch"
+)
+
+// WalletAdjustmentServiceClient is the client API for WalletAdjustmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Wallet Adjustment Service - batch wallet credits and debits by admins. A
+// batch is executed only after a second admin approves it.
+type WalletAdjustmentServiceClient interface {
+	CreateAdjustmentBatch(ctx context.Context, in *CreateAdjustmentBatchRequest, opts ...grpc.CallOption) (*AdjustmentBatch, error)
+	ListAdjustmentBatches(ctx context.Context, in *ListAdjustmentBatchesRequest, opts ...grpc.CallOption) (*ListAdjustmentBatchesResponse, error)
+	GetAdjustmentBatch(ctx context.Context, in *GetAdjustmentBatchRequest, opts ...grpc.CallOption) (*AdjustmentBatch, error)
+	ApproveAdjustmentBatch(ctx context.Context, in *ApproveAdjustmentBatchRequest, opts ...grpc.CallOption) (*AdjustmentBatch, error)
+	RejectAdjustmentBatch(ctx context.Context, in *RejectAdjustmentBatchRequest, opts ...grpc.CallOption) (*AdjustmentBatch, error)
+}
+
+type walletAdjustmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWalletAdjustmentServiceClient(cc grpc.ClientConnInterface) WalletAdjustmentServiceClient {
+	return &walletAdjustmentServiceClient{cc}
+}
+
+func (c *walletAdjustmentServiceClient) CreateAdjustmentBatch(ctx context.Context, in *CreateAdjustmentBatchRequest, opts ...grpc.CallOption) (*AdjustmentBatch, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustmentBatch)
+	err := c.cc.Invoke(ctx, WalletAdjustmentService_CreateAdjustmentBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAdjustmentServiceClient) ListAdjustmentBatches(ctx context.Context, in *ListAdjustmentBatchesRequest, opts ...grpc.CallOption) (*ListAdjustmentBatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAdjustmentBatchesResponse)
+	err := c.cc.Invoke(ctx, WalletAdjustmentService_ListAdjustmentBatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAdjustmentServiceClient) GetAdjustmentBatch(ctx context.Context, in *GetAdjustmentBatchRequest, opts ...grpc.CallOption) (*AdjustmentBatch, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustmentBatch)
+	err := c.cc.Invoke(ctx, WalletAdjustmentService_GetAdjustmentBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAdjustmentServiceClient) ApproveAdjustmentBatch(ctx context.Context, in *ApproveAdjustmentBatchRequest, opts ...grpc.CallOption) (*AdjustmentBatch, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustmentBatch)
+	err := c.cc.Invoke(ctx, WalletAdjustmentService_ApproveAdjustmentBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAdjustmentServiceClient) RejectAdjustmentBatch(ctx context.Context, in *RejectAdjustmentBatchRequest, opts ...grpc.CallOption) (*AdjustmentBatch, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustmentBatch)
+	err := c.cc.Invoke(ctx, WalletAdjustmentService_RejectAdjustmentBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletAdjustmentServiceServer is the server API for WalletAdjustmentService service.
+// All implementations must embed UnimplementedWalletAdjustmentServiceServer
+// for forward compatibility.
+//
+// Wallet Adjustment Service - batch wallet credits and debits by admins. A
+// batch is executed only after a second admin approves it.
+type WalletAdjustmentServiceServer interface {
+	CreateAdjustmentBatch(context.Context, *CreateAdjustmentBatchRequest) (*AdjustmentBatch, error)
+	ListAdjustmentBatches(context.Context, *ListAdjustmentBatchesRequest) (*ListAdjustmentBatchesResponse, error)
+	GetAdjustmentBatch(context.Context, *GetAdjustmentBatchRequest) (*AdjustmentBatch, error)
+	ApproveAdjustmentBatch(context.Context, *ApproveAdjustmentBatchRequest) (*AdjustmentBatch, error)
+	RejectAdjustmentBatch(context.Context, *RejectAdjustmentBatchRequest) (*AdjustmentBatch, error)
+	mustEmbedUnimplementedWalletAdjustmentServiceServer()
+}
+
+// UnimplementedWalletAdjustmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWalletAdjustmentServiceServer struct{}
+
+func (UnimplementedWalletAdjustmentServiceServer) CreateAdjustmentBatch(context.Context, *CreateAdjustmentBatchRequest) (*AdjustmentBatch, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAdjustmentBatch not implemented")
+}
+func (UnimplementedWalletAdjustmentServiceServer) ListAdjustmentBatches(context.Context, *ListAdjustmentBatchesRequest) (*ListAdjustmentBatchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAdjustmentBatches not implemented")
+}
+func (UnimplementedWalletAdjustmentServiceServer) GetAdjustmentBatch(context.Context, *GetAdjustmentBatchRequest) (*AdjustmentBatch, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAdjustmentBatch not implemented")
+}
+func (UnimplementedWalletAdjustmentServiceServer) ApproveAdjustmentBatch(context.Context, *ApproveAdjustmentBatchRequest) (*AdjustmentBatch, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveAdjustmentBatch not implemented")
+}
+func (UnimplementedWalletAdjustmentServiceServer) RejectAdjustmentBatch(context.Context, *RejectAdjustmentBatchRequest) (*AdjustmentBatch, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectAdjustmentBatch not implemented")
+}
+func (UnimplementedWalletAdjustmentServiceServer) mustEmbedUnimplementedWalletAdjustmentServiceServer() {
+}
+func (UnimplementedWalletAdjustmentServiceServer) testEmbeddedByValue() {}
+
+// UnsafeWalletAdjustmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WalletAdjustmentServiceServer will
+// result in compilation errors.
+type UnsafeWalletAdjustmentServiceServer interface {
+	mustEmbedUnimplementedWalletAdjustmentServiceServer()
+}
+
+func RegisterWalletAdjustmentServiceServer(s grpc.ServiceRegistrar, srv WalletAdjustmentServiceServer) {
+	// If the following call panics, it indicates UnimplementedWalletAdjustmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WalletAdjustmentService_ServiceDesc, srv)
+}
+
+func _WalletAdjustmentService_CreateAdjustmentBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAdjustmentBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAdjustmentServiceServer).CreateAdjustmentBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAdjustmentService_CreateAdjustmentBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAdjustmentServiceServer).CreateAdjustmentBatch(ctx, req.(*CreateAdjustmentBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAdjustmentService_ListAdjustmentBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAdjustmentBatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAdjustmentServiceServer).ListAdjustmentBatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAdjustmentService_ListAdjustmentBatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAdjustmentServiceServer).ListAdjustmentBatches(ctx, req.(*ListAdjustmentBatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAdjustmentService_GetAdjustmentBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdjustmentBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAdjustmentServiceServer).GetAdjustmentBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAdjustmentService_GetAdjustmentBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAdjustmentServiceServer).GetAdjustmentBatch(ctx, req.(*GetAdjustmentBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAdjustmentService_ApproveAdjustmentBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveAdjustmentBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAdjustmentServiceServer).ApproveAdjustmentBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAdjustmentService_ApproveAdjustmentBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAdjustmentServiceServer).ApproveAdjustmentBatch(ctx, req.(*ApproveAdjustmentBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAdjustmentService_RejectAdjustmentBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectAdjustmentBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAdjustmentServiceServer).RejectAdjustmentBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAdjustmentService_RejectAdjustmentBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAdjustmentServiceServer).RejectAdjustmentBatch(ctx, req.(*RejectAdjustmentBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WalletAdjustmentService_ServiceDesc is the grpc.ServiceDesc for WalletAdjustmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WalletAdjustmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "commercial.WalletAdjustmentService",
+	HandlerType: (*WalletAdjustmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAdjustmentBatch",
+			Handler:    _WalletAdjustmentService_CreateAdjustmentBatch_Handler,
+		},
+		{
+			MethodName: "ListAdjustmentBatches",
+			Handler:    _WalletAdjustmentService_ListAdjustmentBatches_Handler,
+		},
+		{
+			MethodName: "GetAdjustmentBatch",
+			Handler:    _WalletAdjustmentService_GetAdjustmentBatch_Handler,
+		},
+		{
+			MethodName: "ApproveAdjustmentBatch",
+			Handler:    _WalletAdjustmentService_ApproveAdjustmentBatch_Handler,
+		},
+		{
+			MethodName: "RejectAdjustmentBatch",
+			Handler:    _WalletAdjustmentService_RejectAdjustmentBatch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "commercial.proto",
+}
+
+const (
+	InstallmentService_CreateInstallmentPlan_FullMethodName = "/commercial.InstallmentService/CreateInstallmentPlan"
+	InstallmentService_ListInstallmentPlans_FullMethodName  = "/commercial.InstallmentService/ListInstallmentPlans"
+	InstallmentService_GetInstallmentPlan_FullMethodName    = "/commercial.InstallmentService/GetInstallmentPlan"
+	InstallmentService_PayInstallment_FullMethodName        = "/commercial.InstallmentService/PayInstallment"
+)
+
+// InstallmentServiceClient is the client API for InstallmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Installment Service - buys a user-owned feature over scheduled installments.
+// The feature is reserved for the buyer and changes owner after the final
+// payment; a missed installment past the grace period defaults the plan.
+type InstallmentServiceClient interface {
+	CreateInstallmentPlan(ctx context.Context, in *CreateInstallmentPlanRequest, opts ...grpc.CallOption) (*InstallmentPlan, error)
+	ListInstallmentPlans(ctx context.Context, in *ListInstallmentPlansRequest, opts ...grpc.CallOption) (*ListInstallmentPlansResponse, error)
+	GetInstallmentPlan(ctx context.Context, in *GetInstallmentPlanRequest, opts ...grpc.CallOption) (*InstallmentPlan, error)
+	PayInstallment(ctx context.Context, in *PayInstallmentRequest, opts ...grpc.CallOption) (*InstallmentPlan, error)
+}
+
+type installmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInstallmentServiceClient(cc grpc.ClientConnInterface) InstallmentServiceClient {
+	return &installmentServiceClient{cc}
+}
+
+func (c *installmentServiceClient) CreateInstallmentPlan(ctx context.Context, in *CreateInstallmentPlanRequest, opts ...grpc.CallOption) (*InstallmentPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstallmentPlan)
+	err := c.cc.Invoke(ctx, InstallmentService_CreateInstallmentPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *installmentServiceClient) ListInstallmentPlans(ctx context.Context, in *ListInstallmentPlansRequest, opts ...grpc.CallOption) (*ListInstallmentPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInstallmentPlansResponse)
+	err := c.cc.Invoke(ctx, InstallmentService_ListInstallmentPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *installmentServiceClient) GetInstallmentPlan(ctx context.Context, in *GetInstallmentPlanRequest, opts ...grpc.CallOption) (*InstallmentPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstallmentPlan)
+	err := c.cc.Invoke(ctx, InstallmentService_GetInstallmentPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *installmentServiceClient) PayInstallment(ctx context.Context, in *PayInstallmentRequest, opts ...grpc.CallOption) (*InstallmentPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstallmentPlan)
+	err := c.cc.Invoke(ctx, InstallmentService_PayInstallment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InstallmentServiceServer is the server API for InstallmentService service.
+// All implementations must embed UnimplementedInstallmentServiceServer
+// for forward compatibility.
+//
+// Installment Service - buys a user-owned feature over scheduled installments.
+// The feature is reserved for the buyer and changes owner after the final
+// payment; a missed installment past the grace period defaults the plan.
+type InstallmentServiceServer interface {
+	CreateInstallmentPlan(context.Context, *CreateInstallmentPlanRequest) (*InstallmentPlan, error)
+	ListInstallmentPlans(context.Context, *ListInstallmentPlansRequest) (*ListInstallmentPlansResponse, error)
+	GetInstallmentPlan(context.Context, *GetInstallmentPlanRequest) (*InstallmentPlan, error)
+	PayInstallment(context.Context, *PayInstallmentRequest) (*InstallmentPlan, error)
+	mustEmbedUnimplementedInstallmentServiceServer()
+}
+
+// UnimplementedInstallmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInstallmentServiceServer struct{}
+
+func (UnimplementedInstallmentServiceServer) CreateInstallmentPlan(context.Context, *CreateInstallmentPlanRequest) (*InstallmentPlan, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateInstallmentPlan not implemented")
+}
+func (UnimplementedInstallmentServiceServer) ListInstallmentPlans(context.Context, *ListInstallmentPlansRequest) (*ListInstallmentPlansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInstallmentPlans not implemented")
+}
+func (UnimplementedInstallmentServiceServer) GetInstallmentPlan(context.Context, *GetInstallmentPlanRequest) (*InstallmentPlan, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInstallmentPlan not implemented")
+}
+func (UnimplementedInstallmentServiceServer) PayInstallment(context.Context, *PayInstallmentRequest) (*InstallmentPlan, error) {
+	return nil, status.Error(codes.Unimplemented, "method PayInstallment not implemented")
+}
+func (UnimplementedInstallmentServiceServer) mustEmbedUnimplementedInstallmentServiceServer() {}
+func (UnimplementedInstallmentServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeInstallmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InstallmentServiceServer will
+// result in compilation errors.
+type UnsafeInstallmentServiceServer interface {
+	mustEmbedUnimplementedInstallmentServiceServer()
+}
+
+func RegisterInstallmentServiceServer(s grpc.ServiceRegistrar, srv InstallmentServiceServer) {
+	// If the following call panics, it indicates UnimplementedInstallmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InstallmentService_ServiceDesc, srv)
+}
+
+func _InstallmentService_CreateInstallmentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInstallmentPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstallmentServiceServer).CreateInstallmentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstallmentService_CreateInstallmentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstallmentServiceServer).CreateInstallmentPlan(ctx, req.(*CreateInstallmentPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstallmentService_ListInstallmentPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInstallmentPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstallmentServiceServer).ListInstallmentPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstallmentService_ListInstallmentPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstallmentServiceServer).ListInstallmentPlans(ctx, req.(*ListInstallmentPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstallmentService_GetInstallmentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstallmentPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstallmentServiceServer).GetInstallmentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstallmentService_GetInstallmentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstallmentServiceServer).GetInstallmentPlan(ctx, req.(*GetInstallmentPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstallmentService_PayInstallment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayInstallmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstallmentServiceServer).PayInstallment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstallmentService_PayInstallment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstallmentServiceServer).PayInstallment(ctx, req.(*PayInstallmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InstallmentService_ServiceDesc is the grpc.ServiceDesc for InstallmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InstallmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "commercial.InstallmentService",
+	HandlerType: (*InstallmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateInstallmentPlan",
+			Handler:    _InstallmentService_CreateInstallmentPlan_Handler,
+		},
+		{
+			MethodName: "ListInstallmentPlans",
+			Handler:    _InstallmentService_ListInstallmentPlans_Handler,
+		},
+		{
+			MethodName: "GetInstallmentPlan",
+			Handler:    _InstallmentService_GetInstallmentPlan_Handler,
+		},
+		{
+			MethodName: "PayInstallment",
+			Handler:    _InstallmentService_PayInstallment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "commercial.proto",
+}
+
+const (
+	ExchangeService_ListExchangeRates_FullMethodName = "/commercial.ExchangeService/ListExchangeRates"
+	ExchangeService_SetExchangeRate_FullMethodName   = "/commercial.ExchangeService/SetExchangeRate"
+	ExchangeService_Convert_FullMethodName           = "/commercial.ExchangeService/Convert"
+)
+
+// ExchangeServiceClient is the client API for ExchangeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Exchange Service - converts between color assets in a wallet at rates and
+// fees set by wallet admins. Both legs of a conversion are recorded as
+// transactions.
+type ExchangeServiceClient interface {
+	ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error)
+	SetExchangeRate(ctx context.Context, in *SetExchangeRateRequest, opts ...grpc.CallOption) (*ExchangeRate, error)
+	Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*Conversion, error)
+}
+
+type exchangeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExchangeServiceClient(cc grpc.ClientConnInterface) ExchangeServiceClient {
+	return &exchangeServiceClient{cc}
+}
+
+func (c *exchangeServiceClient) ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_ListExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) SetExchangeRate(ctx context.Context, in *SetExchangeRateRequest, opts ...grpc.CallOption) (*ExchangeRate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExchangeRate)
+	err := c.cc.Invoke(ctx, ExchangeService_SetExchangeRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*Conversion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Conversion)
+	err := c.cc.Invoke(ctx, ExchangeService_Convert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExchangeServiceServer is the server API for ExchangeService service.
+// All implementations must embed UnimplementedExchangeServiceServer
+// for forward compatibility.
+//
+// Exchange Service - converts between color assets in a wallet at rates and
+// fees set by wallet admins. Both legs of a conversion are recorded as
+// transactions.
+type ExchangeServiceServer interface {
+	ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error)
+	SetExchangeRate(context.Context, *SetExchangeRateRequest) (*ExchangeRate, error)
+	Convert(context.Context, *ConvertRequest) (*Conversion, error)
+	mustEmbedUnimplementedExchangeServiceServer()
+}
+
+// UnimplementedExchangeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExchangeServiceServer struct{}
+
+func (UnimplementedExchangeServiceServer) ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListExchangeRates not implemented")
+}
+func (UnimplementedExchangeServiceServer) SetExchangeRate(context.Context, *SetExchangeRateRequest) (*ExchangeRate, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetExchangeRate not implemented")
+}
+func (UnimplementedExchangeServiceServer) Convert(context.Context, *ConvertRequest) (*Conversion, error) {
+	return nil, status.Error(codes.Unimplemented, "method Convert not implemented")
+}
+func (UnimplementedExchangeServiceServer) mustEmbedUnimplementedExchangeServiceServer() {}
+func (UnimplementedExchangeServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeExchangeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExchangeServiceServer will
+// result in compilation errors.
+type UnsafeExchangeServiceServer interface {
+	mustEmbedUnimplementedExchangeServiceServer()
+}
+
+func RegisterExchangeServiceServer(s grpc.ServiceRegistrar, srv ExchangeServiceServer) {
+	// If the following call panics, it indicates UnimplementedExchangeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ExchangeService_ServiceDesc, srv)
+}
+
+func _ExchangeService_ListExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).ListExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_ListExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).ListExchangeRates(ctx, req.(*ListExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_SetExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).SetExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_SetExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).SetExchangeRate(ctx, req.(*SetExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_Convert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).Convert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_Convert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).Convert(ctx, req.(*ConvertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExchangeService_ServiceDesc is the grpc.ServiceDesc for ExchangeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExchangeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "commercial.ExchangeService",
+	HandlerType: (*ExchangeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListExchangeRates",
+			Handler:    _ExchangeService_ListExchangeRates_Handler,
+		},
+		{
+			MethodName: "SetExchangeRate",
+			Handler:    _ExchangeService_SetExchangeRate_Handler,
+		},
+		{
+			MethodName: "Convert",
+			Handler:    _ExchangeService_Convert_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "commercial.proto",
+}
+
+const (
+	SpendingLimitService_GetSpendingLimits_FullMethodName = "/commercial.SpendingLimitService/GetSpendingLimits"
+	SpendingLimitService_SetSpendingLimits_FullMethodName = "/commercial.SpendingLimitService/SetSpendingLimits"
+)
+
+// SpendingLimitServiceClient is the client API for SpendingLimitService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Spending Limit Service - daily and monthly caps on what users under 18
+// spend from their psc and irr balances. Guardians adjust the caps of their
+// children through dynasty-service, within the platform maximums.
+type SpendingLimitServiceClient interface {
+	GetSpendingLimits(ctx context.Context, in *GetSpendingLimitsRequest, opts ...grpc.CallOption) (*SpendingLimits, error)
+	SetSpendingLimits(ctx context.Context, in *SetSpendingLimitsRequest, opts ...grpc.CallOption) (*SpendingLimits, error)
+}
+
+type spendingLimitServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSpendingLimitServiceClient(cc grpc.ClientConnInterface) SpendingLimitServiceClient {
+	return &spendingLimitServiceClient{cc}
+}
+
+func (c *spendingLimitServiceClient) GetSpendingLimits(ctx context.Context, in *GetSpendingLimitsRequest, opts ...grpc.CallOption) (*SpendingLimits, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpendingLimits)
+	err := c.cc.Invoke(ctx, SpendingLimitService_GetSpendingLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spendingLimitServiceClient) SetSpendingLimits(ctx context.Context, in *SetSpendingLimitsRequest, opts ...grpc.CallOption) (*SpendingLimits, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpendingLimits)
+	err := c.cc.Invoke(ctx, SpendingLimitService_SetSpendingLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SpendingLimitServiceServer is the server API for SpendingLimitService service.
+// All implementations must embed UnimplementedSpendingLimitServiceServer
+// for forward compatibility.
+//
+// Spending Limit Service - daily and monthly caps on what users under 18
+// spend from their psc and irr balances. Guardians adjust the caps of their
+// children through dynasty-service, within the platform maximums.
+type SpendingLimitServiceServer interface {
+	GetSpendingLimits(context.Context, *GetSpendingLimitsRequest) (*SpendingLimits, error)
+	SetSpendingLimits(context.Context, *SetSpendingLimitsRequest) (*SpendingLimits, error)
+	mustEmbedUnimplementedSpendingLimitServiceServer()
+}
+
+// UnimplementedSpendingLimitServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSpendingLimitServiceServer struct{}
+
+func (UnimplementedSpendingLimitServiceServer) GetSpendingLimits(context.Context, *GetSpendingLimitsRequest) (*SpendingLimits, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSpendingLimits not implemented")
+}
+func (UnimplementedSpendingLimitServiceServer) SetSpendingLimits(context.Context, *SetSpendingLimitsRequest) (*SpendingLimits, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSpendingLimits not implemented")
+}
+func (UnimplementedSpendingLimitServiceServer) mustEmbedUnimplementedSpendingLimitServiceServer() {}
+func (UnimplementedSpendingLimitServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeSpendingLimitServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SpendingLimitServiceServer will
+// result in compilation errors.
+type UnsafeSpendingLimitServiceServer interface {
+	mustEmbedUnimplementedSpendingLimitServiceServer()
+}
+
+func RegisterSpendingLimitServiceServer(s grpc.ServiceRegistrar, srv SpendingLimitServiceServer) {
+	// If the following call panics, it indicates UnimplementedSpendingLimitServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SpendingLimitService_ServiceDesc, srv)
+}
+
+func _SpendingLimitService_GetSpendingLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpendingLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpendingLimitServiceServer).GetSpendingLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SpendingLimitService_GetSpendingLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpendingLimitServiceServer).GetSpendingLimits(ctx, req.(*GetSpendingLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpendingLimitService_SetSpendingLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSpendingLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpendingLimitServiceServer).SetSpendingLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SpendingLimitService_SetSpendingLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpendingLimitServiceServer).SetSpendingLimits(ctx, req.(*SetSpendingLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SpendingLimitService_ServiceDesc is the grpc.ServiceDesc for SpendingLimitService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SpendingLimitService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "commercial.SpendingLimitService",
+	HandlerType: (*SpendingLimitServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSpendingLimits",
+			Handler:    _SpendingLimitService_GetSpendingLimits_Handler,
+		},
+		{
+			MethodName: "SetSpendingLimits",
+			Handler:    _SpendingLimitService_SetSpendingLimits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "commercial.proto",
+}
+
+const (
+	FraudService_ListFraudReviews_FullMethodName   = "/commercial.FraudService/ListFraudReviews"
+	FraudService_ResolveFraudReview_FullMethodName = "/commercial.FraudService/ResolveFraudReview"
+	FraudService_ListBlockedCards_FullMethodName   = "/commercial.FraudService/ListBlockedCards"
+	FraudService_BlockCard_FullMethodName          = "/commercial.FraudService/BlockCard"
+	FraudService_UnblockCard_FullMethodName        = "/commercial.FraudService/UnblockCard"
+)
+
+// FraudServiceClient is the client API for FraudService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Fraud Service - payments and large wallet transfers are checked against
+// velocity, login and card rules before they go through. Checks that need a
+// person wait in the review queue for wallet admins.
+type FraudServiceClient interface {
+	ListFraudReviews(ctx context.Context, in *ListFraudReviewsRequest, opts ...grpc.CallOption) (*ListFraudReviewsResponse, error)
+	ResolveFraudReview(ctx context.Context, in *ResolveFraudReviewRequest, opts ...grpc.CallOption) (*FraudCheck, error)
+	ListBlockedCards(ctx context.Context, in *ListBlockedCardsRequest, opts ...grpc.CallOption) (*ListBlockedCardsResponse, error)
+	BlockCard(ctx context.Context, in *BlockCardRequest, opts ...grpc.CallOption) (*BlockedCard, error)
+	UnblockCard(ctx context.Context, in *UnblockCardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type fraudServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFraudServiceClient(cc grpc.ClientConnInterface) FraudServiceClient {
+	return &fraudServiceClient{cc}
+}
+
+func (c *fraudServiceClient) ListFraudReviews(ctx context.Context, in *ListFraudReviewsRequest, opts ...grpc.CallOption) (*ListFraudReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFraudReviewsResponse)
+	err := c.cc.Invoke(ctx, FraudService_ListFraudReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fraudServiceClient) ResolveFraudReview(ctx context.Context, in *ResolveFraudReviewRequest, opts ...grpc.CallOption) (*FraudCheck, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FraudCheck)
+	err := c.cc.Invoke(ctx, FraudService_ResolveFraudReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fraudServiceClient) ListBlockedCards(ctx context.Context, in *ListBlockedCardsRequest, opts ...grpc.CallOption) (*ListBlockedCardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlockedCardsResponse)
+	err := c.cc.Invoke(ctx, FraudService_ListBlockedCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fraudServiceClient) BlockCard(ctx context.Context, in *BlockCardRequest, opts ...grpc.CallOption) (*BlockedCard, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockedCard)
+	err := c.cc.Invoke(ctx, FraudService_BlockCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fraudServiceClient) UnblockCard(ctx context.Context, in *UnblockCardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FraudService_UnblockCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FraudServiceServer is the server API for FraudService service.
+// All implementations must embed UnimplementedFraudServiceServer
+// for forward compatibility.
+//
+// Fraud Service - payments and large wallet transfers are checked against
+// velocity, login and card rules before they go through. Checks that need a
+// person wait in the review queue for wallet admins.
+type FraudServiceServer interface {
+	ListFraudReviews(context.Context, *ListFraudReviewsRequest) (*ListFraudReviewsResponse, error)
+	ResolveFraudReview(context.Context, *ResolveFraudReviewRequest) (*FraudCheck, error)
+	ListBlockedCards(context.Context, *ListBlockedCardsRequest) (*ListBlockedCardsResponse, error)
+	BlockCard(context.Context, *BlockCardRequest) (*BlockedCard, error)
+	UnblockCard(context.Context, *UnblockCardRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedFraudServiceServer()
+}
+
+// UnimplementedFraudServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFraudServiceServer struct{}
+
+func (UnimplementedFraudServiceServer) ListFraudReviews(context.Context, *ListFraudReviewsRequest) (*ListFraudReviewsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFraudReviews not implemented")
+}
+func (UnimplementedFraudServiceServer) ResolveFraudReview(context.Context, *ResolveFraudReviewRequest) (*FraudCheck, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveFraudReview not implemented")
+}
+func (UnimplementedFraudServiceServer) ListBlockedCards(context.Context, *ListBlockedCardsRequest) (*ListBlockedCardsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBlockedCards not implemented")
+}
+func (UnimplementedFraudServiceServer) BlockCard(context.Context, *BlockCardRequest) (*BlockedCard, error) {
+	return nil, status.Error(codes.Unimplemented, "method BlockCard not implemented")
+}
+func (UnimplementedFraudServiceServer) UnblockCard(context.Context, *UnblockCardRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnblockCard not implemented")
+}
+func (UnimplementedFraudServiceServer) mustEmbedUnimplementedFraudServiceServer() {}
+func (UnimplementedFraudServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeFraudServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FraudServiceServer will
+// result in compilation errors.
+type UnsafeFraudServiceServer interface {
+	mustEmbedUnimplementedFraudServiceServer()
+}
+
+func RegisterFraudServiceServer(s grpc.ServiceRegistrar, srv FraudServiceServer) {
+	// If the following call panics, it indicates UnimplementedFraudServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FraudService_ServiceDesc, srv)
+}
+
+func _FraudService_ListFraudReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFraudReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FraudServiceServer).ListFraudReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FraudService_ListFraudReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FraudServiceServer).ListFraudReviews(ctx, req.(*ListFraudReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FraudService_ResolveFraudReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveFraudReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FraudServiceServer).ResolveFraudReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FraudService_ResolveFraudReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FraudServiceServer).ResolveFraudReview(ctx, req.(*ResolveFraudReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FraudService_ListBlockedCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FraudServiceServer).ListBlockedCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FraudService_ListBlockedCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FraudServiceServer).ListBlockedCards(ctx, req.(*ListBlockedCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FraudService_BlockCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FraudServiceServer).BlockCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FraudService_BlockCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FraudServiceServer).BlockCard(ctx, req.(*BlockCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FraudService_UnblockCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FraudServiceServer).UnblockCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FraudService_UnblockCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FraudServiceServer).UnblockCard(ctx, req.(*UnblockCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FraudService_ServiceDesc is the grpc.ServiceDesc for FraudService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FraudService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "commercial.FraudService",
+	HandlerType: (*FraudServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListFraudReviews",
+			Handler:    _FraudService_ListFraudReviews_Handler,
+		},
+		{
+			MethodName: "ResolveFraudReview",
+			Handler:    _FraudService_ResolveFraudReview_Handler,
+		},
+		{
+			MethodName: "ListBlockedCards",
+			Handler:    _FraudService_ListBlockedCards_Handler,
+		},
+		{
+			MethodName: "BlockCard",
+			Handler:    _FraudService_BlockCard_Handler,
+		},
+		{
+			MethodName: "UnblockCard",
+			Handler:    _FraudService_UnblockCard_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "commercial.proto",
+}
+
+const (
+	SubscriptionService_ListSubscriptionPlans_FullMethodName = "/commercial.SubscriptionService/ListSubscriptionPlans"
+	SubscriptionService_Subscribe_FullMethodName             = "/commercial.SubscriptionService/Subscribe"
+	SubscriptionService_GetSubscription_FullMethodName       = "/commercial.SubscriptionService/GetSubscription"
+	SubscriptionService_CancelSubscription_FullMethodName    = "/commercial.SubscriptionService/CancelSubscription"
+	SubscriptionService_PaySubscription_FullMethodName       = "/commercial.SubscriptionService/PaySubscription"
+	SubscriptionService_GetEntitlements_FullMethodName       = "/commercial.SubscriptionService/GetEntitlements"
+)
+
+// SubscriptionServiceClient is the client API for SubscriptionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Subscription Service - premium memberships billed from the wallet every
+// plan period. Failed renewals are retried on a dunning schedule before the
+// subscription expires. Other services read a user's entitlement flags with
+// GetEntitlements.
+type SubscriptionServiceClient interface {
+	ListSubscriptionPlans(ctx context.Context, in *ListSubscriptionPlansRequest, opts ...grpc.CallOption) (*ListSubscriptionPlansResponse, error)
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscriptionPayment, error)
+	GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
+	CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
+	PaySubscription(ctx context.Context, in *PaySubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionPayment, error)
+	GetEntitlements(ctx context.Context, in *GetEntitlementsRequest, opts ...grpc.CallOption) (*Entitlements, error)
+}
+
+type subscriptionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSubscriptionServiceClient(cc grpc.ClientConnInterface) SubscriptionServiceClient {
+	return &subscriptionServiceClient{cc}
+}
+
+func (c *subscriptionServiceClient) ListSubscriptionPlans(ctx context.Context, in *ListSubscriptionPlansRequest, opts ...grpc.CallOption) (*ListSubscriptionPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscriptionPlansResponse)
+	err := c.cc.Invoke(ctx, SubscriptionService_ListSubscriptionPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscriptionPayment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionPayment)
+	err := c.cc.Invoke(ctx, SubscriptionService_Subscribe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, SubscriptionService_GetSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, SubscriptionService_CancelSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) PaySubscription(ctx context.Context, in *PaySubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionPayment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionPayment)
+	err := c.cc.Invoke(ctx, SubscriptionService_PaySubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) GetEntitlements(ctx context.Context, in *GetEntitlementsRequest, opts ...grpc.CallOption) (*Entitlements, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Entitlements)
+	err := c.cc.Invoke(ctx, SubscriptionService_GetEntitlements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SubscriptionServiceServer is the server API for SubscriptionService service.
+// All implementations must embed UnimplementedSubscriptionServiceServer
+// for forward compatibility.
+//
+// Subscription Service - premium memberships billed from the wallet every
+// plan period. Failed renewals are retried on a dunning schedule before the
+// subscription expires. Other services read a user's entitlement flags with
+// GetEntitlements.
+type SubscriptionServiceServer interface {
+	ListSubscriptionPlans(context.Context, *ListSubscriptionPlansRequest) (*ListSubscriptionPlansResponse, error)
+	Subscribe(context.Context, *SubscribeRequest) (*SubscriptionPayment, error)
+	GetSubscription(context.Context, *GetSubscriptionRequest) (*Subscription, error)
+	CancelSubscription(context.Context, *CancelSubscriptionRequest) (*Subscription, error)
+	PaySubscription(context.Context, *PaySubscriptionRequest) (*SubscriptionPayment, error)
+	GetEntitlements(context.Context, *GetEntitlementsRequest) (*Entitlements, error)
+	mustEmbedUnimplementedSubscriptionServiceServer()
+}
+
+// UnimplementedSubscriptionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSubscriptionServiceServer struct{}
+
+func (UnimplementedSubscriptionServiceServer) ListSubscriptionPlans(context.Context, *ListSubscriptionPlansRequest) (*ListSubscriptionPlansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSubscriptionPlans not implemented")
+}
+func (UnimplementedSubscriptionServiceServer) Subscribe(context.Context, *SubscribeRequest) (*SubscriptionPayment, error) {
+	return nil, status.Error(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedSubscriptionServiceServer) GetSubscription(context.Context, *GetSubscriptionRequest) (*Subscription, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSubscription not implemented")
+}
+func (UnimplementedSubscriptionServiceServer) CancelSubscription(context.Context, *CancelSubscriptionRequest) (*Subscription, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelSubscription not implemented")
+}
+func (UnimplementedSubscriptionServiceServer) PaySubscription(context.Context, *PaySubscriptionRequest) (*SubscriptionPayment, error) {
+	return nil, status.Error(codes.Unimplemented, "method PaySubscription not implemented")
+}
+func (UnimplementedSubscriptionServiceServer) GetEntitlements(context.Context, *GetEntitlementsRequest) (*Entitlements, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEntitlements not implemented")
+}
+func (UnimplementedSubscriptionServiceServer) mustEmbedUnimplementedSubscriptionServiceServer() {}
+func (UnimplementedSubscriptionServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeSubscriptionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SubscriptionServiceServer will
+// result in compilation errors.
+type UnsafeSubscriptionServiceServer interface {
+	mustEmbedUnimplementedSubscriptionServiceServer()
+}
+
+func RegisterSubscriptionServiceServer(s grpc.ServiceRegistrar, srv SubscriptionServiceServer) {
+	// If the following call panics, it indicates UnimplementedSubscriptionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SubscriptionService_ServiceDesc, srv)
+}
+
+func _SubscriptionService_ListSubscriptionPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).ListSubscriptionPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_ListSubscriptionPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).ListSubscriptionPlans(ctx, req.(*ListSubscriptionPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_Subscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).Subscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_Subscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).Subscribe(ctx, req.(*SubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_GetSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).GetSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_GetSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).GetSubscription(ctx, req.(*GetSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).CancelSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_CancelSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).CancelSubscription(ctx, req.(*CancelSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_PaySubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaySubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).PaySubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_PaySubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).PaySubscription(ctx, req.(*PaySubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_GetEntitlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntitlementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).GetEntitlements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_GetEntitlements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).GetEntitlements(ctx, req.(*GetEntitlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SubscriptionService_ServiceDesc is the grpc.ServiceDesc for SubscriptionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SubscriptionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "commercial.SubscriptionService",
+	HandlerType: (*SubscriptionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSubscriptionPlans",
+			Handler:    _SubscriptionService_ListSubscriptionPlans_Handler,
+		},
+		{
+			MethodName: "Subscribe",
+			Handler:    _SubscriptionService_Subscribe_Handler,
+		},
+		{
+			MethodName: "GetSubscription",
+			Handler:    _SubscriptionService_GetSubscription_Handler,
+		},
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _SubscriptionService_CancelSubscription_Handler,
+		},
+		{
+			MethodName: "PaySubscription",
+			Handler:    _SubscriptionService_PaySubscription_Handler,
+		},
+		{
+			MethodName: "GetEntitlements",
+			Handler:    _SubscriptionService_GetEntitlements_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "commercial.proto",
@@ -1758,6 +1758,295 @@ func (x *ChildPermissions) GetCOTB() bool {
 	return false
 }
 
+type GetChildSpendingLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChildUserId   uint64                 `protobuf:"varint,1,opt,name=child_user_id,json=childUserId,proto3" json:"child_user_id,omitempty"`
+	ParentUserId  uint64                 `protobuf:"varint,2,opt,name=parent_user_id,json=parentUserId,proto3" json:"parent_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChildSpendingLimitsRequest) Reset() {
+	*x = GetChildSpendingLimitsRequest{}
+	mi := &file_dynasty_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChildSpendingLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChildSpendingLimitsRequest) ProtoMessage() {}
+
+func (x *GetChildSpendingLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChildSpendingLimitsRequest.ProtoReflect.Descriptor instead.
+func (*GetChildSpendingLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetChildSpendingLimitsRequest) GetChildUserId() uint64 {
+	if x != nil {
+		return x.ChildUserId
+	}
+	return 0
+}
+
+func (x *GetChildSpendingLimitsRequest) GetParentUserId() uint64 {
+	if x != nil {
+		return x.ParentUserId
+	}
+	return 0
+}
+
+// Empty amounts keep the current limit
+type SetChildSpendingLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChildUserId   uint64                 `protobuf:"varint,1,opt,name=child_user_id,json=childUserId,proto3" json:"child_user_id,omitempty"`
+	ParentUserId  uint64                 `protobuf:"varint,2,opt,name=parent_user_id,json=parentUserId,proto3" json:"parent_user_id,omitempty"`
+	DailyPsc      string                 `protobuf:"bytes,3,opt,name=daily_psc,json=dailyPsc,proto3" json:"daily_psc,omitempty"`
+	MonthlyPsc    string                 `protobuf:"bytes,4,opt,name=monthly_psc,json=monthlyPsc,proto3" json:"monthly_psc,omitempty"`
+	DailyIrr      string                 `protobuf:"bytes,5,opt,name=daily_irr,json=dailyIrr,proto3" json:"daily_irr,omitempty"`
+	MonthlyIrr    string                 `protobuf:"bytes,6,opt,name=monthly_irr,json=monthlyIrr,proto3" json:"monthly_irr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChildSpendingLimitsRequest) Reset() {
+	*x = SetChildSpendingLimitsRequest{}
+	mi := &file_dynasty_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChildSpendingLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChildSpendingLimitsRequest) ProtoMessage() {}
+
+func (x *SetChildSpendingLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChildSpendingLimitsRequest.ProtoReflect.Descriptor instead.
+func (*SetChildSpendingLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetChildSpendingLimitsRequest) GetChildUserId() uint64 {
+	if x != nil {
+		return x.ChildUserId
+	}
+	return 0
+}
+
+func (x *SetChildSpendingLimitsRequest) GetParentUserId() uint64 {
+	if x != nil {
+		return x.ParentUserId
+	}
+	return 0
+}
+
+func (x *SetChildSpendingLimitsRequest) GetDailyPsc() string {
+	if x != nil {
+		return x.DailyPsc
+	}
+	return ""
+}
+
+func (x *SetChildSpendingLimitsRequest) GetMonthlyPsc() string {
+	if x != nil {
+		return x.MonthlyPsc
+	}
+	return ""
+}
+
+func (x *SetChildSpendingLimitsRequest) GetDailyIrr() string {
+	if x != nil {
+		return x.DailyIrr
+	}
+	return ""
+}
+
+func (x *SetChildSpendingLimitsRequest) GetMonthlyIrr() string {
+	if x != nil {
+		return x.MonthlyIrr
+	}
+	return ""
+}
+
+type ChildSpendingLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChildUserId   uint64                 `protobuf:"varint,1,opt,name=child_user_id,json=childUserId,proto3" json:"child_user_id,omitempty"`
+	Psc           *SpendingLimit         `protobuf:"bytes,2,opt,name=psc,proto3" json:"psc,omitempty"`
+	Irr           *SpendingLimit         `protobuf:"bytes,3,opt,name=irr,proto3" json:"irr,omitempty"`
+	UpdatedBy     uint64                 `protobuf:"varint,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"` // 0 while the platform maximums apply
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChildSpendingLimitsResponse) Reset() {
+	*x = ChildSpendingLimitsResponse{}
+	mi := &file_dynasty_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChildSpendingLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChildSpendingLimitsResponse) ProtoMessage() {}
+
+func (x *ChildSpendingLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChildSpendingLimitsResponse.ProtoReflect.Descriptor instead.
+func (*ChildSpendingLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ChildSpendingLimitsResponse) GetChildUserId() uint64 {
+	if x != nil {
+		return x.ChildUserId
+	}
+	return 0
+}
+
+func (x *ChildSpendingLimitsResponse) GetPsc() *SpendingLimit {
+	if x != nil {
+		return x.Psc
+	}
+	return nil
+}
+
+func (x *ChildSpendingLimitsResponse) GetIrr() *SpendingLimit {
+	if x != nil {
+		return x.Irr
+	}
+	return nil
+}
+
+func (x *ChildSpendingLimitsResponse) GetUpdatedBy() uint64 {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return 0
+}
+
+type SpendingLimit struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Daily          string                 `protobuf:"bytes,1,opt,name=daily,proto3" json:"daily,omitempty"`
+	Monthly        string                 `protobuf:"bytes,2,opt,name=monthly,proto3" json:"monthly,omitempty"`
+	SpentToday     string                 `protobuf:"bytes,3,opt,name=spent_today,json=spentToday,proto3" json:"spent_today,omitempty"`               // Spent in the last 24 hours
+	SpentThisMonth string                 `protobuf:"bytes,4,opt,name=spent_this_month,json=spentThisMonth,proto3" json:"spent_this_month,omitempty"` // Spent in the last 30 days
+	MaxDaily       string                 `protobuf:"bytes,5,opt,name=max_daily,json=maxDaily,proto3" json:"max_daily,omitempty"`                     // Platform maximums, limits cannot exceed them
+	MaxMonthly     string                 `protobuf:"bytes,6,opt,name=max_monthly,json=maxMonthly,proto3" json:"max_monthly,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SpendingLimit) Reset() {
+	*x = SpendingLimit{}
+	mi := &file_dynasty_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendingLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingLimit) ProtoMessage() {}
+
+func (x *SpendingLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingLimit.ProtoReflect.Descriptor instead.
+func (*SpendingLimit) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SpendingLimit) GetDaily() string {
+	if x != nil {
+		return x.Daily
+	}
+	return ""
+}
+
+func (x *SpendingLimit) GetMonthly() string {
+	if x != nil {
+		return x.Monthly
+	}
+	return ""
+}
+
+func (x *SpendingLimit) GetSpentToday() string {
+	if x != nil {
+		return x.SpentToday
+	}
+	return ""
+}
+
+func (x *SpendingLimit) GetSpentThisMonth() string {
+	if x != nil {
+		return x.SpentThisMonth
+	}
+	return ""
+}
+
+func (x *SpendingLimit) GetMaxDaily() string {
+	if x != nil {
+		return x.MaxDaily
+	}
+	return ""
+}
+
+func (x *SpendingLimit) GetMaxMonthly() string {
+	if x != nil {
+		return x.MaxMonthly
+	}
+	return ""
+}
+
 type GetPrizesRequest struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
 	UserId        uint64                    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1766,21 +2055,568 @@ type GetPrizesRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPrizesRequest) Reset() {
-	*x = GetPrizesRequest{}
-	mi := &file_dynasty_proto_msgTypes[28]
+func (x *GetPrizesRequest) Reset() {
+	*x = GetPrizesRequest{}
+	mi := &file_dynasty_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrizesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrizesRequest) ProtoMessage() {}
+
+func (x *GetPrizesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrizesRequest.ProtoReflect.Descriptor instead.
+func (*GetPrizesRequest) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetPrizesRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetPrizesRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type PrizesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prizes        []*DynastyPrize        `protobuf:"bytes,1,rep,name=prizes,proto3" json:"prizes,omitempty"`
+	Pagination    *common.PaginationMeta `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrizesResponse) Reset() {
+	*x = PrizesResponse{}
+	mi := &file_dynasty_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrizesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrizesResponse) ProtoMessage() {}
+
+func (x *PrizesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrizesResponse.ProtoReflect.Descriptor instead.
+func (*PrizesResponse) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PrizesResponse) GetPrizes() []*DynastyPrize {
+	if x != nil {
+		return x.Prizes
+	}
+	return nil
+}
+
+func (x *PrizesResponse) GetPagination() *common.PaginationMeta {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetPrizeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PrizeId       uint64                 `protobuf:"varint,1,opt,name=prize_id,json=prizeId,proto3" json:"prize_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrizeRequest) Reset() {
+	*x = GetPrizeRequest{}
+	mi := &file_dynasty_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrizeRequest) ProtoMessage() {}
+
+func (x *GetPrizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrizeRequest.ProtoReflect.Descriptor instead.
+func (*GetPrizeRequest) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetPrizeRequest) GetPrizeId() uint64 {
+	if x != nil {
+		return x.PrizeId
+	}
+	return 0
+}
+
+type PrizeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prize         *DynastyPrize          `protobuf:"bytes,1,opt,name=prize,proto3" json:"prize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrizeResponse) Reset() {
+	*x = PrizeResponse{}
+	mi := &file_dynasty_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrizeResponse) ProtoMessage() {}
+
+func (x *PrizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrizeResponse.ProtoReflect.Descriptor instead.
+func (*PrizeResponse) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PrizeResponse) GetPrize() *DynastyPrize {
+	if x != nil {
+		return x.Prize
+	}
+	return nil
+}
+
+type ClaimPrizeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PrizeId       uint64                 `protobuf:"varint,1,opt,name=prize_id,json=prizeId,proto3" json:"prize_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimPrizeRequest) Reset() {
+	*x = ClaimPrizeRequest{}
+	mi := &file_dynasty_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimPrizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimPrizeRequest) ProtoMessage() {}
+
+func (x *ClaimPrizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimPrizeRequest.ProtoReflect.Descriptor instead.
+func (*ClaimPrizeRequest) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ClaimPrizeRequest) GetPrizeId() uint64 {
+	if x != nil {
+		return x.PrizeId
+	}
+	return 0
+}
+
+func (x *ClaimPrizeRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ClaimAllPrizesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimAllPrizesRequest) Reset() {
+	*x = ClaimAllPrizesRequest{}
+	mi := &file_dynasty_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimAllPrizesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimAllPrizesRequest) ProtoMessage() {}
+
+func (x *ClaimAllPrizesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimAllPrizesRequest.ProtoReflect.Descriptor instead.
+func (*ClaimAllPrizesRequest) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ClaimAllPrizesRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ClaimAllPrizesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Claimed       []*DynastyPrize        `protobuf:"bytes,1,rep,name=claimed,proto3" json:"claimed,omitempty"`
+	Psc           int64                  `protobuf:"varint,2,opt,name=psc,proto3" json:"psc,omitempty"`                  // psc credited for the claimed prizes
+	Satisfaction  string                 `protobuf:"bytes,3,opt,name=satisfaction,proto3" json:"satisfaction,omitempty"` // satisfaction credited for the claimed prizes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimAllPrizesResponse) Reset() {
+	*x = ClaimAllPrizesResponse{}
+	mi := &file_dynasty_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimAllPrizesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimAllPrizesResponse) ProtoMessage() {}
+
+func (x *ClaimAllPrizesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimAllPrizesResponse.ProtoReflect.Descriptor instead.
+func (*ClaimAllPrizesResponse) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ClaimAllPrizesResponse) GetClaimed() []*DynastyPrize {
+	if x != nil {
+		return x.Claimed
+	}
+	return nil
+}
+
+func (x *ClaimAllPrizesResponse) GetPsc() int64 {
+	if x != nil {
+		return x.Psc
+	}
+	return 0
+}
+
+func (x *ClaimAllPrizesResponse) GetSatisfaction() string {
+	if x != nil {
+		return x.Satisfaction
+	}
+	return ""
+}
+
+type DynastyPrize struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	Id                         uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Member                     string                 `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	Satisfaction               string                 `protobuf:"bytes,3,opt,name=satisfaction,proto3" json:"satisfaction,omitempty"`
+	IntroductionProfitIncrease string                 `protobuf:"bytes,4,opt,name=introduction_profit_increase,json=introductionProfitIncrease,proto3" json:"introduction_profit_increase,omitempty"`
+	AccumulatedCapitalReserve  string                 `protobuf:"bytes,5,opt,name=accumulated_capital_reserve,json=accumulatedCapitalReserve,proto3" json:"accumulated_capital_reserve,omitempty"`
+	DataStorage                string                 `protobuf:"bytes,6,opt,name=data_storage,json=dataStorage,proto3" json:"data_storage,omitempty"`
+	Psc                        int32                  `protobuf:"varint,7,opt,name=psc,proto3" json:"psc,omitempty"`
+	ReceivedPrizeId            uint64                 `protobuf:"varint,8,opt,name=received_prize_id,json=receivedPrizeId,proto3" json:"received_prize_id,omitempty"` // the awarded prize to claim, set when listing a user's prizes
+	Status                     string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`                                             // pending, claimed or expired
+	ExpiresAt                  string                 `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                     // end of the claim window, Jalali; empty when it never expires
+	Message                    string                 `protobuf:"bytes,11,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *DynastyPrize) Reset() {
+	*x = DynastyPrize{}
+	mi := &file_dynasty_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DynastyPrize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynastyPrize) ProtoMessage() {}
+
+func (x *DynastyPrize) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynastyPrize.ProtoReflect.Descriptor instead.
+func (*DynastyPrize) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DynastyPrize) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DynastyPrize) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *DynastyPrize) GetSatisfaction() string {
+	if x != nil {
+		return x.Satisfaction
+	}
+	return ""
+}
+
+func (x *DynastyPrize) GetIntroductionProfitIncrease() string {
+	if x != nil {
+		return x.IntroductionProfitIncrease
+	}
+	return ""
+}
+
+func (x *DynastyPrize) GetAccumulatedCapitalReserve() string {
+	if x != nil {
+		return x.AccumulatedCapitalReserve
+	}
+	return ""
+}
+
+func (x *DynastyPrize) GetDataStorage() string {
+	if x != nil {
+		return x.DataStorage
+	}
+	return ""
+}
+
+func (x *DynastyPrize) GetPsc() int32 {
+	if x != nil {
+		return x.Psc
+	}
+	return 0
+}
+
+func (x *DynastyPrize) GetReceivedPrizeId() uint64 {
+	if x != nil {
+		return x.ReceivedPrizeId
+	}
+	return 0
+}
+
+func (x *DynastyPrize) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DynastyPrize) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *DynastyPrize) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type MembershipRules struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	MaxFamilySize           int32                  `protobuf:"varint,1,opt,name=max_family_size,json=maxFamilySize,proto3" json:"max_family_size,omitempty"`                                                                                        // 0 means no limit
+	RelationshipLimits      map[string]int32       `protobuf:"bytes,2,rep,name=relationship_limits,json=relationshipLimits,proto3" json:"relationship_limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // relationship => max members, missing means no limit
+	MinorRelationships      []string               `protobuf:"bytes,3,rep,name=minor_relationships,json=minorRelationships,proto3" json:"minor_relationships,omitempty"`                                                                            // relationships under-18 users may join as
+	UnverifiedRelationships []string               `protobuf:"bytes,4,rep,name=unverified_relationships,json=unverifiedRelationships,proto3" json:"unverified_relationships,omitempty"`                                                             // relationships users without approved KYC may join as
+	LeaveCooldownHours      int32                  `protobuf:"varint,5,opt,name=leave_cooldown_hours,json=leaveCooldownHours,proto3" json:"leave_cooldown_hours,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *MembershipRules) Reset() {
+	*x = MembershipRules{}
+	mi := &file_dynasty_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MembershipRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MembershipRules) ProtoMessage() {}
+
+func (x *MembershipRules) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MembershipRules.ProtoReflect.Descriptor instead.
+func (*MembershipRules) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MembershipRules) GetMaxFamilySize() int32 {
+	if x != nil {
+		return x.MaxFamilySize
+	}
+	return 0
+}
+
+func (x *MembershipRules) GetRelationshipLimits() map[string]int32 {
+	if x != nil {
+		return x.RelationshipLimits
+	}
+	return nil
+}
+
+func (x *MembershipRules) GetMinorRelationships() []string {
+	if x != nil {
+		return x.MinorRelationships
+	}
+	return nil
+}
+
+func (x *MembershipRules) GetUnverifiedRelationships() []string {
+	if x != nil {
+		return x.UnverifiedRelationships
+	}
+	return nil
+}
+
+func (x *MembershipRules) GetLeaveCooldownHours() int32 {
+	if x != nil {
+		return x.LeaveCooldownHours
+	}
+	return 0
+}
+
+type GetMembershipRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMembershipRulesRequest) Reset() {
+	*x = GetMembershipRulesRequest{}
+	mi := &file_dynasty_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPrizesRequest) String() string {
+func (x *GetMembershipRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrizesRequest) ProtoMessage() {}
+func (*GetMembershipRulesRequest) ProtoMessage() {}
 
-func (x *GetPrizesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dynasty_proto_msgTypes[28]
+func (x *GetMembershipRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1791,48 +2627,94 @@ func (x *GetPrizesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPrizesRequest.ProtoReflect.Descriptor instead.
-func (*GetPrizesRequest) Descriptor() ([]byte, []int) {
-	return file_dynasty_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GetMembershipRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetMembershipRulesRequest) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetPrizesRequest) GetUserId() uint64 {
+func (x *GetMembershipRulesRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetPrizesRequest) GetPagination() *common.PaginationRequest {
+type UpdateMembershipRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rules         *MembershipRules       `protobuf:"bytes,2,opt,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMembershipRulesRequest) Reset() {
+	*x = UpdateMembershipRulesRequest{}
+	mi := &file_dynasty_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMembershipRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMembershipRulesRequest) ProtoMessage() {}
+
+func (x *UpdateMembershipRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[42]
 	if x != nil {
-		return x.Pagination
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMembershipRulesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMembershipRulesRequest) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdateMembershipRulesRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateMembershipRulesRequest) GetRules() *MembershipRules {
+	if x != nil {
+		return x.Rules
 	}
 	return nil
 }
 
-type PrizesResponse struct {
+type MembershipRulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Prizes        []*DynastyPrize        `protobuf:"bytes,1,rep,name=prizes,proto3" json:"prizes,omitempty"`
-	Pagination    *common.PaginationMeta `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Rules         *MembershipRules       `protobuf:"bytes,1,opt,name=rules,proto3" json:"rules,omitempty"`
+	UpdatedBy     uint64                 `protobuf:"varint,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Jalali date-time, empty while the defaults apply
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PrizesResponse) Reset() {
-	*x = PrizesResponse{}
-	mi := &file_dynasty_proto_msgTypes[29]
+func (x *MembershipRulesResponse) Reset() {
+	*x = MembershipRulesResponse{}
+	mi := &file_dynasty_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PrizesResponse) String() string {
+func (x *MembershipRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrizesResponse) ProtoMessage() {}
+func (*MembershipRulesResponse) ProtoMessage() {}
 
-func (x *PrizesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dynasty_proto_msgTypes[29]
+func (x *MembershipRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1843,47 +2725,56 @@ func (x *PrizesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrizesResponse.ProtoReflect.Descriptor instead.
-func (*PrizesResponse) Descriptor() ([]byte, []int) {
-	return file_dynasty_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use MembershipRulesResponse.ProtoReflect.Descriptor instead.
+func (*MembershipRulesResponse) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *PrizesResponse) GetPrizes() []*DynastyPrize {
+func (x *MembershipRulesResponse) GetRules() *MembershipRules {
 	if x != nil {
-		return x.Prizes
+		return x.Rules
 	}
 	return nil
 }
 
-func (x *PrizesResponse) GetPagination() *common.PaginationMeta {
+func (x *MembershipRulesResponse) GetUpdatedBy() uint64 {
 	if x != nil {
-		return x.Pagination
+		return x.UpdatedBy
 	}
-	return nil
+	return 0
 }
 
-type GetPrizeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PrizeId       uint64                 `protobuf:"varint,1,opt,name=prize_id,json=prizeId,proto3" json:"prize_id,omitempty"`
+func (x *MembershipRulesResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// GetDynastyLeaderboardRequest - GET /api/dynasty/leaderboard
+type GetDynastyLeaderboardRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	SortBy        string                    `protobuf:"bytes,1,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"` // "score" (default), "lands" or "prizes"
+	Pagination    *common.PaginationRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPrizeRequest) Reset() {
-	*x = GetPrizeRequest{}
-	mi := &file_dynasty_proto_msgTypes[30]
+func (x *GetDynastyLeaderboardRequest) Reset() {
+	*x = GetDynastyLeaderboardRequest{}
+	mi := &file_dynasty_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPrizeRequest) String() string {
+func (x *GetDynastyLeaderboardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrizeRequest) ProtoMessage() {}
+func (*GetDynastyLeaderboardRequest) ProtoMessage() {}
 
-func (x *GetPrizeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dynasty_proto_msgTypes[30]
+func (x *GetDynastyLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1894,40 +2785,48 @@ func (x *GetPrizeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPrizeRequest.ProtoReflect.Descriptor instead.
-func (*GetPrizeRequest) Descriptor() ([]byte, []int) {
-	return file_dynasty_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetDynastyLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetDynastyLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetPrizeRequest) GetPrizeId() uint64 {
+func (x *GetDynastyLeaderboardRequest) GetSortBy() string {
 	if x != nil {
-		return x.PrizeId
+		return x.SortBy
 	}
-	return 0
+	return ""
 }
 
-type PrizeResponse struct {
+func (x *GetDynastyLeaderboardRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// GetDynastyStatsRequest - GET /api/dynasty/{dynasty}/stats
+type GetDynastyStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Prize         *DynastyPrize          `protobuf:"bytes,1,opt,name=prize,proto3" json:"prize,omitempty"`
+	DynastyId     uint64                 `protobuf:"varint,1,opt,name=dynasty_id,json=dynastyId,proto3" json:"dynasty_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PrizeResponse) Reset() {
-	*x = PrizeResponse{}
-	mi := &file_dynasty_proto_msgTypes[31]
+func (x *GetDynastyStatsRequest) Reset() {
+	*x = GetDynastyStatsRequest{}
+	mi := &file_dynasty_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PrizeResponse) String() string {
+func (x *GetDynastyStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrizeResponse) ProtoMessage() {}
+func (*GetDynastyStatsRequest) ProtoMessage() {}
 
-func (x *PrizeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dynasty_proto_msgTypes[31]
+func (x *GetDynastyStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1938,41 +2837,47 @@ func (x *PrizeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrizeResponse.ProtoReflect.Descriptor instead.
-func (*PrizeResponse) Descriptor() ([]byte, []int) {
-	return file_dynasty_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetDynastyStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetDynastyStatsRequest) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *PrizeResponse) GetPrize() *DynastyPrize {
+func (x *GetDynastyStatsRequest) GetDynastyId() uint64 {
 	if x != nil {
-		return x.Prize
+		return x.DynastyId
 	}
-	return nil
+	return 0
 }
 
-type ClaimPrizeRequest struct {
+type DynastyStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PrizeId       uint64                 `protobuf:"varint,1,opt,name=prize_id,json=prizeId,proto3" json:"prize_id,omitempty"`
-	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DynastyId     uint64                 `protobuf:"varint,1,opt,name=dynasty_id,json=dynastyId,proto3" json:"dynasty_id,omitempty"`
+	Owner         *common.UserBasic      `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	MemberCount   int32                  `protobuf:"varint,3,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"` // Family members, the owner included
+	MemberScore   int64                  `protobuf:"varint,4,opt,name=member_score,json=memberScore,proto3" json:"member_score,omitempty"` // Sum of the members' scores in levels-service
+	LandCount     int32                  `protobuf:"varint,5,opt,name=land_count,json=landCount,proto3" json:"land_count,omitempty"`       // Features the members own
+	PrizePsc      int64                  `protobuf:"varint,6,opt,name=prize_psc,json=prizePsc,proto3" json:"prize_psc,omitempty"`          // psc of the prizes the dynasty's memberships earned
+	Rank          int32                  `protobuf:"varint,7,opt,name=rank,proto3" json:"rank,omitempty"`                                  // Position in the requested ranking, 0 outside a leaderboard
+	ComputedAt    string                 `protobuf:"bytes,8,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`     // Jalali date-time of the aggregation
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClaimPrizeRequest) Reset() {
-	*x = ClaimPrizeRequest{}
-	mi := &file_dynasty_proto_msgTypes[32]
+func (x *DynastyStats) Reset() {
+	*x = DynastyStats{}
+	mi := &file_dynasty_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClaimPrizeRequest) String() string {
+func (x *DynastyStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClaimPrizeRequest) ProtoMessage() {}
+func (*DynastyStats) ProtoMessage() {}
 
-func (x *ClaimPrizeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dynasty_proto_msgTypes[32]
+func (x *DynastyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1983,53 +2888,90 @@ func (x *ClaimPrizeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClaimPrizeRequest.ProtoReflect.Descriptor instead.
-func (*ClaimPrizeRequest) Descriptor() ([]byte, []int) {
-	return file_dynasty_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use DynastyStats.ProtoReflect.Descriptor instead.
+func (*DynastyStats) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ClaimPrizeRequest) GetPrizeId() uint64 {
+func (x *DynastyStats) GetDynastyId() uint64 {
 	if x != nil {
-		return x.PrizeId
+		return x.DynastyId
 	}
 	return 0
 }
 
-func (x *ClaimPrizeRequest) GetUserId() uint64 {
+func (x *DynastyStats) GetOwner() *common.UserBasic {
 	if x != nil {
-		return x.UserId
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *DynastyStats) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
 	}
 	return 0
 }
 
-type DynastyPrize struct {
-	state                      protoimpl.MessageState `protogen:"open.v1"`
-	Id                         uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Member                     string                 `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
-	Satisfaction               string                 `protobuf:"bytes,3,opt,name=satisfaction,proto3" json:"satisfaction,omitempty"`
-	IntroductionProfitIncrease string                 `protobuf:"bytes,4,opt,name=introduction_profit_increase,json=introductionProfitIncrease,proto3" json:"introduction_profit_increase,omitempty"`
-	AccumulatedCapitalReserve  string                 `protobuf:"bytes,5,opt,name=accumulated_capital_reserve,json=accumulatedCapitalReserve,proto3" json:"accumulated_capital_reserve,omitempty"`
-	DataStorage                string                 `protobuf:"bytes,6,opt,name=data_storage,json=dataStorage,proto3" json:"data_storage,omitempty"`
-	Psc                        int32                  `protobuf:"varint,7,opt,name=psc,proto3" json:"psc,omitempty"`
-	unknownFields              protoimpl.UnknownFields
-	sizeCache                  protoimpl.SizeCache
+func (x *DynastyStats) GetMemberScore() int64 {
+	if x != nil {
+		return x.MemberScore
+	}
+	return 0
 }
 
-func (x *DynastyPrize) Reset() {
-	*x = DynastyPrize{}
-	mi := &file_dynasty_proto_msgTypes[33]
+func (x *DynastyStats) GetLandCount() int32 {
+	if x != nil {
+		return x.LandCount
+	}
+	return 0
+}
+
+func (x *DynastyStats) GetPrizePsc() int64 {
+	if x != nil {
+		return x.PrizePsc
+	}
+	return 0
+}
+
+func (x *DynastyStats) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *DynastyStats) GetComputedAt() string {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return ""
+}
+
+type DynastyLeaderboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*DynastyStats        `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination    *common.PaginationMeta `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DynastyLeaderboardResponse) Reset() {
+	*x = DynastyLeaderboardResponse{}
+	mi := &file_dynasty_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DynastyPrize) String() string {
+func (x *DynastyLeaderboardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DynastyPrize) ProtoMessage() {}
+func (*DynastyLeaderboardResponse) ProtoMessage() {}
 
-func (x *DynastyPrize) ProtoReflect() protoreflect.Message {
-	mi := &file_dynasty_proto_msgTypes[33]
+func (x *DynastyLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2040,56 +2982,89 @@ func (x *DynastyPrize) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DynastyPrize.ProtoReflect.Descriptor instead.
-func (*DynastyPrize) Descriptor() ([]byte, []int) {
-	return file_dynasty_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use DynastyLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*DynastyLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *DynastyPrize) GetId() uint64 {
+func (x *DynastyLeaderboardResponse) GetData() []*DynastyStats {
 	if x != nil {
-		return x.Id
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *DynastyPrize) GetMember() string {
+func (x *DynastyLeaderboardResponse) GetPagination() *common.PaginationMeta {
 	if x != nil {
-		return x.Member
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (x *DynastyPrize) GetSatisfaction() string {
+type DynastyStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         *DynastyStats          `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	ScoreRank     int32                  `protobuf:"varint,2,opt,name=score_rank,json=scoreRank,proto3" json:"score_rank,omitempty"`
+	LandRank      int32                  `protobuf:"varint,3,opt,name=land_rank,json=landRank,proto3" json:"land_rank,omitempty"`
+	PrizeRank     int32                  `protobuf:"varint,4,opt,name=prize_rank,json=prizeRank,proto3" json:"prize_rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DynastyStatsResponse) Reset() {
+	*x = DynastyStatsResponse{}
+	mi := &file_dynasty_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DynastyStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynastyStatsResponse) ProtoMessage() {}
+
+func (x *DynastyStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dynasty_proto_msgTypes[48]
 	if x != nil {
-		return x.Satisfaction
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DynastyPrize) GetIntroductionProfitIncrease() string {
+// Deprecated: Use DynastyStatsResponse.ProtoReflect.Descriptor instead.
+func (*DynastyStatsResponse) Descriptor() ([]byte, []int) {
+	return file_dynasty_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DynastyStatsResponse) GetStats() *DynastyStats {
 	if x != nil {
-		return x.IntroductionProfitIncrease
+		return x.Stats
 	}
-	return ""
+	return nil
 }
 
-func (x *DynastyPrize) GetAccumulatedCapitalReserve() string {
+func (x *DynastyStatsResponse) GetScoreRank() int32 {
 	if x != nil {
-		return x.AccumulatedCapitalReserve
+		return x.ScoreRank
 	}
-	return ""
+	return 0
 }
 
-func (x *DynastyPrize) GetDataStorage() string {
+func (x *DynastyStatsResponse) GetLandRank() int32 {
 	if x != nil {
-		return x.DataStorage
+		return x.LandRank
 	}
-	return ""
+	return 0
 }
 
-func (x *DynastyPrize) GetPsc() int32 {
+func (x *DynastyStatsResponse) GetPrizeRank() int32 {
 	if x != nil {
-		return x.Psc
+		return x.PrizeRank
 	}
 	return 0
 }
@@ -2245,7 +3220,34 @@ const file_dynasty_proto_rawDesc = "" +
 	"\x03PIC\x18\t \x01(\bR\x03PIC\x12\x12\n" +
 	"\x04ESOO\x18\n" +
 	" \x01(\bR\x04ESOO\x12\x12\n" +
-	"\x04COTB\x18\v \x01(\bR\x04COTB\"f\n" +
+	"\x04COTB\x18\v \x01(\bR\x04COTB\"i\n" +
+	"\x1dGetChildSpendingLimitsRequest\x12\"\n" +
+	"\rchild_user_id\x18\x01 \x01(\x04R\vchildUserId\x12$\n" +
+	"\x0eparent_user_id\x18\x02 \x01(\x04R\fparentUserId\"\xe5\x01\n" +
+	"\x1dSetChildSpendingLimitsRequest\x12\"\n" +
+	"\rchild_user_id\x18\x01 \x01(\x04R\vchildUserId\x12$\n" +
+	"\x0eparent_user_id\x18\x02 \x01(\x04R\fparentUserId\x12\x1b\n" +
+	"\tdaily_psc\x18\x03 \x01(\tR\bdailyPsc\x12\x1f\n" +
+	"\vmonthly_psc\x18\x04 \x01(\tR\n" +
+	"monthlyPsc\x12\x1b\n" +
+	"\tdaily_irr\x18\x05 \x01(\tR\bdailyIrr\x12\x1f\n" +
+	"\vmonthly_irr\x18\x06 \x01(\tR\n" +
+	"monthlyIrr\"\xb4\x01\n" +
+	"\x1bChildSpendingLimitsResponse\x12\"\n" +
+	"\rchild_user_id\x18\x01 \x01(\x04R\vchildUserId\x12(\n" +
+	"\x03psc\x18\x02 \x01(\v2\x16.dynasty.SpendingLimitR\x03psc\x12(\n" +
+	"\x03irr\x18\x03 \x01(\v2\x16.dynasty.SpendingLimitR\x03irr\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x04 \x01(\x04R\tupdatedBy\"\xc8\x01\n" +
+	"\rSpendingLimit\x12\x14\n" +
+	"\x05daily\x18\x01 \x01(\tR\x05daily\x12\x18\n" +
+	"\amonthly\x18\x02 \x01(\tR\amonthly\x12\x1f\n" +
+	"\vspent_today\x18\x03 \x01(\tR\n" +
+	"spentToday\x12(\n" +
+	"\x10spent_this_month\x18\x04 \x01(\tR\x0espentThisMonth\x12\x1b\n" +
+	"\tmax_daily\x18\x05 \x01(\tR\bmaxDaily\x12\x1f\n" +
+	"\vmax_monthly\x18\x06 \x01(\tR\n" +
+	"maxMonthly\"f\n" +
 	"\x10GetPrizesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x129\n" +
 	"\n" +
@@ -2262,7 +3264,13 @@ const file_dynasty_proto_rawDesc = "" +
 	"\x05prize\x18\x01 \x01(\v2\x15.dynasty.DynastyPrizeR\x05prize\"G\n" +
 	"\x11ClaimPrizeRequest\x12\x19\n" +
 	"\bprize_id\x18\x01 \x01(\x04R\aprizeId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x04R\x06userId\"\x91\x02\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"0\n" +
+	"\x15ClaimAllPrizesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"\x7f\n" +
+	"\x16ClaimAllPrizesResponse\x12/\n" +
+	"\aclaimed\x18\x01 \x03(\v2\x15.dynasty.DynastyPrizeR\aclaimed\x12\x10\n" +
+	"\x03psc\x18\x02 \x01(\x03R\x03psc\x12\"\n" +
+	"\fsatisfaction\x18\x03 \x01(\tR\fsatisfaction\"\x8e\x03\n" +
 	"\fDynastyPrize\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
 	"\x06member\x18\x02 \x01(\tR\x06member\x12\"\n" +
@@ -2270,7 +3278,65 @@ const file_dynasty_proto_rawDesc = "" +
 	"\x1cintroduction_profit_increase\x18\x04 \x01(\tR\x1aintroductionProfitIncrease\x12>\n" +
 	"\x1baccumulated_capital_reserve\x18\x05 \x01(\tR\x19accumulatedCapitalReserve\x12!\n" +
 	"\fdata_storage\x18\x06 \x01(\tR\vdataStorage\x12\x10\n" +
-	"\x03psc\x18\a \x01(\x05R\x03psc2\xc2\x02\n" +
+	"\x03psc\x18\a \x01(\x05R\x03psc\x12*\n" +
+	"\x11received_prize_id\x18\b \x01(\x04R\x0freceivedPrizeId\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\n" +
+	" \x01(\tR\texpiresAt\x12\x18\n" +
+	"\amessage\x18\v \x01(\tR\amessage\"\x81\x03\n" +
+	"\x0fMembershipRules\x12&\n" +
+	"\x0fmax_family_size\x18\x01 \x01(\x05R\rmaxFamilySize\x12a\n" +
+	"\x13relationship_limits\x18\x02 \x03(\v20.dynasty.MembershipRules.RelationshipLimitsEntryR\x12relationshipLimits\x12/\n" +
+	"\x13minor_relationships\x18\x03 \x03(\tR\x12minorRelationships\x129\n" +
+	"\x18unverified_relationships\x18\x04 \x03(\tR\x17unverifiedRelationships\x120\n" +
+	"\x14leave_cooldown_hours\x18\x05 \x01(\x05R\x12leaveCooldownHours\x1aE\n" +
+	"\x17RelationshipLimitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"4\n" +
+	"\x19GetMembershipRulesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"g\n" +
+	"\x1cUpdateMembershipRulesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12.\n" +
+	"\x05rules\x18\x02 \x01(\v2\x18.dynasty.MembershipRulesR\x05rules\"\x87\x01\n" +
+	"\x17MembershipRulesResponse\x12.\n" +
+	"\x05rules\x18\x01 \x01(\v2\x18.dynasty.MembershipRulesR\x05rules\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x02 \x01(\x04R\tupdatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\"r\n" +
+	"\x1cGetDynastyLeaderboardRequest\x12\x17\n" +
+	"\asort_by\x18\x01 \x01(\tR\x06sortBy\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\"7\n" +
+	"\x16GetDynastyStatsRequest\x12\x1d\n" +
+	"\n" +
+	"dynasty_id\x18\x01 \x01(\x04R\tdynastyId\"\x8d\x02\n" +
+	"\fDynastyStats\x12\x1d\n" +
+	"\n" +
+	"dynasty_id\x18\x01 \x01(\x04R\tdynastyId\x12'\n" +
+	"\x05owner\x18\x02 \x01(\v2\x11.common.UserBasicR\x05owner\x12!\n" +
+	"\fmember_count\x18\x03 \x01(\x05R\vmemberCount\x12!\n" +
+	"\fmember_score\x18\x04 \x01(\x03R\vmemberScore\x12\x1d\n" +
+	"\n" +
+	"land_count\x18\x05 \x01(\x05R\tlandCount\x12\x1b\n" +
+	"\tprize_psc\x18\x06 \x01(\x03R\bprizePsc\x12\x12\n" +
+	"\x04rank\x18\a \x01(\x05R\x04rank\x12\x1f\n" +
+	"\vcomputed_at\x18\b \x01(\tR\n" +
+	"computedAt\"\x7f\n" +
+	"\x1aDynastyLeaderboardResponse\x12)\n" +
+	"\x04data\x18\x01 \x03(\v2\x15.dynasty.DynastyStatsR\x04data\x126\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x16.common.PaginationMetaR\n" +
+	"pagination\"\x9e\x01\n" +
+	"\x14DynastyStatsResponse\x12+\n" +
+	"\x05stats\x18\x01 \x01(\v2\x15.dynasty.DynastyStatsR\x05stats\x12\x1d\n" +
+	"\n" +
+	"score_rank\x18\x02 \x01(\x05R\tscoreRank\x12\x1b\n" +
+	"\tland_rank\x18\x03 \x01(\x05R\blandRank\x12\x1d\n" +
+	"\n" +
+	"prize_rank\x18\x04 \x01(\x05R\tprizeRank2\xc2\x02\n" +
 	"\x0eDynastyService\x12H\n" +
 	"\rCreateDynasty\x12\x1d.dynasty.CreateDynastyRequest\x1a\x18.dynasty.DynastyResponse\x12B\n" +
 	"\n" +
@@ -2286,16 +3352,25 @@ const file_dynasty_proto_rawDesc = "" +
 	"\x11RejectJoinRequest\x12!.dynasty.RejectJoinRequestRequest\x1a\r.common.Empty\x12E\n" +
 	"\x11DeleteJoinRequest\x12!.dynasty.DeleteJoinRequestRequest\x1a\r.common.Empty\x12c\n" +
 	"\x15GetDefaultPermissions\x12%.dynasty.GetDefaultPermissionsRequest\x1a#.dynasty.DefaultPermissionsResponse\x12H\n" +
-	"\vSearchUsers\x12\x1b.dynasty.SearchUsersRequest\x1a\x1c.dynasty.SearchUsersResponse2\xf1\x01\n" +
+	"\vSearchUsers\x12\x1b.dynasty.SearchUsersRequest\x1a\x1c.dynasty.SearchUsersResponse2\xc1\x03\n" +
 	"\rFamilyService\x12?\n" +
 	"\tGetFamily\x12\x19.dynasty.GetFamilyRequest\x1a\x17.dynasty.FamilyResponse\x12T\n" +
 	"\x10GetFamilyMembers\x12 .dynasty.GetFamilyMembersRequest\x1a\x1e.dynasty.FamilyMembersResponse\x12I\n" +
-	"\x13SetChildPermissions\x12#.dynasty.SetChildPermissionsRequest\x1a\r.common.Empty2\xcd\x01\n" +
+	"\x13SetChildPermissions\x12#.dynasty.SetChildPermissionsRequest\x1a\r.common.Empty\x12f\n" +
+	"\x16GetChildSpendingLimits\x12&.dynasty.GetChildSpendingLimitsRequest\x1a$.dynasty.ChildSpendingLimitsResponse\x12f\n" +
+	"\x16SetChildSpendingLimits\x12&.dynasty.SetChildSpendingLimitsRequest\x1a$.dynasty.ChildSpendingLimitsResponse2\xa0\x02\n" +
 	"\x13DynastyPrizeService\x12?\n" +
 	"\tGetPrizes\x12\x19.dynasty.GetPrizesRequest\x1a\x17.dynasty.PrizesResponse\x12<\n" +
 	"\bGetPrize\x12\x18.dynasty.GetPrizeRequest\x1a\x16.dynasty.PrizeResponse\x127\n" +
 	"\n" +
-	"ClaimPrize\x12\x1a.dynasty.ClaimPrizeRequest\x1a\r.common.EmptyB\x1bZ\x19metargb/shared/pb/dynastyb\x06proto3"
+	"ClaimPrize\x12\x1a.dynasty.ClaimPrizeRequest\x1a\r.common.Empty\x12Q\n" +
+	"\x0eClaimAllPrizes\x12\x1e.dynasty.ClaimAllPrizesRequest\x1a\x1f.dynasty.ClaimAllPrizesResponse2\xd6\x01\n" +
+	"\x16MembershipRulesService\x12Z\n" +
+	"\x12GetMembershipRules\x12\".dynasty.GetMembershipRulesRequest\x1a .dynasty.MembershipRulesResponse\x12`\n" +
+	"\x15UpdateMembershipRules\x12%.dynasty.UpdateMembershipRulesRequest\x1a .dynasty.MembershipRulesResponse2\xd3\x01\n" +
+	"\x19DynastyLeaderboardService\x12c\n" +
+	"\x15GetDynastyLeaderboard\x12%.dynasty.GetDynastyLeaderboardRequest\x1a#.dynasty.DynastyLeaderboardResponse\x12Q\n" +
+	"\x0fGetDynastyStats\x12\x1f.dynasty.GetDynastyStatsRequest\x1a\x1d.dynasty.DynastyStatsResponseB\x1bZ\x19metargb/shared/pb/dynastyb\x06proto3"
 
 var (
 	file_dynasty_proto_rawDescOnce sync.Once
@@ -2309,112 +3384,153 @@ func file_dynasty_proto_rawDescGZIP() []byte {
 	return file_dynasty_proto_rawDescData
 }
 
-var file_dynasty_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_dynasty_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_dynasty_proto_goTypes = []any{
-	(*CreateDynastyRequest)(nil),         // 0: dynasty.CreateDynastyRequest
-	(*GetDynastyRequest)(nil),            // 1: dynasty.GetDynastyRequest
-	(*UpdateDynastyFeatureRequest)(nil),  // 2: dynasty.UpdateDynastyFeatureRequest
-	(*GetUserDynastyRequest)(nil),        // 3: dynasty.GetUserDynastyRequest
-	(*DynastyResponse)(nil),              // 4: dynasty.DynastyResponse
-	(*DynastyFeature)(nil),               // 5: dynasty.DynastyFeature
-	(*AvailableFeature)(nil),             // 6: dynasty.AvailableFeature
-	(*SendJoinRequestRequest)(nil),       // 7: dynasty.SendJoinRequestRequest
-	(*JoinRequestResponse)(nil),          // 8: dynasty.JoinRequestResponse
-	(*GetSentRequestsRequest)(nil),       // 9: dynasty.GetSentRequestsRequest
-	(*GetReceivedRequestsRequest)(nil),   // 10: dynasty.GetReceivedRequestsRequest
-	(*GetJoinRequestRequest)(nil),        // 11: dynasty.GetJoinRequestRequest
-	(*JoinRequestsResponse)(nil),         // 12: dynasty.JoinRequestsResponse
-	(*AcceptJoinRequestRequest)(nil),     // 13: dynasty.AcceptJoinRequestRequest
-	(*RejectJoinRequestRequest)(nil),     // 14: dynasty.RejectJoinRequestRequest
-	(*DeleteJoinRequestRequest)(nil),     // 15: dynasty.DeleteJoinRequestRequest
-	(*GetDefaultPermissionsRequest)(nil), // 16: dynasty.GetDefaultPermissionsRequest
-	(*DefaultPermissionsResponse)(nil),   // 17: dynasty.DefaultPermissionsResponse
-	(*SearchUsersRequest)(nil),           // 18: dynasty.SearchUsersRequest
-	(*SearchUsersResponse)(nil),          // 19: dynasty.SearchUsersResponse
-	(*UserSearchResult)(nil),             // 20: dynasty.UserSearchResult
-	(*GetFamilyRequest)(nil),             // 21: dynasty.GetFamilyRequest
-	(*FamilyResponse)(nil),               // 22: dynasty.FamilyResponse
-	(*GetFamilyMembersRequest)(nil),      // 23: dynasty.GetFamilyMembersRequest
-	(*FamilyMembersResponse)(nil),        // 24: dynasty.FamilyMembersResponse
-	(*FamilyMember)(nil),                 // 25: dynasty.FamilyMember
-	(*SetChildPermissionsRequest)(nil),   // 26: dynasty.SetChildPermissionsRequest
-	(*ChildPermissions)(nil),             // 27: dynasty.ChildPermissions
-	(*GetPrizesRequest)(nil),             // 28: dynasty.GetPrizesRequest
-	(*PrizesResponse)(nil),               // 29: dynasty.PrizesResponse
-	(*GetPrizeRequest)(nil),              // 30: dynasty.GetPrizeRequest
-	(*PrizeResponse)(nil),                // 31: dynasty.PrizeResponse
-	(*ClaimPrizeRequest)(nil),            // 32: dynasty.ClaimPrizeRequest
-	(*DynastyPrize)(nil),                 // 33: dynasty.DynastyPrize
-	(*common.UserBasic)(nil),             // 34: common.UserBasic
-	(*common.PaginationRequest)(nil),     // 35: common.PaginationRequest
-	(*common.PaginationMeta)(nil),        // 36: common.PaginationMeta
-	(*common.Empty)(nil),                 // 37: common.Empty
+	(*CreateDynastyRequest)(nil),          // 0: dynasty.CreateDynastyRequest
+	(*GetDynastyRequest)(nil),             // 1: dynasty.GetDynastyRequest
+	(*UpdateDynastyFeatureRequest)(nil),   // 2: dynasty.UpdateDynastyFeatureRequest
+	(*GetUserDynastyRequest)(nil),         // 3: dynasty.GetUserDynastyRequest
+	(*DynastyResponse)(nil),               // 4: dynasty.DynastyResponse
+	(*DynastyFeature)(nil),                // 5: dynasty.DynastyFeature
+	(*AvailableFeature)(nil),              // 6: dynasty.AvailableFeature
+	(*SendJoinRequestRequest)(nil),        // 7: dynasty.SendJoinRequestRequest
+	(*JoinRequestResponse)(nil),           // 8: dynasty.JoinRequestResponse
+	(*GetSentRequestsRequest)(nil),        // 9: dynasty.GetSentRequestsRequest
+	(*GetReceivedRequestsRequest)(nil),    // 10: dynasty.GetReceivedRequestsRequest
+	(*GetJoinRequestRequest)(nil),         // 11: dynasty.GetJoinRequestRequest
+	(*JoinRequestsResponse)(nil),          // 12: dynasty.JoinRequestsResponse
+	(*AcceptJoinRequestRequest)(nil),      // 13: dynasty.AcceptJoinRequestRequest
+	(*RejectJoinRequestRequest)(nil),      // 14: dynasty.RejectJoinRequestRequest
+	(*DeleteJoinRequestRequest)(nil),      // 15: dynasty.DeleteJoinRequestRequest
+	(*GetDefaultPermissionsRequest)(nil),  // 16: dynasty.GetDefaultPermissionsRequest
+	(*DefaultPermissionsResponse)(nil),    // 17: dynasty.DefaultPermissionsResponse
+	(*SearchUsersRequest)(nil),            // 18: dynasty.SearchUsersRequest
+	(*SearchUsersResponse)(nil),           // 19: dynasty.SearchUsersResponse
+	(*UserSearchResult)(nil),              // 20: dynasty.UserSearchResult
+	(*GetFamilyRequest)(nil),              // 21: dynasty.GetFamilyRequest
+	(*FamilyResponse)(nil),                // 22: dynasty.FamilyResponse
+	(*GetFamilyMembersRequest)(nil),       // 23: dynasty.GetFamilyMembersRequest
+	(*FamilyMembersResponse)(nil),         // 24: dynasty.FamilyMembersResponse
+	(*FamilyMember)(nil),                  // 25: dynasty.FamilyMember
+	(*SetChildPermissionsRequest)(nil),    // 26: dynasty.SetChildPermissionsRequest
+	(*ChildPermissions)(nil),              // 27: dynasty.ChildPermissions
+	(*GetChildSpendingLimitsRequest)(nil), // 28: dynasty.GetChildSpendingLimitsRequest
+	(*SetChildSpendingLimitsRequest)(nil), // 29: dynasty.SetChildSpendingLimitsRequest
+	(*ChildSpendingLimitsResponse)(nil),   // 30: dynasty.ChildSpendingLimitsResponse
+	(*SpendingLimit)(nil),                 // 31: dynasty.SpendingLimit
+	(*GetPrizesRequest)(nil),              // 32: dynasty.GetPrizesRequest
+	(*PrizesResponse)(nil),                // 33: dynasty.PrizesResponse
+	(*GetPrizeRequest)(nil),               // 34: dynasty.GetPrizeRequest
+	(*PrizeResponse)(nil),                 // 35: dynasty.PrizeResponse
+	(*ClaimPrizeRequest)(nil),             // 36: dynasty.ClaimPrizeRequest
+	(*ClaimAllPrizesRequest)(nil),         // 37: dynasty.ClaimAllPrizesRequest
+	(*ClaimAllPrizesResponse)(nil),        // 38: dynasty.ClaimAllPrizesResponse
+	(*DynastyPrize)(nil),                  // 39: dynasty.DynastyPrize
+	(*MembershipRules)(nil),               // 40: dynasty.MembershipRules
+	(*GetMembershipRulesRequest)(nil),     // 41: dynasty.GetMembershipRulesRequest
+	(*UpdateMembershipRulesRequest)(nil),  // 42: dynasty.UpdateMembershipRulesRequest
+	(*MembershipRulesResponse)(nil),       // 43: dynasty.MembershipRulesResponse
+	(*GetDynastyLeaderboardRequest)(nil),  // 44: dynasty.GetDynastyLeaderboardRequest
+	(*GetDynastyStatsRequest)(nil),        // 45: dynasty.GetDynastyStatsRequest
+	(*DynastyStats)(nil),                  // 46: dynasty.DynastyStats
+	(*DynastyLeaderboardResponse)(nil),    // 47: dynasty.DynastyLeaderboardResponse
+	(*DynastyStatsResponse)(nil),          // 48: dynasty.DynastyStatsResponse
+	nil,                                   // 49: dynasty.MembershipRules.RelationshipLimitsEntry
+	(*common.UserBasic)(nil),              // 50: common.UserBasic
+	(*common.PaginationRequest)(nil),      // 51: common.PaginationRequest
+	(*common.PaginationMeta)(nil),         // 52: common.PaginationMeta
+	(*common.Empty)(nil),                  // 53: common.Empty
 }
 var file_dynasty_proto_depIdxs = []int32{
 	5,  // 0: dynasty.DynastyResponse.dynasty_feature:type_name -> dynasty.DynastyFeature
 	6,  // 1: dynasty.DynastyResponse.features:type_name -> dynasty.AvailableFeature
 	27, // 2: dynasty.SendJoinRequestRequest.permissions:type_name -> dynasty.ChildPermissions
-	34, // 3: dynasty.JoinRequestResponse.to_user_info:type_name -> common.UserBasic
-	33, // 4: dynasty.JoinRequestResponse.request_prize:type_name -> dynasty.DynastyPrize
-	35, // 5: dynasty.GetSentRequestsRequest.pagination:type_name -> common.PaginationRequest
-	35, // 6: dynasty.GetReceivedRequestsRequest.pagination:type_name -> common.PaginationRequest
+	50, // 3: dynasty.JoinRequestResponse.to_user_info:type_name -> common.UserBasic
+	39, // 4: dynasty.JoinRequestResponse.request_prize:type_name -> dynasty.DynastyPrize
+	51, // 5: dynasty.GetSentRequestsRequest.pagination:type_name -> common.PaginationRequest
+	51, // 6: dynasty.GetReceivedRequestsRequest.pagination:type_name -> common.PaginationRequest
 	8,  // 7: dynasty.JoinRequestsResponse.requests:type_name -> dynasty.JoinRequestResponse
-	36, // 8: dynasty.JoinRequestsResponse.pagination:type_name -> common.PaginationMeta
+	52, // 8: dynasty.JoinRequestsResponse.pagination:type_name -> common.PaginationMeta
 	27, // 9: dynasty.DefaultPermissionsResponse.permissions:type_name -> dynasty.ChildPermissions
 	20, // 10: dynasty.SearchUsersResponse.data:type_name -> dynasty.UserSearchResult
 	25, // 11: dynasty.FamilyResponse.members:type_name -> dynasty.FamilyMember
-	35, // 12: dynasty.GetFamilyMembersRequest.pagination:type_name -> common.PaginationRequest
+	51, // 12: dynasty.GetFamilyMembersRequest.pagination:type_name -> common.PaginationRequest
 	25, // 13: dynasty.FamilyMembersResponse.members:type_name -> dynasty.FamilyMember
-	36, // 14: dynasty.FamilyMembersResponse.pagination:type_name -> common.PaginationMeta
-	34, // 15: dynasty.FamilyMember.user_info:type_name -> common.UserBasic
+	52, // 14: dynasty.FamilyMembersResponse.pagination:type_name -> common.PaginationMeta
+	50, // 15: dynasty.FamilyMember.user_info:type_name -> common.UserBasic
 	27, // 16: dynasty.SetChildPermissionsRequest.permissions:type_name -> dynasty.ChildPermissions
-	35, // 17: dynasty.GetPrizesRequest.pagination:type_name -> common.PaginationRequest
-	33, // 18: dynasty.PrizesResponse.prizes:type_name -> dynasty.DynastyPrize
-	36, // 19: dynasty.PrizesResponse.pagination:type_name -> common.PaginationMeta
-	33, // 20: dynasty.PrizeResponse.prize:type_name -> dynasty.DynastyPrize
-	0,  // 21: dynasty.DynastyService.CreateDynasty:input_type -> dynasty.CreateDynastyRequest
-	1,  // 22: dynasty.DynastyService.GetDynasty:input_type -> dynasty.GetDynastyRequest
-	2,  // 23: dynasty.DynastyService.UpdateDynastyFeature:input_type -> dynasty.UpdateDynastyFeatureRequest
-	3,  // 24: dynasty.DynastyService.GetUserDynasty:input_type -> dynasty.GetUserDynastyRequest
-	7,  // 25: dynasty.JoinRequestService.SendJoinRequest:input_type -> dynasty.SendJoinRequestRequest
-	9,  // 26: dynasty.JoinRequestService.GetSentRequests:input_type -> dynasty.GetSentRequestsRequest
-	10, // 27: dynasty.JoinRequestService.GetReceivedRequests:input_type -> dynasty.GetReceivedRequestsRequest
-	11, // 28: dynasty.JoinRequestService.GetJoinRequest:input_type -> dynasty.GetJoinRequestRequest
-	13, // 29: dynasty.JoinRequestService.AcceptJoinRequest:input_type -> dynasty.AcceptJoinRequestRequest
-	14, // 30: dynasty.JoinRequestService.RejectJoinRequest:input_type -> dynasty.RejectJoinRequestRequest
-	15, // 31: dynasty.JoinRequestService.DeleteJoinRequest:input_type -> dynasty.DeleteJoinRequestRequest
-	16, // 32: dynasty.JoinRequestService.GetDefaultPermissions:input_type -> dynasty.GetDefaultPermissionsRequest
-	18, // 33: dynasty.JoinRequestService.SearchUsers:input_type -> dynasty.SearchUsersRequest
-	21, // 34: dynasty.FamilyService.GetFamily:input_type -> dynasty.GetFamilyRequest
-	23, // 35: dynasty.FamilyService.GetFamilyMembers:input_type -> dynasty.GetFamilyMembersRequest
-	26, // 36: dynasty.FamilyService.SetChildPermissions:input_type -> dynasty.SetChildPermissionsRequest
-	28, // 37: dynasty.DynastyPrizeService.GetPrizes:input_type -> dynasty.GetPrizesRequest
-	30, // 38: dynasty.DynastyPrizeService.GetPrize:input_type -> dynasty.GetPrizeRequest
-	32, // 39: dynasty.DynastyPrizeService.ClaimPrize:input_type -> dynasty.ClaimPrizeRequest
-	4,  // 40: dynasty.DynastyService.CreateDynasty:output_type -> dynasty.DynastyResponse
-	4,  // 41: dynasty.DynastyService.GetDynasty:output_type -> dynasty.DynastyResponse
-	4,  // 42: dynasty.DynastyService.UpdateDynastyFeature:output_type -> dynasty.DynastyResponse
-	4,  // 43: dynasty.DynastyService.GetUserDynasty:output_type -> dynasty.DynastyResponse
-	8,  // 44: dynasty.JoinRequestService.SendJoinRequest:output_type -> dynasty.JoinRequestResponse
-	12, // 45: dynasty.JoinRequestService.GetSentRequests:output_type -> dynasty.JoinRequestsResponse
-	12, // 46: dynasty.JoinRequestService.GetReceivedRequests:output_type -> dynasty.JoinRequestsResponse
-	8,  // 47: dynasty.JoinRequestService.GetJoinRequest:output_type -> dynasty.JoinRequestResponse
-	37, // 48: dynasty.JoinRequestService.AcceptJoinRequest:output_type -> common.Empty
-	37, // 49: dynasty.JoinRequestService.RejectJoinRequest:output_type -> common.Empty
-	37, // 50: dynasty.JoinRequestService.DeleteJoinRequest:output_type -> common.Empty
-	17, // 51: dynasty.JoinRequestService.GetDefaultPermissions:output_type -> dynasty.DefaultPermissionsResponse
-	19, // 52: dynasty.JoinRequestService.SearchUsers:output_type -> dynasty.SearchUsersResponse
-	22, // 53: dynasty.FamilyService.GetFamily:output_type -> dynasty.FamilyResponse
-	24, // 54: dynasty.FamilyService.GetFamilyMembers:output_type -> dynasty.FamilyMembersResponse
-	37, // 55: dynasty.FamilyService.SetChildPermissions:output_type -> common.Empty
-	29, // 56: dynasty.DynastyPrizeService.GetPrizes:output_type -> dynasty.PrizesResponse
-	31, // 57: dynasty.DynastyPrizeService.GetPrize:output_type -> dynasty.PrizeResponse
-	37, // 58: dynasty.DynastyPrizeService.ClaimPrize:output_type -> common.Empty
-	40, // [40:59] is the sub-list for method output_type
-	21, // [21:40] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	31, // 17: dynasty.ChildSpendingLimitsResponse.psc:type_name -> dynasty.SpendingLimit
+	31, // 18: dynasty.ChildSpendingLimitsResponse.irr:type_name -> dynasty.SpendingLimit
+	51, // 19: dynasty.GetPrizesRequest.pagination:type_name -> common.PaginationRequest
+	39, // 20: dynasty.PrizesResponse.prizes:type_name -> dynasty.DynastyPrize
+	52, // 21: dynasty.PrizesResponse.pagination:type_name -> common.PaginationMeta
+	39, // 22: dynasty.PrizeResponse.prize:type_name -> dynasty.DynastyPrize
+	39, // 23: dynasty.ClaimAllPrizesResponse.claimed:type_name -> dynasty.DynastyPrize
+	49, // 24: dynasty.MembershipRules.relationship_limits:type_name -> dynasty.MembershipRules.RelationshipLimitsEntry
+	40, // 25: dynasty.UpdateMembershipRulesRequest.rules:type_name -> dynasty.MembershipRules
+	40, // 26: dynasty.MembershipRulesResponse.rules:type_name -> dynasty.MembershipRules
+	51, // 27: dynasty.GetDynastyLeaderboardRequest.pagination:type_name -> common.PaginationRequest
+	50, // 28: dynasty.DynastyStats.owner:type_name -> common.UserBasic
+	46, // 29: dynasty.DynastyLeaderboardResponse.data:type_name -> dynasty.DynastyStats
+	52, // 30: dynasty.DynastyLeaderboardResponse.pagination:type_name -> common.PaginationMeta
+	46, // 31: dynasty.DynastyStatsResponse.stats:type_name -> dynasty.DynastyStats
+	0,  // 32: dynasty.DynastyService.CreateDynasty:input_type -> dynasty.CreateDynastyRequest
+	1,  // 33: dynasty.DynastyService.GetDynasty:input_type -> dynasty.GetDynastyRequest
+	2,  // 34: dynasty.DynastyService.UpdateDynastyFeature:input_type -> dynasty.UpdateDynastyFeatureRequest
+	3,  // 35: dynasty.DynastyService.GetUserDynasty:input_type -> dynasty.GetUserDynastyRequest
+	7,  // 36: dynasty.JoinRequestService.SendJoinRequest:input_type -> dynasty.SendJoinRequestRequest
+	9,  // 37: dynasty.JoinRequestService.GetSentRequests:input_type -> dynasty.GetSentRequestsRequest
+	10, // 38: dynasty.JoinRequestService.GetReceivedRequests:input_type -> dynasty.GetReceivedRequestsRequest
+	11, // 39: dynasty.JoinRequestService.GetJoinRequest:input_type -> dynasty.GetJoinRequestRequest
+	13, // 40: dynasty.JoinRequestService.AcceptJoinRequest:input_type -> dynasty.AcceptJoinRequestRequest
+	14, // 41: dynasty.JoinRequestService.RejectJoinRequest:input_type -> dynasty.RejectJoinRequestRequest
+	15, // 42: dynasty.JoinRequestService.DeleteJoinRequest:input_type -> dynasty.DeleteJoinRequestRequest
+	16, // 43: dynasty.JoinRequestService.GetDefaultPermissions:input_type -> dynasty.GetDefaultPermissionsRequest
+	18, // 44: dynasty.JoinRequestService.SearchUsers:input_type -> dynasty.SearchUsersRequest
+	21, // 45: dynasty.FamilyService.GetFamily:input_type -> dynasty.GetFamilyRequest
+	23, // 46: dynasty.FamilyService.GetFamilyMembers:input_type -> dynasty.GetFamilyMembersRequest
+	26, // 47: dynasty.FamilyService.SetChildPermissions:input_type -> dynasty.SetChildPermissionsRequest
+	28, // 48: dynasty.FamilyService.GetChildSpendingLimits:input_type -> dynasty.GetChildSpendingLimitsRequest
+	29, // 49: dynasty.FamilyService.SetChildSpendingLimits:input_type -> dynasty.SetChildSpendingLimitsRequest
+	32, // 50: dynasty.DynastyPrizeService.GetPrizes:input_type -> dynasty.GetPrizesRequest
+	34, // 51: dynasty.DynastyPrizeService.GetPrize:input_type -> dynasty.GetPrizeRequest
+	36, // 52: dynasty.DynastyPrizeService.ClaimPrize:input_type -> dynasty.ClaimPrizeRequest
+	37, // 53: dynasty.DynastyPrizeService.ClaimAllPrizes:input_type -> dynasty.ClaimAllPrizesRequest
+	41, // 54: dynasty.MembershipRulesService.GetMembershipRules:input_type -> dynasty.GetMembershipRulesRequest
+	42, // 55: dynasty.MembershipRulesService.UpdateMembershipRules:input_type -> dynasty.UpdateMembershipRulesRequest
+	44, // 56: dynasty.DynastyLeaderboardService.GetDynastyLeaderboard:input_type -> dynasty.GetDynastyLeaderboardRequest
+	45, // 57: dynasty.DynastyLeaderboardService.GetDynastyStats:input_type -> dynasty.GetDynastyStatsRequest
+	4,  // 58: dynasty.DynastyService.CreateDynasty:output_type -> dynasty.DynastyResponse
+	4,  // 59: dynasty.DynastyService.GetDynasty:output_type -> dynasty.DynastyResponse
+	4,  // 60: dynasty.DynastyService.UpdateDynastyFeature:output_type -> dynasty.DynastyResponse
+	4,  // 61: dynasty.DynastyService.GetUserDynasty:output_type -> dynasty.DynastyResponse
+	8,  // 62: dynasty.JoinRequestService.SendJoinRequest:output_type -> dynasty.JoinRequestResponse
+	12, // 63: dynasty.JoinRequestService.GetSentRequests:output_type -> dynasty.JoinRequestsResponse
+	12, // 64: dynasty.JoinRequestService.GetReceivedRequests:output_type -> dynasty.JoinRequestsResponse
+	8,  // 65: dynasty.JoinRequestService.GetJoinRequest:output_type -> dynasty.JoinRequestResponse
+	53, // 66: dynasty.JoinRequestService.AcceptJoinRequest:output_type -> common.Empty
+	53, // 67: dynasty.JoinRequestService.RejectJoinRequest:output_type -> common.Empty
+	53, // 68: dynasty.JoinRequestService.DeleteJoinRequest:output_type -> common.Empty
+	17, // 69: dynasty.JoinRequestService.GetDefaultPermissions:output_type -> dynasty.DefaultPermissionsResponse
+	19, // 70: dynasty.JoinRequestService.SearchUsers:output_type -> dynasty.SearchUsersResponse
+	22, // 71: dynasty.FamilyService.GetFamily:output_type -> dynasty.FamilyResponse
+	24, // 72: dynasty.FamilyService.GetFamilyMembers:output_type -> dynasty.FamilyMembersResponse
+	53, // 73: dynasty.FamilyService.SetChildPermissions:output_type -> common.Empty
+	30, // 74: dynasty.FamilyService.GetChildSpendingLimits:output_type -> dynasty.ChildSpendingLimitsResponse
+	30, // 75: dynasty.FamilyService.SetChildSpendingLimits:output_type -> dynasty.ChildSpendingLimitsResponse
+	33, // 76: dynasty.DynastyPrizeService.GetPrizes:output_type -> dynasty.PrizesResponse
+	35, // 77: dynasty.DynastyPrizeService.GetPrize:output_type -> dynasty.PrizeResponse
+	53, // 78: dynasty.DynastyPrizeService.ClaimPrize:output_type -> common.Empty
+	38, // 79: dynasty.DynastyPrizeService.ClaimAllPrizes:output_type -> dynasty.ClaimAllPrizesResponse
+	43, // 80: dynasty.MembershipRulesService.GetMembershipRules:output_type -> dynasty.MembershipRulesResponse
+	43, // 81: dynasty.MembershipRulesService.UpdateMembershipRules:output_type -> dynasty.MembershipRulesResponse
+	47, // 82: dynasty.DynastyLeaderboardService.GetDynastyLeaderboard:output_type -> dynasty.DynastyLeaderboardResponse
+	48, // 83: dynasty.DynastyLeaderboardService.GetDynastyStats:output_type -> dynasty.DynastyStatsResponse
+	58, // [58:84] is the sub-list for method output_type
+	32, // [32:58] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_dynasty_proto_init() }
@@ -2428,9 +3544,9 @@ func file_dynasty_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dynasty_proto_rawDesc), len(file_dynasty_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   50,
 			NumExtensions: 0,
-			NumServices:   4,
+			NumServices:   6,
 		},
 		GoTypes:           file_dynasty_proto_goTypes,
 		DependencyIndexes: file_dynasty_proto_depIdxs,
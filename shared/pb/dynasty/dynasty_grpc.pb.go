@@ -650,9 +650,11 @@ var JoinRequestService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	FamilyService_GetFamily_FullMethodName           = "/dynasty.FamilyService/GetFamily"
-	FamilyService_GetFamilyMembers_FullMethodName    = "/dynasty.FamilyService/GetFamilyMembers"
-	FamilyService_SetChildPermissions_FullMethodName = "/dynasty.FamilyService/SetChildPermissions"
+	FamilyService_GetFamily_FullMethodName              = "/dynasty.FamilyService/GetFamily"
+	FamilyService_GetFamilyMembers_FullMethodName       = "/dynasty.FamilyService/GetFamilyMembers"
+	FamilyService_SetChildPermissions_FullMethodName    = "/dynasty.FamilyService/SetChildPermissions"
+	FamilyService_GetChildSpendingLimits_FullMethodName = "/dynasty.FamilyService/GetChildSpendingLimits"
+	FamilyService_SetChildSpendingLimits_FullMethodName = "/dynasty.FamilyService/SetChildSpendingLimits"
 )
 
 // FamilyServiceClient is the client API for FamilyService service.
@@ -664,6 +666,10 @@ type FamilyServiceClient interface {
 	GetFamily(ctx context.Context, in *GetFamilyRequest, opts ...grpc.CallOption) (*FamilyResponse, error)
 	GetFamilyMembers(ctx context.Context, in *GetFamilyMembersRequest, opts ...grpc.CallOption) (*FamilyMembersResponse, error)
 	SetChildPermissions(ctx context.Context, in *SetChildPermissionsRequest, opts ...grpc.CallOption) (*common.Empty, error)
+	// Spending limits of an offspring under 18, kept by commercial-service.
+	// Only the dynasty owner the child joined as offspring can use them.
+	GetChildSpendingLimits(ctx context.Context, in *GetChildSpendingLimitsRequest, opts ...grpc.CallOption) (*ChildSpendingLimitsResponse, error)
+	SetChildSpendingLimits(ctx context.Context, in *SetChildSpendingLimitsRequest, opts ...grpc.CallOption) (*ChildSpendingLimitsResponse, error)
 }
 
 type familyServiceClient struct {
@@ -704,6 +710,26 @@ func (c *familyServiceClient) SetChildPermissions(ctx context.Context, in *SetCh
 	return out, nil
 }
 
+func (c *familyServiceClient) GetChildSpendingLimits(ctx context.Context, in *GetChildSpendingLimitsRequest, opts ...grpc.CallOption) (*ChildSpendingLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChildSpendingLimitsResponse)
+	err := c.cc.Invoke(ctx, FamilyService_GetChildSpendingLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *familyServiceClient) SetChildSpendingLimits(ctx context.Context, in *SetChildSpendingLimitsRequest, opts ...grpc.CallOption) (*ChildSpendingLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChildSpendingLimitsResponse)
+	err := c.cc.Invoke(ctx, FamilyService_SetChildSpendingLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FamilyServiceServer is the server API for FamilyService service.
 // All implementations must embed UnimplementedFamilyServiceServer
 // for forward compatibility.
@@ -713,6 +739,10 @@ type FamilyServiceServer interface {
 	GetFamily(context.Context, *GetFamilyRequest) (*FamilyResponse, error)
 	GetFamilyMembers(context.Context, *GetFamilyMembersRequest) (*FamilyMembersResponse, error)
 	SetChildPermissions(context.Context, *SetChildPermissionsRequest) (*common.Empty, error)
+	// Spending limits of an offspring under 18, kept by commercial-service.
+	// Only the dynasty owner the child joined as offspring can use them.
+	GetChildSpendingLimits(context.Context, *GetChildSpendingLimitsRequest) (*ChildSpendingLimitsResponse, error)
+	SetChildSpendingLimits(context.Context, *SetChildSpendingLimitsRequest) (*ChildSpendingLimitsResponse, error)
 	mustEmbedUnimplementedFamilyServiceServer()
 }
 
@@ -732,6 +762,12 @@ func (UnimplementedFamilyServiceServer) GetFamilyMembers(context.Context, *GetFa
 func (UnimplementedFamilyServiceServer) SetChildPermissions(context.Context, *SetChildPermissionsRequest) (*common.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetChildPermissions not implemented")
 }
+func (UnimplementedFamilyServiceServer) GetChildSpendingLimits(context.Context, *GetChildSpendingLimitsRequest) (*ChildSpendingLimitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetChildSpendingLimits not implemented")
+}
+func (UnimplementedFamilyServiceServer) SetChildSpendingLimits(context.Context, *SetChildSpendingLimitsRequest) (*ChildSpendingLimitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetChildSpendingLimits not implemented")
+}
 func (UnimplementedFamilyServiceServer) mustEmbedUnimplementedFamilyServiceServer() {}
 func (UnimplementedFamilyServiceServer) testEmbeddedByValue()                       {}
 
@@ -807,6 +843,42 @@ func _FamilyService_SetChildPermissions_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FamilyService_GetChildSpendingLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChildSpendingLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FamilyServiceServer).GetChildSpendingLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FamilyService_GetChildSpendingLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FamilyServiceServer).GetChildSpendingLimits(ctx, req.(*GetChildSpendingLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FamilyService_SetChildSpendingLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChildSpendingLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FamilyServiceServer).SetChildSpendingLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FamilyService_SetChildSpendingLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FamilyServiceServer).SetChildSpendingLimits(ctx, req.(*SetChildSpendingLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FamilyService_ServiceDesc is the grpc.ServiceDesc for FamilyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -826,26 +898,39 @@ var FamilyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetChildPermissions",
 			Handler:    _FamilyService_SetChildPermissions_Handler,
 		},
+		{
+			MethodName: "GetChildSpendingLimits",
+			Handler:    _FamilyService_GetChildSpendingLimits_Handler,
+		},
+		{
+			MethodName: "SetChildSpendingLimits",
+			Handler:    _FamilyService_SetChildSpendingLimits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dynasty.proto",
 }
 
 const (
-	DynastyPrizeService_GetPrizes_FullMethodName  = "/dynasty.DynastyPrizeService/GetPrizes"
-	DynastyPrizeService_GetPrize_FullMethodName   = "/dynasty.DynastyPrizeService/GetPrize"
-	DynastyPrizeService_ClaimPrize_FullMethodName = "/dynasty.DynastyPrizeService/ClaimPrize"
+	DynastyPrizeService_GetPrizes_FullMethodName      = "/dynasty.DynastyPrizeService/GetPrizes"
+	DynastyPrizeService_GetPrize_FullMethodName       = "/dynasty.DynastyPrizeService/GetPrize"
+	DynastyPrizeService_ClaimPrize_FullMethodName     = "/dynasty.DynastyPrizeService/ClaimPrize"
+	DynastyPrizeService_ClaimAllPrizes_FullMethodName = "/dynasty.DynastyPrizeService/ClaimAllPrizes"
 )
 
 // DynastyPrizeServiceClient is the client API for DynastyPrizeService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// DynastyPrizeService handles dynasty prizes
+// DynastyPrizeService handles dynasty prizes. Awarded prizes are held in
+// escrow until the user claims them or their claim window ends, when they
+// are returned to the prize pool.
 type DynastyPrizeServiceClient interface {
 	GetPrizes(ctx context.Context, in *GetPrizesRequest, opts ...grpc.CallOption) (*PrizesResponse, error)
 	GetPrize(ctx context.Context, in *GetPrizeRequest, opts ...grpc.CallOption) (*PrizeResponse, error)
 	ClaimPrize(ctx context.Context, in *ClaimPrizeRequest, opts ...grpc.CallOption) (*common.Empty, error)
+	// ClaimAllPrizes claims every unexpired prize of the user
+	ClaimAllPrizes(ctx context.Context, in *ClaimAllPrizesRequest, opts ...grpc.CallOption) (*ClaimAllPrizesResponse, error)
 }
 
 type dynastyPrizeServiceClient struct {
@@ -886,15 +971,29 @@ func (c *dynastyPrizeServiceClient) ClaimPrize(ctx context.Context, in *ClaimPri
 	return out, nil
 }
 
+func (c *dynastyPrizeServiceClient) ClaimAllPrizes(ctx context.Context, in *ClaimAllPrizesRequest, opts ...grpc.CallOption) (*ClaimAllPrizesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimAllPrizesResponse)
+	err := c.cc.Invoke(ctx, DynastyPrizeService_ClaimAllPrizes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DynastyPrizeServiceServer is the server API for DynastyPrizeService service.
 // All implementations must embed UnimplementedDynastyPrizeServiceServer
 // for forward compatibility.
 //
-// DynastyPrizeService handles dynasty prizes
+// DynastyPrizeService handles dynasty prizes. Awarded prizes are held in
+// escrow until the user claims them or their claim window ends, when they
+// are returned to the prize pool.
 type DynastyPrizeServiceServer interface {
 	GetPrizes(context.Context, *GetPrizesRequest) (*PrizesResponse, error)
 	GetPrize(context.Context, *GetPrizeRequest) (*PrizeResponse, error)
 	ClaimPrize(context.Context, *ClaimPrizeRequest) (*common.Empty, error)
+	// ClaimAllPrizes claims every unexpired prize of the user
+	ClaimAllPrizes(context.Context, *ClaimAllPrizesRequest) (*ClaimAllPrizesResponse, error)
 	mustEmbedUnimplementedDynastyPrizeServiceServer()
 }
 
@@ -914,6 +1013,9 @@ func (UnimplementedDynastyPrizeServiceServer) GetPrize(context.Context, *GetPriz
 func (UnimplementedDynastyPrizeServiceServer) ClaimPrize(context.Context, *ClaimPrizeRequest) (*common.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method ClaimPrize not implemented")
 }
+func (UnimplementedDynastyPrizeServiceServer) ClaimAllPrizes(context.Context, *ClaimAllPrizesRequest) (*ClaimAllPrizesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClaimAllPrizes not implemented")
+}
 func (UnimplementedDynastyPrizeServiceServer) mustEmbedUnimplementedDynastyPrizeServiceServer() {}
 func (UnimplementedDynastyPrizeServiceServer) testEmbeddedByValue()                             {}
 
@@ -989,6 +1091,24 @@ func _DynastyPrizeService_ClaimPrize_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DynastyPrizeService_ClaimAllPrizes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimAllPrizesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DynastyPrizeServiceServer).ClaimAllPrizes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DynastyPrizeService_ClaimAllPrizes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DynastyPrizeServiceServer).ClaimAllPrizes(ctx, req.(*ClaimAllPrizesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DynastyPrizeService_ServiceDesc is the grpc.ServiceDesc for DynastyPrizeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1008,6 +1128,302 @@ var DynastyPrizeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimPrize",
 			Handler:    _DynastyPrizeService_ClaimPrize_Handler,
 		},
+		{
+			MethodName: "ClaimAllPrizes",
+			Handler:    _DynastyPrizeService_ClaimAllPrizes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dynasty.proto",
+}
+
+const (
+	MembershipRulesService_GetMembershipRules_FullMethodName    = "/dynasty.MembershipRulesService/GetMembershipRules"
+	MembershipRulesService_UpdateMembershipRules_FullMethodName = "/dynasty.MembershipRulesService/UpdateMembershipRules"
+)
+
+// MembershipRulesServiceClient is the client API for MembershipRulesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MembershipRulesService lets dynasty admins manage the rules join requests are checked against
+type MembershipRulesServiceClient interface {
+	GetMembershipRules(ctx context.Context, in *GetMembershipRulesRequest, opts ...grpc.CallOption) (*MembershipRulesResponse, error)
+	UpdateMembershipRules(ctx context.Context, in *UpdateMembershipRulesRequest, opts ...grpc.CallOption) (*MembershipRulesResponse, error)
+}
+
+type membershipRulesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMembershipRulesServiceClient(cc grpc.ClientConnInterface) MembershipRulesServiceClient {
+	return &membershipRulesServiceClient{cc}
+}
+
+func (c *membershipRulesServiceClient) GetMembershipRules(ctx context.Context, in *GetMembershipRulesRequest, opts ...grpc.CallOption) (*MembershipRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MembershipRulesResponse)
+	err := c.cc.Invoke(ctx, MembershipRulesService_GetMembershipRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *membershipRulesServiceClient) UpdateMembershipRules(ctx context.Context, in *UpdateMembershipRulesRequest, opts ...grpc.CallOption) (*MembershipRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MembershipRulesResponse)
+	err := c.cc.Invoke(ctx, MembershipRulesService_UpdateMembershipRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MembershipRulesServiceServer is the server API for MembershipRulesService service.
+// All implementations must embed UnimplementedMembershipRulesServiceServer
+// for forward compatibility.
+//
+// MembershipRulesService lets dynasty admins manage the rules join requests are checked against
+type MembershipRulesServiceServer interface {
+	GetMembershipRules(context.Context, *GetMembershipRulesRequest) (*MembershipRulesResponse, error)
+	UpdateMembershipRules(context.Context, *UpdateMembershipRulesRequest) (*MembershipRulesResponse, error)
+	mustEmbedUnimplementedMembershipRulesServiceServer()
+}
+
+// UnimplementedMembershipRulesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMembershipRulesServiceServer struct{}
+
+func (UnimplementedMembershipRulesServiceServer) GetMembershipRules(context.Context, *GetMembershipRulesRequest) (*MembershipRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMembershipRules not implemented")
+}
+func (UnimplementedMembershipRulesServiceServer) UpdateMembershipRules(context.Context, *UpdateMembershipRulesRequest) (*MembershipRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMembershipRules not implemented")
+}
+func (UnimplementedMembershipRulesServiceServer) mustEmbedUnimplementedMembershipRulesServiceServer() {
+}
+func (UnimplementedMembershipRulesServiceServer) testEmbeddedByValue() {}
+
+// UnsafeMembershipRulesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MembershipRulesServiceServer will
+// result in compilation errors.
+type UnsafeMembershipRulesServiceServer interface {
+	mustEmbedUnimplementedMembershipRulesServiceServer()
+}
+
+func RegisterMembershipRulesServiceServer(s grpc.ServiceRegistrar, srv MembershipRulesServiceServer) {
+	// If the following call panics, it indicates UnimplementedMembershipRulesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MembershipRulesService_ServiceDesc, srv)
+}
+
+func _MembershipRulesService_GetMembershipRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMembershipRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MembershipRulesServiceServer).GetMembershipRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MembershipRulesService_GetMembershipRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MembershipRulesServiceServer).GetMembershipRules(ctx, req.(*GetMembershipRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MembershipRulesService_UpdateMembershipRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMembershipRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MembershipRulesServiceServer).UpdateMembershipRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MembershipRulesService_UpdateMembershipRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MembershipRulesServiceServer).UpdateMembershipRules(ctx, req.(*UpdateMembershipRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MembershipRulesService_ServiceDesc is the grpc.ServiceDesc for MembershipRulesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MembershipRulesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dynasty.MembershipRulesService",
+	HandlerType: (*MembershipRulesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMembershipRules",
+			Handler:    _MembershipRulesService_GetMembershipRules_Handler,
+		},
+		{
+			MethodName: "UpdateMembershipRules",
+			Handler:    _MembershipRulesService_UpdateMembershipRules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dynasty.proto",
+}
+
+const (
+	DynastyLeaderboardService_GetDynastyLeaderboard_FullMethodName = "/dynasty.DynastyLeaderboardService/GetDynastyLeaderboard"
+	DynastyLeaderboardService_GetDynastyStats_FullMethodName       = "/dynasty.DynastyLeaderboardService/GetDynastyStats"
+)
+
+// DynastyLeaderboardServiceClient is the client API for DynastyLeaderboardService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DynastyLeaderboardService ranks dynasties by the statistics a periodic job
+// aggregates into dynasty_stats
+type DynastyLeaderboardServiceClient interface {
+	GetDynastyLeaderboard(ctx context.Context, in *GetDynastyLeaderboardRequest, opts ...grpc.CallOption) (*DynastyLeaderboardResponse, error)
+	GetDynastyStats(ctx context.Context, in *GetDynastyStatsRequest, opts ...grpc.CallOption) (*DynastyStatsResponse, error)
+}
+
+type dynastyLeaderboardServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDynastyLeaderboardServiceClient(cc grpc.ClientConnInterface) DynastyLeaderboardServiceClient {
+	return &dynastyLeaderboardServiceClient{cc}
+}
+
+func (c *dynastyLeaderboardServiceClient) GetDynastyLeaderboard(ctx context.Context, in *GetDynastyLeaderboardRequest, opts ...grpc.CallOption) (*DynastyLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DynastyLeaderboardResponse)
+	err := c.cc.Invoke(ctx, DynastyLeaderboardService_GetDynastyLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dynastyLeaderboardServiceClient) GetDynastyStats(ctx context.Context, in *GetDynastyStatsRequest, opts ...grpc.CallOption) (*DynastyStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DynastyStatsResponse)
+	err := c.cc.Invoke(ctx, DynastyLeaderboardService_GetDynastyStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DynastyLeaderboardServiceServer is the server API for DynastyLeaderboardService service.
+// All implementations must embed UnimplementedDynastyLeaderboardServiceServer
+// for forward compatibility.
+//
+// DynastyLeaderboardService ranks dynasties by the statistics a periodic job
+// aggregates into dynasty_stats
+type DynastyLeaderboardServiceServer interface {
+	GetDynastyLeaderboard(context.Context, *GetDynastyLeaderboardRequest) (*DynastyLeaderboardResponse, error)
+	GetDynastyStats(context.Context, *GetDynastyStatsRequest) (*DynastyStatsResponse, error)
+	mustEmbedUnimplementedDynastyLeaderboardServiceServer()
+}
+
+// UnimplementedDynastyLeaderboardServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDynastyLeaderboardServiceServer struct{}
+
+func (UnimplementedDynastyLeaderboardServiceServer) GetDynastyLeaderboard(context.Context, *GetDynastyLeaderboardRequest) (*DynastyLeaderboardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDynastyLeaderboard not implemented")
+}
+func (UnimplementedDynastyLeaderboardServiceServer) GetDynastyStats(context.Context, *GetDynastyStatsRequest) (*DynastyStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDynastyStats not implemented")
+}
+func (UnimplementedDynastyLeaderboardServiceServer) mustEmbedUnimplementedDynastyLeaderboardServiceServer() {
+}
+func (UnimplementedDynastyLeaderboardServiceServer) testEmbeddedByValue() {}
+
+// UnsafeDynastyLeaderboardServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DynastyLeaderboardServiceServer will
+// result in compilation errors.
+type UnsafeDynastyLeaderboardServiceServer interface {
+	mustEmbedUnimplementedDynastyLeaderboardServiceServer()
+}
+
+func RegisterDynastyLeaderboardServiceServer(s grpc.ServiceRegistrar, srv DynastyLeaderboardServiceServer) {
+	// If the following call panics, it indicates UnimplementedDynastyLeaderboardServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DynastyLeaderboardService_ServiceDesc, srv)
+}
+
+func _DynastyLeaderboardService_GetDynastyLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDynastyLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DynastyLeaderboardServiceServer).GetDynastyLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DynastyLeaderboardService_GetDynastyLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DynastyLeaderboardServiceServer).GetDynastyLeaderboard(ctx, req.(*GetDynastyLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DynastyLeaderboardService_GetDynastyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDynastyStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DynastyLeaderboardServiceServer).GetDynastyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DynastyLeaderboardService_GetDynastyStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DynastyLeaderboardServiceServer).GetDynastyStats(ctx, req.(*GetDynastyStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DynastyLeaderboardService_ServiceDesc is the grpc.ServiceDesc for DynastyLeaderboardService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DynastyLeaderboardService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dynasty.DynastyLeaderboardService",
+	HandlerType: (*DynastyLeaderboardServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDynastyLeaderboard",
+			Handler:    _DynastyLeaderboardService_GetDynastyLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetDynastyStats",
+			Handler:    _DynastyLeaderboardService_GetDynastyStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dynasty.proto",
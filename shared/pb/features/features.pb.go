@@ -10,7 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
-	_ "metargb/shared/pb/common"
+	common "metargb/shared/pb/common"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -532,7 +532,7 @@ type AddMyFeatureImagesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                  // User ID from path (for scoped binding)
 	FeatureId     uint64                 `protobuf:"varint,2,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`         // Feature ID (must belong to user_id)
-	ImageData     [][]byte               `protobuf:"bytes,3,rep,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`          // Image file data (PNG, JPG, BMP, ≤1024 KB each)
+	ImageData     [][]byte               `protobuf:"bytes,3,rep,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`          // Image file data (PNG or JPG, ≤1024 KB each)
 	Filenames     []string               `protobuf:"bytes,4,rep,name=filenames,proto3" json:"filenames,omitempty"`                           // Original filenames
 	ContentTypes  []string               `protobuf:"bytes,5,rep,name=content_types,json=contentTypes,proto3" json:"content_types,omitempty"` // MIME types
 	unknownFields protoimpl.UnknownFields
@@ -724,6 +724,95 @@ func (x *UpdateMyFeatureRequest) GetMinimumPricePercentage() int32 {
 	return 0
 }
 
+// CountOwnedFeaturesRequest counts the features of many owners at once, at most 1000
+type CountOwnedFeaturesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerIds      []uint64               `protobuf:"varint,1,rep,packed,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountOwnedFeaturesRequest) Reset() {
+	*x = CountOwnedFeaturesRequest{}
+	mi := &file_features_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountOwnedFeaturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountOwnedFeaturesRequest) ProtoMessage() {}
+
+func (x *CountOwnedFeaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountOwnedFeaturesRequest.ProtoReflect.Descriptor instead.
+func (*CountOwnedFeaturesRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CountOwnedFeaturesRequest) GetOwnerIds() []uint64 {
+	if x != nil {
+		return x.OwnerIds
+	}
+	return nil
+}
+
+type OwnedFeatureCountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Counts        map[uint64]int32       `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Owners without features are left out
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnedFeatureCountsResponse) Reset() {
+	*x = OwnedFeatureCountsResponse{}
+	mi := &file_features_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnedFeatureCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnedFeatureCountsResponse) ProtoMessage() {}
+
+func (x *OwnedFeatureCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnedFeatureCountsResponse.ProtoReflect.Descriptor instead.
+func (*OwnedFeatureCountsResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *OwnedFeatureCountsResponse) GetCounts() map[uint64]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
 // Pagination messages (simple pagination - no total counts)
 type PaginationLinks struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -737,7 +826,7 @@ type PaginationLinks struct {
 
 func (x *PaginationLinks) Reset() {
 	*x = PaginationLinks{}
-	mi := &file_features_proto_msgTypes[13]
+	mi := &file_features_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -749,7 +838,7 @@ func (x *PaginationLinks) String() string {
 func (*PaginationLinks) ProtoMessage() {}
 
 func (x *PaginationLinks) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[13]
+	mi := &file_features_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -762,7 +851,7 @@ func (x *PaginationLinks) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaginationLinks.ProtoReflect.Descriptor instead.
 func (*PaginationLinks) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{13}
+	return file_features_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *PaginationLinks) GetFirst() string {
@@ -804,7 +893,7 @@ type SimplePaginationMeta struct {
 
 func (x *SimplePaginationMeta) Reset() {
 	*x = SimplePaginationMeta{}
-	mi := &file_features_proto_msgTypes[14]
+	mi := &file_features_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -816,7 +905,7 @@ func (x *SimplePaginationMeta) String() string {
 func (*SimplePaginationMeta) ProtoMessage() {}
 
 func (x *SimplePaginationMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[14]
+	mi := &file_features_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,7 +918,7 @@ func (x *SimplePaginationMeta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimplePaginationMeta.ProtoReflect.Descriptor instead.
 func (*SimplePaginationMeta) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{14}
+	return file_features_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SimplePaginationMeta) GetCurrentPage() int32 {
@@ -872,7 +961,7 @@ type Feature struct {
 
 func (x *Feature) Reset() {
 	*x = Feature{}
-	mi := &file_features_proto_msgTypes[15]
+	mi := &file_features_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -884,7 +973,7 @@ func (x *Feature) String() string {
 func (*Feature) ProtoMessage() {}
 
 func (x *Feature) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[15]
+	mi := &file_features_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -897,7 +986,7 @@ func (x *Feature) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Feature.ProtoReflect.Descriptor instead.
 func (*Feature) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{15}
+	return file_features_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Feature) GetId() uint64 {
@@ -988,7 +1077,7 @@ type Seller struct {
 
 func (x *Seller) Reset() {
 	*x = Seller{}
-	mi := &file_features_proto_msgTypes[16]
+	mi := &file_features_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1000,7 +1089,7 @@ func (x *Seller) String() string {
 func (*Seller) ProtoMessage() {}
 
 func (x *Seller) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[16]
+	mi := &file_features_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1013,7 +1102,7 @@ func (x *Seller) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Seller.ProtoReflect.Descriptor instead.
 func (*Seller) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{16}
+	return file_features_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Seller) GetId() uint64 {
@@ -1060,7 +1149,7 @@ type FeatureProperties struct {
 
 func (x *FeatureProperties) Reset() {
 	*x = FeatureProperties{}
-	mi := &file_features_proto_msgTypes[17]
+	mi := &file_features_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1072,7 +1161,7 @@ func (x *FeatureProperties) String() string {
 func (*FeatureProperties) ProtoMessage() {}
 
 func (x *FeatureProperties) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[17]
+	mi := &file_features_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1085,7 +1174,7 @@ func (x *FeatureProperties) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeatureProperties.ProtoReflect.Descriptor instead.
 func (*FeatureProperties) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{17}
+	return file_features_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *FeatureProperties) GetId() string {
@@ -1205,7 +1294,7 @@ type Geometry struct {
 
 func (x *Geometry) Reset() {
 	*x = Geometry{}
-	mi := &file_features_proto_msgTypes[18]
+	mi := &file_features_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1217,7 +1306,7 @@ func (x *Geometry) String() string {
 func (*Geometry) ProtoMessage() {}
 
 func (x *Geometry) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[18]
+	mi := &file_features_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1230,7 +1319,7 @@ func (x *Geometry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Geometry.ProtoReflect.Descriptor instead.
 func (*Geometry) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{18}
+	return file_features_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Geometry) GetId() uint64 {
@@ -1273,7 +1362,7 @@ type Coordinate struct {
 
 func (x *Coordinate) Reset() {
 	*x = Coordinate{}
-	mi := &file_features_proto_msgTypes[19]
+	mi := &file_features_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1285,7 +1374,7 @@ func (x *Coordinate) String() string {
 func (*Coordinate) ProtoMessage() {}
 
 func (x *Coordinate) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[19]
+	mi := &file_features_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1298,7 +1387,7 @@ func (x *Coordinate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Coordinate.ProtoReflect.Descriptor instead.
 func (*Coordinate) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{19}
+	return file_features_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Coordinate) GetId() uint64 {
@@ -1333,13 +1422,16 @@ type Image struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	ThumbnailUrl  string                 `protobuf:"bytes,3,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"` // Empty for images added before thumbnails were generated
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`                            // Gallery order, starting at 0
+	IsCover       bool                   `protobuf:"varint,5,opt,name=is_cover,json=isCover,proto3" json:"is_cover,omitempty"`               // Exactly one image of a feature with images is the cover
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Image) Reset() {
 	*x = Image{}
-	mi := &file_features_proto_msgTypes[20]
+	mi := &file_features_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1351,7 +1443,7 @@ func (x *Image) String() string {
 func (*Image) ProtoMessage() {}
 
 func (x *Image) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[20]
+	mi := &file_features_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1364,7 +1456,7 @@ func (x *Image) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Image.ProtoReflect.Descriptor instead.
 func (*Image) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{20}
+	return file_features_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Image) GetId() uint64 {
@@ -1381,6 +1473,27 @@ func (x *Image) GetUrl() string {
 	return ""
 }
 
+func (x *Image) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *Image) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Image) GetIsCover() bool {
+	if x != nil {
+		return x.IsCover
+	}
+	return false
+}
+
 type BuyFeatureRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FeatureId     uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
@@ -1393,7 +1506,7 @@ type BuyFeatureRequest struct {
 
 func (x *BuyFeatureRequest) Reset() {
 	*x = BuyFeatureRequest{}
-	mi := &file_features_proto_msgTypes[21]
+	mi := &file_features_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1405,7 +1518,7 @@ func (x *BuyFeatureRequest) String() string {
 func (*BuyFeatureRequest) ProtoMessage() {}
 
 func (x *BuyFeatureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[21]
+	mi := &file_features_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1418,7 +1531,7 @@ func (x *BuyFeatureRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuyFeatureRequest.ProtoReflect.Descriptor instead.
 func (*BuyFeatureRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{21}
+	return file_features_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *BuyFeatureRequest) GetFeatureId() uint64 {
@@ -1460,7 +1573,7 @@ type BuyFeatureResponse struct {
 
 func (x *BuyFeatureResponse) Reset() {
 	*x = BuyFeatureResponse{}
-	mi := &file_features_proto_msgTypes[22]
+	mi := &file_features_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1472,7 +1585,7 @@ func (x *BuyFeatureResponse) String() string {
 func (*BuyFeatureResponse) ProtoMessage() {}
 
 func (x *BuyFeatureResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[22]
+	mi := &file_features_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1485,7 +1598,7 @@ func (x *BuyFeatureResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuyFeatureResponse.ProtoReflect.Descriptor instead.
 func (*BuyFeatureResponse) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{22}
+	return file_features_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *BuyFeatureResponse) GetSuccess() bool {
@@ -1509,32 +1622,30 @@ func (x *BuyFeatureResponse) GetFeature() *Feature {
 	return nil
 }
 
-type SendBuyRequestRequest struct {
+// CheckoutReservationRequest - POST and DELETE /api/features/{feature}/reservation
+type CheckoutReservationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FeatureId     uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
-	BuyerId       uint64                 `protobuf:"varint,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
-	PricePsc      string                 `protobuf:"bytes,3,opt,name=price_psc,json=pricePsc,proto3" json:"price_psc,omitempty"`
-	PriceIrr      string                 `protobuf:"bytes,4,opt,name=price_irr,json=priceIrr,proto3" json:"price_irr,omitempty"`
-	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendBuyRequestRequest) Reset() {
-	*x = SendBuyRequestRequest{}
-	mi := &file_features_proto_msgTypes[23]
+func (x *CheckoutReservationRequest) Reset() {
+	*x = CheckoutReservationRequest{}
+	mi := &file_features_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendBuyRequestRequest) String() string {
+func (x *CheckoutReservationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendBuyRequestRequest) ProtoMessage() {}
+func (*CheckoutReservationRequest) ProtoMessage() {}
 
-func (x *SendBuyRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[23]
+func (x *CheckoutReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1545,79 +1656,52 @@ func (x *SendBuyRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendBuyRequestRequest.ProtoReflect.Descriptor instead.
-func (*SendBuyRequestRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use CheckoutReservationRequest.ProtoReflect.Descriptor instead.
+func (*CheckoutReservationRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *SendBuyRequestRequest) GetFeatureId() uint64 {
+func (x *CheckoutReservationRequest) GetFeatureId() uint64 {
 	if x != nil {
 		return x.FeatureId
 	}
 	return 0
 }
 
-func (x *SendBuyRequestRequest) GetBuyerId() uint64 {
+func (x *CheckoutReservationRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.BuyerId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *SendBuyRequestRequest) GetPricePsc() string {
-	if x != nil {
-		return x.PricePsc
-	}
-	return ""
-}
-
-func (x *SendBuyRequestRequest) GetPriceIrr() string {
-	if x != nil {
-		return x.PriceIrr
-	}
-	return ""
-}
-
-func (x *SendBuyRequestRequest) GetNote() string {
-	if x != nil {
-		return x.Note
-	}
-	return ""
-}
-
-type BuyRequestResponse struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Id                   uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Buyer                *BuyerInfo             `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
-	Seller               *SellerInfo            `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
-	FeatureId            uint64                 `protobuf:"varint,4,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
-	Status               int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
-	Note                 string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
-	PricePsc             string                 `protobuf:"bytes,7,opt,name=price_psc,json=pricePsc,proto3" json:"price_psc,omitempty"`
-	PriceIrr             string                 `protobuf:"bytes,8,opt,name=price_irr,json=priceIrr,proto3" json:"price_irr,omitempty"`
-	FeatureProperties    *FeatureProperties     `protobuf:"bytes,9,opt,name=feature_properties,json=featureProperties,proto3" json:"feature_properties,omitempty"`
-	FeatureCoordinates   []*Coordinate          `protobuf:"bytes,10,rep,name=feature_coordinates,json=featureCoordinates,proto3" json:"feature_coordinates,omitempty"`
-	RequestedGracePeriod string                 `protobuf:"bytes,11,opt,name=requested_grace_period,json=requestedGracePeriod,proto3" json:"requested_grace_period,omitempty"`
-	CreatedAt            string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// CheckoutReservation is a short hold on a feature. A user holds at most one
+// feature; reserving another releases the previous one.
+type CheckoutReservation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeatureId     uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`    // Unix timestamp
+	TtlSeconds    int32                  `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // Seconds left of the hold
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuyRequestResponse) Reset() {
-	*x = BuyRequestResponse{}
-	mi := &file_features_proto_msgTypes[24]
+func (x *CheckoutReservation) Reset() {
+	*x = CheckoutReservation{}
+	mi := &file_features_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuyRequestResponse) String() string {
+func (x *CheckoutReservation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuyRequestResponse) ProtoMessage() {}
+func (*CheckoutReservation) ProtoMessage() {}
 
-func (x *BuyRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[24]
+func (x *CheckoutReservation) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1628,119 +1712,4874 @@ func (x *BuyRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuyRequestResponse.ProtoReflect.Descriptor instead.
-func (*BuyRequestResponse) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use CheckoutReservation.ProtoReflect.Descriptor instead.
+func (*CheckoutReservation) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *BuyRequestResponse) GetId() uint64 {
+func (x *CheckoutReservation) GetFeatureId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.FeatureId
 	}
 	return 0
 }
 
-func (x *BuyRequestResponse) GetBuyer() *BuyerInfo {
-	if x != nil {
-		return x.Buyer
-	}
-	return nil
-}
-
-func (x *BuyRequestResponse) GetSeller() *SellerInfo {
+func (x *CheckoutReservation) GetUserId() uint64 {
 	if x != nil {
-		return x.Seller
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-func (x *BuyRequestResponse) GetFeatureId() uint64 {
+func (x *CheckoutReservation) GetExpiresAt() int64 {
 	if x != nil {
-		return x.FeatureId
+		return x.ExpiresAt
 	}
 	return 0
 }
 
-func (x *BuyRequestResponse) GetStatus() int32 {
+func (x *CheckoutReservation) GetTtlSeconds() int32 {
 	if x != nil {
-		return x.Status
+		return x.TtlSeconds
 	}
 	return 0
 }
 
-func (x *BuyRequestResponse) GetNote() string {
-	if x != nil {
-		return x.Note
-	}
-	return ""
+// GetLimitedFeatureAvailabilityRequest - GET /api/features/limited/availability
+type GetLimitedFeatureAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FeatureId     uint64                 `protobuf:"varint,2,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"` // Optional, the campaign of this feature; all active campaigns when 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuyRequestResponse) GetPricePsc() string {
-	if x != nil {
-		return x.PricePsc
-	}
-	return ""
+func (x *GetLimitedFeatureAvailabilityRequest) Reset() {
+	*x = GetLimitedFeatureAvailabilityRequest{}
+	mi := &file_features_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLimitedFeatureAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLimitedFeatureAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetLimitedFeatureAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLimitedFeatureAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetLimitedFeatureAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetLimitedFeatureAvailabilityRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetLimitedFeatureAvailabilityRequest) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+// LimitedFeatureAvailability is the stock of one limited campaign (feature_limits row)
+type LimitedFeatureAvailability struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	LimitationId       uint64                 `protobuf:"varint,1,opt,name=limitation_id,json=limitationId,proto3" json:"limitation_id,omitempty"`
+	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Total              int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`                                                       // Features in the campaign's range
+	Sold               int32                  `protobuf:"varint,4,opt,name=sold,proto3" json:"sold,omitempty"`                                                         // Purchases recorded for the campaign
+	Held               int32                  `protobuf:"varint,5,opt,name=held,proto3" json:"held,omitempty"`                                                         // Unsold features other buyers hold for checkout
+	Remaining          int32                  `protobuf:"varint,6,opt,name=remaining,proto3" json:"remaining,omitempty"`                                               // Unsold features the user can still buy
+	IndividualBuyLimit bool                   `protobuf:"varint,7,opt,name=individual_buy_limit,json=individualBuyLimit,proto3" json:"individual_buy_limit,omitempty"` // Whether each user may buy at most individual_buy_count
+	IndividualBuyCount int32                  `protobuf:"varint,8,opt,name=individual_buy_count,json=individualBuyCount,proto3" json:"individual_buy_count,omitempty"`
+	UserPurchased      int32                  `protobuf:"varint,9,opt,name=user_purchased,json=userPurchased,proto3" json:"user_purchased,omitempty"`
+	UserRemaining      int32                  `protobuf:"varint,10,opt,name=user_remaining,json=userRemaining,proto3" json:"user_remaining,omitempty"`                 // At most remaining
+	UserHeldFeatureId  uint64                 `protobuf:"varint,11,opt,name=user_held_feature_id,json=userHeldFeatureId,proto3" json:"user_held_feature_id,omitempty"` // Campaign feature the user holds for checkout, 0 if none
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *LimitedFeatureAvailability) Reset() {
+	*x = LimitedFeatureAvailability{}
+	mi := &file_features_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LimitedFeatureAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitedFeatureAvailability) ProtoMessage() {}
+
+func (x *LimitedFeatureAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitedFeatureAvailability.ProtoReflect.Descriptor instead.
+func (*LimitedFeatureAvailability) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LimitedFeatureAvailability) GetLimitationId() uint64 {
+	if x != nil {
+		return x.LimitationId
+	}
+	return 0
+}
+
+func (x *LimitedFeatureAvailability) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LimitedFeatureAvailability) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *LimitedFeatureAvailability) GetSold() int32 {
+	if x != nil {
+		return x.Sold
+	}
+	return 0
+}
+
+func (x *LimitedFeatureAvailability) GetHeld() int32 {
+	if x != nil {
+		return x.Held
+	}
+	return 0
+}
+
+func (x *LimitedFeatureAvailability) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *LimitedFeatureAvailability) GetIndividualBuyLimit() bool {
+	if x != nil {
+		return x.IndividualBuyLimit
+	}
+	return false
+}
+
+func (x *LimitedFeatureAvailability) GetIndividualBuyCount() int32 {
+	if x != nil {
+		return x.IndividualBuyCount
+	}
+	return 0
+}
+
+func (x *LimitedFeatureAvailability) GetUserPurchased() int32 {
+	if x != nil {
+		return x.UserPurchased
+	}
+	return 0
+}
+
+func (x *LimitedFeatureAvailability) GetUserRemaining() int32 {
+	if x != nil {
+		return x.UserRemaining
+	}
+	return 0
+}
+
+func (x *LimitedFeatureAvailability) GetUserHeldFeatureId() uint64 {
+	if x != nil {
+		return x.UserHeldFeatureId
+	}
+	return 0
+}
+
+type GetLimitedFeatureAvailabilityResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Data          []*LimitedFeatureAvailability `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLimitedFeatureAvailabilityResponse) Reset() {
+	*x = GetLimitedFeatureAvailabilityResponse{}
+	mi := &file_features_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLimitedFeatureAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLimitedFeatureAvailabilityResponse) ProtoMessage() {}
+
+func (x *GetLimitedFeatureAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLimitedFeatureAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetLimitedFeatureAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetLimitedFeatureAvailabilityResponse) GetData() []*LimitedFeatureAvailability {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SendBuyRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeatureId     uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	BuyerId       uint64                 `protobuf:"varint,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	PricePsc      string                 `protobuf:"bytes,3,opt,name=price_psc,json=pricePsc,proto3" json:"price_psc,omitempty"`
+	PriceIrr      string                 `protobuf:"bytes,4,opt,name=price_irr,json=priceIrr,proto3" json:"price_irr,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendBuyRequestRequest) Reset() {
+	*x = SendBuyRequestRequest{}
+	mi := &file_features_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendBuyRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendBuyRequestRequest) ProtoMessage() {}
+
+func (x *SendBuyRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendBuyRequestRequest.ProtoReflect.Descriptor instead.
+func (*SendBuyRequestRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SendBuyRequestRequest) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+func (x *SendBuyRequestRequest) GetBuyerId() uint64 {
+	if x != nil {
+		return x.BuyerId
+	}
+	return 0
+}
+
+func (x *SendBuyRequestRequest) GetPricePsc() string {
+	if x != nil {
+		return x.PricePsc
+	}
+	return ""
+}
+
+func (x *SendBuyRequestRequest) GetPriceIrr() string {
+	if x != nil {
+		return x.PriceIrr
+	}
+	return ""
+}
+
+func (x *SendBuyRequestRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type BuyRequestResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Buyer                *BuyerInfo             `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Seller               *SellerInfo            `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	FeatureId            uint64                 `protobuf:"varint,4,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	Status               int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	Note                 string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	PricePsc             string                 `protobuf:"bytes,7,opt,name=price_psc,json=pricePsc,proto3" json:"price_psc,omitempty"`
+	PriceIrr             string                 `protobuf:"bytes,8,opt,name=price_irr,json=priceIrr,proto3" json:"price_irr,omitempty"`
+	FeatureProperties    *FeatureProperties     `protobuf:"bytes,9,opt,name=feature_properties,json=featureProperties,proto3" json:"feature_properties,omitempty"`
+	FeatureCoordinates   []*Coordinate          `protobuf:"bytes,10,rep,name=feature_coordinates,json=featureCoordinates,proto3" json:"feature_coordinates,omitempty"`
+	RequestedGracePeriod string                 `protobuf:"bytes,11,opt,name=requested_grace_period,json=requestedGracePeriod,proto3" json:"requested_grace_period,omitempty"`
+	CreatedAt            string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BuyRequestResponse) Reset() {
+	*x = BuyRequestResponse{}
+	mi := &file_features_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyRequestResponse) ProtoMessage() {}
+
+func (x *BuyRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyRequestResponse.ProtoReflect.Descriptor instead.
+func (*BuyRequestResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BuyRequestResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BuyRequestResponse) GetBuyer() *BuyerInfo {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *BuyRequestResponse) GetSeller() *SellerInfo {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *BuyRequestResponse) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+func (x *BuyRequestResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *BuyRequestResponse) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *BuyRequestResponse) GetPricePsc() string {
+	if x != nil {
+		return x.PricePsc
+	}
+	return ""
 }
 
 func (x *BuyRequestResponse) GetPriceIrr() string {
 	if x != nil {
-		return x.PriceIrr
+		return x.PriceIrr
+	}
+	return ""
+}
+
+func (x *BuyRequestResponse) GetFeatureProperties() *FeatureProperties {
+	if x != nil {
+		return x.FeatureProperties
+	}
+	return nil
+}
+
+func (x *BuyRequestResponse) GetFeatureCoordinates() []*Coordinate {
+	if x != nil {
+		return x.FeatureCoordinates
+	}
+	return nil
+}
+
+func (x *BuyRequestResponse) GetRequestedGracePeriod() string {
+	if x != nil {
+		return x.RequestedGracePeriod
+	}
+	return ""
+}
+
+func (x *BuyRequestResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type BuyerInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	ProfilePhoto  string                 `protobuf:"bytes,3,opt,name=profile_photo,json=profilePhoto,proto3" json:"profile_photo,omitempty"` // URL to latest profile photo
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyerInfo) Reset() {
+	*x = BuyerInfo{}
+	mi := &file_features_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyerInfo) ProtoMessage() {}
+
+func (x *BuyerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyerInfo.ProtoReflect.Descriptor instead.
+func (*BuyerInfo) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BuyerInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BuyerInfo) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BuyerInfo) GetProfilePhoto() string {
+	if x != nil {
+		return x.ProfilePhoto
+	}
+	return ""
+}
+
+type SellerInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SellerInfo) Reset() {
+	*x = SellerInfo{}
+	mi := &file_features_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SellerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellerInfo) ProtoMessage() {}
+
+func (x *SellerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SellerInfo.ProtoReflect.Descriptor instead.
+func (*SellerInfo) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SellerInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SellerInfo) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ListBuyRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BuyerId       uint64                 `protobuf:"varint,1,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBuyRequestsRequest) Reset() {
+	*x = ListBuyRequestsRequest{}
+	mi := &file_features_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBuyRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBuyRequestsRequest) ProtoMessage() {}
+
+func (x *ListBuyRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBuyRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListBuyRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListBuyRequestsRequest) GetBuyerId() uint64 {
+	if x != nil {
+		return x.BuyerId
+	}
+	return 0
+}
+
+type ListReceivedBuyRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SellerId      uint64                 `protobuf:"varint,1,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReceivedBuyRequestsRequest) Reset() {
+	*x = ListReceivedBuyRequestsRequest{}
+	mi := &file_features_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReceivedBuyRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReceivedBuyRequestsRequest) ProtoMessage() {}
+
+func (x *ListReceivedBuyRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReceivedBuyRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListReceivedBuyRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListReceivedBuyRequestsRequest) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+type BuyRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BuyRequests   []*BuyRequestResponse  `protobuf:"bytes,1,rep,name=buy_requests,json=buyRequests,proto3" json:"buy_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyRequestsResponse) Reset() {
+	*x = BuyRequestsResponse{}
+	mi := &file_features_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyRequestsResponse) ProtoMessage() {}
+
+func (x *BuyRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyRequestsResponse.ProtoReflect.Descriptor instead.
+func (*BuyRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BuyRequestsResponse) GetBuyRequests() []*BuyRequestResponse {
+	if x != nil {
+		return x.BuyRequests
+	}
+	return nil
+}
+
+type RejectBuyRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     uint64                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	SellerId      uint64                 `protobuf:"varint,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectBuyRequestRequest) Reset() {
+	*x = RejectBuyRequestRequest{}
+	mi := &file_features_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectBuyRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectBuyRequestRequest) ProtoMessage() {}
+
+func (x *RejectBuyRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectBuyRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectBuyRequestRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RejectBuyRequestRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *RejectBuyRequestRequest) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+type DeleteBuyRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     uint64                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	BuyerId       uint64                 `protobuf:"varint,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBuyRequestRequest) Reset() {
+	*x = DeleteBuyRequestRequest{}
+	mi := &file_features_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBuyRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBuyRequestRequest) ProtoMessage() {}
+
+func (x *DeleteBuyRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBuyRequestRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBuyRequestRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteBuyRequestRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *DeleteBuyRequestRequest) GetBuyerId() uint64 {
+	if x != nil {
+		return x.BuyerId
+	}
+	return 0
+}
+
+type UpdateGracePeriodRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RequestId       uint64                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	SellerId        uint64                 `protobuf:"varint,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	GracePeriodDays int32                  `protobuf:"varint,3,opt,name=grace_period_days,json=gracePeriodDays,proto3" json:"grace_period_days,omitempty"` // 1-30
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateGracePeriodRequest) Reset() {
+	*x = UpdateGracePeriodRequest{}
+	mi := &file_features_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGracePeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGracePeriodRequest) ProtoMessage() {}
+
+func (x *UpdateGracePeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGracePeriodRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGracePeriodRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateGracePeriodRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *UpdateGracePeriodRequest) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *UpdateGracePeriodRequest) GetGracePeriodDays() int32 {
+	if x != nil {
+		return x.GracePeriodDays
+	}
+	return 0
+}
+
+type AcceptBuyRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     uint64                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	SellerId      uint64                 `protobuf:"varint,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptBuyRequestRequest) Reset() {
+	*x = AcceptBuyRequestRequest{}
+	mi := &file_features_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptBuyRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptBuyRequestRequest) ProtoMessage() {}
+
+func (x *AcceptBuyRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptBuyRequestRequest.ProtoReflect.Descriptor instead.
+func (*AcceptBuyRequestRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AcceptBuyRequestRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *AcceptBuyRequestRequest) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+type CounterBuyRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     uint64                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	SellerId      uint64                 `protobuf:"varint,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	PricePsc      string                 `protobuf:"bytes,3,opt,name=price_psc,json=pricePsc,proto3" json:"price_psc,omitempty"`
+	PriceIrr      string                 `protobuf:"bytes,4,opt,name=price_irr,json=priceIrr,proto3" json:"price_irr,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CounterBuyRequestRequest) Reset() {
+	*x = CounterBuyRequestRequest{}
+	mi := &file_features_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CounterBuyRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterBuyRequestRequest) ProtoMessage() {}
+
+func (x *CounterBuyRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterBuyRequestRequest.ProtoReflect.Descriptor instead.
+func (*CounterBuyRequestRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CounterBuyRequestRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *CounterBuyRequestRequest) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *CounterBuyRequestRequest) GetPricePsc() string {
+	if x != nil {
+		return x.PricePsc
+	}
+	return ""
+}
+
+func (x *CounterBuyRequestRequest) GetPriceIrr() string {
+	if x != nil {
+		return x.PriceIrr
+	}
+	return ""
+}
+
+func (x *CounterBuyRequestRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AnswerCounterOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     uint64                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	BuyerId       uint64                 `protobuf:"varint,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerCounterOfferRequest) Reset() {
+	*x = AnswerCounterOfferRequest{}
+	mi := &file_features_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerCounterOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerCounterOfferRequest) ProtoMessage() {}
+
+func (x *AnswerCounterOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerCounterOfferRequest.ProtoReflect.Descriptor instead.
+func (*AnswerCounterOfferRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AnswerCounterOfferRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *AnswerCounterOfferRequest) GetBuyerId() uint64 {
+	if x != nil {
+		return x.BuyerId
+	}
+	return 0
+}
+
+type GetNegotiationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     uint64                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Buyer or seller of the request
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNegotiationRequest) Reset() {
+	*x = GetNegotiationRequest{}
+	mi := &file_features_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNegotiationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNegotiationRequest) ProtoMessage() {}
+
+func (x *GetNegotiationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNegotiationRequest.ProtoReflect.Descriptor instead.
+func (*GetNegotiationRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetNegotiationRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *GetNegotiationRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type BuyRequestOffer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Round         int32                  `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"` // 0 is the buyer's opening offer
+	OfferedBy     uint64                 `protobuf:"varint,3,opt,name=offered_by,json=offeredBy,proto3" json:"offered_by,omitempty"`
+	PricePsc      string                 `protobuf:"bytes,4,opt,name=price_psc,json=pricePsc,proto3" json:"price_psc,omitempty"`
+	PriceIrr      string                 `protobuf:"bytes,5,opt,name=price_irr,json=priceIrr,proto3" json:"price_irr,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // open, accepted, declined, countered
+	RespondedAt   string                 `protobuf:"bytes,8,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyRequestOffer) Reset() {
+	*x = BuyRequestOffer{}
+	mi := &file_features_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyRequestOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyRequestOffer) ProtoMessage() {}
+
+func (x *BuyRequestOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyRequestOffer.ProtoReflect.Descriptor instead.
+func (*BuyRequestOffer) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *BuyRequestOffer) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BuyRequestOffer) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *BuyRequestOffer) GetOfferedBy() uint64 {
+	if x != nil {
+		return x.OfferedBy
+	}
+	return 0
+}
+
+func (x *BuyRequestOffer) GetPricePsc() string {
+	if x != nil {
+		return x.PricePsc
+	}
+	return ""
+}
+
+func (x *BuyRequestOffer) GetPriceIrr() string {
+	if x != nil {
+		return x.PriceIrr
+	}
+	return ""
+}
+
+func (x *BuyRequestOffer) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *BuyRequestOffer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BuyRequestOffer) GetRespondedAt() string {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return ""
+}
+
+func (x *BuyRequestOffer) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type NegotiationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BuyRequest    *BuyRequestResponse    `protobuf:"bytes,1,opt,name=buy_request,json=buyRequest,proto3" json:"buy_request,omitempty"`
+	Offers        []*BuyRequestOffer     `protobuf:"bytes,2,rep,name=offers,proto3" json:"offers,omitempty"`
+	RoundsUsed    int32                  `protobuf:"varint,3,opt,name=rounds_used,json=roundsUsed,proto3" json:"rounds_used,omitempty"`
+	MaxRounds     int32                  `protobuf:"varint,4,opt,name=max_rounds,json=maxRounds,proto3" json:"max_rounds,omitempty"`
+	OpenOffer     *BuyRequestOffer       `protobuf:"bytes,5,opt,name=open_offer,json=openOffer,proto3" json:"open_offer,omitempty"` // Unset when no counter-offer awaits the buyer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NegotiationResponse) Reset() {
+	*x = NegotiationResponse{}
+	mi := &file_features_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NegotiationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NegotiationResponse) ProtoMessage() {}
+
+func (x *NegotiationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NegotiationResponse.ProtoReflect.Descriptor instead.
+func (*NegotiationResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *NegotiationResponse) GetBuyRequest() *BuyRequestResponse {
+	if x != nil {
+		return x.BuyRequest
+	}
+	return nil
+}
+
+func (x *NegotiationResponse) GetOffers() []*BuyRequestOffer {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+func (x *NegotiationResponse) GetRoundsUsed() int32 {
+	if x != nil {
+		return x.RoundsUsed
+	}
+	return 0
+}
+
+func (x *NegotiationResponse) GetMaxRounds() int32 {
+	if x != nil {
+		return x.MaxRounds
+	}
+	return 0
+}
+
+func (x *NegotiationResponse) GetOpenOffer() *BuyRequestOffer {
+	if x != nil {
+		return x.OpenOffer
+	}
+	return nil
+}
+
+type CreateSellRequestRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	FeatureId              uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	SellerId               uint64                 `protobuf:"varint,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	PricePsc               string                 `protobuf:"bytes,3,opt,name=price_psc,json=pricePsc,proto3" json:"price_psc,omitempty"`                                              // Optional, mutually exclusive with minimum_price_percentage
+	PriceIrr               string                 `protobuf:"bytes,4,opt,name=price_irr,json=priceIrr,proto3" json:"price_irr,omitempty"`                                              // Optional, mutually exclusive with minimum_price_percentage
+	MinimumPricePercentage int32                  `protobuf:"varint,5,opt,name=minimum_price_percentage,json=minimumPricePercentage,proto3" json:"minimum_price_percentage,omitempty"` // Optional, mutually exclusive with price_psc/price_irr, min:80 (min:110 if under 18)
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateSellRequestRequest) Reset() {
+	*x = CreateSellRequestRequest{}
+	mi := &file_features_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSellRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSellRequestRequest) ProtoMessage() {}
+
+func (x *CreateSellRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSellRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreateSellRequestRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateSellRequestRequest) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+func (x *CreateSellRequestRequest) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *CreateSellRequestRequest) GetPricePsc() string {
+	if x != nil {
+		return x.PricePsc
+	}
+	return ""
+}
+
+func (x *CreateSellRequestRequest) GetPriceIrr() string {
+	if x != nil {
+		return x.PriceIrr
+	}
+	return ""
+}
+
+func (x *CreateSellRequestRequest) GetMinimumPricePercentage() int32 {
+	if x != nil {
+		return x.MinimumPricePercentage
+	}
+	return 0
+}
+
+type ListSellRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SellerId      uint64                 `protobuf:"varint,1,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"` // Required - authenticated seller
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSellRequestsRequest) Reset() {
+	*x = ListSellRequestsRequest{}
+	mi := &file_features_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSellRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSellRequestsRequest) ProtoMessage() {}
+
+func (x *ListSellRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSellRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListSellRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListSellRequestsRequest) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+type DeleteSellRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SellRequestId uint64                 `protobuf:"varint,1,opt,name=sell_request_id,json=sellRequestId,proto3" json:"sell_request_id,omitempty"` // Required
+	SellerId      uint64                 `protobuf:"varint,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`                  // Required - for authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSellRequestRequest) Reset() {
+	*x = DeleteSellRequestRequest{}
+	mi := &file_features_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSellRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSellRequestRequest) ProtoMessage() {}
+
+func (x *DeleteSellRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSellRequestRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSellRequestRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteSellRequestRequest) GetSellRequestId() uint64 {
+	if x != nil {
+		return x.SellRequestId
+	}
+	return 0
+}
+
+func (x *DeleteSellRequestRequest) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+type SellRequestResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SellerId           uint64                 `protobuf:"varint,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	FeatureId          uint64                 `protobuf:"varint,3,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	PricePsc           string                 `protobuf:"bytes,4,opt,name=price_psc,json=pricePsc,proto3" json:"price_psc,omitempty"`
+	PriceIrr           string                 `protobuf:"bytes,5,opt,name=price_irr,json=priceIrr,proto3" json:"price_irr,omitempty"`
+	Status             int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"` // 0 = open, 1 = closed
+	CreatedAt          string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FeatureProperties  *FeatureProperties     `protobuf:"bytes,8,opt,name=feature_properties,json=featureProperties,proto3" json:"feature_properties,omitempty"`    // Eager loaded feature properties
+	FeatureCoordinates []*Coordinate          `protobuf:"bytes,9,rep,name=feature_coordinates,json=featureCoordinates,proto3" json:"feature_coordinates,omitempty"` // Eager loaded coordinates
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SellRequestResponse) Reset() {
+	*x = SellRequestResponse{}
+	mi := &file_features_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SellRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellRequestResponse) ProtoMessage() {}
+
+func (x *SellRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SellRequestResponse.ProtoReflect.Descriptor instead.
+func (*SellRequestResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SellRequestResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SellRequestResponse) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *SellRequestResponse) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+func (x *SellRequestResponse) GetPricePsc() string {
+	if x != nil {
+		return x.PricePsc
+	}
+	return ""
+}
+
+func (x *SellRequestResponse) GetPriceIrr() string {
+	if x != nil {
+		return x.PriceIrr
+	}
+	return ""
+}
+
+func (x *SellRequestResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SellRequestResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SellRequestResponse) GetFeatureProperties() *FeatureProperties {
+	if x != nil {
+		return x.FeatureProperties
+	}
+	return nil
+}
+
+func (x *SellRequestResponse) GetFeatureCoordinates() []*Coordinate {
+	if x != nil {
+		return x.FeatureCoordinates
+	}
+	return nil
+}
+
+type SellRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SellRequests  []*SellRequestResponse `protobuf:"bytes,1,rep,name=sell_requests,json=sellRequests,proto3" json:"sell_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SellRequestsResponse) Reset() {
+	*x = SellRequestsResponse{}
+	mi := &file_features_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SellRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellRequestsResponse) ProtoMessage() {}
+
+func (x *SellRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SellRequestsResponse.ProtoReflect.Descriptor instead.
+func (*SellRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SellRequestsResponse) GetSellRequests() []*SellRequestResponse {
+	if x != nil {
+		return x.SellRequests
+	}
+	return nil
+}
+
+// ListForSaleFeaturesRequest - GET /api/marketplace/listings
+// Lists features with an open sell request that are not reserved for an
+// installment buyer. Does not require authentication.
+type ListForSaleFeaturesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        int32                  `protobuf:"varint,1,opt,name=region,proto3" json:"region,omitempty"`                    // Optional, feature_properties.region
+	Karbari       string                 `protobuf:"bytes,2,opt,name=karbari,proto3" json:"karbari,omitempty"`                   // Optional, e.g. "m", "t" or "a"
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`                       // Optional alternative to karbari: "yellow", "red" or "blue"
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`                 // "irr" (default) or "psc", used by the price range and price sorts
+	MinPrice      string                 `protobuf:"bytes,5,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"` // Optional, inclusive
+	MaxPrice      string                 `protobuf:"bytes,6,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"` // Optional, inclusive
+	Sort          string                 `protobuf:"bytes,7,opt,name=sort,proto3" json:"sort,omitempty"`                         // "newest" (default), "price_asc" or "price_desc"
+	Page          int32                  `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`                        // Default 1
+	PerPage       int32                  `protobuf:"varint,9,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`   // Default 20, at most 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListForSaleFeaturesRequest) Reset() {
+	*x = ListForSaleFeaturesRequest{}
+	mi := &file_features_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListForSaleFeaturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListForSaleFeaturesRequest) ProtoMessage() {}
+
+func (x *ListForSaleFeaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListForSaleFeaturesRequest.ProtoReflect.Descriptor instead.
+func (*ListForSaleFeaturesRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListForSaleFeaturesRequest) GetRegion() int32 {
+	if x != nil {
+		return x.Region
+	}
+	return 0
+}
+
+func (x *ListForSaleFeaturesRequest) GetKarbari() string {
+	if x != nil {
+		return x.Karbari
+	}
+	return ""
+}
+
+func (x *ListForSaleFeaturesRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *ListForSaleFeaturesRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListForSaleFeaturesRequest) GetMinPrice() string {
+	if x != nil {
+		return x.MinPrice
+	}
+	return ""
+}
+
+func (x *ListForSaleFeaturesRequest) GetMaxPrice() string {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return ""
+}
+
+func (x *ListForSaleFeaturesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListForSaleFeaturesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListForSaleFeaturesRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type MarketplaceListing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SellRequestId uint64                 `protobuf:"varint,1,opt,name=sell_request_id,json=sellRequestId,proto3" json:"sell_request_id,omitempty"`
+	FeatureId     uint64                 `protobuf:"varint,2,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	PropertiesId  string                 `protobuf:"bytes,3,opt,name=properties_id,json=propertiesId,proto3" json:"properties_id,omitempty"` // feature_properties.id, e.g. "HM-2000001"
+	Karbari       string                 `protobuf:"bytes,4,opt,name=karbari,proto3" json:"karbari,omitempty"`
+	Region        int32                  `protobuf:"varint,5,opt,name=region,proto3" json:"region,omitempty"`
+	Area          int64                  `protobuf:"varint,6,opt,name=area,proto3" json:"area,omitempty"`
+	Address       string                 `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	SellerId      uint64                 `protobuf:"varint,8,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	PricePsc      string                 `protobuf:"bytes,9,opt,name=price_psc,json=pricePsc,proto3" json:"price_psc,omitempty"`
+	PriceIrr      string                 `protobuf:"bytes,10,opt,name=price_irr,json=priceIrr,proto3" json:"price_irr,omitempty"`
+	Date          string                 `protobuf:"bytes,11,opt,name=date,proto3" json:"date,omitempty"` // Jalali date the sell request was created
+	Time          string                 `protobuf:"bytes,12,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketplaceListing) Reset() {
+	*x = MarketplaceListing{}
+	mi := &file_features_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketplaceListing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketplaceListing) ProtoMessage() {}
+
+func (x *MarketplaceListing) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketplaceListing.ProtoReflect.Descriptor instead.
+func (*MarketplaceListing) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MarketplaceListing) GetSellRequestId() uint64 {
+	if x != nil {
+		return x.SellRequestId
+	}
+	return 0
+}
+
+func (x *MarketplaceListing) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+func (x *MarketplaceListing) GetPropertiesId() string {
+	if x != nil {
+		return x.PropertiesId
+	}
+	return ""
+}
+
+func (x *MarketplaceListing) GetKarbari() string {
+	if x != nil {
+		return x.Karbari
+	}
+	return ""
+}
+
+func (x *MarketplaceListing) GetRegion() int32 {
+	if x != nil {
+		return x.Region
+	}
+	return 0
+}
+
+func (x *MarketplaceListing) GetArea() int64 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *MarketplaceListing) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *MarketplaceListing) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *MarketplaceListing) GetPricePsc() string {
+	if x != nil {
+		return x.PricePsc
+	}
+	return ""
+}
+
+func (x *MarketplaceListing) GetPriceIrr() string {
+	if x != nil {
+		return x.PriceIrr
+	}
+	return ""
+}
+
+func (x *MarketplaceListing) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *MarketplaceListing) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type ListForSaleFeaturesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*MarketplaceListing  `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Links         *PaginationLinks       `protobuf:"bytes,2,opt,name=links,proto3" json:"links,omitempty"`
+	Meta          *SimplePaginationMeta  `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListForSaleFeaturesResponse) Reset() {
+	*x = ListForSaleFeaturesResponse{}
+	mi := &file_features_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListForSaleFeaturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListForSaleFeaturesResponse) ProtoMessage() {}
+
+func (x *ListForSaleFeaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListForSaleFeaturesResponse.ProtoReflect.Descriptor instead.
+func (*ListForSaleFeaturesResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListForSaleFeaturesResponse) GetData() []*MarketplaceListing {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListForSaleFeaturesResponse) GetLinks() *PaginationLinks {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *ListForSaleFeaturesResponse) GetMeta() *SimplePaginationMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type RequestGracePeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     uint64                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	BuyerId       uint64                 `protobuf:"varint,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	GracePeriod   string                 `protobuf:"bytes,3,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"` // Deprecated - use UpdateGracePeriod instead
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestGracePeriodRequest) Reset() {
+	*x = RequestGracePeriodRequest{}
+	mi := &file_features_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestGracePeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestGracePeriodRequest) ProtoMessage() {}
+
+func (x *RequestGracePeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestGracePeriodRequest.ProtoReflect.Descriptor instead.
+func (*RequestGracePeriodRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RequestGracePeriodRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *RequestGracePeriodRequest) GetBuyerId() uint64 {
+	if x != nil {
+		return x.BuyerId
+	}
+	return 0
+}
+
+func (x *RequestGracePeriodRequest) GetGracePeriod() string {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return ""
+}
+
+type GracePeriodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approved      bool                   `protobuf:"varint,1,opt,name=approved,proto3" json:"approved,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GracePeriodResponse) Reset() {
+	*x = GracePeriodResponse{}
+	mi := &file_features_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GracePeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GracePeriodResponse) ProtoMessage() {}
+
+func (x *GracePeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GracePeriodResponse.ProtoReflect.Descriptor instead.
+func (*GracePeriodResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GracePeriodResponse) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *GracePeriodResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetHourlyProfitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHourlyProfitsRequest) Reset() {
+	*x = GetHourlyProfitsRequest{}
+	mi := &file_features_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHourlyProfitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHourlyProfitsRequest) ProtoMessage() {}
+
+func (x *GetHourlyProfitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHourlyProfitsRequest.ProtoReflect.Descriptor instead.
+func (*GetHourlyProfitsRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetHourlyProfitsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetHourlyProfitsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetHourlyProfitsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type HourlyProfitsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Profits             []*HourlyProfit        `protobuf:"bytes,1,rep,name=profits,proto3" json:"profits,omitempty"`
+	TotalMaskoniProfit  string                 `protobuf:"bytes,2,opt,name=total_maskoni_profit,json=totalMaskoniProfit,proto3" json:"total_maskoni_profit,omitempty"`
+	TotalTejariProfit   string                 `protobuf:"bytes,3,opt,name=total_tejari_profit,json=totalTejariProfit,proto3" json:"total_tejari_profit,omitempty"`
+	TotalAmozeshiProfit string                 `protobuf:"bytes,4,opt,name=total_amozeshi_profit,json=totalAmozeshiProfit,proto3" json:"total_amozeshi_profit,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *HourlyProfitsResponse) Reset() {
+	*x = HourlyProfitsResponse{}
+	mi := &file_features_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HourlyProfitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HourlyProfitsResponse) ProtoMessage() {}
+
+func (x *HourlyProfitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HourlyProfitsResponse.ProtoReflect.Descriptor instead.
+func (*HourlyProfitsResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *HourlyProfitsResponse) GetProfits() []*HourlyProfit {
+	if x != nil {
+		return x.Profits
+	}
+	return nil
+}
+
+func (x *HourlyProfitsResponse) GetTotalMaskoniProfit() string {
+	if x != nil {
+		return x.TotalMaskoniProfit
+	}
+	return ""
+}
+
+func (x *HourlyProfitsResponse) GetTotalTejariProfit() string {
+	if x != nil {
+		return x.TotalTejariProfit
+	}
+	return ""
+}
+
+func (x *HourlyProfitsResponse) GetTotalAmozeshiProfit() string {
+	if x != nil {
+		return x.TotalAmozeshiProfit
+	}
+	return ""
+}
+
+type HourlyProfit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FeatureId     uint64                 `protobuf:"varint,2,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Asset         string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`                       // color: yellow, red, blue
+	Amount        string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`                     // formatted as string
+	DeadLine      string                 `protobuf:"bytes,6,opt,name=dead_line,json=deadLine,proto3" json:"dead_line,omitempty"` // timestamp
+	IsActive      bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HourlyProfit) Reset() {
+	*x = HourlyProfit{}
+	mi := &file_features_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HourlyProfit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HourlyProfit) ProtoMessage() {}
+
+func (x *HourlyProfit) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HourlyProfit.ProtoReflect.Descriptor instead.
+func (*HourlyProfit) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *HourlyProfit) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *HourlyProfit) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+func (x *HourlyProfit) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *HourlyProfit) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *HourlyProfit) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *HourlyProfit) GetDeadLine() string {
+	if x != nil {
+		return x.DeadLine
+	}
+	return ""
+}
+
+func (x *HourlyProfit) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type GetSingleProfitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfitId      uint64                 `protobuf:"varint,1,opt,name=profit_id,json=profitId,proto3" json:"profit_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSingleProfitRequest) Reset() {
+	*x = GetSingleProfitRequest{}
+	mi := &file_features_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSingleProfitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSingleProfitRequest) ProtoMessage() {}
+
+func (x *GetSingleProfitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSingleProfitRequest.ProtoReflect.Descriptor instead.
+func (*GetSingleProfitRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetSingleProfitRequest) GetProfitId() uint64 {
+	if x != nil {
+		return x.ProfitId
+	}
+	return 0
+}
+
+func (x *GetSingleProfitRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type HourlyProfitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profit        *HourlyProfit          `protobuf:"bytes,1,opt,name=profit,proto3" json:"profit,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HourlyProfitResponse) Reset() {
+	*x = HourlyProfitResponse{}
+	mi := &file_features_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HourlyProfitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HourlyProfitResponse) ProtoMessage() {}
+
+func (x *HourlyProfitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HourlyProfitResponse.ProtoReflect.Descriptor instead.
+func (*HourlyProfitResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *HourlyProfitResponse) GetProfit() *HourlyProfit {
+	if x != nil {
+		return x.Profit
+	}
+	return nil
+}
+
+func (x *HourlyProfitResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetProfitsByApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Karbari       string                 `protobuf:"bytes,2,opt,name=karbari,proto3" json:"karbari,omitempty"` // m, t, a
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfitsByApplicationRequest) Reset() {
+	*x = GetProfitsByApplicationRequest{}
+	mi := &file_features_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfitsByApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfitsByApplicationRequest) ProtoMessage() {}
+
+func (x *GetProfitsByApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfitsByApplicationRequest.ProtoReflect.Descriptor instead.
+func (*GetProfitsByApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetProfitsByApplicationRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetProfitsByApplicationRequest) GetKarbari() string {
+	if x != nil {
+		return x.Karbari
+	}
+	return ""
+}
+
+type ProfitsByApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalAmount   string                 `protobuf:"bytes,1,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfitsByApplicationResponse) Reset() {
+	*x = ProfitsByApplicationResponse{}
+	mi := &file_features_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfitsByApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfitsByApplicationResponse) ProtoMessage() {}
+
+func (x *ProfitsByApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfitsByApplicationResponse.ProtoReflect.Descriptor instead.
+func (*ProfitsByApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ProfitsByApplicationResponse) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *ProfitsByApplicationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetFeatureProfitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FeatureId     uint64                 `protobuf:"varint,2,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeatureProfitRequest) Reset() {
+	*x = GetFeatureProfitRequest{}
+	mi := &file_features_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeatureProfitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeatureProfitRequest) ProtoMessage() {}
+
+func (x *GetFeatureProfitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeatureProfitRequest.ProtoReflect.Descriptor instead.
+func (*GetFeatureProfitRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetFeatureProfitRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetFeatureProfitRequest) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+type FeatureProfitResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Profit            *HourlyProfit          `protobuf:"bytes,1,opt,name=profit,proto3" json:"profit,omitempty"`
+	HourlyRate        string                 `protobuf:"bytes,2,opt,name=hourly_rate,json=hourlyRate,proto3" json:"hourly_rate,omitempty"`                         // Current accrual per hour in the profit's asset
+	Accruing          bool                   `protobuf:"varint,3,opt,name=accruing,proto3" json:"accruing,omitempty"`                                              // False once dead_line passed or while a building is under way
+	SecondsToDeadline int64                  `protobuf:"varint,4,opt,name=seconds_to_deadline,json=secondsToDeadline,proto3" json:"seconds_to_deadline,omitempty"` // 0 once dead_line passed
+	Compound          bool                   `protobuf:"varint,5,opt,name=compound,proto3" json:"compound,omitempty"`                                              // The accrued amount earns profit as well
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *FeatureProfitResponse) Reset() {
+	*x = FeatureProfitResponse{}
+	mi := &file_features_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeatureProfitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureProfitResponse) ProtoMessage() {}
+
+func (x *FeatureProfitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureProfitResponse.ProtoReflect.Descriptor instead.
+func (*FeatureProfitResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *FeatureProfitResponse) GetProfit() *HourlyProfit {
+	if x != nil {
+		return x.Profit
+	}
+	return nil
+}
+
+func (x *FeatureProfitResponse) GetHourlyRate() string {
+	if x != nil {
+		return x.HourlyRate
+	}
+	return ""
+}
+
+func (x *FeatureProfitResponse) GetAccruing() bool {
+	if x != nil {
+		return x.Accruing
+	}
+	return false
+}
+
+func (x *FeatureProfitResponse) GetSecondsToDeadline() int64 {
+	if x != nil {
+		return x.SecondsToDeadline
+	}
+	return 0
+}
+
+func (x *FeatureProfitResponse) GetCompound() bool {
+	if x != nil {
+		return x.Compound
+	}
+	return false
+}
+
+type GetProfitSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfitSettingsRequest) Reset() {
+	*x = GetProfitSettingsRequest{}
+	mi := &file_features_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfitSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfitSettingsRequest) ProtoMessage() {}
+
+func (x *GetProfitSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfitSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetProfitSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetProfitSettingsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UpdateProfitSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AutoClaim     bool                   `protobuf:"varint,2,opt,name=auto_claim,json=autoClaim,proto3" json:"auto_claim,omitempty"`
+	Compound      bool                   `protobuf:"varint,3,opt,name=compound,proto3" json:"compound,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfitSettingsRequest) Reset() {
+	*x = UpdateProfitSettingsRequest{}
+	mi := &file_features_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfitSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfitSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateProfitSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfitSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfitSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpdateProfitSettingsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateProfitSettingsRequest) GetAutoClaim() bool {
+	if x != nil {
+		return x.AutoClaim
+	}
+	return false
+}
+
+func (x *UpdateProfitSettingsRequest) GetCompound() bool {
+	if x != nil {
+		return x.Compound
+	}
+	return false
+}
+
+type ProfitSettingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Profits are claimed to the wallet before their dead_line so they keep accruing
+	AutoClaim bool `protobuf:"varint,1,opt,name=auto_claim,json=autoClaim,proto3" json:"auto_claim,omitempty"`
+	// Unclaimed profit earns at the same rate as the feature's stability
+	Compound           bool  `protobuf:"varint,2,opt,name=compound,proto3" json:"compound,omitempty"`
+	WithdrawProfitDays int32 `protobuf:"varint,3,opt,name=withdraw_profit_days,json=withdrawProfitDays,proto3" json:"withdraw_profit_days,omitempty"` // Length of the claim window after each claim
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ProfitSettingsResponse) Reset() {
+	*x = ProfitSettingsResponse{}
+	mi := &file_features_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfitSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfitSettingsResponse) ProtoMessage() {}
+
+func (x *ProfitSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfitSettingsResponse.ProtoReflect.Descriptor instead.
+func (*ProfitSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ProfitSettingsResponse) GetAutoClaim() bool {
+	if x != nil {
+		return x.AutoClaim
+	}
+	return false
+}
+
+func (x *ProfitSettingsResponse) GetCompound() bool {
+	if x != nil {
+		return x.Compound
+	}
+	return false
+}
+
+func (x *ProfitSettingsResponse) GetWithdrawProfitDays() int32 {
+	if x != nil {
+		return x.WithdrawProfitDays
+	}
+	return 0
+}
+
+type GetBuildPackageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeatureId     uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBuildPackageRequest) Reset() {
+	*x = GetBuildPackageRequest{}
+	mi := &file_features_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBuildPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuildPackageRequest) ProtoMessage() {}
+
+func (x *GetBuildPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBuildPackageRequest.ProtoReflect.Descriptor instead.
+func (*GetBuildPackageRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetBuildPackageRequest) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+func (x *GetBuildPackageRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type BuildPackageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Models        []*BuildingModel       `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
+	Coordinates   []string               `protobuf:"bytes,2,rep,name=coordinates,proto3" json:"coordinates,omitempty"`
+	CurrentPage   int32                  `protobuf:"varint,3,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	LastPage      int32                  `protobuf:"varint,4,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
+	HasMorePages  bool                   `protobuf:"varint,5,opt,name=has_more_pages,json=hasMorePages,proto3" json:"has_more_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildPackageResponse) Reset() {
+	*x = BuildPackageResponse{}
+	mi := &file_features_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildPackageResponse) ProtoMessage() {}
+
+func (x *BuildPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildPackageResponse.ProtoReflect.Descriptor instead.
+func (*BuildPackageResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *BuildPackageResponse) GetModels() []*BuildingModel {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+func (x *BuildPackageResponse) GetCoordinates() []string {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *BuildPackageResponse) GetCurrentPage() int32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *BuildPackageResponse) GetLastPage() int32 {
+	if x != nil {
+		return x.LastPage
+	}
+	return 0
+}
+
+func (x *BuildPackageResponse) GetHasMorePages() bool {
+	if x != nil {
+		return x.HasMorePages
+	}
+	return false
+}
+
+type BuildPackageChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Models        []*BuildingModel       `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
+	Coordinates   []string               `protobuf:"bytes,2,rep,name=coordinates,proto3" json:"coordinates,omitempty"` // Sent with the first chunk only
+	CurrentPage   int32                  `protobuf:"varint,3,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	LastPage      int32                  `protobuf:"varint,4,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
+	HasMorePages  bool                   `protobuf:"varint,5,opt,name=has_more_pages,json=hasMorePages,proto3" json:"has_more_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildPackageChunk) Reset() {
+	*x = BuildPackageChunk{}
+	mi := &file_features_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildPackageChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildPackageChunk) ProtoMessage() {}
+
+func (x *BuildPackageChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildPackageChunk.ProtoReflect.Descriptor instead.
+func (*BuildPackageChunk) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *BuildPackageChunk) GetModels() []*BuildingModel {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+func (x *BuildPackageChunk) GetCoordinates() []string {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *BuildPackageChunk) GetCurrentPage() int32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *BuildPackageChunk) GetLastPage() int32 {
+	if x != nil {
+		return x.LastPage
+	}
+	return 0
+}
+
+func (x *BuildPackageChunk) GetHasMorePages() bool {
+	if x != nil {
+		return x.HasMorePages
+	}
+	return false
+}
+
+type BuildingModel struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ModelId              string                 `protobuf:"bytes,2,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Name                 string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Sku                  string                 `protobuf:"bytes,4,opt,name=sku,proto3" json:"sku,omitempty"`
+	Images               string                 `protobuf:"bytes,5,opt,name=images,proto3" json:"images,omitempty"`         // JSON array; inline data: URIs are replaced with storage URLs
+	Attributes           string                 `protobuf:"bytes,6,opt,name=attributes,proto3" json:"attributes,omitempty"` // JSON array
+	File                 string                 `protobuf:"bytes,7,opt,name=file,proto3" json:"file,omitempty"`             // JSON object; inline data: URIs are replaced with storage URLs
+	RequiredSatisfaction string                 `protobuf:"bytes,8,opt,name=required_satisfaction,json=requiredSatisfaction,proto3" json:"required_satisfaction,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BuildingModel) Reset() {
+	*x = BuildingModel{}
+	mi := &file_features_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildingModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildingModel) ProtoMessage() {}
+
+func (x *BuildingModel) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildingModel.ProtoReflect.Descriptor instead.
+func (*BuildingModel) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *BuildingModel) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BuildingModel) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *BuildingModel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BuildingModel) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *BuildingModel) GetImages() string {
+	if x != nil {
+		return x.Images
+	}
+	return ""
+}
+
+func (x *BuildingModel) GetAttributes() string {
+	if x != nil {
+		return x.Attributes
+	}
+	return ""
+}
+
+func (x *BuildingModel) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *BuildingModel) GetRequiredSatisfaction() string {
+	if x != nil {
+		return x.RequiredSatisfaction
+	}
+	return ""
+}
+
+type BuildFeatureRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	FeatureId            uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	BuildingModelId      uint64                 `protobuf:"varint,2,opt,name=building_model_id,json=buildingModelId,proto3" json:"building_model_id,omitempty"`
+	LaunchedSatisfaction string                 `protobuf:"bytes,3,opt,name=launched_satisfaction,json=launchedSatisfaction,proto3" json:"launched_satisfaction,omitempty"`
+	Rotation             string                 `protobuf:"bytes,4,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	Position             string                 `protobuf:"bytes,5,opt,name=position,proto3" json:"position,omitempty"`
+	Information          *BuildingInformation   `protobuf:"bytes,6,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BuildFeatureRequest) Reset() {
+	*x = BuildFeatureRequest{}
+	mi := &file_features_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildFeatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildFeatureRequest) ProtoMessage() {}
+
+func (x *BuildFeatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildFeatureRequest.ProtoReflect.Descriptor instead.
+func (*BuildFeatureRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *BuildFeatureRequest) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+func (x *BuildFeatureRequest) GetBuildingModelId() uint64 {
+	if x != nil {
+		return x.BuildingModelId
+	}
+	return 0
+}
+
+func (x *BuildFeatureRequest) GetLaunchedSatisfaction() string {
+	if x != nil {
+		return x.LaunchedSatisfaction
+	}
+	return ""
+}
+
+func (x *BuildFeatureRequest) GetRotation() string {
+	if x != nil {
+		return x.Rotation
+	}
+	return ""
+}
+
+func (x *BuildFeatureRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *BuildFeatureRequest) GetInformation() *BuildingInformation {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+type BuildingInformation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityLine  string                 `protobuf:"bytes,1,opt,name=activity_line,json=activityLine,proto3" json:"activity_line,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,4,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Website       string                 `protobuf:"bytes,5,opt,name=website,proto3" json:"website,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildingInformation) Reset() {
+	*x = BuildingInformation{}
+	mi := &file_features_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildingInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildingInformation) ProtoMessage() {}
+
+func (x *BuildingInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildingInformation.ProtoReflect.Descriptor instead.
+func (*BuildingInformation) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *BuildingInformation) GetActivityLine() string {
+	if x != nil {
+		return x.ActivityLine
+	}
+	return ""
+}
+
+func (x *BuildingInformation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BuildingInformation) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *BuildingInformation) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *BuildingInformation) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *BuildingInformation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type BuildFeatureResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildFeatureResponse) Reset() {
+	*x = BuildFeatureResponse{}
+	mi := &file_features_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildFeatureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildFeatureResponse) ProtoMessage() {}
+
+func (x *BuildFeatureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildFeatureResponse.ProtoReflect.Descriptor instead.
+func (*BuildFeatureResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *BuildFeatureResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BuildFeatureResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetBuildingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeatureId     uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBuildingsRequest) Reset() {
+	*x = GetBuildingsRequest{}
+	mi := &file_features_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBuildingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuildingsRequest) ProtoMessage() {}
+
+func (x *GetBuildingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBuildingsRequest.ProtoReflect.Descriptor instead.
+func (*GetBuildingsRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetBuildingsRequest) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+type BuildingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Buildings     []*Building            `protobuf:"bytes,1,rep,name=buildings,proto3" json:"buildings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildingsResponse) Reset() {
+	*x = BuildingsResponse{}
+	mi := &file_features_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildingsResponse) ProtoMessage() {}
+
+func (x *BuildingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildingsResponse.ProtoReflect.Descriptor instead.
+func (*BuildingsResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *BuildingsResponse) GetBuildings() []*Building {
+	if x != nil {
+		return x.Buildings
+	}
+	return nil
+}
+
+type Building struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Model                 *BuildingModel         `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	ConstructionStartDate string                 `protobuf:"bytes,3,opt,name=construction_start_date,json=constructionStartDate,proto3" json:"construction_start_date,omitempty"`
+	ConstructionEndDate   string                 `protobuf:"bytes,4,opt,name=construction_end_date,json=constructionEndDate,proto3" json:"construction_end_date,omitempty"`
+	LaunchedSatisfaction  string                 `protobuf:"bytes,5,opt,name=launched_satisfaction,json=launchedSatisfaction,proto3" json:"launched_satisfaction,omitempty"`
+	Rotation              string                 `protobuf:"bytes,6,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	Position              string                 `protobuf:"bytes,7,opt,name=position,proto3" json:"position,omitempty"`
+	BubbleDiameter        string                 `protobuf:"bytes,8,opt,name=bubble_diameter,json=bubbleDiameter,proto3" json:"bubble_diameter,omitempty"`
+	Information           string                 `protobuf:"bytes,9,opt,name=information,proto3" json:"information,omitempty"` // JSON
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Building) Reset() {
+	*x = Building{}
+	mi := &file_features_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Building) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Building) ProtoMessage() {}
+
+func (x *Building) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Building.ProtoReflect.Descriptor instead.
+func (*Building) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Building) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Building) GetModel() *BuildingModel {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+func (x *Building) GetConstructionStartDate() string {
+	if x != nil {
+		return x.ConstructionStartDate
+	}
+	return ""
+}
+
+func (x *Building) GetConstructionEndDate() string {
+	if x != nil {
+		return x.ConstructionEndDate
+	}
+	return ""
+}
+
+func (x *Building) GetLaunchedSatisfaction() string {
+	if x != nil {
+		return x.LaunchedSatisfaction
+	}
+	return ""
+}
+
+func (x *Building) GetRotation() string {
+	if x != nil {
+		return x.Rotation
+	}
+	return ""
+}
+
+func (x *Building) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *Building) GetBubbleDiameter() string {
+	if x != nil {
+		return x.BubbleDiameter
+	}
+	return ""
+}
+
+func (x *Building) GetInformation() string {
+	if x != nil {
+		return x.Information
+	}
+	return ""
+}
+
+type UpdateBuildingRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	FeatureId            uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	BuildingModelId      uint64                 `protobuf:"varint,2,opt,name=building_model_id,json=buildingModelId,proto3" json:"building_model_id,omitempty"`
+	LaunchedSatisfaction string                 `protobuf:"bytes,3,opt,name=launched_satisfaction,json=launchedSatisfaction,proto3" json:"launched_satisfaction,omitempty"`
+	Rotation             string                 `protobuf:"bytes,4,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	Position             string                 `protobuf:"bytes,5,opt,name=position,proto3" json:"position,omitempty"`
+	Information          *BuildingInformation   `protobuf:"bytes,6,opt,name=information,proto3" json:"information,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateBuildingRequest) Reset() {
+	*x = UpdateBuildingRequest{}
+	mi := &file_features_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBuildingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBuildingRequest) ProtoMessage() {}
+
+func (x *UpdateBuildingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBuildingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBuildingRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UpdateBuildingRequest) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+func (x *UpdateBuildingRequest) GetBuildingModelId() uint64 {
+	if x != nil {
+		return x.BuildingModelId
+	}
+	return 0
+}
+
+func (x *UpdateBuildingRequest) GetLaunchedSatisfaction() string {
+	if x != nil {
+		return x.LaunchedSatisfaction
+	}
+	return ""
+}
+
+func (x *UpdateBuildingRequest) GetRotation() string {
+	if x != nil {
+		return x.Rotation
+	}
+	return ""
+}
+
+func (x *UpdateBuildingRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *UpdateBuildingRequest) GetInformation() *BuildingInformation {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+type BuildingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Building      *Building              `protobuf:"bytes,3,opt,name=building,proto3" json:"building,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildingResponse) Reset() {
+	*x = BuildingResponse{}
+	mi := &file_features_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildingResponse) ProtoMessage() {}
+
+func (x *BuildingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildingResponse.ProtoReflect.Descriptor instead.
+func (*BuildingResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *BuildingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BuildingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BuildingResponse) GetBuilding() *Building {
+	if x != nil {
+		return x.Building
+	}
+	return nil
+}
+
+type DestroyBuildingRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FeatureId       uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	BuildingModelId uint64                 `protobuf:"varint,2,opt,name=building_model_id,json=buildingModelId,proto3" json:"building_model_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DestroyBuildingRequest) Reset() {
+	*x = DestroyBuildingRequest{}
+	mi := &file_features_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DestroyBuildingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroyBuildingRequest) ProtoMessage() {}
+
+func (x *DestroyBuildingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroyBuildingRequest.ProtoReflect.Descriptor instead.
+func (*DestroyBuildingRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DestroyBuildingRequest) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+func (x *DestroyBuildingRequest) GetBuildingModelId() uint64 {
+	if x != nil {
+		return x.BuildingModelId
+	}
+	return 0
+}
+
+type SimulateBuildRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	FeatureId            uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	BuildingModelId      uint64                 `protobuf:"varint,2,opt,name=building_model_id,json=buildingModelId,proto3" json:"building_model_id,omitempty"`
+	LaunchedSatisfaction string                 `protobuf:"bytes,3,opt,name=launched_satisfaction,json=launchedSatisfaction,proto3" json:"launched_satisfaction,omitempty"` // Defaults to the model's required satisfaction
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SimulateBuildRequest) Reset() {
+	*x = SimulateBuildRequest{}
+	mi := &file_features_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateBuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateBuildRequest) ProtoMessage() {}
+
+func (x *SimulateBuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateBuildRequest.ProtoReflect.Descriptor instead.
+func (*SimulateBuildRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SimulateBuildRequest) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+func (x *SimulateBuildRequest) GetBuildingModelId() uint64 {
+	if x != nil {
+		return x.BuildingModelId
+	}
+	return 0
+}
+
+func (x *SimulateBuildRequest) GetLaunchedSatisfaction() string {
+	if x != nil {
+		return x.LaunchedSatisfaction
+	}
+	return ""
+}
+
+type SimulateBuildResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Qualifies             bool                   `protobuf:"varint,1,opt,name=qualifies,proto3" json:"qualifies,omitempty"`                                                  // BuildFeature would pass its checks now
+	Missing               []*BuildRequirement    `protobuf:"bytes,2,rep,name=missing,proto3" json:"missing,omitempty"`                                                       // Empty when qualifies
+	RequiredSatisfaction  string                 `protobuf:"bytes,3,opt,name=required_satisfaction,json=requiredSatisfaction,proto3" json:"required_satisfaction,omitempty"` // Of the model on this feature
+	LaunchedSatisfaction  string                 `protobuf:"bytes,4,opt,name=launched_satisfaction,json=launchedSatisfaction,proto3" json:"launched_satisfaction,omitempty"`
+	AvailableSatisfaction string                 `protobuf:"bytes,5,opt,name=available_satisfaction,json=availableSatisfaction,proto3" json:"available_satisfaction,omitempty"`   // In the user's wallet
+	ConstructionSeconds   int64                  `protobuf:"varint,6,opt,name=construction_seconds,json=constructionSeconds,proto3" json:"construction_seconds,omitempty"`        // At launched_satisfaction
+	ConstructionEndDate   string                 `protobuf:"bytes,7,opt,name=construction_end_date,json=constructionEndDate,proto3" json:"construction_end_date,omitempty"`       // Jalali Y/m/d H:i:s if built now
+	ProfitAsset           string                 `protobuf:"bytes,8,opt,name=profit_asset,json=profitAsset,proto3" json:"profit_asset,omitempty"`                                 // Color the feature's hourly profit is paid in
+	ProjectedHourlyProfit string                 `protobuf:"bytes,9,opt,name=projected_hourly_profit,json=projectedHourlyProfit,proto3" json:"projected_hourly_profit,omitempty"` // While the feature's profit is active
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SimulateBuildResponse) Reset() {
+	*x = SimulateBuildResponse{}
+	mi := &file_features_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateBuildResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateBuildResponse) ProtoMessage() {}
+
+func (x *SimulateBuildResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateBuildResponse.ProtoReflect.Descriptor instead.
+func (*SimulateBuildResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SimulateBuildResponse) GetQualifies() bool {
+	if x != nil {
+		return x.Qualifies
+	}
+	return false
+}
+
+func (x *SimulateBuildResponse) GetMissing() []*BuildRequirement {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *SimulateBuildResponse) GetRequiredSatisfaction() string {
+	if x != nil {
+		return x.RequiredSatisfaction
+	}
+	return ""
+}
+
+func (x *SimulateBuildResponse) GetLaunchedSatisfaction() string {
+	if x != nil {
+		return x.LaunchedSatisfaction
+	}
+	return ""
+}
+
+func (x *SimulateBuildResponse) GetAvailableSatisfaction() string {
+	if x != nil {
+		return x.AvailableSatisfaction
+	}
+	return ""
+}
+
+func (x *SimulateBuildResponse) GetConstructionSeconds() int64 {
+	if x != nil {
+		return x.ConstructionSeconds
+	}
+	return 0
+}
+
+func (x *SimulateBuildResponse) GetConstructionEndDate() string {
+	if x != nil {
+		return x.ConstructionEndDate
+	}
+	return ""
+}
+
+func (x *SimulateBuildResponse) GetProfitAsset() string {
+	if x != nil {
+		return x.ProfitAsset
+	}
+	return ""
+}
+
+func (x *SimulateBuildResponse) GetProjectedHourlyProfit() string {
+	if x != nil {
+		return x.ProjectedHourlyProfit
+	}
+	return ""
+}
+
+type BuildRequirement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ownership, building_model, existing_building, satisfaction, balance
+	Code          string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Required      string `protobuf:"bytes,3,opt,name=required,proto3" json:"required,omitempty"` // Set for satisfaction and balance
+	Available     string `protobuf:"bytes,4,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildRequirement) Reset() {
+	*x = BuildRequirement{}
+	mi := &file_features_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildRequirement) ProtoMessage() {}
+
+func (x *BuildRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildRequirement.ProtoReflect.Descriptor instead.
+func (*BuildRequirement) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *BuildRequirement) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BuildRequirement) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BuildRequirement) GetRequired() string {
+	if x != nil {
+		return x.Required
+	}
+	return ""
+}
+
+func (x *BuildRequirement) GetAvailable() string {
+	if x != nil {
+		return x.Available
+	}
+	return ""
+}
+
+type ListMapsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMapsRequest) Reset() {
+	*x = ListMapsRequest{}
+	mi := &file_features_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMapsRequest) ProtoMessage() {}
+
+func (x *ListMapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMapsRequest.ProtoReflect.Descriptor instead.
+func (*ListMapsRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{84}
+}
+
+type GetMapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MapId         uint64                 `protobuf:"varint,1,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMapRequest) Reset() {
+	*x = GetMapRequest{}
+	mi := &file_features_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMapRequest) ProtoMessage() {}
+
+func (x *GetMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMapRequest.ProtoReflect.Descriptor instead.
+func (*GetMapRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetMapRequest) GetMapId() uint64 {
+	if x != nil {
+		return x.MapId
+	}
+	return 0
+}
+
+type ListMapsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Maps          []*Map                 `protobuf:"bytes,1,rep,name=maps,proto3" json:"maps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMapsResponse) Reset() {
+	*x = ListMapsResponse{}
+	mi := &file_features_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMapsResponse) ProtoMessage() {}
+
+func (x *ListMapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMapsResponse.ProtoReflect.Descriptor instead.
+func (*ListMapsResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListMapsResponse) GetMaps() []*Map {
+	if x != nil {
+		return x.Maps
+	}
+	return nil
+}
+
+type GetMapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Map           *Map                   `protobuf:"bytes,1,opt,name=map,proto3" json:"map,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMapResponse) Reset() {
+	*x = GetMapResponse{}
+	mi := &file_features_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMapResponse) ProtoMessage() {}
+
+func (x *GetMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMapResponse.ProtoReflect.Descriptor instead.
+func (*GetMapResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetMapResponse) GetMap() *Map {
+	if x != nil {
+		return x.Map
+	}
+	return nil
+}
+
+type GetMapBorderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *MapBorderData         `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMapBorderResponse) Reset() {
+	*x = GetMapBorderResponse{}
+	mi := &file_features_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMapBorderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMapBorderResponse) ProtoMessage() {}
+
+func (x *GetMapBorderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMapBorderResponse.ProtoReflect.Descriptor instead.
+func (*GetMapBorderResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetMapBorderResponse) GetData() *MapBorderData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type MapBorderData struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	BorderCoordinates string                 `protobuf:"bytes,1,opt,name=border_coordinates,json=borderCoordinates,proto3" json:"border_coordinates,omitempty"` // JSON string
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MapBorderData) Reset() {
+	*x = MapBorderData{}
+	mi := &file_features_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapBorderData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapBorderData) ProtoMessage() {}
+
+func (x *MapBorderData) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapBorderData.ProtoReflect.Descriptor instead.
+func (*MapBorderData) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *MapBorderData) GetBorderCoordinates() string {
+	if x != nil {
+		return x.BorderCoordinates
+	}
+	return ""
+}
+
+type Map struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color                   string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`                                                                      // polygon_color
+	CentralPointCoordinates string                 `protobuf:"bytes,4,opt,name=central_point_coordinates,json=centralPointCoordinates,proto3" json:"central_point_coordinates,omitempty"` // JSON string
+	SoldFeaturesPercentage  string                 `protobuf:"bytes,5,opt,name=sold_features_percentage,json=soldFeaturesPercentage,proto3" json:"sold_features_percentage,omitempty"`    // formatted to 2 decimal places
+	// Additional fields for detail view
+	BorderCoordinates string       `protobuf:"bytes,6,opt,name=border_coordinates,json=borderCoordinates,proto3" json:"border_coordinates,omitempty"` // JSON string (only in detail)
+	Area              uint64       `protobuf:"varint,7,opt,name=area,proto3" json:"area,omitempty"`                                                   // polygon_area (only in detail)
+	Address           string       `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`                                              // polygon_address (only in detail)
+	PublishedAt       string       `protobuf:"bytes,9,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`                   // publish_date (only in detail)
+	Features          *MapFeatures `protobuf:"bytes,10,opt,name=features,proto3" json:"features,omitempty"`                                           // grouping by karbari (only in detail)
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Map) Reset() {
+	*x = Map{}
+	mi := &file_features_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Map) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Map) ProtoMessage() {}
+
+func (x *Map) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Map.ProtoReflect.Descriptor instead.
+func (*Map) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *Map) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Map) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Map) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Map) GetCentralPointCoordinates() string {
+	if x != nil {
+		return x.CentralPointCoordinates
+	}
+	return ""
+}
+
+func (x *Map) GetSoldFeaturesPercentage() string {
+	if x != nil {
+		return x.SoldFeaturesPercentage
+	}
+	return ""
+}
+
+func (x *Map) GetBorderCoordinates() string {
+	if x != nil {
+		return x.BorderCoordinates
+	}
+	return ""
+}
+
+func (x *Map) GetArea() uint64 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *Map) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Map) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
+}
+
+func (x *Map) GetFeatures() *MapFeatures {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type MapFeatures struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Maskoni       *MapFeatureCount       `protobuf:"bytes,1,opt,name=maskoni,proto3" json:"maskoni,omitempty"`
+	Tejari        *MapFeatureCount       `protobuf:"bytes,2,opt,name=tejari,proto3" json:"tejari,omitempty"`
+	Amoozeshi     *MapFeatureCount       `protobuf:"bytes,3,opt,name=amoozeshi,proto3" json:"amoozeshi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MapFeatures) Reset() {
+	*x = MapFeatures{}
+	mi := &file_features_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapFeatures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapFeatures) ProtoMessage() {}
+
+func (x *MapFeatures) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapFeatures.ProtoReflect.Descriptor instead.
+func (*MapFeatures) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *MapFeatures) GetMaskoni() *MapFeatureCount {
+	if x != nil {
+		return x.Maskoni
+	}
+	return nil
+}
+
+func (x *MapFeatures) GetTejari() *MapFeatureCount {
+	if x != nil {
+		return x.Tejari
+	}
+	return nil
+}
+
+func (x *MapFeatures) GetAmoozeshi() *MapFeatureCount {
+	if x != nil {
+		return x.Amoozeshi
+	}
+	return nil
+}
+
+type MapFeatureCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sold          int32                  `protobuf:"varint,1,opt,name=sold,proto3" json:"sold,omitempty"` // count of features with owner_id != 1 and matching karbari
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MapFeatureCount) Reset() {
+	*x = MapFeatureCount{}
+	mi := &file_features_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapFeatureCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapFeatureCount) ProtoMessage() {}
+
+func (x *MapFeatureCount) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapFeatureCount.ProtoReflect.Descriptor instead.
+func (*MapFeatureCount) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *MapFeatureCount) GetSold() int32 {
+	if x != nil {
+		return x.Sold
+	}
+	return 0
+}
+
+// AddToWatchlistRequest - POST /api/features/{feature}/watch
+// Adding a feature that is already watched returns the existing item.
+type AddToWatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FeatureId     uint64                 `protobuf:"varint,2,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToWatchlistRequest) Reset() {
+	*x = AddToWatchlistRequest{}
+	mi := &file_features_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToWatchlistRequest) ProtoMessage() {}
+
+func (x *AddToWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*AddToWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *AddToWatchlistRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddToWatchlistRequest) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+// RemoveFromWatchlistRequest - DELETE /api/features/{feature}/watch
+type RemoveFromWatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FeatureId     uint64                 `protobuf:"varint,2,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromWatchlistRequest) Reset() {
+	*x = RemoveFromWatchlistRequest{}
+	mi := &file_features_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromWatchlistRequest) ProtoMessage() {}
+
+func (x *RemoveFromWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *RemoveFromWatchlistRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveFromWatchlistRequest) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+// ListWatchlistRequest - GET /api/watchlist
+type ListWatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchlistRequest) Reset() {
+	*x = ListWatchlistRequest{}
+	mi := &file_features_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchlistRequest) ProtoMessage() {}
+
+func (x *ListWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*ListWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListWatchlistRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type WatchlistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FeatureId     uint64                 `protobuf:"varint,2,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	PropertiesId  string                 `protobuf:"bytes,3,opt,name=properties_id,json=propertiesId,proto3" json:"properties_id,omitempty"` // feature_properties.id, e.g. "HM-2000001"
+	Karbari       string                 `protobuf:"bytes,4,opt,name=karbari,proto3" json:"karbari,omitempty"`
+	OwnerId       uint64                 `protobuf:"varint,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	PricePsc      string                 `protobuf:"bytes,6,opt,name=price_psc,json=pricePsc,proto3" json:"price_psc,omitempty"`
+	PriceIrr      string                 `protobuf:"bytes,7,opt,name=price_irr,json=priceIrr,proto3" json:"price_irr,omitempty"`
+	OnSale        bool                   `protobuf:"varint,8,opt,name=on_sale,json=onSale,proto3" json:"on_sale,omitempty"` // Feature has a pending sell request
+	Date          string                 `protobuf:"bytes,9,opt,name=date,proto3" json:"date,omitempty"`                    // Jalali date the feature was added
+	Time          string                 `protobuf:"bytes,10,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchlistItem) Reset() {
+	*x = WatchlistItem{}
+	mi := &file_features_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistItem) ProtoMessage() {}
+
+func (x *WatchlistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistItem.ProtoReflect.Descriptor instead.
+func (*WatchlistItem) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *WatchlistItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WatchlistItem) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+func (x *WatchlistItem) GetPropertiesId() string {
+	if x != nil {
+		return x.PropertiesId
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetKarbari() string {
+	if x != nil {
+		return x.Karbari
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetOwnerId() uint64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *WatchlistItem) GetPricePsc() string {
+	if x != nil {
+		return x.PricePsc
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetPriceIrr() string {
+	if x != nil {
+		return x.PriceIrr
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetOnSale() bool {
+	if x != nil {
+		return x.OnSale
+	}
+	return false
+}
+
+func (x *WatchlistItem) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type WatchlistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *WatchlistItem         `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchlistItemResponse) Reset() {
+	*x = WatchlistItemResponse{}
+	mi := &file_features_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistItemResponse) ProtoMessage() {}
+
+func (x *WatchlistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistItemResponse.ProtoReflect.Descriptor instead.
+func (*WatchlistItemResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *WatchlistItemResponse) GetData() *WatchlistItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListWatchlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*WatchlistItem       `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchlistResponse) Reset() {
+	*x = ListWatchlistResponse{}
+	mi := &file_features_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchlistResponse) ProtoMessage() {}
+
+func (x *ListWatchlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchlistResponse.ProtoReflect.Descriptor instead.
+func (*ListWatchlistResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListWatchlistResponse) GetData() []*WatchlistItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// CreateSavedSearchRequest - POST /api/marketplace/saved-searches
+// Only sell requests created after the search is saved are notified.
+type CreateSavedSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                         // Optional label, at most 100 characters
+	Region        int32                  `protobuf:"varint,3,opt,name=region,proto3" json:"region,omitempty"`                    // Optional, feature_properties.region
+	Karbari       string                 `protobuf:"bytes,4,opt,name=karbari,proto3" json:"karbari,omitempty"`                   // Optional, e.g. "m", "t" or "a"
+	Color         string                 `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`                       // Optional alternative to karbari: "yellow", "red" or "blue"
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`                 // "irr" (default) or "psc", used by the price range
+	MinPrice      string                 `protobuf:"bytes,7,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"` // Optional, inclusive
+	MaxPrice      string                 `protobuf:"bytes,8,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"` // Optional, inclusive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSavedSearchRequest) Reset() {
+	*x = CreateSavedSearchRequest{}
+	mi := &file_features_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedSearchRequest) ProtoMessage() {}
+
+func (x *CreateSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*CreateSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *CreateSavedSearchRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateSavedSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSavedSearchRequest) GetRegion() int32 {
+	if x != nil {
+		return x.Region
+	}
+	return 0
+}
+
+func (x *CreateSavedSearchRequest) GetKarbari() string {
+	if x != nil {
+		return x.Karbari
 	}
 	return ""
 }
 
-func (x *BuyRequestResponse) GetFeatureProperties() *FeatureProperties {
+func (x *CreateSavedSearchRequest) GetColor() string {
 	if x != nil {
-		return x.FeatureProperties
+		return x.Color
 	}
-	return nil
+	return ""
 }
 
-func (x *BuyRequestResponse) GetFeatureCoordinates() []*Coordinate {
+func (x *CreateSavedSearchRequest) GetCurrency() string {
 	if x != nil {
-		return x.FeatureCoordinates
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-func (x *BuyRequestResponse) GetRequestedGracePeriod() string {
+func (x *CreateSavedSearchRequest) GetMinPrice() string {
 	if x != nil {
-		return x.RequestedGracePeriod
+		return x.MinPrice
 	}
 	return ""
 }
 
-func (x *BuyRequestResponse) GetCreatedAt() string {
+func (x *CreateSavedSearchRequest) GetMaxPrice() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.MaxPrice
 	}
 	return ""
 }
 
-type BuyerInfo struct {
+// UpdateSavedSearchRequest - PUT /api/marketplace/saved-searches/{savedSearch}
+// Replaces all criteria of the search.
+type UpdateSavedSearchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	ProfilePhoto  string                 `protobuf:"bytes,3,opt,name=profile_photo,json=profilePhoto,proto3" json:"profile_photo,omitempty"` // URL to latest profile photo
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SavedSearchId uint64                 `protobuf:"varint,2,opt,name=saved_search_id,json=savedSearchId,proto3" json:"saved_search_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Region        int32                  `protobuf:"varint,4,opt,name=region,proto3" json:"region,omitempty"`
+	Karbari       string                 `protobuf:"bytes,5,opt,name=karbari,proto3" json:"karbari,omitempty"`
+	Color         string                 `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`
+	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	MinPrice      string                 `protobuf:"bytes,8,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice      string                 `protobuf:"bytes,9,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuyerInfo) Reset() {
-	*x = BuyerInfo{}
-	mi := &file_features_proto_msgTypes[25]
+func (x *UpdateSavedSearchRequest) Reset() {
+	*x = UpdateSavedSearchRequest{}
+	mi := &file_features_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuyerInfo) String() string {
+func (x *UpdateSavedSearchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuyerInfo) ProtoMessage() {}
+func (*UpdateSavedSearchRequest) ProtoMessage() {}
 
-func (x *BuyerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[25]
+func (x *UpdateSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1751,55 +6590,98 @@ func (x *BuyerInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuyerInfo.ProtoReflect.Descriptor instead.
-func (*BuyerInfo) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use UpdateSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *BuyerInfo) GetId() uint64 {
+func (x *UpdateSavedSearchRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *BuyerInfo) GetCode() string {
+func (x *UpdateSavedSearchRequest) GetSavedSearchId() uint64 {
 	if x != nil {
-		return x.Code
+		return x.SavedSearchId
+	}
+	return 0
+}
+
+func (x *UpdateSavedSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *BuyerInfo) GetProfilePhoto() string {
+func (x *UpdateSavedSearchRequest) GetRegion() int32 {
 	if x != nil {
-		return x.ProfilePhoto
+		return x.Region
+	}
+	return 0
+}
+
+func (x *UpdateSavedSearchRequest) GetKarbari() string {
+	if x != nil {
+		return x.Karbari
 	}
 	return ""
 }
 
-type SellerInfo struct {
+func (x *UpdateSavedSearchRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *UpdateSavedSearchRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateSavedSearchRequest) GetMinPrice() string {
+	if x != nil {
+		return x.MinPrice
+	}
+	return ""
+}
+
+func (x *UpdateSavedSearchRequest) GetMaxPrice() string {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return ""
+}
+
+// DeleteSavedSearchRequest - DELETE /api/marketplace/saved-searches/{savedSearch}
+type DeleteSavedSearchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SavedSearchId uint64                 `protobuf:"varint,2,opt,name=saved_search_id,json=savedSearchId,proto3" json:"saved_search_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SellerInfo) Reset() {
-	*x = SellerInfo{}
-	mi := &file_features_proto_msgTypes[26]
+func (x *DeleteSavedSearchRequest) Reset() {
+	*x = DeleteSavedSearchRequest{}
+	mi := &file_features_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SellerInfo) String() string {
+func (x *DeleteSavedSearchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SellerInfo) ProtoMessage() {}
+func (*DeleteSavedSearchRequest) ProtoMessage() {}
 
-func (x *SellerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[26]
+func (x *DeleteSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1810,47 +6692,48 @@ func (x *SellerInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SellerInfo.ProtoReflect.Descriptor instead.
-func (*SellerInfo) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use DeleteSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *SellerInfo) GetId() uint64 {
+func (x *DeleteSavedSearchRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *SellerInfo) GetCode() string {
+func (x *DeleteSavedSearchRequest) GetSavedSearchId() uint64 {
 	if x != nil {
-		return x.Code
+		return x.SavedSearchId
 	}
-	return ""
+	return 0
 }
 
-type ListBuyRequestsRequest struct {
+// ListSavedSearchesRequest - GET /api/marketplace/saved-searches
+type ListSavedSearchesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BuyerId       uint64                 `protobuf:"varint,1,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBuyRequestsRequest) Reset() {
-	*x = ListBuyRequestsRequest{}
-	mi := &file_features_proto_msgTypes[27]
+func (x *ListSavedSearchesRequest) Reset() {
+	*x = ListSavedSearchesRequest{}
+	mi := &file_features_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBuyRequestsRequest) String() string {
+func (x *ListSavedSearchesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBuyRequestsRequest) ProtoMessage() {}
+func (*ListSavedSearchesRequest) ProtoMessage() {}
 
-func (x *ListBuyRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[27]
+func (x *ListSavedSearchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1861,40 +6744,48 @@ func (x *ListBuyRequestsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBuyRequestsRequest.ProtoReflect.Descriptor instead.
-func (*ListBuyRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ListSavedSearchesRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *ListBuyRequestsRequest) GetBuyerId() uint64 {
+func (x *ListSavedSearchesRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.BuyerId
+		return x.UserId
 	}
 	return 0
 }
 
-type ListReceivedBuyRequestsRequest struct {
+type SavedSearch struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SellerId      uint64                 `protobuf:"varint,1,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Region        int32                  `protobuf:"varint,3,opt,name=region,proto3" json:"region,omitempty"`  // 0 when any region matches
+	Karbari       string                 `protobuf:"bytes,4,opt,name=karbari,proto3" json:"karbari,omitempty"` // Empty when any karbari matches
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	MinPrice      string                 `protobuf:"bytes,6,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"` // "0" when there is no lower bound
+	MaxPrice      string                 `protobuf:"bytes,7,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"` // "0" when there is no upper bound
+	Date          string                 `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"`                         // Jalali date the search was saved
+	Time          string                 `protobuf:"bytes,9,opt,name=time,proto3" json:"time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListReceivedBuyRequestsRequest) Reset() {
-	*x = ListReceivedBuyRequestsRequest{}
-	mi := &file_features_proto_msgTypes[28]
+func (x *SavedSearch) Reset() {
+	*x = SavedSearch{}
+	mi := &file_features_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListReceivedBuyRequestsRequest) String() string {
+func (x *SavedSearch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReceivedBuyRequestsRequest) ProtoMessage() {}
+func (*SavedSearch) ProtoMessage() {}
 
-func (x *ListReceivedBuyRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[28]
+func (x *SavedSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1905,40 +6796,96 @@ func (x *ListReceivedBuyRequestsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReceivedBuyRequestsRequest.ProtoReflect.Descriptor instead.
-func (*ListReceivedBuyRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use SavedSearch.ProtoReflect.Descriptor instead.
+func (*SavedSearch) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *ListReceivedBuyRequestsRequest) GetSellerId() uint64 {
+func (x *SavedSearch) GetId() uint64 {
 	if x != nil {
-		return x.SellerId
+		return x.Id
 	}
 	return 0
 }
 
-type BuyRequestsResponse struct {
+func (x *SavedSearch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetRegion() int32 {
+	if x != nil {
+		return x.Region
+	}
+	return 0
+}
+
+func (x *SavedSearch) GetKarbari() string {
+	if x != nil {
+		return x.Karbari
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetMinPrice() string {
+	if x != nil {
+		return x.MinPrice
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetMaxPrice() string {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type SavedSearchResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BuyRequests   []*BuyRequestResponse  `protobuf:"bytes,1,rep,name=buy_requests,json=buyRequests,proto3" json:"buy_requests,omitempty"`
+	Data          *SavedSearch           `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuyRequestsResponse) Reset() {
-	*x = BuyRequestsResponse{}
-	mi := &file_features_proto_msgTypes[29]
+func (x *SavedSearchResponse) Reset() {
+	*x = SavedSearchResponse{}
+	mi := &file_features_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuyRequestsResponse) String() string {
+func (x *SavedSearchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuyRequestsResponse) ProtoMessage() {}
+func (*SavedSearchResponse) ProtoMessage() {}
 
-func (x *BuyRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[29]
+func (x *SavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1949,41 +6896,40 @@ func (x *BuyRequestsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuyRequestsResponse.ProtoReflect.Descriptor instead.
-func (*BuyRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use SavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*SavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *BuyRequestsResponse) GetBuyRequests() []*BuyRequestResponse {
+func (x *SavedSearchResponse) GetData() *SavedSearch {
 	if x != nil {
-		return x.BuyRequests
+		return x.Data
 	}
 	return nil
 }
 
-type RejectBuyRequestRequest struct {
+type ListSavedSearchesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     uint64                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	SellerId      uint64                 `protobuf:"varint,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	Data          []*SavedSearch         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RejectBuyRequestRequest) Reset() {
-	*x = RejectBuyRequestRequest{}
-	mi := &file_features_proto_msgTypes[30]
+func (x *ListSavedSearchesResponse) Reset() {
+	*x = ListSavedSearchesResponse{}
+	mi := &file_features_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RejectBuyRequestRequest) String() string {
+func (x *ListSavedSearchesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RejectBuyRequestRequest) ProtoMessage() {}
+func (*ListSavedSearchesResponse) ProtoMessage() {}
 
-func (x *RejectBuyRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[30]
+func (x *ListSavedSearchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1994,48 +6940,40 @@ func (x *RejectBuyRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RejectBuyRequestRequest.ProtoReflect.Descriptor instead.
-func (*RejectBuyRequestRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *RejectBuyRequestRequest) GetRequestId() uint64 {
-	if x != nil {
-		return x.RequestId
-	}
-	return 0
+// Deprecated: Use ListSavedSearchesResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *RejectBuyRequestRequest) GetSellerId() uint64 {
+func (x *ListSavedSearchesResponse) GetData() []*SavedSearch {
 	if x != nil {
-		return x.SellerId
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-type DeleteBuyRequestRequest struct {
+type GetTradeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     uint64                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	BuyerId       uint64                 `protobuf:"varint,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	TradeId       uint64                 `protobuf:"varint,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteBuyRequestRequest) Reset() {
-	*x = DeleteBuyRequestRequest{}
-	mi := &file_features_proto_msgTypes[31]
+func (x *GetTradeRequest) Reset() {
+	*x = GetTradeRequest{}
+	mi := &file_features_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBuyRequestRequest) String() string {
+func (x *GetTradeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBuyRequestRequest) ProtoMessage() {}
+func (*GetTradeRequest) ProtoMessage() {}
 
-func (x *DeleteBuyRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[31]
+func (x *GetTradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2046,49 +6984,40 @@ func (x *DeleteBuyRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBuyRequestRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBuyRequestRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *DeleteBuyRequestRequest) GetRequestId() uint64 {
-	if x != nil {
-		return x.RequestId
-	}
-	return 0
+// Deprecated: Use GetTradeRequest.ProtoReflect.Descriptor instead.
+func (*GetTradeRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *DeleteBuyRequestRequest) GetBuyerId() uint64 {
+func (x *GetTradeRequest) GetTradeId() uint64 {
 	if x != nil {
-		return x.BuyerId
+		return x.TradeId
 	}
 	return 0
 }
 
-type UpdateGracePeriodRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	RequestId       uint64                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	SellerId        uint64                 `protobuf:"varint,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
-	GracePeriodDays int32                  `protobuf:"varint,3,opt,name=grace_period_days,json=gracePeriodDays,proto3" json:"grace_period_days,omitempty"` // 1-30
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type TradeFundsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TradeId       uint64                 `protobuf:"varint,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGracePeriodRequest) Reset() {
-	*x = UpdateGracePeriodRequest{}
-	mi := &file_features_proto_msgTypes[32]
+func (x *TradeFundsRequest) Reset() {
+	*x = TradeFundsRequest{}
+	mi := &file_features_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGracePeriodRequest) String() string {
+func (x *TradeFundsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGracePeriodRequest) ProtoMessage() {}
+func (*TradeFundsRequest) ProtoMessage() {}
 
-func (x *UpdateGracePeriodRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[32]
+func (x *TradeFundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2099,55 +7028,41 @@ func (x *UpdateGracePeriodRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGracePeriodRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGracePeriodRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *UpdateGracePeriodRequest) GetRequestId() uint64 {
-	if x != nil {
-		return x.RequestId
-	}
-	return 0
-}
-
-func (x *UpdateGracePeriodRequest) GetSellerId() uint64 {
-	if x != nil {
-		return x.SellerId
-	}
-	return 0
+// Deprecated: Use TradeFundsRequest.ProtoReflect.Descriptor instead.
+func (*TradeFundsRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *UpdateGracePeriodRequest) GetGracePeriodDays() int32 {
+func (x *TradeFundsRequest) GetTradeId() uint64 {
 	if x != nil {
-		return x.GracePeriodDays
+		return x.TradeId
 	}
 	return 0
 }
 
-type AcceptBuyRequestRequest struct {
+type RefundTradeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     uint64                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	SellerId      uint64                 `protobuf:"varint,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	TradeId       uint64                 `protobuf:"varint,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	FundsFrozen   bool                   `protobuf:"varint,2,opt,name=funds_frozen,json=fundsFrozen,proto3" json:"funds_frozen,omitempty"` // Proceeds were frozen by FreezeTradeFunds
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AcceptBuyRequestRequest) Reset() {
-	*x = AcceptBuyRequestRequest{}
-	mi := &file_features_proto_msgTypes[33]
+func (x *RefundTradeRequest) Reset() {
+	*x = RefundTradeRequest{}
+	mi := &file_features_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AcceptBuyRequestRequest) String() string {
+func (x *RefundTradeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptBuyRequestRequest) ProtoMessage() {}
+func (*RefundTradeRequest) ProtoMessage() {}
 
-func (x *AcceptBuyRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[33]
+func (x *RefundTradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2158,51 +7073,54 @@ func (x *AcceptBuyRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptBuyRequestRequest.ProtoReflect.Descriptor instead.
-func (*AcceptBuyRequestRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use RefundTradeRequest.ProtoReflect.Descriptor instead.
+func (*RefundTradeRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *AcceptBuyRequestRequest) GetRequestId() uint64 {
+func (x *RefundTradeRequest) GetTradeId() uint64 {
 	if x != nil {
-		return x.RequestId
+		return x.TradeId
 	}
 	return 0
 }
 
-func (x *AcceptBuyRequestRequest) GetSellerId() uint64 {
+func (x *RefundTradeRequest) GetFundsFrozen() bool {
 	if x != nil {
-		return x.SellerId
+		return x.FundsFrozen
 	}
-	return 0
+	return false
 }
 
-type CreateSellRequestRequest struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	FeatureId              uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
-	SellerId               uint64                 `protobuf:"varint,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
-	PricePsc               string                 `protobuf:"bytes,3,opt,name=price_psc,json=pricePsc,proto3" json:"price_psc,omitempty"`                                              // Optional, mutually exclusive with minimum_price_percentage
-	PriceIrr               string                 `protobuf:"bytes,4,opt,name=price_irr,json=priceIrr,proto3" json:"price_irr,omitempty"`                                              // Optional, mutually exclusive with minimum_price_percentage
-	MinimumPricePercentage int32                  `protobuf:"varint,5,opt,name=minimum_price_percentage,json=minimumPricePercentage,proto3" json:"minimum_price_percentage,omitempty"` // Optional, mutually exclusive with price_psc/price_irr, min:80 (min:110 if under 18)
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type TradeDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FeatureId     uint64                 `protobuf:"varint,2,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	PropertiesId  string                 `protobuf:"bytes,3,opt,name=properties_id,json=propertiesId,proto3" json:"properties_id,omitempty"`
+	BuyerId       uint64                 `protobuf:"varint,4,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	SellerId      uint64                 `protobuf:"varint,5,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	PscAmount     float64                `protobuf:"fixed64,6,opt,name=psc_amount,json=pscAmount,proto3" json:"psc_amount,omitempty"`
+	IrrAmount     float64                `protobuf:"fixed64,7,opt,name=irr_amount,json=irrAmount,proto3" json:"irr_amount,omitempty"`
+	TradedAt      int64                  `protobuf:"varint,8,opt,name=traded_at,json=tradedAt,proto3" json:"traded_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSellRequestRequest) Reset() {
-	*x = CreateSellRequestRequest{}
-	mi := &file_features_proto_msgTypes[34]
+func (x *TradeDetails) Reset() {
+	*x = TradeDetails{}
+	mi := &file_features_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSellRequestRequest) String() string {
+func (x *TradeDetails) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSellRequestRequest) ProtoMessage() {}
+func (*TradeDetails) ProtoMessage() {}
 
-func (x *CreateSellRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[34]
+func (x *TradeDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2213,68 +7131,89 @@ func (x *CreateSellRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSellRequestRequest.ProtoReflect.Descriptor instead.
-func (*CreateSellRequestRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use TradeDetails.ProtoReflect.Descriptor instead.
+func (*TradeDetails) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *CreateSellRequestRequest) GetFeatureId() uint64 {
+func (x *TradeDetails) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TradeDetails) GetFeatureId() uint64 {
 	if x != nil {
 		return x.FeatureId
 	}
 	return 0
 }
 
-func (x *CreateSellRequestRequest) GetSellerId() uint64 {
+func (x *TradeDetails) GetPropertiesId() string {
+	if x != nil {
+		return x.PropertiesId
+	}
+	return ""
+}
+
+func (x *TradeDetails) GetBuyerId() uint64 {
+	if x != nil {
+		return x.BuyerId
+	}
+	return 0
+}
+
+func (x *TradeDetails) GetSellerId() uint64 {
 	if x != nil {
 		return x.SellerId
 	}
 	return 0
 }
 
-func (x *CreateSellRequestRequest) GetPricePsc() string {
+func (x *TradeDetails) GetPscAmount() float64 {
 	if x != nil {
-		return x.PricePsc
+		return x.PscAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateSellRequestRequest) GetPriceIrr() string {
+func (x *TradeDetails) GetIrrAmount() float64 {
 	if x != nil {
-		return x.PriceIrr
+		return x.IrrAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateSellRequestRequest) GetMinimumPricePercentage() int32 {
+func (x *TradeDetails) GetTradedAt() int64 {
 	if x != nil {
-		return x.MinimumPricePercentage
+		return x.TradedAt
 	}
 	return 0
 }
 
-type ListSellRequestsRequest struct {
+type TradeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SellerId      uint64                 `protobuf:"varint,1,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"` // Required - authenticated seller
+	Data          *TradeDetails          `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSellRequestsRequest) Reset() {
-	*x = ListSellRequestsRequest{}
-	mi := &file_features_proto_msgTypes[35]
+func (x *TradeResponse) Reset() {
+	*x = TradeResponse{}
+	mi := &file_features_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSellRequestsRequest) String() string {
+func (x *TradeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSellRequestsRequest) ProtoMessage() {}
+func (*TradeResponse) ProtoMessage() {}
 
-func (x *ListSellRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[35]
+func (x *TradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2285,41 +7224,43 @@ func (x *ListSellRequestsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSellRequestsRequest.ProtoReflect.Descriptor instead.
-func (*ListSellRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use TradeResponse.ProtoReflect.Descriptor instead.
+func (*TradeResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *ListSellRequestsRequest) GetSellerId() uint64 {
+func (x *TradeResponse) GetData() *TradeDetails {
 	if x != nil {
-		return x.SellerId
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-type DeleteSellRequestRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SellRequestId uint64                 `protobuf:"varint,1,opt,name=sell_request_id,json=sellRequestId,proto3" json:"sell_request_id,omitempty"` // Required
-	SellerId      uint64                 `protobuf:"varint,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`                  // Required - for authorization
+type UpdateFeatureGeometryRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	FeatureId uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	// Closed ring: the last coordinate must repeat the first. Only x and y are read.
+	Coordinates   []*Coordinate `protobuf:"bytes,2,rep,name=coordinates,proto3" json:"coordinates,omitempty"`
+	Reason        string        `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSellRequestRequest) Reset() {
-	*x = DeleteSellRequestRequest{}
-	mi := &file_features_proto_msgTypes[36]
+func (x *UpdateFeatureGeometryRequest) Reset() {
+	*x = UpdateFeatureGeometryRequest{}
+	mi := &file_features_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSellRequestRequest) String() string {
+func (x *UpdateFeatureGeometryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSellRequestRequest) ProtoMessage() {}
+func (*UpdateFeatureGeometryRequest) ProtoMessage() {}
 
-func (x *DeleteSellRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[36]
+func (x *UpdateFeatureGeometryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2330,55 +7271,54 @@ func (x *DeleteSellRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSellRequestRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSellRequestRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use UpdateFeatureGeometryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFeatureGeometryRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *DeleteSellRequestRequest) GetSellRequestId() uint64 {
+func (x *UpdateFeatureGeometryRequest) GetFeatureId() uint64 {
 	if x != nil {
-		return x.SellRequestId
+		return x.FeatureId
 	}
 	return 0
 }
 
-func (x *DeleteSellRequestRequest) GetSellerId() uint64 {
+func (x *UpdateFeatureGeometryRequest) GetCoordinates() []*Coordinate {
 	if x != nil {
-		return x.SellerId
+		return x.Coordinates
 	}
-	return 0
+	return nil
 }
 
-type SellRequestResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	SellerId           uint64                 `protobuf:"varint,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
-	FeatureId          uint64                 `protobuf:"varint,3,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
-	PricePsc           string                 `protobuf:"bytes,4,opt,name=price_psc,json=pricePsc,proto3" json:"price_psc,omitempty"`
-	PriceIrr           string                 `protobuf:"bytes,5,opt,name=price_irr,json=priceIrr,proto3" json:"price_irr,omitempty"`
-	Status             int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"` // 0 = open, 1 = closed
-	CreatedAt          string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	FeatureProperties  *FeatureProperties     `protobuf:"bytes,8,opt,name=feature_properties,json=featureProperties,proto3" json:"feature_properties,omitempty"`    // Eager loaded feature properties
-	FeatureCoordinates []*Coordinate          `protobuf:"bytes,9,rep,name=feature_coordinates,json=featureCoordinates,proto3" json:"feature_coordinates,omitempty"` // Eager loaded coordinates
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *UpdateFeatureGeometryRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (x *SellRequestResponse) Reset() {
-	*x = SellRequestResponse{}
-	mi := &file_features_proto_msgTypes[37]
+type ListGeometryVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeatureId     uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGeometryVersionsRequest) Reset() {
+	*x = ListGeometryVersionsRequest{}
+	mi := &file_features_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SellRequestResponse) String() string {
+func (x *ListGeometryVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SellRequestResponse) ProtoMessage() {}
+func (*ListGeometryVersionsRequest) ProtoMessage() {}
 
-func (x *SellRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[37]
+func (x *ListGeometryVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2389,142 +7329,148 @@ func (x *SellRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SellRequestResponse.ProtoReflect.Descriptor instead.
-func (*SellRequestResponse) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ListGeometryVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListGeometryVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *SellRequestResponse) GetId() uint64 {
+func (x *ListGeometryVersionsRequest) GetFeatureId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.FeatureId
 	}
 	return 0
 }
 
-func (x *SellRequestResponse) GetSellerId() uint64 {
-	if x != nil {
-		return x.SellerId
-	}
-	return 0
+type GeometryVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FeatureId     uint64                 `protobuf:"varint,2,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Coordinates   []*Coordinate          `protobuf:"bytes,4,rep,name=coordinates,proto3" json:"coordinates,omitempty"`
+	Area          string                 `protobuf:"bytes,5,opt,name=area,proto3" json:"area,omitempty"`                          // Polygon area in coordinate units, as string
+	EditedBy      uint64                 `protobuf:"varint,6,opt,name=edited_by,json=editedBy,proto3" json:"edited_by,omitempty"` // 0 for the geometry recorded before the first edit
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Date          string                 `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"` // Jalali format Y/m/d
+	Time          string                 `protobuf:"bytes,9,opt,name=time,proto3" json:"time,omitempty"` // Jalali format H:m:s
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SellRequestResponse) GetFeatureId() uint64 {
-	if x != nil {
-		return x.FeatureId
-	}
-	return 0
+func (x *GeometryVersion) Reset() {
+	*x = GeometryVersion{}
+	mi := &file_features_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SellRequestResponse) GetPricePsc() string {
-	if x != nil {
-		return x.PricePsc
-	}
-	return ""
+func (x *GeometryVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SellRequestResponse) GetPriceIrr() string {
+func (*GeometryVersion) ProtoMessage() {}
+
+func (x *GeometryVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[113]
 	if x != nil {
-		return x.PriceIrr
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SellRequestResponse) GetStatus() int32 {
+// Deprecated: Use GeometryVersion.ProtoReflect.Descriptor instead.
+func (*GeometryVersion) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GeometryVersion) GetId() uint64 {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
 	return 0
 }
 
-func (x *SellRequestResponse) GetCreatedAt() string {
+func (x *GeometryVersion) GetFeatureId() uint64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.FeatureId
 	}
-	return ""
+	return 0
 }
 
-func (x *SellRequestResponse) GetFeatureProperties() *FeatureProperties {
+func (x *GeometryVersion) GetVersion() int32 {
 	if x != nil {
-		return x.FeatureProperties
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-func (x *SellRequestResponse) GetFeatureCoordinates() []*Coordinate {
+func (x *GeometryVersion) GetCoordinates() []*Coordinate {
 	if x != nil {
-		return x.FeatureCoordinates
+		return x.Coordinates
 	}
 	return nil
 }
 
-type SellRequestsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SellRequests  []*SellRequestResponse `protobuf:"bytes,1,rep,name=sell_requests,json=sellRequests,proto3" json:"sell_requests,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SellRequestsResponse) Reset() {
-	*x = SellRequestsResponse{}
-	mi := &file_features_proto_msgTypes[38]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GeometryVersion) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
 }
 
-func (x *SellRequestsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GeometryVersion) GetEditedBy() uint64 {
+	if x != nil {
+		return x.EditedBy
+	}
+	return 0
 }
 
-func (*SellRequestsResponse) ProtoMessage() {}
-
-func (x *SellRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[38]
+func (x *GeometryVersion) GetReason() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Reason
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SellRequestsResponse.ProtoReflect.Descriptor instead.
-func (*SellRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{38}
+func (x *GeometryVersion) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
 }
 
-func (x *SellRequestsResponse) GetSellRequests() []*SellRequestResponse {
+func (x *GeometryVersion) GetTime() string {
 	if x != nil {
-		return x.SellRequests
+		return x.Time
 	}
-	return nil
+	return ""
 }
 
-type RequestGracePeriodRequest struct {
+type GeometryVersionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     uint64                 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	BuyerId       uint64                 `protobuf:"varint,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
-	GracePeriod   string                 `protobuf:"bytes,3,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"` // Deprecated - use UpdateGracePeriod instead
+	Data          *GeometryVersion       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RequestGracePeriodRequest) Reset() {
-	*x = RequestGracePeriodRequest{}
-	mi := &file_features_proto_msgTypes[39]
+func (x *GeometryVersionResponse) Reset() {
+	*x = GeometryVersionResponse{}
+	mi := &file_features_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RequestGracePeriodRequest) String() string {
+func (x *GeometryVersionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestGracePeriodRequest) ProtoMessage() {}
+func (*GeometryVersionResponse) ProtoMessage() {}
 
-func (x *RequestGracePeriodRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[39]
+func (x *GeometryVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2535,55 +7481,40 @@ func (x *RequestGracePeriodRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestGracePeriodRequest.ProtoReflect.Descriptor instead.
-func (*RequestGracePeriodRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{39}
-}
-
-func (x *RequestGracePeriodRequest) GetRequestId() uint64 {
-	if x != nil {
-		return x.RequestId
-	}
-	return 0
-}
-
-func (x *RequestGracePeriodRequest) GetBuyerId() uint64 {
-	if x != nil {
-		return x.BuyerId
-	}
-	return 0
+// Deprecated: Use GeometryVersionResponse.ProtoReflect.Descriptor instead.
+func (*GeometryVersionResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *RequestGracePeriodRequest) GetGracePeriod() string {
+func (x *GeometryVersionResponse) GetData() *GeometryVersion {
 	if x != nil {
-		return x.GracePeriod
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type GracePeriodResponse struct {
+type ListGeometryVersionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Approved      bool                   `protobuf:"varint,1,opt,name=approved,proto3" json:"approved,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*GeometryVersion     `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"` // Newest first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GracePeriodResponse) Reset() {
-	*x = GracePeriodResponse{}
-	mi := &file_features_proto_msgTypes[40]
+func (x *ListGeometryVersionsResponse) Reset() {
+	*x = ListGeometryVersionsResponse{}
+	mi := &file_features_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GracePeriodResponse) String() string {
+func (x *ListGeometryVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GracePeriodResponse) ProtoMessage() {}
+func (*ListGeometryVersionsResponse) ProtoMessage() {}
 
-func (x *GracePeriodResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[40]
+func (x *ListGeometryVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2594,49 +7525,41 @@ func (x *GracePeriodResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GracePeriodResponse.ProtoReflect.Descriptor instead.
-func (*GracePeriodResponse) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *GracePeriodResponse) GetApproved() bool {
-	if x != nil {
-		return x.Approved
-	}
-	return false
+// Deprecated: Use ListGeometryVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListGeometryVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *GracePeriodResponse) GetMessage() string {
+func (x *ListGeometryVersionsResponse) GetData() []*GeometryVersion {
 	if x != nil {
-		return x.Message
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type GetHourlyProfitsRequest struct {
+type ReserveFeatureRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	FeatureId     uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	BuyerId       uint64                 `protobuf:"varint,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHourlyProfitsRequest) Reset() {
-	*x = GetHourlyProfitsRequest{}
-	mi := &file_features_proto_msgTypes[41]
+func (x *ReserveFeatureRequest) Reset() {
+	*x = ReserveFeatureRequest{}
+	mi := &file_features_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHourlyProfitsRequest) String() string {
+func (x *ReserveFeatureRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHourlyProfitsRequest) ProtoMessage() {}
+func (*ReserveFeatureRequest) ProtoMessage() {}
 
-func (x *GetHourlyProfitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[41]
+func (x *ReserveFeatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2647,57 +7570,48 @@ func (x *GetHourlyProfitsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHourlyProfitsRequest.ProtoReflect.Descriptor instead.
-func (*GetHourlyProfitsRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{41}
-}
-
-func (x *GetHourlyProfitsRequest) GetUserId() uint64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use ReserveFeatureRequest.ProtoReflect.Descriptor instead.
+func (*ReserveFeatureRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *GetHourlyProfitsRequest) GetPage() int32 {
+func (x *ReserveFeatureRequest) GetFeatureId() uint64 {
 	if x != nil {
-		return x.Page
+		return x.FeatureId
 	}
 	return 0
 }
 
-func (x *GetHourlyProfitsRequest) GetPageSize() int32 {
+func (x *ReserveFeatureRequest) GetBuyerId() uint64 {
 	if x != nil {
-		return x.PageSize
+		return x.BuyerId
 	}
 	return 0
 }
 
-type HourlyProfitsResponse struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Profits             []*HourlyProfit        `protobuf:"bytes,1,rep,name=profits,proto3" json:"profits,omitempty"`
-	TotalMaskoniProfit  string                 `protobuf:"bytes,2,opt,name=total_maskoni_profit,json=totalMaskoniProfit,proto3" json:"total_maskoni_profit,omitempty"`
-	TotalTejariProfit   string                 `protobuf:"bytes,3,opt,name=total_tejari_profit,json=totalTejariProfit,proto3" json:"total_tejari_profit,omitempty"`
-	TotalAmozeshiProfit string                 `protobuf:"bytes,4,opt,name=total_amozeshi_profit,json=totalAmozeshiProfit,proto3" json:"total_amozeshi_profit,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type FeatureReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeatureId     uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	BuyerId       uint64                 `protobuf:"varint,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HourlyProfitsResponse) Reset() {
-	*x = HourlyProfitsResponse{}
-	mi := &file_features_proto_msgTypes[42]
+func (x *FeatureReservationRequest) Reset() {
+	*x = FeatureReservationRequest{}
+	mi := &file_features_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HourlyProfitsResponse) String() string {
+func (x *FeatureReservationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HourlyProfitsResponse) ProtoMessage() {}
+func (*FeatureReservationRequest) ProtoMessage() {}
 
-func (x *HourlyProfitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[42]
+func (x *FeatureReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2708,67 +7622,58 @@ func (x *HourlyProfitsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HourlyProfitsResponse.ProtoReflect.Descriptor instead.
-func (*HourlyProfitsResponse) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *HourlyProfitsResponse) GetProfits() []*HourlyProfit {
-	if x != nil {
-		return x.Profits
-	}
-	return nil
-}
-
-func (x *HourlyProfitsResponse) GetTotalMaskoniProfit() string {
-	if x != nil {
-		return x.TotalMaskoniProfit
-	}
-	return ""
+// Deprecated: Use FeatureReservationRequest.ProtoReflect.Descriptor instead.
+func (*FeatureReservationRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *HourlyProfitsResponse) GetTotalTejariProfit() string {
+func (x *FeatureReservationRequest) GetFeatureId() uint64 {
 	if x != nil {
-		return x.TotalTejariProfit
+		return x.FeatureId
 	}
-	return ""
+	return 0
 }
 
-func (x *HourlyProfitsResponse) GetTotalAmozeshiProfit() string {
+func (x *FeatureReservationRequest) GetBuyerId() uint64 {
 	if x != nil {
-		return x.TotalAmozeshiProfit
+		return x.BuyerId
 	}
-	return ""
-}
-
-type HourlyProfit struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	FeatureId     uint64                 `protobuf:"varint,2,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
-	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Asset         string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`                       // color: yellow, red, blue
-	Amount        string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`                     // formatted as string
-	DeadLine      string                 `protobuf:"bytes,6,opt,name=dead_line,json=deadLine,proto3" json:"dead_line,omitempty"` // timestamp
-	IsActive      bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return 0
 }
 
-func (x *HourlyProfit) Reset() {
-	*x = HourlyProfit{}
-	mi := &file_features_proto_msgTypes[43]
+// FeatureReservation carries the sale amounts fixed when the feature was
+// reserved, using the same fees as a direct purchase
+type FeatureReservation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	FeatureId        uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	BuyerId          uint64                 `protobuf:"varint,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	SellerId         uint64                 `protobuf:"varint,3,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	BuyerChargePsc   float64                `protobuf:"fixed64,4,opt,name=buyer_charge_psc,json=buyerChargePsc,proto3" json:"buyer_charge_psc,omitempty"` // Price plus buyer fee
+	BuyerChargeIrr   float64                `protobuf:"fixed64,5,opt,name=buyer_charge_irr,json=buyerChargeIrr,proto3" json:"buyer_charge_irr,omitempty"`
+	SellerPaymentPsc float64                `protobuf:"fixed64,6,opt,name=seller_payment_psc,json=sellerPaymentPsc,proto3" json:"seller_payment_psc,omitempty"` // Price minus seller fee
+	SellerPaymentIrr float64                `protobuf:"fixed64,7,opt,name=seller_payment_irr,json=sellerPaymentIrr,proto3" json:"seller_payment_irr,omitempty"`
+	PlatformFeePsc   float64                `protobuf:"fixed64,8,opt,name=platform_fee_psc,json=platformFeePsc,proto3" json:"platform_fee_psc,omitempty"`
+	PlatformFeeIrr   float64                `protobuf:"fixed64,9,opt,name=platform_fee_irr,json=platformFeeIrr,proto3" json:"platform_fee_irr,omitempty"`
+	PlatformUserId   uint64                 `protobuf:"varint,10,opt,name=platform_user_id,json=platformUserId,proto3" json:"platform_user_id,omitempty"` // Receives the platform fee
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FeatureReservation) Reset() {
+	*x = FeatureReservation{}
+	mi := &file_features_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HourlyProfit) String() string {
+func (x *FeatureReservation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HourlyProfit) ProtoMessage() {}
+func (*FeatureReservation) ProtoMessage() {}
 
-func (x *HourlyProfit) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[43]
+func (x *FeatureReservation) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2779,83 +7684,103 @@ func (x *HourlyProfit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HourlyProfit.ProtoReflect.Descriptor instead.
-func (*HourlyProfit) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use FeatureReservation.ProtoReflect.Descriptor instead.
+func (*FeatureReservation) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *HourlyProfit) GetId() uint64 {
+func (x *FeatureReservation) GetFeatureId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.FeatureId
 	}
 	return 0
 }
 
-func (x *HourlyProfit) GetFeatureId() uint64 {
+func (x *FeatureReservation) GetBuyerId() uint64 {
 	if x != nil {
-		return x.FeatureId
+		return x.BuyerId
 	}
 	return 0
 }
 
-func (x *HourlyProfit) GetUserId() uint64 {
+func (x *FeatureReservation) GetSellerId() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.SellerId
 	}
 	return 0
 }
 
-func (x *HourlyProfit) GetAsset() string {
+func (x *FeatureReservation) GetBuyerChargePsc() float64 {
 	if x != nil {
-		return x.Asset
+		return x.BuyerChargePsc
 	}
-	return ""
+	return 0
 }
 
-func (x *HourlyProfit) GetAmount() string {
+func (x *FeatureReservation) GetBuyerChargeIrr() float64 {
 	if x != nil {
-		return x.Amount
+		return x.BuyerChargeIrr
 	}
-	return ""
+	return 0
 }
 
-func (x *HourlyProfit) GetDeadLine() string {
+func (x *FeatureReservation) GetSellerPaymentPsc() float64 {
 	if x != nil {
-		return x.DeadLine
+		return x.SellerPaymentPsc
 	}
-	return ""
+	return 0
 }
 
-func (x *HourlyProfit) GetIsActive() bool {
+func (x *FeatureReservation) GetSellerPaymentIrr() float64 {
 	if x != nil {
-		return x.IsActive
+		return x.SellerPaymentIrr
 	}
-	return false
+	return 0
 }
 
-type GetSingleProfitRequest struct {
+func (x *FeatureReservation) GetPlatformFeePsc() float64 {
+	if x != nil {
+		return x.PlatformFeePsc
+	}
+	return 0
+}
+
+func (x *FeatureReservation) GetPlatformFeeIrr() float64 {
+	if x != nil {
+		return x.PlatformFeeIrr
+	}
+	return 0
+}
+
+func (x *FeatureReservation) GetPlatformUserId() uint64 {
+	if x != nil {
+		return x.PlatformUserId
+	}
+	return 0
+}
+
+type CompleteReservedPurchaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProfitId      uint64                 `protobuf:"varint,1,opt,name=profit_id,json=profitId,proto3" json:"profit_id,omitempty"`
-	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TradeId       uint64                 `protobuf:"varint,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSingleProfitRequest) Reset() {
-	*x = GetSingleProfitRequest{}
-	mi := &file_features_proto_msgTypes[44]
+func (x *CompleteReservedPurchaseResponse) Reset() {
+	*x = CompleteReservedPurchaseResponse{}
+	mi := &file_features_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSingleProfitRequest) String() string {
+func (x *CompleteReservedPurchaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSingleProfitRequest) ProtoMessage() {}
+func (*CompleteReservedPurchaseResponse) ProtoMessage() {}
 
-func (x *GetSingleProfitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[44]
+func (x *CompleteReservedPurchaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2866,48 +7791,41 @@ func (x *GetSingleProfitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSingleProfitRequest.ProtoReflect.Descriptor instead.
-func (*GetSingleProfitRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *GetSingleProfitRequest) GetProfitId() uint64 {
-	if x != nil {
-		return x.ProfitId
-	}
-	return 0
+// Deprecated: Use CompleteReservedPurchaseResponse.ProtoReflect.Descriptor instead.
+func (*CompleteReservedPurchaseResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *GetSingleProfitRequest) GetUserId() uint64 {
+func (x *CompleteReservedPurchaseResponse) GetTradeId() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.TradeId
 	}
 	return 0
 }
 
-type HourlyProfitResponse struct {
+// ListFeatureImagesRequest - GET /api/features/{feature}/images
+type ListFeatureImagesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profit        *HourlyProfit          `protobuf:"bytes,1,opt,name=profit,proto3" json:"profit,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	FeatureId     uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HourlyProfitResponse) Reset() {
-	*x = HourlyProfitResponse{}
-	mi := &file_features_proto_msgTypes[45]
+func (x *ListFeatureImagesRequest) Reset() {
+	*x = ListFeatureImagesRequest{}
+	mi := &file_features_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HourlyProfitResponse) String() string {
+func (x *ListFeatureImagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HourlyProfitResponse) ProtoMessage() {}
+func (*ListFeatureImagesRequest) ProtoMessage() {}
 
-func (x *HourlyProfitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[45]
+func (x *ListFeatureImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2918,48 +7836,42 @@ func (x *HourlyProfitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HourlyProfitResponse.ProtoReflect.Descriptor instead.
-func (*HourlyProfitResponse) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *HourlyProfitResponse) GetProfit() *HourlyProfit {
-	if x != nil {
-		return x.Profit
-	}
-	return nil
+// Deprecated: Use ListFeatureImagesRequest.ProtoReflect.Descriptor instead.
+func (*ListFeatureImagesRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *HourlyProfitResponse) GetSuccess() bool {
+func (x *ListFeatureImagesRequest) GetFeatureId() uint64 {
 	if x != nil {
-		return x.Success
+		return x.FeatureId
 	}
-	return false
+	return 0
 }
 
-type GetProfitsByApplicationRequest struct {
+type ImageUpload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Karbari       string                 `protobuf:"bytes,2,opt,name=karbari,proto3" json:"karbari,omitempty"` // m, t, a
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // JPEG or PNG, at most 1024 KB
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetProfitsByApplicationRequest) Reset() {
-	*x = GetProfitsByApplicationRequest{}
-	mi := &file_features_proto_msgTypes[46]
+}
+
+func (x *ImageUpload) Reset() {
+	*x = ImageUpload{}
+	mi := &file_features_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfitsByApplicationRequest) String() string {
+func (x *ImageUpload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfitsByApplicationRequest) ProtoMessage() {}
+func (*ImageUpload) ProtoMessage() {}
 
-func (x *GetProfitsByApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[46]
+func (x *ImageUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2970,48 +7882,58 @@ func (x *GetProfitsByApplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfitsByApplicationRequest.ProtoReflect.Descriptor instead.
-func (*GetProfitsByApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use ImageUpload.ProtoReflect.Descriptor instead.
+func (*ImageUpload) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *GetProfitsByApplicationRequest) GetUserId() uint64 {
+func (x *ImageUpload) GetData() []byte {
 	if x != nil {
-		return x.UserId
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *GetProfitsByApplicationRequest) GetKarbari() string {
+func (x *ImageUpload) GetFilename() string {
 	if x != nil {
-		return x.Karbari
+		return x.Filename
 	}
 	return ""
 }
 
-type ProfitsByApplicationResponse struct {
+func (x *ImageUpload) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// AttachFeatureImagesRequest - POST /api/features/{feature}/images
+// Images are appended to the end of the gallery. The first image of an empty
+// gallery becomes its cover.
+type AttachFeatureImagesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TotalAmount   string                 `protobuf:"bytes,1,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	FeatureId     uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	Images        []*ImageUpload         `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProfitsByApplicationResponse) Reset() {
-	*x = ProfitsByApplicationResponse{}
-	mi := &file_features_proto_msgTypes[47]
+func (x *AttachFeatureImagesRequest) Reset() {
+	*x = AttachFeatureImagesRequest{}
+	mi := &file_features_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProfitsByApplicationResponse) String() string {
+func (x *AttachFeatureImagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfitsByApplicationResponse) ProtoMessage() {}
+func (*AttachFeatureImagesRequest) ProtoMessage() {}
 
-func (x *ProfitsByApplicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[47]
+func (x *AttachFeatureImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3022,48 +7944,50 @@ func (x *ProfitsByApplicationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfitsByApplicationResponse.ProtoReflect.Descriptor instead.
-func (*ProfitsByApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use AttachFeatureImagesRequest.ProtoReflect.Descriptor instead.
+func (*AttachFeatureImagesRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *ProfitsByApplicationResponse) GetTotalAmount() string {
+func (x *AttachFeatureImagesRequest) GetFeatureId() uint64 {
 	if x != nil {
-		return x.TotalAmount
+		return x.FeatureId
 	}
-	return ""
+	return 0
 }
 
-func (x *ProfitsByApplicationResponse) GetSuccess() bool {
+func (x *AttachFeatureImagesRequest) GetImages() []*ImageUpload {
 	if x != nil {
-		return x.Success
+		return x.Images
 	}
-	return false
+	return nil
 }
 
-type GetBuildPackageRequest struct {
+// RemoveFeatureImageRequest - DELETE /api/features/{feature}/images/{image}
+// Removing the cover makes the next image in order the cover.
+type RemoveFeatureImageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FeatureId     uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	ImageId       uint64                 `protobuf:"varint,2,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBuildPackageRequest) Reset() {
-	*x = GetBuildPackageRequest{}
-	mi := &file_features_proto_msgTypes[48]
+func (x *RemoveFeatureImageRequest) Reset() {
+	*x = RemoveFeatureImageRequest{}
+	mi := &file_features_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBuildPackageRequest) String() string {
+func (x *RemoveFeatureImageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBuildPackageRequest) ProtoMessage() {}
+func (*RemoveFeatureImageRequest) ProtoMessage() {}
 
-func (x *GetBuildPackageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[48]
+func (x *RemoveFeatureImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3074,48 +7998,49 @@ func (x *GetBuildPackageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBuildPackageRequest.ProtoReflect.Descriptor instead.
-func (*GetBuildPackageRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use RemoveFeatureImageRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFeatureImageRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *GetBuildPackageRequest) GetFeatureId() uint64 {
+func (x *RemoveFeatureImageRequest) GetFeatureId() uint64 {
 	if x != nil {
 		return x.FeatureId
 	}
 	return 0
 }
 
-func (x *GetBuildPackageRequest) GetPage() int32 {
+func (x *RemoveFeatureImageRequest) GetImageId() uint64 {
 	if x != nil {
-		return x.Page
+		return x.ImageId
 	}
 	return 0
 }
 
-type BuildPackageResponse struct {
+// ReorderFeatureImagesRequest - PUT /api/features/{feature}/images/order
+type ReorderFeatureImagesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Models        []*BuildingModel       `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
-	Coordinates   []string               `protobuf:"bytes,2,rep,name=coordinates,proto3" json:"coordinates,omitempty"`
+	FeatureId     uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	ImageIds      []uint64               `protobuf:"varint,2,rep,packed,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"` // Every image of the feature exactly once, in the new order
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildPackageResponse) Reset() {
-	*x = BuildPackageResponse{}
-	mi := &file_features_proto_msgTypes[49]
+func (x *ReorderFeatureImagesRequest) Reset() {
+	*x = ReorderFeatureImagesRequest{}
+	mi := &file_features_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildPackageResponse) String() string {
+func (x *ReorderFeatureImagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildPackageResponse) ProtoMessage() {}
+func (*ReorderFeatureImagesRequest) ProtoMessage() {}
 
-func (x *BuildPackageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[49]
+func (x *ReorderFeatureImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3126,54 +8051,49 @@ func (x *BuildPackageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildPackageResponse.ProtoReflect.Descriptor instead.
-func (*BuildPackageResponse) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use ReorderFeatureImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderFeatureImagesRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *BuildPackageResponse) GetModels() []*BuildingModel {
+func (x *ReorderFeatureImagesRequest) GetFeatureId() uint64 {
 	if x != nil {
-		return x.Models
+		return x.FeatureId
 	}
-	return nil
+	return 0
 }
 
-func (x *BuildPackageResponse) GetCoordinates() []string {
+func (x *ReorderFeatureImagesRequest) GetImageIds() []uint64 {
 	if x != nil {
-		return x.Coordinates
+		return x.ImageIds
 	}
 	return nil
 }
 
-type BuildingModel struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Id                   uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ModelId              string                 `protobuf:"bytes,2,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
-	Name                 string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Sku                  string                 `protobuf:"bytes,4,opt,name=sku,proto3" json:"sku,omitempty"`
-	Images               string                 `protobuf:"bytes,5,opt,name=images,proto3" json:"images,omitempty"`         // JSON array
-	Attributes           string                 `protobuf:"bytes,6,opt,name=attributes,proto3" json:"attributes,omitempty"` // JSON array
-	File                 string                 `protobuf:"bytes,7,opt,name=file,proto3" json:"file,omitempty"`             // JSON object
-	RequiredSatisfaction string                 `protobuf:"bytes,8,opt,name=required_satisfaction,json=requiredSatisfaction,proto3" json:"required_satisfaction,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// SetFeatureCoverImageRequest - PUT /api/features/{feature}/images/{image}/cover
+type SetFeatureCoverImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeatureId     uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	ImageId       uint64                 `protobuf:"varint,2,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildingModel) Reset() {
-	*x = BuildingModel{}
-	mi := &file_features_proto_msgTypes[50]
+func (x *SetFeatureCoverImageRequest) Reset() {
+	*x = SetFeatureCoverImageRequest{}
+	mi := &file_features_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildingModel) String() string {
+func (x *SetFeatureCoverImageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildingModel) ProtoMessage() {}
+func (*SetFeatureCoverImageRequest) ProtoMessage() {}
 
-func (x *BuildingModel) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[50]
+func (x *SetFeatureCoverImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3184,94 +8104,93 @@ func (x *BuildingModel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildingModel.ProtoReflect.Descriptor instead.
-func (*BuildingModel) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use SetFeatureCoverImageRequest.ProtoReflect.Descriptor instead.
+func (*SetFeatureCoverImageRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *BuildingModel) GetId() uint64 {
+func (x *SetFeatureCoverImageRequest) GetFeatureId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.FeatureId
 	}
 	return 0
 }
 
-func (x *BuildingModel) GetModelId() string {
+func (x *SetFeatureCoverImageRequest) GetImageId() uint64 {
 	if x != nil {
-		return x.ModelId
+		return x.ImageId
 	}
-	return ""
+	return 0
 }
 
-func (x *BuildingModel) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type FeatureImagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Image               `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"` // Gallery order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildingModel) GetSku() string {
-	if x != nil {
-		return x.Sku
-	}
-	return ""
+func (x *FeatureImagesResponse) Reset() {
+	*x = FeatureImagesResponse{}
+	mi := &file_features_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildingModel) GetImages() string {
-	if x != nil {
-		return x.Images
-	}
-	return ""
+func (x *FeatureImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *BuildingModel) GetAttributes() string {
+func (*FeatureImagesResponse) ProtoMessage() {}
+
+func (x *FeatureImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[126]
 	if x != nil {
-		return x.Attributes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *BuildingModel) GetFile() string {
-	if x != nil {
-		return x.File
-	}
-	return ""
+// Deprecated: Use FeatureImagesResponse.ProtoReflect.Descriptor instead.
+func (*FeatureImagesResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *BuildingModel) GetRequiredSatisfaction() string {
+func (x *FeatureImagesResponse) GetData() []*Image {
 	if x != nil {
-		return x.RequiredSatisfaction
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type BuildFeatureRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	FeatureId            uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
-	BuildingModelId      uint64                 `protobuf:"varint,2,opt,name=building_model_id,json=buildingModelId,proto3" json:"building_model_id,omitempty"`
-	LaunchedSatisfaction string                 `protobuf:"bytes,3,opt,name=launched_satisfaction,json=launchedSatisfaction,proto3" json:"launched_satisfaction,omitempty"`
-	Rotation             string                 `protobuf:"bytes,4,opt,name=rotation,proto3" json:"rotation,omitempty"`
-	Position             string                 `protobuf:"bytes,5,opt,name=position,proto3" json:"position,omitempty"`
-	Information          *BuildingInformation   `protobuf:"bytes,6,opt,name=information,proto3" json:"information,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// GetTradeReceiptRequest - GET /api/trades/{trade}/receipt
+// Only the buyer and the seller of the trade can get its receipt.
+type GetTradeReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TradeId       uint64                 `protobuf:"varint,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildFeatureRequest) Reset() {
-	*x = BuildFeatureRequest{}
-	mi := &file_features_proto_msgTypes[51]
+func (x *GetTradeReceiptRequest) Reset() {
+	*x = GetTradeReceiptRequest{}
+	mi := &file_features_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildFeatureRequest) String() string {
+func (x *GetTradeReceiptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildFeatureRequest) ProtoMessage() {}
+func (*GetTradeReceiptRequest) ProtoMessage() {}
 
-func (x *BuildFeatureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[51]
+func (x *GetTradeReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3282,80 +8201,99 @@ func (x *BuildFeatureRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildFeatureRequest.ProtoReflect.Descriptor instead.
-func (*BuildFeatureRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use GetTradeReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetTradeReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *BuildFeatureRequest) GetFeatureId() uint64 {
+func (x *GetTradeReceiptRequest) GetTradeId() uint64 {
 	if x != nil {
-		return x.FeatureId
+		return x.TradeId
 	}
 	return 0
 }
 
-func (x *BuildFeatureRequest) GetBuildingModelId() uint64 {
-	if x != nil {
-		return x.BuildingModelId
-	}
-	return 0
+// VerifyTradeReceiptRequest - GET /api/trades/verify/{code}
+// Does not require authentication. Case, spaces and dashes are ignored.
+type VerifyTradeReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildFeatureRequest) GetLaunchedSatisfaction() string {
-	if x != nil {
-		return x.LaunchedSatisfaction
-	}
-	return ""
+func (x *VerifyTradeReceiptRequest) Reset() {
+	*x = VerifyTradeReceiptRequest{}
+	mi := &file_features_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildFeatureRequest) GetRotation() string {
-	if x != nil {
-		return x.Rotation
-	}
-	return ""
+func (x *VerifyTradeReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *BuildFeatureRequest) GetPosition() string {
+func (*VerifyTradeReceiptRequest) ProtoMessage() {}
+
+func (x *VerifyTradeReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[128]
 	if x != nil {
-		return x.Position
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *BuildFeatureRequest) GetInformation() *BuildingInformation {
+// Deprecated: Use VerifyTradeReceiptRequest.ProtoReflect.Descriptor instead.
+func (*VerifyTradeReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *VerifyTradeReceiptRequest) GetCode() string {
 	if x != nil {
-		return x.Information
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-type BuildingInformation struct {
+// TradeReceipt holds the facts of a trade that may be shown to anyone with
+// its code. Participants are identified by their public citizen codes.
+type TradeReceipt struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActivityLine  string                 `protobuf:"bytes,1,opt,name=activity_line,json=activityLine,proto3" json:"activity_line,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	PostalCode    string                 `protobuf:"bytes,4,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
-	Website       string                 `protobuf:"bytes,5,opt,name=website,proto3" json:"website,omitempty"`
-	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // Verification code, e.g. "7KQ2M-XH93A"
+	TradeId       uint64                 `protobuf:"varint,2,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	FeatureId     uint64                 `protobuf:"varint,3,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	PropertiesId  string                 `protobuf:"bytes,4,opt,name=properties_id,json=propertiesId,proto3" json:"properties_id,omitempty"` // Feature code shown on the map
+	BuyerCode     string                 `protobuf:"bytes,5,opt,name=buyer_code,json=buyerCode,proto3" json:"buyer_code,omitempty"`
+	SellerCode    string                 `protobuf:"bytes,6,opt,name=seller_code,json=sellerCode,proto3" json:"seller_code,omitempty"`
+	PscAmount     float64                `protobuf:"fixed64,7,opt,name=psc_amount,json=pscAmount,proto3" json:"psc_amount,omitempty"`
+	IrrAmount     float64                `protobuf:"fixed64,8,opt,name=irr_amount,json=irrAmount,proto3" json:"irr_amount,omitempty"`
+	Date          string                 `protobuf:"bytes,9,opt,name=date,proto3" json:"date,omitempty"`                                // Jalali format Y/m/d of the trade
+	Time          string                 `protobuf:"bytes,10,opt,name=time,proto3" json:"time,omitempty"`                               // Jalali format H:m:s of the trade
+	Status        string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`                           // "completed", or "refunded" after a dispute reversed the trade
+	RefundedAt    string                 `protobuf:"bytes,12,opt,name=refunded_at,json=refundedAt,proto3" json:"refunded_at,omitempty"` // Jalali Y/m/d H:m:s, empty unless refunded
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildingInformation) Reset() {
-	*x = BuildingInformation{}
-	mi := &file_features_proto_msgTypes[52]
+func (x *TradeReceipt) Reset() {
+	*x = TradeReceipt{}
+	mi := &file_features_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildingInformation) String() string {
+func (x *TradeReceipt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildingInformation) ProtoMessage() {}
+func (*TradeReceipt) ProtoMessage() {}
 
-func (x *BuildingInformation) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[52]
+func (x *TradeReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3366,76 +8304,117 @@ func (x *BuildingInformation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildingInformation.ProtoReflect.Descriptor instead.
-func (*BuildingInformation) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use TradeReceipt.ProtoReflect.Descriptor instead.
+func (*TradeReceipt) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *TradeReceipt) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *TradeReceipt) GetTradeId() uint64 {
+	if x != nil {
+		return x.TradeId
+	}
+	return 0
+}
+
+func (x *TradeReceipt) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+func (x *TradeReceipt) GetPropertiesId() string {
+	if x != nil {
+		return x.PropertiesId
+	}
+	return ""
 }
 
-func (x *BuildingInformation) GetActivityLine() string {
+func (x *TradeReceipt) GetBuyerCode() string {
 	if x != nil {
-		return x.ActivityLine
+		return x.BuyerCode
 	}
 	return ""
 }
 
-func (x *BuildingInformation) GetName() string {
+func (x *TradeReceipt) GetSellerCode() string {
 	if x != nil {
-		return x.Name
+		return x.SellerCode
 	}
 	return ""
 }
 
-func (x *BuildingInformation) GetAddress() string {
+func (x *TradeReceipt) GetPscAmount() float64 {
 	if x != nil {
-		return x.Address
+		return x.PscAmount
+	}
+	return 0
+}
+
+func (x *TradeReceipt) GetIrrAmount() float64 {
+	if x != nil {
+		return x.IrrAmount
+	}
+	return 0
+}
+
+func (x *TradeReceipt) GetDate() string {
+	if x != nil {
+		return x.Date
 	}
 	return ""
 }
 
-func (x *BuildingInformation) GetPostalCode() string {
+func (x *TradeReceipt) GetTime() string {
 	if x != nil {
-		return x.PostalCode
+		return x.Time
 	}
 	return ""
 }
 
-func (x *BuildingInformation) GetWebsite() string {
+func (x *TradeReceipt) GetStatus() string {
 	if x != nil {
-		return x.Website
+		return x.Status
 	}
 	return ""
 }
 
-func (x *BuildingInformation) GetDescription() string {
+func (x *TradeReceipt) GetRefundedAt() string {
 	if x != nil {
-		return x.Description
+		return x.RefundedAt
 	}
 	return ""
 }
 
-type BuildFeatureResponse struct {
+type TradeReceiptResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *TradeReceipt          `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildFeatureResponse) Reset() {
-	*x = BuildFeatureResponse{}
-	mi := &file_features_proto_msgTypes[53]
+func (x *TradeReceiptResponse) Reset() {
+	*x = TradeReceiptResponse{}
+	mi := &file_features_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildFeatureResponse) String() string {
+func (x *TradeReceiptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildFeatureResponse) ProtoMessage() {}
+func (*TradeReceiptResponse) ProtoMessage() {}
 
-func (x *BuildFeatureResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[53]
+func (x *TradeReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3446,47 +8425,42 @@ func (x *BuildFeatureResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildFeatureResponse.ProtoReflect.Descriptor instead.
-func (*BuildFeatureResponse) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{53}
-}
-
-func (x *BuildFeatureResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use TradeReceiptResponse.ProtoReflect.Descriptor instead.
+func (*TradeReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *BuildFeatureResponse) GetMessage() string {
+func (x *TradeReceiptResponse) GetData() *TradeReceipt {
 	if x != nil {
-		return x.Message
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type GetBuildingsRequest struct {
+// GetAnnualTradeSummaryRequest - GET /api/trades/annual-summary
+type GetAnnualTradeSummaryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FeatureId     uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"` // Jalali year, e.g. 1403. Default the current year
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBuildingsRequest) Reset() {
-	*x = GetBuildingsRequest{}
-	mi := &file_features_proto_msgTypes[54]
+func (x *GetAnnualTradeSummaryRequest) Reset() {
+	*x = GetAnnualTradeSummaryRequest{}
+	mi := &file_features_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBuildingsRequest) String() string {
+func (x *GetAnnualTradeSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBuildingsRequest) ProtoMessage() {}
+func (*GetAnnualTradeSummaryRequest) ProtoMessage() {}
 
-func (x *GetBuildingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[54]
+func (x *GetAnnualTradeSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3497,40 +8471,59 @@ func (x *GetBuildingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBuildingsRequest.ProtoReflect.Descriptor instead.
-func (*GetBuildingsRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use GetAnnualTradeSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetAnnualTradeSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *GetBuildingsRequest) GetFeatureId() uint64 {
+func (x *GetAnnualTradeSummaryRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.FeatureId
+		return x.UserId
 	}
 	return 0
 }
 
-type BuildingsResponse struct {
+func (x *GetAnnualTradeSummaryRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+// AnnualTrade is a trade of the year from the user's side
+type AnnualTrade struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Buildings     []*Building            `protobuf:"bytes,1,rep,name=buildings,proto3" json:"buildings,omitempty"`
+	TradeId       uint64                 `protobuf:"varint,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	FeatureId     uint64                 `protobuf:"varint,2,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	PropertiesId  string                 `protobuf:"bytes,3,opt,name=properties_id,json=propertiesId,proto3" json:"properties_id,omitempty"` // Feature code shown on the map
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`                                     // "buyer" or "seller"
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                 // "completed", or "refunded" after a dispute reversed the trade
+	PricePsc      string                 `protobuf:"bytes,6,opt,name=price_psc,json=pricePsc,proto3" json:"price_psc,omitempty"`
+	PriceIrr      string                 `protobuf:"bytes,7,opt,name=price_irr,json=priceIrr,proto3" json:"price_irr,omitempty"`
+	FeePsc        string                 `protobuf:"bytes,8,opt,name=fee_psc,json=feePsc,proto3" json:"fee_psc,omitempty"` // The user's share of the marketplace fee
+	FeeIrr        string                 `protobuf:"bytes,9,opt,name=fee_irr,json=feeIrr,proto3" json:"fee_irr,omitempty"`
+	ReceiptCode   string                 `protobuf:"bytes,10,opt,name=receipt_code,json=receiptCode,proto3" json:"receipt_code,omitempty"` // Verification code of the trade receipt
+	Date          string                 `protobuf:"bytes,11,opt,name=date,proto3" json:"date,omitempty"`                                  // Jalali format Y/m/d
+	Time          string                 `protobuf:"bytes,12,opt,name=time,proto3" json:"time,omitempty"`                                  // H:m:s
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildingsResponse) Reset() {
-	*x = BuildingsResponse{}
-	mi := &file_features_proto_msgTypes[55]
+func (x *AnnualTrade) Reset() {
+	*x = AnnualTrade{}
+	mi := &file_features_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildingsResponse) String() string {
+func (x *AnnualTrade) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildingsResponse) ProtoMessage() {}
+func (*AnnualTrade) ProtoMessage() {}
 
-func (x *BuildingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[55]
+func (x *AnnualTrade) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3541,48 +8534,127 @@ func (x *BuildingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildingsResponse.ProtoReflect.Descriptor instead.
-func (*BuildingsResponse) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use AnnualTrade.ProtoReflect.Descriptor instead.
+func (*AnnualTrade) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *BuildingsResponse) GetBuildings() []*Building {
+func (x *AnnualTrade) GetTradeId() uint64 {
 	if x != nil {
-		return x.Buildings
+		return x.TradeId
 	}
-	return nil
+	return 0
 }
 
-type Building struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Id                    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Model                 *BuildingModel         `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
-	ConstructionStartDate string                 `protobuf:"bytes,3,opt,name=construction_start_date,json=constructionStartDate,proto3" json:"construction_start_date,omitempty"`
-	ConstructionEndDate   string                 `protobuf:"bytes,4,opt,name=construction_end_date,json=constructionEndDate,proto3" json:"construction_end_date,omitempty"`
-	LaunchedSatisfaction  string                 `protobuf:"bytes,5,opt,name=launched_satisfaction,json=launchedSatisfaction,proto3" json:"launched_satisfaction,omitempty"`
-	Rotation              string                 `protobuf:"bytes,6,opt,name=rotation,proto3" json:"rotation,omitempty"`
-	Position              string                 `protobuf:"bytes,7,opt,name=position,proto3" json:"position,omitempty"`
-	BubbleDiameter        string                 `protobuf:"bytes,8,opt,name=bubble_diameter,json=bubbleDiameter,proto3" json:"bubble_diameter,omitempty"`
-	Information           string                 `protobuf:"bytes,9,opt,name=information,proto3" json:"information,omitempty"` // JSON
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *AnnualTrade) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
 }
 
-func (x *Building) Reset() {
-	*x = Building{}
-	mi := &file_features_proto_msgTypes[56]
+func (x *AnnualTrade) GetPropertiesId() string {
+	if x != nil {
+		return x.PropertiesId
+	}
+	return ""
+}
+
+func (x *AnnualTrade) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AnnualTrade) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AnnualTrade) GetPricePsc() string {
+	if x != nil {
+		return x.PricePsc
+	}
+	return ""
+}
+
+func (x *AnnualTrade) GetPriceIrr() string {
+	if x != nil {
+		return x.PriceIrr
+	}
+	return ""
+}
+
+func (x *AnnualTrade) GetFeePsc() string {
+	if x != nil {
+		return x.FeePsc
+	}
+	return ""
+}
+
+func (x *AnnualTrade) GetFeeIrr() string {
+	if x != nil {
+		return x.FeeIrr
+	}
+	return ""
+}
+
+func (x *AnnualTrade) GetReceiptCode() string {
+	if x != nil {
+		return x.ReceiptCode
+	}
+	return ""
+}
+
+func (x *AnnualTrade) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AnnualTrade) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+// AnnualTradeTotals counts completed trades only
+type AnnualTradeTotals struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoughtCount   int32                  `protobuf:"varint,1,opt,name=bought_count,json=boughtCount,proto3" json:"bought_count,omitempty"`
+	BoughtPsc     string                 `protobuf:"bytes,2,opt,name=bought_psc,json=boughtPsc,proto3" json:"bought_psc,omitempty"` // Prices paid, without fees
+	BoughtIrr     string                 `protobuf:"bytes,3,opt,name=bought_irr,json=boughtIrr,proto3" json:"bought_irr,omitempty"`
+	SoldCount     int32                  `protobuf:"varint,4,opt,name=sold_count,json=soldCount,proto3" json:"sold_count,omitempty"`
+	SoldPsc       string                 `protobuf:"bytes,5,opt,name=sold_psc,json=soldPsc,proto3" json:"sold_psc,omitempty"` // Prices received, without fees
+	SoldIrr       string                 `protobuf:"bytes,6,opt,name=sold_irr,json=soldIrr,proto3" json:"sold_irr,omitempty"`
+	FeesPsc       string                 `protobuf:"bytes,7,opt,name=fees_psc,json=feesPsc,proto3" json:"fees_psc,omitempty"` // Fees paid as buyer and as seller
+	FeesIrr       string                 `protobuf:"bytes,8,opt,name=fees_irr,json=feesIrr,proto3" json:"fees_irr,omitempty"`
+	NetPsc        string                 `protobuf:"bytes,9,opt,name=net_psc,json=netPsc,proto3" json:"net_psc,omitempty"` // sold - bought - fees
+	NetIrr        string                 `protobuf:"bytes,10,opt,name=net_irr,json=netIrr,proto3" json:"net_irr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnualTradeTotals) Reset() {
+	*x = AnnualTradeTotals{}
+	mi := &file_features_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Building) String() string {
+func (x *AnnualTradeTotals) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Building) ProtoMessage() {}
+func (*AnnualTradeTotals) ProtoMessage() {}
 
-func (x *Building) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[56]
+func (x *AnnualTradeTotals) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3593,101 +8665,161 @@ func (x *Building) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Building.ProtoReflect.Descriptor instead.
-func (*Building) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use AnnualTradeTotals.ProtoReflect.Descriptor instead.
+func (*AnnualTradeTotals) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *Building) GetId() uint64 {
+func (x *AnnualTradeTotals) GetBoughtCount() int32 {
 	if x != nil {
-		return x.Id
+		return x.BoughtCount
 	}
 	return 0
 }
 
-func (x *Building) GetModel() *BuildingModel {
+func (x *AnnualTradeTotals) GetBoughtPsc() string {
 	if x != nil {
-		return x.Model
+		return x.BoughtPsc
 	}
-	return nil
+	return ""
 }
 
-func (x *Building) GetConstructionStartDate() string {
+func (x *AnnualTradeTotals) GetBoughtIrr() string {
 	if x != nil {
-		return x.ConstructionStartDate
+		return x.BoughtIrr
 	}
 	return ""
 }
 
-func (x *Building) GetConstructionEndDate() string {
+func (x *AnnualTradeTotals) GetSoldCount() int32 {
 	if x != nil {
-		return x.ConstructionEndDate
+		return x.SoldCount
+	}
+	return 0
+}
+
+func (x *AnnualTradeTotals) GetSoldPsc() string {
+	if x != nil {
+		return x.SoldPsc
 	}
 	return ""
 }
 
-func (x *Building) GetLaunchedSatisfaction() string {
+func (x *AnnualTradeTotals) GetSoldIrr() string {
 	if x != nil {
-		return x.LaunchedSatisfaction
+		return x.SoldIrr
 	}
 	return ""
 }
 
-func (x *Building) GetRotation() string {
+func (x *AnnualTradeTotals) GetFeesPsc() string {
 	if x != nil {
-		return x.Rotation
+		return x.FeesPsc
 	}
 	return ""
 }
 
-func (x *Building) GetPosition() string {
+func (x *AnnualTradeTotals) GetFeesIrr() string {
 	if x != nil {
-		return x.Position
+		return x.FeesIrr
 	}
 	return ""
 }
 
-func (x *Building) GetBubbleDiameter() string {
+func (x *AnnualTradeTotals) GetNetPsc() string {
 	if x != nil {
-		return x.BubbleDiameter
+		return x.NetPsc
 	}
 	return ""
 }
 
-func (x *Building) GetInformation() string {
+func (x *AnnualTradeTotals) GetNetIrr() string {
 	if x != nil {
-		return x.Information
+		return x.NetIrr
 	}
 	return ""
 }
 
-type UpdateBuildingRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	FeatureId            uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
-	BuildingModelId      uint64                 `protobuf:"varint,2,opt,name=building_model_id,json=buildingModelId,proto3" json:"building_model_id,omitempty"`
-	LaunchedSatisfaction string                 `protobuf:"bytes,3,opt,name=launched_satisfaction,json=launchedSatisfaction,proto3" json:"launched_satisfaction,omitempty"`
-	Rotation             string                 `protobuf:"bytes,4,opt,name=rotation,proto3" json:"rotation,omitempty"`
-	Position             string                 `protobuf:"bytes,5,opt,name=position,proto3" json:"position,omitempty"`
-	Information          *BuildingInformation   `protobuf:"bytes,6,opt,name=information,proto3" json:"information,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// AnnualProfit is the hourly profit of one asset paid into the user's wallet
+type AnnualProfit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asset         string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"` // yellow, red or blue
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBuildingRequest) Reset() {
-	*x = UpdateBuildingRequest{}
-	mi := &file_features_proto_msgTypes[57]
+func (x *AnnualProfit) Reset() {
+	*x = AnnualProfit{}
+	mi := &file_features_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBuildingRequest) String() string {
+func (x *AnnualProfit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBuildingRequest) ProtoMessage() {}
+func (*AnnualProfit) ProtoMessage() {}
 
-func (x *UpdateBuildingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[57]
+func (x *AnnualProfit) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnualProfit.ProtoReflect.Descriptor instead.
+func (*AnnualProfit) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *AnnualProfit) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *AnnualProfit) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type AnnualTradeSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"` // Jalali Y/m/d of the first day of the year
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`     // Jalali Y/m/d of the last day of the year
+	Totals        *AnnualTradeTotals     `protobuf:"bytes,4,opt,name=totals,proto3" json:"totals,omitempty"`
+	Profits       []*AnnualProfit        `protobuf:"bytes,5,rep,name=profits,proto3" json:"profits,omitempty"`
+	Trades        []*AnnualTrade         `protobuf:"bytes,6,rep,name=trades,proto3" json:"trades,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnualTradeSummaryResponse) Reset() {
+	*x = AnnualTradeSummaryResponse{}
+	mi := &file_features_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnualTradeSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnualTradeSummaryResponse) ProtoMessage() {}
+
+func (x *AnnualTradeSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3698,77 +8830,78 @@ func (x *UpdateBuildingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBuildingRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBuildingRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use AnnualTradeSummaryResponse.ProtoReflect.Descriptor instead.
+func (*AnnualTradeSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *UpdateBuildingRequest) GetFeatureId() uint64 {
+func (x *AnnualTradeSummaryResponse) GetYear() int32 {
 	if x != nil {
-		return x.FeatureId
+		return x.Year
 	}
 	return 0
 }
 
-func (x *UpdateBuildingRequest) GetBuildingModelId() uint64 {
+func (x *AnnualTradeSummaryResponse) GetFrom() string {
 	if x != nil {
-		return x.BuildingModelId
+		return x.From
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateBuildingRequest) GetLaunchedSatisfaction() string {
+func (x *AnnualTradeSummaryResponse) GetTo() string {
 	if x != nil {
-		return x.LaunchedSatisfaction
+		return x.To
 	}
 	return ""
 }
 
-func (x *UpdateBuildingRequest) GetRotation() string {
+func (x *AnnualTradeSummaryResponse) GetTotals() *AnnualTradeTotals {
 	if x != nil {
-		return x.Rotation
+		return x.Totals
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateBuildingRequest) GetPosition() string {
+func (x *AnnualTradeSummaryResponse) GetProfits() []*AnnualProfit {
 	if x != nil {
-		return x.Position
+		return x.Profits
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateBuildingRequest) GetInformation() *BuildingInformation {
+func (x *AnnualTradeSummaryResponse) GetTrades() []*AnnualTrade {
 	if x != nil {
-		return x.Information
+		return x.Trades
 	}
 	return nil
 }
 
-type BuildingResponse struct {
+// GetUserPortfolioRequest - GET /api/portfolio
+type GetUserPortfolioRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Building      *Building              `protobuf:"bytes,3,opt,name=building,proto3" json:"building,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                      // Default 1
+	PerPage       int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"` // Default 20, at most 50
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildingResponse) Reset() {
-	*x = BuildingResponse{}
-	mi := &file_features_proto_msgTypes[58]
+func (x *GetUserPortfolioRequest) Reset() {
+	*x = GetUserPortfolioRequest{}
+	mi := &file_features_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildingResponse) String() string {
+func (x *GetUserPortfolioRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildingResponse) ProtoMessage() {}
+func (*GetUserPortfolioRequest) ProtoMessage() {}
 
-func (x *BuildingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[58]
+func (x *GetUserPortfolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3779,55 +8912,56 @@ func (x *BuildingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildingResponse.ProtoReflect.Descriptor instead.
-func (*BuildingResponse) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use GetUserPortfolioRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPortfolioRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *BuildingResponse) GetSuccess() bool {
+func (x *GetUserPortfolioRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Success
+		return x.UserId
 	}
-	return false
+	return 0
 }
 
-func (x *BuildingResponse) GetMessage() string {
+func (x *GetUserPortfolioRequest) GetPage() int32 {
 	if x != nil {
-		return x.Message
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *BuildingResponse) GetBuilding() *Building {
+func (x *GetUserPortfolioRequest) GetPerPage() int32 {
 	if x != nil {
-		return x.Building
+		return x.PerPage
 	}
-	return nil
+	return 0
 }
 
-type DestroyBuildingRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	FeatureId       uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
-	BuildingModelId uint64                 `protobuf:"varint,2,opt,name=building_model_id,json=buildingModelId,proto3" json:"building_model_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type PortfolioSellRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PricePsc      string                 `protobuf:"bytes,2,opt,name=price_psc,json=pricePsc,proto3" json:"price_psc,omitempty"`
+	PriceIrr      string                 `protobuf:"bytes,3,opt,name=price_irr,json=priceIrr,proto3" json:"price_irr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DestroyBuildingRequest) Reset() {
-	*x = DestroyBuildingRequest{}
-	mi := &file_features_proto_msgTypes[59]
+func (x *PortfolioSellRequest) Reset() {
+	*x = PortfolioSellRequest{}
+	mi := &file_features_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DestroyBuildingRequest) String() string {
+func (x *PortfolioSellRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DestroyBuildingRequest) ProtoMessage() {}
+func (*PortfolioSellRequest) ProtoMessage() {}
 
-func (x *DestroyBuildingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[59]
+func (x *PortfolioSellRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3838,46 +8972,59 @@ func (x *DestroyBuildingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DestroyBuildingRequest.ProtoReflect.Descriptor instead.
-func (*DestroyBuildingRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use PortfolioSellRequest.ProtoReflect.Descriptor instead.
+func (*PortfolioSellRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *DestroyBuildingRequest) GetFeatureId() uint64 {
+func (x *PortfolioSellRequest) GetId() uint64 {
 	if x != nil {
-		return x.FeatureId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *DestroyBuildingRequest) GetBuildingModelId() uint64 {
+func (x *PortfolioSellRequest) GetPricePsc() string {
 	if x != nil {
-		return x.BuildingModelId
+		return x.PricePsc
 	}
-	return 0
+	return ""
 }
 
-type ListMapsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PortfolioSellRequest) GetPriceIrr() string {
+	if x != nil {
+		return x.PriceIrr
+	}
+	return ""
 }
 
-func (x *ListMapsRequest) Reset() {
-	*x = ListMapsRequest{}
-	mi := &file_features_proto_msgTypes[60]
+type PortfolioItem struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	FeatureId          uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	Properties         *FeatureProperties     `protobuf:"bytes,2,opt,name=properties,proto3" json:"properties,omitempty"`
+	EstimatedValueIrr  string                 `protobuf:"bytes,3,opt,name=estimated_value_irr,json=estimatedValueIrr,proto3" json:"estimated_value_irr,omitempty"`     // stability × rate of the karbari's color, empty when the rate is unavailable
+	Profit             *HourlyProfit          `protobuf:"bytes,4,opt,name=profit,proto3" json:"profit,omitempty"`                                                      // Unset when the feature has no hourly profit
+	SellRequest        *PortfolioSellRequest  `protobuf:"bytes,5,opt,name=sell_request,json=sellRequest,proto3" json:"sell_request,omitempty"`                         // Latest pending sell request, unset when not for sale
+	PendingBuyRequests int32                  `protobuf:"varint,6,opt,name=pending_buy_requests,json=pendingBuyRequests,proto3" json:"pending_buy_requests,omitempty"` // Pending buy requests received for the feature
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PortfolioItem) Reset() {
+	*x = PortfolioItem{}
+	mi := &file_features_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMapsRequest) String() string {
+func (x *PortfolioItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMapsRequest) ProtoMessage() {}
+func (*PortfolioItem) ProtoMessage() {}
 
-func (x *ListMapsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[60]
+func (x *PortfolioItem) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3888,77 +9035,81 @@ func (x *ListMapsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMapsRequest.ProtoReflect.Descriptor instead.
-func (*ListMapsRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use PortfolioItem.ProtoReflect.Descriptor instead.
+func (*PortfolioItem) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{138}
 }
 
-type GetMapRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MapId         uint64                 `protobuf:"varint,1,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PortfolioItem) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
 }
 
-func (x *GetMapRequest) Reset() {
-	*x = GetMapRequest{}
-	mi := &file_features_proto_msgTypes[61]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PortfolioItem) GetProperties() *FeatureProperties {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
 }
 
-func (x *GetMapRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PortfolioItem) GetEstimatedValueIrr() string {
+	if x != nil {
+		return x.EstimatedValueIrr
+	}
+	return ""
 }
 
-func (*GetMapRequest) ProtoMessage() {}
-
-func (x *GetMapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[61]
+func (x *PortfolioItem) GetProfit() *HourlyProfit {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Profit
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetMapRequest.ProtoReflect.Descriptor instead.
-func (*GetMapRequest) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{61}
+func (x *PortfolioItem) GetSellRequest() *PortfolioSellRequest {
+	if x != nil {
+		return x.SellRequest
+	}
+	return nil
 }
 
-func (x *GetMapRequest) GetMapId() uint64 {
+func (x *PortfolioItem) GetPendingBuyRequests() int32 {
 	if x != nil {
-		return x.MapId
+		return x.PendingBuyRequests
 	}
 	return 0
 }
 
-type ListMapsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Maps          []*Map                 `protobuf:"bytes,1,rep,name=maps,proto3" json:"maps,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PortfolioSummary struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	FeatureCount       int32                  `protobuf:"varint,1,opt,name=feature_count,json=featureCount,proto3" json:"feature_count,omitempty"`
+	EstimatedValueIrr  string                 `protobuf:"bytes,2,opt,name=estimated_value_irr,json=estimatedValueIrr,proto3" json:"estimated_value_irr,omitempty"` // Sum over all owned features, empty when a rate is unavailable
+	ProfitMaskoni      string                 `protobuf:"bytes,3,opt,name=profit_maskoni,json=profitMaskoni,proto3" json:"profit_maskoni,omitempty"`               // Accrued yellow
+	ProfitTejari       string                 `protobuf:"bytes,4,opt,name=profit_tejari,json=profitTejari,proto3" json:"profit_tejari,omitempty"`                  // Accrued red
+	ProfitAmozeshi     string                 `protobuf:"bytes,5,opt,name=profit_amozeshi,json=profitAmozeshi,proto3" json:"profit_amozeshi,omitempty"`            // Accrued blue
+	OnSale             int32                  `protobuf:"varint,6,opt,name=on_sale,json=onSale,proto3" json:"on_sale,omitempty"`                                   // Features with a pending sell request
+	PendingBuyRequests int32                  `protobuf:"varint,7,opt,name=pending_buy_requests,json=pendingBuyRequests,proto3" json:"pending_buy_requests,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ListMapsResponse) Reset() {
-	*x = ListMapsResponse{}
-	mi := &file_features_proto_msgTypes[62]
+func (x *PortfolioSummary) Reset() {
+	*x = PortfolioSummary{}
+	mi := &file_features_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMapsResponse) String() string {
+func (x *PortfolioSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMapsResponse) ProtoMessage() {}
+func (*PortfolioSummary) ProtoMessage() {}
 
-func (x *ListMapsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[62]
+func (x *PortfolioSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3969,40 +9120,85 @@ func (x *ListMapsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMapsResponse.ProtoReflect.Descriptor instead.
-func (*ListMapsResponse) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use PortfolioSummary.ProtoReflect.Descriptor instead.
+func (*PortfolioSummary) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *ListMapsResponse) GetMaps() []*Map {
+func (x *PortfolioSummary) GetFeatureCount() int32 {
 	if x != nil {
-		return x.Maps
+		return x.FeatureCount
 	}
-	return nil
+	return 0
 }
 
-type GetMapResponse struct {
+func (x *PortfolioSummary) GetEstimatedValueIrr() string {
+	if x != nil {
+		return x.EstimatedValueIrr
+	}
+	return ""
+}
+
+func (x *PortfolioSummary) GetProfitMaskoni() string {
+	if x != nil {
+		return x.ProfitMaskoni
+	}
+	return ""
+}
+
+func (x *PortfolioSummary) GetProfitTejari() string {
+	if x != nil {
+		return x.ProfitTejari
+	}
+	return ""
+}
+
+func (x *PortfolioSummary) GetProfitAmozeshi() string {
+	if x != nil {
+		return x.ProfitAmozeshi
+	}
+	return ""
+}
+
+func (x *PortfolioSummary) GetOnSale() int32 {
+	if x != nil {
+		return x.OnSale
+	}
+	return 0
+}
+
+func (x *PortfolioSummary) GetPendingBuyRequests() int32 {
+	if x != nil {
+		return x.PendingBuyRequests
+	}
+	return 0
+}
+
+type PortfolioMeta struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Map           *Map                   `protobuf:"bytes,1,opt,name=map,proto3" json:"map,omitempty"`
+	CurrentPage   int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	LastPage      int32                  `protobuf:"varint,4,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMapResponse) Reset() {
-	*x = GetMapResponse{}
-	mi := &file_features_proto_msgTypes[63]
+func (x *PortfolioMeta) Reset() {
+	*x = PortfolioMeta{}
+	mi := &file_features_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMapResponse) String() string {
+func (x *PortfolioMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMapResponse) ProtoMessage() {}
+func (*PortfolioMeta) ProtoMessage() {}
 
-func (x *GetMapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[63]
+func (x *PortfolioMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4013,40 +9209,63 @@ func (x *GetMapResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMapResponse.ProtoReflect.Descriptor instead.
-func (*GetMapResponse) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use PortfolioMeta.ProtoReflect.Descriptor instead.
+func (*PortfolioMeta) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *GetMapResponse) GetMap() *Map {
+func (x *PortfolioMeta) GetCurrentPage() int32 {
 	if x != nil {
-		return x.Map
+		return x.CurrentPage
 	}
-	return nil
+	return 0
 }
 
-type GetMapBorderResponse struct {
+func (x *PortfolioMeta) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *PortfolioMeta) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PortfolioMeta) GetLastPage() int32 {
+	if x != nil {
+		return x.LastPage
+	}
+	return 0
+}
+
+type UserPortfolioResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *MapBorderData         `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data          []*PortfolioItem       `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Summary       *PortfolioSummary      `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	Meta          *PortfolioMeta         `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMapBorderResponse) Reset() {
-	*x = GetMapBorderResponse{}
-	mi := &file_features_proto_msgTypes[64]
+func (x *UserPortfolioResponse) Reset() {
+	*x = UserPortfolioResponse{}
+	mi := &file_features_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMapBorderResponse) String() string {
+func (x *UserPortfolioResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMapBorderResponse) ProtoMessage() {}
+func (*UserPortfolioResponse) ProtoMessage() {}
 
-func (x *GetMapBorderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[64]
+func (x *UserPortfolioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4057,40 +9276,59 @@ func (x *GetMapBorderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMapBorderResponse.ProtoReflect.Descriptor instead.
-func (*GetMapBorderResponse) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use UserPortfolioResponse.ProtoReflect.Descriptor instead.
+func (*UserPortfolioResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *GetMapBorderResponse) GetData() *MapBorderData {
+func (x *UserPortfolioResponse) GetData() []*PortfolioItem {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type MapBorderData struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	BorderCoordinates string                 `protobuf:"bytes,1,opt,name=border_coordinates,json=borderCoordinates,proto3" json:"border_coordinates,omitempty"` // JSON string
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *UserPortfolioResponse) GetSummary() *PortfolioSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *UserPortfolioResponse) GetMeta() *PortfolioMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// GetFeatureHistoryRequest - GET /api/features/{feature}/history
+// Only the feature's current owner can get its history.
+type GetFeatureHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeatureId     uint64                 `protobuf:"varint,1,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                      // Default 1
+	PerPage       int32                  `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"` // Default 20, at most 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MapBorderData) Reset() {
-	*x = MapBorderData{}
-	mi := &file_features_proto_msgTypes[65]
+func (x *GetFeatureHistoryRequest) Reset() {
+	*x = GetFeatureHistoryRequest{}
+	mi := &file_features_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MapBorderData) String() string {
+func (x *GetFeatureHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MapBorderData) ProtoMessage() {}
+func (*GetFeatureHistoryRequest) ProtoMessage() {}
 
-func (x *MapBorderData) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[65]
+func (x *GetFeatureHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4101,50 +9339,73 @@ func (x *MapBorderData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MapBorderData.ProtoReflect.Descriptor instead.
-func (*MapBorderData) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use GetFeatureHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetFeatureHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *MapBorderData) GetBorderCoordinates() string {
+func (x *GetFeatureHistoryRequest) GetFeatureId() uint64 {
 	if x != nil {
-		return x.BorderCoordinates
+		return x.FeatureId
 	}
-	return ""
+	return 0
 }
 
-type Map struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Id                      uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Color                   string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`                                                                      // polygon_color
-	CentralPointCoordinates string                 `protobuf:"bytes,4,opt,name=central_point_coordinates,json=centralPointCoordinates,proto3" json:"central_point_coordinates,omitempty"` // JSON string
-	SoldFeaturesPercentage  string                 `protobuf:"bytes,5,opt,name=sold_features_percentage,json=soldFeaturesPercentage,proto3" json:"sold_features_percentage,omitempty"`    // formatted to 2 decimal places
-	// Additional fields for detail view
-	BorderCoordinates string       `protobuf:"bytes,6,opt,name=border_coordinates,json=borderCoordinates,proto3" json:"border_coordinates,omitempty"` // JSON string (only in detail)
-	Area              uint64       `protobuf:"varint,7,opt,name=area,proto3" json:"area,omitempty"`                                                   // polygon_area (only in detail)
-	Address           string       `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`                                              // polygon_address (only in detail)
-	PublishedAt       string       `protobuf:"bytes,9,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`                   // publish_date (only in detail)
-	Features          *MapFeatures `protobuf:"bytes,10,opt,name=features,proto3" json:"features,omitempty"`                                           // grouping by karbari (only in detail)
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *GetFeatureHistoryRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
 }
 
-func (x *Map) Reset() {
-	*x = Map{}
-	mi := &file_features_proto_msgTypes[66]
+func (x *GetFeatureHistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetFeatureHistoryRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+// FeatureHistoryEvent is one entry of a feature's history. Which fields are
+// set depends on the type.
+type FeatureHistoryEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Type           string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                                            // trade, sell_request, buy_request, build or profit_payout
+	ReferenceId    uint64                 `protobuf:"varint,2,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`          // ID of the trade, request, building or payout
+	Date           string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`                                            // Jalali Y/m/d
+	Time           string                 `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`                                            // Jalali H:m:s
+	UserId         uint64                 `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                         // Buyer of trades and buy requests, seller of sell requests, owner paid out, 0 for builds
+	CounterpartyId uint64                 `protobuf:"varint,6,opt,name=counterparty_id,json=counterpartyId,proto3" json:"counterparty_id,omitempty"` // Seller of trades and buy requests, 0 otherwise
+	PricePsc       string                 `protobuf:"bytes,7,opt,name=price_psc,json=pricePsc,proto3" json:"price_psc,omitempty"`                    // Trades and requests
+	PriceIrr       string                 `protobuf:"bytes,8,opt,name=price_irr,json=priceIrr,proto3" json:"price_irr,omitempty"`                    // Trades and requests
+	Asset          string                 `protobuf:"bytes,9,opt,name=asset,proto3" json:"asset,omitempty"`                                          // Profit payouts: yellow, red or blue
+	Amount         string                 `protobuf:"bytes,10,opt,name=amount,proto3" json:"amount,omitempty"`                                       // Profit payouts
+	Status         string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`                                       // Trades: completed or refunded; sell requests: open or closed; buy requests: pending, accepted or cancelled; builds: under_construction or built
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FeatureHistoryEvent) Reset() {
+	*x = FeatureHistoryEvent{}
+	mi := &file_features_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Map) String() string {
+func (x *FeatureHistoryEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Map) ProtoMessage() {}
+func (*FeatureHistoryEvent) ProtoMessage() {}
 
-func (x *Map) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[66]
+func (x *FeatureHistoryEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4155,105 +9416,111 @@ func (x *Map) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Map.ProtoReflect.Descriptor instead.
-func (*Map) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use FeatureHistoryEvent.ProtoReflect.Descriptor instead.
+func (*FeatureHistoryEvent) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *Map) GetId() uint64 {
+func (x *FeatureHistoryEvent) GetType() string {
 	if x != nil {
-		return x.Id
+		return x.Type
+	}
+	return ""
+}
+
+func (x *FeatureHistoryEvent) GetReferenceId() uint64 {
+	if x != nil {
+		return x.ReferenceId
 	}
 	return 0
 }
 
-func (x *Map) GetName() string {
+func (x *FeatureHistoryEvent) GetDate() string {
 	if x != nil {
-		return x.Name
+		return x.Date
 	}
 	return ""
 }
 
-func (x *Map) GetColor() string {
+func (x *FeatureHistoryEvent) GetTime() string {
 	if x != nil {
-		return x.Color
+		return x.Time
 	}
 	return ""
 }
 
-func (x *Map) GetCentralPointCoordinates() string {
+func (x *FeatureHistoryEvent) GetUserId() uint64 {
 	if x != nil {
-		return x.CentralPointCoordinates
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *Map) GetSoldFeaturesPercentage() string {
+func (x *FeatureHistoryEvent) GetCounterpartyId() uint64 {
 	if x != nil {
-		return x.SoldFeaturesPercentage
+		return x.CounterpartyId
 	}
-	return ""
+	return 0
 }
 
-func (x *Map) GetBorderCoordinates() string {
+func (x *FeatureHistoryEvent) GetPricePsc() string {
 	if x != nil {
-		return x.BorderCoordinates
+		return x.PricePsc
 	}
 	return ""
 }
 
-func (x *Map) GetArea() uint64 {
+func (x *FeatureHistoryEvent) GetPriceIrr() string {
 	if x != nil {
-		return x.Area
+		return x.PriceIrr
 	}
-	return 0
+	return ""
 }
 
-func (x *Map) GetAddress() string {
+func (x *FeatureHistoryEvent) GetAsset() string {
 	if x != nil {
-		return x.Address
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *Map) GetPublishedAt() string {
+func (x *FeatureHistoryEvent) GetAmount() string {
 	if x != nil {
-		return x.PublishedAt
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *Map) GetFeatures() *MapFeatures {
+func (x *FeatureHistoryEvent) GetStatus() string {
 	if x != nil {
-		return x.Features
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type MapFeatures struct {
+type FeatureHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Maskoni       *MapFeatureCount       `protobuf:"bytes,1,opt,name=maskoni,proto3" json:"maskoni,omitempty"`
-	Tejari        *MapFeatureCount       `protobuf:"bytes,2,opt,name=tejari,proto3" json:"tejari,omitempty"`
-	Amoozeshi     *MapFeatureCount       `protobuf:"bytes,3,opt,name=amoozeshi,proto3" json:"amoozeshi,omitempty"`
+	Data          []*FeatureHistoryEvent `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"` // Oldest first
+	Meta          *common.PaginationMeta `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MapFeatures) Reset() {
-	*x = MapFeatures{}
-	mi := &file_features_proto_msgTypes[67]
+func (x *FeatureHistoryResponse) Reset() {
+	*x = FeatureHistoryResponse{}
+	mi := &file_features_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MapFeatures) String() string {
+func (x *FeatureHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MapFeatures) ProtoMessage() {}
+func (*FeatureHistoryResponse) ProtoMessage() {}
 
-func (x *MapFeatures) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[67]
+func (x *FeatureHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_features_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4264,76 +9531,25 @@ func (x *MapFeatures) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MapFeatures.ProtoReflect.Descriptor instead.
-func (*MapFeatures) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{67}
-}
-
-func (x *MapFeatures) GetMaskoni() *MapFeatureCount {
-	if x != nil {
-		return x.Maskoni
-	}
-	return nil
+// Deprecated: Use FeatureHistoryResponse.ProtoReflect.Descriptor instead.
+func (*FeatureHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_features_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *MapFeatures) GetTejari() *MapFeatureCount {
+func (x *FeatureHistoryResponse) GetData() []*FeatureHistoryEvent {
 	if x != nil {
-		return x.Tejari
+		return x.Data
 	}
 	return nil
 }
 
-func (x *MapFeatures) GetAmoozeshi() *MapFeatureCount {
+func (x *FeatureHistoryResponse) GetMeta() *common.PaginationMeta {
 	if x != nil {
-		return x.Amoozeshi
+		return x.Meta
 	}
 	return nil
 }
 
-type MapFeatureCount struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sold          int32                  `protobuf:"varint,1,opt,name=sold,proto3" json:"sold,omitempty"` // count of features with owner_id != 1 and matching karbari
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *MapFeatureCount) Reset() {
-	*x = MapFeatureCount{}
-	mi := &file_features_proto_msgTypes[68]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *MapFeatureCount) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MapFeatureCount) ProtoMessage() {}
-
-func (x *MapFeatureCount) ProtoReflect() protoreflect.Message {
-	mi := &file_features_proto_msgTypes[68]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MapFeatureCount.ProtoReflect.Descriptor instead.
-func (*MapFeatureCount) Descriptor() ([]byte, []int) {
-	return file_features_proto_rawDescGZIP(), []int{68}
-}
-
-func (x *MapFeatureCount) GetSold() int32 {
-	if x != nil {
-		return x.Sold
-	}
-	return 0
-}
-
 var File_features_proto protoreflect.FileDescriptor
 
 const file_features_proto_rawDesc = "" +
@@ -4391,7 +9607,14 @@ const file_features_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1d\n" +
 	"\n" +
 	"feature_id\x18\x02 \x01(\x04R\tfeatureId\x128\n" +
-	"\x18minimum_price_percentage\x18\x03 \x01(\x05R\x16minimumPricePercentage\"c\n" +
+	"\x18minimum_price_percentage\x18\x03 \x01(\x05R\x16minimumPricePercentage\"8\n" +
+	"\x19CountOwnedFeaturesRequest\x12\x1b\n" +
+	"\towner_ids\x18\x01 \x03(\x04R\bownerIds\"\xa1\x01\n" +
+	"\x1aOwnedFeatureCountsResponse\x12H\n" +
+	"\x06counts\x18\x01 \x03(\v20.features.OwnedFeatureCountsResponse.CountsEntryR\x06counts\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x04R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"c\n" +
 	"\x0fPaginationLinks\x12\x14\n" +
 	"\x05first\x18\x01 \x01(\tR\x05first\x12\x12\n" +
 	"\x04last\x18\x02 \x01(\tR\x04last\x12\x12\n" +
@@ -4449,10 +9672,13 @@ const file_features_proto_rawDesc = "" +
 	"\vgeometry_id\x18\x02 \x01(\x04R\n" +
 	"geometryId\x12\f\n" +
 	"\x01x\x18\x03 \x01(\tR\x01x\x12\f\n" +
-	"\x01y\x18\x04 \x01(\tR\x01y\")\n" +
+	"\x01y\x18\x04 \x01(\tR\x01y\"\x85\x01\n" +
 	"\x05Image\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x10\n" +
-	"\x03url\x18\x02 \x01(\tR\x03url\"\x87\x01\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12#\n" +
+	"\rthumbnail_url\x18\x03 \x01(\tR\fthumbnailUrl\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x12\x19\n" +
+	"\bis_cover\x18\x05 \x01(\bR\aisCover\"\x87\x01\n" +
 	"\x11BuyFeatureRequest\x12\x1d\n" +
 	"\n" +
 	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x12\x19\n" +
@@ -4462,7 +9688,38 @@ const file_features_proto_rawDesc = "" +
 	"\x12BuyFeatureResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12+\n" +
-	"\afeature\x18\x03 \x01(\v2\x11.features.FeatureR\afeature\"\x9f\x01\n" +
+	"\afeature\x18\x03 \x01(\v2\x11.features.FeatureR\afeature\"T\n" +
+	"\x1aCheckoutReservationRequest\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"\x8d\x01\n" +
+	"\x13CheckoutReservation\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\x12\x1f\n" +
+	"\vttl_seconds\x18\x04 \x01(\x05R\n" +
+	"ttlSeconds\"^\n" +
+	"$GetLimitedFeatureAvailabilityRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x02 \x01(\x04R\tfeatureId\"\x96\x03\n" +
+	"\x1aLimitedFeatureAvailability\x12#\n" +
+	"\rlimitation_id\x18\x01 \x01(\x04R\flimitationId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04sold\x18\x04 \x01(\x05R\x04sold\x12\x12\n" +
+	"\x04held\x18\x05 \x01(\x05R\x04held\x12\x1c\n" +
+	"\tremaining\x18\x06 \x01(\x05R\tremaining\x120\n" +
+	"\x14individual_buy_limit\x18\a \x01(\bR\x12individualBuyLimit\x120\n" +
+	"\x14individual_buy_count\x18\b \x01(\x05R\x12individualBuyCount\x12%\n" +
+	"\x0euser_purchased\x18\t \x01(\x05R\ruserPurchased\x12%\n" +
+	"\x0euser_remaining\x18\n" +
+	" \x01(\x05R\ruserRemaining\x12/\n" +
+	"\x14user_held_feature_id\x18\v \x01(\x04R\x11userHeldFeatureId\"a\n" +
+	"%GetLimitedFeatureAvailabilityResponse\x128\n" +
+	"\x04data\x18\x01 \x03(\v2$.features.LimitedFeatureAvailabilityR\x04data\"\x9f\x01\n" +
 	"\x15SendBuyRequestRequest\x12\x1d\n" +
 	"\n" +
 	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x12\x19\n" +
@@ -4516,7 +9773,44 @@ const file_features_proto_rawDesc = "" +
 	"\x17AcceptBuyRequestRequest\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\x04R\trequestId\x12\x1b\n" +
-	"\tseller_id\x18\x02 \x01(\x04R\bsellerId\"\xca\x01\n" +
+	"\tseller_id\x18\x02 \x01(\x04R\bsellerId\"\xa4\x01\n" +
+	"\x18CounterBuyRequestRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x04R\trequestId\x12\x1b\n" +
+	"\tseller_id\x18\x02 \x01(\x04R\bsellerId\x12\x1b\n" +
+	"\tprice_psc\x18\x03 \x01(\tR\bpricePsc\x12\x1b\n" +
+	"\tprice_irr\x18\x04 \x01(\tR\bpriceIrr\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"U\n" +
+	"\x19AnswerCounterOfferRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x04R\trequestId\x12\x19\n" +
+	"\bbuyer_id\x18\x02 \x01(\x04R\abuyerId\"O\n" +
+	"\x15GetNegotiationRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x04R\trequestId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"\xfe\x01\n" +
+	"\x0fBuyRequestOffer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
+	"\x05round\x18\x02 \x01(\x05R\x05round\x12\x1d\n" +
+	"\n" +
+	"offered_by\x18\x03 \x01(\x04R\tofferedBy\x12\x1b\n" +
+	"\tprice_psc\x18\x04 \x01(\tR\bpricePsc\x12\x1b\n" +
+	"\tprice_irr\x18\x05 \x01(\tR\bpriceIrr\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12!\n" +
+	"\fresponded_at\x18\b \x01(\tR\vrespondedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"\x81\x02\n" +
+	"\x13NegotiationResponse\x12=\n" +
+	"\vbuy_request\x18\x01 \x01(\v2\x1c.features.BuyRequestResponseR\n" +
+	"buyRequest\x121\n" +
+	"\x06offers\x18\x02 \x03(\v2\x19.features.BuyRequestOfferR\x06offers\x12\x1f\n" +
+	"\vrounds_used\x18\x03 \x01(\x05R\n" +
+	"roundsUsed\x12\x1d\n" +
+	"\n" +
+	"max_rounds\x18\x04 \x01(\x05R\tmaxRounds\x128\n" +
+	"\n" +
+	"open_offer\x18\x05 \x01(\v2\x19.features.BuyRequestOfferR\topenOffer\"\xca\x01\n" +
 	"\x18CreateSellRequestRequest\x12\x1d\n" +
 	"\n" +
 	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x12\x1b\n" +
@@ -4542,7 +9836,36 @@ const file_features_proto_rawDesc = "" +
 	"\x12feature_properties\x18\b \x01(\v2\x1b.features.FeaturePropertiesR\x11featureProperties\x12E\n" +
 	"\x13feature_coordinates\x18\t \x03(\v2\x14.features.CoordinateR\x12featureCoordinates\"Z\n" +
 	"\x14SellRequestsResponse\x12B\n" +
-	"\rsell_requests\x18\x01 \x03(\v2\x1d.features.SellRequestResponseR\fsellRequests\"x\n" +
+	"\rsell_requests\x18\x01 \x03(\v2\x1d.features.SellRequestResponseR\fsellRequests\"\xfd\x01\n" +
+	"\x1aListForSaleFeaturesRequest\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\x05R\x06region\x12\x18\n" +
+	"\akarbari\x18\x02 \x01(\tR\akarbari\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x1b\n" +
+	"\tmin_price\x18\x05 \x01(\tR\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\x06 \x01(\tR\bmaxPrice\x12\x12\n" +
+	"\x04sort\x18\a \x01(\tR\x04sort\x12\x12\n" +
+	"\x04page\x18\b \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\t \x01(\x05R\aperPage\"\xdf\x02\n" +
+	"\x12MarketplaceListing\x12&\n" +
+	"\x0fsell_request_id\x18\x01 \x01(\x04R\rsellRequestId\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x02 \x01(\x04R\tfeatureId\x12#\n" +
+	"\rproperties_id\x18\x03 \x01(\tR\fpropertiesId\x12\x18\n" +
+	"\akarbari\x18\x04 \x01(\tR\akarbari\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\x05R\x06region\x12\x12\n" +
+	"\x04area\x18\x06 \x01(\x03R\x04area\x12\x18\n" +
+	"\aaddress\x18\a \x01(\tR\aaddress\x12\x1b\n" +
+	"\tseller_id\x18\b \x01(\x04R\bsellerId\x12\x1b\n" +
+	"\tprice_psc\x18\t \x01(\tR\bpricePsc\x12\x1b\n" +
+	"\tprice_irr\x18\n" +
+	" \x01(\tR\bpriceIrr\x12\x12\n" +
+	"\x04date\x18\v \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\f \x01(\tR\x04time\"\xb4\x01\n" +
+	"\x1bListForSaleFeaturesResponse\x120\n" +
+	"\x04data\x18\x01 \x03(\v2\x1c.features.MarketplaceListingR\x04data\x12/\n" +
+	"\x05links\x18\x02 \x01(\v2\x19.features.PaginationLinksR\x05links\x122\n" +
+	"\x04meta\x18\x03 \x01(\v2\x1e.features.SimplePaginationMetaR\x04meta\"x\n" +
 	"\x19RequestGracePeriodRequest\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\x04R\trequestId\x12\x19\n" +
@@ -4580,14 +9903,46 @@ const file_features_proto_rawDesc = "" +
 	"\akarbari\x18\x02 \x01(\tR\akarbari\"[\n" +
 	"\x1cProfitsByApplicationResponse\x12!\n" +
 	"\ftotal_amount\x18\x01 \x01(\tR\vtotalAmount\x12\x18\n" +
-	"\asuccess\x18\x02 \x01(\bR\asuccess\"K\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"Q\n" +
+	"\x17GetFeatureProfitRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x02 \x01(\x04R\tfeatureId\"\xd0\x01\n" +
+	"\x15FeatureProfitResponse\x12.\n" +
+	"\x06profit\x18\x01 \x01(\v2\x16.features.HourlyProfitR\x06profit\x12\x1f\n" +
+	"\vhourly_rate\x18\x02 \x01(\tR\n" +
+	"hourlyRate\x12\x1a\n" +
+	"\baccruing\x18\x03 \x01(\bR\baccruing\x12.\n" +
+	"\x13seconds_to_deadline\x18\x04 \x01(\x03R\x11secondsToDeadline\x12\x1a\n" +
+	"\bcompound\x18\x05 \x01(\bR\bcompound\"3\n" +
+	"\x18GetProfitSettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"q\n" +
+	"\x1bUpdateProfitSettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\n" +
+	"auto_claim\x18\x02 \x01(\bR\tautoClaim\x12\x1a\n" +
+	"\bcompound\x18\x03 \x01(\bR\bcompound\"\x85\x01\n" +
+	"\x16ProfitSettingsResponse\x12\x1d\n" +
+	"\n" +
+	"auto_claim\x18\x01 \x01(\bR\tautoClaim\x12\x1a\n" +
+	"\bcompound\x18\x02 \x01(\bR\bcompound\x120\n" +
+	"\x14withdraw_profit_days\x18\x03 \x01(\x05R\x12withdrawProfitDays\"K\n" +
 	"\x16GetBuildPackageRequest\x12\x1d\n" +
 	"\n" +
 	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\"i\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\"\xcf\x01\n" +
 	"\x14BuildPackageResponse\x12/\n" +
 	"\x06models\x18\x01 \x03(\v2\x17.features.BuildingModelR\x06models\x12 \n" +
-	"\vcoordinates\x18\x02 \x03(\tR\vcoordinates\"\xe1\x01\n" +
+	"\vcoordinates\x18\x02 \x03(\tR\vcoordinates\x12!\n" +
+	"\fcurrent_page\x18\x03 \x01(\x05R\vcurrentPage\x12\x1b\n" +
+	"\tlast_page\x18\x04 \x01(\x05R\blastPage\x12$\n" +
+	"\x0ehas_more_pages\x18\x05 \x01(\bR\fhasMorePages\"\xcc\x01\n" +
+	"\x11BuildPackageChunk\x12/\n" +
+	"\x06models\x18\x01 \x03(\v2\x17.features.BuildingModelR\x06models\x12 \n" +
+	"\vcoordinates\x18\x02 \x03(\tR\vcoordinates\x12!\n" +
+	"\fcurrent_page\x18\x03 \x01(\x05R\vcurrentPage\x12\x1b\n" +
+	"\tlast_page\x18\x04 \x01(\x05R\blastPage\x12$\n" +
+	"\x0ehas_more_pages\x18\x05 \x01(\bR\fhasMorePages\"\xe1\x01\n" +
 	"\rBuildingModel\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
 	"\bmodel_id\x18\x02 \x01(\tR\amodelId\x12\x12\n" +
@@ -4648,7 +10003,27 @@ const file_features_proto_rawDesc = "" +
 	"\x16DestroyBuildingRequest\x12\x1d\n" +
 	"\n" +
 	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x12*\n" +
-	"\x11building_model_id\x18\x02 \x01(\x04R\x0fbuildingModelId\"\x11\n" +
+	"\x11building_model_id\x18\x02 \x01(\x04R\x0fbuildingModelId\"\x96\x01\n" +
+	"\x14SimulateBuildRequest\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x12*\n" +
+	"\x11building_model_id\x18\x02 \x01(\x04R\x0fbuildingModelId\x123\n" +
+	"\x15launched_satisfaction\x18\x03 \x01(\tR\x14launchedSatisfaction\"\xce\x03\n" +
+	"\x15SimulateBuildResponse\x12\x1c\n" +
+	"\tqualifies\x18\x01 \x01(\bR\tqualifies\x124\n" +
+	"\amissing\x18\x02 \x03(\v2\x1a.features.BuildRequirementR\amissing\x123\n" +
+	"\x15required_satisfaction\x18\x03 \x01(\tR\x14requiredSatisfaction\x123\n" +
+	"\x15launched_satisfaction\x18\x04 \x01(\tR\x14launchedSatisfaction\x125\n" +
+	"\x16available_satisfaction\x18\x05 \x01(\tR\x15availableSatisfaction\x121\n" +
+	"\x14construction_seconds\x18\x06 \x01(\x03R\x13constructionSeconds\x122\n" +
+	"\x15construction_end_date\x18\a \x01(\tR\x13constructionEndDate\x12!\n" +
+	"\fprofit_asset\x18\b \x01(\tR\vprofitAsset\x126\n" +
+	"\x17projected_hourly_profit\x18\t \x01(\tR\x15projectedHourlyProfit\"z\n" +
+	"\x10BuildRequirement\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\tR\brequired\x12\x1c\n" +
+	"\tavailable\x18\x04 \x01(\tR\tavailable\"\x11\n" +
 	"\x0fListMapsRequest\"&\n" +
 	"\rGetMapRequest\x12\x15\n" +
 	"\x06map_id\x18\x01 \x01(\x04R\x05mapId\"5\n" +
@@ -4677,7 +10052,290 @@ const file_features_proto_rawDesc = "" +
 	"\x06tejari\x18\x02 \x01(\v2\x19.features.MapFeatureCountR\x06tejari\x127\n" +
 	"\tamoozeshi\x18\x03 \x01(\v2\x19.features.MapFeatureCountR\tamoozeshi\"%\n" +
 	"\x0fMapFeatureCount\x12\x12\n" +
-	"\x04sold\x18\x01 \x01(\x05R\x04sold2\xa5\x06\n" +
+	"\x04sold\x18\x01 \x01(\x05R\x04sold\"O\n" +
+	"\x15AddToWatchlistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x02 \x01(\x04R\tfeatureId\"T\n" +
+	"\x1aRemoveFromWatchlistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x02 \x01(\x04R\tfeatureId\"/\n" +
+	"\x14ListWatchlistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"\x93\x02\n" +
+	"\rWatchlistItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x02 \x01(\x04R\tfeatureId\x12#\n" +
+	"\rproperties_id\x18\x03 \x01(\tR\fpropertiesId\x12\x18\n" +
+	"\akarbari\x18\x04 \x01(\tR\akarbari\x12\x19\n" +
+	"\bowner_id\x18\x05 \x01(\x04R\aownerId\x12\x1b\n" +
+	"\tprice_psc\x18\x06 \x01(\tR\bpricePsc\x12\x1b\n" +
+	"\tprice_irr\x18\a \x01(\tR\bpriceIrr\x12\x17\n" +
+	"\aon_sale\x18\b \x01(\bR\x06onSale\x12\x12\n" +
+	"\x04date\x18\t \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\n" +
+	" \x01(\tR\x04time\"D\n" +
+	"\x15WatchlistItemResponse\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.features.WatchlistItemR\x04data\"D\n" +
+	"\x15ListWatchlistResponse\x12+\n" +
+	"\x04data\x18\x01 \x03(\v2\x17.features.WatchlistItemR\x04data\"\xe5\x01\n" +
+	"\x18CreateSavedSearchRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\x05R\x06region\x12\x18\n" +
+	"\akarbari\x18\x04 \x01(\tR\akarbari\x12\x14\n" +
+	"\x05color\x18\x05 \x01(\tR\x05color\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x1b\n" +
+	"\tmin_price\x18\a \x01(\tR\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\b \x01(\tR\bmaxPrice\"\x8d\x02\n" +
+	"\x18UpdateSavedSearchRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12&\n" +
+	"\x0fsaved_search_id\x18\x02 \x01(\x04R\rsavedSearchId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\x05R\x06region\x12\x18\n" +
+	"\akarbari\x18\x05 \x01(\tR\akarbari\x12\x14\n" +
+	"\x05color\x18\x06 \x01(\tR\x05color\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x1b\n" +
+	"\tmin_price\x18\b \x01(\tR\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\t \x01(\tR\bmaxPrice\"[\n" +
+	"\x18DeleteSavedSearchRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12&\n" +
+	"\x0fsaved_search_id\x18\x02 \x01(\x04R\rsavedSearchId\"3\n" +
+	"\x18ListSavedSearchesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"\xe1\x01\n" +
+	"\vSavedSearch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\x05R\x06region\x12\x18\n" +
+	"\akarbari\x18\x04 \x01(\tR\akarbari\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1b\n" +
+	"\tmin_price\x18\x06 \x01(\tR\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\a \x01(\tR\bmaxPrice\x12\x12\n" +
+	"\x04date\x18\b \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\t \x01(\tR\x04time\"@\n" +
+	"\x13SavedSearchResponse\x12)\n" +
+	"\x04data\x18\x01 \x01(\v2\x15.features.SavedSearchR\x04data\"F\n" +
+	"\x19ListSavedSearchesResponse\x12)\n" +
+	"\x04data\x18\x01 \x03(\v2\x15.features.SavedSearchR\x04data\",\n" +
+	"\x0fGetTradeRequest\x12\x19\n" +
+	"\btrade_id\x18\x01 \x01(\x04R\atradeId\".\n" +
+	"\x11TradeFundsRequest\x12\x19\n" +
+	"\btrade_id\x18\x01 \x01(\x04R\atradeId\"R\n" +
+	"\x12RefundTradeRequest\x12\x19\n" +
+	"\btrade_id\x18\x01 \x01(\x04R\atradeId\x12!\n" +
+	"\ffunds_frozen\x18\x02 \x01(\bR\vfundsFrozen\"\xf5\x01\n" +
+	"\fTradeDetails\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x02 \x01(\x04R\tfeatureId\x12#\n" +
+	"\rproperties_id\x18\x03 \x01(\tR\fpropertiesId\x12\x19\n" +
+	"\bbuyer_id\x18\x04 \x01(\x04R\abuyerId\x12\x1b\n" +
+	"\tseller_id\x18\x05 \x01(\x04R\bsellerId\x12\x1d\n" +
+	"\n" +
+	"psc_amount\x18\x06 \x01(\x01R\tpscAmount\x12\x1d\n" +
+	"\n" +
+	"irr_amount\x18\a \x01(\x01R\tirrAmount\x12\x1b\n" +
+	"\ttraded_at\x18\b \x01(\x03R\btradedAt\";\n" +
+	"\rTradeResponse\x12*\n" +
+	"\x04data\x18\x01 \x01(\v2\x16.features.TradeDetailsR\x04data\"\x8d\x01\n" +
+	"\x1cUpdateFeatureGeometryRequest\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x126\n" +
+	"\vcoordinates\x18\x02 \x03(\v2\x14.features.CoordinateR\vcoordinates\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"<\n" +
+	"\x1bListGeometryVersionsRequest\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x01 \x01(\x04R\tfeatureId\"\x83\x02\n" +
+	"\x0fGeometryVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x02 \x01(\x04R\tfeatureId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x126\n" +
+	"\vcoordinates\x18\x04 \x03(\v2\x14.features.CoordinateR\vcoordinates\x12\x12\n" +
+	"\x04area\x18\x05 \x01(\tR\x04area\x12\x1b\n" +
+	"\tedited_by\x18\x06 \x01(\x04R\beditedBy\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x12\n" +
+	"\x04date\x18\b \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\t \x01(\tR\x04time\"H\n" +
+	"\x17GeometryVersionResponse\x12-\n" +
+	"\x04data\x18\x01 \x01(\v2\x19.features.GeometryVersionR\x04data\"M\n" +
+	"\x1cListGeometryVersionsResponse\x12-\n" +
+	"\x04data\x18\x01 \x03(\v2\x19.features.GeometryVersionR\x04data\"Q\n" +
+	"\x15ReserveFeatureRequest\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x12\x19\n" +
+	"\bbuyer_id\x18\x02 \x01(\x04R\abuyerId\"U\n" +
+	"\x19FeatureReservationRequest\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x12\x19\n" +
+	"\bbuyer_id\x18\x02 \x01(\x04R\abuyerId\"\x99\x03\n" +
+	"\x12FeatureReservation\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x12\x19\n" +
+	"\bbuyer_id\x18\x02 \x01(\x04R\abuyerId\x12\x1b\n" +
+	"\tseller_id\x18\x03 \x01(\x04R\bsellerId\x12(\n" +
+	"\x10buyer_charge_psc\x18\x04 \x01(\x01R\x0ebuyerChargePsc\x12(\n" +
+	"\x10buyer_charge_irr\x18\x05 \x01(\x01R\x0ebuyerChargeIrr\x12,\n" +
+	"\x12seller_payment_psc\x18\x06 \x01(\x01R\x10sellerPaymentPsc\x12,\n" +
+	"\x12seller_payment_irr\x18\a \x01(\x01R\x10sellerPaymentIrr\x12(\n" +
+	"\x10platform_fee_psc\x18\b \x01(\x01R\x0eplatformFeePsc\x12(\n" +
+	"\x10platform_fee_irr\x18\t \x01(\x01R\x0eplatformFeeIrr\x12(\n" +
+	"\x10platform_user_id\x18\n" +
+	" \x01(\x04R\x0eplatformUserId\"=\n" +
+	" CompleteReservedPurchaseResponse\x12\x19\n" +
+	"\btrade_id\x18\x01 \x01(\x04R\atradeId\"9\n" +
+	"\x18ListFeatureImagesRequest\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x01 \x01(\x04R\tfeatureId\"`\n" +
+	"\vImageUpload\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"j\n" +
+	"\x1aAttachFeatureImagesRequest\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x12-\n" +
+	"\x06images\x18\x02 \x03(\v2\x15.features.ImageUploadR\x06images\"U\n" +
+	"\x19RemoveFeatureImageRequest\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x12\x19\n" +
+	"\bimage_id\x18\x02 \x01(\x04R\aimageId\"Y\n" +
+	"\x1bReorderFeatureImagesRequest\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x12\x1b\n" +
+	"\timage_ids\x18\x02 \x03(\x04R\bimageIds\"W\n" +
+	"\x1bSetFeatureCoverImageRequest\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x12\x19\n" +
+	"\bimage_id\x18\x02 \x01(\x04R\aimageId\"<\n" +
+	"\x15FeatureImagesResponse\x12#\n" +
+	"\x04data\x18\x01 \x03(\v2\x0f.features.ImageR\x04data\"3\n" +
+	"\x16GetTradeReceiptRequest\x12\x19\n" +
+	"\btrade_id\x18\x01 \x01(\x04R\atradeId\"/\n" +
+	"\x19VerifyTradeReceiptRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\xe0\x02\n" +
+	"\fTradeReceipt\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x19\n" +
+	"\btrade_id\x18\x02 \x01(\x04R\atradeId\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x03 \x01(\x04R\tfeatureId\x12#\n" +
+	"\rproperties_id\x18\x04 \x01(\tR\fpropertiesId\x12\x1d\n" +
+	"\n" +
+	"buyer_code\x18\x05 \x01(\tR\tbuyerCode\x12\x1f\n" +
+	"\vseller_code\x18\x06 \x01(\tR\n" +
+	"sellerCode\x12\x1d\n" +
+	"\n" +
+	"psc_amount\x18\a \x01(\x01R\tpscAmount\x12\x1d\n" +
+	"\n" +
+	"irr_amount\x18\b \x01(\x01R\tirrAmount\x12\x12\n" +
+	"\x04date\x18\t \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\n" +
+	" \x01(\tR\x04time\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x1f\n" +
+	"\vrefunded_at\x18\f \x01(\tR\n" +
+	"refundedAt\"B\n" +
+	"\x14TradeReceiptResponse\x12*\n" +
+	"\x04data\x18\x01 \x01(\v2\x16.features.TradeReceiptR\x04data\"K\n" +
+	"\x1cGetAnnualTradeSummaryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\"\xcf\x02\n" +
+	"\vAnnualTrade\x12\x19\n" +
+	"\btrade_id\x18\x01 \x01(\x04R\atradeId\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x02 \x01(\x04R\tfeatureId\x12#\n" +
+	"\rproperties_id\x18\x03 \x01(\tR\fpropertiesId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1b\n" +
+	"\tprice_psc\x18\x06 \x01(\tR\bpricePsc\x12\x1b\n" +
+	"\tprice_irr\x18\a \x01(\tR\bpriceIrr\x12\x17\n" +
+	"\afee_psc\x18\b \x01(\tR\x06feePsc\x12\x17\n" +
+	"\afee_irr\x18\t \x01(\tR\x06feeIrr\x12!\n" +
+	"\freceipt_code\x18\n" +
+	" \x01(\tR\vreceiptCode\x12\x12\n" +
+	"\x04date\x18\v \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\f \x01(\tR\x04time\"\xb1\x02\n" +
+	"\x11AnnualTradeTotals\x12!\n" +
+	"\fbought_count\x18\x01 \x01(\x05R\vboughtCount\x12\x1d\n" +
+	"\n" +
+	"bought_psc\x18\x02 \x01(\tR\tboughtPsc\x12\x1d\n" +
+	"\n" +
+	"bought_irr\x18\x03 \x01(\tR\tboughtIrr\x12\x1d\n" +
+	"\n" +
+	"sold_count\x18\x04 \x01(\x05R\tsoldCount\x12\x19\n" +
+	"\bsold_psc\x18\x05 \x01(\tR\asoldPsc\x12\x19\n" +
+	"\bsold_irr\x18\x06 \x01(\tR\asoldIrr\x12\x19\n" +
+	"\bfees_psc\x18\a \x01(\tR\afeesPsc\x12\x19\n" +
+	"\bfees_irr\x18\b \x01(\tR\afeesIrr\x12\x17\n" +
+	"\anet_psc\x18\t \x01(\tR\x06netPsc\x12\x17\n" +
+	"\anet_irr\x18\n" +
+	" \x01(\tR\x06netIrr\"<\n" +
+	"\fAnnualProfit\x12\x14\n" +
+	"\x05asset\x18\x01 \x01(\tR\x05asset\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\"\xea\x01\n" +
+	"\x1aAnnualTradeSummaryResponse\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x123\n" +
+	"\x06totals\x18\x04 \x01(\v2\x1b.features.AnnualTradeTotalsR\x06totals\x120\n" +
+	"\aprofits\x18\x05 \x03(\v2\x16.features.AnnualProfitR\aprofits\x12-\n" +
+	"\x06trades\x18\x06 \x03(\v2\x15.features.AnnualTradeR\x06trades\"a\n" +
+	"\x17GetUserPortfolioRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x03 \x01(\x05R\aperPage\"`\n" +
+	"\x14PortfolioSellRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
+	"\tprice_psc\x18\x02 \x01(\tR\bpricePsc\x12\x1b\n" +
+	"\tprice_irr\x18\x03 \x01(\tR\bpriceIrr\"\xc0\x02\n" +
+	"\rPortfolioItem\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x12;\n" +
+	"\n" +
+	"properties\x18\x02 \x01(\v2\x1b.features.FeaturePropertiesR\n" +
+	"properties\x12.\n" +
+	"\x13estimated_value_irr\x18\x03 \x01(\tR\x11estimatedValueIrr\x12.\n" +
+	"\x06profit\x18\x04 \x01(\v2\x16.features.HourlyProfitR\x06profit\x12A\n" +
+	"\fsell_request\x18\x05 \x01(\v2\x1e.features.PortfolioSellRequestR\vsellRequest\x120\n" +
+	"\x14pending_buy_requests\x18\x06 \x01(\x05R\x12pendingBuyRequests\"\xa7\x02\n" +
+	"\x10PortfolioSummary\x12#\n" +
+	"\rfeature_count\x18\x01 \x01(\x05R\ffeatureCount\x12.\n" +
+	"\x13estimated_value_irr\x18\x02 \x01(\tR\x11estimatedValueIrr\x12%\n" +
+	"\x0eprofit_maskoni\x18\x03 \x01(\tR\rprofitMaskoni\x12#\n" +
+	"\rprofit_tejari\x18\x04 \x01(\tR\fprofitTejari\x12'\n" +
+	"\x0fprofit_amozeshi\x18\x05 \x01(\tR\x0eprofitAmozeshi\x12\x17\n" +
+	"\aon_sale\x18\x06 \x01(\x05R\x06onSale\x120\n" +
+	"\x14pending_buy_requests\x18\a \x01(\x05R\x12pendingBuyRequests\"\x80\x01\n" +
+	"\rPortfolioMeta\x12!\n" +
+	"\fcurrent_page\x18\x01 \x01(\x05R\vcurrentPage\x12\x19\n" +
+	"\bper_page\x18\x02 \x01(\x05R\aperPage\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x1b\n" +
+	"\tlast_page\x18\x04 \x01(\x05R\blastPage\"\xa7\x01\n" +
+	"\x15UserPortfolioResponse\x12+\n" +
+	"\x04data\x18\x01 \x03(\v2\x17.features.PortfolioItemR\x04data\x124\n" +
+	"\asummary\x18\x02 \x01(\v2\x1a.features.PortfolioSummaryR\asummary\x12+\n" +
+	"\x04meta\x18\x03 \x01(\v2\x17.features.PortfolioMetaR\x04meta\"\x81\x01\n" +
+	"\x18GetFeatureHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x01 \x01(\x04R\tfeatureId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x04 \x01(\x05R\aperPage\"\xb6\x02\n" +
+	"\x13FeatureHistoryEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12!\n" +
+	"\freference_id\x18\x02 \x01(\x04R\vreferenceId\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\x04 \x01(\tR\x04time\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x04R\x06userId\x12'\n" +
+	"\x0fcounterparty_id\x18\x06 \x01(\x04R\x0ecounterpartyId\x12\x1b\n" +
+	"\tprice_psc\x18\a \x01(\tR\bpricePsc\x12\x1b\n" +
+	"\tprice_irr\x18\b \x01(\tR\bpriceIrr\x12\x14\n" +
+	"\x05asset\x18\t \x01(\tR\x05asset\x12\x16\n" +
+	"\x06amount\x18\n" +
+	" \x01(\tR\x06amount\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\"w\n" +
+	"\x16FeatureHistoryResponse\x121\n" +
+	"\x04data\x18\x01 \x03(\v2\x1d.features.FeatureHistoryEventR\x04data\x12*\n" +
+	"\x04meta\x18\x02 \x01(\v2\x16.common.PaginationMetaR\x04meta2\x86\a\n" +
 	"\x0eFeatureService\x12I\n" +
 	"\fListFeatures\x12\x1d.features.ListFeaturesRequest\x1a\x1a.features.FeaturesResponse\x12D\n" +
 	"\n" +
@@ -4689,7 +10347,8 @@ const file_features_proto_rawDesc = "" +
 	"\fGetMyFeature\x12\x1d.features.GetMyFeatureRequest\x1a\x19.features.FeatureResponse\x12T\n" +
 	"\x12AddMyFeatureImages\x12#.features.AddMyFeatureImagesRequest\x1a\x19.features.FeatureResponse\x12U\n" +
 	"\x14RemoveMyFeatureImage\x12%.features.RemoveMyFeatureImageRequest\x1a\x16.google.protobuf.Empty\x12K\n" +
-	"\x0fUpdateMyFeature\x12 .features.UpdateMyFeatureRequest\x1a\x16.google.protobuf.Empty2\x8b\b\n" +
+	"\x0fUpdateMyFeature\x12 .features.UpdateMyFeatureRequest\x1a\x16.google.protobuf.Empty\x12_\n" +
+	"\x12CountOwnedFeatures\x12#.features.CountOwnedFeaturesRequest\x1a$.features.OwnedFeatureCountsResponse2\xfb\r\n" +
 	"\x19FeatureMarketplaceService\x12G\n" +
 	"\n" +
 	"BuyFeature\x12\x1b.features.BuyFeatureRequest\x1a\x1c.features.BuyFeatureResponse\x12O\n" +
@@ -4703,21 +10362,70 @@ const file_features_proto_rawDesc = "" +
 	"\x17ListReceivedBuyRequests\x12(.features.ListReceivedBuyRequestsRequest\x1a\x1d.features.BuyRequestsResponse\x12M\n" +
 	"\x10RejectBuyRequest\x12!.features.RejectBuyRequestRequest\x1a\x16.google.protobuf.Empty\x12M\n" +
 	"\x10DeleteBuyRequest\x12!.features.DeleteBuyRequestRequest\x1a\x16.google.protobuf.Empty\x12O\n" +
-	"\x11UpdateGracePeriod\x12\".features.UpdateGracePeriodRequest\x1a\x16.google.protobuf.Empty2\xb0\x02\n" +
+	"\x11UpdateGracePeriod\x12\".features.UpdateGracePeriodRequest\x1a\x16.google.protobuf.Empty\x12b\n" +
+	"\x13ListForSaleFeatures\x12$.features.ListForSaleFeaturesRequest\x1a%.features.ListForSaleFeaturesResponse\x12U\n" +
+	"\x0eReserveFeature\x12$.features.CheckoutReservationRequest\x1a\x1d.features.CheckoutReservation\x12R\n" +
+	"\x12ReleaseReservation\x12$.features.CheckoutReservationRequest\x1a\x16.google.protobuf.Empty\x12\x80\x01\n" +
+	"\x1dGetLimitedFeatureAvailability\x12..features.GetLimitedFeatureAvailabilityRequest\x1a/.features.GetLimitedFeatureAvailabilityResponse\x12V\n" +
+	"\x11CounterBuyRequest\x12\".features.CounterBuyRequestRequest\x1a\x1d.features.NegotiationResponse\x12W\n" +
+	"\x12AcceptCounterOffer\x12#.features.AnswerCounterOfferRequest\x1a\x1c.features.BuyRequestResponse\x12Y\n" +
+	"\x13DeclineCounterOffer\x12#.features.AnswerCounterOfferRequest\x1a\x1d.features.NegotiationResponse\x12P\n" +
+	"\x0eGetNegotiation\x12\x1f.features.GetNegotiationRequest\x1a\x1d.features.NegotiationResponse2\xc4\x04\n" +
 	"\x14FeatureProfitService\x12V\n" +
 	"\x10GetHourlyProfits\x12!.features.GetHourlyProfitsRequest\x1a\x1f.features.HourlyProfitsResponse\x12S\n" +
 	"\x0fGetSingleProfit\x12 .features.GetSingleProfitRequest\x1a\x1e.features.HourlyProfitResponse\x12k\n" +
-	"\x17GetProfitsByApplication\x12(.features.GetProfitsByApplicationRequest\x1a&.features.ProfitsByApplicationResponse2\xa1\x03\n" +
+	"\x17GetProfitsByApplication\x12(.features.GetProfitsByApplicationRequest\x1a&.features.ProfitsByApplicationResponse\x12V\n" +
+	"\x10GetFeatureProfit\x12!.features.GetFeatureProfitRequest\x1a\x1f.features.FeatureProfitResponse\x12Y\n" +
+	"\x11GetProfitSettings\x12\".features.GetProfitSettingsRequest\x1a .features.ProfitSettingsResponse\x12_\n" +
+	"\x14UpdateProfitSettings\x12%.features.UpdateProfitSettingsRequest\x1a .features.ProfitSettingsResponse2\xca\x04\n" +
 	"\x0fBuildingService\x12S\n" +
-	"\x0fGetBuildPackage\x12 .features.GetBuildPackageRequest\x1a\x1e.features.BuildPackageResponse\x12M\n" +
+	"\x0fGetBuildPackage\x12 .features.GetBuildPackageRequest\x1a\x1e.features.BuildPackageResponse\x12U\n" +
+	"\x12StreamBuildPackage\x12 .features.GetBuildPackageRequest\x1a\x1b.features.BuildPackageChunk0\x01\x12M\n" +
 	"\fBuildFeature\x12\x1d.features.BuildFeatureRequest\x1a\x1e.features.BuildFeatureResponse\x12J\n" +
 	"\fGetBuildings\x12\x1d.features.GetBuildingsRequest\x1a\x1b.features.BuildingsResponse\x12M\n" +
 	"\x0eUpdateBuilding\x12\x1f.features.UpdateBuildingRequest\x1a\x1a.features.BuildingResponse\x12O\n" +
-	"\x0fDestroyBuilding\x12 .features.DestroyBuildingRequest\x1a\x1a.features.BuildingResponse2\xd6\x01\n" +
+	"\x0fDestroyBuilding\x12 .features.DestroyBuildingRequest\x1a\x1a.features.BuildingResponse\x12P\n" +
+	"\rSimulateBuild\x12\x1e.features.SimulateBuildRequest\x1a\x1f.features.SimulateBuildResponse2\xd6\x01\n" +
 	"\vMapsService\x12A\n" +
 	"\bListMaps\x12\x19.features.ListMapsRequest\x1a\x1a.features.ListMapsResponse\x12;\n" +
 	"\x06GetMap\x12\x17.features.GetMapRequest\x1a\x18.features.GetMapResponse\x12G\n" +
-	"\fGetMapBorder\x12\x17.features.GetMapRequest\x1a\x1e.features.GetMapBorderResponseB\x1cZ\x1ametargb/shared/pb/featuresb\x06proto3"
+	"\fGetMapBorder\x12\x17.features.GetMapRequest\x1a\x1e.features.GetMapBorderResponse2\x8d\x02\n" +
+	"\x10WatchlistService\x12R\n" +
+	"\x0eAddToWatchlist\x12\x1f.features.AddToWatchlistRequest\x1a\x1f.features.WatchlistItemResponse\x12S\n" +
+	"\x13RemoveFromWatchlist\x12$.features.RemoveFromWatchlistRequest\x1a\x16.google.protobuf.Empty\x12P\n" +
+	"\rListWatchlist\x12\x1e.features.ListWatchlistRequest\x1a\x1f.features.ListWatchlistResponse2\xf3\x02\n" +
+	"\x12SavedSearchService\x12V\n" +
+	"\x11CreateSavedSearch\x12\".features.CreateSavedSearchRequest\x1a\x1d.features.SavedSearchResponse\x12V\n" +
+	"\x11UpdateSavedSearch\x12\".features.UpdateSavedSearchRequest\x1a\x1d.features.SavedSearchResponse\x12O\n" +
+	"\x11DeleteSavedSearch\x12\".features.DeleteSavedSearchRequest\x1a\x16.google.protobuf.Empty\x12\\\n" +
+	"\x11ListSavedSearches\x12\".features.ListSavedSearchesRequest\x1a#.features.ListSavedSearchesResponse2\xa6\x02\n" +
+	"\fTradeService\x12>\n" +
+	"\bGetTrade\x12\x19.features.GetTradeRequest\x1a\x17.features.TradeResponse\x12G\n" +
+	"\x10FreezeTradeFunds\x12\x1b.features.TradeFundsRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\x11ReleaseTradeFunds\x12\x1b.features.TradeFundsRequest\x1a\x16.google.protobuf.Empty\x12C\n" +
+	"\vRefundTrade\x12\x1c.features.RefundTradeRequest\x1a\x16.google.protobuf.Empty2\xe3\x01\n" +
+	"\x16FeatureGeometryService\x12b\n" +
+	"\x15UpdateFeatureGeometry\x12&.features.UpdateFeatureGeometryRequest\x1a!.features.GeometryVersionResponse\x12e\n" +
+	"\x14ListGeometryVersions\x12%.features.ListGeometryVersionsRequest\x1a&.features.ListGeometryVersionsResponse2\xb3\x02\n" +
+	"\x19FeatureInstallmentService\x12O\n" +
+	"\x0eReserveFeature\x12\x1f.features.ReserveFeatureRequest\x1a\x1c.features.FeatureReservation\x12k\n" +
+	"\x18CompleteReservedPurchase\x12#.features.FeatureReservationRequest\x1a*.features.CompleteReservedPurchaseResponse\x12X\n" +
+	"\x19ReleaseFeatureReservation\x12#.features.FeatureReservationRequest\x1a\x16.google.protobuf.Empty2\xeb\x03\n" +
+	"\x15FeatureGalleryService\x12X\n" +
+	"\x11ListFeatureImages\x12\".features.ListFeatureImagesRequest\x1a\x1f.features.FeatureImagesResponse\x12\\\n" +
+	"\x13AttachFeatureImages\x12$.features.AttachFeatureImagesRequest\x1a\x1f.features.FeatureImagesResponse\x12Z\n" +
+	"\x12RemoveFeatureImage\x12#.features.RemoveFeatureImageRequest\x1a\x1f.features.FeatureImagesResponse\x12^\n" +
+	"\x14ReorderFeatureImages\x12%.features.ReorderFeatureImagesRequest\x1a\x1f.features.FeatureImagesResponse\x12^\n" +
+	"\x14SetFeatureCoverImage\x12%.features.SetFeatureCoverImageRequest\x1a\x1f.features.FeatureImagesResponse2\xc5\x01\n" +
+	"\x13TradeReceiptService\x12S\n" +
+	"\x0fGetTradeReceipt\x12 .features.GetTradeReceiptRequest\x1a\x1e.features.TradeReceiptResponse\x12Y\n" +
+	"\x12VerifyTradeReceipt\x12#.features.VerifyTradeReceiptRequest\x1a\x1e.features.TradeReceiptResponse2|\n" +
+	"\x13TradeSummaryService\x12e\n" +
+	"\x15GetAnnualTradeSummary\x12&.features.GetAnnualTradeSummaryRequest\x1a$.features.AnnualTradeSummaryResponse2q\n" +
+	"\x17FeaturePortfolioService\x12V\n" +
+	"\x10GetUserPortfolio\x12!.features.GetUserPortfolioRequest\x1a\x1f.features.UserPortfolioResponse2r\n" +
+	"\x15FeatureHistoryService\x12Y\n" +
+	"\x11GetFeatureHistory\x12\".features.GetFeatureHistoryRequest\x1a .features.FeatureHistoryResponseB\x1cZ\x1ametargb/shared/pb/featuresb\x06proto3"
 
 var (
 	file_features_proto_rawDescOnce sync.Once
@@ -4731,187 +10439,379 @@ func file_features_proto_rawDescGZIP() []byte {
 	return file_features_proto_rawDescData
 }
 
-var file_features_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
+var file_features_proto_msgTypes = make([]protoimpl.MessageInfo, 146)
 var file_features_proto_goTypes = []any{
-	(*ListFeaturesRequest)(nil),            // 0: features.ListFeaturesRequest
-	(*FeaturesResponse)(nil),               // 1: features.FeaturesResponse
-	(*GetFeatureRequest)(nil),              // 2: features.GetFeatureRequest
-	(*FeatureResponse)(nil),                // 3: features.FeatureResponse
-	(*UpdateFeatureRequest)(nil),           // 4: features.UpdateFeatureRequest
-	(*AddFeatureImagesRequest)(nil),        // 5: features.AddFeatureImagesRequest
-	(*GetMyFeaturesRequest)(nil),           // 6: features.GetMyFeaturesRequest
-	(*ListMyFeaturesRequest)(nil),          // 7: features.ListMyFeaturesRequest
-	(*ListMyFeaturesResponse)(nil),         // 8: features.ListMyFeaturesResponse
-	(*GetMyFeatureRequest)(nil),            // 9: features.GetMyFeatureRequest
-	(*AddMyFeatureImagesRequest)(nil),      // 10: features.AddMyFeatureImagesRequest
-	(*RemoveMyFeatureImageRequest)(nil),    // 11: features.RemoveMyFeatureImageRequest
-	(*UpdateMyFeatureRequest)(nil),         // 12: features.UpdateMyFeatureRequest
-	(*PaginationLinks)(nil),                // 13: features.PaginationLinks
-	(*SimplePaginationMeta)(nil),           // 14: features.SimplePaginationMeta
-	(*Feature)(nil),                        // 15: features.Feature
-	(*Seller)(nil),                         // 16: features.Seller
-	(*FeatureProperties)(nil),              // 17: features.FeatureProperties
-	(*Geometry)(nil),                       // 18: features.Geometry
-	(*Coordinate)(nil),                     // 19: features.Coordinate
-	(*Image)(nil),                          // 20: features.Image
-	(*BuyFeatureRequest)(nil),              // 21: features.BuyFeatureRequest
-	(*BuyFeatureResponse)(nil),             // 22: features.BuyFeatureResponse
-	(*SendBuyRequestRequest)(nil),          // 23: features.SendBuyRequestRequest
-	(*BuyRequestResponse)(nil),             // 24: features.BuyRequestResponse
-	(*BuyerInfo)(nil),                      // 25: features.BuyerInfo
-	(*SellerInfo)(nil),                     // 26: features.SellerInfo
-	(*ListBuyRequestsRequest)(nil),         // 27: features.ListBuyRequestsRequest
-	(*ListReceivedBuyRequestsRequest)(nil), // 28: features.ListReceivedBuyRequestsRequest
-	(*BuyRequestsResponse)(nil),            // 29: features.BuyRequestsResponse
-	(*RejectBuyRequestRequest)(nil),        // 30: features.RejectBuyRequestRequest
-	(*DeleteBuyRequestRequest)(nil),        // 31: features.DeleteBuyRequestRequest
-	(*UpdateGracePeriodRequest)(nil),       // 32: features.UpdateGracePeriodRequest
-	(*AcceptBuyRequestRequest)(nil),        // 33: features.AcceptBuyRequestRequest
-	(*CreateSellRequestRequest)(nil),       // 34: features.CreateSellRequestRequest
-	(*ListSellRequestsRequest)(nil),        // 35: features.ListSellRequestsRequest
-	(*DeleteSellRequestRequest)(nil),       // 36: features.DeleteSellRequestRequest
-	(*SellRequestResponse)(nil),            // 37: features.SellRequestResponse
-	(*SellRequestsResponse)(nil),           // 38: features.SellRequestsResponse
-	(*RequestGracePeriodRequest)(nil),      // 39: features.RequestGracePeriodRequest
-	(*GracePeriodResponse)(nil),            // 40: features.GracePeriodResponse
-	(*GetHourlyProfitsRequest)(nil),        // 41: features.GetHourlyProfitsRequest
-	(*HourlyProfitsResponse)(nil),          // 42: features.HourlyProfitsResponse
-	(*HourlyProfit)(nil),                   // 43: features.HourlyProfit
-	(*GetSingleProfitRequest)(nil),         // 44: features.GetSingleProfitRequest
-	(*HourlyProfitResponse)(nil),           // 45: features.HourlyProfitResponse
-	(*GetProfitsByApplicationRequest)(nil), // 46: features.GetProfitsByApplicationRequest
-	(*ProfitsByApplicationResponse)(nil),   // 47: features.ProfitsByApplicationResponse
-	(*GetBuildPackageRequest)(nil),         // 48: features.GetBuildPackageRequest
-	(*BuildPackageResponse)(nil),           // 49: features.BuildPackageResponse
-	(*BuildingModel)(nil),                  // 50: features.BuildingModel
-	(*BuildFeatureRequest)(nil),            // 51: features.BuildFeatureRequest
-	(*BuildingInformation)(nil),            // 52: features.BuildingInformation
-	(*BuildFeatureResponse)(nil),           // 53: features.BuildFeatureResponse
-	(*GetBuildingsRequest)(nil),            // 54: features.GetBuildingsRequest
-	(*BuildingsResponse)(nil),              // 55: features.BuildingsResponse
-	(*Building)(nil),                       // 56: features.Building
-	(*UpdateBuildingRequest)(nil),          // 57: features.UpdateBuildingRequest
-	(*BuildingResponse)(nil),               // 58: features.BuildingResponse
-	(*DestroyBuildingRequest)(nil),         // 59: features.DestroyBuildingRequest
-	(*ListMapsRequest)(nil),                // 60: features.ListMapsRequest
-	(*GetMapRequest)(nil),                  // 61: features.GetMapRequest
-	(*ListMapsResponse)(nil),               // 62: features.ListMapsResponse
-	(*GetMapResponse)(nil),                 // 63: features.GetMapResponse
-	(*GetMapBorderResponse)(nil),           // 64: features.GetMapBorderResponse
-	(*MapBorderData)(nil),                  // 65: features.MapBorderData
-	(*Map)(nil),                            // 66: features.Map
-	(*MapFeatures)(nil),                    // 67: features.MapFeatures
-	(*MapFeatureCount)(nil),                // 68: features.MapFeatureCount
-	(*emptypb.Empty)(nil),                  // 69: google.protobuf.Empty
+	(*ListFeaturesRequest)(nil),                   // 0: features.ListFeaturesRequest
+	(*FeaturesResponse)(nil),                      // 1: features.FeaturesResponse
+	(*GetFeatureRequest)(nil),                     // 2: features.GetFeatureRequest
+	(*FeatureResponse)(nil),                       // 3: features.FeatureResponse
+	(*UpdateFeatureRequest)(nil),                  // 4: features.UpdateFeatureRequest
+	(*AddFeatureImagesRequest)(nil),               // 5: features.AddFeatureImagesRequest
+	(*GetMyFeaturesRequest)(nil),                  // 6: features.GetMyFeaturesRequest
+	(*ListMyFeaturesRequest)(nil),                 // 7: features.ListMyFeaturesRequest
+	(*ListMyFeaturesResponse)(nil),                // 8: features.ListMyFeaturesResponse
+	(*GetMyFeatureRequest)(nil),                   // 9: features.GetMyFeatureRequest
+	(*AddMyFeatureImagesRequest)(nil),             // 10: features.AddMyFeatureImagesRequest
+	(*RemoveMyFeatureImageRequest)(nil),           // 11: features.RemoveMyFeatureImageRequest
+	(*UpdateMyFeatureRequest)(nil),                // 12: features.UpdateMyFeatureRequest
+	(*CountOwnedFeaturesRequest)(nil),             // 13: features.CountOwnedFeaturesRequest
+	(*OwnedFeatureCountsResponse)(nil),            // 14: features.OwnedFeatureCountsResponse
+	(*PaginationLinks)(nil),                       // 15: features.PaginationLinks
+	(*SimplePaginationMeta)(nil),                  // 16: features.SimplePaginationMeta
+	(*Feature)(nil),                               // 17: features.Feature
+	(*Seller)(nil),                                // 18: features.Seller
+	(*FeatureProperties)(nil),                     // 19: features.FeatureProperties
+	(*Geometry)(nil),                              // 20: features.Geometry
+	(*Coordinate)(nil),                            // 21: features.Coordinate
+	(*Image)(nil),                                 // 22: features.Image
+	(*BuyFeatureRequest)(nil),                     // 23: features.BuyFeatureRequest
+	(*BuyFeatureResponse)(nil),                    // 24: features.BuyFeatureResponse
+	(*CheckoutReservationRequest)(nil),            // 25: features.CheckoutReservationRequest
+	(*CheckoutReservation)(nil),                   // 26: features.CheckoutReservation
+	(*GetLimitedFeatureAvailabilityRequest)(nil),  // 27: features.GetLimitedFeatureAvailabilityRequest
+	(*LimitedFeatureAvailability)(nil),            // 28: features.LimitedFeatureAvailability
+	(*GetLimitedFeatureAvailabilityResponse)(nil), // 29: features.GetLimitedFeatureAvailabilityResponse
+	(*SendBuyRequestRequest)(nil),                 // 30: features.SendBuyRequestRequest
+	(*BuyRequestResponse)(nil),                    // 31: features.BuyRequestResponse
+	(*BuyerInfo)(nil),                             // 32: features.BuyerInfo
+	(*SellerInfo)(nil),                            // 33: features.SellerInfo
+	(*ListBuyRequestsRequest)(nil),                // 34: features.ListBuyRequestsRequest
+	(*ListReceivedBuyRequestsRequest)(nil),        // 35: features.ListReceivedBuyRequestsRequest
+	(*BuyRequestsResponse)(nil),                   // 36: features.BuyRequestsResponse
+	(*RejectBuyRequestRequest)(nil),               // 37: features.RejectBuyRequestRequest
+	(*DeleteBuyRequestRequest)(nil),               // 38: features.DeleteBuyRequestRequest
+	(*UpdateGracePeriodRequest)(nil),              // 39: features.UpdateGracePeriodRequest
+	(*AcceptBuyRequestRequest)(nil),               // 40: features.AcceptBuyRequestRequest
+	(*CounterBuyRequestRequest)(nil),              // 41: features.CounterBuyRequestRequest
+	(*AnswerCounterOfferRequest)(nil),             // 42: features.AnswerCounterOfferRequest
+	(*GetNegotiationRequest)(nil),                 // 43: features.GetNegotiationRequest
+	(*BuyRequestOffer)(nil),                       // 44: features.BuyRequestOffer
+	(*NegotiationResponse)(nil),                   // 45: features.NegotiationResponse
+	(*CreateSellRequestRequest)(nil),              // 46: features.CreateSellRequestRequest
+	(*ListSellRequestsRequest)(nil),               // 47: features.ListSellRequestsRequest
+	(*DeleteSellRequestRequest)(nil),              // 48: features.DeleteSellRequestRequest
+	(*SellRequestResponse)(nil),                   // 49: features.SellRequestResponse
+	(*SellRequestsResponse)(nil),                  // 50: features.SellRequestsResponse
+	(*ListForSaleFeaturesRequest)(nil),            // 51: features.ListForSaleFeaturesRequest
+	(*MarketplaceListing)(nil),                    // 52: features.MarketplaceListing
+	(*ListForSaleFeaturesResponse)(nil),           // 53: features.ListForSaleFeaturesResponse
+	(*RequestGracePeriodRequest)(nil),             // 54: features.RequestGracePeriodRequest
+	(*GracePeriodResponse)(nil),                   // 55: features.GracePeriodResponse
+	(*GetHourlyProfitsRequest)(nil),               // 56: features.GetHourlyProfitsRequest
+	(*HourlyProfitsResponse)(nil),                 // 57: features.HourlyProfitsResponse
+	(*HourlyProfit)(nil),                          // 58: features.HourlyProfit
+	(*GetSingleProfitRequest)(nil),                // 59: features.GetSingleProfitRequest
+	(*HourlyProfitResponse)(nil),                  // 60: features.HourlyProfitResponse
+	(*GetProfitsByApplicationRequest)(nil),        // 61: features.GetProfitsByApplicationRequest
+	(*ProfitsByApplicationResponse)(nil),          // 62: features.ProfitsByApplicationResponse
+	(*GetFeatureProfitRequest)(nil),               // 63: features.GetFeatureProfitRequest
+	(*FeatureProfitResponse)(nil),                 // 64: features.FeatureProfitResponse
+	(*GetProfitSettingsRequest)(nil),              // 65: features.GetProfitSettingsRequest
+	(*UpdateProfitSettingsRequest)(nil),           // 66: features.UpdateProfitSettingsRequest
+	(*ProfitSettingsResponse)(nil),                // 67: features.ProfitSettingsResponse
+	(*GetBuildPackageRequest)(nil),                // 68: features.GetBuildPackageRequest
+	(*BuildPackageResponse)(nil),                  // 69: features.BuildPackageResponse
+	(*BuildPackageChunk)(nil),                     // 70: features.BuildPackageChunk
+	(*BuildingModel)(nil),                         // 71: features.BuildingModel
+	(*BuildFeatureRequest)(nil),                   // 72: features.BuildFeatureRequest
+	(*BuildingInformation)(nil),                   // 73: features.BuildingInformation
+	(*BuildFeatureResponse)(nil),                  // 74: features.BuildFeatureResponse
+	(*GetBuildingsRequest)(nil),                   // 75: features.GetBuildingsRequest
+	(*BuildingsResponse)(nil),                     // 76: features.BuildingsResponse
+	(*Building)(nil),                              // 77: features.Building
+	(*UpdateBuildingRequest)(nil),                 // 78: features.UpdateBuildingRequest
+	(*BuildingResponse)(nil),                      // 79: features.BuildingResponse
+	(*DestroyBuildingRequest)(nil),                // 80: features.DestroyBuildingRequest
+	(*SimulateBuildRequest)(nil),                  // 81: features.SimulateBuildRequest
+	(*SimulateBuildResponse)(nil),                 // 82: features.SimulateBuildResponse
+	(*BuildRequirement)(nil),                      // 83: features.BuildRequirement
+	(*ListMapsRequest)(nil),                       // 84: features.ListMapsRequest
+	(*GetMapRequest)(nil),                         // 85: features.GetMapRequest
+	(*ListMapsResponse)(nil),                      // 86: features.ListMapsResponse
+	(*GetMapResponse)(nil),                        // 87: features.GetMapResponse
+	(*GetMapBorderResponse)(nil),                  // 88: features.GetMapBorderResponse
+	(*MapBorderData)(nil),                         // 89: features.MapBorderData
+	(*Map)(nil),                                   // 90: features.Map
+	(*MapFeatures)(nil),                           // 91: features.MapFeatures
+	(*MapFeatureCount)(nil),                       // 92: features.MapFeatureCount
+	(*AddToWatchlistRequest)(nil),                 // 93: features.AddToWatchlistRequest
+	(*RemoveFromWatchlistRequest)(nil),            // 94: features.RemoveFromWatchlistRequest
+	(*ListWatchlistRequest)(nil),                  // 95: features.ListWatchlistRequest
+	(*WatchlistItem)(nil),                         // 96: features.WatchlistItem
+	(*WatchlistItemResponse)(nil),                 // 97: features.WatchlistItemResponse
+	(*ListWatchlistResponse)(nil),                 // 98: features.ListWatchlistResponse
+	(*CreateSavedSearchRequest)(nil),              // 99: features.CreateSavedSearchRequest
+	(*UpdateSavedSearchRequest)(nil),              // 100: features.UpdateSavedSearchRequest
+	(*DeleteSavedSearchRequest)(nil),              // 101: features.DeleteSavedSearchRequest
+	(*ListSavedSearchesRequest)(nil),              // 102: features.ListSavedSearchesRequest
+	(*SavedSearch)(nil),                           // 103: features.SavedSearch
+	(*SavedSearchResponse)(nil),                   // 104: features.SavedSearchResponse
+	(*ListSavedSearchesResponse)(nil),             // 105: features.ListSavedSearchesResponse
+	(*GetTradeRequest)(nil),                       // 106: features.GetTradeRequest
+	(*TradeFundsRequest)(nil),                     // 107: features.TradeFundsRequest
+	(*RefundTradeRequest)(nil),                    // 108: features.RefundTradeRequest
+	(*TradeDetails)(nil),                          // 109: features.TradeDetails
+	(*TradeResponse)(nil),                         // 110: features.TradeResponse
+	(*UpdateFeatureGeometryRequest)(nil),          // 111: features.UpdateFeatureGeometryRequest
+	(*ListGeometryVersionsRequest)(nil),           // 112: features.ListGeometryVersionsRequest
+	(*GeometryVersion)(nil),                       // 113: features.GeometryVersion
+	(*GeometryVersionResponse)(nil),               // 114: features.GeometryVersionResponse
+	(*ListGeometryVersionsResponse)(nil),          // 115: features.ListGeometryVersionsResponse
+	(*ReserveFeatureRequest)(nil),                 // 116: features.ReserveFeatureRequest
+	(*FeatureReservationRequest)(nil),             // 117: features.FeatureReservationRequest
+	(*FeatureReservation)(nil),                    // 118: features.FeatureReservation
+	(*CompleteReservedPurchaseResponse)(nil),      // 119: features.CompleteReservedPurchaseResponse
+	(*ListFeatureImagesRequest)(nil),              // 120: features.ListFeatureImagesRequest
+	(*ImageUpload)(nil),                           // 121: features.ImageUpload
+	(*AttachFeatureImagesRequest)(nil),            // 122: features.AttachFeatureImagesRequest
+	(*RemoveFeatureImageRequest)(nil),             // 123: features.RemoveFeatureImageRequest
+	(*ReorderFeatureImagesRequest)(nil),           // 124: features.ReorderFeatureImagesRequest
+	(*SetFeatureCoverImageRequest)(nil),           // 125: features.SetFeatureCoverImageRequest
+	(*FeatureImagesResponse)(nil),                 // 126: features.FeatureImagesResponse
+	(*GetTradeReceiptRequest)(nil),                // 127: features.GetTradeReceiptRequest
+	(*VerifyTradeReceiptRequest)(nil),             // 128: features.VerifyTradeReceiptRequest
+	(*TradeReceipt)(nil),                          // 129: features.TradeReceipt
+	(*TradeReceiptResponse)(nil),                  // 130: features.TradeReceiptResponse
+	(*GetAnnualTradeSummaryRequest)(nil),          // 131: features.GetAnnualTradeSummaryRequest
+	(*AnnualTrade)(nil),                           // 132: features.AnnualTrade
+	(*AnnualTradeTotals)(nil),                     // 133: features.AnnualTradeTotals
+	(*AnnualProfit)(nil),                          // 134: features.AnnualProfit
+	(*AnnualTradeSummaryResponse)(nil),            // 135: features.AnnualTradeSummaryResponse
+	(*GetUserPortfolioRequest)(nil),               // 136: features.GetUserPortfolioRequest
+	(*PortfolioSellRequest)(nil),                  // 137: features.PortfolioSellRequest
+	(*PortfolioItem)(nil),                         // 138: features.PortfolioItem
+	(*PortfolioSummary)(nil),                      // 139: features.PortfolioSummary
+	(*PortfolioMeta)(nil),                         // 140: features.PortfolioMeta
+	(*UserPortfolioResponse)(nil),                 // 141: features.UserPortfolioResponse
+	(*GetFeatureHistoryRequest)(nil),              // 142: features.GetFeatureHistoryRequest
+	(*FeatureHistoryEvent)(nil),                   // 143: features.FeatureHistoryEvent
+	(*FeatureHistoryResponse)(nil),                // 144: features.FeatureHistoryResponse
+	nil,                                           // 145: features.OwnedFeatureCountsResponse.CountsEntry
+	(*common.PaginationMeta)(nil),                 // 146: common.PaginationMeta
+	(*emptypb.Empty)(nil),                         // 147: google.protobuf.Empty
 }
 var file_features_proto_depIdxs = []int32{
-	15, // 0: features.FeaturesResponse.features:type_name -> features.Feature
-	15, // 1: features.FeatureResponse.feature:type_name -> features.Feature
-	17, // 2: features.UpdateFeatureRequest.properties:type_name -> features.FeatureProperties
-	15, // 3: features.ListMyFeaturesResponse.data:type_name -> features.Feature
-	13, // 4: features.ListMyFeaturesResponse.links:type_name -> features.PaginationLinks
-	14, // 5: features.ListMyFeaturesResponse.meta:type_name -> features.SimplePaginationMeta
-	17, // 6: features.Feature.properties:type_name -> features.FeatureProperties
-	18, // 7: features.Feature.geometry:type_name -> features.Geometry
-	20, // 8: features.Feature.images:type_name -> features.Image
-	16, // 9: features.Feature.seller:type_name -> features.Seller
-	56, // 10: features.Feature.building_models:type_name -> features.Building
-	19, // 11: features.Geometry.coordinates:type_name -> features.Coordinate
-	15, // 12: features.BuyFeatureResponse.feature:type_name -> features.Feature
-	25, // 13: features.BuyRequestResponse.buyer:type_name -> features.BuyerInfo
-	26, // 14: features.BuyRequestResponse.seller:type_name -> features.SellerInfo
-	17, // 15: features.BuyRequestResponse.feature_properties:type_name -> features.FeatureProperties
-	19, // 16: features.BuyRequestResponse.feature_coordinates:type_name -> features.Coordinate
-	24, // 17: features.BuyRequestsResponse.buy_requests:type_name -> features.BuyRequestResponse
-	17, // 18: features.SellRequestResponse.feature_properties:type_name -> features.FeatureProperties
-	19, // 19: features.SellRequestResponse.feature_coordinates:type_name -> features.Coordinate
-	37, // 20: features.SellRequestsResponse.sell_requests:type_name -> features.SellRequestResponse
-	43, // 21: features.HourlyProfitsResponse.profits:type_name -> features.HourlyProfit
-	43, // 22: features.HourlyProfitResponse.profit:type_name -> features.HourlyProfit
-	50, // 23: features.BuildPackageResponse.models:type_name -> features.BuildingModel
-	52, // 24: features.BuildFeatureRequest.information:type_name -> features.BuildingInformation
-	56, // 25: features.BuildingsResponse.buildings:type_name -> features.Building
-	50, // 26: features.Building.model:type_name -> features.BuildingModel
-	52, // 27: features.UpdateBuildingRequest.information:type_name -> features.BuildingInformation
-	56, // 28: features.BuildingResponse.building:type_name -> features.Building
-	66, // 29: features.ListMapsResponse.maps:type_name -> features.Map
-	66, // 30: features.GetMapResponse.map:type_name -> features.Map
-	65, // 31: features.GetMapBorderResponse.data:type_name -> features.MapBorderData
-	67, // 32: features.Map.features:type_name -> features.MapFeatures
-	68, // 33: features.MapFeatures.maskoni:type_name -> features.MapFeatureCount
-	68, // 34: features.MapFeatures.tejari:type_name -> features.MapFeatureCount
-	68, // 35: features.MapFeatures.amoozeshi:type_name -> features.MapFeatureCount
-	0,  // 36: features.FeatureService.ListFeatures:input_type -> features.ListFeaturesRequest
-	2,  // 37: features.FeatureService.GetFeature:input_type -> features.GetFeatureRequest
-	4,  // 38: features.FeatureService.UpdateFeature:input_type -> features.UpdateFeatureRequest
-	5,  // 39: features.FeatureService.AddFeatureImages:input_type -> features.AddFeatureImagesRequest
-	6,  // 40: features.FeatureService.GetMyFeatures:input_type -> features.GetMyFeaturesRequest
-	7,  // 41: features.FeatureService.ListMyFeatures:input_type -> features.ListMyFeaturesRequest
-	9,  // 42: features.FeatureService.GetMyFeature:input_type -> features.GetMyFeatureRequest
-	10, // 43: features.FeatureService.AddMyFeatureImages:input_type -> features.AddMyFeatureImagesRequest
-	11, // 44: features.FeatureService.RemoveMyFeatureImage:input_type -> features.RemoveMyFeatureImageRequest
-	12, // 45: features.FeatureService.UpdateMyFeature:input_type -> features.UpdateMyFeatureRequest
-	21, // 46: features.FeatureMarketplaceService.BuyFeature:input_type -> features.BuyFeatureRequest
-	23, // 47: features.FeatureMarketplaceService.SendBuyRequest:input_type -> features.SendBuyRequestRequest
-	33, // 48: features.FeatureMarketplaceService.AcceptBuyRequest:input_type -> features.AcceptBuyRequestRequest
-	34, // 49: features.FeatureMarketplaceService.CreateSellRequest:input_type -> features.CreateSellRequestRequest
-	35, // 50: features.FeatureMarketplaceService.ListSellRequests:input_type -> features.ListSellRequestsRequest
-	36, // 51: features.FeatureMarketplaceService.DeleteSellRequest:input_type -> features.DeleteSellRequestRequest
-	39, // 52: features.FeatureMarketplaceService.RequestGracePeriod:input_type -> features.RequestGracePeriodRequest
-	27, // 53: features.FeatureMarketplaceService.ListBuyRequests:input_type -> features.ListBuyRequestsRequest
-	28, // 54: features.FeatureMarketplaceService.ListReceivedBuyRequests:input_type -> features.ListReceivedBuyRequestsRequest
-	30, // 55: features.FeatureMarketplaceService.RejectBuyRequest:input_type -> features.RejectBuyRequestRequest
-	31, // 56: features.FeatureMarketplaceService.DeleteBuyRequest:input_type -> features.DeleteBuyRequestRequest
-	32, // 57: features.FeatureMarketplaceService.UpdateGracePeriod:input_type -> features.UpdateGracePeriodRequest
-	41, // 58: features.FeatureProfitService.GetHourlyProfits:input_type -> features.GetHourlyProfitsRequest
-	44, // 59: features.FeatureProfitService.GetSingleProfit:input_type -> features.GetSingleProfitRequest
-	46, // 60: features.FeatureProfitService.GetProfitsByApplication:input_type -> features.GetProfitsByApplicationRequest
-	48, // 61: features.BuildingService.GetBuildPackage:input_type -> features.GetBuildPackageRequest
-	51, // 62: features.BuildingService.BuildFeature:input_type -> features.BuildFeatureRequest
-	54, // 63: features.BuildingService.GetBuildings:input_type -> features.GetBuildingsRequest
-	57, // 64: features.BuildingService.UpdateBuilding:input_type -> features.UpdateBuildingRequest
-	59, // 65: features.BuildingService.DestroyBuilding:input_type -> features.DestroyBuildingRequest
-	60, // 66: features.MapsService.ListMaps:input_type -> features.ListMapsRequest
-	61, // 67: features.MapsService.GetMap:input_type -> features.GetMapRequest
-	61, // 68: features.MapsService.GetMapBorder:input_type -> features.GetMapRequest
-	1,  // 69: features.FeatureService.ListFeatures:output_type -> features.FeaturesResponse
-	3,  // 70: features.FeatureService.GetFeature:output_type -> features.FeatureResponse
-	3,  // 71: features.FeatureService.UpdateFeature:output_type -> features.FeatureResponse
-	3,  // 72: features.FeatureService.AddFeatureImages:output_type -> features.FeatureResponse
-	1,  // 73: features.FeatureService.GetMyFeatures:output_type -> features.FeaturesResponse
-	8,  // 74: features.FeatureService.ListMyFeatures:output_type -> features.ListMyFeaturesResponse
-	3,  // 75: features.FeatureService.GetMyFeature:output_type -> features.FeatureResponse
-	3,  // 76: features.FeatureService.AddMyFeatureImages:output_type -> features.FeatureResponse
-	69, // 77: features.FeatureService.RemoveMyFeatureImage:output_type -> google.protobuf.Empty
-	69, // 78: features.FeatureService.UpdateMyFeature:output_type -> google.protobuf.Empty
-	22, // 79: features.FeatureMarketplaceService.BuyFeature:output_type -> features.BuyFeatureResponse
-	24, // 80: features.FeatureMarketplaceService.SendBuyRequest:output_type -> features.BuyRequestResponse
-	24, // 81: features.FeatureMarketplaceService.AcceptBuyRequest:output_type -> features.BuyRequestResponse
-	37, // 82: features.FeatureMarketplaceService.CreateSellRequest:output_type -> features.SellRequestResponse
-	38, // 83: features.FeatureMarketplaceService.ListSellRequests:output_type -> features.SellRequestsResponse
-	69, // 84: features.FeatureMarketplaceService.DeleteSellRequest:output_type -> google.protobuf.Empty
-	40, // 85: features.FeatureMarketplaceService.RequestGracePeriod:output_type -> features.GracePeriodResponse
-	29, // 86: features.FeatureMarketplaceService.ListBuyRequests:output_type -> features.BuyRequestsResponse
-	29, // 87: features.FeatureMarketplaceService.ListReceivedBuyRequests:output_type -> features.BuyRequestsResponse
-	69, // 88: features.FeatureMarketplaceService.RejectBuyRequest:output_type -> google.protobuf.Empty
-	69, // 89: features.FeatureMarketplaceService.DeleteBuyRequest:output_type -> google.protobuf.Empty
-	69, // 90: features.FeatureMarketplaceService.UpdateGracePeriod:output_type -> google.protobuf.Empty
-	42, // 91: features.FeatureProfitService.GetHourlyProfits:output_type -> features.HourlyProfitsResponse
-	45, // 92: features.FeatureProfitService.GetSingleProfit:output_type -> features.HourlyProfitResponse
-	47, // 93: features.FeatureProfitService.GetProfitsByApplication:output_type -> features.ProfitsByApplicationResponse
-	49, // 94: features.BuildingService.GetBuildPackage:output_type -> features.BuildPackageResponse
-	53, // 95: features.BuildingService.BuildFeature:output_type -> features.BuildFeatureResponse
-	55, // 96: features.BuildingService.GetBuildings:output_type -> features.BuildingsResponse
-	58, // 97: features.BuildingService.UpdateBuilding:output_type -> features.BuildingResponse
-	58, // 98: features.BuildingService.DestroyBuilding:output_type -> features.BuildingResponse
-	62, // 99: features.MapsService.ListMaps:output_type -> features.ListMapsResponse
-	63, // 100: features.MapsService.GetMap:output_type -> features.GetMapResponse
-	64, // 101: features.MapsService.GetMapBorder:output_type -> features.GetMapBorderResponse
-	69, // [69:102] is the sub-list for method output_type
-	36, // [36:69] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	17,  // 0: features.FeaturesResponse.features:type_name -> features.Feature
+	17,  // 1: features.FeatureResponse.feature:type_name -> features.Feature
+	19,  // 2: features.UpdateFeatureRequest.properties:type_name -> features.FeatureProperties
+	17,  // 3: features.ListMyFeaturesResponse.data:type_name -> features.Feature
+	15,  // 4: features.ListMyFeaturesResponse.links:type_name -> features.PaginationLinks
+	16,  // 5: features.ListMyFeaturesResponse.meta:type_name -> features.SimplePaginationMeta
+	145, // 6: features.OwnedFeatureCountsResponse.counts:type_name -> features.OwnedFeatureCountsResponse.CountsEntry
+	19,  // 7: features.Feature.properties:type_name -> features.FeatureProperties
+	20,  // 8: features.Feature.geometry:type_name -> features.Geometry
+	22,  // 9: features.Feature.images:type_name -> features.Image
+	18,  // 10: features.Feature.seller:type_name -> features.Seller
+	77,  // 11: features.Feature.building_models:type_name -> features.Building
+	21,  // 12: features.Geometry.coordinates:type_name -> features.Coordinate
+	17,  // 13: features.BuyFeatureResponse.feature:type_name -> features.Feature
+	28,  // 14: features.GetLimitedFeatureAvailabilityResponse.data:type_name -> features.LimitedFeatureAvailability
+	32,  // 15: features.BuyRequestResponse.buyer:type_name -> features.BuyerInfo
+	33,  // 16: features.BuyRequestResponse.seller:type_name -> features.SellerInfo
+	19,  // 17: features.BuyRequestResponse.feature_properties:type_name -> features.FeatureProperties
+	21,  // 18: features.BuyRequestResponse.feature_coordinates:type_name -> features.Coordinate
+	31,  // 19: features.BuyRequestsResponse.buy_requests:type_name -> features.BuyRequestResponse
+	31,  // 20: features.NegotiationResponse.buy_request:type_name -> features.BuyRequestResponse
+	44,  // 21: features.NegotiationResponse.offers:type_name -> features.BuyRequestOffer
+	44,  // 22: features.NegotiationResponse.open_offer:type_name -> features.BuyRequestOffer
+	19,  // 23: features.SellRequestResponse.feature_properties:type_name -> features.FeatureProperties
+	21,  // 24: features.SellRequestResponse.feature_coordinates:type_name -> features.Coordinate
+	49,  // 25: features.SellRequestsResponse.sell_requests:type_name -> features.SellRequestResponse
+	52,  // 26: features.ListForSaleFeaturesResponse.data:type_name -> features.MarketplaceListing
+	15,  // 27: features.ListForSaleFeaturesResponse.links:type_name -> features.PaginationLinks
+	16,  // 28: features.ListForSaleFeaturesResponse.meta:type_name -> features.SimplePaginationMeta
+	58,  // 29: features.HourlyProfitsResponse.profits:type_name -> features.HourlyProfit
+	58,  // 30: features.HourlyProfitResponse.profit:type_name -> features.HourlyProfit
+	58,  // 31: features.FeatureProfitResponse.profit:type_name -> features.HourlyProfit
+	71,  // 32: features.BuildPackageResponse.models:type_name -> features.BuildingModel
+	71,  // 33: features.BuildPackageChunk.models:type_name -> features.BuildingModel
+	73,  // 34: features.BuildFeatureRequest.information:type_name -> features.BuildingInformation
+	77,  // 35: features.BuildingsResponse.buildings:type_name -> features.Building
+	71,  // 36: features.Building.model:type_name -> features.BuildingModel
+	73,  // 37: features.UpdateBuildingRequest.information:type_name -> features.BuildingInformation
+	77,  // 38: features.BuildingResponse.building:type_name -> features.Building
+	83,  // 39: features.SimulateBuildResponse.missing:type_name -> features.BuildRequirement
+	90,  // 40: features.ListMapsResponse.maps:type_name -> features.Map
+	90,  // 41: features.GetMapResponse.map:type_name -> features.Map
+	89,  // 42: features.GetMapBorderResponse.data:type_name -> features.MapBorderData
+	91,  // 43: features.Map.features:type_name -> features.MapFeatures
+	92,  // 44: features.MapFeatures.maskoni:type_name -> features.MapFeatureCount
+	92,  // 45: features.MapFeatures.tejari:type_name -> features.MapFeatureCount
+	92,  // 46: features.MapFeatures.amoozeshi:type_name -> features.MapFeatureCount
+	96,  // 47: features.WatchlistItemResponse.data:type_name -> features.WatchlistItem
+	96,  // 48: features.ListWatchlistResponse.data:type_name -> features.WatchlistItem
+	103, // 49: features.SavedSearchResponse.data:type_name -> features.SavedSearch
+	103, // 50: features.ListSavedSearchesResponse.data:type_name -> features.SavedSearch
+	109, // 51: features.TradeResponse.data:type_name -> features.TradeDetails
+	21,  // 52: features.UpdateFeatureGeometryRequest.coordinates:type_name -> features.Coordinate
+	21,  // 53: features.GeometryVersion.coordinates:type_name -> features.Coordinate
+	113, // 54: features.GeometryVersionResponse.data:type_name -> features.GeometryVersion
+	113, // 55: features.ListGeometryVersionsResponse.data:type_name -> features.GeometryVersion
+	121, // 56: features.AttachFeatureImagesRequest.images:type_name -> features.ImageUpload
+	22,  // 57: features.FeatureImagesResponse.data:type_name -> features.Image
+	129, // 58: features.TradeReceiptResponse.data:type_name -> features.TradeReceipt
+	133, // 59: features.AnnualTradeSummaryResponse.totals:type_name -> features.AnnualTradeTotals
+	134, // 60: features.AnnualTradeSummaryResponse.profits:type_name -> features.AnnualProfit
+	132, // 61: features.AnnualTradeSummaryResponse.trades:type_name -> features.AnnualTrade
+	19,  // 62: features.PortfolioItem.properties:type_name -> features.FeatureProperties
+	58,  // 63: features.PortfolioItem.profit:type_name -> features.HourlyProfit
+	137, // 64: features.PortfolioItem.sell_request:type_name -> features.PortfolioSellRequest
+	138, // 65: features.UserPortfolioResponse.data:type_name -> features.PortfolioItem
+	139, // 66: features.UserPortfolioResponse.summary:type_name -> features.PortfolioSummary
+	140, // 67: features.UserPortfolioResponse.meta:type_name -> features.PortfolioMeta
+	143, // 68: features.FeatureHistoryResponse.data:type_name -> features.FeatureHistoryEvent
+	146, // 69: features.FeatureHistoryResponse.meta:type_name -> common.PaginationMeta
+	0,   // 70: features.FeatureService.ListFeatures:input_type -> features.ListFeaturesRequest
+	2,   // 71: features.FeatureService.GetFeature:input_type -> features.GetFeatureRequest
+	4,   // 72: features.FeatureService.UpdateFeature:input_type -> features.UpdateFeatureRequest
+	5,   // 73: features.FeatureService.AddFeatureImages:input_type -> features.AddFeatureImagesRequest
+	6,   // 74: features.FeatureService.GetMyFeatures:input_type -> features.GetMyFeaturesRequest
+	7,   // 75: features.FeatureService.ListMyFeatures:input_type -> features.ListMyFeaturesRequest
+	9,   // 76: features.FeatureService.GetMyFeature:input_type -> features.GetMyFeatureRequest
+	10,  // 77: features.FeatureService.AddMyFeatureImages:input_type -> features.AddMyFeatureImagesRequest
+	11,  // 78: features.FeatureService.RemoveMyFeatureImage:input_type -> features.RemoveMyFeatureImageRequest
+	12,  // 79: features.FeatureService.UpdateMyFeature:input_type -> features.UpdateMyFeatureRequest
+	13,  // 80: features.FeatureService.CountOwnedFeatures:input_type -> features.CountOwnedFeaturesRequest
+	23,  // 81: features.FeatureMarketplaceService.BuyFeature:input_type -> features.BuyFeatureRequest
+	30,  // 82: features.FeatureMarketplaceService.SendBuyRequest:input_type -> features.SendBuyRequestRequest
+	40,  // 83: features.FeatureMarketplaceService.AcceptBuyRequest:input_type -> features.AcceptBuyRequestRequest
+	46,  // 84: features.FeatureMarketplaceService.CreateSellRequest:input_type -> features.CreateSellRequestRequest
+	47,  // 85: features.FeatureMarketplaceService.ListSellRequests:input_type -> features.ListSellRequestsRequest
+	48,  // 86: features.FeatureMarketplaceService.DeleteSellRequest:input_type -> features.DeleteSellRequestRequest
+	54,  // 87: features.FeatureMarketplaceService.RequestGracePeriod:input_type -> features.RequestGracePeriodRequest
+	34,  // 88: features.FeatureMarketplaceService.ListBuyRequests:input_type -> features.ListBuyRequestsRequest
+	35,  // 89: features.FeatureMarketplaceService.ListReceivedBuyRequests:input_type -> features.ListReceivedBuyRequestsRequest
+	37,  // 90: features.FeatureMarketplaceService.RejectBuyRequest:input_type -> features.RejectBuyRequestRequest
+	38,  // 91: features.FeatureMarketplaceService.DeleteBuyRequest:input_type -> features.DeleteBuyRequestRequest
+	39,  // 92: features.FeatureMarketplaceService.UpdateGracePeriod:input_type -> features.UpdateGracePeriodRequest
+	51,  // 93: features.FeatureMarketplaceService.ListForSaleFeatures:input_type -> features.ListForSaleFeaturesRequest
+	25,  // 94: features.FeatureMarketplaceService.ReserveFeature:input_type -> features.CheckoutReservationRequest
+	25,  // 95: features.FeatureMarketplaceService.ReleaseReservation:input_type -> features.CheckoutReservationRequest
+	27,  // 96: features.FeatureMarketplaceService.GetLimitedFeatureAvailability:input_type -> features.GetLimitedFeatureAvailabilityRequest
+	41,  // 97: features.FeatureMarketplaceService.CounterBuyRequest:input_type -> features.CounterBuyRequestRequest
+	42,  // 98: features.FeatureMarketplaceService.AcceptCounterOffer:input_type -> features.AnswerCounterOfferRequest
+	42,  // 99: features.FeatureMarketplaceService.DeclineCounterOffer:input_type -> features.AnswerCounterOfferRequest
+	43,  // 100: features.FeatureMarketplaceService.GetNegotiation:input_type -> features.GetNegotiationRequest
+	56,  // 101: features.FeatureProfitService.GetHourlyProfits:input_type -> features.GetHourlyProfitsRequest
+	59,  // 102: features.FeatureProfitService.GetSingleProfit:input_type -> features.GetSingleProfitRequest
+	61,  // 103: features.FeatureProfitService.GetProfitsByApplication:input_type -> features.GetProfitsByApplicationRequest
+	63,  // 104: features.FeatureProfitService.GetFeatureProfit:input_type -> features.GetFeatureProfitRequest
+	65,  // 105: features.FeatureProfitService.GetProfitSettings:input_type -> features.GetProfitSettingsRequest
+	66,  // 106: features.FeatureProfitService.UpdateProfitSettings:input_type -> features.UpdateProfitSettingsRequest
+	68,  // 107: features.BuildingService.GetBuildPackage:input_type -> features.GetBuildPackageRequest
+	68,  // 108: features.BuildingService.StreamBuildPackage:input_type -> features.GetBuildPackageRequest
+	72,  // 109: features.BuildingService.BuildFeature:input_type -> features.BuildFeatureRequest
+	75,  // 110: features.BuildingService.GetBuildings:input_type -> features.GetBuildingsRequest
+	78,  // 111: features.BuildingService.UpdateBuilding:input_type -> features.UpdateBuildingRequest
+	80,  // 112: features.BuildingService.DestroyBuilding:input_type -> features.DestroyBuildingRequest
+	81,  // 113: features.BuildingService.SimulateBuild:input_type -> features.SimulateBuildRequest
+	84,  // 114: features.MapsService.ListMaps:input_type -> features.ListMapsRequest
+	85,  // 115: features.MapsService.GetMap:input_type -> features.GetMapRequest
+	85,  // 116: features.MapsService.GetMapBorder:input_type -> features.GetMapRequest
+	93,  // 117: features.WatchlistService.AddToWatchlist:input_type -> features.AddToWatchlistRequest
+	94,  // 118: features.WatchlistService.RemoveFromWatchlist:input_type -> features.RemoveFromWatchlistRequest
+	95,  // 119: features.WatchlistService.ListWatchlist:input_type -> features.ListWatchlistRequest
+	99,  // 120: features.SavedSearchService.CreateSavedSearch:input_type -> features.CreateSavedSearchRequest
+	100, // 121: features.SavedSearchService.UpdateSavedSearch:input_type -> features.UpdateSavedSearchRequest
+	101, // 122: features.SavedSearchService.DeleteSavedSearch:input_type -> features.DeleteSavedSearchRequest
+	102, // 123: features.SavedSearchService.ListSavedSearches:input_type -> features.ListSavedSearchesRequest
+	106, // 124: features.TradeService.GetTrade:input_type -> features.GetTradeRequest
+	107, // 125: features.TradeService.FreezeTradeFunds:input_type -> features.TradeFundsRequest
+	107, // 126: features.TradeService.ReleaseTradeFunds:input_type -> features.TradeFundsRequest
+	108, // 127: features.TradeService.RefundTrade:input_type -> features.RefundTradeRequest
+	111, // 128: features.FeatureGeometryService.UpdateFeatureGeometry:input_type -> features.UpdateFeatureGeometryRequest
+	112, // 129: features.FeatureGeometryService.ListGeometryVersions:input_type -> features.ListGeometryVersionsRequest
+	116, // 130: features.FeatureInstallmentService.ReserveFeature:input_type -> features.ReserveFeatureRequest
+	117, // 131: features.FeatureInstallmentService.CompleteReservedPurchase:input_type -> features.FeatureReservationRequest
+	117, // 132: features.FeatureInstallmentService.ReleaseFeatureReservation:input_type -> features.FeatureReservationRequest
+	120, // 133: features.FeatureGalleryService.ListFeatureImages:input_type -> features.ListFeatureImagesRequest
+	122, // 134: features.FeatureGalleryService.AttachFeatureImages:input_type -> features.AttachFeatureImagesRequest
+	123, // 135: features.FeatureGalleryService.RemoveFeatureImage:input_type -> features.RemoveFeatureImageRequest
+	124, // 136: features.FeatureGalleryService.ReorderFeatureImages:input_type -> features.ReorderFeatureImagesRequest
+	125, // 137: features.FeatureGalleryService.SetFeatureCoverImage:input_type -> features.SetFeatureCoverImageRequest
+	127, // 138: features.TradeReceiptService.GetTradeReceipt:input_type -> features.GetTradeReceiptRequest
+	128, // 139: features.TradeReceiptService.VerifyTradeReceipt:input_type -> features.VerifyTradeReceiptRequest
+	131, // 140: features.TradeSummaryService.GetAnnualTradeSummary:input_type -> features.GetAnnualTradeSummaryRequest
+	136, // 141: features.FeaturePortfolioService.GetUserPortfolio:input_type -> features.GetUserPortfolioRequest
+	142, // 142: features.FeatureHistoryService.GetFeatureHistory:input_type -> features.GetFeatureHistoryRequest
+	1,   // 143: features.FeatureService.ListFeatures:output_type -> features.FeaturesResponse
+	3,   // 144: features.FeatureService.GetFeature:output_type -> features.FeatureResponse
+	3,   // 145: features.FeatureService.UpdateFeature:output_type -> features.FeatureResponse
+	3,   // 146: features.FeatureService.AddFeatureImages:output_type -> features.FeatureResponse
+	1,   // 147: features.FeatureService.GetMyFeatures:output_type -> features.FeaturesResponse
+	8,   // 148: features.FeatureService.ListMyFeatures:output_type -> features.ListMyFeaturesResponse
+	3,   // 149: features.FeatureService.GetMyFeature:output_type -> features.FeatureResponse
+	3,   // 150: features.FeatureService.AddMyFeatureImages:output_type -> features.FeatureResponse
+	147, // 151: features.FeatureService.RemoveMyFeatureImage:output_type -> google.protobuf.Empty
+	147, // 152: features.FeatureService.UpdateMyFeature:output_type -> google.protobuf.Empty
+	14,  // 153: features.FeatureService.CountOwnedFeatures:output_type -> features.OwnedFeatureCountsResponse
+	24,  // 154: features.FeatureMarketplaceService.BuyFeature:output_type -> features.BuyFeatureResponse
+	31,  // 155: features.FeatureMarketplaceService.SendBuyRequest:output_type -> features.BuyRequestResponse
+	31,  // 156: features.FeatureMarketplaceService.AcceptBuyRequest:output_type -> features.BuyRequestResponse
+	49,  // 157: features.FeatureMarketplaceService.CreateSellRequest:output_type -> features.SellRequestResponse
+	50,  // 158: features.FeatureMarketplaceService.ListSellRequests:output_type -> features.SellRequestsResponse
+	147, // 159: features.FeatureMarketplaceService.DeleteSellRequest:output_type -> google.protobuf.Empty
+	55,  // 160: features.FeatureMarketplaceService.RequestGracePeriod:output_type -> features.GracePeriodResponse
+	36,  // 161: features.FeatureMarketplaceService.ListBuyRequests:output_type -> features.BuyRequestsResponse
+	36,  // 162: features.FeatureMarketplaceService.ListReceivedBuyRequests:output_type -> features.BuyRequestsResponse
+	147, // 163: features.FeatureMarketplaceService.RejectBuyRequest:output_type -> google.protobuf.Empty
+	147, // 164: features.FeatureMarketplaceService.DeleteBuyRequest:output_type -> google.protobuf.Empty
+	147, // 165: features.FeatureMarketplaceService.UpdateGracePeriod:output_type -> google.protobuf.Empty
+	53,  // 166: features.FeatureMarketplaceService.ListForSaleFeatures:output_type -> features.ListForSaleFeaturesResponse
+	26,  // 167: features.FeatureMarketplaceService.ReserveFeature:output_type -> features.CheckoutReservation
+	147, // 168: features.FeatureMarketplaceService.ReleaseReservation:output_type -> google.protobuf.Empty
+	29,  // 169: features.FeatureMarketplaceService.GetLimitedFeatureAvailability:output_type -> features.GetLimitedFeatureAvailabilityResponse
+	45,  // 170: features.FeatureMarketplaceService.CounterBuyRequest:output_type -> features.NegotiationResponse
+	31,  // 171: features.FeatureMarketplaceService.AcceptCounterOffer:output_type -> features.BuyRequestResponse
+	45,  // 172: features.FeatureMarketplaceService.DeclineCounterOffer:output_type -> features.NegotiationResponse
+	45,  // 173: features.FeatureMarketplaceService.GetNegotiation:output_type -> features.NegotiationResponse
+	57,  // 174: features.FeatureProfitService.GetHourlyProfits:output_type -> features.HourlyProfitsResponse
+	60,  // 175: features.FeatureProfitService.GetSingleProfit:output_type -> features.HourlyProfitResponse
+	62,  // 176: features.FeatureProfitService.GetProfitsByApplication:output_type -> features.ProfitsByApplicationResponse
+	64,  // 177: features.FeatureProfitService.GetFeatureProfit:output_type -> features.FeatureProfitResponse
+	67,  // 178: features.FeatureProfitService.GetProfitSettings:output_type -> features.ProfitSettingsResponse
+	67,  // 179: features.FeatureProfitService.UpdateProfitSettings:output_type -> features.ProfitSettingsResponse
+	69,  // 180: features.BuildingService.GetBuildPackage:output_type -> features.BuildPackageResponse
+	70,  // 181: features.BuildingService.StreamBuildPackage:output_type -> features.BuildPackageChunk
+	74,  // 182: features.BuildingService.BuildFeature:output_type -> features.BuildFeatureResponse
+	76,  // 183: features.BuildingService.GetBuildings:output_type -> features.BuildingsResponse
+	79,  // 184: features.BuildingService.UpdateBuilding:output_type -> features.BuildingResponse
+	79,  // 185: features.BuildingService.DestroyBuilding:output_type -> features.BuildingResponse
+	82,  // 186: features.BuildingService.SimulateBuild:output_type -> features.SimulateBuildResponse
+	86,  // 187: features.MapsService.ListMaps:output_type -> features.ListMapsResponse
+	87,  // 188: features.MapsService.GetMap:output_type -> features.GetMapResponse
+	88,  // 189: features.MapsService.GetMapBorder:output_type -> features.GetMapBorderResponse
+	97,  // 190: features.WatchlistService.AddToWatchlist:output_type -> features.WatchlistItemResponse
+	147, // 191: features.WatchlistService.RemoveFromWatchlist:output_type -> google.protobuf.Empty
+	98,  // 192: features.WatchlistService.ListWatchlist:output_type -> features.ListWatchlistResponse
+	104, // 193: features.SavedSearchService.CreateSavedSearch:output_type -> features.SavedSearchResponse
+	104, // 194: features.SavedSearchService.UpdateSavedSearch:output_type -> features.SavedSearchResponse
+	147, // 195: features.SavedSearchService.DeleteSavedSearch:output_type -> google.protobuf.Empty
+	105, // 196: features.SavedSearchService.ListSavedSearches:output_type -> features.ListSavedSearchesResponse
+	110, // 197: features.TradeService.GetTrade:output_type -> features.TradeResponse
+	147, // 198: features.TradeService.FreezeTradeFunds:output_type -> google.protobuf.Empty
+	147, // 199: features.TradeService.ReleaseTradeFunds:output_type -> google.protobuf.Empty
+	147, // 200: features.TradeService.RefundTrade:output_type -> google.protobuf.Empty
+	114, // 201: features.FeatureGeometryService.UpdateFeatureGeometry:output_type -> features.GeometryVersionResponse
+	115, // 202: features.FeatureGeometryService.ListGeometryVersions:output_type -> features.ListGeometryVersionsResponse
+	118, // 203: features.FeatureInstallmentService.ReserveFeature:output_type -> features.FeatureReservation
+	119, // 204: features.FeatureInstallmentService.CompleteReservedPurchase:output_type -> features.CompleteReservedPurchaseResponse
+	147, // 205: features.FeatureInstallmentService.ReleaseFeatureReservation:output_type -> google.protobuf.Empty
+	126, // 206: features.FeatureGalleryService.ListFeatureImages:output_type -> features.FeatureImagesResponse
+	126, // 207: features.FeatureGalleryService.AttachFeatureImages:output_type -> features.FeatureImagesResponse
+	126, // 208: features.FeatureGalleryService.RemoveFeatureImage:output_type -> features.FeatureImagesResponse
+	126, // 209: features.FeatureGalleryService.ReorderFeatureImages:output_type -> features.FeatureImagesResponse
+	126, // 210: features.FeatureGalleryService.SetFeatureCoverImage:output_type -> features.FeatureImagesResponse
+	130, // 211: features.TradeReceiptService.GetTradeReceipt:output_type -> features.TradeReceiptResponse
+	130, // 212: features.TradeReceiptService.VerifyTradeReceipt:output_type -> features.TradeReceiptResponse
+	135, // 213: features.TradeSummaryService.GetAnnualTradeSummary:output_type -> features.AnnualTradeSummaryResponse
+	141, // 214: features.FeaturePortfolioService.GetUserPortfolio:output_type -> features.UserPortfolioResponse
+	144, // 215: features.FeatureHistoryService.GetFeatureHistory:output_type -> features.FeatureHistoryResponse
+	143, // [143:216] is the sub-list for method output_type
+	70,  // [70:143] is the sub-list for method input_type
+	70,  // [70:70] is the sub-list for extension type_name
+	70,  // [70:70] is the sub-list for extension extendee
+	0,   // [0:70] is the sub-list for field type_name
 }
 
 func init() { file_features_proto_init() }
@@ -4925,9 +10825,9 @@ func file_features_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_features_proto_rawDesc), len(file_features_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   69,
+			NumMessages:   146,
 			NumExtensions: 0,
-			NumServices:   5,
+			NumServices:   15,
 		},
 		GoTypes:           file_features_proto_goTypes,
 		DependencyIndexes: file_features_proto_depIdxs,
@@ -30,6 +30,7 @@ const (
 	FeatureService_AddMyFeatureImages_FullMethodName   = "/features.FeatureService/AddMyFeatureImages"
 	FeatureService_RemoveMyFeatureImage_FullMethodName = "/features.FeatureService/RemoveMyFeatureImage"
 	FeatureService_UpdateMyFeature_FullMethodName      = "/features.FeatureService/UpdateMyFeature"
+	FeatureService_CountOwnedFeatures_FullMethodName   = "/features.FeatureService/CountOwnedFeatures"
 )
 
 // FeatureServiceClient is the client API for FeatureService service.
@@ -49,6 +50,7 @@ type FeatureServiceClient interface {
 	AddMyFeatureImages(ctx context.Context, in *AddMyFeatureImagesRequest, opts ...grpc.CallOption) (*FeatureResponse, error)
 	RemoveMyFeatureImage(ctx context.Context, in *RemoveMyFeatureImageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateMyFeature(ctx context.Context, in *UpdateMyFeatureRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CountOwnedFeatures(ctx context.Context, in *CountOwnedFeaturesRequest, opts ...grpc.CallOption) (*OwnedFeatureCountsResponse, error)
 }
 
 type featureServiceClient struct {
@@ -159,6 +161,16 @@ func (c *featureServiceClient) UpdateMyFeature(ctx context.Context, in *UpdateMy
 	return out, nil
 }
 
+func (c *featureServiceClient) CountOwnedFeatures(ctx context.Context, in *CountOwnedFeaturesRequest, opts ...grpc.CallOption) (*OwnedFeatureCountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OwnedFeatureCountsResponse)
+	err := c.cc.Invoke(ctx, FeatureService_CountOwnedFeatures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FeatureServiceServer is the server API for FeatureService service.
 // All implementations must embed UnimplementedFeatureServiceServer
 // for forward compatibility.
@@ -176,6 +188,7 @@ type FeatureServiceServer interface {
 	AddMyFeatureImages(context.Context, *AddMyFeatureImagesRequest) (*FeatureResponse, error)
 	RemoveMyFeatureImage(context.Context, *RemoveMyFeatureImageRequest) (*emptypb.Empty, error)
 	UpdateMyFeature(context.Context, *UpdateMyFeatureRequest) (*emptypb.Empty, error)
+	CountOwnedFeatures(context.Context, *CountOwnedFeaturesRequest) (*OwnedFeatureCountsResponse, error)
 	mustEmbedUnimplementedFeatureServiceServer()
 }
 
@@ -216,6 +229,9 @@ func (UnimplementedFeatureServiceServer) RemoveMyFeatureImage(context.Context, *
 func (UnimplementedFeatureServiceServer) UpdateMyFeature(context.Context, *UpdateMyFeatureRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateMyFeature not implemented")
 }
+func (UnimplementedFeatureServiceServer) CountOwnedFeatures(context.Context, *CountOwnedFeaturesRequest) (*OwnedFeatureCountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CountOwnedFeatures not implemented")
+}
 func (UnimplementedFeatureServiceServer) mustEmbedUnimplementedFeatureServiceServer() {}
 func (UnimplementedFeatureServiceServer) testEmbeddedByValue()                        {}
 
@@ -417,6 +433,24 @@ func _FeatureService_UpdateMyFeature_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FeatureService_CountOwnedFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountOwnedFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureServiceServer).CountOwnedFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureService_CountOwnedFeatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureServiceServer).CountOwnedFeatures(ctx, req.(*CountOwnedFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FeatureService_ServiceDesc is the grpc.ServiceDesc for FeatureService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -464,24 +498,36 @@ var FeatureService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateMyFeature",
 			Handler:    _FeatureService_UpdateMyFeature_Handler,
 		},
+		{
+			MethodName: "CountOwnedFeatures",
+			Handler:    _FeatureService_CountOwnedFeatures_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "features.proto",
 }
 
 const (
-	FeatureMarketplaceService_BuyFeature_FullMethodName              = "/features.FeatureMarketplaceService/BuyFeature"
-	FeatureMarketplaceService_SendBuyRequest_FullMethodName          = "/features.FeatureMarketplaceService/SendBuyRequest"
-	FeatureMarketplaceService_AcceptBuyRequest_FullMethodName        = "/features.FeatureMarketplaceService/AcceptBuyRequest"
-	FeatureMarketplaceService_CreateSellRequest_FullMethodName       = "/features.FeatureMarketplaceService/CreateSellRequest"
-	FeatureMarketplaceService_ListSellRequests_FullMethodName        = "/features.FeatureMarketplaceService/ListSellRequests"
-	FeatureMarketplaceService_DeleteSellRequest_FullMethodName       = "/features.FeatureMarketplaceService/DeleteSellRequest"
-	FeatureMarketplaceService_RequestGracePeriod_FullMethodName      = "/features.FeatureMarketplaceService/RequestGracePeriod"
-	FeatureMarketplaceService_ListBuyRequests_FullMethodName         = "/features.FeatureMarketplaceService/ListBuyRequests"
-	FeatureMarketplaceService_ListReceivedBuyRequests_FullMethodName = "/features.FeatureMarketplaceService/ListReceivedBuyRequests"
-	FeatureMarketplaceService_RejectBuyRequest_FullMethodName        = "/features.FeatureMarketplaceService/RejectBuyRequest"
-	FeatureMarketplaceService_DeleteBuyRequest_FullMethodName        = "/features.FeatureMarketplaceService/DeleteBuyRequest"
-	FeatureMarketplaceService_UpdateGracePeriod_FullMethodName       = "/features.FeatureMarketplaceService/UpdateGracePeriod"
+	FeatureMarketplaceService_BuyFeature_FullMethodName                    = "/features.FeatureMarketplaceService/BuyFeature"
+	FeatureMarketplaceService_SendBuyRequest_FullMethodName                = "/features.FeatureMarketplaceService/SendBuyRequest"
+	FeatureMarketplaceService_AcceptBuyRequest_FullMethodName              = "/features.FeatureMarketplaceService/AcceptBuyRequest"
+	FeatureMarketplaceService_CreateSellRequest_FullMethodName             = "/features.FeatureMarketplaceService/CreateSellRequest"
+	FeatureMarketplaceService_ListSellRequests_FullMethodName              = "/features.FeatureMarketplaceService/ListSellRequests"
+	FeatureMarketplaceService_DeleteSellRequest_FullMethodName             = "/features.FeatureMarketplaceService/DeleteSellRequest"
+	FeatureMarketplaceService_RequestGracePeriod_FullMethodName            = "/features.FeatureMarketplaceService/RequestGracePeriod"
+	FeatureMarketplaceService_ListBuyRequests_FullMethodName               = "/features.FeatureMarketplaceService/ListBuyRequests"
+	FeatureMarketplaceService_ListReceivedBuyRequests_FullMethodName       = "/features.FeatureMarketplaceService/ListReceivedBuyRequests"
+	FeatureMarketplaceService_RejectBuyRequest_FullMethodName              = "/features.FeatureMarketplaceService/RejectBuyRequest"
+	FeatureMarketplaceService_DeleteBuyRequest_FullMethodName              = "/features.FeatureMarketplaceService/DeleteBuyRequest"
+	FeatureMarketplaceService_UpdateGracePeriod_FullMethodName             = "/features.FeatureMarketplaceService/UpdateGracePeriod"
+	FeatureMarketplaceService_ListForSaleFeatures_FullMethodName           = "/features.FeatureMarketplaceService/ListForSaleFeatures"
+	FeatureMarketplaceService_ReserveFeature_FullMethodName                = "/features.FeatureMarketplaceService/ReserveFeature"
+	FeatureMarketplaceService_ReleaseReservation_FullMethodName            = "/features.FeatureMarketplaceService/ReleaseReservation"
+	FeatureMarketplaceService_GetLimitedFeatureAvailability_FullMethodName = "/features.FeatureMarketplaceService/GetLimitedFeatureAvailability"
+	FeatureMarketplaceService_CounterBuyRequest_FullMethodName             = "/features.FeatureMarketplaceService/CounterBuyRequest"
+	FeatureMarketplaceService_AcceptCounterOffer_FullMethodName            = "/features.FeatureMarketplaceService/AcceptCounterOffer"
+	FeatureMarketplaceService_DeclineCounterOffer_FullMethodName           = "/features.FeatureMarketplaceService/DeclineCounterOffer"
+	FeatureMarketplaceService_GetNegotiation_FullMethodName                = "/features.FeatureMarketplaceService/GetNegotiation"
 )
 
 // FeatureMarketplaceServiceClient is the client API for FeatureMarketplaceService service.
@@ -502,6 +548,20 @@ type FeatureMarketplaceServiceClient interface {
 	RejectBuyRequest(ctx context.Context, in *RejectBuyRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteBuyRequest(ctx context.Context, in *DeleteBuyRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateGracePeriod(ctx context.Context, in *UpdateGracePeriodRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListForSaleFeatures(ctx context.Context, in *ListForSaleFeaturesRequest, opts ...grpc.CallOption) (*ListForSaleFeaturesResponse, error)
+	// Checkout reservations hold a feature for a few minutes while the 3D
+	// client confirms a purchase. Only the holder can buy it in that time.
+	ReserveFeature(ctx context.Context, in *CheckoutReservationRequest, opts ...grpc.CallOption) (*CheckoutReservation, error)
+	ReleaseReservation(ctx context.Context, in *CheckoutReservationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Remaining features of the limited sale campaigns, overall and for the
+	// user. Features other buyers hold for checkout are not counted as remaining.
+	GetLimitedFeatureAvailability(ctx context.Context, in *GetLimitedFeatureAvailabilityRequest, opts ...grpc.CallOption) (*GetLimitedFeatureAvailabilityResponse, error)
+	// Negotiation on a pending buy request: the seller counters with a price,
+	// the buyer accepts or declines it, for a bounded number of rounds
+	CounterBuyRequest(ctx context.Context, in *CounterBuyRequestRequest, opts ...grpc.CallOption) (*NegotiationResponse, error)
+	AcceptCounterOffer(ctx context.Context, in *AnswerCounterOfferRequest, opts ...grpc.CallOption) (*BuyRequestResponse, error)
+	DeclineCounterOffer(ctx context.Context, in *AnswerCounterOfferRequest, opts ...grpc.CallOption) (*NegotiationResponse, error)
+	GetNegotiation(ctx context.Context, in *GetNegotiationRequest, opts ...grpc.CallOption) (*NegotiationResponse, error)
 }
 
 type featureMarketplaceServiceClient struct {
@@ -632,6 +692,86 @@ func (c *featureMarketplaceServiceClient) UpdateGracePeriod(ctx context.Context,
 	return out, nil
 }
 
+func (c *featureMarketplaceServiceClient) ListForSaleFeatures(ctx context.Context, in *ListForSaleFeaturesRequest, opts ...grpc.CallOption) (*ListForSaleFeaturesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListForSaleFeaturesResponse)
+	err := c.cc.Invoke(ctx, FeatureMarketplaceService_ListForSaleFeatures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureMarketplaceServiceClient) ReserveFeature(ctx context.Context, in *CheckoutReservationRequest, opts ...grpc.CallOption) (*CheckoutReservation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckoutReservation)
+	err := c.cc.Invoke(ctx, FeatureMarketplaceService_ReserveFeature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureMarketplaceServiceClient) ReleaseReservation(ctx context.Context, in *CheckoutReservationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FeatureMarketplaceService_ReleaseReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureMarketplaceServiceClient) GetLimitedFeatureAvailability(ctx context.Context, in *GetLimitedFeatureAvailabilityRequest, opts ...grpc.CallOption) (*GetLimitedFeatureAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLimitedFeatureAvailabilityResponse)
+	err := c.cc.Invoke(ctx, FeatureMarketplaceService_GetLimitedFeatureAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureMarketplaceServiceClient) CounterBuyRequest(ctx context.Context, in *CounterBuyRequestRequest, opts ...grpc.CallOption) (*NegotiationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NegotiationResponse)
+	err := c.cc.Invoke(ctx, FeatureMarketplaceService_CounterBuyRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureMarketplaceServiceClient) AcceptCounterOffer(ctx context.Context, in *AnswerCounterOfferRequest, opts ...grpc.CallOption) (*BuyRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuyRequestResponse)
+	err := c.cc.Invoke(ctx, FeatureMarketplaceService_AcceptCounterOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureMarketplaceServiceClient) DeclineCounterOffer(ctx context.Context, in *AnswerCounterOfferRequest, opts ...grpc.CallOption) (*NegotiationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NegotiationResponse)
+	err := c.cc.Invoke(ctx, FeatureMarketplaceService_DeclineCounterOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureMarketplaceServiceClient) GetNegotiation(ctx context.Context, in *GetNegotiationRequest, opts ...grpc.CallOption) (*NegotiationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NegotiationResponse)
+	err := c.cc.Invoke(ctx, FeatureMarketplaceService_GetNegotiation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FeatureMarketplaceServiceServer is the server API for FeatureMarketplaceService service.
 // All implementations must embed UnimplementedFeatureMarketplaceServiceServer
 // for forward compatibility.
@@ -650,6 +790,20 @@ type FeatureMarketplaceServiceServer interface {
 	RejectBuyRequest(context.Context, *RejectBuyRequestRequest) (*emptypb.Empty, error)
 	DeleteBuyRequest(context.Context, *DeleteBuyRequestRequest) (*emptypb.Empty, error)
 	UpdateGracePeriod(context.Context, *UpdateGracePeriodRequest) (*emptypb.Empty, error)
+	ListForSaleFeatures(context.Context, *ListForSaleFeaturesRequest) (*ListForSaleFeaturesResponse, error)
+	// Checkout reservations hold a feature for a few minutes while the 3D
+	// client confirms a purchase. Only the holder can buy it in that time.
+	ReserveFeature(context.Context, *CheckoutReservationRequest) (*CheckoutReservation, error)
+	ReleaseReservation(context.Context, *CheckoutReservationRequest) (*emptypb.Empty, error)
+	// Remaining features of the limited sale campaigns, overall and for the
+	// user. Features other buyers hold for checkout are not counted as remaining.
+	GetLimitedFeatureAvailability(context.Context, *GetLimitedFeatureAvailabilityRequest) (*GetLimitedFeatureAvailabilityResponse, error)
+	// Negotiation on a pending buy request: the seller counters with a price,
+	// the buyer accepts or declines it, for a bounded number of rounds
+	CounterBuyRequest(context.Context, *CounterBuyRequestRequest) (*NegotiationResponse, error)
+	AcceptCounterOffer(context.Context, *AnswerCounterOfferRequest) (*BuyRequestResponse, error)
+	DeclineCounterOffer(context.Context, *AnswerCounterOfferRequest) (*NegotiationResponse, error)
+	GetNegotiation(context.Context, *GetNegotiationRequest) (*NegotiationResponse, error)
 	mustEmbedUnimplementedFeatureMarketplaceServiceServer()
 }
 
@@ -696,6 +850,30 @@ func (UnimplementedFeatureMarketplaceServiceServer) DeleteBuyRequest(context.Con
 func (UnimplementedFeatureMarketplaceServiceServer) UpdateGracePeriod(context.Context, *UpdateGracePeriodRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateGracePeriod not implemented")
 }
+func (UnimplementedFeatureMarketplaceServiceServer) ListForSaleFeatures(context.Context, *ListForSaleFeaturesRequest) (*ListForSaleFeaturesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListForSaleFeatures not implemented")
+}
+func (UnimplementedFeatureMarketplaceServiceServer) ReserveFeature(context.Context, *CheckoutReservationRequest) (*CheckoutReservation, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReserveFeature not implemented")
+}
+func (UnimplementedFeatureMarketplaceServiceServer) ReleaseReservation(context.Context, *CheckoutReservationRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseReservation not implemented")
+}
+func (UnimplementedFeatureMarketplaceServiceServer) GetLimitedFeatureAvailability(context.Context, *GetLimitedFeatureAvailabilityRequest) (*GetLimitedFeatureAvailabilityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLimitedFeatureAvailability not implemented")
+}
+func (UnimplementedFeatureMarketplaceServiceServer) CounterBuyRequest(context.Context, *CounterBuyRequestRequest) (*NegotiationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CounterBuyRequest not implemented")
+}
+func (UnimplementedFeatureMarketplaceServiceServer) AcceptCounterOffer(context.Context, *AnswerCounterOfferRequest) (*BuyRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptCounterOffer not implemented")
+}
+func (UnimplementedFeatureMarketplaceServiceServer) DeclineCounterOffer(context.Context, *AnswerCounterOfferRequest) (*NegotiationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeclineCounterOffer not implemented")
+}
+func (UnimplementedFeatureMarketplaceServiceServer) GetNegotiation(context.Context, *GetNegotiationRequest) (*NegotiationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNegotiation not implemented")
+}
 func (UnimplementedFeatureMarketplaceServiceServer) mustEmbedUnimplementedFeatureMarketplaceServiceServer() {
 }
 func (UnimplementedFeatureMarketplaceServiceServer) testEmbeddedByValue() {}
@@ -934,6 +1112,150 @@ func _FeatureMarketplaceService_UpdateGracePeriod_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FeatureMarketplaceService_ListForSaleFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListForSaleFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureMarketplaceServiceServer).ListForSaleFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureMarketplaceService_ListForSaleFeatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureMarketplaceServiceServer).ListForSaleFeatures(ctx, req.(*ListForSaleFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureMarketplaceService_ReserveFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureMarketplaceServiceServer).ReserveFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureMarketplaceService_ReserveFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureMarketplaceServiceServer).ReserveFeature(ctx, req.(*CheckoutReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureMarketplaceService_ReleaseReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureMarketplaceServiceServer).ReleaseReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureMarketplaceService_ReleaseReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureMarketplaceServiceServer).ReleaseReservation(ctx, req.(*CheckoutReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureMarketplaceService_GetLimitedFeatureAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLimitedFeatureAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureMarketplaceServiceServer).GetLimitedFeatureAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureMarketplaceService_GetLimitedFeatureAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureMarketplaceServiceServer).GetLimitedFeatureAvailability(ctx, req.(*GetLimitedFeatureAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureMarketplaceService_CounterBuyRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CounterBuyRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureMarketplaceServiceServer).CounterBuyRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureMarketplaceService_CounterBuyRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureMarketplaceServiceServer).CounterBuyRequest(ctx, req.(*CounterBuyRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureMarketplaceService_AcceptCounterOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerCounterOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureMarketplaceServiceServer).AcceptCounterOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureMarketplaceService_AcceptCounterOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureMarketplaceServiceServer).AcceptCounterOffer(ctx, req.(*AnswerCounterOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureMarketplaceService_DeclineCounterOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerCounterOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureMarketplaceServiceServer).DeclineCounterOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureMarketplaceService_DeclineCounterOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureMarketplaceServiceServer).DeclineCounterOffer(ctx, req.(*AnswerCounterOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureMarketplaceService_GetNegotiation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNegotiationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureMarketplaceServiceServer).GetNegotiation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureMarketplaceService_GetNegotiation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureMarketplaceServiceServer).GetNegotiation(ctx, req.(*GetNegotiationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FeatureMarketplaceService_ServiceDesc is the grpc.ServiceDesc for FeatureMarketplaceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -989,6 +1311,38 @@ var FeatureMarketplaceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGracePeriod",
 			Handler:    _FeatureMarketplaceService_UpdateGracePeriod_Handler,
 		},
+		{
+			MethodName: "ListForSaleFeatures",
+			Handler:    _FeatureMarketplaceService_ListForSaleFeatures_Handler,
+		},
+		{
+			MethodName: "ReserveFeature",
+			Handler:    _FeatureMarketplaceService_ReserveFeature_Handler,
+		},
+		{
+			MethodName: "ReleaseReservation",
+			Handler:    _FeatureMarketplaceService_ReleaseReservation_Handler,
+		},
+		{
+			MethodName: "GetLimitedFeatureAvailability",
+			Handler:    _FeatureMarketplaceService_GetLimitedFeatureAvailability_Handler,
+		},
+		{
+			MethodName: "CounterBuyRequest",
+			Handler:    _FeatureMarketplaceService_CounterBuyRequest_Handler,
+		},
+		{
+			MethodName: "AcceptCounterOffer",
+			Handler:    _FeatureMarketplaceService_AcceptCounterOffer_Handler,
+		},
+		{
+			MethodName: "DeclineCounterOffer",
+			Handler:    _FeatureMarketplaceService_DeclineCounterOffer_Handler,
+		},
+		{
+			MethodName: "GetNegotiation",
+			Handler:    _FeatureMarketplaceService_GetNegotiation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "features.proto",
@@ -998,6 +1352,9 @@ const (
 	FeatureProfitService_GetHourlyProfits_FullMethodName        = "/features.FeatureProfitService/GetHourlyProfits"
 	FeatureProfitService_GetSingleProfit_FullMethodName         = "/features.FeatureProfitService/GetSingleProfit"
 	FeatureProfitService_GetProfitsByApplication_FullMethodName = "/features.FeatureProfitService/GetProfitsByApplication"
+	FeatureProfitService_GetFeatureProfit_FullMethodName        = "/features.FeatureProfitService/GetFeatureProfit"
+	FeatureProfitService_GetProfitSettings_FullMethodName       = "/features.FeatureProfitService/GetProfitSettings"
+	FeatureProfitService_UpdateProfitSettings_FullMethodName    = "/features.FeatureProfitService/UpdateProfitSettings"
 )
 
 // FeatureProfitServiceClient is the client API for FeatureProfitService service.
@@ -1009,6 +1366,10 @@ type FeatureProfitServiceClient interface {
 	GetHourlyProfits(ctx context.Context, in *GetHourlyProfitsRequest, opts ...grpc.CallOption) (*HourlyProfitsResponse, error)
 	GetSingleProfit(ctx context.Context, in *GetSingleProfitRequest, opts ...grpc.CallOption) (*HourlyProfitResponse, error)
 	GetProfitsByApplication(ctx context.Context, in *GetProfitsByApplicationRequest, opts ...grpc.CallOption) (*ProfitsByApplicationResponse, error)
+	// GetFeatureProfit shows the accrued profit of one feature and how fast it grows
+	GetFeatureProfit(ctx context.Context, in *GetFeatureProfitRequest, opts ...grpc.CallOption) (*FeatureProfitResponse, error)
+	GetProfitSettings(ctx context.Context, in *GetProfitSettingsRequest, opts ...grpc.CallOption) (*ProfitSettingsResponse, error)
+	UpdateProfitSettings(ctx context.Context, in *UpdateProfitSettingsRequest, opts ...grpc.CallOption) (*ProfitSettingsResponse, error)
 }
 
 type featureProfitServiceClient struct {
@@ -1049,6 +1410,36 @@ func (c *featureProfitServiceClient) GetProfitsByApplication(ctx context.Context
 	return out, nil
 }
 
+func (c *featureProfitServiceClient) GetFeatureProfit(ctx context.Context, in *GetFeatureProfitRequest, opts ...grpc.CallOption) (*FeatureProfitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureProfitResponse)
+	err := c.cc.Invoke(ctx, FeatureProfitService_GetFeatureProfit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureProfitServiceClient) GetProfitSettings(ctx context.Context, in *GetProfitSettingsRequest, opts ...grpc.CallOption) (*ProfitSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfitSettingsResponse)
+	err := c.cc.Invoke(ctx, FeatureProfitService_GetProfitSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureProfitServiceClient) UpdateProfitSettings(ctx context.Context, in *UpdateProfitSettingsRequest, opts ...grpc.CallOption) (*ProfitSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfitSettingsResponse)
+	err := c.cc.Invoke(ctx, FeatureProfitService_UpdateProfitSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FeatureProfitServiceServer is the server API for FeatureProfitService service.
 // All implementations must embed UnimplementedFeatureProfitServiceServer
 // for forward compatibility.
@@ -1058,6 +1449,10 @@ type FeatureProfitServiceServer interface {
 	GetHourlyProfits(context.Context, *GetHourlyProfitsRequest) (*HourlyProfitsResponse, error)
 	GetSingleProfit(context.Context, *GetSingleProfitRequest) (*HourlyProfitResponse, error)
 	GetProfitsByApplication(context.Context, *GetProfitsByApplicationRequest) (*ProfitsByApplicationResponse, error)
+	// GetFeatureProfit shows the accrued profit of one feature and how fast it grows
+	GetFeatureProfit(context.Context, *GetFeatureProfitRequest) (*FeatureProfitResponse, error)
+	GetProfitSettings(context.Context, *GetProfitSettingsRequest) (*ProfitSettingsResponse, error)
+	UpdateProfitSettings(context.Context, *UpdateProfitSettingsRequest) (*ProfitSettingsResponse, error)
 	mustEmbedUnimplementedFeatureProfitServiceServer()
 }
 
@@ -1077,6 +1472,15 @@ func (UnimplementedFeatureProfitServiceServer) GetSingleProfit(context.Context,
 func (UnimplementedFeatureProfitServiceServer) GetProfitsByApplication(context.Context, *GetProfitsByApplicationRequest) (*ProfitsByApplicationResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProfitsByApplication not implemented")
 }
+func (UnimplementedFeatureProfitServiceServer) GetFeatureProfit(context.Context, *GetFeatureProfitRequest) (*FeatureProfitResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFeatureProfit not implemented")
+}
+func (UnimplementedFeatureProfitServiceServer) GetProfitSettings(context.Context, *GetProfitSettingsRequest) (*ProfitSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProfitSettings not implemented")
+}
+func (UnimplementedFeatureProfitServiceServer) UpdateProfitSettings(context.Context, *UpdateProfitSettingsRequest) (*ProfitSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateProfitSettings not implemented")
+}
 func (UnimplementedFeatureProfitServiceServer) mustEmbedUnimplementedFeatureProfitServiceServer() {}
 func (UnimplementedFeatureProfitServiceServer) testEmbeddedByValue()                              {}
 
@@ -1152,36 +1556,104 @@ func _FeatureProfitService_GetProfitsByApplication_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
-// FeatureProfitService_ServiceDesc is the grpc.ServiceDesc for FeatureProfitService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var FeatureProfitService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "features.FeatureProfitService",
-	HandlerType: (*FeatureProfitServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetHourlyProfits",
-			Handler:    _FeatureProfitService_GetHourlyProfits_Handler,
-		},
-		{
-			MethodName: "GetSingleProfit",
-			Handler:    _FeatureProfitService_GetSingleProfit_Handler,
-		},
-		{
-			MethodName: "GetProfitsByApplication",
-			Handler:    _FeatureProfitService_GetProfitsByApplication_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "features.proto",
-}
+func _FeatureProfitService_GetFeatureProfit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeatureProfitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureProfitServiceServer).GetFeatureProfit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureProfitService_GetFeatureProfit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureProfitServiceServer).GetFeatureProfit(ctx, req.(*GetFeatureProfitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureProfitService_GetProfitSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfitSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureProfitServiceServer).GetProfitSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureProfitService_GetProfitSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureProfitServiceServer).GetProfitSettings(ctx, req.(*GetProfitSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureProfitService_UpdateProfitSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfitSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureProfitServiceServer).UpdateProfitSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureProfitService_UpdateProfitSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureProfitServiceServer).UpdateProfitSettings(ctx, req.(*UpdateProfitSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FeatureProfitService_ServiceDesc is the grpc.ServiceDesc for FeatureProfitService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FeatureProfitService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "features.FeatureProfitService",
+	HandlerType: (*FeatureProfitServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetHourlyProfits",
+			Handler:    _FeatureProfitService_GetHourlyProfits_Handler,
+		},
+		{
+			MethodName: "GetSingleProfit",
+			Handler:    _FeatureProfitService_GetSingleProfit_Handler,
+		},
+		{
+			MethodName: "GetProfitsByApplication",
+			Handler:    _FeatureProfitService_GetProfitsByApplication_Handler,
+		},
+		{
+			MethodName: "GetFeatureProfit",
+			Handler:    _FeatureProfitService_GetFeatureProfit_Handler,
+		},
+		{
+			MethodName: "GetProfitSettings",
+			Handler:    _FeatureProfitService_GetProfitSettings_Handler,
+		},
+		{
+			MethodName: "UpdateProfitSettings",
+			Handler:    _FeatureProfitService_UpdateProfitSettings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "features.proto",
+}
 
 const (
-	BuildingService_GetBuildPackage_FullMethodName = "/features.BuildingService/GetBuildPackage"
-	BuildingService_BuildFeature_FullMethodName    = "/features.BuildingService/BuildFeature"
-	BuildingService_GetBuildings_FullMethodName    = "/features.BuildingService/GetBuildings"
-	BuildingService_UpdateBuilding_FullMethodName  = "/features.BuildingService/UpdateBuilding"
-	BuildingService_DestroyBuilding_FullMethodName = "/features.BuildingService/DestroyBuilding"
+	BuildingService_GetBuildPackage_FullMethodName    = "/features.BuildingService/GetBuildPackage"
+	BuildingService_StreamBuildPackage_FullMethodName = "/features.BuildingService/StreamBuildPackage"
+	BuildingService_BuildFeature_FullMethodName       = "/features.BuildingService/BuildFeature"
+	BuildingService_GetBuildings_FullMethodName       = "/features.BuildingService/GetBuildings"
+	BuildingService_UpdateBuilding_FullMethodName     = "/features.BuildingService/UpdateBuilding"
+	BuildingService_DestroyBuilding_FullMethodName    = "/features.BuildingService/DestroyBuilding"
+	BuildingService_SimulateBuild_FullMethodName      = "/features.BuildingService/SimulateBuild"
 )
 
 // BuildingServiceClient is the client API for BuildingService service.
@@ -1191,10 +1663,16 @@ const (
 // BuildingService handles building construction
 type BuildingServiceClient interface {
 	GetBuildPackage(ctx context.Context, in *GetBuildPackageRequest, opts ...grpc.CallOption) (*BuildPackageResponse, error)
+	// StreamBuildPackage returns the same page as GetBuildPackage split into
+	// chunks that each fit the message size limit
+	StreamBuildPackage(ctx context.Context, in *GetBuildPackageRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BuildPackageChunk], error)
 	BuildFeature(ctx context.Context, in *BuildFeatureRequest, opts ...grpc.CallOption) (*BuildFeatureResponse, error)
 	GetBuildings(ctx context.Context, in *GetBuildingsRequest, opts ...grpc.CallOption) (*BuildingsResponse, error)
 	UpdateBuilding(ctx context.Context, in *UpdateBuildingRequest, opts ...grpc.CallOption) (*BuildingResponse, error)
 	DestroyBuilding(ctx context.Context, in *DestroyBuildingRequest, opts ...grpc.CallOption) (*BuildingResponse, error)
+	// SimulateBuild checks the BuildFeature requirements without building, so
+	// clients can show what is missing before the user tries
+	SimulateBuild(ctx context.Context, in *SimulateBuildRequest, opts ...grpc.CallOption) (*SimulateBuildResponse, error)
 }
 
 type buildingServiceClient struct {
@@ -1215,6 +1693,25 @@ func (c *buildingServiceClient) GetBuildPackage(ctx context.Context, in *GetBuil
 	return out, nil
 }
 
+func (c *buildingServiceClient) StreamBuildPackage(ctx context.Context, in *GetBuildPackageRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BuildPackageChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BuildingService_ServiceDesc.Streams[0], BuildingService_StreamBuildPackage_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetBuildPackageRequest, BuildPackageChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BuildingService_StreamBuildPackageClient = grpc.ServerStreamingClient[BuildPackageChunk]
+
 func (c *buildingServiceClient) BuildFeature(ctx context.Context, in *BuildFeatureRequest, opts ...grpc.CallOption) (*BuildFeatureResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BuildFeatureResponse)
@@ -1255,6 +1752,16 @@ func (c *buildingServiceClient) DestroyBuilding(ctx context.Context, in *Destroy
 	return out, nil
 }
 
+func (c *buildingServiceClient) SimulateBuild(ctx context.Context, in *SimulateBuildRequest, opts ...grpc.CallOption) (*SimulateBuildResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateBuildResponse)
+	err := c.cc.Invoke(ctx, BuildingService_SimulateBuild_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BuildingServiceServer is the server API for BuildingService service.
 // All implementations must embed UnimplementedBuildingServiceServer
 // for forward compatibility.
@@ -1262,10 +1769,16 @@ func (c *buildingServiceClient) DestroyBuilding(ctx context.Context, in *Destroy
 // BuildingService handles building construction
 type BuildingServiceServer interface {
 	GetBuildPackage(context.Context, *GetBuildPackageRequest) (*BuildPackageResponse, error)
+	// StreamBuildPackage returns the same page as GetBuildPackage split into
+	// chunks that each fit the message size limit
+	StreamBuildPackage(*GetBuildPackageRequest, grpc.ServerStreamingServer[BuildPackageChunk]) error
 	BuildFeature(context.Context, *BuildFeatureRequest) (*BuildFeatureResponse, error)
 	GetBuildings(context.Context, *GetBuildingsRequest) (*BuildingsResponse, error)
 	UpdateBuilding(context.Context, *UpdateBuildingRequest) (*BuildingResponse, error)
 	DestroyBuilding(context.Context, *DestroyBuildingRequest) (*BuildingResponse, error)
+	// SimulateBuild checks the BuildFeature requirements without building, so
+	// clients can show what is missing before the user tries
+	SimulateBuild(context.Context, *SimulateBuildRequest) (*SimulateBuildResponse, error)
 	mustEmbedUnimplementedBuildingServiceServer()
 }
 
@@ -1279,6 +1792,9 @@ type UnimplementedBuildingServiceServer struct{}
 func (UnimplementedBuildingServiceServer) GetBuildPackage(context.Context, *GetBuildPackageRequest) (*BuildPackageResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetBuildPackage not implemented")
 }
+func (UnimplementedBuildingServiceServer) StreamBuildPackage(*GetBuildPackageRequest, grpc.ServerStreamingServer[BuildPackageChunk]) error {
+	return status.Error(codes.Unimplemented, "method StreamBuildPackage not implemented")
+}
 func (UnimplementedBuildingServiceServer) BuildFeature(context.Context, *BuildFeatureRequest) (*BuildFeatureResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method BuildFeature not implemented")
 }
@@ -1291,6 +1807,9 @@ func (UnimplementedBuildingServiceServer) UpdateBuilding(context.Context, *Updat
 func (UnimplementedBuildingServiceServer) DestroyBuilding(context.Context, *DestroyBuildingRequest) (*BuildingResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DestroyBuilding not implemented")
 }
+func (UnimplementedBuildingServiceServer) SimulateBuild(context.Context, *SimulateBuildRequest) (*SimulateBuildResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SimulateBuild not implemented")
+}
 func (UnimplementedBuildingServiceServer) mustEmbedUnimplementedBuildingServiceServer() {}
 func (UnimplementedBuildingServiceServer) testEmbeddedByValue()                         {}
 
@@ -1330,6 +1849,17 @@ func _BuildingService_GetBuildPackage_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BuildingService_StreamBuildPackage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetBuildPackageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BuildingServiceServer).StreamBuildPackage(m, &grpc.GenericServerStream[GetBuildPackageRequest, BuildPackageChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BuildingService_StreamBuildPackageServer = grpc.ServerStreamingServer[BuildPackageChunk]
+
 func _BuildingService_BuildFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BuildFeatureRequest)
 	if err := dec(in); err != nil {
@@ -1402,6 +1932,24 @@ func _BuildingService_DestroyBuilding_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BuildingService_SimulateBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BuildingServiceServer).SimulateBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BuildingService_SimulateBuild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BuildingServiceServer).SimulateBuild(ctx, req.(*SimulateBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BuildingService_ServiceDesc is the grpc.ServiceDesc for BuildingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1429,8 +1977,18 @@ var BuildingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyBuilding",
 			Handler:    _BuildingService_DestroyBuilding_Handler,
 		},
+		{
+			MethodName: "SimulateBuild",
+			Handler:    _BuildingService_SimulateBuild_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBuildPackage",
+			Handler:       _BuildingService_StreamBuildPackage_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "features.proto",
 }
 
@@ -1615,3 +2173,1706 @@ var MapsService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "features.proto",
 }
+
+const (
+	WatchlistService_AddToWatchlist_FullMethodName      = "/features.WatchlistService/AddToWatchlist"
+	WatchlistService_RemoveFromWatchlist_FullMethodName = "/features.WatchlistService/RemoveFromWatchlist"
+	WatchlistService_ListWatchlist_FullMethodName       = "/features.WatchlistService/ListWatchlist"
+)
+
+// WatchlistServiceClient is the client API for WatchlistService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// WatchlistService lets users follow features and get
+// notified when a watched feature is put up for sale, repriced or sold
+type WatchlistServiceClient interface {
+	AddToWatchlist(ctx context.Context, in *AddToWatchlistRequest, opts ...grpc.CallOption) (*WatchlistItemResponse, error)
+	RemoveFromWatchlist(ctx context.Context, in *RemoveFromWatchlistRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListWatchlist(ctx context.Context, in *ListWatchlistRequest, opts ...grpc.CallOption) (*ListWatchlistResponse, error)
+}
+
+type watchlistServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWatchlistServiceClient(cc grpc.ClientConnInterface) WatchlistServiceClient {
+	return &watchlistServiceClient{cc}
+}
+
+func (c *watchlistServiceClient) AddToWatchlist(ctx context.Context, in *AddToWatchlistRequest, opts ...grpc.CallOption) (*WatchlistItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchlistItemResponse)
+	err := c.cc.Invoke(ctx, WatchlistService_AddToWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) RemoveFromWatchlist(ctx context.Context, in *RemoveFromWatchlistRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WatchlistService_RemoveFromWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) ListWatchlist(ctx context.Context, in *ListWatchlistRequest, opts ...grpc.CallOption) (*ListWatchlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWatchlistResponse)
+	err := c.cc.Invoke(ctx, WatchlistService_ListWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WatchlistServiceServer is the server API for WatchlistService service.
+// All implementations must embed UnimplementedWatchlistServiceServer
+// for forward compatibility.
+//
+// WatchlistService lets users follow features and get
+// notified when a watched feature is put up for sale, repriced or sold
+type WatchlistServiceServer interface {
+	AddToWatchlist(context.Context, *AddToWatchlistRequest) (*WatchlistItemResponse, error)
+	RemoveFromWatchlist(context.Context, *RemoveFromWatchlistRequest) (*emptypb.Empty, error)
+	ListWatchlist(context.Context, *ListWatchlistRequest) (*ListWatchlistResponse, error)
+	mustEmbedUnimplementedWatchlistServiceServer()
+}
+
+// UnimplementedWatchlistServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWatchlistServiceServer struct{}
+
+func (UnimplementedWatchlistServiceServer) AddToWatchlist(context.Context, *AddToWatchlistRequest) (*WatchlistItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddToWatchlist not implemented")
+}
+func (UnimplementedWatchlistServiceServer) RemoveFromWatchlist(context.Context, *RemoveFromWatchlistRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveFromWatchlist not implemented")
+}
+func (UnimplementedWatchlistServiceServer) ListWatchlist(context.Context, *ListWatchlistRequest) (*ListWatchlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWatchlist not implemented")
+}
+func (UnimplementedWatchlistServiceServer) mustEmbedUnimplementedWatchlistServiceServer() {}
+func (UnimplementedWatchlistServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeWatchlistServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WatchlistServiceServer will
+// result in compilation errors.
+type UnsafeWatchlistServiceServer interface {
+	mustEmbedUnimplementedWatchlistServiceServer()
+}
+
+func RegisterWatchlistServiceServer(s grpc.ServiceRegistrar, srv WatchlistServiceServer) {
+	// If the following call panics, it indicates UnimplementedWatchlistServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WatchlistService_ServiceDesc, srv)
+}
+
+func _WatchlistService_AddToWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToWatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).AddToWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_AddToWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).AddToWatchlist(ctx, req.(*AddToWatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_RemoveFromWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromWatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).RemoveFromWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_RemoveFromWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).RemoveFromWatchlist(ctx, req.(*RemoveFromWatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_ListWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).ListWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_ListWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).ListWatchlist(ctx, req.(*ListWatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WatchlistService_ServiceDesc is the grpc.ServiceDesc for WatchlistService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WatchlistService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "features.WatchlistService",
+	HandlerType: (*WatchlistServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddToWatchlist",
+			Handler:    _WatchlistService_AddToWatchlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromWatchlist",
+			Handler:    _WatchlistService_RemoveFromWatchlist_Handler,
+		},
+		{
+			MethodName: "ListWatchlist",
+			Handler:    _WatchlistService_ListWatchlist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "features.proto",
+}
+
+const (
+	SavedSearchService_CreateSavedSearch_FullMethodName = "/features.SavedSearchService/CreateSavedSearch"
+	SavedSearchService_UpdateSavedSearch_FullMethodName = "/features.SavedSearchService/UpdateSavedSearch"
+	SavedSearchService_DeleteSavedSearch_FullMethodName = "/features.SavedSearchService/DeleteSavedSearch"
+	SavedSearchService_ListSavedSearches_FullMethodName = "/features.SavedSearchService/ListSavedSearches"
+)
+
+// SavedSearchServiceClient is the client API for SavedSearchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SavedSearchService stores marketplace search criteria and notifies their
+// owners when a feature matching them is put up for sale
+type SavedSearchServiceClient interface {
+	CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error)
+	UpdateSavedSearch(ctx context.Context, in *UpdateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error)
+	DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error)
+}
+
+type savedSearchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSavedSearchServiceClient(cc grpc.ClientConnInterface) SavedSearchServiceClient {
+	return &savedSearchServiceClient{cc}
+}
+
+func (c *savedSearchServiceClient) CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearchResponse)
+	err := c.cc.Invoke(ctx, SavedSearchService_CreateSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchServiceClient) UpdateSavedSearch(ctx context.Context, in *UpdateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearchResponse)
+	err := c.cc.Invoke(ctx, SavedSearchService_UpdateSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchServiceClient) DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SavedSearchService_DeleteSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchServiceClient) ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSavedSearchesResponse)
+	err := c.cc.Invoke(ctx, SavedSearchService_ListSavedSearches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SavedSearchServiceServer is the server API for SavedSearchService service.
+// All implementations must embed UnimplementedSavedSearchServiceServer
+// for forward compatibility.
+//
+// SavedSearchService stores marketplace search criteria and notifies their
+// owners when a feature matching them is put up for sale
+type SavedSearchServiceServer interface {
+	CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*SavedSearchResponse, error)
+	UpdateSavedSearch(context.Context, *UpdateSavedSearchRequest) (*SavedSearchResponse, error)
+	DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*emptypb.Empty, error)
+	ListSavedSearches(context.Context, *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error)
+	mustEmbedUnimplementedSavedSearchServiceServer()
+}
+
+// UnimplementedSavedSearchServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSavedSearchServiceServer struct{}
+
+func (UnimplementedSavedSearchServiceServer) CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*SavedSearchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSavedSearch not implemented")
+}
+func (UnimplementedSavedSearchServiceServer) UpdateSavedSearch(context.Context, *UpdateSavedSearchRequest) (*SavedSearchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSavedSearch not implemented")
+}
+func (UnimplementedSavedSearchServiceServer) DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSavedSearch not implemented")
+}
+func (UnimplementedSavedSearchServiceServer) ListSavedSearches(context.Context, *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSavedSearches not implemented")
+}
+func (UnimplementedSavedSearchServiceServer) mustEmbedUnimplementedSavedSearchServiceServer() {}
+func (UnimplementedSavedSearchServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeSavedSearchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SavedSearchServiceServer will
+// result in compilation errors.
+type UnsafeSavedSearchServiceServer interface {
+	mustEmbedUnimplementedSavedSearchServiceServer()
+}
+
+func RegisterSavedSearchServiceServer(s grpc.ServiceRegistrar, srv SavedSearchServiceServer) {
+	// If the following call panics, it indicates UnimplementedSavedSearchServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SavedSearchService_ServiceDesc, srv)
+}
+
+func _SavedSearchService_CreateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServiceServer).CreateSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearchService_CreateSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServiceServer).CreateSavedSearch(ctx, req.(*CreateSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearchService_UpdateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServiceServer).UpdateSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearchService_UpdateSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServiceServer).UpdateSavedSearch(ctx, req.(*UpdateSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearchService_DeleteSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServiceServer).DeleteSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearchService_DeleteSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServiceServer).DeleteSavedSearch(ctx, req.(*DeleteSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearchService_ListSavedSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedSearchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServiceServer).ListSavedSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearchService_ListSavedSearches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServiceServer).ListSavedSearches(ctx, req.(*ListSavedSearchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SavedSearchService_ServiceDesc is the grpc.ServiceDesc for SavedSearchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SavedSearchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "features.SavedSearchService",
+	HandlerType: (*SavedSearchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSavedSearch",
+			Handler:    _SavedSearchService_CreateSavedSearch_Handler,
+		},
+		{
+			MethodName: "UpdateSavedSearch",
+			Handler:    _SavedSearchService_UpdateSavedSearch_Handler,
+		},
+		{
+			MethodName: "DeleteSavedSearch",
+			Handler:    _SavedSearchService_DeleteSavedSearch_Handler,
+		},
+		{
+			MethodName: "ListSavedSearches",
+			Handler:    _SavedSearchService_ListSavedSearches_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "features.proto",
+}
+
+const (
+	TradeService_GetTrade_FullMethodName          = "/features.TradeService/GetTrade"
+	TradeService_FreezeTradeFunds_FullMethodName  = "/features.TradeService/FreezeTradeFunds"
+	TradeService_ReleaseTradeFunds_FullMethodName = "/features.TradeService/ReleaseTradeFunds"
+	TradeService_RefundTrade_FullMethodName       = "/features.TradeService/RefundTrade"
+)
+
+// TradeServiceClient is the client API for TradeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TradeService exposes completed trades to support-service's dispute
+// workflow and executes dispute outcomes. It is not routed by the gateway.
+type TradeServiceClient interface {
+	GetTrade(ctx context.Context, in *GetTradeRequest, opts ...grpc.CallOption) (*TradeResponse, error)
+	// FreezeTradeFunds locks the seller's proceeds of the trade in their wallet
+	FreezeTradeFunds(ctx context.Context, in *TradeFundsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ReleaseTradeFunds unlocks proceeds frozen by FreezeTradeFunds
+	ReleaseTradeFunds(ctx context.Context, in *TradeFundsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RefundTrade returns the price to the buyer and the feature to the seller
+	RefundTrade(ctx context.Context, in *RefundTradeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type tradeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTradeServiceClient(cc grpc.ClientConnInterface) TradeServiceClient {
+	return &tradeServiceClient{cc}
+}
+
+func (c *tradeServiceClient) GetTrade(ctx context.Context, in *GetTradeRequest, opts ...grpc.CallOption) (*TradeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TradeResponse)
+	err := c.cc.Invoke(ctx, TradeService_GetTrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeServiceClient) FreezeTradeFunds(ctx context.Context, in *TradeFundsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TradeService_FreezeTradeFunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeServiceClient) ReleaseTradeFunds(ctx context.Context, in *TradeFundsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TradeService_ReleaseTradeFunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeServiceClient) RefundTrade(ctx context.Context, in *RefundTradeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TradeService_RefundTrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TradeServiceServer is the server API for TradeService service.
+// All implementations must embed UnimplementedTradeServiceServer
+// for forward compatibility.
+//
+// TradeService exposes completed trades to support-service's dispute
+// workflow and executes dispute outcomes. It is not routed by the gateway.
+type TradeServiceServer interface {
+	GetTrade(context.Context, *GetTradeRequest) (*TradeResponse, error)
+	// FreezeTradeFunds locks the seller's proceeds of the trade in their wallet
+	FreezeTradeFunds(context.Context, *TradeFundsRequest) (*emptypb.Empty, error)
+	// ReleaseTradeFunds unlocks proceeds frozen by FreezeTradeFunds
+	ReleaseTradeFunds(context.Context, *TradeFundsRequest) (*emptypb.Empty, error)
+	// RefundTrade returns the price to the buyer and the feature to the seller
+	RefundTrade(context.Context, *RefundTradeRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedTradeServiceServer()
+}
+
+// UnimplementedTradeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTradeServiceServer struct{}
+
+func (UnimplementedTradeServiceServer) GetTrade(context.Context, *GetTradeRequest) (*TradeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTrade not implemented")
+}
+func (UnimplementedTradeServiceServer) FreezeTradeFunds(context.Context, *TradeFundsRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method FreezeTradeFunds not implemented")
+}
+func (UnimplementedTradeServiceServer) ReleaseTradeFunds(context.Context, *TradeFundsRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseTradeFunds not implemented")
+}
+func (UnimplementedTradeServiceServer) RefundTrade(context.Context, *RefundTradeRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefundTrade not implemented")
+}
+func (UnimplementedTradeServiceServer) mustEmbedUnimplementedTradeServiceServer() {}
+func (UnimplementedTradeServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeTradeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TradeServiceServer will
+// result in compilation errors.
+type UnsafeTradeServiceServer interface {
+	mustEmbedUnimplementedTradeServiceServer()
+}
+
+func RegisterTradeServiceServer(s grpc.ServiceRegistrar, srv TradeServiceServer) {
+	// If the following call panics, it indicates UnimplementedTradeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TradeService_ServiceDesc, srv)
+}
+
+func _TradeService_GetTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeServiceServer).GetTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TradeService_GetTrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeServiceServer).GetTrade(ctx, req.(*GetTradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradeService_FreezeTradeFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradeFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeServiceServer).FreezeTradeFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TradeService_FreezeTradeFunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeServiceServer).FreezeTradeFunds(ctx, req.(*TradeFundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradeService_ReleaseTradeFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradeFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeServiceServer).ReleaseTradeFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TradeService_ReleaseTradeFunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeServiceServer).ReleaseTradeFunds(ctx, req.(*TradeFundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradeService_RefundTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundTradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeServiceServer).RefundTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TradeService_RefundTrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeServiceServer).RefundTrade(ctx, req.(*RefundTradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TradeService_ServiceDesc is the grpc.ServiceDesc for TradeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TradeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "features.TradeService",
+	HandlerType: (*TradeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTrade",
+			Handler:    _TradeService_GetTrade_Handler,
+		},
+		{
+			MethodName: "FreezeTradeFunds",
+			Handler:    _TradeService_FreezeTradeFunds_Handler,
+		},
+		{
+			MethodName: "ReleaseTradeFunds",
+			Handler:    _TradeService_ReleaseTradeFunds_Handler,
+		},
+		{
+			MethodName: "RefundTrade",
+			Handler:    _TradeService_RefundTrade_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "features.proto",
+}
+
+const (
+	FeatureGeometryService_UpdateFeatureGeometry_FullMethodName = "/features.FeatureGeometryService/UpdateFeatureGeometry"
+	FeatureGeometryService_ListGeometryVersions_FullMethodName  = "/features.FeatureGeometryService/ListGeometryVersions"
+)
+
+// FeatureGeometryServiceClient is the client API for FeatureGeometryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FeatureGeometryService lets map admins redraw feature polygons. Every edit
+// is validated, kept as a numbered version and broadcast to map clients.
+type FeatureGeometryServiceClient interface {
+	UpdateFeatureGeometry(ctx context.Context, in *UpdateFeatureGeometryRequest, opts ...grpc.CallOption) (*GeometryVersionResponse, error)
+	ListGeometryVersions(ctx context.Context, in *ListGeometryVersionsRequest, opts ...grpc.CallOption) (*ListGeometryVersionsResponse, error)
+}
+
+type featureGeometryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFeatureGeometryServiceClient(cc grpc.ClientConnInterface) FeatureGeometryServiceClient {
+	return &featureGeometryServiceClient{cc}
+}
+
+func (c *featureGeometryServiceClient) UpdateFeatureGeometry(ctx context.Context, in *UpdateFeatureGeometryRequest, opts ...grpc.CallOption) (*GeometryVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeometryVersionResponse)
+	err := c.cc.Invoke(ctx, FeatureGeometryService_UpdateFeatureGeometry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureGeometryServiceClient) ListGeometryVersions(ctx context.Context, in *ListGeometryVersionsRequest, opts ...grpc.CallOption) (*ListGeometryVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGeometryVersionsResponse)
+	err := c.cc.Invoke(ctx, FeatureGeometryService_ListGeometryVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FeatureGeometryServiceServer is the server API for FeatureGeometryService service.
+// All implementations must embed UnimplementedFeatureGeometryServiceServer
+// for forward compatibility.
+//
+// FeatureGeometryService lets map admins redraw feature polygons. Every edit
+// is validated, kept as a numbered version and broadcast to map clients.
+type FeatureGeometryServiceServer interface {
+	UpdateFeatureGeometry(context.Context, *UpdateFeatureGeometryRequest) (*GeometryVersionResponse, error)
+	ListGeometryVersions(context.Context, *ListGeometryVersionsRequest) (*ListGeometryVersionsResponse, error)
+	mustEmbedUnimplementedFeatureGeometryServiceServer()
+}
+
+// UnimplementedFeatureGeometryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFeatureGeometryServiceServer struct{}
+
+func (UnimplementedFeatureGeometryServiceServer) UpdateFeatureGeometry(context.Context, *UpdateFeatureGeometryRequest) (*GeometryVersionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateFeatureGeometry not implemented")
+}
+func (UnimplementedFeatureGeometryServiceServer) ListGeometryVersions(context.Context, *ListGeometryVersionsRequest) (*ListGeometryVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGeometryVersions not implemented")
+}
+func (UnimplementedFeatureGeometryServiceServer) mustEmbedUnimplementedFeatureGeometryServiceServer() {
+}
+func (UnimplementedFeatureGeometryServiceServer) testEmbeddedByValue() {}
+
+// UnsafeFeatureGeometryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FeatureGeometryServiceServer will
+// result in compilation errors.
+type UnsafeFeatureGeometryServiceServer interface {
+	mustEmbedUnimplementedFeatureGeometryServiceServer()
+}
+
+func RegisterFeatureGeometryServiceServer(s grpc.ServiceRegistrar, srv FeatureGeometryServiceServer) {
+	// If the following call panics, it indicates UnimplementedFeatureGeometryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FeatureGeometryService_ServiceDesc, srv)
+}
+
+func _FeatureGeometryService_UpdateFeatureGeometry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFeatureGeometryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureGeometryServiceServer).UpdateFeatureGeometry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureGeometryService_UpdateFeatureGeometry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureGeometryServiceServer).UpdateFeatureGeometry(ctx, req.(*UpdateFeatureGeometryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureGeometryService_ListGeometryVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGeometryVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureGeometryServiceServer).ListGeometryVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureGeometryService_ListGeometryVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureGeometryServiceServer).ListGeometryVersions(ctx, req.(*ListGeometryVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FeatureGeometryService_ServiceDesc is the grpc.ServiceDesc for FeatureGeometryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FeatureGeometryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "features.FeatureGeometryService",
+	HandlerType: (*FeatureGeometryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateFeatureGeometry",
+			Handler:    _FeatureGeometryService_UpdateFeatureGeometry_Handler,
+		},
+		{
+			MethodName: "ListGeometryVersions",
+			Handler:    _FeatureGeometryService_ListGeometryVersions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "features.proto",
+}
+
+const (
+	FeatureInstallmentService_ReserveFeature_FullMethodName            = "/features.FeatureInstallmentService/ReserveFeature"
+	FeatureInstallmentService_CompleteReservedPurchase_FullMethodName  = "/features.FeatureInstallmentService/CompleteReservedPurchase"
+	FeatureInstallmentService_ReleaseFeatureReservation_FullMethodName = "/features.FeatureInstallmentService/ReleaseFeatureReservation"
+)
+
+// FeatureInstallmentServiceClient is the client API for FeatureInstallmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FeatureInstallmentService holds features for installment purchases run by
+// commercial-service. A reserved feature cannot be bought by anyone else; it
+// changes owner only when the plan is paid off and is released on default.
+type FeatureInstallmentServiceClient interface {
+	ReserveFeature(ctx context.Context, in *ReserveFeatureRequest, opts ...grpc.CallOption) (*FeatureReservation, error)
+	CompleteReservedPurchase(ctx context.Context, in *FeatureReservationRequest, opts ...grpc.CallOption) (*CompleteReservedPurchaseResponse, error)
+	ReleaseFeatureReservation(ctx context.Context, in *FeatureReservationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type featureInstallmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFeatureInstallmentServiceClient(cc grpc.ClientConnInterface) FeatureInstallmentServiceClient {
+	return &featureInstallmentServiceClient{cc}
+}
+
+func (c *featureInstallmentServiceClient) ReserveFeature(ctx context.Context, in *ReserveFeatureRequest, opts ...grpc.CallOption) (*FeatureReservation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureReservation)
+	err := c.cc.Invoke(ctx, FeatureInstallmentService_ReserveFeature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureInstallmentServiceClient) CompleteReservedPurchase(ctx context.Context, in *FeatureReservationRequest, opts ...grpc.CallOption) (*CompleteReservedPurchaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteReservedPurchaseResponse)
+	err := c.cc.Invoke(ctx, FeatureInstallmentService_CompleteReservedPurchase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureInstallmentServiceClient) ReleaseFeatureReservation(ctx context.Context, in *FeatureReservationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FeatureInstallmentService_ReleaseFeatureReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FeatureInstallmentServiceServer is the server API for FeatureInstallmentService service.
+// All implementations must embed UnimplementedFeatureInstallmentServiceServer
+// for forward compatibility.
+//
+// FeatureInstallmentService holds features for installment purchases run by
+// commercial-service. A reserved feature cannot be bought by anyone else; it
+// changes owner only when the plan is paid off and is released on default.
+type FeatureInstallmentServiceServer interface {
+	ReserveFeature(context.Context, *ReserveFeatureRequest) (*FeatureReservation, error)
+	CompleteReservedPurchase(context.Context, *FeatureReservationRequest) (*CompleteReservedPurchaseResponse, error)
+	ReleaseFeatureReservation(context.Context, *FeatureReservationRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedFeatureInstallmentServiceServer()
+}
+
+// UnimplementedFeatureInstallmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFeatureInstallmentServiceServer struct{}
+
+func (UnimplementedFeatureInstallmentServiceServer) ReserveFeature(context.Context, *ReserveFeatureRequest) (*FeatureReservation, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReserveFeature not implemented")
+}
+func (UnimplementedFeatureInstallmentServiceServer) CompleteReservedPurchase(context.Context, *FeatureReservationRequest) (*CompleteReservedPurchaseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteReservedPurchase not implemented")
+}
+func (UnimplementedFeatureInstallmentServiceServer) ReleaseFeatureReservation(context.Context, *FeatureReservationRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseFeatureReservation not implemented")
+}
+func (UnimplementedFeatureInstallmentServiceServer) mustEmbedUnimplementedFeatureInstallmentServiceServer() {
+}
+func (UnimplementedFeatureInstallmentServiceServer) testEmbeddedByValue() {}
+
+// UnsafeFeatureInstallmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FeatureInstallmentServiceServer will
+// result in compilation errors.
+type UnsafeFeatureInstallmentServiceServer interface {
+	mustEmbedUnimplementedFeatureInstallmentServiceServer()
+}
+
+func RegisterFeatureInstallmentServiceServer(s grpc.ServiceRegistrar, srv FeatureInstallmentServiceServer) {
+	// If the following call panics, it indicates UnimplementedFeatureInstallmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FeatureInstallmentService_ServiceDesc, srv)
+}
+
+func _FeatureInstallmentService_ReserveFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveFeatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureInstallmentServiceServer).ReserveFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureInstallmentService_ReserveFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureInstallmentServiceServer).ReserveFeature(ctx, req.(*ReserveFeatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureInstallmentService_CompleteReservedPurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeatureReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureInstallmentServiceServer).CompleteReservedPurchase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureInstallmentService_CompleteReservedPurchase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureInstallmentServiceServer).CompleteReservedPurchase(ctx, req.(*FeatureReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureInstallmentService_ReleaseFeatureReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeatureReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureInstallmentServiceServer).ReleaseFeatureReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureInstallmentService_ReleaseFeatureReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureInstallmentServiceServer).ReleaseFeatureReservation(ctx, req.(*FeatureReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FeatureInstallmentService_ServiceDesc is the grpc.ServiceDesc for FeatureInstallmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FeatureInstallmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "features.FeatureInstallmentService",
+	HandlerType: (*FeatureInstallmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReserveFeature",
+			Handler:    _FeatureInstallmentService_ReserveFeature_Handler,
+		},
+		{
+			MethodName: "CompleteReservedPurchase",
+			Handler:    _FeatureInstallmentService_CompleteReservedPurchase_Handler,
+		},
+		{
+			MethodName: "ReleaseFeatureReservation",
+			Handler:    _FeatureInstallmentService_ReleaseFeatureReservation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "features.proto",
+}
+
+const (
+	FeatureGalleryService_ListFeatureImages_FullMethodName    = "/features.FeatureGalleryService/ListFeatureImages"
+	FeatureGalleryService_AttachFeatureImages_FullMethodName  = "/features.FeatureGalleryService/AttachFeatureImages"
+	FeatureGalleryService_RemoveFeatureImage_FullMethodName   = "/features.FeatureGalleryService/RemoveFeatureImage"
+	FeatureGalleryService_ReorderFeatureImages_FullMethodName = "/features.FeatureGalleryService/ReorderFeatureImages"
+	FeatureGalleryService_SetFeatureCoverImage_FullMethodName = "/features.FeatureGalleryService/SetFeatureCoverImage"
+)
+
+// FeatureGalleryServiceClient is the client API for FeatureGalleryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FeatureGalleryService manages the image gallery of a feature. Anyone may
+// list a gallery; only the feature's owner may change it. Uploads are stored
+// through storage-service together with a generated thumbnail.
+type FeatureGalleryServiceClient interface {
+	ListFeatureImages(ctx context.Context, in *ListFeatureImagesRequest, opts ...grpc.CallOption) (*FeatureImagesResponse, error)
+	AttachFeatureImages(ctx context.Context, in *AttachFeatureImagesRequest, opts ...grpc.CallOption) (*FeatureImagesResponse, error)
+	RemoveFeatureImage(ctx context.Context, in *RemoveFeatureImageRequest, opts ...grpc.CallOption) (*FeatureImagesResponse, error)
+	ReorderFeatureImages(ctx context.Context, in *ReorderFeatureImagesRequest, opts ...grpc.CallOption) (*FeatureImagesResponse, error)
+	SetFeatureCoverImage(ctx context.Context, in *SetFeatureCoverImageRequest, opts ...grpc.CallOption) (*FeatureImagesResponse, error)
+}
+
+type featureGalleryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFeatureGalleryServiceClient(cc grpc.ClientConnInterface) FeatureGalleryServiceClient {
+	return &featureGalleryServiceClient{cc}
+}
+
+func (c *featureGalleryServiceClient) ListFeatureImages(ctx context.Context, in *ListFeatureImagesRequest, opts ...grpc.CallOption) (*FeatureImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureImagesResponse)
+	err := c.cc.Invoke(ctx, FeatureGalleryService_ListFeatureImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureGalleryServiceClient) AttachFeatureImages(ctx context.Context, in *AttachFeatureImagesRequest, opts ...grpc.CallOption) (*FeatureImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureImagesResponse)
+	err := c.cc.Invoke(ctx, FeatureGalleryService_AttachFeatureImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureGalleryServiceClient) RemoveFeatureImage(ctx context.Context, in *RemoveFeatureImageRequest, opts ...grpc.CallOption) (*FeatureImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureImagesResponse)
+	err := c.cc.Invoke(ctx, FeatureGalleryService_RemoveFeatureImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureGalleryServiceClient) ReorderFeatureImages(ctx context.Context, in *ReorderFeatureImagesRequest, opts ...grpc.CallOption) (*FeatureImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureImagesResponse)
+	err := c.cc.Invoke(ctx, FeatureGalleryService_ReorderFeatureImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureGalleryServiceClient) SetFeatureCoverImage(ctx context.Context, in *SetFeatureCoverImageRequest, opts ...grpc.CallOption) (*FeatureImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureImagesResponse)
+	err := c.cc.Invoke(ctx, FeatureGalleryService_SetFeatureCoverImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FeatureGalleryServiceServer is the server API for FeatureGalleryService service.
+// All implementations must embed UnimplementedFeatureGalleryServiceServer
+// for forward compatibility.
+//
+// FeatureGalleryService manages the image gallery of a feature. Anyone may
+// list a gallery; only the feature's owner may change it. Uploads are stored
+// through storage-service together with a generated thumbnail.
+type FeatureGalleryServiceServer interface {
+	ListFeatureImages(context.Context, *ListFeatureImagesRequest) (*FeatureImagesResponse, error)
+	AttachFeatureImages(context.Context, *AttachFeatureImagesRequest) (*FeatureImagesResponse, error)
+	RemoveFeatureImage(context.Context, *RemoveFeatureImageRequest) (*FeatureImagesResponse, error)
+	ReorderFeatureImages(context.Context, *ReorderFeatureImagesRequest) (*FeatureImagesResponse, error)
+	SetFeatureCoverImage(context.Context, *SetFeatureCoverImageRequest) (*FeatureImagesResponse, error)
+	mustEmbedUnimplementedFeatureGalleryServiceServer()
+}
+
+// UnimplementedFeatureGalleryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFeatureGalleryServiceServer struct{}
+
+func (UnimplementedFeatureGalleryServiceServer) ListFeatureImages(context.Context, *ListFeatureImagesRequest) (*FeatureImagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFeatureImages not implemented")
+}
+func (UnimplementedFeatureGalleryServiceServer) AttachFeatureImages(context.Context, *AttachFeatureImagesRequest) (*FeatureImagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AttachFeatureImages not implemented")
+}
+func (UnimplementedFeatureGalleryServiceServer) RemoveFeatureImage(context.Context, *RemoveFeatureImageRequest) (*FeatureImagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveFeatureImage not implemented")
+}
+func (UnimplementedFeatureGalleryServiceServer) ReorderFeatureImages(context.Context, *ReorderFeatureImagesRequest) (*FeatureImagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderFeatureImages not implemented")
+}
+func (UnimplementedFeatureGalleryServiceServer) SetFeatureCoverImage(context.Context, *SetFeatureCoverImageRequest) (*FeatureImagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFeatureCoverImage not implemented")
+}
+func (UnimplementedFeatureGalleryServiceServer) mustEmbedUnimplementedFeatureGalleryServiceServer() {}
+func (UnimplementedFeatureGalleryServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeFeatureGalleryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FeatureGalleryServiceServer will
+// result in compilation errors.
+type UnsafeFeatureGalleryServiceServer interface {
+	mustEmbedUnimplementedFeatureGalleryServiceServer()
+}
+
+func RegisterFeatureGalleryServiceServer(s grpc.ServiceRegistrar, srv FeatureGalleryServiceServer) {
+	// If the following call panics, it indicates UnimplementedFeatureGalleryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FeatureGalleryService_ServiceDesc, srv)
+}
+
+func _FeatureGalleryService_ListFeatureImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeatureImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureGalleryServiceServer).ListFeatureImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureGalleryService_ListFeatureImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureGalleryServiceServer).ListFeatureImages(ctx, req.(*ListFeatureImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureGalleryService_AttachFeatureImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachFeatureImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureGalleryServiceServer).AttachFeatureImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureGalleryService_AttachFeatureImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureGalleryServiceServer).AttachFeatureImages(ctx, req.(*AttachFeatureImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureGalleryService_RemoveFeatureImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFeatureImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureGalleryServiceServer).RemoveFeatureImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureGalleryService_RemoveFeatureImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureGalleryServiceServer).RemoveFeatureImage(ctx, req.(*RemoveFeatureImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureGalleryService_ReorderFeatureImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderFeatureImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureGalleryServiceServer).ReorderFeatureImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureGalleryService_ReorderFeatureImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureGalleryServiceServer).ReorderFeatureImages(ctx, req.(*ReorderFeatureImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureGalleryService_SetFeatureCoverImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFeatureCoverImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureGalleryServiceServer).SetFeatureCoverImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureGalleryService_SetFeatureCoverImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureGalleryServiceServer).SetFeatureCoverImage(ctx, req.(*SetFeatureCoverImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FeatureGalleryService_ServiceDesc is the grpc.ServiceDesc for FeatureGalleryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FeatureGalleryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "features.FeatureGalleryService",
+	HandlerType: (*FeatureGalleryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListFeatureImages",
+			Handler:    _FeatureGalleryService_ListFeatureImages_Handler,
+		},
+		{
+			MethodName: "AttachFeatureImages",
+			Handler:    _FeatureGalleryService_AttachFeatureImages_Handler,
+		},
+		{
+			MethodName: "RemoveFeatureImage",
+			Handler:    _FeatureGalleryService_RemoveFeatureImage_Handler,
+		},
+		{
+			MethodName: "ReorderFeatureImages",
+			Handler:    _FeatureGalleryService_ReorderFeatureImages_Handler,
+		},
+		{
+			MethodName: "SetFeatureCoverImage",
+			Handler:    _FeatureGalleryService_SetFeatureCoverImage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "features.proto",
+}
+
+const (
+	TradeReceiptService_GetTradeReceipt_FullMethodName    = "/features.TradeReceiptService/GetTradeReceipt"
+	TradeReceiptService_VerifyTradeReceipt_FullMethodName = "/features.TradeReceiptService/VerifyTradeReceipt"
+)
+
+// TradeReceiptServiceClient is the client API for TradeReceiptService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TradeReceiptService serves the receipt issued for every trade. Its
+// verification code lets buyers and sellers prove a trade happened to anyone,
+// for example in a dispute off the platform.
+type TradeReceiptServiceClient interface {
+	GetTradeReceipt(ctx context.Context, in *GetTradeReceiptRequest, opts ...grpc.CallOption) (*TradeReceiptResponse, error)
+	VerifyTradeReceipt(ctx context.Context, in *VerifyTradeReceiptRequest, opts ...grpc.CallOption) (*TradeReceiptResponse, error)
+}
+
+type tradeReceiptServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTradeReceiptServiceClient(cc grpc.ClientConnInterface) TradeReceiptServiceClient {
+	return &tradeReceiptServiceClient{cc}
+}
+
+func (c *tradeReceiptServiceClient) GetTradeReceipt(ctx context.Context, in *GetTradeReceiptRequest, opts ...grpc.CallOption) (*TradeReceiptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TradeReceiptResponse)
+	err := c.cc.Invoke(ctx, TradeReceiptService_GetTradeReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeReceiptServiceClient) VerifyTradeReceipt(ctx context.Context, in *VerifyTradeReceiptRequest, opts ...grpc.CallOption) (*TradeReceiptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TradeReceiptResponse)
+	err := c.cc.Invoke(ctx, TradeReceiptService_VerifyTradeReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TradeReceiptServiceServer is the server API for TradeReceiptService service.
+// All implementations must embed UnimplementedTradeReceiptServiceServer
+// for forward compatibility.
+//
+// TradeReceiptService serves the receipt issued for every trade. Its
+// verification code lets buyers and sellers prove a trade happened to anyone,
+// for example in a dispute off the platform.
+type TradeReceiptServiceServer interface {
+	GetTradeReceipt(context.Context, *GetTradeReceiptRequest) (*TradeReceiptResponse, error)
+	VerifyTradeReceipt(context.Context, *VerifyTradeReceiptRequest) (*TradeReceiptResponse, error)
+	mustEmbedUnimplementedTradeReceiptServiceServer()
+}
+
+// UnimplementedTradeReceiptServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTradeReceiptServiceServer struct{}
+
+func (UnimplementedTradeReceiptServiceServer) GetTradeReceipt(context.Context, *GetTradeReceiptRequest) (*TradeReceiptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTradeReceipt not implemented")
+}
+func (UnimplementedTradeReceiptServiceServer) VerifyTradeReceipt(context.Context, *VerifyTradeReceiptRequest) (*TradeReceiptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyTradeReceipt not implemented")
+}
+func (UnimplementedTradeReceiptServiceServer) mustEmbedUnimplementedTradeReceiptServiceServer() {}
+func (UnimplementedTradeReceiptServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeTradeReceiptServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TradeReceiptServiceServer will
+// result in compilation errors.
+type UnsafeTradeReceiptServiceServer interface {
+	mustEmbedUnimplementedTradeReceiptServiceServer()
+}
+
+func RegisterTradeReceiptServiceServer(s grpc.ServiceRegistrar, srv TradeReceiptServiceServer) {
+	// If the following call panics, it indicates UnimplementedTradeReceiptServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TradeReceiptService_ServiceDesc, srv)
+}
+
+func _TradeReceiptService_GetTradeReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTradeReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeReceiptServiceServer).GetTradeReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TradeReceiptService_GetTradeReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeReceiptServiceServer).GetTradeReceipt(ctx, req.(*GetTradeReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradeReceiptService_VerifyTradeReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTradeReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeReceiptServiceServer).VerifyTradeReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TradeReceiptService_VerifyTradeReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeReceiptServiceServer).VerifyTradeReceipt(ctx, req.(*VerifyTradeReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TradeReceiptService_ServiceDesc is the grpc.ServiceDesc for TradeReceiptService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TradeReceiptService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "features.TradeReceiptService",
+	HandlerType: (*TradeReceiptServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTradeReceipt",
+			Handler:    _TradeReceiptService_GetTradeReceipt_Handler,
+		},
+		{
+			MethodName: "VerifyTradeReceipt",
+			Handler:    _TradeReceiptService_VerifyTradeReceipt_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "features.proto",
+}
+
+const (
+	TradeSummaryService_GetAnnualTradeSummary_FullMethodName = "/features.TradeSummaryService/GetAnnualTradeSummary"
+)
+
+// TradeSummaryServiceClient is the client API for TradeSummaryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TradeSummaryService totals a user's trades, fees and profit payouts per
+// Jalali year, the figures users need at tax time
+type TradeSummaryServiceClient interface {
+	GetAnnualTradeSummary(ctx context.Context, in *GetAnnualTradeSummaryRequest, opts ...grpc.CallOption) (*AnnualTradeSummaryResponse, error)
+}
+
+type tradeSummaryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTradeSummaryServiceClient(cc grpc.ClientConnInterface) TradeSummaryServiceClient {
+	return &tradeSummaryServiceClient{cc}
+}
+
+func (c *tradeSummaryServiceClient) GetAnnualTradeSummary(ctx context.Context, in *GetAnnualTradeSummaryRequest, opts ...grpc.CallOption) (*AnnualTradeSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnnualTradeSummaryResponse)
+	err := c.cc.Invoke(ctx, TradeSummaryService_GetAnnualTradeSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TradeSummaryServiceServer is the server API for TradeSummaryService service.
+// All implementations must embed UnimplementedTradeSummaryServiceServer
+// for forward compatibility.
+//
+// TradeSummaryService totals a user's trades, fees and profit payouts per
+// Jalali year, the figures users need at tax time
+type TradeSummaryServiceServer interface {
+	GetAnnualTradeSummary(context.Context, *GetAnnualTradeSummaryRequest) (*AnnualTradeSummaryResponse, error)
+	mustEmbedUnimplementedTradeSummaryServiceServer()
+}
+
+// UnimplementedTradeSummaryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTradeSummaryServiceServer struct{}
+
+func (UnimplementedTradeSummaryServiceServer) GetAnnualTradeSummary(context.Context, *GetAnnualTradeSummaryRequest) (*AnnualTradeSummaryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAnnualTradeSummary not implemented")
+}
+func (UnimplementedTradeSummaryServiceServer) mustEmbedUnimplementedTradeSummaryServiceServer() {}
+func (UnimplementedTradeSummaryServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeTradeSummaryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TradeSummaryServiceServer will
+// result in compilation errors.
+type UnsafeTradeSummaryServiceServer interface {
+	mustEmbedUnimplementedTradeSummaryServiceServer()
+}
+
+func RegisterTradeSummaryServiceServer(s grpc.ServiceRegistrar, srv TradeSummaryServiceServer) {
+	// If the following call panics, it indicates UnimplementedTradeSummaryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TradeSummaryService_ServiceDesc, srv)
+}
+
+func _TradeSummaryService_GetAnnualTradeSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnnualTradeSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeSummaryServiceServer).GetAnnualTradeSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TradeSummaryService_GetAnnualTradeSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeSummaryServiceServer).GetAnnualTradeSummary(ctx, req.(*GetAnnualTradeSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TradeSummaryService_ServiceDesc is the grpc.ServiceDesc for TradeSummaryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TradeSummaryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "features.TradeSummaryService",
+	HandlerType: (*TradeSummaryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAnnualTradeSummary",
+			Handler:    _TradeSummaryService_GetAnnualTradeSummary_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "features.proto",
+}
+
+const (
+	FeaturePortfolioService_GetUserPortfolio_FullMethodName = "/features.FeaturePortfolioService/GetUserPortfolio"
+)
+
+// FeaturePortfolioServiceClient is the client API for FeaturePortfolioService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FeaturePortfolioService serves the profile page's view of everything a user
+// owns in one call, instead of a call per feature for profits and requests
+type FeaturePortfolioServiceClient interface {
+	GetUserPortfolio(ctx context.Context, in *GetUserPortfolioRequest, opts ...grpc.CallOption) (*UserPortfolioResponse, error)
+}
+
+type featurePortfolioServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFeaturePortfolioServiceClient(cc grpc.ClientConnInterface) FeaturePortfolioServiceClient {
+	return &featurePortfolioServiceClient{cc}
+}
+
+func (c *featurePortfolioServiceClient) GetUserPortfolio(ctx context.Context, in *GetUserPortfolioRequest, opts ...grpc.CallOption) (*UserPortfolioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserPortfolioResponse)
+	err := c.cc.Invoke(ctx, FeaturePortfolioService_GetUserPortfolio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FeaturePortfolioServiceServer is the server API for FeaturePortfolioService service.
+// All implementations must embed UnimplementedFeaturePortfolioServiceServer
+// for forward compatibility.
+//
+// FeaturePortfolioService serves the profile page's view of everything a user
+// owns in one call, instead of a call per feature for profits and requests
+type FeaturePortfolioServiceServer interface {
+	GetUserPortfolio(context.Context, *GetUserPortfolioRequest) (*UserPortfolioResponse, error)
+	mustEmbedUnimplementedFeaturePortfolioServiceServer()
+}
+
+// UnimplementedFeaturePortfolioServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFeaturePortfolioServiceServer struct{}
+
+func (UnimplementedFeaturePortfolioServiceServer) GetUserPortfolio(context.Context, *GetUserPortfolioRequest) (*UserPortfolioResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserPortfolio not implemented")
+}
+func (UnimplementedFeaturePortfolioServiceServer) mustEmbedUnimplementedFeaturePortfolioServiceServer() {
+}
+func (UnimplementedFeaturePortfolioServiceServer) testEmbeddedByValue() {}
+
+// UnsafeFeaturePortfolioServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FeaturePortfolioServiceServer will
+// result in compilation errors.
+type UnsafeFeaturePortfolioServiceServer interface {
+	mustEmbedUnimplementedFeaturePortfolioServiceServer()
+}
+
+func RegisterFeaturePortfolioServiceServer(s grpc.ServiceRegistrar, srv FeaturePortfolioServiceServer) {
+	// If the following call panics, it indicates UnimplementedFeaturePortfolioServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FeaturePortfolioService_ServiceDesc, srv)
+}
+
+func _FeaturePortfolioService_GetUserPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeaturePortfolioServiceServer).GetUserPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeaturePortfolioService_GetUserPortfolio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeaturePortfolioServiceServer).GetUserPortfolio(ctx, req.(*GetUserPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FeaturePortfolioService_ServiceDesc is the grpc.ServiceDesc for FeaturePortfolioService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FeaturePortfolioService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "features.FeaturePortfolioService",
+	HandlerType: (*FeaturePortfolioServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUserPortfolio",
+			Handler:    _FeaturePortfolioService_GetUserPortfolio_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "features.proto",
+}
+
+const (
+	FeatureHistoryService_GetFeatureHistory_FullMethodName = "/features.FeatureHistoryService/GetFeatureHistory"
+)
+
+// FeatureHistoryServiceClient is the client API for FeatureHistoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FeatureHistoryService lets an owner audit everything that happened to their
+// feature: trades, sell and buy requests, builds and profit payouts
+type FeatureHistoryServiceClient interface {
+	GetFeatureHistory(ctx context.Context, in *GetFeatureHistoryRequest, opts ...grpc.CallOption) (*FeatureHistoryResponse, error)
+}
+
+type featureHistoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFeatureHistoryServiceClient(cc grpc.ClientConnInterface) FeatureHistoryServiceClient {
+	return &featureHistoryServiceClient{cc}
+}
+
+func (c *featureHistoryServiceClient) GetFeatureHistory(ctx context.Context, in *GetFeatureHistoryRequest, opts ...grpc.CallOption) (*FeatureHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureHistoryResponse)
+	err := c.cc.Invoke(ctx, FeatureHistoryService_GetFeatureHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FeatureHistoryServiceServer is the server API for FeatureHistoryService service.
+// All implementations must embed UnimplementedFeatureHistoryServiceServer
+// for forward compatibility.
+//
+// FeatureHistoryService lets an owner audit everything that happened to their
+// feature: trades, sell and buy requests, builds and profit payouts
+type FeatureHistoryServiceServer interface {
+	GetFeatureHistory(context.Context, *GetFeatureHistoryRequest) (*FeatureHistoryResponse, error)
+	mustEmbedUnimplementedFeatureHistoryServiceServer()
+}
+
+// UnimplementedFeatureHistoryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFeatureHistoryServiceServer struct{}
+
+func (UnimplementedFeatureHistoryServiceServer) GetFeatureHistory(context.Context, *GetFeatureHistoryRequest) (*FeatureHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFeatureHistory not implemented")
+}
+func (UnimplementedFeatureHistoryServiceServer) mustEmbedUnimplementedFeatureHistoryServiceServer() {}
+func (UnimplementedFeatureHistoryServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeFeatureHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FeatureHistoryServiceServer will
+// result in compilation errors.
+type UnsafeFeatureHistoryServiceServer interface {
+	mustEmbedUnimplementedFeatureHistoryServiceServer()
+}
+
+func RegisterFeatureHistoryServiceServer(s grpc.ServiceRegistrar, srv FeatureHistoryServiceServer) {
+	// If the following call panics, it indicates UnimplementedFeatureHistoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FeatureHistoryService_ServiceDesc, srv)
+}
+
+func _FeatureHistoryService_GetFeatureHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeatureHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureHistoryServiceServer).GetFeatureHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureHistoryService_GetFeatureHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureHistoryServiceServer).GetFeatureHistory(ctx, req.(*GetFeatureHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FeatureHistoryService_ServiceDesc is the grpc.ServiceDesc for FeatureHistoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FeatureHistoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "features.FeatureHistoryService",
+	HandlerType: (*FeatureHistoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFeatureHistory",
+			Handler:    _FeatureHistoryService_GetFeatureHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "features.proto",
+}
@@ -444,6 +444,8 @@ type CreateOrderRequest struct {
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Amount        int32                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // integer quantity of units
 	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`    // psc, irr, red, blue, yellow
+	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`          // Client IP, compared with the user's recent logins
+	Device        string                 `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`  // Client user agent, compared with the user's recent logins
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -499,6 +501,20 @@ func (x *CreateOrderRequest) GetAsset() string {
 	return ""
 }
 
+func (x *CreateOrderRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
 type CreateOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Link          string                 `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"` // Parsian payment URL
@@ -551,6 +567,7 @@ type HandleCallbackRequest struct {
 	Rrn              int64                  `protobuf:"varint,4,opt,name=rrn,proto3" json:"rrn,omitempty"`                                                                                                                            // Reference number
 	CardMaskPan      string                 `protobuf:"bytes,5,opt,name=card_mask_pan,json=cardMaskPan,proto3" json:"card_mask_pan,omitempty"`                                                                                        // Masked card number
 	AdditionalParams map[string]string      `protobuf:"bytes,6,rep,name=additional_params,json=additionalParams,proto3" json:"additional_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Other gateway fields
+	RawPayload       string                 `protobuf:"bytes,7,opt,name=raw_payload,json=rawPayload,proto3" json:"raw_payload,omitempty"`                                                                                             // Callback body exactly as received, kept for audit
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -627,6 +644,13 @@ func (x *HandleCallbackRequest) GetAdditionalParams() map[string]string {
 	return nil
 }
 
+func (x *HandleCallbackRequest) GetRawPayload() string {
+	if x != nil {
+		return x.RawPayload
+	}
+	return ""
+}
+
 type HandleCallbackResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RedirectUrl   string                 `protobuf:"bytes,1,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"` // Frontend verification URL with query params
@@ -811,20 +835,24 @@ const file_financial_proto_rawDesc = "" +
 	"\n" +
 	"unit_price\x18\x05 \x01(\x01R\tunitPrice\x12\x19\n" +
 	"\x05image\x18\x06 \x01(\tH\x00R\x05image\x88\x01\x01B\b\n" +
-	"\x06_image\"[\n" +
+	"\x06_image\"\x83\x01\n" +
 	"\x12CreateOrderRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\x05R\x06amount\x12\x14\n" +
-	"\x05asset\x18\x03 \x01(\tR\x05asset\")\n" +
+	"\x05asset\x18\x03 \x01(\tR\x05asset\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\x12\x16\n" +
+	"\x06device\x18\x05 \x01(\tR\x06device\")\n" +
 	"\x13CreateOrderResponse\x12\x12\n" +
-	"\x04link\x18\x01 \x01(\tR\x04link\"\xc0\x02\n" +
+	"\x04link\x18\x01 \x01(\tR\x04link\"\xe1\x02\n" +
 	"\x15HandleCallbackRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x04R\aorderId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x14\n" +
 	"\x05token\x18\x03 \x01(\x03R\x05token\x12\x10\n" +
 	"\x03rrn\x18\x04 \x01(\x03R\x03rrn\x12\"\n" +
 	"\rcard_mask_pan\x18\x05 \x01(\tR\vcardMaskPan\x12c\n" +
-	"\x11additional_params\x18\x06 \x03(\v26.financial.HandleCallbackRequest.AdditionalParamsEntryR\x10additionalParams\x1aC\n" +
+	"\x11additional_params\x18\x06 \x03(\v26.financial.HandleCallbackRequest.AdditionalParamsEntryR\x10additionalParams\x12\x1f\n" +
+	"\vraw_payload\x18\a \x01(\tR\n" +
+	"rawPayload\x1aC\n" +
 	"\x15AdditionalParamsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\";\n" +
@@ -134,6 +134,95 @@ func (x *UserLevelResponse) GetUserScore() int32 {
 	return 0
 }
 
+// GetUserScoresRequest reads the scores of many users at once, at most 1000
+type GetUserScoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []uint64               `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserScoresRequest) Reset() {
+	*x = GetUserScoresRequest{}
+	mi := &file_levels_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserScoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserScoresRequest) ProtoMessage() {}
+
+func (x *GetUserScoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_levels_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserScoresRequest.ProtoReflect.Descriptor instead.
+func (*GetUserScoresRequest) Descriptor() ([]byte, []int) {
+	return file_levels_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetUserScoresRequest) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type UserScoresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scores        map[uint64]int32       `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Users that do not exist are left out
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserScoresResponse) Reset() {
+	*x = UserScoresResponse{}
+	mi := &file_levels_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserScoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserScoresResponse) ProtoMessage() {}
+
+func (x *UserScoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_levels_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserScoresResponse.ProtoReflect.Descriptor instead.
+func (*UserScoresResponse) Descriptor() ([]byte, []int) {
+	return file_levels_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UserScoresResponse) GetScores() map[uint64]int32 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
 type GetAllLevelsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -142,7 +231,7 @@ type GetAllLevelsRequest struct {
 
 func (x *GetAllLevelsRequest) Reset() {
 	*x = GetAllLevelsRequest{}
-	mi := &file_levels_proto_msgTypes[2]
+	mi := &file_levels_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -154,7 +243,7 @@ func (x *GetAllLevelsRequest) String() string {
 func (*GetAllLevelsRequest) ProtoMessage() {}
 
 func (x *GetAllLevelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[2]
+	mi := &file_levels_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -167,7 +256,7 @@ func (x *GetAllLevelsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllLevelsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllLevelsRequest) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{2}
+	return file_levels_proto_rawDescGZIP(), []int{4}
 }
 
 type LevelsResponse struct {
@@ -179,7 +268,7 @@ type LevelsResponse struct {
 
 func (x *LevelsResponse) Reset() {
 	*x = LevelsResponse{}
-	mi := &file_levels_proto_msgTypes[3]
+	mi := &file_levels_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -191,7 +280,7 @@ func (x *LevelsResponse) String() string {
 func (*LevelsResponse) ProtoMessage() {}
 
 func (x *LevelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[3]
+	mi := &file_levels_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -204,7 +293,7 @@ func (x *LevelsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LevelsResponse.ProtoReflect.Descriptor instead.
 func (*LevelsResponse) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{3}
+	return file_levels_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *LevelsResponse) GetLevels() []*Level {
@@ -224,7 +313,7 @@ type GetLevelRequest struct {
 
 func (x *GetLevelRequest) Reset() {
 	*x = GetLevelRequest{}
-	mi := &file_levels_proto_msgTypes[4]
+	mi := &file_levels_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -236,7 +325,7 @@ func (x *GetLevelRequest) String() string {
 func (*GetLevelRequest) ProtoMessage() {}
 
 func (x *GetLevelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[4]
+	mi := &file_levels_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -249,7 +338,7 @@ func (x *GetLevelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLevelRequest.ProtoReflect.Descriptor instead.
 func (*GetLevelRequest) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{4}
+	return file_levels_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetLevelRequest) GetLevelId() uint64 {
@@ -275,7 +364,7 @@ type LevelResponse struct {
 
 func (x *LevelResponse) Reset() {
 	*x = LevelResponse{}
-	mi := &file_levels_proto_msgTypes[5]
+	mi := &file_levels_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -287,7 +376,7 @@ func (x *LevelResponse) String() string {
 func (*LevelResponse) ProtoMessage() {}
 
 func (x *LevelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[5]
+	mi := &file_levels_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,7 +389,7 @@ func (x *LevelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LevelResponse.ProtoReflect.Descriptor instead.
 func (*LevelResponse) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{5}
+	return file_levels_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *LevelResponse) GetLevel() *Level {
@@ -329,7 +418,7 @@ type Level struct {
 
 func (x *Level) Reset() {
 	*x = Level{}
-	mi := &file_levels_proto_msgTypes[6]
+	mi := &file_levels_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -341,7 +430,7 @@ func (x *Level) String() string {
 func (*Level) ProtoMessage() {}
 
 func (x *Level) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[6]
+	mi := &file_levels_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -354,7 +443,7 @@ func (x *Level) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Level.ProtoReflect.Descriptor instead.
 func (*Level) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{6}
+	return file_levels_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Level) GetId() uint64 {
@@ -461,7 +550,7 @@ type LevelGeneralInfo struct {
 
 func (x *LevelGeneralInfo) Reset() {
 	*x = LevelGeneralInfo{}
-	mi := &file_levels_proto_msgTypes[7]
+	mi := &file_levels_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -473,7 +562,7 @@ func (x *LevelGeneralInfo) String() string {
 func (*LevelGeneralInfo) ProtoMessage() {}
 
 func (x *LevelGeneralInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[7]
+	mi := &file_levels_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -486,7 +575,7 @@ func (x *LevelGeneralInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LevelGeneralInfo.ProtoReflect.Descriptor instead.
 func (*LevelGeneralInfo) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{7}
+	return file_levels_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *LevelGeneralInfo) GetId() uint64 {
@@ -639,7 +728,7 @@ type LevelPrize struct {
 
 func (x *LevelPrize) Reset() {
 	*x = LevelPrize{}
-	mi := &file_levels_proto_msgTypes[8]
+	mi := &file_levels_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -651,7 +740,7 @@ func (x *LevelPrize) String() string {
 func (*LevelPrize) ProtoMessage() {}
 
 func (x *LevelPrize) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[8]
+	mi := &file_levels_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -664,7 +753,7 @@ func (x *LevelPrize) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LevelPrize.ProtoReflect.Descriptor instead.
 func (*LevelPrize) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{8}
+	return file_levels_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *LevelPrize) GetId() uint64 {
@@ -752,7 +841,7 @@ type LevelGem struct {
 
 func (x *LevelGem) Reset() {
 	*x = LevelGem{}
-	mi := &file_levels_proto_msgTypes[9]
+	mi := &file_levels_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -764,7 +853,7 @@ func (x *LevelGem) String() string {
 func (*LevelGem) ProtoMessage() {}
 
 func (x *LevelGem) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[9]
+	mi := &file_levels_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -777,7 +866,7 @@ func (x *LevelGem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LevelGem.ProtoReflect.Descriptor instead.
 func (*LevelGem) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{9}
+	return file_levels_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *LevelGem) GetId() uint64 {
@@ -909,7 +998,7 @@ type LevelGift struct {
 
 func (x *LevelGift) Reset() {
 	*x = LevelGift{}
-	mi := &file_levels_proto_msgTypes[10]
+	mi := &file_levels_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -921,7 +1010,7 @@ func (x *LevelGift) String() string {
 func (*LevelGift) ProtoMessage() {}
 
 func (x *LevelGift) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[10]
+	mi := &file_levels_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,7 +1023,7 @@ func (x *LevelGift) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LevelGift.ProtoReflect.Descriptor instead.
 func (*LevelGift) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{10}
+	return file_levels_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *LevelGift) GetId() uint64 {
@@ -1125,7 +1214,7 @@ type LevelLicense struct {
 
 func (x *LevelLicense) Reset() {
 	*x = LevelLicense{}
-	mi := &file_levels_proto_msgTypes[11]
+	mi := &file_levels_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1137,7 +1226,7 @@ func (x *LevelLicense) String() string {
 func (*LevelLicense) ProtoMessage() {}
 
 func (x *LevelLicense) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[11]
+	mi := &file_levels_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1150,7 +1239,7 @@ func (x *LevelLicense) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LevelLicense.ProtoReflect.Descriptor instead.
 func (*LevelLicense) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{11}
+	return file_levels_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *LevelLicense) GetId() uint64 {
@@ -1296,7 +1385,7 @@ type GetLevelGeneralInfoRequest struct {
 
 func (x *GetLevelGeneralInfoRequest) Reset() {
 	*x = GetLevelGeneralInfoRequest{}
-	mi := &file_levels_proto_msgTypes[12]
+	mi := &file_levels_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1308,7 +1397,7 @@ func (x *GetLevelGeneralInfoRequest) String() string {
 func (*GetLevelGeneralInfoRequest) ProtoMessage() {}
 
 func (x *GetLevelGeneralInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[12]
+	mi := &file_levels_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,7 +1410,7 @@ func (x *GetLevelGeneralInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLevelGeneralInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetLevelGeneralInfoRequest) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{12}
+	return file_levels_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetLevelGeneralInfoRequest) GetLevelId() uint64 {
@@ -1347,7 +1436,7 @@ type LevelGeneralInfoResponse struct {
 
 func (x *LevelGeneralInfoResponse) Reset() {
 	*x = LevelGeneralInfoResponse{}
-	mi := &file_levels_proto_msgTypes[13]
+	mi := &file_levels_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1359,7 +1448,7 @@ func (x *LevelGeneralInfoResponse) String() string {
 func (*LevelGeneralInfoResponse) ProtoMessage() {}
 
 func (x *LevelGeneralInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[13]
+	mi := &file_levels_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1372,7 +1461,7 @@ func (x *LevelGeneralInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LevelGeneralInfoResponse.ProtoReflect.Descriptor instead.
 func (*LevelGeneralInfoResponse) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{13}
+	return file_levels_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *LevelGeneralInfoResponse) GetGeneralInfo() *LevelGeneralInfo {
@@ -1392,7 +1481,7 @@ type GetLevelGemRequest struct {
 
 func (x *GetLevelGemRequest) Reset() {
 	*x = GetLevelGemRequest{}
-	mi := &file_levels_proto_msgTypes[14]
+	mi := &file_levels_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1404,7 +1493,7 @@ func (x *GetLevelGemRequest) String() string {
 func (*GetLevelGemRequest) ProtoMessage() {}
 
 func (x *GetLevelGemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[14]
+	mi := &file_levels_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1417,7 +1506,7 @@ func (x *GetLevelGemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLevelGemRequest.ProtoReflect.Descriptor instead.
 func (*GetLevelGemRequest) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{14}
+	return file_levels_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetLevelGemRequest) GetLevelId() uint64 {
@@ -1443,7 +1532,7 @@ type LevelGemResponse struct {
 
 func (x *LevelGemResponse) Reset() {
 	*x = LevelGemResponse{}
-	mi := &file_levels_proto_msgTypes[15]
+	mi := &file_levels_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1455,7 +1544,7 @@ func (x *LevelGemResponse) String() string {
 func (*LevelGemResponse) ProtoMessage() {}
 
 func (x *LevelGemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[15]
+	mi := &file_levels_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1468,7 +1557,7 @@ func (x *LevelGemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LevelGemResponse.ProtoReflect.Descriptor instead.
 func (*LevelGemResponse) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{15}
+	return file_levels_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *LevelGemResponse) GetGem() *LevelGem {
@@ -1488,7 +1577,7 @@ type GetLevelGiftRequest struct {
 
 func (x *GetLevelGiftRequest) Reset() {
 	*x = GetLevelGiftRequest{}
-	mi := &file_levels_proto_msgTypes[16]
+	mi := &file_levels_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1500,7 +1589,7 @@ func (x *GetLevelGiftRequest) String() string {
 func (*GetLevelGiftRequest) ProtoMessage() {}
 
 func (x *GetLevelGiftRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[16]
+	mi := &file_levels_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1513,7 +1602,7 @@ func (x *GetLevelGiftRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLevelGiftRequest.ProtoReflect.Descriptor instead.
 func (*GetLevelGiftRequest) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{16}
+	return file_levels_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetLevelGiftRequest) GetLevelId() uint64 {
@@ -1539,7 +1628,7 @@ type LevelGiftResponse struct {
 
 func (x *LevelGiftResponse) Reset() {
 	*x = LevelGiftResponse{}
-	mi := &file_levels_proto_msgTypes[17]
+	mi := &file_levels_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1551,7 +1640,7 @@ func (x *LevelGiftResponse) String() string {
 func (*LevelGiftResponse) ProtoMessage() {}
 
 func (x *LevelGiftResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[17]
+	mi := &file_levels_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1564,7 +1653,7 @@ func (x *LevelGiftResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LevelGiftResponse.ProtoReflect.Descriptor instead.
 func (*LevelGiftResponse) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{17}
+	return file_levels_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *LevelGiftResponse) GetGift() *LevelGift {
@@ -1584,7 +1673,7 @@ type GetLevelLicensesRequest struct {
 
 func (x *GetLevelLicensesRequest) Reset() {
 	*x = GetLevelLicensesRequest{}
-	mi := &file_levels_proto_msgTypes[18]
+	mi := &file_levels_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1596,7 +1685,7 @@ func (x *GetLevelLicensesRequest) String() string {
 func (*GetLevelLicensesRequest) ProtoMessage() {}
 
 func (x *GetLevelLicensesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[18]
+	mi := &file_levels_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1609,7 +1698,7 @@ func (x *GetLevelLicensesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLevelLicensesRequest.ProtoReflect.Descriptor instead.
 func (*GetLevelLicensesRequest) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{18}
+	return file_levels_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetLevelLicensesRequest) GetLevelId() uint64 {
@@ -1635,7 +1724,7 @@ type LevelLicensesResponse struct {
 
 func (x *LevelLicensesResponse) Reset() {
 	*x = LevelLicensesResponse{}
-	mi := &file_levels_proto_msgTypes[19]
+	mi := &file_levels_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1647,7 +1736,7 @@ func (x *LevelLicensesResponse) String() string {
 func (*LevelLicensesResponse) ProtoMessage() {}
 
 func (x *LevelLicensesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[19]
+	mi := &file_levels_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1660,7 +1749,7 @@ func (x *LevelLicensesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LevelLicensesResponse.ProtoReflect.Descriptor instead.
 func (*LevelLicensesResponse) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{19}
+	return file_levels_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *LevelLicensesResponse) GetLicenses() *LevelLicense {
@@ -1680,7 +1769,7 @@ type GetLevelPrizesRequest struct {
 
 func (x *GetLevelPrizesRequest) Reset() {
 	*x = GetLevelPrizesRequest{}
-	mi := &file_levels_proto_msgTypes[20]
+	mi := &file_levels_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1692,7 +1781,7 @@ func (x *GetLevelPrizesRequest) String() string {
 func (*GetLevelPrizesRequest) ProtoMessage() {}
 
 func (x *GetLevelPrizesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[20]
+	mi := &file_levels_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1705,7 +1794,7 @@ func (x *GetLevelPrizesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLevelPrizesRequest.ProtoReflect.Descriptor instead.
 func (*GetLevelPrizesRequest) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{20}
+	return file_levels_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetLevelPrizesRequest) GetLevelId() uint64 {
@@ -1731,7 +1820,7 @@ type LevelPrizesResponse struct {
 
 func (x *LevelPrizesResponse) Reset() {
 	*x = LevelPrizesResponse{}
-	mi := &file_levels_proto_msgTypes[21]
+	mi := &file_levels_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1743,7 +1832,7 @@ func (x *LevelPrizesResponse) String() string {
 func (*LevelPrizesResponse) ProtoMessage() {}
 
 func (x *LevelPrizesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[21]
+	mi := &file_levels_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1756,7 +1845,7 @@ func (x *LevelPrizesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LevelPrizesResponse.ProtoReflect.Descriptor instead.
 func (*LevelPrizesResponse) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{21}
+	return file_levels_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *LevelPrizesResponse) GetPrize() *LevelPrize {
@@ -1776,7 +1865,7 @@ type ClaimPrizeRequest struct {
 
 func (x *ClaimPrizeRequest) Reset() {
 	*x = ClaimPrizeRequest{}
-	mi := &file_levels_proto_msgTypes[22]
+	mi := &file_levels_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1788,7 +1877,7 @@ func (x *ClaimPrizeRequest) String() string {
 func (*ClaimPrizeRequest) ProtoMessage() {}
 
 func (x *ClaimPrizeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[22]
+	mi := &file_levels_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1801,7 +1890,7 @@ func (x *ClaimPrizeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClaimPrizeRequest.ProtoReflect.Descriptor instead.
 func (*ClaimPrizeRequest) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{22}
+	return file_levels_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ClaimPrizeRequest) GetUserId() uint64 {
@@ -1828,7 +1917,7 @@ type ClaimPrizeResponse struct {
 
 func (x *ClaimPrizeResponse) Reset() {
 	*x = ClaimPrizeResponse{}
-	mi := &file_levels_proto_msgTypes[23]
+	mi := &file_levels_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1840,7 +1929,7 @@ func (x *ClaimPrizeResponse) String() string {
 func (*ClaimPrizeResponse) ProtoMessage() {}
 
 func (x *ClaimPrizeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[23]
+	mi := &file_levels_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1853,7 +1942,7 @@ func (x *ClaimPrizeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClaimPrizeResponse.ProtoReflect.Descriptor instead.
 func (*ClaimPrizeResponse) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{23}
+	return file_levels_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ClaimPrizeResponse) GetSuccess() bool {
@@ -1882,7 +1971,7 @@ type LogActivityRequest struct {
 
 func (x *LogActivityRequest) Reset() {
 	*x = LogActivityRequest{}
-	mi := &file_levels_proto_msgTypes[24]
+	mi := &file_levels_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1894,7 +1983,7 @@ func (x *LogActivityRequest) String() string {
 func (*LogActivityRequest) ProtoMessage() {}
 
 func (x *LogActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[24]
+	mi := &file_levels_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1907,7 +1996,7 @@ func (x *LogActivityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogActivityRequest.ProtoReflect.Descriptor instead.
 func (*LogActivityRequest) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{24}
+	return file_levels_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *LogActivityRequest) GetUserId() uint64 {
@@ -1948,7 +2037,7 @@ type LogActivityResponse struct {
 
 func (x *LogActivityResponse) Reset() {
 	*x = LogActivityResponse{}
-	mi := &file_levels_proto_msgTypes[25]
+	mi := &file_levels_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1960,7 +2049,7 @@ func (x *LogActivityResponse) String() string {
 func (*LogActivityResponse) ProtoMessage() {}
 
 func (x *LogActivityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[25]
+	mi := &file_levels_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1973,7 +2062,7 @@ func (x *LogActivityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogActivityResponse.ProtoReflect.Descriptor instead.
 func (*LogActivityResponse) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{25}
+	return file_levels_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *LogActivityResponse) GetSuccess() bool {
@@ -2000,7 +2089,7 @@ type GetUserActivitiesRequest struct {
 
 func (x *GetUserActivitiesRequest) Reset() {
 	*x = GetUserActivitiesRequest{}
-	mi := &file_levels_proto_msgTypes[26]
+	mi := &file_levels_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2012,7 +2101,7 @@ func (x *GetUserActivitiesRequest) String() string {
 func (*GetUserActivitiesRequest) ProtoMessage() {}
 
 func (x *GetUserActivitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[26]
+	mi := &file_levels_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2025,7 +2114,7 @@ func (x *GetUserActivitiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserActivitiesRequest.ProtoReflect.Descriptor instead.
 func (*GetUserActivitiesRequest) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{26}
+	return file_levels_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetUserActivitiesRequest) GetUserId() uint64 {
@@ -2052,7 +2141,7 @@ type UserActivitiesResponse struct {
 
 func (x *UserActivitiesResponse) Reset() {
 	*x = UserActivitiesResponse{}
-	mi := &file_levels_proto_msgTypes[27]
+	mi := &file_levels_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2064,7 +2153,7 @@ func (x *UserActivitiesResponse) String() string {
 func (*UserActivitiesResponse) ProtoMessage() {}
 
 func (x *UserActivitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[27]
+	mi := &file_levels_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2077,7 +2166,7 @@ func (x *UserActivitiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserActivitiesResponse.ProtoReflect.Descriptor instead.
 func (*UserActivitiesResponse) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{27}
+	return file_levels_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *UserActivitiesResponse) GetActivities() []*UserActivity {
@@ -2108,7 +2197,7 @@ type UserActivity struct {
 
 func (x *UserActivity) Reset() {
 	*x = UserActivity{}
-	mi := &file_levels_proto_msgTypes[28]
+	mi := &file_levels_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2120,7 +2209,7 @@ func (x *UserActivity) String() string {
 func (*UserActivity) ProtoMessage() {}
 
 func (x *UserActivity) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[28]
+	mi := &file_levels_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2133,7 +2222,7 @@ func (x *UserActivity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserActivity.ProtoReflect.Descriptor instead.
 func (*UserActivity) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{28}
+	return file_levels_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *UserActivity) GetId() uint64 {
@@ -2193,7 +2282,7 @@ type UserLog struct {
 
 func (x *UserLog) Reset() {
 	*x = UserLog{}
-	mi := &file_levels_proto_msgTypes[29]
+	mi := &file_levels_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2205,7 +2294,7 @@ func (x *UserLog) String() string {
 func (*UserLog) ProtoMessage() {}
 
 func (x *UserLog) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[29]
+	mi := &file_levels_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2218,7 +2307,7 @@ func (x *UserLog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserLog.ProtoReflect.Descriptor instead.
 func (*UserLog) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{29}
+	return file_levels_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UserLog) GetId() uint64 {
@@ -2279,7 +2368,7 @@ type UpdateActivityScoreRequest struct {
 
 func (x *UpdateActivityScoreRequest) Reset() {
 	*x = UpdateActivityScoreRequest{}
-	mi := &file_levels_proto_msgTypes[30]
+	mi := &file_levels_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2291,7 +2380,7 @@ func (x *UpdateActivityScoreRequest) String() string {
 func (*UpdateActivityScoreRequest) ProtoMessage() {}
 
 func (x *UpdateActivityScoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[30]
+	mi := &file_levels_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2304,7 +2393,7 @@ func (x *UpdateActivityScoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateActivityScoreRequest.ProtoReflect.Descriptor instead.
 func (*UpdateActivityScoreRequest) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{30}
+	return file_levels_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *UpdateActivityScoreRequest) GetUserId() uint64 {
@@ -2326,7 +2415,7 @@ type UpdateActivityScoreResponse struct {
 
 func (x *UpdateActivityScoreResponse) Reset() {
 	*x = UpdateActivityScoreResponse{}
-	mi := &file_levels_proto_msgTypes[31]
+	mi := &file_levels_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2338,7 +2427,7 @@ func (x *UpdateActivityScoreResponse) String() string {
 func (*UpdateActivityScoreResponse) ProtoMessage() {}
 
 func (x *UpdateActivityScoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[31]
+	mi := &file_levels_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2351,7 +2440,7 @@ func (x *UpdateActivityScoreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateActivityScoreResponse.ProtoReflect.Descriptor instead.
 func (*UpdateActivityScoreResponse) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{31}
+	return file_levels_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *UpdateActivityScoreResponse) GetSuccess() bool {
@@ -2393,7 +2482,7 @@ type RecordTradeRequest struct {
 
 func (x *RecordTradeRequest) Reset() {
 	*x = RecordTradeRequest{}
-	mi := &file_levels_proto_msgTypes[32]
+	mi := &file_levels_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2405,7 +2494,7 @@ func (x *RecordTradeRequest) String() string {
 func (*RecordTradeRequest) ProtoMessage() {}
 
 func (x *RecordTradeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[32]
+	mi := &file_levels_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2418,7 +2507,7 @@ func (x *RecordTradeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordTradeRequest.ProtoReflect.Descriptor instead.
 func (*RecordTradeRequest) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{32}
+	return file_levels_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *RecordTradeRequest) GetUserId() uint64 {
@@ -2451,7 +2540,7 @@ type RecordTradeResponse struct {
 
 func (x *RecordTradeResponse) Reset() {
 	*x = RecordTradeResponse{}
-	mi := &file_levels_proto_msgTypes[33]
+	mi := &file_levels_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2463,7 +2552,7 @@ func (x *RecordTradeResponse) String() string {
 func (*RecordTradeResponse) ProtoMessage() {}
 
 func (x *RecordTradeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[33]
+	mi := &file_levels_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2476,7 +2565,7 @@ func (x *RecordTradeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordTradeResponse.ProtoReflect.Descriptor instead.
 func (*RecordTradeResponse) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{33}
+	return file_levels_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *RecordTradeResponse) GetSuccess() bool {
@@ -2496,7 +2585,7 @@ type RecordDepositRequest struct {
 
 func (x *RecordDepositRequest) Reset() {
 	*x = RecordDepositRequest{}
-	mi := &file_levels_proto_msgTypes[34]
+	mi := &file_levels_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2508,7 +2597,7 @@ func (x *RecordDepositRequest) String() string {
 func (*RecordDepositRequest) ProtoMessage() {}
 
 func (x *RecordDepositRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[34]
+	mi := &file_levels_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2521,7 +2610,7 @@ func (x *RecordDepositRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordDepositRequest.ProtoReflect.Descriptor instead.
 func (*RecordDepositRequest) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{34}
+	return file_levels_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *RecordDepositRequest) GetUserId() uint64 {
@@ -2547,7 +2636,7 @@ type RecordDepositResponse struct {
 
 func (x *RecordDepositResponse) Reset() {
 	*x = RecordDepositResponse{}
-	mi := &file_levels_proto_msgTypes[35]
+	mi := &file_levels_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2559,7 +2648,7 @@ func (x *RecordDepositResponse) String() string {
 func (*RecordDepositResponse) ProtoMessage() {}
 
 func (x *RecordDepositResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[35]
+	mi := &file_levels_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2572,7 +2661,7 @@ func (x *RecordDepositResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordDepositResponse.ProtoReflect.Descriptor instead.
 func (*RecordDepositResponse) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{35}
+	return file_levels_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *RecordDepositResponse) GetSuccess() bool {
@@ -2591,7 +2680,7 @@ type RecordFollowerRequest struct {
 
 func (x *RecordFollowerRequest) Reset() {
 	*x = RecordFollowerRequest{}
-	mi := &file_levels_proto_msgTypes[36]
+	mi := &file_levels_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2603,7 +2692,7 @@ func (x *RecordFollowerRequest) String() string {
 func (*RecordFollowerRequest) ProtoMessage() {}
 
 func (x *RecordFollowerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[36]
+	mi := &file_levels_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2616,7 +2705,7 @@ func (x *RecordFollowerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordFollowerRequest.ProtoReflect.Descriptor instead.
 func (*RecordFollowerRequest) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{36}
+	return file_levels_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *RecordFollowerRequest) GetUserId() uint64 {
@@ -2635,7 +2724,7 @@ type RecordFollowerResponse struct {
 
 func (x *RecordFollowerResponse) Reset() {
 	*x = RecordFollowerResponse{}
-	mi := &file_levels_proto_msgTypes[37]
+	mi := &file_levels_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2647,7 +2736,7 @@ func (x *RecordFollowerResponse) String() string {
 func (*RecordFollowerResponse) ProtoMessage() {}
 
 func (x *RecordFollowerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[37]
+	mi := &file_levels_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2660,7 +2749,7 @@ func (x *RecordFollowerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordFollowerResponse.ProtoReflect.Descriptor instead.
 func (*RecordFollowerResponse) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{37}
+	return file_levels_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *RecordFollowerResponse) GetSuccess() bool {
@@ -2670,6 +2759,129 @@ func (x *RecordFollowerResponse) GetSuccess() bool {
 	return false
 }
 
+type HeartbeatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ip            string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeartbeatRequest) Reset() {
+	*x = HeartbeatRequest{}
+	mi := &file_levels_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatRequest) ProtoMessage() {}
+
+func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_levels_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_levels_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *HeartbeatRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *HeartbeatRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type HeartbeatResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// accepted is false when the heartbeat came too soon after the previous one
+	Accepted   bool   `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	ActivityId uint64 `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	// session_minutes is the length of the current session so far
+	SessionMinutes int32 `protobuf:"varint,3,opt,name=session_minutes,json=sessionMinutes,proto3" json:"session_minutes,omitempty"`
+	// next_heartbeat_seconds is when the client should send the next heartbeat
+	NextHeartbeatSeconds int32 `protobuf:"varint,4,opt,name=next_heartbeat_seconds,json=nextHeartbeatSeconds,proto3" json:"next_heartbeat_seconds,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *HeartbeatResponse) Reset() {
+	*x = HeartbeatResponse{}
+	mi := &file_levels_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatResponse) ProtoMessage() {}
+
+func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_levels_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_levels_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *HeartbeatResponse) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *HeartbeatResponse) GetActivityId() uint64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *HeartbeatResponse) GetSessionMinutes() int32 {
+	if x != nil {
+		return x.SessionMinutes
+	}
+	return 0
+}
+
+func (x *HeartbeatResponse) GetNextHeartbeatSeconds() int32 {
+	if x != nil {
+		return x.NextHeartbeatSeconds
+	}
+	return 0
+}
+
 type GetQuestionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -2679,7 +2891,7 @@ type GetQuestionRequest struct {
 
 func (x *GetQuestionRequest) Reset() {
 	*x = GetQuestionRequest{}
-	mi := &file_levels_proto_msgTypes[38]
+	mi := &file_levels_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2691,7 +2903,7 @@ func (x *GetQuestionRequest) String() string {
 func (*GetQuestionRequest) ProtoMessage() {}
 
 func (x *GetQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[38]
+	mi := &file_levels_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2704,7 +2916,7 @@ func (x *GetQuestionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetQuestionRequest.ProtoReflect.Descriptor instead.
 func (*GetQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{38}
+	return file_levels_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *GetQuestionRequest) GetUserId() uint64 {
@@ -2724,7 +2936,7 @@ type QuestionResponse struct {
 
 func (x *QuestionResponse) Reset() {
 	*x = QuestionResponse{}
-	mi := &file_levels_proto_msgTypes[39]
+	mi := &file_levels_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2736,7 +2948,7 @@ func (x *QuestionResponse) String() string {
 func (*QuestionResponse) ProtoMessage() {}
 
 func (x *QuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[39]
+	mi := &file_levels_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2749,7 +2961,7 @@ func (x *QuestionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuestionResponse.ProtoReflect.Descriptor instead.
 func (*QuestionResponse) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{39}
+	return file_levels_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *QuestionResponse) GetQuestion() *Question {
@@ -2780,7 +2992,7 @@ type Question struct {
 
 func (x *Question) Reset() {
 	*x = Question{}
-	mi := &file_levels_proto_msgTypes[40]
+	mi := &file_levels_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2792,7 +3004,7 @@ func (x *Question) String() string {
 func (*Question) ProtoMessage() {}
 
 func (x *Question) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[40]
+	mi := &file_levels_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2805,7 +3017,7 @@ func (x *Question) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Question.ProtoReflect.Descriptor instead.
 func (*Question) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{40}
+	return file_levels_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *Question) GetId() uint64 {
@@ -2861,7 +3073,7 @@ type Answer struct {
 
 func (x *Answer) Reset() {
 	*x = Answer{}
-	mi := &file_levels_proto_msgTypes[41]
+	mi := &file_levels_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2873,7 +3085,7 @@ func (x *Answer) String() string {
 func (*Answer) ProtoMessage() {}
 
 func (x *Answer) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[41]
+	mi := &file_levels_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2886,7 +3098,7 @@ func (x *Answer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Answer.ProtoReflect.Descriptor instead.
 func (*Answer) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{41}
+	return file_levels_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *Answer) GetId() uint64 {
@@ -2921,7 +3133,7 @@ type SubmitAnswerRequest struct {
 
 func (x *SubmitAnswerRequest) Reset() {
 	*x = SubmitAnswerRequest{}
-	mi := &file_levels_proto_msgTypes[42]
+	mi := &file_levels_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2933,7 +3145,7 @@ func (x *SubmitAnswerRequest) String() string {
 func (*SubmitAnswerRequest) ProtoMessage() {}
 
 func (x *SubmitAnswerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[42]
+	mi := &file_levels_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2946,7 +3158,7 @@ func (x *SubmitAnswerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitAnswerRequest.ProtoReflect.Descriptor instead.
 func (*SubmitAnswerRequest) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{42}
+	return file_levels_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *SubmitAnswerRequest) GetUserId() uint64 {
@@ -2981,7 +3193,7 @@ type AnswerResultResponse struct {
 
 func (x *AnswerResultResponse) Reset() {
 	*x = AnswerResultResponse{}
-	mi := &file_levels_proto_msgTypes[43]
+	mi := &file_levels_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2993,7 +3205,7 @@ func (x *AnswerResultResponse) String() string {
 func (*AnswerResultResponse) ProtoMessage() {}
 
 func (x *AnswerResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[43]
+	mi := &file_levels_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3006,7 +3218,7 @@ func (x *AnswerResultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnswerResultResponse.ProtoReflect.Descriptor instead.
 func (*AnswerResultResponse) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{43}
+	return file_levels_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *AnswerResultResponse) GetIsCorrect() bool {
@@ -3039,7 +3251,7 @@ type GetTimingsRequest struct {
 
 func (x *GetTimingsRequest) Reset() {
 	*x = GetTimingsRequest{}
-	mi := &file_levels_proto_msgTypes[44]
+	mi := &file_levels_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3051,7 +3263,7 @@ func (x *GetTimingsRequest) String() string {
 func (*GetTimingsRequest) ProtoMessage() {}
 
 func (x *GetTimingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[44]
+	mi := &file_levels_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3064,7 +3276,7 @@ func (x *GetTimingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTimingsRequest.ProtoReflect.Descriptor instead.
 func (*GetTimingsRequest) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{44}
+	return file_levels_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetTimingsRequest) GetUserId() uint64 {
@@ -3088,7 +3300,7 @@ type TimingsResponse struct {
 
 func (x *TimingsResponse) Reset() {
 	*x = TimingsResponse{}
-	mi := &file_levels_proto_msgTypes[45]
+	mi := &file_levels_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3100,7 +3312,7 @@ func (x *TimingsResponse) String() string {
 func (*TimingsResponse) ProtoMessage() {}
 
 func (x *TimingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_levels_proto_msgTypes[45]
+	mi := &file_levels_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3113,7 +3325,7 @@ func (x *TimingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimingsResponse.ProtoReflect.Descriptor instead.
 func (*TimingsResponse) Descriptor() ([]byte, []int) {
-	return file_levels_proto_rawDescGZIP(), []int{45}
+	return file_levels_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *TimingsResponse) GetDisplayAdInterval() int32 {
@@ -3158,6 +3370,271 @@ func (x *TimingsResponse) GetWrongAnswers() int32 {
 	return 0
 }
 
+// Onboarding Messages
+type GetOnboardingStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnboardingStateRequest) Reset() {
+	*x = GetOnboardingStateRequest{}
+	mi := &file_levels_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnboardingStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnboardingStateRequest) ProtoMessage() {}
+
+func (x *GetOnboardingStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_levels_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnboardingStateRequest.ProtoReflect.Descriptor instead.
+func (*GetOnboardingStateRequest) Descriptor() ([]byte, []int) {
+	return file_levels_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetOnboardingStateRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type OnboardingStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`     // verify_phone, complete_kyc, buy_first_land, build_first_building, join_dynasty
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"` // Persian title
+	Completed     bool                   `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	CompletedAt   int64                  `protobuf:"varint,4,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"` // unix seconds, 0 until completed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnboardingStep) Reset() {
+	*x = OnboardingStep{}
+	mi := &file_levels_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnboardingStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnboardingStep) ProtoMessage() {}
+
+func (x *OnboardingStep) ProtoReflect() protoreflect.Message {
+	mi := &file_levels_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnboardingStep.ProtoReflect.Descriptor instead.
+func (*OnboardingStep) Descriptor() ([]byte, []int) {
+	return file_levels_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *OnboardingStep) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *OnboardingStep) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *OnboardingStep) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *OnboardingStep) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+type OnboardingReward struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asset         string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"` // wallet asset, e.g. psc
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Granted       bool                   `protobuf:"varint,3,opt,name=granted,proto3" json:"granted,omitempty"`
+	GrantedAt     int64                  `protobuf:"varint,4,opt,name=granted_at,json=grantedAt,proto3" json:"granted_at,omitempty"` // unix seconds, 0 until granted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnboardingReward) Reset() {
+	*x = OnboardingReward{}
+	mi := &file_levels_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnboardingReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnboardingReward) ProtoMessage() {}
+
+func (x *OnboardingReward) ProtoReflect() protoreflect.Message {
+	mi := &file_levels_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnboardingReward.ProtoReflect.Descriptor instead.
+func (*OnboardingReward) Descriptor() ([]byte, []int) {
+	return file_levels_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *OnboardingReward) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *OnboardingReward) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *OnboardingReward) GetGranted() bool {
+	if x != nil {
+		return x.Granted
+	}
+	return false
+}
+
+func (x *OnboardingReward) GetGrantedAt() int64 {
+	if x != nil {
+		return x.GrantedAt
+	}
+	return 0
+}
+
+type OnboardingStateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Steps          []*OnboardingStep      `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"` // in checklist order
+	CompletedSteps int32                  `protobuf:"varint,3,opt,name=completed_steps,json=completedSteps,proto3" json:"completed_steps,omitempty"`
+	TotalSteps     int32                  `protobuf:"varint,4,opt,name=total_steps,json=totalSteps,proto3" json:"total_steps,omitempty"`
+	Completed      bool                   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	Reward         *OnboardingReward      `protobuf:"bytes,6,opt,name=reward,proto3" json:"reward,omitempty"` // unset when no completion reward is configured
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OnboardingStateResponse) Reset() {
+	*x = OnboardingStateResponse{}
+	mi := &file_levels_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnboardingStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnboardingStateResponse) ProtoMessage() {}
+
+func (x *OnboardingStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_levels_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnboardingStateResponse.ProtoReflect.Descriptor instead.
+func (*OnboardingStateResponse) Descriptor() ([]byte, []int) {
+	return file_levels_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *OnboardingStateResponse) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *OnboardingStateResponse) GetSteps() []*OnboardingStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *OnboardingStateResponse) GetCompletedSteps() int32 {
+	if x != nil {
+		return x.CompletedSteps
+	}
+	return 0
+}
+
+func (x *OnboardingStateResponse) GetTotalSteps() int32 {
+	if x != nil {
+		return x.TotalSteps
+	}
+	return 0
+}
+
+func (x *OnboardingStateResponse) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *OnboardingStateResponse) GetReward() *OnboardingReward {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
 var File_levels_proto protoreflect.FileDescriptor
 
 const file_levels_proto_rawDesc = "" +
@@ -3170,7 +3647,14 @@ const file_levels_proto_rawDesc = "" +
 	"\x0fprevious_levels\x18\x02 \x03(\v2\r.levels.LevelR\x0epreviousLevels\x12B\n" +
 	"\x1escore_percentage_to_next_level\x18\x03 \x01(\x05R\x1ascorePercentageToNextLevel\x12\x1d\n" +
 	"\n" +
-	"user_score\x18\x04 \x01(\x05R\tuserScore\"\x15\n" +
+	"user_score\x18\x04 \x01(\x05R\tuserScore\"1\n" +
+	"\x14GetUserScoresRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x04R\auserIds\"\x8f\x01\n" +
+	"\x12UserScoresResponse\x12>\n" +
+	"\x06scores\x18\x01 \x03(\v2&.levels.UserScoresResponse.ScoresEntryR\x06scores\x1a9\n" +
+	"\vScoresEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x04R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x15\n" +
 	"\x13GetAllLevelsRequest\"7\n" +
 	"\x0eLevelsResponse\x12%\n" +
 	"\x06levels\x18\x01 \x03(\v2\r.levels.LevelR\x06levels\"K\n" +
@@ -3389,7 +3873,16 @@ const file_levels_proto_rawDesc = "" +
 	"\x15RecordFollowerRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\"2\n" +
 	"\x16RecordFollowerResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"-\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\";\n" +
+	"\x10HeartbeatRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x0e\n" +
+	"\x02ip\x18\x02 \x01(\tR\x02ip\"\xaf\x01\n" +
+	"\x11HeartbeatResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\bR\baccepted\x12\x1f\n" +
+	"\vactivity_id\x18\x02 \x01(\x04R\n" +
+	"activityId\x12'\n" +
+	"\x0fsession_minutes\x18\x03 \x01(\x05R\x0esessionMinutes\x124\n" +
+	"\x16next_heartbeat_seconds\x18\x04 \x01(\x05R\x14nextHeartbeatSeconds\"-\n" +
 	"\x12GetQuestionRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\"c\n" +
 	"\x10QuestionResponse\x12,\n" +
@@ -3425,7 +3918,28 @@ const file_levels_proto_rawDesc = "" +
 	"\x17display_answer_interval\x18\x03 \x01(\x05R\x15displayAnswerInterval\x12\"\n" +
 	"\fparticipants\x18\x04 \x01(\x05R\fparticipants\x12'\n" +
 	"\x0fcorrect_answers\x18\x05 \x01(\x05R\x0ecorrectAnswers\x12#\n" +
-	"\rwrong_answers\x18\x06 \x01(\x05R\fwrongAnswers2\xa8\x05\n" +
+	"\rwrong_answers\x18\x06 \x01(\x05R\fwrongAnswers\"4\n" +
+	"\x19GetOnboardingStateRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"y\n" +
+	"\x0eOnboardingStep\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1c\n" +
+	"\tcompleted\x18\x03 \x01(\bR\tcompleted\x12!\n" +
+	"\fcompleted_at\x18\x04 \x01(\x03R\vcompletedAt\"y\n" +
+	"\x10OnboardingReward\x12\x14\n" +
+	"\x05asset\x18\x01 \x01(\tR\x05asset\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x18\n" +
+	"\agranted\x18\x03 \x01(\bR\agranted\x12\x1d\n" +
+	"\n" +
+	"granted_at\x18\x04 \x01(\x03R\tgrantedAt\"\xfa\x01\n" +
+	"\x17OnboardingStateResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12,\n" +
+	"\x05steps\x18\x02 \x03(\v2\x16.levels.OnboardingStepR\x05steps\x12'\n" +
+	"\x0fcompleted_steps\x18\x03 \x01(\x05R\x0ecompletedSteps\x12\x1f\n" +
+	"\vtotal_steps\x18\x04 \x01(\x05R\n" +
+	"totalSteps\x12\x1c\n" +
+	"\tcompleted\x18\x05 \x01(\bR\tcompleted\x120\n" +
+	"\x06reward\x18\x06 \x01(\v2\x18.levels.OnboardingRewardR\x06reward2\xf3\x05\n" +
 	"\fLevelService\x12F\n" +
 	"\fGetUserLevel\x12\x1b.levels.GetUserLevelRequest\x1a\x19.levels.UserLevelResponse\x12C\n" +
 	"\fGetAllLevels\x12\x1b.levels.GetAllLevelsRequest\x1a\x16.levels.LevelsResponse\x12:\n" +
@@ -3436,19 +3950,23 @@ const file_levels_proto_rawDesc = "" +
 	"\x10GetLevelLicenses\x12\x1f.levels.GetLevelLicensesRequest\x1a\x1d.levels.LevelLicensesResponse\x12L\n" +
 	"\x0eGetLevelPrizes\x12\x1d.levels.GetLevelPrizesRequest\x1a\x1b.levels.LevelPrizesResponse\x12C\n" +
 	"\n" +
-	"ClaimPrize\x12\x19.levels.ClaimPrizeRequest\x1a\x1a.levels.ClaimPrizeResponse2\xf7\x03\n" +
+	"ClaimPrize\x12\x19.levels.ClaimPrizeRequest\x1a\x1a.levels.ClaimPrizeResponse\x12I\n" +
+	"\rGetUserScores\x12\x1c.levels.GetUserScoresRequest\x1a\x1a.levels.UserScoresResponse2\xb9\x04\n" +
 	"\x0fActivityService\x12F\n" +
 	"\vLogActivity\x12\x1a.levels.LogActivityRequest\x1a\x1b.levels.LogActivityResponse\x12U\n" +
 	"\x11GetUserActivities\x12 .levels.GetUserActivitiesRequest\x1a\x1e.levels.UserActivitiesResponse\x12^\n" +
 	"\x13UpdateActivityScore\x12\".levels.UpdateActivityScoreRequest\x1a#.levels.UpdateActivityScoreResponse\x12F\n" +
 	"\vRecordTrade\x12\x1a.levels.RecordTradeRequest\x1a\x1b.levels.RecordTradeResponse\x12L\n" +
 	"\rRecordDeposit\x12\x1c.levels.RecordDepositRequest\x1a\x1d.levels.RecordDepositResponse\x12O\n" +
-	"\x0eRecordFollower\x12\x1d.levels.RecordFollowerRequest\x1a\x1e.levels.RecordFollowerResponse2\xe4\x01\n" +
+	"\x0eRecordFollower\x12\x1d.levels.RecordFollowerRequest\x1a\x1e.levels.RecordFollowerResponse\x12@\n" +
+	"\tHeartbeat\x12\x18.levels.HeartbeatRequest\x1a\x19.levels.HeartbeatResponse2\xe4\x01\n" +
 	"\x10ChallengeService\x12C\n" +
 	"\vGetQuestion\x12\x1a.levels.GetQuestionRequest\x1a\x18.levels.QuestionResponse\x12I\n" +
 	"\fSubmitAnswer\x12\x1b.levels.SubmitAnswerRequest\x1a\x1c.levels.AnswerResultResponse\x12@\n" +
 	"\n" +
-	"GetTimings\x12\x19.levels.GetTimingsRequest\x1a\x17.levels.TimingsResponseB\x1aZ\x18metargb/shared/pb/levelsb\x06proto3"
+	"GetTimings\x12\x19.levels.GetTimingsRequest\x1a\x17.levels.TimingsResponse2m\n" +
+	"\x11OnboardingService\x12X\n" +
+	"\x12GetOnboardingState\x12!.levels.GetOnboardingStateRequest\x1a\x1f.levels.OnboardingStateResponseB\x1aZ\x18metargb/shared/pb/levelsb\x06proto3"
 
 var (
 	file_levels_proto_rawDescOnce sync.Once
@@ -3462,116 +3980,134 @@ func file_levels_proto_rawDescGZIP() []byte {
 	return file_levels_proto_rawDescData
 }
 
-var file_levels_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_levels_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_levels_proto_goTypes = []any{
 	(*GetUserLevelRequest)(nil),         // 0: levels.GetUserLevelRequest
 	(*UserLevelResponse)(nil),           // 1: levels.UserLevelResponse
-	(*GetAllLevelsRequest)(nil),         // 2: levels.GetAllLevelsRequest
-	(*LevelsResponse)(nil),              // 3: levels.LevelsResponse
-	(*GetLevelRequest)(nil),             // 4: levels.GetLevelRequest
-	(*LevelResponse)(nil),               // 5: levels.LevelResponse
-	(*Level)(nil),                       // 6: levels.Level
-	(*LevelGeneralInfo)(nil),            // 7: levels.LevelGeneralInfo
-	(*LevelPrize)(nil),                  // 8: levels.LevelPrize
-	(*LevelGem)(nil),                    // 9: levels.LevelGem
-	(*LevelGift)(nil),                   // 10: levels.LevelGift
-	(*LevelLicense)(nil),                // 11: levels.LevelLicense
-	(*GetLevelGeneralInfoRequest)(nil),  // 12: levels.GetLevelGeneralInfoRequest
-	(*LevelGeneralInfoResponse)(nil),    // 13: levels.LevelGeneralInfoResponse
-	(*GetLevelGemRequest)(nil),          // 14: levels.GetLevelGemRequest
-	(*LevelGemResponse)(nil),            // 15: levels.LevelGemResponse
-	(*GetLevelGiftRequest)(nil),         // 16: levels.GetLevelGiftRequest
-	(*LevelGiftResponse)(nil),           // 17: levels.LevelGiftResponse
-	(*GetLevelLicensesRequest)(nil),     // 18: levels.GetLevelLicensesRequest
-	(*LevelLicensesResponse)(nil),       // 19: levels.LevelLicensesResponse
-	(*GetLevelPrizesRequest)(nil),       // 20: levels.GetLevelPrizesRequest
-	(*LevelPrizesResponse)(nil),         // 21: levels.LevelPrizesResponse
-	(*ClaimPrizeRequest)(nil),           // 22: levels.ClaimPrizeRequest
-	(*ClaimPrizeResponse)(nil),          // 23: levels.ClaimPrizeResponse
-	(*LogActivityRequest)(nil),          // 24: levels.LogActivityRequest
-	(*LogActivityResponse)(nil),         // 25: levels.LogActivityResponse
-	(*GetUserActivitiesRequest)(nil),    // 26: levels.GetUserActivitiesRequest
-	(*UserActivitiesResponse)(nil),      // 27: levels.UserActivitiesResponse
-	(*UserActivity)(nil),                // 28: levels.UserActivity
-	(*UserLog)(nil),                     // 29: levels.UserLog
-	(*UpdateActivityScoreRequest)(nil),  // 30: levels.UpdateActivityScoreRequest
-	(*UpdateActivityScoreResponse)(nil), // 31: levels.UpdateActivityScoreResponse
-	(*RecordTradeRequest)(nil),          // 32: levels.RecordTradeRequest
-	(*RecordTradeResponse)(nil),         // 33: levels.RecordTradeResponse
-	(*RecordDepositRequest)(nil),        // 34: levels.RecordDepositRequest
-	(*RecordDepositResponse)(nil),       // 35: levels.RecordDepositResponse
-	(*RecordFollowerRequest)(nil),       // 36: levels.RecordFollowerRequest
-	(*RecordFollowerResponse)(nil),      // 37: levels.RecordFollowerResponse
-	(*GetQuestionRequest)(nil),          // 38: levels.GetQuestionRequest
-	(*QuestionResponse)(nil),            // 39: levels.QuestionResponse
-	(*Question)(nil),                    // 40: levels.Question
-	(*Answer)(nil),                      // 41: levels.Answer
-	(*SubmitAnswerRequest)(nil),         // 42: levels.SubmitAnswerRequest
-	(*AnswerResultResponse)(nil),        // 43: levels.AnswerResultResponse
-	(*GetTimingsRequest)(nil),           // 44: levels.GetTimingsRequest
-	(*TimingsResponse)(nil),             // 45: levels.TimingsResponse
+	(*GetUserScoresRequest)(nil),        // 2: levels.GetUserScoresRequest
+	(*UserScoresResponse)(nil),          // 3: levels.UserScoresResponse
+	(*GetAllLevelsRequest)(nil),         // 4: levels.GetAllLevelsRequest
+	(*LevelsResponse)(nil),              // 5: levels.LevelsResponse
+	(*GetLevelRequest)(nil),             // 6: levels.GetLevelRequest
+	(*LevelResponse)(nil),               // 7: levels.LevelResponse
+	(*Level)(nil),                       // 8: levels.Level
+	(*LevelGeneralInfo)(nil),            // 9: levels.LevelGeneralInfo
+	(*LevelPrize)(nil),                  // 10: levels.LevelPrize
+	(*LevelGem)(nil),                    // 11: levels.LevelGem
+	(*LevelGift)(nil),                   // 12: levels.LevelGift
+	(*LevelLicense)(nil),                // 13: levels.LevelLicense
+	(*GetLevelGeneralInfoRequest)(nil),  // 14: levels.GetLevelGeneralInfoRequest
+	(*LevelGeneralInfoResponse)(nil),    // 15: levels.LevelGeneralInfoResponse
+	(*GetLevelGemRequest)(nil),          // 16: levels.GetLevelGemRequest
+	(*LevelGemResponse)(nil),            // 17: levels.LevelGemResponse
+	(*GetLevelGiftRequest)(nil),         // 18: levels.GetLevelGiftRequest
+	(*LevelGiftResponse)(nil),           // 19: levels.LevelGiftResponse
+	(*GetLevelLicensesRequest)(nil),     // 20: levels.GetLevelLicensesRequest
+	(*LevelLicensesResponse)(nil),       // 21: levels.LevelLicensesResponse
+	(*GetLevelPrizesRequest)(nil),       // 22: levels.GetLevelPrizesRequest
+	(*LevelPrizesResponse)(nil),         // 23: levels.LevelPrizesResponse
+	(*ClaimPrizeRequest)(nil),           // 24: levels.ClaimPrizeRequest
+	(*ClaimPrizeResponse)(nil),          // 25: levels.ClaimPrizeResponse
+	(*LogActivityRequest)(nil),          // 26: levels.LogActivityRequest
+	(*LogActivityResponse)(nil),         // 27: levels.LogActivityResponse
+	(*GetUserActivitiesRequest)(nil),    // 28: levels.GetUserActivitiesRequest
+	(*UserActivitiesResponse)(nil),      // 29: levels.UserActivitiesResponse
+	(*UserActivity)(nil),                // 30: levels.UserActivity
+	(*UserLog)(nil),                     // 31: levels.UserLog
+	(*UpdateActivityScoreRequest)(nil),  // 32: levels.UpdateActivityScoreRequest
+	(*UpdateActivityScoreResponse)(nil), // 33: levels.UpdateActivityScoreResponse
+	(*RecordTradeRequest)(nil),          // 34: levels.RecordTradeRequest
+	(*RecordTradeResponse)(nil),         // 35: levels.RecordTradeResponse
+	(*RecordDepositRequest)(nil),        // 36: levels.RecordDepositRequest
+	(*RecordDepositResponse)(nil),       // 37: levels.RecordDepositResponse
+	(*RecordFollowerRequest)(nil),       // 38: levels.RecordFollowerRequest
+	(*RecordFollowerResponse)(nil),      // 39: levels.RecordFollowerResponse
+	(*HeartbeatRequest)(nil),            // 40: levels.HeartbeatRequest
+	(*HeartbeatResponse)(nil),           // 41: levels.HeartbeatResponse
+	(*GetQuestionRequest)(nil),          // 42: levels.GetQuestionRequest
+	(*QuestionResponse)(nil),            // 43: levels.QuestionResponse
+	(*Question)(nil),                    // 44: levels.Question
+	(*Answer)(nil),                      // 45: levels.Answer
+	(*SubmitAnswerRequest)(nil),         // 46: levels.SubmitAnswerRequest
+	(*AnswerResultResponse)(nil),        // 47: levels.AnswerResultResponse
+	(*GetTimingsRequest)(nil),           // 48: levels.GetTimingsRequest
+	(*TimingsResponse)(nil),             // 49: levels.TimingsResponse
+	(*GetOnboardingStateRequest)(nil),   // 50: levels.GetOnboardingStateRequest
+	(*OnboardingStep)(nil),              // 51: levels.OnboardingStep
+	(*OnboardingReward)(nil),            // 52: levels.OnboardingReward
+	(*OnboardingStateResponse)(nil),     // 53: levels.OnboardingStateResponse
+	nil,                                 // 54: levels.UserScoresResponse.ScoresEntry
 }
 var file_levels_proto_depIdxs = []int32{
-	6,  // 0: levels.UserLevelResponse.latest_level:type_name -> levels.Level
-	6,  // 1: levels.UserLevelResponse.previous_levels:type_name -> levels.Level
-	6,  // 2: levels.LevelsResponse.levels:type_name -> levels.Level
-	6,  // 3: levels.LevelResponse.level:type_name -> levels.Level
-	7,  // 4: levels.Level.general_info:type_name -> levels.LevelGeneralInfo
-	8,  // 5: levels.Level.prize:type_name -> levels.LevelPrize
-	9,  // 6: levels.Level.gem:type_name -> levels.LevelGem
-	10, // 7: levels.Level.gift:type_name -> levels.LevelGift
-	11, // 8: levels.Level.licenses:type_name -> levels.LevelLicense
-	7,  // 9: levels.LevelGeneralInfoResponse.general_info:type_name -> levels.LevelGeneralInfo
-	9,  // 10: levels.LevelGemResponse.gem:type_name -> levels.LevelGem
-	10, // 11: levels.LevelGiftResponse.gift:type_name -> levels.LevelGift
-	11, // 12: levels.LevelLicensesResponse.licenses:type_name -> levels.LevelLicense
-	8,  // 13: levels.LevelPrizesResponse.prize:type_name -> levels.LevelPrize
-	28, // 14: levels.UserActivitiesResponse.activities:type_name -> levels.UserActivity
-	29, // 15: levels.UserActivitiesResponse.user_log:type_name -> levels.UserLog
-	40, // 16: levels.QuestionResponse.question:type_name -> levels.Question
-	41, // 17: levels.Question.answers:type_name -> levels.Answer
-	40, // 18: levels.AnswerResultResponse.question:type_name -> levels.Question
-	0,  // 19: levels.LevelService.GetUserLevel:input_type -> levels.GetUserLevelRequest
-	2,  // 20: levels.LevelService.GetAllLevels:input_type -> levels.GetAllLevelsRequest
-	4,  // 21: levels.LevelService.GetLevel:input_type -> levels.GetLevelRequest
-	12, // 22: levels.LevelService.GetLevelGeneralInfo:input_type -> levels.GetLevelGeneralInfoRequest
-	14, // 23: levels.LevelService.GetLevelGem:input_type -> levels.GetLevelGemRequest
-	16, // 24: levels.LevelService.GetLevelGift:input_type -> levels.GetLevelGiftRequest
-	18, // 25: levels.LevelService.GetLevelLicenses:input_type -> levels.GetLevelLicensesRequest
-	20, // 26: levels.LevelService.GetLevelPrizes:input_type -> levels.GetLevelPrizesRequest
-	22, // 27: levels.LevelService.ClaimPrize:input_type -> levels.ClaimPrizeRequest
-	24, // 28: levels.ActivityService.LogActivity:input_type -> levels.LogActivityRequest
-	26, // 29: levels.ActivityService.GetUserActivities:input_type -> levels.GetUserActivitiesRequest
-	30, // 30: levels.ActivityService.UpdateActivityScore:input_type -> levels.UpdateActivityScoreRequest
-	32, // 31: levels.ActivityService.RecordTrade:input_type -> levels.RecordTradeRequest
-	34, // 32: levels.ActivityService.RecordDeposit:input_type -> levels.RecordDepositRequest
-	36, // 33: levels.ActivityService.RecordFollower:input_type -> levels.RecordFollowerRequest
-	38, // 34: levels.ChallengeService.GetQuestion:input_type -> levels.GetQuestionRequest
-	42, // 35: levels.ChallengeService.SubmitAnswer:input_type -> levels.SubmitAnswerRequest
-	44, // 36: levels.ChallengeService.GetTimings:input_type -> levels.GetTimingsRequest
-	1,  // 37: levels.LevelService.GetUserLevel:output_type -> levels.UserLevelResponse
-	3,  // 38: levels.LevelService.GetAllLevels:output_type -> levels.LevelsResponse
-	5,  // 39: levels.LevelService.GetLevel:output_type -> levels.LevelResponse
-	13, // 40: levels.LevelService.GetLevelGeneralInfo:output_type -> levels.LevelGeneralInfoResponse
-	15, // 41: levels.LevelService.GetLevelGem:output_type -> levels.LevelGemResponse
-	17, // 42: levels.LevelService.GetLevelGift:output_type -> levels.LevelGiftResponse
-	19, // 43: levels.LevelService.GetLevelLicenses:output_type -> levels.LevelLicensesResponse
-	21, // 44: levels.LevelService.GetLevelPrizes:output_type -> levels.LevelPrizesResponse
-	23, // 45: levels.LevelService.ClaimPrize:output_type -> levels.ClaimPrizeResponse
-	25, // 46: levels.ActivityService.LogActivity:output_type -> levels.LogActivityResponse
-	27, // 47: levels.ActivityService.GetUserActivities:output_type -> levels.UserActivitiesResponse
-	31, // 48: levels.ActivityService.UpdateActivityScore:output_type -> levels.UpdateActivityScoreResponse
-	33, // 49: levels.ActivityService.RecordTrade:output_type -> levels.RecordTradeResponse
-	35, // 50: levels.ActivityService.RecordDeposit:output_type -> levels.RecordDepositResponse
-	37, // 51: levels.ActivityService.RecordFollower:output_type -> levels.RecordFollowerResponse
-	39, // 52: levels.ChallengeService.GetQuestion:output_type -> levels.QuestionResponse
-	43, // 53: levels.ChallengeService.SubmitAnswer:output_type -> levels.AnswerResultResponse
-	45, // 54: levels.ChallengeService.GetTimings:output_type -> levels.TimingsResponse
-	37, // [37:55] is the sub-list for method output_type
-	19, // [19:37] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	8,  // 0: levels.UserLevelResponse.latest_level:type_name -> levels.Level
+	8,  // 1: levels.UserLevelResponse.previous_levels:type_name -> levels.Level
+	54, // 2: levels.UserScoresResponse.scores:type_name -> levels.UserScoresResponse.ScoresEntry
+	8,  // 3: levels.LevelsResponse.levels:type_name -> levels.Level
+	8,  // 4: levels.LevelResponse.level:type_name -> levels.Level
+	9,  // 5: levels.Level.general_info:type_name -> levels.LevelGeneralInfo
+	10, // 6: levels.Level.prize:type_name -> levels.LevelPrize
+	11, // 7: levels.Level.gem:type_name -> levels.LevelGem
+	12, // 8: levels.Level.gift:type_name -> levels.LevelGift
+	13, // 9: levels.Level.licenses:type_name -> levels.LevelLicense
+	9,  // 10: levels.LevelGeneralInfoResponse.general_info:type_name -> levels.LevelGeneralInfo
+	11, // 11: levels.LevelGemResponse.gem:type_name -> levels.LevelGem
+	12, // 12: levels.LevelGiftResponse.gift:type_name -> levels.LevelGift
+	13, // 13: levels.LevelLicensesResponse.licenses:type_name -> levels.LevelLicense
+	10, // 14: levels.LevelPrizesResponse.prize:type_name -> levels.LevelPrize
+	30, // 15: levels.UserActivitiesResponse.activities:type_name -> levels.UserActivity
+	31, // 16: levels.UserActivitiesResponse.user_log:type_name -> levels.UserLog
+	44, // 17: levels.QuestionResponse.question:type_name -> levels.Question
+	45, // 18: levels.Question.answers:type_name -> levels.Answer
+	44, // 19: levels.AnswerResultResponse.question:type_name -> levels.Question
+	51, // 20: levels.OnboardingStateResponse.steps:type_name -> levels.OnboardingStep
+	52, // 21: levels.OnboardingStateResponse.reward:type_name -> levels.OnboardingReward
+	0,  // 22: levels.LevelService.GetUserLevel:input_type -> levels.GetUserLevelRequest
+	4,  // 23: levels.LevelService.GetAllLevels:input_type -> levels.GetAllLevelsRequest
+	6,  // 24: levels.LevelService.GetLevel:input_type -> levels.GetLevelRequest
+	14, // 25: levels.LevelService.GetLevelGeneralInfo:input_type -> levels.GetLevelGeneralInfoRequest
+	16, // 26: levels.LevelService.GetLevelGem:input_type -> levels.GetLevelGemRequest
+	18, // 27: levels.LevelService.GetLevelGift:input_type -> levels.GetLevelGiftRequest
+	20, // 28: levels.LevelService.GetLevelLicenses:input_type -> levels.GetLevelLicensesRequest
+	22, // 29: levels.LevelService.GetLevelPrizes:input_type -> levels.GetLevelPrizesRequest
+	24, // 30: levels.LevelService.ClaimPrize:input_type -> levels.ClaimPrizeRequest
+	2,  // 31: levels.LevelService.GetUserScores:input_type -> levels.GetUserScoresRequest
+	26, // 32: levels.ActivityService.LogActivity:input_type -> levels.LogActivityRequest
+	28, // 33: levels.ActivityService.GetUserActivities:input_type -> levels.GetUserActivitiesRequest
+	32, // 34: levels.ActivityService.UpdateActivityScore:input_type -> levels.UpdateActivityScoreRequest
+	34, // 35: levels.ActivityService.RecordTrade:input_type -> levels.RecordTradeRequest
+	36, // 36: levels.ActivityService.RecordDeposit:input_type -> levels.RecordDepositRequest
+	38, // 37: levels.ActivityService.RecordFollower:input_type -> levels.RecordFollowerRequest
+	40, // 38: levels.ActivityService.Heartbeat:input_type -> levels.HeartbeatRequest
+	42, // 39: levels.ChallengeService.GetQuestion:input_type -> levels.GetQuestionRequest
+	46, // 40: levels.ChallengeService.SubmitAnswer:input_type -> levels.SubmitAnswerRequest
+	48, // 41: levels.ChallengeService.GetTimings:input_type -> levels.GetTimingsRequest
+	50, // 42: levels.OnboardingService.GetOnboardingState:input_type -> levels.GetOnboardingStateRequest
+	1,  // 43: levels.LevelService.GetUserLevel:output_type -> levels.UserLevelResponse
+	5,  // 44: levels.LevelService.GetAllLevels:output_type -> levels.LevelsResponse
+	7,  // 45: levels.LevelService.GetLevel:output_type -> levels.LevelResponse
+	15, // 46: levels.LevelService.GetLevelGeneralInfo:output_type -> levels.LevelGeneralInfoResponse
+	17, // 47: levels.LevelService.GetLevelGem:output_type -> levels.LevelGemResponse
+	19, // 48: levels.LevelService.GetLevelGift:output_type -> levels.LevelGiftResponse
+	21, // 49: levels.LevelService.GetLevelLicenses:output_type -> levels.LevelLicensesResponse
+	23, // 50: levels.LevelService.GetLevelPrizes:output_type -> levels.LevelPrizesResponse
+	25, // 51: levels.LevelService.ClaimPrize:output_type -> levels.ClaimPrizeResponse
+	3,  // 52: levels.LevelService.GetUserScores:output_type -> levels.UserScoresResponse
+	27, // 53: levels.ActivityService.LogActivity:output_type -> levels.LogActivityResponse
+	29, // 54: levels.ActivityService.GetUserActivities:output_type -> levels.UserActivitiesResponse
+	33, // 55: levels.ActivityService.UpdateActivityScore:output_type -> levels.UpdateActivityScoreResponse
+	35, // 56: levels.ActivityService.RecordTrade:output_type -> levels.RecordTradeResponse
+	37, // 57: levels.ActivityService.RecordDeposit:output_type -> levels.RecordDepositResponse
+	39, // 58: levels.ActivityService.RecordFollower:output_type -> levels.RecordFollowerResponse
+	41, // 59: levels.ActivityService.Heartbeat:output_type -> levels.HeartbeatResponse
+	43, // 60: levels.ChallengeService.GetQuestion:output_type -> levels.QuestionResponse
+	47, // 61: levels.ChallengeService.SubmitAnswer:output_type -> levels.AnswerResultResponse
+	49, // 62: levels.ChallengeService.GetTimings:output_type -> levels.TimingsResponse
+	53, // 63: levels.OnboardingService.GetOnboardingState:output_type -> levels.OnboardingStateResponse
+	43, // [43:64] is the sub-list for method output_type
+	22, // [22:43] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_levels_proto_init() }
@@ -3585,9 +4121,9 @@ func file_levels_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_levels_proto_rawDesc), len(file_levels_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   46,
+			NumMessages:   55,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   4,
 		},
 		GoTypes:           file_levels_proto_goTypes,
 		DependencyIndexes: file_levels_proto_depIdxs,
@@ -28,6 +28,7 @@ const (
 	LevelService_GetLevelLicenses_FullMethodName    = "/levels.LevelService/GetLevelLicenses"
 	LevelService_GetLevelPrizes_FullMethodName      = "/levels.LevelService/GetLevelPrizes"
 	LevelService_ClaimPrize_FullMethodName          = "/levels.LevelService/ClaimPrize"
+	LevelService_GetUserScores_FullMethodName       = "/levels.LevelService/GetUserScores"
 )
 
 // LevelServiceClient is the client API for LevelService service.
@@ -45,6 +46,7 @@ type LevelServiceClient interface {
 	GetLevelLicenses(ctx context.Context, in *GetLevelLicensesRequest, opts ...grpc.CallOption) (*LevelLicensesResponse, error)
 	GetLevelPrizes(ctx context.Context, in *GetLevelPrizesRequest, opts ...grpc.CallOption) (*LevelPrizesResponse, error)
 	ClaimPrize(ctx context.Context, in *ClaimPrizeRequest, opts ...grpc.CallOption) (*ClaimPrizeResponse, error)
+	GetUserScores(ctx context.Context, in *GetUserScoresRequest, opts ...grpc.CallOption) (*UserScoresResponse, error)
 }
 
 type levelServiceClient struct {
@@ -145,6 +147,16 @@ func (c *levelServiceClient) ClaimPrize(ctx context.Context, in *ClaimPrizeReque
 	return out, nil
 }
 
+func (c *levelServiceClient) GetUserScores(ctx context.Context, in *GetUserScoresRequest, opts ...grpc.CallOption) (*UserScoresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserScoresResponse)
+	err := c.cc.Invoke(ctx, LevelService_GetUserScores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LevelServiceServer is the server API for LevelService service.
 // All implementations must embed UnimplementedLevelServiceServer
 // for forward compatibility.
@@ -160,6 +172,7 @@ type LevelServiceServer interface {
 	GetLevelLicenses(context.Context, *GetLevelLicensesRequest) (*LevelLicensesResponse, error)
 	GetLevelPrizes(context.Context, *GetLevelPrizesRequest) (*LevelPrizesResponse, error)
 	ClaimPrize(context.Context, *ClaimPrizeRequest) (*ClaimPrizeResponse, error)
+	GetUserScores(context.Context, *GetUserScoresRequest) (*UserScoresResponse, error)
 	mustEmbedUnimplementedLevelServiceServer()
 }
 
@@ -197,6 +210,9 @@ func (UnimplementedLevelServiceServer) GetLevelPrizes(context.Context, *GetLevel
 func (UnimplementedLevelServiceServer) ClaimPrize(context.Context, *ClaimPrizeRequest) (*ClaimPrizeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ClaimPrize not implemented")
 }
+func (UnimplementedLevelServiceServer) GetUserScores(context.Context, *GetUserScoresRequest) (*UserScoresResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserScores not implemented")
+}
 func (UnimplementedLevelServiceServer) mustEmbedUnimplementedLevelServiceServer() {}
 func (UnimplementedLevelServiceServer) testEmbeddedByValue()                      {}
 
@@ -380,6 +396,24 @@ func _LevelService_ClaimPrize_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LevelService_GetUserScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LevelServiceServer).GetUserScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LevelService_GetUserScores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LevelServiceServer).GetUserScores(ctx, req.(*GetUserScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LevelService_ServiceDesc is the grpc.ServiceDesc for LevelService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -423,6 +457,10 @@ var LevelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimPrize",
 			Handler:    _LevelService_ClaimPrize_Handler,
 		},
+		{
+			MethodName: "GetUserScores",
+			Handler:    _LevelService_GetUserScores_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "levels.proto",
@@ -435,6 +473,7 @@ const (
 	ActivityService_RecordTrade_FullMethodName         = "/levels.ActivityService/RecordTrade"
 	ActivityService_RecordDeposit_FullMethodName       = "/levels.ActivityService/RecordDeposit"
 	ActivityService_RecordFollower_FullMethodName      = "/levels.ActivityService/RecordFollower"
+	ActivityService_Heartbeat_FullMethodName           = "/levels.ActivityService/Heartbeat"
 )
 
 // ActivityServiceClient is the client API for ActivityService service.
@@ -449,6 +488,8 @@ type ActivityServiceClient interface {
 	RecordTrade(ctx context.Context, in *RecordTradeRequest, opts ...grpc.CallOption) (*RecordTradeResponse, error)
 	RecordDeposit(ctx context.Context, in *RecordDepositRequest, opts ...grpc.CallOption) (*RecordDepositResponse, error)
 	RecordFollower(ctx context.Context, in *RecordFollowerRequest, opts ...grpc.CallOption) (*RecordFollowerResponse, error)
+	// Heartbeat keeps the user's activity session open while the client is in use
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
 }
 
 type activityServiceClient struct {
@@ -519,6 +560,16 @@ func (c *activityServiceClient) RecordFollower(ctx context.Context, in *RecordFo
 	return out, nil
 }
 
+func (c *activityServiceClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HeartbeatResponse)
+	err := c.cc.Invoke(ctx, ActivityService_Heartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ActivityServiceServer is the server API for ActivityService service.
 // All implementations must embed UnimplementedActivityServiceServer
 // for forward compatibility.
@@ -531,6 +582,8 @@ type ActivityServiceServer interface {
 	RecordTrade(context.Context, *RecordTradeRequest) (*RecordTradeResponse, error)
 	RecordDeposit(context.Context, *RecordDepositRequest) (*RecordDepositResponse, error)
 	RecordFollower(context.Context, *RecordFollowerRequest) (*RecordFollowerResponse, error)
+	// Heartbeat keeps the user's activity session open while the client is in use
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
 	mustEmbedUnimplementedActivityServiceServer()
 }
 
@@ -559,6 +612,9 @@ func (UnimplementedActivityServiceServer) RecordDeposit(context.Context, *Record
 func (UnimplementedActivityServiceServer) RecordFollower(context.Context, *RecordFollowerRequest) (*RecordFollowerResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RecordFollower not implemented")
 }
+func (UnimplementedActivityServiceServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Heartbeat not implemented")
+}
 func (UnimplementedActivityServiceServer) mustEmbedUnimplementedActivityServiceServer() {}
 func (UnimplementedActivityServiceServer) testEmbeddedByValue()                         {}
 
@@ -688,6 +744,24 @@ func _ActivityService_RecordFollower_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ActivityService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ActivityService_ServiceDesc is the grpc.ServiceDesc for ActivityService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -719,6 +793,10 @@ var ActivityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RecordFollower",
 			Handler:    _ActivityService_RecordFollower_Handler,
 		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _ActivityService_Heartbeat_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "levels.proto",
@@ -905,3 +983,111 @@ var ChallengeService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "levels.proto",
 }
+
+const (
+	OnboardingService_GetOnboardingState_FullMethodName = "/levels.OnboardingService/GetOnboardingState"
+)
+
+// OnboardingServiceClient is the client API for OnboardingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// OnboardingService tracks the onboarding checklist of new users. Steps are
+// completed by activity events other services publish.
+type OnboardingServiceClient interface {
+	GetOnboardingState(ctx context.Context, in *GetOnboardingStateRequest, opts ...grpc.CallOption) (*OnboardingStateResponse, error)
+}
+
+type onboardingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOnboardingServiceClient(cc grpc.ClientConnInterface) OnboardingServiceClient {
+	return &onboardingServiceClient{cc}
+}
+
+func (c *onboardingServiceClient) GetOnboardingState(ctx context.Context, in *GetOnboardingStateRequest, opts ...grpc.CallOption) (*OnboardingStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnboardingStateResponse)
+	err := c.cc.Invoke(ctx, OnboardingService_GetOnboardingState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OnboardingServiceServer is the server API for OnboardingService service.
+// All implementations must embed UnimplementedOnboardingServiceServer
+// for forward compatibility.
+//
+// OnboardingService tracks the onboarding checklist of new users. Steps are
+// completed by activity events other services publish.
+type OnboardingServiceServer interface {
+	GetOnboardingState(context.Context, *GetOnboardingStateRequest) (*OnboardingStateResponse, error)
+	mustEmbedUnimplementedOnboardingServiceServer()
+}
+
+// UnimplementedOnboardingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOnboardingServiceServer struct{}
+
+func (UnimplementedOnboardingServiceServer) GetOnboardingState(context.Context, *GetOnboardingStateRequest) (*OnboardingStateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOnboardingState not implemented")
+}
+func (UnimplementedOnboardingServiceServer) mustEmbedUnimplementedOnboardingServiceServer() {}
+func (UnimplementedOnboardingServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeOnboardingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OnboardingServiceServer will
+// result in compilation errors.
+type UnsafeOnboardingServiceServer interface {
+	mustEmbedUnimplementedOnboardingServiceServer()
+}
+
+func RegisterOnboardingServiceServer(s grpc.ServiceRegistrar, srv OnboardingServiceServer) {
+	// If the following call panics, it indicates UnimplementedOnboardingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OnboardingService_ServiceDesc, srv)
+}
+
+func _OnboardingService_GetOnboardingState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOnboardingStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnboardingServiceServer).GetOnboardingState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnboardingService_GetOnboardingState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnboardingServiceServer).GetOnboardingState(ctx, req.(*GetOnboardingStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OnboardingService_ServiceDesc is the grpc.ServiceDesc for OnboardingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OnboardingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "levels.OnboardingService",
+	HandlerType: (*OnboardingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOnboardingState",
+			Handler:    _OnboardingService_GetOnboardingState_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "levels.proto",
+}
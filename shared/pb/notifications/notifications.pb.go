@@ -31,6 +31,8 @@ type SendNotificationRequest struct {
 	Data          map[string]string      `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	SendSms       bool                   `protobuf:"varint,6,opt,name=send_sms,json=sendSms,proto3" json:"send_sms,omitempty"`
 	SendEmail     bool                   `protobuf:"varint,7,opt,name=send_email,json=sendEmail,proto3" json:"send_email,omitempty"`
+	Category      string                 `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`  // marketplace, dynasty, support, marketing; empty bypasses preferences
+	Critical      bool                   `protobuf:"varint,9,opt,name=critical,proto3" json:"critical,omitempty"` // Also send to the user's linked bot chat
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -114,12 +116,29 @@ func (x *SendNotificationRequest) GetSendEmail() bool {
 	return false
 }
 
+func (x *SendNotificationRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SendNotificationRequest) GetCritical() bool {
+	if x != nil {
+		return x.Critical
+	}
+	return false
+}
+
 type NotificationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Sent          bool                   `protobuf:"varint,2,opt,name=sent,proto3" json:"sent,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sent               bool                   `protobuf:"varint,2,opt,name=sent,proto3" json:"sent,omitempty"`
+	SuppressedChannels []string               `protobuf:"bytes,3,rep,name=suppressed_channels,json=suppressedChannels,proto3" json:"suppressed_channels,omitempty"` // Channels skipped because of user preferences
+	DigestChannels     []string               `protobuf:"bytes,4,rep,name=digest_channels,json=digestChannels,proto3" json:"digest_channels,omitempty"`             // Channels queued for the user's hourly or daily digest
+	SentToBot          bool                   `protobuf:"varint,5,opt,name=sent_to_bot,json=sentToBot,proto3" json:"sent_to_bot,omitempty"`                         // A critical notification reached the user's bot chat
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *NotificationResponse) Reset() {
@@ -166,6 +185,27 @@ func (x *NotificationResponse) GetSent() bool {
 	return false
 }
 
+func (x *NotificationResponse) GetSuppressedChannels() []string {
+	if x != nil {
+		return x.SuppressedChannels
+	}
+	return nil
+}
+
+func (x *NotificationResponse) GetDigestChannels() []string {
+	if x != nil {
+		return x.DigestChannels
+	}
+	return nil
+}
+
+func (x *NotificationResponse) GetSentToBot() bool {
+	if x != nil {
+		return x.SentToBot
+	}
+	return false
+}
+
 type GetNotificationsRequest struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
 	UserId        uint64                    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -524,6 +564,8 @@ type SendSMSRequest struct {
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Template      string                 `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`                                                                       // Kavenegar template name
 	Tokens        map[string]string      `protobuf:"bytes,4,rep,name=tokens,proto3" json:"tokens,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Template tokens
+	UserId        uint64                 `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                            // Optional, lets critical messages reach the user's bot chat
+	Critical      bool                   `protobuf:"varint,6,opt,name=critical,proto3" json:"critical,omitempty"`                                                                      // Route through the bot chat when SMS cannot reach the user
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -586,6 +628,20 @@ func (x *SendSMSRequest) GetTokens() map[string]string {
 	return nil
 }
 
+func (x *SendSMSRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SendSMSRequest) GetCritical() bool {
+	if x != nil {
+		return x.Critical
+	}
+	return false
+}
+
 type SMSResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sent          bool                   `protobuf:"varint,1,opt,name=sent,proto3" json:"sent,omitempty"`
@@ -651,6 +707,7 @@ type SendOTPRequest struct {
 	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
 	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	UserId        uint64                 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Optional, lets the code reach the user's bot chat
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -706,6 +763,13 @@ func (x *SendOTPRequest) GetReason() string {
 	return ""
 }
 
+func (x *SendOTPRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
 type SendEmailRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	To            string                 `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
@@ -714,6 +778,7 @@ type SendEmailRequest struct {
 	HtmlBody      string                 `protobuf:"bytes,4,opt,name=html_body,json=htmlBody,proto3" json:"html_body,omitempty"`
 	Cc            []string               `protobuf:"bytes,5,rep,name=cc,proto3" json:"cc,omitempty"`
 	Bcc           []string               `protobuf:"bytes,6,rep,name=bcc,proto3" json:"bcc,omitempty"`
+	Headers       map[string]string      `protobuf:"bytes,7,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // extra headers such as Message-ID and In-Reply-To
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -790,6 +855,13 @@ func (x *SendEmailRequest) GetBcc() []string {
 	return nil
 }
 
+func (x *SendEmailRequest) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
 type EmailResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sent          bool                   `protobuf:"varint,1,opt,name=sent,proto3" json:"sent,omitempty"`
@@ -842,99 +914,1267 @@ func (x *EmailResponse) GetMessageId() string {
 	return ""
 }
 
-var File_notifications_proto protoreflect.FileDescriptor
+// NotificationPreference - whether a category is delivered over a channel
+type NotificationPreference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`   // sms, email, push, in_app
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"` // marketplace, dynasty, support, marketing
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_notifications_proto_rawDesc = "" +
-	"\n" +
-	"\x13notifications.proto\x12\rnotifications\x1a\fcommon.proto\"\xaf\x02\n" +
-	"\x17SendNotificationRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
-	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
-	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage\x12D\n" +
-	"\x04data\x18\x05 \x03(\v20.notifications.SendNotificationRequest.DataEntryR\x04data\x12\x19\n" +
-	"\bsend_sms\x18\x06 \x01(\bR\asendSms\x12\x1d\n" +
-	"\n" +
-	"send_email\x18\a \x01(\bR\tsendEmail\x1a7\n" +
-	"\tDataEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\":\n" +
-	"\x14NotificationResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
-	"\x04sent\x18\x02 \x01(\bR\x04sent\"\x8e\x01\n" +
-	"\x17GetNotificationsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\x129\n" +
-	"\n" +
-	"pagination\x18\x02 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\x12\x1f\n" +
-	"\vunread_only\x18\x03 \x01(\bR\n" +
-	"unreadOnly\"Z\n" +
-	"\x16GetNotificationRequest\x12'\n" +
-	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x04R\x06userId\"\x92\x01\n" +
-	"\x15NotificationsResponse\x12A\n" +
-	"\rnotifications\x18\x01 \x03(\v2\x1b.notifications.NotificationR\rnotifications\x126\n" +
-	"\n" +
-	"pagination\x18\x02 \x01(\v2\x16.common.PaginationMetaR\n" +
-	"pagination\"\x8e\x02\n" +
-	"\fNotification\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
-	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage\x129\n" +
-	"\x04data\x18\x05 \x03(\v2%.notifications.Notification.DataEntryR\x04data\x12\x17\n" +
-	"\aread_at\x18\x06 \x01(\tR\x06readAt\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\a \x01(\tR\tcreatedAt\x1a7\n" +
-	"\tDataEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"U\n" +
-	"\x11MarkAsReadRequest\x12'\n" +
-	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x04R\x06userId\"/\n" +
-	"\x14MarkAllAsReadRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\"\xda\x01\n" +
-	"\x0eSendSMSRequest\x12\x14\n" +
-	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
-	"\btemplate\x18\x03 \x01(\tR\btemplate\x12A\n" +
-	"\x06tokens\x18\x04 \x03(\v2).notifications.SendSMSRequest.TokensEntryR\x06tokens\x1a9\n" +
-	"\vTokensEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"X\n" +
-	"\vSMSResponse\x12\x12\n" +
-	"\x04sent\x18\x01 \x01(\bR\x04sent\x12\x1d\n" +
-	"\n" +
-	"message_id\x18\x02 \x01(\tR\tmessageId\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\"R\n" +
-	"\x0eSendOTPRequest\x12\x14\n" +
-	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x12\n" +
-	"\x04code\x18\x02 \x01(\tR\x04code\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x8f\x01\n" +
-	"\x10SendEmailRequest\x12\x0e\n" +
-	"\x02to\x18\x01 \x01(\tR\x02to\x12\x18\n" +
-	"\asubject\x18\x02 \x01(\tR\asubject\x12\x12\n" +
-	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1b\n" +
-	"\thtml_body\x18\x04 \x01(\tR\bhtmlBody\x12\x0e\n" +
-	"\x02cc\x18\x05 \x03(\tR\x02cc\x12\x10\n" +
-	"\x03bcc\x18\x06 \x03(\tR\x03bcc\"B\n" +
-	"\rEmailResponse\x12\x12\n" +
-	"\x04sent\x18\x01 \x01(\bR\x04sent\x12\x1d\n" +
-	"\n" +
-	"message_id\x18\x02 \x01(\tR\tmessageId2\xb3\x03\n" +
-	"\x13NotificationService\x12_\n" +
-	"\x10SendNotification\x12&.notifications.SendNotificationRequest\x1a#.notifications.NotificationResponse\x12`\n" +
-	"\x10GetNotifications\x12&.notifications.GetNotificationsRequest\x1a$.notifications.NotificationsResponse\x12U\n" +
-	"\x0fGetNotification\x12%.notifications.GetNotificationRequest\x1a\x1b.notifications.Notification\x12=\n" +
-	"\n" +
-	"MarkAsRead\x12 .notifications.MarkAsReadRequest\x1a\r.common.Empty\x12C\n" +
-	"\rMarkAllAsRead\x12#.notifications.MarkAllAsReadRequest\x1a\r.common.Empty2\x98\x01\n" +
-	"\n" +
-	"SMSService\x12D\n" +
-	"\aSendSMS\x12\x1d.notifications.SendSMSRequest\x1a\x1a.notifications.SMSResponse\x12D\n" +
-	"\aSendOTP\x12\x1d.notifications.SendOTPRequest\x1a\x1a.notifications.SMSResponse2Z\n" +
-	"\fEmailService\x12J\n" +
-	"\tSendEmail\x12\x1f.notifications.SendEmailRequest\x1a\x1c.notifications.EmailResponseB!Z\x1fmetargb/shared/pb/notificationsb\x06proto3"
+func (x *NotificationPreference) Reset() {
+	*x = NotificationPreference{}
+	mi := &file_notifications_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreference) ProtoMessage() {}
+
+func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreference.ProtoReflect.Descriptor instead.
+func (*NotificationPreference) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NotificationPreference) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *NotificationPreference) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *NotificationPreference) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type GetPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreferencesRequest) Reset() {
+	*x = GetPreferencesRequest{}
+	mi := &file_notifications_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesRequest) ProtoMessage() {}
+
+func (x *GetPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetPreferencesRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// UpdatePreferencesRequest - only the listed channel/category pairs are changed
+type UpdatePreferencesRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	UserId        uint64                    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Preferences   []*NotificationPreference `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePreferencesRequest) Reset() {
+	*x = UpdatePreferencesRequest{}
+	mi := &file_notifications_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferencesRequest) ProtoMessage() {}
+
+func (x *UpdatePreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdatePreferencesRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdatePreferencesRequest) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// PreferencesResponse - the full channel x category matrix, defaults included
+type PreferencesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreferencesResponse) Reset() {
+	*x = PreferencesResponse{}
+	mi := &file_notifications_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesResponse) ProtoMessage() {}
+
+func (x *PreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesResponse.ProtoReflect.Descriptor instead.
+func (*PreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PreferencesResponse) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// DigestSettings - how categorized SMS and email notifications are delivered
+type DigestSettings struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Mode            string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`                                                 // immediate, hourly, daily
+	Timezone        string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`                                         // IANA name, e.g. Asia/Tehran; empty follows the account timezone
+	QuietHoursStart int32                  `protobuf:"varint,3,opt,name=quiet_hours_start,json=quietHoursStart,proto3" json:"quiet_hours_start,omitempty"` // Hour 0-23 in timezone; equal start and end disables quiet hours
+	QuietHoursEnd   int32                  `protobuf:"varint,4,opt,name=quiet_hours_end,json=quietHoursEnd,proto3" json:"quiet_hours_end,omitempty"`       // Hour 0-23 in timezone, exclusive
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DigestSettings) Reset() {
+	*x = DigestSettings{}
+	mi := &file_notifications_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigestSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigestSettings) ProtoMessage() {}
+
+func (x *DigestSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigestSettings.ProtoReflect.Descriptor instead.
+func (*DigestSettings) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DigestSettings) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *DigestSettings) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *DigestSettings) GetQuietHoursStart() int32 {
+	if x != nil {
+		return x.QuietHoursStart
+	}
+	return 0
+}
+
+func (x *DigestSettings) GetQuietHoursEnd() int32 {
+	if x != nil {
+		return x.QuietHoursEnd
+	}
+	return 0
+}
+
+type GetDigestSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDigestSettingsRequest) Reset() {
+	*x = GetDigestSettingsRequest{}
+	mi := &file_notifications_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigestSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigestSettingsRequest) ProtoMessage() {}
+
+func (x *GetDigestSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigestSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetDigestSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetDigestSettingsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// UpdateDigestSettingsRequest - replaces the user's digest settings
+type UpdateDigestSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Settings      *DigestSettings        `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDigestSettingsRequest) Reset() {
+	*x = UpdateDigestSettingsRequest{}
+	mi := &file_notifications_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDigestSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDigestSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateDigestSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDigestSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDigestSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateDigestSettingsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateDigestSettingsRequest) GetSettings() *DigestSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type DigestSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *DigestSettings        `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DigestSettingsResponse) Reset() {
+	*x = DigestSettingsResponse{}
+	mi := &file_notifications_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigestSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigestSettingsResponse) ProtoMessage() {}
+
+func (x *DigestSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigestSettingsResponse.ProtoReflect.Descriptor instead.
+func (*DigestSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DigestSettingsResponse) GetSettings() *DigestSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type ListTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Admin making the request
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_notifications_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListTemplatesRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type TemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []string               `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"` // e.g. email/otp, email/dynasty/join_request_sent
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplatesResponse) Reset() {
+	*x = TemplatesResponse{}
+	mi := &file_notifications_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplatesResponse) ProtoMessage() {}
+
+func (x *TemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplatesResponse.ProtoReflect.Descriptor instead.
+func (*TemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TemplatesResponse) GetTemplates() []string {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type PreviewTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Template      string                 `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	Subject       string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`                                                                     // Defaults to the template name
+	Data          map[string]string      `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Template fields; dotted keys such as Footer.Tagline fill nested fields
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewTemplateRequest) Reset() {
+	*x = PreviewTemplateRequest{}
+	mi := &file_notifications_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewTemplateRequest) ProtoMessage() {}
+
+func (x *PreviewTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewTemplateRequest.ProtoReflect.Descriptor instead.
+func (*PreviewTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PreviewTemplateRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PreviewTemplateRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *PreviewTemplateRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *PreviewTemplateRequest) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type TemplatePreviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      string                 `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	HtmlBody      string                 `protobuf:"bytes,3,opt,name=html_body,json=htmlBody,proto3" json:"html_body,omitempty"`
+	Fields        []string               `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`                                 // Top-level fields the template reads
+	SampleFields  []string               `protobuf:"bytes,5,rep,name=sample_fields,json=sampleFields,proto3" json:"sample_fields,omitempty"` // Fields not given in data and filled with sample values
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplatePreviewResponse) Reset() {
+	*x = TemplatePreviewResponse{}
+	mi := &file_notifications_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplatePreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplatePreviewResponse) ProtoMessage() {}
+
+func (x *TemplatePreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplatePreviewResponse.ProtoReflect.Descriptor instead.
+func (*TemplatePreviewResponse) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TemplatePreviewResponse) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *TemplatePreviewResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *TemplatePreviewResponse) GetHtmlBody() string {
+	if x != nil {
+		return x.HtmlBody
+	}
+	return ""
+}
+
+func (x *TemplatePreviewResponse) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *TemplatePreviewResponse) GetSampleFields() []string {
+	if x != nil {
+		return x.SampleFields
+	}
+	return nil
+}
+
+// TestSendTemplateRequest - email receives the rendered template, phone receives
+// sms_template (tokens from data) or sms_message. Preferences and digests are skipped.
+type TestSendTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Template      string                 `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	Subject       string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Data          map[string]string      `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
+	SmsTemplate   string                 `protobuf:"bytes,7,opt,name=sms_template,json=smsTemplate,proto3" json:"sms_template,omitempty"` // Kavenegar template name
+	SmsMessage    string                 `protobuf:"bytes,8,opt,name=sms_message,json=smsMessage,proto3" json:"sms_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestSendTemplateRequest) Reset() {
+	*x = TestSendTemplateRequest{}
+	mi := &file_notifications_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestSendTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestSendTemplateRequest) ProtoMessage() {}
+
+func (x *TestSendTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestSendTemplateRequest.ProtoReflect.Descriptor instead.
+func (*TestSendTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TestSendTemplateRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TestSendTemplateRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *TestSendTemplateRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *TestSendTemplateRequest) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *TestSendTemplateRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *TestSendTemplateRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *TestSendTemplateRequest) GetSmsTemplate() string {
+	if x != nil {
+		return x.SmsTemplate
+	}
+	return ""
+}
+
+func (x *TestSendTemplateRequest) GetSmsMessage() string {
+	if x != nil {
+		return x.SmsMessage
+	}
+	return ""
+}
+
+type TestSendTemplateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EmailMessageId string                 `protobuf:"bytes,1,opt,name=email_message_id,json=emailMessageId,proto3" json:"email_message_id,omitempty"`
+	SmsMessageId   string                 `protobuf:"bytes,2,opt,name=sms_message_id,json=smsMessageId,proto3" json:"sms_message_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TestSendTemplateResponse) Reset() {
+	*x = TestSendTemplateResponse{}
+	mi := &file_notifications_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestSendTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestSendTemplateResponse) ProtoMessage() {}
+
+func (x *TestSendTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestSendTemplateResponse.ProtoReflect.Descriptor instead.
+func (*TestSendTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TestSendTemplateResponse) GetEmailMessageId() string {
+	if x != nil {
+		return x.EmailMessageId
+	}
+	return ""
+}
+
+func (x *TestSendTemplateResponse) GetSmsMessageId() string {
+	if x != nil {
+		return x.SmsMessageId
+	}
+	return ""
+}
+
+type CreateBotLinkCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBotLinkCodeRequest) Reset() {
+	*x = CreateBotLinkCodeRequest{}
+	mi := &file_notifications_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBotLinkCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBotLinkCodeRequest) ProtoMessage() {}
+
+func (x *CreateBotLinkCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBotLinkCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreateBotLinkCodeRequest) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateBotLinkCodeRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// BotLinkCodeResponse - the user sends code to the bot, or opens link_url
+type BotLinkCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"` // telegram
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	LinkUrl       string                 `protobuf:"bytes,3,opt,name=link_url,json=linkUrl,proto3" json:"link_url,omitempty"`       // e.g. https://t.me/<bot>?start=<code>
+	ExpiresAt     string                 `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // RFC 3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BotLinkCodeResponse) Reset() {
+	*x = BotLinkCodeResponse{}
+	mi := &file_notifications_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BotLinkCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BotLinkCodeResponse) ProtoMessage() {}
+
+func (x *BotLinkCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BotLinkCodeResponse.ProtoReflect.Descriptor instead.
+func (*BotLinkCodeResponse) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BotLinkCodeResponse) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *BotLinkCodeResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BotLinkCodeResponse) GetLinkUrl() string {
+	if x != nil {
+		return x.LinkUrl
+	}
+	return ""
+}
+
+func (x *BotLinkCodeResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type GetBotLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBotLinkRequest) Reset() {
+	*x = GetBotLinkRequest{}
+	mi := &file_notifications_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBotLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBotLinkRequest) ProtoMessage() {}
+
+func (x *GetBotLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBotLinkRequest.ProtoReflect.Descriptor instead.
+func (*GetBotLinkRequest) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetBotLinkRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type BotLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Linked        bool                   `protobuf:"varint,1,opt,name=linked,proto3" json:"linked,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`                 // Chat username, when the user has one
+	LinkedAt      string                 `protobuf:"bytes,4,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"` // RFC 3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BotLinkResponse) Reset() {
+	*x = BotLinkResponse{}
+	mi := &file_notifications_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BotLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BotLinkResponse) ProtoMessage() {}
+
+func (x *BotLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BotLinkResponse.ProtoReflect.Descriptor instead.
+func (*BotLinkResponse) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BotLinkResponse) GetLinked() bool {
+	if x != nil {
+		return x.Linked
+	}
+	return false
+}
+
+func (x *BotLinkResponse) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *BotLinkResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *BotLinkResponse) GetLinkedAt() string {
+	if x != nil {
+		return x.LinkedAt
+	}
+	return ""
+}
+
+type UnlinkBotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkBotRequest) Reset() {
+	*x = UnlinkBotRequest{}
+	mi := &file_notifications_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkBotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkBotRequest) ProtoMessage() {}
+
+func (x *UnlinkBotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkBotRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkBotRequest) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UnlinkBotRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+var File_notifications_proto protoreflect.FileDescriptor
+
+const file_notifications_proto_rawDesc = "" +
+	"\n" +
+	"\x13notifications.proto\x12\rnotifications\x1a\fcommon.proto\"\xe7\x02\n" +
+	"\x17SendNotificationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12D\n" +
+	"\x04data\x18\x05 \x03(\v20.notifications.SendNotificationRequest.DataEntryR\x04data\x12\x19\n" +
+	"\bsend_sms\x18\x06 \x01(\bR\asendSms\x12\x1d\n" +
+	"\n" +
+	"send_email\x18\a \x01(\bR\tsendEmail\x12\x1a\n" +
+	"\bcategory\x18\b \x01(\tR\bcategory\x12\x1a\n" +
+	"\bcritical\x18\t \x01(\bR\bcritical\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb4\x01\n" +
+	"\x14NotificationResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04sent\x18\x02 \x01(\bR\x04sent\x12/\n" +
+	"\x13suppressed_channels\x18\x03 \x03(\tR\x12suppressedChannels\x12'\n" +
+	"\x0fdigest_channels\x18\x04 \x03(\tR\x0edigestChannels\x12\x1e\n" +
+	"\vsent_to_bot\x18\x05 \x01(\bR\tsentToBot\"\x8e\x01\n" +
+	"\x17GetNotificationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\x12\x1f\n" +
+	"\vunread_only\x18\x03 \x01(\bR\n" +
+	"unreadOnly\"Z\n" +
+	"\x16GetNotificationRequest\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"\x92\x01\n" +
+	"\x15NotificationsResponse\x12A\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x1b.notifications.NotificationR\rnotifications\x126\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x16.common.PaginationMetaR\n" +
+	"pagination\"\x8e\x02\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x129\n" +
+	"\x04data\x18\x05 \x03(\v2%.notifications.Notification.DataEntryR\x04data\x12\x17\n" +
+	"\aread_at\x18\x06 \x01(\tR\x06readAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"U\n" +
+	"\x11MarkAsReadRequest\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"/\n" +
+	"\x14MarkAllAsReadRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"\x8f\x02\n" +
+	"\x0eSendSMSRequest\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\btemplate\x18\x03 \x01(\tR\btemplate\x12A\n" +
+	"\x06tokens\x18\x04 \x03(\v2).notifications.SendSMSRequest.TokensEntryR\x06tokens\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x04R\x06userId\x12\x1a\n" +
+	"\bcritical\x18\x06 \x01(\bR\bcritical\x1a9\n" +
+	"\vTokensEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"X\n" +
+	"\vSMSResponse\x12\x12\n" +
+	"\x04sent\x18\x01 \x01(\bR\x04sent\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"k\n" +
+	"\x0eSendOTPRequest\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x04R\x06userId\"\x93\x02\n" +
+	"\x10SendEmailRequest\x12\x0e\n" +
+	"\x02to\x18\x01 \x01(\tR\x02to\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1b\n" +
+	"\thtml_body\x18\x04 \x01(\tR\bhtmlBody\x12\x0e\n" +
+	"\x02cc\x18\x05 \x03(\tR\x02cc\x12\x10\n" +
+	"\x03bcc\x18\x06 \x03(\tR\x03bcc\x12F\n" +
+	"\aheaders\x18\a \x03(\v2,.notifications.SendEmailRequest.HeadersEntryR\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"B\n" +
+	"\rEmailResponse\x12\x12\n" +
+	"\x04sent\x18\x01 \x01(\bR\x04sent\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\"h\n" +
+	"\x16NotificationPreference\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\"0\n" +
+	"\x15GetPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"|\n" +
+	"\x18UpdatePreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12G\n" +
+	"\vpreferences\x18\x02 \x03(\v2%.notifications.NotificationPreferenceR\vpreferences\"^\n" +
+	"\x13PreferencesResponse\x12G\n" +
+	"\vpreferences\x18\x01 \x03(\v2%.notifications.NotificationPreferenceR\vpreferences\"\x94\x01\n" +
+	"\x0eDigestSettings\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12*\n" +
+	"\x11quiet_hours_start\x18\x03 \x01(\x05R\x0fquietHoursStart\x12&\n" +
+	"\x0fquiet_hours_end\x18\x04 \x01(\x05R\rquietHoursEnd\"3\n" +
+	"\x18GetDigestSettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"q\n" +
+	"\x1bUpdateDigestSettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x129\n" +
+	"\bsettings\x18\x02 \x01(\v2\x1d.notifications.DigestSettingsR\bsettings\"S\n" +
+	"\x16DigestSettingsResponse\x129\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1d.notifications.DigestSettingsR\bsettings\"/\n" +
+	"\x14ListTemplatesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"1\n" +
+	"\x11TemplatesResponse\x12\x1c\n" +
+	"\ttemplates\x18\x01 \x03(\tR\ttemplates\"\xe5\x01\n" +
+	"\x16PreviewTemplateRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1a\n" +
+	"\btemplate\x18\x02 \x01(\tR\btemplate\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12C\n" +
+	"\x04data\x18\x04 \x03(\v2/.notifications.PreviewTemplateRequest.DataEntryR\x04data\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa9\x01\n" +
+	"\x17TemplatePreviewResponse\x12\x1a\n" +
+	"\btemplate\x18\x01 \x01(\tR\btemplate\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x1b\n" +
+	"\thtml_body\x18\x03 \x01(\tR\bhtmlBody\x12\x16\n" +
+	"\x06fields\x18\x04 \x03(\tR\x06fields\x12#\n" +
+	"\rsample_fields\x18\x05 \x03(\tR\fsampleFields\"\xd7\x02\n" +
+	"\x17TestSendTemplateRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1a\n" +
+	"\btemplate\x18\x02 \x01(\tR\btemplate\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12D\n" +
+	"\x04data\x18\x04 \x03(\v20.notifications.TestSendTemplateRequest.DataEntryR\x04data\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x06 \x01(\tR\x05phone\x12!\n" +
+	"\fsms_template\x18\a \x01(\tR\vsmsTemplate\x12\x1f\n" +
+	"\vsms_message\x18\b \x01(\tR\n" +
+	"smsMessage\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"j\n" +
+	"\x18TestSendTemplateResponse\x12(\n" +
+	"\x10email_message_id\x18\x01 \x01(\tR\x0eemailMessageId\x12$\n" +
+	"\x0esms_message_id\x18\x02 \x01(\tR\fsmsMessageId\"3\n" +
+	"\x18CreateBotLinkCodeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"\x7f\n" +
+	"\x13BotLinkCodeResponse\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x19\n" +
+	"\blink_url\x18\x03 \x01(\tR\alinkUrl\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tR\texpiresAt\",\n" +
+	"\x11GetBotLinkRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"~\n" +
+	"\x0fBotLinkResponse\x12\x16\n" +
+	"\x06linked\x18\x01 \x01(\bR\x06linked\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1b\n" +
+	"\tlinked_at\x18\x04 \x01(\tR\blinkedAt\"+\n" +
+	"\x10UnlinkBotRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId2\xb3\x03\n" +
+	"\x13NotificationService\x12_\n" +
+	"\x10SendNotification\x12&.notifications.SendNotificationRequest\x1a#.notifications.NotificationResponse\x12`\n" +
+	"\x10GetNotifications\x12&.notifications.GetNotificationsRequest\x1a$.notifications.NotificationsResponse\x12U\n" +
+	"\x0fGetNotification\x12%.notifications.GetNotificationRequest\x1a\x1b.notifications.Notification\x12=\n" +
+	"\n" +
+	"MarkAsRead\x12 .notifications.MarkAsReadRequest\x1a\r.common.Empty\x12C\n" +
+	"\rMarkAllAsRead\x12#.notifications.MarkAllAsReadRequest\x1a\r.common.Empty2\x98\x01\n" +
+	"\n" +
+	"SMSService\x12D\n" +
+	"\aSendSMS\x12\x1d.notifications.SendSMSRequest\x1a\x1a.notifications.SMSResponse\x12D\n" +
+	"\aSendOTP\x12\x1d.notifications.SendOTPRequest\x1a\x1a.notifications.SMSResponse2Z\n" +
+	"\fEmailService\x12J\n" +
+	"\tSendEmail\x12\x1f.notifications.SendEmailRequest\x1a\x1c.notifications.EmailResponse2\xad\x03\n" +
+	"\x1dNotificationPreferenceService\x12Z\n" +
+	"\x0eGetPreferences\x12$.notifications.GetPreferencesRequest\x1a\".notifications.PreferencesResponse\x12`\n" +
+	"\x11UpdatePreferences\x12'.notifications.UpdatePreferencesRequest\x1a\".notifications.PreferencesResponse\x12c\n" +
+	"\x11GetDigestSettings\x12'.notifications.GetDigestSettingsRequest\x1a%.notifications.DigestSettingsResponse\x12i\n" +
+	"\x14UpdateDigestSettings\x12*.notifications.UpdateDigestSettingsRequest\x1a%.notifications.DigestSettingsResponse2\xbc\x02\n" +
+	"\x1bNotificationTemplateService\x12V\n" +
+	"\rListTemplates\x12#.notifications.ListTemplatesRequest\x1a .notifications.TemplatesResponse\x12`\n" +
+	"\x0fPreviewTemplate\x12%.notifications.PreviewTemplateRequest\x1a&.notifications.TemplatePreviewResponse\x12c\n" +
+	"\x10TestSendTemplate\x12&.notifications.TestSendTemplateRequest\x1a'.notifications.TestSendTemplateResponse2\x87\x02\n" +
+	"\x16NotificationBotService\x12`\n" +
+	"\x11CreateBotLinkCode\x12'.notifications.CreateBotLinkCodeRequest\x1a\".notifications.BotLinkCodeResponse\x12N\n" +
+	"\n" +
+	"GetBotLink\x12 .notifications.GetBotLinkRequest\x1a\x1e.notifications.BotLinkResponse\x12;\n" +
+	"\tUnlinkBot\x12\x1f.notifications.UnlinkBotRequest\x1a\r.common.EmptyB!Z\x1fmetargb/shared/pb/notificationsb\x06proto3"
 
 var (
 	file_notifications_proto_rawDescOnce sync.Once
@@ -948,56 +2188,105 @@ func file_notifications_proto_rawDescGZIP() []byte {
 	return file_notifications_proto_rawDescData
 }
 
-var file_notifications_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_notifications_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_notifications_proto_goTypes = []any{
-	(*SendNotificationRequest)(nil),  // 0: notifications.SendNotificationRequest
-	(*NotificationResponse)(nil),     // 1: notifications.NotificationResponse
-	(*GetNotificationsRequest)(nil),  // 2: notifications.GetNotificationsRequest
-	(*GetNotificationRequest)(nil),   // 3: notifications.GetNotificationRequest
-	(*NotificationsResponse)(nil),    // 4: notifications.NotificationsResponse
-	(*Notification)(nil),             // 5: notifications.Notification
-	(*MarkAsReadRequest)(nil),        // 6: notifications.MarkAsReadRequest
-	(*MarkAllAsReadRequest)(nil),     // 7: notifications.MarkAllAsReadRequest
-	(*SendSMSRequest)(nil),           // 8: notifications.SendSMSRequest
-	(*SMSResponse)(nil),              // 9: notifications.SMSResponse
-	(*SendOTPRequest)(nil),           // 10: notifications.SendOTPRequest
-	(*SendEmailRequest)(nil),         // 11: notifications.SendEmailRequest
-	(*EmailResponse)(nil),            // 12: notifications.EmailResponse
-	nil,                              // 13: notifications.SendNotificationRequest.DataEntry
-	nil,                              // 14: notifications.Notification.DataEntry
-	nil,                              // 15: notifications.SendSMSRequest.TokensEntry
-	(*common.PaginationRequest)(nil), // 16: common.PaginationRequest
-	(*common.PaginationMeta)(nil),    // 17: common.PaginationMeta
-	(*common.Empty)(nil),             // 18: common.Empty
+	(*SendNotificationRequest)(nil),     // 0: notifications.SendNotificationRequest
+	(*NotificationResponse)(nil),        // 1: notifications.NotificationResponse
+	(*GetNotificationsRequest)(nil),     // 2: notifications.GetNotificationsRequest
+	(*GetNotificationRequest)(nil),      // 3: notifications.GetNotificationRequest
+	(*NotificationsResponse)(nil),       // 4: notifications.NotificationsResponse
+	(*Notification)(nil),                // 5: notifications.Notification
+	(*MarkAsReadRequest)(nil),           // 6: notifications.MarkAsReadRequest
+	(*MarkAllAsReadRequest)(nil),        // 7: notifications.MarkAllAsReadRequest
+	(*SendSMSRequest)(nil),              // 8: notifications.SendSMSRequest
+	(*SMSResponse)(nil),                 // 9: notifications.SMSResponse
+	(*SendOTPRequest)(nil),              // 10: notifications.SendOTPRequest
+	(*SendEmailRequest)(nil),            // 11: notifications.SendEmailRequest
+	(*EmailResponse)(nil),               // 12: notifications.EmailResponse
+	(*NotificationPreference)(nil),      // 13: notifications.NotificationPreference
+	(*GetPreferencesRequest)(nil),       // 14: notifications.GetPreferencesRequest
+	(*UpdatePreferencesRequest)(nil),    // 15: notifications.UpdatePreferencesRequest
+	(*PreferencesResponse)(nil),         // 16: notifications.PreferencesResponse
+	(*DigestSettings)(nil),              // 17: notifications.DigestSettings
+	(*GetDigestSettingsRequest)(nil),    // 18: notifications.GetDigestSettingsRequest
+	(*UpdateDigestSettingsRequest)(nil), // 19: notifications.UpdateDigestSettingsRequest
+	(*DigestSettingsResponse)(nil),      // 20: notifications.DigestSettingsResponse
+	(*ListTemplatesRequest)(nil),        // 21: notifications.ListTemplatesRequest
+	(*TemplatesResponse)(nil),           // 22: notifications.TemplatesResponse
+	(*PreviewTemplateRequest)(nil),      // 23: notifications.PreviewTemplateRequest
+	(*TemplatePreviewResponse)(nil),     // 24: notifications.TemplatePreviewResponse
+	(*TestSendTemplateRequest)(nil),     // 25: notifications.TestSendTemplateRequest
+	(*TestSendTemplateResponse)(nil),    // 26: notifications.TestSendTemplateResponse
+	(*CreateBotLinkCodeRequest)(nil),    // 27: notifications.CreateBotLinkCodeRequest
+	(*BotLinkCodeResponse)(nil),         // 28: notifications.BotLinkCodeResponse
+	(*GetBotLinkRequest)(nil),           // 29: notifications.GetBotLinkRequest
+	(*BotLinkResponse)(nil),             // 30: notifications.BotLinkResponse
+	(*UnlinkBotRequest)(nil),            // 31: notifications.UnlinkBotRequest
+	nil,                                 // 32: notifications.SendNotificationRequest.DataEntry
+	nil,                                 // 33: notifications.Notification.DataEntry
+	nil,                                 // 34: notifications.SendSMSRequest.TokensEntry
+	nil,                                 // 35: notifications.SendEmailRequest.HeadersEntry
+	nil,                                 // 36: notifications.PreviewTemplateRequest.DataEntry
+	nil,                                 // 37: notifications.TestSendTemplateRequest.DataEntry
+	(*common.PaginationRequest)(nil),    // 38: common.PaginationRequest
+	(*common.PaginationMeta)(nil),       // 39: common.PaginationMeta
+	(*common.Empty)(nil),                // 40: common.Empty
 }
 var file_notifications_proto_depIdxs = []int32{
-	13, // 0: notifications.SendNotificationRequest.data:type_name -> notifications.SendNotificationRequest.DataEntry
-	16, // 1: notifications.GetNotificationsRequest.pagination:type_name -> common.PaginationRequest
+	32, // 0: notifications.SendNotificationRequest.data:type_name -> notifications.SendNotificationRequest.DataEntry
+	38, // 1: notifications.GetNotificationsRequest.pagination:type_name -> common.PaginationRequest
 	5,  // 2: notifications.NotificationsResponse.notifications:type_name -> notifications.Notification
-	17, // 3: notifications.NotificationsResponse.pagination:type_name -> common.PaginationMeta
-	14, // 4: notifications.Notification.data:type_name -> notifications.Notification.DataEntry
-	15, // 5: notifications.SendSMSRequest.tokens:type_name -> notifications.SendSMSRequest.TokensEntry
-	0,  // 6: notifications.NotificationService.SendNotification:input_type -> notifications.SendNotificationRequest
-	2,  // 7: notifications.NotificationService.GetNotifications:input_type -> notifications.GetNotificationsRequest
-	3,  // 8: notifications.NotificationService.GetNotification:input_type -> notifications.GetNotificationRequest
-	6,  // 9: notifications.NotificationService.MarkAsRead:input_type -> notifications.MarkAsReadRequest
-	7,  // 10: notifications.NotificationService.MarkAllAsRead:input_type -> notifications.MarkAllAsReadRequest
-	8,  // 11: notifications.SMSService.SendSMS:input_type -> notifications.SendSMSRequest
-	10, // 12: notifications.SMSService.SendOTP:input_type -> notifications.SendOTPRequest
-	11, // 13: notifications.EmailService.SendEmail:input_type -> notifications.SendEmailRequest
-	1,  // 14: notifications.NotificationService.SendNotification:output_type -> notifications.NotificationResponse
-	4,  // 15: notifications.NotificationService.GetNotifications:output_type -> notifications.NotificationsResponse
-	5,  // 16: notifications.NotificationService.GetNotification:output_type -> notifications.Notification
-	18, // 17: notifications.NotificationService.MarkAsRead:output_type -> common.Empty
-	18, // 18: notifications.NotificationService.MarkAllAsRead:output_type -> common.Empty
-	9,  // 19: notifications.SMSService.SendSMS:output_type -> notifications.SMSResponse
-	9,  // 20: notifications.SMSService.SendOTP:output_type -> notifications.SMSResponse
-	12, // 21: notifications.EmailService.SendEmail:output_type -> notifications.EmailResponse
-	14, // [14:22] is the sub-list for method output_type
-	6,  // [6:14] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	39, // 3: notifications.NotificationsResponse.pagination:type_name -> common.PaginationMeta
+	33, // 4: notifications.Notification.data:type_name -> notifications.Notification.DataEntry
+	34, // 5: notifications.SendSMSRequest.tokens:type_name -> notifications.SendSMSRequest.TokensEntry
+	35, // 6: notifications.SendEmailRequest.headers:type_name -> notifications.SendEmailRequest.HeadersEntry
+	13, // 7: notifications.UpdatePreferencesRequest.preferences:type_name -> notifications.NotificationPreference
+	13, // 8: notifications.PreferencesResponse.preferences:type_name -> notifications.NotificationPreference
+	17, // 9: notifications.UpdateDigestSettingsRequest.settings:type_name -> notifications.DigestSettings
+	17, // 10: notifications.DigestSettingsResponse.settings:type_name -> notifications.DigestSettings
+	36, // 11: notifications.PreviewTemplateRequest.data:type_name -> notifications.PreviewTemplateRequest.DataEntry
+	37, // 12: notifications.TestSendTemplateRequest.data:type_name -> notifications.TestSendTemplateRequest.DataEntry
+	0,  // 13: notifications.NotificationService.SendNotification:input_type -> notifications.SendNotificationRequest
+	2,  // 14: notifications.NotificationService.GetNotifications:input_type -> notifications.GetNotificationsRequest
+	3,  // 15: notifications.NotificationService.GetNotification:input_type -> notifications.GetNotificationRequest
+	6,  // 16: notifications.NotificationService.MarkAsRead:input_type -> notifications.MarkAsReadRequest
+	7,  // 17: notifications.NotificationService.MarkAllAsRead:input_type -> notifications.MarkAllAsReadRequest
+	8,  // 18: notifications.SMSService.SendSMS:input_type -> notifications.SendSMSRequest
+	10, // 19: notifications.SMSService.SendOTP:input_type -> notifications.SendOTPRequest
+	11, // 20: notifications.EmailService.SendEmail:input_type -> notifications.SendEmailRequest
+	14, // 21: notifications.NotificationPreferenceService.GetPreferences:input_type -> notifications.GetPreferencesRequest
+	15, // 22: notifications.NotificationPreferenceService.UpdatePreferences:input_type -> notifications.UpdatePreferencesRequest
+	18, // 23: notifications.NotificationPreferenceService.GetDigestSettings:input_type -> notifications.GetDigestSettingsRequest
+	19, // 24: notifications.NotificationPreferenceService.UpdateDigestSettings:input_type -> notifications.UpdateDigestSettingsRequest
+	21, // 25: notifications.NotificationTemplateService.ListTemplates:input_type -> notifications.ListTemplatesRequest
+	23, // 26: notifications.NotificationTemplateService.PreviewTemplate:input_type -> notifications.PreviewTemplateRequest
+	25, // 27: notifications.NotificationTemplateService.TestSendTemplate:input_type -> notifications.TestSendTemplateRequest
+	27, // 28: notifications.NotificationBotService.CreateBotLinkCode:input_type -> notifications.CreateBotLinkCodeRequest
+	29, // 29: notifications.NotificationBotService.GetBotLink:input_type -> notifications.GetBotLinkRequest
+	31, // 30: notifications.NotificationBotService.UnlinkBot:input_type -> notifications.UnlinkBotRequest
+	1,  // 31: notifications.NotificationService.SendNotification:output_type -> notifications.NotificationResponse
+	4,  // 32: notifications.NotificationService.GetNotifications:output_type -> notifications.NotificationsResponse
+	5,  // 33: notifications.NotificationService.GetNotification:output_type -> notifications.Notification
+	40, // 34: notifications.NotificationService.MarkAsRead:output_type -> common.Empty
+	40, // 35: notifications.NotificationService.MarkAllAsRead:output_type -> common.Empty
+	9,  // 36: notifications.SMSService.SendSMS:output_type -> notifications.SMSResponse
+	9,  // 37: notifications.SMSService.SendOTP:output_type -> notifications.SMSResponse
+	12, // 38: notifications.EmailService.SendEmail:output_type -> notifications.EmailResponse
+	16, // 39: notifications.NotificationPreferenceService.GetPreferences:output_type -> notifications.PreferencesResponse
+	16, // 40: notifications.NotificationPreferenceService.UpdatePreferences:output_type -> notifications.PreferencesResponse
+	20, // 41: notifications.NotificationPreferenceService.GetDigestSettings:output_type -> notifications.DigestSettingsResponse
+	20, // 42: notifications.NotificationPreferenceService.UpdateDigestSettings:output_type -> notifications.DigestSettingsResponse
+	22, // 43: notifications.NotificationTemplateService.ListTemplates:output_type -> notifications.TemplatesResponse
+	24, // 44: notifications.NotificationTemplateService.PreviewTemplate:output_type -> notifications.TemplatePreviewResponse
+	26, // 45: notifications.NotificationTemplateService.TestSendTemplate:output_type -> notifications.TestSendTemplateResponse
+	28, // 46: notifications.NotificationBotService.CreateBotLinkCode:output_type -> notifications.BotLinkCodeResponse
+	30, // 47: notifications.NotificationBotService.GetBotLink:output_type -> notifications.BotLinkResponse
+	40, // 48: notifications.NotificationBotService.UnlinkBot:output_type -> common.Empty
+	31, // [31:49] is the sub-list for method output_type
+	13, // [13:31] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_notifications_proto_init() }
@@ -1011,9 +2300,9 @@ func file_notifications_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notifications_proto_rawDesc), len(file_notifications_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   38,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   6,
 		},
 		GoTypes:           file_notifications_proto_goTypes,
 		DependencyIndexes: file_notifications_proto_depIdxs,
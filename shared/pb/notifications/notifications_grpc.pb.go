@@ -526,3 +526,594 @@ var EmailService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "notifications.proto",
 }
+
+const (
+	NotificationPreferenceService_GetPreferences_FullMethodName       = "/notifications.NotificationPreferenceService/GetPreferences"
+	NotificationPreferenceService_UpdatePreferences_FullMethodName    = "/notifications.NotificationPreferenceService/UpdatePreferences"
+	NotificationPreferenceService_GetDigestSettings_FullMethodName    = "/notifications.NotificationPreferenceService/GetDigestSettings"
+	NotificationPreferenceService_UpdateDigestSettings_FullMethodName = "/notifications.NotificationPreferenceService/UpdateDigestSettings"
+)
+
+// NotificationPreferenceServiceClient is the client API for NotificationPreferenceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// NotificationPreferenceService stores per-channel, per-category opt-outs
+type NotificationPreferenceServiceClient interface {
+	GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*PreferencesResponse, error)
+	UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*PreferencesResponse, error)
+	GetDigestSettings(ctx context.Context, in *GetDigestSettingsRequest, opts ...grpc.CallOption) (*DigestSettingsResponse, error)
+	UpdateDigestSettings(ctx context.Context, in *UpdateDigestSettingsRequest, opts ...grpc.CallOption) (*DigestSettingsResponse, error)
+}
+
+type notificationPreferenceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationPreferenceServiceClient(cc grpc.ClientConnInterface) NotificationPreferenceServiceClient {
+	return &notificationPreferenceServiceClient{cc}
+}
+
+func (c *notificationPreferenceServiceClient) GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*PreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreferencesResponse)
+	err := c.cc.Invoke(ctx, NotificationPreferenceService_GetPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationPreferenceServiceClient) UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*PreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreferencesResponse)
+	err := c.cc.Invoke(ctx, NotificationPreferenceService_UpdatePreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationPreferenceServiceClient) GetDigestSettings(ctx context.Context, in *GetDigestSettingsRequest, opts ...grpc.CallOption) (*DigestSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DigestSettingsResponse)
+	err := c.cc.Invoke(ctx, NotificationPreferenceService_GetDigestSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationPreferenceServiceClient) UpdateDigestSettings(ctx context.Context, in *UpdateDigestSettingsRequest, opts ...grpc.CallOption) (*DigestSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DigestSettingsResponse)
+	err := c.cc.Invoke(ctx, NotificationPreferenceService_UpdateDigestSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationPreferenceServiceServer is the server API for NotificationPreferenceService service.
+// All implementations must embed UnimplementedNotificationPreferenceServiceServer
+// for forward compatibility.
+//
+// NotificationPreferenceService stores per-channel, per-category opt-outs
+type NotificationPreferenceServiceServer interface {
+	GetPreferences(context.Context, *GetPreferencesRequest) (*PreferencesResponse, error)
+	UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*PreferencesResponse, error)
+	GetDigestSettings(context.Context, *GetDigestSettingsRequest) (*DigestSettingsResponse, error)
+	UpdateDigestSettings(context.Context, *UpdateDigestSettingsRequest) (*DigestSettingsResponse, error)
+	mustEmbedUnimplementedNotificationPreferenceServiceServer()
+}
+
+// UnimplementedNotificationPreferenceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationPreferenceServiceServer struct{}
+
+func (UnimplementedNotificationPreferenceServiceServer) GetPreferences(context.Context, *GetPreferencesRequest) (*PreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPreferences not implemented")
+}
+func (UnimplementedNotificationPreferenceServiceServer) UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*PreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePreferences not implemented")
+}
+func (UnimplementedNotificationPreferenceServiceServer) GetDigestSettings(context.Context, *GetDigestSettingsRequest) (*DigestSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDigestSettings not implemented")
+}
+func (UnimplementedNotificationPreferenceServiceServer) UpdateDigestSettings(context.Context, *UpdateDigestSettingsRequest) (*DigestSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateDigestSettings not implemented")
+}
+func (UnimplementedNotificationPreferenceServiceServer) mustEmbedUnimplementedNotificationPreferenceServiceServer() {
+}
+func (UnimplementedNotificationPreferenceServiceServer) testEmbeddedByValue() {}
+
+// UnsafeNotificationPreferenceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationPreferenceServiceServer will
+// result in compilation errors.
+type UnsafeNotificationPreferenceServiceServer interface {
+	mustEmbedUnimplementedNotificationPreferenceServiceServer()
+}
+
+func RegisterNotificationPreferenceServiceServer(s grpc.ServiceRegistrar, srv NotificationPreferenceServiceServer) {
+	// If the following call panics, it indicates UnimplementedNotificationPreferenceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationPreferenceService_ServiceDesc, srv)
+}
+
+func _NotificationPreferenceService_GetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationPreferenceServiceServer).GetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationPreferenceService_GetPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationPreferenceServiceServer).GetPreferences(ctx, req.(*GetPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationPreferenceService_UpdatePreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationPreferenceServiceServer).UpdatePreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationPreferenceService_UpdatePreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationPreferenceServiceServer).UpdatePreferences(ctx, req.(*UpdatePreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationPreferenceService_GetDigestSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDigestSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationPreferenceServiceServer).GetDigestSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationPreferenceService_GetDigestSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationPreferenceServiceServer).GetDigestSettings(ctx, req.(*GetDigestSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationPreferenceService_UpdateDigestSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDigestSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationPreferenceServiceServer).UpdateDigestSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationPreferenceService_UpdateDigestSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationPreferenceServiceServer).UpdateDigestSettings(ctx, req.(*UpdateDigestSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationPreferenceService_ServiceDesc is the grpc.ServiceDesc for NotificationPreferenceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationPreferenceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "notifications.NotificationPreferenceService",
+	HandlerType: (*NotificationPreferenceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPreferences",
+			Handler:    _NotificationPreferenceService_GetPreferences_Handler,
+		},
+		{
+			MethodName: "UpdatePreferences",
+			Handler:    _NotificationPreferenceService_UpdatePreferences_Handler,
+		},
+		{
+			MethodName: "GetDigestSettings",
+			Handler:    _NotificationPreferenceService_GetDigestSettings_Handler,
+		},
+		{
+			MethodName: "UpdateDigestSettings",
+			Handler:    _NotificationPreferenceService_UpdateDigestSettings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "notifications.proto",
+}
+
+const (
+	NotificationTemplateService_ListTemplates_FullMethodName    = "/notifications.NotificationTemplateService/ListTemplates"
+	NotificationTemplateService_PreviewTemplate_FullMethodName  = "/notifications.NotificationTemplateService/PreviewTemplate"
+	NotificationTemplateService_TestSendTemplate_FullMethodName = "/notifications.NotificationTemplateService/TestSendTemplate"
+)
+
+// NotificationTemplateServiceClient is the client API for NotificationTemplateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// NotificationTemplateService lets notification admins preview email templates
+// and send test copies to a single phone or email address
+type NotificationTemplateServiceClient interface {
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*TemplatesResponse, error)
+	PreviewTemplate(ctx context.Context, in *PreviewTemplateRequest, opts ...grpc.CallOption) (*TemplatePreviewResponse, error)
+	TestSendTemplate(ctx context.Context, in *TestSendTemplateRequest, opts ...grpc.CallOption) (*TestSendTemplateResponse, error)
+}
+
+type notificationTemplateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationTemplateServiceClient(cc grpc.ClientConnInterface) NotificationTemplateServiceClient {
+	return &notificationTemplateServiceClient{cc}
+}
+
+func (c *notificationTemplateServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*TemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TemplatesResponse)
+	err := c.cc.Invoke(ctx, NotificationTemplateService_ListTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationTemplateServiceClient) PreviewTemplate(ctx context.Context, in *PreviewTemplateRequest, opts ...grpc.CallOption) (*TemplatePreviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TemplatePreviewResponse)
+	err := c.cc.Invoke(ctx, NotificationTemplateService_PreviewTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationTemplateServiceClient) TestSendTemplate(ctx context.Context, in *TestSendTemplateRequest, opts ...grpc.CallOption) (*TestSendTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestSendTemplateResponse)
+	err := c.cc.Invoke(ctx, NotificationTemplateService_TestSendTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationTemplateServiceServer is the server API for NotificationTemplateService service.
+// All implementations must embed UnimplementedNotificationTemplateServiceServer
+// for forward compatibility.
+//
+// NotificationTemplateService lets notification admins preview email templates
+// and send test copies to a single phone or email address
+type NotificationTemplateServiceServer interface {
+	ListTemplates(context.Context, *ListTemplatesRequest) (*TemplatesResponse, error)
+	PreviewTemplate(context.Context, *PreviewTemplateRequest) (*TemplatePreviewResponse, error)
+	TestSendTemplate(context.Context, *TestSendTemplateRequest) (*TestSendTemplateResponse, error)
+	mustEmbedUnimplementedNotificationTemplateServiceServer()
+}
+
+// UnimplementedNotificationTemplateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationTemplateServiceServer struct{}
+
+func (UnimplementedNotificationTemplateServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*TemplatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedNotificationTemplateServiceServer) PreviewTemplate(context.Context, *PreviewTemplateRequest) (*TemplatePreviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewTemplate not implemented")
+}
+func (UnimplementedNotificationTemplateServiceServer) TestSendTemplate(context.Context, *TestSendTemplateRequest) (*TestSendTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TestSendTemplate not implemented")
+}
+func (UnimplementedNotificationTemplateServiceServer) mustEmbedUnimplementedNotificationTemplateServiceServer() {
+}
+func (UnimplementedNotificationTemplateServiceServer) testEmbeddedByValue() {}
+
+// UnsafeNotificationTemplateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationTemplateServiceServer will
+// result in compilation errors.
+type UnsafeNotificationTemplateServiceServer interface {
+	mustEmbedUnimplementedNotificationTemplateServiceServer()
+}
+
+func RegisterNotificationTemplateServiceServer(s grpc.ServiceRegistrar, srv NotificationTemplateServiceServer) {
+	// If the following call panics, it indicates UnimplementedNotificationTemplateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationTemplateService_ServiceDesc, srv)
+}
+
+func _NotificationTemplateService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationTemplateServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationTemplateService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationTemplateServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationTemplateService_PreviewTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationTemplateServiceServer).PreviewTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationTemplateService_PreviewTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationTemplateServiceServer).PreviewTemplate(ctx, req.(*PreviewTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationTemplateService_TestSendTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestSendTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationTemplateServiceServer).TestSendTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationTemplateService_TestSendTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationTemplateServiceServer).TestSendTemplate(ctx, req.(*TestSendTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationTemplateService_ServiceDesc is the grpc.ServiceDesc for NotificationTemplateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationTemplateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "notifications.NotificationTemplateService",
+	HandlerType: (*NotificationTemplateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTemplates",
+			Handler:    _NotificationTemplateService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "PreviewTemplate",
+			Handler:    _NotificationTemplateService_PreviewTemplate_Handler,
+		},
+		{
+			MethodName: "TestSendTemplate",
+			Handler:    _NotificationTemplateService_TestSendTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "notifications.proto",
+}
+
+const (
+	NotificationBotService_CreateBotLinkCode_FullMethodName = "/notifications.NotificationBotService/CreateBotLinkCode"
+	NotificationBotService_GetBotLink_FullMethodName        = "/notifications.NotificationBotService/GetBotLink"
+	NotificationBotService_UnlinkBot_FullMethodName         = "/notifications.NotificationBotService/UnlinkBot"
+)
+
+// NotificationBotServiceClient is the client API for NotificationBotService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// NotificationBotService links users to a chat bot (Telegram) that receives
+// their OTPs and critical alerts when SMS cannot reach them
+type NotificationBotServiceClient interface {
+	CreateBotLinkCode(ctx context.Context, in *CreateBotLinkCodeRequest, opts ...grpc.CallOption) (*BotLinkCodeResponse, error)
+	GetBotLink(ctx context.Context, in *GetBotLinkRequest, opts ...grpc.CallOption) (*BotLinkResponse, error)
+	UnlinkBot(ctx context.Context, in *UnlinkBotRequest, opts ...grpc.CallOption) (*common.Empty, error)
+}
+
+type notificationBotServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationBotServiceClient(cc grpc.ClientConnInterface) NotificationBotServiceClient {
+	return &notificationBotServiceClient{cc}
+}
+
+func (c *notificationBotServiceClient) CreateBotLinkCode(ctx context.Context, in *CreateBotLinkCodeRequest, opts ...grpc.CallOption) (*BotLinkCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BotLinkCodeResponse)
+	err := c.cc.Invoke(ctx, NotificationBotService_CreateBotLinkCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationBotServiceClient) GetBotLink(ctx context.Context, in *GetBotLinkRequest, opts ...grpc.CallOption) (*BotLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BotLinkResponse)
+	err := c.cc.Invoke(ctx, NotificationBotService_GetBotLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationBotServiceClient) UnlinkBot(ctx context.Context, in *UnlinkBotRequest, opts ...grpc.CallOption) (*common.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, NotificationBotService_UnlinkBot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationBotServiceServer is the server API for NotificationBotService service.
+// All implementations must embed UnimplementedNotificationBotServiceServer
+// for forward compatibility.
+//
+// NotificationBotService links users to a chat bot (Telegram) that receives
+// their OTPs and critical alerts when SMS cannot reach them
+type NotificationBotServiceServer interface {
+	CreateBotLinkCode(context.Context, *CreateBotLinkCodeRequest) (*BotLinkCodeResponse, error)
+	GetBotLink(context.Context, *GetBotLinkRequest) (*BotLinkResponse, error)
+	UnlinkBot(context.Context, *UnlinkBotRequest) (*common.Empty, error)
+	mustEmbedUnimplementedNotificationBotServiceServer()
+}
+
+// UnimplementedNotificationBotServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationBotServiceServer struct{}
+
+func (UnimplementedNotificationBotServiceServer) CreateBotLinkCode(context.Context, *CreateBotLinkCodeRequest) (*BotLinkCodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBotLinkCode not implemented")
+}
+func (UnimplementedNotificationBotServiceServer) GetBotLink(context.Context, *GetBotLinkRequest) (*BotLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBotLink not implemented")
+}
+func (UnimplementedNotificationBotServiceServer) UnlinkBot(context.Context, *UnlinkBotRequest) (*common.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlinkBot not implemented")
+}
+func (UnimplementedNotificationBotServiceServer) mustEmbedUnimplementedNotificationBotServiceServer() {
+}
+func (UnimplementedNotificationBotServiceServer) testEmbeddedByValue() {}
+
+// UnsafeNotificationBotServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationBotServiceServer will
+// result in compilation errors.
+type UnsafeNotificationBotServiceServer interface {
+	mustEmbedUnimplementedNotificationBotServiceServer()
+}
+
+func RegisterNotificationBotServiceServer(s grpc.ServiceRegistrar, srv NotificationBotServiceServer) {
+	// If the following call panics, it indicates UnimplementedNotificationBotServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationBotService_ServiceDesc, srv)
+}
+
+func _NotificationBotService_CreateBotLinkCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBotLinkCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationBotServiceServer).CreateBotLinkCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationBotService_CreateBotLinkCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationBotServiceServer).CreateBotLinkCode(ctx, req.(*CreateBotLinkCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationBotService_GetBotLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBotLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationBotServiceServer).GetBotLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationBotService_GetBotLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationBotServiceServer).GetBotLink(ctx, req.(*GetBotLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationBotService_UnlinkBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkBotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationBotServiceServer).UnlinkBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationBotService_UnlinkBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationBotServiceServer).UnlinkBot(ctx, req.(*UnlinkBotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationBotService_ServiceDesc is the grpc.ServiceDesc for NotificationBotService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationBotService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "notifications.NotificationBotService",
+	HandlerType: (*NotificationBotServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBotLinkCode",
+			Handler:    _NotificationBotService_CreateBotLinkCode_Handler,
+		},
+		{
+			MethodName: "GetBotLink",
+			Handler:    _NotificationBotService_GetBotLink_Handler,
+		},
+		{
+			MethodName: "UnlinkBot",
+			Handler:    _NotificationBotService_UnlinkBot_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "notifications.proto",
+}
@@ -430,6 +430,7 @@ type TicketResponse struct {
 	Responses     []*TicketResponseItem  `protobuf:"bytes,11,rep,name=responses,proto3" json:"responses,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Jalali formatted
 	UpdatedAt     string                 `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Jalali formatted
+	Suggestions   []*TicketSuggestion    `protobuf:"bytes,14,rep,name=suggestions,proto3" json:"suggestions,omitempty"`              // only returned by CreateTicket, best match first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -555,6 +556,13 @@ func (x *TicketResponse) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *TicketResponse) GetSuggestions() []*TicketSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
 type TicketsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tickets       []*TicketResponse      `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
@@ -1942,222 +1950,2474 @@ func (x *NotesResponse) GetNotes() []*NoteResponse {
 	return nil
 }
 
-var File_support_proto protoreflect.FileDescriptor
+// Dispute Messages
+type OpenDisputeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // buyer or seller of the trade
+	TradeId       uint64                 `protobuf:"varint,2,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_support_proto_rawDesc = "" +
-	"\n" +
-	"\rsupport.proto\x12\asupport\x1a\fcommon.proto\"\xbf\x01\n" +
-	"\x13CreateTicketRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1e\n" +
-	"\n" +
-	"attachment\x18\x04 \x01(\tR\n" +
-	"attachment\x12\x1f\n" +
-	"\vreceiver_id\x18\x05 \x01(\x04R\n" +
-	"receiverId\x12\x1e\n" +
-	"\n" +
-	"department\x18\x06 \x01(\tR\n" +
-	"department\"\x9b\x01\n" +
-	"\x13UpdateTicketRequest\x12\x1b\n" +
-	"\tticket_id\x18\x01 \x01(\x04R\bticketId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x14\n" +
-	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1e\n" +
-	"\n" +
-	"attachment\x18\x05 \x01(\tR\n" +
-	"attachment\"\x86\x01\n" +
-	"\x12AddResponseRequest\x12\x1b\n" +
-	"\tticket_id\x18\x01 \x01(\x04R\bticketId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x1a\n" +
-	"\bresponse\x18\x03 \x01(\tR\bresponse\x12\x1e\n" +
-	"\n" +
-	"attachment\x18\x04 \x01(\tR\n" +
-	"attachment\"J\n" +
-	"\x12CloseTicketRequest\x12\x1b\n" +
-	"\tticket_id\x18\x01 \x01(\x04R\bticketId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x04R\x06userId\"\x8c\x01\n" +
-	"\x11GetTicketsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\x129\n" +
-	"\n" +
-	"pagination\x18\x02 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\x12#\n" +
-	"\rstatus_filter\x18\x03 \x01(\x05R\fstatusFilter\"H\n" +
-	"\x10GetTicketRequest\x12\x1b\n" +
-	"\tticket_id\x18\x01 \x01(\x04R\bticketId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x04R\x06userId\"\xaf\x03\n" +
-	"\x0eTicketResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1e\n" +
-	"\n" +
-	"attachment\x18\x04 \x01(\tR\n" +
-	"attachment\x12\x12\n" +
-	"\x04code\x18\x05 \x01(\x05R\x04code\x12\x1e\n" +
-	"\n" +
-	"department\x18\x06 \x01(\tR\n" +
-	"department\x12\x16\n" +
-	"\x06status\x18\a \x01(\x05R\x06status\x12\x1e\n" +
-	"\n" +
-	"importance\x18\b \x01(\x05R\n" +
-	"importance\x12)\n" +
-	"\x06sender\x18\t \x01(\v2\x11.common.UserBasicR\x06sender\x12-\n" +
-	"\breceiver\x18\n" +
-	" \x01(\v2\x11.common.UserBasicR\breceiver\x129\n" +
-	"\tresponses\x18\v \x03(\v2\x1b.support.TicketResponseItemR\tresponses\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\f \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\r \x01(\tR\tupdatedAt\"|\n" +
-	"\x0fTicketsResponse\x121\n" +
-	"\atickets\x18\x01 \x03(\v2\x17.support.TicketResponseR\atickets\x126\n" +
-	"\n" +
-	"pagination\x18\x02 \x01(\v2\x16.common.PaginationMetaR\n" +
-	"pagination\"\xe6\x01\n" +
-	"\x12TicketResponseItem\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
-	"\tticket_id\x18\x02 \x01(\x04R\bticketId\x12\x1a\n" +
-	"\bresponse\x18\x03 \x01(\tR\bresponse\x12\x1e\n" +
-	"\n" +
-	"attachment\x18\x04 \x01(\tR\n" +
-	"attachment\x12%\n" +
-	"\x0eresponser_name\x18\x05 \x01(\tR\rresponserName\x12!\n" +
-	"\fresponser_id\x18\x06 \x01(\x04R\vresponserId\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\a \x01(\tR\tcreatedAt\"\xb6\x01\n" +
-	"\x13CreateReportRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12'\n" +
-	"\x0freportable_type\x18\x02 \x01(\tR\x0ereportableType\x12#\n" +
-	"\rreportable_id\x18\x03 \x01(\x04R\freportableId\x12\x16\n" +
-	"\x06reason\x18\x04 \x01(\tR\x06reason\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\"g\n" +
-	"\x11GetReportsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\x129\n" +
-	"\n" +
-	"pagination\x18\x02 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\"/\n" +
-	"\x10GetReportRequest\x12\x1b\n" +
-	"\treport_id\x18\x01 \x01(\x04R\breportId\"\xe0\x01\n" +
-	"\x0eReportResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12'\n" +
-	"\x0freportable_type\x18\x03 \x01(\tR\x0ereportableType\x12#\n" +
-	"\rreportable_id\x18\x04 \x01(\x04R\freportableId\x12\x16\n" +
-	"\x06reason\x18\x05 \x01(\tR\x06reason\x12 \n" +
-	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\a \x01(\tR\tcreatedAt\"|\n" +
-	"\x0fReportsResponse\x121\n" +
-	"\areports\x18\x01 \x03(\v2\x17.support.ReportResponseR\areports\x126\n" +
-	"\n" +
-	"pagination\x18\x02 \x01(\v2\x16.common.PaginationMetaR\n" +
-	"pagination\"\x88\x01\n" +
-	"\x16CreateUserEventRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
-	"\n" +
-	"event_date\x18\x04 \x01(\tR\teventDate\"j\n" +
-	"\x14GetUserEventsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\x129\n" +
-	"\n" +
-	"pagination\x18\x02 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\"0\n" +
-	"\x13GetUserEventRequest\x12\x19\n" +
-	"\bevent_id\x18\x01 \x01(\x04R\aeventId\"\xb2\x01\n" +
-	"\x11UserEventResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x14\n" +
-	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
-	"\n" +
-	"event_date\x18\x05 \x01(\tR\teventDate\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\tR\tcreatedAt\"\x80\x01\n" +
-	"\x12UserEventsResponse\x122\n" +
-	"\x06events\x18\x01 \x03(\v2\x1a.support.UserEventResponseR\x06events\x126\n" +
-	"\n" +
-	"pagination\x18\x02 \x01(\v2\x16.common.PaginationMetaR\n" +
-	"pagination\"\xb0\x01\n" +
-	"\x16ReportUserEventRequest\x12\x19\n" +
-	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x12\x1f\n" +
-	"\vreporter_id\x18\x02 \x01(\x04R\n" +
-	"reporterId\x12-\n" +
-	"\x12suspicious_citizen\x18\x03 \x01(\tR\x11suspiciousCitizen\x12+\n" +
-	"\x11event_description\x18\x04 \x01(\tR\x10eventDescription\"\xbf\x01\n" +
-	"\x17UserEventReportResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
-	"\bevent_id\x18\x02 \x01(\x04R\aeventId\x12-\n" +
-	"\x12suspicious_citizen\x18\x03 \x01(\tR\x11suspiciousCitizen\x12+\n" +
-	"\x11event_description\x18\x04 \x01(\tR\x10eventDescription\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\"|\n" +
-	"\x1eSendEventReportResponseRequest\x12\x1b\n" +
-	"\treport_id\x18\x01 \x01(\x04R\breportId\x12!\n" +
-	"\fresponder_id\x18\x02 \x01(\x04R\vresponderId\x12\x1a\n" +
-	"\bresponse\x18\x03 \x01(\tR\bresponse\"|\n" +
-	"\x11CreateNoteRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1e\n" +
-	"\n" +
-	"attachment\x18\x04 \x01(\tR\n" +
-	"attachment\"\x95\x01\n" +
-	"\x11UpdateNoteRequest\x12\x17\n" +
-	"\anote_id\x18\x01 \x01(\x04R\x06noteId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x14\n" +
-	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1e\n" +
-	"\n" +
-	"attachment\x18\x05 \x01(\tR\n" +
-	"attachment\"*\n" +
-	"\x0fGetNotesRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\"B\n" +
-	"\x0eGetNoteRequest\x12\x17\n" +
-	"\anote_id\x18\x01 \x01(\x04R\x06noteId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x04R\x06userId\"E\n" +
-	"\x11DeleteNoteRequest\x12\x17\n" +
-	"\anote_id\x18\x01 \x01(\x04R\x06noteId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x04R\x06userId\"\x96\x01\n" +
-	"\fNoteResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1e\n" +
-	"\n" +
-	"attachment\x18\x04 \x01(\tR\n" +
-	"attachment\x12\x12\n" +
-	"\x04date\x18\x05 \x01(\tR\x04date\x12\x12\n" +
-	"\x04time\x18\x06 \x01(\tR\x04time\"<\n" +
-	"\rNotesResponse\x12+\n" +
-	"\x05notes\x18\x01 \x03(\v2\x15.support.NoteResponseR\x05notes2\xac\x03\n" +
-	"\rTicketService\x12E\n" +
-	"\fCreateTicket\x12\x1c.support.CreateTicketRequest\x1a\x17.support.TicketResponse\x12B\n" +
-	"\n" +
-	"GetTickets\x12\x1a.support.GetTicketsRequest\x1a\x18.support.TicketsResponse\x12?\n" +
-	"\tGetTicket\x12\x19.support.GetTicketRequest\x1a\x17.support.TicketResponse\x12E\n" +
-	"\fUpdateTicket\x12\x1c.support.UpdateTicketRequest\x1a\x17.support.TicketResponse\x12C\n" +
-	"\vAddResponse\x12\x1b.support.AddResponseRequest\x1a\x17.support.TicketResponse\x12C\n" +
-	"\vCloseTicket\x12\x1b.support.CloseTicketRequest\x1a\x17.support.TicketResponse2\xdb\x01\n" +
-	"\rReportService\x12E\n" +
-	"\fCreateReport\x12\x1c.support.CreateReportRequest\x1a\x17.support.ReportResponse\x12B\n" +
-	"\n" +
-	"GetReports\x12\x1a.support.GetReportsRequest\x1a\x18.support.ReportsResponse\x12?\n" +
-	"\tGetReport\x12\x19.support.GetReportRequest\x1a\x17.support.ReportResponse2\xa8\x03\n" +
-	"\x16UserEventReportService\x12N\n" +
-	"\x0fCreateUserEvent\x12\x1f.support.CreateUserEventRequest\x1a\x1a.support.UserEventResponse\x12K\n" +
-	"\rGetUserEvents\x12\x1d.support.GetUserEventsRequest\x1a\x1b.support.UserEventsResponse\x12H\n" +
-	"\fGetUserEvent\x12\x1c.support.GetUserEventRequest\x1a\x1a.support.UserEventResponse\x12T\n" +
-	"\x0fReportUserEvent\x12\x1f.support.ReportUserEventRequest\x1a .support.UserEventReportResponse\x12Q\n" +
-	"\x17SendEventReportResponse\x12'.support.SendEventReportResponseRequest\x1a\r.common.Empty2\xc1\x02\n" +
-	"\vNoteService\x12?\n" +
-	"\n" +
-	"CreateNote\x12\x1a.support.CreateNoteRequest\x1a\x15.support.NoteResponse\x12<\n" +
-	"\bGetNotes\x12\x18.support.GetNotesRequest\x1a\x16.support.NotesResponse\x129\n" +
-	"\aGetNote\x12\x17.support.GetNoteRequest\x1a\x15.support.NoteResponse\x12?\n" +
-	"\n" +
-	"UpdateNote\x12\x1a.support.UpdateNoteRequest\x1a\x15.support.NoteResponse\x127\n" +
+func (x *OpenDisputeRequest) Reset() {
+	*x = OpenDisputeRequest{}
+	mi := &file_support_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenDisputeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenDisputeRequest) ProtoMessage() {}
+
+func (x *OpenDisputeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenDisputeRequest.ProtoReflect.Descriptor instead.
+func (*OpenDisputeRequest) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *OpenDisputeRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *OpenDisputeRequest) GetTradeId() uint64 {
+	if x != nil {
+		return x.TradeId
+	}
+	return 0
+}
+
+func (x *OpenDisputeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OpenDisputeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ListDisputesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // support agents get all open disputes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDisputesRequest) Reset() {
+	*x = ListDisputesRequest{}
+	mi := &file_support_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDisputesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDisputesRequest) ProtoMessage() {}
+
+func (x *ListDisputesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDisputesRequest.ProtoReflect.Descriptor instead.
+func (*ListDisputesRequest) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListDisputesRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetDisputeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DisputeId     uint64                 `protobuf:"varint,1,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // for authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDisputeRequest) Reset() {
+	*x = GetDisputeRequest{}
+	mi := &file_support_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDisputeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDisputeRequest) ProtoMessage() {}
+
+func (x *GetDisputeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDisputeRequest.ProtoReflect.Descriptor instead.
+func (*GetDisputeRequest) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetDisputeRequest) GetDisputeId() uint64 {
+	if x != nil {
+		return x.DisputeId
+	}
+	return 0
+}
+
+func (x *GetDisputeRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ResolveDisputeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DisputeId     uint64                 `protobuf:"varint,1,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+	AgentId       uint64                 `protobuf:"varint,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Outcome       string                 `protobuf:"bytes,3,opt,name=outcome,proto3" json:"outcome,omitempty"` // "refund" or "uphold"
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveDisputeRequest) Reset() {
+	*x = ResolveDisputeRequest{}
+	mi := &file_support_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveDisputeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveDisputeRequest) ProtoMessage() {}
+
+func (x *ResolveDisputeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveDisputeRequest.ProtoReflect.Descriptor instead.
+func (*ResolveDisputeRequest) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ResolveDisputeRequest) GetDisputeId() uint64 {
+	if x != nil {
+		return x.DisputeId
+	}
+	return 0
+}
+
+func (x *ResolveDisputeRequest) GetAgentId() uint64 {
+	if x != nil {
+		return x.AgentId
+	}
+	return 0
+}
+
+func (x *ResolveDisputeRequest) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *ResolveDisputeRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type DisputeResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TradeId        uint64                 `protobuf:"varint,2,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	FeatureId      uint64                 `protobuf:"varint,3,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	PropertiesId   string                 `protobuf:"bytes,4,opt,name=properties_id,json=propertiesId,proto3" json:"properties_id,omitempty"`
+	BuyerId        uint64                 `protobuf:"varint,5,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	SellerId       uint64                 `protobuf:"varint,6,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	OpenedBy       uint64                 `protobuf:"varint,7,opt,name=opened_by,json=openedBy,proto3" json:"opened_by,omitempty"`
+	TicketId       uint64                 `protobuf:"varint,8,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	Description    string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	FundsFrozen    bool                   `protobuf:"varint,11,opt,name=funds_frozen,json=fundsFrozen,proto3" json:"funds_frozen,omitempty"`
+	Status         string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`   // "open" or "resolved"
+	Outcome        string                 `protobuf:"bytes,13,opt,name=outcome,proto3" json:"outcome,omitempty"` // empty while open
+	ResolutionNote string                 `protobuf:"bytes,14,opt,name=resolution_note,json=resolutionNote,proto3" json:"resolution_note,omitempty"`
+	Date           string                 `protobuf:"bytes,15,opt,name=date,proto3" json:"date,omitempty"`                                     // Jalali formatted date (Y/m/d)
+	Time           string                 `protobuf:"bytes,16,opt,name=time,proto3" json:"time,omitempty"`                                     // Jalali formatted time (H:m:s)
+	ResolvedDate   string                 `protobuf:"bytes,17,opt,name=resolved_date,json=resolvedDate,proto3" json:"resolved_date,omitempty"` // Jalali formatted date, empty while open
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DisputeResponse) Reset() {
+	*x = DisputeResponse{}
+	mi := &file_support_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisputeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisputeResponse) ProtoMessage() {}
+
+func (x *DisputeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisputeResponse.ProtoReflect.Descriptor instead.
+func (*DisputeResponse) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DisputeResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DisputeResponse) GetTradeId() uint64 {
+	if x != nil {
+		return x.TradeId
+	}
+	return 0
+}
+
+func (x *DisputeResponse) GetFeatureId() uint64 {
+	if x != nil {
+		return x.FeatureId
+	}
+	return 0
+}
+
+func (x *DisputeResponse) GetPropertiesId() string {
+	if x != nil {
+		return x.PropertiesId
+	}
+	return ""
+}
+
+func (x *DisputeResponse) GetBuyerId() uint64 {
+	if x != nil {
+		return x.BuyerId
+	}
+	return 0
+}
+
+func (x *DisputeResponse) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *DisputeResponse) GetOpenedBy() uint64 {
+	if x != nil {
+		return x.OpenedBy
+	}
+	return 0
+}
+
+func (x *DisputeResponse) GetTicketId() uint64 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *DisputeResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DisputeResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DisputeResponse) GetFundsFrozen() bool {
+	if x != nil {
+		return x.FundsFrozen
+	}
+	return false
+}
+
+func (x *DisputeResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DisputeResponse) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *DisputeResponse) GetResolutionNote() string {
+	if x != nil {
+		return x.ResolutionNote
+	}
+	return ""
+}
+
+func (x *DisputeResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DisputeResponse) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *DisputeResponse) GetResolvedDate() string {
+	if x != nil {
+		return x.ResolvedDate
+	}
+	return ""
+}
+
+type DisputesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Disputes      []*DisputeResponse     `protobuf:"bytes,1,rep,name=disputes,proto3" json:"disputes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisputesResponse) Reset() {
+	*x = DisputesResponse{}
+	mi := &file_support_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisputesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisputesResponse) ProtoMessage() {}
+
+func (x *DisputesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisputesResponse.ProtoReflect.Descriptor instead.
+func (*DisputesResponse) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DisputesResponse) GetDisputes() []*DisputeResponse {
+	if x != nil {
+		return x.Disputes
+	}
+	return nil
+}
+
+// Ticket Email Messages
+type IngestEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RawMessage    []byte                 `protobuf:"bytes,1,opt,name=raw_message,json=rawMessage,proto3" json:"raw_message,omitempty"`       // RFC 5322 message as received by the mail provider
+	WebhookToken  string                 `protobuf:"bytes,2,opt,name=webhook_token,json=webhookToken,proto3" json:"webhook_token,omitempty"` // shared secret configured with the mail provider
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestEmailRequest) Reset() {
+	*x = IngestEmailRequest{}
+	mi := &file_support_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestEmailRequest) ProtoMessage() {}
+
+func (x *IngestEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestEmailRequest.ProtoReflect.Descriptor instead.
+func (*IngestEmailRequest) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *IngestEmailRequest) GetRawMessage() []byte {
+	if x != nil {
+		return x.RawMessage
+	}
+	return nil
+}
+
+func (x *IngestEmailRequest) GetWebhookToken() string {
+	if x != nil {
+		return x.WebhookToken
+	}
+	return ""
+}
+
+type IngestEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`                      // "created", "replied", "duplicate" or "ignored"
+	TicketId      uint64                 `protobuf:"varint,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"` // empty when ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestEmailResponse) Reset() {
+	*x = IngestEmailResponse{}
+	mi := &file_support_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestEmailResponse) ProtoMessage() {}
+
+func (x *IngestEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestEmailResponse.ProtoReflect.Descriptor instead.
+func (*IngestEmailResponse) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *IngestEmailResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *IngestEmailResponse) GetTicketId() uint64 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+// Support Stats Messages
+type GetSupportStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // must be a support agent
+	From          int64                  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`                   // unix seconds, inclusive
+	To            int64                  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`                       // unix seconds, exclusive; at most 366 days after from
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSupportStatsRequest) Reset() {
+	*x = GetSupportStatsRequest{}
+	mi := &file_support_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupportStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportStatsRequest) ProtoMessage() {}
+
+func (x *GetSupportStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetSupportStatsRequest) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetSupportStatsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetSupportStatsRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *GetSupportStatsRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type SupportStatusCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // e.g. "new" or "closed"
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupportStatusCount) Reset() {
+	*x = SupportStatusCount{}
+	mi := &file_support_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportStatusCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportStatusCount) ProtoMessage() {}
+
+func (x *SupportStatusCount) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportStatusCount.ProtoReflect.Descriptor instead.
+func (*SupportStatusCount) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SupportStatusCount) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SupportStatusCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SupportStatusCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SupportDepartmentCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Department    string                 `protobuf:"bytes,1,opt,name=department,proto3" json:"department,omitempty"` // empty for tickets sent to a user instead of a department
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`           // Persian department title
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupportDepartmentCount) Reset() {
+	*x = SupportDepartmentCount{}
+	mi := &file_support_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportDepartmentCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportDepartmentCount) ProtoMessage() {}
+
+func (x *SupportDepartmentCount) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportDepartmentCount.ProtoReflect.Descriptor instead.
+func (*SupportDepartmentCount) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SupportDepartmentCount) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *SupportDepartmentCount) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SupportDepartmentCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// SupportStatsResponse covers the tickets created in [from, to)
+type SupportStatsResponse struct {
+	state                   protoimpl.MessageState    `protogen:"open.v1"`
+	From                    int64                     `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                      int64                     `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	TotalTickets            int64                     `protobuf:"varint,3,opt,name=total_tickets,json=totalTickets,proto3" json:"total_tickets,omitempty"`
+	ByStatus                []*SupportStatusCount     `protobuf:"bytes,4,rep,name=by_status,json=byStatus,proto3" json:"by_status,omitempty"`
+	ByDepartment            []*SupportDepartmentCount `protobuf:"bytes,5,rep,name=by_department,json=byDepartment,proto3" json:"by_department,omitempty"`
+	RespondedTickets        int64                     `protobuf:"varint,6,opt,name=responded_tickets,json=respondedTickets,proto3" json:"responded_tickets,omitempty"` // tickets answered by someone other than their sender
+	AvgFirstResponseSeconds float64                   `protobuf:"fixed64,7,opt,name=avg_first_response_seconds,json=avgFirstResponseSeconds,proto3" json:"avg_first_response_seconds,omitempty"`
+	ResolvedTickets         int64                     `protobuf:"varint,8,opt,name=resolved_tickets,json=resolvedTickets,proto3" json:"resolved_tickets,omitempty"` // tickets resolved or closed
+	AvgResolutionSeconds    float64                   `protobuf:"fixed64,9,opt,name=avg_resolution_seconds,json=avgResolutionSeconds,proto3" json:"avg_resolution_seconds,omitempty"`
+	GeneratedAt             int64                     `protobuf:"varint,10,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`                // unix seconds the figures were computed, they are cached for a few minutes
+	SuggestedTickets        int64                     `protobuf:"varint,11,opt,name=suggested_tickets,json=suggestedTickets,proto3" json:"suggested_tickets,omitempty"` // tickets that were shown FAQ or training suggestions
+	DeflectedTickets        int64                     `protobuf:"varint,12,opt,name=deflected_tickets,json=deflectedTickets,proto3" json:"deflected_tickets,omitempty"` // tickets closed by their sender accepting a suggestion
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *SupportStatsResponse) Reset() {
+	*x = SupportStatsResponse{}
+	mi := &file_support_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportStatsResponse) ProtoMessage() {}
+
+func (x *SupportStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportStatsResponse.ProtoReflect.Descriptor instead.
+func (*SupportStatsResponse) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SupportStatsResponse) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *SupportStatsResponse) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *SupportStatsResponse) GetTotalTickets() int64 {
+	if x != nil {
+		return x.TotalTickets
+	}
+	return 0
+}
+
+func (x *SupportStatsResponse) GetByStatus() []*SupportStatusCount {
+	if x != nil {
+		return x.ByStatus
+	}
+	return nil
+}
+
+func (x *SupportStatsResponse) GetByDepartment() []*SupportDepartmentCount {
+	if x != nil {
+		return x.ByDepartment
+	}
+	return nil
+}
+
+func (x *SupportStatsResponse) GetRespondedTickets() int64 {
+	if x != nil {
+		return x.RespondedTickets
+	}
+	return 0
+}
+
+func (x *SupportStatsResponse) GetAvgFirstResponseSeconds() float64 {
+	if x != nil {
+		return x.AvgFirstResponseSeconds
+	}
+	return 0
+}
+
+func (x *SupportStatsResponse) GetResolvedTickets() int64 {
+	if x != nil {
+		return x.ResolvedTickets
+	}
+	return 0
+}
+
+func (x *SupportStatsResponse) GetAvgResolutionSeconds() float64 {
+	if x != nil {
+		return x.AvgResolutionSeconds
+	}
+	return 0
+}
+
+func (x *SupportStatsResponse) GetGeneratedAt() int64 {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return 0
+}
+
+func (x *SupportStatsResponse) GetSuggestedTickets() int64 {
+	if x != nil {
+		return x.SuggestedTickets
+	}
+	return 0
+}
+
+func (x *SupportStatsResponse) GetDeflectedTickets() int64 {
+	if x != nil {
+		return x.DeflectedTickets
+	}
+	return 0
+}
+
+// Support Chat Messages
+type StartChatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      uint64                 `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // the ticket sender or a support agent
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartChatRequest) Reset() {
+	*x = StartChatRequest{}
+	mi := &file_support_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartChatRequest) ProtoMessage() {}
+
+func (x *StartChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartChatRequest.ProtoReflect.Descriptor instead.
+func (*StartChatRequest) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *StartChatRequest) GetTicketId() uint64 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *StartChatRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetChatRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChatId          uint64                 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	UserId          uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // for authorization
+	IncludeMessages bool                   `protobuf:"varint,3,opt,name=include_messages,json=includeMessages,proto3" json:"include_messages,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetChatRequest) Reset() {
+	*x = GetChatRequest{}
+	mi := &file_support_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatRequest) ProtoMessage() {}
+
+func (x *GetChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatRequest.ProtoReflect.Descriptor instead.
+func (*GetChatRequest) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetChatRequest) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *GetChatRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetChatRequest) GetIncludeMessages() bool {
+	if x != nil {
+		return x.IncludeMessages
+	}
+	return false
+}
+
+type ListChatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // support agents get all open chats
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChatsRequest) Reset() {
+	*x = ListChatsRequest{}
+	mi := &file_support_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChatsRequest) ProtoMessage() {}
+
+func (x *ListChatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChatsRequest.ProtoReflect.Descriptor instead.
+func (*ListChatsRequest) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListChatsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type SendChatMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatId        uint64                 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendChatMessageRequest) Reset() {
+	*x = SendChatMessageRequest{}
+	mi := &file_support_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendChatMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatMessageRequest) ProtoMessage() {}
+
+func (x *SendChatMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendChatMessageRequest) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SendChatMessageRequest) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *SendChatMessageRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SendChatMessageRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type CloseChatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatId        uint64                 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseChatRequest) Reset() {
+	*x = CloseChatRequest{}
+	mi := &file_support_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseChatRequest) ProtoMessage() {}
+
+func (x *CloseChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseChatRequest.ProtoReflect.Descriptor instead.
+func (*CloseChatRequest) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CloseChatRequest) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *CloseChatRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ExportChatTranscriptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatId        uint64                 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportChatTranscriptRequest) Reset() {
+	*x = ExportChatTranscriptRequest{}
+	mi := &file_support_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportChatTranscriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChatTranscriptRequest) ProtoMessage() {}
+
+func (x *ExportChatTranscriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChatTranscriptRequest.ProtoReflect.Descriptor instead.
+func (*ExportChatTranscriptRequest) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ExportChatTranscriptRequest) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *ExportChatTranscriptRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ChatMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChatId        uint64                 `protobuf:"varint,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	SenderId      uint64                 `protobuf:"varint,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	FromAgent     bool                   `protobuf:"varint,4,opt,name=from_agent,json=fromAgent,proto3" json:"from_agent,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Date          string                 `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`                             // Jalali formatted date (Y/m/d)
+	Time          string                 `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`                             // Jalali formatted time (H:m:s)
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // unix seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessageResponse) Reset() {
+	*x = ChatMessageResponse{}
+	mi := &file_support_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessageResponse) ProtoMessage() {}
+
+func (x *ChatMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessageResponse.ProtoReflect.Descriptor instead.
+func (*ChatMessageResponse) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ChatMessageResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChatMessageResponse) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *ChatMessageResponse) GetSenderId() uint64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *ChatMessageResponse) GetFromAgent() bool {
+	if x != nil {
+		return x.FromAgent
+	}
+	return false
+}
+
+func (x *ChatMessageResponse) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ChatMessageResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ChatMessageResponse) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *ChatMessageResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ChatSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TicketId      uint64                 `protobuf:"varint,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`            // the ticket sender
+	AgentId       uint64                 `protobuf:"varint,4,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`         // the first agent to reply, 0 until then
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                           // "open" or "closed"
+	Room          string                 `protobuf:"bytes,6,opt,name=room,proto3" json:"room,omitempty"`                               // websocket gateway room delivering the chat's events
+	Date          string                 `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"`                               // Jalali formatted date (Y/m/d)
+	Time          string                 `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"`                               // Jalali formatted time (H:m:s)
+	ClosedDate    string                 `protobuf:"bytes,9,opt,name=closed_date,json=closedDate,proto3" json:"closed_date,omitempty"` // Jalali formatted date, empty while open
+	Messages      []*ChatMessageResponse `protobuf:"bytes,10,rep,name=messages,proto3" json:"messages,omitempty"`                      // oldest first, only when requested
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatSessionResponse) Reset() {
+	*x = ChatSessionResponse{}
+	mi := &file_support_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatSessionResponse) ProtoMessage() {}
+
+func (x *ChatSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatSessionResponse.ProtoReflect.Descriptor instead.
+func (*ChatSessionResponse) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ChatSessionResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChatSessionResponse) GetTicketId() uint64 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *ChatSessionResponse) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ChatSessionResponse) GetAgentId() uint64 {
+	if x != nil {
+		return x.AgentId
+	}
+	return 0
+}
+
+func (x *ChatSessionResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ChatSessionResponse) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *ChatSessionResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ChatSessionResponse) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *ChatSessionResponse) GetClosedDate() string {
+	if x != nil {
+		return x.ClosedDate
+	}
+	return ""
+}
+
+func (x *ChatSessionResponse) GetMessages() []*ChatMessageResponse {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type ChatSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chats         []*ChatSessionResponse `protobuf:"bytes,1,rep,name=chats,proto3" json:"chats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatSessionsResponse) Reset() {
+	*x = ChatSessionsResponse{}
+	mi := &file_support_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatSessionsResponse) ProtoMessage() {}
+
+func (x *ChatSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ChatSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ChatSessionsResponse) GetChats() []*ChatSessionResponse {
+	if x != nil {
+		return x.Chats
+	}
+	return nil
+}
+
+type ChatTranscriptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"` // UTF-8 plain text
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatTranscriptResponse) Reset() {
+	*x = ChatTranscriptResponse{}
+	mi := &file_support_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatTranscriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatTranscriptResponse) ProtoMessage() {}
+
+func (x *ChatTranscriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatTranscriptResponse.ProtoReflect.Descriptor instead.
+func (*ChatTranscriptResponse) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ChatTranscriptResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ChatTranscriptResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// FAQ Messages
+type ListFaqsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Department    string                 `protobuf:"bytes,1,opt,name=department,proto3" json:"department,omitempty"` // optional, FAQs of the department and general FAQs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFaqsRequest) Reset() {
+	*x = ListFaqsRequest{}
+	mi := &file_support_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFaqsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFaqsRequest) ProtoMessage() {}
+
+func (x *ListFaqsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFaqsRequest.ProtoReflect.Descriptor instead.
+func (*ListFaqsRequest) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListFaqsRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+type CreateFaqRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // must be a support agent
+	Question      string                 `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	Answer        string                 `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+	Department    string                 `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"` // optional, empty for every department
+	Keywords      string                 `protobuf:"bytes,5,opt,name=keywords,proto3" json:"keywords,omitempty"`     // optional, comma separated extra terms to match
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFaqRequest) Reset() {
+	*x = CreateFaqRequest{}
+	mi := &file_support_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFaqRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFaqRequest) ProtoMessage() {}
+
+func (x *CreateFaqRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFaqRequest.ProtoReflect.Descriptor instead.
+func (*CreateFaqRequest) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateFaqRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateFaqRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *CreateFaqRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *CreateFaqRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *CreateFaqRequest) GetKeywords() string {
+	if x != nil {
+		return x.Keywords
+	}
+	return ""
+}
+
+type UpdateFaqRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FaqId         uint64                 `protobuf:"varint,1,opt,name=faq_id,json=faqId,proto3" json:"faq_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // must be a support agent
+	Question      string                 `protobuf:"bytes,3,opt,name=question,proto3" json:"question,omitempty"`
+	Answer        string                 `protobuf:"bytes,4,opt,name=answer,proto3" json:"answer,omitempty"`
+	Department    string                 `protobuf:"bytes,5,opt,name=department,proto3" json:"department,omitempty"`
+	Keywords      string                 `protobuf:"bytes,6,opt,name=keywords,proto3" json:"keywords,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFaqRequest) Reset() {
+	*x = UpdateFaqRequest{}
+	mi := &file_support_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFaqRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFaqRequest) ProtoMessage() {}
+
+func (x *UpdateFaqRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFaqRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFaqRequest) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdateFaqRequest) GetFaqId() uint64 {
+	if x != nil {
+		return x.FaqId
+	}
+	return 0
+}
+
+func (x *UpdateFaqRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateFaqRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *UpdateFaqRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *UpdateFaqRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *UpdateFaqRequest) GetKeywords() string {
+	if x != nil {
+		return x.Keywords
+	}
+	return ""
+}
+
+type DeleteFaqRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FaqId         uint64                 `protobuf:"varint,1,opt,name=faq_id,json=faqId,proto3" json:"faq_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // must be a support agent
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFaqRequest) Reset() {
+	*x = DeleteFaqRequest{}
+	mi := &file_support_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFaqRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFaqRequest) ProtoMessage() {}
+
+func (x *DeleteFaqRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFaqRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFaqRequest) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteFaqRequest) GetFaqId() uint64 {
+	if x != nil {
+		return x.FaqId
+	}
+	return 0
+}
+
+func (x *DeleteFaqRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type FaqResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Question      string                 `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	Answer        string                 `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+	Department    string                 `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"`
+	Keywords      string                 `protobuf:"bytes,5,opt,name=keywords,proto3" json:"keywords,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Jalali formatted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FaqResponse) Reset() {
+	*x = FaqResponse{}
+	mi := &file_support_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FaqResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaqResponse) ProtoMessage() {}
+
+func (x *FaqResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaqResponse.ProtoReflect.Descriptor instead.
+func (*FaqResponse) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *FaqResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FaqResponse) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *FaqResponse) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *FaqResponse) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *FaqResponse) GetKeywords() string {
+	if x != nil {
+		return x.Keywords
+	}
+	return ""
+}
+
+func (x *FaqResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type FaqsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Faqs          []*FaqResponse         `protobuf:"bytes,1,rep,name=faqs,proto3" json:"faqs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FaqsResponse) Reset() {
+	*x = FaqsResponse{}
+	mi := &file_support_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FaqsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaqsResponse) ProtoMessage() {}
+
+func (x *FaqsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaqsResponse.ProtoReflect.Descriptor instead.
+func (*FaqsResponse) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *FaqsResponse) GetFaqs() []*FaqResponse {
+	if x != nil {
+		return x.Faqs
+	}
+	return nil
+}
+
+// TicketSuggestion is an FAQ or training video matched to a new ticket
+type TicketSuggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TicketId      uint64                 `protobuf:"varint,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"` // "faq" or "training_video"
+	SourceId      uint64                 `protobuf:"varint,4,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`   // FAQ question or video title
+	Body          string                 `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`     // FAQ answer or the start of the video description
+	Slug          string                 `protobuf:"bytes,7,opt,name=slug,proto3" json:"slug,omitempty"`     // video slug, empty for FAQs
+	Score         float64                `protobuf:"fixed64,8,opt,name=score,proto3" json:"score,omitempty"` // 0 to 1
+	Accepted      bool                   `protobuf:"varint,9,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketSuggestion) Reset() {
+	*x = TicketSuggestion{}
+	mi := &file_support_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketSuggestion) ProtoMessage() {}
+
+func (x *TicketSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketSuggestion.ProtoReflect.Descriptor instead.
+func (*TicketSuggestion) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TicketSuggestion) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TicketSuggestion) GetTicketId() uint64 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *TicketSuggestion) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TicketSuggestion) GetSourceId() uint64 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *TicketSuggestion) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TicketSuggestion) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *TicketSuggestion) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *TicketSuggestion) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TicketSuggestion) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+type AcceptTicketSuggestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      uint64                 `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	SuggestionId  uint64                 `protobuf:"varint,2,opt,name=suggestion_id,json=suggestionId,proto3" json:"suggestion_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // must be the ticket sender
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTicketSuggestionRequest) Reset() {
+	*x = AcceptTicketSuggestionRequest{}
+	mi := &file_support_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTicketSuggestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTicketSuggestionRequest) ProtoMessage() {}
+
+func (x *AcceptTicketSuggestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_support_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTicketSuggestionRequest.ProtoReflect.Descriptor instead.
+func (*AcceptTicketSuggestionRequest) Descriptor() ([]byte, []int) {
+	return file_support_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AcceptTicketSuggestionRequest) GetTicketId() uint64 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *AcceptTicketSuggestionRequest) GetSuggestionId() uint64 {
+	if x != nil {
+		return x.SuggestionId
+	}
+	return 0
+}
+
+func (x *AcceptTicketSuggestionRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+var File_support_proto protoreflect.FileDescriptor
+
+const file_support_proto_rawDesc = "" +
+	"\n" +
+	"\rsupport.proto\x12\asupport\x1a\fcommon.proto\"\xbf\x01\n" +
+	"\x13CreateTicketRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1e\n" +
+	"\n" +
+	"attachment\x18\x04 \x01(\tR\n" +
+	"attachment\x12\x1f\n" +
+	"\vreceiver_id\x18\x05 \x01(\x04R\n" +
+	"receiverId\x12\x1e\n" +
+	"\n" +
+	"department\x18\x06 \x01(\tR\n" +
+	"department\"\x9b\x01\n" +
+	"\x13UpdateTicketRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x04R\bticketId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1e\n" +
+	"\n" +
+	"attachment\x18\x05 \x01(\tR\n" +
+	"attachment\"\x86\x01\n" +
+	"\x12AddResponseRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x04R\bticketId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x1a\n" +
+	"\bresponse\x18\x03 \x01(\tR\bresponse\x12\x1e\n" +
+	"\n" +
+	"attachment\x18\x04 \x01(\tR\n" +
+	"attachment\"J\n" +
+	"\x12CloseTicketRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x04R\bticketId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"\x8c\x01\n" +
+	"\x11GetTicketsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\x12#\n" +
+	"\rstatus_filter\x18\x03 \x01(\x05R\fstatusFilter\"H\n" +
+	"\x10GetTicketRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x04R\bticketId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"\xec\x03\n" +
+	"\x0eTicketResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1e\n" +
+	"\n" +
+	"attachment\x18\x04 \x01(\tR\n" +
+	"attachment\x12\x12\n" +
+	"\x04code\x18\x05 \x01(\x05R\x04code\x12\x1e\n" +
+	"\n" +
+	"department\x18\x06 \x01(\tR\n" +
+	"department\x12\x16\n" +
+	"\x06status\x18\a \x01(\x05R\x06status\x12\x1e\n" +
+	"\n" +
+	"importance\x18\b \x01(\x05R\n" +
+	"importance\x12)\n" +
+	"\x06sender\x18\t \x01(\v2\x11.common.UserBasicR\x06sender\x12-\n" +
+	"\breceiver\x18\n" +
+	" \x01(\v2\x11.common.UserBasicR\breceiver\x129\n" +
+	"\tresponses\x18\v \x03(\v2\x1b.support.TicketResponseItemR\tresponses\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\tR\tupdatedAt\x12;\n" +
+	"\vsuggestions\x18\x0e \x03(\v2\x19.support.TicketSuggestionR\vsuggestions\"|\n" +
+	"\x0fTicketsResponse\x121\n" +
+	"\atickets\x18\x01 \x03(\v2\x17.support.TicketResponseR\atickets\x126\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x16.common.PaginationMetaR\n" +
+	"pagination\"\xe6\x01\n" +
+	"\x12TicketResponseItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\x04R\bticketId\x12\x1a\n" +
+	"\bresponse\x18\x03 \x01(\tR\bresponse\x12\x1e\n" +
+	"\n" +
+	"attachment\x18\x04 \x01(\tR\n" +
+	"attachment\x12%\n" +
+	"\x0eresponser_name\x18\x05 \x01(\tR\rresponserName\x12!\n" +
+	"\fresponser_id\x18\x06 \x01(\x04R\vresponserId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"\xb6\x01\n" +
+	"\x13CreateReportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12'\n" +
+	"\x0freportable_type\x18\x02 \x01(\tR\x0ereportableType\x12#\n" +
+	"\rreportable_id\x18\x03 \x01(\x04R\freportableId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"g\n" +
+	"\x11GetReportsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\"/\n" +
+	"\x10GetReportRequest\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\x04R\breportId\"\xe0\x01\n" +
+	"\x0eReportResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12'\n" +
+	"\x0freportable_type\x18\x03 \x01(\tR\x0ereportableType\x12#\n" +
+	"\rreportable_id\x18\x04 \x01(\x04R\freportableId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"|\n" +
+	"\x0fReportsResponse\x121\n" +
+	"\areports\x18\x01 \x03(\v2\x17.support.ReportResponseR\areports\x126\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x16.common.PaginationMetaR\n" +
+	"pagination\"\x88\x01\n" +
+	"\x16CreateUserEventRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"event_date\x18\x04 \x01(\tR\teventDate\"j\n" +
+	"\x14GetUserEventsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\"0\n" +
+	"\x13GetUserEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x04R\aeventId\"\xb2\x01\n" +
+	"\x11UserEventResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"event_date\x18\x05 \x01(\tR\teventDate\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"\x80\x01\n" +
+	"\x12UserEventsResponse\x122\n" +
+	"\x06events\x18\x01 \x03(\v2\x1a.support.UserEventResponseR\x06events\x126\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x16.common.PaginationMetaR\n" +
+	"pagination\"\xb0\x01\n" +
+	"\x16ReportUserEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x12\x1f\n" +
+	"\vreporter_id\x18\x02 \x01(\x04R\n" +
+	"reporterId\x12-\n" +
+	"\x12suspicious_citizen\x18\x03 \x01(\tR\x11suspiciousCitizen\x12+\n" +
+	"\x11event_description\x18\x04 \x01(\tR\x10eventDescription\"\xbf\x01\n" +
+	"\x17UserEventReportResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\x04R\aeventId\x12-\n" +
+	"\x12suspicious_citizen\x18\x03 \x01(\tR\x11suspiciousCitizen\x12+\n" +
+	"\x11event_description\x18\x04 \x01(\tR\x10eventDescription\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"|\n" +
+	"\x1eSendEventReportResponseRequest\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\x04R\breportId\x12!\n" +
+	"\fresponder_id\x18\x02 \x01(\x04R\vresponderId\x12\x1a\n" +
+	"\bresponse\x18\x03 \x01(\tR\bresponse\"|\n" +
+	"\x11CreateNoteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1e\n" +
+	"\n" +
+	"attachment\x18\x04 \x01(\tR\n" +
+	"attachment\"\x95\x01\n" +
+	"\x11UpdateNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\x04R\x06noteId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1e\n" +
+	"\n" +
+	"attachment\x18\x05 \x01(\tR\n" +
+	"attachment\"*\n" +
+	"\x0fGetNotesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"B\n" +
+	"\x0eGetNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\x04R\x06noteId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"E\n" +
+	"\x11DeleteNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\x04R\x06noteId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"\x96\x01\n" +
+	"\fNoteResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1e\n" +
+	"\n" +
+	"attachment\x18\x04 \x01(\tR\n" +
+	"attachment\x12\x12\n" +
+	"\x04date\x18\x05 \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\x06 \x01(\tR\x04time\"<\n" +
+	"\rNotesResponse\x12+\n" +
+	"\x05notes\x18\x01 \x03(\v2\x15.support.NoteResponseR\x05notes\"\x82\x01\n" +
+	"\x12OpenDisputeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x19\n" +
+	"\btrade_id\x18\x02 \x01(\x04R\atradeId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\".\n" +
+	"\x13ListDisputesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"K\n" +
+	"\x11GetDisputeRequest\x12\x1d\n" +
+	"\n" +
+	"dispute_id\x18\x01 \x01(\x04R\tdisputeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"\x7f\n" +
+	"\x15ResolveDisputeRequest\x12\x1d\n" +
+	"\n" +
+	"dispute_id\x18\x01 \x01(\x04R\tdisputeId\x12\x19\n" +
+	"\bagent_id\x18\x02 \x01(\x04R\aagentId\x12\x18\n" +
+	"\aoutcome\x18\x03 \x01(\tR\aoutcome\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"\xf7\x03\n" +
+	"\x0fDisputeResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\btrade_id\x18\x02 \x01(\x04R\atradeId\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x03 \x01(\x04R\tfeatureId\x12#\n" +
+	"\rproperties_id\x18\x04 \x01(\tR\fpropertiesId\x12\x19\n" +
+	"\bbuyer_id\x18\x05 \x01(\x04R\abuyerId\x12\x1b\n" +
+	"\tseller_id\x18\x06 \x01(\x04R\bsellerId\x12\x1b\n" +
+	"\topened_by\x18\a \x01(\x04R\bopenedBy\x12\x1b\n" +
+	"\tticket_id\x18\b \x01(\x04R\bticketId\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\x12 \n" +
+	"\vdescription\x18\n" +
+	" \x01(\tR\vdescription\x12!\n" +
+	"\ffunds_frozen\x18\v \x01(\bR\vfundsFrozen\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x12\x18\n" +
+	"\aoutcome\x18\r \x01(\tR\aoutcome\x12'\n" +
+	"\x0fresolution_note\x18\x0e \x01(\tR\x0eresolutionNote\x12\x12\n" +
+	"\x04date\x18\x0f \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\x10 \x01(\tR\x04time\x12#\n" +
+	"\rresolved_date\x18\x11 \x01(\tR\fresolvedDate\"H\n" +
+	"\x10DisputesResponse\x124\n" +
+	"\bdisputes\x18\x01 \x03(\v2\x18.support.DisputeResponseR\bdisputes\"Z\n" +
+	"\x12IngestEmailRequest\x12\x1f\n" +
+	"\vraw_message\x18\x01 \x01(\fR\n" +
+	"rawMessage\x12#\n" +
+	"\rwebhook_token\x18\x02 \x01(\tR\fwebhookToken\"J\n" +
+	"\x13IngestEmailResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\x04R\bticketId\"U\n" +
+	"\x16GetSupportStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x03R\x02to\"V\n" +
+	"\x12SupportStatusCount\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"d\n" +
+	"\x16SupportDepartmentCount\x12\x1e\n" +
+	"\n" +
+	"department\x18\x01 \x01(\tR\n" +
+	"department\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"\xa7\x04\n" +
+	"\x14SupportStatsResponse\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\x03R\x02to\x12#\n" +
+	"\rtotal_tickets\x18\x03 \x01(\x03R\ftotalTickets\x128\n" +
+	"\tby_status\x18\x04 \x03(\v2\x1b.support.SupportStatusCountR\bbyStatus\x12D\n" +
+	"\rby_department\x18\x05 \x03(\v2\x1f.support.SupportDepartmentCountR\fbyDepartment\x12+\n" +
+	"\x11responded_tickets\x18\x06 \x01(\x03R\x10respondedTickets\x12;\n" +
+	"\x1aavg_first_response_seconds\x18\a \x01(\x01R\x17avgFirstResponseSeconds\x12)\n" +
+	"\x10resolved_tickets\x18\b \x01(\x03R\x0fresolvedTickets\x124\n" +
+	"\x16avg_resolution_seconds\x18\t \x01(\x01R\x14avgResolutionSeconds\x12!\n" +
+	"\fgenerated_at\x18\n" +
+	" \x01(\x03R\vgeneratedAt\x12+\n" +
+	"\x11suggested_tickets\x18\v \x01(\x03R\x10suggestedTickets\x12+\n" +
+	"\x11deflected_tickets\x18\f \x01(\x03R\x10deflectedTickets\"H\n" +
+	"\x10StartChatRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x04R\bticketId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"m\n" +
+	"\x0eGetChatRequest\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\x04R\x06chatId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12)\n" +
+	"\x10include_messages\x18\x03 \x01(\bR\x0fincludeMessages\"+\n" +
+	"\x10ListChatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"^\n" +
+	"\x16SendChatMessageRequest\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\x04R\x06chatId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\"D\n" +
+	"\x10CloseChatRequest\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\x04R\x06chatId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"O\n" +
+	"\x1bExportChatTranscriptRequest\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\x04R\x06chatId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"\xd5\x01\n" +
+	"\x13ChatMessageResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\achat_id\x18\x02 \x01(\x04R\x06chatId\x12\x1b\n" +
+	"\tsender_id\x18\x03 \x01(\x04R\bsenderId\x12\x1d\n" +
+	"\n" +
+	"from_agent\x18\x04 \x01(\bR\tfromAgent\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12\x12\n" +
+	"\x04date\x18\x06 \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\a \x01(\tR\x04time\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\"\xa5\x02\n" +
+	"\x13ChatSessionResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\x04R\bticketId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x04R\x06userId\x12\x19\n" +
+	"\bagent_id\x18\x04 \x01(\x04R\aagentId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x12\n" +
+	"\x04room\x18\x06 \x01(\tR\x04room\x12\x12\n" +
+	"\x04date\x18\a \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\b \x01(\tR\x04time\x12\x1f\n" +
+	"\vclosed_date\x18\t \x01(\tR\n" +
+	"closedDate\x128\n" +
+	"\bmessages\x18\n" +
+	" \x03(\v2\x1c.support.ChatMessageResponseR\bmessages\"J\n" +
+	"\x14ChatSessionsResponse\x122\n" +
+	"\x05chats\x18\x01 \x03(\v2\x1c.support.ChatSessionResponseR\x05chats\"N\n" +
+	"\x16ChatTranscriptResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"1\n" +
+	"\x0fListFaqsRequest\x12\x1e\n" +
+	"\n" +
+	"department\x18\x01 \x01(\tR\n" +
+	"department\"\x9b\x01\n" +
+	"\x10CreateFaqRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1a\n" +
+	"\bquestion\x18\x02 \x01(\tR\bquestion\x12\x16\n" +
+	"\x06answer\x18\x03 \x01(\tR\x06answer\x12\x1e\n" +
+	"\n" +
+	"department\x18\x04 \x01(\tR\n" +
+	"department\x12\x1a\n" +
+	"\bkeywords\x18\x05 \x01(\tR\bkeywords\"\xb2\x01\n" +
+	"\x10UpdateFaqRequest\x12\x15\n" +
+	"\x06faq_id\x18\x01 \x01(\x04R\x05faqId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x1a\n" +
+	"\bquestion\x18\x03 \x01(\tR\bquestion\x12\x16\n" +
+	"\x06answer\x18\x04 \x01(\tR\x06answer\x12\x1e\n" +
+	"\n" +
+	"department\x18\x05 \x01(\tR\n" +
+	"department\x12\x1a\n" +
+	"\bkeywords\x18\x06 \x01(\tR\bkeywords\"B\n" +
+	"\x10DeleteFaqRequest\x12\x15\n" +
+	"\x06faq_id\x18\x01 \x01(\x04R\x05faqId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"\xac\x01\n" +
+	"\vFaqResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1a\n" +
+	"\bquestion\x18\x02 \x01(\tR\bquestion\x12\x16\n" +
+	"\x06answer\x18\x03 \x01(\tR\x06answer\x12\x1e\n" +
+	"\n" +
+	"department\x18\x04 \x01(\tR\n" +
+	"department\x12\x1a\n" +
+	"\bkeywords\x18\x05 \x01(\tR\bkeywords\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"8\n" +
+	"\fFaqsResponse\x12(\n" +
+	"\x04faqs\x18\x01 \x03(\v2\x14.support.FaqResponseR\x04faqs\"\xe4\x01\n" +
+	"\x10TicketSuggestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\x04R\bticketId\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x1b\n" +
+	"\tsource_id\x18\x04 \x01(\x04R\bsourceId\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x06 \x01(\tR\x04body\x12\x12\n" +
+	"\x04slug\x18\a \x01(\tR\x04slug\x12\x14\n" +
+	"\x05score\x18\b \x01(\x01R\x05score\x12\x1a\n" +
+	"\baccepted\x18\t \x01(\bR\baccepted\"z\n" +
+	"\x1dAcceptTicketSuggestionRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x04R\bticketId\x12#\n" +
+	"\rsuggestion_id\x18\x02 \x01(\x04R\fsuggestionId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x04R\x06userId2\xac\x03\n" +
+	"\rTicketService\x12E\n" +
+	"\fCreateTicket\x12\x1c.support.CreateTicketRequest\x1a\x17.support.TicketResponse\x12B\n" +
+	"\n" +
+	"GetTickets\x12\x1a.support.GetTicketsRequest\x1a\x18.support.TicketsResponse\x12?\n" +
+	"\tGetTicket\x12\x19.support.GetTicketRequest\x1a\x17.support.TicketResponse\x12E\n" +
+	"\fUpdateTicket\x12\x1c.support.UpdateTicketRequest\x1a\x17.support.TicketResponse\x12C\n" +
+	"\vAddResponse\x12\x1b.support.AddResponseRequest\x1a\x17.support.TicketResponse\x12C\n" +
+	"\vCloseTicket\x12\x1b.support.CloseTicketRequest\x1a\x17.support.TicketResponse2\xdb\x01\n" +
+	"\rReportService\x12E\n" +
+	"\fCreateReport\x12\x1c.support.CreateReportRequest\x1a\x17.support.ReportResponse\x12B\n" +
+	"\n" +
+	"GetReports\x12\x1a.support.GetReportsRequest\x1a\x18.support.ReportsResponse\x12?\n" +
+	"\tGetReport\x12\x19.support.GetReportRequest\x1a\x17.support.ReportResponse2\xa8\x03\n" +
+	"\x16UserEventReportService\x12N\n" +
+	"\x0fCreateUserEvent\x12\x1f.support.CreateUserEventRequest\x1a\x1a.support.UserEventResponse\x12K\n" +
+	"\rGetUserEvents\x12\x1d.support.GetUserEventsRequest\x1a\x1b.support.UserEventsResponse\x12H\n" +
+	"\fGetUserEvent\x12\x1c.support.GetUserEventRequest\x1a\x1a.support.UserEventResponse\x12T\n" +
+	"\x0fReportUserEvent\x12\x1f.support.ReportUserEventRequest\x1a .support.UserEventReportResponse\x12Q\n" +
+	"\x17SendEventReportResponse\x12'.support.SendEventReportResponseRequest\x1a\r.common.Empty2\xc1\x02\n" +
+	"\vNoteService\x12?\n" +
+	"\n" +
+	"CreateNote\x12\x1a.support.CreateNoteRequest\x1a\x15.support.NoteResponse\x12<\n" +
+	"\bGetNotes\x12\x18.support.GetNotesRequest\x1a\x16.support.NotesResponse\x129\n" +
+	"\aGetNote\x12\x17.support.GetNoteRequest\x1a\x15.support.NoteResponse\x12?\n" +
+	"\n" +
+	"UpdateNote\x12\x1a.support.UpdateNoteRequest\x1a\x15.support.NoteResponse\x127\n" +
+	"\n" +
+	"DeleteNote\x12\x1a.support.DeleteNoteRequest\x1a\r.common.Empty2\xaf\x02\n" +
+	"\x0eDisputeService\x12D\n" +
+	"\vOpenDispute\x12\x1b.support.OpenDisputeRequest\x1a\x18.support.DisputeResponse\x12G\n" +
+	"\fListDisputes\x12\x1c.support.ListDisputesRequest\x1a\x19.support.DisputesResponse\x12B\n" +
+	"\n" +
+	"GetDispute\x12\x1a.support.GetDisputeRequest\x1a\x18.support.DisputeResponse\x12J\n" +
+	"\x0eResolveDispute\x12\x1e.support.ResolveDisputeRequest\x1a\x18.support.DisputeResponse2^\n" +
+	"\x12TicketEmailService\x12H\n" +
+	"\vIngestEmail\x12\x1b.support.IngestEmailRequest\x1a\x1c.support.IngestEmailResponse2h\n" +
+	"\x13SupportStatsService\x12Q\n" +
+	"\x0fGetSupportStats\x12\x1f.support.GetSupportStatsRequest\x1a\x1d.support.SupportStatsResponse2\xd9\x02\n" +
 	"\n" +
-	"DeleteNote\x12\x1a.support.DeleteNoteRequest\x1a\r.common.EmptyB\x1bZ\x19metargb/shared/pb/supportb\x06proto3"
+	"FaqService\x12;\n" +
+	"\bListFaqs\x12\x18.support.ListFaqsRequest\x1a\x15.support.FaqsResponse\x12<\n" +
+	"\tCreateFaq\x12\x19.support.CreateFaqRequest\x1a\x14.support.FaqResponse\x12<\n" +
+	"\tUpdateFaq\x12\x19.support.UpdateFaqRequest\x1a\x14.support.FaqResponse\x125\n" +
+	"\tDeleteFaq\x12\x19.support.DeleteFaqRequest\x1a\r.common.Empty\x12[\n" +
+	"\x16AcceptTicketSuggestion\x12&.support.AcceptTicketSuggestionRequest\x1a\x19.support.TicketSuggestion2\xda\x03\n" +
+	"\x12SupportChatService\x12D\n" +
+	"\tStartChat\x12\x19.support.StartChatRequest\x1a\x1c.support.ChatSessionResponse\x12@\n" +
+	"\aGetChat\x12\x17.support.GetChatRequest\x1a\x1c.support.ChatSessionResponse\x12E\n" +
+	"\tListChats\x12\x19.support.ListChatsRequest\x1a\x1d.support.ChatSessionsResponse\x12P\n" +
+	"\x0fSendChatMessage\x12\x1f.support.SendChatMessageRequest\x1a\x1c.support.ChatMessageResponse\x12D\n" +
+	"\tCloseChat\x12\x19.support.CloseChatRequest\x1a\x1c.support.ChatSessionResponse\x12]\n" +
+	"\x14ExportChatTranscript\x12$.support.ExportChatTranscriptRequest\x1a\x1f.support.ChatTranscriptResponseB\x1bZ\x19metargb/shared/pb/supportb\x06proto3"
 
 var (
 	file_support_proto_rawDescOnce sync.Once
@@ -2171,7 +4431,7 @@ func file_support_proto_rawDescGZIP() []byte {
 	return file_support_proto_rawDescData
 }
 
-var file_support_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_support_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_support_proto_goTypes = []any{
 	(*CreateTicketRequest)(nil),            // 0: support.CreateTicketRequest
 	(*UpdateTicketRequest)(nil),            // 1: support.UpdateTicketRequest
@@ -2202,68 +4462,139 @@ var file_support_proto_goTypes = []any{
 	(*DeleteNoteRequest)(nil),              // 26: support.DeleteNoteRequest
 	(*NoteResponse)(nil),                   // 27: support.NoteResponse
 	(*NotesResponse)(nil),                  // 28: support.NotesResponse
-	(*common.PaginationRequest)(nil),       // 29: common.PaginationRequest
-	(*common.UserBasic)(nil),               // 30: common.UserBasic
-	(*common.PaginationMeta)(nil),          // 31: common.PaginationMeta
-	(*common.Empty)(nil),                   // 32: common.Empty
+	(*OpenDisputeRequest)(nil),             // 29: support.OpenDisputeRequest
+	(*ListDisputesRequest)(nil),            // 30: support.ListDisputesRequest
+	(*GetDisputeRequest)(nil),              // 31: support.GetDisputeRequest
+	(*ResolveDisputeRequest)(nil),          // 32: support.ResolveDisputeRequest
+	(*DisputeResponse)(nil),                // 33: support.DisputeResponse
+	(*DisputesResponse)(nil),               // 34: support.DisputesResponse
+	(*IngestEmailRequest)(nil),             // 35: support.IngestEmailRequest
+	(*IngestEmailResponse)(nil),            // 36: support.IngestEmailResponse
+	(*GetSupportStatsRequest)(nil),         // 37: support.GetSupportStatsRequest
+	(*SupportStatusCount)(nil),             // 38: support.SupportStatusCount
+	(*SupportDepartmentCount)(nil),         // 39: support.SupportDepartmentCount
+	(*SupportStatsResponse)(nil),           // 40: support.SupportStatsResponse
+	(*StartChatRequest)(nil),               // 41: support.StartChatRequest
+	(*GetChatRequest)(nil),                 // 42: support.GetChatRequest
+	(*ListChatsRequest)(nil),               // 43: support.ListChatsRequest
+	(*SendChatMessageRequest)(nil),         // 44: support.SendChatMessageRequest
+	(*CloseChatRequest)(nil),               // 45: support.CloseChatRequest
+	(*ExportChatTranscriptRequest)(nil),    // 46: support.ExportChatTranscriptRequest
+	(*ChatMessageResponse)(nil),            // 47: support.ChatMessageResponse
+	(*ChatSessionResponse)(nil),            // 48: support.ChatSessionResponse
+	(*ChatSessionsResponse)(nil),           // 49: support.ChatSessionsResponse
+	(*ChatTranscriptResponse)(nil),         // 50: support.ChatTranscriptResponse
+	(*ListFaqsRequest)(nil),                // 51: support.ListFaqsRequest
+	(*CreateFaqRequest)(nil),               // 52: support.CreateFaqRequest
+	(*UpdateFaqRequest)(nil),               // 53: support.UpdateFaqRequest
+	(*DeleteFaqRequest)(nil),               // 54: support.DeleteFaqRequest
+	(*FaqResponse)(nil),                    // 55: support.FaqResponse
+	(*FaqsResponse)(nil),                   // 56: support.FaqsResponse
+	(*TicketSuggestion)(nil),               // 57: support.TicketSuggestion
+	(*AcceptTicketSuggestionRequest)(nil),  // 58: support.AcceptTicketSuggestionRequest
+	(*common.PaginationRequest)(nil),       // 59: common.PaginationRequest
+	(*common.UserBasic)(nil),               // 60: common.UserBasic
+	(*common.PaginationMeta)(nil),          // 61: common.PaginationMeta
+	(*common.Empty)(nil),                   // 62: common.Empty
 }
 var file_support_proto_depIdxs = []int32{
-	29, // 0: support.GetTicketsRequest.pagination:type_name -> common.PaginationRequest
-	30, // 1: support.TicketResponse.sender:type_name -> common.UserBasic
-	30, // 2: support.TicketResponse.receiver:type_name -> common.UserBasic
+	59, // 0: support.GetTicketsRequest.pagination:type_name -> common.PaginationRequest
+	60, // 1: support.TicketResponse.sender:type_name -> common.UserBasic
+	60, // 2: support.TicketResponse.receiver:type_name -> common.UserBasic
 	8,  // 3: support.TicketResponse.responses:type_name -> support.TicketResponseItem
-	6,  // 4: support.TicketsResponse.tickets:type_name -> support.TicketResponse
-	31, // 5: support.TicketsResponse.pagination:type_name -> common.PaginationMeta
-	29, // 6: support.GetReportsRequest.pagination:type_name -> common.PaginationRequest
-	12, // 7: support.ReportsResponse.reports:type_name -> support.ReportResponse
-	31, // 8: support.ReportsResponse.pagination:type_name -> common.PaginationMeta
-	29, // 9: support.GetUserEventsRequest.pagination:type_name -> common.PaginationRequest
-	17, // 10: support.UserEventsResponse.events:type_name -> support.UserEventResponse
-	31, // 11: support.UserEventsResponse.pagination:type_name -> common.PaginationMeta
-	27, // 12: support.NotesResponse.notes:type_name -> support.NoteResponse
-	0,  // 13: support.TicketService.CreateTicket:input_type -> support.CreateTicketRequest
-	4,  // 14: support.TicketService.GetTickets:input_type -> support.GetTicketsRequest
-	5,  // 15: support.TicketService.GetTicket:input_type -> support.GetTicketRequest
-	1,  // 16: support.TicketService.UpdateTicket:input_type -> support.UpdateTicketRequest
-	2,  // 17: support.TicketService.AddResponse:input_type -> support.AddResponseRequest
-	3,  // 18: support.TicketService.CloseTicket:input_type -> support.CloseTicketRequest
-	9,  // 19: support.ReportService.CreateReport:input_type -> support.CreateReportRequest
-	10, // 20: support.ReportService.GetReports:input_type -> support.GetReportsRequest
-	11, // 21: support.ReportService.GetReport:input_type -> support.GetReportRequest
-	14, // 22: support.UserEventReportService.CreateUserEvent:input_type -> support.CreateUserEventRequest
-	15, // 23: support.UserEventReportService.GetUserEvents:input_type -> support.GetUserEventsRequest
-	16, // 24: support.UserEventReportService.GetUserEvent:input_type -> support.GetUserEventRequest
-	19, // 25: support.UserEventReportService.ReportUserEvent:input_type -> support.ReportUserEventRequest
-	21, // 26: support.UserEventReportService.SendEventReportResponse:input_type -> support.SendEventReportResponseRequest
-	22, // 27: support.NoteService.CreateNote:input_type -> support.CreateNoteRequest
-	24, // 28: support.NoteService.GetNotes:input_type -> support.GetNotesRequest
-	25, // 29: support.NoteService.GetNote:input_type -> support.GetNoteRequest
-	23, // 30: support.NoteService.UpdateNote:input_type -> support.UpdateNoteRequest
-	26, // 31: support.NoteService.DeleteNote:input_type -> support.DeleteNoteRequest
-	6,  // 32: support.TicketService.CreateTicket:output_type -> support.TicketResponse
-	7,  // 33: support.TicketService.GetTickets:output_type -> support.TicketsResponse
-	6,  // 34: support.TicketService.GetTicket:output_type -> support.TicketResponse
-	6,  // 35: support.TicketService.UpdateTicket:output_type -> support.TicketResponse
-	6,  // 36: support.TicketService.AddResponse:output_type -> support.TicketResponse
-	6,  // 37: support.TicketService.CloseTicket:output_type -> support.TicketResponse
-	12, // 38: support.ReportService.CreateReport:output_type -> support.ReportResponse
-	13, // 39: support.ReportService.GetReports:output_type -> support.ReportsResponse
-	12, // 40: support.ReportService.GetReport:output_type -> support.ReportResponse
-	17, // 41: support.UserEventReportService.CreateUserEvent:output_type -> support.UserEventResponse
-	18, // 42: support.UserEventReportService.GetUserEvents:output_type -> support.UserEventsResponse
-	17, // 43: support.UserEventReportService.GetUserEvent:output_type -> support.UserEventResponse
-	20, // 44: support.UserEventReportService.ReportUserEvent:output_type -> support.UserEventReportResponse
-	32, // 45: support.UserEventReportService.SendEventReportResponse:output_type -> common.Empty
-	27, // 46: support.NoteService.CreateNote:output_type -> support.NoteResponse
-	28, // 47: support.NoteService.GetNotes:output_type -> support.NotesResponse
-	27, // 48: support.NoteService.GetNote:output_type -> support.NoteResponse
-	27, // 49: support.NoteService.UpdateNote:output_type -> support.NoteResponse
-	32, // 50: support.NoteService.DeleteNote:output_type -> common.Empty
-	32, // [32:51] is the sub-list for method output_type
-	13, // [13:32] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	57, // 4: support.TicketResponse.suggestions:type_name -> support.TicketSuggestion
+	6,  // 5: support.TicketsResponse.tickets:type_name -> support.TicketResponse
+	61, // 6: support.TicketsResponse.pagination:type_name -> common.PaginationMeta
+	59, // 7: support.GetReportsRequest.pagination:type_name -> common.PaginationRequest
+	12, // 8: support.ReportsResponse.reports:type_name -> support.ReportResponse
+	61, // 9: support.ReportsResponse.pagination:type_name -> common.PaginationMeta
+	59, // 10: support.GetUserEventsRequest.pagination:type_name -> common.PaginationRequest
+	17, // 11: support.UserEventsResponse.events:type_name -> support.UserEventResponse
+	61, // 12: support.UserEventsResponse.pagination:type_name -> common.PaginationMeta
+	27, // 13: support.NotesResponse.notes:type_name -> support.NoteResponse
+	33, // 14: support.DisputesResponse.disputes:type_name -> support.DisputeResponse
+	38, // 15: support.SupportStatsResponse.by_status:type_name -> support.SupportStatusCount
+	39, // 16: support.SupportStatsResponse.by_department:type_name -> support.SupportDepartmentCount
+	47, // 17: support.ChatSessionResponse.messages:type_name -> support.ChatMessageResponse
+	48, // 18: support.ChatSessionsResponse.chats:type_name -> support.ChatSessionResponse
+	55, // 19: support.FaqsResponse.faqs:type_name -> support.FaqResponse
+	0,  // 20: support.TicketService.CreateTicket:input_type -> support.CreateTicketRequest
+	4,  // 21: support.TicketService.GetTickets:input_type -> support.GetTicketsRequest
+	5,  // 22: support.TicketService.GetTicket:input_type -> support.GetTicketRequest
+	1,  // 23: support.TicketService.UpdateTicket:input_type -> support.UpdateTicketRequest
+	2,  // 24: support.TicketService.AddResponse:input_type -> support.AddResponseRequest
+	3,  // 25: support.TicketService.CloseTicket:input_type -> support.CloseTicketRequest
+	9,  // 26: support.ReportService.CreateReport:input_type -> support.CreateReportRequest
+	10, // 27: support.ReportService.GetReports:input_type -> support.GetReportsRequest
+	11, // 28: support.ReportService.GetReport:input_type -> support.GetReportRequest
+	14, // 29: support.UserEventReportService.CreateUserEvent:input_type -> support.CreateUserEventRequest
+	15, // 30: support.UserEventReportService.GetUserEvents:input_type -> support.GetUserEventsRequest
+	16, // 31: support.UserEventReportService.GetUserEvent:input_type -> support.GetUserEventRequest
+	19, // 32: support.UserEventReportService.ReportUserEvent:input_type -> support.ReportUserEventRequest
+	21, // 33: support.UserEventReportService.SendEventReportResponse:input_type -> support.SendEventReportResponseRequest
+	22, // 34: support.NoteService.CreateNote:input_type -> support.CreateNoteRequest
+	24, // 35: support.NoteService.GetNotes:input_type -> support.GetNotesRequest
+	25, // 36: support.NoteService.GetNote:input_type -> support.GetNoteRequest
+	23, // 37: support.NoteService.UpdateNote:input_type -> support.UpdateNoteRequest
+	26, // 38: support.NoteService.DeleteNote:input_type -> support.DeleteNoteRequest
+	29, // 39: support.DisputeService.OpenDispute:input_type -> support.OpenDisputeRequest
+	30, // 40: support.DisputeService.ListDisputes:input_type -> support.ListDisputesRequest
+	31, // 41: support.DisputeService.GetDispute:input_type -> support.GetDisputeRequest
+	32, // 42: support.DisputeService.ResolveDispute:input_type -> support.ResolveDisputeRequest
+	35, // 43: support.TicketEmailService.IngestEmail:input_type -> support.IngestEmailRequest
+	37, // 44: support.SupportStatsService.GetSupportStats:input_type -> support.GetSupportStatsRequest
+	51, // 45: support.FaqService.ListFaqs:input_type -> support.ListFaqsRequest
+	52, // 46: support.FaqService.CreateFaq:input_type -> support.CreateFaqRequest
+	53, // 47: support.FaqService.UpdateFaq:input_type -> support.UpdateFaqRequest
+	54, // 48: support.FaqService.DeleteFaq:input_type -> support.DeleteFaqRequest
+	58, // 49: support.FaqService.AcceptTicketSuggestion:input_type -> support.AcceptTicketSuggestionRequest
+	41, // 50: support.SupportChatService.StartChat:input_type -> support.StartChatRequest
+	42, // 51: support.SupportChatService.GetChat:input_type -> support.GetChatRequest
+	43, // 52: support.SupportChatService.ListChats:input_type -> support.ListChatsRequest
+	44, // 53: support.SupportChatService.SendChatMessage:input_type -> support.SendChatMessageRequest
+	45, // 54: support.SupportChatService.CloseChat:input_type -> support.CloseChatRequest
+	46, // 55: support.SupportChatService.ExportChatTranscript:input_type -> support.ExportChatTranscriptRequest
+	6,  // 56: support.TicketService.CreateTicket:output_type -> support.TicketResponse
+	7,  // 57: support.TicketService.GetTickets:output_type -> support.TicketsResponse
+	6,  // 58: support.TicketService.GetTicket:output_type -> support.TicketResponse
+	6,  // 59: support.TicketService.UpdateTicket:output_type -> support.TicketResponse
+	6,  // 60: support.TicketService.AddResponse:output_type -> support.TicketResponse
+	6,  // 61: support.TicketService.CloseTicket:output_type -> support.TicketResponse
+	12, // 62: support.ReportService.CreateReport:output_type -> support.ReportResponse
+	13, // 63: support.ReportService.GetReports:output_type -> support.ReportsResponse
+	12, // 64: support.ReportService.GetReport:output_type -> support.ReportResponse
+	17, // 65: support.UserEventReportService.CreateUserEvent:output_type -> support.UserEventResponse
+	18, // 66: support.UserEventReportService.GetUserEvents:output_type -> support.UserEventsResponse
+	17, // 67: support.UserEventReportService.GetUserEvent:output_type -> support.UserEventResponse
+	20, // 68: support.UserEventReportService.ReportUserEvent:output_type -> support.UserEventReportResponse
+	62, // 69: support.UserEventReportService.SendEventReportResponse:output_type -> common.Empty
+	27, // 70: support.NoteService.CreateNote:output_type -> support.NoteResponse
+	28, // 71: support.NoteService.GetNotes:output_type -> support.NotesResponse
+	27, // 72: support.NoteService.GetNote:output_type -> support.NoteResponse
+	27, // 73: support.NoteService.UpdateNote:output_type -> support.NoteResponse
+	62, // 74: support.NoteService.DeleteNote:output_type -> common.Empty
+	33, // 75: support.DisputeService.OpenDispute:output_type -> support.DisputeResponse
+	34, // 76: support.DisputeService.ListDisputes:output_type -> support.DisputesResponse
+	33, // 77: support.DisputeService.GetDispute:output_type -> support.DisputeResponse
+	33, // 78: support.DisputeService.ResolveDispute:output_type -> support.DisputeResponse
+	36, // 79: support.TicketEmailService.IngestEmail:output_type -> support.IngestEmailResponse
+	40, // 80: support.SupportStatsService.GetSupportStats:output_type -> support.SupportStatsResponse
+	56, // 81: support.FaqService.ListFaqs:output_type -> support.FaqsResponse
+	55, // 82: support.FaqService.CreateFaq:output_type -> support.FaqResponse
+	55, // 83: support.FaqService.UpdateFaq:output_type -> support.FaqResponse
+	62, // 84: support.FaqService.DeleteFaq:output_type -> common.Empty
+	57, // 85: support.FaqService.AcceptTicketSuggestion:output_type -> support.TicketSuggestion
+	48, // 86: support.SupportChatService.StartChat:output_type -> support.ChatSessionResponse
+	48, // 87: support.SupportChatService.GetChat:output_type -> support.ChatSessionResponse
+	49, // 88: support.SupportChatService.ListChats:output_type -> support.ChatSessionsResponse
+	47, // 89: support.SupportChatService.SendChatMessage:output_type -> support.ChatMessageResponse
+	48, // 90: support.SupportChatService.CloseChat:output_type -> support.ChatSessionResponse
+	50, // 91: support.SupportChatService.ExportChatTranscript:output_type -> support.ChatTranscriptResponse
+	56, // [56:92] is the sub-list for method output_type
+	20, // [20:56] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_support_proto_init() }
@@ -2277,9 +4608,9 @@ func file_support_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_support_proto_rawDesc), len(file_support_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   29,
+			NumMessages:   59,
 			NumExtensions: 0,
-			NumServices:   4,
+			NumServices:   9,
 		},
 		GoTypes:           file_support_proto_goTypes,
 		DependencyIndexes: file_support_proto_depIdxs,
@@ -1013,3 +1013,991 @@ var NoteService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "support.proto",
 }
+
+const (
+	DisputeService_OpenDispute_FullMethodName    = "/support.DisputeService/OpenDispute"
+	DisputeService_ListDisputes_FullMethodName   = "/support.DisputeService/ListDisputes"
+	DisputeService_GetDispute_FullMethodName     = "/support.DisputeService/GetDispute"
+	DisputeService_ResolveDispute_FullMethodName = "/support.DisputeService/ResolveDispute"
+)
+
+// DisputeServiceClient is the client API for DisputeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DisputeService handles disputes on feature trades
+type DisputeServiceClient interface {
+	OpenDispute(ctx context.Context, in *OpenDisputeRequest, opts ...grpc.CallOption) (*DisputeResponse, error)
+	ListDisputes(ctx context.Context, in *ListDisputesRequest, opts ...grpc.CallOption) (*DisputesResponse, error)
+	GetDispute(ctx context.Context, in *GetDisputeRequest, opts ...grpc.CallOption) (*DisputeResponse, error)
+	ResolveDispute(ctx context.Context, in *ResolveDisputeRequest, opts ...grpc.CallOption) (*DisputeResponse, error)
+}
+
+type disputeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDisputeServiceClient(cc grpc.ClientConnInterface) DisputeServiceClient {
+	return &disputeServiceClient{cc}
+}
+
+func (c *disputeServiceClient) OpenDispute(ctx context.Context, in *OpenDisputeRequest, opts ...grpc.CallOption) (*DisputeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisputeResponse)
+	err := c.cc.Invoke(ctx, DisputeService_OpenDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *disputeServiceClient) ListDisputes(ctx context.Context, in *ListDisputesRequest, opts ...grpc.CallOption) (*DisputesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisputesResponse)
+	err := c.cc.Invoke(ctx, DisputeService_ListDisputes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *disputeServiceClient) GetDispute(ctx context.Context, in *GetDisputeRequest, opts ...grpc.CallOption) (*DisputeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisputeResponse)
+	err := c.cc.Invoke(ctx, DisputeService_GetDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *disputeServiceClient) ResolveDispute(ctx context.Context, in *ResolveDisputeRequest, opts ...grpc.CallOption) (*DisputeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisputeResponse)
+	err := c.cc.Invoke(ctx, DisputeService_ResolveDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DisputeServiceServer is the server API for DisputeService service.
+// All implementations must embed UnimplementedDisputeServiceServer
+// for forward compatibility.
+//
+// DisputeService handles disputes on feature trades
+type DisputeServiceServer interface {
+	OpenDispute(context.Context, *OpenDisputeRequest) (*DisputeResponse, error)
+	ListDisputes(context.Context, *ListDisputesRequest) (*DisputesResponse, error)
+	GetDispute(context.Context, *GetDisputeRequest) (*DisputeResponse, error)
+	ResolveDispute(context.Context, *ResolveDisputeRequest) (*DisputeResponse, error)
+	mustEmbedUnimplementedDisputeServiceServer()
+}
+
+// UnimplementedDisputeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDisputeServiceServer struct{}
+
+func (UnimplementedDisputeServiceServer) OpenDispute(context.Context, *OpenDisputeRequest) (*DisputeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method OpenDispute not implemented")
+}
+func (UnimplementedDisputeServiceServer) ListDisputes(context.Context, *ListDisputesRequest) (*DisputesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDisputes not implemented")
+}
+func (UnimplementedDisputeServiceServer) GetDispute(context.Context, *GetDisputeRequest) (*DisputeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDispute not implemented")
+}
+func (UnimplementedDisputeServiceServer) ResolveDispute(context.Context, *ResolveDisputeRequest) (*DisputeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveDispute not implemented")
+}
+func (UnimplementedDisputeServiceServer) mustEmbedUnimplementedDisputeServiceServer() {}
+func (UnimplementedDisputeServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeDisputeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DisputeServiceServer will
+// result in compilation errors.
+type UnsafeDisputeServiceServer interface {
+	mustEmbedUnimplementedDisputeServiceServer()
+}
+
+func RegisterDisputeServiceServer(s grpc.ServiceRegistrar, srv DisputeServiceServer) {
+	// If the following call panics, it indicates UnimplementedDisputeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DisputeService_ServiceDesc, srv)
+}
+
+func _DisputeService_OpenDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisputeServiceServer).OpenDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisputeService_OpenDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisputeServiceServer).OpenDispute(ctx, req.(*OpenDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisputeService_ListDisputes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDisputesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisputeServiceServer).ListDisputes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisputeService_ListDisputes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisputeServiceServer).ListDisputes(ctx, req.(*ListDisputesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisputeService_GetDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisputeServiceServer).GetDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisputeService_GetDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisputeServiceServer).GetDispute(ctx, req.(*GetDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisputeService_ResolveDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisputeServiceServer).ResolveDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisputeService_ResolveDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisputeServiceServer).ResolveDispute(ctx, req.(*ResolveDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DisputeService_ServiceDesc is the grpc.ServiceDesc for DisputeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DisputeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "support.DisputeService",
+	HandlerType: (*DisputeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OpenDispute",
+			Handler:    _DisputeService_OpenDispute_Handler,
+		},
+		{
+			MethodName: "ListDisputes",
+			Handler:    _DisputeService_ListDisputes_Handler,
+		},
+		{
+			MethodName: "GetDispute",
+			Handler:    _DisputeService_GetDispute_Handler,
+		},
+		{
+			MethodName: "ResolveDispute",
+			Handler:    _DisputeService_ResolveDispute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "support.proto",
+}
+
+const (
+	TicketEmailService_IngestEmail_FullMethodName = "/support.TicketEmailService/IngestEmail"
+)
+
+// TicketEmailServiceClient is the client API for TicketEmailService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TicketEmailService turns emails sent to the support mailbox into tickets
+type TicketEmailServiceClient interface {
+	IngestEmail(ctx context.Context, in *IngestEmailRequest, opts ...grpc.CallOption) (*IngestEmailResponse, error)
+}
+
+type ticketEmailServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTicketEmailServiceClient(cc grpc.ClientConnInterface) TicketEmailServiceClient {
+	return &ticketEmailServiceClient{cc}
+}
+
+func (c *ticketEmailServiceClient) IngestEmail(ctx context.Context, in *IngestEmailRequest, opts ...grpc.CallOption) (*IngestEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IngestEmailResponse)
+	err := c.cc.Invoke(ctx, TicketEmailService_IngestEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TicketEmailServiceServer is the server API for TicketEmailService service.
+// All implementations must embed UnimplementedTicketEmailServiceServer
+// for forward compatibility.
+//
+// TicketEmailService turns emails sent to the support mailbox into tickets
+type TicketEmailServiceServer interface {
+	IngestEmail(context.Context, *IngestEmailRequest) (*IngestEmailResponse, error)
+	mustEmbedUnimplementedTicketEmailServiceServer()
+}
+
+// UnimplementedTicketEmailServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTicketEmailServiceServer struct{}
+
+func (UnimplementedTicketEmailServiceServer) IngestEmail(context.Context, *IngestEmailRequest) (*IngestEmailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method IngestEmail not implemented")
+}
+func (UnimplementedTicketEmailServiceServer) mustEmbedUnimplementedTicketEmailServiceServer() {}
+func (UnimplementedTicketEmailServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeTicketEmailServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TicketEmailServiceServer will
+// result in compilation errors.
+type UnsafeTicketEmailServiceServer interface {
+	mustEmbedUnimplementedTicketEmailServiceServer()
+}
+
+func RegisterTicketEmailServiceServer(s grpc.ServiceRegistrar, srv TicketEmailServiceServer) {
+	// If the following call panics, it indicates UnimplementedTicketEmailServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TicketEmailService_ServiceDesc, srv)
+}
+
+func _TicketEmailService_IngestEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IngestEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketEmailServiceServer).IngestEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketEmailService_IngestEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketEmailServiceServer).IngestEmail(ctx, req.(*IngestEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TicketEmailService_ServiceDesc is the grpc.ServiceDesc for TicketEmailService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TicketEmailService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "support.TicketEmailService",
+	HandlerType: (*TicketEmailServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IngestEmail",
+			Handler:    _TicketEmailService_IngestEmail_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "support.proto",
+}
+
+const (
+	SupportStatsService_GetSupportStats_FullMethodName = "/support.SupportStatsService/GetSupportStats"
+)
+
+// SupportStatsServiceClient is the client API for SupportStatsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SupportStatsService reports ticket volumes and response times to support agents
+type SupportStatsServiceClient interface {
+	GetSupportStats(ctx context.Context, in *GetSupportStatsRequest, opts ...grpc.CallOption) (*SupportStatsResponse, error)
+}
+
+type supportStatsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSupportStatsServiceClient(cc grpc.ClientConnInterface) SupportStatsServiceClient {
+	return &supportStatsServiceClient{cc}
+}
+
+func (c *supportStatsServiceClient) GetSupportStats(ctx context.Context, in *GetSupportStatsRequest, opts ...grpc.CallOption) (*SupportStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupportStatsResponse)
+	err := c.cc.Invoke(ctx, SupportStatsService_GetSupportStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SupportStatsServiceServer is the server API for SupportStatsService service.
+// All implementations must embed UnimplementedSupportStatsServiceServer
+// for forward compatibility.
+//
+// SupportStatsService reports ticket volumes and response times to support agents
+type SupportStatsServiceServer interface {
+	GetSupportStats(context.Context, *GetSupportStatsRequest) (*SupportStatsResponse, error)
+	mustEmbedUnimplementedSupportStatsServiceServer()
+}
+
+// UnimplementedSupportStatsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSupportStatsServiceServer struct{}
+
+func (UnimplementedSupportStatsServiceServer) GetSupportStats(context.Context, *GetSupportStatsRequest) (*SupportStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSupportStats not implemented")
+}
+func (UnimplementedSupportStatsServiceServer) mustEmbedUnimplementedSupportStatsServiceServer() {}
+func (UnimplementedSupportStatsServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeSupportStatsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SupportStatsServiceServer will
+// result in compilation errors.
+type UnsafeSupportStatsServiceServer interface {
+	mustEmbedUnimplementedSupportStatsServiceServer()
+}
+
+func RegisterSupportStatsServiceServer(s grpc.ServiceRegistrar, srv SupportStatsServiceServer) {
+	// If the following call panics, it indicates UnimplementedSupportStatsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SupportStatsService_ServiceDesc, srv)
+}
+
+func _SupportStatsService_GetSupportStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSupportStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupportStatsServiceServer).GetSupportStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupportStatsService_GetSupportStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupportStatsServiceServer).GetSupportStats(ctx, req.(*GetSupportStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SupportStatsService_ServiceDesc is the grpc.ServiceDesc for SupportStatsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SupportStatsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "support.SupportStatsService",
+	HandlerType: (*SupportStatsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSupportStats",
+			Handler:    _SupportStatsService_GetSupportStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "support.proto",
+}
+
+const (
+	FaqService_ListFaqs_FullMethodName               = "/support.FaqService/ListFaqs"
+	FaqService_CreateFaq_FullMethodName              = "/support.FaqService/CreateFaq"
+	FaqService_UpdateFaq_FullMethodName              = "/support.FaqService/UpdateFaq"
+	FaqService_DeleteFaq_FullMethodName              = "/support.FaqService/DeleteFaq"
+	FaqService_AcceptTicketSuggestion_FullMethodName = "/support.FaqService/AcceptTicketSuggestion"
+)
+
+// FaqServiceClient is the client API for FaqService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FaqService manages the FAQs suggested when tickets are created and records
+// which suggestions answered the ticket
+type FaqServiceClient interface {
+	ListFaqs(ctx context.Context, in *ListFaqsRequest, opts ...grpc.CallOption) (*FaqsResponse, error)
+	CreateFaq(ctx context.Context, in *CreateFaqRequest, opts ...grpc.CallOption) (*FaqResponse, error)
+	UpdateFaq(ctx context.Context, in *UpdateFaqRequest, opts ...grpc.CallOption) (*FaqResponse, error)
+	DeleteFaq(ctx context.Context, in *DeleteFaqRequest, opts ...grpc.CallOption) (*common.Empty, error)
+	AcceptTicketSuggestion(ctx context.Context, in *AcceptTicketSuggestionRequest, opts ...grpc.CallOption) (*TicketSuggestion, error)
+}
+
+type faqServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFaqServiceClient(cc grpc.ClientConnInterface) FaqServiceClient {
+	return &faqServiceClient{cc}
+}
+
+func (c *faqServiceClient) ListFaqs(ctx context.Context, in *ListFaqsRequest, opts ...grpc.CallOption) (*FaqsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FaqsResponse)
+	err := c.cc.Invoke(ctx, FaqService_ListFaqs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faqServiceClient) CreateFaq(ctx context.Context, in *CreateFaqRequest, opts ...grpc.CallOption) (*FaqResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FaqResponse)
+	err := c.cc.Invoke(ctx, FaqService_CreateFaq_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faqServiceClient) UpdateFaq(ctx context.Context, in *UpdateFaqRequest, opts ...grpc.CallOption) (*FaqResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FaqResponse)
+	err := c.cc.Invoke(ctx, FaqService_UpdateFaq_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faqServiceClient) DeleteFaq(ctx context.Context, in *DeleteFaqRequest, opts ...grpc.CallOption) (*common.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, FaqService_DeleteFaq_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faqServiceClient) AcceptTicketSuggestion(ctx context.Context, in *AcceptTicketSuggestionRequest, opts ...grpc.CallOption) (*TicketSuggestion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TicketSuggestion)
+	err := c.cc.Invoke(ctx, FaqService_AcceptTicketSuggestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FaqServiceServer is the server API for FaqService service.
+// All implementations must embed UnimplementedFaqServiceServer
+// for forward compatibility.
+//
+// FaqService manages the FAQs suggested when tickets are created and records
+// which suggestions answered the ticket
+type FaqServiceServer interface {
+	ListFaqs(context.Context, *ListFaqsRequest) (*FaqsResponse, error)
+	CreateFaq(context.Context, *CreateFaqRequest) (*FaqResponse, error)
+	UpdateFaq(context.Context, *UpdateFaqRequest) (*FaqResponse, error)
+	DeleteFaq(context.Context, *DeleteFaqRequest) (*common.Empty, error)
+	AcceptTicketSuggestion(context.Context, *AcceptTicketSuggestionRequest) (*TicketSuggestion, error)
+	mustEmbedUnimplementedFaqServiceServer()
+}
+
+// UnimplementedFaqServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFaqServiceServer struct{}
+
+func (UnimplementedFaqServiceServer) ListFaqs(context.Context, *ListFaqsRequest) (*FaqsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFaqs not implemented")
+}
+func (UnimplementedFaqServiceServer) CreateFaq(context.Context, *CreateFaqRequest) (*FaqResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateFaq not implemented")
+}
+func (UnimplementedFaqServiceServer) UpdateFaq(context.Context, *UpdateFaqRequest) (*FaqResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateFaq not implemented")
+}
+func (UnimplementedFaqServiceServer) DeleteFaq(context.Context, *DeleteFaqRequest) (*common.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteFaq not implemented")
+}
+func (UnimplementedFaqServiceServer) AcceptTicketSuggestion(context.Context, *AcceptTicketSuggestionRequest) (*TicketSuggestion, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptTicketSuggestion not implemented")
+}
+func (UnimplementedFaqServiceServer) mustEmbedUnimplementedFaqServiceServer() {}
+func (UnimplementedFaqServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeFaqServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FaqServiceServer will
+// result in compilation errors.
+type UnsafeFaqServiceServer interface {
+	mustEmbedUnimplementedFaqServiceServer()
+}
+
+func RegisterFaqServiceServer(s grpc.ServiceRegistrar, srv FaqServiceServer) {
+	// If the following call panics, it indicates UnimplementedFaqServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FaqService_ServiceDesc, srv)
+}
+
+func _FaqService_ListFaqs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFaqsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaqServiceServer).ListFaqs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaqService_ListFaqs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaqServiceServer).ListFaqs(ctx, req.(*ListFaqsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaqService_CreateFaq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFaqRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaqServiceServer).CreateFaq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaqService_CreateFaq_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaqServiceServer).CreateFaq(ctx, req.(*CreateFaqRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaqService_UpdateFaq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFaqRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaqServiceServer).UpdateFaq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaqService_UpdateFaq_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaqServiceServer).UpdateFaq(ctx, req.(*UpdateFaqRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaqService_DeleteFaq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFaqRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaqServiceServer).DeleteFaq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaqService_DeleteFaq_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaqServiceServer).DeleteFaq(ctx, req.(*DeleteFaqRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaqService_AcceptTicketSuggestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptTicketSuggestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaqServiceServer).AcceptTicketSuggestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaqService_AcceptTicketSuggestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaqServiceServer).AcceptTicketSuggestion(ctx, req.(*AcceptTicketSuggestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FaqService_ServiceDesc is the grpc.ServiceDesc for FaqService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FaqService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "support.FaqService",
+	HandlerType: (*FaqServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListFaqs",
+			Handler:    _FaqService_ListFaqs_Handler,
+		},
+		{
+			MethodName: "CreateFaq",
+			Handler:    _FaqService_CreateFaq_Handler,
+		},
+		{
+			MethodName: "UpdateFaq",
+			Handler:    _FaqService_UpdateFaq_Handler,
+		},
+		{
+			MethodName: "DeleteFaq",
+			Handler:    _FaqService_DeleteFaq_Handler,
+		},
+		{
+			MethodName: "AcceptTicketSuggestion",
+			Handler:    _FaqService_AcceptTicketSuggestion_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "support.proto",
+}
+
+const (
+	SupportChatService_StartChat_FullMethodName            = "/support.SupportChatService/StartChat"
+	SupportChatService_GetChat_FullMethodName              = "/support.SupportChatService/GetChat"
+	SupportChatService_ListChats_FullMethodName            = "/support.SupportChatService/ListChats"
+	SupportChatService_SendChatMessage_FullMethodName      = "/support.SupportChatService/SendChatMessage"
+	SupportChatService_CloseChat_FullMethodName            = "/support.SupportChatService/CloseChat"
+	SupportChatService_ExportChatTranscript_FullMethodName = "/support.SupportChatService/ExportChatTranscript"
+)
+
+// SupportChatServiceClient is the client API for SupportChatService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SupportChatService runs live chats between users and support agents on a ticket
+type SupportChatServiceClient interface {
+	StartChat(ctx context.Context, in *StartChatRequest, opts ...grpc.CallOption) (*ChatSessionResponse, error)
+	GetChat(ctx context.Context, in *GetChatRequest, opts ...grpc.CallOption) (*ChatSessionResponse, error)
+	ListChats(ctx context.Context, in *ListChatsRequest, opts ...grpc.CallOption) (*ChatSessionsResponse, error)
+	SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*ChatMessageResponse, error)
+	CloseChat(ctx context.Context, in *CloseChatRequest, opts ...grpc.CallOption) (*ChatSessionResponse, error)
+	ExportChatTranscript(ctx context.Context, in *ExportChatTranscriptRequest, opts ...grpc.CallOption) (*ChatTranscriptResponse, error)
+}
+
+type supportChatServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSupportChatServiceClient(cc grpc.ClientConnInterface) SupportChatServiceClient {
+	return &supportChatServiceClient{cc}
+}
+
+func (c *supportChatServiceClient) StartChat(ctx context.Context, in *StartChatRequest, opts ...grpc.CallOption) (*ChatSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatSessionResponse)
+	err := c.cc.Invoke(ctx, SupportChatService_StartChat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supportChatServiceClient) GetChat(ctx context.Context, in *GetChatRequest, opts ...grpc.CallOption) (*ChatSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatSessionResponse)
+	err := c.cc.Invoke(ctx, SupportChatService_GetChat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supportChatServiceClient) ListChats(ctx context.Context, in *ListChatsRequest, opts ...grpc.CallOption) (*ChatSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatSessionsResponse)
+	err := c.cc.Invoke(ctx, SupportChatService_ListChats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supportChatServiceClient) SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*ChatMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatMessageResponse)
+	err := c.cc.Invoke(ctx, SupportChatService_SendChatMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supportChatServiceClient) CloseChat(ctx context.Context, in *CloseChatRequest, opts ...grpc.CallOption) (*ChatSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatSessionResponse)
+	err := c.cc.Invoke(ctx, SupportChatService_CloseChat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supportChatServiceClient) ExportChatTranscript(ctx context.Context, in *ExportChatTranscriptRequest, opts ...grpc.CallOption) (*ChatTranscriptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatTranscriptResponse)
+	err := c.cc.Invoke(ctx, SupportChatService_ExportChatTranscript_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SupportChatServiceServer is the server API for SupportChatService service.
+// All implementations must embed UnimplementedSupportChatServiceServer
+// for forward compatibility.
+//
+// SupportChatService runs live chats between users and support agents on a ticket
+type SupportChatServiceServer interface {
+	StartChat(context.Context, *StartChatRequest) (*ChatSessionResponse, error)
+	GetChat(context.Context, *GetChatRequest) (*ChatSessionResponse, error)
+	ListChats(context.Context, *ListChatsRequest) (*ChatSessionsResponse, error)
+	SendChatMessage(context.Context, *SendChatMessageRequest) (*ChatMessageResponse, error)
+	CloseChat(context.Context, *CloseChatRequest) (*ChatSessionResponse, error)
+	ExportChatTranscript(context.Context, *ExportChatTranscriptRequest) (*ChatTranscriptResponse, error)
+	mustEmbedUnimplementedSupportChatServiceServer()
+}
+
+// UnimplementedSupportChatServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSupportChatServiceServer struct{}
+
+func (UnimplementedSupportChatServiceServer) StartChat(context.Context, *StartChatRequest) (*ChatSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartChat not implemented")
+}
+func (UnimplementedSupportChatServiceServer) GetChat(context.Context, *GetChatRequest) (*ChatSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetChat not implemented")
+}
+func (UnimplementedSupportChatServiceServer) ListChats(context.Context, *ListChatsRequest) (*ChatSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListChats not implemented")
+}
+func (UnimplementedSupportChatServiceServer) SendChatMessage(context.Context, *SendChatMessageRequest) (*ChatMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendChatMessage not implemented")
+}
+func (UnimplementedSupportChatServiceServer) CloseChat(context.Context, *CloseChatRequest) (*ChatSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloseChat not implemented")
+}
+func (UnimplementedSupportChatServiceServer) ExportChatTranscript(context.Context, *ExportChatTranscriptRequest) (*ChatTranscriptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportChatTranscript not implemented")
+}
+func (UnimplementedSupportChatServiceServer) mustEmbedUnimplementedSupportChatServiceServer() {}
+func (UnimplementedSupportChatServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeSupportChatServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SupportChatServiceServer will
+// result in compilation errors.
+type UnsafeSupportChatServiceServer interface {
+	mustEmbedUnimplementedSupportChatServiceServer()
+}
+
+func RegisterSupportChatServiceServer(s grpc.ServiceRegistrar, srv SupportChatServiceServer) {
+	// If the following call panics, it indicates UnimplementedSupportChatServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SupportChatService_ServiceDesc, srv)
+}
+
+func _SupportChatService_StartChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupportChatServiceServer).StartChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupportChatService_StartChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupportChatServiceServer).StartChat(ctx, req.(*StartChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupportChatService_GetChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupportChatServiceServer).GetChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupportChatService_GetChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupportChatServiceServer).GetChat(ctx, req.(*GetChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupportChatService_ListChats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupportChatServiceServer).ListChats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupportChatService_ListChats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupportChatServiceServer).ListChats(ctx, req.(*ListChatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupportChatService_SendChatMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChatMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupportChatServiceServer).SendChatMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupportChatService_SendChatMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupportChatServiceServer).SendChatMessage(ctx, req.(*SendChatMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupportChatService_CloseChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupportChatServiceServer).CloseChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupportChatService_CloseChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupportChatServiceServer).CloseChat(ctx, req.(*CloseChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupportChatService_ExportChatTranscript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportChatTranscriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupportChatServiceServer).ExportChatTranscript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupportChatService_ExportChatTranscript_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupportChatServiceServer).ExportChatTranscript(ctx, req.(*ExportChatTranscriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SupportChatService_ServiceDesc is the grpc.ServiceDesc for SupportChatService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SupportChatService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "support.SupportChatService",
+	HandlerType: (*SupportChatServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartChat",
+			Handler:    _SupportChatService_StartChat_Handler,
+		},
+		{
+			MethodName: "GetChat",
+			Handler:    _SupportChatService_GetChat_Handler,
+		},
+		{
+			MethodName: "ListChats",
+			Handler:    _SupportChatService_ListChats_Handler,
+		},
+		{
+			MethodName: "SendChatMessage",
+			Handler:    _SupportChatService_SendChatMessage_Handler,
+		},
+		{
+			MethodName: "CloseChat",
+			Handler:    _SupportChatService_CloseChat_Handler,
+		},
+		{
+			MethodName: "ExportChatTranscript",
+			Handler:    _SupportChatService_ExportChatTranscript_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "support.proto",
+}
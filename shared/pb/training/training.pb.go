@@ -147,6 +147,7 @@ type GetVideoByFileNameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`    // partial file name to match
 	IpAddress     string                 `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"` // optional for view tracking
+	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`         // optional, required to watch premium videos
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -195,6 +196,13 @@ func (x *GetVideoByFileNameRequest) GetIpAddress() string {
 	return ""
 }
 
+func (x *GetVideoByFileNameRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
 type SearchVideosRequest struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
 	Query         string                    `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
@@ -262,6 +270,7 @@ type VideoResponse struct {
 	SubCategory   *SubCategoryInfo       `protobuf:"bytes,11,opt,name=sub_category,json=subCategory,proto3" json:"sub_category,omitempty"`
 	Stats         *VideoStats            `protobuf:"bytes,12,opt,name=stats,proto3" json:"stats,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Jalali formatted
+	Premium       bool                   `protobuf:"varint,14,opt,name=premium,proto3" json:"premium,omitempty"`                     // requires the premium_videos entitlement
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -387,6 +396,13 @@ func (x *VideoResponse) GetCreatedAt() string {
 	return ""
 }
 
+func (x *VideoResponse) GetPremium() bool {
+	if x != nil {
+		return x.Premium
+	}
+	return false
+}
+
 type VideosResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Videos        []*VideoResponse       `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
@@ -2094,16 +2110,17 @@ const file_training_proto_rawDesc = "" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x1d\n" +
 	"\n" +
-	"ip_address\x18\x03 \x01(\tR\tipAddress\"W\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\"p\n" +
 	"\x19GetVideoByFileNameRequest\x12\x1b\n" +
 	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12\x1d\n" +
 	"\n" +
-	"ip_address\x18\x02 \x01(\tR\tipAddress\"f\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x04R\x06userId\"f\n" +
 	"\x13SearchVideosRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x129\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\"\xcf\x03\n" +
+	"pagination\"\xe9\x03\n" +
 	"\rVideoResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
@@ -2119,7 +2136,8 @@ const file_training_proto_rawDesc = "" +
 	"\fsub_category\x18\v \x01(\v2\x19.training.SubCategoryInfoR\vsubCategory\x12*\n" +
 	"\x05stats\x18\f \x01(\v2\x14.training.VideoStatsR\x05stats\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\r \x01(\tR\tcreatedAt\"y\n" +
+	"created_at\x18\r \x01(\tR\tcreatedAt\x12\x18\n" +
+	"\apremium\x18\x0e \x01(\bR\apremium\"y\n" +
 	"\x0eVideosResponse\x12/\n" +
 	"\x06videos\x18\x01 \x03(\v2\x17.training.VideoResponseR\x06videos\x126\n" +
 	"\n" +
@@ -0,0 +1,98 @@
+// Package activity defines the user activity events levels-service ingests
+// from the shared event bus. Producers add an event to the Redis stream
+// Stream with the JSON encoded event under the field PayloadField, e.g.
+//
+//	XADD activity-events * event '{"id":"auth-login-9f2c","type":"login","user_id":12}'
+//
+// levels-service reads the stream through a consumer group and skips events
+// whose id it has already processed, so producers may retry freely.
+package activity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// Stream is the Redis stream activity events are added to
+	Stream = "activity-events"
+	// PayloadField is the stream entry field holding the JSON encoded event
+	PayloadField = "event"
+)
+
+// Event types
+const (
+	TypeLogin             = "login"
+	TypeLogout            = "logout"
+	TypeTrade             = "trade"
+	TypeBuild             = "build"
+	TypeTutorialCompleted = "tutorial_completed"
+	TypeDeposit           = "deposit"
+	TypeFollow            = "follow"
+
+	// Onboarding milestones, each completes a step of the onboarding checklist
+	TypePhoneVerified = "phone_verified"
+	TypeKYCVerified   = "kyc_verified"
+	TypeLandPurchased = "land_purchased"
+	TypeDynastyJoined = "dynasty_joined"
+)
+
+var knownTypes = map[string]bool{
+	TypeLogin: true, TypeLogout: true, TypeTrade: true, TypeBuild: true,
+	TypeTutorialCompleted: true, TypeDeposit: true, TypeFollow: true,
+	TypePhoneVerified: true, TypeKYCVerified: true, TypeLandPurchased: true, TypeDynastyJoined: true,
+}
+
+// maxIDLength matches the event_id column deduplication is keyed on
+const maxIDLength = 191
+
+var ErrInvalidEvent = errors.New("invalid activity event")
+
+// Event is a single user activity. ID must be unique per activity and stay
+// the same when the producer retries.
+type Event struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	UserID     uint64    `json:"user_id"`
+	OccurredAt time.Time `json:"occurred_at,omitempty"`
+	IP         string    `json:"ip,omitempty"`
+	Device     string    `json:"device,omitempty"`
+	IRRAmount  string    `json:"irr_amount,omitempty"` // trade
+	PSCAmount  string    `json:"psc_amount,omitempty"` // trade
+	Amount     string    `json:"amount,omitempty"`     // deposit
+}
+
+// Validate checks the fields every event needs
+func (e Event) Validate() error {
+	switch {
+	case e.ID == "" || len(e.ID) > maxIDLength:
+		return fmt.Errorf("%w: id must be 1 to %d characters", ErrInvalidEvent, maxIDLength)
+	case !knownTypes[e.Type]:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidEvent, e.Type)
+	case e.UserID == 0:
+		return fmt.Errorf("%w: user_id is required", ErrInvalidEvent)
+	}
+	return nil
+}
+
+// Marshal validates and encodes an event for the stream
+func (e Event) Marshal() ([]byte, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(e)
+}
+
+// Unmarshal decodes and validates an event read from the stream
+func Unmarshal(payload []byte) (Event, error) {
+	var e Event
+	if err := json.Unmarshal(payload, &e); err != nil {
+		return Event{}, fmt.Errorf("%w: %v", ErrInvalidEvent, err)
+	}
+	if err := e.Validate(); err != nil {
+		return Event{}, err
+	}
+	return e, nil
+}
@@ -0,0 +1,35 @@
+package activity
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEventRoundTrip(t *testing.T) {
+	payload, err := Event{ID: "trade-41", Type: TypeTrade, UserID: 12, IRRAmount: "8000000"}.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+
+	event, err := Unmarshal(payload)
+	if err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if event.ID != "trade-41" || event.Type != TypeTrade || event.UserID != 12 || event.IRRAmount != "8000000" {
+		t.Errorf("unexpected event %+v", event)
+	}
+}
+
+func TestUnmarshalRejectsInvalidEvents(t *testing.T) {
+	payloads := []string{
+		`not json`,
+		`{"type":"login","user_id":12}`,
+		`{"id":"e1","type":"dance","user_id":12}`,
+		`{"id":"e1","type":"login"}`,
+	}
+	for _, payload := range payloads {
+		if _, err := Unmarshal([]byte(payload)); !errors.Is(err, ErrInvalidEvent) {
+			t.Errorf("Unmarshal(%s) error = %v, want ErrInvalidEvent", payload, err)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	commercialpb "metargb/shared/pb/commercial"
+)
+
+// DefaultEntitlementTTL is how long a user's entitlements are served from
+// memory before they are fetched again
+const DefaultEntitlementTTL = time.Minute
+
+// Entitlement flags granted by subscription plans
+const (
+	// EntitlementPremiumVideos unlocks the premium training videos
+	EntitlementPremiumVideos = "premium_videos"
+	// EntitlementMaxFeatures raises how many features a user may own, written
+	// as a limit flag such as "max_features:50"
+	EntitlementMaxFeatures = "max_features"
+)
+
+// Entitlements are the flags a user's subscription grants. Flags are either
+// plain ("premium_videos") or limits written as name:value ("max_features:50").
+type Entitlements struct {
+	UserID    uint64
+	Flags     []string
+	PlanSlug  string
+	ExpiresAt time.Time
+}
+
+// Has reports whether flag was granted
+func (e *Entitlements) Has(flag string) bool {
+	if e == nil {
+		return false
+	}
+	for _, granted := range e.Flags {
+		if granted == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// Limit returns the highest value granted for the limit name, or fallback
+// when no plan grants it. Values that are not integers are ignored.
+func (e *Entitlements) Limit(name string, fallback int) int {
+	if e == nil {
+		return fallback
+	}
+	limit, found := 0, false
+	for _, granted := range e.Flags {
+		value, ok := strings.CutPrefix(granted, name+":")
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			continue
+		}
+		if !found || n > limit {
+			limit, found = n, true
+		}
+	}
+	if !found {
+		return fallback
+	}
+	return limit
+}
+
+// EntitlementResolver returns what a user's subscription grants
+type EntitlementResolver interface {
+	Entitlements(ctx context.Context, userID uint64) (*Entitlements, error)
+}
+
+type entitlementEntry struct {
+	entitlements *Entitlements
+	expiresAt    time.Time
+}
+
+// EntitlementCache fetches entitlements through commercial-service's
+// SubscriptionService.GetEntitlements and keeps them for a fixed TTL, and no
+// longer than the paid period. When commercial-service fails, the last known
+// entitlements are served so an outage does not lock subscribers out. It is
+// safe for concurrent use, and a nil *EntitlementCache grants nothing, so
+// services run on the free tier without commercial-service.
+type EntitlementCache struct {
+	client commercialpb.SubscriptionServiceClient
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu      sync.RWMutex
+	entries map[uint64]entitlementEntry
+}
+
+// NewEntitlementCache creates a cache around an existing SubscriptionService client
+func NewEntitlementCache(client commercialpb.SubscriptionServiceClient, ttl time.Duration) *EntitlementCache {
+	if ttl <= 0 {
+		ttl = DefaultEntitlementTTL
+	}
+	return &EntitlementCache{
+		client:  client,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[uint64]entitlementEntry),
+	}
+}
+
+// NewEntitlementCacheFromConn creates a cache using a connection to
+// commercial-service. GetEntitlements needs an API key with the
+// service:entitlements scope, see WithServiceAPIKey.
+func NewEntitlementCacheFromConn(conn *grpc.ClientConn, ttl time.Duration) *EntitlementCache {
+	return NewEntitlementCache(commercialpb.NewSubscriptionServiceClient(conn), ttl)
+}
+
+// Entitlements returns what the user's subscription grants
+func (c *EntitlementCache) Entitlements(ctx context.Context, userID uint64) (*Entitlements, error) {
+	if c == nil {
+		return &Entitlements{UserID: userID}, nil
+	}
+
+	c.mu.RLock()
+	e, ok := c.entries[userID]
+	c.mu.RUnlock()
+	now := c.now()
+	if ok && now.Before(e.expiresAt) {
+		return e.entitlements, nil
+	}
+
+	resp, err := c.client.GetEntitlements(ctx, &commercialpb.GetEntitlementsRequest{UserId: userID})
+	if err != nil {
+		if ok && (e.entitlements.ExpiresAt.IsZero() || now.Before(e.entitlements.ExpiresAt)) {
+			return e.entitlements, nil
+		}
+		return nil, fmt.Errorf("failed to get entitlements: %w", err)
+	}
+
+	entitlements := &Entitlements{
+		UserID:   userID,
+		Flags:    resp.Flags,
+		PlanSlug: resp.PlanSlug,
+	}
+	expiresAt := now.Add(c.ttl)
+	if resp.ExpiresAt != nil {
+		entitlements.ExpiresAt = resp.ExpiresAt.AsTime()
+		if entitlements.ExpiresAt.After(now) && entitlements.ExpiresAt.Before(expiresAt) {
+			expiresAt = entitlements.ExpiresAt
+		}
+	}
+
+	c.mu.Lock()
+	c.entries[userID] = entitlementEntry{entitlements: entitlements, expiresAt: expiresAt}
+	c.mu.Unlock()
+
+	return entitlements, nil
+}
+
+// Has reports whether the user's subscription grants flag
+func (c *EntitlementCache) Has(ctx context.Context, userID uint64, flag string) (bool, error) {
+	entitlements, err := c.Entitlements(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return entitlements.Has(flag), nil
+}
+
+// Invalidate drops a user's entitlements, e.g. after they subscribed
+func (c *EntitlementCache) Invalidate(userID uint64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, userID)
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commercialpb "metargb/shared/pb/commercial"
+)
+
+type fakeSubscriptionClient struct {
+	commercialpb.SubscriptionServiceClient
+	resp  *commercialpb.Entitlements
+	err   error
+	calls int
+}
+
+func (f *fakeSubscriptionClient) GetEntitlements(ctx context.Context, req *commercialpb.GetEntitlementsRequest, opts ...grpc.CallOption) (*commercialpb.Entitlements, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.resp, nil
+}
+
+func TestEntitlementsLimit(t *testing.T) {
+	entitlements := &Entitlements{Flags: []string{"premium_videos", "max_features:20", "max_features:50", "max_features:lots"}}
+	if got := entitlements.Limit(EntitlementMaxFeatures, 5); got != 50 {
+		t.Errorf("Limit = %d, want the highest grant 50", got)
+	}
+	if got := entitlements.Limit("max_buildings", 3); got != 3 {
+		t.Errorf("Limit without a grant = %d, want the fallback 3", got)
+	}
+	if !entitlements.Has(EntitlementPremiumVideos) || entitlements.Has("max_features") {
+		t.Error("Has should match whole flags only")
+	}
+	var none *Entitlements
+	if none.Has(EntitlementPremiumVideos) || none.Limit(EntitlementMaxFeatures, 5) != 5 {
+		t.Error("nil entitlements should grant nothing")
+	}
+}
+
+func TestEntitlementCache(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	client := &fakeSubscriptionClient{resp: &commercialpb.Entitlements{
+		UserId:    7,
+		Flags:     []string{"premium_videos"},
+		PlanSlug:  "premium-monthly",
+		ExpiresAt: timestamppb.New(now.Add(30 * time.Second)),
+	}}
+	cache := NewEntitlementCache(client, time.Minute)
+	cache.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		ok, err := cache.Has(ctx, 7, EntitlementPremiumVideos)
+		if err != nil || !ok {
+			t.Fatalf("Has = %v, %v", ok, err)
+		}
+	}
+	if client.calls != 1 {
+		t.Fatalf("expected 1 lookup, got %d", client.calls)
+	}
+
+	// Entries expire with the paid period when it ends before the TTL
+	now = now.Add(31 * time.Second)
+	client.resp = &commercialpb.Entitlements{UserId: 7, Flags: []string{}}
+	if ok, _ := cache.Has(ctx, 7, EntitlementPremiumVideos); ok || client.calls != 2 {
+		t.Fatalf("expected a refetch without entitlements, got %v after %d calls", ok, client.calls)
+	}
+
+	// The last known entitlements are served while commercial-service fails
+	cache.Invalidate(7)
+	if _, err := cache.Entitlements(ctx, 7); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(2 * time.Minute)
+	client.err = errors.New("unavailable")
+	if entitlements, err := cache.Entitlements(ctx, 7); err != nil || entitlements.UserID != 7 {
+		t.Fatalf("expected stale entitlements, got %v, %v", entitlements, err)
+	}
+	if _, err := cache.Entitlements(ctx, 8); err == nil {
+		t.Fatal("expected an error for a user never fetched")
+	}
+
+	var disabled *EntitlementCache
+	if ok, err := disabled.Has(ctx, 7, EntitlementPremiumVideos); ok || err != nil {
+		t.Fatalf("nil cache should grant nothing, got %v, %v", ok, err)
+	}
+}
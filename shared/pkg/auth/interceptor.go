@@ -15,14 +15,59 @@ import (
 // ErrInvalidToken is returned when a token validation fails
 var ErrInvalidToken = errors.New("invalid token")
 
+// ErrInvalidAPIKey is returned when an API key validation fails
+var ErrInvalidAPIKey = errors.New("invalid api key")
+
+// APIKeyMetadataKey is the gRPC metadata key carrying an API key
+const APIKeyMetadataKey = "x-api-key"
+
 // UserContextKey is the key for user data in context
 type UserContextKey struct{}
 
 // UserContext holds authenticated user information
 type UserContext struct {
-	UserID uint64
-	Email  string
-	Token  string
+	UserID   uint64
+	Email    string
+	Token    string
+	APIKeyID uint64   // Set when the caller authenticated with an API key instead of a token
+	Scopes   []string // Scopes granted to the API key or token; login tokens carry ScopeAll
+}
+
+// IsAPIKey reports whether the caller authenticated with an API key
+func (u *UserContext) IsAPIKey() bool {
+	return u.APIKeyID != 0
+}
+
+// HasScope reports whether the caller may perform actions covered by scope.
+// Granted scopes match the exact scope, the "*" wildcard, or a "<resource>:*"
+// wildcard. Tokens validated without scopes, by callers that predate them,
+// have every scope. Service scopes are only held by API keys granted exactly
+// that scope.
+func (u *UserContext) HasScope(scope string) bool {
+	if IsServiceScope(scope) {
+		if !u.IsAPIKey() {
+			return false
+		}
+		for _, granted := range u.Scopes {
+			if granted == scope {
+				return true
+			}
+		}
+		return false
+	}
+	if !u.IsAPIKey() && len(u.Scopes) == 0 {
+		return true
+	}
+	resource := scope
+	if i := strings.Index(scope, ":"); i >= 0 {
+		resource = scope[:i]
+	}
+	for _, granted := range u.Scopes {
+		if granted == "*" || granted == scope || granted == resource+":*" {
+			return true
+		}
+	}
+	return false
 }
 
 // TokenValidator interface for validating tokens
@@ -30,6 +75,12 @@ type TokenValidator interface {
 	ValidateToken(ctx context.Context, token string) (*UserContext, error)
 }
 
+// APIKeyValidator is optionally implemented by a TokenValidator to accept the
+// x-api-key metadata header as an alternative to a bearer token
+type APIKeyValidator interface {
+	ValidateAPIKey(ctx context.Context, key string) (*UserContext, error)
+}
+
 // UnaryServerInterceptor returns a new unary server interceptor for authentication
 func UnaryServerInterceptor(validator TokenValidator) grpc.UnaryServerInterceptor {
 	return func(
@@ -43,26 +94,12 @@ func UnaryServerInterceptor(validator TokenValidator) grpc.UnaryServerIntercepto
 			return handler(ctx, req)
 		}
 
-		// Extract token from metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "missing metadata")
-		}
-
-		authHeader := md.Get("authorization")
-		if len(authHeader) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "missing authorization header")
-		}
-
-		token := extractToken(authHeader[0])
-		if token == "" {
-			return nil, status.Error(codes.Unauthenticated, "invalid authorization header format")
-		}
-
-		// Validate token
-		userCtx, err := validator.ValidateToken(ctx, token)
+		userCtx, err := authenticate(ctx, validator)
 		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, fmt.Sprintf("invalid token: %v", err))
+			return nil, err
+		}
+		if err := authorizeMethod(userCtx, info.FullMethod); err != nil {
+			return nil, err
 		}
 
 		// Add user context
@@ -87,26 +124,12 @@ func StreamServerInterceptor(validator TokenValidator) grpc.StreamServerIntercep
 
 		ctx := stream.Context()
 
-		// Extract token from metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return status.Error(codes.Unauthenticated, "missing metadata")
-		}
-
-		authHeader := md.Get("authorization")
-		if len(authHeader) == 0 {
-			return status.Error(codes.Unauthenticated, "missing authorization header")
-		}
-
-		token := extractToken(authHeader[0])
-		if token == "" {
-			return status.Error(codes.Unauthenticated, "invalid authorization header format")
-		}
-
-		// Validate token
-		userCtx, err := validator.ValidateToken(ctx, token)
+		userCtx, err := authenticate(ctx, validator)
 		if err != nil {
-			return status.Error(codes.Unauthenticated, fmt.Sprintf("invalid token: %v", err))
+			return err
+		}
+		if err := authorizeMethod(userCtx, info.FullMethod); err != nil {
+			return err
 		}
 
 		// Add user context
@@ -122,6 +145,76 @@ func StreamServerInterceptor(validator TokenValidator) grpc.StreamServerIntercep
 	}
 }
 
+// ServiceUnaryServerInterceptor guards only the internal methods that require a
+// service scope, for services whose other methods are public or check the
+// caller themselves. Those methods need an API key holding the scope.
+func ServiceUnaryServerInterceptor(validator APIKeyValidator) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if _, ok := serviceMethodScopes[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		apiKey := md.Get(APIKeyMetadataKey)
+		if len(apiKey) == 0 || apiKey[0] == "" {
+			return nil, status.Error(codes.Unauthenticated, "missing api key")
+		}
+		userCtx, err := validator.ValidateAPIKey(ctx, apiKey[0])
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, fmt.Sprintf("invalid api key: %v", err))
+		}
+		if err := authorizeMethod(userCtx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		ctx = context.WithValue(ctx, UserContextKey{}, userCtx)
+		return handler(ctx, req)
+	}
+}
+
+// authenticate validates the bearer token from the incoming metadata, falling
+// back to the x-api-key header when the validator supports API keys
+func authenticate(ctx context.Context, validator TokenValidator) (*UserContext, error) {
+	// Extract token from metadata
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	authHeader := md.Get("authorization")
+	if len(authHeader) == 0 {
+		if apiKey := md.Get(APIKeyMetadataKey); len(apiKey) > 0 && apiKey[0] != "" {
+			keyValidator, ok := validator.(APIKeyValidator)
+			if !ok {
+				return nil, status.Error(codes.Unauthenticated, "api key authentication is not supported")
+			}
+			userCtx, err := keyValidator.ValidateAPIKey(ctx, apiKey[0])
+			if err != nil {
+				return nil, status.Error(codes.Unauthenticated, fmt.Sprintf("invalid api key: %v", err))
+			}
+			return userCtx, nil
+		}
+		return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+	}
+
+	token := extractToken(authHeader[0])
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "invalid authorization header format")
+	}
+
+	// Validate token
+	userCtx, err := validator.ValidateToken(ctx, token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, fmt.Sprintf("invalid token: %v", err))
+	}
+	return userCtx, nil
+}
+
 // extractToken extracts the token from "Bearer <token>" format
 func extractToken(authHeader string) string {
 	parts := strings.SplitN(authHeader, " ", 2)
@@ -143,9 +236,22 @@ func shouldSkipAuth(fullMethod string) bool {
 		"/auth.AuthService/Login",
 		"/auth.AuthService/Redirect",
 		"/auth.AuthService/Callback",
-		"/auth.AuthService/ValidateToken", // Other services call this to validate tokens
+		"/auth.AuthService/ValidateToken",    // Other services call this to validate tokens
+		"/auth.APIKeyService/ValidateAPIKey", // Gateway and services call this to validate API keys
+		"/auth.MagicLinkService/RequestMagicLink",
+		"/auth.MagicLinkService/ConsumeMagicLink",
 		// Commercial service public endpoints
 		"/commercial.WalletService/GetWallet", // Public endpoint - anyone can view any user's wallet
+		"/commercial.VariableService/GetVariables", // Exchange rates, read by other services
+		"/commercial.VariableService/DisplayRates", // Price tickers are shown without logging in
+		// Premium plans can be browsed without logging in
+		"/commercial.SubscriptionService/ListSubscriptionPlans",
+		// Marketplace listings can be browsed without logging in
+		"/features.FeatureMarketplaceService/ListForSaleFeatures",
+		// Feature galleries can be viewed without logging in
+		"/features.FeatureGalleryService/ListFeatureImages",
+		// Anyone holding a trade receipt code can check it
+		"/features.TradeReceiptService/VerifyTradeReceipt",
 	}
 
 	for _, method := range publicMethods {
@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUserContextHasScope(t *testing.T) {
+	tests := []struct {
+		name  string
+		user  UserContext
+		scope string
+		want  bool
+	}{
+		{"token user has every scope", UserContext{UserID: 1}, "features:write", true},
+		{"exact match", UserContext{UserID: 1, APIKeyID: 2, Scopes: []string{"features:read"}}, "features:read", true},
+		{"missing scope", UserContext{UserID: 1, APIKeyID: 2, Scopes: []string{"features:read"}}, "features:write", false},
+		{"resource wildcard", UserContext{UserID: 1, APIKeyID: 2, Scopes: []string{"features:*"}}, "features:write", true},
+		{"other resource wildcard", UserContext{UserID: 1, APIKeyID: 2, Scopes: []string{"wallet:*"}}, "features:write", false},
+		{"global wildcard", UserContext{UserID: 1, APIKeyID: 2, Scopes: []string{"*"}}, "wallet:read", true},
+		{"no scopes", UserContext{UserID: 1, APIKeyID: 2}, "wallet:read", false},
+		{"login token", UserContext{UserID: 1, Scopes: []string{"*"}}, "*", true},
+		{"scoped token", UserContext{UserID: 1, Scopes: []string{"marketplace:read"}}, "marketplace:read", true},
+		{"scoped token missing scope", UserContext{UserID: 1, Scopes: []string{"marketplace:read"}}, "marketplace:write", false},
+		{"scoped token on unscoped method", UserContext{UserID: 1, Scopes: []string{"marketplace:*"}}, "*", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.user.HasScope(tt.scope); got != tt.want {
+				t.Errorf("HasScope(%q) = %v, want %v", tt.scope, got, tt.want)
+			}
+		})
+	}
+}
+
+type stubValidator struct {
+	user *UserContext
+}
+
+func (v stubValidator) ValidateToken(ctx context.Context, token string) (*UserContext, error) {
+	return v.user, nil
+}
+
+func TestUnaryServerInterceptorChecksMethodScope(t *testing.T) {
+	tests := []struct {
+		name   string
+		scopes []string
+		method string
+		want   codes.Code
+	}{
+		{"login token", []string{"*"}, "/commercial.WalletService/DeductBalance", codes.OK},
+		{"granted scope", []string{"wallet:read"}, "/commercial.TransactionService/ListTransactions", codes.OK},
+		{"resource wildcard", []string{"marketplace:*"}, "/features.FeatureMarketplaceService/BuyFeature", codes.OK},
+		{"missing scope", []string{"wallet:read"}, "/commercial.PaymentService/InitiatePayment", codes.PermissionDenied},
+		{"method without scope", []string{"wallet:*"}, "/commercial.WalletService/DeductBalance", codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := UnaryServerInterceptor(stubValidator{user: &UserContext{UserID: 1, Scopes: tt.scopes}})
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			})
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("code = %v, want %v (err %v)", got, tt.want, err)
+			}
+		})
+	}
+}
+
+func TestIsKnownScope(t *testing.T) {
+	for scope, want := range map[string]bool{
+		"*":                 true,
+		"features:read":     true,
+		"marketplace:*":     true,
+		"wallet:write":      true,
+		"wallet:delete":     false,
+		"profile:*":         false,
+		"marketplacex:read": false,
+	} {
+		if got := IsKnownScope(scope); got != want {
+			t.Errorf("IsKnownScope(%q) = %v, want %v", scope, got, want)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package auth
+
+import (
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ScopeAll grants every scope. Login tokens carry it, and it is required by
+// methods without an entry in methodScopes.
+const ScopeAll = "*"
+
+// methodScopes maps each gRPC method a scoped token or API key may call to the
+// scope it requires. Scopes are "<resource>:<action>".
+var methodScopes = map[string]string{
+	// Features and their buildings, profits and images
+	"/features.FeatureService/ListFeatures":                  "features:read",
+	"/features.FeatureService/GetFeature":                    "features:read",
+	"/features.FeatureService/GetMyFeatures":                 "features:read",
+	"/features.FeatureService/ListMyFeatures":                "features:read",
+	"/features.FeatureService/GetMyFeature":                  "features:read",
+	"/features.MapsService/ListMaps":                         "features:read",
+	"/features.MapsService/GetMap":                           "features:read",
+	"/features.MapsService/GetMapBorder":                     "features:read",
+	"/features.FeatureProfitService/GetHourlyProfits":        "features:read",
+	"/features.FeatureProfitService/GetFeatureProfit":        "features:read",
+	"/features.FeatureProfitService/GetProfitSettings":       "features:read",
+	"/features.BuildingService/GetBuildPackage":              "features:read",
+	"/features.BuildingService/StreamBuildPackage":           "features:read",
+	"/features.BuildingService/GetBuildings":                 "features:read",
+	"/features.BuildingService/SimulateBuild":                "features:read",
+	"/features.FeatureGeometryService/ListGeometryVersions":  "features:read",
+	"/features.FeaturePortfolioService/GetUserPortfolio":     "features:read",
+	"/features.FeatureHistoryService/GetFeatureHistory":      "features:read",
+	"/features.TradeReceiptService/GetTradeReceipt":          "features:read",
+	"/features.FeatureService/UpdateFeature":                 "features:write",
+	"/features.FeatureService/AddFeatureImages":              "features:write",
+	"/features.FeatureService/AddMyFeatureImages":            "features:write",
+	"/features.FeatureService/RemoveMyFeatureImage":          "features:write",
+	"/features.FeatureService/UpdateMyFeature":               "features:write",
+	"/features.FeatureProfitService/GetSingleProfit":         "features:write", // Credits the profit to the wallet
+	"/features.FeatureProfitService/GetProfitsByApplication": "features:write", // Credits the profits to the wallet
+	"/features.FeatureProfitService/UpdateProfitSettings":    "features:write",
+	"/features.BuildingService/BuildFeature":                 "features:write",
+	"/features.BuildingService/UpdateBuilding":               "features:write",
+	"/features.BuildingService/DestroyBuilding":              "features:write",
+	"/features.FeatureGalleryService/AttachFeatureImages":    "features:write",
+	"/features.FeatureGalleryService/RemoveFeatureImage":     "features:write",
+	"/features.FeatureGalleryService/ReorderFeatureImages":   "features:write",
+	"/features.FeatureGalleryService/SetFeatureCoverImage":   "features:write",
+
+	// Buying and selling features
+	"/features.FeatureMarketplaceService/ListSellRequests":              "marketplace:read",
+	"/features.FeatureMarketplaceService/ListBuyRequests":               "marketplace:read",
+	"/features.FeatureMarketplaceService/ListReceivedBuyRequests":       "marketplace:read",
+	"/features.FeatureMarketplaceService/GetLimitedFeatureAvailability": "marketplace:read",
+	"/features.WatchlistService/ListWatchlist":                          "marketplace:read",
+	"/features.SavedSearchService/ListSavedSearches":                    "marketplace:read",
+	"/features.TradeService/GetTrade":                                   "marketplace:read",
+	"/features.FeatureMarketplaceService/BuyFeature":                    "marketplace:write",
+	"/features.FeatureMarketplaceService/SendBuyRequest":                "marketplace:write",
+	"/features.FeatureMarketplaceService/AcceptBuyRequest":              "marketplace:write",
+	"/features.FeatureMarketplaceService/RejectBuyRequest":              "marketplace:write",
+	"/features.FeatureMarketplaceService/DeleteBuyRequest":              "marketplace:write",
+	"/features.FeatureMarketplaceService/CreateSellRequest":             "marketplace:write",
+	"/features.FeatureMarketplaceService/DeleteSellRequest":             "marketplace:write",
+	"/features.FeatureMarketplaceService/RequestGracePeriod":            "marketplace:write",
+	"/features.FeatureMarketplaceService/UpdateGracePeriod":             "marketplace:write",
+	"/features.WatchlistService/AddToWatchlist":                         "marketplace:write",
+	"/features.WatchlistService/RemoveFromWatchlist":                    "marketplace:write",
+	"/features.SavedSearchService/CreateSavedSearch":                    "marketplace:write",
+	"/features.SavedSearchService/UpdateSavedSearch":                    "marketplace:write",
+	"/features.SavedSearchService/DeleteSavedSearch":                    "marketplace:write",
+
+	// Wallet history, payments, installments and subscriptions
+	"/commercial.TransactionService/ListTransactions":      "wallet:read",
+	"/commercial.TransactionService/GetLatestTransaction":  "wallet:read",
+	"/commercial.OrderService/ListOrders":                  "wallet:read",
+	"/commercial.InstallmentService/ListInstallmentPlans":  "wallet:read",
+	"/commercial.InstallmentService/GetInstallmentPlan":    "wallet:read",
+	"/commercial.ExchangeService/ListExchangeRates":        "wallet:read",
+	"/commercial.SpendingLimitService/GetSpendingLimits":   "wallet:read",
+	"/commercial.PaymentService/InitiatePayment":           "wallet:write",
+	"/commercial.InstallmentService/CreateInstallmentPlan": "wallet:write",
+	"/commercial.InstallmentService/PayInstallment":        "wallet:write",
+	"/commercial.ExchangeService/Convert":                  "wallet:write",
+	"/commercial.SpendingLimitService/SetSpendingLimits":   "wallet:write",
+	"/commercial.SubscriptionService/GetSubscription":      "wallet:read",
+	"/commercial.SubscriptionService/Subscribe":            "wallet:write",
+	"/commercial.SubscriptionService/CancelSubscription":   "wallet:write",
+	"/commercial.SubscriptionService/PaySubscription":      "wallet:write",
+}
+
+// ServiceScopePrefix starts the scopes of internal methods that other services
+// call on behalf of any user. Only API keys listing the exact scope are
+// granted them; "*" and login tokens are not.
+const ServiceScopePrefix = "service:"
+
+// serviceMethodScopes maps the internal methods not routed by the gateway to
+// the service scope the calling service's API key must carry
+var serviceMethodScopes = map[string]string{
+	// Feature reservations for installment plans, called by commercial-service
+	"/features.FeatureInstallmentService/ReserveFeature":            "service:installments",
+	"/features.FeatureInstallmentService/CompleteReservedPurchase":  "service:installments",
+	"/features.FeatureInstallmentService/ReleaseFeatureReservation": "service:installments",
+	// Figures for the scheduled admin reports, called by reporting-service
+	"/stats.StatsService/GetStats": "service:reports",
+	// Entitlement flags gating premium features, called by features-service
+	"/commercial.SubscriptionService/GetEntitlements": "service:entitlements",
+	// Land counts for the dynasty leaderboards, called by dynasty-service
+	"/features.FeatureService/CountOwnedFeatures": "service:feature-counts",
+	// Fraud screening and crediting of store orders, called by financial-service
+	"/commercial.PaymentService/ScreenPayment":      "service:payments",
+	"/commercial.PaymentService/ScreenPaymentCard":  "service:payments",
+	"/commercial.PaymentService/SettleOrder":        "service:payments",
+	"/commercial.PaymentService/PublishOrderStatus": "service:payments",
+}
+
+// IsServiceScope reports whether scope guards an internal method
+func IsServiceScope(scope string) bool {
+	return strings.HasPrefix(scope, ServiceScopePrefix)
+}
+
+// IsKnownServiceScope reports whether scope is the exact scope of some
+// internal method, which only API keys of service key admins may list
+func IsKnownServiceScope(scope string) bool {
+	for _, known := range serviceMethodScopes {
+		if known == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// RequiredScope returns the scope a method requires, ScopeAll for methods a
+// scoped caller may not call
+func RequiredScope(fullMethod string) string {
+	if scope, ok := serviceMethodScopes[fullMethod]; ok {
+		return scope
+	}
+	if scope, ok := methodScopes[fullMethod]; ok {
+		return scope
+	}
+	return ScopeAll
+}
+
+// KnownScopes returns every scope some method requires, sorted
+func KnownScopes() []string {
+	seen := make(map[string]bool)
+	var scopes []string
+	for _, scope := range methodScopes {
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, scope)
+		}
+	}
+	sort.Strings(scopes)
+	return scopes
+}
+
+// IsKnownScope reports whether scope can be granted: ScopeAll, a known scope,
+// or "<resource>:*" for a resource with known scopes
+func IsKnownScope(scope string) bool {
+	if scope == ScopeAll {
+		return true
+	}
+	resource, action, ok := strings.Cut(scope, ":")
+	for _, known := range methodScopes {
+		if known == scope || (ok && action == "*" && strings.HasPrefix(known, resource+":")) {
+			return true
+		}
+	}
+	return false
+}
+
+// authorizeMethod rejects callers without the scope fullMethod requires
+func authorizeMethod(userCtx *UserContext, fullMethod string) error {
+	scope := RequiredScope(fullMethod)
+	if !userCtx.HasScope(scope) {
+		return status.Errorf(codes.PermissionDenied, "missing scope %s for %s", scope, fullMethod)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequiredScope(t *testing.T) {
+	for method, want := range map[string]string{
+		"/features.FeatureService/GetFeature":                    "features:read",
+		"/features.FeatureMarketplaceService/BuyFeature":         "marketplace:write",
+		"/commercial.TransactionService/ListTransactions":        "wallet:read",
+		"/commercial.WalletService/DeductBalance":                ScopeAll,
+		"/features.FeatureService/NoSuchMethod":                  ScopeAll,
+		"/commercial.SubscriptionService/CancelSubscription":     "wallet:write",
+		"/features.FeatureProfitService/GetProfitsByApplication": "features:write",
+		"/features.FeatureInstallmentService/ReserveFeature":     "service:installments",
+	} {
+		if got := RequiredScope(method); got != want {
+			t.Errorf("RequiredScope(%s) = %q, want %q", method, got, want)
+		}
+	}
+}
+
+func TestKnownScopesAreGrantable(t *testing.T) {
+	scopes := KnownScopes()
+	if len(scopes) == 0 {
+		t.Fatal("no known scopes")
+	}
+	for i, scope := range scopes {
+		if scope == ScopeAll || IsServiceScope(scope) {
+			t.Errorf("KnownScopes() lists %q", ScopeAll)
+		}
+		if !IsKnownScope(scope) {
+			t.Errorf("IsKnownScope(%q) = false for a listed scope", scope)
+		}
+		if i > 0 && scopes[i-1] >= scope {
+			t.Errorf("KnownScopes() not sorted and unique at %q", scope)
+		}
+	}
+}
+
+func TestIsKnownServiceScope(t *testing.T) {
+	for scope, want := range map[string]bool{
+		"service:installments": true,
+		"service:reports":      true,
+		"service:*":            false,
+		"service:payments":     true,
+		"service:billing":      false,
+		"features:read":        false,
+	} {
+		if got := IsKnownServiceScope(scope); got != want {
+			t.Errorf("IsKnownServiceScope(%q) = %v, want %v", scope, got, want)
+		}
+	}
+}
+
+func TestAuthorizeMethod(t *testing.T) {
+	tests := []struct {
+		name   string
+		user   UserContext
+		method string
+		want   codes.Code
+	}{
+		{"token without scopes", UserContext{UserID: 1}, "/commercial.WalletService/DeductBalance", codes.OK},
+		{"login token", UserContext{UserID: 1, Scopes: []string{ScopeAll}}, "/commercial.WalletService/DeductBalance", codes.OK},
+		{"scoped token read", UserContext{UserID: 1, Scopes: []string{"features:read"}}, "/features.FeatureService/GetFeature", codes.OK},
+		{"scoped token write", UserContext{UserID: 1, Scopes: []string{"features:read"}}, "/features.FeatureService/UpdateFeature", codes.PermissionDenied},
+		{"scoped token unmapped method", UserContext{UserID: 1, Scopes: []string{"features:*", "wallet:*"}}, "/commercial.WalletService/DeductBalance", codes.PermissionDenied},
+		{"api key without scopes", UserContext{UserID: 1, APIKeyID: 3}, "/features.FeatureService/GetFeature", codes.PermissionDenied},
+		{"api key resource wildcard", UserContext{UserID: 1, APIKeyID: 3, Scopes: []string{"wallet:*"}}, "/commercial.PaymentService/InitiatePayment", codes.OK},
+		{"api key other resource", UserContext{UserID: 1, APIKeyID: 3, Scopes: []string{"wallet:*"}}, "/features.FeatureMarketplaceService/BuyFeature", codes.PermissionDenied},
+		{"service key", UserContext{UserID: 2, APIKeyID: 4, Scopes: []string{"service:installments"}}, "/features.FeatureInstallmentService/CompleteReservedPurchase", codes.OK},
+		{"service key user method", UserContext{UserID: 2, APIKeyID: 4, Scopes: []string{"service:installments"}}, "/features.FeatureService/GetFeature", codes.PermissionDenied},
+		{"api key wildcard internal method", UserContext{UserID: 1, APIKeyID: 3, Scopes: []string{ScopeAll}}, "/features.FeatureInstallmentService/ReserveFeature", codes.PermissionDenied},
+		{"api key service wildcard", UserContext{UserID: 1, APIKeyID: 3, Scopes: []string{"service:*"}}, "/features.FeatureInstallmentService/ReserveFeature", codes.PermissionDenied},
+		{"login token internal method", UserContext{UserID: 1, Scopes: []string{ScopeAll}}, "/features.FeatureInstallmentService/ReserveFeature", codes.PermissionDenied},
+		{"token without scopes internal method", UserContext{UserID: 1}, "/features.FeatureInstallmentService/ReleaseFeatureReservation", codes.PermissionDenied},
+		{"user token reading entitlements", UserContext{UserID: 1}, "/commercial.SubscriptionService/GetEntitlements", codes.PermissionDenied},
+		{"entitlements key", UserContext{UserID: 2, APIKeyID: 5, Scopes: []string{"service:entitlements"}}, "/commercial.SubscriptionService/GetEntitlements", codes.OK},
+		{"user token counting lands", UserContext{UserID: 1, Scopes: []string{"features:*"}}, "/features.FeatureService/CountOwnedFeatures", codes.PermissionDenied},
+		{"feature counts key", UserContext{UserID: 2, APIKeyID: 6, Scopes: []string{"service:feature-counts"}}, "/features.FeatureService/CountOwnedFeatures", codes.OK},
+		{"user token screening a payment", UserContext{UserID: 1, Scopes: []string{ScopeAll}}, "/commercial.PaymentService/ScreenPayment", codes.PermissionDenied},
+		{"payments key", UserContext{UserID: 2, APIKeyID: 7, Scopes: []string{"service:payments"}}, "/commercial.PaymentService/ScreenPaymentCard", codes.OK},
+		{"token listing a service scope", UserContext{UserID: 1, Scopes: []string{"service:installments"}}, "/features.FeatureInstallmentService/ReserveFeature", codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorizeMethod(&tt.user, tt.method)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("authorizeMethod() code = %v, want %v (err %v)", got, tt.want, err)
+			}
+			if err != nil && status.Convert(err).Message() != "missing scope "+RequiredScope(tt.method)+" for "+tt.method {
+				t.Errorf("authorizeMethod() message = %q", status.Convert(err).Message())
+			}
+		})
+	}
+}
+
+// stubKeyValidator accepts one bearer token and one API key
+type stubKeyValidator struct {
+	token, key *UserContext
+}
+
+func (v stubKeyValidator) ValidateToken(context.Context, string) (*UserContext, error) {
+	return v.token, nil
+}
+
+func (v stubKeyValidator) ValidateAPIKey(context.Context, string) (*UserContext, error) {
+	return v.key, nil
+}
+
+type stubServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s stubServerStream) Context() context.Context { return s.ctx }
+
+func TestInterceptorsEnforceAPIKeyScopes(t *testing.T) {
+	validator := stubKeyValidator{
+		token: &UserContext{UserID: 1},
+		key:   &UserContext{UserID: 1, APIKeyID: 9, Scopes: []string{"features:read"}},
+	}
+	keyCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(APIKeyMetadataKey, "mgk_test"))
+
+	unary := UnaryServerInterceptor(validator)
+	call := func(method string) error {
+		_, err := unary(keyCtx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			if user, err := GetUserFromContext(ctx); err != nil || user.APIKeyID != 9 {
+				t.Errorf("handler user = %+v, %v", user, err)
+			}
+			return nil, nil
+		})
+		return err
+	}
+	if err := call("/features.FeatureService/GetFeature"); err != nil {
+		t.Errorf("granted unary method: %v", err)
+	}
+	if err := call("/features.FeatureService/UpdateFeature"); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ungranted unary method: %v, want PermissionDenied", err)
+	}
+
+	stream := StreamServerInterceptor(validator)
+	serve := func(method string) error {
+		return stream(nil, stubServerStream{ctx: keyCtx}, &grpc.StreamServerInfo{FullMethod: method}, func(srv interface{}, ss grpc.ServerStream) error {
+			return nil
+		})
+	}
+	if err := serve("/features.BuildingService/StreamBuildPackage"); err != nil {
+		t.Errorf("granted stream method: %v", err)
+	}
+	if err := serve("/features.FeatureMarketplaceService/BuyFeature"); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ungranted stream method: %v, want PermissionDenied", err)
+	}
+}
+
+func TestWithServiceAPIKeySendsTheKey(t *testing.T) {
+	md, err := serviceKeyCredentials{key: "mgk_service"}.GetRequestMetadata(context.Background())
+	if err != nil || md[APIKeyMetadataKey] != "mgk_service" {
+		t.Fatalf("GetRequestMetadata() = %v, %v", md, err)
+	}
+	if _, ok := WithServiceAPIKey("").(grpc.EmptyDialOption); !ok {
+		t.Error("WithServiceAPIKey(\"\") should add nothing")
+	}
+}
+
+func TestServiceUnaryServerInterceptor(t *testing.T) {
+	validator := stubKeyValidator{key: &UserContext{UserID: 2, APIKeyID: 4, Scopes: []string{"service:reports"}}}
+	interceptor := ServiceUnaryServerInterceptor(validator)
+	call := func(ctx context.Context, method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return err
+	}
+	withKey := metadata.NewIncomingContext(context.Background(), metadata.Pairs(APIKeyMetadataKey, "mgk_reports"))
+	withToken := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer login-token"))
+
+	if err := call(withKey, "/stats.StatsService/GetStats"); err != nil {
+		t.Errorf("service key: %v", err)
+	}
+	if err := call(withToken, "/stats.StatsService/GetStats"); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("login token: %v, want Unauthenticated", err)
+	}
+	if err := call(context.Background(), "/stats.StatsService/GetStats"); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("no credentials: %v, want Unauthenticated", err)
+	}
+	if err := call(withKey, "/features.FeatureInstallmentService/ReserveFeature"); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("other service scope: %v, want PermissionDenied", err)
+	}
+	if err := call(context.Background(), "/auth.AuthService/Login"); err != nil {
+		t.Errorf("unguarded method: %v", err)
+	}
+}
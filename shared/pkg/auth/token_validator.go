@@ -12,17 +12,20 @@ import (
 // This adapter bridges the gap between the auth service's ValidateToken response
 // and the middleware's expected UserContext format.
 type AuthServiceTokenValidator struct {
-	authClient pb.AuthServiceClient
+	authClient   pb.AuthServiceClient
+	apiKeyClient pb.APIKeyServiceClient
 }
 
 // NewAuthServiceTokenValidator creates a new token validator that uses the auth service.
 func NewAuthServiceTokenValidator(conn *grpc.ClientConn) *AuthServiceTokenValidator {
 	return &AuthServiceTokenValidator{
-		authClient: pb.NewAuthServiceClient(conn),
+		authClient:   pb.NewAuthServiceClient(conn),
+		apiKeyClient: pb.NewAPIKeyServiceClient(conn),
 	}
 }
 
 // NewAuthServiceTokenValidatorWithClient creates a validator with an existing client.
+// API keys are rejected unless WithAPIKeyClient is also called.
 func NewAuthServiceTokenValidatorWithClient(client pb.AuthServiceClient) *AuthServiceTokenValidator {
 	return &AuthServiceTokenValidator{
 		authClient: client,
@@ -46,5 +49,37 @@ func (v *AuthServiceTokenValidator) ValidateToken(ctx context.Context, token str
 		UserID: resp.UserId,
 		Email:  resp.Email,
 		Token:  token,
+		Scopes: resp.Scopes,
+	}, nil
+}
+
+// WithAPIKeyClient enables API key validation using the given client.
+func (v *AuthServiceTokenValidator) WithAPIKeyClient(client pb.APIKeyServiceClient) *AuthServiceTokenValidator {
+	v.apiKeyClient = client
+	return v
+}
+
+// ValidateAPIKey validates an API key by calling the auth service and returns UserContext.
+func (v *AuthServiceTokenValidator) ValidateAPIKey(ctx context.Context, key string) (*UserContext, error) {
+	if v.apiKeyClient == nil {
+		return nil, ErrInvalidAPIKey
+	}
+
+	resp, err := v.apiKeyClient.ValidateAPIKey(ctx, &pb.ValidateAPIKeyRequest{
+		Key: key,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Valid {
+		return nil, ErrInvalidAPIKey
+	}
+
+	return &UserContext{
+		UserID:   resp.UserId,
+		Email:    resp.Email,
+		APIKeyID: resp.KeyId,
+		Scopes:   resp.Scopes,
 	}, nil
 }
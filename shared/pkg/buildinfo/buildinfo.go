@@ -0,0 +1,146 @@
+// Package buildinfo reports which build of a service is running. Release
+// builds set the version, commit and build date with -ldflags:
+//
+//	go build -ldflags "-X metargb/shared/pkg/buildinfo.Version=1.4.0 \
+//	  -X metargb/shared/pkg/buildinfo.Commit=$(git rev-parse HEAD) \
+//	  -X metargb/shared/pkg/buildinfo.BuildDate=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+//
+// Local builds fall back to the VCS stamp go build records from a git
+// checkout. The build is served as JSON on Path by the health probe and
+// metrics servers, exported as the metargb_build_info metric, and sent as
+// gRPC response headers.
+package buildinfo
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"runtime"
+	"runtime/debug"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Set with -ldflags -X at build time
+var (
+	Version   = "dev"
+	Commit    = ""
+	BuildDate = ""
+)
+
+// Path is the HTTP path serving the build
+const Path = "/version"
+
+// gRPC response headers naming the build
+const (
+	VersionHeader = "x-build-version"
+	CommitHeader  = "x-build-commit"
+)
+
+// Info describes the running build
+type Info struct {
+	Service   string `json:"service"`
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"build_date"`
+	GoVersion string `json:"go_version"`
+}
+
+var buildInfo = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "metargb",
+		Name:      "build_info",
+		Help:      "Build of the running service; always 1",
+	},
+	[]string{"service", "version", "commit", "build_date", "go_version"},
+)
+
+var (
+	mu      sync.RWMutex
+	service string
+)
+
+// Register names the running service and sets its metargb_build_info series.
+// The health probe calls it, so services rarely need to.
+func Register(name string) Info {
+	mu.Lock()
+	service = name
+	mu.Unlock()
+
+	info := Get()
+	buildInfo.WithLabelValues(info.Service, info.Version, info.Commit, info.BuildDate, info.GoVersion).Set(1)
+	return info
+}
+
+// Get returns the running build
+func Get() Info {
+	mu.RLock()
+	info := Info{Service: service, Version: Version, Commit: Commit, BuildDate: BuildDate, GoVersion: runtime.Version()}
+	mu.RUnlock()
+
+	if info.Commit == "" || info.BuildDate == "" {
+		if build, ok := debug.ReadBuildInfo(); ok {
+			for _, setting := range build.Settings {
+				switch {
+				case setting.Key == "vcs.revision" && info.Commit == "":
+					info.Commit = setting.Value
+				case setting.Key == "vcs.time" && info.BuildDate == "":
+					info.BuildDate = setting.Value
+				case setting.Key == "vcs.modified" && setting.Value == "true" && info.Version == "dev":
+					info.Version = "dev-dirty"
+				}
+			}
+		}
+	}
+	if info.Commit == "" {
+		info.Commit = "unknown"
+	}
+	if info.BuildDate == "" {
+		info.BuildDate = "unknown"
+	}
+	return info
+}
+
+// Handler serves the running build as JSON
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		json.NewEncoder(w).Encode(Get())
+	})
+}
+
+// ServerOptions add the build's version and commit to the headers of every
+// gRPC response, where grpcurl -v and client logs can show them
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(StreamServerInterceptor()),
+	}
+}
+
+// UnaryServerInterceptor sends the build headers with every unary response
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		_ = grpc.SetHeader(ctx, header().Copy())
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor sends the build headers with every stream
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		_ = stream.SetHeader(header().Copy())
+		return handler(srv, stream)
+	}
+}
+
+// header is built once, as the build cannot change while running
+var header = sync.OnceValue(func() metadata.MD {
+	info := Get()
+	return metadata.Pairs(VersionHeader, info.Version, CommitHeader, info.Commit)
+})
@@ -0,0 +1,43 @@
+package buildinfo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRegister(t *testing.T) {
+	oldVersion, oldCommit, oldDate := Version, Commit, BuildDate
+	t.Cleanup(func() { Version, Commit, BuildDate = oldVersion, oldCommit, oldDate })
+	Version, Commit, BuildDate = "1.4.0", "abc123", "2026-01-02T03:04:05Z"
+
+	info := Register("levels-service")
+	want := Info{Service: "levels-service", Version: "1.4.0", Commit: "abc123", BuildDate: "2026-01-02T03:04:05Z", GoVersion: runtime.Version()}
+	if info != want {
+		t.Errorf("Register() = %+v, want %+v", info, want)
+	}
+
+	expected := `
+# HELP metargb_build_info Build of the running service; always 1
+# TYPE metargb_build_info gauge
+metargb_build_info{build_date="2026-01-02T03:04:05Z",commit="abc123",go_version="` + runtime.Version() + `",service="levels-service",version="1.4.0"} 1
+`
+	if err := testutil.CollectAndCompare(buildInfo, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+	var served Info
+	if err := json.NewDecoder(rec.Body).Decode(&served); err != nil {
+		t.Fatalf("decode %s: %v", Path, err)
+	}
+	if served != want {
+		t.Errorf("%s = %+v, want %+v", Path, served, want)
+	}
+}
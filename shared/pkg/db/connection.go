@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"time"
@@ -35,32 +36,15 @@ func NewConnection(cfg Config) (*Connection, error) {
 		cfg.Database,
 	)
 
-	var db *sql.DB
-	var err error
-
-	// Retry logic for initial connection
-	maxRetries := 5
-	for i := 0; i < maxRetries; i++ {
-		db, err = sql.Open("mysql", dsn)
-		if err != nil {
-			if i == maxRetries-1 {
-				return nil, fmt.Errorf("failed to open database after %d attempts: %w", maxRetries, err)
-			}
-			time.Sleep(time.Second * time.Duration(i+1))
-			continue
-		}
-
-		err = db.Ping()
-		if err != nil {
-			if i == maxRetries-1 {
-				return nil, fmt.Errorf("failed to ping database after %d attempts: %w", maxRetries, err)
-			}
-			time.Sleep(time.Second * time.Duration(i+1))
-			continue
-		}
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
 
-		// Successfully connected
-		break
+	// Retry the initial ping so a database restart doesn't crash the caller
+	if err := PingWithRetry(context.Background(), db, RetryPolicyFromEnv()); err != nil {
+		db.Close()
+		return nil, err
 	}
 
 	// Set connection pool settings
@@ -0,0 +1,28 @@
+package db
+
+import (
+	"os"
+	"strings"
+)
+
+// ServiceDSN picks the DSN a service should connect with. A service-specific
+// <SERVICE>_DB_DSN (e.g. FEATURES_DB_DSN for "features-service") wins over the
+// shared DB_DSN, and fallback - usually built from DB_HOST, DB_DATABASE etc. -
+// is used when neither is set. This lets each service move to its own schema
+// without touching the others' configuration.
+func ServiceDSN(service, fallback string) string {
+	if dsn := os.Getenv(ServiceDSNEnv(service)); dsn != "" {
+		return dsn
+	}
+	if dsn := os.Getenv("DB_DSN"); dsn != "" {
+		return dsn
+	}
+	return fallback
+}
+
+// ServiceDSNEnv returns the name of the service-specific DSN variable
+func ServiceDSNEnv(service string) string {
+	name := strings.TrimSuffix(service, "-service")
+	name = strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+	return name + "_DB_DSN"
+}
@@ -0,0 +1,40 @@
+package db
+
+import "testing"
+
+func TestServiceDSNEnv(t *testing.T) {
+	cases := map[string]string{
+		"features-service":      "FEATURES_DB_DSN",
+		"features":              "FEATURES_DB_DSN",
+		"notifications-service": "NOTIFICATIONS_DB_DSN",
+		"health-check-service":  "HEALTH_CHECK_DB_DSN",
+	}
+	for service, want := range cases {
+		if got := ServiceDSNEnv(service); got != want {
+			t.Errorf("ServiceDSNEnv(%q) = %q, want %q", service, got, want)
+		}
+	}
+}
+
+func TestServiceDSN(t *testing.T) {
+	const fallback = "root:@tcp(localhost:3306)/metargb_db"
+
+	t.Setenv("FEATURES_DB_DSN", "")
+	t.Setenv("DB_DSN", "")
+	if got := ServiceDSN("features-service", fallback); got != fallback {
+		t.Errorf("expected fallback, got %q", got)
+	}
+
+	t.Setenv("DB_DSN", "shared")
+	if got := ServiceDSN("features-service", fallback); got != "shared" {
+		t.Errorf("expected DB_DSN, got %q", got)
+	}
+
+	t.Setenv("FEATURES_DB_DSN", "features")
+	if got := ServiceDSN("features-service", fallback); got != "features" {
+		t.Errorf("expected FEATURES_DB_DSN, got %q", got)
+	}
+	if got := ServiceDSN("levels-service", fallback); got != "shared" {
+		t.Errorf("other services must not pick up FEATURES_DB_DSN, got %q", got)
+	}
+}
@@ -0,0 +1,111 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// ErrDatabaseUnavailable is reported by HealthMonitor.Check while the
+// database cannot be reached
+var ErrDatabaseUnavailable = errors.New("database unreachable")
+
+// HealthMonitor periodically pings the database and mirrors the result into
+// the gRPC health server, so load balancers stop routing to a service while
+// its database is unreachable. database/sql re-dials broken connections on its
+// own; the monitor only reports whether that is currently succeeding.
+type HealthMonitor struct {
+	db       *sql.DB
+	health   *health.Server
+	services []string
+	interval time.Duration
+	timeout  time.Duration
+	healthy  atomic.Bool
+	checked  atomic.Bool
+}
+
+// NewHealthMonitor creates a monitor that updates the overall ("") status and
+// any additional gRPC service names on the given health server. healthServer
+// may be nil when a health.Probe owns the gRPC status and uses Check instead.
+func NewHealthMonitor(db *sql.DB, healthServer *health.Server, services ...string) *HealthMonitor {
+	return &HealthMonitor{
+		db:       db,
+		health:   healthServer,
+		services: append([]string{""}, services...),
+		interval: 10 * time.Second,
+		timeout:  3 * time.Second,
+	}
+}
+
+// WithInterval sets how often the database is pinged (default: 10s)
+func (m *HealthMonitor) WithInterval(interval time.Duration) *HealthMonitor {
+	if interval > 0 {
+		m.interval = interval
+	}
+	return m
+}
+
+// Start runs an initial check and then keeps checking until ctx is cancelled
+func (m *HealthMonitor) Start(ctx context.Context) {
+	m.check(ctx)
+
+	go func() {
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.check(ctx)
+			}
+		}
+	}()
+}
+
+// Healthy reports the result of the most recent check
+func (m *HealthMonitor) Healthy() bool {
+	return m.healthy.Load()
+}
+
+// Check is a health.Check reporting the most recent ping
+func (m *HealthMonitor) Check(context.Context) error {
+	if !m.Healthy() {
+		return ErrDatabaseUnavailable
+	}
+	return nil
+}
+
+func (m *HealthMonitor) check(ctx context.Context) {
+	pingCtx, cancel := context.WithTimeout(ctx, m.timeout)
+	err := m.db.PingContext(pingCtx)
+	cancel()
+
+	healthy := err == nil
+	previous := m.healthy.Swap(healthy)
+	if m.checked.Swap(true) && previous != healthy {
+		if healthy {
+			log.Println("Database connection restored")
+		} else {
+			log.Printf("Database connection lost: %v", err)
+		}
+	}
+
+	if m.health == nil {
+		return
+	}
+
+	status := healthpb.HealthCheckResponse_SERVING
+	if !healthy {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	for _, service := range m.services {
+		m.health.SetServingStatus(service, status)
+	}
+}
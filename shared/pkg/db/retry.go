@@ -0,0 +1,106 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how connection attempts are retried during startup
+type RetryPolicy struct {
+	MaxAttempts    int           // 0 means retry until the context is cancelled
+	InitialBackoff time.Duration // delay before the second attempt
+	MaxBackoff     time.Duration // upper bound for the exponential delay
+	PingTimeout    time.Duration // timeout for a single ping attempt
+}
+
+// DefaultRetryPolicy returns the retry policy used when no overrides are configured
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    30,
+		InitialBackoff: time.Second,
+		MaxBackoff:     30 * time.Second,
+		PingTimeout:    5 * time.Second,
+	}
+}
+
+// RetryPolicyFromEnv builds a retry policy from DB_CONNECT_MAX_ATTEMPTS,
+// DB_CONNECT_INITIAL_BACKOFF, DB_CONNECT_MAX_BACKOFF and DB_CONNECT_PING_TIMEOUT
+func RetryPolicyFromEnv() RetryPolicy {
+	policy := DefaultRetryPolicy()
+
+	if v := os.Getenv("DB_CONNECT_MAX_ATTEMPTS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			policy.MaxAttempts = n
+		}
+	}
+	if v := os.Getenv("DB_CONNECT_INITIAL_BACKOFF"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			policy.InitialBackoff = d
+		}
+	}
+	if v := os.Getenv("DB_CONNECT_MAX_BACKOFF"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			policy.MaxBackoff = d
+		}
+	}
+	if v := os.Getenv("DB_CONNECT_PING_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			policy.PingTimeout = d
+		}
+	}
+
+	return policy
+}
+
+// Backoff returns the delay to wait after the given (1-based) failed attempt
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	delay := p.InitialBackoff
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if p.MaxBackoff > 0 && delay >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return delay
+}
+
+// PingWithRetry pings the database until it responds, the policy is exhausted
+// or the context is cancelled. It replaces fail-fast startup checks so that
+// services wait out database maintenance instead of crash looping.
+func PingWithRetry(ctx context.Context, db *sql.DB, policy RetryPolicy) error {
+	var lastErr error
+	for attempt := 1; policy.MaxAttempts == 0 || attempt <= policy.MaxAttempts; attempt++ {
+		pingCtx, cancel := context.WithTimeout(ctx, policy.PingTimeout)
+		lastErr = db.PingContext(pingCtx)
+		cancel()
+		if lastErr == nil {
+			return nil
+		}
+
+		if policy.MaxAttempts != 0 && attempt == policy.MaxAttempts {
+			break
+		}
+
+		delay := policy.Backoff(attempt)
+		log.Printf("Database not reachable (attempt %d): %v; retrying in %s", attempt, lastErr, delay)
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("database connection cancelled: %w", ctx.Err())
+		case <-time.After(delay):
+		}
+	}
+
+	return fmt.Errorf("failed to ping database after %d attempts: %w", policy.MaxAttempts, lastErr)
+}
@@ -0,0 +1,42 @@
+package db
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 10 * time.Second}
+
+	cases := map[int]time.Duration{
+		0: time.Second,
+		1: time.Second,
+		2: 2 * time.Second,
+		3: 4 * time.Second,
+		4: 8 * time.Second,
+		5: 10 * time.Second,
+		9: 10 * time.Second,
+	}
+	for attempt, want := range cases {
+		if got := policy.Backoff(attempt); got != want {
+			t.Errorf("Backoff(%d) = %s, want %s", attempt, got, want)
+		}
+	}
+}
+
+func TestRetryPolicyFromEnv(t *testing.T) {
+	t.Setenv("DB_CONNECT_MAX_ATTEMPTS", "0")
+	t.Setenv("DB_CONNECT_INITIAL_BACKOFF", "250ms")
+	t.Setenv("DB_CONNECT_MAX_BACKOFF", "bogus")
+
+	policy := RetryPolicyFromEnv()
+	if policy.MaxAttempts != 0 {
+		t.Errorf("MaxAttempts = %d, want 0 (unlimited)", policy.MaxAttempts)
+	}
+	if policy.InitialBackoff != 250*time.Millisecond {
+		t.Errorf("InitialBackoff = %s, want 250ms", policy.InitialBackoff)
+	}
+	if policy.MaxBackoff != DefaultRetryPolicy().MaxBackoff {
+		t.Errorf("invalid MaxBackoff should fall back to default, got %s", policy.MaxBackoff)
+	}
+}
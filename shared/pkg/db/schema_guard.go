@@ -1,8 +1,11 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+	"sync/atomic"
 )
 
 // ColumnType represents expected column schema
@@ -30,6 +33,11 @@ func NewSchemaGuard(db *sql.DB) *SchemaGuard {
 
 // ValidateTable validates a table's schema
 func (sg *SchemaGuard) ValidateTable(schema TableSchema) error {
+	return sg.ValidateTableContext(context.Background(), schema)
+}
+
+// ValidateTableContext validates a table's schema, giving up when ctx is done
+func (sg *SchemaGuard) ValidateTableContext(ctx context.Context, schema TableSchema) error {
 	// Query actual schema
 	query := `
 		SELECT COLUMN_NAME, DATA_TYPE, IS_NULLABLE
@@ -39,7 +47,7 @@ func (sg *SchemaGuard) ValidateTable(schema TableSchema) error {
 		ORDER BY ORDINAL_POSITION
 	`
 
-	rows, err := sg.db.Query(query, schema.Name)
+	rows, err := sg.db.QueryContext(ctx, query, schema.Name)
 	if err != nil {
 		return fmt.Errorf("failed to query table schema for %s: %w", schema.Name, err)
 	}
@@ -79,6 +87,72 @@ func (sg *SchemaGuard) ValidateTable(schema TableSchema) error {
 	return nil
 }
 
+// MissingTables returns the given tables that do not exist in the current
+// schema
+func (sg *SchemaGuard) MissingTables(ctx context.Context, tables []string) ([]string, error) {
+	if len(tables) == 0 {
+		return nil, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(tables)), ",")
+	args := make([]interface{}, len(tables))
+	for i, table := range tables {
+		args[i] = table
+	}
+
+	rows, err := sg.db.QueryContext(ctx, `
+		SELECT TABLE_NAME
+		FROM INFORMATION_SCHEMA.TABLES
+		WHERE TABLE_SCHEMA = DATABASE()
+		AND TABLE_NAME IN (`+placeholders+`)
+	`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tables: %w", err)
+	}
+	defer rows.Close()
+
+	existing := make(map[string]bool, len(tables))
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan table name: %w", err)
+		}
+		existing[name] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	for _, table := range tables {
+		if !existing[table] {
+			missing = append(missing, table)
+		}
+	}
+	return missing, nil
+}
+
+// RequireTables returns a readiness check that fails until every table
+// exists, i.e. until migrations have been applied. Once they all exist the
+// check stops querying the database.
+func (sg *SchemaGuard) RequireTables(tables ...string) func(context.Context) error {
+	var applied atomic.Bool
+	return func(ctx context.Context) error {
+		if applied.Load() {
+			return nil
+		}
+		missing, err := sg.MissingTables(ctx, tables)
+		if err != nil {
+			return err
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("migrations not applied, missing tables: %s", strings.Join(missing, ", "))
+		}
+		applied.Store(true)
+		return nil
+	}
+}
+
 // matchesDataType checks if data types are compatible (handles varchar(n), decimal(n,m), etc.)
 func matchesDataType(actual, expected string) bool {
 	// Simple check - can be enhanced to handle size specifications
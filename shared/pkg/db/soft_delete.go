@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -57,14 +58,24 @@ func (q *SoftDeleteQuery) Build() (string, []interface{}) {
 
 // QueryRows executes the query and returns rows
 func (q *SoftDeleteQuery) QueryRows(db *sql.DB) (*sql.Rows, error) {
+	return q.QueryRowsContext(context.Background(), db)
+}
+
+// QueryRowsContext executes the query under ctx and returns rows
+func (q *SoftDeleteQuery) QueryRowsContext(ctx context.Context, db *sql.DB) (*sql.Rows, error) {
 	query, params := q.Build()
-	return db.Query(query, params...)
+	return db.QueryContext(ctx, query, params...)
 }
 
 // QueryRow executes the query and returns a single row
 func (q *SoftDeleteQuery) QueryRow(db *sql.DB) *sql.Row {
+	return q.QueryRowContext(context.Background(), db)
+}
+
+// QueryRowContext executes the query under ctx and returns a single row
+func (q *SoftDeleteQuery) QueryRowContext(ctx context.Context, db *sql.DB) *sql.Row {
 	query, params := q.Build()
-	return db.QueryRow(query, params...)
+	return db.QueryRowContext(ctx, query, params...)
 }
 
 // WithTrashed includes soft deleted records
@@ -0,0 +1,79 @@
+package dbsplit
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+)
+
+func TestOwnershipHasNoOverlaps(t *testing.T) {
+	seen := make(map[string]string)
+	for _, service := range Services() {
+		for _, table := range Ownership[service] {
+			if other, ok := seen[table]; ok {
+				t.Errorf("table %s is owned by both %s and %s", table, other, service)
+			}
+			seen[table] = service
+		}
+	}
+	for _, table := range Shared {
+		if owner, ok := seen[table]; ok {
+			t.Errorf("shared table %s is also owned by %s", table, owner)
+		}
+	}
+}
+
+func TestOwnerAndSchemaName(t *testing.T) {
+	if got := Owner("feature_properties"); got != "features-service" {
+		t.Errorf("Owner(feature_properties) = %q", got)
+	}
+	if got := Owner("images"); got != "" {
+		t.Errorf("shared table must have no owner, got %q", got)
+	}
+	if got := SchemaName("notifications-service"); got != "metargb_notifications" {
+		t.Errorf("SchemaName = %q", got)
+	}
+}
+
+func TestStatements(t *testing.T) {
+	statements, err := Statements("metargb_db", "metargb_features", "trades")
+	if err != nil {
+		t.Fatalf("Statements failed: %v", err)
+	}
+	want := []string{
+		"CREATE DATABASE IF NOT EXISTS `metargb_features` CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci",
+		"CREATE TABLE IF NOT EXISTS `metargb_features`.`trades` LIKE `metargb_db`.`trades`",
+		"INSERT INTO `metargb_features`.`trades` SELECT * FROM `metargb_db`.`trades`",
+	}
+	for i := range want {
+		if statements[i] != want[i] {
+			t.Errorf("statement %d = %q, want %q", i, statements[i], want[i])
+		}
+	}
+
+	if _, err := Statements("metargb_db", "x`; DROP DATABASE metargb_db; --", "trades"); !errors.Is(err, ErrInvalidIdentifier) {
+		t.Errorf("expected ErrInvalidIdentifier, got %v", err)
+	}
+	if _, err := Statements("metargb_db", "metargb_db", "trades"); err == nil {
+		t.Error("expected error when source and target are the same schema")
+	}
+}
+
+func TestTableResultOK(t *testing.T) {
+	sum := sql.NullInt64{Int64: 42, Valid: true}
+	cases := []struct {
+		name   string
+		result TableResult
+		want   bool
+	}{
+		{"match", TableResult{SourceRows: 3, TargetRows: 3, SourceChecksum: sum, TargetChecksum: sum}, true},
+		{"row mismatch", TableResult{SourceRows: 3, TargetRows: 2, SourceChecksum: sum, TargetChecksum: sum}, false},
+		{"checksum mismatch", TableResult{SourceRows: 3, TargetRows: 3, SourceChecksum: sum, TargetChecksum: sql.NullInt64{Int64: 7, Valid: true}}, false},
+		{"missing target", TableResult{SourceRows: 0, TargetRows: 0, SourceChecksum: sum}, false},
+	}
+	for _, tc := range cases {
+		if got := tc.result.OK(); got != tc.want {
+			t.Errorf("%s: OK() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
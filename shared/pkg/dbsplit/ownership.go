@@ -0,0 +1,103 @@
+// Package dbsplit copies each service's tables out of the shared metargb_db
+// schema into a schema of its own and verifies the copies, so services can be
+// moved to separate databases one at a time.
+package dbsplit
+
+import (
+	"sort"
+	"strings"
+)
+
+// Ownership lists the tables each service owns and may move to its own
+// schema. A service can only be split once every other service reads these
+// tables through its gRPC API instead of SQL.
+var Ownership = map[string][]string{
+	"auth-service": {
+		"account_securities", "api_keys", "bank_accounts", "kyc_errors", "kyc_verify_texts",
+		"kycs", "login_alerts", "otps", "password_resets", "personal_access_tokens", "personal_infos",
+		"privacies", "profile_limitations", "settings", "user_devices", "user_variables", "users",
+	},
+	"calendar-service": {
+		"calendar_rsvps", "calendars",
+	},
+	"commercial-service": {
+		"exchange_rates", "first_orders", "fraud_card_blocklist", "fraud_checks", "fraud_login_sightings",
+		"installment_plans", "installments", "locked_assets", "orders", "payments", "rate_history",
+		"referral_order_histories", "referrals", "scheduled_variable_changes", "spending_limits",
+		"spending_records", "subscription_plans", "subscriptions", "transactions", "variable_change_logs",
+		"variables", "wallet_adjustment_batches", "wallet_adjustment_entries", "wallet_conversions", "wallets",
+	},
+	"dynasty-service": {
+		"children_permissions", "dynasties", "dynasty_membership_rules", "dynasty_messages", "dynasty_permissions",
+		"dynasty_prize_pool", "dynasty_prizes", "dynasty_stats", "families", "family_member_departures", "family_members", "join_requests",
+		"received_prizes",
+	},
+	"features-service": {
+		"building_models", "buildings", "buy_feature_request_expiries", "buy_feature_request_offers", "buy_feature_requests", "comissions", "coordinates",
+		"feature_geometry_versions", "feature_hourly_profits", "feature_limits", "feature_pricing_limits",
+		"feature_profit_payouts", "feature_profit_settings", "feature_properties", "feature_reservations",
+		"feature_watchlists", "features", "geometries", "isic_codes", "limited_feature_purchases", "locked_features",
+		"maps", "saved_searches", "sell_feature_requests", "trade_receipts", "trades",
+	},
+	"financial-service": {
+		"options", "processed_callbacks",
+	},
+	"levels-service": {
+		"answers", "correct_answers", "level_gems", "level_general_infos", "level_gifts",
+		"level_licenses", "level_prizes", "level_user", "levels", "prizes", "processed_activity_events",
+		"questions", "recieved_level_prizes", "user_activities", "user_logs", "user_onboarding_rewards",
+		"user_onboarding_steps", "user_question_answers",
+	},
+	"notifications-service": {
+		"notification_bot_link_codes", "notification_bot_links", "notification_digest_queue", "notification_digest_settings",
+		"notification_preferences", "notifications",
+	},
+	"reporting-service": {
+		"report_definitions",
+	},
+	"social-service": {
+		"follows",
+	},
+	"support-service": {
+		"notes", "support_chat_messages", "support_chat_sessions", "support_faqs", "ticket_emails",
+		"ticket_responses", "ticket_suggestions", "tickets", "trade_disputes",
+	},
+	"training-service": {
+		"comment_reports", "comments", "video_categories", "video_sub_categories", "videos",
+	},
+}
+
+// Shared lists tables several services still write to directly. They stay in
+// the source schema until a single owner exposes them over gRPC.
+var Shared = []string{
+	"images", "interactions", "system_variables", "views",
+}
+
+// Services returns the services with owned tables in a stable order
+func Services() []string {
+	services := make([]string, 0, len(Ownership))
+	for service := range Ownership {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	return services
+}
+
+// Owner returns the service owning table, or "" for shared and unknown tables
+func Owner(table string) string {
+	for service, tables := range Ownership {
+		for _, t := range tables {
+			if t == table {
+				return service
+			}
+		}
+	}
+	return ""
+}
+
+// SchemaName returns the default target schema for a service, e.g.
+// metargb_features for features-service
+func SchemaName(service string) string {
+	name := strings.TrimSuffix(service, "-service")
+	return "metargb_" + strings.ReplaceAll(name, "-", "_")
+}
@@ -0,0 +1,154 @@
+package dbsplit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// ErrInvalidIdentifier is returned for schema or table names that cannot be
+// safely quoted
+var ErrInvalidIdentifier = errors.New("invalid identifier")
+
+var identifierPattern = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// TableResult is the outcome of copying or verifying one table
+type TableResult struct {
+	Table          string
+	Copied         bool // false when the target already had rows and was left alone
+	SourceRows     int64
+	TargetRows     int64
+	SourceChecksum sql.NullInt64
+	TargetChecksum sql.NullInt64
+}
+
+// OK reports whether the target table matches the source table
+func (r TableResult) OK() bool {
+	return r.SourceRows == r.TargetRows &&
+		r.SourceChecksum.Valid && r.TargetChecksum.Valid &&
+		r.SourceChecksum.Int64 == r.TargetChecksum.Int64
+}
+
+// Splitter copies tables between two schemas on the same MySQL server. The
+// source schema is never modified.
+type Splitter struct {
+	db     *sql.DB
+	source string
+}
+
+// New creates a splitter reading from the source schema
+func New(db *sql.DB, source string) *Splitter {
+	return &Splitter{db: db, source: source}
+}
+
+// Statements returns the SQL that copies table from source to target, in order
+func Statements(source, target, table string) ([]string, error) {
+	for _, name := range []string{source, target, table} {
+		if !identifierPattern.MatchString(name) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidIdentifier, name)
+		}
+	}
+	if source == target {
+		return nil, fmt.Errorf("source and target schema must differ")
+	}
+
+	return []string{
+		fmt.Sprintf("CREATE DATABASE IF NOT EXISTS `%s` CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci", target),
+		fmt.Sprintf("CREATE TABLE IF NOT EXISTS `%s`.`%s` LIKE `%s`.`%s`", target, table, source, table),
+		fmt.Sprintf("INSERT INTO `%s`.`%s` SELECT * FROM `%s`.`%s`", target, table, source, table),
+	}, nil
+}
+
+// Copy creates table in target with the source definition and copies its rows.
+// A target that already holds rows is left untouched, so an interrupted run
+// can be repeated; Verify reports whether the earlier copy was complete.
+func (s *Splitter) Copy(ctx context.Context, target, table string) (*TableResult, error) {
+	statements, err := Statements(s.source, target, table)
+	if err != nil {
+		return nil, err
+	}
+
+	// Foreign key checks are per session, so keep every statement on one connection
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SET FOREIGN_KEY_CHECKS = 0"); err != nil {
+		return nil, fmt.Errorf("failed to disable foreign key checks: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), "SET FOREIGN_KEY_CHECKS = 1")
+
+	for _, stmt := range statements[:2] {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			return nil, fmt.Errorf("failed to prepare %s: %w", table, err)
+		}
+	}
+
+	result := &TableResult{Table: table}
+	existing, err := countRows(ctx, conn, target, table)
+	if err != nil {
+		return nil, err
+	}
+	if existing == 0 {
+		if _, err := conn.ExecContext(ctx, statements[2]); err != nil {
+			return nil, fmt.Errorf("failed to copy %s: %w", table, err)
+		}
+		result.Copied = true
+	}
+
+	return result, nil
+}
+
+// Verify compares row counts and CHECKSUM TABLE results between the source and
+// target copies of table
+func (s *Splitter) Verify(ctx context.Context, target, table string) (*TableResult, error) {
+	if _, err := Statements(s.source, target, table); err != nil {
+		return nil, err
+	}
+
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	result := &TableResult{Table: table}
+	if result.SourceRows, err = countRows(ctx, conn, s.source, table); err != nil {
+		return nil, err
+	}
+	if result.TargetRows, err = countRows(ctx, conn, target, table); err != nil {
+		return nil, err
+	}
+	if result.SourceChecksum, err = checksum(ctx, conn, s.source, table); err != nil {
+		return nil, err
+	}
+	if result.TargetChecksum, err = checksum(ctx, conn, target, table); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func countRows(ctx context.Context, conn *sql.Conn, schema, table string) (int64, error) {
+	var count int64
+	query := fmt.Sprintf("SELECT COUNT(*) FROM `%s`.`%s`", schema, table)
+	if err := conn.QueryRowContext(ctx, query).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count %s.%s: %w", schema, table, err)
+	}
+	return count, nil
+}
+
+// checksum returns the CHECKSUM TABLE value, which is NULL for missing tables
+func checksum(ctx context.Context, conn *sql.Conn, schema, table string) (sql.NullInt64, error) {
+	var name string
+	var value sql.NullInt64
+	query := fmt.Sprintf("CHECKSUM TABLE `%s`.`%s`", schema, table)
+	if err := conn.QueryRowContext(ctx, query).Scan(&name, &value); err != nil {
+		return sql.NullInt64{}, fmt.Errorf("failed to checksum %s.%s: %w", schema, table, err)
+	}
+	return value, nil
+}
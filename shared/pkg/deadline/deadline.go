@@ -0,0 +1,206 @@
+// Package deadline caps how long gRPC handlers may run.
+//
+// Callers that send no deadline, or one longer than the method's limit, get
+// the limit instead, so a slow query or dependency cannot hold a connection
+// and its database session forever. Handlers must pass their ctx down to the
+// repositories (QueryContext, ExecContext, ...) for the limit to cancel SQL.
+package deadline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultMaxDeadline is the limit for unary methods without their own limit
+const DefaultMaxDeadline = 30 * time.Second
+
+// Exceeded counts calls that ran out of time, by service and method
+var Exceeded = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "metargb",
+		Subsystem: "grpc",
+		Name:      "deadline_exceeded_total",
+		Help:      "Total number of gRPC calls that exceeded their deadline",
+	},
+	[]string{"service", "method"},
+)
+
+// Policy holds the longest time a service's methods may run
+type Policy struct {
+	Service string
+	// Default applies to unary methods not listed in Methods; 0 leaves them
+	// unlimited. Streams are only limited when listed in Methods.
+	Default time.Duration
+	// Methods maps full method names, or service prefixes such as
+	// "/storage.FileStorageService/*", to their limit
+	Methods map[string]time.Duration
+}
+
+// Defaults returns a policy limiting unary methods to DefaultMaxDeadline
+func Defaults() Policy {
+	return Policy{Default: DefaultMaxDeadline, Methods: map[string]time.Duration{}}
+}
+
+// FromEnv reads <SERVICE>_GRPC_MAX_DEADLINE and <SERVICE>_GRPC_METHOD_DEADLINES
+// (e.g. STORAGE_GRPC_MAX_DEADLINE for "storage-service"), falling back to
+// GRPC_MAX_DEADLINE / GRPC_METHOD_DEADLINES and then to defaults. Method
+// limits are comma separated, e.g.
+// "/reporting.ReportService/ExportReport=2m,/storage.FileStorageService/*=10m".
+// Invalid values are ignored.
+func FromEnv(service string, defaults Policy) Policy {
+	prefix := envPrefix(service)
+	policy := Policy{Service: service, Default: defaults.Default, Methods: map[string]time.Duration{}}
+	for method, limit := range defaults.Methods {
+		policy.Methods[method] = limit
+	}
+
+	if value := lookupEnv(prefix+"GRPC_MAX_DEADLINE", "GRPC_MAX_DEADLINE"); value != "" {
+		if limit, err := time.ParseDuration(value); err == nil && limit >= 0 {
+			policy.Default = limit
+		}
+	}
+	if value := lookupEnv(prefix+"GRPC_METHOD_DEADLINES", "GRPC_METHOD_DEADLINES"); value != "" {
+		if methods, err := ParseMethods(value); err == nil {
+			for method, limit := range methods {
+				policy.Methods[method] = limit
+			}
+		}
+	}
+	return policy
+}
+
+// ParseMethods parses comma separated method=duration pairs
+func ParseMethods(value string) (map[string]time.Duration, error) {
+	methods := map[string]time.Duration{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		method, limitValue, ok := strings.Cut(entry, "=")
+		method = strings.TrimSpace(method)
+		if !ok || !strings.HasPrefix(method, "/") {
+			return nil, fmt.Errorf("invalid method deadline %q", entry)
+		}
+		limit, err := time.ParseDuration(strings.TrimSpace(limitValue))
+		if err != nil || limit <= 0 {
+			return nil, fmt.Errorf("invalid deadline for %s: %q", method, limitValue)
+		}
+		methods[method] = limit
+	}
+	return methods, nil
+}
+
+// Limit returns the limit for fullMethod and whether it was listed explicitly
+func (p Policy) Limit(fullMethod string) (time.Duration, bool) {
+	if limit, ok := p.Methods[fullMethod]; ok {
+		return limit, true
+	}
+	if i := strings.LastIndex(fullMethod, "/"); i > 0 {
+		if limit, ok := p.Methods[fullMethod[:i+1]+"*"]; ok {
+			return limit, true
+		}
+	}
+	return p.Default, false
+}
+
+// ServerOptions installs the limits on a gRPC server. Add them after the
+// logging interceptors so logs show the final status of timed out calls.
+func (p Policy) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(p.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(p.StreamServerInterceptor()),
+	}
+}
+
+// UnaryServerInterceptor enforces the method's limit on unary calls
+func (p Policy) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		limit, _ := p.Limit(info.FullMethod)
+		ctx, cancel := capDeadline(ctx, limit)
+		defer cancel()
+
+		resp, err := handler(ctx, req)
+		return resp, p.observe(ctx, info.FullMethod, err)
+	}
+}
+
+// StreamServerInterceptor enforces limits on streams listed in Methods
+func (p Policy) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		limit, listed := p.Limit(info.FullMethod)
+		if !listed {
+			limit = 0
+		}
+		ctx, cancel := capDeadline(ss.Context(), limit)
+		defer cancel()
+
+		err := handler(srv, &stream{ServerStream: ss, ctx: ctx})
+		return p.observe(ctx, info.FullMethod, err)
+	}
+}
+
+// observe counts timed out calls. Handlers usually wrap a cancelled query as
+// Internal or Unknown; those are reported as DeadlineExceeded instead.
+func (p Policy) observe(ctx context.Context, fullMethod string, err error) error {
+	if err == nil {
+		return nil
+	}
+	code := status.Code(err)
+	timedOut := errors.Is(ctx.Err(), context.DeadlineExceeded)
+	if code != codes.DeadlineExceeded && !timedOut {
+		return err
+	}
+
+	Exceeded.WithLabelValues(p.Service, fullMethod).Inc()
+	if code == codes.Internal || code == codes.Unknown {
+		return status.Errorf(codes.DeadlineExceeded, "%s exceeded its deadline", fullMethod)
+	}
+	return err
+}
+
+// capDeadline shortens ctx's deadline to limit from now; limit 0 keeps it
+func capDeadline(ctx context.Context, limit time.Duration) (context.Context, context.CancelFunc) {
+	if limit <= 0 {
+		return ctx, func() {}
+	}
+	if current, ok := ctx.Deadline(); ok && time.Until(current) <= limit {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, limit)
+}
+
+// stream replaces the context of a server stream
+type stream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *stream) Context() context.Context {
+	return s.ctx
+}
+
+func lookupEnv(keys ...string) string {
+	for _, key := range keys {
+		if value := os.Getenv(key); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// envPrefix matches the per-service prefix of msgsize.FromEnv, e.g. "AUTH_"
+func envPrefix(service string) string {
+	name := strings.TrimSuffix(service, "-service")
+	return strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+}
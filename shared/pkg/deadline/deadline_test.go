@@ -0,0 +1,120 @@
+package deadline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("GRPC_MAX_DEADLINE", "10s")
+	t.Setenv("STORAGE_GRPC_MAX_DEADLINE", "")
+	t.Setenv("STORAGE_GRPC_METHOD_DEADLINES", "/storage.FileStorageService/*=10m, /storage.FileStorageService/Delete=5s")
+
+	policy := FromEnv("storage-service", Defaults())
+	if policy.Default != 10*time.Second {
+		t.Errorf("Default = %s, want 10s", policy.Default)
+	}
+	cases := map[string]time.Duration{
+		"/storage.FileStorageService/Upload": 10 * time.Minute,
+		"/storage.FileStorageService/Delete": 5 * time.Second,
+		"/storage.OtherService/Upload":       10 * time.Second,
+	}
+	for method, want := range cases {
+		if got, _ := policy.Limit(method); got != want {
+			t.Errorf("Limit(%s) = %s, want %s", method, got, want)
+		}
+	}
+
+	t.Setenv("GRPC_MAX_DEADLINE", "soon")
+	t.Setenv("STORAGE_GRPC_METHOD_DEADLINES", "Upload=1m")
+	policy = FromEnv("storage-service", Defaults())
+	if policy.Default != DefaultMaxDeadline || len(policy.Methods) != 0 {
+		t.Errorf("expected invalid values to be ignored, got %+v", policy)
+	}
+}
+
+func TestParseMethods(t *testing.T) {
+	for _, input := range []string{"/a.B/C", "/a.B/C=", "/a.B/C=-1s", "a.B/C=1s", "/a.B/C=later"} {
+		if _, err := ParseMethods(input); err == nil {
+			t.Errorf("ParseMethods(%q) should fail", input)
+		}
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	policy := Policy{Service: "test-service", Default: 20 * time.Millisecond}
+	interceptor := policy.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/a.B/Slow"}
+
+	t.Run("caps calls without a deadline", func(t *testing.T) {
+		before := testutil.ToFloat64(Exceeded.WithLabelValues("test-service", info.FullMethod))
+		_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			if _, ok := ctx.Deadline(); !ok {
+				t.Errorf("expected the handler to get a deadline")
+			}
+			<-ctx.Done()
+			return nil, status.Errorf(codes.Internal, "failed to query: %v", ctx.Err())
+		})
+		if status.Code(err) != codes.DeadlineExceeded {
+			t.Errorf("expected DeadlineExceeded, got %v", err)
+		}
+		if got := testutil.ToFloat64(Exceeded.WithLabelValues("test-service", info.FullMethod)); got != before+1 {
+			t.Errorf("expected the deadline metric to be incremented, got %v", got-before)
+		}
+	})
+
+	t.Run("keeps a shorter caller deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+		want, _ := ctx.Deadline()
+		interceptor(ctx, nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			if got, _ := ctx.Deadline(); !got.Equal(want) {
+				t.Errorf("deadline = %s, want the caller's %s", got, want)
+			}
+			return nil, nil
+		})
+	})
+
+	t.Run("leaves other errors alone", func(t *testing.T) {
+		notFound := status.Error(codes.NotFound, "missing")
+		_, err := interceptor(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) {
+			return nil, notFound
+		})
+		if !errors.Is(err, notFound) {
+			t.Errorf("expected the handler's error, got %v", err)
+		}
+	})
+}
+
+func TestStreamLimitsOnlyListedMethods(t *testing.T) {
+	policy := Policy{Default: time.Second, Methods: map[string]time.Duration{"/a.B/Upload": time.Minute}}
+	interceptor := policy.StreamServerInterceptor()
+
+	for method, wantDeadline := range map[string]bool{"/a.B/Upload": true, "/a.B/Watch": false} {
+		err := interceptor(nil, &fakeStream{}, &grpc.StreamServerInfo{FullMethod: method}, func(_ interface{}, ss grpc.ServerStream) error {
+			if _, ok := ss.Context().Deadline(); ok != wantDeadline {
+				return fmt.Errorf("%s has deadline %v, want %v", method, ok, wantDeadline)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+type fakeStream struct {
+	grpc.ServerStream
+}
+
+func (fakeStream) Context() context.Context {
+	return context.Background()
+}
@@ -0,0 +1,282 @@
+// Package debug serves runtime profiling endpoints on a separate listener so
+// staging services can be profiled without rebuilding them.
+//
+// The listener starts when <SERVICE>_DEBUG_ADDR or DEBUG_ADDR is set, e.g.
+// ":6060", and in development (see devmode) on DefaultDevelopmentAddr. It
+// serves:
+//
+//	/debug/pprof/   net/http/pprof profiles
+//	/debug/vars     expvar, including memstats and goroutines
+//	/debug/gc       garbage collector and heap statistics as JSON
+//
+// Outside development requests must come from DEBUG_ALLOWED_NETWORKS
+// (loopback only by default, which kubectl port-forward satisfies) and, when
+// DEBUG_TOKEN is set, carry it as a bearer token:
+//
+//	kubectl port-forward deploy/features-service 6060
+//	go tool pprof http://localhost:6060/debug/pprof/profile?seconds=30
+package debug
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"runtime"
+	rdebug "runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"metargb/shared/pkg/devmode"
+	"metargb/shared/pkg/logger"
+)
+
+// DefaultDevelopmentAddr is where the listener runs in development when no
+// address is set
+const DefaultDevelopmentAddr = ":6060"
+
+// DefaultAllowedNetworks are the networks allowed outside development when
+// DEBUG_ALLOWED_NETWORKS is unset
+const DefaultAllowedNetworks = "127.0.0.0/8,::1/128"
+
+// Config controls the debug listener
+type Config struct {
+	// Addr is the listen address; the listener is off when it is empty
+	Addr string
+	// Token, when set, must be sent as "Authorization: Bearer <token>"
+	Token string
+	// AllowedNetworks limits the clients by remote address; nil allows all
+	AllowedNetworks []*net.IPNet
+}
+
+// FromEnv reads the configuration of service from the environment. In
+// development the listener defaults to DefaultDevelopmentAddr and every
+// network is allowed unless DEBUG_ALLOWED_NETWORKS says otherwise.
+func FromEnv(service string) (Config, error) {
+	cfg := Config{
+		Addr:  lookupEnv(envPrefix(service)+"DEBUG_ADDR", "DEBUG_ADDR"),
+		Token: lookupEnv(envPrefix(service)+"DEBUG_TOKEN", "DEBUG_TOKEN"),
+	}
+
+	switch {
+	case strings.EqualFold(cfg.Addr, "off"):
+		cfg.Addr = ""
+	case cfg.Addr == "" && devmode.Enabled():
+		cfg.Addr = DefaultDevelopmentAddr
+	}
+
+	networks := lookupEnv(envPrefix(service)+"DEBUG_ALLOWED_NETWORKS", "DEBUG_ALLOWED_NETWORKS")
+	if networks == "" {
+		if devmode.Enabled() {
+			return cfg, nil
+		}
+		networks = DefaultAllowedNetworks
+	}
+
+	allowed, err := ParseNetworks(networks)
+	if err != nil {
+		return cfg, err
+	}
+	cfg.AllowedNetworks = allowed
+	return cfg, nil
+}
+
+// ParseNetworks parses a comma separated list of CIDRs and IPs
+func ParseNetworks(value string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if !strings.Contains(part, "/") {
+			ip := net.ParseIP(part)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid debug network %q", part)
+			}
+			bits := 128
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid debug network %q: %w", part, err)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// Serve starts the debug listener of service when one is configured and runs
+// it until ctx is cancelled. An invalid configuration is logged and leaves
+// the listener off.
+func Serve(ctx context.Context, service string, log *logger.Logger) {
+	cfg, err := FromEnv(service)
+	if err != nil {
+		log.Error("Debug listener disabled", "error", err)
+		return
+	}
+	if cfg.Addr == "" {
+		return
+	}
+	if cfg.Token == "" && cfg.AllowedNetworks == nil && !devmode.Enabled() {
+		log.Warn("Debug listener is open to every network without a token")
+	}
+
+	server := &http.Server{Addr: cfg.Addr, Handler: Handler(cfg), ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		log.Info("Debug listener started", "addr", cfg.Addr, "token", cfg.Token != "", "networks", len(cfg.AllowedNetworks))
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Debug listener failed", "error", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+}
+
+// Handler serves the debug endpoints to the clients cfg allows
+func Handler(cfg Config) http.Handler {
+	publishOnce.Do(publish)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.HandleFunc("/debug/gc", serveGCStats)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !cfg.allowsAddr(r.RemoteAddr) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		if !cfg.allowsToken(r.Header.Get("Authorization")) {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func (c Config) allowsAddr(remoteAddr string) bool {
+	if c.AllowedNetworks == nil {
+		return true
+	}
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, network := range c.AllowedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c Config) allowsToken(header string) bool {
+	if c.Token == "" {
+		return true
+	}
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(token), []byte(c.Token)) == 1
+}
+
+var publishOnce sync.Once
+
+// publish adds the runtime values expvar does not export by itself
+func publish() {
+	expvar.Publish("goroutines", expvar.Func(func() interface{} {
+		return runtime.NumGoroutine()
+	}))
+	expvar.Publish("gomaxprocs", expvar.Func(func() interface{} {
+		return runtime.GOMAXPROCS(0)
+	}))
+}
+
+// GCStats is the body of /debug/gc
+type GCStats struct {
+	NumGC        int64     `json:"num_gc"`
+	LastGC       time.Time `json:"last_gc"`
+	PauseTotalMS float64   `json:"pause_total_ms"`
+	// RecentPausesMS are the latest pauses, most recent first
+	RecentPausesMS []float64 `json:"recent_pauses_ms"`
+	HeapAlloc      uint64    `json:"heap_alloc_bytes"`
+	HeapInuse      uint64    `json:"heap_inuse_bytes"`
+	HeapObjects    uint64    `json:"heap_objects"`
+	NextGC         uint64    `json:"next_gc_bytes"`
+	MemoryLimit    int64     `json:"memory_limit_bytes"`
+	Goroutines     int       `json:"goroutines"`
+}
+
+// recentPauses is how many pauses /debug/gc lists
+const recentPauses = 10
+
+func serveGCStats(w http.ResponseWriter, r *http.Request) {
+	var gc rdebug.GCStats
+	gc.Pause = make([]time.Duration, 0, recentPauses)
+	rdebug.ReadGCStats(&gc)
+
+	var memory runtime.MemStats
+	runtime.ReadMemStats(&memory)
+
+	stats := GCStats{
+		NumGC:        gc.NumGC,
+		LastGC:       gc.LastGC,
+		PauseTotalMS: milliseconds(gc.PauseTotal),
+		HeapAlloc:    memory.HeapAlloc,
+		HeapInuse:    memory.HeapInuse,
+		HeapObjects:  memory.HeapObjects,
+		NextGC:       memory.NextGC,
+		// A negative limit reads the current one without changing it
+		MemoryLimit: rdebug.SetMemoryLimit(-1),
+		Goroutines:  runtime.NumGoroutine(),
+	}
+	for i, pause := range gc.Pause {
+		if i == recentPauses {
+			break
+		}
+		stats.RecentPausesMS = append(stats.RecentPausesMS, milliseconds(pause))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(stats)
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func lookupEnv(keys ...string) string {
+	for _, key := range keys {
+		if value := os.Getenv(key); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// envPrefix matches the per-service prefix of msgsize.FromEnv, e.g. "AUTH_"
+func envPrefix(service string) string {
+	name := strings.TrimSuffix(service, "-service")
+	return strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+}
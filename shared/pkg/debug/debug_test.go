@@ -0,0 +1,130 @@
+package debug
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFromEnv(t *testing.T) {
+	t.Run("OffByDefault", func(t *testing.T) {
+		t.Setenv("ENV", "production")
+		cfg, err := FromEnv("features-service")
+		if err != nil {
+			t.Fatalf("FromEnv returned error: %v", err)
+		}
+		if cfg.Addr != "" {
+			t.Errorf("expected no listener, got %q", cfg.Addr)
+		}
+		if len(cfg.AllowedNetworks) != 2 {
+			t.Errorf("expected the loopback networks, got %v", cfg.AllowedNetworks)
+		}
+	})
+
+	t.Run("ServiceSettingsWin", func(t *testing.T) {
+		t.Setenv("ENV", "staging")
+		t.Setenv("DEBUG_ADDR", ":6060")
+		t.Setenv("FEATURES_DEBUG_ADDR", ":6061")
+		t.Setenv("DEBUG_TOKEN", "secret")
+		t.Setenv("DEBUG_ALLOWED_NETWORKS", "10.0.0.0/8, 192.168.1.7")
+		cfg, err := FromEnv("features-service")
+		if err != nil {
+			t.Fatalf("FromEnv returned error: %v", err)
+		}
+		if cfg.Addr != ":6061" || cfg.Token != "secret" || len(cfg.AllowedNetworks) != 2 {
+			t.Errorf("unexpected config: %+v", cfg)
+		}
+	})
+
+	t.Run("Development", func(t *testing.T) {
+		t.Setenv("ENV", "development")
+		cfg, err := FromEnv("features-service")
+		if err != nil {
+			t.Fatalf("FromEnv returned error: %v", err)
+		}
+		if cfg.Addr != DefaultDevelopmentAddr || cfg.AllowedNetworks != nil {
+			t.Errorf("unexpected config: %+v", cfg)
+		}
+
+		t.Setenv("DEBUG_ADDR", "off")
+		if cfg, _ := FromEnv("features-service"); cfg.Addr != "" {
+			t.Errorf("expected the listener to be off, got %q", cfg.Addr)
+		}
+	})
+
+	t.Run("InvalidNetworks", func(t *testing.T) {
+		t.Setenv("ENV", "production")
+		t.Setenv("DEBUG_ALLOWED_NETWORKS", "10.0.0.0/33")
+		if _, err := FromEnv("features-service"); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestHandlerAccess(t *testing.T) {
+	networks, err := ParseNetworks(DefaultAllowedNetworks)
+	if err != nil {
+		t.Fatalf("ParseNetworks returned error: %v", err)
+	}
+	handler := Handler(Config{Token: "secret", AllowedNetworks: networks})
+
+	cases := []struct {
+		name       string
+		remoteAddr string
+		auth       string
+		want       int
+	}{
+		{"Allowed", "127.0.0.1:51000", "Bearer secret", http.StatusOK},
+		{"IPv6Loopback", "[::1]:51000", "Bearer secret", http.StatusOK},
+		{"OtherNetwork", "10.1.2.3:51000", "Bearer secret", http.StatusForbidden},
+		{"MissingToken", "127.0.0.1:51000", "", http.StatusUnauthorized},
+		{"WrongToken", "127.0.0.1:51000", "Bearer guess", http.StatusUnauthorized},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/debug/vars", nil)
+			req.RemoteAddr = tc.remoteAddr
+			if tc.auth != "" {
+				req.Header.Set("Authorization", tc.auth)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tc.want {
+				t.Errorf("expected %d, got %d", tc.want, rec.Code)
+			}
+		})
+	}
+}
+
+func TestEndpoints(t *testing.T) {
+	handler := Handler(Config{})
+
+	for _, path := range []string{"/debug/pprof/", "/debug/pprof/cmdline", "/debug/vars"} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code != http.StatusOK {
+			t.Errorf("%s: expected 200, got %d", path, rec.Code)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/gc", nil))
+	var stats GCStats
+	if err := json.NewDecoder(rec.Body).Decode(&stats); err != nil {
+		t.Fatalf("failed to decode GC stats: %v", err)
+	}
+	if stats.Goroutines == 0 || stats.HeapAlloc == 0 {
+		t.Errorf("unexpected GC stats: %+v", stats)
+	}
+
+	var vars map[string]interface{}
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/vars", nil))
+	if err := json.NewDecoder(rec.Body).Decode(&vars); err != nil {
+		t.Fatalf("failed to decode expvar: %v", err)
+	}
+	if _, ok := vars["goroutines"]; !ok {
+		t.Error("expected goroutines in expvar")
+	}
+}
@@ -0,0 +1,122 @@
+// Package devmode gates debugging aids on the ENV variable.
+//
+// With ENV=development a service registers gRPC reflection, starts its debug
+// listener by default (see package debug) and returns errors exactly as its
+// handlers produced them. In every other environment reflection stays off
+// and internal errors are sanitized: the caller gets a generic message with a
+// reference, and the full error is logged under that reference.
+package devmode
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+
+	"metargb/shared/pkg/logger"
+)
+
+// Development is the ENV value that turns the debugging aids on
+const Development = "development"
+
+// InternalMessage replaces the message of sanitized errors
+const InternalMessage = "internal error"
+
+// Enabled reports whether ENV is development
+func Enabled() bool {
+	return strings.EqualFold(strings.TrimSpace(os.Getenv("ENV")), Development)
+}
+
+// FromEnv returns the server options that sanitize the errors of service, or
+// nil in development. Add them after the logging interceptors, which then log
+// the sanitized error; the full one is logged by Sanitize.
+func FromEnv(service string, log *logger.Logger) []grpc.ServerOption {
+	if Enabled() {
+		log.Warn("Development mode is enabled - errors are returned unsanitized")
+		return nil
+	}
+	return ServerOptions(service, log)
+}
+
+// ServerOptions installs the error sanitizer on a gRPC server
+func ServerOptions(service string, log *logger.Logger) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryServerInterceptor(service, log)),
+		grpc.ChainStreamInterceptor(StreamServerInterceptor(service, log)),
+	}
+}
+
+// UnaryServerInterceptor sanitizes the errors of unary calls
+func UnaryServerInterceptor(service string, log *logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, Sanitize(err, service, info.FullMethod, log)
+		}
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor sanitizes the errors of streaming calls
+func StreamServerInterceptor(service string, log *logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return Sanitize(err, service, info.FullMethod, log)
+		}
+		return nil
+	}
+}
+
+// Sanitize returns err unchanged unless it is Internal, Unknown or DataLoss,
+// or not a gRPC status at all. Those are logged with a new reference and
+// replaced by InternalMessage carrying the reference in its message and in
+// an ErrorInfo detail, so support can find the log line a user reports.
+// Context errors become Canceled or DeadlineExceeded.
+func Sanitize(err error, service, method string, log *logger.Logger) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		if _, ok := status.FromError(err); !ok {
+			return status.FromContextError(err).Err()
+		}
+	}
+
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.Internal, codes.Unknown, codes.DataLoss:
+	default:
+		return err
+	}
+
+	reference := uuid.NewString()
+	log.Error("gRPC call failed", "reference", reference, "method", method, "code", st.Code().String(), "error", err)
+
+	sanitized := status.New(st.Code(), InternalMessage+" (reference "+reference+")")
+	withDetails, detailErr := sanitized.WithDetails(&errdetails.ErrorInfo{
+		Reason:   strings.ToUpper(st.Code().String()),
+		Domain:   service,
+		Metadata: map[string]string{"reference": reference},
+	})
+	if detailErr != nil {
+		return sanitized.Err()
+	}
+	return withDetails.Err()
+}
+
+// Register enables gRPC reflection on server in development; elsewhere it
+// does nothing
+func Register(server *grpc.Server, log *logger.Logger) {
+	if !Enabled() {
+		return
+	}
+	reflection.Register(server)
+	log.Info("gRPC reflection enabled")
+}
@@ -0,0 +1,117 @@
+package devmode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"metargb/shared/pkg/logger"
+)
+
+func TestEnabled(t *testing.T) {
+	for value, want := range map[string]bool{
+		"":             false,
+		"production":   false,
+		"staging":      false,
+		"development":  true,
+		" Development": true,
+	} {
+		t.Setenv("ENV", value)
+		if got := Enabled(); got != want {
+			t.Errorf("ENV=%q: Enabled() = %v, want %v", value, got, want)
+		}
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	log := logger.NewLogger("test-service")
+
+	t.Run("InternalErrorsAreReplaced", func(t *testing.T) {
+		err := Sanitize(status.Error(codes.Internal, "failed to query: dial tcp 10.0.0.3:3306: refused"), "test-service", "/a.B/C", log)
+		st := status.Convert(err)
+		if st.Code() != codes.Internal {
+			t.Fatalf("expected Internal, got %v", st.Code())
+		}
+		if strings.Contains(st.Message(), "10.0.0.3") || !strings.HasPrefix(st.Message(), InternalMessage) {
+			t.Fatalf("message was not sanitized: %q", st.Message())
+		}
+
+		details := st.Details()
+		if len(details) != 1 {
+			t.Fatalf("expected one detail, got %d", len(details))
+		}
+		info, ok := details[0].(*errdetails.ErrorInfo)
+		if !ok {
+			t.Fatalf("expected ErrorInfo, got %T", details[0])
+		}
+		if info.Domain != "test-service" || info.Reason != "INTERNAL" {
+			t.Errorf("unexpected error info: %+v", info)
+		}
+		if reference := info.Metadata["reference"]; reference == "" || !strings.Contains(st.Message(), reference) {
+			t.Errorf("reference %q missing from message %q", reference, st.Message())
+		}
+	})
+
+	t.Run("PlainErrorsAreReplaced", func(t *testing.T) {
+		st := status.Convert(Sanitize(fmt.Errorf("scan row: %w", errors.New("sql: no rows")), "test-service", "/a.B/C", log))
+		if st.Code() != codes.Unknown || strings.Contains(st.Message(), "sql") {
+			t.Errorf("unexpected status: %v %q", st.Code(), st.Message())
+		}
+	})
+
+	t.Run("ClientErrorsAreKept", func(t *testing.T) {
+		original := status.Error(codes.NotFound, "feature not found")
+		if err := Sanitize(original, "test-service", "/a.B/C", log); err != original {
+			t.Errorf("expected the error unchanged, got %v", err)
+		}
+	})
+
+	t.Run("ContextErrors", func(t *testing.T) {
+		if code := status.Code(Sanitize(context.DeadlineExceeded, "test-service", "/a.B/C", log)); code != codes.DeadlineExceeded {
+			t.Errorf("expected DeadlineExceeded, got %v", code)
+		}
+		if code := status.Code(Sanitize(context.Canceled, "test-service", "/a.B/C", log)); code != codes.Canceled {
+			t.Errorf("expected Canceled, got %v", code)
+		}
+	})
+}
+
+func TestFromEnv(t *testing.T) {
+	log := logger.NewLogger("test-service")
+
+	t.Setenv("ENV", Development)
+	if opts := FromEnv("test-service", log); opts != nil {
+		t.Errorf("expected no options in development, got %d", len(opts))
+	}
+
+	t.Setenv("ENV", "production")
+	if opts := FromEnv("test-service", log); len(opts) != 2 {
+		t.Errorf("expected the sanitizing interceptors, got %d options", len(opts))
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor("test-service", logger.NewLogger("test-service"))
+	info := &grpc.UnaryServerInfo{FullMethod: "/a.B/C"}
+
+	_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.Internal, "secret")
+	})
+	if st := status.Convert(err); st.Code() != codes.Internal || strings.Contains(st.Message(), "secret") {
+		t.Errorf("unexpected status: %v %q", st.Code(), st.Message())
+	}
+
+	resp, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	if err != nil || resp != "ok" {
+		t.Errorf("unexpected result: %v %v", resp, err)
+	}
+}
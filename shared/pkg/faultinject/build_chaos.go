@@ -0,0 +1,7 @@
+//go:build chaos
+// +build chaos
+
+package faultinject
+
+// compiledIn enables fault injection in binaries built with -tags chaos
+const compiledIn = true
@@ -0,0 +1,7 @@
+//go:build !chaos
+// +build !chaos
+
+package faultinject
+
+// compiledIn is false in regular builds, where FAULT_INJECTION=true is needed
+const compiledIn = false
@@ -0,0 +1,206 @@
+// Package faultinject injects latency and errors into chosen gRPC methods so
+// the gateway and multi-service flows can be tested under partial failure in
+// staging.
+//
+// Injection is off unless the binary is built with -tags chaos or
+// <SERVICE>_FAULT_INJECTION / FAULT_INJECTION is "true". Rules are read from
+// <SERVICE>_FAULT_RULES or FAULT_RULES (see ParseRules), and when
+// <SERVICE>_FAULT_ADMIN_ADDR or FAULT_ADMIN_ADDR is set they can be changed at
+// runtime through Handler at AdminPath:
+//
+//	curl -X PUT -d '[{"method":"/commercial.WalletService/*","error":"unavailable","rate":0.5}]' http://commercial-service:9096/faults
+//	curl -X DELETE http://commercial-service:9096/faults
+package faultinject
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"metargb/shared/pkg/logger"
+)
+
+// AdminPath is where the admin server serves the active rules
+const AdminPath = "/faults"
+
+// Injector applies the active rules to incoming gRPC calls
+type Injector struct {
+	mu     sync.RWMutex
+	rules  []Rule
+	log    *logger.Logger
+	random func() float64
+	sleep  func(ctx context.Context, d time.Duration) error
+}
+
+// New returns an injector with the given rules
+func New(rules []Rule, log *logger.Logger) *Injector {
+	return &Injector{
+		rules:  rules,
+		log:    log,
+		random: rand.Float64,
+		sleep:  sleepContext,
+	}
+}
+
+// Enabled reports whether fault injection is turned on for service
+func Enabled(service string) bool {
+	if compiledIn {
+		return true
+	}
+	for _, key := range []string{envPrefix(service) + "FAULT_INJECTION", "FAULT_INJECTION"} {
+		if strings.EqualFold(os.Getenv(key), "true") {
+			return true
+		}
+	}
+	return false
+}
+
+// FromEnv returns the server options that inject faults into service, or nil
+// when injection is disabled. The admin server, if configured, runs until
+// ctx is cancelled.
+func FromEnv(ctx context.Context, service string, log *logger.Logger) []grpc.ServerOption {
+	if !Enabled(service) {
+		return nil
+	}
+
+	rulesValue := lookupEnv(envPrefix(service)+"FAULT_RULES", "FAULT_RULES")
+	rules, err := ParseRules(rulesValue)
+	if err != nil {
+		log.Error("Ignoring invalid fault injection rules", "error", err, "rules", rulesValue)
+		rules = nil
+	}
+
+	injector := New(rules, log)
+	log.Warn("Fault injection is enabled - do not run this build in production", "rules", len(rules))
+
+	if addr := lookupEnv(envPrefix(service)+"FAULT_ADMIN_ADDR", "FAULT_ADMIN_ADDR"); addr != "" {
+		injector.serveAdmin(ctx, addr)
+	}
+	return injector.ServerOptions()
+}
+
+// ServerOptions installs the injector on a gRPC server. Add it after the
+// logging interceptors so injected failures are logged like real ones.
+func (i *Injector) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(i.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(i.StreamServerInterceptor()),
+	}
+}
+
+// UnaryServerInterceptor injects faults into unary calls
+func (i *Injector) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := i.Apply(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor injects faults when a stream is opened
+func (i *Injector) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := i.Apply(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// Apply runs the first rule matching fullMethod. It waits for the rule's
+// latency and returns its error, or nil when the call should proceed. A call
+// cancelled while waiting returns the context error.
+func (i *Injector) Apply(ctx context.Context, fullMethod string) error {
+	rule, ok := i.match(fullMethod)
+	if !ok || i.random() >= rule.Rate {
+		return nil
+	}
+
+	if rule.Latency > 0 {
+		if err := i.sleep(ctx, rule.Latency); err != nil {
+			return status.FromContextError(err).Err()
+		}
+	}
+	if rule.Code != codes.OK {
+		i.log.Debug("Injected gRPC error", "method", fullMethod, "code", rule.Code.String())
+		return status.Errorf(rule.Code, "injected fault for %s", fullMethod)
+	}
+	return nil
+}
+
+func (i *Injector) match(fullMethod string) (Rule, bool) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	for _, rule := range i.rules {
+		if rule.Matches(fullMethod) {
+			return rule, true
+		}
+	}
+	return Rule{}, false
+}
+
+// Rules returns a copy of the active rules
+func (i *Injector) Rules() []Rule {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return append([]Rule{}, i.rules...)
+}
+
+// SetRules replaces the active rules
+func (i *Injector) SetRules(rules []Rule) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.rules = append([]Rule{}, rules...)
+}
+
+func (i *Injector) serveAdmin(ctx context.Context, addr string) {
+	mux := http.NewServeMux()
+	mux.Handle(AdminPath, i.Handler())
+	server := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		i.log.Info("Fault injection admin server started", "addr", addr, "path", AdminPath)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			i.log.Error("Fault injection admin server failed", "error", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func lookupEnv(keys ...string) string {
+	for _, key := range keys {
+		if value := os.Getenv(key); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// envPrefix matches the per-service prefix of msgsize.FromEnv, e.g. "AUTH_"
+func envPrefix(service string) string {
+	name := strings.TrimSuffix(service, "-service")
+	return strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+}
@@ -0,0 +1,142 @@
+package faultinject
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"metargb/shared/pkg/logger"
+)
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules("/commercial.WalletService/GetWallet latency=2s; /features.FeatureService/* error=unavailable rate=0.3;")
+	if err != nil {
+		t.Fatalf("ParseRules returned error: %v", err)
+	}
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 rules, got %d", len(rules))
+	}
+	if rules[0].Latency != 2*time.Second || rules[0].Rate != 1 || rules[0].Code != codes.OK {
+		t.Errorf("unexpected first rule: %+v", rules[0])
+	}
+	if rules[1].Code != codes.Unavailable || rules[1].Rate != 0.3 {
+		t.Errorf("unexpected second rule: %+v", rules[1])
+	}
+
+	for _, input := range []string{
+		"/a.B/C",
+		"a.B/C latency=1s",
+		"/a.B/C latency",
+		"/a.B/C error=broken",
+		"/a.B/C latency=1s rate=2",
+		"/a.B/C latency=-1s",
+		"/a.B/C delay=1s",
+	} {
+		if _, err := ParseRules(input); err == nil {
+			t.Errorf("ParseRules(%q) should fail", input)
+		}
+	}
+}
+
+func TestRuleMatches(t *testing.T) {
+	cases := []struct {
+		method string
+		full   string
+		want   bool
+	}{
+		{"/a.B/C", "/a.B/C", true},
+		{"/a.B/C", "/a.B/D", false},
+		{"/a.B/*", "/a.B/D", true},
+		{"/a.B/*", "/a.X/D", false},
+		{"*", "/a.B/C", true},
+		{"*", "/grpc.health.v1.Health/Check", false},
+		{"/grpc.health.v1.Health/Check", "/grpc.health.v1.Health/Check", true},
+	}
+	for _, c := range cases {
+		if got := (Rule{Method: c.method}).Matches(c.full); got != c.want {
+			t.Errorf("Rule{%q}.Matches(%q) = %v, want %v", c.method, c.full, got, c.want)
+		}
+	}
+}
+
+func TestInjectorApply(t *testing.T) {
+	ctx := context.Background()
+	injector := New([]Rule{
+		{Method: "/a.B/Slow", Latency: time.Second, Rate: 1},
+		{Method: "/a.B/*", Code: codes.Unavailable, Rate: 0.5},
+	}, logger.NewLogger("test-service"))
+
+	var slept time.Duration
+	injector.sleep = func(_ context.Context, d time.Duration) error {
+		slept += d
+		return nil
+	}
+
+	if err := injector.Apply(ctx, "/a.B/Slow"); err != nil || slept != time.Second {
+		t.Fatalf("expected a 1s delay and no error, got %s and %v", slept, err)
+	}
+
+	injector.random = func() float64 { return 0.4 }
+	if err := injector.Apply(ctx, "/a.B/Other"); status.Code(err) != codes.Unavailable {
+		t.Errorf("expected an injected Unavailable error, got %v", err)
+	}
+	injector.random = func() float64 { return 0.5 }
+	if err := injector.Apply(ctx, "/a.B/Other"); err != nil {
+		t.Errorf("expected calls above the rate to pass, got %v", err)
+	}
+	if err := injector.Apply(ctx, "/x.Y/Z"); err != nil {
+		t.Errorf("expected unmatched methods to pass, got %v", err)
+	}
+
+	injector.sleep = sleepContext
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := injector.Apply(cancelled, "/a.B/Slow"); status.Code(err) != codes.Canceled {
+		t.Errorf("expected a cancelled call to return Canceled, got %v", err)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	injector := New(nil, logger.NewLogger("test-service"))
+	handler := injector.Handler()
+
+	put := httptest.NewRequest(http.MethodPut, AdminPath, strings.NewReader(`[{"method":"/a.B/*","latency":"250ms","error":"deadline_exceeded"}]`))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, put)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("PUT returned %d: %s", rec.Code, rec.Body)
+	}
+	want := `[{"method":"/a.B/*","latency":"250ms","error":"deadline_exceeded","rate":1}]`
+	if got := strings.TrimSpace(rec.Body.String()); got != want {
+		t.Errorf("PUT returned %s, want %s", got, want)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, AdminPath, strings.NewReader(`[{"method":"/a.B/*"}]`)))
+	if rec.Code != http.StatusUnprocessableEntity || len(injector.Rules()) != 1 {
+		t.Errorf("expected an invalid rule to be rejected without changing the rules, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, AdminPath, nil))
+	if got := strings.TrimSpace(rec.Body.String()); got != "[]" {
+		t.Errorf("DELETE returned %s, want []", got)
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	t.Setenv("FAULT_INJECTION", "")
+	t.Setenv("COMMERCIAL_FAULT_INJECTION", "true")
+	if !Enabled("commercial-service") {
+		t.Errorf("expected COMMERCIAL_FAULT_INJECTION to enable injection")
+	}
+	if Enabled("features-service") != compiledIn {
+		t.Errorf("expected features-service to follow the build tag")
+	}
+}
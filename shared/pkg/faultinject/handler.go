@@ -0,0 +1,34 @@
+package faultinject
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Handler returns the active rules on GET, replaces them on PUT with a JSON
+// array of rules, and clears them on DELETE
+func (i *Injector) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			var rules []Rule
+			if err := json.NewDecoder(r.Body).Decode(&rules); err != nil {
+				http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+				return
+			}
+			i.SetRules(rules)
+			i.log.Warn("Fault injection rules changed by admin endpoint", "rules", len(rules))
+		case http.MethodDelete:
+			i.SetRules(nil)
+			i.log.Warn("Fault injection rules cleared by admin endpoint")
+		default:
+			w.Header().Set("Allow", "GET, PUT, DELETE")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(i.Rules())
+	})
+}
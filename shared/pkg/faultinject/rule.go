@@ -0,0 +1,175 @@
+package faultinject
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Rule injects latency, an error, or both into the gRPC methods it matches
+type Rule struct {
+	// Method is a full method such as "/commercial.WalletService/GetWallet",
+	// a prefix ending in "*" such as "/commercial.WalletService/*", or "*"
+	Method string
+	// Latency is added before the handler runs
+	Latency time.Duration
+	// Code is returned instead of calling the handler, unless it is codes.OK
+	Code codes.Code
+	// Rate is the fraction of matching calls affected, from 0 to 1
+	Rate float64
+}
+
+// healthPrefix is never matched by wildcards so probes keep working
+const healthPrefix = "/grpc.health.v1.Health/"
+
+// Matches reports whether the rule applies to fullMethod
+func (r Rule) Matches(fullMethod string) bool {
+	if !strings.HasSuffix(r.Method, "*") {
+		return r.Method == fullMethod
+	}
+	if strings.HasPrefix(fullMethod, healthPrefix) {
+		return false
+	}
+	return strings.HasPrefix(fullMethod, strings.TrimSuffix(r.Method, "*"))
+}
+
+func (r Rule) validate() error {
+	if r.Method == "" {
+		return fmt.Errorf("rule has no method")
+	}
+	if r.Method != "*" && !strings.HasPrefix(r.Method, "/") {
+		return fmt.Errorf("method %q must start with / or be *", r.Method)
+	}
+	if r.Latency < 0 {
+		return fmt.Errorf("rule for %s has a negative latency", r.Method)
+	}
+	if r.Latency == 0 && r.Code == codes.OK {
+		return fmt.Errorf("rule for %s injects neither latency nor an error", r.Method)
+	}
+	if r.Rate <= 0 || r.Rate > 1 {
+		return fmt.Errorf("rule for %s has rate %v, want more than 0 and at most 1", r.Method, r.Rate)
+	}
+	return nil
+}
+
+// ParseRules parses rules separated by ";". Each rule is a method followed by
+// space separated settings, e.g.
+//
+//	/commercial.WalletService/GetWallet latency=2s; /features.FeatureService/* error=unavailable rate=0.3
+//
+// error takes a gRPC code name and rate defaults to 1.
+func ParseRules(value string) ([]Rule, error) {
+	var rules []Rule
+	for _, entry := range strings.Split(value, ";") {
+		fields := strings.Fields(entry)
+		if len(fields) == 0 {
+			continue
+		}
+
+		rule := Rule{Method: fields[0], Rate: 1}
+		for _, field := range fields[1:] {
+			key, val, ok := strings.Cut(field, "=")
+			if !ok {
+				return nil, fmt.Errorf("setting %q for %s must be key=value", field, rule.Method)
+			}
+			var err error
+			switch key {
+			case "latency":
+				rule.Latency, err = time.ParseDuration(val)
+			case "error":
+				rule.Code, err = parseCode(val)
+			case "rate":
+				rule.Rate, err = strconv.ParseFloat(val, 64)
+			default:
+				err = fmt.Errorf("unknown setting %q", key)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s for %s: %w", key, rule.Method, err)
+			}
+		}
+
+		if err := rule.validate(); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// parseCode accepts gRPC code names in any case, e.g. "unavailable"
+func parseCode(name string) (codes.Code, error) {
+	var code codes.Code
+	if err := code.UnmarshalJSON([]byte(strconv.Quote(strings.ToUpper(name)))); err != nil {
+		return 0, err
+	}
+	return code, nil
+}
+
+// codeName returns the name parseCode accepts, e.g. "deadline_exceeded"
+func codeName(code codes.Code) string {
+	var name strings.Builder
+	for i, c := range code.String() {
+		if i > 0 && c >= 'A' && c <= 'Z' {
+			name.WriteByte('_')
+		}
+		name.WriteRune(c)
+	}
+	return strings.ToLower(name.String())
+}
+
+// ruleJSON is how the admin endpoint reads and writes rules, e.g.
+// {"method":"/commercial.WalletService/*","latency":"500ms","error":"unavailable","rate":0.5}
+type ruleJSON struct {
+	Method  string  `json:"method"`
+	Latency string  `json:"latency,omitempty"`
+	Error   string  `json:"error,omitempty"`
+	Rate    float64 `json:"rate,omitempty"`
+}
+
+// MarshalJSON writes the latency as a duration string and the code by name
+func (r Rule) MarshalJSON() ([]byte, error) {
+	body := ruleJSON{Method: r.Method, Rate: r.Rate}
+	if r.Latency > 0 {
+		body.Latency = r.Latency.String()
+	}
+	if r.Code != codes.OK {
+		body.Error = codeName(r.Code)
+	}
+	return json.Marshal(body)
+}
+
+// UnmarshalJSON reads a rule written by MarshalJSON; rate defaults to 1
+func (r *Rule) UnmarshalJSON(data []byte) error {
+	var body ruleJSON
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+
+	rule := Rule{Method: body.Method, Rate: body.Rate}
+	if rule.Rate == 0 {
+		rule.Rate = 1
+	}
+	if body.Latency != "" {
+		latency, err := time.ParseDuration(body.Latency)
+		if err != nil {
+			return fmt.Errorf("invalid latency for %s: %w", body.Method, err)
+		}
+		rule.Latency = latency
+	}
+	if body.Error != "" {
+		code, err := parseCode(body.Error)
+		if err != nil {
+			return fmt.Errorf("invalid error for %s: %w", body.Method, err)
+		}
+		rule.Code = code
+	}
+	if err := rule.validate(); err != nil {
+		return err
+	}
+	*r = rule
+	return nil
+}
@@ -0,0 +1,173 @@
+// Package geoip finds the approximate location of IP addresses in an IP range
+// database loaded into memory.
+//
+// The database is a CSV file, optionally gzipped, in one of the DB-IP "lite"
+// layouts, which are free to use with attribution:
+//
+//	ip_start,ip_end,country                                               (IP to Country)
+//	ip_start,ip_end,continent,country,stateprov,city,latitude,longitude  (IP to City)
+//
+// IPv4 and IPv6 ranges can be mixed. Rows whose first field is not an IP
+// address, such as a header, are skipped.
+package geoip
+
+import (
+	"compress/gzip"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Location is the approximate place of an IP address
+type Location struct {
+	CountryCode string // ISO 3166-1 alpha-2, e.g. "IR"
+	Region      string
+	City        string
+}
+
+// IsZero reports whether nothing is known about the location
+func (l Location) IsZero() bool {
+	return l == Location{}
+}
+
+type ipRange struct {
+	start    netip.Addr
+	end      netip.Addr
+	location *Location
+}
+
+// DB looks up IP addresses in sorted, non-overlapping ranges
+type DB struct {
+	ranges []ipRange
+}
+
+// Open loads the database at path. Paths ending in .gz are decompressed.
+func Open(path string) (*DB, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open geoip database: %w", err)
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress geoip database: %w", err)
+		}
+		defer gz.Close()
+		reader = gz
+	}
+	return Load(reader)
+}
+
+// Load reads a database in one of the CSV layouts of the package
+func Load(r io.Reader) (*DB, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	// Most ranges share a location, so each distinct one is stored once
+	locations := make(map[Location]*Location)
+	db := &DB{}
+	line := 0
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("geoip database line %d: %w", line, err)
+		}
+
+		start, err := netip.ParseAddr(strings.TrimSpace(record[0]))
+		if err != nil {
+			continue
+		}
+		if len(record) < 3 {
+			return nil, fmt.Errorf("geoip database line %d: expected at least 3 fields, got %d", line, len(record))
+		}
+		end, err := netip.ParseAddr(strings.TrimSpace(record[1]))
+		if err != nil {
+			return nil, fmt.Errorf("geoip database line %d: invalid end address %q", line, record[1])
+		}
+		start, end = start.Unmap(), end.Unmap()
+		if start.Is4() != end.Is4() || end.Less(start) {
+			return nil, fmt.Errorf("geoip database line %d: invalid range %s - %s", line, start, end)
+		}
+
+		var location Location
+		switch {
+		case len(record) >= 6:
+			location = Location{CountryCode: record[3], Region: record[4], City: record[5]}
+		case len(record) == 3:
+			location = Location{CountryCode: record[2]}
+		default:
+			return nil, fmt.Errorf("geoip database line %d: unsupported layout with %d fields", line, len(record))
+		}
+		location.CountryCode = strings.ToUpper(strings.TrimSpace(location.CountryCode))
+		location.Region = strings.TrimSpace(location.Region)
+		location.City = strings.TrimSpace(location.City)
+		// DB-IP marks unassigned space with "ZZ"
+		if location.CountryCode == "ZZ" {
+			location.CountryCode = ""
+		}
+		if location.IsZero() {
+			continue
+		}
+
+		shared, ok := locations[location]
+		if !ok {
+			shared = &location
+			locations[location] = shared
+		}
+		db.ranges = append(db.ranges, ipRange{start: start, end: end, location: shared})
+	}
+
+	sort.Slice(db.ranges, func(i, j int) bool {
+		return db.ranges[i].start.Less(db.ranges[j].start)
+	})
+	for i := 1; i < len(db.ranges); i++ {
+		if !db.ranges[i-1].end.Less(db.ranges[i].start) {
+			return nil, fmt.Errorf("geoip database has overlapping ranges at %s", db.ranges[i].start)
+		}
+	}
+	return db, nil
+}
+
+// Len returns the number of ranges in the database
+func (d *DB) Len() int {
+	return len(d.ranges)
+}
+
+// Lookup returns the location of ip. Private, loopback and unknown addresses
+// are not found.
+func (d *DB) Lookup(ip string) (Location, bool) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return Location{}, false
+	}
+	addr = addr.Unmap()
+	if addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() || addr.IsUnspecified() {
+		return Location{}, false
+	}
+
+	// The candidate is the last range starting at or before addr
+	i := sort.Search(len(d.ranges), func(i int) bool {
+		return addr.Less(d.ranges[i].start)
+	}) - 1
+	if i < 0 {
+		return Location{}, false
+	}
+	candidate := d.ranges[i]
+	if candidate.start.Is4() != addr.Is4() || candidate.end.Less(addr) {
+		return Location{}, false
+	}
+	return *candidate.location, true
+}
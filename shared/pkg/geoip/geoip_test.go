@@ -0,0 +1,96 @@
+package geoip
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const cityDatabase = `ip_start,ip_end,continent,country,stateprov,city,latitude,longitude
+2.144.0.0,2.144.255.255,AS,IR,Tehran,Tehran,35.6892,51.389
+5.112.0.0,5.112.127.255,AS,IR,Isfahan,Isfahan,32.6525,51.6746
+5.112.128.0,5.112.255.255,AS,ZZ,,,0,0
+8.8.8.0,8.8.8.255,NA,US,California,"Mountain View",37.4056,-122.0775
+2a01:5ec0::,2a01:5ec0:ffff:ffff:ffff:ffff:ffff:ffff,AS,IR,Tehran,Tehran,35.6892,51.389
+`
+
+func TestLookup(t *testing.T) {
+	db, err := Load(strings.NewReader(cityDatabase))
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if db.Len() != 4 {
+		t.Fatalf("expected 4 ranges, got %d", db.Len())
+	}
+
+	cases := []struct {
+		ip    string
+		want  Location
+		found bool
+	}{
+		{"2.144.10.20", Location{CountryCode: "IR", Region: "Tehran", City: "Tehran"}, true},
+		{"2.144.0.0", Location{CountryCode: "IR", Region: "Tehran", City: "Tehran"}, true},
+		{"5.112.127.255", Location{CountryCode: "IR", Region: "Isfahan", City: "Isfahan"}, true},
+		{"::ffff:8.8.8.8", Location{CountryCode: "US", Region: "California", City: "Mountain View"}, true},
+		{"2a01:5ec0:10::1", Location{CountryCode: "IR", Region: "Tehran", City: "Tehran"}, true},
+		{"5.112.200.1", Location{}, false},
+		{"2.145.0.1", Location{}, false},
+		{"1.1.1.1", Location{}, false},
+		{"192.168.1.10", Location{}, false},
+		{"127.0.0.1", Location{}, false},
+		{"::1", Location{}, false},
+		{"not an ip", Location{}, false},
+	}
+	for _, tc := range cases {
+		got, found := db.Lookup(tc.ip)
+		if found != tc.found || got != tc.want {
+			t.Errorf("Lookup(%q) = %+v, %v; want %+v, %v", tc.ip, got, found, tc.want, tc.found)
+		}
+	}
+}
+
+func TestLoadCountryLayout(t *testing.T) {
+	db, err := Load(strings.NewReader("2.144.0.0,2.144.255.255,ir\n"))
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if got, _ := db.Lookup("2.144.1.1"); got != (Location{CountryCode: "IR"}) {
+		t.Errorf("unexpected location %+v", got)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	for name, data := range map[string]string{
+		"MixedFamilies": "2.144.0.0,2a01::,IR\n",
+		"Reversed":      "2.144.255.255,2.144.0.0,IR\n",
+		"Overlapping":   "2.144.0.0,2.144.255.255,IR\n2.144.128.0,2.145.0.0,IR\n",
+		"Layout":        "2.144.0.0,2.144.255.255,AS,IR\n",
+	} {
+		if _, err := Load(strings.NewReader(data)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestOpenGzip(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	gz.Write([]byte(cityDatabase))
+	gz.Close()
+
+	path := filepath.Join(t.TempDir(), "dbip-city-lite.csv.gz")
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open returned error: %v", err)
+	}
+	if _, found := db.Lookup("2.144.10.20"); !found {
+		t.Error("expected the address to be found")
+	}
+}
@@ -0,0 +1,196 @@
+// Package health serves liveness and readiness probes over HTTP. Liveness
+// only says the process responds; readiness says it should receive traffic:
+// every dependency check passes, every startup step (migrations, cache
+// warm-up) has finished and the service is not shutting down.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"metargb/shared/pkg/buildinfo"
+	"metargb/shared/pkg/jobs"
+)
+
+// DefaultPort is the port of the probe listener unless HEALTH_PORT is set
+const DefaultPort = "8086"
+
+// DefaultInterval is how often Watch re-evaluates readiness
+const DefaultInterval = 5 * time.Second
+
+// Paths of the probes
+const (
+	LivenessPath  = "/livez"
+	ReadinessPath = "/readyz"
+)
+
+// Check reports whether a dependency is usable
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Report is the body of both probes
+type Report struct {
+	Service string            `json:"service"`
+	Status  string            `json:"status"`
+	Checks  map[string]string `json:"checks,omitempty"`
+	Pending []string          `json:"pending,omitempty"`
+}
+
+// Probe tracks the readiness of one service
+type Probe struct {
+	service  string
+	timeout  time.Duration
+	mu       sync.RWMutex
+	checks   []namedCheck
+	pending  map[string]bool
+	draining atomic.Bool
+}
+
+// NewProbe creates a probe for service with no checks, which is ready until
+// a check or startup step is added. It also registers service's build, see
+// buildinfo.
+func NewProbe(service string) *Probe {
+	buildinfo.Register(service)
+	return &Probe{
+		service: service,
+		timeout: 2 * time.Second,
+		pending: make(map[string]bool),
+	}
+}
+
+// AddCheck adds a dependency check run on every readiness probe
+func (p *Probe) AddCheck(name string, check Check) *Probe {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.checks = append(p.checks, namedCheck{name: name, check: check})
+	return p
+}
+
+// Starting marks step as unfinished, keeping the service unready until the
+// returned function is called
+func (p *Probe) Starting(step string) (done func()) {
+	p.mu.Lock()
+	p.pending[step] = true
+	p.mu.Unlock()
+
+	return func() {
+		p.mu.Lock()
+		delete(p.pending, step)
+		p.mu.Unlock()
+	}
+}
+
+// Drain makes readiness fail from now on, so traffic moves to other
+// replicas before the server stops. Liveness is unaffected.
+func (p *Probe) Drain() {
+	p.draining.Store(true)
+}
+
+// Ready runs every check and reports whether the service is ready
+func (p *Probe) Ready(ctx context.Context) (Report, bool) {
+	p.mu.RLock()
+	checks := append([]namedCheck(nil), p.checks...)
+	pending := make([]string, 0, len(p.pending))
+	for step := range p.pending {
+		pending = append(pending, step)
+	}
+	p.mu.RUnlock()
+	sort.Strings(pending)
+
+	report := Report{Service: p.service, Checks: make(map[string]string, len(checks)), Pending: pending}
+	ready := len(pending) == 0
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	for _, c := range checks {
+		if err := c.check(ctx); err != nil {
+			report.Checks[c.name] = err.Error()
+			ready = false
+		} else {
+			report.Checks[c.name] = "ok"
+		}
+	}
+
+	switch {
+	case p.draining.Load():
+		report.Status = "draining"
+		ready = false
+	case ready:
+		report.Status = "ready"
+	default:
+		report.Status = "not_ready"
+	}
+	return report, ready
+}
+
+// Watch mirrors readiness into the overall ("") status of the gRPC health
+// server every interval until ctx is cancelled, so gRPC health checks (Kong
+// upstreams, grpc_health_probe) see the same answer as ReadinessPath
+func (p *Probe) Watch(ctx context.Context, healthServer *health.Server, interval time.Duration) {
+	update := func() {
+		status := healthpb.HealthCheckResponse_NOT_SERVING
+		if _, ready := p.Ready(ctx); ready {
+			status = healthpb.HealthCheckResponse_SERVING
+		}
+		healthServer.SetServingStatus("", status)
+	}
+	update()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				update()
+			}
+		}
+	}()
+}
+
+// Handler serves LivenessPath, ReadinessPath, the build on buildinfo.Path and
+// the background jobs on jobs.Path. Unready services answer ReadinessPath
+// with 503.
+func (p *Probe) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(LivenessPath, func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, http.StatusOK, Report{Service: p.service, Status: "alive"})
+	})
+	mux.HandleFunc(ReadinessPath, func(w http.ResponseWriter, r *http.Request) {
+		report, ready := p.Ready(r.Context())
+		status := http.StatusOK
+		if !ready {
+			status = http.StatusServiceUnavailable
+		}
+		writeReport(w, status, report)
+	})
+	mux.Handle(buildinfo.Path, buildinfo.Handler())
+	mux.Handle(jobs.Path, jobs.Handler())
+	return mux
+}
+
+// NewServer returns an HTTP server exposing the probes on addr
+func NewServer(addr string, probe *Probe) *http.Server {
+	return &http.Server{Addr: addr, Handler: probe.Handler(), ReadHeaderTimeout: 5 * time.Second}
+}
+
+func writeReport(w http.ResponseWriter, status int, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,84 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func probeStatus(t *testing.T, probe *Probe, path string) (int, Report) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	probe.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+	var report Report
+	if err := json.NewDecoder(rec.Body).Decode(&report); err != nil {
+		t.Fatalf("decode %s: %v", path, err)
+	}
+	return rec.Code, report
+}
+
+func TestReadinessWaitsForStartupAndChecks(t *testing.T) {
+	probe := NewProbe("test-service")
+	var dbErr error
+	probe.AddCheck("database", func(context.Context) error { return dbErr })
+	warmed := probe.Starting("caches")
+
+	if code, report := probeStatus(t, probe, ReadinessPath); code != http.StatusServiceUnavailable || len(report.Pending) != 1 || report.Pending[0] != "caches" {
+		t.Fatalf("before warm-up got %d %+v", code, report)
+	}
+
+	warmed()
+	if code, report := probeStatus(t, probe, ReadinessPath); code != http.StatusOK || report.Status != "ready" || report.Checks["database"] != "ok" {
+		t.Fatalf("after warm-up got %d %+v", code, report)
+	}
+
+	dbErr = errors.New("database unreachable")
+	if code, report := probeStatus(t, probe, ReadinessPath); code != http.StatusServiceUnavailable || report.Checks["database"] != "database unreachable" {
+		t.Fatalf("with failing check got %d %+v", code, report)
+	}
+	if code, _ := probeStatus(t, probe, LivenessPath); code != http.StatusOK {
+		t.Fatalf("liveness failed with a failing dependency: %d", code)
+	}
+}
+
+func TestDrainFailsReadinessOnly(t *testing.T) {
+	probe := NewProbe("test-service")
+	probe.Drain()
+
+	if code, report := probeStatus(t, probe, ReadinessPath); code != http.StatusServiceUnavailable || report.Status != "draining" {
+		t.Fatalf("draining readiness got %d %+v", code, report)
+	}
+	if code, report := probeStatus(t, probe, LivenessPath); code != http.StatusOK || report.Status != "alive" {
+		t.Fatalf("draining liveness got %d %+v", code, report)
+	}
+}
+
+func TestWatchMirrorsReadinessToGRPC(t *testing.T) {
+	probe := NewProbe("test-service")
+	done := probe.Starting("migrations")
+	healthServer := health.NewServer()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	probe.Watch(ctx, healthServer, time.Hour)
+
+	resp, err := healthServer.Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil || resp.Status != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("expected NOT_SERVING while migrations are pending, got %v %v", resp, err)
+	}
+
+	done()
+	probe.Watch(ctx, healthServer, time.Hour)
+	resp, err = healthServer.Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil || resp.Status != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("expected SERVING once ready, got %v %v", resp, err)
+	}
+}
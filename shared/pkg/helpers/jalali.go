@@ -73,3 +73,18 @@ func NowJalali() string {
 func NowJalaliDateTime() string {
 	return FormatJalaliDateTime(time.Now())
 }
+
+// JalaliYear returns the Jalali year t falls in, in Iran's time zone
+// Example: 2025-10-30 -> 1404
+func JalaliYear(t time.Time) int {
+	return ptime.New(t.In(ptime.Iran())).Year()
+}
+
+// JalaliYearRange returns the start of a Jalali year and the start of the
+// next one, in Iran's time zone
+// Example: 1402 -> 2023-03-21, 2024-03-20
+func JalaliYearRange(year int) (time.Time, time.Time) {
+	start := ptime.Date(year, ptime.Farvardin, 1, 0, 0, 0, 0, ptime.Iran())
+	end := ptime.Date(year+1, ptime.Farvardin, 1, 0, 0, 0, 0, ptime.Iran())
+	return start.Time(), end.Time()
+}
@@ -0,0 +1,27 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestJalaliYearRange(t *testing.T) {
+	start, end := JalaliYearRange(1402)
+	if got := start.Format("2006-01-02"); got != "2023-03-21" {
+		t.Errorf("1402 starts on %s, want 2023-03-21", got)
+	}
+	if got := end.Format("2006-01-02"); got != "2024-03-20" {
+		t.Errorf("1402 ends on %s, want 2024-03-20", got)
+	}
+
+	// The year turns at midnight in Tehran, not UTC
+	if got := JalaliYear(start); got != 1402 {
+		t.Errorf("JalaliYear(start) = %d, want 1402", got)
+	}
+	if got := JalaliYear(start.Add(-time.Second)); got != 1401 {
+		t.Errorf("JalaliYear(start - 1s) = %d, want 1401", got)
+	}
+	if got := JalaliYear(time.Date(2023, 3, 20, 21, 0, 0, 0, time.UTC)); got != 1402 {
+		t.Errorf("JalaliYear(2023-03-20 21:00 UTC) = %d, want 1402", got)
+	}
+}
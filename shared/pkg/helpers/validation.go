@@ -1,6 +1,7 @@
 package helpers
 
 import (
+	"reflect"
 	"regexp"
 	"strings"
 
@@ -26,6 +27,19 @@ func NewCustomValidator() *CustomValidator {
 	v.RegisterValidation("iranian_national_code", validateIranianNationalCode)
 	v.RegisterValidation("ir_sheba", validateIranianSheba)
 	v.RegisterValidation("ir_bank_card_number", validateIranianBankCardNumber)
+	v.RegisterValidation("position", validatePosition)
+
+	// Report fields by their request (json) name, matching the names clients send
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return field.Name
+		}
+		return name
+	})
 
 	return &CustomValidator{validate: v}
 }
@@ -77,6 +91,14 @@ func validateIranianPostalCode(fl validator.FieldLevel) bool {
 	return postalCodeRegex.MatchString(postalCode)
 }
 
+// positionRegex matches an "x,y" coordinate pair such as "12.5, -3"
+var positionRegex = regexp.MustCompile(`^(-?\d+(\.\d+)?),\s*(-?\d+(\.\d+)?)$`)
+
+// validatePosition validates "x,y" coordinate pairs used for building positions
+func validatePosition(fl validator.FieldLevel) bool {
+	return positionRegex.MatchString(fl.Field().String())
+}
+
 // validateIranianNationalCode validates Iranian national codes (10 digits with check digit)
 func validateIranianNationalCode(fl validator.FieldLevel) bool {
 	nationalCode := NormalizePersianNumbers(fl.Field().String())
@@ -2,8 +2,10 @@ package helpers
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"reflect"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
@@ -33,6 +35,16 @@ type LocaleTranslations struct {
 	IranianNationalCode string
 	IranianSheba  string
 	IranianBankCard string
+	MinValue      string
+	MaxValue      string
+	GreaterThan   string
+	LessThan      string
+	Numeric       string
+	Integer       string
+	Boolean       string
+	String        string
+	URL           string
+	Position      string
 	Invalid       string
 }
 
@@ -55,6 +67,16 @@ var translations = map[string]LocaleTranslations{
 		IranianNationalCode: "The %s field must be a valid Iranian national code",
 		IranianSheba:        "The %s field must be a valid Iranian Sheba (IBAN) number",
 		IranianBankCard:     "The %s field must be a valid Iranian bank card number",
+		MinValue:            "The %s field must be at least %s",
+		MaxValue:            "The %s field must not be greater than %s",
+		GreaterThan:         "The %s field must be greater than %s",
+		LessThan:            "The %s field must be less than %s",
+		Numeric:             "The %s field must be a number",
+		Integer:             "The %s field must be an integer",
+		Boolean:             "The %s field must be true or false",
+		String:              "The %s field must be a string",
+		URL:                 "The %s field must be a valid URL",
+		Position:            "The %s field must be in x,y format",
 		Invalid:             "The %s field is invalid",
 	},
 	"fa": {
@@ -74,6 +96,16 @@ var translations = map[string]LocaleTranslations{
 		IranianNationalCode: "فیلد %s باید یک کد ملی ایرانی معتبر باشد",
 		IranianSheba:        "فیلد %s باید یک شماره شبا (IBAN) ایرانی معتبر باشد",
 		IranianBankCard:     "فیلد %s باید یک شماره کارت بانکی ایرانی معتبر باشد",
+		MinValue:            "فیلد %s باید حداقل %s باشد",
+		MaxValue:            "فیلد %s نباید بیشتر از %s باشد",
+		GreaterThan:         "فیلد %s باید بزرگتر از %s باشد",
+		LessThan:            "فیلد %s باید کوچکتر از %s باشد",
+		Numeric:             "فیلد %s باید عدد باشد",
+		Integer:             "فیلد %s باید عدد صحیح باشد",
+		Boolean:             "فیلد %s باید true یا false باشد",
+		String:              "فیلد %s باید رشته باشد",
+		URL:                 "فیلد %s باید یک آدرس اینترنتی معتبر باشد",
+		Position:            "فیلد %s باید به صورت x,y باشد",
 		Invalid:             "فیلد %s نامعتبر است",
 	},
 }
@@ -102,9 +134,31 @@ func FormatValidationError(fe validator.FieldError, locale string) string {
 	case "email":
 		return fmt.Sprintf(t.Email, fieldName)
 	case "min":
+		if isNumberKind(fe.Kind()) {
+			return fmt.Sprintf(t.MinValue, fieldName, fe.Param())
+		}
 		return fmt.Sprintf(t.Min, fieldName, fe.Param())
 	case "max":
+		if isNumberKind(fe.Kind()) {
+			return fmt.Sprintf(t.MaxValue, fieldName, fe.Param())
+		}
 		return fmt.Sprintf(t.Max, fieldName, fe.Param())
+	case "gte":
+		return fmt.Sprintf(t.MinValue, fieldName, fe.Param())
+	case "lte":
+		return fmt.Sprintf(t.MaxValue, fieldName, fe.Param())
+	case "gt":
+		return fmt.Sprintf(t.GreaterThan, fieldName, fe.Param())
+	case "lt":
+		return fmt.Sprintf(t.LessThan, fieldName, fe.Param())
+	case "numeric", "number":
+		return fmt.Sprintf(t.Numeric, fieldName)
+	case "boolean":
+		return fmt.Sprintf(t.Boolean, fieldName)
+	case "url", "http_url":
+		return fmt.Sprintf(t.URL, fieldName)
+	case "position":
+		return fmt.Sprintf(t.Position, fieldName)
 	case "len":
 		return fmt.Sprintf(t.Len, fieldName, fe.Param())
 	case "oneof":
@@ -134,17 +188,60 @@ func FormatValidationError(fe validator.FieldError, locale string) string {
 	}
 }
 
+// FormatTypeError formats the message for a request field whose value has the
+// wrong type, e.g. a string sent for an integer field. field may be a dotted
+// path such as "information.name".
+func FormatTypeError(field string, kind reflect.Kind, locale string) string {
+	t := GetLocaleTranslations(locale)
+	if i := strings.LastIndex(field, "."); i >= 0 {
+		field = field[i+1:]
+	}
+	fieldName := humanizeFieldName(field)
+
+	switch {
+	case kind >= reflect.Int && kind <= reflect.Uint64:
+		return fmt.Sprintf(t.Integer, fieldName)
+	case kind == reflect.Float32 || kind == reflect.Float64:
+		return fmt.Sprintf(t.Numeric, fieldName)
+	case kind == reflect.Bool:
+		return fmt.Sprintf(t.Boolean, fieldName)
+	case kind == reflect.String:
+		return fmt.Sprintf(t.String, fieldName)
+	default:
+		return fmt.Sprintf(t.Invalid, fieldName)
+	}
+}
+
+// AsValidationErrors reports whether err holds field validation failures
+func AsValidationErrors(err error) (validator.ValidationErrors, bool) {
+	var validationErrors validator.ValidationErrors
+	ok := errors.As(err, &validationErrors)
+	return validationErrors, ok
+}
+
+func isNumberKind(kind reflect.Kind) bool {
+	return (kind >= reflect.Int && kind <= reflect.Uint64) || kind == reflect.Float32 || kind == reflect.Float64
+}
+
 // getFieldName extracts a human-readable field name from the FieldError
 func getFieldName(fe validator.FieldError) string {
-	fieldName := fe.Field()
-	
-	// Convert camelCase to space-separated words
+	return humanizeFieldName(fe.Field())
+}
+
+// humanizeFieldName lowercases a field name and replaces underscores with spaces
+func humanizeFieldName(fieldName string) string {
 	fieldName = strings.ToLower(fieldName)
-	
-	// Replace common field name patterns
-	fieldName = strings.ReplaceAll(fieldName, "_", " ")
-	
-	return fieldName
+	return strings.ReplaceAll(fieldName, "_", " ")
+}
+
+// fieldPath returns the Laravel-style key of a field error: its namespace
+// without the top-level struct, e.g. "information.postal_code"
+func fieldPath(fe validator.FieldError) string {
+	namespace := fe.Namespace()
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return fe.Field()
 }
 
 // WriteValidationErrorResponse writes a validation error response in the specified format
@@ -154,7 +251,7 @@ func WriteValidationErrorResponse(w http.ResponseWriter, validationErrors valida
 	var firstMessage string
 	
 	for i, err := range validationErrors {
-		fieldName := err.Field()
+		fieldName := fieldPath(err)
 		errorMessage := FormatValidationError(err, locale)
 		
 		errors[fieldName] = errorMessage
@@ -0,0 +1,88 @@
+package helpers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+type testBuildingInformation struct {
+	PostalCode string `json:"postal_code" validate:"omitempty,iranian_postal_code"`
+}
+
+type testBuildingRequest struct {
+	Rotation    string                   `json:"rotation" validate:"required,numeric"`
+	Position    string                   `json:"position" validate:"required,position"`
+	GracePeriod int32                    `json:"grace_period" validate:"gte=1,lte=30"`
+	Name        string                   `json:"name" validate:"omitempty,min=3"`
+	Information *testBuildingInformation `json:"information"`
+}
+
+func TestWriteValidationErrorResponseUsesJSONFieldPaths(t *testing.T) {
+	req := testBuildingRequest{
+		Rotation:    "left",
+		Position:    "1;2",
+		GracePeriod: 40,
+		Name:        "ab",
+		Information: &testBuildingInformation{PostalCode: "123"},
+	}
+
+	err := NewCustomValidator().Validate(req)
+	validationErrors, ok := AsValidationErrors(err)
+	if !ok {
+		t.Fatalf("expected validation errors, got %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	WriteValidationErrorResponse(w, validationErrors, "fa")
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("status = %d, want 422", w.Code)
+	}
+
+	var response ValidationErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	want := map[string]string{
+		"rotation":                "فیلد rotation باید عدد باشد",
+		"position":                "فیلد position باید به صورت x,y باشد",
+		"grace_period":            "فیلد grace period نباید بیشتر از 30 باشد",
+		"name":                    "فیلد name باید حداقل 3 کاراکتر باشد",
+		"information.postal_code": "فیلد postal code باید یک کد پستی ایرانی معتبر باشد",
+	}
+	if !reflect.DeepEqual(response.Errors, want) {
+		t.Errorf("errors = %v, want %v", response.Errors, want)
+	}
+	if response.Message != want["rotation"] {
+		t.Errorf("message = %q, want the first field error", response.Message)
+	}
+}
+
+func TestValidPositionPasses(t *testing.T) {
+	req := testBuildingRequest{Rotation: "90", Position: "12.5, -3", GracePeriod: 1}
+	if err := NewCustomValidator().Validate(req); err != nil {
+		t.Errorf("expected valid request, got %v", err)
+	}
+}
+
+func TestFormatTypeError(t *testing.T) {
+	cases := []struct {
+		field string
+		kind  reflect.Kind
+		want  string
+	}{
+		{"grace_period", reflect.Int32, "فیلد grace period باید عدد صحیح باشد"},
+		{"price_psc", reflect.Float64, "فیلد price psc باید عدد باشد"},
+		{"information.name", reflect.String, "فیلد name باید رشته باشد"},
+		{"liked", reflect.Bool, "فیلد liked باید true یا false باشد"},
+		{"information", reflect.Struct, "فیلد information نامعتبر است"},
+	}
+	for _, c := range cases {
+		if got := FormatTypeError(c.field, c.kind, "fa"); got != c.want {
+			t.Errorf("FormatTypeError(%q, %s) = %q, want %q", c.field, c.kind, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestFitKeepsAspectRatio(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 400, 100))
+	for y := 0; y < 100; y++ {
+		for x := 0; x < 400; x++ {
+			src.SetNRGBA(x, y, color.NRGBA{R: 200, A: 255})
+		}
+	}
+
+	got := Fit(src, 100)
+	if b := got.Bounds(); b.Dx() != 100 || b.Dy() != 25 {
+		t.Fatalf("got %dx%d, want 100x25", b.Dx(), b.Dy())
+	}
+	if c := color.NRGBAModel.Convert(got.At(50, 10)).(color.NRGBA); c.R != 200 || c.A != 255 {
+		t.Fatalf("averaged pixel %+v", c)
+	}
+
+	tall := Fit(image.NewNRGBA(image.Rect(0, 0, 30, 300)), 60)
+	if b := tall.Bounds(); b.Dx() != 6 || b.Dy() != 60 {
+		t.Fatalf("tall image got %dx%d, want 6x60", b.Dx(), b.Dy())
+	}
+}
+
+func TestFitLeavesSmallImages(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 80, 60))
+	if got := Fit(src, 100); got != image.Image(src) {
+		t.Fatal("an image that fits was copied")
+	}
+}
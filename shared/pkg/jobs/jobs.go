@@ -0,0 +1,296 @@
+// Package jobs runs a service's background jobs on fixed intervals and makes
+// them observable. Every run is timed and counted as metargb_job_* metrics, a
+// panic fails the run instead of the process, and a job never runs twice at
+// once. The state of every job is served as JSON on Path by the health probe
+// and metrics servers:
+//
+//	runner := jobs.NewRunner("features-service", log).
+//		Add("hourly-profit", time.Hour, profits.Run)
+//	runner.Start(ctx)
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"metargb/shared/pkg/logger"
+)
+
+// Path is the HTTP path listing the jobs
+const Path = "/jobs"
+
+// Results of a run, the result label of metargb_job_runs_total
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+	ResultPanic   = "panic"
+)
+
+var (
+	// ErrUnknownJob is returned by RunNow for a name that was never added
+	ErrUnknownJob = errors.New("unknown job")
+	// ErrJobRunning is returned by RunNow while the job is already running
+	ErrJobRunning = errors.New("job is already running")
+)
+
+var (
+	runsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "metargb_job_runs_total",
+		Help: "Background job runs by result: success, failure or panic",
+	}, []string{"service", "job", "result"})
+	skippedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "metargb_job_skipped_total",
+		Help: "Background job runs skipped because the previous run had not finished",
+	}, []string{"service", "job"})
+	runDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "metargb_job_duration_seconds",
+		Help:    "Duration of background job runs",
+		Buckets: prometheus.ExponentialBuckets(0.01, 4, 10),
+	}, []string{"service", "job"})
+	lastRun = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "metargb_job_last_run_timestamp_seconds",
+		Help: "Unix time the latest run of a background job finished",
+	}, []string{"service", "job"})
+	lastSuccess = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "metargb_job_last_success_timestamp_seconds",
+		Help: "Unix time the latest successful run of a background job finished",
+	}, []string{"service", "job"})
+	running = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "metargb_job_running",
+		Help: "Whether a background job is running; 1 while it runs",
+	}, []string{"service", "job"})
+)
+
+// Func is one run of a job. ctx is cancelled when the runner stops.
+type Func func(ctx context.Context) error
+
+// State is what is known about a job, as listed on Path
+type State struct {
+	Service      string     `json:"service"`
+	Name         string     `json:"name"`
+	Interval     string     `json:"interval"`
+	Running      bool       `json:"running"`
+	Runs         uint64     `json:"runs"`
+	Failures     uint64     `json:"failures"`
+	Skipped      uint64     `json:"skipped"`
+	LastStarted  *time.Time `json:"last_started,omitempty"`
+	LastFinished *time.Time `json:"last_finished,omitempty"`
+	LastSuccess  *time.Time `json:"last_success,omitempty"`
+	LastDuration string     `json:"last_duration,omitempty"`
+	LastError    string     `json:"last_error,omitempty"`
+}
+
+type job struct {
+	name      string
+	interval  time.Duration
+	run       Func
+	immediate bool
+	busy      atomic.Bool
+
+	mu    sync.Mutex
+	state State
+}
+
+// Runner runs the jobs of one service
+type Runner struct {
+	service string
+	log     *logger.Logger
+
+	mu   sync.RWMutex
+	jobs []*job
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   []*Runner
+)
+
+// NewRunner creates a runner for service without jobs and lists it on Path
+func NewRunner(service string, log *logger.Logger) *Runner {
+	r := &Runner{service: service, log: log}
+	registryMu.Lock()
+	registry = append(registry, r)
+	registryMu.Unlock()
+	return r
+}
+
+// Add adds a job running fn once every interval. Add every job before Start.
+// Names must be unique within the runner.
+func (r *Runner) Add(name string, interval time.Duration, fn Func) *Runner {
+	return r.add(name, interval, fn, false)
+}
+
+// AddImmediate adds a job like Add that also runs right at Start, for jobs
+// whose backlog should not wait an interval after a restart
+func (r *Runner) AddImmediate(name string, interval time.Duration, fn Func) *Runner {
+	return r.add(name, interval, fn, true)
+}
+
+func (r *Runner) add(name string, interval time.Duration, fn Func, immediate bool) *Runner {
+	if interval <= 0 {
+		panic(fmt.Sprintf("jobs: interval of %s must be positive", name))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, j := range r.jobs {
+		if j.name == name {
+			panic(fmt.Sprintf("jobs: %s added twice", name))
+		}
+	}
+	r.jobs = append(r.jobs, &job{
+		name:      name,
+		interval:  interval,
+		run:       fn,
+		immediate: immediate,
+		state:     State{Service: r.service, Name: name, Interval: interval.String()},
+	})
+	return r
+}
+
+// Start runs every job once per interval, the first time one interval after
+// Start unless it was added with AddImmediate, until ctx is cancelled. It does
+// not block.
+func (r *Runner) Start(ctx context.Context) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, j := range r.jobs {
+		r.log.Info("Background job started", "job", j.name, "interval", j.interval)
+		go r.loop(ctx, j)
+	}
+}
+
+func (r *Runner) loop(ctx context.Context, j *job) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	if j.immediate {
+		r.execute(ctx, j)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.execute(ctx, j); errors.Is(err, ErrJobRunning) {
+				r.log.Warn("Background job still running, skipping this run", "job", j.name)
+			}
+		}
+	}
+}
+
+// RunNow runs the job name right away and returns its error. It fails with
+// ErrJobRunning instead of starting a second run at the same time.
+func (r *Runner) RunNow(ctx context.Context, name string) error {
+	r.mu.RLock()
+	var found *job
+	for _, j := range r.jobs {
+		if j.name == name {
+			found = j
+		}
+	}
+	r.mu.RUnlock()
+	if found == nil {
+		return fmt.Errorf("%w: %s", ErrUnknownJob, name)
+	}
+	return r.execute(ctx, found)
+}
+
+func (r *Runner) execute(ctx context.Context, j *job) (err error) {
+	if !j.busy.CompareAndSwap(false, true) {
+		skippedTotal.WithLabelValues(r.service, j.name).Inc()
+		j.mu.Lock()
+		j.state.Skipped++
+		j.mu.Unlock()
+		return ErrJobRunning
+	}
+	defer j.busy.Store(false)
+
+	started := time.Now()
+	running.WithLabelValues(r.service, j.name).Set(1)
+	j.mu.Lock()
+	j.state.Running = true
+	j.state.LastStarted = &started
+	j.mu.Unlock()
+
+	result := ResultSuccess
+	defer func() {
+		if p := recover(); p != nil {
+			result = ResultPanic
+			err = fmt.Errorf("panic: %v", p)
+			r.log.Error("Background job panicked", "job", j.name, "panic", p, "stack", string(debug.Stack()))
+		}
+		r.finish(j, started, result, err)
+	}()
+
+	if err = j.run(ctx); err != nil {
+		result = ResultFailure
+		r.log.Warn("Background job failed", "job", j.name, "error", err)
+	}
+	return err
+}
+
+func (r *Runner) finish(j *job, started time.Time, result string, err error) {
+	finished := time.Now()
+	duration := finished.Sub(started)
+
+	running.WithLabelValues(r.service, j.name).Set(0)
+	runsTotal.WithLabelValues(r.service, j.name, result).Inc()
+	runDuration.WithLabelValues(r.service, j.name).Observe(duration.Seconds())
+	lastRun.WithLabelValues(r.service, j.name).Set(float64(finished.Unix()))
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.state.Running = false
+	j.state.Runs++
+	j.state.LastFinished = &finished
+	j.state.LastDuration = duration.String()
+	j.state.LastError = ""
+	if err != nil {
+		j.state.Failures++
+		j.state.LastError = err.Error()
+		return
+	}
+	j.state.LastSuccess = &finished
+	lastSuccess.WithLabelValues(r.service, j.name).Set(float64(finished.Unix()))
+}
+
+// States returns the state of every job of the runner, by name
+func (r *Runner) States() []State {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	states := make([]State, 0, len(r.jobs))
+	for _, j := range r.jobs {
+		j.mu.Lock()
+		states = append(states, j.state)
+		j.mu.Unlock()
+	}
+	sort.Slice(states, func(a, b int) bool { return states[a].Name < states[b].Name })
+	return states
+}
+
+// Handler lists the jobs of every runner of the process as JSON
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		registryMu.RLock()
+		states := []State{}
+		for _, runner := range registry {
+			states = append(states, runner.States()...)
+		}
+		registryMu.RUnlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		json.NewEncoder(w).Encode(map[string][]State{"jobs": states})
+	})
+}
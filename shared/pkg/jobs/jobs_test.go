@@ -0,0 +1,156 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"metargb/shared/pkg/logger"
+)
+
+func stateOf(t *testing.T, runner *Runner, name string) State {
+	t.Helper()
+	for _, state := range runner.States() {
+		if state.Name == name {
+			return state
+		}
+	}
+	t.Fatalf("no state for %s", name)
+	return State{}
+}
+
+func TestRunNowRecordsResults(t *testing.T) {
+	var err error
+	runner := NewRunner("test-service", logger.NewLogger("test-service")).
+		Add("sweep", time.Hour, func(context.Context) error { return err })
+
+	if got := runner.RunNow(context.Background(), "sweep"); got != nil {
+		t.Fatalf("RunNow() = %v", got)
+	}
+	err = errors.New("database unreachable")
+	if got := runner.RunNow(context.Background(), "sweep"); got != err {
+		t.Fatalf("RunNow() = %v, want %v", got, err)
+	}
+
+	state := stateOf(t, runner, "sweep")
+	if state.Runs != 2 || state.Failures != 1 || state.LastError != "database unreachable" || state.Running {
+		t.Fatalf("state = %+v", state)
+	}
+	if state.LastSuccess == nil || state.LastFinished == nil || state.LastSuccess.After(*state.LastFinished) {
+		t.Fatalf("last success %v, last finished %v", state.LastSuccess, state.LastFinished)
+	}
+
+	if got := runner.RunNow(context.Background(), "missing"); !errors.Is(got, ErrUnknownJob) {
+		t.Fatalf("RunNow(missing) = %v, want ErrUnknownJob", got)
+	}
+}
+
+func TestPanicFailsTheRun(t *testing.T) {
+	runner := NewRunner("test-service", logger.NewLogger("test-service")).
+		Add("explode", time.Hour, func(context.Context) error { panic("nil map") })
+
+	if err := runner.RunNow(context.Background(), "explode"); err == nil || err.Error() != "panic: nil map" {
+		t.Fatalf("RunNow() = %v, want the panic as error", err)
+	}
+	if state := stateOf(t, runner, "explode"); state.Failures != 1 || state.Running {
+		t.Fatalf("state = %+v", state)
+	}
+}
+
+func TestRunsDoNotOverlap(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	runner := NewRunner("test-service", logger.NewLogger("test-service")).
+		Add("slow", time.Hour, func(context.Context) error {
+			close(started)
+			<-release
+			return nil
+		})
+
+	done := make(chan error)
+	go func() { done <- runner.RunNow(context.Background(), "slow") }()
+	<-started
+
+	if state := stateOf(t, runner, "slow"); !state.Running {
+		t.Fatalf("state = %+v, want running", state)
+	}
+	if err := runner.RunNow(context.Background(), "slow"); !errors.Is(err, ErrJobRunning) {
+		t.Fatalf("second RunNow() = %v, want ErrJobRunning", err)
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("first RunNow() = %v", err)
+	}
+
+	if state := stateOf(t, runner, "slow"); state.Runs != 1 || state.Skipped != 1 {
+		t.Fatalf("state = %+v, want one run and one skipped", state)
+	}
+}
+
+func TestStartRunsEveryInterval(t *testing.T) {
+	ran := make(chan struct{}, 10)
+	runner := NewRunner("test-service", logger.NewLogger("test-service")).
+		Add("tick", 10*time.Millisecond, func(context.Context) error {
+			ran <- struct{}{}
+			return nil
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	runner.Start(ctx)
+	for i := 0; i < 2; i++ {
+		select {
+		case <-ran:
+		case <-time.After(time.Second):
+			t.Fatalf("job ran %d times within a second", i)
+		}
+	}
+}
+
+func TestAddImmediateRunsAtStart(t *testing.T) {
+	ran := make(chan struct{}, 10)
+	runner := NewRunner("test-service", logger.NewLogger("test-service")).
+		AddImmediate("backlog", time.Hour, func(context.Context) error {
+			ran <- struct{}{}
+			return nil
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	runner.Start(ctx)
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Fatal("job did not run at Start")
+	}
+	if state := stateOf(t, runner, "backlog"); state.Interval != "1h0m0s" {
+		t.Fatalf("state = %+v", state)
+	}
+}
+
+func TestHandlerListsJobs(t *testing.T) {
+	NewRunner("listed-service", logger.NewLogger("listed-service")).
+		Add("digest", time.Minute, func(context.Context) error { return nil })
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+
+	var body struct {
+		Jobs []State `json:"jobs"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	for _, state := range body.Jobs {
+		if state.Service == "listed-service" && state.Name == "digest" {
+			if state.Interval != "1m0s" || state.Runs != 0 {
+				t.Fatalf("listed %+v", state)
+			}
+			return
+		}
+	}
+	t.Fatalf("digest not listed in %+v", body.Jobs)
+}
@@ -0,0 +1,110 @@
+// Package legacyimport migrates users, wallets, feature ownership and trades
+// from the legacy Laravel database into the microservice schema. Every
+// imported row is recorded in legacy_id_map, so references are rewritten to
+// the new ids, an interrupted run resumes where it stopped, and rows whose
+// references cannot be resolved are reported instead of being inserted as
+// orphans.
+package legacyimport
+
+import "fmt"
+
+// Entity describes how one legacy table is imported
+type Entity struct {
+	Name  string
+	Table string
+	// Columns are copied as they are, apart from those listed in Refs
+	Columns []string
+	// Refs maps a column to the entity whose id it holds
+	Refs map[string]string
+	// OptionalRefs are set to NULL instead of skipping the row when they
+	// cannot be resolved
+	OptionalRefs map[string]bool
+	// MatchColumn finds a row created in the target by an earlier manual
+	// migration, e.g. a user with the same email. Matched rows are mapped
+	// instead of inserted again.
+	MatchColumn string
+	// KeepID inserts the row with its legacy id and matches on id. Features
+	// keep their ids because map geometry refers to them.
+	KeepID bool
+	// UpdateOnMatch columns are overwritten on matched rows
+	UpdateOnMatch []string
+}
+
+// Entities lists the imported entities in dependency order
+var Entities = []Entity{
+	{
+		Name:  "users",
+		Table: "users",
+		Columns: []string{
+			"name", "email", "phone", "phone_verified_at", "email_verified_at", "ip", "password",
+			"referal_link", "referrer_id", "code", "score", "last_seen", "remember_token",
+			"created_at", "updated_at",
+		},
+		Refs:         map[string]string{"referrer_id": "users"},
+		OptionalRefs: map[string]bool{"referrer_id": true},
+		MatchColumn:  "email",
+	},
+	{
+		Name:  "wallets",
+		Table: "wallets",
+		Columns: []string{
+			"user_id", "psc", "irr", "red", "blue", "yellow", "satisfaction", "effect",
+			"created_at", "updated_at",
+		},
+		Refs: map[string]string{"user_id": "users"},
+		// A user has one wallet; one created by the new system is kept as is
+		MatchColumn: "user_id",
+	},
+	{
+		Name:          "features",
+		Table:         "features",
+		Columns:       []string{"map_id", "owner_id", "type", "created_at", "updated_at"},
+		Refs:          map[string]string{"owner_id": "users"},
+		KeepID:        true,
+		UpdateOnMatch: []string{"owner_id"},
+	},
+	{
+		Name:  "trades",
+		Table: "trades",
+		Columns: []string{
+			"feature_id", "buyer_id", "seller_id", "irr_amount", "psc_amount", "date",
+			"created_at", "updated_at",
+		},
+		Refs: map[string]string{"feature_id": "features", "buyer_id": "users", "seller_id": "users"},
+	},
+}
+
+// Names returns the entity names in import order
+func Names() []string {
+	names := make([]string, len(Entities))
+	for i, e := range Entities {
+		names[i] = e.Name
+	}
+	return names
+}
+
+// Select returns the named entities in import order, or all of them when
+// names is empty. Entities referenced by a selected one must be selected too
+// or imported by an earlier run, otherwise its rows are reported as orphans.
+func Select(names []string) ([]Entity, error) {
+	if len(names) == 0 {
+		return Entities, nil
+	}
+
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[name] = true
+	}
+
+	var selected []Entity
+	for _, e := range Entities {
+		if wanted[e.Name] {
+			selected = append(selected, e)
+			delete(wanted, e.Name)
+		}
+	}
+	for name := range wanted {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownEntity, name)
+	}
+	return selected, nil
+}
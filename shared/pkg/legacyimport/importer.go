@@ -0,0 +1,398 @@
+package legacyimport
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// ErrUnknownEntity is returned by Select for names not in Entities
+var ErrUnknownEntity = errors.New("unknown entity")
+
+// MapTable records the target id of every imported or matched legacy row
+const MapTable = "legacy_id_map"
+
+// MapTableDDL creates MapTable in the target schema
+const MapTableDDL = "CREATE TABLE IF NOT EXISTS `" + MapTable + "` (" +
+	"`entity` varchar(64) NOT NULL, " +
+	"`legacy_id` bigint(20) unsigned NOT NULL, " +
+	"`new_id` bigint(20) unsigned NOT NULL, " +
+	"`created_at` timestamp NULL DEFAULT NULL, " +
+	"PRIMARY KEY (`entity`,`legacy_id`)" +
+	") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci"
+
+// DefaultBatchSize is how many legacy rows are read at a time
+const DefaultBatchSize = 500
+
+// maxOrphanSamples limits the orphans listed per entity in a Result
+const maxOrphanSamples = 20
+
+// Options configure an Importer
+type Options struct {
+	// DryRun resolves and matches every row without writing to the target
+	DryRun    bool
+	BatchSize int
+}
+
+// Result counts what happened to the legacy rows of one entity
+type Result struct {
+	Entity   string
+	Read     int
+	Inserted int
+	Matched  int // already in the target, mapped without inserting
+	Updated  int // matched rows whose UpdateOnMatch columns changed
+	Skipped  int // imported by an earlier run
+	Orphaned int // not imported because a reference could not be resolved
+	// OrphanSamples describes the first orphans, e.g. "trades 12: buyer_id 99 not imported"
+	OrphanSamples []string
+}
+
+// Importer copies legacy rows into the target schema. The source is only read.
+type Importer struct {
+	source *sql.DB
+	target *sql.DB
+	opts   Options
+	// ids maps entity -> legacy id -> target id. In a dry run rows that would
+	// be inserted map to 0 so their dependants still resolve.
+	ids map[string]map[uint64]uint64
+}
+
+// New creates an importer reading the legacy database and writing the target
+func New(source, target *sql.DB, opts Options) *Importer {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+	return &Importer{
+		source: source,
+		target: target,
+		opts:   opts,
+		ids:    make(map[string]map[uint64]uint64),
+	}
+}
+
+// Run imports the entities in order. Rows already in MapTable are skipped, so
+// an interrupted run is resumed by running it again.
+func (im *Importer) Run(ctx context.Context, entities []Entity) ([]*Result, error) {
+	if !im.opts.DryRun {
+		if _, err := im.target.ExecContext(ctx, MapTableDDL); err != nil {
+			return nil, fmt.Errorf("failed to create %s: %w", MapTable, err)
+		}
+	}
+
+	var results []*Result
+	for _, e := range entities {
+		result, err := im.importEntity(ctx, e)
+		if result != nil {
+			results = append(results, result)
+		}
+		if err != nil {
+			return results, fmt.Errorf("failed to import %s: %w", e.Name, err)
+		}
+	}
+	return results, nil
+}
+
+func (im *Importer) importEntity(ctx context.Context, e Entity) (*Result, error) {
+	if _, err := im.loadIDs(ctx, e.Name); err != nil {
+		return nil, err
+	}
+	for _, ref := range e.Refs {
+		if _, err := im.loadIDs(ctx, ref); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &Result{Entity: e.Name}
+	query := fmt.Sprintf("SELECT id, %s FROM `%s` WHERE id > ? ORDER BY id LIMIT ?", quoteColumns(e.Columns), e.Table)
+	var lastID uint64
+	for {
+		rows, err := im.readBatch(ctx, query, len(e.Columns)+1, lastID)
+		if err != nil {
+			return result, err
+		}
+		for _, row := range rows {
+			legacyID, _ := toUint64(row[0])
+			lastID = legacyID
+			result.Read++
+			if err := im.importRow(ctx, e, legacyID, row[1:], result); err != nil {
+				return result, fmt.Errorf("legacy id %d: %w", legacyID, err)
+			}
+		}
+		if len(rows) < im.opts.BatchSize {
+			return result, nil
+		}
+	}
+}
+
+func (im *Importer) importRow(ctx context.Context, e Entity, legacyID uint64, values []any, result *Result) error {
+	if _, ok := im.ids[e.Name][legacyID]; ok {
+		result.Skipped++
+		return nil
+	}
+
+	if column, ref, ok := resolveRefs(e, values, im.ids); !ok {
+		result.Orphaned++
+		if len(result.OrphanSamples) < maxOrphanSamples {
+			result.OrphanSamples = append(result.OrphanSamples,
+				fmt.Sprintf("%s %d: %s %d not imported", e.Name, legacyID, column, ref))
+		}
+		return nil
+	}
+
+	targetID, found, err := im.match(ctx, e, legacyID, values)
+	if err != nil {
+		return err
+	}
+	if found {
+		result.Matched++
+		if im.opts.DryRun {
+			im.ids[e.Name][legacyID] = targetID
+			return nil
+		}
+		updated, err := im.update(ctx, e, targetID, values)
+		if err != nil {
+			return err
+		}
+		if updated {
+			result.Updated++
+		}
+		return im.record(ctx, e.Name, legacyID, targetID)
+	}
+
+	result.Inserted++
+	if im.opts.DryRun {
+		im.ids[e.Name][legacyID] = 0
+		return nil
+	}
+	return im.insert(ctx, e, legacyID, values)
+}
+
+// resolveRefs rewrites the reference columns of values to target ids. It
+// returns the first column that cannot be resolved and its legacy id; NULL
+// and zero references are kept.
+func resolveRefs(e Entity, values []any, ids map[string]map[uint64]uint64) (string, uint64, bool) {
+	for i, column := range e.Columns {
+		ref, ok := e.Refs[column]
+		if !ok {
+			continue
+		}
+		legacy, ok := toUint64(values[i])
+		if !ok || legacy == 0 {
+			continue
+		}
+		if target, ok := ids[ref][legacy]; ok {
+			values[i] = target
+		} else if e.OptionalRefs[column] {
+			values[i] = nil
+		} else {
+			return column, legacy, false
+		}
+	}
+	return "", 0, true
+}
+
+// match finds a target row the legacy row was already migrated to
+func (im *Importer) match(ctx context.Context, e Entity, legacyID uint64, values []any) (uint64, bool, error) {
+	var query string
+	var arg any
+	switch {
+	case e.KeepID:
+		query, arg = fmt.Sprintf("SELECT id FROM `%s` WHERE id = ?", e.Table), legacyID
+	case e.MatchColumn != "":
+		value := values[columnIndex(e.Columns, e.MatchColumn)]
+		if value == nil {
+			return 0, false, nil
+		}
+		query, arg = fmt.Sprintf("SELECT id FROM `%s` WHERE `%s` = ? ORDER BY id LIMIT 1", e.Table, e.MatchColumn), value
+	default:
+		return 0, false, nil
+	}
+
+	var id uint64
+	err := im.target.QueryRowContext(ctx, query, arg).Scan(&id)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to match %s: %w", e.Name, err)
+	}
+	return id, true, nil
+}
+
+func (im *Importer) update(ctx context.Context, e Entity, targetID uint64, values []any) (bool, error) {
+	if len(e.UpdateOnMatch) == 0 {
+		return false, nil
+	}
+
+	sets := make([]string, len(e.UpdateOnMatch))
+	args := make([]any, 0, len(e.UpdateOnMatch)+1)
+	for i, column := range e.UpdateOnMatch {
+		sets[i] = fmt.Sprintf("`%s` = ?", column)
+		args = append(args, values[columnIndex(e.Columns, column)])
+	}
+	args = append(args, targetID)
+
+	res, err := im.target.ExecContext(ctx, fmt.Sprintf("UPDATE `%s` SET %s WHERE id = ?", e.Table, strings.Join(sets, ", ")), args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to update %s %d: %w", e.Name, targetID, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// insert adds the row and its mapping in one transaction, so a crash never
+// leaves a row the next run would insert again
+func (im *Importer) insert(ctx context.Context, e Entity, legacyID uint64, values []any) error {
+	columns, args := e.Columns, values
+	if e.KeepID {
+		columns = append([]string{"id"}, e.Columns...)
+		args = append([]any{legacyID}, values...)
+	}
+	query := fmt.Sprintf("INSERT INTO `%s` (%s) VALUES (%s)", e.Table, quoteColumns(columns), placeholders(len(columns)))
+
+	tx, err := im.target.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert %s: %w", e.Name, err)
+	}
+	targetID := legacyID
+	if !e.KeepID {
+		id, err := res.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("failed to get %s id: %w", e.Name, err)
+		}
+		targetID = uint64(id)
+	}
+
+	if _, err := tx.ExecContext(ctx, "INSERT INTO `"+MapTable+"` (entity, legacy_id, new_id, created_at) VALUES (?, ?, ?, NOW())", e.Name, legacyID, targetID); err != nil {
+		return fmt.Errorf("failed to record %s mapping: %w", e.Name, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit %s: %w", e.Name, err)
+	}
+
+	im.ids[e.Name][legacyID] = targetID
+	return nil
+}
+
+func (im *Importer) record(ctx context.Context, entity string, legacyID, targetID uint64) error {
+	if _, err := im.target.ExecContext(ctx, "INSERT INTO `"+MapTable+"` (entity, legacy_id, new_id, created_at) VALUES (?, ?, ?, NOW())", entity, legacyID, targetID); err != nil {
+		return fmt.Errorf("failed to record %s mapping: %w", entity, err)
+	}
+	im.ids[entity][legacyID] = targetID
+	return nil
+}
+
+// loadIDs reads the mappings of entity from MapTable once. A missing table,
+// possible in a dry run before the first import, means nothing is mapped.
+func (im *Importer) loadIDs(ctx context.Context, entity string) (map[uint64]uint64, error) {
+	if ids, ok := im.ids[entity]; ok {
+		return ids, nil
+	}
+
+	ids := make(map[uint64]uint64)
+	rows, err := im.target.QueryContext(ctx, "SELECT legacy_id, new_id FROM `"+MapTable+"` WHERE entity = ?", entity)
+	if err != nil {
+		if im.opts.DryRun && isMissingTable(err) {
+			im.ids[entity] = ids
+			return ids, nil
+		}
+		return nil, fmt.Errorf("failed to load %s mappings: %w", entity, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var legacyID, newID uint64
+		if err := rows.Scan(&legacyID, &newID); err != nil {
+			return nil, fmt.Errorf("failed to scan %s mapping: %w", entity, err)
+		}
+		ids[legacyID] = newID
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating %s mappings: %w", entity, err)
+	}
+
+	im.ids[entity] = ids
+	return ids, nil
+}
+
+func (im *Importer) readBatch(ctx context.Context, query string, width int, after uint64) ([][]any, error) {
+	rows, err := im.source.QueryContext(ctx, query, after, im.opts.BatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read legacy rows: %w", err)
+	}
+	defer rows.Close()
+
+	var batch [][]any
+	for rows.Next() {
+		values := make([]any, width)
+		dest := make([]any, width)
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("failed to scan legacy row: %w", err)
+		}
+		batch = append(batch, values)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating legacy rows: %w", err)
+	}
+	return batch, nil
+}
+
+// toUint64 converts an id scanned into an interface, which the MySQL driver
+// returns as an integer or as text depending on the protocol
+func toUint64(v any) (uint64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return uint64(n), n >= 0
+	case uint64:
+		return n, true
+	case []byte:
+		id, err := strconv.ParseUint(string(n), 10, 64)
+		return id, err == nil
+	case string:
+		id, err := strconv.ParseUint(n, 10, 64)
+		return id, err == nil
+	}
+	return 0, false
+}
+
+func isMissingTable(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1146 // ER_NO_SUCH_TABLE
+}
+
+func columnIndex(columns []string, column string) int {
+	for i, c := range columns {
+		if c == column {
+			return i
+		}
+	}
+	panic("legacyimport: unknown column " + column)
+}
+
+func quoteColumns(columns []string) string {
+	quoted := make([]string, len(columns))
+	for i, c := range columns {
+		quoted[i] = "`" + c + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
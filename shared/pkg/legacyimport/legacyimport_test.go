@@ -0,0 +1,102 @@
+package legacyimport
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEntitiesAreConsistent(t *testing.T) {
+	imported := make(map[string]bool)
+	for _, e := range Entities {
+		columns := make(map[string]bool, len(e.Columns))
+		for _, c := range e.Columns {
+			columns[c] = true
+		}
+		for column, ref := range e.Refs {
+			if !columns[column] {
+				t.Errorf("%s: reference column %s is not copied", e.Name, column)
+			}
+			if ref != e.Name && !imported[ref] {
+				t.Errorf("%s: %s refers to %s, which is imported later", e.Name, column, ref)
+			}
+		}
+		for column := range e.OptionalRefs {
+			if _, ok := e.Refs[column]; !ok {
+				t.Errorf("%s: optional column %s is not a reference", e.Name, column)
+			}
+		}
+		for _, column := range append([]string{e.MatchColumn}, e.UpdateOnMatch...) {
+			if column != "" && !columns[column] {
+				t.Errorf("%s: column %s is not copied", e.Name, column)
+			}
+		}
+		imported[e.Name] = true
+	}
+}
+
+func TestSelect(t *testing.T) {
+	selected, err := Select([]string{"trades", "users"})
+	if err != nil {
+		t.Fatalf("Select failed: %v", err)
+	}
+	if len(selected) != 2 || selected[0].Name != "users" || selected[1].Name != "trades" {
+		t.Errorf("Select returned %v, want users then trades", selected)
+	}
+
+	if all, _ := Select(nil); len(all) != len(Entities) {
+		t.Errorf("Select(nil) returned %d entities, want all %d", len(all), len(Entities))
+	}
+	if _, err := Select([]string{"orders"}); !errors.Is(err, ErrUnknownEntity) {
+		t.Errorf("expected ErrUnknownEntity, got %v", err)
+	}
+}
+
+func TestResolveRefs(t *testing.T) {
+	trades, _ := Select([]string{"trades"})
+	users, _ := Select([]string{"users"})
+	ids := map[string]map[uint64]uint64{
+		"users":    {5: 105, 6: 106},
+		"features": {42: 42},
+	}
+
+	// feature_id, buyer_id, seller_id, irr_amount, psc_amount, date, created_at, updated_at
+	values := []any{int64(42), []byte("5"), uint64(6), nil, int64(10), "2024-01-01", nil, nil}
+	if _, _, ok := resolveRefs(trades[0], values, ids); !ok {
+		t.Fatal("expected every reference to resolve")
+	}
+	if values[0] != uint64(42) || values[1] != uint64(105) || values[2] != uint64(106) || values[4] != int64(10) {
+		t.Errorf("resolved values = %v", values)
+	}
+
+	values = []any{int64(42), int64(5), int64(7), nil, nil, "2024-01-01", nil, nil}
+	column, legacy, ok := resolveRefs(trades[0], values, ids)
+	if ok || column != "seller_id" || legacy != 7 {
+		t.Errorf("resolveRefs = (%q, %d, %v), want seller_id 7 unresolved", column, legacy, ok)
+	}
+
+	// An unknown referrer is dropped instead of orphaning the user
+	values = make([]any, len(users[0].Columns))
+	values[columnIndex(users[0].Columns, "referrer_id")] = int64(99)
+	if _, _, ok := resolveRefs(users[0], values, ids); !ok {
+		t.Fatal("optional reference must not orphan the row")
+	}
+	if v := values[columnIndex(users[0].Columns, "referrer_id")]; v != nil {
+		t.Errorf("referrer_id = %v, want NULL", v)
+	}
+}
+
+func TestCheckOK(t *testing.T) {
+	if !(Check{LegacyRows: 3, Mapped: 3, Dangling: map[string]int64{"user_id": 0}}).OK() {
+		t.Error("complete import must be OK")
+	}
+	if (Check{LegacyRows: 3, Mapped: 2}).OK() {
+		t.Error("missing rows must fail")
+	}
+	check := Check{LegacyRows: 3, Mapped: 3, Dangling: map[string]int64{"seller_id": 1, "buyer_id": 2, "feature_id": 0}}
+	if check.OK() {
+		t.Error("dangling references must fail")
+	}
+	if got := check.DanglingColumns(); len(got) != 2 || got[0] != "buyer_id" || got[1] != "seller_id" {
+		t.Errorf("DanglingColumns = %v", got)
+	}
+}
@@ -0,0 +1,75 @@
+package legacyimport
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// Check compares one entity between the legacy database and the target
+type Check struct {
+	Entity     string
+	LegacyRows int64
+	Mapped     int64
+	// Dangling counts target rows per reference column whose referenced row
+	// does not exist, whether they came from this tool or a manual migration
+	Dangling map[string]int64
+}
+
+// OK reports whether every legacy row was imported and nothing dangles
+func (c Check) OK() bool {
+	if c.LegacyRows != c.Mapped {
+		return false
+	}
+	for _, n := range c.Dangling {
+		if n > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// DanglingColumns returns the reference columns with dangling rows, sorted
+func (c Check) DanglingColumns() []string {
+	var columns []string
+	for column, n := range c.Dangling {
+		if n > 0 {
+			columns = append(columns, column)
+		}
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+// Verify checks the entities after an import. It only reads.
+func (im *Importer) Verify(ctx context.Context, entities []Entity) ([]*Check, error) {
+	tables := make(map[string]string, len(Entities))
+	for _, e := range Entities {
+		tables[e.Name] = e.Table
+	}
+
+	var checks []*Check
+	for _, e := range entities {
+		check := &Check{Entity: e.Name, Dangling: make(map[string]int64)}
+		if err := im.source.QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM `%s`", e.Table)).Scan(&check.LegacyRows); err != nil {
+			return checks, fmt.Errorf("failed to count legacy %s: %w", e.Name, err)
+		}
+		if err := im.target.QueryRowContext(ctx, "SELECT COUNT(*) FROM `"+MapTable+"` WHERE entity = ?", e.Name).Scan(&check.Mapped); err != nil {
+			return checks, fmt.Errorf("failed to count %s mappings: %w", e.Name, err)
+		}
+
+		for column, ref := range e.Refs {
+			query := fmt.Sprintf(
+				"SELECT COUNT(*) FROM `%s` t WHERE t.`%s` IS NOT NULL AND t.`%s` <> 0 AND NOT EXISTS (SELECT 1 FROM `%s` r WHERE r.id = t.`%s`)",
+				e.Table, column, column, tables[ref], column,
+			)
+			var n int64
+			if err := im.target.QueryRowContext(ctx, query).Scan(&n); err != nil {
+				return checks, fmt.Errorf("failed to check %s.%s: %w", e.Table, column, err)
+			}
+			check.Dangling[column] = n
+		}
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
@@ -0,0 +1,129 @@
+package loadgen
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// Rect is a map area in feature coordinates (x is longitude, y latitude)
+type Rect struct {
+	MinX, MinY, MaxX, MaxY float64
+}
+
+// ParseRect parses "minX,minY,maxX,maxY"
+func ParseRect(s string) (Rect, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 4 {
+		return Rect{}, fmt.Errorf("expected minX,minY,maxX,maxY, got %q", s)
+	}
+	var v [4]float64
+	for i, part := range parts {
+		f, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return Rect{}, fmt.Errorf("invalid coordinate %q: %w", part, err)
+		}
+		v[i] = f
+	}
+	r := Rect{MinX: v[0], MinY: v[1], MaxX: v[2], MaxY: v[3]}
+	if r.MinX >= r.MaxX || r.MinY >= r.MaxY {
+		return Rect{}, fmt.Errorf("empty area %q", s)
+	}
+	return r, nil
+}
+
+// Points returns the corners in the order ListFeatures expects: top left,
+// top right, bottom left, bottom right
+func (r Rect) Points() []string {
+	return []string{
+		formatPoint(r.MinX, r.MaxY),
+		formatPoint(r.MaxX, r.MaxY),
+		formatPoint(r.MinX, r.MinY),
+		formatPoint(r.MaxX, r.MinY),
+	}
+}
+
+func formatPoint(x, y float64) string {
+	return strconv.FormatFloat(x, 'f', 6, 64) + "," + strconv.FormatFloat(y, 'f', 6, 64)
+}
+
+// FixtureConfig describes the data the scenarios draw from. The users,
+// parcels and region must exist in the target environment, e.g. from the
+// seed command.
+type FixtureConfig struct {
+	Seed int64
+	// FirstUserID and Users select the user ids FirstUserID..FirstUserID+Users-1
+	FirstUserID uint64
+	Users       int
+	// ContestedFeatureID is the parcel every buyer races for
+	ContestedFeatureID uint64
+	// Region is swept by viewports of ViewportSize degrees
+	Region       Rect
+	ViewportSize float64
+}
+
+// DefaultFixtureConfig covers central Tehran with 100 users
+func DefaultFixtureConfig() FixtureConfig {
+	return FixtureConfig{
+		Seed:               1,
+		FirstUserID:        1,
+		Users:              100,
+		ContestedFeatureID: 1,
+		Region:             Rect{MinX: 51.2, MinY: 35.6, MaxX: 51.6, MaxY: 35.8},
+		ViewportSize:       0.01,
+	}
+}
+
+// Fixtures are the users, phones, parcel and viewports derived from a config.
+// The same config always yields the same fixtures.
+type Fixtures struct {
+	Config    FixtureConfig
+	Users     []uint64
+	Phones    []string // Phones[i] belongs to Users[i]
+	Viewports []Rect   // Row by row, west to east and north to south
+}
+
+// NewFixtures derives the fixtures of cfg
+func NewFixtures(cfg FixtureConfig) (*Fixtures, error) {
+	if cfg.Users <= 0 {
+		return nil, fmt.Errorf("loadgen: at least one user is required")
+	}
+	if cfg.ViewportSize <= 0 {
+		return nil, fmt.Errorf("loadgen: viewport size must be positive")
+	}
+
+	rng := rand.New(rand.NewSource(cfg.Seed))
+	f := &Fixtures{Config: cfg}
+	for i := 0; i < cfg.Users; i++ {
+		f.Users = append(f.Users, cfg.FirstUserID+uint64(i))
+		f.Phones = append(f.Phones, fmt.Sprintf("0912%07d", rng.Intn(10_000_000)))
+	}
+
+	for y := cfg.Region.MaxY; y-cfg.ViewportSize >= cfg.Region.MinY-1e-9; y -= cfg.ViewportSize {
+		for x := cfg.Region.MinX; x+cfg.ViewportSize <= cfg.Region.MaxX+1e-9; x += cfg.ViewportSize {
+			f.Viewports = append(f.Viewports, Rect{MinX: x, MinY: y - cfg.ViewportSize, MaxX: x + cfg.ViewportSize, MaxY: y})
+		}
+	}
+	if len(f.Viewports) == 0 {
+		return nil, fmt.Errorf("loadgen: viewport size %g is larger than the region", cfg.ViewportSize)
+	}
+	return f, nil
+}
+
+// User picks a random user and their phone
+func (f *Fixtures) User(rng *rand.Rand) (uint64, string) {
+	i := rng.Intn(len(f.Users))
+	return f.Users[i], f.Phones[i]
+}
+
+// Viewport returns the viewport a worker looks at on an iteration. Workers
+// start spread across the region and pan through it in order, like users
+// scrolling the map, so caches see both neighbouring and distant tiles.
+func (f *Fixtures) Viewport(w *Worker) Rect {
+	offset := 0
+	if w.Workers > 0 {
+		offset = w.ID * len(f.Viewports) / w.Workers
+	}
+	return f.Viewports[(offset+w.Iteration)%len(f.Viewports)]
+}
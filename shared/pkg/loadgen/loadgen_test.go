@@ -0,0 +1,113 @@
+package loadgen
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRunStopsAtRequestBudget(t *testing.T) {
+	var calls atomic.Int64
+	scenario := Scenario{
+		Name: "fake",
+		Step: func(ctx context.Context, w *Worker) error {
+			if calls.Add(1)%5 == 0 {
+				return status.Error(codes.FailedPrecondition, "already sold")
+			}
+			return nil
+		},
+	}
+
+	report, err := Run(context.Background(), scenario, Options{Workers: 4, Requests: 100})
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if report.Requests != 100 || calls.Load() != 100 {
+		t.Errorf("ran %d requests (%d calls), want 100", report.Requests, calls.Load())
+	}
+	if report.Outcomes["OK"] != 80 || report.Outcomes["FailedPrecondition"] != 20 {
+		t.Errorf("Outcomes = %v", report.Outcomes)
+	}
+
+	if _, err := Run(context.Background(), scenario, Options{}); err == nil {
+		t.Error("a run without duration or request budget must be rejected")
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	report := &Report{}
+	for i := 1; i <= 100; i++ {
+		report.latencies = append(report.latencies, time.Duration(i)*time.Millisecond)
+	}
+	for p, want := range map[float64]time.Duration{50: 50 * time.Millisecond, 95: 95 * time.Millisecond, 99: 99 * time.Millisecond, 100: 100 * time.Millisecond, 0: time.Millisecond} {
+		if got := report.Percentile(p); got != want {
+			t.Errorf("p%g = %s, want %s", p, got, want)
+		}
+	}
+	if (&Report{}).Percentile(95) != 0 {
+		t.Error("empty report must have zero percentiles")
+	}
+}
+
+func TestFixturesAreSeeded(t *testing.T) {
+	cfg := DefaultFixtureConfig()
+	a, err := NewFixtures(cfg)
+	if err != nil {
+		t.Fatalf("NewFixtures failed: %v", err)
+	}
+	b, _ := NewFixtures(cfg)
+	for i := range a.Phones {
+		if a.Phones[i] != b.Phones[i] {
+			t.Fatalf("phone %d differs between runs with the same seed", i)
+		}
+	}
+	cfg.Seed = 2
+	c, _ := NewFixtures(cfg)
+	if c.Phones[0] == a.Phones[0] && c.Phones[1] == a.Phones[1] {
+		t.Error("a different seed must give different phones")
+	}
+
+	// 0.4 x 0.2 degrees in 0.01 degree viewports
+	if len(a.Viewports) != 40*20 {
+		t.Errorf("got %d viewports, want 800", len(a.Viewports))
+	}
+	first := a.Viewport(&Worker{ID: 0, Workers: 2})
+	if got := first.Points(); got[0] != "51.200000,35.800000" || got[3] != "51.210000,35.790000" {
+		t.Errorf("first viewport points = %v", got)
+	}
+	second := a.Viewport(&Worker{ID: 1, Workers: 2})
+	if second == first {
+		t.Error("workers must start at different viewports")
+	}
+}
+
+func TestScenarios(t *testing.T) {
+	f, _ := NewFixtures(DefaultFixtureConfig())
+	got, err := Scenarios([]string{"otp-storm", "viewport-sweep"}, Conns{}, f)
+	if err != nil {
+		t.Fatalf("Scenarios failed: %v", err)
+	}
+	if len(got) != 2 || got[0].Name != "otp-storm" || got[1].Name != "viewport-sweep" {
+		t.Errorf("Scenarios = %v", got)
+	}
+	if _, err := Scenarios([]string{"checkout"}, Conns{}, f); !errors.Is(err, ErrUnknownScenario) {
+		t.Errorf("expected ErrUnknownScenario, got %v", err)
+	}
+}
+
+func TestParseRect(t *testing.T) {
+	r, err := ParseRect("51.2, 35.6, 51.6, 35.8")
+	if err != nil || r != (Rect{MinX: 51.2, MinY: 35.6, MaxX: 51.6, MaxY: 35.8}) {
+		t.Errorf("ParseRect = %v, %v", r, err)
+	}
+	for _, bad := range []string{"1,2,3", "1,2,x,4", "5,2,3,4"} {
+		if _, err := ParseRect(bad); err == nil {
+			t.Errorf("ParseRect(%q) should fail", bad)
+		}
+	}
+}
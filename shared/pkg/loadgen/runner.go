@@ -0,0 +1,163 @@
+// Package loadgen drives load against the gRPC services and reports latency
+// percentiles per scenario. Scenarios draw their users, parcels and map
+// viewports from seeded Fixtures, so two runs with the same seed send the same
+// requests and their reports can be compared across releases.
+package loadgen
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultWorkers = 10
+	DefaultTimeout = 5 * time.Second
+)
+
+// Scenario is one hot flow. Step sends a single request; the runner times it.
+type Scenario struct {
+	Name string
+	Step func(ctx context.Context, w *Worker) error
+}
+
+// Worker is the state of one concurrent caller
+type Worker struct {
+	ID        int
+	Workers   int // Workers in the run
+	Iteration int
+	// Rand is seeded from the fixtures seed and the worker id
+	Rand *rand.Rand
+}
+
+// Options controls how hard and how long a scenario runs
+type Options struct {
+	Workers int
+	// Duration stops the run after this long; Requests stops it after this
+	// many requests. When both are set, whichever comes first wins.
+	Duration time.Duration
+	Requests int
+	// Timeout of each request
+	Timeout time.Duration
+	Seed    int64
+}
+
+// Report summarizes a run. Outcomes counts requests by gRPC status code.
+type Report struct {
+	Scenario  string
+	Requests  int
+	Elapsed   time.Duration
+	Outcomes  map[string]int
+	latencies []time.Duration // sorted
+}
+
+// Run executes scenario until the duration or request budget is spent, or
+// ctx is cancelled
+func Run(ctx context.Context, scenario Scenario, opts Options) (*Report, error) {
+	if opts.Duration <= 0 && opts.Requests <= 0 {
+		return nil, fmt.Errorf("loadgen: set a duration or a request count")
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = DefaultWorkers
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+	if opts.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Duration)
+		defer cancel()
+	}
+
+	var (
+		sent      atomic.Int64
+		mu        sync.Mutex
+		latencies []time.Duration
+		outcomes  = make(map[string]int)
+		wg        sync.WaitGroup
+	)
+	start := time.Now()
+	for i := 0; i < opts.Workers; i++ {
+		wg.Add(1)
+		go func(w *Worker) {
+			defer wg.Done()
+			for ; ctx.Err() == nil; w.Iteration++ {
+				if opts.Requests > 0 && sent.Add(1) > int64(opts.Requests) {
+					return
+				}
+				reqCtx, cancel := context.WithTimeout(ctx, opts.Timeout)
+				begin := time.Now()
+				err := scenario.Step(reqCtx, w)
+				took := time.Since(begin)
+				cancel()
+				if err != nil && ctx.Err() != nil {
+					// Cut short by the end of the run, not a real outcome
+					return
+				}
+
+				mu.Lock()
+				latencies = append(latencies, took)
+				outcomes[status.Code(err).String()]++
+				mu.Unlock()
+			}
+		}(&Worker{ID: i, Workers: opts.Workers, Rand: rand.New(rand.NewSource(opts.Seed + int64(i)))})
+	}
+	wg.Wait()
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	return &Report{
+		Scenario:  scenario.Name,
+		Requests:  len(latencies),
+		Elapsed:   time.Since(start),
+		Outcomes:  outcomes,
+		latencies: latencies,
+	}, nil
+}
+
+// Percentile returns the latency p percent of requests finished within,
+// using the nearest-rank method
+func (r *Report) Percentile(p float64) time.Duration {
+	if len(r.latencies) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p/100*float64(len(r.latencies)))) - 1
+	return r.latencies[min(max(rank, 0), len(r.latencies)-1)]
+}
+
+// Throughput returns the completed requests per second
+func (r *Report) Throughput() float64 {
+	if r.Elapsed <= 0 {
+		return 0
+	}
+	return float64(r.Requests) / r.Elapsed.Seconds()
+}
+
+// String formats the report as one summary line plus the outcome counts
+func (r *Report) String() string {
+	codes := make([]string, 0, len(r.Outcomes))
+	for code := range r.Outcomes {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	outcomes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		outcomes = append(outcomes, fmt.Sprintf("%s=%d", code, r.Outcomes[code]))
+	}
+
+	return fmt.Sprintf("%s: %d requests in %s (%.1f/s) p50=%s p90=%s p95=%s p99=%s max=%s [%s]",
+		r.Scenario, r.Requests, r.Elapsed.Round(time.Millisecond), r.Throughput(),
+		r.rounded(50), r.rounded(90), r.rounded(95), r.rounded(99), r.rounded(100),
+		strings.Join(outcomes, " "))
+}
+
+func (r *Report) rounded(p float64) time.Duration {
+	return r.Percentile(p).Round(time.Microsecond)
+}
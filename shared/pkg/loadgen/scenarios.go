@@ -0,0 +1,104 @@
+package loadgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"google.golang.org/grpc"
+
+	authpb "metargb/shared/pb/auth"
+	featurespb "metargb/shared/pb/features"
+)
+
+// Conns are the service connections the scenarios call
+type Conns struct {
+	Auth     grpc.ClientConnInterface
+	Features grpc.ClientConnInterface
+}
+
+// ErrUnknownScenario is returned by Scenarios for a name it does not know
+var ErrUnknownScenario = errors.New("unknown scenario")
+
+var scenarios = map[string]func(Conns, *Fixtures) Scenario{
+	"viewport-sweep": ViewportSweep,
+	"buy-contention": BuyContention,
+	"otp-storm":      OTPStorm,
+}
+
+// ScenarioNames lists the available scenarios, sorted
+func ScenarioNames() []string {
+	names := make([]string, 0, len(scenarios))
+	for name := range scenarios {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Scenarios builds the named scenarios, in the given order
+func Scenarios(names []string, conns Conns, f *Fixtures) ([]Scenario, error) {
+	out := make([]Scenario, 0, len(names))
+	for _, name := range names {
+		build, ok := scenarios[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownScenario, name)
+		}
+		out = append(out, build(conns, f))
+	}
+	return out, nil
+}
+
+// ViewportSweep pans every worker across the fixture region with ListFeatures,
+// the call behind every map scroll
+func ViewportSweep(conns Conns, f *Fixtures) Scenario {
+	client := featurespb.NewFeatureServiceClient(conns.Features)
+	return Scenario{
+		Name: "viewport-sweep",
+		Step: func(ctx context.Context, w *Worker) error {
+			_, err := client.ListFeatures(ctx, &featurespb.ListFeaturesRequest{
+				Points:        f.Viewport(w).Points(),
+				LoadBuildings: w.Iteration%4 == 0,
+			})
+			return err
+		},
+	}
+}
+
+// BuyContention has random users race to buy the same parcel. Only one
+// purchase may win, so expect one OK and the rest rejected; more than one OK
+// per listing means a double sale.
+func BuyContention(conns Conns, f *Fixtures) Scenario {
+	client := featurespb.NewFeatureMarketplaceServiceClient(conns.Features)
+	return Scenario{
+		Name: "buy-contention",
+		Step: func(ctx context.Context, w *Worker) error {
+			buyer, _ := f.User(w.Rand)
+			_, err := client.BuyFeature(ctx, &featurespb.BuyFeatureRequest{
+				FeatureId: f.Config.ContestedFeatureID,
+				BuyerId:   buyer,
+			})
+			return err
+		},
+	}
+}
+
+// OTPStorm requests account security codes for random users, as a burst of
+// logins would. Each request stores a code and sends an SMS, so point it at an
+// environment whose SMS provider is stubbed.
+func OTPStorm(conns Conns, f *Fixtures) Scenario {
+	client := authpb.NewAuthServiceClient(conns.Auth)
+	return Scenario{
+		Name: "otp-storm",
+		Step: func(ctx context.Context, w *Worker) error {
+			user, phone := f.User(w.Rand)
+			_, err := client.RequestAccountSecurity(ctx, &authpb.RequestAccountSecurityRequest{
+				UserId:      user,
+				TimeMinutes: 5,
+				Phone:       phone,
+			})
+			return err
+		},
+	}
+}
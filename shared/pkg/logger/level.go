@@ -0,0 +1,154 @@
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// LevelPath is where the admin server serves the current log level
+const LevelPath = "/log/level"
+
+// LevelFromEnv reads <SERVICE>_LOG_LEVEL (e.g. AUTH_LOG_LEVEL for
+// "auth-service"), falling back to LOG_LEVEL and then to info. Levels are
+// debug, info, warn and error.
+func LevelFromEnv(service string) logrus.Level {
+	for _, key := range []string{envPrefix(service) + "LOG_LEVEL", "LOG_LEVEL"} {
+		if level, err := ParseLevel(os.Getenv(key)); err == nil {
+			return level
+		}
+	}
+	return logrus.InfoLevel
+}
+
+// ParseLevel parses debug, info, warn or error
+func ParseLevel(name string) (logrus.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "debug":
+		return logrus.DebugLevel, nil
+	case "info":
+		return logrus.InfoLevel, nil
+	case "warn", "warning":
+		return logrus.WarnLevel, nil
+	case "error":
+		return logrus.ErrorLevel, nil
+	}
+	return 0, errors.New("level must be debug, info, warn or error")
+}
+
+// SetLevelName changes the level at runtime
+func (l *Logger) SetLevelName(name string) error {
+	level, err := ParseLevel(name)
+	if err != nil {
+		return err
+	}
+	l.SetLevel(level)
+	return nil
+}
+
+// LevelName returns the current level, e.g. "info"
+func (l *Logger) LevelName() string {
+	if level := l.GetLevel(); level != logrus.WarnLevel {
+		return level.String()
+	}
+	return "warn"
+}
+
+// ToggleDebug switches between debug and the configured level
+func (l *Logger) ToggleDebug() {
+	if l.GetLevel() >= logrus.DebugLevel && l.level < logrus.DebugLevel {
+		l.SetLevel(l.level)
+		return
+	}
+	l.SetLevel(logrus.DebugLevel)
+}
+
+// EnableLevelControl lets operators change the level of a running service
+// until ctx is cancelled:
+//   - SIGHUP toggles debug logging on and off
+//   - when <SERVICE>_LOG_ADMIN_ADDR or LOG_ADMIN_ADDR is set (e.g. ":9095"),
+//     an HTTP server on that address serves LevelHandler at LevelPath
+func (l *Logger) EnableLevelControl(ctx context.Context) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	go func() {
+		defer signal.Stop(signals)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-signals:
+				l.ToggleDebug()
+				l.Warn("Log level changed by SIGHUP", "level", l.LevelName())
+			}
+		}
+	}()
+
+	addr := os.Getenv(envPrefix(l.service) + "LOG_ADMIN_ADDR")
+	if addr == "" {
+		addr = os.Getenv("LOG_ADMIN_ADDR")
+	}
+	if addr == "" {
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(LevelPath, l.LevelHandler())
+	server := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		l.Info("Log admin server started", "addr", addr, "path", LevelPath)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			l.Error("Log admin server failed", "error", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+}
+
+type levelBody struct {
+	Level string `json:"level"`
+}
+
+// LevelHandler returns the current level on GET and changes it on PUT with a
+// body such as {"level":"debug"}
+func (l *Logger) LevelHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			var body levelBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				http.Error(w, "invalid body", http.StatusBadRequest)
+				return
+			}
+			if err := l.SetLevelName(body.Level); err != nil {
+				http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+				return
+			}
+			l.Warn("Log level changed by admin endpoint", "level", l.LevelName())
+		default:
+			w.Header().Set("Allow", "GET, PUT")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(levelBody{Level: l.LevelName()})
+	})
+}
+
+// envPrefix matches the per-service prefix of msgsize.FromEnv, e.g. "AUTH_"
+func envPrefix(service string) string {
+	name := strings.TrimSuffix(service, "-service")
+	return strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+}
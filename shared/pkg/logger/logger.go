@@ -1,19 +1,37 @@
+// Package logger provides the structured JSON logger every service uses.
+//
+// Levels are set per service with <SERVICE>_LOG_LEVEL or LOG_LEVEL (e.g.
+// AUTH_LOG_LEVEL=debug). A running service toggles debug logging on SIGHUP,
+// and serves its level at LevelPath when <SERVICE>_LOG_ADMIN_ADDR or
+// LOG_ADMIN_ADDR is set:
+//
+//	curl -X PUT -d '{"level":"debug"}' http://auth-service:9095/log/level
+//
+// The gRPC interceptors sample request logs per method, see Sampler.
 package logger
 
 import (
 	"context"
+	"fmt"
+	stdlog "log"
 	"os"
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 )
 
-// Logger wraps logrus logger
+// Logger wraps logrus logger with structured, leveled output. The level can
+// be changed at runtime (see EnableLevelControl) and high-volume logs can be
+// sampled (see Sampled).
 type Logger struct {
 	*logrus.Logger
+	service string
+	level   logrus.Level // configured level, restored when debug is toggled off
+	sampler *Sampler
 }
 
-// NewLogger creates a new logger instance
+// NewLogger creates a new logger instance. The level is read from
+// <SERVICE>_LOG_LEVEL or LOG_LEVEL, see LevelFromEnv.
 func NewLogger(serviceName string) *Logger {
 	log := logrus.New()
 
@@ -29,25 +47,77 @@ func NewLogger(serviceName string) *Logger {
 	// Set output
 	log.SetOutput(os.Stdout)
 
-	// Set log level from environment
-	level := os.Getenv("LOG_LEVEL")
-	switch level {
-	case "debug":
-		log.SetLevel(logrus.DebugLevel)
-	case "info":
-		log.SetLevel(logrus.InfoLevel)
-	case "warn":
-		log.SetLevel(logrus.WarnLevel)
-	case "error":
-		log.SetLevel(logrus.ErrorLevel)
-	default:
-		log.SetLevel(logrus.InfoLevel)
-	}
+	level := LevelFromEnv(serviceName)
+	log.SetLevel(level)
 
 	// Add default fields
-	log = log.WithField("service", serviceName).Logger
+	log.AddHook(serviceHook(serviceName))
+
+	return &Logger{
+		Logger:  log,
+		service: serviceName,
+		level:   level,
+		sampler: SamplerFromEnv(serviceName),
+	}
+}
 
-	return &Logger{Logger: log}
+// Debug logs msg with alternating key/value pairs as fields, e.g.
+// log.Debug("Cache miss", "key", key)
+func (l *Logger) Debug(msg string, keysAndValues ...interface{}) {
+	l.WithFields(fields(keysAndValues)).Debug(msg)
+}
+
+// Info logs msg with alternating key/value pairs as fields
+func (l *Logger) Info(msg string, keysAndValues ...interface{}) {
+	l.WithFields(fields(keysAndValues)).Info(msg)
+}
+
+// Warn logs msg with alternating key/value pairs as fields
+func (l *Logger) Warn(msg string, keysAndValues ...interface{}) {
+	l.WithFields(fields(keysAndValues)).Warn(msg)
+}
+
+// Error logs msg with alternating key/value pairs as fields
+func (l *Logger) Error(msg string, keysAndValues ...interface{}) {
+	l.WithFields(fields(keysAndValues)).Error(msg)
+}
+
+// Fatal logs msg with alternating key/value pairs as fields and exits
+func (l *Logger) Fatal(msg string, keysAndValues ...interface{}) {
+	l.WithFields(fields(keysAndValues)).Fatal(msg)
+}
+
+// RedirectStdLog sends output of the standard library log package, still used
+// by some internal packages, through this logger at info level
+func (l *Logger) RedirectStdLog() {
+	stdlog.SetFlags(0)
+	stdlog.SetOutput(l.WriterLevel(logrus.InfoLevel))
+}
+
+// fields turns alternating key/value pairs into logrus fields. A trailing
+// key without a value is kept under "extra".
+func fields(keysAndValues []interface{}) logrus.Fields {
+	result := make(logrus.Fields, len(keysAndValues)/2)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		if i+1 == len(keysAndValues) {
+			result["extra"] = keysAndValues[i]
+			break
+		}
+		result[fmt.Sprint(keysAndValues[i])] = keysAndValues[i+1]
+	}
+	return result
+}
+
+// serviceHook adds the service name to every entry
+type serviceHook string
+
+func (h serviceHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h serviceHook) Fire(entry *logrus.Entry) error {
+	entry.Data["service"] = string(h)
+	return nil
 }
 
 // WithRequestID adds request ID to logger
@@ -68,11 +138,9 @@ func UnaryServerInterceptor(logger *Logger) grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		// Log the request
-		logger.WithFields(logrus.Fields{
-			"method": info.FullMethod,
-			"type":   "unary",
-		}).Info("gRPC request")
+		// Log the request, sampled per method since some methods (e.g. token
+		// validation) are called on every gateway request
+		logger.Sampled(info.FullMethod).Info("gRPC request", "method", info.FullMethod, "type", "unary")
 
 		// Call handler
 		resp, err := handler(ctx, req)
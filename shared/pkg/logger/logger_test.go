@@ -0,0 +1,103 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestStructuredFields(t *testing.T) {
+	log := NewLogger("levels-service")
+	var out bytes.Buffer
+	log.SetOutput(&out)
+
+	log.Info("Event ingested", "event_id", "trade-41", "user_id", 12)
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal(out.Bytes(), &entry); err != nil {
+		t.Fatalf("output is not JSON: %v", err)
+	}
+	if entry["message"] != "Event ingested" || entry["event_id"] != "trade-41" || entry["user_id"] != float64(12) || entry["service"] != "levels-service" {
+		t.Errorf("unexpected entry %v", entry)
+	}
+}
+
+func TestLevelFromEnv(t *testing.T) {
+	t.Setenv("LOG_LEVEL", "warn")
+	if level := LevelFromEnv("auth-service"); level != logrus.WarnLevel {
+		t.Errorf("LOG_LEVEL: got %s, want warning", level)
+	}
+
+	t.Setenv("AUTH_LOG_LEVEL", "debug")
+	if level := LevelFromEnv("auth-service"); level != logrus.DebugLevel {
+		t.Errorf("AUTH_LOG_LEVEL: got %s, want debug", level)
+	}
+	if level := LevelFromEnv("commercial-service"); level != logrus.WarnLevel {
+		t.Errorf("other services: got %s, want warning", level)
+	}
+}
+
+func TestToggleDebug(t *testing.T) {
+	t.Setenv("LOG_LEVEL", "error")
+	log := NewLogger("auth-service")
+
+	log.ToggleDebug()
+	if log.LevelName() != "debug" {
+		t.Fatalf("expected debug after the first toggle, got %s", log.LevelName())
+	}
+	log.ToggleDebug()
+	if log.LevelName() != "error" {
+		t.Fatalf("expected the configured level after the second toggle, got %s", log.LevelName())
+	}
+}
+
+func TestLevelHandler(t *testing.T) {
+	log := NewLogger("auth-service")
+	handler := log.LevelHandler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, LevelPath, strings.NewReader(`{"level":"warn"}`)))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"level":"warn"`) {
+		t.Fatalf("PUT: got %d %s", rec.Code, rec.Body)
+	}
+	if log.GetLevel() != logrus.WarnLevel {
+		t.Errorf("level was not changed")
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, LevelPath, strings.NewReader(`{"level":"loud"}`)))
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("invalid level: got %d, want 422", rec.Code)
+	}
+}
+
+func TestSampler(t *testing.T) {
+	sampler := NewSampler(2, 3, time.Second)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	sampler.now = func() time.Time { return now }
+
+	var allowed []int
+	for i := 1; i <= 8; i++ {
+		if sampler.Allow("/auth.AuthService/ValidateToken") {
+			allowed = append(allowed, i)
+		}
+	}
+	if len(allowed) != 4 || allowed[2] != 5 || allowed[3] != 8 {
+		t.Errorf("allowed %v, want [1 2 5 8]", allowed)
+	}
+	if !sampler.Allow("/auth.AuthService/Login") {
+		t.Errorf("keys must be sampled separately")
+	}
+
+	now = start.Add(time.Second)
+	if !sampler.Allow("/auth.AuthService/ValidateToken") {
+		t.Errorf("the count must reset every tick")
+	}
+}
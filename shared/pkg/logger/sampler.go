@@ -0,0 +1,128 @@
+package logger
+
+import (
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Sampling defaults: per key and second, the first DefaultSampleFirst logs
+// are written and then every DefaultSampleThereafter-th
+const (
+	DefaultSampleFirst      = 10
+	DefaultSampleThereafter = 100
+)
+
+// Sampler limits how often logs with the same key are written
+type Sampler struct {
+	first      uint64
+	thereafter uint64
+	tick       time.Duration
+	now        func() time.Time
+
+	mu       sync.Mutex
+	counters map[string]*sampleCounter
+}
+
+type sampleCounter struct {
+	resetAt time.Time
+	count   uint64
+}
+
+// NewSampler writes the first logs per key and tick, then every thereafter-th.
+// A thereafter of zero drops the rest of the tick.
+func NewSampler(first, thereafter int, tick time.Duration) *Sampler {
+	if first < 0 {
+		first = 0
+	}
+	if thereafter < 0 {
+		thereafter = 0
+	}
+	return &Sampler{
+		first:      uint64(first),
+		thereafter: uint64(thereafter),
+		tick:       tick,
+		now:        time.Now,
+		counters:   make(map[string]*sampleCounter),
+	}
+}
+
+// SamplerFromEnv reads <SERVICE>_LOG_SAMPLE_FIRST and
+// <SERVICE>_LOG_SAMPLE_THEREAFTER, falling back to LOG_SAMPLE_FIRST /
+// LOG_SAMPLE_THEREAFTER and then to the defaults. Both apply per second.
+func SamplerFromEnv(service string) *Sampler {
+	prefix := envPrefix(service)
+	return NewSampler(
+		intFromEnv(DefaultSampleFirst, prefix+"LOG_SAMPLE_FIRST", "LOG_SAMPLE_FIRST"),
+		intFromEnv(DefaultSampleThereafter, prefix+"LOG_SAMPLE_THEREAFTER", "LOG_SAMPLE_THEREAFTER"),
+		time.Second,
+	)
+}
+
+// Allow reports whether a log with key should be written
+func (s *Sampler) Allow(key string) bool {
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counter, ok := s.counters[key]
+	if !ok || !now.Before(counter.resetAt) {
+		counter = &sampleCounter{resetAt: now.Add(s.tick)}
+		s.counters[key] = counter
+	}
+	counter.count++
+
+	if counter.count <= s.first {
+		return true
+	}
+	return s.thereafter > 0 && (counter.count-s.first)%s.thereafter == 0
+}
+
+func intFromEnv(defaultValue int, keys ...string) int {
+	for _, key := range keys {
+		if n, err := strconv.Atoi(os.Getenv(key)); err == nil && n >= 0 {
+			return n
+		}
+	}
+	return defaultValue
+}
+
+// SampledLogger samples debug and info logs of one key. Warnings and errors
+// are always written.
+type SampledLogger struct {
+	logger *Logger
+	key    string
+}
+
+// Sampled returns a logger for a high-volume path, e.g. a gRPC method
+func (l *Logger) Sampled(key string) *SampledLogger {
+	return &SampledLogger{logger: l, key: key}
+}
+
+// Debug logs msg if debug is enabled and the sampler allows it
+func (s *SampledLogger) Debug(msg string, keysAndValues ...interface{}) {
+	if s.logger.IsLevelEnabled(logrus.DebugLevel) && s.logger.sampler.Allow(s.key) {
+		s.logger.Debug(msg, keysAndValues...)
+	}
+}
+
+// Info logs msg if info is enabled and the sampler allows it
+func (s *SampledLogger) Info(msg string, keysAndValues ...interface{}) {
+	if s.logger.IsLevelEnabled(logrus.InfoLevel) && s.logger.sampler.Allow(s.key) {
+		s.logger.Info(msg, keysAndValues...)
+	}
+}
+
+// Warn always logs msg
+func (s *SampledLogger) Warn(msg string, keysAndValues ...interface{}) {
+	s.logger.Warn(msg, keysAndValues...)
+}
+
+// Error always logs msg
+func (s *SampledLogger) Error(msg string, keysAndValues ...interface{}) {
+	s.logger.Error(msg, keysAndValues...)
+}
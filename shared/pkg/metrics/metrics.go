@@ -2,12 +2,17 @@ package metrics
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/status"
+
+	"metargb/shared/pkg/buildinfo"
+	"metargb/shared/pkg/jobs"
 )
 
 // Metrics holds Prometheus metrics for a service
@@ -142,3 +147,13 @@ func (m *Metrics) RecordDBPoolStats(open, inUse, idle int, waitCount int64, wait
 	m.DBConnPoolStats.WithLabelValues("wait_duration_ms").Set(float64(waitDuration.Milliseconds()))
 }
 
+// NewServer returns an HTTP server exposing every registered metric at
+// /metrics on addr, the build on buildinfo.Path and the background jobs on
+// jobs.Path
+func NewServer(addr string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.Handle(buildinfo.Path, buildinfo.Handler())
+	mux.Handle(jobs.Path, jobs.Handler())
+	return &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+}
@@ -0,0 +1,80 @@
+// Package money parses, rounds and formats wallet amounts as exact decimals.
+//
+// Amounts used to travel as float64 and were parsed from strings with
+// fmt.Sscanf, which loses digits past about 15 significant figures: a psc
+// balance of 123456.0123456789 has 16. Services keep amounts as
+// decimal.Decimal instead, and send them over gRPC as decimal strings in the
+// amount_decimal fields next to the legacy double fields.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// IRR is the only asset held in whole units, rials have no fractions
+const IRR = "irr"
+
+// Scale is the number of decimal places wallets keep for assets other than irr
+const Scale = 10
+
+var ErrInvalidAmount = errors.New("invalid amount")
+
+// Parse parses a decimal amount such as "12.5". Surrounding spaces are
+// ignored. Empty strings are invalid, and so are exponents, since "1e999999999"
+// would allocate a number with a billion digits.
+func Parse(value string) (decimal.Decimal, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.ContainsAny(value, "eE") {
+		return decimal.Zero, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+	}
+	amount, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+	}
+	return amount, nil
+}
+
+// ParseOrZero parses a stored amount, treating empty and malformed values as
+// zero like the columns they are read from, e.g. feature_properties.price_psc
+func ParseOrZero(value string) decimal.Decimal {
+	amount, err := Parse(value)
+	if err != nil {
+		return decimal.Zero
+	}
+	return amount
+}
+
+// FromRequest returns the exact amount of a request when it was sent, and
+// otherwise the legacy double amount. Doubles are converted through their
+// shortest representation, so 0.1 stays 0.1.
+func FromRequest(exact string, legacy float64) (decimal.Decimal, error) {
+	if strings.TrimSpace(exact) != "" {
+		return Parse(exact)
+	}
+	return decimal.NewFromFloat(legacy), nil
+}
+
+// Truncate drops the digits a wallet cannot hold: irr is kept as an integer
+// and other assets with Scale decimal places
+func Truncate(asset string, amount decimal.Decimal) decimal.Decimal {
+	if asset == IRR {
+		return amount.Truncate(0)
+	}
+	return amount.Truncate(Scale)
+}
+
+// Format returns amount as a plain decimal string without trailing zeros,
+// for the amount_decimal fields and JSON responses
+func Format(amount decimal.Decimal) string {
+	return amount.String()
+}
+
+// Float returns amount for the legacy double fields
+func Float(amount decimal.Decimal) float64 {
+	f, _ := amount.Float64()
+	return f
+}
@@ -0,0 +1,63 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestParse(t *testing.T) {
+	amount, err := Parse(" 123456.0123456789 ")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if got := Format(amount); got != "123456.0123456789" {
+		t.Errorf("Format(Parse()) = %s, want 123456.0123456789", got)
+	}
+
+	for _, value := range []string{"", "  ", "abc", "1,000", "1e999999999"} {
+		if _, err := Parse(value); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("Parse(%q) error = %v, want ErrInvalidAmount", value, err)
+		}
+	}
+
+	if got := ParseOrZero("not a number"); !got.IsZero() {
+		t.Errorf("ParseOrZero returned %s, want 0", got)
+	}
+}
+
+func TestFromRequest(t *testing.T) {
+	tests := []struct {
+		exact  string
+		legacy float64
+		want   string
+	}{
+		{exact: "0.3", legacy: 99, want: "0.3"},
+		{exact: "", legacy: 0.1, want: "0.1"},
+		{exact: "", legacy: 1500000, want: "1500000"},
+	}
+	for _, tt := range tests {
+		got, err := FromRequest(tt.exact, tt.legacy)
+		if err != nil {
+			t.Fatalf("FromRequest(%q, %v) returned error: %v", tt.exact, tt.legacy, err)
+		}
+		if got.String() != tt.want {
+			t.Errorf("FromRequest(%q, %v) = %s, want %s", tt.exact, tt.legacy, got, tt.want)
+		}
+	}
+
+	if _, err := FromRequest("12..5", 12.5); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("malformed exact amount: error = %v, want ErrInvalidAmount", err)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	amount := decimal.RequireFromString("1999.123456789012")
+	if got := Truncate("irr", amount).String(); got != "1999" {
+		t.Errorf("Truncate(irr) = %s, want 1999", got)
+	}
+	if got := Truncate("psc", amount).String(); got != "1999.123456789" {
+		t.Errorf("Truncate(psc) = %s, want 1999.123456789", got)
+	}
+}
@@ -0,0 +1,92 @@
+// Package msgsize makes gRPC message size limits configurable per service
+package msgsize
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+// DefaultMaxMessageSize is grpc-go's own default receive limit
+const DefaultMaxMessageSize = 4 << 20
+
+// Limits holds the largest message, in bytes, a service accepts and sends
+type Limits struct {
+	MaxRecv int
+	MaxSend int
+}
+
+// Defaults returns the grpc-go default limits
+func Defaults() Limits {
+	return Limits{MaxRecv: DefaultMaxMessageSize, MaxSend: DefaultMaxMessageSize}
+}
+
+// FromEnv reads <SERVICE>_GRPC_MAX_RECV_MSG_SIZE and <SERVICE>_GRPC_MAX_SEND_MSG_SIZE
+// (e.g. FEATURES_GRPC_MAX_SEND_MSG_SIZE for "features-service"), falling back to
+// GRPC_MAX_RECV_MSG_SIZE / GRPC_MAX_SEND_MSG_SIZE and then to defaults. Values
+// are bytes and may use a KB or MB suffix, e.g. "16MB".
+func FromEnv(service string, defaults Limits) Limits {
+	prefix := envPrefix(service)
+	return Limits{
+		MaxRecv: sizeFromEnv(defaults.MaxRecv, prefix+"GRPC_MAX_RECV_MSG_SIZE", "GRPC_MAX_RECV_MSG_SIZE"),
+		MaxSend: sizeFromEnv(defaults.MaxSend, prefix+"GRPC_MAX_SEND_MSG_SIZE", "GRPC_MAX_SEND_MSG_SIZE"),
+	}
+}
+
+// ServerOptions applies the limits to a gRPC server
+func (l Limits) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(l.MaxRecv),
+		grpc.MaxSendMsgSize(l.MaxSend),
+	}
+}
+
+// DialOptions applies the limits to every call made over a client connection
+func (l Limits) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(l.MaxRecv),
+			grpc.MaxCallSendMsgSize(l.MaxSend),
+		),
+	}
+}
+
+func envPrefix(service string) string {
+	name := strings.TrimSuffix(service, "-service")
+	return strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+}
+
+func sizeFromEnv(defaultValue int, keys ...string) int {
+	for _, key := range keys {
+		if size, ok := ParseSize(os.Getenv(key)); ok {
+			return size
+		}
+	}
+	return defaultValue
+}
+
+// ParseSize parses a positive byte size such as "4194304", "512KB" or "16MB"
+func ParseSize(value string) (int, bool) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if value == "" {
+		return 0, false
+	}
+
+	multiplier := 1
+	switch {
+	case strings.HasSuffix(value, "MB"):
+		multiplier = 1 << 20
+		value = strings.TrimSuffix(value, "MB")
+	case strings.HasSuffix(value, "KB"):
+		multiplier = 1 << 10
+		value = strings.TrimSuffix(value, "KB")
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || n <= 0 || n > (1<<31-1)/multiplier {
+		return 0, false
+	}
+	return n * multiplier, true
+}
@@ -0,0 +1,44 @@
+package msgsize
+
+import "testing"
+
+func TestParseSize(t *testing.T) {
+	cases := map[string]int{
+		"1024":  1024,
+		"512KB": 512 << 10,
+		"16mb":  16 << 20,
+		" 8MB ": 8 << 20,
+	}
+	for input, want := range cases {
+		got, ok := ParseSize(input)
+		if !ok || got != want {
+			t.Errorf("ParseSize(%q) = %d, %v, want %d", input, got, ok, want)
+		}
+	}
+
+	for _, input := range []string{"", "0", "-1", "lots", "4GB", "9999999MB"} {
+		if _, ok := ParseSize(input); ok {
+			t.Errorf("ParseSize(%q) should fail", input)
+		}
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("GRPC_MAX_RECV_MSG_SIZE", "8MB")
+	t.Setenv("GRPC_MAX_SEND_MSG_SIZE", "")
+	t.Setenv("FEATURES_GRPC_MAX_RECV_MSG_SIZE", "")
+	t.Setenv("FEATURES_GRPC_MAX_SEND_MSG_SIZE", "32MB")
+
+	limits := FromEnv("features-service", Defaults())
+	if limits.MaxRecv != 8<<20 {
+		t.Errorf("MaxRecv = %d, want shared 8MB", limits.MaxRecv)
+	}
+	if limits.MaxSend != 32<<20 {
+		t.Errorf("MaxSend = %d, want service-specific 32MB", limits.MaxSend)
+	}
+
+	other := FromEnv("levels-service", Limits{MaxRecv: 1, MaxSend: 2})
+	if other.MaxRecv != 8<<20 || other.MaxSend != 2 {
+		t.Errorf("levels-service limits = %+v, want shared recv and default send", other)
+	}
+}
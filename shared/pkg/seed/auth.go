@@ -0,0 +1,120 @@
+package seed
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+const (
+	// SystemUserCode is the code of the user that owns unsold parcels
+	SystemUserCode = "hm-2000000"
+
+	// passwordHash is bcrypt("password"); users log in through OAuth, so it
+	// only fills the NOT NULL column
+	passwordHash = "$2y$10$92IXUNpkjO0rOQ5byMi.Ye4oKoEa3Ro9llC/.og/at2.uheWG/igi"
+)
+
+var (
+	firstNames = []string{"علی", "زهرا", "محمد", "فاطمه", "حسین", "مریم", "رضا", "سارا", "امیر", "نرگس", "مهدی", "الهام"}
+	lastNames  = []string{"احمدی", "رضایی", "محمدی", "حسینی", "کریمی", "موسوی", "جعفری", "صادقی", "رحیمی", "نوری"}
+	provinces  = []string{"تهران", "اصفهان", "فارس", "خراسان رضوی", "آذربایجان شرقی", "گیلان"}
+)
+
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func seedAuth(ctx context.Context, tx *sql.Tx, st *State, res *Result) error {
+	if err := ensureSystemUser(ctx, tx, st, res); err != nil {
+		return err
+	}
+
+	st.Users = nil
+	for i := 1; i <= st.Options.Users; i++ {
+		first := firstNames[st.Rand.Intn(len(firstNames))]
+		last := lastNames[st.Rand.Intn(len(lastNames))]
+		email := fmt.Sprintf("user%d@%s", i, SeedEmailDomain)
+		code := fmt.Sprintf("hm-%d", 2900000+i)
+
+		result, err := tx.ExecContext(ctx, `
+			INSERT IGNORE INTO users (name, email, phone, phone_verified_at, email_verified_at, ip, password, code, score, last_seen, created_at, updated_at)
+			VALUES (?, ?, ?, NOW(), NOW(), '127.0.0.1', ?, ?, ?, NOW(), NOW(), NOW())`,
+			first+" "+last, email, fmt.Sprintf("0912%07d", st.Rand.Intn(10_000_000)), passwordHash, code, st.Rand.Intn(5000),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert user %s: %w", email, err)
+		}
+		res.add("users", result)
+
+		var u User
+		if err := tx.QueryRowContext(ctx, "SELECT id, code FROM users WHERE email = ?", email).Scan(&u.ID, &u.Code); err != nil {
+			return fmt.Errorf("failed to read user %s: %w", email, err)
+		}
+		st.Users = append(st.Users, u)
+
+		// Every other user has passed KYC, so both verified and unverified
+		// flows can be tried
+		if i%2 == 0 {
+			continue
+		}
+		result, err = tx.ExecContext(ctx, `
+			INSERT INTO kycs (user_id, melli_card, fname, lname, melli_code, birthdate, gender, province, status, created_at, updated_at)
+			SELECT ?, 'uploads/kyc/seed-melli-card.jpg', ?, ?, ?, ?, ?, ?, 1, NOW(), NOW()
+			FROM DUAL WHERE NOT EXISTS (SELECT 1 FROM kycs WHERE user_id = ?)`,
+			u.ID, first, last, melliCode(st.Rand.Intn(1_000_000_000)),
+			fmt.Sprintf("%d-%02d-%02d", 1970+st.Rand.Intn(35), 1+st.Rand.Intn(12), 1+st.Rand.Intn(28)),
+			[]string{"male", "female"}[i%4/2], provinces[st.Rand.Intn(len(provinces))], u.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert KYC of %s: %w", email, err)
+		}
+		res.add("kycs", result)
+	}
+	return nil
+}
+
+// ensureSystemUser finds the user that owns unsold parcels and creates it on
+// an empty database
+func ensureSystemUser(ctx context.Context, tx *sql.Tx, st *State, res *Result) error {
+	err := loadSystemUser(ctx, tx, st)
+	if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO users (name, email, ip, password, code, created_at, updated_at)
+		VALUES ('متارنگ', ?, '127.0.0.1', ?, ?, NOW(), NOW())`,
+		"system@"+SeedEmailDomain, passwordHash, SystemUserCode,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert system user: %w", err)
+	}
+	res.add("users", result)
+	return loadSystemUser(ctx, tx, st)
+}
+
+func loadSystemUser(ctx context.Context, q querier, st *State) error {
+	err := q.QueryRowContext(ctx, "SELECT id, code FROM users WHERE code = ? ORDER BY id LIMIT 1", SystemUserCode).
+		Scan(&st.SystemUser.ID, &st.SystemUser.Code)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to load system user: %w", err)
+	}
+	return err
+}
+
+// melliCode turns a nine digit number into a national code with a valid
+// check digit
+func melliCode(n int) string {
+	digits := fmt.Sprintf("%09d", n)
+	sum := 0
+	for i, d := range digits {
+		sum += int(d-'0') * (10 - i)
+	}
+	check := sum % 11
+	if check >= 2 {
+		check = 11 - check
+	}
+	return digits + strconv.Itoa(check)
+}
@@ -0,0 +1,34 @@
+package seed
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+func seedCommercial(ctx context.Context, tx *sql.Tx, st *State, res *Result) error {
+	if len(st.Users) == 0 {
+		return ErrNoUsers
+	}
+
+	for _, u := range st.Users {
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO wallets (user_id, psc, irr, red, blue, yellow, satisfaction, effect, created_at, updated_at)
+			SELECT ?, ?, ?, ?, ?, ?, ?, 1, NOW(), NOW()
+			FROM DUAL WHERE NOT EXISTS (SELECT 1 FROM wallets WHERE user_id = ?)`,
+			u.ID,
+			float64(st.Rand.Intn(5_000_000))/100,
+			st.Rand.Intn(50_000)*10_000,
+			st.Rand.Intn(2000),
+			st.Rand.Intn(2000),
+			st.Rand.Intn(2000),
+			float64(1+st.Rand.Intn(1000))/10,
+			u.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert wallet of user %d: %w", u.ID, err)
+		}
+		res.add("wallets", result)
+	}
+	return nil
+}
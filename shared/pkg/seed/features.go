@@ -0,0 +1,156 @@
+package seed
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+)
+
+const (
+	// SeedMapFile identifies the seed map; its parcels are only created once
+	SeedMapFile = "seed-grid.geojson"
+
+	// The grid starts at the north west corner of central Tehran with
+	// parcels of about 90 x 110 metres
+	gridOriginX = 51.380
+	gridOriginY = 35.700
+	cellSize    = 0.001
+)
+
+// parcelKind is a land use with the rgb status codes of a parcel for sale
+type parcelKind struct {
+	karbari   string
+	ownedCode string // sold to a user and priced
+	saleCode  string // owned by the system and priced
+}
+
+var parcelKinds = []parcelKind{
+	{karbari: "m", ownedCode: "a", saleCode: "d"},
+	{karbari: "t", ownedCode: "h", saleCode: "k"},
+	{karbari: "a", ownedCode: "o", saleCode: "r"},
+}
+
+func seedFeatures(ctx context.Context, tx *sql.Tx, st *State, res *Result) error {
+	if len(st.Users) == 0 || st.SystemUser.ID == 0 {
+		return ErrNoUsers
+	}
+
+	var existing int
+	if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM maps WHERE fileName = ?", SeedMapFile).Scan(&existing); err != nil {
+		return fmt.Errorf("failed to look up seed map: %w", err)
+	}
+	if existing > 0 {
+		return nil
+	}
+
+	grid := st.Options.Grid
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO maps (name, karbari, publish_date, publisher_name, polygon_count, total_area, first_id, last_id, status, fileName, polygon_color)
+		VALUES ('نقشه نمونه', 'm', CURDATE(), 'seed', ?, 0, '', '', 1, ?, '#ffc107')`,
+		grid*grid, SeedMapFile,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert seed map: %w", err)
+	}
+	res.add("maps", result)
+	mapID, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	var firstID, lastID string
+	var totalArea int64
+	for row := 0; row < grid; row++ {
+		for col := 0; col < grid; col++ {
+			propertyID, area, err := seedParcel(ctx, tx, st, res, mapID, row, col)
+			if err != nil {
+				return err
+			}
+			if firstID == "" {
+				firstID = propertyID
+			}
+			lastID = propertyID
+			totalArea += area
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE maps SET first_id = ?, last_id = ?, total_area = ? WHERE id = ?", firstID, lastID, totalArea, mapID)
+	return err
+}
+
+// seedParcel inserts one grid cell with its properties and geometry. One in
+// five parcels belongs to a seed user; the rest are for sale by the system.
+func seedParcel(ctx context.Context, tx *sql.Tx, st *State, res *Result, mapID int64, row, col int) (string, int64, error) {
+	kind := parcelKinds[0]
+	if n := st.Rand.Intn(20); n >= 15 {
+		kind = parcelKinds[2]
+	} else if n >= 10 {
+		kind = parcelKinds[1]
+	}
+	owner, rgb := st.SystemUser, kind.saleCode
+	if st.Rand.Intn(5) == 0 {
+		owner, rgb = st.Users[st.Rand.Intn(len(st.Users))], kind.ownedCode
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO features (map_id, owner_id, type, created_at, updated_at)
+		VALUES (?, ?, 'Polygon', NOW(), NOW())`, mapID, owner.ID)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to insert parcel %d,%d: %w", row, col, err)
+	}
+	res.add("features", result)
+	featureID, err := result.LastInsertId()
+	if err != nil {
+		return "", 0, err
+	}
+
+	minX := gridOriginX + float64(col)*cellSize
+	maxY := gridOriginY - float64(row)*cellSize
+	maxX, minY := minX+cellSize, maxY-cellSize
+	propertyID := fmt.Sprintf("sd-%d", featureID)
+	area := int64(9500 + st.Rand.Intn(1000))
+	pricePSC := 100 + st.Rand.Intn(4900)
+
+	result, err = tx.ExecContext(ctx, `
+		INSERT INTO feature_properties (id, id_prefix, id_postfix, feature_id, address, density, date, stability, area, region, karbari, center, owner, rgb, price_psc, price_irr, created_at, updated_at)
+		VALUES (?, 'sd', ?, ?, ?, ?, CURDATE(), ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())`,
+		propertyID, featureID, featureID,
+		fmt.Sprintf("تهران، بلوک %d، قطعه %d", row+1, col+1),
+		1+st.Rand.Intn(10), 1000+st.Rand.Intn(99_000), area, 6+st.Rand.Intn(6), kind.karbari,
+		coordinate(minX+cellSize/2)+","+coordinate(minY+cellSize/2),
+		owner.Code, rgb, strconv.Itoa(pricePSC), strconv.Itoa(pricePSC*50_000),
+	)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to insert properties of parcel %d: %w", featureID, err)
+	}
+	res.add("feature_properties", result)
+
+	result, err = tx.ExecContext(ctx, `
+		INSERT INTO geometries (feature_id, type, created_at, updated_at)
+		VALUES (?, 'Polygon', NOW(), NOW())`, featureID)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to insert geometry of parcel %d: %w", featureID, err)
+	}
+	res.add("geometries", result)
+	geometryID, err := result.LastInsertId()
+	if err != nil {
+		return "", 0, err
+	}
+
+	corners := [][2]float64{{minX, maxY}, {maxX, maxY}, {maxX, minY}, {minX, minY}}
+	for _, c := range corners {
+		result, err = tx.ExecContext(ctx, `
+			INSERT INTO coordinates (geometry_id, x, y, created_at, updated_at)
+			VALUES (?, ?, ?, NOW(), NOW())`, geometryID, coordinate(c[0]), coordinate(c[1]))
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to insert coordinates of parcel %d: %w", featureID, err)
+		}
+		res.add("coordinates", result)
+	}
+	return propertyID, area, nil
+}
+
+func coordinate(v float64) string {
+	return strconv.FormatFloat(v, 'f', 6, 64)
+}
@@ -0,0 +1,199 @@
+// Package seed fills a development database with realistic sample data: users
+// with KYC, wallets with balances, a grid of parcels with geometry, and
+// tutorial videos. Seed rows are recognizable (users by the SeedEmailDomain,
+// the rest by their seed map, category and slugs), so seeding again only adds
+// what is missing.
+package seed
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+// SeedEmailDomain marks seed users
+const SeedEmailDomain = "seed.metargb.local"
+
+// ErrUnknownService is returned by Select for a service without a seeder
+var ErrUnknownService = errors.New("unknown service")
+
+// ErrNoUsers is returned when a seeder needs users and none are seeded
+var ErrNoUsers = errors.New("no seed users, seed auth-service first")
+
+// Options controls how much data is created
+type Options struct {
+	Users int
+	// Grid is the number of parcels per side of the seed map
+	Grid int
+	// Rand seeds names, balances and prices, so runs are reproducible
+	Seed int64
+}
+
+// DefaultOptions creates 20 users and a 10x10 parcel grid
+func DefaultOptions() Options {
+	return Options{Users: 20, Grid: 10, Seed: 1}
+}
+
+// User is a seed user as the other seeders see it
+type User struct {
+	ID   uint64
+	Code string
+}
+
+// State is shared by the seeders of one run
+type State struct {
+	Options Options
+	Rand    *rand.Rand
+	// Users are the seed users, filled by the auth-service seeder or LoadUsers
+	Users []User
+	// SystemUser owns the parcels nobody has bought
+	SystemUser User
+}
+
+// NewState creates the state of a run
+func NewState(opts Options) *State {
+	return &State{Options: opts, Rand: rand.New(rand.NewSource(opts.Seed))}
+}
+
+// Result counts the rows a seeder inserted per table
+type Result struct {
+	Service string
+	Rows    map[string]int64
+}
+
+func (r *Result) add(table string, res sql.Result) {
+	if n, err := res.RowsAffected(); err == nil {
+		r.Rows[table] += n
+	}
+}
+
+// String lists the inserted rows per table, in the order they are written
+func (r *Result) String() string {
+	var total int64
+	for _, n := range r.Rows {
+		total += n
+	}
+	if total == 0 {
+		return r.Service + ": nothing to insert"
+	}
+	parts := make([]string, 0, len(r.Rows))
+	for _, table := range r.order() {
+		parts = append(parts, fmt.Sprintf("%s %d", table, r.Rows[table]))
+	}
+	return r.Service + ": " + strings.Join(parts, ", ")
+}
+
+func (r *Result) order() []string {
+	var tables []string
+	for _, s := range Seeders {
+		if s.Service != r.Service {
+			continue
+		}
+		for _, table := range s.Tables {
+			if _, ok := r.Rows[table]; ok {
+				tables = append(tables, table)
+			}
+		}
+	}
+	return tables
+}
+
+// Seeder inserts the sample data of one service
+type Seeder struct {
+	Service string
+	// Tables it writes, in order
+	Tables []string
+	Seed   func(ctx context.Context, tx *sql.Tx, st *State, res *Result) error
+}
+
+// Seeders are ordered so users exist before anything that refers to them
+var Seeders = []Seeder{
+	{Service: "auth-service", Tables: []string{"users", "kycs"}, Seed: seedAuth},
+	{Service: "commercial-service", Tables: []string{"wallets"}, Seed: seedCommercial},
+	{Service: "features-service", Tables: []string{"maps", "features", "feature_properties", "geometries", "coordinates"}, Seed: seedFeatures},
+	{Service: "training-service", Tables: []string{"video_categories", "video_sub_categories", "videos"}, Seed: seedTraining},
+}
+
+// Services lists the services with a seeder, in seeding order
+func Services() []string {
+	names := make([]string, 0, len(Seeders))
+	for _, s := range Seeders {
+		names = append(names, s.Service)
+	}
+	return names
+}
+
+// Select returns the seeders of services in seeding order; nil selects all
+func Select(services []string) ([]Seeder, error) {
+	if len(services) == 0 {
+		return Seeders, nil
+	}
+	wanted := make(map[string]bool, len(services))
+	for _, name := range services {
+		wanted[strings.TrimSpace(name)] = true
+	}
+	var selected []Seeder
+	for _, s := range Seeders {
+		if wanted[s.Service] {
+			selected = append(selected, s)
+			delete(wanted, s.Service)
+		}
+	}
+	for name := range wanted {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownService, name)
+	}
+	return selected, nil
+}
+
+// Run seeds one service in a transaction. With dryRun the transaction is
+// rolled back, so the result only reports what would be inserted.
+func Run(ctx context.Context, db *sql.DB, s Seeder, st *State, dryRun bool) (*Result, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	res := &Result{Service: s.Service, Rows: make(map[string]int64)}
+	if err := s.Seed(ctx, tx, st, res); err != nil {
+		return res, fmt.Errorf("failed to seed %s: %w", s.Service, err)
+	}
+	if dryRun {
+		return res, nil
+	}
+	return res, tx.Commit()
+}
+
+// LoadUsers reads the seed users from the auth database, for runs that do
+// not seed auth-service themselves
+func LoadUsers(ctx context.Context, db *sql.DB, st *State) error {
+	rows, err := db.QueryContext(ctx, "SELECT id, code FROM users WHERE email LIKE ? AND code <> ? ORDER BY id", "%@"+SeedEmailDomain, SystemUserCode)
+	if err != nil {
+		return fmt.Errorf("failed to load seed users: %w", err)
+	}
+	defer rows.Close()
+
+	st.Users = nil
+	for rows.Next() {
+		var u User
+		if err := rows.Scan(&u.ID, &u.Code); err != nil {
+			return err
+		}
+		st.Users = append(st.Users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(st.Users) == 0 {
+		return ErrNoUsers
+	}
+	if err := loadSystemUser(ctx, db, st); errors.Is(err, sql.ErrNoRows) {
+		return ErrNoUsers
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
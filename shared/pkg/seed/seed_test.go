@@ -0,0 +1,70 @@
+package seed
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSelect(t *testing.T) {
+	selected, err := Select([]string{"training-service", "auth-service"})
+	if err != nil {
+		t.Fatalf("Select failed: %v", err)
+	}
+	if len(selected) != 2 || selected[0].Service != "auth-service" || selected[1].Service != "training-service" {
+		t.Errorf("Select returned %v, want auth-service then training-service", Services())
+	}
+	if all, _ := Select(nil); len(all) != len(Seeders) {
+		t.Errorf("Select(nil) returned %d seeders, want %d", len(all), len(Seeders))
+	}
+	if _, err := Select([]string{"orders-service"}); !errors.Is(err, ErrUnknownService) {
+		t.Errorf("expected ErrUnknownService, got %v", err)
+	}
+}
+
+func TestMelliCodeIsValid(t *testing.T) {
+	for _, n := range []int{0, 1, 123456789, 999999999, 4242} {
+		code := melliCode(n)
+		if len(code) != 10 {
+			t.Fatalf("melliCode(%d) = %q, want 10 digits", n, code)
+		}
+		sum := 0
+		for i := 0; i < 9; i++ {
+			sum += int(code[i]-'0') * (10 - i)
+		}
+		check, r := int(code[9]-'0'), sum%11
+		if (r < 2 && check != r) || (r >= 2 && check != 11-r) {
+			t.Errorf("melliCode(%d) = %s has an invalid check digit", n, code)
+		}
+	}
+}
+
+func TestResultString(t *testing.T) {
+	res := &Result{Service: "features-service", Rows: map[string]int64{"coordinates": 400, "maps": 1, "features": 100}}
+	if got, want := res.String(), "features-service: maps 1, features 100, coordinates 400"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	empty := &Result{Service: "auth-service", Rows: map[string]int64{}}
+	if got := empty.String(); got != "auth-service: nothing to insert" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestTutorialSlugsAreUnique(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, category := range tutorials {
+		for _, slug := range append([]string{category.slug}, subSlugs(category)...) {
+			if seen[slug] {
+				t.Errorf("slug %s is used twice", slug)
+			}
+			seen[slug] = true
+		}
+	}
+}
+
+func subSlugs(c tutorialCategory) []string {
+	var slugs []string
+	for _, sub := range c.subs {
+		slugs = append(slugs, sub.slug)
+	}
+	return slugs
+}
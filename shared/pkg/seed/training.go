@@ -0,0 +1,96 @@
+package seed
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+type tutorialCategory struct {
+	name, slug string
+	subs       []tutorialSubCategory
+}
+
+type tutorialSubCategory struct {
+	name, slug string
+	videos     []string // titles
+}
+
+// tutorials mirror the sections of the production tutorial catalogue. Every
+// slug starts with "seed-" so real content is never matched.
+var tutorials = []tutorialCategory{
+	{name: "آشنایی با متارنگ", slug: "seed-getting-started", subs: []tutorialSubCategory{
+		{name: "شروع کار", slug: "seed-first-steps", videos: []string{"ثبت نام و ورود", "تکمیل احراز هویت", "شارژ کیف پول"}},
+		{name: "نقشه", slug: "seed-map", videos: []string{"جستجو در نقشه", "رنگ زمین‌ها"}},
+	}},
+	{name: "خرید و فروش زمین", slug: "seed-trading", subs: []tutorialSubCategory{
+		{name: "خرید", slug: "seed-buying", videos: []string{"خرید زمین از متارنگ", "ارسال پیشنهاد خرید"}},
+		{name: "فروش", slug: "seed-selling", videos: []string{"قیمت گذاری زمین", "پذیرش پیشنهاد"}},
+	}},
+	{name: "ساخت و ساز", slug: "seed-building", subs: []tutorialSubCategory{
+		{name: "ساخت بنا", slug: "seed-constructing", videos: []string{"انتخاب مدل بنا", "سود ساعتی"}},
+	}},
+}
+
+func seedTraining(ctx context.Context, tx *sql.Tx, st *State, res *Result) error {
+	if len(st.Users) == 0 {
+		return ErrNoUsers
+	}
+
+	for _, category := range tutorials {
+		categoryID, err := insertCategory(ctx, tx, res, "video_categories", "", 0, category.name, category.slug)
+		if err != nil {
+			return err
+		}
+		for _, sub := range category.subs {
+			subID, err := insertCategory(ctx, tx, res, "video_sub_categories", "video_category_id, ", categoryID, sub.name, sub.slug)
+			if err != nil {
+				return err
+			}
+			for i, title := range sub.videos {
+				slug := fmt.Sprintf("%s-%d", sub.slug, i+1)
+				creator := st.Users[st.Rand.Intn(len(st.Users))]
+				result, err := tx.ExecContext(ctx, `
+					INSERT IGNORE INTO videos (video_sub_category_id, title, slug, description, fileName, creator_code, image, created_at, updated_at)
+					VALUES (?, ?, ?, ?, ?, ?, ?, NOW(), NOW())`,
+					subID, title, slug, "ویدیوی آموزشی "+title, "videos/"+slug+".mp4", creator.Code, "videos/"+slug+".jpg",
+				)
+				if err != nil {
+					return fmt.Errorf("failed to insert video %s: %w", slug, err)
+				}
+				res.add("videos", result)
+			}
+		}
+	}
+	return nil
+}
+
+// insertCategory adds a category or sub category unless its slug exists and
+// returns its id. parentColumn is empty for top level categories.
+func insertCategory(ctx context.Context, tx *sql.Tx, res *Result, table, parentColumn string, parentID int64, name, slug string) (int64, error) {
+	var id int64
+	err := tx.QueryRowContext(ctx, "SELECT id FROM "+table+" WHERE slug = ? LIMIT 1", slug).Scan(&id)
+	if err == nil {
+		return id, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("failed to look up %s %s: %w", table, slug, err)
+	}
+
+	args := []any{name, slug, "آموزش‌های " + name, "videos/categories/" + slug + ".png"}
+	placeholders := "?, ?, ?, ?"
+	if parentColumn != "" {
+		args = append([]any{parentID}, args...)
+		placeholders = "?, " + placeholders
+	}
+	result, err := tx.ExecContext(ctx,
+		"INSERT INTO "+table+" ("+parentColumn+"name, slug, description, image, created_at, updated_at) VALUES ("+placeholders+", NOW(), NOW())",
+		args...,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert %s %s: %w", table, slug, err)
+	}
+	res.add(table, result)
+	return result.LastInsertId()
+}
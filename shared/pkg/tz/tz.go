@@ -0,0 +1,68 @@
+// Package tz resolves the timezones users choose in their settings.
+//
+// Schedules such as reminders, digests and quiet hours are computed in the
+// user's timezone instead of the server's. Users who never chose one get
+// Default. The tz database is embedded, so zones load on images without
+// /usr/share/zoneinfo.
+package tz
+
+import (
+	"sync"
+	"time"
+	_ "time/tzdata"
+)
+
+// Default is the timezone of users who never chose one
+const Default = "Asia/Tehran"
+
+var locations sync.Map // name -> *time.Location
+
+// Valid reports whether name is an IANA timezone such as "Europe/Berlin".
+// The empty name, which time.LoadLocation reads as UTC, is not valid.
+func Valid(name string) bool {
+	if name == "" || name == "Local" {
+		return false
+	}
+	_, err := load(name)
+	return err == nil
+}
+
+// Load returns the location of name, falling back to Default when name is
+// empty or unknown
+func Load(name string) *time.Location {
+	if name != "" && name != "Local" {
+		if loc, err := load(name); err == nil {
+			return loc
+		}
+	}
+	if loc, err := load(Default); err == nil {
+		return loc
+	}
+	return time.UTC
+}
+
+func load(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// InHours reports whether t falls between the hours start (inclusive) and end
+// (exclusive) in loc. Windows where start is after end span midnight, e.g. 22
+// to 7. Equal hours make an empty window.
+func InHours(t time.Time, loc *time.Location, start, end int) bool {
+	if start == end {
+		return false
+	}
+	hour := t.In(loc).Hour()
+	if start < end {
+		return hour >= start && hour < end
+	}
+	return hour >= start || hour < end
+}
@@ -0,0 +1,58 @@
+package tz
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValid(t *testing.T) {
+	tests := map[string]bool{
+		"Europe/Berlin": true,
+		"Asia/Tehran":   true,
+		"UTC":           true,
+		"":              false,
+		"Local":         false,
+		"Mars/Olympus":  false,
+	}
+	for name, want := range tests {
+		if got := Valid(name); got != want {
+			t.Errorf("Valid(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestLoadFallsBackToDefault(t *testing.T) {
+	if got := Load("Europe/Berlin").String(); got != "Europe/Berlin" {
+		t.Errorf("Load(Europe/Berlin) = %s", got)
+	}
+	for _, name := range []string{"", "Local", "Mars/Olympus"} {
+		if got := Load(name).String(); got != Default {
+			t.Errorf("Load(%q) = %s, want %s", name, got, Default)
+		}
+	}
+}
+
+func TestInHours(t *testing.T) {
+	berlin := Load("Europe/Berlin")
+	tests := []struct {
+		name       string
+		start, end int
+		hour       int // UTC hour on a summer day, Berlin is UTC+2
+		want       bool
+	}{
+		{"empty window", 5, 5, 3, false},
+		{"inside same-day window", 13, 15, 12, true},
+		{"end is exclusive", 13, 15, 13, false},
+		{"before midnight", 22, 7, 21, true},
+		{"after midnight", 22, 7, 4, true},
+		{"outside overnight window", 22, 7, 10, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Date(2024, 7, 1, tt.hour, 30, 0, 0, time.UTC)
+			if got := InHours(now, berlin, tt.start, tt.end); got != tt.want {
+				t.Errorf("InHours(%02d:30 UTC) = %v, want %v", tt.hour, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+// Package usercache provides a client-side cache of user snapshots fetched
+// from auth-service, so other services can read user names, codes and ages
+// without querying the users schema directly.
+package usercache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "metargb/shared/pb/auth"
+	"metargb/shared/pkg/tz"
+)
+
+// DefaultTTL is how long a snapshot is served from memory before it is refetched
+const DefaultTTL = 5 * time.Minute
+
+var (
+	// ErrUserNotFound is returned when auth-service has no user with the given ID or code
+	ErrUserNotFound = errors.New("user not found")
+	// ErrUnavailable is returned by a nil Cache, i.e. when auth-service was not configured
+	ErrUnavailable = errors.New("user cache unavailable")
+)
+
+// Snapshot is the subset of user data other services need
+type Snapshot struct {
+	ID                 uint64
+	Code               string
+	Name               string
+	Birthdate          *time.Time
+	WithdrawProfitDays int
+	Timezone           string // Empty until the user chooses one
+}
+
+// IsUnder18 reports whether the user's KYC birthdate makes them a minor at now.
+// Users without a birthdate are treated as adults.
+func (s *Snapshot) IsUnder18(now time.Time) bool {
+	if s.Birthdate == nil {
+		return false
+	}
+	return s.Birthdate.AddDate(18, 0, 0).After(now)
+}
+
+type entry struct {
+	snapshot  *Snapshot
+	expiresAt time.Time
+}
+
+// Cache fetches user snapshots through auth-service's UserService.GetUserInfo
+// and keeps them for a fixed TTL. It is safe for concurrent use, and a nil
+// *Cache returns ErrUnavailable so callers can run without auth-service.
+type Cache struct {
+	client pb.UserServiceClient
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu     sync.RWMutex
+	byID   map[uint64]entry
+	byCode map[string]uint64
+}
+
+// New creates a cache around an existing UserService client
+func New(client pb.UserServiceClient, ttl time.Duration) *Cache {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Cache{
+		client: client,
+		ttl:    ttl,
+		now:    time.Now,
+		byID:   make(map[uint64]entry),
+		byCode: make(map[string]uint64),
+	}
+}
+
+// NewFromConn creates a cache using a connection to auth-service
+func NewFromConn(conn *grpc.ClientConn, ttl time.Duration) *Cache {
+	return New(pb.NewUserServiceClient(conn), ttl)
+}
+
+// Get returns the snapshot for a user ID
+func (c *Cache) Get(ctx context.Context, userID uint64) (*Snapshot, error) {
+	if c == nil {
+		return nil, ErrUnavailable
+	}
+	if snapshot, ok := c.lookup(userID); ok {
+		return snapshot, nil
+	}
+	return c.fetch(ctx, &pb.GetUserInfoRequest{UserId: userID})
+}
+
+// GetByCode returns the snapshot for a user code such as "hm-2000000"
+func (c *Cache) GetByCode(ctx context.Context, code string) (*Snapshot, error) {
+	if c == nil {
+		return nil, ErrUnavailable
+	}
+	c.mu.RLock()
+	userID, ok := c.byCode[code]
+	c.mu.RUnlock()
+	if ok {
+		if snapshot, ok := c.lookup(userID); ok {
+			return snapshot, nil
+		}
+	}
+	return c.fetch(ctx, &pb.GetUserInfoRequest{Code: code})
+}
+
+// Location returns the timezone the user chose in their settings. Users who
+// never chose one, and lookups that fail, get tz.Default, so schedules keep
+// running while auth-service is unavailable.
+func (c *Cache) Location(ctx context.Context, userID uint64) *time.Location {
+	snapshot, err := c.Get(ctx, userID)
+	if err != nil {
+		return tz.Load("")
+	}
+	return tz.Load(snapshot.Timezone)
+}
+
+// Invalidate drops a user's snapshot, e.g. after their profile or KYC changed
+func (c *Cache) Invalidate(userID uint64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.byID[userID]; ok {
+		delete(c.byCode, e.snapshot.Code)
+	}
+	delete(c.byID, userID)
+}
+
+func (c *Cache) lookup(userID uint64) (*Snapshot, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	e, ok := c.byID[userID]
+	if !ok || !c.now().Before(e.expiresAt) {
+		return nil, false
+	}
+	return e.snapshot, true
+}
+
+func (c *Cache) fetch(ctx context.Context, req *pb.GetUserInfoRequest) (*Snapshot, error) {
+	info, err := c.client.GetUserInfo(ctx, req)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user info: %w", err)
+	}
+
+	snapshot := &Snapshot{
+		ID:                 info.Id,
+		Code:               info.Code,
+		Name:               info.Name,
+		WithdrawProfitDays: int(info.WithdrawProfitDays),
+		Timezone:           info.Timezone,
+	}
+	if info.Birthdate != "" {
+		if birthdate, err := time.Parse("2006-01-02", info.Birthdate); err == nil {
+			snapshot.Birthdate = &birthdate
+		}
+	}
+
+	c.mu.Lock()
+	c.byID[snapshot.ID] = entry{snapshot: snapshot, expiresAt: c.now().Add(c.ttl)}
+	c.byCode[snapshot.Code] = snapshot.ID
+	c.mu.Unlock()
+
+	return snapshot, nil
+}
@@ -0,0 +1,137 @@
+package usercache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "metargb/shared/pb/auth"
+	"metargb/shared/pkg/tz"
+)
+
+type fakeUserClient struct {
+	pb.UserServiceClient
+	users map[uint64]*pb.UserInfo
+	calls int
+}
+
+func (f *fakeUserClient) GetUserInfo(ctx context.Context, req *pb.GetUserInfoRequest, opts ...grpc.CallOption) (*pb.UserInfo, error) {
+	f.calls++
+	for _, user := range f.users {
+		if user.Id == req.UserId || (req.UserId == 0 && user.Code == req.Code) {
+			return user, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "user not found")
+}
+
+func TestCacheServesFromMemoryUntilExpiry(t *testing.T) {
+	client := &fakeUserClient{users: map[uint64]*pb.UserInfo{
+		7: {Id: 7, Code: "hm-7", Name: "Sara", Birthdate: "2000-01-02", WithdrawProfitDays: 10},
+	}}
+	cache := New(client, time.Minute)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+
+	snapshot, err := cache.Get(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if snapshot.Name != "Sara" || snapshot.WithdrawProfitDays != 10 || snapshot.Birthdate == nil {
+		t.Fatalf("unexpected snapshot: %+v", snapshot)
+	}
+
+	if _, err := cache.GetByCode(context.Background(), "hm-7"); err != nil {
+		t.Fatalf("GetByCode failed: %v", err)
+	}
+	if client.calls != 1 {
+		t.Errorf("expected 1 call while cached, got %d", client.calls)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, err := cache.Get(context.Background(), 7); err != nil {
+		t.Fatalf("Get after expiry failed: %v", err)
+	}
+	if client.calls != 2 {
+		t.Errorf("expected refetch after expiry, got %d calls", client.calls)
+	}
+
+	cache.Invalidate(7)
+	if _, err := cache.GetByCode(context.Background(), "hm-7"); err != nil {
+		t.Fatalf("GetByCode after invalidate failed: %v", err)
+	}
+	if client.calls != 3 {
+		t.Errorf("expected refetch after invalidate, got %d calls", client.calls)
+	}
+}
+
+func TestCacheNotFound(t *testing.T) {
+	cache := New(&fakeUserClient{}, time.Minute)
+
+	if _, err := cache.Get(context.Background(), 1); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestNilCacheIsUnavailable(t *testing.T) {
+	var cache *Cache
+
+	if _, err := cache.Get(context.Background(), 1); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("expected ErrUnavailable, got %v", err)
+	}
+	cache.Invalidate(1)
+}
+
+func TestCacheLocation(t *testing.T) {
+	client := &fakeUserClient{users: map[uint64]*pb.UserInfo{
+		1: {Id: 1, Code: "hm-1", Timezone: "Europe/Berlin"},
+		2: {Id: 2, Code: "hm-2"},
+	}}
+	cache := New(client, time.Minute)
+	ctx := context.Background()
+
+	if got := cache.Location(ctx, 1).String(); got != "Europe/Berlin" {
+		t.Errorf("expected the chosen timezone, got %s", got)
+	}
+	if got := cache.Location(ctx, 2).String(); got != tz.Default {
+		t.Errorf("expected %s for a user without a timezone, got %s", tz.Default, got)
+	}
+	if got := cache.Location(ctx, 3).String(); got != tz.Default {
+		t.Errorf("expected %s for an unknown user, got %s", tz.Default, got)
+	}
+
+	var nilCache *Cache
+	if got := nilCache.Location(ctx, 1).String(); got != tz.Default {
+		t.Errorf("expected %s without auth-service, got %s", tz.Default, got)
+	}
+}
+
+func TestSnapshotIsUnder18(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	birthdate := func(y, m, d int) *time.Time {
+		b := time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC)
+		return &b
+	}
+
+	tests := []struct {
+		birthdate *time.Time
+		want      bool
+	}{
+		{birthdate: nil, want: false},
+		{birthdate: birthdate(2007, 6, 1), want: false},
+		{birthdate: birthdate(2007, 6, 2), want: true},
+		{birthdate: birthdate(1990, 1, 1), want: false},
+	}
+
+	for _, tt := range tests {
+		s := &Snapshot{Birthdate: tt.birthdate}
+		if got := s.IsUnder18(now); got != tt.want {
+			t.Errorf("IsUnder18(%v) = %v, want %v", tt.birthdate, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package variables
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultTTL is how long a value is served from memory when no change event arrives
+const DefaultTTL = 5 * time.Minute
+
+// Loader loads the values of keys. Keys without a value are left out of the result.
+type Loader func(ctx context.Context, keys []string) (map[string]float64, error)
+
+type entry struct {
+	value     float64
+	found     bool
+	expiresAt time.Time
+}
+
+// Cache keeps variable values loaded through a Loader for a fixed TTL.
+// Missing keys are cached too, so an unset variable is not reloaded on every
+// read. It is safe for concurrent use.
+type Cache struct {
+	load Loader
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu      sync.RWMutex
+	entries map[string]entry
+}
+
+// NewCache creates a cache around load
+func NewCache(load Loader, ttl time.Duration) *Cache {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Cache{
+		load:    load,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]entry),
+	}
+}
+
+// Get returns the values of keys, loading the missing and expired ones in a
+// single call. Keys without a value are left out of the result.
+func (c *Cache) Get(ctx context.Context, keys ...string) (map[string]float64, error) {
+	values := make(map[string]float64, len(keys))
+	var missing []string
+
+	now := c.now()
+	c.mu.RLock()
+	for _, key := range keys {
+		e, ok := c.entries[key]
+		if !ok || !now.Before(e.expiresAt) {
+			missing = append(missing, key)
+			continue
+		}
+		if e.found {
+			values[key] = e.value
+		}
+	}
+	c.mu.RUnlock()
+
+	if len(missing) == 0 {
+		return values, nil
+	}
+
+	loaded, err := c.load(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := c.now().Add(c.ttl)
+	c.mu.Lock()
+	for _, key := range missing {
+		value, found := loaded[key]
+		c.entries[key] = entry{value: value, found: found, expiresAt: expiresAt}
+		if found {
+			values[key] = value
+		}
+	}
+	c.mu.Unlock()
+
+	return values, nil
+}
+
+// Invalidate drops a key so the next Get loads it again
+func (c *Cache) Invalidate(key string) {
+	c.mu.Lock()
+	delete(c.entries, key)
+	c.mu.Unlock()
+}
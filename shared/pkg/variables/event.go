@@ -0,0 +1,50 @@
+// Package variables defines the event commercial-service publishes when an
+// admin changes a variable (an exchange rate, a pricing limit, a referral
+// tier), and the cache services keep of variable values. Events are
+// published on the Redis channel Channel as JSON, e.g.
+//
+//	PUBLISH variable-changed '{"key":"psc","value":"120000","changed_by":4}'
+//
+// Subscribers drop the key from their Cache so the next read loads the new
+// value. Pub/sub is not durable, so caches also expire entries after a TTL.
+package variables
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Channel is the Redis channel variable change events are published on
+const Channel = "variable-changed"
+
+var ErrInvalidEvent = errors.New("invalid variable event")
+
+// ChangedEvent announces a variable's new value
+type ChangedEvent struct {
+	Key       string    `json:"key"`
+	Value     string    `json:"value"`
+	ChangedBy uint64    `json:"changed_by"`
+	ChangedAt time.Time `json:"changed_at,omitempty"`
+}
+
+// Marshal validates and encodes an event for the channel
+func (e ChangedEvent) Marshal() ([]byte, error) {
+	if e.Key == "" {
+		return nil, fmt.Errorf("%w: key is required", ErrInvalidEvent)
+	}
+	return json.Marshal(e)
+}
+
+// Unmarshal decodes and validates an event read from the channel
+func Unmarshal(payload []byte) (ChangedEvent, error) {
+	var e ChangedEvent
+	if err := json.Unmarshal(payload, &e); err != nil {
+		return ChangedEvent{}, fmt.Errorf("%w: %v", ErrInvalidEvent, err)
+	}
+	if e.Key == "" {
+		return ChangedEvent{}, fmt.Errorf("%w: key is required", ErrInvalidEvent)
+	}
+	return e, nil
+}
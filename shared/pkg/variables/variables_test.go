@@ -0,0 +1,84 @@
+package variables
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestChangedEventRoundTrip(t *testing.T) {
+	payload, err := ChangedEvent{Key: "psc", Value: "120000", ChangedBy: 4}.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+
+	event, err := Unmarshal(payload)
+	if err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if event.Key != "psc" || event.Value != "120000" || event.ChangedBy != 4 {
+		t.Errorf("unexpected event %+v", event)
+	}
+
+	for _, payload := range []string{`not json`, `{"value":"1"}`} {
+		if _, err := Unmarshal([]byte(payload)); !errors.Is(err, ErrInvalidEvent) {
+			t.Errorf("Unmarshal(%s) error = %v, want ErrInvalidEvent", payload, err)
+		}
+	}
+}
+
+func TestCacheLoadsOnceUntilInvalidated(t *testing.T) {
+	source := map[string]float64{"psc": 100}
+	var loads [][]string
+	cache := NewCache(func(_ context.Context, keys []string) (map[string]float64, error) {
+		loads = append(loads, keys)
+		values := map[string]float64{}
+		for _, key := range keys {
+			if value, ok := source[key]; ok {
+				values[key] = value
+			}
+		}
+		return values, nil
+	}, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		values, err := cache.Get(context.Background(), "psc", "red")
+		if err != nil {
+			t.Fatalf("Get returned error: %v", err)
+		}
+		if values["psc"] != 100 || len(values) != 1 {
+			t.Errorf("Get = %v, want only psc 100", values)
+		}
+	}
+	if len(loads) != 1 {
+		t.Fatalf("loaded %d times, want 1 (missing keys are cached too)", len(loads))
+	}
+
+	source["psc"] = 120
+	cache.Invalidate("psc")
+	values, _ := cache.Get(context.Background(), "psc", "red")
+	if values["psc"] != 120 {
+		t.Errorf("after Invalidate psc = %v, want 120", values["psc"])
+	}
+	if len(loads) != 2 || len(loads[1]) != 1 || loads[1][0] != "psc" {
+		t.Errorf("reload requested %v, want only psc", loads[len(loads)-1])
+	}
+}
+
+func TestCacheExpires(t *testing.T) {
+	loads := 0
+	cache := NewCache(func(context.Context, []string) (map[string]float64, error) {
+		loads++
+		return map[string]float64{"psc": 1}, nil
+	}, time.Minute)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	cache.Get(context.Background(), "psc")
+	now = now.Add(2 * time.Minute)
+	cache.Get(context.Background(), "psc")
+	if loads != 2 {
+		t.Errorf("loaded %d times, want 2 after the TTL passed", loads)
+	}
+}
@@ -286,8 +286,8 @@ func TestAuthHandler_Logout(t *testing.T) {
 		}
 
 		tokenRepo := &mockTokenRepository{}
-		tokenRepo.validateTokenFunc = func(ctx context.Context, token string) (*models.User, error) {
-			return &models.User{ID: 1}, nil
+		tokenRepo.validateTokenFunc = func(ctx context.Context, token string) (*models.User, *models.ValidatedToken, error) {
+			return &models.User{ID: 1}, &models.ValidatedToken{ID: 1, ExpiresAt: time.Now().Add(time.Hour)}, nil
 		}
 
 		handler := &authHandler{
@@ -308,8 +308,8 @@ func TestAuthHandler_Logout(t *testing.T) {
 	t.Run("logout with invalid token", func(t *testing.T) {
 		mockAuthService := &mockAuthService{}
 		tokenRepo := &mockTokenRepository{}
-		tokenRepo.validateTokenFunc = func(ctx context.Context, token string) (*models.User, error) {
-			return nil, errors.New("invalid token")
+		tokenRepo.validateTokenFunc = func(ctx context.Context, token string) (*models.User, *models.ValidatedToken, error) {
+			return nil, nil, errors.New("invalid token")
 		}
 
 		handler := &authHandler{
@@ -341,8 +341,8 @@ func TestAuthHandler_ValidateToken(t *testing.T) {
 
 	t.Run("successful token validation", func(t *testing.T) {
 		mockAuthService := &mockAuthService{}
-		mockAuthService.validateTokenFunc = func(ctx context.Context, token string) (*models.User, error) {
-			return &models.User{ID: 1, Email: "test@example.com"}, nil
+		mockAuthService.validateTokenFunc = func(ctx context.Context, token string) (*models.User, *models.ValidatedToken, error) {
+			return &models.User{ID: 1, Email: "test@example.com"}, &models.ValidatedToken{ID: 1, ExpiresAt: time.Now().Add(time.Hour)}, nil
 		}
 
 		tokenRepo := &mockTokenRepository{}
@@ -370,8 +370,8 @@ func TestAuthHandler_ValidateToken(t *testing.T) {
 
 	t.Run("invalid token", func(t *testing.T) {
 		mockAuthService := &mockAuthService{}
-		mockAuthService.validateTokenFunc = func(ctx context.Context, token string) (*models.User, error) {
-			return nil, errors.New("invalid token")
+		mockAuthService.validateTokenFunc = func(ctx context.Context, token string) (*models.User, *models.ValidatedToken, error) {
+			return nil, nil, errors.New("invalid token")
 		}
 
 		tokenRepo := &mockTokenRepository{}
@@ -818,7 +818,7 @@ type mockAuthService struct {
 	callbackFunc               func(context.Context, string, string) (*service.CallbackResult, error)
 	getMeFunc                  func(context.Context, string) (*service.UserDetails, error)
 	logoutFunc                 func(context.Context, uint64, string, string) error
-	validateTokenFunc          func(context.Context, string) (*models.User, error)
+	validateTokenFunc          func(context.Context, string) (*models.User, *models.ValidatedToken, error)
 	requestAccountSecurityFunc func(context.Context, uint64, int32, string) error
 	verifyAccountSecurityFunc  func(context.Context, uint64, string, string, string) error
 }
@@ -837,7 +837,7 @@ func (m *mockAuthService) Redirect(ctx context.Context, redirectTo, backURL stri
 	return "", "", nil
 }
 
-func (m *mockAuthService) Callback(ctx context.Context, state, code string) (*service.CallbackResult, error) {
+func (m *mockAuthService) Callback(ctx context.Context, state, code, ip, userAgent string) (*service.CallbackResult, error) {
 	if m.callbackFunc != nil {
 		return m.callbackFunc(ctx, state, code)
 	}
@@ -858,11 +858,11 @@ func (m *mockAuthService) Logout(ctx context.Context, userID uint64, ip, userAge
 	return nil
 }
 
-func (m *mockAuthService) ValidateToken(ctx context.Context, token string) (*models.User, error) {
+func (m *mockAuthService) ValidateToken(ctx context.Context, token string) (*models.User, *models.ValidatedToken, error) {
 	if m.validateTokenFunc != nil {
 		return m.validateTokenFunc(ctx, token)
 	}
-	return nil, nil
+	return nil, nil, nil
 }
 
 func (m *mockAuthService) RequestAccountSecurity(ctx context.Context, userID uint64, minutes int32, phone string) error {
@@ -882,18 +882,18 @@ func (m *mockAuthService) VerifyAccountSecurity(ctx context.Context, userID uint
 var _ service.AuthService = (*mockAuthService)(nil)
 
 type mockTokenRepository struct {
-	validateTokenFunc func(context.Context, string) (*models.User, error)
+	validateTokenFunc func(context.Context, string) (*models.User, *models.ValidatedToken, error)
 }
 
 func (m *mockTokenRepository) Create(ctx context.Context, userID uint64, name string, expiresAt time.Time) (string, error) {
 	return "", nil
 }
 
-func (m *mockTokenRepository) ValidateToken(ctx context.Context, token string) (*models.User, error) {
+func (m *mockTokenRepository) ValidateToken(ctx context.Context, token string) (*models.User, *models.ValidatedToken, error) {
 	if m.validateTokenFunc != nil {
 		return m.validateTokenFunc(ctx, token)
 	}
-	return nil, nil
+	return nil, nil, nil
 }
 
 func (m *mockTokenRepository) DeleteUserTokens(ctx context.Context, userID uint64) error {
@@ -904,4 +904,8 @@ func (m *mockTokenRepository) FindTokenByHash(ctx context.Context, tokenHash str
 	return nil, nil
 }
 
+func (m *mockTokenRepository) DeleteIdleTokens(ctx context.Context, now time.Time) (int64, error) {
+	return 0, nil
+}
+
 var _ repository.TokenRepository = (*mockTokenRepository)(nil)
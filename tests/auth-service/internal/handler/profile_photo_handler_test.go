@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"strings"
 	"testing"
@@ -50,6 +51,30 @@ func (m *mockProfilePhotoService) DeleteProfilePhoto(ctx context.Context, userID
 	return nil
 }
 
+func (m *mockProfilePhotoService) CreateProfilePhotoRecord(ctx context.Context, userID uint64, url string) (*models.Image, error) {
+	return &models.Image{ImageableID: userID, URL: url}, nil
+}
+
+// mockPhotoUploadService is a mock implementation for testing
+type mockPhotoUploadService struct {
+	queueFunc  func(ctx context.Context, userID uint64, imageData []byte, filename, contentType string) (*models.ProfilePhotoUpload, error)
+	statusFunc func(ctx context.Context, userID, uploadID uint64) (*models.ProfilePhotoUpload, error)
+}
+
+func (m *mockPhotoUploadService) QueueUpload(ctx context.Context, userID uint64, imageData []byte, filename, contentType string) (*models.ProfilePhotoUpload, error) {
+	if m.queueFunc != nil {
+		return m.queueFunc(ctx, userID, imageData, filename, contentType)
+	}
+	return nil, nil
+}
+
+func (m *mockPhotoUploadService) GetUploadStatus(ctx context.Context, userID, uploadID uint64) (*models.ProfilePhotoUpload, error) {
+	if m.statusFunc != nil {
+		return m.statusFunc(ctx, userID, uploadID)
+	}
+	return nil, nil
+}
+
 func TestProfilePhotoHandler_ListProfilePhotos(t *testing.T) {
 	ctx := context.Background()
 
@@ -251,21 +276,16 @@ func TestProfilePhotoHandler_ListProfilePhotos(t *testing.T) {
 func TestProfilePhotoHandler_UploadProfilePhoto(t *testing.T) {
 	ctx := context.Background()
 
-	t.Run("successful upload - database record stored by auth-service, file uploaded by storage-service", func(t *testing.T) {
-		mockService := &mockProfilePhotoService{}
-		// Simulate: storage-service uploads file and returns relative URL
-		// auth-service stores database record with that URL
-		mockService.uploadPhotoFunc = func(ctx context.Context, userID uint64, imageData []byte, filename, contentType string) (*models.Image, error) {
-			return &models.Image{
-				ID:  1,
-				URL: "/uploads/profile/test.jpg", // Relative URL from storage-service
-			}, nil
+	t.Run("successful upload - queued for processing", func(t *testing.T) {
+		uploadService := &mockPhotoUploadService{}
+		uploadService.queueFunc = func(ctx context.Context, userID uint64, imageData []byte, filename, contentType string) (*models.ProfilePhotoUpload, error) {
+			return &models.ProfilePhotoUpload{ID: 41, UserID: userID, Status: models.PhotoUploadProcessing}, nil
 		}
 
-		apiGatewayURL := "https://api.example.com"
 		handler := &ProfilePhotoHandler{
-			ProfilePhotoService: mockService,
-			ApiGatewayURL:       apiGatewayURL,
+			ProfilePhotoService: &mockProfilePhotoService{},
+			UploadService:       uploadService,
+			ApiGatewayURL:       "https://api.example.com",
 		}
 
 		req := &pb.UploadProfilePhotoRequest{
@@ -280,23 +300,12 @@ func TestProfilePhotoHandler_UploadProfilePhoto(t *testing.T) {
 			t.Fatalf("UploadProfilePhoto failed: %v", err)
 		}
 
-		// Verify response contains id
-		if resp.Id != 1 {
-			t.Errorf("Expected ID 1, got %d", resp.Id)
+		// The upload returns before storage-service is involved
+		if resp.UploadId != 41 || resp.Status != models.PhotoUploadProcessing {
+			t.Errorf("Expected upload 41 processing, got %d %s", resp.UploadId, resp.Status)
 		}
-
-		// Verify response contains full image URL (prepended with gateway URL)
-		if resp.Url == "" {
-			t.Error("Expected URL (full image URL) to be set")
-		}
-		expectedURL := "https://api.example.com/uploads/profile/test.jpg"
-		if resp.Url != expectedURL {
-			t.Errorf("Expected full URL %s, got %s", expectedURL, resp.Url)
-		}
-
-		// Verify URL is a full URL
-		if !strings.HasPrefix(resp.Url, "http://") && !strings.HasPrefix(resp.Url, "https://") {
-			t.Errorf("Expected full URL (starts with http:// or https://), got %s", resp.Url)
+		if resp.ProfilePhotoId != 0 || resp.Url != "" {
+			t.Errorf("Expected no photo before processing, got %d %s", resp.ProfilePhotoId, resp.Url)
 		}
 	})
 
@@ -343,12 +352,12 @@ func TestProfilePhotoHandler_UploadProfilePhoto(t *testing.T) {
 	})
 
 	t.Run("invalid image error", func(t *testing.T) {
-		mockService := &mockProfilePhotoService{}
-		mockService.uploadPhotoFunc = func(ctx context.Context, userID uint64, imageData []byte, filename, contentType string) (*models.Image, error) {
+		uploadService := &mockPhotoUploadService{}
+		uploadService.queueFunc = func(ctx context.Context, userID uint64, imageData []byte, filename, contentType string) (*models.ProfilePhotoUpload, error) {
 			return nil, service.ErrInvalidImage
 		}
 
-		handler := &ProfilePhotoHandler{ProfilePhotoService: mockService}
+		handler := &ProfilePhotoHandler{ProfilePhotoService: &mockProfilePhotoService{}, UploadService: uploadService}
 
 		req := &pb.UploadProfilePhotoRequest{
 			UserId:      1,
@@ -369,6 +378,42 @@ func TestProfilePhotoHandler_UploadProfilePhoto(t *testing.T) {
 	})
 }
 
+func TestProfilePhotoHandler_GetPhotoStatus(t *testing.T) {
+	ctx := context.Background()
+
+	uploadService := &mockPhotoUploadService{}
+	uploadService.statusFunc = func(ctx context.Context, userID, uploadID uint64) (*models.ProfilePhotoUpload, error) {
+		if userID != 1 {
+			return nil, service.ErrPhotoUploadNotFound
+		}
+		return &models.ProfilePhotoUpload{
+			ID:      uploadID,
+			Status:  models.PhotoUploadReady,
+			ImageID: sql.NullInt64{Int64: 310, Valid: true},
+			URL:     sql.NullString{String: "/uploads/profile/test.jpg", Valid: true},
+		}, nil
+	}
+	handler := &ProfilePhotoHandler{UploadService: uploadService, ApiGatewayURL: "https://api.example.com"}
+
+	resp, err := handler.GetPhotoStatus(ctx, &pb.GetPhotoStatusRequest{UserId: 1, UploadId: 41})
+	if err != nil {
+		t.Fatalf("GetPhotoStatus failed: %v", err)
+	}
+	if resp.Status != models.PhotoUploadReady || resp.ProfilePhotoId != 310 || resp.Url != "https://api.example.com/uploads/profile/test.jpg" {
+		t.Errorf("Unexpected status %+v", resp)
+	}
+
+	_, err = handler.GetPhotoStatus(ctx, &pb.GetPhotoStatusRequest{UserId: 2, UploadId: 41})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.NotFound {
+		t.Errorf("Expected NotFound for another user's upload, got %v", err)
+	}
+
+	_, err = handler.GetPhotoStatus(ctx, &pb.GetPhotoStatusRequest{UserId: 1})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for missing upload_id, got %v", err)
+	}
+}
+
 func TestProfilePhotoHandler_GetProfilePhoto(t *testing.T) {
 	ctx := context.Background()
 
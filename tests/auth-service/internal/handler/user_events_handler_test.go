@@ -1,12 +1,16 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"errors"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -23,6 +27,7 @@ type mockUserEventsService struct {
 	reportUserEventFunc    func(ctx context.Context, userID, eventID uint64, suspeciousCitizen *string, eventDescription string) (*models.UserEventReport, error)
 	sendReportResponseFunc func(ctx context.Context, userID, eventID uint64, responserName, response string) (*models.UserEventReportResponse, error)
 	closeEventReportFunc   func(ctx context.Context, userID, eventID uint64) error
+	exportUserEventsFunc   func(ctx context.Context, requesterID, userID uint64, fromDate, toDate string, w io.Writer) error
 }
 
 func (m *mockUserEventsService) ListUserEvents(ctx context.Context, userID uint64, page int32) ([]*models.UserEvent, string, string, error) {
@@ -60,6 +65,13 @@ func (m *mockUserEventsService) CloseEventReport(ctx context.Context, userID, ev
 	return errors.New("not implemented")
 }
 
+func (m *mockUserEventsService) ExportUserEvents(ctx context.Context, requesterID, userID uint64, fromDate, toDate string, w io.Writer) error {
+	if m.exportUserEventsFunc != nil {
+		return m.exportUserEventsFunc(ctx, requesterID, userID, fromDate, toDate, w)
+	}
+	return errors.New("not implemented")
+}
+
 type mockUserRepo struct {
 	findByIDFunc func(ctx context.Context, id uint64) (*models.User, error)
 }
@@ -656,3 +668,84 @@ func TestUserEventsHandler_CloseEventReport(t *testing.T) {
 		}
 	})
 }
+
+// Test ExportUserEvents
+
+type mockExportStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks [][]byte
+}
+
+func (m *mockExportStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockExportStream) Send(chunk *pb.UserEventsExportChunk) error {
+	m.chunks = append(m.chunks, append([]byte(nil), chunk.Data...))
+	return nil
+}
+
+func TestUserEventsHandler_ExportUserEvents(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("streams csv in chunks", func(t *testing.T) {
+		row := strings.Repeat("x", 1000) + "\n"
+		mockService := &mockUserEventsService{}
+		mockService.exportUserEventsFunc = func(ctx context.Context, requesterID, userID uint64, fromDate, toDate string, w io.Writer) error {
+			if requesterID != 1 || userID != 2 || fromDate != "2025-01-01" || toDate != "2025-01-31" {
+				t.Errorf("Unexpected export arguments %d %d %s %s", requesterID, userID, fromDate, toDate)
+			}
+			for i := 0; i < 100; i++ {
+				if _, err := io.WriteString(w, row); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		handler := &userEventsHandler{service: mockService, userRepo: &mockUserRepo{}}
+		stream := &mockExportStream{ctx: ctx}
+		err := handler.ExportUserEvents(&pb.ExportUserEventsRequest{
+			RequesterId: 1,
+			UserId:      2,
+			FromDate:    "2025-01-01",
+			ToDate:      "2025-01-31",
+		}, stream)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(stream.chunks) != 2 {
+			t.Fatalf("Expected 2 chunks, got %d", len(stream.chunks))
+		}
+		if got := string(bytes.Join(stream.chunks, nil)); got != strings.Repeat(row, 100) {
+			t.Errorf("Streamed CSV does not match the written rows (%d bytes)", len(got))
+		}
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		mockService := &mockUserEventsService{}
+		mockService.exportUserEventsFunc = func(ctx context.Context, requesterID, userID uint64, fromDate, toDate string, w io.Writer) error {
+			return service.ErrUserEventExportDenied
+		}
+
+		handler := &userEventsHandler{service: mockService, userRepo: &mockUserRepo{}}
+		stream := &mockExportStream{ctx: ctx}
+		err := handler.ExportUserEvents(&pb.ExportUserEventsRequest{RequesterId: 1, UserId: 2}, stream)
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("Expected PermissionDenied error code, got %v", err)
+		}
+		if len(stream.chunks) != 0 {
+			t.Errorf("Expected no chunks, got %d", len(stream.chunks))
+		}
+	})
+
+	t.Run("requires requester", func(t *testing.T) {
+		handler := &userEventsHandler{service: &mockUserEventsService{}, userRepo: &mockUserRepo{}}
+		err := handler.ExportUserEvents(&pb.ExportUserEventsRequest{}, &mockExportStream{ctx: ctx})
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("Expected Unauthenticated error code, got %v", err)
+		}
+	})
+}
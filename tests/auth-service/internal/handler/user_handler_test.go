@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/repository"
 	"metargb/auth-service/internal/service"
 	pb "metargb/shared/pb/auth"
 )
@@ -51,6 +52,10 @@ func (m *mockUserService) GetUserFeaturesCount(ctx context.Context, userID uint6
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockUserService) GetUserInfo(ctx context.Context, userID uint64, code string) (*repository.UserInfo, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (m *mockUserService) GetUser(ctx context.Context, userID uint64) (*models.User, error) {
 	if m.getUserFunc != nil {
 		return m.getUserFunc(ctx, userID)
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIdleExpired(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		idle            time.Duration
+		automaticLogout int64
+		want            bool
+	}{
+		{name: "active within setting", idle: 9 * time.Minute, automaticLogout: 10, want: false},
+		{name: "idle past setting", idle: 11 * time.Minute, automaticLogout: 10, want: true},
+		{name: "unset uses default", idle: 50 * time.Minute, automaticLogout: 0, want: false},
+		{name: "idle past default", idle: 56 * time.Minute, automaticLogout: 0, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := idleExpired(now.Add(-tt.idle), tt.automaticLogout, now); got != tt.want {
+				t.Errorf("idleExpired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"metargb/auth-service/internal/models"
+)
+
+type fakeAPIKeyRepository struct {
+	keys map[uint64]*models.APIKey
+}
+
+func (f *fakeAPIKeyRepository) Create(_ context.Context, key *models.APIKey) error {
+	key.ID = uint64(len(f.keys) + 1)
+	f.keys[key.ID] = key
+	return nil
+}
+
+func (f *fakeAPIKeyRepository) FindByID(_ context.Context, id uint64) (*models.APIKey, error) {
+	return f.keys[id], nil
+}
+
+func (f *fakeAPIKeyRepository) FindByHash(_ context.Context, keyHash string) (*models.APIKey, error) {
+	for _, key := range f.keys {
+		if key.KeyHash == keyHash {
+			return key, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeAPIKeyRepository) ListByUserID(_ context.Context, userID uint64) ([]*models.APIKey, error) {
+	var keys []*models.APIKey
+	for _, key := range f.keys {
+		if key.UserID == userID {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (f *fakeAPIKeyRepository) UpdateSecret(context.Context, uint64, string, string) error {
+	panic("unexpected call to UpdateSecret")
+}
+
+func (f *fakeAPIKeyRepository) Revoke(context.Context, uint64) error {
+	panic("unexpected call to Revoke")
+}
+
+func (f *fakeAPIKeyRepository) TouchLastUsed(context.Context, uint64) error {
+	return nil
+}
+
+func TestAPIKeyService_ServiceScopesNeedAServiceKeyAdmin(t *testing.T) {
+	ctx := context.Background()
+	users := newFakeUserRepository(map[uint64]*models.User{
+		1: {ID: 1, Email: "user@example.com"},
+		7: {ID: 7, Email: "ops@example.com"},
+	})
+	svc := NewAPIKeyService(&fakeAPIKeyRepository{keys: map[uint64]*models.APIKey{}}, users, []uint64{7})
+
+	if _, _, err := svc.Create(ctx, 1, "installments", []string{"Service:Installments"}, 0, ""); !errors.Is(err, ErrAPIKeyServiceScope) {
+		t.Fatalf("Create() by a user = %v, want ErrAPIKeyServiceScope", err)
+	}
+	if _, _, err := svc.Create(ctx, 1, "reports", []string{"features:read", "wallet:*"}, 0, ""); err != nil {
+		t.Fatalf("Create() without service scopes = %v", err)
+	}
+
+	key, plain, err := svc.Create(ctx, 7, "installments", []string{"service:installments"}, 0, "")
+	if err != nil {
+		t.Fatalf("Create() by a service key admin = %v", err)
+	}
+	validated, _, err := svc.Validate(ctx, plain)
+	if err != nil || validated.ID != key.ID || len(validated.Scopes) != 1 || validated.Scopes[0] != "service:installments" {
+		t.Fatalf("Validate() = %+v, %v", validated, err)
+	}
+}
+
+func TestAPIKeyService_UnknownScopesAreRejected(t *testing.T) {
+	ctx := context.Background()
+	users := newFakeUserRepository(map[uint64]*models.User{
+		1: {ID: 1, Email: "user@example.com"},
+	})
+	repo := &fakeAPIKeyRepository{keys: map[uint64]*models.APIKey{}}
+	svc := NewAPIKeyService(repo, users, nil)
+
+	for _, scopes := range [][]string{
+		{"features:delete"},
+		{"features:read", "wallets:read"},
+		{"billing:*"},
+		{"service:payments"},
+	} {
+		if _, _, err := svc.Create(ctx, 1, "reports", scopes, 0, ""); !errors.Is(err, ErrAPIKeyScopeUnknown) {
+			t.Errorf("Create(%v) = %v, want ErrAPIKeyScopeUnknown", scopes, err)
+		}
+	}
+	if len(repo.keys) != 0 {
+		t.Fatalf("created %d keys with unknown scopes", len(repo.keys))
+	}
+
+	// A key stored before its scope was retired is not rotated onto a new secret
+	repo.keys[1] = &models.APIKey{ID: 1, UserID: 1, Scopes: []string{"wallet:read", "legacy:export"}}
+	if _, _, err := svc.Rotate(ctx, 1, 1); !errors.Is(err, ErrAPIKeyScopeUnknown) {
+		t.Fatalf("Rotate() = %v, want ErrAPIKeyScopeUnknown", err)
+	}
+}
@@ -798,6 +798,10 @@ func (f *fakeUserRepository) GetFeatureCounts(context.Context, uint64) (int32, i
 	panic("unexpected call to GetFeatureCounts")
 }
 
+func (f *fakeUserRepository) FindUserInfo(context.Context, uint64, string) (*repository.UserInfo, error) {
+	panic("unexpected call to FindUserInfo")
+}
+
 var _ repository.UserRepository = (*fakeUserRepository)(nil)
 
 type fakeAccountSecurityRepository struct {
@@ -942,6 +946,35 @@ func (f *fakeActivityRepository) GetUserEventsByUserID(context.Context, uint64,
 	panic("unexpected call to GetUserEventsByUserID")
 }
 
+func (f *fakeActivityRepository) StreamUserEvents(_ context.Context, filter models.UserEventExportFilter, fn func(*models.UserEvent) error) error {
+	for _, event := range f.events {
+		if filter.UserID != 0 && event.UserID != filter.UserID {
+			continue
+		}
+		if (!filter.From.IsZero() && event.CreatedAt.Before(filter.From)) || (!filter.To.IsZero() && !event.CreatedAt.Before(filter.To)) {
+			continue
+		}
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeActivityRepository) PurgeUserEvents(_ context.Context, before time.Time, limit int) (int64, error) {
+	var kept []*models.UserEvent
+	var deleted int64
+	for _, event := range f.events {
+		if event.CreatedAt.Before(before) && deleted < int64(limit) {
+			deleted++
+			continue
+		}
+		kept = append(kept, event)
+	}
+	f.events = kept
+	return deleted, nil
+}
+
 func (f *fakeActivityRepository) GetUserEventReportByEventID(context.Context, uint64) (*models.UserEventReport, error) {
 	return nil, nil
 }
@@ -259,7 +259,7 @@ func TestCallback(t *testing.T) {
 			"http://localhost:3000",
 		)
 
-		result, err := svc.Callback(ctx, state, "test_code", "127.0.0.1")
+		result, err := svc.Callback(ctx, state, "test_code", "127.0.0.1", "")
 		if err != nil {
 			t.Fatalf("Callback failed: %v", err)
 		}
@@ -309,7 +309,7 @@ func TestCallback(t *testing.T) {
 			"http://localhost:3000",
 		)
 
-		_, err := svc.Callback(ctx, "invalid_state", "test_code", "127.0.0.1")
+		_, err := svc.Callback(ctx, "invalid_state", "test_code", "127.0.0.1", "")
 		if err == nil {
 			t.Fatal("Expected error for invalid state")
 		}
@@ -360,7 +360,7 @@ func TestCallback(t *testing.T) {
 			"http://localhost:3000",
 		)
 
-		result, err := svc.Callback(ctx, state, "test_code", "127.0.0.1")
+		result, err := svc.Callback(ctx, state, "test_code", "127.0.0.1", "")
 		if err != nil {
 			t.Fatalf("Callback failed: %v", err)
 		}
@@ -422,7 +422,7 @@ func TestCallback(t *testing.T) {
 			"http://localhost:3000",
 		)
 
-		result, err := svc.Callback(ctx, state, "test_code", "127.0.0.1")
+		result, err := svc.Callback(ctx, state, "test_code", "127.0.0.1", "")
 		if err != nil {
 			t.Fatalf("Callback failed: %v", err)
 		}
@@ -466,8 +466,8 @@ func TestGetMe(t *testing.T) {
 		}
 
 		tokenRepo := newFakeTokenRepository()
-		tokenRepo.validateTokenFunc = func(_ context.Context, token string) (*models.User, error) {
-			return users[1], nil
+		tokenRepo.validateTokenFunc = func(_ context.Context, token string) (*models.User, *models.ValidatedToken, error) {
+			return users[1], &models.ValidatedToken{ID: 1, ExpiresAt: time.Now().Add(time.Hour)}, nil
 		}
 
 		cacheRepo := newFakeCacheRepository()
@@ -502,8 +502,8 @@ func TestGetMe(t *testing.T) {
 	t.Run("get me with invalid token", func(t *testing.T) {
 		userRepo := newFakeUserRepository(nil)
 		tokenRepo := newFakeTokenRepository()
-		tokenRepo.validateTokenFunc = func(_ context.Context, token string) (*models.User, error) {
-			return nil, fmt.Errorf("invalid token")
+		tokenRepo.validateTokenFunc = func(_ context.Context, token string) (*models.User, *models.ValidatedToken, error) {
+			return nil, nil, fmt.Errorf("invalid token")
 		}
 		cacheRepo := newFakeCacheRepository()
 		accountRepo := newFakeAccountSecurityRepository()
@@ -592,8 +592,8 @@ func TestValidateToken(t *testing.T) {
 		}
 		userRepo := newFakeUserRepository(nil)
 		tokenRepo := newFakeTokenRepository()
-		tokenRepo.validateTokenFunc = func(_ context.Context, token string) (*models.User, error) {
-			return users[1], nil
+		tokenRepo.validateTokenFunc = func(_ context.Context, token string) (*models.User, *models.ValidatedToken, error) {
+			return users[1], &models.ValidatedToken{ID: 1, ExpiresAt: time.Now().Add(time.Hour)}, nil
 		}
 		cacheRepo := newFakeCacheRepository()
 		accountRepo := newFakeAccountSecurityRepository()
@@ -605,7 +605,7 @@ func TestValidateToken(t *testing.T) {
 			"", "", "", "", "",
 		)
 
-		user, err := svc.ValidateToken(ctx, "valid_token")
+		user, _, err := svc.ValidateToken(ctx, "valid_token")
 		if err != nil {
 			t.Fatalf("ValidateToken failed: %v", err)
 		}
@@ -620,8 +620,8 @@ func TestValidateToken(t *testing.T) {
 	t.Run("invalid token", func(t *testing.T) {
 		userRepo := newFakeUserRepository(nil)
 		tokenRepo := newFakeTokenRepository()
-		tokenRepo.validateTokenFunc = func(_ context.Context, token string) (*models.User, error) {
-			return nil, fmt.Errorf("invalid token")
+		tokenRepo.validateTokenFunc = func(_ context.Context, token string) (*models.User, *models.ValidatedToken, error) {
+			return nil, nil, fmt.Errorf("invalid token")
 		}
 		cacheRepo := newFakeCacheRepository()
 		accountRepo := newFakeAccountSecurityRepository()
@@ -633,7 +633,7 @@ func TestValidateToken(t *testing.T) {
 			"", "", "", "", "",
 		)
 
-		_, err := svc.ValidateToken(ctx, "invalid_token")
+		_, _, err := svc.ValidateToken(ctx, "invalid_token")
 		if err == nil {
 			t.Fatal("Expected error for invalid token")
 		}
@@ -719,8 +719,9 @@ var _ repository.CacheRepository = (*fakeCacheRepository)(nil)
 type fakeTokenRepository struct {
 	tokens               map[string]*models.User
 	createTokenFunc      func(context.Context, uint64, string, time.Time) (string, error)
-	validateTokenFunc    func(context.Context, string) (*models.User, error)
+	validateTokenFunc    func(context.Context, string) (*models.User, *models.ValidatedToken, error)
 	deleteUserTokensFunc func(context.Context, uint64) error
+	deleteIdleTokensFunc func(context.Context, time.Time) (int64, error)
 }
 
 func newFakeTokenRepository() *fakeTokenRepository {
@@ -737,14 +738,14 @@ func (f *fakeTokenRepository) Create(ctx context.Context, userID uint64, name st
 	return fmt.Sprintf("%d|%s", userID, token), nil
 }
 
-func (f *fakeTokenRepository) ValidateToken(ctx context.Context, token string) (*models.User, error) {
+func (f *fakeTokenRepository) ValidateToken(ctx context.Context, token string) (*models.User, *models.ValidatedToken, error) {
 	if f.validateTokenFunc != nil {
 		return f.validateTokenFunc(ctx, token)
 	}
 	if user, ok := f.tokens[token]; ok {
-		return user, nil
+		return user, &models.ValidatedToken{ExpiresAt: time.Now().Add(time.Hour), Scopes: []string{"*"}}, nil
 	}
-	return nil, fmt.Errorf("invalid token")
+	return nil, nil, fmt.Errorf("invalid token")
 }
 
 func (f *fakeTokenRepository) DeleteUserTokens(ctx context.Context, userID uint64) error {
@@ -758,6 +759,13 @@ func (f *fakeTokenRepository) FindTokenByHash(ctx context.Context, tokenHash str
 	return nil, nil
 }
 
+func (f *fakeTokenRepository) DeleteIdleTokens(ctx context.Context, now time.Time) (int64, error) {
+	if f.deleteIdleTokensFunc != nil {
+		return f.deleteIdleTokensFunc(ctx, now)
+	}
+	return 0, nil
+}
+
 var _ repository.TokenRepository = (*fakeTokenRepository)(nil)
 
 type fakeObserverService struct {
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/repository"
+)
+
+type fakeEmailVerificationRepository struct {
+	requests map[string]int64
+}
+
+func (f *fakeEmailVerificationRepository) IncrementRequests(ctx context.Context, key string, window time.Duration) (int64, error) {
+	f.requests[key]++
+	return f.requests[key], nil
+}
+
+var _ repository.EmailVerificationRepository = (*fakeEmailVerificationRepository)(nil)
+
+// emailVerifyingUserRepository records MarkEmailAsVerified on the stored user
+type emailVerifyingUserRepository struct {
+	*fakeUserRepository
+}
+
+func (f *emailVerifyingUserRepository) MarkEmailAsVerified(ctx context.Context, userID uint64) error {
+	f.users[userID].EmailVerifiedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	return nil
+}
+
+func newTestEmailVerificationService(users map[uint64]*models.User) (*emailVerificationService, *fakeEmailServiceClient) {
+	email := &fakeEmailServiceClient{}
+	svc := NewEmailVerificationService(
+		&emailVerifyingUserRepository{fakeUserRepository: newFakeUserRepository(users)},
+		&fakeEmailVerificationRepository{requests: make(map[string]int64)},
+		email,
+		"secret",
+		"https://api.example.com",
+		"https://app.example.com",
+	).(*emailVerificationService)
+	return svc, email
+}
+
+// emailedVerificationLink extracts the path hash and query parameters from the sent email
+func emailedVerificationLink(t *testing.T, body string) (string, int64, string) {
+	t.Helper()
+
+	for _, field := range strings.Fields(body) {
+		if !strings.HasPrefix(field, "https://api.example.com/api/email/verify/") {
+			continue
+		}
+		link, err := url.Parse(field)
+		if err != nil {
+			t.Fatalf("invalid verification link %q: %v", field, err)
+		}
+		segments := strings.Split(link.Path, "/")
+		expires, err := strconv.ParseInt(link.Query().Get("expires"), 10, 64)
+		if err != nil {
+			t.Fatalf("invalid expires in verification link: %v", err)
+		}
+		return segments[len(segments)-1], expires, link.Query().Get("signature")
+	}
+
+	t.Fatalf("no verification link in email body %q", body)
+	return "", 0, ""
+}
+
+func TestEmailVerificationService_SendAndVerify(t *testing.T) {
+	ctx := context.Background()
+	users := map[uint64]*models.User{7: {ID: 7, Email: "user@example.com"}}
+	svc, email := newTestEmailVerificationService(users)
+
+	if err := svc.SendEmailVerification(ctx, 7); err != nil {
+		t.Fatalf("SendEmailVerification() error = %v", err)
+	}
+	if len(email.requests) != 1 || email.requests[0].To != "user@example.com" {
+		t.Fatalf("expected one email to user@example.com, got %+v", email.requests)
+	}
+	hash, expires, signature := emailedVerificationLink(t, email.requests[0].Body)
+
+	if _, err := svc.VerifyEmail(ctx, 8, hash, expires, signature); !errors.Is(err, ErrEmailVerificationInvalid) {
+		t.Errorf("VerifyEmail() for another user error = %v, want ErrEmailVerificationInvalid", err)
+	}
+	if _, err := svc.VerifyEmail(ctx, 7, hash, expires+1, signature); !errors.Is(err, ErrEmailVerificationInvalid) {
+		t.Errorf("VerifyEmail() with tampered expiry error = %v, want ErrEmailVerificationInvalid", err)
+	}
+
+	alreadyVerified, err := svc.VerifyEmail(ctx, 7, hash, expires, signature)
+	if err != nil || alreadyVerified {
+		t.Fatalf("VerifyEmail() = %v, %v; want false, nil", alreadyVerified, err)
+	}
+	if !users[7].EmailVerifiedAt.Valid {
+		t.Error("email not marked verified")
+	}
+
+	alreadyVerified, err = svc.VerifyEmail(ctx, 7, hash, expires, signature)
+	if err != nil || !alreadyVerified {
+		t.Errorf("second VerifyEmail() = %v, %v; want true, nil", alreadyVerified, err)
+	}
+	if err := svc.SendEmailVerification(ctx, 7); !errors.Is(err, ErrEmailAlreadyVerified) {
+		t.Errorf("SendEmailVerification() after verifying error = %v, want ErrEmailAlreadyVerified", err)
+	}
+}
+
+func TestEmailVerificationService_RejectsExpiredAndStaleLinks(t *testing.T) {
+	ctx := context.Background()
+	users := map[uint64]*models.User{7: {ID: 7, Email: "user@example.com"}}
+	svc, email := newTestEmailVerificationService(users)
+
+	if err := svc.SendEmailVerification(ctx, 7); err != nil {
+		t.Fatalf("SendEmailVerification() error = %v", err)
+	}
+	hash, expires, signature := emailedVerificationLink(t, email.requests[0].Body)
+
+	svc.now = func() time.Time { return time.Unix(expires+1, 0) }
+	if _, err := svc.VerifyEmail(ctx, 7, hash, expires, signature); !errors.Is(err, ErrEmailVerificationExpired) {
+		t.Errorf("VerifyEmail() after expiry error = %v, want ErrEmailVerificationExpired", err)
+	}
+
+	svc.now = time.Now
+	users[7].Email = "new@example.com"
+	if _, err := svc.VerifyEmail(ctx, 7, hash, expires, signature); !errors.Is(err, ErrEmailVerificationInvalid) {
+		t.Errorf("VerifyEmail() after email change error = %v, want ErrEmailVerificationInvalid", err)
+	}
+}
+
+func TestEmailVerificationService_ResendCooldown(t *testing.T) {
+	ctx := context.Background()
+	svc, email := newTestEmailVerificationService(map[uint64]*models.User{7: {ID: 7, Email: "user@example.com"}})
+
+	if err := svc.SendEmailVerification(ctx, 7); err != nil {
+		t.Fatalf("SendEmailVerification() error = %v", err)
+	}
+	if err := svc.SendEmailVerification(ctx, 7); !errors.Is(err, ErrEmailVerificationRateLimited) {
+		t.Errorf("immediate resend error = %v, want ErrEmailVerificationRateLimited", err)
+	}
+	if len(email.requests) != 1 {
+		t.Errorf("sent %d emails, want 1", len(email.requests))
+	}
+}
+
+func TestEmailVerificationService_Disabled(t *testing.T) {
+	svc := NewEmailVerificationService(nil, nil, nil, "", "https://api.example.com", "https://app.example.com")
+	if err := svc.SendEmailVerification(context.Background(), 7); !errors.Is(err, ErrEmailVerificationDisabled) {
+		t.Errorf("SendEmailVerification() error = %v, want ErrEmailVerificationDisabled", err)
+	}
+}
@@ -255,6 +255,10 @@ func (r *fakeKYCUserRepository) GetFeatureCounts(ctx context.Context, userID uin
 	return 0, 0, 0, nil
 }
 
+func (r *fakeKYCUserRepository) FindUserInfo(ctx context.Context, userID uint64, code string) (*repository.UserInfo, error) {
+	return nil, nil
+}
+
 func TestGetKYC_NotFound(t *testing.T) {
 	ctx := context.Background()
 	kycRepo := newFakeKYCRepository()
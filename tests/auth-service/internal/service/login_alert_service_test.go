@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/repository"
+	notificationspb "metargb/shared/pb/notifications"
+)
+
+type fakeLoginAlertRepository struct {
+	devices []*models.UserDevice
+	alerts  map[uint64]*models.LoginAlert
+}
+
+func newFakeLoginAlertRepository() *fakeLoginAlertRepository {
+	return &fakeLoginAlertRepository{alerts: make(map[uint64]*models.LoginAlert)}
+}
+
+func (f *fakeLoginAlertRepository) KnownLogin(ctx context.Context, userID uint64, fingerprint, ip string) (bool, bool, bool, error) {
+	var hasDevices, deviceKnown, ipKnown bool
+	for _, device := range f.devices {
+		if device.UserID != userID {
+			continue
+		}
+		hasDevices = true
+		deviceKnown = deviceKnown || device.Fingerprint == fingerprint
+		ipKnown = ipKnown || device.IP == ip
+	}
+	return hasDevices, deviceKnown, ipKnown, nil
+}
+
+func (f *fakeLoginAlertRepository) TouchDevice(ctx context.Context, device *models.UserDevice) error {
+	f.devices = append(f.devices, device)
+	return nil
+}
+
+func (f *fakeLoginAlertRepository) CreateAlert(ctx context.Context, alert *models.LoginAlert) error {
+	alert.ID = uint64(len(f.alerts) + 1)
+	f.alerts[alert.ID] = alert
+	return nil
+}
+
+func (f *fakeLoginAlertRepository) FindAlert(ctx context.Context, userID, alertID uint64) (*models.LoginAlert, error) {
+	alert, ok := f.alerts[alertID]
+	if !ok || alert.UserID != userID {
+		return nil, nil
+	}
+	copied := *alert
+	return &copied, nil
+}
+
+func (f *fakeLoginAlertRepository) ListAlerts(ctx context.Context, userID uint64, pendingOnly bool) ([]*models.LoginAlert, error) {
+	var alerts []*models.LoginAlert
+	for _, alert := range f.alerts {
+		if alert.UserID == userID && (!pendingOnly || !alert.Confirmed.Valid) {
+			alerts = append(alerts, alert)
+		}
+	}
+	return alerts, nil
+}
+
+func (f *fakeLoginAlertRepository) RespondAlert(ctx context.Context, alertID uint64, wasMe bool) error {
+	f.alerts[alertID].Confirmed = sql.NullBool{Bool: wasMe, Valid: true}
+	return nil
+}
+
+var _ repository.LoginAlertRepository = (*fakeLoginAlertRepository)(nil)
+
+type fakeAlertNotificationClient struct {
+	notificationspb.NotificationServiceClient
+	requests []*notificationspb.SendNotificationRequest
+}
+
+func (f *fakeAlertNotificationClient) SendNotification(ctx context.Context, req *notificationspb.SendNotificationRequest, opts ...grpc.CallOption) (*notificationspb.NotificationResponse, error) {
+	f.requests = append(f.requests, req)
+	return &notificationspb.NotificationResponse{Sent: true}, nil
+}
+
+type fakeAlertSMSClient struct {
+	notificationspb.SMSServiceClient
+	phones []string
+}
+
+func (f *fakeAlertSMSClient) SendSMS(ctx context.Context, req *notificationspb.SendSMSRequest, opts ...grpc.CallOption) (*notificationspb.SMSResponse, error) {
+	f.phones = append(f.phones, req.Phone)
+	return &notificationspb.SMSResponse{Sent: true}, nil
+}
+
+func TestLoginAlertService_CheckLogin(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := newFakeLoginAlertRepository()
+	notifications := &fakeAlertNotificationClient{}
+	sms := &fakeAlertSMSClient{}
+	svc := NewLoginAlertService(alertRepo, newFakeTokenRepository(), notifications, sms)
+
+	user := &models.User{ID: 1, Phone: sql.NullString{String: "09120000000", Valid: true}}
+	login := func(eventID uint64, ip, device string) *models.LoginAlert {
+		alert, err := svc.CheckLogin(ctx, user, &models.UserEvent{ID: eventID, UserID: user.ID, IP: ip, Device: device})
+		if err != nil {
+			t.Fatalf("CheckLogin failed: %v", err)
+		}
+		return alert
+	}
+
+	if alert := login(1, "1.1.1.1", "Firefox"); alert != nil {
+		t.Fatalf("first login must only record the device, got %+v", alert)
+	}
+	if alert := login(2, "1.1.1.1", "Firefox"); alert != nil {
+		t.Fatalf("known device and IP must not alert, got %+v", alert)
+	}
+
+	alert := login(3, "2.2.2.2", "Firefox")
+	if alert == nil || alert.Reason != models.LoginAlertReasonNewIP || alert.UserEventID != 3 {
+		t.Fatalf("expected new_ip alert for event 3, got %+v", alert)
+	}
+
+	alert = login(4, "2.2.2.2", "Chrome")
+	if alert == nil || alert.Reason != models.LoginAlertReasonNewDevice {
+		t.Fatalf("expected new_device alert, got %+v", alert)
+	}
+
+	if len(notifications.requests) != 2 || notifications.requests[1].Data["alert_id"] != "2" {
+		t.Errorf("expected 2 in-app alerts, got %d", len(notifications.requests))
+	}
+	if notifications.requests[0].Category != "" {
+		t.Errorf("security alerts must bypass notification preferences")
+	}
+	if len(sms.phones) != 2 || sms.phones[0] != "09120000000" {
+		t.Errorf("expected 2 SMS alerts, got %v", sms.phones)
+	}
+}
+
+func TestLoginAlertService_ConfirmLoginAlert(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := newFakeLoginAlertRepository()
+	tokenRepo := newFakeTokenRepository()
+	svc := NewLoginAlertService(alertRepo, tokenRepo, nil, nil)
+
+	var revokedUser uint64
+	tokenRepo.deleteUserTokensFunc = func(_ context.Context, userID uint64) error {
+		revokedUser = userID
+		return nil
+	}
+
+	_ = alertRepo.CreateAlert(ctx, &models.LoginAlert{UserID: 1, Reason: models.LoginAlertReasonNewDevice})
+	_ = alertRepo.CreateAlert(ctx, &models.LoginAlert{UserID: 1, Reason: models.LoginAlertReasonNewIP})
+
+	if _, err := svc.ConfirmLoginAlert(ctx, 2, 1, true); !errors.Is(err, ErrLoginAlertNotFound) {
+		t.Fatalf("expected ErrLoginAlertNotFound for another user's alert, got %v", err)
+	}
+
+	alert, err := svc.ConfirmLoginAlert(ctx, 1, 1, true)
+	if err != nil {
+		t.Fatalf("ConfirmLoginAlert failed: %v", err)
+	}
+	if alert.Status() != "confirmed" || revokedUser != 0 {
+		t.Errorf("confirming must not revoke sessions, status %q", alert.Status())
+	}
+
+	if _, err := svc.ConfirmLoginAlert(ctx, 1, 1, false); !errors.Is(err, ErrLoginAlertAnswered) {
+		t.Errorf("expected ErrLoginAlertAnswered, got %v", err)
+	}
+
+	alert, err = svc.ConfirmLoginAlert(ctx, 1, 2, false)
+	if err != nil {
+		t.Fatalf("ConfirmLoginAlert failed: %v", err)
+	}
+	if alert.Status() != "denied" || revokedUser != 1 {
+		t.Errorf("denying must revoke the user's sessions, status %q revoked %d", alert.Status(), revokedUser)
+	}
+}
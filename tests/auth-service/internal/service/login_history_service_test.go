@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"metargb/auth-service/internal/models"
+	"metargb/shared/pkg/geoip"
+)
+
+type fakeLoginHistoryRepository struct {
+	entries []*models.LoginHistoryEntry
+	limit   int
+	offset  int
+}
+
+func (f *fakeLoginHistoryRepository) ListLogins(_ context.Context, _ uint64, limit, offset int) ([]*models.LoginHistoryEntry, error) {
+	f.limit, f.offset = limit, offset
+	if offset >= len(f.entries) {
+		return nil, nil
+	}
+	end := offset + limit
+	if end > len(f.entries) {
+		end = len(f.entries)
+	}
+	return f.entries[offset:end], nil
+}
+
+type fakeIPLocator map[string]geoip.Location
+
+func (f fakeIPLocator) Lookup(ip string) (geoip.Location, bool) {
+	location, ok := f[ip]
+	return location, ok
+}
+
+func newLoginHistoryEntries(n int) []*models.LoginHistoryEntry {
+	entries := make([]*models.LoginHistoryEntry, 0, n)
+	for i := n; i > 0; i-- {
+		entries = append(entries, &models.LoginHistoryEntry{
+			EventID:   uint64(i),
+			IP:        "2.144.0.1",
+			Device:    "Chrome",
+			CreatedAt: time.Date(2025, 1, 1, 8, 0, 0, 0, time.Local).Add(time.Duration(i) * time.Hour),
+		})
+	}
+	return entries
+}
+
+func TestLoginHistoryService_GetLoginHistory(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("paginates and locates logins", func(t *testing.T) {
+		repo := &fakeLoginHistoryRepository{entries: newLoginHistoryEntries(5)}
+		repo.entries[1].IP = "10.0.0.1"
+		repo.entries[1].Alert = &models.LoginAlert{Reason: models.LoginAlertReasonNewDevice, Confirmed: sql.NullBool{Bool: false, Valid: true}}
+		svc := NewLoginHistoryService(repo, fakeIPLocator{"2.144.0.1": {CountryCode: "IR", City: "Tehran"}})
+
+		entries, next, prev, err := svc.GetLoginHistory(ctx, 7, 1, 2)
+		if err != nil {
+			t.Fatalf("GetLoginHistory failed: %v", err)
+		}
+		if repo.limit != 3 || repo.offset != 0 {
+			t.Errorf("expected limit 3 offset 0, got %d %d", repo.limit, repo.offset)
+		}
+		if len(entries) != 2 || entries[0].EventID != 5 {
+			t.Fatalf("unexpected entries %+v", entries)
+		}
+		if entries[0].Location.CountryCode != "IR" {
+			t.Errorf("expected the first login to be located, got %+v", entries[0].Location)
+		}
+		if !entries[1].Location.IsZero() {
+			t.Errorf("expected no location for a private IP, got %+v", entries[1].Location)
+		}
+		if entries[1].Alert.Status() != "denied" {
+			t.Errorf("expected the alert to be kept, got %q", entries[1].Alert.Status())
+		}
+		if next != "/api/auth/login-history?page=2&per_page=2" || prev != "" {
+			t.Errorf("unexpected pagination %q %q", next, prev)
+		}
+
+		entries, next, prev, err = svc.GetLoginHistory(ctx, 7, 3, 2)
+		if err != nil {
+			t.Fatalf("GetLoginHistory failed: %v", err)
+		}
+		if len(entries) != 1 || next != "" || prev != "/api/auth/login-history?page=2&per_page=2" {
+			t.Errorf("unexpected last page: %d entries, %q %q", len(entries), next, prev)
+		}
+	})
+
+	t.Run("clamps the page size", func(t *testing.T) {
+		repo := &fakeLoginHistoryRepository{}
+		svc := NewLoginHistoryService(repo, nil)
+
+		if _, _, _, err := svc.GetLoginHistory(ctx, 7, 0, 0); err != nil {
+			t.Fatalf("GetLoginHistory failed: %v", err)
+		}
+		if repo.limit != DefaultLoginHistoryPerPage+1 || repo.offset != 0 {
+			t.Errorf("expected the default page size, got limit %d offset %d", repo.limit, repo.offset)
+		}
+
+		if _, _, _, err := svc.GetLoginHistory(ctx, 7, 2, 500); err != nil {
+			t.Fatalf("GetLoginHistory failed: %v", err)
+		}
+		if repo.limit != MaxLoginHistoryPerPage+1 || repo.offset != MaxLoginHistoryPerPage {
+			t.Errorf("expected the max page size, got limit %d offset %d", repo.limit, repo.offset)
+		}
+	})
+}
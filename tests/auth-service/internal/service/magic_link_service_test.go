@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/repository"
+	notificationspb "metargb/shared/pb/notifications"
+)
+
+const (
+	magicLinkTestUserAgent = "Mozilla/5.0 (X11; Linux x86_64) Firefox/120.0"
+	magicLinkTestEmail     = "user@example.com"
+	magicLinkTestNonce     = "nonce-from-the-requesting-browser"
+)
+
+type fakeMagicLinkRepository struct {
+	links    map[string]*models.MagicLink
+	requests map[string]int64
+}
+
+func newFakeMagicLinkRepository() *fakeMagicLinkRepository {
+	return &fakeMagicLinkRepository{
+		links:    make(map[string]*models.MagicLink),
+		requests: make(map[string]int64),
+	}
+}
+
+func (f *fakeMagicLinkRepository) Store(ctx context.Context, tokenHash string, link *models.MagicLink, ttl time.Duration) error {
+	f.links[tokenHash] = link
+	return nil
+}
+
+func (f *fakeMagicLinkRepository) Consume(ctx context.Context, tokenHash string) (*models.MagicLink, error) {
+	link, ok := f.links[tokenHash]
+	if !ok {
+		return nil, nil
+	}
+	delete(f.links, tokenHash)
+	return link, nil
+}
+
+func (f *fakeMagicLinkRepository) IncrementRequests(ctx context.Context, key string, window time.Duration) (int64, error) {
+	f.requests[key]++
+	return f.requests[key], nil
+}
+
+var _ repository.MagicLinkRepository = (*fakeMagicLinkRepository)(nil)
+
+type fakeEmailServiceClient struct {
+	notificationspb.EmailServiceClient
+	requests []*notificationspb.SendEmailRequest
+}
+
+func (f *fakeEmailServiceClient) SendEmail(ctx context.Context, req *notificationspb.SendEmailRequest, opts ...grpc.CallOption) (*notificationspb.EmailResponse, error) {
+	f.requests = append(f.requests, req)
+	return &notificationspb.EmailResponse{Sent: true}, nil
+}
+
+func newTestMagicLinkService(secret string) (*magicLinkService, *fakeEmailServiceClient, *fakeObserverService) {
+	users := map[uint64]*models.User{
+		7: {ID: 7, Email: magicLinkTestEmail},
+	}
+	userRepo := &extendedFakeUserRepository{
+		fakeUserRepository: newFakeUserRepository(users),
+	}
+	email := &fakeEmailServiceClient{}
+	observer := newFakeObserverService()
+
+	svc := NewMagicLinkService(
+		userRepo,
+		newFakeTokenRepository(),
+		newFakeMagicLinkRepository(),
+		observer,
+		email,
+		secret,
+		"https://api.example.com",
+		"https://app.example.com",
+		[]string{"https://admin.example.com"},
+	).(*magicLinkService)
+
+	return svc, email, observer
+}
+
+// emailedMagicLink extracts the callback query parameters from the sent email
+func emailedMagicLink(t *testing.T, req *notificationspb.SendEmailRequest) (string, int64, string) {
+	t.Helper()
+
+	for _, field := range strings.Fields(req.Body) {
+		if !strings.HasPrefix(field, "https://api.example.com/api/auth/magic-link/callback?") {
+			continue
+		}
+		link, err := url.Parse(field)
+		if err != nil {
+			t.Fatalf("invalid magic link %q: %v", field, err)
+		}
+		query := link.Query()
+		expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+		if err != nil {
+			t.Fatalf("invalid expires in magic link: %v", err)
+		}
+		return query.Get("token"), expires, query.Get("signature")
+	}
+
+	t.Fatalf("no magic link in email body %q", req.Body)
+	return "", 0, ""
+}
+
+func TestMagicLinkService_RequestAndConsume(t *testing.T) {
+	ctx := context.Background()
+	svc, email, observer := newTestMagicLinkService("secret")
+
+	if err := svc.RequestMagicLink(ctx, " User@Example.com ", "https://app.example.com/dashboard", "1.2.3.4", magicLinkTestNonce); err != nil {
+		t.Fatalf("RequestMagicLink failed: %v", err)
+	}
+	if len(email.requests) != 1 || email.requests[0].To != magicLinkTestEmail {
+		t.Fatalf("expected one email to %s, got %+v", magicLinkTestEmail, email.requests)
+	}
+
+	token, expires, signature := emailedMagicLink(t, email.requests[0])
+
+	result, err := svc.ConsumeMagicLink(ctx, token, expires, signature, "1.2.3.4", magicLinkTestUserAgent, magicLinkTestNonce)
+	if err != nil {
+		t.Fatalf("ConsumeMagicLink failed: %v", err)
+	}
+	if result.Token == "" {
+		t.Error("expected a personal access token")
+	}
+	if !strings.HasPrefix(result.RedirectURL, "https://app.example.com/dashboard/?") {
+		t.Errorf("expected redirect to back_url, got %s", result.RedirectURL)
+	}
+	if observer.loginCount != 1 {
+		t.Errorf("expected login observer to fire once, got %d", observer.loginCount)
+	}
+
+	if _, err := svc.ConsumeMagicLink(ctx, token, expires, signature, "1.2.3.4", magicLinkTestUserAgent, magicLinkTestNonce); !errors.Is(err, ErrMagicLinkInvalid) {
+		t.Errorf("expected reused link to be rejected, got %v", err)
+	}
+}
+
+func TestMagicLinkService_UnknownEmail(t *testing.T) {
+	svc, email, _ := newTestMagicLinkService("secret")
+
+	if err := svc.RequestMagicLink(context.Background(), "nobody@example.com", "", "1.2.3.4", magicLinkTestNonce); err != nil {
+		t.Fatalf("expected success for unknown email, got %v", err)
+	}
+	if len(email.requests) != 0 {
+		t.Errorf("expected no email for unknown address, got %d", len(email.requests))
+	}
+}
+
+func TestMagicLinkService_RateLimit(t *testing.T) {
+	ctx := context.Background()
+	svc, email, _ := newTestMagicLinkService("secret")
+
+	for i := 0; i < magicLinkEmailLimit; i++ {
+		if err := svc.RequestMagicLink(ctx, magicLinkTestEmail, "", "1.2.3.4", magicLinkTestNonce); err != nil {
+			t.Fatalf("request %d failed: %v", i+1, err)
+		}
+	}
+
+	err := svc.RequestMagicLink(ctx, magicLinkTestEmail, "", "1.2.3.4", magicLinkTestNonce)
+	if !errors.Is(err, ErrMagicLinkRateLimited) {
+		t.Fatalf("expected ErrMagicLinkRateLimited, got %v", err)
+	}
+	if len(email.requests) != magicLinkEmailLimit {
+		t.Errorf("expected %d emails, got %d", magicLinkEmailLimit, len(email.requests))
+	}
+}
+
+func TestMagicLinkService_ConsumeRejections(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		consume   func(svc *magicLinkService, token string, expires int64, signature string) error
+		wantError error
+	}{
+		{
+			name: "tampered signature",
+			consume: func(svc *magicLinkService, token string, expires int64, signature string) error {
+				_, err := svc.ConsumeMagicLink(ctx, token, expires+3600, signature, "1.2.3.4", magicLinkTestUserAgent, magicLinkTestNonce)
+				return err
+			},
+			wantError: ErrMagicLinkInvalid,
+		},
+		{
+			name: "other device",
+			consume: func(svc *magicLinkService, token string, expires int64, signature string) error {
+				_, err := svc.ConsumeMagicLink(ctx, token, expires, signature, "1.2.3.4", magicLinkTestUserAgent, "nonce-of-another-browser")
+				return err
+			},
+			wantError: ErrMagicLinkDeviceMismatch,
+		},
+		{
+			name: "same user agent without the cookie",
+			consume: func(svc *magicLinkService, token string, expires int64, signature string) error {
+				_, err := svc.ConsumeMagicLink(ctx, token, expires, signature, "1.2.3.4", magicLinkTestUserAgent, "")
+				return err
+			},
+			wantError: ErrMagicLinkDeviceMismatch,
+		},
+		{
+			name: "expired",
+			consume: func(svc *magicLinkService, token string, expires int64, signature string) error {
+				svc.now = func() time.Time { return time.Now().Add(MagicLinkTTL + time.Minute) }
+				_, err := svc.ConsumeMagicLink(ctx, token, expires, signature, "1.2.3.4", magicLinkTestUserAgent, magicLinkTestNonce)
+				return err
+			},
+			wantError: ErrMagicLinkExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, email, observer := newTestMagicLinkService("secret")
+			if err := svc.RequestMagicLink(ctx, magicLinkTestEmail, "", "1.2.3.4", magicLinkTestNonce); err != nil {
+				t.Fatalf("RequestMagicLink failed: %v", err)
+			}
+			token, expires, signature := emailedMagicLink(t, email.requests[0])
+
+			if err := tt.consume(svc, token, expires, signature); !errors.Is(err, tt.wantError) {
+				t.Errorf("expected %v, got %v", tt.wantError, err)
+			}
+			if observer.loginCount != 0 {
+				t.Errorf("expected no login, got %d", observer.loginCount)
+			}
+		})
+	}
+}
+
+func TestMagicLinkService_Disabled(t *testing.T) {
+	svc, email, _ := newTestMagicLinkService("")
+
+	err := svc.RequestMagicLink(context.Background(), magicLinkTestEmail, "", "1.2.3.4", magicLinkTestNonce)
+	if !errors.Is(err, ErrMagicLinkDisabled) {
+		t.Fatalf("expected ErrMagicLinkDisabled, got %v", err)
+	}
+	if len(email.requests) != 0 {
+		t.Errorf("expected no email, got %d", len(email.requests))
+	}
+}
+
+func TestMagicLinkService_BackURL(t *testing.T) {
+	tests := []struct {
+		backURL string
+		allowed bool
+	}{
+		{"https://app.example.com/market?tab=1", true},
+		{"https://admin.example.com", true},
+		{"HTTPS://APP.EXAMPLE.COM/x", true},
+		{"https://evil.example.net/app.example.com", false},
+		{"https://app.example.com.evil.net/", false},
+		{"https://user@app.example.com/", false},
+		{"http://app.example.com/", false},
+		{"javascript:alert(1)", false},
+		{"//app.example.com/", false},
+		{"/dashboard", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.backURL, func(t *testing.T) {
+			svc, email, _ := newTestMagicLinkService("secret")
+			err := svc.RequestMagicLink(context.Background(), magicLinkTestEmail, tt.backURL, "1.2.3.4", magicLinkTestNonce)
+			if tt.allowed && err != nil {
+				t.Fatalf("expected %s to be allowed, got %v", tt.backURL, err)
+			}
+			if !tt.allowed && !errors.Is(err, ErrMagicLinkBackURL) {
+				t.Fatalf("expected ErrMagicLinkBackURL for %s, got %v", tt.backURL, err)
+			}
+			if !tt.allowed && len(email.requests) != 0 {
+				t.Errorf("expected no email for a rejected back_url, got %d", len(email.requests))
+			}
+		})
+	}
+}
+
+func TestMagicLinkService_RequiresDeviceNonce(t *testing.T) {
+	svc, email, _ := newTestMagicLinkService("secret")
+
+	err := svc.RequestMagicLink(context.Background(), magicLinkTestEmail, "", "1.2.3.4", "")
+	if !errors.Is(err, ErrMagicLinkDeviceRequired) {
+		t.Fatalf("expected ErrMagicLinkDeviceRequired, got %v", err)
+	}
+	if len(email.requests) != 0 {
+		t.Errorf("expected no email, got %d", len(email.requests))
+	}
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/repository"
+)
+
+type fakeMeCacheRepository struct {
+	snapshots   map[uint64]*models.MeSnapshot
+	generations map[uint64]int64
+	err         error
+}
+
+func newFakeMeCacheRepository() *fakeMeCacheRepository {
+	return &fakeMeCacheRepository{
+		snapshots:   make(map[uint64]*models.MeSnapshot),
+		generations: make(map[uint64]int64),
+	}
+}
+
+func (f *fakeMeCacheRepository) Get(_ context.Context, userID uint64) (*models.MeSnapshot, int64, error) {
+	if f.err != nil {
+		return nil, 0, f.err
+	}
+	generation := f.generations[userID]
+	snapshot, ok := f.snapshots[userID]
+	if !ok || snapshot.Generation != generation {
+		return nil, generation, nil
+	}
+	copied := *snapshot
+	return &copied, generation, nil
+}
+
+func (f *fakeMeCacheRepository) Set(_ context.Context, snapshot *models.MeSnapshot, _ time.Duration) error {
+	copied := *snapshot
+	f.snapshots[snapshot.UserID] = &copied
+	return nil
+}
+
+func (f *fakeMeCacheRepository) Invalidate(_ context.Context, userID uint64, _ time.Duration) error {
+	f.generations[userID]++
+	return nil
+}
+
+func (f *fakeMeCacheRepository) ListenNotificationsChanged(ctx context.Context, _ func(uint64)) error {
+	<-ctx.Done()
+	return nil
+}
+
+var _ repository.MeCacheRepository = (*fakeMeCacheRepository)(nil)
+
+func TestMeCacheServesSnapshotForSameScore(t *testing.T) {
+	ctx := context.Background()
+	cache := NewMeCache(newFakeMeCacheRepository(), 0)
+
+	_, generation, ok := cache.Get(ctx, 1, 10)
+	if !ok {
+		t.Fatal("expected the cache to be readable")
+	}
+	cache.Set(ctx, &models.MeSnapshot{UserID: 1, Score: 10, Notifications: 3}, generation)
+
+	snapshot, _, _ := cache.Get(ctx, 1, 10)
+	if snapshot == nil || snapshot.Notifications != 3 {
+		t.Fatalf("expected the stored snapshot, got %+v", snapshot)
+	}
+
+	// A score change may move the user to another level
+	if snapshot, _, _ := cache.Get(ctx, 1, 11); snapshot != nil {
+		t.Fatalf("expected a miss after the score changed, got %+v", snapshot)
+	}
+}
+
+func TestMeCacheInvalidateDropsSnapshotsBuiltBefore(t *testing.T) {
+	ctx := context.Background()
+	cache := NewMeCache(newFakeMeCacheRepository(), 0)
+
+	_, generation, _ := cache.Get(ctx, 1, 0)
+	cache.Set(ctx, &models.MeSnapshot{UserID: 1}, generation)
+	cache.Invalidate(ctx, 1)
+	if snapshot, _, _ := cache.Get(ctx, 1, 0); snapshot != nil {
+		t.Fatalf("expected a miss after invalidation, got %+v", snapshot)
+	}
+
+	// A snapshot read before an invalidation and stored after it is stale
+	_, staleGeneration, _ := cache.Get(ctx, 1, 0)
+	cache.Invalidate(ctx, 1)
+	cache.Set(ctx, &models.MeSnapshot{UserID: 1}, staleGeneration)
+	if snapshot, _, _ := cache.Get(ctx, 1, 0); snapshot != nil {
+		t.Fatalf("expected the stale snapshot to be ignored, got %+v", snapshot)
+	}
+}
+
+func TestMeCacheUnreadableIsNotCacheable(t *testing.T) {
+	repo := newFakeMeCacheRepository()
+	repo.err = errors.New("redis down")
+	cache := NewMeCache(repo, 0)
+
+	if snapshot, _, ok := cache.Get(context.Background(), 1, 0); snapshot != nil || ok {
+		t.Fatalf("expected an uncacheable miss, got %+v, %v", snapshot, ok)
+	}
+
+	var disabled *MeCache
+	if _, _, ok := disabled.Get(context.Background(), 1, 0); ok {
+		t.Fatal("expected a nil cache to cache nothing")
+	}
+	disabled.Invalidate(context.Background(), 1)
+}
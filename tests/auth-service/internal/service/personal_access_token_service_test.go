@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"metargb/auth-service/internal/models"
+)
+
+type fakePersonalAccessTokenRepository struct {
+	tokens map[uint64]*models.ScopedToken
+	nextID uint64
+}
+
+func newFakePersonalAccessTokenRepository() *fakePersonalAccessTokenRepository {
+	return &fakePersonalAccessTokenRepository{tokens: make(map[uint64]*models.ScopedToken)}
+}
+
+func (f *fakePersonalAccessTokenRepository) Create(_ context.Context, token *models.ScopedToken) (string, error) {
+	f.nextID++
+	token.ID = f.nextID
+	token.CreatedAt = time.Now()
+	f.tokens[token.ID] = token
+	return "plain", nil
+}
+
+func (f *fakePersonalAccessTokenRepository) FindByID(_ context.Context, id uint64) (*models.ScopedToken, error) {
+	return f.tokens[id], nil
+}
+
+func (f *fakePersonalAccessTokenRepository) ListByUserID(_ context.Context, userID uint64) ([]*models.ScopedToken, error) {
+	var tokens []*models.ScopedToken
+	for _, token := range f.tokens {
+		if token.UserID == userID {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens, nil
+}
+
+func (f *fakePersonalAccessTokenRepository) Delete(_ context.Context, id uint64) error {
+	delete(f.tokens, id)
+	return nil
+}
+
+func TestPersonalAccessTokenService_Create(t *testing.T) {
+	ctx := context.Background()
+	users := newFakeUserRepository(map[uint64]*models.User{1: {ID: 1}, 2: {ID: 2}})
+
+	t.Run("normalizes scopes", func(t *testing.T) {
+		svc := NewPersonalAccessTokenService(newFakePersonalAccessTokenRepository(), users)
+		token, plain, err := svc.Create(ctx, 1, " bot ", []string{"Marketplace:Read", "marketplace:read", "wallet:*"}, "")
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		if plain == "" || token.Name != "bot" {
+			t.Errorf("Create() = %+v, %q", token, plain)
+		}
+		if len(token.Scopes) != 2 || token.Scopes[0] != "marketplace:read" || token.Scopes[1] != "wallet:*" {
+			t.Errorf("Scopes = %v, want [marketplace:read wallet:*]", token.Scopes)
+		}
+	})
+
+	t.Run("rejects invalid scopes", func(t *testing.T) {
+		svc := NewPersonalAccessTokenService(newFakePersonalAccessTokenRepository(), users)
+		for _, scopes := range [][]string{nil, {"*"}, {"profile:read"}, {"wallet:delete"}} {
+			if _, _, err := svc.Create(ctx, 1, "bot", scopes, ""); !errors.Is(err, ErrPersonalAccessTokenScopeInvalid) {
+				t.Errorf("Create(%v) error = %v, want ErrPersonalAccessTokenScopeInvalid", scopes, err)
+			}
+		}
+	})
+
+	t.Run("rejects past expiry", func(t *testing.T) {
+		svc := NewPersonalAccessTokenService(newFakePersonalAccessTokenRepository(), users)
+		past := time.Now().Add(-time.Hour).Format(time.RFC3339)
+		if _, _, err := svc.Create(ctx, 1, "bot", []string{"features:read"}, past); !errors.Is(err, ErrPersonalAccessTokenExpiresAt) {
+			t.Errorf("Create() error = %v, want ErrPersonalAccessTokenExpiresAt", err)
+		}
+	})
+
+	t.Run("caps active tokens", func(t *testing.T) {
+		svc := NewPersonalAccessTokenService(newFakePersonalAccessTokenRepository(), users)
+		for i := 0; i < MaxPersonalAccessTokensPerUser; i++ {
+			if _, _, err := svc.Create(ctx, 1, "bot", []string{"features:read"}, ""); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+		}
+		if _, _, err := svc.Create(ctx, 1, "bot", []string{"features:read"}, ""); !errors.Is(err, ErrPersonalAccessTokenLimitExceeded) {
+			t.Errorf("Create() error = %v, want ErrPersonalAccessTokenLimitExceeded", err)
+		}
+	})
+}
+
+func TestPersonalAccessTokenService_Revoke(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakePersonalAccessTokenRepository()
+	svc := NewPersonalAccessTokenService(repo, newFakeUserRepository(map[uint64]*models.User{1: {ID: 1}}))
+
+	token, _, err := svc.Create(ctx, 1, "bot", []string{"features:read"}, "")
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if err := svc.Revoke(ctx, 2, token.ID); !errors.Is(err, ErrPersonalAccessTokenNotFound) {
+		t.Errorf("Revoke() by another user error = %v, want ErrPersonalAccessTokenNotFound", err)
+	}
+	if err := svc.Revoke(ctx, 1, token.ID); err != nil {
+		t.Errorf("Revoke() error = %v", err)
+	}
+	if _, ok := repo.tokens[token.ID]; ok {
+		t.Error("token still stored after Revoke()")
+	}
+}
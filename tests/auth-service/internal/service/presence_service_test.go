@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/repository"
+)
+
+type fakePresenceRepository struct {
+	presence map[uint64]*models.Presence
+	err      error
+	saved    map[uint64]time.Time
+}
+
+func (r *fakePresenceRepository) GetPresence(_ context.Context, userIDs []uint64) (map[uint64]*models.Presence, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	result := make(map[uint64]*models.Presence)
+	for _, id := range userIDs {
+		if p, ok := r.presence[id]; ok {
+			result[id] = p
+		}
+	}
+	return result, nil
+}
+
+func (r *fakePresenceRepository) SaveLastSeen(_ context.Context, userID uint64, at time.Time) error {
+	if r.saved == nil {
+		r.saved = make(map[uint64]time.Time)
+	}
+	r.saved[userID] = at
+	return nil
+}
+
+func (r *fakePresenceRepository) Listen(context.Context, func(*models.PresenceEvent)) error {
+	return nil
+}
+
+func TestPresenceService_GetPresence(t *testing.T) {
+	seen := time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC)
+	repo := &fakePresenceRepository{presence: map[uint64]*models.Presence{
+		1: {UserID: 1, Online: true, LastSeen: sql.NullTime{Time: seen, Valid: true}},
+		2: {UserID: 2, LastSeen: sql.NullTime{Time: seen, Valid: true}, Hidden: true},
+	}}
+	svc := NewPresenceService(repo)
+
+	result, err := svc.GetPresence(context.Background(), []uint64{2, 3, 1, 2})
+	if err != nil {
+		t.Fatalf("GetPresence failed: %v", err)
+	}
+	if len(result) != 2 || result[0].UserID != 2 || result[1].UserID != 1 {
+		t.Fatalf("expected users 2 and 1 in request order, got %+v", result)
+	}
+	if !result[1].Online {
+		t.Error("expected user 1 to be online")
+	}
+
+	if _, err := svc.GetPresence(context.Background(), make([]uint64, MaxPresenceUserIDs+1)); !errors.Is(err, ErrTooManyPresenceUserIDs) {
+		t.Errorf("expected ErrTooManyPresenceUserIDs, got %v", err)
+	}
+}
+
+func TestPresenceRecorder_Record(t *testing.T) {
+	repo := &fakePresenceRepository{}
+	recorder := NewPresenceRecorder(repo, 0)
+	seen := time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC)
+
+	recorder.Record(context.Background(), &models.PresenceEvent{UserID: 1, Online: true, LastSeen: seen})
+	if len(repo.saved) != 0 {
+		t.Fatalf("expected online events not to be saved, got %v", repo.saved)
+	}
+
+	recorder.Record(context.Background(), &models.PresenceEvent{UserID: 1, LastSeen: seen})
+	if !repo.saved[1].Equal(seen) {
+		t.Errorf("expected last seen %v, got %v", seen, repo.saved[1])
+	}
+}
+
+type presenceSearchRepository struct {
+	repository.SearchRepository
+	results []*repository.SearchUserResult
+}
+
+func (r *presenceSearchRepository) SearchUsers(context.Context, string) ([]*repository.SearchUserResult, error) {
+	return r.results, nil
+}
+
+func TestSearchService_SearchUsersWithPresence(t *testing.T) {
+	searchRepo := &presenceSearchRepository{results: []*repository.SearchUserResult{
+		{User: &models.User{ID: 1, Code: "hm-1"}},
+		{User: &models.User{ID: 2, Code: "hm-2"}},
+	}}
+	presenceRepo := &fakePresenceRepository{presence: map[uint64]*models.Presence{
+		1: {UserID: 1, Online: true},
+		2: {UserID: 2, Online: true, Hidden: true},
+	}}
+
+	results, err := NewSearchServiceWithPresence(searchRepo, NewPresenceService(presenceRepo)).SearchUsers(context.Background(), "hm")
+	if err != nil {
+		t.Fatalf("SearchUsers failed: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if results[0].Presence == nil || !results[0].Presence.Online {
+		t.Error("expected user 1 to be shown online")
+	}
+	if results[1].Presence != nil {
+		t.Error("expected the presence of user 2 to be hidden")
+	}
+
+	presenceRepo.err = errors.New("redis unavailable")
+	results, err = NewSearchServiceWithPresence(searchRepo, NewPresenceService(presenceRepo)).SearchUsers(context.Background(), "hm")
+	if err != nil || len(results) != 2 || results[0].Presence != nil {
+		t.Errorf("expected results without presence when Redis fails, got %v, %v", results, err)
+	}
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"metargb/auth-service/internal/models"
+	"metargb/auth-service/internal/pubsub"
+	storagepb "metargb/shared/pb/storage"
+)
+
+type fakePhotoUploadRepository struct {
+	pending []*models.ProfilePhotoUpload
+	ready   map[uint64]uint64
+	failed  map[uint64]string
+}
+
+func newFakePhotoUploadRepository(uploads ...*models.ProfilePhotoUpload) *fakePhotoUploadRepository {
+	return &fakePhotoUploadRepository{pending: uploads, ready: make(map[uint64]uint64), failed: make(map[uint64]string)}
+}
+
+func (f *fakePhotoUploadRepository) Create(ctx context.Context, upload *models.ProfilePhotoUpload) error {
+	upload.ID = uint64(len(f.pending) + 1)
+	upload.Status = models.PhotoUploadProcessing
+	f.pending = append(f.pending, upload)
+	return nil
+}
+
+func (f *fakePhotoUploadRepository) FindByID(ctx context.Context, id uint64) (*models.ProfilePhotoUpload, error) {
+	for _, upload := range f.pending {
+		if upload.ID == id {
+			return upload, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakePhotoUploadRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*models.ProfilePhotoUpload, error) {
+	var claimed []*models.ProfilePhotoUpload
+	for _, upload := range f.pending {
+		if upload.Status == models.PhotoUploadProcessing && len(claimed) < limit {
+			upload.Attempts++
+			claimed = append(claimed, upload)
+		}
+	}
+	return claimed, nil
+}
+
+func (f *fakePhotoUploadRepository) MarkReady(ctx context.Context, id, imageID uint64, url string) error {
+	f.ready[id] = imageID
+	for _, upload := range f.pending {
+		if upload.ID == id {
+			upload.Status = models.PhotoUploadReady
+		}
+	}
+	return nil
+}
+
+func (f *fakePhotoUploadRepository) MarkFailed(ctx context.Context, id uint64, reason string) error {
+	f.failed[id] = reason
+	for _, upload := range f.pending {
+		if upload.ID == id {
+			upload.Status = models.PhotoUploadFailed
+		}
+	}
+	return nil
+}
+
+type fakePhotoRecordService struct {
+	ProfilePhotoService
+	created []string
+}
+
+func (f *fakePhotoRecordService) CreateProfilePhotoRecord(ctx context.Context, userID uint64, url string) (*models.Image, error) {
+	f.created = append(f.created, url)
+	return &models.Image{ID: uint64(300 + len(f.created)), URL: "https://api.example.com/" + url}, nil
+}
+
+type fakeChunkStorageClient struct {
+	storagepb.FileStorageServiceClient
+	err      error
+	uploaded [][]byte
+}
+
+func (f *fakeChunkStorageClient) ChunkUpload(ctx context.Context, req *storagepb.ChunkUploadRequest, opts ...grpc.CallOption) (*storagepb.ChunkUploadResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.uploaded = append(f.uploaded, req.ChunkData)
+	return &storagepb.ChunkUploadResponse{Success: true, IsFinished: true, FileUrl: "uploads/image-png/2024-01-01/", FilePath: req.Filename}, nil
+}
+
+type fakePhotoStatusPublisher struct {
+	events []pubsub.ProfilePhotoStatusEvent
+}
+
+func (f *fakePhotoStatusPublisher) PublishProfilePhotoStatus(ctx context.Context, event pubsub.ProfilePhotoStatusEvent) error {
+	f.events = append(f.events, event)
+	return nil
+}
+
+func testPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 200, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("failed to encode test image: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestProfilePhotoProcessor_ResizesAndMarksReady(t *testing.T) {
+	repo := newFakePhotoUploadRepository(&models.ProfilePhotoUpload{
+		ID: 1, UserID: 7, Status: models.PhotoUploadProcessing,
+		Filename: "a.png", ContentType: "image/png", ImageData: testPNG(t, 1024, 768),
+	})
+	storage := &fakeChunkStorageClient{}
+	photos := &fakePhotoRecordService{}
+	publisher := &fakePhotoStatusPublisher{}
+	processor := NewProfilePhotoProcessor(repo, photos, storage, publisher, nil, 0)
+
+	finished, err := processor.ProcessPending(context.Background())
+	if err != nil || finished != 1 {
+		t.Fatalf("ProcessPending = (%d, %v), want (1, nil)", finished, err)
+	}
+	if repo.ready[1] != 301 {
+		t.Errorf("upload linked to image %d, want 301", repo.ready[1])
+	}
+	if len(photos.created) != 1 || photos.created[0] != "uploads/image-png/2024-01-01/a.png" {
+		t.Errorf("created records %v", photos.created)
+	}
+
+	cfg, err := png.DecodeConfig(bytes.NewReader(storage.uploaded[0]))
+	if err != nil {
+		t.Fatalf("stored image is not a PNG: %v", err)
+	}
+	if cfg.Width != 512 || cfg.Height != 384 {
+		t.Errorf("stored image is %dx%d, want 512x384", cfg.Width, cfg.Height)
+	}
+
+	if len(publisher.events) != 1 || publisher.events[0].Status != models.PhotoUploadReady || publisher.events[0].ProfilePhotoID != 301 {
+		t.Errorf("published %+v, want one ready event for photo 301", publisher.events)
+	}
+}
+
+func TestProfilePhotoProcessor_RejectsUnusableImages(t *testing.T) {
+	repo := newFakePhotoUploadRepository(
+		&models.ProfilePhotoUpload{ID: 1, UserID: 7, Status: models.PhotoUploadProcessing, Filename: "small.png", ContentType: "image/png", ImageData: testPNG(t, 40, 40)},
+		&models.ProfilePhotoUpload{ID: 2, UserID: 7, Status: models.PhotoUploadProcessing, Filename: "fake.png", ContentType: "image/png", ImageData: []byte("not an image")},
+	)
+	storage := &fakeChunkStorageClient{}
+	publisher := &fakePhotoStatusPublisher{}
+	processor := NewProfilePhotoProcessor(repo, &fakePhotoRecordService{}, storage, publisher, nil, 0)
+
+	if finished, err := processor.ProcessPending(context.Background()); err != nil || finished != 2 {
+		t.Fatalf("ProcessPending = (%d, %v), want (2, nil)", finished, err)
+	}
+	if repo.failed[1] != errPhotoTooSmall.Error() || repo.failed[2] != errPhotoUndecodable.Error() {
+		t.Errorf("failure reasons = %v", repo.failed)
+	}
+	if len(storage.uploaded) != 0 {
+		t.Errorf("rejected images were sent to storage")
+	}
+	if len(publisher.events) != 2 || publisher.events[0].Status != models.PhotoUploadFailed {
+		t.Errorf("published %+v, want two failed events", publisher.events)
+	}
+}
+
+func TestProfilePhotoProcessor_RetriesStorageFailures(t *testing.T) {
+	repo := newFakePhotoUploadRepository(&models.ProfilePhotoUpload{
+		ID: 1, UserID: 7, Status: models.PhotoUploadProcessing,
+		Filename: "a.png", ContentType: "image/png", ImageData: testPNG(t, 200, 200),
+	})
+	storage := &fakeChunkStorageClient{err: errors.New("connection refused")}
+	processor := NewProfilePhotoProcessor(repo, &fakePhotoRecordService{}, storage, nil, nil, 0)
+
+	for attempt := 1; attempt < maxProfilePhotoAttempts; attempt++ {
+		if finished, _ := processor.ProcessPending(context.Background()); finished != 0 {
+			t.Fatalf("attempt %d finished the upload, want it retried", attempt)
+		}
+	}
+	if finished, _ := processor.ProcessPending(context.Background()); finished != 1 {
+		t.Fatalf("last attempt did not finish the upload")
+	}
+	if repo.failed[1] != "upload to storage failed" {
+		t.Errorf("failure reason = %q", repo.failed[1])
+	}
+}
+
+func TestProfilePhotoUploadService_HidesOtherUsersUploads(t *testing.T) {
+	repo := newFakePhotoUploadRepository()
+	svc := NewProfilePhotoUploadService(repo)
+
+	upload, err := svc.QueueUpload(context.Background(), 7, testPNG(t, 200, 200), "a.PNG", "Image/PNG")
+	if err != nil {
+		t.Fatalf("QueueUpload returned error: %v", err)
+	}
+	if upload.Status != models.PhotoUploadProcessing || upload.ContentType != "image/png" {
+		t.Errorf("queued upload %+v", upload)
+	}
+
+	if _, err := svc.QueueUpload(context.Background(), 7, []byte("x"), "a.gif", "image/gif"); err != ErrInvalidImage {
+		t.Errorf("QueueUpload(gif) error = %v, want ErrInvalidImage", err)
+	}
+	if _, err := svc.GetUploadStatus(context.Background(), 7, upload.ID); err != nil {
+		t.Errorf("GetUploadStatus(owner) error = %v", err)
+	}
+	if _, err := svc.GetUploadStatus(context.Background(), 8, upload.ID); err != ErrPhotoUploadNotFound {
+		t.Errorf("GetUploadStatus(other user) error = %v, want ErrPhotoUploadNotFound", err)
+	}
+}
@@ -377,3 +377,48 @@ func TestSettingsService_UpdatePrivacySettings(t *testing.T) {
 		}
 	})
 }
+
+func TestSettingsService_UpdateTimezone(t *testing.T) {
+	mockRepo := &mockSettingsRepository{}
+	service := NewSettingsService(mockRepo)
+	ctx := context.Background()
+
+	t.Run("stores a valid timezone", func(t *testing.T) {
+		var saved string
+		mockRepo.updateFunc = func(_ context.Context, settings *models.Settings) error {
+			saved = settings.Timezone
+			return nil
+		}
+		if err := service.UpdateTimezone(ctx, 1, "Europe/Berlin"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if saved != "Europe/Berlin" {
+			t.Errorf("expected Europe/Berlin to be saved, got %q", saved)
+		}
+	})
+
+	t.Run("rejects unknown timezones", func(t *testing.T) {
+		for _, timezone := range []string{"", "Local", "Mars/Olympus"} {
+			if err := service.UpdateTimezone(ctx, 1, timezone); err != ErrInvalidTimezone {
+				t.Errorf("UpdateTimezone(%q): expected ErrInvalidTimezone, got %v", timezone, err)
+			}
+		}
+	})
+
+	t.Run("creates settings if not exists", func(t *testing.T) {
+		mockRepo.findByUserIDFunc = func(context.Context, uint64) (*models.Settings, error) {
+			return &models.Settings{UserID: 1}, nil
+		}
+		var created string
+		mockRepo.createFunc = func(_ context.Context, settings *models.Settings) error {
+			created = settings.Timezone
+			return nil
+		}
+		if err := service.UpdateTimezone(ctx, 1, "America/Toronto"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if created != "America/Toronto" {
+			t.Errorf("expected settings created with America/Toronto, got %q", created)
+		}
+	})
+}
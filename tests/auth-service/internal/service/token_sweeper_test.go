@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTokenSweeper_Sweep(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	tokenRepo := newFakeTokenRepository()
+
+	var sweptAt time.Time
+	tokenRepo.deleteIdleTokensFunc = func(_ context.Context, at time.Time) (int64, error) {
+		sweptAt = at
+		return 3, nil
+	}
+
+	sweeper := NewTokenSweeper(tokenRepo, 0)
+	sweeper.now = func() time.Time { return now }
+
+	deleted, err := sweeper.Sweep(context.Background())
+	if err != nil {
+		t.Fatalf("Sweep failed: %v", err)
+	}
+	if deleted != 3 {
+		t.Errorf("expected 3 deleted tokens, got %d", deleted)
+	}
+	if !sweptAt.Equal(now) {
+		t.Errorf("expected sweep at %v, got %v", now, sweptAt)
+	}
+	if sweeper.interval != DefaultTokenSweepInterval {
+		t.Errorf("expected default interval, got %v", sweeper.interval)
+	}
+}
+
+func TestTokenSweeper_SweepError(t *testing.T) {
+	tokenRepo := newFakeTokenRepository()
+	tokenRepo.deleteIdleTokensFunc = func(context.Context, time.Time) (int64, error) {
+		return 0, errors.New("database unavailable")
+	}
+
+	if _, err := NewTokenSweeper(tokenRepo, time.Minute).Sweep(context.Background()); err == nil {
+		t.Error("expected sweep error")
+	}
+}
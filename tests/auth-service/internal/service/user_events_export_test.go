@@ -0,0 +1,104 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"metargb/auth-service/internal/models"
+)
+
+func newExportTestEvents() *fakeActivityRepository {
+	repo := newFakeActivityRepository()
+	repo.events = []*models.UserEvent{
+		{ID: 1, UserID: 7, Event: "ورود به حساب کاربری", IP: "10.0.0.1", Device: "Chrome", Status: 1, CreatedAt: time.Date(2025, 1, 1, 8, 0, 0, 0, time.Local)},
+		{ID: 2, UserID: 8, Event: "ورود به حساب کاربری", IP: "10.0.0.2", Device: "Firefox", Status: 0, CreatedAt: time.Date(2025, 1, 15, 9, 30, 0, 0, time.Local)},
+		{ID: 3, UserID: 7, Event: "خروج از حساب کاربری", IP: "10.0.0.1", Device: "Chrome, Windows", Status: 1, CreatedAt: time.Date(2025, 1, 31, 23, 0, 0, 0, time.Local)},
+		{ID: 4, UserID: 7, Event: "ورود به حساب کاربری", IP: "10.0.0.3", Device: "Safari", Status: 1, CreatedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, time.Local)},
+	}
+	return repo
+}
+
+func TestUserEventsService_ExportUserEvents(t *testing.T) {
+	ctx := context.Background()
+	svc := NewUserEventsService(newExportTestEvents(), newFakeUserRepository(nil), []uint64{99})
+
+	t.Run("user exports own events in date range", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := svc.ExportUserEvents(ctx, 7, 0, "2025-01-01", "2025-01-31", &buf); err != nil {
+			t.Fatalf("ExportUserEvents failed: %v", err)
+		}
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		if len(lines) != 3 {
+			t.Fatalf("expected header and 2 rows, got %d lines:\n%s", len(lines), buf.String())
+		}
+		if lines[0] != "id,user_id,event,ip,device,status,created_at" {
+			t.Errorf("unexpected header %q", lines[0])
+		}
+		if lines[1] != "1,7,ورود به حساب کاربری,10.0.0.1,Chrome,1,2025-01-01 08:00:00" {
+			t.Errorf("unexpected row %q", lines[1])
+		}
+		if !strings.HasPrefix(lines[2], `3,7,خروج از حساب کاربری,10.0.0.1,"Chrome, Windows",1,`) {
+			t.Errorf("expected quoted device in row %q", lines[2])
+		}
+	})
+
+	t.Run("user cannot export other users", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := svc.ExportUserEvents(ctx, 7, 8, "", "", &buf)
+		if !errors.Is(err, ErrUserEventExportDenied) {
+			t.Errorf("expected ErrUserEventExportDenied, got %v", err)
+		}
+		if buf.Len() != 0 {
+			t.Error("expected nothing to be written")
+		}
+	})
+
+	t.Run("admin exports every user", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := svc.ExportUserEvents(ctx, 99, 0, "", "", &buf); err != nil {
+			t.Fatalf("ExportUserEvents failed: %v", err)
+		}
+		if lines := strings.Count(buf.String(), "\n"); lines != 5 {
+			t.Errorf("expected header and 4 rows, got %d lines", lines)
+		}
+	})
+
+	t.Run("invalid dates", func(t *testing.T) {
+		for _, dates := range [][2]string{{"1403/10/01", ""}, {"", "2025-13-01"}, {"2025-02-01", "2025-01-01"}} {
+			err := svc.ExportUserEvents(ctx, 7, 0, dates[0], dates[1], &bytes.Buffer{})
+			if !errors.Is(err, ErrInvalidExportDateRange) {
+				t.Errorf("expected ErrInvalidExportDateRange for %v, got %v", dates, err)
+			}
+		}
+	})
+}
+
+func TestUserEventPurger_Purge(t *testing.T) {
+	repo := newExportTestEvents()
+	purger := NewUserEventPurger(repo, 1, 0)
+	purger.now = func() time.Time { return time.Date(2025, 2, 20, 0, 0, 0, 0, time.Local) }
+
+	deleted, err := purger.Purge(context.Background())
+	if err != nil {
+		t.Fatalf("Purge failed: %v", err)
+	}
+	if deleted != 2 {
+		t.Errorf("expected 2 purged events, got %d", deleted)
+	}
+	if len(repo.events) != 2 || repo.events[0].ID != 3 {
+		t.Errorf("expected events from 2025-01-20 on to be kept, got %d events", len(repo.events))
+	}
+	if purger.interval != DefaultUserEventPurgeInterval {
+		t.Errorf("expected default interval, got %v", purger.interval)
+	}
+
+	disabled := NewUserEventPurger(repo, 0, time.Hour)
+	if deleted, err := disabled.Purge(context.Background()); err != nil || deleted != 0 {
+		t.Errorf("expected disabled purger to delete nothing, got %d, %v", deleted, err)
+	}
+}
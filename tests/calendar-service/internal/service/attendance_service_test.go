@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"metargb/calendar-service/internal/models"
+	"metargb/shared/pkg/logger"
+	"metargb/shared/pkg/tz"
+)
+
+type fakeEventFinder struct {
+	events map[uint64]*models.Calendar
+}
+
+func (f *fakeEventFinder) GetEventByID(ctx context.Context, id uint64) (*models.Calendar, error) {
+	return f.events[id], nil
+}
+
+type fakeRsvpRepository struct {
+	rsvps     map[[2]uint64]*models.Rsvp
+	reminders []*models.EventReminder
+	reminded  []uint64
+}
+
+func (f *fakeRsvpRepository) UpsertRsvp(ctx context.Context, rsvp *models.Rsvp) error {
+	if f.rsvps == nil {
+		f.rsvps = make(map[[2]uint64]*models.Rsvp)
+	}
+	f.rsvps[[2]uint64{rsvp.CalendarID, rsvp.UserID}] = rsvp
+	return nil
+}
+
+func (f *fakeRsvpRepository) GetRsvp(ctx context.Context, eventID, userID uint64) (*models.Rsvp, error) {
+	return f.rsvps[[2]uint64{eventID, userID}], nil
+}
+
+func (f *fakeRsvpRepository) CountRsvps(ctx context.Context, eventID uint64) (*models.RsvpCounts, error) {
+	counts := &models.RsvpCounts{}
+	for key, rsvp := range f.rsvps {
+		if key[0] != eventID {
+			continue
+		}
+		switch rsvp.Status {
+		case models.RsvpGoing:
+			counts.Going++
+		case models.RsvpMaybe:
+			counts.Maybe++
+		case models.RsvpDeclined:
+			counts.Declined++
+		}
+	}
+	return counts, nil
+}
+
+func (f *fakeRsvpRepository) ListAttendees(ctx context.Context, eventID uint64, status string, page, perPage int32) ([]*models.Attendee, int32, error) {
+	var attendees []*models.Attendee
+	for key, rsvp := range f.rsvps {
+		if key[0] == eventID && rsvp.Status == status {
+			attendees = append(attendees, &models.Attendee{UserID: rsvp.UserID, Status: rsvp.Status})
+		}
+	}
+	return attendees, int32(len(attendees)), nil
+}
+
+func (f *fakeRsvpRepository) ListDueReminders(ctx context.Context, limit int) ([]*models.EventReminder, error) {
+	return f.reminders, nil
+}
+
+func (f *fakeRsvpRepository) MarkReminded(ctx context.Context, rsvpID uint64) error {
+	f.reminded = append(f.reminded, rsvpID)
+	return nil
+}
+
+type fakeReminderNotifier struct {
+	sent []uint64
+	fail map[uint64]bool
+	data map[uint64]map[string]string
+}
+
+func (f *fakeReminderNotifier) SendNotification(ctx context.Context, userID uint64, notificationType, title, message string, data map[string]string) error {
+	if f.fail[userID] {
+		return errors.New("unavailable")
+	}
+	f.sent = append(f.sent, userID)
+	if f.data == nil {
+		f.data = make(map[uint64]map[string]string)
+	}
+	f.data[userID] = data
+	return nil
+}
+
+type fakeUserLocations map[uint64]string
+
+func (f fakeUserLocations) Location(ctx context.Context, userID uint64) *time.Location {
+	return tz.Load(f[userID])
+}
+
+func newTestAttendanceService(rsvps *fakeRsvpRepository, now time.Time) *AttendanceService {
+	events := &fakeEventFinder{events: map[uint64]*models.Calendar{
+		1: {ID: 1, StartsAt: now.Add(24 * time.Hour)},
+		2: {ID: 2, StartsAt: now.Add(-time.Hour)},
+		3: {ID: 3, IsVersion: true, StartsAt: now.Add(time.Hour)},
+	}}
+	svc := NewAttendanceService(events, rsvps).(*AttendanceService)
+	svc.now = func() time.Time { return now }
+	return svc
+}
+
+func TestAttendanceService_RespondToEvent(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	rsvps := &fakeRsvpRepository{}
+	svc := newTestAttendanceService(rsvps, now)
+	ctx := context.Background()
+
+	summary, err := svc.RespondToEvent(ctx, 1, 7, models.RsvpGoing, 30)
+	if err != nil {
+		t.Fatalf("RespondToEvent failed: %v", err)
+	}
+	if summary.Rsvp == nil || summary.Rsvp.Status != models.RsvpGoing || summary.Counts.Going != 1 {
+		t.Errorf("unexpected summary: %+v", summary)
+	}
+	if remindAt := summary.RemindAt(); remindAt == nil || !remindAt.Equal(now.Add(24*time.Hour-30*time.Minute)) {
+		t.Errorf("expected a reminder 30 minutes before the event, got %v", remindAt)
+	}
+
+	summary, err = svc.RespondToEvent(ctx, 1, 7, models.RsvpDeclined, 30)
+	if err != nil {
+		t.Fatalf("RespondToEvent failed: %v", err)
+	}
+	if summary.Rsvp.RemindMinutes != 0 || summary.RemindAt() != nil || summary.Counts.Declined != 1 || summary.Counts.Going != 0 {
+		t.Errorf("expected declining to drop the reminder, got %+v", summary)
+	}
+
+	tests := []struct {
+		name    string
+		eventID uint64
+		status  string
+		remind  int32
+		want    error
+	}{
+		{"unknown status", 1, "yes", 0, ErrRsvpInvalidStatus},
+		{"negative reminder", 1, models.RsvpGoing, -1, ErrRsvpInvalidReminder},
+		{"reminder too early", 1, models.RsvpGoing, MaxRemindMinutes + 1, ErrRsvpInvalidReminder},
+		{"missing event", 9, models.RsvpGoing, 0, ErrEventNotFound},
+		{"started event", 2, models.RsvpGoing, 0, ErrRsvpEventStarted},
+		{"version", 3, models.RsvpMaybe, 0, ErrRsvpNotAnEvent},
+	}
+	for _, tt := range tests {
+		if _, err := svc.RespondToEvent(ctx, tt.eventID, 8, tt.status, tt.remind); !errors.Is(err, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+}
+
+func TestAttendanceService_ListAttendees(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	rsvps := &fakeRsvpRepository{}
+	svc := newTestAttendanceService(rsvps, now)
+	ctx := context.Background()
+
+	for userID, status := range map[uint64]string{7: models.RsvpGoing, 8: models.RsvpGoing, 9: models.RsvpMaybe} {
+		if _, err := svc.RespondToEvent(ctx, 1, userID, status, 0); err != nil {
+			t.Fatalf("RespondToEvent failed: %v", err)
+		}
+	}
+
+	attendees, total, err := svc.ListAttendees(ctx, 1, "", 1, 10)
+	if err != nil {
+		t.Fatalf("ListAttendees failed: %v", err)
+	}
+	if total != 2 || len(attendees) != 2 {
+		t.Errorf("expected the 2 going users by default, got %d", total)
+	}
+
+	if _, _, err := svc.ListAttendees(ctx, 1, "all", 1, 10); !errors.Is(err, ErrRsvpInvalidStatus) {
+		t.Errorf("expected ErrRsvpInvalidStatus, got %v", err)
+	}
+	if _, _, err := svc.ListAttendees(ctx, 9, "", 1, 10); !errors.Is(err, ErrEventNotFound) {
+		t.Errorf("expected ErrEventNotFound, got %v", err)
+	}
+}
+
+func TestEventReminderWorker_Run(t *testing.T) {
+	repo := &fakeRsvpRepository{reminders: []*models.EventReminder{
+		{RsvpID: 1, UserID: 7, EventID: 1, Title: "launch", StartsAt: time.Now().Add(time.Hour)},
+		{RsvpID: 2, UserID: 8, EventID: 1, Title: "launch", StartsAt: time.Now().Add(time.Hour)},
+	}}
+	notifier := &fakeReminderNotifier{fail: map[uint64]bool{8: true}}
+	worker := NewEventReminderWorker(repo, notifier, 0, logger.NewLogger("test"))
+
+	sent, err := worker.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if sent != 1 || len(notifier.sent) != 1 || notifier.sent[0] != 7 {
+		t.Errorf("expected one reminder for user 7, got %d: %v", sent, notifier.sent)
+	}
+	if len(repo.reminded) != 1 || repo.reminded[0] != 1 {
+		t.Errorf("expected only the delivered reminder to be marked, got %v", repo.reminded)
+	}
+	if worker.interval != DefaultEventReminderInterval {
+		t.Errorf("expected default interval, got %v", worker.interval)
+	}
+}
+
+func TestEventReminderWorker_UsesUserTimezone(t *testing.T) {
+	// 2026-10-01 12:00 UTC is 14:00 in Berlin and 15:30 in Tehran
+	startsAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	repo := &fakeRsvpRepository{reminders: []*models.EventReminder{
+		{RsvpID: 1, UserID: 7, EventID: 1, Title: "launch", StartsAt: startsAt},
+		{RsvpID: 2, UserID: 8, EventID: 1, Title: "launch", StartsAt: startsAt},
+	}}
+	notifier := &fakeReminderNotifier{}
+	worker := NewEventReminderWorker(repo, notifier, 0, logger.NewLogger("test"))
+	worker.SetLocations(fakeUserLocations{7: "Europe/Berlin"})
+
+	if _, err := worker.Run(context.Background()); err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if got := notifier.data[7]; !strings.HasSuffix(got["starts_at"], " 14:00") || got["timezone"] != "Europe/Berlin" {
+		t.Errorf("expected the start in Berlin time, got %v", got)
+	}
+	if got := notifier.data[8]; !strings.HasSuffix(got["starts_at"], " 15:30") || got["timezone"] != tz.Default {
+		t.Errorf("expected the start in the default timezone, got %v", got)
+	}
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"metargb/calendar-service/internal/models"
+	"metargb/shared/pkg/logger"
+)
+
+func findOccasion(occasions []*models.Occasion, title string) *models.Occasion {
+	for _, occasion := range occasions {
+		if occasion.Title == title {
+			return occasion
+		}
+	}
+	return nil
+}
+
+func TestOccasionService_GetOccasions(t *testing.T) {
+	svc, err := NewOccasionService("", 0, logger.NewLogger("test"))
+	if err != nil {
+		t.Fatalf("NewOccasionService failed: %v", err)
+	}
+	ctx := context.Background()
+
+	occasions, err := svc.GetOccasions(ctx, 1404, 1)
+	if err != nil {
+		t.Fatalf("GetOccasions failed: %v", err)
+	}
+	nowruz := findOccasion(occasions, "جشن نوروز / جشن سال نو")
+	if nowruz == nil || !nowruz.IsHoliday || nowruz.JalaliDay != 1 || nowruz.Type != models.OccasionSolar {
+		t.Fatalf("expected Nowruz on Farvardin 1, got %+v", nowruz)
+	}
+	if got := nowruz.Date.Format("2006-01-02"); got != "2025-03-21" {
+		t.Errorf("expected Nowruz 1404 on 2025-03-21, got %s", got)
+	}
+	for i := 1; i < len(occasions); i++ {
+		if occasions[i].Date.Before(occasions[i-1].Date) {
+			t.Errorf("expected occasions sorted by date")
+		}
+	}
+
+	// Tasua and Ashura 1447 fell on 1404/04/14 and 1404/04/15
+	occasions, err = svc.GetOccasions(ctx, 1404, 4)
+	if err != nil {
+		t.Fatalf("GetOccasions failed: %v", err)
+	}
+	ashura := findOccasion(occasions, "عاشورای حسینی")
+	if ashura == nil || !ashura.Approximate || ashura.Type != models.OccasionLunar {
+		t.Fatalf("expected a computed Ashura in Tir 1404, got %+v", ashura)
+	}
+	if ashura.JalaliDay < 14 || ashura.JalaliDay > 16 {
+		t.Errorf("expected Ashura within a day of Tir 15, got Tir %d", ashura.JalaliDay)
+	}
+
+	year, err := svc.GetOccasions(ctx, 1404, 0)
+	if err != nil {
+		t.Fatalf("GetOccasions failed: %v", err)
+	}
+	if len(year) < 30 {
+		t.Errorf("expected every occasion of the year, got %d", len(year))
+	}
+	oil := findOccasion(year, "روز ملی شدن صنعت نفت ایران")
+	if oil == nil || oil.JalaliMonth != 12 || oil.Date.Format("2006-01-02") != "2026-03-20" {
+		t.Errorf("expected the oil nationalization day on 2026-03-20, got %+v", oil)
+	}
+
+	if _, err := svc.GetOccasions(ctx, 1404, 13); !errors.Is(err, ErrOccasionInvalidMonth) {
+		t.Errorf("expected ErrOccasionInvalidMonth, got %v", err)
+	}
+	if _, err := svc.GetOccasions(ctx, 404, 1); !errors.Is(err, ErrOccasionInvalidYear) {
+		t.Errorf("expected ErrOccasionInvalidYear, got %v", err)
+	}
+}
+
+func TestOccasionService_Refresh(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "occasions.json")
+	dataset := `{
+		"solar": [{"month": 1, "day": 1, "title": "nowruz", "is_holiday": true}],
+		"lunar": [{"key": "ashura", "month": 1, "day": 10, "title": "ashura", "is_holiday": true}],
+		"lunar_dates": {"1447": {"ashura": "1404/04/15"}}
+	}`
+	if err := os.WriteFile(source, []byte(dataset), 0o644); err != nil {
+		t.Fatalf("failed to write source: %v", err)
+	}
+
+	svc, err := NewOccasionService(source, 0, logger.NewLogger("test"))
+	if err != nil {
+		t.Fatalf("NewOccasionService failed: %v", err)
+	}
+	ctx := context.Background()
+
+	count, err := svc.Refresh(ctx)
+	if err != nil || count != 2 {
+		t.Fatalf("expected 2 occasions loaded, got %d: %v", count, err)
+	}
+
+	occasions, err := svc.GetOccasions(ctx, 1404, 4)
+	if err != nil {
+		t.Fatalf("GetOccasions failed: %v", err)
+	}
+	if len(occasions) != 1 || occasions[0].Approximate || occasions[0].JalaliDay != 15 {
+		t.Fatalf("expected only the official Ashura date, got %+v", occasions)
+	}
+
+	// A broken source keeps the last good dataset
+	if err := os.WriteFile(source, []byte(`{"solar": [{"month": 13, "day": 1, "title": "x"}]}`), 0o644); err != nil {
+		t.Fatalf("failed to write source: %v", err)
+	}
+	if _, err := svc.Refresh(ctx); err == nil {
+		t.Fatal("expected an invalid dataset to fail")
+	}
+	occasions, err = svc.GetOccasions(ctx, 1404, 1)
+	if err != nil || len(occasions) != 1 || occasions[0].Title != "nowruz" {
+		t.Errorf("expected the previous dataset to stay in use, got %+v: %v", occasions, err)
+	}
+}
@@ -0,0 +1,181 @@
+package contract
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"metargb/grpc-gateway/internal/handler"
+	"metargb/grpc-gateway/internal/middleware"
+	pb "metargb/shared/pb/auth"
+	notificationpb "metargb/shared/pb/notifications"
+)
+
+const (
+	userID = 7
+	token  = "contract-token"
+)
+
+// gateway wires the handlers under test to the fake services, with the same
+// auth middleware the gateway puts in front of protected routes
+func gateway(t *testing.T) http.Handler {
+	t.Helper()
+
+	authConn := Serve(t, func(s *grpc.Server) {
+		pb.RegisterAuthServiceServer(s, &authService{tokens: map[string]uint64{token: userID}})
+		pb.RegisterUserServiceServer(s, &userService{
+			wallets: map[uint64]*pb.UserWalletResponse{
+				userID: {Psc: "12.4K", Irr: "3.1M", Red: "532", Blue: "1.6K", Yellow: "713", Satisfaction: "87.5", Effect: 12},
+			},
+			featureCounts: map[uint64]*pb.UserFeaturesCountData{
+				userID: {MaskoniFeaturesCount: 5, TejariFeaturesCount: 2},
+			},
+		})
+		pb.RegisterProfilePhotoServiceServer(s, &profilePhotoService{
+			uploads: map[uint64]*pb.PhotoUploadStatusResponse{41: {UploadId: 41, Status: "processing"}},
+			owners:  map[uint64]uint64{41: userID},
+		})
+	})
+	notificationConn := Serve(t, func(s *grpc.Server) {
+		notificationpb.RegisterNotificationServiceServer(s, &notificationService{
+			notifications: map[uint64][]*notificationpb.Notification{
+				userID: {
+					{
+						Id:        "550e8400-e29b-41d4-a716-446655440000",
+						Type:      "transactions",
+						Message:   "مقدار 100 PSC به حساب شما واریز گردید!",
+						Data:      map[string]string{"related-to": "transactions", "sender-name": "متارنگ", "sender-image": "https://example.com/uploads/img/logo.png"},
+						CreatedAt: "1403/09/15 14:30:25",
+					},
+					{
+						Id:        "550e8400-e29b-41d4-a716-446655440001",
+						Type:      "dynasty",
+						Message:   "سلسله شما تاسیس شد.",
+						CreatedAt: "1403/09/14 10:15:30",
+					},
+				},
+			},
+		})
+	})
+
+	authHandler := handler.NewAuthHandler(authConn, "en")
+	notificationHandler := handler.NewNotificationHandler(notificationConn, authConn)
+	requireAuth := middleware.AuthMiddleware(pb.NewAuthServiceClient(authConn))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/users/{user}/wallet", authHandler.GetUserWallet)
+	mux.HandleFunc("GET /api/users/{user}/features/count", authHandler.GetUserFeaturesCount)
+	mux.Handle("GET /api/profilePhotos/uploads/{upload}", requireAuth(http.HandlerFunc(authHandler.GetProfilePhotoStatus)))
+	mux.Handle("GET /api/notifications", requireAuth(http.HandlerFunc(notificationHandler.GetNotifications)))
+	mux.Handle("GET /api/notifications/{notification}", requireAuth(http.HandlerFunc(notificationHandler.GetNotification)))
+	return mux
+}
+
+func TestContracts(t *testing.T) {
+	gw := gateway(t)
+
+	tests := []struct {
+		name    string
+		target  string
+		auth    bool
+		status  int
+		doc     string
+		section string
+		example int
+		// skip names a known difference between the docs and the gateway
+		skip string
+	}{
+		{
+			name:    "user wallet",
+			target:  "/api/users/7/wallet",
+			status:  http.StatusOK,
+			doc:     "auth-service/users_api.md",
+			section: "`GET /api/users/{user}/wallet`",
+			skip:    "gateway returns balances as numbers; the docs show compact strings like \"12.4K\"",
+		},
+		{
+			name:    "user features count",
+			target:  "/api/users/7/features/count",
+			status:  http.StatusOK,
+			doc:     "auth-service/users_api.md",
+			section: "`GET /api/users/{user}/features/count`",
+		},
+		{
+			name:    "profile photo upload status",
+			target:  "/api/profilePhotos/uploads/41",
+			auth:    true,
+			status:  http.StatusOK,
+			doc:     "auth-service/profile_photos_api.md",
+			section: "Asynchronous Processing",
+		},
+		{
+			name:    "unread notifications",
+			target:  "/api/notifications",
+			auth:    true,
+			status:  http.StatusOK,
+			doc:     "notification-service/notifications_api.md",
+			section: "Get Unread Notifications",
+		},
+		{
+			name:    "single notification",
+			target:  "/api/notifications/550e8400-e29b-41d4-a716-446655440000",
+			auth:    true,
+			status:  http.StatusOK,
+			doc:     "notification-service/notifications_api.md",
+			section: "Get Single Notification",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.skip != "" {
+				t.Skip(tt.skip)
+			}
+			example := DocExample(t, tt.doc, tt.section, tt.example)
+
+			var bearer string
+			if tt.auth {
+				bearer = token
+			}
+			code, body := Call(t, gw, http.MethodGet, tt.target, bearer)
+			if code != tt.status {
+				t.Fatalf("status = %d, want %d: %v", code, tt.status, body)
+			}
+			if diffs := ShapeDiff(example, body); len(diffs) > 0 {
+				t.Errorf("response does not match %s %q:\n%s", tt.doc, tt.section, strings.Join(diffs, "\n"))
+			}
+		})
+	}
+}
+
+func TestProtectedRoutesRequireToken(t *testing.T) {
+	gw := gateway(t)
+
+	for _, target := range []string{"/api/notifications", "/api/profilePhotos/uploads/41"} {
+		if code, body := Call(t, gw, http.MethodGet, target, "invalid"); code != http.StatusUnauthorized {
+			t.Errorf("%s with an invalid token: status = %d, want 401: %v", target, code, body)
+		}
+	}
+}
+
+func TestShapeDiff(t *testing.T) {
+	example := map[string]any{
+		"data":  []any{map[string]any{"id": "x", "read_at": nil}},
+		"count": 1.0,
+	}
+	actual := map[string]any{
+		"data":  []any{map[string]any{"id": 5.0, "read_at": "1403/09/15"}},
+		"extra": true,
+	}
+	diffs := ShapeDiff(example, actual)
+	want := []string{
+		"$.count: documented but missing",
+		"$.data[0].id: documented string, got number",
+		"$.extra: not documented",
+	}
+	if strings.Join(diffs, "\n") != strings.Join(want, "\n") {
+		t.Errorf("ShapeDiff =\n%s\nwant\n%s", strings.Join(diffs, "\n"), strings.Join(want, "\n"))
+	}
+}
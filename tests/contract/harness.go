@@ -0,0 +1,212 @@
+// Package contract runs the gateway's HTTP handlers against in-memory gRPC
+// services and checks their JSON against the examples in api-docs. Each fake
+// service keeps its data in maps, so the tests need no database, network or
+// running services; only the gateway side of the contract is real.
+//
+// The module path sits under metargb/grpc-gateway so the tests may import the
+// gateway's internal handler and middleware packages.
+package contract
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Serve starts an in-process gRPC server with the services register adds and
+// returns a connection to it. Both are closed when the test ends.
+func Serve(t testing.TB, register func(*grpc.Server)) *grpc.ClientConn {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	register(server)
+	go server.Serve(listener)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial in-memory server: %v", err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		server.Stop()
+	})
+	return conn
+}
+
+// Call sends a request to handler and returns the status code and the decoded
+// JSON body
+func Call(t testing.TB, handler http.Handler, method, target, token string) (int, any) {
+	t.Helper()
+
+	req := httptest.NewRequest(method, target, nil)
+	req.Header.Set("Accept", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	var body any
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("%s %s returned invalid JSON: %v\n%s", method, target, err, rec.Body.String())
+	}
+	return rec.Code, body
+}
+
+var (
+	commentPattern = regexp.MustCompile(`/\*.*?\*/`)
+	headingPattern = regexp.MustCompile(`^#{1,6}\s`)
+)
+
+// DocExample returns the index-th JSON block below the heading containing
+// section in api-docs/<doc>, decoded. Comments like /* ... */ are removed.
+func DocExample(t testing.TB, doc, section string, index int) any {
+	t.Helper()
+
+	path := filepath.Join(apiDocsDir(), doc)
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("failed to open api doc: %v", err)
+	}
+	defer file.Close()
+
+	var (
+		inSection bool
+		inBlock   bool
+		found     int
+		block     strings.Builder
+	)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case inBlock && line == "```":
+			if found == index {
+				var example any
+				text := commentPattern.ReplaceAllString(block.String(), "")
+				if err := json.Unmarshal([]byte(text), &example); err != nil {
+					t.Fatalf("%s %q example %d is not valid JSON: %v", doc, section, index, err)
+				}
+				return example
+			}
+			found++
+			inBlock = false
+			block.Reset()
+		case inBlock:
+			block.WriteString(line)
+			block.WriteByte('\n')
+		case headingPattern.MatchString(line):
+			if inSection {
+				// The example is not in the first matching section
+				t.Fatalf("%s has no JSON example %d under %q", doc, index, section)
+			}
+			inSection = strings.Contains(line, section)
+		case inSection && line == "```json":
+			inBlock = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("failed to read %s: %v", doc, err)
+	}
+	t.Fatalf("%s has no JSON example %d under %q", doc, index, section)
+	return nil
+}
+
+// apiDocsDir finds api-docs relative to this file, so tests run from any directory
+func apiDocsDir() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "api-docs")
+}
+
+// ShapeDiff compares the shape of actual with a documented example: objects
+// must have exactly the documented keys, and values the documented JSON
+// type. A null in the example allows any type, an empty example array allows
+// any elements, and every actual array element is checked against the first
+// example element.
+func ShapeDiff(example, actual any) []string {
+	var diffs []string
+	shapeDiff("$", example, actual, &diffs)
+	return diffs
+}
+
+func shapeDiff(path string, example, actual any, diffs *[]string) {
+	if example == nil {
+		return
+	}
+	if kindOf(example) != kindOf(actual) {
+		*diffs = append(*diffs, fmt.Sprintf("%s: documented %s, got %s", path, kindOf(example), kindOf(actual)))
+		return
+	}
+
+	switch ev := example.(type) {
+	case map[string]any:
+		av := actual.(map[string]any)
+		for _, key := range sortedKeys(ev) {
+			if _, ok := av[key]; !ok {
+				*diffs = append(*diffs, fmt.Sprintf("%s.%s: documented but missing", path, key))
+				continue
+			}
+			shapeDiff(path+"."+key, ev[key], av[key], diffs)
+		}
+		for _, key := range sortedKeys(av) {
+			if _, ok := ev[key]; !ok {
+				*diffs = append(*diffs, fmt.Sprintf("%s.%s: not documented", path, key))
+			}
+		}
+	case []any:
+		if len(ev) == 0 {
+			return
+		}
+		for i, element := range actual.([]any) {
+			shapeDiff(fmt.Sprintf("%s[%d]", path, i), ev[0], element, diffs)
+		}
+	}
+}
+
+func kindOf(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
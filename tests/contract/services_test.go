@@ -0,0 +1,89 @@
+package contract
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "metargb/shared/pb/auth"
+	notificationpb "metargb/shared/pb/notifications"
+)
+
+// The fakes below stand in for the services behind the gateway. They hold
+// their rows in maps and implement only the RPCs the contracts call; any
+// other RPC fails with Unimplemented.
+
+type authService struct {
+	pb.UnimplementedAuthServiceServer
+	tokens map[string]uint64 // token -> user id
+}
+
+func (s *authService) ValidateToken(_ context.Context, req *pb.ValidateTokenRequest) (*pb.ValidateTokenResponse, error) {
+	userID, ok := s.tokens[req.Token]
+	if !ok {
+		return &pb.ValidateTokenResponse{Valid: false}, nil
+	}
+	return &pb.ValidateTokenResponse{Valid: true, UserId: userID}, nil
+}
+
+type userService struct {
+	pb.UnimplementedUserServiceServer
+	wallets       map[uint64]*pb.UserWalletResponse
+	featureCounts map[uint64]*pb.UserFeaturesCountData
+}
+
+func (s *userService) GetUserWallet(_ context.Context, req *pb.GetUserWalletRequest) (*pb.UserWalletResponse, error) {
+	wallet, ok := s.wallets[req.UserId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	return wallet, nil
+}
+
+func (s *userService) GetUserFeaturesCount(_ context.Context, req *pb.GetUserFeaturesCountRequest) (*pb.GetUserFeaturesCountResponse, error) {
+	counts, ok := s.featureCounts[req.UserId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	return &pb.GetUserFeaturesCountResponse{Data: counts}, nil
+}
+
+type profilePhotoService struct {
+	pb.UnimplementedProfilePhotoServiceServer
+	uploads map[uint64]*pb.PhotoUploadStatusResponse
+	owners  map[uint64]uint64 // upload id -> user id
+}
+
+func (s *profilePhotoService) GetPhotoStatus(_ context.Context, req *pb.GetPhotoStatusRequest) (*pb.PhotoUploadStatusResponse, error) {
+	upload, ok := s.uploads[req.UploadId]
+	if !ok || s.owners[req.UploadId] != req.UserId {
+		return nil, status.Error(codes.NotFound, "upload not found")
+	}
+	return upload, nil
+}
+
+type notificationService struct {
+	notificationpb.UnimplementedNotificationServiceServer
+	notifications map[uint64][]*notificationpb.Notification // user id -> notifications
+}
+
+func (s *notificationService) GetNotifications(_ context.Context, req *notificationpb.GetNotificationsRequest) (*notificationpb.NotificationsResponse, error) {
+	var out []*notificationpb.Notification
+	for _, n := range s.notifications[req.UserId] {
+		if req.UnreadOnly && n.ReadAt != "" {
+			continue
+		}
+		out = append(out, n)
+	}
+	return &notificationpb.NotificationsResponse{Notifications: out}, nil
+}
+
+func (s *notificationService) GetNotification(_ context.Context, req *notificationpb.GetNotificationRequest) (*notificationpb.Notification, error) {
+	for _, n := range s.notifications[req.UserId] {
+		if n.Id == req.NotificationId {
+			return n, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "notification not found")
+}
@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	pb "metargb/shared/pb/commercial"
+	"metargb/shared/pkg/db"
+)
+
+// fakeWalletService serves fixed wallets and records which users were looked up
+type fakeWalletService struct {
+	pb.UnimplementedWalletServiceServer
+	mu      sync.Mutex
+	wallets map[uint64]*pb.WalletResponse
+	lookups []uint64
+}
+
+func (s *fakeWalletService) GetWallet(ctx context.Context, req *pb.GetWalletRequest) (*pb.WalletResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lookups = append(s.lookups, req.UserId)
+	if wallet, ok := s.wallets[req.UserId]; ok {
+		return wallet, nil
+	}
+	return &pb.WalletResponse{Psc: "0", Irr: "0", Red: "0", Blue: "0", Yellow: "0"}, nil
+}
+
+// startCommercialServer runs wallets and a serving health service on a local
+// port and returns its address
+func startCommercialServer(t *testing.T, wallets *fakeWalletService) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	server := grpc.NewServer()
+	pb.RegisterWalletServiceServer(server, wallets)
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	return lis.Addr().String()
+}
+
+// unreachableAddress returns a local address nothing listens on
+func unreachableAddress(t *testing.T) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	addr := lis.Addr().String()
+	lis.Close()
+	return addr
+}
+
+func quickRetryPolicy() db.RetryPolicy {
+	return db.RetryPolicy{
+		MaxAttempts:    2,
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     10 * time.Millisecond,
+		PingTimeout:    200 * time.Millisecond,
+	}
+}
+
+func TestConnectCommercialAtStartup_RequiredAndUnreachableFails(t *testing.T) {
+	start := time.Now()
+	c, err := ConnectCommercialAtStartup(context.Background(), unreachableAddress(t), quickRetryPolicy(), true)
+	if err == nil {
+		c.Close()
+		t.Fatal("expected an error when commercial-service is required and unreachable")
+	}
+	if c != nil {
+		t.Fatalf("expected no client, got %v", c)
+	}
+	// Two attempts of at most PingTimeout each plus one backoff
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("startup took %s, want it to give up after the retry policy", elapsed)
+	}
+}
+
+func TestConnectCommercialAtStartup_OptionalAndUnreachableDegrades(t *testing.T) {
+	c, err := ConnectCommercialAtStartup(context.Background(), unreachableAddress(t), quickRetryPolicy(), false)
+	if err != nil {
+		t.Fatalf("expected no error when commercial-service is optional, got %v", err)
+	}
+	if c != nil {
+		c.Close()
+		t.Fatal("expected a nil client when commercial-service is unreachable")
+	}
+}
+
+func TestConnectCommercialAtStartup_CancelledContextStopsRetrying(t *testing.T) {
+	policy := quickRetryPolicy()
+	policy.MaxAttempts = 0
+	policy.InitialBackoff = time.Minute
+	policy.MaxBackoff = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	if _, err := ConnectCommercialAtStartup(ctx, unreachableAddress(t), policy, true); err == nil {
+		t.Fatal("expected an error once the context is cancelled")
+	}
+}
+
+func TestCheckBalance_GoesThroughCommercialService(t *testing.T) {
+	wallets := &fakeWalletService{wallets: map[uint64]*pb.WalletResponse{
+		7: {Psc: "150.5", Irr: "1000000", Red: "3", Blue: "0", Yellow: "12"},
+	}}
+	addr := startCommercialServer(t, wallets)
+
+	c, err := ConnectCommercialAtStartup(context.Background(), addr, quickRetryPolicy(), true)
+	if err != nil {
+		t.Fatalf("ConnectCommercialAtStartup() error = %v", err)
+	}
+	defer c.Close()
+
+	tests := []struct {
+		name     string
+		userID   uint64
+		asset    string
+		required string
+		want     bool
+	}{
+		{"enough psc", 7, "psc", "150.5", true},
+		{"too little psc", 7, "psc", "150.51", false},
+		{"enough irr", 7, "irr", "999999", true},
+		{"empty blue", 7, "blue", "1", false},
+		{"unknown user", 8, "yellow", "1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.CheckBalance(context.Background(), tt.userID, tt.asset, decimal.RequireFromString(tt.required))
+			if err != nil {
+				t.Fatalf("CheckBalance() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("CheckBalance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	wallets.mu.Lock()
+	defer wallets.mu.Unlock()
+	if len(wallets.lookups) != len(tests) {
+		t.Fatalf("commercial-service saw %d wallet lookups, want %d", len(wallets.lookups), len(tests))
+	}
+	if wallets.lookups[len(tests)-1] != 8 {
+		t.Errorf("last lookup was for user %d, want 8", wallets.lookups[len(tests)-1])
+	}
+}
+
+func TestCheckBalance_UnknownAssetFails(t *testing.T) {
+	addr := startCommercialServer(t, &fakeWalletService{})
+
+	c, err := ConnectCommercialAtStartup(context.Background(), addr, quickRetryPolicy(), true)
+	if err != nil {
+		t.Fatalf("ConnectCommercialAtStartup() error = %v", err)
+	}
+	defer c.Close()
+
+	if _, err := c.CheckBalance(context.Background(), 1, "gold", decimal.NewFromInt(1)); err == nil {
+		t.Fatal("expected an error for an unknown asset")
+	}
+}
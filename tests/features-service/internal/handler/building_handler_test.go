@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"strings"
+	"testing"
+
+	pb "metargb/shared/pb/features"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestChunkBuildingModels(t *testing.T) {
+	models := []*pb.BuildingModel{
+		{ModelId: "1", Images: strings.Repeat("a", 100)},
+		{ModelId: "2", Images: strings.Repeat("b", 100)},
+		{ModelId: "3", Images: strings.Repeat("c", 100)},
+	}
+	budget := 2*(proto.Size(models[0])+6) + 1
+
+	chunks, err := chunkBuildingModels(models, budget)
+	if err != nil {
+		t.Fatalf("chunkBuildingModels failed: %v", err)
+	}
+	if len(chunks) != 2 || len(chunks[0]) != 2 || len(chunks[1]) != 1 {
+		t.Fatalf("unexpected chunk layout: %d chunks", len(chunks))
+	}
+	if chunks[1][0].ModelId != "3" {
+		t.Errorf("expected model order to be kept, got %s", chunks[1][0].ModelId)
+	}
+}
+
+func TestChunkBuildingModelsEmpty(t *testing.T) {
+	chunks, err := chunkBuildingModels(nil, 1024)
+	if err != nil {
+		t.Fatalf("chunkBuildingModels failed: %v", err)
+	}
+	if len(chunks) != 1 || len(chunks[0]) != 0 {
+		t.Errorf("expected one empty chunk, got %v", chunks)
+	}
+}
+
+func TestChunkBuildingModelsRejectsOversizedModel(t *testing.T) {
+	models := []*pb.BuildingModel{{ModelId: "1", File: strings.Repeat("x", 2048)}}
+
+	if _, err := chunkBuildingModels(models, 1024); err == nil {
+		t.Error("expected error for model larger than the chunk budget")
+	}
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"metargb/features-service/internal/constants"
+	"metargb/features-service/internal/repository"
+	"metargb/features-service/internal/service"
+	pb "metargb/shared/pb/features"
+	"metargb/shared/pkg/logger"
+)
+
+// fakeCheckoutHolds reports fixed checkout holders
+type fakeCheckoutHolds struct {
+	repository.CheckoutReservationRepository
+	holders map[uint64]uint64
+}
+
+func (f fakeCheckoutHolds) Holders(context.Context, []uint64) (map[uint64]uint64, error) {
+	return f.holders, nil
+}
+
+var featureLimitColumns = []string{"id", "title", "start_date", "end_date", "start_id", "end_id",
+	"price_limit", "verified_kyc_limit", "under_18_limit", "more_than_18_limit",
+	"dynasty_owner_limit", "individual_buy_limit", "individual_buy_count", "expired",
+	"created_at", "updated_at"}
+
+func newAvailabilityHandler(t *testing.T) (*MarketplaceHandler, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	svc := service.NewMarketplaceService(repository.NewFeatureRepository(db), nil, nil, nil, nil, nil, nil, nil,
+		repository.NewFeatureLimitRepository(db), nil, nil, nil, nil, db, logger.NewLogger("features-service-test"))
+	svc.SetCheckoutReservations(fakeCheckoutHolds{holders: map[uint64]uint64{1: 99, 2: 7}}, time.Minute)
+	return NewMarketplaceHandler(svc, nil, nil, nil, nil), mock
+}
+
+func TestMarketplaceHandler_GetLimitedFeatureAvailability(t *testing.T) {
+	h, mock := newAvailabilityHandler(t)
+	now := time.Now()
+
+	mock.ExpectQuery("FROM feature_limits").
+		WillReturnRows(sqlmock.NewRows(featureLimitColumns).
+			AddRow(3, "Launch", now.Add(-time.Hour), now.Add(time.Hour), "hm-1", "hm-9", false, false, false, false, false, true, 2, false, now, now))
+	mock.ExpectQuery("FROM feature_properties").
+		WithArgs("hm-1", "hm-9").
+		WillReturnRows(sqlmock.NewRows([]string{"feature_id", "rgb"}).
+			AddRow(1, constants.MaskoniTradingLimited).
+			AddRow(2, constants.MaskoniTradingLimited).
+			AddRow(3, constants.TejariTradingLimited).
+			AddRow(4, constants.MaskoniSoldAndPriced))
+	mock.ExpectQuery("WHERE feature_limit_id = ?").
+		WithArgs(uint64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery("WHERE user_id = \\? AND feature_limit_id = \\?").
+		WithArgs(uint64(7), uint64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	resp, err := h.GetLimitedFeatureAvailability(context.Background(), &pb.GetLimitedFeatureAvailabilityRequest{UserId: 7})
+	if err != nil {
+		t.Fatalf("GetLimitedFeatureAvailability() = %v", err)
+	}
+	if len(resp.Data) != 1 {
+		t.Fatalf("got %d campaigns, want 1", len(resp.Data))
+	}
+	got := resp.Data[0]
+	// Feature 1 is held by another buyer, feature 2 by the caller, feature 3
+	// is free and feature 4 was sold. The caller may buy one more.
+	if got.LimitationId != 3 || got.Title != "Launch" || got.Total != 4 || got.Sold != 1 ||
+		got.Remaining != 2 || got.Held != 1 || got.UserHeldFeatureId != 2 ||
+		!got.IndividualBuyLimit || got.IndividualBuyCount != 2 || got.UserPurchased != 1 || got.UserRemaining != 1 {
+		t.Errorf("availability = %+v", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMarketplaceHandler_GetLimitedFeatureAvailabilityOutsideCampaigns(t *testing.T) {
+	h, mock := newAvailabilityHandler(t)
+	now := time.Now()
+
+	mock.ExpectQuery("FROM features f").
+		WithArgs(uint64(41)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "owner_id", "dynasty_id", "created_at", "updated_at",
+			"prop_id", "feature_id", "karbari", "rgb", "owner", "label", "area", "density", "stability",
+			"price_psc", "price_irr", "minimum_price_percentage", "prop_created_at", "prop_updated_at"}).
+			AddRow(41, 9, nil, now, now, "hm-41", 41, "m", constants.MaskoniSoldAndPriced, "seller", "", 100.0, 1, 1.0, "100", "0", 80, now, now))
+	mock.ExpectQuery("FROM feature_limits").
+		WithArgs("hm-41", "hm-41").
+		WillReturnRows(sqlmock.NewRows(featureLimitColumns))
+
+	_, err := h.GetLimitedFeatureAvailability(context.Background(), &pb.GetLimitedFeatureAvailabilityRequest{UserId: 7, FeatureId: 41})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("GetLimitedFeatureAvailability() = %v, want NotFound", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+func setupFeatureLimitTestDB(t *testing.T) *sql.DB {
+	// Use test database connection
+	dsn := "root@tcp(localhost:3306)/metargb_db_test?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci"
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		t.Skipf("Database not available: %v", err)
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		t.Skipf("Database ping failed: %v", err)
+	}
+	return db
+}
+
+func countLimitedPurchases(t *testing.T, repo *FeatureLimitRepository, userID, limitID uint64) int {
+	t.Helper()
+	count, err := repo.CountLimitedPurchases(context.Background(), userID, limitID)
+	if err != nil {
+		t.Fatalf("CountLimitedPurchases() = %v", err)
+	}
+	return count
+}
+
+// Concurrent purchases of one user must not take more features of a campaign
+// than individual_buy_count allows
+func TestFeatureLimitRepository_ClaimLimitedPurchaseConcurrently(t *testing.T) {
+	db := setupFeatureLimitTestDB(t)
+	defer db.Close()
+
+	repo := NewFeatureLimitRepository(db)
+	ctx := context.Background()
+	userID := uint64(time.Now().UnixNano() % 1_000_000_000)
+	limitID := uint64(900_001)
+	const maxPurchases = 3
+	cleanup := func() {
+		db.Exec("DELETE FROM limited_feature_purchases WHERE user_id = ? AND feature_limit_id = ?", userID, limitID)
+	}
+	cleanup()
+	defer cleanup()
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		claimed int
+	)
+	start := make(chan struct{})
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(featureID uint64) {
+			defer wg.Done()
+			<-start
+			// Racing claims may also fail with a deadlock, which rolls the
+			// claim back like a refusal
+			_, ok, err := repo.ClaimLimitedPurchase(ctx, userID, limitID, featureID, maxPurchases)
+			if err == nil && ok {
+				mu.Lock()
+				claimed++
+				mu.Unlock()
+			}
+		}(uint64(i + 1))
+	}
+	close(start)
+	wg.Wait()
+
+	if claimed > maxPurchases {
+		t.Fatalf("%d concurrent claims succeeded, limit is %d", claimed, maxPurchases)
+	}
+	if count := countLimitedPurchases(t, repo, userID, limitID); count != claimed {
+		t.Fatalf("%d purchases recorded for %d successful claims", count, claimed)
+	}
+
+	// Claims that lost a race leave room that later claims can take, up to the limit
+	for i := claimed; i < maxPurchases; i++ {
+		if _, ok, err := repo.ClaimLimitedPurchase(ctx, userID, limitID, uint64(100+i), maxPurchases); err != nil || !ok {
+			t.Fatalf("claim %d = %v, %v", i+1, ok, err)
+		}
+	}
+	if _, ok, err := repo.ClaimLimitedPurchase(ctx, userID, limitID, 200, maxPurchases); err != nil || ok {
+		t.Fatalf("claim over the limit = %v, %v, want refused", ok, err)
+	}
+	if count := countLimitedPurchases(t, repo, userID, limitID); count != maxPurchases {
+		t.Fatalf("%d purchases recorded, want %d", count, maxPurchases)
+	}
+}
+
+// A released claim frees its place in the quota
+func TestFeatureLimitRepository_DeleteLimitedPurchaseFreesTheQuota(t *testing.T) {
+	db := setupFeatureLimitTestDB(t)
+	defer db.Close()
+
+	repo := NewFeatureLimitRepository(db)
+	ctx := context.Background()
+	userID := uint64(time.Now().UnixNano()%1_000_000_000) + 1
+	limitID := uint64(900_002)
+	defer db.Exec("DELETE FROM limited_feature_purchases WHERE user_id = ? AND feature_limit_id = ?", userID, limitID)
+
+	purchaseID, ok, err := repo.ClaimLimitedPurchase(ctx, userID, limitID, 1, 1)
+	if err != nil || !ok {
+		t.Fatalf("first claim = %v, %v", ok, err)
+	}
+	if _, ok, err := repo.ClaimLimitedPurchase(ctx, userID, limitID, 2, 1); err != nil || ok {
+		t.Fatalf("second claim = %v, %v, want refused", ok, err)
+	}
+	if err := repo.DeleteLimitedPurchase(ctx, purchaseID); err != nil {
+		t.Fatalf("DeleteLimitedPurchase() = %v", err)
+	}
+	if _, ok, err := repo.ClaimLimitedPurchase(ctx, userID, limitID, 2, 1); err != nil || !ok {
+		t.Fatalf("claim after delete = %v, %v", ok, err)
+	}
+}
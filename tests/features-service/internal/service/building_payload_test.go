@@ -0,0 +1,61 @@
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOffloadInlinePayloads(t *testing.T) {
+	value := map[string]interface{}{
+		"url": "https://cdn.example.com/model.glb",
+		"images": []interface{}{
+			map[string]interface{}{"src": "data:image/png;base64,aGVsbG8="},
+		},
+	}
+
+	var uploaded []string
+	upload := func(contentType string, data []byte) (string, error) {
+		uploaded = append(uploaded, contentType+":"+string(data))
+		return "https://storage.example.com/building-models/1.png", nil
+	}
+
+	if _, err := offloadInlinePayloads(value, upload); err != nil {
+		t.Fatalf("offloadInlinePayloads failed: %v", err)
+	}
+
+	if len(uploaded) != 1 || uploaded[0] != "image/png:hello" {
+		t.Fatalf("unexpected uploads: %v", uploaded)
+	}
+	image := value["images"].([]interface{})[0].(map[string]interface{})
+	if image["src"] != "https://storage.example.com/building-models/1.png" {
+		t.Errorf("expected data URI to be replaced, got %v", image["src"])
+	}
+	if value["url"] != "https://cdn.example.com/model.glb" {
+		t.Errorf("plain URLs must be left alone, got %v", value["url"])
+	}
+}
+
+func TestDecodeDataURI(t *testing.T) {
+	contentType, data, err := decodeDataURI("data:,hello%20world")
+	if err != nil {
+		t.Fatalf("decodeDataURI failed: %v", err)
+	}
+	if contentType != "application/octet-stream" || string(data) != "hello world" {
+		t.Errorf("unexpected result %q %q", contentType, data)
+	}
+
+	if _, _, err := decodeDataURI("data:image/png;base64"); err == nil {
+		t.Error("expected error for data URI without payload")
+	}
+}
+
+func TestPayloadFilenameIsStable(t *testing.T) {
+	a := payloadFilename("image/png", []byte("hello"))
+	b := payloadFilename("image/png", []byte("hello"))
+	if a != b {
+		t.Errorf("expected identical names, got %q and %q", a, b)
+	}
+	if !strings.HasSuffix(a, ".png") {
+		t.Errorf("expected .png extension, got %q", a)
+	}
+}
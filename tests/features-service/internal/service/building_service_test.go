@@ -161,7 +161,7 @@ func TestBuildingService_GetBuildPackage(t *testing.T) {
 		// For full testing, integration tests or a 3D client interface would be needed
 		service := NewBuildingService(mockBuildingRepo, mockFeatureRepo, mockGeometryRepo, mockProfitRepo, nil)
 
-		_, _, err := service.GetBuildPackage(ctx, 1, 1, 200) // Different user ID
+		_, err := service.GetBuildPackage(ctx, 1, 1, 200) // Different user ID
 		if err == nil {
 			t.Error("Expected error for unauthorized user")
 		}
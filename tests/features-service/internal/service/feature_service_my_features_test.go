@@ -4,9 +4,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
+
+	"google.golang.org/grpc"
 
 	"metargb/features-service/internal/models"
 	"metargb/features-service/internal/repository"
+	authpb "metargb/shared/pb/auth"
+	"metargb/shared/pkg/usercache"
 )
 
 // Mock repositories for testing
@@ -84,14 +89,23 @@ func (m *mockGeometryRepo) GetCoordinatesByFeatureID(ctx context.Context, featur
 }
 
 type mockTradeRepo struct {
-	getLatestForFeatureWithSellerFunc func(ctx context.Context, featureID uint64) (*models.Trade, *repository.SellerInfo, error)
+	getLatestForFeatureFunc func(ctx context.Context, featureID uint64) (*models.Trade, error)
 }
 
-func (m *mockTradeRepo) GetLatestForFeatureWithSeller(ctx context.Context, featureID uint64) (*models.Trade, *repository.SellerInfo, error) {
-	if m.getLatestForFeatureWithSellerFunc != nil {
-		return m.getLatestForFeatureWithSellerFunc(ctx, featureID)
+func (m *mockTradeRepo) GetLatestForFeature(ctx context.Context, featureID uint64) (*models.Trade, error) {
+	if m.getLatestForFeatureFunc != nil {
+		return m.getLatestForFeatureFunc(ctx, featureID)
 	}
-	return nil, nil, errors.New("not implemented")
+	return nil, errors.New("not implemented")
+}
+
+type mockUserClient struct {
+	authpb.UserServiceClient
+	info *authpb.UserInfo
+}
+
+func (m *mockUserClient) GetUserInfo(ctx context.Context, req *authpb.GetUserInfoRequest, opts ...grpc.CallOption) (*authpb.UserInfo, error) {
+	return m.info, nil
 }
 
 type mockPricingService struct {
@@ -223,15 +237,20 @@ func TestFeatureService_GetMyFeature(t *testing.T) {
 		}
 
 		mockTradeRepo := &mockTradeRepo{}
-		mockTradeRepo.getLatestForFeatureWithSellerFunc = func(ctx context.Context, featureID uint64) (*models.Trade, *repository.SellerInfo, error) {
-			return &models.Trade{ID: 1}, &repository.SellerInfo{ID: 2, Name: "Seller", Code: "S001"}, nil
+		mockTradeRepo.getLatestForFeatureFunc = func(ctx context.Context, featureID uint64) (*models.Trade, error) {
+			return &models.Trade{ID: 1, SellerID: 2}, nil
 		}
 
+		userCache := usercache.New(&mockUserClient{
+			info: &authpb.UserInfo{Id: 2, Name: "Seller", Code: "S001"},
+		}, time.Minute)
+
 		service := &FeatureService{
 			featureRepo:  mockFeatureRepo,
 			geometryRepo: mockGeometryRepo,
 			imageRepo:    mockImageRepo,
 			tradeRepo:    mockTradeRepo,
+			userCache:    userCache,
 		}
 
 		feature, err := service.GetMyFeature(ctx, 1, 1)
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"metargb/features-service/internal/models"
+	"metargb/shared/pkg/logger"
+)
+
+type fakeGeometryEditRepository struct {
+	versions []*models.GeometryVersion
+	missing  bool
+}
+
+func (f *fakeGeometryEditRepository) ReplaceCoordinates(ctx context.Context, featureID uint64, points []models.GeometryPoint, area float64, editedBy uint64, reason string) (*models.GeometryVersion, error) {
+	if f.missing {
+		return nil, nil
+	}
+	version := &models.GeometryVersion{
+		ID:          uint64(len(f.versions) + 1),
+		FeatureID:   featureID,
+		Version:     len(f.versions) + 1,
+		Coordinates: points,
+		Area:        area,
+		EditedBy:    editedBy,
+		Reason:      reason,
+	}
+	f.versions = append(f.versions, version)
+	return version, nil
+}
+
+func (f *fakeGeometryEditRepository) ListVersions(ctx context.Context, featureID uint64) ([]*models.GeometryVersion, error) {
+	return f.versions, nil
+}
+
+type fakeGeometryPublisher struct {
+	published []*models.GeometryVersion
+	err       error
+}
+
+func (f *fakeGeometryPublisher) PublishGeometryChanged(ctx context.Context, version *models.GeometryVersion) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.published = append(f.published, version)
+	return nil
+}
+
+func ring(coords ...float64) []models.GeometryPoint {
+	points := make([]models.GeometryPoint, 0, len(coords)/2)
+	for i := 0; i+1 < len(coords); i += 2 {
+		points = append(points, models.GeometryPoint{X: coords[i], Y: coords[i+1]})
+	}
+	return points
+}
+
+func TestValidatePolygon(t *testing.T) {
+	tests := []struct {
+		name    string
+		points  []models.GeometryPoint
+		wantErr error
+	}{
+		{"square", ring(0, 0, 10, 0, 10, 10, 0, 10, 0, 0), nil},
+		{"concave", ring(0, 0, 10, 0, 10, 10, 5, 4, 0, 10, 0, 0), nil},
+		{"too few points", ring(0, 0, 10, 0, 0, 0), ErrGeometryTooFewPoints},
+		{"open ring", ring(0, 0, 10, 0, 10, 10, 0, 10), ErrGeometryNotClosed},
+		{"repeated vertex", ring(0, 0, 10, 0, 10, 10, 10, 0, 0, 10, 0, 0), ErrGeometryDuplicatePoint},
+		{"bow tie", ring(0, 0, 10, 10, 10, 0, 0, 10, 0, 0), ErrGeometrySelfIntersecting},
+		{"folds back on itself", ring(0, 0, 10, 0, 5, 0, 5, 10, 0, 0), ErrGeometrySelfIntersecting},
+		{"vertex touches edge", ring(0, 0, 10, 0, 10, 10, 5, 0, 0, 10, 0, 0), ErrGeometrySelfIntersecting},
+		{"collinear", ring(0, 0, 5, 0, 10, 0, 0, 0), ErrGeometrySelfIntersecting},
+		{"too small", ring(0, 0, 0.5, 0, 0.5, 0.5, 0, 0.5, 0, 0), ErrGeometryAreaOutOfBounds},
+		{"too large", ring(0, 0, 2000, 0, 2000, 2000, 0, 2000, 0, 0), ErrGeometryAreaOutOfBounds},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ValidatePolygon(tt.points, DefaultGeometryMinArea, DefaultGeometryMaxArea)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidatePolygon() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	area, err := ValidatePolygon(ring(0, 0, 10, 0, 10, 10, 0, 10, 0, 0), DefaultGeometryMinArea, DefaultGeometryMaxArea)
+	if err != nil || area != 100 {
+		t.Fatalf("expected area 100, got %v (error %v)", area, err)
+	}
+}
+
+func TestGeometryService_UpdateGeometry(t *testing.T) {
+	ctx := context.Background()
+	square := ring(0, 0, 10, 0, 10, 10, 0, 10, 0, 0)
+
+	t.Run("only admins can edit", func(t *testing.T) {
+		repo := &fakeGeometryEditRepository{}
+		svc := NewGeometryService(repo, nil, []uint64{4}, 0, 0, logger.NewLogger("test"))
+
+		if _, err := svc.UpdateGeometry(ctx, 7, 100, square, ""); !errors.Is(err, ErrGeometryNotAdmin) {
+			t.Fatalf("expected ErrGeometryNotAdmin, got %v", err)
+		}
+		if _, err := svc.ListVersions(ctx, 7, 100); !errors.Is(err, ErrGeometryNotAdmin) {
+			t.Fatalf("expected ErrGeometryNotAdmin, got %v", err)
+		}
+		if len(repo.versions) != 0 {
+			t.Fatalf("expected no versions, got %d", len(repo.versions))
+		}
+	})
+
+	t.Run("stores and broadcasts a valid polygon", func(t *testing.T) {
+		repo := &fakeGeometryEditRepository{}
+		publisher := &fakeGeometryPublisher{}
+		svc := NewGeometryService(repo, publisher, []uint64{4}, 0, 0, logger.NewLogger("test"))
+
+		version, err := svc.UpdateGeometry(ctx, 4, 100, square, "  survey correction ")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if version.Area != 100 || version.EditedBy != 4 || version.Reason != "survey correction" {
+			t.Fatalf("unexpected version: %+v", version)
+		}
+		if len(publisher.published) != 1 || publisher.published[0].FeatureID != 100 {
+			t.Fatalf("expected one broadcast for feature 100, got %+v", publisher.published)
+		}
+	})
+
+	t.Run("invalid polygon is not stored", func(t *testing.T) {
+		repo := &fakeGeometryEditRepository{}
+		publisher := &fakeGeometryPublisher{}
+		svc := NewGeometryService(repo, publisher, []uint64{4}, 0, 0, logger.NewLogger("test"))
+
+		if _, err := svc.UpdateGeometry(ctx, 4, 100, ring(0, 0, 10, 10, 10, 0, 0, 10, 0, 0), ""); !errors.Is(err, ErrGeometrySelfIntersecting) {
+			t.Fatalf("expected ErrGeometrySelfIntersecting, got %v", err)
+		}
+		if len(repo.versions) != 0 || len(publisher.published) != 0 {
+			t.Fatal("expected nothing stored or broadcast")
+		}
+	})
+
+	t.Run("broadcast failure keeps the edit", func(t *testing.T) {
+		repo := &fakeGeometryEditRepository{}
+		publisher := &fakeGeometryPublisher{err: errors.New("redis down")}
+		svc := NewGeometryService(repo, publisher, []uint64{4}, 0, 0, logger.NewLogger("test"))
+
+		if _, err := svc.UpdateGeometry(ctx, 4, 100, square, ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(repo.versions) != 1 {
+			t.Fatalf("expected the edit to be stored, got %d versions", len(repo.versions))
+		}
+	})
+
+	t.Run("feature without geometry", func(t *testing.T) {
+		repo := &fakeGeometryEditRepository{missing: true}
+		svc := NewGeometryService(repo, nil, []uint64{4}, 0, 0, logger.NewLogger("test"))
+
+		if _, err := svc.UpdateGeometry(ctx, 4, 100, square, ""); !errors.Is(err, ErrGeometryFeatureNotFound) {
+			t.Fatalf("expected ErrGeometryFeatureNotFound, got %v", err)
+		}
+	})
+}
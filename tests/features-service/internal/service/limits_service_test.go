@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"metargb/features-service/internal/repository"
+	"metargb/shared/pkg/logger"
+)
+
+type fakeOwnedFeatureCounter struct {
+	counts map[string]int
+	err    error
+	calls  int
+}
+
+func (f *fakeOwnedFeatureCounter) CountOwnedByKarbari(context.Context, uint64) (map[string]int, error) {
+	f.calls++
+	return f.counts, f.err
+}
+
+type fakeLevelResolver struct {
+	slug, name string
+	err        error
+}
+
+func (f fakeLevelResolver) GetUserLevel(context.Context, uint64) (string, string, error) {
+	return f.slug, f.name, f.err
+}
+
+func TestLimitsService_CheckPurchase(t *testing.T) {
+	ctx := context.Background()
+	log := logger.NewLogger("features-service-test")
+	owned := map[string]int{"m": 3, "t": 1}
+	caps := OwnershipCaps{
+		PerKarbari: map[string]int{"m": 3, "t": 5},
+		PerLevel:   map[string]int{"citizen": 4, "baron": 10},
+	}
+
+	tests := []struct {
+		name    string
+		counter *fakeOwnedFeatureCounter
+		levels  LevelResolver
+		caps    OwnershipCaps
+		karbari string
+		want    *OwnershipCapError
+	}{
+		{"karbari cap reached", &fakeOwnedFeatureCounter{counts: owned}, nil, caps, "m", &OwnershipCapError{Karbari: "m", Limit: 3, Owned: 3}},
+		{"under the karbari cap", &fakeOwnedFeatureCounter{counts: owned}, nil, caps, "t", nil},
+		{"karbari without a cap", &fakeOwnedFeatureCounter{counts: owned}, nil, caps, "a", nil},
+		{"level cap reached", &fakeOwnedFeatureCounter{counts: owned}, fakeLevelResolver{slug: "citizen", name: "Citizen"}, caps, "t", &OwnershipCapError{Level: "Citizen", Limit: 4, Owned: 4}},
+		{"level cap named by slug", &fakeOwnedFeatureCounter{counts: owned}, fakeLevelResolver{slug: "citizen"}, caps, "a", &OwnershipCapError{Level: "citizen", Limit: 4, Owned: 4}},
+		{"under the level cap", &fakeOwnedFeatureCounter{counts: owned}, fakeLevelResolver{slug: "baron", name: "Baron"}, caps, "t", nil},
+		{"level without a cap", &fakeOwnedFeatureCounter{counts: owned}, fakeLevelResolver{slug: "duke"}, caps, "t", nil},
+		{"no level resolver", &fakeOwnedFeatureCounter{counts: owned}, nil, caps, "t", nil},
+		{"count failure does not block", &fakeOwnedFeatureCounter{err: errors.New("db down")}, fakeLevelResolver{slug: "citizen"}, caps, "m", nil},
+		{"level failure does not block", &fakeOwnedFeatureCounter{counts: owned}, fakeLevelResolver{err: errors.New("levels down")}, caps, "t", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewLimitsService(tt.counter, tt.levels, tt.caps, log).CheckPurchase(ctx, 7, tt.karbari)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("CheckPurchase() = %v, want nil", err)
+				}
+				return
+			}
+			var capErr *OwnershipCapError
+			if !errors.As(err, &capErr) || *capErr != *tt.want {
+				t.Fatalf("CheckPurchase() = %#v, want %#v", err, tt.want)
+			}
+			if !errors.Is(err, ErrOwnershipLimitReached) {
+				t.Errorf("CheckPurchase() = %v, does not match ErrOwnershipLimitReached", err)
+			}
+		})
+	}
+}
+
+func TestLimitsService_CheckPurchaseWithoutCaps(t *testing.T) {
+	counter := &fakeOwnedFeatureCounter{counts: map[string]int{"m": 100}}
+	if err := NewLimitsService(counter, nil, OwnershipCaps{}, logger.NewLogger("features-service-test")).CheckPurchase(context.Background(), 7, "m"); err != nil {
+		t.Fatalf("CheckPurchase() = %v", err)
+	}
+	if counter.calls != 0 {
+		t.Errorf("counted owned features %d times without caps", counter.calls)
+	}
+
+	var nilLimits *LimitsService
+	if err := nilLimits.CheckPurchase(context.Background(), 7, "m"); err != nil {
+		t.Fatalf("nil CheckPurchase() = %v", err)
+	}
+}
+
+func TestParseOwnershipCaps(t *testing.T) {
+	caps, err := ParseOwnershipCaps(" m:20, t:10,,a:5 ")
+	if err != nil || len(caps) != 3 || caps["m"] != 20 || caps["t"] != 10 || caps["a"] != 5 {
+		t.Fatalf("ParseOwnershipCaps() = %v, %v", caps, err)
+	}
+	for _, value := range []string{"m", "m:0", "m:-1", ":3", "m:x"} {
+		if _, err := ParseOwnershipCaps(value); err == nil {
+			t.Errorf("ParseOwnershipCaps(%q) accepted", value)
+		}
+	}
+}
+
+// A buyer may have bought other features while paying installments, so the
+// caps are checked again before the reserved feature is handed over
+func TestCompleteReservedPurchaseChecksOwnershipCaps(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	log := logger.NewLogger("features-service-test")
+	svc := NewMarketplaceService(repository.NewFeatureRepository(db), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, db, log)
+	svc.SetLimits(NewLimitsService(&fakeOwnedFeatureCounter{counts: map[string]int{"m": 2}}, nil, OwnershipCaps{PerKarbari: map[string]int{"m": 2}}, log))
+
+	now := time.Now()
+	mock.ExpectQuery("FROM feature_reservations").
+		WithArgs(uint64(41)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "feature_id", "buyer_id", "seller_id", "price_psc", "price_irr", "trade_id", "created_at", "updated_at"}).
+			AddRow(5, 41, 7, 9, "100", "0", nil, now, now))
+	mock.ExpectQuery("FROM features f").
+		WithArgs(uint64(41)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "owner_id", "dynasty_id", "created_at", "updated_at",
+			"prop_id", "feature_id", "karbari", "rgb", "owner", "label", "area", "density", "stability",
+			"price_psc", "price_irr", "minimum_price_percentage", "prop_created_at", "prop_updated_at"}).
+			AddRow(41, 9, nil, now, now, "hm-41", 41, "m", "1", "seller", "", 100.0, 1, 1.0, "100", "0", 80, now, now))
+
+	if _, err := svc.CompleteReservedPurchase(context.Background(), 41, 7); !errors.Is(err, ErrOwnershipLimitReached) {
+		t.Fatalf("CompleteReservedPurchase() = %v, want ErrOwnershipLimitReached", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
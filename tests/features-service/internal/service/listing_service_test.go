@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"metargb/features-service/internal/models"
+)
+
+type fakeListingRepository struct {
+	listings []*models.Listing
+	filter   models.ListingFilter
+}
+
+func (f *fakeListingRepository) ListForSale(ctx context.Context, filter models.ListingFilter) ([]*models.Listing, error) {
+	f.filter = filter
+	if filter.Limit < len(f.listings) {
+		return f.listings[:filter.Limit], nil
+	}
+	return f.listings, nil
+}
+
+func TestListingService_ListForSale(t *testing.T) {
+	repo := &fakeListingRepository{}
+	for i := uint64(1); i <= 3; i++ {
+		repo.listings = append(repo.listings, &models.Listing{SellRequestID: i})
+	}
+	svc := NewListingService(repo)
+
+	listings, hasMore, err := svc.ListForSale(context.Background(), models.ListingFilter{Karbari: "m"}, 2, 2)
+	if err != nil {
+		t.Fatalf("ListForSale failed: %v", err)
+	}
+	if len(listings) != 2 || !hasMore {
+		t.Errorf("expected 2 listings and another page, got %d and %v", len(listings), hasMore)
+	}
+	if repo.filter.Limit != 3 || repo.filter.Offset != 2 {
+		t.Errorf("expected limit 3 offset 2, got %d and %d", repo.filter.Limit, repo.filter.Offset)
+	}
+	if repo.filter.Currency != "irr" || repo.filter.Sort != models.ListingSortNewest {
+		t.Errorf("expected irr and newest defaults, got %q and %q", repo.filter.Currency, repo.filter.Sort)
+	}
+
+	if _, _, err := svc.ListForSale(context.Background(), models.ListingFilter{}, 1, 0); err != nil || repo.filter.Limit != DefaultListingsPerPage+1 {
+		t.Errorf("expected the default page size, got limit %d and %v", repo.filter.Limit, err)
+	}
+}
+
+func TestListingService_ListForSaleValidation(t *testing.T) {
+	svc := NewListingService(&fakeListingRepository{})
+
+	tests := []struct {
+		name    string
+		filter  models.ListingFilter
+		perPage int
+		want    error
+	}{
+		{"unknown karbari", models.ListingFilter{Karbari: "x"}, 20, ErrListingInvalidKarbari},
+		{"unknown currency", models.ListingFilter{Currency: "usd"}, 20, ErrListingInvalidCurrency},
+		{"unknown sort", models.ListingFilter{Sort: "area"}, 20, ErrListingInvalidSort},
+		{"inverted price range", models.ListingFilter{MinPrice: 10, MaxPrice: 5}, 20, ErrListingInvalidPriceRange},
+		{"page too large", models.ListingFilter{}, MaxListingsPerPage + 1, ErrListingInvalidPerPage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := svc.ListForSale(context.Background(), tt.filter, 1, tt.perPage); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
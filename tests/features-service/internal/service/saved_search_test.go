@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"metargb/features-service/internal/models"
+	"metargb/shared/pkg/logger"
+)
+
+type fakeSavedSearchRepository struct {
+	searches []*models.SavedSearch
+	cursors  map[uint64]uint64
+}
+
+func (f *fakeSavedSearchRepository) Create(ctx context.Context, search *models.SavedSearch) (uint64, error) {
+	search.ID = uint64(len(f.searches) + 1)
+	f.searches = append(f.searches, search)
+	return search.ID, nil
+}
+
+func (f *fakeSavedSearchRepository) Update(ctx context.Context, search *models.SavedSearch) error {
+	return nil
+}
+
+func (f *fakeSavedSearchRepository) Delete(ctx context.Context, userID, id uint64) (bool, error) {
+	return false, nil
+}
+
+func (f *fakeSavedSearchRepository) Find(ctx context.Context, userID, id uint64) (*models.SavedSearch, error) {
+	for _, search := range f.searches {
+		if search.ID == id && search.UserID == userID {
+			return search, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeSavedSearchRepository) ListByUser(ctx context.Context, userID uint64) ([]*models.SavedSearch, error) {
+	return f.searches, nil
+}
+
+func (f *fakeSavedSearchRepository) CountByUser(ctx context.Context, userID uint64) (int, error) {
+	return len(f.searches), nil
+}
+
+func (f *fakeSavedSearchRepository) MinLastSellRequestID(ctx context.Context) (uint64, bool, error) {
+	if len(f.searches) == 0 {
+		return 0, false, nil
+	}
+	cursor := f.searches[0].LastSellRequestID
+	for _, search := range f.searches {
+		if search.LastSellRequestID < cursor {
+			cursor = search.LastSellRequestID
+		}
+	}
+	return cursor, true, nil
+}
+
+func (f *fakeSavedSearchRepository) ListBefore(ctx context.Context, sellRequestID uint64) ([]*models.SavedSearch, error) {
+	var searches []*models.SavedSearch
+	for _, search := range f.searches {
+		if search.LastSellRequestID < sellRequestID {
+			searches = append(searches, search)
+		}
+	}
+	return searches, nil
+}
+
+func (f *fakeSavedSearchRepository) UpdateLastSellRequestID(ctx context.Context, id, sellRequestID uint64) error {
+	if f.cursors == nil {
+		f.cursors = make(map[uint64]uint64)
+	}
+	f.cursors[id] = sellRequestID
+	return nil
+}
+
+type fakeSavedSearchListingRepository struct {
+	listings []*models.Listing
+	afterID  uint64
+}
+
+func (f *fakeSavedSearchListingRepository) ListCreatedAfter(ctx context.Context, afterID uint64, limit int) ([]*models.Listing, error) {
+	f.afterID = afterID
+	return f.listings, nil
+}
+
+func TestSavedSearchService_CreateSavedSearch(t *testing.T) {
+	svc := NewSavedSearchService(&fakeSavedSearchRepository{})
+
+	search, err := svc.CreateSavedSearch(context.Background(), &models.SavedSearch{UserID: 1, Name: "  north  ", Region: 3})
+	if err != nil {
+		t.Fatalf("CreateSavedSearch failed: %v", err)
+	}
+	if search.Name != "north" || search.Currency != "irr" {
+		t.Errorf("expected trimmed name and default currency, got %q and %q", search.Name, search.Currency)
+	}
+
+	tests := []struct {
+		search *models.SavedSearch
+		want   error
+	}{
+		{&models.SavedSearch{UserID: 1}, ErrSavedSearchEmpty},
+		{&models.SavedSearch{UserID: 1, Region: -1}, ErrSavedSearchInvalidRegion},
+		{&models.SavedSearch{UserID: 1, Karbari: "x"}, ErrListingInvalidKarbari},
+		{&models.SavedSearch{UserID: 1, Currency: "usd", MinPrice: 1}, ErrListingInvalidCurrency},
+		{&models.SavedSearch{UserID: 1, MinPrice: 10, MaxPrice: 5}, ErrListingInvalidPriceRange},
+	}
+	for _, tt := range tests {
+		if _, err := svc.CreateSavedSearch(context.Background(), tt.search); !errors.Is(err, tt.want) {
+			t.Errorf("CreateSavedSearch(%+v) = %v, want %v", tt.search, err, tt.want)
+		}
+	}
+}
+
+func TestSavedSearchService_CreateSavedSearchLimit(t *testing.T) {
+	repo := &fakeSavedSearchRepository{}
+	for i := 0; i < 20; i++ {
+		repo.searches = append(repo.searches, &models.SavedSearch{ID: uint64(i + 1), UserID: 1})
+	}
+	svc := NewSavedSearchService(repo)
+
+	if _, err := svc.CreateSavedSearch(context.Background(), &models.SavedSearch{UserID: 1, Region: 1}); !errors.Is(err, ErrSavedSearchLimit) {
+		t.Errorf("expected ErrSavedSearchLimit, got %v", err)
+	}
+}
+
+func TestSavedSearchService_UpdateSavedSearchNotFound(t *testing.T) {
+	repo := &fakeSavedSearchRepository{searches: []*models.SavedSearch{{ID: 1, UserID: 1, Region: 1}}}
+	svc := NewSavedSearchService(repo)
+
+	if _, err := svc.UpdateSavedSearch(context.Background(), &models.SavedSearch{ID: 1, UserID: 2, Region: 2}); !errors.Is(err, ErrSavedSearchNotFound) {
+		t.Errorf("expected ErrSavedSearchNotFound for another user's search, got %v", err)
+	}
+}
+
+func TestSavedSearch_Matches(t *testing.T) {
+	listing := &models.Listing{Region: 3, Karbari: "m", PricePSC: 1200, PriceIRR: 0}
+
+	tests := []struct {
+		name   string
+		search models.SavedSearch
+		want   bool
+	}{
+		{"region and karbari", models.SavedSearch{Region: 3, Karbari: "m", Currency: "irr"}, true},
+		{"other region", models.SavedSearch{Region: 4, Currency: "irr"}, false},
+		{"other karbari", models.SavedSearch{Karbari: "t", Currency: "irr"}, false},
+		{"psc range", models.SavedSearch{Currency: "psc", MinPrice: 1000, MaxPrice: 1500}, true},
+		{"psc above max", models.SavedSearch{Currency: "psc", MaxPrice: 1000}, false},
+		{"irr below min", models.SavedSearch{Currency: "irr", MinPrice: 1}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.search.Matches(listing); got != tt.want {
+			t.Errorf("%s: Matches = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSavedSearchWorker_Run(t *testing.T) {
+	searches := &fakeSavedSearchRepository{searches: []*models.SavedSearch{
+		{ID: 1, UserID: 42, Region: 3, Currency: "irr", LastSellRequestID: 10},
+		{ID: 2, UserID: 42, Karbari: "m", Currency: "irr", LastSellRequestID: 10},
+		{ID: 3, UserID: 43, Region: 3, Currency: "irr", LastSellRequestID: 12},
+		{ID: 4, UserID: 44, Region: 3, Currency: "irr", LastSellRequestID: 10},
+	}}
+	listings := &fakeSavedSearchListingRepository{listings: []*models.Listing{
+		{SellRequestID: 11, FeatureID: 100, Region: 3, Karbari: "m", SellerID: 44},
+		{SellRequestID: 13, FeatureID: 101, Region: 3, Karbari: "t", SellerID: 45},
+	}}
+	notifier := &fakeWatchlistNotifier{}
+	worker := NewSavedSearchWorker(searches, listings, notifier, 0, logger.NewLogger("test"))
+
+	sent, err := worker.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if listings.afterID != 10 {
+		t.Errorf("expected listings after the oldest cursor 10, got %d", listings.afterID)
+	}
+
+	// User 42 gets sell requests 11 and 13 once each, user 43 only 13 (11 is
+	// before its cursor) and user 44 only 13 (11 is their own)
+	if sent != 4 || len(notifier.sent) != 4 {
+		t.Fatalf("expected 4 notifications, got %d: %+v", sent, notifier.sent)
+	}
+	for _, id := range []uint64{1, 2, 3, 4} {
+		if searches.cursors[id] != 13 {
+			t.Errorf("expected search %d to advance to 13, got %d", id, searches.cursors[id])
+		}
+	}
+	if worker.interval != DefaultSavedSearchInterval {
+		t.Errorf("expected default interval, got %v", worker.interval)
+	}
+}
+
+func TestSavedSearchWorker_RunWithoutSearches(t *testing.T) {
+	listings := &fakeSavedSearchListingRepository{listings: []*models.Listing{{SellRequestID: 1}}}
+	worker := NewSavedSearchWorker(&fakeSavedSearchRepository{}, listings, nil, 0, logger.NewLogger("test"))
+
+	if sent, err := worker.Run(context.Background()); err != nil || sent != 0 {
+		t.Errorf("expected nothing sent without saved searches, got %d, %v", sent, err)
+	}
+}
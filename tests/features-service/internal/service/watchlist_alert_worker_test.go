@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"metargb/features-service/internal/models"
+	"metargb/shared/pkg/logger"
+)
+
+type fakeWatchlistAlertRepository struct {
+	changed   []*models.WatchedFeature
+	snapshots []uint64
+}
+
+func (f *fakeWatchlistAlertRepository) ListChanged(ctx context.Context, limit int) ([]*models.WatchedFeature, error) {
+	return f.changed, nil
+}
+
+func (f *fakeWatchlistAlertRepository) UpdateSnapshot(ctx context.Context, watch *models.WatchedFeature) error {
+	f.snapshots = append(f.snapshots, watch.ID)
+	return nil
+}
+
+type sentWatchlistAlert struct {
+	userID uint64
+	change string
+}
+
+type fakeWatchlistNotifier struct {
+	sent []sentWatchlistAlert
+	err  error
+}
+
+func (f *fakeWatchlistNotifier) SendNotification(ctx context.Context, userID uint64, notificationType, title, message string, data map[string]string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.sent = append(f.sent, sentWatchlistAlert{userID: userID, change: data["change"]})
+	return nil
+}
+
+func newWatchedFeature(id, userID uint64) *models.WatchedFeature {
+	return &models.WatchedFeature{
+		FeatureWatch: models.FeatureWatch{
+			ID:           id,
+			UserID:       userID,
+			FeatureID:    100 + id,
+			LastOwnerID:  5,
+			LastPricePSC: "10",
+			LastPriceIRR: "0",
+		},
+		PropertiesID: "HM-200",
+		OwnerID:      5,
+		PricePSC:     "10",
+		PriceIRR:     "0",
+	}
+}
+
+func TestWatchlistChanges(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(w *models.WatchedFeature)
+		want   []string
+	}{
+		{
+			name:   "unchanged",
+			modify: func(w *models.WatchedFeature) {},
+			want:   nil,
+		},
+		{
+			name:   "new sell request",
+			modify: func(w *models.WatchedFeature) { w.SellRequestID = 9 },
+			want:   []string{WatchlistChangeSellRequest},
+		},
+		{
+			name: "sell request withdrawn",
+			modify: func(w *models.WatchedFeature) {
+				w.LastSellRequestID = 9
+				w.SellRequestID = 0
+			},
+			want: nil,
+		},
+		{
+			name:   "price change",
+			modify: func(w *models.WatchedFeature) { w.PriceIRR = "5000" },
+			want:   []string{WatchlistChangePrice},
+		},
+		{
+			name: "sold with new price",
+			modify: func(w *models.WatchedFeature) {
+				w.OwnerID = 6
+				w.PricePSC = "0"
+			},
+			want: []string{WatchlistChangePrice, WatchlistChangeOwner},
+		},
+		{
+			name:   "bought by the watcher",
+			modify: func(w *models.WatchedFeature) { w.OwnerID = w.UserID },
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			watch := newWatchedFeature(1, 42)
+			tt.modify(watch)
+
+			got := watchlistChanges(watch)
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("expected %v, got %v", tt.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestWatchlistAlertWorker_Run(t *testing.T) {
+	onSale := newWatchedFeature(1, 42)
+	onSale.SellRequestID = 7
+	sold := newWatchedFeature(2, 43)
+	sold.OwnerID = 8
+
+	repo := &fakeWatchlistAlertRepository{changed: []*models.WatchedFeature{onSale, sold}}
+	notifier := &fakeWatchlistNotifier{}
+	worker := NewWatchlistAlertWorker(repo, notifier, 0, logger.NewLogger("test"))
+
+	sent, err := worker.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if sent != 2 {
+		t.Errorf("expected 2 alerts, got %d", sent)
+	}
+	if len(notifier.sent) != 2 ||
+		notifier.sent[0] != (sentWatchlistAlert{userID: 42, change: WatchlistChangeSellRequest}) ||
+		notifier.sent[1] != (sentWatchlistAlert{userID: 43, change: WatchlistChangeOwner}) {
+		t.Errorf("unexpected alerts: %+v", notifier.sent)
+	}
+	if len(repo.snapshots) != 2 {
+		t.Errorf("expected both snapshots updated, got %v", repo.snapshots)
+	}
+}
+
+func TestWatchlistAlertWorker_RunAdvancesSnapshotOnFailure(t *testing.T) {
+	watch := newWatchedFeature(1, 42)
+	watch.PricePSC = "20"
+
+	repo := &fakeWatchlistAlertRepository{changed: []*models.WatchedFeature{watch}}
+	notifier := &fakeWatchlistNotifier{err: errors.New("unavailable")}
+	worker := NewWatchlistAlertWorker(repo, notifier, 0, logger.NewLogger("test"))
+
+	sent, err := worker.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if sent != 0 {
+		t.Errorf("expected no alerts sent, got %d", sent)
+	}
+	if len(repo.snapshots) != 1 {
+		t.Errorf("expected snapshot to advance, got %v", repo.snapshots)
+	}
+}
+
+func TestWatchlistAlertWorker_NoNotifier(t *testing.T) {
+	watch := newWatchedFeature(1, 42)
+	watch.OwnerID = 9
+
+	repo := &fakeWatchlistAlertRepository{changed: []*models.WatchedFeature{watch}}
+	worker := NewWatchlistAlertWorker(repo, nil, 0, logger.NewLogger("test"))
+
+	if _, err := worker.Run(context.Background()); err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if len(repo.snapshots) != 1 {
+		t.Errorf("expected snapshot to advance without notifier, got %v", repo.snapshots)
+	}
+	if worker.interval != DefaultWatchlistAlertInterval {
+		t.Errorf("expected default interval, got %v", worker.interval)
+	}
+}
@@ -5,10 +5,10 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
-	"time"
 
 	"metargb/financial-service/internal/models"
 	"metargb/financial-service/internal/parsian"
+	"metargb/shared/pkg/logger"
 )
 
 // Mock repositories
@@ -106,17 +106,14 @@ func (m *mockVariableRepo) GetRate(ctx context.Context, asset string) (float64,
 	return 0, sql.ErrNoRows
 }
 
-type mockFirstOrderRepo struct {
-	count int
-}
+type mockProcessedCallbackRepo struct{}
 
-func (m *mockFirstOrderRepo) Create(ctx context.Context, firstOrder *models.FirstOrder) error {
-	m.count++
-	return nil
+func (m *mockProcessedCallbackRepo) Claim(ctx context.Context, callback *models.ProcessedCallback) (bool, error) {
+	return true, nil
 }
 
-func (m *mockFirstOrderRepo) Count(ctx context.Context, userID uint64) (int, error) {
-	return m.count, nil
+func (m *mockProcessedCallbackRepo) UpdateResult(ctx context.Context, id uint64, result string) error {
+	return nil
 }
 
 type mockParsianClient struct {
@@ -126,41 +123,52 @@ type mockParsianClient struct {
 	verifyError     error
 }
 
-func (m *mockParsianClient) RequestPayment(params parsian.RequestParams) (*parsian.RequestResponse, error) {
+func (m *mockParsianClient) RequestPayment(ctx context.Context, params parsian.RequestParams) (*parsian.RequestResponse, error) {
 	if m.requestError != nil {
 		return nil, m.requestError
 	}
 	return m.requestResponse, nil
 }
 
-func (m *mockParsianClient) VerifyPayment(params parsian.VerificationParams) (*parsian.VerificationResponse, error) {
+func (m *mockParsianClient) VerifyPayment(ctx context.Context, params parsian.VerificationParams) (*parsian.VerificationResponse, error) {
 	if m.verifyError != nil {
 		return nil, m.verifyError
 	}
 	return m.verifyResponse, nil
 }
 
-type mockOrderPolicy struct {
-	canBuy      bool
-	canGetBonus bool
+type mockCommercialPayments struct {
+	refuse bool
 }
 
-func (m *mockOrderPolicy) CanBuyFromStore(ctx context.Context, userID uint64) (bool, error) {
-	return m.canBuy, nil
+func (m *mockCommercialPayments) ScreenPayment(ctx context.Context, userID uint64, asset string, amount float64, ip, device string) error {
+	if m.refuse {
+		return ErrPaymentRefused
+	}
+	return nil
+}
+
+func (m *mockCommercialPayments) ScreenPaymentCard(ctx context.Context, userID, orderID uint64, asset string, amount float64, cardPan string) error {
+	if m.refuse {
+		return ErrPaymentRefused
+	}
+	return nil
 }
 
-func (m *mockOrderPolicy) CanGetBonus(ctx context.Context, userID uint64, asset string) (bool, error) {
-	return m.canGetBonus, nil
+func (m *mockCommercialPayments) SettleOrder(ctx context.Context, orderID uint64, refID int64) error {
+	return nil
 }
 
-type mockJalaliConverter struct{}
+func (m *mockCommercialPayments) PublishOrderStatus(ctx context.Context, orderID uint64, status, message string) error {
+	return nil
+}
 
-func (m *mockJalaliConverter) NowJalali() string {
-	return "1403/01/01"
+type mockOrderPolicy struct {
+	canBuy bool
 }
 
-func (m *mockJalaliConverter) FormatJalaliDate(t time.Time) string {
-	return "1403/01/01"
+func (m *mockOrderPolicy) CanBuyFromStore(ctx context.Context, userID uint64) (bool, error) {
+	return m.canBuy, nil
 }
 
 func TestOrderService_CreateOrder(t *testing.T) {
@@ -224,7 +232,6 @@ func TestOrderService_CreateOrder(t *testing.T) {
 			variableRepo := &mockVariableRepo{
 				rates: map[string]float64{"psc": tt.rate},
 			}
-			firstOrderRepo := &mockFirstOrderRepo{}
 			parsianClient := &mockParsianClient{
 				requestResponse: &parsian.RequestResponse{
 					Status: tt.parsianStatus,
@@ -232,7 +239,6 @@ func TestOrderService_CreateOrder(t *testing.T) {
 				},
 			}
 			orderPolicy := &mockOrderPolicy{canBuy: tt.canBuy}
-			jalaliConverter := &mockJalaliConverter{}
 
 			config := OrderConfig{
 				ParsianMerchantID:            "test_merchant",
@@ -246,15 +252,16 @@ func TestOrderService_CreateOrder(t *testing.T) {
 				transactionRepo,
 				paymentRepo,
 				variableRepo,
-				firstOrderRepo,
+				&mockProcessedCallbackRepo{},
 				parsianClient, // mockParsianClient implements ParsianClient interface
+				&mockCommercialPayments{},
 				orderPolicy,
-				jalaliConverter,
 				config,
+				logger.NewLogger("test"),
 			)
 
 			ctx := context.Background()
-			link, err := service.CreateOrder(ctx, tt.userID, tt.amount, tt.asset)
+			link, err := service.CreateOrder(ctx, tt.userID, tt.amount, tt.asset, "", "")
 
 			if tt.expectError {
 				if err == nil {
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"metargb/levels-service/internal/repository"
+)
+
+func TestActivityService_Heartbeat(t *testing.T) {
+	sessionColumns := []string{"id", "user_id", "start", "end", "total", "ip"}
+	newService := func(t *testing.T) (*ActivityService, sqlmock.Sqlmock) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+		return NewActivityService(
+			repository.NewActivityRepository(db),
+			repository.NewUserLogRepository(db),
+			repository.NewLevelRepository(db),
+		), mock
+	}
+	format := func(t time.Time) string { return t.Format("2006-01-02 15:04:05") }
+	ctx := context.Background()
+
+	t.Run("Starts a session when there is none", func(t *testing.T) {
+		svc, mock := newService(t)
+		mock.ExpectQuery("SELECT id, user_id, start, end, total, ip").WithArgs(1).
+			WillReturnRows(sqlmock.NewRows(sessionColumns))
+		mock.ExpectExec("INSERT INTO user_activities").WithArgs(1, "10.0.0.1").
+			WillReturnResult(sqlmock.NewResult(7, 1))
+
+		result, err := svc.Heartbeat(ctx, 1, "10.0.0.1")
+		require.NoError(t, err)
+		assert.True(t, result.Accepted)
+		assert.Equal(t, uint64(7), result.ActivityID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Extends a recent session and debounces", func(t *testing.T) {
+		svc, mock := newService(t)
+		now := time.Now()
+		mock.ExpectQuery("SELECT id, user_id, start, end, total, ip").WithArgs(1).
+			WillReturnRows(sqlmock.NewRows(sessionColumns).
+				AddRow(3, 1, format(now.Add(-20*time.Minute)), format(now.Add(-time.Minute)), 19, "10.0.0.1"))
+		mock.ExpectExec("UPDATE user_activities").WithArgs(sqlmock.AnyArg(), 20, 3).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		// 50 minutes in total before and after still start only the first hour
+		mock.ExpectQuery("SELECT COALESCE\\(SUM\\(total\\), 0\\) FROM user_activities").WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"total"}).AddRow(50))
+
+		result, err := svc.Heartbeat(ctx, 1, "10.0.0.1")
+		require.NoError(t, err)
+		assert.True(t, result.Accepted)
+		assert.Equal(t, uint64(3), result.ActivityID)
+		assert.Equal(t, int32(20), result.SessionMinutes)
+
+		result, err = svc.Heartbeat(ctx, 1, "10.0.0.1")
+		require.NoError(t, err)
+		assert.False(t, result.Accepted)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Starts a new session after a long gap", func(t *testing.T) {
+		svc, mock := newService(t)
+		now := time.Now()
+		mock.ExpectQuery("SELECT id, user_id, start, end, total, ip").WithArgs(1).
+			WillReturnRows(sqlmock.NewRows(sessionColumns).
+				AddRow(3, 1, format(now.Add(-2*time.Hour)), format(now.Add(-time.Hour)), 60, "10.0.0.1"))
+		mock.ExpectExec("INSERT INTO user_activities").WithArgs(1, "10.0.0.2").
+			WillReturnResult(sqlmock.NewResult(8, 1))
+
+		result, err := svc.Heartbeat(ctx, 1, "10.0.0.2")
+		require.NoError(t, err)
+		assert.Equal(t, uint64(8), result.ActivityID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "metargb/shared/pb/levels"
+	"metargb/shared/pkg/activity"
+)
+
+type fakeActivityRecorder struct {
+	calls []string
+	err   error
+}
+
+func (f *fakeActivityRecorder) record(call string) error {
+	f.calls = append(f.calls, call)
+	return f.err
+}
+
+func (f *fakeActivityRecorder) LogActivity(_ context.Context, req *pb.LogActivityRequest) (uint64, error) {
+	return 1, f.record("login:" + req.Ip)
+}
+
+func (f *fakeActivityRecorder) LogLogout(context.Context, uint64, string) error {
+	return f.record("logout")
+}
+
+func (f *fakeActivityRecorder) RecordTrade(_ context.Context, _ uint64, irrAmount, _ string) error {
+	return f.record("trade:" + irrAmount)
+}
+
+func (f *fakeActivityRecorder) RecordDeposit(_ context.Context, _ uint64, amount string) error {
+	return f.record("deposit:" + amount)
+}
+
+func (f *fakeActivityRecorder) RecordFollower(context.Context, uint64) error {
+	return f.record("follow")
+}
+
+func (f *fakeActivityRecorder) RecordUserEvent(_ context.Context, _ uint64, event, _, _ string) error {
+	return f.record("event:" + event)
+}
+
+type fakeProcessedEvents struct {
+	ids map[string]bool
+}
+
+func (f *fakeProcessedEvents) MarkProcessed(_ context.Context, eventID, _ string, _ uint64) (bool, error) {
+	if f.ids[eventID] {
+		return false, nil
+	}
+	f.ids[eventID] = true
+	return true, nil
+}
+
+func (f *fakeProcessedEvents) Unmark(_ context.Context, eventID string) error {
+	delete(f.ids, eventID)
+	return nil
+}
+
+type fakeOnboardingTracker struct {
+	steps []string
+}
+
+func (f *fakeOnboardingTracker) CompleteStep(_ context.Context, _ uint64, step string, _ time.Time) error {
+	f.steps = append(f.steps, step)
+	return nil
+}
+
+func TestActivityIngestor_Ingest(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Applies each event once", func(t *testing.T) {
+		recorder := &fakeActivityRecorder{}
+		ingestor := NewActivityIngestor(recorder, &fakeProcessedEvents{ids: map[string]bool{}}, nil)
+
+		event := activity.Event{ID: "trade-41", Type: activity.TypeTrade, UserID: 12, IRRAmount: "8000000"}
+		for i := 0; i < 2; i++ {
+			applied, err := ingestor.Ingest(ctx, event)
+			if err != nil {
+				t.Fatalf("Ingest returned error: %v", err)
+			}
+			if applied != (i == 0) {
+				t.Fatalf("delivery %d: applied = %v", i+1, applied)
+			}
+		}
+		if len(recorder.calls) != 1 || recorder.calls[0] != "trade:8000000" {
+			t.Errorf("unexpected calls %v", recorder.calls)
+		}
+	})
+
+	t.Run("Maps event types", func(t *testing.T) {
+		recorder := &fakeActivityRecorder{}
+		ingestor := NewActivityIngestor(recorder, &fakeProcessedEvents{ids: map[string]bool{}}, nil)
+
+		events := []activity.Event{
+			{ID: "1", Type: activity.TypeLogin, UserID: 12, IP: "10.0.0.1"},
+			{ID: "2", Type: activity.TypeLogout, UserID: 12},
+			{ID: "3", Type: activity.TypeDeposit, UserID: 12, Amount: "500000"},
+			{ID: "4", Type: activity.TypeFollow, UserID: 12},
+			{ID: "5", Type: activity.TypeBuild, UserID: 12},
+			{ID: "6", Type: activity.TypeTutorialCompleted, UserID: 12},
+		}
+		for _, event := range events {
+			if _, err := ingestor.Ingest(ctx, event); err != nil {
+				t.Fatalf("Ingest(%s) returned error: %v", event.Type, err)
+			}
+		}
+
+		want := []string{"login:10.0.0.1", "logout", "deposit:500000", "follow", "event:ساخت بنا", "event:تکمیل آموزش"}
+		if len(recorder.calls) != len(want) {
+			t.Fatalf("calls = %v, want %v", recorder.calls, want)
+		}
+		for i := range want {
+			if recorder.calls[i] != want[i] {
+				t.Errorf("call %d = %q, want %q", i, recorder.calls[i], want[i])
+			}
+		}
+	})
+
+	t.Run("Failed event is retried", func(t *testing.T) {
+		recorder := &fakeActivityRecorder{err: errors.New("database unavailable")}
+		ingestor := NewActivityIngestor(recorder, &fakeProcessedEvents{ids: map[string]bool{}}, nil)
+
+		event := activity.Event{ID: "follow-7", Type: activity.TypeFollow, UserID: 12}
+		if _, err := ingestor.Ingest(ctx, event); err == nil {
+			t.Fatal("expected the recorder error")
+		}
+
+		recorder.err = nil
+		applied, err := ingestor.Ingest(ctx, event)
+		if err != nil || !applied {
+			t.Fatalf("retry: applied = %v, err = %v", applied, err)
+		}
+	})
+
+	t.Run("Completes onboarding steps", func(t *testing.T) {
+		tracker := &fakeOnboardingTracker{}
+		ingestor := NewActivityIngestor(&fakeActivityRecorder{}, &fakeProcessedEvents{ids: map[string]bool{}}, tracker)
+
+		events := []activity.Event{
+			{ID: "1", Type: activity.TypePhoneVerified, UserID: 12},
+			{ID: "2", Type: activity.TypeFollow, UserID: 12},
+			{ID: "3", Type: activity.TypeBuild, UserID: 12},
+			{ID: "4", Type: activity.TypeDynastyJoined, UserID: 12},
+		}
+		for _, event := range events {
+			if _, err := ingestor.Ingest(ctx, event); err != nil {
+				t.Fatalf("Ingest(%s) returned error: %v", event.Type, err)
+			}
+		}
+
+		want := []string{"verify_phone", "build_first_building", "join_dynasty"}
+		if len(tracker.steps) != len(want) {
+			t.Fatalf("steps = %v, want %v", tracker.steps, want)
+		}
+		for i := range want {
+			if tracker.steps[i] != want[i] {
+				t.Errorf("step %d = %q, want %q", i, tracker.steps[i], want[i])
+			}
+		}
+	})
+
+	t.Run("Rejects invalid events", func(t *testing.T) {
+		ingestor := NewActivityIngestor(&fakeActivityRecorder{}, &fakeProcessedEvents{ids: map[string]bool{}}, nil)
+
+		if _, err := ingestor.Ingest(ctx, activity.Event{ID: "x", Type: activity.TypeLogin}); !errors.Is(err, activity.ErrInvalidEvent) {
+			t.Errorf("expected ErrInvalidEvent, got %v", err)
+		}
+	})
+}
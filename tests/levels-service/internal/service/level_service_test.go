@@ -70,18 +70,13 @@ func TestLevelService_GetLevel(t *testing.T) {
 		levelRows := sqlmock.NewRows([]string{"id", "name", "slug", "score", "background_image", "image_url"}).
 			AddRow(1, "Level 1", "level-1", 100, "bg1.jpg", "img1.jpg")
 
-		generalInfoRows := sqlmock.NewRows([]string{"id", "level_id", "score", "rank", "description", "subcategories",
-			"persian_font", "english_font", "file_volume", "used_colors", "points", "lines",
-			"has_animation", "designer", "model_designer", "creation_date", "png_file", "fbx_file", "gif_file"}).
-			WillReturnError(sql.ErrNoRows)
-
 		mock.ExpectQuery("SELECT l.id, l.name, l.slug, CAST\\(l.score AS UNSIGNED\\) as score").
 			WithArgs(uint64(1)).
 			WillReturnRows(levelRows)
 
-		mock.ExpectQuery("SELECT id, level_id, score, rank").
+		mock.ExpectQuery("SELECT id, level_id, score, `rank`").
 			WithArgs(uint64(1)).
-			WillReturnRows(generalInfoRows)
+			WillReturnError(sql.ErrNoRows)
 
 		level, err := service.GetLevel(ctx, 1, "")
 		require.NoError(t, err)
@@ -94,18 +89,13 @@ func TestLevelService_GetLevel(t *testing.T) {
 		levelRows := sqlmock.NewRows([]string{"id", "name", "slug", "score", "background_image", "image_url"}).
 			AddRow(1, "Level 1", "level-1", 100, "bg1.jpg", "img1.jpg")
 
-		generalInfoRows := sqlmock.NewRows([]string{"id", "level_id", "score", "rank", "description", "subcategories",
-			"persian_font", "english_font", "file_volume", "used_colors", "points", "lines",
-			"has_animation", "designer", "model_designer", "creation_date", "png_file", "fbx_file", "gif_file"}).
-			WillReturnError(sql.ErrNoRows)
-
 		mock.ExpectQuery("SELECT l.id, l.name, l.slug, CAST\\(l.score AS UNSIGNED\\) as score").
 			WithArgs("level-1").
 			WillReturnRows(levelRows)
 
-		mock.ExpectQuery("SELECT id, level_id, score, rank").
+		mock.ExpectQuery("SELECT id, level_id, score, `rank`").
 			WithArgs(uint64(1)).
-			WillReturnRows(generalInfoRows)
+			WillReturnError(sql.ErrNoRows)
 
 		level, err := service.GetLevel(ctx, 0, "level-1")
 		require.NoError(t, err)
@@ -150,7 +140,12 @@ func TestLevelService_GetLevelGeneralInfo(t *testing.T) {
 			WithArgs("level-1").
 			WillReturnRows(levelRows)
 
-		mock.ExpectQuery("SELECT id, level_id, score, rank").
+		// FindBySlug loads the general info along with the level
+		mock.ExpectQuery("SELECT id, level_id, score, `rank`").
+			WithArgs(uint64(1)).
+			WillReturnError(sql.ErrNoRows)
+
+		mock.ExpectQuery("SELECT id, level_id, score, `rank`").
 			WithArgs(uint64(1)).
 			WillReturnRows(generalInfoRows)
 
@@ -169,7 +164,11 @@ func TestLevelService_GetLevelGeneralInfo(t *testing.T) {
 			WithArgs("level-1").
 			WillReturnRows(levelRows)
 
-		mock.ExpectQuery("SELECT id, level_id, score, rank").
+		mock.ExpectQuery("SELECT id, level_id, score, `rank`").
+			WithArgs(uint64(1)).
+			WillReturnError(sql.ErrNoRows)
+
+		mock.ExpectQuery("SELECT id, level_id, score, `rank`").
 			WithArgs(uint64(1)).
 			WillReturnError(sql.ErrNoRows)
 
@@ -430,7 +429,7 @@ func TestLevelService_GetUserLevel(t *testing.T) {
 		nextLevelRows := sqlmock.NewRows([]string{"id", "name", "slug", "score", "background_image", "image_url"}).
 			AddRow(3, "Level 3", "level-3", 300, "bg3.jpg", "img3.jpg")
 
-		mock.ExpectQuery("SELECT l.id, l.name, l.slug, l.score").
+		mock.ExpectQuery("SELECT l.id, l.name, l.slug, CAST\\(l.score AS UNSIGNED\\) as score").
 			WithArgs(userID).
 			WillReturnRows(latestLevelRows)
 
@@ -438,7 +437,7 @@ func TestLevelService_GetUserLevel(t *testing.T) {
 			WithArgs(int32(200)).
 			WillReturnRows(previousLevelsRows)
 
-		mock.ExpectQuery("SELECT score FROM user_logs").
+		mock.ExpectQuery("SELECT score FROM users WHERE id = \\?").
 			WithArgs(userID).
 			WillReturnRows(sqlmock.NewRows([]string{"score"}).AddRow(250))
 
@@ -453,10 +452,11 @@ func TestLevelService_GetUserLevel(t *testing.T) {
 		assert.Equal(t, uint64(2), userLevel.LatestLevel.Id)
 		assert.Len(t, userLevel.PreviousLevels, 1)
 		assert.Equal(t, int32(250), userLevel.UserScore)
+		assert.Equal(t, int32(83), userLevel.ScorePercentageToNextLevel)
 	})
 
 	t.Run("UserHasNoLevel", func(t *testing.T) {
-		mock.ExpectQuery("SELECT l.id, l.name, l.slug, l.score").
+		mock.ExpectQuery("SELECT l.id, l.name, l.slug, CAST\\(l.score AS UNSIGNED\\) as score").
 			WithArgs(userID).
 			WillReturnError(sql.ErrNoRows)
 
@@ -471,6 +471,38 @@ func TestLevelService_GetUserLevel(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestLevelService_GetUserScores(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	levelRepo := repository.NewLevelRepository(db)
+	userLogRepo := repository.NewUserLogRepository(db)
+	service := NewLevelService(levelRepo, userLogRepo)
+
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery("SELECT id, score FROM users WHERE id IN \\(\\?,\\?,\\?\\)").
+			WithArgs(uint64(1), uint64(2), uint64(3)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "score"}).
+				AddRow(1, "250.5").
+				AddRow(2, nil))
+
+		scores, err := service.GetUserScores(ctx, []uint64{1, 2, 3})
+		require.NoError(t, err)
+		assert.Equal(t, map[uint64]int32{1: 250, 2: 0}, scores)
+	})
+
+	t.Run("NoUsers", func(t *testing.T) {
+		scores, err := service.GetUserScores(ctx, nil)
+		require.NoError(t, err)
+		assert.Empty(t, scores)
+	})
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestLevelService_ClaimPrize(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -497,7 +529,7 @@ func TestLevelService_ClaimPrize(t *testing.T) {
 			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
 
 		mock.ExpectExec("INSERT INTO recieved_level_prizes").
-			WithArgs(userID, uint64(1), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WithArgs(userID, uint64(1)).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
 		err := service.ClaimPrize(ctx, userID, levelID)
@@ -511,7 +543,7 @@ func TestLevelService_ClaimPrize(t *testing.T) {
 
 		err := service.ClaimPrize(ctx, userID, levelID)
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "failed to get level prize")
+		assert.Contains(t, err.Error(), "prize not found for level")
 	})
 
 	t.Run("PrizeAlreadyClaimed", func(t *testing.T) {
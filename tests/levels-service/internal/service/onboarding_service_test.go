@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"metargb/levels-service/internal/models"
+)
+
+type fakeOnboardingStore struct {
+	steps  map[string]time.Time
+	reward *models.OnboardingReward
+}
+
+func newFakeOnboardingStore() *fakeOnboardingStore {
+	return &fakeOnboardingStore{steps: map[string]time.Time{}}
+}
+
+func (f *fakeOnboardingStore) CompleteStep(_ context.Context, _ uint64, step string, at time.Time) (bool, error) {
+	if _, ok := f.steps[step]; ok {
+		return false, nil
+	}
+	f.steps[step] = at
+	return true, nil
+}
+
+func (f *fakeOnboardingStore) GetCompletedSteps(context.Context, uint64) (map[string]time.Time, error) {
+	completed := make(map[string]time.Time, len(f.steps))
+	for step, at := range f.steps {
+		completed[step] = at
+	}
+	return completed, nil
+}
+
+func (f *fakeOnboardingStore) GetReward(context.Context, uint64) (*models.OnboardingReward, error) {
+	if f.reward == nil {
+		return nil, nil
+	}
+	reward := *f.reward
+	return &reward, nil
+}
+
+func (f *fakeOnboardingStore) CreateReward(_ context.Context, userID uint64, asset string, amount float64) error {
+	if f.reward == nil {
+		f.reward = &models.OnboardingReward{UserID: userID, Asset: asset, Amount: amount}
+	}
+	return nil
+}
+
+func (f *fakeOnboardingStore) MarkRewardGranted(_ context.Context, _ uint64, at time.Time) error {
+	if f.reward != nil && f.reward.GrantedAt == nil {
+		f.reward.GrantedAt = &at
+	}
+	return nil
+}
+
+type fakeWallet struct {
+	credits []float64
+	err     error
+}
+
+func (f *fakeWallet) AddBalance(_ context.Context, _ uint64, _ string, amount float64) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.credits = append(f.credits, amount)
+	return nil
+}
+
+func completeAllSteps(t *testing.T, svc *OnboardingService) error {
+	t.Helper()
+	var err error
+	for _, def := range models.OnboardingSteps {
+		err = svc.CompleteStep(context.Background(), 12, def.Key, time.Time{})
+	}
+	return err
+}
+
+func TestOnboardingService_GetOnboardingState(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Lists steps in checklist order", func(t *testing.T) {
+		store := newFakeOnboardingStore()
+		svc := NewOnboardingService(store, &fakeWallet{}, "", 100)
+
+		at := time.Unix(1760000000, 0)
+		if err := svc.CompleteStep(ctx, 12, models.OnboardingBuyFirstLand, at); err != nil {
+			t.Fatalf("CompleteStep returned error: %v", err)
+		}
+
+		state, err := svc.GetOnboardingState(ctx, 12)
+		if err != nil {
+			t.Fatalf("GetOnboardingState returned error: %v", err)
+		}
+		if len(state.Steps) != len(models.OnboardingSteps) || state.TotalSteps != int32(len(models.OnboardingSteps)) {
+			t.Fatalf("unexpected steps %v", state.Steps)
+		}
+		for i, def := range models.OnboardingSteps {
+			step := state.Steps[i]
+			if step.Key != def.Key {
+				t.Errorf("step %d = %q, want %q", i, step.Key, def.Key)
+			}
+			if step.Completed != (def.Key == models.OnboardingBuyFirstLand) {
+				t.Errorf("step %q completed = %v", step.Key, step.Completed)
+			}
+		}
+		if state.CompletedSteps != 1 || state.Completed {
+			t.Errorf("completed_steps = %d, completed = %v", state.CompletedSteps, state.Completed)
+		}
+		if state.Steps[2].CompletedAt != at.Unix() {
+			t.Errorf("completed_at = %d, want %d", state.Steps[2].CompletedAt, at.Unix())
+		}
+		if state.Reward == nil || state.Reward.Asset != DefaultOnboardingRewardAsset || state.Reward.Amount != 100 || state.Reward.Granted {
+			t.Errorf("unexpected reward %v", state.Reward)
+		}
+	})
+
+	t.Run("No reward when disabled", func(t *testing.T) {
+		svc := NewOnboardingService(newFakeOnboardingStore(), nil, "psc", 100)
+
+		state, err := svc.GetOnboardingState(ctx, 12)
+		if err != nil {
+			t.Fatalf("GetOnboardingState returned error: %v", err)
+		}
+		if state.Reward != nil {
+			t.Errorf("expected no reward, got %v", state.Reward)
+		}
+	})
+}
+
+func TestOnboardingService_CompleteStep(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Pays the reward once", func(t *testing.T) {
+		store := newFakeOnboardingStore()
+		wallet := &fakeWallet{}
+		svc := NewOnboardingService(store, wallet, "psc", 100)
+
+		if err := completeAllSteps(t, svc); err != nil {
+			t.Fatalf("CompleteStep returned error: %v", err)
+		}
+		// A redelivered event for the last step must not pay again
+		if err := svc.CompleteStep(ctx, 12, models.OnboardingJoinDynasty, time.Time{}); err != nil {
+			t.Fatalf("CompleteStep returned error: %v", err)
+		}
+
+		if len(wallet.credits) != 1 || wallet.credits[0] != 100 {
+			t.Errorf("credits = %v, want [100]", wallet.credits)
+		}
+		state, err := svc.GetOnboardingState(ctx, 12)
+		if err != nil {
+			t.Fatalf("GetOnboardingState returned error: %v", err)
+		}
+		if !state.Completed || state.Reward == nil || !state.Reward.Granted || state.Reward.GrantedAt == 0 {
+			t.Errorf("unexpected state %v", state)
+		}
+	})
+
+	t.Run("Failed payment is retried", func(t *testing.T) {
+		store := newFakeOnboardingStore()
+		wallet := &fakeWallet{err: errors.New("commercial service unavailable")}
+		svc := NewOnboardingService(store, wallet, "psc", 100)
+
+		if err := completeAllSteps(t, svc); err == nil {
+			t.Fatal("expected the wallet error")
+		}
+		if store.reward == nil || store.reward.GrantedAt != nil {
+			t.Fatalf("unexpected reward %v", store.reward)
+		}
+
+		wallet.err = nil
+		if err := svc.CompleteStep(ctx, 12, models.OnboardingJoinDynasty, time.Time{}); err != nil {
+			t.Fatalf("retry returned error: %v", err)
+		}
+		if len(wallet.credits) != 1 || store.reward.GrantedAt == nil {
+			t.Errorf("credits = %v, reward = %v", wallet.credits, store.reward)
+		}
+	})
+
+	t.Run("Nothing is paid before the last step", func(t *testing.T) {
+		wallet := &fakeWallet{}
+		svc := NewOnboardingService(newFakeOnboardingStore(), wallet, "psc", 100)
+
+		if err := svc.CompleteStep(ctx, 12, models.OnboardingVerifyPhone, time.Time{}); err != nil {
+			t.Fatalf("CompleteStep returned error: %v", err)
+		}
+		if len(wallet.credits) != 0 {
+			t.Errorf("credits = %v, want none", wallet.credits)
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"metargb/levels-service/internal/repository"
+)
+
+func expectScoreSources(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("FROM user_logs\\s+WHERE user_id IN").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "transactions_count", "followers_count", "deposit_amount", "activity_hours", "score"}).
+			AddRow(1, "4", "0.5", "10", "0", "14").
+			AddRow(2, "2", "0.0", "0", "0.1", "2"))
+	mock.ExpectQuery("JOIN trades t").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "count"}).AddRow(1, 3).AddRow(2, 1))
+	mock.ExpectQuery("FROM followers WHERE followed_id IN").
+		WillReturnRows(sqlmock.NewRows([]string{"followed_id", "count"}).AddRow(1, 5))
+	mock.ExpectQuery("FROM user_activities WHERE user_id IN").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "total"}).AddRow(1, 125).AddRow(2, 30))
+}
+
+func TestScoreRecalculationService_DryRun(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	svc := NewScoreRecalculationService(repository.NewUserLogRepository(db), repository.NewLevelRepository(db))
+	levelColumns := []string{"id", "name", "slug", "score", "background_image", "image_url"}
+
+	mock.ExpectQuery("SELECT user_id FROM user_logs WHERE user_id > \\? ORDER BY user_id LIMIT \\?").
+		WithArgs(0, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(1).AddRow(2))
+	expectScoreSources(mock)
+	mock.ExpectQuery("SELECT l.id, l.name, l.slug").WithArgs(16, 1).
+		WillReturnRows(sqlmock.NewRows(levelColumns).AddRow(3, "Level 3", "level-3", 15, "", ""))
+	mock.ExpectQuery("SELECT l.id, l.name, l.slug").WithArgs(2, 2).
+		WillReturnRows(sqlmock.NewRows(levelColumns))
+	mock.ExpectQuery("SELECT user_id FROM user_logs WHERE user_id > \\? ORDER BY user_id LIMIT \\?").
+		WithArgs(2, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+
+	var changes []ScoreChange
+	result, err := svc.Recalculate(context.Background(), ScoreRecalculationOptions{ChunkSize: 2}, func(change ScoreChange) {
+		changes = append(changes, change)
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, result.UsersChecked)
+	assert.Equal(t, 1, result.UsersChanged)
+	assert.Equal(t, 1, result.LevelsReached)
+	assert.Equal(t, uint64(2), result.LastUserID)
+	assert.False(t, result.Applied)
+
+	require.Len(t, changes, 1)
+	change := changes[0]
+	assert.Equal(t, uint64(1), change.UserID)
+	assert.Equal(t, int32(14), change.OldScore)
+	// 3 trades * 2 + 5 followers * 0.1 + 10 deposit + ceil(125 / 60) * 0.1
+	assert.Equal(t, int32(16), change.NewScore)
+	assert.InDelta(t, 6, change.New.Transactions, 0.001)
+	assert.InDelta(t, 0.5, change.New.Followers, 0.001)
+	assert.InDelta(t, 10, change.New.Deposit, 0.001)
+	assert.InDelta(t, 0.3, change.New.ActivityHours, 0.001)
+	require.NotNil(t, change.ReachedLevel)
+	assert.Equal(t, "level-3", change.ReachedLevel.Slug)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestScoreRecalculationService_ApplySingleUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	svc := NewScoreRecalculationService(repository.NewUserLogRepository(db), repository.NewLevelRepository(db))
+
+	mock.ExpectQuery("FROM user_logs\\s+WHERE user_id IN").WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "transactions_count", "followers_count", "deposit_amount", "activity_hours", "score"}).
+			AddRow(1, "4", "0.5", "10", "0", "14"))
+	mock.ExpectQuery("JOIN trades t").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "count"}).AddRow(1, 3))
+	mock.ExpectQuery("FROM followers WHERE followed_id IN").
+		WillReturnRows(sqlmock.NewRows([]string{"followed_id", "count"}).AddRow(1, 5))
+	mock.ExpectQuery("FROM user_activities WHERE user_id IN").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "total"}).AddRow(1, 125))
+	mock.ExpectQuery("SELECT l.id, l.name, l.slug").WithArgs(16, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "score", "background_image", "image_url"}).AddRow(3, "Level 3", "level-3", 15, "", ""))
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE user_logs").WithArgs("6", "0.5", "0.3", "16", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE users SET score").WithArgs("16", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec("INSERT INTO level_user").WithArgs(1, 3).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	result, err := svc.Recalculate(context.Background(), ScoreRecalculationOptions{UserID: 1, Apply: true}, nil)
+	require.NoError(t, err)
+	assert.True(t, result.Applied)
+	assert.Equal(t, 1, result.UsersChanged)
+	assert.Equal(t, 1, result.LevelsReached)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
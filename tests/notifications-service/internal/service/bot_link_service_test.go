@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"metargb/notifications-service/internal/errs"
+	"metargb/notifications-service/internal/models"
+)
+
+type fakeBotLinkStore struct {
+	codes map[string]models.BotLinkCode
+	links map[uint64]models.BotLink
+}
+
+func newFakeBotLinkStore() *fakeBotLinkStore {
+	return &fakeBotLinkStore{
+		codes: make(map[string]models.BotLinkCode),
+		links: make(map[uint64]models.BotLink),
+	}
+}
+
+func (s *fakeBotLinkStore) CreateLinkCode(ctx context.Context, code *models.BotLinkCode) error {
+	for key, existing := range s.codes {
+		if existing.UserID == code.UserID {
+			delete(s.codes, key)
+		}
+	}
+	s.codes[code.Code] = *code
+	return nil
+}
+
+func (s *fakeBotLinkStore) ConsumeLinkCode(ctx context.Context, provider, code string, now time.Time) (uint64, error) {
+	linkCode, ok := s.codes[code]
+	if !ok || linkCode.Provider != provider || !now.Before(linkCode.ExpiresAt) {
+		return 0, nil
+	}
+	delete(s.codes, code)
+	return linkCode.UserID, nil
+}
+
+func (s *fakeBotLinkStore) UpsertLink(ctx context.Context, link *models.BotLink) error {
+	s.links[link.UserID] = *link
+	return nil
+}
+
+func (s *fakeBotLinkStore) GetLink(ctx context.Context, userID uint64, provider string) (*models.BotLink, error) {
+	link, ok := s.links[userID]
+	if !ok {
+		return nil, nil
+	}
+	return &link, nil
+}
+
+func (s *fakeBotLinkStore) DeleteLink(ctx context.Context, userID uint64, provider string) error {
+	delete(s.links, userID)
+	return nil
+}
+
+func (s *fakeBotLinkStore) DeleteLinkByChat(ctx context.Context, provider, chatID string) error {
+	for userID, link := range s.links {
+		if link.ChatID == chatID {
+			delete(s.links, userID)
+		}
+	}
+	return nil
+}
+
+type fakeBot struct {
+	sent    map[string][]string
+	blocked map[string]bool
+}
+
+func newFakeBot() *fakeBot {
+	return &fakeBot{sent: make(map[string][]string), blocked: make(map[string]bool)}
+}
+
+func (b *fakeBot) Provider() string { return models.BotProviderTelegram }
+
+func (b *fakeBot) LinkURL(code string) string { return "https://t.me/test_bot?start=" + code }
+
+func (b *fakeBot) SendMessage(ctx context.Context, chatID, text string) (string, error) {
+	if b.blocked[chatID] {
+		return "", fmt.Errorf("%w: blocked", errs.ErrBotChatUnavailable)
+	}
+	b.sent[chatID] = append(b.sent[chatID], text)
+	return fmt.Sprintf("%d", len(b.sent[chatID])), nil
+}
+
+func TestBotLinkService_LinksChatWithCode(t *testing.T) {
+	ctx := context.Background()
+	store := newFakeBotLinkStore()
+	bot := newFakeBot()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	svc := NewBotLinkService(store, bot, 10*time.Minute).(*botLinkService)
+	svc.now = func() time.Time { return now }
+
+	code, err := svc.CreateLinkCode(ctx, 7)
+	require.NoError(t, err)
+	assert.Len(t, code.Code, botLinkCodeLength)
+	assert.Equal(t, "https://t.me/test_bot?start="+code.Code, code.LinkURL)
+
+	_, err = svc.GetLink(ctx, 7)
+	assert.ErrorIs(t, err, errs.ErrBotNotLinked)
+
+	// The t.me link sends the code with /start
+	require.NoError(t, svc.HandleMessage(ctx, models.BotMessage{ChatID: "42", Username: "sara", Text: "/start " + code.Code}))
+	link, err := svc.GetLink(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, "42", link.ChatID)
+	assert.Equal(t, "sara", link.Username)
+	assert.Equal(t, botReplyLinked, bot.sent["42"][0])
+
+	// Codes work once
+	require.NoError(t, svc.HandleMessage(ctx, models.BotMessage{ChatID: "43", Text: code.Code}))
+	assert.Equal(t, botReplyInvalidCode, bot.sent["43"][0])
+
+	// Typed codes are accepted in lower case, but not after they expire
+	code, err = svc.CreateLinkCode(ctx, 8)
+	require.NoError(t, err)
+	now = now.Add(11 * time.Minute)
+	require.NoError(t, svc.HandleMessage(ctx, models.BotMessage{ChatID: "44", Text: code.Code}))
+	assert.Equal(t, botReplyInvalidCode, bot.sent["44"][0])
+	code, err = svc.CreateLinkCode(ctx, 8)
+	require.NoError(t, err)
+	require.NoError(t, svc.HandleMessage(ctx, models.BotMessage{ChatID: "44", Text: " " + strings.ToLower(code.Code) + " "}))
+	assert.Equal(t, "44", store.links[8].ChatID)
+
+	// /stop unlinks the chat it is sent from
+	require.NoError(t, svc.HandleMessage(ctx, models.BotMessage{ChatID: "42", Text: "/stop"}))
+	_, err = svc.GetLink(ctx, 7)
+	assert.ErrorIs(t, err, errs.ErrBotNotLinked)
+
+	// Without a bot nothing can be linked
+	_, err = NewBotLinkService(store, nil, 0).CreateLinkCode(ctx, 7)
+	assert.ErrorIs(t, err, errs.ErrBotNotConfigured)
+}
+
+func TestBotLinkService_SendToUserUnlinksBlockedChats(t *testing.T) {
+	ctx := context.Background()
+	store := newFakeBotLinkStore()
+	bot := newFakeBot()
+	svc := NewBotLinkService(store, bot, 0)
+	store.links[7] = models.BotLink{UserID: 7, Provider: models.BotProviderTelegram, ChatID: "42"}
+
+	_, sent, err := svc.SendToUser(ctx, 7, "hello")
+	require.NoError(t, err)
+	assert.True(t, sent)
+
+	_, sent, err = svc.SendToUser(ctx, 8, "hello")
+	require.NoError(t, err)
+	assert.False(t, sent, "users without a linked chat are skipped")
+
+	bot.blocked["42"] = true
+	_, sent, err = svc.SendToUser(ctx, 7, "hello")
+	require.NoError(t, err)
+	assert.False(t, sent)
+	assert.NotContains(t, store.links, uint64(7), "chats that blocked the bot are unlinked")
+}
+
+func TestBotRoutedSMSChannel(t *testing.T) {
+	ctx := context.Background()
+	store := newFakeBotLinkStore()
+	bot := newFakeBot()
+	links := NewBotLinkService(store, bot, 0)
+	store.links[7] = models.BotLink{UserID: 7, Provider: models.BotProviderTelegram, ChatID: "42"}
+	rules := models.BotRoutingRules{PhonePrefixes: []string{"0990"}, FallbackOnSMSFailure: true}
+
+	// Operators routed to the bot skip SMS for linked users
+	sms := new(MockSMSChannel)
+	channel := NewBotRoutedSMSChannel(sms, links, rules)
+	messageID, err := channel.SendOTP(ctx, models.OTPPayload{Phone: "+989901234567", Code: "123456", UserID: 7})
+	require.NoError(t, err)
+	assert.True(t, IsBotMessage(messageID))
+	assert.Contains(t, bot.sent["42"][0], "123456")
+	sms.AssertNotCalled(t, "SendOTP", mock.Anything, mock.Anything)
+
+	// Other operators get SMS, and the bot when SMS fails
+	sms = new(MockSMSChannel)
+	sms.On("SendOTP", mock.Anything, mock.Anything).Return("", errors.New("operator unreachable"))
+	channel = NewBotRoutedSMSChannel(sms, links, rules)
+	messageID, err = channel.SendOTP(ctx, models.OTPPayload{Phone: "09120000000", Code: "654321", UserID: 7})
+	require.NoError(t, err)
+	assert.True(t, IsBotMessage(messageID))
+	assert.Contains(t, bot.sent["42"][1], "654321")
+
+	// Without a linked chat the SMS error is returned
+	_, err = channel.SendOTP(ctx, models.OTPPayload{Phone: "09120000000", Code: "654321", UserID: 8})
+	assert.EqualError(t, err, "operator unreachable")
+
+	// Bulk messages are never rerouted, critical ones are
+	sms = new(MockSMSChannel)
+	sms.On("SendSMS", mock.Anything, mock.Anything).Return("", errors.New("operator unreachable"))
+	channel = NewBotRoutedSMSChannel(sms, links, rules)
+	_, err = channel.SendSMS(ctx, models.SMSPayload{Phone: "09120000000", Message: "sale", UserID: 7})
+	assert.Error(t, err)
+	messageID, err = channel.SendSMS(ctx, models.SMSPayload{Phone: "09120000000", Message: "new login", UserID: 7, Critical: true})
+	require.NoError(t, err)
+	assert.True(t, IsBotMessage(messageID))
+	assert.Equal(t, "new login", bot.sent["42"][2])
+}
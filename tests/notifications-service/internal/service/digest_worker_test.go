@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"metargb/notifications-service/internal/models"
+)
+
+// fakeDigestStore keeps digest settings and queued items in memory
+type fakeDigestStore struct {
+	pending []models.PendingDigest
+	items   map[uint64][]models.DigestItem
+	sent    map[uint64][]uint64
+}
+
+func newFakeDigestStore() *fakeDigestStore {
+	return &fakeDigestStore{
+		items: make(map[uint64][]models.DigestItem),
+		sent:  make(map[uint64][]uint64),
+	}
+}
+
+func (s *fakeDigestStore) GetDigestSettings(ctx context.Context, userID uint64) (models.DigestSettings, error) {
+	return models.DefaultDigestSettings(), nil
+}
+
+func (s *fakeDigestStore) UpsertDigestSettings(ctx context.Context, userID uint64, settings models.DigestSettings) error {
+	return nil
+}
+
+func (s *fakeDigestStore) QueueDigestItem(ctx context.Context, item *models.DigestItem) error {
+	item.ID = uint64(len(s.items[item.UserID]) + 1)
+	s.items[item.UserID] = append(s.items[item.UserID], *item)
+	return nil
+}
+
+func (s *fakeDigestStore) ListPendingDigests(ctx context.Context) ([]models.PendingDigest, error) {
+	return s.pending, nil
+}
+
+func (s *fakeDigestStore) ListDigestItems(ctx context.Context, userID uint64) ([]models.DigestItem, error) {
+	return s.items[userID], nil
+}
+
+func (s *fakeDigestStore) MarkDigestSent(ctx context.Context, userID uint64, itemIDs []uint64, sentAt time.Time) error {
+	s.sent[userID] = append(s.sent[userID], itemIDs...)
+	return nil
+}
+
+func TestDigestWorker_Run(t *testing.T) {
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	store := newFakeDigestStore()
+	store.pending = []models.PendingDigest{
+		{UserID: 1, Settings: models.DigestSettings{Mode: models.DigestModeHourly, Timezone: "UTC"}, OldestQueued: now.Add(-2 * time.Hour)},
+		{UserID: 2, Settings: models.DigestSettings{Mode: models.DigestModeHourly, Timezone: "UTC"}, OldestQueued: now.Add(-10 * time.Minute)},
+	}
+	store.items[1] = []models.DigestItem{
+		{ID: 1, UserID: 1, Channel: models.ChannelEmail, Category: models.CategoryMarketplace, Title: "Offer received", Message: "A buyer sent an offer", Recipient: "old@example.com"},
+		{ID: 2, UserID: 1, Channel: models.ChannelEmail, Category: models.CategoryDynasty, Title: "Join request", Message: "A member wants to join", Recipient: "user@example.com"},
+		{ID: 3, UserID: 1, Channel: models.ChannelSMS, Category: models.CategorySupport, Title: "Ticket answered", Message: "Support replied", Recipient: "09120000000"},
+	}
+	store.items[2] = []models.DigestItem{
+		{ID: 4, UserID: 2, Channel: models.ChannelSMS, Category: models.CategoryMarketing, Title: "News", Message: "New season", Recipient: "09121111111"},
+	}
+
+	email := new(MockEmailChannel)
+	email.On("SendEmail", mock.Anything, mock.MatchedBy(func(p models.EmailPayload) bool {
+		return p.To == "user@example.com" &&
+			strings.Contains(p.Subject, "(2)") &&
+			strings.Contains(p.Body, "Offer received") &&
+			strings.Contains(p.Body, "Join request")
+	})).Return("email-1", nil).Once()
+
+	sms := new(MockSMSChannel)
+	sms.On("SendSMS", mock.Anything, mock.MatchedBy(func(p models.SMSPayload) bool {
+		return p.Phone == "09120000000"
+	})).Return("sms-1", errors.New("provider unavailable")).Once()
+
+	worker := NewDigestWorker(store, sms, email, 9, time.Minute)
+	worker.now = func() time.Time { return now }
+
+	sent, err := worker.Run(context.Background())
+	require.NoError(t, err)
+
+	// Only the email digest of user 1 went out; the failed SMS stays queued
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, []uint64{1, 2}, store.sent[1])
+	assert.Empty(t, store.sent[2])
+	email.AssertExpectations(t)
+	sms.AssertExpectations(t)
+}
+
+type fakeUserLocations map[uint64]string
+
+func (f fakeUserLocations) Location(ctx context.Context, userID uint64) *time.Location {
+	loc, err := time.LoadLocation(f[userID])
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func TestDigestWorker_RunUsesAccountTimezone(t *testing.T) {
+	// 07:30 UTC is 09:30 in Berlin and 11:00 in Tehran
+	now := time.Date(2024, 7, 1, 7, 30, 0, 0, time.UTC)
+
+	store := newFakeDigestStore()
+	store.pending = []models.PendingDigest{
+		// Follows the account timezone: due from 09:00 Berlin time
+		{UserID: 1, Settings: models.DigestSettings{Mode: models.DigestModeDaily}, OldestQueued: now.Add(-2 * time.Hour)},
+		// Quiet hours 10 to 12 in the Tehran time chosen for digests
+		{UserID: 2, Settings: models.DigestSettings{Mode: models.DigestModeDaily, Timezone: "Asia/Tehran", QuietHoursStart: 10, QuietHoursEnd: 12}, OldestQueued: now.Add(-2 * time.Hour)},
+	}
+	store.items[1] = []models.DigestItem{
+		{ID: 1, UserID: 1, Channel: models.ChannelSMS, Category: models.CategoryMarketplace, Title: "Offer received", Message: "A buyer sent an offer", Recipient: "09120000000"},
+	}
+	store.items[2] = []models.DigestItem{
+		{ID: 2, UserID: 2, Channel: models.ChannelSMS, Category: models.CategoryMarketplace, Title: "Offer received", Message: "A buyer sent an offer", Recipient: "09121111111"},
+	}
+
+	sms := new(MockSMSChannel)
+	sms.On("SendSMS", mock.Anything, mock.MatchedBy(func(p models.SMSPayload) bool {
+		return p.Phone == "09120000000"
+	})).Return("sms-1", nil).Once()
+
+	worker := NewDigestWorker(store, sms, nil, 9, time.Minute)
+	worker.now = func() time.Time { return now }
+	worker.SetLocations(fakeUserLocations{1: "Europe/Berlin", 2: "Europe/Berlin"})
+
+	sent, err := worker.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, []uint64{1}, store.sent[1])
+	assert.Empty(t, store.sent[2], "the digest timezone overrides the account one")
+	sms.AssertExpectations(t)
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"metargb/notifications-service/internal/errs"
+	"metargb/notifications-service/internal/models"
+)
+
+// newTestShapedSMSChannel returns a channel at 2 messages per second whose
+// clock only moves when it sleeps
+func newTestShapedSMSChannel(sms *MockSMSChannel, now time.Time) (*ShapedSMSChannel, *time.Time) {
+	channel := NewShapedSMSChannel(sms, SMSShapingConfig{
+		Provider:          "test",
+		MessagesPerSecond: 2,
+		MaxWait:           time.Second,
+		QuietHoursStart:   22,
+		QuietHoursEnd:     8,
+		Timezone:          "UTC",
+		QueueSize:         2,
+	})
+	clock := now
+	channel.now = func() time.Time { return clock }
+	channel.sleep = func(ctx context.Context, d time.Duration) error {
+		clock = clock.Add(d)
+		return nil
+	}
+	return channel, &clock
+}
+
+func TestShapedSMSChannel_SpacesSendsAndThrottles(t *testing.T) {
+	ctx := context.Background()
+	sms := new(MockSMSChannel)
+	sms.On("SendSMS", mock.Anything, mock.Anything).Return("id", nil)
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	channel, clock := newTestShapedSMSChannel(sms, start)
+
+	for i := 0; i < 3; i++ {
+		_, err := channel.SendSMS(ctx, models.SMSPayload{Phone: "09120000000", Message: "hi"})
+		require.NoError(t, err)
+	}
+	// At 2 per second the third message goes out a second after the first
+	assert.Equal(t, start.Add(time.Second), *clock)
+
+	// While the clock stands still, slots further than MaxWait away are refused
+	channel, _ = newTestShapedSMSChannel(sms, start)
+	channel.sleep = func(context.Context, time.Duration) error { return nil }
+	for i := 0; i < 3; i++ {
+		_, err := channel.SendSMS(ctx, models.SMSPayload{Phone: "09120000000", Message: "hi"})
+		require.NoError(t, err)
+	}
+	_, err := channel.SendSMS(ctx, models.SMSPayload{Phone: "09120000000", Message: "hi"})
+	assert.ErrorIs(t, err, errs.ErrSMSThrottled)
+	sms.AssertNumberOfCalls(t, "SendSMS", 6)
+}
+
+func TestShapedSMSChannel_QueuesBulkDuringQuietHours(t *testing.T) {
+	ctx := context.Background()
+	sms := new(MockSMSChannel)
+	sms.On("SendSMS", mock.Anything, mock.Anything).Return("id", nil)
+	sms.On("SendOTP", mock.Anything, mock.Anything).Return("otp", nil)
+	channel, clock := newTestShapedSMSChannel(sms, time.Date(2024, 5, 1, 23, 0, 0, 0, time.UTC))
+
+	messageID, err := channel.SendSMS(ctx, models.SMSPayload{Phone: "09120000000", Message: "sale"})
+	require.NoError(t, err)
+	assert.True(t, IsQueuedSMS(messageID))
+
+	// Transactional messages are not held back
+	_, err = channel.SendOTP(ctx, models.OTPPayload{Phone: "09120000000", Code: "1234"})
+	require.NoError(t, err)
+	_, err = channel.SendSMS(ctx, models.SMSPayload{Phone: "09120000000", Template: "verify", Tokens: map[string]string{"token": "1"}})
+	require.NoError(t, err)
+	sms.AssertNumberOfCalls(t, "SendSMS", 1)
+
+	// The queue is bounded
+	_, err = channel.SendSMS(ctx, models.SMSPayload{Phone: "09120000001", Message: "sale"})
+	require.NoError(t, err)
+	_, err = channel.SendSMS(ctx, models.SMSPayload{Phone: "09120000002", Message: "sale"})
+	assert.ErrorIs(t, err, errs.ErrSMSThrottled)
+
+	// Nothing is flushed before quiet hours end
+	channel.Flush(ctx)
+	assert.Equal(t, 2, channel.QueueLength())
+
+	*clock = time.Date(2024, 5, 2, 8, 0, 0, 0, time.UTC)
+	channel.Flush(ctx)
+	assert.Equal(t, 0, channel.QueueLength())
+	sms.AssertNumberOfCalls(t, "SendSMS", 3)
+}
+
+func TestShapedSMSChannel_DefaultProviderRates(t *testing.T) {
+	assert.Equal(t, SMSProviderRates["kavenegar"], DefaultSMSShapingConfig("kavenegar").MessagesPerSecond)
+	assert.Equal(t, DefaultSMSMessagesPerSecond, DefaultSMSShapingConfig("unknown").MessagesPerSecond)
+}
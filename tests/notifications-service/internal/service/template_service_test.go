@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"metargb/notifications-service/internal/errs"
+	"metargb/notifications-service/internal/models"
+	"metargb/notifications-service/templates"
+)
+
+func TestLoadEmailTemplates_RendersEveryTemplate(t *testing.T) {
+	emailTemplates, err := LoadEmailTemplates(templates.FS)
+	require.NoError(t, err)
+	require.Contains(t, emailTemplates.Names(), "email/otp")
+	require.Contains(t, emailTemplates.Names(), "email/dynasty/join_request_sent")
+
+	svc := NewTemplateService(emailTemplates, nil, nil, []uint64{1})
+	for _, name := range emailTemplates.Names() {
+		preview, err := svc.PreviewTemplate(context.Background(), 1, models.TemplateRender{Template: name})
+		require.NoError(t, err, name)
+		assert.NotContains(t, preview.HTMLBody, "<no value>", name)
+		assert.ElementsMatch(t, preview.Fields, preview.SampleFields, name)
+	}
+}
+
+func TestTemplateService_PreviewTemplate(t *testing.T) {
+	emailTemplates, err := LoadEmailTemplates(templates.FS)
+	require.NoError(t, err)
+	svc := NewTemplateService(emailTemplates, nil, nil, []uint64{1})
+
+	preview, err := svc.PreviewTemplate(context.Background(), 1, models.TemplateRender{
+		Template: "email/otp",
+		Subject:  "کد تأیید ورود",
+		Data:     map[string]string{"OtpCode": "135790", "Footer.Tagline": "Preview footer"},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, preview.HTMLBody, "135790")
+	assert.Contains(t, preview.HTMLBody, "Preview footer")
+	assert.Contains(t, preview.HTMLBody, "<title>کد تأیید ورود</title>")
+	assert.Contains(t, preview.SampleFields, "RecipientName")
+	assert.NotContains(t, preview.SampleFields, "OtpCode")
+
+	_, err = svc.PreviewTemplate(context.Background(), 1, models.TemplateRender{Template: "email/missing"})
+	assert.ErrorIs(t, err, errs.ErrTemplateNotFound)
+
+	_, err = svc.PreviewTemplate(context.Background(), 2, models.TemplateRender{Template: "email/otp"})
+	assert.ErrorIs(t, err, errs.ErrNotTemplateAdmin)
+}
+
+func TestTemplateService_TestSendTemplate(t *testing.T) {
+	emailTemplates, err := LoadEmailTemplates(templates.FS)
+	require.NoError(t, err)
+
+	email := new(MockEmailChannel)
+	email.On("SendEmail", mock.Anything, mock.MatchedBy(func(p models.EmailPayload) bool {
+		return p.To == "admin@example.com" &&
+			p.Subject == "[TEST] email/login_alert" &&
+			strings.Contains(p.HTMLBody, "5.160.12.34")
+	})).Return("email-1", nil).Once()
+
+	sms := new(MockSMSChannel)
+	sms.On("SendSMS", mock.Anything, mock.MatchedBy(func(p models.SMSPayload) bool {
+		return p.Phone == "09120000000" && p.Template == "verify" && p.Tokens["token"] == "1234"
+	})).Return("sms-1", nil).Once()
+
+	svc := NewTemplateService(emailTemplates, sms, email, []uint64{1})
+	result, err := svc.TestSendTemplate(context.Background(), 1, models.TemplateTestSend{
+		TemplateRender: models.TemplateRender{Template: "email/login_alert", Data: map[string]string{"token": "1234"}},
+		Email:          "admin@example.com",
+		Phone:          "09120000000",
+		SMSTemplate:    "verify",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "email-1", result.EmailMessageID)
+	assert.Equal(t, "sms-1", result.SMSMessageID)
+	email.AssertExpectations(t)
+	sms.AssertExpectations(t)
+
+	// A bad phone is rejected before the email goes out
+	_, err = svc.TestSendTemplate(context.Background(), 1, models.TemplateTestSend{
+		TemplateRender: models.TemplateRender{Template: "email/otp"},
+		Email:          "admin@example.com",
+		Phone:          "12345",
+		SMSMessage:     "test",
+	})
+	assert.ErrorIs(t, err, errs.ErrInvalidTestRecipient)
+	email.AssertNumberOfCalls(t, "SendEmail", 1)
+
+	_, err = svc.TestSendTemplate(context.Background(), 1, models.TemplateTestSend{
+		TemplateRender: models.TemplateRender{Template: "email/otp"},
+	})
+	assert.ErrorIs(t, err, errs.ErrInvalidTestRecipient)
+}
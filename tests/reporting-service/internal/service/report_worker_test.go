@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"metargb/reporting-service/internal/models"
+
+	"google.golang.org/grpc"
+	pbNotifications "metargb/shared/pb/notifications"
+	pbStats "metargb/shared/pb/stats"
+)
+
+type fakeReportRepository struct {
+	reports []*models.ReportDefinition
+	sent    map[uint64]time.Time
+}
+
+func (r *fakeReportRepository) ListEnabled(context.Context) ([]*models.ReportDefinition, error) {
+	return r.reports, nil
+}
+
+func (r *fakeReportRepository) MarkSent(_ context.Context, reportID uint64, periodEnd time.Time) error {
+	if r.sent == nil {
+		r.sent = make(map[uint64]time.Time)
+	}
+	r.sent[reportID] = periodEnd
+	return nil
+}
+
+type fakeStatsClient struct {
+	metrics []*pbStats.Metric
+	err     error
+	lastReq *pbStats.GetStatsRequest
+}
+
+func (c *fakeStatsClient) GetStats(_ context.Context, in *pbStats.GetStatsRequest, _ ...grpc.CallOption) (*pbStats.GetStatsResponse, error) {
+	c.lastReq = in
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &pbStats.GetStatsResponse{Metrics: c.metrics}, nil
+}
+
+type fakeEmailClient struct {
+	sent []*pbNotifications.SendEmailRequest
+	fail map[string]bool
+}
+
+func (c *fakeEmailClient) SendEmail(_ context.Context, in *pbNotifications.SendEmailRequest, _ ...grpc.CallOption) (*pbNotifications.EmailResponse, error) {
+	if c.fail[in.To] {
+		return nil, errors.New("smtp unavailable")
+	}
+	c.sent = append(c.sent, in)
+	return &pbNotifications.EmailResponse{Sent: true}, nil
+}
+
+func mustTehran(t *testing.T) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation("Asia/Tehran")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	return loc
+}
+
+func TestPeriodFor(t *testing.T) {
+	loc := mustTehran(t)
+	// Wednesday 2025-01-15 10:00 Tehran time
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, loc)
+
+	daily, ok := PeriodFor(models.FrequencyDaily, now, loc)
+	if !ok {
+		t.Fatal("expected daily period")
+	}
+	if !daily.From.Equal(time.Date(2025, 1, 14, 0, 0, 0, 0, loc)) || !daily.To.Equal(time.Date(2025, 1, 15, 0, 0, 0, 0, loc)) {
+		t.Errorf("unexpected daily period %v - %v", daily.From, daily.To)
+	}
+
+	weekly, ok := PeriodFor(models.FrequencyWeekly, now, loc)
+	if !ok {
+		t.Fatal("expected weekly period")
+	}
+	// The previous Saturday to Friday week
+	if !weekly.From.Equal(time.Date(2025, 1, 4, 0, 0, 0, 0, loc)) || !weekly.To.Equal(time.Date(2025, 1, 11, 0, 0, 0, 0, loc)) {
+		t.Errorf("unexpected weekly period %v - %v", weekly.From, weekly.To)
+	}
+
+	// On a Saturday the week that just ended is reported
+	saturday := time.Date(2025, 1, 11, 0, 30, 0, 0, loc)
+	weekly, _ = PeriodFor(models.FrequencyWeekly, saturday, loc)
+	if !weekly.To.Equal(time.Date(2025, 1, 11, 0, 0, 0, 0, loc)) {
+		t.Errorf("expected week ending on Saturday, got %v", weekly.To)
+	}
+
+	if _, ok := PeriodFor("monthly", now, loc); ok {
+		t.Error("expected unknown frequency to be rejected")
+	}
+}
+
+func TestIsDue(t *testing.T) {
+	period := ReportPeriod{
+		From: time.Date(2025, 1, 14, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	if !IsDue(&models.ReportDefinition{}, period) {
+		t.Error("expected never sent report to be due")
+	}
+	sent := period.To
+	if IsDue(&models.ReportDefinition{LastPeriodEnd: &sent}, period) {
+		t.Error("expected report sent for the period not to be due")
+	}
+	previous := period.From
+	if !IsDue(&models.ReportDefinition{LastPeriodEnd: &previous}, period) {
+		t.Error("expected report sent for an earlier period to be due")
+	}
+}
+
+func TestReportWorker_Run(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	periodEnd := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	repo := &fakeReportRepository{
+		reports: []*models.ReportDefinition{
+			{ID: 1, Name: "Daily summary", Frequency: models.FrequencyDaily, Metrics: "new_users, tickets", Recipients: "a@example.com, b@example.com"},
+			{ID: 2, Name: "Already sent", Frequency: models.FrequencyDaily, Recipients: "a@example.com", LastPeriodEnd: &periodEnd},
+		},
+	}
+	auth := &fakeStatsClient{metrics: []*pbStats.Metric{{Key: "new_users", Value: 12}}}
+	support := &fakeStatsClient{err: errors.New("connection refused")}
+	email := &fakeEmailClient{fail: map[string]bool{"b@example.com": true}}
+
+	worker := NewReportWorker(repo, []StatsSource{
+		{Name: "auth-service", Client: auth},
+		{Name: "support-service", Client: support},
+	}, email, time.UTC, 0)
+	worker.now = func() time.Time { return now }
+
+	if err := worker.Run(context.Background()); err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+
+	if auth.lastReq == nil || auth.lastReq.From != periodEnd.AddDate(0, 0, -1).Unix() || auth.lastReq.To != periodEnd.Unix() {
+		t.Errorf("unexpected stats request %+v", auth.lastReq)
+	}
+	if len(email.sent) != 1 || email.sent[0].To != "a@example.com" {
+		t.Fatalf("expected one email to a@example.com, got %+v", email.sent)
+	}
+	body := email.sent[0].Body
+	if !strings.Contains(body, "کاربران جدید: 12") {
+		t.Errorf("expected new users in report, got %q", body)
+	}
+	if !strings.Contains(body, "support-service") {
+		t.Errorf("expected unavailable source in report, got %q", body)
+	}
+	if strings.Contains(body, "تعداد معاملات") {
+		t.Errorf("expected only selected metrics in report, got %q", body)
+	}
+
+	if got, ok := repo.sent[1]; !ok || !got.Equal(periodEnd) {
+		t.Errorf("expected report 1 marked sent for %v, got %v", periodEnd, got)
+	}
+	if _, ok := repo.sent[2]; ok {
+		t.Error("expected already sent report to be skipped")
+	}
+}
+
+func TestReportWorker_RunNotMarkedWhenNoEmailSent(t *testing.T) {
+	repo := &fakeReportRepository{
+		reports: []*models.ReportDefinition{
+			{ID: 1, Name: "Weekly summary", Frequency: models.FrequencyWeekly, Recipients: "a@example.com"},
+		},
+	}
+	email := &fakeEmailClient{fail: map[string]bool{"a@example.com": true}}
+
+	worker := NewReportWorker(repo, nil, email, time.UTC, time.Minute)
+	if err := worker.Run(context.Background()); err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if len(repo.sent) != 0 {
+		t.Error("expected report not to be marked sent")
+	}
+}
@@ -0,0 +1,218 @@
+package ftp
+
+import (
+	"errors"
+	"io"
+	"net/textproto"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jlaffaye/ftp"
+)
+
+// fakeServerConn records the commands sent to it
+type fakeServerConn struct {
+	mu      sync.Mutex
+	noOpErr error
+	storErr error
+	quit    bool
+	noOps   int
+}
+
+func (c *fakeServerConn) Stor(path string, r io.Reader) error { return c.storErr }
+
+func (c *fakeServerConn) Retr(path string) (*ftp.Response, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *fakeServerConn) Delete(path string) error { return nil }
+
+func (c *fakeServerConn) NoOp() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.noOps++
+	return c.noOpErr
+}
+
+func (c *fakeServerConn) Quit() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.quit = true
+	return nil
+}
+
+type fakeDialer struct {
+	mu    sync.Mutex
+	conns []*fakeServerConn
+	err   error
+}
+
+func (d *fakeDialer) dial() (serverConn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.err != nil {
+		return nil, d.err
+	}
+	conn := &fakeServerConn{}
+	d.conns = append(d.conns, conn)
+	return conn, nil
+}
+
+func newTestClient(config PoolConfig, dialer *fakeDialer) *FTPClient {
+	return &FTPClient{baseURL: "http://example.com", pool: newConnPool(config, dialer.dial)}
+}
+
+func TestPool_ReusesConnectionsUpToSize(t *testing.T) {
+	dialer := &fakeDialer{}
+	client := newTestClient(PoolConfig{Size: 2, AcquireTimeout: 50 * time.Millisecond}, dialer)
+	defer client.Close()
+
+	for i := 0; i < 5; i++ {
+		if err := client.UploadFile("a.png", nil); err != nil {
+			t.Fatalf("upload %d failed: %v", i, err)
+		}
+	}
+	if len(dialer.conns) != 1 {
+		t.Fatalf("expected sequential uploads to share 1 connection, dialed %d", len(dialer.conns))
+	}
+
+	// Holding both connections makes the next operation wait, then fail
+	first, err := client.pool.acquire()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := client.pool.acquire()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dialer.conns) != 2 {
+		t.Fatalf("expected 2 connections, dialed %d", len(dialer.conns))
+	}
+	if err := client.UploadFile("b.png", nil); !errors.Is(err, ErrPoolExhausted) {
+		t.Fatalf("expected ErrPoolExhausted, got %v", err)
+	}
+
+	// A released connection unblocks a waiting operation
+	done := make(chan error)
+	go func() { done <- client.UploadFile("c.png", nil) }()
+	time.Sleep(10 * time.Millisecond)
+	client.pool.release(first, false)
+	if err := <-done; err != nil {
+		t.Fatalf("expected waiting upload to succeed, got %v", err)
+	}
+	client.pool.release(second, false)
+	if len(dialer.conns) != 2 {
+		t.Fatalf("expected no extra connections, dialed %d", len(dialer.conns))
+	}
+}
+
+func TestPool_ReconnectsBrokenAndStaleConnections(t *testing.T) {
+	dialer := &fakeDialer{}
+	client := newTestClient(PoolConfig{Size: 1, IdleTimeout: time.Hour}, dialer)
+	defer client.Close()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	client.pool.now = func() time.Time { return now }
+
+	// Server replies, like a missing file, keep the connection
+	if err := client.UploadFile("a.png", nil); err != nil {
+		t.Fatal(err)
+	}
+	dialer.conns[0].storErr = &textproto.Error{Code: 550, Msg: "No such file"}
+	if err := client.UploadFile("a.png", nil); err == nil {
+		t.Fatal("expected upload error")
+	}
+	if dialer.conns[0].quit {
+		t.Fatal("expected connection to survive a server reply")
+	}
+
+	// Network errors drop it and the next operation reconnects
+	dialer.conns[0].storErr = io.ErrUnexpectedEOF
+	if err := client.UploadFile("a.png", nil); err == nil {
+		t.Fatal("expected upload error")
+	}
+	if !dialer.conns[0].quit {
+		t.Fatal("expected broken connection to be closed")
+	}
+	if err := client.UploadFile("a.png", nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(dialer.conns) != 2 {
+		t.Fatalf("expected a reconnect, dialed %d", len(dialer.conns))
+	}
+
+	// Connections idle past the keepalive interval are checked before use
+	now = now.Add(DefaultKeepaliveInterval)
+	client.pool.config.KeepaliveInterval = DefaultKeepaliveInterval
+	dialer.conns[1].noOpErr = io.EOF
+	if err := client.UploadFile("a.png", nil); err != nil {
+		t.Fatal(err)
+	}
+	if !dialer.conns[1].quit || len(dialer.conns) != 3 {
+		t.Fatal("expected the unhealthy connection to be replaced")
+	}
+
+	// and closed once idle past the idle timeout
+	now = now.Add(time.Hour)
+	if err := client.UploadFile("a.png", nil); err != nil {
+		t.Fatal(err)
+	}
+	if !dialer.conns[2].quit || dialer.conns[2].noOps != 0 || len(dialer.conns) != 4 {
+		t.Fatal("expected the idle connection to be replaced without NOOP")
+	}
+
+	// Dial failures give the slot back
+	dialer.conns[3].storErr = io.EOF
+	client.UploadFile("a.png", nil)
+	dialer.err = errors.New("connection refused")
+	if err := client.UploadFile("a.png", nil); err == nil {
+		t.Fatal("expected dial error")
+	}
+	dialer.err = nil
+	if err := client.UploadFile("a.png", nil); err != nil {
+		t.Fatalf("expected the pool to recover, got %v", err)
+	}
+}
+
+func TestPool_KeepaliveSendsNoOpToIdleConnections(t *testing.T) {
+	dialer := &fakeDialer{}
+	client := newTestClient(PoolConfig{Size: 2}, dialer)
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	client.pool.now = func() time.Time { return now }
+	client.pool.config.KeepaliveInterval = time.Minute
+
+	if err := client.Connect(); err != nil {
+		t.Fatal(err)
+	}
+
+	client.pool.checkIdle()
+	if dialer.conns[0].noOps != 0 {
+		t.Fatal("expected recently used connections to be skipped")
+	}
+
+	now = now.Add(time.Minute)
+	client.pool.checkIdle()
+	if dialer.conns[0].noOps != 1 {
+		t.Fatalf("expected 1 NOOP, got %d", dialer.conns[0].noOps)
+	}
+
+	now = now.Add(time.Minute)
+	dialer.conns[0].noOpErr = io.EOF
+	client.pool.checkIdle()
+	if !dialer.conns[0].quit {
+		t.Fatal("expected the connection failing NOOP to be closed")
+	}
+
+	if err := client.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !dialer.conns[1].quit {
+		t.Fatal("expected Close to quit idle connections")
+	}
+	if err := client.DeleteFile("a.png"); err == nil {
+		t.Fatal("expected operations after Close to fail")
+	}
+}
@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"metargb/support-service/internal/models"
+)
+
+// mockChatRepository implements ChatRepository for testing
+type mockChatRepository struct {
+	sessions map[uint64]*models.ChatSession
+	messages map[uint64][]*models.ChatMessage
+}
+
+func newMockChatRepository() *mockChatRepository {
+	return &mockChatRepository{
+		sessions: make(map[uint64]*models.ChatSession),
+		messages: make(map[uint64][]*models.ChatMessage),
+	}
+}
+
+func (m *mockChatRepository) CreateSession(ctx context.Context, session *models.ChatSession) (*models.ChatSession, error) {
+	session.ID = uint64(len(m.sessions) + 1)
+	session.CreatedAt = time.Now()
+	session.UpdatedAt = time.Now()
+	m.sessions[session.ID] = session
+	return session, nil
+}
+
+func (m *mockChatRepository) GetSession(ctx context.Context, sessionID uint64) (*models.ChatSession, error) {
+	return m.sessions[sessionID], nil
+}
+
+func (m *mockChatRepository) GetOpenSessionByTicketID(ctx context.Context, ticketID uint64) (*models.ChatSession, error) {
+	for _, session := range m.sessions {
+		if session.TicketID == ticketID && session.IsOpen() {
+			return session, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockChatRepository) GetSessionsByUserID(ctx context.Context, userID uint64) ([]*models.ChatSession, error) {
+	var result []*models.ChatSession
+	for _, session := range m.sessions {
+		if session.UserID == userID {
+			result = append(result, session)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockChatRepository) GetOpenSessions(ctx context.Context) ([]*models.ChatSession, error) {
+	var result []*models.ChatSession
+	for _, session := range m.sessions {
+		if session.IsOpen() {
+			result = append(result, session)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockChatRepository) AssignAgent(ctx context.Context, sessionID, agentID uint64) (bool, error) {
+	session := m.sessions[sessionID]
+	if session.AgentID != nil {
+		return false, nil
+	}
+	session.AgentID = &agentID
+	return true, nil
+}
+
+func (m *mockChatRepository) CloseSession(ctx context.Context, sessionID, closedBy uint64) error {
+	session := m.sessions[sessionID]
+	now := time.Now()
+	session.Status = models.ChatStatusClosed
+	session.ClosedBy = &closedBy
+	session.ClosedAt = &now
+	return nil
+}
+
+func (m *mockChatRepository) CreateMessage(ctx context.Context, message *models.ChatMessage) (*models.ChatMessage, error) {
+	message.ID = uint64(len(m.messages[message.SessionID]) + 1)
+	m.messages[message.SessionID] = append(m.messages[message.SessionID], message)
+	return message, nil
+}
+
+func (m *mockChatRepository) GetMessages(ctx context.Context, sessionID uint64) ([]*models.ChatMessage, error) {
+	return m.messages[sessionID], nil
+}
+
+// mockChatPublisher records the events published to chat rooms
+type mockChatPublisher struct {
+	events []string
+	rooms  []string
+}
+
+func (m *mockChatPublisher) PublishRoomEvent(ctx context.Context, room, event string, data interface{}) error {
+	m.rooms = append(m.rooms, room)
+	m.events = append(m.events, event)
+	return nil
+}
+
+const (
+	testChatUserID  = uint64(10)
+	testChatAgentID = uint64(99)
+)
+
+func newTestChatService() (ChatService, *mockChatRepository, *mockTicketRepository, *mockChatPublisher) {
+	chatRepo := newMockChatRepository()
+	ticketRepo := newMockTicketRepository()
+	publisher := &mockChatPublisher{}
+	svc := NewChatService(chatRepo, ticketRepo, publisher, []uint64{testChatAgentID})
+	return svc, chatRepo, ticketRepo, publisher
+}
+
+func createChatTicket(t *testing.T, ticketRepo *mockTicketRepository, department *string) uint64 {
+	t.Helper()
+	ticket, err := ticketRepo.Create(context.Background(), &models.Ticket{
+		Title:      "Help",
+		Content:    "Content",
+		UserID:     testChatUserID,
+		Department: department,
+	})
+	if err != nil {
+		t.Fatalf("failed to create ticket: %v", err)
+	}
+	return ticket.ID
+}
+
+func TestChatService_StartChat(t *testing.T) {
+	ctx := context.Background()
+	svc, _, ticketRepo, _ := newTestChatService()
+	department := models.DeptTechnicalSupport
+	ticketID := createChatTicket(t, ticketRepo, &department)
+
+	session, err := svc.StartChat(ctx, ticketID, testChatUserID)
+	if err != nil {
+		t.Fatalf("StartChat failed: %v", err)
+	}
+	if session.UserID != testChatUserID || !session.IsOpen() || session.AgentID != nil {
+		t.Errorf("unexpected session: %+v", session)
+	}
+
+	again, err := svc.StartChat(ctx, ticketID, testChatAgentID)
+	if err != nil {
+		t.Fatalf("StartChat by agent failed: %v", err)
+	}
+	if again.ID != session.ID {
+		t.Errorf("expected the open chat %d to be reused, got %d", session.ID, again.ID)
+	}
+
+	if _, err := svc.StartChat(ctx, ticketID, 30); !errors.Is(err, ErrChatForbidden) {
+		t.Errorf("expected ErrChatForbidden, got %v", err)
+	}
+	if _, err := svc.StartChat(ctx, 42, testChatUserID); !errors.Is(err, ErrChatTicketNotFound) {
+		t.Errorf("expected ErrChatTicketNotFound, got %v", err)
+	}
+
+	userTicketID := createChatTicket(t, ticketRepo, nil)
+	if _, err := svc.StartChat(ctx, userTicketID, testChatUserID); !errors.Is(err, ErrChatNotSupportTicket) {
+		t.Errorf("expected ErrChatNotSupportTicket, got %v", err)
+	}
+
+	closedTicketID := createChatTicket(t, ticketRepo, &department)
+	ticketRepo.tickets[closedTicketID].Status = models.TicketStatusClosed
+	if _, err := svc.StartChat(ctx, closedTicketID, testChatUserID); !errors.Is(err, ErrChatTicketClosed) {
+		t.Errorf("expected ErrChatTicketClosed, got %v", err)
+	}
+}
+
+func TestChatService_SendMessage(t *testing.T) {
+	ctx := context.Background()
+	svc, chatRepo, ticketRepo, publisher := newTestChatService()
+	department := models.DeptTechnicalSupport
+	session, _ := svc.StartChat(ctx, createChatTicket(t, ticketRepo, &department), testChatUserID)
+
+	message, err := svc.SendMessage(ctx, session.ID, testChatUserID, "  hello  ")
+	if err != nil {
+		t.Fatalf("SendMessage failed: %v", err)
+	}
+	if message.Body != "hello" || message.FromAgent {
+		t.Errorf("unexpected message: %+v", message)
+	}
+
+	reply, err := svc.SendMessage(ctx, session.ID, testChatAgentID, "how can I help?")
+	if err != nil {
+		t.Fatalf("SendMessage by agent failed: %v", err)
+	}
+	if !reply.FromAgent {
+		t.Error("expected the agent's message to be marked from_agent")
+	}
+	if agentID := chatRepo.sessions[session.ID].AgentID; agentID == nil || *agentID != testChatAgentID {
+		t.Errorf("expected the replying agent to be assigned, got %v", agentID)
+	}
+
+	want := []string{ChatEventMessage, ChatEventAgentAssigned, ChatEventMessage}
+	if strings.Join(publisher.events, ",") != strings.Join(want, ",") {
+		t.Errorf("expected events %v, got %v", want, publisher.events)
+	}
+	for _, room := range publisher.rooms {
+		if room != ChatRoom(session.ID) {
+			t.Errorf("expected events in room %s, got %s", ChatRoom(session.ID), room)
+		}
+	}
+
+	if _, err := svc.SendMessage(ctx, session.ID, 30, "hi"); !errors.Is(err, ErrChatForbidden) {
+		t.Errorf("expected ErrChatForbidden, got %v", err)
+	}
+	if _, err := svc.SendMessage(ctx, session.ID, testChatUserID, "   "); !errors.Is(err, ErrChatEmptyMessage) {
+		t.Errorf("expected ErrChatEmptyMessage, got %v", err)
+	}
+	if _, err := svc.SendMessage(ctx, session.ID, testChatUserID, strings.Repeat("س", MaxChatMessageLength+1)); !errors.Is(err, ErrChatMessageTooLong) {
+		t.Errorf("expected ErrChatMessageTooLong, got %v", err)
+	}
+}
+
+func TestChatService_CloseChat(t *testing.T) {
+	ctx := context.Background()
+	svc, _, ticketRepo, publisher := newTestChatService()
+	department := models.DeptTechnicalSupport
+	ticketID := createChatTicket(t, ticketRepo, &department)
+	session, _ := svc.StartChat(ctx, ticketID, testChatUserID)
+
+	closed, err := svc.CloseChat(ctx, session.ID, testChatAgentID)
+	if err != nil {
+		t.Fatalf("CloseChat failed: %v", err)
+	}
+	if closed.IsOpen() || closed.ClosedAt == nil {
+		t.Errorf("expected a closed chat, got %+v", closed)
+	}
+	if publisher.events[len(publisher.events)-1] != ChatEventClosed {
+		t.Errorf("expected %s to be published, got %v", ChatEventClosed, publisher.events)
+	}
+
+	if _, err := svc.SendMessage(ctx, session.ID, testChatUserID, "hello?"); !errors.Is(err, ErrChatClosed) {
+		t.Errorf("expected ErrChatClosed, got %v", err)
+	}
+	if _, err := svc.CloseChat(ctx, session.ID, testChatUserID); !errors.Is(err, ErrChatClosed) {
+		t.Errorf("expected ErrChatClosed, got %v", err)
+	}
+
+	reopened, err := svc.StartChat(ctx, ticketID, testChatUserID)
+	if err != nil {
+		t.Fatalf("StartChat after close failed: %v", err)
+	}
+	if reopened.ID == session.ID {
+		t.Error("expected a new chat once the previous one is closed")
+	}
+}
+
+func TestChatService_ExportTranscript(t *testing.T) {
+	ctx := context.Background()
+	svc, _, ticketRepo, _ := newTestChatService()
+	department := models.DeptTechnicalSupport
+	session, _ := svc.StartChat(ctx, createChatTicket(t, ticketRepo, &department), testChatUserID)
+	svc.SendMessage(ctx, session.ID, testChatUserID, "my wallet is empty")
+	svc.SendMessage(ctx, session.ID, testChatAgentID, "checking now")
+
+	filename, content, err := svc.ExportTranscript(ctx, session.ID, testChatUserID)
+	if err != nil {
+		t.Fatalf("ExportTranscript failed: %v", err)
+	}
+	if filename != "support-chat-1.txt" {
+		t.Errorf("unexpected filename %q", filename)
+	}
+	if !strings.Contains(content, chatUserName+": my wallet is empty") || !strings.Contains(content, chatAgentName+": checking now") {
+		t.Errorf("unexpected transcript:\n%s", content)
+	}
+
+	if _, _, err := svc.ExportTranscript(ctx, session.ID, 30); !errors.Is(err, ErrChatForbidden) {
+		t.Errorf("expected ErrChatForbidden, got %v", err)
+	}
+}
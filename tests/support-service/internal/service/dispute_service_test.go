@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pbFeatures "metargb/shared/pb/features"
+	"metargb/support-service/internal/models"
+)
+
+// mockDisputeRepository implements DisputeRepository for testing
+type mockDisputeRepository struct {
+	disputes map[uint64]*models.Dispute
+}
+
+func newMockDisputeRepository() *mockDisputeRepository {
+	return &mockDisputeRepository{disputes: make(map[uint64]*models.Dispute)}
+}
+
+func (m *mockDisputeRepository) Create(ctx context.Context, dispute *models.Dispute) (*models.Dispute, error) {
+	dispute.ID = uint64(len(m.disputes) + 1)
+	dispute.CreatedAt = time.Now()
+	dispute.UpdatedAt = time.Now()
+	m.disputes[dispute.ID] = dispute
+	return dispute, nil
+}
+
+func (m *mockDisputeRepository) GetByID(ctx context.Context, disputeID uint64) (*models.Dispute, error) {
+	return m.disputes[disputeID], nil
+}
+
+func (m *mockDisputeRepository) GetOpenByTradeID(ctx context.Context, tradeID uint64) (*models.Dispute, error) {
+	for _, dispute := range m.disputes {
+		if dispute.TradeID == tradeID && dispute.Status == models.DisputeStatusOpen {
+			return dispute, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockDisputeRepository) GetByUserID(ctx context.Context, userID uint64) ([]*models.Dispute, error) {
+	var result []*models.Dispute
+	for _, dispute := range m.disputes {
+		if dispute.IsParticipant(userID) {
+			result = append(result, dispute)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockDisputeRepository) GetOpen(ctx context.Context) ([]*models.Dispute, error) {
+	var result []*models.Dispute
+	for _, dispute := range m.disputes {
+		if dispute.Status == models.DisputeStatusOpen {
+			result = append(result, dispute)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockDisputeRepository) Resolve(ctx context.Context, disputeID, agentID uint64, outcome, note string) error {
+	dispute, ok := m.disputes[disputeID]
+	if !ok {
+		return errors.New("dispute not found")
+	}
+	now := time.Now()
+	dispute.Status = models.DisputeStatusResolved
+	dispute.Outcome = &outcome
+	dispute.ResolutionNote = &note
+	dispute.ResolvedBy = &agentID
+	dispute.ResolvedAt = &now
+	return nil
+}
+
+// mockTradeClient implements the features TradeServiceClient for testing
+type mockTradeClient struct {
+	trades    map[uint64]*pbFeatures.TradeDetails
+	freezeErr error
+	refundErr error
+	frozen    []uint64
+	released  []uint64
+	refunded  []*pbFeatures.RefundTradeRequest
+}
+
+func (m *mockTradeClient) GetTrade(ctx context.Context, in *pbFeatures.GetTradeRequest, opts ...grpc.CallOption) (*pbFeatures.TradeResponse, error) {
+	trade, ok := m.trades[in.TradeId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "trade not found")
+	}
+	return &pbFeatures.TradeResponse{Data: trade}, nil
+}
+
+func (m *mockTradeClient) FreezeTradeFunds(ctx context.Context, in *pbFeatures.TradeFundsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if m.freezeErr != nil {
+		return nil, m.freezeErr
+	}
+	m.frozen = append(m.frozen, in.TradeId)
+	return &emptypb.Empty{}, nil
+}
+
+func (m *mockTradeClient) ReleaseTradeFunds(ctx context.Context, in *pbFeatures.TradeFundsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.released = append(m.released, in.TradeId)
+	return &emptypb.Empty{}, nil
+}
+
+func (m *mockTradeClient) RefundTrade(ctx context.Context, in *pbFeatures.RefundTradeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if m.refundErr != nil {
+		return nil, m.refundErr
+	}
+	m.refunded = append(m.refunded, in)
+	return &emptypb.Empty{}, nil
+}
+
+const (
+	testBuyerID  = uint64(10)
+	testSellerID = uint64(20)
+	testAgentID  = uint64(99)
+)
+
+var testTradeTime = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestDisputeService(tradeClient *mockTradeClient) (*disputeService, *mockDisputeRepository, *mockTicketRepository) {
+	disputeRepo := newMockDisputeRepository()
+	ticketRepo := newMockTicketRepository()
+	svc := NewDisputeService(
+		disputeRepo,
+		NewTicketService(ticketRepo, ""),
+		ticketRepo,
+		tradeClient,
+		0,
+		[]uint64{testAgentID},
+	).(*disputeService)
+	svc.now = func() time.Time { return testTradeTime.Add(2 * 24 * time.Hour) }
+	return svc, disputeRepo, ticketRepo
+}
+
+func newMockTradeClient() *mockTradeClient {
+	return &mockTradeClient{
+		trades: map[uint64]*pbFeatures.TradeDetails{
+			1: {
+				Id:           1,
+				FeatureId:    500,
+				PropertiesId: "HM-200500",
+				BuyerId:      testBuyerID,
+				SellerId:     testSellerID,
+				PscAmount:    100,
+				TradedAt:     testTradeTime.Unix(),
+			},
+		},
+	}
+}
+
+func TestDisputeService_OpenDispute(t *testing.T) {
+	ctx := context.Background()
+	tradeClient := newMockTradeClient()
+	svc, _, ticketRepo := newTestDisputeService(tradeClient)
+
+	dispute, err := svc.OpenDispute(ctx, testBuyerID, 1, "not_as_described", "details")
+	if err != nil {
+		t.Fatalf("OpenDispute failed: %v", err)
+	}
+	if dispute.Status != models.DisputeStatusOpen || dispute.SellerID != testSellerID || dispute.OpenedBy != testBuyerID {
+		t.Errorf("unexpected dispute: %+v", dispute)
+	}
+	if !dispute.FundsFrozen || len(tradeClient.frozen) != 1 {
+		t.Errorf("expected trade funds to be frozen, got %v", tradeClient.frozen)
+	}
+
+	ticket := ticketRepo.tickets[dispute.TicketID]
+	if ticket == nil || ticket.Department == nil || *ticket.Department != models.DeptTradeDisputes {
+		t.Errorf("expected a trade_disputes ticket, got %+v", ticket)
+	}
+
+	if _, err := svc.OpenDispute(ctx, testSellerID, 1, "other", ""); !errors.Is(err, ErrDisputeAlreadyOpen) {
+		t.Errorf("expected ErrDisputeAlreadyOpen, got %v", err)
+	}
+}
+
+func TestDisputeService_OpenDisputeRejected(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		userID  uint64
+		tradeID uint64
+		now     time.Time
+		want    error
+	}{
+		{"not a participant", 30, 1, testTradeTime, ErrDisputeNotParticipant},
+		{"unknown trade", testBuyerID, 2, testTradeTime, ErrDisputeTradeNotFound},
+		{"window closed", testBuyerID, 1, testTradeTime.Add(8 * 24 * time.Hour), ErrDisputeWindowClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, _ := newTestDisputeService(newMockTradeClient())
+			svc.now = func() time.Time { return tt.now }
+
+			if _, err := svc.OpenDispute(ctx, tt.userID, tt.tradeID, "reason", ""); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestDisputeService_OpenDisputeFreezeFails(t *testing.T) {
+	tradeClient := newMockTradeClient()
+	tradeClient.freezeErr = status.Error(codes.FailedPrecondition, "insufficient balance to lock")
+	svc, _, _ := newTestDisputeService(tradeClient)
+
+	dispute, err := svc.OpenDispute(context.Background(), testBuyerID, 1, "reason", "")
+	if err != nil {
+		t.Fatalf("OpenDispute failed: %v", err)
+	}
+	if dispute.FundsFrozen {
+		t.Error("expected funds_frozen to be false when freezing fails")
+	}
+}
+
+func TestDisputeService_ResolveDispute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("refund", func(t *testing.T) {
+		tradeClient := newMockTradeClient()
+		svc, _, ticketRepo := newTestDisputeService(tradeClient)
+		opened, _ := svc.OpenDispute(ctx, testBuyerID, 1, "reason", "")
+
+		dispute, err := svc.ResolveDispute(ctx, opened.ID, testAgentID, models.DisputeOutcomeRefund, "confirmed")
+		if err != nil {
+			t.Fatalf("ResolveDispute failed: %v", err)
+		}
+		if dispute.Status != models.DisputeStatusResolved || *dispute.Outcome != models.DisputeOutcomeRefund {
+			t.Errorf("unexpected dispute: %+v", dispute)
+		}
+		if len(tradeClient.refunded) != 1 || !tradeClient.refunded[0].FundsFrozen {
+			t.Errorf("expected a refund of frozen funds, got %+v", tradeClient.refunded)
+		}
+		if len(tradeClient.released) != 0 {
+			t.Errorf("expected no release on refund, got %v", tradeClient.released)
+		}
+		if ticketRepo.tickets[opened.TicketID].Status != models.TicketStatusResolved || ticketRepo.createResponseCount != 1 {
+			t.Error("expected the dispute ticket to be answered and resolved")
+		}
+	})
+
+	t.Run("uphold", func(t *testing.T) {
+		tradeClient := newMockTradeClient()
+		svc, _, _ := newTestDisputeService(tradeClient)
+		opened, _ := svc.OpenDispute(ctx, testBuyerID, 1, "reason", "")
+
+		if _, err := svc.ResolveDispute(ctx, opened.ID, testAgentID, models.DisputeOutcomeUphold, ""); err != nil {
+			t.Fatalf("ResolveDispute failed: %v", err)
+		}
+		if len(tradeClient.released) != 1 || len(tradeClient.refunded) != 0 {
+			t.Errorf("expected frozen funds released, got released=%v refunded=%v", tradeClient.released, tradeClient.refunded)
+		}
+
+		if _, err := svc.ResolveDispute(ctx, opened.ID, testAgentID, models.DisputeOutcomeRefund, ""); !errors.Is(err, ErrDisputeAlreadyResolved) {
+			t.Errorf("expected ErrDisputeAlreadyResolved, got %v", err)
+		}
+	})
+
+	t.Run("refund fails", func(t *testing.T) {
+		tradeClient := newMockTradeClient()
+		tradeClient.refundErr = status.Error(codes.FailedPrecondition, "feature is no longer owned by the buyer")
+		svc, disputeRepo, _ := newTestDisputeService(tradeClient)
+		opened, _ := svc.OpenDispute(ctx, testBuyerID, 1, "reason", "")
+
+		_, err := svc.ResolveDispute(ctx, opened.ID, testAgentID, models.DisputeOutcomeRefund, "")
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("expected FailedPrecondition, got %v", err)
+		}
+		if disputeRepo.disputes[opened.ID].Status != models.DisputeStatusOpen {
+			t.Error("expected dispute to stay open when the refund fails")
+		}
+	})
+
+	t.Run("not an agent", func(t *testing.T) {
+		svc, _, _ := newTestDisputeService(newMockTradeClient())
+		opened, _ := svc.OpenDispute(ctx, testBuyerID, 1, "reason", "")
+
+		if _, err := svc.ResolveDispute(ctx, opened.ID, testBuyerID, models.DisputeOutcomeRefund, ""); !errors.Is(err, ErrDisputeNotAgent) {
+			t.Errorf("expected ErrDisputeNotAgent, got %v", err)
+		}
+	})
+
+	t.Run("invalid outcome", func(t *testing.T) {
+		svc, _, _ := newTestDisputeService(newMockTradeClient())
+
+		if _, err := svc.ResolveDispute(ctx, 1, testAgentID, "split", ""); !errors.Is(err, ErrDisputeInvalidOutcome) {
+			t.Errorf("expected ErrDisputeInvalidOutcome, got %v", err)
+		}
+	})
+}
+
+func TestDisputeService_GetDispute(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _ := newTestDisputeService(newMockTradeClient())
+	opened, _ := svc.OpenDispute(ctx, testBuyerID, 1, "reason", "")
+
+	for _, userID := range []uint64{testBuyerID, testSellerID, testAgentID} {
+		if _, err := svc.GetDispute(ctx, opened.ID, userID); err != nil {
+			t.Errorf("user %d: expected access, got %v", userID, err)
+		}
+	}
+	if _, err := svc.GetDispute(ctx, opened.ID, 30); !errors.Is(err, ErrDisputeForbidden) {
+		t.Errorf("expected ErrDisputeForbidden, got %v", err)
+	}
+	if _, err := svc.GetDispute(ctx, 42, testBuyerID); !errors.Is(err, ErrDisputeNotFound) {
+		t.Errorf("expected ErrDisputeNotFound, got %v", err)
+	}
+}
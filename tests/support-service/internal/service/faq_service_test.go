@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	pbCommon "metargb/shared/pb/common"
+	pbTraining "metargb/shared/pb/training"
+	"metargb/support-service/internal/models"
+)
+
+// mockFaqRepository implements FaqRepository for testing
+type mockFaqRepository struct {
+	faqs        map[uint64]*models.Faq
+	suggestions map[uint64]*models.TicketSuggestion
+}
+
+func newMockFaqRepository() *mockFaqRepository {
+	return &mockFaqRepository{
+		faqs:        make(map[uint64]*models.Faq),
+		suggestions: make(map[uint64]*models.TicketSuggestion),
+	}
+}
+
+func (m *mockFaqRepository) List(ctx context.Context, department string) ([]*models.Faq, error) {
+	var result []*models.Faq
+	for id := uint64(1); id <= uint64(len(m.faqs)+1); id++ {
+		faq, ok := m.faqs[id]
+		if !ok {
+			continue
+		}
+		if department == "" || faq.Department == nil || *faq.Department == department {
+			result = append(result, faq)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockFaqRepository) GetByID(ctx context.Context, faqID uint64) (*models.Faq, error) {
+	return m.faqs[faqID], nil
+}
+
+func (m *mockFaqRepository) Create(ctx context.Context, faq *models.Faq) (*models.Faq, error) {
+	faq.ID = uint64(len(m.faqs) + 1)
+	faq.CreatedAt = time.Now()
+	faq.UpdatedAt = time.Now()
+	m.faqs[faq.ID] = faq
+	return faq, nil
+}
+
+func (m *mockFaqRepository) Update(ctx context.Context, faq *models.Faq) error {
+	faq.UpdatedAt = time.Now()
+	m.faqs[faq.ID] = faq
+	return nil
+}
+
+func (m *mockFaqRepository) Delete(ctx context.Context, faqID uint64) error {
+	delete(m.faqs, faqID)
+	return nil
+}
+
+func (m *mockFaqRepository) CreateSuggestions(ctx context.Context, suggestions []*models.TicketSuggestion) error {
+	for _, s := range suggestions {
+		s.ID = uint64(len(m.suggestions) + 1)
+		m.suggestions[s.ID] = s
+	}
+	return nil
+}
+
+func (m *mockFaqRepository) GetSuggestion(ctx context.Context, suggestionID uint64) (*models.TicketSuggestion, error) {
+	return m.suggestions[suggestionID], nil
+}
+
+func (m *mockFaqRepository) AcceptSuggestion(ctx context.Context, suggestionID uint64, at time.Time) error {
+	if s := m.suggestions[suggestionID]; s.AcceptedAt == nil {
+		s.AcceptedAt = &at
+	}
+	return nil
+}
+
+// mockVideoClient implements the training VideoServiceClient for testing
+type mockVideoClient struct {
+	pbTraining.VideoServiceClient
+	videos []*pbTraining.VideoResponse
+	err    error
+}
+
+func (m *mockVideoClient) GetVideos(ctx context.Context, in *pbTraining.GetVideosRequest, opts ...grpc.CallOption) (*pbTraining.VideosResponse, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &pbTraining.VideosResponse{
+		Videos:     m.videos,
+		Pagination: &pbCommon.PaginationMeta{CurrentPage: 1, PerPage: in.Pagination.PerPage, Total: int32(len(m.videos)), LastPage: 1},
+	}, nil
+}
+
+const (
+	testFaqUserID  = uint64(10)
+	testFaqAgentID = uint64(99)
+)
+
+func newTestFaqService(videos *mockVideoClient) (FaqService, *mockFaqRepository, *mockTicketRepository) {
+	faqRepo := newMockFaqRepository()
+	ticketRepo := newMockTicketRepository()
+	var videoClient pbTraining.VideoServiceClient
+	if videos != nil {
+		videoClient = videos
+	}
+	svc := NewFaqService(faqRepo, ticketRepo, videoClient, []uint64{testFaqAgentID})
+	return svc, faqRepo, ticketRepo
+}
+
+func createSuggestionTicket(t *testing.T, ticketRepo *mockTicketRepository, title, content string, department *string) *models.TicketWithRelations {
+	t.Helper()
+	ticket, err := ticketRepo.Create(context.Background(), &models.Ticket{
+		Title:      title,
+		Content:    content,
+		UserID:     testFaqUserID,
+		Department: department,
+	})
+	if err != nil {
+		t.Fatalf("failed to create ticket: %v", err)
+	}
+	return ticketRepo.tickets[ticket.ID]
+}
+
+func TestSuggestionTokens(t *testing.T) {
+	// Arabic yeh and kaf, ZWNJ, diacritics and Persian digits are normalized
+	tokens := suggestionTokens("كيف‌پول من در بازارِ ۱۲۳ شارژ نمي‌شود")
+	want := []string{"کیف", "پول", "بازار", "123", "شارژ", "شود"}
+	if len(tokens) != len(want) {
+		t.Fatalf("expected tokens %v, got %v", want, tokens)
+	}
+	for i := range want {
+		if tokens[i] != want[i] {
+			t.Errorf("token %d: expected %q, got %q", i, want[i], tokens[i])
+		}
+	}
+}
+
+func TestFaqService_Suggest(t *testing.T) {
+	ctx := context.Background()
+	videos := &mockVideoClient{videos: []*pbTraining.VideoResponse{
+		{Id: 7, Title: "آموزش خرید ملک", Slug: "buy-feature", Description: "مراحل خرید ملک در نقشه و پرداخت آن"},
+	}}
+	svc, faqRepo, ticketRepo := newTestFaqService(videos)
+
+	technical := models.DeptTechnicalSupport
+	investment := models.DeptInvestment
+	if _, err := svc.CreateFaq(ctx, testFaqAgentID, "شارژ کیف پول انجام نمی‌شود", "پس از پرداخت چند دقیقه صبر کنید.", "", "کیف پول, شارژ"); err != nil {
+		t.Fatalf("CreateFaq failed: %v", err)
+	}
+	if _, err := svc.CreateFaq(ctx, testFaqAgentID, "سود سرمایه گذاری کی واریز می‌شود؟", "هر ماه واریز می‌شود.", investment, ""); err != nil {
+		t.Fatalf("CreateFaq failed: %v", err)
+	}
+	if err := svc.Refresh(ctx); err != nil {
+		t.Fatalf("Refresh failed: %v", err)
+	}
+
+	ticket := createSuggestionTicket(t, ticketRepo, "مشکل شارژ", "كيف پول من شارژ نشد", &technical)
+	suggestions, err := svc.Suggest(ctx, ticket)
+	if err != nil {
+		t.Fatalf("Suggest failed: %v", err)
+	}
+	if len(suggestions) == 0 {
+		t.Fatal("expected the wallet FAQ to be suggested")
+	}
+	if suggestions[0].Source != models.SuggestionSourceFaq || suggestions[0].SourceID != 1 {
+		t.Errorf("expected FAQ 1 first, got %+v", suggestions[0])
+	}
+	if len(faqRepo.suggestions) != len(suggestions) {
+		t.Errorf("expected %d suggestions recorded, got %d", len(suggestions), len(faqRepo.suggestions))
+	}
+
+	ticket = createSuggestionTicket(t, ticketRepo, "خرید ملک", "چطور ملک بخرم؟", &technical)
+	suggestions, _ = svc.Suggest(ctx, ticket)
+	if len(suggestions) == 0 || suggestions[0].Source != models.SuggestionSourceTrainingVideo || suggestions[0].Slug != "buy-feature" {
+		t.Errorf("expected the training video to be suggested, got %+v", suggestions)
+	}
+
+	// FAQs of another department are not suggested
+	ticket = createSuggestionTicket(t, ticketRepo, "سود سرمایه گذاری", "سود من واریز نشده", &technical)
+	suggestions, _ = svc.Suggest(ctx, ticket)
+	for _, s := range suggestions {
+		if s.Source == models.SuggestionSourceFaq && s.SourceID == 2 {
+			t.Errorf("expected the investment FAQ not to be suggested to technical support, got %+v", s)
+		}
+	}
+
+	// Tickets between users get no suggestions
+	ticket = createSuggestionTicket(t, ticketRepo, "شارژ کیف پول", "کیف پول", nil)
+	if suggestions, _ := svc.Suggest(ctx, ticket); len(suggestions) != 0 {
+		t.Errorf("expected no suggestions for a ticket to a user, got %+v", suggestions)
+	}
+}
+
+func TestFaqService_SuggestWithoutTrainingService(t *testing.T) {
+	ctx := context.Background()
+	svc, _, ticketRepo := newTestFaqService(&mockVideoClient{err: errors.New("unavailable")})
+	svc.CreateFaq(ctx, testFaqAgentID, "رمز عبور را فراموش کرده‌ام", "از صفحه ورود گزینه بازیابی را بزنید.", "", "")
+	if err := svc.Refresh(ctx); err != nil {
+		t.Fatalf("Refresh failed: %v", err)
+	}
+
+	department := models.DeptTechnicalSupport
+	ticket := createSuggestionTicket(t, ticketRepo, "رمز عبور", "رمز عبورم را فراموش کردم", &department)
+	suggestions, err := svc.Suggest(ctx, ticket)
+	if err != nil {
+		t.Fatalf("Suggest failed: %v", err)
+	}
+	if len(suggestions) != 1 || suggestions[0].Source != models.SuggestionSourceFaq {
+		t.Errorf("expected the FAQ to be suggested, got %+v", suggestions)
+	}
+}
+
+func TestFaqService_ManageFaqs(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _ := newTestFaqService(nil)
+
+	if _, err := svc.CreateFaq(ctx, testFaqUserID, "q", "a", "", ""); !errors.Is(err, ErrFaqNotAgent) {
+		t.Errorf("expected ErrFaqNotAgent, got %v", err)
+	}
+	if _, err := svc.CreateFaq(ctx, testFaqAgentID, "  ", "a", "", ""); !errors.Is(err, ErrFaqQuestionRequired) {
+		t.Errorf("expected ErrFaqQuestionRequired, got %v", err)
+	}
+	if _, err := svc.CreateFaq(ctx, testFaqAgentID, "q", "a", "sales", ""); !errors.Is(err, ErrFaqInvalidDepartment) {
+		t.Errorf("expected ErrFaqInvalidDepartment, got %v", err)
+	}
+
+	faq, err := svc.CreateFaq(ctx, testFaqAgentID, " question ", "answer", models.DeptInvestment, "")
+	if err != nil {
+		t.Fatalf("CreateFaq failed: %v", err)
+	}
+	if faq.Question != "question" || faq.Department == nil || *faq.Department != models.DeptInvestment {
+		t.Errorf("unexpected faq: %+v", faq)
+	}
+
+	updated, err := svc.UpdateFaq(ctx, faq.ID, testFaqAgentID, "question", "new answer", "", "")
+	if err != nil {
+		t.Fatalf("UpdateFaq failed: %v", err)
+	}
+	if updated.Answer != "new answer" || updated.Department != nil {
+		t.Errorf("unexpected updated faq: %+v", updated)
+	}
+
+	if err := svc.DeleteFaq(ctx, faq.ID, testFaqAgentID); err != nil {
+		t.Fatalf("DeleteFaq failed: %v", err)
+	}
+	if err := svc.DeleteFaq(ctx, faq.ID, testFaqAgentID); !errors.Is(err, ErrFaqNotFound) {
+		t.Errorf("expected ErrFaqNotFound, got %v", err)
+	}
+}
+
+func TestFaqService_AcceptSuggestion(t *testing.T) {
+	ctx := context.Background()
+	svc, faqRepo, ticketRepo := newTestFaqService(nil)
+	svc.CreateFaq(ctx, testFaqAgentID, "شارژ کیف پول", "چند دقیقه صبر کنید.", "", "")
+	svc.Refresh(ctx)
+
+	department := models.DeptTechnicalSupport
+	ticket := createSuggestionTicket(t, ticketRepo, "شارژ کیف پول", "شارژ نشد", &department)
+	suggestions, _ := svc.Suggest(ctx, ticket)
+	if len(suggestions) == 0 {
+		t.Fatal("expected a suggestion")
+	}
+	suggestionID := suggestions[0].ID
+
+	if _, err := svc.AcceptSuggestion(ctx, ticket.ID, suggestionID, testFaqAgentID); !errors.Is(err, ErrSuggestionForbidden) {
+		t.Errorf("expected ErrSuggestionForbidden, got %v", err)
+	}
+	if _, err := svc.AcceptSuggestion(ctx, ticket.ID+1, suggestionID, testFaqUserID); !errors.Is(err, ErrSuggestionNotFound) {
+		t.Errorf("expected ErrSuggestionNotFound, got %v", err)
+	}
+
+	accepted, err := svc.AcceptSuggestion(ctx, ticket.ID, suggestionID, testFaqUserID)
+	if err != nil {
+		t.Fatalf("AcceptSuggestion failed: %v", err)
+	}
+	if accepted.AcceptedAt == nil || faqRepo.suggestions[suggestionID].AcceptedAt == nil {
+		t.Error("expected the suggestion to be accepted")
+	}
+	if !ticketRepo.tickets[ticket.ID].IsClosed() {
+		t.Error("expected the ticket to be closed")
+	}
+
+	// Accepting again is a no-op
+	if _, err := svc.AcceptSuggestion(ctx, ticket.ID, suggestionID, testFaqUserID); err != nil {
+		t.Errorf("expected accepting twice to succeed, got %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"metargb/support-service/internal/models"
+	"metargb/support-service/internal/repository"
+)
+
+// mockStatsRepository implements StatsRepository for testing
+type mockStatsRepository struct {
+	repository.StatsRepository
+	calls int
+	err   error
+}
+
+func (m *mockStatsRepository) GetSupportStats(ctx context.Context, from, to time.Time) (*models.SupportStats, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &models.SupportStats{
+		From:         from,
+		To:           to,
+		Total:        3,
+		ByStatus:     map[int32]int64{models.TicketStatusNew: 1, models.TicketStatusClosed: 2},
+		ByDepartment: map[string]int64{models.DeptTechnicalSupport: 3},
+	}, nil
+}
+
+func TestSupportStatsService_GetSupportStats(t *testing.T) {
+	repo := &mockStatsRepository{}
+	svc := NewSupportStatsService(repo, []uint64{7}, time.Hour)
+	ctx := context.Background()
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	stats, err := svc.GetSupportStats(ctx, 7, from, to)
+	if err != nil {
+		t.Fatalf("GetSupportStats failed: %v", err)
+	}
+	if stats.Total != 3 || stats.GeneratedAt.IsZero() {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+
+	if _, err := svc.GetSupportStats(ctx, 7, from, to); err != nil {
+		t.Fatalf("GetSupportStats failed: %v", err)
+	}
+	if repo.calls != 1 {
+		t.Errorf("expected the second request to be cached, got %d queries", repo.calls)
+	}
+
+	if _, err := svc.GetSupportStats(ctx, 7, from, to.AddDate(0, 0, 1)); err != nil {
+		t.Fatalf("GetSupportStats failed: %v", err)
+	}
+	if repo.calls != 2 {
+		t.Errorf("expected another period to be queried, got %d queries", repo.calls)
+	}
+}
+
+func TestSupportStatsService_CacheExpires(t *testing.T) {
+	repo := &mockStatsRepository{}
+	svc := NewSupportStatsService(repo, []uint64{7}, time.Nanosecond)
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 2; i++ {
+		if _, err := svc.GetSupportStats(context.Background(), 7, from, from.AddDate(0, 0, 7)); err != nil {
+			t.Fatalf("GetSupportStats failed: %v", err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if repo.calls != 2 {
+		t.Errorf("expected expired stats to be recomputed, got %d queries", repo.calls)
+	}
+}
+
+func TestSupportStatsService_Errors(t *testing.T) {
+	repo := &mockStatsRepository{}
+	svc := NewSupportStatsService(repo, []uint64{7}, 0)
+	ctx := context.Background()
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		userID uint64
+		to     time.Time
+		want   error
+	}{
+		{"not an agent", 8, from.AddDate(0, 0, 1), ErrSupportStatsNotAgent},
+		{"empty period", 7, from, ErrSupportStatsInvalidPeriod},
+		{"too long", 7, from.AddDate(1, 0, 2), ErrSupportStatsPeriodTooLong},
+	}
+	for _, tt := range tests {
+		if _, err := svc.GetSupportStats(ctx, tt.userID, from, tt.to); !errors.Is(err, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+	if repo.calls != 0 {
+		t.Errorf("expected rejected requests not to query, got %d queries", repo.calls)
+	}
+
+	repo.err = errors.New("db down")
+	if _, err := svc.GetSupportStats(ctx, 7, from, from.AddDate(0, 0, 1)); err == nil {
+		t.Error("expected the repository error")
+	}
+}
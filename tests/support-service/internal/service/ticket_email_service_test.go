@@ -0,0 +1,369 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"metargb/support-service/internal/models"
+
+	pbNotification "metargb/shared/pb/notifications"
+)
+
+// mockTicketEmailRepository implements TicketEmailRepository for testing
+type mockTicketEmailRepository struct {
+	emails  []*models.TicketEmail
+	senders map[string]*models.EmailSender
+	pending []*models.PendingEmailReply
+	codes   map[int32]uint64
+}
+
+func newMockTicketEmailRepository() *mockTicketEmailRepository {
+	return &mockTicketEmailRepository{
+		senders: make(map[string]*models.EmailSender),
+		codes:   make(map[int32]uint64),
+	}
+}
+
+func (m *mockTicketEmailRepository) Create(ctx context.Context, email *models.TicketEmail) (*models.TicketEmail, error) {
+	email.ID = uint64(len(m.emails) + 1)
+	m.emails = append(m.emails, email)
+	return email, nil
+}
+
+func (m *mockTicketEmailRepository) GetByMessageID(ctx context.Context, messageID string) (*models.TicketEmail, error) {
+	for _, email := range m.emails {
+		if email.MessageID == messageID {
+			return email, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockTicketEmailRepository) FindTicketIDByMessageIDs(ctx context.Context, messageIDs []string) (uint64, error) {
+	for _, messageID := range messageIDs {
+		if email, _ := m.GetByMessageID(ctx, messageID); email != nil {
+			return email.TicketID, nil
+		}
+	}
+	return 0, nil
+}
+
+func (m *mockTicketEmailRepository) FindTicketIDByCode(ctx context.Context, code int32, userID uint64) (uint64, error) {
+	return m.codes[code], nil
+}
+
+func (m *mockTicketEmailRepository) GetThread(ctx context.Context, ticketID uint64) ([]*models.TicketEmail, error) {
+	var thread []*models.TicketEmail
+	for _, email := range m.emails {
+		if email.TicketID == ticketID {
+			thread = append(thread, email)
+		}
+	}
+	return thread, nil
+}
+
+func (m *mockTicketEmailRepository) ListPendingReplies(ctx context.Context, limit int) ([]*models.PendingEmailReply, error) {
+	return m.pending, nil
+}
+
+func (m *mockTicketEmailRepository) FindSenderByEmail(ctx context.Context, email string) (*models.EmailSender, error) {
+	return m.senders[email], nil
+}
+
+// mockEmailClient records the emails sent through notifications-service
+type mockEmailClient struct {
+	requests []*pbNotification.SendEmailRequest
+	err      error
+}
+
+func (m *mockEmailClient) SendEmail(ctx context.Context, in *pbNotification.SendEmailRequest, opts ...grpc.CallOption) (*pbNotification.EmailResponse, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.requests = append(m.requests, in)
+	return &pbNotification.EmailResponse{}, nil
+}
+
+const testWebhookSecret = "secret"
+
+func newTestTicketEmailService() (TicketEmailService, *mockTicketEmailRepository, *mockTicketRepository) {
+	emailRepo := newMockTicketEmailRepository()
+	emailRepo.senders["ali@example.com"] = &models.EmailSender{UserID: 7, Name: "Ali"}
+	ticketRepo := newMockTicketRepository()
+	svc := NewTicketEmailService(emailRepo, NewTicketService(ticketRepo, ""), ticketRepo, testWebhookSecret, "support@metargb.com")
+	return svc, emailRepo, ticketRepo
+}
+
+func rawEmail(headers, body string) []byte {
+	return []byte(strings.ReplaceAll(headers, "\n", "\r\n") + "\r\n" + body)
+}
+
+func TestParseInboundEmail(t *testing.T) {
+	t.Run("multipart prefers plain text and strips quotes", func(t *testing.T) {
+		raw := rawEmail(`From: Ali <Ali@Example.com>
+To: support@metargb.com
+Subject: =?UTF-8?B?2YXYtNqp2YQg2qnbjNmBINm+2YjZhA==?=
+Message-ID: <abc@mail.example.com>
+In-Reply-To: <ticket-1.response-2.3@metargb.com>
+References: <first@mail.example.com> <ticket-1.response-2.3@metargb.com>
+MIME-Version: 1.0
+Content-Type: multipart/alternative; boundary="b1"
+`, "--b1\r\n"+
+			"Content-Type: text/plain; charset=utf-8\r\n"+
+			"Content-Transfer-Encoding: quoted-printable\r\n\r\n"+
+			"Still not fixed=\r\n, please check.\r\n\r\n"+
+			"On Mon, 1 Jan 2024 at 10:00, Support <support@metargb.com> wrote:\r\n"+
+			"> Previous answer\r\n"+
+			"--b1\r\n"+
+			"Content-Type: text/html; charset=utf-8\r\n\r\n"+
+			"<p>Still not fixed, please check.</p>\r\n"+
+			"--b1--\r\n")
+
+		email, err := ParseInboundEmail(raw)
+		if err != nil {
+			t.Fatalf("ParseInboundEmail failed: %v", err)
+		}
+		if email.FromAddress != "ali@example.com" || email.FromName != "Ali" {
+			t.Errorf("Expected sender ali@example.com (Ali), got %s (%s)", email.FromAddress, email.FromName)
+		}
+		if email.Subject != "مشکل کیف پول" {
+			t.Errorf("Expected decoded subject, got %q", email.Subject)
+		}
+		if email.Body != "Still not fixed, please check." {
+			t.Errorf("Expected reply without quote, got %q", email.Body)
+		}
+		ids := email.ThreadIDs()
+		if len(ids) != 2 || ids[0] != "<ticket-1.response-2.3@metargb.com>" || ids[1] != "<first@mail.example.com>" {
+			t.Errorf("Unexpected thread IDs %v", ids)
+		}
+	})
+
+	t.Run("html only body", func(t *testing.T) {
+		raw := rawEmail(`From: ali@example.com
+Subject: Help
+Content-Type: text/html; charset=utf-8
+`, "<div>Line one</div><div>Tom &amp; Jerry</div>")
+
+		email, err := ParseInboundEmail(raw)
+		if err != nil {
+			t.Fatalf("ParseInboundEmail failed: %v", err)
+		}
+		if email.Body != "Line one\nTom & Jerry" {
+			t.Errorf("Unexpected body %q", email.Body)
+		}
+		if email.MessageID == "" {
+			t.Error("Expected a fallback message ID")
+		}
+	})
+
+	t.Run("auto reply", func(t *testing.T) {
+		raw := rawEmail(`From: ali@example.com
+Subject: Out of office
+Auto-Submitted: auto-replied
+`, "I am away")
+
+		email, err := ParseInboundEmail(raw)
+		if err != nil {
+			t.Fatalf("ParseInboundEmail failed: %v", err)
+		}
+		if !email.AutoSubmitted {
+			t.Error("Expected auto-replied email to be marked as auto submitted")
+		}
+	})
+
+	t.Run("missing sender", func(t *testing.T) {
+		_, err := ParseInboundEmail(rawEmail("Subject: Help\n", "body"))
+		if !errors.Is(err, ErrInvalidEmail) {
+			t.Errorf("Expected ErrInvalidEmail, got %v", err)
+		}
+	})
+}
+
+func TestTicketEmailService_IngestEmail(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rejects invalid token", func(t *testing.T) {
+		svc, _, _ := newTestTicketEmailService()
+		raw := rawEmail("From: ali@example.com\nSubject: Help\n", "body")
+		if _, err := svc.IngestEmail(ctx, raw, "wrong"); !errors.Is(err, ErrInvalidWebhookToken) {
+			t.Errorf("Expected ErrInvalidWebhookToken, got %v", err)
+		}
+	})
+
+	t.Run("disabled without secret", func(t *testing.T) {
+		ticketRepo := newMockTicketRepository()
+		svc := NewTicketEmailService(newMockTicketEmailRepository(), NewTicketService(ticketRepo, ""), ticketRepo, "", "")
+		raw := rawEmail("From: ali@example.com\nSubject: Help\n", "body")
+		if _, err := svc.IngestEmail(ctx, raw, ""); !errors.Is(err, ErrEmailIngestionDisabled) {
+			t.Errorf("Expected ErrEmailIngestionDisabled, got %v", err)
+		}
+	})
+
+	t.Run("creates ticket and threads reply", func(t *testing.T) {
+		svc, emailRepo, ticketRepo := newTestTicketEmailService()
+
+		raw := rawEmail(`From: Ali <ali@example.com>
+To: support@metargb.com
+Subject: Wallet top-up
+Message-ID: <first@mail.example.com>
+`, "My top-up did not arrive")
+		result, err := svc.IngestEmail(ctx, raw, testWebhookSecret)
+		if err != nil {
+			t.Fatalf("IngestEmail failed: %v", err)
+		}
+		if result.Status != models.IngestStatusCreated {
+			t.Fatalf("Expected status %s, got %s", models.IngestStatusCreated, result.Status)
+		}
+		ticket := ticketRepo.tickets[result.TicketID]
+		if ticket == nil || ticket.UserID != 7 || ticket.Title != "Wallet top-up" {
+			t.Fatalf("Unexpected ticket %+v", ticket)
+		}
+		if ticket.Department == nil || *ticket.Department != models.DeptTechnicalSupport {
+			t.Errorf("Expected department %s, got %v", models.DeptTechnicalSupport, ticket.Department)
+		}
+		if len(emailRepo.emails) != 1 || emailRepo.emails[0].Direction != models.TicketEmailInbound {
+			t.Fatalf("Expected one inbound email to be recorded, got %d", len(emailRepo.emails))
+		}
+
+		// The same message delivered again is not ingested twice
+		again, err := svc.IngestEmail(ctx, raw, testWebhookSecret)
+		if err != nil {
+			t.Fatalf("IngestEmail failed: %v", err)
+		}
+		if again.Status != models.IngestStatusDuplicate || again.TicketID != result.TicketID {
+			t.Errorf("Expected duplicate of ticket %d, got %+v", result.TicketID, again)
+		}
+
+		reply := rawEmail(`From: ali@example.com
+Subject: Re: Wallet top-up
+Message-ID: <second@mail.example.com>
+In-Reply-To: <first@mail.example.com>
+`, "Any update?\r\n\r\n> My top-up did not arrive")
+		replied, err := svc.IngestEmail(ctx, reply, testWebhookSecret)
+		if err != nil {
+			t.Fatalf("IngestEmail failed: %v", err)
+		}
+		if replied.Status != models.IngestStatusReplied || replied.TicketID != result.TicketID {
+			t.Fatalf("Expected reply to ticket %d, got %+v", result.TicketID, replied)
+		}
+		responses := ticketRepo.responses[result.TicketID]
+		if len(responses) != 1 || responses[0].Response != "Any update?" || responses[0].ResponserID != 7 {
+			t.Errorf("Unexpected responses %+v", responses)
+		}
+	})
+
+	t.Run("threads reply by ticket code", func(t *testing.T) {
+		svc, emailRepo, ticketRepo := newTestTicketEmailService()
+		ticketRepo.tickets[3] = &models.TicketWithRelations{Ticket: models.Ticket{ID: 3, UserID: 7, Code: 482913, Status: models.TicketStatusAnswered}}
+		emailRepo.codes[482913] = 3
+
+		raw := rawEmail("From: ali@example.com\nSubject: Re: Wallet top-up [#482913]\nMessage-ID: <third@mail.example.com>\n", "Thanks")
+		result, err := svc.IngestEmail(ctx, raw, testWebhookSecret)
+		if err != nil {
+			t.Fatalf("IngestEmail failed: %v", err)
+		}
+		if result.Status != models.IngestStatusReplied || result.TicketID != 3 {
+			t.Errorf("Expected reply to ticket 3, got %+v", result)
+		}
+	})
+
+	t.Run("reply to closed ticket opens a new one", func(t *testing.T) {
+		svc, emailRepo, ticketRepo := newTestTicketEmailService()
+		ticketRepo.tickets[1] = &models.TicketWithRelations{Ticket: models.Ticket{ID: 1, UserID: 7, Code: 482913, Status: models.TicketStatusClosed}}
+		emailRepo.codes[482913] = 1
+
+		raw := rawEmail("From: ali@example.com\nSubject: Re: Wallet top-up [#482913]\nMessage-ID: <fourth@mail.example.com>\n", "It broke again")
+		result, err := svc.IngestEmail(ctx, raw, testWebhookSecret)
+		if err != nil {
+			t.Fatalf("IngestEmail failed: %v", err)
+		}
+		if result.Status != models.IngestStatusCreated || result.TicketID == 1 {
+			t.Fatalf("Expected a new ticket, got %+v", result)
+		}
+		if title := ticketRepo.tickets[result.TicketID].Title; title != "Re: Wallet top-up" {
+			t.Errorf("Expected title without ticket code, got %q", title)
+		}
+	})
+
+	t.Run("ignores unregistered and automatic senders", func(t *testing.T) {
+		svc, emailRepo, ticketRepo := newTestTicketEmailService()
+
+		for _, raw := range [][]byte{
+			rawEmail("From: stranger@example.com\nSubject: Hello\n", "body"),
+			rawEmail("From: ali@example.com\nSubject: Away\nPrecedence: bulk\n", "body"),
+			rawEmail("From: support@metargb.com\nSubject: Re: Help\n", "body"),
+			rawEmail("From: ali@example.com\nSubject: Empty\n", "  "),
+		} {
+			result, err := svc.IngestEmail(ctx, raw, testWebhookSecret)
+			if err != nil {
+				t.Fatalf("IngestEmail failed: %v", err)
+			}
+			if result.Status != models.IngestStatusIgnored {
+				t.Errorf("Expected status %s, got %s", models.IngestStatusIgnored, result.Status)
+			}
+		}
+		if len(ticketRepo.tickets) != 0 || len(emailRepo.emails) != 0 {
+			t.Errorf("Expected nothing to be stored, got %d tickets and %d emails", len(ticketRepo.tickets), len(emailRepo.emails))
+		}
+	})
+}
+
+func TestTicketEmailWorker_Run(t *testing.T) {
+	ctx := context.Background()
+	emailRepo := newMockTicketEmailRepository()
+	emailRepo.emails = []*models.TicketEmail{
+		{ID: 1, TicketID: 5, Direction: models.TicketEmailInbound, MessageID: "<first@mail.example.com>", FromAddress: "ali@example.com"},
+		{ID: 2, TicketID: 5, Direction: models.TicketEmailInbound, MessageID: "<second@mail.example.com>", FromAddress: "ali@example.com"},
+	}
+	emailRepo.pending = []*models.PendingEmailReply{
+		{ResponseID: 9, TicketID: 5, TicketTitle: "Wallet top-up", TicketCode: 482913, Response: "Your wallet is charged.", ResponserName: "پشتیبانی"},
+	}
+	client := &mockEmailClient{}
+	worker := NewTicketEmailWorker(emailRepo, client, "support@metargb.com", 0)
+
+	sent, err := worker.Run(ctx)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if sent != 1 || len(client.requests) != 1 {
+		t.Fatalf("Expected one email, got %d", len(client.requests))
+	}
+
+	req := client.requests[0]
+	if req.To != "ali@example.com" || req.Subject != "Re: Wallet top-up [#482913]" {
+		t.Errorf("Unexpected recipient or subject: %s %q", req.To, req.Subject)
+	}
+	if req.Headers["In-Reply-To"] != "<second@mail.example.com>" {
+		t.Errorf("Expected In-Reply-To of the latest email, got %q", req.Headers["In-Reply-To"])
+	}
+	if req.Headers["References"] != "<first@mail.example.com> <second@mail.example.com>" {
+		t.Errorf("Unexpected References %q", req.Headers["References"])
+	}
+	if !strings.HasSuffix(req.Headers["Message-ID"], "@metargb.com>") {
+		t.Errorf("Unexpected Message-ID %q", req.Headers["Message-ID"])
+	}
+
+	outbound := emailRepo.emails[len(emailRepo.emails)-1]
+	if outbound.Direction != models.TicketEmailOutbound || outbound.ResponseID == nil || *outbound.ResponseID != 9 {
+		t.Errorf("Expected outbound email for response 9, got %+v", outbound)
+	}
+	if outbound.MessageID != req.Headers["Message-ID"] {
+		t.Errorf("Expected recorded Message-ID %q, got %q", req.Headers["Message-ID"], outbound.MessageID)
+	}
+
+	t.Run("failed email is not recorded", func(t *testing.T) {
+		before := len(emailRepo.emails)
+		worker := NewTicketEmailWorker(emailRepo, &mockEmailClient{err: errors.New("smtp down")}, "support@metargb.com", 0)
+		sent, err := worker.Run(ctx)
+		if err != nil {
+			t.Fatalf("Run failed: %v", err)
+		}
+		if sent != 0 || len(emailRepo.emails) != before {
+			t.Errorf("Expected nothing sent or recorded, got %d sent", sent)
+		}
+	})
+}